@@ -0,0 +1,245 @@
+// Command admin-ops runs operational admin tasks against a running API
+// through the generated client (pkg/client): failover drills, query stats,
+// log levels and runtime snapshots. It needs an admin token, e.g. from
+// `make admin-token-raw`, in -token or SAGA_TOKEN.
+//
+//	go run ./cmd/admin-ops region
+//	go run ./cmd/admin-ops region -read-only=true
+//	go run ./cmd/admin-ops query-stats -limit 10
+//	go run ./cmd/admin-ops log-level -set debug -for 30m
+//	go run ./cmd/admin-ops snapshot
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/forgo/saga/api/pkg/client"
+)
+
+const usage = `Usage: admin-ops [-api url] [-token token] <command> [flags]
+
+Commands:
+  region       Show the region state, or change it with -role and -read-only
+  query-stats  Show the slowest repository methods, or clear them with -reset
+  log-level    Show the log level, or change it with -set and -for
+  snapshot     Write goroutine and heap snapshots on the serving instance
+`
+
+func main() {
+	baseURL := flag.String("api", envOr("SAGA_API_URL", client.DefaultBaseURL), "API base URL")
+	token := flag.String("token", os.Getenv("SAGA_TOKEN"), "Admin access token")
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *token == "" {
+		fmt.Fprintf(os.Stderr, "Error: an admin token is required (-token or SAGA_TOKEN)\n")
+		fmt.Fprintf(os.Stderr, "\nGenerate one with: make admin-token-raw\n")
+		os.Exit(2)
+	}
+
+	api := client.NewClient(client.Config{
+		BaseURL:   *baseURL,
+		Token:     *token,
+		UserAgent: "saga-admin-ops",
+	})
+	ctx := context.Background()
+
+	var err error
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "region":
+		err = runRegion(ctx, api, args)
+	case "query-stats":
+		err = runQueryStats(ctx, api, args)
+	case "log-level":
+		err = runLogLevel(ctx, api, args)
+	case "snapshot":
+		err = runSnapshot(ctx, api)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", cmd)
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runRegion(ctx context.Context, api *client.Client, args []string) error {
+	fs := flag.NewFlagSet("region", flag.ExitOnError)
+	role := fs.String("role", "", "Promote (active) or demote (standby) the region")
+	readOnly := fs.String("read-only", "", "Turn read-only mode on (true) or off (false)")
+	_ = fs.Parse(args)
+
+	var req client.UpdateRegionRequest
+	if *role != "" {
+		req.Role = role
+	}
+	if *readOnly != "" {
+		value, err := strconv.ParseBool(*readOnly)
+		if err != nil {
+			return fmt.Errorf("-read-only must be true or false, got %q", *readOnly)
+		}
+		req.ReadOnly = &value
+	}
+
+	var status *client.RegionStatus
+	if req.Role == nil && req.ReadOnly == nil {
+		resp, err := api.AdminGetRegion(ctx)
+		if err != nil {
+			return err
+		}
+		status = resp.Data
+	} else {
+		resp, err := api.AdminUpdateRegion(ctx, &req)
+		if err != nil {
+			return err
+		}
+		status = resp.Data
+	}
+	if status == nil {
+		return errors.New("empty response")
+	}
+
+	fmt.Printf("Region:     %s\n", status.Name)
+	fmt.Printf("Role:       %s\n", status.Role)
+	fmt.Printf("Read-only:  %t\n", status.ReadOnly)
+	if status.ReplicaLagSeconds != nil {
+		fmt.Printf("Lag:        %.1fs", *status.ReplicaLagSeconds)
+		if status.LagExceeded {
+			fmt.Print(" (exceeds DB_MAX_REPLICA_LAG)")
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+func runQueryStats(ctx context.Context, api *client.Client, args []string) error {
+	fs := flag.NewFlagSet("query-stats", flag.ExitOnError)
+	limit := fs.Int("limit", 20, "Show only the worst N methods (0 for all)")
+	reset := fs.Bool("reset", false, "Clear the collected stats instead of showing them")
+	_ = fs.Parse(args)
+
+	if *reset {
+		if err := api.AdminResetQueryStats(ctx); err != nil {
+			return err
+		}
+		fmt.Println("Query stats cleared")
+		return nil
+	}
+
+	params := &client.AdminGetQueryStatsParams{}
+	if *limit > 0 {
+		params.Limit = limit
+	}
+	resp, err := api.AdminGetQueryStats(ctx, params)
+	if err != nil {
+		return err
+	}
+	if resp.Data == nil {
+		return errors.New("empty response")
+	}
+	stats := resp.Data
+
+	fmt.Printf("Since %s, slow threshold %dms\n\n", stats.Since.Format(time.RFC3339), stats.SlowThresholdMs)
+	fmt.Printf("%-60s %8s %8s %6s %10s %10s\n", "METHOD", "COUNT", "ERRORS", "SLOW", "TOTAL", "MAX")
+	for _, m := range stats.Methods {
+		fmt.Printf("%-60s %8d %8d %6d %10s %10s\n", m.Method, m.Count, m.Errors, m.SlowCount,
+			nanos(m.TotalDurationNs), nanos(m.MaxDurationNs))
+	}
+
+	if len(stats.RecentSlow) > 0 {
+		fmt.Printf("\nRecent slow queries:\n")
+		for _, q := range stats.RecentSlow {
+			fmt.Printf("  %s  %s  %s (%d rows)\n", q.ExecutedOn.Format(time.RFC3339), nanos(q.DurationNs), q.Method, q.Rows)
+			if q.Error != nil {
+				fmt.Printf("    error: %s\n", *q.Error)
+			}
+			fmt.Printf("    %s\n", q.Query)
+		}
+	}
+	return nil
+}
+
+func runLogLevel(ctx context.Context, api *client.Client, args []string) error {
+	fs := flag.NewFlagSet("log-level", flag.ExitOnError)
+	set := fs.String("set", "", "New level: debug, info, warn or error")
+	duration := fs.Duration("for", 0, "Revert to the configured level after this long, e.g. 30m (whole minutes)")
+	_ = fs.Parse(args)
+
+	var level *client.LogLevel
+	if *set == "" {
+		if *duration != 0 {
+			return errors.New("-for needs -set")
+		}
+		resp, err := api.AdminGetLogLevel(ctx)
+		if err != nil {
+			return err
+		}
+		level = resp.Data
+	} else {
+		req := &client.UpdateLogLevelRequest{Level: *set}
+		if *duration != 0 {
+			minutes := int(duration.Minutes())
+			if minutes < 1 || time.Duration(minutes)*time.Minute != *duration {
+				return fmt.Errorf("-for must be a whole number of minutes, got %s", *duration)
+			}
+			req.DurationMinutes = &minutes
+		}
+		resp, err := api.AdminUpdateLogLevel(ctx, req)
+		if err != nil {
+			return err
+		}
+		level = resp.Data
+	}
+	if level == nil {
+		return errors.New("empty response")
+	}
+
+	fmt.Printf("Level:    %s\n", level.Level)
+	fmt.Printf("Default:  %s\n", level.DefaultLevel)
+	if level.ExpiresOn != nil {
+		fmt.Printf("Reverts:  %s\n", level.ExpiresOn.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func runSnapshot(ctx context.Context, api *client.Client) error {
+	resp, err := api.AdminCreateRuntimeSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.Data == nil {
+		return errors.New("empty response")
+	}
+	snap := resp.Data
+
+	fmt.Printf("Snapshot taken %s\n", snap.TakenOn.Format(time.RFC3339))
+	fmt.Printf("Goroutines:  %d (%s)\n", snap.Goroutines, snap.GoroutineFile)
+	fmt.Printf("Heap:        %.1f MB, %d objects (%s)\n", snap.HeapAllocMb, snap.HeapObjects, snap.HeapFile)
+	fmt.Printf("GC cycles:   %d\n", snap.NumGc)
+	fmt.Println("\nThe files are on the instance that served the request.")
+	return nil
+}
+
+// nanos formats a duration reported in nanoseconds
+func nanos(ns int) string {
+	return time.Duration(ns).Round(time.Microsecond).String()
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,250 @@
+// Command matchsim evaluates pool matching offline. It generates a seeded
+// population, runs a number of matching rounds through PoolService for each
+// matching config, and reports how the configs compare:
+//
+//   - repeat pairs: share of pairings that had already happened in an
+//     earlier round
+//   - avg compat: mean compatibility (0-100) of the members paired together
+//   - stranded: members present for a round but left out of every group
+//
+// Nothing touches the database; matches live in memory on a simulated clock,
+// so the variety penalty sees earlier rounds as it would in production.
+// Member exclusions are not simulated.
+//
+//	go run ./cmd/matchsim -members 60 -rounds 26 -configs default,variety
+//	go run ./cmd/matchsim -variety 0.8 -compat 0.5 -recency 60 -json
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// presets are the matching configs compared by default
+var presets = map[string]model.MatchingConfig{
+	"default":       model.DefaultMatchingConfig,
+	"random":        {VarietyWeight: 0, CompatibilityWeight: 0, RecencyDays: 30},
+	"compatibility": {VarietyWeight: 0.2, CompatibilityWeight: 0.9, RecencyDays: 30},
+	"variety":       {VarietyWeight: 1, CompatibilityWeight: 0.4, RecencyDays: 90},
+}
+
+// options describe the simulated pool, shared by every config
+type options struct {
+	Members   int     `json:"members"`
+	MatchSize int     `json:"match_size"`
+	Rounds    int     `json:"rounds"`
+	Frequency string  `json:"frequency"`
+	Absent    float64 `json:"absent"`
+	Seed      uint64  `json:"seed"`
+}
+
+// result is one config's outcome over all rounds
+type result struct {
+	Config          string               `json:"config"`
+	Matching        model.MatchingConfig `json:"matching"`
+	Groups          int                  `json:"groups"`
+	Pairs           int                  `json:"pairs"`
+	RepeatPairRate  float64              `json:"repeat_pair_rate"`
+	AvgCompat       float64              `json:"avg_compatibility"`
+	StrandedAvg     float64              `json:"stranded_per_round"`
+	LongestStranded int                  `json:"longest_stranded_rounds"`
+}
+
+func main() {
+	var opts options
+	flag.IntVar(&opts.Members, "members", 40, "Members in the pool")
+	flag.IntVar(&opts.MatchSize, "size", 2, "Members per group")
+	flag.IntVar(&opts.Rounds, "rounds", 12, "Matching rounds to run")
+	flag.StringVar(&opts.Frequency, "frequency", model.PoolFrequencyWeekly, "Pool frequency: weekly, biweekly or monthly")
+	flag.Float64Var(&opts.Absent, "absent", 0, "Chance a member sits out a round (0-1)")
+	flag.Uint64Var(&opts.Seed, "seed", 1, "Seed for the population, absences and shuffles")
+	configs := flag.String("configs", "default,random,compatibility,variety", "Comma-separated presets to compare")
+	variety := flag.Float64("variety", -1, "Add a custom config with this variety weight")
+	compat := flag.Float64("compat", -1, "Add a custom config with this compatibility weight")
+	recency := flag.Int("recency", -1, "Add a custom config with this recency window in days")
+	asJSON := flag.Bool("json", false, "Print results as JSON")
+	verbose := flag.Bool("v", false, "Show PoolService logs")
+	flag.Parse()
+
+	if opts.Members < opts.MatchSize || opts.MatchSize < 2 || opts.Rounds < 1 || opts.Absent < 0 || opts.Absent >= 1 {
+		fmt.Fprintf(os.Stderr, "Need -size >= 2, -members >= -size, -rounds >= 1 and 0 <= -absent < 1\n")
+		os.Exit(2)
+	}
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	var names []string
+	for _, name := range strings.Split(*configs, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := presets[name]; !ok {
+			fmt.Fprintf(os.Stderr, "Unknown config %q; presets are %s\n", name, strings.Join(presetNames(), ", "))
+			os.Exit(2)
+		}
+		names = append(names, name)
+	}
+	matching := make(map[string]model.MatchingConfig, len(names)+1)
+	for _, name := range names {
+		matching[name] = presets[name]
+	}
+	if *variety >= 0 || *compat >= 0 || *recency >= 0 {
+		custom := model.DefaultMatchingConfig
+		if *variety >= 0 {
+			custom.VarietyWeight = *variety
+		}
+		if *compat >= 0 {
+			custom.CompatibilityWeight = *compat
+		}
+		if *recency >= 0 {
+			custom.RecencyDays = *recency
+		}
+		names = append(names, "custom")
+		matching["custom"] = custom
+	}
+
+	results := make([]*result, 0, len(names))
+	for _, name := range names {
+		r, err := simulate(name, matching[name], opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error simulating %s: %v\n", name, err)
+			os.Exit(1)
+		}
+		results = append(results, r)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(map[string]any{"options": opts, "results": results})
+		return
+	}
+	printTable(opts, results)
+}
+
+// simulate runs every round for one config against a fresh copy of the
+// population. Absences and shuffles come from the same seed for every
+// config, so differences come from the config alone.
+func simulate(name string, cfg model.MatchingConfig, opts options) (*result, error) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	pop := newPopulation(opts.Members, opts.Seed, start)
+	pool := &model.MatchingPool{
+		ID:        simPoolID,
+		Name:      "Simulation",
+		Frequency: opts.Frequency,
+		MatchSize: opts.MatchSize,
+		Active:    true,
+	}
+	st := newStore(pool, nil, start)
+	svc := service.NewPoolService(service.PoolServiceConfig{
+		PoolRepo:      st,
+		Compatibility: pop,
+		Config:        &cfg,
+		Rand:          rand.NewPCG(opts.Seed, 1),
+	})
+	absences := rand.New(rand.NewPCG(opts.Seed, 2))
+
+	r := &result{Config: name, Matching: cfg}
+	seen := make(map[string]bool)
+	streak := make(map[model.MemberID]int)
+	compatSum, repeats, stranded := 0.0, 0, 0
+
+	for round := 0; round < opts.Rounds; round++ {
+		present := make([]*model.PoolMember, 0, len(pop.members))
+		for _, m := range pop.members {
+			if absences.Float64() >= opts.Absent {
+				present = append(present, m)
+			}
+		}
+		st.members = present
+
+		matched := make(map[model.MemberID]bool, len(present))
+		if len(present) >= opts.MatchSize {
+			info, err := svc.RunMatching(ctx, pool.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, match := range info.Matches {
+				r.Groups++
+				for i := range match.Members {
+					matched[match.Members[i]] = true
+					for j := i + 1; j < len(match.Members); j++ {
+						key := pairKey(match.Members[i], match.Members[j])
+						if seen[key] {
+							repeats++
+						}
+						seen[key] = true
+						compatSum += pop.score(match.MemberUserIDs[i], match.MemberUserIDs[j])
+						r.Pairs++
+					}
+				}
+			}
+		}
+
+		for _, m := range present {
+			if matched[m.MemberID] {
+				streak[m.MemberID] = 0
+				continue
+			}
+			stranded++
+			streak[m.MemberID]++
+			r.LongestStranded = max(r.LongestStranded, streak[m.MemberID])
+		}
+
+		st.now = model.GetNextMatchDate(opts.Frequency, st.now)
+	}
+
+	if r.Pairs > 0 {
+		r.RepeatPairRate = float64(repeats) / float64(r.Pairs)
+		r.AvgCompat = compatSum / float64(r.Pairs)
+	}
+	r.StrandedAvg = float64(stranded) / float64(opts.Rounds)
+	return r, nil
+}
+
+func printTable(opts options, results []*result) {
+	fmt.Printf("%d members, groups of %d, %d %s rounds, %.0f%% absent, seed %d\n\n",
+		opts.Members, opts.MatchSize, opts.Rounds, opts.Frequency, opts.Absent*100, opts.Seed)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CONFIG\tVARIETY\tCOMPAT\tRECENCY\tGROUPS\tREPEAT PAIRS\tAVG COMPAT\tSTRANDED/ROUND\tLONGEST STRANDED")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%.2f\t%.2f\t%dd\t%d\t%.1f%%\t%.1f\t%.1f\t%d rounds\n",
+			r.Config, r.Matching.VarietyWeight, r.Matching.CompatibilityWeight, r.Matching.RecencyDays,
+			r.Groups, r.RepeatPairRate*100, r.AvgCompat, r.StrandedAvg, r.LongestStranded)
+	}
+	_ = w.Flush()
+}
+
+// pairKey identifies an unordered pair of members
+func pairKey(a, b model.MemberID) string {
+	if a > b {
+		a, b = b, a
+	}
+	return string(a) + "|" + string(b)
+}
+
+func presetNames() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
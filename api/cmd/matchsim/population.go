@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// traitCount is how many answers each simulated member has
+const traitCount = 6
+
+// population is a seeded set of pool members with questionnaire-like traits.
+// Compatibility between two members is how close their traits are.
+type population struct {
+	members []*model.PoolMember
+	traits  map[model.UserID][]float64
+}
+
+// newPopulation generates size members. Members lean towards one of a few
+// clusters, as real guilds have groups with shared interests.
+func newPopulation(size int, seed uint64, joined time.Time) *population {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	clusters := make([][]float64, 4)
+	for i := range clusters {
+		clusters[i] = make([]float64, traitCount)
+		for j := range clusters[i] {
+			clusters[i][j] = rng.Float64()
+		}
+	}
+
+	p := &population{traits: make(map[model.UserID][]float64, size)}
+	for i := 0; i < size; i++ {
+		userID := model.UserID(fmt.Sprintf("user:sim%04d", i))
+		center := clusters[rng.IntN(len(clusters))]
+		traits := make([]float64, traitCount)
+		for j := range traits {
+			traits[j] = math.Min(1, math.Max(0, center[j]+rng.NormFloat64()*0.2))
+		}
+		p.traits[userID] = traits
+		p.members = append(p.members, &model.PoolMember{
+			ID:       model.PoolMemberID(fmt.Sprintf("pool_member:sim%04d", i)),
+			PoolID:   simPoolID,
+			MemberID: model.MemberID(fmt.Sprintf("member:sim%04d", i)),
+			UserID:   userID,
+			Active:   true,
+			JoinedOn: joined,
+		})
+	}
+	return p
+}
+
+// score is the 0-100 compatibility between two users
+func (p *population) score(userAID, userBID model.UserID) float64 {
+	a, b := p.traits[userAID], p.traits[userBID]
+	diff := 0.0
+	for i := range a {
+		diff += math.Abs(a[i] - b[i])
+	}
+	return 100 * (1 - diff/float64(len(a)))
+}
+
+// CalculateCompatibility implements service.CompatibilityCalculator
+func (p *population) CalculateCompatibility(ctx context.Context, userAID, userBID string) (*model.CompatibilityScore, error) {
+	score := p.score(model.UserID(userAID), model.UserID(userBID))
+	return &model.CompatibilityScore{
+		UserAID:     userAID,
+		UserBID:     userBID,
+		Score:       score,
+		AToB:        score,
+		BToA:        score,
+		SharedCount: traitCount,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+const simPoolID = "matching_pool:sim"
+
+// store is an in-memory PoolRepository for a single pool, on a simulated
+// clock. Only what RunMatching uses is implemented; the embedded interface
+// panics if anything else is called.
+type store struct {
+	service.PoolRepository
+	pool    *model.MatchingPool
+	members []*model.PoolMember
+	matches []*model.MatchResult
+	now     time.Time
+}
+
+func newStore(pool *model.MatchingPool, members []*model.PoolMember, now time.Time) *store {
+	return &store{pool: pool, members: members, now: now}
+}
+
+func (s *store) GetPool(ctx context.Context, poolID string) (*model.MatchingPool, error) {
+	if poolID != string(s.pool.ID) {
+		return nil, nil
+	}
+	pool := *s.pool
+	return &pool, nil
+}
+
+func (s *store) GetPoolMembers(ctx context.Context, poolID string) ([]*model.PoolMember, error) {
+	return s.members, nil
+}
+
+// GetRecentMatchesBetween finds matches containing all memberIDs within the
+// last days of simulated time
+func (s *store) GetRecentMatchesBetween(ctx context.Context, memberIDs []string, days int) ([]*model.MatchResult, error) {
+	since := s.now.AddDate(0, 0, -days)
+	var recent []*model.MatchResult
+	for _, match := range s.matches {
+		if !match.CreatedOn.After(since) {
+			continue
+		}
+		all := true
+		for _, id := range memberIDs {
+			if !slices.Contains(match.Members, model.MemberID(id)) {
+				all = false
+				break
+			}
+		}
+		if all {
+			recent = append(recent, match)
+		}
+	}
+	return recent, nil
+}
+
+func (s *store) CreateMatchResult(ctx context.Context, match *model.MatchResult) error {
+	match.ID = model.MatchID(fmt.Sprintf("match_result:sim%d", len(s.matches)))
+	match.CreatedOn = s.now
+	match.UpdatedOn = s.now
+	s.matches = append(s.matches, match)
+	return nil
+}
+
+func (s *store) UpdatePool(ctx context.Context, poolID string, updates map[string]interface{}) (*model.MatchingPool, error) {
+	return s.GetPool(ctx, poolID)
+}
@@ -0,0 +1,89 @@
+// Command replay-matching re-forms a matching pool's groups with the seed
+// logged for a past round ("[PoolService] Matching pool ... with seed N") and
+// prints them, without saving anything. Use it to debug a bad round.
+//
+// Scores are computed from current data, so members who joined or left since
+// the round, and the round's own matches, can change the outcome.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/config"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/repository"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+func main() {
+	poolID := flag.String("pool", "", "Pool ID, e.g. matching_pool:abc123")
+	seed := flag.Uint64("seed", 0, "Seed logged for the round")
+	flag.Parse()
+
+	if *poolID == "" {
+		fmt.Fprintf(os.Stderr, "Usage: replay-matching -pool <pool id> -seed <seed>\n")
+		os.Exit(2)
+	}
+	pool, err := model.ParsePoolID(*poolID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	db, err := database.Open(database.Config{
+		Driver:    cfg.Database.Driver,
+		Host:      cfg.Database.Host,
+		Port:      cfg.Database.Port,
+		User:      cfg.Database.User,
+		Password:  cfg.Database.Password,
+		Namespace: cfg.Database.Namespace,
+		Database:  cfg.Database.Database,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening database: %v\n", err)
+		os.Exit(1)
+	}
+	ctx := context.Background()
+	if err := db.Connect(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error connecting to database: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() { _ = db.Close() }()
+
+	// Score as the server does
+	poolService := service.NewPoolService(service.PoolServiceConfig{
+		PoolRepo:   repository.NewPoolRepository(db),
+		GuildRepo:  repository.NewGuildRepository(db),
+		MemberRepo: repository.NewMemberRepository(db),
+		Compatibility: service.NewCompatibilityService(service.CompatibilityServiceConfig{
+			QuestionnaireRepo: repository.NewQuestionnaireRepository(db),
+		}),
+	})
+
+	replay, err := poolService.ReplayMatching(ctx, pool, *seed)
+	if errors.Is(err, service.ErrNotEnoughMembers) {
+		fmt.Fprintf(os.Stderr, "Pool %s no longer has enough active members to match\n", pool)
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error replaying matching: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Pool %s (%s), seed %d: %d groups\n", replay.PoolName, replay.PoolID, replay.Seed, replay.MatchCount)
+	for i, match := range replay.Matches {
+		fmt.Printf("  %d. %s\n", i+1, strings.Join(model.IDStrings(match.Members), ", "))
+	}
+}
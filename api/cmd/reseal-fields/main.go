@@ -0,0 +1,76 @@
+// Command reseal-fields encrypts sensitive fields stored before encryption was
+// enabled, and re-encrypts fields sealed with an old key under the primary
+// key in FIELD_ENCRYPTION_KEYS. Run it after adding a key, before removing
+// the old one. It is safe to run repeatedly and while the API is serving.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/forgo/saga/api/internal/config"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/repository"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 100, "Rows to reseal per query")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	keyring, err := cfg.Fields.Keyring()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading FIELD_ENCRYPTION_KEYS: %v\n", err)
+		os.Exit(1)
+	}
+	if keyring == nil {
+		fmt.Fprintf(os.Stderr, "FIELD_ENCRYPTION_KEYS is not set\n")
+		fmt.Fprintf(os.Stderr, "\nGenerate a key with: openssl rand -base64 32\n")
+		os.Exit(1)
+	}
+
+	db, err := database.Open(database.Config{
+		Driver:    cfg.Database.Driver,
+		Host:      cfg.Database.Host,
+		Port:      cfg.Database.Port,
+		User:      cfg.Database.User,
+		Password:  cfg.Database.Password,
+		Namespace: cfg.Database.Namespace,
+		Database:  cfg.Database.Database,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening database: %v\n", err)
+		os.Exit(1)
+	}
+	ctx := context.Background()
+	if err := db.Connect(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error connecting to database: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() { _ = db.Close() }()
+
+	fmt.Printf("Resealing with primary key %q\n", keyring.PrimaryKeyID())
+
+	profileRepo := repository.NewProfileRepository(db, keyring)
+	total := 0
+	for {
+		n, err := profileRepo.ResealLocations(ctx, *batchSize)
+		total += n
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error resealing profile locations after %d rows: %v\n", total, err)
+			fmt.Fprintf(os.Stderr, "\nRows sealed with a key missing from FIELD_ENCRYPTION_KEYS can't be opened; add it back and rerun.\n")
+			os.Exit(1)
+		}
+		if n == 0 {
+			break
+		}
+	}
+	fmt.Printf("Profile locations: %d resealed\n", total)
+}
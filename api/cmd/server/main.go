@@ -2,28 +2,47 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"expvar"
 	"log/slog"
 	"net/http"
+	"net/http/pprof"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/forgo/saga/api/internal/broker"
+	"github.com/forgo/saga/api/internal/chaos"
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/config"
 	"github.com/forgo/saga/api/internal/database"
 	"github.com/forgo/saga/api/internal/handler"
 	"github.com/forgo/saga/api/internal/jobs"
 	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
 	"github.com/forgo/saga/api/internal/repository"
+	"github.com/forgo/saga/api/internal/router"
+	"github.com/forgo/saga/api/internal/schema"
 	"github.com/forgo/saga/api/internal/service"
+	"github.com/forgo/saga/api/openapi"
+	"github.com/forgo/saga/api/pkg/dpop"
+	"github.com/forgo/saga/api/pkg/geocode"
 	"github.com/forgo/saga/api/pkg/jwt"
+	"github.com/forgo/saga/api/pkg/mail"
+	"github.com/forgo/saga/api/pkg/payments"
+	"github.com/forgo/saga/api/pkg/pwned"
+	"github.com/forgo/saga/api/pkg/redact"
+	"github.com/forgo/saga/api/pkg/s3"
 )
 
 func main() {
-	// Initialize structured logging
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
-	}))
+	// Initialize structured logging (level is adjustable at runtime by admins).
+	// Personal data is masked before it is written, including log.Printf output.
+	logLevel := new(slog.LevelVar)
+	logger := slog.New(redact.NewHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: logLevel,
+	})))
 	slog.SetDefault(logger)
 
 	// Load configuration
@@ -39,8 +58,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if level, err := cfg.Server.SlogLevel(); err == nil {
+		logLevel.Set(level)
+	}
+
 	// Initialize database connection
-	db := database.NewSurrealDB(database.Config{
+	conn, err := database.Open(database.Config{
+		Driver:    cfg.Database.Driver,
 		Host:      cfg.Database.Host,
 		Port:      cfg.Database.Port,
 		User:      cfg.Database.User,
@@ -48,19 +72,62 @@ func main() {
 		Namespace: cfg.Database.Namespace,
 		Database:  cfg.Database.Database,
 	})
+	if err != nil {
+		slog.Error("invalid database config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
 	ctx := context.Background()
-	if err := db.Connect(ctx); err != nil {
+	if err := conn.Connect(ctx); err != nil {
 		slog.Error("failed to connect to database", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
-	defer func() { _ = db.Close() }()
+	defer func() { _ = conn.Close() }()
+
+	// Fault injection for resilience tests (never enabled in production)
+	faults, err := cfg.Chaos.Injector()
+	if err != nil {
+		slog.Error("invalid fault injection config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if faults != nil {
+		slog.Warn("fault injection enabled",
+			slog.String("database", faults.Rule(chaos.Database).String()),
+			slog.String("push", faults.Rule(chaos.Push).String()),
+			slog.String("eventhub", faults.Rule(chaos.EventHub).String()),
+		)
+	}
+
+	// Instrument all queries for slow-query logging and per-method stats
+	queryStats := database.NewQueryStats(database.QueryStatsConfig{
+		SlowThreshold: cfg.Database.SlowQueryThreshold,
+	})
+	db := database.NewInstrumentedDB(chaos.WrapDatabase(conn, faults), queryStats)
+	expvar.Publish("query_stats", expvar.Func(func() any { return queryStats.Methods() }))
+	transactor := database.NewTransactor(db)
 
 	slog.Info("connected to database",
 		slog.String("host", cfg.Database.Host),
 		slog.String("database", cfg.Database.Database),
 	)
 
+	// Initialize region state (active/standby role and read-only mode)
+	regionState := database.NewRegionState(database.RegionConfig{
+		Name:          cfg.Region.Name,
+		Role:          database.RegionRole(cfg.Region.Role),
+		ReadOnly:      cfg.Region.ReadOnly,
+		MaxReplicaLag: cfg.Region.MaxReplicaLag,
+	})
+	slog.Info("region configured",
+		slog.String("region", cfg.Region.Name),
+		slog.String("role", cfg.Region.Role),
+		slog.Bool("read_only", regionState.ReadOnly()),
+	)
+
+	// Background jobs that write run only while the region accepts writes;
+	// they are collected here and started by the region gate below
+	var writeJobs []func() jobs.Job
+
 	// Initialize JWT service
 	jwtService, err := jwt.NewService(jwt.Config{
 		PrivateKeyPath: cfg.JWT.PrivateKeyPath,
@@ -73,6 +140,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Sensitive fields (precise locations) are encrypted before they are stored
+	fieldKeyring, err := cfg.Fields.Keyring()
+	if err != nil {
+		slog.Error("failed to load field encryption keys", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if fieldKeyring == nil {
+		slog.Warn("FIELD_ENCRYPTION_KEYS not set, precise locations will be stored unencrypted")
+	}
+
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
 	identityRepo := repository.NewIdentityRepository(db)
@@ -80,19 +157,31 @@ func main() {
 	tokenRepo := repository.NewTokenRepository(db)
 	guildRepo := repository.NewGuildRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
-	profileRepo := repository.NewProfileRepository(db)
+	profileRepo := repository.NewProfileRepository(db, fieldKeyring)
 	interestRepo := repository.NewInterestRepository(db)
 	questionnaireRepo := repository.NewQuestionnaireRepository(db)
+	contentRepo := repository.NewContentRepository(db)
+	guildAnswerRepo := repository.NewGuildAnswerRepository(db)
 	availabilityRepo := repository.NewAvailabilityRepository(db)
+	hangoutTypeRepo := repository.NewHangoutTypeRepository(db)
+	venueRepo := repository.NewVenueRepository(db)
+	spontaneousRepo := repository.NewSpontaneousRepository(db)
 	resonanceRepo := repository.NewResonanceRepository(db)
 	reviewRepo := repository.NewReviewRepository(db)
 	eventRepo := repository.NewEventRepository(db)
 	eventRoleRepo := repository.NewEventRoleRepository(db)
+	eventRoleAlertRepo := repository.NewEventRoleAlertRepository(db)
+	guildHealthRepo := repository.NewGuildHealthRepository(db)
+	winBackRepo := repository.NewWinBackRepository(db)
 	trustRepo := repository.NewTrustRepository(db)
 	trustRatingRepo := repository.NewTrustRatingRepository(db)
 	roleCatalogRepo := repository.NewRoleCatalogRepository(db)
 	rideshareRoleRepo := repository.NewRideshareRoleRepository(db)
+	rideshareRepo := repository.NewRideshareRepository(db)
+	rideRequestRepo := repository.NewRideRequestRepository(db)
+	expenseRepo := repository.NewExpenseRepository(db)
 	voteRepo := repository.NewVoteRepository(db)
+	nudgePreferenceRepo := repository.NewNudgePreferenceRepository(db)
 	adventureRepo := repository.NewAdventureRepository(db)
 	adventureAdmissionRepo := repository.NewAdventureAdmissionRepository(db)
 	// TODO: Implement Rideshare repository (renamed from Commute)
@@ -100,20 +189,127 @@ func main() {
 	poolRepo := repository.NewPoolRepository(db)
 	moderationRepo := repository.NewModerationRepository(db)
 	deviceTokenRepo := repository.NewDeviceTokenRepository(db)
+	eventReminderRepo := repository.NewEventReminderRepository(db)
+	activityRepo := repository.NewActivityRepository(db)
+	noShowRepo := repository.NewNoShowRepository(db)
+	analyticsRepo := repository.NewAnalyticsRepository(db)
+	shareLinkRepo := repository.NewShareLinkRepository(db)
+	guildInviteRepo := repository.NewGuildInviteRepository(db)
+	announcementRepo := repository.NewAnnouncementRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+	undoRepo := repository.NewUndoRepository(db)
+	analyticsEventRepo := repository.NewAnalyticsEventRepository(db)
+	consentRepo := repository.NewConsentRepository(db)
+	legalHoldRepo := repository.NewLegalHoldRepository(db)
+	quotaRepo := repository.NewQuotaRepository(db)
+	remoteConfigRepo := repository.NewRemoteConfigRepository(db)
+	experimentRepo := repository.NewExperimentRepository(db)
+	ipDenyRepo := repository.NewIPDenyRepository(db)
+	adminReportRepo := repository.NewAdminReportRepository(db)
+	userImportRepo := repository.NewUserImportRepository(db)
+	userInvitationRepo := repository.NewUserInvitationRepository(db)
+	guildImportRepo := repository.NewGuildImportRepository(db)
+	emailChangeRepo := repository.NewEmailChangeRepository(db)
+	securityEventRepo := repository.NewSecurityEventRepository(db)
+	signupRiskRepo := repository.NewSignupRiskRepository(db)
+	devicePairingRepo := repository.NewDevicePairingRepository(db)
+	idempotencyRepo := repository.NewIdempotencyRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	notificationOutboxRepo := repository.NewNotificationOutboxRepository(db)
+	paymentRepo := repository.NewPaymentRepository(db)
+	guildTierRepo := repository.NewGuildTierRepository(db)
+	guildPermissionRepo := repository.NewGuildPermissionRepository(db)
+	muteRepo := repository.NewMuteRepository(db)
+	reactionRepo := repository.NewReactionRepository(db)
+	searchRepo := repository.NewSearchRepository(db)
+	clientErrorRepo := repository.NewClientErrorRepository(db)
 
 	// Initialize services
+	// Proof of possession is rolled out per client platform
+	dpopPolicy := dpop.Policy{
+		string(model.PlatformIOS):     dpop.Mode(cfg.DPoP.IOSMode),
+		string(model.PlatformAndroid): dpop.Mode(cfg.DPoP.AndroidMode),
+		string(model.PlatformWeb):     dpop.Mode(cfg.DPoP.WebMode),
+	}
 	tokenService := service.NewTokenService(service.TokenServiceConfig{
 		JWTService: jwtService,
 		TokenRepo:  tokenRepo,
+		DPoPPolicy: dpopPolicy,
 	})
 
+	securityEventService := service.NewSecurityEventService(service.SecurityEventServiceConfig{
+		Repo: securityEventRepo,
+	})
+	clientErrorService := service.NewClientErrorService(service.ClientErrorServiceConfig{
+		Repo:               clientErrorRepo,
+		CrashSampleRate:    cfg.ClientErrors.CrashSampleRate,
+		MismatchSampleRate: cfg.ClientErrors.MismatchSampleRate,
+	})
+	signupRiskService := service.NewSignupRiskService(service.SignupRiskServiceConfig{
+		Repo:     signupRiskRepo,
+		UserRepo: userRepo,
+	})
+
+	// Password breach checks send only a hash prefix to HaveIBeenPwned
+	var breachChecker service.PasswordBreachChecker
+	if cfg.Password.BreachCheckEnabled {
+		breachChecker = pwned.NewClient(pwned.Config{BaseURL: cfg.Password.BreachCheckURL})
+	}
+	// Email changes are verified by mail, so they need SMTP
+	var mailer service.EmailSender
+	if cfg.Mail.SMTPHost != "" {
+		smtpSender, err := mail.NewSender(mail.Config{
+			Host:     cfg.Mail.SMTPHost,
+			Port:     cfg.Mail.SMTPPort,
+			Username: cfg.Mail.SMTPUsername,
+			Password: cfg.Mail.SMTPPassword,
+			From:     cfg.Mail.From,
+		})
+		if err != nil {
+			slog.Error("failed to initialize mail", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		mailer = smtpSender
+	} else {
+		slog.Warn("SMTP_HOST not set, email changes are unavailable")
+	}
 	authService := service.NewAuthService(service.AuthServiceConfig{
-		UserRepo:     userRepo,
-		IdentityRepo: identityRepo,
-		PasskeyRepo:  passkeyRepo,
-		TokenService: tokenService,
+		UserRepo:         userRepo,
+		IdentityRepo:     identityRepo,
+		PasskeyRepo:      passkeyRepo,
+		TokenService:     tokenService,
+		MinPasswordScore: cfg.Password.MinScore,
+		BreachChecker:    breachChecker,
+		EmailChangeRepo:  emailChangeRepo,
+		Mailer:           mailer,
+		PublicBaseURL:    cfg.Share.BaseURL,
+		InvitationRepo:   userInvitationRepo,
+		SecurityEvents:   securityEventService,
+		SignupRisk:       signupRiskService,
+	})
+
+	// QR login for shared devices (payloads are signed; an unset key outside
+	// production gets a random one, so codes on screen stop working on restart)
+	pairingSigningKey := []byte(cfg.Pairing.SigningKey)
+	if len(pairingSigningKey) == 0 {
+		pairingSigningKey = make([]byte, config.MinPairingSigningKeyLength)
+		if _, err := rand.Read(pairingSigningKey); err != nil {
+			slog.Error("failed to generate device pairing signing key", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		slog.Warn("DEVICE_PAIRING_SIGNING_KEY not set, QR logins will not survive a restart")
+	}
+	devicePairingService := service.NewDevicePairingService(service.DevicePairingServiceConfig{
+		Repo:           devicePairingRepo,
+		UserRepo:       userRepo,
+		TokenService:   tokenService,
+		SigningKey:     pairingSigningKey,
+		TTL:            cfg.Pairing.TTL,
+		SecurityEvents: securityEventService,
 	})
 
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewDevicePairingCleanup(devicePairingService, 1*time.Minute) })
+
 	oauthService := service.NewOAuthService(service.OAuthServiceConfig{
 		Config: service.OAuthConfig{
 			Google: service.GoogleOAuthConfig{
@@ -147,33 +343,150 @@ func main() {
 		PasskeyRepo:  passkeyRepo,
 		UserRepo:     userRepo,
 		TokenService: tokenService,
+		Recoverer:    authService,
+	})
+
+	// Activity ledger, written by services after timeline-worthy actions
+	activityService := service.NewActivityService(service.ActivityServiceConfig{
+		Repo: activityRepo,
+	})
+
+	// Domain events, queued in the outbox by the services that publish them and
+	// delivered to subscribers (registered below) by the dispatcher job
+	domainEventBus := service.NewDomainEventBus(service.DomainEventBusConfig{
+		Repo: outboxRepo,
+	})
+
+	// Undo window for deleting availability, leaving guilds and cancelling RSVPs
+	undoService := service.NewUndoService(service.UndoServiceConfig{
+		Repo:             undoRepo,
+		AvailabilityRepo: availabilityRepo,
+		GuildRepo:        guildRepo,
+		EventRepo:        eventRepo,
+	})
+
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewUndoCleanup(undoService, 1*time.Minute) })
+
+	// Guild trash for deleted events, pools and votes
+	trashService := service.NewTrashService(service.TrashServiceConfig{
+		Events:    eventRepo,
+		Pools:     poolRepo,
+		Votes:     voteRepo,
+		GuildRepo: guildRepo,
 	})
 
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewTrashPurge(trashService, 1*time.Hour) })
+
+	// Consent to analytics, marketing email and location processing
+	consentService := service.NewConsentService(service.ConsentServiceConfig{
+		Repo: consentRepo,
+	})
+
+	// Legal holds - preserve a user's data and log access to it
+	legalHoldService := service.NewLegalHoldService(service.LegalHoldServiceConfig{
+		Repo:     legalHoldRepo,
+		UserRepo: userRepo,
+	})
+
+	// Anonymized analytics events - recorded and exported only when enabled,
+	// and only for users who consented
+	analyticsEventService := service.NewAnalyticsEventService(service.AnalyticsEventServiceConfig{
+		Repo:    analyticsEventRepo,
+		Consent: consentService,
+		Enabled: cfg.Analytics.Enabled,
+		HashKey: []byte(cfg.Analytics.HashKey),
+	})
+
+	if cfg.Analytics.Enabled && cfg.Analytics.ExportDir != "" {
+		writeJobs = append(writeJobs, func() jobs.Job {
+			return jobs.NewAnalyticsExport(analyticsEventService, cfg.Analytics.ExportDir, 1*time.Hour, clock.Real)
+		})
+	}
+
 	guildService := service.NewGuildService(service.GuildServiceConfig{
 		GuildRepo:  guildRepo,
 		MemberRepo: memberRepo,
 		UserRepo:   userRepo,
+		SlugRepo:   guildRepo,
+		Activity:   activityService,
+		Undo:       undoService,
+		Events:     domainEventBus,
+		Tx:         transactor,
 	})
-
-	profileService := service.NewProfileService(service.ProfileServiceConfig{
-		ProfileRepo: profileRepo,
-		UserRepo:    userRepo,
-	})
+	guildTierService := service.NewGuildTierService(service.GuildTierServiceConfig{Repo: guildTierRepo, Guilds: guildRepo})
+	permissionService := service.NewPermissionService(guildPermissionRepo, guildRepo)
+	muteService := service.NewMuteService(service.MuteServiceConfig{Repo: muteRepo, Guilds: guildRepo})
+	presenceService := service.NewPresenceService(service.PresenceServiceConfig{Repo: profileRepo})
 
 	interestService := service.NewInterestService(service.InterestServiceConfig{
 		InterestRepo: interestRepo,
 	})
 
+	contentService := service.NewContentService(service.ContentServiceConfig{
+		Repo:      contentRepo,
+		Questions: questionnaireRepo,
+	})
+
+	remoteConfigService := service.NewRemoteConfigService(service.RemoteConfigServiceConfig{
+		Repo: remoteConfigRepo,
+	})
+
+	experimentService := service.NewExperimentService(service.ExperimentServiceConfig{
+		Repo:      experimentRepo,
+		Analytics: analyticsEventService,
+	})
+
 	questionnaireService := service.NewQuestionnaireService(service.QuestionnaireServiceConfig{
-		Repo: questionnaireRepo,
+		Repo:    questionnaireRepo,
+		Catalog: contentService,
+	})
+
+	guildQuestionService := service.NewGuildQuestionService(service.GuildQuestionServiceConfig{
+		Questions: questionnaireRepo,
+		Answers:   guildAnswerRepo,
+		GuildRepo: guildRepo,
 	})
 
 	compatibilityService := service.NewCompatibilityService(service.CompatibilityServiceConfig{
 		QuestionnaireRepo: questionnaireRepo,
 	})
 
+	commitmentService := service.NewCommitmentService(service.CommitmentServiceConfig{
+		Events:     eventRepo,
+		Hangouts:   availabilityRepo,
+		Rideshares: rideshareRepo,
+	})
+
+	hangoutTypeService := service.NewHangoutTypeService(hangoutTypeRepo, guildRepo)
+
+	// New venue addresses are geocoded when a provider is configured
+	var geocoder service.Geocoder
+	if cfg.Geocoding.Provider == "nominatim" {
+		geocoder = geocode.NewClient(geocode.Config{BaseURL: cfg.Geocoding.URL, UserAgent: cfg.Geocoding.UserAgent})
+	}
+	venueService := service.NewVenueService(service.VenueServiceConfig{
+		Repo:     venueRepo,
+		Guilds:   guildRepo,
+		Geocoder: geocoder,
+	})
+	rideshareService := service.NewRideshareService(service.RideshareServiceConfig{
+		Rideshares:   rideshareRepo,
+		RideRequests: rideRequestRepo,
+		Events:       eventRepo,
+	})
+	eventExpenseService := service.NewEventExpenseService(service.EventExpenseServiceConfig{
+		Expenses: expenseRepo,
+		Events:   eventRepo,
+	})
+
 	availabilityService := service.NewAvailabilityService(service.AvailabilityServiceConfig{
-		Repo: availabilityRepo,
+		Repo:         availabilityRepo,
+		Undo:         undoService,
+		Analytics:    analyticsEventService,
+		Guilds:       guildRepo,
+		Events:       eventRepo,
+		Conflicts:    commitmentService,
+		HangoutTypes: hangoutTypeService,
 	})
 
 	resonanceService := service.NewResonanceService(service.ResonanceServiceConfig{
@@ -181,10 +494,28 @@ func main() {
 	})
 
 	reviewService := service.NewReviewService(service.ReviewServiceConfig{
-		Repo: reviewRepo,
+		Repo:     reviewRepo,
+		Catalog:  contentService,
+		Activity: activityService,
+	})
+
+	profileService := service.NewProfileService(service.ProfileServiceConfig{
+		ProfileRepo:    profileRepo,
+		UserRepo:       userRepo,
+		ModerationRepo: moderationRepo,
+		GuildRepo:      guildRepo,
+		Reputation:     reviewService,
+		Interests:      interestRepo,
+		Questionnaire:  questionnaireRepo,
+		Availability:   availabilityRepo,
+		Consent:        consentService,
+	})
+
+	handleService := service.NewHandleService(service.HandleServiceConfig{
+		Repo: userRepo,
 	})
 
-	trustService := service.NewTrustService(trustRepo)
+	trustService := service.NewTrustService(trustRepo, activityService, domainEventBus)
 
 	trustRatingService := service.NewTrustRatingService(service.TrustRatingServiceConfig{
 		Repo: trustRatingRepo,
@@ -197,19 +528,27 @@ func main() {
 	})
 
 	voteService := service.NewVoteService(service.VoteServiceConfig{
-		VoteRepo:  voteRepo,
-		GuildRepo: guildRepo,
+		VoteRepo:    voteRepo,
+		GuildRepo:   guildRepo,
+		Permissions: permissionService,
 	})
 
 	adventureService := service.NewAdventureService(service.AdventureServiceConfig{
 		AdventureRepo: adventureRepo,
 		AdmissionRepo: adventureAdmissionRepo,
 		GuildRepo:     guildRepo,
+		Tx:            transactor,
 	})
 
-	eventRoleService := service.NewEventRoleService(eventRoleRepo, interestService)
+	noShowService := service.NewNoShowService(service.NoShowServiceConfig{
+		Repo:      noShowRepo,
+		EventRepo: eventRepo,
+		GuildRepo: guildRepo,
+	})
 
-	eventService := service.NewEventService(eventRepo, compatibilityService, questionnaireService, eventRoleService)
+	analyticsService := service.NewAnalyticsService(service.AnalyticsServiceConfig{
+		Repo: analyticsRepo,
+	})
 
 	// TODO: Implement Rideshare service (renamed from Commute)
 	// commuteService := service.NewCommuteService(commuteRepo, trustService)
@@ -219,6 +558,9 @@ func main() {
 		GuildRepo:     guildRepo,
 		MemberRepo:    memberRepo,
 		Compatibility: compatibilityService,
+		Activity:      activityService,
+		Analytics:     analyticsEventService,
+		Tiers:         guildTierService,
 	})
 
 	discoveryService := service.NewDiscoveryService(service.DiscoveryServiceConfig{
@@ -226,10 +568,19 @@ func main() {
 		CompatibilityRepo: questionnaireRepo,
 		InterestRepo:      interestRepo,
 		ProfileRepo:       profileRepo,
+		Completeness:      profileService,
+		HangoutTypes:      hangoutTypeService,
+		LanguageRepo:      profileRepo,
+		Consent:           consentService,
+	})
+
+	searchService := service.NewSearchService(service.SearchServiceConfig{
+		Engine:       searchRepo,
+		BlockChecker: moderationRepo,
 	})
 
 	// Initialize seeder service for admin tools
-	seederService := service.NewSeederService(db)
+	seederService := service.NewSeederService(db, nil)
 
 	// Initialize admin actions service (will be connected to eventHub after it's created)
 	var adminActionsService *service.AdminActionsService
@@ -242,15 +593,131 @@ func main() {
 	})
 	defer rateLimiter.Stop()
 
-	// Initialize idempotency store
-	idempotencyStore := middleware.NewIdempotencyStore(middleware.IdempotencyConfig{
-		TTL:     24 * time.Hour,
-		Cleanup: time.Hour,
+	// Public embed endpoints get a much smaller budget per client address
+	embedRateLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
+		Rate:   30, // 30 requests per minute
+		Window: time.Minute,
+		Burst:  10,
+	})
+	defer embedRateLimiter.Stop()
+
+	// Reactions get their own per-user budget so tapping through emoji can't
+	// flood guild streams
+	reactionRateLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
+		Rate:   30, // 30 reactions per minute
+		Window: time.Minute,
+		Burst:  10,
 	})
-	defer idempotencyStore.Stop()
+	defer reactionRateLimiter.Stop()
+
+	// Going available right now alerts people nearby, so toggling it gets a
+	// small per-user budget on top of the service's daily session limit
+	spontaneousRateLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
+		Rate:   5, // 5 toggles per minute
+		Window: time.Minute,
+		Burst:  3,
+	})
+	defer spontaneousRateLimiter.Stop()
+
+	// Client error reports arrive signed in or not, so they're limited per
+	// client address
+	clientErrorRateLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
+		Rate:   cfg.ClientErrors.RateLimit,
+		Window: time.Minute,
+		Burst:  5,
+	})
+	defer clientErrorRateLimiter.Stop()
+	// Clients caught scraping are slowed down, then blocked, by address
+	tarpit := middleware.NewTarpit(middleware.TarpitConfig{
+		DecoyPaths:       cfg.Scrapers.DecoyPaths,
+		EnumerationLimit: cfg.Scrapers.EnumerationLimit,
+		DiscoveryLimit:   cfg.Scrapers.DiscoveryLimit,
+		Window:           cfg.Scrapers.Window,
+		BlockAfter:       cfg.Scrapers.BlockAfter,
+		BlockFor:         cfg.Scrapers.BlockFor,
+		Recorder:         securityEventService,
+	})
+	defer tarpit.Stop()
+	// Admin routes only accept allowlisted addresses; denied addresses are
+	// turned away from every route
+	networkPolicy, err := middleware.NewNetworkPolicy(middleware.NetworkPolicyConfig{
+		AdminAllowlist: cfg.Network.AdminAllowlist,
+		Recorder:       securityEventService,
+	})
+	if err != nil {
+		slog.Error("invalid network policy", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	networkPolicyService := service.NewNetworkPolicyService(service.NetworkPolicyServiceConfig{
+		Repo:     ipDenyRepo,
+		Enforcer: networkPolicy,
+	})
+	if err := networkPolicyService.LoadDenyList(ctx); err != nil {
+		slog.Warn("failed to load IP deny list", slog.String("error", err.Error()))
+	}
+	publicEmbed := func(h http.HandlerFunc) http.Handler {
+		return middleware.Chain(h, middleware.OpenCORS, middleware.RateLimitByIP(embedRateLimiter))
+	}
+
+	// Daily request quotas - weighted by request cost, adjustable per user
+	quotaLimiter := middleware.NewQuotaLimiter(middleware.QuotaConfig{
+		DailyLimit: cfg.Quota.DailyLimit,
+	})
+	quotaService := service.NewQuotaService(service.QuotaServiceConfig{
+		Repo:     quotaRepo,
+		Tracker:  quotaLimiter,
+		UserRepo: userRepo,
+	})
+	if err := quotaService.LoadOverrides(ctx); err != nil {
+		slog.Warn("failed to load quota overrides", slog.String("error", err.Error()))
+	}
+
+	// Select the idempotency store (the database by default, so retries are
+	// deduplicated across restarts and instances)
+	var idempotencyStore middleware.IdempotencyStore
+	if cfg.Idempotency.Store == "memory" {
+		memoryIdempotencyStore := middleware.NewIdempotencyStore(middleware.IdempotencyConfig{
+			TTL:     cfg.Idempotency.TTL,
+			Cleanup: time.Hour,
+		})
+		defer memoryIdempotencyStore.Stop()
+		idempotencyStore = memoryIdempotencyStore
+	} else {
+		idempotencyService := service.NewIdempotencyService(service.IdempotencyServiceConfig{
+			Repo: idempotencyRepo,
+			TTL:  cfg.Idempotency.TTL,
+		})
+		writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewIdempotencyCleanup(idempotencyService, 1*time.Hour) })
+		idempotencyStore = idempotencyService
+	}
 
 	// Initialize event hub for real-time updates
-	eventHub := service.NewEventHub()
+	// Select the event broker (in-process by default, Redis to fan out across instances)
+	var eventBroker service.EventBroker
+	if cfg.EventHub.Broker == "redis" {
+		redisBroker, err := broker.NewRedisBroker(ctx, broker.RedisConfig{
+			URL:     cfg.EventHub.RedisURL,
+			Channel: cfg.EventHub.Channel,
+		})
+		if err != nil {
+			slog.Error("failed to connect event broker", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		eventBroker = redisBroker
+		slog.Info("event broker connected", slog.String("broker", "redis"), slog.String("channel", cfg.EventHub.Channel))
+	}
+
+	notificationFeed := service.NewNotificationFeed(service.NotificationFeedConfig{
+		PollTimeout:  cfg.EventHub.PollTimeout,
+		PollsPerUser: cfg.EventHub.PollsPerUser,
+	})
+	eventHub := service.NewEventHub(service.EventHubConfig{
+		BufferSize:    cfg.EventHub.BufferSize,
+		Policy:        service.BufferPolicy(cfg.EventHub.BufferPolicy),
+		Broker:        eventBroker,
+		Faults:        faults,
+		Notifications: notificationFeed,
+	})
 	defer eventHub.Close()
 
 	// Initialize admin actions service (now that eventHub exists)
@@ -260,20 +727,60 @@ func main() {
 	moderationService := service.NewModerationService(moderationRepo, eventHub)
 
 	// Initialize admin users service
-	adminUsersService := service.NewAdminUsersService(db, userRepo, profileRepo, moderationService)
+	adminUsersService := service.NewAdminUsersService(db, userRepo, profileRepo, moderationService, legalHoldService, signupRiskService)
+
+	// Background admin reports - generated into object storage when a bucket
+	// is configured
+	var reportStore service.ObjectStore
+	if cfg.Reports.Bucket != "" {
+		s3Client, err := s3.NewClient(s3.Config{
+			Bucket:          cfg.Reports.Bucket,
+			Region:          cfg.Reports.Region,
+			Endpoint:        cfg.Reports.Endpoint,
+			AccessKeyID:     cfg.Reports.AccessKeyID,
+			SecretAccessKey: cfg.Reports.SecretAccessKey,
+			PathStyle:       cfg.Reports.PathStyle,
+		})
+		if err != nil {
+			slog.Error("failed to initialize report storage", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		reportStore = s3Client
+	}
+	adminReportService := service.NewAdminReportService(service.AdminReportServiceConfig{
+		Repo:       adminReportRepo,
+		Store:      reportStore,
+		Users:      adminUsersService,
+		Moderation: moderationRepo,
+		Retention:  cfg.Reports.Retention,
+		URLTTL:     cfg.Reports.URLTTL,
+	})
+	if adminReportService.Enabled() {
+		writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewAdminReports(adminReportService, 15*time.Second) })
+	}
+
+	// CSV member imports (invitations need SMTP)
+	userImportService := service.NewUserImportService(service.UserImportServiceConfig{
+		Repo:           userImportRepo,
+		UserRepo:       userRepo,
+		Guilds:         guildService,
+		InvitationRepo: userInvitationRepo,
+		Mailer:         mailer,
+		PublicBaseURL:  cfg.Share.BaseURL,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewUserImports(userImportService, 15*time.Second) })
 
 	// Initialize admin discovery service
 	adminDiscoveryService := service.NewAdminDiscoveryService(db, discoveryService, compatibilityService)
 
-	poolMatcher := jobs.NewPoolMatcher(poolService, 1*time.Hour)
-	poolMatcher.Start()
-	defer poolMatcher.Stop()
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewPoolMatcher(poolService, 1*time.Hour) })
 
 	// Initialize push notification service
 	pushService, err := service.NewPushService(service.PushServiceConfig{
 		DeviceRepo:         deviceTokenRepo,
 		Enabled:            cfg.Push.Enabled,
 		FCMCredentialsPath: cfg.Push.FCMCredentialsPath,
+		Faults:             faults,
 	})
 	if err != nil {
 		slog.Error("Failed to initialize push service", "error", err)
@@ -281,44 +788,291 @@ func main() {
 		pushService = nil
 	}
 
+	// Notifications are queued in an outbox and sent by its processor, with
+	// retries, so they survive a crash right after the write that caused them
+	notificationOutbox := service.NewNotificationOutbox(service.NotificationOutboxConfig{
+		Repo:        notificationOutboxRepo,
+		PushService: pushService,
+		EventHub:    eventHub,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewOutboxProcessor(notificationOutbox, 10*time.Second) })
+
+	// Initialize event role and event services (role nominations notify via push/SSE)
+	eventRoleService := service.NewEventRoleService(service.EventRoleServiceConfig{
+		Repo:            eventRoleRepo,
+		InterestService: interestService,
+		EventRepo:       eventRepo,
+		EventHub:        eventHub,
+		PushService:     pushService,
+		Outbox:          notificationOutbox,
+	})
+
+	// Paid event tickets - through Stripe when a secret key is configured
+	var paymentProvider service.PaymentProvider
+	if cfg.Payments.SecretKey != "" {
+		stripeClient, err := payments.NewClient(payments.Config{
+			SecretKey:     cfg.Payments.SecretKey,
+			WebhookSecret: cfg.Payments.WebhookSecret,
+			Sandbox:       cfg.Payments.Sandbox,
+		})
+		if err != nil {
+			slog.Error("failed to initialize payments", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		paymentProvider = stripeClient
+	}
+	paymentService := service.NewPaymentService(service.PaymentServiceConfig{
+		Provider:  paymentProvider,
+		Payments:  paymentRepo,
+		Events:    eventRepo,
+		Guilds:    guildRepo,
+		ReturnURL: cfg.Payments.ReturnURL,
+	})
+
+	reactionService := service.NewReactionService(service.ReactionServiceConfig{
+		Repo:             reactionRepo,
+		EventRepo:        eventRepo,
+		AnnouncementRepo: announcementRepo,
+		EventHub:         eventHub,
+	})
+
+	spontaneousService := service.NewSpontaneousService(service.SpontaneousServiceConfig{
+		AvailabilityRepo: availabilityRepo,
+		Repo:             spontaneousRepo,
+		ProfileRepo:      profileRepo,
+		InterestRepo:     interestRepo,
+		BlockChecker:     moderationRepo,
+		EventHub:         eventHub,
+		PushService:      pushService,
+		Outbox:           notificationOutbox,
+		HangoutTypes:     hangoutTypeService,
+		Consent:          consentService,
+	})
+
+	eventService := service.NewEventService(eventRepo, compatibilityService, questionnaireService, eventRoleService, noShowService, analyticsService, activityService, undoService, commitmentService, domainEventBus, guildRepo, paymentService, guildTierService, reactionService, profileRepo, venueService, rideshareService, permissionService)
+
+	// Guild event embeds for organizers' own websites
+	guildEmbedService := service.NewGuildEmbedService(service.GuildEmbedServiceConfig{
+		Guilds:    guildService,
+		GuildRepo: guildRepo,
+		Events:    eventService,
+	})
+
+	// Meetup and Discord imports (members go through the CSV import job)
+	guildImportService := service.NewGuildImportService(service.GuildImportServiceConfig{
+		Repo:     guildImportRepo,
+		UserRepo: userRepo,
+		Guilds:   guildService,
+		Events:   eventService,
+		Members:  userImportService,
+	})
+
+	// Subscribe to domain events. Subscriber names are stored with queued
+	// events, so renaming one strands what is already queued for it.
+	domainEventNotifier := service.NewDomainEventNotifier(service.DomainEventNotifierConfig{
+		EventHub:    eventHub,
+		PushService: pushService,
+	})
+	service.SubscribeDomainEvent(domainEventBus, "notifications", domainEventNotifier.HandleMemberJoined)
+	service.SubscribeDomainEvent(domainEventBus, "notifications", domainEventNotifier.HandleTrustGranted)
+	service.SubscribeDomainEvent(domainEventBus, "resonance", resonanceService.HandleEventCompleted)
+	if analyticsEventService.Enabled() {
+		service.SubscribeDomainEvent(domainEventBus, "analytics", analyticsEventService.HandleMemberJoined)
+		service.SubscribeDomainEvent(domainEventBus, "analytics", analyticsEventService.HandleEventCompleted)
+	}
+
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewDomainEventDispatcher(domainEventBus, 10*time.Second) })
+
+	// Initialize share link service (tokens are signed; an unset key outside
+	// production gets a random one, so links stop resolving on restart)
+	shareSigningKey := []byte(cfg.Share.SigningKey)
+	if len(shareSigningKey) == 0 {
+		shareSigningKey = make([]byte, config.MinShareSigningKeyLength)
+		if _, err := rand.Read(shareSigningKey); err != nil {
+			slog.Error("failed to generate share link signing key", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		slog.Warn("SHARE_LINK_SIGNING_KEY not set, share links will not survive a restart")
+	}
+	shareLinkService := service.NewShareLinkService(service.ShareLinkServiceConfig{
+		Repo:             shareLinkRepo,
+		Events:           eventRepo,
+		Guilds:           guildRepo,
+		Adventures:       adventureRepo,
+		GuildInviter:     guildService,
+		AdventureInviter: adventureService,
+		SigningKey:       shareSigningKey,
+		BaseURL:          cfg.Share.BaseURL,
+	})
+	guildInviteService := service.NewGuildInviteService(service.GuildInviteServiceConfig{
+		Repo:        guildInviteRepo,
+		Guilds:      guildRepo,
+		Users:       userRepo,
+		Joiner:      guildService,
+		Permissions: permissionService,
+		BaseURL:     cfg.Share.BaseURL,
+	})
+
 	// Initialize nudge service and processor
+	// Marketing email only reaches users who opted in to it
+	var marketingEmailService *service.MarketingEmailService
+	if mailer != nil {
+		marketingEmailService = service.NewMarketingEmailService(service.MarketingEmailServiceConfig{
+			Mailer:   mailer,
+			UserRepo: userRepo,
+			Consent:  consentService,
+		})
+	}
 	nudgeService := service.NewNudgeService(service.NudgeServiceConfig{
 		AvailabilityRepo: availabilityRepo,
 		PoolRepo:         poolRepo,
 		EventHub:         eventHub,
 		PushService:      pushService,
+		Outbox:           notificationOutbox,
+		ProfileNudgeRepo: profileRepo,
+		Completeness:     profileService,
+		PreferenceRepo:   nudgePreferenceRepo,
+		WinBackRepo:      winBackRepo,
+		WinBackProfiles:  profileRepo,
+		Compatibility:    compatibilityService,
+		Consent:          consentService,
+		MarketingEmail:   marketingEmailService,
+		HoldoutPercent:   cfg.WinBack.HoldoutPercent,
 	})
-	nudgeProcessor := jobs.NewNudgeProcessor(nudgeService, 15*time.Minute)
-	nudgeProcessor.Start()
-	defer nudgeProcessor.Stop()
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewNudgeProcessor(nudgeService, 15*time.Minute) })
+
+	// Win-back nudges for users drifting toward inactivity, with a holdout group
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewChurnRiskDetector(nudgeService, cfg.WinBack.Interval) })
 
 	// Initialize Nexus monthly job (calculates on 1st of each month)
-	nexusMonthlyJob := jobs.NewNexusMonthlyJob(resonanceService, resonanceService)
-	nexusMonthlyJob.Start()
-	defer nexusMonthlyJob.Stop()
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewNexusMonthlyJob(resonanceService, resonanceService, clock.Real) })
 
 	// Initialize Vote status processor (checks every minute)
-	voteStatusProcessor := jobs.NewVoteStatusProcessor(voteService, 1*time.Minute)
-	voteStatusProcessor.Start()
-	defer voteStatusProcessor.Stop()
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewVoteStatusProcessor(voteService, 1*time.Minute) })
+
+	// Initialize event reminder service and processor
+	eventReminderService := service.NewEventReminderService(service.EventReminderServiceConfig{
+		ReminderRepo: eventReminderRepo,
+		ProfileRepo:  profileRepo,
+		EventHub:     eventHub,
+		PushService:  pushService,
+		Outbox:       notificationOutbox,
+		Offsets:      cfg.Reminder.Offsets,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewEventReminderProcessor(eventReminderService, cfg.Reminder.Interval) })
+
+	// Initialize vote reminder service and processor (checks every 15 minutes)
+	voteReminderService := service.NewVoteReminderService(service.VoteReminderServiceConfig{
+		VoteService:    voteService,
+		ReminderRepo:   voteRepo,
+		PreferenceRepo: nudgePreferenceRepo,
+		EventHub:       eventHub,
+		PushService:    pushService,
+		Outbox:         notificationOutbox,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewVoteReminderProcessor(voteReminderService, 15*time.Minute) })
+
+	// Initialize event role alert service and processor (checks every 15 minutes)
+	eventRoleAlertService := service.NewEventRoleAlertService(service.EventRoleAlertServiceConfig{
+		AlertRepo:   eventRoleAlertRepo,
+		HostRepo:    eventRepo,
+		EventHub:    eventHub,
+		PushService: pushService,
+		Outbox:      notificationOutbox,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewEventRoleAlertProcessor(eventRoleAlertService, 15*time.Minute) })
+
+	// Initialize guild health service and scorer (rescores guilds every 6 hours)
+	guildHealthService := service.NewGuildHealthService(service.GuildHealthServiceConfig{
+		Repo:        guildHealthRepo,
+		EventHub:    eventHub,
+		PushService: pushService,
+		Outbox:      notificationOutbox,
+		Clock:       clock.Real,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewGuildHealthScorer(guildHealthService, 6*time.Hour) })
+
+	// Initialize announcement service and processor (delivers scheduled announcements every minute)
+	announcementService := service.NewAnnouncementService(service.AnnouncementServiceConfig{
+		Repo:        announcementRepo,
+		GuildRepo:   guildRepo,
+		EventHub:    eventHub,
+		PushService: pushService,
+		Outbox:      notificationOutbox,
+		Mutes:       muteService,
+		Reactions:   reactionService,
+		Permissions: permissionService,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewAnnouncementProcessor(announcementService, 1*time.Minute) })
+
+	// Initialize publication publisher (publishes scheduled events and votes every minute)
+	publicationService := service.NewPublicationService(service.PublicationServiceConfig{
+		EventRepo:   eventRepo,
+		VoteRepo:    voteRepo,
+		GuildRepo:   guildRepo,
+		EventHub:    eventHub,
+		PushService: pushService,
+		Outbox:      notificationOutbox,
+		Mutes:       muteService,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewPublicationPublisher(publicationService, 1*time.Minute) })
+
+	// Initialize draft cleanup (removes expired drafts hourly)
+	draftService := service.NewDraftService(service.DraftServiceConfig{
+		Repo:             draftRepo,
+		EventCreator:     eventService,
+		AdventureCreator: adventureService,
+	})
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewDraftCleanup(draftService, 1*time.Hour) })
+
+	// Initialize no-show detector (checks ended events hourly)
+	writeJobs = append(writeJobs, func() jobs.Job { return jobs.NewNoShowDetector(noShowService, 1*time.Hour) })
+
+	// Initialize region heartbeat (publishes or measures replica lag). It runs
+	// in either role, unlike the write jobs.
+	regionHeartbeat := jobs.NewRegionHeartbeat(regionState, db, cfg.Region.HeartbeatInterval)
+	regionHeartbeat.Start()
+	defer regionHeartbeat.Stop()
+
+	// Start the write jobs while the region is active and not read-only, and
+	// stop them whenever it is demoted or made read-only
+	regionGate := jobs.NewRegionGate(regionState, writeJobs...)
+	regionGate.Start()
+	defer regionGate.Stop()
 
 	// Initialize handlers
+	healthHandler := handler.NewHealthHandler(regionState)
+	metricsHandler := handler.NewMetricsHandler(cfg.Server.MetricsToken, queryStats, eventHub)
 	authHandler := handler.NewAuthHandler(authService)
 	oauthHandler := handler.NewOAuthHandler(oauthService)
 	passkeyHandler := handler.NewPasskeyHandler(passkeyService)
+	devicePairingHandler := handler.NewDevicePairingHandler(devicePairingService)
 	guildHandler := handler.NewGuildHandler(guildService)
+	guildTierHandler := handler.NewGuildTierHandler(guildTierService)
+	guildPermissionHandler := handler.NewGuildPermissionHandler(permissionService)
+	guildHealthHandler := handler.NewGuildHealthHandler(guildHealthService)
 	// TODO: Implement Person, Activity, Timer handlers
 	// personHandler := handler.NewPersonHandler(guildService, eventHub)
 	// activityHandler := handler.NewActivityHandler(guildService, eventHub)
 	// timerHandler := handler.NewTimerHandler(guildService, eventHub)
 	eventsHandler := handler.NewEventsHandler(eventHub)
-	profileHandler := handler.NewProfileHandler(profileService)
+	eventSocketHandler := handler.NewEventSocketHandler(eventHub, guildService, cfg.Server.AllowedOrigins)
+	notificationHandler := handler.NewNotificationHandler(notificationFeed)
+	profileHandler := handler.NewProfileHandler(profileService, handleService)
 	interestHandler := handler.NewInterestHandler(interestService)
 	questionnaireHandler := handler.NewQuestionnaireHandler(questionnaireService, compatibilityService)
+	contentHandler := handler.NewContentHandler(contentService)
+	guildQuestionHandler := handler.NewGuildQuestionHandler(guildQuestionService)
 	availabilityHandler := handler.NewAvailabilityHandler(availabilityService, profileService)
+	hangoutTypeHandler := handler.NewHangoutTypeHandler(hangoutTypeService)
+	venueHandler := handler.NewVenueHandler(venueService)
+	carpoolHandler := handler.NewCarpoolHandler(rideshareService)
+	eventExpenseHandler := handler.NewEventExpenseHandler(eventExpenseService)
 	resonanceHandler := handler.NewResonanceHandler(resonanceService)
 	reviewHandler := handler.NewReviewHandler(reviewService)
 	eventHandler := handler.NewEventHandler(eventService)
+	paymentHandler := handler.NewPaymentHandler(paymentService)
+	noShowHandler := handler.NewNoShowHandler(noShowService)
 	eventRoleHandler := handler.NewEventRoleHandler(eventRoleService)
 	trustHandler := handler.NewTrustHandler(trustService)
 	trustRatingHandler := handler.NewTrustRatingHandler(trustRatingService)
@@ -329,300 +1083,712 @@ func main() {
 	// commuteHandler := handler.NewCommuteHandler(commuteService)
 	poolHandler := handler.NewPoolHandler(poolService, guildService)
 	discoveryHandler := handler.NewDiscoveryHandler(discoveryService)
+	searchHandler := handler.NewSearchHandler(searchService)
 	moderationHandler := handler.NewModerationHandler(moderationService, userRepo)
 	deviceHandler := handler.NewDeviceHandler(deviceTokenRepo)
+	eventReminderHandler := handler.NewEventReminderHandler(eventReminderService)
+	muteHandler := handler.NewMuteHandler(muteService)
+	reactionHandler := handler.NewReactionHandler(reactionService)
+	presenceHandler := handler.NewPresenceHandler(presenceService)
+	spontaneousHandler := handler.NewSpontaneousHandler(spontaneousService)
+	activityHandler := handler.NewActivityHandler(activityService)
+	shareLinkHandler := handler.NewShareLinkHandler(shareLinkService)
+	guildInviteHandler := handler.NewGuildInviteHandler(guildInviteService)
+	announcementHandler := handler.NewAnnouncementHandler(announcementService)
+	draftHandler := handler.NewDraftHandler(draftService)
+	undoHandler := handler.NewUndoHandler(undoService)
+	trashHandler := handler.NewTrashHandler(trashService)
+	consentHandler := handler.NewConsentHandler(consentService)
 	adminSeederHandler := handler.NewAdminSeederHandler(seederService)
 	adminActionsHandler := handler.NewAdminActionsHandler(adminActionsService)
 	adminUsersHandler := handler.NewAdminUsersHandler(adminUsersService)
+	adminLegalHoldHandler := handler.NewAdminLegalHoldHandler(legalHoldService)
+	adminSecurityEventHandler := handler.NewAdminSecurityEventHandler(securityEventService)
+	adminScraperHandler := handler.NewAdminScraperHandler(securityEventService, tarpit)
+	adminNetworkHandler := handler.NewAdminNetworkHandler(networkPolicyService, securityEventService)
+	clientErrorHandler := handler.NewClientErrorHandler(clientErrorService)
+	clientConfig := cfg.ClientApps.ClientConfig()
+	metaHandler := handler.NewMetaHandler(clientConfig, remoteConfigService, experimentService)
+	adminRemoteConfigHandler := handler.NewAdminRemoteConfigHandler(remoteConfigService)
+	adminExperimentHandler := handler.NewAdminExperimentHandler(experimentService)
+	adminSignupRiskHandler := handler.NewAdminSignupRiskHandler(signupRiskService)
+	adminQuotaHandler := handler.NewAdminQuotaHandler(quotaService)
+	adminReportHandler := handler.NewAdminReportHandler(adminReportService)
+	adminUserImportHandler := handler.NewAdminUserImportHandler(userImportService)
+	adminGuildImportHandler := handler.NewAdminGuildImportHandler(guildImportService)
+	guildEmbedHandler := handler.NewGuildEmbedHandler(guildEmbedService, cfg.Server.PublicURL)
 	adminDiscoveryHandler := handler.NewAdminDiscoveryHandler(adminDiscoveryService)
+	adminRegionHandler := handler.NewAdminRegionHandler(regionState)
+	adminContentHandler := handler.NewAdminContentHandler(contentService)
+	adminQueryStatsHandler := handler.NewAdminQueryStatsHandler(queryStats)
+	adminAnalyticsHandler := handler.NewAdminAnalyticsHandler(analyticsEventService)
+	adminWinBackHandler := handler.NewAdminWinBackHandler(nudgeService)
+	adminDiagnosticsHandler := handler.NewAdminDiagnosticsHandler(logLevel, cfg.Server.DiagnosticsDir)
 
 	// Create router and register routes
 	mux := http.NewServeMux()
 
 	// Health check endpoint
-	mux.HandleFunc("GET /health", handler.Health)
+	mux.HandleFunc("GET /health", healthHandler.Health)
+
+	// Prometheus metrics endpoint (optionally protected by METRICS_TOKEN)
+	mux.HandleFunc("GET /metrics", metricsHandler.Metrics)
+
+	// Request bodies and query parameters are checked against the OpenAPI
+	// spec before handlers run
+	requestValidator, err := schema.Load(openapi.FS, openapi.Root)
+	if err != nil {
+		slog.Error("failed to load OpenAPI spec", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	// API versions serve their routes under their prefix, each with its own
+	// middleware after the global stack. /v2 gets a group when its first
+	// route ships.
+	v1DeprecatedOn, v1SunsetOn, _ := cfg.API.V1Deprecation() // Checked by Validate
+	// Apps older than their platform's minimum version are told to upgrade,
+	// except when reading the client config or reporting errors
+	v1 := router.NewGroup(mux, "/v1",
+		middleware.Deprecation(middleware.DeprecationConfig{
+			DeprecatedOn: v1DeprecatedOn,
+			SunsetOn:     v1SunsetOn,
+			Link:         cfg.API.V1MigrationURL,
+		}),
+		middleware.RequireAppVersion(clientConfig.Platforms, "/v1/meta/", "/v1/client-errors"),
+		middleware.ValidateRequest(middleware.RequestValidationConfig{
+			Validator: requestValidator,
+			Mode:      schema.Mode(cfg.API.RequestValidationMode),
+		}),
+	)
+
+	// Admin routes, which stay writable while the region is read-only so
+	// operators can end a drill or promote a standby
+	admin := v1.Group("/admin")
+
+	// Proof of possession: token routes bind tokens to the client's key,
+	// authenticated routes check the proof
+	dpopChecker := middleware.NewDPoP(middleware.DPoPConfig{
+		Verifier: dpop.NewVerifier(dpop.Config{MaxAge: cfg.DPoP.MaxAge}),
+		Policy:   dpopPolicy,
+	})
+	dpopBind := middleware.DPoPBind(dpopChecker)
+	dpopProof := middleware.DPoPProof(dpopChecker)
 
 	// Auth endpoints (public)
-	mux.HandleFunc("POST /v1/auth/register", authHandler.Register)
-	mux.HandleFunc("POST /v1/auth/login", authHandler.Login)
-	mux.HandleFunc("POST /v1/auth/refresh", authHandler.Refresh)
+	v1.Handle("POST /auth/register", dpopBind(http.HandlerFunc(authHandler.Register)))
+	v1.Handle("POST /auth/login", dpopBind(http.HandlerFunc(authHandler.Login)))
+	v1.Handle("POST /auth/refresh", dpopBind(http.HandlerFunc(authHandler.Refresh)))
+	v1.HandleFunc("POST /auth/email/verify", authHandler.VerifyEmailChange)
+	v1.HandleFunc("POST /auth/recovery/cancel", authHandler.CancelEmailRecovery)
+	v1.Handle("POST /auth/invitations/accept", dpopBind(http.HandlerFunc(authHandler.AcceptInvitation)))
 
 	// OAuth endpoints (public)
-	mux.HandleFunc("POST /v1/auth/oauth/google", oauthHandler.Google)
-	mux.HandleFunc("POST /v1/auth/oauth/apple", oauthHandler.Apple)
+	v1.Handle("POST /auth/oauth/google", dpopBind(http.HandlerFunc(oauthHandler.Google)))
+	v1.Handle("POST /auth/oauth/apple", dpopBind(http.HandlerFunc(oauthHandler.Apple)))
 
 	// Passkey login endpoints (public)
-	mux.HandleFunc("POST /v1/auth/passkey/login/start", passkeyHandler.LoginStart)
-	mux.HandleFunc("POST /v1/auth/passkey/login/finish", passkeyHandler.LoginFinish)
+	v1.HandleFunc("POST /auth/passkey/login/start", passkeyHandler.LoginStart)
+	v1.Handle("POST /auth/passkey/login/finish", dpopBind(http.HandlerFunc(passkeyHandler.LoginFinish)))
+	v1.HandleFunc("POST /auth/passkey/recover", passkeyHandler.Recover)
+	v1.HandleFunc("POST /auth/passkey/cross-device", passkeyHandler.CrossDeviceStart)
+	v1.HandleFunc("GET /auth/passkey/cross-device/{id}", passkeyHandler.CrossDeviceOptions)
+	v1.HandleFunc("POST /auth/passkey/cross-device/{id}/approve", passkeyHandler.CrossDeviceApprove)
+	v1.Handle("POST /auth/passkey/cross-device/{id}/poll", dpopBind(http.HandlerFunc(passkeyHandler.CrossDevicePoll)))
+
+	// QR login endpoints (public - the shared device is not signed in)
+	v1.HandleFunc("POST /auth/device-pairing", devicePairingHandler.Start)
+	v1.Handle("POST /auth/device-pairing/{id}/poll", dpopBind(http.HandlerFunc(devicePairingHandler.Poll)))
 
 	// Auth endpoints (protected)
-	authMiddleware := middleware.Auth(tokenService)
-	adminMiddleware := middleware.AdminAuth(tokenService)
-	mux.Handle("POST /v1/auth/logout", authMiddleware(http.HandlerFunc(authHandler.Logout)))
-	mux.Handle("GET /v1/auth/me", authMiddleware(http.HandlerFunc(authHandler.Me)))
+	// Authenticated requests also mark the user active, and risky signups
+	// can't make changes until they verify their email
+	authMiddleware := func(next http.Handler) http.Handler {
+		return middleware.Chain(next, middleware.Auth(tokenService), dpopProof, middleware.Presence(presenceService), middleware.RequireVerifiedWrites(signupRiskService))
+	}
+	adminMiddleware := func(next http.Handler) http.Handler {
+		return middleware.Chain(next, middleware.AdminAuth(tokenService), dpopProof)
+	}
+	superAdminMiddleware := func(next http.Handler) http.Handler {
+		return middleware.Chain(next, middleware.SuperAdminAuth(tokenService), dpopProof)
+	}
+	// Reaction routes also spend the per-user reaction budget
+	reactionLimit := middleware.RateLimit(reactionRateLimiter)
+	// Going available right now spends the per-user spontaneous budget
+	spontaneousLimit := middleware.RateLimit(spontaneousRateLimiter)
+	// Routes that name a user in their path log access to held users' data
+	legalHoldAudit := middleware.LegalHoldAudit(legalHoldService)
+	managePools := middleware.RequireGuildPermission(permissionService, model.PermissionManagePools)
+	v1.Handle("POST /auth/logout", authMiddleware(http.HandlerFunc(authHandler.Logout)))
+	v1.Handle("GET /auth/me", authMiddleware(http.HandlerFunc(authHandler.Me)))
+	v1.Handle("POST /auth/password", authMiddleware(http.HandlerFunc(authHandler.ChangePassword)))
+	v1.Handle("POST /auth/email/change", authMiddleware(http.HandlerFunc(authHandler.RequestEmailChange)))
+	v1.Handle("POST /auth/email/verification", authMiddleware(http.HandlerFunc(authHandler.SendEmailVerification)))
 
 	// Passkey registration endpoints (protected - user must be logged in)
-	mux.Handle("POST /v1/auth/passkey/register/start", authMiddleware(http.HandlerFunc(passkeyHandler.RegisterStart)))
-	mux.Handle("POST /v1/auth/passkey/register/finish", authMiddleware(http.HandlerFunc(passkeyHandler.RegisterFinish)))
-	mux.Handle("DELETE /v1/auth/passkey/", authMiddleware(http.HandlerFunc(passkeyHandler.Delete)))
+	v1.Handle("POST /auth/passkey/register/start", authMiddleware(http.HandlerFunc(passkeyHandler.RegisterStart)))
+	v1.Handle("POST /auth/passkey/register/finish", authMiddleware(http.HandlerFunc(passkeyHandler.RegisterFinish)))
+	v1.Handle("DELETE /auth/passkey/", authMiddleware(http.HandlerFunc(passkeyHandler.Delete)))
+
+	// QR login approval (protected - the phone approving is signed in)
+	v1.Handle("POST /auth/device-approve", authMiddleware(http.HandlerFunc(devicePairingHandler.Approve)))
 
 	// Guild endpoints
-	mux.Handle("GET /v1/guilds", authMiddleware(http.HandlerFunc(guildHandler.List)))
-	mux.Handle("POST /v1/guilds", authMiddleware(http.HandlerFunc(guildHandler.Create)))
-	mux.Handle("GET /v1/guilds/{guildId}", authMiddleware(http.HandlerFunc(guildHandler.Get)))
-	mux.Handle("PATCH /v1/guilds/{guildId}", authMiddleware(http.HandlerFunc(guildHandler.Update)))
-	mux.Handle("DELETE /v1/guilds/{guildId}", authMiddleware(http.HandlerFunc(guildHandler.Delete)))
-	mux.Handle("POST /v1/guilds/{guildId}/join", authMiddleware(http.HandlerFunc(guildHandler.Join)))
-	mux.Handle("POST /v1/guilds/{guildId}/leave", authMiddleware(http.HandlerFunc(guildHandler.Leave)))
-	mux.Handle("GET /v1/guilds/{guildId}/members", authMiddleware(http.HandlerFunc(guildHandler.GetMembers)))
-	mux.Handle("GET /v1/guilds/{guildId}/members/{userId}/role", authMiddleware(http.HandlerFunc(guildHandler.GetMemberRole)))
-	mux.Handle("PATCH /v1/guilds/{guildId}/members/{userId}/role", authMiddleware(http.HandlerFunc(guildHandler.UpdateMemberRole)))
+	v1.Handle("GET /guilds", authMiddleware(http.HandlerFunc(guildHandler.List)))
+	v1.Handle("POST /guilds", authMiddleware(http.HandlerFunc(guildHandler.Create)))
+	v1.Handle("GET /guilds/{guildId}", authMiddleware(http.HandlerFunc(guildHandler.Get)))
+	v1.Handle("GET /guild-slugs/{slug}", authMiddleware(http.HandlerFunc(guildHandler.GetBySlug)))
+	v1.Handle("PATCH /guilds/{guildId}", authMiddleware(http.HandlerFunc(guildHandler.Update)))
+	v1.Handle("DELETE /guilds/{guildId}", authMiddleware(http.HandlerFunc(guildHandler.Delete)))
+	v1.Handle("POST /guilds/{guildId}/join", authMiddleware(http.HandlerFunc(guildHandler.Join)))
+	v1.Handle("POST /guilds/{guildId}/leave", authMiddleware(http.HandlerFunc(guildHandler.Leave)))
+	v1.Handle("GET /guilds/{guildId}/members", authMiddleware(http.HandlerFunc(guildHandler.GetMembers)))
+	v1.Handle("GET /guilds/{guildId}/members/{userId}/role", authMiddleware(legalHoldAudit(http.HandlerFunc(guildHandler.GetMemberRole))))
+	v1.Handle("PATCH /guilds/{guildId}/members/{userId}/role", authMiddleware(legalHoldAudit(http.HandlerFunc(guildHandler.UpdateMemberRole))))
+	v1.Handle("GET /guilds/{guildId}/members/{userId}/tier", authMiddleware(legalHoldAudit(http.HandlerFunc(guildTierHandler.GetMemberTier))))
+	v1.Handle("PUT /guilds/{guildId}/members/{userId}/tier", authMiddleware(legalHoldAudit(http.HandlerFunc(guildTierHandler.AssignTier))))
+	v1.Handle("DELETE /guilds/{guildId}/members/{userId}/tier", authMiddleware(legalHoldAudit(http.HandlerFunc(guildTierHandler.RemoveMemberTier))))
+
+	// Guild membership tiers
+	v1.Handle("GET /guilds/{guildId}/tiers", authMiddleware(http.HandlerFunc(guildTierHandler.ListTiers)))
+	v1.Handle("POST /guilds/{guildId}/tiers", authMiddleware(http.HandlerFunc(guildTierHandler.CreateTier)))
+	v1.Handle("PATCH /guilds/{guildId}/tiers/{tierId}", authMiddleware(http.HandlerFunc(guildTierHandler.UpdateTier)))
+	v1.Handle("DELETE /guilds/{guildId}/tiers/{tierId}", authMiddleware(http.HandlerFunc(guildTierHandler.DeleteTier)))
+	v1.Handle("GET /guilds/{guildId}/tiers/{tierId}/members", authMiddleware(http.HandlerFunc(guildTierHandler.ListTierMembers)))
+
+	// Guild health score and suggested actions (organizers only)
+	v1.Handle("GET /guilds/{guildId}/health", authMiddleware(managePools(http.HandlerFunc(guildHealthHandler.Get))))
+
+	// Guild custom roles and the permissions they grant
+	v1.Handle("GET /guilds/{guildId}/members/{userId}/permissions", authMiddleware(http.HandlerFunc(guildPermissionHandler.GetMemberPermissions)))
+	v1.Handle("GET /guilds/{guildId}/roles", authMiddleware(http.HandlerFunc(guildPermissionHandler.ListRoles)))
+	v1.Handle("POST /guilds/{guildId}/roles", authMiddleware(http.HandlerFunc(guildPermissionHandler.CreateRole)))
+	v1.Handle("PATCH /guilds/{guildId}/roles/{roleId}", authMiddleware(http.HandlerFunc(guildPermissionHandler.UpdateRole)))
+	v1.Handle("DELETE /guilds/{guildId}/roles/{roleId}", authMiddleware(http.HandlerFunc(guildPermissionHandler.DeleteRole)))
+	v1.Handle("PUT /guilds/{guildId}/roles/{roleId}/members/{userId}", authMiddleware(http.HandlerFunc(guildPermissionHandler.AssignRole)))
+	v1.Handle("DELETE /guilds/{guildId}/roles/{roleId}/members/{userId}", authMiddleware(http.HandlerFunc(guildPermissionHandler.UnassignRole)))
+
+	// Guild custom hangout types, for availability scoped to the guild
+	v1.Handle("GET /guilds/{guildId}/hangout-types", authMiddleware(http.HandlerFunc(hangoutTypeHandler.ListGuildTypes)))
+	v1.Handle("POST /guilds/{guildId}/hangout-types", authMiddleware(http.HandlerFunc(hangoutTypeHandler.CreateGuildType)))
+	v1.Handle("PATCH /guilds/{guildId}/hangout-types/{type}", authMiddleware(http.HandlerFunc(hangoutTypeHandler.UpdateGuildType)))
+	v1.Handle("DELETE /guilds/{guildId}/hangout-types/{type}", authMiddleware(http.HandlerFunc(hangoutTypeHandler.DeleteGuildType)))
+
+	// Guild venue directory, reusable across the guild's events
+	v1.Handle("GET /guilds/{guildId}/venues", authMiddleware(http.HandlerFunc(venueHandler.List)))
+	v1.Handle("POST /guilds/{guildId}/venues", authMiddleware(http.HandlerFunc(venueHandler.Create)))
+	v1.Handle("GET /guilds/{guildId}/venues/{venueId}", authMiddleware(http.HandlerFunc(venueHandler.Get)))
+	v1.Handle("PATCH /guilds/{guildId}/venues/{venueId}", authMiddleware(http.HandlerFunc(venueHandler.Update)))
+	v1.Handle("DELETE /guilds/{guildId}/venues/{venueId}", authMiddleware(http.HandlerFunc(venueHandler.Delete)))
+
+	// Guild invites: expiring codes that let people join without approval
+	v1.Handle("GET /guilds/{guildId}/invites", authMiddleware(http.HandlerFunc(guildInviteHandler.List)))
+	v1.Handle("POST /guilds/{guildId}/invites", authMiddleware(http.HandlerFunc(guildInviteHandler.Create)))
+	v1.Handle("DELETE /guilds/{guildId}/invites/{inviteId}", authMiddleware(http.HandlerFunc(guildInviteHandler.Revoke)))
+	v1.Handle("POST /invites/{code}/accept", authMiddleware(http.HandlerFunc(guildInviteHandler.Accept)))
+
+	// Event carpools: ride offers, ride requests and seat booking
+	v1.Handle("GET /events/{eventId}/carpool/rides", authMiddleware(http.HandlerFunc(carpoolHandler.ListRides)))
+	v1.Handle("POST /events/{eventId}/carpool/rides", authMiddleware(http.HandlerFunc(carpoolHandler.OfferRide)))
+	v1.Handle("POST /events/{eventId}/carpool/requests", authMiddleware(http.HandlerFunc(carpoolHandler.RequestRide)))
+	v1.Handle("DELETE /events/{eventId}/carpool/requests/{requestId}", authMiddleware(http.HandlerFunc(carpoolHandler.CancelRequest)))
+	v1.Handle("GET /events/{eventId}/carpool/matches", authMiddleware(http.HandlerFunc(carpoolHandler.Matches)))
+	v1.Handle("POST /rideshares/{rideshareId}/seats", authMiddleware(http.HandlerFunc(carpoolHandler.RequestSeat)))
+	v1.Handle("POST /rideshares/{rideshareId}/seats/{seatId}/respond", authMiddleware(http.HandlerFunc(carpoolHandler.RespondToSeat)))
+
+	// Event expenses: hosts record costs, attendees see their share and settle up
+	v1.Handle("GET /events/{eventId}/expenses", authMiddleware(http.HandlerFunc(eventExpenseHandler.List)))
+	v1.Handle("POST /events/{eventId}/expenses", authMiddleware(http.HandlerFunc(eventExpenseHandler.Create)))
+	v1.Handle("DELETE /events/{eventId}/expenses/{expenseId}", authMiddleware(http.HandlerFunc(eventExpenseHandler.Delete)))
+	v1.Handle("GET /events/{eventId}/expenses/ledger", authMiddleware(http.HandlerFunc(eventExpenseHandler.Ledger)))
+	v1.Handle("POST /events/{eventId}/expenses/settlements", authMiddleware(http.HandlerFunc(eventExpenseHandler.Settle)))
 
 	// SSE events endpoint - simplified without guild access for now
-	mux.Handle("GET /v1/events/stream", authMiddleware(http.HandlerFunc(eventsHandler.Stream)))
+	v1.Handle("GET /events/stream", authMiddleware(http.HandlerFunc(eventsHandler.Stream)))
 	_ = eventsHandler
 
+	// The same events over WebSocket, for clients behind proxies that buffer SSE
+	v1.Handle("GET /events/ws", authMiddleware(http.HandlerFunc(eventSocketHandler.Stream)))
+
+	// Long-poll fallback for clients that can't hold the SSE stream open
+	v1.Handle("GET /notifications/poll", authMiddleware(http.HandlerFunc(notificationHandler.Poll)))
+
 	// Profile endpoints (auth required)
-	mux.Handle("GET /v1/profile", authMiddleware(http.HandlerFunc(profileHandler.Get)))
-	mux.Handle("PATCH /v1/profile", authMiddleware(http.HandlerFunc(profileHandler.Update)))
-	mux.Handle("GET /v1/users/{userId}/profile", authMiddleware(http.HandlerFunc(profileHandler.GetUser)))
-	mux.Handle("GET /v1/profiles/nearby", authMiddleware(http.HandlerFunc(profileHandler.GetNearby)))
+	v1.Handle("GET /profile", authMiddleware(http.HandlerFunc(profileHandler.Get)))
+	v1.Handle("PATCH /profile", authMiddleware(http.HandlerFunc(profileHandler.Update)))
+	v1.Handle("PUT /profile/handle", authMiddleware(http.HandlerFunc(profileHandler.SetHandle)))
+	v1.Handle("GET /profile/payout-account", authMiddleware(http.HandlerFunc(paymentHandler.GetPayoutAccount)))
+	v1.Handle("POST /profile/payout-account/link", authMiddleware(http.HandlerFunc(paymentHandler.LinkPayoutAccount)))
+	v1.Handle("GET /users/{userId}/profile", authMiddleware(legalHoldAudit(http.HandlerFunc(profileHandler.GetUser))))
+	v1.Handle("GET /public/users/{userId}/profile", legalHoldAudit(http.HandlerFunc(profileHandler.GetShared)))
+
+	// Payment webhooks (unauthenticated; Stripe signs each delivery)
+	v1.HandleFunc("POST /payments/webhook", paymentHandler.Webhook)
+
+	// Guild event embeds (unauthenticated, opt-in per guild, readable from any origin)
+	v1.Handle("GET /public/guilds/{slug}/events", publicEmbed(guildEmbedHandler.Events))
+	v1.Handle("GET /public/guilds/{slug}/events/widget", publicEmbed(guildEmbedHandler.Widget))
+	v1.Handle("GET /public/guilds/{slug}/events/oembed", publicEmbed(guildEmbedHandler.OEmbed))
+
+	// Client app versions and feature flags (public)
+	v1.HandleFunc("GET /meta/client-config", metaHandler.ClientConfig)
+	v1.HandleFunc("GET /meta/config", metaHandler.Config)
+	v1.Handle("GET /meta/experiments", authMiddleware(http.HandlerFunc(metaHandler.Experiments)))
+
+	// Client crash and API mismatch reports (signed in or not, limited per address)
+	v1.Handle("POST /client-errors", middleware.Chain(http.HandlerFunc(clientErrorHandler.Report), middleware.RateLimitByIP(clientErrorRateLimiter), middleware.OptionalAuth(tokenService)))
+	v1.Handle("GET /profiles/nearby", authMiddleware(http.HandlerFunc(profileHandler.GetNearby)))
 
 	// Device token endpoints (for push notifications)
-	mux.Handle("POST /v1/devices", authMiddleware(http.HandlerFunc(deviceHandler.Register)))
-	mux.Handle("GET /v1/devices", authMiddleware(http.HandlerFunc(deviceHandler.List)))
-	mux.Handle("DELETE /v1/devices/{deviceId}", authMiddleware(http.HandlerFunc(deviceHandler.Delete)))
+	v1.Handle("POST /devices", authMiddleware(http.HandlerFunc(deviceHandler.Register)))
+	v1.Handle("GET /devices", authMiddleware(http.HandlerFunc(deviceHandler.List)))
+	v1.Handle("DELETE /devices/{deviceId}", authMiddleware(http.HandlerFunc(deviceHandler.Delete)))
+
+	// Event reminder preference endpoints
+	v1.Handle("GET /profile/event-reminders", authMiddleware(http.HandlerFunc(eventReminderHandler.GetPreference)))
+	v1.Handle("PATCH /profile/event-reminders", authMiddleware(http.HandlerFunc(eventReminderHandler.UpdatePreference)))
+
+	// Notification mute endpoints
+	v1.Handle("GET /profile/mutes", authMiddleware(http.HandlerFunc(muteHandler.ListMutes)))
+	v1.Handle("POST /profile/mutes", authMiddleware(http.HandlerFunc(muteHandler.CreateMute)))
+	v1.Handle("DELETE /profile/mutes/{muteId}", authMiddleware(http.HandlerFunc(muteHandler.DeleteMute)))
+
+	// Presence heartbeat
+	v1.Handle("POST /presence", authMiddleware(http.HandlerFunc(presenceHandler.Heartbeat)))
+
+	// Activity timeline endpoint
+	v1.Handle("GET /profile/activity", authMiddleware(http.HandlerFunc(activityHandler.GetMyActivity)))
 
 	// Discovery endpoints (global people matching)
-	mux.Handle("GET /v1/discover/people", authMiddleware(http.HandlerFunc(discoveryHandler.DiscoverPeople)))
-	mux.Handle("GET /v1/discover/interest/{interestId}", authMiddleware(http.HandlerFunc(discoveryHandler.DiscoverByInterest)))
-	mux.Handle("GET /v1/discover/teach-learn", authMiddleware(http.HandlerFunc(discoveryHandler.DiscoverTeachLearn)))
-	mux.HandleFunc("GET /v1/discover/hangout-types", discoveryHandler.GetHangoutTypes)
+	v1.Handle("GET /discover/people", authMiddleware(http.HandlerFunc(discoveryHandler.DiscoverPeople)))
+	v1.Handle("GET /discover/interest/{interestId}", authMiddleware(http.HandlerFunc(discoveryHandler.DiscoverByInterest)))
+	v1.Handle("GET /discover/teach-learn", authMiddleware(http.HandlerFunc(discoveryHandler.DiscoverTeachLearn)))
+	v1.HandleFunc("GET /discover/hangout-types", discoveryHandler.GetHangoutTypes)
+
+	// Full-text search across public guilds, events, interests and people
+	v1.Handle("GET /search", authMiddleware(http.HandlerFunc(searchHandler.Search)))
 
 	// Interest endpoints (public and auth)
-	mux.HandleFunc("GET /v1/interests", interestHandler.ListInterests)
-	mux.HandleFunc("GET /v1/interests/categories", interestHandler.GetCategories)
-	mux.Handle("GET /v1/profile/interests", authMiddleware(http.HandlerFunc(interestHandler.GetUserInterests)))
-	mux.Handle("POST /v1/profile/interests", authMiddleware(http.HandlerFunc(interestHandler.AddUserInterest)))
-	mux.Handle("PATCH /v1/profile/interests/{interestId}", authMiddleware(http.HandlerFunc(interestHandler.UpdateUserInterest)))
-	mux.Handle("DELETE /v1/profile/interests/{interestId}", authMiddleware(http.HandlerFunc(interestHandler.RemoveUserInterest)))
-	mux.Handle("GET /v1/profile/interests/stats", authMiddleware(http.HandlerFunc(interestHandler.GetInterestStats)))
-	mux.Handle("GET /v1/interests/matches/teaching", authMiddleware(http.HandlerFunc(interestHandler.FindTeachingMatches)))
-	mux.Handle("GET /v1/interests/matches/learning", authMiddleware(http.HandlerFunc(interestHandler.FindLearningMatches)))
-	mux.Handle("GET /v1/interests/shared", authMiddleware(http.HandlerFunc(interestHandler.FindSharedInterests)))
+	v1.HandleFunc("GET /interests", interestHandler.ListInterests)
+	v1.HandleFunc("GET /interests/categories", interestHandler.GetCategories)
+	v1.Handle("GET /profile/interests", authMiddleware(http.HandlerFunc(interestHandler.GetUserInterests)))
+	v1.Handle("POST /profile/interests", authMiddleware(http.HandlerFunc(interestHandler.AddUserInterest)))
+	v1.Handle("PATCH /profile/interests/{interestId}", authMiddleware(http.HandlerFunc(interestHandler.UpdateUserInterest)))
+	v1.Handle("DELETE /profile/interests/{interestId}", authMiddleware(http.HandlerFunc(interestHandler.RemoveUserInterest)))
+	v1.Handle("GET /profile/interests/stats", authMiddleware(http.HandlerFunc(interestHandler.GetInterestStats)))
+	v1.Handle("GET /interests/matches/teaching", authMiddleware(http.HandlerFunc(interestHandler.FindTeachingMatches)))
+	v1.Handle("GET /interests/matches/learning", authMiddleware(http.HandlerFunc(interestHandler.FindLearningMatches)))
+	v1.Handle("GET /interests/shared", authMiddleware(http.HandlerFunc(interestHandler.FindSharedInterests)))
 
 	// Questionnaire endpoints (public)
-	mux.HandleFunc("GET /v1/questions", questionnaireHandler.ListQuestions)
-	mux.HandleFunc("GET /v1/questions/categories", questionnaireHandler.GetCategories)
+	v1.HandleFunc("GET /questions", questionnaireHandler.ListQuestions)
+	v1.HandleFunc("GET /questions/categories", contentHandler.GetQuestionCategories)
 
 	// Questionnaire endpoints (auth required)
-	mux.Handle("GET /v1/questions/{questionId}", authMiddleware(http.HandlerFunc(questionnaireHandler.GetQuestion)))
-	mux.Handle("GET /v1/profile/answers", authMiddleware(http.HandlerFunc(questionnaireHandler.GetUserAnswers)))
-	mux.Handle("GET /v1/profile/answers/detailed", authMiddleware(http.HandlerFunc(questionnaireHandler.GetUserAnswersWithQuestions)))
-	mux.Handle("GET /v1/profile/questions/progress", authMiddleware(http.HandlerFunc(questionnaireHandler.GetQuestionProgress)))
-	mux.Handle("POST /v1/questions/{questionId}/answer", authMiddleware(http.HandlerFunc(questionnaireHandler.AnswerQuestion)))
-	mux.Handle("PATCH /v1/questions/{questionId}/answer", authMiddleware(http.HandlerFunc(questionnaireHandler.UpdateAnswer)))
-	mux.Handle("DELETE /v1/questions/{questionId}/answer", authMiddleware(http.HandlerFunc(questionnaireHandler.DeleteAnswer)))
-	mux.Handle("GET /v1/compatibility/{userId}", authMiddleware(http.HandlerFunc(questionnaireHandler.GetCompatibility)))
-	mux.Handle("GET /v1/compatibility/{userId}/yikes", authMiddleware(http.HandlerFunc(questionnaireHandler.GetYikesSummary)))
+	v1.Handle("GET /questions/{questionId}", authMiddleware(http.HandlerFunc(questionnaireHandler.GetQuestion)))
+	v1.Handle("GET /profile/answers", authMiddleware(http.HandlerFunc(questionnaireHandler.GetUserAnswers)))
+	v1.Handle("GET /profile/answers/detailed", authMiddleware(http.HandlerFunc(questionnaireHandler.GetUserAnswersWithQuestions)))
+	v1.Handle("GET /profile/answers/export", authMiddleware(http.HandlerFunc(questionnaireHandler.ExportAnswers)))
+	v1.Handle("POST /profile/answers/import", authMiddleware(http.HandlerFunc(questionnaireHandler.ImportAnswers)))
+	v1.Handle("GET /profile/questions/progress", authMiddleware(http.HandlerFunc(questionnaireHandler.GetQuestionProgress)))
+	v1.Handle("POST /questions/{questionId}/answer", authMiddleware(http.HandlerFunc(questionnaireHandler.AnswerQuestion)))
+	v1.Handle("PATCH /questions/{questionId}/answer", authMiddleware(http.HandlerFunc(questionnaireHandler.UpdateAnswer)))
+	v1.Handle("DELETE /questions/{questionId}/answer", authMiddleware(http.HandlerFunc(questionnaireHandler.DeleteAnswer)))
+	v1.Handle("GET /compatibility/{userId}", authMiddleware(legalHoldAudit(http.HandlerFunc(questionnaireHandler.GetCompatibility))))
+	v1.Handle("POST /compatibility/batch", authMiddleware(http.HandlerFunc(questionnaireHandler.GetCompatibilityBatch)))
+	v1.Handle("GET /compatibility/{userId}/yikes", authMiddleware(legalHoldAudit(http.HandlerFunc(questionnaireHandler.GetYikesSummary))))
+
+	// Guild questionnaire endpoints - organizers manage questions and read answers
+	v1.Handle("GET /guilds/{guildId}/questions", authMiddleware(http.HandlerFunc(guildQuestionHandler.ListQuestions)))
+	v1.Handle("POST /guilds/{guildId}/questions", authMiddleware(http.HandlerFunc(guildQuestionHandler.CreateQuestion)))
+	v1.Handle("PATCH /guilds/{guildId}/questions/{questionId}", authMiddleware(http.HandlerFunc(guildQuestionHandler.UpdateQuestion)))
+	v1.Handle("DELETE /guilds/{guildId}/questions/{questionId}", authMiddleware(http.HandlerFunc(guildQuestionHandler.DeleteQuestion)))
+	v1.Handle("POST /guilds/{guildId}/questions/{questionId}/answer", authMiddleware(http.HandlerFunc(guildQuestionHandler.AnswerQuestion)))
+	v1.Handle("DELETE /guilds/{guildId}/questions/{questionId}/answer", authMiddleware(http.HandlerFunc(guildQuestionHandler.DeleteAnswer)))
+	v1.Handle("GET /guilds/{guildId}/questions/answers", authMiddleware(http.HandlerFunc(guildQuestionHandler.GetAnswers)))
+	v1.Handle("GET /guilds/{guildId}/questions/answers/me", authMiddleware(http.HandlerFunc(guildQuestionHandler.GetMyAnswers)))
 
 	// Availability endpoints
-	mux.HandleFunc("GET /v1/hangout-types", availabilityHandler.GetHangoutTypes)
-	mux.Handle("POST /v1/availability", authMiddleware(http.HandlerFunc(availabilityHandler.CreateAvailability)))
-	mux.Handle("GET /v1/availability/{availabilityId}", authMiddleware(http.HandlerFunc(availabilityHandler.GetAvailability)))
-	mux.Handle("PATCH /v1/availability/{availabilityId}", authMiddleware(http.HandlerFunc(availabilityHandler.UpdateAvailability)))
-	mux.Handle("DELETE /v1/availability/{availabilityId}", authMiddleware(http.HandlerFunc(availabilityHandler.DeleteAvailability)))
-	mux.Handle("GET /v1/profile/availability", authMiddleware(http.HandlerFunc(availabilityHandler.GetMyAvailabilities)))
-	mux.Handle("GET /v1/discover/availability", authMiddleware(http.HandlerFunc(availabilityHandler.FindNearby)))
-	mux.Handle("GET /v1/discover/availability/type/{type}", authMiddleware(http.HandlerFunc(availabilityHandler.FindByType)))
-	mux.Handle("POST /v1/availability/{availabilityId}/request", authMiddleware(http.HandlerFunc(availabilityHandler.RequestHangout)))
-	mux.Handle("GET /v1/availability/{availabilityId}/requests", authMiddleware(http.HandlerFunc(availabilityHandler.GetPendingRequests)))
-	mux.Handle("POST /v1/requests/{requestId}/respond", authMiddleware(http.HandlerFunc(availabilityHandler.RespondToRequest)))
-	mux.Handle("GET /v1/profile/hangouts", authMiddleware(http.HandlerFunc(availabilityHandler.GetUserHangouts)))
-	mux.Handle("PATCH /v1/hangouts/{hangoutId}/status", authMiddleware(http.HandlerFunc(availabilityHandler.UpdateHangoutStatus)))
+	v1.HandleFunc("GET /hangout-types", availabilityHandler.GetHangoutTypes)
+	v1.Handle("POST /availability", authMiddleware(http.HandlerFunc(availabilityHandler.CreateAvailability)))
+	v1.Handle("GET /availability/now", authMiddleware(http.HandlerFunc(spontaneousHandler.GetAvailableNow)))
+	v1.Handle("POST /availability/now", authMiddleware(spontaneousLimit(http.HandlerFunc(spontaneousHandler.GoAvailableNow))))
+	v1.Handle("DELETE /availability/now", authMiddleware(spontaneousLimit(http.HandlerFunc(spontaneousHandler.StopAvailableNow))))
+	v1.Handle("GET /profile/spontaneous-alerts", authMiddleware(http.HandlerFunc(spontaneousHandler.GetAlertPreference)))
+	v1.Handle("PATCH /profile/spontaneous-alerts", authMiddleware(http.HandlerFunc(spontaneousHandler.UpdateAlertPreference)))
+	v1.Handle("GET /availability/{availabilityId}", authMiddleware(http.HandlerFunc(availabilityHandler.GetAvailability)))
+	v1.Handle("PATCH /availability/{availabilityId}", authMiddleware(http.HandlerFunc(availabilityHandler.UpdateAvailability)))
+	v1.Handle("DELETE /availability/{availabilityId}", authMiddleware(http.HandlerFunc(availabilityHandler.DeleteAvailability)))
+	v1.Handle("GET /profile/availability", authMiddleware(http.HandlerFunc(availabilityHandler.GetMyAvailabilities)))
+	v1.Handle("GET /discover/availability", authMiddleware(http.HandlerFunc(availabilityHandler.FindNearby)))
+	v1.Handle("GET /discover/availability/type/{type}", authMiddleware(http.HandlerFunc(availabilityHandler.FindByType)))
+	v1.Handle("GET /guilds/{guildId}/availability", authMiddleware(http.HandlerFunc(availabilityHandler.GetGuildAvailability)))
+	v1.Handle("GET /guilds/{guildId}/availability/heatmap", authMiddleware(http.HandlerFunc(availabilityHandler.GetGuildHeatmap)))
+	v1.Handle("POST /availability/{availabilityId}/request", authMiddleware(http.HandlerFunc(availabilityHandler.RequestHangout)))
+	v1.Handle("GET /availability/{availabilityId}/requests", authMiddleware(http.HandlerFunc(availabilityHandler.GetPendingRequests)))
+	v1.Handle("POST /requests/{requestId}/respond", authMiddleware(http.HandlerFunc(availabilityHandler.RespondToRequest)))
+	v1.Handle("GET /profile/hangouts", authMiddleware(http.HandlerFunc(availabilityHandler.GetUserHangouts)))
+	v1.Handle("PATCH /hangouts/{hangoutId}/status", authMiddleware(http.HandlerFunc(availabilityHandler.UpdateHangoutStatus)))
 
 	// Resonance endpoints
-	mux.Handle("GET /v1/resonance", authMiddleware(http.HandlerFunc(resonanceHandler.GetMyResonance)))
-	mux.Handle("GET /v1/resonance/ledger", authMiddleware(http.HandlerFunc(resonanceHandler.GetLedger)))
-	mux.Handle("POST /v1/resonance/recalculate", authMiddleware(http.HandlerFunc(resonanceHandler.RecalculateScore)))
-	mux.HandleFunc("GET /v1/resonance/explain", resonanceHandler.GetResonanceExplainer)
-	mux.Handle("GET /v1/users/{userId}/resonance", authMiddleware(http.HandlerFunc(resonanceHandler.GetUserResonance)))
+	v1.Handle("GET /resonance", authMiddleware(http.HandlerFunc(resonanceHandler.GetMyResonance)))
+	v1.Handle("GET /resonance/ledger", authMiddleware(http.HandlerFunc(resonanceHandler.GetLedger)))
+	v1.Handle("POST /resonance/recalculate", authMiddleware(http.HandlerFunc(resonanceHandler.RecalculateScore)))
+	v1.HandleFunc("GET /resonance/explain", resonanceHandler.GetResonanceExplainer)
+	v1.Handle("GET /users/{userId}/resonance", authMiddleware(legalHoldAudit(http.HandlerFunc(resonanceHandler.GetUserResonance))))
 
 	// Review endpoints
-	mux.Handle("POST /v1/reviews", authMiddleware(http.HandlerFunc(reviewHandler.CreateReview)))
-	mux.Handle("GET /v1/reviews/{reviewId}", authMiddleware(http.HandlerFunc(reviewHandler.GetReview)))
-	mux.Handle("GET /v1/profile/reviews/given", authMiddleware(http.HandlerFunc(reviewHandler.GetReviewsGiven)))
-	mux.Handle("GET /v1/profile/reviews/received", authMiddleware(http.HandlerFunc(reviewHandler.GetReviewsReceived)))
-	mux.Handle("GET /v1/profile/reputation", authMiddleware(http.HandlerFunc(reviewHandler.GetMyReputation)))
-	mux.Handle("GET /v1/users/{userId}/reputation", authMiddleware(http.HandlerFunc(reviewHandler.GetUserReputation)))
-	mux.HandleFunc("GET /v1/reviews/tags/positive", reviewHandler.GetPositiveTags)
-	mux.HandleFunc("GET /v1/reviews/tags/improvement", reviewHandler.GetImprovementTags)
+	v1.Handle("POST /reviews", authMiddleware(http.HandlerFunc(reviewHandler.CreateReview)))
+	v1.Handle("GET /reviews/{reviewId}", authMiddleware(http.HandlerFunc(reviewHandler.GetReview)))
+	v1.Handle("GET /profile/reviews/given", authMiddleware(http.HandlerFunc(reviewHandler.GetReviewsGiven)))
+	v1.Handle("GET /profile/reviews/received", authMiddleware(http.HandlerFunc(reviewHandler.GetReviewsReceived)))
+	v1.Handle("GET /profile/reputation", authMiddleware(http.HandlerFunc(reviewHandler.GetMyReputation)))
+	v1.Handle("GET /users/{userId}/reputation", authMiddleware(legalHoldAudit(http.HandlerFunc(reviewHandler.GetUserReputation))))
+	v1.HandleFunc("GET /reviews/tags/positive", contentHandler.GetPositiveTags)
+	v1.HandleFunc("GET /reviews/tags/improvement", contentHandler.GetImprovementTags)
 
 	// Event endpoints
-	mux.Handle("POST /v1/events", authMiddleware(http.HandlerFunc(eventHandler.CreateEvent)))
-	mux.Handle("GET /v1/events/{eventId}", authMiddleware(http.HandlerFunc(eventHandler.GetEvent)))
-	mux.Handle("PATCH /v1/events/{eventId}", authMiddleware(http.HandlerFunc(eventHandler.UpdateEvent)))
-	mux.Handle("POST /v1/events/{eventId}/cancel", authMiddleware(http.HandlerFunc(eventHandler.CancelEvent)))
-	mux.Handle("POST /v1/events/{eventId}/rsvp", authMiddleware(http.HandlerFunc(eventHandler.RSVP)))
-	mux.Handle("DELETE /v1/events/{eventId}/rsvp", authMiddleware(http.HandlerFunc(eventHandler.CancelRSVP)))
-	mux.Handle("GET /v1/events/{eventId}/pending-rsvps", authMiddleware(http.HandlerFunc(eventHandler.GetPendingRSVPs)))
-	mux.Handle("POST /v1/events/{eventId}/rsvps/{rsvpUserId}/respond", authMiddleware(http.HandlerFunc(eventHandler.RespondToRSVP)))
-	mux.Handle("POST /v1/events/{eventId}/hosts", authMiddleware(http.HandlerFunc(eventHandler.AddHost)))
-	mux.Handle("POST /v1/events/{eventId}/completion", authMiddleware(http.HandlerFunc(eventHandler.ConfirmCompletion)))
-	mux.Handle("POST /v1/events/{eventId}/checkin", authMiddleware(http.HandlerFunc(eventHandler.Checkin)))
-	mux.Handle("POST /v1/events/{eventId}/feedback", authMiddleware(http.HandlerFunc(eventHandler.SubmitFeedback)))
-	mux.Handle("GET /v1/discover/events", authMiddleware(http.HandlerFunc(eventHandler.GetPublicEvents)))
-	mux.Handle("GET /v1/guilds/{guildId}/events", authMiddleware(http.HandlerFunc(eventHandler.GetGuildEvents)))
+	v1.Handle("POST /events", authMiddleware(http.HandlerFunc(eventHandler.CreateEvent)))
+	v1.Handle("GET /events/{eventId}", authMiddleware(http.HandlerFunc(eventHandler.GetEvent)))
+	v1.Handle("PATCH /events/{eventId}", authMiddleware(http.HandlerFunc(eventHandler.UpdateEvent)))
+	v1.Handle("DELETE /events/{eventId}", authMiddleware(http.HandlerFunc(eventHandler.DeleteEvent)))
+	v1.Handle("POST /events/{eventId}/cancel", authMiddleware(http.HandlerFunc(eventHandler.CancelEvent)))
+	v1.Handle("PUT /events/{eventId}/schedule", authMiddleware(http.HandlerFunc(eventHandler.SchedulePublication)))
+	v1.Handle("PUT /events/{eventId}/rsvp-window", authMiddleware(http.HandlerFunc(eventHandler.SetRSVPWindow)))
+	v1.Handle("POST /events/{eventId}/rsvp", authMiddleware(http.HandlerFunc(eventHandler.RSVP)))
+	v1.Handle("DELETE /events/{eventId}/rsvp", authMiddleware(http.HandlerFunc(eventHandler.CancelRSVP)))
+	v1.Handle("GET /events/{eventId}/pending-rsvps", authMiddleware(http.HandlerFunc(eventHandler.GetPendingRSVPs)))
+	v1.Handle("POST /events/{eventId}/rsvps/{rsvpUserId}/respond", authMiddleware(http.HandlerFunc(eventHandler.RespondToRSVP)))
+	v1.Handle("POST /events/{eventId}/hosts", authMiddleware(http.HandlerFunc(eventHandler.AddHost)))
+	v1.Handle("POST /events/{eventId}/completion", authMiddleware(http.HandlerFunc(eventHandler.ConfirmCompletion)))
+	v1.Handle("POST /events/{eventId}/checkin", authMiddleware(http.HandlerFunc(eventHandler.Checkin)))
+	v1.Handle("POST /events/{eventId}/feedback", authMiddleware(http.HandlerFunc(eventHandler.SubmitFeedback)))
+	v1.Handle("PUT /events/{eventId}/reactions", authMiddleware(reactionLimit(http.HandlerFunc(reactionHandler.ReactToEvent))))
+	v1.Handle("DELETE /events/{eventId}/reactions", authMiddleware(reactionLimit(http.HandlerFunc(reactionHandler.UnreactToEvent))))
+	v1.Handle("POST /events/{eventId}/tickets/hold", authMiddleware(http.HandlerFunc(eventHandler.HoldTicket)))
+	v1.Handle("DELETE /events/{eventId}/tickets/hold", authMiddleware(http.HandlerFunc(eventHandler.ReleaseTicketHold)))
+	v1.Handle("GET /events/{eventId}/tickets/mine", authMiddleware(http.HandlerFunc(eventHandler.GetMyTicket)))
+	v1.Handle("POST /events/{eventId}/tickets/{ticketId}/transfer", authMiddleware(http.HandlerFunc(eventHandler.TransferTicket)))
+	v1.Handle("POST /events/{eventId}/tickets/check-in", authMiddleware(http.HandlerFunc(eventHandler.CheckInTicket)))
+	v1.Handle("POST /events/{eventId}/rsvp/checkout", authMiddleware(http.HandlerFunc(paymentHandler.Checkout)))
+	v1.Handle("GET /events/{eventId}/promo-codes", authMiddleware(http.HandlerFunc(paymentHandler.ListPromoCodes)))
+	v1.Handle("POST /events/{eventId}/promo-codes", authMiddleware(http.HandlerFunc(paymentHandler.CreatePromoCode)))
+	v1.Handle("PATCH /events/{eventId}/promo-codes/{promoId}", authMiddleware(http.HandlerFunc(paymentHandler.UpdatePromoCode)))
+	v1.Handle("DELETE /events/{eventId}/promo-codes/{promoId}", authMiddleware(http.HandlerFunc(paymentHandler.DeletePromoCode)))
+	v1.Handle("PUT /events/{eventId}/member-discounts", authMiddleware(http.HandlerFunc(paymentHandler.SetMemberDiscounts)))
+	v1.Handle("GET /events/{eventId}/discount-report", authMiddleware(http.HandlerFunc(paymentHandler.GetDiscountReport)))
+	v1.Handle("GET /discover/events", authMiddleware(http.HandlerFunc(eventHandler.GetPublicEvents)))
+	v1.Handle("GET /guilds/{guildId}/events", authMiddleware(http.HandlerFunc(eventHandler.GetGuildEvents)))
+	v1.Handle("POST /guilds/{guildId}/events/suggest-times", authMiddleware(http.HandlerFunc(availabilityHandler.SuggestEventTimes)))
+
+	// No-show tracking endpoints
+	v1.Handle("GET /events/{eventId}/rsvps/{userId}/no-shows", authMiddleware(legalHoldAudit(http.HandlerFunc(noShowHandler.GetAttendeeStats))))
+	v1.Handle("POST /events/{eventId}/no-shows/{userId}", authMiddleware(legalHoldAudit(http.HandlerFunc(noShowHandler.MarkNoShow))))
+	v1.Handle("DELETE /events/{eventId}/no-shows/{userId}", authMiddleware(legalHoldAudit(http.HandlerFunc(noShowHandler.RemoveNoShow))))
+	v1.Handle("GET /profile/no-shows", authMiddleware(http.HandlerFunc(noShowHandler.GetMyNoShows)))
+	v1.Handle("GET /guilds/{guildId}/no-show-policy", authMiddleware(http.HandlerFunc(noShowHandler.GetGuildPolicy)))
+	v1.Handle("PATCH /guilds/{guildId}/no-show-policy", authMiddleware(http.HandlerFunc(noShowHandler.UpdateGuildPolicy)))
 
 	// Event role endpoints
-	mux.Handle("POST /v1/events/{eventId}/roles", authMiddleware(http.HandlerFunc(eventRoleHandler.CreateRole)))
-	mux.Handle("GET /v1/events/{eventId}/roles", authMiddleware(http.HandlerFunc(eventRoleHandler.GetRoles)))
-	mux.Handle("GET /v1/events/{eventId}/roles/overview", authMiddleware(http.HandlerFunc(eventRoleHandler.GetRolesOverview)))
-	mux.Handle("PATCH /v1/events/{eventId}/roles/{roleId}", authMiddleware(http.HandlerFunc(eventRoleHandler.UpdateRole)))
-	mux.Handle("DELETE /v1/events/{eventId}/roles/{roleId}", authMiddleware(http.HandlerFunc(eventRoleHandler.DeleteRole)))
-	mux.Handle("POST /v1/events/{eventId}/roles/assign", authMiddleware(http.HandlerFunc(eventRoleHandler.AssignRole)))
-	mux.Handle("GET /v1/events/{eventId}/roles/mine", authMiddleware(http.HandlerFunc(eventRoleHandler.GetMyRoles)))
-	mux.Handle("GET /v1/events/{eventId}/roles/suggestions", authMiddleware(http.HandlerFunc(eventRoleHandler.GetRoleSuggestions)))
-	mux.Handle("DELETE /v1/events/{eventId}/roles/assignments/{assignmentId}", authMiddleware(http.HandlerFunc(eventRoleHandler.CancelAssignment)))
+	v1.Handle("POST /events/{eventId}/roles", authMiddleware(http.HandlerFunc(eventRoleHandler.CreateRole)))
+	v1.Handle("GET /events/{eventId}/roles", authMiddleware(http.HandlerFunc(eventRoleHandler.GetRoles)))
+	v1.Handle("GET /events/{eventId}/roles/overview", authMiddleware(http.HandlerFunc(eventRoleHandler.GetRolesOverview)))
+	v1.Handle("PATCH /events/{eventId}/roles/{roleId}", authMiddleware(http.HandlerFunc(eventRoleHandler.UpdateRole)))
+	v1.Handle("DELETE /events/{eventId}/roles/{roleId}", authMiddleware(http.HandlerFunc(eventRoleHandler.DeleteRole)))
+	v1.Handle("POST /events/{eventId}/roles/assign", authMiddleware(http.HandlerFunc(eventRoleHandler.AssignRole)))
+	v1.Handle("GET /events/{eventId}/roles/mine", authMiddleware(http.HandlerFunc(eventRoleHandler.GetMyRoles)))
+	v1.Handle("GET /events/{eventId}/roles/suggestions", authMiddleware(http.HandlerFunc(eventRoleHandler.GetRoleSuggestions)))
+	v1.Handle("DELETE /events/{eventId}/roles/assignments/{assignmentId}", authMiddleware(http.HandlerFunc(eventRoleHandler.CancelAssignment)))
+	v1.Handle("POST /events/{eventId}/roles/{roleId}/nominations", authMiddleware(http.HandlerFunc(eventRoleHandler.NominateForRole)))
+	v1.Handle("GET /events/{eventId}/roles/nominations", authMiddleware(http.HandlerFunc(eventRoleHandler.GetNominations)))
+	v1.Handle("POST /events/{eventId}/roles/nominations/{assignmentId}/approve", authMiddleware(http.HandlerFunc(eventRoleHandler.ApproveNomination)))
+	v1.Handle("POST /events/{eventId}/roles/nominations/{assignmentId}/decline", authMiddleware(http.HandlerFunc(eventRoleHandler.DeclineNomination)))
 
 	// Trust endpoints
-	mux.Handle("GET /v1/trust", authMiddleware(http.HandlerFunc(trustHandler.GetTrustedUsers)))
-	mux.Handle("GET /v1/trust/{userId}", authMiddleware(http.HandlerFunc(trustHandler.GetTrustSummary)))
-	mux.Handle("POST /v1/trust/{userId}", authMiddleware(http.HandlerFunc(trustHandler.GrantTrust)))
-	mux.Handle("DELETE /v1/trust/{userId}", authMiddleware(http.HandlerFunc(trustHandler.RevokeTrust)))
-	mux.Handle("GET /v1/profile/trust", authMiddleware(http.HandlerFunc(trustHandler.GetTrustProfile)))
-	mux.Handle("GET /v1/irl", authMiddleware(http.HandlerFunc(trustHandler.GetIRLConnections)))
-	mux.Handle("POST /v1/irl/{userId}", authMiddleware(http.HandlerFunc(trustHandler.ConfirmIRL)))
+	v1.Handle("GET /trust", authMiddleware(http.HandlerFunc(trustHandler.GetTrustedUsers)))
+	v1.Handle("GET /trust/{userId}", authMiddleware(legalHoldAudit(http.HandlerFunc(trustHandler.GetTrustSummary))))
+	v1.Handle("POST /trust/{userId}", authMiddleware(legalHoldAudit(http.HandlerFunc(trustHandler.GrantTrust))))
+	v1.Handle("DELETE /trust/{userId}", authMiddleware(legalHoldAudit(http.HandlerFunc(trustHandler.RevokeTrust))))
+	v1.Handle("GET /profile/trust", authMiddleware(http.HandlerFunc(trustHandler.GetTrustProfile)))
+	v1.Handle("GET /irl", authMiddleware(http.HandlerFunc(trustHandler.GetIRLConnections)))
+	v1.Handle("POST /irl/{userId}", authMiddleware(legalHoldAudit(http.HandlerFunc(trustHandler.ConfirmIRL))))
 
 	// TODO: Rideshare endpoints (renamed from Commute) - needs rideshareHandler
-	// mux.Handle("GET /v1/rideshares", authMiddleware(http.HandlerFunc(rideshareHandler.GetUserRideshares)))
+	// v1.Handle("GET /rideshares", authMiddleware(http.HandlerFunc(rideshareHandler.GetUserRideshares)))
 	// ... etc
 
 	// Pool endpoints (guild-scoped)
-	mux.Handle("GET /v1/guilds/{guildId}/pools", authMiddleware(http.HandlerFunc(poolHandler.ListPools)))
-	mux.Handle("POST /v1/guilds/{guildId}/pools", authMiddleware(http.HandlerFunc(poolHandler.CreatePool)))
-	mux.Handle("GET /v1/guilds/{guildId}/pools/{poolId}", authMiddleware(http.HandlerFunc(poolHandler.GetPool)))
-	mux.Handle("PATCH /v1/guilds/{guildId}/pools/{poolId}", authMiddleware(http.HandlerFunc(poolHandler.UpdatePool)))
-	mux.Handle("DELETE /v1/guilds/{guildId}/pools/{poolId}", authMiddleware(http.HandlerFunc(poolHandler.DeletePool)))
-	mux.Handle("POST /v1/guilds/{guildId}/pools/{poolId}/join", authMiddleware(http.HandlerFunc(poolHandler.JoinPool)))
-	mux.Handle("POST /v1/guilds/{guildId}/pools/{poolId}/leave", authMiddleware(http.HandlerFunc(poolHandler.LeavePool)))
-	mux.Handle("GET /v1/guilds/{guildId}/pools/{poolId}/members", authMiddleware(http.HandlerFunc(poolHandler.GetPoolMembers)))
-	mux.Handle("PATCH /v1/guilds/{guildId}/pools/{poolId}/membership", authMiddleware(http.HandlerFunc(poolHandler.UpdateMembership)))
-	mux.Handle("GET /v1/guilds/{guildId}/pools/{poolId}/stats", authMiddleware(http.HandlerFunc(poolHandler.GetPoolStats)))
-	mux.Handle("GET /v1/guilds/{guildId}/pools/{poolId}/matches", authMiddleware(http.HandlerFunc(poolHandler.GetMatchHistory)))
+	v1.Handle("GET /guilds/{guildId}/pools", authMiddleware(http.HandlerFunc(poolHandler.ListPools)))
+	v1.Handle("POST /guilds/{guildId}/pools", authMiddleware(managePools(http.HandlerFunc(poolHandler.CreatePool))))
+	v1.Handle("GET /guilds/{guildId}/pools/{poolId}", authMiddleware(http.HandlerFunc(poolHandler.GetPool)))
+	v1.Handle("PATCH /guilds/{guildId}/pools/{poolId}", authMiddleware(managePools(http.HandlerFunc(poolHandler.UpdatePool))))
+	v1.Handle("DELETE /guilds/{guildId}/pools/{poolId}", authMiddleware(managePools(http.HandlerFunc(poolHandler.DeletePool))))
+	v1.Handle("POST /guilds/{guildId}/pools/{poolId}/join", authMiddleware(http.HandlerFunc(poolHandler.JoinPool)))
+	v1.Handle("POST /guilds/{guildId}/pools/{poolId}/leave", authMiddleware(http.HandlerFunc(poolHandler.LeavePool)))
+	v1.Handle("GET /guilds/{guildId}/pools/{poolId}/members", authMiddleware(http.HandlerFunc(poolHandler.GetPoolMembers)))
+	v1.Handle("PATCH /guilds/{guildId}/pools/{poolId}/membership", authMiddleware(http.HandlerFunc(poolHandler.UpdateMembership)))
+	v1.Handle("GET /guilds/{guildId}/pools/{poolId}/stats", authMiddleware(http.HandlerFunc(poolHandler.GetPoolStats)))
+	v1.Handle("GET /guilds/{guildId}/pools/{poolId}/matches", authMiddleware(http.HandlerFunc(poolHandler.GetMatchHistory)))
 
 	// Pool matching endpoints (user-scoped)
-	mux.Handle("GET /v1/profile/matches/pending", authMiddleware(http.HandlerFunc(poolHandler.GetPendingMatches)))
-	mux.Handle("PATCH /v1/matches/{matchId}", authMiddleware(http.HandlerFunc(poolHandler.UpdateMatch)))
+	v1.Handle("GET /profile/matches/pending", authMiddleware(http.HandlerFunc(poolHandler.GetPendingMatches)))
+	v1.Handle("PATCH /matches/{matchId}", authMiddleware(http.HandlerFunc(poolHandler.UpdateMatch)))
 
 	// Trust Rating endpoints
-	mux.Handle("POST /v1/trust-ratings", authMiddleware(http.HandlerFunc(trustRatingHandler.Create)))
-	mux.Handle("GET /v1/trust-ratings/{ratingId}", authMiddleware(http.HandlerFunc(trustRatingHandler.GetByID)))
-	mux.Handle("PATCH /v1/trust-ratings/{ratingId}", authMiddleware(http.HandlerFunc(trustRatingHandler.Update)))
-	mux.Handle("DELETE /v1/trust-ratings/{ratingId}", authMiddleware(http.HandlerFunc(trustRatingHandler.Delete)))
-	mux.Handle("GET /v1/users/{userId}/trust-ratings/received", authMiddleware(http.HandlerFunc(trustRatingHandler.GetReceivedRatings)))
-	mux.Handle("GET /v1/users/{userId}/trust-ratings/given", authMiddleware(http.HandlerFunc(trustRatingHandler.GetGivenRatings)))
-	mux.Handle("GET /v1/users/{userId}/trust-aggregate", authMiddleware(http.HandlerFunc(trustRatingHandler.GetAggregate)))
-	mux.Handle("POST /v1/trust-ratings/{ratingId}/endorsements", authMiddleware(http.HandlerFunc(trustRatingHandler.CreateEndorsement)))
-	mux.Handle("GET /v1/trust-ratings/{ratingId}/endorsements", authMiddleware(http.HandlerFunc(trustRatingHandler.GetEndorsements)))
-	mux.Handle("GET /v1/admin/distrust-signals", adminMiddleware(http.HandlerFunc(trustRatingHandler.GetDistrustSignals)))
+	v1.Handle("POST /trust-ratings", authMiddleware(http.HandlerFunc(trustRatingHandler.Create)))
+	v1.Handle("GET /trust-ratings/{ratingId}", authMiddleware(http.HandlerFunc(trustRatingHandler.GetByID)))
+	v1.Handle("PATCH /trust-ratings/{ratingId}", authMiddleware(http.HandlerFunc(trustRatingHandler.Update)))
+	v1.Handle("DELETE /trust-ratings/{ratingId}", authMiddleware(http.HandlerFunc(trustRatingHandler.Delete)))
+	v1.Handle("GET /users/{userId}/trust-ratings/received", authMiddleware(legalHoldAudit(http.HandlerFunc(trustRatingHandler.GetReceivedRatings))))
+	v1.Handle("GET /users/{userId}/trust-ratings/given", authMiddleware(legalHoldAudit(http.HandlerFunc(trustRatingHandler.GetGivenRatings))))
+	v1.Handle("GET /users/{userId}/trust-aggregate", authMiddleware(legalHoldAudit(http.HandlerFunc(trustRatingHandler.GetAggregate))))
+	v1.Handle("POST /trust-ratings/{ratingId}/endorsements", authMiddleware(http.HandlerFunc(trustRatingHandler.CreateEndorsement)))
+	v1.Handle("GET /trust-ratings/{ratingId}/endorsements", authMiddleware(http.HandlerFunc(trustRatingHandler.GetEndorsements)))
+	admin.Handle("GET /distrust-signals", adminMiddleware(http.HandlerFunc(trustRatingHandler.GetDistrustSignals)))
 
 	// Role Catalog endpoints - Guild catalogs
-	mux.Handle("GET /v1/guilds/{guildId}/role-catalogs", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetGuildCatalogs)))
-	mux.Handle("POST /v1/guilds/{guildId}/role-catalogs", authMiddleware(http.HandlerFunc(roleCatalogHandler.CreateGuildCatalog)))
+	v1.Handle("GET /guilds/{guildId}/role-catalogs", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetGuildCatalogs)))
+	v1.Handle("POST /guilds/{guildId}/role-catalogs", authMiddleware(http.HandlerFunc(roleCatalogHandler.CreateGuildCatalog)))
 	// Role Catalog endpoints - User catalogs
-	mux.Handle("GET /v1/users/me/role-catalogs", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetUserCatalogs)))
-	mux.Handle("POST /v1/users/me/role-catalogs", authMiddleware(http.HandlerFunc(roleCatalogHandler.CreateUserCatalog)))
+	v1.Handle("GET /users/me/role-catalogs", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetUserCatalogs)))
+	v1.Handle("POST /users/me/role-catalogs", authMiddleware(http.HandlerFunc(roleCatalogHandler.CreateUserCatalog)))
 	// Role Catalog endpoints - Common
-	mux.Handle("GET /v1/role-catalogs/{catalogId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetCatalogByID)))
-	mux.Handle("PATCH /v1/role-catalogs/{catalogId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.UpdateCatalog)))
-	mux.Handle("DELETE /v1/role-catalogs/{catalogId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.DeleteCatalog)))
+	v1.Handle("GET /role-catalogs/{catalogId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetCatalogByID)))
+	v1.Handle("PATCH /role-catalogs/{catalogId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.UpdateCatalog)))
+	v1.Handle("DELETE /role-catalogs/{catalogId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.DeleteCatalog)))
 	// Rideshare role endpoints
-	mux.Handle("GET /v1/rideshares/{rideshareId}/roles", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetRideshareRoles)))
-	mux.Handle("POST /v1/rideshares/{rideshareId}/roles", authMiddleware(http.HandlerFunc(roleCatalogHandler.CreateRideshareRole)))
-	mux.Handle("GET /v1/rideshares/{rideshareId}/roles/detailed", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetRideshareRolesWithAssignments)))
-	mux.Handle("PATCH /v1/rideshares/{rideshareId}/roles/{roleId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.UpdateRideshareRole)))
-	mux.Handle("DELETE /v1/rideshares/{rideshareId}/roles/{roleId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.DeleteRideshareRole)))
-	mux.Handle("POST /v1/rideshares/{rideshareId}/roles/assign", authMiddleware(http.HandlerFunc(roleCatalogHandler.AssignRideshareRole)))
-	mux.Handle("DELETE /v1/rideshares/{rideshareId}/roles/assignments/{assignmentId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.UnassignRideshareRole)))
-	mux.Handle("GET /v1/rideshares/{rideshareId}/my-roles", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetUserRideshareRoles)))
+	v1.Handle("GET /rideshares/{rideshareId}/roles", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetRideshareRoles)))
+	v1.Handle("POST /rideshares/{rideshareId}/roles", authMiddleware(http.HandlerFunc(roleCatalogHandler.CreateRideshareRole)))
+	v1.Handle("GET /rideshares/{rideshareId}/roles/detailed", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetRideshareRolesWithAssignments)))
+	v1.Handle("PATCH /rideshares/{rideshareId}/roles/{roleId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.UpdateRideshareRole)))
+	v1.Handle("DELETE /rideshares/{rideshareId}/roles/{roleId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.DeleteRideshareRole)))
+	v1.Handle("POST /rideshares/{rideshareId}/roles/assign", authMiddleware(http.HandlerFunc(roleCatalogHandler.AssignRideshareRole)))
+	v1.Handle("DELETE /rideshares/{rideshareId}/roles/assignments/{assignmentId}", authMiddleware(http.HandlerFunc(roleCatalogHandler.UnassignRideshareRole)))
+	v1.Handle("GET /rideshares/{rideshareId}/my-roles", authMiddleware(http.HandlerFunc(roleCatalogHandler.GetUserRideshareRoles)))
 
 	// Adventure endpoints
-	mux.Handle("POST /v1/adventures", authMiddleware(http.HandlerFunc(adventureHandler.Create)))
-	mux.Handle("GET /v1/adventures/{adventureId}", authMiddleware(http.HandlerFunc(adventureHandler.GetByID)))
-	mux.Handle("GET /v1/guilds/{guildId}/adventures", authMiddleware(http.HandlerFunc(adventureHandler.ListGuildAdventures)))
-	mux.Handle("POST /v1/guilds/{guildId}/adventures", authMiddleware(http.HandlerFunc(adventureHandler.CreateGuildAdventure)))
-	mux.Handle("POST /v1/users/me/adventures", authMiddleware(http.HandlerFunc(adventureHandler.CreateUserAdventure)))
+	v1.Handle("POST /adventures", authMiddleware(http.HandlerFunc(adventureHandler.Create)))
+	v1.Handle("GET /adventures/{adventureId}", authMiddleware(http.HandlerFunc(adventureHandler.GetByID)))
+	v1.Handle("GET /guilds/{guildId}/adventures", authMiddleware(http.HandlerFunc(adventureHandler.ListGuildAdventures)))
+	v1.Handle("POST /guilds/{guildId}/adventures", authMiddleware(http.HandlerFunc(adventureHandler.CreateGuildAdventure)))
+	v1.Handle("POST /users/me/adventures", authMiddleware(http.HandlerFunc(adventureHandler.CreateUserAdventure)))
 	// Adventure admission endpoints
-	mux.Handle("POST /v1/adventures/{adventureId}/admission/request", authMiddleware(http.HandlerFunc(adventureHandler.RequestAdmission)))
-	mux.Handle("GET /v1/adventures/{adventureId}/admission", authMiddleware(http.HandlerFunc(adventureHandler.GetAdmission)))
-	mux.Handle("DELETE /v1/adventures/{adventureId}/admission", authMiddleware(http.HandlerFunc(adventureHandler.WithdrawAdmission)))
-	mux.Handle("GET /v1/adventures/{adventureId}/admitted", authMiddleware(http.HandlerFunc(adventureHandler.CheckAdmission)))
+	v1.Handle("POST /adventures/{adventureId}/admission/request", authMiddleware(http.HandlerFunc(adventureHandler.RequestAdmission)))
+	v1.Handle("GET /adventures/{adventureId}/admission", authMiddleware(http.HandlerFunc(adventureHandler.GetAdmission)))
+	v1.Handle("DELETE /adventures/{adventureId}/admission", authMiddleware(http.HandlerFunc(adventureHandler.WithdrawAdmission)))
+	v1.Handle("GET /adventures/{adventureId}/admitted", authMiddleware(http.HandlerFunc(adventureHandler.CheckAdmission)))
 	// Adventure admission management
-	mux.Handle("GET /v1/adventures/{adventureId}/admissions", authMiddleware(http.HandlerFunc(adventureHandler.GetAdmissions)))
-	mux.Handle("GET /v1/adventures/{adventureId}/admissions/pending", authMiddleware(http.HandlerFunc(adventureHandler.GetPendingAdmissions)))
-	mux.Handle("POST /v1/adventures/{adventureId}/admissions/{userId}/respond", authMiddleware(http.HandlerFunc(adventureHandler.RespondToAdmission)))
-	mux.Handle("POST /v1/adventures/{adventureId}/admissions/invite", authMiddleware(http.HandlerFunc(adventureHandler.InviteToAdventure)))
+	v1.Handle("GET /adventures/{adventureId}/admissions", authMiddleware(http.HandlerFunc(adventureHandler.GetAdmissions)))
+	v1.Handle("GET /adventures/{adventureId}/admissions/pending", authMiddleware(http.HandlerFunc(adventureHandler.GetPendingAdmissions)))
+	v1.Handle("POST /adventures/{adventureId}/admissions/{userId}/respond", authMiddleware(legalHoldAudit(http.HandlerFunc(adventureHandler.RespondToAdmission))))
+	v1.Handle("POST /adventures/{adventureId}/admissions/invite", authMiddleware(http.HandlerFunc(adventureHandler.InviteToAdventure)))
 	// Adventure organizer management
-	mux.Handle("POST /v1/adventures/{adventureId}/transfer", authMiddleware(http.HandlerFunc(adventureHandler.TransferAdventure)))
-	mux.Handle("POST /v1/adventures/{adventureId}/unfreeze", authMiddleware(http.HandlerFunc(adventureHandler.UnfreezeAdventure)))
+	v1.Handle("POST /adventures/{adventureId}/transfer", authMiddleware(http.HandlerFunc(adventureHandler.TransferAdventure)))
+	v1.Handle("POST /adventures/{adventureId}/unfreeze", authMiddleware(http.HandlerFunc(adventureHandler.UnfreezeAdventure)))
+
+	// Share link endpoints (resolution is public so links can be unfurled)
+	v1.Handle("POST /share-links", authMiddleware(http.HandlerFunc(shareLinkHandler.Create)))
+	v1.HandleFunc("GET /share-links/{token}", shareLinkHandler.Resolve)
+	v1.Handle("DELETE /share-links/{token}", authMiddleware(http.HandlerFunc(shareLinkHandler.Revoke)))
+	v1.Handle("POST /share-links/{token}/accept", authMiddleware(http.HandlerFunc(shareLinkHandler.Accept)))
+	v1.HandleFunc("GET /preview/{token}", shareLinkHandler.Preview)
+	mux.HandleFunc("GET /s/{token}", shareLinkHandler.Page)
+
+	// Announcement endpoints (guild admins compose, members read)
+	v1.Handle("POST /guilds/{guildId}/announcements", authMiddleware(http.HandlerFunc(announcementHandler.GuildCreate)))
+	v1.Handle("GET /guilds/{guildId}/announcements", authMiddleware(http.HandlerFunc(announcementHandler.GuildList)))
+	v1.Handle("GET /guilds/{guildId}/announcements/{announcementId}", authMiddleware(http.HandlerFunc(announcementHandler.GuildGet)))
+	v1.Handle("PATCH /guilds/{guildId}/announcements/{announcementId}", authMiddleware(http.HandlerFunc(announcementHandler.GuildUpdate)))
+	v1.Handle("DELETE /guilds/{guildId}/announcements/{announcementId}", authMiddleware(http.HandlerFunc(announcementHandler.GuildDelete)))
+	v1.Handle("POST /guilds/{guildId}/announcements/{announcementId}/send", authMiddleware(http.HandlerFunc(announcementHandler.GuildSend)))
+	// Announcement inbox
+	v1.Handle("GET /announcements", authMiddleware(http.HandlerFunc(announcementHandler.Inbox)))
+	v1.Handle("POST /announcements/{announcementId}/read", authMiddleware(http.HandlerFunc(announcementHandler.MarkRead)))
+	v1.Handle("PUT /announcements/{announcementId}/reactions", authMiddleware(reactionLimit(http.HandlerFunc(reactionHandler.ReactToAnnouncement))))
+	v1.Handle("DELETE /announcements/{announcementId}/reactions", authMiddleware(reactionLimit(http.HandlerFunc(reactionHandler.UnreactToAnnouncement))))
+
+	// Draft endpoints (autosave for event and adventure creation)
+	v1.Handle("POST /drafts", authMiddleware(http.HandlerFunc(draftHandler.Create)))
+	v1.Handle("GET /drafts", authMiddleware(http.HandlerFunc(draftHandler.List)))
+	v1.Handle("GET /drafts/{draftId}", authMiddleware(http.HandlerFunc(draftHandler.Get)))
+	v1.Handle("PUT /drafts/{draftId}", authMiddleware(http.HandlerFunc(draftHandler.Update)))
+	v1.Handle("DELETE /drafts/{draftId}", authMiddleware(http.HandlerFunc(draftHandler.Delete)))
+	v1.Handle("POST /drafts/{draftId}/submit", authMiddleware(http.HandlerFunc(draftHandler.Submit)))
+
+	// Undo endpoint (destructive actions return an operation to undo within the window)
+	v1.Handle("POST /undo/{operationId}", authMiddleware(http.HandlerFunc(undoHandler.Undo)))
+
+	// Guild trash endpoints (deleted events, pools and votes, restorable for 30 days)
+	v1.Handle("GET /guilds/{guildId}/trash", authMiddleware(http.HandlerFunc(trashHandler.List)))
+	v1.Handle("POST /guilds/{guildId}/trash/{itemId}/restore", authMiddleware(http.HandlerFunc(trashHandler.Restore)))
+
+	// Consent endpoints
+	v1.Handle("GET /consents", authMiddleware(http.HandlerFunc(consentHandler.List)))
+	v1.Handle("GET /consents/history", authMiddleware(http.HandlerFunc(consentHandler.History)))
+	v1.Handle("PUT /consents/{purpose}", authMiddleware(http.HandlerFunc(consentHandler.Grant)))
+	v1.Handle("DELETE /consents/{purpose}", authMiddleware(http.HandlerFunc(consentHandler.Withdraw)))
 
 	// Vote endpoints
-	mux.Handle("POST /v1/votes", authMiddleware(http.HandlerFunc(voteHandler.Create)))
-	mux.Handle("GET /v1/votes/{voteId}", authMiddleware(http.HandlerFunc(voteHandler.GetByID)))
-	mux.Handle("PATCH /v1/votes/{voteId}", authMiddleware(http.HandlerFunc(voteHandler.Update)))
-	mux.Handle("DELETE /v1/votes/{voteId}", authMiddleware(http.HandlerFunc(voteHandler.Delete)))
-	mux.Handle("POST /v1/votes/{voteId}/open", authMiddleware(http.HandlerFunc(voteHandler.Open)))
-	mux.Handle("POST /v1/votes/{voteId}/close", authMiddleware(http.HandlerFunc(voteHandler.Close)))
-	mux.Handle("POST /v1/votes/{voteId}/cancel", authMiddleware(http.HandlerFunc(voteHandler.Cancel)))
+	v1.Handle("POST /votes", authMiddleware(http.HandlerFunc(voteHandler.Create)))
+	v1.Handle("GET /votes/{voteId}", authMiddleware(http.HandlerFunc(voteHandler.GetByID)))
+	v1.Handle("PATCH /votes/{voteId}", authMiddleware(http.HandlerFunc(voteHandler.Update)))
+	v1.Handle("DELETE /votes/{voteId}", authMiddleware(http.HandlerFunc(voteHandler.Delete)))
+	v1.Handle("POST /votes/{voteId}/open", authMiddleware(http.HandlerFunc(voteHandler.Open)))
+	v1.Handle("POST /votes/{voteId}/close", authMiddleware(http.HandlerFunc(voteHandler.Close)))
+	v1.Handle("POST /votes/{voteId}/cancel", authMiddleware(http.HandlerFunc(voteHandler.Cancel)))
+	v1.Handle("PUT /votes/{voteId}/schedule", authMiddleware(http.HandlerFunc(voteHandler.SchedulePublication)))
 	// Vote option endpoints
-	mux.Handle("GET /v1/votes/{voteId}/options", authMiddleware(http.HandlerFunc(voteHandler.GetOptions)))
-	mux.Handle("POST /v1/votes/{voteId}/options", authMiddleware(http.HandlerFunc(voteHandler.CreateOption)))
-	mux.Handle("POST /v1/votes/{voteId}/options/batch", authMiddleware(http.HandlerFunc(voteHandler.BatchCreateOptions)))
-	mux.Handle("PATCH /v1/votes/{voteId}/options/{optionId}", authMiddleware(http.HandlerFunc(voteHandler.UpdateOption)))
-	mux.Handle("DELETE /v1/votes/{voteId}/options/{optionId}", authMiddleware(http.HandlerFunc(voteHandler.DeleteOption)))
+	v1.Handle("GET /votes/{voteId}/options", authMiddleware(http.HandlerFunc(voteHandler.GetOptions)))
+	v1.Handle("POST /votes/{voteId}/options", authMiddleware(http.HandlerFunc(voteHandler.CreateOption)))
+	v1.Handle("POST /votes/{voteId}/options/batch", authMiddleware(http.HandlerFunc(voteHandler.BatchCreateOptions)))
+	v1.Handle("PATCH /votes/{voteId}/options/{optionId}", authMiddleware(http.HandlerFunc(voteHandler.UpdateOption)))
+	v1.Handle("DELETE /votes/{voteId}/options/{optionId}", authMiddleware(http.HandlerFunc(voteHandler.DeleteOption)))
 	// Vote ballot endpoints
-	mux.Handle("POST /v1/votes/{voteId}/ballot", authMiddleware(http.HandlerFunc(voteHandler.CastBallot)))
-	mux.Handle("GET /v1/votes/{voteId}/ballot", authMiddleware(http.HandlerFunc(voteHandler.GetMyBallot)))
-	mux.Handle("GET /v1/votes/{voteId}/ballots", authMiddleware(http.HandlerFunc(voteHandler.GetBallots)))
+	v1.Handle("POST /votes/{voteId}/ballot", authMiddleware(http.HandlerFunc(voteHandler.CastBallot)))
+	v1.Handle("GET /votes/{voteId}/ballot", authMiddleware(http.HandlerFunc(voteHandler.GetMyBallot)))
+	v1.Handle("GET /votes/{voteId}/ballots", authMiddleware(http.HandlerFunc(voteHandler.GetBallots)))
 	// Vote results endpoints
-	mux.Handle("GET /v1/votes/{voteId}/results", authMiddleware(http.HandlerFunc(voteHandler.GetResults)))
-	mux.Handle("GET /v1/votes/{voteId}/stats", authMiddleware(http.HandlerFunc(voteHandler.GetVoteStats)))
+	v1.Handle("GET /votes/{voteId}/results", authMiddleware(http.HandlerFunc(voteHandler.GetResults)))
+	v1.Handle("GET /votes/{voteId}/stats", authMiddleware(http.HandlerFunc(voteHandler.GetVoteStats)))
 	// Vote scoped query endpoints
-	mux.Handle("GET /v1/guilds/{guildId}/votes", authMiddleware(http.HandlerFunc(voteHandler.GetGuildVotes)))
-	mux.Handle("GET /v1/votes/global", authMiddleware(http.HandlerFunc(voteHandler.GetGlobalVotes)))
+	v1.Handle("GET /guilds/{guildId}/votes", authMiddleware(http.HandlerFunc(voteHandler.GetGuildVotes)))
+	v1.Handle("GET /votes/global", authMiddleware(http.HandlerFunc(voteHandler.GetGlobalVotes)))
 
 	// Admin seeder endpoints (for development/testing) - requires admin role
-	mux.Handle("GET /v1/admin/seed/scenarios", adminMiddleware(http.HandlerFunc(adminSeederHandler.ListScenarios)))
-	mux.Handle("POST /v1/admin/seed/users", adminMiddleware(http.HandlerFunc(adminSeederHandler.SeedUsers)))
-	mux.Handle("POST /v1/admin/seed/guilds", adminMiddleware(http.HandlerFunc(adminSeederHandler.SeedGuilds)))
-	mux.Handle("POST /v1/admin/seed/events", adminMiddleware(http.HandlerFunc(adminSeederHandler.SeedEvents)))
-	mux.Handle("POST /v1/admin/seed/scenario", adminMiddleware(http.HandlerFunc(adminSeederHandler.SeedScenario)))
-	mux.Handle("DELETE /v1/admin/seed/cleanup", adminMiddleware(http.HandlerFunc(adminSeederHandler.Cleanup)))
+	admin.Handle("GET /seed/scenarios", adminMiddleware(http.HandlerFunc(adminSeederHandler.ListScenarios)))
+	admin.Handle("POST /seed/users", adminMiddleware(http.HandlerFunc(adminSeederHandler.SeedUsers)))
+	admin.Handle("POST /seed/guilds", adminMiddleware(http.HandlerFunc(adminSeederHandler.SeedGuilds)))
+	admin.Handle("POST /seed/events", adminMiddleware(http.HandlerFunc(adminSeederHandler.SeedEvents)))
+	admin.Handle("POST /seed/scenario", adminMiddleware(http.HandlerFunc(adminSeederHandler.SeedScenario)))
+	admin.Handle("DELETE /seed/cleanup", adminMiddleware(http.HandlerFunc(adminSeederHandler.Cleanup)))
 
 	// Admin user management endpoints - requires admin role
-	mux.Handle("GET /v1/admin/users", adminMiddleware(http.HandlerFunc(adminUsersHandler.ListUsers)))
-	mux.Handle("GET /v1/admin/users/{userId}", adminMiddleware(http.HandlerFunc(adminUsersHandler.GetUser)))
-	mux.Handle("PATCH /v1/admin/users/{userId}/role", adminMiddleware(http.HandlerFunc(adminUsersHandler.UpdateRole)))
-	mux.Handle("DELETE /v1/admin/users/{userId}", adminMiddleware(http.HandlerFunc(adminUsersHandler.DeleteUser)))
+	admin.Handle("GET /users", adminMiddleware(http.HandlerFunc(adminUsersHandler.ListUsers)))
+	admin.Handle("GET /users/{userId}", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminUsersHandler.GetUser))))
+	admin.Handle("PATCH /users/{userId}/role", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminUsersHandler.UpdateRole))))
+	admin.Handle("DELETE /users/{userId}", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminUsersHandler.DeleteUser))))
+	admin.Handle("GET /users/{userId}/security-events", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminSecurityEventHandler.List))))
+	admin.Handle("POST /users/{userId}/signup-review", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminSignupRiskHandler.Review))))
+	admin.Handle("GET /signup-reviews", adminMiddleware(http.HandlerFunc(adminSignupRiskHandler.ListFlagged)))
+
+	// Scraper defense endpoints - requires admin role
+	admin.Handle("GET /scraper-detections", adminMiddleware(http.HandlerFunc(adminScraperHandler.ListDetections)))
+	admin.Handle("GET /tarpit", adminMiddleware(http.HandlerFunc(adminScraperHandler.ListTarpit)))
+	admin.Handle("DELETE /tarpit/{ip}", adminMiddleware(http.HandlerFunc(adminScraperHandler.Release)))
+
+	// Network policy endpoints - requires admin role
+	admin.Handle("GET /network", adminMiddleware(http.HandlerFunc(adminNetworkHandler.Get)))
+	admin.Handle("POST /network/deny", adminMiddleware(http.HandlerFunc(adminNetworkHandler.Deny)))
+	admin.Handle("DELETE /network/deny/{entryId}", adminMiddleware(http.HandlerFunc(adminNetworkHandler.RemoveDeny)))
+	admin.Handle("GET /network/blocked-attempts", adminMiddleware(http.HandlerFunc(adminNetworkHandler.ListBlockedAttempts)))
+	admin.Handle("GET /client-errors", adminMiddleware(http.HandlerFunc(clientErrorHandler.List)))
+	admin.Handle("GET /client-errors/summary", adminMiddleware(http.HandlerFunc(clientErrorHandler.Summary)))
+
+	// Legal hold endpoints - requires superadmin role
+	admin.Handle("GET /users/{userId}/legal-hold", superAdminMiddleware(http.HandlerFunc(adminLegalHoldHandler.Get)))
+	admin.Handle("PUT /users/{userId}/legal-hold", superAdminMiddleware(http.HandlerFunc(adminLegalHoldHandler.Place)))
+	admin.Handle("DELETE /users/{userId}/legal-hold", superAdminMiddleware(http.HandlerFunc(adminLegalHoldHandler.Release)))
+	admin.Handle("GET /users/{userId}/legal-hold/access-log", superAdminMiddleware(http.HandlerFunc(adminLegalHoldHandler.AccessLog)))
+
+	// Request quota endpoints - requires admin role
+	admin.Handle("GET /quotas", adminMiddleware(http.HandlerFunc(adminQuotaHandler.Top)))
+	admin.Handle("GET /users/{userId}/quota", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminQuotaHandler.Get))))
+	admin.Handle("PUT /users/{userId}/quota", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminQuotaHandler.SetLimit))))
+	admin.Handle("DELETE /users/{userId}/quota", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminQuotaHandler.ClearLimit))))
+	admin.Handle("POST /users/{userId}/quota/reset", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminQuotaHandler.ResetUsage))))
+
+	// Background admin report endpoints - requires admin role
+	admin.Handle("POST /reports", adminMiddleware(http.HandlerFunc(adminReportHandler.Create)))
+	admin.Handle("GET /reports", adminMiddleware(http.HandlerFunc(adminReportHandler.List)))
+	admin.Handle("GET /reports/{reportId}", adminMiddleware(http.HandlerFunc(adminReportHandler.Get)))
+	admin.Handle("POST /import/users", adminMiddleware(http.HandlerFunc(adminUserImportHandler.Create)))
+	admin.Handle("GET /import/users", adminMiddleware(http.HandlerFunc(adminUserImportHandler.List)))
+	admin.Handle("GET /import/users/{importId}", adminMiddleware(http.HandlerFunc(adminUserImportHandler.Get)))
+	admin.Handle("POST /import/guilds", adminMiddleware(http.HandlerFunc(adminGuildImportHandler.Create)))
+	admin.Handle("GET /import/guilds", adminMiddleware(http.HandlerFunc(adminGuildImportHandler.List)))
+	admin.Handle("GET /import/guilds/{importId}", adminMiddleware(http.HandlerFunc(adminGuildImportHandler.Get)))
+	admin.Handle("POST /import/guilds/{importId}/commit", adminMiddleware(http.HandlerFunc(adminGuildImportHandler.Commit)))
 
 	// Admin discovery lab endpoints - requires admin role
-	mux.Handle("GET /v1/admin/discovery/users", adminMiddleware(http.HandlerFunc(adminDiscoveryHandler.GetUsersWithLocations)))
-	mux.Handle("POST /v1/admin/discovery/simulate", adminMiddleware(http.HandlerFunc(adminDiscoveryHandler.SimulateDiscovery)))
-	mux.Handle("GET /v1/admin/discovery/compatibility/{userAId}/{userBId}", adminMiddleware(http.HandlerFunc(adminDiscoveryHandler.GetCompatibility)))
+	admin.Handle("GET /discovery/users", adminMiddleware(http.HandlerFunc(adminDiscoveryHandler.GetUsersWithLocations)))
+	admin.Handle("POST /discovery/simulate", adminMiddleware(http.HandlerFunc(adminDiscoveryHandler.SimulateDiscovery)))
+	admin.Handle("GET /discovery/compatibility/{userAId}/{userBId}", adminMiddleware(legalHoldAudit(http.HandlerFunc(adminDiscoveryHandler.GetCompatibility))))
 
 	// Admin action endpoints (for triggering events as users) - requires admin role
-	mux.Handle("GET /v1/admin/actions/users", adminMiddleware(http.HandlerFunc(adminActionsHandler.GetUsers)))
-	mux.Handle("GET /v1/admin/actions/guilds", adminMiddleware(http.HandlerFunc(adminActionsHandler.GetGuilds)))
-	mux.Handle("GET /v1/admin/actions/events", adminMiddleware(http.HandlerFunc(adminActionsHandler.GetEvents)))
-	mux.Handle("POST /v1/admin/actions/location", adminMiddleware(http.HandlerFunc(adminActionsHandler.UpdateLocation)))
-	mux.Handle("POST /v1/admin/actions/trust-rating", adminMiddleware(http.HandlerFunc(adminActionsHandler.CreateTrustRating)))
-	mux.Handle("POST /v1/admin/actions/guild-join", adminMiddleware(http.HandlerFunc(adminActionsHandler.JoinGuild)))
-	mux.Handle("POST /v1/admin/actions/rsvp", adminMiddleware(http.HandlerFunc(adminActionsHandler.RSVP)))
-	mux.Handle("POST /v1/admin/actions/event-create", adminMiddleware(http.HandlerFunc(adminActionsHandler.CreateEvent)))
+	admin.Handle("GET /actions/users", adminMiddleware(http.HandlerFunc(adminActionsHandler.GetUsers)))
+	admin.Handle("GET /actions/guilds", adminMiddleware(http.HandlerFunc(adminActionsHandler.GetGuilds)))
+	admin.Handle("GET /actions/events", adminMiddleware(http.HandlerFunc(adminActionsHandler.GetEvents)))
+	admin.Handle("POST /actions/location", adminMiddleware(http.HandlerFunc(adminActionsHandler.UpdateLocation)))
+	admin.Handle("POST /actions/trust-rating", adminMiddleware(http.HandlerFunc(adminActionsHandler.CreateTrustRating)))
+	admin.Handle("POST /actions/guild-join", adminMiddleware(http.HandlerFunc(adminActionsHandler.JoinGuild)))
+	admin.Handle("POST /actions/rsvp", adminMiddleware(http.HandlerFunc(adminActionsHandler.RSVP)))
+	admin.Handle("POST /actions/event-create", adminMiddleware(http.HandlerFunc(adminActionsHandler.CreateEvent)))
+
+	// Admin content endpoints - questionnaire, review tag and hangout type catalogs
+	admin.Handle("GET /questions", adminMiddleware(http.HandlerFunc(adminContentHandler.ListQuestions)))
+	admin.Handle("POST /questions", adminMiddleware(http.HandlerFunc(adminContentHandler.CreateQuestion)))
+	admin.Handle("GET /questions/{questionId}", adminMiddleware(http.HandlerFunc(adminContentHandler.GetQuestion)))
+	admin.Handle("PATCH /questions/{questionId}", adminMiddleware(http.HandlerFunc(adminContentHandler.UpdateQuestion)))
+	admin.Handle("DELETE /questions/{questionId}", adminMiddleware(http.HandlerFunc(adminContentHandler.DeleteQuestion)))
+	admin.Handle("GET /question-categories", adminMiddleware(http.HandlerFunc(adminContentHandler.ListQuestionCategories)))
+	admin.Handle("POST /question-categories", adminMiddleware(http.HandlerFunc(adminContentHandler.CreateQuestionCategory)))
+	admin.Handle("PATCH /question-categories/{key}", adminMiddleware(http.HandlerFunc(adminContentHandler.UpdateQuestionCategory)))
+	admin.Handle("DELETE /question-categories/{key}", adminMiddleware(http.HandlerFunc(adminContentHandler.DeleteQuestionCategory)))
+	admin.Handle("GET /review-tags/{kind}", adminMiddleware(http.HandlerFunc(adminContentHandler.ListReviewTags)))
+	admin.Handle("POST /review-tags/{kind}", adminMiddleware(http.HandlerFunc(adminContentHandler.CreateReviewTag)))
+	admin.Handle("PATCH /review-tags/{kind}/{tag}", adminMiddleware(http.HandlerFunc(adminContentHandler.UpdateReviewTag)))
+	admin.Handle("DELETE /review-tags/{kind}/{tag}", adminMiddleware(http.HandlerFunc(adminContentHandler.DeleteReviewTag)))
+	admin.Handle("GET /hangout-types", adminMiddleware(http.HandlerFunc(hangoutTypeHandler.AdminList)))
+	admin.Handle("POST /hangout-types", adminMiddleware(http.HandlerFunc(hangoutTypeHandler.AdminCreate)))
+	admin.Handle("PATCH /hangout-types/{type}", adminMiddleware(http.HandlerFunc(hangoutTypeHandler.AdminUpdate)))
+	admin.Handle("DELETE /hangout-types/{type}", adminMiddleware(http.HandlerFunc(hangoutTypeHandler.AdminDelete)))
+
+	// Admin remote config endpoints - client tunables served from /v1/meta/config
+	admin.Handle("GET /remote-config", adminMiddleware(http.HandlerFunc(adminRemoteConfigHandler.List)))
+	admin.Handle("PUT /remote-config/{key}", adminMiddleware(http.HandlerFunc(adminRemoteConfigHandler.Set)))
+	admin.Handle("DELETE /remote-config/{key}", adminMiddleware(http.HandlerFunc(adminRemoteConfigHandler.Delete)))
+
+	// Admin experiment endpoints - A/B tests served from /v1/meta/experiments
+	admin.Handle("GET /experiments", adminMiddleware(http.HandlerFunc(adminExperimentHandler.List)))
+	admin.Handle("POST /experiments", adminMiddleware(http.HandlerFunc(adminExperimentHandler.Create)))
+	admin.Handle("GET /experiments/{key}", adminMiddleware(http.HandlerFunc(adminExperimentHandler.Get)))
+	admin.Handle("PATCH /experiments/{key}", adminMiddleware(http.HandlerFunc(adminExperimentHandler.Update)))
+
+	// Admin announcement endpoints - platform-wide, guild or area broadcasts
+	admin.Handle("POST /announcements", adminMiddleware(http.HandlerFunc(announcementHandler.AdminCreate)))
+	admin.Handle("GET /announcements", adminMiddleware(http.HandlerFunc(announcementHandler.AdminList)))
+	admin.Handle("GET /announcements/{announcementId}", adminMiddleware(http.HandlerFunc(announcementHandler.AdminGet)))
+	admin.Handle("PATCH /announcements/{announcementId}", adminMiddleware(http.HandlerFunc(announcementHandler.AdminUpdate)))
+	admin.Handle("DELETE /announcements/{announcementId}", adminMiddleware(http.HandlerFunc(announcementHandler.AdminDelete)))
+	admin.Handle("POST /announcements/{announcementId}/send", adminMiddleware(http.HandlerFunc(announcementHandler.AdminSend)))
+
+	// Admin region endpoints (failover drills) - exempt from read-only mode
+	admin.Handle("GET /region", adminMiddleware(http.HandlerFunc(adminRegionHandler.Get)))
+	admin.Handle("PATCH /region", adminMiddleware(http.HandlerFunc(adminRegionHandler.Update)))
+
+	// Admin query stats endpoints - worst repository methods and recent slow queries
+	admin.Handle("GET /query-stats", adminMiddleware(http.HandlerFunc(adminQueryStatsHandler.Get)))
+	admin.Handle("DELETE /query-stats", adminMiddleware(http.HandlerFunc(adminQueryStatsHandler.Reset)))
+
+	// Admin analytics export - anonymized domain events as NDJSON
+	admin.Handle("GET /analytics/events/export", adminMiddleware(http.HandlerFunc(adminAnalyticsHandler.Export)))
+
+	// Admin win-back stats - return rates of nudged vs held-out at-risk users
+	admin.Handle("GET /win-back/stats", adminMiddleware(http.HandlerFunc(adminWinBackHandler.Stats)))
+
+	// Admin runtime diagnostics - pprof, expvar, snapshots and log level
+	// Note: CPU profiles and traces must be shorter than SERVER_WRITE_TIMEOUT
+	mux.Handle("GET /debug/pprof/", adminMiddleware(http.HandlerFunc(pprof.Index)))
+	mux.Handle("GET /debug/pprof/cmdline", adminMiddleware(http.HandlerFunc(pprof.Cmdline)))
+	mux.Handle("GET /debug/pprof/profile", adminMiddleware(http.HandlerFunc(pprof.Profile)))
+	mux.Handle("GET /debug/pprof/symbol", adminMiddleware(http.HandlerFunc(pprof.Symbol)))
+	mux.Handle("GET /debug/pprof/trace", adminMiddleware(http.HandlerFunc(pprof.Trace)))
+	mux.Handle("GET /debug/vars", adminMiddleware(expvar.Handler()))
+	admin.Handle("POST /diagnostics/snapshot", adminMiddleware(http.HandlerFunc(adminDiagnosticsHandler.Snapshot)))
+	admin.Handle("GET /diagnostics/log-level", adminMiddleware(http.HandlerFunc(adminDiagnosticsHandler.GetLogLevel)))
+	admin.Handle("PATCH /diagnostics/log-level", adminMiddleware(http.HandlerFunc(adminDiagnosticsHandler.UpdateLogLevel)))
 
 	// Moderation endpoints
-	moderationHandler.RegisterRoutes(mux)
+	moderationHandler.RegisterRoutes(v1)
 
 	// Apply global middleware
 	wrapped := middleware.Chain(
@@ -631,8 +1797,12 @@ func main() {
 		middleware.Logger,
 		middleware.Recovery,
 		middleware.CORS(cfg.Server.AllowedOrigins),
+		middleware.EnforceNetworkPolicy(networkPolicy),
+		middleware.Region(regionState, admin.Prefix()+"/"),
+		middleware.TarpitScrapers(tarpit),
 		middleware.RateLimit(rateLimiter),
-		middleware.Idempotency(idempotencyStore),
+		middleware.Quota(quotaLimiter, tokenService),
+		middleware.Idempotency(idempotencyStore, tokenService),
 		middleware.Compress,
 	)
 
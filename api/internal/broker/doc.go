@@ -0,0 +1,21 @@
+// Package broker provides pub/sub backends that fan EventHub events out
+// across API instances.
+//
+// Without a broker the EventHub is in-process only, so SSE clients connected
+// to one replica never see events published on another. A broker carries
+// opaque event payloads between replicas; the EventHub handles encoding and
+// ignores messages it published itself.
+//
+// # Backends
+//
+//   - memory (default): no broker, events stay in-process
+//   - redis: Redis Pub/Sub on a single channel
+//
+// # Usage Example
+//
+//	b, err := broker.NewRedisBroker(ctx, broker.RedisConfig{
+//	    URL:     "redis://localhost:6379/0",
+//	    Channel: "saga:events",
+//	})
+//	hub := service.NewEventHub(service.EventHubConfig{Broker: b})
+package broker
@@ -0,0 +1,79 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultChannel is the Redis channel used when none is configured
+const DefaultChannel = "saga:events"
+
+// RedisConfig holds Redis Pub/Sub settings
+type RedisConfig struct {
+	URL     string // e.g. redis://:password@localhost:6379/0
+	Channel string
+}
+
+// RedisBroker fans events out across instances using Redis Pub/Sub
+type RedisBroker struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewRedisBroker connects to Redis and verifies the connection
+func NewRedisBroker(ctx context.Context, cfg RedisConfig) (*RedisBroker, error) {
+	opts, err := redis.ParseURL(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("redis ping failed: %w", err)
+	}
+
+	channel := cfg.Channel
+	if channel == "" {
+		channel = DefaultChannel
+	}
+
+	return &RedisBroker{client: client, channel: channel}, nil
+}
+
+// Publish sends a payload to all subscribed instances
+func (b *RedisBroker) Publish(ctx context.Context, payload []byte) error {
+	return b.client.Publish(ctx, b.channel, payload).Err()
+}
+
+// Subscribe delivers payloads published by any instance until ctx is done.
+// The Redis client reconnects and resubscribes automatically.
+func (b *RedisBroker) Subscribe(ctx context.Context, deliver func(payload []byte)) error {
+	pubsub := b.client.Subscribe(ctx, b.channel)
+	defer func() { _ = pubsub.Close() }()
+
+	// Wait for the subscription to be confirmed before consuming
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return fmt.Errorf("redis subscribe failed: %w", err)
+	}
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			deliver([]byte(msg.Payload))
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Close closes the Redis connection
+func (b *RedisBroker) Close() error {
+	return b.client.Close()
+}
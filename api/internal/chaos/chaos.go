@@ -0,0 +1,187 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Target names a dependency faults can be injected into
+type Target string
+
+// Targets
+const (
+	Database Target = "database"
+	Push     Target = "push"
+	EventHub Target = "eventhub"
+)
+
+var (
+	// ErrInjected is returned by calls chosen to fail
+	ErrInjected = errors.New("chaos: injected failure")
+	// ErrDisconnected is returned by calls chosen to lose their connection
+	ErrDisconnected = errors.New("chaos: injected disconnect")
+	// ErrInvalidRule indicates a rule spec that can't be parsed
+	ErrInvalidRule = errors.New("chaos: invalid rule")
+)
+
+// Rule describes the faults injected into one target. Rates are 0-1.
+type Rule struct {
+	Latency        time.Duration // Delay added to a share of calls
+	LatencyRate    float64       // Share of calls delayed
+	ErrorRate      float64       // Share of calls failing with ErrInjected
+	DisconnectRate float64       // Share of calls failing with ErrDisconnected
+}
+
+// IsZero returns true if the rule injects nothing
+func (r Rule) IsZero() bool {
+	return (r.Latency == 0 || r.LatencyRate == 0) && r.ErrorRate == 0 && r.DisconnectRate == 0
+}
+
+// String formats the rule as ParseRule accepts it
+func (r Rule) String() string {
+	var parts []string
+	if r.Latency > 0 && r.LatencyRate > 0 {
+		parts = append(parts, fmt.Sprintf("latency=%s@%g", r.Latency, r.LatencyRate))
+	}
+	if r.ErrorRate > 0 {
+		parts = append(parts, fmt.Sprintf("error=%g", r.ErrorRate))
+	}
+	if r.DisconnectRate > 0 {
+		parts = append(parts, fmt.Sprintf("disconnect=%g", r.DisconnectRate))
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseRule parses "latency=250ms@0.2,error=0.05,disconnect=0.01". Every
+// part is optional; a latency without "@rate" applies to every call.
+func ParseRule(spec string) (Rule, error) {
+	var r Rule
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("%w: %q is not name=value", ErrInvalidRule, part)
+		}
+		var err error
+		switch name {
+		case "latency":
+			delay, rate, hasRate := strings.Cut(value, "@")
+			if r.Latency, err = time.ParseDuration(delay); err != nil || r.Latency < 0 {
+				return Rule{}, fmt.Errorf("%w: latency %q", ErrInvalidRule, delay)
+			}
+			r.LatencyRate = 1
+			if hasRate {
+				if r.LatencyRate, err = parseRate(rate); err != nil {
+					return Rule{}, err
+				}
+			}
+		case "error":
+			if r.ErrorRate, err = parseRate(value); err != nil {
+				return Rule{}, err
+			}
+		case "disconnect":
+			if r.DisconnectRate, err = parseRate(value); err != nil {
+				return Rule{}, err
+			}
+		default:
+			return Rule{}, fmt.Errorf("%w: unknown fault %q", ErrInvalidRule, name)
+		}
+	}
+	if r.ErrorRate+r.DisconnectRate > 1 {
+		return Rule{}, fmt.Errorf("%w: error and disconnect rates add up to more than 1", ErrInvalidRule)
+	}
+	return r, nil
+}
+
+func parseRate(s string) (float64, error) {
+	rate, err := strconv.ParseFloat(s, 64)
+	if err != nil || rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("%w: rate %q must be between 0 and 1", ErrInvalidRule, s)
+	}
+	return rate, nil
+}
+
+// Injector decides which calls to a target are delayed or fail. It is safe
+// for concurrent use, and a nil *Injector injects nothing.
+type Injector struct {
+	rules map[Target]Rule
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewInjector creates an injector for the given rules. A seeded src makes
+// the sequence of faults repeatable; nil seeds randomly.
+func NewInjector(rules map[Target]Rule, src rand.Source) *Injector {
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return &Injector{rules: rules, rand: rand.New(src)}
+}
+
+// Rule returns the rule for a target
+func (i *Injector) Rule(target Target) Rule {
+	if i == nil {
+		return Rule{}
+	}
+	return i.rules[target]
+}
+
+// Delay returns how long to delay this call to target, usually zero
+func (i *Injector) Delay(target Target) time.Duration {
+	rule := i.Rule(target)
+	if rule.Latency == 0 || rule.LatencyRate == 0 {
+		return 0
+	}
+	if i.roll() < rule.LatencyRate {
+		return rule.Latency
+	}
+	return 0
+}
+
+// Fail returns ErrInjected or ErrDisconnected if this call to target should
+// fail, or nil
+func (i *Injector) Fail(target Target) error {
+	rule := i.Rule(target)
+	if rule.ErrorRate == 0 && rule.DisconnectRate == 0 {
+		return nil
+	}
+	switch r := i.roll(); {
+	case r < rule.ErrorRate:
+		return ErrInjected
+	case r < rule.ErrorRate+rule.DisconnectRate:
+		return ErrDisconnected
+	}
+	return nil
+}
+
+// Inject waits out any delay for this call to target, then returns the
+// injected failure, if any. It returns early with the context's error if
+// ctx is done while waiting.
+func (i *Injector) Inject(ctx context.Context, target Target) error {
+	if delay := i.Delay(target); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return i.Fail(target)
+}
+
+func (i *Injector) roll() float64 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.rand.Float64()
+}
@@ -0,0 +1,172 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+)
+
+// ============================================================================
+// ParseRule() Tests
+// ============================================================================
+
+func TestParseRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		spec string
+		want Rule
+	}{
+		{"", Rule{}},
+		{"error=0.05", Rule{ErrorRate: 0.05}},
+		{"latency=250ms", Rule{Latency: 250 * time.Millisecond, LatencyRate: 1}},
+		{" latency=1s@0.2 , error=0.1,disconnect=0.01", Rule{Latency: time.Second, LatencyRate: 0.2, ErrorRate: 0.1, DisconnectRate: 0.01}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseRule(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseRule(%q) failed: %v", tt.spec, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRule(%q) = %+v, want %+v", tt.spec, got, tt.want)
+			}
+			again, err := ParseRule(got.String())
+			if err != nil || again != got {
+				t.Errorf("expected %q to round-trip, got %+v, %v", got.String(), again, err)
+			}
+		})
+	}
+}
+
+func TestParseRule_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{
+		"error",
+		"error=1.5",
+		"error=-0.1",
+		"latency=fast",
+		"latency=-1s",
+		"latency=1s@2",
+		"timeout=0.1",
+		"error=0.6,disconnect=0.6",
+	} {
+		if _, err := ParseRule(spec); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("ParseRule(%q): expected ErrInvalidRule, got %v", spec, err)
+		}
+	}
+}
+
+// ============================================================================
+// Injector Tests
+// ============================================================================
+
+func TestInjector_Rates(t *testing.T) {
+	t.Parallel()
+
+	faults := NewInjector(map[Target]Rule{
+		Push: {Latency: time.Second, LatencyRate: 0.5, ErrorRate: 0.1, DisconnectRate: 0.05},
+	}, rand.NewPCG(1, 2))
+
+	const calls = 10000
+	delayed, failed, disconnected := 0, 0, 0
+	for i := 0; i < calls; i++ {
+		if faults.Delay(Push) > 0 {
+			delayed++
+		}
+		switch faults.Fail(Push) {
+		case ErrInjected:
+			failed++
+		case ErrDisconnected:
+			disconnected++
+		}
+	}
+
+	for _, c := range []struct {
+		name string
+		got  int
+		rate float64
+	}{
+		{"delayed", delayed, 0.5},
+		{"failed", failed, 0.1},
+		{"disconnected", disconnected, 0.05},
+	} {
+		if got := float64(c.got) / calls; got < c.rate*0.9 || got > c.rate*1.1 {
+			t.Errorf("expected about %.0f%% %s, got %.1f%%", c.rate*100, c.name, got*100)
+		}
+	}
+	if faults.Fail(Database) != nil || faults.Delay(EventHub) != 0 {
+		t.Error("expected targets without a rule to be left alone")
+	}
+}
+
+func TestInjector_Nil(t *testing.T) {
+	t.Parallel()
+
+	var faults *Injector
+	if err := faults.Inject(context.Background(), Database); err != nil {
+		t.Errorf("expected a nil injector to inject nothing, got %v", err)
+	}
+	if !faults.Rule(Push).IsZero() {
+		t.Error("expected a zero rule")
+	}
+}
+
+func TestInject_StopsWaitingWhenContextDone(t *testing.T) {
+	t.Parallel()
+
+	faults := NewInjector(map[Target]Rule{Database: {Latency: time.Hour, LatencyRate: 1}}, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := faults.Inject(ctx, Database); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the context's error, got %v", err)
+	}
+}
+
+// ============================================================================
+// WrapDatabase() Tests
+// ============================================================================
+
+// countingDB counts queries that reach the real client
+type countingDB struct {
+	database.Database
+	queries int
+}
+
+func (d *countingDB) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
+	d.queries++
+	return nil, nil
+}
+
+func TestWrapDatabase(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	inner := &countingDB{}
+	if db := WrapDatabase(inner, nil); db != database.Database(inner) {
+		t.Error("expected the client to be returned as is without a rule")
+	}
+
+	db := WrapDatabase(inner, NewInjector(map[Target]Rule{Database: {DisconnectRate: 1}}, nil))
+	_, err := db.Query(ctx, "SELECT * FROM user", nil)
+	if !errors.Is(err, database.ErrConnection) || !errors.Is(err, ErrDisconnected) {
+		t.Errorf("expected an injected connection error, got %v", err)
+	}
+	if inner.queries != 0 {
+		t.Error("expected the failed query not to reach the client")
+	}
+
+	db = WrapDatabase(inner, NewInjector(map[Target]Rule{Database: {ErrorRate: 0.5}}, rand.NewPCG(3, 4)))
+	for i := 0; i < 100; i++ {
+		_, _ = db.Query(ctx, "SELECT * FROM user", nil)
+	}
+	if inner.queries < 30 || inner.queries > 70 {
+		t.Errorf("expected about half the queries to reach the client, got %d", inner.queries)
+	}
+}
@@ -0,0 +1,100 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+)
+
+// faultyDB injects Database faults before each call reaches the real client.
+// Injected disconnects wrap database.ErrConnection, as a dropped socket would.
+type faultyDB struct {
+	database.Database
+	faults *Injector
+}
+
+// WrapDatabase injects the Database rule's faults into db's queries, pings
+// and transactions. Connect and Close are passed through.
+func WrapDatabase(db database.Database, faults *Injector) database.Database {
+	if faults.Rule(Database).IsZero() {
+		return db
+	}
+	return &faultyDB{Database: db, faults: faults}
+}
+
+func (d *faultyDB) Ping(ctx context.Context) error {
+	if err := injectDatabase(ctx, d.faults); err != nil {
+		return err
+	}
+	return d.Database.Ping(ctx)
+}
+
+func (d *faultyDB) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
+	if err := injectDatabase(ctx, d.faults); err != nil {
+		return nil, err
+	}
+	return d.Database.Query(ctx, query, vars)
+}
+
+func (d *faultyDB) QueryOne(ctx context.Context, query string, vars map[string]interface{}) (interface{}, error) {
+	if err := injectDatabase(ctx, d.faults); err != nil {
+		return nil, err
+	}
+	return d.Database.QueryOne(ctx, query, vars)
+}
+
+func (d *faultyDB) Execute(ctx context.Context, query string, vars map[string]interface{}) error {
+	if err := injectDatabase(ctx, d.faults); err != nil {
+		return err
+	}
+	return d.Database.Execute(ctx, query, vars)
+}
+
+func (d *faultyDB) BeginTx(ctx context.Context) (database.Transaction, error) {
+	if err := injectDatabase(ctx, d.faults); err != nil {
+		return nil, err
+	}
+	tx, err := d.Database.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &faultyTx{Transaction: tx, faults: d.faults}, nil
+}
+
+// faultyTx injects faults into statements within a transaction. Commit and
+// Rollback are passed through so a failed statement can still roll back.
+type faultyTx struct {
+	database.Transaction
+	faults *Injector
+}
+
+func (t *faultyTx) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
+	if err := injectDatabase(ctx, t.faults); err != nil {
+		return nil, err
+	}
+	return t.Transaction.Query(ctx, query, vars)
+}
+
+func (t *faultyTx) QueryOne(ctx context.Context, query string, vars map[string]interface{}) (interface{}, error) {
+	if err := injectDatabase(ctx, t.faults); err != nil {
+		return nil, err
+	}
+	return t.Transaction.QueryOne(ctx, query, vars)
+}
+
+func (t *faultyTx) Execute(ctx context.Context, query string, vars map[string]interface{}) error {
+	if err := injectDatabase(ctx, t.faults); err != nil {
+		return err
+	}
+	return t.Transaction.Execute(ctx, query, vars)
+}
+
+func injectDatabase(ctx context.Context, faults *Injector) error {
+	err := faults.Inject(ctx, Database)
+	if errors.Is(err, ErrDisconnected) {
+		return fmt.Errorf("%w: %w", database.ErrConnection, err)
+	}
+	return err
+}
@@ -0,0 +1,25 @@
+// Package chaos injects faults into the Saga API's dependencies so retries,
+// timeouts and graceful degradation can be tested against a running server.
+//
+// Each target (the database client, the push provider and the EventHub) has a
+// Rule: added latency on a share of calls, and a share of calls that fail
+// with ErrInjected or with ErrDisconnected, as if the connection dropped.
+// Injection is configured with CHAOS_* variables and refused in production.
+//
+// # Rules
+//
+//	rule, err := chaos.ParseRule("latency=250ms@0.2,error=0.05,disconnect=0.01")
+//
+// 20% of calls wait 250ms, then 5% fail and 1% disconnect. A latency without
+// a rate applies to every call.
+//
+// # Wiring
+//
+//	faults := chaos.NewInjector(map[chaos.Target]chaos.Rule{
+//	    chaos.Database: rule,
+//	}, nil)
+//	db := chaos.WrapDatabase(surrealDB, faults)
+//	hub := service.NewEventHub(service.EventHubConfig{Faults: faults})
+//
+// A nil *Injector injects nothing, so services can hold one unconditionally.
+package chaos
@@ -0,0 +1,21 @@
+// Package clock abstracts the current time for the Saga API.
+//
+// Services and jobs whose behavior depends on the time (token expiry, vote
+// scheduling, moderation expirations, monthly jobs) take a Clock instead of
+// calling time.Now, so tests can move time forward without sleeping.
+//
+// # Usage
+//
+// Configs take an optional Clock; nil means the system clock:
+//
+//	svc := service.NewTokenService(service.TokenServiceConfig{
+//	    JWTService: jwtService,
+//	    TokenRepo:  tokenRepo,
+//	    Clock:      clock.Real,
+//	})
+//
+// In tests, use the fake in internal/testing/fakeclock:
+//
+//	clk := fakeclock.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+//	clk.Advance(24 * time.Hour)
+package clock
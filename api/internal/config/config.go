@@ -3,20 +3,50 @@ package config
 import (
 	"errors"
 	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/mail"
 	"os"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/forgo/saga/api/internal/chaos"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/fieldcrypt"
+	"github.com/forgo/saga/api/pkg/payments"
 )
 
 // Config holds all application configuration
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Push     PushConfig
-	OAuth    OAuthConfig
-	Passkey  PasskeyConfig
+	Server       ServerConfig
+	API          APIConfig
+	Database     DatabaseConfig
+	JWT          JWTConfig
+	Push         PushConfig
+	OAuth        OAuthConfig
+	Passkey      PasskeyConfig
+	Reminder     ReminderConfig
+	WinBack      WinBackConfig
+	Region       RegionConfig
+	EventHub     EventHubConfig
+	Idempotency  IdempotencyConfig
+	Share        ShareConfig
+	Analytics    AnalyticsConfig
+	Quota        QuotaConfig
+	Scrapers     ScraperConfig
+	Network      NetworkConfig
+	ClientErrors ClientErrorsConfig
+	ClientApps   ClientAppsConfig
+	Reports      ReportsConfig
+	Password     PasswordConfig
+	Mail         MailConfig
+	Payments     PaymentsConfig
+	Geocoding    GeocodingConfig
+	Pairing      DevicePairingConfig
+	DPoP         DPoPConfig
+	Fields       FieldEncryptionConfig
+	Chaos        ChaosConfig
 }
 
 // ServerConfig holds HTTP server settings
@@ -26,16 +56,61 @@ type ServerConfig struct {
 	ReadTimeout    time.Duration
 	WriteTimeout   time.Duration
 	AllowedOrigins []string
+	PublicURL      string // Where browsers reach the API, for links in embeds
+	MetricsToken   string // Bearer token required on /metrics when set
+	LogLevel       string // Initial slog level: debug, info, warn or error
+	DiagnosticsDir string // Where runtime snapshots are written (OS temp dir if empty)
+}
+
+// APIConfig holds API versioning settings. Dates are RFC 3339 timestamps
+// or plain dates.
+type APIConfig struct {
+	V1DeprecatedOn string // When /v1 was deprecated; /v1 responses carry Deprecation headers once set
+	V1SunsetOn     string // When /v1 stops working, sent as the Sunset header
+	V1MigrationURL string // Guide to moving off /v1, linked from deprecated responses
+
+	// RequestValidationMode is off, report (log requests that don't match
+	// the OpenAPI spec) or enforce (reject them with 422)
+	RequestValidationMode string
+}
+
+// V1Deprecation returns when /v1 was deprecated and when it sunsets; each
+// is zero when not set
+func (a APIConfig) V1Deprecation() (deprecatedOn, sunsetOn time.Time, err error) {
+	if deprecatedOn, err = parseAPIDate(a.V1DeprecatedOn); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("API_V1_DEPRECATED_ON: %w", err)
+	}
+	if sunsetOn, err = parseAPIDate(a.V1SunsetOn); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("API_V1_SUNSET_ON: %w", err)
+	}
+	return deprecatedOn, sunsetOn, nil
 }
 
-// DatabaseConfig holds SurrealDB connection settings
+func parseAPIDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be an RFC 3339 timestamp or a date, got '%s'", value)
+	}
+	return t, nil
+}
+
+// DatabaseConfig holds database connection settings
 type DatabaseConfig struct {
+	Driver    string // One of databaseDrivers
 	Host      string
 	Port      string
 	Namespace string
 	Database  string
 	User      string
 	Password  string
+
+	SlowQueryThreshold time.Duration // Queries slower than this are logged and counted as slow
 }
 
 // JWTConfig holds JWT signing settings
@@ -84,6 +159,232 @@ type PasskeyConfig struct {
 	AttestationType string
 }
 
+// ReminderConfig holds event reminder scheduling settings
+type ReminderConfig struct {
+	Offsets  []time.Duration // How long before an event starts to send reminders
+	Interval time.Duration   // How often the reminder job checks for due reminders
+}
+
+// WinBackConfig holds churn-risk detection and win-back nudge settings
+type WinBackConfig struct {
+	HoldoutPercent int           // Share of at-risk users never nudged, to measure the nudges against
+	Interval       time.Duration // How often the churn-risk job looks for at-risk users
+}
+
+// RegionConfig holds multi-region active-passive settings
+type RegionConfig struct {
+	Name              string
+	Role              string        // active or standby; a standby region is always read-only
+	ReadOnly          bool          // Reject writes even when active, e.g. during failover drills
+	MaxReplicaLag     time.Duration // Replica lag above which a standby reports itself degraded
+	HeartbeatInterval time.Duration // How often the replication heartbeat is written or read
+}
+
+// EventHubConfig holds SSE event hub buffering settings
+type EventHubConfig struct {
+	BufferSize   int    // Events buffered per SSE subscriber
+	BufferPolicy string // drop_oldest, drop_newest or disconnect when a buffer is full
+	Broker       string // memory (in-process only) or redis (fan out across instances)
+	RedisURL     string // Redis connection URL when Broker is redis
+	Channel      string // Pub/sub channel shared by all instances
+
+	PollTimeout  time.Duration // How long GET /v1/notifications/poll waits for a notification
+	PollsPerUser int           // Notification polls a user may hold open at once
+}
+
+// IdempotencyConfig holds Idempotency-Key replay settings
+type IdempotencyConfig struct {
+	Store string        // database (survives restarts, shared across instances) or memory (in-process only)
+	TTL   time.Duration // How long responses are replayed to retries
+}
+
+// ShareConfig holds share link settings
+type ShareConfig struct {
+	SigningKey string // HMAC key for share tokens; a random per-process key is used when empty outside production
+	BaseURL    string // Public site that opens /s/{token} links
+}
+
+// MinShareSigningKeyLength is the minimum share link signing key length in bytes
+const MinShareSigningKeyLength = 32
+
+// AnalyticsConfig holds anonymized analytics event settings. The pipeline is
+// off unless enabled, so no analytics are collected by default.
+type AnalyticsConfig struct {
+	Enabled   bool   // Record analytics events and allow exporting them
+	HashKey   string // HMAC key user IDs are hashed with; keep it stable so hashes join across exports
+	ExportDir string // Where the export job writes daily NDJSON files; no job runs when empty
+}
+
+// MinAnalyticsHashKeyLength is the minimum analytics hash key length in bytes
+const MinAnalyticsHashKeyLength = 32
+
+// QuotaConfig holds per-user daily request quota settings. Requests are
+// weighted by cost, so a discovery search spends more than a simple read.
+type QuotaConfig struct {
+	DailyLimit int // Request cost each user may spend per UTC day (10000 when zero)
+}
+
+// ScraperConfig holds the tarpit that slows down and then blocks clients
+// scraping the API. Zero limits and durations use the tarpit's defaults.
+type ScraperConfig struct {
+	DecoyPaths       []string      // Paths no real client requests, such as /wp-login.php; requesting one blocks the client
+	EnumerationLimit int           // Distinct users a client may look up per window
+	DiscoveryLimit   int           // Discovery searches a client may run per window
+	Window           time.Duration // How long a client's lookups and searches are counted for
+	BlockAfter       int           // Strikes, one per window over a limit, before the client is blocked
+	BlockFor         time.Duration // How long a blocked client is turned away
+}
+
+// NetworkConfig holds the client addresses allowed to reach admin routes.
+// Admins manage the IP deny list at runtime; it isn't configured here.
+type NetworkConfig struct {
+	AdminAllowlist []string // Addresses or CIDR ranges; empty lets admins in from anywhere
+}
+
+// ClientErrorsConfig holds sampling and rate limits for crash and API
+// mismatch reports from client apps
+type ClientErrorsConfig struct {
+	CrashSampleRate    float64 // Share of crash reports kept, from 0 to 1; 0 keeps every report
+	MismatchSampleRate float64 // Share of API mismatch reports kept, from 0 to 1; 0 keeps every report
+	RateLimit          int     // Reports one client address may send per minute
+}
+
+// ClientAppsConfig holds the app releases each platform must run and the
+// feature flags turned on for clients, advertised at /v1/meta/client-config
+type ClientAppsConfig struct {
+	IOS      ClientAppConfig
+	Android  ClientAppConfig
+	Web      ClientAppConfig
+	Features []string // Feature flags turned on
+}
+
+// ClientAppConfig holds one platform's app releases
+type ClientAppConfig struct {
+	MinimumVersion string // Older apps get 426 Upgrade Required; empty supports every version
+	CurrentVersion string // Latest release, for suggesting optional upgrades
+	UpdateURL      string // Store listing or download page
+}
+
+// ClientConfig returns the releases for each platform and the feature
+// flags, as advertised to clients
+func (c ClientAppsConfig) ClientConfig() *model.ClientConfig {
+	config := &model.ClientConfig{
+		Platforms: make(map[model.DevicePlatform]model.ClientRelease, 3),
+		Features:  make(map[string]bool, len(c.Features)),
+	}
+	for platform, app := range map[model.DevicePlatform]ClientAppConfig{
+		model.PlatformIOS:     c.IOS,
+		model.PlatformAndroid: c.Android,
+		model.PlatformWeb:     c.Web,
+	} {
+		config.Platforms[platform] = model.ClientRelease{
+			MinimumVersion: app.MinimumVersion,
+			CurrentVersion: app.CurrentVersion,
+			UpdateURL:      app.UpdateURL,
+		}
+	}
+	for _, feature := range c.Features {
+		if feature = strings.TrimSpace(feature); feature != "" {
+			config.Features[feature] = true
+		}
+	}
+	return config
+}
+
+// ReportsConfig holds object storage settings for background admin reports.
+// Reports are disabled when no bucket is set.
+type ReportsConfig struct {
+	Bucket          string
+	Region          string
+	Endpoint        string // Set for S3-compatible stores such as MinIO
+	AccessKeyID     string
+	SecretAccessKey string
+	PathStyle       bool          // Address the bucket in the path rather than the host name
+	Retention       time.Duration // How long generated files are kept
+	URLTTL          time.Duration // How long a signed download URL is valid
+}
+
+// PasswordConfig holds password policy settings for registration and
+// password changes
+type PasswordConfig struct {
+	MinScore           int    // Strength score (0-4) new passwords need; 0 disables the check
+	BreachCheckEnabled bool   // Reject passwords found in HaveIBeenPwned
+	BreachCheckURL     string // Pwned Passwords range API base URL
+}
+
+// MailConfig holds SMTP settings for transactional email such as address
+// verification. Email changes are disabled when no host is set.
+type MailConfig struct {
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string // Authentication is skipped when empty
+	SMTPPassword string
+	From         string // Sender address, optionally with a display name
+}
+
+// PaymentsConfig holds Stripe settings for paid event tickets. Paid events
+// are disabled when no secret key is set.
+type PaymentsConfig struct {
+	SecretKey     string // Stripe API key; a test key in sandbox, a live key otherwise
+	WebhookSecret string // Signing secret of the Stripe webhook endpoint
+	Sandbox       bool   // Stripe test mode; on unless explicitly turned off
+	ReturnURL     string // Site guests and organizers return to from Stripe's pages
+}
+
+// GeocodingConfig holds the address lookup used when organizers save a
+// venue. Venues keep only the coordinates they are given when no provider
+// is set.
+type GeocodingConfig struct {
+	Provider  string // nominatim, or empty to turn geocoding off
+	URL       string // Provider API base URL
+	UserAgent string // Identifies the app, as Nominatim's usage policy requires
+}
+
+// databaseDrivers are the accepted values of DB_DRIVER
+var databaseDrivers = map[string]bool{"surrealdb": true, "postgres": true}
+
+// geocodingProviders are the accepted values of GEOCODING_PROVIDER
+var geocodingProviders = map[string]bool{"nominatim": true}
+
+// DevicePairingConfig holds QR login settings for shared devices
+type DevicePairingConfig struct {
+	SigningKey string        // HMAC key for QR payloads; a random per-process key is used when empty outside production
+	TTL        time.Duration // How long a QR code can be approved; 0 uses the service default
+}
+
+// MinPairingSigningKeyLength is the minimum QR payload signing key length in bytes
+const MinPairingSigningKeyLength = 32
+
+// DPoPConfig holds proof-of-possession settings. Each client platform is
+// off, report (bind when offered, log what enforce would reject) or enforce.
+type DPoPConfig struct {
+	IOSMode     string
+	AndroidMode string
+	WebMode     string
+	MaxAge      time.Duration // How old a proof may be, allowing for clock skew
+}
+
+// FieldEncryptionConfig holds keys for sensitive fields encrypted at the
+// application layer, such as precise profile locations
+type FieldEncryptionConfig struct {
+	Keys string // "id:base64key,..." with the primary key first; fields are stored unencrypted when empty outside production
+}
+
+// ChaosConfig holds fault injection rules for resilience testing. Each rule
+// is a spec such as "latency=250ms@0.2,error=0.05,disconnect=0.01"; see
+// package chaos. Refused in production.
+type ChaosConfig struct {
+	Enabled  bool
+	Seed     uint64 // Makes the sequence of faults repeatable; 0 seeds randomly
+	Database string
+	Push     string
+	EventHub string
+}
+
+// rolloutModes are the accepted values of settings rolled out as off,
+// report or enforce, such as DPOP_MODE_*
+var rolloutModes = map[string]bool{"off": true, "report": true, "enforce": true}
+
 // Load reads configuration from environment variables with sensible defaults
 func Load() (*Config, error) {
 	return &Config{
@@ -93,14 +394,27 @@ func Load() (*Config, error) {
 			ReadTimeout:    getDurationEnv("SERVER_READ_TIMEOUT", 15*time.Second),
 			WriteTimeout:   getDurationEnv("SERVER_WRITE_TIMEOUT", 15*time.Second),
 			AllowedOrigins: getSliceEnv("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:5173", "http://localhost:5174", "http://localhost:8000"}),
+			PublicURL:      getEnv("API_PUBLIC_URL", "http://localhost:8080"),
+			MetricsToken:   getEnv("METRICS_TOKEN", ""),
+			LogLevel:       getEnv("LOG_LEVEL", "info"),
+			DiagnosticsDir: getEnv("DIAGNOSTICS_DIR", ""),
+		},
+		API: APIConfig{
+			V1DeprecatedOn: getEnv("API_V1_DEPRECATED_ON", ""),
+			V1SunsetOn:     getEnv("API_V1_SUNSET_ON", ""),
+			V1MigrationURL: getEnv("API_V1_MIGRATION_URL", ""),
+
+			RequestValidationMode: getEnv("REQUEST_VALIDATION_MODE", "enforce"),
 		},
 		Database: DatabaseConfig{
-			Host:      getEnv("DB_HOST", "localhost"),
-			Port:      getEnv("DB_PORT", "8000"),
-			Namespace: getEnv("DB_NAMESPACE", "saga"),
-			Database:  getEnv("DB_DATABASE", "main"),
-			User:      getEnv("DB_USER", "root"),
-			Password:  getEnv("DB_PASSWORD", "root"),
+			Driver:             getEnv("DB_DRIVER", "surrealdb"),
+			Host:               getEnv("DB_HOST", "localhost"),
+			Port:               getEnv("DB_PORT", "8000"),
+			Namespace:          getEnv("DB_NAMESPACE", "saga"),
+			Database:           getEnv("DB_DATABASE", "main"),
+			User:               getEnv("DB_USER", "root"),
+			Password:           getEnv("DB_PASSWORD", "root"),
+			SlowQueryThreshold: getDurationEnv("DB_SLOW_QUERY_THRESHOLD", 200*time.Millisecond),
 		},
 		JWT: JWTConfig{
 			PrivateKeyPath: getEnv("JWT_PRIVATE_KEY_PATH", "./keys/private.pem"),
@@ -134,6 +448,121 @@ func Load() (*Config, error) {
 			RequireUV:       getBoolEnv("PASSKEY_REQUIRE_UV", false),
 			AttestationType: getEnv("PASSKEY_ATTESTATION_TYPE", "none"),
 		},
+		Reminder: ReminderConfig{
+			Offsets:  getDurationSliceEnv("EVENT_REMINDER_OFFSETS", []time.Duration{24 * time.Hour, 1 * time.Hour}),
+			Interval: getDurationEnv("EVENT_REMINDER_INTERVAL", 5*time.Minute),
+		},
+		WinBack: WinBackConfig{
+			HoldoutPercent: getIntEnv("WIN_BACK_HOLDOUT_PERCENT", model.DefaultWinBackHoldoutPercent),
+			Interval:       getDurationEnv("WIN_BACK_INTERVAL", 6*time.Hour),
+		},
+		Region: RegionConfig{
+			Name:              getEnv("REGION_NAME", "local"),
+			Role:              getEnv("REGION_ROLE", "active"),
+			ReadOnly:          getBoolEnv("READ_ONLY_MODE", false),
+			MaxReplicaLag:     getDurationEnv("DB_MAX_REPLICA_LAG", 30*time.Second),
+			HeartbeatInterval: getDurationEnv("REGION_HEARTBEAT_INTERVAL", 10*time.Second),
+		},
+		EventHub: EventHubConfig{
+			BufferSize:   getIntEnv("EVENTHUB_BUFFER_SIZE", 100),
+			BufferPolicy: getEnv("EVENTHUB_BUFFER_POLICY", "drop_oldest"),
+			Broker:       getEnv("EVENTHUB_BROKER", "memory"),
+			RedisURL:     getEnv("REDIS_URL", ""),
+			Channel:      getEnv("EVENTHUB_CHANNEL", "saga:events"),
+			PollTimeout:  getDurationEnv("NOTIFICATION_POLL_TIMEOUT", 25*time.Second),
+			PollsPerUser: getIntEnv("NOTIFICATION_POLLS_PER_USER", 2),
+		},
+		Idempotency: IdempotencyConfig{
+			Store: getEnv("IDEMPOTENCY_STORE", "database"),
+			TTL:   getDurationEnv("IDEMPOTENCY_TTL", model.IdempotencyTTL),
+		},
+		Share: ShareConfig{
+			SigningKey: getEnv("SHARE_LINK_SIGNING_KEY", ""),
+			BaseURL:    getEnv("SHARE_LINK_BASE_URL", "http://localhost:3000"),
+		},
+		Analytics: AnalyticsConfig{
+			Enabled:   getBoolEnv("ANALYTICS_EVENTS_ENABLED", false),
+			HashKey:   getEnv("ANALYTICS_HASH_KEY", ""),
+			ExportDir: getEnv("ANALYTICS_EXPORT_DIR", ""),
+		},
+		Quota: QuotaConfig{
+			DailyLimit: getIntEnv("QUOTA_DAILY_LIMIT", 10000),
+		},
+		Scrapers: ScraperConfig{
+			DecoyPaths:       getSliceEnv("SCRAPER_DECOY_PATHS", []string{"/wp-login.php", "/.env", "/.git/config", "/v1/users/export", "/v1/admin/backup"}),
+			EnumerationLimit: getIntEnv("SCRAPER_ENUMERATION_LIMIT", 50),
+			DiscoveryLimit:   getIntEnv("SCRAPER_DISCOVERY_LIMIT", 120),
+			Window:           getDurationEnv("SCRAPER_WINDOW", 10*time.Minute),
+			BlockAfter:       getIntEnv("SCRAPER_BLOCK_AFTER", 3),
+			BlockFor:         getDurationEnv("SCRAPER_BLOCK_DURATION", time.Hour),
+		},
+		Network: NetworkConfig{
+			AdminAllowlist: getSliceEnv("ADMIN_ALLOWED_CIDRS", nil),
+		},
+		ClientErrors: ClientErrorsConfig{
+			CrashSampleRate:    getFloatEnv("CLIENT_ERROR_CRASH_SAMPLE_RATE", 1),
+			MismatchSampleRate: getFloatEnv("CLIENT_ERROR_MISMATCH_SAMPLE_RATE", 0.25),
+			RateLimit:          getIntEnv("CLIENT_ERROR_RATE_LIMIT", 10),
+		},
+		ClientApps: ClientAppsConfig{
+			IOS:      getClientAppEnv("IOS"),
+			Android:  getClientAppEnv("ANDROID"),
+			Web:      getClientAppEnv("WEB"),
+			Features: getSliceEnv("CLIENT_FEATURES", nil),
+		},
+		Reports: ReportsConfig{
+			Bucket:          getEnv("REPORTS_S3_BUCKET", ""),
+			Region:          getEnv("REPORTS_S3_REGION", "us-east-1"),
+			Endpoint:        getEnv("REPORTS_S3_ENDPOINT", ""),
+			AccessKeyID:     getEnv("REPORTS_S3_ACCESS_KEY_ID", ""),
+			SecretAccessKey: getEnv("REPORTS_S3_SECRET_ACCESS_KEY", ""),
+			PathStyle:       getBoolEnv("REPORTS_S3_PATH_STYLE", false),
+			Retention:       getDurationEnv("REPORTS_RETENTION", 7*24*time.Hour),
+			URLTTL:          getDurationEnv("REPORTS_URL_TTL", 15*time.Minute),
+		},
+		Password: PasswordConfig{
+			MinScore:           getIntEnv("PASSWORD_MIN_SCORE", 2),
+			BreachCheckEnabled: getBoolEnv("PASSWORD_BREACH_CHECK_ENABLED", true),
+			BreachCheckURL:     getEnv("PASSWORD_BREACH_CHECK_URL", "https://api.pwnedpasswords.com"),
+		},
+		Mail: MailConfig{
+			SMTPHost:     getEnv("SMTP_HOST", ""),
+			SMTPPort:     getIntEnv("SMTP_PORT", 587),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			From:         getEnv("MAIL_FROM", "Saga <no-reply@saga.forgo.software>"),
+		},
+		Payments: PaymentsConfig{
+			SecretKey:     getEnv("STRIPE_SECRET_KEY", ""),
+			WebhookSecret: getEnv("STRIPE_WEBHOOK_SECRET", ""),
+			Sandbox:       getBoolEnv("PAYMENTS_SANDBOX", true),
+			ReturnURL:     getEnv("PAYMENTS_RETURN_URL", "http://localhost:3000"),
+		},
+		Geocoding: GeocodingConfig{
+			Provider:  getEnv("GEOCODING_PROVIDER", ""),
+			URL:       getEnv("GEOCODING_URL", "https://nominatim.openstreetmap.org"),
+			UserAgent: getEnv("GEOCODING_USER_AGENT", "saga-api"),
+		},
+		Pairing: DevicePairingConfig{
+			SigningKey: getEnv("DEVICE_PAIRING_SIGNING_KEY", ""),
+			TTL:        getDurationEnv("DEVICE_PAIRING_TTL", 2*time.Minute),
+		},
+		DPoP: DPoPConfig{
+			IOSMode:     getEnv("DPOP_MODE_IOS", "off"),
+			AndroidMode: getEnv("DPOP_MODE_ANDROID", "off"),
+			WebMode:     getEnv("DPOP_MODE_WEB", "off"),
+			MaxAge:      getDurationEnv("DPOP_PROOF_MAX_AGE", time.Minute),
+		},
+		Fields: FieldEncryptionConfig{
+			Keys: getEnv("FIELD_ENCRYPTION_KEYS", ""),
+		},
+		Chaos: ChaosConfig{
+			Enabled:  getBoolEnv("CHAOS_ENABLED", false),
+			Seed:     uint64(getIntEnv("CHAOS_SEED", 0)),
+			Database: getEnv("CHAOS_DATABASE", ""),
+			Push:     getEnv("CHAOS_PUSH", ""),
+			EventHub: getEnv("CHAOS_EVENTHUB", ""),
+		},
 	}, nil
 }
 
@@ -162,8 +591,27 @@ func (c *Config) Validate() error {
 	if len(c.Server.AllowedOrigins) == 0 {
 		errs = append(errs, errors.New("CORS_ALLOWED_ORIGINS must have at least one origin"))
 	}
+	if c.Server.LogLevel != "" {
+		if _, err := c.Server.SlogLevel(); err != nil {
+			errs = append(errs, fmt.Errorf("LOG_LEVEL must be 'debug', 'info', 'warn', or 'error', got '%s'", c.Server.LogLevel))
+		}
+	}
+
+	// API versioning validation - a sunset needs a deprecation before it
+	deprecatedOn, sunsetOn, err := c.API.V1Deprecation()
+	if err != nil {
+		errs = append(errs, err)
+	} else if !sunsetOn.IsZero() && (deprecatedOn.IsZero() || !sunsetOn.After(deprecatedOn)) {
+		errs = append(errs, errors.New("API_V1_SUNSET_ON must be after API_V1_DEPRECATED_ON"))
+	}
+	if mode := c.API.RequestValidationMode; mode != "" && !rolloutModes[mode] {
+		errs = append(errs, fmt.Errorf("REQUEST_VALIDATION_MODE must be 'off', 'report', or 'enforce', got '%s'", mode))
+	}
 
 	// Database validation
+	if c.Database.Driver != "" && !databaseDrivers[c.Database.Driver] {
+		errs = append(errs, fmt.Errorf("DB_DRIVER must be surrealdb or postgres, got %q", c.Database.Driver))
+	}
 	if c.Database.Host == "" {
 		errs = append(errs, errors.New("DB_HOST is required"))
 	}
@@ -176,6 +624,9 @@ func (c *Config) Validate() error {
 	if c.Database.Database == "" {
 		errs = append(errs, errors.New("DB_DATABASE is required"))
 	}
+	if c.Database.SlowQueryThreshold < 0 {
+		errs = append(errs, errors.New("DB_SLOW_QUERY_THRESHOLD must not be negative"))
+	}
 
 	// JWT validation - critical for production
 	if c.IsProduction() {
@@ -218,12 +669,305 @@ func (c *Config) Validate() error {
 		errs = append(errs, errors.New("PASSKEY_RP_ORIGINS must have at least one origin"))
 	}
 
+	// Event reminder validation - empty offsets or interval fall back to defaults
+	for _, offset := range c.Reminder.Offsets {
+		if offset <= 0 {
+			errs = append(errs, fmt.Errorf("EVENT_REMINDER_OFFSETS must be positive, got '%s'", offset))
+		}
+	}
+	if c.Reminder.Interval < 0 {
+		errs = append(errs, errors.New("EVENT_REMINDER_INTERVAL must not be negative"))
+	}
+
+	// Win-back validation - a larger holdout would withhold nudges from too many users
+	if c.WinBack.HoldoutPercent < 0 || c.WinBack.HoldoutPercent > model.MaxWinBackHoldoutPercent {
+		errs = append(errs, fmt.Errorf("WIN_BACK_HOLDOUT_PERCENT must be between 0 and %d, got %d", model.MaxWinBackHoldoutPercent, c.WinBack.HoldoutPercent))
+	}
+	if c.WinBack.Interval < 0 {
+		errs = append(errs, errors.New("WIN_BACK_INTERVAL must not be negative"))
+	}
+
+	// Region validation - an empty role means active
+	if c.Region.Role != "" && c.Region.Role != "active" && c.Region.Role != "standby" {
+		errs = append(errs, fmt.Errorf("REGION_ROLE must be 'active' or 'standby', got '%s'", c.Region.Role))
+	}
+	if c.Region.MaxReplicaLag < 0 {
+		errs = append(errs, errors.New("DB_MAX_REPLICA_LAG must not be negative"))
+	}
+	if c.Region.HeartbeatInterval < 0 {
+		errs = append(errs, errors.New("REGION_HEARTBEAT_INTERVAL must not be negative"))
+	}
+
+	// Event hub validation - zero values fall back to defaults
+	if c.EventHub.BufferSize < 0 {
+		errs = append(errs, errors.New("EVENTHUB_BUFFER_SIZE must not be negative"))
+	}
+	switch c.EventHub.BufferPolicy {
+	case "", "drop_oldest", "drop_newest", "disconnect":
+	default:
+		errs = append(errs, fmt.Errorf("EVENTHUB_BUFFER_POLICY must be 'drop_oldest', 'drop_newest', or 'disconnect', got '%s'", c.EventHub.BufferPolicy))
+	}
+	switch c.EventHub.Broker {
+	case "", "memory":
+	case "redis":
+		if c.EventHub.RedisURL == "" {
+			errs = append(errs, errors.New("REDIS_URL is required when EVENTHUB_BROKER is 'redis'"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("EVENTHUB_BROKER must be 'memory' or 'redis', got '%s'", c.EventHub.Broker))
+	}
+	if c.EventHub.PollTimeout < 0 {
+		errs = append(errs, errors.New("NOTIFICATION_POLL_TIMEOUT must not be negative"))
+	}
+	if c.EventHub.PollsPerUser < 0 {
+		errs = append(errs, errors.New("NOTIFICATION_POLLS_PER_USER must not be negative"))
+	}
+	switch c.Idempotency.Store {
+	case "", "database", "memory":
+	default:
+		errs = append(errs, fmt.Errorf("IDEMPOTENCY_STORE must be 'database' or 'memory', got '%s'", c.Idempotency.Store))
+	}
+	if c.Idempotency.TTL < 0 {
+		errs = append(errs, errors.New("IDEMPOTENCY_TTL must not be negative"))
+	}
+
+	// Share link validation - links signed with a random key die on restart
+	if c.IsProduction() && c.Share.SigningKey == "" {
+		errs = append(errs, errors.New("SHARE_LINK_SIGNING_KEY is required in production"))
+	}
+	if c.Share.SigningKey != "" && len(c.Share.SigningKey) < MinShareSigningKeyLength {
+		errs = append(errs, fmt.Errorf("SHARE_LINK_SIGNING_KEY must be at least %d bytes", MinShareSigningKeyLength))
+	}
+
+	// Analytics validation - user IDs are never stored unhashed
+	if c.Analytics.Enabled {
+		if c.Analytics.HashKey == "" {
+			errs = append(errs, errors.New("ANALYTICS_HASH_KEY is required when ANALYTICS_EVENTS_ENABLED is true"))
+		} else if len(c.Analytics.HashKey) < MinAnalyticsHashKeyLength {
+			errs = append(errs, fmt.Errorf("ANALYTICS_HASH_KEY must be at least %d bytes", MinAnalyticsHashKeyLength))
+		}
+	}
+
+	// Quota validation
+	if c.Quota.DailyLimit < 0 {
+		errs = append(errs, fmt.Errorf("QUOTA_DAILY_LIMIT must not be negative, got %d", c.Quota.DailyLimit))
+	}
+
+	// Scraper tarpit validation
+	for _, path := range c.Scrapers.DecoyPaths {
+		if !strings.HasPrefix(path, "/") {
+			errs = append(errs, fmt.Errorf("SCRAPER_DECOY_PATHS must be paths starting with /, got %q", path))
+		}
+	}
+	if c.Scrapers.EnumerationLimit < 0 || c.Scrapers.DiscoveryLimit < 0 || c.Scrapers.BlockAfter < 0 {
+		errs = append(errs, errors.New("SCRAPER_ENUMERATION_LIMIT, SCRAPER_DISCOVERY_LIMIT and SCRAPER_BLOCK_AFTER must not be negative"))
+	}
+
+	// Network policy validation
+	for _, network := range c.Network.AdminAllowlist {
+		if _, err := model.ParseNetwork(network); err != nil {
+			errs = append(errs, fmt.Errorf("ADMIN_ALLOWED_CIDRS entries must be IP addresses or CIDR ranges, got %q", network))
+		}
+	}
+
+	// Client error validation
+	for _, rate := range []struct {
+		key   string
+		value float64
+	}{
+		{"CLIENT_ERROR_CRASH_SAMPLE_RATE", c.ClientErrors.CrashSampleRate},
+		{"CLIENT_ERROR_MISMATCH_SAMPLE_RATE", c.ClientErrors.MismatchSampleRate},
+	} {
+		if rate.value < 0 || rate.value > 1 {
+			errs = append(errs, fmt.Errorf("%s must be between 0 and 1, got %v", rate.key, rate.value))
+		}
+	}
+	if c.ClientErrors.RateLimit < 0 {
+		errs = append(errs, fmt.Errorf("CLIENT_ERROR_RATE_LIMIT must not be negative, got %d", c.ClientErrors.RateLimit))
+	}
+
+	// Client app validation
+	for _, app := range []struct {
+		platform string
+		config   ClientAppConfig
+	}{
+		{"IOS", c.ClientApps.IOS},
+		{"ANDROID", c.ClientApps.Android},
+		{"WEB", c.ClientApps.Web},
+	} {
+		var minimum, current model.ClientVersion
+		var minimumErr, currentErr error
+		if app.config.MinimumVersion != "" {
+			if minimum, minimumErr = model.ParseClientVersion(app.config.MinimumVersion); minimumErr != nil {
+				errs = append(errs, fmt.Errorf("CLIENT_%s_MIN_VERSION %w, got %q", app.platform, minimumErr, app.config.MinimumVersion))
+			}
+		}
+		if app.config.CurrentVersion != "" {
+			if current, currentErr = model.ParseClientVersion(app.config.CurrentVersion); currentErr != nil {
+				errs = append(errs, fmt.Errorf("CLIENT_%s_CURRENT_VERSION %w, got %q", app.platform, currentErr, app.config.CurrentVersion))
+			}
+		}
+		if app.config.MinimumVersion != "" && app.config.CurrentVersion != "" && minimumErr == nil && currentErr == nil && current.Less(minimum) {
+			errs = append(errs, fmt.Errorf("CLIENT_%s_MIN_VERSION must not be newer than CLIENT_%s_CURRENT_VERSION", app.platform, app.platform))
+		}
+	}
+
+	// Reports validation - storage is optional, but must be complete when set
+	if c.Reports.Bucket != "" {
+		if c.Reports.AccessKeyID == "" || c.Reports.SecretAccessKey == "" {
+			errs = append(errs, errors.New("REPORTS_S3_ACCESS_KEY_ID and REPORTS_S3_SECRET_ACCESS_KEY are required when REPORTS_S3_BUCKET is set"))
+		}
+		if c.Reports.URLTTL < time.Second || c.Reports.URLTTL > 7*24*time.Hour {
+			errs = append(errs, fmt.Errorf("REPORTS_URL_TTL must be between 1s and 168h, got %v", c.Reports.URLTTL))
+		}
+		if c.Reports.Retention <= 0 {
+			errs = append(errs, errors.New("REPORTS_RETENTION must be positive"))
+		}
+	}
+
+	// Password validation
+	if c.Password.MinScore < 0 || c.Password.MinScore > 4 {
+		errs = append(errs, fmt.Errorf("PASSWORD_MIN_SCORE must be between 0 and 4, got %d", c.Password.MinScore))
+	}
+	if c.Password.BreachCheckEnabled && c.Password.BreachCheckURL == "" {
+		errs = append(errs, errors.New("PASSWORD_BREACH_CHECK_URL is required when PASSWORD_BREACH_CHECK_ENABLED is true"))
+	}
+
+	// Mail validation - SMTP is optional, but must be usable when set
+	if c.Mail.SMTPHost != "" {
+		if c.Mail.SMTPPort <= 0 || c.Mail.SMTPPort > 65535 {
+			errs = append(errs, fmt.Errorf("SMTP_PORT must be between 1 and 65535, got %d", c.Mail.SMTPPort))
+		}
+		if _, err := mail.ParseAddress(c.Mail.From); err != nil {
+			errs = append(errs, fmt.Errorf("MAIL_FROM must be a valid address: %w", err))
+		}
+	}
+
+	// Payments validation - Stripe is optional, but must be complete when set
+	if c.Payments.SecretKey != "" {
+		if c.Payments.WebhookSecret == "" {
+			errs = append(errs, errors.New("STRIPE_WEBHOOK_SECRET is required when STRIPE_SECRET_KEY is set"))
+		}
+		if c.Payments.ReturnURL == "" {
+			errs = append(errs, errors.New("PAYMENTS_RETURN_URL is required when STRIPE_SECRET_KEY is set"))
+		}
+		if payments.IsTestKey(c.Payments.SecretKey) != c.Payments.Sandbox {
+			errs = append(errs, errors.New("STRIPE_SECRET_KEY must be a test key when PAYMENTS_SANDBOX is true and a live key otherwise"))
+		}
+	}
+
+	// Geocoding validation - optional, but needs somewhere to send lookups
+	if c.Geocoding.Provider != "" {
+		if !geocodingProviders[c.Geocoding.Provider] {
+			errs = append(errs, fmt.Errorf("GEOCODING_PROVIDER must be nominatim or empty, got %q", c.Geocoding.Provider))
+		}
+		if c.Geocoding.URL == "" {
+			errs = append(errs, errors.New("GEOCODING_URL is required when GEOCODING_PROVIDER is set"))
+		}
+	}
+
+	// Device pairing validation - a random key breaks QR codes across instances
+	if c.IsProduction() && c.Pairing.SigningKey == "" {
+		errs = append(errs, errors.New("DEVICE_PAIRING_SIGNING_KEY is required in production"))
+	}
+	if c.Pairing.SigningKey != "" && len(c.Pairing.SigningKey) < MinPairingSigningKeyLength {
+		errs = append(errs, fmt.Errorf("DEVICE_PAIRING_SIGNING_KEY must be at least %d bytes", MinPairingSigningKeyLength))
+	}
+	if c.Pairing.TTL != 0 && (c.Pairing.TTL < 30*time.Second || c.Pairing.TTL > 10*time.Minute) {
+		errs = append(errs, fmt.Errorf("DEVICE_PAIRING_TTL must be between 30s and 10m, got %v", c.Pairing.TTL))
+	}
+
+	// Proof-of-possession validation
+	for _, m := range []struct{ key, mode string }{
+		{"DPOP_MODE_IOS", c.DPoP.IOSMode},
+		{"DPOP_MODE_ANDROID", c.DPoP.AndroidMode},
+		{"DPOP_MODE_WEB", c.DPoP.WebMode},
+	} {
+		if m.mode != "" && !rolloutModes[m.mode] {
+			errs = append(errs, fmt.Errorf("%s must be off, report or enforce, got %q", m.key, m.mode))
+		}
+	}
+	if c.DPoP.MaxAge != 0 && (c.DPoP.MaxAge < 10*time.Second || c.DPoP.MaxAge > 10*time.Minute) {
+		errs = append(errs, fmt.Errorf("DPOP_PROOF_MAX_AGE must be between 10s and 10m, got %v", c.DPoP.MaxAge))
+	}
+
+	// Field encryption validation - a malformed key list would leave data unreadable
+	if c.IsProduction() && c.Fields.Keys == "" {
+		errs = append(errs, errors.New("FIELD_ENCRYPTION_KEYS is required in production"))
+	}
+	if c.Fields.Keys != "" {
+		if _, err := c.Fields.Keyring(); err != nil {
+			errs = append(errs, fmt.Errorf("FIELD_ENCRYPTION_KEYS is invalid: %w", err))
+		}
+	}
+
+	// Fault injection validation - never in production
+	if c.Chaos.Enabled && c.IsProduction() {
+		errs = append(errs, errors.New("CHAOS_ENABLED must not be set in production"))
+	}
+	if c.Chaos.Enabled {
+		if _, err := c.Chaos.Injector(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
 	return nil
 }
 
+// SlogLevel parses LogLevel, defaulting to info when unset
+func (s ServerConfig) SlogLevel() (slog.Level, error) {
+	var level slog.Level
+	if s.LogLevel == "" {
+		return slog.LevelInfo, nil
+	}
+	err := level.UnmarshalText([]byte(s.LogLevel))
+	return level, err
+}
+
+// Keyring parses the configured keys, or returns nil when none are set
+func (f FieldEncryptionConfig) Keyring() (*fieldcrypt.Keyring, error) {
+	if f.Keys == "" {
+		return nil, nil
+	}
+	keys, err := fieldcrypt.ParseKeys(f.Keys)
+	if err != nil {
+		return nil, err
+	}
+	return fieldcrypt.NewKeyring(keys)
+}
+
+// Injector builds the fault injector, or returns nil when chaos is disabled
+func (c ChaosConfig) Injector() (*chaos.Injector, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+	rules := make(map[chaos.Target]chaos.Rule)
+	for _, r := range []struct {
+		key    string
+		target chaos.Target
+		spec   string
+	}{
+		{"CHAOS_DATABASE", chaos.Database, c.Database},
+		{"CHAOS_PUSH", chaos.Push, c.Push},
+		{"CHAOS_EVENTHUB", chaos.EventHub, c.EventHub},
+	} {
+		rule, err := chaos.ParseRule(r.spec)
+		if err != nil {
+			return nil, fmt.Errorf("%s is invalid: %w", r.key, err)
+		}
+		rules[r.target] = rule
+	}
+
+	var src rand.Source
+	if c.Seed != 0 {
+		src = rand.NewPCG(c.Seed, c.Seed)
+	}
+	return chaos.NewInjector(rules, src), nil
+}
+
 // IsConfigured returns true if any Google OAuth field is set
 func (g GoogleOAuthConfig) IsConfigured() bool {
 	return g.ClientID != "" || g.ClientSecret != "" || g.RedirectURI != ""
@@ -291,6 +1035,25 @@ func getIntEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getClientAppEnv reads CLIENT_<PLATFORM>_MIN_VERSION, _CURRENT_VERSION and
+// _UPDATE_URL
+func getClientAppEnv(platform string) ClientAppConfig {
+	return ClientAppConfig{
+		MinimumVersion: getEnv("CLIENT_"+platform+"_MIN_VERSION", ""),
+		CurrentVersion: getEnv("CLIENT_"+platform+"_CURRENT_VERSION", ""),
+		UpdateURL:      getEnv("CLIENT_"+platform+"_UPDATE_URL", ""),
+	}
+}
+
+func getFloatEnv(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return defaultValue
+}
+
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if d, err := time.ParseDuration(value); err == nil {
@@ -307,6 +1070,21 @@ func getSliceEnv(key string, defaultValue []string) []string {
 	return defaultValue
 }
 
+func getDurationSliceEnv(key string, defaultValue []time.Duration) []time.Duration {
+	if value := os.Getenv(key); value != "" {
+		var durations []time.Duration
+		for _, part := range strings.Split(value, ",") {
+			d, err := time.ParseDuration(strings.TrimSpace(part))
+			if err != nil {
+				return defaultValue
+			}
+			durations = append(durations, d)
+		}
+		return durations
+	}
+	return defaultValue
+}
+
 func getBoolEnv(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		if b, err := strconv.ParseBool(value); err == nil {
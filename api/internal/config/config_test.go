@@ -157,6 +157,413 @@ func TestConfig_Validate_MissingPasskeyRPID(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate_NonPositiveReminderOffset(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Reminder.Offsets = []time.Duration{time.Hour, 0}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Error("expected error for non-positive reminder offset")
+	}
+	if !strings.Contains(err.Error(), "EVENT_REMINDER_OFFSETS") {
+		t.Errorf("expected error to mention EVENT_REMINDER_OFFSETS, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_WinBackHoldoutTooLarge(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.WinBack.HoldoutPercent = 80
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Error("expected error for a holdout over half of at-risk users")
+	}
+	if !strings.Contains(err.Error(), "WIN_BACK_HOLDOUT_PERCENT") {
+		t.Errorf("expected error to mention WIN_BACK_HOLDOUT_PERCENT, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_InvalidRegionRole(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Region.Role = "primary"
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Error("expected error for invalid region role")
+	}
+	if !strings.Contains(err.Error(), "REGION_ROLE") {
+		t.Errorf("expected error to mention REGION_ROLE, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_InvalidLogLevel(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Server.LogLevel = "verbose"
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Error("expected error for invalid log level")
+	}
+	if !strings.Contains(err.Error(), "LOG_LEVEL") {
+		t.Errorf("expected error to mention LOG_LEVEL, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_RedisBrokerRequiresURL(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.EventHub.Broker = "redis"
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Error("expected error for redis broker without REDIS_URL")
+	}
+	if !strings.Contains(err.Error(), "REDIS_URL") {
+		t.Errorf("expected error to mention REDIS_URL, got: %v", err)
+	}
+
+	cfg.EventHub.RedisURL = "redis://localhost:6379/0"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_IdempotencyStore(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Idempotency.Store = "redis"
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "IDEMPOTENCY_STORE") {
+		t.Errorf("expected error to mention IDEMPOTENCY_STORE, got: %v", err)
+	}
+
+	cfg.Idempotency.Store = "memory"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_NotificationPoll(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.EventHub.PollTimeout = -time.Second
+	cfg.EventHub.PollsPerUser = -1
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error for negative poll settings")
+	}
+	for _, name := range []string{"NOTIFICATION_POLL_TIMEOUT", "NOTIFICATION_POLLS_PER_USER"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("expected error to mention %s, got: %v", name, err)
+		}
+	}
+}
+
+func TestConfig_Validate_ShareLinkSigningKey(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Server.Env = "production"
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "SHARE_LINK_SIGNING_KEY") {
+		t.Errorf("expected error for missing SHARE_LINK_SIGNING_KEY in production, got: %v", err)
+	}
+
+	cfg.Share.SigningKey = "too-short"
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "at least") {
+		t.Errorf("expected error for short SHARE_LINK_SIGNING_KEY, got: %v", err)
+	}
+
+	cfg.Share.SigningKey = strings.Repeat("k", MinShareSigningKeyLength)
+	cfg.Pairing.SigningKey = strings.Repeat("p", MinPairingSigningKeyLength)
+	cfg.Fields.Keys = testFieldKeys
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_AnalyticsHashKey(t *testing.T) {
+	cfg := validBaseConfig()
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected analytics to be optional when disabled, got: %v", err)
+	}
+
+	cfg.Analytics.Enabled = true
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "ANALYTICS_HASH_KEY") {
+		t.Errorf("expected error for missing ANALYTICS_HASH_KEY, got: %v", err)
+	}
+
+	cfg.Analytics.HashKey = "too-short"
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "at least") {
+		t.Errorf("expected error for short ANALYTICS_HASH_KEY, got: %v", err)
+	}
+
+	cfg.Analytics.HashKey = strings.Repeat("k", MinAnalyticsHashKeyLength)
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_QuotaDailyLimit(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Quota.DailyLimit = -1
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "QUOTA_DAILY_LIMIT") {
+		t.Errorf("expected error for negative QUOTA_DAILY_LIMIT, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_Scrapers(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Scrapers.DecoyPaths = []string{"/wp-login.php", "wp-admin"}
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "SCRAPER_DECOY_PATHS") {
+		t.Errorf("expected error for a decoy path without a leading slash, got: %v", err)
+	}
+
+	cfg.Scrapers.DecoyPaths = nil
+	cfg.Scrapers.BlockAfter = -1
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "SCRAPER_BLOCK_AFTER") {
+		t.Errorf("expected error for a negative SCRAPER_BLOCK_AFTER, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_ClientErrors(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.ClientErrors.MismatchSampleRate = 1.5
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "CLIENT_ERROR_MISMATCH_SAMPLE_RATE") {
+		t.Errorf("expected error for a sample rate above 1, got: %v", err)
+	}
+
+	cfg.ClientErrors.MismatchSampleRate = 0.25
+	cfg.ClientErrors.RateLimit = -1
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "CLIENT_ERROR_RATE_LIMIT") {
+		t.Errorf("expected error for a negative CLIENT_ERROR_RATE_LIMIT, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_ClientApps(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.ClientApps.IOS = ClientAppConfig{MinimumVersion: "3.0.0", CurrentVersion: "3.4.1"}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid client app versions, got: %v", err)
+	}
+
+	cfg.ClientApps.Android = ClientAppConfig{MinimumVersion: "latest"}
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "CLIENT_ANDROID_MIN_VERSION") {
+		t.Errorf("expected error for an unreadable minimum version, got: %v", err)
+	}
+
+	cfg.ClientApps.Android = ClientAppConfig{}
+	cfg.ClientApps.IOS.MinimumVersion = "4.0"
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "must not be newer than CLIENT_IOS_CURRENT_VERSION") {
+		t.Errorf("expected error for a minimum newer than the current release, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_AdminAllowlist(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Network.AdminAllowlist = []string{"10.0.0.0/8", " 203.0.113.7", "2001:db8::/32"}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected addresses and ranges to be accepted, got: %v", err)
+	}
+
+	cfg.Network.AdminAllowlist = []string{"10.0.0.0/8", "office"}
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "ADMIN_ALLOWED_CIDRS") {
+		t.Errorf("expected error for an entry that isn't an address, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_Reports(t *testing.T) {
+	cfg := validBaseConfig()
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected reports to be optional, got: %v", err)
+	}
+
+	cfg.Reports = ReportsConfig{Bucket: "saga-reports", Retention: time.Hour, URLTTL: time.Minute}
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "REPORTS_S3_ACCESS_KEY_ID") {
+		t.Errorf("expected error for missing credentials, got: %v", err)
+	}
+
+	cfg.Reports.AccessKeyID = "key"
+	cfg.Reports.SecretAccessKey = "secret"
+	cfg.Reports.URLTTL = 8 * 24 * time.Hour
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "REPORTS_URL_TTL") {
+		t.Errorf("expected error for long REPORTS_URL_TTL, got: %v", err)
+	}
+
+	cfg.Reports.URLTTL = 15 * time.Minute
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_Password(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Password.MinScore = 5
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "PASSWORD_MIN_SCORE") {
+		t.Errorf("expected error for PASSWORD_MIN_SCORE out of range, got: %v", err)
+	}
+
+	cfg.Password = PasswordConfig{MinScore: 2, BreachCheckEnabled: true}
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "PASSWORD_BREACH_CHECK_URL") {
+		t.Errorf("expected error for missing PASSWORD_BREACH_CHECK_URL, got: %v", err)
+	}
+
+	cfg.Password.BreachCheckURL = "https://api.pwnedpasswords.com"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_Mail(t *testing.T) {
+	cfg := validBaseConfig()
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected mail to be optional, got: %v", err)
+	}
+
+	cfg.Mail = MailConfig{SMTPHost: "smtp.example.com", SMTPPort: 587, From: "saga"}
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "MAIL_FROM") {
+		t.Errorf("expected error for invalid MAIL_FROM, got: %v", err)
+	}
+
+	cfg.Mail.From = "Saga <no-reply@example.com>"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_Payments(t *testing.T) {
+	cfg := validBaseConfig()
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected payments to be optional, got: %v", err)
+	}
+
+	cfg.Payments = PaymentsConfig{SecretKey: "sk_test_abc", Sandbox: true, ReturnURL: "https://saga.example"}
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "STRIPE_WEBHOOK_SECRET") {
+		t.Errorf("expected error for missing STRIPE_WEBHOOK_SECRET, got: %v", err)
+	}
+
+	cfg.Payments.WebhookSecret = "whsec_abc"
+	cfg.Payments.Sandbox = false
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "PAYMENTS_SANDBOX") {
+		t.Errorf("expected error for a test key outside the sandbox, got: %v", err)
+	}
+
+	cfg.Payments.Sandbox = true
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_DatabaseDriver(t *testing.T) {
+	cfg := validBaseConfig()
+	for _, driver := range []string{"surrealdb", "postgres"} {
+		cfg.Database.Driver = driver
+		if err := cfg.Validate(); err != nil {
+			t.Errorf("expected valid config for %s, got: %v", driver, err)
+		}
+	}
+
+	cfg.Database.Driver = "mysql"
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "DB_DRIVER") {
+		t.Errorf("expected error for unsupported DB_DRIVER, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_Geocoding(t *testing.T) {
+	cfg := validBaseConfig()
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected geocoding to be optional, got: %v", err)
+	}
+
+	cfg.Geocoding = GeocodingConfig{Provider: "google"}
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "GEOCODING_PROVIDER") || !strings.Contains(err.Error(), "GEOCODING_URL") {
+		t.Errorf("expected errors for the unknown provider and missing URL, got: %v", err)
+	}
+
+	cfg.Geocoding = GeocodingConfig{Provider: "nominatim", URL: "https://nominatim.example"}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_APIDeprecation(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.API.V1DeprecatedOn = "next week"
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "API_V1_DEPRECATED_ON") {
+		t.Errorf("expected error for unparseable API_V1_DEPRECATED_ON, got: %v", err)
+	}
+
+	cfg.API = APIConfig{V1DeprecatedOn: "2027-01-01", V1SunsetOn: "2026-12-01T00:00:00Z"}
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "API_V1_SUNSET_ON") {
+		t.Errorf("expected error for a sunset before the deprecation, got: %v", err)
+	}
+
+	cfg.API.V1SunsetOn = "2027-07-01T00:00:00Z"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+	deprecatedOn, sunsetOn, _ := cfg.API.V1Deprecation()
+	if !deprecatedOn.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) || !sunsetOn.Equal(time.Date(2027, 7, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected dates %v, %v", deprecatedOn, sunsetOn)
+	}
+}
+
+func TestConfig_Validate_RequestValidationMode(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.API.RequestValidationMode = "strict"
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "REQUEST_VALIDATION_MODE") {
+		t.Errorf("expected error for an unknown REQUEST_VALIDATION_MODE, got: %v", err)
+	}
+
+	cfg.API.RequestValidationMode = "report"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestGetDurationSliceEnv(t *testing.T) {
+	defaults := []time.Duration{time.Hour}
+
+	t.Setenv("TEST_DURATIONS", "24h, 30m")
+	got := getDurationSliceEnv("TEST_DURATIONS", defaults)
+	if len(got) != 2 || got[0] != 24*time.Hour || got[1] != 30*time.Minute {
+		t.Errorf("expected [24h 30m], got %v", got)
+	}
+
+	t.Setenv("TEST_DURATIONS", "24h,bogus")
+	got = getDurationSliceEnv("TEST_DURATIONS", defaults)
+	if len(got) != 1 || got[0] != time.Hour {
+		t.Errorf("expected defaults on parse error, got %v", got)
+	}
+}
+
 func TestGoogleOAuthConfig_Validate_Complete(t *testing.T) {
 	cfg := GoogleOAuthConfig{
 		ClientID:     "client-id",
@@ -312,6 +719,105 @@ func TestConfig_Validate_MultipleErrors(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate_DevicePairing(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Pairing.SigningKey = "too-short"
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "DEVICE_PAIRING_SIGNING_KEY") {
+		t.Errorf("expected error for short DEVICE_PAIRING_SIGNING_KEY, got: %v", err)
+	}
+
+	cfg.Pairing.SigningKey = strings.Repeat("k", MinPairingSigningKeyLength)
+	cfg.Pairing.TTL = time.Hour
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "DEVICE_PAIRING_TTL") {
+		t.Errorf("expected error for DEVICE_PAIRING_TTL out of range, got: %v", err)
+	}
+
+	cfg.Pairing.TTL = 2 * time.Minute
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_DPoP(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.DPoP.AndroidMode = "strict"
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "DPOP_MODE_ANDROID") {
+		t.Errorf("expected error for unknown DPOP_MODE_ANDROID, got: %v", err)
+	}
+
+	cfg.DPoP.AndroidMode = "report"
+	cfg.DPoP.MaxAge = time.Second
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "DPOP_PROOF_MAX_AGE") {
+		t.Errorf("expected error for DPOP_PROOF_MAX_AGE out of range, got: %v", err)
+	}
+
+	cfg.DPoP.IOSMode = "enforce"
+	cfg.DPoP.MaxAge = time.Minute
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+}
+
+// testFieldKeys is one all-zero 32-byte key, base64 encoded
+const testFieldKeys = "k1:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+
+func TestConfig_Validate_FieldEncryption(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Server.Env = "production"
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "FIELD_ENCRYPTION_KEYS is required") {
+		t.Errorf("expected error for missing FIELD_ENCRYPTION_KEYS in production, got: %v", err)
+	}
+
+	cfg.Server.Env = "development"
+	cfg.Fields.Keys = "k1:c2hvcnQ="
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "FIELD_ENCRYPTION_KEYS is invalid") {
+		t.Errorf("expected error for a short key, got: %v", err)
+	}
+
+	cfg.Fields.Keys = "k2:" + strings.Repeat("B", 43) + "," + testFieldKeys
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid config, got: %v", err)
+	}
+	keyring, err := cfg.Fields.Keyring()
+	if err != nil || keyring.PrimaryKeyID() != "k2" {
+		t.Errorf("expected the first key to be primary, got %v", err)
+	}
+}
+
+func TestConfig_Validate_Chaos(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.Chaos = ChaosConfig{Enabled: true, Database: "error=0.1"}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected chaos to be allowed outside production, got: %v", err)
+	}
+
+	cfg.Server.Env = "production"
+	cfg.Fields.Keys = testFieldKeys
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "CHAOS_ENABLED must not be set in production") {
+		t.Errorf("expected chaos to be refused in production, got: %v", err)
+	}
+
+	cfg.Server.Env = "development"
+	cfg.Chaos.Push = "error=2"
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "CHAOS_PUSH is invalid") {
+		t.Errorf("expected error for an out-of-range rate, got: %v", err)
+	}
+
+	cfg.Chaos.Enabled = false
+	faults, err := cfg.Chaos.Injector()
+	if err != nil || faults != nil {
+		t.Errorf("expected no injector when disabled, got %v, %v", faults, err)
+	}
+}
+
 func TestConfig_IsDevelopment(t *testing.T) {
 	cfg := &Config{Server: ServerConfig{Env: "development"}}
 	if !cfg.IsDevelopment() {
@@ -372,5 +878,15 @@ func validBaseConfig() *Config {
 			RequireUV:       false,
 			AttestationType: "none",
 		},
+		Reminder: ReminderConfig{
+			Offsets:  []time.Duration{24 * time.Hour, 1 * time.Hour},
+			Interval: 5 * time.Minute,
+		},
+		Region: RegionConfig{
+			Name:              "local",
+			Role:              "active",
+			MaxReplicaLag:     30 * time.Second,
+			HeartbeatInterval: 10 * time.Second,
+		},
 	}
 }
@@ -1,7 +1,9 @@
 // Package database provides the database abstraction layer for Saga.
 //
 // This package defines the Database interface that abstracts SurrealDB operations,
-// allowing for clean separation between business logic and data access.
+// allowing for clean separation between business logic and data access. Open
+// picks the implementation from Config.Driver: SurrealDB, or PostgreSQL
+// through pgx (see postgres.go).
 //
 // # Interface Design
 //
@@ -12,16 +14,16 @@
 //
 // # Transaction Support
 //
-// IMPORTANT: Transactions in this package are BATCH-BASED, not connection-level.
-// When you call BeginTx(), queries are accumulated in memory until Commit() is called.
-// At commit time, all queries are wrapped in BEGIN TRANSACTION / COMMIT TRANSACTION
-// and executed atomically. This means:
-//   - No isolation between Add() calls until Commit()
-//   - Rollback() simply discards accumulated queries (nothing to undo)
-//   - All queries succeed or fail together at commit time
+// BeginTx starts an interactive transaction (SurrealDB v3): each statement
+// runs when it is issued and returns its results, and nothing is visible
+// outside the transaction until Commit(). Rollback() cancels it.
 //
-// For most use cases, prefer AtomicBatch over BeginTx() for clarity.
-// See transaction.go for advanced transaction utilities.
+// Services use WithTransaction rather than BeginTx directly. It puts the
+// transaction in the context, so repositories called with that context
+// join it without taking a Transaction parameter.
+//
+// For several statements inside one repository method, AtomicBatch is
+// simpler. See transaction.go for the transaction utilities.
 //
 // # Error Handling
 //
@@ -39,7 +41,7 @@
 //
 // # Usage Example
 //
-//	db := database.NewSurrealDB(cfg)
+//	db, err := database.Open(cfg)
 //	db.Connect(ctx)
 //	defer db.Close()
 //
@@ -101,6 +103,7 @@ type Transaction interface {
 
 // Config holds database configuration
 type Config struct {
+	Driver    string // See Open; empty means DriverSurrealDB
 	Host      string
 	Port      string
 	User      string
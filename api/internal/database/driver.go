@@ -0,0 +1,32 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Database drivers, selected by Config.Driver
+const (
+	DriverSurrealDB = "surrealdb" // The default when a Config names none
+	DriverPostgres  = "postgres"
+)
+
+// ErrUnsupportedDriver indicates a Config names a driver this build can't
+// open.
+var ErrUnsupportedDriver = errors.New("unsupported database driver")
+
+// Open returns an unconnected Database for cfg.Driver.
+//
+// The Database interface is driver-neutral, but a query is passed through
+// as written, so the repositories a Database is given to must be written
+// for its driver.
+func Open(cfg Config) (Database, error) {
+	switch cfg.Driver {
+	case "", DriverSurrealDB:
+		return NewSurrealDB(cfg), nil
+	case DriverPostgres:
+		return NewPostgres(cfg), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedDriver, cfg.Driver)
+	}
+}
@@ -0,0 +1,41 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// ============================================================================
+// Driver Tests
+// ============================================================================
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		driver  string
+		want    string
+		wantErr error
+	}{
+		{"", "*database.SurrealDB", nil},
+		{DriverSurrealDB, "*database.SurrealDB", nil},
+		{DriverPostgres, "*database.Postgres", nil},
+		{"mysql", "", ErrUnsupportedDriver},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.driver, func(t *testing.T) {
+			t.Parallel()
+			db, err := Open(Config{Driver: tt.driver})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Open(%q) error = %v, want %v", tt.driver, err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if got := fmt.Sprintf("%T", db); got != tt.want {
+					t.Errorf("Open(%q) = %s, want %s", tt.driver, got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package database
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ListColumns maps the fields a collection is sorted and filtered by to
+// the columns they're stored in, such as "city" to "location.city". Only
+// mapped fields reach a query, so it doubles as the repository's
+// allowlist behind model.ListFields.
+type ListColumns map[string]string
+
+// ListConditions returns a WHERE condition for each filter in q, binding
+// the filter values in vars as $filter_<field>. Filters on unmapped fields
+// are dropped.
+func ListConditions(q model.ListQuery, columns ListColumns, vars map[string]interface{}) []string {
+	var conditions []string
+	for _, f := range q.Filters {
+		column, ok := columns[f.Field]
+		if !ok || len(f.Values) == 0 {
+			continue
+		}
+		name := "filter_" + varName(f.Field)
+		if len(f.Values) == 1 {
+			conditions = append(conditions, column+" = $"+name)
+			vars[name] = f.Values[0]
+		} else {
+			conditions = append(conditions, column+" IN $"+name)
+			vars[name] = f.Values
+		}
+	}
+	return conditions
+}
+
+// ListOrder returns the ORDER BY clause for q's sort, or for fallback, such
+// as "start_time ASC", when q doesn't ask for one. Unmapped fields are
+// dropped.
+func ListOrder(q model.ListQuery, columns ListColumns, fallback string) string {
+	var keys []string
+	for _, key := range q.Sort {
+		column, ok := columns[key.Field]
+		if !ok {
+			continue
+		}
+		if key.Desc {
+			keys = append(keys, column+" DESC")
+		} else {
+			keys = append(keys, column+" ASC")
+		}
+	}
+	if len(keys) == 0 {
+		return "ORDER BY " + fallback
+	}
+	return "ORDER BY " + strings.Join(keys, ", ")
+}
+
+// ListPage returns the clauses that read one page of a list sorted by q,
+// or by fallback when q doesn't ask for an order: a WHERE condition
+// starting the page at its cursor (empty without one), and the ORDER BY,
+// LIMIT and START that follow. The record ID breaks ties in the order, so
+// the page after a cursor is found with the index rather than by skipping
+// rows. One row more than the limit is read, to tell whether the list goes
+// on.
+//
+// Pages before a cursor are read in reverse order. columns lists the
+// columns each item sorts by, for the cursors of the next page.
+func ListPage(q model.ListQuery, columns ListColumns, fallback []model.SortKey, page model.PageRequest, vars map[string]interface{}) (condition, tail string, sortColumns []string, err error) {
+	keys := q.Sort
+	if !anyMapped(keys, columns) {
+		keys = fallback
+	}
+
+	type orderKey struct {
+		column string
+		desc   bool
+	}
+	var order []orderKey
+	for _, key := range keys {
+		if column, ok := columns[key.Field]; ok {
+			order = append(order, orderKey{column: column, desc: key.Desc})
+			sortColumns = append(sortColumns, column)
+		}
+	}
+	idDesc := len(order) > 0 && order[len(order)-1].desc
+	order = append(order, orderKey{column: "id", desc: idDesc})
+
+	cursor := page.Cursor
+	if cursor != nil && cursor.Before {
+		for i := range order {
+			order[i].desc = !order[i].desc
+		}
+	}
+
+	if cursor != nil {
+		if len(cursor.Values) != len(sortColumns) {
+			return "", "", nil, model.ErrInvalidCursor
+		}
+		// (a > $a) OR (a = $a AND b > $b) OR ... with id as the last key
+		var alternatives []string
+		for i, key := range order {
+			var terms []string
+			for j := 0; j < i; j++ {
+				terms = append(terms, order[j].column+" = "+cursorVar(j, len(order), vars, cursor))
+			}
+			op := " > "
+			if key.desc {
+				op = " < "
+			}
+			terms = append(terms, key.column+op+cursorVar(i, len(order), vars, cursor))
+			alternatives = append(alternatives, "("+strings.Join(terms, " AND ")+")")
+		}
+		condition = "(" + strings.Join(alternatives, " OR ") + ")"
+	}
+
+	clauses := make([]string, len(order))
+	for i, key := range order {
+		if key.desc {
+			clauses[i] = key.column + " DESC"
+		} else {
+			clauses[i] = key.column + " ASC"
+		}
+	}
+	tail = "ORDER BY " + strings.Join(clauses, ", ") + " LIMIT $page_limit"
+	vars["page_limit"] = page.Limit + 1
+	if cursor == nil && page.Offset > 0 {
+		tail += " START $page_offset"
+		vars["page_offset"] = page.Offset
+	}
+	return condition, tail, sortColumns, nil
+}
+
+// cursorVar binds the cursor's value for the i-th of n order keys, the
+// last being the record ID
+func cursorVar(i, n int, vars map[string]interface{}, cursor *model.ListCursor) string {
+	if i == n-1 {
+		vars["cursor_id"] = cursor.ID
+		return "type::record($cursor_id)"
+	}
+	name := "cursor_" + strconv.Itoa(i)
+	vars[name] = cursor.Values[i]
+	return "$" + name
+}
+
+func anyMapped(keys []model.SortKey, columns ListColumns) bool {
+	for _, key := range keys {
+		if _, ok := columns[key.Field]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// varName makes a field name safe to use in a query variable
+func varName(field string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, field)
+}
@@ -0,0 +1,124 @@
+package database
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+var testListColumns = ListColumns{
+	"start_time": "start_time",
+	"title":      "title",
+	"status":     "status",
+	"city":       "location.city",
+}
+
+// ============================================================================
+// List Query Tests
+// ============================================================================
+
+func TestListConditions(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{}
+	conditions := ListConditions(model.ListQuery{Filters: []model.ListFilter{
+		{Field: "city", Values: []string{"Portland"}},
+		{Field: "status", Values: []string{"published", "completed"}},
+		{Field: "password", Values: []string{"hunter2"}},
+	}}, testListColumns, vars)
+
+	want := []string{"location.city = $filter_city", "status IN $filter_status"}
+	if !reflect.DeepEqual(conditions, want) {
+		t.Errorf("expected %v, got %v", want, conditions)
+	}
+	if vars["filter_city"] != "Portland" {
+		t.Errorf("expected the city bound, got %v", vars["filter_city"])
+	}
+	if got, _ := vars["filter_status"].([]string); len(got) != 2 {
+		t.Errorf("expected both statuses bound, got %v", vars["filter_status"])
+	}
+	if _, ok := vars["filter_password"]; ok {
+		t.Error("expected unmapped filters to be dropped")
+	}
+}
+
+func TestListOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		sort []model.SortKey
+		want string
+	}{
+		{"fallback", nil, "ORDER BY start_time ASC"},
+		{"sorted", []model.SortKey{{Field: "city", Desc: true}, {Field: "title"}}, "ORDER BY location.city DESC, title ASC"},
+		{"unmapped", []model.SortKey{{Field: "password"}}, "ORDER BY start_time ASC"},
+	}
+	for _, tt := range tests {
+		if got := ListOrder(model.ListQuery{Sort: tt.sort}, testListColumns, "start_time ASC"); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestListPage(t *testing.T) {
+	t.Parallel()
+
+	fallback := []model.SortKey{{Field: "start_time"}}
+	cursor := &model.ListCursor{Values: []interface{}{"Portland"}, ID: "event:abc"}
+
+	tests := []struct {
+		name      string
+		sort      []model.SortKey
+		page      model.PageRequest
+		condition string
+		tail      string
+	}{
+		{
+			"first page", nil, model.PageRequest{Limit: 20}, "",
+			"ORDER BY start_time ASC, id ASC LIMIT $page_limit",
+		},
+		{
+			"offset", nil, model.PageRequest{Limit: 20, Offset: 40}, "",
+			"ORDER BY start_time ASC, id ASC LIMIT $page_limit START $page_offset",
+		},
+		{
+			"after", []model.SortKey{{Field: "city", Desc: true}}, model.PageRequest{Limit: 20, Offset: 40, Cursor: cursor},
+			"((location.city < $cursor_0) OR (location.city = $cursor_0 AND id < type::record($cursor_id)))",
+			"ORDER BY location.city DESC, id DESC LIMIT $page_limit",
+		},
+		{
+			"before", []model.SortKey{{Field: "city", Desc: true}}, model.PageRequest{Limit: 20, Cursor: &model.ListCursor{Values: cursor.Values, ID: cursor.ID, Before: true}},
+			"((location.city > $cursor_0) OR (location.city = $cursor_0 AND id > type::record($cursor_id)))",
+			"ORDER BY location.city ASC, id ASC LIMIT $page_limit",
+		},
+	}
+	for _, tt := range tests {
+		vars := map[string]interface{}{}
+		condition, tail, _, err := ListPage(model.ListQuery{Sort: tt.sort}, testListColumns, fallback, tt.page, vars)
+		if err != nil {
+			t.Fatalf("%s: ListPage failed: %v", tt.name, err)
+		}
+		if condition != tt.condition {
+			t.Errorf("%s: expected condition %q, got %q", tt.name, tt.condition, condition)
+		}
+		if tail != tt.tail {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.tail, tail)
+		}
+		if vars["page_limit"] != 21 {
+			t.Errorf("%s: expected one row past the limit, got %v", tt.name, vars["page_limit"])
+		}
+	}
+}
+
+func TestListPage_CursorForAnotherSort(t *testing.T) {
+	t.Parallel()
+
+	page := model.PageRequest{Limit: 20, Cursor: &model.ListCursor{Values: []interface{}{"a", "b"}, ID: "event:abc"}}
+	_, _, _, err := ListPage(model.ListQuery{}, testListColumns, []model.SortKey{{Field: "start_time"}}, page, map[string]interface{}{})
+	if !errors.Is(err, model.ErrInvalidCursor) {
+		t.Errorf("expected ErrInvalidCursor, got %v", err)
+	}
+}
@@ -0,0 +1,340 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// pgUniqueViolation is the SQLSTATE PostgreSQL reports for a unique
+// constraint violation
+const pgUniqueViolation = "23505"
+
+// Postgres implements the Database interface for PostgreSQL through a pgx
+// connection pool.
+//
+// Queries use the same named $variables as SurrealQL, bound from vars, and
+// return the same {status, result} wrapper as SurrealDB, so UnmarshalResult
+// and the list helpers work unchanged. Config.Namespace is the schema
+// (search_path) and Config.Database the database.
+type Postgres struct {
+	pool   *pgxpool.Pool
+	config Config
+}
+
+// NewPostgres creates a new Postgres instance
+func NewPostgres(cfg Config) *Postgres {
+	return &Postgres{
+		config: cfg,
+	}
+}
+
+// Connect opens the connection pool and checks it can reach the server
+func (p *Postgres) Connect(ctx context.Context) error {
+	poolConfig, err := pgxpool.ParseConfig(p.connString())
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrConnection, err)
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrConnection, err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return fmt.Errorf("%w: %v", ErrConnection, err)
+	}
+
+	p.pool = pool
+	return nil
+}
+
+// connString builds a postgres:// URL from the config
+func (p *Postgres) connString() string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(p.config.User, p.config.Password),
+		Host:   net.JoinHostPort(p.config.Host, p.config.Port),
+		Path:   "/" + p.config.Database,
+	}
+	if p.config.Namespace != "" {
+		u.RawQuery = url.Values{"search_path": {p.config.Namespace}}.Encode()
+	}
+	return u.String()
+}
+
+// Close closes the connection pool
+func (p *Postgres) Close() error {
+	if p.pool != nil {
+		p.pool.Close()
+	}
+	return nil
+}
+
+// Ping checks the database connection
+func (p *Postgres) Ping(ctx context.Context) error {
+	if p.pool == nil {
+		return ErrConnection
+	}
+	if err := p.pool.Ping(ctx); err != nil {
+		return fmt.Errorf("%w: %v", ErrConnection, err)
+	}
+	return nil
+}
+
+// Query executes a query and returns results. Inside WithTransaction the
+// query runs in the context's transaction.
+func (p *Postgres) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, vars)
+	}
+	if p.pool == nil {
+		return nil, ErrConnection
+	}
+
+	return postgresQuery(ctx, p.pool, query, vars)
+}
+
+// QueryOne executes a query and returns a single result
+func (p *Postgres) QueryOne(ctx context.Context, query string, vars map[string]interface{}) (interface{}, error) {
+	results, err := p.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return firstResult(results)
+}
+
+// Execute runs a query without returning results
+func (p *Postgres) Execute(ctx context.Context, query string, vars map[string]interface{}) error {
+	_, err := p.Query(ctx, query, vars)
+	return err
+}
+
+// BeginTx starts a transaction on one of the pool's connections
+func (p *Postgres) BeginTx(ctx context.Context) (Transaction, error) {
+	if p.pool == nil {
+		return nil, ErrConnection
+	}
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: begin failed: %v", postgresError(err), err)
+	}
+	return &PostgresTransaction{tx: tx, ctx: ctx}, nil
+}
+
+// PostgresTransaction implements Transaction for PostgreSQL
+type PostgresTransaction struct {
+	tx  pgx.Tx
+	ctx context.Context // From BeginTx; used to commit or roll back
+}
+
+func (t *PostgresTransaction) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
+	return postgresQuery(ctx, t.tx, query, vars)
+}
+
+func (t *PostgresTransaction) QueryOne(ctx context.Context, query string, vars map[string]interface{}) (interface{}, error) {
+	results, err := t.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return firstResult(results)
+}
+
+func (t *PostgresTransaction) Execute(ctx context.Context, query string, vars map[string]interface{}) error {
+	_, err := t.Query(ctx, query, vars)
+	return err
+}
+
+func (t *PostgresTransaction) Commit() error {
+	err := t.tx.Commit(t.ctx)
+	if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: commit failed: %v", postgresError(err), err)
+	}
+	return nil
+}
+
+// Rollback rolls the transaction back. It runs even if the request was
+// cancelled, since that is a common reason to roll back.
+func (t *PostgresTransaction) Rollback() error {
+	err := t.tx.Rollback(context.WithoutCancel(t.ctx))
+	if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: rollback failed: %v", postgresError(err), err)
+	}
+	return nil
+}
+
+// postgresQuerier is the part of a pool or transaction postgresQuery uses
+type postgresQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// postgresQuery runs one statement and wraps its rows like a SurrealDB
+// statement result, each row a map of column name to value
+func postgresQuery(ctx context.Context, q postgresQuerier, query string, vars map[string]interface{}) ([]interface{}, error) {
+	sql, args, err := bindNamed(tagQuery(ctx, query), vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", postgresError(err), err)
+	}
+	defer rows.Close()
+
+	fields := rows.FieldDescriptions()
+	records := []interface{}{}
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrQuery, err)
+		}
+		record := make(map[string]interface{}, len(fields))
+		for i, field := range fields {
+			record[field.Name] = postgresValue(values[i])
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", postgresError(err), err)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"status": "OK",
+		"result": records,
+	}}, nil
+}
+
+// postgresValue converts the pgx values that don't round-trip through JSON
+// the way repositories expect: UUIDs become strings and numerics floats
+func postgresValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case [16]byte:
+		return uuid.UUID(v).String()
+	case pgtype.Numeric:
+		f, err := v.Float64Value()
+		if err != nil || !f.Valid {
+			return nil
+		}
+		return f.Float64
+	default:
+		return value
+	}
+}
+
+// postgresError returns the standard error matching a pgx error
+func postgresError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgUniqueViolation {
+			return ErrDuplicate
+		}
+		return ErrQuery
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) || pgconn.SafeToRetry(err) || pgconn.Timeout(err) {
+		return ErrConnection
+	}
+	return ErrQuery
+}
+
+// bindNamed rewrites the named $variables in query to PostgreSQL's
+// positional $1, $2, ... and returns their values from vars in order. A
+// variable used twice is bound once. Quoted strings and identifiers,
+// comments and dollar-quoted bodies are left alone.
+func bindNamed(query string, vars map[string]interface{}) (string, []interface{}, error) {
+	var (
+		out       strings.Builder
+		args      []interface{}
+		positions = map[string]int{}
+	)
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			// A doubled quote inside is an escape, which this handles as
+			// two adjacent literals
+			end := strings.IndexByte(query[i+1:], c)
+			if end < 0 {
+				return "", nil, fmt.Errorf("%w: unterminated quote", ErrQuery)
+			}
+			out.WriteString(query[i : i+end+2])
+			i += end + 2
+
+		case strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query) - i
+			}
+			out.WriteString(query[i : i+end])
+			i += end
+
+		case strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return "", nil, fmt.Errorf("%w: unterminated comment", ErrQuery)
+			}
+			out.WriteString(query[i : i+end+4])
+			i += end + 4
+
+		case c == '$' && i+1 < len(query) && (query[i+1] == '$' || isIdentStart(query[i+1])):
+			j := i + 1
+			for j < len(query) && isIdentPart(query[j]) {
+				j++
+			}
+
+			// $tag$ or $$ opens a dollar-quoted body that runs to the same tag
+			if j < len(query) && query[j] == '$' {
+				tag := query[i : j+1]
+				end := strings.Index(query[j+1:], tag)
+				if end < 0 {
+					return "", nil, fmt.Errorf("%w: unterminated dollar quote", ErrQuery)
+				}
+				end += j + 1 + len(tag)
+				out.WriteString(query[i:end])
+				i = end
+				continue
+			}
+
+			name := query[i+1 : j]
+			position, ok := positions[name]
+			if !ok {
+				value, ok := vars[name]
+				if !ok {
+					return "", nil, fmt.Errorf("%w: no value for $%s", ErrQuery, name)
+				}
+				args = append(args, value)
+				position = len(args)
+				positions[name] = position
+			}
+			fmt.Fprintf(&out, "$%d", position)
+			i = j
+
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+
+	return out.String(), args, nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
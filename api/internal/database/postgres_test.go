@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ============================================================================
+// Postgres Tests
+// ============================================================================
+
+func TestBindNamed(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{"id": "user:1", "limit": 10, "name": "Ada"}
+
+	tests := []struct {
+		name     string
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+		wantErr  error
+	}{
+		{
+			name:    "no variables",
+			query:   "SELECT 1",
+			wantSQL: "SELECT 1",
+		},
+		{
+			name:     "in order of first use",
+			query:    "SELECT * FROM users WHERE id = $id LIMIT $limit",
+			wantSQL:  "SELECT * FROM users WHERE id = $1 LIMIT $2",
+			wantArgs: []interface{}{"user:1", 10},
+		},
+		{
+			name:     "reused variable bound once",
+			query:    "SELECT * FROM users WHERE id = $id OR owner = $id",
+			wantSQL:  "SELECT * FROM users WHERE id = $1 OR owner = $1",
+			wantArgs: []interface{}{"user:1"},
+		},
+		{
+			name:     "casts and strings",
+			query:    "SELECT $name::text, '$id', 'it''s $id', \"$id\"",
+			wantSQL:  "SELECT $1::text, '$id', 'it''s $id', \"$id\"",
+			wantArgs: []interface{}{"Ada"},
+		},
+		{
+			name:     "comments",
+			query:    "-- request_id: $id\nSELECT /* $limit */ $name",
+			wantSQL:  "-- request_id: $id\nSELECT /* $limit */ $1",
+			wantArgs: []interface{}{"Ada"},
+		},
+		{
+			name:    "dollar quoted",
+			query:   "DO $$ SELECT $id $$; DO $fn$ SELECT $id $fn$",
+			wantSQL: "DO $$ SELECT $id $$; DO $fn$ SELECT $id $fn$",
+		},
+		{
+			name:    "missing variable",
+			query:   "SELECT * FROM users WHERE email = $email",
+			wantErr: ErrQuery,
+		},
+		{
+			name:    "unterminated quote",
+			query:   "SELECT 'abc",
+			wantErr: ErrQuery,
+		},
+		{
+			name:    "unterminated dollar quote",
+			query:   "DO $$ SELECT 1",
+			wantErr: ErrQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sql, args, err := bindNamed(tt.query, vars)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("bindNamed() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if sql != tt.wantSQL {
+				t.Errorf("bindNamed() sql = %q, want %q", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("bindNamed() args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestPostgresError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"unique violation", &pgconn.PgError{Code: pgUniqueViolation}, ErrDuplicate},
+		{"wrapped unique violation", fmt.Errorf("insert: %w", &pgconn.PgError{Code: pgUniqueViolation}), ErrDuplicate},
+		{"syntax error", &pgconn.PgError{Code: "42601"}, ErrQuery},
+		{"connect failed", &pgconn.ConnectError{}, ErrConnection},
+		{"other", errors.New("boom"), ErrQuery},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := postgresError(tt.err); got != tt.want {
+				t.Errorf("postgresError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostgresValue(t *testing.T) {
+	t.Parallel()
+
+	var numeric pgtype.Numeric
+	if err := numeric.Scan("12.5"); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{"uuid", [16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}, "12345678-9abc-def0-1234-56789abcdef0"},
+		{"numeric", numeric, 12.5},
+		{"null numeric", pgtype.Numeric{}, nil},
+		{"string", "Ada", "Ada"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := postgresValue(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("postgresValue(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostgres_ConnString(t *testing.T) {
+	t.Parallel()
+
+	p := NewPostgres(Config{Host: "db", Port: "5432", User: "saga", Password: "p@ss/word", Namespace: "saga", Database: "main"})
+	want := "postgres://saga:p%40ss%2Fword@db:5432/main?search_path=saga"
+	if got := p.connString(); got != want {
+		t.Errorf("connString() = %q, want %q", got, want)
+	}
+}
+
+func TestPostgres_NotConnected(t *testing.T) {
+	t.Parallel()
+
+	p := NewPostgres(Config{})
+	ctx := context.Background()
+	if _, err := p.Query(ctx, "SELECT 1", nil); !errors.Is(err, ErrConnection) {
+		t.Errorf("Query() error = %v, want ErrConnection", err)
+	}
+	if err := p.Ping(ctx); !errors.Is(err, ErrConnection) {
+		t.Errorf("Ping() error = %v, want ErrConnection", err)
+	}
+	if _, err := p.BeginTx(ctx); !errors.Is(err, ErrConnection) {
+		t.Errorf("BeginTx() error = %v, want ErrConnection", err)
+	}
+}
@@ -0,0 +1,320 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/pkg/redact"
+)
+
+// Query stats defaults
+const (
+	DefaultSlowQueryThreshold = 200 * time.Millisecond
+	// maxRecentSlowQueries bounds the slow-query ring kept for the admin endpoint
+	maxRecentSlowQueries = 50
+	// maxLoggedQueryLength truncates query text in slow-query logs
+	maxLoggedQueryLength = 500
+	// unknownQueryMethod labels queries not issued from a repository method
+	unknownQueryMethod = "unknown"
+)
+
+// QueryStatsConfig holds query instrumentation settings
+type QueryStatsConfig struct {
+	SlowThreshold time.Duration // Queries slower than this are logged and counted as slow
+}
+
+// QueryMethodStats aggregates query metrics for one repository method
+type QueryMethodStats struct {
+	Method        string        `json:"method"`
+	Count         int64         `json:"count"`
+	Errors        int64         `json:"errors"`
+	SlowCount     int64         `json:"slow_count"`
+	Rows          int64         `json:"rows"`
+	TotalDuration time.Duration `json:"total_duration_ns"`
+	MaxDuration   time.Duration `json:"max_duration_ns"`
+}
+
+// AvgDuration returns the mean query duration
+func (s QueryMethodStats) AvgDuration() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.TotalDuration / time.Duration(s.Count)
+}
+
+// SlowQuery records a single query that exceeded the slow threshold
+type SlowQuery struct {
+	Method     string        `json:"method"`
+	Query      string        `json:"query"`
+	Duration   time.Duration `json:"duration_ns"`
+	Rows       int           `json:"rows"`
+	Error      string        `json:"error,omitempty"`
+	ExecutedOn time.Time     `json:"executed_on"`
+}
+
+// QueryStats collects per-method query metrics and recent slow queries.
+// It is safe for concurrent use.
+type QueryStats struct {
+	mu            sync.Mutex
+	slowThreshold time.Duration
+	methods       map[string]*QueryMethodStats
+	recentSlow    []SlowQuery
+	since         time.Time
+}
+
+// NewQueryStats creates a new query stats collector
+func NewQueryStats(cfg QueryStatsConfig) *QueryStats {
+	if cfg.SlowThreshold == 0 {
+		cfg.SlowThreshold = DefaultSlowQueryThreshold
+	}
+	return &QueryStats{
+		slowThreshold: cfg.SlowThreshold,
+		methods:       make(map[string]*QueryMethodStats),
+		since:         time.Now(),
+	}
+}
+
+// SlowThreshold returns the configured slow-query threshold
+func (q *QueryStats) SlowThreshold() time.Duration {
+	return q.slowThreshold
+}
+
+// Since returns when stats collection started or was last reset
+func (q *QueryStats) Since() time.Time {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.since
+}
+
+// Record adds a completed query to the stats and logs it if slow
+func (q *QueryStats) Record(method, query string, duration time.Duration, rows int, err error) {
+	slow := duration >= q.slowThreshold
+
+	q.mu.Lock()
+	stats, ok := q.methods[method]
+	if !ok {
+		stats = &QueryMethodStats{Method: method}
+		q.methods[method] = stats
+	}
+	stats.Count++
+	stats.Rows += int64(rows)
+	stats.TotalDuration += duration
+	if duration > stats.MaxDuration {
+		stats.MaxDuration = duration
+	}
+	if err != nil {
+		stats.Errors++
+	}
+	if slow {
+		stats.SlowCount++
+		entry := SlowQuery{
+			Method:     method,
+			Query:      truncateQuery(query),
+			Duration:   duration,
+			Rows:       rows,
+			ExecutedOn: time.Now(),
+		}
+		if err != nil {
+			// Errors can echo the values a query was run with
+			entry.Error = redact.String(err.Error())
+		}
+		q.recentSlow = append(q.recentSlow, entry)
+		if len(q.recentSlow) > maxRecentSlowQueries {
+			q.recentSlow = q.recentSlow[len(q.recentSlow)-maxRecentSlowQueries:]
+		}
+	}
+	q.mu.Unlock()
+
+	if slow {
+		slog.Warn("slow query",
+			slog.String("method", method),
+			slog.Duration("duration", duration),
+			slog.Int("rows", rows),
+			slog.String("query", truncateQuery(query)),
+		)
+	}
+}
+
+// Methods returns a copy of the per-method stats, sorted by total duration descending
+func (q *QueryStats) Methods() []QueryMethodStats {
+	q.mu.Lock()
+	result := make([]QueryMethodStats, 0, len(q.methods))
+	for _, stats := range q.methods {
+		result = append(result, *stats)
+	}
+	q.mu.Unlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TotalDuration != result[j].TotalDuration {
+			return result[i].TotalDuration > result[j].TotalDuration
+		}
+		return result[i].Method < result[j].Method
+	})
+	return result
+}
+
+// RecentSlowQueries returns the most recent slow queries, newest first
+func (q *QueryStats) RecentSlowQueries() []SlowQuery {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	result := make([]SlowQuery, len(q.recentSlow))
+	for i, entry := range q.recentSlow {
+		result[len(q.recentSlow)-1-i] = entry
+	}
+	return result
+}
+
+// Reset clears all collected stats
+func (q *QueryStats) Reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.methods = make(map[string]*QueryMethodStats)
+	q.recentSlow = nil
+	q.since = time.Now()
+}
+
+// WriteMetrics writes the stats in Prometheus text exposition format
+func (q *QueryStats) WriteMetrics(w io.Writer) {
+	methods := q.Methods()
+
+	writeMetricFamily(w, "saga_db_queries_total", "counter", "Total queries executed per repository method", methods,
+		func(s QueryMethodStats) string { return fmt.Sprintf("%d", s.Count) })
+	writeMetricFamily(w, "saga_db_query_errors_total", "counter", "Failed queries per repository method", methods,
+		func(s QueryMethodStats) string { return fmt.Sprintf("%d", s.Errors) })
+	writeMetricFamily(w, "saga_db_slow_queries_total", "counter", "Queries over the slow threshold per repository method", methods,
+		func(s QueryMethodStats) string { return fmt.Sprintf("%d", s.SlowCount) })
+	writeMetricFamily(w, "saga_db_query_rows_total", "counter", "Rows returned per repository method", methods,
+		func(s QueryMethodStats) string { return fmt.Sprintf("%d", s.Rows) })
+	writeMetricFamily(w, "saga_db_query_duration_seconds_sum", "counter", "Total query time per repository method", methods,
+		func(s QueryMethodStats) string { return fmt.Sprintf("%g", s.TotalDuration.Seconds()) })
+	writeMetricFamily(w, "saga_db_query_duration_seconds_max", "gauge", "Slowest query per repository method", methods,
+		func(s QueryMethodStats) string { return fmt.Sprintf("%g", s.MaxDuration.Seconds()) })
+}
+
+func writeMetricFamily(w io.Writer, name, kind, help string, methods []QueryMethodStats, value func(QueryMethodStats) string) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	for _, s := range methods {
+		_, _ = fmt.Fprintf(w, "%s{method=%q} %s\n", name, s.Method, value(s))
+	}
+}
+
+func truncateQuery(query string) string {
+	query = strings.Join(strings.Fields(query), " ")
+	if len(query) > maxLoggedQueryLength {
+		return query[:maxLoggedQueryLength] + "..."
+	}
+	return query
+}
+
+// InstrumentedDB wraps a Database and records duration, row counts and
+// errors for every query, attributed to the calling repository method.
+// Queries inside WithTransaction are recorded too; statements sent on a
+// Transaction directly are not.
+type InstrumentedDB struct {
+	Database
+	stats *QueryStats
+}
+
+// NewInstrumentedDB wraps db so that all queries are recorded in stats
+func NewInstrumentedDB(db Database, stats *QueryStats) *InstrumentedDB {
+	return &InstrumentedDB{Database: db, stats: stats}
+}
+
+// Query executes a query and records its metrics
+func (d *InstrumentedDB) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
+	start := time.Now()
+	results, err := d.Database.Query(ctx, query, vars)
+	d.stats.Record(callerMethod(), query, time.Since(start), countResultRows(results), err)
+	return results, err
+}
+
+// QueryOne executes a query and records its metrics
+func (d *InstrumentedDB) QueryOne(ctx context.Context, query string, vars map[string]interface{}) (interface{}, error) {
+	start := time.Now()
+	result, err := d.Database.QueryOne(ctx, query, vars)
+	rows := 0
+	if result != nil {
+		rows = 1
+	}
+	// Not found is an expected outcome, not a failed query
+	recordErr := err
+	if errors.Is(recordErr, ErrNotFound) {
+		recordErr = nil
+	}
+	d.stats.Record(callerMethod(), query, time.Since(start), rows, recordErr)
+	return result, err
+}
+
+// Execute runs a mutation and records its metrics
+func (d *InstrumentedDB) Execute(ctx context.Context, query string, vars map[string]interface{}) error {
+	start := time.Now()
+	err := d.Database.Execute(ctx, query, vars)
+	d.stats.Record(callerMethod(), query, time.Since(start), 0, err)
+	return err
+}
+
+// countResultRows sums the rows across all statement results
+func countResultRows(results []interface{}) int {
+	rows := 0
+	for _, r := range results {
+		resp, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if data, ok := resp["result"].([]interface{}); ok {
+			rows += len(data)
+		} else if resp["result"] != nil {
+			rows++
+		}
+	}
+	return rows
+}
+
+// callerMethod returns the first repository (or, failing that, service)
+// function on the call stack, e.g. "repository.(*EventRepository).Get"
+func callerMethod() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	fallback := ""
+	for {
+		frame, more := frames.Next()
+		name := shortFuncName(frame.Function)
+		switch {
+		case strings.HasPrefix(name, "repository."):
+			return name
+		case fallback == "" && (strings.HasPrefix(name, "service.") || strings.HasPrefix(name, "jobs.")):
+			fallback = name
+		}
+		if !more {
+			break
+		}
+	}
+
+	if fallback != "" {
+		return fallback
+	}
+	return unknownQueryMethod
+}
+
+// shortFuncName trims the module path and closure suffixes from a fully
+// qualified function name
+func shortFuncName(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.Index(name, ".func"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
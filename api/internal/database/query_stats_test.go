@@ -0,0 +1,72 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeDatabase struct {
+	Database
+	delay   time.Duration
+	results []interface{}
+}
+
+func (f *fakeDatabase) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
+	time.Sleep(f.delay)
+	return f.results, nil
+}
+
+func TestInstrumentedDB_RecordsRowsAndSlowQueries(t *testing.T) {
+	t.Parallel()
+
+	stats := NewQueryStats(QueryStatsConfig{SlowThreshold: time.Millisecond})
+	db := NewInstrumentedDB(&fakeDatabase{
+		delay: 2 * time.Millisecond,
+		results: []interface{}{
+			map[string]interface{}{"status": "OK", "result": []interface{}{1, 2, 3}},
+		},
+	}, stats)
+
+	if _, err := db.Query(context.Background(), "SELECT * FROM user", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	methods := stats.Methods()
+	if len(methods) != 1 {
+		t.Fatalf("expected 1 method, got %d", len(methods))
+	}
+	if methods[0].Count != 1 || methods[0].Rows != 3 || methods[0].SlowCount != 1 {
+		t.Errorf("unexpected stats: %+v", methods[0])
+	}
+	if len(stats.RecentSlowQueries()) != 1 {
+		t.Errorf("expected 1 recent slow query, got %d", len(stats.RecentSlowQueries()))
+	}
+
+	var buf bytes.Buffer
+	stats.WriteMetrics(&buf)
+	if !strings.Contains(buf.String(), `saga_db_queries_total{method="`+methods[0].Method+`"} 1`) {
+		t.Errorf("expected query counter in metrics output, got:\n%s", buf.String())
+	}
+
+	stats.Reset()
+	if len(stats.Methods()) != 0 || len(stats.RecentSlowQueries()) != 0 {
+		t.Error("expected stats to be cleared after reset")
+	}
+}
+
+func TestShortFuncName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"github.com/forgo/saga/api/internal/repository.(*EventRepository).Get":       "repository.(*EventRepository).Get",
+		"github.com/forgo/saga/api/internal/repository.(*EventRepository).Get.func1": "repository.(*EventRepository).Get",
+	}
+	for in, want := range tests {
+		if got := shortFuncName(in); got != want {
+			t.Errorf("shortFuncName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
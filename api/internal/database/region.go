@@ -0,0 +1,197 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/surrealdb/surrealdb.go/pkg/models"
+)
+
+// RegionRole is the role this deployment plays in an active-passive pair
+type RegionRole string
+
+const (
+	// RegionRoleActive serves reads and writes and publishes the replication heartbeat
+	RegionRoleActive RegionRole = "active"
+	// RegionRoleStandby serves reads only and measures replica lag from the heartbeat
+	RegionRoleStandby RegionRole = "standby"
+)
+
+// RegionConfig holds multi-region settings
+type RegionConfig struct {
+	Name          string
+	Role          RegionRole
+	ReadOnly      bool          // Force read-only even when active (e.g. during failover drills)
+	MaxReplicaLag time.Duration // Lag above which a standby reports itself degraded
+}
+
+// RegionStatus is a point-in-time snapshot of the region state
+type RegionStatus struct {
+	Name              string     `json:"name"`
+	Role              RegionRole `json:"role"`
+	ReadOnly          bool       `json:"read_only"`
+	ReplicaLagSeconds *float64   `json:"replica_lag_seconds,omitempty"`
+	LagCheckedOn      *time.Time `json:"lag_checked_on,omitempty"`
+	LagExceeded       bool       `json:"lag_exceeded"`
+}
+
+// RegionState tracks the region role, read-only flag and replica lag.
+// It is safe for concurrent use and may be changed at runtime for failover drills.
+type RegionState struct {
+	mu           sync.RWMutex
+	name         string
+	role         RegionRole
+	readOnly     bool
+	maxLag       time.Duration
+	lag          time.Duration
+	lagCheckedOn time.Time
+	changed      chan struct{} // Closed and replaced when role or read-only changes
+}
+
+// NewRegionState creates region state from configuration
+func NewRegionState(cfg RegionConfig) *RegionState {
+	role := cfg.Role
+	if role == "" {
+		role = RegionRoleActive
+	}
+	return &RegionState{
+		name:     cfg.Name,
+		role:     role,
+		readOnly: cfg.ReadOnly,
+		maxLag:   cfg.MaxReplicaLag,
+		changed:  make(chan struct{}),
+	}
+}
+
+// Role returns the current region role
+func (r *RegionState) Role() RegionRole {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.role
+}
+
+// SetRole changes the region role, e.g. when promoting a standby
+func (r *RegionState) SetRole(role RegionRole) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.role != role {
+		r.role = role
+		r.notifyChanged()
+	}
+}
+
+// ReadOnly reports whether writes must be rejected.
+// A standby region is always read-only.
+func (r *RegionState) ReadOnly() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.readOnly || r.role == RegionRoleStandby
+}
+
+// SetReadOnly sets the explicit read-only flag
+func (r *RegionState) SetReadOnly(readOnly bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.readOnly != readOnly {
+		r.readOnly = readOnly
+		r.notifyChanged()
+	}
+}
+
+// Changed returns a channel that is closed the next time the role or the
+// read-only flag changes. Call it again after it fires to keep watching.
+func (r *RegionState) Changed() <-chan struct{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.changed
+}
+
+// notifyChanged wakes everyone waiting on Changed. Callers hold r.mu.
+func (r *RegionState) notifyChanged() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+// RecordReplicaLag stores the latest replica lag measurement
+func (r *RegionState) RecordReplicaLag(lag time.Duration, checkedOn time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lag = lag
+	r.lagCheckedOn = checkedOn
+}
+
+// Status returns a snapshot of the region state
+func (r *RegionState) Status() RegionStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	status := RegionStatus{
+		Name:     r.name,
+		Role:     r.role,
+		ReadOnly: r.readOnly || r.role == RegionRoleStandby,
+	}
+
+	if r.role == RegionRoleStandby && !r.lagCheckedOn.IsZero() {
+		seconds := r.lag.Seconds()
+		checkedOn := r.lagCheckedOn
+		status.ReplicaLagSeconds = &seconds
+		status.LagCheckedOn = &checkedOn
+		status.LagExceeded = r.maxLag > 0 && r.lag > r.maxLag
+	}
+
+	return status
+}
+
+// Heartbeat publishes or reads the replication heartbeat.
+// The active region writes the current time; a standby reads the replicated
+// value and records how far behind it is.
+func (r *RegionState) Heartbeat(ctx context.Context, db Database) error {
+	if r.Role() == RegionRoleActive {
+		return db.Execute(ctx, `UPSERT region_heartbeat:primary SET region = $region, beat_at = time::now()`, map[string]interface{}{
+			"region": r.name,
+		})
+	}
+
+	result, err := db.QueryOne(ctx, `SELECT beat_at FROM region_heartbeat:primary`, nil)
+	if err != nil {
+		return err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%w: unexpected heartbeat result", ErrQuery)
+	}
+
+	beatAt, err := parseHeartbeatTime(data["beat_at"])
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	r.RecordReplicaLag(now.Sub(beatAt), now)
+	return nil
+}
+
+func parseHeartbeatTime(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case models.CustomDateTime:
+		return t.Time, nil
+	case *models.CustomDateTime:
+		if t != nil {
+			return t.Time, nil
+		}
+		return time.Time{}, fmt.Errorf("%w: missing heartbeat time", ErrQuery)
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid heartbeat time: %v", ErrQuery, err)
+		}
+		return parsed, nil
+	default:
+		return time.Time{}, fmt.Errorf("%w: missing heartbeat time", ErrQuery)
+	}
+}
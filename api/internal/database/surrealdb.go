@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/forgo/saga/api/internal/requestid"
 	"github.com/surrealdb/surrealdb.go"
 )
 
@@ -70,37 +71,17 @@ func (s *SurrealDB) Ping(ctx context.Context) error {
 	return nil
 }
 
-// Query executes a query and returns results
+// Query executes a query and returns results. Inside WithTransaction the
+// query runs in the context's transaction.
 func (s *SurrealDB) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, vars)
+	}
 	if s.db == nil {
 		return nil, ErrConnection
 	}
 
-	results, err := surrealdb.Query[interface{}](ctx, s.db, query, vars)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrQuery, err)
-	}
-
-	// Convert QueryResult to []interface{}
-	if results == nil {
-		return nil, nil
-	}
-
-	output := make([]interface{}, 0, len(*results))
-	for _, r := range *results {
-		if r.Status != "OK" {
-			if r.Error != nil {
-				return nil, fmt.Errorf("%w: %s", ErrQuery, r.Error.Message)
-			}
-			return nil, ErrQuery
-		}
-		output = append(output, map[string]interface{}{
-			"status": r.Status,
-			"result": r.Result,
-		})
-	}
-
-	return output, nil
+	return queryOutput(surrealdb.Query[interface{}](ctx, s.db, tagQuery(ctx, query), vars))
 }
 
 // QueryOne executes a query and returns a single result
@@ -109,28 +90,7 @@ func (s *SurrealDB) QueryOne(ctx context.Context, query string, vars map[string]
 	if err != nil {
 		return nil, err
 	}
-
-	if len(results) == 0 {
-		return nil, ErrNotFound
-	}
-
-	// Unwrap the response wrapper {status: "OK", result: [...]}
-	first := results[0]
-	if resp, ok := first.(map[string]interface{}); ok {
-		if status, ok := resp["status"].(string); ok && status == "OK" {
-			if resultData, ok := resp["result"].([]interface{}); ok {
-				if len(resultData) == 0 {
-					return nil, ErrNotFound
-				}
-				// Return the first record from the result array
-				return resultData[0], nil
-			}
-			// Result is not an array, return as-is (e.g., scalar values)
-			return resp["result"], nil
-		}
-	}
-
-	return first, nil
+	return firstResult(results)
 }
 
 // Execute runs a query without returning results
@@ -139,83 +99,127 @@ func (s *SurrealDB) Execute(ctx context.Context, query string, vars map[string]i
 	return err
 }
 
-// BeginTx starts a new transaction
+// BeginTx starts an interactive transaction (SurrealDB v3 over WebSocket).
+// Statements run as they are issued, so their results can be read before
+// deciding to commit.
 func (s *SurrealDB) BeginTx(ctx context.Context) (Transaction, error) {
 	if s.db == nil {
 		return nil, ErrConnection
 	}
 
-	// SurrealDB transactions are handled via BEGIN TRANSACTION / COMMIT / CANCEL
-	// We wrap this in a transaction object
-	return &SurrealTransaction{
-		db:      s.db,
-		ctx:     ctx,
-		queries: make([]txQuery, 0),
-	}, nil
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: begin failed: %v", ErrQuery, err)
+	}
+	return &SurrealTransaction{tx: tx, ctx: ctx}, nil
 }
 
 // SurrealTransaction implements Transaction for SurrealDB
 type SurrealTransaction struct {
-	db        *surrealdb.DB
-	ctx       context.Context
-	queries   []txQuery
-	committed bool
-}
-
-type txQuery struct {
-	query string
-	vars  map[string]interface{}
+	tx  *surrealdb.Transaction
+	ctx context.Context // From BeginTx; used to commit or cancel
 }
 
 func (t *SurrealTransaction) Query(ctx context.Context, query string, vars map[string]interface{}) ([]interface{}, error) {
-	t.queries = append(t.queries, txQuery{query: query, vars: vars})
-	// In SurrealDB, we batch execute on commit
-	return nil, nil
+	return queryOutput(surrealdb.Query[interface{}](ctx, t.tx, tagQuery(ctx, query), vars))
 }
 
 func (t *SurrealTransaction) QueryOne(ctx context.Context, query string, vars map[string]interface{}) (interface{}, error) {
-	t.queries = append(t.queries, txQuery{query: query, vars: vars})
-	return nil, nil
+	results, err := t.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return firstResult(results)
 }
 
 func (t *SurrealTransaction) Execute(ctx context.Context, query string, vars map[string]interface{}) error {
-	t.queries = append(t.queries, txQuery{query: query, vars: vars})
-	return nil
+	_, err := t.Query(ctx, query, vars)
+	return err
 }
 
 func (t *SurrealTransaction) Commit() error {
-	if t.committed {
+	if t.tx.IsClosed() {
 		return nil
 	}
+	if err := t.tx.Commit(t.ctx); err != nil {
+		return fmt.Errorf("%w: commit failed: %v", ErrQuery, err)
+	}
+	return nil
+}
 
-	// Build transaction query
-	txQueryStr := "BEGIN TRANSACTION;\n"
-	for _, q := range t.queries {
-		txQueryStr += q.query + ";\n"
+// Rollback cancels the transaction. It runs even if the request was
+// cancelled, since that is a common reason to roll back.
+func (t *SurrealTransaction) Rollback() error {
+	if t.tx.IsClosed() {
+		return nil
+	}
+	if err := t.tx.Cancel(context.WithoutCancel(t.ctx)); err != nil {
+		return fmt.Errorf("%w: rollback failed: %v", ErrQuery, err)
 	}
-	txQueryStr += "COMMIT TRANSACTION;"
+	return nil
+}
 
-	// Merge all vars
-	allVars := make(map[string]interface{})
-	for _, q := range t.queries {
-		for k, v := range q.vars {
-			allVars[k] = v
-		}
+// tagQuery prefixes the query with a comment naming the request that caused
+// it, so slow or failing statements in the database's logs can be traced
+// back to the request. An ID that could end the comment is left out.
+func tagQuery(ctx context.Context, query string) string {
+	id := requestid.FromContext(ctx)
+	if !requestid.Valid(id) {
+		return query
 	}
+	return "-- request_id: " + id + "\n" + query
+}
 
-	_, err := surrealdb.Query[interface{}](t.ctx, t.db, txQueryStr, allVars)
+// queryOutput converts SurrealDB query results to the response wrappers
+// repositories parse, failing on the first statement that didn't succeed
+func queryOutput(results *[]surrealdb.QueryResult[interface{}], err error) ([]interface{}, error) {
 	if err != nil {
-		return fmt.Errorf("%w: commit failed: %v", ErrQuery, err)
+		return nil, fmt.Errorf("%w: %v", ErrQuery, err)
+	}
+	if results == nil {
+		return nil, nil
 	}
 
-	t.committed = true
-	return nil
+	output := make([]interface{}, 0, len(*results))
+	for _, r := range *results {
+		if r.Status != "OK" {
+			if r.Error != nil {
+				return nil, fmt.Errorf("%w: %s", ErrQuery, r.Error.Message)
+			}
+			return nil, ErrQuery
+		}
+		output = append(output, map[string]interface{}{
+			"status": r.Status,
+			"result": r.Result,
+		})
+	}
+
+	return output, nil
 }
 
-func (t *SurrealTransaction) Rollback() error {
-	// Clear pending queries
-	t.queries = nil
-	return nil
+// firstResult returns the first record of the first statement's result
+func firstResult(results []interface{}) (interface{}, error) {
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+
+	// Unwrap the response wrapper {status: "OK", result: [...]}
+	first := results[0]
+	if resp, ok := first.(map[string]interface{}); ok {
+		if status, ok := resp["status"].(string); ok && status == "OK" {
+			if resultData, ok := resp["result"].([]interface{}); ok {
+				if len(resultData) == 0 {
+					return nil, ErrNotFound
+				}
+				// Return the first record from the result array
+				return resultData[0], nil
+			}
+			// Result is not an array, return as-is (e.g., scalar values)
+			return resp["result"], nil
+		}
+	}
+
+	return first, nil
 }
 
 // UnmarshalResult unmarshals SurrealDB query results into the given type.
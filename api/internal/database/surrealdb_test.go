@@ -0,0 +1,28 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/requestid"
+)
+
+func TestTagQuery(t *testing.T) {
+	t.Parallel()
+
+	query := "SELECT * FROM user"
+
+	if got := tagQuery(context.Background(), query); got != query {
+		t.Errorf("without a request ID: got %q, want the query unchanged", got)
+	}
+
+	ctx := requestid.NewContext(context.Background(), "req-42")
+	if got, want := tagQuery(ctx, query), "-- request_id: req-42\n"+query; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	ctx = requestid.NewContext(context.Background(), "req\nDELETE user")
+	if got := tagQuery(ctx, query); got != query {
+		t.Errorf("with an invalid request ID: got %q, want the query unchanged", got)
+	}
+}
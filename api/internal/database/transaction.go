@@ -5,6 +5,19 @@ package database
 // This file provides multiple patterns for atomic database operations.
 // Choose the right pattern based on your needs:
 //
+// # WithTransaction (For service operations spanning repositories)
+//
+// Runs a function in an interactive transaction. Repositories called with
+// the context it passes in join the transaction without any changes, and
+// see each other's writes:
+//
+//	err := WithTransaction(ctx, db, func(ctx context.Context) error {
+//		if err := guilds.Create(ctx, guild); err != nil {
+//			return err  // Nothing is kept
+//		}
+//		return guilds.AddMember(ctx, memberID, guild.ID)
+//	})
+//
 // # AtomicBatch (Recommended for most cases)
 //
 // Simple, fluent API for 2-5 statements that must succeed together:
@@ -41,16 +54,78 @@ package database
 //	mso.AddStep("step2", executeFunc, rollbackFunc)
 //	mso.Execute(ctx)  // Rollbacks run in reverse order on failure
 //
-// IMPORTANT: All patterns are BATCH-BASED. Queries accumulate and execute
-// together at commit time. There is no isolation between Add() calls.
+// IMPORTANT: All patterns but WithTransaction are BATCH-BASED. Queries
+// accumulate and execute together at commit time. There is no isolation
+// between Add() calls.
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync/atomic"
 )
 
+// txKey is the context key for the transaction WithTransaction runs in
+type txKey struct{}
+
+// ContextWithTx returns a copy of ctx whose queries run in tx
+func ContextWithTx(ctx context.Context, tx Transaction) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext returns the transaction ctx's queries run in, if any
+func TxFromContext(ctx context.Context) (Transaction, bool) {
+	tx, ok := ctx.Value(txKey{}).(Transaction)
+	return tx, ok
+}
+
+// WithTransaction runs fn in a transaction on db. Queries made with the
+// context passed to fn, including through repositories, run inside it. The
+// transaction commits if fn returns nil and rolls back if it returns an
+// error or panics. Called inside another WithTransaction, fn joins the
+// outer transaction.
+func WithTransaction(ctx context.Context, db Database, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(ContextWithTx(ctx, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Transactor runs functions in transactions on one database, for services
+// that hold repositories rather than the database itself
+type Transactor struct {
+	db Database
+}
+
+// NewTransactor creates a transactor for db
+func NewTransactor(db Database) *Transactor {
+	return &Transactor{db: db}
+}
+
+// WithTransaction runs fn in a transaction; see the package function
+func (t *Transactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return WithTransaction(ctx, t.db, fn)
+}
+
 // TxBuilder builds atomic transaction queries with automatic variable namespacing.
 // This prevents variable name collisions when combining queries from different sources.
 //
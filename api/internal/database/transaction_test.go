@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fakeTx records how a transaction ended
+type fakeTx struct {
+	Transaction
+	committed  bool
+	rolledBack bool
+}
+
+func (f *fakeTx) Commit() error {
+	f.committed = true
+	return nil
+}
+
+func (f *fakeTx) Rollback() error {
+	f.rolledBack = true
+	return nil
+}
+
+// txDatabase hands out fakeTx transactions
+type txDatabase struct {
+	Database
+	began    int
+	beginErr error
+	tx       *fakeTx
+}
+
+func (d *txDatabase) BeginTx(ctx context.Context) (Transaction, error) {
+	if d.beginErr != nil {
+		return nil, d.beginErr
+	}
+	d.began++
+	d.tx = &fakeTx{}
+	return d.tx, nil
+}
+
+// ============================================================================
+// WithTransaction Tests
+// ============================================================================
+
+func TestWithTransaction_CommitsOnSuccess(t *testing.T) {
+	t.Parallel()
+
+	db := &txDatabase{}
+	err := WithTransaction(context.Background(), db, func(ctx context.Context) error {
+		if tx, ok := TxFromContext(ctx); !ok || tx != db.tx {
+			t.Error("expected the transaction in the context")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !db.tx.committed || db.tx.rolledBack {
+		t.Errorf("expected commit only, got %+v", db.tx)
+	}
+}
+
+func TestWithTransaction_RollsBackOnError(t *testing.T) {
+	t.Parallel()
+
+	db := &txDatabase{}
+	failure := errors.New("step failed")
+	err := WithTransaction(context.Background(), db, func(ctx context.Context) error {
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected the step error, got %v", err)
+	}
+	if db.tx.committed || !db.tx.rolledBack {
+		t.Errorf("expected rollback only, got %+v", db.tx)
+	}
+}
+
+func TestWithTransaction_RollsBackOnPanic(t *testing.T) {
+	t.Parallel()
+
+	db := &txDatabase{}
+	defer func() {
+		if recover() == nil {
+			t.Error("expected the panic to propagate")
+		}
+		if db.tx.committed || !db.tx.rolledBack {
+			t.Errorf("expected rollback only, got %+v", db.tx)
+		}
+	}()
+	_ = WithTransaction(context.Background(), db, func(ctx context.Context) error {
+		panic("boom")
+	})
+}
+
+func TestWithTransaction_JoinsOuterTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := &txDatabase{}
+	err := WithTransaction(context.Background(), db, func(ctx context.Context) error {
+		return WithTransaction(ctx, db, func(ctx context.Context) error {
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if db.began != 1 || !db.tx.committed {
+		t.Errorf("expected one committed transaction, began %d", db.began)
+	}
+}
+
+func TestWithTransaction_BeginError(t *testing.T) {
+	t.Parallel()
+
+	db := &txDatabase{beginErr: errors.New("connection lost")}
+	called := false
+	err := WithTransaction(context.Background(), db, func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, db.beginErr) || called {
+		t.Errorf("expected the begin error without running fn, got %v (called %v)", err, called)
+	}
+}
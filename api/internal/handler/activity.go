@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// ActivityHandler handles activity timeline endpoints
+type ActivityHandler struct {
+	activityService *service.ActivityService
+}
+
+// NewActivityHandler creates a new activity handler
+func NewActivityHandler(activityService *service.ActivityService) *ActivityHandler {
+	return &ActivityHandler{activityService: activityService}
+}
+
+// GetMyActivity handles GET /v1/profile/activity - get my activity timeline
+// Query params: limit (default 20, max 50), cursor (from the previous page)
+func (h *ActivityHandler) GetMyActivity(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	limit := model.DefaultActivityPageSize
+	if r.URL.Query().Get("limit") != "" {
+		if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= model.MaxActivityPageSize {
+			limit = l
+		}
+	}
+
+	page, err := h.activityService.GetTimeline(r.Context(), userID, r.URL.Query().Get("cursor"), limit)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidActivityCursor) {
+			WriteError(w, model.NewBadRequestError("invalid cursor"))
+			return
+		}
+		WriteError(w, model.NewInternalError("failed to get activity"))
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, page.Entries, &PaginationInfo{
+		Cursor:  page.NextCursor,
+		HasMore: page.NextCursor != "",
+	}, map[string]string{
+		"self": "/v1/profile/activity",
+	})
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminAnalyticsHandler exports the anonymized analytics event stream
+type AdminAnalyticsHandler struct {
+	analyticsEventService *service.AnalyticsEventService
+}
+
+// NewAdminAnalyticsHandler creates a new admin analytics handler
+func NewAdminAnalyticsHandler(analyticsEventService *service.AnalyticsEventService) *AdminAnalyticsHandler {
+	return &AdminAnalyticsHandler{analyticsEventService: analyticsEventService}
+}
+
+// Export handles GET /v1/admin/analytics/events/export?since=&until= -
+// events in the range as NDJSON, one per line. Both bounds are RFC 3339 and
+// default to the last 24 hours.
+func (h *AdminAnalyticsHandler) Export(w http.ResponseWriter, r *http.Request) {
+	until := time.Now().UTC()
+	if v := r.URL.Query().Get("until"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			WriteError(w, model.NewBadRequestError("until must be an RFC 3339 timestamp"))
+			return
+		}
+		until = t
+	}
+	since := until.Add(-24 * time.Hour)
+	if v := r.URL.Query().Get("since"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			WriteError(w, model.NewBadRequestError("since must be an RFC 3339 timestamp"))
+			return
+		}
+		since = t
+	}
+
+	// Nothing is written to w before the first event, so errors up to that
+	// point still get a problem response
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	written, err := h.analyticsEventService.Export(r.Context(), since, until, w)
+	if err == nil {
+		return
+	}
+	if written > 0 {
+		log.Printf("[AdminAnalyticsHandler] Export failed after %d events: %v", written, err)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrAnalyticsDisabled):
+		WriteError(w, model.NewNotFoundError("analytics export"))
+	case errors.Is(err, service.ErrInvalidAnalyticsRange):
+		WriteError(w, model.NewBadRequestError("since must be before until and in the past"))
+	default:
+		WriteError(w, model.NewInternalError("failed to export analytics events"))
+	}
+}
@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminContentHandler handles admin management of questions, question
+// categories and review tags
+type AdminContentHandler struct {
+	contentService *service.ContentService
+}
+
+// NewAdminContentHandler creates a new admin content handler
+func NewAdminContentHandler(contentService *service.ContentService) *AdminContentHandler {
+	return &AdminContentHandler{contentService: contentService}
+}
+
+// ===== Questions =====
+
+// ListQuestions handles GET /v1/admin/questions
+// Deprecated questions are included with ?include_inactive=true.
+func (h *AdminContentHandler) ListQuestions(w http.ResponseWriter, r *http.Request) {
+	includeInactive := r.URL.Query().Get("include_inactive") == "true"
+
+	questions, err := h.contentService.ListQuestions(r.Context(), includeInactive)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, questions, nil, map[string]string{
+		"self": "/v1/admin/questions",
+	})
+}
+
+// GetQuestion handles GET /v1/admin/questions/{questionId}
+func (h *AdminContentHandler) GetQuestion(w http.ResponseWriter, r *http.Request) {
+	questionID := r.PathValue("questionId")
+
+	question, err := h.contentService.GetQuestion(r.Context(), questionID)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, question, map[string]string{
+		"self": "/v1/admin/questions/" + questionID,
+	})
+}
+
+// CreateQuestion handles POST /v1/admin/questions
+func (h *AdminContentHandler) CreateQuestion(w http.ResponseWriter, r *http.Request) {
+	var req model.CreateQuestionRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	question, err := h.contentService.CreateQuestion(r.Context(), &req)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, question, map[string]string{
+		"self": "/v1/admin/questions/" + question.ID,
+	})
+}
+
+// UpdateQuestion handles PATCH /v1/admin/questions/{questionId}
+func (h *AdminContentHandler) UpdateQuestion(w http.ResponseWriter, r *http.Request) {
+	questionID := r.PathValue("questionId")
+
+	var req model.UpdateQuestionRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	question, err := h.contentService.UpdateQuestion(r.Context(), questionID, &req)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, question, map[string]string{
+		"self": "/v1/admin/questions/" + questionID,
+	})
+}
+
+// DeleteQuestion handles DELETE /v1/admin/questions/{questionId}
+// Questions that have answers are deprecated instead of deleted.
+func (h *AdminContentHandler) DeleteQuestion(w http.ResponseWriter, r *http.Request) {
+	removal, err := h.contentService.RemoveQuestion(r.Context(), r.PathValue("questionId"))
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, removal, nil)
+}
+
+// ===== Question Categories =====
+
+// ListQuestionCategories handles GET /v1/admin/question-categories
+func (h *AdminContentHandler) ListQuestionCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.contentService.ListQuestionCategories(r.Context())
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, categories, nil, map[string]string{
+		"self": "/v1/admin/question-categories",
+	})
+}
+
+// CreateQuestionCategory handles POST /v1/admin/question-categories
+func (h *AdminContentHandler) CreateQuestionCategory(w http.ResponseWriter, r *http.Request) {
+	var req model.CreateQuestionCategoryRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	category, err := h.contentService.CreateQuestionCategory(r.Context(), &req)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, category, map[string]string{
+		"self": "/v1/admin/question-categories/" + category.Key,
+	})
+}
+
+// UpdateQuestionCategory handles PATCH /v1/admin/question-categories/{key}
+func (h *AdminContentHandler) UpdateQuestionCategory(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+
+	var req model.UpdateQuestionCategoryRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	category, err := h.contentService.UpdateQuestionCategory(r.Context(), key, &req)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, category, map[string]string{
+		"self": "/v1/admin/question-categories/" + key,
+	})
+}
+
+// DeleteQuestionCategory handles DELETE /v1/admin/question-categories/{key}
+// Categories that still contain questions are deprecated instead of deleted.
+func (h *AdminContentHandler) DeleteQuestionCategory(w http.ResponseWriter, r *http.Request) {
+	removal, err := h.contentService.RemoveQuestionCategory(r.Context(), r.PathValue("key"))
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, removal, nil)
+}
+
+// ===== Review Tags =====
+
+// ListReviewTags handles GET /v1/admin/review-tags/{kind}
+func (h *AdminContentHandler) ListReviewTags(w http.ResponseWriter, r *http.Request) {
+	kind := model.ReviewTagKind(r.PathValue("kind"))
+
+	tags, err := h.contentService.ListReviewTags(r.Context(), kind)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, tags, nil, map[string]string{
+		"self": "/v1/admin/review-tags/" + string(kind),
+	})
+}
+
+// CreateReviewTag handles POST /v1/admin/review-tags/{kind}
+func (h *AdminContentHandler) CreateReviewTag(w http.ResponseWriter, r *http.Request) {
+	kind := model.ReviewTagKind(r.PathValue("kind"))
+
+	var req model.CreateReviewTagRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	tag, err := h.contentService.CreateReviewTag(r.Context(), kind, &req)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, tag, map[string]string{
+		"self": "/v1/admin/review-tags/" + string(kind) + "/" + tag.Tag,
+	})
+}
+
+// UpdateReviewTag handles PATCH /v1/admin/review-tags/{kind}/{tag}
+func (h *AdminContentHandler) UpdateReviewTag(w http.ResponseWriter, r *http.Request) {
+	kind := model.ReviewTagKind(r.PathValue("kind"))
+	tagName := r.PathValue("tag")
+
+	var req model.UpdateReviewTagRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	tag, err := h.contentService.UpdateReviewTag(r.Context(), kind, tagName, &req)
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, tag, map[string]string{
+		"self": "/v1/admin/review-tags/" + string(kind) + "/" + tagName,
+	})
+}
+
+// DeleteReviewTag handles DELETE /v1/admin/review-tags/{kind}/{tag}
+// Tags used by existing reviews are deprecated instead of deleted.
+func (h *AdminContentHandler) DeleteReviewTag(w http.ResponseWriter, r *http.Request) {
+	kind := model.ReviewTagKind(r.PathValue("kind"))
+
+	removal, err := h.contentService.RemoveReviewTag(r.Context(), kind, r.PathValue("tag"))
+	if err != nil {
+		h.handleContentError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, removal, nil)
+}
+
+func (h *AdminContentHandler) handleContentError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrQuestionNotFound):
+		WriteError(w, model.NewNotFoundError("question"))
+	case errors.Is(err, service.ErrQuestionCategoryNotFound):
+		WriteError(w, model.NewNotFoundError("question category"))
+	case errors.Is(err, service.ErrReviewTagNotFound):
+		WriteError(w, model.NewNotFoundError("review tag"))
+	case errors.Is(err, service.ErrQuestionCategoryExists),
+		errors.Is(err, service.ErrReviewTagExists),
+		errors.Is(err, service.ErrQuestionOptionInUse):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrUnknownQuestionCategory):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "category", Message: err.Error()},
+		}))
+	case errors.Is(err, service.ErrInvalidReviewTagKind):
+		WriteError(w, model.NewNotFoundError("review tag catalog"))
+	default:
+		WriteError(w, model.NewInternalError("content operation failed"))
+	}
+}
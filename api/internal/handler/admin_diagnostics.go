@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// maxLogLevelMinutes bounds how long a temporary log level can last
+const maxLogLevelMinutes = 1440
+
+// AdminDiagnosticsHandler handles runtime diagnostics endpoints
+type AdminDiagnosticsHandler struct {
+	logLevel    *slog.LevelVar
+	baseLevel   slog.Level // Level to revert to when a temporary level expires
+	snapshotDir string
+
+	mu        sync.Mutex
+	revert    *time.Timer
+	revertOn  time.Time
+	revertSeq uint64
+}
+
+// NewAdminDiagnosticsHandler creates a new admin diagnostics handler.
+// The current level of logLevel is the one temporary changes revert to.
+// Snapshots are written to snapshotDir, or the OS temp dir if empty.
+func NewAdminDiagnosticsHandler(logLevel *slog.LevelVar, snapshotDir string) *AdminDiagnosticsHandler {
+	if snapshotDir == "" {
+		snapshotDir = os.TempDir()
+	}
+	return &AdminDiagnosticsHandler{
+		logLevel:    logLevel,
+		baseLevel:   logLevel.Level(),
+		snapshotDir: snapshotDir,
+	}
+}
+
+// LogLevelRequest represents a request to change the log level
+type LogLevelRequest struct {
+	Level           string `json:"level"`
+	DurationMinutes *int   `json:"duration_minutes,omitempty"` // nil = until the next change or restart
+}
+
+// LogLevelResponse reports the current log level
+type LogLevelResponse struct {
+	Level        string     `json:"level"`
+	DefaultLevel string     `json:"default_level"`
+	ExpiresOn    *time.Time `json:"expires_on,omitempty"` // When the level reverts to default_level
+}
+
+// RuntimeSnapshot describes a goroutine/heap snapshot written to disk
+type RuntimeSnapshot struct {
+	GoroutineFile string    `json:"goroutine_file"`
+	HeapFile      string    `json:"heap_file"`
+	Goroutines    int       `json:"goroutines"`
+	HeapAllocMB   float64   `json:"heap_alloc_mb"`
+	HeapObjects   uint64    `json:"heap_objects"`
+	NumGC         uint32    `json:"num_gc"`
+	TakenOn       time.Time `json:"taken_on"`
+}
+
+// GetLogLevel handles GET /v1/admin/diagnostics/log-level
+func (h *AdminDiagnosticsHandler) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+	WriteData(w, http.StatusOK, h.logLevelStatus(), map[string]string{
+		"self": "/v1/admin/diagnostics/log-level",
+	})
+}
+
+// UpdateLogLevel handles PATCH /v1/admin/diagnostics/log-level - change the slog level without a redeploy
+func (h *AdminDiagnosticsHandler) UpdateLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req LogLevelRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(req.Level))); err != nil {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "level", Message: "level must be one of debug, info, warn, error"},
+		}))
+		return
+	}
+	if req.DurationMinutes != nil && (*req.DurationMinutes < 1 || *req.DurationMinutes > maxLogLevelMinutes) {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "duration_minutes", Message: fmt.Sprintf("duration_minutes must be between 1 and %d", maxLogLevelMinutes)},
+		}))
+		return
+	}
+
+	var duration time.Duration
+	if req.DurationMinutes != nil {
+		duration = time.Duration(*req.DurationMinutes) * time.Minute
+	}
+	h.setLogLevel(level, duration)
+
+	WriteData(w, http.StatusOK, h.logLevelStatus(), map[string]string{
+		"self": "/v1/admin/diagnostics/log-level",
+	})
+}
+
+// setLogLevel changes the log level, replacing any pending revert. A positive
+// duration reverts to the base level once it has passed.
+func (h *AdminDiagnosticsHandler) setLogLevel(level slog.Level, duration time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.revert != nil {
+		h.revert.Stop()
+		h.revert = nil
+		h.revertOn = time.Time{}
+	}
+	h.revertSeq++
+
+	previous := h.logLevel.Level()
+	h.logLevel.Set(level)
+	attrs := []any{
+		slog.String("from", previous.String()),
+		slog.String("to", level.String()),
+	}
+
+	if duration > 0 {
+		seq := h.revertSeq
+		h.revertOn = time.Now().Add(duration)
+		h.revert = time.AfterFunc(duration, func() { h.revertLogLevel(seq) })
+		attrs = append(attrs, slog.Time("expires_on", h.revertOn))
+	}
+
+	slog.Warn("log level changed", attrs...)
+}
+
+// revertLogLevel restores the base level, unless the level changed again
+// after the revert with sequence seq was scheduled
+func (h *AdminDiagnosticsHandler) revertLogLevel(seq uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if seq != h.revertSeq {
+		return
+	}
+	previous := h.logLevel.Level()
+	h.logLevel.Set(h.baseLevel)
+	h.revert = nil
+	h.revertOn = time.Time{}
+
+	slog.Warn("temporary log level expired",
+		slog.String("from", previous.String()),
+		slog.String("to", h.baseLevel.String()),
+	)
+}
+
+// logLevelStatus reports the current level and when it reverts, if ever
+func (h *AdminDiagnosticsHandler) logLevelStatus() LogLevelResponse {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	resp := LogLevelResponse{
+		Level:        h.logLevel.Level().String(),
+		DefaultLevel: h.baseLevel.String(),
+	}
+	if !h.revertOn.IsZero() {
+		expiresOn := h.revertOn
+		resp.ExpiresOn = &expiresOn
+	}
+	return resp
+}
+
+// Snapshot handles POST /v1/admin/diagnostics/snapshot - write goroutine and heap profiles to disk
+func (h *AdminDiagnosticsHandler) Snapshot(w http.ResponseWriter, r *http.Request) {
+	now := time.Now().UTC()
+	stamp := now.Format("20060102T150405Z")
+
+	goroutineFile := filepath.Join(h.snapshotDir, fmt.Sprintf("saga-goroutine-%s.txt", stamp))
+	if err := writeProfile(goroutineFile, func(f io.Writer) error {
+		return pprof.Lookup("goroutine").WriteTo(f, 2)
+	}); err != nil {
+		WriteError(w, model.NewInternalError("failed to write goroutine snapshot: "+err.Error()))
+		return
+	}
+
+	// Collect garbage first so the heap profile reflects live objects
+	runtime.GC()
+	heapFile := filepath.Join(h.snapshotDir, fmt.Sprintf("saga-heap-%s.pprof", stamp))
+	if err := writeProfile(heapFile, pprof.WriteHeapProfile); err != nil {
+		WriteError(w, model.NewInternalError("failed to write heap snapshot: "+err.Error()))
+		return
+	}
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	slog.Info("runtime snapshot written",
+		slog.String("goroutine_file", goroutineFile),
+		slog.String("heap_file", heapFile),
+	)
+
+	WriteData(w, http.StatusCreated, RuntimeSnapshot{
+		GoroutineFile: goroutineFile,
+		HeapFile:      heapFile,
+		Goroutines:    runtime.NumGoroutine(),
+		HeapAllocMB:   float64(mem.HeapAlloc) / (1024 * 1024),
+		HeapObjects:   mem.HeapObjects,
+		NumGC:         mem.NumGC,
+		TakenOn:       now,
+	}, nil)
+}
+
+func writeProfile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
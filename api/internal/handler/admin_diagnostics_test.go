@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestUpdateLogLevel_ValidatesDuration(t *testing.T) {
+	logLevel := new(slog.LevelVar)
+	h := NewAdminDiagnosticsHandler(logLevel, t.TempDir())
+
+	for _, body := range []string{
+		`{"level":"loud"}`,
+		`{"level":"debug","duration_minutes":0}`,
+		`{"level":"debug","duration_minutes":1441}`,
+	} {
+		req := httptest.NewRequest(http.MethodPatch, "/v1/admin/diagnostics/log-level", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		h.UpdateLogLevel(rr, req)
+
+		if rr.Code != http.StatusUnprocessableEntity {
+			t.Errorf("%s: expected 422, got %d", body, rr.Code)
+		}
+	}
+	if logLevel.Level() != slog.LevelInfo {
+		t.Errorf("rejected updates should leave the level alone, got %s", logLevel.Level())
+	}
+}
+
+func TestSetLogLevel_TemporaryLevelReverts(t *testing.T) {
+	logLevel := new(slog.LevelVar)
+	logLevel.Set(slog.LevelWarn)
+	h := NewAdminDiagnosticsHandler(logLevel, t.TempDir())
+
+	h.setLogLevel(slog.LevelDebug, 20*time.Millisecond)
+	status := h.logLevelStatus()
+	if status.Level != "DEBUG" || status.DefaultLevel != "WARN" || status.ExpiresOn == nil {
+		t.Fatalf("unexpected status after temporary change: %+v", status)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for logLevel.Level() != slog.LevelWarn && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if logLevel.Level() != slog.LevelWarn {
+		t.Fatalf("expected level to revert to WARN, got %s", logLevel.Level())
+	}
+	if h.logLevelStatus().ExpiresOn != nil {
+		t.Error("expected no expiry once reverted")
+	}
+}
+
+func TestSetLogLevel_LaterChangeCancelsRevert(t *testing.T) {
+	logLevel := new(slog.LevelVar)
+	h := NewAdminDiagnosticsHandler(logLevel, t.TempDir())
+
+	h.setLogLevel(slog.LevelDebug, 20*time.Millisecond)
+	h.setLogLevel(slog.LevelError, 0)
+
+	time.Sleep(50 * time.Millisecond)
+	if logLevel.Level() != slog.LevelError {
+		t.Errorf("expected the untimed change to stick, got %s", logLevel.Level())
+	}
+	if h.logLevelStatus().ExpiresOn != nil {
+		t.Error("expected no expiry after an untimed change")
+	}
+}
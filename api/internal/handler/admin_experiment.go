@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminExperimentHandler handles managing A/B experiments
+type AdminExperimentHandler struct {
+	experimentService *service.ExperimentService
+}
+
+// NewAdminExperimentHandler creates a new admin experiment handler
+func NewAdminExperimentHandler(experimentService *service.ExperimentService) *AdminExperimentHandler {
+	return &AdminExperimentHandler{experimentService: experimentService}
+}
+
+// List handles GET /v1/admin/experiments?status= - experiments, newest first
+func (h *AdminExperimentHandler) List(w http.ResponseWriter, r *http.Request) {
+	status := model.ExperimentStatus(r.URL.Query().Get("status"))
+	if status != "" && !status.IsValid() {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "status", Message: "status must be draft, running, or stopped"},
+		}))
+		return
+	}
+
+	experiments, err := h.experimentService.List(r.Context(), status)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, experiments, nil, map[string]string{
+		"self": "/v1/admin/experiments",
+	})
+}
+
+// Create handles POST /v1/admin/experiments - create a draft experiment
+func (h *AdminExperimentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req model.CreateExperimentRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	experiment, err := h.experimentService.Create(r.Context(), middleware.GetUserID(r.Context()), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, experiment, experimentLinks(experiment.Key))
+}
+
+// Get handles GET /v1/admin/experiments/{key}
+func (h *AdminExperimentHandler) Get(w http.ResponseWriter, r *http.Request) {
+	experiment, err := h.experimentService.Get(r.Context(), r.PathValue("key"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, experiment, experimentLinks(experiment.Key))
+}
+
+// Update handles PATCH /v1/admin/experiments/{key} - edit the description,
+// or start or stop the experiment
+func (h *AdminExperimentHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req model.UpdateExperimentRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	experiment, err := h.experimentService.Update(r.Context(), r.PathValue("key"), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, experiment, experimentLinks(experiment.Key))
+}
+
+func experimentLinks(key string) map[string]string {
+	return map[string]string{
+		"self":        "/v1/admin/experiments/" + key,
+		"experiments": "/v1/admin/experiments",
+	}
+}
+
+func (h *AdminExperimentHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrExperimentNotFound):
+		WriteError(w, model.NewNotFoundError("experiment"))
+	case errors.Is(err, service.ErrExperimentExists):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrInvalidExperimentTransition):
+		WriteError(w, model.NewConflictError(err.Error()))
+	default:
+		WriteError(w, model.NewInternalError("experiment operation failed"))
+	}
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminGuildImportHandler handles Meetup and Discord guild imports
+type AdminGuildImportHandler struct {
+	importService *service.GuildImportService
+}
+
+// NewAdminGuildImportHandler creates a new admin guild import handler
+func NewAdminGuildImportHandler(importService *service.GuildImportService) *AdminGuildImportHandler {
+	return &AdminGuildImportHandler{importService: importService}
+}
+
+// Create handles POST /v1/admin/import/guilds - upload a Meetup events
+// export or a Discord member list and preview how it maps onto a guild.
+// Nothing is created until the preview is committed.
+func (h *AdminGuildImportHandler) Create(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, model.MaxGuildImportBytes)
+
+	var req model.CreateGuildImportRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			WriteError(w, model.NewBadRequestError(fmt.Sprintf("request must be at most %d bytes", model.MaxGuildImportBytes)))
+			return
+		}
+		WriteError(w, model.NewBadRequestError("Invalid request body: "+err.Error()))
+		return
+	}
+
+	imp, err := h.importService.Preview(r.Context(), middleware.GetUserID(r.Context()), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, imp, guildImportLinks(imp))
+}
+
+// List handles GET /v1/admin/import/guilds?limit=N
+func (h *AdminGuildImportHandler) List(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	imports, err := h.importService.List(r.Context(), limit)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, imports, nil, map[string]string{
+		"self": "/v1/admin/import/guilds",
+	})
+}
+
+// Get handles GET /v1/admin/import/guilds/{importId}
+func (h *AdminGuildImportHandler) Get(w http.ResponseWriter, r *http.Request) {
+	imp, err := h.importService.Get(r.Context(), r.PathValue("importId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, imp, guildImportLinks(imp))
+}
+
+// Commit handles POST /v1/admin/import/guilds/{importId}/commit - apply
+// corrections to the preview and carry it out. The body is optional.
+// Members are imported in the background by the linked user import.
+func (h *AdminGuildImportHandler) Commit(w http.ResponseWriter, r *http.Request) {
+	var req model.CommitGuildImportRequest
+	if err := DecodeJSON(r, &req); err != nil && !errors.Is(err, io.EOF) {
+		WriteError(w, model.NewBadRequestError("Invalid request body: "+err.Error()))
+		return
+	}
+
+	imp, err := h.importService.Commit(r.Context(), middleware.GetUserID(r.Context()), r.PathValue("importId"), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, imp, guildImportLinks(imp))
+}
+
+func guildImportLinks(imp *model.GuildImport) map[string]string {
+	links := map[string]string{
+		"self": "/v1/admin/import/guilds/" + imp.ID,
+	}
+	if imp.Status == model.GuildImportPreviewed {
+		links["commit"] = "/v1/admin/import/guilds/" + imp.ID + "/commit"
+	}
+	if imp.UserImportID != "" {
+		links["user_import"] = "/v1/admin/import/users/" + imp.UserImportID
+	}
+	return links
+}
+
+func (h *AdminGuildImportHandler) handleError(w http.ResponseWriter, err error) {
+	if pd, ok := err.(*model.ProblemDetails); ok {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrGuildNotFound):
+		WriteError(w, model.NewNotFoundError("guild"))
+	case errors.Is(err, service.ErrInvalidGuildExport):
+		WriteError(w, model.NewBadRequestError(err.Error()))
+	case errors.Is(err, service.ErrGuildImportNotFound):
+		WriteError(w, model.NewNotFoundError("guild import"))
+	case errors.Is(err, service.ErrGuildImportCommitted):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrGuildImportExpired):
+		WriteError(w, model.NewGoneError(err.Error()))
+	default:
+		WriteError(w, model.NewInternalError("guild import operation failed"))
+	}
+}
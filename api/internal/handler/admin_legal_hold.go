@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminLegalHoldHandler handles legal holds; its routes are superadmin-only
+type AdminLegalHoldHandler struct {
+	legalHoldService *service.LegalHoldService
+}
+
+// NewAdminLegalHoldHandler creates a new admin legal hold handler
+func NewAdminLegalHoldHandler(legalHoldService *service.LegalHoldService) *AdminLegalHoldHandler {
+	return &AdminLegalHoldHandler{legalHoldService: legalHoldService}
+}
+
+// Get handles GET /v1/admin/users/{userId}/legal-hold
+func (h *AdminLegalHoldHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("userId")
+	hold, err := h.legalHoldService.Get(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, hold, legalHoldLinks(userID))
+}
+
+// Place handles PUT /v1/admin/users/{userId}/legal-hold - preserve the
+// user's data for an investigation
+func (h *AdminLegalHoldHandler) Place(w http.ResponseWriter, r *http.Request) {
+	var req model.PlaceLegalHoldRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	userID := r.PathValue("userId")
+	hold, err := h.legalHoldService.Place(r.Context(), middleware.GetUserID(r.Context()), userID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, hold, legalHoldLinks(userID))
+}
+
+// Release handles DELETE /v1/admin/users/{userId}/legal-hold
+func (h *AdminLegalHoldHandler) Release(w http.ResponseWriter, r *http.Request) {
+	if _, err := h.legalHoldService.Release(r.Context(), middleware.GetUserID(r.Context()), r.PathValue("userId")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// AccessLog handles GET /v1/admin/users/{userId}/legal-hold/access-log?limit=N -
+// every access to the user's data while they were held, newest first
+func (h *AdminLegalHoldHandler) AccessLog(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	userID := r.PathValue("userId")
+	entries, err := h.legalHoldService.AccessLog(r.Context(), userID, limit)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, entries, nil, map[string]string{
+		"self": "/v1/admin/users/" + userID + "/legal-hold/access-log",
+	})
+}
+
+func legalHoldLinks(userID string) map[string]string {
+	return map[string]string{
+		"self":       "/v1/admin/users/" + userID + "/legal-hold",
+		"access_log": "/v1/admin/users/" + userID + "/legal-hold/access-log",
+	}
+}
+
+func (h *AdminLegalHoldHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		WriteError(w, model.NewNotFoundError("user"))
+	case errors.Is(err, service.ErrLegalHoldNotFound):
+		WriteError(w, model.NewNotFoundError("legal hold"))
+	case errors.Is(err, service.ErrLegalHoldExists):
+		WriteError(w, model.NewConflictError("user is already on legal hold"))
+	default:
+		WriteError(w, model.NewInternalError("legal hold operation failed"))
+	}
+}
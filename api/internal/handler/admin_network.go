@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminNetworkHandler handles the network policy: the admin allowlist, the
+// IP deny list, and the admin requests they turned away
+type AdminNetworkHandler struct {
+	networkPolicyService *service.NetworkPolicyService
+	securityEventService *service.SecurityEventService
+}
+
+// NewAdminNetworkHandler creates a new admin network handler
+func NewAdminNetworkHandler(networkPolicyService *service.NetworkPolicyService, securityEventService *service.SecurityEventService) *AdminNetworkHandler {
+	return &AdminNetworkHandler{
+		networkPolicyService: networkPolicyService,
+		securityEventService: securityEventService,
+	}
+}
+
+// Get handles GET /v1/admin/network - the admin allowlist and the deny list
+// in force
+func (h *AdminNetworkHandler) Get(w http.ResponseWriter, r *http.Request) {
+	WriteData(w, http.StatusOK, h.networkPolicyService.Get(), networkLinks())
+}
+
+// Deny handles POST /v1/admin/network/deny - turn an address or range away
+// from the whole API
+func (h *AdminNetworkHandler) Deny(w http.ResponseWriter, r *http.Request) {
+	var req model.CreateIPDenyRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	entry, err := h.networkPolicyService.Deny(r.Context(), middleware.GetUserID(r.Context()), middleware.ClientIP(r), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, entry, map[string]string{
+		"self":    "/v1/admin/network/deny/" + entry.ID,
+		"network": "/v1/admin/network",
+	})
+}
+
+// RemoveDeny handles DELETE /v1/admin/network/deny/{entryId}
+func (h *AdminNetworkHandler) RemoveDeny(w http.ResponseWriter, r *http.Request) {
+	if err := h.networkPolicyService.RemoveDeny(r.Context(), r.PathValue("entryId")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// ListBlockedAttempts handles GET /v1/admin/network/blocked-attempts?limit=N
+// - admin requests the network policy turned away, newest first
+func (h *AdminNetworkHandler) ListBlockedAttempts(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	events, err := h.securityEventService.ListBlockedAdminAttempts(r.Context(), limit)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to load blocked admin attempts"))
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, events, nil, networkLinks())
+}
+
+func networkLinks() map[string]string {
+	return map[string]string{
+		"self":             "/v1/admin/network",
+		"deny":             "/v1/admin/network/deny",
+		"blocked_attempts": "/v1/admin/network/blocked-attempts",
+	}
+}
+
+func (h *AdminNetworkHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, model.ErrInvalidNetwork):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "cidr", Message: "cidr " + model.ErrInvalidNetwork.Error()},
+		}))
+	case errors.Is(err, service.ErrDenyOwnAddress):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "cidr", Message: "cidr includes your own address"},
+		}))
+	case errors.Is(err, service.ErrIPAlreadyDenied):
+		WriteError(w, model.NewConflictError("range is already on the deny list"))
+	case errors.Is(err, service.ErrIPDenyNotFound):
+		WriteError(w, model.NewNotFoundError("deny list entry"))
+	default:
+		WriteError(w, model.NewInternalError("network policy operation failed"))
+	}
+}
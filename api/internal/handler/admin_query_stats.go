@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+)
+
+// AdminQueryStatsHandler exposes repository query stats to admins
+type AdminQueryStatsHandler struct {
+	stats *database.QueryStats
+}
+
+// NewAdminQueryStatsHandler creates a new admin query stats handler
+func NewAdminQueryStatsHandler(stats *database.QueryStats) *AdminQueryStatsHandler {
+	return &AdminQueryStatsHandler{stats: stats}
+}
+
+// QueryStatsResponse is the admin view of query stats
+type QueryStatsResponse struct {
+	Since           time.Time                   `json:"since"`
+	SlowThresholdMS int64                       `json:"slow_threshold_ms"`
+	Methods         []database.QueryMethodStats `json:"methods"`
+	RecentSlow      []database.SlowQuery        `json:"recent_slow"`
+}
+
+// Get handles GET /v1/admin/query-stats?limit=N - worst repository methods by total query time
+func (h *AdminQueryStatsHandler) Get(w http.ResponseWriter, r *http.Request) {
+	methods := h.stats.Methods()
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit > 0 && limit < len(methods) {
+		methods = methods[:limit]
+	}
+
+	WriteData(w, http.StatusOK, QueryStatsResponse{
+		Since:           h.stats.Since(),
+		SlowThresholdMS: h.stats.SlowThreshold().Milliseconds(),
+		Methods:         methods,
+		RecentSlow:      h.stats.RecentSlowQueries(),
+	}, map[string]string{
+		"self": "/v1/admin/query-stats",
+	})
+}
+
+// Reset handles DELETE /v1/admin/query-stats - clear collected stats
+func (h *AdminQueryStatsHandler) Reset(w http.ResponseWriter, r *http.Request) {
+	h.stats.Reset()
+	WriteNoContent(w)
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminQuotaHandler handles inspecting and adjusting daily request quotas
+type AdminQuotaHandler struct {
+	quotaService *service.QuotaService
+}
+
+// NewAdminQuotaHandler creates a new admin quota handler
+func NewAdminQuotaHandler(quotaService *service.QuotaService) *AdminQuotaHandler {
+	return &AdminQuotaHandler{quotaService: quotaService}
+}
+
+// Top handles GET /v1/admin/quotas?limit=N - today's heaviest users
+func (h *AdminQuotaHandler) Top(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	usages := h.quotaService.Top(limit)
+	WriteCollection(w, http.StatusOK, usages, nil, map[string]string{
+		"self": "/v1/admin/quotas",
+	})
+}
+
+// Get handles GET /v1/admin/users/{userId}/quota
+func (h *AdminQuotaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("userId")
+	usage, err := h.quotaService.Get(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, usage, quotaLinks(userID))
+}
+
+// SetLimit handles PUT /v1/admin/users/{userId}/quota - override the user's
+// daily limit
+func (h *AdminQuotaHandler) SetLimit(w http.ResponseWriter, r *http.Request) {
+	var req model.SetQuotaRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	userID := r.PathValue("userId")
+	usage, err := h.quotaService.SetLimit(r.Context(), middleware.GetUserID(r.Context()), userID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, usage, quotaLinks(userID))
+}
+
+// ClearLimit handles DELETE /v1/admin/users/{userId}/quota - back to the
+// default daily limit
+func (h *AdminQuotaHandler) ClearLimit(w http.ResponseWriter, r *http.Request) {
+	if err := h.quotaService.ClearLimit(r.Context(), r.PathValue("userId")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// ResetUsage handles POST /v1/admin/users/{userId}/quota/reset - forgive
+// what the user has spent today
+func (h *AdminQuotaHandler) ResetUsage(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("userId")
+	usage, err := h.quotaService.ResetUsage(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, usage, quotaLinks(userID))
+}
+
+func quotaLinks(userID string) map[string]string {
+	return map[string]string{
+		"self":  "/v1/admin/users/" + userID + "/quota",
+		"reset": "/v1/admin/users/" + userID + "/quota/reset",
+	}
+}
+
+func (h *AdminQuotaHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		WriteError(w, model.NewNotFoundError("user"))
+	case errors.Is(err, service.ErrQuotaOverrideNotFound):
+		WriteError(w, model.NewNotFoundError("quota override"))
+	default:
+		WriteError(w, model.NewInternalError("quota operation failed"))
+	}
+}
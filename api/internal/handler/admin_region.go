@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// AdminRegionHandler handles region role and read-only mode for failover drills
+type AdminRegionHandler struct {
+	region *database.RegionState
+}
+
+// NewAdminRegionHandler creates a new admin region handler
+func NewAdminRegionHandler(region *database.RegionState) *AdminRegionHandler {
+	return &AdminRegionHandler{region: region}
+}
+
+// UpdateRegionRequest represents a request to change the region state
+type UpdateRegionRequest struct {
+	Role     *database.RegionRole `json:"role,omitempty"`
+	ReadOnly *bool                `json:"read_only,omitempty"`
+}
+
+// Get handles GET /v1/admin/region
+func (h *AdminRegionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	WriteData(w, http.StatusOK, h.region.Status(), map[string]string{
+		"self": "/v1/admin/region",
+	})
+}
+
+// Update handles PATCH /v1/admin/region - promote/demote the region or toggle read-only mode
+func (h *AdminRegionHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req UpdateRegionRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if req.Role != nil && *req.Role != database.RegionRoleActive && *req.Role != database.RegionRoleStandby {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "role", Message: "role must be 'active' or 'standby'"},
+		}))
+		return
+	}
+
+	if req.Role != nil {
+		h.region.SetRole(*req.Role)
+	}
+	if req.ReadOnly != nil {
+		h.region.SetReadOnly(*req.ReadOnly)
+	}
+
+	WriteData(w, http.StatusOK, h.region.Status(), map[string]string{
+		"self": "/v1/admin/region",
+	})
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminRemoteConfigHandler handles managing client tunables
+type AdminRemoteConfigHandler struct {
+	remoteConfigService *service.RemoteConfigService
+}
+
+// NewAdminRemoteConfigHandler creates a new admin remote config handler
+func NewAdminRemoteConfigHandler(remoteConfigService *service.RemoteConfigService) *AdminRemoteConfigHandler {
+	return &AdminRemoteConfigHandler{remoteConfigService: remoteConfigService}
+}
+
+// List handles GET /v1/admin/remote-config - every admin-set value, by key
+// and then platform
+func (h *AdminRemoteConfigHandler) List(w http.ResponseWriter, r *http.Request) {
+	values, err := h.remoteConfigService.List(r.Context())
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, values, nil, map[string]string{
+		"self":   "/v1/admin/remote-config",
+		"public": "/v1/meta/config",
+	})
+}
+
+// Set handles PUT /v1/admin/remote-config/{key} - set a tunable for every
+// platform, or override it for the platform in the body
+func (h *AdminRemoteConfigHandler) Set(w http.ResponseWriter, r *http.Request) {
+	var req model.SetRemoteConfigRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	key := r.PathValue("key")
+	var v model.Validator
+	v.Check("key", model.ValidRemoteConfigKey(key), "key must be dotted lowercase words such as discovery.default_radius_km")
+	v.Merge(req.Validate())
+	if fieldErrors := v.Errors(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	value, err := h.remoteConfigService.Set(r.Context(), middleware.GetUserID(r.Context()), key, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, value, map[string]string{
+		"self": "/v1/admin/remote-config/" + key,
+	})
+}
+
+// Delete handles DELETE /v1/admin/remote-config/{key}?platform= - remove a
+// platform's override, or the value for every platform without one
+func (h *AdminRemoteConfigHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	platform := model.DevicePlatform(r.URL.Query().Get("platform"))
+	if platform != "" && !platform.IsValid() {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "platform", Message: "platform must be ios, android, or web"},
+		}))
+		return
+	}
+
+	if err := h.remoteConfigService.Delete(r.Context(), r.PathValue("key"), platform); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+func (h *AdminRemoteConfigHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrRemoteConfigNotFound):
+		WriteError(w, model.NewNotFoundError("remote config value"))
+	default:
+		WriteError(w, model.NewInternalError("remote config operation failed"))
+	}
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminReportHandler handles background admin reports
+type AdminReportHandler struct {
+	reportService *service.AdminReportService
+}
+
+// NewAdminReportHandler creates a new admin report handler
+func NewAdminReportHandler(reportService *service.AdminReportService) *AdminReportHandler {
+	return &AdminReportHandler{reportService: reportService}
+}
+
+// Create handles POST /v1/admin/reports - queue a report. Poll the returned
+// report until it completes, then fetch its download_url.
+func (h *AdminReportHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req model.CreateAdminReportRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	report, err := h.reportService.Request(r.Context(), middleware.GetUserID(r.Context()), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, report, adminReportLinks(report.ID))
+}
+
+// List handles GET /v1/admin/reports?limit=N
+func (h *AdminReportHandler) List(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	reports, err := h.reportService.List(r.Context(), limit)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, reports, nil, map[string]string{
+		"self": "/v1/admin/reports",
+	})
+}
+
+// Get handles GET /v1/admin/reports/{reportId}
+func (h *AdminReportHandler) Get(w http.ResponseWriter, r *http.Request) {
+	report, err := h.reportService.Get(r.Context(), r.PathValue("reportId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, report, adminReportLinks(report.ID))
+}
+
+func adminReportLinks(reportID string) map[string]string {
+	return map[string]string{
+		"self": "/v1/admin/reports/" + reportID,
+	}
+}
+
+func (h *AdminReportHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrAdminReportsDisabled):
+		WriteError(w, model.NewNotFoundError("admin reports"))
+	case errors.Is(err, service.ErrAdminReportNotFound):
+		WriteError(w, model.NewNotFoundError("admin report"))
+	default:
+		WriteError(w, model.NewInternalError("admin report operation failed"))
+	}
+}
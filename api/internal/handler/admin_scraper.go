@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminScraperHandler shows admins the clients caught scraping, and lets
+// them release ones caught by mistake
+type AdminScraperHandler struct {
+	securityEventService *service.SecurityEventService
+	tarpit               *middleware.Tarpit
+}
+
+// NewAdminScraperHandler creates a new admin scraper handler
+func NewAdminScraperHandler(securityEventService *service.SecurityEventService, tarpit *middleware.Tarpit) *AdminScraperHandler {
+	return &AdminScraperHandler{
+		securityEventService: securityEventService,
+		tarpit:               tarpit,
+	}
+}
+
+// ListDetections handles GET /v1/admin/scraper-detections?limit=N -
+// honeypot hits, tarpittings and blocks, newest first
+func (h *AdminScraperHandler) ListDetections(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	events, err := h.securityEventService.ListScraperDetections(r.Context(), limit)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to load scraper detections"))
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, events, nil, map[string]string{
+		"self":   "/v1/admin/scraper-detections",
+		"tarpit": "/v1/admin/tarpit",
+	})
+}
+
+// ListTarpit handles GET /v1/admin/tarpit - clients being slowed down or
+// blocked right now, blocked ones first
+func (h *AdminScraperHandler) ListTarpit(w http.ResponseWriter, r *http.Request) {
+	WriteCollection(w, http.StatusOK, h.tarpit.Clients(), nil, map[string]string{
+		"self":       "/v1/admin/tarpit",
+		"detections": "/v1/admin/scraper-detections",
+	})
+}
+
+// Release handles DELETE /v1/admin/tarpit/{ip} - clear a client's strikes
+// and lift any block
+func (h *AdminScraperHandler) Release(w http.ResponseWriter, r *http.Request) {
+	if !h.tarpit.Release(r.PathValue("ip")) {
+		WriteError(w, model.NewNotFoundError("tarpitted client"))
+		return
+	}
+	WriteNoContent(w)
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminSecurityEventHandler shows users' security logs to admins
+type AdminSecurityEventHandler struct {
+	securityEventService *service.SecurityEventService
+}
+
+// NewAdminSecurityEventHandler creates a new admin security event handler
+func NewAdminSecurityEventHandler(securityEventService *service.SecurityEventService) *AdminSecurityEventHandler {
+	return &AdminSecurityEventHandler{securityEventService: securityEventService}
+}
+
+// List handles GET /v1/admin/users/{userId}/security-events?limit=N -
+// password and email changes and account recoveries, newest first
+func (h *AdminSecurityEventHandler) List(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	userID := r.PathValue("userId")
+	events, err := h.securityEventService.List(r.Context(), userID, limit)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to load security events"))
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, events, nil, map[string]string{
+		"self": "/v1/admin/users/" + userID + "/security-events",
+	})
+}
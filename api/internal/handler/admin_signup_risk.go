@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminSignupRiskHandler shows admins the accounts flagged at signup as
+// likely spam or bots
+type AdminSignupRiskHandler struct {
+	signupRiskService *service.SignupRiskService
+}
+
+// NewAdminSignupRiskHandler creates a new admin signup risk handler
+func NewAdminSignupRiskHandler(signupRiskService *service.SignupRiskService) *AdminSignupRiskHandler {
+	return &AdminSignupRiskHandler{signupRiskService: signupRiskService}
+}
+
+// ListFlagged handles GET /v1/admin/signup-reviews?limit=N - flagged
+// accounts no admin has reviewed, riskiest first
+func (h *AdminSignupRiskHandler) ListFlagged(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	risks, err := h.signupRiskService.ListFlagged(r.Context(), limit)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, risks, nil, map[string]string{
+		"self": "/v1/admin/signup-reviews",
+	})
+}
+
+// Review handles POST /v1/admin/users/{userId}/signup-review - clear a
+// flagged account, lifting its restriction on making changes
+func (h *AdminSignupRiskHandler) Review(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("userId")
+	risk, err := h.signupRiskService.Review(r.Context(), userID, middleware.GetUserID(r.Context()))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, risk, map[string]string{
+		"self": "/v1/admin/users/" + userID + "/signup-review",
+		"user": "/v1/admin/users/" + userID,
+	})
+}
+
+func (h *AdminSignupRiskHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrSignupRiskNotFound):
+		WriteError(w, model.NewNotFoundError("signup risk"))
+	default:
+		WriteError(w, model.NewInternalError("signup review operation failed"))
+	}
+}
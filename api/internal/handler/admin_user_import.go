@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminUserImportHandler handles CSV imports of members
+type AdminUserImportHandler struct {
+	importService *service.UserImportService
+}
+
+// NewAdminUserImportHandler creates a new admin user import handler
+func NewAdminUserImportHandler(importService *service.UserImportService) *AdminUserImportHandler {
+	return &AdminUserImportHandler{importService: importService}
+}
+
+// Create handles POST /v1/admin/import/users - upload a text/csv file with
+// email and name columns and an optional guilds column of slugs separated
+// by semicolons. Poll the returned import until it completes to see each
+// row's result.
+func (h *AdminUserImportHandler) Create(w http.ResponseWriter, r *http.Request) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "text/csv" {
+		WriteError(w, model.NewUnsupportedMediaTypeError("user imports must be uploaded as text/csv"))
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, model.MaxUserImportBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			WriteError(w, model.NewBadRequestError(fmt.Sprintf("file must be at most %d bytes", model.MaxUserImportBytes)))
+			return
+		}
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	imp, err := h.importService.Request(r.Context(), middleware.GetUserID(r.Context()), bytes.NewReader(data))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, imp, userImportLinks(imp.ID))
+}
+
+// List handles GET /v1/admin/import/users?limit=N
+func (h *AdminUserImportHandler) List(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	imports, err := h.importService.List(r.Context(), limit)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, imports, nil, map[string]string{
+		"self": "/v1/admin/import/users",
+	})
+}
+
+// Get handles GET /v1/admin/import/users/{importId}
+func (h *AdminUserImportHandler) Get(w http.ResponseWriter, r *http.Request) {
+	imp, err := h.importService.Get(r.Context(), r.PathValue("importId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, imp, userImportLinks(imp.ID))
+}
+
+func userImportLinks(importID string) map[string]string {
+	return map[string]string{
+		"self": "/v1/admin/import/users/" + importID,
+	}
+}
+
+func (h *AdminUserImportHandler) handleError(w http.ResponseWriter, err error) {
+	var fileErr *service.UserImportFileError
+	switch {
+	case errors.As(err, &fileErr):
+		WriteError(w, model.NewBadRequestError(fileErr.Error()))
+	case errors.Is(err, service.ErrUserImportNotFound):
+		WriteError(w, model.NewNotFoundError("user import"))
+	default:
+		WriteError(w, model.NewInternalError("user import operation failed"))
+	}
+}
@@ -25,6 +25,11 @@ func (h *AdminUsersHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 
 	page, _ := strconv.Atoi(q.Get("page"))
 	pageSize, _ := strconv.Atoi(q.Get("page_size"))
+	list, fieldErrors := service.AdminUserListFields.Parse(q)
+	if len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
 
 	req := service.ListUsersRequest{
 		Page:     page,
@@ -33,6 +38,7 @@ func (h *AdminUsersHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 		Role:     q.Get("role"),
 		SortBy:   q.Get("sort_by"),
 		SortDir:  q.Get("sort_dir"),
+		List:     list,
 	}
 
 	result, err := h.usersService.ListUsers(r.Context(), req)
@@ -82,10 +88,10 @@ func (h *AdminUsersHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
 	// Validate role enum
 	role := model.UserRole(req.Role)
 	switch role {
-	case model.UserRoleUser, model.UserRoleModerator, model.UserRoleAdmin:
+	case model.UserRoleUser, model.UserRoleModerator, model.UserRoleAdmin, model.UserRoleSuperAdmin:
 		// valid
 	default:
-		WriteError(w, model.NewBadRequestError("Invalid role. Must be one of: user, moderator, admin"))
+		WriteError(w, model.NewBadRequestError("Invalid role. Must be one of: user, moderator, admin, superadmin"))
 		return
 	}
 
@@ -96,6 +102,10 @@ func (h *AdminUsersHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
 			WriteError(w, model.NewNotFoundError("User"))
 			return
 		}
+		if err == service.ErrSuperAdminRequired {
+			WriteError(w, model.NewForbiddenError(err.Error()))
+			return
+		}
 		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
@@ -119,6 +129,10 @@ func (h *AdminUsersHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 			WriteError(w, model.NewNotFoundError("User"))
 			return
 		}
+		if err == service.ErrUserOnLegalHold {
+			WriteError(w, model.NewConflictError("user is on legal hold and can't be deleted"))
+			return
+		}
 		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminWinBackHandler reports how well win-back nudges bring users back
+type AdminWinBackHandler struct {
+	nudgeService *service.NudgeService
+}
+
+// NewAdminWinBackHandler creates a new admin win-back handler
+func NewAdminWinBackHandler(nudgeService *service.NudgeService) *AdminWinBackHandler {
+	return &AdminWinBackHandler{nudgeService: nudgeService}
+}
+
+// Stats handles GET /v1/admin/win-back/stats?since= - return rates of nudged
+// and held-out at-risk users targeted since an RFC 3339 time, which defaults
+// to 90 days ago
+func (h *AdminWinBackHandler) Stats(w http.ResponseWriter, r *http.Request) {
+	since := time.Now().UTC().AddDate(0, 0, -model.WinBackStatsDays)
+	if v := r.URL.Query().Get("since"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			WriteError(w, model.NewBadRequestError("since must be an RFC 3339 timestamp"))
+			return
+		}
+		since = t
+	}
+
+	stats, err := h.nudgeService.WinBackStats(r.Context(), since)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to get win-back stats"))
+		return
+	}
+
+	WriteData(w, http.StatusOK, stats, map[string]string{
+		"self": "/v1/admin/win-back/stats",
+	})
+}
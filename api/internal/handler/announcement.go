@@ -0,0 +1,332 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AnnouncementHandler handles platform and guild announcement endpoints
+type AnnouncementHandler struct {
+	announcementService *service.AnnouncementService
+}
+
+// NewAnnouncementHandler creates a new announcement handler
+func NewAnnouncementHandler(announcementService *service.AnnouncementService) *AnnouncementHandler {
+	return &AnnouncementHandler{announcementService: announcementService}
+}
+
+// ============================================================================
+// Platform announcements (admin)
+// ============================================================================
+
+// AdminCreate handles POST /v1/admin/announcements
+func (h *AnnouncementHandler) AdminCreate(w http.ResponseWriter, r *http.Request) {
+	var req model.CreateAnnouncementRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	announcement, err := h.announcementService.Create(r.Context(), middleware.GetUserID(r.Context()), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, announcement, adminAnnouncementLinks(announcement.ID))
+}
+
+// AdminList handles GET /v1/admin/announcements
+func (h *AnnouncementHandler) AdminList(w http.ResponseWriter, r *http.Request) {
+	announcements, err := h.announcementService.List(r.Context())
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, announcements, nil, map[string]string{
+		"self": "/v1/admin/announcements",
+	})
+}
+
+// AdminGet handles GET /v1/admin/announcements/{announcementId}
+func (h *AnnouncementHandler) AdminGet(w http.ResponseWriter, r *http.Request) {
+	announcement, err := h.announcementService.Get(r.Context(), r.PathValue("announcementId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, announcement, adminAnnouncementLinks(announcement.ID))
+}
+
+// AdminUpdate handles PATCH /v1/admin/announcements/{announcementId}
+func (h *AnnouncementHandler) AdminUpdate(w http.ResponseWriter, r *http.Request) {
+	var req model.UpdateAnnouncementRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	announcement, err := h.announcementService.Update(r.Context(), r.PathValue("announcementId"), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, announcement, adminAnnouncementLinks(announcement.ID))
+}
+
+// AdminSend handles POST /v1/admin/announcements/{announcementId}/send -
+// schedule for immediate delivery
+func (h *AnnouncementHandler) AdminSend(w http.ResponseWriter, r *http.Request) {
+	announcement, err := h.announcementService.Send(r.Context(), r.PathValue("announcementId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, announcement, adminAnnouncementLinks(announcement.ID))
+}
+
+// AdminDelete handles DELETE /v1/admin/announcements/{announcementId}
+func (h *AnnouncementHandler) AdminDelete(w http.ResponseWriter, r *http.Request) {
+	if err := h.announcementService.Delete(r.Context(), r.PathValue("announcementId")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// ============================================================================
+// Guild announcements
+// ============================================================================
+
+// GuildCreate handles POST /v1/guilds/{guildId}/announcements (guild admins)
+func (h *AnnouncementHandler) GuildCreate(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+
+	var req model.CreateAnnouncementRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	// Guild announcements always go to the guild in the path
+	req.Audience = model.AnnouncementAudienceGuild
+	req.GuildID = &guildID
+	req.Area = nil
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	announcement, err := h.announcementService.CreateForGuild(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, announcement, guildAnnouncementLinks(guildID, announcement.ID))
+}
+
+// GuildList handles GET /v1/guilds/{guildId}/announcements
+func (h *AnnouncementHandler) GuildList(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+
+	announcements, err := h.announcementService.ListForGuild(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, announcements, nil, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/announcements",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// GuildGet handles GET /v1/guilds/{guildId}/announcements/{announcementId}
+func (h *AnnouncementHandler) GuildGet(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+
+	announcement, err := h.announcementService.GetForGuild(r.Context(), userID, guildID, r.PathValue("announcementId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, announcement, guildAnnouncementLinks(guildID, announcement.ID))
+}
+
+// GuildUpdate handles PATCH /v1/guilds/{guildId}/announcements/{announcementId}
+func (h *AnnouncementHandler) GuildUpdate(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+
+	var req model.UpdateAnnouncementRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	announcement, err := h.announcementService.UpdateForGuild(r.Context(), userID, guildID, r.PathValue("announcementId"), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, announcement, guildAnnouncementLinks(guildID, announcement.ID))
+}
+
+// GuildSend handles POST /v1/guilds/{guildId}/announcements/{announcementId}/send
+func (h *AnnouncementHandler) GuildSend(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+
+	announcement, err := h.announcementService.SendForGuild(r.Context(), userID, guildID, r.PathValue("announcementId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, announcement, guildAnnouncementLinks(guildID, announcement.ID))
+}
+
+// GuildDelete handles DELETE /v1/guilds/{guildId}/announcements/{announcementId}
+func (h *AnnouncementHandler) GuildDelete(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	if err := h.announcementService.DeleteForGuild(r.Context(), userID, r.PathValue("guildId"), r.PathValue("announcementId")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// ============================================================================
+// Inbox
+// ============================================================================
+
+// Inbox handles GET /v1/announcements - announcements delivered to the user
+func (h *AnnouncementHandler) Inbox(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	inbox, err := h.announcementService.GetInbox(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, inbox, map[string]string{
+		"self": "/v1/announcements",
+	})
+}
+
+// MarkRead handles POST /v1/announcements/{announcementId}/read
+func (h *AnnouncementHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	if err := h.announcementService.MarkRead(r.Context(), userID, r.PathValue("announcementId")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+func adminAnnouncementLinks(id string) map[string]string {
+	return map[string]string{
+		"self": "/v1/admin/announcements/" + id,
+		"send": "/v1/admin/announcements/" + id + "/send",
+	}
+}
+
+func guildAnnouncementLinks(guildID, id string) map[string]string {
+	return map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/announcements/" + id,
+		"send":  "/v1/guilds/" + guildID + "/announcements/" + id + "/send",
+		"guild": "/v1/guilds/" + guildID,
+	}
+}
+
+func (h *AnnouncementHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrAnnouncementNotFound):
+		WriteError(w, model.NewNotFoundError("announcement"))
+	case errors.Is(err, service.ErrGuildNotFound):
+		WriteError(w, model.NewNotFoundError("guild"))
+	case errors.Is(err, service.ErrAnnouncementNotEditable):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrAnnouncementSendAtInvalid):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "send_at", Message: err.Error()}}))
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a member of this guild"))
+	case errors.Is(err, service.ErrNotGuildAdmin):
+		WriteError(w, model.NewForbiddenError("guild role doesn't allow managing announcements"))
+	default:
+		WriteError(w, model.NewInternalError("announcement operation failed"))
+	}
+}
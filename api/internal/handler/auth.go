@@ -4,7 +4,9 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
+	"github.com/forgo/saga/api/internal/mapping"
 	"github.com/forgo/saga/api/internal/middleware"
 	"github.com/forgo/saga/api/internal/model"
 	"github.com/forgo/saga/api/internal/service"
@@ -41,24 +43,30 @@ type RefreshRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-// TokenResponse represents a token response
-type TokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	TokenType    string `json:"token_type"`
-	ExpiresIn    int    `json:"expires_in"`
+// ChangePasswordRequest represents the change password endpoint request
+// body. CurrentPassword may be omitted right after signing in.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password,omitempty"`
+	NewPassword     string `json:"new_password"`
+}
+
+// EmailChangeRequest represents the email change endpoint request body.
+// CurrentPassword may be omitted right after signing in.
+type EmailChangeRequest struct {
+	NewEmail        string `json:"new_email"`
+	CurrentPassword string `json:"current_password,omitempty"`
+}
+
+// EmailVerifyRequest represents the email verification endpoint request body
+type EmailVerifyRequest struct {
+	Token string `json:"token"`
 }
 
-// UserResponse represents a user in API responses
-type UserResponse struct {
-	ID            string  `json:"id"`
-	Email         string  `json:"email"`
-	Username      *string `json:"username,omitempty"`
-	Firstname     *string `json:"firstname,omitempty"`
-	Lastname      *string `json:"lastname,omitempty"`
-	EmailVerified bool    `json:"email_verified"`
-	CreatedOn     string  `json:"created_on"`
-	UpdatedOn     string  `json:"updated_on"`
+// AcceptInvitationRequest represents the accept invitation endpoint request
+// body
+type AcceptInvitationRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
 }
 
 // Register handles POST /v1/auth/register
@@ -79,6 +87,7 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		Password:  req.Password,
 		Firstname: req.Firstname,
 		Lastname:  req.Lastname,
+		IP:        middleware.ClientIP(r),
 	})
 
 	if err != nil {
@@ -88,11 +97,13 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 
 	// Build response
 	response := struct {
-		User  UserResponse  `json:"user"`
-		Token TokenResponse `json:"token"`
+		User                 mapping.UserResponse  `json:"user"`
+		Token                mapping.TokenResponse `json:"token"`
+		VerificationRequired bool                  `json:"verification_required,omitempty"`
 	}{
-		User:  toUserResponse(result.User),
-		Token: toTokenResponse(result.TokenPair),
+		User:                 mapping.User(result.User),
+		Token:                mapping.Token(result.TokenPair),
+		VerificationRequired: result.VerificationRequired,
 	}
 
 	WriteData(w, http.StatusCreated, response, map[string]string{
@@ -124,11 +135,11 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := struct {
-		User  UserResponse  `json:"user"`
-		Token TokenResponse `json:"token"`
+		User  mapping.UserResponse  `json:"user"`
+		Token mapping.TokenResponse `json:"token"`
 	}{
-		User:  toUserResponse(result.User),
-		Token: toTokenResponse(result.TokenPair),
+		User:  mapping.User(result.User),
+		Token: mapping.Token(result.TokenPair),
 	}
 
 	WriteData(w, http.StatusOK, response, map[string]string{
@@ -162,7 +173,7 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	WriteData(w, http.StatusOK, toTokenResponse(tokenPair), nil)
+	WriteData(w, http.StatusOK, mapping.Token(tokenPair), nil)
 }
 
 // Logout handles POST /v1/auth/logout
@@ -210,13 +221,13 @@ func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := struct {
-		User       UserResponse       `json:"user"`
-		Identities []IdentityResponse `json:"identities"`
-		Passkeys   []PasskeyResponse  `json:"passkeys"`
+		User       mapping.UserResponse       `json:"user"`
+		Identities []mapping.IdentityResponse `json:"identities"`
+		Passkeys   []mapping.PasskeyResponse  `json:"passkeys"`
 	}{
-		User:       toUserResponse(userWithIdentities.User),
-		Identities: toIdentitiesResponse(userWithIdentities.Identities),
-		Passkeys:   toPasskeysResponse(userWithIdentities.Passkeys),
+		User:       mapping.User(userWithIdentities.User),
+		Identities: mapping.Identities(userWithIdentities.Identities),
+		Passkeys:   mapping.Passkeys(userWithIdentities.Passkeys),
 	}
 
 	WriteData(w, http.StatusOK, response, map[string]string{
@@ -224,23 +235,203 @@ func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// IdentityResponse represents an identity in API responses
-type IdentityResponse struct {
-	ID            string `json:"id"`
-	Provider      string `json:"provider"`
-	ProviderEmail string `json:"provider_email,omitempty"`
-	CreatedOn     string `json:"created_on"`
+// ChangePassword handles POST /v1/auth/password
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req ChangePasswordRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	tokenPair, err := h.authService.ChangePassword(r.Context(), service.ChangePasswordRequest{
+		UserID:          userID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+		AuthTime:        authTime(r),
+	})
+	if err != nil {
+		h.handleCredentialChangeError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, mapping.Token(tokenPair), nil)
 }
 
-// PasskeyResponse represents a passkey in API responses
-type PasskeyResponse struct {
-	ID         string  `json:"id"`
-	Name       string  `json:"name"`
-	CreatedOn  string  `json:"created_on"`
-	LastUsedOn *string `json:"last_used_on,omitempty"`
+// RequestEmailChange handles POST /v1/auth/email/change
+func (h *AuthHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req EmailChangeRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	change, err := h.authService.RequestEmailChange(r.Context(), service.EmailChangeRequest{
+		UserID:          userID,
+		NewEmail:        req.NewEmail,
+		CurrentPassword: req.CurrentPassword,
+		AuthTime:        authTime(r),
+	})
+	if err != nil {
+		h.handleCredentialChangeError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, change, nil)
+}
+
+// VerifyEmailChange handles POST /v1/auth/email/verify
+func (h *AuthHandler) VerifyEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req EmailVerifyRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+	if req.Token == "" {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "token", Message: "token is required"},
+		}))
+		return
+	}
+
+	user, err := h.authService.ConfirmEmailChange(r.Context(), req.Token)
+	if err != nil {
+		h.handleAuthError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, mapping.User(user), nil)
+}
+
+// SendEmailVerification handles POST /v1/auth/email/verification - email a
+// link verifying the account's current address
+func (h *AuthHandler) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	change, err := h.authService.SendEmailVerification(r.Context(), userID)
+	if err != nil {
+		h.handleAuthError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, change, nil)
+}
+
+// CancelEmailRecovery handles POST /v1/auth/recovery/cancel - stop a
+// passkey recovery with the token emailed to the old address
+func (h *AuthHandler) CancelEmailRecovery(w http.ResponseWriter, r *http.Request) {
+	var req EmailVerifyRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+	if req.Token == "" {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "token", Message: "token is required"},
+		}))
+		return
+	}
+
+	if err := h.authService.CancelEmailRecovery(r.Context(), req.Token); err != nil {
+		h.handleAuthError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// AcceptInvitation handles POST /v1/auth/invitations/accept - choose a
+// password for an account an admin created, with the token from the
+// invitation email
+func (h *AuthHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	var req AcceptInvitationRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+	if req.Token == "" {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "token", Message: "token is required"},
+		}))
+		return
+	}
+
+	result, err := h.authService.AcceptInvitation(r.Context(), req.Token, req.Password)
+	if err != nil {
+		h.handleAuthError(w, err)
+		return
+	}
+
+	response := struct {
+		User  mapping.UserResponse  `json:"user"`
+		Token mapping.TokenResponse `json:"token"`
+	}{
+		User:  mapping.User(result.User),
+		Token: mapping.Token(result.TokenPair),
+	}
+
+	WriteData(w, http.StatusOK, response, map[string]string{
+		"self": "/v1/auth/me",
+	})
+}
+
+// handleCredentialChangeError reports errors on the fields of a password or
+// email change. A wrong current password is a validation error rather than
+// a 401, which clients would take to mean their session has ended.
+func (h *AuthHandler) handleCredentialChangeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidCredentials):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "current_password", Message: "current password is incorrect"},
+		}))
+	case errors.Is(err, service.ErrInvalidEmail):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "new_email", Message: "invalid email format"},
+		}))
+	case errors.Is(err, service.ErrEmailUnchanged):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "new_email", Message: err.Error()},
+		}))
+	default:
+		h.handleAuthError(w, err)
+	}
+}
+
+// authTime is when the caller last signed in, from their access token
+func authTime(r *http.Request) time.Time {
+	if claims := middleware.GetClaims(r.Context()); claims != nil && claims.AuthTime != 0 {
+		return time.Unix(claims.AuthTime, 0)
+	}
+	return time.Time{}
 }
 
 func (h *AuthHandler) handleAuthError(w http.ResponseWriter, err error) {
+	var weak *service.WeakPasswordError
+	if errors.As(err, &weak) {
+		WriteError(w, model.NewWeakPasswordError(weak.Strength))
+		return
+	}
+	var pending *service.RecoveryPendingError
+	if errors.As(err, &pending) {
+		WriteError(w, model.NewConflictError("account recovery can be completed after "+pending.EffectiveOn.UTC().Format(time.RFC3339)))
+		return
+	}
+
 	switch {
 	case errors.Is(err, service.ErrInvalidCredentials):
 		WriteError(w, model.NewUnauthorizedError("invalid email or password"))
@@ -268,65 +459,26 @@ func (h *AuthHandler) handleAuthError(w http.ResponseWriter, err error) {
 		errors.Is(err, service.ErrRefreshTokenExpired),
 		errors.Is(err, service.ErrRefreshTokenRevoked):
 		WriteError(w, model.NewUnauthorizedError("invalid or expired refresh token"))
+	case errors.Is(err, service.ErrRefreshTokenUnbound):
+		WriteError(w, model.NewUnauthorizedError("refresh token needs a DPoP proof from the key it is bound to"))
+	case errors.Is(err, service.ErrReauthRequired):
+		WriteError(w, model.NewReauthRequiredError())
+	case errors.Is(err, service.ErrEmailAlreadyVerified):
+		WriteError(w, model.NewConflictError("email is already verified"))
+	case errors.Is(err, service.ErrEmailChangeNotFound):
+		WriteError(w, model.NewNotFoundError("email change"))
+	case errors.Is(err, service.ErrEmailChangeExpired):
+		WriteError(w, model.NewGoneError("email change link has expired; request a new one"))
+	case errors.Is(err, service.ErrInvitationNotFound):
+		WriteError(w, model.NewNotFoundError("invitation"))
+	case errors.Is(err, service.ErrInvitationExpired):
+		WriteError(w, model.NewGoneError("invitation link has expired; ask an admin to send a new one"))
+	case errors.Is(err, service.ErrEmailDeliveryUnavailable):
+		WriteError(w, model.NewServiceUnavailableError("email changes are unavailable"))
+	case errors.Is(err, service.ErrAccountRecoveryDisabled):
+		WriteError(w, model.NewServiceUnavailableError("account recovery is unavailable"))
 	default:
 		slog.Error("unhandled auth error", "error", err)
 		WriteError(w, model.NewInternalError("authentication error"))
 	}
 }
-
-// Helper functions
-
-func toUserResponse(user *model.User) UserResponse {
-	return UserResponse{
-		ID:            user.ID,
-		Email:         user.Email,
-		Username:      user.Username,
-		Firstname:     user.Firstname,
-		Lastname:      user.Lastname,
-		EmailVerified: user.EmailVerified,
-		CreatedOn:     user.CreatedOn.Format("2006-01-02T15:04:05Z"),
-		UpdatedOn:     user.UpdatedOn.Format("2006-01-02T15:04:05Z"),
-	}
-}
-
-func toTokenResponse(tokenPair *service.TokenPair) TokenResponse {
-	return TokenResponse{
-		AccessToken:  tokenPair.AccessToken,
-		RefreshToken: tokenPair.RefreshToken,
-		TokenType:    tokenPair.TokenType,
-		ExpiresIn:    tokenPair.ExpiresIn,
-	}
-}
-
-func toIdentitiesResponse(identities []*model.Identity) []IdentityResponse {
-	result := make([]IdentityResponse, 0, len(identities))
-	for _, identity := range identities {
-		resp := IdentityResponse{
-			ID:        identity.ID,
-			Provider:  identity.Provider,
-			CreatedOn: identity.CreatedOn.Format("2006-01-02T15:04:05Z"),
-		}
-		if identity.ProviderEmail != nil {
-			resp.ProviderEmail = *identity.ProviderEmail
-		}
-		result = append(result, resp)
-	}
-	return result
-}
-
-func toPasskeysResponse(passkeys []*model.Passkey) []PasskeyResponse {
-	result := make([]PasskeyResponse, 0, len(passkeys))
-	for _, passkey := range passkeys {
-		resp := PasskeyResponse{
-			ID:        passkey.ID,
-			Name:      passkey.Name,
-			CreatedOn: passkey.CreatedOn.Format("2006-01-02T15:04:05Z"),
-		}
-		if passkey.LastUsedOn != nil {
-			formatted := passkey.LastUsedOn.Format("2006-01-02T15:04:05Z")
-			resp.LastUsedOn = &formatted
-		}
-		result = append(result, resp)
-	}
-	return result
-}
@@ -9,9 +9,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/forgo/saga/api/internal/mapping"
 	"github.com/forgo/saga/api/internal/middleware"
 	"github.com/forgo/saga/api/internal/model"
 	"github.com/forgo/saga/api/internal/service"
+	"github.com/forgo/saga/api/internal/testing/golden"
+	"github.com/forgo/saga/api/pkg/jwt"
 )
 
 // ============================================================================
@@ -165,11 +168,11 @@ func TestRegister_ValidInput_ReturnsCreated(t *testing.T) {
 		}
 
 		response := struct {
-			User  UserResponse  `json:"user"`
-			Token TokenResponse `json:"token"`
+			User  mapping.UserResponse  `json:"user"`
+			Token mapping.TokenResponse `json:"token"`
 		}{
-			User:  toUserResponse(result.User),
-			Token: toTokenResponse(result.TokenPair),
+			User:  mapping.User(result.User),
+			Token: mapping.Token(result.TokenPair),
 		}
 		WriteData(w, http.StatusCreated, response, nil)
 	})
@@ -179,6 +182,7 @@ func TestRegister_ValidInput_ReturnsCreated(t *testing.T) {
 	if rr.Code != http.StatusCreated {
 		t.Errorf("expected status %d, got %d", http.StatusCreated, rr.Code)
 	}
+	golden.Response(t, rr)
 
 	// Verify response body contains expected fields
 	var resp DataResponse
@@ -279,6 +283,7 @@ func TestRegister_InvalidEmail_ReturnsValidationError(t *testing.T) {
 	if rr.Code != http.StatusUnprocessableEntity {
 		t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, rr.Code)
 	}
+	golden.Response(t, rr)
 
 	problem := parseErrorResponse(t, rr.Body.Bytes())
 	if len(problem.Errors) == 0 {
@@ -478,11 +483,11 @@ func TestLogin_ValidCredentials_ReturnsOK(t *testing.T) {
 		}
 
 		response := struct {
-			User  UserResponse  `json:"user"`
-			Token TokenResponse `json:"token"`
+			User  mapping.UserResponse  `json:"user"`
+			Token mapping.TokenResponse `json:"token"`
 		}{
-			User:  toUserResponse(result.User),
-			Token: toTokenResponse(result.TokenPair),
+			User:  mapping.User(result.User),
+			Token: mapping.Token(result.TokenPair),
 		}
 		WriteData(w, http.StatusOK, response, nil)
 	})
@@ -492,6 +497,7 @@ func TestLogin_ValidCredentials_ReturnsOK(t *testing.T) {
 	if rr.Code != http.StatusOK {
 		t.Errorf("expected status %d, got %d", http.StatusOK, rr.Code)
 	}
+	golden.Response(t, rr)
 
 	var resp DataResponse
 	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
@@ -590,6 +596,7 @@ func TestLogin_NonexistentUser_ReturnsUnauthorized(t *testing.T) {
 	if rr.Code != http.StatusUnauthorized {
 		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rr.Code)
 	}
+	golden.Response(t, rr)
 
 	// Verify error message doesn't reveal user doesn't exist (prevents enumeration)
 	problem := parseErrorResponse(t, rr.Body.Bytes())
@@ -682,7 +689,7 @@ func TestRefresh_ValidToken_ReturnsNewTokens(t *testing.T) {
 			return
 		}
 
-		WriteData(w, http.StatusOK, toTokenResponse(tokenPair), nil)
+		WriteData(w, http.StatusOK, mapping.Token(tokenPair), nil)
 	})
 
 	testHandler.ServeHTTP(rr, req)
@@ -690,6 +697,7 @@ func TestRefresh_ValidToken_ReturnsNewTokens(t *testing.T) {
 	if rr.Code != http.StatusOK {
 		t.Errorf("expected status %d, got %d", http.StatusOK, rr.Code)
 	}
+	golden.Response(t, rr)
 
 	var resp DataResponse
 	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
@@ -807,6 +815,7 @@ func TestRefresh_MissingToken_ReturnsValidationError(t *testing.T) {
 	if rr.Code != http.StatusUnprocessableEntity {
 		t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, rr.Code)
 	}
+	golden.Response(t, rr)
 
 	problem := parseErrorResponse(t, rr.Body.Bytes())
 	if len(problem.Errors) == 0 {
@@ -963,13 +972,13 @@ func TestMe_Authenticated_ReturnsUserData(t *testing.T) {
 		}
 
 		response := struct {
-			User       UserResponse       `json:"user"`
-			Identities []IdentityResponse `json:"identities"`
-			Passkeys   []PasskeyResponse  `json:"passkeys"`
+			User       mapping.UserResponse       `json:"user"`
+			Identities []mapping.IdentityResponse `json:"identities"`
+			Passkeys   []mapping.PasskeyResponse  `json:"passkeys"`
 		}{
-			User:       toUserResponse(userWithIdentities.User),
-			Identities: toIdentitiesResponse(userWithIdentities.Identities),
-			Passkeys:   toPasskeysResponse(userWithIdentities.Passkeys),
+			User:       mapping.User(userWithIdentities.User),
+			Identities: mapping.Identities(userWithIdentities.Identities),
+			Passkeys:   mapping.Passkeys(userWithIdentities.Passkeys),
 		}
 
 		WriteData(w, http.StatusOK, response, nil)
@@ -980,6 +989,7 @@ func TestMe_Authenticated_ReturnsUserData(t *testing.T) {
 	if rr.Code != http.StatusOK {
 		t.Errorf("expected status %d, got %d", http.StatusOK, rr.Code)
 	}
+	golden.Response(t, rr)
 
 	var resp DataResponse
 	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
@@ -1070,6 +1080,7 @@ func TestMe_UserNotFound_ReturnsNotFound(t *testing.T) {
 	if rr.Code != http.StatusNotFound {
 		t.Errorf("expected status %d, got %d", http.StatusNotFound, rr.Code)
 	}
+	golden.Response(t, rr)
 }
 
 func TestMe_WrongMethod_ReturnsMethodNotAllowed(t *testing.T) {
@@ -1092,3 +1103,64 @@ func TestMe_WrongMethod_ReturnsMethodNotAllowed(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, rr.Code)
 	}
 }
+
+// ============================================================================
+// Credential Change Tests
+// ============================================================================
+
+func TestHandleCredentialChangeError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantField  string
+		wantCode   model.ErrorCode
+	}{
+		{"wrong current password", service.ErrInvalidCredentials, http.StatusUnprocessableEntity, "current_password", model.ErrCodeValidation},
+		{"invalid new email", service.ErrInvalidEmail, http.StatusUnprocessableEntity, "new_email", model.ErrCodeValidation},
+		{"same email", service.ErrEmailUnchanged, http.StatusUnprocessableEntity, "new_email", model.ErrCodeValidation},
+		{"reauth required", service.ErrReauthRequired, http.StatusForbidden, "", model.ErrCodeReauthRequired},
+		{"email taken", service.ErrEmailAlreadyExists, http.StatusConflict, "", model.ErrCodeConflict},
+		{"expired link", service.ErrEmailChangeExpired, http.StatusGone, "", model.ErrCodeNotFound},
+		{"no mail", service.ErrEmailDeliveryUnavailable, http.StatusServiceUnavailable, "", model.ErrCodeExternalAPI},
+		{"recovery waiting", &service.RecoveryPendingError{EffectiveOn: time.Now().Add(time.Hour)}, http.StatusConflict, "", model.ErrCodeConflict},
+		{"recovery disabled", service.ErrAccountRecoveryDisabled, http.StatusServiceUnavailable, "", model.ErrCodeExternalAPI},
+	}
+
+	h := NewAuthHandler(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rr := httptest.NewRecorder()
+			h.handleCredentialChangeError(rr, tt.err)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rr.Code)
+			}
+			problem := parseErrorResponse(t, rr.Body.Bytes())
+			if problem.Code != tt.wantCode {
+				t.Errorf("expected code %d, got %d", tt.wantCode, problem.Code)
+			}
+			if tt.wantField != "" && (len(problem.Errors) == 0 || problem.Errors[0].Field != tt.wantField) {
+				t.Errorf("expected error on %q, got %+v", tt.wantField, problem.Errors)
+			}
+		})
+	}
+}
+
+func TestAuthTime_FromClaims(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/auth/password", nil)
+	if got := authTime(req); !got.IsZero() {
+		t.Errorf("expected zero time without claims, got %v", got)
+	}
+
+	signedIn := time.Now().Add(-time.Minute).Truncate(time.Second)
+	ctx := context.WithValue(req.Context(), middleware.ClaimsKey, &jwt.Claims{AuthTime: signedIn.Unix()})
+	if got := authTime(req.WithContext(ctx)); !got.Equal(signedIn) {
+		t.Errorf("expected %v, got %v", signedIn, got)
+	}
+}
@@ -42,27 +42,7 @@ func (h *AvailabilityHandler) CreateAvailability(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// Validate
-	var fieldErrors []model.FieldError
-	if req.StartTime == "" {
-		fieldErrors = append(fieldErrors, model.FieldError{
-			Field:   "start_time",
-			Message: "start_time is required",
-		})
-	}
-	if req.EndTime == "" {
-		fieldErrors = append(fieldErrors, model.FieldError{
-			Field:   "end_time",
-			Message: "end_time is required",
-		})
-	}
-	if req.HangoutType == "" {
-		fieldErrors = append(fieldErrors, model.FieldError{
-			Field:   "hangout_type",
-			Message: "hangout_type is required",
-		})
-	}
-	if len(fieldErrors) > 0 {
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
 		WriteError(w, model.NewValidationError(fieldErrors))
 		return
 	}
@@ -153,7 +133,7 @@ func (h *AvailabilityHandler) UpdateAvailability(w http.ResponseWriter, r *http.
 	})
 }
 
-// DeleteAvailability handles DELETE /v1/availability/{availabilityId}
+// DeleteAvailability handles DELETE /v1/availability/{availabilityId}; the response links to undo it
 func (h *AvailabilityHandler) DeleteAvailability(w http.ResponseWriter, r *http.Request) {
 	userID := middleware.GetUserID(r.Context())
 	if userID == "" {
@@ -167,12 +147,13 @@ func (h *AvailabilityHandler) DeleteAvailability(w http.ResponseWriter, r *http.
 		return
 	}
 
-	if err := h.availabilityService.DeleteAvailability(r.Context(), userID, availabilityID); err != nil {
+	op, err := h.availabilityService.DeleteAvailability(r.Context(), userID, availabilityID)
+	if err != nil {
 		h.handleAvailabilityError(w, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	writeUndoable(w, op)
 }
 
 // FindNearby handles GET /v1/discover/availability - find nearby available people
@@ -185,6 +166,10 @@ func (h *AvailabilityHandler) FindNearby(w http.ResponseWriter, r *http.Request)
 
 	// Get user's location
 	location, err := h.profileService.GetLocationInternal(r.Context(), userID)
+	if errors.Is(err, service.ErrLocationConsentRequired) {
+		WriteError(w, model.NewForbiddenError("location processing consent required to discover nearby availability"))
+		return
+	}
 	if err != nil || location == nil {
 		WriteError(w, model.NewBadRequestError("location required to discover nearby availability"))
 		return
@@ -233,6 +218,101 @@ func (h *AvailabilityHandler) FindNearby(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// GetGuildAvailability handles GET /v1/guilds/{guildId}/availability -
+// availability scoped to the guild
+func (h *AvailabilityHandler) GetGuildAvailability(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	limit := 20
+	if r.URL.Query().Get("limit") != "" {
+		if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= 50 {
+			limit = l
+		}
+	}
+
+	guildID := r.PathValue("guildId")
+	availabilities, err := h.availabilityService.GetGuildAvailability(r.Context(), userID, guildID, limit)
+	if err != nil {
+		h.handleAvailabilityError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, availabilities, nil, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/availability",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// GetGuildHeatmap handles GET /v1/guilds/{guildId}/availability/heatmap?weeks=N&tz=Zone -
+// when the guild's members are generally free, by hour of the week
+func (h *AvailabilityHandler) GetGuildHeatmap(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	weeks, _ := strconv.Atoi(r.URL.Query().Get("weeks"))
+
+	loc := time.UTC
+	if tz := r.URL.Query().Get("tz"); tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			WriteError(w, model.NewBadRequestError("tz must be an IANA time zone such as Europe/Berlin"))
+			return
+		}
+		loc = l
+	}
+
+	guildID := r.PathValue("guildId")
+	heatmap, err := h.availabilityService.GuildHeatmap(r.Context(), userID, guildID, weeks, loc)
+	if err != nil {
+		h.handleAvailabilityError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, heatmap, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/availability/heatmap",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// SuggestEventTimes handles POST /v1/guilds/{guildId}/events/suggest-times -
+// rank candidate event slots by expected attendance
+func (h *AvailabilityHandler) SuggestEventTimes(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.SuggestEventTimesRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	suggestions, err := h.availabilityService.SuggestEventTimes(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleAvailabilityError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, suggestions, nil, map[string]string{
+		"heatmap": "/v1/guilds/" + guildID + "/availability/heatmap",
+		"events":  "/v1/guilds/" + guildID + "/events",
+	})
+}
+
 // FindByType handles GET /v1/discover/availability/type/{type} - find by hangout type
 func (h *AvailabilityHandler) FindByType(w http.ResponseWriter, r *http.Request) {
 	userID := middleware.GetUserID(r.Context())
@@ -347,13 +427,14 @@ func (h *AvailabilityHandler) RespondToRequest(w http.ResponseWriter, r *http.Re
 
 	var req struct {
 		Accept bool `json:"accept"`
+		Force  bool `json:"force"` // Accept despite schedule conflicts
 	}
 	if err := DecodeJSON(r, &req); err != nil {
 		WriteError(w, model.NewBadRequestError("invalid request body"))
 		return
 	}
 
-	hangout, err := h.availabilityService.RespondToRequest(r.Context(), userID, requestID, req.Accept)
+	hangout, err := h.availabilityService.RespondToRequest(r.Context(), userID, requestID, req.Accept, req.Force)
 	if err != nil {
 		h.handleAvailabilityError(w, err)
 		return
@@ -370,7 +451,11 @@ func (h *AvailabilityHandler) RespondToRequest(w http.ResponseWriter, r *http.Re
 
 // GetHangoutTypes handles GET /v1/hangout-types - list hangout types
 func (h *AvailabilityHandler) GetHangoutTypes(w http.ResponseWriter, r *http.Request) {
-	types := model.GetHangoutTypeInfo()
+	types, err := h.availabilityService.ListHangoutTypes(r.Context())
+	if err != nil {
+		h.handleAvailabilityError(w, err)
+		return
+	}
 	WriteCollection(w, http.StatusOK, types, nil, map[string]string{
 		"self": "/v1/hangout-types",
 	})
@@ -433,6 +518,12 @@ func (h *AvailabilityHandler) UpdateHangoutStatus(w http.ResponseWriter, r *http
 }
 
 func (h *AvailabilityHandler) handleAvailabilityError(w http.ResponseWriter, err error) {
+	var conflict *service.ScheduleConflictError
+	if errors.As(err, &conflict) {
+		WriteError(w, model.NewScheduleConflictError(conflict.Conflicts))
+		return
+	}
+
 	switch {
 	case errors.Is(err, service.ErrAvailabilityNotFound):
 		WriteError(w, model.NewNotFoundError("availability"))
@@ -456,6 +547,8 @@ func (h *AvailabilityHandler) handleAvailabilityError(w http.ResponseWriter, err
 		WriteError(w, model.NewConflictError("already requested this hangout"))
 	case errors.Is(err, service.ErrCannotRequestOwn):
 		WriteError(w, model.NewBadRequestError("cannot request your own availability"))
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a member of this guild"))
 	default:
 		WriteError(w, model.NewInternalError("availability operation failed"))
 	}
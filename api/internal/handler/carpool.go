@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// CarpoolHandler handles carpools to events
+type CarpoolHandler struct {
+	rideshareService *service.RideshareService
+}
+
+// NewCarpoolHandler creates a new carpool handler
+func NewCarpoolHandler(rideshareService *service.RideshareService) *CarpoolHandler {
+	return &CarpoolHandler{rideshareService: rideshareService}
+}
+
+// ListRides handles GET /v1/events/{eventId}/carpool/rides - list the rides
+// offered to an event
+func (h *CarpoolHandler) ListRides(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	rides, err := h.rideshareService.ListEventRides(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, rides, nil, map[string]string{
+		"self":  "/v1/events/" + eventID + "/carpool/rides",
+		"event": "/v1/events/" + eventID,
+	})
+}
+
+// OfferRide handles POST /v1/events/{eventId}/carpool/rides - offer a ride
+// to an event
+func (h *CarpoolHandler) OfferRide(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.OfferRideRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	ride, err := h.rideshareService.OfferRide(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, ride, map[string]string{
+		"self":  "/v1/events/" + eventID + "/carpool/rides",
+		"event": "/v1/events/" + eventID,
+	})
+}
+
+// RequestRide handles POST /v1/events/{eventId}/carpool/requests - look for
+// a ride to an event
+func (h *CarpoolHandler) RequestRide(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.RequestRideRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	rideRequest, err := h.rideshareService.RequestRide(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, rideRequest, map[string]string{
+		"self":    "/v1/events/" + eventID + "/carpool/requests/" + rideRequest.ID,
+		"matches": "/v1/events/" + eventID + "/carpool/matches",
+	})
+}
+
+// CancelRequest handles DELETE /v1/events/{eventId}/carpool/requests/{requestId}
+// - withdraw a ride request
+func (h *CarpoolHandler) CancelRequest(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	requestID := r.PathValue("requestId")
+	if eventID == "" || requestID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and request ID required"))
+		return
+	}
+
+	if err := h.rideshareService.CancelRideRequest(r.Context(), userID, eventID, requestID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// Matches handles GET /v1/events/{eventId}/carpool/matches - rides passing
+// near the user's pickup, closest first
+func (h *CarpoolHandler) Matches(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	matches, err := h.rideshareService.FindRideMatches(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, matches, nil, map[string]string{
+		"self":  "/v1/events/" + eventID + "/carpool/matches",
+		"event": "/v1/events/" + eventID,
+	})
+}
+
+// RequestSeat handles POST /v1/rideshares/{rideshareId}/seats - ask the
+// driver for a seat
+func (h *CarpoolHandler) RequestSeat(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	rideshareID := r.PathValue("rideshareId")
+	if rideshareID == "" {
+		WriteError(w, model.NewBadRequestError("rideshare ID required"))
+		return
+	}
+
+	var req model.RequestSeatRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	seat, err := h.rideshareService.RequestSeat(r.Context(), userID, rideshareID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, seat, map[string]string{
+		"self": "/v1/rideshares/" + rideshareID + "/seats/" + seat.ID,
+	})
+}
+
+// RespondToSeat handles POST /v1/rideshares/{rideshareId}/seats/{seatId}/respond
+// - confirm or decline a seat request (driver only)
+func (h *CarpoolHandler) RespondToSeat(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	rideshareID := r.PathValue("rideshareId")
+	seatID := r.PathValue("seatId")
+	if rideshareID == "" || seatID == "" {
+		WriteError(w, model.NewBadRequestError("rideshare ID and seat ID required"))
+		return
+	}
+
+	var req model.RespondToSeatRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	seat, err := h.rideshareService.RespondToSeat(r.Context(), userID, rideshareID, seatID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, seat, map[string]string{
+		"self": "/v1/rideshares/" + rideshareID + "/seats/" + seatID,
+	})
+}
+
+// handleError converts service errors to HTTP responses
+func (h *CarpoolHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		WriteError(w, model.NewNotFoundError("event"))
+	case errors.Is(err, service.ErrRideshareNotFound):
+		WriteError(w, model.NewNotFoundError("rideshare"))
+	case errors.Is(err, service.ErrRideRequestNotFound):
+		WriteError(w, model.NewNotFoundError("ride request"))
+	case errors.Is(err, service.ErrSeatNotFound):
+		WriteError(w, model.NewNotFoundError("seat"))
+	case errors.Is(err, service.ErrNotEventAttendee),
+		errors.Is(err, service.ErrNotRideshareDriver):
+		WriteError(w, model.NewForbiddenError(err.Error()))
+	case errors.Is(err, service.ErrAlreadyOfferingRide),
+		errors.Is(err, service.ErrRideRequestExists),
+		errors.Is(err, service.ErrSeatAlreadyRequested),
+		errors.Is(err, service.ErrSeatAlreadyAnswered):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrCarpoolClosed),
+		errors.Is(err, service.ErrOwnRideshare),
+		errors.Is(err, service.ErrRideshareFull):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "state", Message: err.Error()}}))
+	case errors.Is(err, service.ErrTooManyRideshares):
+		WriteError(w, model.NewLimitExceededError("maximum rides per event reached", model.MaxRidesharesPerEvent, model.MaxRidesharesPerEvent))
+	default:
+		WriteError(w, model.NewInternalError("carpool operation failed"))
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// ClientErrorHandler collects client crash and API mismatch reports, and
+// shows them to admins
+type ClientErrorHandler struct {
+	clientErrorService *service.ClientErrorService
+}
+
+// NewClientErrorHandler creates a new client error handler
+func NewClientErrorHandler(clientErrorService *service.ClientErrorService) *ClientErrorHandler {
+	return &ClientErrorHandler{clientErrorService: clientErrorService}
+}
+
+// Report handles POST /v1/client-errors - a crash or API mismatch from an
+// app, signed in or not. Sampled reports are accepted without being stored.
+func (h *ClientErrorHandler) Report(w http.ResponseWriter, r *http.Request) {
+	var req model.ReportClientErrorRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	receipt, err := h.clientErrorService.Report(r.Context(), middleware.GetUserID(r.Context()), &req)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to store client error"))
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, receipt, nil)
+}
+
+// List handles GET /v1/admin/client-errors?kind=&platform=&app_version=
+// &fingerprint=&days=N&limit=N - reports from the last days, newest first
+func (h *ClientErrorHandler) List(w http.ResponseWriter, r *http.Request) {
+	filter, days, ok := clientErrorFilter(w, r)
+	if !ok {
+		return
+	}
+
+	reports, err := h.clientErrorService.List(r.Context(), filter, days)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to load client errors"))
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, reports, nil, clientErrorLinks())
+}
+
+// Summary handles GET /v1/admin/client-errors/summary with the same filters
+// as List - reports grouped by fingerprint, most reported first
+func (h *ClientErrorHandler) Summary(w http.ResponseWriter, r *http.Request) {
+	filter, days, ok := clientErrorFilter(w, r)
+	if !ok {
+		return
+	}
+
+	groups, err := h.clientErrorService.Summary(r.Context(), filter, days)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to summarize client errors"))
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, groups, nil, clientErrorLinks())
+}
+
+// clientErrorFilter reads the admin filters from the query string, writing
+// a validation error for unknown kinds and platforms
+func clientErrorFilter(w http.ResponseWriter, r *http.Request) (model.ClientErrorFilter, int, bool) {
+	q := r.URL.Query()
+	filter := model.ClientErrorFilter{
+		Kind:        model.ClientErrorKind(q.Get("kind")),
+		Platform:    model.DevicePlatform(q.Get("platform")),
+		AppVersion:  q.Get("app_version"),
+		Fingerprint: q.Get("fingerprint"),
+	}
+	filter.Limit, _ = strconv.Atoi(q.Get("limit"))
+	days, _ := strconv.Atoi(q.Get("days"))
+
+	var v model.Validator
+	v.Check("kind", filter.Kind == "" || filter.Kind.IsValid(), "kind must be crash or api_mismatch")
+	v.Check("platform", filter.Platform == "" || filter.Platform.IsValid(), "platform must be ios, android, or web")
+	if fieldErrors := v.Errors(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return filter, 0, false
+	}
+	return filter, days, true
+}
+
+func clientErrorLinks() map[string]string {
+	return map[string]string{
+		"self":    "/v1/admin/client-errors",
+		"summary": "/v1/admin/client-errors/summary",
+	}
+}
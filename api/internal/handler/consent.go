@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// ConsentHandler handles the user's data processing consents
+type ConsentHandler struct {
+	consentService *service.ConsentService
+}
+
+// NewConsentHandler creates a new consent handler
+func NewConsentHandler(consentService *service.ConsentService) *ConsentHandler {
+	return &ConsentHandler{consentService: consentService}
+}
+
+// List handles GET /v1/consents - the current text and decision for every
+// purpose
+func (h *ConsentHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	states, err := h.consentService.List(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, states, nil, map[string]string{
+		"self":    "/v1/consents",
+		"history": "/v1/consents/history",
+	})
+}
+
+// History handles GET /v1/consents/history - every grant and withdrawal,
+// newest first
+func (h *ConsentHandler) History(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	history, err := h.consentService.History(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, history, nil, map[string]string{
+		"self": "/v1/consents/history",
+	})
+}
+
+// Grant handles PUT /v1/consents/{purpose} - consent to the version of the
+// purpose's text the user was shown
+func (h *ConsentHandler) Grant(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.GrantConsentRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	purpose := model.ConsentPurpose(r.PathValue("purpose"))
+	state, err := h.consentService.Grant(r.Context(), userID, purpose, req.Version)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, state, consentLinks(purpose))
+}
+
+// Withdraw handles DELETE /v1/consents/{purpose} - withdraw consent, taking
+// effect immediately
+func (h *ConsentHandler) Withdraw(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	purpose := model.ConsentPurpose(r.PathValue("purpose"))
+	state, err := h.consentService.Withdraw(r.Context(), userID, purpose)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, state, consentLinks(purpose))
+}
+
+func consentLinks(purpose model.ConsentPurpose) map[string]string {
+	return map[string]string{
+		"self": "/v1/consents/" + string(purpose),
+	}
+}
+
+func (h *ConsentHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidConsentPurpose):
+		WriteError(w, model.NewNotFoundError("consent purpose"))
+	case errors.Is(err, service.ErrConsentVersionOutdated):
+		WriteError(w, model.NewConflictError("the consent text has changed; show the current version and ask again"))
+	default:
+		WriteError(w, model.NewInternalError("consent operation failed"))
+	}
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// ContentHandler serves the public question category and review tag catalogs
+type ContentHandler struct {
+	contentService *service.ContentService
+}
+
+// NewContentHandler creates a new content handler
+func NewContentHandler(contentService *service.ContentService) *ContentHandler {
+	return &ContentHandler{contentService: contentService}
+}
+
+// GetQuestionCategories handles GET /v1/questions/categories - list question categories
+func (h *ContentHandler) GetQuestionCategories(w http.ResponseWriter, r *http.Request) {
+	categories, etag, err := h.contentService.QuestionCategories(r.Context())
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to get question categories"))
+		return
+	}
+
+	writeCatalog(w, r, etag, categories, map[string]string{
+		"self": "/v1/questions/categories",
+	})
+}
+
+// GetPositiveTags handles GET /v1/reviews/tags/positive - list positive tags
+func (h *ContentHandler) GetPositiveTags(w http.ResponseWriter, r *http.Request) {
+	h.getReviewTags(w, r, model.ReviewTagKindPositive)
+}
+
+// GetImprovementTags handles GET /v1/reviews/tags/improvement - list improvement tags
+func (h *ContentHandler) GetImprovementTags(w http.ResponseWriter, r *http.Request) {
+	h.getReviewTags(w, r, model.ReviewTagKindImprovement)
+}
+
+func (h *ContentHandler) getReviewTags(w http.ResponseWriter, r *http.Request, kind model.ReviewTagKind) {
+	tags, etag, err := h.contentService.ReviewTags(r.Context(), kind)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to get review tags"))
+		return
+	}
+
+	writeCatalog(w, r, etag, tags, map[string]string{
+		"self": "/v1/reviews/tags/" + string(kind),
+	})
+}
+
+// writeCatalog writes a catalog collection with an ETag. Clients must
+// revalidate on every use, so admin changes are visible as soon as the
+// catalog cache is invalidated; unchanged catalogs answer 304.
+func writeCatalog(w http.ResponseWriter, r *http.Request, etag string, data interface{}, links map[string]string) {
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if match := r.Header.Get("If-None-Match"); match != "" && match == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, data, nil, links)
+}
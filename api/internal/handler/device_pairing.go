@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/mapping"
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// DevicePairingHandler handles QR login for shared devices
+type DevicePairingHandler struct {
+	pairingService *service.DevicePairingService
+}
+
+// NewDevicePairingHandler creates a new device pairing handler
+func NewDevicePairingHandler(pairingService *service.DevicePairingService) *DevicePairingHandler {
+	return &DevicePairingHandler{pairingService: pairingService}
+}
+
+// Start handles POST /v1/auth/device-pairing - show a QR login on a device
+// that isn't signed in
+func (h *DevicePairingHandler) Start(w http.ResponseWriter, r *http.Request) {
+	var req model.StartDevicePairingRequest
+	// Allow an empty body; the device name is optional
+	_ = DecodeJSON(r, &req)
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	pairing, err := h.pairingService.Start(r.Context(), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, pairing, map[string]string{
+		"poll": "/v1/auth/device-pairing/" + pairing.ID + "/poll",
+	})
+}
+
+// DevicePairingPollResponse is pending until the QR login is approved,
+// then carries the session
+type DevicePairingPollResponse struct {
+	Status string                 `json:"status"`
+	User   *mapping.UserResponse  `json:"user,omitempty"`
+	Token  *mapping.TokenResponse `json:"token,omitempty"`
+}
+
+// Poll handles POST /v1/auth/device-pairing/{id}/poll
+func (h *DevicePairingHandler) Poll(w http.ResponseWriter, r *http.Request) {
+	var req model.PollDevicePairingRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	result, err := h.pairingService.Poll(r.Context(), r.PathValue("id"), req.PollToken)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	response := DevicePairingPollResponse{Status: result.Status}
+	if result.User != nil {
+		user := mapping.User(result.User)
+		token := mapping.Token(result.TokenPair)
+		response.User = &user
+		response.Token = &token
+	}
+
+	WriteData(w, http.StatusOK, response, nil)
+}
+
+// Approve handles POST /v1/auth/device-approve - sign the device behind a
+// scanned QR code in as the caller
+func (h *DevicePairingHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	var req model.ApproveDeviceRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	pairing, err := h.pairingService.Approve(r.Context(), middleware.GetUserID(r.Context()), req.Payload)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, pairing, nil)
+}
+
+func (h *DevicePairingHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidPairingPayload):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "payload", Message: "not a valid QR login code"},
+		}))
+	case errors.Is(err, service.ErrDevicePairingNotFound):
+		WriteError(w, model.NewNotFoundError("device pairing"))
+	case errors.Is(err, service.ErrDevicePairingExpired):
+		WriteError(w, model.NewGoneError("QR code has expired; show a new one"))
+	case errors.Is(err, service.ErrDevicePairingApproved):
+		WriteError(w, model.NewConflictError("QR code was already approved"))
+	case errors.Is(err, service.ErrInvalidPollToken):
+		WriteError(w, model.NewForbiddenError("invalid poll token"))
+	case errors.Is(err, service.ErrUserNotFound):
+		WriteError(w, model.NewNotFoundError("user"))
+	default:
+		WriteError(w, model.NewInternalError("device pairing failed"))
+	}
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -68,6 +69,9 @@ func (h *DiscoveryHandler) DiscoverPeople(w http.ResponseWriter, r *http.Request
 		filter.HangoutTypes = append(filter.HangoutTypes, model.HangoutType(ht))
 	}
 
+	// Parse spoken languages (can be multiple)
+	filter.Languages = r.URL.Query()["language"]
+
 	// Parse interest filter
 	if interestID := r.URL.Query().Get("interest_id"); interestID != "" {
 		filter.InterestID = &interestID
@@ -102,7 +106,7 @@ func (h *DiscoveryHandler) DiscoverPeople(w http.ResponseWriter, r *http.Request
 
 	response, err := h.discoveryService.DiscoverPeople(r.Context(), userID, filter)
 	if err != nil {
-		WriteError(w, model.NewInternalError("failed to discover people"))
+		writeDiscoveryError(w, err, "failed to discover people")
 		return
 	}
 
@@ -133,7 +137,7 @@ func (h *DiscoveryHandler) DiscoverByInterest(w http.ResponseWriter, r *http.Req
 
 	results, err := h.discoveryService.DiscoverByInterest(r.Context(), userID, interestID, limit)
 	if err != nil {
-		WriteError(w, model.NewInternalError("failed to discover by interest"))
+		writeDiscoveryError(w, err, "failed to discover by interest")
 		return
 	}
 
@@ -161,7 +165,7 @@ func (h *DiscoveryHandler) DiscoverTeachLearn(w http.ResponseWriter, r *http.Req
 
 	results, err := h.discoveryService.FindTeachLearnMatches(r.Context(), userID, limit)
 	if err != nil {
-		WriteError(w, model.NewInternalError("failed to find teach/learn matches"))
+		writeDiscoveryError(w, err, "failed to find teach/learn matches")
 		return
 	}
 
@@ -173,8 +177,39 @@ func (h *DiscoveryHandler) DiscoverTeachLearn(w http.ResponseWriter, r *http.Req
 
 // GetHangoutTypes handles GET /v1/discover/hangout-types - get available hangout types
 func (h *DiscoveryHandler) GetHangoutTypes(w http.ResponseWriter, r *http.Request) {
-	types := model.GetHangoutTypeInfo()
+	types, err := h.discoveryService.ListHangoutTypes(r.Context())
+	if err != nil {
+		writeDiscoveryError(w, err, "failed to list hangout types")
+		return
+	}
 	WriteJSON(w, http.StatusOK, map[string]interface{}{
 		"hangout_types": types,
 	})
 }
+
+// writeDiscoveryError maps discovery errors, reporting the completeness score
+// when the requester's profile hasn't unlocked discovery yet
+func writeDiscoveryError(w http.ResponseWriter, err error, detail string) {
+	var incomplete *service.ProfileIncompleteError
+	if errors.As(err, &incomplete) {
+		WriteError(w, model.NewProfileIncompleteError(incomplete.Completeness))
+		return
+	}
+	if errors.Is(err, service.ErrInvalidHangoutType) {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "hangout_type", Message: "invalid hangout type"},
+		}))
+		return
+	}
+	if errors.Is(err, service.ErrInvalidLanguage) {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "language", Message: "languages must be ISO 639-1 codes such as en or es"},
+		}))
+		return
+	}
+	if errors.Is(err, service.ErrLocationConsentRequired) {
+		WriteError(w, model.NewForbiddenError("location processing consent required to search near a location"))
+		return
+	}
+	WriteError(w, model.NewInternalError(detail))
+}
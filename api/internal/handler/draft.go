@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// DraftHandler handles draft autosave endpoints
+type DraftHandler struct {
+	draftService *service.DraftService
+}
+
+// NewDraftHandler creates a new draft handler
+func NewDraftHandler(draftService *service.DraftService) *DraftHandler {
+	return &DraftHandler{draftService: draftService}
+}
+
+// Create handles POST /v1/drafts - start a draft
+func (h *DraftHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.SaveDraftRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	draft, err := h.draftService.Save(r.Context(), userID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, draft, draftLinks(draft.ID))
+}
+
+// List handles GET /v1/drafts - the user's drafts, optionally filtered by
+// ?resource_type=
+func (h *DraftHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var resourceType *model.DraftResourceType
+	if value := r.URL.Query().Get("resource_type"); value != "" {
+		rt := model.DraftResourceType(value)
+		if !rt.IsValid() {
+			WriteError(w, model.NewBadRequestError("resource_type must be event or adventure"))
+			return
+		}
+		resourceType = &rt
+	}
+
+	drafts, err := h.draftService.List(r.Context(), userID, resourceType)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, drafts, nil, map[string]string{
+		"self": "/v1/drafts",
+	})
+}
+
+// Get handles GET /v1/drafts/{draftId} - resume a draft
+func (h *DraftHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	draft, err := h.draftService.Get(r.Context(), userID, r.PathValue("draftId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, draft, draftLinks(draft.ID))
+}
+
+// Update handles PUT /v1/drafts/{draftId} - autosave a draft's data
+func (h *DraftHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.UpdateDraftRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	draft, err := h.draftService.Update(r.Context(), userID, r.PathValue("draftId"), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, draft, draftLinks(draft.ID))
+}
+
+// Delete handles DELETE /v1/drafts/{draftId} - discard a draft
+func (h *DraftHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	if err := h.draftService.Delete(r.Context(), userID, r.PathValue("draftId")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// Submit handles POST /v1/drafts/{draftId}/submit - create the resource the
+// draft describes. Validation failures return 422 and are saved on the draft.
+func (h *DraftHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	submission, err := h.draftService.Submit(r.Context(), userID, r.PathValue("draftId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	links := map[string]string{}
+	switch submission.ResourceType {
+	case model.DraftResourceEvent:
+		links["resource"] = "/v1/events/" + submission.ResourceID
+	case model.DraftResourceAdventure:
+		links["resource"] = "/v1/adventures/" + submission.ResourceID
+	}
+
+	WriteData(w, http.StatusCreated, submission, links)
+}
+
+func draftLinks(id string) map[string]string {
+	return map[string]string{
+		"self":   "/v1/drafts/" + id,
+		"submit": "/v1/drafts/" + id + "/submit",
+	}
+}
+
+func (h *DraftHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+	var duplicate *service.DuplicateError
+	if errors.As(err, &duplicate) {
+		WriteError(w, model.NewPossibleDuplicateError(duplicate.Candidates))
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrDraftNotFound):
+		WriteError(w, model.NewNotFoundError("draft"))
+	case errors.Is(err, service.ErrMaxDraftsReached):
+		WriteError(w, model.NewLimitExceededError("drafts", model.MaxDraftsPerUser, model.MaxDraftsPerUser))
+	default:
+		WriteError(w, model.NewInternalError("draft operation failed"))
+	}
+}
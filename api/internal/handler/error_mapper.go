@@ -15,13 +15,32 @@ func MapServiceError(err error) *model.ProblemDetails {
 		return nil
 	}
 
+	// Errors carrying structured guidance
+	var weak *service.WeakPasswordError
+	if errors.As(err, &weak) {
+		return model.NewWeakPasswordError(weak.Strength)
+	}
+	var notOpen *service.RSVPNotOpenError
+	if errors.As(err, &notOpen) {
+		return model.NewRSVPNotOpenError(notOpen.OpensAt)
+	}
+	var duplicate *service.DuplicateError
+	if errors.As(err, &duplicate) {
+		return model.NewPossibleDuplicateError(duplicate.Candidates)
+	}
+	var spontaneousLimit *service.SpontaneousLimitError
+	if errors.As(err, &spontaneousLimit) {
+		return model.NewRateLimitError(retryAfterSeconds(spontaneousLimit.RetryAt))
+	}
+
 	// ===== Authentication Errors → 401 =====
 	switch {
 	case errors.Is(err, service.ErrInvalidCredentials):
 		return model.NewUnauthorizedError(err.Error())
 	case errors.Is(err, service.ErrInvalidRefreshToken),
 		errors.Is(err, service.ErrRefreshTokenExpired),
-		errors.Is(err, service.ErrRefreshTokenRevoked):
+		errors.Is(err, service.ErrRefreshTokenRevoked),
+		errors.Is(err, service.ErrRefreshTokenUnbound):
 		return model.NewUnauthorizedError(err.Error())
 	case errors.Is(err, service.ErrInvalidChallenge),
 		errors.Is(err, service.ErrInvalidCredential),
@@ -32,10 +51,21 @@ func MapServiceError(err error) *model.ProblemDetails {
 	case errors.Is(err, service.ErrNotGuildAdmin),
 		errors.Is(err, service.ErrNotGuildMember),
 		errors.Is(err, service.ErrNotEventHost),
+		errors.Is(err, service.ErrNotTicketHolder),
 		errors.Is(err, service.ErrNotPoolMember),
 		errors.Is(err, service.ErrNotMatchMember),
-		errors.Is(err, service.ErrCannotAssignOthers):
+		errors.Is(err, service.ErrCannotAssignOthers),
+		errors.Is(err, service.ErrTierRequired),
+		errors.Is(err, service.ErrNotVenueCreator),
+		errors.Is(err, service.ErrNotEventAttendee),
+		errors.Is(err, service.ErrNotRideshareDriver),
+		errors.Is(err, service.ErrNotExpenseParticipant),
+		errors.Is(err, service.ErrNotSettlementParty),
+		errors.Is(err, service.ErrNotInvitee),
+		errors.Is(err, service.ErrMissingPermission):
 		return model.NewForbiddenError(err.Error())
+	case errors.Is(err, service.ErrReauthRequired):
+		return model.NewReauthRequiredError()
 
 	// ===== Not Found Errors → 404 =====
 	case errors.Is(err, service.ErrUserNotFound):
@@ -54,6 +84,8 @@ func MapServiceError(err error) *model.ProblemDetails {
 		return model.NewNotFoundError("profile")
 	case errors.Is(err, service.ErrAvailabilityNotFound):
 		return model.NewNotFoundError("availability")
+	case errors.Is(err, service.ErrNotAvailableNow):
+		return model.NewNotFoundError("spontaneous availability")
 	case errors.Is(err, service.ErrHangoutNotFound):
 		return model.NewNotFoundError("hangout")
 	case errors.Is(err, service.ErrHangoutRequestNotFound):
@@ -80,10 +112,50 @@ func MapServiceError(err error) *model.ProblemDetails {
 		return model.NewNotFoundError("moderation action")
 	case errors.Is(err, service.ErrPasskeyNotFound):
 		return model.NewNotFoundError("passkey")
+	case errors.Is(err, service.ErrTicketNotFound):
+		return model.NewNotFoundError("ticket")
+	case errors.Is(err, service.ErrPayoutAccountNotFound):
+		return model.NewNotFoundError("payout account")
+	case errors.Is(err, service.ErrPromoCodeNotFound):
+		return model.NewNotFoundError("promo code")
+	case errors.Is(err, service.ErrTierNotFound):
+		return model.NewNotFoundError("guild tier")
+	case errors.Is(err, service.ErrTierAssignmentNotFound):
+		return model.NewNotFoundError("tier assignment")
+	case errors.Is(err, service.ErrMuteNotFound):
+		return model.NewNotFoundError("mute")
+	case errors.Is(err, service.ErrReactionNotFound):
+		return model.NewNotFoundError("reaction")
+	case errors.Is(err, service.ErrHangoutTypeNotFound):
+		return model.NewNotFoundError("hangout type")
+	case errors.Is(err, service.ErrVenueNotFound):
+		return model.NewNotFoundError("venue")
+	case errors.Is(err, service.ErrRideshareNotFound):
+		return model.NewNotFoundError("rideshare")
+	case errors.Is(err, service.ErrRideRequestNotFound):
+		return model.NewNotFoundError("ride request")
+	case errors.Is(err, service.ErrSeatNotFound):
+		return model.NewNotFoundError("seat")
+	case errors.Is(err, service.ErrExpenseNotFound):
+		return model.NewNotFoundError("expense")
+	case errors.Is(err, service.ErrGuildInviteNotFound):
+		return model.NewNotFoundError("guild invite")
+	case errors.Is(err, service.ErrGuildRoleNotFound):
+		return model.NewNotFoundError("guild role")
+	case errors.Is(err, service.ErrSignupRiskNotFound):
+		return model.NewNotFoundError("signup risk")
+	case errors.Is(err, service.ErrGuildRoleNotHeld):
+		return model.NewNotFoundError("role assignment")
 
 	// ===== Conflict Errors → 409 =====
 	case errors.Is(err, service.ErrEmailAlreadyExists),
-		errors.Is(err, service.ErrGuildNameExists):
+		errors.Is(err, service.ErrEmailAlreadyVerified),
+		errors.Is(err, service.ErrGuildNameExists),
+		errors.Is(err, service.ErrGuildSlugTaken),
+		errors.Is(err, service.ErrPromoCodeExists),
+		errors.Is(err, service.ErrTierExists),
+		errors.Is(err, service.ErrHangoutTypeExists),
+		errors.Is(err, service.ErrGuildRoleExists):
 		return model.NewConflictError(err.Error())
 	case errors.Is(err, service.ErrAlreadyGuildMember),
 		errors.Is(err, service.ErrAlreadyRSVPd),
@@ -95,7 +167,11 @@ func MapServiceError(err error) *model.ProblemDetails {
 		errors.Is(err, service.ErrAlreadyHost),
 		errors.Is(err, service.ErrAlreadyRequested),
 		errors.Is(err, service.ErrInterestAlreadyExists),
-		errors.Is(err, service.ErrProfileExists):
+		errors.Is(err, service.ErrProfileExists),
+		errors.Is(err, service.ErrAlreadyOfferingRide),
+		errors.Is(err, service.ErrRideRequestExists),
+		errors.Is(err, service.ErrSeatAlreadyRequested),
+		errors.Is(err, service.ErrSeatAlreadyAnswered):
 		return model.NewConflictError(err.Error())
 
 	// ===== Validation Errors → 422 =====
@@ -119,6 +195,9 @@ func MapServiceError(err error) *model.ProblemDetails {
 		errors.Is(err, service.ErrGuildDescTooLong):
 		return model.NewValidationError([]model.FieldError{{Field: "guild", Message: err.Error()}})
 
+	case errors.Is(err, service.ErrInvalidGuildSlug):
+		return model.NewValidationError([]model.FieldError{{Field: "slug", Message: err.Error()}})
+
 	case errors.Is(err, service.ErrInvalidHangoutType),
 		errors.Is(err, service.ErrInvalidTimeRange),
 		errors.Is(err, service.ErrInvalidStartTimeFormat),
@@ -140,6 +219,14 @@ func MapServiceError(err error) *model.ProblemDetails {
 		errors.Is(err, service.ErrTaglineTooLong),
 		errors.Is(err, service.ErrTooManyLanguages):
 		return model.NewValidationError([]model.FieldError{{Field: "profile", Message: err.Error()}})
+	case errors.Is(err, service.ErrInvalidLanguage):
+		return model.NewValidationError([]model.FieldError{{Field: "languages", Message: err.Error()}})
+	case errors.Is(err, service.ErrInvalidAccessibility):
+		return model.NewValidationError([]model.FieldError{{Field: "accessibility_needs", Message: err.Error()}})
+	case errors.Is(err, service.ErrAddressNotFound):
+		return model.NewValidationError([]model.FieldError{{Field: "address", Message: err.Error()}})
+	case errors.Is(err, service.ErrVenueOverCapacity):
+		return model.NewValidationError([]model.FieldError{{Field: "max_attendees", Message: err.Error()}})
 
 	case errors.Is(err, service.ErrInvalidReviewContext),
 		errors.Is(err, service.ErrTooManyTags),
@@ -183,7 +270,44 @@ func MapServiceError(err error) *model.ProblemDetails {
 		errors.Is(err, service.ErrTrustNotEstablished),
 		errors.Is(err, service.ErrIRLRequired),
 		errors.Is(err, service.ErrValuesCheckRequired),
-		errors.Is(err, service.ErrRSVPNotAllowed):
+		errors.Is(err, service.ErrRSVPNotAllowed),
+		errors.Is(err, service.ErrEventNotTicketed),
+		errors.Is(err, service.ErrTicketHoldRequired),
+		errors.Is(err, service.ErrTicketNotTransferable),
+		errors.Is(err, service.ErrTicketRecipientHasTicket),
+		errors.Is(err, service.ErrTicketRecipientNotMember),
+		errors.Is(err, service.ErrInvalidTicket),
+		errors.Is(err, service.ErrTicketAlreadyUsed),
+		errors.Is(err, service.ErrTicketNotApproved),
+		errors.Is(err, service.ErrPaymentsDisabled),
+		errors.Is(err, service.ErrEventNotPaid),
+		errors.Is(err, service.ErrPaymentNotDue),
+		errors.Is(err, service.ErrPayoutAccountNotReady),
+		errors.Is(err, service.ErrPromoCodeInvalid),
+		errors.Is(err, service.ErrPromoCodeRedeemed),
+		errors.Is(err, service.ErrTooManyPromoCodes),
+		errors.Is(err, service.ErrNotGuildEvent),
+		errors.Is(err, service.ErrTierInUse),
+		errors.Is(err, service.ErrTooManyTiers),
+		errors.Is(err, service.ErrTooManyMutes),
+		errors.Is(err, service.ErrTierHolderNotMember),
+		errors.Is(err, service.ErrRSVPNotOpen),
+		errors.Is(err, service.ErrEarlyAccessNotGuild),
+		errors.Is(err, service.ErrBuiltInHangoutType),
+		errors.Is(err, service.ErrTooManyHangoutTypes),
+		errors.Is(err, service.ErrTooManyVenues),
+		errors.Is(err, service.ErrCarpoolClosed),
+		errors.Is(err, service.ErrOwnRideshare),
+		errors.Is(err, service.ErrRideshareFull),
+		errors.Is(err, service.ErrTooManyRideshares),
+		errors.Is(err, service.ErrPayerNotParticipant),
+		errors.Is(err, service.ErrExpenseCurrencyMismatch),
+		errors.Is(err, service.ErrTooManyExpenses),
+		errors.Is(err, service.ErrNothingToSettle),
+		errors.Is(err, service.ErrTooManyGuildInvites),
+		errors.Is(err, service.ErrTooManyGuildRoles),
+		errors.Is(err, service.ErrTooManyMemberRoles),
+		errors.Is(err, service.ErrGuildRoleHolderNotMember):
 		return model.NewValidationError([]model.FieldError{{Field: "state", Message: err.Error()}})
 
 	// ===== Security Errors → 400 =====
@@ -197,6 +321,8 @@ func MapServiceError(err error) *model.ProblemDetails {
 	case errors.Is(err, service.ErrAccountLinkingRequired),
 		errors.Is(err, service.ErrAccountLinkPending):
 		return model.NewBadRequestError(err.Error())
+	case errors.Is(err, service.ErrInvalidWebhook):
+		return model.NewBadRequestError(err.Error())
 
 	// ===== Banned/Suspended Users → 403 =====
 	case errors.Is(err, service.ErrUserBanned),
@@ -36,28 +36,14 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate
-	var fieldErrors []model.FieldError
-	if req.Title == "" {
-		fieldErrors = append(fieldErrors, model.FieldError{
-			Field:   "title",
-			Message: "title is required",
-		})
-	}
-	if req.StartTime.IsZero() {
-		fieldErrors = append(fieldErrors, model.FieldError{
-			Field:   "start_time",
-			Message: "start_time is required",
-		})
-	}
-	if len(fieldErrors) > 0 {
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
 		WriteError(w, model.NewValidationError(fieldErrors))
 		return
 	}
 
 	event, err := h.eventService.CreateEvent(r.Context(), userID, &req)
 	if err != nil {
-		WriteError(w, model.NewInternalError("failed to create event"))
+		h.handleEventError(w, err)
 		return
 	}
 
@@ -82,8 +68,21 @@ func (h *EventHandler) GetEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	WriteData(w, http.StatusOK, eventDetails, map[string]string{
-		"self": "/v1/events/" + eventID,
+	self := "/v1/events/" + eventID
+	rsvp := self + "/rsvp"
+	if eventDetails.Event.Ticketed && eventDetails.UserTicket == nil {
+		rsvp = self + "/tickets/hold" // Ticketed RSVPs start with a hold
+	}
+	links := map[string]string{
+		"self": self,
+	}
+	if mine := eventDetails.UserRSVP; mine != nil && mine.PaymentStatus != nil && *mine.PaymentStatus == model.PaymentStatusPending {
+		links["checkout"] = rsvp + "/checkout"
+	}
+	WriteResource(w, http.StatusOK, eventDetails, links, h.eventService.Affordances(eventDetails, userID), map[model.Action]string{
+		model.ActionEdit:   self,
+		model.ActionCancel: self + "/cancel",
+		model.ActionRSVP:   rsvp,
 	})
 }
 
@@ -102,8 +101,15 @@ func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req model.UpdateEventRequest
-	if err := DecodeJSON(r, &req); err != nil {
-		WriteError(w, model.NewBadRequestError("invalid request body"))
+	cleared, problem := DecodePatch(r, &req, model.EventPatchRules)
+	if problem != nil {
+		WriteError(w, problem)
+		return
+	}
+	req.Clear = cleared
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
 		return
 	}
 
@@ -118,7 +124,7 @@ func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// CancelEvent handles DELETE /v1/events/{eventId} - cancel an event
+// CancelEvent handles POST /v1/events/{eventId}/cancel - cancel an event
 func (h *EventHandler) CancelEvent(w http.ResponseWriter, r *http.Request) {
 	userID := middleware.GetUserID(r.Context())
 	if userID == "" {
@@ -140,6 +146,103 @@ func (h *EventHandler) CancelEvent(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// DeleteEvent handles DELETE /v1/events/{eventId} - move a guild event to the
+// guild's trash
+func (h *EventHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	if err := h.eventService.DeleteEvent(r.Context(), userID, eventID); err != nil {
+		h.handleEventError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SchedulePublication handles PUT /v1/events/{eventId}/schedule - schedule
+// or reschedule when a draft event is published
+func (h *EventHandler) SchedulePublication(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.SchedulePublicationRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	event, err := h.eventService.SchedulePublication(r.Context(), userID, eventID, *req.PublishAt)
+	if err != nil {
+		h.handleEventError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, event, map[string]string{
+		"self": "/v1/events/" + eventID,
+	})
+}
+
+// SetRSVPWindow handles PUT /v1/events/{eventId}/rsvp-window - replace when
+// RSVPs open and who can RSVP early (host only)
+func (h *EventHandler) SetRSVPWindow(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.SetRSVPWindowRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	event, err := h.eventService.SetRSVPWindow(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleEventError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, event, map[string]string{
+		"self": "/v1/events/" + eventID,
+	})
+}
+
 // RSVP handles POST /v1/events/{eventId}/rsvp - RSVP to an event
 func (h *EventHandler) RSVP(w http.ResponseWriter, r *http.Request) {
 	userID := middleware.GetUserID(r.Context())
@@ -190,12 +293,13 @@ func (h *EventHandler) CancelRSVP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.eventService.CancelRSVP(r.Context(), userID, eventID); err != nil {
+	op, err := h.eventService.CancelRSVP(r.Context(), userID, eventID)
+	if err != nil {
 		h.handleEventError(w, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	writeUndoable(w, op)
 }
 
 // GetPendingRSVPs handles GET /v1/events/{eventId}/rsvps/pending - get pending RSVPs (host only)
@@ -378,8 +482,16 @@ func (h *EventHandler) GetPublicEvents(w http.ResponseWriter, r *http.Request) {
 	if city := r.URL.Query().Get("city"); city != "" {
 		filters.City = &city
 	}
+	filters.Accessibility = r.URL.Query()["accessibility"]
+	if !model.ValidAccessibility(filters.Accessibility) {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "accessibility", Message: model.InvalidAccessibilityMessage},
+		}))
+		return
+	}
+	filters.MatchNeeds = r.URL.Query().Get("match_needs") == "true"
 
-	events, err := h.eventService.GetPublicEvents(r.Context(), &filters, limit)
+	events, err := h.eventService.GetPublicEvents(r.Context(), middleware.GetUserID(r.Context()), &filters, limit)
 	if err != nil {
 		WriteError(w, model.NewInternalError("failed to get events"))
 		return
@@ -398,18 +510,56 @@ func (h *EventHandler) GetGuildEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	events, err := h.eventService.GetGuildEvents(r.Context(), guildID, nil)
+	list, fieldErrors := model.EventListFields.Parse(r.URL.Query())
+	if len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	page, problem := getPageRequest(r, list)
+	if problem != nil {
+		WriteError(w, problem)
+		return
+	}
+
+	events, err := h.eventService.GetGuildEventsPage(r.Context(), guildID, list, page)
 	if err != nil {
-		WriteError(w, model.NewInternalError("failed to get events"))
+		if !writeCursorError(w, err) {
+			WriteError(w, model.NewInternalError("failed to get events"))
+		}
 		return
 	}
 
-	WriteCollection(w, http.StatusOK, events, nil, map[string]string{
-		"self": "/v1/guilds/" + guildID + "/events",
-	})
+	writePage(w, r, events, "/v1/guilds/"+guildID+"/events")
 }
 
 func (h *EventHandler) handleEventError(w http.ResponseWriter, err error) {
+	var conflict *service.VersionConflictError
+	if errors.As(err, &conflict) {
+		WriteError(w, model.NewVersionConflictError(conflict.Current))
+		return
+	}
+	var scheduleConflict *service.ScheduleConflictError
+	if errors.As(err, &scheduleConflict) {
+		WriteError(w, model.NewScheduleConflictError(scheduleConflict.Conflicts))
+		return
+	}
+	var duplicate *service.DuplicateError
+	if errors.As(err, &duplicate) {
+		WriteError(w, model.NewPossibleDuplicateError(duplicate.Candidates))
+		return
+	}
+	var notOpen *service.RSVPNotOpenError
+	if errors.As(err, &notOpen) {
+		WriteError(w, model.NewRSVPNotOpenError(notOpen.OpensAt))
+		return
+	}
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
 	switch {
 	case errors.Is(err, service.ErrEventNotFound):
 		WriteError(w, model.NewNotFoundError("event"))
@@ -421,8 +571,48 @@ func (h *EventHandler) handleEventError(w http.ResponseWriter, err error) {
 		WriteError(w, model.NewConflictError("event is full"))
 	case errors.Is(err, service.ErrAlreadyRSVPd):
 		WriteError(w, model.NewConflictError("already RSVP'd"))
+	case errors.Is(err, service.ErrRSVPNotAllowed):
+		WriteError(w, model.NewConflictError("event isn't taking RSVPs"))
+	case errors.Is(err, service.ErrRSVPNotOpen):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrTierRequired),
+		errors.Is(err, service.ErrMissingPermission),
+		errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError(err.Error()))
+	case errors.Is(err, service.ErrTierNotFound):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "min_tier", Message: err.Error()}}))
+	case errors.Is(err, service.ErrEarlyAccessNotGuild):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "early_access", Message: err.Error()}}))
 	case errors.Is(err, service.ErrValuesCheckRequired):
 		WriteError(w, model.NewBadRequestError("values alignment check required"))
+	case errors.Is(err, service.ErrPublicationNotSchedulable):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrPublishAtInvalid):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "publish_at", Message: err.Error()}}))
+	case errors.Is(err, service.ErrNotGuildContent):
+		WriteError(w, model.NewConflictError("only guild events can be deleted; cancel the event instead"))
+	case errors.Is(err, service.ErrTicketNotFound):
+		WriteError(w, model.NewNotFoundError("ticket"))
+	case errors.Is(err, service.ErrNotTicketHolder):
+		WriteError(w, model.NewForbiddenError(err.Error()))
+	case errors.Is(err, service.ErrEventNotTicketed),
+		errors.Is(err, service.ErrTicketHoldRequired),
+		errors.Is(err, service.ErrTicketNotTransferable),
+		errors.Is(err, service.ErrTicketRecipientHasTicket),
+		errors.Is(err, service.ErrTicketAlreadyUsed),
+		errors.Is(err, service.ErrTicketNotApproved):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrTicketRecipientNotMember):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "to_user_id", Message: err.Error()}}))
+	case errors.Is(err, service.ErrInvalidTicket):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "payload", Message: err.Error()}}))
+	case errors.Is(err, service.ErrPaymentsDisabled),
+		errors.Is(err, service.ErrPayoutAccountNotReady):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "ticket_price", Message: err.Error()}}))
+	case errors.Is(err, service.ErrVenueNotFound):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "venue_id", Message: err.Error()}}))
+	case errors.Is(err, service.ErrVenueOverCapacity):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "max_attendees", Message: err.Error()}}))
 	default:
 		WriteError(w, model.NewInternalError("event operation failed"))
 	}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// EventReminderHandler handles event reminder preference endpoints
+type EventReminderHandler struct {
+	reminderService *service.EventReminderService
+}
+
+// NewEventReminderHandler creates a new event reminder handler
+func NewEventReminderHandler(reminderService *service.EventReminderService) *EventReminderHandler {
+	return &EventReminderHandler{reminderService: reminderService}
+}
+
+// GetPreference handles GET /v1/profile/event-reminders - get reminder preferences
+func (h *EventReminderHandler) GetPreference(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	pref, err := h.reminderService.GetPreference(r.Context(), userID)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to get reminder preferences"))
+		return
+	}
+
+	WriteData(w, http.StatusOK, pref, map[string]string{
+		"self": "/v1/profile/event-reminders",
+	})
+}
+
+// UpdatePreference handles PATCH /v1/profile/event-reminders - update reminder preferences
+func (h *EventReminderHandler) UpdatePreference(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.UpdateEventReminderPreferenceRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	pref, err := h.reminderService.UpdatePreference(r.Context(), userID, &req)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to update reminder preferences"))
+		return
+	}
+
+	WriteData(w, http.StatusOK, pref, map[string]string{
+		"self": "/v1/profile/event-reminders",
+	})
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -256,6 +257,115 @@ func (h *EventRoleHandler) CancelAssignment(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// NominateForRole handles POST /v1/events/{eventId}/roles/{roleId}/nominations - nominate self for an approval-mode role
+func (h *EventRoleHandler) NominateForRole(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	roleID := r.PathValue("roleId")
+	if eventID == "" || roleID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and role ID required"))
+		return
+	}
+
+	var req model.NominateRoleRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	assignment, err := h.eventRoleService.NominateForRole(r.Context(), userID, eventID, roleID, &req)
+	if err != nil {
+		h.handleEventRoleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, assignment, nil)
+}
+
+// GetNominations handles GET /v1/events/{eventId}/roles/nominations - list pending nominations (host only)
+func (h *EventRoleHandler) GetNominations(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	nominations, err := h.eventRoleService.GetPendingNominations(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleEventRoleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, nominations, nil, map[string]string{
+		"self": "/v1/events/" + eventID + "/roles/nominations",
+	})
+}
+
+// ApproveNomination handles POST /v1/events/{eventId}/roles/nominations/{assignmentId}/approve - approve a nomination (host only)
+func (h *EventRoleHandler) ApproveNomination(w http.ResponseWriter, r *http.Request) {
+	h.reviewNomination(w, r, h.eventRoleService.ApproveNomination)
+}
+
+// DeclineNomination handles POST /v1/events/{eventId}/roles/nominations/{assignmentId}/decline - decline a nomination (host only)
+func (h *EventRoleHandler) DeclineNomination(w http.ResponseWriter, r *http.Request) {
+	h.reviewNomination(w, r, h.eventRoleService.DeclineNomination)
+}
+
+type nominationReviewFunc func(ctx context.Context, hostUserID, eventID, assignmentID string, req *model.ReviewNominationRequest) (*model.EventRoleAssignment, error)
+
+func (h *EventRoleHandler) reviewNomination(w http.ResponseWriter, r *http.Request, review nominationReviewFunc) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	assignmentID := r.PathValue("assignmentId")
+	if eventID == "" || assignmentID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and assignment ID required"))
+		return
+	}
+
+	// The body is optional; it only carries a response to the nominee
+	var req model.ReviewNominationRequest
+	if r.ContentLength != 0 {
+		if err := DecodeJSON(r, &req); err != nil {
+			WriteError(w, model.NewBadRequestError("invalid request body"))
+			return
+		}
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	assignment, err := review(r.Context(), userID, eventID, assignmentID, &req)
+	if err != nil {
+		h.handleEventRoleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, assignment, nil)
+}
+
 func (h *EventRoleHandler) handleEventRoleError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, service.ErrRoleNotFound):
@@ -274,6 +384,16 @@ func (h *EventRoleHandler) handleEventRoleError(w http.ResponseWriter, err error
 		WriteError(w, model.NewBadRequestError("maximum roles per user reached"))
 	case errors.Is(err, service.ErrCannotAssignOthers):
 		WriteError(w, model.NewForbiddenError("cannot assign roles to others"))
+	case errors.Is(err, service.ErrNotEventHost):
+		WriteError(w, model.NewForbiddenError("only event hosts can review nominations"))
+	case errors.Is(err, service.ErrInvalidAssignmentMode):
+		WriteError(w, model.NewBadRequestError("assignment mode must be direct or approval"))
+	case errors.Is(err, service.ErrRoleRequiresApproval):
+		WriteError(w, model.NewBadRequestError("role requires host approval; submit a nomination instead"))
+	case errors.Is(err, service.ErrRoleNotApprovalMode):
+		WriteError(w, model.NewBadRequestError("role does not take nominations; assign it directly"))
+	case errors.Is(err, service.ErrNominationNotPending):
+		WriteError(w, model.NewConflictError("nomination has already been reviewed"))
 	default:
 		WriteError(w, model.NewInternalError("event role operation failed"))
 	}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// HoldTicket handles POST /v1/events/{eventId}/tickets/hold - hold a ticket
+// to RSVP with
+func (h *EventHandler) HoldTicket(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	ticket, err := h.eventService.HoldTicket(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleEventError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, ticket, map[string]string{
+		"self": "/v1/events/" + eventID + "/tickets/mine",
+		"rsvp": "/v1/events/" + eventID + "/rsvp",
+	})
+}
+
+// ReleaseTicketHold handles DELETE /v1/events/{eventId}/tickets/hold -
+// give back an unclaimed hold
+func (h *EventHandler) ReleaseTicketHold(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	if err := h.eventService.ReleaseTicketHold(r.Context(), userID, eventID); err != nil {
+		h.handleEventError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetMyTicket handles GET /v1/events/{eventId}/tickets/mine - get own ticket
+func (h *EventHandler) GetMyTicket(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	ticket, err := h.eventService.GetMyTicket(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleEventError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, ticket, map[string]string{
+		"self":  "/v1/events/" + eventID + "/tickets/mine",
+		"event": "/v1/events/" + eventID,
+	})
+}
+
+// TransferTicket handles POST /v1/events/{eventId}/tickets/{ticketId}/transfer -
+// give own ticket to another guild member
+func (h *EventHandler) TransferTicket(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	ticketID := r.PathValue("ticketId")
+	if eventID == "" || ticketID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and ticket ID required"))
+		return
+	}
+
+	var req model.TransferTicketRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	ticket, err := h.eventService.TransferTicket(r.Context(), userID, eventID, ticketID, &req)
+	if err != nil {
+		h.handleEventError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, ticket, map[string]string{
+		"event": "/v1/events/" + eventID,
+	})
+}
+
+// CheckInTicket handles POST /v1/events/{eventId}/tickets/check-in - scan a
+// ticket at the door (host only)
+func (h *EventHandler) CheckInTicket(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.CheckInTicketRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	ticket, err := h.eventService.CheckInTicket(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleEventError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, ticket, map[string]string{
+		"event": "/v1/events/" + eventID,
+	})
+}
@@ -66,6 +66,11 @@ func (h *EventsHandler) Stream(w http.ResponseWriter, r *http.Request) {
 			flusher.Flush()
 
 		case <-sub.Done:
+			if sub.Disconnected() {
+				// Client fell too far behind; tell it to reconnect and resync
+				_, _ = fmt.Fprint(w, "event: disconnected\ndata: {\"reason\":\"slow_consumer\"}\n\n")
+				flusher.Flush()
+			}
 			return
 
 		case <-r.Context().Done():
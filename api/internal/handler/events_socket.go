@@ -0,0 +1,329 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+// WebSocket timings. The server pings well inside the pong wait so one lost
+// ping doesn't drop the connection, and often enough to keep proxies that
+// close idle connections after a minute from doing so.
+const (
+	socketPingInterval = 25 * time.Second
+	socketPongWait     = 60 * time.Second
+	socketWriteWait    = 10 * time.Second
+)
+
+// Socket limits
+const (
+	maxSocketGuilds       = 50   // Guild subscriptions per connection
+	maxSocketMessageBytes = 4096 // Client messages are small control messages
+)
+
+// Client message types
+const (
+	socketSubscribe   = "subscribe"
+	socketUnsubscribe = "unsubscribe"
+	socketPing        = "ping"
+)
+
+// socketRequest is a control message from the client
+type socketRequest struct {
+	Type    string `json:"type"` // subscribe, unsubscribe, ping
+	GuildID string `json:"guild_id,omitempty"`
+}
+
+// socketMessage is a message to the client. Hub events keep their SSE type
+// and data; guild events say which guild they came from.
+type socketMessage struct {
+	Type    string      `json:"type"`
+	GuildID string      `json:"guild_id,omitempty"`
+	ID      string      `json:"id,omitempty"` // Poll cursor of a user-directed event
+	Data    interface{} `json:"data,omitempty"`
+
+	final bool // Close the connection once written
+}
+
+// EventSocketHandler streams event hub events over WebSocket, for clients
+// behind proxies that buffer SSE
+type EventSocketHandler struct {
+	eventHub     *service.EventHub
+	guildService *service.GuildService
+	upgrader     websocket.Upgrader
+}
+
+// NewEventSocketHandler creates a new event socket handler. Browsers may
+// connect from allowedOrigins; clients that send no Origin, like mobile
+// apps, are always allowed.
+func NewEventSocketHandler(eventHub *service.EventHub, guildService *service.GuildService, allowedOrigins []string) *EventSocketHandler {
+	return &EventSocketHandler{
+		eventHub:     eventHub,
+		guildService: guildService,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 4096,
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				if origin == "" || slices.Contains(allowedOrigins, "*") || slices.Contains(allowedOrigins, origin) {
+					return true
+				}
+				u, err := url.Parse(origin)
+				return err == nil && strings.EqualFold(u.Host, r.Host)
+			},
+		},
+	}
+}
+
+// Stream handles GET /v1/events/ws - stream events over WebSocket
+// Query params: guild_id (repeatable; guilds to subscribe to on connect)
+// The connection always carries the user's own notifications. Clients add
+// and drop guilds with {"type":"subscribe","guild_id":"..."} and
+// {"type":"unsubscribe",...}, and can send {"type":"ping"} to get a pong
+// where they can't answer WebSocket pings themselves.
+func (h *EventSocketHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildIDs := r.URL.Query()["guild_id"]
+	if len(guildIDs) > maxSocketGuilds {
+		WriteError(w, model.NewBadRequestError("too many guild_id values"))
+		return
+	}
+
+	// The upgrader writes its own error response
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	s := &eventSocket{
+		hub:          h.eventHub,
+		guildService: h.guildService,
+		conn:         conn,
+		userID:       userID,
+		subscriberID: uuid.New().String(),
+		guilds:       map[string]*service.Subscriber{},
+		out:          make(chan socketMessage),
+		done:         make(chan struct{}),
+		stopped:      make(chan struct{}),
+	}
+	s.run(r.Context(), guildIDs)
+}
+
+// eventSocket is one client connection. The connection's only reader is
+// run, which also owns the guild subscriptions; its only writer is
+// writeLoop, which everything else sends to through out.
+type eventSocket struct {
+	hub          *service.EventHub
+	guildService *service.GuildService
+	conn         *websocket.Conn
+	userID       string
+	subscriberID string
+	guilds       map[string]*service.Subscriber // guildID -> subscription
+
+	out     chan socketMessage
+	done    chan struct{} // Closed once the client has gone away
+	stopped chan struct{} // Closed once writeLoop returns
+	wg      sync.WaitGroup
+}
+
+// run serves the connection until the client goes away
+func (s *eventSocket) run(ctx context.Context, guildIDs []string) {
+	go func() {
+		defer close(s.stopped)
+		s.writeLoop()
+	}()
+
+	defer func() {
+		close(s.done)
+		s.unsubscribeAll()
+		s.wg.Wait()
+		<-s.stopped
+		_ = s.conn.Close()
+	}()
+
+	user := s.hub.SubscribeUser(s.userID, s.subscriberID)
+	s.forward(user, "", func() { s.hub.UnsubscribeUser(s.userID, s.subscriberID) })
+
+	s.send(socketMessage{Type: "connected", Data: map[string]string{"subscriber_id": s.subscriberID}})
+	for _, guildID := range guildIDs {
+		s.subscribe(ctx, guildID)
+	}
+
+	s.conn.SetReadLimit(maxSocketMessageBytes)
+	_ = s.conn.SetReadDeadline(time.Now().Add(socketPongWait))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(socketPongWait))
+	})
+
+	for {
+		// Read errors are final: the client closed, stopped answering pings,
+		// or the writer closed the connection
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		// Any message shows the client is still there
+		_ = s.conn.SetReadDeadline(time.Now().Add(socketPongWait))
+
+		var req socketRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			s.send(socketMessage{Type: "error", Data: map[string]string{"message": "messages must be JSON objects"}})
+			continue
+		}
+
+		switch req.Type {
+		case socketSubscribe:
+			s.subscribe(ctx, req.GuildID)
+		case socketUnsubscribe:
+			s.unsubscribe(req.GuildID)
+		case socketPing:
+			s.send(socketMessage{Type: "pong"})
+		default:
+			s.send(socketMessage{Type: "error", Data: map[string]string{"message": "unknown message type"}})
+		}
+	}
+}
+
+// subscribe adds a guild's events to the connection, for its members
+func (s *eventSocket) subscribe(ctx context.Context, guildID string) {
+	fail := func(message string) {
+		s.send(socketMessage{Type: "error", GuildID: guildID, Data: map[string]string{"message": message}})
+	}
+	if guildID == "" {
+		fail("guild_id required")
+		return
+	}
+
+	if _, ok := s.guilds[guildID]; ok {
+		s.send(socketMessage{Type: "subscribed", GuildID: guildID})
+		return
+	}
+	if len(s.guilds) >= maxSocketGuilds {
+		fail("too many guild subscriptions")
+		return
+	}
+
+	isMember, err := s.guildService.IsMember(ctx, s.userID, guildID)
+	if err != nil {
+		fail("failed to check guild membership")
+		return
+	}
+	if !isMember {
+		fail("not a member of this guild")
+		return
+	}
+
+	sub := s.hub.Subscribe(guildID, s.subscriberID)
+	s.guilds[guildID] = sub
+	s.forward(sub, guildID, nil)
+	s.send(socketMessage{Type: "subscribed", GuildID: guildID})
+}
+
+// unsubscribe drops a guild's events from the connection
+func (s *eventSocket) unsubscribe(guildID string) {
+	if _, ok := s.guilds[guildID]; !ok {
+		return
+	}
+	delete(s.guilds, guildID)
+	s.hub.Unsubscribe(guildID, s.subscriberID)
+	s.send(socketMessage{Type: "unsubscribed", GuildID: guildID})
+}
+
+// unsubscribeAll drops every guild subscription
+func (s *eventSocket) unsubscribeAll() {
+	for guildID := range s.guilds {
+		s.hub.Unsubscribe(guildID, s.subscriberID)
+		delete(s.guilds, guildID)
+	}
+}
+
+// forward sends a subscription's events to the client until the hub closes
+// it or the connection ends, then runs release if given. A subscriber the
+// hub drops for falling behind closes the connection so the client
+// reconnects and resyncs, as the SSE stream does.
+func (s *eventSocket) forward(sub *service.Subscriber, guildID string, release func()) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if release != nil {
+			defer release()
+		}
+		for {
+			select {
+			case event, ok := <-sub.Events:
+				if !ok {
+					return
+				}
+				s.send(socketMessage{Type: string(event.Type), GuildID: guildID, ID: event.ID, Data: event.Data})
+			case <-sub.Done:
+				if sub.Disconnected() {
+					s.send(socketMessage{Type: "disconnected", Data: map[string]string{"reason": "slow_consumer"}, final: true})
+				}
+				return
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+// send queues a message for the writer, giving up once the connection ends
+func (s *eventSocket) send(msg socketMessage) {
+	select {
+	case s.out <- msg:
+	case <-s.done:
+	case <-s.stopped:
+	}
+}
+
+// writeLoop writes queued messages and pings the client. It closes the
+// connection after a final message or a failed write, which ends run's
+// read loop.
+func (s *eventSocket) writeLoop() {
+	ticker := time.NewTicker(socketPingInterval)
+	defer ticker.Stop()
+
+	closeWith := func(code int, text string) {
+		_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(socketWriteWait))
+		_ = s.conn.Close()
+	}
+
+	for {
+		select {
+		case msg := <-s.out:
+			_ = s.conn.SetWriteDeadline(time.Now().Add(socketWriteWait))
+			if err := s.conn.WriteJSON(msg); err != nil {
+				_ = s.conn.Close()
+				return
+			}
+			if msg.final {
+				closeWith(websocket.CloseTryAgainLater, "slow consumer")
+				return
+			}
+		case <-ticker.C:
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(socketWriteWait)); err != nil {
+				_ = s.conn.Close()
+				return
+			}
+		case <-s.done:
+			closeWith(websocket.CloseNormalClosure, "")
+			return
+		}
+	}
+}
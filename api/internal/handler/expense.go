@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// EventExpenseHandler handles cost splitting for events
+type EventExpenseHandler struct {
+	expenseService *service.EventExpenseService
+}
+
+// NewEventExpenseHandler creates a new event expense handler
+func NewEventExpenseHandler(expenseService *service.EventExpenseService) *EventExpenseHandler {
+	return &EventExpenseHandler{expenseService: expenseService}
+}
+
+// List handles GET /v1/events/{eventId}/expenses - list an event's expenses
+func (h *EventExpenseHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	expenses, err := h.expenseService.ListExpenses(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, expenses, nil, map[string]string{
+		"self":   "/v1/events/" + eventID + "/expenses",
+		"ledger": "/v1/events/" + eventID + "/expenses/ledger",
+		"event":  "/v1/events/" + eventID,
+	})
+}
+
+// Create handles POST /v1/events/{eventId}/expenses - record a cost (hosts
+// only)
+func (h *EventExpenseHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.CreateExpenseRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	expense, err := h.expenseService.AddExpense(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, expense, map[string]string{
+		"self":   "/v1/events/" + eventID + "/expenses/" + expense.ID,
+		"ledger": "/v1/events/" + eventID + "/expenses/ledger",
+	})
+}
+
+// Delete handles DELETE /v1/events/{eventId}/expenses/{expenseId} - remove
+// an expense (hosts only)
+func (h *EventExpenseHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	expenseID := r.PathValue("expenseId")
+	if eventID == "" || expenseID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and expense ID required"))
+		return
+	}
+
+	if err := h.expenseService.DeleteExpense(r.Context(), userID, eventID, expenseID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// Ledger handles GET /v1/events/{eventId}/expenses/ledger - everyone's
+// share and what is still owed
+func (h *EventExpenseHandler) Ledger(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	ledger, err := h.expenseService.Ledger(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, ledger, map[string]string{
+		"self":     "/v1/events/" + eventID + "/expenses/ledger",
+		"expenses": "/v1/events/" + eventID + "/expenses",
+	})
+}
+
+// Settle handles POST /v1/events/{eventId}/expenses/settlements - mark what
+// one participant owes another as paid
+func (h *EventExpenseHandler) Settle(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.SettleExpensesRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	settlement, err := h.expenseService.Settle(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, settlement, map[string]string{
+		"ledger": "/v1/events/" + eventID + "/expenses/ledger",
+	})
+}
+
+// handleError converts service errors to HTTP responses
+func (h *EventExpenseHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		WriteError(w, model.NewNotFoundError("event"))
+	case errors.Is(err, service.ErrExpenseNotFound):
+		WriteError(w, model.NewNotFoundError("expense"))
+	case errors.Is(err, service.ErrNotEventHost),
+		errors.Is(err, service.ErrNotExpenseParticipant),
+		errors.Is(err, service.ErrNotSettlementParty):
+		WriteError(w, model.NewForbiddenError(err.Error()))
+	case errors.Is(err, service.ErrPayerNotParticipant):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "paid_by", Message: err.Error()}}))
+	case errors.Is(err, service.ErrExpenseCurrencyMismatch):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "currency", Message: err.Error()}}))
+	case errors.Is(err, service.ErrNothingToSettle):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "state", Message: err.Error()}}))
+	case errors.Is(err, service.ErrTooManyExpenses):
+		WriteError(w, model.NewLimitExceededError("maximum expenses per event reached", model.MaxExpensesPerScope, model.MaxExpensesPerScope))
+	default:
+		WriteError(w, model.NewInternalError("expense operation failed"))
+	}
+}
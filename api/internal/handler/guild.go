@@ -85,6 +85,33 @@ func (h *GuildHandler) Get(w http.ResponseWriter, r *http.Request) {
 	WriteData(w, http.StatusOK, guildData, nil)
 }
 
+// GetBySlug handles GET /v1/guild-slugs/{slug} - get guild details by current
+// or previous slug
+func (h *GuildHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	slug := r.PathValue("slug")
+	if slug == "" {
+		WriteError(w, model.NewBadRequestError("slug required"))
+		return
+	}
+
+	guildData, err := h.svc.GetGuildBySlug(ctx, userID, slug)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, guildData, map[string]string{
+		"self": "/v1/guilds/" + guildData.Guild.ID,
+	})
+}
+
 // Update handles PATCH /v1/guilds/{guildId} - update a guild
 func (h *GuildHandler) Update(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -101,10 +128,12 @@ func (h *GuildHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req service.UpdateGuildRequest
-	if err := DecodeJSON(r, &req); err != nil {
-		WriteError(w, model.NewBadRequestError("invalid request body"))
+	cleared, problem := DecodePatch(r, &req, model.GuildPatchRules)
+	if problem != nil {
+		WriteError(w, problem)
 		return
 	}
+	req.Clear = cleared
 
 	guild, err := h.svc.UpdateGuild(ctx, userID, guildID, req)
 	if err != nil {
@@ -176,12 +205,13 @@ func (h *GuildHandler) Leave(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.svc.LeaveGuild(ctx, userID, guildID); err != nil {
+	op, err := h.svc.LeaveGuild(ctx, userID, guildID)
+	if err != nil {
 		h.handleError(w, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	writeUndoable(w, op)
 }
 
 // GetMembers handles GET /v1/guilds/{guildId}/members - list guild members
@@ -199,14 +229,22 @@ func (h *GuildHandler) GetMembers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// GetGuildWithMembers already checks membership for private guilds
-	guildData, err := h.svc.GetGuildWithMembers(ctx, userID, guildID)
+	page, problem := getPageRequest(r, model.ListQuery{})
+	if problem != nil {
+		WriteError(w, problem)
+		return
+	}
+
+	// ListMembers checks membership for private guilds
+	members, err := h.svc.ListMembers(ctx, userID, guildID, page)
 	if err != nil {
-		h.handleError(w, err)
+		if !writeCursorError(w, err) {
+			h.handleError(w, err)
+		}
 		return
 	}
 
-	WriteData(w, http.StatusOK, guildData.Members, nil)
+	writePage(w, r, members, "/v1/guilds/"+guildID+"/members")
 }
 
 // GetMemberRole handles GET /v1/guilds/{guildId}/members/{userId}/role - get member's role
@@ -294,6 +332,17 @@ func (h *GuildHandler) UpdateMemberRole(w http.ResponseWriter, r *http.Request)
 
 // handleError converts service errors to HTTP responses
 func (h *GuildHandler) handleError(w http.ResponseWriter, err error) {
+	var conflict *service.VersionConflictError
+	if errors.As(err, &conflict) {
+		WriteError(w, model.NewVersionConflictError(conflict.Current))
+		return
+	}
+	var duplicate *service.DuplicateError
+	if errors.As(err, &duplicate) {
+		WriteError(w, model.NewPossibleDuplicateError(duplicate.Candidates))
+		return
+	}
+
 	switch {
 	case errors.Is(err, service.ErrGuildNotFound):
 		WriteError(w, model.NewNotFoundError("guild not found"))
@@ -323,6 +372,12 @@ func (h *GuildHandler) handleError(w http.ResponseWriter, err error) {
 		WriteError(w, model.NewLimitExceededError("guild has reached maximum member limit", model.MaxMembersPerGuild, model.MaxMembersPerGuild))
 	case errors.Is(err, service.ErrGuildNameExists):
 		WriteError(w, model.NewConflictError("a guild with this name already exists"))
+	case errors.Is(err, service.ErrInvalidGuildSlug):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "slug", Message: "slug must be 3-50 lowercase letters and digits separated by single hyphens, and not reserved"},
+		}))
+	case errors.Is(err, service.ErrGuildSlugTaken):
+		WriteError(w, model.NewConflictError("guild slug is already taken"))
 	case errors.Is(err, service.ErrUserNotFound):
 		WriteError(w, model.NewNotFoundError("user not found"))
 	default:
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// guildEmbedCacheControl lets browsers and CDNs reuse embeds for a few
+// minutes, so busy organizer sites don't run into the rate limit
+const guildEmbedCacheControl = "public, max-age=300"
+
+// GuildEmbedHandler serves guild events to other sites. Every endpoint is
+// unauthenticated.
+type GuildEmbedHandler struct {
+	embedService *service.GuildEmbedService
+	publicURL    string
+}
+
+// NewGuildEmbedHandler creates a new guild embed handler. publicURL is where
+// browsers reach the API; the oEmbed snippet frames the widget from it.
+func NewGuildEmbedHandler(embedService *service.GuildEmbedService, publicURL string) *GuildEmbedHandler {
+	return &GuildEmbedHandler{
+		embedService: embedService,
+		publicURL:    strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+// Events handles GET /v1/public/guilds/{slug}/events?limit=N - the guild's
+// upcoming public events as JSON, for sites that render them themselves
+func (h *GuildEmbedHandler) Events(w http.ResponseWriter, r *http.Request) {
+	slug := r.PathValue("slug")
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	embed, err := h.embedService.GetUpcomingEvents(r.Context(), slug, limit)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", guildEmbedCacheControl)
+	WriteData(w, http.StatusOK, embed, map[string]string{
+		"self":   guildEmbedPath(embed.Guild.Slug),
+		"widget": guildEmbedPath(embed.Guild.Slug) + "/widget",
+	})
+}
+
+// Widget handles GET /v1/public/guilds/{slug}/events/widget?limit=N - a
+// self-contained HTML list of the guild's upcoming public events, made to
+// be framed by other sites
+func (h *GuildEmbedHandler) Widget(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	status := http.StatusOK
+	embed, err := h.embedService.GetUpcomingEvents(r.Context(), r.PathValue("slug"), limit)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrGuildNotFound):
+		status = http.StatusNotFound
+	default:
+		slog.Error("rendering guild events widget", "error", err)
+		status = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; frame-ancestors *")
+	if status == http.StatusOK {
+		w.Header().Set("Cache-Control", guildEmbedCacheControl)
+	}
+	w.WriteHeader(status)
+	if err := guildEventsWidgetTemplate.Execute(w, embed); err != nil {
+		slog.Error("writing guild events widget", "error", err)
+	}
+}
+
+// OEmbed handles GET /v1/public/guilds/{slug}/events/oembed - an oEmbed
+// rich response whose HTML frames the widget. maxwidth and maxheight cap
+// the suggested size.
+func (h *GuildEmbedHandler) OEmbed(w http.ResponseWriter, r *http.Request) {
+	if format := r.URL.Query().Get("format"); format != "" && format != "json" {
+		WriteError(w, model.NewBadRequestError("only the json format is supported"))
+		return
+	}
+
+	embed, err := h.embedService.GetUpcomingEvents(r.Context(), r.PathValue("slug"), 0)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	width := boundedDimension(r.URL.Query().Get("maxwidth"), model.GuildEmbedWidgetWidth)
+	height := boundedDimension(r.URL.Query().Get("maxheight"), model.GuildEmbedWidgetHeight)
+	title := "Upcoming events: " + embed.Guild.Name
+
+	var snippet bytes.Buffer
+	if err := guildEventsFrameTemplate.Execute(&snippet, map[string]interface{}{
+		"URL":    h.publicURL + guildEmbedPath(embed.Guild.Slug) + "/widget",
+		"Title":  title,
+		"Width":  width,
+		"Height": height,
+	}); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", guildEmbedCacheControl)
+	WriteJSON(w, http.StatusOK, model.OEmbed{
+		Type:         "rich",
+		Version:      "1.0",
+		Title:        title,
+		ProviderName: model.ShareSiteName,
+		HTML:         snippet.String(),
+		Width:        width,
+		Height:       height,
+		CacheAge:     300,
+	})
+}
+
+// boundedDimension returns the default size, or the consumer's maximum
+// when that is smaller
+func boundedDimension(limit string, size int) int {
+	if n, err := strconv.Atoi(limit); err == nil && n > 0 && n < size {
+		return n
+	}
+	return size
+}
+
+func guildEmbedPath(slug string) string {
+	return "/v1/public/guilds/" + url.PathEscape(slug) + "/events"
+}
+
+var guildEventsFrameTemplate = template.Must(template.New("frame").Parse(
+	`<iframe src="{{.URL}}" title="{{.Title}}" width="{{.Width}}" height="{{.Height}}" style="border:0" loading="lazy"></iframe>`))
+
+var guildEventsWidgetTemplate = template.Must(template.New("widget").Funcs(template.FuncMap{
+	"date": func(e model.EmbedEvent) string {
+		return e.StartTime.UTC().Format("Mon, Jan 2 · 15:04 UTC")
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>{{if .}}Upcoming events: {{.Guild.Name}}{{else}}Events unavailable{{end}}</title>
+<style>
+body{margin:0;padding:12px;font:14px/1.4 system-ui,sans-serif;color:#222;background:#fff}
+h1{font-size:16px;margin:0 0 8px}
+ul{list-style:none;margin:0;padding:0}
+li{padding:8px 0;border-top:1px solid #eee}
+.title{font-weight:600}
+.meta{color:#666;font-size:13px}
+</style>
+</head>
+<body>
+{{- if .}}
+<h1>{{.Guild.Name}}</h1>
+{{- if .Events}}
+<ul>
+{{- range .Events}}
+<li>
+<div class="title">{{.Title}}</div>
+<div class="meta"><time datetime="{{.StartTime.UTC.Format "2006-01-02T15:04:05Z07:00"}}">{{date .}}</time>
+{{- with .Location}} · {{if .IsVirtual}}Online{{else}}{{.Name}}{{with .City}}, {{.}}{{end}}{{end}}{{end}}</div>
+</li>
+{{- end}}
+</ul>
+{{- else}}
+<p class="meta">No upcoming events.</p>
+{{- end}}
+{{- else}}
+<p class="meta">These events aren't available.</p>
+{{- end}}
+</body>
+</html>
+`))
+
+func (h *GuildEmbedHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrGuildNotFound):
+		WriteError(w, model.NewNotFoundError("guild"))
+	default:
+		WriteError(w, model.NewInternalError("failed to get guild events"))
+	}
+}
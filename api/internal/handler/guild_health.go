@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// GuildHealthHandler handles guild health reports for organizers
+type GuildHealthHandler struct {
+	healthService *service.GuildHealthService
+}
+
+// NewGuildHealthHandler creates a new guild health handler
+func NewGuildHealthHandler(healthService *service.GuildHealthService) *GuildHealthHandler {
+	return &GuildHealthHandler{healthService: healthService}
+}
+
+// Get handles GET /v1/guilds/{guildId}/health - the guild's health score,
+// what it's made of, suggested actions and recent daily scores. Organizers
+// only; the route requires the manage_pools permission.
+func (h *GuildHealthHandler) Get(w http.ResponseWriter, r *http.Request) {
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	report, err := h.healthService.Report(r.Context(), guildID)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to get guild health"))
+		return
+	}
+
+	WriteData(w, http.StatusOK, report, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/health",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
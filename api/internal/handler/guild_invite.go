@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// GuildInviteHandler handles guild invite endpoints
+type GuildInviteHandler struct {
+	inviteService *service.GuildInviteService
+}
+
+// NewGuildInviteHandler creates a new guild invite handler
+func NewGuildInviteHandler(inviteService *service.GuildInviteService) *GuildInviteHandler {
+	return &GuildInviteHandler{inviteService: inviteService}
+}
+
+// Create handles POST /v1/guilds/{guildId}/invites - create an invite code
+// (guild admins only)
+func (h *GuildInviteHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	var req model.CreateGuildInviteRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	invite, err := h.inviteService.CreateInvite(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, invite, map[string]string{
+		"self":   "/v1/guilds/" + guildID + "/invites/" + invite.ID,
+		"accept": "/v1/invites/" + invite.Code + "/accept",
+		"guild":  "/v1/guilds/" + guildID,
+	})
+}
+
+// List handles GET /v1/guilds/{guildId}/invites - list a guild's invites
+// (guild admins only)
+func (h *GuildInviteHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	invites, err := h.inviteService.ListInvites(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, invites, nil, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/invites",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// Revoke handles DELETE /v1/guilds/{guildId}/invites/{inviteId} - revoke an
+// invite (guild admins only)
+func (h *GuildInviteHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	inviteID := r.PathValue("inviteId")
+	if guildID == "" || inviteID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and invite ID required"))
+		return
+	}
+
+	if err := h.inviteService.RevokeInvite(r.Context(), userID, guildID, inviteID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// Accept handles POST /v1/invites/{code}/accept - join a guild with an
+// invite code
+func (h *GuildInviteHandler) Accept(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	code := r.PathValue("code")
+	if code == "" {
+		WriteError(w, model.NewBadRequestError("invite code required"))
+		return
+	}
+
+	guild, err := h.inviteService.AcceptInvite(r.Context(), userID, code)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, guild, map[string]string{
+		"guild": "/v1/guilds/" + guild.ID,
+	})
+}
+
+// handleError converts service errors to HTTP responses
+func (h *GuildInviteHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrGuildNotFound):
+		WriteError(w, model.NewNotFoundError("guild"))
+	case errors.Is(err, service.ErrGuildInviteNotFound):
+		WriteError(w, model.NewNotFoundError("guild invite"))
+	case errors.Is(err, service.ErrGuildInviteExpired):
+		WriteError(w, model.NewGoneError("guild invite has expired"))
+	case errors.Is(err, service.ErrGuildInviteExhausted):
+		WriteError(w, model.NewGoneError("guild invite has no uses left"))
+	case errors.Is(err, service.ErrNotGuildAdmin):
+		WriteError(w, model.NewForbiddenError("not authorized to perform this action"))
+	case errors.Is(err, service.ErrNotInvitee):
+		WriteError(w, model.NewForbiddenError("this invite is for someone else"))
+	case errors.Is(err, service.ErrTooManyGuildInvites):
+		WriteError(w, model.NewLimitExceededError("active guild invites", model.MaxActiveGuildInvites, model.MaxActiveGuildInvites))
+	case errors.Is(err, service.ErrAlreadyGuildMember):
+		WriteError(w, model.NewConflictError("already a member of this guild"))
+	case errors.Is(err, service.ErrMaxGuildsReached):
+		WriteError(w, model.NewLimitExceededError("guilds", model.MaxGuildsPerUser, model.MaxGuildsPerUser))
+	case errors.Is(err, service.ErrMaxMembersReached):
+		WriteError(w, model.NewLimitExceededError("guild members", model.MaxMembersPerGuild, model.MaxMembersPerGuild))
+	default:
+		WriteError(w, model.NewInternalError("guild invite operation failed"))
+	}
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// GuildPermissionHandler handles guild custom roles and member permissions
+type GuildPermissionHandler struct {
+	permissionService *service.PermissionService
+}
+
+// NewGuildPermissionHandler creates a new guild permission handler
+func NewGuildPermissionHandler(permissionService *service.PermissionService) *GuildPermissionHandler {
+	return &GuildPermissionHandler{permissionService: permissionService}
+}
+
+// GetMemberPermissions handles GET
+// /v1/guilds/{guildId}/members/{userId}/permissions - what a member may do
+// and which roles grant it
+func (h *GuildPermissionHandler) GetMemberPermissions(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	memberUserID := r.PathValue("userId")
+	if guildID == "" || memberUserID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and user ID required"))
+		return
+	}
+
+	perms, err := h.permissionService.GetMemberPermissions(r.Context(), userID, guildID, memberUserID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, perms, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/members/" + memberUserID + "/permissions",
+		"roles": "/v1/guilds/" + guildID + "/roles",
+	})
+}
+
+// ListRoles handles GET /v1/guilds/{guildId}/roles - list a guild's custom
+// roles
+func (h *GuildPermissionHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	roles, err := h.permissionService.ListRoles(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, roles, nil, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/roles",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// CreateRole handles POST /v1/guilds/{guildId}/roles - define a custom role
+// (manage_roles)
+func (h *GuildPermissionHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	var req model.CreateGuildCustomRoleRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	role, err := h.permissionService.CreateRole(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, role, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/roles/" + role.ID,
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// UpdateRole handles PATCH /v1/guilds/{guildId}/roles/{roleId} - change a
+// custom role (manage_roles)
+func (h *GuildPermissionHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	roleID := r.PathValue("roleId")
+	if guildID == "" || roleID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and role ID required"))
+		return
+	}
+
+	var req model.UpdateGuildCustomRoleRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	role, err := h.permissionService.UpdateRole(r.Context(), userID, guildID, roleID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, role, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/roles/" + roleID,
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// DeleteRole handles DELETE /v1/guilds/{guildId}/roles/{roleId} - delete a
+// custom role and take it from its holders (manage_roles)
+func (h *GuildPermissionHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	roleID := r.PathValue("roleId")
+	if guildID == "" || roleID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and role ID required"))
+		return
+	}
+
+	if err := h.permissionService.DeleteRole(r.Context(), userID, guildID, roleID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// AssignRole handles PUT /v1/guilds/{guildId}/roles/{roleId}/members/{userId}
+// - give a member a custom role (manage_roles)
+func (h *GuildPermissionHandler) AssignRole(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	roleID := r.PathValue("roleId")
+	memberUserID := r.PathValue("userId")
+	if guildID == "" || roleID == "" || memberUserID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID, role ID and user ID required"))
+		return
+	}
+
+	perms, err := h.permissionService.AssignRole(r.Context(), userID, guildID, roleID, memberUserID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, perms, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/members/" + memberUserID + "/permissions",
+		"role": "/v1/guilds/" + guildID + "/roles/" + roleID,
+	})
+}
+
+// UnassignRole handles DELETE
+// /v1/guilds/{guildId}/roles/{roleId}/members/{userId} - take a custom role
+// from a member (manage_roles)
+func (h *GuildPermissionHandler) UnassignRole(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	roleID := r.PathValue("roleId")
+	memberUserID := r.PathValue("userId")
+	if guildID == "" || roleID == "" || memberUserID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID, role ID and user ID required"))
+		return
+	}
+
+	if err := h.permissionService.UnassignRole(r.Context(), userID, guildID, roleID, memberUserID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// handleError converts service errors to HTTP responses
+func (h *GuildPermissionHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrGuildRoleNotFound):
+		WriteError(w, model.NewNotFoundError("guild role"))
+	case errors.Is(err, service.ErrGuildRoleNotHeld):
+		WriteError(w, model.NewNotFoundError("role assignment"))
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a member of this guild"))
+	case errors.Is(err, service.ErrMissingPermission):
+		WriteError(w, model.NewForbiddenError(err.Error()))
+	case errors.Is(err, service.ErrGuildRoleExists):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrTooManyGuildRoles):
+		WriteError(w, model.NewLimitExceededError("guild roles", model.MaxCustomRolesPerGuild, model.MaxCustomRolesPerGuild))
+	case errors.Is(err, service.ErrTooManyMemberRoles):
+		WriteError(w, model.NewLimitExceededError("member roles", model.MaxCustomRolesPerMember, model.MaxCustomRolesPerMember))
+	case errors.Is(err, service.ErrGuildRoleHolderNotMember):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "user_id", Message: err.Error()}}))
+	default:
+		WriteError(w, model.NewInternalError("guild role operation failed"))
+	}
+}
@@ -0,0 +1,242 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// GuildQuestionHandler handles guild-scoped questionnaire endpoints
+type GuildQuestionHandler struct {
+	guildQuestionService *service.GuildQuestionService
+}
+
+// NewGuildQuestionHandler creates a new guild question handler
+func NewGuildQuestionHandler(guildQuestionService *service.GuildQuestionService) *GuildQuestionHandler {
+	return &GuildQuestionHandler{guildQuestionService: guildQuestionService}
+}
+
+// ListQuestions handles GET /v1/guilds/{guildId}/questions - list guild questions (members)
+// Organizers can include retired questions with ?include_inactive=true.
+func (h *GuildQuestionHandler) ListQuestions(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	includeInactive := r.URL.Query().Get("include_inactive") == "true"
+
+	questions, err := h.guildQuestionService.ListQuestions(r.Context(), userID, guildID, includeInactive)
+	if err != nil {
+		h.handleGuildQuestionError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, questions, nil, map[string]string{
+		"self":    "/v1/guilds/" + guildID + "/questions",
+		"answers": "/v1/guilds/" + guildID + "/questions/answers/me",
+	})
+}
+
+// CreateQuestion handles POST /v1/guilds/{guildId}/questions - add a guild question (organizers only)
+func (h *GuildQuestionHandler) CreateQuestion(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+
+	var req model.CreateGuildQuestionRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	question, err := h.guildQuestionService.CreateQuestion(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleGuildQuestionError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, question, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/questions/" + question.ID,
+	})
+}
+
+// UpdateQuestion handles PATCH /v1/guilds/{guildId}/questions/{questionId} - update a guild question (organizers only)
+func (h *GuildQuestionHandler) UpdateQuestion(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	questionID := r.PathValue("questionId")
+
+	var req model.UpdateGuildQuestionRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	question, err := h.guildQuestionService.UpdateQuestion(r.Context(), userID, guildID, questionID, &req)
+	if err != nil {
+		h.handleGuildQuestionError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, question, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/questions/" + questionID,
+	})
+}
+
+// DeleteQuestion handles DELETE /v1/guilds/{guildId}/questions/{questionId} - remove a guild question (organizers only)
+// Questions that members answered are retired instead of deleted.
+func (h *GuildQuestionHandler) DeleteQuestion(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	removal, err := h.guildQuestionService.RemoveQuestion(r.Context(), userID, r.PathValue("guildId"), r.PathValue("questionId"))
+	if err != nil {
+		h.handleGuildQuestionError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, removal, nil)
+}
+
+// AnswerQuestion handles POST /v1/guilds/{guildId}/questions/{questionId}/answer - answer a guild question
+func (h *GuildQuestionHandler) AnswerQuestion(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	questionID := r.PathValue("questionId")
+
+	var req model.AnswerGuildQuestionRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	answer, err := h.guildQuestionService.AnswerQuestion(r.Context(), userID, guildID, questionID, &req)
+	if err != nil {
+		h.handleGuildQuestionError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, answer, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/questions/" + questionID + "/answer",
+	})
+}
+
+// DeleteAnswer handles DELETE /v1/guilds/{guildId}/questions/{questionId}/answer - withdraw an answer
+func (h *GuildQuestionHandler) DeleteAnswer(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	if err := h.guildQuestionService.DeleteAnswer(r.Context(), userID, r.PathValue("guildId"), r.PathValue("questionId")); err != nil {
+		h.handleGuildQuestionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetMyAnswers handles GET /v1/guilds/{guildId}/questions/answers/me - get your own guild answers
+func (h *GuildQuestionHandler) GetMyAnswers(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+
+	answers, err := h.guildQuestionService.GetMyAnswers(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleGuildQuestionError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, answers, nil, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/questions/answers/me",
+	})
+}
+
+// GetAnswers handles GET /v1/guilds/{guildId}/questions/answers - get members' guild answers (organizers only)
+// Filter to one member with ?user_id=.
+func (h *GuildQuestionHandler) GetAnswers(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+
+	answers, err := h.guildQuestionService.GetAnswers(r.Context(), userID, guildID, r.URL.Query().Get("user_id"))
+	if err != nil {
+		h.handleGuildQuestionError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, answers, nil, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/questions/answers",
+	})
+}
+
+func (h *GuildQuestionHandler) handleGuildQuestionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a member of this guild"))
+	case errors.Is(err, service.ErrNotGuildAdmin):
+		WriteError(w, model.NewForbiddenError("guild organizer access required"))
+	case errors.Is(err, service.ErrQuestionNotFound):
+		WriteError(w, model.NewNotFoundError("question"))
+	case errors.Is(err, service.ErrAnswerNotFound):
+		WriteError(w, model.NewNotFoundError("answer"))
+	case errors.Is(err, service.ErrInvalidOption):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "selected_option", Message: "invalid option for this question"},
+		}))
+	case errors.Is(err, service.ErrQuestionOptionInUse):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrGuildQuestionLimit):
+		WriteError(w, model.NewConflictError(err.Error()))
+	default:
+		WriteError(w, model.NewInternalError("guild question operation failed"))
+	}
+}
@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// GuildTierHandler handles guild membership tiers and their assignment
+type GuildTierHandler struct {
+	tierService *service.GuildTierService
+}
+
+// NewGuildTierHandler creates a new guild tier handler
+func NewGuildTierHandler(tierService *service.GuildTierService) *GuildTierHandler {
+	return &GuildTierHandler{tierService: tierService}
+}
+
+// ListTiers handles GET /v1/guilds/{guildId}/tiers - list a guild's tiers,
+// highest ranked first
+func (h *GuildTierHandler) ListTiers(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	tiers, err := h.tierService.ListTiers(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, tiers, nil, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/tiers",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// CreateTier handles POST /v1/guilds/{guildId}/tiers - add a tier (admin
+// only)
+func (h *GuildTierHandler) CreateTier(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	var req model.CreateGuildTierRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	tier, err := h.tierService.CreateTier(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, tier, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/tiers/" + tier.ID,
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// UpdateTier handles PATCH /v1/guilds/{guildId}/tiers/{tierId} - change a
+// tier (admin only)
+func (h *GuildTierHandler) UpdateTier(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	tierID := r.PathValue("tierId")
+	if guildID == "" || tierID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and tier ID required"))
+		return
+	}
+
+	var req model.UpdateGuildTierRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	tier, err := h.tierService.UpdateTier(r.Context(), userID, guildID, tierID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, tier, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/tiers/" + tierID,
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// DeleteTier handles DELETE /v1/guilds/{guildId}/tiers/{tierId} - delete a
+// tier no upcoming event or pool is gated on (admin only)
+func (h *GuildTierHandler) DeleteTier(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	tierID := r.PathValue("tierId")
+	if guildID == "" || tierID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and tier ID required"))
+		return
+	}
+
+	if err := h.tierService.DeleteTier(r.Context(), userID, guildID, tierID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// ListTierMembers handles GET /v1/guilds/{guildId}/tiers/{tierId}/members -
+// list who holds a tier (admin only)
+func (h *GuildTierHandler) ListTierMembers(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	tierID := r.PathValue("tierId")
+	if guildID == "" || tierID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and tier ID required"))
+		return
+	}
+
+	assignments, err := h.tierService.ListTierMembers(r.Context(), userID, guildID, tierID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, assignments, nil, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/tiers/" + tierID + "/members",
+		"tier": "/v1/guilds/" + guildID + "/tiers/" + tierID,
+	})
+}
+
+// GetMemberTier handles GET /v1/guilds/{guildId}/members/{userId}/tier -
+// get the tier a member holds
+func (h *GuildTierHandler) GetMemberTier(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	memberUserID := r.PathValue("userId")
+	if guildID == "" || memberUserID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and user ID required"))
+		return
+	}
+
+	assignment, err := h.tierService.GetMemberTier(r.Context(), userID, guildID, memberUserID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, assignment, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/members/" + memberUserID + "/tier",
+		"tier": "/v1/guilds/" + guildID + "/tiers/" + assignment.TierID,
+	})
+}
+
+// AssignTier handles PUT /v1/guilds/{guildId}/members/{userId}/tier - give
+// a member a tier, replacing the one they hold (admin only)
+func (h *GuildTierHandler) AssignTier(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	memberUserID := r.PathValue("userId")
+	if guildID == "" || memberUserID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and user ID required"))
+		return
+	}
+
+	var req model.AssignGuildTierRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	assignment, err := h.tierService.AssignTier(r.Context(), userID, guildID, memberUserID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, assignment, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/members/" + memberUserID + "/tier",
+		"tier": "/v1/guilds/" + guildID + "/tiers/" + assignment.TierID,
+	})
+}
+
+// RemoveMemberTier handles DELETE /v1/guilds/{guildId}/members/{userId}/tier
+// - take a member's tier away (admin only)
+func (h *GuildTierHandler) RemoveMemberTier(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	memberUserID := r.PathValue("userId")
+	if guildID == "" || memberUserID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and user ID required"))
+		return
+	}
+
+	if err := h.tierService.RemoveMemberTier(r.Context(), userID, guildID, memberUserID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// handleError converts service errors to HTTP responses
+func (h *GuildTierHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrTierNotFound):
+		WriteError(w, model.NewNotFoundError("guild tier"))
+	case errors.Is(err, service.ErrTierAssignmentNotFound):
+		WriteError(w, model.NewNotFoundError("tier assignment"))
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a guild member"))
+	case errors.Is(err, service.ErrNotGuildAdmin):
+		WriteError(w, model.NewForbiddenError("only guild admins can manage tiers"))
+	case errors.Is(err, service.ErrTierExists),
+		errors.Is(err, service.ErrTierInUse):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrTooManyTiers):
+		WriteError(w, model.NewLimitExceededError("maximum tiers per guild reached", model.MaxTiersPerGuild, model.MaxTiersPerGuild))
+	case errors.Is(err, service.ErrTierHolderNotMember):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "user_id", Message: err.Error()}}))
+	default:
+		WriteError(w, model.NewInternalError("guild tier operation failed"))
+	}
+}
@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// HangoutTypeHandler handles the hangout type catalog: platform types for
+// admins and custom types for guilds
+type HangoutTypeHandler struct {
+	hangoutTypeService *service.HangoutTypeService
+}
+
+// NewHangoutTypeHandler creates a new hangout type handler
+func NewHangoutTypeHandler(hangoutTypeService *service.HangoutTypeService) *HangoutTypeHandler {
+	return &HangoutTypeHandler{hangoutTypeService: hangoutTypeService}
+}
+
+// ===== Platform Types =====
+
+// AdminList handles GET /v1/admin/hangout-types - list platform types,
+// including inactive ones
+func (h *HangoutTypeHandler) AdminList(w http.ResponseWriter, r *http.Request) {
+	types, err := h.hangoutTypeService.ListPlatformTypes(r.Context())
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, types, nil, map[string]string{
+		"self": "/v1/admin/hangout-types",
+	})
+}
+
+// AdminCreate handles POST /v1/admin/hangout-types - add a platform type
+func (h *HangoutTypeHandler) AdminCreate(w http.ResponseWriter, r *http.Request) {
+	var req model.CreateHangoutTypeRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	info, err := h.hangoutTypeService.CreatePlatformType(r.Context(), &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, info, map[string]string{
+		"self": "/v1/admin/hangout-types/" + string(info.Type),
+	})
+}
+
+// AdminUpdate handles PATCH /v1/admin/hangout-types/{type} - change a
+// platform type
+func (h *HangoutTypeHandler) AdminUpdate(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("type")
+
+	var req model.UpdateHangoutTypeRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	info, err := h.hangoutTypeService.UpdatePlatformType(r.Context(), key, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, info, map[string]string{
+		"self": "/v1/admin/hangout-types/" + key,
+	})
+}
+
+// AdminDelete handles DELETE /v1/admin/hangout-types/{type} - remove a
+// platform type. Built-in types can only be relabeled.
+func (h *HangoutTypeHandler) AdminDelete(w http.ResponseWriter, r *http.Request) {
+	if err := h.hangoutTypeService.DeletePlatformType(r.Context(), r.PathValue("type")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// ===== Guild Types =====
+
+// ListGuildTypes handles GET /v1/guilds/{guildId}/hangout-types - list a
+// guild's custom types
+func (h *HangoutTypeHandler) ListGuildTypes(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	types, err := h.hangoutTypeService.ListGuildTypes(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, types, nil, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/hangout-types",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// CreateGuildType handles POST /v1/guilds/{guildId}/hangout-types - add a
+// custom type (admin only)
+func (h *HangoutTypeHandler) CreateGuildType(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	var req model.CreateHangoutTypeRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	info, err := h.hangoutTypeService.CreateGuildType(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, info, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/hangout-types/" + string(info.Type),
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// UpdateGuildType handles PATCH /v1/guilds/{guildId}/hangout-types/{type} -
+// change a custom type (admin only)
+func (h *HangoutTypeHandler) UpdateGuildType(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	key := r.PathValue("type")
+	if guildID == "" || key == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and hangout type required"))
+		return
+	}
+
+	var req model.UpdateHangoutTypeRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	info, err := h.hangoutTypeService.UpdateGuildType(r.Context(), userID, guildID, key, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, info, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/hangout-types/" + key,
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// DeleteGuildType handles DELETE /v1/guilds/{guildId}/hangout-types/{type} -
+// remove a custom type (admin only)
+func (h *HangoutTypeHandler) DeleteGuildType(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	key := r.PathValue("type")
+	if guildID == "" || key == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and hangout type required"))
+		return
+	}
+
+	if err := h.hangoutTypeService.DeleteGuildType(r.Context(), userID, guildID, key); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// handleError converts service errors to HTTP responses
+func (h *HangoutTypeHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrHangoutTypeNotFound):
+		WriteError(w, model.NewNotFoundError("hangout type"))
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a guild member"))
+	case errors.Is(err, service.ErrNotGuildAdmin):
+		WriteError(w, model.NewForbiddenError("only guild admins can manage hangout types"))
+	case errors.Is(err, service.ErrHangoutTypeExists):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrBuiltInHangoutType):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "type", Message: err.Error()}}))
+	case errors.Is(err, service.ErrTooManyHangoutTypes):
+		WriteError(w, model.NewLimitExceededError("maximum hangout types per guild reached", model.MaxGuildHangoutTypes, model.MaxGuildHangoutTypes))
+	default:
+		WriteError(w, model.NewInternalError("hangout type operation failed"))
+	}
+}
@@ -4,23 +4,45 @@ import (
 	"encoding/json"
 	"net/http"
 	"time"
+
+	"github.com/forgo/saga/api/internal/database"
 )
 
 // HealthResponse represents the health check response
 type HealthResponse struct {
-	Status    string `json:"status"`
-	Timestamp string `json:"timestamp"`
-	Version   string `json:"version"`
+	Status    string                 `json:"status"`
+	Timestamp string                 `json:"timestamp"`
+	Version   string                 `json:"version"`
+	Region    *database.RegionStatus `json:"region,omitempty"`
+}
+
+// HealthHandler handles health check endpoints
+type HealthHandler struct {
+	region *database.RegionState
 }
 
-// Health returns the health status of the API
-func Health(w http.ResponseWriter, r *http.Request) {
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(region *database.RegionState) *HealthHandler {
+	return &HealthHandler{region: region}
+}
+
+// Health returns the health status of the API, including the region role.
+// A standby whose replica lag exceeds the configured maximum reports "degraded".
+func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
 	response := HealthResponse{
 		Status:    "healthy",
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
 		Version:   "1.0.0",
 	}
 
+	if h.region != nil {
+		status := h.region.Status()
+		response.Region = &status
+		if status.LagExceeded {
+			response.Status = "degraded"
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(response)
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// MetaHandler tells clients about the API: which app versions it supports,
+// which features are on, the tunables admins have set and the experiments
+// a user is in
+type MetaHandler struct {
+	clientConfig        *model.ClientConfig
+	remoteConfigService *service.RemoteConfigService
+	experimentService   *service.ExperimentService
+}
+
+// NewMetaHandler creates a new meta handler
+func NewMetaHandler(clientConfig *model.ClientConfig, remoteConfigService *service.RemoteConfigService, experimentService *service.ExperimentService) *MetaHandler {
+	return &MetaHandler{
+		clientConfig:        clientConfig,
+		remoteConfigService: remoteConfigService,
+		experimentService:   experimentService,
+	}
+}
+
+// ClientConfig handles GET /v1/meta/client-config - the minimum and current
+// app version for each platform, and the feature flags turned on. Apps
+// below the minimum can still read it to show an update prompt.
+func (h *MetaHandler) ClientConfig(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	WriteData(w, http.StatusOK, h.clientConfig, map[string]string{
+		"self": "/v1/meta/client-config",
+	})
+}
+
+// Config handles GET /v1/meta/config?platform= - client tunables such as the
+// default discovery radius and nudge copy, with the overrides for the
+// platform from the query or X-Client-Platform. Clients revalidate with
+// If-None-Match on every launch; unchanged tunables answer 304.
+func (h *MetaHandler) Config(w http.ResponseWriter, r *http.Request) {
+	platform, ok := clientPlatform(w, r)
+	if !ok {
+		return
+	}
+
+	config, etag, err := h.remoteConfigService.Config(r.Context(), platform)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to load remote config"))
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Add("Vary", middleware.ClientPlatformHeader)
+	if match := r.Header.Get("If-None-Match"); match != "" && match == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	WriteData(w, http.StatusOK, config, map[string]string{
+		"self": "/v1/meta/config",
+	})
+}
+
+// Experiments handles GET /v1/meta/experiments?platform= - the signed-in
+// user's variant of each running experiment on the platform, with the
+// remote config params the variant overrides. Handing out an assignment
+// counts as an exposure.
+func (h *MetaHandler) Experiments(w http.ResponseWriter, r *http.Request) {
+	platform, ok := clientPlatform(w, r)
+	if !ok {
+		return
+	}
+
+	assignments, err := h.experimentService.Assignments(r.Context(), middleware.GetUserID(r.Context()), platform)
+	if err != nil {
+		WriteError(w, model.NewInternalError("failed to load experiments"))
+		return
+	}
+
+	w.Header().Set("Cache-Control", "private, no-cache")
+	WriteCollection(w, http.StatusOK, assignments, nil, map[string]string{
+		"self":   "/v1/meta/experiments",
+		"config": "/v1/meta/config",
+	})
+}
+
+// clientPlatform reads the client's platform from the platform query
+// parameter or X-Client-Platform, writing a validation error for an unknown
+// platform in the query. It is empty when the client didn't say.
+func clientPlatform(w http.ResponseWriter, r *http.Request) (model.DevicePlatform, bool) {
+	platform := model.DevicePlatform(r.URL.Query().Get("platform"))
+	if platform != "" && !platform.IsValid() {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "platform", Message: "platform must be ios, android, or web"},
+		}))
+		return "", false
+	}
+	if platform == "" {
+		if header := model.DevicePlatform(strings.ToLower(r.Header.Get(middleware.ClientPlatformHeader))); header.IsValid() {
+			platform = header
+		}
+	}
+	return platform, true
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// MetricsCollector writes metrics in Prometheus text exposition format
+type MetricsCollector interface {
+	WriteMetrics(w io.Writer)
+}
+
+// MetricsHandler serves GET /metrics for Prometheus scraping
+type MetricsHandler struct {
+	token      string
+	collectors []MetricsCollector
+}
+
+// NewMetricsHandler creates a new metrics handler. If token is non-empty,
+// scrapers must send it as a bearer token.
+func NewMetricsHandler(token string, collectors ...MetricsCollector) *MetricsHandler {
+	return &MetricsHandler{token: token, collectors: collectors}
+}
+
+// Metrics handles GET /metrics
+func (h *MetricsHandler) Metrics(w http.ResponseWriter, r *http.Request) {
+	if h.token != "" {
+		expected := "Bearer " + h.token
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(expected)) != 1 {
+			WriteError(w, model.NewUnauthorizedError("metrics token required"))
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	for _, collector := range h.collectors {
+		collector.WriteMetrics(w)
+	}
+}
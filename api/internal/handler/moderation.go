@@ -8,6 +8,7 @@ import (
 
 	"github.com/forgo/saga/api/internal/middleware"
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/router"
 	"github.com/forgo/saga/api/internal/service"
 )
 
@@ -60,27 +61,27 @@ func (h *ModerationHandler) requireAdmin(ctx context.Context, userID string) (*m
 	return user, nil
 }
 
-// RegisterRoutes registers moderation routes
-func (h *ModerationHandler) RegisterRoutes(mux *http.ServeMux) {
+// RegisterRoutes registers moderation routes on an API version
+func (h *ModerationHandler) RegisterRoutes(routes router.Routes) {
 	// Reports
-	mux.HandleFunc("POST /v1/reports", h.CreateReport)
-	mux.HandleFunc("GET /v1/reports/{reportId}", h.GetReport)
-	mux.HandleFunc("GET /v1/reports/pending", h.GetPendingReports)
-	mux.HandleFunc("PATCH /v1/reports/{reportId}/review", h.ReviewReport)
+	routes.HandleFunc("POST /reports", h.CreateReport)
+	routes.HandleFunc("GET /reports/{reportId}", h.GetReport)
+	routes.HandleFunc("GET /reports/pending", h.GetPendingReports)
+	routes.HandleFunc("PATCH /reports/{reportId}/review", h.ReviewReport)
 
 	// Moderation actions (admin)
-	mux.HandleFunc("POST /v1/moderation/actions", h.TakeAction)
-	mux.HandleFunc("GET /v1/moderation/actions/{actionId}", h.GetAction)
-	mux.HandleFunc("POST /v1/moderation/actions/{actionId}/lift", h.LiftAction)
-	mux.HandleFunc("GET /v1/moderation/users/{userId}/status", h.GetUserStatus)
-	mux.HandleFunc("GET /v1/moderation/users/{userId}/actions", h.GetUserActions)
-	mux.HandleFunc("GET /v1/moderation/stats", h.GetStats)
+	routes.HandleFunc("POST /moderation/actions", h.TakeAction)
+	routes.HandleFunc("GET /moderation/actions/{actionId}", h.GetAction)
+	routes.HandleFunc("POST /moderation/actions/{actionId}/lift", h.LiftAction)
+	routes.HandleFunc("GET /moderation/users/{userId}/status", h.GetUserStatus)
+	routes.HandleFunc("GET /moderation/users/{userId}/actions", h.GetUserActions)
+	routes.HandleFunc("GET /moderation/stats", h.GetStats)
 
 	// Blocks (user-facing)
-	mux.HandleFunc("POST /v1/blocks", h.BlockUser)
-	mux.HandleFunc("GET /v1/blocks", h.GetBlockedUsers)
-	mux.HandleFunc("DELETE /v1/blocks/{blockedUserId}", h.UnblockUser)
-	mux.HandleFunc("GET /v1/blocks/{userId}/check", h.CheckBlock)
+	routes.HandleFunc("POST /blocks", h.BlockUser)
+	routes.HandleFunc("GET /blocks", h.GetBlockedUsers)
+	routes.HandleFunc("DELETE /blocks/{blockedUserId}", h.UnblockUser)
+	routes.HandleFunc("GET /blocks/{userId}/check", h.CheckBlock)
 }
 
 // Report handlers
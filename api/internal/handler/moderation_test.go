@@ -12,6 +12,7 @@ import (
 	"github.com/forgo/saga/api/internal/middleware"
 	"github.com/forgo/saga/api/internal/model"
 	"github.com/forgo/saga/api/internal/service"
+	"github.com/forgo/saga/api/internal/testing/golden"
 )
 
 // ============================================================================
@@ -259,6 +260,7 @@ func TestCreateReport_AsUser_Success(t *testing.T) {
 	if rr.Code != http.StatusCreated {
 		t.Errorf("expected status %d, got %d", http.StatusCreated, rr.Code)
 	}
+	golden.Response(t, rr)
 }
 
 func TestCreateReport_CannotReportSelf(t *testing.T) {
@@ -406,6 +408,7 @@ func TestReviewReport_AsModerator_Success(t *testing.T) {
 	if rr.Code != http.StatusOK {
 		t.Errorf("expected status %d, got %d", http.StatusOK, rr.Code)
 	}
+	golden.Response(t, rr)
 }
 
 // ============================================================================
@@ -524,6 +527,7 @@ func TestTakeAction_BanAsAdmin_Success(t *testing.T) {
 	if rr.Code != http.StatusCreated {
 		t.Errorf("expected status %d, got %d", http.StatusCreated, rr.Code)
 	}
+	golden.Response(t, rr)
 }
 
 func TestTakeAction_WarningAsModerator_Success(t *testing.T) {
@@ -595,6 +599,7 @@ func TestTakeAction_WarningAsModerator_Success(t *testing.T) {
 	if rr.Code != http.StatusCreated {
 		t.Errorf("expected status %d, got %d", http.StatusCreated, rr.Code)
 	}
+	golden.Response(t, rr)
 }
 
 func TestTakeAction_SuspensionAsModerator_ReturnsForbidden(t *testing.T) {
@@ -774,6 +779,7 @@ func TestGetStats_AsAdmin_Success(t *testing.T) {
 	if rr.Code != http.StatusOK {
 		t.Errorf("expected status %d, got %d", http.StatusOK, rr.Code)
 	}
+	golden.Response(t, rr)
 }
 
 // ============================================================================
@@ -827,6 +833,7 @@ func TestBlockUser_Success(t *testing.T) {
 	if rr.Code != http.StatusCreated {
 		t.Errorf("expected status %d, got %d", http.StatusCreated, rr.Code)
 	}
+	golden.Response(t, rr)
 }
 
 func TestBlockUser_CannotBlockSelf(t *testing.T) {
@@ -911,6 +918,7 @@ func TestBlockUser_AlreadyBlocked(t *testing.T) {
 	if rr.Code != http.StatusConflict {
 		t.Errorf("expected status %d, got %d", http.StatusConflict, rr.Code)
 	}
+	golden.Response(t, rr)
 }
 
 // ============================================================================
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// MuteHandler handles the user's notification mutes
+type MuteHandler struct {
+	muteService *service.MuteService
+}
+
+// NewMuteHandler creates a new mute handler
+func NewMuteHandler(muteService *service.MuteService) *MuteHandler {
+	return &MuteHandler{muteService: muteService}
+}
+
+// ListMutes handles GET /v1/profile/mutes - list the guilds and event
+// categories the user has muted
+func (h *MuteHandler) ListMutes(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	mutes, err := h.muteService.ListMutes(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, mutes, nil, map[string]string{
+		"self": "/v1/profile/mutes",
+	})
+}
+
+// CreateMute handles POST /v1/profile/mutes - mute a guild or an event
+// category
+func (h *MuteHandler) CreateMute(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.CreateMuteRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	mute, err := h.muteService.CreateMute(r.Context(), userID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, mute, map[string]string{
+		"self":  "/v1/profile/mutes/" + mute.ID,
+		"mutes": "/v1/profile/mutes",
+	})
+}
+
+// DeleteMute handles DELETE /v1/profile/mutes/{muteId} - unmute
+func (h *MuteHandler) DeleteMute(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	muteID := r.PathValue("muteId")
+	if muteID == "" {
+		WriteError(w, model.NewBadRequestError("mute ID required"))
+		return
+	}
+
+	if err := h.muteService.DeleteMute(r.Context(), userID, muteID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// handleError converts service errors to HTTP responses
+func (h *MuteHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrMuteNotFound):
+		WriteError(w, model.NewNotFoundError("mute"))
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a guild member"))
+	case errors.Is(err, service.ErrTooManyMutes):
+		WriteError(w, model.NewLimitExceededError("mutes", model.MaxMutesPerUser, model.MaxMutesPerUser))
+	default:
+		WriteError(w, model.NewInternalError("mute operation failed"))
+	}
+}
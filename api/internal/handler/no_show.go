@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// NoShowHandler handles no-show tracking endpoints
+type NoShowHandler struct {
+	noShowService *service.NoShowService
+}
+
+// NewNoShowHandler creates a new no-show handler
+func NewNoShowHandler(noShowService *service.NoShowService) *NoShowHandler {
+	return &NoShowHandler{noShowService: noShowService}
+}
+
+// GetAttendeeStats handles GET /v1/events/{eventId}/rsvps/{userId}/no-shows - get an RSVP'er's no-show stats (host only)
+func (h *NoShowHandler) GetAttendeeStats(w http.ResponseWriter, r *http.Request) {
+	hostUserID := middleware.GetUserID(r.Context())
+	if hostUserID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	userID := r.PathValue("userId")
+	if eventID == "" || userID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and user ID required"))
+		return
+	}
+
+	stats, err := h.noShowService.GetStatsForHost(r.Context(), hostUserID, eventID, userID)
+	if err != nil {
+		h.handleNoShowError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, stats, map[string]string{
+		"self": "/v1/events/" + eventID + "/rsvps/" + userID + "/no-shows",
+	})
+}
+
+// MarkNoShow handles POST /v1/events/{eventId}/no-shows/{userId} - mark an attendee as a no-show (host only)
+func (h *NoShowHandler) MarkNoShow(w http.ResponseWriter, r *http.Request) {
+	hostUserID := middleware.GetUserID(r.Context())
+	if hostUserID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	userID := r.PathValue("userId")
+	if eventID == "" || userID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and user ID required"))
+		return
+	}
+
+	noShow, err := h.noShowService.MarkNoShow(r.Context(), hostUserID, eventID, userID)
+	if err != nil {
+		h.handleNoShowError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, noShow, nil)
+}
+
+// RemoveNoShow handles DELETE /v1/events/{eventId}/no-shows/{userId} - remove an erroneous no-show mark (host or guild admin)
+func (h *NoShowHandler) RemoveNoShow(w http.ResponseWriter, r *http.Request) {
+	actorUserID := middleware.GetUserID(r.Context())
+	if actorUserID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	userID := r.PathValue("userId")
+	if eventID == "" || userID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and user ID required"))
+		return
+	}
+
+	if err := h.noShowService.RemoveNoShow(r.Context(), actorUserID, eventID, userID); err != nil {
+		h.handleNoShowError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// GetMyNoShows handles GET /v1/profile/no-shows - get own no-show stats and records
+func (h *NoShowHandler) GetMyNoShows(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	stats, err := h.noShowService.GetUserStats(r.Context(), userID, model.DefaultNoShowLookbackDays)
+	if err != nil {
+		h.handleNoShowError(w, err)
+		return
+	}
+
+	records, err := h.noShowService.GetUserNoShows(r.Context(), userID)
+	if err != nil {
+		h.handleNoShowError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, map[string]interface{}{
+		"stats":    stats,
+		"no_shows": records,
+	}, map[string]string{
+		"self": "/v1/profile/no-shows",
+	})
+}
+
+// GetGuildPolicy handles GET /v1/guilds/{guildId}/no-show-policy - get the guild's no-show policy (members only)
+func (h *NoShowHandler) GetGuildPolicy(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	policy, err := h.noShowService.GetPolicyForMember(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleNoShowError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, policy, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/no-show-policy",
+	})
+}
+
+// UpdateGuildPolicy handles PATCH /v1/guilds/{guildId}/no-show-policy - update the guild's no-show policy (guild admin only)
+func (h *NoShowHandler) UpdateGuildPolicy(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	var req model.UpdateGuildNoShowPolicyRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	policy, err := h.noShowService.UpdatePolicy(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleNoShowError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, policy, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/no-show-policy",
+	})
+}
+
+func (h *NoShowHandler) handleNoShowError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		WriteError(w, model.NewNotFoundError("event"))
+	case errors.Is(err, service.ErrRSVPNotFound):
+		WriteError(w, model.NewNotFoundError("RSVP"))
+	case errors.Is(err, service.ErrNoShowNotFound):
+		WriteError(w, model.NewNotFoundError("no-show"))
+	case errors.Is(err, service.ErrNotEventHost):
+		WriteError(w, model.NewForbiddenError("not an event host"))
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a member of this guild"))
+	case errors.Is(err, service.ErrNotGuildAdmin):
+		WriteError(w, model.NewForbiddenError("guild admin access required"))
+	case errors.Is(err, service.ErrEventNotEnded):
+		WriteError(w, model.NewConflictError("event has not ended"))
+	default:
+		WriteError(w, model.NewInternalError("no-show operation failed"))
+	}
+}
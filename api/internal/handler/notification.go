@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// pollWriteSlack is how long past the poll timeout a poll's response may
+// take to write
+const pollWriteSlack = 10 * time.Second
+
+// NotificationHandler handles notification long polling
+type NotificationHandler struct {
+	feed *service.NotificationFeed
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(feed *service.NotificationFeed) *NotificationHandler {
+	return &NotificationHandler{feed: feed}
+}
+
+// Poll handles GET /v1/notifications/poll - wait for notifications
+// Query params: since (the cursor from the previous poll)
+// A fallback for clients that can't keep the SSE stream open. It returns
+// as soon as there are notifications after since, or empty once the poll
+// times out.
+func (h *NotificationHandler) Poll(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	// The server's write timeout is shorter than a poll
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(h.feed.PollTimeout() + pollWriteSlack))
+
+	poll, err := h.feed.Poll(r.Context(), userID, r.URL.Query().Get("since"))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidNotificationCursor):
+			WriteError(w, model.NewBadRequestError(err.Error()))
+		case errors.Is(err, service.ErrTooManyNotificationPolls):
+			w.Header().Set("Retry-After", strconv.Itoa(int(h.feed.PollTimeout().Seconds())))
+			WriteError(w, model.NewTooManyRequestsError("too many notification polls are open; close one or wait for it to return"))
+		case r.Context().Err() != nil:
+			// Client went away
+		default:
+			WriteError(w, model.NewInternalError("failed to poll notifications"))
+		}
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	WriteData(w, http.StatusOK, poll, map[string]string{
+		"self": "/v1/notifications/poll",
+		"next": "/v1/notifications/poll?since=" + url.QueryEscape(poll.Cursor),
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/forgo/saga/api/internal/mapping"
 	"github.com/forgo/saga/api/internal/model"
 	"github.com/forgo/saga/api/internal/service"
 )
@@ -29,9 +30,9 @@ type OAuthCallbackRequest struct {
 
 // OAuthSuccessResponse represents a successful OAuth response.
 type OAuthSuccessResponse struct {
-	User      UserResponse  `json:"user"`
-	Token     TokenResponse `json:"token"`
-	IsNewUser bool          `json:"is_new_user"`
+	User      mapping.UserResponse  `json:"user"`
+	Token     mapping.TokenResponse `json:"token"`
+	IsNewUser bool                  `json:"is_new_user"`
 }
 
 // LinkRequiredResponse indicates account linking is needed
@@ -139,8 +140,8 @@ func (h *OAuthHandler) writeOAuthResult(w http.ResponseWriter, result *service.O
 
 	// Successful authentication
 	response := OAuthSuccessResponse{
-		User:      toUserResponse(result.User),
-		Token:     toTokenResponse(result.TokenPair),
+		User:      mapping.User(result.User),
+		Token:     mapping.Token(result.TokenPair),
 		IsNewUser: result.IsNewUser,
 	}
 
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// getPageRequest reads a list's ?cursor=, ?limit= and ?offset=. A limit
+// left out, or out of range, is zero for the service to apply its default.
+// The cursor must come from the same list with the same sort.
+func getPageRequest(r *http.Request, list model.ListQuery) (model.PageRequest, *model.ProblemDetails) {
+	var page model.PageRequest
+	query := r.URL.Query()
+
+	if v, err := strconv.Atoi(query.Get("limit")); err == nil && v > 0 {
+		page.Limit = v
+	}
+	if v, err := strconv.Atoi(query.Get("offset")); err == nil && v > 0 {
+		page.Offset = v
+	}
+
+	if raw := query.Get("cursor"); raw != "" {
+		cursor, err := model.DecodeListCursor(raw)
+		if err != nil {
+			return page, model.NewBadRequestError("invalid cursor")
+		}
+		if cursor.Sort != list.SortParam() {
+			return page, model.NewBadRequestError("cursor is for another sort; start again without it to change the sort")
+		}
+		page.Cursor = cursor
+	}
+
+	return page, nil
+}
+
+// writeCursorError writes a 400 for a cursor the list turned down, and
+// reports whether it did
+func writeCursorError(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, model.ErrInvalidCursor) {
+		return false
+	}
+	WriteError(w, model.NewBadRequestError("invalid cursor"))
+	return true
+}
+
+// writePage writes one page of a collection at self, with next and prev
+// links that keep the request's sort and filters
+func writePage[T any](w http.ResponseWriter, r *http.Request, page *model.Page[T], self string) {
+	links := map[string]string{"self": self}
+	if page.NextCursor != "" {
+		links["next"] = pageLink(r, self, page.NextCursor)
+	}
+	if page.PrevCursor != "" {
+		links["prev"] = pageLink(r, self, page.PrevCursor)
+	}
+
+	WriteCollection(w, http.StatusOK, page.Items, &PaginationInfo{
+		Cursor:     page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.NextCursor != "",
+	}, links)
+}
+
+// pageLink returns the request's URL at path with its cursor swapped for
+// cursor. Any offset is dropped, as the cursor takes its place.
+func pageLink(r *http.Request, path, cursor string) string {
+	query := url.Values{}
+	for key, values := range r.URL.Query() {
+		query[key] = values
+	}
+	query.Del("offset")
+	query.Set("cursor", cursor)
+	return path + "?" + query.Encode()
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/forgo/saga/api/internal/mapping"
 	"github.com/forgo/saga/api/internal/middleware"
 	"github.com/forgo/saga/api/internal/model"
 	"github.com/forgo/saga/api/internal/service"
@@ -118,18 +119,13 @@ func (h *PasskeyHandler) RegisterFinish(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	response := PasskeyResponse{
-		ID:        result.Passkey.ID,
-		Name:      result.Passkey.Name,
-		CreatedOn: result.Passkey.CreatedOn.Format("2006-01-02T15:04:05Z"),
-	}
-
-	WriteData(w, http.StatusCreated, response, nil)
+	WriteData(w, http.StatusCreated, mapping.Passkey(result.Passkey), nil)
 }
 
 // PasskeyLoginStartRequest represents the login start request body.
 type PasskeyLoginStartRequest struct {
-	Email string `json:"email,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Mediation string `json:"mediation,omitempty"` // "conditional" for passkey autofill
 }
 
 // LoginStart handles POST /v1/auth/passkey/login/start
@@ -144,7 +140,8 @@ func (h *PasskeyHandler) LoginStart(w http.ResponseWriter, r *http.Request) {
 	_ = DecodeJSON(r, &req)
 
 	result, err := h.passkeyService.StartLogin(r.Context(), service.LoginStartRequest{
-		Email: req.Email,
+		Email:       req.Email,
+		Conditional: req.Mediation == "conditional",
 	})
 	if err != nil {
 		h.handlePasskeyError(w, err)
@@ -188,11 +185,11 @@ func (h *PasskeyHandler) LoginFinish(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := struct {
-		User  UserResponse  `json:"user"`
-		Token TokenResponse `json:"token"`
+		User  mapping.UserResponse  `json:"user"`
+		Token mapping.TokenResponse `json:"token"`
 	}{
-		User:  toUserResponse(result.User),
-		Token: toTokenResponse(result.TokenPair),
+		User:  mapping.User(result.User),
+		Token: mapping.Token(result.TokenPair),
 	}
 
 	WriteData(w, http.StatusOK, response, map[string]string{
@@ -200,6 +197,148 @@ func (h *PasskeyHandler) LoginFinish(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// CrossDeviceStart handles POST /v1/auth/passkey/cross-device - start a
+// login on this device to be approved with a passkey on another
+func (h *PasskeyHandler) CrossDeviceStart(w http.ResponseWriter, r *http.Request) {
+	result, err := h.passkeyService.StartCrossDevice(r.Context())
+	if err != nil {
+		h.handlePasskeyError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, result, crossDeviceLinks(result.ID))
+}
+
+// CrossDeviceOptions handles GET /v1/auth/passkey/cross-device/{id} - the
+// assertion options for the approving device
+func (h *PasskeyHandler) CrossDeviceOptions(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	result, err := h.passkeyService.CrossDeviceOptions(r.Context(), id)
+	if err != nil {
+		h.handlePasskeyError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, result, crossDeviceLinks(id))
+}
+
+// CrossDeviceApprove handles POST /v1/auth/passkey/cross-device/{id}/approve
+func (h *PasskeyHandler) CrossDeviceApprove(w http.ResponseWriter, r *http.Request) {
+	var req PasskeyLoginFinishRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if req.Credential == nil {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "credential", Message: "credential is required"},
+		}))
+		return
+	}
+
+	if err := h.passkeyService.ApproveCrossDevice(r.Context(), r.PathValue("id"), req.Credential); err != nil {
+		h.handlePasskeyError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// CrossDevicePollRequest represents the cross-device poll request body.
+type CrossDevicePollRequest struct {
+	PollToken string `json:"poll_token"`
+}
+
+// CrossDevicePollResponse is pending until the login is approved, then
+// carries the session
+type CrossDevicePollResponse struct {
+	Status string                 `json:"status"`
+	User   *mapping.UserResponse  `json:"user,omitempty"`
+	Token  *mapping.TokenResponse `json:"token,omitempty"`
+}
+
+// CrossDevicePoll handles POST /v1/auth/passkey/cross-device/{id}/poll
+func (h *PasskeyHandler) CrossDevicePoll(w http.ResponseWriter, r *http.Request) {
+	var req CrossDevicePollRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if req.PollToken == "" {
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "poll_token", Message: "poll token is required"},
+		}))
+		return
+	}
+
+	result, err := h.passkeyService.PollCrossDevice(r.Context(), r.PathValue("id"), req.PollToken)
+	if err != nil {
+		h.handlePasskeyError(w, err)
+		return
+	}
+
+	response := CrossDevicePollResponse{Status: result.Status}
+	if result.User != nil {
+		user := mapping.User(result.User)
+		token := mapping.Token(result.TokenPair)
+		response.User = &user
+		response.Token = &token
+	}
+
+	WriteData(w, http.StatusOK, response, nil)
+}
+
+func crossDeviceLinks(id string) map[string]string {
+	return map[string]string{
+		"self":    "/v1/auth/passkey/cross-device/" + id,
+		"approve": "/v1/auth/passkey/cross-device/" + id + "/approve",
+		"poll":    "/v1/auth/passkey/cross-device/" + id + "/poll",
+	}
+}
+
+// PasskeyRecoverRequest represents the passkey recovery request body. The
+// credential answers a challenge from login/start.
+type PasskeyRecoverRequest struct {
+	Credential *service.AssertionResponse `json:"credential"`
+	NewEmail   string                     `json:"new_email"`
+}
+
+// Recover handles POST /v1/auth/passkey/recover - move an account whose
+// inbox is lost to a new email, proven with a passkey. The new address can
+// be verified once the recovery delay has passed.
+func (h *PasskeyHandler) Recover(w http.ResponseWriter, r *http.Request) {
+	var req PasskeyRecoverRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	var fieldErrors []model.FieldError
+	if req.Credential == nil {
+		fieldErrors = append(fieldErrors, model.FieldError{Field: "credential", Message: "credential is required"})
+	}
+	if req.NewEmail == "" {
+		fieldErrors = append(fieldErrors, model.FieldError{Field: "new_email", Message: "new email is required"})
+	}
+	if len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	change, err := h.passkeyService.FinishRecovery(r.Context(), service.RecoveryFinishRequest{
+		Credential: req.Credential,
+		NewEmail:   req.NewEmail,
+	})
+	if err != nil {
+		h.handleRecoveryError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusAccepted, change, nil)
+}
+
 // Delete handles DELETE /v1/auth/passkey/{id}
 func (h *PasskeyHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodDelete {
@@ -231,6 +370,28 @@ func (h *PasskeyHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	WriteNoContent(w)
 }
 
+// handleRecoveryError reports problems with the new address, then falls
+// back to passkey errors
+func (h *PasskeyHandler) handleRecoveryError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidEmail):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "new_email", Message: "invalid email format"},
+		}))
+	case errors.Is(err, service.ErrEmailUnchanged):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "new_email", Message: err.Error()},
+		}))
+	case errors.Is(err, service.ErrEmailAlreadyExists):
+		WriteError(w, model.NewConflictError("email already registered"))
+	case errors.Is(err, service.ErrAccountRecoveryDisabled),
+		errors.Is(err, service.ErrEmailDeliveryUnavailable):
+		WriteError(w, model.NewServiceUnavailableError("account recovery is unavailable"))
+	default:
+		h.handlePasskeyError(w, err)
+	}
+}
+
 func (h *PasskeyHandler) handlePasskeyError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, service.ErrUserNotFound):
@@ -247,6 +408,14 @@ func (h *PasskeyHandler) handlePasskeyError(w http.ResponseWriter, err error) {
 		WriteError(w, model.NewBadRequestError("sign count mismatch - potential cloned authenticator"))
 	case errors.Is(err, service.ErrPasskeyLimitReached):
 		WriteError(w, model.NewLimitExceededError("passkeys per user", 10, 10))
+	case errors.Is(err, service.ErrCrossDeviceNotFound):
+		WriteError(w, model.NewNotFoundError("cross-device login"))
+	case errors.Is(err, service.ErrCrossDeviceExpired):
+		WriteError(w, model.NewGoneError("cross-device login has expired; start a new one"))
+	case errors.Is(err, service.ErrCrossDeviceAlreadyApproved):
+		WriteError(w, model.NewConflictError("cross-device login was already approved"))
+	case errors.Is(err, service.ErrInvalidPollToken):
+		WriteError(w, model.NewForbiddenError("invalid poll token"))
 	default:
 		WriteError(w, model.NewInternalError("passkey operation failed"))
 	}
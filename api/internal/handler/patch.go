@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// PATCH body media types
+const (
+	MediaTypeMergePatch = "application/merge-patch+json" // RFC 7386
+	MediaTypeJSONPatch  = "application/json-patch+json"  // RFC 6902
+)
+
+// jsonPatchOp is one RFC 6902 operation
+type jsonPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+	From  string          `json:"from,omitempty"`
+}
+
+// DecodePatch decodes a PATCH body into dst, an update request with pointer
+// fields, and returns the fields the patch cleared.
+//
+// The body is an RFC 7386 merge patch (plain application/json is treated as
+// one): members set a field, null clears it. Object-valued fields such as
+// location are replaced as a whole. With application/json-patch+json the body
+// is an RFC 6902 patch whose add, replace and remove operations on top-level
+// fields are applied as the equivalent merge patch; a test of /version is the
+// version precondition.
+//
+// Immutable fields and clearing a field that isn't clearable are validation
+// errors; anything the request doesn't know is a bad request.
+func DecodePatch(r *http.Request, dst interface{}, rules model.PatchRules) (model.ClearedFields, *model.ProblemDetails) {
+	mediaType := MediaTypeMergePatch
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		parsed, _, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			return nil, model.NewUnsupportedMediaTypeError("invalid Content-Type")
+		}
+		mediaType = parsed
+	}
+
+	var doc map[string]json.RawMessage
+	switch mediaType {
+	case MediaTypeMergePatch, "application/json":
+		if err := json.NewDecoder(r.Body).Decode(&doc); err != nil || doc == nil {
+			return nil, model.NewBadRequestError("invalid request body: expected a JSON object")
+		}
+	case MediaTypeJSONPatch:
+		var ops []jsonPatchOp
+		if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+			return nil, model.NewBadRequestError("invalid request body: expected an array of JSON Patch operations")
+		}
+		var problem *model.ProblemDetails
+		if doc, problem = jsonPatchToMergePatch(ops); problem != nil {
+			return nil, problem
+		}
+	default:
+		return nil, model.NewUnsupportedMediaTypeError(fmt.Sprintf("PATCH accepts %s or %s", MediaTypeMergePatch, MediaTypeJSONPatch))
+	}
+
+	cleared, fieldErrors := applyPatchRules(doc, rules)
+	if len(fieldErrors) > 0 {
+		return nil, model.NewValidationError(fieldErrors)
+	}
+
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return nil, model.NewBadRequestError("invalid request body")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dst); err != nil {
+		return nil, model.NewBadRequestError("invalid request body")
+	}
+
+	return cleared, nil
+}
+
+// applyPatchRules checks a merge patch against the resource's rules and
+// removes the null members, returning them as cleared fields
+func applyPatchRules(doc map[string]json.RawMessage, rules model.PatchRules) (model.ClearedFields, []model.FieldError) {
+	immutable := make(map[string]bool, len(rules.Immutable))
+	for _, field := range rules.Immutable {
+		immutable[field] = true
+	}
+	clearable := make(map[string]bool, len(rules.Clearable))
+	for _, field := range rules.Clearable {
+		clearable[field] = true
+	}
+
+	fields := make([]string, 0, len(doc))
+	for field := range doc {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var cleared model.ClearedFields
+	var fieldErrors []model.FieldError
+	for _, field := range fields {
+		switch {
+		case immutable[field]:
+			fieldErrors = append(fieldErrors, model.FieldError{Field: field, Message: field + " cannot be changed"})
+		case isJSONNull(doc[field]):
+			if !clearable[field] {
+				fieldErrors = append(fieldErrors, model.FieldError{Field: field, Message: field + " cannot be cleared"})
+				continue
+			}
+			cleared = append(cleared, field)
+			delete(doc, field)
+		}
+	}
+	return cleared, fieldErrors
+}
+
+// jsonPatchToMergePatch turns JSON Patch operations on top-level fields into
+// the equivalent merge patch. Later operations on a field win, as they would
+// when applied in order.
+func jsonPatchToMergePatch(ops []jsonPatchOp) (map[string]json.RawMessage, *model.ProblemDetails) {
+	doc := make(map[string]json.RawMessage)
+	var fieldErrors []model.FieldError
+
+	for i, op := range ops {
+		location := fmt.Sprintf("[%d]", i)
+		field, ok := jsonPointerField(op.Path)
+		if !ok {
+			fieldErrors = append(fieldErrors, model.FieldError{Field: location + ".path", Message: "path must name a top-level field, like /title"})
+			continue
+		}
+
+		switch op.Op {
+		case "add", "replace":
+			if len(op.Value) == 0 {
+				fieldErrors = append(fieldErrors, model.FieldError{Field: location + ".value", Message: "value is required"})
+				continue
+			}
+			doc[field] = op.Value
+		case "remove":
+			doc[field] = json.RawMessage("null")
+		case "test":
+			if field != "version" || len(op.Value) == 0 {
+				fieldErrors = append(fieldErrors, model.FieldError{Field: location + ".op", Message: "test is only supported on /version"})
+				continue
+			}
+			doc[field] = op.Value
+		default:
+			fieldErrors = append(fieldErrors, model.FieldError{Field: location + ".op", Message: "op must be add, replace, remove or test"})
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return nil, model.NewValidationError(fieldErrors)
+	}
+	return doc, nil
+}
+
+// jsonPointerField returns the field a single-segment JSON pointer names
+func jsonPointerField(pointer string) (string, bool) {
+	if !strings.HasPrefix(pointer, "/") {
+		return "", false
+	}
+	segment := pointer[1:]
+	if segment == "" || strings.Contains(segment, "/") {
+		return "", false
+	}
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(segment), true
+}
+
+func isJSONNull(raw json.RawMessage) bool {
+	return string(bytes.TrimSpace(raw)) == "null"
+}
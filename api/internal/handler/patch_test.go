@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+func newPatchRequest(contentType, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPatch, "/v1/events/event:1", strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return req
+}
+
+func assertPatchFieldError(t *testing.T, problem *model.ProblemDetails, field string) {
+	t.Helper()
+	if problem == nil || problem.Status != http.StatusUnprocessableEntity {
+		t.Fatalf("expected validation problem, got %+v", problem)
+	}
+	for _, fe := range problem.Errors {
+		if fe.Field == field {
+			return
+		}
+	}
+	t.Errorf("expected an error on %s, got %+v", field, problem.Errors)
+}
+
+// ============================================================================
+// Merge Patch Tests
+// ============================================================================
+
+func TestDecodePatch_MergePatchSetsAndClears(t *testing.T) {
+	t.Parallel()
+
+	var req model.UpdateEventRequest
+	cleared, problem := DecodePatch(
+		newPatchRequest(MediaTypeMergePatch, `{"title":"Picnic","description":null,"version":3}`),
+		&req, model.EventPatchRules,
+	)
+	if problem != nil {
+		t.Fatalf("unexpected problem: %+v", problem)
+	}
+	if req.Title == nil || *req.Title != "Picnic" {
+		t.Errorf("expected title to be set, got %v", req.Title)
+	}
+	if req.Version == nil || *req.Version != 3 {
+		t.Errorf("expected version 3, got %v", req.Version)
+	}
+	if req.Description != nil || !cleared.Has("description") || len(cleared) != 1 {
+		t.Errorf("expected only description to be cleared, got %v", cleared)
+	}
+}
+
+func TestDecodePatch_PlainJSONIsMergePatch(t *testing.T) {
+	t.Parallel()
+
+	var req model.UpdateProfileRequest
+	cleared, problem := DecodePatch(newPatchRequest("application/json; charset=utf-8", `{"bio":null}`), &req, model.ProfilePatchRules)
+	if problem != nil {
+		t.Fatalf("unexpected problem: %+v", problem)
+	}
+	if !cleared.Has("bio") {
+		t.Errorf("expected bio to be cleared, got %v", cleared)
+	}
+}
+
+func TestDecodePatch_RejectsInvalidFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		body  string
+		field string
+	}{
+		{name: "immutable field", body: `{"guild_id":"guild:2"}`, field: "guild_id"},
+		{name: "clearing a required field", body: `{"title":null}`, field: "title"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var req model.UpdateEventRequest
+			_, problem := DecodePatch(newPatchRequest(MediaTypeMergePatch, tt.body), &req, model.EventPatchRules)
+			assertPatchFieldError(t, problem, tt.field)
+		})
+	}
+}
+
+func TestDecodePatch_BadRequests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		status      int
+	}{
+		{name: "unknown field", contentType: MediaTypeMergePatch, body: `{"mood":"sunny"}`, status: http.StatusBadRequest},
+		{name: "not an object", contentType: MediaTypeMergePatch, body: `["title"]`, status: http.StatusBadRequest},
+		{name: "null body", contentType: MediaTypeMergePatch, body: `null`, status: http.StatusBadRequest},
+		{name: "unsupported media type", contentType: "text/plain", body: `{}`, status: http.StatusUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var req model.UpdateEventRequest
+			_, problem := DecodePatch(newPatchRequest(tt.contentType, tt.body), &req, model.EventPatchRules)
+			if problem == nil || problem.Status != tt.status {
+				t.Errorf("expected status %d, got %+v", tt.status, problem)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// JSON Patch Tests
+// ============================================================================
+
+func TestDecodePatch_JSONPatchOperations(t *testing.T) {
+	t.Parallel()
+
+	var req service.UpdateGuildRequest
+	cleared, problem := DecodePatch(newPatchRequest(MediaTypeJSONPatch, `[
+		{"op":"test","path":"/version","value":2},
+		{"op":"replace","path":"/name","value":"Hikers"},
+		{"op":"remove","path":"/icon"}
+	]`), &req, model.GuildPatchRules)
+	if problem != nil {
+		t.Fatalf("unexpected problem: %+v", problem)
+	}
+	if req.Name == nil || *req.Name != "Hikers" {
+		t.Errorf("expected name to be replaced, got %v", req.Name)
+	}
+	if req.Version == nil || *req.Version != 2 {
+		t.Errorf("expected the version test to become the version, got %v", req.Version)
+	}
+	if !cleared.Has("icon") {
+		t.Errorf("expected icon to be cleared, got %v", cleared)
+	}
+}
+
+func TestDecodePatch_JSONPatchRejectsUnsupportedOperations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		body  string
+		field string
+	}{
+		{name: "move", body: `[{"op":"move","from":"/title","path":"/description"}]`, field: "[0].op"},
+		{name: "nested path", body: `[{"op":"replace","path":"/location/city","value":"Oslo"}]`, field: "[0].path"},
+		{name: "test on a field", body: `[{"op":"test","path":"/title","value":"Picnic"}]`, field: "[0].op"},
+		{name: "replace without value", body: `[{"op":"replace","path":"/title"}]`, field: "[0].value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var req model.UpdateEventRequest
+			_, problem := DecodePatch(newPatchRequest(MediaTypeJSONPatch, tt.body), &req, model.EventPatchRules)
+			assertPatchFieldError(t, problem, tt.field)
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// maxWebhookBytes bounds a payment webhook's body; Stripe's are a few KB
+const maxWebhookBytes = 64 << 10
+
+// PaymentHandler handles paid event checkout, payment webhooks, and
+// organizers' payout accounts
+type PaymentHandler struct {
+	paymentService *service.PaymentService
+}
+
+// NewPaymentHandler creates a new payment handler
+func NewPaymentHandler(paymentService *service.PaymentService) *PaymentHandler {
+	return &PaymentHandler{paymentService: paymentService}
+}
+
+// Checkout handles POST /v1/events/{eventId}/rsvp/checkout - start paying
+// for a held ticket, optionally with a promo code. Send the guest to the
+// returned URL; their RSVP is approved once the payment goes through.
+func (h *PaymentHandler) Checkout(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	// The body is optional; without one no promo code is applied
+	var req model.CheckoutRequest
+	if err := DecodeJSON(r, &req); err != nil && !errors.Is(err, io.EOF) {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	session, err := h.paymentService.Checkout(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, session, map[string]string{
+		"event":  "/v1/events/" + eventID,
+		"ticket": "/v1/events/" + eventID + "/tickets/mine",
+	})
+}
+
+// Webhook handles POST /v1/payments/webhook - Stripe's event deliveries.
+// Requests are authenticated by their Stripe-Signature header, not a token.
+func (h *PaymentHandler) Webhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBytes))
+	if err != nil {
+		WriteError(w, model.NewBadRequestError("webhook body too large"))
+		return
+	}
+
+	if err := h.paymentService.HandleWebhook(r.Context(), payload, r.Header.Get("Stripe-Signature")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// GetPayoutAccount handles GET /v1/profile/payout-account - the account
+// the user's ticket sales are paid out to
+func (h *PaymentHandler) GetPayoutAccount(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	account, err := h.paymentService.GetPayoutAccount(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, account, map[string]string{
+		"self": "/v1/profile/payout-account",
+		"link": "/v1/profile/payout-account/link",
+	})
+}
+
+// LinkPayoutAccount handles POST /v1/profile/payout-account/link - get a
+// Stripe page to set up the payout account on, creating the account first
+// if needed
+func (h *PaymentHandler) LinkPayoutAccount(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	link, err := h.paymentService.LinkPayoutAccount(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, link, map[string]string{
+		"account": "/v1/profile/payout-account",
+	})
+}
+
+// ListPromoCodes handles GET /v1/events/{eventId}/promo-codes - an event's
+// promo codes (host only)
+func (h *PaymentHandler) ListPromoCodes(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	promos, err := h.paymentService.ListPromoCodes(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, promos, nil, map[string]string{
+		"self":   "/v1/events/" + eventID + "/promo-codes",
+		"event":  "/v1/events/" + eventID,
+		"report": "/v1/events/" + eventID + "/discount-report",
+	})
+}
+
+// CreatePromoCode handles POST /v1/events/{eventId}/promo-codes - add a
+// promo code to a paid event (host only)
+func (h *PaymentHandler) CreatePromoCode(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.CreatePromoCodeRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	promo, err := h.paymentService.CreatePromoCode(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, promo, map[string]string{
+		"self":  "/v1/events/" + eventID + "/promo-codes/" + promo.ID,
+		"event": "/v1/events/" + eventID,
+	})
+}
+
+// UpdatePromoCode handles PATCH /v1/events/{eventId}/promo-codes/{promoId} -
+// change a promo code's cap or expiry, or deactivate it (host only)
+func (h *PaymentHandler) UpdatePromoCode(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	promoID := r.PathValue("promoId")
+	if eventID == "" || promoID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and promo code ID required"))
+		return
+	}
+
+	var req model.UpdatePromoCodeRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	promo, err := h.paymentService.UpdatePromoCode(r.Context(), userID, eventID, promoID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, promo, map[string]string{
+		"self":  "/v1/events/" + eventID + "/promo-codes/" + promo.ID,
+		"event": "/v1/events/" + eventID,
+	})
+}
+
+// DeletePromoCode handles DELETE /v1/events/{eventId}/promo-codes/{promoId} -
+// delete a promo code no one has redeemed (host only)
+func (h *PaymentHandler) DeletePromoCode(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	promoID := r.PathValue("promoId")
+	if eventID == "" || promoID == "" {
+		WriteError(w, model.NewBadRequestError("event ID and promo code ID required"))
+		return
+	}
+
+	if err := h.paymentService.DeletePromoCode(r.Context(), userID, eventID, promoID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// SetMemberDiscounts handles PUT /v1/events/{eventId}/member-discounts -
+// replace the percentage off a guild event's tickets guild members get by
+// role (host only)
+func (h *PaymentHandler) SetMemberDiscounts(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	var req model.SetMemberDiscountsRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	discounts, err := h.paymentService.SetMemberDiscounts(r.Context(), userID, eventID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, discounts, nil, map[string]string{
+		"event": "/v1/events/" + eventID,
+	})
+}
+
+// GetDiscountReport handles GET /v1/events/{eventId}/discount-report -
+// ticket sales by promo code and member discount (host only)
+func (h *PaymentHandler) GetDiscountReport(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	eventID := r.PathValue("eventId")
+	if eventID == "" {
+		WriteError(w, model.NewBadRequestError("event ID required"))
+		return
+	}
+
+	report, err := h.paymentService.GetDiscountReport(r.Context(), userID, eventID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, report, map[string]string{
+		"self":        "/v1/events/" + eventID + "/discount-report",
+		"promo_codes": "/v1/events/" + eventID + "/promo-codes",
+	})
+}
+
+func (h *PaymentHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrPaymentsDisabled):
+		WriteError(w, model.NewNotFoundError("payments"))
+	case errors.Is(err, service.ErrEventNotFound):
+		WriteError(w, model.NewNotFoundError("event"))
+	case errors.Is(err, service.ErrPayoutAccountNotFound):
+		WriteError(w, model.NewNotFoundError("payout account"))
+	case errors.Is(err, service.ErrPromoCodeNotFound):
+		WriteError(w, model.NewNotFoundError("promo code"))
+	case errors.Is(err, service.ErrNotEventHost):
+		WriteError(w, model.NewForbiddenError("only event hosts can manage discounts"))
+	case errors.Is(err, service.ErrNotGuildEvent):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "discounts", Message: err.Error()}}))
+	case errors.Is(err, service.ErrEventNotPaid),
+		errors.Is(err, service.ErrPaymentNotDue),
+		errors.Is(err, service.ErrTicketHoldRequired),
+		errors.Is(err, service.ErrPayoutAccountNotReady),
+		errors.Is(err, service.ErrPromoCodeInvalid),
+		errors.Is(err, service.ErrPromoCodeExists),
+		errors.Is(err, service.ErrPromoCodeRedeemed),
+		errors.Is(err, service.ErrTooManyPromoCodes):
+		WriteError(w, model.NewConflictError(err.Error()))
+	case errors.Is(err, service.ErrInvalidWebhook):
+		WriteError(w, model.NewBadRequestError(err.Error()))
+	default:
+		// Webhooks that fail here are retried by Stripe
+		WriteError(w, model.NewInternalError("payment operation failed"))
+	}
+}
@@ -33,9 +33,9 @@ func (h *PoolHandler) ListPools(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	if guildID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID required"))
+	guildID, err := model.ParseGuildID(r.PathValue("guildId"))
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -57,9 +57,9 @@ func (h *PoolHandler) CreatePool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	if guildID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID required"))
+	guildID, err := model.ParseGuildID(r.PathValue("guildId"))
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -88,7 +88,7 @@ func (h *PoolHandler) CreatePool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pool, err := h.poolService.CreatePool(ctx, guildID, &req, userID)
+	pool, err := h.poolService.CreatePool(ctx, guildID, &req, model.UserID(userID))
 	if err != nil {
 		h.handleError(w, err)
 		return
@@ -106,10 +106,9 @@ func (h *PoolHandler) GetPool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -140,10 +139,9 @@ func (h *PoolHandler) UpdatePool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -177,10 +175,9 @@ func (h *PoolHandler) DeletePool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -190,7 +187,7 @@ func (h *PoolHandler) DeletePool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.poolService.DeletePool(ctx, poolID); err != nil {
+	if err := h.poolService.DeletePool(ctx, poolID, model.UserID(userID)); err != nil {
 		h.handleError(w, err)
 		return
 	}
@@ -207,10 +204,9 @@ func (h *PoolHandler) JoinPool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -226,7 +222,13 @@ func (h *PoolHandler) JoinPool(w http.ResponseWriter, r *http.Request) {
 		req = model.JoinPoolRequest{}
 	}
 
-	member, err := h.poolService.JoinPool(ctx, poolID, userID, userID, &req)
+	memberID, err := h.poolService.MemberIDForUser(ctx, model.UserID(userID))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	member, err := h.poolService.JoinPool(ctx, poolID, memberID, model.UserID(userID), &req)
 	if err != nil {
 		h.handleError(w, err)
 		return
@@ -244,10 +246,9 @@ func (h *PoolHandler) LeavePool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -257,7 +258,13 @@ func (h *PoolHandler) LeavePool(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.poolService.LeavePool(ctx, poolID, userID); err != nil {
+	memberID, err := h.poolService.MemberIDForUser(ctx, model.UserID(userID))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	if err := h.poolService.LeavePool(ctx, poolID, memberID); err != nil {
 		h.handleError(w, err)
 		return
 	}
@@ -274,10 +281,9 @@ func (h *PoolHandler) GetPoolMembers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -305,10 +311,9 @@ func (h *PoolHandler) UpdateMembership(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -324,7 +329,13 @@ func (h *PoolHandler) UpdateMembership(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	member, err := h.poolService.UpdateMembership(ctx, poolID, userID, &req)
+	memberID, err := h.poolService.MemberIDForUser(ctx, model.UserID(userID))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	member, err := h.poolService.UpdateMembership(ctx, poolID, memberID, &req)
 	if err != nil {
 		h.handleError(w, err)
 		return
@@ -342,10 +353,9 @@ func (h *PoolHandler) GetPoolStats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -373,10 +383,9 @@ func (h *PoolHandler) GetMatchHistory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	guildID := r.PathValue("guildId")
-	poolID := r.PathValue("poolId")
-	if guildID == "" || poolID == "" {
-		WriteError(w, model.NewBadRequestError("guild ID and pool ID required"))
+	guildID, poolID, err := poolPathIDs(r)
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -412,7 +421,7 @@ func (h *PoolHandler) GetPendingMatches(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	matches, err := h.poolService.GetPendingMatches(ctx, userID)
+	matches, err := h.poolService.GetPendingMatches(ctx, model.UserID(userID))
 	if err != nil {
 		h.handleError(w, err)
 		return
@@ -430,9 +439,9 @@ func (h *PoolHandler) UpdateMatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	matchID := r.PathValue("matchId")
-	if matchID == "" {
-		WriteError(w, model.NewBadRequestError("match ID required"))
+	matchID, err := model.ParseMatchID(r.PathValue("matchId"))
+	if err != nil {
+		WriteError(w, model.NewBadRequestError(err.Error()))
 		return
 	}
 
@@ -442,7 +451,7 @@ func (h *PoolHandler) UpdateMatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	match, err := h.poolService.UpdateMatch(ctx, matchID, userID, &req)
+	match, err := h.poolService.UpdateMatch(ctx, matchID, model.UserID(userID), &req)
 	if err != nil {
 		h.handleError(w, err)
 		return
@@ -460,10 +469,16 @@ func (h *PoolHandler) handleError(w http.ResponseWriter, err error) {
 		WriteError(w, model.NewNotFoundError("pool not found"))
 	case errors.Is(err, service.ErrMatchNotFound):
 		WriteError(w, model.NewNotFoundError("match not found"))
+	case errors.Is(err, service.ErrNoMemberRecord):
+		WriteError(w, model.NewForbiddenError("not a guild member"))
 	case errors.Is(err, service.ErrNotPoolMember):
 		WriteError(w, model.NewNotFoundError("not a pool member"))
 	case errors.Is(err, service.ErrNotMatchMember):
 		WriteError(w, model.NewForbiddenError("not a member of this match"))
+	case errors.Is(err, service.ErrTierRequired):
+		WriteError(w, model.NewForbiddenError(err.Error()))
+	case errors.Is(err, service.ErrTierNotFound):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "min_tier", Message: err.Error()}}))
 	case errors.Is(err, service.ErrAlreadyPoolMember):
 		WriteError(w, model.NewConflictError("already a member of this pool"))
 	case errors.Is(err, service.ErrPoolLimitReached):
@@ -484,3 +499,16 @@ func (h *PoolHandler) handleError(w http.ResponseWriter, err error) {
 		WriteError(w, model.NewInternalError("an unexpected error occurred"))
 	}
 }
+
+// poolPathIDs parses the guild and pool IDs in the request path
+func poolPathIDs(r *http.Request) (model.GuildID, model.PoolID, error) {
+	guildID, err := model.ParseGuildID(r.PathValue("guildId"))
+	if err != nil {
+		return "", "", err
+	}
+	poolID, err := model.ParsePoolID(r.PathValue("poolId"))
+	if err != nil {
+		return "", "", err
+	}
+	return guildID, poolID, nil
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// PresenceHandler handles explicit activity heartbeats
+type PresenceHandler struct {
+	presenceService *service.PresenceService
+}
+
+// NewPresenceHandler creates a new presence handler
+func NewPresenceHandler(presenceService *service.PresenceService) *PresenceHandler {
+	return &PresenceHandler{presenceService: presenceService}
+}
+
+// Heartbeat handles POST /v1/presence - mark the user active, for clients
+// that stay open without making other requests
+func (h *PresenceHandler) Heartbeat(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	h.presenceService.Touch(r.Context(), userID)
+
+	WriteNoContent(w)
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/forgo/saga/api/internal/mapping"
 	"github.com/forgo/saga/api/internal/middleware"
 	"github.com/forgo/saga/api/internal/model"
 	"github.com/forgo/saga/api/internal/service"
@@ -13,41 +15,22 @@ import (
 // ProfileHandler handles profile endpoints
 type ProfileHandler struct {
 	profileService *service.ProfileService
+	handleService  *service.HandleService
 }
 
 // NewProfileHandler creates a new profile handler
-func NewProfileHandler(profileService *service.ProfileService) *ProfileHandler {
+func NewProfileHandler(profileService *service.ProfileService, handleService *service.HandleService) *ProfileHandler {
 	return &ProfileHandler{
 		profileService: profileService,
+		handleService:  handleService,
 	}
 }
 
-// ProfileResponse represents a profile in API responses
-type ProfileResponse struct {
-	UserID     string   `json:"user_id"`
-	Bio        *string  `json:"bio,omitempty"`
-	Tagline    *string  `json:"tagline,omitempty"`
-	Languages  []string `json:"languages,omitempty"`
-	Timezone   *string  `json:"timezone,omitempty"`
-	City       string   `json:"city,omitempty"`
-	Country    string   `json:"country,omitempty"`
-	Visibility string   `json:"visibility"`
-	CreatedOn  string   `json:"created_on"`
-	UpdatedOn  string   `json:"updated_on"`
-}
-
-// PublicProfileResponse is what other users see
-type PublicProfileResponse struct {
-	UserID         string   `json:"user_id"`
-	Firstname      *string  `json:"firstname,omitempty"`
-	Bio            *string  `json:"bio,omitempty"`
-	Tagline        *string  `json:"tagline,omitempty"`
-	Languages      []string `json:"languages,omitempty"`
-	City           string   `json:"city,omitempty"`
-	Country        string   `json:"country,omitempty"`
-	Distance       string   `json:"distance,omitempty"`
-	ActivityStatus string   `json:"activity_status,omitempty"`
-	Compatibility  *float64 `json:"compatibility,omitempty"`
+// HandleResponse is the user's handle and when it may next change
+type HandleResponse struct {
+	Handle       *string    `json:"handle,omitempty"`
+	ChangedOn    *time.Time `json:"changed_on,omitempty"`
+	NextChangeOn *time.Time `json:"next_change_on,omitempty"`
 }
 
 // Get handles GET /v1/profile - get own profile
@@ -64,7 +47,13 @@ func (h *ProfileHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	WriteData(w, http.StatusOK, toProfileResponse(profile), map[string]string{
+	resp := mapping.Profile(profile)
+	// Completeness is supplementary; a failed lookup leaves it out
+	if completeness, err := h.profileService.CompletenessFor(r.Context(), profile); err == nil {
+		resp.Completeness = completeness
+	}
+
+	WriteData(w, http.StatusOK, resp, map[string]string{
 		"self":      "/v1/profile",
 		"interests": "/v1/profile/interests",
 	})
@@ -79,10 +68,12 @@ func (h *ProfileHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req model.UpdateProfileRequest
-	if err := DecodeJSON(r, &req); err != nil {
-		WriteError(w, model.NewBadRequestError("invalid request body"))
+	cleared, problem := DecodePatch(r, &req, model.ProfilePatchRules)
+	if problem != nil {
+		WriteError(w, problem)
 		return
 	}
+	req.Clear = cleared
 
 	// Validate
 	var fieldErrors []model.FieldError
@@ -98,12 +89,30 @@ func (h *ProfileHandler) Update(w http.ResponseWriter, r *http.Request) {
 			Message: "tagline must be at most 100 characters",
 		})
 	}
+	if req.PhotoURL != nil && !model.IsValidPhotoURL(*req.PhotoURL) {
+		fieldErrors = append(fieldErrors, model.FieldError{
+			Field:   "photo_url",
+			Message: "photo_url must be an https URL of at most 2048 characters",
+		})
+	}
 	if len(req.Languages) > model.MaxLanguages {
 		fieldErrors = append(fieldErrors, model.FieldError{
 			Field:   "languages",
 			Message: "maximum 10 languages allowed",
 		})
 	}
+	if _, ok := model.NormalizeLanguages(req.Languages); !ok {
+		fieldErrors = append(fieldErrors, model.FieldError{
+			Field:   "languages",
+			Message: "languages must be ISO 639-1 codes such as en or es",
+		})
+	}
+	if !model.ValidAccessibility(req.AccessibilityNeeds) {
+		fieldErrors = append(fieldErrors, model.FieldError{
+			Field:   "accessibility_needs",
+			Message: model.InvalidAccessibilityMessage,
+		})
+	}
 	if req.Visibility != nil && !isValidVisibility(*req.Visibility) {
 		fieldErrors = append(fieldErrors, model.FieldError{
 			Field:   "visibility",
@@ -122,7 +131,7 @@ func (h *ProfileHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	WriteData(w, http.StatusOK, toProfileResponse(profile), map[string]string{
+	WriteData(w, http.StatusOK, mapping.Profile(profile), map[string]string{
 		"self":      "/v1/profile",
 		"interests": "/v1/profile/interests",
 	})
@@ -136,9 +145,8 @@ func (h *ProfileHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	targetUserID := r.PathValue("userId")
-	if targetUserID == "" {
-		WriteError(w, model.NewBadRequestError("user ID required"))
+	targetUserID, ok := h.resolveUserRef(w, r)
+	if !ok {
 		return
 	}
 
@@ -151,11 +159,65 @@ func (h *ProfileHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	WriteData(w, http.StatusOK, toPublicProfileResponse(profile), map[string]string{
+	WriteData(w, http.StatusOK, mapping.PublicProfile(profile), map[string]string{
 		"self": "/v1/users/" + targetUserID + "/profile",
 	})
 }
 
+// GetShared handles GET /v1/public/users/{userId}/profile - unauthenticated
+// profile for share links, available only when the owner enabled sharing
+func (h *ProfileHandler) GetShared(w http.ResponseWriter, r *http.Request) {
+	targetUserID, ok := h.resolveUserRef(w, r)
+	if !ok {
+		return
+	}
+
+	profile, err := h.profileService.GetSharedProfile(r.Context(), targetUserID)
+	if err != nil {
+		h.handleProfileError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, mapping.PublicProfile(profile), map[string]string{
+		"self": sharedProfilePath(targetUserID),
+	})
+}
+
+// SetHandle handles PUT /v1/profile/handle - set or change own handle
+func (h *ProfileHandler) SetHandle(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.SetHandleRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	user, err := h.handleService.SetHandle(r.Context(), userID, req.Handle)
+	if err != nil {
+		h.handleProfileError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, HandleResponse{
+		Handle:       user.Username,
+		ChangedOn:    user.UsernameChangedOn,
+		NextChangeOn: service.NextHandleChange(user),
+	}, map[string]string{
+		"self":    "/v1/profile/handle",
+		"profile": "/v1/users/" + model.HandlePrefix + *user.Username + "/profile",
+	})
+}
+
 // GetNearby handles GET /v1/discover/people - find people nearby
 func (h *ProfileHandler) GetNearby(w http.ResponseWriter, r *http.Request) {
 	viewerID := middleware.GetUserID(r.Context())
@@ -166,6 +228,10 @@ func (h *ProfileHandler) GetNearby(w http.ResponseWriter, r *http.Request) {
 
 	// Get viewer's location
 	viewerLocation, err := h.profileService.GetLocationInternal(r.Context(), viewerID)
+	if errors.Is(err, service.ErrLocationConsentRequired) {
+		WriteError(w, model.NewForbiddenError("location processing consent required to discover nearby people"))
+		return
+	}
 	if err != nil || viewerLocation == nil {
 		WriteError(w, model.NewBadRequestError("location required to discover nearby people"))
 		return
@@ -192,18 +258,22 @@ func (h *ProfileHandler) GetNearby(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert to response format
-	response := make([]PublicProfileResponse, 0, len(profiles))
-	for _, p := range profiles {
-		response = append(response, *toPublicProfileResponse(p))
-	}
-
-	WriteCollection(w, http.StatusOK, response, nil, map[string]string{
+	WriteCollection(w, http.StatusOK, mapping.PublicProfiles(profiles), nil, map[string]string{
 		"self": "/v1/discover/people",
 	})
 }
 
 func (h *ProfileHandler) handleProfileError(w http.ResponseWriter, err error) {
+	var conflict *service.VersionConflictError
+	if errors.As(err, &conflict) {
+		if current, ok := conflict.Current.(*model.UserProfile); ok {
+			WriteError(w, model.NewVersionConflictError(mapping.Profile(current)))
+			return
+		}
+		WriteError(w, model.NewVersionConflictError(nil))
+		return
+	}
+
 	switch {
 	case errors.Is(err, service.ErrProfileNotFound):
 		WriteError(w, model.NewNotFoundError("profile"))
@@ -219,44 +289,54 @@ func (h *ProfileHandler) handleProfileError(w http.ResponseWriter, err error) {
 		WriteError(w, model.NewValidationError([]model.FieldError{
 			{Field: "tagline", Message: "tagline exceeds maximum length"},
 		}))
+	case errors.Is(err, service.ErrInvalidPhotoURL):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "photo_url", Message: "photo_url must be an https URL"},
+		}))
+	case errors.Is(err, service.ErrInvalidLanguage):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "languages", Message: "languages must be ISO 639-1 codes such as en or es"},
+		}))
+	case errors.Is(err, service.ErrInvalidAccessibility):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "accessibility_needs", Message: model.InvalidAccessibilityMessage},
+		}))
+	case errors.Is(err, service.ErrInvalidHandle):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "handle", Message: "invalid handle"},
+		}))
+	case errors.Is(err, service.ErrLocationConsentRequired):
+		WriteError(w, model.NewForbiddenError("location processing consent required to save a location"))
+	case errors.Is(err, service.ErrHandleTaken):
+		WriteError(w, model.NewConflictError("handle is already taken"))
+	case errors.Is(err, service.ErrHandleChangeTooSoon):
+		WriteError(w, model.NewConflictError("handle can only be changed once every 30 days"))
+	case errors.Is(err, service.ErrHandleNotFound), errors.Is(err, service.ErrUserNotFound):
+		WriteError(w, model.NewNotFoundError("user"))
 	default:
 		WriteError(w, model.NewInternalError("profile operation failed"))
 	}
 }
 
-func toProfileResponse(p *model.UserProfile) ProfileResponse {
-	resp := ProfileResponse{
-		UserID:     p.UserID,
-		Bio:        p.Bio,
-		Tagline:    p.Tagline,
-		Languages:  p.Languages,
-		Timezone:   p.Timezone,
-		Visibility: p.Visibility,
-		CreatedOn:  p.CreatedOn.Format("2006-01-02T15:04:05Z"),
-		UpdatedOn:  p.UpdatedOn.Format("2006-01-02T15:04:05Z"),
+// resolveUserRef reads the userId path value, which may be a user ID or an
+// @handle, writing an error response when it cannot be resolved
+func (h *ProfileHandler) resolveUserRef(w http.ResponseWriter, r *http.Request) (string, bool) {
+	ref := r.PathValue("userId")
+	if ref == "" {
+		WriteError(w, model.NewBadRequestError("user ID required"))
+		return "", false
 	}
 
-	if p.Location != nil {
-		resp.City = p.Location.City
-		resp.Country = p.Location.Country
+	userID, err := h.handleService.ResolveUserRef(r.Context(), ref)
+	if err != nil {
+		h.handleProfileError(w, err)
+		return "", false
 	}
-
-	return resp
+	return userID, true
 }
 
-func toPublicProfileResponse(p *model.PublicProfile) *PublicProfileResponse {
-	return &PublicProfileResponse{
-		UserID:         p.UserID,
-		Firstname:      p.Firstname,
-		Bio:            p.Bio,
-		Tagline:        p.Tagline,
-		Languages:      p.Languages,
-		City:           p.City,
-		Country:        p.Country,
-		Distance:       string(p.Distance),
-		ActivityStatus: string(p.ActivityStatus),
-		Compatibility:  p.Compatibility,
-	}
+func sharedProfilePath(userID string) string {
+	return "/v1/public/users/" + userID + "/profile"
 }
 
 func isValidVisibility(v string) bool {
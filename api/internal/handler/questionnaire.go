@@ -50,14 +50,6 @@ func (h *QuestionnaireHandler) ListQuestions(w http.ResponseWriter, r *http.Requ
 	})
 }
 
-// GetCategories handles GET /v1/questions/categories - list question categories
-func (h *QuestionnaireHandler) GetCategories(w http.ResponseWriter, r *http.Request) {
-	categories := model.GetQuestionCategories()
-	WriteCollection(w, http.StatusOK, categories, nil, map[string]string{
-		"self": "/v1/questions/categories",
-	})
-}
-
 // GetQuestion handles GET /v1/questions/{questionId} - get a specific question
 func (h *QuestionnaireHandler) GetQuestion(w http.ResponseWriter, r *http.Request) {
 	questionID := r.PathValue("questionId")
@@ -115,6 +107,60 @@ func (h *QuestionnaireHandler) GetUserAnswersWithQuestions(w http.ResponseWriter
 	})
 }
 
+// ExportAnswers handles GET /v1/profile/answers/export - export answers as JSON
+func (h *QuestionnaireHandler) ExportAnswers(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	export, err := h.questionnaireService.ExportAnswers(r.Context(), userID)
+	if err != nil {
+		h.handleQuestionnaireError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, export, map[string]string{
+		"self":   "/v1/profile/answers/export",
+		"import": "/v1/profile/answers/import",
+	})
+}
+
+// ImportAnswers handles POST /v1/profile/answers/import - import exported answers.
+// With ?dry_run=true nothing is saved and the response reports what would happen.
+func (h *QuestionnaireHandler) ImportAnswers(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.QuestionnaireExport
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	result, err := h.questionnaireService.ImportAnswers(r.Context(), userID, &req, dryRun)
+	if err != nil {
+		h.handleQuestionnaireError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, result, map[string]string{
+		"self":    "/v1/profile/answers/import",
+		"answers": "/v1/profile/answers",
+	})
+}
+
 // GetQuestionProgress handles GET /v1/profile/questions/progress - get question progress
 func (h *QuestionnaireHandler) GetQuestionProgress(w http.ResponseWriter, r *http.Request) {
 	userID := middleware.GetUserID(r.Context())
@@ -154,15 +200,7 @@ func (h *QuestionnaireHandler) AnswerQuestion(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// Validate
-	var fieldErrors []model.FieldError
-	if req.SelectedOption == "" {
-		fieldErrors = append(fieldErrors, model.FieldError{
-			Field:   "selected_option",
-			Message: "selected_option is required",
-		})
-	}
-	if len(fieldErrors) > 0 {
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
 		WriteError(w, model.NewValidationError(fieldErrors))
 		return
 	}
@@ -261,6 +299,38 @@ func (h *QuestionnaireHandler) GetCompatibility(w http.ResponseWriter, r *http.R
 	})
 }
 
+// GetCompatibilityBatch handles POST /v1/compatibility/batch - score several users in one request
+func (h *QuestionnaireHandler) GetCompatibilityBatch(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.BatchCompatibilityRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	scores, err := h.compatibilityService.CalculateCompatibilityBatch(r.Context(), userID, req.UserIDs)
+	if err != nil {
+		h.handleQuestionnaireError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, map[string]interface{}{
+		"scores": scores,
+	}, map[string]string{
+		"self": "/v1/compatibility/batch",
+	})
+}
+
 // GetYikesSummary handles GET /v1/compatibility/{userId}/yikes - get yikes flags
 func (h *QuestionnaireHandler) GetYikesSummary(w http.ResponseWriter, r *http.Request) {
 	userID := middleware.GetUserID(r.Context())
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// ReactionHandler handles emoji reactions on events and announcements
+type ReactionHandler struct {
+	reactionService *service.ReactionService
+}
+
+// NewReactionHandler creates a new reaction handler
+func NewReactionHandler(reactionService *service.ReactionService) *ReactionHandler {
+	return &ReactionHandler{reactionService: reactionService}
+}
+
+// ReactToEvent handles PUT /v1/events/{eventId}/reactions - react to an
+// event, replacing the user's reaction
+func (h *ReactionHandler) ReactToEvent(w http.ResponseWriter, r *http.Request) {
+	h.react(w, r, model.ReactionTargetEvent, r.PathValue("eventId"), "/v1/events/")
+}
+
+// UnreactToEvent handles DELETE /v1/events/{eventId}/reactions - remove the
+// user's reaction from an event
+func (h *ReactionHandler) UnreactToEvent(w http.ResponseWriter, r *http.Request) {
+	h.unreact(w, r, model.ReactionTargetEvent, r.PathValue("eventId"), "/v1/events/")
+}
+
+// ReactToAnnouncement handles PUT /v1/announcements/{announcementId}/reactions
+// - react to an announcement delivered to the user
+func (h *ReactionHandler) ReactToAnnouncement(w http.ResponseWriter, r *http.Request) {
+	h.react(w, r, model.ReactionTargetAnnouncement, r.PathValue("announcementId"), "/v1/announcements/")
+}
+
+// UnreactToAnnouncement handles DELETE
+// /v1/announcements/{announcementId}/reactions - remove the user's reaction
+// from an announcement
+func (h *ReactionHandler) UnreactToAnnouncement(w http.ResponseWriter, r *http.Request) {
+	h.unreact(w, r, model.ReactionTargetAnnouncement, r.PathValue("announcementId"), "/v1/announcements/")
+}
+
+func (h *ReactionHandler) react(w http.ResponseWriter, r *http.Request, targetType model.ReactionTargetType, targetID, basePath string) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+	if targetID == "" {
+		WriteError(w, model.NewBadRequestError(string(targetType)+" ID required"))
+		return
+	}
+
+	var req model.SetReactionRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	summary, err := h.reactionService.React(r.Context(), userID, targetType, targetID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, summary, map[string]string{
+		"self":             basePath + targetID + "/reactions",
+		string(targetType): basePath + targetID,
+	})
+}
+
+func (h *ReactionHandler) unreact(w http.ResponseWriter, r *http.Request, targetType model.ReactionTargetType, targetID, basePath string) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+	if targetID == "" {
+		WriteError(w, model.NewBadRequestError(string(targetType)+" ID required"))
+		return
+	}
+
+	summary, err := h.reactionService.Unreact(r.Context(), userID, targetType, targetID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, summary, map[string]string{
+		"self":             basePath + targetID + "/reactions",
+		string(targetType): basePath + targetID,
+	})
+}
+
+// handleError converts service errors to HTTP responses
+func (h *ReactionHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		WriteError(w, model.NewNotFoundError("event"))
+	case errors.Is(err, service.ErrAnnouncementNotFound):
+		WriteError(w, model.NewNotFoundError("announcement"))
+	case errors.Is(err, service.ErrReactionNotFound):
+		WriteError(w, model.NewNotFoundError("reaction"))
+	default:
+		WriteError(w, model.NewInternalError("reaction operation failed"))
+	}
+}
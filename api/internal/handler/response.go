@@ -7,10 +7,12 @@ import (
 	"github.com/forgo/saga/api/internal/model"
 )
 
-// DataResponse wraps a successful response with optional HATEOAS links
+// DataResponse wraps a successful response with optional HATEOAS links and
+// the actions the caller may take on it
 type DataResponse struct {
-	Data  interface{}       `json:"data"`
-	Links map[string]string `json:"_links,omitempty"`
+	Data    interface{}       `json:"data"`
+	Links   map[string]string `json:"_links,omitempty"`
+	Actions model.Affordances `json:"_actions,omitempty"`
 }
 
 // CollectionResponse wraps a collection response with pagination
@@ -22,8 +24,9 @@ type CollectionResponse struct {
 
 // PaginationInfo contains cursor-based pagination info
 type PaginationInfo struct {
-	Cursor  string `json:"cursor,omitempty"`
-	HasMore bool   `json:"has_more"`
+	Cursor     string `json:"cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
 }
 
 // WriteJSON writes a JSON response with the given status code
@@ -44,6 +47,24 @@ func WriteData(w http.ResponseWriter, status int, data interface{}, links map[st
 	WriteJSON(w, status, response)
 }
 
+// WriteResource writes a single resource with its affordances, adding a link
+// to the endpoint of each action the caller may take
+func WriteResource(w http.ResponseWriter, status int, data interface{}, links map[string]string, actions model.Affordances, endpoints map[model.Action]string) {
+	if links == nil {
+		links = make(map[string]string)
+	}
+	for _, action := range actions.Allowed() {
+		if href, ok := endpoints[action]; ok {
+			links[string(action)] = href
+		}
+	}
+	WriteJSON(w, status, DataResponse{
+		Data:    data,
+		Links:   links,
+		Actions: actions,
+	})
+}
+
 // WriteCollection writes a collection response with pagination
 func WriteCollection(w http.ResponseWriter, status int, data interface{}, pagination *PaginationInfo, links map[string]string) {
 	response := CollectionResponse{
@@ -3,7 +3,6 @@ package handler
 import (
 	"errors"
 	"net/http"
-	"strconv"
 
 	"github.com/forgo/saga/api/internal/middleware"
 	"github.com/forgo/saga/api/internal/model"
@@ -36,21 +35,7 @@ func (h *ReviewHandler) CreateReview(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate
-	var fieldErrors []model.FieldError
-	if req.RevieweeID == "" {
-		fieldErrors = append(fieldErrors, model.FieldError{
-			Field:   "reviewee_id",
-			Message: "reviewee_id is required",
-		})
-	}
-	if req.Context == "" {
-		fieldErrors = append(fieldErrors, model.FieldError{
-			Field:   "context",
-			Message: "context is required",
-		})
-	}
-	if len(fieldErrors) > 0 {
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
 		WriteError(w, model.NewValidationError(fieldErrors))
 		return
 	}
@@ -99,29 +84,21 @@ func (h *ReviewHandler) GetReviewsGiven(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	limit := 20
-	if r.URL.Query().Get("limit") != "" {
-		if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= 50 {
-			limit = l
-		}
-	}
-
-	offset := 0
-	if r.URL.Query().Get("offset") != "" {
-		if o, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && o >= 0 {
-			offset = o
-		}
+	page, problem := getPageRequest(r, model.ListQuery{})
+	if problem != nil {
+		WriteError(w, problem)
+		return
 	}
 
-	reviews, err := h.reviewService.GetReviewsGiven(r.Context(), userID, limit, offset)
+	reviews, err := h.reviewService.GetReviewsGiven(r.Context(), userID, page)
 	if err != nil {
-		WriteError(w, model.NewInternalError("failed to get reviews"))
+		if !writeCursorError(w, err) {
+			WriteError(w, model.NewInternalError("failed to get reviews"))
+		}
 		return
 	}
 
-	WriteCollection(w, http.StatusOK, reviews, nil, map[string]string{
-		"self": "/v1/profile/reviews/given",
-	})
+	writePage(w, r, reviews, "/v1/profile/reviews/given")
 }
 
 // GetReviewsReceived handles GET /v1/profile/reviews/received - reviews you've received
@@ -132,29 +109,21 @@ func (h *ReviewHandler) GetReviewsReceived(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	limit := 20
-	if r.URL.Query().Get("limit") != "" {
-		if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= 50 {
-			limit = l
-		}
-	}
-
-	offset := 0
-	if r.URL.Query().Get("offset") != "" {
-		if o, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && o >= 0 {
-			offset = o
-		}
+	page, problem := getPageRequest(r, model.ListQuery{})
+	if problem != nil {
+		WriteError(w, problem)
+		return
 	}
 
-	reviews, err := h.reviewService.GetReviewsReceived(r.Context(), userID, limit, offset)
+	reviews, err := h.reviewService.GetReviewsReceived(r.Context(), userID, page)
 	if err != nil {
-		WriteError(w, model.NewInternalError("failed to get reviews"))
+		if !writeCursorError(w, err) {
+			WriteError(w, model.NewInternalError("failed to get reviews"))
+		}
 		return
 	}
 
-	WriteCollection(w, http.StatusOK, reviews, nil, map[string]string{
-		"self": "/v1/profile/reviews/received",
-	})
+	writePage(w, r, reviews, "/v1/profile/reviews/received")
 }
 
 // GetMyReputation handles GET /v1/profile/reputation - get own reputation
@@ -201,22 +170,6 @@ func (h *ReviewHandler) GetUserReputation(w http.ResponseWriter, r *http.Request
 	})
 }
 
-// GetPositiveTags handles GET /v1/reviews/tags/positive - list positive tags
-func (h *ReviewHandler) GetPositiveTags(w http.ResponseWriter, r *http.Request) {
-	tags := model.GetPositiveTags()
-	WriteCollection(w, http.StatusOK, tags, nil, map[string]string{
-		"self": "/v1/reviews/tags/positive",
-	})
-}
-
-// GetImprovementTags handles GET /v1/reviews/tags/improvement - list improvement tags
-func (h *ReviewHandler) GetImprovementTags(w http.ResponseWriter, r *http.Request) {
-	tags := model.GetImprovementTags()
-	WriteCollection(w, http.StatusOK, tags, nil, map[string]string{
-		"self": "/v1/reviews/tags/improvement",
-	})
-}
-
 func (h *ReviewHandler) handleReviewError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, service.ErrReviewNotFound):
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// SearchHandler handles full-text search
+type SearchHandler struct {
+	searchService *service.SearchService
+}
+
+// NewSearchHandler creates a new search handler
+func NewSearchHandler(searchService *service.SearchService) *SearchHandler {
+	return &SearchHandler{searchService: searchService}
+}
+
+// Search handles GET /v1/search - find public guilds, events, interests
+// and people by name
+// Query parameters:
+//   - q: search text (required, 2-100 characters)
+//   - type: guild, event, interest or user; comma separated or repeated (optional, default all)
+//   - limit: max results per type (optional, default: 20, max: 50)
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	query := r.URL.Query()
+	var types []string
+	for _, value := range query["type"] {
+		for _, t := range strings.Split(value, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				types = append(types, t)
+			}
+		}
+	}
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	results, err := h.searchService.Search(r.Context(), userID, query.Get("q"), types, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrSearchQueryLength):
+			WriteError(w, model.NewValidationError([]model.FieldError{
+				{Field: "q", Message: fmt.Sprintf("must be %d to %d characters", model.MinSearchQueryLength, model.MaxSearchQueryLength)},
+			}))
+		case errors.Is(err, service.ErrInvalidSearchType):
+			WriteError(w, model.NewValidationError([]model.FieldError{
+				{Field: "type", Message: "must be guild, event, interest or user"},
+			}))
+		default:
+			WriteError(w, model.NewInternalError("search failed"))
+		}
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, results, nil, map[string]string{
+		"self": r.URL.RequestURI(),
+	})
+}
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// ShareLinkHandler handles share link endpoints
+type ShareLinkHandler struct {
+	shareLinkService *service.ShareLinkService
+}
+
+// NewShareLinkHandler creates a new share link handler
+func NewShareLinkHandler(shareLinkService *service.ShareLinkService) *ShareLinkHandler {
+	return &ShareLinkHandler{shareLinkService: shareLinkService}
+}
+
+// Create handles POST /v1/share-links - create a share or invite link
+func (h *ShareLinkHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.CreateShareLinkRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	link, err := h.shareLinkService.CreateLink(r.Context(), userID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, link, map[string]string{
+		"self":   "/v1/share-links/" + link.Token,
+		"target": shareTargetPath(link.TargetType, link.TargetID),
+	})
+}
+
+// Resolve handles GET /v1/share-links/{token} - resolve a share token.
+// Unauthenticated; the preview is redacted for non-public targets.
+func (h *ShareLinkHandler) Resolve(w http.ResponseWriter, r *http.Request) {
+	token := r.PathValue("token")
+
+	resolution, err := h.shareLinkService.Resolve(r.Context(), token)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, resolution, map[string]string{
+		"self":   "/v1/share-links/" + token,
+		"target": shareTargetPath(resolution.TargetType, resolution.TargetID),
+	})
+}
+
+// Accept handles POST /v1/share-links/{token}/accept - redeem an invite link
+func (h *ShareLinkHandler) Accept(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	token := r.PathValue("token")
+
+	resolution, err := h.shareLinkService.Accept(r.Context(), userID, token)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, resolution, map[string]string{
+		"self":   "/v1/share-links/" + token,
+		"target": shareTargetPath(resolution.TargetType, resolution.TargetID),
+	})
+}
+
+// Revoke handles DELETE /v1/share-links/{token} - revoke a link you created
+func (h *ShareLinkHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	if err := h.shareLinkService.Revoke(r.Context(), userID, r.PathValue("token")); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// Preview handles GET /v1/preview/{token} - OpenGraph metadata and
+// structured data for unfurling a share link. Unauthenticated.
+func (h *ShareLinkHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	token := r.PathValue("token")
+
+	preview, err := h.shareLinkService.Unfurl(r.Context(), token)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, preview, map[string]string{
+		"self":  "/v1/preview/" + token,
+		"share": "/v1/share-links/" + token,
+	})
+}
+
+// Page handles GET /s/{token} - a minimal HTML page carrying the share link's
+// OpenGraph and Twitter meta tags and JSON-LD, for crawlers that unfurl links.
+// Dead links still get a page so the unfurl shows something sensible.
+func (h *ShareLinkHandler) Page(w http.ResponseWriter, r *http.Request) {
+	status := http.StatusOK
+	preview, err := h.shareLinkService.Unfurl(r.Context(), r.PathValue("token"))
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrShareLinkExpired), errors.Is(err, service.ErrShareLinkExhausted):
+		status = http.StatusGone
+		preview = unavailableSharePreview("This link has expired.")
+	case errors.Is(err, service.ErrShareLinkNotFound):
+		status = http.StatusNotFound
+		preview = unavailableSharePreview("This link is no longer available.")
+	default:
+		slog.Error("rendering share page", "error", err)
+		status = http.StatusInternalServerError
+		preview = unavailableSharePreview("Something went wrong.")
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(status)
+	if err := sharePageTemplate.Execute(w, preview); err != nil {
+		slog.Error("writing share page", "error", err)
+	}
+}
+
+func unavailableSharePreview(description string) *model.OpenGraphPreview {
+	return &model.OpenGraphPreview{
+		Title:       model.ShareSiteName,
+		Description: description,
+		Type:        "website",
+		SiteName:    model.ShareSiteName,
+		Restricted:  true,
+	}
+}
+
+var sharePageTemplate = template.Must(template.New("share").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<meta name="description" content="{{.Description}}">
+{{- if .Restricted}}
+<meta name="robots" content="noindex">
+{{- end}}
+<meta property="og:title" content="{{.Title}}">
+<meta property="og:description" content="{{.Description}}">
+<meta property="og:type" content="{{.Type}}">
+<meta property="og:site_name" content="{{.SiteName}}">
+{{- if .URL}}
+<meta property="og:url" content="{{.URL}}">
+<link rel="canonical" href="{{.URL}}">
+{{- end}}
+{{- if .ImageURL}}
+<meta property="og:image" content="{{.ImageURL}}">
+<meta name="twitter:card" content="summary_large_image">
+<meta name="twitter:image" content="{{.ImageURL}}">
+{{- else}}
+<meta name="twitter:card" content="summary">
+{{- end}}
+<meta name="twitter:title" content="{{.Title}}">
+<meta name="twitter:description" content="{{.Description}}">
+{{- if .StructuredData}}
+<script type="application/ld+json">{{.StructuredData}}</script>
+{{- end}}
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Description}}</p>
+{{- if .URL}}
+<p><a href="{{.URL}}">Open in {{.SiteName}}</a></p>
+{{- end}}
+</body>
+</html>
+`))
+
+// shareTargetPath returns the API path of a share link's target
+func shareTargetPath(targetType model.ShareTargetType, targetID string) string {
+	switch targetType {
+	case model.ShareTargetEvent:
+		return "/v1/events/" + targetID
+	case model.ShareTargetGuild:
+		return "/v1/guilds/" + targetID
+	case model.ShareTargetAdventure:
+		return "/v1/adventures/" + targetID
+	}
+	return ""
+}
+
+func (h *ShareLinkHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrShareLinkNotFound):
+		WriteError(w, model.NewNotFoundError("share link"))
+	case errors.Is(err, service.ErrShareTargetNotFound):
+		WriteError(w, model.NewNotFoundError("share target"))
+	case errors.Is(err, service.ErrShareLinkExpired):
+		WriteError(w, model.NewGoneError("share link has expired"))
+	case errors.Is(err, service.ErrShareLinkExhausted):
+		WriteError(w, model.NewGoneError("invite link has no uses left"))
+	case errors.Is(err, service.ErrShareLinkNotInvite):
+		WriteError(w, model.NewBadRequestError("share link is not an invite"))
+	case errors.Is(err, service.ErrShareNotAllowed):
+		WriteError(w, model.NewForbiddenError("not authorized to perform this action"))
+	case errors.Is(err, service.ErrAlreadyGuildMember):
+		WriteError(w, model.NewConflictError("already a member of this guild"))
+	case errors.Is(err, service.ErrMaxGuildsReached):
+		WriteError(w, model.NewLimitExceededError("guilds", model.MaxGuildsPerUser, model.MaxGuildsPerUser))
+	case errors.Is(err, service.ErrMaxMembersReached):
+		WriteError(w, model.NewLimitExceededError("guild members", model.MaxMembersPerGuild, model.MaxMembersPerGuild))
+	default:
+		WriteError(w, model.NewInternalError("share link operation failed"))
+	}
+}
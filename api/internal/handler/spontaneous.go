@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// SpontaneousHandler handles "available right now" windows and the alerts
+// they send
+type SpontaneousHandler struct {
+	spontaneousService *service.SpontaneousService
+}
+
+// NewSpontaneousHandler creates a new spontaneous handler
+func NewSpontaneousHandler(spontaneousService *service.SpontaneousService) *SpontaneousHandler {
+	return &SpontaneousHandler{spontaneousService: spontaneousService}
+}
+
+// GoAvailableNow handles POST /v1/availability/now - go available right now,
+// or extend the window if already available
+func (h *SpontaneousHandler) GoAvailableNow(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.GoAvailableNowRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	av, err := h.spontaneousService.GoAvailableNow(r.Context(), userID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, av, map[string]string{
+		"self":         "/v1/availability/now",
+		"availability": "/v1/availability/" + av.ID,
+	})
+}
+
+// GetAvailableNow handles GET /v1/availability/now - get the user's
+// spontaneous window
+func (h *SpontaneousHandler) GetAvailableNow(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	av, err := h.spontaneousService.GetAvailableNow(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, av, map[string]string{
+		"self":         "/v1/availability/now",
+		"availability": "/v1/availability/" + av.ID,
+	})
+}
+
+// StopAvailableNow handles DELETE /v1/availability/now - end the user's
+// spontaneous window early
+func (h *SpontaneousHandler) StopAvailableNow(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	if err := h.spontaneousService.StopAvailableNow(r.Context(), userID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// GetAlertPreference handles GET /v1/profile/spontaneous-alerts - get
+// spontaneous alert preferences
+func (h *SpontaneousHandler) GetAlertPreference(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	pref, err := h.spontaneousService.GetAlertPreference(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, pref, map[string]string{
+		"self": "/v1/profile/spontaneous-alerts",
+	})
+}
+
+// UpdateAlertPreference handles PATCH /v1/profile/spontaneous-alerts - opt in
+// or out of spontaneous alerts
+func (h *SpontaneousHandler) UpdateAlertPreference(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	var req model.UpdateSpontaneousAlertPreferenceRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	pref, err := h.spontaneousService.UpdateAlertPreference(r.Context(), userID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, pref, map[string]string{
+		"self": "/v1/profile/spontaneous-alerts",
+	})
+}
+
+// handleError converts service errors to HTTP responses
+func (h *SpontaneousHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	var limited *service.SpontaneousLimitError
+	if errors.As(err, &limited) {
+		retryAfter := retryAfterSeconds(limited.RetryAt)
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		WriteError(w, model.NewRateLimitError(retryAfter))
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrNotAvailableNow):
+		WriteError(w, model.NewNotFoundError("spontaneous availability"))
+	case errors.Is(err, service.ErrInvalidHangoutType):
+		WriteError(w, model.NewValidationError([]model.FieldError{
+			{Field: "hangout_type", Message: "invalid hangout type"},
+		}))
+	case errors.Is(err, service.ErrLocationConsentRequired):
+		WriteError(w, model.NewForbiddenError("location processing consent required to go available now"))
+	default:
+		WriteError(w, model.NewInternalError("spontaneous availability operation failed"))
+	}
+}
+
+// retryAfterSeconds is the Retry-After value for retrying at t, at least 1
+func retryAfterSeconds(t time.Time) int {
+	seconds := int(math.Ceil(time.Until(t).Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
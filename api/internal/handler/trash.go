@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// TrashHandler handles a guild's trash of deleted events, pools and votes
+type TrashHandler struct {
+	trashService *service.TrashService
+}
+
+// NewTrashHandler creates a new trash handler
+func NewTrashHandler(trashService *service.TrashService) *TrashHandler {
+	return &TrashHandler{trashService: trashService}
+}
+
+// List handles GET /v1/guilds/{guildId}/trash - deleted content that can
+// still be restored
+func (h *TrashHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	items, err := h.trashService.List(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, items, nil, map[string]string{
+		"self": "/v1/guilds/" + guildID + "/trash",
+	})
+}
+
+// Restore handles POST /v1/guilds/{guildId}/trash/{itemId}/restore - take an
+// item out of the trash
+func (h *TrashHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	item, err := h.trashService.Restore(r.Context(), userID, guildID, r.PathValue("itemId"))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, item, trashItemLinks(item))
+}
+
+func trashItemLinks(item *model.TrashItem) map[string]string {
+	switch item.Type {
+	case model.TrashItemEvent:
+		return map[string]string{"resource": "/v1/events/" + item.ID}
+	case model.TrashItemPool:
+		return map[string]string{"resource": "/v1/guilds/" + item.GuildID + "/pools/" + item.ID}
+	case model.TrashItemVote:
+		return map[string]string{"resource": "/v1/votes/" + item.ID}
+	}
+	return nil
+}
+
+func (h *TrashHandler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotGuildAdmin):
+		WriteError(w, model.NewForbiddenError("only guild organizers can manage the trash"))
+	case errors.Is(err, service.ErrTrashItemNotFound):
+		WriteError(w, model.NewNotFoundError("trash item"))
+	case errors.Is(err, service.ErrPoolLimitReached):
+		WriteError(w, model.NewLimitExceededError("maximum pools per guild reached", model.MaxPoolsPerGuild, model.MaxPoolsPerGuild))
+	default:
+		WriteError(w, model.NewInternalError("trash operation failed"))
+	}
+}
@@ -106,15 +106,26 @@ func (h *TrustRatingHandler) GetReceivedRatings(w http.ResponseWriter, r *http.R
 	ctx := r.Context()
 	targetUserID := r.PathValue("userId")
 
-	limit, offset := getPaginationParams(r)
+	list, fieldErrors := model.TrustRatingListFields.Parse(r.URL.Query())
+	if len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+	page, problem := getPageRequest(r, list)
+	if problem != nil {
+		WriteError(w, problem)
+		return
+	}
 
-	ratings, err := h.svc.GetReceivedRatings(ctx, targetUserID, limit, offset)
+	ratings, err := h.svc.GetReceivedRatings(ctx, targetUserID, list, page)
 	if err != nil {
-		h.handleError(w, err)
+		if !writeCursorError(w, err) {
+			h.handleError(w, err)
+		}
 		return
 	}
 
-	WriteCollection(w, http.StatusOK, ratings, nil, nil)
+	writePage(w, r, ratings, "/v1/users/"+targetUserID+"/trust-ratings/received")
 }
 
 // GetGivenRatings handles GET /v1/users/{userId}/trust-ratings/given
@@ -122,15 +133,26 @@ func (h *TrustRatingHandler) GetGivenRatings(w http.ResponseWriter, r *http.Requ
 	ctx := r.Context()
 	targetUserID := r.PathValue("userId")
 
-	limit, offset := getPaginationParams(r)
+	list, fieldErrors := model.TrustRatingListFields.Parse(r.URL.Query())
+	if len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+	page, problem := getPageRequest(r, list)
+	if problem != nil {
+		WriteError(w, problem)
+		return
+	}
 
-	ratings, err := h.svc.GetGivenRatings(ctx, targetUserID, limit, offset)
+	ratings, err := h.svc.GetGivenRatings(ctx, targetUserID, list, page)
 	if err != nil {
-		h.handleError(w, err)
+		if !writeCursorError(w, err) {
+			h.handleError(w, err)
+		}
 		return
 	}
 
-	WriteCollection(w, http.StatusOK, ratings, nil, nil)
+	writePage(w, r, ratings, "/v1/users/"+targetUserID+"/trust-ratings/given")
 }
 
 // GetAggregate handles GET /v1/users/{userId}/trust-aggregate
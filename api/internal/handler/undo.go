@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// UndoHandler handles undoing destructive actions
+type UndoHandler struct {
+	undoService *service.UndoService
+}
+
+// NewUndoHandler creates a new undo handler
+func NewUndoHandler(undoService *service.UndoService) *UndoHandler {
+	return &UndoHandler{undoService: undoService}
+}
+
+// Undo handles POST /v1/undo/{operationId} - reverse a recent destructive
+// action
+func (h *UndoHandler) Undo(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	op, err := h.undoService.Undo(r.Context(), userID, r.PathValue("operationId"))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUndoNotFound):
+			WriteError(w, model.NewNotFoundError("undo operation"))
+		case errors.Is(err, service.ErrUndoUnavailable):
+			WriteError(w, model.NewConflictError(err.Error()))
+		default:
+			WriteError(w, model.NewInternalError("undo failed"))
+		}
+		return
+	}
+
+	WriteData(w, http.StatusOK, op, undoResourceLinks(op))
+}
+
+// writeUndoable responds to a destructive action. When the action can be
+// undone the operation is returned with a link to undo it; otherwise there's
+// no content.
+func writeUndoable(w http.ResponseWriter, op *model.UndoOperation) {
+	if op == nil {
+		WriteNoContent(w)
+		return
+	}
+	WriteData(w, http.StatusOK, op, map[string]string{
+		"undo": "/v1/undo/" + op.ID,
+	})
+}
+
+func undoResourceLinks(op *model.UndoOperation) map[string]string {
+	switch op.Type {
+	case model.UndoAvailabilityDelete:
+		return map[string]string{"resource": "/v1/availability/" + op.ResourceID}
+	case model.UndoGuildLeave:
+		return map[string]string{"resource": "/v1/guilds/" + op.ResourceID}
+	case model.UndoRSVPCancel:
+		if eventID, ok := op.Data["event_id"].(string); ok {
+			return map[string]string{"resource": "/v1/events/" + eventID}
+		}
+	}
+	return nil
+}
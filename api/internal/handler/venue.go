@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/middleware"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// VenueHandler handles guilds' saved venues
+type VenueHandler struct {
+	venueService *service.VenueService
+}
+
+// NewVenueHandler creates a new venue handler
+func NewVenueHandler(venueService *service.VenueService) *VenueHandler {
+	return &VenueHandler{venueService: venueService}
+}
+
+// List handles GET /v1/guilds/{guildId}/venues - list a guild's venues
+func (h *VenueHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	venues, err := h.venueService.ListGuildVenues(r.Context(), userID, guildID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteCollection(w, http.StatusOK, venues, nil, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/venues",
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// Get handles GET /v1/guilds/{guildId}/venues/{venueId} - get a venue
+func (h *VenueHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	venueID := r.PathValue("venueId")
+	if guildID == "" || venueID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and venue ID required"))
+		return
+	}
+
+	venue, err := h.venueService.GetVenue(r.Context(), userID, guildID, venueID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, venue, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/venues/" + venueID,
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// Create handles POST /v1/guilds/{guildId}/venues - save a venue, geocoding
+// its address when no coordinates are given
+func (h *VenueHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	if guildID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID required"))
+		return
+	}
+
+	var req model.CreateVenueRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	venue, err := h.venueService.CreateVenue(r.Context(), userID, guildID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusCreated, venue, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/venues/" + venue.ID,
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// Update handles PATCH /v1/guilds/{guildId}/venues/{venueId} - change a
+// venue (its creator or a guild admin)
+func (h *VenueHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	venueID := r.PathValue("venueId")
+	if guildID == "" || venueID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and venue ID required"))
+		return
+	}
+
+	var req model.UpdateVenueRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+
+	venue, err := h.venueService.UpdateVenue(r.Context(), userID, guildID, venueID, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, venue, map[string]string{
+		"self":  "/v1/guilds/" + guildID + "/venues/" + venueID,
+		"guild": "/v1/guilds/" + guildID,
+	})
+}
+
+// Delete handles DELETE /v1/guilds/{guildId}/venues/{venueId} - remove a
+// venue (its creator or a guild admin)
+func (h *VenueHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	guildID := r.PathValue("guildId")
+	venueID := r.PathValue("venueId")
+	if guildID == "" || venueID == "" {
+		WriteError(w, model.NewBadRequestError("guild ID and venue ID required"))
+		return
+	}
+
+	if err := h.venueService.DeleteVenue(r.Context(), userID, guildID, venueID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteNoContent(w)
+}
+
+// handleError converts service errors to HTTP responses
+func (h *VenueHandler) handleError(w http.ResponseWriter, err error) {
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) {
+		WriteError(w, pd)
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrVenueNotFound):
+		WriteError(w, model.NewNotFoundError("venue"))
+	case errors.Is(err, service.ErrNotGuildMember):
+		WriteError(w, model.NewForbiddenError("not a guild member"))
+	case errors.Is(err, service.ErrNotVenueCreator):
+		WriteError(w, model.NewForbiddenError(err.Error()))
+	case errors.Is(err, service.ErrAddressNotFound):
+		WriteError(w, model.NewValidationError([]model.FieldError{{Field: "address", Message: err.Error()}}))
+	case errors.Is(err, service.ErrTooManyVenues):
+		WriteError(w, model.NewLimitExceededError("maximum venues per guild reached", model.MaxGuildVenues, model.MaxGuildVenues))
+	default:
+		WriteError(w, model.NewInternalError("venue operation failed"))
+	}
+}
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/forgo/saga/api/internal/middleware"
 	"github.com/forgo/saga/api/internal/model"
@@ -57,7 +56,14 @@ func (h *VoteHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	WriteData(w, http.StatusOK, vote, nil)
+	self := "/v1/votes/" + voteID
+	WriteResource(w, http.StatusOK, vote, map[string]string{
+		"self": self,
+	}, h.svc.Affordances(vote, userID), map[model.Action]string{
+		model.ActionEdit:   self,
+		model.ActionCancel: self + "/cancel",
+		model.ActionVote:   self + "/ballot",
+	})
 }
 
 // Update handles PATCH /v1/votes/{voteId}
@@ -103,6 +109,37 @@ func (h *VoteHandler) Open(w http.ResponseWriter, r *http.Request) {
 	WriteData(w, http.StatusOK, map[string]string{"status": "opened"}, nil)
 }
 
+// SchedulePublication handles PUT /v1/votes/{voteId}/schedule - schedule or
+// reschedule when a draft vote is published
+func (h *VoteHandler) SchedulePublication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		WriteError(w, model.NewUnauthorizedError("authentication required"))
+		return
+	}
+	voteID := r.PathValue("voteId")
+
+	var req model.SchedulePublicationRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, model.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	if errors := req.Validate(); len(errors) > 0 {
+		WriteError(w, model.NewValidationError(errors))
+		return
+	}
+
+	vote, err := h.svc.SchedulePublication(ctx, voteID, userID, *req.PublishAt)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	WriteData(w, http.StatusOK, vote, nil)
+}
+
 // Close handles POST /v1/votes/{voteId}/close
 func (h *VoteHandler) Close(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -140,7 +177,6 @@ func (h *VoteHandler) Cancel(w http.ResponseWriter, r *http.Request) {
 }
 
 // Delete handles DELETE /v1/votes/{voteId}
-// Note: Vote deletion is handled via Cancel for now
 func (h *VoteHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	userID := middleware.GetUserID(ctx)
@@ -150,8 +186,8 @@ func (h *VoteHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 	voteID := r.PathValue("voteId")
 
-	// Votes are cancelled rather than deleted to maintain audit trail
-	if err := h.svc.Cancel(ctx, voteID, userID); err != nil {
+	// Guild votes go to the guild's trash; global votes are cancelled
+	if err := h.svc.Delete(ctx, voteID, userID); err != nil {
 		h.handleError(w, err)
 		return
 	}
@@ -333,32 +369,52 @@ func (h *VoteHandler) GetGuildVotes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	guildID := r.PathValue("guildId")
 
-	limit, offset := getVotePaginationParams(r)
-	status := getStatusFilterString(r)
+	list, fieldErrors := getVoteListQuery(r)
+	if len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+	page, problem := getPageRequest(r, list)
+	if problem != nil {
+		WriteError(w, problem)
+		return
+	}
 
-	votes, err := h.svc.GetGuildVotes(ctx, guildID, status, limit, offset)
+	votes, err := h.svc.GetGuildVotes(ctx, guildID, list, page)
 	if err != nil {
-		h.handleError(w, err)
+		if !writeCursorError(w, err) {
+			h.handleError(w, err)
+		}
 		return
 	}
 
-	WriteCollection(w, http.StatusOK, votes, nil, nil)
+	writePage(w, r, votes, "/v1/guilds/"+guildID+"/votes")
 }
 
 // GetGlobalVotes handles GET /v1/votes/global
 func (h *VoteHandler) GetGlobalVotes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	limit, offset := getVotePaginationParams(r)
-	status := getStatusFilterString(r)
+	list, fieldErrors := getVoteListQuery(r)
+	if len(fieldErrors) > 0 {
+		WriteError(w, model.NewValidationError(fieldErrors))
+		return
+	}
+	page, problem := getPageRequest(r, list)
+	if problem != nil {
+		WriteError(w, problem)
+		return
+	}
 
-	votes, err := h.svc.GetGlobalVotes(ctx, status, limit, offset)
+	votes, err := h.svc.GetGlobalVotes(ctx, list, page)
 	if err != nil {
-		h.handleError(w, err)
+		if !writeCursorError(w, err) {
+			h.handleError(w, err)
+		}
 		return
 	}
 
-	WriteCollection(w, http.StatusOK, votes, nil, nil)
+	writePage(w, r, votes, "/v1/votes/global")
 }
 
 // GetVoteStats handles GET /v1/votes/{voteId}/stats
@@ -437,30 +493,12 @@ func (h *VoteHandler) handleError(w http.ResponseWriter, err error) {
 	WriteError(w, model.NewInternalError("internal server error"))
 }
 
-// Helper function for status filter (returns *string for service calls)
-func getStatusFilterString(r *http.Request) *string {
-	if v := r.URL.Query().Get("status"); v != "" {
-		return &v
-	}
-	return nil
-}
-
-// Helper function for pagination (vote-specific to avoid redeclaration)
-func getVotePaginationParams(r *http.Request) (limit, offset int) {
-	limit = 50
-	offset = 0
-
-	if v := r.URL.Query().Get("limit"); v != "" {
-		if parsed, err := strconv.Atoi(v); err == nil && parsed > 0 && parsed <= 100 {
-			limit = parsed
-		}
-	}
-
-	if v := r.URL.Query().Get("offset"); v != "" {
-		if parsed, err := strconv.Atoi(v); err == nil && parsed >= 0 {
-			offset = parsed
-		}
+// getVoteListQuery reads a vote list's sort and filters. ?status= is kept
+// as shorthand for filter[status].
+func getVoteListQuery(r *http.Request) (model.ListQuery, []model.FieldError) {
+	list, fieldErrors := model.VoteListFields.Parse(r.URL.Query())
+	if status := r.URL.Query().Get("status"); status != "" {
+		list = list.WithFilter("status", status)
 	}
-
-	return limit, offset
+	return list, fieldErrors
 }
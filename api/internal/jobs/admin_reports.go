@@ -0,0 +1,122 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AdminReports generates queued admin reports and deletes expired report
+// files from object storage
+type AdminReports struct {
+	reportService *service.AdminReportService
+	interval      time.Duration
+	stopCh        chan struct{}
+	wg            sync.WaitGroup
+	running       bool
+	mu            sync.Mutex
+}
+
+// NewAdminReports creates a new admin reports job
+func NewAdminReports(reportService *service.AdminReportService, interval time.Duration) *AdminReports {
+	if interval == 0 {
+		interval = 15 * time.Second // Default check every 15 seconds so reports start promptly
+	}
+	return &AdminReports{
+		reportService: reportService,
+		interval:      interval,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start begins the admin reports job
+func (j *AdminReports) Start() {
+	j.mu.Lock()
+	if j.running {
+		j.mu.Unlock()
+		return
+	}
+	j.running = true
+	j.mu.Unlock()
+
+	j.wg.Add(1)
+	go j.run()
+	log.Printf("Admin reports started (interval: %v)", j.interval)
+}
+
+// Stop gracefully stops the admin reports job
+func (j *AdminReports) Stop() {
+	j.mu.Lock()
+	if !j.running {
+		j.mu.Unlock()
+		return
+	}
+	j.running = false
+	j.mu.Unlock()
+
+	close(j.stopCh)
+	j.wg.Wait()
+	log.Println("Admin reports stopped")
+}
+
+// run is the main loop
+func (j *AdminReports) run() {
+	defer j.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	j.process()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			j.process()
+		case <-j.stopCh:
+			return
+		}
+	}
+}
+
+// process generates pending reports, then expires old ones
+func (j *AdminReports) process() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	generated, err := j.reportService.ProcessPending(ctx)
+	if err != nil {
+		log.Printf("Error generating admin reports: %v", err)
+	}
+	if generated > 0 {
+		log.Printf("Generated %d admin reports", generated)
+	}
+
+	expired, err := j.reportService.ExpireOld(ctx)
+	if err != nil {
+		log.Printf("Error expiring admin reports: %v", err)
+	}
+	if expired > 0 {
+		log.Printf("Expired %d admin reports", expired)
+	}
+}
+
+// RunOnce runs the job once (for testing or manual trigger)
+func (j *AdminReports) RunOnce(ctx context.Context) error {
+	if _, err := j.reportService.ProcessPending(ctx); err != nil {
+		return err
+	}
+	_, err := j.reportService.ExpireOld(ctx)
+	return err
+}
+
+// IsRunning returns whether the admin reports job is running
+func (j *AdminReports) IsRunning() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.running
+}
@@ -0,0 +1,158 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AnalyticsExport writes each completed UTC day of analytics events to an
+// NDJSON file in the export directory for warehouses to pick up
+type AnalyticsExport struct {
+	analyticsEventService *service.AnalyticsEventService
+	dir                   string
+	interval              time.Duration
+	clock                 clock.Clock
+	stopCh                chan struct{}
+	wg                    sync.WaitGroup
+	running               bool
+	mu                    sync.Mutex
+}
+
+// NewAnalyticsExport creates a new analytics export job. A nil clock means
+// the system clock.
+func NewAnalyticsExport(analyticsEventService *service.AnalyticsEventService, dir string, interval time.Duration, clk clock.Clock) *AnalyticsExport {
+	if interval == 0 {
+		interval = 1 * time.Hour // Default check every hour
+	}
+	return &AnalyticsExport{
+		analyticsEventService: analyticsEventService,
+		dir:                   dir,
+		interval:              interval,
+		clock:                 clock.OrReal(clk),
+		stopCh:                make(chan struct{}),
+	}
+}
+
+// Start begins the analytics export job
+func (e *AnalyticsExport) Start() {
+	e.mu.Lock()
+	if e.running {
+		e.mu.Unlock()
+		return
+	}
+	e.running = true
+	e.mu.Unlock()
+
+	e.wg.Add(1)
+	go e.run()
+	log.Printf("Analytics export started (interval: %v, dir: %s)", e.interval, e.dir)
+}
+
+// Stop gracefully stops the analytics export job
+func (e *AnalyticsExport) Stop() {
+	e.mu.Lock()
+	if !e.running {
+		e.mu.Unlock()
+		return
+	}
+	e.running = false
+	e.mu.Unlock()
+
+	close(e.stopCh)
+	e.wg.Wait()
+	log.Println("Analytics export stopped")
+}
+
+// run is the main loop
+func (e *AnalyticsExport) run() {
+	defer e.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	e.exportPreviousDay()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.exportPreviousDay()
+		case <-e.stopCh:
+			return
+		}
+	}
+}
+
+// exportPreviousDay writes yesterday's events unless the file already exists
+func (e *AnalyticsExport) exportPreviousDay() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	path, written, err := e.export(ctx, e.clock.Now().UTC())
+	if err != nil {
+		log.Printf("Error exporting analytics events: %v", err)
+		return
+	}
+	if path != "" {
+		log.Printf("Exported %d analytics events to %s", written, path)
+	}
+}
+
+// export writes the UTC day before now to analytics-events-YYYY-MM-DD.ndjson.
+// The file is written under a temporary name and renamed once complete, so
+// a file that exists is always a finished export and is left alone.
+func (e *AnalyticsExport) export(ctx context.Context, now time.Time) (string, int, error) {
+	until := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	since := until.AddDate(0, 0, -1)
+	path := filepath.Join(e.dir, fmt.Sprintf("analytics-events-%s.ndjson", since.Format("2006-01-02")))
+
+	if _, err := os.Stat(path); err == nil {
+		return "", 0, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", 0, err
+	}
+
+	if err := os.MkdirAll(e.dir, 0o750); err != nil {
+		return "", 0, err
+	}
+	tmp, err := os.CreateTemp(e.dir, ".analytics-events-*.tmp")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := e.analyticsEventService.Export(ctx, since, until, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, err
+	}
+	return path, written, nil
+}
+
+// RunOnce runs the export once (for testing or manual trigger)
+func (e *AnalyticsExport) RunOnce(ctx context.Context) error {
+	_, _, err := e.export(ctx, e.clock.Now().UTC())
+	return err
+}
+
+// IsRunning returns whether the export job is running
+func (e *AnalyticsExport) IsRunning() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.running
+}
@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// AnnouncementProcessor delivers scheduled announcements once their send time passes
+type AnnouncementProcessor struct {
+	announcementService *service.AnnouncementService
+	interval            time.Duration
+	stopCh              chan struct{}
+	wg                  sync.WaitGroup
+	running             bool
+	mu                  sync.Mutex
+}
+
+// NewAnnouncementProcessor creates a new announcement processor job
+func NewAnnouncementProcessor(announcementService *service.AnnouncementService, interval time.Duration) *AnnouncementProcessor {
+	if interval == 0 {
+		interval = 1 * time.Minute // Default check every minute
+	}
+	return &AnnouncementProcessor{
+		announcementService: announcementService,
+		interval:            interval,
+		stopCh:              make(chan struct{}),
+	}
+}
+
+// Start begins the announcement processor job
+func (p *AnnouncementProcessor) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Announcement processor started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the announcement processor job
+func (p *AnnouncementProcessor) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Announcement processor stopped")
+}
+
+// run is the main loop
+func (p *AnnouncementProcessor) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.processAnnouncements()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.processAnnouncements()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// processAnnouncements delivers due announcements
+func (p *AnnouncementProcessor) processAnnouncements() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.announcementService.ProcessDueAnnouncements(ctx); err != nil {
+		log.Printf("Error processing announcements: %v", err)
+	}
+}
+
+// RunOnce runs announcement delivery once (for testing or manual trigger)
+func (p *AnnouncementProcessor) RunOnce(ctx context.Context) error {
+	return p.announcementService.ProcessDueAnnouncements(ctx)
+}
+
+// IsRunning returns whether the processor is running
+func (p *AnnouncementProcessor) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// ChurnRiskDetector finds users drifting toward inactivity and sends them win-back nudges
+type ChurnRiskDetector struct {
+	nudgeService *service.NudgeService
+	interval     time.Duration
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+	running      bool
+	mu           sync.Mutex
+}
+
+// NewChurnRiskDetector creates a new churn-risk detector job
+func NewChurnRiskDetector(nudgeService *service.NudgeService, interval time.Duration) *ChurnRiskDetector {
+	if interval == 0 {
+		interval = 6 * time.Hour // Default check every 6 hours
+	}
+	return &ChurnRiskDetector{
+		nudgeService: nudgeService,
+		interval:     interval,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start begins the churn-risk detector job
+func (d *ChurnRiskDetector) Start() {
+	d.mu.Lock()
+	if d.running {
+		d.mu.Unlock()
+		return
+	}
+	d.running = true
+	d.mu.Unlock()
+
+	d.wg.Add(1)
+	go d.run()
+	log.Printf("Churn-risk detector started (interval: %v)", d.interval)
+}
+
+// Stop gracefully stops the churn-risk detector job
+func (d *ChurnRiskDetector) Stop() {
+	d.mu.Lock()
+	if !d.running {
+		d.mu.Unlock()
+		return
+	}
+	d.running = false
+	d.mu.Unlock()
+
+	close(d.stopCh)
+	d.wg.Wait()
+	log.Println("Churn-risk detector stopped")
+}
+
+// run is the main loop
+func (d *ChurnRiskDetector) run() {
+	defer d.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	d.detect()
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.detect()
+		case <-d.stopCh:
+			return
+		}
+	}
+}
+
+// detect targets at-risk users and marks those who came back
+func (d *ChurnRiskDetector) detect() {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
+
+	if err := d.nudgeService.ProcessWinBackNudges(ctx); err != nil {
+		log.Printf("Error processing win-back nudges: %v", err)
+	}
+}
+
+// RunOnce targets at-risk users once (for testing or manual trigger)
+func (d *ChurnRiskDetector) RunOnce(ctx context.Context) error {
+	return d.nudgeService.ProcessWinBackNudges(ctx)
+}
+
+// IsRunning returns whether the detector is running
+func (d *ChurnRiskDetector) IsRunning() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.running
+}
@@ -0,0 +1,112 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// DevicePairingCleanup removes QR logins that expired without being
+// collected
+type DevicePairingCleanup struct {
+	pairingService *service.DevicePairingService
+	interval       time.Duration
+	stopCh         chan struct{}
+	wg             sync.WaitGroup
+	running        bool
+	mu             sync.Mutex
+}
+
+// NewDevicePairingCleanup creates a new device pairing cleanup job
+func NewDevicePairingCleanup(pairingService *service.DevicePairingService, interval time.Duration) *DevicePairingCleanup {
+	if interval == 0 {
+		interval = 1 * time.Minute // Default check every minute
+	}
+	return &DevicePairingCleanup{
+		pairingService: pairingService,
+		interval:       interval,
+		stopCh:         make(chan struct{}),
+	}
+}
+
+// Start begins the device pairing cleanup job
+func (p *DevicePairingCleanup) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Device pairing cleanup started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the device pairing cleanup job
+func (p *DevicePairingCleanup) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Device pairing cleanup stopped")
+}
+
+// run is the main loop
+func (p *DevicePairingCleanup) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.purgeExpired()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.purgeExpired()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// purgeExpired deletes expired pairings
+func (p *DevicePairingCleanup) purgeExpired() {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	purged, err := p.pairingService.PurgeExpired(ctx)
+	if err != nil {
+		log.Printf("Error purging device pairings: %v", err)
+		return
+	}
+	if purged > 0 {
+		log.Printf("Purged %d expired device pairings", purged)
+	}
+}
+
+// RunOnce runs the cleanup once (for testing or manual trigger)
+func (p *DevicePairingCleanup) RunOnce(ctx context.Context) error {
+	_, err := p.pairingService.PurgeExpired(ctx)
+	return err
+}
+
+// IsRunning returns whether the cleanup job is running
+func (p *DevicePairingCleanup) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// DomainEventDispatcher delivers queued domain events to their subscribers
+type DomainEventDispatcher struct {
+	bus      *service.DomainEventBus
+	interval time.Duration
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+	running  bool
+	mu       sync.Mutex
+}
+
+// NewDomainEventDispatcher creates a new domain event dispatcher
+func NewDomainEventDispatcher(bus *service.DomainEventBus, interval time.Duration) *DomainEventDispatcher {
+	if interval == 0 {
+		interval = 10 * time.Second // Default check every 10 seconds
+	}
+	return &DomainEventDispatcher{
+		bus:      bus,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start begins the domain event dispatcher
+func (p *DomainEventDispatcher) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Domain event dispatcher started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the domain event dispatcher
+func (p *DomainEventDispatcher) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Domain event dispatcher stopped")
+}
+
+// run is the main loop
+func (p *DomainEventDispatcher) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.dispatch()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.dispatch()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// dispatch delivers the domain events that are due
+func (p *DomainEventDispatcher) dispatch() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	delivered, err := p.bus.Dispatch(ctx)
+	if err != nil {
+		log.Printf("Error dispatching domain events: %v", err)
+		return
+	}
+	if delivered > 0 {
+		log.Printf("Delivered %d domain events", delivered)
+	}
+}
+
+// RunOnce dispatches once (for testing or manual trigger)
+func (p *DomainEventDispatcher) RunOnce(ctx context.Context) error {
+	_, err := p.bus.Dispatch(ctx)
+	return err
+}
+
+// IsRunning returns whether the dispatcher is running
+func (p *DomainEventDispatcher) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// DraftCleanup removes drafts that have passed their expiry
+type DraftCleanup struct {
+	draftService *service.DraftService
+	interval     time.Duration
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+	running      bool
+	mu           sync.Mutex
+}
+
+// NewDraftCleanup creates a new draft cleanup job
+func NewDraftCleanup(draftService *service.DraftService, interval time.Duration) *DraftCleanup {
+	if interval == 0 {
+		interval = 1 * time.Hour // Default check every hour
+	}
+	return &DraftCleanup{
+		draftService: draftService,
+		interval:     interval,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start begins the draft cleanup job
+func (p *DraftCleanup) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Draft cleanup started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the draft cleanup job
+func (p *DraftCleanup) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Draft cleanup stopped")
+}
+
+// run is the main loop
+func (p *DraftCleanup) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.cleanupDrafts()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.cleanupDrafts()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// cleanupDrafts removes expired drafts
+func (p *DraftCleanup) cleanupDrafts() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	removed, err := p.draftService.CleanupExpired(ctx)
+	if err != nil {
+		log.Printf("Error cleaning up expired drafts: %v", err)
+		return
+	}
+	if removed > 0 {
+		log.Printf("Removed %d expired drafts", removed)
+	}
+}
+
+// RunOnce runs cleanup once (for testing or manual trigger)
+func (p *DraftCleanup) RunOnce(ctx context.Context) error {
+	_, err := p.draftService.CleanupExpired(ctx)
+	return err
+}
+
+// IsRunning returns whether the cleanup job is running
+func (p *DraftCleanup) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// EventReminderProcessor sends due event reminders on a schedule
+type EventReminderProcessor struct {
+	reminderService *service.EventReminderService
+	interval        time.Duration
+	stopCh          chan struct{}
+	wg              sync.WaitGroup
+	running         bool
+	mu              sync.Mutex
+}
+
+// NewEventReminderProcessor creates a new event reminder processor job
+func NewEventReminderProcessor(reminderService *service.EventReminderService, interval time.Duration) *EventReminderProcessor {
+	if interval == 0 {
+		interval = 5 * time.Minute // Default check every 5 minutes
+	}
+	return &EventReminderProcessor{
+		reminderService: reminderService,
+		interval:        interval,
+		stopCh:          make(chan struct{}),
+	}
+}
+
+// Start begins the event reminder processor job
+func (p *EventReminderProcessor) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Event reminder processor started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the event reminder processor job
+func (p *EventReminderProcessor) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Event reminder processor stopped")
+}
+
+// run is the main loop
+func (p *EventReminderProcessor) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.processReminders()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.processReminders()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// processReminders sends all due event reminders
+func (p *EventReminderProcessor) processReminders() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.reminderService.ProcessDueReminders(ctx); err != nil {
+		log.Printf("Error processing event reminders: %v", err)
+	}
+}
+
+// RunOnce runs the reminder processing once (for testing or manual trigger)
+func (p *EventReminderProcessor) RunOnce(ctx context.Context) error {
+	return p.reminderService.ProcessDueReminders(ctx)
+}
+
+// IsRunning returns whether the processor is running
+func (p *EventReminderProcessor) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
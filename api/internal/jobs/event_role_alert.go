@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// EventRoleAlertProcessor alerts hosts about unfilled critical roles and reminds assignees of their role
+type EventRoleAlertProcessor struct {
+	alertService *service.EventRoleAlertService
+	interval     time.Duration
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+	running      bool
+	mu           sync.Mutex
+}
+
+// NewEventRoleAlertProcessor creates a new event role alert processor job
+func NewEventRoleAlertProcessor(alertService *service.EventRoleAlertService, interval time.Duration) *EventRoleAlertProcessor {
+	if interval == 0 {
+		interval = 15 * time.Minute // Default check every 15 minutes
+	}
+	return &EventRoleAlertProcessor{
+		alertService: alertService,
+		interval:     interval,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start begins the event role alert processor job
+func (p *EventRoleAlertProcessor) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Event role alert processor started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the event role alert processor job
+func (p *EventRoleAlertProcessor) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Event role alert processor stopped")
+}
+
+// run is the main loop
+func (p *EventRoleAlertProcessor) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.processAlerts()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.processAlerts()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// processAlerts sends due role gap alerts and assignee reminders
+func (p *EventRoleAlertProcessor) processAlerts() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.alertService.ProcessRoleAlerts(ctx); err != nil {
+		log.Printf("Error processing event role alerts: %v", err)
+	}
+}
+
+// RunOnce runs the alert processing once (for testing or manual trigger)
+func (p *EventRoleAlertProcessor) RunOnce(ctx context.Context) error {
+	return p.alertService.ProcessRoleAlerts(ctx)
+}
+
+// IsRunning returns whether the processor is running
+func (p *EventRoleAlertProcessor) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// GuildHealthScorer scores each guild's health daily and alerts organizers when it drops sharply
+type GuildHealthScorer struct {
+	healthService *service.GuildHealthService
+	interval      time.Duration
+	stopCh        chan struct{}
+	wg            sync.WaitGroup
+	running       bool
+	mu            sync.Mutex
+}
+
+// NewGuildHealthScorer creates a new guild health scorer job
+func NewGuildHealthScorer(healthService *service.GuildHealthService, interval time.Duration) *GuildHealthScorer {
+	if interval == 0 {
+		interval = 6 * time.Hour // Default rescore every 6 hours
+	}
+	return &GuildHealthScorer{
+		healthService: healthService,
+		interval:      interval,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start begins the guild health scorer job
+func (s *GuildHealthScorer) Start() {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = true
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go s.run()
+	log.Printf("Guild health scorer started (interval: %v)", s.interval)
+}
+
+// Stop gracefully stops the guild health scorer job
+func (s *GuildHealthScorer) Stop() {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = false
+	s.mu.Unlock()
+
+	close(s.stopCh)
+	s.wg.Wait()
+	log.Println("Guild health scorer stopped")
+}
+
+// run is the main loop
+func (s *GuildHealthScorer) run() {
+	defer s.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	s.scoreGuilds()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.scoreGuilds()
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+// scoreGuilds scores every guild and alerts organizers of those that dropped
+func (s *GuildHealthScorer) scoreGuilds() {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
+
+	if err := s.healthService.ProcessGuildHealth(ctx); err != nil {
+		log.Printf("Error scoring guild health: %v", err)
+	}
+}
+
+// RunOnce scores every guild once (for testing or manual trigger)
+func (s *GuildHealthScorer) RunOnce(ctx context.Context) error {
+	return s.healthService.ProcessGuildHealth(ctx)
+}
+
+// IsRunning returns whether the scorer is running
+func (s *GuildHealthScorer) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
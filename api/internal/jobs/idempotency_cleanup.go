@@ -0,0 +1,112 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// IdempotencyCleanup removes stored idempotent responses that are no
+// longer replayed
+type IdempotencyCleanup struct {
+	idempotencyService *service.IdempotencyService
+	interval           time.Duration
+	stopCh             chan struct{}
+	wg                 sync.WaitGroup
+	running            bool
+	mu                 sync.Mutex
+}
+
+// NewIdempotencyCleanup creates a new idempotency cleanup job
+func NewIdempotencyCleanup(idempotencyService *service.IdempotencyService, interval time.Duration) *IdempotencyCleanup {
+	if interval == 0 {
+		interval = 1 * time.Hour // Default check every hour
+	}
+	return &IdempotencyCleanup{
+		idempotencyService: idempotencyService,
+		interval:           interval,
+		stopCh:             make(chan struct{}),
+	}
+}
+
+// Start begins the idempotency cleanup job
+func (p *IdempotencyCleanup) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Idempotency cleanup started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the idempotency cleanup job
+func (p *IdempotencyCleanup) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Idempotency cleanup stopped")
+}
+
+// run is the main loop
+func (p *IdempotencyCleanup) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.purgeExpired()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.purgeExpired()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// purgeExpired deletes responses past their TTL
+func (p *IdempotencyCleanup) purgeExpired() {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	purged, err := p.idempotencyService.PurgeExpired(ctx)
+	if err != nil {
+		log.Printf("Error purging idempotent responses: %v", err)
+		return
+	}
+	if purged > 0 {
+		log.Printf("Purged %d expired idempotent responses", purged)
+	}
+}
+
+// RunOnce runs the cleanup once (for testing or manual trigger)
+func (p *IdempotencyCleanup) RunOnce(ctx context.Context) error {
+	_, err := p.idempotencyService.PurgeExpired(ctx)
+	return err
+}
+
+// IsRunning returns whether the cleanup job is running
+func (p *IdempotencyCleanup) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
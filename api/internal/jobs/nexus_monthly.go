@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/model"
 )
 
@@ -26,17 +27,20 @@ type NexusDataProvider interface {
 type NexusMonthlyJob struct {
 	calculator   NexusCalculator
 	dataProvider NexusDataProvider
+	clock        clock.Clock
 	stopCh       chan struct{}
 	wg           sync.WaitGroup
 	running      bool
 	mu           sync.Mutex
 }
 
-// NewNexusMonthlyJob creates a new Nexus monthly job
-func NewNexusMonthlyJob(calculator NexusCalculator, dataProvider NexusDataProvider) *NexusMonthlyJob {
+// NewNexusMonthlyJob creates a new Nexus monthly job. A nil clock means the
+// system clock.
+func NewNexusMonthlyJob(calculator NexusCalculator, dataProvider NexusDataProvider, clk clock.Clock) *NexusMonthlyJob {
 	return &NexusMonthlyJob{
 		calculator:   calculator,
 		dataProvider: dataProvider,
+		clock:        clock.OrReal(clk),
 		stopCh:       make(chan struct{}),
 	}
 }
@@ -94,7 +98,7 @@ func (j *NexusMonthlyJob) run() {
 
 // checkAndRun runs the calculation if it's the 1st of the month
 func (j *NexusMonthlyJob) checkAndRun() {
-	now := time.Now()
+	now := j.clock.Now()
 	if now.Day() == 1 {
 		log.Println("Running monthly Nexus calculation")
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// NoShowDetector marks no-shows for ended events on a schedule
+type NoShowDetector struct {
+	noShowService *service.NoShowService
+	interval      time.Duration
+	stopCh        chan struct{}
+	wg            sync.WaitGroup
+	running       bool
+	mu            sync.Mutex
+}
+
+// NewNoShowDetector creates a new no-show detector job
+func NewNoShowDetector(noShowService *service.NoShowService, interval time.Duration) *NoShowDetector {
+	if interval == 0 {
+		interval = 1 * time.Hour // Default check every hour
+	}
+	return &NoShowDetector{
+		noShowService: noShowService,
+		interval:      interval,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start begins the no-show detector job
+func (p *NoShowDetector) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("No-show detector started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the no-show detector job
+func (p *NoShowDetector) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("No-show detector stopped")
+}
+
+// run is the main loop
+func (p *NoShowDetector) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.detectNoShows()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.detectNoShows()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// detectNoShows marks no-shows for events past their grace period
+func (p *NoShowDetector) detectNoShows() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.noShowService.DetectNoShows(ctx); err != nil {
+		log.Printf("Error detecting no-shows: %v", err)
+	}
+}
+
+// RunOnce runs no-show detection once (for testing or manual trigger)
+func (p *NoShowDetector) RunOnce(ctx context.Context) error {
+	return p.noShowService.DetectNoShows(ctx)
+}
+
+// IsRunning returns whether the detector is running
+func (p *NoShowDetector) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
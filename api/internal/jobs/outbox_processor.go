@@ -0,0 +1,114 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// OutboxProcessor delivers queued notifications over push and SSE
+type OutboxProcessor struct {
+	outbox   *service.NotificationOutbox
+	interval time.Duration
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+	running  bool
+	mu       sync.Mutex
+}
+
+// NewOutboxProcessor creates a new notification outbox processor
+func NewOutboxProcessor(outbox *service.NotificationOutbox, interval time.Duration) *OutboxProcessor {
+	if interval == 0 {
+		interval = 10 * time.Second // Default check every 10 seconds
+	}
+	return &OutboxProcessor{
+		outbox:   outbox,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start begins the outbox processor
+func (p *OutboxProcessor) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Notification outbox processor started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the outbox processor
+func (p *OutboxProcessor) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Notification outbox processor stopped")
+}
+
+// run is the main loop. Besides the ticker, it drains as soon as
+// notifications are queued on this instance; retries wait for the ticker.
+func (p *OutboxProcessor) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.drain()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.drain()
+		case <-p.outbox.Wake():
+			p.drain()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// drain delivers the notifications that are due
+func (p *OutboxProcessor) drain() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	delivered, err := p.outbox.Drain(ctx)
+	if err != nil {
+		log.Printf("Error draining notification outbox: %v", err)
+		return
+	}
+	if delivered > 0 {
+		log.Printf("Delivered %d queued notifications", delivered)
+	}
+}
+
+// RunOnce drains once (for testing or manual trigger)
+func (p *OutboxProcessor) RunOnce(ctx context.Context) error {
+	_, err := p.outbox.Drain(ctx)
+	return err
+}
+
+// IsRunning returns whether the processor is running
+func (p *OutboxProcessor) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
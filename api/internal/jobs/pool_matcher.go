@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/forgo/saga/api/internal/model"
 	"github.com/forgo/saga/api/internal/service"
 )
 
@@ -107,7 +108,7 @@ func (m *PoolMatcher) processPoolsUnsafe() {
 }
 
 // processPool runs matching for a single pool
-func (m *PoolMatcher) processPool(ctx context.Context, poolID string) error {
+func (m *PoolMatcher) processPool(ctx context.Context, poolID model.PoolID) error {
 	log.Printf("Running matching for pool %s", poolID)
 
 	roundInfo, err := m.poolService.RunMatching(ctx, poolID)
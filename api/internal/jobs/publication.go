@@ -0,0 +1,106 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// PublicationPublisher publishes scheduled events and votes once their publish
+// time passes and notifies their guilds
+type PublicationPublisher struct {
+	publicationService *service.PublicationService
+	interval           time.Duration
+	stopCh             chan struct{}
+	wg                 sync.WaitGroup
+	running            bool
+	mu                 sync.Mutex
+}
+
+// NewPublicationPublisher creates a new publication publisher job
+func NewPublicationPublisher(publicationService *service.PublicationService, interval time.Duration) *PublicationPublisher {
+	if interval == 0 {
+		interval = 1 * time.Minute // Default check every minute
+	}
+	return &PublicationPublisher{
+		publicationService: publicationService,
+		interval:           interval,
+		stopCh:             make(chan struct{}),
+	}
+}
+
+// Start begins the publication publisher job
+func (p *PublicationPublisher) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Publication publisher started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the publication publisher job
+func (p *PublicationPublisher) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Publication publisher stopped")
+}
+
+// run is the main loop
+func (p *PublicationPublisher) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.processPublications()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.processPublications()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// processPublications publishes due events and votes
+func (p *PublicationPublisher) processPublications() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.publicationService.ProcessDuePublications(ctx); err != nil {
+		log.Printf("Error publishing scheduled items: %v", err)
+	}
+}
+
+// RunOnce runs publication once (for testing or manual trigger)
+func (p *PublicationPublisher) RunOnce(ctx context.Context) error {
+	return p.publicationService.ProcessDuePublications(ctx)
+}
+
+// IsRunning returns whether the publisher is running
+func (p *PublicationPublisher) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
@@ -0,0 +1,146 @@
+package jobs
+
+import (
+	"log"
+	"sync"
+
+	"github.com/forgo/saga/api/internal/database"
+)
+
+// Job is a background job that can be started and stopped
+type Job interface {
+	Start()
+	Stop()
+}
+
+// RegionGate runs the background jobs that write to the database only while
+// the region accepts writes. They are started when the region is active and
+// not read-only, and stopped when it is demoted or made read-only, so a
+// standby never sends reminders or marks no-shows twice.
+//
+// Jobs can't be restarted once stopped, so the gate takes constructors and
+// builds fresh jobs each time the region becomes writable again.
+type RegionGate struct {
+	region  *database.RegionState
+	newJobs []func() Job
+	jobs    []Job // Running jobs; nil while paused
+	jobsMu  sync.Mutex
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+	running bool
+	mu      sync.Mutex
+}
+
+// NewRegionGate creates a gate for the jobs the given constructors build
+func NewRegionGate(region *database.RegionState, newJobs ...func() Job) *RegionGate {
+	return &RegionGate{
+		region:  region,
+		newJobs: newJobs,
+		stopCh:  make(chan struct{}),
+	}
+}
+
+// Start begins watching the region, starting the jobs if it is writable
+func (g *RegionGate) Start() {
+	g.mu.Lock()
+	if g.running {
+		g.mu.Unlock()
+		return
+	}
+	g.running = true
+	g.mu.Unlock()
+
+	changed := g.region.Changed()
+	g.sync()
+
+	g.wg.Add(1)
+	go g.run(changed)
+	log.Printf("Region gate started (%d jobs)", len(g.newJobs))
+}
+
+// Stop stops watching the region and stops any running jobs
+func (g *RegionGate) Stop() {
+	g.mu.Lock()
+	if !g.running {
+		g.mu.Unlock()
+		return
+	}
+	g.running = false
+	g.mu.Unlock()
+
+	close(g.stopCh)
+	g.wg.Wait()
+	g.pause()
+	log.Println("Region gate stopped")
+}
+
+// run starts or stops the jobs whenever the region changes
+func (g *RegionGate) run(changed <-chan struct{}) {
+	defer g.wg.Done()
+
+	for {
+		select {
+		case <-changed:
+			changed = g.region.Changed()
+			g.sync()
+		case <-g.stopCh:
+			return
+		}
+	}
+}
+
+// sync starts the jobs if the region accepts writes and stops them if not
+func (g *RegionGate) sync() {
+	if g.region.ReadOnly() {
+		g.pause()
+	} else {
+		g.resume()
+	}
+}
+
+// resume starts fresh jobs unless they are already running
+func (g *RegionGate) resume() {
+	g.jobsMu.Lock()
+	defer g.jobsMu.Unlock()
+	if g.jobs != nil {
+		return
+	}
+
+	g.jobs = make([]Job, 0, len(g.newJobs))
+	for _, newJob := range g.newJobs {
+		job := newJob()
+		job.Start()
+		g.jobs = append(g.jobs, job)
+	}
+	log.Printf("Region is writable; started %d background jobs", len(g.jobs))
+}
+
+// pause stops the running jobs, in reverse order of starting
+func (g *RegionGate) pause() {
+	g.jobsMu.Lock()
+	defer g.jobsMu.Unlock()
+	if g.jobs == nil {
+		return
+	}
+
+	for i := len(g.jobs) - 1; i >= 0; i-- {
+		g.jobs[i].Stop()
+	}
+	log.Printf("Region is read-only; stopped %d background jobs", len(g.jobs))
+	g.jobs = nil
+}
+
+// IsPaused returns whether the jobs are stopped because the region is
+// read-only (or the gate isn't running)
+func (g *RegionGate) IsPaused() bool {
+	g.jobsMu.Lock()
+	defer g.jobsMu.Unlock()
+	return g.jobs == nil
+}
+
+// IsRunning returns whether the gate is watching the region
+func (g *RegionGate) IsRunning() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.running
+}
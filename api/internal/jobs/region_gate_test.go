@@ -0,0 +1,144 @@
+package jobs
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+)
+
+// countingJob records how many times it was started and stopped
+type countingJob struct {
+	mu      sync.Mutex
+	running bool
+	starts  int
+}
+
+func (j *countingJob) Start() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.running = true
+	j.starts++
+}
+
+func (j *countingJob) Stop() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.running = false
+}
+
+// jobRecorder builds countingJobs and keeps them for inspection
+type jobRecorder struct {
+	mu   sync.Mutex
+	jobs []*countingJob
+}
+
+func (r *jobRecorder) newJob() Job {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job := &countingJob{}
+	r.jobs = append(r.jobs, job)
+	return job
+}
+
+// running returns how many of the built jobs are running
+func (r *jobRecorder) running() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, job := range r.jobs {
+		job.mu.Lock()
+		if job.running {
+			n++
+		}
+		job.mu.Unlock()
+	}
+	return n
+}
+
+// waitForRunning waits for the gate to react to a region change
+func waitForRunning(t *testing.T, r *jobRecorder, want int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for r.running() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d running jobs, got %d", want, r.running())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRegionGate_StartsOnlyWhenWritable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		config      database.RegionConfig
+		wantRunning int
+	}{
+		{"active", database.RegionConfig{Role: database.RegionRoleActive}, 2},
+		{"default role", database.RegionConfig{}, 2},
+		{"active but read-only", database.RegionConfig{Role: database.RegionRoleActive, ReadOnly: true}, 0},
+		{"standby", database.RegionConfig{Role: database.RegionRoleStandby}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			recorder := &jobRecorder{}
+			gate := NewRegionGate(database.NewRegionState(tt.config), recorder.newJob, recorder.newJob)
+			gate.Start()
+			defer gate.Stop()
+
+			if got := recorder.running(); got != tt.wantRunning {
+				t.Errorf("expected %d running jobs, got %d", tt.wantRunning, got)
+			}
+			if gate.IsPaused() != (tt.wantRunning == 0) {
+				t.Errorf("expected paused = %t", tt.wantRunning == 0)
+			}
+		})
+	}
+}
+
+func TestRegionGate_FollowsRoleChanges(t *testing.T) {
+	t.Parallel()
+
+	region := database.NewRegionState(database.RegionConfig{Role: database.RegionRoleStandby})
+	recorder := &jobRecorder{}
+	gate := NewRegionGate(region, recorder.newJob)
+	gate.Start()
+	waitForRunning(t, recorder, 0)
+
+	region.SetRole(database.RegionRoleActive)
+	waitForRunning(t, recorder, 1)
+
+	region.SetReadOnly(true)
+	waitForRunning(t, recorder, 0)
+
+	region.SetReadOnly(false)
+	waitForRunning(t, recorder, 1)
+
+	region.SetRole(database.RegionRoleStandby)
+	waitForRunning(t, recorder, 0)
+
+	region.SetRole(database.RegionRoleActive)
+	waitForRunning(t, recorder, 1)
+
+	gate.Stop()
+	if got := recorder.running(); got != 0 {
+		t.Errorf("expected Stop to stop the jobs, %d still running", got)
+	}
+
+	// Stopped jobs aren't restarted; each promotion builds fresh ones
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.jobs) != 3 {
+		t.Errorf("expected a job built per writable period, got %d", len(recorder.jobs))
+	}
+	for i, job := range recorder.jobs {
+		if job.starts != 1 {
+			t.Errorf("job %d started %d times, want 1", i, job.starts)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+)
+
+// RegionHeartbeat publishes the replication heartbeat from the active region
+// and measures replica lag from it in a standby region
+type RegionHeartbeat struct {
+	region   *database.RegionState
+	db       database.Database
+	interval time.Duration
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+	running  bool
+	mu       sync.Mutex
+}
+
+// NewRegionHeartbeat creates a new region heartbeat job
+func NewRegionHeartbeat(region *database.RegionState, db database.Database, interval time.Duration) *RegionHeartbeat {
+	if interval == 0 {
+		interval = 10 * time.Second // Default heartbeat every 10 seconds
+	}
+	return &RegionHeartbeat{
+		region:   region,
+		db:       db,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start begins the region heartbeat job
+func (p *RegionHeartbeat) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Region heartbeat started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the region heartbeat job
+func (p *RegionHeartbeat) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Region heartbeat stopped")
+}
+
+// run is the main loop
+func (p *RegionHeartbeat) run() {
+	defer p.wg.Done()
+
+	// Beat immediately so health reports lag as soon as possible
+	p.beat()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.beat()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// beat writes or reads the heartbeat depending on the current role
+func (p *RegionHeartbeat) beat() {
+	ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+	defer cancel()
+
+	if err := p.region.Heartbeat(ctx, p.db); err != nil {
+		log.Printf("Error on region heartbeat: %v", err)
+	}
+}
+
+// RunOnce runs a single heartbeat (for testing or manual trigger)
+func (p *RegionHeartbeat) RunOnce(ctx context.Context) error {
+	return p.region.Heartbeat(ctx, p.db)
+}
+
+// IsRunning returns whether the heartbeat is running
+func (p *RegionHeartbeat) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
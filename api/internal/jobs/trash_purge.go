@@ -0,0 +1,112 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// TrashPurge permanently deletes guild content that has been in the trash
+// longer than the retention period
+type TrashPurge struct {
+	trashService *service.TrashService
+	interval     time.Duration
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+	running      bool
+	mu           sync.Mutex
+}
+
+// NewTrashPurge creates a new trash purge job
+func NewTrashPurge(trashService *service.TrashService, interval time.Duration) *TrashPurge {
+	if interval == 0 {
+		interval = 1 * time.Hour // Default check every hour
+	}
+	return &TrashPurge{
+		trashService: trashService,
+		interval:     interval,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start begins the trash purge job
+func (p *TrashPurge) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Trash purge started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the trash purge job
+func (p *TrashPurge) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Trash purge stopped")
+}
+
+// run is the main loop
+func (p *TrashPurge) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.purgeExpired()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.purgeExpired()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// purgeExpired deletes trashed events, pools and votes past retention
+func (p *TrashPurge) purgeExpired() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	purged, err := p.trashService.PurgeExpired(ctx)
+	if err != nil {
+		log.Printf("Error purging trash: %v", err)
+		return
+	}
+	if purged > 0 {
+		log.Printf("Purged %d trashed items", purged)
+	}
+}
+
+// RunOnce runs the purge once (for testing or manual trigger)
+func (p *TrashPurge) RunOnce(ctx context.Context) error {
+	_, err := p.trashService.PurgeExpired(ctx)
+	return err
+}
+
+// IsRunning returns whether the purge job is running
+func (p *TrashPurge) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// UndoCleanup finalizes destructive actions whose undo window has closed
+type UndoCleanup struct {
+	undoService *service.UndoService
+	interval    time.Duration
+	stopCh      chan struct{}
+	wg          sync.WaitGroup
+	running     bool
+	mu          sync.Mutex
+}
+
+// NewUndoCleanup creates a new undo cleanup job
+func NewUndoCleanup(undoService *service.UndoService, interval time.Duration) *UndoCleanup {
+	if interval == 0 {
+		interval = 1 * time.Minute // Default check every minute
+	}
+	return &UndoCleanup{
+		undoService: undoService,
+		interval:    interval,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+// Start begins the undo cleanup job
+func (p *UndoCleanup) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Undo cleanup started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the undo cleanup job
+func (p *UndoCleanup) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Undo cleanup stopped")
+}
+
+// run is the main loop
+func (p *UndoCleanup) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.finalizeExpired()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.finalizeExpired()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// finalizeExpired deletes pending availability and expired undo operations
+func (p *UndoCleanup) finalizeExpired() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	expired, err := p.undoService.FinalizeExpired(ctx)
+	if err != nil {
+		log.Printf("Error finalizing expired undo operations: %v", err)
+		return
+	}
+	if expired > 0 {
+		log.Printf("Finalized %d expired undo operations", expired)
+	}
+}
+
+// RunOnce runs cleanup once (for testing or manual trigger)
+func (p *UndoCleanup) RunOnce(ctx context.Context) error {
+	_, err := p.undoService.FinalizeExpired(ctx)
+	return err
+}
+
+// IsRunning returns whether the cleanup job is running
+func (p *UndoCleanup) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
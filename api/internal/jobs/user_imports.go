@@ -0,0 +1,110 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// UserImports processes CSV user imports queued by admins
+type UserImports struct {
+	importService *service.UserImportService
+	interval      time.Duration
+	stopCh        chan struct{}
+	wg            sync.WaitGroup
+	running       bool
+	mu            sync.Mutex
+}
+
+// NewUserImports creates a new user imports job
+func NewUserImports(importService *service.UserImportService, interval time.Duration) *UserImports {
+	if interval == 0 {
+		interval = 15 * time.Second // Default check every 15 seconds so imports start promptly
+	}
+	return &UserImports{
+		importService: importService,
+		interval:      interval,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start begins the user imports job
+func (j *UserImports) Start() {
+	j.mu.Lock()
+	if j.running {
+		j.mu.Unlock()
+		return
+	}
+	j.running = true
+	j.mu.Unlock()
+
+	j.wg.Add(1)
+	go j.run()
+	log.Printf("User imports started (interval: %v)", j.interval)
+}
+
+// Stop gracefully stops the user imports job
+func (j *UserImports) Stop() {
+	j.mu.Lock()
+	if !j.running {
+		j.mu.Unlock()
+		return
+	}
+	j.running = false
+	j.mu.Unlock()
+
+	close(j.stopCh)
+	j.wg.Wait()
+	log.Println("User imports stopped")
+}
+
+// run is the main loop
+func (j *UserImports) run() {
+	defer j.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	j.process()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			j.process()
+		case <-j.stopCh:
+			return
+		}
+	}
+}
+
+// process imports queued files
+func (j *UserImports) process() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	processed, err := j.importService.ProcessPending(ctx)
+	if err != nil {
+		log.Printf("Error processing user imports: %v", err)
+	}
+	if processed > 0 {
+		log.Printf("Processed %d user imports", processed)
+	}
+}
+
+// RunOnce runs the job once (for testing or manual trigger)
+func (j *UserImports) RunOnce(ctx context.Context) error {
+	_, err := j.importService.ProcessPending(ctx)
+	return err
+}
+
+// IsRunning returns whether the user imports job is running
+func (j *UserImports) IsRunning() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.running
+}
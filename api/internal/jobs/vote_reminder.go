@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// VoteReminderProcessor reminds non-voters of votes that are about to close
+type VoteReminderProcessor struct {
+	reminderService *service.VoteReminderService
+	interval        time.Duration
+	stopCh          chan struct{}
+	wg              sync.WaitGroup
+	running         bool
+	mu              sync.Mutex
+}
+
+// NewVoteReminderProcessor creates a new vote reminder processor job
+func NewVoteReminderProcessor(reminderService *service.VoteReminderService, interval time.Duration) *VoteReminderProcessor {
+	if interval == 0 {
+		interval = 15 * time.Minute // Default check every 15 minutes
+	}
+	return &VoteReminderProcessor{
+		reminderService: reminderService,
+		interval:        interval,
+		stopCh:          make(chan struct{}),
+	}
+}
+
+// Start begins the vote reminder processor job
+func (p *VoteReminderProcessor) Start() {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run()
+	log.Printf("Vote reminder processor started (interval: %v)", p.interval)
+}
+
+// Stop gracefully stops the vote reminder processor job
+func (p *VoteReminderProcessor) Stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	p.mu.Unlock()
+
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Println("Vote reminder processor stopped")
+}
+
+// run is the main loop
+func (p *VoteReminderProcessor) run() {
+	defer p.wg.Done()
+
+	// Run immediately on start (but with a short delay to let services initialize)
+	time.Sleep(5 * time.Second)
+	p.processReminders()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.processReminders()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// processReminders sends reminders for votes closing soon
+func (p *VoteReminderProcessor) processReminders() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.reminderService.ProcessClosingReminders(ctx); err != nil {
+		log.Printf("Error processing vote reminders: %v", err)
+	}
+}
+
+// RunOnce runs the reminder processing once (for testing or manual trigger)
+func (p *VoteReminderProcessor) RunOnce(ctx context.Context) error {
+	return p.reminderService.ProcessClosingReminders(ctx)
+}
+
+// IsRunning returns whether the processor is running
+func (p *VoteReminderProcessor) IsRunning() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
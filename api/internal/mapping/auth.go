@@ -0,0 +1,122 @@
+package mapping
+
+import (
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// UserResponse represents a user in API responses
+type UserResponse struct {
+	ID            string  `json:"id"`
+	Email         string  `json:"email"`
+	Username      *string `json:"username,omitempty"`
+	Firstname     *string `json:"firstname,omitempty"`
+	Lastname      *string `json:"lastname,omitempty"`
+	EmailVerified bool    `json:"email_verified"`
+	CreatedOn     string  `json:"created_on"`
+	UpdatedOn     string  `json:"updated_on"`
+}
+
+// TokenResponse represents a token response
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// IdentityResponse represents a linked OAuth identity in API responses
+type IdentityResponse struct {
+	ID            string `json:"id"`
+	Provider      string `json:"provider"`
+	ProviderEmail string `json:"provider_email,omitempty"`
+	CreatedOn     string `json:"created_on"`
+}
+
+// PasskeyResponse represents a passkey in API responses
+type PasskeyResponse struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	CreatedOn  string  `json:"created_on"`
+	LastUsedOn *string `json:"last_used_on,omitempty"`
+}
+
+// User converts a user for API responses
+func User(user *model.User) UserResponse {
+	return UserResponse{
+		ID:            user.ID,
+		Email:         user.Email,
+		Username:      user.Username,
+		Firstname:     user.Firstname,
+		Lastname:      user.Lastname,
+		EmailVerified: user.EmailVerified,
+		CreatedOn:     timestamp(user.CreatedOn),
+		UpdatedOn:     timestamp(user.UpdatedOn),
+	}
+}
+
+// Token converts a token pair for API responses
+func Token(tokenPair *service.TokenPair) TokenResponse {
+	return TokenResponse{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		TokenType:    tokenPair.TokenType,
+		ExpiresIn:    tokenPair.ExpiresIn,
+	}
+}
+
+// Identity converts a linked identity for API responses
+func Identity(identity *model.Identity) IdentityResponse {
+	resp := IdentityResponse{
+		ID:        identity.ID,
+		Provider:  identity.Provider,
+		CreatedOn: timestamp(identity.CreatedOn),
+	}
+	if identity.ProviderEmail != nil {
+		resp.ProviderEmail = *identity.ProviderEmail
+	}
+	return resp
+}
+
+// Identities converts linked identities, returning an empty slice for none
+func Identities(identities []*model.Identity) []IdentityResponse {
+	return each(identities, Identity)
+}
+
+// Passkey converts a passkey for API responses
+func Passkey(passkey *model.Passkey) PasskeyResponse {
+	return PasskeyResponse{
+		ID:         passkey.ID,
+		Name:       passkey.Name,
+		CreatedOn:  timestamp(passkey.CreatedOn),
+		LastUsedOn: optionalTimestamp(passkey.LastUsedOn),
+	}
+}
+
+// Passkeys converts passkeys, returning an empty slice for none
+func Passkeys(passkeys []*model.Passkey) []PasskeyResponse {
+	return each(passkeys, Passkey)
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func optionalTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := timestamp(*t)
+	return &formatted
+}
+
+// each converts a list, never returning nil so empty lists encode as []
+func each[S, D any](items []S, convert func(S) D) []D {
+	result := make([]D, 0, len(items))
+	for _, item := range items {
+		result = append(result, convert(item))
+	}
+	return result
+}
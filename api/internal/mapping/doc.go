@@ -0,0 +1,19 @@
+// Package mapping converts domain models to the response DTOs handlers
+// write, so each conversion lives in one place instead of being rebuilt
+// field by field in every handler that returns the resource.
+//
+// # Usage
+//
+//	WriteData(w, http.StatusOK, mapping.User(user), nil)
+//	WriteCollection(w, http.StatusOK, mapping.PublicProfiles(profiles), nil, links)
+//
+// Timestamps are written as RFC 3339 in UTC.
+//
+// # Adding Fields
+//
+// The package tests fill every field of each source model, convert it and
+// fail when a model field reaches neither the DTO nor the converter's list of
+// deliberately omitted fields. A new model field therefore fails the tests
+// until it is mapped or its omission is recorded with a reason, rather than
+// silently never reaching the API.
+package mapping
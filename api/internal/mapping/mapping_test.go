@@ -0,0 +1,271 @@
+package mapping
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/service"
+)
+
+// converter describes one mapping for the coverage tests. Every exported
+// field of the source must reach the DTO under the same name or be listed in
+// omitted with the reason it stays out of the API.
+type converter struct {
+	name    string
+	source  func() any           // Returns a pointer to a zero source value
+	convert func(source any) any // Returns the DTO
+	omitted map[string]string    // Source field -> why it is not in the DTO
+	unset   map[string]string    // DTO field -> who fills it instead of the converter
+}
+
+var converters = []converter{
+	{
+		name:    "User",
+		source:  func() any { return &model.User{} },
+		convert: func(s any) any { return User(s.(*model.User)) },
+		omitted: map[string]string{
+			"Hash":              "password hashes never leave the server",
+			"Role":              "roles are checked server-side, not shown to clients",
+			"LoginOn":           "sign-in times are only used for security events",
+			"UsernameChangedOn": "served with the handle, by GET /v1/profile/handle",
+		},
+	},
+	{
+		name:    "Token",
+		source:  func() any { return &service.TokenPair{} },
+		convert: func(s any) any { return Token(s.(*service.TokenPair)) },
+	},
+	{
+		name:    "Identity",
+		source:  func() any { return &model.Identity{} },
+		convert: func(s any) any { return Identity(s.(*model.Identity)) },
+		omitted: map[string]string{
+			"UserID":                  "identities are only listed for their owner",
+			"ProviderUserID":          "the provider's account ID is internal",
+			"EmailVerifiedByProvider": "only used when linking accounts",
+			"UpdatedOn":               "clients only show when an identity was linked",
+		},
+	},
+	{
+		name:    "Passkey",
+		source:  func() any { return &model.Passkey{} },
+		convert: func(s any) any { return Passkey(s.(*model.Passkey)) },
+		omitted: map[string]string{
+			"UserID":       "passkeys are only listed for their owner",
+			"CredentialID": "WebAuthn ceremonies send credential IDs themselves",
+			"PublicKey":    "key material never leaves the server",
+			"SignCount":    "only used to detect cloned authenticators",
+		},
+	},
+	{
+		name:    "Profile",
+		source:  func() any { return &model.UserProfile{} },
+		convert: func(s any) any { return Profile(s.(*model.UserProfile)) },
+		omitted: map[string]string{
+			"ID":                     "the profile is addressed by user_id",
+			"Location":               "flattened into city and country; coordinates stay private",
+			"LastActive":             "shown to others as an activity bucket instead",
+			"DiscoveryEligible":      "superseded by completeness",
+			"CategoriesCompleted":    "superseded by completeness",
+			"QuestionCount":          "superseded by completeness",
+			"ProfileCompletionScore": "superseded by completeness",
+			"Username":               "served by GET /v1/auth/me",
+			"Firstname":              "served by GET /v1/auth/me",
+		},
+		unset: map[string]string{
+			"Completeness": "the profile handler, when the lookup succeeds",
+		},
+	},
+	{
+		name:    "PublicProfile",
+		source:  func() any { return &model.PublicProfile{} },
+		convert: func(s any) any { return PublicProfile(s.(*model.PublicProfile)) },
+		omitted: map[string]string{
+			"DiscoveryEligible": "other users only see profiles that are eligible",
+		},
+	},
+}
+
+// ============================================================================
+// Coverage Tests
+// ============================================================================
+
+func TestConverters_MapEverySourceField(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range converters {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			src := reflect.TypeOf(c.source()).Elem()
+			dst := reflect.TypeOf(c.convert(fill(c.source())))
+
+			for _, f := range reflect.VisibleFields(src) {
+				if !f.IsExported() {
+					continue
+				}
+				_, inDTO := dst.FieldByName(f.Name)
+				_, omitted := c.omitted[f.Name]
+				switch {
+				case inDTO && omitted:
+					t.Errorf("%s.%s is mapped but also listed as omitted", src.Name(), f.Name)
+				case !inDTO && !omitted:
+					t.Errorf("%s.%s never reaches %s: map it, or add it to omitted with the reason", src.Name(), f.Name, dst.Name())
+				}
+			}
+			for name := range c.omitted {
+				if _, ok := src.FieldByName(name); !ok {
+					t.Errorf("omitted lists %s, which %s no longer has", name, src.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestConverters_FillEveryDTOField(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range converters {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			dto := reflect.ValueOf(c.convert(fill(c.source())))
+			for _, f := range reflect.VisibleFields(dto.Type()) {
+				_, unset := c.unset[f.Name]
+				zero := dto.FieldByIndex(f.Index).IsZero()
+				switch {
+				case zero && !unset:
+					t.Errorf("%s.%s is empty although every source field was set", dto.Type().Name(), f.Name)
+				case !zero && unset:
+					t.Errorf("%s.%s is listed as unset but the converter fills it", dto.Type().Name(), f.Name)
+				}
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Round-Trip Tests
+// ============================================================================
+
+// TestConverters_JSONMatchesModel encodes the source and the DTO and checks
+// that every key they share carries the same value, so a DTO field can't be
+// wired to the wrong source field or renamed away from the model's JSON.
+func TestConverters_JSONMatchesModel(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range converters {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			src := fill(c.source())
+			want := encode(t, src)
+			got := encode(t, c.convert(src))
+
+			for key, value := range got {
+				modelValue, ok := want[key]
+				if !ok {
+					continue
+				}
+				if !reflect.DeepEqual(value, modelValue) {
+					t.Errorf("%q: DTO has %v, model has %v", key, value, modelValue)
+				}
+			}
+		})
+	}
+}
+
+func TestTimestamp_UTC(t *testing.T) {
+	t.Parallel()
+
+	berlin := time.FixedZone("CET", 60*60)
+	got := timestamp(time.Date(2025, 6, 1, 12, 30, 0, 0, berlin))
+	if got != "2025-06-01T11:30:00Z" {
+		t.Errorf("expected the time in UTC, got %s", got)
+	}
+}
+
+func TestLists_EncodeEmptyAsArray(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(map[string]any{
+		"identities": Identities(nil),
+		"passkeys":   Passkeys(nil),
+		"profiles":   PublicProfiles(nil),
+	})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"identities":[],"passkeys":[],"profiles":[]}` {
+		t.Errorf("expected empty arrays, got %s", data)
+	}
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+var fillTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// fill sets every field reachable from ptr to a non-zero value and returns
+// ptr. Strings hold their field's name, so a DTO field wired to the wrong
+// source field shows up in the JSON comparison.
+func fill(ptr any) any {
+	fillValue(reflect.ValueOf(ptr).Elem(), "")
+	return ptr
+}
+
+func fillValue(v reflect.Value, name string) {
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		v.Set(reflect.ValueOf(fillTime))
+		return
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(name)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(7)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(7)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(0.5)
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(v.Elem(), name)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillValue(v.Index(0), name)
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		elem := reflect.New(v.Type().Elem()).Elem()
+		fillValue(key, name+".key")
+		fillValue(elem, name)
+		v.SetMapIndex(key, elem)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Type().Field(i); f.IsExported() {
+				fillValue(v.Field(i), f.Name)
+			}
+		}
+	}
+}
+
+func encode(t *testing.T, v any) map[string]any {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	return out
+}
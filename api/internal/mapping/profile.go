@@ -0,0 +1,99 @@
+package mapping
+
+import "github.com/forgo/saga/api/internal/model"
+
+// ProfileResponse represents a profile in API responses
+type ProfileResponse struct {
+	UserID       string   `json:"user_id"`
+	Bio          *string  `json:"bio,omitempty"`
+	Tagline      *string  `json:"tagline,omitempty"`
+	PhotoURL     *string  `json:"photo_url,omitempty"`
+	Languages    []string `json:"languages,omitempty"`
+	Timezone     *string  `json:"timezone,omitempty"`
+	City         string   `json:"city,omitempty"`
+	Country      string   `json:"country,omitempty"`
+	Visibility   string   `json:"visibility"`
+	ShareEnabled bool     `json:"share_enabled"`
+
+	AccessibilityNeeds []string                   `json:"accessibility_needs,omitempty"`
+	ShareAccessibility bool                       `json:"share_accessibility"`
+	Version            int                        `json:"version"`
+	CreatedOn          string                     `json:"created_on"`
+	UpdatedOn          string                     `json:"updated_on"`
+	Completeness       *model.ProfileCompleteness `json:"completeness,omitempty"` // Own profile view only
+}
+
+// PublicProfileResponse is what other users see
+type PublicProfileResponse struct {
+	UserID         string   `json:"user_id"`
+	Username       *string  `json:"username,omitempty"`
+	Firstname      *string  `json:"firstname,omitempty"`
+	Bio            *string  `json:"bio,omitempty"`
+	Tagline        *string  `json:"tagline,omitempty"`
+	PhotoURL       *string  `json:"photo_url,omitempty"`
+	Languages      []string `json:"languages,omitempty"`
+	City           string   `json:"city,omitempty"`
+	Country        string   `json:"country,omitempty"`
+	Distance       string   `json:"distance,omitempty"`
+	ActivityStatus string   `json:"activity_status,omitempty"`
+	Compatibility  *float64 `json:"compatibility,omitempty"`
+
+	AccessibilityNeeds []string `json:"accessibility_needs,omitempty"` // Only when the owner shares them
+
+	Reputation *model.ReputationDisplay `json:"reputation,omitempty"`
+	Badges     []model.ProfileBadge     `json:"badges,omitempty"`
+}
+
+// Profile converts the user's own profile. Completeness is left for the
+// handler, which computes it only where the client asked.
+func Profile(p *model.UserProfile) ProfileResponse {
+	resp := ProfileResponse{
+		UserID:       p.UserID,
+		Bio:          p.Bio,
+		Tagline:      p.Tagline,
+		PhotoURL:     p.PhotoURL,
+		Languages:    p.Languages,
+		Timezone:     p.Timezone,
+		Visibility:   p.Visibility,
+		ShareEnabled: p.ShareEnabled,
+		Version:      p.Version,
+		CreatedOn:    timestamp(p.CreatedOn),
+		UpdatedOn:    timestamp(p.UpdatedOn),
+
+		AccessibilityNeeds: p.AccessibilityNeeds,
+		ShareAccessibility: p.ShareAccessibility,
+	}
+
+	if p.Location != nil {
+		resp.City = p.Location.City
+		resp.Country = p.Location.Country
+	}
+
+	return resp
+}
+
+// PublicProfile converts a profile as another user sees it
+func PublicProfile(p *model.PublicProfile) PublicProfileResponse {
+	return PublicProfileResponse{
+		UserID:             p.UserID,
+		Username:           p.Username,
+		Firstname:          p.Firstname,
+		Bio:                p.Bio,
+		Tagline:            p.Tagline,
+		PhotoURL:           p.PhotoURL,
+		Languages:          p.Languages,
+		City:               p.City,
+		Country:            p.Country,
+		Distance:           string(p.Distance),
+		ActivityStatus:     string(p.ActivityStatus),
+		Compatibility:      p.Compatibility,
+		Reputation:         p.Reputation,
+		AccessibilityNeeds: p.AccessibilityNeeds,
+		Badges:             p.Badges,
+	}
+}
+
+// PublicProfiles converts profiles, returning an empty slice for none
+func PublicProfiles(profiles []*model.PublicProfile) []PublicProfileResponse {
+	return each(profiles, PublicProfile)
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// AppVersionHeader carries the client app's version, such as 3.2.1. Apps
+// send it with ClientPlatformHeader on every request.
+const AppVersionHeader = "X-App-Version"
+
+// RequireAppVersion turns away apps older than the minimum version for
+// their platform with a 426 Upgrade Required problem saying where to
+// update. Requests that don't name a known platform and a readable version
+// are let through, as are paths under the exempt prefixes, so obsolete apps
+// can still read their configuration and report errors.
+func RequireAppVersion(releases map[model.DevicePlatform]model.ClientRelease, exemptPrefixes ...string) Middleware {
+	minimums := make(map[model.DevicePlatform]model.ClientVersion)
+	for platform, release := range releases {
+		if minimum, err := model.ParseClientVersion(release.MinimumVersion); err == nil {
+			minimums[platform] = minimum
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		if len(minimums) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			platform := model.DevicePlatform(strings.ToLower(r.Header.Get(ClientPlatformHeader)))
+			minimum, gated := minimums[platform]
+			if !gated || hasAnyPrefix(r.URL.Path, exemptPrefixes) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			sent := r.Header.Get(AppVersionHeader)
+			version, err := model.ParseClientVersion(sent)
+			if err != nil || !version.Less(minimum) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			release := releases[platform]
+			model.NewUpgradeRequiredError(&model.UpgradeRequired{
+				Platform:       platform,
+				Version:        sent,
+				MinimumVersion: release.MinimumVersion,
+				CurrentVersion: release.CurrentVersion,
+				UpdateURL:      release.UpdateURL,
+			}).WriteJSON(w)
+		})
+	}
+}
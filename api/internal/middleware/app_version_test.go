@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// RequireAppVersion Tests
+// ============================================================================
+
+func newAppVersionHandler() http.Handler {
+	return RequireAppVersion(map[model.DevicePlatform]model.ClientRelease{
+		model.PlatformIOS: {MinimumVersion: "3.0.0", CurrentVersion: "3.4.1", UpdateURL: "https://apps.apple.com/app/saga"},
+		model.PlatformWeb: {CurrentVersion: "2026.10.1"}, // No minimum
+	}, "/v1/meta/")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestRequireAppVersion_ObsoleteClient(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/guilds", nil)
+	req.Header.Set(ClientPlatformHeader, "iOS")
+	req.Header.Set(AppVersionHeader, "2.9.7")
+	rec := httptest.NewRecorder()
+	newAppVersionHandler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUpgradeRequired {
+		t.Fatalf("expected status 426, got %d", rec.Code)
+	}
+	var problem model.ProblemDetails
+	if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+		t.Fatalf("decoding problem: %v", err)
+	}
+	if problem.Code != model.ErrCodeUpgradeRequired || problem.Upgrade == nil {
+		t.Fatalf("expected an upgrade-required problem, got %+v", problem)
+	}
+	if problem.Upgrade.MinimumVersion != "3.0.0" || problem.Upgrade.UpdateURL == "" {
+		t.Errorf("expected the minimum version and update URL, got %+v", problem.Upgrade)
+	}
+}
+
+func TestRequireAppVersion_LetsThrough(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		path     string
+		platform string
+		version  string
+	}{
+		{"supported version", "/v1/guilds", "ios", "3.0.0-beta.2"},
+		{"newer version", "/v1/guilds", "ios", "10.1"},
+		{"no version", "/v1/guilds", "ios", ""},
+		{"unreadable version", "/v1/guilds", "ios", "latest"},
+		{"no platform", "/v1/guilds", "", "1.0.0"},
+		{"platform without minimum", "/v1/guilds", "web", "1.0.0"},
+		{"exempt path", "/v1/meta/client-config", "ios", "1.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set(ClientPlatformHeader, tt.platform)
+			req.Header.Set(AppVersionHeader, tt.version)
+			rec := httptest.NewRecorder()
+			newAppVersionHandler().ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Errorf("expected status 200, got %d", rec.Code)
+			}
+		})
+	}
+}
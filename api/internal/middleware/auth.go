@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/dpop"
 	"github.com/forgo/saga/api/pkg/jwt"
 )
 
@@ -25,9 +26,9 @@ func Auth(authService AuthService) Middleware {
 				return
 			}
 
-			// Check Bearer prefix
+			// Check Bearer or DPoP prefix
 			parts := strings.SplitN(authHeader, " ", 2)
-			if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+			if len(parts) != 2 || !isTokenScheme(parts[0]) {
 				model.NewUnauthorizedError("invalid authorization header format").WriteJSON(w)
 				return
 			}
@@ -58,6 +59,12 @@ func Auth(authService AuthService) Middleware {
 	}
 }
 
+// isTokenScheme reports whether an Authorization scheme carries an access
+// token: Bearer, or DPoP for tokens bound to a client key
+func isTokenScheme(scheme string) bool {
+	return strings.EqualFold(scheme, "Bearer") || strings.EqualFold(scheme, dpop.Scheme)
+}
+
 // ClaimsKey is the context key for JWT claims
 const ClaimsKey contextKey = "claims"
 
@@ -90,6 +97,17 @@ func GetClaims(ctx context.Context) *jwt.Claims {
 
 // AdminAuth returns a middleware that validates JWT tokens and requires admin role
 func AdminAuth(authService AuthService) Middleware {
+	return roleAuth(authService, (*jwt.Claims).IsAdmin, "admin access required")
+}
+
+// SuperAdminAuth returns a middleware that validates JWT tokens and requires
+// superadmin role
+func SuperAdminAuth(authService AuthService) Middleware {
+	return roleAuth(authService, (*jwt.Claims).IsSuperAdmin, "superadmin access required")
+}
+
+// roleAuth validates JWT tokens and requires the claims to pass hasRole
+func roleAuth(authService AuthService, hasRole func(*jwt.Claims) bool, forbidden string) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Extract token from Authorization header
@@ -99,9 +117,9 @@ func AdminAuth(authService AuthService) Middleware {
 				return
 			}
 
-			// Check Bearer prefix
+			// Check Bearer or DPoP prefix
 			parts := strings.SplitN(authHeader, " ", 2)
-			if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+			if len(parts) != 2 || !isTokenScheme(parts[0]) {
 				model.NewUnauthorizedError("invalid authorization header format").WriteJSON(w)
 				return
 			}
@@ -122,9 +140,9 @@ func AdminAuth(authService AuthService) Middleware {
 				return
 			}
 
-			// Check admin role
-			if !claims.IsAdmin() {
-				model.NewForbiddenError("admin access required").WriteJSON(w)
+			// Check role
+			if !hasRole(claims) {
+				model.NewForbiddenError(forbidden).WriteJSON(w)
 				return
 			}
 
@@ -157,8 +175,9 @@ func OptionalAuth(authService AuthService) Middleware {
 
 			token := parts[1]
 			claims, err := authService.ValidateAccessToken(token)
-			if err != nil {
-				// Invalid token, but optional so continue without auth
+			if err != nil || claims.IsBound() {
+				// Invalid token, but optional so continue without auth. A
+				// key-bound token needs a proof this route doesn't check.
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -415,6 +415,65 @@ func TestOptionalAuth_ExpiredToken_ProceedsWithoutAuth(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// AdminAuth() / SuperAdminAuth() Middleware Tests
+// ============================================================================
+
+func TestRoleAuth_RequiresRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		middleware func(AuthService) Middleware
+		role       string
+		wantStatus int
+	}{
+		{name: "admin allows admin", middleware: AdminAuth, role: "admin", wantStatus: http.StatusOK},
+		{name: "admin allows superadmin", middleware: AdminAuth, role: "superadmin", wantStatus: http.StatusOK},
+		{name: "admin rejects moderator", middleware: AdminAuth, role: "moderator", wantStatus: http.StatusForbidden},
+		{name: "superadmin allows superadmin", middleware: SuperAdminAuth, role: "superadmin", wantStatus: http.StatusOK},
+		{name: "superadmin rejects admin", middleware: SuperAdminAuth, role: "admin", wantStatus: http.StatusForbidden},
+		{name: "superadmin rejects user", middleware: SuperAdminAuth, role: "user", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			authSvc := &mockAuthService{
+				validateFunc: func(token string) (*jwt.Claims, error) {
+					return &jwt.Claims{UserID: "user:123", Role: tt.role}, nil
+				},
+			}
+			handler := &captureHandler{}
+			rr := httptest.NewRecorder()
+
+			tt.middleware(authSvc)(handler).ServeHTTP(rr, newTestRequest("Bearer valid-token"))
+
+			if rr.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rr.Code)
+			}
+			if handler.called != (tt.wantStatus == http.StatusOK) {
+				t.Errorf("handler called = %v, want %v", handler.called, tt.wantStatus == http.StatusOK)
+			}
+		})
+	}
+}
+
+func TestRoleAuth_MissingHeader_ReturnsUnauthorized(t *testing.T) {
+	t.Parallel()
+	handler := &captureHandler{}
+	rr := httptest.NewRecorder()
+
+	SuperAdminAuth(successAuthService("user:123", "test@example.com"))(handler).ServeHTTP(rr, newTestRequest(""))
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rr.Code)
+	}
+	if handler.called {
+		t.Error("handler should not have been called")
+	}
+}
+
 // ============================================================================
 // Context Helper Tests
 // ============================================================================
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DeprecationConfig describes a deprecated API version
+type DeprecationConfig struct {
+	DeprecatedOn time.Time // When the version was deprecated; nothing is marked while zero
+	SunsetOn     time.Time // When the version stops working; zero until that's decided
+	Link         string    // Migration guide for clients moving off the version
+}
+
+// Deprecation marks responses as coming from a deprecated API version, so
+// clients can warn before it goes away. It sets Deprecation (RFC 9745),
+// Sunset (RFC 8594) once a sunset is planned, and a Link to the migration
+// guide. With no DeprecatedOn the version isn't deprecated and responses
+// are left alone.
+func Deprecation(cfg DeprecationConfig) Middleware {
+	if cfg.DeprecatedOn.IsZero() {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	deprecation := "@" + strconv.FormatInt(cfg.DeprecatedOn.Unix(), 10)
+	var sunset, link string
+	if !cfg.SunsetOn.IsZero() {
+		sunset = cfg.SunsetOn.UTC().Format(http.TimeFormat)
+	}
+	if cfg.Link != "" {
+		link = "<" + cfg.Link + `>; rel="deprecation"; type="text/html"`
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := w.Header()
+			header.Set("Deprecation", deprecation)
+			if sunset != "" {
+				header.Set("Sunset", sunset)
+			}
+			if link != "" {
+				header.Add("Link", link)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Deprecation Tests
+// ============================================================================
+
+func TestDeprecation_SetsHeaders(t *testing.T) {
+	t.Parallel()
+
+	handler := Deprecation(DeprecationConfig{
+		DeprecatedOn: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		SunsetOn:     time.Date(2027, 7, 1, 0, 0, 0, 0, time.UTC),
+		Link:         "https://saga.forgo.software/docs/v2-migration",
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/guilds", nil))
+
+	if got := rec.Header().Get("Deprecation"); got != "@1798761600" {
+		t.Errorf("expected Deprecation @1798761600, got %q", got)
+	}
+	if got := rec.Header().Get("Sunset"); got != "Thu, 01 Jul 2027 00:00:00 GMT" {
+		t.Errorf("expected an HTTP-date Sunset, got %q", got)
+	}
+	if got := rec.Header().Get("Link"); got != `<https://saga.forgo.software/docs/v2-migration>; rel="deprecation"; type="text/html"` {
+		t.Errorf("expected a deprecation Link, got %q", got)
+	}
+}
+
+func TestDeprecation_NotDeprecated(t *testing.T) {
+	t.Parallel()
+
+	handler := Deprecation(DeprecationConfig{
+		SunsetOn: time.Date(2027, 7, 1, 0, 0, 0, 0, time.UTC),
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/guilds", nil))
+
+	for _, name := range []string{"Deprecation", "Sunset", "Link"} {
+		if got := rec.Header().Get(name); got != "" {
+			t.Errorf("expected no %s header, got %q", name, got)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/dpop"
+)
+
+// ClientPlatformHeader tells token-issuing routes which kind of client is
+// signing in: ios, android or web. Tokens remember it, so a stolen token
+// can't dodge enforcement by claiming to be another client.
+const ClientPlatformHeader = "X-Client-Platform"
+
+// DPoP checks proof of possession (RFC 9449) with a mode per client platform.
+// Off ignores proofs; report binds tokens when a proof is sent and logs what
+// enforce would reject; enforce requires bound tokens and valid proofs.
+type DPoP struct {
+	verifier *dpop.Verifier
+	policy   dpop.Policy
+}
+
+// DPoPConfig holds proof-of-possession configuration
+type DPoPConfig struct {
+	Verifier *dpop.Verifier // Default dpop.NewVerifier with the default max age
+	Policy   dpop.Policy    // Mode per platform; nil is off for all
+}
+
+// NewDPoP creates a new proof-of-possession checker
+func NewDPoP(cfg DPoPConfig) *DPoP {
+	if cfg.Verifier == nil {
+		cfg.Verifier = dpop.NewVerifier(dpop.Config{})
+	}
+	return &DPoP{verifier: cfg.Verifier, policy: cfg.Policy}
+}
+
+// DPoPBind goes on routes that issue tokens. It verifies the client's proof,
+// if any, and passes its key on in the context so the tokens are bound to it.
+// Clients on enforced platforms must send one.
+func DPoPBind(d *DPoP) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			platform := clientPlatform(r)
+			binding := dpop.Binding{Platform: platform}
+
+			mode := d.policy.Mode(platform)
+			if mode != dpop.ModeOff {
+				proof, err := d.verifier.Verify(r.Header.Get(dpop.HeaderName), r.Method, r.URL.Path, "")
+				switch {
+				case err == nil:
+					binding.KeyThumbprint = proof.KeyThumbprint
+				case mode == dpop.ModeEnforce:
+					writeDPoPError(w, err)
+					return
+				default:
+					reportDPoP(r, platform, err)
+				}
+			}
+
+			next.ServeHTTP(w, r.WithContext(dpop.NewContext(r.Context(), binding)))
+		})
+	}
+}
+
+// DPoPProof goes after Auth. Key-bound tokens need a proof from their key;
+// on enforced platforms, unbound tokens are refused. The mode comes from the
+// platform the token was issued to, not from the request. Tokens issued
+// while handling the request, such as after a password change, keep the
+// session's binding.
+func DPoPProof(d *DPoP) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims := GetClaims(r.Context())
+			if claims == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			binding := dpop.Binding{Platform: claims.Platform}
+			if claims.IsBound() {
+				binding.KeyThumbprint = claims.Confirmation.JKT
+			}
+			r = r.WithContext(dpop.NewContext(r.Context(), binding))
+
+			mode := d.policy.Mode(claims.Platform)
+			if mode == dpop.ModeOff {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var err error
+			scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+			switch {
+			case !claims.IsBound():
+				err = dpop.ErrUnboundToken
+			case !strings.EqualFold(scheme, dpop.Scheme):
+				err = dpop.ErrWrongScheme
+			default:
+				var proof *dpop.Proof
+				proof, err = d.verifier.Verify(r.Header.Get(dpop.HeaderName), r.Method, r.URL.Path, token)
+				if err == nil && proof.KeyThumbprint != claims.Confirmation.JKT {
+					err = dpop.ErrKeyMismatch
+				}
+			}
+
+			if err != nil {
+				if mode == dpop.ModeEnforce {
+					writeDPoPError(w, err)
+					return
+				}
+				reportDPoP(r, claims.Platform, err)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientPlatform returns the platform a client says it is, or empty
+func clientPlatform(r *http.Request) string {
+	platform := model.DevicePlatform(strings.ToLower(r.Header.Get(ClientPlatformHeader)))
+	if !platform.IsValid() {
+		return ""
+	}
+	return string(platform)
+}
+
+// writeDPoPError rejects a request with the challenge RFC 9449 describes
+func writeDPoPError(w http.ResponseWriter, err error) {
+	code := "invalid_dpop_proof"
+	if err == dpop.ErrUnboundToken || err == dpop.ErrWrongScheme {
+		code = "invalid_token"
+	}
+	w.Header().Set("WWW-Authenticate", `DPoP algs="ES256", error="`+code+`", error_description="`+err.Error()+`"`)
+	model.NewUnauthorizedError(err.Error()).WriteJSON(w)
+}
+
+// reportDPoP logs what enforcement would have rejected
+func reportDPoP(r *http.Request, platform string, err error) {
+	slog.Warn("dpop report-only",
+		slog.String("platform", platform),
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.String("user_id", GetUserID(r.Context())),
+		slog.String("error", err.Error()),
+	)
+}
@@ -0,0 +1,231 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/forgo/saga/api/pkg/dpop"
+	"github.com/forgo/saga/api/pkg/jwt"
+)
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+func newDPoPKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	jkt, err := dpop.Thumbprint(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("Thumbprint failed: %v", err)
+	}
+	return key, jkt
+}
+
+func newDPoPRequest(t *testing.T, method, path string, key *ecdsa.PrivateKey, accessToken string) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	if key != nil {
+		proof, err := dpop.Sign(key, method, "https://api.example.com"+path, accessToken)
+		if err != nil {
+			t.Fatalf("Sign failed: %v", err)
+		}
+		req.Header.Set(dpop.HeaderName, proof)
+	}
+	return req
+}
+
+// withClaims runs the request as if Auth had accepted claims
+func withClaims(req *http.Request, claims *jwt.Claims) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), ClaimsKey, claims))
+}
+
+// ============================================================================
+// DPoPBind() Tests
+// ============================================================================
+
+func TestDPoPBind_BindsValidProof(t *testing.T) {
+	key, jkt := newDPoPKey(t)
+	d := NewDPoP(DPoPConfig{Policy: dpop.Policy{"ios": dpop.ModeEnforce}})
+
+	req := newDPoPRequest(t, http.MethodPost, "/v1/auth/login", key, "")
+	req.Header.Set(ClientPlatformHeader, "iOS")
+	handler := &captureHandler{}
+	rr := httptest.NewRecorder()
+	DPoPBind(d)(handler).ServeHTTP(rr, req)
+
+	if !handler.called {
+		t.Fatalf("expected next to be called, got %d", rr.Code)
+	}
+	binding := dpop.FromContext(handler.ctx)
+	if binding.KeyThumbprint != jkt || binding.Platform != "ios" {
+		t.Errorf("expected binding to the proof's key on ios, got %+v", binding)
+	}
+}
+
+func TestDPoPBind_Modes(t *testing.T) {
+	d := NewDPoP(DPoPConfig{Policy: dpop.Policy{"ios": dpop.ModeEnforce, "android": dpop.ModeReport}})
+
+	tests := []struct {
+		name       string
+		platform   string
+		wantCalled bool
+	}{
+		{"enforced platform needs a proof", "ios", false},
+		{"report-only platform proceeds", "android", true},
+		{"off platform proceeds", "web", true},
+		{"unknown platform proceeds", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newDPoPRequest(t, http.MethodPost, "/v1/auth/login", nil, "")
+			req.Header.Set(ClientPlatformHeader, tt.platform)
+			handler := &captureHandler{}
+			rr := httptest.NewRecorder()
+			DPoPBind(d)(handler).ServeHTTP(rr, req)
+
+			if handler.called != tt.wantCalled {
+				t.Fatalf("expected called=%v, got %v (%d)", tt.wantCalled, handler.called, rr.Code)
+			}
+			if !tt.wantCalled {
+				if rr.Code != http.StatusUnauthorized || !strings.Contains(rr.Header().Get("WWW-Authenticate"), `error="invalid_dpop_proof"`) {
+					t.Errorf("expected 401 with a DPoP challenge, got %d %q", rr.Code, rr.Header().Get("WWW-Authenticate"))
+				}
+				return
+			}
+			if binding := dpop.FromContext(handler.ctx); binding.KeyThumbprint != "" {
+				t.Errorf("expected no key without a proof, got %+v", binding)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// DPoPProof() Tests
+// ============================================================================
+
+func TestDPoPProof_BoundToken(t *testing.T) {
+	key, jkt := newDPoPKey(t)
+	otherKey, _ := newDPoPKey(t)
+	d := NewDPoP(DPoPConfig{Policy: dpop.Policy{"ios": dpop.ModeEnforce}})
+	claims := &jwt.Claims{UserID: "user:123", Platform: "ios", Confirmation: &jwt.Confirmation{JKT: jkt}}
+
+	tests := []struct {
+		name       string
+		scheme     string
+		key        *ecdsa.PrivateKey
+		proofToken string
+		wantCalled bool
+	}{
+		{"valid proof", "DPoP", key, "access-token", true},
+		{"missing proof", "DPoP", nil, "", false},
+		{"sent as bearer", "Bearer", key, "access-token", false},
+		{"proof from another key", "DPoP", otherKey, "access-token", false},
+		{"proof for another token", "DPoP", key, "other-token", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newDPoPRequest(t, http.MethodGet, "/v1/profile", tt.key, tt.proofToken)
+			req.Header.Set("Authorization", tt.scheme+" access-token")
+			handler := &captureHandler{}
+			rr := httptest.NewRecorder()
+			DPoPProof(d)(handler).ServeHTTP(rr, withClaims(req, claims))
+
+			if handler.called != tt.wantCalled {
+				t.Fatalf("expected called=%v, got %v (%d)", tt.wantCalled, handler.called, rr.Code)
+			}
+			if !tt.wantCalled && rr.Code != http.StatusUnauthorized {
+				t.Errorf("expected 401, got %d", rr.Code)
+			}
+			if tt.wantCalled && dpop.FromContext(handler.ctx).KeyThumbprint != jkt {
+				t.Error("expected the session's binding to be passed on")
+			}
+		})
+	}
+}
+
+func TestDPoPProof_UnboundToken(t *testing.T) {
+	d := NewDPoP(DPoPConfig{Policy: dpop.Policy{"ios": dpop.ModeEnforce, "android": dpop.ModeReport}})
+
+	tests := []struct {
+		platform   string
+		wantCalled bool
+	}{
+		{"ios", false},
+		{"android", true},
+		{"web", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.platform, func(t *testing.T) {
+			req := newTestRequest("Bearer access-token")
+			handler := &captureHandler{}
+			rr := httptest.NewRecorder()
+			DPoPProof(d)(handler).ServeHTTP(rr, withClaims(req, &jwt.Claims{UserID: "user:123", Platform: tt.platform}))
+
+			if handler.called != tt.wantCalled {
+				t.Fatalf("expected called=%v, got %v (%d)", tt.wantCalled, handler.called, rr.Code)
+			}
+			if !tt.wantCalled && !strings.Contains(rr.Header().Get("WWW-Authenticate"), `error="invalid_token"`) {
+				t.Errorf("expected invalid_token challenge, got %q", rr.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestDPoPProof_ReportOnly_Proceeds(t *testing.T) {
+	_, jkt := newDPoPKey(t)
+	d := NewDPoP(DPoPConfig{Policy: dpop.Policy{"android": dpop.ModeReport}})
+
+	req := newTestRequest("DPoP access-token")
+	handler := &captureHandler{}
+	rr := httptest.NewRecorder()
+	claims := &jwt.Claims{UserID: "user:123", Platform: "android", Confirmation: &jwt.Confirmation{JKT: jkt}}
+	DPoPProof(d)(handler).ServeHTTP(rr, withClaims(req, claims))
+
+	if !handler.called {
+		t.Errorf("expected report-only to let a missing proof through, got %d", rr.Code)
+	}
+}
+
+func TestDPoPProof_NoClaims_Proceeds(t *testing.T) {
+	d := NewDPoP(DPoPConfig{Policy: dpop.Policy{"ios": dpop.ModeEnforce}})
+
+	handler := &captureHandler{}
+	DPoPProof(d)(handler).ServeHTTP(httptest.NewRecorder(), newTestRequest(""))
+
+	if !handler.called {
+		t.Error("expected next to be called")
+	}
+}
+
+func TestAuth_AcceptsDPoPScheme(t *testing.T) {
+	handler := &captureHandler{}
+	rr := httptest.NewRecorder()
+	Auth(successAuthService("user:123", "test@example.com"))(handler).ServeHTTP(rr, newTestRequest("DPoP token"))
+
+	if !handler.called {
+		t.Errorf("expected DPoP scheme to be accepted, got %d", rr.Code)
+	}
+}
+
+func TestOptionalAuth_IgnoresBoundToken(t *testing.T) {
+	authSvc := &mockAuthService{validateFunc: func(token string) (*jwt.Claims, error) {
+		return &jwt.Claims{UserID: "user:123", Confirmation: &jwt.Confirmation{JKT: "jkt"}}, nil
+	}}
+	handler := &captureHandler{}
+	OptionalAuth(authSvc)(handler).ServeHTTP(httptest.NewRecorder(), newTestRequest("Bearer token"))
+
+	if !handler.called || GetUserID(handler.ctx) != "" {
+		t.Error("expected a key-bound token to be treated as anonymous")
+	}
+}
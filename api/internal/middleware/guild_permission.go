@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildPermissionSource defines the interface for looking up what a member
+// may do in a guild
+type GuildPermissionSource interface {
+	MemberPermissions(ctx context.Context, userID, guildID string) (*model.GuildPermissions, error)
+}
+
+// GuildPermissionsKey is the context key for the member's guild permissions
+const GuildPermissionsKey contextKey = "guildPermissions"
+
+// GetGuildPermissions extracts the member's guild permissions from context
+func GetGuildPermissions(ctx context.Context) *model.GuildPermissions {
+	if perms, ok := ctx.Value(GuildPermissionsKey).(*model.GuildPermissions); ok {
+		return perms
+	}
+	return nil
+}
+
+// RequireGuildPermission returns a middleware that lets a request through
+// only if the user holds every one of perms in the guild from the URL path.
+// Like GuildAccess, non-members get 404 so guild existence isn't leaked;
+// members missing a permission get 403.
+func RequireGuildPermission(source GuildPermissionSource, perms ...model.Permission) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID := GetUserID(r.Context())
+			if userID == "" {
+				model.NewUnauthorizedError("authentication required").WriteJSON(w)
+				return
+			}
+
+			guildID := extractGuildID(r.URL.Path)
+			if guildID == "" {
+				model.NewBadRequestError("invalid guild ID").WriteJSON(w)
+				return
+			}
+
+			held, err := source.MemberPermissions(r.Context(), userID, guildID)
+			if err != nil || held == nil {
+				model.NewNotFoundError("guild").WriteJSON(w)
+				return
+			}
+
+			if !held.HasAll(perms) {
+				model.NewForbiddenError("guild role doesn't allow this action").WriteJSON(w)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), GuildIDKey, guildID)
+			ctx = context.WithValue(ctx, GuildPermissionsKey, held)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+type fakePermissionSource struct {
+	perms *model.GuildPermissions
+	err   error
+}
+
+func (f *fakePermissionSource) MemberPermissions(ctx context.Context, userID, guildID string) (*model.GuildPermissions, error) {
+	return f.perms, f.err
+}
+
+func servePermissionRequest(source GuildPermissionSource, userID string, perms ...model.Permission) (*httptest.ResponseRecorder, *captureHandler) {
+	handler := &captureHandler{}
+	req := httptest.NewRequest(http.MethodPost, "/v1/guilds/guild:123/pools", nil)
+	if userID != "" {
+		req = req.WithContext(context.WithValue(req.Context(), UserIDKey, userID))
+	}
+	rr := httptest.NewRecorder()
+
+	RequireGuildPermission(source, perms...)(handler).ServeHTTP(rr, req)
+	return rr, handler
+}
+
+func TestRequireGuildPermission_NoUserID_ReturnsUnauthorized(t *testing.T) {
+	t.Parallel()
+	rr, handler := servePermissionRequest(&fakePermissionSource{}, "", model.PermissionManagePools)
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rr.Code)
+	}
+	if handler.called {
+		t.Error("handler should not have been called")
+	}
+}
+
+func TestRequireGuildPermission_NotMember_ReturnsNotFound(t *testing.T) {
+	t.Parallel()
+	source := &fakePermissionSource{err: errors.New("not a member of this guild")}
+	rr, handler := servePermissionRequest(source, "user:1", model.PermissionManagePools)
+
+	// Returns 404 instead of 403 to not leak guild existence
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, rr.Code)
+	}
+	if handler.called {
+		t.Error("handler should not have been called")
+	}
+}
+
+func TestRequireGuildPermission_MissingPermission_ReturnsForbidden(t *testing.T) {
+	t.Parallel()
+	source := &fakePermissionSource{perms: &model.GuildPermissions{
+		GuildID:     "guild:123",
+		Role:        model.GuildRoleMember,
+		Permissions: model.GuildRoleMember.DefaultPermissions(),
+	}}
+	rr, handler := servePermissionRequest(source, "user:1", model.PermissionManagePools)
+
+	if rr.Code != http.StatusForbidden {
+		t.Errorf("expected status %d, got %d", http.StatusForbidden, rr.Code)
+	}
+	if handler.called {
+		t.Error("handler should not have been called")
+	}
+}
+
+func TestRequireGuildPermission_HoldsPermission_Proceeds(t *testing.T) {
+	t.Parallel()
+	held := &model.GuildPermissions{
+		GuildID:     "guild:123",
+		Role:        model.GuildRoleMember,
+		Permissions: model.MergePermissions(model.GuildRoleMember.DefaultPermissions(), []model.Permission{model.PermissionManagePools}),
+	}
+	rr, handler := servePermissionRequest(&fakePermissionSource{perms: held}, "user:1", model.PermissionManagePools)
+
+	if rr.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+	if !handler.called {
+		t.Fatal("handler should have been called")
+	}
+	if got := GetGuildID(handler.ctx); got != "guild:123" {
+		t.Errorf("expected guild ID 'guild:123', got %q", got)
+	}
+	if got := GetGuildPermissions(handler.ctx); got != held {
+		t.Errorf("expected permissions in context, got %+v", got)
+	}
+}
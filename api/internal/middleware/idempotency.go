@@ -2,16 +2,38 @@ package middleware
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"io"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
+
+	"github.com/forgo/saga/api/internal/model"
 )
 
-// IdempotencyStore stores idempotency key results
-type IdempotencyStore struct {
+// IdempotencyStore keeps the responses to requests sent with an
+// Idempotency-Key so retries of a request get its original response.
+//
+// Claim takes key for a new request and returns nil, or returns the stored
+// response when the request was already handled. While another request
+// holds the key it may wait for that request's response, returning
+// model.ErrIdempotencyKeyInUse if it gives up. Complete stores the response
+// for the key; Release gives the key up without one.
+type IdempotencyStore interface {
+	Claim(ctx context.Context, key string) (*model.IdempotentResponse, error)
+	Complete(ctx context.Context, key string, response *model.IdempotentResponse) error
+	Release(ctx context.Context, key string) error
+}
+
+// MemoryIdempotencyStore keeps idempotency key results in process memory.
+// They are lost on restart and not shared between instances; use
+// service.IdempotencyService to keep them in the database.
+type MemoryIdempotencyStore struct {
 	mu       sync.RWMutex
 	entries  map[string]*idempotencyEntry
 	ttl      time.Duration
@@ -27,14 +49,14 @@ type idempotencyEntry struct {
 	done      chan struct{}
 }
 
-// IdempotencyConfig holds configuration for idempotency middleware
+// IdempotencyConfig holds configuration for the in-memory idempotency store
 type IdempotencyConfig struct {
 	TTL     time.Duration // How long to keep idempotency results (default 24h)
 	Cleanup time.Duration // Cleanup interval (default 1h)
 }
 
-// NewIdempotencyStore creates a new idempotency store
-func NewIdempotencyStore(cfg IdempotencyConfig) *IdempotencyStore {
+// NewIdempotencyStore creates a new in-memory idempotency store
+func NewIdempotencyStore(cfg IdempotencyConfig) *MemoryIdempotencyStore {
 	if cfg.TTL == 0 {
 		cfg.TTL = 24 * time.Hour
 	}
@@ -42,7 +64,7 @@ func NewIdempotencyStore(cfg IdempotencyConfig) *IdempotencyStore {
 		cfg.Cleanup = time.Hour
 	}
 
-	store := &IdempotencyStore{
+	store := &MemoryIdempotencyStore{
 		entries:  make(map[string]*idempotencyEntry),
 		ttl:      cfg.TTL,
 		stopChan: make(chan struct{}),
@@ -54,11 +76,78 @@ func NewIdempotencyStore(cfg IdempotencyConfig) *IdempotencyStore {
 }
 
 // Stop stops the cleanup goroutine
-func (s *IdempotencyStore) Stop() {
+func (s *MemoryIdempotencyStore) Stop() {
 	close(s.stopChan)
 }
 
-func (s *IdempotencyStore) cleanupLoop(interval time.Duration) {
+// Claim implements IdempotencyStore, waiting for an in-flight request with
+// the same key for as long as ctx allows
+func (s *MemoryIdempotencyStore) Claim(ctx context.Context, key string) (*model.IdempotentResponse, error) {
+	for {
+		s.mu.Lock()
+		entry, exists := s.entries[key]
+		if !exists || (!entry.inFlight && !entry.expiresAt.After(time.Now())) {
+			// Create new entry to mark request as in-flight
+			s.entries[key] = &idempotencyEntry{
+				inFlight: true,
+				done:     make(chan struct{}),
+			}
+			s.mu.Unlock()
+			return nil, nil
+		}
+		if !entry.inFlight {
+			response := &model.IdempotentResponse{
+				Status:  entry.status,
+				Headers: entry.headers,
+				Body:    entry.body,
+			}
+			s.mu.Unlock()
+			return response, nil
+		}
+		s.mu.Unlock()
+
+		// Request is still processing, wait for it
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, model.ErrIdempotencyKeyInUse
+		}
+	}
+}
+
+// Complete implements IdempotencyStore
+func (s *MemoryIdempotencyStore) Complete(ctx context.Context, key string, response *model.IdempotentResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.entries[key]
+	if !exists || !entry.inFlight {
+		return nil
+	}
+	entry.status = response.Status
+	entry.headers = response.Headers
+	entry.body = response.Body
+	entry.expiresAt = time.Now().Add(s.ttl)
+	entry.inFlight = false
+	close(entry.done)
+	return nil
+}
+
+// Release implements IdempotencyStore
+func (s *MemoryIdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.entries[key]
+	if !exists || !entry.inFlight {
+		return nil
+	}
+	delete(s.entries, key)
+	close(entry.done)
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) cleanupLoop(interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
@@ -72,7 +161,7 @@ func (s *IdempotencyStore) cleanupLoop(interval time.Duration) {
 	}
 }
 
-func (s *IdempotencyStore) cleanup() {
+func (s *MemoryIdempotencyStore) cleanup() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -112,8 +201,13 @@ func (w *idempotencyResponseWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
-// Idempotency returns middleware that handles idempotency keys for POST/PATCH requests
-func Idempotency(store *IdempotencyStore) Middleware {
+// Idempotency returns middleware that handles idempotency keys for POST/PATCH
+// requests. Keys are scoped to the caller: the signed-in user, read from the
+// access token when authService is set since this runs before Auth, or else
+// the client IP. A retry sent while the original is still being handled
+// waits for its response, or gets 409 Conflict if it takes too long. When
+// the store fails, requests are handled without deduplication.
+func Idempotency(store IdempotencyStore, authService AuthService) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Only apply to POST and PATCH requests
@@ -130,12 +224,6 @@ func Idempotency(store *IdempotencyStore) Middleware {
 				return
 			}
 
-			// Get user ID from context
-			userID := GetUserID(r.Context())
-			if userID == "" {
-				userID = r.RemoteAddr // Fallback for unauthenticated requests
-			}
-
 			// Read and restore request body
 			body, err := io.ReadAll(r.Body)
 			if err != nil {
@@ -145,57 +233,40 @@ func Idempotency(store *IdempotencyStore) Middleware {
 			r.Body = io.NopCloser(bytes.NewReader(body))
 
 			// Generate composite key
-			key := generateKey(userID, idempotencyKey, r.Method, r.URL.Path, body)
-
-			// Check if we have a cached response
-			store.mu.Lock()
-			entry, exists := store.entries[key]
-
-			if exists {
-				if entry.inFlight {
-					// Request is still processing, wait for it
-					store.mu.Unlock()
-					<-entry.done
-
-					// Now get the completed entry
-					store.mu.RLock()
-					entry = store.entries[key]
-					store.mu.RUnlock()
-
-					if entry != nil && !entry.inFlight {
-						// Return cached response
-						for k, v := range entry.headers {
-							for _, val := range v {
-								w.Header().Add(k, val)
-							}
-						}
-						w.Header().Set("X-Idempotency-Replayed", "true")
-						w.WriteHeader(entry.status)
-						_, _ = w.Write(entry.body)
-						return
-					}
-				} else if entry.expiresAt.After(time.Now()) {
-					// Return cached response
-					store.mu.Unlock()
-					for k, v := range entry.headers {
-						for _, val := range v {
-							w.Header().Add(k, val)
-						}
+			key := generateKey(idempotencyCaller(r, authService), idempotencyKey, r.Method, r.URL.Path, body)
+
+			// Check if we have a stored response
+			stored, err := store.Claim(r.Context(), key)
+			if errors.Is(err, model.ErrIdempotencyKeyInUse) {
+				w.Header().Set("Retry-After", strconv.Itoa(int(model.IdempotencyWait.Seconds())))
+				model.NewConflictError(err.Error()).WriteJSON(w)
+				return
+			}
+			if err != nil {
+				slog.Warn("failed to claim idempotency key", slog.String("error", err.Error()))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if stored != nil {
+				for k, v := range stored.Headers {
+					for _, val := range v {
+						w.Header().Add(k, val)
 					}
-					w.Header().Set("X-Idempotency-Replayed", "true")
-					w.WriteHeader(entry.status)
-					_, _ = w.Write(entry.body)
-					return
 				}
+				w.Header().Set("X-Idempotency-Replayed", "true")
+				w.WriteHeader(stored.Status)
+				_, _ = w.Write(stored.Body)
+				return
 			}
 
-			// Create new entry to mark request as in-flight
-			entry = &idempotencyEntry{
-				inFlight: true,
-				done:     make(chan struct{}),
-			}
-			store.entries[key] = entry
-			store.mu.Unlock()
+			// Give the key back if the handler panics or the response can't be
+			// stored, so retries can run
+			completed := false
+			defer func() {
+				if !completed {
+					_ = store.Release(context.WithoutCancel(r.Context()), key)
+				}
+			}()
 
 			// Wrap response writer to capture response
 			irw := &idempotencyResponseWriter{
@@ -206,15 +277,30 @@ func Idempotency(store *IdempotencyStore) Middleware {
 			// Process the request
 			next.ServeHTTP(irw, r)
 
-			// Cache the response
-			store.mu.Lock()
-			entry.status = irw.status
-			entry.headers = irw.Header().Clone()
-			entry.body = irw.body.Bytes()
-			entry.expiresAt = time.Now().Add(store.ttl)
-			entry.inFlight = false
-			close(entry.done)
-			store.mu.Unlock()
+			// Store the response
+			response := &model.IdempotentResponse{
+				Status:  irw.status,
+				Headers: irw.Header().Clone(),
+				Body:    irw.body.Bytes(),
+			}
+			if err := store.Complete(context.WithoutCancel(r.Context()), key, response); err != nil {
+				slog.Warn("failed to store idempotent response", slog.String("error", err.Error()))
+				return
+			}
+			completed = true
 		})
 	}
 }
+
+// idempotencyCaller identifies who sent r, for scoping idempotency keys
+func idempotencyCaller(r *http.Request, authService AuthService) string {
+	if userID := GetUserID(r.Context()); userID != "" {
+		return userID
+	}
+	if authService != nil {
+		if claims := bearerClaims(r, authService); claims != nil {
+			return claims.UserID
+		}
+	}
+	return ClientIP(r) // Fallback for unauthenticated requests
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +11,8 @@ import (
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/forgo/saga/api/internal/model"
 )
 
 // ============================================================================
@@ -150,7 +153,7 @@ func TestIdempotency_SkipsGET_Proceeds(t *testing.T) {
 	defer store.Stop()
 
 	handler := &captureHandler{}
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/test", nil)
 	req.Header.Set("Idempotency-Key", "test-key")
@@ -172,7 +175,7 @@ func TestIdempotency_SkipsDELETE_Proceeds(t *testing.T) {
 	defer store.Stop()
 
 	handler := &captureHandler{}
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	req := httptest.NewRequest(http.MethodDelete, "/api/test", nil)
 	req.Header.Set("Idempotency-Key", "test-key")
@@ -191,7 +194,7 @@ func TestIdempotency_SkipsPUT_Proceeds(t *testing.T) {
 	defer store.Stop()
 
 	handler := &captureHandler{}
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	req := httptest.NewRequest(http.MethodPut, "/api/test", nil)
 	req.Header.Set("Idempotency-Key", "test-key")
@@ -219,7 +222,7 @@ func TestIdempotency_POST_NoKey_ProceedsNormally(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 		_, _ = w.Write([]byte("created"))
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// First request
 	req1 := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -242,7 +245,7 @@ func TestIdempotency_PATCH_NoKey_ProceedsNormally(t *testing.T) {
 	defer store.Stop()
 
 	handler := &captureHandler{}
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	req := httptest.NewRequest(http.MethodPatch, "/api/test", bytes.NewReader([]byte(`{}`)))
 	// No Idempotency-Key header
@@ -269,7 +272,7 @@ func TestIdempotency_CacheMiss_ProcessesAndCaches(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 		_, _ = w.Write([]byte(`{"id":"123"}`))
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
 	req.Header.Set("Idempotency-Key", "unique-key")
@@ -301,7 +304,7 @@ func TestIdempotency_CacheHit_ReturnsCachedResponse(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 		_, _ = w.Write([]byte(`{"id":"123"}`))
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// First request
 	req1 := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -343,7 +346,7 @@ func TestIdempotency_CacheHit_CopiesOriginalHeaders(t *testing.T) {
 		w.Header().Add("X-Multi", "value2")
 		w.WriteHeader(http.StatusOK)
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// First request
 	req1 := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -385,7 +388,7 @@ func TestIdempotency_UsesUserID_WhenAuthenticated(t *testing.T) {
 		callCount++
 		w.WriteHeader(http.StatusOK)
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// Request from user A
 	req1 := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -419,7 +422,7 @@ func TestIdempotency_FallsBackToRemoteAddr_WhenUnauthenticated(t *testing.T) {
 		callCount++
 		w.WriteHeader(http.StatusOK)
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// Request from IP A
 	req1 := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -461,7 +464,7 @@ func TestIdempotency_InFlight_SecondRequestWaits(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 		_, _ = w.Write([]byte(`{"result":"done"}`))
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	var wg sync.WaitGroup
 	results := make([]*httptest.ResponseRecorder, 2)
@@ -530,7 +533,7 @@ func TestIdempotencyStore_Cleanup_RemovesExpiredEntries(t *testing.T) {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// Make a request to create an entry
 	req := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -573,7 +576,7 @@ func TestIdempotencyStore_Cleanup_KeepsNonExpiredEntries(t *testing.T) {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// Make a request to create an entry
 	req := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -667,7 +670,7 @@ func TestIdempotency_RestoresRequestBody(t *testing.T) {
 		receivedBody, _ = io.ReadAll(r.Body)
 		w.WriteHeader(http.StatusOK)
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	originalBody := `{"key":"value","nested":{"a":1}}`
 	req := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(originalBody)))
@@ -700,7 +703,7 @@ func TestIdempotency_ExpiredEntry_ProcessesAgain(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("response"))
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// First request
 	req1 := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -738,7 +741,7 @@ func TestIdempotency_EmptyIdempotencyKey_ProceedsNormally(t *testing.T) {
 		callCount++
 		w.WriteHeader(http.StatusOK)
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// Request with empty idempotency key header
 	req := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -763,7 +766,7 @@ func TestIdempotency_PATCH_WithKey_Works(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("patched"))
 	})
-	middleware := Idempotency(store)
+	middleware := Idempotency(store, nil)
 
 	// First PATCH request
 	req1 := httptest.NewRequest(http.MethodPatch, "/api/test", bytes.NewReader([]byte(`{}`)))
@@ -786,3 +789,82 @@ func TestIdempotency_PATCH_WithKey_Works(t *testing.T) {
 		t.Error("second PATCH should be replayed")
 	}
 }
+
+// ============================================================================
+// Store Outcome Tests
+// ============================================================================
+
+type busyIdempotencyStore struct{ err error }
+
+func (s busyIdempotencyStore) Claim(ctx context.Context, key string) (*model.IdempotentResponse, error) {
+	return nil, s.err
+}
+
+func (s busyIdempotencyStore) Complete(ctx context.Context, key string, response *model.IdempotentResponse) error {
+	return nil
+}
+
+func (s busyIdempotencyStore) Release(ctx context.Context, key string) error {
+	return nil
+}
+
+func TestIdempotency_KeyInUse_Returns409(t *testing.T) {
+	t.Parallel()
+	handler := &captureHandler{}
+	middleware := Idempotency(busyIdempotencyStore{err: model.ErrIdempotencyKeyInUse}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("Idempotency-Key", "busy-key")
+	rr := httptest.NewRecorder()
+	middleware(handler).ServeHTTP(rr, req)
+
+	if handler.called {
+		t.Error("handler should not be called while the key is in use")
+	}
+	if rr.Code != http.StatusConflict {
+		t.Errorf("expected status 409, got %d", rr.Code)
+	}
+	if rr.Header().Get("Retry-After") == "" {
+		t.Error("expected a Retry-After header")
+	}
+}
+
+func TestIdempotency_StoreFailure_Proceeds(t *testing.T) {
+	t.Parallel()
+	handler := &captureHandler{}
+	middleware := Idempotency(busyIdempotencyStore{err: errors.New("database unavailable")}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("Idempotency-Key", "key")
+	rr := httptest.NewRecorder()
+	middleware(handler).ServeHTTP(rr, req)
+
+	if !handler.called {
+		t.Error("handler should be called when the store fails")
+	}
+}
+
+func TestIdempotency_ReleasesKeyOnPanic(t *testing.T) {
+	t.Parallel()
+	store := NewIdempotencyStore(IdempotencyConfig{TTL: time.Hour})
+	defer store.Stop()
+
+	panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("Idempotency-Key", "panic-key")
+	func() {
+		defer func() { _ = recover() }()
+		Idempotency(store, nil)(panicking).ServeHTTP(httptest.NewRecorder(), req)
+	}()
+
+	handler := &captureHandler{}
+	retry := httptest.NewRequest(http.MethodPost, "/api/test", bytes.NewReader([]byte(`{}`)))
+	retry.Header.Set("Idempotency-Key", "panic-key")
+	Idempotency(store, nil)(handler).ServeHTTP(httptest.NewRecorder(), retry)
+
+	if !handler.called {
+		t.Error("retry should run after the original request panicked")
+	}
+}
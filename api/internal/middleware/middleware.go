@@ -1,16 +1,20 @@
 package middleware
 
 import (
+	"bufio"
 	"compress/gzip"
 	"context"
+	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"runtime/debug"
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/forgo/saga/api/internal/requestid"
+	"github.com/forgo/saga/api/pkg/redact"
 )
 
 // Middleware is a function that wraps an http.Handler
@@ -28,31 +32,29 @@ func Chain(handler http.Handler, middlewares ...Middleware) http.Handler {
 type contextKey string
 
 const (
-	RequestIDKey contextKey = "requestID"
+	RequestIDKey            = requestid.ContextKey // Where RequestID stores the ID
 	UserIDKey    contextKey = "userID"
 )
 
-// RequestID adds a unique request ID to each request
+// RequestID gives each request an ID, returned in the X-Request-ID response
+// header and carried in the context for logs, database queries, push calls
+// and queued jobs. A client may send its own ID in X-Request-ID; one that
+// isn't valid (see requestid.Valid) is replaced by a new one.
 func RequestID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		requestID := r.Header.Get("X-Request-ID")
-		if requestID == "" {
-			requestID = uuid.New().String()
+		id := r.Header.Get(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
 		}
 
-		ctx := context.WithValue(r.Context(), RequestIDKey, requestID)
-		w.Header().Set("X-Request-ID", requestID)
-
-		next.ServeHTTP(w, r.WithContext(ctx))
+		w.Header().Set(requestid.Header, id)
+		next.ServeHTTP(w, r.WithContext(requestid.NewContext(r.Context(), id)))
 	})
 }
 
 // GetRequestID extracts the request ID from context
 func GetRequestID(ctx context.Context) string {
-	if id, ok := ctx.Value(RequestIDKey).(string); ok {
-		return id
-	}
-	return ""
+	return requestid.FromContext(ctx)
 }
 
 // Logger logs request details using structured logging
@@ -71,6 +73,7 @@ func Logger(next http.Handler) http.Handler {
 		slog.Info("request",
 			slog.String("method", r.Method),
 			slog.String("path", r.URL.Path),
+			slog.String("query", redact.Query(r.URL.RawQuery)),
 			slog.Int("status", wrapped.statusCode),
 			slog.Duration("duration", duration),
 			slog.String("request_id", requestID),
@@ -80,7 +83,8 @@ func Logger(next http.Handler) http.Handler {
 	})
 }
 
-// Recovery recovers from panics and returns a 500 error
+// Recovery recovers from panics and returns a 500 error. The panic value is
+// masked before it is reported, as it often embeds request data.
 func Recovery(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
@@ -88,7 +92,7 @@ func Recovery(next http.Handler) http.Handler {
 				requestID := GetRequestID(r.Context())
 
 				slog.Error("panic recovered",
-					slog.Any("error", err),
+					slog.String("error", redact.String(fmt.Sprint(err))),
 					slog.String("request_id", requestID),
 					slog.String("stack", string(debug.Stack())),
 				)
@@ -123,8 +127,8 @@ func CORS(allowedOrigins []string) Middleware {
 			}
 
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PATCH, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-Request-ID, Idempotency-Key")
-			w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, Retry-After")
+			w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-Request-ID, Idempotency-Key, DPoP, X-Client-Platform, X-App-Version")
+			w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, Retry-After, X-Region, X-Region-Role, WWW-Authenticate")
 			w.Header().Set("Access-Control-Max-Age", "86400")
 
 			// Handle preflight
@@ -138,11 +142,22 @@ func CORS(allowedOrigins []string) Middleware {
 	}
 }
 
+// OpenCORS lets pages on any origin read the response. Wrap individual
+// public routes with it; it overrides the origin that CORS only allows for
+// the configured origins. No credentials are allowed, so browsers never
+// send cookies with these requests.
+func OpenCORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		next.ServeHTTP(w, r)
+	})
+}
+
 // Compress compresses responses using gzip when supported
 func Compress(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Skip compression for SSE
-		if r.Header.Get("Accept") == "text/event-stream" {
+		// Skip compression for SSE and WebSocket upgrades
+		if r.Header.Get("Accept") == "text/event-stream" || strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -176,6 +191,16 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Hijack hands the connection to a WebSocket upgrade, which is logged as
+// switching protocols
+func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := http.NewResponseController(rw.ResponseWriter).Hijack()
+	if err == nil {
+		rw.statusCode = http.StatusSwitchingProtocols
+	}
+	return conn, brw, err
+}
+
 // gzipResponseWriter wraps http.ResponseWriter with gzip
 type gzipResponseWriter struct {
 	http.ResponseWriter
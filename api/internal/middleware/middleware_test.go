@@ -1,15 +1,30 @@
 package middleware
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"github.com/forgo/saga/api/pkg/redact"
 )
 
+// captureLogs sends slog's default output to a buffer for the rest of the
+// test. Tests using it must not be parallel.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
 // ============================================================================
 // Chain Tests
 // ============================================================================
@@ -149,6 +164,26 @@ func TestRequestID_WithHeader_PreservesExisting(t *testing.T) {
 	}
 }
 
+func TestRequestID_InvalidHeader_Replaced(t *testing.T) {
+	t.Parallel()
+
+	handler := &captureHandler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("X-Request-ID", "abc */ DELETE user")
+	rr := httptest.NewRecorder()
+
+	RequestID(handler).ServeHTTP(rr, req)
+
+	responseID := rr.Header().Get("X-Request-ID")
+	if responseID == "abc */ DELETE user" || len(responseID) != 36 {
+		t.Errorf("expected the invalid ID to be replaced with a UUID, got %q", responseID)
+	}
+	if contextID := GetRequestID(handler.ctx); contextID != responseID {
+		t.Errorf("context ID (%q) should match response header (%q)", contextID, responseID)
+	}
+}
+
 func TestRequestID_GeneratedID_IsUUID(t *testing.T) {
 	t.Parallel()
 
@@ -207,6 +242,23 @@ func TestGetRequestID_WrongType_ReturnsEmpty(t *testing.T) {
 // Recovery Tests
 // ============================================================================
 
+func TestRecovery_MasksPanicValue(t *testing.T) {
+	logs := captureLogs(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("no profile for ana@example.com at 37.774929,-122.419416")
+	})
+	Recovery(handler).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	out := logs.String()
+	if !strings.Contains(out, "panic recovered") || !strings.Contains(out, "no profile for [email] at [coords]") {
+		t.Errorf("expected masked panic value in logs, got %s", out)
+	}
+	if strings.Contains(out, "ana@example.com") || strings.Contains(out, "37.774929") {
+		t.Error("expected personal data to be masked")
+	}
+}
+
 func TestRecovery_NoPanic_ProceedsNormally(t *testing.T) {
 	t.Parallel()
 
@@ -425,6 +477,30 @@ func TestCORS_NoOriginHeader_ProceedsWithoutCORS(t *testing.T) {
 	}
 }
 
+func TestOpenCORS_OverridesAllowList(t *testing.T) {
+	t.Parallel()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// Applied globally with an allow-list, then per route
+	wrapped := CORS([]string{"https://allowed.com"})(OpenCORS(handler))
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Origin", "https://organizer.example")
+	rr := httptest.NewRecorder()
+
+	wrapped.ServeHTTP(rr, req)
+
+	if got := rr.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("expected Access-Control-Allow-Origin '*', got %q", got)
+	}
+	if got := rr.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+		t.Errorf("expected no Access-Control-Allow-Credentials, got %q", got)
+	}
+}
+
 // ============================================================================
 // Compress Tests
 // ============================================================================
@@ -507,6 +583,27 @@ func TestCompress_SSERequest_DoesNotCompress(t *testing.T) {
 	}
 }
 
+func TestCompress_WebSocketUpgrade_DoesNotCompress(t *testing.T) {
+	t.Parallel()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := w.(*gzipResponseWriter); ok {
+			t.Error("upgrade should get the connection's writer, not a gzip writer")
+		}
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/events/ws", nil)
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+	rr := httptest.NewRecorder()
+
+	Compress(handler).ServeHTTP(rr, req)
+
+	if rr.Header().Get("Content-Encoding") == "gzip" {
+		t.Error("should not compress WebSocket upgrades")
+	}
+}
+
 // ============================================================================
 // Logger Tests (via responseWriter)
 // ============================================================================
@@ -527,6 +624,34 @@ func TestResponseWriter_CapturesStatusCode(t *testing.T) {
 	}
 }
 
+func TestResponseWriter_Hijack(t *testing.T) {
+	t.Parallel()
+
+	// httptest.ResponseRecorder can't be hijacked
+	rw := &responseWriter{ResponseWriter: httptest.NewRecorder(), statusCode: http.StatusOK}
+	if _, _, err := rw.Hijack(); err == nil {
+		t.Error("expected an error hijacking a recorder")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		conn, _, err := rw.Hijack()
+		if err != nil {
+			t.Errorf("Hijack() error = %v", err)
+			return
+		}
+		_ = conn.Close()
+		if rw.statusCode != http.StatusSwitchingProtocols {
+			t.Errorf("expected status %d after hijacking, got %d", http.StatusSwitchingProtocols, rw.statusCode)
+		}
+	}))
+	defer server.Close()
+
+	if resp, err := http.Get(server.URL); err == nil {
+		_ = resp.Body.Close()
+	}
+}
+
 func TestResponseWriter_DefaultStatusOK(t *testing.T) {
 	t.Parallel()
 
@@ -601,3 +726,16 @@ func TestLogger_CompletesRequest(t *testing.T) {
 		t.Errorf("expected body 'created', got %q", rr.Body.String())
 	}
 }
+
+func TestLogger_MasksQuery(t *testing.T) {
+	logs := captureLogs(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	req := httptest.NewRequest(http.MethodGet, "/v1/profiles/nearby?lat=37.774929&lng=-122.419416&radius_km=5&invite=ana%40example.com", nil)
+	Logger(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	want := `"query":"lat=` + redact.Redacted + `&lng=` + redact.Redacted + `&radius_km=5&invite=` + redact.Email + `"`
+	if !strings.Contains(logs.String(), want) {
+		t.Errorf("expected masked query %s, got %s", want, logs.String())
+	}
+}
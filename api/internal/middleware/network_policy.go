@@ -0,0 +1,187 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"sort"
+	"sync"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DefaultAdminPathPrefixes are where the admin routes live: the admin API,
+// and the profiling and runtime stats endpoints
+var DefaultAdminPathPrefixes = []string{
+	"/v1/admin/",
+	"/debug/",
+}
+
+// AccessRecorder records requests the network policy turns away
+type AccessRecorder interface {
+	RecordClient(ctx context.Context, ip, eventType, detail string)
+}
+
+// NetworkPolicy decides which client addresses may reach the API. Admin
+// routes only accept addresses in the allowlist, when one is set. The deny
+// list turns addresses away from every route; admins manage it at runtime.
+type NetworkPolicy struct {
+	mu       sync.RWMutex
+	allow    []netip.Prefix
+	deny     map[string]deniedNetwork // By entry ID
+	admin    []string
+	recorder AccessRecorder
+	clock    clock.Clock
+}
+
+type deniedNetwork struct {
+	prefix netip.Prefix
+	entry  *model.IPDenyEntry
+}
+
+// NetworkPolicyConfig holds network policy configuration
+type NetworkPolicyConfig struct {
+	AdminAllowlist    []string    // Addresses or CIDR ranges; empty lets admins in from anywhere
+	AdminPathPrefixes []string    // Routes the allowlist guards (default DefaultAdminPathPrefixes)
+	Clock             clock.Clock // Default: the system clock
+
+	// Recorder logs blocked admin requests; nil skips recording
+	Recorder AccessRecorder
+}
+
+// NewNetworkPolicy creates a network policy with an empty deny list
+func NewNetworkPolicy(cfg NetworkPolicyConfig) (*NetworkPolicy, error) {
+	if cfg.AdminPathPrefixes == nil {
+		cfg.AdminPathPrefixes = DefaultAdminPathPrefixes
+	}
+
+	allow := make([]netip.Prefix, 0, len(cfg.AdminAllowlist))
+	for _, network := range cfg.AdminAllowlist {
+		prefix, err := model.ParseNetwork(network)
+		if err != nil {
+			return nil, fmt.Errorf("admin allowlist %q: %w", network, err)
+		}
+		allow = append(allow, prefix)
+	}
+
+	return &NetworkPolicy{
+		allow:    allow,
+		deny:     make(map[string]deniedNetwork),
+		admin:    cfg.AdminPathPrefixes,
+		recorder: cfg.Recorder,
+		clock:    clock.OrReal(cfg.Clock),
+	}, nil
+}
+
+// AdminAllowlist returns the ranges admin routes accept
+func (p *NetworkPolicy) AdminAllowlist() []string {
+	networks := make([]string, 0, len(p.allow))
+	for _, prefix := range p.allow {
+		networks = append(networks, prefix.String())
+	}
+	return networks
+}
+
+// Deny adds an entry to the deny list, replacing any with the same ID.
+// Entries whose range can't be parsed are ignored.
+func (p *NetworkPolicy) Deny(entry *model.IPDenyEntry) {
+	prefix, err := model.ParseNetwork(entry.CIDR)
+	if err != nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.deny[entry.ID] = deniedNetwork{prefix: prefix, entry: entry}
+}
+
+// RemoveDeny takes an entry off the deny list
+func (p *NetworkPolicy) RemoveDeny(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.deny, id)
+}
+
+// DenyList returns the entries still in force, newest first
+func (p *NetworkPolicy) DenyList() []*model.IPDenyEntry {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	now := p.clock.Now()
+	entries := make([]*model.IPDenyEntry, 0, len(p.deny))
+	for _, d := range p.deny {
+		if d.entry.Active(now) {
+			entries = append(entries, d.entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedOn.After(entries[j].CreatedOn)
+	})
+	return entries
+}
+
+// check returns why a request from ip to path is turned away, or "" to let
+// it through. An address that can't be parsed never matches a range, so it
+// is kept out of admin routes whenever there is an allowlist.
+func (p *NetworkPolicy) check(ip, path string) string {
+	addr, err := netip.ParseAddr(ip)
+	valid := err == nil
+	addr = addr.Unmap()
+
+	if valid {
+		if reason := p.denied(addr); reason != "" {
+			return reason
+		}
+	}
+
+	if len(p.allow) == 0 || !hasAnyPrefix(path, p.admin) {
+		return ""
+	}
+	if valid {
+		for _, prefix := range p.allow {
+			if prefix.Contains(addr) {
+				return ""
+			}
+		}
+	}
+	return "address is outside the admin allowlist"
+}
+
+// denied returns why addr is on the deny list, or "" if it isn't
+func (p *NetworkPolicy) denied(addr netip.Addr) string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	now := p.clock.Now()
+	for _, d := range p.deny {
+		if d.entry.Active(now) && d.prefix.Contains(addr) {
+			return fmt.Sprintf("address is on the deny list (%s: %s)", d.prefix, d.entry.Reason)
+		}
+	}
+	return ""
+}
+
+// EnforceNetworkPolicy returns a middleware that turns away denied
+// addresses everywhere, and addresses outside the allowlist on admin routes.
+// Blocked admin requests are recorded; denied clients hitting other routes
+// are not, as an abusive client would flood the log.
+func EnforceNetworkPolicy(p *NetworkPolicy) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := ClientIP(r)
+			reason := p.check(ip, r.URL.Path)
+			if reason == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if p.recorder != nil && hasAnyPrefix(r.URL.Path, p.admin) {
+				detail := fmt.Sprintf("%s %s: %s", r.Method, r.URL.Path, reason)
+				p.recorder.RecordClient(r.Context(), ip, model.SecurityEventAdminAccessBlocked, detail)
+			}
+			model.NewForbiddenError("access from this address is not allowed").WriteJSON(w)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+func newTestNetworkPolicy(t *testing.T, cfg NetworkPolicyConfig) *NetworkPolicy {
+	t.Helper()
+	p, err := NewNetworkPolicy(cfg)
+	if err != nil {
+		t.Fatalf("NewNetworkPolicy() error = %v", err)
+	}
+	return p
+}
+
+// ============================================================================
+// Network Policy Tests
+// ============================================================================
+
+func TestNetworkPolicy_AdminAllowlist(t *testing.T) {
+	t.Parallel()
+	recorder := &scraperRecorderStub{}
+	p := newTestNetworkPolicy(t, NetworkPolicyConfig{
+		AdminAllowlist: []string{"10.0.0.0/8", "203.0.113.7"},
+		Recorder:       recorder,
+	})
+	handler := EnforceNetworkPolicy(p)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	tests := []struct {
+		ip, path string
+		want     int
+	}{
+		{"10.1.2.3", "/v1/admin/users", http.StatusOK},
+		{"203.0.113.7", "/v1/admin/users", http.StatusOK},
+		{"203.0.113.8", "/v1/admin/users", http.StatusForbidden},
+		{"203.0.113.8", "/debug/pprof/", http.StatusForbidden},
+		{"203.0.113.8", "/v1/guilds", http.StatusOK},
+	}
+	for _, tt := range tests {
+		if got := tarpitRequest(handler, tt.ip, tt.path); got != tt.want {
+			t.Errorf("%s %s: status = %d, want %d", tt.ip, tt.path, got, tt.want)
+		}
+	}
+
+	if len(recorder.events) != 2 {
+		t.Fatalf("recorded %d events, want 2", len(recorder.events))
+	}
+	if e := recorder.events[0]; e.ip != "203.0.113.8" || e.eventType != model.SecurityEventAdminAccessBlocked {
+		t.Errorf("recorded %+v, want an admin_access_blocked event for 203.0.113.8", e)
+	}
+}
+
+func TestNetworkPolicy_NoAllowlistAdmitsAnyone(t *testing.T) {
+	t.Parallel()
+	p := newTestNetworkPolicy(t, NetworkPolicyConfig{})
+	handler := EnforceNetworkPolicy(p)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	if got := tarpitRequest(handler, "198.51.100.1", "/v1/admin/users"); got != http.StatusOK {
+		t.Errorf("status = %d, want 200", got)
+	}
+}
+
+func TestNetworkPolicy_DenyList(t *testing.T) {
+	t.Parallel()
+	recorder := &scraperRecorderStub{}
+	clk := fakeclock.New(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	p := newTestNetworkPolicy(t, NetworkPolicyConfig{Recorder: recorder, Clock: clk})
+	handler := EnforceNetworkPolicy(p)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	expires := clk.Now().Add(time.Hour)
+	p.Deny(&model.IPDenyEntry{ID: "ip_deny:1", CIDR: "198.51.100.0/24", Reason: "credential stuffing", ExpiresAt: &expires})
+
+	if got := tarpitRequest(handler, "198.51.100.9", "/v1/guilds"); got != http.StatusForbidden {
+		t.Errorf("denied address: status = %d, want 403", got)
+	}
+	if got := tarpitRequest(handler, "198.51.101.9", "/v1/guilds"); got != http.StatusOK {
+		t.Errorf("address outside the range: status = %d, want 200", got)
+	}
+	if len(recorder.events) != 0 {
+		t.Errorf("recorded %d events for a non-admin route, want 0", len(recorder.events))
+	}
+	if got := tarpitRequest(handler, "198.51.100.9", "/v1/admin/users"); got != http.StatusForbidden {
+		t.Errorf("denied address on admin route: status = %d, want 403", got)
+	}
+	if len(recorder.events) != 1 {
+		t.Errorf("recorded %d events for an admin route, want 1", len(recorder.events))
+	}
+
+	clk.Advance(2 * time.Hour)
+	if got := tarpitRequest(handler, "198.51.100.9", "/v1/guilds"); got != http.StatusOK {
+		t.Errorf("after expiry: status = %d, want 200", got)
+	}
+	if len(p.DenyList()) != 0 {
+		t.Errorf("DenyList() still lists the expired entry")
+	}
+
+	p.Deny(&model.IPDenyEntry{ID: "ip_deny:2", CIDR: "198.51.100.9", Reason: "scraping"})
+	p.RemoveDeny("ip_deny:2")
+	if got := tarpitRequest(handler, "198.51.100.9", "/v1/guilds"); got != http.StatusOK {
+		t.Errorf("after removal: status = %d, want 200", got)
+	}
+}
+
+func TestNewNetworkPolicy_InvalidAllowlist(t *testing.T) {
+	t.Parallel()
+	if _, err := NewNetworkPolicy(NetworkPolicyConfig{AdminAllowlist: []string{"office"}}); err == nil {
+		t.Error("expected an error for an allowlist entry that isn't an address")
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+)
+
+// PresenceRecorder records that a user is active
+type PresenceRecorder interface {
+	Touch(ctx context.Context, userID string)
+}
+
+// Presence returns a middleware that marks the authenticated user active.
+// Wrap it inside auth middleware so the user is known; the recorder decides
+// how often that is written.
+func Presence(recorder PresenceRecorder) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if userID := GetUserID(r.Context()); userID != "" {
+				recorder.Touch(r.Context(), userID)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
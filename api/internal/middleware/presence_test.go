@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// ============================================================================
+// Mock Recorder
+// ============================================================================
+
+type mockPresenceRecorder struct {
+	mu      sync.Mutex
+	touched []string
+}
+
+func (m *mockPresenceRecorder) Touch(ctx context.Context, userID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.touched = append(m.touched, userID)
+}
+
+// ============================================================================
+// Presence() Middleware Tests
+// ============================================================================
+
+func TestPresence_TouchesUser(t *testing.T) {
+	t.Parallel()
+	recorder := &mockPresenceRecorder{}
+	handler := &captureHandler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/profile", nil)
+	req = req.WithContext(context.WithValue(req.Context(), UserIDKey, "user:a"))
+	Presence(recorder)(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	if !handler.called {
+		t.Error("handler should have been called")
+	}
+	if len(recorder.touched) != 1 || recorder.touched[0] != "user:a" {
+		t.Errorf("expected user:a to be touched, got %v", recorder.touched)
+	}
+}
+
+func TestPresence_SkipsAnonymous(t *testing.T) {
+	t.Parallel()
+	recorder := &mockPresenceRecorder{}
+	handler := &captureHandler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/public/events", nil)
+	Presence(recorder)(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	if !handler.called {
+		t.Error("handler should have been called")
+	}
+	if len(recorder.touched) != 0 {
+		t.Errorf("expected no touches without a user, got %v", recorder.touched)
+	}
+}
@@ -0,0 +1,245 @@
+package middleware
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/jwt"
+)
+
+// QuotaCostRule weighs requests whose path starts with PathPrefix. An empty
+// Method matches any method.
+type QuotaCostRule struct {
+	Method     string
+	PathPrefix string
+	Cost       int
+}
+
+// DefaultQuotaCost is what a request matching no cost rule costs
+const DefaultQuotaCost = 1
+
+// DefaultQuotaCostRules weigh the searches that fan out across many users.
+// The first matching rule wins.
+var DefaultQuotaCostRules = []QuotaCostRule{
+	{Method: http.MethodGet, PathPrefix: "/v1/discover/hangout-types", Cost: 1},
+	{Method: http.MethodGet, PathPrefix: "/v1/discover/", Cost: 10},
+	{Method: http.MethodGet, PathPrefix: "/v1/profiles/nearby", Cost: 10},
+	{Method: http.MethodGet, PathPrefix: "/v1/search", Cost: 5},
+	{Method: http.MethodPost, PathPrefix: "/v1/compatibility/batch", Cost: 10},
+	{Method: http.MethodGet, PathPrefix: "/v1/compatibility/", Cost: 5},
+}
+
+// QuotaLimiter tracks the request cost each user spends per UTC day. It sits
+// alongside the RateLimiter: the rate limiter smooths bursts, the quota caps
+// how much work a user can ask for in a day.
+type QuotaLimiter struct {
+	mu         sync.Mutex
+	dailyLimit int
+	costs      []QuotaCostRule
+	day        time.Time      // Midnight UTC of the day being counted
+	used       map[string]int // Cost spent today by user ID
+	limits     map[string]int // Admin overrides of dailyLimit by user ID
+	clock      clock.Clock
+}
+
+// QuotaConfig holds quota limiter configuration
+type QuotaConfig struct {
+	DailyLimit int             // Cost each user may spend per day (default 10000)
+	Costs      []QuotaCostRule // Request weights (default DefaultQuotaCostRules)
+	Clock      clock.Clock     // Default: the system clock
+}
+
+// NewQuotaLimiter creates a new quota limiter
+func NewQuotaLimiter(cfg QuotaConfig) *QuotaLimiter {
+	if cfg.DailyLimit == 0 {
+		cfg.DailyLimit = 10000
+	}
+	if cfg.Costs == nil {
+		cfg.Costs = DefaultQuotaCostRules
+	}
+
+	return &QuotaLimiter{
+		dailyLimit: cfg.DailyLimit,
+		costs:      cfg.Costs,
+		used:       make(map[string]int),
+		limits:     make(map[string]int),
+		clock:      clock.OrReal(cfg.Clock),
+	}
+}
+
+// Cost returns what a request costs against the caller's quota
+func (q *QuotaLimiter) Cost(r *http.Request) int {
+	for _, rule := range q.costs {
+		if rule.Method != "" && rule.Method != r.Method {
+			continue
+		}
+		if strings.HasPrefix(r.URL.Path, rule.PathPrefix) {
+			return rule.Cost
+		}
+	}
+	return DefaultQuotaCost
+}
+
+// Spend charges cost to a user's quota. A request that would take the user
+// over their limit is refused and not charged.
+func (q *QuotaLimiter) Spend(userID string, cost int) (bool, model.QuotaUsage) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.rollover()
+	if q.used[userID]+cost > q.limit(userID) {
+		return false, q.usage(userID)
+	}
+	q.used[userID] += cost
+	return true, q.usage(userID)
+}
+
+// Usage returns a user's usage for today
+func (q *QuotaLimiter) Usage(userID string) model.QuotaUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.rollover()
+	return q.usage(userID)
+}
+
+// TopUsage returns the n users who have spent the most today, highest first
+func (q *QuotaLimiter) TopUsage(n int) []model.QuotaUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.rollover()
+	usages := make([]model.QuotaUsage, 0, len(q.used))
+	for userID := range q.used {
+		usages = append(usages, q.usage(userID))
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Used != usages[j].Used {
+			return usages[i].Used > usages[j].Used
+		}
+		return usages[i].UserID < usages[j].UserID
+	})
+	if len(usages) > n {
+		usages = usages[:n]
+	}
+	return usages
+}
+
+// SetLimit overrides a user's daily limit
+func (q *QuotaLimiter) SetLimit(userID string, limit int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.limits[userID] = limit
+}
+
+// ClearLimit puts a user back on the default daily limit
+func (q *QuotaLimiter) ClearLimit(userID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.limits, userID)
+}
+
+// ResetUsage forgives what a user has spent today
+func (q *QuotaLimiter) ResetUsage(userID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.used, userID)
+}
+
+// rollover starts a new day's count once midnight UTC has passed. Callers
+// must hold mu.
+func (q *QuotaLimiter) rollover() {
+	today := q.clock.Now().UTC().Truncate(24 * time.Hour)
+	if !today.Equal(q.day) {
+		q.day = today
+		q.used = make(map[string]int)
+	}
+}
+
+// limit returns a user's daily limit. Callers must hold mu.
+func (q *QuotaLimiter) limit(userID string) int {
+	if limit, ok := q.limits[userID]; ok {
+		return limit
+	}
+	return q.dailyLimit
+}
+
+// usage builds a user's usage. Callers must hold mu.
+func (q *QuotaLimiter) usage(userID string) model.QuotaUsage {
+	_, override := q.limits[userID]
+	limit := q.limit(userID)
+	used := q.used[userID]
+	remaining := limit - used
+	if remaining < 0 {
+		remaining = 0
+	}
+	return model.QuotaUsage{
+		UserID:     userID,
+		DailyLimit: limit,
+		Used:       used,
+		Remaining:  remaining,
+		ResetsAt:   q.day.Add(24 * time.Hour),
+		Override:   override,
+	}
+}
+
+// Quota returns a middleware that charges each authenticated request's cost
+// to the caller's daily quota. It runs before route auth, so it reads the
+// bearer token itself; requests without a valid token pass through for the
+// route to reject or serve anonymously. Admins are not metered.
+func Quota(limiter *QuotaLimiter, authService AuthService) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims := bearerClaims(r, authService)
+			if claims == nil || claims.IsAdmin() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cost := limiter.Cost(r)
+			allowed, usage := limiter.Spend(claims.UserID, cost)
+
+			w.Header().Set("X-Quota-Limit", strconv.Itoa(usage.DailyLimit))
+			w.Header().Set("X-Quota-Remaining", strconv.Itoa(usage.Remaining))
+			w.Header().Set("X-Quota-Reset", strconv.FormatInt(usage.ResetsAt.Unix(), 10))
+			w.Header().Set("X-Quota-Cost", strconv.Itoa(cost))
+
+			if !allowed {
+				retryAfter := int(time.Until(usage.ResetsAt).Seconds())
+				if retryAfter < 1 {
+					retryAfter = 1
+				}
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+
+				model.NewQuotaExceededError(retryAfter).WriteJSON(w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bearerClaims returns the claims of a valid bearer token, or nil
+func bearerClaims(r *http.Request, authService AuthService) *jwt.Claims {
+	if claims := GetClaims(r.Context()); claims != nil {
+		return claims
+	}
+
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || !isTokenScheme(parts[0]) {
+		return nil
+	}
+
+	claims, err := authService.ValidateAccessToken(parts[1])
+	if err != nil {
+		return nil
+	}
+	return claims
+}
@@ -0,0 +1,210 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/pkg/jwt"
+)
+
+func newTestQuotaLimiter(dailyLimit int, now time.Time) *QuotaLimiter {
+	return NewQuotaLimiter(QuotaConfig{DailyLimit: dailyLimit, Clock: fakeclock.New(now)})
+}
+
+// ============================================================================
+// Cost Tests
+// ============================================================================
+
+func TestQuotaCost(t *testing.T) {
+	t.Parallel()
+	q := NewQuotaLimiter(QuotaConfig{})
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{http.MethodGet, "/v1/discover/people", 10},
+		{http.MethodGet, "/v1/discover/hangout-types", 1},
+		{http.MethodGet, "/v1/profiles/nearby", 10},
+		{http.MethodPost, "/v1/compatibility/batch", 10},
+		{http.MethodGet, "/v1/compatibility/user:1", 5},
+		{http.MethodGet, "/v1/guilds", DefaultQuotaCost},
+		{http.MethodPost, "/v1/discover/people", DefaultQuotaCost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if got := q.Cost(req); got != tt.want {
+				t.Errorf("expected cost %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Spend Tests
+// ============================================================================
+
+func TestQuotaSpend_RefusesOverLimitWithoutCharging(t *testing.T) {
+	t.Parallel()
+	q := newTestQuotaLimiter(25, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	if ok, _ := q.Spend("user:1", 10); !ok {
+		t.Fatal("first spend should be allowed")
+	}
+	if ok, _ := q.Spend("user:1", 10); !ok {
+		t.Fatal("second spend should be allowed")
+	}
+	ok, usage := q.Spend("user:1", 10)
+	if ok {
+		t.Fatal("spend over the limit should be refused")
+	}
+	if usage.Used != 20 || usage.Remaining != 5 {
+		t.Errorf("refused spend should not be charged, got %+v", usage)
+	}
+	if ok, _ := q.Spend("user:1", 5); !ok {
+		t.Error("spend within the remainder should be allowed")
+	}
+	if ok, _ := q.Spend("user:2", 10); !ok {
+		t.Error("users should have separate quotas")
+	}
+}
+
+func TestQuotaSpend_ResetsAtMidnightUTC(t *testing.T) {
+	t.Parallel()
+	clk := fakeclock.New(time.Date(2026, 3, 1, 23, 59, 0, 0, time.UTC))
+	q := NewQuotaLimiter(QuotaConfig{DailyLimit: 10, Clock: clk})
+
+	_, usage := q.Spend("user:1", 10)
+	if want := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC); !usage.ResetsAt.Equal(want) {
+		t.Errorf("expected reset at %v, got %v", want, usage.ResetsAt)
+	}
+	if ok, _ := q.Spend("user:1", 1); ok {
+		t.Fatal("quota should be spent")
+	}
+
+	clk.Advance(2 * time.Minute)
+	if ok, usage := q.Spend("user:1", 1); !ok || usage.Used != 1 {
+		t.Errorf("quota should reset for the new day, got %+v", usage)
+	}
+}
+
+func TestQuotaLimits_OverrideAndReset(t *testing.T) {
+	t.Parallel()
+	q := newTestQuotaLimiter(10, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	q.Spend("user:1", 10)
+	q.SetLimit("user:1", 50)
+	usage := q.Usage("user:1")
+	if usage.DailyLimit != 50 || usage.Remaining != 40 || !usage.Override {
+		t.Errorf("expected override to apply, got %+v", usage)
+	}
+
+	q.ResetUsage("user:1")
+	if usage := q.Usage("user:1"); usage.Used != 0 {
+		t.Errorf("expected usage reset, got %+v", usage)
+	}
+
+	q.ClearLimit("user:1")
+	if usage := q.Usage("user:1"); usage.DailyLimit != 10 || usage.Override {
+		t.Errorf("expected default limit, got %+v", usage)
+	}
+}
+
+func TestQuotaTopUsage(t *testing.T) {
+	t.Parallel()
+	q := newTestQuotaLimiter(100, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	q.Spend("user:a", 5)
+	q.Spend("user:b", 30)
+	q.Spend("user:c", 10)
+
+	top := q.TopUsage(2)
+	if len(top) != 2 || top[0].UserID != "user:b" || top[1].UserID != "user:c" {
+		t.Errorf("expected user:b then user:c, got %+v", top)
+	}
+}
+
+// ============================================================================
+// Quota() Middleware Tests
+// ============================================================================
+
+func TestQuotaMiddleware_ChargesAndSetsHeaders(t *testing.T) {
+	t.Parallel()
+	q := NewQuotaLimiter(QuotaConfig{DailyLimit: 15})
+	authSvc := successAuthService("user:1", "a@example.com")
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/discover/people", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rr := httptest.NewRecorder()
+	handler := &captureHandler{}
+	Quota(q, authSvc)(handler).ServeHTTP(rr, req)
+
+	if !handler.called {
+		t.Fatal("handler should have been called")
+	}
+	if got := rr.Header().Get("X-Quota-Remaining"); got != "5" {
+		t.Errorf("expected X-Quota-Remaining 5, got %q", got)
+	}
+	if got := rr.Header().Get("X-Quota-Cost"); got != "10" {
+		t.Errorf("expected X-Quota-Cost 10, got %q", got)
+	}
+
+	rr = httptest.NewRecorder()
+	handler = &captureHandler{}
+	Quota(q, authSvc)(handler).ServeHTTP(rr, req)
+
+	if handler.called {
+		t.Error("handler should not be called over quota")
+	}
+	if rr.Code != http.StatusTooManyRequests {
+		t.Errorf("expected 429, got %d", rr.Code)
+	}
+	if rr.Header().Get("Retry-After") == "" {
+		t.Error("expected Retry-After header")
+	}
+}
+
+func TestQuotaMiddleware_SkipsAnonymousAndAdmins(t *testing.T) {
+	t.Parallel()
+	q := NewQuotaLimiter(QuotaConfig{DailyLimit: 1})
+	admin := &mockAuthService{
+		validateFunc: func(token string) (*jwt.Claims, error) {
+			return &jwt.Claims{UserID: "user:admin", Role: "admin"}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		authSvc AuthService
+		header  string
+	}{
+		{name: "no token", authSvc: admin, header: ""},
+		{name: "invalid token", authSvc: errorAuthService(jwt.ErrInvalidToken), header: "Bearer bad"},
+		{name: "admin", authSvc: admin, header: "Bearer valid-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for i := 0; i < 3; i++ {
+				rr := httptest.NewRecorder()
+				handler := &captureHandler{}
+				Quota(q, tt.authSvc)(handler).ServeHTTP(rr, newTestRequest(tt.header))
+
+				if !handler.called {
+					t.Fatalf("request %d should not be metered", i)
+				}
+				if rr.Header().Get("X-Quota-Limit") != "" {
+					t.Errorf("expected no quota headers, got %q", rr.Header().Get("X-Quota-Limit"))
+				}
+			}
+		})
+	}
+}
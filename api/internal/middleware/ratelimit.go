@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"net"
 	"net/http"
 	"strconv"
 	"sync"
@@ -141,13 +142,36 @@ func (rl *RateLimiter) Allow(key string) (allowed bool, remaining int, resetTime
 
 // RateLimit returns a middleware that applies rate limiting
 func RateLimit(limiter *RateLimiter) Middleware {
+	return rateLimit(limiter, func(r *http.Request) string {
+		// Get rate limit key (user ID if authenticated, otherwise IP)
+		key := GetUserID(r.Context())
+		if key == "" {
+			key = r.RemoteAddr
+		}
+		return key
+	})
+}
+
+// RateLimitByIP returns a middleware that rate limits by client IP alone,
+// for public endpoints where a client opening new connections from other
+// ports mustn't get a fresh bucket
+func RateLimitByIP(limiter *RateLimiter) Middleware {
+	return rateLimit(limiter, ClientIP)
+}
+
+// ClientIP returns the client's address without its port
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func rateLimit(limiter *RateLimiter, keyFor func(r *http.Request) string) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Get rate limit key (user ID if authenticated, otherwise IP)
-			key := GetUserID(r.Context())
-			if key == "" {
-				key = r.RemoteAddr
-			}
+			key := keyFor(r)
 
 			allowed, remaining, resetTime := limiter.Allow(key)
 
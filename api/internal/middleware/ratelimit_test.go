@@ -596,6 +596,39 @@ func TestRateLimitMiddleware_UsesIP_WhenUnauthenticated(t *testing.T) {
 	}
 }
 
+func TestRateLimitByIP_IgnoresPortAndUser(t *testing.T) {
+	t.Parallel()
+	rl := NewRateLimiter(RateLimitConfig{
+		Rate:   2,
+		Window: time.Minute,
+		Burst:  1, // Small burst (0 triggers default of 20)
+	})
+	defer rl.Stop()
+
+	middleware := RateLimitByIP(rl)
+	handler := &captureHandler{}
+
+	// New connections and a signed in user share the address's bucket
+	// (rate+burst = 3)
+	for i, port := range []string{"1001", "1002", "1003"} {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.RemoteAddr = "192.168.1.1:" + port
+		if i == 2 {
+			req = req.WithContext(context.WithValue(req.Context(), UserIDKey, "user:1"))
+		}
+		middleware(handler).ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.RemoteAddr = "192.168.1.1:1004"
+	rr := httptest.NewRecorder()
+	middleware(handler).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", rr.Code)
+	}
+}
+
 func TestRateLimitMiddleware_RetryAfter_MinimumOne(t *testing.T) {
 	t.Parallel()
 	rl := NewRateLimiter(RateLimitConfig{
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ReadOnlyRetryAfter is the Retry-After hint (seconds) sent with read-only rejections
+const ReadOnlyRetryAfter = 30
+
+// Region returns a middleware that tags responses with the serving region and
+// rejects writes with 503 while the region is read-only. Paths under any of the
+// exempt prefixes are always allowed through so operators can end a drill.
+func Region(state *database.RegionState, exemptPrefixes ...string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			status := state.Status()
+			w.Header().Set("X-Region", status.Name)
+			w.Header().Set("X-Region-Role", string(status.Role))
+
+			if status.ReadOnly && isWriteMethod(r.Method) && !hasAnyPrefix(r.URL.Path, exemptPrefixes) {
+				w.Header().Set("Retry-After", strconv.Itoa(ReadOnlyRetryAfter))
+				model.NewReadOnlyError(status.Name).WriteJSON(w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// isWriteMethod returns true for HTTP methods that may mutate state
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+func hasAnyPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/database"
+)
+
+func TestRegion_ReadOnlyRejectsWrites(t *testing.T) {
+	t.Parallel()
+
+	state := database.NewRegionState(database.RegionConfig{Name: "us-west", Role: database.RegionRoleStandby})
+	handler := Region(state, "/v1/admin/region")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{http.MethodGet, "/v1/guilds", http.StatusOK},
+		{http.MethodPost, "/v1/guilds", http.StatusServiceUnavailable},
+		{http.MethodDelete, "/v1/guilds/guild:1", http.StatusServiceUnavailable},
+		{http.MethodPatch, "/v1/admin/region", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, nil))
+
+		if rr.Code != tt.want {
+			t.Errorf("%s %s: expected %d, got %d", tt.method, tt.path, tt.want, rr.Code)
+		}
+		if rr.Header().Get("X-Region") != "us-west" {
+			t.Errorf("%s %s: expected X-Region header", tt.method, tt.path)
+		}
+		if tt.want == http.StatusServiceUnavailable && rr.Header().Get("Retry-After") == "" {
+			t.Errorf("%s %s: expected Retry-After header", tt.method, tt.path)
+		}
+	}
+}
+
+func TestRegion_ActiveAllowsWritesUntilReadOnly(t *testing.T) {
+	t.Parallel()
+
+	state := database.NewRegionState(database.RegionConfig{Name: "us-east"})
+	handler := Region(state)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/v1/guilds", nil))
+	if rr.Code != http.StatusCreated {
+		t.Errorf("expected 201 while active, got %d", rr.Code)
+	}
+
+	state.SetReadOnly(true)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/v1/guilds", nil))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 in read-only mode, got %d", rr.Code)
+	}
+}
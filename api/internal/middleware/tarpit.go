@@ -0,0 +1,401 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DefaultUserPathPrefixes are routes whose next path segment is a user ID.
+// A client walking through many of them is enumerating users.
+var DefaultUserPathPrefixes = []string{
+	"/v1/users/",
+	"/v1/compatibility/",
+	"/v1/trust/",
+}
+
+// DefaultDiscoveryPathPrefixes are the searches across many users a
+// scraper hammers to harvest profiles
+var DefaultDiscoveryPathPrefixes = []string{
+	"/v1/discover/",
+	"/v1/profiles/nearby",
+	"/v1/search",
+}
+
+// ScraperRecorder records scraper detections for admins
+type ScraperRecorder interface {
+	RecordClient(ctx context.Context, ip, eventType, detail string)
+}
+
+// Tarpit catches clients scraping the API. A client that requests a decoy
+// path is blocked at once. One that looks up too many users, or runs too
+// many discovery searches, in a window gets a strike: each strike slows
+// its responses further, and enough of them block it for a while.
+//
+// Clients are told apart by address, as scrapers rotate accounts and
+// tokens more readily than addresses.
+type Tarpit struct {
+	mu        sync.Mutex
+	clients   map[string]*tarpitClient
+	decoys    map[string]bool
+	users     []string
+	discovery []string
+
+	enumerationLimit int
+	discoveryLimit   int
+	window           time.Duration
+	delay            time.Duration
+	maxDelay         time.Duration
+	blockAfter       int
+	blockFor         time.Duration
+	cleanup          time.Duration
+	recorder         ScraperRecorder
+
+	clock    clock.Clock
+	sleep    func(ctx context.Context, d time.Duration) bool
+	stopChan chan struct{}
+}
+
+type tarpitClient struct {
+	windowStart  time.Time
+	users        map[string]bool // User IDs looked up this window
+	searches     int             // Discovery requests this window
+	struck       bool            // Already given a strike this window
+	strikes      int
+	reason       string
+	blockedUntil time.Time
+	lastSeen     time.Time
+}
+
+// TarpitConfig holds tarpit configuration
+type TarpitConfig struct {
+	DecoyPaths            []string      // Paths no real client requests; requesting one blocks the client
+	UserPathPrefixes      []string      // Routes followed by a user ID (default DefaultUserPathPrefixes)
+	DiscoveryPathPrefixes []string      // Discovery searches (default DefaultDiscoveryPathPrefixes)
+	EnumerationLimit      int           // Distinct users a client may look up per window (default 50)
+	DiscoveryLimit        int           // Discovery searches a client may run per window (default 120)
+	Window                time.Duration // Default 10 minutes
+	Delay                 time.Duration // Added to each response per strike (default 2 seconds)
+	MaxDelay              time.Duration // Default 20 seconds
+	BlockAfter            int           // Strikes before the client is blocked (default 3)
+	BlockFor              time.Duration // Default 1 hour
+	Cleanup               time.Duration // Cleanup interval for idle clients (default 5 minutes)
+	Clock                 clock.Clock   // Default: the system clock
+
+	// Recorder logs detections for admins; nil skips recording
+	Recorder ScraperRecorder
+}
+
+// NewTarpit creates a new tarpit
+func NewTarpit(cfg TarpitConfig) *Tarpit {
+	if cfg.UserPathPrefixes == nil {
+		cfg.UserPathPrefixes = DefaultUserPathPrefixes
+	}
+	if cfg.DiscoveryPathPrefixes == nil {
+		cfg.DiscoveryPathPrefixes = DefaultDiscoveryPathPrefixes
+	}
+	if cfg.EnumerationLimit == 0 {
+		cfg.EnumerationLimit = 50
+	}
+	if cfg.DiscoveryLimit == 0 {
+		cfg.DiscoveryLimit = 120
+	}
+	if cfg.Window == 0 {
+		cfg.Window = 10 * time.Minute
+	}
+	if cfg.Delay == 0 {
+		cfg.Delay = 2 * time.Second
+	}
+	if cfg.MaxDelay == 0 {
+		cfg.MaxDelay = 20 * time.Second
+	}
+	if cfg.BlockAfter == 0 {
+		cfg.BlockAfter = 3
+	}
+	if cfg.BlockFor == 0 {
+		cfg.BlockFor = time.Hour
+	}
+	if cfg.Cleanup == 0 {
+		cfg.Cleanup = 5 * time.Minute
+	}
+
+	decoys := make(map[string]bool, len(cfg.DecoyPaths))
+	for _, path := range cfg.DecoyPaths {
+		decoys[path] = true
+	}
+
+	t := &Tarpit{
+		clients:          make(map[string]*tarpitClient),
+		decoys:           decoys,
+		users:            cfg.UserPathPrefixes,
+		discovery:        cfg.DiscoveryPathPrefixes,
+		enumerationLimit: cfg.EnumerationLimit,
+		discoveryLimit:   cfg.DiscoveryLimit,
+		window:           cfg.Window,
+		delay:            cfg.Delay,
+		maxDelay:         cfg.MaxDelay,
+		blockAfter:       cfg.BlockAfter,
+		blockFor:         cfg.BlockFor,
+		cleanup:          cfg.Cleanup,
+		recorder:         cfg.Recorder,
+		clock:            clock.OrReal(cfg.Clock),
+		sleep:            sleepContext,
+		stopChan:         make(chan struct{}),
+	}
+
+	go t.cleanupLoop()
+
+	return t
+}
+
+// Stop stops the tarpit cleanup goroutine
+func (t *Tarpit) Stop() {
+	close(t.stopChan)
+}
+
+func (t *Tarpit) cleanupLoop() {
+	ticker := time.NewTicker(t.cleanup)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.cleanupIdle()
+		case <-t.stopChan:
+			return
+		}
+	}
+}
+
+// cleanupIdle forgets clients that have gone quiet and aren't blocked
+func (t *Tarpit) cleanupIdle() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	cutoff := now.Add(-t.blockFor)
+	for ip, c := range t.clients {
+		if c.lastSeen.Before(cutoff) && !c.blockedUntil.After(now) {
+			delete(t.clients, ip)
+		}
+	}
+}
+
+// tarpitVerdict is what to do with one request, and what to record about it
+type tarpitVerdict struct {
+	delay        time.Duration
+	blockedUntil time.Time
+	events       []tarpitEvent
+}
+
+type tarpitEvent struct {
+	eventType string
+	detail    string
+}
+
+// check counts a request against its client and decides what to do with it
+func (t *Tarpit) check(ip string, r *http.Request) tarpitVerdict {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	c, ok := t.clients[ip]
+	if !ok {
+		c = &tarpitClient{windowStart: now, users: make(map[string]bool)}
+		t.clients[ip] = c
+	}
+	c.lastSeen = now
+
+	var v tarpitVerdict
+	if c.blockedUntil.After(now) {
+		v.blockedUntil = c.blockedUntil
+		return v
+	}
+	if !c.blockedUntil.IsZero() {
+		// Served their time; start over
+		c.blockedUntil = time.Time{}
+		c.strikes = 0
+	}
+	if now.Sub(c.windowStart) >= t.window {
+		c.windowStart = now
+		c.users = make(map[string]bool)
+		c.searches = 0
+		c.struck = false
+	}
+
+	path := r.URL.Path
+	if t.decoys[path] {
+		c.reason = "requested decoy " + path
+		v.events = append(v.events, tarpitEvent{model.SecurityEventHoneypotHit, c.reason})
+		c.strikes = t.blockAfter
+		return t.block(c, now, v)
+	}
+
+	offence := ""
+	if r.Method == http.MethodGet {
+		if userID := pathUserID(path, t.users); userID != "" {
+			c.users[userID] = true
+			if len(c.users) > t.enumerationLimit {
+				offence = fmt.Sprintf("looked up more than %d users in %s", t.enumerationLimit, t.window)
+			}
+		}
+		if hasAnyPrefix(path, t.discovery) {
+			c.searches++
+			if c.searches > t.discoveryLimit {
+				offence = fmt.Sprintf("ran more than %d discovery searches in %s", t.discoveryLimit, t.window)
+			}
+		}
+	}
+	if offence != "" && !c.struck {
+		c.struck = true
+		c.strikes++
+		c.reason = offence
+		if c.strikes >= t.blockAfter {
+			return t.block(c, now, v)
+		}
+		v.events = append(v.events, tarpitEvent{model.SecurityEventScraperTarpitted, fmt.Sprintf("%s; strike %d of %d", offence, c.strikes, t.blockAfter)})
+	}
+
+	v.delay = t.delayFor(c)
+	return v
+}
+
+// block turns a client away for blockFor. Callers must hold mu.
+func (t *Tarpit) block(c *tarpitClient, now time.Time, v tarpitVerdict) tarpitVerdict {
+	c.blockedUntil = now.Add(t.blockFor)
+	v.blockedUntil = c.blockedUntil
+	v.events = append(v.events, tarpitEvent{model.SecurityEventScraperBlocked, fmt.Sprintf("%s; blocked for %s", c.reason, t.blockFor)})
+	return v
+}
+
+// delayFor is how much slower a client's responses are. Callers must hold
+// mu.
+func (t *Tarpit) delayFor(c *tarpitClient) time.Duration {
+	delay := time.Duration(c.strikes) * t.delay
+	if delay > t.maxDelay {
+		delay = t.maxDelay
+	}
+	return delay
+}
+
+// Clients returns the clients with strikes against them, blocked ones
+// first, then by strikes
+func (t *Tarpit) Clients() []model.TarpitClient {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	clients := make([]model.TarpitClient, 0)
+	for ip, c := range t.clients {
+		if c.strikes == 0 {
+			continue
+		}
+		client := model.TarpitClient{
+			IP:       ip,
+			Strikes:  c.strikes,
+			Reason:   c.reason,
+			LastSeen: c.lastSeen,
+		}
+		if c.blockedUntil.After(now) {
+			until := c.blockedUntil
+			client.BlockedUntil = &until
+		} else if delay := t.delayFor(c); delay > 0 {
+			client.Delay = delay.String()
+		}
+		clients = append(clients, client)
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		if (clients[i].BlockedUntil != nil) != (clients[j].BlockedUntil != nil) {
+			return clients[i].BlockedUntil != nil
+		}
+		if clients[i].Strikes != clients[j].Strikes {
+			return clients[i].Strikes > clients[j].Strikes
+		}
+		return clients[i].IP < clients[j].IP
+	})
+	return clients
+}
+
+// Release clears a client's strikes and lifts any block, for clients
+// caught by mistake. It reports whether the client had any.
+func (t *Tarpit) Release(ip string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c, ok := t.clients[ip]
+	if !ok || c.strikes == 0 {
+		return false
+	}
+	delete(t.clients, ip)
+	return true
+}
+
+// TarpitScrapers returns a middleware that slows down and then blocks
+// clients the tarpit catches scraping. Decoy paths answer like any path
+// that doesn't exist, so the client can't tell it was caught.
+func TarpitScrapers(t *Tarpit) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := ClientIP(r)
+			v := t.check(ip, r)
+
+			if t.recorder != nil {
+				for _, e := range v.events {
+					t.recorder.RecordClient(r.Context(), ip, e.eventType, e.detail)
+				}
+			}
+
+			if !v.blockedUntil.IsZero() {
+				if t.decoys[r.URL.Path] {
+					http.NotFound(w, r)
+					return
+				}
+				retryAfter := int(v.blockedUntil.Sub(t.clock.Now()).Seconds())
+				if retryAfter < 1 {
+					retryAfter = 1
+				}
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+				model.NewRateLimitError(retryAfter).WriteJSON(w)
+				return
+			}
+
+			if v.delay > 0 && !t.sleep(r.Context(), v.delay) {
+				return // The client gave up waiting
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// pathUserID returns the user ID following one of prefixes in path, if any
+func pathUserID(path string, prefixes []string) string {
+	for _, prefix := range prefixes {
+		if rest, ok := strings.CutPrefix(path, prefix); ok {
+			id, _, _ := strings.Cut(rest, "/")
+			return id
+		}
+	}
+	return ""
+}
+
+// sleepContext waits for d, or until ctx is done. It reports whether the
+// wait ran its course.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
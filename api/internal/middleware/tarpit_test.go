@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+type recordedScraperEvent struct {
+	ip, eventType string
+}
+
+type scraperRecorderStub struct {
+	mu     sync.Mutex
+	events []recordedScraperEvent
+}
+
+func (s *scraperRecorderStub) RecordClient(ctx context.Context, ip, eventType, detail string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, recordedScraperEvent{ip, eventType})
+}
+
+// newTestTarpit returns a tarpit on a clock the test moves, recording the
+// delays it would have slept for instead of sleeping
+func newTestTarpit(t *testing.T, cfg TarpitConfig) (*Tarpit, *fakeclock.Clock, *[]time.Duration) {
+	t.Helper()
+	clk := fakeclock.New(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	cfg.Clock = clk
+	tp := NewTarpit(cfg)
+	t.Cleanup(tp.Stop)
+
+	var delays []time.Duration
+	tp.sleep = func(ctx context.Context, d time.Duration) bool {
+		delays = append(delays, d)
+		return true
+	}
+	return tp, clk, &delays
+}
+
+func tarpitRequest(handler http.Handler, ip, path string) int {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = ip + ":40000"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+// ============================================================================
+// Tarpit Tests
+// ============================================================================
+
+func TestTarpit_DecoyBlocksClient(t *testing.T) {
+	t.Parallel()
+	recorder := &scraperRecorderStub{}
+	tp, _, _ := newTestTarpit(t, TarpitConfig{DecoyPaths: []string{"/wp-login.php"}, Recorder: recorder})
+	handler := TarpitScrapers(tp)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	if code := tarpitRequest(handler, "203.0.113.7", "/wp-login.php"); code != http.StatusNotFound {
+		t.Errorf("expected the decoy to look missing, got %d", code)
+	}
+	if code := tarpitRequest(handler, "203.0.113.7", "/v1/guilds"); code != http.StatusTooManyRequests {
+		t.Errorf("expected the client blocked, got %d", code)
+	}
+	if code := tarpitRequest(handler, "198.51.100.1", "/v1/guilds"); code != http.StatusOK {
+		t.Errorf("expected other clients unaffected, got %d", code)
+	}
+
+	want := []recordedScraperEvent{
+		{"203.0.113.7", model.SecurityEventHoneypotHit},
+		{"203.0.113.7", model.SecurityEventScraperBlocked},
+	}
+	if fmt.Sprint(recorder.events) != fmt.Sprint(want) {
+		t.Errorf("expected %v recorded, got %v", want, recorder.events)
+	}
+}
+
+func TestTarpit_EnumerationSlowsThenBlocks(t *testing.T) {
+	t.Parallel()
+	recorder := &scraperRecorderStub{}
+	tp, clk, delays := newTestTarpit(t, TarpitConfig{
+		EnumerationLimit: 3,
+		Window:           time.Minute,
+		Delay:            time.Second,
+		BlockAfter:       2,
+		BlockFor:         time.Hour,
+		Recorder:         recorder,
+	})
+	handler := TarpitScrapers(tp)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	// The same users again and again are fine
+	for i := 0; i < 10; i++ {
+		tarpitRequest(handler, "203.0.113.7", fmt.Sprintf("/v1/users/user:%d/profile", i%3))
+	}
+	if len(*delays) != 0 {
+		t.Fatalf("expected no delay for revisiting users, got %v", *delays)
+	}
+
+	// A fourth user is a strike, slowing every response after
+	tarpitRequest(handler, "203.0.113.7", "/v1/users/user:3/profile")
+	tarpitRequest(handler, "203.0.113.7", "/v1/guilds")
+	if got := *delays; len(got) != 2 || got[1] != time.Second {
+		t.Errorf("expected responses slowed by a second, got %v", got)
+	}
+	if clients := tp.Clients(); len(clients) != 1 || clients[0].Strikes != 1 || clients[0].BlockedUntil != nil {
+		t.Errorf("expected one tarpitted client, got %+v", clients)
+	}
+
+	// Doing it again next window blocks the client
+	clk.Advance(time.Minute)
+	for i := 10; i < 14; i++ {
+		tarpitRequest(handler, "203.0.113.7", fmt.Sprintf("/v1/users/user:%d/profile", i))
+	}
+	if code := tarpitRequest(handler, "203.0.113.7", "/v1/guilds"); code != http.StatusTooManyRequests {
+		t.Errorf("expected the client blocked, got %d", code)
+	}
+	if clients := tp.Clients(); len(clients) != 1 || clients[0].BlockedUntil == nil {
+		t.Errorf("expected the client listed as blocked, got %+v", clients)
+	}
+
+	// An admin can let it back in
+	if !tp.Release("203.0.113.7") {
+		t.Error("expected the client released")
+	}
+	if code := tarpitRequest(handler, "203.0.113.7", "/v1/guilds"); code != http.StatusOK {
+		t.Errorf("expected the released client served, got %d", code)
+	}
+
+	want := []recordedScraperEvent{
+		{"203.0.113.7", model.SecurityEventScraperTarpitted},
+		{"203.0.113.7", model.SecurityEventScraperBlocked},
+	}
+	if fmt.Sprint(recorder.events) != fmt.Sprint(want) {
+		t.Errorf("expected %v recorded, got %v", want, recorder.events)
+	}
+}
+
+func TestTarpit_DiscoveryHammering(t *testing.T) {
+	t.Parallel()
+	tp, clk, _ := newTestTarpit(t, TarpitConfig{DiscoveryLimit: 2, Window: time.Minute, BlockAfter: 1, BlockFor: time.Hour})
+	handler := TarpitScrapers(tp)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for i := 0; i < 2; i++ {
+		if code := tarpitRequest(handler, "203.0.113.7", "/v1/discover/people"); code != http.StatusOK {
+			t.Fatalf("expected searches within the limit served, got %d", code)
+		}
+	}
+	if code := tarpitRequest(handler, "203.0.113.7", "/v1/discover/people"); code != http.StatusTooManyRequests {
+		t.Errorf("expected the client blocked, got %d", code)
+	}
+
+	// Blocks run out
+	clk.Advance(time.Hour)
+	if code := tarpitRequest(handler, "203.0.113.7", "/v1/discover/people"); code != http.StatusOK {
+		t.Errorf("expected the block lifted, got %d", code)
+	}
+}
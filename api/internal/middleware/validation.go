@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/schema"
+)
+
+// MaxValidatedBodyBytes is the largest request body checked against the
+// spec. Larger bodies, such as imports, are passed on unchecked for their
+// handlers to limit.
+const MaxValidatedBodyBytes = 1 << 20
+
+// RequestValidationConfig holds request validation configuration
+type RequestValidationConfig struct {
+	Validator *schema.Validator
+	Mode      schema.Mode // Default enforce
+}
+
+// ValidateRequest checks JSON request bodies and query parameters against
+// the OpenAPI spec before the handler runs, and rejects requests that
+// don't match with a 422 listing every field that failed. Routes are
+// found by the pattern they were registered with, so it goes on route
+// groups rather than the global stack; routes the spec doesn't describe
+// pass through. Malformed JSON is left for the handler's decoder. Report
+// mode logs what enforce would reject.
+func ValidateRequest(cfg RequestValidationConfig) Middleware {
+	if cfg.Mode == "" {
+		cfg.Mode = schema.ModeEnforce
+	}
+	if cfg.Mode == schema.ModeOff || cfg.Validator == nil {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			op := cfg.Validator.Operation(r.Pattern)
+			if op == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			errs := op.ValidateQuery(r.URL.Query())
+			contentType := r.Header.Get("Content-Type")
+			if r.Body != nil && r.Body != http.NoBody && op.ValidatesBody(contentType) {
+				if body, ok := bufferBody(r); ok {
+					errs = append(errs, op.ValidateBody(contentType, body)...)
+				}
+			}
+
+			if len(errs) > 0 {
+				if cfg.Mode == schema.ModeEnforce {
+					model.NewValidationError(errs).WriteJSON(w)
+					return
+				}
+				reportValidation(r, op, errs)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bufferBody reads the request body so it can be checked, and puts it back
+// for the handler. It reports false, leaving the body unread past what was
+// buffered, when the body is too large to check or can't be read.
+func bufferBody(r *http.Request) ([]byte, bool) {
+	if r.ContentLength > MaxValidatedBodyBytes {
+		return nil, false
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxValidatedBodyBytes+1))
+	if err != nil || len(body) > MaxValidatedBodyBytes {
+		r.Body = readCloser{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		return nil, false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, true
+}
+
+// readCloser reads from a replacement reader and closes the original body
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// reportValidation logs what enforcement would have rejected
+func reportValidation(r *http.Request, op *schema.Operation, errs []model.FieldError) {
+	fields := make([]string, len(errs))
+	for i, e := range errs {
+		fields[i] = e.Message
+	}
+	slog.Warn("request validation report-only",
+		slog.String("operation", op.ID),
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.String("user_id", GetUserID(r.Context())),
+		slog.Any("errors", fields),
+	)
+}
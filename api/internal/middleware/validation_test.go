@@ -0,0 +1,162 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/forgo/saga/api/internal/schema"
+)
+
+// validationSpec describes one route, with a required body field and a
+// bounded query parameter
+var validationSpec = fstest.MapFS{
+	"openapi.yaml": {Data: []byte(`
+openapi: 3.0.3
+paths:
+  /v1/notes:
+    post:
+      operationId: createNote
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            minimum: 1
+            maximum: 10
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [text]
+              properties:
+                text:
+                  type: string
+                  minLength: 1
+`)},
+}
+
+// serveValidated registers a handler that echoes the body it reads on a mux
+// behind ValidateRequest, and serves one request through it
+func serveValidated(t *testing.T, mode schema.Mode, method, target, body string) (*httptest.ResponseRecorder, bool) {
+	t.Helper()
+	validator, err := schema.Load(validationSpec, "openapi.yaml")
+	if err != nil {
+		t.Fatalf("schema.Load: %v", err)
+	}
+
+	called := false
+	validate := ValidateRequest(RequestValidationConfig{Validator: validator, Mode: mode})
+	mux := http.NewServeMux()
+	echo := validate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		data, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(data)
+	}))
+	mux.Handle("POST /v1/notes", echo)
+	mux.Handle("POST /v1/drafts", echo)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	mux.ServeHTTP(rec, req)
+	return rec, called
+}
+
+// ============================================================================
+// Request Validation Tests
+// ============================================================================
+
+func TestValidateRequest_Enforce(t *testing.T) {
+	t.Parallel()
+
+	rec, called := serveValidated(t, schema.ModeEnforce, http.MethodPost, "/v1/notes?limit=0", `{"text":""}`)
+	if called {
+		t.Error("expected the handler not to run")
+	}
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422, got %d", rec.Code)
+	}
+
+	var problem struct {
+		Errors []struct {
+			Field string `json:"field"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+		t.Fatalf("decode problem: %v", err)
+	}
+	var fields []string
+	for _, e := range problem.Errors {
+		fields = append(fields, e.Field)
+	}
+	if got := strings.Join(fields, ","); got != "limit,text" {
+		t.Errorf("expected errors for limit and text, got %q", got)
+	}
+}
+
+func TestValidateRequest_LeavesLimitToClamp(t *testing.T) {
+	t.Parallel()
+
+	// Handlers reduce a limit above the maximum to the maximum
+	rec, called := serveValidated(t, schema.ModeEnforce, http.MethodPost, "/v1/notes?limit=50", `{"text":"hi"}`)
+	if !called || rec.Code != http.StatusOK {
+		t.Fatalf("expected the handler to run, got %d", rec.Code)
+	}
+}
+
+func TestValidateRequest_PassesBodyOn(t *testing.T) {
+	t.Parallel()
+
+	rec, called := serveValidated(t, schema.ModeEnforce, http.MethodPost, "/v1/notes", `{"text":"hi"}`)
+	if !called || rec.Code != http.StatusOK {
+		t.Fatalf("expected the handler to run, got %d", rec.Code)
+	}
+	if got := rec.Body.String(); got != `{"text":"hi"}` {
+		t.Errorf("expected the handler to read the body, got %q", got)
+	}
+}
+
+func TestValidateRequest_MalformedLeftToHandler(t *testing.T) {
+	t.Parallel()
+
+	rec, called := serveValidated(t, schema.ModeEnforce, http.MethodPost, "/v1/notes", `{"text":`)
+	if !called {
+		t.Fatal("expected malformed JSON to reach the handler")
+	}
+	if got := rec.Body.String(); got != `{"text":` {
+		t.Errorf("expected the handler to read the body, got %q", got)
+	}
+}
+
+func TestValidateRequest_Report(t *testing.T) {
+	t.Parallel()
+
+	rec, called := serveValidated(t, schema.ModeReport, http.MethodPost, "/v1/notes", `{}`)
+	if !called || rec.Code != http.StatusOK {
+		t.Errorf("expected report mode to let the request through, got %d", rec.Code)
+	}
+}
+
+func TestValidateRequest_Off(t *testing.T) {
+	t.Parallel()
+
+	rec, called := serveValidated(t, schema.ModeOff, http.MethodPost, "/v1/notes", `{}`)
+	if !called || rec.Code != http.StatusOK {
+		t.Errorf("expected off mode to let the request through, got %d", rec.Code)
+	}
+}
+
+func TestValidateRequest_UnspeccedRoute(t *testing.T) {
+	t.Parallel()
+
+	rec, called := serveValidated(t, schema.ModeEnforce, http.MethodPost, "/v1/drafts", `{}`)
+	if !called || rec.Code != http.StatusOK {
+		t.Errorf("expected a route the spec doesn't describe to pass through, got %d", rec.Code)
+	}
+}
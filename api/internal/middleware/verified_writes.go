@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// WriteRestrictionSource reports whether a user must verify their email
+// before making changes
+type WriteRestrictionSource interface {
+	WritesRestricted(ctx context.Context, userID string) (bool, error)
+}
+
+// RequireVerifiedWrites returns a middleware that turns away changes from
+// users whose signup looked risky until they verify their email. Reads go
+// through, as do /v1/auth/ routes so the user can still verify and sign
+// out. Wrap it inside auth middleware so the user is known.
+//
+// A failed lookup lets the request through: the restriction slows spam
+// down, and isn't worth taking writes offline for.
+func RequireVerifiedWrites(source WriteRestrictionSource) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID := GetUserID(r.Context())
+			if userID == "" || !isWriteMethod(r.Method) || strings.HasPrefix(r.URL.Path, "/v1/auth/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			restricted, err := source.WritesRestricted(r.Context(), userID)
+			if err != nil {
+				slog.Warn("failed to check write restriction", slog.String("user_id", userID), slog.String("error", err.Error()))
+			}
+			if restricted {
+				model.NewForbiddenError("verify your email address before making changes").WriteJSON(w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeWriteRestrictions struct {
+	restricted bool
+	err        error
+}
+
+func (f *fakeWriteRestrictions) WritesRestricted(ctx context.Context, userID string) (bool, error) {
+	return f.restricted, f.err
+}
+
+func serveVerifiedWrites(source WriteRestrictionSource, method, path string) (*httptest.ResponseRecorder, *captureHandler) {
+	handler := &captureHandler{}
+	req := httptest.NewRequest(method, path, nil)
+	req = req.WithContext(context.WithValue(req.Context(), UserIDKey, "user:1"))
+	rr := httptest.NewRecorder()
+
+	RequireVerifiedWrites(source)(handler).ServeHTTP(rr, req)
+	return rr, handler
+}
+
+func TestRequireVerifiedWrites(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		source     *fakeWriteRestrictions
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{"restricted write", &fakeWriteRestrictions{restricted: true}, http.MethodPost, "/v1/guilds", http.StatusForbidden},
+		{"restricted read", &fakeWriteRestrictions{restricted: true}, http.MethodGet, "/v1/guilds", http.StatusOK},
+		{"restricted auth route", &fakeWriteRestrictions{restricted: true}, http.MethodPost, "/v1/auth/email/verification", http.StatusOK},
+		{"unrestricted write", &fakeWriteRestrictions{}, http.MethodPatch, "/v1/guilds/guild:1", http.StatusOK},
+		{"failed lookup", &fakeWriteRestrictions{err: errors.New("db down")}, http.MethodDelete, "/v1/guilds/guild:1", http.StatusOK},
+	}
+	for _, tt := range tests {
+		rr, handler := serveVerifiedWrites(tt.source, tt.method, tt.path)
+		if rr.Code != tt.wantStatus {
+			t.Errorf("%s: expected status %d, got %d", tt.name, tt.wantStatus, rr.Code)
+		}
+		if handler.called != (tt.wantStatus == http.StatusOK) {
+			t.Errorf("%s: handler called = %v", tt.name, handler.called)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package model
+
+// Accessibility features. Event venues list the ones they offer and people
+// list the ones they need, so event discovery can match the two.
+const (
+	AccessibilityWheelchair = "wheelchair_access" // Accessible entrance, seating and restroom
+	AccessibilityStepFree   = "step_free"         // No steps between the street and the space
+	AccessibilityQuietSpace = "quiet_space"       // Somewhere quiet to step away to
+)
+
+// AccessibilityFeatures lists every accessibility feature
+var AccessibilityFeatures = []string{AccessibilityWheelchair, AccessibilityStepFree, AccessibilityQuietSpace}
+
+// InvalidAccessibilityMessage is the field error for an unknown feature
+const InvalidAccessibilityMessage = "accessibility features must be wheelchair_access, step_free, or quiet_space"
+
+// ValidAccessibility reports whether every feature is one of
+// AccessibilityFeatures
+func ValidAccessibility(features []string) bool {
+	for _, feature := range features {
+		if !contains(AccessibilityFeatures, feature) {
+			return false
+		}
+	}
+	return true
+}
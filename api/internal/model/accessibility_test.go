@@ -0,0 +1,60 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Accessibility Tests
+// ============================================================================
+
+func TestCreateEventRequest_ValidateAccessibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		features []string
+		wantErr  bool
+	}{
+		{"none", nil, false},
+		{"known features", []string{AccessibilityWheelchair, AccessibilityQuietSpace}, false},
+		{"unknown feature", []string{AccessibilityStepFree, "elevator"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := &CreateEventRequest{
+				Title:     "Board games",
+				StartTime: time.Now().Add(time.Hour),
+				Location:  &EventLocation{Name: "Library", City: "Denver", Accessibility: tt.features},
+			}
+			errs := req.Validate()
+			if got := len(errs) > 0; got != tt.wantErr {
+				t.Errorf("Validate() = %v, want error %v", errs, tt.wantErr)
+			}
+			if tt.wantErr && errs[0].Field != "location.accessibility" {
+				t.Errorf("error field = %s, want location.accessibility", errs[0].Field)
+			}
+		})
+	}
+}
+
+func TestToPublicFor_AccessibilityNeedsOnlyWhenShared(t *testing.T) {
+	t.Parallel()
+
+	needs := []string{AccessibilityStepFree}
+	private := &UserProfile{UserID: "user:1", Visibility: VisibilityPublic, AccessibilityNeeds: needs}
+	shared := &UserProfile{UserID: "user:1", Visibility: VisibilityPublic, AccessibilityNeeds: needs, ShareAccessibility: true}
+
+	if got := private.ToPublicFor(ProfileAudienceGuild).AccessibilityNeeds; got != nil {
+		t.Errorf("unshared needs = %v, want hidden", got)
+	}
+	if got := shared.ToPublicFor(ProfileAudienceMember).AccessibilityNeeds; len(got) != 1 {
+		t.Errorf("shared needs = %v, want %v", got, needs)
+	}
+	if got := shared.ToPublicFor(ProfileAudienceAnonymous).AccessibilityNeeds; got != nil {
+		t.Errorf("share link needs = %v, want hidden", got)
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// ActivityType identifies what happened in an activity timeline entry
+type ActivityType string
+
+const (
+	ActivityGuildJoined    ActivityType = "guild_joined"
+	ActivityRSVPed         ActivityType = "rsvped"
+	ActivityMatched        ActivityType = "matched"
+	ActivityReviewGiven    ActivityType = "review_given"
+	ActivityReviewReceived ActivityType = "review_received"
+	ActivityTrustGiven     ActivityType = "trust_given"
+	ActivityTrustReceived  ActivityType = "trust_received"
+)
+
+// Activity subject types (the entity an activity is about)
+const (
+	ActivitySubjectGuild  = "guild"
+	ActivitySubjectEvent  = "event"
+	ActivitySubjectMatch  = "match"
+	ActivitySubjectReview = "review"
+	ActivitySubjectTrust  = "trust"
+)
+
+// ActivityEntry is one item on a user's activity timeline. Entries cover both
+// the user's own actions and actions others took toward them (ActorID set).
+type ActivityEntry struct {
+	ID          string            `json:"id"`
+	UserID      string            `json:"user_id"`            // Whose timeline this entry is on
+	Type        ActivityType      `json:"type"`               // What happened
+	ActorID     *string           `json:"actor_id,omitempty"` // Who acted, when it wasn't the user
+	SubjectType string            `json:"subject_type"`       // guild, event, match, review, trust
+	SubjectID   string            `json:"subject_id"`
+	Data        map[string]string `json:"data,omitempty"` // Small display hints, e.g. pool_id or rsvp status
+	CreatedOn   time.Time         `json:"created_on"`
+}
+
+// Activity timeline paging
+const (
+	DefaultActivityPageSize = 20
+	MaxActivityPageSize     = 50
+)
+
+// ActivityPage is one page of a user's activity timeline, newest first
+type ActivityPage struct {
+	Entries    []*ActivityEntry
+	NextCursor string // Empty when there are no older entries
+}
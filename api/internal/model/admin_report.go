@@ -0,0 +1,72 @@
+package model
+
+import "time"
+
+// AdminReportKind is a report admins can generate in the background
+type AdminReportKind string
+
+const (
+	AdminReportUsers             AdminReportKind = "users"
+	AdminReportModerationHistory AdminReportKind = "moderation_history"
+)
+
+// IsValid checks if the report kind is known
+func (k AdminReportKind) IsValid() bool {
+	switch k {
+	case AdminReportUsers, AdminReportModerationHistory:
+		return true
+	}
+	return false
+}
+
+// AdminReportStatus tracks a report through generation
+type AdminReportStatus string
+
+const (
+	AdminReportPending   AdminReportStatus = "pending"
+	AdminReportRunning   AdminReportStatus = "running"
+	AdminReportCompleted AdminReportStatus = "completed"
+	AdminReportFailed    AdminReportStatus = "failed"
+	AdminReportExpired   AdminReportStatus = "expired" // The file was deleted from storage
+)
+
+// Admin report constraints
+const (
+	AdminReportPageSize      = 100              // Rows read per query while generating
+	AdminReportTimeout       = 30 * time.Minute // Running reports older than this are failed
+	DefaultAdminReportsLimit = 20
+	MaxAdminReportsLimit     = 100
+)
+
+// AdminReport is a long admin report generated in the background and written
+// to object storage as CSV. DownloadURL is a signed, short-lived link filled
+// in when a completed report is read.
+type AdminReport struct {
+	ID          string            `json:"id"`
+	Kind        AdminReportKind   `json:"kind"`
+	Status      AdminReportStatus `json:"status"`
+	RequestedBy string            `json:"requested_by"`
+	ObjectKey   string            `json:"-"`
+	RowCount    int               `json:"row_count"`
+	Error       string            `json:"error,omitempty"`
+	DownloadURL string            `json:"download_url,omitempty"`
+	RequestID   string            `json:"request_id,omitempty"` // Request that queued the report
+	CreatedOn   time.Time         `json:"created_on"`
+	StartedOn   *time.Time        `json:"started_on,omitempty"`
+	CompletedOn *time.Time        `json:"completed_on,omitempty"`
+	ExpiresOn   *time.Time        `json:"expires_on,omitempty"`
+}
+
+// CreateAdminReportRequest represents a request to generate a report
+type CreateAdminReportRequest struct {
+	Kind AdminReportKind `json:"kind"`
+}
+
+// Validate validates the create admin report request
+func (r *CreateAdminReportRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("kind", r.Kind.IsValid(), "kind must be users or moderation_history")
+
+	return v.Errors()
+}
@@ -234,35 +234,18 @@ func (r *CreateAdventureRequest) GetOrganizerType() AdventureOrganizerType {
 
 // Validate checks if the create request is valid
 func (r *CreateAdventureRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.Title == "" {
-		errors = append(errors, FieldError{Field: "title", Message: "title is required"})
-	} else if len(r.Title) > MaxAdventureTitleLength {
-		errors = append(errors, FieldError{Field: "title", Message: "title must be 100 characters or less"})
-	}
-	if r.Description != nil && len(*r.Description) > MaxAdventureDescLength {
-		errors = append(errors, FieldError{Field: "description", Message: "description must be 1000 characters or less"})
-	}
-	if r.StartDate == "" {
-		errors = append(errors, FieldError{Field: "start_date", Message: "start_date is required"})
-	}
-	if r.EndDate == "" {
-		errors = append(errors, FieldError{Field: "end_date", Message: "end_date is required"})
-	}
-	// Validate organizer type
-	if r.OrganizerType != nil {
-		if *r.OrganizerType != string(AdventureOrganizerGuild) && *r.OrganizerType != string(AdventureOrganizerUser) {
-			errors = append(errors, FieldError{Field: "organizer_type", Message: "organizer_type must be 'guild' or 'user'"})
-		}
-	}
+	v.String("title", r.Title).Required().MaxLength(MaxAdventureTitleLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxAdventureDescLength)
+	v.Timestamp("start_date", r.StartDate).Required()
+	v.Timestamp("end_date", r.EndDate).Required()
+	v.OptionalString("organizer_type", r.OrganizerType).OneOf(string(AdventureOrganizerGuild), string(AdventureOrganizerUser))
 	// Guild ID required for guild-organized adventures
-	orgType := r.GetOrganizerType()
-	if orgType == AdventureOrganizerGuild && (r.GuildID == nil || *r.GuildID == "") {
-		errors = append(errors, FieldError{Field: "guild_id", Message: "guild_id is required for guild-organized adventures"})
-	}
+	v.Check("guild_id", r.GetOrganizerType() != AdventureOrganizerGuild || (r.GuildID != nil && *r.GuildID != ""),
+		"guild_id is required for guild-organized adventures")
 
-	return errors
+	return v.Errors()
 }
 
 // UpdateAdventureRequest represents a request to update an adventure
@@ -433,16 +416,13 @@ type RespondToAdmissionRequest struct {
 
 // Validate checks if the response is valid
 func (r *RespondToAdmissionRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if !r.Admit && (r.RejectionReason == nil || *r.RejectionReason == "") {
-		errors = append(errors, FieldError{Field: "rejection_reason", Message: "rejection_reason is required when rejecting"})
-	}
-	if r.RejectionReason != nil && len(*r.RejectionReason) > MaxRejectionReasonLength {
-		errors = append(errors, FieldError{Field: "rejection_reason", Message: "rejection_reason must be 500 characters or less"})
-	}
+	v.Check("rejection_reason", r.Admit || (r.RejectionReason != nil && *r.RejectionReason != ""),
+		"rejection_reason is required when rejecting")
+	v.OptionalString("rejection_reason", r.RejectionReason).MaxLength(MaxRejectionReasonLength)
 
-	return errors
+	return v.Errors()
 }
 
 // InviteToAdventureRequest represents a request to invite a user
@@ -452,13 +432,11 @@ type InviteToAdventureRequest struct {
 
 // Validate checks if the invite request is valid
 func (r *InviteToAdventureRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.UserID == "" {
-		errors = append(errors, FieldError{Field: "user_id", Message: "user_id is required"})
-	}
+	v.String("user_id", r.UserID).Required()
 
-	return errors
+	return v.Errors()
 }
 
 // TransferAdventureRequest represents a request to transfer organizer role
@@ -468,13 +446,11 @@ type TransferAdventureRequest struct {
 
 // Validate checks if the transfer request is valid
 func (r *TransferAdventureRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.NewOrganizerUserID == "" {
-		errors = append(errors, FieldError{Field: "new_organizer_user_id", Message: "new_organizer_user_id is required"})
-	}
+	v.String("new_organizer_user_id", r.NewOrganizerUserID).Required()
 
-	return errors
+	return v.Errors()
 }
 
 // UnfreezeAdventureRequest represents a request to unfreeze an adventure
@@ -484,13 +460,11 @@ type UnfreezeAdventureRequest struct {
 
 // Validate checks if the unfreeze request is valid
 func (r *UnfreezeAdventureRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.NewOrganizerUserID == "" {
-		errors = append(errors, FieldError{Field: "new_organizer_user_id", Message: "new_organizer_user_id is required"})
-	}
+	v.String("new_organizer_user_id", r.NewOrganizerUserID).Required()
 
-	return errors
+	return v.Errors()
 }
 
 // AdventureAdmissionWithUser includes user details
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Action is something a caller can do to a resource
+type Action string
+
+const (
+	ActionEdit   Action = "edit"
+	ActionCancel Action = "cancel"
+	ActionRSVP   Action = "rsvp"
+	ActionVote   Action = "vote"
+)
+
+// Affordances are the actions the caller may take on a resource, worked out
+// with the same checks the service applies when they try one. Clients show
+// or hide controls with them instead of repeating those rules. They encode
+// as can_<action> flags:
+//
+//	{"can_edit": true, "can_cancel": true, "can_rsvp": false}
+type Affordances map[Action]bool
+
+// Can reports whether the caller may take an action
+func (a Affordances) Can(action Action) bool {
+	return a[action]
+}
+
+// Allowed returns the actions the caller may take, in name order
+func (a Affordances) Allowed() []Action {
+	var allowed []Action
+	for action, ok := range a {
+		if ok {
+			allowed = append(allowed, action)
+		}
+	}
+	sort.Slice(allowed, func(i, j int) bool { return allowed[i] < allowed[j] })
+	return allowed
+}
+
+// MarshalJSON encodes each action as a can_<action> flag
+func (a Affordances) MarshalJSON() ([]byte, error) {
+	flags := make(map[string]bool, len(a))
+	for action, ok := range a {
+		flags["can_"+string(action)] = ok
+	}
+	return json.Marshal(flags)
+}
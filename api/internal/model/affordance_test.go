@@ -0,0 +1,35 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// ============================================================================
+// Affordance Tests
+// ============================================================================
+
+func TestAffordances_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	got, err := json.Marshal(Affordances{ActionEdit: true, ActionRSVP: false})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if want := `{"can_edit":true,"can_rsvp":false}`; string(got) != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestAffordances_Allowed(t *testing.T) {
+	t.Parallel()
+
+	a := Affordances{ActionVote: true, ActionCancel: true, ActionEdit: false}
+	if got, want := a.Allowed(), []Action{ActionCancel, ActionVote}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if a.Can(ActionEdit) || a.Can(ActionRSVP) {
+		t.Error("expected edit and rsvp to be disallowed")
+	}
+}
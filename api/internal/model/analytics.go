@@ -0,0 +1,66 @@
+package model
+
+import "time"
+
+// ForecastConfidence labels how much historical data backs a forecast
+type ForecastConfidence string
+
+const (
+	ForecastConfidenceLow    ForecastConfidence = "low"
+	ForecastConfidenceMedium ForecastConfidence = "medium"
+	ForecastConfidenceHigh   ForecastConfidence = "high"
+)
+
+// ForecastBasis describes which historical events a forecast was computed from
+type ForecastBasis string
+
+const (
+	ForecastBasisGuildTemplate ForecastBasis = "guild_template" // Same guild and event template
+	ForecastBasisGuild         ForecastBasis = "guild"          // Same guild, any template
+	ForecastBasisTemplate      ForecastBasis = "template"       // Any guild, same template
+	ForecastBasisDefault       ForecastBasis = "default"        // No usable history
+)
+
+// AttendanceSample is the RSVP outcome of a single past event
+type AttendanceSample struct {
+	EventID  string `json:"event_id"`
+	Approved int    `json:"approved"`
+	NoShows  int    `json:"no_shows"`
+}
+
+// AttendanceHistoryFilter selects past events used for forecasting
+type AttendanceHistoryFilter struct {
+	GuildID  *string
+	Template *string
+	Since    time.Time
+	Limit    int
+}
+
+// AttendanceForecast is a host-facing estimate of how many approved attendees will show up
+type AttendanceForecast struct {
+	ApprovedRSVPs      int                `json:"approved_rsvps"`
+	ExpectedAttendance int                `json:"expected_attendance"`
+	ExpectedLow        int                `json:"expected_low"`
+	ExpectedHigh       int                `json:"expected_high"`
+	ConversionRate     float64            `json:"conversion_rate"` // Historical RSVP-to-attendance rate, 0-1
+	Confidence         ForecastConfidence `json:"confidence"`
+	Basis              ForecastBasis      `json:"basis"`
+	SampleEvents       int                `json:"sample_events"`
+	SampleRSVPs        int                `json:"sample_rsvps"`
+	Explanation        string             `json:"explanation"`
+	ComputedOn         time.Time          `json:"computed_on"`
+}
+
+// Forecasting constants
+const (
+	// DefaultAttendanceRate is the prior RSVP-to-attendance rate used when history is thin
+	DefaultAttendanceRate = 0.8
+	// AttendancePriorWeight is how many RSVPs the prior rate counts for when blending with history
+	AttendancePriorWeight = 10
+	// ForecastLookbackDays bounds how far back historical events are considered
+	ForecastLookbackDays = 365
+	// ForecastMaxSampleEvents caps the number of past events per forecast
+	ForecastMaxSampleEvents = 50
+	// ForecastMinSampleEvents is the minimum events needed to use a forecast basis
+	ForecastMinSampleEvents = 3
+)
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// AnalyticsEventName is a domain event recorded for product analytics
+type AnalyticsEventName string
+
+const (
+	AnalyticsHangoutScheduled AnalyticsEventName = "hangout_scheduled"
+	AnalyticsHangoutCompleted AnalyticsEventName = "hangout_completed"
+	AnalyticsMatchAccepted    AnalyticsEventName = "match_accepted"
+	AnalyticsMatchCompleted   AnalyticsEventName = "match_completed"
+	AnalyticsGuildJoined      AnalyticsEventName = "guild_joined"
+	AnalyticsEventCompleted   AnalyticsEventName = "event_completed"
+
+	// AnalyticsExperimentExposed is recorded each time a client is given
+	// its variant of an experiment, with the experiment and variant keys
+	AnalyticsExperimentExposed AnalyticsEventName = "experiment_exposed"
+)
+
+// AnalyticsExportPageSize is how many events the exporter reads per query
+const AnalyticsExportPageSize = 500
+
+// AnalyticsEvent is an anonymized, append-only record of something that
+// happened. Subject is a keyed hash of the user's ID, never the ID itself,
+// and Properties only hold coarse attributes such as a hangout type.
+type AnalyticsEvent struct {
+	ID         string                 `json:"-"`
+	Name       AnalyticsEventName     `json:"event"`
+	Subject    string                 `json:"subject"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	OccurredOn time.Time              `json:"occurred_on"`
+}
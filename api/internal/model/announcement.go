@@ -0,0 +1,173 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AnnouncementAudience is who an announcement is delivered to
+type AnnouncementAudience string
+
+const (
+	AnnouncementAudienceAll   AnnouncementAudience = "all"   // Every user (platform admins only)
+	AnnouncementAudienceGuild AnnouncementAudience = "guild" // Members of one guild
+	AnnouncementAudienceArea  AnnouncementAudience = "area"  // Users whose profile location is within a radius (platform admins only)
+)
+
+// IsValid reports whether the audience is supported
+func (a AnnouncementAudience) IsValid() bool {
+	switch a {
+	case AnnouncementAudienceAll, AnnouncementAudienceGuild, AnnouncementAudienceArea:
+		return true
+	}
+	return false
+}
+
+// AnnouncementStatus is where an announcement is in its lifecycle
+type AnnouncementStatus string
+
+const (
+	AnnouncementStatusDraft     AnnouncementStatus = "draft"     // Not scheduled, editable
+	AnnouncementStatusScheduled AnnouncementStatus = "scheduled" // Waiting for send_at, editable
+	AnnouncementStatusSending   AnnouncementStatus = "sending"   // Being delivered
+	AnnouncementStatusSent      AnnouncementStatus = "sent"      // Delivered to every recipient
+)
+
+// IsEditable reports whether the announcement can still be changed
+func (s AnnouncementStatus) IsEditable() bool {
+	return s == AnnouncementStatusDraft || s == AnnouncementStatusScheduled
+}
+
+// Announcement constraints
+const (
+	MaxAnnouncementTitleLength  = 120
+	MaxAnnouncementBodyLength   = 2000
+	MaxAnnouncementRadiusKm     = 500
+	MaxAnnouncementScheduleDays = 90
+)
+
+// AnnouncementArea targets users whose profile location is within RadiusKm of
+// a point
+type AnnouncementArea struct {
+	Lat      float64 `json:"lat"`
+	Lng      float64 `json:"lng"`
+	RadiusKm float64 `json:"radius_km"`
+}
+
+// Announcement is a broadcast message from platform admins or guild
+// organizers. Delivery creates an in-app receipt per recipient, which also
+// tracks whether they read it.
+type Announcement struct {
+	ID             string               `json:"id"`
+	Title          string               `json:"title"`
+	Body           string               `json:"body"`
+	Audience       AnnouncementAudience `json:"audience"`
+	GuildID        *string              `json:"guild_id,omitempty"`
+	Area           *AnnouncementArea    `json:"area,omitempty"`
+	Push           bool                 `json:"push"` // Also send a push notification
+	Status         AnnouncementStatus   `json:"status"`
+	SendAt         *time.Time           `json:"send_at,omitempty"`
+	SentOn         *time.Time           `json:"sent_on,omitempty"`
+	RecipientCount int                  `json:"recipient_count"`
+	ReadCount      int                  `json:"read_count"`
+	CreatedBy      string               `json:"created_by"`
+	CreatedOn      time.Time            `json:"created_on"`
+	UpdatedOn      time.Time            `json:"updated_on"`
+}
+
+// AnnouncementReceipt is an announcement as delivered to one user
+type AnnouncementReceipt struct {
+	AnnouncementID string           `json:"announcement_id"`
+	Title          string           `json:"title"`
+	Body           string           `json:"body"`
+	GuildID        *string          `json:"guild_id,omitempty"`
+	DeliveredOn    time.Time        `json:"delivered_on"`
+	ReadOn         *time.Time       `json:"read_on,omitempty"`
+	Reactions      *ReactionSummary `json:"reactions,omitempty"`
+}
+
+// AnnouncementInbox is a user's delivered announcements, newest first
+type AnnouncementInbox struct {
+	Announcements []*AnnouncementReceipt `json:"announcements"`
+	UnreadCount   int                    `json:"unread_count"`
+}
+
+// AnnouncementRecipientLocation is a candidate recipient of an area announcement
+type AnnouncementRecipientLocation struct {
+	UserID string
+	Lat    float64
+	Lng    float64
+}
+
+// CreateAnnouncementRequest represents a request to compose an announcement.
+// Without send_at the announcement is saved as a draft.
+type CreateAnnouncementRequest struct {
+	Title    string               `json:"title"`
+	Body     string               `json:"body"`
+	Audience AnnouncementAudience `json:"audience"`
+	GuildID  *string              `json:"guild_id,omitempty"`
+	Area     *AnnouncementArea    `json:"area,omitempty"`
+	Push     bool                 `json:"push"`
+	SendAt   *time.Time           `json:"send_at,omitempty"`
+}
+
+// Validate validates the create announcement request
+func (r *CreateAnnouncementRequest) Validate() []FieldError {
+	var v Validator
+
+	validateAnnouncementText(&v, &r.Title, &r.Body)
+	v.String("audience", string(r.Audience)).Required().
+		OneOf(string(AnnouncementAudienceAll), string(AnnouncementAudienceGuild), string(AnnouncementAudienceArea))
+
+	switch r.Audience {
+	case AnnouncementAudienceGuild:
+		v.Check("guild_id", r.GuildID != nil && *r.GuildID != "", "guild_id is required for guild announcements")
+	case AnnouncementAudienceArea:
+		r.Area.validate(&v)
+	}
+
+	return v.Errors()
+}
+
+// UpdateAnnouncementRequest represents a request to edit a draft or scheduled
+// announcement. The audience cannot be changed.
+type UpdateAnnouncementRequest struct {
+	Title  *string    `json:"title,omitempty"`
+	Body   *string    `json:"body,omitempty"`
+	Push   *bool      `json:"push,omitempty"`
+	SendAt *time.Time `json:"send_at,omitempty"` // Schedules a draft or reschedules
+}
+
+// Validate validates the update announcement request
+func (r *UpdateAnnouncementRequest) Validate() []FieldError {
+	var v Validator
+
+	validateAnnouncementText(&v, r.Title, r.Body)
+
+	return v.Errors()
+}
+
+// validateAnnouncementText trims the title and body that were sent and
+// checks them
+func validateAnnouncementText(v *Validator, title, body *string) {
+	if title != nil {
+		*title = strings.TrimSpace(*title)
+	}
+	if body != nil {
+		*body = strings.TrimSpace(*body)
+	}
+	v.OptionalString("title", title).Required().MaxLength(MaxAnnouncementTitleLength)
+	v.OptionalString("body", body).Required().MaxLength(MaxAnnouncementBodyLength)
+}
+
+func (a *AnnouncementArea) validate(v *Validator) {
+	if a == nil {
+		v.Fail("area", "area is required for area announcements")
+		return
+	}
+	v.Check("area.lat", a.Lat >= -90 && a.Lat <= 90, "lat must be between -90 and 90")
+	v.Check("area.lng", a.Lng >= -180 && a.Lng <= 180, "lng must be between -180 and 180")
+	v.Check("area.radius_km", a.RadiusKm > 0 && a.RadiusKm <= MaxAnnouncementRadiusKm,
+		fmt.Sprintf("radius_km must be greater than 0 and at most %d", MaxAnnouncementRadiusKm))
+}
@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
 
 // HangoutType represents the type of hangout being sought
 type HangoutType string
@@ -13,17 +17,28 @@ const (
 	HangoutTypeMeetAnyone       HangoutType = "meet_anyone"       // Open to anyone
 )
 
-// HangoutTypeInfo provides display information for hangout types
+// HangoutTypeInfo is an entry in the hangout type catalog. Platform types
+// are managed by admins and usable anywhere; guild types are added by guild
+// admins for availability scoped to their guild. Type is the key stored on
+// availability.
 type HangoutTypeInfo struct {
+	ID          string      `json:"id,omitempty"`
 	Type        HangoutType `json:"type"`
 	Label       string      `json:"label"`
 	Description string      `json:"description"`
 	Icon        string      `json:"icon"`
+	GuildID     *string     `json:"guild_id,omitempty"` // nil for platform types
+	BuiltIn     bool        `json:"built_in"`           // The server relies on built-in types; they can't be removed
+	IsActive    bool        `json:"is_active"`
+	SortOrder   int         `json:"sort_order"`
+	CreatedOn   time.Time   `json:"created_on"`
+	UpdatedOn   time.Time   `json:"updated_on"`
 }
 
-// GetHangoutTypeInfo returns display info for each type
+// GetHangoutTypeInfo returns the built-in hangout types. They seed the
+// catalog and stand in for it when none is configured.
 func GetHangoutTypeInfo() []HangoutTypeInfo {
-	return []HangoutTypeInfo{
+	types := []HangoutTypeInfo{
 		{
 			Type:        HangoutTypeTalkItOut,
 			Label:       "Talk It Out",
@@ -55,6 +70,80 @@ func GetHangoutTypeInfo() []HangoutTypeInfo {
 			Icon:        "sparkles",
 		},
 	}
+	for i := range types {
+		types[i].BuiltIn = true
+		types[i].IsActive = true
+		types[i].SortOrder = i + 1
+	}
+	return types
+}
+
+// IsBuiltInHangoutType reports whether t is one of the built-in types
+func IsBuiltInHangoutType(t HangoutType) bool {
+	switch t {
+	case HangoutTypeTalkItOut, HangoutTypeHereToListen, HangoutTypeConcreteActivity,
+		HangoutTypeMutualInterest, HangoutTypeMeetAnyone:
+		return true
+	}
+	return false
+}
+
+// Hangout type catalog limits
+const (
+	MaxGuildHangoutTypes      = 20
+	MaxHangoutTypeLabelLength = 50
+	MaxHangoutTypeDescLength  = 200
+	MaxHangoutTypeIconLength  = 50
+	MaxHangoutTypeSortOrder   = 1000
+)
+
+var hangoutTypeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,31}$`)
+
+// CreateHangoutTypeRequest represents a request to add a hangout type to the
+// platform catalog or a guild's
+type CreateHangoutTypeRequest struct {
+	Type        string `json:"type"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	SortOrder   int    `json:"sort_order,omitempty"`
+}
+
+// Validate validates the create hangout type request
+func (r *CreateHangoutTypeRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("type", r.Type).Required()
+	v.Check("type", hangoutTypeKeyPattern.MatchString(r.Type),
+		"type must be 2 to 32 lower case letters, digits, or underscores, starting with a letter")
+	v.String("label", r.Label).Required().MaxLength(MaxHangoutTypeLabelLength)
+	v.String("description", r.Description).MaxLength(MaxHangoutTypeDescLength)
+	v.String("icon", r.Icon).MaxLength(MaxHangoutTypeIconLength)
+	v.Int("sort_order", r.SortOrder).Between(0, MaxHangoutTypeSortOrder)
+
+	return v.Errors()
+}
+
+// UpdateHangoutTypeRequest represents a request to update a hangout type.
+// The type key can't change, as availability refers to it.
+type UpdateHangoutTypeRequest struct {
+	Label       *string `json:"label,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	SortOrder   *int    `json:"sort_order,omitempty"`
+	IsActive    *bool   `json:"is_active,omitempty"`
+}
+
+// Validate validates the update hangout type request
+func (r *UpdateHangoutTypeRequest) Validate() []FieldError {
+	var v Validator
+
+	v.OptionalString("label", r.Label).NotEmpty().MaxLength(MaxHangoutTypeLabelLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxHangoutTypeDescLength)
+	v.OptionalString("icon", r.Icon).MaxLength(MaxHangoutTypeIconLength)
+	v.OptionalInt("sort_order", r.SortOrder).Between(0, MaxHangoutTypeSortOrder)
+
+	return v.Errors()
 }
 
 // AvailabilityStatus constants
@@ -94,7 +183,9 @@ type Availability struct {
 	InterestID          *string               `json:"interest_id,omitempty"`          // For mutual_interest
 	MaxPeople           int                   `json:"max_people"`
 	Note                *string               `json:"note,omitempty"`
-	Visibility          string                `json:"visibility"` // circles, public
+	Visibility          string                `json:"visibility"`         // circles, public
+	Spontaneous         bool                  `json:"spontaneous"`        // Available right now, rather than planned
+	GuildID             *string               `json:"guild_id,omitempty"` // Only the guild's members can see guild-scoped availability
 	ExpiresAt           time.Time             `json:"expires_at"`
 	CreatedOn           time.Time             `json:"created_on"`
 	UpdatedOn           time.Time             `json:"updated_on"`
@@ -115,6 +206,7 @@ type AvailabilityPublic struct {
 	InterestID          *string        `json:"interest_id,omitempty"`
 	MaxPeople           int            `json:"max_people"`
 	Note                *string        `json:"note,omitempty"`
+	Spontaneous         bool           `json:"spontaneous"`
 	// User info
 	UserProfile *PublicProfile `json:"user_profile,omitempty"`
 	// Interest details if mutual_interest type
@@ -201,6 +293,18 @@ type CreateAvailabilityRequest struct {
 	MaxPeople           *int                         `json:"max_people,omitempty"` // Default: 1
 	Note                *string                      `json:"note,omitempty"`
 	Visibility          *string                      `json:"visibility,omitempty"` // Default: circles
+	GuildID             *string                      `json:"guild_id,omitempty"`   // Scope to a guild, allowing its custom hangout types
+}
+
+// Validate validates the create availability request
+func (r *CreateAvailabilityRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("start_time", r.StartTime).Required()
+	v.String("end_time", r.EndTime).Required()
+	v.String("hangout_type", r.HangoutType).Required()
+
+	return v.Errors()
 }
 
 // UpdateAvailabilityRequest represents a request to update availability
@@ -243,3 +347,88 @@ type AvailabilityFilter struct {
 	EndBefore    *time.Time    `json:"end_before,omitempty"`
 	InterestID   *string       `json:"interest_id,omitempty"`
 }
+
+// Availability heatmap constraints
+const (
+	AvailabilityHeatmapMinMembers   = 3 // Hours with fewer distinct members are reported as zero
+	DefaultAvailabilityHeatmapWeeks = 8
+	MaxAvailabilityHeatmapWeeks     = 26
+)
+
+// AvailabilityHeatmap shows when a guild's members are generally free. Each
+// bucket is the number of distinct members who posted availability covering
+// that hour of the week during the lookback period, indexed [weekday][hour]
+// with Sunday as 0. Buckets below MinMembers are zeroed so no individual's
+// schedule can be read off the map.
+type AvailabilityHeatmap struct {
+	GuildID      string     `json:"guild_id"`
+	Timezone     string     `json:"timezone"`
+	Since        time.Time  `json:"since"`
+	Until        time.Time  `json:"until"`
+	Contributors int        `json:"contributors"` // Members with any availability in the period
+	MinMembers   int        `json:"min_members"`
+	Buckets      [7][24]int `json:"buckets"`
+}
+
+// Event time suggestion constraints
+const (
+	MinSuggestDurationMinutes = 15
+	MaxSuggestDurationMinutes = 24 * 60
+	MaxSuggestRanges          = 10
+	MaxSuggestRangeSpan       = 31 * 24 * time.Hour // Total length of all candidate ranges
+	DefaultSuggestedTimes     = 5
+	MaxSuggestedTimes         = 20
+)
+
+// SuggestTimeRange is a period the organizer could hold an event in
+type SuggestTimeRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// SuggestEventTimesRequest asks for the best times to hold a guild event.
+// Candidate slots start on the hour in Timezone (default UTC).
+type SuggestEventTimesRequest struct {
+	DurationMinutes int                `json:"duration_minutes"`
+	Ranges          []SuggestTimeRange `json:"ranges"`
+	Timezone        string             `json:"timezone,omitempty"`
+	Limit           int                `json:"limit,omitempty"`
+}
+
+// Validate validates the suggest event times request
+func (r *SuggestEventTimesRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Int("duration_minutes", r.DurationMinutes).Between(MinSuggestDurationMinutes, MaxSuggestDurationMinutes)
+
+	v.Check("ranges", len(r.Ranges) > 0 && len(r.Ranges) <= MaxSuggestRanges,
+		fmt.Sprintf("ranges must have between 1 and %d entries", MaxSuggestRanges))
+	var span time.Duration
+	for _, rg := range r.Ranges {
+		v.Check("ranges", rg.End.After(rg.Start), "each range must end after it starts")
+		span += rg.End.Sub(rg.Start)
+	}
+	v.Check("ranges", span <= MaxSuggestRangeSpan, "ranges may cover at most 31 days in total")
+
+	if r.Timezone != "" {
+		_, err := time.LoadLocation(r.Timezone)
+		v.Check("timezone", err == nil, "timezone must be an IANA time zone such as Europe/Berlin")
+	}
+
+	v.Check("limit", r.Limit >= 0 && r.Limit <= MaxSuggestedTimes,
+		fmt.Sprintf("limit must be between 1 and %d", MaxSuggestedTimes))
+
+	return v.Errors()
+}
+
+// SuggestedEventTime is a ranked candidate slot. AvailableMembers is the
+// fewest members free in any hour of the slot according to the guild's
+// availability heatmap; ExpectedAttendance discounts it for every guild event
+// already scheduled over the slot.
+type SuggestedEventTime struct {
+	Start               time.Time `json:"start"`
+	End                 time.Time `json:"end"`
+	AvailableMembers    int       `json:"available_members"`
+	ExpectedAttendance  float64   `json:"expected_attendance"`
+	ConflictingEventIDs []string  `json:"conflicting_event_ids,omitempty"`
+}
@@ -0,0 +1,76 @@
+package model
+
+import "testing"
+
+// ============================================================================
+// Hangout Type Catalog Tests
+// ============================================================================
+
+func TestCreateHangoutTypeRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		req     CreateHangoutTypeRequest
+		wantErr bool
+	}{
+		{"valid", CreateHangoutTypeRequest{Type: "board_games", Label: "Board Games"}, false},
+		{"missing label", CreateHangoutTypeRequest{Type: "board_games"}, true},
+		{"missing type", CreateHangoutTypeRequest{Label: "Board Games"}, true},
+		{"upper case key", CreateHangoutTypeRequest{Type: "BoardGames", Label: "Board Games"}, true},
+		{"key starting with digit", CreateHangoutTypeRequest{Type: "1v1", Label: "One on One"}, true},
+		{"key too short", CreateHangoutTypeRequest{Type: "b", Label: "B"}, true},
+		{"negative sort order", CreateHangoutTypeRequest{Type: "board_games", Label: "Board Games", SortOrder: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			errs := tt.req.Validate()
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Validate() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateHangoutTypeRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	empty, label := "", "Board Games"
+	tooFar := MaxHangoutTypeSortOrder + 1
+
+	tests := []struct {
+		name    string
+		req     UpdateHangoutTypeRequest
+		wantErr bool
+	}{
+		{"empty", UpdateHangoutTypeRequest{}, false},
+		{"label", UpdateHangoutTypeRequest{Label: &label}, false},
+		{"blank label", UpdateHangoutTypeRequest{Label: &empty}, true},
+		{"sort order too large", UpdateHangoutTypeRequest{SortOrder: &tooFar}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			errs := tt.req.Validate()
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Validate() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetHangoutTypeInfo_AllBuiltIn(t *testing.T) {
+	t.Parallel()
+
+	for _, info := range GetHangoutTypeInfo() {
+		if !info.BuiltIn || !info.IsActive || !IsBuiltInHangoutType(info.Type) {
+			t.Errorf("%s: built_in = %v, is_active = %v, want both true", info.Type, info.BuiltIn, info.IsActive)
+		}
+	}
+	if IsBuiltInHangoutType("board_games") {
+		t.Error("board_games should not be built in")
+	}
+}
@@ -0,0 +1,120 @@
+package model
+
+import "time"
+
+// Carpools are rideshares attached to an event. Attendees offer rides with
+// a route to the event and post ride requests with a pickup point; riders
+// are matched to drivers whose route passes near their pickup.
+
+// RideRequestStatus constants
+const (
+	RideRequestStatusOpen      = "open"      // Looking for a ride
+	RideRequestStatusMatched   = "matched"   // A driver confirmed a seat
+	RideRequestStatusCancelled = "cancelled" // Withdrawn by the rider
+)
+
+// Carpool constraints
+const (
+	MaxCarpoolStops        = MaxSegmentsPerRideshare - 1 // Stops between the origin and the event
+	MaxRideRequestNotesLen = 300
+	// MaxPickupDetourKm is how far a pickup can be from a driver's route and
+	// still match
+	MaxPickupDetourKm = 5.0
+)
+
+// CarpoolStop is a point on a carpool route as entered by its driver or
+// rider. The coordinates are used for matching and stored, but rideshare
+// responses never show them.
+type CarpoolStop struct {
+	Name         string  `json:"name"` // e.g., "Park and ride on 5th"
+	Neighborhood *string `json:"neighborhood,omitempty"`
+	City         string  `json:"city"`
+	Lat          float64 `json:"lat"`
+	Lng          float64 `json:"lng"`
+}
+
+// Location returns the stop as a rideshare location
+func (s CarpoolStop) Location() RideshareLocation {
+	return RideshareLocation{
+		Name:         s.Name,
+		Neighborhood: s.Neighborhood,
+		City:         s.City,
+		Lat:          s.Lat,
+		Lng:          s.Lng,
+	}
+}
+
+// validate checks a stop at field
+func (s CarpoolStop) validate(v *Validator, field string) {
+	v.String(field+".name", s.Name).Required().MaxLength(MaxLocationNameLength)
+	v.String(field+".city", s.City).Required().MaxLength(MaxLocationNameLength)
+	v.Check(field+".lat", s.Lat >= -90 && s.Lat <= 90, "lat must be between -90 and 90")
+	v.Check(field+".lng", s.Lng >= -180 && s.Lng <= 180, "lng must be between -180 and 180")
+}
+
+// OfferRideRequest represents a request to drive attendees to an event. The
+// route runs from the origin through the stops to the event.
+type OfferRideRequest struct {
+	Title         string        `json:"title"`
+	Description   *string       `json:"description,omitempty"`
+	Origin        CarpoolStop   `json:"origin"`
+	Stops         []CarpoolStop `json:"stops,omitempty"` // In driving order
+	DepartureTime time.Time     `json:"departure_time"`
+	SeatsTotal    int           `json:"seats_total"`
+	TrustRequired bool          `json:"trust_required"`
+}
+
+// Validate validates the offer ride request
+func (r *OfferRideRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("title", r.Title).Required().MaxLength(MaxLocationNameLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxRideshareDescLength)
+	r.Origin.validate(&v, "origin")
+	v.Check("stops", len(r.Stops) <= MaxCarpoolStops, "too many stops")
+	for _, stop := range r.Stops {
+		stop.validate(&v, "stops")
+	}
+	v.Time("departure_time", r.DepartureTime).Required()
+	v.Int("seats_total", r.SeatsTotal).Between(1, MaxSeatsPerRideshare)
+
+	return v.Errors()
+}
+
+// RideRequest is an attendee looking for a ride to an event
+type RideRequest struct {
+	ID          string            `json:"id"`
+	EventID     string            `json:"event_id"`
+	UserID      string            `json:"user_id"`
+	Pickup      RideshareLocation `json:"pickup"`
+	Notes       *string           `json:"notes,omitempty"`
+	Status      string            `json:"status"`                 // open, matched, cancelled
+	RideshareID *string           `json:"rideshare_id,omitempty"` // Set once matched
+	CreatedOn   time.Time         `json:"created_on"`
+	UpdatedOn   time.Time         `json:"updated_on"`
+}
+
+// RequestRideRequest represents a request to look for a ride to an event
+type RequestRideRequest struct {
+	Pickup CarpoolStop `json:"pickup"`
+	Notes  *string     `json:"notes,omitempty"`
+}
+
+// Validate validates the request ride request
+func (r *RequestRideRequest) Validate() []FieldError {
+	var v Validator
+
+	r.Pickup.validate(&v, "pickup")
+	v.OptionalString("notes", r.Notes).MaxLength(MaxRideRequestNotesLen)
+
+	return v.Errors()
+}
+
+// CarpoolSummary is an event's carpooling at a glance, for its detail view
+type CarpoolSummary struct {
+	Rides          int          `json:"rides"`           // Open ride offers
+	SeatsAvailable int          `json:"seats_available"` // Across open offers
+	RidersWaiting  int          `json:"riders_waiting"`  // Open ride requests
+	MyRideID       *string      `json:"my_ride_id,omitempty"`
+	MyRequest      *RideRequest `json:"my_request,omitempty"`
+}
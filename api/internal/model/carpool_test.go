@@ -0,0 +1,64 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Carpool Tests
+// ============================================================================
+
+func TestOfferRideRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	stop := CarpoolStop{Name: "Park and ride", City: "Denver", Lat: 39.7, Lng: -104.9}
+
+	tests := []struct {
+		name      string
+		modify    func(r *OfferRideRequest)
+		wantField string
+	}{
+		{"valid", func(r *OfferRideRequest) {}, ""},
+		{"with stops", func(r *OfferRideRequest) { r.Stops = []CarpoolStop{stop} }, ""},
+		{"missing title", func(r *OfferRideRequest) { r.Title = "" }, "title"},
+		{"origin off the globe", func(r *OfferRideRequest) { r.Origin.Lat = 95 }, "origin.lat"},
+		{"too many stops", func(r *OfferRideRequest) { r.Stops = make([]CarpoolStop, MaxCarpoolStops+1) }, "stops"},
+		{"no departure", func(r *OfferRideRequest) { r.DepartureTime = time.Time{} }, "departure_time"},
+		{"too many seats", func(r *OfferRideRequest) { r.SeatsTotal = MaxSeatsPerRideshare + 1 }, "seats_total"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := &OfferRideRequest{Title: "Ride to the hike", Origin: stop, DepartureTime: time.Now(), SeatsTotal: 3}
+			tt.modify(req)
+			errs := req.Validate()
+			if tt.wantField == "" {
+				if len(errs) > 0 {
+					t.Errorf("Validate() = %v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) == 0 || errs[0].Field != tt.wantField {
+				t.Errorf("Validate() = %v, want an error on %s", errs, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestRequestRideRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("x", MaxRideRequestNotesLen+1)
+	req := &RequestRideRequest{Pickup: CarpoolStop{Name: "Corner", City: "Denver"}, Notes: &long}
+	if errs := req.Validate(); len(errs) == 0 || errs[0].Field != "notes" {
+		t.Errorf("Validate() = %v, want an error on notes", errs)
+	}
+
+	req.Pickup.City = ""
+	if errs := req.Validate(); len(errs) == 0 || errs[0].Field != "pickup.city" {
+		t.Errorf("Validate() = %v, want an error on pickup.city", errs)
+	}
+}
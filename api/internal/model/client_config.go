@@ -0,0 +1,82 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidClientVersion is returned for app versions that aren't
+// major.minor.patch
+var ErrInvalidClientVersion = errors.New("must be a version such as 3.2.1")
+
+// ClientVersion is a client app's version. Missing minor and patch numbers
+// count as 0; a leading v and pre-release or build suffixes are ignored, so
+// 3.2.0-beta.1 is treated as 3.2.0.
+type ClientVersion struct {
+	Major, Minor, Patch int
+}
+
+// ParseClientVersion parses an app version such as "3.2.1"
+func ParseClientVersion(s string) (ClientVersion, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return ClientVersion{}, ErrInvalidClientVersion
+	}
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return ClientVersion{}, ErrInvalidClientVersion
+		}
+		numbers[i] = n
+	}
+	return ClientVersion{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// Less reports whether v is older than other
+func (v ClientVersion) Less(other ClientVersion) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+// String formats the version as major.minor.patch
+func (v ClientVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// ClientRelease describes the app releases for one platform. Either version
+// may be empty: without a minimum every version is supported.
+type ClientRelease struct {
+	MinimumVersion string `json:"minimum_version,omitempty"` // Older apps get 426 Upgrade Required
+	CurrentVersion string `json:"current_version,omitempty"` // Latest release, for suggesting optional upgrades
+	UpdateURL      string `json:"update_url,omitempty"`      // Store listing or download page
+}
+
+// ClientConfig is what clients read at startup: the supported app versions
+// for each platform and the feature flags turned on
+type ClientConfig struct {
+	Platforms map[DevicePlatform]ClientRelease `json:"platforms"`
+	Features  map[string]bool                  `json:"features"`
+}
+
+// UpgradeRequired is sent with a 426 to clients older than their platform's
+// minimum version
+type UpgradeRequired struct {
+	Platform       DevicePlatform `json:"platform"`
+	Version        string         `json:"version"` // What the client sent
+	MinimumVersion string         `json:"minimum_version"`
+	CurrentVersion string         `json:"current_version,omitempty"`
+	UpdateURL      string         `json:"update_url,omitempty"`
+}
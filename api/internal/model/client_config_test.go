@@ -0,0 +1,51 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseClientVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want ClientVersion
+		err  bool
+	}{
+		{in: "3.2.1", want: ClientVersion{3, 2, 1}},
+		{in: "v3.2", want: ClientVersion{3, 2, 0}},
+		{in: "4", want: ClientVersion{4, 0, 0}},
+		{in: "3.2.0-beta.1+45", want: ClientVersion{3, 2, 0}},
+		{in: "", err: true},
+		{in: "3.x", err: true},
+		{in: "1.2.3.4", err: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseClientVersion(tt.in)
+		if tt.err {
+			if !errors.Is(err, ErrInvalidClientVersion) {
+				t.Errorf("ParseClientVersion(%q) error = %v, want ErrInvalidClientVersion", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseClientVersion(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestClientVersion_Less(t *testing.T) {
+	t.Parallel()
+
+	if !(ClientVersion{2, 9, 9}).Less(ClientVersion{3, 0, 0}) {
+		t.Error("expected 2.9.9 < 3.0.0")
+	}
+	if (ClientVersion{3, 10, 0}).Less(ClientVersion{3, 9, 0}) {
+		t.Error("expected 3.10.0 >= 3.9.0")
+	}
+	if (ClientVersion{3, 0, 0}).Less(ClientVersion{3, 0, 0}) {
+		t.Error("expected equal versions not to be less")
+	}
+}
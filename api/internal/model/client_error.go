@@ -0,0 +1,125 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ClientErrorKind is what went wrong in a client app
+type ClientErrorKind string
+
+const (
+	ClientErrorCrash       ClientErrorKind = "crash"
+	ClientErrorAPIMismatch ClientErrorKind = "api_mismatch" // A response didn't match the API contract the app was built against
+)
+
+// IsValid checks if the client error kind is known
+func (k ClientErrorKind) IsValid() bool {
+	switch k {
+	case ClientErrorCrash, ClientErrorAPIMismatch:
+		return true
+	}
+	return false
+}
+
+// Client error constraints
+const (
+	MaxClientErrorMessageLength    = 2000
+	MaxClientErrorStackTraceLength = 16000
+	MaxClientErrorAppVersionLength = 32
+	MaxClientErrorEndpointLength   = 200
+	MaxClientErrorRequestIDs       = 10
+	ClientErrorTitleLength         = 200 // Leading part of the message errors are grouped by
+	DefaultClientErrorsLimit       = 50
+	MaxClientErrorsLimit           = 200
+	DefaultClientErrorSummaryDays  = 7
+	MaxClientErrorSummaryDays      = 90
+)
+
+// ClientError is a crash or API-contract mismatch reported by a mobile or
+// web client. Reports are sampled, so counts are relative rather than
+// absolute. Reports with the same kind, platform, endpoint and title share a
+// Fingerprint, which admins aggregate on.
+type ClientError struct {
+	ID          string          `json:"id"`
+	Kind        ClientErrorKind `json:"kind"`
+	Platform    DevicePlatform  `json:"platform"`
+	AppVersion  string          `json:"app_version"`
+	Title       string          `json:"title"` // First line of Message
+	Message     string          `json:"message"`
+	StackTrace  string          `json:"stack_trace,omitempty"`
+	Endpoint    string          `json:"endpoint,omitempty"` // e.g. "GET /v1/guilds/{guildId}"
+	StatusCode  int             `json:"status_code,omitempty"`
+	RequestIDs  []string        `json:"request_ids,omitempty"` // Requests the client made leading up to the error
+	RequestID   string          `json:"request_id,omitempty"`  // Request that reported the error
+	Fingerprint string          `json:"fingerprint"`
+	UserID      string          `json:"user_id,omitempty"` // Set when the client was signed in
+	OccurredOn  time.Time       `json:"occurred_on"`
+	CreatedOn   time.Time       `json:"created_on"`
+}
+
+// ReportClientErrorRequest is a client's report of a crash or mismatch
+type ReportClientErrorRequest struct {
+	Kind       ClientErrorKind `json:"kind"`
+	Platform   DevicePlatform  `json:"platform"`
+	AppVersion string          `json:"app_version"`
+	Message    string          `json:"message"`
+	StackTrace string          `json:"stack_trace,omitempty"`
+	Endpoint   string          `json:"endpoint,omitempty"`
+	StatusCode int             `json:"status_code,omitempty"`
+	RequestIDs []string        `json:"request_ids,omitempty"`
+	OccurredOn *time.Time      `json:"occurred_on,omitempty"` // Defaults to when the report arrives
+}
+
+// Validate validates the report client error request
+func (r *ReportClientErrorRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("kind", r.Kind.IsValid(), "kind must be crash or api_mismatch")
+	v.Check("platform", r.Platform.IsValid(), "platform must be ios, android, or web")
+	v.String("app_version", r.AppVersion).Required().MaxLength(MaxClientErrorAppVersionLength)
+	v.String("message", r.Message).Required().MaxLength(MaxClientErrorMessageLength)
+	v.String("stack_trace", r.StackTrace).MaxLength(MaxClientErrorStackTraceLength)
+	endpoint := v.String("endpoint", r.Endpoint)
+	if r.Kind == ClientErrorAPIMismatch {
+		endpoint.Required()
+	}
+	endpoint.MaxLength(MaxClientErrorEndpointLength)
+	if r.StatusCode != 0 {
+		v.Int("status_code", r.StatusCode).Between(100, 599)
+	}
+	v.Check("request_ids", len(r.RequestIDs) <= MaxClientErrorRequestIDs, fmt.Sprintf("request_ids must have %d entries or fewer", MaxClientErrorRequestIDs))
+
+	return v.Errors()
+}
+
+// ClientErrorReceipt tells the client whether its report was kept. Reports
+// that lose the sampling draw are accepted and dropped.
+type ClientErrorReceipt struct {
+	Stored bool   `json:"stored"`
+	ID     string `json:"id,omitempty"`
+}
+
+// ClientErrorFilter narrows the client errors admins browse. Empty fields
+// match any value.
+type ClientErrorFilter struct {
+	Kind        ClientErrorKind
+	Platform    DevicePlatform
+	AppVersion  string
+	Fingerprint string
+	Since       time.Time
+	Limit       int
+}
+
+// ClientErrorGroup aggregates the reports sharing a fingerprint
+type ClientErrorGroup struct {
+	Fingerprint string          `json:"fingerprint"`
+	Kind        ClientErrorKind `json:"kind"`
+	Platform    DevicePlatform  `json:"platform"`
+	Title       string          `json:"title"`
+	Endpoint    string          `json:"endpoint,omitempty"`
+	Count       int             `json:"count"`
+	AppVersions []string        `json:"app_versions"`
+	FirstSeen   time.Time       `json:"first_seen"`
+	LastSeen    time.Time       `json:"last_seen"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// CommitmentConflictKind describes how an existing commitment clashes with a
+// new one
+type CommitmentConflictKind string
+
+const (
+	ConflictOverlappingEvent   CommitmentConflictKind = "overlapping_event"
+	ConflictOverlappingHangout CommitmentConflictKind = "overlapping_hangout"
+	ConflictAdjacentRideshare  CommitmentConflictKind = "adjacent_rideshare"
+)
+
+// Commitment conflict constants
+const (
+	DefaultHangoutLength = 2 * time.Hour // Hangouts only record when they start
+	RideshareAdjacency   = time.Hour     // Rides this close to a commitment are flagged
+)
+
+// CommitmentCheck describes a commitment a user is about to make. EventID is
+// set when RSVPing, so the event itself and rides to it aren't reported.
+type CommitmentCheck struct {
+	UserID  string
+	Start   time.Time
+	End     time.Time
+	EventID string
+}
+
+// CommitmentConflict is an existing commitment that clashes with a new one.
+// Clients show these as warnings and resubmit with force to go ahead anyway.
+type CommitmentConflict struct {
+	Kind  CommitmentConflictKind `json:"kind"`
+	ID    string                 `json:"id"`
+	Title string                 `json:"title,omitempty"`
+	Start time.Time              `json:"start"`
+	End   time.Time              `json:"end"`
+}
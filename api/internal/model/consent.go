@@ -0,0 +1,115 @@
+package model
+
+import "time"
+
+// ConsentPurpose is a kind of data processing a user can opt in to
+type ConsentPurpose string
+
+const (
+	ConsentAnalytics          ConsentPurpose = "analytics"
+	ConsentMarketingEmail     ConsentPurpose = "marketing_email"
+	ConsentLocationProcessing ConsentPurpose = "location_processing"
+)
+
+// ConsentPurposes lists every purpose in display order
+var ConsentPurposes = []ConsentPurpose{
+	ConsentAnalytics,
+	ConsentMarketingEmail,
+	ConsentLocationProcessing,
+}
+
+// IsValid reports whether consent can be given for this purpose
+func (p ConsentPurpose) IsValid() bool {
+	_, ok := consentTexts[p]
+	return ok
+}
+
+// ConsentText is the wording a user agrees to for a purpose. Rewording a
+// purpose means bumping its version here; grants of an older version stop
+// counting and the user is asked again.
+type ConsentText struct {
+	Purpose ConsentPurpose `json:"purpose"`
+	Version int            `json:"version"`
+	Text    string         `json:"text"`
+}
+
+var consentTexts = map[ConsentPurpose]ConsentText{
+	ConsentAnalytics: {
+		Purpose: ConsentAnalytics,
+		Version: 1,
+		Text:    "Record anonymized events, such as completed hangouts and accepted matches, to help us understand how Saga is used. Your account is never identified in them.",
+	},
+	ConsentMarketingEmail: {
+		Purpose: ConsentMarketingEmail,
+		Version: 1,
+		Text:    "Send me occasional emails about new features, events and offers.",
+	},
+	ConsentLocationProcessing: {
+		Purpose: ConsentLocationProcessing,
+		Version: 1,
+		Text:    "Use my approximate location to suggest nearby people, guilds and events.",
+	},
+}
+
+// CurrentConsentText returns the wording currently shown for a purpose
+func CurrentConsentText(purpose ConsentPurpose) (ConsentText, bool) {
+	text, ok := consentTexts[purpose]
+	return text, ok
+}
+
+// Consent is one grant or withdrawal, kept as an append-only history so the
+// exact wording a user agreed to, and when, can always be shown
+type Consent struct {
+	ID            string         `json:"id"`
+	UserID        string         `json:"user_id"`
+	Purpose       ConsentPurpose `json:"purpose"`
+	Granted       bool           `json:"granted"`
+	Version       int            `json:"version"`                 // Version of the text granted or withdrawn
+	Grandfathered bool           `json:"grandfathered,omitempty"` // Backfilled for users who shared a location before consent was required
+	DecidedOn     time.Time      `json:"decided_on"`
+}
+
+// ConsentState is a user's current decision for a purpose. Granted is only
+// true when the latest decision granted the current text; NeedsReconsent is
+// set when the user granted an older version.
+type ConsentState struct {
+	Purpose        ConsentPurpose `json:"purpose"`
+	Text           ConsentText    `json:"text"`
+	Granted        bool           `json:"granted"`
+	GrantedVersion *int           `json:"granted_version,omitempty"`
+	NeedsReconsent bool           `json:"needs_reconsent"`
+	DecidedOn      *time.Time     `json:"decided_on,omitempty"`
+}
+
+// NewConsentState derives the state for a purpose from the latest decision,
+// which may be nil when the user has never decided
+func NewConsentState(purpose ConsentPurpose, latest *Consent) *ConsentState {
+	text, _ := CurrentConsentText(purpose)
+	state := &ConsentState{Purpose: purpose, Text: text}
+	if latest == nil {
+		return state
+	}
+
+	state.DecidedOn = &latest.DecidedOn
+	if latest.Granted {
+		version := latest.Version
+		state.GrantedVersion = &version
+		state.Granted = version == text.Version
+		state.NeedsReconsent = !state.Granted
+	}
+	return state
+}
+
+// GrantConsentRequest grants consent to the text version the user was shown
+type GrantConsentRequest struct {
+	Version int `json:"version"`
+}
+
+// Validate validates the grant consent request
+func (r *GrantConsentRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("version", r.Version >= 1, "version of the consent text shown is required")
+
+	return v.Errors()
+}
@@ -0,0 +1,255 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ReviewTagKind distinguishes the two review tag catalogs
+type ReviewTagKind string
+
+const (
+	ReviewTagKindPositive    ReviewTagKind = "positive"
+	ReviewTagKindImprovement ReviewTagKind = "improvement"
+)
+
+// IsValid reports whether the kind is a known review tag catalog
+func (k ReviewTagKind) IsValid() bool {
+	return k == ReviewTagKindPositive || k == ReviewTagKindImprovement
+}
+
+// QuestionCategory is an admin-managed questionnaire category
+type QuestionCategory struct {
+	Key          string     `json:"key"`
+	Label        string     `json:"label"`
+	Icon         string     `json:"icon,omitempty"`
+	SortOrder    int        `json:"sort_order"`
+	Active       bool       `json:"active"`
+	DeprecatedOn *time.Time `json:"deprecated_on,omitempty"` // Set when removed while still referenced
+	CreatedOn    time.Time  `json:"created_on"`
+	UpdatedOn    time.Time  `json:"updated_on"`
+}
+
+// Info returns the public display form of the category
+func (c *QuestionCategory) Info() QuestionCategoryInfo {
+	return QuestionCategoryInfo{ID: c.Key, Label: c.Label, Icon: c.Icon}
+}
+
+// ReviewTag is an admin-managed review tag
+type ReviewTag struct {
+	Kind         ReviewTagKind `json:"kind"`
+	Tag          string        `json:"tag"`
+	Label        string        `json:"label"`
+	Icon         string        `json:"icon,omitempty"`
+	SortOrder    int           `json:"sort_order"`
+	Active       bool          `json:"active"`
+	DeprecatedOn *time.Time    `json:"deprecated_on,omitempty"` // Set when removed while still referenced
+	CreatedOn    time.Time     `json:"created_on"`
+	UpdatedOn    time.Time     `json:"updated_on"`
+}
+
+// Info returns the public display form of the tag
+func (t *ReviewTag) Info() TagInfo {
+	return TagInfo{Tag: t.Tag, Label: t.Label, Icon: t.Icon}
+}
+
+// AdminQuestionOption is a question option including its internal bias weight
+type AdminQuestionOption struct {
+	Value        string  `json:"value"`
+	Label        string  `json:"label"`
+	ImplicitBias float64 `json:"implicit_bias"`
+}
+
+// AdminQuestion is the admin view of a question, exposing option bias
+// weights and how many answers reference it
+type AdminQuestion struct {
+	*Question
+	Options     []AdminQuestionOption `json:"options"`
+	AnswerCount int                   `json:"answer_count"`
+}
+
+// NewAdminQuestion builds the admin view of a question
+func NewAdminQuestion(q *Question, answerCount int) *AdminQuestion {
+	options := make([]AdminQuestionOption, len(q.Options))
+	for i, opt := range q.Options {
+		options[i] = AdminQuestionOption(opt)
+	}
+	return &AdminQuestion{
+		Question:    q,
+		Options:     options,
+		AnswerCount: answerCount,
+	}
+}
+
+// ContentRemoval reports the outcome of removing a catalog item.
+// Items still referenced are deprecated (hidden from public catalogs)
+// rather than deleted.
+type ContentRemoval struct {
+	Deleted    bool `json:"deleted"`
+	Deprecated bool `json:"deprecated"`
+	References int  `json:"references"`
+}
+
+// Content constraints
+const (
+	MaxQuestionTextLength = 500
+	MinQuestionOptions    = 2
+	MaxQuestionOptions    = 10
+	MaxContentLabelLength = 100
+	MaxContentKeyLength   = 50
+	MaxContentIconLength  = 100
+	MinImplicitBias       = -1.0
+	MaxImplicitBias       = 1.0
+)
+
+// contentKeyPattern restricts catalog keys, tags and option values to snake_case
+var contentKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+const contentKeyPatternError = "must be lowercase letters, digits and underscores"
+
+// CreateQuestionRequest represents an admin request to create a global question
+type CreateQuestionRequest struct {
+	Text                  string                `json:"text"`
+	Category              string                `json:"category"`
+	Options               []AdminQuestionOption `json:"options"`
+	IsDealBreakerEligible bool                  `json:"is_dealbreaker_eligible"`
+	SortOrder             int                   `json:"sort_order"`
+}
+
+// Validate validates the create question request
+func (r *CreateQuestionRequest) Validate() []FieldError {
+	var v Validator
+
+	validateQuestionText(&v, r.Text)
+	v.String("category", r.Category).Required()
+	validateQuestionOptions(&v, r.Options)
+
+	return v.Errors()
+}
+
+// UpdateQuestionRequest represents an admin request to update a question
+type UpdateQuestionRequest struct {
+	Text                  *string               `json:"text,omitempty"`
+	Category              *string               `json:"category,omitempty"`
+	Options               []AdminQuestionOption `json:"options,omitempty"`
+	IsDealBreakerEligible *bool                 `json:"is_dealbreaker_eligible,omitempty"`
+	SortOrder             *int                  `json:"sort_order,omitempty"`
+	Active                *bool                 `json:"active,omitempty"` // Set true to restore a deprecated question
+}
+
+// Validate validates the update question request
+func (r *UpdateQuestionRequest) Validate() []FieldError {
+	var v Validator
+
+	if r.Text != nil {
+		validateQuestionText(&v, *r.Text)
+	}
+	v.OptionalString("category", r.Category).NotEmpty()
+	if r.Options != nil {
+		validateQuestionOptions(&v, r.Options)
+	}
+
+	return v.Errors()
+}
+
+func validateQuestionText(v *Validator, text string) {
+	v.String("text", text).Required().MaxLength(MaxQuestionTextLength)
+}
+
+func validateQuestionOptions(v *Validator, options []AdminQuestionOption) {
+	v.Check("options", len(options) >= MinQuestionOptions && len(options) <= MaxQuestionOptions,
+		fmt.Sprintf("must have between %d and %d options", MinQuestionOptions, MaxQuestionOptions))
+
+	seen := make(map[string]bool, len(options))
+	for i, opt := range options {
+		field := fmt.Sprintf("options[%d]", i)
+		v.Check(field+".value", contentKeyPattern.MatchString(opt.Value), "value "+contentKeyPatternError)
+		v.Check(field+".value", !seen[opt.Value], "duplicate option value")
+		seen[opt.Value] = true
+
+		v.Check(field+".label", strings.TrimSpace(opt.Label) != "", "label is required")
+		v.Check(field+".implicit_bias", opt.ImplicitBias >= MinImplicitBias && opt.ImplicitBias <= MaxImplicitBias,
+			"implicit_bias must be between -1 and 1")
+	}
+}
+
+// CreateQuestionCategoryRequest represents an admin request to create a question category
+type CreateQuestionCategoryRequest struct {
+	Key       string `json:"key"`
+	Label     string `json:"label"`
+	Icon      string `json:"icon,omitempty"`
+	SortOrder int    `json:"sort_order"`
+}
+
+// Validate validates the create question category request
+func (r *CreateQuestionCategoryRequest) Validate() []FieldError {
+	var v Validator
+
+	validateContentKey(&v, "key", r.Key)
+	v.String("label", r.Label).Required().MaxLength(MaxContentLabelLength)
+	v.String("icon", r.Icon).MaxLength(MaxContentIconLength)
+
+	return v.Errors()
+}
+
+// UpdateQuestionCategoryRequest represents an admin request to update a question category
+type UpdateQuestionCategoryRequest struct {
+	Label     *string `json:"label,omitempty"`
+	Icon      *string `json:"icon,omitempty"`
+	SortOrder *int    `json:"sort_order,omitempty"`
+	Active    *bool   `json:"active,omitempty"` // Set true to restore a deprecated category
+}
+
+// Validate validates the update question category request
+func (r *UpdateQuestionCategoryRequest) Validate() []FieldError {
+	var v Validator
+
+	v.OptionalString("label", r.Label).NotEmpty().MaxLength(MaxContentLabelLength)
+	v.OptionalString("icon", r.Icon).MaxLength(MaxContentIconLength)
+
+	return v.Errors()
+}
+
+// CreateReviewTagRequest represents an admin request to create a review tag
+type CreateReviewTagRequest struct {
+	Tag       string `json:"tag"`
+	Label     string `json:"label"`
+	Icon      string `json:"icon,omitempty"`
+	SortOrder int    `json:"sort_order"`
+}
+
+// Validate validates the create review tag request
+func (r *CreateReviewTagRequest) Validate() []FieldError {
+	var v Validator
+
+	validateContentKey(&v, "tag", r.Tag)
+	v.String("label", r.Label).Required().MaxLength(MaxContentLabelLength)
+	v.String("icon", r.Icon).MaxLength(MaxContentIconLength)
+
+	return v.Errors()
+}
+
+// UpdateReviewTagRequest represents an admin request to update a review tag
+type UpdateReviewTagRequest struct {
+	Label     *string `json:"label,omitempty"`
+	Icon      *string `json:"icon,omitempty"`
+	SortOrder *int    `json:"sort_order,omitempty"`
+	Active    *bool   `json:"active,omitempty"` // Set true to restore a deprecated tag
+}
+
+// Validate validates the update review tag request
+func (r *UpdateReviewTagRequest) Validate() []FieldError {
+	var v Validator
+
+	v.OptionalString("label", r.Label).NotEmpty().MaxLength(MaxContentLabelLength)
+	v.OptionalString("icon", r.Icon).MaxLength(MaxContentIconLength)
+
+	return v.Errors()
+}
+
+func validateContentKey(v *Validator, field, key string) {
+	v.String(field, key).Required().MaxLength(MaxContentKeyLength)
+	v.Check(field, contentKeyPattern.MatchString(key), field+" "+contentKeyPatternError)
+}
@@ -43,40 +43,17 @@ type RegisterDeviceRequest struct {
 
 // Validate validates the register device request
 func (r *RegisterDeviceRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if !r.Platform.IsValid() {
-		errors = append(errors, FieldError{
-			Field:   "platform",
-			Message: "platform must be ios, android, or web",
-		})
-	}
-
-	if r.Token == "" {
-		errors = append(errors, FieldError{
-			Field:   "token",
-			Message: "token is required",
-		})
-	}
-
-	if len(r.Token) > 4096 {
-		errors = append(errors, FieldError{
-			Field:   "token",
-			Message: "token exceeds maximum length",
-		})
-	}
-
-	if len(r.Name) > 100 {
-		errors = append(errors, FieldError{
-			Field:   "name",
-			Message: "name exceeds maximum length",
-		})
-	}
+	v.Check("platform", r.Platform.IsValid(), "platform must be ios, android, or web")
+	v.String("token", r.Token).Required().MaxLength(MaxDeviceTokenLength)
+	v.String("name", r.Name).MaxLength(MaxDeviceNameLength)
 
-	return errors
+	return v.Errors()
 }
 
 // Business constraints for devices
 const (
-	MaxDevicesPerUser = 10
+	MaxDevicesPerUser    = 10
+	MaxDeviceTokenLength = 4096
 )
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// Device pairing constants
+const (
+	DefaultDevicePairingTTL   = 2 * time.Minute
+	DevicePairingPollInterval = 2 // Seconds the new device waits between polls
+	MaxDeviceNameLength       = 100
+)
+
+// Device pairing statuses
+const (
+	DevicePairingPending  = "pending"
+	DevicePairingApproved = "approved"
+)
+
+// DevicePairing is a QR login for a shared device. The device shows a signed
+// payload as a QR code; a phone that is already signed in approves it, and
+// the device collects its tokens by polling.
+type DevicePairing struct {
+	ID            string     `json:"id"`
+	DeviceName    string     `json:"device_name,omitempty"`
+	PollTokenHash string     `json:"-"`
+	UserID        string     `json:"-"` // Set once approved
+	ApprovedOn    *time.Time `json:"approved_on,omitempty"`
+	ExpiresOn     time.Time  `json:"expires_on"`
+	CreatedOn     time.Time  `json:"created_on"`
+}
+
+// IsExpired reports whether the QR code can no longer be approved or collected
+func (p *DevicePairing) IsExpired(now time.Time) bool {
+	return !now.Before(p.ExpiresOn)
+}
+
+// DevicePairingStart is returned to the device that wants to sign in. It
+// shows Payload as a QR code and keeps PollToken to itself.
+type DevicePairingStart struct {
+	ID        string    `json:"id"`
+	Payload   string    `json:"payload"`
+	PollToken string    `json:"poll_token"`
+	ExpiresOn time.Time `json:"expires_on"`
+	Interval  int       `json:"interval"`
+}
+
+// StartDevicePairingRequest represents a request to show a QR login
+type StartDevicePairingRequest struct {
+	DeviceName string `json:"device_name,omitempty"` // Shown on the phone, e.g. "Library kiosk"
+}
+
+// Validate validates the start device pairing request
+func (r *StartDevicePairingRequest) Validate() []FieldError {
+	r.DeviceName = strings.TrimSpace(r.DeviceName)
+
+	var v Validator
+	v.String("device_name", r.DeviceName).MaxLength(MaxDeviceNameLength)
+	return v.Errors()
+}
+
+// ApproveDeviceRequest represents a signed-in user approving a QR login
+type ApproveDeviceRequest struct {
+	Payload string `json:"payload"`
+}
+
+// Validate validates the approve device request
+func (r *ApproveDeviceRequest) Validate() []FieldError {
+	var v Validator
+	v.String("payload", r.Payload).Required()
+	return v.Errors()
+}
+
+// PollDevicePairingRequest represents the new device checking for approval
+type PollDevicePairingRequest struct {
+	PollToken string `json:"poll_token"`
+}
+
+// Validate validates the poll device pairing request
+func (r *PollDevicePairingRequest) Validate() []FieldError {
+	var v Validator
+	v.String("poll_token", r.PollToken).Required()
+	return v.Errors()
+}
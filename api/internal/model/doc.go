@@ -33,6 +33,19 @@
 //	    MaxMembersPerGuild = 1000
 //	)
 //
+// # Request Validation
+//
+// Request types implement Validate() []FieldError with a Validator, which
+// declares rules per field and keeps the first error for each:
+//
+//	func (r *CreateVoteRequest) Validate() []FieldError {
+//	    var v Validator
+//	    v.String("title", r.Title).Required().MaxLength(MaxVoteTitleLength)
+//	    v.Timestamp("opens_at", r.OpensAt).Required()
+//	    v.Timestamp("closes_at", r.ClosesAt).Required().After("opens_at")
+//	    return v.Errors()
+//	}
+//
 // # Error Types
 //
 // RFC 9457 Problem Details errors are defined in errors.go:
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DomainEventType names something that happened in the domain which other
+// parts of the service layer react to
+type DomainEventType string
+
+const (
+	DomainEventMemberJoined   DomainEventType = "member_joined"
+	DomainEventEventCompleted DomainEventType = "event_completed"
+	DomainEventTrustGranted   DomainEventType = "trust_granted"
+)
+
+// DomainEvent is the payload of a domain event. Each payload type belongs to
+// exactly one DomainEventType.
+type DomainEvent interface {
+	DomainEventType() DomainEventType
+}
+
+// MemberJoined is published when a user becomes a member of a guild, either
+// directly or by accepting an invite. Requests to join a private guild that
+// still await approval are not published.
+type MemberJoined struct {
+	UserID    string `json:"user_id"`
+	GuildID   string `json:"guild_id"`
+	GuildName string `json:"guild_name"`
+}
+
+// DomainEventType implements DomainEvent
+func (MemberJoined) DomainEventType() DomainEventType { return DomainEventMemberJoined }
+
+// EventCompleted is published when an attendee confirms they completed an
+// event
+type EventCompleted struct {
+	EventID     string     `json:"event_id"`
+	UserID      string     `json:"user_id"`
+	StartTime   time.Time  `json:"start_time"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+	CheckinTime *time.Time `json:"checkin_time,omitempty"`
+	ConfirmedOn time.Time  `json:"confirmed_on"`
+}
+
+// DomainEventType implements DomainEvent
+func (EventCompleted) DomainEventType() DomainEventType { return DomainEventEventCompleted }
+
+// EarlyConfirm reports whether completion was confirmed within
+// EarlyConfirmHours of the event ending
+func (e EventCompleted) EarlyConfirm() bool {
+	end := e.StartTime.Add(DefaultEventLength)
+	if e.EndTime != nil {
+		end = *e.EndTime
+	}
+	return !e.ConfirmedOn.After(end.Add(EarlyConfirmHours * time.Hour))
+}
+
+// OnTimeCheckin reports whether the attendee checked in no later than
+// CheckinWindowMinutes after the event started
+func (e EventCompleted) OnTimeCheckin() bool {
+	return e.CheckinTime != nil && !e.CheckinTime.After(e.StartTime.Add(CheckinWindowMinutes*time.Minute))
+}
+
+// TrustGranted is published when one user grants trust to another
+type TrustGranted struct {
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+}
+
+// DomainEventType implements DomainEvent
+func (TrustGranted) DomainEventType() DomainEventType { return DomainEventTrustGranted }
+
+// Outbox delivery settings
+const (
+	OutboxMaxAttempts    = 8                // Deliveries tried before an entry is marked dead
+	OutboxRetryBaseDelay = 30 * time.Second // Delay after the first failure, doubled per attempt
+	OutboxRetryMaxDelay  = 1 * time.Hour
+	OutboxClaimLease     = 5 * time.Minute // How long a claimed entry is hidden from other dispatchers
+)
+
+// OutboxEntry is one pending delivery of a domain event to one subscriber.
+// Entries are deleted once delivered; an entry that keeps failing is kept
+// with DeadOn set so it can be inspected.
+type OutboxEntry struct {
+	ID          string          `json:"id"`
+	EventType   DomainEventType `json:"event_type"`
+	Subscriber  string          `json:"subscriber"`
+	Payload     json.RawMessage `json:"payload"`
+	Attempts    int             `json:"attempts"`
+	LastError   *string         `json:"last_error,omitempty"`
+	AvailableOn time.Time       `json:"available_on"`
+	DeadOn      *time.Time      `json:"dead_on,omitempty"`
+	OccurredOn  time.Time       `json:"occurred_on"`
+	RequestID   string          `json:"request_id,omitempty"` // Request that published the event
+	CreatedOn   time.Time       `json:"created_on"`
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// EventCompleted Tests
+// ============================================================================
+
+func TestEventCompleted_EarlyConfirm(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+	tests := []struct {
+		name      string
+		endTime   *time.Time
+		confirmed time.Time
+		want      bool
+	}{
+		{"within window of end", &end, end.Add(EarlyConfirmHours * time.Hour), true},
+		{"after window of end", &end, end.Add(EarlyConfirmHours*time.Hour + time.Minute), false},
+		{"no end time uses default length", nil, start.Add(DefaultEventLength + EarlyConfirmHours*time.Hour), true},
+		{"no end time, late", nil, start.Add(DefaultEventLength + EarlyConfirmHours*time.Hour + time.Minute), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := EventCompleted{StartTime: start, EndTime: tt.endTime, ConfirmedOn: tt.confirmed}
+			if got := e.EarlyConfirm(); got != tt.want {
+				t.Errorf("EarlyConfirm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventCompleted_OnTimeCheckin(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+	early := start.Add(-30 * time.Minute)
+	edge := start.Add(CheckinWindowMinutes * time.Minute)
+	late := edge.Add(time.Second)
+	tests := []struct {
+		name    string
+		checkin *time.Time
+		want    bool
+	}{
+		{"no checkin", nil, false},
+		{"before start", &early, true},
+		{"end of window", &edge, true},
+		{"late", &late, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := EventCompleted{StartTime: start, CheckinTime: tt.checkin}
+			if got := e.OnTimeCheckin(); got != tt.want {
+				t.Errorf("OnTimeCheckin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DraftResourceType is the kind of resource a draft becomes when submitted
+type DraftResourceType string
+
+const (
+	DraftResourceEvent     DraftResourceType = "event"
+	DraftResourceAdventure DraftResourceType = "adventure"
+)
+
+// IsValid reports whether drafts of this resource type are supported
+func (t DraftResourceType) IsValid() bool {
+	return t == DraftResourceEvent || t == DraftResourceAdventure
+}
+
+// Draft constraints
+const (
+	DraftTTLDays      = 30        // Drafts expire this long after their last save
+	MaxDraftsPerUser  = 25        // Across all resource types
+	MaxDraftDataBytes = 32 * 1024 // Encoded size of a draft's data
+)
+
+// Draft is a user's unfinished create request, saved as they fill in a long
+// form. Data holds the request fields as entered, keyed like the create
+// request, so submitting a draft is the same as posting its data.
+type Draft struct {
+	ID           string                 `json:"id"`
+	UserID       string                 `json:"user_id"`
+	ResourceType DraftResourceType      `json:"resource_type"`
+	Data         map[string]interface{} `json:"data"`
+	Errors       []FieldError           `json:"errors,omitempty"` // From the last failed submit, keyed by data field
+	ExpiresOn    time.Time              `json:"expires_on"`
+	CreatedOn    time.Time              `json:"created_on"`
+	UpdatedOn    time.Time              `json:"updated_on"`
+}
+
+// DraftSubmission is the resource created from a submitted draft
+type DraftSubmission struct {
+	ResourceType DraftResourceType `json:"resource_type"`
+	ResourceID   string            `json:"resource_id"`
+	Resource     interface{}       `json:"resource"`
+}
+
+// SaveDraftRequest represents a request to start a draft
+type SaveDraftRequest struct {
+	ResourceType DraftResourceType      `json:"resource_type"`
+	Data         map[string]interface{} `json:"data"`
+}
+
+// Validate validates the save draft request
+func (r *SaveDraftRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("resource_type", r.ResourceType.IsValid(), "resource_type must be event or adventure")
+	v.Merge(validateDraftData(r.Data))
+
+	return v.Errors()
+}
+
+// UpdateDraftRequest represents an autosave of a draft's data
+type UpdateDraftRequest struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+// Validate validates the update draft request
+func (r *UpdateDraftRequest) Validate() []FieldError {
+	return validateDraftData(r.Data)
+}
+
+func validateDraftData(data map[string]interface{}) []FieldError {
+	if data == nil {
+		return []FieldError{{Field: "data", Message: "data is required"}}
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return []FieldError{{Field: "data", Message: "data must be a JSON object"}}
+	}
+	if len(encoded) > MaxDraftDataBytes {
+		return []FieldError{{Field: "data", Message: fmt.Sprintf("data must be %d bytes or less", MaxDraftDataBytes)}}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DuplicateKind is the kind of resource a duplicate candidate is
+type DuplicateKind string
+
+const (
+	DuplicateGuild DuplicateKind = "guild"
+	DuplicateEvent DuplicateKind = "event"
+)
+
+// Duplicate detection constants
+const (
+	DuplicateNameThreshold      = 0.8 // Names at least this similar are duplicates on their own
+	DuplicateNamePlaceThreshold = 0.5 // Event titles this similar are duplicates at the same place
+	DuplicateNearbyKm           = 0.5 // Events this close count as the same place
+	MaxDuplicateCandidates      = 5
+)
+
+// DuplicateCandidate is an existing guild or event a new one looks like.
+// Clients show these as warnings and resubmit with confirm_duplicate to
+// create it anyway.
+type DuplicateCandidate struct {
+	Kind       DuplicateKind `json:"kind"`
+	ID         string        `json:"id"`
+	Name       string        `json:"name"`
+	Similarity float64       `json:"similarity"`           // Of the names, from 0 to 1
+	StartTime  *time.Time    `json:"start_time,omitempty"` // Events only
+	City       string        `json:"city,omitempty"`       // Events only
+}
+
+// SortDuplicateCandidates orders candidates most similar first and keeps
+// at most MaxDuplicateCandidates
+func SortDuplicateCandidates(candidates []DuplicateCandidate) []DuplicateCandidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Similarity > candidates[j].Similarity
+	})
+	if len(candidates) > MaxDuplicateCandidates {
+		candidates = candidates[:MaxDuplicateCandidates]
+	}
+	return candidates
+}
+
+// NameSimilarity scores how alike two names are, from 0 to 1. Case,
+// punctuation, spacing and word order are ignored, so "Tuesday Hikers" and
+// "hikers - tuesday" score 1; otherwise it is one minus the edit distance
+// over the longer name's length.
+func NameSimilarity(a, b string) float64 {
+	wordsA, wordsB := nameWords(a), nameWords(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	score := editSimilarity(strings.Join(wordsA, " "), strings.Join(wordsB, " "))
+	sort.Strings(wordsA)
+	sort.Strings(wordsB)
+	if sorted := editSimilarity(strings.Join(wordsA, " "), strings.Join(wordsB, " ")); sorted > score {
+		score = sorted
+	}
+	return score
+}
+
+// nameWords lowercases a name and splits it into words, dropping
+// punctuation
+func nameWords(name string) []string {
+	return strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// editSimilarity is one minus the Levenshtein distance between a and b over
+// the longer one's length
+func editSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+
+	// Two rows of the distance matrix are enough
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return 1 - float64(prev[len(rb)])/float64(longest)
+}
@@ -0,0 +1,47 @@
+package model
+
+import "testing"
+
+// ============================================================================
+// Duplicate Detection Tests
+// ============================================================================
+
+func TestNameSimilarity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+		min  float64
+		max  float64
+	}{
+		{"Tuesday Hikers", "tuesday hikers", 1, 1},
+		{"Tuesday Hikers", "Hikers - Tuesday!", 1, 1},
+		{"Board Game Night", "Boardgame Night", 0.9, 0.99},
+		{"Chess Club", "Chess Clubb", 0.9, 0.99},
+		{"Chess Club", "Book Club", 0, DuplicateNameThreshold - 0.01},
+		{"Chess Club", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := NameSimilarity(tt.a, tt.b); got < tt.min || got > tt.max {
+			t.Errorf("NameSimilarity(%q, %q) = %.2f, want between %.2f and %.2f", tt.a, tt.b, got, tt.min, tt.max)
+		}
+	}
+}
+
+func TestSortDuplicateCandidates(t *testing.T) {
+	t.Parallel()
+
+	var candidates []DuplicateCandidate
+	for i := range MaxDuplicateCandidates + 2 {
+		candidates = append(candidates, DuplicateCandidate{ID: string(rune('a' + i)), Similarity: float64(i) / 10})
+	}
+
+	got := SortDuplicateCandidates(candidates)
+	if len(got) != MaxDuplicateCandidates {
+		t.Fatalf("kept %d candidates, want %d", len(got), MaxDuplicateCandidates)
+	}
+	if got[0].Similarity < got[len(got)-1].Similarity {
+		t.Errorf("candidates = %+v, want the most similar first", got)
+	}
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// Credential change constants
+const (
+	ReauthWindow   = 5 * time.Minute // How recent a sign-in must be to skip the current password
+	EmailChangeTTL = 24 * time.Hour  // How long a verification link for a new address works
+	RecoveryDelay  = 72 * time.Hour  // How long a passkey recovery waits before the new address can be verified
+)
+
+// Paths on the public site that open emailed links
+const (
+	EmailVerifyPath    = "/verify-email"
+	RecoveryCancelPath = "/cancel-recovery"
+)
+
+// EmailChange is a pending switch to a new email address. The account keeps
+// its current address until the new one is verified with the emailed token.
+//
+// A recovery change is started with a passkey by a user who has lost their
+// inbox. Its link only works from EffectiveOn, giving the old address time
+// to cancel it with the token in the notice sent there.
+type EmailChange struct {
+	ID              string     `json:"-"`
+	UserID          string     `json:"-"`
+	NewEmail        string     `json:"new_email"`
+	TokenHash       string     `json:"-"` // SHA-256 of the emailed token; the token itself is never stored
+	Recovery        bool       `json:"recovery,omitempty"`
+	EffectiveOn     *time.Time `json:"effective_on,omitempty"`
+	CancelTokenHash string     `json:"-"` // SHA-256 of the token sent to the old address, on recovery changes
+	ExpiresOn       time.Time  `json:"expires_on"`
+	CreatedOn       time.Time  `json:"created_on"`
+}
+
+// IsExpired reports whether the verification link has lapsed
+func (c *EmailChange) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresOn)
+}
+
+// IsPending reports whether a recovery change is still in its waiting period
+func (c *EmailChange) IsPending(now time.Time) bool {
+	return c.EffectiveOn != nil && now.Before(*c.EffectiveOn)
+}
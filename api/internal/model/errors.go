@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // ErrorCode represents API error codes
@@ -17,23 +18,31 @@ const (
 	ErrCodeLoginFailed  ErrorCode = 1004
 
 	// Authorization errors (2xxx)
-	ErrCodeForbidden ErrorCode = 2001
-	ErrCodeNotMember ErrorCode = 2002
+	ErrCodeForbidden         ErrorCode = 2001
+	ErrCodeNotMember         ErrorCode = 2002
+	ErrCodeProfileIncomplete ErrorCode = 2003
+	ErrCodeReauthRequired    ErrorCode = 2004
 
 	// Resource errors (3xxx)
-	ErrCodeNotFound      ErrorCode = 3001
-	ErrCodeAlreadyExists ErrorCode = 3002
-	ErrCodeConflict      ErrorCode = 3003
+	ErrCodeNotFound          ErrorCode = 3001
+	ErrCodeAlreadyExists     ErrorCode = 3002
+	ErrCodeConflict          ErrorCode = 3003
+	ErrCodeStaleVersion      ErrorCode = 3004
+	ErrCodeScheduleConflict  ErrorCode = 3005
+	ErrCodeRSVPNotOpen       ErrorCode = 3006
+	ErrCodePossibleDuplicate ErrorCode = 3007
 
 	// Validation errors (4xxx)
-	ErrCodeValidation    ErrorCode = 4001
-	ErrCodeInvalidInput  ErrorCode = 4002
-	ErrCodeLimitExceeded ErrorCode = 4003
+	ErrCodeValidation      ErrorCode = 4001
+	ErrCodeInvalidInput    ErrorCode = 4002
+	ErrCodeLimitExceeded   ErrorCode = 4003
+	ErrCodeUpgradeRequired ErrorCode = 4004
 
 	// Internal errors (5xxx)
 	ErrCodeInternal    ErrorCode = 5001
 	ErrCodeDatabase    ErrorCode = 5002
 	ErrCodeExternalAPI ErrorCode = 5003
+	ErrCodeReadOnly    ErrorCode = 5004
 )
 
 // ProblemDetails represents RFC 9457 Problem Details for HTTP APIs
@@ -45,9 +54,16 @@ type ProblemDetails struct {
 	Instance string       `json:"instance,omitempty"`
 	Errors   []FieldError `json:"errors,omitempty"`
 	// Extension fields
-	Code    ErrorCode `json:"code,omitempty"`
-	Limit   *int      `json:"limit,omitempty"`
-	Current *int      `json:"current,omitempty"`
+	Code       ErrorCode             `json:"code,omitempty"`
+	Limit      *int                  `json:"limit,omitempty"`
+	Current    *int                  `json:"current,omitempty"`
+	Resource   interface{}           `json:"resource,omitempty"`   // Current state, on version conflicts
+	Conflicts  []CommitmentConflict  `json:"conflicts,omitempty"`  // Clashing commitments, on schedule conflicts
+	NextStep   *CompletenessNextStep `json:"next_step,omitempty"`  // What to fill in, on incomplete profiles
+	Password   *PasswordStrength     `json:"password,omitempty"`   // Strength and guidance, on rejected passwords
+	OpensAt    *time.Time            `json:"opens_at,omitempty"`   // When the caller can RSVP, on early RSVPs
+	Duplicates []DuplicateCandidate  `json:"duplicates,omitempty"` // Lookalikes, on possible duplicates
+	Upgrade    *UpgradeRequired      `json:"upgrade,omitempty"`    // Versions and where to update, on obsolete clients
 }
 
 // FieldError represents a validation error on a specific field
@@ -90,6 +106,34 @@ func NewForbiddenError(detail string) *ProblemDetails {
 	}
 }
 
+// NewReauthRequiredError rejects a sensitive change until the user enters
+// their current password or signs in again
+func NewReauthRequiredError() *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "https://saga-api.forgo.software/errors/reauth-required",
+		Title:  "Re-authentication Required",
+		Status: http.StatusForbidden,
+		Detail: fmt.Sprintf("Enter your current password, or sign in again within the last %d minutes", int(ReauthWindow.Minutes())),
+		Code:   ErrCodeReauthRequired,
+	}
+}
+
+// NewProfileIncompleteError rejects a feature gated on profile completeness.
+// Current and Limit carry the score and the threshold.
+func NewProfileIncompleteError(completeness *ProfileCompleteness) *ProblemDetails {
+	score, threshold := completeness.Score, completeness.DiscoveryThreshold
+	return &ProblemDetails{
+		Type:     "https://saga-api.forgo.software/errors/profile-incomplete",
+		Title:    "Profile Incomplete",
+		Status:   http.StatusForbidden,
+		Detail:   fmt.Sprintf("Complete your profile to at least %d%% to use discovery (currently %d%%)", threshold, score),
+		Code:     ErrCodeProfileIncomplete,
+		Limit:    &threshold,
+		Current:  &score,
+		NextStep: completeness.NextStep,
+	}
+}
+
 func NewNotFoundError(resource string) *ProblemDetails {
 	return &ProblemDetails{
 		Type:   "https://saga-api.forgo.software/errors/not-found",
@@ -119,6 +163,18 @@ func NewValidationError(errors []FieldError) *ProblemDetails {
 	}
 }
 
+// NewWeakPasswordError rejects a password that is too guessable or has been
+// breached, with the strength estimate and suggestions for a better one
+func NewWeakPasswordError(strength *PasswordStrength) *ProblemDetails {
+	message := "password is too easy to guess"
+	if strength.Breached {
+		message = "password has appeared in a data breach"
+	}
+	pd := NewValidationError([]FieldError{{Field: "password", Message: message}})
+	pd.Password = strength
+	return pd
+}
+
 func NewLimitExceededError(resource string, limit, current int) *ProblemDetails {
 	return &ProblemDetails{
 		Type:    "https://saga-api.forgo.software/errors/limit-exceeded",
@@ -141,6 +197,66 @@ func NewConflictError(detail string) *ProblemDetails {
 	}
 }
 
+// NewVersionConflictError reports an update based on a stale version. The
+// resource's current state is included so the client can merge and retry.
+func NewVersionConflictError(resource interface{}) *ProblemDetails {
+	return &ProblemDetails{
+		Type:     "https://saga-api.forgo.software/errors/version-conflict",
+		Title:    "Version Conflict",
+		Status:   http.StatusConflict,
+		Detail:   "resource was modified since the given version",
+		Code:     ErrCodeStaleVersion,
+		Resource: resource,
+	}
+}
+
+func NewScheduleConflictError(conflicts []CommitmentConflict) *ProblemDetails {
+	return &ProblemDetails{
+		Type:      "https://saga-api.forgo.software/errors/schedule-conflict",
+		Title:     "Schedule Conflict",
+		Status:    http.StatusConflict,
+		Detail:    "this overlaps other commitments; resubmit with force to continue",
+		Code:      ErrCodeScheduleConflict,
+		Conflicts: conflicts,
+	}
+}
+
+// NewPossibleDuplicateError rejects a new guild or event that looks like
+// existing ones until the client confirms it
+func NewPossibleDuplicateError(duplicates []DuplicateCandidate) *ProblemDetails {
+	return &ProblemDetails{
+		Type:       "https://saga-api.forgo.software/errors/possible-duplicate",
+		Title:      "Possible Duplicate",
+		Status:     http.StatusConflict,
+		Detail:     "this looks like something that already exists; resubmit with confirm_duplicate to create it anyway",
+		Code:       ErrCodePossibleDuplicate,
+		Duplicates: duplicates,
+	}
+}
+
+// NewRSVPNotOpenError rejects an RSVP before the event's RSVP window opens
+// for the caller. OpensAt is when it does.
+func NewRSVPNotOpenError(opensAt time.Time) *ProblemDetails {
+	return &ProblemDetails{
+		Type:    "https://saga-api.forgo.software/errors/rsvp-not-open",
+		Title:   "RSVPs Not Open",
+		Status:  http.StatusConflict,
+		Detail:  fmt.Sprintf("RSVPs open for you at %s", opensAt.UTC().Format(time.RFC3339)),
+		Code:    ErrCodeRSVPNotOpen,
+		OpensAt: &opensAt,
+	}
+}
+
+func NewGoneError(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "https://saga-api.forgo.software/errors/gone",
+		Title:  "Gone",
+		Status: http.StatusGone,
+		Detail: detail,
+		Code:   ErrCodeNotFound,
+	}
+}
+
 func NewInternalError(detail string) *ProblemDetails {
 	if detail == "" {
 		detail = "An unexpected error occurred"
@@ -154,6 +270,16 @@ func NewInternalError(detail string) *ProblemDetails {
 	}
 }
 
+func NewUnsupportedMediaTypeError(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "https://saga-api.forgo.software/errors/unsupported-media-type",
+		Title:  "Unsupported Media Type",
+		Status: http.StatusUnsupportedMediaType,
+		Detail: detail,
+		Code:   ErrCodeInvalidInput,
+	}
+}
+
 func NewBadRequestError(detail string) *ProblemDetails {
 	return &ProblemDetails{
 		Type:   "https://saga-api.forgo.software/errors/bad-request",
@@ -181,3 +307,54 @@ func NewRateLimitError(retryAfter int) *ProblemDetails {
 		Detail: fmt.Sprintf("Rate limit exceeded. Retry after %d seconds", retryAfter),
 	}
 }
+
+func NewTooManyRequestsError(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "https://saga-api.forgo.software/errors/too-many-requests",
+		Title:  "Too Many Requests",
+		Status: http.StatusTooManyRequests,
+		Detail: detail,
+	}
+}
+
+func NewQuotaExceededError(retryAfter int) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "https://saga-api.forgo.software/errors/quota-exceeded",
+		Title:  "Too Many Requests",
+		Status: http.StatusTooManyRequests,
+		Detail: fmt.Sprintf("Daily request quota exceeded. Retry after %d seconds", retryAfter),
+	}
+}
+
+func NewServiceUnavailableError(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "https://saga-api.forgo.software/errors/service-unavailable",
+		Title:  "Service Unavailable",
+		Status: http.StatusServiceUnavailable,
+		Detail: detail,
+		Code:   ErrCodeExternalAPI,
+	}
+}
+
+func NewReadOnlyError(region string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "https://saga-api.forgo.software/errors/read-only",
+		Title:  "Service Unavailable",
+		Status: http.StatusServiceUnavailable,
+		Detail: fmt.Sprintf("Region %s is read-only; writes are temporarily unavailable", region),
+		Code:   ErrCodeReadOnly,
+	}
+}
+
+// NewUpgradeRequiredError rejects a client app older than the minimum
+// version supported on its platform
+func NewUpgradeRequiredError(upgrade *UpgradeRequired) *ProblemDetails {
+	return &ProblemDetails{
+		Type:    "https://saga-api.forgo.software/errors/upgrade-required",
+		Title:   "Upgrade Required",
+		Status:  http.StatusUpgradeRequired,
+		Detail:  fmt.Sprintf("Version %s of the app is no longer supported; update to %s or later", upgrade.Version, upgrade.MinimumVersion),
+		Code:    ErrCodeUpgradeRequired,
+		Upgrade: upgrade,
+	}
+}
@@ -306,6 +306,96 @@ func TestNewConflictError_ReturnsCorrectValues(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// Constructor Tests - NewScheduleConflictError
+// ============================================================================
+
+func TestNewScheduleConflictError_ReturnsCorrectValues(t *testing.T) {
+	t.Parallel()
+
+	conflicts := []CommitmentConflict{{Kind: ConflictOverlappingEvent, ID: "event:1"}}
+	pd := NewScheduleConflictError(conflicts)
+
+	if pd.Status != http.StatusConflict {
+		t.Errorf("expected status %d, got %d", http.StatusConflict, pd.Status)
+	}
+	if pd.Code != ErrCodeScheduleConflict {
+		t.Errorf("expected code %d, got %d", ErrCodeScheduleConflict, pd.Code)
+	}
+	if len(pd.Conflicts) != 1 || pd.Conflicts[0].ID != "event:1" {
+		t.Errorf("expected conflicts to be carried, got %v", pd.Conflicts)
+	}
+}
+
+// ============================================================================
+// Constructor Tests - NewProfileIncompleteError
+// ============================================================================
+
+func TestNewProfileIncompleteError_ReturnsCorrectValues(t *testing.T) {
+	t.Parallel()
+
+	pd := NewProfileIncompleteError(NewProfileCompleteness(CompletenessInput{HasBio: true}))
+
+	if pd.Status != http.StatusForbidden {
+		t.Errorf("expected status %d, got %d", http.StatusForbidden, pd.Status)
+	}
+	if pd.Code != ErrCodeProfileIncomplete {
+		t.Errorf("expected code %d, got %d", ErrCodeProfileIncomplete, pd.Code)
+	}
+	if pd.Current == nil || *pd.Current != 20 {
+		t.Errorf("expected current score 20, got %v", pd.Current)
+	}
+	if pd.Limit == nil || *pd.Limit != DiscoveryMinCompleteness {
+		t.Errorf("expected limit %d, got %v", DiscoveryMinCompleteness, pd.Limit)
+	}
+	if pd.NextStep == nil || pd.NextStep.Item != CompletenessQuestionnaire {
+		t.Errorf("expected questionnaire as next step, got %+v", pd.NextStep)
+	}
+}
+
+func TestNewWeakPasswordError_ReturnsCorrectValues(t *testing.T) {
+	t.Parallel()
+
+	strength := &PasswordStrength{Score: 1, Breached: true, BreachCount: 12, Suggestions: []string{"Add another word or two."}}
+	pd := NewWeakPasswordError(strength)
+
+	if pd.Status != http.StatusUnprocessableEntity {
+		t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, pd.Status)
+	}
+	if len(pd.Errors) != 1 || pd.Errors[0].Field != "password" {
+		t.Errorf("expected a password field error, got %+v", pd.Errors)
+	}
+	if pd.Errors[0].Message != "password has appeared in a data breach" {
+		t.Errorf("unexpected message %q", pd.Errors[0].Message)
+	}
+	if pd.Password != strength {
+		t.Error("expected the strength guidance to be attached")
+	}
+}
+
+// ============================================================================
+// Constructor Tests - NewGoneError
+// ============================================================================
+
+func TestNewGoneError_ReturnsCorrectValues(t *testing.T) {
+	t.Parallel()
+
+	pd := NewGoneError("share link has expired")
+
+	if pd.Status != http.StatusGone {
+		t.Errorf("expected status %d, got %d", http.StatusGone, pd.Status)
+	}
+	if pd.Title != "Gone" {
+		t.Errorf("expected title 'Gone', got %q", pd.Title)
+	}
+	if pd.Detail != "share link has expired" {
+		t.Errorf("expected detail 'share link has expired', got %q", pd.Detail)
+	}
+	if pd.Code != ErrCodeNotFound {
+		t.Errorf("expected code %d, got %d", ErrCodeNotFound, pd.Code)
+	}
+}
+
 // ============================================================================
 // Constructor Tests - NewInternalError
 // ============================================================================
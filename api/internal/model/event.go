@@ -11,6 +11,7 @@ type Event struct {
 	Title            string         `json:"title"`
 	Description      *string        `json:"description,omitempty"`
 	Location         *EventLocation `json:"location,omitempty"`
+	VenueID          *string        `json:"venue_id,omitempty"` // Saved venue the location was copied from
 	StartTime        time.Time      `json:"start_time"`
 	EndTime          *time.Time     `json:"end_time,omitempty"`
 	// Event configuration
@@ -21,6 +22,18 @@ type Event struct {
 	RequiresApproval bool   `json:"requires_approval"` // Host must approve all RSVPs
 	AllowPlusOnes    bool   `json:"allow_plus_ones"`   // Guests can bring +1
 	MaxPlusOnes      int    `json:"max_plus_ones"`     // Per guest (default 1)
+	Ticketed         bool   `json:"ticketed"`          // Seats are tickets; guests hold one to RSVP
+	// Paid tickets
+	TicketPrice  int    `json:"ticket_price,omitempty"`  // In Currency's minor unit; 0 = free
+	Currency     string `json:"currency,omitempty"`      // usd, eur, gbp, cad, aud
+	RefundPolicy string `json:"refund_policy,omitempty"` // full, day_before, none
+	// Guild event discounts applied at checkout
+	MemberDiscounts []MemberDiscount `json:"member_discounts,omitempty"`
+	// Guild tier perks
+	MinTier     *string    `json:"min_tier,omitempty"`      // Guild tier needed to RSVP; higher tiers qualify too
+	RSVPOpensAt *time.Time `json:"rsvp_opens_at,omitempty"` // Tiers with early access can RSVP sooner
+	// Who else can RSVP before rsvp_opens_at
+	RSVPEarlyAccess []RSVPEarlyAccess `json:"rsvp_early_access,omitempty"`
 	// Styling
 	CoverImage *string `json:"cover_image,omitempty"`
 	ThemeColor *string `json:"theme_color,omitempty"`
@@ -45,10 +58,12 @@ type Event struct {
 	AttendeeCount int `json:"attendee_count"`
 
 	// Status
-	Status    string    `json:"status"` // draft, published, cancelled, completed
-	CreatedBy string    `json:"created_by"`
-	CreatedOn time.Time `json:"created_on"`
-	UpdatedOn time.Time `json:"updated_on"`
+	Status    string     `json:"status"`               // draft, scheduled, published, cancelled, completed
+	PublishAt *time.Time `json:"publish_at,omitempty"` // When a scheduled event is published
+	Version   int        `json:"version"`              // Incremented on every update
+	CreatedBy string     `json:"created_by"`
+	CreatedOn time.Time  `json:"created_on"`
+	UpdatedOn time.Time  `json:"updated_on"`
 }
 
 // EventLocation represents where an event takes place
@@ -63,6 +78,8 @@ type EventLocation struct {
 	// Virtual event
 	IsVirtual bool    `json:"is_virtual"`
 	MeetLink  *string `json:"meet_link,omitempty"` // Only shown to confirmed attendees
+	// Venue features from AccessibilityFeatures
+	Accessibility []string `json:"accessibility,omitempty"`
 }
 
 // EventTemplate constants
@@ -76,6 +93,13 @@ const (
 	EventTemplateTrip        = "trip"         // Travel/outing
 )
 
+// EventTemplates lists every event template, which doubles as the event's
+// category
+var EventTemplates = []string{
+	EventTemplateCasual, EventTemplateDinnerParty, EventTemplateActivity, EventTemplateBirthday,
+	EventTemplateSupport, EventTemplateWorkshop, EventTemplateTrip,
+}
+
 // EventVisibility constants
 const (
 	EventVisibilityPublic     = "public"      // Anyone can discover
@@ -87,11 +111,23 @@ const (
 // EventStatus constants
 const (
 	EventStatusDraft     = "draft"
+	EventStatusScheduled = "scheduled" // Published automatically at publish_at
 	EventStatusPublished = "published"
 	EventStatusCancelled = "cancelled"
 	EventStatusCompleted = "completed"
 )
 
+// EventListFields is what guild event lists can be sorted and filtered by
+var EventListFields = ListFields{
+	Sort: []string{"start_time", "created_on", "title", "attendee_count"},
+	Filter: map[string][]string{
+		"status":     {EventStatusPublished, EventStatusCompleted},
+		"template":   EventTemplates,
+		"visibility": {EventVisibilityPublic, EventVisibilityGuilds, EventVisibilityInviteOnly, EventVisibilityPrivate},
+		"city":       nil,
+	},
+}
+
 // Confirmation deadline constants
 const (
 	// ConfirmationDeadlineHours is how long after event end users have to confirm
@@ -117,6 +153,11 @@ func (e *Event) IsCompletionVerifiable() bool {
 	return e.ConfirmedCount >= MinConfirmationsForGroup
 }
 
+// IsUnpublished reports whether the event is still hidden from everyone but its hosts
+func (e *Event) IsUnpublished() bool {
+	return e.Status == EventStatusDraft || e.Status == EventStatusScheduled
+}
+
 // IsWithinConfirmationDeadline checks if the event can still accept confirmations
 func (e *Event) IsWithinConfirmationDeadline() bool {
 	if e.ConfirmationDeadline == nil {
@@ -125,6 +166,18 @@ func (e *Event) IsWithinConfirmationDeadline() bool {
 	return time.Now().Before(*e.ConfirmationDeadline)
 }
 
+// DefaultEventLength is how long an event without an end time is assumed to run
+const DefaultEventLength = 2 * time.Hour
+
+// EndOrDefault returns the event's end time, or DefaultEventLength after it
+// starts if it has none
+func (e *Event) EndOrDefault() time.Time {
+	if e.EndTime != nil {
+		return *e.EndTime
+	}
+	return e.StartTime.Add(DefaultEventLength)
+}
+
 // EventRSVP represents a user's response to an event
 type EventRSVP struct {
 	ID       string `json:"id"`
@@ -137,7 +190,8 @@ type EventRSVP struct {
 	AlignmentScore float64 `json:"-"`
 	YikesCount     int     `json:"-"`
 	// Waiting room info (only for pending)
-	WaitingReason *string `json:"waiting_reason,omitempty"` // "values_review", "capacity", "host_approval"
+	WaitingReason *string `json:"waiting_reason,omitempty"` // "values_review", "capacity", "host_approval", "payment"
+	PaymentStatus *string `json:"payment_status,omitempty"` // Paid events: pending, paid, refunded
 	// Host response
 	HostNote    *string    `json:"host_note,omitempty"`    // Private message to RSVP'er
 	RespondedBy *string    `json:"responded_by,omitempty"` // Host who responded
@@ -153,6 +207,8 @@ type EventRSVP struct {
 	CheckinTime         *time.Time `json:"checkin_time,omitempty"`
 	HelpfulnessRating   *string    `json:"helpfulness_rating,omitempty"` // YES, SOMEWHAT, NOT_REALLY, SKIP
 	HelpfulnessTags     []string   `json:"helpfulness_tags,omitempty"`
+	// Attendance reliability, populated for hosts reviewing pending RSVPs
+	NoShowStats *NoShowStats `json:"no_show_stats,omitempty"`
 }
 
 // RSVPStatus constants
@@ -177,6 +233,7 @@ const (
 	WaitingReasonCapacity     = "capacity"      // Event at capacity
 	WaitingReasonHostApproval = "host_approval" // Host must approve all RSVPs
 	WaitingReasonYikes        = "yikes"         // Yikes flags triggered
+	WaitingReasonPayment      = "payment"       // Paid event awaiting checkout
 )
 
 // EventHost represents a host/organizer of an event
@@ -225,6 +282,11 @@ type EventWithDetails struct {
 	WaitlistCount  int                  `json:"waitlist_count"`
 	UserRSVP       *EventRSVP           `json:"user_rsvp,omitempty"` // Current user's RSVP
 	UserRole       *EventRoleAssignment `json:"user_role,omitempty"`
+	UserTicket     *EventTicket         `json:"user_ticket,omitempty"`  // Current user's active ticket
+	TicketsLeft    *int                 `json:"tickets_left,omitempty"` // Ticketed events only
+	Forecast       *AttendanceForecast  `json:"forecast,omitempty"`     // Hosts only
+	Reactions      *ReactionSummary     `json:"reactions,omitempty"`
+	Carpool        *CarpoolSummary      `json:"carpool,omitempty"`
 }
 
 // EventSummary provides minimal event info for lists
@@ -256,6 +318,7 @@ type CreateEventRequest struct {
 	Title              string         `json:"title"`
 	Description        *string        `json:"description,omitempty"`
 	Location           *EventLocation `json:"location,omitempty"`
+	VenueID            *string        `json:"venue_id,omitempty"` // Use a saved guild venue instead of location
 	StartTime          time.Time      `json:"start_time"`
 	EndTime            *time.Time     `json:"end_time,omitempty"`
 	Template           string         `json:"template"`
@@ -272,6 +335,48 @@ type CreateEventRequest struct {
 	AutoApproveAligned bool           `json:"auto_approve_aligned"`
 	YikesThreshold     int            `json:"yikes_threshold"`
 	IsSupportEvent     bool           `json:"is_support_event"`
+	PublishAt          *time.Time     `json:"publish_at,omitempty"` // Schedule publication instead of publishing now
+	Ticketed           bool           `json:"ticketed,omitempty"`
+	TicketPrice        int            `json:"ticket_price,omitempty"`
+	Currency           string         `json:"currency,omitempty"`
+	RefundPolicy       string         `json:"refund_policy,omitempty"`
+	MinTier            *string        `json:"min_tier,omitempty"`
+	RSVPOpensAt        *time.Time     `json:"rsvp_opens_at,omitempty"`
+	ConfirmDuplicate   bool           `json:"confirm_duplicate,omitempty"` // Create it even if it looks like another of the guild's events
+}
+
+// Validate validates the create event request
+func (r *CreateEventRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("title", r.Title).Required()
+	v.Time("start_time", r.StartTime).Required()
+	if r.Location != nil {
+		v.Check("location.accessibility", ValidAccessibility(r.Location.Accessibility), InvalidAccessibilityMessage)
+	}
+	if r.VenueID != nil {
+		v.Check("venue_id", r.Location == nil, venueOrLocation)
+		v.Check("venue_id", r.GuildID != nil, "venue_id is only for guild events")
+	}
+	if r.Ticketed && r.MaxAttendees == nil {
+		v.Fail("max_attendees", ticketedNeedsCapacity)
+	}
+	v.Int("ticket_price", r.TicketPrice).Min(0)
+	if r.TicketPrice > 0 {
+		v.Check("ticketed", r.Ticketed, paidNeedsTickets)
+		v.String("currency", r.Currency).Required().OneOf(Currencies...)
+	}
+	if r.RefundPolicy != "" {
+		v.String("refund_policy", r.RefundPolicy).OneOf(RefundPolicies...)
+	}
+	if r.MinTier != nil {
+		v.Check("min_tier", r.GuildID != nil, tierNeedsGuild)
+	}
+	if r.RSVPOpensAt != nil && !r.StartTime.IsZero() {
+		v.Check("rsvp_opens_at", r.RSVPOpensAt.Before(r.StartTime), "rsvp_opens_at must be before start_time")
+	}
+
+	return v.Errors()
 }
 
 // UpdateEventRequest represents a request to update an event
@@ -279,6 +384,7 @@ type UpdateEventRequest struct {
 	Title              *string        `json:"title,omitempty"`
 	Description        *string        `json:"description,omitempty"`
 	Location           *EventLocation `json:"location,omitempty"`
+	VenueID            *string        `json:"venue_id,omitempty"` // Move to a saved guild venue
 	StartTime          *time.Time     `json:"start_time,omitempty"`
 	EndTime            *time.Time     `json:"end_time,omitempty"`
 	MaxAttendees       *int           `json:"max_attendees,omitempty"`
@@ -293,6 +399,15 @@ type UpdateEventRequest struct {
 	AutoApproveAligned *bool          `json:"auto_approve_aligned,omitempty"`
 	YikesThreshold     *int           `json:"yikes_threshold,omitempty"`
 	Status             *string        `json:"status,omitempty"`
+	Ticketed           *bool          `json:"ticketed,omitempty"`
+	TicketPrice        *int           `json:"ticket_price,omitempty"`
+	Currency           *string        `json:"currency,omitempty"`
+	RefundPolicy       *string        `json:"refund_policy,omitempty"`
+	MinTier            *string        `json:"min_tier,omitempty"`
+	RSVPOpensAt        *time.Time     `json:"rsvp_opens_at,omitempty"`
+	Version            *int           `json:"version,omitempty"` // Version the edit is based on; rejected if stale
+
+	Clear ClearedFields `json:"-"` // Fields the PATCH set to null
 }
 
 // RSVPRequest represents a request to RSVP to an event
@@ -300,9 +415,79 @@ type RSVPRequest struct {
 	RSVPType     string   `json:"rsvp_type"` // going, maybe, not_going
 	PlusOnes     int      `json:"plus_ones,omitempty"`
 	PlusOneNames []string `json:"plus_one_names,omitempty"`
-	Note         *string  `json:"note,omitempty"` // Message to host
+	Note         *string  `json:"note,omitempty"`  // Message to host
+	Force        bool     `json:"force,omitempty"` // RSVP despite schedule conflicts
 }
 
+// Validate checks the rules that don't depend on the event being changed
+func (r *UpdateEventRequest) Validate() []FieldError {
+	var v Validator
+
+	if r.Location != nil {
+		v.Check("location.accessibility", ValidAccessibility(r.Location.Accessibility), InvalidAccessibilityMessage)
+	}
+	if r.VenueID != nil {
+		v.Check("venue_id", r.Location == nil, venueOrLocation)
+	}
+
+	return v.Errors()
+}
+
+// ValidateFor checks the update against the event it changes, for rules
+// that depend on fields the update leaves alone
+func (r *UpdateEventRequest) ValidateFor(current *Event) []FieldError {
+	var v Validator
+
+	ticketed := current.Ticketed
+	if r.Ticketed != nil {
+		ticketed = *r.Ticketed
+	}
+	hasCapacity := (current.MaxAttendees != nil || r.MaxAttendees != nil) && !r.Clear.Has("max_attendees")
+	if ticketed && !hasCapacity {
+		v.Fail("max_attendees", ticketedNeedsCapacity)
+	}
+
+	price, currency := current.TicketPrice, current.Currency
+	if r.TicketPrice != nil {
+		price = *r.TicketPrice
+	}
+	if r.Currency != nil {
+		currency = *r.Currency
+	}
+	v.Int("ticket_price", price).Min(0)
+	if price > 0 {
+		v.Check("ticketed", ticketed, paidNeedsTickets)
+		v.String("currency", currency).Required().OneOf(Currencies...)
+	}
+	v.OptionalString("refund_policy", r.RefundPolicy).OneOf(RefundPolicies...)
+	if r.MinTier != nil {
+		v.Check("min_tier", current.GuildID != nil, tierNeedsGuild)
+	}
+	if r.RSVPOpensAt != nil {
+		start := current.StartTime
+		if r.StartTime != nil {
+			start = *r.StartTime
+		}
+		v.Check("rsvp_opens_at", r.RSVPOpensAt.Before(start), "rsvp_opens_at must be before start_time")
+	}
+
+	return v.Errors()
+}
+
+// venueOrLocation is why an event can't take both: the venue sets the
+// location
+const venueOrLocation = "give either venue_id or location, not both"
+
+// tierNeedsGuild is why only guild events can be gated on a tier
+const tierNeedsGuild = "min_tier is only for guild events"
+
+// ticketedNeedsCapacity is why a ticketed event can't be unlimited
+const ticketedNeedsCapacity = "ticketed events need max_attendees"
+
+// paidNeedsTickets is why a paid event must be ticketed: the ticket hold
+// is what keeps a seat while the guest pays
+const paidNeedsTickets = "paid events must be ticketed"
+
 // RespondToRSVPRequest represents host's response to an RSVP
 type RespondToRSVPRequest struct {
 	Approved bool    `json:"approved"`
@@ -335,4 +520,9 @@ type EventSearchFilters struct {
 	City        *string    `json:"city,omitempty"`
 	Visibility  *string    `json:"visibility,omitempty"`
 	HostID      *string    `json:"host_id,omitempty"`
+	// Accessibility features the venue must offer, all of them
+	Accessibility []string `json:"accessibility,omitempty"`
+	// Also require the searcher's own accessibility needs, looked up
+	// server-side so they aren't sent with the search
+	MatchNeeds bool `json:"match_needs,omitempty"`
 }
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// EventReminderPreference holds a user's overrides for event reminders.
+// A user without a stored preference receives the server default offsets.
+type EventReminderPreference struct {
+	ID             string    `json:"id,omitempty"`
+	UserID         string    `json:"user_id"`
+	Enabled        bool      `json:"enabled"`
+	OffsetsMinutes []int     `json:"offsets_minutes"` // Empty means use server defaults
+	CreatedOn      time.Time `json:"created_on"`
+	UpdatedOn      time.Time `json:"updated_on"`
+}
+
+// Offsets returns the preference offsets as durations
+func (p *EventReminderPreference) Offsets() []time.Duration {
+	offsets := make([]time.Duration, 0, len(p.OffsetsMinutes))
+	for _, m := range p.OffsetsMinutes {
+		offsets = append(offsets, time.Duration(m)*time.Minute)
+	}
+	return offsets
+}
+
+// EventReminderSent records that a reminder was delivered for a user, event and offset.
+// The (user_id, event_id, offset_minutes) tuple is unique so each reminder is sent once.
+type EventReminderSent struct {
+	ID            string    `json:"id,omitempty"`
+	UserID        string    `json:"user_id"`
+	EventID       string    `json:"event_id"`
+	OffsetMinutes int       `json:"offset_minutes"`
+	SentOn        time.Time `json:"sent_on"`
+}
+
+// EventReminderCandidate is an approved RSVP for an upcoming event
+type EventReminderCandidate struct {
+	EventID   string    `json:"event_id"`
+	UserID    string    `json:"user_id"`
+	Title     string    `json:"title"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// UpdateEventReminderPreferenceRequest represents a request to update reminder preferences
+type UpdateEventReminderPreferenceRequest struct {
+	Enabled        *bool `json:"enabled,omitempty"`
+	OffsetsMinutes []int `json:"offsets_minutes,omitempty"`
+}
+
+// Validate validates the update event reminder preference request
+func (r *UpdateEventReminderPreferenceRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("offsets_minutes", len(r.OffsetsMinutes) <= MaxEventReminderOffsets,
+		fmt.Sprintf("maximum %d reminder offsets allowed", MaxEventReminderOffsets))
+	for _, m := range r.OffsetsMinutes {
+		v.Check("offsets_minutes", m >= MinEventReminderOffsetMinutes && m <= MaxEventReminderOffsetMinutes,
+			fmt.Sprintf("offsets must be between %d and %d minutes", MinEventReminderOffsetMinutes, MaxEventReminderOffsetMinutes))
+	}
+
+	return v.Errors()
+}
+
+// Business constraints for event reminders
+const (
+	MaxEventReminderOffsets       = 5
+	MinEventReminderOffsetMinutes = 5
+	MaxEventReminderOffsetMinutes = 7 * 24 * 60 // One week
+)
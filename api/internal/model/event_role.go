@@ -4,17 +4,19 @@ import "time"
 
 // EventRole represents a role that attendees can fill at an event
 type EventRole struct {
-	ID          string    `json:"id"`
-	EventID     string    `json:"event_id"`
-	Name        string    `json:"name"`                  // e.g., "Dessert-bringer", "DJ", "Guest"
-	Description *string   `json:"description,omitempty"` // e.g., "Bring something sweet to share"
-	MaxSlots    int       `json:"max_slots"`             // Default 1, 0 = unlimited (for default Guest role)
-	FilledSlots int       `json:"filled_slots"`          // Computed from assignments
-	IsDefault   bool      `json:"is_default"`            // True for the default "Guest" role
-	SortOrder   int       `json:"sort_order"`            // Display ordering
-	CreatedBy   string    `json:"created_by"`            // Host who created this role
-	CreatedOn   time.Time `json:"created_on"`
-	UpdatedOn   time.Time `json:"updated_on"`
+	ID             string    `json:"id"`
+	EventID        string    `json:"event_id"`
+	Name           string    `json:"name"`                  // e.g., "Dessert-bringer", "DJ", "Guest"
+	Description    *string   `json:"description,omitempty"` // e.g., "Bring something sweet to share"
+	MaxSlots       int       `json:"max_slots"`             // Default 1, 0 = unlimited (for default Guest role)
+	FilledSlots    int       `json:"filled_slots"`          // Computed from assignments
+	IsDefault      bool      `json:"is_default"`            // True for the default "Guest" role
+	SortOrder      int       `json:"sort_order"`            // Display ordering
+	AssignmentMode string    `json:"assignment_mode"`       // direct or approval (hosts approve nominations)
+	IsCritical     bool      `json:"is_critical"`           // Hosts are alerted while it is unfilled close to the event
+	CreatedBy      string    `json:"created_by"`            // Host who created this role
+	CreatedOn      time.Time `json:"created_on"`
+	UpdatedOn      time.Time `json:"updated_on"`
 	// Optional: suggest this role to users with matching interests
 	SuggestedInterests []string `json:"suggested_interests,omitempty"`
 }
@@ -22,6 +24,17 @@ type EventRole struct {
 // DefaultMaxSlotsPerRole is the default number of slots for a role (1 person per role)
 const DefaultMaxSlotsPerRole = 1
 
+// EventRole assignment modes
+const (
+	RoleAssignmentModeDirect   = "direct"   // Members assign themselves, first come first served
+	RoleAssignmentModeApproval = "approval" // Members nominate themselves, a host approves or declines
+)
+
+// IsValidRoleAssignmentMode reports whether mode is a known assignment mode
+func IsValidRoleAssignmentMode(mode string) bool {
+	return mode == RoleAssignmentModeDirect || mode == RoleAssignmentModeApproval
+}
+
 // EventRoleAssignment represents a user's assignment to a role
 // A user can have multiple role assignments per event (e.g., DJ + bring lasagna + wash dishes)
 type EventRoleAssignment struct {
@@ -30,9 +43,13 @@ type EventRoleAssignment struct {
 	RoleID     string    `json:"role_id"`
 	UserID     string    `json:"user_id"`
 	Note       *string   `json:"note,omitempty"` // User's note about their contribution
-	Status     string    `json:"status"`         // pending, confirmed, cancelled
+	Status     string    `json:"status"`         // pending, confirmed, declined, cancelled
 	AssignedOn time.Time `json:"assigned_on"`
 	UpdatedOn  time.Time `json:"updated_on"`
+	// Set when a host approves or declines a nomination
+	ReviewedBy *string    `json:"reviewed_by,omitempty"`
+	ReviewedOn *time.Time `json:"reviewed_on,omitempty"`
+	Response   *string    `json:"response,omitempty"` // Host's message to the nominee
 	// Populated by joins
 	RoleName *string `json:"role_name,omitempty"`
 }
@@ -48,9 +65,15 @@ type UserEventRoles struct {
 const (
 	RoleAssignmentStatusPending   = "pending"   // User expressed interest, awaiting confirmation
 	RoleAssignmentStatusConfirmed = "confirmed" // User is confirmed in this role
+	RoleAssignmentStatusDeclined  = "declined"  // Host declined the user's nomination
 	RoleAssignmentStatusCancelled = "cancelled" // User cancelled their assignment
 )
 
+// IsActiveRoleAssignmentStatus reports whether an assignment holds or awaits a slot
+func IsActiveRoleAssignmentStatus(status string) bool {
+	return status == RoleAssignmentStatusPending || status == RoleAssignmentStatusConfirmed
+}
+
 // Note: EventAttendanceConfig fields are now part of Event struct in event.go
 
 // EventRoleWithAssignments includes the role and its current assignments
@@ -92,6 +115,8 @@ type CreateEventRoleRequest struct {
 	Description        *string  `json:"description,omitempty"`
 	MaxSlots           int      `json:"max_slots,omitempty"` // 0 = unlimited
 	SuggestedInterests []string `json:"suggested_interests,omitempty"`
+	AssignmentMode     string   `json:"assignment_mode,omitempty"` // direct (default) or approval
+	IsCritical         bool     `json:"is_critical,omitempty"`
 }
 
 // UpdateEventRoleRequest represents a request to update a role
@@ -100,6 +125,8 @@ type UpdateEventRoleRequest struct {
 	Description        *string  `json:"description,omitempty"`
 	MaxSlots           *int     `json:"max_slots,omitempty"`
 	SuggestedInterests []string `json:"suggested_interests,omitempty"`
+	AssignmentMode     *string  `json:"assignment_mode,omitempty"`
+	IsCritical         *bool    `json:"is_critical,omitempty"`
 }
 
 // AssignRoleRequest represents a request to assign oneself to a role
@@ -108,6 +135,30 @@ type AssignRoleRequest struct {
 	Note   *string `json:"note,omitempty"` // e.g., "I'll bring vegan brownies"
 }
 
+// NominateRoleRequest represents a member nominating themselves for an approval-mode role
+type NominateRoleRequest struct {
+	Note *string `json:"note,omitempty"` // e.g., "I've DJ'd three house parties"
+}
+
+// Validate validates the nominate role request
+func (r *NominateRoleRequest) Validate() []FieldError {
+	var v Validator
+	v.OptionalString("note", r.Note).MaxLength(MaxAssignmentNoteLen)
+	return v.Errors()
+}
+
+// ReviewNominationRequest represents a host approving or declining a nomination
+type ReviewNominationRequest struct {
+	Response *string `json:"response,omitempty"` // Optional message to the nominee
+}
+
+// Validate validates the review nomination request
+func (r *ReviewNominationRequest) Validate() []FieldError {
+	var v Validator
+	v.OptionalString("response", r.Response).MaxLength(MaxAssignmentNoteLen)
+	return v.Errors()
+}
+
 // UpdateAssignmentRequest represents a request to update an assignment
 type UpdateAssignmentRequest struct {
 	Note *string `json:"note,omitempty"`
@@ -119,3 +170,43 @@ type RoleSuggestion struct {
 	MatchedInterest string    `json:"matched_interest"`
 	Reason          string    `json:"reason"` // e.g., "You're interested in baking"
 }
+
+// Role alert kinds, recorded so each alert is delivered once
+const (
+	RoleAlertKindGap      = "gap"      // Host alert: a critical role is still unfilled
+	RoleAlertKindAssignee = "assignee" // Assignee reminder the day before the event
+)
+
+// RoleGapCandidate is a critical role at an upcoming event with its confirmed count
+type RoleGapCandidate struct {
+	EventID     string    `json:"event_id"`
+	EventTitle  string    `json:"event_title"`
+	StartTime   time.Time `json:"start_time"`
+	RoleID      string    `json:"role_id"`
+	RoleName    string    `json:"role_name"`
+	MaxSlots    int       `json:"max_slots"`
+	FilledSlots int       `json:"filled_slots"`
+}
+
+// OpenSlots returns how many more confirmed assignees the role needs.
+// Unlimited roles (max_slots 0) need at least one.
+func (c *RoleGapCandidate) OpenSlots() int {
+	needed := c.MaxSlots
+	if needed <= 0 {
+		needed = 1
+	}
+	if c.FilledSlots >= needed {
+		return 0
+	}
+	return needed - c.FilledSlots
+}
+
+// RoleAssigneeCandidate is a confirmed role assignment at an upcoming event
+type RoleAssigneeCandidate struct {
+	EventID    string    `json:"event_id"`
+	EventTitle string    `json:"event_title"`
+	StartTime  time.Time `json:"start_time"`
+	RoleID     string    `json:"role_id"`
+	RoleName   string    `json:"role_name"`
+	UserID     string    `json:"user_id"`
+}
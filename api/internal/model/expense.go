@@ -0,0 +1,217 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// Expenses are shared costs: one participant pays and the cost is split
+// among everyone taking part, weighted by headcount. Settlements record
+// money changing hands between participants afterwards. Both belong to a
+// scope (an event or an adventure) so the same ledger serves either.
+
+// Expense constraints
+const (
+	MaxExpensesPerScope      = 100
+	MaxExpenseDescriptionLen = 200
+	MaxExpenseAmount         = 10_000_000 // In the currency's minor unit
+)
+
+// Expense is a cost one participant paid for everyone
+type Expense struct {
+	ID          string    `json:"id"`
+	ScopeID     string    `json:"scope_id"` // The event or adventure
+	PaidBy      string    `json:"paid_by"`
+	Description string    `json:"description"`
+	Amount      int       `json:"amount"` // In the currency's minor unit
+	Currency    string    `json:"currency"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedOn   time.Time `json:"created_on"`
+}
+
+// CreateExpenseRequest represents a request to record an expense
+type CreateExpenseRequest struct {
+	Description string  `json:"description"`
+	Amount      int     `json:"amount"`
+	Currency    string  `json:"currency"`
+	PaidBy      *string `json:"paid_by,omitempty"` // Defaults to whoever records it
+}
+
+// Validate validates the create expense request
+func (r *CreateExpenseRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("description", r.Description).Required().MaxLength(MaxExpenseDescriptionLen)
+	v.Int("amount", r.Amount).Between(1, MaxExpenseAmount)
+	v.String("currency", r.Currency).Required().OneOf(Currencies...)
+	v.OptionalString("paid_by", r.PaidBy).NotEmpty()
+
+	return v.Errors()
+}
+
+// ExpenseSettlement records that one participant paid another back
+type ExpenseSettlement struct {
+	ID        string    `json:"id"`
+	ScopeID   string    `json:"scope_id"`
+	FromUser  string    `json:"from_user_id"`
+	ToUser    string    `json:"to_user_id"`
+	Amount    int       `json:"amount"`
+	Currency  string    `json:"currency"`
+	MarkedBy  string    `json:"marked_by"` // Either party, or a host
+	SettledOn time.Time `json:"settled_on"`
+}
+
+// SettleExpensesRequest represents a request to mark what one participant
+// owes another as paid
+type SettleExpensesRequest struct {
+	FromUser string `json:"from_user_id"`
+	ToUser   string `json:"to_user_id"`
+}
+
+// Validate validates the settle expenses request
+func (r *SettleExpensesRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("from_user_id", r.FromUser).Required()
+	v.String("to_user_id", r.ToUser).Required()
+	v.Check("to_user_id", r.FromUser != r.ToUser, "to_user_id must differ from from_user_id")
+
+	return v.Errors()
+}
+
+// ExpenseParticipant is someone costs are split among. Headcount is the
+// people they bring, themselves included, and weights their share.
+type ExpenseParticipant struct {
+	UserID    string
+	Headcount int
+}
+
+// ExpenseLedger is who paid what, who owes what, and the transfers that
+// would settle everyone up
+type ExpenseLedger struct {
+	Currency       string            `json:"currency,omitempty"`
+	Total          int               `json:"total"`
+	Headcount      int               `json:"headcount"`
+	PerPersonShare int               `json:"per_person_share"` // Total over headcount, rounded down
+	Balances       []ExpenseBalance  `json:"balances"`
+	Transfers      []ExpenseTransfer `json:"transfers"` // Outstanding
+}
+
+// ExpenseBalance is one participant's position in the ledger. Balance is
+// what they are owed; negative when they owe.
+type ExpenseBalance struct {
+	UserID    string `json:"user_id"`
+	Headcount int    `json:"headcount"`
+	Paid      int    `json:"paid"`
+	Share     int    `json:"share"`
+	Settled   int    `json:"settled"` // Paid back to others, less received
+	Balance   int    `json:"balance"`
+}
+
+// ExpenseTransfer is money one participant still owes another
+type ExpenseTransfer struct {
+	FromUser string `json:"from_user_id"`
+	ToUser   string `json:"to_user_id"`
+	Amount   int    `json:"amount"`
+}
+
+// Transfer returns the outstanding transfer from one participant to
+// another, or nil if there is none
+func (l *ExpenseLedger) Transfer(from, to string) *ExpenseTransfer {
+	for i := range l.Transfers {
+		if l.Transfers[i].FromUser == from && l.Transfers[i].ToUser == to {
+			return &l.Transfers[i]
+		}
+	}
+	return nil
+}
+
+// BuildExpenseLedger splits expenses among participants and nets out the
+// settlements. Shares are in whole minor units: the remainder goes a unit
+// at a time to participants in user ID order, so shares always add up to
+// the total. Payers who are no longer participants are still paid back.
+// The transfers pair the largest debts with the largest credits, which
+// settles everyone in at most one fewer transfer than there are balances.
+func BuildExpenseLedger(participants []ExpenseParticipant, expenses []*Expense, settlements []*ExpenseSettlement) *ExpenseLedger {
+	ledger := &ExpenseLedger{Balances: []ExpenseBalance{}, Transfers: []ExpenseTransfer{}}
+
+	balances := map[string]*ExpenseBalance{}
+	balance := func(userID string) *ExpenseBalance {
+		b, ok := balances[userID]
+		if !ok {
+			b = &ExpenseBalance{UserID: userID}
+			balances[userID] = b
+		}
+		return b
+	}
+
+	for _, p := range participants {
+		headcount := max(p.Headcount, 1)
+		balance(p.UserID).Headcount += headcount
+		ledger.Headcount += headcount
+	}
+	for _, e := range expenses {
+		ledger.Currency = e.Currency
+		ledger.Total += e.Amount
+		balance(e.PaidBy).Paid += e.Amount
+	}
+	for _, s := range settlements {
+		balance(s.FromUser).Settled += s.Amount
+		balance(s.ToUser).Settled -= s.Amount
+	}
+
+	userIDs := make([]string, 0, len(balances))
+	for userID := range balances {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+
+	if ledger.Headcount > 0 {
+		ledger.PerPersonShare = ledger.Total / ledger.Headcount
+		remainder := ledger.Total % ledger.Headcount
+		for _, userID := range userIDs {
+			b := balances[userID]
+			b.Share = ledger.PerPersonShare * b.Headcount
+			extra := min(remainder, b.Headcount)
+			b.Share += extra
+			remainder -= extra
+		}
+	}
+
+	var debtors, creditors []*ExpenseBalance
+	for _, userID := range userIDs {
+		b := balances[userID]
+		b.Balance = b.Paid - b.Share + b.Settled
+		ledger.Balances = append(ledger.Balances, *b)
+		switch {
+		case b.Balance < 0:
+			debtors = append(debtors, &ExpenseBalance{UserID: userID, Balance: -b.Balance})
+		case b.Balance > 0:
+			creditors = append(creditors, &ExpenseBalance{UserID: userID, Balance: b.Balance})
+		}
+	}
+
+	// Largest first, ties by user ID; both lists are already in ID order
+	largest := func(list []*ExpenseBalance) {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Balance > list[j].Balance })
+	}
+	largest(debtors)
+	largest(creditors)
+	for len(debtors) > 0 && len(creditors) > 0 {
+		debtor, creditor := debtors[0], creditors[0]
+		amount := min(debtor.Balance, creditor.Balance)
+		ledger.Transfers = append(ledger.Transfers, ExpenseTransfer{FromUser: debtor.UserID, ToUser: creditor.UserID, Amount: amount})
+		debtor.Balance -= amount
+		creditor.Balance -= amount
+		if debtor.Balance == 0 {
+			debtors = debtors[1:]
+		}
+		if creditor.Balance == 0 {
+			creditors = creditors[1:]
+		}
+		largest(debtors)
+		largest(creditors)
+	}
+
+	return ledger
+}
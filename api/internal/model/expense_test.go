@@ -0,0 +1,104 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+// ============================================================================
+// Expense Ledger Tests
+// ============================================================================
+
+func TestBuildExpenseLedger(t *testing.T) {
+	t.Parallel()
+
+	participants := []ExpenseParticipant{
+		{UserID: "user:a", Headcount: 1},
+		{UserID: "user:b", Headcount: 2}, // Brings a plus-one
+		{UserID: "user:c", Headcount: 1},
+	}
+	expenses := []*Expense{
+		{PaidBy: "user:a", Amount: 1000, Currency: "usd"},
+		{PaidBy: "user:c", Amount: 1001, Currency: "usd"},
+	}
+
+	ledger := BuildExpenseLedger(participants, expenses, nil)
+	if ledger.Total != 2001 || ledger.Headcount != 4 || ledger.PerPersonShare != 500 || ledger.Currency != "usd" {
+		t.Fatalf("ledger = %+v, want 2001 over 4 people", ledger)
+	}
+
+	// The unit left over goes to user:a, first by ID
+	wantShares := map[string]int{"user:a": 501, "user:b": 1000, "user:c": 500}
+	for _, b := range ledger.Balances {
+		if b.Share != wantShares[b.UserID] {
+			t.Errorf("%s share = %d, want %d", b.UserID, b.Share, wantShares[b.UserID])
+		}
+	}
+
+	want := []ExpenseTransfer{
+		{FromUser: "user:b", ToUser: "user:c", Amount: 501},
+		{FromUser: "user:b", ToUser: "user:a", Amount: 499},
+	}
+	if !reflect.DeepEqual(ledger.Transfers, want) {
+		t.Errorf("transfers = %+v, want %+v", ledger.Transfers, want)
+	}
+}
+
+func TestBuildExpenseLedger_Settlements(t *testing.T) {
+	t.Parallel()
+
+	participants := []ExpenseParticipant{{UserID: "user:a", Headcount: 1}, {UserID: "user:b", Headcount: 1}}
+	expenses := []*Expense{{PaidBy: "user:a", Amount: 900, Currency: "eur"}}
+
+	partly := BuildExpenseLedger(participants, expenses, []*ExpenseSettlement{{FromUser: "user:b", ToUser: "user:a", Amount: 200}})
+	if tr := partly.Transfer("user:b", "user:a"); tr == nil || tr.Amount != 250 {
+		t.Errorf("after a partial settlement: transfer = %+v, want 250 outstanding", tr)
+	}
+
+	settled := BuildExpenseLedger(participants, expenses, []*ExpenseSettlement{{FromUser: "user:b", ToUser: "user:a", Amount: 450}})
+	if len(settled.Transfers) != 0 {
+		t.Errorf("after settling up: transfers = %+v, want none", settled.Transfers)
+	}
+	for _, b := range settled.Balances {
+		if b.Balance != 0 {
+			t.Errorf("%s balance = %d, want 0", b.UserID, b.Balance)
+		}
+	}
+}
+
+func TestBuildExpenseLedger_PayerWhoLeft(t *testing.T) {
+	t.Parallel()
+
+	// user:gone paid before leaving and is owed the whole amount
+	ledger := BuildExpenseLedger(
+		[]ExpenseParticipant{{UserID: "user:a", Headcount: 1}},
+		[]*Expense{{PaidBy: "user:gone", Amount: 300, Currency: "usd"}},
+		nil,
+	)
+	if tr := ledger.Transfer("user:a", "user:gone"); tr == nil || tr.Amount != 300 {
+		t.Errorf("transfer = %+v, want user:a to pay back 300", tr)
+	}
+
+	if empty := BuildExpenseLedger(nil, nil, nil); empty.Total != 0 || len(empty.Transfers) != 0 {
+		t.Errorf("empty ledger = %+v", empty)
+	}
+}
+
+func TestCreateExpenseRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	req := &CreateExpenseRequest{Description: "Firewood", Amount: 2500, Currency: "usd"}
+	if errs := req.Validate(); len(errs) > 0 {
+		t.Errorf("Validate() = %v, want no errors", errs)
+	}
+
+	req.Currency = "btc"
+	if errs := req.Validate(); len(errs) == 0 || errs[0].Field != "currency" {
+		t.Errorf("Validate() = %v, want an error on currency", errs)
+	}
+
+	req.Currency, req.Amount = "usd", 0
+	if errs := req.Validate(); len(errs) == 0 || errs[0].Field != "amount" {
+		t.Errorf("Validate() = %v, want an error on amount", errs)
+	}
+}
@@ -0,0 +1,175 @@
+package model
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ExperimentStatus is where an experiment is in its lifecycle. Experiments
+// are created as drafts, assign users while running, and stay stopped once
+// stopped so their results aren't mixed with a later run.
+type ExperimentStatus string
+
+const (
+	ExperimentDraft   ExperimentStatus = "draft"
+	ExperimentRunning ExperimentStatus = "running"
+	ExperimentStopped ExperimentStatus = "stopped"
+)
+
+// IsValid reports whether s is a known experiment status
+func (s ExperimentStatus) IsValid() bool {
+	switch s {
+	case ExperimentDraft, ExperimentRunning, ExperimentStopped:
+		return true
+	}
+	return false
+}
+
+// Experiment constraints
+const (
+	MaxExperimentKeyLength         = 64
+	MaxExperimentDescriptionLength = 500
+	MinExperimentVariants          = 2
+	MaxExperimentVariants          = 10
+	MaxExperimentVariantWeight     = 1000
+	MaxExperimentParams            = 20
+)
+
+// ExperimentProtectedPrefixes are remote config namespaces experiments may
+// not vary. Sign-in, proof of possession, privacy, safety and payment
+// behavior must be the same for every user, so an experiment can never
+// weaken it for some of them.
+var ExperimentProtectedPrefixes = []string{
+	"auth.",
+	"security.",
+	"dpop.",
+	"privacy.",
+	"moderation.",
+	"safety.",
+	"payments.",
+}
+
+// IsProtectedConfigKey reports whether key is in a namespace experiments
+// may not vary
+func IsProtectedConfigKey(key string) bool {
+	for _, prefix := range ExperimentProtectedPrefixes {
+		if strings.HasPrefix(key, prefix) || key+"." == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// experimentKeyPattern matches lowercase keys such as onboarding_copy
+var experimentKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Experiment is an A/B test. Each user is bucketed into one variant by a
+// hash of the experiment's salt and their ID, so they see the same variant
+// on every device and request for as long as the experiment runs.
+type Experiment struct {
+	ID          string              `json:"id"`
+	Key         string              `json:"key"`
+	Description string              `json:"description,omitempty"`
+	Status      ExperimentStatus    `json:"status"`
+	Salt        string              `json:"salt"`
+	Variants    []ExperimentVariant `json:"variants"`
+	Platforms   []DevicePlatform    `json:"platforms,omitempty"` // Empty for every platform
+	CreatedBy   string              `json:"created_by"`
+	CreatedOn   time.Time           `json:"created_on"`
+	UpdatedOn   time.Time           `json:"updated_on"`
+	StartedOn   *time.Time          `json:"started_on,omitempty"`
+	StoppedOn   *time.Time          `json:"stopped_on,omitempty"`
+}
+
+// Targets reports whether the experiment runs on platform. Clients that
+// don't say which platform they are only get experiments for every
+// platform.
+func (e *Experiment) Targets(platform DevicePlatform) bool {
+	if len(e.Platforms) == 0 {
+		return true
+	}
+	for _, p := range e.Platforms {
+		if p == platform {
+			return true
+		}
+	}
+	return false
+}
+
+// ExperimentVariant is one arm of an experiment. Users are split between
+// variants in proportion to their weights. Params are remote config values
+// the variant overrides for its users.
+type ExperimentVariant struct {
+	Key    string                     `json:"key"`
+	Weight int                        `json:"weight"`
+	Params map[string]json.RawMessage `json:"params,omitempty"`
+}
+
+// ExperimentAssignment is the variant a user is in for a running experiment
+type ExperimentAssignment struct {
+	Experiment string                     `json:"experiment"`
+	Variant    string                     `json:"variant"`
+	Params     map[string]json.RawMessage `json:"params,omitempty"`
+}
+
+// CreateExperimentRequest represents an admin request to create an
+// experiment. It starts as a draft.
+type CreateExperimentRequest struct {
+	Key         string              `json:"key"`
+	Description string              `json:"description,omitempty"`
+	Variants    []ExperimentVariant `json:"variants"`
+	Platforms   []DevicePlatform    `json:"platforms,omitempty"`
+}
+
+// Validate validates the create experiment request
+func (r *CreateExperimentRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("key", r.Key).Required().MaxLength(MaxExperimentKeyLength)
+	v.Check("key", r.Key == "" || experimentKeyPattern.MatchString(r.Key), "key must be lowercase letters, digits and underscores")
+	v.String("description", r.Description).MaxLength(MaxExperimentDescriptionLength)
+
+	for _, p := range r.Platforms {
+		v.Check("platforms", p.IsValid(), "platforms must be ios, android, or web")
+	}
+
+	v.Check("variants", len(r.Variants) >= MinExperimentVariants && len(r.Variants) <= MaxExperimentVariants,
+		"an experiment needs between 2 and 10 variants")
+	seen := make(map[string]bool, len(r.Variants))
+	for _, variant := range r.Variants {
+		v.Check("variants", experimentKeyPattern.MatchString(variant.Key) && len(variant.Key) <= MaxExperimentKeyLength,
+			"variant keys must be lowercase letters, digits and underscores")
+		v.Check("variants", !seen[variant.Key], "variant keys must be unique")
+		seen[variant.Key] = true
+		v.Check("variants", variant.Weight >= 1 && variant.Weight <= MaxExperimentVariantWeight,
+			"variant weights must be between 1 and 1000")
+		v.Check("variants", len(variant.Params) <= MaxExperimentParams, "a variant may override at most 20 params")
+		for key, value := range variant.Params {
+			v.Check("variants", ValidRemoteConfigKey(key), "param keys must be remote config keys such as discovery.default_radius_km")
+			v.Check("variants", !IsProtectedConfigKey(key), "experiments may not vary auth, security, dpop, privacy, moderation, safety or payments params")
+			v.Check("variants", json.Valid(value) && len(value) <= MaxRemoteConfigValueSize, "param values must be JSON of at most 16 KB")
+		}
+	}
+
+	return v.Errors()
+}
+
+// UpdateExperimentRequest represents an admin request to update an
+// experiment. Variants can't change once created, since that would move
+// users between them.
+type UpdateExperimentRequest struct {
+	Description *string           `json:"description,omitempty"`
+	Status      *ExperimentStatus `json:"status,omitempty"` // running to start, stopped to end
+}
+
+// Validate validates the update experiment request
+func (r *UpdateExperimentRequest) Validate() []FieldError {
+	var v Validator
+
+	v.OptionalString("description", r.Description).MaxLength(MaxExperimentDescriptionLength)
+	v.Check("status", r.Status == nil || r.Status.IsValid(), "status must be draft, running, or stopped")
+
+	return v.Errors()
+}
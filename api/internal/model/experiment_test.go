@@ -0,0 +1,55 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCreateExperimentRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	variants := func(params map[string]json.RawMessage) []ExperimentVariant {
+		return []ExperimentVariant{
+			{Key: "control", Weight: 1},
+			{Key: "treatment", Weight: 1, Params: params},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		req   CreateExperimentRequest
+		valid bool
+	}{
+		{"valid", CreateExperimentRequest{Key: "radius_test", Variants: variants(map[string]json.RawMessage{
+			"discovery.default_radius_km": json.RawMessage(`40`),
+		})}, true},
+		{"one variant", CreateExperimentRequest{Key: "radius_test", Variants: variants(nil)[:1]}, false},
+		{"duplicate variant", CreateExperimentRequest{Key: "radius_test", Variants: []ExperimentVariant{
+			{Key: "a", Weight: 1}, {Key: "a", Weight: 1},
+		}}, false},
+		{"zero weight", CreateExperimentRequest{Key: "radius_test", Variants: []ExperimentVariant{
+			{Key: "a", Weight: 0}, {Key: "b", Weight: 1},
+		}}, false},
+		{"bad key", CreateExperimentRequest{Key: "Radius Test", Variants: variants(nil)}, false},
+		{"auth param", CreateExperimentRequest{Key: "mfa_test", Variants: variants(map[string]json.RawMessage{
+			"auth.mfa_required": json.RawMessage(`false`),
+		})}, false},
+		{"security namespace itself", CreateExperimentRequest{Key: "sec_test", Variants: variants(map[string]json.RawMessage{
+			"security": json.RawMessage(`{}`),
+		})}, false},
+		{"unknown platform", CreateExperimentRequest{Key: "radius_test", Variants: variants(nil), Platforms: []DevicePlatform{"tv"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			errs := tt.req.Validate()
+			if tt.valid && len(errs) > 0 {
+				t.Errorf("Validate() = %v, want valid", errs)
+			}
+			if !tt.valid && len(errs) == 0 {
+				t.Error("Validate() passed, want errors")
+			}
+		})
+	}
+}
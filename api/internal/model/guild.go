@@ -1,6 +1,11 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
 
 // Member represents a member linked to a user
 type Member struct {
@@ -14,14 +19,17 @@ type Member struct {
 
 // Guild represents a community with shared purpose (formerly Circle)
 type Guild struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	Icon        string    `json:"icon,omitempty"`
-	Color       string    `json:"color,omitempty"`
-	Visibility  string    `json:"visibility"` // private, public
-	CreatedOn   time.Time `json:"created_on"`
-	UpdatedOn   time.Time `json:"updated_on"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Slug         string    `json:"slug,omitempty"` // Vanity URL path segment, unique
+	Description  string    `json:"description,omitempty"`
+	Icon         string    `json:"icon,omitempty"`
+	Color        string    `json:"color,omitempty"`
+	Visibility   string    `json:"visibility"`    // private, public
+	EmbedEnabled bool      `json:"embed_enabled"` // Other sites may embed the guild's upcoming public events
+	Version      int       `json:"version"`       // Incremented on every update
+	CreatedOn    time.Time `json:"created_on"`
+	UpdatedOn    time.Time `json:"updated_on"`
 }
 
 // GuildVisibility constants
@@ -49,6 +57,17 @@ func (r GuildRole) IsModerator() bool {
 	return r == GuildRoleModerator || r == GuildRoleAdmin
 }
 
+// AtLeast returns true if the role grants at least floor's privileges
+func (r GuildRole) AtLeast(floor GuildRole) bool {
+	switch floor {
+	case GuildRoleAdmin:
+		return r.IsAdmin()
+	case GuildRoleModerator:
+		return r.IsModerator()
+	}
+	return r.IsValid()
+}
+
 // IsValid returns true if the role is a valid guild role
 func (r GuildRole) IsValid() bool {
 	switch r {
@@ -80,11 +99,76 @@ const (
 
 	MaxGuildNameLength = 100
 	MaxGuildDescLength = 500
+	MinGuildSlugLength = 3
+	MaxGuildSlugLength = 50
+
+	// GuildSlugReservationPeriod is how long a released slug keeps redirecting
+	// to its previous guild before another guild may claim it
+	GuildSlugReservationPeriod = 90 * 24 * time.Hour
 )
 
+// guildSlugPattern allows lowercase letters and digits separated by single hyphens
+var guildSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// reservedGuildSlugs collide with routes or could impersonate the platform
+var reservedGuildSlugs = map[string]bool{
+	"admin":    true,
+	"api":      true,
+	"help":     true,
+	"new":      true,
+	"official": true,
+	"saga":     true,
+	"settings": true,
+	"support":  true,
+}
+
+// ValidateGuildSlug checks the slug's length, charset and reserved words
+func ValidateGuildSlug(slug string) []FieldError {
+	if len(slug) < MinGuildSlugLength || len(slug) > MaxGuildSlugLength {
+		return []FieldError{{Field: "slug", Message: fmt.Sprintf("slug must be %d-%d characters", MinGuildSlugLength, MaxGuildSlugLength)}}
+	}
+	if !guildSlugPattern.MatchString(slug) {
+		return []FieldError{{Field: "slug", Message: "slug must be lowercase letters and digits separated by single hyphens"}}
+	}
+	if reservedGuildSlugs[slug] {
+		return []FieldError{{Field: "slug", Message: "slug is reserved"}}
+	}
+	return nil
+}
+
+// SlugifyGuildName derives a slug from a guild name, e.g. "Tuesday Board
+// Games!" becomes "tuesday-board-games". The result may still be too short or
+// reserved, so callers validate it.
+func SlugifyGuildName(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			hyphen = false
+		} else if !hyphen && b.Len() > 0 {
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if len(slug) > MaxGuildSlugLength {
+		slug = strings.TrimSuffix(slug[:MaxGuildSlugLength], "-")
+	}
+	return slug
+}
+
+// GuildSlugRedirect maps a released slug to the guild that last used it
+type GuildSlugRedirect struct {
+	Slug       string    `json:"slug"`
+	GuildID    string    `json:"guild_id"`
+	ReleasedOn time.Time `json:"released_on"`
+}
+
 // CreateGuildRequest represents a request to create a guild
 type CreateGuildRequest struct {
 	Name        string `json:"name"`
+	Slug        string `json:"slug,omitempty"` // defaults to one derived from the name
 	Description string `json:"description,omitempty"`
 	Icon        string `json:"icon,omitempty"`
 	Color       string `json:"color,omitempty"`
@@ -93,11 +177,14 @@ type CreateGuildRequest struct {
 
 // UpdateGuildRequest represents a request to update a guild
 type UpdateGuildRequest struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Icon        *string `json:"icon,omitempty"`
-	Color       *string `json:"color,omitempty"`
-	Visibility  *string `json:"visibility,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Slug         *string `json:"slug,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Icon         *string `json:"icon,omitempty"`
+	Color        *string `json:"color,omitempty"`
+	Visibility   *string `json:"visibility,omitempty"`
+	EmbedEnabled *bool   `json:"embed_enabled,omitempty"`
+	Version      *int    `json:"version,omitempty"` // Version the edit is based on; rejected if stale
 }
 
 // CreatePersonRequest represents a request to create a person
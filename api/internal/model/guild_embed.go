@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+// Guild event embed constraints
+const (
+	DefaultGuildEmbedEventsLimit = 10
+	MaxGuildEmbedEventsLimit     = 50
+
+	// Widget size suggested to oEmbed consumers, in pixels
+	GuildEmbedWidgetWidth  = 400
+	GuildEmbedWidgetHeight = 600
+)
+
+// GuildEventsEmbed is what other sites see of a guild that enabled event
+// embeds: its name and its upcoming public events, and nothing about who
+// organizes or attends them
+type GuildEventsEmbed struct {
+	Guild  EmbedGuild   `json:"guild"`
+	Events []EmbedEvent `json:"events"`
+}
+
+// EmbedGuild identifies the guild in an embed
+type EmbedGuild struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// EmbedEvent is an upcoming event in an embed
+type EmbedEvent struct {
+	ID        string         `json:"id"`
+	Title     string         `json:"title"`
+	StartTime time.Time      `json:"start_time"`
+	EndTime   *time.Time     `json:"end_time,omitempty"`
+	Location  *EmbedLocation `json:"location,omitempty"`
+}
+
+// EmbedLocation is where an embedded event takes place, without the street
+// address or meeting link that only attendees see
+type EmbedLocation struct {
+	Name      string `json:"name"`
+	City      string `json:"city,omitempty"`
+	IsVirtual bool   `json:"is_virtual"`
+}
+
+// OEmbed is an oEmbed rich response describing the events widget
+type OEmbed struct {
+	Type         string `json:"type"`
+	Version      string `json:"version"`
+	Title        string `json:"title"`
+	ProviderName string `json:"provider_name"`
+	HTML         string `json:"html"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	CacheAge     int    `json:"cache_age"` // Seconds consumers may cache the response
+}
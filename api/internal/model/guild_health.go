@@ -0,0 +1,113 @@
+package model
+
+import "time"
+
+// Guild health scoring. A guild's score is a weighted blend of how often it
+// meets, how many members RSVP, how many stay, and how many take part in
+// matching pools, each measured over the trailing window.
+const (
+	GuildHealthWindow = 28 * 24 * time.Hour // Trailing window each snapshot measures
+
+	GuildHealthTargetEvents            = 4    // Events per window for full marks (about weekly)
+	GuildHealthTargetRSVPRate          = 0.25 // Share of members RSVPing to the average event
+	GuildHealthMaxChurnRate            = 0.20 // Share of members leaving per window that scores zero
+	GuildHealthTargetPoolParticipation = 0.30 // Share of members in an active matching pool
+
+	GuildHealthWeightEvents = 30
+	GuildHealthWeightRSVPs  = 30
+	GuildHealthWeightChurn  = 20
+	GuildHealthWeightPools  = 20
+
+	// GuildHealthDropWindow is how far back a score is compared to decide
+	// whether it dropped sharply
+	GuildHealthDropWindow = 7 * 24 * time.Hour
+	// GuildHealthDropThreshold is how many points a score must fall within
+	// the drop window for organizers to be alerted
+	GuildHealthDropThreshold = 15
+	// GuildHealthAtRiskScore is the score below which a guild is at risk
+	// regardless of its trend
+	GuildHealthAtRiskScore = 40
+
+	// GuildHealthHistoryDays is how many daily scores a health report includes
+	GuildHealthHistoryDays = 30
+)
+
+// GuildHealthAction is something organizers can do to improve their guild's
+// health
+type GuildHealthAction string
+
+const (
+	GuildHealthActionScheduleEvent   GuildHealthAction = "schedule_event"
+	GuildHealthActionPromoteEvents   GuildHealthAction = "promote_events"
+	GuildHealthActionRunMatching     GuildHealthAction = "run_matching"
+	GuildHealthActionReengageMembers GuildHealthAction = "reengage_members"
+)
+
+// GuildHealthMetrics are the raw counts behind a health score
+type GuildHealthMetrics struct {
+	Members          int `json:"members"`
+	Joined           int `json:"joined"` // Members who joined since the churn baseline
+	Events           int `json:"events"`
+	ApprovedRSVPs    int `json:"approved_rsvps"`
+	ActivePools      int `json:"active_pools"`
+	PoolParticipants int `json:"pool_participants"`
+}
+
+// GuildHealthComponents are the parts of a health score, each from 0 to 1.
+// MemberChurn is nil until there is a snapshot a full window old to compare
+// membership against; the other parts are then weighted up to cover it.
+type GuildHealthComponents struct {
+	EventFrequency    float64  `json:"event_frequency"`
+	RSVPRate          float64  `json:"rsvp_rate"`
+	MemberChurn       *float64 `json:"member_churn,omitempty"`
+	PoolParticipation float64  `json:"pool_participation"`
+}
+
+// GuildHealthSuggestion is an action organizers can take, with why it helps
+type GuildHealthSuggestion struct {
+	Action  GuildHealthAction `json:"action"`
+	Message string            `json:"message"`
+}
+
+// GuildHealth is a snapshot of a guild's health, computed daily
+type GuildHealth struct {
+	ID               string                  `json:"id"`
+	GuildID          string                  `json:"guild_id"`
+	Day              string                  `json:"day"` // UTC date the snapshot is for, YYYY-MM-DD
+	Score            int                     `json:"score"`
+	PreviousScore    *int                    `json:"previous_score,omitempty"` // Score a drop window earlier
+	AtRisk           bool                    `json:"at_risk"`
+	Metrics          GuildHealthMetrics      `json:"metrics"`
+	ChurnRate        *float64                `json:"churn_rate,omitempty"`
+	Components       GuildHealthComponents   `json:"components"`
+	SuggestedActions []GuildHealthSuggestion `json:"suggested_actions"`
+	AlertedOn        *time.Time              `json:"alerted_on,omitempty"`
+	ComputedOn       time.Time               `json:"computed_on"`
+}
+
+// Change returns how many points the score moved since the previous score,
+// or 0 when there is none
+func (h *GuildHealth) Change() int {
+	if h.PreviousScore == nil {
+		return 0
+	}
+	return h.Score - *h.PreviousScore
+}
+
+// DroppedSharply reports whether the score fell by at least the drop
+// threshold since the previous score
+func (h *GuildHealth) DroppedSharply() bool {
+	return h.Change() <= -GuildHealthDropThreshold
+}
+
+// GuildHealthPoint is a guild's score on one day
+type GuildHealthPoint struct {
+	Day   string `json:"day"`
+	Score int    `json:"score"`
+}
+
+// GuildHealthReport is a guild's latest health and its recent daily scores
+type GuildHealthReport struct {
+	Current *GuildHealth       `json:"current"`
+	History []GuildHealthPoint `json:"history"` // Newest first
+}
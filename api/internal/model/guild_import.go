@@ -0,0 +1,164 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// GuildImportSource is the platform an export came from
+type GuildImportSource string
+
+const (
+	GuildImportMeetup  GuildImportSource = "meetup"  // Events from the Meetup events API
+	GuildImportDiscord GuildImportSource = "discord" // Members from the Discord guild members API
+)
+
+// IsValid checks if the source is known
+func (s GuildImportSource) IsValid() bool {
+	switch s {
+	case GuildImportMeetup, GuildImportDiscord:
+		return true
+	}
+	return false
+}
+
+// GuildImportStatus tracks a guild import from preview to commit
+type GuildImportStatus string
+
+const (
+	GuildImportPreviewed GuildImportStatus = "previewed"
+	GuildImportCommitted GuildImportStatus = "committed"
+)
+
+// GuildImportAction is what committing does with one member or event
+type GuildImportAction string
+
+const (
+	GuildImportCreate   GuildImportAction = "create"   // A new account or event is created
+	GuildImportExisting GuildImportAction = "existing" // Matches an account, or an event the guild already has
+	GuildImportSkip     GuildImportAction = "skip"     // Left out; Note says why
+)
+
+// Guild import constraints
+const (
+	MaxGuildImportBytes      = 4 << 20 // Largest request body accepted
+	MaxGuildImportMembers    = MaxUserImportRows
+	MaxGuildImportEvents     = 500
+	GuildImportPreviewTTL    = 24 * time.Hour // How long a preview can be committed
+	DefaultGuildImportsLimit = 20
+	MaxGuildImportsLimit     = 100
+)
+
+// GuildImportMember is one member found in an export. Exports rarely carry
+// emails, and members without one are skipped unless the admin supplies an
+// email when committing.
+type GuildImportMember struct {
+	ExternalID string            `json:"external_id"`
+	Name       string            `json:"name"`
+	Email      string            `json:"email,omitempty"`
+	Action     GuildImportAction `json:"action"`
+	UserID     string            `json:"user_id,omitempty"` // The matching account, for existing members
+	Note       string            `json:"note,omitempty"`
+}
+
+// GuildImportEvent is one event found in an export
+type GuildImportEvent struct {
+	ExternalID  string            `json:"external_id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description,omitempty"`
+	StartTime   time.Time         `json:"start_time"`
+	EndTime     *time.Time        `json:"end_time,omitempty"`
+	Location    *EventLocation    `json:"location,omitempty"`
+	Action      GuildImportAction `json:"action"`
+	EventID     string            `json:"event_id,omitempty"` // The matching event, or the one created on commit
+	Note        string            `json:"note,omitempty"`
+}
+
+// GuildImportSummary counts what committing will do, or did
+type GuildImportSummary struct {
+	MembersCreated  int `json:"members_created"`
+	MembersExisting int `json:"members_existing"`
+	MembersSkipped  int `json:"members_skipped"`
+	EventsCreated   int `json:"events_created"`
+	EventsExisting  int `json:"events_existing"`
+	EventsSkipped   int `json:"events_skipped"`
+}
+
+// GuildImport maps a Meetup or Discord export onto a guild. It is first a
+// preview the admin reviews and corrects; committing creates the events
+// and queues the members as a user import, which creates accounts, adds
+// them to the guild and sends invitations.
+type GuildImport struct {
+	ID           string              `json:"id"`
+	Source       GuildImportSource   `json:"source"`
+	GuildID      string              `json:"guild_id"`
+	GuildSlug    string              `json:"guild_slug"`
+	Status       GuildImportStatus   `json:"status"`
+	RequestedBy  string              `json:"requested_by"`
+	Members      []GuildImportMember `json:"members,omitempty"`
+	Events       []GuildImportEvent  `json:"events,omitempty"`
+	Summary      GuildImportSummary  `json:"summary"`
+	UserImportID string              `json:"user_import_id,omitempty"` // Set on commit when there are members to import
+	CreatedOn    time.Time           `json:"created_on"`
+	ExpiresOn    time.Time           `json:"expires_on"`
+	CommittedOn  *time.Time          `json:"committed_on,omitempty"`
+}
+
+// IsExpired reports whether the preview can no longer be committed
+func (g *GuildImport) IsExpired(now time.Time) bool {
+	return !now.Before(g.ExpiresOn)
+}
+
+// Summarize counts the actions of the import's members and events
+func (g *GuildImport) Summarize() GuildImportSummary {
+	var s GuildImportSummary
+	for _, m := range g.Members {
+		switch m.Action {
+		case GuildImportCreate:
+			s.MembersCreated++
+		case GuildImportExisting:
+			s.MembersExisting++
+		case GuildImportSkip:
+			s.MembersSkipped++
+		}
+	}
+	for _, e := range g.Events {
+		switch e.Action {
+		case GuildImportCreate:
+			s.EventsCreated++
+		case GuildImportExisting:
+			s.EventsExisting++
+		case GuildImportSkip:
+			s.EventsSkipped++
+		}
+	}
+	return s
+}
+
+// CreateGuildImportRequest uploads an export to preview. Export is the
+// JSON the platform's API returned: an array of Meetup events or of
+// Discord guild members.
+type CreateGuildImportRequest struct {
+	Source GuildImportSource `json:"source"`
+	Guild  string            `json:"guild"` // Slug of the guild to import into
+	Export json.RawMessage   `json:"export"`
+}
+
+// Validate validates the create guild import request
+func (r *CreateGuildImportRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("source", r.Source.IsValid(), "source must be meetup or discord")
+	v.String("guild", r.Guild).Required()
+	v.Check("export", len(r.Export) > 0, "export is required")
+
+	return v.Errors()
+}
+
+// CommitGuildImportRequest applies the admin's corrections to a preview
+// and commits it. MemberEmails sets the email of members by external ID;
+// Skip leaves out members and events by external ID.
+type CommitGuildImportRequest struct {
+	MemberEmails map[string]string `json:"member_emails,omitempty"`
+	Skip         []string          `json:"skip,omitempty"`
+}
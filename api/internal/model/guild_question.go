@@ -0,0 +1,86 @@
+package model
+
+import (
+	"time"
+)
+
+// GuildQuestionCategory is the category stored on every guild question.
+// Guild questions are not part of the global category catalog.
+const GuildQuestionCategory = "guild"
+
+// MaxGuildQuestions caps the active custom questions a guild can have
+const MaxGuildQuestions = 10
+
+// GuildAnswer is a member's answer to a guild question. Guild answers are
+// visible to the member and the guild's organizers only, and are never used
+// for global compatibility scoring.
+type GuildAnswer struct {
+	ID             string    `json:"id"`
+	GuildID        string    `json:"guild_id"`
+	QuestionID     string    `json:"question_id"`
+	UserID         string    `json:"user_id"`
+	SelectedOption string    `json:"selected_option"`
+	CreatedOn      time.Time `json:"created_on"`
+	UpdatedOn      time.Time `json:"updated_on"`
+}
+
+// CreateGuildQuestionRequest represents an organizer request to add a guild question
+type CreateGuildQuestionRequest struct {
+	Text      string           `json:"text"`
+	Options   []QuestionOption `json:"options"`
+	SortOrder int              `json:"sort_order"`
+}
+
+// Validate validates the create guild question request
+func (r *CreateGuildQuestionRequest) Validate() []FieldError {
+	var v Validator
+
+	validateQuestionText(&v, r.Text)
+	validateGuildQuestionOptions(&v, r.Options)
+
+	return v.Errors()
+}
+
+// UpdateGuildQuestionRequest represents an organizer request to update a guild question
+type UpdateGuildQuestionRequest struct {
+	Text      *string          `json:"text,omitempty"`
+	Options   []QuestionOption `json:"options,omitempty"`
+	SortOrder *int             `json:"sort_order,omitempty"`
+	Active    *bool            `json:"active,omitempty"` // Set true to restore a retired question
+}
+
+// Validate validates the update guild question request
+func (r *UpdateGuildQuestionRequest) Validate() []FieldError {
+	var v Validator
+
+	if r.Text != nil {
+		validateQuestionText(&v, *r.Text)
+	}
+	if r.Options != nil {
+		validateGuildQuestionOptions(&v, r.Options)
+	}
+
+	return v.Errors()
+}
+
+// AnswerGuildQuestionRequest represents a member's answer to a guild question
+type AnswerGuildQuestionRequest struct {
+	SelectedOption string `json:"selected_option"`
+}
+
+// Validate validates the answer guild question request
+func (r *AnswerGuildQuestionRequest) Validate() []FieldError {
+	var v Validator
+	v.String("selected_option", r.SelectedOption).Required()
+	return v.Errors()
+}
+
+// validateGuildQuestionOptions applies the global option rules. Guild
+// organizers cannot set bias weights, so every option is neutral.
+func validateGuildQuestionOptions(v *Validator, options []QuestionOption) {
+	admin := make([]AdminQuestionOption, len(options))
+	for i, opt := range options {
+		admin[i] = AdminQuestionOption{Value: opt.Value, Label: opt.Label}
+	}
+	validateQuestionOptions(v, admin)
+}
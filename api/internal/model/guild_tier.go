@@ -0,0 +1,114 @@
+package model
+
+import (
+	"regexp"
+	"time"
+)
+
+// GuildTier is a membership level a guild hands out, like "supporter" or
+// "patron", separate from the member's role. Higher ranked tiers include
+// the perks of lower ones: events and pools gated on a tier are open to
+// every tier ranked at or above it.
+type GuildTier struct {
+	ID             string    `json:"id"`
+	GuildID        string    `json:"guild_id"`
+	Key            string    `json:"key"` // Stable lower case identifier, unique in the guild
+	Name           string    `json:"name"`
+	Description    *string   `json:"description,omitempty"`
+	Rank           int       `json:"rank"`             // Higher ranks outrank lower ones
+	EarlyRSVPHours int       `json:"early_rsvp_hours"` // How long before rsvp_opens_at holders can RSVP
+	CreatedOn      time.Time `json:"created_on"`
+	UpdatedOn      time.Time `json:"updated_on"`
+}
+
+// GuildTierAssignment gives a guild member a tier, until it expires
+type GuildTierAssignment struct {
+	ID         string     `json:"id"`
+	GuildID    string     `json:"guild_id"`
+	UserID     string     `json:"user_id"`
+	TierID     string     `json:"tier_id"`
+	AssignedBy string     `json:"assigned_by"`
+	AssignedOn time.Time  `json:"assigned_on"`
+	ExpiresOn  *time.Time `json:"expires_on,omitempty"` // nil = until removed
+	// Populated on reads
+	Tier *GuildTier `json:"tier,omitempty"`
+}
+
+// Guild tier limits
+const (
+	MaxTiersPerGuild  = 10
+	MaxTierRank       = 100
+	MaxEarlyRSVPHours = 14 * 24
+)
+
+var tierKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,31}$`)
+
+// ActiveAt reports whether the assignment still grants its tier at now
+func (a *GuildTierAssignment) ActiveAt(now time.Time) bool {
+	return a.ExpiresOn == nil || now.Before(*a.ExpiresOn)
+}
+
+// Includes reports whether holding t grants the perks gated on required.
+// No tier includes nothing.
+func (t *GuildTier) Includes(required *GuildTier) bool {
+	return t != nil && t.Rank >= required.Rank
+}
+
+// CreateGuildTierRequest represents a request to add a tier to a guild
+type CreateGuildTierRequest struct {
+	Key            string  `json:"key"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	Rank           int     `json:"rank"`
+	EarlyRSVPHours int     `json:"early_rsvp_hours,omitempty"`
+}
+
+// Validate validates the create guild tier request
+func (r *CreateGuildTierRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("key", r.Key).Required()
+	v.Check("key", tierKeyPattern.MatchString(r.Key),
+		"key must be 2 to 32 lower case letters, digits, dashes, or underscores")
+	v.String("name", r.Name).Required().MaxLength(50)
+	v.OptionalString("description", r.Description).MaxLength(500)
+	v.Int("rank", r.Rank).Between(1, MaxTierRank)
+	v.Int("early_rsvp_hours", r.EarlyRSVPHours).Between(0, MaxEarlyRSVPHours)
+
+	return v.Errors()
+}
+
+// UpdateGuildTierRequest represents a request to update a tier. The key
+// can't change, as clients may refer to it.
+type UpdateGuildTierRequest struct {
+	Name           *string `json:"name,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Rank           *int    `json:"rank,omitempty"`
+	EarlyRSVPHours *int    `json:"early_rsvp_hours,omitempty"`
+}
+
+// Validate validates the update guild tier request
+func (r *UpdateGuildTierRequest) Validate() []FieldError {
+	var v Validator
+
+	v.OptionalString("name", r.Name).NotEmpty().MaxLength(50)
+	v.OptionalString("description", r.Description).MaxLength(500)
+	v.OptionalInt("rank", r.Rank).Between(1, MaxTierRank)
+	v.OptionalInt("early_rsvp_hours", r.EarlyRSVPHours).Between(0, MaxEarlyRSVPHours)
+
+	return v.Errors()
+}
+
+// AssignGuildTierRequest represents a request to give a member a tier,
+// replacing any tier they hold
+type AssignGuildTierRequest struct {
+	TierID    string     `json:"tier_id"`
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+}
+
+// Validate validates the assign guild tier request
+func (r *AssignGuildTierRequest) Validate() []FieldError {
+	var v Validator
+	v.String("tier_id", r.TierID).Required()
+	return v.Errors()
+}
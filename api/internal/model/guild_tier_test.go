@@ -0,0 +1,95 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Guild Tier Tests
+// ============================================================================
+
+func TestGuildTier_Includes(t *testing.T) {
+	t.Parallel()
+
+	supporter := &GuildTier{Rank: 10}
+	patron := &GuildTier{Rank: 20}
+
+	if !patron.Includes(supporter) {
+		t.Error("expected a higher tier to include a lower one")
+	}
+	if !supporter.Includes(supporter) {
+		t.Error("expected a tier to include itself")
+	}
+	if supporter.Includes(patron) {
+		t.Error("expected a lower tier not to include a higher one")
+	}
+	var none *GuildTier
+	if none.Includes(supporter) {
+		t.Error("expected no tier to include nothing")
+	}
+}
+
+func TestGuildTierAssignment_ActiveAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	if !(&GuildTierAssignment{}).ActiveAt(now) {
+		t.Error("expected an assignment without expiry to be active")
+	}
+	if !(&GuildTierAssignment{ExpiresOn: &later}).ActiveAt(now) {
+		t.Error("expected an assignment before expiry to be active")
+	}
+	if (&GuildTierAssignment{ExpiresOn: &now}).ActiveAt(now) {
+		t.Error("expected an assignment at expiry to have lapsed")
+	}
+}
+
+func TestCreateGuildTierRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		req   CreateGuildTierRequest
+		field string
+	}{
+		{"valid", CreateGuildTierRequest{Key: "supporter", Name: "Supporter", Rank: 10, EarlyRSVPHours: 24}, ""},
+		{"upper case key", CreateGuildTierRequest{Key: "Supporter", Name: "Supporter", Rank: 10}, "key"},
+		{"missing name", CreateGuildTierRequest{Key: "supporter", Rank: 10}, "name"},
+		{"zero rank", CreateGuildTierRequest{Key: "supporter", Name: "Supporter"}, "rank"},
+		{"early access too long", CreateGuildTierRequest{Key: "supporter", Name: "Supporter", Rank: 10, EarlyRSVPHours: 1000}, "early_rsvp_hours"},
+	}
+	for _, tt := range tests {
+		errs := tt.req.Validate()
+		if tt.field == "" {
+			if len(errs) != 0 {
+				t.Errorf("%s: expected no errors, got %v", tt.name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || errs[0].Field != tt.field {
+			t.Errorf("%s: expected an error on %s, got %v", tt.name, tt.field, errs)
+		}
+	}
+}
+
+func TestCreateEventRequest_ValidateTierPerks(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 6, 10, 18, 0, 0, 0, time.UTC)
+	tier := "guild_tier:supporter"
+	guild := "guild:1"
+	late := start.Add(time.Hour)
+
+	req := CreateEventRequest{Title: "Launch", StartTime: start, MinTier: &tier}
+	if errs := req.Validate(); len(errs) != 1 || errs[0].Field != "min_tier" {
+		t.Errorf("expected min_tier to need a guild, got %v", errs)
+	}
+
+	req = CreateEventRequest{Title: "Launch", StartTime: start, GuildID: &guild, MinTier: &tier, RSVPOpensAt: &late}
+	if errs := req.Validate(); len(errs) != 1 || errs[0].Field != "rsvp_opens_at" {
+		t.Errorf("expected rsvp_opens_at to need to be before start_time, got %v", errs)
+	}
+}
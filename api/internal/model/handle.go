@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Handle constraints
+const (
+	MinHandleLength = 3
+	MaxHandleLength = 30
+
+	// HandleChangeCooldown is the minimum time between handle changes
+	HandleChangeCooldown = 30 * 24 * time.Hour
+
+	// HandleReservationPeriod is how long a released handle keeps redirecting
+	// to its previous owner before anyone else may claim it
+	HandleReservationPeriod = 90 * 24 * time.Hour
+)
+
+// handlePattern allows letters, digits and underscores, starting with a letter
+var handlePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// reservedHandles are lowercase handles nobody may claim because they
+// collide with routes or could impersonate staff
+var reservedHandles = map[string]bool{
+	"admin":         true,
+	"administrator": true,
+	"api":           true,
+	"help":          true,
+	"me":            true,
+	"mod":           true,
+	"moderator":     true,
+	"null":          true,
+	"official":      true,
+	"root":          true,
+	"saga":          true,
+	"settings":      true,
+	"staff":         true,
+	"support":       true,
+	"system":        true,
+	"undefined":     true,
+}
+
+// HandlePrefix marks a path segment as a handle rather than a user ID
+const HandlePrefix = "@"
+
+// NormalizeHandle returns the lowercase lookup key for a handle, with any
+// leading @ removed. Handles are unique case-insensitively on this key.
+func NormalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), HandlePrefix))
+}
+
+// IsReservedHandle reports whether the handle is reserved
+func IsReservedHandle(handle string) bool {
+	return reservedHandles[NormalizeHandle(handle)]
+}
+
+// ValidateHandle checks the handle's length, charset and reserved words
+func ValidateHandle(handle string) []FieldError {
+	if len(handle) < MinHandleLength || len(handle) > MaxHandleLength {
+		return []FieldError{{Field: "handle", Message: fmt.Sprintf("handle must be %d-%d characters", MinHandleLength, MaxHandleLength)}}
+	}
+	if !handlePattern.MatchString(handle) {
+		return []FieldError{{Field: "handle", Message: "handle must start with a letter and contain only letters, digits and underscores"}}
+	}
+	if IsReservedHandle(handle) {
+		return []FieldError{{Field: "handle", Message: "handle is reserved"}}
+	}
+	return nil
+}
+
+// SetHandleRequest represents a request to set or change the user's handle
+type SetHandleRequest struct {
+	Handle string `json:"handle"`
+}
+
+// Validate validates the set handle request
+func (r *SetHandleRequest) Validate() []FieldError {
+	return ValidateHandle(strings.TrimPrefix(r.Handle, HandlePrefix))
+}
+
+// HandleRedirect maps a released handle to the user who last held it
+type HandleRedirect struct {
+	Handle     string    `json:"handle"` // Lowercase lookup key
+	UserID     string    `json:"user_id"`
+	ReleasedOn time.Time `json:"released_on"`
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidID indicates a record ID that isn't "table:key" for the expected
+// table
+var ErrInvalidID = errors.New("invalid record ID")
+
+// Record IDs are SurrealDB "table:key" strings. Each table has its own type
+// so passing, say, a user ID where a member ID is expected fails to compile.
+// They are plain strings underneath, so JSON and query variables are
+// unchanged.
+type (
+	UserID       string // user:...
+	GuildID      string // guild:...
+	MemberID     string // member:...
+	PoolID       string // matching_pool:...
+	PoolMemberID string // pool_member:...
+	MatchID      string // match_result:...
+)
+
+// Tables the typed IDs belong to
+const (
+	TableUser       = "user"
+	TableGuild      = "guild"
+	TableMember     = "member"
+	TablePool       = "matching_pool"
+	TablePoolMember = "pool_member"
+	TableMatch      = "match_result"
+)
+
+// ParseUserID checks that s is a user record ID
+func ParseUserID(s string) (UserID, error) { return parseID[UserID](TableUser, s) }
+
+// ParseGuildID checks that s is a guild record ID
+func ParseGuildID(s string) (GuildID, error) { return parseID[GuildID](TableGuild, s) }
+
+// ParseMemberID checks that s is a member record ID
+func ParseMemberID(s string) (MemberID, error) { return parseID[MemberID](TableMember, s) }
+
+// ParsePoolID checks that s is a matching pool record ID
+func ParsePoolID(s string) (PoolID, error) { return parseID[PoolID](TablePool, s) }
+
+// ParseMatchID checks that s is a match result record ID
+func ParseMatchID(s string) (MatchID, error) { return parseID[MatchID](TableMatch, s) }
+
+func (id UserID) String() string       { return string(id) }
+func (id GuildID) String() string      { return string(id) }
+func (id MemberID) String() string     { return string(id) }
+func (id PoolID) String() string       { return string(id) }
+func (id PoolMemberID) String() string { return string(id) }
+func (id MatchID) String() string      { return string(id) }
+
+func parseID[T ~string](table, s string) (T, error) {
+	prefix, key, found := strings.Cut(s, ":")
+	if !found || prefix != table || key == "" {
+		return "", fmt.Errorf("%w: %q is not a %s ID", ErrInvalidID, s, table)
+	}
+	return T(s), nil
+}
+
+// IDStrings converts typed IDs to strings, for repositories and queries
+func IDStrings[T ~string](ids []T) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = string(id)
+	}
+	return out
+}
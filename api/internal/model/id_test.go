@@ -0,0 +1,83 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// ============================================================================
+// Typed ID Tests
+// ============================================================================
+
+func TestParsePoolID(t *testing.T) {
+	t.Parallel()
+
+	id, err := ParsePoolID("matching_pool:abc123")
+	if err != nil {
+		t.Fatalf("ParsePoolID failed: %v", err)
+	}
+	if id.String() != "matching_pool:abc123" {
+		t.Errorf("expected the ID unchanged, got %q", id)
+	}
+
+	for _, s := range []string{"", "abc123", "matching_pool:", "guild:abc123", "user:abc123"} {
+		if _, err := ParsePoolID(s); !errors.Is(err, ErrInvalidID) {
+			t.Errorf("ParsePoolID(%q): expected ErrInvalidID, got %v", s, err)
+		}
+	}
+}
+
+func TestParseIDs_CheckTable(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseUserID("user:1"); err != nil {
+		t.Errorf("ParseUserID: %v", err)
+	}
+	if _, err := ParseGuildID("guild:1"); err != nil {
+		t.Errorf("ParseGuildID: %v", err)
+	}
+	if _, err := ParseMemberID("member:1"); err != nil {
+		t.Errorf("ParseMemberID: %v", err)
+	}
+	if _, err := ParseMatchID("match_result:1"); err != nil {
+		t.Errorf("ParseMatchID: %v", err)
+	}
+
+	// The mixup typed IDs exist to catch
+	if _, err := ParseMemberID("user:1"); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("expected a user ID to be rejected as a member ID, got %v", err)
+	}
+}
+
+func TestTypedIDs_JSONUnchanged(t *testing.T) {
+	t.Parallel()
+
+	member := PoolMember{
+		ID:              "pool_member:1",
+		PoolID:          "matching_pool:1",
+		MemberID:        "member:1",
+		UserID:          "user:1",
+		ExcludedMembers: []MemberID{"member:2"},
+	}
+	data, err := json.Marshal(member)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if raw["member_id"] != "member:1" || raw["user_id"] != "user:1" {
+		t.Errorf("expected IDs to marshal as plain strings, got %s", data)
+	}
+
+	var decoded PoolMember
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded.MemberID != member.MemberID || decoded.ExcludedMembers[0] != "member:2" {
+		t.Errorf("expected a round trip, got %+v", decoded)
+	}
+}
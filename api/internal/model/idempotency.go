@@ -0,0 +1,26 @@
+package model
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrIdempotencyKeyInUse is returned while an earlier request with the same
+// Idempotency-Key is still being handled
+var ErrIdempotencyKeyInUse = errors.New("a request with this idempotency key is still in progress")
+
+// Idempotency constraints
+const (
+	IdempotencyTTL   = 24 * time.Hour   // How long responses are replayed
+	IdempotencyLease = 2 * time.Minute  // How long a request holds its key before an instance that died mid-request is assumed gone
+	IdempotencyWait  = 10 * time.Second // How long a retry waits for the request holding its key
+)
+
+// IdempotentResponse is the response to a request sent with an
+// Idempotency-Key, replayed to retries of the request
+type IdempotentResponse struct {
+	Status  int
+	Headers http.Header
+	Body    []byte
+}
@@ -0,0 +1,66 @@
+package model
+
+import "strings"
+
+// languageCodes holds the ISO 639-1 two-letter language codes
+var languageCodes = map[string]struct{}{
+	"aa": {}, "ab": {}, "ae": {}, "af": {}, "ak": {}, "am": {}, "an": {}, "ar": {}, "as": {}, "av": {},
+	"ay": {}, "az": {}, "ba": {}, "be": {}, "bg": {}, "bi": {}, "bm": {}, "bn": {}, "bo": {}, "br": {},
+	"bs": {}, "ca": {}, "ce": {}, "ch": {}, "co": {}, "cr": {}, "cs": {}, "cu": {}, "cv": {}, "cy": {},
+	"da": {}, "de": {}, "dv": {}, "dz": {}, "ee": {}, "el": {}, "en": {}, "eo": {}, "es": {}, "et": {},
+	"eu": {}, "fa": {}, "ff": {}, "fi": {}, "fj": {}, "fo": {}, "fr": {}, "fy": {}, "ga": {}, "gd": {},
+	"gl": {}, "gn": {}, "gu": {}, "gv": {}, "ha": {}, "he": {}, "hi": {}, "ho": {}, "hr": {}, "ht": {},
+	"hu": {}, "hy": {}, "hz": {}, "ia": {}, "id": {}, "ie": {}, "ig": {}, "ii": {}, "ik": {}, "io": {},
+	"is": {}, "it": {}, "iu": {}, "ja": {}, "jv": {}, "ka": {}, "kg": {}, "ki": {}, "kj": {}, "kk": {},
+	"kl": {}, "km": {}, "kn": {}, "ko": {}, "kr": {}, "ks": {}, "ku": {}, "kv": {}, "kw": {}, "ky": {},
+	"la": {}, "lb": {}, "lg": {}, "li": {}, "ln": {}, "lo": {}, "lt": {}, "lu": {}, "lv": {}, "mg": {},
+	"mh": {}, "mi": {}, "mk": {}, "ml": {}, "mn": {}, "mr": {}, "ms": {}, "mt": {}, "my": {}, "na": {},
+	"nb": {}, "nd": {}, "ne": {}, "ng": {}, "nl": {}, "nn": {}, "no": {}, "nr": {}, "nv": {}, "ny": {},
+	"oc": {}, "oj": {}, "om": {}, "or": {}, "os": {}, "pa": {}, "pi": {}, "pl": {}, "ps": {}, "pt": {},
+	"qu": {}, "rm": {}, "rn": {}, "ro": {}, "ru": {}, "rw": {}, "sa": {}, "sc": {}, "sd": {}, "se": {},
+	"sg": {}, "si": {}, "sk": {}, "sl": {}, "sm": {}, "sn": {}, "so": {}, "sq": {}, "sr": {}, "ss": {},
+	"st": {}, "su": {}, "sv": {}, "sw": {}, "ta": {}, "te": {}, "tg": {}, "th": {}, "ti": {}, "tk": {},
+	"tl": {}, "tn": {}, "to": {}, "tr": {}, "ts": {}, "tt": {}, "tw": {}, "ty": {}, "ug": {}, "uk": {},
+	"ur": {}, "uz": {}, "ve": {}, "vi": {}, "vo": {}, "wa": {}, "wo": {}, "xh": {}, "yi": {}, "yo": {},
+	"za": {}, "zh": {}, "zu": {},
+}
+
+// IsValidLanguageCode reports whether code is an ISO 639-1 language code.
+// Codes are lower case.
+func IsValidLanguageCode(code string) bool {
+	_, ok := languageCodes[code]
+	return ok
+}
+
+// NormalizeLanguages lower-cases and de-duplicates language codes, keeping
+// their order. It reports false if any code isn't ISO 639-1.
+func NormalizeLanguages(codes []string) ([]string, bool) {
+	seen := make(map[string]bool, len(codes))
+	normalized := make([]string, 0, len(codes))
+	for _, code := range codes {
+		code = strings.ToLower(strings.TrimSpace(code))
+		if !IsValidLanguageCode(code) {
+			return nil, false
+		}
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		normalized = append(normalized, code)
+	}
+	return normalized, true
+}
+
+// SharedLanguages returns the languages in b that a also speaks, in b's order
+func SharedLanguages(a, b []string) []string {
+	var shared []string
+	for _, code := range b {
+		for _, other := range a {
+			if code == other {
+				shared = append(shared, code)
+				break
+			}
+		}
+	}
+	return shared
+}
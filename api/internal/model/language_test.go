@@ -0,0 +1,51 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+// ============================================================================
+// Language Tests
+// ============================================================================
+
+func TestNormalizeLanguages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		codes  []string
+		want   []string
+		wantOK bool
+	}{
+		{"empty", nil, []string{}, true},
+		{"lower cases and trims", []string{" EN", "Es"}, []string{"en", "es"}, true},
+		{"drops duplicates", []string{"en", "fr", "EN"}, []string{"en", "fr"}, true},
+		{"language name", []string{"english"}, nil, false},
+		{"three-letter code", []string{"eng"}, nil, false},
+		{"unassigned code", []string{"xx"}, nil, false},
+		{"blank", []string{""}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := NormalizeLanguages(tt.codes)
+			if ok != tt.wantOK || (ok && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("NormalizeLanguages(%v) = %v, %v, want %v, %v", tt.codes, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSharedLanguages(t *testing.T) {
+	t.Parallel()
+
+	got := SharedLanguages([]string{"en", "es"}, []string{"fr", "es", "en"})
+	if want := []string{"es", "en"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SharedLanguages() = %v, want %v", got, want)
+	}
+	if got := SharedLanguages(nil, []string{"en"}); len(got) != 0 {
+		t.Errorf("SharedLanguages() with no languages = %v, want none", got)
+	}
+}
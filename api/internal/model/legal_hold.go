@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// Legal hold constraints
+const (
+	MaxLegalHoldReasonLength    = 1000
+	DefaultLegalHoldAccessLimit = 100
+	MaxLegalHoldAccessLimit     = 500
+)
+
+// Legal hold access log actions other than request routes
+const (
+	LegalHoldActionPlaced   = "legal_hold.placed"
+	LegalHoldActionReleased = "legal_hold.released"
+)
+
+// LegalHold preserves a user's data for an investigation. While a hold is in
+// place the account can't be deleted, purge jobs skip the user's data and
+// every access to it is logged.
+type LegalHold struct {
+	UserID   string    `json:"user_id"`
+	Reason   string    `json:"reason"`
+	PlacedBy string    `json:"placed_by"`
+	PlacedOn time.Time `json:"placed_on"`
+}
+
+// LegalHoldAccess is one entry in a held user's access log. ActorID is empty
+// for unauthenticated access, such as a public profile view.
+type LegalHoldAccess struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	ActorID    string    `json:"actor_id,omitempty"`
+	Action     string    `json:"action"` // e.g. "GET /v1/users/user:1/profile"
+	AccessedOn time.Time `json:"accessed_on"`
+}
+
+// PlaceLegalHoldRequest represents a request to place a legal hold
+type PlaceLegalHoldRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Validate validates the place legal hold request
+func (r *PlaceLegalHoldRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("reason", r.Reason).Required().MaxLength(MaxLegalHoldReasonLength)
+
+	return v.Errors()
+}
@@ -0,0 +1,193 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// MaxSortKeys is the most fields a collection can be sorted by at once
+const MaxSortKeys = 3
+
+// ListFields is what a collection lets clients sort and filter by. Each
+// endpoint declares its own, and requests naming anything else are
+// rejected:
+//
+//	?sort=-created_on,title          created_on newest first, then title
+//	?filter[status]=open             status is open
+//	?filter[status]=open,closed      status is open or closed
+type ListFields struct {
+	Sort   []string            // Fields the collection can be sorted by
+	Filter map[string][]string // Fields it can be filtered by, with the values each accepts; nil accepts any
+}
+
+// ListQuery is the order and filters a client asked a collection for.
+// Repositories turn it into a query with database.ListConditions and
+// database.ListOrder.
+type ListQuery struct {
+	Sort    []SortKey    // In priority order; the collection's default when empty
+	Filters []ListFilter // By field name; all must match
+}
+
+// SortKey is one field of a sort order
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// ListFilter matches items whose field is any of Values
+type ListFilter struct {
+	Field  string
+	Values []string
+}
+
+// Filter returns the values a field is filtered to, nil when it isn't
+func (q ListQuery) Filter(field string) []string {
+	for _, f := range q.Filters {
+		if f.Field == field {
+			return f.Values
+		}
+	}
+	return nil
+}
+
+// SortParam returns q's order as a sort parameter, such as
+// "-created_on,title"; cursors carry it to check they're used with the
+// order they came from
+func (q ListQuery) SortParam() string {
+	parts := make([]string, len(q.Sort))
+	for i, key := range q.Sort {
+		if key.Desc {
+			parts[i] = "-" + key.Field
+		} else {
+			parts[i] = key.Field
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// WithFilter returns q filtered on field, unless it already is. Endpoints
+// use it to keep older single-purpose parameters such as ?status= working.
+func (q ListQuery) WithFilter(field string, values ...string) ListQuery {
+	if len(values) == 0 || q.Filter(field) != nil {
+		return q
+	}
+	q.Filters = append(append([]ListFilter(nil), q.Filters...), ListFilter{Field: field, Values: values})
+	sort.Slice(q.Filters, func(i, j int) bool { return q.Filters[i].Field < q.Filters[j].Field })
+	return q
+}
+
+// Parse reads the sort and filter[...] parameters of a collection request.
+// Other parameters are ignored, and empty ones are treated as absent.
+func (f ListFields) Parse(query url.Values) (ListQuery, []FieldError) {
+	var v Validator
+	var q ListQuery
+
+	if raw := query.Get("sort"); raw != "" {
+		q.Sort = f.parseSort(&v, raw)
+	}
+
+	params := make([]string, 0, len(query))
+	for param := range query {
+		params = append(params, param)
+	}
+	sort.Strings(params) // Filters, and their errors, in a stable order
+	for _, param := range params {
+		field, ok := filterField(param)
+		if !ok {
+			continue
+		}
+		raw := strings.Join(query[param], ",")
+		if raw == "" {
+			continue
+		}
+		if filter, ok := f.parseFilter(&v, param, field, raw); ok {
+			q.Filters = append(q.Filters, filter)
+		}
+	}
+
+	return q, v.Errors()
+}
+
+func (f ListFields) parseSort(v *Validator, raw string) []SortKey {
+	var keys []SortKey
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		key := SortKey{Field: strings.TrimPrefix(part, "-"), Desc: strings.HasPrefix(part, "-")}
+		switch {
+		case key.Field == "":
+			v.Fail("sort", "sort has an empty field")
+		case !contains(f.Sort, key.Field):
+			if len(f.Sort) == 0 {
+				v.Fail("sort", "this collection can't be sorted")
+			} else {
+				v.Fail("sort", fmt.Sprintf("sort can't use %s; sort by %s", key.Field, list(f.Sort)))
+			}
+		case seen[key.Field]:
+			v.Fail("sort", fmt.Sprintf("sort lists %s more than once", key.Field))
+		default:
+			seen[key.Field] = true
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > MaxSortKeys {
+		v.Fail("sort", fmt.Sprintf("sort can use at most %d fields", MaxSortKeys))
+	}
+	return keys
+}
+
+func (f ListFields) parseFilter(v *Validator, param, field, raw string) (ListFilter, bool) {
+	accepted, ok := f.Filter[field]
+	if !ok {
+		if len(f.Filter) == 0 {
+			v.Fail(param, "this collection can't be filtered")
+		} else {
+			v.Fail(param, fmt.Sprintf("%s isn't a filter; filter by %s", field, list(f.filterNames())))
+		}
+		return ListFilter{}, false
+	}
+
+	filter := ListFilter{Field: field}
+	for _, value := range strings.Split(raw, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if accepted != nil && !contains(accepted, value) {
+			v.Fail(param, fmt.Sprintf("%s must be %s", param, list(accepted)))
+			return ListFilter{}, false
+		}
+		if !contains(filter.Values, value) {
+			filter.Values = append(filter.Values, value)
+		}
+	}
+	return filter, len(filter.Values) > 0
+}
+
+func (f ListFields) filterNames() []string {
+	names := make([]string, 0, len(f.Filter))
+	for name := range f.Filter {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// filterField returns the field of a filter[field] parameter
+func filterField(param string) (string, bool) {
+	if !strings.HasPrefix(param, "filter[") || !strings.HasSuffix(param, "]") {
+		return "", false
+	}
+	return param[len("filter[") : len(param)-1], true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package model
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+var testListFields = ListFields{
+	Sort: []string{"created_on", "title", "closes_at", "status"},
+	Filter: map[string][]string{
+		"status": {"open", "closed"},
+		"city":   nil,
+	},
+}
+
+func parseListQuery(t *testing.T, raw string) (ListQuery, []FieldError) {
+	t.Helper()
+	query, err := url.ParseQuery(raw)
+	if err != nil {
+		t.Fatalf("ParseQuery(%q): %v", raw, err)
+	}
+	return testListFields.Parse(query)
+}
+
+// ============================================================================
+// Sort Tests
+// ============================================================================
+
+func TestListFields_ParseSort(t *testing.T) {
+	t.Parallel()
+
+	q, errs := parseListQuery(t, "sort=-created_on,title")
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	want := []SortKey{{Field: "created_on", Desc: true}, {Field: "title"}}
+	if !reflect.DeepEqual(q.Sort, want) {
+		t.Errorf("expected %v, got %v", want, q.Sort)
+	}
+}
+
+func TestListFields_ParseSort_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"sort=password", "sort can't use password; sort by created_on, title, closes_at, or status"},
+		{"sort=title,-title", "sort lists title more than once"},
+		{"sort=title,", "sort has an empty field"},
+		{"sort=title,created_on,closes_at,status", "sort can use at most 3 fields"},
+	}
+	for _, tt := range tests {
+		_, errs := parseListQuery(t, tt.query)
+		if len(errs) != 1 || errs[0].Field != "sort" || errs[0].Message != tt.want {
+			t.Errorf("%s: expected %q, got %v", tt.query, tt.want, errs)
+		}
+	}
+}
+
+// ============================================================================
+// Filter Tests
+// ============================================================================
+
+func TestListFields_ParseFilter(t *testing.T) {
+	t.Parallel()
+
+	q, errs := parseListQuery(t, "filter[status]=open,closed,open&filter[city]=Portland&filter[title]=&limit=5")
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	want := []ListFilter{
+		{Field: "city", Values: []string{"Portland"}},
+		{Field: "status", Values: []string{"open", "closed"}},
+	}
+	if !reflect.DeepEqual(q.Filters, want) {
+		t.Errorf("expected %v, got %v", want, q.Filters)
+	}
+	if got := q.Filter("status"); len(got) != 2 {
+		t.Errorf("expected two status values, got %v", got)
+	}
+	if got := q.Filter("title"); got != nil {
+		t.Errorf("expected no title filter, got %v", got)
+	}
+}
+
+func TestListFields_ParseFilter_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		field string
+		want  string
+	}{
+		{"filter[status]=lost", "filter[status]", "filter[status] must be open or closed"},
+		{"filter[owner_id]=user:1", "filter[owner_id]", "owner_id isn't a filter; filter by city or status"},
+	}
+	for _, tt := range tests {
+		_, errs := parseListQuery(t, tt.query)
+		if len(errs) != 1 || errs[0].Field != tt.field || errs[0].Message != tt.want {
+			t.Errorf("%s: expected %s %q, got %v", tt.query, tt.field, tt.want, errs)
+		}
+	}
+}
+
+func TestListFields_Parse_Unlisted(t *testing.T) {
+	t.Parallel()
+
+	_, errs := ListFields{}.Parse(url.Values{"sort": {"title"}, "filter[status]": {"open"}})
+	if len(errs) != 2 {
+		t.Fatalf("expected sort and filter errors, got %v", errs)
+	}
+	if errs[0].Message != "this collection can't be sorted" || errs[1].Message != "this collection can't be filtered" {
+		t.Errorf("unexpected messages: %v", errs)
+	}
+}
+
+func TestListQuery_WithFilter(t *testing.T) {
+	t.Parallel()
+
+	q := ListQuery{Filters: []ListFilter{{Field: "status", Values: []string{"open"}}}}
+
+	if got := q.WithFilter("status", "closed").Filter("status"); !reflect.DeepEqual(got, []string{"open"}) {
+		t.Errorf("expected an existing filter to win, got %v", got)
+	}
+
+	withCity := q.WithFilter("city", "Portland")
+	if got := withCity.Filter("city"); !reflect.DeepEqual(got, []string{"Portland"}) {
+		t.Errorf("expected the city filter, got %v", got)
+	}
+	if withCity.Filters[0].Field != "city" {
+		t.Errorf("expected filters sorted by field, got %v", withCity.Filters)
+	}
+	if len(q.Filters) != 1 {
+		t.Errorf("expected the original query unchanged, got %v", q.Filters)
+	}
+}
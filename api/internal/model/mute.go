@@ -0,0 +1,77 @@
+package model
+
+import "time"
+
+// MuteKind is what a mute silences
+type MuteKind string
+
+const (
+	MuteKindGuild         MuteKind = "guild"          // A guild's new events, votes and announcements
+	MuteKindEventCategory MuteKind = "event_category" // New events of one category, in every guild
+)
+
+// Mute silences a kind of notification for one user, who stays in the
+// guilds concerned. Notifications addressed to the user personally, like
+// event reminders and role assignments, are never muted.
+type Mute struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	Kind      MuteKind   `json:"kind"`
+	GuildID   *string    `json:"guild_id,omitempty"` // For guild mutes
+	Category  *string    `json:"category,omitempty"` // Event template, for event category mutes
+	Until     *time.Time `json:"until,omitempty"`    // nil = until removed
+	CreatedOn time.Time  `json:"created_on"`
+}
+
+// MaxMutesPerUser caps how many mutes one user can have
+const MaxMutesPerUser = 100
+
+// NotificationScope is what a guild broadcast is about, for matching it
+// against mutes. EventCategory is empty for anything but new events.
+type NotificationScope struct {
+	GuildID       string
+	EventCategory string
+}
+
+// Silences reports whether the mute silences a notification about scope at
+// now
+func (m *Mute) Silences(scope NotificationScope, now time.Time) bool {
+	if m.Until != nil && !now.Before(*m.Until) {
+		return false
+	}
+	switch m.Kind {
+	case MuteKindGuild:
+		return m.GuildID != nil && *m.GuildID == scope.GuildID
+	case MuteKindEventCategory:
+		return m.Category != nil && scope.EventCategory != "" && *m.Category == scope.EventCategory
+	}
+	return false
+}
+
+// CreateMuteRequest represents a request to mute a guild or an event
+// category
+type CreateMuteRequest struct {
+	Kind     MuteKind   `json:"kind"`
+	GuildID  *string    `json:"guild_id,omitempty"`
+	Category *string    `json:"category,omitempty"`
+	Until    *time.Time `json:"until,omitempty"`
+}
+
+// Validate validates the create mute request
+func (r *CreateMuteRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("kind", string(r.Kind)).Required().OneOf(string(MuteKindGuild), string(MuteKindEventCategory))
+	switch r.Kind {
+	case MuteKindGuild:
+		v.Check("guild_id", r.GuildID != nil, "guild_id is required")
+		v.OptionalString("guild_id", r.GuildID).NotEmpty()
+		v.Check("category", r.Category == nil, "category is only for event_category mutes")
+	case MuteKindEventCategory:
+		v.Check("category", r.Category != nil, "category is required")
+		v.OptionalString("category", r.Category).OneOf(EventTemplates...)
+		v.Check("guild_id", r.GuildID == nil, "guild_id is only for guild mutes")
+	}
+
+	return v.Errors()
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Mute Tests
+// ============================================================================
+
+func TestMute_Silences(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	guildID := "guild:1"
+	category := EventTemplateTrip
+	guildMute := &Mute{Kind: MuteKindGuild, GuildID: &guildID}
+	categoryMute := &Mute{Kind: MuteKindEventCategory, Category: &category}
+
+	if !guildMute.Silences(NotificationScope{GuildID: "guild:1"}, now) {
+		t.Error("expected a guild mute to silence its guild")
+	}
+	if guildMute.Silences(NotificationScope{GuildID: "guild:2"}, now) {
+		t.Error("expected a guild mute not to silence other guilds")
+	}
+	if !categoryMute.Silences(NotificationScope{GuildID: "guild:2", EventCategory: EventTemplateTrip}, now) {
+		t.Error("expected a category mute to silence its category in any guild")
+	}
+	if categoryMute.Silences(NotificationScope{GuildID: "guild:2"}, now) {
+		t.Error("expected a category mute not to silence votes and announcements")
+	}
+	if categoryMute.Silences(NotificationScope{GuildID: "guild:2", EventCategory: EventTemplateCasual}, now) {
+		t.Error("expected a category mute not to silence other categories")
+	}
+}
+
+func TestMute_Silences_Expired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	guildID := "guild:1"
+	until := now
+	mute := &Mute{Kind: MuteKindGuild, GuildID: &guildID, Until: &until}
+	scope := NotificationScope{GuildID: guildID}
+
+	if mute.Silences(scope, now) {
+		t.Error("expected a mute to end at until")
+	}
+	if !mute.Silences(scope, now.Add(-time.Minute)) {
+		t.Error("expected a mute to silence before until")
+	}
+}
+
+func TestCreateMuteRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	guildID := "guild:1"
+	empty := ""
+	trip := EventTemplateTrip
+	unknown := "rave"
+
+	tests := []struct {
+		name    string
+		req     CreateMuteRequest
+		wantErr bool
+	}{
+		{"guild", CreateMuteRequest{Kind: MuteKindGuild, GuildID: &guildID}, false},
+		{"category", CreateMuteRequest{Kind: MuteKindEventCategory, Category: &trip}, false},
+		{"missing kind", CreateMuteRequest{GuildID: &guildID}, true},
+		{"unknown kind", CreateMuteRequest{Kind: "user", GuildID: &guildID}, true},
+		{"guild without guild_id", CreateMuteRequest{Kind: MuteKindGuild}, true},
+		{"guild with empty guild_id", CreateMuteRequest{Kind: MuteKindGuild, GuildID: &empty}, true},
+		{"guild with category", CreateMuteRequest{Kind: MuteKindGuild, GuildID: &guildID, Category: &trip}, true},
+		{"category without category", CreateMuteRequest{Kind: MuteKindEventCategory}, true},
+		{"unknown category", CreateMuteRequest{Kind: MuteKindEventCategory, Category: &unknown}, true},
+		{"category with guild_id", CreateMuteRequest{Kind: MuteKindEventCategory, Category: &trip, GuildID: &guildID}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			errs := tt.req.Validate()
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Validate() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
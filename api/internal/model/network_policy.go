@@ -0,0 +1,79 @@
+package model
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// Network policy constraints
+const (
+	MaxIPDenyReasonLength = 500
+	MaxIPDenyDuration     = 365 * 24 * time.Hour
+)
+
+// ErrInvalidNetwork is returned for a string that is neither an IP address
+// nor a CIDR range
+var ErrInvalidNetwork = errors.New("must be an IP address or CIDR range, such as 203.0.113.7 or 203.0.113.0/24")
+
+// ParseNetwork parses a CIDR range, or a single address as a range holding
+// only it. The range is masked, so 203.0.113.7/24 becomes 203.0.113.0/24.
+// Surrounding spaces are ignored.
+func ParseNetwork(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, ErrInvalidNetwork
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// IPDenyEntry turns a client address, or a range of them, away from the
+// whole API. Admins add them for abusive clients; an entry without an
+// expiry stays until it's removed.
+type IPDenyEntry struct {
+	ID        string     `json:"id"`
+	CIDR      string     `json:"cidr"`
+	Reason    string     `json:"reason"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedBy string     `json:"created_by"`
+	CreatedOn time.Time  `json:"created_on"`
+}
+
+// Active reports whether the entry still applies at now
+func (e *IPDenyEntry) Active(now time.Time) bool {
+	return e.ExpiresAt == nil || now.Before(*e.ExpiresAt)
+}
+
+// NetworkPolicy is the network policy as admins see it
+type NetworkPolicy struct {
+	AdminAllowlist []string       `json:"admin_allowlist"` // Ranges admin routes accept; empty accepts any
+	DenyList       []*IPDenyEntry `json:"deny_list"`
+}
+
+// CreateIPDenyRequest represents a request to deny an address or range
+type CreateIPDenyRequest struct {
+	CIDR            string `json:"cidr"`
+	Reason          string `json:"reason"`
+	DurationMinutes int    `json:"duration_minutes,omitempty"` // 0 denies until removed
+}
+
+// Validate validates the create IP deny request
+func (r *CreateIPDenyRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("cidr", r.CIDR).Required()
+	if !v.Failed("cidr") {
+		_, err := ParseNetwork(r.CIDR)
+		v.Check("cidr", err == nil, "cidr "+ErrInvalidNetwork.Error())
+	}
+	v.String("reason", r.Reason).Required().MaxLength(MaxIPDenyReasonLength)
+	v.Int("duration_minutes", r.DurationMinutes).Between(0, int(MaxIPDenyDuration/time.Minute))
+
+	return v.Errors()
+}
@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+// NoShowSource describes how a no-show was recorded
+type NoShowSource string
+
+const (
+	NoShowSourceDetected NoShowSource = "detected" // Found by the no-show detection job
+	NoShowSourceHost     NoShowSource = "host"     // Marked manually by an event host
+)
+
+// NoShow records that a user with an approved RSVP did not attend an event.
+// A user is a no-show if they never checked in and did not confirm completion.
+type NoShow struct {
+	ID       string       `json:"id"`
+	UserID   string       `json:"user_id"`
+	EventID  string       `json:"event_id"`
+	GuildID  *string      `json:"guild_id,omitempty"`
+	Source   NoShowSource `json:"source"`
+	MarkedOn time.Time    `json:"marked_on"`
+}
+
+// NoShowEventRef identifies an ended event awaiting no-show detection
+type NoShowEventRef struct {
+	EventID string  `json:"event_id"`
+	GuildID *string `json:"guild_id,omitempty"`
+}
+
+// NoShowStats summarizes a user's attendance reliability over a lookback window
+type NoShowStats struct {
+	UserID       string  `json:"user_id"`
+	LookbackDays int     `json:"lookback_days"`
+	Attended     int     `json:"attended"`     // Approved RSVPs for checked events, minus no-shows
+	NoShows      int     `json:"no_shows"`     // No-shows within the window
+	NoShowRate   float64 `json:"no_show_rate"` // NoShows / (Attended + NoShows), 0-1
+}
+
+// GuildNoShowPolicy configures how a guild reacts to repeated no-shows
+type GuildNoShowPolicy struct {
+	GuildID string `json:"guild_id"`
+	// AutoDeclineThreshold is the number of no-shows within the lookback window
+	// after which new RSVPs to guild events are declined automatically. 0 disables it.
+	AutoDeclineThreshold int       `json:"auto_decline_threshold"`
+	LookbackDays         int       `json:"lookback_days"`
+	UpdatedBy            string    `json:"updated_by,omitempty"`
+	UpdatedOn            time.Time `json:"updated_on"`
+}
+
+// UpdateGuildNoShowPolicyRequest represents a request to update a guild's no-show policy
+type UpdateGuildNoShowPolicyRequest struct {
+	AutoDeclineThreshold *int `json:"auto_decline_threshold,omitempty"`
+	LookbackDays         *int `json:"lookback_days,omitempty"`
+}
+
+// Validate validates the update guild no-show policy request
+func (r *UpdateGuildNoShowPolicyRequest) Validate() []FieldError {
+	var v Validator
+
+	v.OptionalInt("auto_decline_threshold", r.AutoDeclineThreshold).Between(0, MaxNoShowAutoDeclineThreshold)
+	v.OptionalInt("lookback_days", r.LookbackDays).Between(1, MaxNoShowLookbackDays)
+
+	return v.Errors()
+}
+
+// Business constraints for no-show tracking
+const (
+	DefaultNoShowLookbackDays     = 180
+	MaxNoShowLookbackDays         = 730
+	MaxNoShowAutoDeclineThreshold = 50
+	// NoShowGracePeriod is how long after an event ends attendees may still
+	// check in or confirm completion before being marked as no-shows
+	NoShowGracePeriod = 24 * time.Hour
+)
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// NotificationOutboxEntry is one pending notification to one user: a push,
+// an SSE event, or a push that falls back to the event. It is delivered with
+// the same attempts and backoff as domain events (OutboxMaxAttempts and
+// friends). Entries are deleted once delivered; one that keeps failing is
+// kept with DeadOn set so it can be inspected.
+type NotificationOutboxEntry struct {
+	ID          string          `json:"id"`
+	UserID      string          `json:"user_id"`
+	Payload     json.RawMessage `json:"payload"`
+	Attempts    int             `json:"attempts"`
+	LastError   *string         `json:"last_error,omitempty"`
+	AvailableOn time.Time       `json:"available_on"`
+	DeadOn      *time.Time      `json:"dead_on,omitempty"`
+	RequestID   string          `json:"request_id,omitempty"` // Request that queued the notification
+	CreatedOn   time.Time       `json:"created_on"`
+}
@@ -19,6 +19,15 @@ const (
 	// Pool-related nudges
 	NudgeTypePoolMatchCreated NudgeType = "pool_match_created" // New pool match available
 	NudgeTypePoolMatchStale   NudgeType = "pool_match_stale"   // Pool match not acted on
+
+	// Vote-related nudges
+	NudgeTypeVoteClosing NudgeType = "vote_closing" // Vote closes soon and member hasn't voted
+
+	// Profile-related nudges
+	NudgeTypeProfileIncomplete NudgeType = "profile_incomplete" // Profile is missing items that count toward completeness
+
+	// Re-engagement nudges
+	NudgeTypeWinBack NudgeType = "win_back" // User has gone quiet with nothing coming up
 )
 
 // NudgeChannel represents how the nudge is delivered
@@ -47,10 +56,10 @@ type Nudge struct {
 // NudgeData contains type-specific context
 type NudgeData struct {
 	// For hangout/match nudges
-	HangoutID      *string `json:"hangout_id,omitempty"`
-	MatchID        *string `json:"match_id,omitempty"`
-	AvailabilityID *string `json:"availability_id,omitempty"`
-	PoolID         *string `json:"pool_id,omitempty"`
+	HangoutID      *string  `json:"hangout_id,omitempty"`
+	MatchID        *MatchID `json:"match_id,omitempty"`
+	AvailabilityID *string  `json:"availability_id,omitempty"`
+	PoolID         *PoolID  `json:"pool_id,omitempty"`
 
 	// For partner-related nudges
 	PartnerUserID  *string  `json:"partner_user_id,omitempty"`
@@ -61,6 +70,17 @@ type NudgeData struct {
 	ActivityDesc  *string    `json:"activity_desc,omitempty"`
 	ScheduledTime *time.Time `json:"scheduled_time,omitempty"`
 
+	// For vote nudges
+	VoteID   *string    `json:"vote_id,omitempty"`
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+
+	// For profile nudges
+	ProfileItem *CompletenessItem `json:"profile_item,omitempty"`
+
+	// For win-back nudges
+	NewMemberCount *int           `json:"new_member_count,omitempty"`
+	Events         []WinBackEvent `json:"events,omitempty"`
+
 	// Deep link info
 	ActionURL *string `json:"action_url,omitempty"` // e.g., "/hangout/123"
 }
@@ -150,6 +170,33 @@ var DefaultNudgeConfigs = map[NudgeType]NudgeConfig{
 		CooldownPeriod: 24 * time.Hour,
 		Channel:        NudgeChannelSSE,
 	},
+	NudgeTypeVoteClosing: {
+		Type:           NudgeTypeVoteClosing,
+		Enabled:        true,
+		DelayAfter:     0, // Triggered before close
+		RepeatInterval: 0, // No repeat
+		MaxRepeat:      1,
+		CooldownPeriod: 0,
+		Channel:        NudgeChannelPush,
+	},
+	NudgeTypeProfileIncomplete: {
+		Type:           NudgeTypeProfileIncomplete,
+		Enabled:        true,
+		DelayAfter:     24 * time.Hour,     // Give new users a day first
+		RepeatInterval: 7 * 24 * time.Hour, // Weekly
+		MaxRepeat:      3,
+		CooldownPeriod: 24 * time.Hour,
+		Channel:        NudgeChannelPush,
+	},
+	NudgeTypeWinBack: {
+		Type:           NudgeTypeWinBack,
+		Enabled:        true,
+		DelayAfter:     WinBackAwayAfter,    // Away for a week
+		RepeatInterval: 14 * 24 * time.Hour, // At most every two weeks
+		MaxRepeat:      2,
+		CooldownPeriod: 24 * time.Hour,
+		Channel:        NudgeChannelPush,
+	},
 }
 
 // NudgeHistory tracks sent nudges to prevent over-nudging
@@ -216,6 +263,18 @@ var NudgeTemplates = map[NudgeType]struct {
 		Title:   "Don't forget your match!",
 		Message: "You were matched with %s but haven't connected yet. The next round is coming up!",
 	},
+	NudgeTypeVoteClosing: {
+		Title:   "Vote closing soon",
+		Message: "%s closes in %s and you haven't voted yet.",
+	},
+	NudgeTypeProfileIncomplete: {
+		Title:   "Finish your profile",
+		Message: "Your profile is %d%% complete. %s",
+	},
+	NudgeTypeWinBack: {
+		Title:   "We've missed you",
+		Message: "It's been a while. %s",
+	},
 }
 
 // GetNudgeMessage generates a nudge message from template
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Cursor-paginated list sizes, for lists without their own
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 100
+)
+
+// PageRequest asks for one page of a list: Limit items after Cursor, or
+// before it for a prev cursor. Offset serves clients still paging with
+// ?offset=, and is ignored once there is a cursor.
+type PageRequest struct {
+	Limit  int
+	Offset int
+	Cursor *ListCursor
+}
+
+// Page is one page of a list, with opaque cursors for the pages either
+// side of it. A cursor is empty when there is no page that way.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+	PrevCursor string
+}
+
+// ListCursor marks an item in a list by the values it sorts by, with its ID
+// as a tiebreak, so the next page can start after it without counting the
+// rows before. Sort is the sort parameter the list was read with; a cursor
+// can't be used with another.
+type ListCursor struct {
+	Sort   string
+	Values []interface{}
+	ID     string
+	Before bool // The page ends just before the item rather than starting after it
+}
+
+// listCursorJSON is how a cursor is encoded. Times are tagged so they
+// decode as times rather than strings.
+type listCursorJSON struct {
+	Sort   string        `json:"s,omitempty"`
+	Values []interface{} `json:"v,omitempty"`
+	ID     string        `json:"id"`
+	Before bool          `json:"b,omitempty"`
+}
+
+// ErrInvalidCursor indicates a cursor that wasn't made by Encode, or was
+// made for another list
+var ErrInvalidCursor = errors.New("cursor is not valid")
+
+const cursorTimeKey = "$t"
+
+// Encode returns the cursor as an opaque string for a query parameter
+func (c *ListCursor) Encode() string {
+	values := make([]interface{}, len(c.Values))
+	for i, v := range c.Values {
+		if t, ok := v.(time.Time); ok {
+			v = map[string]string{cursorTimeKey: t.UTC().Format(time.RFC3339Nano)}
+		}
+		values[i] = v
+	}
+	raw, _ := json.Marshal(listCursorJSON{Sort: c.Sort, Values: values, ID: c.ID, Before: c.Before})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeListCursor reads a cursor from Encode
+func DecodeListCursor(s string) (*ListCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var decoded listCursorJSON
+	if err := json.Unmarshal(raw, &decoded); err != nil || decoded.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	for i, v := range decoded.Values {
+		tagged, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		s, _ := tagged[cursorTimeKey].(string)
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		decoded.Values[i] = t
+	}
+
+	return &ListCursor{
+		Sort:   decoded.Sort,
+		Values: decoded.Values,
+		ID:     decoded.ID,
+		Before: decoded.Before,
+	}, nil
+}
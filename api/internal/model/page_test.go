@@ -0,0 +1,42 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// List Cursor Tests
+// ============================================================================
+
+func TestListCursor_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 3, 14, 15, 9, 26, 535000000, time.UTC)
+	cursor := &ListCursor{Sort: "-created_on,title", Values: []interface{}{created, "Picnic"}, ID: "vote:abc", Before: true}
+
+	decoded, err := DecodeListCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("DecodeListCursor failed: %v", err)
+	}
+	if decoded.Sort != cursor.Sort || decoded.ID != cursor.ID || !decoded.Before {
+		t.Errorf("expected %+v, got %+v", cursor, decoded)
+	}
+	if got, ok := decoded.Values[0].(time.Time); !ok || !got.Equal(created) {
+		t.Errorf("expected the time %v back, got %#v", created, decoded.Values[0])
+	}
+	if decoded.Values[1] != "Picnic" {
+		t.Errorf("expected the title back, got %#v", decoded.Values[1])
+	}
+}
+
+func TestDecodeListCursor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, raw := range []string{"not a cursor!", "e30", "eyJpZCI6IngiLCJ2IjpbeyIkdCI6Im5vcGUifV19"} {
+		if _, err := DecodeListCursor(raw); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%q: expected ErrInvalidCursor, got %v", raw, err)
+		}
+	}
+}
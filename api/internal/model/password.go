@@ -0,0 +1,24 @@
+package model
+
+// Password strength scores, in the style of zxcvbn, bucketed by the
+// estimated number of guesses needed to crack the password
+const (
+	PasswordScoreTooGuessable      = 0 // Under 10^3 guesses
+	PasswordScoreVeryGuessable     = 1 // Under 10^6 guesses
+	PasswordScoreSomewhatGuessable = 2 // Under 10^8 guesses
+	PasswordScoreSafelyUnguessable = 3 // Under 10^10 guesses
+	PasswordScoreVeryUnguessable   = 4
+)
+
+// DefaultMinPasswordScore is the weakest score accepted for new passwords
+const DefaultMinPasswordScore = PasswordScoreSomewhatGuessable
+
+// PasswordStrength is the estimated strength of a password, with guidance on
+// making a weak one stronger
+type PasswordStrength struct {
+	Score       int      `json:"score"` // 0-4
+	Warning     string   `json:"warning,omitempty"`
+	Suggestions []string `json:"suggestions,omitempty"`
+	Breached    bool     `json:"breached,omitempty"`     // Found in a known data breach
+	BreachCount int      `json:"breach_count,omitempty"` // Times seen in breaches
+}
@@ -0,0 +1,40 @@
+package model
+
+// ClearedFields lists the fields a PATCH set to null. Pointer fields on an
+// update request can't tell "absent" from "null", so the handler records
+// clears here and the service removes those fields.
+type ClearedFields []string
+
+// Has reports whether the patch cleared field
+func (c ClearedFields) Has(field string) bool {
+	for _, f := range c {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// PatchRules describes which fields of a resource a PATCH may touch beyond
+// its update request: Immutable fields are rejected outright, Clearable
+// fields may be set to null to remove them.
+type PatchRules struct {
+	Immutable []string
+	Clearable []string
+}
+
+// Patch rules for the resources with PATCH endpoints
+var (
+	GuildPatchRules = PatchRules{
+		Immutable: []string{"id", "created_on", "updated_on"},
+		Clearable: []string{"description", "icon", "color"},
+	}
+	EventPatchRules = PatchRules{
+		Immutable: []string{"id", "guild_id", "adventure_id", "template", "created_by", "attendee_count", "created_on", "updated_on"},
+		Clearable: []string{"description", "location", "end_time", "max_attendees", "cover_image", "theme_color", "values_questions", "min_tier", "rsvp_opens_at"},
+	}
+	ProfilePatchRules = PatchRules{
+		Immutable: []string{"id", "user_id", "created_on", "updated_on"},
+		Clearable: []string{"bio", "tagline", "photo_url", "timezone", "location", "accessibility_needs"},
+	}
+)
@@ -0,0 +1,96 @@
+package model
+
+import "time"
+
+// EventPayment is a guest's payment for a paid event's ticket, taken
+// through the payment provider's hosted checkout and paid out to the event
+// creator's payout account
+type EventPayment struct {
+	ID                string     `json:"id"`
+	EventID           string     `json:"event_id"`
+	UserID            string     `json:"user_id"`
+	TicketID          string     `json:"ticket_id"`
+	Amount            int        `json:"amount"`             // Charged, in the currency's minor unit
+	Discount          int        `json:"discount,omitempty"` // Taken off the ticket price
+	DiscountSource    string     `json:"discount_source,omitempty"`
+	PromoCodeID       *string    `json:"promo_code_id,omitempty"`
+	Currency          string     `json:"currency"`
+	Status            string     `json:"status"` // pending, paid, refunded, expired
+	CheckoutSessionID *string    `json:"checkout_session_id,omitempty"`
+	PaymentIntentID   *string    `json:"-"` // What refunds are issued against
+	RefundID          *string    `json:"-"`
+	PaidOn            *time.Time `json:"paid_on,omitempty"`
+	RefundedOn        *time.Time `json:"refunded_on,omitempty"`
+	CreatedOn         time.Time  `json:"created_on"`
+	UpdatedOn         time.Time  `json:"updated_on"`
+}
+
+// PaymentStatus constants, for payments and the RSVPs they pay for
+const (
+	PaymentStatusPending  = "pending"  // Awaiting checkout
+	PaymentStatusPaid     = "paid"     // Checkout completed
+	PaymentStatusRefunded = "refunded" // Money returned to the guest
+	PaymentStatusExpired  = "expired"  // Checkout expired or the payment failed
+)
+
+// RefundPolicy constants: when guests who cancel get their money back.
+// Guests are always refunded when the host cancels the event or declines
+// them.
+const (
+	RefundPolicyFull      = "full"       // Until the event starts
+	RefundPolicyDayBefore = "day_before" // Until 24 hours before the event starts
+	RefundPolicyNone      = "none"       // Never
+)
+
+// RefundPolicies lists the valid refund policies
+var RefundPolicies = []string{RefundPolicyFull, RefundPolicyDayBefore, RefundPolicyNone}
+
+// Currencies paid events may charge in. All have two-decimal minor units.
+var Currencies = []string{"usd", "eur", "gbp", "cad", "aud"}
+
+// IsPaid reports whether guests pay for the event's tickets
+func (e *Event) IsPaid() bool {
+	return e.Ticketed && e.TicketPrice > 0
+}
+
+// RefundableAt reports whether a guest who cancels at now gets their money
+// back under the event's refund policy
+func (e *Event) RefundableAt(now time.Time) bool {
+	switch e.RefundPolicy {
+	case RefundPolicyNone:
+		return false
+	case RefundPolicyDayBefore:
+		return now.Before(e.StartTime.Add(-24 * time.Hour))
+	}
+	return now.Before(e.StartTime)
+}
+
+// CheckoutSession is where a guest pays for their ticket
+type CheckoutSession struct {
+	PaymentID      string    `json:"payment_id"`
+	URL            string    `json:"url"`    // Hosted payment page, or the event page if nothing is due
+	Amount         int       `json:"amount"` // 0 when a discount made the ticket free
+	Discount       int       `json:"discount"`
+	DiscountSource string    `json:"discount_source,omitempty"`
+	ExpiresOn      time.Time `json:"expires_on"` // The ticket hold lasts until shortly after
+}
+
+// PayoutAccount links an organizer to the payment provider account their
+// ticket sales are paid out to
+type PayoutAccount struct {
+	ID               string    `json:"id"`
+	UserID           string    `json:"user_id"`
+	AccountID        string    `json:"account_id"`      // Provider's account ID
+	ChargesEnabled   bool      `json:"charges_enabled"` // Can sell tickets
+	PayoutsEnabled   bool      `json:"payouts_enabled"` // Can be paid out to a bank
+	DetailsSubmitted bool      `json:"details_submitted"`
+	CreatedOn        time.Time `json:"created_on"`
+	UpdatedOn        time.Time `json:"updated_on"`
+}
+
+// PayoutAccountLink is a single-use page where an organizer finishes
+// setting up their payout account
+type PayoutAccountLink struct {
+	URL       string    `json:"url"`
+	ExpiresOn time.Time `json:"expires_on"`
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Payment Tests
+// ============================================================================
+
+func TestEvent_RefundableAt(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		policy string
+		before time.Duration
+		want   bool
+	}{
+		{"", time.Hour, true},
+		{RefundPolicyFull, time.Hour, true},
+		{RefundPolicyFull, -time.Minute, false},
+		{RefundPolicyDayBefore, 25 * time.Hour, true},
+		{RefundPolicyDayBefore, 23 * time.Hour, false},
+		{RefundPolicyNone, 30 * 24 * time.Hour, false},
+	}
+	for _, tt := range tests {
+		event := &Event{StartTime: start, RefundPolicy: tt.policy}
+		if got := event.RefundableAt(start.Add(-tt.before)); got != tt.want {
+			t.Errorf("policy %q, %v before: expected %v, got %v", tt.policy, tt.before, tt.want, got)
+		}
+	}
+}
+
+func TestCreateEventRequest_ValidatePaid(t *testing.T) {
+	t.Parallel()
+
+	capacity := 20
+	base := func() CreateEventRequest {
+		return CreateEventRequest{
+			Title:        "Game night",
+			StartTime:    time.Now().Add(48 * time.Hour),
+			MaxAttendees: &capacity,
+			Ticketed:     true,
+			TicketPrice:  1500,
+			Currency:     "usd",
+		}
+	}
+
+	valid := base()
+	if errs := valid.Validate(); len(errs) != 0 {
+		t.Errorf("expected a paid ticketed event to pass, got %v", errs)
+	}
+
+	unticketed := base()
+	unticketed.Ticketed = false
+	noCurrency := base()
+	noCurrency.Currency = ""
+	badPolicy := base()
+	badPolicy.RefundPolicy = "sometimes"
+	for name, req := range map[string]CreateEventRequest{"ticketed": unticketed, "currency": noCurrency, "refund_policy": badPolicy} {
+		errs := req.Validate()
+		if len(errs) != 1 || errs[0].Field != name {
+			t.Errorf("expected %s to fail, got %v", name, errs)
+		}
+	}
+}
+
+func TestUpdateEventRequest_ValidateForPaid(t *testing.T) {
+	t.Parallel()
+
+	capacity := 20
+	no := false
+	price := 1500
+	paid := &Event{Ticketed: true, MaxAttendees: &capacity, TicketPrice: 1500, Currency: "usd"}
+
+	if errs := (&UpdateEventRequest{Ticketed: &no}).ValidateFor(paid); len(errs) != 1 || errs[0].Field != "ticketed" {
+		t.Errorf("expected unticketing a paid event to fail, got %v", errs)
+	}
+	free := &Event{Ticketed: true, MaxAttendees: &capacity}
+	if errs := (&UpdateEventRequest{TicketPrice: &price}).ValidateFor(free); len(errs) != 1 || errs[0].Field != "currency" {
+		t.Errorf("expected pricing without a currency to fail, got %v", errs)
+	}
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// Permission is something a guild member may be allowed to do
+type Permission string
+
+const (
+	PermissionCreateEvents      Permission = "create_events"      // Host events for the guild
+	PermissionManagePools       Permission = "manage_pools"       // Create, edit and delete matching pools
+	PermissionStartVotes        Permission = "start_votes"        // Start guild votes
+	PermissionSendAnnouncements Permission = "send_announcements" // Compose and send guild announcements
+	PermissionManageInvites     Permission = "manage_invites"     // Create and revoke invite codes
+	PermissionManageRoles       Permission = "manage_roles"       // Define custom roles and assign them
+)
+
+// AllPermissions lists every guild permission
+var AllPermissions = []Permission{
+	PermissionCreateEvents,
+	PermissionManagePools,
+	PermissionStartVotes,
+	PermissionSendAnnouncements,
+	PermissionManageInvites,
+	PermissionManageRoles,
+}
+
+// IsValid checks if the permission is known
+func (p Permission) IsValid() bool {
+	return slices.Contains(AllPermissions, p)
+}
+
+// Custom role constraints
+const (
+	MaxCustomRolesPerGuild  = 20
+	MaxCustomRolesPerMember = 5
+	MaxCustomRoleNameLength = 50
+	MaxCustomRoleDescLength = 200
+)
+
+// DefaultPermissions returns what a built-in guild role may do. Members can
+// host events, moderators also run pools and votes, and admins can do
+// everything.
+func (r GuildRole) DefaultPermissions() []Permission {
+	switch r {
+	case GuildRoleAdmin:
+		return slices.Clone(AllPermissions)
+	case GuildRoleModerator:
+		return []Permission{PermissionCreateEvents, PermissionManagePools, PermissionStartVotes}
+	case GuildRoleMember:
+		return []Permission{PermissionCreateEvents}
+	default:
+		return nil
+	}
+}
+
+// GuildCustomRole is a role a guild defines on top of member, moderator and
+// admin, such as "organizer". Members holding it gain its permissions in
+// addition to their built-in role's.
+type GuildCustomRole struct {
+	ID          string       `json:"id"`
+	GuildID     string       `json:"guild_id"`
+	Name        string       `json:"name"`
+	Description *string      `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions"`
+	CreatedBy   string       `json:"created_by"`
+	CreatedOn   time.Time    `json:"created_on"`
+	UpdatedOn   time.Time    `json:"updated_on"`
+}
+
+// GuildPermissions is what a member may do in a guild and where it comes
+// from
+type GuildPermissions struct {
+	GuildID     string            `json:"guild_id"`
+	Role        GuildRole         `json:"role"`
+	CustomRoles []GuildCustomRole `json:"custom_roles"`
+	Permissions []Permission      `json:"permissions"`
+}
+
+// Has reports whether the member holds the permission
+func (p *GuildPermissions) Has(perm Permission) bool {
+	return p != nil && slices.Contains(p.Permissions, perm)
+}
+
+// HasAll reports whether the member holds every one of the permissions
+func (p *GuildPermissions) HasAll(perms []Permission) bool {
+	for _, perm := range perms {
+		if !p.Has(perm) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergePermissions combines permission lists, dropping duplicates and
+// keeping AllPermissions' order
+func MergePermissions(lists ...[]Permission) []Permission {
+	merged := make([]Permission, 0, len(AllPermissions))
+	for _, perm := range AllPermissions {
+		for _, list := range lists {
+			if slices.Contains(list, perm) {
+				merged = append(merged, perm)
+				break
+			}
+		}
+	}
+	return merged
+}
+
+// CreateGuildCustomRoleRequest represents a request to define a custom role
+type CreateGuildCustomRoleRequest struct {
+	Name        string       `json:"name"`
+	Description *string      `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// Validate validates the create custom role request
+func (r *CreateGuildCustomRoleRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("name", r.Name).Required().MaxLength(MaxCustomRoleNameLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxCustomRoleDescLength)
+	v.Check("name", !isBuiltInRoleName(r.Name), "name is reserved for a built-in role")
+	validatePermissionList(&v, r.Permissions)
+
+	return v.Errors()
+}
+
+// UpdateGuildCustomRoleRequest represents a request to change a custom role
+type UpdateGuildCustomRoleRequest struct {
+	Name        *string      `json:"name,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions,omitempty"` // Replaces the role's permissions when set
+}
+
+// Validate validates the update custom role request
+func (r *UpdateGuildCustomRoleRequest) Validate() []FieldError {
+	var v Validator
+
+	v.OptionalString("name", r.Name).NotEmpty().MaxLength(MaxCustomRoleNameLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxCustomRoleDescLength)
+	v.Check("name", r.Name == nil || !isBuiltInRoleName(*r.Name), "name is reserved for a built-in role")
+	if r.Permissions != nil {
+		validatePermissionList(&v, r.Permissions)
+	}
+
+	return v.Errors()
+}
+
+// isBuiltInRoleName keeps custom roles from being mistaken for member,
+// moderator or admin
+func isBuiltInRoleName(name string) bool {
+	return GuildRole(strings.ToLower(strings.TrimSpace(name))).IsValid()
+}
+
+func validatePermissionList(v *Validator, perms []Permission) {
+	v.Check("permissions", len(perms) > 0, "at least one permission is required")
+	for _, perm := range perms {
+		if !perm.IsValid() {
+			v.Fail("permissions", "unknown permission: "+string(perm))
+		}
+	}
+}
@@ -4,8 +4,8 @@ import "time"
 
 // MatchingPool represents a Donut-style matching pool within a guild
 type MatchingPool struct {
-	ID                 string     `json:"id"`
-	GuildID            string     `json:"guild_id"`
+	ID                 PoolID     `json:"id"`
+	GuildID            GuildID    `json:"guild_id"`
 	Name               string     `json:"name"`
 	Description        *string    `json:"description,omitempty"`
 	Frequency          string     `json:"frequency"`  // weekly, biweekly, monthly
@@ -14,7 +14,8 @@ type MatchingPool struct {
 	NextMatchOn        time.Time  `json:"next_match_on"`
 	LastMatchOn        *time.Time `json:"last_match_on,omitempty"`
 	Active             bool       `json:"active"`
-	CreatedBy          string     `json:"created_by"` // Member ID
+	MinTier            *string    `json:"min_tier,omitempty"` // Guild tier needed to join; higher tiers qualify too
+	CreatedBy          UserID     `json:"created_by"`
 	CreatedOn          time.Time  `json:"created_on"`
 	UpdatedOn          time.Time  `json:"updated_on"`
 	// Computed fields
@@ -44,23 +45,23 @@ func GetNextMatchDate(frequency string, from time.Time) time.Time {
 
 // PoolMember represents a member's participation in a matching pool
 type PoolMember struct {
-	ID              string    `json:"id"`
-	PoolID          string    `json:"pool_id"`
-	MemberID        string    `json:"member_id"`
-	UserID          string    `json:"user_id"` // For easier querying
-	Active          bool      `json:"active"`
-	ExcludedMembers []string  `json:"excluded_members,omitempty"` // Member IDs to never match with
-	JoinedOn        time.Time `json:"joined_on"`
+	ID              PoolMemberID `json:"id"`
+	PoolID          PoolID       `json:"pool_id"`
+	MemberID        MemberID     `json:"member_id"`
+	UserID          UserID       `json:"user_id"` // For easier querying
+	Active          bool         `json:"active"`
+	ExcludedMembers []MemberID   `json:"excluded_members,omitempty"` // Never matched with
+	JoinedOn        time.Time    `json:"joined_on"`
 	// Populated fields
 	MemberName *string `json:"member_name,omitempty"`
 }
 
 // MatchResult represents a generated match from the pool
 type MatchResult struct {
-	ID             string     `json:"id"`
-	PoolID         string     `json:"pool_id"`
-	Members        []string   `json:"members"`                   // Member IDs
-	MemberUserIDs  []string   `json:"member_user_ids"`           // User IDs for notifications
+	ID             MatchID    `json:"id"`
+	PoolID         PoolID     `json:"pool_id"`
+	Members        []MemberID `json:"members"`
+	MemberUserIDs  []UserID   `json:"member_user_ids"`           // For notifications
 	Status         string     `json:"status"`                    // pending, scheduled, completed, skipped
 	MatchRound     string     `json:"match_round"`               // e.g., "2026-W02"
 	ScheduledEvent *string    `json:"scheduled_event,omitempty"` // Event ID if created
@@ -88,15 +89,15 @@ type PoolWithMembers struct {
 
 // PoolMatchHistory shows recent matches for a user
 type PoolMatchHistory struct {
-	UserID        string        `json:"user_id"`
+	UserID        UserID        `json:"user_id"`
 	RecentMatches []MatchResult `json:"recent_matches"`
 	// Maps member_id -> count of matches in last 30 days (for variety scoring)
-	MatchCounts map[string]int `json:"match_counts,omitempty"`
+	MatchCounts map[MemberID]int `json:"match_counts,omitempty"`
 }
 
 // PoolStats provides statistics for a pool
 type PoolStats struct {
-	PoolID           string  `json:"pool_id"`
+	PoolID           PoolID  `json:"pool_id"`
 	TotalMembers     int     `json:"total_members"`
 	ActiveMembers    int     `json:"active_members"`
 	TotalRounds      int     `json:"total_rounds"`
@@ -124,6 +125,7 @@ type CreatePoolRequest struct {
 	Frequency          string  `json:"frequency"`
 	MatchSize          int     `json:"match_size,omitempty"` // Default: 2
 	ActivitySuggestion *string `json:"activity_suggestion,omitempty"`
+	MinTier            *string `json:"min_tier,omitempty"`
 }
 
 // UpdatePoolRequest represents a request to update a pool
@@ -134,17 +136,18 @@ type UpdatePoolRequest struct {
 	MatchSize          *int    `json:"match_size,omitempty"`
 	ActivitySuggestion *string `json:"activity_suggestion,omitempty"`
 	Active             *bool   `json:"active,omitempty"`
+	MinTier            *string `json:"min_tier,omitempty"` // Empty opens the pool to every member
 }
 
 // JoinPoolRequest represents a request to join a pool
 type JoinPoolRequest struct {
-	ExcludedMembers []string `json:"excluded_members,omitempty"`
+	ExcludedMembers []MemberID `json:"excluded_members,omitempty"`
 }
 
 // UpdateMembershipRequest represents updating pool membership settings
 type UpdateMembershipRequest struct {
-	Active          *bool    `json:"active,omitempty"`
-	ExcludedMembers []string `json:"excluded_members,omitempty"`
+	Active          *bool      `json:"active,omitempty"`
+	ExcludedMembers []MemberID `json:"excluded_members,omitempty"`
 }
 
 // UpdateMatchRequest represents updating a match result
@@ -155,10 +158,11 @@ type UpdateMatchRequest struct {
 
 // MatchRoundInfo provides info about a matching round
 type MatchRoundInfo struct {
-	PoolID     string        `json:"pool_id"`
+	PoolID     PoolID        `json:"pool_id"`
 	PoolName   string        `json:"pool_name"`
 	Round      string        `json:"round"` // e.g., "2026-W02"
 	RanOn      time.Time     `json:"ran_on"`
+	Seed       uint64        `json:"seed,string"` // Reproduces the round's shuffle
 	MatchCount int           `json:"match_count"`
 	Matches    []MatchResult `json:"matches"`
 }
@@ -167,9 +171,9 @@ type MatchRoundInfo struct {
 type PendingMatch struct {
 	Match        MatchResult `json:"match"`
 	PoolName     string      `json:"pool_name"`
-	GuildID      string      `json:"guild_id"`
+	GuildID      GuildID     `json:"guild_id"`
 	GuildName    string      `json:"guild_name"`
-	PartnerIDs   []string    `json:"partner_ids"`   // Other member IDs in the match
+	PartnerIDs   []MemberID  `json:"partner_ids"`   // Other members in the match
 	PartnerNames []string    `json:"partner_names"` // Other member names
 	Suggestion   *string     `json:"suggestion,omitempty"`
 	DueBy        *time.Time  `json:"due_by,omitempty"` // When next round happens
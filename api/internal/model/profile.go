@@ -1,6 +1,9 @@
 package model
 
-import "time"
+import (
+	"net/url"
+	"time"
+)
 
 // UserProfile represents extended profile information for a user
 type UserProfile struct {
@@ -8,14 +11,24 @@ type UserProfile struct {
 	UserID     string     `json:"user_id"`
 	Bio        *string    `json:"bio,omitempty"`
 	Tagline    *string    `json:"tagline,omitempty"`
+	PhotoURL   *string    `json:"photo_url,omitempty"`
 	Languages  []string   `json:"languages,omitempty"`
 	Timezone   *string    `json:"timezone,omitempty"`
 	Location   *Location  `json:"location,omitempty"`
 	Visibility string     `json:"visibility"` // guilds, public, private
 	LastActive *time.Time `json:"last_active,omitempty"`
+	Version    int        `json:"version"` // Incremented on every profile edit
 	CreatedOn  time.Time  `json:"created_on"`
 	UpdatedOn  time.Time  `json:"updated_on"`
 
+	// Opt-in to unauthenticated share links (still hidden when private)
+	ShareEnabled bool `json:"share_enabled"`
+
+	// Accessibility needs from AccessibilityFeatures. Event discovery matches
+	// on them server-side; other users only see them with ShareAccessibility.
+	AccessibilityNeeds []string `json:"accessibility_needs,omitempty"`
+	ShareAccessibility bool     `json:"share_accessibility"`
+
 	// Discovery eligibility tracking
 	// User must answer 3+ questions from required categories (values, social, lifestyle, communication)
 	DiscoveryEligible      bool     `json:"discovery_eligible"`
@@ -30,26 +43,71 @@ type UserProfile struct {
 
 // ToPublic converts a UserProfile to its privacy-respecting public representation
 func (p *UserProfile) ToPublic() *PublicProfile {
+	return p.ToPublicFor(ProfileAudienceMember)
+}
+
+// ToPublicFor serializes the profile for the given audience. Callers must check
+// VisibleTo first; this only decides which fields the audience may see.
+func (p *UserProfile) ToPublicFor(audience ProfileAudience) *PublicProfile {
 	pub := &PublicProfile{
 		UserID:            p.UserID,
 		Username:          p.Username,
 		Firstname:         p.Firstname,
 		Bio:               p.Bio,
 		Tagline:           p.Tagline,
+		PhotoURL:          p.PhotoURL,
 		Languages:         p.Languages,
 		DiscoveryEligible: p.DiscoveryEligible,
 	}
 
+	// Share links are readable by anyone on the internet: country only and no
+	// activity status
+	if audience == ProfileAudienceAnonymous {
+		if p.Location != nil {
+			pub.Country = p.Location.Country
+		}
+		return pub
+	}
+
 	if p.Location != nil {
 		pub.City = p.Location.City
 		pub.Country = p.Location.Country
 	}
+	if p.ShareAccessibility {
+		pub.AccessibilityNeeds = p.AccessibilityNeeds
+	}
 
 	pub.ActivityStatus = GetActivityStatus(p.LastActive)
 
 	return pub
 }
 
+// VisibleTo reports whether the audience may see the profile at all
+func (p *UserProfile) VisibleTo(audience ProfileAudience) bool {
+	switch audience {
+	case ProfileAudienceSelf:
+		return true
+	case ProfileAudienceGuild:
+		return p.Visibility == VisibilityGuilds || p.Visibility == VisibilityPublic
+	case ProfileAudienceMember:
+		return p.Visibility == VisibilityPublic
+	case ProfileAudienceAnonymous:
+		return p.ShareEnabled && p.Visibility != VisibilityPrivate
+	default:
+		return false
+	}
+}
+
+// ProfileAudience is who a profile is being shown to, from closest to furthest
+type ProfileAudience string
+
+const (
+	ProfileAudienceSelf      ProfileAudience = "self"      // The profile owner
+	ProfileAudienceGuild     ProfileAudience = "guild"     // Shares at least one guild with the owner
+	ProfileAudienceMember    ProfileAudience = "member"    // Any signed-in user
+	ProfileAudienceAnonymous ProfileAudience = "anonymous" // Unauthenticated share link
+)
+
 // Location stores geographic information with privacy controls
 // IMPORTANT: lat/lng are stored internally but NEVER exposed to other users
 type Location struct {
@@ -145,6 +203,23 @@ const (
 	FreshnessBucketAway           FreshnessBucket = "away"
 )
 
+// GetFreshnessBucket groups a last active time into the coarser buckets
+// shown in discovery
+func GetFreshnessBucket(lastActive *time.Time) FreshnessBucket {
+	switch GetActivityStatus(lastActive) {
+	case ActivityStatusNow:
+		return FreshnessBucketActiveNow
+	case ActivityStatusRecently, ActivityStatusThisHour:
+		return FreshnessBucketActiveRecently
+	case ActivityStatusToday:
+		return FreshnessBucketActiveToday
+	case ActivityStatusYesterday, ActivityStatusThisWeek:
+		return FreshnessBucketActiveThisWeek
+	default:
+		return FreshnessBucketAway
+	}
+}
+
 // GetDistanceBucket converts exact distance to privacy-preserving bucket
 func GetDistanceBucket(distanceKm float64) DistanceBucket {
 	switch {
@@ -168,6 +243,7 @@ type PublicProfile struct {
 	Firstname         *string        `json:"firstname,omitempty"`
 	Bio               *string        `json:"bio,omitempty"`
 	Tagline           *string        `json:"tagline,omitempty"`
+	PhotoURL          *string        `json:"photo_url,omitempty"`
 	Languages         []string       `json:"languages,omitempty"`
 	City              string         `json:"city,omitempty"`
 	Country           string         `json:"country,omitempty"`
@@ -175,6 +251,52 @@ type PublicProfile struct {
 	ActivityStatus    ActivityStatus `json:"activity_status,omitempty"`
 	Compatibility     *float64       `json:"compatibility,omitempty"` // 0-100% if calculated
 	DiscoveryEligible bool           `json:"discovery_eligible"`      // Eligible for discovery
+
+	// Only when the owner shares them, and never on share links
+	AccessibilityNeeds []string `json:"accessibility_needs,omitempty"`
+
+	// Optional populated fields (single-profile views only)
+	Reputation *ReputationDisplay `json:"reputation,omitempty"`
+	Badges     []ProfileBadge     `json:"badges,omitempty"`
+}
+
+// ProfileBadge is a positive review tag a user has been given often enough to
+// show on their profile
+type ProfileBadge struct {
+	Tag   string `json:"tag"`
+	Label string `json:"label"`
+	Icon  string `json:"icon,omitempty"`
+	Count int    `json:"count"`
+}
+
+// MinBadgeTagCount is how many times a positive tag must be given before it
+// shows as a badge
+const MinBadgeTagCount = 3
+
+// BadgesFromReputation derives profile badges from a reputation's top tags
+func BadgesFromReputation(display *ReputationDisplay) []ProfileBadge {
+	if display == nil {
+		return nil
+	}
+
+	icons := make(map[string]string)
+	for _, info := range GetPositiveTags() {
+		icons[info.Tag] = info.Icon
+	}
+
+	var badges []ProfileBadge
+	for _, tc := range display.TopTags {
+		if tc.Count < MinBadgeTagCount {
+			continue
+		}
+		badges = append(badges, ProfileBadge{
+			Tag:   tc.Tag,
+			Label: tc.Label,
+			Icon:  icons[tc.Tag],
+			Count: tc.Count,
+		})
+	}
+	return badges
 }
 
 // IsEligibleForDiscovery checks if a user profile meets discovery requirements
@@ -209,11 +331,22 @@ const (
 
 // Profile constraints
 const (
-	MaxBioLength     = 500
-	MaxTaglineLength = 100
-	MaxLanguages     = 10
+	MaxBioLength      = 500
+	MaxTaglineLength  = 100
+	MaxLanguages      = 10
+	MaxPhotoURLLength = 2048
 )
 
+// IsValidPhotoURL reports whether raw is an absolute https URL short enough
+// to store as a profile photo
+func IsValidPhotoURL(raw string) bool {
+	if raw == "" || len(raw) > MaxPhotoURLLength {
+		return false
+	}
+	u, err := url.Parse(raw)
+	return err == nil && u.Scheme == "https" && u.Host != ""
+}
+
 // RequiredQuestionCategories lists the question categories required for discovery eligibility.
 var RequiredQuestionCategories = []string{
 	"values",
@@ -227,12 +360,13 @@ const MinQuestionsForEligibility = 3
 
 // CreateProfileRequest represents a request to create/update a profile
 type CreateProfileRequest struct {
-	Bio        *string          `json:"bio,omitempty"`
-	Tagline    *string          `json:"tagline,omitempty"`
-	Languages  []string         `json:"languages,omitempty"`
-	Timezone   *string          `json:"timezone,omitempty"`
-	Location   *LocationRequest `json:"location,omitempty"`
-	Visibility *string          `json:"visibility,omitempty"`
+	Bio          *string          `json:"bio,omitempty"`
+	Tagline      *string          `json:"tagline,omitempty"`
+	Languages    []string         `json:"languages,omitempty"`
+	Timezone     *string          `json:"timezone,omitempty"`
+	Location     *LocationRequest `json:"location,omitempty"`
+	Visibility   *string          `json:"visibility,omitempty"`
+	ShareEnabled *bool            `json:"share_enabled,omitempty"`
 }
 
 // LocationRequest is used when user updates their location
@@ -247,10 +381,18 @@ type LocationRequest struct {
 
 // UpdateProfileRequest represents a request to partially update a profile
 type UpdateProfileRequest struct {
-	Bio        *string          `json:"bio,omitempty"`
-	Tagline    *string          `json:"tagline,omitempty"`
-	Languages  []string         `json:"languages,omitempty"`
-	Timezone   *string          `json:"timezone,omitempty"`
-	Location   *LocationRequest `json:"location,omitempty"`
-	Visibility *string          `json:"visibility,omitempty"`
+	Bio          *string          `json:"bio,omitempty"`
+	Tagline      *string          `json:"tagline,omitempty"`
+	PhotoURL     *string          `json:"photo_url,omitempty"` // https URL of an uploaded photo
+	Languages    []string         `json:"languages,omitempty"`
+	Timezone     *string          `json:"timezone,omitempty"`
+	Location     *LocationRequest `json:"location,omitempty"`
+	Visibility   *string          `json:"visibility,omitempty"`
+	ShareEnabled *bool            `json:"share_enabled,omitempty"`
+	Version      *int             `json:"version,omitempty"` // Version the edit is based on; rejected if stale
+
+	AccessibilityNeeds []string `json:"accessibility_needs,omitempty"` // Replaces the list; null clears it
+	ShareAccessibility *bool    `json:"share_accessibility,omitempty"`
+
+	Clear ClearedFields `json:"-"` // Fields the PATCH set to null
 }
@@ -0,0 +1,152 @@
+package model
+
+import "math"
+
+// CompletenessItem is a part of a profile that counts toward its
+// completeness score
+type CompletenessItem string
+
+const (
+	CompletenessPhoto         CompletenessItem = "photo"
+	CompletenessBio           CompletenessItem = "bio"
+	CompletenessInterests     CompletenessItem = "interests"
+	CompletenessQuestionnaire CompletenessItem = "questionnaire"
+	CompletenessAvailability  CompletenessItem = "availability"
+)
+
+// Profile completeness constants
+const (
+	DiscoveryMinCompleteness   = 60 // Score needed before discovery opens up
+	CompletenessInterestTarget = 3  // Interests needed for full interest credit
+)
+
+// CompletenessWeights is how many of the 100 points each item is worth
+var CompletenessWeights = map[CompletenessItem]int{
+	CompletenessPhoto:         20,
+	CompletenessBio:           20,
+	CompletenessInterests:     20,
+	CompletenessQuestionnaire: 25,
+	CompletenessAvailability:  15,
+}
+
+// completenessOrder fixes the order items are reported in, and breaks ties
+// when picking the next step
+var completenessOrder = []CompletenessItem{
+	CompletenessPhoto,
+	CompletenessBio,
+	CompletenessInterests,
+	CompletenessQuestionnaire,
+	CompletenessAvailability,
+}
+
+// CompletenessPrompts tell the user how to fill in each item
+var CompletenessPrompts = map[CompletenessItem]string{
+	CompletenessPhoto:         "Add a profile photo so people recognize you.",
+	CompletenessBio:           "Write a short bio so people know what you're about.",
+	CompletenessInterests:     "Add a few interests to find people who share them.",
+	CompletenessQuestionnaire: "Answer questions from each category to improve your matches.",
+	CompletenessAvailability:  "Post when you're free so people can join you.",
+}
+
+// CompletenessInput is what a profile's completeness is computed from
+type CompletenessInput struct {
+	HasPhoto            bool
+	HasBio              bool
+	InterestCount       int
+	QuestionsAnswered   int
+	MissingCategories   int // Required question categories with no answer yet
+	HasOpenAvailability bool
+}
+
+// CompletenessItemStatus reports how far along one item is
+type CompletenessItemStatus struct {
+	Item     CompletenessItem `json:"item"`
+	Weight   int              `json:"weight"`
+	Progress float64          `json:"progress"` // 0-1
+	Complete bool             `json:"complete"`
+}
+
+// CompletenessNextStep is the missing item worth the most points
+type CompletenessNextStep struct {
+	Item   CompletenessItem `json:"item"`
+	Prompt string           `json:"prompt"`
+	Gain   int              `json:"gain"` // Points gained by completing it
+}
+
+// ProfileCompleteness scores how filled-in a profile is, out of 100.
+// Discovery stays locked until the score reaches DiscoveryThreshold.
+type ProfileCompleteness struct {
+	Score              int                      `json:"score"`
+	DiscoveryThreshold int                      `json:"discovery_threshold"`
+	DiscoveryUnlocked  bool                     `json:"discovery_unlocked"`
+	Items              []CompletenessItemStatus `json:"items"`
+	NextStep           *CompletenessNextStep    `json:"next_step,omitempty"`
+}
+
+// NewProfileCompleteness scores a profile from its inputs
+func NewProfileCompleteness(in CompletenessInput) *ProfileCompleteness {
+	progress := map[CompletenessItem]float64{
+		CompletenessPhoto:         boolProgress(in.HasPhoto),
+		CompletenessBio:           boolProgress(in.HasBio),
+		CompletenessInterests:     fractionProgress(in.InterestCount, CompletenessInterestTarget),
+		CompletenessQuestionnaire: questionnaireProgress(in.QuestionsAnswered, in.MissingCategories),
+		CompletenessAvailability:  boolProgress(in.HasOpenAvailability),
+	}
+
+	c := &ProfileCompleteness{
+		DiscoveryThreshold: DiscoveryMinCompleteness,
+		Items:              make([]CompletenessItemStatus, 0, len(completenessOrder)),
+	}
+	var points float64
+	for _, item := range completenessOrder {
+		weight := CompletenessWeights[item]
+		p := progress[item]
+		points += float64(weight) * p
+		c.Items = append(c.Items, CompletenessItemStatus{
+			Item:     item,
+			Weight:   weight,
+			Progress: p,
+			Complete: p >= 1,
+		})
+
+		gain := int(math.Round(float64(weight) * (1 - p)))
+		if gain > 0 && (c.NextStep == nil || gain > c.NextStep.Gain) {
+			c.NextStep = &CompletenessNextStep{
+				Item:   item,
+				Prompt: CompletenessPrompts[item],
+				Gain:   gain,
+			}
+		}
+	}
+
+	c.Score = int(math.Round(points))
+	c.DiscoveryUnlocked = c.Score >= DiscoveryMinCompleteness
+	return c
+}
+
+func boolProgress(done bool) float64 {
+	if done {
+		return 1
+	}
+	return 0
+}
+
+// questionnaireProgress counts the answers still needed to meet
+// MinQuestionsForDiscovery with every required category covered
+func questionnaireProgress(answered, missingCategories int) float64 {
+	needed := MinQuestionsForDiscovery - answered
+	if missingCategories > needed {
+		needed = missingCategories
+	}
+	return fractionProgress(MinQuestionsForDiscovery-needed, MinQuestionsForDiscovery)
+}
+
+func fractionProgress(have, want int) float64 {
+	if want <= 0 || have >= want {
+		return 1
+	}
+	if have <= 0 {
+		return 0
+	}
+	return float64(have) / float64(want)
+}
@@ -0,0 +1,212 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// PromoCode is a code an organizer hands out for a discount on a paid
+// event's tickets. Redemptions are reserved when a guest checks out with
+// the code and given back if their checkout expires.
+type PromoCode struct {
+	ID             string     `json:"id"`
+	EventID        string     `json:"event_id"`
+	Code           string     `json:"code"`          // Upper case; matched case-insensitively
+	DiscountType   string     `json:"discount_type"` // percent, fixed
+	Amount         int        `json:"amount"`        // Percent off, or minor units off the ticket price
+	MaxRedemptions *int       `json:"max_redemptions,omitempty"`
+	Redemptions    int        `json:"redemptions"`
+	ExpiresOn      *time.Time `json:"expires_on,omitempty"`
+	Active         bool       `json:"active"`
+	CreatedBy      string     `json:"created_by"`
+	CreatedOn      time.Time  `json:"created_on"`
+	UpdatedOn      time.Time  `json:"updated_on"`
+}
+
+// DiscountType constants
+const (
+	DiscountTypePercent = "percent" // Amount is percent off
+	DiscountTypeFixed   = "fixed"   // Amount is minor units off
+)
+
+// DiscountTypes lists the valid discount types
+var DiscountTypes = []string{DiscountTypePercent, DiscountTypeFixed}
+
+// DiscountSource constants: where a payment's discount came from
+const (
+	DiscountSourcePromoCode = "promo_code"
+	DiscountSourceMember    = "member"
+)
+
+// MinimumCharge is the smallest amount, in minor units, the payment
+// provider takes. A discount leaving less makes the ticket free.
+const MinimumCharge = 50
+
+// MaxPromoCodesPerEvent caps how many codes an event can have
+const MaxPromoCodesPerEvent = 100
+
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{2,31}$`)
+
+// NormalizePromoCode returns a code as it is stored and looked up
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// UsableAt reports whether the code can be redeemed at now
+func (p *PromoCode) UsableAt(now time.Time) bool {
+	if !p.Active {
+		return false
+	}
+	if p.ExpiresOn != nil && !now.Before(*p.ExpiresOn) {
+		return false
+	}
+	return p.MaxRedemptions == nil || p.Redemptions < *p.MaxRedemptions
+}
+
+// DiscountOn returns how much the code takes off price
+func (p *PromoCode) DiscountOn(price int) int {
+	if p.DiscountType == DiscountTypePercent {
+		return percentOf(price, p.Amount)
+	}
+	return min(p.Amount, price)
+}
+
+// MemberDiscount takes a percentage off a guild event's tickets for guild
+// members holding at least Role
+type MemberDiscount struct {
+	Role       GuildRole `json:"role"`
+	PercentOff int       `json:"percent_off"`
+}
+
+// MemberDiscountFor returns how much a guild member with role gets off the
+// event's ticket price: the best of the discounts their role qualifies for
+func (e *Event) MemberDiscountFor(role GuildRole) int {
+	best := 0
+	for _, d := range e.MemberDiscounts {
+		if role.AtLeast(d.Role) && d.PercentOff > best {
+			best = d.PercentOff
+		}
+	}
+	return percentOf(e.TicketPrice, best)
+}
+
+// DiscountedPrice applies a discount to price. Prices the payment provider
+// can't charge become free.
+func DiscountedPrice(price, discount int) int {
+	amount := price - discount
+	if amount < MinimumCharge {
+		return 0
+	}
+	return amount
+}
+
+// percentOf returns percent of amount, rounded to the nearest minor unit
+func percentOf(amount, percent int) int {
+	return (amount*percent + 50) / 100
+}
+
+// CreatePromoCodeRequest represents a request to create a promo code
+type CreatePromoCodeRequest struct {
+	Code           string     `json:"code"`
+	DiscountType   string     `json:"discount_type"`
+	Amount         int        `json:"amount"`
+	MaxRedemptions *int       `json:"max_redemptions,omitempty"`
+	ExpiresOn      *time.Time `json:"expires_on,omitempty"`
+}
+
+// Validate validates the create promo code request
+func (r *CreatePromoCodeRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("code", r.Code).Required()
+	v.Check("code", promoCodePattern.MatchString(NormalizePromoCode(r.Code)),
+		"code must be 3 to 32 letters, digits, dashes, or underscores")
+	v.String("discount_type", r.DiscountType).Required().OneOf(DiscountTypes...)
+	if r.DiscountType == DiscountTypePercent {
+		v.Int("amount", r.Amount).Between(1, 100)
+	} else {
+		v.Int("amount", r.Amount).Min(1)
+	}
+	v.OptionalInt("max_redemptions", r.MaxRedemptions).Min(1)
+
+	return v.Errors()
+}
+
+// UpdatePromoCodeRequest represents a request to update a promo code. The
+// discount itself can't change once guests may have redeemed it.
+type UpdatePromoCodeRequest struct {
+	MaxRedemptions *int       `json:"max_redemptions,omitempty"`
+	ExpiresOn      *time.Time `json:"expires_on,omitempty"`
+	Active         *bool      `json:"active,omitempty"`
+}
+
+// Validate validates the update promo code request
+func (r *UpdatePromoCodeRequest) Validate() []FieldError {
+	var v Validator
+	v.OptionalInt("max_redemptions", r.MaxRedemptions).Min(1)
+	return v.Errors()
+}
+
+// SetMemberDiscountsRequest replaces a guild event's member discounts
+type SetMemberDiscountsRequest struct {
+	Discounts []MemberDiscount `json:"discounts"`
+}
+
+// Validate validates the set member discounts request
+func (r *SetMemberDiscountsRequest) Validate() []FieldError {
+	var v Validator
+
+	seen := make(map[GuildRole]bool)
+	for _, d := range r.Discounts {
+		v.Check("discounts", d.Role.IsValid(), "discounts role must be member, moderator, or admin")
+		v.Check("discounts", !seen[d.Role], "discounts may have one discount per role")
+		v.Int("discounts", d.PercentOff).Between(1, 100)
+		seen[d.Role] = true
+	}
+
+	return v.Errors()
+}
+
+// CheckoutRequest optionally applies a promo code to a checkout
+type CheckoutRequest struct {
+	PromoCode string `json:"promo_code,omitempty"`
+}
+
+// DiscountReport sums an event's ticket sales by discount
+type DiscountReport struct {
+	EventID     string             `json:"event_id"`
+	Currency    string             `json:"currency"`
+	Sales       int                `json:"sales"`   // Tickets paid for, refunded or not
+	Revenue     int                `json:"revenue"` // Taken, excluding refunded sales
+	PromoCodes  []*PromoCodeReport `json:"promo_codes"`
+	Member      DiscountTotals     `json:"member"`
+	Redemptions []*PromoRedemption `json:"redemptions"`
+}
+
+// PromoCodeReport sums one promo code's redemptions
+type PromoCodeReport struct {
+	PromoCodeID string `json:"promo_code_id"`
+	Code        string `json:"code"`
+	DiscountTotals
+}
+
+// DiscountTotals sums the sales one kind of discount applied to
+type DiscountTotals struct {
+	Redemptions   int `json:"redemptions"`
+	Refunded      int `json:"refunded"`
+	DiscountTotal int `json:"discount_total"` // Given up, excluding refunded sales
+	Revenue       int `json:"revenue"`        // Taken, excluding refunded sales
+}
+
+// PromoRedemption is one discounted sale
+type PromoRedemption struct {
+	PaymentID      string     `json:"payment_id"`
+	UserID         string     `json:"user_id"`
+	PromoCodeID    *string    `json:"promo_code_id,omitempty"`
+	DiscountSource string     `json:"discount_source"`
+	Amount         int        `json:"amount"`
+	Discount       int        `json:"discount"`
+	Status         string     `json:"status"` // paid, refunded
+	PaidOn         *time.Time `json:"paid_on,omitempty"`
+}
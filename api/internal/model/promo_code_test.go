@@ -0,0 +1,120 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Promo Code Tests
+// ============================================================================
+
+func TestPromoCode_DiscountOn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		promo PromoCode
+		price int
+		want  int
+	}{
+		{"percent", PromoCode{DiscountType: DiscountTypePercent, Amount: 25}, 1500, 375},
+		{"percent rounds", PromoCode{DiscountType: DiscountTypePercent, Amount: 33}, 1999, 660},
+		{"fixed", PromoCode{DiscountType: DiscountTypeFixed, Amount: 500}, 1500, 500},
+		{"fixed above price", PromoCode{DiscountType: DiscountTypeFixed, Amount: 5000}, 1500, 1500},
+	}
+	for _, tt := range tests {
+		if got := tt.promo.DiscountOn(tt.price); got != tt.want {
+			t.Errorf("%s: expected %d, got %d", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestPromoCode_UsableAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	limit := 2
+
+	tests := []struct {
+		name  string
+		promo PromoCode
+		want  bool
+	}{
+		{"active", PromoCode{Active: true}, true},
+		{"inactive", PromoCode{}, false},
+		{"before expiry", PromoCode{Active: true, ExpiresOn: &later}, true},
+		{"at expiry", PromoCode{Active: true, ExpiresOn: &now}, false},
+		{"under cap", PromoCode{Active: true, MaxRedemptions: &limit, Redemptions: 1}, true},
+		{"at cap", PromoCode{Active: true, MaxRedemptions: &limit, Redemptions: 2}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.promo.UsableAt(now); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestEvent_MemberDiscountFor(t *testing.T) {
+	t.Parallel()
+
+	event := &Event{
+		TicketPrice: 2000,
+		MemberDiscounts: []MemberDiscount{
+			{Role: GuildRoleMember, PercentOff: 10},
+			{Role: GuildRoleAdmin, PercentOff: 50},
+		},
+	}
+
+	if got := event.MemberDiscountFor(GuildRoleMember); got != 200 {
+		t.Errorf("member: expected 200, got %d", got)
+	}
+	if got := event.MemberDiscountFor(GuildRoleModerator); got != 200 {
+		t.Errorf("moderator: expected the member discount, got %d", got)
+	}
+	if got := event.MemberDiscountFor(GuildRoleAdmin); got != 1000 {
+		t.Errorf("admin: expected the best discount, got %d", got)
+	}
+}
+
+func TestDiscountedPrice(t *testing.T) {
+	t.Parallel()
+
+	if got := DiscountedPrice(1500, 375); got != 1125 {
+		t.Errorf("expected 1125, got %d", got)
+	}
+	if got := DiscountedPrice(1500, 1470); got != 0 {
+		t.Errorf("expected an uncollectable remainder to be free, got %d", got)
+	}
+}
+
+func TestCreatePromoCodeRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	zero := 0
+	tests := []struct {
+		name  string
+		req   CreatePromoCodeRequest
+		field string
+	}{
+		{"valid", CreatePromoCodeRequest{Code: "spring-25", DiscountType: DiscountTypePercent, Amount: 25}, ""},
+		{"short code", CreatePromoCodeRequest{Code: "AB", DiscountType: DiscountTypeFixed, Amount: 100}, "code"},
+		{"spaces", CreatePromoCodeRequest{Code: "SPRING SALE", DiscountType: DiscountTypeFixed, Amount: 100}, "code"},
+		{"type", CreatePromoCodeRequest{Code: "SPRING", DiscountType: "bogo", Amount: 1}, "discount_type"},
+		{"percent over 100", CreatePromoCodeRequest{Code: "SPRING", DiscountType: DiscountTypePercent, Amount: 150}, "amount"},
+		{"zero cap", CreatePromoCodeRequest{Code: "SPRING", DiscountType: DiscountTypeFixed, Amount: 100, MaxRedemptions: &zero}, "max_redemptions"},
+	}
+	for _, tt := range tests {
+		errs := tt.req.Validate()
+		if tt.field == "" {
+			if len(errs) != 0 {
+				t.Errorf("%s: expected no errors, got %v", tt.name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || errs[0].Field != tt.field {
+			t.Errorf("%s: expected an error on %s, got %v", tt.name, tt.field, errs)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// MaxPublicationScheduleDays is how far ahead an event or vote can be scheduled
+const MaxPublicationScheduleDays = 90
+
+// SchedulePublicationRequest schedules or reschedules when a draft event or
+// vote is published
+type SchedulePublicationRequest struct {
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+// Validate checks if the schedule request is valid
+func (r *SchedulePublicationRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("publish_at", r.PublishAt != nil && !r.PublishAt.IsZero(), "publish_at is required")
+
+	return v.Errors()
+}
@@ -1,6 +1,12 @@
 package model
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
 
 // Question represents a matching question
 type Question struct {
@@ -11,12 +17,26 @@ type Question struct {
 	IsDealBreakerEligible bool             `json:"is_dealbreaker_eligible"`
 	SortOrder             int              `json:"sort_order"`
 	Active                bool             `json:"active"`
-	// Circle-specific question (nil = global question)
-	CircleID  *string   `json:"circle_id,omitempty"`
-	CreatedBy *string   `json:"created_by,omitempty"` // For circle questions
+	DeprecatedOn          *time.Time       `json:"deprecated_on,omitempty"` // Set when retired while still answered
+	// Guild-specific question (nil = global question)
+	GuildID   *string   `json:"guild_id,omitempty"`
+	CreatedBy *string   `json:"created_by,omitempty"` // Member who created a guild question
 	CreatedOn time.Time `json:"created_on"`
 }
 
+// Version returns a short fingerprint of the question text and option values.
+// It changes whenever the wording or the set of options changes, so answers
+// exported against an older version of the question can be detected.
+func (q *Question) Version() string {
+	h := sha256.New()
+	h.Write([]byte(q.Text))
+	for _, opt := range q.Options {
+		h.Write([]byte{0})
+		h.Write([]byte(opt.Value))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
+
 // QuestionOption represents an answer option for a question
 type QuestionOption struct {
 	Value string `json:"value"` // Machine-readable value
@@ -115,6 +135,28 @@ type CompatibilityScore struct {
 	DealBreaker bool    `json:"deal_breaker"` // True if any dealbreaker violated
 }
 
+// MaxBatchCompatibilityUsers limits how many users can be scored in one batch request
+const MaxBatchCompatibilityUsers = 50
+
+// BatchCompatibilityRequest represents a request to score several users at once
+type BatchCompatibilityRequest struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// Validate validates the batch compatibility request
+func (r *BatchCompatibilityRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("user_ids", len(r.UserIDs) > 0, "at least one user ID is required")
+	v.Check("user_ids", len(r.UserIDs) <= MaxBatchCompatibilityUsers,
+		fmt.Sprintf("at most %d user IDs are allowed", MaxBatchCompatibilityUsers))
+	for _, id := range r.UserIDs {
+		v.Check("user_ids", strings.TrimSpace(id) != "", "user IDs must not be empty")
+	}
+
+	return v.Errors()
+}
+
 // CompatibilityBreakdown provides detailed scoring info
 type CompatibilityBreakdown struct {
 	CompatibilityScore
@@ -167,6 +209,15 @@ type AnswerQuestionRequest struct {
 	YikesOptions      []string `json:"yikes_options,omitempty"`    // Red flag answers
 }
 
+// Validate validates the answer question request
+func (r *AnswerQuestionRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("selected_option", r.SelectedOption).Required()
+
+	return v.Errors()
+}
+
 // UpdateAnswerRequest represents a request to update an answer
 type UpdateAnswerRequest struct {
 	SelectedOption    *string  `json:"selected_option,omitempty"`
@@ -177,6 +228,70 @@ type UpdateAnswerRequest struct {
 	YikesOptions      []string `json:"yikes_options,omitempty"`
 }
 
+// Questionnaire export/import constraints
+const (
+	QuestionnaireExportFormatVersion = 1
+	MaxImportAnswers                 = 500
+)
+
+// QuestionnaireExport is a portable snapshot of a user's answers
+type QuestionnaireExport struct {
+	FormatVersion int              `json:"format_version"`
+	ExportedOn    time.Time        `json:"exported_on"`
+	Answers       []ExportedAnswer `json:"answers"`
+}
+
+// ExportedAnswer is a single answer in a questionnaire export
+type ExportedAnswer struct {
+	QuestionID        string   `json:"question_id"`
+	QuestionVersion   string   `json:"question_version,omitempty"` // Question.Version() at export time
+	SelectedOption    string   `json:"selected_option"`
+	AcceptableOptions []string `json:"acceptable_options,omitempty"`
+	Importance        string   `json:"importance,omitempty"`
+	IsDealBreaker     bool     `json:"is_dealbreaker,omitempty"`
+	AlignmentWeight   *float64 `json:"alignment_weight,omitempty"`
+	YikesOptions      []string `json:"yikes_options,omitempty"`
+}
+
+// Validate validates a questionnaire import payload
+func (e *QuestionnaireExport) Validate() []FieldError {
+	var v Validator
+
+	v.Check("format_version", e.FormatVersion == QuestionnaireExportFormatVersion,
+		fmt.Sprintf("unsupported format version (expected %d)", QuestionnaireExportFormatVersion))
+	v.Check("answers", len(e.Answers) > 0, "at least one answer is required")
+	v.Check("answers", len(e.Answers) <= MaxImportAnswers,
+		fmt.Sprintf("at most %d answers can be imported at once", MaxImportAnswers))
+
+	return v.Errors()
+}
+
+// Import skip reasons
+const (
+	ImportSkipDuplicate         = "duplicate"          // Question appears more than once in the payload
+	ImportSkipQuestionNotFound  = "question_not_found" // Question does not exist in this environment
+	ImportSkipQuestionRetired   = "question_retired"   // Question is no longer active
+	ImportSkipQuestionChanged   = "question_changed"   // Question wording or options changed since export
+	ImportSkipInvalidOption     = "invalid_option"
+	ImportSkipInvalidImportance = "invalid_importance"
+	ImportSkipInvalidAlignment  = "invalid_alignment_weight"
+	ImportSkipDealBreaker       = "dealbreaker_not_allowed"
+)
+
+// SkippedAnswer reports an answer that was (or would be) left out of an import
+type SkippedAnswer struct {
+	QuestionID string `json:"question_id"`
+	Reason     string `json:"reason"`
+}
+
+// QuestionnaireImportResult summarizes a questionnaire import
+type QuestionnaireImportResult struct {
+	DryRun  bool            `json:"dry_run"`
+	Created int             `json:"created"` // New answers (would be) created
+	Updated int             `json:"updated"` // Existing answers (would be) overwritten
+	Skipped []SkippedAnswer `json:"skipped"`
+}
+
 // QuestionProgress tracks user's progress in answering questions
 type QuestionProgress struct {
 	TotalQuestions     int            `json:"total_questions"`
@@ -254,14 +369,6 @@ type CreateCircleValuesRequest struct {
 	Required    bool     `json:"required"`
 }
 
-// CreateCircleQuestionRequest represents a request to create a circle-specific question
-type CreateCircleQuestionRequest struct {
-	Text                  string           `json:"text"`
-	Category              string           `json:"category"`
-	Options               []QuestionOption `json:"options"`
-	IsDealBreakerEligible bool             `json:"is_dealbreaker_eligible"`
-}
-
 // YikesSummary provides info about red flags in a compatibility match
 type YikesSummary struct {
 	HasYikes   bool     `json:"has_yikes"`
@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// Quota constraints
+const (
+	MaxQuotaDailyLimit   = 1000000
+	MaxQuotaReasonLength = 500
+	DefaultQuotaTopLimit = 20
+	MaxQuotaTopLimit     = 100
+)
+
+// QuotaUsage is a user's request cost spent against their daily quota. Each
+// request costs a weight by route - discovery searches cost more than a
+// simple read - and usage resets at midnight UTC.
+type QuotaUsage struct {
+	UserID     string    `json:"user_id"`
+	DailyLimit int       `json:"daily_limit"`
+	Used       int       `json:"used"`
+	Remaining  int       `json:"remaining"`
+	ResetsAt   time.Time `json:"resets_at"`
+	Override   bool      `json:"override"` // DailyLimit was set by an admin rather than the default
+}
+
+// QuotaOverride is an admin-set daily limit for one user
+type QuotaOverride struct {
+	UserID     string    `json:"user_id"`
+	DailyLimit int       `json:"daily_limit"`
+	Reason     string    `json:"reason,omitempty"`
+	SetBy      string    `json:"set_by"`
+	UpdatedOn  time.Time `json:"updated_on"`
+}
+
+// SetQuotaRequest represents a request to override a user's daily limit
+type SetQuotaRequest struct {
+	DailyLimit int    `json:"daily_limit"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// Validate validates the set quota request
+func (r *SetQuotaRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Int("daily_limit", r.DailyLimit).Between(1, MaxQuotaDailyLimit)
+	v.String("reason", r.Reason).MaxLength(MaxQuotaReasonLength)
+
+	return v.Errors()
+}
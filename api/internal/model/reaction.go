@@ -0,0 +1,68 @@
+package model
+
+import "time"
+
+// ReactionTargetType is the kind of item a reaction is on
+type ReactionTargetType string
+
+const (
+	ReactionTargetEvent        ReactionTargetType = "event"
+	ReactionTargetAnnouncement ReactionTargetType = "announcement"
+)
+
+// Reaction emoji, by name; clients render the glyph
+const (
+	ReactionThumbsUp = "thumbs_up"
+	ReactionHeart    = "heart"
+	ReactionLaugh    = "laugh"
+	ReactionParty    = "party"
+	ReactionWow      = "wow"
+	ReactionSad      = "sad"
+)
+
+// ReactionEmojis lists every emoji users can react with
+var ReactionEmojis = []string{
+	ReactionThumbsUp, ReactionHeart, ReactionLaugh, ReactionParty, ReactionWow, ReactionSad,
+}
+
+// Reaction is one user's emoji on an event or announcement. A user has at
+// most one reaction per item; reacting again changes it.
+type Reaction struct {
+	TargetType ReactionTargetType `json:"target_type"`
+	TargetID   string             `json:"target_id"`
+	UserID     string             `json:"user_id"`
+	Emoji      string             `json:"emoji"`
+	CreatedOn  time.Time          `json:"created_on"`
+}
+
+// ReactionSummary is the reactions on one item: how many of each emoji, and
+// the current user's own
+type ReactionSummary struct {
+	Counts map[string]int `json:"counts"` // Emoji with at least one reaction
+	Total  int            `json:"total"`
+	Mine   *string        `json:"mine,omitempty"`
+}
+
+// Add counts n reactions with emoji
+func (s *ReactionSummary) Add(emoji string, n int) {
+	if s.Counts == nil {
+		s.Counts = make(map[string]int)
+	}
+	s.Counts[emoji] += n
+	s.Total += n
+}
+
+// SetReactionRequest represents a request to react to an item, replacing the
+// user's reaction if they have one
+type SetReactionRequest struct {
+	Emoji string `json:"emoji"`
+}
+
+// Validate validates the set reaction request
+func (r *SetReactionRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("emoji", r.Emoji).Required().OneOf(ReactionEmojis...)
+
+	return v.Errors()
+}
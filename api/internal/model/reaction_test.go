@@ -0,0 +1,40 @@
+package model
+
+import "testing"
+
+// ============================================================================
+// Reaction Tests
+// ============================================================================
+
+func TestReactionSummary_Add(t *testing.T) {
+	t.Parallel()
+
+	var summary ReactionSummary
+	summary.Add(ReactionHeart, 2)
+	summary.Add(ReactionParty, 1)
+	summary.Add(ReactionHeart, 1)
+
+	if summary.Counts[ReactionHeart] != 3 || summary.Counts[ReactionParty] != 1 {
+		t.Errorf("unexpected counts %v", summary.Counts)
+	}
+	if summary.Total != 4 {
+		t.Errorf("expected total 4, got %d", summary.Total)
+	}
+}
+
+func TestSetReactionRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, emoji := range ReactionEmojis {
+		req := SetReactionRequest{Emoji: emoji}
+		if errs := req.Validate(); len(errs) > 0 {
+			t.Errorf("expected %s to be valid, got %v", emoji, errs)
+		}
+	}
+	for _, emoji := range []string{"", "❤️", "thumbs_down"} {
+		req := SetReactionRequest{Emoji: emoji}
+		if errs := req.Validate(); len(errs) == 0 {
+			t.Errorf("expected %q to be rejected", emoji)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+)
+
+// Remote config constraints
+const (
+	MaxRemoteConfigKeyLength         = 64
+	MaxRemoteConfigValueSize         = 16 * 1024 // Bytes of JSON
+	MaxRemoteConfigDescriptionLength = 500
+)
+
+// remoteConfigKeyPattern matches dotted lowercase keys such as
+// discovery.default_radius_km
+var remoteConfigKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`)
+
+// ValidRemoteConfigKey reports whether key is a dotted lowercase key such
+// as discovery.default_radius_km
+func ValidRemoteConfigKey(key string) bool {
+	return len(key) <= MaxRemoteConfigKeyLength && remoteConfigKeyPattern.MatchString(key)
+}
+
+// RemoteConfigValue is an admin-set client tunable. Without a platform it
+// applies to every platform; with one it overrides the value for that
+// platform only.
+type RemoteConfigValue struct {
+	Key         string          `json:"key"`
+	Platform    DevicePlatform  `json:"platform,omitempty"`
+	Value       json.RawMessage `json:"value"`
+	Description string          `json:"description,omitempty"`
+	UpdatedBy   string          `json:"updated_by"`
+	UpdatedOn   time.Time       `json:"updated_on"`
+}
+
+// RemoteConfig is the set of tunables a client on a platform reads: built-in
+// defaults, overlaid with admin-set values, overlaid with the platform's
+// overrides
+type RemoteConfig struct {
+	Platform DevicePlatform             `json:"platform,omitempty"`
+	Values   map[string]json.RawMessage `json:"values"`
+}
+
+// SetRemoteConfigRequest represents a request to set a tunable's value
+type SetRemoteConfigRequest struct {
+	Platform    DevicePlatform  `json:"platform,omitempty"` // Empty for every platform
+	Value       json.RawMessage `json:"value"`
+	Description string          `json:"description,omitempty"`
+}
+
+// Validate validates the set remote config request
+func (r *SetRemoteConfigRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("platform", r.Platform == "" || r.Platform.IsValid(), "platform must be ios, android, or web")
+	v.Check("value", len(r.Value) > 0 && json.Valid(r.Value) && string(r.Value) != "null", "value is required and must be JSON")
+	v.Check("value", len(r.Value) <= MaxRemoteConfigValueSize, "value must be at most 16 KB")
+	v.String("description", r.Description).MaxLength(MaxRemoteConfigDescriptionLength)
+
+	return v.Errors()
+}
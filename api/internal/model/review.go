@@ -144,6 +144,16 @@ type CreateReviewRequest struct {
 	PrivateNote     *string  `json:"private_note,omitempty"`
 }
 
+// Validate validates the create review request
+func (r *CreateReviewRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("reviewee_id", r.RevieweeID).Required()
+	v.String("context", r.Context).Required()
+
+	return v.Errors()
+}
+
 // EventFeedbackFlow represents the post-event feedback questions
 type EventFeedbackFlow struct {
 	EventID  string `json:"event_id"`
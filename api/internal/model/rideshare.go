@@ -27,6 +27,7 @@ type Rideshare struct {
 	SeatsAvailable int               `json:"seats_available"` // Computed from bookings
 	Status         string            `json:"status"`          // open, full, departed, completed, cancelled
 	TrustRequired  bool              `json:"trust_required"`  // Requires mutual trust
+	Visibility     string            `json:"visibility"`      // Can't exceed the parent event's
 	CreatedOn      time.Time         `json:"created_on"`
 	UpdatedOn      time.Time         `json:"updated_on"`
 }
@@ -98,23 +98,13 @@ type CreateRoleCatalogRequest struct {
 
 // Validate checks if the create request is valid
 func (r *CreateRoleCatalogRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.RoleType == "" {
-		errors = append(errors, FieldError{Field: "role_type", Message: "role_type is required"})
-	} else if r.RoleType != string(RoleCatalogRoleEvent) && r.RoleType != string(RoleCatalogRoleRideshare) {
-		errors = append(errors, FieldError{Field: "role_type", Message: "role_type must be 'event' or 'rideshare'"})
-	}
-	if r.Name == "" {
-		errors = append(errors, FieldError{Field: "name", Message: "name is required"})
-	} else if len(r.Name) > MaxRoleCatalogNameLength {
-		errors = append(errors, FieldError{Field: "name", Message: "name must be 50 characters or less"})
-	}
-	if r.Description != nil && len(*r.Description) > MaxRoleCatalogDescLength {
-		errors = append(errors, FieldError{Field: "description", Message: "description must be 500 characters or less"})
-	}
+	v.String("role_type", r.RoleType).Required().OneOf(string(RoleCatalogRoleEvent), string(RoleCatalogRoleRideshare))
+	v.String("name", r.Name).Required().MaxLength(MaxRoleCatalogNameLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxRoleCatalogDescLength)
 
-	return errors
+	return v.Errors()
 }
 
 // UpdateRoleCatalogRequest represents a request to update a role template
@@ -127,20 +117,12 @@ type UpdateRoleCatalogRequest struct {
 
 // Validate checks if the update request is valid
 func (r *UpdateRoleCatalogRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.Name != nil {
-		if *r.Name == "" {
-			errors = append(errors, FieldError{Field: "name", Message: "name cannot be empty"})
-		} else if len(*r.Name) > MaxRoleCatalogNameLength {
-			errors = append(errors, FieldError{Field: "name", Message: "name must be 50 characters or less"})
-		}
-	}
-	if r.Description != nil && len(*r.Description) > MaxRoleCatalogDescLength {
-		errors = append(errors, FieldError{Field: "description", Message: "description must be 500 characters or less"})
-	}
+	v.OptionalString("name", r.Name).NotEmpty().MaxLength(MaxRoleCatalogNameLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxRoleCatalogDescLength)
 
-	return errors
+	return v.Errors()
 }
 
 // CreateRoleFromCatalogRequest represents a request to create a role from a catalog template
@@ -152,16 +134,12 @@ type CreateRoleFromCatalogRequest struct {
 
 // Validate checks if the request is valid
 func (r *CreateRoleFromCatalogRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.CatalogRoleID == "" {
-		errors = append(errors, FieldError{Field: "catalog_role_id", Message: "catalog_role_id is required"})
-	}
-	if r.MaxSlots != nil && *r.MaxSlots < 0 {
-		errors = append(errors, FieldError{Field: "max_slots", Message: "max_slots must be 0 or greater"})
-	}
+	v.String("catalog_role_id", r.CatalogRoleID).Required()
+	v.OptionalInt("max_slots", r.MaxSlots).Min(0)
 
-	return errors
+	return v.Errors()
 }
 
 // CreateRideshareRoleRequest represents a request to create a rideshare role
@@ -174,21 +152,13 @@ type CreateRideshareRoleRequest struct {
 
 // Validate checks if the create request is valid
 func (r *CreateRideshareRoleRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.Name == "" {
-		errors = append(errors, FieldError{Field: "name", Message: "name is required"})
-	} else if len(r.Name) > MaxRoleCatalogNameLength {
-		errors = append(errors, FieldError{Field: "name", Message: "name must be 50 characters or less"})
-	}
-	if r.Description != nil && len(*r.Description) > MaxRoleCatalogDescLength {
-		errors = append(errors, FieldError{Field: "description", Message: "description must be 500 characters or less"})
-	}
-	if r.MaxSlots < 0 {
-		errors = append(errors, FieldError{Field: "max_slots", Message: "max_slots must be 0 or greater"})
-	}
+	v.String("name", r.Name).Required().MaxLength(MaxRoleCatalogNameLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxRoleCatalogDescLength)
+	v.Int("max_slots", r.MaxSlots).Min(0)
 
-	return errors
+	return v.Errors()
 }
 
 // UpdateRideshareRoleRequest represents a request to update a rideshare role
@@ -200,23 +170,13 @@ type UpdateRideshareRoleRequest struct {
 
 // Validate checks if the update request is valid
 func (r *UpdateRideshareRoleRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.Name != nil {
-		if *r.Name == "" {
-			errors = append(errors, FieldError{Field: "name", Message: "name cannot be empty"})
-		} else if len(*r.Name) > MaxRoleCatalogNameLength {
-			errors = append(errors, FieldError{Field: "name", Message: "name must be 50 characters or less"})
-		}
-	}
-	if r.Description != nil && len(*r.Description) > MaxRoleCatalogDescLength {
-		errors = append(errors, FieldError{Field: "description", Message: "description must be 500 characters or less"})
-	}
-	if r.MaxSlots != nil && *r.MaxSlots < 0 {
-		errors = append(errors, FieldError{Field: "max_slots", Message: "max_slots must be 0 or greater"})
-	}
+	v.OptionalString("name", r.Name).NotEmpty().MaxLength(MaxRoleCatalogNameLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxRoleCatalogDescLength)
+	v.OptionalInt("max_slots", r.MaxSlots).Min(0)
 
-	return errors
+	return v.Errors()
 }
 
 // AssignRideshareRoleRequest represents a request to assign oneself to a rideshare role
@@ -227,14 +187,10 @@ type AssignRideshareRoleRequest struct {
 
 // Validate checks if the request is valid
 func (r *AssignRideshareRoleRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.RoleID == "" {
-		errors = append(errors, FieldError{Field: "role_id", Message: "role_id is required"})
-	}
-	if r.Note != nil && len(*r.Note) > MaxRideshareRoleNoteLength {
-		errors = append(errors, FieldError{Field: "note", Message: "note must be 200 characters or less"})
-	}
+	v.String("role_id", r.RoleID).Required()
+	v.OptionalString("note", r.Note).MaxLength(MaxRideshareRoleNoteLength)
 
-	return errors
+	return v.Errors()
 }
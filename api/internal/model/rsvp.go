@@ -1,6 +1,9 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // UnifiedRSVP represents a polymorphic RSVP that works across events, adventures, hangouts, and pool matches.
 // This replaces the fragmented EventRSVP, event_participant, and rsvp relation tables.
@@ -160,51 +163,31 @@ const (
 
 // Validate validates a CreateRSVPRequest
 func (r *CreateRSVPRequest) Validate() []FieldError {
-	var errors []FieldError
-
-	if r.TargetType == "" {
-		errors = append(errors, FieldError{Field: "target_type", Message: "target_type is required"})
-	} else if r.TargetType != RSVPTargetEvent && r.TargetType != RSVPTargetAdventure &&
-		r.TargetType != RSVPTargetHangout && r.TargetType != RSVPTargetPoolMatch {
-		errors = append(errors, FieldError{Field: "target_type", Message: "invalid target_type"})
-	}
+	var v Validator
 
-	if r.TargetID == "" {
-		errors = append(errors, FieldError{Field: "target_id", Message: "target_id is required"})
+	v.String("target_type", r.TargetType).Required()
+	switch r.TargetType {
+	case "", RSVPTargetEvent, RSVPTargetAdventure, RSVPTargetHangout, RSVPTargetPoolMatch:
+	default:
+		v.Fail("target_type", "invalid target_type")
 	}
+	v.String("target_id", r.TargetID).Required()
+	v.Check("plus_ones", r.PlusOnes == nil || *r.PlusOnes <= MaxPlusOnes, fmt.Sprintf("maximum %d plus ones allowed", MaxPlusOnes))
+	v.Check("note", r.Note == nil || len(*r.Note) <= MaxRSVPNote, "note too long")
 
-	if r.PlusOnes != nil && *r.PlusOnes > MaxPlusOnes {
-		errors = append(errors, FieldError{Field: "plus_ones", Message: "maximum 5 plus ones allowed"})
-	}
-
-	if r.Note != nil && len(*r.Note) > MaxRSVPNote {
-		errors = append(errors, FieldError{Field: "note", Message: "note too long"})
-	}
-
-	return errors
+	return v.Errors()
 }
 
 // Validate validates an RSVPFeedbackRequest
 func (r *RSVPFeedbackRequest) Validate() []FieldError {
-	var errors []FieldError
-
-	rating := HelpfulnessRating(r.HelpfulnessRating)
-	if rating != HelpfulnessYes && rating != HelpfulnessSomewhat && rating != HelpfulnessNotReally && rating != HelpfulnessSkip {
-		errors = append(errors, FieldError{Field: "helpfulness_rating", Message: "must be YES, SOMEWHAT, NOT_REALLY, or SKIP"})
-	}
-
-	if len(r.Tags) > MaxHelpfulTags {
-		errors = append(errors, FieldError{Field: "tags", Message: "maximum 5 tags allowed"})
-	}
+	var v Validator
 
+	v.String("helpfulness_rating", r.HelpfulnessRating).Required().
+		OneOf(string(HelpfulnessYes), string(HelpfulnessSomewhat), string(HelpfulnessNotReally), string(HelpfulnessSkip))
+	v.Check("tags", len(r.Tags) <= MaxHelpfulTags, fmt.Sprintf("maximum %d tags allowed", MaxHelpfulTags))
 	for i, tag := range r.Tags {
-		if len(tag) > MaxTagLength {
-			errors = append(errors, FieldError{
-				Field:   "tags",
-				Message: "tag " + string(rune(i)) + " is too long",
-			})
-		}
+		v.Check("tags", len(tag) <= MaxTagLength, fmt.Sprintf("tag %d is too long", i))
 	}
 
-	return errors
+	return v.Errors()
 }
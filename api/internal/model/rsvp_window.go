@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// RSVPEarlyAccess lets holders of a guild tier, or members with at least a
+// guild role, RSVP to an event some hours before rsvp_opens_at. A rule names
+// either a tier or a role, not both.
+type RSVPEarlyAccess struct {
+	TierID *string    `json:"tier_id,omitempty"`
+	Role   *GuildRole `json:"role,omitempty"`
+	Hours  int        `json:"hours"`
+}
+
+// MaxRSVPEarlyAccessRules caps the early access rules on one event
+const MaxRSVPEarlyAccessRules = 10
+
+// HasRoleRules reports whether any early access rule is for a guild role
+func (e *Event) HasRoleRules() bool {
+	for _, rule := range e.RSVPEarlyAccess {
+		if rule.Role != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// RSVPOpensFor returns when a guild member holding tier with role can RSVP
+// to the event: rsvp_opens_at, moved earlier by the most early access they
+// get from the tier itself or the event's rules for it or their role. Tier
+// rules are for that exact tier; role rules include higher roles. Returns
+// nil if the event has no RSVP window.
+func (e *Event) RSVPOpensFor(tier *GuildTier, role GuildRole) *time.Time {
+	if e.RSVPOpensAt == nil {
+		return nil
+	}
+
+	hours := 0
+	if tier != nil {
+		hours = tier.EarlyRSVPHours
+	}
+	for _, rule := range e.RSVPEarlyAccess {
+		matches := (rule.TierID != nil && tier != nil && *rule.TierID == tier.ID) ||
+			(rule.Role != nil && role != "" && role.AtLeast(*rule.Role))
+		if matches && rule.Hours > hours {
+			hours = rule.Hours
+		}
+	}
+
+	opens := e.RSVPOpensAt.Add(-time.Duration(hours) * time.Hour)
+	return &opens
+}
+
+// SetRSVPWindowRequest replaces when an event's RSVPs open and who can RSVP
+// early. A nil opens_at opens RSVPs now and drops the early access rules.
+type SetRSVPWindowRequest struct {
+	OpensAt     *time.Time        `json:"opens_at"`
+	EarlyAccess []RSVPEarlyAccess `json:"early_access"`
+}
+
+// Validate validates the set RSVP window request
+func (r *SetRSVPWindowRequest) Validate() []FieldError {
+	var v Validator
+
+	if r.OpensAt == nil {
+		v.Check("early_access", len(r.EarlyAccess) == 0, "early_access needs opens_at")
+	}
+	v.Check("early_access", len(r.EarlyAccess) <= MaxRSVPEarlyAccessRules,
+		fmt.Sprintf("early_access may have at most %d rules", MaxRSVPEarlyAccessRules))
+
+	tiers := make(map[string]bool)
+	roles := make(map[GuildRole]bool)
+	for _, rule := range r.EarlyAccess {
+		v.Check("early_access", (rule.TierID == nil) != (rule.Role == nil), "early_access rules need either tier_id or role")
+		v.Int("early_access", rule.Hours).Between(1, MaxEarlyRSVPHours)
+		if rule.TierID != nil {
+			v.Check("early_access", !tiers[*rule.TierID], "early_access may have one rule per tier")
+			tiers[*rule.TierID] = true
+		}
+		if rule.Role != nil {
+			v.Check("early_access", rule.Role.IsValid(), "early_access role must be member, moderator, or admin")
+			v.Check("early_access", !roles[*rule.Role], "early_access may have one rule per role")
+			roles[*rule.Role] = true
+		}
+	}
+
+	return v.Errors()
+}
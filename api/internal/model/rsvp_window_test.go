@@ -0,0 +1,97 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// RSVP Window Tests
+// ============================================================================
+
+func TestEvent_RSVPOpensFor(t *testing.T) {
+	t.Parallel()
+
+	opens := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	event := &Event{RSVPOpensAt: &opens}
+
+	if got := event.RSVPOpensFor(nil, ""); !got.Equal(opens) {
+		t.Errorf("expected RSVPs to open at %v without a tier, got %v", opens, got)
+	}
+	if got := event.RSVPOpensFor(&GuildTier{EarlyRSVPHours: 48}, GuildRoleMember); !got.Equal(opens.Add(-48 * time.Hour)) {
+		t.Errorf("expected early access 48 hours sooner, got %v", got)
+	}
+	if got := (&Event{}).RSVPOpensFor(&GuildTier{EarlyRSVPHours: 48}, GuildRoleAdmin); got != nil {
+		t.Errorf("expected no window without rsvp_opens_at, got %v", got)
+	}
+}
+
+func TestEvent_RSVPOpensFor_Rules(t *testing.T) {
+	t.Parallel()
+
+	opens := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	supporter := "guild_tier:supporter"
+	moderator := GuildRoleModerator
+	event := &Event{
+		RSVPOpensAt: &opens,
+		RSVPEarlyAccess: []RSVPEarlyAccess{
+			{TierID: &supporter, Hours: 12},
+			{Role: &moderator, Hours: 24},
+		},
+	}
+	supporterTier := &GuildTier{ID: supporter, EarlyRSVPHours: 6}
+	patronTier := &GuildTier{ID: "guild_tier:patron", EarlyRSVPHours: 2}
+
+	tests := []struct {
+		name  string
+		tier  *GuildTier
+		role  GuildRole
+		hours int
+	}{
+		{"plain member", nil, GuildRoleMember, 0},
+		{"tier rule beats the tier's own early access", supporterTier, GuildRoleMember, 12},
+		{"tier rules are for the exact tier", patronTier, GuildRoleMember, 2},
+		{"role rule", nil, GuildRoleModerator, 24},
+		{"role rules include higher roles", supporterTier, GuildRoleAdmin, 24},
+		{"non-member", nil, "", 0},
+	}
+	for _, tt := range tests {
+		want := opens.Add(-time.Duration(tt.hours) * time.Hour)
+		if got := event.RSVPOpensFor(tt.tier, tt.role); !got.Equal(want) {
+			t.Errorf("%s: expected RSVPs to open at %v, got %v", tt.name, want, got)
+		}
+	}
+}
+
+func TestSetRSVPWindowRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	opens := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	tier := "guild_tier:supporter"
+	role := GuildRoleModerator
+	bogus := GuildRole("owner")
+
+	tests := []struct {
+		name  string
+		req   SetRSVPWindowRequest
+		valid bool
+	}{
+		{"no window", SetRSVPWindowRequest{}, true},
+		{"tier and role rules", SetRSVPWindowRequest{OpensAt: &opens, EarlyAccess: []RSVPEarlyAccess{{TierID: &tier, Hours: 24}, {Role: &role, Hours: 12}}}, true},
+		{"rules without opens_at", SetRSVPWindowRequest{EarlyAccess: []RSVPEarlyAccess{{Role: &role, Hours: 12}}}, false},
+		{"rule with tier and role", SetRSVPWindowRequest{OpensAt: &opens, EarlyAccess: []RSVPEarlyAccess{{TierID: &tier, Role: &role, Hours: 12}}}, false},
+		{"rule with neither", SetRSVPWindowRequest{OpensAt: &opens, EarlyAccess: []RSVPEarlyAccess{{Hours: 12}}}, false},
+		{"zero hours", SetRSVPWindowRequest{OpensAt: &opens, EarlyAccess: []RSVPEarlyAccess{{Role: &role}}}, false},
+		{"unknown role", SetRSVPWindowRequest{OpensAt: &opens, EarlyAccess: []RSVPEarlyAccess{{Role: &bogus, Hours: 12}}}, false},
+		{"duplicate tier", SetRSVPWindowRequest{OpensAt: &opens, EarlyAccess: []RSVPEarlyAccess{{TierID: &tier, Hours: 12}, {TierID: &tier, Hours: 24}}}, false},
+	}
+	for _, tt := range tests {
+		errs := tt.req.Validate()
+		if tt.valid && len(errs) != 0 {
+			t.Errorf("%s: expected no errors, got %v", tt.name, errs)
+		}
+		if !tt.valid && (len(errs) != 1 || errs[0].Field != "early_access") {
+			t.Errorf("%s: expected an error on early_access, got %v", tt.name, errs)
+		}
+	}
+}
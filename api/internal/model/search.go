@@ -0,0 +1,38 @@
+package model
+
+// Search result types
+const (
+	SearchTypeGuild    = "guild"
+	SearchTypeEvent    = "event"
+	SearchTypeUser     = "user"
+	SearchTypeInterest = "interest"
+)
+
+// SearchTypes are the kinds of record a search can return, in the order
+// they're searched when no type filter is given
+var SearchTypes = []string{SearchTypeGuild, SearchTypeEvent, SearchTypeInterest, SearchTypeUser}
+
+// Search limits
+const (
+	MinSearchQueryLength = 2
+	MaxSearchQueryLength = 100
+	DefaultSearchLimit   = 20
+	MaxSearchLimit       = 50
+)
+
+// SearchQuery is a full-text search across the kinds of record in Types
+type SearchQuery struct {
+	Text  string
+	Types []string // Empty searches every type
+	Limit int      // Per type
+}
+
+// SearchResult is one match. Only public records are searched, so results
+// carry just enough to list them; clients fetch the record by ID for more.
+type SearchResult struct {
+	Type     string  `json:"type"`
+	ID       string  `json:"id"`
+	Title    string  `json:"title"`              // Guild or interest name, event title, username
+	Subtitle string  `json:"subtitle,omitempty"` // Description, interest category, or the user's full name
+	Score    float64 `json:"score"`              // Relevance; only comparable within one search
+}
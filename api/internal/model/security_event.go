@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// Security event log limits
+const (
+	DefaultSecurityEventLimit = 100
+	MaxSecurityEventLimit     = 500
+)
+
+// Security event types
+const (
+	SecurityEventPasswordChanged        = "password_changed"
+	SecurityEventEmailChangeRequested   = "email_change_requested"
+	SecurityEventEmailChanged           = "email_changed"
+	SecurityEventEmailRecoveryRequested = "email_recovery_requested"
+	SecurityEventEmailRecoveryCancelled = "email_recovery_cancelled"
+	SecurityEventEmailRecoveryCompleted = "email_recovery_completed"
+	SecurityEventDeviceApproved         = "device_approved"
+	SecurityEventInvitationAccepted     = "invitation_accepted"
+)
+
+// Scraper detections. They're recorded against the client's address, and
+// against a user only when the client had signed in.
+const (
+	SecurityEventHoneypotHit      = "honeypot_hit"
+	SecurityEventScraperTarpitted = "scraper_tarpitted"
+	SecurityEventScraperBlocked   = "scraper_blocked"
+)
+
+// ScraperSecurityEvents are the event types scraper defenses record
+var ScraperSecurityEvents = []string{
+	SecurityEventHoneypotHit,
+	SecurityEventScraperTarpitted,
+	SecurityEventScraperBlocked,
+}
+
+// SecurityEventAdminAccessBlocked is a request to an admin route turned away
+// by the network policy, recorded against the client's address
+const SecurityEventAdminAccessBlocked = "admin_access_blocked"
+
+// SecurityEvent is one entry in a user's security log: a change to how they
+// sign in or how they are reached. Admins review it when investigating
+// account takeovers. Scraper detections are logged too, by client address.
+type SecurityEvent struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id,omitempty"`
+	IP        string    `json:"ip,omitempty"`
+	Type      string    `json:"type"`
+	Detail    string    `json:"detail,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+}
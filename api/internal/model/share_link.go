@@ -0,0 +1,130 @@
+package model
+
+import "time"
+
+// ShareTargetType is the kind of resource a share link points at
+type ShareTargetType string
+
+const (
+	ShareTargetEvent     ShareTargetType = "event"
+	ShareTargetGuild     ShareTargetType = "guild"
+	ShareTargetAdventure ShareTargetType = "adventure"
+)
+
+// IsValid reports whether the target type is supported
+func (t ShareTargetType) IsValid() bool {
+	switch t {
+	case ShareTargetEvent, ShareTargetGuild, ShareTargetAdventure:
+		return true
+	}
+	return false
+}
+
+// SupportsInvite reports whether links to this target type can carry an
+// invite. Only targets with membership (guilds) or admission (adventures) do.
+func (t ShareTargetType) SupportsInvite() bool {
+	return t == ShareTargetGuild || t == ShareTargetAdventure
+}
+
+// Share link constraints
+const (
+	MaxShareLinkTTLHours = 90 * 24 // 90 days
+	MaxShareLinkUses     = 1000
+)
+
+// ShareLinkPathPrefix is the path on the public site that opens a share link
+const ShareLinkPathPrefix = "/s/"
+
+// ShareLink is a short, signed link to an event, guild or adventure. Invite
+// links also let whoever redeems them join the target.
+type ShareLink struct {
+	ID         string          `json:"id"`
+	Code       string          `json:"-"`     // Random part of the token, stored and indexed
+	Token      string          `json:"token"` // Code plus signature, never stored
+	URL        string          `json:"url"`
+	TargetType ShareTargetType `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	CreatedBy  string          `json:"created_by"`
+	Invite     bool            `json:"invite"`
+	MaxUses    *int            `json:"max_uses,omitempty"` // Invite redemptions allowed; nil = unlimited
+	UseCount   int             `json:"use_count"`
+	ExpiresOn  *time.Time      `json:"expires_on,omitempty"`
+	CreatedOn  time.Time       `json:"created_on"`
+}
+
+// IsExpired reports whether the link has expired at the given time
+func (l *ShareLink) IsExpired(now time.Time) bool {
+	return l.ExpiresOn != nil && !now.Before(*l.ExpiresOn)
+}
+
+// IsExhausted reports whether an invite link has no redemptions left
+func (l *ShareLink) IsExhausted() bool {
+	return l.MaxUses != nil && l.UseCount >= *l.MaxUses
+}
+
+// CreateShareLinkRequest represents a request to create a share link
+type CreateShareLinkRequest struct {
+	TargetType     ShareTargetType `json:"target_type"`
+	TargetID       string          `json:"target_id"`
+	Invite         bool            `json:"invite"`
+	ExpiresInHours *int            `json:"expires_in_hours,omitempty"`
+	MaxUses        *int            `json:"max_uses,omitempty"` // Invite links only
+}
+
+// Validate validates the create share link request
+func (r *CreateShareLinkRequest) Validate() []FieldError {
+	var v Validator
+
+	v.Check("target_type", r.TargetType.IsValid(), "target_type must be event, guild or adventure")
+	v.String("target_id", r.TargetID).Required()
+	v.Check("invite", !r.Invite || !r.TargetType.IsValid() || r.TargetType.SupportsInvite(),
+		"invite links are only supported for guilds and adventures")
+	v.OptionalInt("expires_in_hours", r.ExpiresInHours).Between(1, MaxShareLinkTTLHours)
+	v.Check("max_uses", r.MaxUses == nil || r.Invite, "max_uses only applies to invite links")
+	v.OptionalInt("max_uses", r.MaxUses).Between(1, MaxShareLinkUses)
+
+	return v.Errors()
+}
+
+// SharePreview is the unauthenticated-safe summary of a share link's target,
+// used to unfurl links. Restricted previews of non-public targets carry only
+// the type and title.
+type SharePreview struct {
+	TargetType  ShareTargetType `json:"target_type"`
+	Title       string          `json:"title"`
+	Description *string         `json:"description,omitempty"`
+	ImageURL    *string         `json:"image_url,omitempty"`
+	StartsOn    *time.Time      `json:"starts_on,omitempty"`
+	EndsOn      *time.Time      `json:"ends_on,omitempty"`
+	City        *string         `json:"city,omitempty"`
+	Status      string          `json:"status,omitempty"`
+	MemberCount *int            `json:"member_count,omitempty"`
+	Restricted  bool            `json:"restricted"`
+}
+
+// ShareLinkResolution is what a share token resolves to
+type ShareLinkResolution struct {
+	TargetType ShareTargetType `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Invite     bool            `json:"invite"`
+	ExpiresOn  *time.Time      `json:"expires_on,omitempty"`
+	Preview    *SharePreview   `json:"preview"`
+}
+
+// ShareSiteName is the og:site_name used when unfurling share links
+const ShareSiteName = "Saga"
+
+// OpenGraphPreview is the unfurl metadata for a share link: OpenGraph and
+// Twitter card fields plus schema.org structured data. Restricted previews
+// keep the title but replace the description and drop the image and
+// structured data.
+type OpenGraphPreview struct {
+	Title          string         `json:"title"`
+	Description    string         `json:"description"`
+	ImageURL       *string        `json:"image_url,omitempty"`
+	URL            string         `json:"url"`
+	Type           string         `json:"type"` // og:type
+	SiteName       string         `json:"site_name"`
+	Restricted     bool           `json:"restricted"`
+	StructuredData map[string]any `json:"structured_data,omitempty"` // JSON-LD
+}
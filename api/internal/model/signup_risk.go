@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+// Signup risk scoring: each heuristic that fires on a registration adds its
+// weight to the score. Accounts at SignupRiskVerifyScore can't make changes
+// until they verify their email; at SignupRiskReviewScore they are also
+// flagged for an admin to look at.
+const (
+	SignupRiskVerifyScore = 40
+	SignupRiskReviewScore = 70
+	MaxSignupRiskScore    = 100
+
+	SignupVelocityWindow = time.Hour // How far back signups from the same IP are counted
+	SignupVelocityLimit  = 3         // Signups from one IP in the window before more look automated
+
+	DefaultSignupReviewLimit = 50
+	MaxSignupReviewLimit     = 200
+)
+
+// Signup risk reasons
+const (
+	SignupRiskDisposableEmail = "disposable_email" // The address is at a throwaway mail provider
+	SignupRiskIPVelocity      = "ip_velocity"      // Many accounts came from the same IP recently
+	SignupRiskRandomEmail     = "random_email"     // The address looks machine generated
+	SignupRiskSuspiciousName  = "suspicious_name"  // The name holds a link or address, or repeats itself
+)
+
+// SignupRiskWeights is how much each reason adds to the score
+var SignupRiskWeights = map[string]int{
+	SignupRiskDisposableEmail: 50,
+	SignupRiskIPVelocity:      40,
+	SignupRiskRandomEmail:     20,
+	SignupRiskSuspiciousName:  25,
+}
+
+// SignupRisk is how likely a registration is to be spam or a bot, worked
+// out when the account was created. Admins see it on the user detail.
+type SignupRisk struct {
+	ID                   string     `json:"-"`
+	UserID               string     `json:"user_id"`
+	IP                   string     `json:"ip,omitempty"`
+	Score                int        `json:"score"`
+	Reasons              []string   `json:"reasons"`
+	RequiresVerification bool       `json:"requires_verification"`
+	FlaggedForReview     bool       `json:"flagged_for_review"`
+	ReviewedBy           *string    `json:"reviewed_by,omitempty"`
+	ReviewedOn           *time.Time `json:"reviewed_on,omitempty"`
+	CreatedOn            time.Time  `json:"created_on"`
+}
+
+// AddReason records a heuristic that fired and adds its weight, capping the
+// score at MaxSignupRiskScore
+func (r *SignupRisk) AddReason(reason string) {
+	r.Reasons = append(r.Reasons, reason)
+	r.Score = min(r.Score+SignupRiskWeights[reason], MaxSignupRiskScore)
+	r.RequiresVerification = r.Score >= SignupRiskVerifyScore
+	r.FlaggedForReview = r.Score >= SignupRiskReviewScore
+}
+
+// NeedsReview reports whether the account is flagged and no admin has
+// looked at it yet
+func (r *SignupRisk) NeedsReview() bool {
+	return r.FlaggedForReview && r.ReviewedOn == nil
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Spontaneous availability constraints. Going available right now is loud -
+// it alerts people nearby - so it is limited harder than planned
+// availability.
+const (
+	DefaultSpontaneousMinutes = 120
+	MinSpontaneousMinutes     = 30
+	MaxSpontaneousMinutes     = 240
+	// Sessions a user may start in any rolling SpontaneousSessionWindow
+	MaxSpontaneousSessions   = 3
+	SpontaneousSessionWindow = 24 * time.Hour
+	// Alerts reach at most this many people per session, and each person at
+	// most once per SpontaneousAlertCooldown
+	MaxSpontaneousAlertRecipients   = 20
+	SpontaneousAlertCooldown        = time.Hour
+	DefaultSpontaneousAlertRadiusKm = 5.0
+	MaxSpontaneousAlertRadiusKm     = 25.0
+)
+
+// GoAvailableNowRequest represents a request to go available right now. The
+// window starts immediately and expires after DurationMinutes.
+type GoAvailableNowRequest struct {
+	DurationMinutes     int                          `json:"duration_minutes,omitempty"` // Default: 120
+	Location            *AvailabilityLocationRequest `json:"location"`
+	HangoutType         string                       `json:"hangout_type,omitempty"` // Default: meet_anyone; checked against the catalog
+	ActivityDescription *string                      `json:"activity_description,omitempty"`
+	ActivityVenue       *string                      `json:"activity_venue,omitempty"`
+	InterestID          *string                      `json:"interest_id,omitempty"` // Required for mutual_interest
+	Note                *string                      `json:"note,omitempty"`
+}
+
+// Validate validates the go available now request
+func (r *GoAvailableNowRequest) Validate() []FieldError {
+	var v Validator
+
+	if r.DurationMinutes != 0 {
+		v.Int("duration_minutes", r.DurationMinutes).Between(MinSpontaneousMinutes, MaxSpontaneousMinutes)
+	}
+
+	v.Check("location", r.Location != nil, "location is required")
+	if r.Location != nil {
+		v.Check("location", r.Location.Lat >= -90 && r.Location.Lat <= 90 &&
+			r.Location.Lng >= -180 && r.Location.Lng <= 180, "location must be valid coordinates")
+	}
+
+	if HangoutType(r.HangoutType) == HangoutTypeMutualInterest {
+		v.Check("interest_id", r.InterestID != nil && *r.InterestID != "", "interest_id is required for mutual_interest")
+	}
+
+	v.OptionalString("activity_description", r.ActivityDescription).MaxLength(MaxActivityDescLength)
+	v.OptionalString("activity_venue", r.ActivityVenue).MaxLength(MaxVenueLength)
+	v.OptionalString("note", r.Note).MaxLength(MaxNoteLength)
+
+	return v.Errors()
+}
+
+// SpontaneousAlertPreference is a user's opt-in to alerts when compatible
+// people within RadiusKm go available right now. A user without a stored
+// preference gets no alerts.
+type SpontaneousAlertPreference struct {
+	UserID        string     `json:"user_id"`
+	Enabled       bool       `json:"enabled"`
+	RadiusKm      float64    `json:"radius_km"`
+	LastAlertedOn *time.Time `json:"last_alerted_on,omitempty"`
+	UpdatedOn     time.Time  `json:"updated_on"`
+}
+
+// UpdateSpontaneousAlertPreferenceRequest represents a request to update
+// spontaneous alert preferences
+type UpdateSpontaneousAlertPreferenceRequest struct {
+	Enabled  *bool    `json:"enabled,omitempty"`
+	RadiusKm *float64 `json:"radius_km,omitempty"`
+}
+
+// Validate validates the update spontaneous alert preference request
+func (r *UpdateSpontaneousAlertPreferenceRequest) Validate() []FieldError {
+	var v Validator
+
+	if r.RadiusKm != nil {
+		v.Check("radius_km", *r.RadiusKm > 0 && *r.RadiusKm <= MaxSpontaneousAlertRadiusKm,
+			fmt.Sprintf("radius_km must be greater than 0 and at most %g", MaxSpontaneousAlertRadiusKm))
+	}
+
+	return v.Errors()
+}
@@ -0,0 +1,67 @@
+package model
+
+import "testing"
+
+// ============================================================================
+// Spontaneous Availability Tests
+// ============================================================================
+
+func TestGoAvailableNowRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	here := &AvailabilityLocationRequest{Lat: 40.0, Lng: -105.0}
+	offMap := &AvailabilityLocationRequest{Lat: 91, Lng: 0}
+	interestID := "interest:1"
+
+	tests := []struct {
+		name    string
+		req     GoAvailableNowRequest
+		wantErr bool
+	}{
+		{"defaults", GoAvailableNowRequest{Location: here}, false},
+		{"full", GoAvailableNowRequest{Location: here, DurationMinutes: 90, HangoutType: string(HangoutTypeConcreteActivity)}, false},
+		{"mutual interest", GoAvailableNowRequest{Location: here, HangoutType: string(HangoutTypeMutualInterest), InterestID: &interestID}, false},
+		{"missing location", GoAvailableNowRequest{}, true},
+		{"invalid location", GoAvailableNowRequest{Location: offMap}, true},
+		{"too short", GoAvailableNowRequest{Location: here, DurationMinutes: MinSpontaneousMinutes - 1}, true},
+		{"too long", GoAvailableNowRequest{Location: here, DurationMinutes: MaxSpontaneousMinutes + 1}, true},
+		{"mutual interest without interest", GoAvailableNowRequest{Location: here, HangoutType: string(HangoutTypeMutualInterest)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			errs := tt.req.Validate()
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Validate() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateSpontaneousAlertPreferenceRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	zero, near, far := 0.0, 5.0, MaxSpontaneousAlertRadiusKm+1
+
+	tests := []struct {
+		name    string
+		req     UpdateSpontaneousAlertPreferenceRequest
+		wantErr bool
+	}{
+		{"empty", UpdateSpontaneousAlertPreferenceRequest{}, false},
+		{"radius", UpdateSpontaneousAlertPreferenceRequest{RadiusKm: &near}, false},
+		{"zero radius", UpdateSpontaneousAlertPreferenceRequest{RadiusKm: &zero}, true},
+		{"radius too large", UpdateSpontaneousAlertPreferenceRequest{RadiusKm: &far}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			errs := tt.req.Validate()
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Validate() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
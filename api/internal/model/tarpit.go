@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// TarpitClient is a client the scraper defenses are slowing down or
+// turning away, as admins see it
+type TarpitClient struct {
+	IP           string     `json:"ip"`
+	Strikes      int        `json:"strikes"`
+	Reason       string     `json:"reason"`
+	Delay        string     `json:"delay,omitempty"` // Added to each response, such as "4s"
+	BlockedUntil *time.Time `json:"blocked_until,omitempty"`
+	LastSeen     time.Time  `json:"last_seen"`
+}
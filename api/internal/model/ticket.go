@@ -0,0 +1,97 @@
+package model
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+)
+
+// EventTicket is one seat at a ticketed event. Guests hold a ticket while
+// they RSVP, which reserves the seat for TicketHoldDuration; the RSVP claims
+// it. A claimed ticket can be passed to another member and is scanned as a
+// QR code at check-in.
+type EventTicket struct {
+	ID              string     `json:"id"`
+	EventID         string     `json:"event_id"`
+	HolderID        string     `json:"holder_id"`
+	Status          string     `json:"status"`                     // held, claimed, used, void
+	HoldExpiresOn   *time.Time `json:"hold_expires_on,omitempty"`  // Held tickets only
+	TransferredFrom *string    `json:"transferred_from,omitempty"` // Previous holder
+	ClaimedOn       *time.Time `json:"claimed_on,omitempty"`
+	CheckedInOn     *time.Time `json:"checked_in_on,omitempty"`
+	CheckedInBy     *string    `json:"checked_in_by,omitempty"` // Host who scanned it
+	CreatedOn       time.Time  `json:"created_on"`
+	UpdatedOn       time.Time  `json:"updated_on"`
+
+	Code      string `json:"-"`                    // Secret in the QR payload; replaced on transfer
+	QRPayload string `json:"qr_payload,omitempty"` // Set for the holder of a claimed ticket
+}
+
+// TicketStatus constants
+const (
+	TicketStatusHeld    = "held"    // Seat reserved while the guest RSVPs
+	TicketStatusClaimed = "claimed" // Guest RSVP'd; valid for check-in
+	TicketStatusUsed    = "used"    // Checked in
+	TicketStatusVoid    = "void"    // Released, expired unclaimed, or RSVP cancelled
+)
+
+// TicketHoldDuration is how long a held ticket reserves its seat
+const TicketHoldDuration = 10 * time.Minute
+
+// ticketPayloadPrefix marks a QR payload as a Saga ticket, versioned so the
+// format can change without misreading old tickets
+const ticketPayloadPrefix = "SAGA-TICKET-1"
+
+// IsActive reports whether the ticket takes a seat at now: it's claimed,
+// used, or held and the hold hasn't expired
+func (t *EventTicket) IsActive(now time.Time) bool {
+	switch t.Status {
+	case TicketStatusClaimed, TicketStatusUsed:
+		return true
+	case TicketStatusHeld:
+		return t.HoldExpiresOn != nil && now.Before(*t.HoldExpiresOn)
+	}
+	return false
+}
+
+// Payload returns what the ticket's QR code encodes
+func (t *EventTicket) Payload() string {
+	return ticketPayloadPrefix + "." + base64.RawURLEncoding.EncodeToString([]byte(t.ID)) + "." + t.Code
+}
+
+// ParseTicketPayload returns the ticket ID and code a QR payload carries
+func ParseTicketPayload(payload string) (ticketID, code string, ok bool) {
+	parts := strings.Split(strings.TrimSpace(payload), ".")
+	if len(parts) != 3 || parts[0] != ticketPayloadPrefix || parts[2] == "" {
+		return "", "", false
+	}
+	id, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || len(id) == 0 {
+		return "", "", false
+	}
+	return string(id), parts[2], true
+}
+
+// TransferTicketRequest passes a claimed ticket to another member
+type TransferTicketRequest struct {
+	ToUserID string `json:"to_user_id"`
+}
+
+// Validate validates the transfer ticket request
+func (r *TransferTicketRequest) Validate() []FieldError {
+	var v Validator
+	v.String("to_user_id", r.ToUserID).Required()
+	return v.Errors()
+}
+
+// CheckInTicketRequest is a host scanning a ticket at the door
+type CheckInTicketRequest struct {
+	Payload string `json:"payload"` // The ticket's QR payload
+}
+
+// Validate validates the check-in ticket request
+func (r *CheckInTicketRequest) Validate() []FieldError {
+	var v Validator
+	v.String("payload", r.Payload).Required()
+	return v.Errors()
+}
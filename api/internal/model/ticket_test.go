@@ -0,0 +1,79 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Ticket Tests
+// ============================================================================
+
+func TestEventTicket_PayloadRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ticket := &EventTicket{ID: "event_ticket:abc123", Code: "s3cret"}
+	id, code, ok := ParseTicketPayload(ticket.Payload())
+	if !ok || id != ticket.ID || code != ticket.Code {
+		t.Errorf("expected (%q, %q), got (%q, %q, %v)", ticket.ID, ticket.Code, id, code, ok)
+	}
+}
+
+func TestParseTicketPayload_Rejects(t *testing.T) {
+	t.Parallel()
+
+	for _, payload := range []string{
+		"",
+		"event_ticket:abc123",
+		"SAGA-TICKET-1.ZXZlbnRfdGlja2V0OmFiYzEyMw",
+		"SAGA-TICKET-1.ZXZlbnRfdGlja2V0OmFiYzEyMw.",
+		"SAGA-TICKET-2.ZXZlbnRfdGlja2V0OmFiYzEyMw.code",
+		"SAGA-TICKET-1.not*base64.code",
+	} {
+		if _, _, ok := ParseTicketPayload(payload); ok {
+			t.Errorf("expected %q to be rejected", payload)
+		}
+	}
+}
+
+func TestEventTicket_IsActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC)
+	later, earlier := now.Add(time.Minute), now.Add(-time.Minute)
+
+	tests := []struct {
+		name   string
+		ticket EventTicket
+		want   bool
+	}{
+		{"live hold", EventTicket{Status: TicketStatusHeld, HoldExpiresOn: &later}, true},
+		{"expired hold", EventTicket{Status: TicketStatusHeld, HoldExpiresOn: &earlier}, false},
+		{"claimed", EventTicket{Status: TicketStatusClaimed}, true},
+		{"used", EventTicket{Status: TicketStatusUsed}, true},
+		{"void", EventTicket{Status: TicketStatusVoid}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.ticket.IsActive(now); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestUpdateEventRequest_ValidateFor(t *testing.T) {
+	t.Parallel()
+
+	capacity := 20
+	yes := true
+	ticketed := &Event{Ticketed: true, MaxAttendees: &capacity}
+
+	if errs := (&UpdateEventRequest{Clear: ClearedFields{"max_attendees"}}).ValidateFor(ticketed); len(errs) != 1 || errs[0].Field != "max_attendees" {
+		t.Errorf("expected clearing a ticketed event's capacity to fail, got %v", errs)
+	}
+	if errs := (&UpdateEventRequest{Ticketed: &yes}).ValidateFor(&Event{}); len(errs) != 1 {
+		t.Errorf("expected ticketing an unlimited event to fail, got %v", errs)
+	}
+	if errs := (&UpdateEventRequest{Ticketed: &yes, MaxAttendees: &capacity}).ValidateFor(&Event{}); len(errs) != 0 {
+		t.Errorf("expected ticketing with a capacity to pass, got %v", errs)
+	}
+}
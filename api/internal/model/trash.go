@@ -0,0 +1,54 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// TrashItemType is the kind of guild content in the trash
+type TrashItemType string
+
+const (
+	TrashItemEvent TrashItemType = "event"
+	TrashItemPool  TrashItemType = "pool"
+	TrashItemVote  TrashItemType = "vote"
+)
+
+// TrashRetentionDays is how long deleted guild content can be restored
+// before the purge job removes it for good
+const TrashRetentionDays = 30
+
+// TrashItem is a deleted event, pool or vote in a guild's trash
+type TrashItem struct {
+	ID        string        `json:"id"`
+	Type      TrashItemType `json:"type"`
+	GuildID   string        `json:"guild_id"`
+	Title     string        `json:"title"`
+	DeletedBy string        `json:"deleted_by,omitempty"`
+	DeletedAt time.Time     `json:"deleted_at"`
+	PurgeAt   time.Time     `json:"purge_at"`
+}
+
+// TrashItemTypeForID returns the trash item type of a record ID, based on its
+// table. Returns false for records that can't be in the trash.
+func TrashItemTypeForID(id string) (TrashItemType, bool) {
+	table, _, found := strings.Cut(id, ":")
+	if !found {
+		return "", false
+	}
+	switch table {
+	case "event":
+		return TrashItemEvent, true
+	case "matching_pool":
+		return TrashItemPool, true
+	case "vote":
+		return TrashItemVote, true
+	}
+	return "", false
+}
+
+// TrashPurgeCutoff returns the deletion time at or before which trashed
+// content is purged
+func TrashPurgeCutoff(now time.Time) time.Time {
+	return now.AddDate(0, 0, -TrashRetentionDays)
+}
@@ -26,6 +26,16 @@ const (
 	ReviewVisibilityAdminOnly ReviewVisibility = "admin_only"
 )
 
+// TrustRatingListFields is what trust rating lists can be sorted and
+// filtered by
+var TrustRatingListFields = ListFields{
+	Sort: []string{"created_on", "updated_on"},
+	Filter: map[string][]string{
+		"trust_level": {string(TrustLevelTrust), string(TrustLevelDistrust)},
+		"anchor_type": {string(TrustAnchorEvent), string(TrustAnchorRideshare)},
+	},
+}
+
 // TrustRating represents an event-anchored trust assessment between users
 type TrustRating struct {
 	ID               string           `json:"id"`
@@ -120,31 +130,15 @@ type CreateTrustRatingRequest struct {
 
 // Validate checks if the create request is valid
 func (r *CreateTrustRatingRequest) Validate() []FieldError {
-	var errors []FieldError
-
-	if r.RateeID == "" {
-		errors = append(errors, FieldError{Field: "ratee_id", Message: "ratee_id is required"})
-	}
-	if r.AnchorType == "" {
-		errors = append(errors, FieldError{Field: "anchor_type", Message: "anchor_type is required"})
-	} else if r.AnchorType != string(TrustAnchorEvent) && r.AnchorType != string(TrustAnchorRideshare) {
-		errors = append(errors, FieldError{Field: "anchor_type", Message: "anchor_type must be 'event' or 'rideshare'"})
-	}
-	if r.AnchorID == "" {
-		errors = append(errors, FieldError{Field: "anchor_id", Message: "anchor_id is required"})
-	}
-	if r.TrustLevel == "" {
-		errors = append(errors, FieldError{Field: "trust_level", Message: "trust_level is required"})
-	} else if r.TrustLevel != string(TrustLevelTrust) && r.TrustLevel != string(TrustLevelDistrust) {
-		errors = append(errors, FieldError{Field: "trust_level", Message: "trust_level must be 'trust' or 'distrust'"})
-	}
-	if r.TrustReview == "" {
-		errors = append(errors, FieldError{Field: "trust_review", Message: "trust_review is required"})
-	} else if len(r.TrustReview) > MaxTrustReviewLength {
-		errors = append(errors, FieldError{Field: "trust_review", Message: "trust_review must be 240 characters or less"})
-	}
-
-	return errors
+	var v Validator
+
+	v.String("ratee_id", r.RateeID).Required()
+	v.String("anchor_type", r.AnchorType).Required().OneOf(string(TrustAnchorEvent), string(TrustAnchorRideshare))
+	v.String("anchor_id", r.AnchorID).Required()
+	v.String("trust_level", r.TrustLevel).Required().OneOf(string(TrustLevelTrust), string(TrustLevelDistrust))
+	v.String("trust_review", r.TrustReview).Required().MaxLength(MaxTrustReviewLength)
+
+	return v.Errors()
 }
 
 // UpdateTrustRatingRequest represents a request to update a trust rating
@@ -155,22 +149,12 @@ type UpdateTrustRatingRequest struct {
 
 // Validate checks if the update request is valid
 func (r *UpdateTrustRatingRequest) Validate() []FieldError {
-	var errors []FieldError
-
-	if r.TrustLevel != nil {
-		if *r.TrustLevel != string(TrustLevelTrust) && *r.TrustLevel != string(TrustLevelDistrust) {
-			errors = append(errors, FieldError{Field: "trust_level", Message: "trust_level must be 'trust' or 'distrust'"})
-		}
-	}
-	if r.TrustReview != nil {
-		if *r.TrustReview == "" {
-			errors = append(errors, FieldError{Field: "trust_review", Message: "trust_review cannot be empty"})
-		} else if len(*r.TrustReview) > MaxTrustReviewLength {
-			errors = append(errors, FieldError{Field: "trust_review", Message: "trust_review must be 240 characters or less"})
-		}
-	}
-
-	return errors
+	var v Validator
+
+	v.OptionalString("trust_level", r.TrustLevel).OneOf(string(TrustLevelTrust), string(TrustLevelDistrust))
+	v.OptionalString("trust_review", r.TrustReview).NotEmpty().MaxLength(MaxTrustReviewLength)
+
+	return v.Errors()
 }
 
 // CreateEndorsementRequest represents a request to endorse a trust rating
@@ -181,18 +165,12 @@ type CreateEndorsementRequest struct {
 
 // Validate checks if the endorsement request is valid
 func (r *CreateEndorsementRequest) Validate() []FieldError {
-	var errors []FieldError
-
-	if r.EndorsementType == "" {
-		errors = append(errors, FieldError{Field: "endorsement_type", Message: "endorsement_type is required"})
-	} else if r.EndorsementType != string(EndorsementAgree) && r.EndorsementType != string(EndorsementDisagree) {
-		errors = append(errors, FieldError{Field: "endorsement_type", Message: "endorsement_type must be 'agree' or 'disagree'"})
-	}
-	if r.Note != nil && len(*r.Note) > MaxEndorsementNoteLength {
-		errors = append(errors, FieldError{Field: "note", Message: "note must be 240 characters or less"})
-	}
-
-	return errors
+	var v Validator
+
+	v.String("endorsement_type", r.EndorsementType).Required().OneOf(string(EndorsementAgree), string(EndorsementDisagree))
+	v.OptionalString("note", r.Note).MaxLength(MaxEndorsementNoteLength)
+
+	return v.Errors()
 }
 
 // DistrustSignal represents a user with significant distrust for admin review
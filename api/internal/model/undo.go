@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// UndoOperationType is the destructive action an undo operation reverses
+type UndoOperationType string
+
+const (
+	UndoAvailabilityDelete UndoOperationType = "availability_delete"
+	UndoGuildLeave         UndoOperationType = "guild_leave"
+	UndoRSVPCancel         UndoOperationType = "rsvp_cancel"
+)
+
+// UndoWindowMinutes is how long a destructive action can be undone
+const UndoWindowMinutes = 10
+
+// UndoOperation records a destructive action for the undo window. Data holds
+// what's needed to reverse it: the membership for a guild leave, the event
+// and previous status for an RSVP cancel. Deleted availability stays in place
+// marked pending-delete until the operation expires.
+type UndoOperation struct {
+	ID         string                 `json:"id"`
+	UserID     string                 `json:"-"`
+	Type       UndoOperationType      `json:"type"`
+	ResourceID string                 `json:"resource_id"`
+	Data       map[string]interface{} `json:"-"`
+	ExpiresOn  time.Time              `json:"expires_on"`
+	CreatedOn  time.Time              `json:"created_on"`
+}
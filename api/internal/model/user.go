@@ -6,9 +6,10 @@ import "time"
 type UserRole string
 
 const (
-	UserRoleUser      UserRole = "user"      // Default role
-	UserRoleModerator UserRole = "moderator" // Can review reports, issue warnings
-	UserRoleAdmin     UserRole = "admin"     // Full access including bans, system settings
+	UserRoleUser       UserRole = "user"       // Default role
+	UserRoleModerator  UserRole = "moderator"  // Can review reports, issue warnings
+	UserRoleAdmin      UserRole = "admin"      // Full access including bans, system settings
+	UserRoleSuperAdmin UserRole = "superadmin" // Admin who can also grant superadmin and manage legal holds
 )
 
 // User represents a user account
@@ -24,16 +25,24 @@ type User struct {
 	CreatedOn     time.Time  `json:"created_on"`
 	UpdatedOn     time.Time  `json:"updated_on"`
 	LoginOn       *time.Time `json:"login_on,omitempty"`
+
+	// When the handle (username) last changed; used to rate-limit changes
+	UsernameChangedOn *time.Time `json:"username_changed_on,omitempty"`
 }
 
-// IsAdmin returns true if the user has admin role
+// IsAdmin returns true if the user has admin or superadmin role
 func (u *User) IsAdmin() bool {
-	return u.Role == UserRoleAdmin
+	return u.Role == UserRoleAdmin || u.IsSuperAdmin()
+}
+
+// IsSuperAdmin returns true if the user has superadmin role
+func (u *User) IsSuperAdmin() bool {
+	return u.Role == UserRoleSuperAdmin
 }
 
-// IsModerator returns true if the user has moderator or admin role
+// IsModerator returns true if the user has moderator, admin or superadmin role
 func (u *User) IsModerator() bool {
-	return u.Role == UserRoleModerator || u.Role == UserRoleAdmin
+	return u.Role == UserRoleModerator || u.IsAdmin()
 }
 
 // CanModerate returns true if the user can perform moderation actions
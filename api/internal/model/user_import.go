@@ -0,0 +1,112 @@
+package model
+
+import "time"
+
+// UserImportStatus tracks an import through processing
+type UserImportStatus string
+
+const (
+	UserImportPending   UserImportStatus = "pending"
+	UserImportRunning   UserImportStatus = "running"
+	UserImportCompleted UserImportStatus = "completed"
+	UserImportFailed    UserImportStatus = "failed"
+)
+
+// UserImportRowStatus is the outcome of one CSV row
+type UserImportRowStatus string
+
+const (
+	UserImportRowCreated  UserImportRowStatus = "created"  // A new account was created
+	UserImportRowExisting UserImportRowStatus = "existing" // The email already had an account
+	UserImportRowInvalid  UserImportRowStatus = "invalid"  // The row failed validation and was skipped
+	UserImportRowFailed   UserImportRowStatus = "failed"   // Processing stopped part way; running the import again retries it
+)
+
+// User import constraints
+const (
+	MaxUserImportBytes      = 1 << 20 // Largest CSV file accepted
+	MaxUserImportRows       = 5000
+	MaxUserImportGuilds     = 10 // Guilds one row can be added to
+	MaxUserImportNameLength = 100
+	UserImportTimeout       = 30 * time.Minute // Running imports older than this are requeued
+	DefaultUserImportsLimit = 20
+	MaxUserImportsLimit     = 100
+)
+
+// UserImportColumns are the CSV header names. email and name are required;
+// guilds holds guild IDs or slugs separated by semicolons.
+const (
+	UserImportColumnEmail  = "email"
+	UserImportColumnName   = "name"
+	UserImportColumnGuilds = "guilds"
+)
+
+// UserImportRecord is one row to import. Line locates it in the upload:
+// its line in a CSV file, counting the header as line 1, or its position
+// in the member list of a guild import.
+type UserImportRecord struct {
+	Line   int      `json:"line"`
+	Email  string   `json:"email"`
+	Name   string   `json:"name"`
+	Guilds []string `json:"guilds,omitempty"`
+}
+
+// UserImportRow is the result of importing one row
+type UserImportRow struct {
+	Line    int                 `json:"line"`
+	Email   string              `json:"email"`
+	Status  UserImportRowStatus `json:"status"`
+	UserID  string              `json:"user_id,omitempty"`
+	Guilds  []string            `json:"guilds_joined,omitempty"` // Guild IDs the user was added to by this run
+	Invited bool                `json:"invited"`                 // An invitation email was sent by this run
+	Errors  []FieldError        `json:"errors,omitempty"`
+}
+
+// UserImportSummary counts row outcomes
+type UserImportSummary struct {
+	Created  int `json:"created"`
+	Existing int `json:"existing"`
+	Invalid  int `json:"invalid"`
+	Failed   int `json:"failed"`
+	Invited  int `json:"invited"`
+}
+
+// UserImport is a CSV file of members an admin uploaded to seed a community.
+// Rows are imported in the background; each row is idempotent, so uploading
+// the same file again creates no duplicates and only invites users who
+// haven't been sent an invitation that is still valid.
+type UserImport struct {
+	ID          string             `json:"id"`
+	Status      UserImportStatus   `json:"status"`
+	RequestedBy string             `json:"requested_by"`
+	TotalRows   int                `json:"total_rows"`
+	Records     []UserImportRecord `json:"-"`
+	Summary     UserImportSummary  `json:"summary"`
+	Rows        []UserImportRow    `json:"rows,omitempty"`
+	Error       string             `json:"error,omitempty"`
+	RequestID   string             `json:"request_id,omitempty"` // Request that queued the import
+	CreatedOn   time.Time          `json:"created_on"`
+	StartedOn   *time.Time         `json:"started_on,omitempty"`
+	CompletedOn *time.Time         `json:"completed_on,omitempty"`
+}
+
+// SummarizeUserImportRows counts the outcomes of imported rows
+func SummarizeUserImportRows(rows []UserImportRow) UserImportSummary {
+	var s UserImportSummary
+	for _, row := range rows {
+		switch row.Status {
+		case UserImportRowCreated:
+			s.Created++
+		case UserImportRowExisting:
+			s.Existing++
+		case UserImportRowInvalid:
+			s.Invalid++
+		case UserImportRowFailed:
+			s.Failed++
+		}
+		if row.Invited {
+			s.Invited++
+		}
+	}
+	return s
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// UserInvitationTTL is how long an emailed invitation link works
+const UserInvitationTTL = 14 * 24 * time.Hour
+
+// InvitationAcceptPath is the path on the public site that opens an
+// invitation link
+const InvitationAcceptPath = "/accept-invite"
+
+// UserInvitation lets a user whose account an admin created choose a
+// password. Accepting it also verifies the email, since the token was only
+// sent there.
+type UserInvitation struct {
+	ID         string     `json:"-"`
+	UserID     string     `json:"-"`
+	TokenHash  string     `json:"-"` // SHA-256 of the emailed token; the token itself is never stored
+	ExpiresOn  time.Time  `json:"expires_on"`
+	AcceptedOn *time.Time `json:"accepted_on,omitempty"`
+	CreatedOn  time.Time  `json:"created_on"`
+}
+
+// IsExpired reports whether the invitation link has lapsed
+func (i *UserInvitation) IsExpired(now time.Time) bool {
+	return !now.Before(i.ExpiresOn)
+}
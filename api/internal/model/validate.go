@@ -0,0 +1,258 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Validator collects FieldErrors for a request's Validate method. Rules are
+// declared per field and chained; a field keeps only its first error, so
+// later rules on a field that already failed are skipped.
+//
+//	var v Validator
+//	v.String("title", r.Title).Required().MaxLength(MaxVoteTitleLength)
+//	v.OptionalString("description", r.Description).MaxLength(MaxVoteDescriptionLength)
+//	v.Timestamp("opens_at", r.OpensAt).Required()
+//	v.Timestamp("closes_at", r.ClosesAt).Required().After("opens_at")
+//	return v.Errors()
+//
+// Messages name the field ("title is required", "title must be 200
+// characters or less") so clients can show them as they are.
+type Validator struct {
+	errors []FieldError
+	failed map[string]bool
+	times  map[string]time.Time // Parsed time fields, for cross-field rules
+}
+
+// Errors returns the collected errors, nil if the request is valid
+func (v *Validator) Errors() []FieldError {
+	return v.errors
+}
+
+// Fail records an error on field unless it already has one
+func (v *Validator) Fail(field, message string) {
+	if v.failed[field] {
+		return
+	}
+	if v.failed == nil {
+		v.failed = make(map[string]bool)
+	}
+	v.failed[field] = true
+	v.errors = append(v.errors, FieldError{Field: field, Message: message})
+}
+
+// Check fails field with message unless ok. Use it for rules the typed
+// helpers don't cover, such as conditions across several fields.
+func (v *Validator) Check(field string, ok bool, message string) {
+	if !ok {
+		v.Fail(field, message)
+	}
+}
+
+// Merge adds errors from another validation, such as a shared helper or a
+// nested value
+func (v *Validator) Merge(errors []FieldError) {
+	for _, e := range errors {
+		v.Fail(e.Field, e.Message)
+	}
+}
+
+// Failed reports whether field already has an error
+func (v *Validator) Failed(field string) bool {
+	return v.failed[field]
+}
+
+// ============================================================================
+// Strings
+// ============================================================================
+
+// StringRules are the rules for one string field
+type StringRules struct {
+	v     *Validator
+	field string
+	value string
+	skip  bool // Absent optional value
+}
+
+// String starts the rules for a string field
+func (v *Validator) String(field, value string) *StringRules {
+	return &StringRules{v: v, field: field, value: value}
+}
+
+// OptionalString starts the rules for a string field that may be omitted,
+// as in update requests. Every rule passes when value is nil.
+func (v *Validator) OptionalString(field string, value *string) *StringRules {
+	if value == nil {
+		return &StringRules{v: v, field: field, skip: true}
+	}
+	return &StringRules{v: v, field: field, value: *value}
+}
+
+func (s *StringRules) active() bool {
+	return !s.skip && !s.v.failed[s.field]
+}
+
+// Required fails when the value is empty or only whitespace
+func (s *StringRules) Required() *StringRules {
+	if s.active() && strings.TrimSpace(s.value) == "" {
+		s.v.Fail(s.field, s.field+" is required")
+	}
+	return s
+}
+
+// NotEmpty fails when a value that was sent is empty or only whitespace.
+// It is Required for fields an update may leave out.
+func (s *StringRules) NotEmpty() *StringRules {
+	if s.active() && strings.TrimSpace(s.value) == "" {
+		s.v.Fail(s.field, s.field+" cannot be empty")
+	}
+	return s
+}
+
+// MaxLength fails when the value is longer than n bytes
+func (s *StringRules) MaxLength(n int) *StringRules {
+	if s.active() && len(s.value) > n {
+		s.v.Fail(s.field, fmt.Sprintf("%s must be %d characters or less", s.field, n))
+	}
+	return s
+}
+
+// OneOf fails when the value isn't one of values. Put Required first for a
+// clearer message when the field is missing.
+func (s *StringRules) OneOf(values ...string) *StringRules {
+	if !s.active() {
+		return s
+	}
+	for _, allowed := range values {
+		if s.value == allowed {
+			return s
+		}
+	}
+	s.v.Fail(s.field, fmt.Sprintf("%s must be %s", s.field, list(values)))
+	return s
+}
+
+// list joins values as "a", "a or b" or "a, b, or c"
+func list(values []string) string {
+	switch len(values) {
+	case 0:
+		return ""
+	case 1:
+		return values[0]
+	case 2:
+		return values[0] + " or " + values[1]
+	}
+	return strings.Join(values[:len(values)-1], ", ") + ", or " + values[len(values)-1]
+}
+
+// ============================================================================
+// Integers
+// ============================================================================
+
+// IntRules are the rules for one integer field
+type IntRules struct {
+	v     *Validator
+	field string
+	value int
+	skip  bool
+}
+
+// Int starts the rules for an integer field
+func (v *Validator) Int(field string, value int) *IntRules {
+	return &IntRules{v: v, field: field, value: value}
+}
+
+// OptionalInt starts the rules for an integer field that may be omitted.
+// Every rule passes when value is nil.
+func (v *Validator) OptionalInt(field string, value *int) *IntRules {
+	if value == nil {
+		return &IntRules{v: v, field: field, skip: true}
+	}
+	return &IntRules{v: v, field: field, value: *value}
+}
+
+func (i *IntRules) active() bool {
+	return !i.skip && !i.v.failed[i.field]
+}
+
+// Min fails when the value is less than n
+func (i *IntRules) Min(n int) *IntRules {
+	if i.active() && i.value < n {
+		i.v.Fail(i.field, fmt.Sprintf("%s must be at least %d", i.field, n))
+	}
+	return i
+}
+
+// Between fails when the value is outside [lo, hi]
+func (i *IntRules) Between(lo, hi int) *IntRules {
+	if i.active() && (i.value < lo || i.value > hi) {
+		i.v.Fail(i.field, fmt.Sprintf("%s must be between %d and %d", i.field, lo, hi))
+	}
+	return i
+}
+
+// ============================================================================
+// Times
+// ============================================================================
+
+// TimeRules are the rules for one time field. Times that pass their own
+// rules are remembered by field name, so later fields can be compared with
+// them.
+type TimeRules struct {
+	v     *Validator
+	field string
+	value time.Time
+}
+
+// Time starts the rules for a time field. A zero time counts as not set.
+func (v *Validator) Time(field string, value time.Time) *TimeRules {
+	v.remember(field, value)
+	return &TimeRules{v: v, field: field, value: value}
+}
+
+// Timestamp starts the rules for a time sent as an RFC 3339 string. A
+// malformed value fails the field; an empty one counts as not set.
+func (v *Validator) Timestamp(field, value string) *TimeRules {
+	var t time.Time
+	if value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			v.Fail(field, field+" must be an RFC 3339 timestamp")
+		} else {
+			t = parsed
+		}
+	}
+	return v.Time(field, t)
+}
+
+func (v *Validator) remember(field string, t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	if v.times == nil {
+		v.times = make(map[string]time.Time)
+	}
+	v.times[field] = t
+}
+
+// Required fails when the time is not set
+func (t *TimeRules) Required() *TimeRules {
+	if !t.v.failed[t.field] && t.value.IsZero() {
+		t.v.Fail(t.field, t.field+" is required")
+	}
+	return t
+}
+
+// After fails when the time is not after the time in the other field. It
+// passes when either time is missing or failed its own rules, as those
+// fields already report why.
+func (t *TimeRules) After(other string) *TimeRules {
+	if t.v.failed[t.field] || t.value.IsZero() || t.v.failed[other] {
+		return t
+	}
+	if before, ok := t.v.times[other]; ok && !t.value.After(before) {
+		t.v.Fail(t.field, t.field+" must be after "+other)
+	}
+	return t
+}
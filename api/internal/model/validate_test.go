@@ -0,0 +1,152 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Validator Tests
+// ============================================================================
+
+func TestValidator_Valid(t *testing.T) {
+	t.Parallel()
+
+	var v Validator
+	v.String("title", "Picnic").Required().MaxLength(10)
+	v.OptionalString("description", nil).NotEmpty().MaxLength(1)
+	v.OptionalInt("max_slots", nil).Min(1)
+	v.Int("limit", 5).Between(1, 10)
+
+	if errs := v.Errors(); errs != nil {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+}
+
+func TestValidator_FirstErrorPerField(t *testing.T) {
+	t.Parallel()
+
+	var v Validator
+	v.String("kind", "").Required().OneOf("a", "b")
+	v.String("name", "far too long").MaxLength(3).OneOf("abc")
+	v.Check("name", false, "unreachable")
+
+	want := []FieldError{
+		{Field: "kind", Message: "kind is required"},
+		{Field: "name", Message: "name must be 3 characters or less"},
+	}
+	if got := v.Errors(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestValidator_Messages(t *testing.T) {
+	t.Parallel()
+
+	empty := " "
+	tests := []struct {
+		name string
+		rule func(v *Validator)
+		want string
+	}{
+		{"not empty", func(v *Validator) { v.OptionalString("f", &empty).NotEmpty() }, "f cannot be empty"},
+		{"one of two", func(v *Validator) { v.String("f", "x").OneOf("a", "b") }, "f must be a or b"},
+		{"one of many", func(v *Validator) { v.String("f", "x").OneOf("a", "b", "c") }, "f must be a, b, or c"},
+		{"min", func(v *Validator) { v.Int("f", 0).Min(1) }, "f must be at least 1"},
+		{"between", func(v *Validator) { v.Int("f", 11).Between(1, 10) }, "f must be between 1 and 10"},
+		{"time required", func(v *Validator) { v.Time("f", time.Time{}).Required() }, "f is required"},
+		{"timestamp format", func(v *Validator) { v.Timestamp("f", "tomorrow").Required() }, "f must be an RFC 3339 timestamp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var v Validator
+			tt.rule(&v)
+			errs := v.Errors()
+			if len(errs) != 1 || errs[0].Field != "f" || errs[0].Message != tt.want {
+				t.Errorf("expected %q on f, got %v", tt.want, errs)
+			}
+		})
+	}
+}
+
+func TestValidator_After(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		end  time.Time
+		ok   bool
+	}{
+		{"later", start.Add(time.Hour), true},
+		{"same time", start, false},
+		{"earlier", start.Add(-time.Hour), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var v Validator
+			v.Time("start", start)
+			v.Time("end", tt.end).After("start")
+			if errs := v.Errors(); (len(errs) == 0) != tt.ok {
+				t.Errorf("expected ok=%v, got %v", tt.ok, errs)
+			}
+		})
+	}
+}
+
+func TestValidator_After_SkipsMissingOrInvalidOther(t *testing.T) {
+	t.Parallel()
+
+	var v Validator
+	v.Timestamp("opens_at", "not a time")
+	v.Timestamp("closes_at", "2025-01-01T00:00:00Z").After("opens_at")
+	v.Timestamp("ends_at", "2025-01-01T00:00:00Z").After("starts_at")
+
+	errs := v.Errors()
+	if len(errs) != 1 || errs[0].Field != "opens_at" {
+		t.Errorf("expected only the opens_at format error, got %v", errs)
+	}
+}
+
+// ============================================================================
+// Cross-Field Rule Tests
+// ============================================================================
+
+func TestCreateVoteRequest_Validate_ClosesBeforeOpens(t *testing.T) {
+	t.Parallel()
+
+	req := &CreateVoteRequest{
+		ScopeType: "global",
+		Title:     "Vote Title",
+		VoteType:  "fptp",
+		OpensAt:   "2025-01-02T00:00:00Z",
+		ClosesAt:  "2025-01-01T00:00:00Z",
+	}
+
+	errs := req.Validate()
+	if len(errs) != 1 || errs[0].Field != "closes_at" || errs[0].Message != "closes_at must be after opens_at" {
+		t.Errorf("expected a closes_at ordering error, got %v", errs)
+	}
+}
+
+func TestCreateVoteRequest_Validate_MalformedTime(t *testing.T) {
+	t.Parallel()
+
+	req := &CreateVoteRequest{
+		ScopeType: "global",
+		Title:     "Vote Title",
+		VoteType:  "fptp",
+		OpensAt:   "2025-01-01",
+		ClosesAt:  "2025-01-02T00:00:00Z",
+	}
+
+	errs := req.Validate()
+	if len(errs) != 1 || errs[0].Field != "opens_at" {
+		t.Errorf("expected an opens_at format error, got %v", errs)
+	}
+}
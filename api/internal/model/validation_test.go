@@ -1671,6 +1671,32 @@ func TestGetActivityStatus_Away(t *testing.T) {
 	}
 }
 
+func TestGetFreshnessBucket(t *testing.T) {
+	t.Parallel()
+
+	ago := func(d time.Duration) *time.Time {
+		at := time.Now().Add(-d)
+		return &at
+	}
+	tests := []struct {
+		lastActive *time.Time
+		want       FreshnessBucket
+	}{
+		{nil, FreshnessBucketAway},
+		{ago(time.Minute), FreshnessBucketActiveNow},
+		{ago(45 * time.Minute), FreshnessBucketActiveRecently},
+		{ago(5 * time.Hour), FreshnessBucketActiveToday},
+		{ago(3 * 24 * time.Hour), FreshnessBucketActiveThisWeek},
+		{ago(10 * 24 * time.Hour), FreshnessBucketAway},
+	}
+
+	for _, tt := range tests {
+		if got := GetFreshnessBucket(tt.lastActive); got != tt.want {
+			t.Errorf("GetFreshnessBucket(%v) = %v, want %v", tt.lastActive, got, tt.want)
+		}
+	}
+}
+
 func TestGetDistanceBucket_Nearby(t *testing.T) {
 	t.Parallel()
 
@@ -1804,3 +1830,262 @@ func TestAdventure_IsUserOrganized(t *testing.T) {
 		t.Error("expected not user organized")
 	}
 }
+
+// ============================================================================
+// SetHandleRequest Tests
+// ============================================================================
+
+func TestSetHandleRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		handle string
+		valid  bool
+	}{
+		{"ada_lovelace", true},
+		{"@Ada99", true},
+		{"ab", false},
+		{strings.Repeat("a", MaxHandleLength+1), false},
+		{"9lives", false},
+		{"has-dash", false},
+		{"has space", false},
+		{"Admin", false},
+		{"@support", false},
+	}
+
+	for _, tt := range tests {
+		req := &SetHandleRequest{Handle: tt.handle}
+		if errs := req.Validate(); (len(errs) == 0) != tt.valid {
+			t.Errorf("handle %q: expected valid=%v, got errors %v", tt.handle, tt.valid, errs)
+		}
+	}
+}
+
+func TestNormalizeHandle(t *testing.T) {
+	t.Parallel()
+
+	if got := NormalizeHandle(" @Ada_Lovelace "); got != "ada_lovelace" {
+		t.Errorf("expected ada_lovelace, got %q", got)
+	}
+}
+
+// ============================================================================
+// Guild Slug Tests
+// ============================================================================
+
+func TestValidateGuildSlug(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		slug  string
+		valid bool
+	}{
+		{"board-games", true},
+		{"club42", true},
+		{"ab", false},
+		{strings.Repeat("a", MaxGuildSlugLength+1), false},
+		{"Board-Games", false},
+		{"double--hyphen", false},
+		{"-leading", false},
+		{"trailing-", false},
+		{"under_score", false},
+		{"admin", false},
+	}
+
+	for _, tt := range tests {
+		if errs := ValidateGuildSlug(tt.slug); (len(errs) == 0) != tt.valid {
+			t.Errorf("slug %q: expected valid=%v, got errors %v", tt.slug, tt.valid, errs)
+		}
+	}
+}
+
+func TestSlugifyGuildName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Tuesday Board Games!", "tuesday-board-games"},
+		{"  Café -- Club  ", "caf-club"},
+		{"!!!", ""},
+		{strings.Repeat("word ", 20), strings.TrimSuffix(strings.Repeat("word-", 10), "-")},
+	}
+
+	for _, tt := range tests {
+		if got := SlugifyGuildName(tt.name); got != tt.want {
+			t.Errorf("SlugifyGuildName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// ============================================================================
+// Share Link Tests
+// ============================================================================
+
+func TestCreateShareLinkRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name  string
+		req   CreateShareLinkRequest
+		valid bool
+	}{
+		{"event share", CreateShareLinkRequest{TargetType: ShareTargetEvent, TargetID: "event:1"}, true},
+		{"guild invite with limits", CreateShareLinkRequest{TargetType: ShareTargetGuild, TargetID: "guild:1", Invite: true, MaxUses: intPtr(5), ExpiresInHours: intPtr(48)}, true},
+		{"unknown target type", CreateShareLinkRequest{TargetType: "user", TargetID: "user:1"}, false},
+		{"missing target id", CreateShareLinkRequest{TargetType: ShareTargetGuild}, false},
+		{"event invite", CreateShareLinkRequest{TargetType: ShareTargetEvent, TargetID: "event:1", Invite: true}, false},
+		{"max uses without invite", CreateShareLinkRequest{TargetType: ShareTargetGuild, TargetID: "guild:1", MaxUses: intPtr(5)}, false},
+		{"max uses too high", CreateShareLinkRequest{TargetType: ShareTargetAdventure, TargetID: "adventure:1", Invite: true, MaxUses: intPtr(MaxShareLinkUses + 1)}, false},
+		{"expiry too long", CreateShareLinkRequest{TargetType: ShareTargetEvent, TargetID: "event:1", ExpiresInHours: intPtr(MaxShareLinkTTLHours + 1)}, false},
+		{"zero expiry", CreateShareLinkRequest{TargetType: ShareTargetEvent, TargetID: "event:1", ExpiresInHours: intPtr(0)}, false},
+	}
+
+	for _, tt := range tests {
+		if errs := tt.req.Validate(); (len(errs) == 0) != tt.valid {
+			t.Errorf("%s: expected valid=%v, got errors %v", tt.name, tt.valid, errs)
+		}
+	}
+}
+
+// ============================================================================
+// Announcement Tests
+// ============================================================================
+
+func TestCreateAnnouncementRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	guildID := "guild:1"
+	tests := []struct {
+		name  string
+		req   CreateAnnouncementRequest
+		valid bool
+	}{
+		{"everyone", CreateAnnouncementRequest{Title: "Hi", Body: "Hello", Audience: AnnouncementAudienceAll}, true},
+		{"guild", CreateAnnouncementRequest{Title: "Hi", Body: "Hello", Audience: AnnouncementAudienceGuild, GuildID: &guildID}, true},
+		{"area", CreateAnnouncementRequest{Title: "Hi", Body: "Hello", Audience: AnnouncementAudienceArea, Area: &AnnouncementArea{Lat: 38.7, Lng: -9.1, RadiusKm: 25}}, true},
+		{"blank title", CreateAnnouncementRequest{Title: "   ", Body: "Hello", Audience: AnnouncementAudienceAll}, false},
+		{"long body", CreateAnnouncementRequest{Title: "Hi", Body: strings.Repeat("a", MaxAnnouncementBodyLength+1), Audience: AnnouncementAudienceAll}, false},
+		{"unknown audience", CreateAnnouncementRequest{Title: "Hi", Body: "Hello", Audience: "friends"}, false},
+		{"guild without id", CreateAnnouncementRequest{Title: "Hi", Body: "Hello", Audience: AnnouncementAudienceGuild}, false},
+		{"area without area", CreateAnnouncementRequest{Title: "Hi", Body: "Hello", Audience: AnnouncementAudienceArea}, false},
+		{"area too wide", CreateAnnouncementRequest{Title: "Hi", Body: "Hello", Audience: AnnouncementAudienceArea, Area: &AnnouncementArea{Lat: 38.7, Lng: -9.1, RadiusKm: MaxAnnouncementRadiusKm + 1}}, false},
+		{"area bad latitude", CreateAnnouncementRequest{Title: "Hi", Body: "Hello", Audience: AnnouncementAudienceArea, Area: &AnnouncementArea{Lat: 91, Lng: -9.1, RadiusKm: 5}}, false},
+	}
+
+	for _, tt := range tests {
+		if errs := tt.req.Validate(); (len(errs) == 0) != tt.valid {
+			t.Errorf("%s: expected valid=%v, got errors %v", tt.name, tt.valid, errs)
+		}
+	}
+}
+
+// ============================================================================
+// Scheduled Publishing Tests
+// ============================================================================
+
+func TestSchedulePublicationRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	publishAt := time.Now().Add(time.Hour)
+	tests := []struct {
+		name  string
+		req   SchedulePublicationRequest
+		valid bool
+	}{
+		{"publish time", SchedulePublicationRequest{PublishAt: &publishAt}, true},
+		{"missing publish time", SchedulePublicationRequest{}, false},
+		{"zero publish time", SchedulePublicationRequest{PublishAt: &time.Time{}}, false},
+	}
+
+	for _, tt := range tests {
+		if errs := tt.req.Validate(); (len(errs) == 0) != tt.valid {
+			t.Errorf("%s: expected valid=%v, got errors %v", tt.name, tt.valid, errs)
+		}
+	}
+}
+
+// ============================================================================
+// Draft Validation Tests
+// ============================================================================
+
+func TestSaveDraftRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		req   SaveDraftRequest
+		valid bool
+	}{
+		{"event draft", SaveDraftRequest{ResourceType: DraftResourceEvent, Data: map[string]interface{}{"title": "Picnic"}}, true},
+		{"empty adventure draft", SaveDraftRequest{ResourceType: DraftResourceAdventure, Data: map[string]interface{}{}}, true},
+		{"unsupported type", SaveDraftRequest{ResourceType: "vote", Data: map[string]interface{}{}}, false},
+		{"missing data", SaveDraftRequest{ResourceType: DraftResourceEvent}, false},
+		{"oversized data", SaveDraftRequest{ResourceType: DraftResourceEvent, Data: map[string]interface{}{"description": strings.Repeat("a", MaxDraftDataBytes)}}, false},
+	}
+
+	for _, tt := range tests {
+		if errs := tt.req.Validate(); (len(errs) == 0) != tt.valid {
+			t.Errorf("%s: expected valid=%v, got errors %v", tt.name, tt.valid, errs)
+		}
+	}
+}
+
+func TestCreateEventRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		req   CreateEventRequest
+		valid bool
+	}{
+		{"title and start", CreateEventRequest{Title: "Picnic", StartTime: time.Now()}, true},
+		{"missing title", CreateEventRequest{StartTime: time.Now()}, false},
+		{"missing start", CreateEventRequest{Title: "Picnic"}, false},
+	}
+
+	for _, tt := range tests {
+		if errs := tt.req.Validate(); (len(errs) == 0) != tt.valid {
+			t.Errorf("%s: expected valid=%v, got errors %v", tt.name, tt.valid, errs)
+		}
+	}
+}
+
+// ============================================================================
+// Required Field Tests
+// ============================================================================
+
+func TestRequiredFields_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		req        interface{ Validate() []FieldError }
+		wantFields string
+	}{
+		{"availability", &CreateAvailabilityRequest{StartTime: "2026-01-01T18:00:00Z", EndTime: "2026-01-01T20:00:00Z", HangoutType: "coffee"}, ""},
+		{"availability missing all", &CreateAvailabilityRequest{}, "start_time,end_time,hangout_type"},
+		{"availability blank hangout type", &CreateAvailabilityRequest{StartTime: "2026-01-01T18:00:00Z", EndTime: "2026-01-01T20:00:00Z", HangoutType: " "}, "hangout_type"},
+		{"review", &CreateReviewRequest{RevieweeID: "user:2", Context: ReviewContextHangout}, ""},
+		{"review missing reviewee", &CreateReviewRequest{Context: ReviewContextHangout}, "reviewee_id"},
+		{"review missing all", &CreateReviewRequest{}, "reviewee_id,context"},
+		{"answer", &AnswerQuestionRequest{SelectedOption: "yes"}, ""},
+		{"answer missing option", &AnswerQuestionRequest{Importance: "very"}, "selected_option"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var fields []string
+			for _, e := range tt.req.Validate() {
+				fields = append(fields, e.Field)
+			}
+			if got := strings.Join(fields, ","); got != tt.wantFields {
+				t.Errorf("Validate() fields = %q, want %q", got, tt.wantFields)
+			}
+		})
+	}
+}
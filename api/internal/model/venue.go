@@ -0,0 +1,115 @@
+package model
+
+import "time"
+
+// Venue is a place a guild saves to reuse across its events. Events copy the
+// venue into their location when created, so later edits to the venue don't
+// move events already scheduled there.
+type Venue struct {
+	ID           string   `json:"id"`
+	GuildID      string   `json:"guild_id"`
+	Name         string   `json:"name"`
+	Address      string   `json:"address"`
+	Neighborhood *string  `json:"neighborhood,omitempty"`
+	City         string   `json:"city"`
+	Lat          *float64 `json:"lat,omitempty"` // Entered or geocoded; nil when unknown
+	Lng          *float64 `json:"lng,omitempty"`
+	Capacity     *int     `json:"capacity,omitempty"` // Most people the space holds
+	// Features from AccessibilityFeatures
+	Accessibility []string  `json:"accessibility,omitempty"`
+	CreatedBy     string    `json:"created_by"`
+	CreatedOn     time.Time `json:"created_on"`
+	UpdatedOn     time.Time `json:"updated_on"`
+}
+
+// EventLocation returns the location an event at the venue gets
+func (v *Venue) EventLocation() *EventLocation {
+	address := v.Address
+	loc := &EventLocation{
+		Name:          v.Name,
+		Address:       &address,
+		Neighborhood:  v.Neighborhood,
+		City:          v.City,
+		Accessibility: v.Accessibility,
+	}
+	if v.Lat != nil && v.Lng != nil {
+		loc.Lat, loc.Lng = *v.Lat, *v.Lng
+	}
+	return loc
+}
+
+// Venue constraints
+const (
+	MaxGuildVenues        = 200
+	MaxVenueNameLength    = 100
+	MaxVenueAddressLength = 300
+	MaxVenueCityLength    = 100
+	MaxVenueCapacity      = 100000
+)
+
+// CreateVenueRequest represents a request to save a venue to a guild. The
+// address is geocoded when no coordinates are given.
+type CreateVenueRequest struct {
+	Name          string   `json:"name"`
+	Address       string   `json:"address"`
+	Neighborhood  *string  `json:"neighborhood,omitempty"`
+	City          string   `json:"city"`
+	Lat           *float64 `json:"lat,omitempty"`
+	Lng           *float64 `json:"lng,omitempty"`
+	Capacity      *int     `json:"capacity,omitempty"`
+	Accessibility []string `json:"accessibility,omitempty"`
+}
+
+// Validate validates the create venue request
+func (r *CreateVenueRequest) Validate() []FieldError {
+	var v Validator
+
+	v.String("name", r.Name).Required().MaxLength(MaxVenueNameLength)
+	v.String("address", r.Address).Required().MaxLength(MaxVenueAddressLength)
+	v.OptionalString("neighborhood", r.Neighborhood).MaxLength(MaxVenueCityLength)
+	v.String("city", r.City).Required().MaxLength(MaxVenueCityLength)
+	validateCoordinates(&v, r.Lat, r.Lng)
+	v.OptionalInt("capacity", r.Capacity).Between(1, MaxVenueCapacity)
+	v.Check("accessibility", ValidAccessibility(r.Accessibility), InvalidAccessibilityMessage)
+
+	return v.Errors()
+}
+
+// UpdateVenueRequest represents a request to change a saved venue. A new
+// address without coordinates is geocoded again.
+type UpdateVenueRequest struct {
+	Name          *string  `json:"name,omitempty"`
+	Address       *string  `json:"address,omitempty"`
+	Neighborhood  *string  `json:"neighborhood,omitempty"`
+	City          *string  `json:"city,omitempty"`
+	Lat           *float64 `json:"lat,omitempty"`
+	Lng           *float64 `json:"lng,omitempty"`
+	Capacity      *int     `json:"capacity,omitempty"`
+	Accessibility []string `json:"accessibility,omitempty"` // Replaces the list
+}
+
+// Validate validates the update venue request
+func (r *UpdateVenueRequest) Validate() []FieldError {
+	var v Validator
+
+	v.OptionalString("name", r.Name).NotEmpty().MaxLength(MaxVenueNameLength)
+	v.OptionalString("address", r.Address).NotEmpty().MaxLength(MaxVenueAddressLength)
+	v.OptionalString("neighborhood", r.Neighborhood).MaxLength(MaxVenueCityLength)
+	v.OptionalString("city", r.City).NotEmpty().MaxLength(MaxVenueCityLength)
+	validateCoordinates(&v, r.Lat, r.Lng)
+	v.OptionalInt("capacity", r.Capacity).Between(1, MaxVenueCapacity)
+	v.Check("accessibility", ValidAccessibility(r.Accessibility), InvalidAccessibilityMessage)
+
+	return v.Errors()
+}
+
+// validateCoordinates requires lat and lng together and on the globe
+func validateCoordinates(v *Validator, lat, lng *float64) {
+	v.Check("lat", (lat == nil) == (lng == nil), "lat and lng must be given together")
+	if lat != nil {
+		v.Check("lat", *lat >= -90 && *lat <= 90, "lat must be between -90 and 90")
+	}
+	if lng != nil {
+		v.Check("lng", *lng >= -180 && *lng <= 180, "lng must be between -180 and 180")
+	}
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Venue Tests
+// ============================================================================
+
+func TestCreateVenueRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	lat, lng, offGlobe := 39.7, -104.9, 95.0
+	zero := 0
+
+	tests := []struct {
+		name      string
+		modify    func(r *CreateVenueRequest)
+		wantField string
+	}{
+		{"valid", func(r *CreateVenueRequest) {}, ""},
+		{"with coordinates", func(r *CreateVenueRequest) { r.Lat, r.Lng = &lat, &lng }, ""},
+		{"missing address", func(r *CreateVenueRequest) { r.Address = "" }, "address"},
+		{"lat without lng", func(r *CreateVenueRequest) { r.Lat = &lat }, "lat"},
+		{"lat off the globe", func(r *CreateVenueRequest) { r.Lat, r.Lng = &offGlobe, &lng }, "lat"},
+		{"zero capacity", func(r *CreateVenueRequest) { r.Capacity = &zero }, "capacity"},
+		{"unknown feature", func(r *CreateVenueRequest) { r.Accessibility = []string{"elevator"} }, "accessibility"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := &CreateVenueRequest{Name: "Library", Address: "5 Elm St", City: "Denver"}
+			tt.modify(req)
+			errs := req.Validate()
+			if tt.wantField == "" {
+				if len(errs) > 0 {
+					t.Errorf("Validate() = %v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) == 0 || errs[0].Field != tt.wantField {
+				t.Errorf("Validate() = %v, want an error on %s", errs, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestCreateEventRequest_ValidateVenue(t *testing.T) {
+	t.Parallel()
+
+	guildID, venueID := "guild:1", "venue:1"
+	base := func() *CreateEventRequest {
+		return &CreateEventRequest{Title: "Board games", StartTime: time.Now().Add(time.Hour), GuildID: &guildID, VenueID: &venueID}
+	}
+
+	if errs := base().Validate(); len(errs) > 0 {
+		t.Errorf("venue only: Validate() = %v, want no errors", errs)
+	}
+
+	both := base()
+	both.Location = &EventLocation{Name: "Library", City: "Denver"}
+	if errs := both.Validate(); len(errs) == 0 || errs[0].Field != "venue_id" {
+		t.Errorf("venue and location: Validate() = %v, want a venue_id error", errs)
+	}
+
+	noGuild := base()
+	noGuild.GuildID = nil
+	if errs := noGuild.Validate(); len(errs) == 0 || errs[0].Field != "venue_id" {
+		t.Errorf("without a guild: Validate() = %v, want a venue_id error", errs)
+	}
+}
+
+func TestVenue_EventLocation(t *testing.T) {
+	t.Parallel()
+
+	venue := &Venue{Name: "Hall", Address: "1 Main St", City: "Denver", Accessibility: []string{AccessibilityQuietSpace}}
+	loc := venue.EventLocation()
+	if loc.Name != "Hall" || loc.Address == nil || *loc.Address != "1 Main St" || loc.Lat != 0 || len(loc.Accessibility) != 1 {
+		t.Errorf("EventLocation() = %+v, want the venue without coordinates", loc)
+	}
+}
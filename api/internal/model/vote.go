@@ -20,16 +20,27 @@ const (
 	VoteTypeMultiSelect  VoteType = "multi_select"  // Select up to N options
 )
 
+var voteTypes = []string{
+	string(VoteTypeFPTP), string(VoteTypeRankedChoice),
+	string(VoteTypeApproval), string(VoteTypeMultiSelect),
+}
+
 // VoteStatus represents the lifecycle stage of a vote
 type VoteStatus string
 
 const (
+	VoteStatusScheduled VoteStatus = "scheduled" // Hidden until publish_at
 	VoteStatusDraft     VoteStatus = "draft"     // Not yet open
 	VoteStatusOpen      VoteStatus = "open"      // Accepting ballots
 	VoteStatusClosed    VoteStatus = "closed"    // Voting ended
 	VoteStatusCancelled VoteStatus = "cancelled" // Vote was cancelled
 )
 
+// IsEditable reports whether the vote and its options can still be changed
+func (s VoteStatus) IsEditable() bool {
+	return s == VoteStatusDraft || s == VoteStatusScheduled
+}
+
 // ResultsVisibility determines when results are visible
 type ResultsVisibility string
 
@@ -39,6 +50,22 @@ const (
 	ResultsVisibilityAdminOnly  ResultsVisibility = "admin_only"  // Only admins can see results
 )
 
+var resultsVisibilities = []string{
+	string(ResultsVisibilityLive), string(ResultsVisibilityAfterClose), string(ResultsVisibilityAdminOnly),
+}
+
+// VoteListFields is what vote lists can be sorted and filtered by
+var VoteListFields = ListFields{
+	Sort: []string{"created_on", "opens_at", "closes_at", "title"},
+	Filter: map[string][]string{
+		"status": {
+			string(VoteStatusDraft), string(VoteStatusOpen), string(VoteStatusClosed), string(VoteStatusCancelled),
+		},
+		"vote_type":          voteTypes,
+		"results_visibility": resultsVisibilities,
+	},
+}
+
 // Vote represents a voting poll
 type Vote struct {
 	ID                   string            `json:"id"`
@@ -51,9 +78,11 @@ type Vote struct {
 	OpensAt              time.Time         `json:"opens_at"`
 	ClosesAt             time.Time         `json:"closes_at"`
 	Status               VoteStatus        `json:"status"`
+	PublishAt            *time.Time        `json:"publish_at,omitempty"` // When a scheduled vote is published
 	ResultsVisibility    ResultsVisibility `json:"results_visibility"`
 	MaxOptionsSelectable *int              `json:"max_options_selectable,omitempty"` // For multi_select
 	AllowAbstain         bool              `json:"allow_abstain"`
+	RemindersDisabled    bool              `json:"reminders_disabled"` // Creator opt-out of closing reminders
 	CreatedOn            time.Time         `json:"created_on"`
 	UpdatedOn            time.Time         `json:"updated_on"`
 	// Computed fields
@@ -167,62 +196,32 @@ type CreateVoteRequest struct {
 	ResultsVisibility    *string `json:"results_visibility,omitempty"`     // live, after_close, admin_only
 	MaxOptionsSelectable *int    `json:"max_options_selectable,omitempty"` // For multi_select
 	AllowAbstain         bool    `json:"allow_abstain,omitempty"`
+	RemindersDisabled    bool    `json:"reminders_disabled,omitempty"` // Skip closing reminders to non-voters
+	PublishAt            *string `json:"publish_at,omitempty"`         // RFC3339 datetime; hidden until then
 }
 
 // Validate checks if the create request is valid
 func (r *CreateVoteRequest) Validate() []FieldError {
-	var errors []FieldError
-
-	if r.ScopeType == "" {
-		errors = append(errors, FieldError{Field: "scope_type", Message: "scope_type is required"})
-	} else if r.ScopeType != string(VoteScopeGuild) && r.ScopeType != string(VoteScopeGlobal) {
-		errors = append(errors, FieldError{Field: "scope_type", Message: "scope_type must be 'guild' or 'global'"})
-	}
-	if r.ScopeType == string(VoteScopeGuild) && (r.ScopeID == nil || *r.ScopeID == "") {
-		errors = append(errors, FieldError{Field: "scope_id", Message: "scope_id is required for guild votes"})
-	}
-	if r.Title == "" {
-		errors = append(errors, FieldError{Field: "title", Message: "title is required"})
-	} else if len(r.Title) > MaxVoteTitleLength {
-		errors = append(errors, FieldError{Field: "title", Message: "title must be 200 characters or less"})
-	}
-	if r.Description != nil && len(*r.Description) > MaxVoteDescriptionLength {
-		errors = append(errors, FieldError{Field: "description", Message: "description must be 2000 characters or less"})
-	}
-	if r.VoteType == "" {
-		errors = append(errors, FieldError{Field: "vote_type", Message: "vote_type is required"})
-	} else {
-		validTypes := map[string]bool{
-			string(VoteTypeFPTP): true, string(VoteTypeRankedChoice): true,
-			string(VoteTypeApproval): true, string(VoteTypeMultiSelect): true,
-		}
-		if !validTypes[r.VoteType] {
-			errors = append(errors, FieldError{Field: "vote_type", Message: "vote_type must be fptp, ranked_choice, approval, or multi_select"})
-		}
-	}
-	if r.OpensAt == "" {
-		errors = append(errors, FieldError{Field: "opens_at", Message: "opens_at is required"})
-	}
-	if r.ClosesAt == "" {
-		errors = append(errors, FieldError{Field: "closes_at", Message: "closes_at is required"})
-	}
-	if r.ResultsVisibility != nil {
-		validVisibility := map[string]bool{
-			string(ResultsVisibilityLive): true, string(ResultsVisibilityAfterClose): true,
-			string(ResultsVisibilityAdminOnly): true,
-		}
-		if !validVisibility[*r.ResultsVisibility] {
-			errors = append(errors, FieldError{Field: "results_visibility", Message: "results_visibility must be live, after_close, or admin_only"})
-		}
-	}
-	if r.VoteType == string(VoteTypeMultiSelect) && r.MaxOptionsSelectable != nil && *r.MaxOptionsSelectable < 1 {
-		errors = append(errors, FieldError{Field: "max_options_selectable", Message: "max_options_selectable must be at least 1"})
+	var v Validator
+
+	v.String("scope_type", r.ScopeType).Required().OneOf(string(VoteScopeGuild), string(VoteScopeGlobal))
+	v.Check("scope_id", r.ScopeType != string(VoteScopeGuild) || (r.ScopeID != nil && *r.ScopeID != ""),
+		"scope_id is required for guild votes")
+	v.String("title", r.Title).Required().MaxLength(MaxVoteTitleLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxVoteDescriptionLength)
+	v.String("vote_type", r.VoteType).Required().OneOf(voteTypes...)
+	v.Timestamp("opens_at", r.OpensAt).Required()
+	v.Timestamp("closes_at", r.ClosesAt).Required().After("opens_at")
+	v.OptionalString("results_visibility", r.ResultsVisibility).OneOf(resultsVisibilities...)
+	if r.VoteType == string(VoteTypeMultiSelect) {
+		v.OptionalInt("max_options_selectable", r.MaxOptionsSelectable).Min(1)
 	}
 
-	return errors
+	return v.Errors()
 }
 
-// UpdateVoteRequest represents a request to update a vote (only when draft)
+// UpdateVoteRequest represents a request to update a vote (only when draft).
+// The reminder opt-out can also be changed while the vote is open.
 type UpdateVoteRequest struct {
 	Title                *string `json:"title,omitempty"`
 	Description          *string `json:"description,omitempty"`
@@ -231,33 +230,25 @@ type UpdateVoteRequest struct {
 	ResultsVisibility    *string `json:"results_visibility,omitempty"`
 	MaxOptionsSelectable *int    `json:"max_options_selectable,omitempty"`
 	AllowAbstain         *bool   `json:"allow_abstain,omitempty"`
+	RemindersDisabled    *bool   `json:"reminders_disabled,omitempty"`
+}
+
+// RemindersOnly reports whether the request changes nothing but the reminder opt-out
+func (r *UpdateVoteRequest) RemindersOnly() bool {
+	return r.RemindersDisabled != nil && r.Title == nil && r.Description == nil &&
+		r.OpensAt == nil && r.ClosesAt == nil && r.ResultsVisibility == nil &&
+		r.MaxOptionsSelectable == nil && r.AllowAbstain == nil
 }
 
 // Validate checks if the update request is valid
 func (r *UpdateVoteRequest) Validate() []FieldError {
-	var errors []FieldError
-
-	if r.Title != nil {
-		if *r.Title == "" {
-			errors = append(errors, FieldError{Field: "title", Message: "title cannot be empty"})
-		} else if len(*r.Title) > MaxVoteTitleLength {
-			errors = append(errors, FieldError{Field: "title", Message: "title must be 200 characters or less"})
-		}
-	}
-	if r.Description != nil && len(*r.Description) > MaxVoteDescriptionLength {
-		errors = append(errors, FieldError{Field: "description", Message: "description must be 2000 characters or less"})
-	}
-	if r.ResultsVisibility != nil {
-		validVisibility := map[string]bool{
-			string(ResultsVisibilityLive): true, string(ResultsVisibilityAfterClose): true,
-			string(ResultsVisibilityAdminOnly): true,
-		}
-		if !validVisibility[*r.ResultsVisibility] {
-			errors = append(errors, FieldError{Field: "results_visibility", Message: "results_visibility must be live, after_close, or admin_only"})
-		}
-	}
+	var v Validator
+
+	v.OptionalString("title", r.Title).NotEmpty().MaxLength(MaxVoteTitleLength)
+	v.OptionalString("description", r.Description).MaxLength(MaxVoteDescriptionLength)
+	v.OptionalString("results_visibility", r.ResultsVisibility).OneOf(resultsVisibilities...)
 
-	return errors
+	return v.Errors()
 }
 
 // CreateVoteOptionRequest represents a request to add an option
@@ -269,18 +260,12 @@ type CreateVoteOptionRequest struct {
 
 // Validate checks if the request is valid
 func (r *CreateVoteOptionRequest) Validate() []FieldError {
-	var errors []FieldError
+	var v Validator
 
-	if r.OptionText == "" {
-		errors = append(errors, FieldError{Field: "option_text", Message: "option_text is required"})
-	} else if len(r.OptionText) > MaxOptionTextLength {
-		errors = append(errors, FieldError{Field: "option_text", Message: "option_text must be 200 characters or less"})
-	}
-	if r.OptionDescription != nil && len(*r.OptionDescription) > MaxOptionDescLength {
-		errors = append(errors, FieldError{Field: "option_description", Message: "option_description must be 500 characters or less"})
-	}
+	v.String("option_text", r.OptionText).Required().MaxLength(MaxOptionTextLength)
+	v.OptionalString("option_description", r.OptionDescription).MaxLength(MaxOptionDescLength)
 
-	return errors
+	return v.Errors()
 }
 
 // UpdateVoteOptionRequest represents a request to update an option
@@ -292,20 +277,12 @@ type UpdateVoteOptionRequest struct {
 
 // Validate checks if the update request is valid
 func (r *UpdateVoteOptionRequest) Validate() []FieldError {
-	var errors []FieldError
-
-	if r.OptionText != nil {
-		if *r.OptionText == "" {
-			errors = append(errors, FieldError{Field: "option_text", Message: "option_text cannot be empty"})
-		} else if len(*r.OptionText) > MaxOptionTextLength {
-			errors = append(errors, FieldError{Field: "option_text", Message: "option_text must be 200 characters or less"})
-		}
-	}
-	if r.OptionDescription != nil && len(*r.OptionDescription) > MaxOptionDescLength {
-		errors = append(errors, FieldError{Field: "option_description", Message: "option_description must be 500 characters or less"})
-	}
+	var v Validator
+
+	v.OptionalString("option_text", r.OptionText).NotEmpty().MaxLength(MaxOptionTextLength)
+	v.OptionalString("option_description", r.OptionDescription).MaxLength(MaxOptionDescLength)
 
-	return errors
+	return v.Errors()
 }
 
 // CastBallotRequest represents a request to cast a vote
@@ -322,38 +299,26 @@ type CastBallotRequest struct {
 
 // ValidateForVoteType checks if the ballot is valid for the given vote type.
 func (r *CastBallotRequest) ValidateForVoteType(voteType VoteType, maxSelectable *int, allowAbstain bool) []FieldError {
-	var errors []FieldError
+	var v Validator
 
 	if r.IsAbstain {
-		if !allowAbstain {
-			errors = append(errors, FieldError{Field: "is_abstain", Message: "abstaining is not allowed for this vote"})
-		}
-		return errors
+		v.Check("is_abstain", allowAbstain, "abstaining is not allowed for this vote")
+		return v.Errors()
 	}
 
 	switch voteType {
 	case VoteTypeFPTP:
-		if r.OptionID == nil || *r.OptionID == "" {
-			errors = append(errors, FieldError{Field: "option_id", Message: "option_id is required for FPTP voting"})
-		}
+		v.Check("option_id", r.OptionID != nil && *r.OptionID != "", "option_id is required for FPTP voting")
 	case VoteTypeRankedChoice:
-		if len(r.Rankings) == 0 {
-			errors = append(errors, FieldError{Field: "rankings", Message: "rankings are required for ranked choice voting"})
-		}
+		v.Check("rankings", len(r.Rankings) > 0, "rankings are required for ranked choice voting")
 	case VoteTypeApproval:
-		if len(r.SelectedOptions) == 0 {
-			errors = append(errors, FieldError{Field: "selected_options", Message: "selected_options are required for approval voting"})
-		}
+		v.Check("selected_options", len(r.SelectedOptions) > 0, "selected_options are required for approval voting")
 	case VoteTypeMultiSelect:
-		if len(r.SelectedOptions) == 0 {
-			errors = append(errors, FieldError{Field: "selected_options", Message: "selected_options are required for multi-select voting"})
-		}
-		if maxSelectable != nil && len(r.SelectedOptions) > *maxSelectable {
-			errors = append(errors, FieldError{Field: "selected_options", Message: "too many options selected"})
-		}
+		v.Check("selected_options", len(r.SelectedOptions) > 0, "selected_options are required for multi-select voting")
+		v.Check("selected_options", maxSelectable == nil || len(r.SelectedOptions) <= *maxSelectable, "too many options selected")
 	}
 
-	return errors
+	return v.Errors()
 }
 
 // ToBallotData converts the request to ballot data based on vote type
@@ -0,0 +1,84 @@
+package model
+
+import "time"
+
+// Win-back targeting. Users who have been away for a week but not yet a
+// month, with nothing on their calendar, are nudged back with reasons to
+// return. A hashed holdout group is left alone so the nudge's effect on
+// return rates can be measured.
+const (
+	WinBackAwayAfter   = 7 * 24 * time.Hour  // Inactive this long before targeting (the "away" status)
+	WinBackLapsedAfter = 30 * 24 * time.Hour // Inactive longer than this is treated as churned, not at risk
+
+	// WinBackReturnWindow is how long after targeting a user must become
+	// active again to count as returned
+	WinBackReturnWindow = 14 * 24 * time.Hour
+
+	WinBackNewMemberWindow  = 30 * 24 * time.Hour // Members who joined this recently count as new
+	WinBackEventWindow      = 14 * 24 * time.Hour // How far ahead upcoming events are suggested from
+	WinBackNearbyRadiusKm   = 25.0
+	WinBackMinCompatibility = 60.0 // Compatibility score (0-100) for a new member to be suggested
+	WinBackMaxEvents        = 3    // Upcoming events named in a nudge
+
+	// DefaultWinBackHoldoutPercent is the share of at-risk users held out
+	// of win-back nudges as a control group
+	DefaultWinBackHoldoutPercent = 10
+	MaxWinBackHoldoutPercent     = 50
+
+	// WinBackStatsDays is how far back the win-back report looks by default
+	WinBackStatsDays = 90
+)
+
+// WinBackGroup is which arm of the win-back measurement a user is in
+type WinBackGroup string
+
+const (
+	WinBackGroupTreatment WinBackGroup = "treatment" // Nudged
+	WinBackGroupHoldout   WinBackGroup = "holdout"   // Targeted but deliberately not nudged
+)
+
+// ChurnRiskCandidate is a user trending toward inactivity: away for a while
+// with no upcoming events, hangouts or pending matches
+type ChurnRiskCandidate struct {
+	UserID     string    `json:"user_id"`
+	LastActive time.Time `json:"last_active"`
+}
+
+// WinBackEvent is a low-commitment upcoming event suggested to a user: open
+// to RSVP without approval and free
+type WinBackEvent struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// WinBackAttempt records one time an at-risk user was targeted, whether or
+// not they were nudged
+type WinBackAttempt struct {
+	ID              string       `json:"id"`
+	UserID          string       `json:"user_id"`
+	Group           WinBackGroup `json:"group"`
+	LastActive      time.Time    `json:"last_active"`
+	NewMembers      int          `json:"new_members"` // Compatible new members nearby, for treatment
+	SuggestedEvents []string     `json:"suggested_events,omitempty"`
+	TargetedOn      time.Time    `json:"targeted_on"`
+	ReturnedOn      *time.Time   `json:"returned_on,omitempty"`
+}
+
+// WinBackGroupStats is how many users in a group were targeted and how many
+// came back within the return window
+type WinBackGroupStats struct {
+	Group      WinBackGroup `json:"group"`
+	Targeted   int          `json:"targeted"`
+	Returned   int          `json:"returned"`
+	ReturnRate float64      `json:"return_rate"`
+}
+
+// WinBackStats compares return rates of nudged and held-out users targeted
+// since a date. Only attempts whose return window has closed are counted.
+type WinBackStats struct {
+	Since     time.Time         `json:"since"`
+	Treatment WinBackGroupStats `json:"treatment"`
+	Holdout   WinBackGroupStats `json:"holdout"`
+	Lift      *float64          `json:"lift,omitempty"` // Treatment return rate minus holdout's; nil without a holdout
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ActivityRepository handles activity ledger data access
+type ActivityRepository struct {
+	db database.Database
+}
+
+// NewActivityRepository creates a new activity repository
+func NewActivityRepository(db database.Database) *ActivityRepository {
+	return &ActivityRepository{db: db}
+}
+
+// Create records an activity entry
+func (r *ActivityRepository) Create(ctx context.Context, entry *model.ActivityEntry) error {
+	// Build query dynamically to avoid NULL vs NONE issues for optional fields
+	setClause := `user_id = type::record($user_id), type = $type, subject_type = $subject_type, subject_id = $subject_id, created_on = time::now()`
+	vars := map[string]interface{}{
+		"user_id":      entry.UserID,
+		"type":         string(entry.Type),
+		"subject_type": entry.SubjectType,
+		"subject_id":   entry.SubjectID,
+	}
+	if entry.ActorID != nil {
+		setClause += ", actor_id = type::record($actor_id)"
+		vars["actor_id"] = *entry.ActorID
+	}
+	if len(entry.Data) > 0 {
+		setClause += ", data = $data"
+		vars["data"] = entry.Data
+	}
+
+	result, err := r.db.Query(ctx, "CREATE activity SET "+setClause, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	entry.ID = created.ID
+	entry.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// GetByUser returns a user's activity newest first. When before is set only
+// entries created strictly earlier are returned.
+func (r *ActivityRepository) GetByUser(ctx context.Context, userID string, before *time.Time, limit int) ([]*model.ActivityEntry, error) {
+	query := `SELECT * FROM activity WHERE user_id = type::record($user_id)`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"limit":   limit,
+	}
+	if before != nil {
+		query += ` AND created_on < $before`
+		vars["before"] = *before
+	}
+	query += ` ORDER BY created_on DESC LIMIT $limit`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.ActivityEntry, 0)
+	for _, data := range flattenResults(results) {
+		entries = append(entries, parseActivityEntry(data))
+	}
+	return entries, nil
+}
+
+func parseActivityEntry(data map[string]interface{}) *model.ActivityEntry {
+	entry := &model.ActivityEntry{
+		ID:          convertSurrealID(data["id"]),
+		UserID:      convertSurrealID(data["user_id"]),
+		Type:        model.ActivityType(getString(data, "type")),
+		SubjectType: getString(data, "subject_type"),
+		SubjectID:   getString(data, "subject_id"),
+	}
+	if actor, ok := data["actor_id"]; ok && actor != nil {
+		actorID := convertSurrealID(actor)
+		entry.ActorID = &actorID
+	}
+	if raw, ok := data["data"].(map[string]interface{}); ok && len(raw) > 0 {
+		entry.Data = make(map[string]string, len(raw))
+		for k, v := range raw {
+			if s, ok := v.(string); ok {
+				entry.Data[k] = s
+			}
+		}
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		entry.CreatedOn = *t
+	}
+	return entry
+}
@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// AdminReportRepository handles background admin report records
+type AdminReportRepository struct {
+	db database.Database
+}
+
+// NewAdminReportRepository creates a new admin report repository
+func NewAdminReportRepository(db database.Database) *AdminReportRepository {
+	return &AdminReportRepository{db: db}
+}
+
+// Create queues a report for generation
+func (r *AdminReportRepository) Create(ctx context.Context, report *model.AdminReport) error {
+	query := `
+		CREATE admin_report SET
+			kind = $kind,
+			status = "pending",
+			requested_by = type::record($requested_by),
+			request_id = $request_id OR NONE,
+			created_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"kind":         string(report.Kind),
+		"requested_by": report.RequestedBy,
+		"request_id":   report.RequestID,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	created := parseAdminReport(rows[0])
+	report.ID = created.ID
+	report.Status = created.Status
+	report.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// Get returns a report by ID, or nil if it doesn't exist
+func (r *AdminReportRepository) Get(ctx context.Context, id string) (*model.AdminReport, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseAdminReport(rows[0]), nil
+}
+
+// List returns the most recent reports, newest first
+func (r *AdminReportRepository) List(ctx context.Context, limit int) ([]*model.AdminReport, error) {
+	query := `SELECT * FROM admin_report ORDER BY created_on DESC LIMIT $limit`
+	vars := map[string]interface{}{"limit": limit}
+
+	return r.query(ctx, query, vars)
+}
+
+// ListByStatus returns reports in a status, oldest first
+func (r *AdminReportRepository) ListByStatus(ctx context.Context, status model.AdminReportStatus, limit int) ([]*model.AdminReport, error) {
+	query := `
+		SELECT * FROM admin_report
+		WHERE status = $status
+		ORDER BY created_on ASC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"status": string(status),
+		"limit":  limit,
+	}
+
+	return r.query(ctx, query, vars)
+}
+
+// ListExpired returns completed reports whose files expired before now
+func (r *AdminReportRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.AdminReport, error) {
+	query := `
+		SELECT * FROM admin_report
+		WHERE status = "completed" AND expires_on <= $now
+		ORDER BY expires_on ASC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"now":   now,
+		"limit": limit,
+	}
+
+	return r.query(ctx, query, vars)
+}
+
+// Claim marks a pending report as running. Returns false if another worker
+// claimed it first.
+func (r *AdminReportRepository) Claim(ctx context.Context, id string) (bool, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = "running",
+			started_on = time::now()
+		WHERE status = "pending"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// Complete records a generated report's file
+func (r *AdminReportRepository) Complete(ctx context.Context, id, objectKey string, rowCount int, expiresOn time.Time) error {
+	query := `
+		UPDATE type::record($id) SET
+			status = "completed",
+			object_key = $object_key,
+			row_count = $row_count,
+			completed_on = time::now(),
+			expires_on = $expires_on
+	`
+	vars := map[string]interface{}{
+		"id":         id,
+		"object_key": objectKey,
+		"row_count":  rowCount,
+		"expires_on": expiresOn,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Fail records why a report couldn't be generated
+func (r *AdminReportRepository) Fail(ctx context.Context, id, reason string) error {
+	query := `
+		UPDATE type::record($id) SET
+			status = "failed",
+			error = $error,
+			completed_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"id":    id,
+		"error": reason,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// MarkExpired records that a report's file has been deleted
+func (r *AdminReportRepository) MarkExpired(ctx context.Context, id string) error {
+	query := `UPDATE type::record($id) SET status = "expired", object_key = NONE`
+	vars := map[string]interface{}{"id": id}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+func (r *AdminReportRepository) query(ctx context.Context, query string, vars map[string]interface{}) ([]*model.AdminReport, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	reports := make([]*model.AdminReport, 0, len(rows))
+	for _, row := range rows {
+		reports = append(reports, parseAdminReport(row))
+	}
+	return reports, nil
+}
+
+func parseAdminReport(data map[string]interface{}) *model.AdminReport {
+	report := &model.AdminReport{
+		ID:          convertSurrealID(data["id"]),
+		Kind:        model.AdminReportKind(getString(data, "kind")),
+		Status:      model.AdminReportStatus(getString(data, "status")),
+		RequestedBy: convertSurrealID(data["requested_by"]),
+		ObjectKey:   getString(data, "object_key"),
+		RowCount:    getInt(data, "row_count"),
+		Error:       getString(data, "error"),
+		StartedOn:   getTime(data, "started_on"),
+		CompletedOn: getTime(data, "completed_on"),
+		ExpiresOn:   getTime(data, "expires_on"),
+		RequestID:   getString(data, "request_id"),
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		report.CreatedOn = *t
+	}
+	return report
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// AnalyticsRepository handles read-only aggregate queries for analytics
+type AnalyticsRepository struct {
+	db database.Database
+}
+
+// NewAnalyticsRepository creates a new analytics repository
+func NewAnalyticsRepository(db database.Database) *AnalyticsRepository {
+	return &AnalyticsRepository{db: db}
+}
+
+// GetAttendanceHistory returns approved RSVP and no-show counts for past events
+// that have been checked for no-shows, newest first. Only attendees who
+// opted in to analytics are counted.
+func (r *AnalyticsRepository) GetAttendanceHistory(ctx context.Context, filter model.AttendanceHistoryFilter) ([]*model.AttendanceSample, error) {
+	vars := map[string]interface{}{
+		"since": filter.Since,
+		"limit": filter.Limit,
+	}
+	rsvpConsent := consentGrantedCondition("$parent.user_id", model.ConsentAnalytics, vars)
+	noShowConsent := consentGrantedCondition("type::record($parent.user_id)", model.ConsentAnalytics, vars)
+	query := `
+		SELECT id,
+			array::len((SELECT id FROM event_rsvp WHERE event_id = $parent.id AND status = "approved" AND ` + rsvpConsent + `)) AS approved,
+			array::len((SELECT id FROM no_show WHERE event_id = <string> $parent.id AND ` + noShowConsent + `)) AS no_shows
+		FROM event
+		WHERE no_shows_checked = true AND start_time >= $since AND deleted_at = NONE
+	`
+
+	if filter.GuildID != nil {
+		query += ` AND guild_id = type::record($guild_id)`
+		vars["guild_id"] = *filter.GuildID
+	}
+	if filter.Template != nil {
+		query += ` AND template = $template`
+		vars["template"] = *filter.Template
+	}
+
+	query += ` ORDER BY start_time DESC LIMIT $limit`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]*model.AttendanceSample, 0)
+	for _, data := range flattenResults(results) {
+		samples = append(samples, &model.AttendanceSample{
+			EventID:  convertSurrealID(data["id"]),
+			Approved: getInt(data, "approved"),
+			NoShows:  getInt(data, "no_shows"),
+		})
+	}
+
+	return samples, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// AnalyticsEventRepository handles the append-only analytics event store
+type AnalyticsEventRepository struct {
+	db database.Database
+}
+
+// NewAnalyticsEventRepository creates a new analytics event repository
+func NewAnalyticsEventRepository(db database.Database) *AnalyticsEventRepository {
+	return &AnalyticsEventRepository{db: db}
+}
+
+// Create appends an analytics event
+func (r *AnalyticsEventRepository) Create(ctx context.Context, event *model.AnalyticsEvent) error {
+	query := `
+		CREATE analytics_event SET
+			name = $name,
+			subject = $subject,
+			properties = $properties,
+			occurred_on = $occurred_on
+	`
+	properties := event.Properties
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	vars := map[string]interface{}{
+		"name":        string(event.Name),
+		"subject":     event.Subject,
+		"properties":  properties,
+		"occurred_on": event.OccurredOn,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	event.ID = created.ID
+	return nil
+}
+
+// GetRange returns a page of events that occurred in [since, until), oldest
+// first. Events are never updated, so offset paging is stable for a range
+// that has already passed.
+func (r *AnalyticsEventRepository) GetRange(ctx context.Context, since, until time.Time, offset, limit int) ([]*model.AnalyticsEvent, error) {
+	query := `
+		SELECT * FROM analytics_event
+		WHERE occurred_on >= $since AND occurred_on < $until
+		ORDER BY occurred_on ASC, id ASC
+		LIMIT $limit START $offset
+	`
+	vars := map[string]interface{}{
+		"since":  since,
+		"until":  until,
+		"offset": offset,
+		"limit":  limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	events := make([]*model.AnalyticsEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, parseAnalyticsEvent(row))
+	}
+	return events, nil
+}
+
+func parseAnalyticsEvent(data map[string]interface{}) *model.AnalyticsEvent {
+	event := &model.AnalyticsEvent{
+		ID:      convertSurrealID(data["id"]),
+		Name:    model.AnalyticsEventName(getString(data, "name")),
+		Subject: getString(data, "subject"),
+	}
+	if properties, ok := data["properties"].(map[string]interface{}); ok && len(properties) > 0 {
+		event.Properties = properties
+	}
+	if t := getTime(data, "occurred_on"); t != nil {
+		event.OccurredOn = *t
+	}
+	return event
+}
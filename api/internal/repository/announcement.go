@@ -0,0 +1,417 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// AnnouncementRepository handles announcement and delivery receipt data access
+type AnnouncementRepository struct {
+	db database.Database
+}
+
+// NewAnnouncementRepository creates a new announcement repository
+func NewAnnouncementRepository(db database.Database) *AnnouncementRepository {
+	return &AnnouncementRepository{db: db}
+}
+
+// announcementFields selects an announcement with its read count
+const announcementFields = `*, count((SELECT id FROM announcement_receipt WHERE announcement = $parent.id AND read_on != NONE)) AS read_count`
+
+// Create stores a new announcement
+func (r *AnnouncementRepository) Create(ctx context.Context, announcement *model.Announcement) error {
+	// Build query dynamically to avoid NULL vs NONE issues for optional fields
+	setClause := `title = $title, body = $body, audience = $audience, push = $push, status = $status, created_by = type::record($created_by), created_on = time::now(), updated_on = time::now()`
+	vars := map[string]interface{}{
+		"title":      announcement.Title,
+		"body":       announcement.Body,
+		"audience":   string(announcement.Audience),
+		"push":       announcement.Push,
+		"status":     string(announcement.Status),
+		"created_by": announcement.CreatedBy,
+	}
+	if announcement.GuildID != nil {
+		setClause += ", guild_id = type::record($guild_id)"
+		vars["guild_id"] = *announcement.GuildID
+	}
+	if announcement.Area != nil {
+		setClause += ", area = $area"
+		vars["area"] = map[string]interface{}{
+			"lat":       announcement.Area.Lat,
+			"lng":       announcement.Area.Lng,
+			"radius_km": announcement.Area.RadiusKm,
+		}
+	}
+	if announcement.SendAt != nil {
+		setClause += ", send_at = $send_at"
+		vars["send_at"] = *announcement.SendAt
+	}
+
+	result, err := r.db.Query(ctx, "CREATE announcement SET "+setClause, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	announcement.ID = created.ID
+	announcement.CreatedOn = created.CreatedOn
+	announcement.UpdatedOn = created.CreatedOn
+	return nil
+}
+
+// GetByID retrieves an announcement with its read count
+func (r *AnnouncementRepository) GetByID(ctx context.Context, id string) (*model.Announcement, error) {
+	query := `SELECT ` + announcementFields + ` FROM type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseAnnouncement(rows[0]), nil
+}
+
+// List returns announcements newest first
+func (r *AnnouncementRepository) List(ctx context.Context, limit int) ([]*model.Announcement, error) {
+	query := `SELECT ` + announcementFields + ` FROM announcement ORDER BY created_on DESC LIMIT $limit`
+	vars := map[string]interface{}{"limit": limit}
+
+	return r.queryAnnouncements(ctx, query, vars)
+}
+
+// ListByGuild returns a guild's announcements newest first. With sentOnly,
+// drafts and scheduled announcements are left out.
+func (r *AnnouncementRepository) ListByGuild(ctx context.Context, guildID string, sentOnly bool, limit int) ([]*model.Announcement, error) {
+	query := `SELECT ` + announcementFields + ` FROM announcement WHERE guild_id = type::record($guild_id)`
+	if sentOnly {
+		query += ` AND status = "sent"`
+	}
+	query += ` ORDER BY created_on DESC LIMIT $limit`
+	vars := map[string]interface{}{
+		"guild_id": guildID,
+		"limit":    limit,
+	}
+
+	return r.queryAnnouncements(ctx, query, vars)
+}
+
+// Update applies updates to a draft or scheduled announcement. Returns nil if
+// the announcement no longer exists or delivery has already started.
+func (r *AnnouncementRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Announcement, error) {
+	query := `UPDATE type::record($id) SET updated_on = time::now()`
+	vars := map[string]interface{}{"id": id}
+
+	for _, field := range []string{"title", "body", "push", "status", "send_at"} {
+		if value, ok := updates[field]; ok {
+			query += fmt.Sprintf(", %s = $%s", field, field)
+			vars[field] = value
+		}
+	}
+	query += ` WHERE status IN ["draft", "scheduled"] RETURN AFTER`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return r.GetByID(ctx, id)
+}
+
+// Delete removes an announcement and, through the cascade, its receipts
+func (r *AnnouncementRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// ClaimDue marks scheduled announcements whose send_at has passed as sending
+// and returns them. Announcements left sending since before staleBefore, by a
+// run that did not finish, are claimed again.
+func (r *AnnouncementRepository) ClaimDue(ctx context.Context, now, staleBefore time.Time) ([]*model.Announcement, error) {
+	query := `
+		UPDATE announcement SET status = "sending", claimed_on = $now
+		WHERE (status = "scheduled" AND send_at <= $now)
+			OR (status = "sending" AND claimed_on < $stale_before)
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"now":          now,
+		"stale_before": staleBefore,
+	}
+
+	return r.queryAnnouncements(ctx, query, vars)
+}
+
+// MarkSent records that delivery finished
+func (r *AnnouncementRepository) MarkSent(ctx context.Context, id string, recipientCount int, sentOn time.Time) error {
+	query := `
+		UPDATE type::record($id) SET
+			status = "sent",
+			sent_on = $sent_on,
+			recipient_count = $recipient_count,
+			updated_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"id":              id,
+		"sent_on":         sentOn,
+		"recipient_count": recipientCount,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// ListUserIDs returns a page of user IDs in ID order, starting after the
+// given ID (empty for the first page)
+func (r *AnnouncementRepository) ListUserIDs(ctx context.Context, after string, limit int) ([]string, error) {
+	query := `SELECT id FROM user`
+	vars := map[string]interface{}{"limit": limit}
+	if after != "" {
+		query += ` WHERE id > type::record($after)`
+		vars["after"] = after
+	}
+	query += ` ORDER BY id LIMIT $limit`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0)
+	for _, data := range flattenResults(results) {
+		userIDs = append(userIDs, convertSurrealID(data["id"]))
+	}
+	return userIDs, nil
+}
+
+// ListLocationsInBox returns a page of users whose profile location falls in
+// the bounding box, in user ID order, starting after the given user ID
+func (r *AnnouncementRepository) ListLocationsInBox(ctx context.Context, minLat, maxLat, minLng, maxLng float64, after string, limit int) ([]*model.AnnouncementRecipientLocation, error) {
+	query := `
+		SELECT user, location.lat AS lat, location.lng AS lng FROM user_profile
+		WHERE location != NONE
+			AND location.lat >= $min_lat
+			AND location.lat <= $max_lat
+			AND location.lng >= $min_lng
+			AND location.lng <= $max_lng
+	`
+	vars := map[string]interface{}{
+		"min_lat": minLat,
+		"max_lat": maxLat,
+		"min_lng": minLng,
+		"max_lng": maxLng,
+		"limit":   limit,
+	}
+	if after != "" {
+		query += ` AND user > type::record($after)`
+		vars["after"] = after
+	}
+	query += ` ORDER BY user LIMIT $limit`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]*model.AnnouncementRecipientLocation, 0)
+	for _, data := range flattenResults(results) {
+		locations = append(locations, &model.AnnouncementRecipientLocation{
+			UserID: convertSurrealID(data["user"]),
+			Lat:    getFloat(data, "lat"),
+			Lng:    getFloat(data, "lng"),
+		})
+	}
+	return locations, nil
+}
+
+// CreateReceipt delivers an announcement to a user. Returns ErrDuplicate if
+// it was already delivered to them.
+func (r *AnnouncementRepository) CreateReceipt(ctx context.Context, announcementID, userID string) error {
+	query := `
+		CREATE announcement_receipt SET
+			announcement = type::record($announcement_id),
+			user = type::record($user_id),
+			delivered_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"announcement_id": announcementID,
+		"user_id":         userID,
+	}
+
+	if err := r.db.Execute(ctx, query, vars); err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: announcement already delivered", database.ErrDuplicate)
+		}
+		return err
+	}
+	return nil
+}
+
+// GetInbox returns the announcements delivered to a user, newest first
+func (r *AnnouncementRepository) GetInbox(ctx context.Context, userID string, limit int) ([]*model.AnnouncementReceipt, error) {
+	query := `
+		SELECT announcement, delivered_on, read_on,
+			announcement.title AS title,
+			announcement.body AS body,
+			announcement.guild_id AS guild_id
+		FROM announcement_receipt
+		WHERE user = type::record($user_id)
+		ORDER BY delivered_on DESC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"limit":   limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]*model.AnnouncementReceipt, 0)
+	for _, data := range flattenResults(results) {
+		receipts = append(receipts, parseAnnouncementReceipt(data))
+	}
+	return receipts, nil
+}
+
+// GetReceipt returns an announcement as delivered to a user, or nil if it
+// was never delivered to them
+func (r *AnnouncementRepository) GetReceipt(ctx context.Context, announcementID, userID string) (*model.AnnouncementReceipt, error) {
+	query := `
+		SELECT announcement, delivered_on, read_on,
+			announcement.title AS title,
+			announcement.body AS body,
+			announcement.guild_id AS guild_id
+		FROM announcement_receipt
+		WHERE announcement = type::record($announcement_id) AND user = type::record($user_id)
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"announcement_id": announcementID,
+		"user_id":         userID,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseAnnouncementReceipt(rows[0]), nil
+}
+
+// CountUnread counts the announcements delivered to a user that they haven't read
+func (r *AnnouncementRepository) CountUnread(ctx context.Context, userID string) (int, error) {
+	query := `SELECT count() FROM announcement_receipt WHERE user = type::record($user_id) AND read_on = NONE GROUP ALL`
+	vars := map[string]interface{}{"user_id": userID}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+	return extractCount(result), nil
+}
+
+// MarkRead marks an announcement read for a user. Marking it again keeps the
+// first read time. Returns false if it was never delivered to them.
+func (r *AnnouncementRepository) MarkRead(ctx context.Context, announcementID, userID string) (bool, error) {
+	query := `
+		UPDATE announcement_receipt SET read_on = read_on ?? time::now()
+		WHERE announcement = type::record($announcement_id) AND user = type::record($user_id)
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"announcement_id": announcementID,
+		"user_id":         userID,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+func (r *AnnouncementRepository) queryAnnouncements(ctx context.Context, query string, vars map[string]interface{}) ([]*model.Announcement, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	announcements := make([]*model.Announcement, 0)
+	for _, data := range flattenResults(results) {
+		announcements = append(announcements, parseAnnouncement(data))
+	}
+	return announcements, nil
+}
+
+func parseAnnouncement(data map[string]interface{}) *model.Announcement {
+	announcement := &model.Announcement{
+		ID:             convertSurrealID(data["id"]),
+		Title:          getString(data, "title"),
+		Body:           getString(data, "body"),
+		Audience:       model.AnnouncementAudience(getString(data, "audience")),
+		Push:           getBool(data, "push"),
+		Status:         model.AnnouncementStatus(getString(data, "status")),
+		SendAt:         getTime(data, "send_at"),
+		SentOn:         getTime(data, "sent_on"),
+		RecipientCount: getInt(data, "recipient_count"),
+		ReadCount:      getInt(data, "read_count"),
+		CreatedBy:      convertSurrealID(data["created_by"]),
+	}
+	if data["guild_id"] != nil {
+		guildID := convertSurrealID(data["guild_id"])
+		announcement.GuildID = &guildID
+	}
+	if area, ok := data["area"].(map[string]interface{}); ok {
+		announcement.Area = &model.AnnouncementArea{
+			Lat:      getFloat(area, "lat"),
+			Lng:      getFloat(area, "lng"),
+			RadiusKm: getFloat(area, "radius_km"),
+		}
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		announcement.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		announcement.UpdatedOn = *t
+	}
+	return announcement
+}
+
+func parseAnnouncementReceipt(data map[string]interface{}) *model.AnnouncementReceipt {
+	receipt := &model.AnnouncementReceipt{
+		AnnouncementID: convertSurrealID(data["announcement"]),
+		Title:          getString(data, "title"),
+		Body:           getString(data, "body"),
+		ReadOn:         getTime(data, "read_on"),
+	}
+	if data["guild_id"] != nil {
+		guildID := convertSurrealID(data["guild_id"])
+		receipt.GuildID = &guildID
+	}
+	if t := getTime(data, "delivered_on"); t != nil {
+		receipt.DeliveredOn = *t
+	}
+	return receipt
+}
@@ -21,9 +21,16 @@ func NewAvailabilityRepository(db database.Database) *AvailabilityRepository {
 
 // Create creates a new availability window
 func (r *AvailabilityRepository) Create(ctx context.Context, av *model.Availability) error {
+	// Guild-scoped availability names its guild; the rest have none
+	guildField := ""
+	if av.GuildID != nil {
+		guildField = "guild: type::record($guild_id),"
+	}
+
 	query := `
 		CREATE availability CONTENT {
 			user: type::record($user_id),
+			` + guildField + `
 			status: $status,
 			start_time: $start_time,
 			end_time: $end_time,
@@ -35,6 +42,7 @@ func (r *AvailabilityRepository) Create(ctx context.Context, av *model.Availabil
 			max_people: $max_people,
 			note: $note,
 			visibility: $visibility,
+			spontaneous: $spontaneous,
 			expires_at: $expires_at,
 			created_on: time::now(),
 			updated_on: time::now()
@@ -63,8 +71,12 @@ func (r *AvailabilityRepository) Create(ctx context.Context, av *model.Availabil
 		"max_people":           av.MaxPeople,
 		"note":                 av.Note,
 		"visibility":           av.Visibility,
+		"spontaneous":          av.Spontaneous,
 		"expires_at":           av.ExpiresAt,
 	}
+	if av.GuildID != nil {
+		vars["guild_id"] = *av.GuildID
+	}
 
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
@@ -82,10 +94,11 @@ func (r *AvailabilityRepository) Create(ctx context.Context, av *model.Availabil
 	return nil
 }
 
-// GetByID retrieves an availability by ID
+// GetByID retrieves an availability by ID. Availability pending deletion is
+// not returned.
 func (r *AvailabilityRepository) GetByID(ctx context.Context, id string) (*model.Availability, error) {
 	// Direct record access - more efficient than WHERE id =
-	query := `SELECT * FROM type::record($id)`
+	query := `SELECT * FROM type::record($id) WHERE pending_delete_until = NONE`
 	vars := map[string]interface{}{"id": id}
 
 	result, err := r.db.QueryOne(ctx, query, vars)
@@ -105,6 +118,7 @@ func (r *AvailabilityRepository) GetByUser(ctx context.Context, userID string) (
 		SELECT * FROM availability
 		WHERE user = type::record($user_id)
 		AND expires_at > time::now()
+		AND pending_delete_until = NONE
 		ORDER BY start_time
 	`
 	vars := map[string]interface{}{"user_id": userID}
@@ -117,24 +131,84 @@ func (r *AvailabilityRepository) GetByUser(ctx context.Context, userID string) (
 	return r.parseAvailabilitiesResult(result)
 }
 
-// GetNearby finds availabilities within a bounding box and time range
-func (r *AvailabilityRepository) GetNearby(ctx context.Context, minLat, maxLat, minLng, maxLng float64, startTime, endTime time.Time, excludeUserID string, limit int) ([]*model.Availability, error) {
+// GetByGuild returns the unexpired availability scoped to a guild, soonest
+// first
+func (r *AvailabilityRepository) GetByGuild(ctx context.Context, guildID string, limit int) ([]*model.Availability, error) {
 	query := `
 		SELECT * FROM availability
-		WHERE location != NONE
-			AND location.lat >= $min_lat
-			AND location.lat <= $max_lat
-			AND location.lng >= $min_lng
-			AND location.lng <= $max_lng
-			AND start_time <= $end_time
-			AND end_time >= $start_time
+		WHERE guild = type::record($guild_id)
 			AND expires_at > time::now()
-			AND user != type::record($exclude_user)
-			AND visibility != "private"
-		ORDER BY start_time
+			AND pending_delete_until = NONE
+		ORDER BY spontaneous DESC, start_time
 		LIMIT $limit
 	`
+	vars := map[string]interface{}{
+		"guild_id": guildID,
+		"limit":    limit,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.parseAvailabilitiesResult(result)
+}
+
+// GetActiveSpontaneous returns the user's unexpired spontaneous
+// availability, or nil if they aren't available right now
+func (r *AvailabilityRepository) GetActiveSpontaneous(ctx context.Context, userID string) (*model.Availability, error) {
+	query := `
+		SELECT * FROM availability
+		WHERE user = type::record($user_id)
+		AND spontaneous = true
+		AND expires_at > time::now()
+		AND pending_delete_until = NONE
+		ORDER BY expires_at DESC
+		LIMIT 1
+	`
+	vars := map[string]interface{}{"user_id": userID}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	availabilities, err := r.parseAvailabilitiesResult(result)
+	if err != nil || len(availabilities) == 0 {
+		return nil, err
+	}
+	return availabilities[0], nil
+}
+
+// GetByGuildInRange returns free or maybe availability windows of a guild's
+// members that overlap [since, until)
+func (r *AvailabilityRepository) GetByGuildInRange(ctx context.Context, guildID string, since, until time.Time) ([]*model.Availability, error) {
+	query := `
+		SELECT * FROM availability
+		WHERE user IN (SELECT VALUE in.user FROM responsible_for WHERE out = type::record($guild_id))
+			AND start_time < $until
+			AND end_time > $since
+			AND status != "busy"
+			AND pending_delete_until = NONE
+	`
+	vars := map[string]interface{}{
+		"guild_id": guildID,
+		"since":    since,
+		"until":    until,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
 
+	return r.parseAvailabilitiesResult(result)
+}
+
+// GetNearby finds availabilities within a bounding box and time range,
+// from users who allow their location to be used
+func (r *AvailabilityRepository) GetNearby(ctx context.Context, minLat, maxLat, minLng, maxLng float64, startTime, endTime time.Time, excludeUserID string, limit int) ([]*model.Availability, error) {
 	vars := map[string]interface{}{
 		"min_lat":      minLat,
 		"max_lat":      maxLat,
@@ -145,6 +219,24 @@ func (r *AvailabilityRepository) GetNearby(ctx context.Context, minLat, maxLat,
 		"exclude_user": excludeUserID,
 		"limit":        limit,
 	}
+	query := `
+		SELECT * FROM availability
+		WHERE location != NONE
+			AND location.lat >= $min_lat
+			AND location.lat <= $max_lat
+			AND location.lng >= $min_lng
+			AND location.lng <= $max_lng
+			AND start_time <= $end_time
+			AND end_time >= $start_time
+			AND expires_at > time::now()
+			AND pending_delete_until = NONE
+			AND user != type::record($exclude_user)
+			AND visibility != "private"
+			AND guild = NONE
+			AND ` + consentGrantedCondition("$parent.user", model.ConsentLocationProcessing, vars) + `
+		ORDER BY spontaneous DESC, start_time
+		LIMIT $limit
+	`
 
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
@@ -160,8 +252,10 @@ func (r *AvailabilityRepository) GetByHangoutType(ctx context.Context, hangoutTy
 		SELECT * FROM availability
 		WHERE hangout_type = $hangout_type
 			AND expires_at > time::now()
+			AND pending_delete_until = NONE
 			AND user != type::record($exclude_user)
 			AND visibility != "private"
+			AND guild = NONE
 		ORDER BY start_time
 		LIMIT $limit
 	`
@@ -225,6 +319,10 @@ func (r *AvailabilityRepository) Update(ctx context.Context, id string, updates
 		query += ", visibility = $visibility"
 		vars["visibility"] = visibility
 	}
+	if expiresAt, ok := updates["expires_at"]; ok {
+		query += ", expires_at = $expires_at"
+		vars["expires_at"] = expiresAt
+	}
 
 	query += ` WHERE id = type::record($id) RETURN AFTER`
 
@@ -244,6 +342,54 @@ func (r *AvailabilityRepository) Delete(ctx context.Context, id string) error {
 	return r.db.Execute(ctx, query, vars)
 }
 
+// MarkPendingDelete hides an availability until the undo window closes at
+// until, when the undo cleanup job deletes it
+func (r *AvailabilityRepository) MarkPendingDelete(ctx context.Context, id string, until time.Time) error {
+	query := `UPDATE type::record($id) SET pending_delete_until = $until, updated_on = time::now()`
+	vars := map[string]interface{}{
+		"id":    id,
+		"until": until,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// RestorePendingDelete brings back an availability whose undo window is still
+// open. Returns false if it's no longer pending deletion.
+func (r *AvailabilityRepository) RestorePendingDelete(ctx context.Context, id string, now time.Time) (bool, error) {
+	query := `
+		UPDATE type::record($id) SET
+			pending_delete_until = NONE,
+			updated_on = time::now()
+		WHERE pending_delete_until > $now
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":  id,
+		"now": now,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// DeleteExpiredPending deletes availability whose undo window closed before
+// now. Availability of users on legal hold stays pending until the hold is
+// released. Returns how many were deleted.
+func (r *AvailabilityRepository) DeleteExpiredPending(ctx context.Context, now time.Time) (int, error) {
+	query := `DELETE availability WHERE pending_delete_until <= $now AND ` + notOnLegalHold("user") + ` RETURN BEFORE`
+	vars := map[string]interface{}{"now": now}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+	return len(flattenResults(results)), nil
+}
+
 // CreateHangoutRequest creates a hangout request
 func (r *AvailabilityRepository) CreateHangoutRequest(ctx context.Context, req *model.HangoutRequest) error {
 	query := `
@@ -453,6 +599,11 @@ func (r *AvailabilityRepository) parseAvailabilityResult(result interface{}) (*m
 		HangoutType: model.HangoutType(getString(data, "hangout_type")),
 		MaxPeople:   getInt(data, "max_people"),
 		Visibility:  getString(data, "visibility"),
+		Spontaneous: getBool(data, "spontaneous"),
+	}
+	if data["guild"] != nil {
+		guildID := convertSurrealID(data["guild"])
+		av.GuildID = &guildID
 	}
 
 	if startTime := getTime(data, "start_time"); startTime != nil {
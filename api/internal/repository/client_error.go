@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ClientErrorRepository handles client error report data access
+type ClientErrorRepository struct {
+	db database.Database
+}
+
+// NewClientErrorRepository creates a new client error repository
+func NewClientErrorRepository(db database.Database) *ClientErrorRepository {
+	return &ClientErrorRepository{db: db}
+}
+
+// Create stores a client error report
+func (r *ClientErrorRepository) Create(ctx context.Context, report *model.ClientError) error {
+	requestIDs := report.RequestIDs
+	if requestIDs == nil {
+		requestIDs = []string{}
+	}
+	vars := map[string]interface{}{
+		"kind":        string(report.Kind),
+		"platform":    string(report.Platform),
+		"app_version": report.AppVersion,
+		"title":       report.Title,
+		"message":     report.Message,
+		"stack_trace": report.StackTrace,
+		"endpoint":    report.Endpoint,
+		"status_code": report.StatusCode,
+		"request_ids": requestIDs,
+		"request_id":  report.RequestID,
+		"fingerprint": report.Fingerprint,
+		"occurred_on": report.OccurredOn,
+	}
+	optionalFields := ""
+	if report.UserID != "" {
+		optionalFields += ",\n\t\t\tuser = type::record($user_id)"
+		vars["user_id"] = report.UserID
+	}
+
+	query := `
+		CREATE client_error SET
+			kind = $kind,
+			platform = $platform,
+			app_version = $app_version,
+			title = $title,
+			message = $message,
+			stack_trace = $stack_trace OR NONE,
+			endpoint = $endpoint OR NONE,
+			status_code = $status_code OR NONE,
+			request_ids = $request_ids,
+			request_id = $request_id OR NONE,
+			fingerprint = $fingerprint,
+			occurred_on = $occurred_on,
+			created_on = time::now()` + optionalFields + `
+	`
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	created := parseClientError(rows[0])
+	report.ID = created.ID
+	report.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// List returns the reports matching filter, newest first
+func (r *ClientErrorRepository) List(ctx context.Context, filter model.ClientErrorFilter) ([]*model.ClientError, error) {
+	query, vars := clientErrorQuery(`SELECT * FROM client_error`, filter)
+	query += ` ORDER BY created_on DESC LIMIT $limit`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	reports := make([]*model.ClientError, 0, len(rows))
+	for _, row := range rows {
+		reports = append(reports, parseClientError(row))
+	}
+	return reports, nil
+}
+
+// Summarize groups the reports matching filter by fingerprint, most
+// reported first
+func (r *ClientErrorRepository) Summarize(ctx context.Context, filter model.ClientErrorFilter) ([]*model.ClientErrorGroup, error) {
+	query, vars := clientErrorQuery(`
+		SELECT fingerprint, kind, platform, title, endpoint,
+			count() AS count,
+			array::group(app_version) AS app_versions,
+			time::min(created_on) AS first_seen,
+			time::max(created_on) AS last_seen
+		FROM client_error`, filter)
+	query += `
+		GROUP BY fingerprint, kind, platform, title, endpoint
+		ORDER BY count DESC
+		LIMIT $limit
+	`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	groups := make([]*model.ClientErrorGroup, 0, len(rows))
+	for _, row := range rows {
+		group := &model.ClientErrorGroup{
+			Fingerprint: getString(row, "fingerprint"),
+			Kind:        model.ClientErrorKind(getString(row, "kind")),
+			Platform:    model.DevicePlatform(getString(row, "platform")),
+			Title:       getString(row, "title"),
+			Endpoint:    getString(row, "endpoint"),
+			Count:       getInt(row, "count"),
+			AppVersions: getStringSlice(row, "app_versions"),
+		}
+		if t := getTime(row, "first_seen"); t != nil {
+			group.FirstSeen = *t
+		}
+		if t := getTime(row, "last_seen"); t != nil {
+			group.LastSeen = *t
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// clientErrorQuery appends filter's conditions to a query over client_error
+func clientErrorQuery(query string, filter model.ClientErrorFilter) (string, map[string]interface{}) {
+	query += ` WHERE created_on >= $since`
+	vars := map[string]interface{}{
+		"since": filter.Since,
+		"limit": filter.Limit,
+	}
+
+	if filter.Kind != "" {
+		query += ` AND kind = $kind`
+		vars["kind"] = string(filter.Kind)
+	}
+	if filter.Platform != "" {
+		query += ` AND platform = $platform`
+		vars["platform"] = string(filter.Platform)
+	}
+	if filter.AppVersion != "" {
+		query += ` AND app_version = $app_version`
+		vars["app_version"] = filter.AppVersion
+	}
+	if filter.Fingerprint != "" {
+		query += ` AND fingerprint = $fingerprint`
+		vars["fingerprint"] = filter.Fingerprint
+	}
+	return query, vars
+}
+
+func parseClientError(data map[string]interface{}) *model.ClientError {
+	report := &model.ClientError{
+		ID:          convertSurrealID(data["id"]),
+		Kind:        model.ClientErrorKind(getString(data, "kind")),
+		Platform:    model.DevicePlatform(getString(data, "platform")),
+		AppVersion:  getString(data, "app_version"),
+		Title:       getString(data, "title"),
+		Message:     getString(data, "message"),
+		StackTrace:  getString(data, "stack_trace"),
+		Endpoint:    getString(data, "endpoint"),
+		StatusCode:  getInt(data, "status_code"),
+		RequestIDs:  getStringSlice(data, "request_ids"),
+		RequestID:   getString(data, "request_id"),
+		Fingerprint: getString(data, "fingerprint"),
+	}
+	if user, ok := data["user"]; ok && user != nil {
+		report.UserID = convertSurrealID(user)
+	}
+	if t := getTime(data, "occurred_on"); t != nil {
+		report.OccurredOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		report.CreatedOn = *t
+	}
+	return report
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ConsentRepository handles the consent decision history
+type ConsentRepository struct {
+	db database.Database
+}
+
+// NewConsentRepository creates a new consent repository
+func NewConsentRepository(db database.Database) *ConsentRepository {
+	return &ConsentRepository{db: db}
+}
+
+// Create records a grant or withdrawal
+func (r *ConsentRepository) Create(ctx context.Context, consent *model.Consent) error {
+	query := `
+		CREATE consent SET
+			user = type::record($user_id),
+			purpose = $purpose,
+			granted = $granted,
+			version = $version,
+			decided_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"user_id": consent.UserID,
+		"purpose": string(consent.Purpose),
+		"granted": consent.Granted,
+		"version": consent.Version,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	created := parseConsent(rows[0])
+	consent.ID = created.ID
+	consent.DecidedOn = created.DecidedOn
+	return nil
+}
+
+// GetLatest returns the user's current decision for a purpose, or nil if
+// they have never decided
+func (r *ConsentRepository) GetLatest(ctx context.Context, userID string, purpose model.ConsentPurpose) (*model.Consent, error) {
+	query := `
+		SELECT * FROM consent
+		WHERE user = type::record($user_id) AND purpose = $purpose
+		ORDER BY decided_on DESC
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"purpose": string(purpose),
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseConsent(rows[0]), nil
+}
+
+// ListByUser returns every decision the user has made, newest first
+func (r *ConsentRepository) ListByUser(ctx context.Context, userID string) ([]*model.Consent, error) {
+	query := `
+		SELECT * FROM consent
+		WHERE user = type::record($user_id)
+		ORDER BY decided_on DESC
+	`
+	vars := map[string]interface{}{"user_id": userID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	consents := make([]*model.Consent, 0, len(rows))
+	for _, row := range rows {
+		consents = append(consents, parseConsent(row))
+	}
+	return consents, nil
+}
+
+// consentGrantedCondition returns a WHERE condition matching rows whose
+// user, the record<user> in userField, currently grants purpose: their latest
+// decision for it is a grant of the current text. The current version is
+// bound in vars. The latest decision is a subquery on the
+// consent_user_purpose index, so results are filtered before LIMIT.
+func consentGrantedCondition(userField string, purpose model.ConsentPurpose, vars map[string]interface{}) string {
+	param := fmt.Sprintf("consent_%s_version", purpose)
+	text, _ := model.CurrentConsentText(purpose)
+	vars[param] = text.Version
+	return fmt.Sprintf(`(SELECT VALUE granted AND version = $%s FROM consent
+			WHERE user = %s AND purpose = "%s"
+			ORDER BY decided_on DESC LIMIT 1)[0] = true`, param, userField, purpose)
+}
+
+func parseConsent(data map[string]interface{}) *model.Consent {
+	consent := &model.Consent{
+		ID:            convertSurrealID(data["id"]),
+		UserID:        convertSurrealID(data["user"]),
+		Purpose:       model.ConsentPurpose(getString(data, "purpose")),
+		Granted:       getBool(data, "granted"),
+		Version:       getInt(data, "version"),
+		Grandfathered: getBool(data, "grandfathered"),
+	}
+	if t := getTime(data, "decided_on"); t != nil {
+		consent.DecidedOn = *t
+	}
+	return consent
+}
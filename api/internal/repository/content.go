@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ContentRepository handles admin-managed catalog content:
+// question categories and review tags
+type ContentRepository struct {
+	db database.Database
+}
+
+// NewContentRepository creates a new content repository
+func NewContentRepository(db database.Database) *ContentRepository {
+	return &ContentRepository{db: db}
+}
+
+// catalogUpdateFields lists the fields that may be changed on categories and tags
+var catalogUpdateFields = []string{"label", "icon", "sort_order", "active"}
+
+// reviewTagFields maps a tag kind to the review field that stores it
+var reviewTagFields = map[model.ReviewTagKind]string{
+	model.ReviewTagKindPositive:    "positive_tags",
+	model.ReviewTagKindImprovement: "improvement_tags",
+}
+
+// ===== Question Categories =====
+
+// ListQuestionCategories retrieves all question categories, including deprecated ones
+func (r *ContentRepository) ListQuestionCategories(ctx context.Context) ([]*model.QuestionCategory, error) {
+	query := `SELECT * FROM question_category ORDER BY sort_order, key`
+
+	results, err := r.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*model.QuestionCategory, 0)
+	for _, data := range flattenResults(results) {
+		categories = append(categories, parseQuestionCategory(data))
+	}
+	return categories, nil
+}
+
+// GetQuestionCategory retrieves a question category by key
+func (r *ContentRepository) GetQuestionCategory(ctx context.Context, key string) (*model.QuestionCategory, error) {
+	query := `SELECT * FROM question_category WHERE key = $key LIMIT 1`
+
+	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"key": key})
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseQuestionCategory(data), nil
+}
+
+// CreateQuestionCategory creates a new question category
+func (r *ContentRepository) CreateQuestionCategory(ctx context.Context, category *model.QuestionCategory) error {
+	query := `
+		CREATE question_category CONTENT {
+			key: $key,
+			label: $label,
+			icon: $icon,
+			sort_order: $sort_order,
+			active: true,
+			created_on: time::now(),
+			updated_on: time::now()
+		}
+	`
+	vars := map[string]interface{}{
+		"key":        category.Key,
+		"label":      category.Label,
+		"icon":       category.Icon,
+		"sort_order": category.SortOrder,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: question category already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	category.Active = true
+	category.CreatedOn = created.CreatedOn
+	category.UpdatedOn = created.UpdatedOn
+	return nil
+}
+
+// UpdateQuestionCategory updates a question category by key.
+// Setting active to false stamps deprecated_on; setting it to true clears it.
+func (r *ContentRepository) UpdateQuestionCategory(ctx context.Context, key string, updates map[string]interface{}) (*model.QuestionCategory, error) {
+	query, vars := buildCatalogUpdate("question_category", updates)
+	query += ` WHERE key = $key RETURN AFTER`
+	vars["key"] = key
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseQuestionCategory(data), nil
+}
+
+// DeleteQuestionCategory permanently deletes a question category
+func (r *ContentRepository) DeleteQuestionCategory(ctx context.Context, key string) error {
+	query := `DELETE question_category WHERE key = $key`
+	return r.db.Execute(ctx, query, map[string]interface{}{"key": key})
+}
+
+// CountQuestionsInCategory counts questions (active or not) in a category
+func (r *ContentRepository) CountQuestionsInCategory(ctx context.Context, key string) (int, error) {
+	query := `SELECT count() AS count FROM question WHERE category = $key GROUP ALL`
+
+	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"key": key})
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return extractCount(result), nil
+}
+
+// ===== Review Tags =====
+
+// ListReviewTags retrieves all review tags of a kind, including deprecated ones
+func (r *ContentRepository) ListReviewTags(ctx context.Context, kind model.ReviewTagKind) ([]*model.ReviewTag, error) {
+	query := `SELECT * FROM review_tag WHERE kind = $kind ORDER BY sort_order, tag`
+
+	results, err := r.db.Query(ctx, query, map[string]interface{}{"kind": string(kind)})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*model.ReviewTag, 0)
+	for _, data := range flattenResults(results) {
+		tags = append(tags, parseReviewTag(data))
+	}
+	return tags, nil
+}
+
+// GetReviewTag retrieves a review tag by kind and tag
+func (r *ContentRepository) GetReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) (*model.ReviewTag, error) {
+	query := `SELECT * FROM review_tag WHERE kind = $kind AND tag = $tag LIMIT 1`
+	vars := map[string]interface{}{"kind": string(kind), "tag": tag}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseReviewTag(data), nil
+}
+
+// CreateReviewTag creates a new review tag
+func (r *ContentRepository) CreateReviewTag(ctx context.Context, tag *model.ReviewTag) error {
+	query := `
+		CREATE review_tag CONTENT {
+			kind: $kind,
+			tag: $tag,
+			label: $label,
+			icon: $icon,
+			sort_order: $sort_order,
+			active: true,
+			created_on: time::now(),
+			updated_on: time::now()
+		}
+	`
+	vars := map[string]interface{}{
+		"kind":       string(tag.Kind),
+		"tag":        tag.Tag,
+		"label":      tag.Label,
+		"icon":       tag.Icon,
+		"sort_order": tag.SortOrder,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: review tag already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	tag.Active = true
+	tag.CreatedOn = created.CreatedOn
+	tag.UpdatedOn = created.UpdatedOn
+	return nil
+}
+
+// UpdateReviewTag updates a review tag.
+// Setting active to false stamps deprecated_on; setting it to true clears it.
+func (r *ContentRepository) UpdateReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string, updates map[string]interface{}) (*model.ReviewTag, error) {
+	query, vars := buildCatalogUpdate("review_tag", updates)
+	query += ` WHERE kind = $kind AND tag = $tag RETURN AFTER`
+	vars["kind"] = string(kind)
+	vars["tag"] = tag
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseReviewTag(data), nil
+}
+
+// DeleteReviewTag permanently deletes a review tag
+func (r *ContentRepository) DeleteReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) error {
+	query := `DELETE review_tag WHERE kind = $kind AND tag = $tag`
+	return r.db.Execute(ctx, query, map[string]interface{}{"kind": string(kind), "tag": tag})
+}
+
+// CountReviewsWithTag counts reviews that used a tag
+func (r *ContentRepository) CountReviewsWithTag(ctx context.Context, kind model.ReviewTagKind, tag string) (int, error) {
+	field, ok := reviewTagFields[kind]
+	if !ok {
+		return 0, fmt.Errorf("unknown review tag kind: %s", kind)
+	}
+
+	query := fmt.Sprintf(`SELECT count() AS count FROM review WHERE %s CONTAINS $tag GROUP ALL`, field)
+
+	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"tag": tag})
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return extractCount(result), nil
+}
+
+// ===== Helpers =====
+
+// buildCatalogUpdate builds an UPDATE statement for the allowed catalog fields
+func buildCatalogUpdate(table string, updates map[string]interface{}) (string, map[string]interface{}) {
+	sets := []string{"updated_on = time::now()"}
+	vars := make(map[string]interface{})
+
+	for _, field := range catalogUpdateFields {
+		value, ok := updates[field]
+		if !ok {
+			continue
+		}
+		sets = append(sets, fmt.Sprintf("%s = $%s", field, field))
+		vars[field] = value
+
+		if field == "active" {
+			if active, _ := value.(bool); active {
+				sets = append(sets, "deprecated_on = NONE")
+			} else {
+				sets = append(sets, "deprecated_on = time::now()")
+			}
+		}
+	}
+
+	return fmt.Sprintf("UPDATE %s SET %s", table, strings.Join(sets, ", ")), vars
+}
+
+func parseQuestionCategory(data map[string]interface{}) *model.QuestionCategory {
+	category := &model.QuestionCategory{
+		Key:          getString(data, "key"),
+		Label:        getString(data, "label"),
+		Icon:         getString(data, "icon"),
+		SortOrder:    getInt(data, "sort_order"),
+		Active:       getBool(data, "active"),
+		DeprecatedOn: getTime(data, "deprecated_on"),
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		category.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		category.UpdatedOn = *t
+	}
+	return category
+}
+
+func parseReviewTag(data map[string]interface{}) *model.ReviewTag {
+	tag := &model.ReviewTag{
+		Kind:         model.ReviewTagKind(getString(data, "kind")),
+		Tag:          getString(data, "tag"),
+		Label:        getString(data, "label"),
+		Icon:         getString(data, "icon"),
+		SortOrder:    getInt(data, "sort_order"),
+		Active:       getBool(data, "active"),
+		DeprecatedOn: getTime(data, "deprecated_on"),
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		tag.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		tag.UpdatedOn = *t
+	}
+	return tag
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DevicePairingRepository handles QR login data access
+type DevicePairingRepository struct {
+	db database.Database
+}
+
+// NewDevicePairingRepository creates a new device pairing repository
+func NewDevicePairingRepository(db database.Database) *DevicePairingRepository {
+	return &DevicePairingRepository{db: db}
+}
+
+// Create stores a new, unapproved pairing
+func (r *DevicePairingRepository) Create(ctx context.Context, pairing *model.DevicePairing) error {
+	query := `
+		CREATE device_pairing SET
+			device_name = $device_name,
+			poll_token_hash = $poll_token_hash,
+			expires_on = $expires_on,
+			created_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"device_name":     pairing.DeviceName,
+		"poll_token_hash": pairing.PollTokenHash,
+		"expires_on":      pairing.ExpiresOn,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	pairing.ID = created.ID
+	pairing.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// GetByID retrieves a pairing, expired or not
+func (r *DevicePairingRepository) GetByID(ctx context.Context, id string) (*model.DevicePairing, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseDevicePairing(rows[0]), nil
+}
+
+// Approve signs a pending, unexpired pairing in as a user. Returns nil if
+// the pairing is missing, expired or already approved, so only one phone
+// can approve it.
+func (r *DevicePairingRepository) Approve(ctx context.Context, id, userID string) (*model.DevicePairing, error) {
+	query := `
+		UPDATE type::record($id) SET
+			user = type::record($user_id),
+			approved_on = time::now()
+		WHERE user = NONE AND expires_on > time::now()
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":      id,
+		"user_id": userID,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseDevicePairing(rows[0]), nil
+}
+
+// Claim deletes an approved pairing and returns it, so its tokens are
+// issued once. Returns nil if it was already claimed or isn't approved.
+func (r *DevicePairingRepository) Claim(ctx context.Context, id string) (*model.DevicePairing, error) {
+	query := `DELETE type::record($id) WHERE user != NONE RETURN BEFORE`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseDevicePairing(rows[0]), nil
+}
+
+// DeleteExpired removes pairings that expired before now. Returns how many
+// were removed.
+func (r *DevicePairingRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	query := `DELETE device_pairing WHERE expires_on <= $now RETURN BEFORE`
+	vars := map[string]interface{}{"now": now}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+	return len(flattenResults(results)), nil
+}
+
+func parseDevicePairing(data map[string]interface{}) *model.DevicePairing {
+	pairing := &model.DevicePairing{
+		ID:            convertSurrealID(data["id"]),
+		DeviceName:    getString(data, "device_name"),
+		PollTokenHash: getString(data, "poll_token_hash"),
+		ApprovedOn:    getTime(data, "approved_on"),
+	}
+	if user := data["user"]; user != nil {
+		pairing.UserID = convertSurrealID(user)
+	}
+	if t := getTime(data, "expires_on"); t != nil {
+		pairing.ExpiresOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		pairing.CreatedOn = *t
+	}
+	return pairing
+}
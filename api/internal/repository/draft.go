@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DraftRepository handles draft data access
+type DraftRepository struct {
+	db database.Database
+}
+
+// NewDraftRepository creates a new draft repository
+func NewDraftRepository(db database.Database) *DraftRepository {
+	return &DraftRepository{db: db}
+}
+
+// Create stores a new draft
+func (r *DraftRepository) Create(ctx context.Context, draft *model.Draft) error {
+	query := `
+		CREATE draft SET
+			user = type::record($user_id),
+			resource_type = $resource_type,
+			data = $data,
+			expires_on = $expires_on,
+			created_on = time::now(),
+			updated_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"user_id":       draft.UserID,
+		"resource_type": string(draft.ResourceType),
+		"data":          draft.Data,
+		"expires_on":    draft.ExpiresOn,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	draft.ID = created.ID
+	draft.CreatedOn = created.CreatedOn
+	draft.UpdatedOn = created.UpdatedOn
+	return nil
+}
+
+// GetByID retrieves a draft, expired or not
+func (r *DraftRepository) GetByID(ctx context.Context, id string) (*model.Draft, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseDraft(rows[0]), nil
+}
+
+// ListByUser returns a user's unexpired drafts, most recently saved first,
+// optionally of one resource type
+func (r *DraftRepository) ListByUser(ctx context.Context, userID string, resourceType *model.DraftResourceType, now time.Time) ([]*model.Draft, error) {
+	query := `SELECT * FROM draft WHERE user = type::record($user_id) AND expires_on > $now`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"now":     now,
+	}
+	if resourceType != nil {
+		query += ` AND resource_type = $resource_type`
+		vars["resource_type"] = string(*resourceType)
+	}
+	query += ` ORDER BY updated_on DESC`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	drafts := make([]*model.Draft, 0)
+	for _, data := range flattenResults(results) {
+		drafts = append(drafts, parseDraft(data))
+	}
+	return drafts, nil
+}
+
+// CountByUser counts a user's unexpired drafts
+func (r *DraftRepository) CountByUser(ctx context.Context, userID string, now time.Time) (int, error) {
+	query := `SELECT count() FROM draft WHERE user = type::record($user_id) AND expires_on > $now GROUP ALL`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"now":     now,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+	return extractCount(result), nil
+}
+
+// UpdateData replaces a draft's data, extends its expiry and clears errors
+// from an earlier submit
+func (r *DraftRepository) UpdateData(ctx context.Context, id string, data map[string]interface{}, expiresOn time.Time) (*model.Draft, error) {
+	query := `
+		UPDATE type::record($id) SET
+			data = $data,
+			errors = NONE,
+			expires_on = $expires_on,
+			updated_on = time::now()
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":         id,
+		"data":       data,
+		"expires_on": expiresOn,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseDraft(rows[0]), nil
+}
+
+// SetErrors records the field errors from a failed submit
+func (r *DraftRepository) SetErrors(ctx context.Context, id string, fieldErrors []model.FieldError) error {
+	errs := make([]map[string]interface{}, 0, len(fieldErrors))
+	for _, fe := range fieldErrors {
+		errs = append(errs, map[string]interface{}{
+			"field":   fe.Field,
+			"message": fe.Message,
+		})
+	}
+
+	query := `UPDATE type::record($id) SET errors = $errors`
+	vars := map[string]interface{}{
+		"id":     id,
+		"errors": errs,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Delete removes a draft
+func (r *DraftRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// DeleteExpired purges drafts that expired before now, except those of
+// users on legal hold. Returns how many were removed.
+func (r *DraftRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	query := `DELETE draft WHERE expires_on <= $now AND ` + notOnLegalHold("user") + ` RETURN BEFORE`
+	vars := map[string]interface{}{"now": now}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+	return len(flattenResults(results)), nil
+}
+
+func parseDraft(data map[string]interface{}) *model.Draft {
+	draft := &model.Draft{
+		ID:           convertSurrealID(data["id"]),
+		UserID:       convertSurrealID(data["user"]),
+		ResourceType: model.DraftResourceType(getString(data, "resource_type")),
+		Data:         map[string]interface{}{},
+	}
+	if fields, ok := data["data"].(map[string]interface{}); ok {
+		draft.Data = fields
+	}
+	if errs, ok := data["errors"].([]interface{}); ok {
+		for _, item := range errs {
+			if fe, ok := item.(map[string]interface{}); ok {
+				draft.Errors = append(draft.Errors, model.FieldError{
+					Field:   getString(fe, "field"),
+					Message: getString(fe, "message"),
+				})
+			}
+		}
+	}
+	if t := getTime(data, "expires_on"); t != nil {
+		draft.ExpiresOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		draft.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		draft.UpdatedOn = *t
+	}
+	return draft
+}
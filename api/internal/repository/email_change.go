@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// EmailChangeRepository handles pending email change data access
+type EmailChangeRepository struct {
+	db database.Database
+}
+
+// NewEmailChangeRepository creates a new email change repository
+func NewEmailChangeRepository(db database.Database) *EmailChangeRepository {
+	return &EmailChangeRepository{db: db}
+}
+
+// Create stores a pending email change, replacing any the user already has
+// so only the latest verification link works
+func (r *EmailChangeRepository) Create(ctx context.Context, change *model.EmailChange) error {
+	if err := r.DeleteByUser(ctx, change.UserID); err != nil {
+		return err
+	}
+
+	vars := map[string]interface{}{
+		"user_id":    change.UserID,
+		"new_email":  change.NewEmail,
+		"token_hash": change.TokenHash,
+		"recovery":   change.Recovery,
+		"expires_on": change.ExpiresOn,
+	}
+	effective := "effective_on = NONE"
+	if change.EffectiveOn != nil {
+		effective = "effective_on = $effective_on"
+		vars["effective_on"] = *change.EffectiveOn
+	}
+	cancel := "cancel_token_hash = NONE"
+	if change.CancelTokenHash != "" {
+		cancel = "cancel_token_hash = $cancel_token_hash"
+		vars["cancel_token_hash"] = change.CancelTokenHash
+	}
+	query := `
+		CREATE email_change SET
+			user = type::record($user_id),
+			new_email = $new_email,
+			token_hash = $token_hash,
+			recovery = $recovery,
+			` + effective + `,
+			` + cancel + `,
+			expires_on = $expires_on,
+			created_on = time::now()
+	`
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	change.ID = created.ID
+	change.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// GetByTokenHash returns the pending change matching a token hash without
+// using it up. Returns nil if there is none.
+func (r *EmailChangeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChange, error) {
+	query := `SELECT * FROM email_change WHERE token_hash = $token_hash LIMIT 1`
+	vars := map[string]interface{}{"token_hash": tokenHash}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseEmailChange(rows[0]), nil
+}
+
+// ClaimByTokenHash deletes the pending change matching a token hash and
+// returns it, so a verification link can only be used once. Returns nil if
+// there is none.
+func (r *EmailChangeRepository) ClaimByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChange, error) {
+	query := `DELETE email_change WHERE token_hash = $token_hash RETURN BEFORE`
+	vars := map[string]interface{}{"token_hash": tokenHash}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseEmailChange(rows[0]), nil
+}
+
+// ClaimByCancelTokenHash deletes the recovery change matching the token
+// sent to the old address and returns it. Returns nil if there is none.
+func (r *EmailChangeRepository) ClaimByCancelTokenHash(ctx context.Context, cancelTokenHash string) (*model.EmailChange, error) {
+	query := `DELETE email_change WHERE cancel_token_hash = $cancel_token_hash RETURN BEFORE`
+	vars := map[string]interface{}{"cancel_token_hash": cancelTokenHash}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseEmailChange(rows[0]), nil
+}
+
+// DeleteByUser cancels a user's pending email change
+func (r *EmailChangeRepository) DeleteByUser(ctx context.Context, userID string) error {
+	query := `DELETE email_change WHERE user = type::record($user_id)`
+	vars := map[string]interface{}{"user_id": userID}
+	return r.db.Execute(ctx, query, vars)
+}
+
+func parseEmailChange(data map[string]interface{}) *model.EmailChange {
+	change := &model.EmailChange{
+		ID:              convertSurrealID(data["id"]),
+		UserID:          convertSurrealID(data["user"]),
+		NewEmail:        getString(data, "new_email"),
+		TokenHash:       getString(data, "token_hash"),
+		Recovery:        getBool(data, "recovery"),
+		EffectiveOn:     getTime(data, "effective_on"),
+		CancelTokenHash: getString(data, "cancel_token_hash"),
+	}
+	if t := getTime(data, "expires_on"); t != nil {
+		change.ExpiresOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		change.CreatedOn = *t
+	}
+	return change
+}
@@ -40,6 +40,7 @@ func (r *EventRepository) Create(ctx context.Context, event *model.Event) error
 		visibility = $visibility,
 		status = $status,
 		created_by = $created_by,
+		version = 1,
 		created_on = time::now(),
 		updated_on = time::now()`
 
@@ -55,16 +56,21 @@ func (r *EventRepository) Create(ctx context.Context, event *model.Event) error
 	if event.Location != nil {
 		setClause += ", location = $location"
 		vars["location"] = map[string]interface{}{
-			"name":         event.Location.Name,
-			"address":      event.Location.Address,
-			"neighborhood": event.Location.Neighborhood,
-			"city":         event.Location.City,
-			"lat":          event.Location.Lat,
-			"lng":          event.Location.Lng,
-			"is_virtual":   event.Location.IsVirtual,
-			"meet_link":    event.Location.MeetLink,
+			"name":          event.Location.Name,
+			"address":       event.Location.Address,
+			"neighborhood":  event.Location.Neighborhood,
+			"city":          event.Location.City,
+			"lat":           event.Location.Lat,
+			"lng":           event.Location.Lng,
+			"is_virtual":    event.Location.IsVirtual,
+			"meet_link":     event.Location.MeetLink,
+			"accessibility": event.Location.Accessibility,
 		}
 	}
+	if event.VenueID != nil {
+		setClause += ", venue_id = $venue_id"
+		vars["venue_id"] = *event.VenueID
+	}
 	if event.EndTime != nil {
 		setClause += ", end_time = $end_time"
 		vars["end_time"] = event.EndTime
@@ -77,6 +83,24 @@ func (r *EventRepository) Create(ctx context.Context, event *model.Event) error
 		setClause += ", waitlist_enabled = $waitlist_enabled"
 		vars["waitlist_enabled"] = event.WaitlistEnabled
 	}
+	if event.Ticketed {
+		setClause += ", ticketed = $ticketed"
+		vars["ticketed"] = event.Ticketed
+	}
+	if event.TicketPrice > 0 {
+		setClause += ", ticket_price = $ticket_price, currency = $currency, refund_policy = $refund_policy"
+		vars["ticket_price"] = event.TicketPrice
+		vars["currency"] = event.Currency
+		vars["refund_policy"] = event.RefundPolicy
+	}
+	if event.MinTier != nil {
+		setClause += ", min_tier = $min_tier"
+		vars["min_tier"] = *event.MinTier
+	}
+	if event.RSVPOpensAt != nil {
+		setClause += ", rsvp_opens_at = $rsvp_opens_at"
+		vars["rsvp_opens_at"] = *event.RSVPOpensAt
+	}
 	if event.CoverImage != nil {
 		setClause += ", cover_image = $cover_image"
 		vars["cover_image"] = event.CoverImage
@@ -113,6 +137,10 @@ func (r *EventRepository) Create(ctx context.Context, event *model.Event) error
 		setClause += ", confirmation_deadline = $confirmation_deadline"
 		vars["confirmation_deadline"] = event.ConfirmationDeadline
 	}
+	if event.PublishAt != nil {
+		setClause += ", publish_at = $publish_at"
+		vars["publish_at"] = event.PublishAt
+	}
 
 	query := "CREATE event SET " + setClause
 
@@ -127,6 +155,7 @@ func (r *EventRepository) Create(ctx context.Context, event *model.Event) error
 	}
 
 	event.ID = created.ID
+	event.Version = 1
 	event.CreatedOn = created.CreatedOn
 	event.UpdatedOn = created.UpdatedOn
 	return nil
@@ -135,7 +164,7 @@ func (r *EventRepository) Create(ctx context.Context, event *model.Event) error
 // Get retrieves an event by ID
 func (r *EventRepository) Get(ctx context.Context, eventID string) (*model.Event, error) {
 	// Direct record access - more efficient than WHERE id =
-	query := `SELECT * FROM type::record($event_id)`
+	query := `SELECT * FROM type::record($event_id) WHERE deleted_at = NONE`
 	vars := map[string]interface{}{"event_id": eventID}
 
 	result, err := r.db.QueryOne(ctx, query, vars)
@@ -149,48 +178,272 @@ func (r *EventRepository) Get(ctx context.Context, eventID string) (*model.Event
 	return r.parseEventResult(result)
 }
 
-// Update updates an event
-func (r *EventRepository) Update(ctx context.Context, eventID string, updates map[string]interface{}) (*model.Event, error) {
-	query := `UPDATE event SET updated_on = time::now()`
+// Update updates an event and bumps its version. When version is given the
+// update only applies if the event is still at that version; returns nil if
+// it isn't or the event doesn't exist.
+func (r *EventRepository) Update(ctx context.Context, eventID string, updates map[string]interface{}, version *int) (*model.Event, error) {
+	query := `UPDATE event SET updated_on = time::now(), version += 1`
 	vars := map[string]interface{}{"event_id": eventID}
 
 	for key, value := range updates {
-		query += ", " + key + " = $" + key
-		vars[key] = value
+		query += ", " + setClause(key, value, vars)
 	}
 
-	query += ` WHERE id = type::record($event_id) RETURN AFTER`
+	query += ` WHERE id = type::record($event_id)`
+	if version != nil {
+		query += ` AND version = $version`
+		vars["version"] = *version
+	}
+	query += ` RETURN AFTER`
 
 	result, err := r.db.QueryOne(ctx, query, vars)
 	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 
 	return r.parseEventResult(result)
 }
 
-// Delete deletes an event
+// SchedulePublication sets when a draft or scheduled event is published.
+// Returns nil if the event has been published or cancelled meanwhile.
+func (r *EventRepository) SchedulePublication(ctx context.Context, eventID string, publishAt time.Time) (*model.Event, error) {
+	query := `
+		UPDATE type::record($event_id) SET
+			status = "scheduled",
+			publish_at = $publish_at,
+			updated_on = time::now()
+		WHERE status IN ["draft", "scheduled"]
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"event_id":   eventID,
+		"publish_at": publishAt,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return r.parseEventResult(rows[0])
+}
+
+// SetRSVPWindow replaces when an event's RSVPs open and its early access
+// rules. A nil opensAt clears the window.
+func (r *EventRepository) SetRSVPWindow(ctx context.Context, eventID string, opensAt *time.Time, earlyAccess []model.RSVPEarlyAccess) (*model.Event, error) {
+	rules := make([]map[string]interface{}, 0, len(earlyAccess))
+	for _, rule := range earlyAccess {
+		entry := map[string]interface{}{"hours": rule.Hours}
+		if rule.TierID != nil {
+			entry["tier_id"] = *rule.TierID
+		}
+		if rule.Role != nil {
+			entry["role"] = string(*rule.Role)
+		}
+		rules = append(rules, entry)
+	}
+
+	vars := map[string]interface{}{
+		"event_id":          eventID,
+		"rsvp_early_access": rules,
+	}
+	var opens interface{}
+	if opensAt != nil {
+		opens = *opensAt
+	}
+	query := `
+		UPDATE type::record($event_id) SET
+			` + setClause("rsvp_opens_at", opens, vars) + `,
+			rsvp_early_access = $rsvp_early_access,
+			updated_on = time::now(),
+			version += 1
+		RETURN AFTER
+	`
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return r.parseEventResult(rows[0])
+}
+
+// PublishDue publishes scheduled events whose publish time has passed and
+// returns them. The status flip is a single statement, so each event is
+// returned by exactly one run.
+func (r *EventRepository) PublishDue(ctx context.Context, now time.Time) ([]*model.Event, error) {
+	query := `
+		UPDATE event SET status = "published", updated_on = time::now()
+		WHERE status = "scheduled" AND publish_at <= $now AND deleted_at = NONE
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"now": now}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.parseEventsResult(result)
+}
+
+// Delete permanently deletes an event with its hosts and RSVPs
 func (r *EventRepository) Delete(ctx context.Context, eventID string) error {
-	query := `DELETE event WHERE id = type::record($event_id)`
+	queries := []string{
+		`DELETE event_host WHERE event_id = type::record($event_id)`,
+		`DELETE event_rsvp WHERE event_id = type::record($event_id)`,
+		`DELETE event WHERE id = type::record($event_id)`,
+	}
 	vars := map[string]interface{}{"event_id": eventID}
 
-	return r.db.Execute(ctx, query, vars)
+	for _, q := range queries {
+		if err := r.db.Execute(ctx, q, vars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MoveToTrash moves an event to its guild's trash. Returns false if the event
+// doesn't exist or is already in the trash.
+func (r *EventRepository) MoveToTrash(ctx context.Context, eventID, userID string) (bool, error) {
+	return moveToTrash(ctx, r.db, eventID, userID)
 }
 
-// GetByGuild retrieves events for a guild
-func (r *EventRepository) GetByGuild(ctx context.Context, guildID string, filters *model.EventSearchFilters) ([]*model.Event, error) {
+// GetTrashByGuild lists a guild's trashed events
+func (r *EventRepository) GetTrashByGuild(ctx context.Context, guildID string) ([]*model.TrashItem, error) {
+	return getTrashByGuild(ctx, r.db, "event", model.TrashItemEvent, guildID)
+}
+
+// RestoreFromTrash restores a trashed event of the guild. Returns nil if the
+// event isn't in the guild's trash.
+func (r *EventRepository) RestoreFromTrash(ctx context.Context, eventID, guildID string) (*model.TrashItem, error) {
+	return restoreFromTrash(ctx, r.db, model.TrashItemEvent, eventID, guildID)
+}
+
+// PurgeTrashed permanently deletes events trashed at or before the cutoff.
+// Returns how many were deleted.
+func (r *EventRepository) PurgeTrashed(ctx context.Context, before time.Time) (int, error) {
+	ids, err := getTrashedBefore(ctx, r.db, "event", before)
+	if err != nil {
+		return 0, err
+	}
+	for i, id := range ids {
+		if err := r.Delete(ctx, id); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
+
+// eventListColumns maps model.EventListFields to event columns
+var eventListColumns = database.ListColumns{
+	"start_time":     "start_time",
+	"created_on":     "created_on",
+	"title":          "title",
+	"attendee_count": "attendee_count",
+	"status":         "status",
+	"template":       "template",
+	"visibility":     "visibility",
+	"city":           "location.city",
+}
+
+// eventListOrder is the order of event lists that don't ask for one
+var eventListOrder = []model.SortKey{{Field: "start_time"}}
+
+// GetByGuild retrieves events for a guild, soonest first unless list asks
+// for another order
+func (r *EventRepository) GetByGuild(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error) {
+	vars := map[string]interface{}{}
+	query := guildEventsQuery(guildID, filters, list, vars)
+	query += ` ` + database.ListOrder(list, eventListColumns, "start_time ASC")
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.parseEventsResult(result)
+}
+
+// GetByGuildPage retrieves a page of a guild's events, soonest first unless
+// list asks for another order
+func (r *EventRepository) GetByGuildPage(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Event], error) {
+	vars := map[string]interface{}{}
+	query := guildEventsQuery(guildID, nil, list, vars)
+
+	condition, tail, sortColumns, err := database.ListPage(list, eventListColumns, eventListOrder, page, vars)
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		query += ` AND ` + condition
+	}
+	query += ` ` + tail
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return readPage(result, list, sortColumns, page, r.parseEventResult), nil
+}
+
+// guildEventsQuery selects a guild's listed events, filtered as filters and
+// list ask, ready for an order
+func guildEventsQuery(guildID string, filters *model.EventSearchFilters, list model.ListQuery, vars map[string]interface{}) string {
 	query := `
 		SELECT * FROM event
-		WHERE guild_id = $guild_id AND status IN ["published", "completed"]
+		WHERE guild_id = $guild_id AND status IN ["published", "completed"] AND deleted_at = NONE
 	`
-	vars := map[string]interface{}{"guild_id": guildID}
+	vars["guild_id"] = guildID
 
 	if filters != nil && filters.StartAfter != nil {
 		query += ` AND start_time >= $start_after`
 		vars["start_after"] = *filters.StartAfter
 	}
+	if filters != nil && filters.StartBefore != nil {
+		query += ` AND start_time < $start_before`
+		vars["start_before"] = *filters.StartBefore
+	}
+	for _, condition := range database.ListConditions(list, eventListColumns, vars) {
+		query += ` AND ` + condition
+	}
+	return query
+}
 
-	query += ` ORDER BY start_time ASC`
+// GetUserEventsInRange retrieves events the user is going to, or waiting to
+// go to, that start within [since, until)
+func (r *EventRepository) GetUserEventsInRange(ctx context.Context, userID string, since, until time.Time) ([]*model.Event, error) {
+	query := `
+		SELECT * FROM event
+		WHERE id IN (
+			SELECT VALUE event_id FROM event_rsvp
+			WHERE user_id = type::record($user_id)
+			AND status IN ["pending", "approved", "waitlisted"]
+			AND rsvp_type != "not_going"
+		)
+		AND status = "published"
+		AND deleted_at = NONE
+		AND start_time >= $since AND start_time < $until
+		ORDER BY start_time ASC
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"since":   since,
+		"until":   until,
+	}
 
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
@@ -204,7 +457,7 @@ func (r *EventRepository) GetByGuild(ctx context.Context, guildID string, filter
 func (r *EventRepository) GetPublicEvents(ctx context.Context, filters *model.EventSearchFilters, limit int) ([]*model.Event, error) {
 	query := `
 		SELECT * FROM event
-		WHERE visibility = "public" AND status = "published"
+		WHERE visibility = "public" AND status = "published" AND deleted_at = NONE
 	`
 	vars := map[string]interface{}{"limit": limit}
 
@@ -225,6 +478,10 @@ func (r *EventRepository) GetPublicEvents(ctx context.Context, filters *model.Ev
 			query += ` AND location.city = $city`
 			vars["city"] = *filters.City
 		}
+		if len(filters.Accessibility) > 0 {
+			query += ` AND location.accessibility CONTAINSALL $accessibility`
+			vars["accessibility"] = filters.Accessibility
+		}
 	}
 
 	query += ` ORDER BY start_time ASC LIMIT $limit`
@@ -326,6 +583,7 @@ func (r *EventRepository) CreateRSVP(ctx context.Context, rsvp *model.EventRSVP)
 			alignment_score: $alignment_score,
 			yikes_count: $yikes_count,
 			waiting_reason: $waiting_reason,
+			payment_status: $payment_status,
 			plus_ones: $plus_ones,
 			plus_one_names: $plus_one_names,
 			requested_on: time::now(),
@@ -342,6 +600,7 @@ func (r *EventRepository) CreateRSVP(ctx context.Context, rsvp *model.EventRSVP)
 		"alignment_score": rsvp.AlignmentScore,
 		"yikes_count":     rsvp.YikesCount,
 		"waiting_reason":  rsvp.WaitingReason,
+		"payment_status":  rsvp.PaymentStatus,
 		"plus_ones":       rsvp.PlusOnes,
 		"plus_one_names":  rsvp.PlusOneNames,
 	}
@@ -405,6 +664,33 @@ func (r *EventRepository) UpdateRSVP(ctx context.Context, rsvpID string, updates
 	return r.parseRSVPResult(result)
 }
 
+// RestoreRSVP sets a cancelled RSVP back to status. Returns nil if the RSVP
+// is no longer cancelled.
+func (r *EventRepository) RestoreRSVP(ctx context.Context, rsvpID, status string) (*model.EventRSVP, error) {
+	query := `
+		UPDATE type::record($rsvp_id) SET
+			status = $status,
+			updated_on = time::now()
+		WHERE status = $cancelled
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"rsvp_id":   rsvpID,
+		"status":    status,
+		"cancelled": model.RSVPStatusCancelled,
+	}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.parseRSVPResult(result)
+}
+
 // GetRSVPsByEvent retrieves all RSVPs for an event
 func (r *EventRepository) GetRSVPsByEvent(ctx context.Context, eventID string) ([]*model.EventRSVP, error) {
 	query := `
@@ -518,6 +804,7 @@ func (r *EventRepository) parseEventResult(result interface{}) (*model.Event, er
 			event.Location.MeetLink = &meetLink
 		}
 		event.Location.IsVirtual = getBool(locData, "is_virtual")
+		event.Location.Accessibility = getStringSlice(locData, "accessibility")
 	}
 
 	event.ValuesQuestions = getStringSlice(data, "values_questions")
@@ -526,6 +813,7 @@ func (r *EventRepository) parseEventResult(result interface{}) (*model.Event, er
 		event.StartTime = *t
 	}
 	event.EndTime = getTime(data, "end_time")
+	event.PublishAt = getTime(data, "publish_at")
 	if t := getTime(data, "created_on"); t != nil {
 		event.CreatedOn = *t
 	}
@@ -915,6 +1203,7 @@ func (r *EventRepository) GetEventsNeedingVerification(ctx context.Context) ([]*
 		AND confirmation_deadline IS NOT NONE
 		AND confirmation_deadline < time::now()
 		AND status = "completed"
+		AND deleted_at = NONE
 		ORDER BY confirmation_deadline ASC
 		LIMIT 100
 	`
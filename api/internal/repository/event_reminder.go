@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// EventReminderRepository handles event reminder data access
+type EventReminderRepository struct {
+	db database.Database
+}
+
+// NewEventReminderRepository creates a new event reminder repository
+func NewEventReminderRepository(db database.Database) *EventReminderRepository {
+	return &EventReminderRepository{db: db}
+}
+
+// GetUpcomingApprovedRSVPs returns approved RSVPs for published events starting in (from, to]
+func (r *EventReminderRepository) GetUpcomingApprovedRSVPs(ctx context.Context, from, to time.Time) ([]*model.EventReminderCandidate, error) {
+	query := `
+		SELECT event_id, user_id, event_id.title AS title, event_id.start_time AS start_time
+		FROM event_rsvp
+		WHERE status = "approved"
+			AND event_id.status = "published"
+			AND event_id.start_time > $from
+			AND event_id.start_time <= $to
+	`
+	vars := map[string]interface{}{
+		"from": from,
+		"to":   to,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*model.EventReminderCandidate, 0)
+	for _, res := range results {
+		resp, ok := res.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		items, ok := resp["result"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			data, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			candidate := &model.EventReminderCandidate{
+				EventID: convertSurrealID(data["event_id"]),
+				UserID:  convertSurrealID(data["user_id"]),
+				Title:   getString(data, "title"),
+			}
+			if t := getTime(data, "start_time"); t != nil {
+				candidate.StartTime = *t
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates, nil
+}
+
+// RecordSent records a delivered reminder. It returns database.ErrDuplicate
+// when the reminder for this user, event and offset was already recorded.
+func (r *EventReminderRepository) RecordSent(ctx context.Context, sent *model.EventReminderSent) error {
+	query := `
+		CREATE event_reminder_sent CONTENT {
+			user_id: $user_id,
+			event_id: $event_id,
+			offset_minutes: $offset_minutes,
+			sent_on: time::now()
+		}
+	`
+	vars := map[string]interface{}{
+		"user_id":        sent.UserID,
+		"event_id":       sent.EventID,
+		"offset_minutes": sent.OffsetMinutes,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: reminder already sent", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	sent.ID = created.ID
+	sent.SentOn = time.Now()
+	return nil
+}
+
+// GetPreference retrieves a user's reminder preference, or nil if none is stored
+func (r *EventReminderRepository) GetPreference(ctx context.Context, userID string) (*model.EventReminderPreference, error) {
+	query := `SELECT * FROM event_reminder_preference WHERE user_id = $user_id LIMIT 1`
+	vars := map[string]interface{}{"user_id": userID}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+
+	pref := &model.EventReminderPreference{
+		ID:      convertSurrealID(data["id"]),
+		UserID:  getString(data, "user_id"),
+		Enabled: getBool(data, "enabled"),
+	}
+	if raw, ok := data["offsets_minutes"].([]interface{}); ok {
+		for _, v := range raw {
+			if m := extractCountValue(v); m > 0 {
+				pref.OffsetsMinutes = append(pref.OffsetsMinutes, m)
+			}
+		}
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		pref.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		pref.UpdatedOn = *t
+	}
+
+	return pref, nil
+}
+
+// UpsertPreference creates or replaces a user's reminder preference
+func (r *EventReminderRepository) UpsertPreference(ctx context.Context, pref *model.EventReminderPreference) error {
+	offsets := pref.OffsetsMinutes
+	if offsets == nil {
+		offsets = []int{}
+	}
+
+	// SurrealDB 3.0 UPSERT doesn't work with WHERE clause properly
+	// Use IF/ELSE pattern instead
+	query := `
+		LET $existing = SELECT * FROM event_reminder_preference WHERE user_id = $user_id;
+		IF array::len($existing) = 0 {
+			CREATE event_reminder_preference SET
+				user_id = $user_id,
+				enabled = $enabled,
+				offsets_minutes = $offsets_minutes
+		} ELSE {
+			UPDATE event_reminder_preference SET
+				enabled = $enabled,
+				offsets_minutes = $offsets_minutes,
+				updated_on = time::now()
+			WHERE user_id = $user_id
+		}
+	`
+	vars := map[string]interface{}{
+		"user_id":         pref.UserID,
+		"enabled":         pref.Enabled,
+		"offsets_minutes": offsets,
+	}
+
+	if _, err := r.db.Query(ctx, query, vars); err != nil {
+		return err
+	}
+
+	pref.UpdatedOn = time.Now()
+	return nil
+}
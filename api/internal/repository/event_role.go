@@ -23,14 +23,19 @@ func NewEventRoleRepository(db database.Database) *EventRoleRepository {
 // CreateRole creates a new event role
 func (r *EventRoleRepository) CreateRole(ctx context.Context, role *model.EventRole) error {
 	// Build query dynamically to avoid NULL vs NONE issues for optional fields
-	setClause := `event_id = type::record($event_id), name = $name, max_slots = $max_slots, is_default = $is_default, sort_order = $sort_order, created_by = type::record($created_by), created_on = time::now(), updated_on = time::now()`
+	setClause := `event_id = type::record($event_id), name = $name, max_slots = $max_slots, is_default = $is_default, sort_order = $sort_order, assignment_mode = $assignment_mode, is_critical = $is_critical, created_by = type::record($created_by), created_on = time::now(), updated_on = time::now()`
+	if role.AssignmentMode == "" {
+		role.AssignmentMode = model.RoleAssignmentModeDirect
+	}
 	vars := map[string]interface{}{
-		"event_id":   role.EventID,
-		"name":       role.Name,
-		"max_slots":  role.MaxSlots,
-		"is_default": role.IsDefault,
-		"sort_order": role.SortOrder,
-		"created_by": role.CreatedBy,
+		"event_id":        role.EventID,
+		"name":            role.Name,
+		"max_slots":       role.MaxSlots,
+		"is_default":      role.IsDefault,
+		"sort_order":      role.SortOrder,
+		"assignment_mode": role.AssignmentMode,
+		"is_critical":     role.IsCritical,
+		"created_by":      role.CreatedBy,
 	}
 
 	// Add optional fields only when they have values
@@ -119,6 +124,14 @@ func (r *EventRoleRepository) UpdateRole(ctx context.Context, roleID string, upd
 		query += ", suggested_interests = $suggested_interests"
 		vars["suggested_interests"] = suggestedInterests
 	}
+	if assignmentMode, ok := updates["assignment_mode"]; ok {
+		query += ", assignment_mode = $assignment_mode"
+		vars["assignment_mode"] = assignmentMode
+	}
+	if isCritical, ok := updates["is_critical"]; ok {
+		query += ", is_critical = $is_critical"
+		vars["is_critical"] = isCritical
+	}
 
 	query += ` WHERE id = type::record($role_id) RETURN AFTER`
 
@@ -236,11 +249,11 @@ func (r *EventRoleRepository) GetUserAssignmentsForEvent(ctx context.Context, ev
 	return r.parseAssignmentsResult(result)
 }
 
-// GetAssignmentsByRole retrieves all assignments for a role
+// GetAssignmentsByRole retrieves all confirmed and pending assignments for a role
 func (r *EventRoleRepository) GetAssignmentsByRole(ctx context.Context, roleID string) ([]*model.EventRoleAssignment, error) {
 	query := `
 		SELECT * FROM event_role_assignment
-		WHERE role_id = type::record($role_id) AND status != "cancelled"
+		WHERE role_id = type::record($role_id) AND status IN ["pending", "confirmed"]
 		ORDER BY assigned_on ASC
 	`
 	vars := map[string]interface{}{"role_id": roleID}
@@ -253,11 +266,11 @@ func (r *EventRoleRepository) GetAssignmentsByRole(ctx context.Context, roleID s
 	return r.parseAssignmentsResult(result)
 }
 
-// GetAssignmentsByEvent retrieves all assignments for an event
+// GetAssignmentsByEvent retrieves all confirmed and pending assignments for an event
 func (r *EventRoleRepository) GetAssignmentsByEvent(ctx context.Context, eventID string) ([]*model.EventRoleAssignment, error) {
 	query := `
 		SELECT * FROM event_role_assignment
-		WHERE event_id = type::record($event_id) AND status != "cancelled"
+		WHERE event_id = type::record($event_id) AND status IN ["pending", "confirmed"]
 		ORDER BY assigned_on ASC
 	`
 	vars := map[string]interface{}{"event_id": eventID}
@@ -286,6 +299,14 @@ func (r *EventRoleRepository) UpdateAssignment(ctx context.Context, assignmentID
 		query += ", status = $status"
 		vars["status"] = status
 	}
+	if reviewedBy, ok := updates["reviewed_by"]; ok {
+		query += ", reviewed_by = type::record($reviewed_by), reviewed_on = time::now()"
+		vars["reviewed_by"] = reviewedBy
+	}
+	if response, ok := updates["response"]; ok {
+		query += ", response = $response"
+		vars["response"] = response
+	}
 
 	query += ` WHERE id = type::record($assignment_id) RETURN AFTER`
 
@@ -309,7 +330,7 @@ func (r *EventRoleRepository) DeleteAssignment(ctx context.Context, assignmentID
 func (r *EventRoleRepository) CountAssignmentsByRole(ctx context.Context, roleID string) (int, error) {
 	query := `
 		SELECT count() as count FROM event_role_assignment
-		WHERE role_id = $role_id AND status = "confirmed"
+		WHERE role_id = type::record($role_id) AND status = "confirmed"
 		GROUP ALL
 	`
 	vars := map[string]interface{}{"role_id": roleID}
@@ -342,15 +363,19 @@ func (r *EventRoleRepository) GetRolesWithAssignments(ctx context.Context, event
 			return nil, err
 		}
 
-		// Convert to non-pointer slice
+		// Convert to non-pointer slice; pending nominations don't take a slot
 		assignmentsList := make([]model.EventRoleAssignment, 0, len(assignments))
+		confirmed := 0
 		for _, a := range assignments {
 			assignmentsList = append(assignmentsList, *a)
+			if a.Status == model.RoleAssignmentStatusConfirmed {
+				confirmed++
+			}
 		}
 
 		spotsLeft := -1 // Unlimited
 		if role.MaxSlots > 0 {
-			spotsLeft = role.MaxSlots - len(assignmentsList)
+			spotsLeft = role.MaxSlots - confirmed
 			if spotsLeft < 0 {
 				spotsLeft = 0
 			}
@@ -359,7 +384,7 @@ func (r *EventRoleRepository) GetRolesWithAssignments(ctx context.Context, event
 		result = append(result, model.EventRoleWithAssignments{
 			Role:        *role,
 			Assignments: assignmentsList,
-			IsFull:      role.MaxSlots > 0 && len(assignmentsList) >= role.MaxSlots,
+			IsFull:      role.MaxSlots > 0 && confirmed >= role.MaxSlots,
 			SpotsLeft:   spotsLeft,
 		})
 	}
@@ -401,6 +426,9 @@ func (r *EventRoleRepository) parseRoleResult(result interface{}) (*model.EventR
 	}
 
 	role.SuggestedInterests = getStringSlice(data, "suggested_interests")
+	if role.AssignmentMode == "" {
+		role.AssignmentMode = model.RoleAssignmentModeDirect
+	}
 
 	if t := getTime(data, "created_on"); t != nil {
 		role.CreatedOn = *t
@@ -462,6 +490,11 @@ func (r *EventRoleRepository) parseAssignmentResult(result interface{}) (*model.
 	if userID, ok := data["user_id"]; ok {
 		data["user_id"] = convertSurrealID(userID)
 	}
+	if reviewedBy, ok := data["reviewed_by"]; ok {
+		data["reviewed_by"] = convertSurrealID(reviewedBy)
+	}
+	reviewedOn := getTime(data, "reviewed_on")
+	delete(data, "reviewed_on")
 
 	jsonBytes, err := json.Marshal(data)
 	if err != nil {
@@ -476,6 +509,7 @@ func (r *EventRoleRepository) parseAssignmentResult(result interface{}) (*model.
 	if t := getTime(data, "assigned_on"); t != nil {
 		assignment.AssignedOn = *t
 	}
+	assignment.ReviewedOn = reviewedOn
 	if t := getTime(data, "updated_on"); t != nil {
 		assignment.UpdatedOn = *t
 	}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// EventRoleAlertRepository handles role fulfillment alert data access
+type EventRoleAlertRepository struct {
+	db database.Database
+}
+
+// NewEventRoleAlertRepository creates a new event role alert repository
+func NewEventRoleAlertRepository(db database.Database) *EventRoleAlertRepository {
+	return &EventRoleAlertRepository{db: db}
+}
+
+// GetCriticalRoles returns critical roles of published events starting in (from, to]
+// along with their confirmed assignment counts
+func (r *EventRoleAlertRepository) GetCriticalRoles(ctx context.Context, from, to time.Time) ([]*model.RoleGapCandidate, error) {
+	query := `
+		SELECT id, event_id, name, max_slots,
+			event_id.title AS event_title,
+			event_id.start_time AS start_time,
+			count((SELECT id FROM event_role_assignment WHERE role_id = $parent.id AND status = "confirmed")) AS filled_slots
+		FROM event_role
+		WHERE is_critical = true
+			AND event_id.status = "published"
+			AND event_id.start_time > $from
+			AND event_id.start_time <= $to
+	`
+	vars := map[string]interface{}{
+		"from": from,
+		"to":   to,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*model.RoleGapCandidate, 0)
+	for _, data := range flattenResults(results) {
+		candidate := &model.RoleGapCandidate{
+			EventID:     convertSurrealID(data["event_id"]),
+			EventTitle:  getString(data, "event_title"),
+			RoleID:      convertSurrealID(data["id"]),
+			RoleName:    getString(data, "name"),
+			MaxSlots:    getInt(data, "max_slots"),
+			FilledSlots: getInt(data, "filled_slots"),
+		}
+		if t := getTime(data, "start_time"); t != nil {
+			candidate.StartTime = *t
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// GetConfirmedAssignments returns confirmed role assignments for published events starting in (from, to]
+func (r *EventRoleAlertRepository) GetConfirmedAssignments(ctx context.Context, from, to time.Time) ([]*model.RoleAssigneeCandidate, error) {
+	query := `
+		SELECT event_id, role_id, user_id,
+			role_id.name AS role_name,
+			event_id.title AS event_title,
+			event_id.start_time AS start_time
+		FROM event_role_assignment
+		WHERE status = "confirmed"
+			AND event_id.status = "published"
+			AND event_id.start_time > $from
+			AND event_id.start_time <= $to
+	`
+	vars := map[string]interface{}{
+		"from": from,
+		"to":   to,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*model.RoleAssigneeCandidate, 0)
+	for _, data := range flattenResults(results) {
+		candidate := &model.RoleAssigneeCandidate{
+			EventID:    convertSurrealID(data["event_id"]),
+			EventTitle: getString(data, "event_title"),
+			RoleID:     convertSurrealID(data["role_id"]),
+			RoleName:   getString(data, "role_name"),
+			UserID:     convertSurrealID(data["user_id"]),
+		}
+		if t := getTime(data, "start_time"); t != nil {
+			candidate.StartTime = *t
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// RecordAlertSent records a delivered role alert. It returns database.ErrDuplicate
+// when an alert of this kind was already sent to the user for the role.
+func (r *EventRoleAlertRepository) RecordAlertSent(ctx context.Context, kind, roleID, userID string) error {
+	query := `
+		CREATE event_role_alert_sent CONTENT {
+			kind: $kind,
+			role_id: type::record($role_id),
+			user_id: type::record($user_id),
+			sent_on: time::now()
+		}
+	`
+	vars := map[string]interface{}{
+		"kind":    kind,
+		"role_id": roleID,
+		"user_id": userID,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: role alert already sent", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	_, err = extractCreatedRecord(result)
+	return err
+}
@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// activeTicketClause matches tickets that take a seat: claimed, used, or
+// held with an unexpired hold
+const activeTicketClause = `(status IN ["claimed", "used"] OR (status = "held" AND hold_expires_on > time::now()))`
+
+// CreateTicketHold holds a ticket if the event has fewer than capacity
+// active tickets. Counting and creating in one statement keeps two guests
+// from taking the last seat. Returns false if the event is sold out.
+func (r *EventRepository) CreateTicketHold(ctx context.Context, ticket *model.EventTicket, capacity int) (bool, error) {
+	query := `
+		IF ((SELECT count() AS n FROM event_ticket
+			WHERE event = type::record($event_id) AND ` + activeTicketClause + `
+			GROUP ALL)[0].n ?? 0) < $capacity {
+			CREATE event_ticket SET
+				event = type::record($event_id),
+				holder = type::record($holder_id),
+				status = "held",
+				hold_expires_on = $hold_expires_on,
+				code = $code,
+				created_on = time::now(),
+				updated_on = time::now()
+		}
+	`
+	vars := map[string]interface{}{
+		"event_id":        ticket.EventID,
+		"holder_id":       ticket.HolderID,
+		"hold_expires_on": ticket.HoldExpiresOn,
+		"code":            ticket.Code,
+		"capacity":        capacity,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return false, nil
+	}
+	*ticket = *parseEventTicket(rows[0])
+	return true, nil
+}
+
+// GetTicket returns a ticket by ID, or nil if it doesn't exist
+func (r *EventRepository) GetTicket(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": ticketID}
+
+	return r.queryTicket(ctx, query, vars)
+}
+
+// GetActiveTicket returns a user's ticket that takes a seat at an event, or
+// nil if they have none
+func (r *EventRepository) GetActiveTicket(ctx context.Context, eventID, userID string) (*model.EventTicket, error) {
+	query := `
+		SELECT * FROM event_ticket
+		WHERE event = type::record($event_id) AND holder = type::record($user_id) AND ` + activeTicketClause + `
+		ORDER BY created_on DESC
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"event_id": eventID,
+		"user_id":  userID,
+	}
+
+	return r.queryTicket(ctx, query, vars)
+}
+
+// CountActiveTickets counts the tickets taking a seat at an event
+func (r *EventRepository) CountActiveTickets(ctx context.Context, eventID string) (int, error) {
+	query := `
+		SELECT count() AS total FROM event_ticket
+		WHERE event = type::record($event_id) AND ` + activeTicketClause + `
+		GROUP ALL
+	`
+	vars := map[string]interface{}{"event_id": eventID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "total"), nil
+}
+
+// ClaimTicket turns an unexpired hold into a ticket. Returns nil if the
+// hold expired or the ticket isn't held.
+func (r *EventRepository) ClaimTicket(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = "claimed",
+			hold_expires_on = NONE,
+			claimed_on = time::now(),
+			updated_on = time::now()
+		WHERE status = "held" AND hold_expires_on > time::now()
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"id": ticketID}
+
+	return r.queryTicket(ctx, query, vars)
+}
+
+// ExtendTicketHold keeps an unexpired hold until a later time, such as
+// while the guest pays for it. Returns nil if the hold already lapsed.
+func (r *EventRepository) ExtendTicketHold(ctx context.Context, ticketID string, until time.Time) (*model.EventTicket, error) {
+	query := `
+		UPDATE type::record($id) SET
+			hold_expires_on = $until,
+			updated_on = time::now()
+		WHERE status = "held" AND hold_expires_on > time::now()
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":    ticketID,
+		"until": until,
+	}
+
+	return r.queryTicket(ctx, query, vars)
+}
+
+// VoidTicket gives a held or claimed ticket's seat back
+func (r *EventRepository) VoidTicket(ctx context.Context, ticketID string) error {
+	query := `
+		UPDATE type::record($id) SET
+			status = "void",
+			hold_expires_on = NONE,
+			updated_on = time::now()
+		WHERE status IN ["held", "claimed"]
+	`
+	vars := map[string]interface{}{"id": ticketID}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// TransferTicket moves a claimed ticket between users under a new code, so
+// the previous holder's QR code stops working. Returns nil if the ticket
+// is no longer the sender's to give.
+func (r *EventRepository) TransferTicket(ctx context.Context, ticketID, fromUserID, toUserID, code string) (*model.EventTicket, error) {
+	query := `
+		UPDATE type::record($id) SET
+			holder = type::record($to_user_id),
+			transferred_from = type::record($from_user_id),
+			code = $code,
+			updated_on = time::now()
+		WHERE holder = type::record($from_user_id) AND status = "claimed"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":           ticketID,
+		"from_user_id": fromUserID,
+		"to_user_id":   toUserID,
+		"code":         code,
+	}
+
+	return r.queryTicket(ctx, query, vars)
+}
+
+// CheckInTicket marks a claimed ticket used. Returns nil if it isn't
+// claimed, such as when it was already scanned.
+func (r *EventRepository) CheckInTicket(ctx context.Context, ticketID, hostUserID string) (*model.EventTicket, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = "used",
+			checked_in_on = time::now(),
+			checked_in_by = type::record($host_id),
+			updated_on = time::now()
+		WHERE status = "claimed"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":      ticketID,
+		"host_id": hostUserID,
+	}
+
+	return r.queryTicket(ctx, query, vars)
+}
+
+// queryTicket runs a query returning at most one ticket
+func (r *EventRepository) queryTicket(ctx context.Context, query string, vars map[string]interface{}) (*model.EventTicket, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseEventTicket(rows[0]), nil
+}
+
+func parseEventTicket(data map[string]interface{}) *model.EventTicket {
+	ticket := &model.EventTicket{
+		ID:            convertSurrealID(data["id"]),
+		EventID:       convertSurrealID(data["event"]),
+		HolderID:      convertSurrealID(data["holder"]),
+		Status:        getString(data, "status"),
+		HoldExpiresOn: getTime(data, "hold_expires_on"),
+		ClaimedOn:     getTime(data, "claimed_on"),
+		CheckedInOn:   getTime(data, "checked_in_on"),
+		Code:          getString(data, "code"),
+	}
+	if from := convertSurrealID(data["transferred_from"]); from != "" {
+		ticket.TransferredFrom = &from
+	}
+	if by := convertSurrealID(data["checked_in_by"]); by != "" {
+		ticket.CheckedInBy = &by
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		ticket.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		ticket.UpdatedOn = *t
+	}
+	return ticket
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ExpenseRepository handles shared expenses and their settlements. Both
+// belong to a scope record (an event or an adventure).
+type ExpenseRepository struct {
+	db database.Database
+}
+
+// NewExpenseRepository creates a new expense repository
+func NewExpenseRepository(db database.Database) *ExpenseRepository {
+	return &ExpenseRepository{db: db}
+}
+
+// Create saves an expense
+func (r *ExpenseRepository) Create(ctx context.Context, expense *model.Expense) error {
+	query := `
+		CREATE expense SET
+			scope = type::record($scope_id),
+			paid_by = type::record($paid_by),
+			description = $description,
+			amount = $amount,
+			currency = $currency,
+			created_by = type::record($created_by),
+			created_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"scope_id":    expense.ScopeID,
+		"paid_by":     expense.PaidBy,
+		"description": expense.Description,
+		"amount":      expense.Amount,
+		"currency":    expense.Currency,
+		"created_by":  expense.CreatedBy,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*expense = *parseExpense(rows[0])
+	return nil
+}
+
+// Get returns an expense, or nil if it doesn't exist
+func (r *ExpenseRepository) Get(ctx context.Context, expenseID string) (*model.Expense, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": expenseID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseExpense(rows[0]), nil
+}
+
+// GetByScope returns a scope's expenses, oldest first
+func (r *ExpenseRepository) GetByScope(ctx context.Context, scopeID string) ([]*model.Expense, error) {
+	query := `
+		SELECT * FROM expense
+		WHERE scope = type::record($scope_id)
+		ORDER BY created_on ASC
+	`
+	vars := map[string]interface{}{"scope_id": scopeID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	expenses := make([]*model.Expense, 0, len(rows))
+	for _, row := range rows {
+		expenses = append(expenses, parseExpense(row))
+	}
+	return expenses, nil
+}
+
+// Delete removes an expense
+func (r *ExpenseRepository) Delete(ctx context.Context, expenseID string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": expenseID}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// CreateSettlement records a payment between participants
+func (r *ExpenseRepository) CreateSettlement(ctx context.Context, settlement *model.ExpenseSettlement) error {
+	query := `
+		CREATE expense_settlement SET
+			scope = type::record($scope_id),
+			from_user = type::record($from_user),
+			to_user = type::record($to_user),
+			amount = $amount,
+			currency = $currency,
+			marked_by = type::record($marked_by),
+			settled_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"scope_id":  settlement.ScopeID,
+		"from_user": settlement.FromUser,
+		"to_user":   settlement.ToUser,
+		"amount":    settlement.Amount,
+		"currency":  settlement.Currency,
+		"marked_by": settlement.MarkedBy,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*settlement = *parseExpenseSettlement(rows[0])
+	return nil
+}
+
+// GetSettlements returns a scope's settlements, oldest first
+func (r *ExpenseRepository) GetSettlements(ctx context.Context, scopeID string) ([]*model.ExpenseSettlement, error) {
+	query := `
+		SELECT * FROM expense_settlement
+		WHERE scope = type::record($scope_id)
+		ORDER BY settled_on ASC
+	`
+	vars := map[string]interface{}{"scope_id": scopeID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	settlements := make([]*model.ExpenseSettlement, 0, len(rows))
+	for _, row := range rows {
+		settlements = append(settlements, parseExpenseSettlement(row))
+	}
+	return settlements, nil
+}
+
+func parseExpense(data map[string]interface{}) *model.Expense {
+	expense := &model.Expense{
+		ID:          convertSurrealID(data["id"]),
+		ScopeID:     convertSurrealID(data["scope"]),
+		PaidBy:      convertSurrealID(data["paid_by"]),
+		Description: getString(data, "description"),
+		Amount:      getInt(data, "amount"),
+		Currency:    getString(data, "currency"),
+		CreatedBy:   convertSurrealID(data["created_by"]),
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		expense.CreatedOn = *t
+	}
+	return expense
+}
+
+func parseExpenseSettlement(data map[string]interface{}) *model.ExpenseSettlement {
+	settlement := &model.ExpenseSettlement{
+		ID:       convertSurrealID(data["id"]),
+		ScopeID:  convertSurrealID(data["scope"]),
+		FromUser: convertSurrealID(data["from_user"]),
+		ToUser:   convertSurrealID(data["to_user"]),
+		Amount:   getInt(data, "amount"),
+		Currency: getString(data, "currency"),
+		MarkedBy: convertSurrealID(data["marked_by"]),
+	}
+	if t := getTime(data, "settled_on"); t != nil {
+		settlement.SettledOn = *t
+	}
+	return settlement
+}
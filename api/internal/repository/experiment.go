@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ExperimentRepository handles A/B experiment data access
+type ExperimentRepository struct {
+	db database.Database
+}
+
+// NewExperimentRepository creates a new experiment repository
+func NewExperimentRepository(db database.Database) *ExperimentRepository {
+	return &ExperimentRepository{db: db}
+}
+
+// Create creates a new experiment
+func (r *ExperimentRepository) Create(ctx context.Context, experiment *model.Experiment) error {
+	variants, err := json.Marshal(experiment.Variants)
+	if err != nil {
+		return fmt.Errorf("encoding variants: %w", err)
+	}
+	platforms := make([]string, len(experiment.Platforms))
+	for i, p := range experiment.Platforms {
+		platforms[i] = string(p)
+	}
+
+	query := `
+		CREATE experiment CONTENT {
+			key: $key,
+			description: $description OR NONE,
+			status: $status,
+			salt: $salt,
+			variants: $variants,
+			platforms: $platforms,
+			created_by: type::record($created_by),
+			created_on: time::now(),
+			updated_on: time::now()
+		}
+	`
+	vars := map[string]interface{}{
+		"key":         experiment.Key,
+		"description": experiment.Description,
+		"status":      string(experiment.Status),
+		"salt":        experiment.Salt,
+		"variants":    string(variants),
+		"platforms":   platforms,
+		"created_by":  experiment.CreatedBy,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: experiment already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	experiment.ID = created.ID
+	experiment.CreatedOn = created.CreatedOn
+	experiment.UpdatedOn = created.UpdatedOn
+	return nil
+}
+
+// GetByKey retrieves an experiment by key, or nil if there is none
+func (r *ExperimentRepository) GetByKey(ctx context.Context, key string) (*model.Experiment, error) {
+	query := `SELECT * FROM experiment WHERE key = $key LIMIT 1`
+
+	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"key": key})
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseExperiment(data)
+}
+
+// List returns experiments, newest first, optionally only those with status
+func (r *ExperimentRepository) List(ctx context.Context, status model.ExperimentStatus) ([]*model.Experiment, error) {
+	query := `SELECT * FROM experiment`
+	vars := map[string]interface{}{}
+	if status != "" {
+		query += ` WHERE status = $status`
+		vars["status"] = string(status)
+	}
+	query += ` ORDER BY created_on DESC`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	experiments := make([]*model.Experiment, 0, len(rows))
+	for _, row := range rows {
+		experiment, err := parseExperiment(row)
+		if err != nil {
+			return nil, err
+		}
+		experiments = append(experiments, experiment)
+	}
+	return experiments, nil
+}
+
+// Update updates an experiment's description and status by key. Moving to
+// running stamps started_on and moving to stopped stamps stopped_on.
+// Returns nil if there is no such experiment.
+func (r *ExperimentRepository) Update(ctx context.Context, key string, updates map[string]interface{}) (*model.Experiment, error) {
+	sets := []string{"updated_on = time::now()"}
+	vars := map[string]interface{}{"key": key}
+
+	if description, ok := updates["description"]; ok {
+		sets = append(sets, "description = $description OR NONE")
+		vars["description"] = description
+	}
+	if status, ok := updates["status"]; ok {
+		sets = append(sets, "status = $status")
+		vars["status"] = status
+		switch model.ExperimentStatus(fmt.Sprint(status)) {
+		case model.ExperimentRunning:
+			sets = append(sets, "started_on = started_on OR time::now()")
+		case model.ExperimentStopped:
+			sets = append(sets, "stopped_on = time::now()")
+		}
+	}
+
+	query := fmt.Sprintf("UPDATE experiment SET %s WHERE key = $key RETURN AFTER", strings.Join(sets, ", "))
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseExperiment(data)
+}
+
+func parseExperiment(data map[string]interface{}) (*model.Experiment, error) {
+	experiment := &model.Experiment{
+		ID:          convertSurrealID(data["id"]),
+		Key:         getString(data, "key"),
+		Description: getString(data, "description"),
+		Status:      model.ExperimentStatus(getString(data, "status")),
+		Salt:        getString(data, "salt"),
+		CreatedBy:   convertSurrealID(data["created_by"]),
+		StartedOn:   getTime(data, "started_on"),
+		StoppedOn:   getTime(data, "stopped_on"),
+	}
+	if err := json.Unmarshal([]byte(getString(data, "variants")), &experiment.Variants); err != nil {
+		return nil, fmt.Errorf("decoding variants of experiment %s: %w", experiment.Key, err)
+	}
+	for _, p := range getStringSlice(data, "platforms") {
+		experiment.Platforms = append(experiment.Platforms, model.DevicePlatform(p))
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		experiment.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		experiment.UpdatedOn = *t
+	}
+	return experiment, nil
+}
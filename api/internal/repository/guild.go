@@ -31,10 +31,12 @@ func (r *GuildRepository) Create(ctx context.Context, guild *model.Guild) error
 	query := `
 		CREATE guild CONTENT {
 			name: $name,
+			slug: IF $slug IS NOT NULL THEN $slug ELSE NONE END,
 			description: IF $description IS NOT NULL THEN $description ELSE NONE END,
 			icon: IF $icon IS NOT NULL THEN $icon ELSE NONE END,
 			color: IF $color IS NOT NULL THEN $color ELSE NONE END,
 			visibility: $visibility,
+			version: 1,
 			created_on: time::now(),
 			updated_on: time::now()
 		}
@@ -42,6 +44,7 @@ func (r *GuildRepository) Create(ctx context.Context, guild *model.Guild) error
 
 	vars := map[string]interface{}{
 		"name":        guild.Name,
+		"slug":        nilIfEmpty(guild.Slug),
 		"description": nilIfEmpty(guild.Description),
 		"icon":        nilIfEmpty(guild.Icon),
 		"color":       nilIfEmpty(guild.Color),
@@ -51,7 +54,7 @@ func (r *GuildRepository) Create(ctx context.Context, guild *model.Guild) error
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
 		if isUniqueConstraintError(err) {
-			return fmt.Errorf("%w: guild name already exists", database.ErrDuplicate)
+			return fmt.Errorf("%w: guild slug already exists", database.ErrDuplicate)
 		}
 		return err
 	}
@@ -65,6 +68,7 @@ func (r *GuildRepository) Create(ctx context.Context, guild *model.Guild) error
 	guild.CreatedOn = created.CreatedOn
 	guild.UpdatedOn = created.UpdatedOn
 	guild.Visibility = visibility
+	guild.Version = 1
 	return nil
 }
 
@@ -91,8 +95,10 @@ func (r *GuildRepository) GetByID(ctx context.Context, id string) (*model.Guild,
 	return guild, nil
 }
 
-// Update updates a guild
-func (r *GuildRepository) Update(ctx context.Context, guild *model.Guild) error {
+// Update updates a guild and bumps its version. When version is given the
+// update only applies if the guild is still at that version; returns nil if
+// it isn't or the guild doesn't exist.
+func (r *GuildRepository) Update(ctx context.Context, guild *model.Guild, version *int) (*model.Guild, error) {
 	query := `
 		UPDATE type::record($id) SET
 			name = $name,
@@ -100,18 +106,122 @@ func (r *GuildRepository) Update(ctx context.Context, guild *model.Guild) error
 			icon = IF $icon IS NOT NULL THEN $icon ELSE NONE END,
 			color = IF $color IS NOT NULL THEN $color ELSE NONE END,
 			visibility = $visibility,
+			embed_enabled = $embed_enabled,
+			version += 1,
 			updated_on = time::now()
 	`
 	vars := map[string]interface{}{
-		"id":          guild.ID,
-		"name":        guild.Name,
-		"description": nilIfEmpty(guild.Description),
-		"icon":        nilIfEmpty(guild.Icon),
-		"color":       nilIfEmpty(guild.Color),
-		"visibility":  guild.Visibility,
+		"id":            guild.ID,
+		"name":          guild.Name,
+		"description":   nilIfEmpty(guild.Description),
+		"icon":          nilIfEmpty(guild.Icon),
+		"color":         nilIfEmpty(guild.Color),
+		"visibility":    guild.Visibility,
+		"embed_enabled": guild.EmbedEnabled,
+	}
+	if version != nil {
+		query += ` WHERE version = $version`
+		vars["version"] = *version
 	}
+	query += ` RETURN AFTER`
 
-	return r.db.Execute(ctx, query, vars)
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseGuildResult(result)
+}
+
+// GetBySlug retrieves a guild by its current slug
+func (r *GuildRepository) GetBySlug(ctx context.Context, slug string) (*model.Guild, error) {
+	query := `SELECT * FROM guild WHERE slug = $slug LIMIT 1`
+	vars := map[string]interface{}{"slug": slug}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	guild, err := parseGuildResult(result)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return guild, nil
+}
+
+// ChangeSlug sets the guild's slug and, when it had one, records the previous
+// slug in guild_slug_history so it keeps resolving to the guild. Any existing
+// redirect for the new slug is dropped. Returns ErrDuplicate if another guild
+// holds the slug.
+func (r *GuildRepository) ChangeSlug(ctx context.Context, guildID, previous, slug string) error {
+	batch := database.NewAtomicBatch()
+
+	batch.Add(`DELETE guild_slug_history WHERE slug = $slug`, map[string]interface{}{
+		"slug": slug,
+	})
+
+	if previous != "" && previous != slug {
+		batch.Add(`DELETE guild_slug_history WHERE slug = $old_slug`, map[string]interface{}{
+			"old_slug": previous,
+		})
+		batch.Add(`
+			CREATE guild_slug_history CONTENT {
+				slug: $old_slug,
+				guild_id: type::record($guild_id),
+				released_on: time::now()
+			}
+		`, map[string]interface{}{
+			"old_slug": previous,
+			"guild_id": guildID,
+		})
+	}
+
+	batch.Add(`UPDATE type::record($id) SET slug = $slug`, map[string]interface{}{
+		"id":   guildID,
+		"slug": slug,
+	})
+
+	if err := batch.Execute(ctx, r.db); err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: guild slug already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+	return nil
+}
+
+// GetSlugRedirect returns the redirect for a released slug, or nil if none
+func (r *GuildRepository) GetSlugRedirect(ctx context.Context, slug string) (*model.GuildSlugRedirect, error) {
+	query := `SELECT * FROM guild_slug_history WHERE slug = $slug LIMIT 1`
+	vars := map[string]interface{}{"slug": slug}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	redirect := &model.GuildSlugRedirect{
+		Slug:    getString(rows[0], "slug"),
+		GuildID: convertGuildID(rows[0]["guild_id"]),
+	}
+	if t := getTime(rows[0], "released_on"); t != nil {
+		redirect.ReleasedOn = *t
+	}
+	return redirect, nil
 }
 
 // Delete deletes a guild
@@ -200,15 +310,34 @@ func (r *GuildRepository) AddMember(ctx context.Context, memberID, guildID strin
 	return r.db.Execute(ctx, query, vars)
 }
 
-// RemoveMember removes a member from a guild
-func (r *GuildRepository) RemoveMember(ctx context.Context, memberID, guildID string) error {
-	query := `DELETE responsible_for WHERE in = type::record($member_id) AND out = type::record($guild_id)`
+// RemoveMember removes a member from a guild and returns the membership it
+// removed, or nil if there was none
+func (r *GuildRepository) RemoveMember(ctx context.Context, memberID, guildID string) (*model.GuildMembership, error) {
+	query := `DELETE responsible_for WHERE in = type::record($member_id) AND out = type::record($guild_id) RETURN BEFORE`
 	vars := map[string]interface{}{
 		"member_id": memberID,
 		"guild_id":  guildID,
 	}
 
-	return r.db.Execute(ctx, query, vars)
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	membership := &model.GuildMembership{
+		MemberID:        memberID,
+		GuildID:         guildID,
+		Role:            model.GuildRole(getString(rows[0], "role")),
+		PendingApproval: getBool(rows[0], "pending_approval"),
+	}
+	if membership.Role == "" {
+		membership.Role = model.GuildRoleMember
+	}
+	return membership, nil
 }
 
 // IsMember checks if a user is a member of a guild
@@ -344,6 +473,37 @@ func (r *GuildRepository) GetMembers(ctx context.Context, guildID string) ([]*mo
 	return parseMembersFromRelationResult(results)
 }
 
+// GetMembersPage retrieves a page of a guild's members, in the order they
+// joined
+func (r *GuildRepository) GetMembersPage(ctx context.Context, guildID string, page model.PageRequest) (*model.Page[*model.Member], error) {
+	query := `SELECT id, in.* AS member FROM responsible_for WHERE out = type::record($guild_id)`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	// Pages follow the membership edge's ID, which is all the order there is
+	condition, tail, sortColumns, err := database.ListPage(model.ListQuery{}, nil, nil, page, vars)
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		query += ` AND ` + condition
+	}
+	query += ` ` + tail
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return readPage(results, model.ListQuery{}, sortColumns, page, func(item interface{}) (*model.Member, error) {
+		data, _ := item.(map[string]interface{})
+		memberData, ok := data["member"].(map[string]interface{})
+		if !ok {
+			return nil, errors.New("unexpected result format")
+		}
+		return parseMemberFromData(memberData)
+	}), nil
+}
+
 // GetMemberRole retrieves a user's role in a guild
 func (r *GuildRepository) GetMemberRole(ctx context.Context, userID, guildID string) (model.GuildRole, error) {
 	// First get the member for this user
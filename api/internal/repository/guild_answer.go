@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildAnswerRepository handles member answers to guild questions.
+// These are kept apart from the global answer table so they never
+// feed compatibility scoring or discovery.
+type GuildAnswerRepository struct {
+	db database.Database
+}
+
+// NewGuildAnswerRepository creates a new guild answer repository
+func NewGuildAnswerRepository(db database.Database) *GuildAnswerRepository {
+	return &GuildAnswerRepository{db: db}
+}
+
+// Get retrieves a user's answer to a guild question
+func (r *GuildAnswerRepository) Get(ctx context.Context, userID, questionID string) (*model.GuildAnswer, error) {
+	query := `SELECT * FROM guild_answer WHERE user = type::record($user_id) AND question = type::record($question_id) LIMIT 1`
+	vars := map[string]interface{}{
+		"user_id":     userID,
+		"question_id": questionID,
+	}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseGuildAnswer(data), nil
+}
+
+// GetByGuild retrieves all answers to a guild's questions,
+// optionally limited to a single user
+func (r *GuildAnswerRepository) GetByGuild(ctx context.Context, guildID, userID string) ([]*model.GuildAnswer, error) {
+	query := `SELECT * FROM guild_answer WHERE guild = type::record($guild_id)`
+	vars := map[string]interface{}{"guild_id": guildID}
+	if userID != "" {
+		query += ` AND user = type::record($user_id)`
+		vars["user_id"] = userID
+	}
+	query += ` ORDER BY user, created_on`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	answers := make([]*model.GuildAnswer, 0)
+	for _, data := range flattenResults(results) {
+		answers = append(answers, parseGuildAnswer(data))
+	}
+	return answers, nil
+}
+
+// Create creates a new guild answer
+func (r *GuildAnswerRepository) Create(ctx context.Context, answer *model.GuildAnswer) error {
+	query := `
+		CREATE guild_answer CONTENT {
+			guild: type::record($guild_id),
+			question: type::record($question_id),
+			user: type::record($user_id),
+			selected_option: $selected_option,
+			created_on: time::now()
+		}
+	`
+	vars := map[string]interface{}{
+		"guild_id":        answer.GuildID,
+		"question_id":     answer.QuestionID,
+		"user_id":         answer.UserID,
+		"selected_option": answer.SelectedOption,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: guild answer already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	answer.ID = created.ID
+	answer.CreatedOn = created.CreatedOn
+	answer.UpdatedOn = created.UpdatedOn
+	return nil
+}
+
+// UpdateSelection changes the selected option of an existing guild answer
+func (r *GuildAnswerRepository) UpdateSelection(ctx context.Context, userID, questionID, selectedOption string) (*model.GuildAnswer, error) {
+	query := `
+		UPDATE guild_answer SET selected_option = $selected_option
+		WHERE user = type::record($user_id) AND question = type::record($question_id)
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"user_id":         userID,
+		"question_id":     questionID,
+		"selected_option": selectedOption,
+	}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseGuildAnswer(data), nil
+}
+
+// Delete deletes a user's answer to a guild question
+func (r *GuildAnswerRepository) Delete(ctx context.Context, userID, questionID string) error {
+	query := `DELETE guild_answer WHERE user = type::record($user_id) AND question = type::record($question_id)`
+	vars := map[string]interface{}{
+		"user_id":     userID,
+		"question_id": questionID,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// CountForQuestion counts the answers to a guild question
+func (r *GuildAnswerRepository) CountForQuestion(ctx context.Context, questionID string) (int, error) {
+	query := `SELECT count() AS count FROM guild_answer WHERE question = type::record($question_id) GROUP ALL`
+
+	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"question_id": questionID})
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return extractCount(result), nil
+}
+
+func parseGuildAnswer(data map[string]interface{}) *model.GuildAnswer {
+	answer := &model.GuildAnswer{
+		ID:             convertSurrealID(data["id"]),
+		GuildID:        convertSurrealID(data["guild"]),
+		QuestionID:     convertSurrealID(data["question"]),
+		UserID:         convertSurrealID(data["user"]),
+		SelectedOption: getString(data, "selected_option"),
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		answer.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		answer.UpdatedOn = *t
+	}
+	return answer
+}
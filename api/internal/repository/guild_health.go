@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildHealthRepository handles guild health metrics and daily snapshots
+type GuildHealthRepository struct {
+	db database.Database
+}
+
+// NewGuildHealthRepository creates a new guild health repository
+func NewGuildHealthRepository(db database.Database) *GuildHealthRepository {
+	return &GuildHealthRepository{db: db}
+}
+
+// ListGuildIDs returns the ID of every guild
+func (r *GuildHealthRepository) ListGuildIDs(ctx context.Context) ([]string, error) {
+	results, err := r.db.Query(ctx, `SELECT id FROM guild`, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, convertSurrealID(row["id"]))
+	}
+	return ids, nil
+}
+
+// guildHealthEventFilter matches a guild's live events starting in the window
+const guildHealthEventFilter = `guild_id = type::record($guild_id)
+	AND deleted_at = NONE
+	AND status IN ["published", "completed"]
+	AND start_time >= $since AND start_time < $until`
+
+// guildHealthPoolFilter matches a guild's active matching pools
+const guildHealthPoolFilter = `guild_id = type::record($guild_id) AND active = true AND deleted_at = NONE`
+
+// GetMetrics counts a guild's approved members, members who joined since
+// joinedSince, events starting in [since, until) with their approved RSVPs,
+// and its active matching pools with their distinct participants
+func (r *GuildHealthRepository) GetMetrics(ctx context.Context, guildID string, since, until, joinedSince time.Time) (*model.GuildHealthMetrics, error) {
+	query := `
+		SELECT
+			array::len((SELECT id FROM responsible_for
+				WHERE out = $parent.id AND pending_approval = false)) AS members,
+			array::len((SELECT id FROM responsible_for
+				WHERE out = $parent.id AND pending_approval = false AND created_on >= $joined_since)) AS joined,
+			array::len((SELECT id FROM event WHERE ` + guildHealthEventFilter + `)) AS events,
+			array::len((SELECT id FROM event_rsvp WHERE status = "approved"
+				AND event_id IN (SELECT VALUE id FROM event WHERE ` + guildHealthEventFilter + `))) AS approved_rsvps,
+			array::len((SELECT id FROM matching_pool WHERE ` + guildHealthPoolFilter + `)) AS active_pools,
+			array::len(array::distinct((SELECT VALUE user_id FROM pool_member WHERE active = true
+				AND pool_id IN (SELECT VALUE id FROM matching_pool WHERE ` + guildHealthPoolFilter + `)))) AS pool_participants
+		FROM type::record($guild_id)
+	`
+	vars := map[string]interface{}{
+		"guild_id":     guildID,
+		"since":        since,
+		"until":        until,
+		"joined_since": joinedSince,
+	}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return &model.GuildHealthMetrics{}, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return &model.GuildHealthMetrics{
+		Members:          getInt(data, "members"),
+		Joined:           getInt(data, "joined"),
+		Events:           getInt(data, "events"),
+		ApprovedRSVPs:    getInt(data, "approved_rsvps"),
+		ActivePools:      getInt(data, "active_pools"),
+		PoolParticipants: getInt(data, "pool_participants"),
+	}, nil
+}
+
+// GetOrganizers returns the user IDs of a guild's admins and moderators
+func (r *GuildHealthRepository) GetOrganizers(ctx context.Context, guildID string) ([]string, error) {
+	query := `
+		SELECT in.user AS user_id FROM responsible_for
+		WHERE out = type::record($guild_id)
+			AND role IN ["admin", "moderator"]
+			AND pending_approval = false
+	`
+
+	results, err := r.db.Query(ctx, query, map[string]interface{}{"guild_id": guildID})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	userIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if userID := convertSurrealID(row["user_id"]); userID != "" {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs, nil
+}
+
+// Save writes a guild's snapshot for its day, replacing one computed earlier
+// that day. When the snapshot was alerted on earlier, that is kept.
+func (r *GuildHealthRepository) Save(ctx context.Context, health *model.GuildHealth) error {
+	vars := map[string]interface{}{
+		"guild_id":           health.GuildID,
+		"day":                health.Day,
+		"score":              health.Score,
+		"at_risk":            health.AtRisk,
+		"members":            health.Metrics.Members,
+		"joined":             health.Metrics.Joined,
+		"events":             health.Metrics.Events,
+		"approved_rsvps":     health.Metrics.ApprovedRSVPs,
+		"active_pools":       health.Metrics.ActivePools,
+		"pool_participants":  health.Metrics.PoolParticipants,
+		"event_frequency":    health.Components.EventFrequency,
+		"rsvp_rate":          health.Components.RSVPRate,
+		"pool_participation": health.Components.PoolParticipation,
+		"computed_on":        health.ComputedOn,
+	}
+	// Set optional fields explicitly: $value OR NONE would drop zero scores
+	optional := setClause("previous_score", intOrNil(health.PreviousScore), vars) + ",\n" +
+		setClause("churn_rate", floatOrNil(health.ChurnRate), vars) + ",\n" +
+		setClause("member_churn", floatOrNil(health.Components.MemberChurn), vars)
+
+	fields := `
+				score = $score,
+				at_risk = $at_risk,
+				members = $members,
+				joined = $joined,
+				events = $events,
+				approved_rsvps = $approved_rsvps,
+				active_pools = $active_pools,
+				pool_participants = $pool_participants,
+				event_frequency = $event_frequency,
+				rsvp_rate = $rsvp_rate,
+				pool_participation = $pool_participation,
+				computed_on = $computed_on,
+				` + optional
+
+	// SurrealDB 3.0 UPSERT doesn't work with WHERE clause properly
+	// Use IF/ELSE pattern instead
+	query := `
+		LET $existing = SELECT * FROM guild_health WHERE guild_id = type::record($guild_id) AND day = $day;
+		IF array::len($existing) = 0 {
+			CREATE guild_health SET
+				guild_id = type::record($guild_id),
+				day = $day,` + fields + `
+		} ELSE {
+			UPDATE guild_health SET` + fields + `
+			WHERE guild_id = type::record($guild_id) AND day = $day
+		}
+	`
+
+	_, err := r.db.Query(ctx, query, vars)
+	return err
+}
+
+// GetOnOrBefore returns a guild's latest snapshot for day or earlier, or nil
+// if there is none. Days are YYYY-MM-DD, so they sort as dates.
+func (r *GuildHealthRepository) GetOnOrBefore(ctx context.Context, guildID, day string) (*model.GuildHealth, error) {
+	query := `
+		SELECT * FROM guild_health
+		WHERE guild_id = type::record($guild_id) AND day <= $day
+		ORDER BY day DESC
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"guild_id": guildID,
+		"day":      day,
+	}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return parseGuildHealth(data), nil
+}
+
+// ListRecent returns a guild's latest snapshots, newest first
+func (r *GuildHealthRepository) ListRecent(ctx context.Context, guildID string, limit int) ([]*model.GuildHealth, error) {
+	query := `
+		SELECT * FROM guild_health
+		WHERE guild_id = type::record($guild_id)
+		ORDER BY day DESC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"guild_id": guildID,
+		"limit":    limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	snapshots := make([]*model.GuildHealth, 0, len(rows))
+	for _, row := range rows {
+		snapshots = append(snapshots, parseGuildHealth(row))
+	}
+	return snapshots, nil
+}
+
+// LastAlertedOn returns when organizers of a guild were last alerted about
+// its health, or nil if they never were
+func (r *GuildHealthRepository) LastAlertedOn(ctx context.Context, guildID string) (*time.Time, error) {
+	query := `
+		SELECT alerted_on FROM guild_health
+		WHERE guild_id = type::record($guild_id) AND alerted_on != NONE
+		ORDER BY alerted_on DESC
+		LIMIT 1
+	`
+
+	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"guild_id": guildID})
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+	return getTime(data, "alerted_on"), nil
+}
+
+// MarkAlerted records that organizers were alerted about a guild's snapshot
+// for day
+func (r *GuildHealthRepository) MarkAlerted(ctx context.Context, guildID, day string, alertedOn time.Time) error {
+	query := `UPDATE guild_health SET alerted_on = $alerted_on WHERE guild_id = type::record($guild_id) AND day = $day`
+	vars := map[string]interface{}{
+		"guild_id":   guildID,
+		"day":        day,
+		"alerted_on": alertedOn,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+func intOrNil(v *int) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+func floatOrNil(v *float64) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+func getFloatPtr(m map[string]interface{}, key string) *float64 {
+	if _, ok := m[key]; !ok || m[key] == nil {
+		return nil
+	}
+	v := getFloat(m, key)
+	return &v
+}
+
+func parseGuildHealth(data map[string]interface{}) *model.GuildHealth {
+	health := &model.GuildHealth{
+		ID:      convertSurrealID(data["id"]),
+		GuildID: convertSurrealID(data["guild_id"]),
+		Day:     getString(data, "day"),
+		Score:   getInt(data, "score"),
+		AtRisk:  getBool(data, "at_risk"),
+		Metrics: model.GuildHealthMetrics{
+			Members:          getInt(data, "members"),
+			Joined:           getInt(data, "joined"),
+			Events:           getInt(data, "events"),
+			ApprovedRSVPs:    getInt(data, "approved_rsvps"),
+			ActivePools:      getInt(data, "active_pools"),
+			PoolParticipants: getInt(data, "pool_participants"),
+		},
+		ChurnRate: getFloatPtr(data, "churn_rate"),
+		Components: model.GuildHealthComponents{
+			EventFrequency:    getFloat(data, "event_frequency"),
+			RSVPRate:          getFloat(data, "rsvp_rate"),
+			MemberChurn:       getFloatPtr(data, "member_churn"),
+			PoolParticipation: getFloat(data, "pool_participation"),
+		},
+		AlertedOn: getTime(data, "alerted_on"),
+	}
+	if _, ok := data["previous_score"]; ok && data["previous_score"] != nil {
+		previous := getInt(data, "previous_score")
+		health.PreviousScore = &previous
+	}
+	if t := getTime(data, "computed_on"); t != nil {
+		health.ComputedOn = *t
+	}
+	return health
+}
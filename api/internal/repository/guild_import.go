@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildImportRepository handles Meetup and Discord guild import records
+type GuildImportRepository struct {
+	db database.Database
+}
+
+// NewGuildImportRepository creates a new guild import repository
+func NewGuildImportRepository(db database.Database) *GuildImportRepository {
+	return &GuildImportRepository{db: db}
+}
+
+// Create stores a previewed import
+func (r *GuildImportRepository) Create(ctx context.Context, imp *model.GuildImport) error {
+	query := `
+		CREATE guild_import SET
+			source = $source,
+			guild = type::record($guild_id),
+			guild_slug = $guild_slug,
+			status = "previewed",
+			requested_by = type::record($requested_by),
+			members = $members,
+			events = $events,
+			created_on = time::now(),
+			expires_on = $expires_on
+	`
+	vars := map[string]interface{}{
+		"source":       string(imp.Source),
+		"guild_id":     imp.GuildID,
+		"guild_slug":   imp.GuildSlug,
+		"requested_by": imp.RequestedBy,
+		"members":      guildImportMembersToObjects(imp.Members),
+		"events":       guildImportEventsToObjects(imp.Events),
+		"expires_on":   imp.ExpiresOn,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	created := parseGuildImport(rows[0])
+	imp.ID = created.ID
+	imp.Status = created.Status
+	imp.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// Get returns an import by ID, or nil if it doesn't exist
+func (r *GuildImportRepository) Get(ctx context.Context, id string) (*model.GuildImport, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseGuildImport(rows[0]), nil
+}
+
+// List returns the most recent imports, newest first. Members and events
+// are left out; fetch an import to see them.
+func (r *GuildImportRepository) List(ctx context.Context, limit int) ([]*model.GuildImport, error) {
+	query := `SELECT * FROM guild_import ORDER BY created_on DESC LIMIT $limit`
+	vars := map[string]interface{}{"limit": limit}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	imports := make([]*model.GuildImport, 0, len(rows))
+	for _, row := range rows {
+		imp := parseGuildImport(row)
+		imp.Members = nil
+		imp.Events = nil
+		imports = append(imports, imp)
+	}
+	return imports, nil
+}
+
+// Claim marks a previewed import committed. Returns false if it was
+// already committed.
+func (r *GuildImportRepository) Claim(ctx context.Context, id string) (bool, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = "committed",
+			committed_on = time::now()
+		WHERE status = "previewed"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// SaveCommit records what committing an import did
+func (r *GuildImportRepository) SaveCommit(ctx context.Context, imp *model.GuildImport) error {
+	vars := map[string]interface{}{
+		"id":      imp.ID,
+		"members": guildImportMembersToObjects(imp.Members),
+		"events":  guildImportEventsToObjects(imp.Events),
+	}
+	userImport := "user_import = NONE"
+	if imp.UserImportID != "" {
+		userImport = "user_import = type::record($user_import)"
+		vars["user_import"] = imp.UserImportID
+	}
+	query := `
+		UPDATE type::record($id) SET
+			members = $members,
+			events = $events,
+			` + userImport + `
+	`
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+func guildImportMembersToObjects(members []model.GuildImportMember) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0, len(members))
+	for _, m := range members {
+		objects = append(objects, map[string]interface{}{
+			"external_id": m.ExternalID,
+			"name":        m.Name,
+			"email":       m.Email,
+			"action":      string(m.Action),
+			"user_id":     m.UserID,
+			"note":        m.Note,
+		})
+	}
+	return objects
+}
+
+func guildImportEventsToObjects(events []model.GuildImportEvent) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0, len(events))
+	for _, e := range events {
+		object := map[string]interface{}{
+			"external_id": e.ExternalID,
+			"title":       e.Title,
+			"description": e.Description,
+			"start_time":  e.StartTime,
+			"action":      string(e.Action),
+			"event_id":    e.EventID,
+			"note":        e.Note,
+		}
+		if e.EndTime != nil {
+			object["end_time"] = *e.EndTime
+		}
+		if loc := e.Location; loc != nil {
+			location := map[string]interface{}{
+				"name":       loc.Name,
+				"city":       loc.City,
+				"lat":        loc.Lat,
+				"lng":        loc.Lng,
+				"is_virtual": loc.IsVirtual,
+			}
+			if loc.Address != nil {
+				location["address"] = *loc.Address
+			}
+			object["location"] = location
+		}
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+func parseGuildImport(data map[string]interface{}) *model.GuildImport {
+	imp := &model.GuildImport{
+		ID:           convertSurrealID(data["id"]),
+		Source:       model.GuildImportSource(getString(data, "source")),
+		GuildID:      convertSurrealID(data["guild"]),
+		GuildSlug:    getString(data, "guild_slug"),
+		Status:       model.GuildImportStatus(getString(data, "status")),
+		RequestedBy:  convertSurrealID(data["requested_by"]),
+		UserImportID: convertSurrealID(data["user_import"]),
+		CommittedOn:  getTime(data, "committed_on"),
+	}
+	if members, ok := data["members"].([]interface{}); ok {
+		for _, item := range members {
+			if m, ok := item.(map[string]interface{}); ok {
+				imp.Members = append(imp.Members, model.GuildImportMember{
+					ExternalID: getString(m, "external_id"),
+					Name:       getString(m, "name"),
+					Email:      getString(m, "email"),
+					Action:     model.GuildImportAction(getString(m, "action")),
+					UserID:     getString(m, "user_id"),
+					Note:       getString(m, "note"),
+				})
+			}
+		}
+	}
+	if events, ok := data["events"].([]interface{}); ok {
+		for _, item := range events {
+			if e, ok := item.(map[string]interface{}); ok {
+				imp.Events = append(imp.Events, parseGuildImportEvent(e))
+			}
+		}
+	}
+	imp.Summary = imp.Summarize()
+	if t := getTime(data, "created_on"); t != nil {
+		imp.CreatedOn = *t
+	}
+	if t := getTime(data, "expires_on"); t != nil {
+		imp.ExpiresOn = *t
+	}
+	return imp
+}
+
+func parseGuildImportEvent(data map[string]interface{}) model.GuildImportEvent {
+	event := model.GuildImportEvent{
+		ExternalID:  getString(data, "external_id"),
+		Title:       getString(data, "title"),
+		Description: getString(data, "description"),
+		EndTime:     getTime(data, "end_time"),
+		Action:      model.GuildImportAction(getString(data, "action")),
+		EventID:     getString(data, "event_id"),
+		Note:        getString(data, "note"),
+	}
+	if t := getTime(data, "start_time"); t != nil {
+		event.StartTime = *t
+	}
+	if loc, ok := data["location"].(map[string]interface{}); ok {
+		event.Location = &model.EventLocation{
+			Name:      getString(loc, "name"),
+			Address:   getStringPtr(loc, "address"),
+			City:      getString(loc, "city"),
+			Lat:       getFloat(loc, "lat"),
+			Lng:       getFloat(loc, "lng"),
+			IsVirtual: getBool(loc, "is_virtual"),
+		}
+	}
+	return event
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildInviteRepository handles guild invite data access
+type GuildInviteRepository struct {
+	db database.Database
+}
+
+// NewGuildInviteRepository creates a new guild invite repository
+func NewGuildInviteRepository(db database.Database) *GuildInviteRepository {
+	return &GuildInviteRepository{db: db}
+}
+
+// Create stores a guild invite
+func (r *GuildInviteRepository) Create(ctx context.Context, invite *model.GuildInvite) error {
+	// Build query dynamically to avoid NULL vs NONE issues for optional fields
+	setClause := `guild = type::record($guild_id), code = $code, created_by = type::record($created_by), use_count = 0, expires_on = $expires_on, created_on = time::now()`
+	vars := map[string]interface{}{
+		"guild_id":   invite.GuildID,
+		"code":       invite.Code,
+		"created_by": invite.CreatedBy,
+		"expires_on": invite.ExpiresOn,
+	}
+	if invite.Email != nil {
+		setClause += ", email = $email"
+		vars["email"] = *invite.Email
+	}
+	if invite.MaxUses != nil {
+		setClause += ", max_uses = $max_uses"
+		vars["max_uses"] = *invite.MaxUses
+	}
+
+	result, err := r.db.Query(ctx, "CREATE guild_invite SET "+setClause, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: guild invite code already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	invite.ID = created.ID
+	invite.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// GetByID retrieves a guild invite by ID
+func (r *GuildInviteRepository) GetByID(ctx context.Context, id string) (*model.GuildInvite, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseGuildInvite(rows[0]), nil
+}
+
+// GetByCode retrieves a guild invite by its code
+func (r *GuildInviteRepository) GetByCode(ctx context.Context, code string) (*model.GuildInvite, error) {
+	query := `SELECT * FROM guild_invite WHERE code = $code LIMIT 1`
+	vars := map[string]interface{}{"code": code}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseGuildInvite(rows[0]), nil
+}
+
+// GetByGuild retrieves a guild's invites, newest first
+func (r *GuildInviteRepository) GetByGuild(ctx context.Context, guildID string) ([]*model.GuildInvite, error) {
+	query := `SELECT * FROM guild_invite WHERE guild = type::record($guild_id) ORDER BY created_on DESC`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	invites := make([]*model.GuildInvite, 0, len(rows))
+	for _, row := range rows {
+		invites = append(invites, parseGuildInvite(row))
+	}
+	return invites, nil
+}
+
+// CountActive counts a guild's invites that are unexpired at now and have
+// uses left
+func (r *GuildInviteRepository) CountActive(ctx context.Context, guildID string, now time.Time) (int, error) {
+	query := `
+		SELECT count() AS count FROM guild_invite
+		WHERE guild = type::record($guild_id)
+		AND expires_on > $now
+		AND (max_uses = NONE OR use_count < max_uses)
+		GROUP ALL
+	`
+	vars := map[string]interface{}{"guild_id": guildID, "now": now}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "count"), nil
+}
+
+// ClaimUse counts one acceptance of the invite, unless it has already
+// reached max_uses. Returns false when no uses are left.
+func (r *GuildInviteRepository) ClaimUse(ctx context.Context, id string) (bool, error) {
+	query := `
+		UPDATE type::record($id) SET use_count += 1
+		WHERE max_uses = NONE OR use_count < max_uses
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// ReleaseUse gives back a use claimed by ClaimUse when joining the guild
+// failed
+func (r *GuildInviteRepository) ReleaseUse(ctx context.Context, id string) error {
+	query := `UPDATE type::record($id) SET use_count -= 1 WHERE use_count > 0`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Delete removes a guild invite, revoking it
+func (r *GuildInviteRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+func parseGuildInvite(data map[string]interface{}) *model.GuildInvite {
+	invite := &model.GuildInvite{
+		ID:        convertSurrealID(data["id"]),
+		GuildID:   convertSurrealID(data["guild"]),
+		Code:      getString(data, "code"),
+		CreatedBy: convertSurrealID(data["created_by"]),
+		UseCount:  getInt(data, "use_count"),
+	}
+	if email := getString(data, "email"); email != "" {
+		invite.Email = &email
+	}
+	if _, ok := data["max_uses"]; ok && data["max_uses"] != nil {
+		maxUses := getInt(data, "max_uses")
+		invite.MaxUses = &maxUses
+	}
+	if t := getTime(data, "expires_on"); t != nil {
+		invite.ExpiresOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		invite.CreatedOn = *t
+	}
+	return invite
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildPermissionRepository handles guild custom roles and who holds them
+type GuildPermissionRepository struct {
+	db database.Database
+}
+
+// NewGuildPermissionRepository creates a new guild permission repository
+func NewGuildPermissionRepository(db database.Database) *GuildPermissionRepository {
+	return &GuildPermissionRepository{db: db}
+}
+
+// ============================================================================
+// Custom Roles
+// ============================================================================
+
+// CreateRole creates a custom role in a guild. Returns ErrDuplicate if the
+// guild already has a role with the name.
+func (r *GuildPermissionRepository) CreateRole(ctx context.Context, role *model.GuildCustomRole) error {
+	setClause := `
+		guild = type::record($guild_id),
+		name = $name,
+		permissions = $permissions,
+		created_by = type::record($created_by),
+		created_on = time::now(),
+		updated_on = time::now()`
+	vars := map[string]interface{}{
+		"guild_id":    role.GuildID,
+		"name":        role.Name,
+		"permissions": permissionStrings(role.Permissions),
+		"created_by":  role.CreatedBy,
+	}
+
+	if role.Description != nil {
+		setClause += ", description = $description"
+		vars["description"] = *role.Description
+	}
+
+	results, err := r.db.Query(ctx, "CREATE guild_custom_role SET "+setClause, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: guild role already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*role = *parseGuildCustomRole(rows[0])
+	return nil
+}
+
+// GetRole returns a custom role by ID, or nil if it doesn't exist
+func (r *GuildPermissionRepository) GetRole(ctx context.Context, roleID string) (*model.GuildCustomRole, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": roleID}
+
+	return r.queryRole(ctx, query, vars)
+}
+
+// ListRoles returns a guild's custom roles by name
+func (r *GuildPermissionRepository) ListRoles(ctx context.Context, guildID string) ([]*model.GuildCustomRole, error) {
+	query := `SELECT * FROM guild_custom_role WHERE guild = type::record($guild_id) ORDER BY name ASC`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	return r.queryRoles(ctx, query, vars)
+}
+
+// CountRoles returns how many custom roles a guild has
+func (r *GuildPermissionRepository) CountRoles(ctx context.Context, guildID string) (int, error) {
+	query := `SELECT count() AS count FROM guild_custom_role WHERE guild = type::record($guild_id) GROUP ALL`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	return r.count(ctx, query, vars)
+}
+
+// UpdateRole applies updates to a custom role. Returns nil if it doesn't
+// exist, and ErrDuplicate if the new name is taken.
+func (r *GuildPermissionRepository) UpdateRole(ctx context.Context, roleID string, updates map[string]interface{}) (*model.GuildCustomRole, error) {
+	query := `UPDATE guild_custom_role SET updated_on = time::now()`
+	vars := map[string]interface{}{"id": roleID}
+
+	for key, value := range updates {
+		if perms, ok := value.([]model.Permission); ok {
+			value = permissionStrings(perms)
+		}
+		query += ", " + setClause(key, value, vars)
+	}
+	query += ` WHERE id = type::record($id) RETURN AFTER`
+
+	role, err := r.queryRole(ctx, query, vars)
+	if err != nil && isUniqueConstraintError(err) {
+		return nil, fmt.Errorf("%w: guild role already exists", database.ErrDuplicate)
+	}
+	return role, err
+}
+
+// DeleteRole deletes a custom role and takes it from everyone holding it
+func (r *GuildPermissionRepository) DeleteRole(ctx context.Context, roleID string) error {
+	query := `
+		DELETE guild_role_assignment WHERE role = type::record($id);
+		DELETE type::record($id);
+	`
+	vars := map[string]interface{}{"id": roleID}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// queryRole runs a query returning at most one custom role
+func (r *GuildPermissionRepository) queryRole(ctx context.Context, query string, vars map[string]interface{}) (*model.GuildCustomRole, error) {
+	roles, err := r.queryRoles(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, nil
+	}
+	return roles[0], nil
+}
+
+func (r *GuildPermissionRepository) queryRoles(ctx context.Context, query string, vars map[string]interface{}) ([]*model.GuildCustomRole, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	roles := make([]*model.GuildCustomRole, 0, len(rows))
+	for _, row := range rows {
+		roles = append(roles, parseGuildCustomRole(row))
+	}
+	return roles, nil
+}
+
+func parseGuildCustomRole(data map[string]interface{}) *model.GuildCustomRole {
+	role := &model.GuildCustomRole{
+		ID:          convertSurrealID(data["id"]),
+		GuildID:     convertSurrealID(data["guild"]),
+		Name:        getString(data, "name"),
+		Description: getStringPtr(data, "description"),
+		CreatedBy:   convertSurrealID(data["created_by"]),
+	}
+	for _, perm := range getStringSlice(data, "permissions") {
+		role.Permissions = append(role.Permissions, model.Permission(perm))
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		role.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		role.UpdatedOn = *t
+	}
+	return role
+}
+
+func permissionStrings(perms []model.Permission) []string {
+	out := make([]string, len(perms))
+	for i, perm := range perms {
+		out[i] = string(perm)
+	}
+	return out
+}
+
+// ============================================================================
+// Assignments
+// ============================================================================
+
+// AssignRole gives a member a custom role. Assigning a role the member
+// already holds does nothing.
+func (r *GuildPermissionRepository) AssignRole(ctx context.Context, guildID, roleID, userID, assignedBy string) error {
+	query := `
+		IF (SELECT id FROM guild_role_assignment WHERE role = type::record($role_id) AND user = type::record($user_id)) = [] {
+			CREATE guild_role_assignment SET
+				guild = type::record($guild_id),
+				role = type::record($role_id),
+				user = type::record($user_id),
+				assigned_by = type::record($assigned_by),
+				created_on = time::now();
+		};
+	`
+	vars := map[string]interface{}{
+		"guild_id":    guildID,
+		"role_id":     roleID,
+		"user_id":     userID,
+		"assigned_by": assignedBy,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// UnassignRole takes a custom role from a member. Returns false if they
+// didn't hold it.
+func (r *GuildPermissionRepository) UnassignRole(ctx context.Context, roleID, userID string) (bool, error) {
+	query := `
+		DELETE guild_role_assignment
+		WHERE role = type::record($role_id) AND user = type::record($user_id)
+		RETURN BEFORE
+	`
+	vars := map[string]interface{}{
+		"role_id": roleID,
+		"user_id": userID,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// GetMemberRoles returns the custom roles a user holds in a guild
+func (r *GuildPermissionRepository) GetMemberRoles(ctx context.Context, guildID, userID string) ([]*model.GuildCustomRole, error) {
+	query := `
+		SELECT * FROM guild_custom_role
+		WHERE id IN (
+			SELECT VALUE role FROM guild_role_assignment
+			WHERE guild = type::record($guild_id) AND user = type::record($user_id)
+		)
+		ORDER BY name ASC
+	`
+	vars := map[string]interface{}{
+		"guild_id": guildID,
+		"user_id":  userID,
+	}
+
+	return r.queryRoles(ctx, query, vars)
+}
+
+// count runs a count() ... GROUP ALL query
+func (r *GuildPermissionRepository) count(ctx context.Context, query string, vars map[string]interface{}) (int, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "count"), nil
+}
@@ -0,0 +1,324 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildTierRepository handles guild membership tiers and who holds them
+type GuildTierRepository struct {
+	db database.Database
+}
+
+// NewGuildTierRepository creates a new guild tier repository
+func NewGuildTierRepository(db database.Database) *GuildTierRepository {
+	return &GuildTierRepository{db: db}
+}
+
+// ============================================================================
+// Tiers
+// ============================================================================
+
+// CreateTier creates a tier in a guild. Returns ErrDuplicate if the guild
+// already has a tier with the key.
+func (r *GuildTierRepository) CreateTier(ctx context.Context, tier *model.GuildTier) error {
+	setClause := `
+		guild = type::record($guild_id),
+		key = $key,
+		name = $name,
+		rank = $rank,
+		early_rsvp_hours = $early_rsvp_hours,
+		created_on = time::now(),
+		updated_on = time::now()`
+	vars := map[string]interface{}{
+		"guild_id":         tier.GuildID,
+		"key":              tier.Key,
+		"name":             tier.Name,
+		"rank":             tier.Rank,
+		"early_rsvp_hours": tier.EarlyRSVPHours,
+	}
+
+	if tier.Description != nil {
+		setClause += ", description = $description"
+		vars["description"] = *tier.Description
+	}
+
+	results, err := r.db.Query(ctx, "CREATE guild_tier SET "+setClause, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: guild tier already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*tier = *parseGuildTier(rows[0])
+	return nil
+}
+
+// GetTier returns a tier by ID, or nil if it doesn't exist
+func (r *GuildTierRepository) GetTier(ctx context.Context, tierID string) (*model.GuildTier, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": tierID}
+
+	return r.queryTier(ctx, query, vars)
+}
+
+// ListTiers returns a guild's tiers, highest ranked first
+func (r *GuildTierRepository) ListTiers(ctx context.Context, guildID string) ([]*model.GuildTier, error) {
+	query := `SELECT * FROM guild_tier WHERE guild = type::record($guild_id) ORDER BY rank DESC`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	tiers := make([]*model.GuildTier, 0, len(rows))
+	for _, row := range rows {
+		tiers = append(tiers, parseGuildTier(row))
+	}
+	return tiers, nil
+}
+
+// CountTiers returns how many tiers a guild has
+func (r *GuildTierRepository) CountTiers(ctx context.Context, guildID string) (int, error) {
+	query := `SELECT count() AS count FROM guild_tier WHERE guild = type::record($guild_id) GROUP ALL`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	return r.count(ctx, query, vars)
+}
+
+// UpdateTier applies updates to a tier. Returns nil if it doesn't exist.
+func (r *GuildTierRepository) UpdateTier(ctx context.Context, tierID string, updates map[string]interface{}) (*model.GuildTier, error) {
+	query := `UPDATE guild_tier SET updated_on = time::now()`
+	vars := map[string]interface{}{"id": tierID}
+
+	for key, value := range updates {
+		query += ", " + setClause(key, value, vars)
+	}
+	query += ` WHERE id = type::record($id) RETURN AFTER`
+
+	return r.queryTier(ctx, query, vars)
+}
+
+// DeleteTier deletes a tier and takes it from everyone holding it
+func (r *GuildTierRepository) DeleteTier(ctx context.Context, tierID string) error {
+	query := `
+		DELETE guild_tier_assignment WHERE tier = type::record($id);
+		DELETE type::record($id);
+	`
+	vars := map[string]interface{}{"id": tierID}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// CountTierUses returns how many upcoming events and pools are gated on a
+// tier, including those in the guild's trash, which may be restored
+func (r *GuildTierRepository) CountTierUses(ctx context.Context, tierID string) (int, error) {
+	vars := map[string]interface{}{"id": tierID}
+
+	events, err := r.count(ctx, `
+		SELECT count() AS count FROM event
+		WHERE min_tier = $id AND status NOT IN ["cancelled", "completed"]
+		GROUP ALL
+	`, vars)
+	if err != nil {
+		return 0, err
+	}
+	pools, err := r.count(ctx, `SELECT count() AS count FROM matching_pool WHERE min_tier = $id GROUP ALL`, vars)
+	if err != nil {
+		return 0, err
+	}
+	return events + pools, nil
+}
+
+// queryTier runs a query returning at most one tier
+func (r *GuildTierRepository) queryTier(ctx context.Context, query string, vars map[string]interface{}) (*model.GuildTier, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseGuildTier(rows[0]), nil
+}
+
+func parseGuildTier(data map[string]interface{}) *model.GuildTier {
+	tier := &model.GuildTier{
+		ID:             convertSurrealID(data["id"]),
+		GuildID:        convertSurrealID(data["guild"]),
+		Key:            getString(data, "key"),
+		Name:           getString(data, "name"),
+		Description:    getStringPtr(data, "description"),
+		Rank:           getInt(data, "rank"),
+		EarlyRSVPHours: getInt(data, "early_rsvp_hours"),
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		tier.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		tier.UpdatedOn = *t
+	}
+	return tier
+}
+
+// ============================================================================
+// Assignments
+// ============================================================================
+
+// AssignTier gives a member a tier, replacing any tier they hold in the
+// guild
+func (r *GuildTierRepository) AssignTier(ctx context.Context, assignment *model.GuildTierAssignment) error {
+	setClause := `
+		guild = type::record($guild_id),
+		user = type::record($user_id),
+		tier = type::record($tier_id),
+		assigned_by = type::record($assigned_by),
+		assigned_on = time::now()`
+	vars := map[string]interface{}{
+		"guild_id":    assignment.GuildID,
+		"user_id":     assignment.UserID,
+		"tier_id":     assignment.TierID,
+		"assigned_by": assignment.AssignedBy,
+	}
+
+	if assignment.ExpiresOn != nil {
+		setClause += ", expires_on = $expires_on"
+		vars["expires_on"] = *assignment.ExpiresOn
+	}
+
+	query := `
+		DELETE guild_tier_assignment
+			WHERE guild = type::record($guild_id) AND user = type::record($user_id)
+			RETURN NONE;
+		CREATE guild_tier_assignment SET ` + setClause + `;
+	`
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*assignment = *parseGuildTierAssignment(rows[len(rows)-1])
+	return nil
+}
+
+// GetAssignment returns the tier assignment a user holds in a guild, with
+// its tier, or nil if they hold none. Expired assignments are returned;
+// callers decide what expiry means.
+func (r *GuildTierRepository) GetAssignment(ctx context.Context, guildID, userID string) (*model.GuildTierAssignment, error) {
+	query := `
+		SELECT *, tier.* AS tier_data FROM guild_tier_assignment
+		WHERE guild = type::record($guild_id) AND user = type::record($user_id)
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"guild_id": guildID,
+		"user_id":  userID,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseGuildTierAssignment(rows[0]), nil
+}
+
+// ListAssignments returns the unexpired assignments of a tier, newest
+// first
+func (r *GuildTierRepository) ListAssignments(ctx context.Context, tierID string, now time.Time) ([]*model.GuildTierAssignment, error) {
+	query := `
+		SELECT * FROM guild_tier_assignment
+		WHERE tier = type::record($tier_id)
+			AND (expires_on = NONE OR expires_on > $now)
+		ORDER BY assigned_on DESC
+	`
+	vars := map[string]interface{}{
+		"tier_id": tierID,
+		"now":     now,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	assignments := make([]*model.GuildTierAssignment, 0, len(rows))
+	for _, row := range rows {
+		assignments = append(assignments, parseGuildTierAssignment(row))
+	}
+	return assignments, nil
+}
+
+// RemoveAssignment takes a member's tier away. Returns false if they held
+// none.
+func (r *GuildTierRepository) RemoveAssignment(ctx context.Context, guildID, userID string) (bool, error) {
+	query := `
+		DELETE guild_tier_assignment
+		WHERE guild = type::record($guild_id) AND user = type::record($user_id)
+		RETURN BEFORE
+	`
+	vars := map[string]interface{}{
+		"guild_id": guildID,
+		"user_id":  userID,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// count runs a count() ... GROUP ALL query
+func (r *GuildTierRepository) count(ctx context.Context, query string, vars map[string]interface{}) (int, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "count"), nil
+}
+
+func parseGuildTierAssignment(data map[string]interface{}) *model.GuildTierAssignment {
+	assignment := &model.GuildTierAssignment{
+		ID:         convertSurrealID(data["id"]),
+		GuildID:    convertSurrealID(data["guild"]),
+		UserID:     convertSurrealID(data["user"]),
+		TierID:     convertSurrealID(data["tier"]),
+		AssignedBy: convertSurrealID(data["assigned_by"]),
+		ExpiresOn:  getTime(data, "expires_on"),
+	}
+	if t := getTime(data, "assigned_on"); t != nil {
+		assignment.AssignedOn = *t
+	}
+	if tier, ok := data["tier_data"].(map[string]interface{}); ok {
+		assignment.Tier = parseGuildTier(tier)
+	}
+	return assignment
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// HangoutTypeRepository handles the hangout type catalog: platform types and
+// guilds' custom types
+type HangoutTypeRepository struct {
+	db database.Database
+}
+
+// NewHangoutTypeRepository creates a new hangout type repository
+func NewHangoutTypeRepository(db database.Database) *HangoutTypeRepository {
+	return &HangoutTypeRepository{db: db}
+}
+
+// Create adds a hangout type, to a guild's catalog when GuildID is set.
+// Returns ErrDuplicate if the catalog already has the type.
+func (r *HangoutTypeRepository) Create(ctx context.Context, info *model.HangoutTypeInfo) error {
+	setClause := `
+		key = $key,
+		label = $label,
+		description = $description,
+		icon = $icon,
+		built_in = false,
+		is_active = true,
+		sort_order = $sort_order,
+		created_on = time::now(),
+		updated_on = time::now()`
+	vars := map[string]interface{}{
+		"key":         string(info.Type),
+		"label":       info.Label,
+		"description": info.Description,
+		"icon":        info.Icon,
+		"sort_order":  info.SortOrder,
+	}
+
+	if info.GuildID != nil {
+		setClause += ", guild = type::record($guild_id)"
+		vars["guild_id"] = *info.GuildID
+	}
+
+	results, err := r.db.Query(ctx, "CREATE hangout_type SET "+setClause, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: hangout type already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*info = *parseHangoutType(rows[0])
+	return nil
+}
+
+// GetByKey returns the type with the key in a guild's catalog, or in the
+// platform catalog when guildID is empty. Returns nil if there is none.
+func (r *HangoutTypeRepository) GetByKey(ctx context.Context, key, guildID string) (*model.HangoutTypeInfo, error) {
+	query := `SELECT * FROM hangout_type WHERE key = $key AND guild = NONE LIMIT 1`
+	vars := map[string]interface{}{"key": key}
+	if guildID != "" {
+		query = `SELECT * FROM hangout_type WHERE key = $key AND guild = type::record($guild_id) LIMIT 1`
+		vars["guild_id"] = guildID
+	}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// List returns a guild's custom types, or the platform types when guildID is
+// empty, in display order. Inactive types are left out unless asked for.
+func (r *HangoutTypeRepository) List(ctx context.Context, guildID string, includeInactive bool) ([]*model.HangoutTypeInfo, error) {
+	query := `SELECT * FROM hangout_type WHERE guild = NONE`
+	vars := map[string]interface{}{}
+	if guildID != "" {
+		query = `SELECT * FROM hangout_type WHERE guild = type::record($guild_id)`
+		vars["guild_id"] = guildID
+	}
+	if !includeInactive {
+		query += ` AND is_active = true`
+	}
+	query += ` ORDER BY sort_order, key`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	types := make([]*model.HangoutTypeInfo, 0, len(rows))
+	for _, row := range rows {
+		types = append(types, parseHangoutType(row))
+	}
+	return types, nil
+}
+
+// CountGuildTypes returns how many custom types a guild has
+func (r *HangoutTypeRepository) CountGuildTypes(ctx context.Context, guildID string) (int, error) {
+	query := `SELECT count() AS count FROM hangout_type WHERE guild = type::record($guild_id) GROUP ALL`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "count"), nil
+}
+
+// Update applies updates to a hangout type. Returns nil if it doesn't exist.
+func (r *HangoutTypeRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (*model.HangoutTypeInfo, error) {
+	query := `UPDATE hangout_type SET updated_on = time::now()`
+	vars := map[string]interface{}{"id": id}
+
+	for key, value := range updates {
+		query += ", " + setClause(key, value, vars)
+	}
+	query += ` WHERE id = type::record($id) RETURN AFTER`
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// Delete removes a hangout type. Availability already using it keeps its
+// type key.
+func (r *HangoutTypeRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// queryOne runs a query returning at most one hangout type
+func (r *HangoutTypeRepository) queryOne(ctx context.Context, query string, vars map[string]interface{}) (*model.HangoutTypeInfo, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseHangoutType(rows[0]), nil
+}
+
+func parseHangoutType(data map[string]interface{}) *model.HangoutTypeInfo {
+	info := &model.HangoutTypeInfo{
+		ID:          convertSurrealID(data["id"]),
+		Type:        model.HangoutType(getString(data, "key")),
+		Label:       getString(data, "label"),
+		Description: getString(data, "description"),
+		Icon:        getString(data, "icon"),
+		BuiltIn:     getBool(data, "built_in"),
+		IsActive:    getBool(data, "is_active"),
+		SortOrder:   getInt(data, "sort_order"),
+	}
+	if data["guild"] != nil {
+		guildID := convertSurrealID(data["guild"])
+		info.GuildID = &guildID
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		info.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		info.UpdatedOn = *t
+	}
+	return info
+}
@@ -91,22 +91,6 @@ func extractQueryResults(result interface{}) ([]interface{}, bool) {
 	return nil, false
 }
 
-// WithTransaction executes a function within a transaction context
-// If the function returns an error, the transaction is rolled back
-func WithTransaction(ctx context.Context, db database.Database, fn func(tx database.Transaction) error) error {
-	tx, err := db.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	if err := fn(tx); err != nil {
-		_ = tx.Rollback()
-		return err
-	}
-
-	return tx.Commit()
-}
-
 // BatchExecute executes multiple queries atomically using AtomicBatch
 func BatchExecute(ctx context.Context, db database.Database, queries []struct {
 	Query string
@@ -152,6 +136,16 @@ func extractCountValue(v interface{}) int {
 	return 0
 }
 
+// setClause builds a "field = $field" assignment for a dynamic UPDATE and
+// binds the value. A nil value clears the field with NONE.
+func setClause(field string, value interface{}, vars map[string]interface{}) string {
+	if value == nil {
+		return field + " = NONE"
+	}
+	vars[field] = value
+	return field + " = $" + field
+}
+
 // getString extracts a string value from a map
 func getString(m map[string]interface{}, key string) string {
 	if v, ok := m[key].(string); ok {
@@ -246,4 +240,25 @@ func getStringSlice(m map[string]interface{}, key string) []string {
 	return nil
 }
 
+// flattenResults unwraps SurrealDB query responses into result rows
+func flattenResults(results []interface{}) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0)
+	for _, res := range results {
+		resp, ok := res.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if items, ok := resp["result"].([]interface{}); ok {
+			for _, item := range items {
+				if data, ok := item.(map[string]interface{}); ok {
+					rows = append(rows, data)
+				}
+			}
+			continue
+		}
+		rows = append(rows, resp)
+	}
+	return rows
+}
+
 // Note: convertSurrealID and extractCreatedRecord are defined in user.go with more comprehensive handling
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// IdempotencyRepository handles stored idempotent response data access
+type IdempotencyRepository struct {
+	db database.Database
+}
+
+// NewIdempotencyRepository creates a new idempotency repository
+func NewIdempotencyRepository(db database.Database) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+// Claim locks key until lockedUntil for a new request, returning claimed.
+// When the key is taken it returns the stored response instead, or neither
+// while the request holding the key is still being handled. Expired rows
+// and lapsed locks are cleared first, so their key can be claimed again.
+func (r *IdempotencyRepository) Claim(ctx context.Context, key string, now, lockedUntil, expiresOn time.Time) (*model.IdempotentResponse, bool, error) {
+	clearQuery := `
+		DELETE idempotency_key
+		WHERE key = $key AND (expires_on <= $now OR (completed_on = NONE AND locked_until <= $now))
+	`
+	vars := map[string]interface{}{
+		"key":          key,
+		"now":          now,
+		"locked_until": lockedUntil,
+		"expires_on":   expiresOn,
+	}
+	if err := r.db.Execute(ctx, clearQuery, vars); err != nil {
+		return nil, false, err
+	}
+
+	createQuery := `
+		CREATE idempotency_key SET
+			key = $key,
+			locked_until = $locked_until,
+			expires_on = $expires_on,
+			created_on = time::now()
+	`
+	_, err := r.db.Query(ctx, createQuery, vars)
+	if err == nil {
+		return nil, true, nil
+	}
+	if !isUniqueConstraintError(err) {
+		return nil, false, err
+	}
+
+	// Taken: replay its response once it has one
+	results, err := r.db.Query(ctx, `SELECT * FROM idempotency_key WHERE key = $key LIMIT 1`, vars)
+	if err != nil {
+		return nil, false, err
+	}
+	rows := flattenResults(results)
+	if len(rows) == 0 || getTime(rows[0], "completed_on") == nil {
+		return nil, false, nil
+	}
+	response, err := parseIdempotentResponse(rows[0])
+	if err != nil {
+		return nil, false, err
+	}
+	return response, false, nil
+}
+
+// Complete stores the response to a claimed key, kept until expiresOn
+func (r *IdempotencyRepository) Complete(ctx context.Context, key string, response *model.IdempotentResponse, expiresOn time.Time) error {
+	headers, err := json.Marshal(response.Headers)
+	if err != nil {
+		return fmt.Errorf("encoding headers: %w", err)
+	}
+
+	query := `
+		UPDATE idempotency_key SET
+			status = $status,
+			headers = $headers,
+			body = $body,
+			completed_on = time::now(),
+			expires_on = $expires_on
+		WHERE key = $key
+	`
+	vars := map[string]interface{}{
+		"key":        key,
+		"status":     response.Status,
+		"headers":    string(headers),
+		"body":       base64.StdEncoding.EncodeToString(response.Body),
+		"expires_on": expiresOn,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Delete drops a key, stored response or not
+func (r *IdempotencyRepository) Delete(ctx context.Context, key string) error {
+	query := `DELETE idempotency_key WHERE key = $key`
+	vars := map[string]interface{}{"key": key}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// DeleteExpired removes responses that are no longer replayed and returns
+// how many were removed
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	query := `DELETE idempotency_key WHERE expires_on <= $now RETURN BEFORE`
+	vars := map[string]interface{}{"now": now}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+	return len(flattenResults(results)), nil
+}
+
+func parseIdempotentResponse(data map[string]interface{}) (*model.IdempotentResponse, error) {
+	response := &model.IdempotentResponse{
+		Status:  getInt(data, "status"),
+		Headers: http.Header{},
+	}
+	if headers := getString(data, "headers"); headers != "" {
+		if err := json.Unmarshal([]byte(headers), &response.Headers); err != nil {
+			return nil, fmt.Errorf("decoding stored headers: %w", err)
+		}
+	}
+	body, err := base64.StdEncoding.DecodeString(getString(data, "body"))
+	if err != nil {
+		return nil, fmt.Errorf("decoding stored body: %w", err)
+	}
+	response.Body = body
+	return response, nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// IPDenyRepository handles the stored IP deny list
+type IPDenyRepository struct {
+	db database.Database
+}
+
+// NewIPDenyRepository creates a new IP deny repository
+func NewIPDenyRepository(db database.Database) *IPDenyRepository {
+	return &IPDenyRepository{db: db}
+}
+
+// Create adds an entry to the deny list, clearing out an expired entry for
+// the same range. Returns ErrDuplicate if the range is already on it.
+func (r *IPDenyRepository) Create(ctx context.Context, entry *model.IPDenyEntry) error {
+	vars := map[string]interface{}{
+		"cidr":       entry.CIDR,
+		"reason":     entry.Reason,
+		"created_by": entry.CreatedBy,
+	}
+	expiresAt := "expires_at = NONE"
+	if entry.ExpiresAt != nil {
+		expiresAt = "expires_at = $expires_at"
+		vars["expires_at"] = *entry.ExpiresAt
+	}
+
+	query := `
+		DELETE ip_deny WHERE cidr = $cidr AND expires_at != NONE AND expires_at <= time::now();
+		CREATE ip_deny SET
+			cidr = $cidr,
+			reason = $reason,
+			` + expiresAt + `,
+			created_by = type::record($created_by),
+			created_on = time::now()
+	`
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: range already denied", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	created := parseIPDenyEntry(rows[0])
+	entry.ID = created.ID
+	entry.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// List returns the entries that haven't expired
+func (r *IPDenyRepository) List(ctx context.Context) ([]*model.IPDenyEntry, error) {
+	query := `
+		SELECT * FROM ip_deny
+		WHERE expires_at = NONE OR expires_at > time::now()
+		ORDER BY created_on DESC
+	`
+
+	results, err := r.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	entries := make([]*model.IPDenyEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, parseIPDenyEntry(row))
+	}
+	return entries, nil
+}
+
+// Delete removes an entry. Returns the removed entry, or nil if there was
+// none.
+func (r *IPDenyRepository) Delete(ctx context.Context, id string) (*model.IPDenyEntry, error) {
+	query := `DELETE ip_deny WHERE id = type::record($id) RETURN BEFORE`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseIPDenyEntry(rows[0]), nil
+}
+
+func parseIPDenyEntry(data map[string]interface{}) *model.IPDenyEntry {
+	entry := &model.IPDenyEntry{
+		ID:        convertSurrealID(data["id"]),
+		CIDR:      getString(data, "cidr"),
+		Reason:    getString(data, "reason"),
+		ExpiresAt: getTime(data, "expires_at"),
+		CreatedBy: convertSurrealID(data["created_by"]),
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		entry.CreatedOn = *t
+	}
+	return entry
+}
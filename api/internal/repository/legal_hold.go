@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// notOnLegalHold is a WHERE condition that skips records owned, through the
+// given record<user> field, by a user on legal hold. Purge queries add it so
+// held users' data is preserved until the hold is released.
+func notOnLegalHold(field string) string {
+	return field + " NOT IN (SELECT VALUE user FROM legal_hold)"
+}
+
+// LegalHoldRepository handles legal holds and their access log
+type LegalHoldRepository struct {
+	db database.Database
+}
+
+// NewLegalHoldRepository creates a new legal hold repository
+func NewLegalHoldRepository(db database.Database) *LegalHoldRepository {
+	return &LegalHoldRepository{db: db}
+}
+
+// Create places a hold. The unique index on user rejects a second hold.
+func (r *LegalHoldRepository) Create(ctx context.Context, hold *model.LegalHold) error {
+	query := `
+		CREATE legal_hold SET
+			user = type::record($user_id),
+			reason = $reason,
+			placed_by = type::record($placed_by),
+			placed_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"user_id":   hold.UserID,
+		"reason":    hold.Reason,
+		"placed_by": hold.PlacedBy,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	hold.PlacedOn = parseLegalHold(rows[0]).PlacedOn
+	return nil
+}
+
+// Get returns the hold on a user, or nil if they aren't held
+func (r *LegalHoldRepository) Get(ctx context.Context, userID string) (*model.LegalHold, error) {
+	query := `SELECT * FROM legal_hold WHERE user = type::record($user_id) LIMIT 1`
+	vars := map[string]interface{}{"user_id": userID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseLegalHold(rows[0]), nil
+}
+
+// Delete releases the hold on a user. Returns the released hold, or nil if
+// there was none.
+func (r *LegalHoldRepository) Delete(ctx context.Context, userID string) (*model.LegalHold, error) {
+	query := `DELETE legal_hold WHERE user = type::record($user_id) RETURN BEFORE`
+	vars := map[string]interface{}{"user_id": userID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseLegalHold(rows[0]), nil
+}
+
+// CreateAccess appends an entry to a held user's access log
+func (r *LegalHoldRepository) CreateAccess(ctx context.Context, access *model.LegalHoldAccess) error {
+	vars := map[string]interface{}{
+		"user_id": access.UserID,
+		"action":  access.Action,
+	}
+	actor := "actor = NONE"
+	if access.ActorID != "" {
+		actor = "actor = type::record($actor_id)"
+		vars["actor_id"] = access.ActorID
+	}
+	query := `
+		CREATE legal_hold_access SET
+			user = type::record($user_id),
+			` + actor + `,
+			action = $action,
+			accessed_on = time::now()
+	`
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	created := parseLegalHoldAccess(rows[0])
+	access.ID = created.ID
+	access.AccessedOn = created.AccessedOn
+	return nil
+}
+
+// ListAccess returns a user's access log, newest first
+func (r *LegalHoldRepository) ListAccess(ctx context.Context, userID string, limit int) ([]*model.LegalHoldAccess, error) {
+	query := `
+		SELECT * FROM legal_hold_access
+		WHERE user = type::record($user_id)
+		ORDER BY accessed_on DESC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"limit":   limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	entries := make([]*model.LegalHoldAccess, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, parseLegalHoldAccess(row))
+	}
+	return entries, nil
+}
+
+func parseLegalHold(data map[string]interface{}) *model.LegalHold {
+	hold := &model.LegalHold{
+		UserID:   convertSurrealID(data["user"]),
+		Reason:   getString(data, "reason"),
+		PlacedBy: convertSurrealID(data["placed_by"]),
+	}
+	if t := getTime(data, "placed_on"); t != nil {
+		hold.PlacedOn = *t
+	}
+	return hold
+}
+
+func parseLegalHoldAccess(data map[string]interface{}) *model.LegalHoldAccess {
+	access := &model.LegalHoldAccess{
+		ID:     convertSurrealID(data["id"]),
+		UserID: convertSurrealID(data["user"]),
+		Action: getString(data, "action"),
+	}
+	if actor := data["actor"]; actor != nil {
+		access.ActorID = convertSurrealID(actor)
+	}
+	if t := getTime(data, "accessed_on"); t != nil {
+		access.AccessedOn = *t
+	}
+	return access
+}
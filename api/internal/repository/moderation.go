@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/forgo/saga/api/internal/database"
 	"github.com/forgo/saga/api/internal/model"
@@ -234,15 +235,19 @@ func (r *ModerationRepository) GetAction(ctx context.Context, id string) (*model
 }
 
 // GetActiveActionsForUser retrieves active moderation actions for a user
-func (r *ModerationRepository) GetActiveActionsForUser(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+func (r *ModerationRepository) GetActiveActionsForUser(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 	query := `
 		SELECT * FROM moderation_action
 		WHERE user_id = $user_id
 		AND is_active = true
-		AND (expires_on IS NULL OR expires_on > time::now())
+		AND (expires_on IS NULL OR expires_on > $now)
 		ORDER BY level DESC, created_on DESC
 	`
-	result, err := r.db.Query(ctx, query, map[string]interface{}{"user_id": userID})
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"now":     now,
+	}
+	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get active actions: %w", err)
 	}
@@ -265,6 +270,25 @@ func (r *ModerationRepository) GetAllActionsForUser(ctx context.Context, userID
 	return r.parseActionsFromQuery(result)
 }
 
+// ListActions returns a page of every moderation action, oldest first
+func (r *ModerationRepository) ListActions(ctx context.Context, offset, limit int) ([]*model.ModerationAction, error) {
+	query := `
+		SELECT * FROM moderation_action
+		ORDER BY created_on ASC
+		LIMIT $limit START $offset
+	`
+	vars := map[string]interface{}{
+		"offset": offset,
+		"limit":  limit,
+	}
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list actions: %w", err)
+	}
+
+	return r.parseActionsFromQuery(result)
+}
+
 // UpdateAction updates a moderation action
 func (r *ModerationRepository) UpdateAction(ctx context.Context, id string, updates map[string]interface{}) error {
 	query := "UPDATE moderation_action SET "
@@ -285,16 +309,16 @@ func (r *ModerationRepository) UpdateAction(ctx context.Context, id string, upda
 	return err
 }
 
-// ExpireOldActions marks expired actions as inactive
-func (r *ModerationRepository) ExpireOldActions(ctx context.Context) error {
+// ExpireOldActions marks actions that expired before now as inactive
+func (r *ModerationRepository) ExpireOldActions(ctx context.Context, now time.Time) error {
 	query := `
 		UPDATE moderation_action
 		SET is_active = false
 		WHERE is_active = true
 		AND expires_on IS NOT NULL
-		AND expires_on < time::now()
+		AND expires_on < $now
 	`
-	_, err := r.db.Query(ctx, query, nil)
+	_, err := r.db.Query(ctx, query, map[string]interface{}{"now": now})
 	return err
 }
 
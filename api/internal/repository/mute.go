@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// MuteRepository handles users' notification mutes
+type MuteRepository struct {
+	db database.Database
+}
+
+// NewMuteRepository creates a new mute repository
+func NewMuteRepository(db database.Database) *MuteRepository {
+	return &MuteRepository{db: db}
+}
+
+// CreateMute saves a mute, replacing the user's mute of the same guild or
+// category if they have one
+func (r *MuteRepository) CreateMute(ctx context.Context, mute *model.Mute) error {
+	target := ""
+	setClause := `
+		user = type::record($user_id),
+		kind = $kind,
+		target = $target,
+		created_on = time::now()`
+	vars := map[string]interface{}{
+		"user_id": mute.UserID,
+		"kind":    string(mute.Kind),
+	}
+
+	if mute.GuildID != nil {
+		setClause += ", guild = type::record($guild_id)"
+		vars["guild_id"] = *mute.GuildID
+		target = *mute.GuildID
+	}
+	if mute.Category != nil {
+		setClause += ", category = $category"
+		vars["category"] = *mute.Category
+		target = *mute.Category
+	}
+	if mute.Until != nil {
+		setClause += ", until = $until"
+		vars["until"] = *mute.Until
+	}
+	vars["target"] = target
+
+	query := `
+		DELETE mute
+		WHERE user = type::record($user_id) AND kind = $kind AND target = $target
+		RETURN NONE;
+		CREATE mute SET ` + setClause + `;
+	`
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*mute = *parseMute(rows[len(rows)-1])
+	return nil
+}
+
+// ListMutes returns a user's unexpired mutes, newest first
+func (r *MuteRepository) ListMutes(ctx context.Context, userID string, now time.Time) ([]*model.Mute, error) {
+	query := `
+		SELECT * FROM mute
+		WHERE user = type::record($user_id) AND (until = NONE OR until > $now)
+		ORDER BY created_on DESC
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"now":     now,
+	}
+
+	return r.queryMutes(ctx, query, vars)
+}
+
+// CountMutes returns how many unexpired mutes a user has
+func (r *MuteRepository) CountMutes(ctx context.Context, userID string, now time.Time) (int, error) {
+	query := `
+		SELECT count() AS count FROM mute
+		WHERE user = type::record($user_id) AND (until = NONE OR until > $now)
+		GROUP ALL
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"now":     now,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "count"), nil
+}
+
+// DeleteMute deletes one of a user's mutes. Returns false if they have no
+// such mute.
+func (r *MuteRepository) DeleteMute(ctx context.Context, userID, muteID string) (bool, error) {
+	query := `
+		DELETE mute
+		WHERE id = type::record($id) AND user = type::record($user_id)
+		RETURN BEFORE
+	`
+	vars := map[string]interface{}{
+		"id":      muteID,
+		"user_id": userID,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// ListMutesFor returns the unexpired mutes, across all users, of the guild
+// or event category a notification is about
+func (r *MuteRepository) ListMutesFor(ctx context.Context, scope model.NotificationScope, now time.Time) ([]*model.Mute, error) {
+	query := `
+		SELECT * FROM mute
+		WHERE ((kind = "guild" AND guild = type::record($guild_id))
+			OR (kind = "event_category" AND category = $category))
+			AND (until = NONE OR until > $now)
+	`
+	vars := map[string]interface{}{
+		"guild_id": scope.GuildID,
+		"category": scope.EventCategory,
+		"now":      now,
+	}
+
+	return r.queryMutes(ctx, query, vars)
+}
+
+func (r *MuteRepository) queryMutes(ctx context.Context, query string, vars map[string]interface{}) ([]*model.Mute, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	mutes := make([]*model.Mute, 0, len(rows))
+	for _, row := range rows {
+		mutes = append(mutes, parseMute(row))
+	}
+	return mutes, nil
+}
+
+func parseMute(data map[string]interface{}) *model.Mute {
+	mute := &model.Mute{
+		ID:       convertSurrealID(data["id"]),
+		UserID:   convertSurrealID(data["user"]),
+		Kind:     model.MuteKind(getString(data, "kind")),
+		Category: getStringPtr(data, "category"),
+		Until:    getTime(data, "until"),
+	}
+	if data["guild"] != nil {
+		guildID := convertSurrealID(data["guild"])
+		mute.GuildID = &guildID
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		mute.CreatedOn = *t
+	}
+	return mute
+}
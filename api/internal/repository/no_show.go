@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// NoShowRepository handles no-show data access
+type NoShowRepository struct {
+	db database.Database
+}
+
+// NewNoShowRepository creates a new no-show repository
+func NewNoShowRepository(db database.Database) *NoShowRepository {
+	return &NoShowRepository{db: db}
+}
+
+// GetEventsAwaitingCheck returns ended events that have not been checked for no-shows.
+// Events without an end time are treated as ending at their start time.
+func (r *NoShowRepository) GetEventsAwaitingCheck(ctx context.Context, endedBefore time.Time, limit int) ([]*model.NoShowEventRef, error) {
+	query := `
+		SELECT id, guild_id FROM event
+		WHERE status IN ["published", "completed"]
+			AND no_shows_checked != true
+			AND deleted_at = NONE
+			AND (end_time ?? start_time) < $ended_before
+		ORDER BY start_time ASC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"ended_before": endedBefore,
+		"limit":        limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]*model.NoShowEventRef, 0)
+	for _, data := range flattenResults(results) {
+		ref := &model.NoShowEventRef{EventID: convertSurrealID(data["id"])}
+		if guildID, ok := data["guild_id"]; ok && guildID != nil {
+			id := convertSurrealID(guildID)
+			ref.GuildID = &id
+		}
+		refs = append(refs, ref)
+	}
+
+	return refs, nil
+}
+
+// GetUnattendedUserIDs returns users with approved RSVPs who neither checked in
+// nor confirmed completion for an event
+func (r *NoShowRepository) GetUnattendedUserIDs(ctx context.Context, eventID string) ([]string, error) {
+	query := `
+		SELECT user_id FROM event_rsvp
+		WHERE event_id = type::record($event_id)
+			AND status = "approved"
+			AND checkin_time IS NONE
+			AND (completion_confirmed IS NONE OR completion_confirmed = false)
+	`
+	vars := map[string]interface{}{"event_id": eventID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0)
+	for _, data := range flattenResults(results) {
+		if id := convertSurrealID(data["user_id"]); id != "" {
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	return userIDs, nil
+}
+
+// MarkEventChecked flags an event as having been checked for no-shows
+func (r *NoShowRepository) MarkEventChecked(ctx context.Context, eventID string) error {
+	query := `UPDATE type::record($event_id) SET no_shows_checked = true`
+	return r.db.Execute(ctx, query, map[string]interface{}{"event_id": eventID})
+}
+
+// Create records a no-show. It returns database.ErrDuplicate if the user is
+// already marked as a no-show for the event.
+func (r *NoShowRepository) Create(ctx context.Context, noShow *model.NoShow) error {
+	query := `
+		CREATE no_show CONTENT {
+			user_id: $user_id,
+			event_id: $event_id,
+			guild_id: IF $guild_id IS NOT NULL THEN $guild_id ELSE NONE END,
+			source: $source,
+			marked_on: time::now()
+		}
+	`
+	var guildID interface{}
+	if noShow.GuildID != nil {
+		guildID = *noShow.GuildID
+	}
+	vars := map[string]interface{}{
+		"user_id":  noShow.UserID,
+		"event_id": noShow.EventID,
+		"guild_id": guildID,
+		"source":   string(noShow.Source),
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: no-show already recorded", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	noShow.ID = created.ID
+	noShow.MarkedOn = created.CreatedOn
+	if noShow.MarkedOn.IsZero() {
+		noShow.MarkedOn = time.Now()
+	}
+	return nil
+}
+
+// Get retrieves the no-show record for a user and event, or nil if none exists
+func (r *NoShowRepository) Get(ctx context.Context, eventID, userID string) (*model.NoShow, error) {
+	query := `SELECT * FROM no_show WHERE event_id = $event_id AND user_id = $user_id LIMIT 1`
+	vars := map[string]interface{}{
+		"event_id": eventID,
+		"user_id":  userID,
+	}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+
+	return parseNoShowData(data), nil
+}
+
+// GetByUser retrieves a user's no-shows since the given time, newest first
+func (r *NoShowRepository) GetByUser(ctx context.Context, userID string, since time.Time) ([]*model.NoShow, error) {
+	query := `
+		SELECT * FROM no_show
+		WHERE user_id = $user_id AND marked_on >= $since
+		ORDER BY marked_on DESC
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"since":   since,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	noShows := make([]*model.NoShow, 0)
+	for _, data := range flattenResults(results) {
+		noShows = append(noShows, parseNoShowData(data))
+	}
+
+	return noShows, nil
+}
+
+// Delete removes a no-show record
+func (r *NoShowRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	return r.db.Execute(ctx, query, map[string]interface{}{"id": id})
+}
+
+// CountCheckedRSVPs counts a user's approved RSVPs for events that have been
+// checked for no-shows and started since the given time
+func (r *NoShowRepository) CountCheckedRSVPs(ctx context.Context, userID string, since time.Time) (int, error) {
+	query := `
+		SELECT count() AS count FROM event_rsvp
+		WHERE user_id = type::record($user_id)
+			AND status = "approved"
+			AND event_id.no_shows_checked = true
+			AND event_id.start_time >= $since
+		GROUP ALL
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"since":   since,
+	}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return extractCount(result), nil
+}
+
+// GetPolicy retrieves a guild's no-show policy, or nil if none is stored
+func (r *NoShowRepository) GetPolicy(ctx context.Context, guildID string) (*model.GuildNoShowPolicy, error) {
+	query := `SELECT * FROM guild_no_show_policy WHERE guild_id = $guild_id LIMIT 1`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+
+	policy := &model.GuildNoShowPolicy{
+		GuildID:              getString(data, "guild_id"),
+		AutoDeclineThreshold: getInt(data, "auto_decline_threshold"),
+		LookbackDays:         getInt(data, "lookback_days"),
+		UpdatedBy:            getString(data, "updated_by"),
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		policy.UpdatedOn = *t
+	}
+
+	return policy, nil
+}
+
+// UpsertPolicy creates or replaces a guild's no-show policy
+func (r *NoShowRepository) UpsertPolicy(ctx context.Context, policy *model.GuildNoShowPolicy) error {
+	// SurrealDB 3.0 UPSERT doesn't work with WHERE clause properly
+	// Use IF/ELSE pattern instead
+	query := `
+		LET $existing = SELECT * FROM guild_no_show_policy WHERE guild_id = $guild_id;
+		IF array::len($existing) = 0 {
+			CREATE guild_no_show_policy SET
+				guild_id = $guild_id,
+				auto_decline_threshold = $auto_decline_threshold,
+				lookback_days = $lookback_days,
+				updated_by = $updated_by
+		} ELSE {
+			UPDATE guild_no_show_policy SET
+				auto_decline_threshold = $auto_decline_threshold,
+				lookback_days = $lookback_days,
+				updated_by = $updated_by,
+				updated_on = time::now()
+			WHERE guild_id = $guild_id
+		}
+	`
+	vars := map[string]interface{}{
+		"guild_id":               policy.GuildID,
+		"auto_decline_threshold": policy.AutoDeclineThreshold,
+		"lookback_days":          policy.LookbackDays,
+		"updated_by":             policy.UpdatedBy,
+	}
+
+	if _, err := r.db.Query(ctx, query, vars); err != nil {
+		return err
+	}
+
+	policy.UpdatedOn = time.Now()
+	return nil
+}
+
+// parseNoShowData converts a result row into a no-show
+func parseNoShowData(data map[string]interface{}) *model.NoShow {
+	noShow := &model.NoShow{
+		ID:      convertSurrealID(data["id"]),
+		UserID:  getString(data, "user_id"),
+		EventID: getString(data, "event_id"),
+		GuildID: getStringPtr(data, "guild_id"),
+		Source:  model.NoShowSource(getString(data, "source")),
+	}
+	if t := getTime(data, "marked_on"); t != nil {
+		noShow.MarkedOn = *t
+	}
+	return noShow
+}
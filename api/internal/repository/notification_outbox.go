@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// NotificationOutboxRepository handles notification outbox data access
+type NotificationOutboxRepository struct {
+	db database.Database
+}
+
+// NewNotificationOutboxRepository creates a new notification outbox repository
+func NewNotificationOutboxRepository(db database.Database) *NotificationOutboxRepository {
+	return &NotificationOutboxRepository{db: db}
+}
+
+// Create queues a notification. Called inside a transaction, the entry is
+// only visible to the processor once the transaction commits.
+func (r *NotificationOutboxRepository) Create(ctx context.Context, entry *model.NotificationOutboxEntry) error {
+	query := `
+		CREATE notification_outbox SET
+			user = type::record($user_id),
+			payload = $payload,
+			attempts = 0,
+			available_on = $available_on,
+			request_id = $request_id OR NONE,
+			created_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"user_id":      entry.UserID,
+		"payload":      string(entry.Payload),
+		"available_on": entry.AvailableOn,
+		"request_id":   entry.RequestID,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	entry.ID = created.ID
+	entry.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// ClaimDue hides the live entries available at now from other processors
+// until leaseUntil, counts the attempt and returns them. Entries of a run
+// that did not finish become available again when the lease ends.
+func (r *NotificationOutboxRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time) ([]*model.NotificationOutboxEntry, error) {
+	query := `
+		UPDATE notification_outbox SET
+			available_on = $lease_until,
+			attempts += 1
+		WHERE dead_on = NONE AND available_on <= $now
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"now":         now,
+		"lease_until": leaseUntil,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	entries := make([]*model.NotificationOutboxEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, parseNotificationOutboxEntry(row))
+	}
+	return entries, nil
+}
+
+// Delete removes a delivered entry
+func (r *NotificationOutboxRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Reschedule records a failed delivery and makes the entry available again
+// at availableOn
+func (r *NotificationOutboxRepository) Reschedule(ctx context.Context, id, lastError string, availableOn time.Time) error {
+	query := `
+		UPDATE type::record($id) SET
+			last_error = $last_error,
+			available_on = $available_on
+	`
+	vars := map[string]interface{}{
+		"id":           id,
+		"last_error":   lastError,
+		"available_on": availableOn,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// MarkDead stops delivery of an entry, keeping it for inspection
+func (r *NotificationOutboxRepository) MarkDead(ctx context.Context, id, lastError string, deadOn time.Time) error {
+	query := `
+		UPDATE type::record($id) SET
+			last_error = $last_error,
+			dead_on = $dead_on
+	`
+	vars := map[string]interface{}{
+		"id":         id,
+		"last_error": lastError,
+		"dead_on":    deadOn,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+func parseNotificationOutboxEntry(data map[string]interface{}) *model.NotificationOutboxEntry {
+	entry := &model.NotificationOutboxEntry{
+		ID:        convertSurrealID(data["id"]),
+		UserID:    convertSurrealID(data["user"]),
+		Payload:   json.RawMessage(getString(data, "payload")),
+		Attempts:  getInt(data, "attempts"),
+		LastError: getStringPtr(data, "last_error"),
+		DeadOn:    getTime(data, "dead_on"),
+		RequestID: getString(data, "request_id"),
+	}
+	if t := getTime(data, "available_on"); t != nil {
+		entry.AvailableOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		entry.CreatedOn = *t
+	}
+	return entry
+}
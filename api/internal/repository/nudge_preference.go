@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// NudgePreferenceRepository handles users' per-type nudge preferences
+type NudgePreferenceRepository struct {
+	db database.Database
+}
+
+// NewNudgePreferenceRepository creates a new nudge preference repository
+func NewNudgePreferenceRepository(db database.Database) *NudgePreferenceRepository {
+	return &NudgePreferenceRepository{db: db}
+}
+
+// GetPreference retrieves a user's preference for a nudge type, or nil if none is stored
+func (r *NudgePreferenceRepository) GetPreference(ctx context.Context, userID string, nudgeType model.NudgeType) (*model.NudgePreference, error) {
+	query := `SELECT * FROM nudge_preference WHERE user_id = type::record($user_id) AND type = $type LIMIT 1`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"type":    string(nudgeType),
+	}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unexpected result format")
+	}
+
+	pref := &model.NudgePreference{
+		UserID:  convertSurrealID(data["user_id"]),
+		Type:    model.NudgeType(getString(data, "type")),
+		Enabled: getBool(data, "enabled"),
+	}
+	if channel := getString(data, "channel"); channel != "" {
+		c := model.NudgeChannel(channel)
+		pref.Channel = &c
+	}
+	return pref, nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// OutboxRepository handles domain event outbox data access
+type OutboxRepository struct {
+	db database.Database
+}
+
+// NewOutboxRepository creates a new outbox repository
+func NewOutboxRepository(db database.Database) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Create queues a delivery of a domain event to one subscriber
+func (r *OutboxRepository) Create(ctx context.Context, entry *model.OutboxEntry) error {
+	query := `
+		CREATE domain_event_outbox SET
+			event_type = $event_type,
+			subscriber = $subscriber,
+			payload = $payload,
+			attempts = 0,
+			available_on = $available_on,
+			occurred_on = $occurred_on,
+			request_id = $request_id OR NONE,
+			created_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"event_type":   string(entry.EventType),
+		"subscriber":   entry.Subscriber,
+		"payload":      string(entry.Payload),
+		"available_on": entry.AvailableOn,
+		"occurred_on":  entry.OccurredOn,
+		"request_id":   entry.RequestID,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	entry.ID = created.ID
+	entry.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// ClaimDue hides the live entries available at now from other dispatchers
+// until leaseUntil, counts the attempt and returns them. Entries of a run
+// that did not finish become available again when the lease ends.
+func (r *OutboxRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time) ([]*model.OutboxEntry, error) {
+	query := `
+		UPDATE domain_event_outbox SET
+			available_on = $lease_until,
+			attempts += 1
+		WHERE dead_on = NONE AND available_on <= $now
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"now":         now,
+		"lease_until": leaseUntil,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	entries := make([]*model.OutboxEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, parseOutboxEntry(row))
+	}
+	return entries, nil
+}
+
+// Delete removes a delivered entry
+func (r *OutboxRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Reschedule records a failed delivery and makes the entry available again
+// at availableOn
+func (r *OutboxRepository) Reschedule(ctx context.Context, id, lastError string, availableOn time.Time) error {
+	query := `
+		UPDATE type::record($id) SET
+			last_error = $last_error,
+			available_on = $available_on
+	`
+	vars := map[string]interface{}{
+		"id":           id,
+		"last_error":   lastError,
+		"available_on": availableOn,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// MarkDead stops delivery of an entry, keeping it for inspection
+func (r *OutboxRepository) MarkDead(ctx context.Context, id, lastError string, deadOn time.Time) error {
+	query := `
+		UPDATE type::record($id) SET
+			last_error = $last_error,
+			dead_on = $dead_on
+	`
+	vars := map[string]interface{}{
+		"id":         id,
+		"last_error": lastError,
+		"dead_on":    deadOn,
+	}
+	return r.db.Execute(ctx, query, vars)
+}
+
+func parseOutboxEntry(data map[string]interface{}) *model.OutboxEntry {
+	entry := &model.OutboxEntry{
+		ID:         convertSurrealID(data["id"]),
+		EventType:  model.DomainEventType(getString(data, "event_type")),
+		Subscriber: getString(data, "subscriber"),
+		Payload:    json.RawMessage(getString(data, "payload")),
+		Attempts:   getInt(data, "attempts"),
+		LastError:  getStringPtr(data, "last_error"),
+		DeadOn:     getTime(data, "dead_on"),
+		RequestID:  getString(data, "request_id"),
+	}
+	if t := getTime(data, "available_on"); t != nil {
+		entry.AvailableOn = *t
+	}
+	if t := getTime(data, "occurred_on"); t != nil {
+		entry.OccurredOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		entry.CreatedOn = *t
+	}
+	return entry
+}
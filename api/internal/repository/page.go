@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// readPage turns the rows of a query built with database.ListPage into a
+// page, with cursors for the pages either side. sortColumns are the
+// columns ListPage returned. Rows parse fails on are left out of the page
+// but still place its cursors.
+func readPage[T any](result []interface{}, list model.ListQuery, sortColumns []string, page model.PageRequest, parse func(interface{}) (T, error)) *model.Page[T] {
+	rows := flattenResults(result)
+	hasMore := len(rows) > page.Limit
+	if hasMore {
+		rows = rows[:page.Limit]
+	}
+
+	backward := page.Cursor != nil && page.Cursor.Before
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	items := make([]T, 0, len(rows))
+	for _, row := range rows {
+		item, err := parse(row)
+		if err != nil {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	out := &model.Page[T]{Items: items}
+	if len(rows) == 0 {
+		return out
+	}
+
+	sort := list.SortParam()
+	// Reading backward, the page's far side is where the cursor was, so
+	// there is always more after it; reading forward, there is more before
+	// it when there was a cursor or an offset
+	if hasMore || backward {
+		out.NextCursor = rowCursor(rows[len(rows)-1], sort, sortColumns, false).Encode()
+	}
+	if (backward && hasMore) || (!backward && (page.Cursor != nil || page.Offset > 0)) {
+		out.PrevCursor = rowCursor(rows[0], sort, sortColumns, true).Encode()
+	}
+	return out
+}
+
+// rowCursor marks a row by the values of its sort columns and its ID
+func rowCursor(row map[string]interface{}, sort string, sortColumns []string, before bool) *model.ListCursor {
+	cursor := &model.ListCursor{
+		Sort:   sort,
+		Values: make([]interface{}, len(sortColumns)),
+		ID:     convertSurrealID(row["id"]),
+		Before: before,
+	}
+	for i, column := range sortColumns {
+		cursor.Values[i] = columnValue(row, column)
+	}
+	return cursor
+}
+
+// columnValue reads a column, such as "location.city", from a row. Times
+// come back as time.Time so the cursor compares them as times.
+func columnValue(row map[string]interface{}, column string) interface{} {
+	path := strings.Split(column, ".")
+	for _, key := range path[:len(path)-1] {
+		nested, ok := row[key].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		row = nested
+	}
+	key := path[len(path)-1]
+	if t := getTime(row, key); t != nil {
+		return *t
+	}
+	return row[key]
+}
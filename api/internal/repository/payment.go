@@ -0,0 +1,344 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// PaymentRepository handles paid event ticket payments and organizers'
+// payout accounts
+type PaymentRepository struct {
+	db database.Database
+}
+
+// NewPaymentRepository creates a new payment repository
+func NewPaymentRepository(db database.Database) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+// ============================================================================
+// Payments
+// ============================================================================
+
+// CreatePayment records a pending payment for a held ticket
+func (r *PaymentRepository) CreatePayment(ctx context.Context, payment *model.EventPayment) error {
+	setClause := `
+		event = type::record($event_id),
+		user = type::record($user_id),
+		ticket = type::record($ticket_id),
+		amount = $amount,
+		discount = $discount,
+		currency = $currency,
+		status = "pending",
+		created_on = time::now(),
+		updated_on = time::now()`
+	vars := map[string]interface{}{
+		"event_id":  payment.EventID,
+		"user_id":   payment.UserID,
+		"ticket_id": payment.TicketID,
+		"amount":    payment.Amount,
+		"discount":  payment.Discount,
+		"currency":  payment.Currency,
+	}
+
+	if payment.DiscountSource != "" {
+		setClause += ", discount_source = $discount_source"
+		vars["discount_source"] = payment.DiscountSource
+	}
+	if payment.PromoCodeID != nil {
+		setClause += ", promo_code = type::record($promo_code_id)"
+		vars["promo_code_id"] = *payment.PromoCodeID
+	}
+
+	results, err := r.db.Query(ctx, "CREATE event_payment SET "+setClause, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*payment = *parseEventPayment(rows[0])
+	return nil
+}
+
+// GetPayment returns a payment by ID, or nil if it doesn't exist
+func (r *PaymentRepository) GetPayment(ctx context.Context, paymentID string) (*model.EventPayment, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": paymentID}
+
+	return r.queryPayment(ctx, query, vars)
+}
+
+// SetCheckoutSession records the checkout session a payment is taken through
+func (r *PaymentRepository) SetCheckoutSession(ctx context.Context, paymentID, sessionID string) error {
+	query := `
+		UPDATE type::record($id) SET
+			checkout_session_id = $session_id,
+			updated_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"id":         paymentID,
+		"session_id": sessionID,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// MarkPaymentPaid marks a pending payment paid. Returns nil if it isn't
+// pending, so a webhook delivered twice is only acted on once.
+func (r *PaymentRepository) MarkPaymentPaid(ctx context.Context, paymentID, paymentIntentID string) (*model.EventPayment, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = "paid",
+			payment_intent_id = $payment_intent_id,
+			paid_on = time::now(),
+			updated_on = time::now()
+		WHERE status = "pending"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":                paymentID,
+		"payment_intent_id": paymentIntentID,
+	}
+
+	return r.queryPayment(ctx, query, vars)
+}
+
+// MarkPaymentExpired marks a pending payment whose checkout expired or
+// failed. Returns nil if it isn't pending.
+func (r *PaymentRepository) MarkPaymentExpired(ctx context.Context, paymentID string) (*model.EventPayment, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = "expired",
+			updated_on = time::now()
+		WHERE status = "pending"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"id": paymentID}
+
+	return r.queryPayment(ctx, query, vars)
+}
+
+// MarkPaymentRefunded records a paid payment's refund. Returns nil if it
+// isn't paid, such as when it was already refunded.
+func (r *PaymentRepository) MarkPaymentRefunded(ctx context.Context, paymentID, refundID string) (*model.EventPayment, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = "refunded",
+			refund_id = $refund_id,
+			refunded_on = time::now(),
+			updated_on = time::now()
+		WHERE status = "paid"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":        paymentID,
+		"refund_id": refundID,
+	}
+
+	return r.queryPayment(ctx, query, vars)
+}
+
+// GetPaidPayment returns a user's paid payment for an event, or nil if they
+// have none
+func (r *PaymentRepository) GetPaidPayment(ctx context.Context, eventID, userID string) (*model.EventPayment, error) {
+	query := `
+		SELECT * FROM event_payment
+		WHERE event = type::record($event_id) AND user = type::record($user_id) AND status = "paid"
+		ORDER BY paid_on DESC
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"event_id": eventID,
+		"user_id":  userID,
+	}
+
+	return r.queryPayment(ctx, query, vars)
+}
+
+// GetPaidPaymentsByEvent returns every paid payment for an event
+func (r *PaymentRepository) GetPaidPaymentsByEvent(ctx context.Context, eventID string) ([]*model.EventPayment, error) {
+	query := `
+		SELECT * FROM event_payment
+		WHERE event = type::record($event_id) AND status = "paid"
+		ORDER BY paid_on ASC
+	`
+	vars := map[string]interface{}{"event_id": eventID}
+
+	return r.queryPayments(ctx, query, vars)
+}
+
+// GetSettledPaymentsByEvent returns an event's paid and refunded payments,
+// for sales reporting
+func (r *PaymentRepository) GetSettledPaymentsByEvent(ctx context.Context, eventID string) ([]*model.EventPayment, error) {
+	query := `
+		SELECT * FROM event_payment
+		WHERE event = type::record($event_id) AND status IN ["paid", "refunded"]
+		ORDER BY paid_on ASC
+	`
+	vars := map[string]interface{}{"event_id": eventID}
+
+	return r.queryPayments(ctx, query, vars)
+}
+
+// queryPayment runs a query returning at most one payment
+func (r *PaymentRepository) queryPayment(ctx context.Context, query string, vars map[string]interface{}) (*model.EventPayment, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseEventPayment(rows[0]), nil
+}
+
+// queryPayments runs a query returning payments
+func (r *PaymentRepository) queryPayments(ctx context.Context, query string, vars map[string]interface{}) ([]*model.EventPayment, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	payments := make([]*model.EventPayment, 0, len(rows))
+	for _, row := range rows {
+		payments = append(payments, parseEventPayment(row))
+	}
+	return payments, nil
+}
+
+func parseEventPayment(data map[string]interface{}) *model.EventPayment {
+	payment := &model.EventPayment{
+		ID:                convertSurrealID(data["id"]),
+		EventID:           convertSurrealID(data["event"]),
+		UserID:            convertSurrealID(data["user"]),
+		TicketID:          convertSurrealID(data["ticket"]),
+		Amount:            getInt(data, "amount"),
+		Discount:          getInt(data, "discount"),
+		DiscountSource:    getString(data, "discount_source"),
+		Currency:          getString(data, "currency"),
+		Status:            getString(data, "status"),
+		CheckoutSessionID: getStringPtr(data, "checkout_session_id"),
+		PaymentIntentID:   getStringPtr(data, "payment_intent_id"),
+		RefundID:          getStringPtr(data, "refund_id"),
+		PaidOn:            getTime(data, "paid_on"),
+		RefundedOn:        getTime(data, "refunded_on"),
+	}
+	if promo := convertSurrealID(data["promo_code"]); promo != "" {
+		payment.PromoCodeID = &promo
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		payment.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		payment.UpdatedOn = *t
+	}
+	return payment
+}
+
+// ============================================================================
+// Payout Accounts
+// ============================================================================
+
+// GetPayoutAccount returns a user's payout account, or nil if they haven't
+// linked one
+func (r *PaymentRepository) GetPayoutAccount(ctx context.Context, userID string) (*model.PayoutAccount, error) {
+	query := `SELECT * FROM payout_account WHERE user = type::record($user_id) LIMIT 1`
+	vars := map[string]interface{}{"user_id": userID}
+
+	return r.queryPayoutAccount(ctx, query, vars)
+}
+
+// CreatePayoutAccount links a provider account to a user
+func (r *PaymentRepository) CreatePayoutAccount(ctx context.Context, account *model.PayoutAccount) error {
+	query := `
+		CREATE payout_account SET
+			user = type::record($user_id),
+			account_id = $account_id,
+			charges_enabled = $charges_enabled,
+			payouts_enabled = $payouts_enabled,
+			details_submitted = $details_submitted,
+			created_on = time::now(),
+			updated_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"user_id":           account.UserID,
+		"account_id":        account.AccountID,
+		"charges_enabled":   account.ChargesEnabled,
+		"payouts_enabled":   account.PayoutsEnabled,
+		"details_submitted": account.DetailsSubmitted,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*account = *parsePayoutAccount(rows[0])
+	return nil
+}
+
+// UpdatePayoutAccount records a provider account's current capabilities.
+// Returns nil if no user has linked the account.
+func (r *PaymentRepository) UpdatePayoutAccount(ctx context.Context, accountID string, chargesEnabled, payoutsEnabled, detailsSubmitted bool) (*model.PayoutAccount, error) {
+	query := `
+		UPDATE payout_account SET
+			charges_enabled = $charges_enabled,
+			payouts_enabled = $payouts_enabled,
+			details_submitted = $details_submitted,
+			updated_on = time::now()
+		WHERE account_id = $account_id
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"account_id":        accountID,
+		"charges_enabled":   chargesEnabled,
+		"payouts_enabled":   payoutsEnabled,
+		"details_submitted": detailsSubmitted,
+	}
+
+	return r.queryPayoutAccount(ctx, query, vars)
+}
+
+// queryPayoutAccount runs a query returning at most one payout account
+func (r *PaymentRepository) queryPayoutAccount(ctx context.Context, query string, vars map[string]interface{}) (*model.PayoutAccount, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parsePayoutAccount(rows[0]), nil
+}
+
+func parsePayoutAccount(data map[string]interface{}) *model.PayoutAccount {
+	account := &model.PayoutAccount{
+		ID:               convertSurrealID(data["id"]),
+		UserID:           convertSurrealID(data["user"]),
+		AccountID:        getString(data, "account_id"),
+		ChargesEnabled:   getBool(data, "charges_enabled"),
+		PayoutsEnabled:   getBool(data, "payouts_enabled"),
+		DetailsSubmitted: getBool(data, "details_submitted"),
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		account.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		account.UpdatedOn = *t
+	}
+	return account
+}
@@ -27,12 +27,12 @@ func (r *PoolRepository) CreatePool(ctx context.Context, pool *model.MatchingPoo
 	// Build query dynamically to avoid NULL vs NONE issues for optional fields
 	setClause := `guild_id = type::record($guild_id), name = $name, frequency = $frequency, match_size = $match_size, next_match_on = $next_match_on, active = true, created_by = type::record($created_by), created_on = time::now(), updated_on = time::now()`
 	vars := map[string]interface{}{
-		"guild_id":      pool.GuildID,
+		"guild_id":      string(pool.GuildID),
 		"name":          pool.Name,
 		"frequency":     pool.Frequency,
 		"match_size":    pool.MatchSize,
 		"next_match_on": pool.NextMatchOn,
-		"created_by":    pool.CreatedBy,
+		"created_by":    string(pool.CreatedBy),
 	}
 
 	// Only include optional fields if provided
@@ -44,6 +44,10 @@ func (r *PoolRepository) CreatePool(ctx context.Context, pool *model.MatchingPoo
 		setClause += ", activity_suggestion = $activity_suggestion"
 		vars["activity_suggestion"] = *pool.ActivitySuggestion
 	}
+	if pool.MinTier != nil {
+		setClause += ", min_tier = $min_tier"
+		vars["min_tier"] = *pool.MinTier
+	}
 
 	query := "CREATE matching_pool SET " + setClause
 	result, err := r.db.Query(ctx, query, vars)
@@ -56,7 +60,7 @@ func (r *PoolRepository) CreatePool(ctx context.Context, pool *model.MatchingPoo
 		return fmt.Errorf("failed to extract created pool: %w", err)
 	}
 
-	pool.ID = created.ID
+	pool.ID = model.PoolID(created.ID)
 	pool.CreatedOn = created.CreatedOn
 	pool.UpdatedOn = created.UpdatedOn
 	pool.Active = true
@@ -68,7 +72,7 @@ func (r *PoolRepository) GetPool(ctx context.Context, poolID string) (*model.Mat
 	query := `
 		SELECT *,
 			(SELECT count() FROM pool_member WHERE pool_id = $parent.id AND active = true GROUP ALL)[0].count AS member_count
-		FROM matching_pool WHERE id = type::record($id)
+		FROM matching_pool WHERE id = type::record($id) AND deleted_at = NONE
 	`
 	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"id": poolID})
 	if err != nil {
@@ -87,7 +91,7 @@ func (r *PoolRepository) GetPoolsByGuild(ctx context.Context, guildID string) ([
 		SELECT *,
 			(SELECT count() FROM pool_member WHERE pool_id = $parent.id AND active = true GROUP ALL)[0].count AS member_count
 		FROM matching_pool
-		WHERE guild_id = $guild_id
+		WHERE guild_id = $guild_id AND deleted_at = NONE
 		ORDER BY created_on DESC
 	`
 	result, err := r.db.Query(ctx, query, map[string]interface{}{"guild_id": guildID})
@@ -100,28 +104,22 @@ func (r *PoolRepository) GetPoolsByGuild(ctx context.Context, guildID string) ([
 
 // UpdatePool updates a pool
 func (r *PoolRepository) UpdatePool(ctx context.Context, poolID string, updates map[string]interface{}) (*model.MatchingPool, error) {
-	updates["updated_on"] = time.Now()
+	query := `UPDATE type::record($id) SET updated_on = time::now()`
+	vars := map[string]interface{}{"id": poolID}
 
-	// Build dynamic SET clause
-	setClause := ""
-	for key := range updates {
-		if setClause != "" {
-			setClause += ", "
-		}
-		setClause += fmt.Sprintf("%s = $%s", key, key)
+	// A nil value clears the field
+	for key, value := range updates {
+		query += ", " + setClause(key, value, vars)
 	}
 
-	query := fmt.Sprintf("UPDATE type::record($id) SET %s", setClause)
-	updates["id"] = poolID
-
-	if err := r.db.Execute(ctx, query, updates); err != nil {
+	if err := r.db.Execute(ctx, query, vars); err != nil {
 		return nil, fmt.Errorf("failed to update pool: %w", err)
 	}
 
 	return r.GetPool(ctx, poolID)
 }
 
-// DeletePool deletes a pool and its memberships
+// DeletePool permanently deletes a pool with its memberships and matches
 func (r *PoolRepository) DeletePool(ctx context.Context, poolID string) error {
 	queries := []string{
 		`DELETE pool_member WHERE pool_id = type::record($pool_id)`,
@@ -137,6 +135,51 @@ func (r *PoolRepository) DeletePool(ctx context.Context, poolID string) error {
 	return nil
 }
 
+// MoveToTrash moves a pool to its guild's trash. Memberships and matches are
+// kept until the pool is purged. Returns false if the pool doesn't exist or
+// is already in the trash.
+func (r *PoolRepository) MoveToTrash(ctx context.Context, poolID, userID string) (bool, error) {
+	moved, err := moveToTrash(ctx, r.db, poolID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to trash pool: %w", err)
+	}
+	return moved, nil
+}
+
+// GetTrashByGuild lists a guild's trashed pools
+func (r *PoolRepository) GetTrashByGuild(ctx context.Context, guildID string) ([]*model.TrashItem, error) {
+	items, err := getTrashByGuild(ctx, r.db, "matching_pool", model.TrashItemPool, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trashed pools: %w", err)
+	}
+	return items, nil
+}
+
+// RestoreFromTrash restores a trashed pool of the guild. Returns nil if the
+// pool isn't in the guild's trash.
+func (r *PoolRepository) RestoreFromTrash(ctx context.Context, poolID, guildID string) (*model.TrashItem, error) {
+	item, err := restoreFromTrash(ctx, r.db, model.TrashItemPool, poolID, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore pool: %w", err)
+	}
+	return item, nil
+}
+
+// PurgeTrashed permanently deletes pools trashed at or before the cutoff.
+// Returns how many were deleted.
+func (r *PoolRepository) PurgeTrashed(ctx context.Context, before time.Time) (int, error) {
+	ids, err := getTrashedBefore(ctx, r.db, "matching_pool", before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get trashed pools: %w", err)
+	}
+	for i, id := range ids {
+		if err := r.DeletePool(ctx, id); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
+
 // AddMember adds a member to a pool
 func (r *PoolRepository) AddMember(ctx context.Context, member *model.PoolMember) error {
 	query := `
@@ -150,10 +193,10 @@ func (r *PoolRepository) AddMember(ctx context.Context, member *model.PoolMember
 		}
 	`
 	result, err := r.db.Query(ctx, query, map[string]interface{}{
-		"pool_id":          member.PoolID,
-		"member_id":        member.MemberID,
-		"user_id":          member.UserID,
-		"excluded_members": member.ExcludedMembers,
+		"pool_id":          string(member.PoolID),
+		"member_id":        string(member.MemberID),
+		"user_id":          string(member.UserID),
+		"excluded_members": model.IDStrings(member.ExcludedMembers),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to add member: %w", err)
@@ -164,7 +207,7 @@ func (r *PoolRepository) AddMember(ctx context.Context, member *model.PoolMember
 		return fmt.Errorf("failed to extract created member: %w", err)
 	}
 
-	member.ID = created.ID
+	member.ID = model.PoolMemberID(created.ID)
 	member.JoinedOn = created.CreatedOn
 	member.Active = true
 	return nil
@@ -273,9 +316,9 @@ func (r *PoolRepository) CreateMatchResult(ctx context.Context, match *model.Mat
 		}
 	`
 	result, err := r.db.Query(ctx, query, map[string]interface{}{
-		"pool_id":         match.PoolID,
-		"members":         match.Members,
-		"member_user_ids": match.MemberUserIDs,
+		"pool_id":         string(match.PoolID),
+		"members":         model.IDStrings(match.Members),
+		"member_user_ids": model.IDStrings(match.MemberUserIDs),
 		"status":          match.Status,
 		"match_round":     match.MatchRound,
 	})
@@ -288,7 +331,7 @@ func (r *PoolRepository) CreateMatchResult(ctx context.Context, match *model.Mat
 		return fmt.Errorf("failed to extract created match: %w", err)
 	}
 
-	match.ID = created.ID
+	match.ID = model.MatchID(created.ID)
 	match.CreatedOn = created.CreatedOn
 	match.UpdatedOn = created.UpdatedOn
 	return nil
@@ -384,7 +427,7 @@ func (r *PoolRepository) GetRecentMatchesBetween(ctx context.Context, memberIDs
 	// Filter to matches containing all specified members
 	var filteredMatches []*model.MatchResult
 	for _, match := range allMatches {
-		if containsAllMembers(match.Members, memberIDs) {
+		if containsAllMembers(model.IDStrings(match.Members), memberIDs) {
 			filteredMatches = append(filteredMatches, match)
 		}
 	}
@@ -419,7 +462,7 @@ func (r *PoolRepository) UpdateMatchResult(ctx context.Context, matchID string,
 func (r *PoolRepository) GetPoolsDueForMatching(ctx context.Context) ([]*model.MatchingPool, error) {
 	query := `
 		SELECT * FROM matching_pool
-		WHERE active = true AND next_match_on <= time::now()
+		WHERE active = true AND next_match_on <= time::now() AND deleted_at = NONE
 		ORDER BY next_match_on ASC
 	`
 	result, err := r.db.Query(ctx, query, nil)
@@ -447,7 +490,7 @@ func (r *PoolRepository) GetUserPoolMemberships(ctx context.Context, userID stri
 
 // CountPoolsByGuild returns the number of pools in a guild
 func (r *PoolRepository) CountPoolsByGuild(ctx context.Context, guildID string) (int, error) {
-	query := `SELECT count() AS count FROM matching_pool WHERE guild_id = $guild_id GROUP ALL`
+	query := `SELECT count() AS count FROM matching_pool WHERE guild_id = $guild_id AND deleted_at = NONE GROUP ALL`
 	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"guild_id": guildID})
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
@@ -497,7 +540,7 @@ func (r *PoolRepository) GetPoolStats(ctx context.Context, poolID string) (*mode
 	}
 
 	return &model.PoolStats{
-		PoolID:           poolID,
+		PoolID:           model.PoolID(poolID),
 		TotalMembers:     total,
 		ActiveMembers:    active,
 		TotalRounds:      rounds,
@@ -4,25 +4,39 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"time"
 
 	"github.com/forgo/saga/api/internal/database"
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/fieldcrypt"
+)
+
+const (
+	// locationSealContext names the field precise coordinates are sealed for
+	locationSealContext = "user_profile.location"
+	// coarseLocationStep is the precision of coordinates stored unencrypted
+	// alongside sealed ones (0.01 degrees, about 1km), kept for area queries
+	coarseLocationStep = 0.01
 )
 
 // ProfileRepository handles user profile data access
 type ProfileRepository struct {
-	db database.Database
+	db     database.Database
+	fields *fieldcrypt.Keyring
 }
 
-// NewProfileRepository creates a new profile repository
-func NewProfileRepository(db database.Database) *ProfileRepository {
-	return &ProfileRepository{db: db}
+// NewProfileRepository creates a new profile repository. Precise locations
+// are encrypted with fields; when it is nil they are stored unencrypted.
+func NewProfileRepository(db database.Database, fields *fieldcrypt.Keyring) *ProfileRepository {
+	return &ProfileRepository{db: db, fields: fields}
 }
 
 // Create creates a new user profile
 func (r *ProfileRepository) Create(ctx context.Context, profile *model.UserProfile) error {
 	// Build query dynamically to avoid NULL vs NONE issues for optional fields
-	setClause := `user = type::record($user_id), visibility = $visibility, created_on = time::now(), updated_on = time::now()`
+	setClause := `user = type::record($user_id), visibility = $visibility, version = 1, created_on = time::now(), updated_on = time::now()`
 	vars := map[string]interface{}{
 		"user_id":    profile.UserID,
 		"visibility": profile.Visibility,
@@ -50,14 +64,22 @@ func (r *ProfileRepository) Create(ctx context.Context, profile *model.UserProfi
 		vars["timezone"] = *profile.Timezone
 	}
 	if profile.Location != nil {
-		setClause += ", location = $location"
-		vars["location"] = map[string]interface{}{
+		location, sealed, err := r.storedLocation(map[string]interface{}{
 			"lat":          profile.Location.Lat,
 			"lng":          profile.Location.Lng,
 			"city":         profile.Location.City,
 			"neighborhood": profile.Location.Neighborhood,
 			"country":      profile.Location.Country,
 			"country_code": profile.Location.CountryCode,
+		})
+		if err != nil {
+			return err
+		}
+		setClause += ", location = $location"
+		vars["location"] = location
+		if sealed != nil {
+			setClause += ", location_sealed = $location_sealed"
+			vars["location_sealed"] = sealed
 		}
 	}
 
@@ -76,6 +98,7 @@ func (r *ProfileRepository) Create(ctx context.Context, profile *model.UserProfi
 	profile.ID = created.ID
 	profile.CreatedOn = created.CreatedOn
 	profile.UpdatedOn = created.UpdatedOn
+	profile.Version = 1
 	return nil
 }
 
@@ -95,57 +118,81 @@ func (r *ProfileRepository) GetByUserID(ctx context.Context, userID string) (*mo
 	return r.parseProfileResult(result)
 }
 
-// Update updates a user profile
-func (r *ProfileRepository) Update(ctx context.Context, userID string, updates map[string]interface{}) (*model.UserProfile, error) {
+// Update updates a user profile and bumps its version. When version is given
+// the update only applies if the profile is still at that version; returns
+// nil if it isn't or the profile doesn't exist.
+func (r *ProfileRepository) Update(ctx context.Context, userID string, updates map[string]interface{}, version *int) (*model.UserProfile, error) {
 	// Build dynamic update query
-	query := `UPDATE user_profile SET updated_on = time::now()`
+	query := `UPDATE user_profile SET updated_on = time::now(), version += 1`
 
 	vars := map[string]interface{}{
 		"user_id": userID,
 	}
 
 	if bio, ok := updates["bio"]; ok {
-		query += ", bio = $bio"
-		vars["bio"] = bio
+		query += ", " + setClause("bio", bio, vars)
 	}
 	if tagline, ok := updates["tagline"]; ok {
-		query += ", tagline = $tagline"
-		vars["tagline"] = tagline
+		query += ", " + setClause("tagline", tagline, vars)
+	}
+	if photoURL, ok := updates["photo_url"]; ok {
+		query += ", " + setClause("photo_url", photoURL, vars)
 	}
 	if languages, ok := updates["languages"]; ok {
-		query += ", languages = $languages"
-		vars["languages"] = languages
+		query += ", " + setClause("languages", languages, vars)
 	}
 	if timezone, ok := updates["timezone"]; ok {
-		query += ", timezone = $timezone"
-		vars["timezone"] = timezone
+		query += ", " + setClause("timezone", timezone, vars)
 	}
 	if location, ok := updates["location"]; ok {
-		query += ", location = $location"
-		vars["location"] = location
+		var sealed interface{}
+		if loc, ok := location.(map[string]interface{}); ok {
+			var err error
+			if location, sealed, err = r.storedLocation(loc); err != nil {
+				return nil, err
+			}
+		}
+		query += ", " + setClause("location", location, vars)
+		query += ", " + setClause("location_sealed", sealed, vars)
 	}
 	if visibility, ok := updates["visibility"]; ok {
-		query += ", visibility = $visibility"
-		vars["visibility"] = visibility
+		query += ", " + setClause("visibility", visibility, vars)
+	}
+	if shareEnabled, ok := updates["share_enabled"]; ok {
+		query += ", " + setClause("share_enabled", shareEnabled, vars)
+	}
+	if needs, ok := updates["accessibility_needs"]; ok {
+		query += ", " + setClause("accessibility_needs", needs, vars)
+	}
+	if shareAccessibility, ok := updates["share_accessibility"]; ok {
+		query += ", " + setClause("share_accessibility", shareAccessibility, vars)
 	}
 	if discoveryEligible, ok := updates["discovery_eligible"]; ok {
-		query += ", discovery_eligible = $discovery_eligible"
-		vars["discovery_eligible"] = discoveryEligible
+		query += ", " + setClause("discovery_eligible", discoveryEligible, vars)
 	}
 
-	query += ` WHERE user = type::record($user_id) RETURN AFTER`
+	query += ` WHERE user = type::record($user_id)`
+	if version != nil {
+		query += ` AND version = $version`
+		vars["version"] = *version
+	}
+	query += ` RETURN AFTER`
 
 	result, err := r.db.QueryOne(ctx, query, vars)
 	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 
 	return r.parseProfileResult(result)
 }
 
-// UpdateLastActive updates the last active timestamp
+// UpdateLastActive updates the last active timestamp. It leaves updated_on
+// alone, since it tracks profile edits.
 func (r *ProfileRepository) UpdateLastActive(ctx context.Context, userID string) error {
-	query := `UPDATE user_profile SET last_active = time::now(), updated_on = time::now() WHERE user = type::record($user_id)`
+	query := `UPDATE user_profile SET last_active = time::now() WHERE user = type::record($user_id)`
 	vars := map[string]interface{}{"user_id": userID}
 
 	return r.db.Execute(ctx, query, vars)
@@ -159,8 +206,22 @@ func (r *ProfileRepository) Delete(ctx context.Context, userID string) error {
 	return r.db.Execute(ctx, query, vars)
 }
 
-// GetNearby finds profiles within a bounding box (for initial filtering)
+// GetNearby finds profiles within a bounding box (for initial filtering),
+// of users who allow their location to be used.
+// When precise locations are encrypted the box is widened by half the coarse
+// step, so rounding never drops a profile that is inside it.
 func (r *ProfileRepository) GetNearby(ctx context.Context, minLat, maxLat, minLng, maxLng float64, limit int) ([]*model.UserProfile, error) {
+	if r.fields != nil {
+		minLat, maxLat = minLat-coarseLocationStep/2, maxLat+coarseLocationStep/2
+		minLng, maxLng = minLng-coarseLocationStep/2, maxLng+coarseLocationStep/2
+	}
+	vars := map[string]interface{}{
+		"min_lat": minLat,
+		"max_lat": maxLat,
+		"min_lng": minLng,
+		"max_lng": maxLng,
+		"limit":   limit,
+	}
 	query := `
 		SELECT * FROM user_profile
 		WHERE location != NONE
@@ -169,16 +230,33 @@ func (r *ProfileRepository) GetNearby(ctx context.Context, minLat, maxLat, minLn
 			AND location.lng >= $min_lng
 			AND location.lng <= $max_lng
 			AND visibility != "private"
+			AND ` + consentGrantedCondition("$parent.user", model.ConsentLocationProcessing, vars) + `
 		ORDER BY last_active DESC
 		LIMIT $limit
 	`
 
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.parseProfilesResult(result)
+}
+
+// GetSpeakers returns which of userIDs speak at least one of languages
+func (r *ProfileRepository) GetSpeakers(ctx context.Context, userIDs, languages []string) (map[string]bool, error) {
+	speakers := make(map[string]bool)
+	if len(userIDs) == 0 || len(languages) == 0 {
+		return speakers, nil
+	}
+
+	query := `
+		SELECT user FROM user_profile
+		WHERE languages CONTAINSANY $languages AND <string> user IN $user_ids
+	`
 	vars := map[string]interface{}{
-		"min_lat": minLat,
-		"max_lat": maxLat,
-		"min_lng": minLng,
-		"max_lng": maxLng,
-		"limit":   limit,
+		"languages": languages,
+		"user_ids":  userIDs,
 	}
 
 	result, err := r.db.Query(ctx, query, vars)
@@ -186,7 +264,12 @@ func (r *ProfileRepository) GetNearby(ctx context.Context, minLat, maxLat, minLn
 		return nil, err
 	}
 
-	return r.parseProfilesResult(result)
+	for _, row := range flattenResults(result) {
+		if row["user"] != nil {
+			speakers[convertSurrealID(row["user"])] = true
+		}
+	}
+	return speakers, nil
 }
 
 // GetByVisibility finds profiles with a specific visibility setting
@@ -213,6 +296,70 @@ func (r *ProfileRepository) GetByVisibility(ctx context.Context, visibility stri
 
 // Helper functions
 
+// GetCompletenessNudgeCandidates returns incomplete profiles created before
+// createdBefore that haven't been nudged since nudgedBefore and have had fewer
+// than maxNudges completeness nudges, least recently nudged first
+func (r *ProfileRepository) GetCompletenessNudgeCandidates(ctx context.Context, createdBefore, nudgedBefore time.Time, maxNudges, limit int) ([]*model.UserProfile, error) {
+	query := `
+		SELECT * FROM user_profile
+		WHERE created_on < $created_before
+			AND profile_completion_score < 1.0
+			AND completeness_nudges < $max_nudges
+			AND (completeness_nudged_on = NONE OR completeness_nudged_on < $nudged_before)
+		ORDER BY completeness_nudged_on ASC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"created_before": createdBefore,
+		"nudged_before":  nudgedBefore,
+		"max_nudges":     maxNudges,
+		"limit":          limit,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.parseProfilesResult(result)
+}
+
+// SetCompletionScore stores the profile's completeness (0-1). It is derived
+// data, so the profile version is left alone.
+func (r *ProfileRepository) SetCompletionScore(ctx context.Context, userID string, score float64) error {
+	query := `UPDATE user_profile SET profile_completion_score = $score WHERE user = type::record($user_id)`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"score":   score,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// ClaimCompletenessNudge records a completeness nudge for the user unless one
+// was already recorded since nudgedBefore. Returns false if another run got
+// there first.
+func (r *ProfileRepository) ClaimCompletenessNudge(ctx context.Context, userID string, nudgedBefore time.Time) (bool, error) {
+	query := `
+		UPDATE user_profile SET
+			completeness_nudged_on = time::now(),
+			completeness_nudges += 1
+		WHERE user = type::record($user_id)
+			AND (completeness_nudged_on = NONE OR completeness_nudged_on < $nudged_before)
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"user_id":       userID,
+		"nudged_before": nudgedBefore,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
 func (r *ProfileRepository) parseProfileResult(result interface{}) (*model.UserProfile, error) {
 	if result == nil {
 		return nil, database.ErrNotFound
@@ -280,6 +427,11 @@ func (r *ProfileRepository) parseProfileResult(result interface{}) (*model.UserP
 		if lng, ok := locData["lng"].(float64); ok {
 			profile.Location.Lng = lng
 		}
+		lat, lng, err := r.openLocation(data, profile.Location.Lat, profile.Location.Lng)
+		if err != nil {
+			return nil, err
+		}
+		profile.Location.Lat, profile.Location.Lng = lat, lng
 	}
 
 	return &profile, nil
@@ -315,7 +467,7 @@ func (r *ProfileRepository) parseProfilesResult(result []interface{}) ([]*model.
 
 // GetLocationInternal returns the internal location data (with coordinates) for calculations
 func (r *ProfileRepository) GetLocationInternal(ctx context.Context, userID string) (*model.LocationInternal, error) {
-	query := `SELECT location FROM user_profile WHERE user = type::record($user_id) LIMIT 1`
+	query := `SELECT location, location_sealed FROM user_profile WHERE user = type::record($user_id) LIMIT 1`
 	vars := map[string]interface{}{"user_id": userID}
 
 	result, err := r.db.QueryOne(ctx, query, vars)
@@ -337,9 +489,14 @@ func (r *ProfileRepository) GetLocationInternal(ctx context.Context, userID stri
 		return nil, nil
 	}
 
+	lat, lng, err := r.openLocation(data, getFloat(locData, "lat"), getFloat(locData, "lng"))
+	if err != nil {
+		return nil, err
+	}
+
 	location := &model.LocationInternal{
-		Lat:         getFloat(locData, "lat"),
-		Lng:         getFloat(locData, "lng"),
+		Lat:         lat,
+		Lng:         lng,
 		City:        getString(locData, "city"),
 		Country:     getString(locData, "country"),
 		CountryCode: getString(locData, "country_code"),
@@ -352,4 +509,116 @@ func (r *ProfileRepository) GetLocationInternal(ctx context.Context, userID stri
 	return location, nil
 }
 
+// sealedCoordinates is what is encrypted in location_sealed
+type sealedCoordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// storedLocation splits a location for storage. With a keyring the precise
+// coordinates are sealed and the location keeps coarse ones; without one the
+// location is stored as is and sealed is nil.
+func (r *ProfileRepository) storedLocation(loc map[string]interface{}) (map[string]interface{}, interface{}, error) {
+	if r.fields == nil {
+		return loc, nil, nil
+	}
+	coords := sealedCoordinates{Lat: getFloat(loc, "lat"), Lng: getFloat(loc, "lng")}
+	plaintext, err := json.Marshal(coords)
+	if err != nil {
+		return nil, nil, err
+	}
+	sealed, err := r.fields.Seal(plaintext, locationSealContext)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored := make(map[string]interface{}, len(loc))
+	for k, v := range loc {
+		stored[k] = v
+	}
+	stored["lat"] = coarsen(coords.Lat)
+	stored["lng"] = coarsen(coords.Lng)
+	return stored, sealed, nil
+}
+
+// openLocation returns a row's precise coordinates: decrypted from
+// location_sealed when present, otherwise the lat and lng given, which are
+// coarse if the row is sealed but no keyring is configured
+func (r *ProfileRepository) openLocation(data map[string]interface{}, lat, lng float64) (float64, float64, error) {
+	sealed, ok := data["location_sealed"].(string)
+	if !ok || sealed == "" || r.fields == nil {
+		return lat, lng, nil
+	}
+	plaintext, err := r.fields.Open(sealed, locationSealContext)
+	if err != nil {
+		return 0, 0, fmt.Errorf("opening precise location: %w", err)
+	}
+	var coords sealedCoordinates
+	if err := json.Unmarshal(plaintext, &coords); err != nil {
+		return 0, 0, fmt.Errorf("opening precise location: %w", err)
+	}
+	return coords.Lat, coords.Lng, nil
+}
+
+// coarsen rounds a coordinate to the coarse step
+func coarsen(v float64) float64 {
+	return math.Round(v/coarseLocationStep) / (1 / coarseLocationStep)
+}
+
+// ResealLocations seals precise locations that are stored unencrypted or
+// with a key other than the primary one, up to limit rows per call. Returns
+// how many rows were resealed; call until it returns 0. Requires a keyring.
+func (r *ProfileRepository) ResealLocations(ctx context.Context, limit int) (int, error) {
+	if r.fields == nil {
+		return 0, fieldcrypt.ErrNoKeys
+	}
+
+	query := `
+		SELECT id, location, location_sealed FROM user_profile
+		WHERE location != NONE
+			AND location.lat != NONE
+			AND (location_sealed = NONE OR !string::starts_with(location_sealed, $sealed_prefix))
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"sealed_prefix": r.fields.SealedPrefix(),
+		"limit":         limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	resealed := 0
+	for _, row := range flattenResults(results) {
+		id := convertSurrealID(row["id"])
+		loc, ok := row["location"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		lat, lng, err := r.openLocation(row, getFloat(loc, "lat"), getFloat(loc, "lng"))
+		if err != nil {
+			return resealed, fmt.Errorf("profile %s: %w", id, err)
+		}
+		loc["lat"], loc["lng"] = lat, lng
+
+		location, sealed, err := r.storedLocation(loc)
+		if err != nil {
+			return resealed, err
+		}
+		update := `UPDATE type::record($id) SET location = $location, location_sealed = $location_sealed`
+		if err := r.db.Execute(ctx, update, map[string]interface{}{
+			"id":              id,
+			"location":        location,
+			"location_sealed": sealed,
+		}); err != nil {
+			return resealed, err
+		}
+		resealed++
+	}
+
+	return resealed, nil
+}
+
 // Helpers getString, getFloat, getTime, getBool, getStringSlice, getInt are defined in helpers.go
@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Promo Codes
+// ============================================================================
+
+// CreatePromoCode creates a promo code for an event. Returns ErrDuplicate
+// if the event already has the code.
+func (r *PaymentRepository) CreatePromoCode(ctx context.Context, promo *model.PromoCode) error {
+	setClause := `
+		event = type::record($event_id),
+		code = $code,
+		discount_type = $discount_type,
+		amount = $amount,
+		redemptions = 0,
+		active = true,
+		created_by = type::record($created_by),
+		created_on = time::now(),
+		updated_on = time::now()`
+	vars := map[string]interface{}{
+		"event_id":      promo.EventID,
+		"code":          promo.Code,
+		"discount_type": promo.DiscountType,
+		"amount":        promo.Amount,
+		"created_by":    promo.CreatedBy,
+	}
+
+	if promo.MaxRedemptions != nil {
+		setClause += ", max_redemptions = $max_redemptions"
+		vars["max_redemptions"] = *promo.MaxRedemptions
+	}
+	if promo.ExpiresOn != nil {
+		setClause += ", expires_on = $expires_on"
+		vars["expires_on"] = *promo.ExpiresOn
+	}
+
+	results, err := r.db.Query(ctx, "CREATE promo_code SET "+setClause, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: promo code already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*promo = *parsePromoCode(rows[0])
+	return nil
+}
+
+// GetPromoCode returns a promo code by ID, or nil if it doesn't exist
+func (r *PaymentRepository) GetPromoCode(ctx context.Context, promoID string) (*model.PromoCode, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": promoID}
+
+	return r.queryPromoCode(ctx, query, vars)
+}
+
+// GetPromoCodeByCode returns an event's promo code by its code, or nil if
+// the event has no such code
+func (r *PaymentRepository) GetPromoCodeByCode(ctx context.Context, eventID, code string) (*model.PromoCode, error) {
+	query := `SELECT * FROM promo_code WHERE event = type::record($event_id) AND code = $code LIMIT 1`
+	vars := map[string]interface{}{
+		"event_id": eventID,
+		"code":     code,
+	}
+
+	return r.queryPromoCode(ctx, query, vars)
+}
+
+// ListPromoCodes returns an event's promo codes, newest first
+func (r *PaymentRepository) ListPromoCodes(ctx context.Context, eventID string) ([]*model.PromoCode, error) {
+	query := `SELECT * FROM promo_code WHERE event = type::record($event_id) ORDER BY created_on DESC`
+	vars := map[string]interface{}{"event_id": eventID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	promos := make([]*model.PromoCode, 0, len(rows))
+	for _, row := range rows {
+		promos = append(promos, parsePromoCode(row))
+	}
+	return promos, nil
+}
+
+// CountPromoCodes returns how many promo codes an event has
+func (r *PaymentRepository) CountPromoCodes(ctx context.Context, eventID string) (int, error) {
+	query := `SELECT count() AS count FROM promo_code WHERE event = type::record($event_id) GROUP ALL`
+	vars := map[string]interface{}{"event_id": eventID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "count"), nil
+}
+
+// UpdatePromoCode applies updates to a promo code. Returns nil if it
+// doesn't exist.
+func (r *PaymentRepository) UpdatePromoCode(ctx context.Context, promoID string, updates map[string]interface{}) (*model.PromoCode, error) {
+	query := `UPDATE promo_code SET updated_on = time::now()`
+	vars := map[string]interface{}{"id": promoID}
+
+	for key, value := range updates {
+		query += ", " + setClause(key, value, vars)
+	}
+	query += ` WHERE id = type::record($id) RETURN AFTER`
+
+	return r.queryPromoCode(ctx, query, vars)
+}
+
+// DeletePromoCode deletes a promo code no one has redeemed. Returns false
+// if it has been redeemed or doesn't exist.
+func (r *PaymentRepository) DeletePromoCode(ctx context.Context, promoID string) (bool, error) {
+	query := `DELETE type::record($id) WHERE redemptions = 0 RETURN BEFORE`
+	vars := map[string]interface{}{"id": promoID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// ReservePromoCode takes one of a promo code's redemptions if it is active,
+// unexpired, and under its cap. The check and increment are one statement,
+// so concurrent checkouts can't redeem past the cap. Returns nil if the
+// code can't be redeemed.
+func (r *PaymentRepository) ReservePromoCode(ctx context.Context, promoID string, now time.Time) (*model.PromoCode, error) {
+	query := `
+		UPDATE type::record($id) SET
+			redemptions += 1,
+			updated_on = time::now()
+		WHERE active = true
+			AND (max_redemptions = NONE OR redemptions < max_redemptions)
+			AND (expires_on = NONE OR expires_on > $now)
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":  promoID,
+		"now": now,
+	}
+
+	return r.queryPromoCode(ctx, query, vars)
+}
+
+// ReleasePromoCode gives back a redemption reserved by a checkout that
+// didn't complete
+func (r *PaymentRepository) ReleasePromoCode(ctx context.Context, promoID string) error {
+	query := `
+		UPDATE type::record($id) SET
+			redemptions -= 1,
+			updated_on = time::now()
+		WHERE redemptions > 0
+	`
+	vars := map[string]interface{}{"id": promoID}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// queryPromoCode runs a query returning at most one promo code
+func (r *PaymentRepository) queryPromoCode(ctx context.Context, query string, vars map[string]interface{}) (*model.PromoCode, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parsePromoCode(rows[0]), nil
+}
+
+func parsePromoCode(data map[string]interface{}) *model.PromoCode {
+	promo := &model.PromoCode{
+		ID:           convertSurrealID(data["id"]),
+		EventID:      convertSurrealID(data["event"]),
+		Code:         getString(data, "code"),
+		DiscountType: getString(data, "discount_type"),
+		Amount:       getInt(data, "amount"),
+		Redemptions:  getInt(data, "redemptions"),
+		ExpiresOn:    getTime(data, "expires_on"),
+		Active:       getBool(data, "active"),
+		CreatedBy:    convertSurrealID(data["created_by"]),
+	}
+	if data["max_redemptions"] != nil {
+		limit := getInt(data, "max_redemptions")
+		promo.MaxRedemptions = &limit
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		promo.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		promo.UpdatedOn = *t
+	}
+	return promo
+}
+
+// ============================================================================
+// Member Discounts
+// ============================================================================
+
+// SetMemberDiscounts replaces a guild event's member discounts
+func (r *PaymentRepository) SetMemberDiscounts(ctx context.Context, eventID string, discounts []model.MemberDiscount) error {
+	entries := make([]map[string]interface{}, 0, len(discounts))
+	for _, d := range discounts {
+		entries = append(entries, map[string]interface{}{
+			"role":        string(d.Role),
+			"percent_off": d.PercentOff,
+		})
+	}
+
+	query := `
+		UPDATE type::record($event_id) SET
+			member_discounts = $discounts,
+			updated_on = time::now(),
+			version += 1
+	`
+	vars := map[string]interface{}{
+		"event_id":  eventID,
+		"discounts": entries,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
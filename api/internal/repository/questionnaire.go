@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/forgo/saga/api/internal/database"
 	"github.com/forgo/saga/api/internal/model"
@@ -20,7 +21,7 @@ func NewQuestionnaireRepository(db database.Database) *QuestionnaireRepository {
 
 // GetAllQuestions retrieves all active questions
 func (r *QuestionnaireRepository) GetAllQuestions(ctx context.Context) ([]*model.Question, error) {
-	query := `SELECT * FROM question WHERE active = true AND circle_id = NONE ORDER BY sort_order`
+	query := `SELECT * FROM question WHERE active = true AND guild_id = NONE ORDER BY sort_order`
 
 	result, err := r.db.Query(ctx, query, nil)
 	if err != nil {
@@ -32,7 +33,7 @@ func (r *QuestionnaireRepository) GetAllQuestions(ctx context.Context) ([]*model
 
 // GetQuestionsByCategory retrieves questions by category
 func (r *QuestionnaireRepository) GetQuestionsByCategory(ctx context.Context, category string) ([]*model.Question, error) {
-	query := `SELECT * FROM question WHERE category = $category AND active = true AND circle_id = NONE ORDER BY sort_order`
+	query := `SELECT * FROM question WHERE category = $category AND active = true AND guild_id = NONE ORDER BY sort_order`
 	vars := map[string]interface{}{"category": category}
 
 	result, err := r.db.Query(ctx, query, vars)
@@ -43,10 +44,15 @@ func (r *QuestionnaireRepository) GetQuestionsByCategory(ctx context.Context, ca
 	return r.parseQuestionsResult(result)
 }
 
-// GetCircleQuestions retrieves questions for a specific circle
-func (r *QuestionnaireRepository) GetCircleQuestions(ctx context.Context, circleID string) ([]*model.Question, error) {
-	query := `SELECT * FROM question WHERE circle_id = type::record($circle_id) AND active = true ORDER BY sort_order`
-	vars := map[string]interface{}{"circle_id": circleID}
+// GetGuildQuestions retrieves questions for a specific guild,
+// optionally including inactive (retired) ones
+func (r *QuestionnaireRepository) GetGuildQuestions(ctx context.Context, guildID string, includeInactive bool) ([]*model.Question, error) {
+	query := `SELECT * FROM question WHERE guild_id = type::record($guild_id)`
+	if !includeInactive {
+		query += ` AND active = true`
+	}
+	query += ` ORDER BY sort_order, created_on`
+	vars := map[string]interface{}{"guild_id": guildID}
 
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
@@ -73,8 +79,28 @@ func (r *QuestionnaireRepository) GetQuestionByID(ctx context.Context, id string
 	return r.parseQuestionResult(result)
 }
 
-// CreateQuestion creates a new question (for circle questions)
+// CreateQuestion creates a new global or guild question
 func (r *QuestionnaireRepository) CreateQuestion(ctx context.Context, question *model.Question) error {
+	vars := map[string]interface{}{
+		"text":                    question.Text,
+		"category":                question.Category,
+		"options":                 questionOptionsData(question.Options),
+		"is_dealbreaker_eligible": question.IsDealBreakerEligible,
+		"sort_order":              question.SortOrder,
+	}
+
+	// Record links must be cast; global questions leave them unset
+	guildID := "NONE"
+	if question.GuildID != nil {
+		guildID = "type::record($guild_id)"
+		vars["guild_id"] = *question.GuildID
+	}
+	createdBy := "NONE"
+	if question.CreatedBy != nil {
+		createdBy = "type::record($created_by)"
+		vars["created_by"] = *question.CreatedBy
+	}
+
 	query := `
 		CREATE question CONTENT {
 			text: $text,
@@ -83,32 +109,12 @@ func (r *QuestionnaireRepository) CreateQuestion(ctx context.Context, question *
 			is_dealbreaker_eligible: $is_dealbreaker_eligible,
 			sort_order: $sort_order,
 			active: true,
-			circle_id: $circle_id,
-			created_by: $created_by,
+			guild_id: ` + guildID + `,
+			created_by: ` + createdBy + `,
 			created_on: time::now()
 		}
 	`
 
-	// Convert options to proper format
-	optionsData := make([]map[string]interface{}, len(question.Options))
-	for i, opt := range question.Options {
-		optionsData[i] = map[string]interface{}{
-			"value":         opt.Value,
-			"label":         opt.Label,
-			"implicit_bias": opt.ImplicitBias,
-		}
-	}
-
-	vars := map[string]interface{}{
-		"text":                    question.Text,
-		"category":                question.Category,
-		"options":                 optionsData,
-		"is_dealbreaker_eligible": question.IsDealBreakerEligible,
-		"sort_order":              question.SortOrder,
-		"circle_id":               question.CircleID,
-		"created_by":              question.CreatedBy,
-	}
-
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
 		return err
@@ -124,6 +130,113 @@ func (r *QuestionnaireRepository) CreateQuestion(ctx context.Context, question *
 	return nil
 }
 
+// ListQuestions retrieves global questions for administration,
+// optionally including inactive (deprecated) ones
+func (r *QuestionnaireRepository) ListQuestions(ctx context.Context, includeInactive bool) ([]*model.Question, error) {
+	query := `SELECT * FROM question WHERE guild_id = NONE`
+	if !includeInactive {
+		query += ` AND active = true`
+	}
+	query += ` ORDER BY category, sort_order`
+
+	result, err := r.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.parseQuestionsResult(result)
+}
+
+// UpdateQuestion updates a question.
+// Setting active to false stamps deprecated_on; setting it to true clears it.
+func (r *QuestionnaireRepository) UpdateQuestion(ctx context.Context, id string, updates map[string]interface{}) (*model.Question, error) {
+	query := `UPDATE type::record($id) SET`
+	vars := map[string]interface{}{"id": id}
+	sets := make([]string, 0, len(updates))
+
+	if text, ok := updates["text"]; ok {
+		sets = append(sets, "text = $text")
+		vars["text"] = text
+	}
+	if category, ok := updates["category"]; ok {
+		sets = append(sets, "category = $category")
+		vars["category"] = category
+	}
+	if options, ok := updates["options"].([]model.QuestionOption); ok {
+		sets = append(sets, "options = $options")
+		vars["options"] = questionOptionsData(options)
+	}
+	if eligible, ok := updates["is_dealbreaker_eligible"]; ok {
+		sets = append(sets, "is_dealbreaker_eligible = $is_dealbreaker_eligible")
+		vars["is_dealbreaker_eligible"] = eligible
+	}
+	if sortOrder, ok := updates["sort_order"]; ok {
+		sets = append(sets, "sort_order = $sort_order")
+		vars["sort_order"] = sortOrder
+	}
+	if active, ok := updates["active"].(bool); ok {
+		sets = append(sets, "active = $active")
+		vars["active"] = active
+		if active {
+			sets = append(sets, "deprecated_on = NONE")
+		} else {
+			sets = append(sets, "deprecated_on = time::now()")
+		}
+	}
+
+	if len(sets) == 0 {
+		return r.GetQuestionByID(ctx, id)
+	}
+
+	query += " " + strings.Join(sets, ", ") + " RETURN AFTER"
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.parseQuestionResult(result)
+}
+
+// DeleteQuestion permanently deletes a question
+func (r *QuestionnaireRepository) DeleteQuestion(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	return r.db.Execute(ctx, query, map[string]interface{}{"id": id})
+}
+
+// CountAnswersForQuestion counts how many users answered a question
+func (r *QuestionnaireRepository) CountAnswersForQuestion(ctx context.Context, id string) (int, error) {
+	query := `SELECT count() AS count FROM answer WHERE question = type::record($id) GROUP ALL`
+
+	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"id": id})
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return extractCount(result), nil
+}
+
+// GetAnswerCountsByQuestion returns the number of answers per question ID
+func (r *QuestionnaireRepository) GetAnswerCountsByQuestion(ctx context.Context) (map[string]int, error) {
+	query := `SELECT question, count() AS count FROM answer GROUP BY question`
+
+	results, err := r.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, data := range flattenResults(results) {
+		counts[convertSurrealID(data["question"])] = getInt(data, "count")
+	}
+	return counts, nil
+}
+
 // GetUserAnswer retrieves a user's answer to a specific question
 func (r *QuestionnaireRepository) GetUserAnswer(ctx context.Context, userID, questionID string) (*model.Answer, error) {
 	query := `SELECT * FROM answer WHERE user = type::record($user_id) AND question = type::record($question_id)`
@@ -330,6 +443,29 @@ func (r *QuestionnaireRepository) GetSharedAnswers(ctx context.Context, userAID,
 	return answersMap, nil
 }
 
+// GetAnswersForUsers retrieves all answers for several users in one query, keyed by user ID
+func (r *QuestionnaireRepository) GetAnswersForUsers(ctx context.Context, userIDs []string) (map[string][]*model.Answer, error) {
+	query := `SELECT * FROM answer WHERE <string> user IN $user_ids`
+	vars := map[string]interface{}{"user_ids": userIDs}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := r.parseAnswersResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := make(map[string][]*model.Answer, len(userIDs))
+	for _, a := range answers {
+		byUser[a.UserID] = append(byUser[a.UserID], a)
+	}
+
+	return byUser, nil
+}
+
 // GetUserBiasProfile retrieves a user's bias profile
 func (r *QuestionnaireRepository) GetUserBiasProfile(ctx context.Context, userID string) (*model.UserBiasProfile, error) {
 	query := `SELECT * FROM user_bias_profile WHERE user = type::record($user_id)`
@@ -404,7 +540,7 @@ func (r *QuestionnaireRepository) UpdateUserBiasProfile(ctx context.Context, use
 // GetQuestionProgress retrieves a user's progress in answering questions
 func (r *QuestionnaireRepository) GetQuestionProgress(ctx context.Context, userID string) (*model.QuestionProgress, error) {
 	// Get total questions
-	totalQuery := `SELECT count() as total FROM question WHERE active = true AND circle_id = NONE GROUP ALL`
+	totalQuery := `SELECT count() as total FROM question WHERE active = true AND guild_id = NONE GROUP ALL`
 	totalResult, err := r.db.QueryOne(ctx, totalQuery, nil)
 	if err != nil && !errors.Is(err, database.ErrNotFound) {
 		return nil, err
@@ -469,6 +605,19 @@ func (r *QuestionnaireRepository) GetQuestionProgress(ctx context.Context, userI
 
 // Helper functions
 
+// questionOptionsData converts options to the stored format
+func questionOptionsData(options []model.QuestionOption) []map[string]interface{} {
+	data := make([]map[string]interface{}, len(options))
+	for i, opt := range options {
+		data[i] = map[string]interface{}{
+			"value":         opt.Value,
+			"label":         opt.Label,
+			"implicit_bias": opt.ImplicitBias,
+		}
+	}
+	return data
+}
+
 func (r *QuestionnaireRepository) parseQuestionResult(result interface{}) (*model.Question, error) {
 	if result == nil {
 		return nil, database.ErrNotFound
@@ -490,9 +639,9 @@ func (r *QuestionnaireRepository) parseQuestionResult(result interface{}) (*mode
 	if id, ok := data["id"]; ok {
 		data["id"] = convertSurrealID(id)
 	}
-	if circleID, ok := data["circle_id"]; ok && circleID != nil {
-		id := convertSurrealID(circleID)
-		data["circle_id"] = id
+	if guildID, ok := data["guild_id"]; ok && guildID != nil {
+		id := convertSurrealID(guildID)
+		data["guild_id"] = id
 	}
 	if createdBy, ok := data["created_by"]; ok && createdBy != nil {
 		id := convertSurrealID(createdBy)
@@ -522,10 +671,11 @@ func (r *QuestionnaireRepository) parseQuestionResult(result interface{}) (*mode
 		IsDealBreakerEligible: getBool(data, "is_dealbreaker_eligible"),
 		SortOrder:             getInt(data, "sort_order"),
 		Active:                getBool(data, "active"),
+		DeprecatedOn:          getTime(data, "deprecated_on"),
 	}
 
-	if circleID, ok := data["circle_id"].(string); ok && circleID != "" {
-		question.CircleID = &circleID
+	if guildID, ok := data["guild_id"].(string); ok && guildID != "" {
+		question.GuildID = &guildID
 	}
 	if createdBy, ok := data["created_by"].(string); ok && createdBy != "" {
 		question.CreatedBy = &createdBy
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// QuotaRepository handles admin overrides of users' daily quotas
+type QuotaRepository struct {
+	db database.Database
+}
+
+// NewQuotaRepository creates a new quota repository
+func NewQuotaRepository(db database.Database) *QuotaRepository {
+	return &QuotaRepository{db: db}
+}
+
+// Upsert creates or replaces a user's quota override
+func (r *QuotaRepository) Upsert(ctx context.Context, override *model.QuotaOverride) error {
+	vars := map[string]interface{}{
+		"user_id":     override.UserID,
+		"daily_limit": override.DailyLimit,
+		"set_by":      override.SetBy,
+	}
+	reason := "reason = NONE"
+	if override.Reason != "" {
+		reason = "reason = $reason"
+		vars["reason"] = override.Reason
+	}
+
+	// SurrealDB 3.0 UPSERT doesn't work with WHERE clause properly
+	// Use IF/ELSE pattern instead
+	query := `
+		LET $existing = SELECT * FROM user_quota WHERE user = type::record($user_id);
+		IF array::len($existing) = 0 {
+			CREATE user_quota SET
+				user = type::record($user_id),
+				daily_limit = $daily_limit,
+				` + reason + `,
+				set_by = type::record($set_by),
+				updated_on = time::now()
+		} ELSE {
+			UPDATE user_quota SET
+				daily_limit = $daily_limit,
+				` + reason + `,
+				set_by = type::record($set_by),
+				updated_on = time::now()
+			WHERE user = type::record($user_id)
+		}
+	`
+
+	if _, err := r.db.Query(ctx, query, vars); err != nil {
+		return err
+	}
+
+	override.UpdatedOn = time.Now()
+	return nil
+}
+
+// Get returns a user's quota override, or nil if they are on the default
+func (r *QuotaRepository) Get(ctx context.Context, userID string) (*model.QuotaOverride, error) {
+	query := `SELECT * FROM user_quota WHERE user = type::record($user_id) LIMIT 1`
+	vars := map[string]interface{}{"user_id": userID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseQuotaOverride(rows[0]), nil
+}
+
+// List returns every quota override
+func (r *QuotaRepository) List(ctx context.Context) ([]*model.QuotaOverride, error) {
+	query := `SELECT * FROM user_quota`
+
+	results, err := r.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	overrides := make([]*model.QuotaOverride, 0, len(rows))
+	for _, row := range rows {
+		overrides = append(overrides, parseQuotaOverride(row))
+	}
+	return overrides, nil
+}
+
+// Delete removes a user's quota override. Returns the removed override, or
+// nil if there was none.
+func (r *QuotaRepository) Delete(ctx context.Context, userID string) (*model.QuotaOverride, error) {
+	query := `DELETE user_quota WHERE user = type::record($user_id) RETURN BEFORE`
+	vars := map[string]interface{}{"user_id": userID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseQuotaOverride(rows[0]), nil
+}
+
+func parseQuotaOverride(data map[string]interface{}) *model.QuotaOverride {
+	override := &model.QuotaOverride{
+		UserID:     convertSurrealID(data["user"]),
+		DailyLimit: getInt(data, "daily_limit"),
+		Reason:     getString(data, "reason"),
+		SetBy:      convertSurrealID(data["set_by"]),
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		override.UpdatedOn = *t
+	}
+	return override
+}
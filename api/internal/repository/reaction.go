@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ReactionRepository handles emoji reactions on events and announcements
+type ReactionRepository struct {
+	db database.Database
+}
+
+// NewReactionRepository creates a new reaction repository
+func NewReactionRepository(db database.Database) *ReactionRepository {
+	return &ReactionRepository{db: db}
+}
+
+// SetReaction saves a reaction, replacing the user's reaction on the same
+// item if they have one
+func (r *ReactionRepository) SetReaction(ctx context.Context, reaction *model.Reaction) error {
+	query := `
+		DELETE reaction
+		WHERE target = type::record($target_id) AND user = type::record($user_id)
+		RETURN NONE;
+		CREATE reaction SET
+			target = type::record($target_id),
+			target_type = $target_type,
+			user = type::record($user_id),
+			emoji = $emoji,
+			created_on = time::now();
+	`
+	vars := map[string]interface{}{
+		"target_id":   reaction.TargetID,
+		"target_type": string(reaction.TargetType),
+		"user_id":     reaction.UserID,
+		"emoji":       reaction.Emoji,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	if t := getTime(rows[len(rows)-1], "created_on"); t != nil {
+		reaction.CreatedOn = *t
+	}
+	return nil
+}
+
+// DeleteReaction removes a user's reaction from an item. Returns false if
+// they hadn't reacted to it.
+func (r *ReactionRepository) DeleteReaction(ctx context.Context, targetID, userID string) (bool, error) {
+	query := `
+		DELETE reaction
+		WHERE target = type::record($target_id) AND user = type::record($user_id)
+		RETURN BEFORE
+	`
+	vars := map[string]interface{}{
+		"target_id": targetID,
+		"user_id":   userID,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// Summaries returns the reaction summary of each of targetIDs, with userID's
+// own reactions when userID is set. Items without reactions get an empty
+// summary.
+func (r *ReactionRepository) Summaries(ctx context.Context, targetIDs []string, userID string) (map[string]*model.ReactionSummary, error) {
+	summaries := make(map[string]*model.ReactionSummary, len(targetIDs))
+	for _, id := range targetIDs {
+		summaries[id] = &model.ReactionSummary{Counts: map[string]int{}}
+	}
+	if len(targetIDs) == 0 {
+		return summaries, nil
+	}
+
+	query := `
+		SELECT target, emoji, count() AS count FROM reaction
+		WHERE <string> target IN $targets
+		GROUP BY target, emoji
+	`
+	vars := map[string]interface{}{"targets": targetIDs}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range flattenResults(results) {
+		if summary := summaries[convertSurrealID(row["target"])]; summary != nil {
+			summary.Add(getString(row, "emoji"), getInt(row, "count"))
+		}
+	}
+
+	if userID == "" {
+		return summaries, nil
+	}
+
+	query = `
+		SELECT target, emoji FROM reaction
+		WHERE user = type::record($user_id) AND <string> target IN $targets
+	`
+	vars["user_id"] = userID
+
+	results, err = r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range flattenResults(results) {
+		if summary := summaries[convertSurrealID(row["target"])]; summary != nil {
+			emoji := getString(row, "emoji")
+			summary.Mine = &emoji
+		}
+	}
+	return summaries, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// RemoteConfigRepository handles client tunable data access
+type RemoteConfigRepository struct {
+	db database.Database
+}
+
+// NewRemoteConfigRepository creates a new remote config repository
+func NewRemoteConfigRepository(db database.Database) *RemoteConfigRepository {
+	return &RemoteConfigRepository{db: db}
+}
+
+// Upsert sets the value for a key and platform
+func (r *RemoteConfigRepository) Upsert(ctx context.Context, value *model.RemoteConfigValue) error {
+	vars := map[string]interface{}{
+		"key":        value.Key,
+		"platform":   string(value.Platform),
+		"value":      string(value.Value),
+		"updated_by": value.UpdatedBy,
+	}
+	description := "description = NONE"
+	if value.Description != "" {
+		description = "description = $description"
+		vars["description"] = value.Description
+	}
+
+	// SurrealDB 3.0 UPSERT doesn't work with WHERE clause properly
+	// Use IF/ELSE pattern instead
+	query := `
+		LET $existing = SELECT * FROM remote_config WHERE key = $key AND platform = $platform;
+		IF array::len($existing) = 0 {
+			CREATE remote_config SET
+				key = $key,
+				platform = $platform,
+				value = $value,
+				` + description + `,
+				updated_by = type::record($updated_by),
+				updated_on = time::now()
+		} ELSE {
+			UPDATE remote_config SET
+				value = $value,
+				` + description + `,
+				updated_by = type::record($updated_by),
+				updated_on = time::now()
+			WHERE key = $key AND platform = $platform
+		}
+	`
+
+	if _, err := r.db.Query(ctx, query, vars); err != nil {
+		return err
+	}
+
+	value.UpdatedOn = time.Now()
+	return nil
+}
+
+// List returns every value, by key and then platform
+func (r *RemoteConfigRepository) List(ctx context.Context) ([]*model.RemoteConfigValue, error) {
+	query := `SELECT * FROM remote_config ORDER BY key, platform`
+
+	results, err := r.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	values := make([]*model.RemoteConfigValue, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, parseRemoteConfigValue(row))
+	}
+	return values, nil
+}
+
+// Delete removes the value for a key and platform. Returns the removed
+// value, or nil if there was none.
+func (r *RemoteConfigRepository) Delete(ctx context.Context, key string, platform model.DevicePlatform) (*model.RemoteConfigValue, error) {
+	query := `DELETE remote_config WHERE key = $key AND platform = $platform RETURN BEFORE`
+	vars := map[string]interface{}{
+		"key":      key,
+		"platform": string(platform),
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseRemoteConfigValue(rows[0]), nil
+}
+
+func parseRemoteConfigValue(data map[string]interface{}) *model.RemoteConfigValue {
+	value := &model.RemoteConfigValue{
+		Key:         getString(data, "key"),
+		Platform:    model.DevicePlatform(getString(data, "platform")),
+		Value:       json.RawMessage(getString(data, "value")),
+		Description: getString(data, "description"),
+		UpdatedBy:   convertSurrealID(data["updated_by"]),
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		value.UpdatedOn = *t
+	}
+	return value
+}
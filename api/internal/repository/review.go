@@ -78,48 +78,54 @@ func (r *ReviewRepository) GetByID(ctx context.Context, id string) (*model.Revie
 	return r.parseReviewResult(result)
 }
 
-// GetReviewsGiven retrieves reviews given by a user
-func (r *ReviewRepository) GetReviewsGiven(ctx context.Context, userID string, limit, offset int) ([]*model.Review, error) {
+// reviewListColumns are the columns review lists are paged by
+var reviewListColumns = database.ListColumns{
+	"created_on": "created_on",
+}
+
+// reviewListOrder is the order of review lists, newest first
+var reviewListOrder = []model.SortKey{{Field: "created_on", Desc: true}}
+
+// GetReviewsGiven retrieves a page of the reviews given by a user, newest
+// first
+func (r *ReviewRepository) GetReviewsGiven(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error) {
 	query := `
 		SELECT * FROM review
 		WHERE reviewer = type::record($user_id)
-		ORDER BY created_on DESC
-		LIMIT $limit START $offset
 	`
-	vars := map[string]interface{}{
-		"user_id": userID,
-		"limit":   limit,
-		"offset":  offset,
-	}
-
-	result, err := r.db.Query(ctx, query, vars)
-	if err != nil {
-		return nil, err
-	}
-
-	return r.parseReviewsResult(result)
+	return r.listReviews(ctx, query, userID, page)
 }
 
-// GetReviewsReceived retrieves reviews received by a user
-func (r *ReviewRepository) GetReviewsReceived(ctx context.Context, userID string, limit, offset int) ([]*model.Review, error) {
+// GetReviewsReceived retrieves a page of the reviews received by a user,
+// newest first
+func (r *ReviewRepository) GetReviewsReceived(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error) {
 	query := `
 		SELECT * FROM review
 		WHERE reviewee = type::record($user_id)
-		ORDER BY created_on DESC
-		LIMIT $limit START $offset
 	`
+	return r.listReviews(ctx, query, userID, page)
+}
+
+// listReviews adds the page's bounds to a review query and reads the page
+func (r *ReviewRepository) listReviews(ctx context.Context, query, userID string, page model.PageRequest) (*model.Page[*model.Review], error) {
 	vars := map[string]interface{}{
 		"user_id": userID,
-		"limit":   limit,
-		"offset":  offset,
 	}
 
-	result, err := r.db.Query(ctx, query, vars)
+	condition, tail, sortColumns, err := database.ListPage(model.ListQuery{}, reviewListColumns, reviewListOrder, page, vars)
 	if err != nil {
 		return nil, err
 	}
+	if condition != "" {
+		query += ` AND ` + condition
+	}
+	query += ` ` + tail
 
-	return r.parseReviewsResult(result)
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return readPage(result, model.ListQuery{}, sortColumns, page, r.parseReviewResult), nil
 }
 
 // HasReviewed checks if a user has already reviewed another for a specific reference
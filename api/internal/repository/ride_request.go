@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// RideRequestRepository handles attendees' requests for rides to events
+type RideRequestRepository struct {
+	db database.Database
+}
+
+// NewRideRequestRepository creates a new ride request repository
+func NewRideRequestRepository(db database.Database) *RideRequestRepository {
+	return &RideRequestRepository{db: db}
+}
+
+// Create saves an open ride request
+func (r *RideRequestRepository) Create(ctx context.Context, req *model.RideRequest) error {
+	setClause := `
+		event = type::record($event_id),
+		user = type::record($user_id),
+		pickup = $pickup,
+		status = "open",
+		created_on = time::now(),
+		updated_on = time::now()`
+	vars := map[string]interface{}{
+		"event_id": req.EventID,
+		"user_id":  req.UserID,
+		"pickup":   rideshareLocationValue(req.Pickup),
+	}
+	if req.Notes != nil {
+		setClause += ", notes = $notes"
+		vars["notes"] = *req.Notes
+	}
+
+	results, err := r.db.Query(ctx, "CREATE ride_request SET "+setClause, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*req = *parseRideRequest(rows[0])
+	return nil
+}
+
+// Get returns a ride request, or nil if it doesn't exist
+func (r *RideRequestRepository) Get(ctx context.Context, requestID string) (*model.RideRequest, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": requestID}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// GetOpen returns a user's open ride request for an event, or nil if they
+// have none
+func (r *RideRequestRepository) GetOpen(ctx context.Context, eventID, userID string) (*model.RideRequest, error) {
+	query := `
+		SELECT * FROM ride_request
+		WHERE event = type::record($event_id)
+		AND user = type::record($user_id)
+		AND status = "open"
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"event_id": eventID,
+		"user_id":  userID,
+	}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// GetOpenByEvent returns the open ride requests for an event, oldest first
+func (r *RideRequestRepository) GetOpenByEvent(ctx context.Context, eventID string) ([]*model.RideRequest, error) {
+	query := `
+		SELECT * FROM ride_request
+		WHERE event = type::record($event_id)
+		AND status = "open"
+		ORDER BY created_on ASC
+	`
+	vars := map[string]interface{}{"event_id": eventID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	requests := make([]*model.RideRequest, 0, len(rows))
+	for _, row := range rows {
+		requests = append(requests, parseRideRequest(row))
+	}
+	return requests, nil
+}
+
+// Close moves an open ride request to status, recording the rideshare that
+// matched it if any. Returns nil if the request is no longer open.
+func (r *RideRequestRepository) Close(ctx context.Context, requestID, status string, rideshareID *string) (*model.RideRequest, error) {
+	query := `UPDATE type::record($id) SET status = $status, updated_on = time::now()`
+	vars := map[string]interface{}{
+		"id":     requestID,
+		"status": status,
+	}
+	if rideshareID != nil {
+		query += ", rideshare = type::record($rideshare_id)"
+		vars["rideshare_id"] = *rideshareID
+	}
+	query += ` WHERE status = "open" RETURN AFTER`
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// queryOne runs a query returning at most one ride request
+func (r *RideRequestRepository) queryOne(ctx context.Context, query string, vars map[string]interface{}) (*model.RideRequest, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseRideRequest(rows[0]), nil
+}
+
+func parseRideRequest(data map[string]interface{}) *model.RideRequest {
+	req := &model.RideRequest{
+		ID:      convertSurrealID(data["id"]),
+		EventID: convertSurrealID(data["event"]),
+		UserID:  convertSurrealID(data["user"]),
+		Pickup:  parseRideshareLocation(data["pickup"]),
+		Notes:   getStringPtr(data, "notes"),
+		Status:  getString(data, "status"),
+	}
+	if data["rideshare"] != nil {
+		rideshareID := convertSurrealID(data["rideshare"])
+		req.RideshareID = &rideshareID
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		req.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		req.UpdatedOn = *t
+	}
+	return req
+}
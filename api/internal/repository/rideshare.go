@@ -0,0 +1,431 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// RideshareRepository handles rideshare data access
+type RideshareRepository struct {
+	db database.Database
+}
+
+// NewRideshareRepository creates a new rideshare repository
+func NewRideshareRepository(db database.Database) *RideshareRepository {
+	return &RideshareRepository{db: db}
+}
+
+// GetByUserInRange retrieves active rideshares the user drives or holds a
+// seat on that depart within [since, until)
+func (r *RideshareRepository) GetByUserInRange(ctx context.Context, userID string, since, until time.Time) ([]*model.Rideshare, error) {
+	query := `
+		SELECT * FROM rideshare
+		WHERE (
+			driver_id = type::record($user_id)
+			OR id IN (
+				SELECT VALUE rideshare_id FROM rideshare_seat
+				WHERE passenger_id = type::record($user_id)
+				AND status IN ["requested", "confirmed"]
+			)
+		)
+		AND status IN ["open", "full", "departed"]
+		AND departure_time >= $since AND departure_time < $until
+		ORDER BY departure_time ASC
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"since":   since,
+		"until":   until,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	rideshares := make([]*model.Rideshare, 0, len(rows))
+	for _, row := range rows {
+		rideshares = append(rideshares, parseRideshare(row))
+	}
+	return rideshares, nil
+}
+
+// Create saves a rideshare with all its seats open
+func (r *RideshareRepository) Create(ctx context.Context, rideshare *model.Rideshare) error {
+	setClause := `
+		driver_id = type::record($driver_id),
+		title = $title,
+		origin = $origin,
+		destination = $destination,
+		departure_time = $departure_time,
+		seats_total = $seats_total,
+		seats_available = $seats_total,
+		status = "open",
+		trust_required = $trust_required,
+		visibility = $visibility,
+		created_on = time::now(),
+		updated_on = time::now()`
+	vars := map[string]interface{}{
+		"driver_id":      rideshare.DriverID,
+		"title":          rideshare.Title,
+		"origin":         rideshareLocationValue(rideshare.Origin),
+		"destination":    rideshareLocationValue(rideshare.Destination),
+		"departure_time": rideshare.DepartureTime,
+		"seats_total":    rideshare.SeatsTotal,
+		"trust_required": rideshare.TrustRequired,
+		"visibility":     rideshare.Visibility,
+	}
+
+	// Add optional fields only when they have values
+	if rideshare.EventID != nil {
+		setClause += ", event_id = type::record($event_id)"
+		vars["event_id"] = *rideshare.EventID
+	}
+	if rideshare.Description != nil {
+		setClause += ", description = $description"
+		vars["description"] = *rideshare.Description
+	}
+
+	results, err := r.db.Query(ctx, "CREATE rideshare SET "+setClause, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*rideshare = *parseRideshare(rows[0])
+	return nil
+}
+
+// Get returns a rideshare, or nil if it doesn't exist
+func (r *RideshareRepository) Get(ctx context.Context, rideshareID string) (*model.Rideshare, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": rideshareID}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// GetByEvent returns the open and full rideshares to an event, by
+// departure time
+func (r *RideshareRepository) GetByEvent(ctx context.Context, eventID string) ([]*model.Rideshare, error) {
+	query := `
+		SELECT * FROM rideshare
+		WHERE event_id = type::record($event_id)
+		AND status IN ["open", "full"]
+		ORDER BY departure_time ASC
+	`
+	vars := map[string]interface{}{"event_id": eventID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	rideshares := make([]*model.Rideshare, 0, len(rows))
+	for _, row := range rows {
+		rideshares = append(rideshares, parseRideshare(row))
+	}
+	return rideshares, nil
+}
+
+// CreateSegments saves a rideshare's route legs
+func (r *RideshareRepository) CreateSegments(ctx context.Context, rideshareID string, segments []model.RideshareSegment) error {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(segments))
+	for _, segment := range segments {
+		rows = append(rows, map[string]interface{}{
+			"sequence_order": segment.SequenceOrder,
+			"pickup_point":   rideshareLocationValue(segment.PickupPoint),
+			"dropoff_point":  rideshareLocationValue(segment.DropoffPoint),
+		})
+	}
+	query := `
+		FOR $segment IN $segments {
+			CREATE rideshare_segment SET
+				rideshare_id = type::record($rideshare_id),
+				sequence_order = $segment.sequence_order,
+				pickup_point = $segment.pickup_point,
+				dropoff_point = $segment.dropoff_point;
+		}
+	`
+	vars := map[string]interface{}{
+		"rideshare_id": rideshareID,
+		"segments":     rows,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// GetSegments returns a rideshare's route legs in driving order
+func (r *RideshareRepository) GetSegments(ctx context.Context, rideshareID string) ([]model.RideshareSegment, error) {
+	query := `
+		SELECT * FROM rideshare_segment
+		WHERE rideshare_id = type::record($rideshare_id)
+		ORDER BY sequence_order ASC
+	`
+	vars := map[string]interface{}{"rideshare_id": rideshareID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	segments := make([]model.RideshareSegment, 0, len(rows))
+	for _, row := range rows {
+		segments = append(segments, model.RideshareSegment{
+			ID:            convertSurrealID(row["id"]),
+			RideshareID:   convertSurrealID(row["rideshare_id"]),
+			SequenceOrder: getInt(row, "sequence_order"),
+			PickupPoint:   parseRideshareLocation(row["pickup_point"]),
+			DropoffPoint:  parseRideshareLocation(row["dropoff_point"]),
+			Notes:         getStringPtr(row, "notes"),
+		})
+	}
+	return segments, nil
+}
+
+// ReserveSeat takes one of a rideshare's open seats. The check and
+// decrement are one statement, so concurrent confirmations can't overfill
+// the car. Returns nil if no seat is left.
+func (r *RideshareRepository) ReserveSeat(ctx context.Context, rideshareID string) (*model.Rideshare, error) {
+	query := `
+		UPDATE type::record($id) SET
+			seats_available -= 1,
+			updated_on = time::now()
+		WHERE status = "open" AND seats_available > 0
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"id": rideshareID}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// ReleaseSeat gives back a seat taken by ReserveSeat, reopening a full
+// rideshare
+func (r *RideshareRepository) ReleaseSeat(ctx context.Context, rideshareID string) error {
+	query := `
+		UPDATE type::record($id) SET
+			seats_available += 1,
+			status = IF status = "full" THEN "open" ELSE status END,
+			updated_on = time::now()
+		WHERE seats_available < seats_total
+	`
+	vars := map[string]interface{}{"id": rideshareID}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// SetStatus changes a rideshare's status
+func (r *RideshareRepository) SetStatus(ctx context.Context, rideshareID, status string) error {
+	query := `UPDATE type::record($id) SET status = $status, updated_on = time::now()`
+	vars := map[string]interface{}{
+		"id":     rideshareID,
+		"status": status,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// CreateSeat saves a passenger's seat request
+func (r *RideshareRepository) CreateSeat(ctx context.Context, seat *model.RideshareSeat) error {
+	setClause := `
+		rideshare_id = type::record($rideshare_id),
+		passenger_id = type::record($passenger_id),
+		status = $status,
+		requested_on = time::now()`
+	vars := map[string]interface{}{
+		"rideshare_id": seat.RideshareID,
+		"passenger_id": seat.PassengerID,
+		"status":       seat.Status,
+	}
+	if seat.Notes != nil {
+		setClause += ", notes = $notes"
+		vars["notes"] = *seat.Notes
+	}
+
+	results, err := r.db.Query(ctx, "CREATE rideshare_seat SET "+setClause, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*seat = *parseRideshareSeat(rows[0])
+	return nil
+}
+
+// GetSeat returns a seat, or nil if it doesn't exist
+func (r *RideshareRepository) GetSeat(ctx context.Context, seatID string) (*model.RideshareSeat, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": seatID}
+
+	return r.querySeat(ctx, query, vars)
+}
+
+// GetPassengerSeat returns a passenger's seat on a rideshare in any status,
+// or nil if they never asked for one. A passenger gets one seat request per
+// rideshare.
+func (r *RideshareRepository) GetPassengerSeat(ctx context.Context, rideshareID, userID string) (*model.RideshareSeat, error) {
+	query := `
+		SELECT * FROM rideshare_seat
+		WHERE rideshare_id = type::record($rideshare_id)
+		AND passenger_id = type::record($user_id)
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"rideshare_id": rideshareID,
+		"user_id":      userID,
+	}
+
+	return r.querySeat(ctx, query, vars)
+}
+
+// SetSeatStatus confirms or cancels a requested seat. Returns nil if the
+// seat is no longer requested.
+func (r *RideshareRepository) SetSeatStatus(ctx context.Context, seatID, status string) (*model.RideshareSeat, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = $status,
+			confirmed_on = IF $status = "confirmed" THEN time::now() ELSE NONE END
+		WHERE status = "requested"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":     seatID,
+		"status": status,
+	}
+
+	return r.querySeat(ctx, query, vars)
+}
+
+// queryOne runs a query returning at most one rideshare
+func (r *RideshareRepository) queryOne(ctx context.Context, query string, vars map[string]interface{}) (*model.Rideshare, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseRideshare(rows[0]), nil
+}
+
+// querySeat runs a query returning at most one seat
+func (r *RideshareRepository) querySeat(ctx context.Context, query string, vars map[string]interface{}) (*model.RideshareSeat, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseRideshareSeat(rows[0]), nil
+}
+
+func parseRideshare(data map[string]interface{}) *model.Rideshare {
+	rideshare := &model.Rideshare{
+		ID:             convertSurrealID(data["id"]),
+		DriverID:       convertSurrealID(data["driver_id"]),
+		Title:          getString(data, "title"),
+		Description:    getStringPtr(data, "description"),
+		Origin:         parseRideshareLocation(data["origin"]),
+		Destination:    parseRideshareLocation(data["destination"]),
+		ArrivalTime:    getTime(data, "arrival_time"),
+		SeatsTotal:     getInt(data, "seats_total"),
+		SeatsAvailable: getInt(data, "seats_available"),
+		Status:         getString(data, "status"),
+		TrustRequired:  getBool(data, "trust_required"),
+		Visibility:     getString(data, "visibility"),
+	}
+	if data["event_id"] != nil {
+		eventID := convertSurrealID(data["event_id"])
+		rideshare.EventID = &eventID
+	}
+	if data["adventure_id"] != nil {
+		adventureID := convertSurrealID(data["adventure_id"])
+		rideshare.AdventureID = &adventureID
+	}
+	if t := getTime(data, "departure_time"); t != nil {
+		rideshare.DepartureTime = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		rideshare.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		rideshare.UpdatedOn = *t
+	}
+	return rideshare
+}
+
+func parseRideshareLocation(v interface{}) model.RideshareLocation {
+	data, ok := v.(map[string]interface{})
+	if !ok {
+		return model.RideshareLocation{}
+	}
+	return model.RideshareLocation{
+		Name:         getString(data, "name"),
+		Description:  getStringPtr(data, "description"),
+		Address:      getStringPtr(data, "address"),
+		Neighborhood: getStringPtr(data, "neighborhood"),
+		City:         getString(data, "city"),
+		Country:      getStringPtr(data, "country"),
+		Lat:          getFloat(data, "lat"),
+		Lng:          getFloat(data, "lng"),
+	}
+}
+
+func parseRideshareSeat(data map[string]interface{}) *model.RideshareSeat {
+	seat := &model.RideshareSeat{
+		ID:          convertSurrealID(data["id"]),
+		RideshareID: convertSurrealID(data["rideshare_id"]),
+		PassengerID: convertSurrealID(data["passenger_id"]),
+		Status:      getString(data, "status"),
+		ConfirmedOn: getTime(data, "confirmed_on"),
+		Notes:       getStringPtr(data, "notes"),
+	}
+	if t := getTime(data, "requested_on"); t != nil {
+		seat.RequestedOn = *t
+	}
+	return seat
+}
+
+// rideshareLocationValue is the stored form of a rideshare location,
+// coordinates included
+func rideshareLocationValue(loc model.RideshareLocation) map[string]interface{} {
+	value := map[string]interface{}{
+		"name": loc.Name,
+		"city": loc.City,
+		"lat":  loc.Lat,
+		"lng":  loc.Lng,
+	}
+	if loc.Description != nil {
+		value["description"] = *loc.Description
+	}
+	if loc.Address != nil {
+		value["address"] = *loc.Address
+	}
+	if loc.Neighborhood != nil {
+		value["neighborhood"] = *loc.Neighborhood
+	}
+	if loc.Country != nil {
+		value["country"] = *loc.Country
+	}
+	return value
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// searchSubtitleLength is how much of a description a result carries
+const searchSubtitleLength = 160
+
+// searchQueries match each kind of record against the full-text indexes
+// from migration 062. Each matched field has its own index, so the score is
+// the sum over fields. Only records anyone may see are searched: public
+// guilds, published public events, and users whose profile is public.
+var searchQueries = map[string]string{
+	model.SearchTypeGuild: `
+		SELECT id, name AS title, description AS subtitle,
+			search::score(0) + search::score(1) AS score
+		FROM guild
+		WHERE visibility = "public"
+			AND (name @0@ $text OR description @1@ $text)
+		ORDER BY score DESC
+		LIMIT $limit
+	`,
+	model.SearchTypeEvent: `
+		SELECT id, title, description AS subtitle,
+			search::score(0) + search::score(1) AS score
+		FROM event
+		WHERE visibility = "public" AND status = "published" AND deleted_at = NONE
+			AND (title @0@ $text OR description @1@ $text)
+		ORDER BY score DESC
+		LIMIT $limit
+	`,
+	model.SearchTypeInterest: `
+		SELECT id, name AS title, category AS subtitle,
+			search::score(0) AS score
+		FROM interest
+		WHERE name @0@ $text
+		ORDER BY score DESC
+		LIMIT $limit
+	`,
+	model.SearchTypeUser: `
+		SELECT id, username AS title,
+			string::trim((firstname ?? "") + " " + (lastname ?? "")) AS subtitle,
+			search::score(0) + search::score(1) + search::score(2) AS score
+		FROM user
+		WHERE (username @0@ $text OR firstname @1@ $text OR lastname @2@ $text)
+			AND id IN (SELECT VALUE user FROM user_profile WHERE visibility = "public")
+		ORDER BY score DESC
+		LIMIT $limit
+	`,
+}
+
+// SearchRepository runs full-text searches with SurrealDB's indexes
+type SearchRepository struct {
+	db database.Database
+}
+
+// NewSearchRepository creates a new search repository
+func NewSearchRepository(db database.Database) *SearchRepository {
+	return &SearchRepository{db: db}
+}
+
+// Search returns up to q.Limit matches of each type in q.Types, best first
+// within each type
+func (r *SearchRepository) Search(ctx context.Context, q model.SearchQuery) ([]*model.SearchResult, error) {
+	results := make([]*model.SearchResult, 0)
+	for _, searchType := range q.Types {
+		query, ok := searchQueries[searchType]
+		if !ok {
+			return nil, fmt.Errorf("unknown search type %q", searchType)
+		}
+		vars := map[string]interface{}{
+			"text":  q.Text,
+			"limit": q.Limit,
+		}
+
+		result, err := r.db.Query(ctx, query, vars)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range flattenResults(result) {
+			results = append(results, parseSearchResult(searchType, row))
+		}
+	}
+	return results, nil
+}
+
+func parseSearchResult(searchType string, data map[string]interface{}) *model.SearchResult {
+	subtitle := []rune(getString(data, "subtitle"))
+	if len(subtitle) > searchSubtitleLength {
+		subtitle = append(subtitle[:searchSubtitleLength-1], '…')
+	}
+	return &model.SearchResult{
+		Type:     searchType,
+		ID:       convertSurrealID(data["id"]),
+		Title:    getString(data, "title"),
+		Subtitle: string(subtitle),
+		Score:    getFloat(data, "score"),
+	}
+}
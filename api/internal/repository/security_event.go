@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// SecurityEventRepository handles security event log data access
+type SecurityEventRepository struct {
+	db database.Database
+}
+
+// NewSecurityEventRepository creates a new security event repository
+func NewSecurityEventRepository(db database.Database) *SecurityEventRepository {
+	return &SecurityEventRepository{db: db}
+}
+
+// Create appends an event to a user's security log, or to the log of
+// client addresses when it has no user
+func (r *SecurityEventRepository) Create(ctx context.Context, event *model.SecurityEvent) error {
+	vars := map[string]interface{}{
+		"type":   event.Type,
+		"detail": event.Detail,
+	}
+	optionalFields := ""
+	if event.UserID != "" {
+		optionalFields += ",\n\t\t\tuser = type::record($user_id)"
+		vars["user_id"] = event.UserID
+	}
+	if event.IP != "" {
+		optionalFields += ",\n\t\t\tip = $ip"
+		vars["ip"] = event.IP
+	}
+
+	query := `
+		CREATE security_event SET
+			type = $type,
+			detail = $detail,
+			created_on = time::now()` + optionalFields + `
+	`
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	created := parseSecurityEvent(rows[0])
+	event.ID = created.ID
+	event.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// ListByUser returns a user's security log, newest first
+func (r *SecurityEventRepository) ListByUser(ctx context.Context, userID string, limit int) ([]*model.SecurityEvent, error) {
+	query := `
+		SELECT * FROM security_event
+		WHERE user = type::record($user_id)
+		ORDER BY created_on DESC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"limit":   limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSecurityEvents(results), nil
+}
+
+// ListByTypes returns the latest events of the given types across all
+// users and clients, newest first
+func (r *SecurityEventRepository) ListByTypes(ctx context.Context, types []string, limit int) ([]*model.SecurityEvent, error) {
+	query := `
+		SELECT * FROM security_event
+		WHERE type IN $types
+		ORDER BY created_on DESC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"types": types,
+		"limit": limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSecurityEvents(results), nil
+}
+
+func parseSecurityEvents(results []interface{}) []*model.SecurityEvent {
+	rows := flattenResults(results)
+	events := make([]*model.SecurityEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, parseSecurityEvent(row))
+	}
+	return events
+}
+
+func parseSecurityEvent(data map[string]interface{}) *model.SecurityEvent {
+	event := &model.SecurityEvent{
+		ID:     convertSurrealID(data["id"]),
+		IP:     getString(data, "ip"),
+		Type:   getString(data, "type"),
+		Detail: getString(data, "detail"),
+	}
+	if user, ok := data["user"]; ok && user != nil {
+		event.UserID = convertSurrealID(user)
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		event.CreatedOn = *t
+	}
+	return event
+}
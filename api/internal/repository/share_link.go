@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ShareLinkRepository handles share link data access
+type ShareLinkRepository struct {
+	db database.Database
+}
+
+// NewShareLinkRepository creates a new share link repository
+func NewShareLinkRepository(db database.Database) *ShareLinkRepository {
+	return &ShareLinkRepository{db: db}
+}
+
+// Create stores a share link. Only the code is stored; the signed token is
+// derived from it by the service.
+func (r *ShareLinkRepository) Create(ctx context.Context, link *model.ShareLink) error {
+	// Build query dynamically to avoid NULL vs NONE issues for optional fields
+	setClause := `code = $code, target_type = $target_type, target_id = $target_id, created_by = type::record($created_by), invite = $invite, use_count = 0, created_on = time::now()`
+	vars := map[string]interface{}{
+		"code":        link.Code,
+		"target_type": string(link.TargetType),
+		"target_id":   link.TargetID,
+		"created_by":  link.CreatedBy,
+		"invite":      link.Invite,
+	}
+	if link.MaxUses != nil {
+		setClause += ", max_uses = $max_uses"
+		vars["max_uses"] = *link.MaxUses
+	}
+	if link.ExpiresOn != nil {
+		setClause += ", expires_on = $expires_on"
+		vars["expires_on"] = *link.ExpiresOn
+	}
+
+	result, err := r.db.Query(ctx, "CREATE share_link SET "+setClause, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: share link code already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	link.ID = created.ID
+	link.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// GetByCode retrieves a share link by its code
+func (r *ShareLinkRepository) GetByCode(ctx context.Context, code string) (*model.ShareLink, error) {
+	query := `SELECT * FROM share_link WHERE code = $code LIMIT 1`
+	vars := map[string]interface{}{"code": code}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseShareLink(rows[0]), nil
+}
+
+// ClaimUse counts one redemption of the link, unless it has already reached
+// max_uses. Returns false when no redemptions are left.
+func (r *ShareLinkRepository) ClaimUse(ctx context.Context, id string) (bool, error) {
+	query := `
+		UPDATE type::record($id) SET use_count += 1
+		WHERE max_uses = NONE OR use_count < max_uses
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// ReleaseUse gives back a redemption claimed by ClaimUse when the redemption
+// itself failed
+func (r *ShareLinkRepository) ReleaseUse(ctx context.Context, id string) error {
+	query := `UPDATE type::record($id) SET use_count -= 1 WHERE use_count > 0`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Delete removes a share link, revoking it
+func (r *ShareLinkRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+func parseShareLink(data map[string]interface{}) *model.ShareLink {
+	link := &model.ShareLink{
+		ID:         convertSurrealID(data["id"]),
+		Code:       getString(data, "code"),
+		TargetType: model.ShareTargetType(getString(data, "target_type")),
+		TargetID:   getString(data, "target_id"),
+		CreatedBy:  convertSurrealID(data["created_by"]),
+		Invite:     getBool(data, "invite"),
+		UseCount:   getInt(data, "use_count"),
+		ExpiresOn:  getTime(data, "expires_on"),
+	}
+	if _, ok := data["max_uses"]; ok && data["max_uses"] != nil {
+		maxUses := getInt(data, "max_uses")
+		link.MaxUses = &maxUses
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		link.CreatedOn = *t
+	}
+	return link
+}
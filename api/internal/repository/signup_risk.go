@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// SignupRiskRepository handles signup risk score data access
+type SignupRiskRepository struct {
+	db database.Database
+}
+
+// NewSignupRiskRepository creates a new signup risk repository
+func NewSignupRiskRepository(db database.Database) *SignupRiskRepository {
+	return &SignupRiskRepository{db: db}
+}
+
+// Create stores the risk worked out for a new account
+func (r *SignupRiskRepository) Create(ctx context.Context, risk *model.SignupRisk) error {
+	query := `
+		CREATE signup_risk SET
+			user = type::record($user_id),
+			ip = $ip,
+			score = $score,
+			reasons = $reasons,
+			requires_verification = $requires_verification,
+			flagged_for_review = $flagged_for_review,
+			created_on = time::now()
+	`
+	reasons := risk.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+	vars := map[string]interface{}{
+		"user_id":               risk.UserID,
+		"ip":                    risk.IP,
+		"score":                 risk.Score,
+		"reasons":               reasons,
+		"requires_verification": risk.RequiresVerification,
+		"flagged_for_review":    risk.FlaggedForReview,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	created := parseSignupRisk(rows[0])
+	risk.ID = created.ID
+	risk.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// GetByUser returns the risk worked out for an account, or nil if it was
+// never scored
+func (r *SignupRiskRepository) GetByUser(ctx context.Context, userID string) (*model.SignupRisk, error) {
+	query := `SELECT * FROM signup_risk WHERE user = type::record($user_id) LIMIT 1`
+	vars := map[string]interface{}{"user_id": userID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseSignupRisk(rows[0]), nil
+}
+
+// CountRecentByIP returns how many accounts were registered from an IP
+// since a time
+func (r *SignupRiskRepository) CountRecentByIP(ctx context.Context, ip string, since time.Time) (int, error) {
+	query := `SELECT count() AS count FROM signup_risk WHERE ip = $ip AND created_on >= $since GROUP ALL`
+	vars := map[string]interface{}{
+		"ip":    ip,
+		"since": since,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "count"), nil
+}
+
+// ListFlagged returns flagged accounts no admin has reviewed, riskiest
+// first
+func (r *SignupRiskRepository) ListFlagged(ctx context.Context, limit int) ([]*model.SignupRisk, error) {
+	query := `
+		SELECT * FROM signup_risk
+		WHERE flagged_for_review = true AND reviewed_on = NONE
+		ORDER BY score DESC, created_on ASC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{"limit": limit}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	risks := make([]*model.SignupRisk, 0, len(rows))
+	for _, row := range rows {
+		risks = append(risks, parseSignupRisk(row))
+	}
+	return risks, nil
+}
+
+// MarkReviewed records that an admin cleared an account, lifting its
+// restriction on changes. Returns nil if the account was never scored.
+func (r *SignupRiskRepository) MarkReviewed(ctx context.Context, userID, reviewedBy string) (*model.SignupRisk, error) {
+	query := `
+		UPDATE signup_risk SET
+			requires_verification = false,
+			reviewed_by = type::record($reviewed_by),
+			reviewed_on = time::now()
+		WHERE user = type::record($user_id)
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"user_id":     userID,
+		"reviewed_by": reviewedBy,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseSignupRisk(rows[0]), nil
+}
+
+func parseSignupRisk(data map[string]interface{}) *model.SignupRisk {
+	risk := &model.SignupRisk{
+		ID:                   convertSurrealID(data["id"]),
+		UserID:               convertSurrealID(data["user"]),
+		IP:                   getString(data, "ip"),
+		Score:                getInt(data, "score"),
+		Reasons:              getStringSlice(data, "reasons"),
+		RequiresVerification: getBool(data, "requires_verification"),
+		FlaggedForReview:     getBool(data, "flagged_for_review"),
+		ReviewedOn:           getTime(data, "reviewed_on"),
+	}
+	if reviewedBy := convertSurrealID(data["reviewed_by"]); reviewedBy != "" {
+		risk.ReviewedBy = &reviewedBy
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		risk.CreatedOn = *t
+	}
+	return risk
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// SpontaneousRepository handles spontaneous alert preferences and the log of
+// spontaneous sessions used to rate limit them
+type SpontaneousRepository struct {
+	db database.Database
+}
+
+// NewSpontaneousRepository creates a new spontaneous repository
+func NewSpontaneousRepository(db database.Database) *SpontaneousRepository {
+	return &SpontaneousRepository{db: db}
+}
+
+// GetAlertPreference retrieves a user's spontaneous alert preference, or nil
+// if none is stored
+func (r *SpontaneousRepository) GetAlertPreference(ctx context.Context, userID string) (*model.SpontaneousAlertPreference, error) {
+	query := `SELECT * FROM spontaneous_alert_preference WHERE user = type::record($user_id) LIMIT 1`
+	vars := map[string]interface{}{"user_id": userID}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseSpontaneousAlertPreference(rows[0]), nil
+}
+
+// UpsertAlertPreference creates or replaces a user's spontaneous alert
+// preference. The last alert time is kept.
+func (r *SpontaneousRepository) UpsertAlertPreference(ctx context.Context, pref *model.SpontaneousAlertPreference) error {
+	query := `
+		LET $existing = SELECT * FROM spontaneous_alert_preference WHERE user = type::record($user_id);
+		IF array::len($existing) = 0 {
+			CREATE spontaneous_alert_preference SET
+				user = type::record($user_id),
+				enabled = $enabled,
+				radius_km = $radius_km
+		} ELSE {
+			UPDATE spontaneous_alert_preference SET
+				enabled = $enabled,
+				radius_km = $radius_km,
+				updated_on = time::now()
+			WHERE user = type::record($user_id)
+		}
+	`
+	vars := map[string]interface{}{
+		"user_id":   pref.UserID,
+		"enabled":   pref.Enabled,
+		"radius_km": pref.RadiusKm,
+	}
+
+	if _, err := r.db.Query(ctx, query, vars); err != nil {
+		return err
+	}
+
+	pref.UpdatedOn = time.Now()
+	return nil
+}
+
+// GetEnabledAlertPreferences returns the preferences of those of userIDs who
+// have spontaneous alerts turned on, keyed by user ID
+func (r *SpontaneousRepository) GetEnabledAlertPreferences(ctx context.Context, userIDs []string) (map[string]*model.SpontaneousAlertPreference, error) {
+	prefs := make(map[string]*model.SpontaneousAlertPreference)
+	if len(userIDs) == 0 {
+		return prefs, nil
+	}
+
+	query := `
+		SELECT * FROM spontaneous_alert_preference
+		WHERE enabled = true AND <string> user IN $user_ids
+	`
+	vars := map[string]interface{}{"user_ids": userIDs}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range flattenResults(result) {
+		pref := parseSpontaneousAlertPreference(row)
+		prefs[pref.UserID] = pref
+	}
+	return prefs, nil
+}
+
+// ClaimAlert records that the user is being alerted now, unless they were
+// already alerted after cutoff or have alerts turned off. Returns whether the
+// alert may be sent.
+func (r *SpontaneousRepository) ClaimAlert(ctx context.Context, userID string, cutoff time.Time) (bool, error) {
+	query := `
+		UPDATE spontaneous_alert_preference SET last_alerted_on = time::now()
+		WHERE user = type::record($user_id)
+			AND enabled = true
+			AND (last_alerted_on = NONE OR last_alerted_on < $cutoff)
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"cutoff":  cutoff,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(result)) > 0, nil
+}
+
+// RecordSession logs that the user started a spontaneous session. The log
+// outlives the availability so stopping early doesn't reset the limit.
+func (r *SpontaneousRepository) RecordSession(ctx context.Context, userID, availabilityID string) error {
+	query := `
+		CREATE spontaneous_session SET
+			user = type::record($user_id),
+			availability = type::record($availability_id),
+			started_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"user_id":         userID,
+		"availability_id": availabilityID,
+	}
+
+	_, err := r.db.Query(ctx, query, vars)
+	return err
+}
+
+// GetSessionStartsSince returns when the user started each spontaneous
+// session since the given time, oldest first
+func (r *SpontaneousRepository) GetSessionStartsSince(ctx context.Context, userID string, since time.Time) ([]time.Time, error) {
+	query := `
+		SELECT started_on FROM spontaneous_session
+		WHERE user = type::record($user_id) AND started_on > $since
+		ORDER BY started_on
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"since":   since,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var starts []time.Time
+	for _, row := range flattenResults(result) {
+		if t := getTime(row, "started_on"); t != nil {
+			starts = append(starts, *t)
+		}
+	}
+	return starts, nil
+}
+
+func parseSpontaneousAlertPreference(data map[string]interface{}) *model.SpontaneousAlertPreference {
+	pref := &model.SpontaneousAlertPreference{
+		UserID:        convertSurrealID(data["user"]),
+		Enabled:       getBool(data, "enabled"),
+		RadiusKm:      getFloat(data, "radius_km"),
+		LastAlertedOn: getTime(data, "last_alerted_on"),
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		pref.UpdatedOn = *t
+	}
+	return pref
+}
@@ -24,22 +24,36 @@ func NewTokenRepository(db database.Database) *TokenRepository {
 
 // CreateRefreshToken stores a new refresh token
 func (r *TokenRepository) CreateRefreshToken(ctx context.Context, token *service.RefreshToken) error {
+	vars := map[string]interface{}{
+		"user":       token.UserID, // UserID is in format "user:xxx"
+		"token_hash": token.TokenHash,
+		"expires_at": token.ExpiresAt.Format(time.RFC3339),
+		"auth_time":  token.AuthTime.Format(time.RFC3339), // Zero for sessions from before sign-in times were kept
+	}
+	jkt := "NONE"
+	if token.KeyThumbprint != "" {
+		jkt = "$dpop_jkt"
+		vars["dpop_jkt"] = token.KeyThumbprint
+	}
+	platform := "NONE"
+	if token.Platform != "" {
+		platform = "$client_platform"
+		vars["client_platform"] = token.Platform
+	}
+
 	query := `
 		CREATE refresh_token CONTENT {
 			user: type::record($user),
 			token_hash: $token_hash,
 			expires_at: <datetime>$expires_at,
 			created_at: time::now(),
+			auth_time: <datetime>$auth_time,
+			dpop_jkt: ` + jkt + `,
+			client_platform: ` + platform + `,
 			revoked: false
 		}
 	`
 
-	vars := map[string]interface{}{
-		"user":       token.UserID, // UserID is in format "user:xxx"
-		"token_hash": token.TokenHash,
-		"expires_at": token.ExpiresAt.Format(time.RFC3339),
-	}
-
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
 		return err
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// Trashed events, pools and votes stay in their tables with deleted_at set.
+// These helpers are shared by the repositories that own them.
+
+// trashFields maps a trash item type to the fields holding its guild and
+// title
+var trashFields = map[model.TrashItemType]struct{ guild, title string }{
+	model.TrashItemEvent: {guild: "guild_id", title: "title"},
+	model.TrashItemPool:  {guild: "guild_id", title: "name"},
+	model.TrashItemVote:  {guild: "scope_id", title: "title"},
+}
+
+// moveToTrash marks a record deleted by the user. Returns false if it doesn't
+// exist or is already in the trash.
+func moveToTrash(ctx context.Context, db database.Database, id, userID string) (bool, error) {
+	query := `
+		UPDATE type::record($id) SET
+			deleted_at = time::now(),
+			deleted_by = type::record($user_id)
+		WHERE deleted_at = NONE
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"id":      id,
+		"user_id": userID,
+	}
+
+	results, err := db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// getTrashByGuild lists a guild's trashed records of one type, most recently
+// deleted first
+func getTrashByGuild(ctx context.Context, db database.Database, table string, itemType model.TrashItemType, guildID string) ([]*model.TrashItem, error) {
+	query := fmt.Sprintf(`
+		SELECT * FROM %s
+		WHERE %s = type::record($guild_id) AND deleted_at != NONE
+		ORDER BY deleted_at DESC
+	`, table, trashFields[itemType].guild)
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	results, err := db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	items := make([]*model.TrashItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, parseTrashItem(row, itemType))
+	}
+	return items, nil
+}
+
+// restoreFromTrash takes a record in the guild's trash out of it. Returns nil
+// if it isn't in that guild's trash.
+func restoreFromTrash(ctx context.Context, db database.Database, itemType model.TrashItemType, id, guildID string) (*model.TrashItem, error) {
+	query := fmt.Sprintf(`
+		UPDATE type::record($id) SET
+			deleted_at = NONE,
+			deleted_by = NONE
+		WHERE %s = type::record($guild_id) AND deleted_at != NONE
+		RETURN BEFORE
+	`, trashFields[itemType].guild)
+	vars := map[string]interface{}{
+		"id":       id,
+		"guild_id": guildID,
+	}
+
+	results, err := db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseTrashItem(rows[0], itemType), nil
+}
+
+// trashOwnerFields lists, per table, the record<user> fields naming users
+// whose legal hold keeps a trashed record from being purged
+var trashOwnerFields = map[string][]string{
+	"event":         {"deleted_by"},
+	"matching_pool": {"deleted_by", "created_by"},
+	"vote":          {"deleted_by", "created_by"},
+}
+
+// getTrashedBefore returns the IDs of a table's records trashed at or before
+// the cutoff, skipping those deleted or created by users on legal hold
+func getTrashedBefore(ctx context.Context, db database.Database, table string, before time.Time) ([]string, error) {
+	query := fmt.Sprintf(`SELECT id FROM %s WHERE deleted_at != NONE AND deleted_at <= $before`, table)
+	for _, field := range trashOwnerFields[table] {
+		query += " AND " + notOnLegalHold(field)
+	}
+	vars := map[string]interface{}{"before": before}
+
+	results, err := db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if id := convertSurrealID(row["id"]); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func parseTrashItem(data map[string]interface{}, itemType model.TrashItemType) *model.TrashItem {
+	fields := trashFields[itemType]
+	item := &model.TrashItem{
+		ID:        convertSurrealID(data["id"]),
+		Type:      itemType,
+		GuildID:   convertSurrealID(data[fields.guild]),
+		Title:     getString(data, fields.title),
+		DeletedBy: convertSurrealID(data["deleted_by"]),
+	}
+	if t := getTime(data, "deleted_at"); t != nil {
+		item.DeletedAt = *t
+		item.PurgeAt = t.AddDate(0, 0, model.TrashRetentionDays)
+	}
+	return item
+}
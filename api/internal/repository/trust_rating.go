@@ -152,49 +152,72 @@ func (r *TrustRatingRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// GetReceivedRatings retrieves ratings received by a user (public only)
-func (r *TrustRatingRepository) GetReceivedRatings(ctx context.Context, userID string, limit, offset int) ([]*model.TrustRating, error) {
+// trustRatingListColumns maps model.TrustRatingListFields to trust_rating
+// columns
+var trustRatingListColumns = database.ListColumns{
+	"created_on":  "created_on",
+	"updated_on":  "updated_on",
+	"trust_level": "trust_level",
+	"anchor_type": "anchor_type",
+}
+
+// trustRatingListOrder is the order of trust rating lists that don't ask
+// for one
+var trustRatingListOrder = []model.SortKey{{Field: "created_on", Desc: true}}
+
+// GetReceivedRatings retrieves a page of the ratings received by a user
+// (public only), newest first unless list asks for another order
+func (r *TrustRatingRepository) GetReceivedRatings(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error) {
 	query := `
 		SELECT * FROM trust_rating
 		WHERE ratee_id = type::record($user_id)
 		AND review_visibility = "public"
-		ORDER BY created_on DESC
-		LIMIT $limit START $offset
 	`
-	vars := map[string]interface{}{
-		"user_id": userID,
-		"limit":   limit,
-		"offset":  offset,
-	}
-
-	result, err := r.db.Query(ctx, query, vars)
+	result, err := r.listRatings(ctx, query, userID, list, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get received ratings: %w", err)
 	}
-
-	return r.parseTrustRatings(result)
+	return result, nil
 }
 
-// GetGivenRatings retrieves ratings given by a user
-func (r *TrustRatingRepository) GetGivenRatings(ctx context.Context, userID string, limit, offset int) ([]*model.TrustRating, error) {
+// GetGivenRatings retrieves a page of the ratings given by a user, newest
+// first unless list asks for another order
+func (r *TrustRatingRepository) GetGivenRatings(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error) {
 	query := `
 		SELECT * FROM trust_rating
 		WHERE rater_id = type::record($user_id)
-		ORDER BY created_on DESC
-		LIMIT $limit START $offset
 	`
+	result, err := r.listRatings(ctx, query, userID, list, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get given ratings: %w", err)
+	}
+	return result, nil
+}
+
+// listRatings adds list's filters and the page's bounds to a trust rating
+// query and reads the page
+func (r *TrustRatingRepository) listRatings(ctx context.Context, query, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error) {
 	vars := map[string]interface{}{
 		"user_id": userID,
-		"limit":   limit,
-		"offset":  offset,
+	}
+	for _, condition := range database.ListConditions(list, trustRatingListColumns, vars) {
+		query += ` AND ` + condition
 	}
 
-	result, err := r.db.Query(ctx, query, vars)
+	condition, tail, sortColumns, err := database.ListPage(list, trustRatingListColumns, trustRatingListOrder, page, vars)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get given ratings: %w", err)
+		return nil, err
+	}
+	if condition != "" {
+		query += ` AND ` + condition
 	}
+	query += ` ` + tail
 
-	return r.parseTrustRatings(result)
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return readPage(result, list, sortColumns, page, r.parseTrustRating), nil
 }
 
 // GetAggregate retrieves aggregated trust stats for a user
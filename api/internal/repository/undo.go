@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// UndoRepository handles undo operation data access
+type UndoRepository struct {
+	db database.Database
+}
+
+// NewUndoRepository creates a new undo repository
+func NewUndoRepository(db database.Database) *UndoRepository {
+	return &UndoRepository{db: db}
+}
+
+// Create stores a new undo operation
+func (r *UndoRepository) Create(ctx context.Context, op *model.UndoOperation) error {
+	query := `
+		CREATE undo_operation SET
+			user = type::record($user_id),
+			type = $type,
+			resource_id = $resource_id,
+			data = $data,
+			expires_on = $expires_on,
+			created_on = time::now()
+	`
+	data := op.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	vars := map[string]interface{}{
+		"user_id":     op.UserID,
+		"type":        string(op.Type),
+		"resource_id": op.ResourceID,
+		"data":        data,
+		"expires_on":  op.ExpiresOn,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	op.ID = created.ID
+	op.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// GetByID retrieves an undo operation, expired or not
+func (r *UndoRepository) GetByID(ctx context.Context, id string) (*model.UndoOperation, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseUndoOperation(rows[0]), nil
+}
+
+// Claim deletes an undo operation and returns it, so only one undo request
+// can act on it. Returns nil if it was already claimed.
+func (r *UndoRepository) Claim(ctx context.Context, id string) (*model.UndoOperation, error) {
+	query := `DELETE type::record($id) RETURN BEFORE`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseUndoOperation(rows[0]), nil
+}
+
+// DeleteExpired removes operations whose window closed before now. Returns
+// how many were removed.
+func (r *UndoRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	query := `DELETE undo_operation WHERE expires_on <= $now RETURN BEFORE`
+	vars := map[string]interface{}{"now": now}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+	return len(flattenResults(results)), nil
+}
+
+func parseUndoOperation(data map[string]interface{}) *model.UndoOperation {
+	op := &model.UndoOperation{
+		ID:         convertSurrealID(data["id"]),
+		UserID:     convertSurrealID(data["user"]),
+		Type:       model.UndoOperationType(getString(data, "type")),
+		ResourceID: getString(data, "resource_id"),
+		Data:       map[string]interface{}{},
+	}
+	if fields, ok := data["data"].(map[string]interface{}); ok {
+		op.Data = fields
+	}
+	if t := getTime(data, "expires_on"); t != nil {
+		op.ExpiresOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		op.CreatedOn = *t
+	}
+	return op
+}
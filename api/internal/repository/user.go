@@ -34,6 +34,7 @@ func (r *UserRepository) Create(ctx context.Context, user *model.User) error {
 		CREATE user CONTENT {
 			email: $email,
 			username: IF $username IS NOT NULL THEN $username ELSE NONE END,
+			username_lower: IF $username IS NOT NULL THEN string::lowercase($username) ELSE NONE END,
 			hash: IF $hash IS NOT NULL THEN $hash ELSE NONE END,
 			firstname: IF $firstname IS NOT NULL THEN $firstname ELSE NONE END,
 			lastname: IF $lastname IS NOT NULL THEN $lastname ELSE NONE END,
@@ -57,7 +58,7 @@ func (r *UserRepository) Create(ctx context.Context, user *model.User) error {
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
 		if isUniqueConstraintError(err) {
-			return fmt.Errorf("%w: email already exists", database.ErrDuplicate)
+			return fmt.Errorf("%w: email or username already exists", database.ErrDuplicate)
 		}
 		return err
 	}
@@ -126,6 +127,7 @@ func (r *UserRepository) Update(ctx context.Context, user *model.User) error {
 		UPDATE type::record($id) SET
 			email = $email,
 			username = $username,
+			username_lower = IF $username THEN string::lowercase($username) ELSE NONE END,
 			firstname = $firstname,
 			lastname = $lastname,
 			email_verified = $email_verified,
@@ -144,6 +146,104 @@ func (r *UserRepository) Update(ctx context.Context, user *model.User) error {
 	return r.db.Execute(ctx, query, vars)
 }
 
+// GetByUsername retrieves a user by their current handle, case-insensitively
+func (r *UserRepository) GetByUsername(ctx context.Context, handle string) (*model.User, error) {
+	query := `SELECT * FROM user WHERE username_lower = $lookup LIMIT 1`
+	vars := map[string]interface{}{"lookup": model.NormalizeHandle(handle)}
+
+	result, err := r.db.QueryOne(ctx, query, vars)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	user, err := parseUserResult(result)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// ChangeUsername sets the user's handle and, when they had one, records the
+// previous handle in username_history so it keeps resolving to them. Any
+// existing redirect for the new handle is dropped. Returns ErrDuplicate if
+// another user holds the handle.
+func (r *UserRepository) ChangeUsername(ctx context.Context, userID string, previous *string, handle string) error {
+	lookup := model.NormalizeHandle(handle)
+	batch := database.NewAtomicBatch()
+
+	batch.Add(`DELETE username_history WHERE handle = $lookup`, map[string]interface{}{
+		"lookup": lookup,
+	})
+
+	if previous != nil && model.NormalizeHandle(*previous) != lookup {
+		oldLookup := model.NormalizeHandle(*previous)
+		batch.Add(`DELETE username_history WHERE handle = $old_lookup`, map[string]interface{}{
+			"old_lookup": oldLookup,
+		})
+		batch.Add(`
+			CREATE username_history CONTENT {
+				handle: $old_lookup,
+				user_id: type::record($user_id),
+				released_on: time::now()
+			}
+		`, map[string]interface{}{
+			"old_lookup": oldLookup,
+			"user_id":    userID,
+		})
+	}
+
+	batch.Add(`
+		UPDATE type::record($id) SET
+			username = $handle,
+			username_lower = $lookup,
+			username_changed_on = time::now(),
+			updated_on = time::now()
+	`, map[string]interface{}{
+		"id":     userID,
+		"handle": handle,
+		"lookup": lookup,
+	})
+
+	if err := batch.Execute(ctx, r.db); err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: username already exists", database.ErrDuplicate)
+		}
+		return err
+	}
+	return nil
+}
+
+// GetHandleRedirect returns the redirect for a released handle, or nil if none
+func (r *UserRepository) GetHandleRedirect(ctx context.Context, handle string) (*model.HandleRedirect, error) {
+	query := `SELECT * FROM username_history WHERE handle = $lookup LIMIT 1`
+	vars := map[string]interface{}{"lookup": model.NormalizeHandle(handle)}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	redirect := &model.HandleRedirect{
+		Handle: getString(rows[0], "handle"),
+		UserID: convertSurrealID(rows[0]["user_id"]),
+	}
+	if t := getTime(rows[0], "released_on"); t != nil {
+		redirect.ReleasedOn = *t
+	}
+	return redirect, nil
+}
+
 // UpdatePassword updates a user's password hash
 func (r *UserRepository) UpdatePassword(ctx context.Context, userID, hash string) error {
 	query := `UPDATE type::record($id) SET hash = $hash, updated_on = time::now()`
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// UserImportRepository handles CSV user import records
+type UserImportRepository struct {
+	db database.Database
+}
+
+// NewUserImportRepository creates a new user import repository
+func NewUserImportRepository(db database.Database) *UserImportRepository {
+	return &UserImportRepository{db: db}
+}
+
+// Create queues an import with its parsed rows
+func (r *UserImportRepository) Create(ctx context.Context, imp *model.UserImport) error {
+	records := make([]map[string]interface{}, 0, len(imp.Records))
+	for _, rec := range imp.Records {
+		records = append(records, map[string]interface{}{
+			"line":   rec.Line,
+			"email":  rec.Email,
+			"name":   rec.Name,
+			"guilds": rec.Guilds,
+		})
+	}
+
+	query := `
+		CREATE user_import SET
+			status = "pending",
+			requested_by = type::record($requested_by),
+			total_rows = $total_rows,
+			records = $records,
+			request_id = $request_id OR NONE,
+			created_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"requested_by": imp.RequestedBy,
+		"total_rows":   len(imp.Records),
+		"records":      records,
+		"request_id":   imp.RequestID,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(result)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+
+	created := parseUserImport(rows[0])
+	imp.ID = created.ID
+	imp.Status = created.Status
+	imp.TotalRows = created.TotalRows
+	imp.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// Get returns an import with its row results, or nil if it doesn't exist
+func (r *UserImportRepository) Get(ctx context.Context, id string) (*model.UserImport, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseUserImport(rows[0]), nil
+}
+
+// List returns the most recent imports, newest first. Rows are left out;
+// fetch an import to see them.
+func (r *UserImportRepository) List(ctx context.Context, limit int) ([]*model.UserImport, error) {
+	query := `SELECT * OMIT records FROM user_import ORDER BY created_on DESC LIMIT $limit`
+	vars := map[string]interface{}{"limit": limit}
+
+	imports, err := r.query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	for _, imp := range imports {
+		imp.Rows = nil
+	}
+	return imports, nil
+}
+
+// ListByStatus returns imports in a status, oldest first
+func (r *UserImportRepository) ListByStatus(ctx context.Context, status model.UserImportStatus, limit int) ([]*model.UserImport, error) {
+	query := `
+		SELECT * FROM user_import
+		WHERE status = $status
+		ORDER BY created_on ASC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"status": string(status),
+		"limit":  limit,
+	}
+
+	return r.query(ctx, query, vars)
+}
+
+// Claim marks a pending import as running. Returns false if another worker
+// claimed it first.
+func (r *UserImportRepository) Claim(ctx context.Context, id string) (bool, error) {
+	query := `
+		UPDATE type::record($id) SET
+			status = "running",
+			started_on = time::now()
+		WHERE status = "pending"
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"id": id}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// Requeue returns a running import to the queue
+func (r *UserImportRepository) Requeue(ctx context.Context, id string) error {
+	query := `UPDATE type::record($id) SET status = "pending", started_on = NONE WHERE status = "running"`
+	vars := map[string]interface{}{"id": id}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Complete records the result of every row
+func (r *UserImportRepository) Complete(ctx context.Context, id string, results []model.UserImportRow) error {
+	rows := make([]map[string]interface{}, 0, len(results))
+	for _, row := range results {
+		errs := make([]map[string]interface{}, 0, len(row.Errors))
+		for _, fe := range row.Errors {
+			errs = append(errs, map[string]interface{}{
+				"field":   fe.Field,
+				"message": fe.Message,
+			})
+		}
+		rows = append(rows, map[string]interface{}{
+			"line":          row.Line,
+			"email":         row.Email,
+			"status":        string(row.Status),
+			"user_id":       row.UserID,
+			"guilds_joined": row.Guilds,
+			"invited":       row.Invited,
+			"errors":        errs,
+		})
+	}
+
+	query := `
+		UPDATE type::record($id) SET
+			status = "completed",
+			rows = $rows,
+			completed_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"id":   id,
+		"rows": rows,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// Fail records why an import couldn't be processed
+func (r *UserImportRepository) Fail(ctx context.Context, id, reason string) error {
+	query := `
+		UPDATE type::record($id) SET
+			status = "failed",
+			error = $error,
+			completed_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"id":    id,
+		"error": reason,
+	}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+func (r *UserImportRepository) query(ctx context.Context, query string, vars map[string]interface{}) ([]*model.UserImport, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	imports := make([]*model.UserImport, 0, len(rows))
+	for _, row := range rows {
+		imports = append(imports, parseUserImport(row))
+	}
+	return imports, nil
+}
+
+func parseUserImport(data map[string]interface{}) *model.UserImport {
+	imp := &model.UserImport{
+		ID:          convertSurrealID(data["id"]),
+		Status:      model.UserImportStatus(getString(data, "status")),
+		RequestedBy: convertSurrealID(data["requested_by"]),
+		TotalRows:   getInt(data, "total_rows"),
+		Error:       getString(data, "error"),
+		StartedOn:   getTime(data, "started_on"),
+		CompletedOn: getTime(data, "completed_on"),
+		RequestID:   getString(data, "request_id"),
+	}
+	if records, ok := data["records"].([]interface{}); ok {
+		for _, item := range records {
+			if rec, ok := item.(map[string]interface{}); ok {
+				imp.Records = append(imp.Records, model.UserImportRecord{
+					Line:   getInt(rec, "line"),
+					Email:  getString(rec, "email"),
+					Name:   getString(rec, "name"),
+					Guilds: getStringSlice(rec, "guilds"),
+				})
+			}
+		}
+	}
+	if rows, ok := data["rows"].([]interface{}); ok {
+		for _, item := range rows {
+			if row, ok := item.(map[string]interface{}); ok {
+				imp.Rows = append(imp.Rows, parseUserImportRow(row))
+			}
+		}
+	}
+	imp.Summary = model.SummarizeUserImportRows(imp.Rows)
+	if t := getTime(data, "created_on"); t != nil {
+		imp.CreatedOn = *t
+	}
+	return imp
+}
+
+func parseUserImportRow(data map[string]interface{}) model.UserImportRow {
+	row := model.UserImportRow{
+		Line:    getInt(data, "line"),
+		Email:   getString(data, "email"),
+		Status:  model.UserImportRowStatus(getString(data, "status")),
+		UserID:  getString(data, "user_id"),
+		Guilds:  getStringSlice(data, "guilds_joined"),
+		Invited: getBool(data, "invited"),
+	}
+	if errs, ok := data["errors"].([]interface{}); ok {
+		for _, item := range errs {
+			if fe, ok := item.(map[string]interface{}); ok {
+				row.Errors = append(row.Errors, model.FieldError{
+					Field:   getString(fe, "field"),
+					Message: getString(fe, "message"),
+				})
+			}
+		}
+	}
+	return row
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// UserInvitationRepository handles invitations to accounts admins created
+type UserInvitationRepository struct {
+	db database.Database
+}
+
+// NewUserInvitationRepository creates a new user invitation repository
+func NewUserInvitationRepository(db database.Database) *UserInvitationRepository {
+	return &UserInvitationRepository{db: db}
+}
+
+// Create stores an invitation
+func (r *UserInvitationRepository) Create(ctx context.Context, invitation *model.UserInvitation) error {
+	query := `
+		CREATE user_invitation SET
+			user = type::record($user_id),
+			token_hash = $token_hash,
+			expires_on = $expires_on,
+			created_on = time::now()
+	`
+	vars := map[string]interface{}{
+		"user_id":    invitation.UserID,
+		"token_hash": invitation.TokenHash,
+		"expires_on": invitation.ExpiresOn,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+
+	invitation.ID = created.ID
+	invitation.CreatedOn = created.CreatedOn
+	return nil
+}
+
+// GetOpenByUser returns the user's newest invitation that is neither
+// accepted nor expired at now, or nil if there is none
+func (r *UserInvitationRepository) GetOpenByUser(ctx context.Context, userID string, now time.Time) (*model.UserInvitation, error) {
+	query := `
+		SELECT * FROM user_invitation
+		WHERE user = type::record($user_id) AND accepted_on IS NONE AND expires_on > $now
+		ORDER BY created_on DESC
+		LIMIT 1
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"now":     now,
+	}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// GetByTokenHash returns the unaccepted invitation matching a token hash
+// without using it up. Returns nil if there is none.
+func (r *UserInvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*model.UserInvitation, error) {
+	query := `SELECT * FROM user_invitation WHERE token_hash = $token_hash AND accepted_on IS NONE LIMIT 1`
+	vars := map[string]interface{}{"token_hash": tokenHash}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// ClaimByTokenHash marks the invitation matching a token hash accepted and
+// returns it, so an invitation link can only be used once. Returns nil if
+// there is none or it was already accepted.
+func (r *UserInvitationRepository) ClaimByTokenHash(ctx context.Context, tokenHash string) (*model.UserInvitation, error) {
+	query := `
+		UPDATE user_invitation SET accepted_on = time::now()
+		WHERE token_hash = $token_hash AND accepted_on IS NONE
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{"token_hash": tokenHash}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// Delete removes an invitation
+func (r *UserInvitationRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": id}
+	return r.db.Execute(ctx, query, vars)
+}
+
+func (r *UserInvitationRepository) queryOne(ctx context.Context, query string, vars map[string]interface{}) (*model.UserInvitation, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseUserInvitation(rows[0]), nil
+}
+
+func parseUserInvitation(data map[string]interface{}) *model.UserInvitation {
+	invitation := &model.UserInvitation{
+		ID:         convertSurrealID(data["id"]),
+		UserID:     convertSurrealID(data["user"]),
+		TokenHash:  getString(data, "token_hash"),
+		AcceptedOn: getTime(data, "accepted_on"),
+	}
+	if t := getTime(data, "expires_on"); t != nil {
+		invitation.ExpiresOn = *t
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		invitation.CreatedOn = *t
+	}
+	return invitation
+}
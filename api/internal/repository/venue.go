@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// VenueRepository handles guilds' saved venues
+type VenueRepository struct {
+	db database.Database
+}
+
+// NewVenueRepository creates a new venue repository
+func NewVenueRepository(db database.Database) *VenueRepository {
+	return &VenueRepository{db: db}
+}
+
+// Create saves a venue to its guild
+func (r *VenueRepository) Create(ctx context.Context, venue *model.Venue) error {
+	setClause := `
+		guild = type::record($guild_id),
+		name = $name,
+		address = $address,
+		city = $city,
+		accessibility = $accessibility,
+		created_by = type::record($created_by),
+		created_on = time::now(),
+		updated_on = time::now()`
+	accessibility := venue.Accessibility
+	if accessibility == nil {
+		accessibility = []string{}
+	}
+	vars := map[string]interface{}{
+		"guild_id":      venue.GuildID,
+		"name":          venue.Name,
+		"address":       venue.Address,
+		"city":          venue.City,
+		"accessibility": accessibility,
+		"created_by":    venue.CreatedBy,
+	}
+
+	// Add optional fields only when they have values
+	if venue.Neighborhood != nil {
+		setClause += ", neighborhood = $neighborhood"
+		vars["neighborhood"] = *venue.Neighborhood
+	}
+	if venue.Lat != nil && venue.Lng != nil {
+		setClause += ", lat = $lat, lng = $lng"
+		vars["lat"] = *venue.Lat
+		vars["lng"] = *venue.Lng
+	}
+	if venue.Capacity != nil {
+		setClause += ", capacity = $capacity"
+		vars["capacity"] = *venue.Capacity
+	}
+
+	results, err := r.db.Query(ctx, "CREATE venue SET "+setClause, vars)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return database.ErrNotFound
+	}
+	*venue = *parseVenue(rows[0])
+	return nil
+}
+
+// Get returns a venue, or nil if it doesn't exist
+func (r *VenueRepository) Get(ctx context.Context, venueID string) (*model.Venue, error) {
+	query := `SELECT * FROM type::record($id)`
+	vars := map[string]interface{}{"id": venueID}
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// ListByGuild returns a guild's venues by name
+func (r *VenueRepository) ListByGuild(ctx context.Context, guildID string) ([]*model.Venue, error) {
+	query := `SELECT * FROM venue WHERE guild = type::record($guild_id) ORDER BY name`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	venues := make([]*model.Venue, 0, len(rows))
+	for _, row := range rows {
+		venues = append(venues, parseVenue(row))
+	}
+	return venues, nil
+}
+
+// CountByGuild returns how many venues a guild has saved
+func (r *VenueRepository) CountByGuild(ctx context.Context, guildID string) (int, error) {
+	query := `SELECT count() AS count FROM venue WHERE guild = type::record($guild_id) GROUP ALL`
+	vars := map[string]interface{}{"guild_id": guildID}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return getInt(rows[0], "count"), nil
+}
+
+// Update applies updates to a venue. Returns nil if it doesn't exist.
+func (r *VenueRepository) Update(ctx context.Context, venueID string, updates map[string]interface{}) (*model.Venue, error) {
+	query := `UPDATE venue SET updated_on = time::now()`
+	vars := map[string]interface{}{"id": venueID}
+
+	for key, value := range updates {
+		query += ", " + setClause(key, value, vars)
+	}
+	query += ` WHERE id = type::record($id) RETURN AFTER`
+
+	return r.queryOne(ctx, query, vars)
+}
+
+// Delete removes a venue. Events already held there keep their copy of the
+// location.
+func (r *VenueRepository) Delete(ctx context.Context, venueID string) error {
+	query := `DELETE type::record($id)`
+	vars := map[string]interface{}{"id": venueID}
+
+	return r.db.Execute(ctx, query, vars)
+}
+
+// queryOne runs a query returning at most one venue
+func (r *VenueRepository) queryOne(ctx context.Context, query string, vars map[string]interface{}) (*model.Venue, error) {
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return parseVenue(rows[0]), nil
+}
+
+func parseVenue(data map[string]interface{}) *model.Venue {
+	venue := &model.Venue{
+		ID:            convertSurrealID(data["id"]),
+		GuildID:       convertSurrealID(data["guild"]),
+		Name:          getString(data, "name"),
+		Address:       getString(data, "address"),
+		Neighborhood:  getStringPtr(data, "neighborhood"),
+		City:          getString(data, "city"),
+		Accessibility: getStringSlice(data, "accessibility"),
+		CreatedBy:     convertSurrealID(data["created_by"]),
+	}
+	if data["lat"] != nil && data["lng"] != nil {
+		lat, lng := getFloat(data, "lat"), getFloat(data, "lng")
+		venue.Lat, venue.Lng = &lat, &lng
+	}
+	if data["capacity"] != nil {
+		capacity := getInt(data, "capacity")
+		venue.Capacity = &capacity
+	}
+	if t := getTime(data, "created_on"); t != nil {
+		venue.CreatedOn = *t
+	}
+	if t := getTime(data, "updated_on"); t != nil {
+		venue.UpdatedOn = *t
+	}
+	return venue
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/forgo/saga/api/internal/database"
 	"github.com/forgo/saga/api/internal/model"
@@ -34,6 +35,7 @@ func (r *VoteRepository) Create(ctx context.Context, vote *model.Vote) error {
 		"status":             vote.Status,
 		"results_visibility": vote.ResultsVisibility,
 		"allow_abstain":      vote.AllowAbstain,
+		"reminders_disabled": vote.RemindersDisabled,
 	}
 
 	// Build optional fields
@@ -50,6 +52,10 @@ func (r *VoteRepository) Create(ctx context.Context, vote *model.Vote) error {
 		optionalFields += ",\n\t\t\tmax_options_selectable: $max_options"
 		vars["max_options"] = *vote.MaxOptionsSelectable
 	}
+	if vote.PublishAt != nil {
+		optionalFields += ",\n\t\t\tpublish_at: $publish_at"
+		vars["publish_at"] = *vote.PublishAt
+	}
 
 	query := `
 		CREATE vote CONTENT {
@@ -62,6 +68,7 @@ func (r *VoteRepository) Create(ctx context.Context, vote *model.Vote) error {
 			status: $status,
 			results_visibility: $results_visibility,
 			allow_abstain: $allow_abstain,
+			reminders_disabled: $reminders_disabled,
 			created_on: time::now(),
 			updated_on: time::now()` + optionalFields + `
 		}
@@ -85,7 +92,7 @@ func (r *VoteRepository) Create(ctx context.Context, vote *model.Vote) error {
 
 // GetByID retrieves a vote by ID
 func (r *VoteRepository) GetByID(ctx context.Context, id string) (*model.Vote, error) {
-	query := `SELECT * FROM type::record($id)`
+	query := `SELECT * FROM type::record($id) WHERE deleted_at = NONE`
 	result, err := r.db.QueryOne(ctx, query, map[string]interface{}{"id": id})
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
@@ -97,69 +104,142 @@ func (r *VoteRepository) GetByID(ctx context.Context, id string) (*model.Vote, e
 	return r.parseVote(result)
 }
 
-// GetByGuild retrieves votes for a guild
-func (r *VoteRepository) GetByGuild(ctx context.Context, guildID string, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error) {
+// voteListColumns maps model.VoteListFields to vote columns
+var voteListColumns = database.ListColumns{
+	"created_on":         "created_on",
+	"opens_at":           "opens_at",
+	"closes_at":          "closes_at",
+	"title":              "title",
+	"status":             "status",
+	"vote_type":          "vote_type",
+	"results_visibility": "results_visibility",
+}
+
+// voteListOrder is the order of vote lists that don't ask for one
+var voteListOrder = []model.SortKey{{Field: "created_on", Desc: true}}
+
+// GetByGuild retrieves a page of a guild's votes, newest first unless list
+// asks for another order
+func (r *VoteRepository) GetByGuild(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error) {
 	query := `
 		SELECT * FROM vote
 		WHERE scope_type = "guild"
 		AND scope_id = type::record($guild_id)
+		AND status != "scheduled"
+		AND deleted_at = NONE
 	`
 	vars := map[string]interface{}{
 		"guild_id": guildID,
-		"limit":    limit,
-		"offset":   offset,
 	}
 
-	if status != nil {
-		query += ` AND status = $status`
-		vars["status"] = *status
+	result, err := r.listVotes(ctx, query, vars, list, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild votes: %w", err)
 	}
+	return result, nil
+}
 
-	query += ` ORDER BY created_on DESC LIMIT $limit START $offset`
+// GetGlobalVotes retrieves a page of global votes, newest first unless list
+// asks for another order
+func (r *VoteRepository) GetGlobalVotes(ctx context.Context, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error) {
+	query := `
+		SELECT * FROM vote
+		WHERE scope_type = "global"
+		AND status != "scheduled"
+		AND deleted_at = NONE
+	`
+	vars := map[string]interface{}{}
 
-	result, err := r.db.Query(ctx, query, vars)
+	result, err := r.listVotes(ctx, query, vars, list, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get guild votes: %w", err)
+		return nil, fmt.Errorf("failed to get global votes: %w", err)
 	}
+	return result, nil
+}
 
-	return r.parseVotes(result)
+// listVotes adds list's filters and the page's bounds to a vote query and
+// reads the page
+func (r *VoteRepository) listVotes(ctx context.Context, query string, vars map[string]interface{}, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error) {
+	for _, condition := range database.ListConditions(list, voteListColumns, vars) {
+		query += ` AND ` + condition
+	}
+
+	condition, tail, sortColumns, err := database.ListPage(list, voteListColumns, voteListOrder, page, vars)
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		query += ` AND ` + condition
+	}
+	query += ` ` + tail
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return readPage(result, list, sortColumns, page, r.parseVote), nil
 }
 
-// GetGlobalVotes retrieves global votes
-func (r *VoteRepository) GetGlobalVotes(ctx context.Context, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error) {
+// SchedulePublication sets when a draft or scheduled vote is published.
+// Returns nil if the vote has been published or cancelled meanwhile.
+func (r *VoteRepository) SchedulePublication(ctx context.Context, id string, publishAt time.Time) (*model.Vote, error) {
 	query := `
-		SELECT * FROM vote
-		WHERE scope_type = "global"
+		UPDATE type::record($id) SET
+			status = "scheduled",
+			publish_at = $publish_at,
+			updated_on = time::now()
+		WHERE status IN ["draft", "scheduled"]
+		RETURN AFTER
 	`
 	vars := map[string]interface{}{
-		"limit":  limit,
-		"offset": offset,
+		"id":         id,
+		"publish_at": publishAt,
 	}
 
-	if status != nil {
-		query += ` AND status = $status`
-		vars["status"] = *status
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule vote: %w", err)
 	}
 
-	query += ` ORDER BY created_on DESC LIMIT $limit START $offset`
+	votes, err := r.parseVotes(result)
+	if err != nil || len(votes) == 0 {
+		return nil, err
+	}
+	return votes[0], nil
+}
+
+// PublishDue publishes scheduled votes whose publish time has passed and
+// returns them. Votes past opens_at open straight away; the rest become
+// drafts and open on schedule.
+func (r *VoteRepository) PublishDue(ctx context.Context, now time.Time) ([]*model.Vote, error) {
+	query := `
+		UPDATE vote SET status = "open", updated_on = time::now()
+		WHERE status = "scheduled" AND publish_at <= $now AND opens_at <= $now AND deleted_at = NONE
+		RETURN AFTER;
+		UPDATE vote SET status = "draft", updated_on = time::now()
+		WHERE status = "scheduled" AND publish_at <= $now AND deleted_at = NONE
+		RETURN AFTER;
+	`
+	vars := map[string]interface{}{"now": now}
 
 	result, err := r.db.Query(ctx, query, vars)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get global votes: %w", err)
+		return nil, fmt.Errorf("failed to publish due votes: %w", err)
 	}
 
 	return r.parseVotes(result)
 }
 
 // GetVotesToOpen retrieves votes that should be opened (opens_at <= now, status = draft)
-func (r *VoteRepository) GetVotesToOpen(ctx context.Context) ([]*model.Vote, error) {
+func (r *VoteRepository) GetVotesToOpen(ctx context.Context, now time.Time) ([]*model.Vote, error) {
 	query := `
 		SELECT * FROM vote
 		WHERE status = "draft"
-		AND opens_at <= time::now()
+		AND opens_at <= $now
+		AND deleted_at = NONE
 	`
 
-	result, err := r.db.Query(ctx, query, nil)
+	result, err := r.db.Query(ctx, query, map[string]interface{}{"now": now})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get votes to open: %w", err)
 	}
@@ -168,14 +248,15 @@ func (r *VoteRepository) GetVotesToOpen(ctx context.Context) ([]*model.Vote, err
 }
 
 // GetVotesToClose retrieves votes that should be closed (closes_at <= now, status = open)
-func (r *VoteRepository) GetVotesToClose(ctx context.Context) ([]*model.Vote, error) {
+func (r *VoteRepository) GetVotesToClose(ctx context.Context, now time.Time) ([]*model.Vote, error) {
 	query := `
 		SELECT * FROM vote
 		WHERE status = "open"
-		AND closes_at <= time::now()
+		AND closes_at <= $now
+		AND deleted_at = NONE
 	`
 
-	result, err := r.db.Query(ctx, query, nil)
+	result, err := r.db.Query(ctx, query, map[string]interface{}{"now": now})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get votes to close: %w", err)
 	}
@@ -183,6 +264,57 @@ func (r *VoteRepository) GetVotesToClose(ctx context.Context) ([]*model.Vote, er
 	return r.parseVotes(result)
 }
 
+// GetVotesClosingBetween retrieves open votes with reminders enabled that close within (from, to]
+func (r *VoteRepository) GetVotesClosingBetween(ctx context.Context, from, to time.Time) ([]*model.Vote, error) {
+	query := `
+		SELECT * FROM vote
+		WHERE status = "open"
+		AND reminders_disabled != true
+		AND deleted_at = NONE
+		AND closes_at > $from
+		AND closes_at <= $to
+		ORDER BY closes_at ASC
+	`
+	vars := map[string]interface{}{
+		"from": from,
+		"to":   to,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get closing votes: %w", err)
+	}
+
+	return r.parseVotes(result)
+}
+
+// RecordReminderSent records that a user was reminded about a vote.
+// Returns database.ErrDuplicate if the user was already reminded.
+func (r *VoteRepository) RecordReminderSent(ctx context.Context, voteID, userID string) error {
+	query := `
+		CREATE vote_reminder_sent CONTENT {
+			vote_id: type::record($vote_id),
+			user_id: type::record($user_id),
+			sent_on: time::now()
+		}
+	`
+	vars := map[string]interface{}{
+		"vote_id": voteID,
+		"user_id": userID,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return fmt.Errorf("%w: vote reminder already sent", database.ErrDuplicate)
+		}
+		return err
+	}
+
+	_, err = extractCreatedRecord(result)
+	return err
+}
+
 // Update updates a vote (only when draft)
 func (r *VoteRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Vote, error) {
 	query := `UPDATE type::record($id) SET updated_on = time::now()`
@@ -217,15 +349,65 @@ func (r *VoteRepository) UpdateStatus(ctx context.Context, id string, status mod
 	return nil
 }
 
-// Delete deletes a vote
+// Delete permanently deletes a vote with its options and ballots
 func (r *VoteRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE type::record($id)`
-	if err := r.db.Execute(ctx, query, map[string]interface{}{"id": id}); err != nil {
-		return fmt.Errorf("failed to delete vote: %w", err)
+	queries := []string{
+		`DELETE vote_ballot WHERE vote_id = type::record($id)`,
+		`DELETE vote_option WHERE vote_id = type::record($id)`,
+		`DELETE type::record($id)`,
+	}
+	for _, q := range queries {
+		if err := r.db.Execute(ctx, q, map[string]interface{}{"id": id}); err != nil {
+			return fmt.Errorf("failed to delete vote: %w", err)
+		}
 	}
 	return nil
 }
 
+// MoveToTrash moves a guild vote to the guild's trash. Returns false if the
+// vote doesn't exist or is already in the trash.
+func (r *VoteRepository) MoveToTrash(ctx context.Context, id, userID string) (bool, error) {
+	moved, err := moveToTrash(ctx, r.db, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to trash vote: %w", err)
+	}
+	return moved, nil
+}
+
+// GetTrashByGuild lists a guild's trashed votes
+func (r *VoteRepository) GetTrashByGuild(ctx context.Context, guildID string) ([]*model.TrashItem, error) {
+	items, err := getTrashByGuild(ctx, r.db, "vote", model.TrashItemVote, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trashed votes: %w", err)
+	}
+	return items, nil
+}
+
+// RestoreFromTrash restores a trashed vote of the guild. Returns nil if the
+// vote isn't in the guild's trash.
+func (r *VoteRepository) RestoreFromTrash(ctx context.Context, id, guildID string) (*model.TrashItem, error) {
+	item, err := restoreFromTrash(ctx, r.db, model.TrashItemVote, id, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore vote: %w", err)
+	}
+	return item, nil
+}
+
+// PurgeTrashed permanently deletes votes trashed at or before the cutoff.
+// Returns how many were deleted.
+func (r *VoteRepository) PurgeTrashed(ctx context.Context, before time.Time) (int, error) {
+	ids, err := getTrashedBefore(ctx, r.db, "vote", before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get trashed votes: %w", err)
+	}
+	for i, id := range ids {
+		if err := r.Delete(ctx, id); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
+
 // Vote Options
 
 // CreateOption creates a vote option
@@ -508,6 +690,7 @@ func (r *VoteRepository) parseVote(result interface{}) (*model.Vote, error) {
 		Status:            model.VoteStatus(getString(data, "status")),
 		ResultsVisibility: model.ResultsVisibility(getString(data, "results_visibility")),
 		AllowAbstain:      getBool(data, "allow_abstain"),
+		RemindersDisabled: getBool(data, "reminders_disabled"),
 	}
 
 	if scopeID := convertSurrealID(data["scope_id"]); scopeID != "" {
@@ -525,6 +708,7 @@ func (r *VoteRepository) parseVote(result interface{}) (*model.Vote, error) {
 	if t := getTime(data, "closes_at"); t != nil {
 		vote.ClosesAt = *t
 	}
+	vote.PublishAt = getTime(data, "publish_at")
 	if t := getTime(data, "created_on"); t != nil {
 		vote.CreatedOn = *t
 	}
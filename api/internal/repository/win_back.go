@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// WinBackRepository finds users drifting toward inactivity and records the
+// win-back attempts made for them
+type WinBackRepository struct {
+	db database.Database
+}
+
+// NewWinBackRepository creates a new win-back repository
+func NewWinBackRepository(db database.Database) *WinBackRepository {
+	return &WinBackRepository{db: db}
+}
+
+// GetChurnRiskCandidates returns users last active in [activeSince,
+// activeBefore) with no upcoming RSVPs, scheduled hangouts or pending matches,
+// who haven't been targeted since targetedBefore. A user targeted maxAttempts
+// times is skipped until they come back and drift away again. Users closest
+// to lapsing come first.
+func (r *WinBackRepository) GetChurnRiskCandidates(ctx context.Context, activeSince, activeBefore, targetedBefore time.Time, maxAttempts, limit int) ([]*model.ChurnRiskCandidate, error) {
+	query := `
+		SELECT user, last_active FROM user_profile
+		WHERE last_active != NONE
+			AND last_active >= $active_since
+			AND last_active < $active_before
+			AND (win_back_targeted_on = NONE OR win_back_targeted_on < $targeted_before)
+			AND (win_back_attempts < $max_attempts OR last_active > win_back_targeted_on)
+			AND array::len((SELECT id FROM event_rsvp
+				WHERE user_id = $parent.user
+					AND status IN ["approved", "pending", "waitlisted"]
+					AND event_id.start_time > time::now()
+					AND event_id.deleted_at = NONE
+				LIMIT 1)) = 0
+			AND array::len((SELECT id FROM hangout
+				WHERE $parent.user IN participants
+					AND status = "scheduled"
+					AND scheduled_time > time::now()
+				LIMIT 1)) = 0
+			AND array::len((SELECT id FROM match_result
+				WHERE $parent.user IN member_user_ids AND status = "pending"
+				LIMIT 1)) = 0
+		ORDER BY last_active ASC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"active_since":    activeSince,
+		"active_before":   activeBefore,
+		"targeted_before": targetedBefore,
+		"max_attempts":    maxAttempts,
+		"limit":           limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	candidates := make([]*model.ChurnRiskCandidate, 0, len(rows))
+	for _, row := range rows {
+		lastActive := getTime(row, "last_active")
+		userID := convertSurrealID(row["user"])
+		if lastActive == nil || userID == "" {
+			continue
+		}
+		candidates = append(candidates, &model.ChurnRiskCandidate{UserID: userID, LastActive: *lastActive})
+	}
+	return candidates, nil
+}
+
+// GetUpcomingEvents returns published events in the user's guilds starting
+// in [from, until) that anyone can join: free and without host approval
+func (r *WinBackRepository) GetUpcomingEvents(ctx context.Context, userID string, from, until time.Time, limit int) ([]model.WinBackEvent, error) {
+	query := `
+		SELECT id, title, start_time FROM event
+		WHERE guild_id IN (SELECT VALUE out FROM responsible_for
+				WHERE in.user = type::record($user_id) AND pending_approval = false)
+			AND deleted_at = NONE
+			AND status = "published"
+			AND requires_approval = false
+			AND ticket_price = 0
+			AND start_time >= $from AND start_time < $until
+		ORDER BY start_time ASC
+		LIMIT $limit
+	`
+	vars := map[string]interface{}{
+		"user_id": userID,
+		"from":    from,
+		"until":   until,
+		"limit":   limit,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	events := make([]model.WinBackEvent, 0, len(rows))
+	for _, row := range rows {
+		event := model.WinBackEvent{
+			ID:    convertSurrealID(row["id"]),
+			Title: getString(row, "title"),
+		}
+		if t := getTime(row, "start_time"); t != nil {
+			event.StartTime = *t
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// ClaimWinBack marks the user targeted at targetedOn unless they were already
+// targeted since targetedBefore. Returns false if another run got there
+// first. Attempts restart from one when the user came back after the last.
+func (r *WinBackRepository) ClaimWinBack(ctx context.Context, userID string, targetedOn, targetedBefore time.Time) (bool, error) {
+	query := `
+		UPDATE user_profile SET
+			win_back_attempts = IF win_back_targeted_on != NONE AND last_active > win_back_targeted_on {
+				1
+			} ELSE {
+				win_back_attempts + 1
+			},
+			win_back_targeted_on = $targeted_on
+		WHERE user = type::record($user_id)
+			AND (win_back_targeted_on = NONE OR win_back_targeted_on < $targeted_before)
+		RETURN AFTER
+	`
+	vars := map[string]interface{}{
+		"user_id":         userID,
+		"targeted_on":     targetedOn,
+		"targeted_before": targetedBefore,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return false, err
+	}
+	return len(flattenResults(results)) > 0, nil
+}
+
+// RecordAttempt stores a win-back attempt for either group
+func (r *WinBackRepository) RecordAttempt(ctx context.Context, attempt *model.WinBackAttempt) error {
+	query := `
+		CREATE win_back_attempt SET
+			user = type::record($user_id),
+			group = $group,
+			last_active = $last_active,
+			new_members = $new_members,
+			suggested_events = $suggested_events,
+			targeted_on = $targeted_on
+	`
+	suggested := attempt.SuggestedEvents
+	if suggested == nil {
+		suggested = []string{}
+	}
+	vars := map[string]interface{}{
+		"user_id":          attempt.UserID,
+		"group":            string(attempt.Group),
+		"last_active":      attempt.LastActive,
+		"new_members":      attempt.NewMembers,
+		"suggested_events": suggested,
+		"targeted_on":      attempt.TargetedOn,
+	}
+
+	result, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return err
+	}
+	created, err := extractCreatedRecord(result)
+	if err != nil {
+		return err
+	}
+	attempt.ID = created.ID
+	return nil
+}
+
+// MarkReturned records a return for attempts targeted since targetedSince
+// whose user has been active since. Callers pass the start of the return
+// window so that only returns within it count. Returns how many were marked.
+func (r *WinBackRepository) MarkReturned(ctx context.Context, targetedSince time.Time) (int, error) {
+	query := `
+		UPDATE win_back_attempt SET
+			returned_on = (SELECT VALUE last_active FROM user_profile WHERE user = $parent.user LIMIT 1)[0]
+		WHERE returned_on = NONE
+			AND targeted_on >= $targeted_since
+			AND (SELECT VALUE last_active FROM user_profile WHERE user = $parent.user LIMIT 1)[0] > targeted_on
+		RETURN AFTER
+	`
+
+	results, err := r.db.Query(ctx, query, map[string]interface{}{"targeted_since": targetedSince})
+	if err != nil {
+		return 0, err
+	}
+	return len(flattenResults(results)), nil
+}
+
+// GetGroupStats counts, per group, the attempts targeted in [since, until)
+// and how many of those users returned
+func (r *WinBackRepository) GetGroupStats(ctx context.Context, since, until time.Time) ([]model.WinBackGroupStats, error) {
+	query := `
+		SELECT group, count() AS targeted, count(returned_on != NONE) AS returned
+		FROM win_back_attempt
+		WHERE targeted_on >= $since AND targeted_on < $until
+		GROUP BY group
+	`
+	vars := map[string]interface{}{
+		"since": since,
+		"until": until,
+	}
+
+	results, err := r.db.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := flattenResults(results)
+	stats := make([]model.WinBackGroupStats, 0, len(rows))
+	for _, row := range rows {
+		stats = append(stats, model.WinBackGroupStats{
+			Group:    model.WinBackGroup(getString(row, "group")),
+			Targeted: getInt(row, "targeted"),
+			Returned: getInt(row, "returned"),
+		})
+	}
+	return stats, nil
+}
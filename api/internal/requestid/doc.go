@@ -0,0 +1,16 @@
+// Package requestid carries the ID of the request that caused some work, so
+// it can be traced from the HTTP response through to the database, the push
+// provider and background jobs.
+//
+// # Usage
+//
+// middleware.RequestID assigns the ID, accepting a valid one from the
+// client's X-Request-ID header. Anything below reads it from the context:
+//
+//	log.Printf("[PushService] sending (request %s)", requestid.FromContext(ctx))
+//
+// Work queued for later stores the ID with its record, and the job that
+// picks it up puts it back in the context:
+//
+//	ctx = requestid.NewContext(ctx, entry.RequestID)
+package requestid
@@ -0,0 +1,57 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Header is the HTTP header carrying the request ID, both ways
+const Header = "X-Request-ID"
+
+// MaxLength is the longest request ID accepted from a client
+const MaxLength = 128
+
+type contextKey string
+
+// ContextKey is the context key the request ID is stored under
+const ContextKey contextKey = "requestID"
+
+// New returns a new random request ID
+func New() string {
+	return uuid.New().String()
+}
+
+// Valid reports whether a client-supplied ID can be used as is: 1 to
+// MaxLength letters, digits, '-', '_', '.' or ':'. Anything else could break
+// log lines or query comments, so it is replaced instead.
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NewContext returns ctx carrying id. An empty id leaves ctx as it is.
+func NewContext(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ContextKey, id)
+}
+
+// FromContext returns the request ID in ctx, or "" if there is none
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(ContextKey).(string); ok {
+		return id
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"3f2b8c1e-7d4a-4c55-9a1e-2b6f0d9c8e71", true},
+		{"ios-app:checkout.retry_2", true},
+		{"", false},
+		{strings.Repeat("a", MaxLength), true},
+		{strings.Repeat("a", MaxLength+1), false},
+		{"abc def", false},
+		{"abc\nSELECT * FROM user", false},
+		{"abc*/", false},
+		{"héllo", false},
+	}
+	for _, tt := range tests {
+		if got := Valid(tt.id); got != tt.want {
+			t.Errorf("Valid(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	if got := FromContext(ctx); got != "" {
+		t.Errorf("FromContext(empty) = %q, want \"\"", got)
+	}
+	if NewContext(ctx, "") != ctx {
+		t.Error("NewContext with an empty ID should return ctx unchanged")
+	}
+	if got := FromContext(NewContext(ctx, "req-1")); got != "req-1" {
+		t.Errorf("FromContext() = %q, want req-1", got)
+	}
+	if !Valid(New()) {
+		t.Error("New() returned an ID Valid rejects")
+	}
+}
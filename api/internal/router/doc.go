@@ -0,0 +1,20 @@
+// Package router groups routes by API version.
+//
+// Each version is a Group over the shared http.ServeMux. Routes are
+// registered with patterns relative to the version's prefix, and each is
+// wrapped in the version's middleware after the global stack has run, so
+// /v1 and /v2 can serve the same resource with different handlers and
+// different behavior:
+//
+//	v1 := router.NewGroup(mux, "/v1", middleware.Deprecation(v1Deprecation))
+//	v2 := router.NewGroup(mux, "/v2")
+//
+//	v1.HandleFunc("GET /guilds", guildHandler.List)   // GET /v1/guilds
+//	v2.HandleFunc("GET /guilds", guildV2Handler.List) // GET /v2/guilds
+//
+// Clients choose a version by path. A resource that a version doesn't
+// serve is a 404 from that version, even if another version serves it.
+//
+// Handlers that register their own routes take Routes, which both a Group
+// and an http.ServeMux satisfy.
+package router
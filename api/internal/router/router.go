@@ -0,0 +1,67 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/middleware"
+)
+
+// Routes is where handlers register their routes
+type Routes interface {
+	Handle(pattern string, handler http.Handler)
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+}
+
+// Group registers routes under a path prefix, such as an API version, and
+// wraps each in the group's middleware
+type Group struct {
+	mux        *http.ServeMux
+	prefix     string
+	middleware []middleware.Middleware
+}
+
+// NewGroup creates a route group. prefix starts with a slash and has none
+// at the end, e.g. "/v2".
+func NewGroup(mux *http.ServeMux, prefix string, middlewares ...middleware.Middleware) *Group {
+	return &Group{
+		mux:        mux,
+		prefix:     strings.TrimSuffix(prefix, "/"),
+		middleware: middlewares,
+	}
+}
+
+// Group creates a group nested under this one, e.g. /v1/admin. Its routes
+// run this group's middleware, then its own.
+func (g *Group) Group(prefix string, middlewares ...middleware.Middleware) *Group {
+	return &Group{
+		mux:        g.mux,
+		prefix:     g.prefix + strings.TrimSuffix(prefix, "/"),
+		middleware: append(append([]middleware.Middleware{}, g.middleware...), middlewares...),
+	}
+}
+
+// Prefix returns the path prefix of the group's routes
+func (g *Group) Prefix() string {
+	return g.prefix
+}
+
+// Handle registers a handler. The pattern is an http.ServeMux pattern
+// whose path is relative to the group, so "GET /guilds/{id}" in the /v1
+// group serves GET /v1/guilds/{id}.
+func (g *Group) Handle(pattern string, handler http.Handler) {
+	method, path, found := strings.Cut(pattern, " ")
+	if !found {
+		method, path = "", pattern
+	}
+	pattern = g.prefix + path
+	if method != "" {
+		pattern = method + " " + pattern
+	}
+	g.mux.Handle(pattern, middleware.Chain(handler, g.middleware...))
+}
+
+// HandleFunc registers a handler function, as Handle does
+func (g *Group) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	g.Handle(pattern, http.HandlerFunc(handler))
+}
@@ -0,0 +1,162 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/middleware"
+)
+
+// setHeader is middleware that marks responses, to see which version's
+// middleware ran
+func setHeader(name, value string) middleware.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(name, value)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// respond writes body, followed by the id path value when there is one
+func respond(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, body+r.PathValue("id"))
+	}
+}
+
+// newVersionedMux serves guilds from /v1 and /v2 the way the server does,
+// with /v1 deprecated and each version marked by its middleware
+func newVersionedMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", respond("ok"))
+
+	v1 := NewGroup(mux, "/v1",
+		setHeader("X-Served-By", "v1"),
+		middleware.Deprecation(middleware.DeprecationConfig{
+			DeprecatedOn: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			SunsetOn:     time.Date(2027, 7, 1, 0, 0, 0, 0, time.UTC),
+		}),
+	)
+	v2 := NewGroup(mux, "/v2/", setHeader("X-Served-By", "v2"))
+
+	v1.HandleFunc("GET /guilds/{id}", respond("v1 guild "))
+	v1.HandleFunc("GET /timers", respond("v1 timers"))
+	v2.HandleFunc("GET /guilds/{id}", respond("v2 guild "))
+	v2.Handle("DELETE /guilds/{id}", respond("v2 deleted "))
+	return mux
+}
+
+func serve(mux *http.ServeMux, method, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
+
+// ============================================================================
+// Version Negotiation Tests
+// ============================================================================
+
+func TestVersions_RouteByPrefix(t *testing.T) {
+	t.Parallel()
+	mux := newVersionedMux()
+
+	tests := []struct {
+		method   string
+		path     string
+		status   int
+		body     string
+		servedBy string
+	}{
+		{http.MethodGet, "/v1/guilds/guild:1", http.StatusOK, "v1 guild guild:1", "v1"},
+		{http.MethodGet, "/v2/guilds/guild:1", http.StatusOK, "v2 guild guild:1", "v2"},
+		{http.MethodDelete, "/v2/guilds/guild:1", http.StatusOK, "v2 deleted guild:1", "v2"},
+		{http.MethodGet, "/v1/timers", http.StatusOK, "v1 timers", "v1"},
+		{http.MethodGet, "/health", http.StatusOK, "ok", ""},
+
+		// A version only serves what it registers
+		{http.MethodGet, "/v2/timers", http.StatusNotFound, "", ""},
+		{http.MethodDelete, "/v1/guilds/guild:1", http.StatusMethodNotAllowed, "", ""},
+		{http.MethodGet, "/v3/guilds/guild:1", http.StatusNotFound, "", ""},
+		{http.MethodGet, "/guilds/guild:1", http.StatusNotFound, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			t.Parallel()
+			rec := serve(mux, tt.method, tt.path)
+			if rec.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, rec.Code)
+			}
+			if tt.body != "" && rec.Body.String() != tt.body {
+				t.Errorf("expected body %q, got %q", tt.body, rec.Body.String())
+			}
+			if got := rec.Header().Get("X-Served-By"); got != tt.servedBy {
+				t.Errorf("expected middleware of %q, got %q", tt.servedBy, got)
+			}
+		})
+	}
+}
+
+func TestVersions_DeprecationOnlyOnV1(t *testing.T) {
+	t.Parallel()
+	mux := newVersionedMux()
+
+	v1 := serve(mux, http.MethodGet, "/v1/guilds/guild:1")
+	if v1.Header().Get("Deprecation") == "" || v1.Header().Get("Sunset") == "" {
+		t.Errorf("expected Deprecation and Sunset on /v1, got %v", v1.Header())
+	}
+
+	for _, path := range []string{"/v2/guilds/guild:1", "/health"} {
+		rec := serve(mux, http.MethodGet, path)
+		if rec.Header().Get("Deprecation") != "" || rec.Header().Get("Sunset") != "" {
+			t.Errorf("expected %s not to be deprecated, got %v", path, rec.Header())
+		}
+	}
+}
+
+func TestGroup_Prefix(t *testing.T) {
+	t.Parallel()
+
+	if got := NewGroup(http.NewServeMux(), "/v2/").Prefix(); got != "/v2" {
+		t.Errorf("expected /v2, got %q", got)
+	}
+}
+
+func TestGroup_PatternWithoutMethod(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	var routes Routes = NewGroup(mux, "/v2")
+	routes.HandleFunc("/ping", respond("pong"))
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		if rec := serve(mux, method, "/v2/ping"); rec.Body.String() != "pong" {
+			t.Errorf("expected %s /v2/ping to match, got %d %q", method, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestGroup_Nested(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	v1 := NewGroup(mux, "/v1", setHeader("X-Served-By", "v1"))
+	admin := v1.Group("/admin/", setHeader("X-Admin", "yes"))
+	admin.HandleFunc("PATCH /region", respond("region"))
+
+	if got := admin.Prefix(); got != "/v1/admin" {
+		t.Errorf("expected /v1/admin, got %q", got)
+	}
+
+	rec := serve(mux, http.MethodPatch, "/v1/admin/region")
+	if rec.Body.String() != "region" {
+		t.Fatalf("expected PATCH /v1/admin/region to match, got %d %q", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("X-Served-By") != "v1" || rec.Header().Get("X-Admin") != "yes" {
+		t.Errorf("expected the middleware of both groups, got %v", rec.Header())
+	}
+}
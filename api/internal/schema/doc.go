@@ -0,0 +1,20 @@
+// Package schema checks requests against the OpenAPI spec.
+//
+// Load compiles the request body and query parameter schemas of every
+// operation once, at startup:
+//
+//	validator, err := schema.Load(openapi.FS, openapi.Root)
+//
+// Operations are looked up by route pattern, and report what's wrong as
+// field errors for a 422 problem:
+//
+//	op := validator.Operation("POST /v1/availability")
+//	errs := op.ValidateBody(r.Header.Get("Content-Type"), body)
+//
+// The keywords the spec uses are checked: type, nullable, enum, required,
+// properties, additionalProperties, items, allOf, length, item count and
+// numeric bounds, pattern, and the email, date-time, date, uri and byte
+// formats. A property sent as null is treated as left out, as handlers
+// decode it, so merge patches can clear fields. Unknown properties are
+// allowed, since handlers refuse them as they decode.
+package schema
@@ -0,0 +1,434 @@
+package schema
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// schema is a compiled JSON Schema. Only the keywords the spec uses are
+// checked; a schema with none of them accepts anything.
+type schema struct {
+	types      []string // Empty accepts any type
+	nullable   bool
+	format     string
+	enum       []any
+	allOf      []*schema
+	required   []string
+	properties map[string]*schema
+	additional *schema // Schema of properties not listed; nil accepts any
+	items      *schema
+	pattern    *regexp.Regexp
+
+	minLength, maxLength       *int
+	minItems, maxItems         *int
+	minimum, maximum           *float64
+	exclusiveMin, exclusiveMax *float64
+}
+
+// compiler turns spec schemas into compiled ones. Schemas reached through
+// the same $ref are compiled once, which also ends recursive schemas.
+type compiler struct {
+	spec     *spec
+	refs     map[string]*schema
+	dangling []string
+}
+
+// compile compiles the schema value found in file
+func (c *compiler) compile(file string, value any) (*schema, error) {
+	m, _ := value.(map[string]any)
+	target, _ := m["$ref"].(string)
+	if target == "" {
+		s := &schema{}
+		return s, c.fill(s, file, m)
+	}
+
+	value, at, err := c.spec.lookup(file, target)
+	if errors.Is(err, errDangling) {
+		if _, seen := c.refs[at.String()]; !seen {
+			c.dangling = append(c.dangling, at.pointer)
+			c.refs[at.String()] = &schema{}
+		}
+		return c.refs[at.String()], nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := c.refs[at.String()]; ok {
+		return s, nil
+	}
+	s := &schema{}
+	c.refs[at.String()] = s
+	return c.compileRef(s, at.file, value)
+}
+
+// compileRef fills s from a value a $ref pointed to, which may itself be a $ref
+func (c *compiler) compileRef(s *schema, file string, value any) (*schema, error) {
+	m, file, err := c.spec.resolve(file, value)
+	if errors.Is(err, errDangling) {
+		c.dangling = append(c.dangling, err.Error())
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s, c.fill(s, file, m)
+}
+
+// fill reads the keywords of a schema
+func (c *compiler) fill(s *schema, file string, m map[string]any) error {
+	switch t := m["type"].(type) {
+	case string:
+		s.types = []string{t}
+	case []any:
+		for _, v := range t {
+			if name, ok := v.(string); ok {
+				s.types = append(s.types, name)
+			}
+		}
+	}
+	s.nullable, _ = m["nullable"].(bool)
+	s.format, _ = m["format"].(string)
+	s.enum, _ = m["enum"].([]any)
+	for _, name := range asSlice(m["required"]) {
+		if name, ok := name.(string); ok {
+			s.required = append(s.required, name)
+		}
+	}
+
+	if pattern, ok := m["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("%s: pattern %q: %w", file, pattern, err)
+		}
+		s.pattern = re
+	}
+	s.minLength, s.maxLength = intKeyword(m, "minLength"), intKeyword(m, "maxLength")
+	s.minItems, s.maxItems = intKeyword(m, "minItems"), intKeyword(m, "maxItems")
+	s.minimum, s.maximum = numberKeyword(m, "minimum"), numberKeyword(m, "maximum")
+	s.exclusiveMin, s.exclusiveMax = numberKeyword(m, "exclusiveMinimum"), numberKeyword(m, "exclusiveMaximum")
+
+	var err error
+	for _, sub := range asSlice(m["allOf"]) {
+		var compiled *schema
+		if compiled, err = c.compile(file, sub); err != nil {
+			return err
+		}
+		s.allOf = append(s.allOf, compiled)
+	}
+	if properties, ok := m["properties"].(map[string]any); ok {
+		s.properties = make(map[string]*schema, len(properties))
+		for name, sub := range properties {
+			if s.properties[name], err = c.compile(file, sub); err != nil {
+				return err
+			}
+		}
+	}
+	if additional, ok := m["additionalProperties"].(map[string]any); ok {
+		if s.additional, err = c.compile(file, additional); err != nil {
+			return err
+		}
+	}
+	if items, ok := m["items"]; ok {
+		if s.items, err = c.compile(file, items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func asSlice(v any) []any {
+	s, _ := v.([]any)
+	return s
+}
+
+func intKeyword(m map[string]any, key string) *int {
+	if n, ok := m[key].(int); ok {
+		return &n
+	}
+	return nil
+}
+
+func numberKeyword(m map[string]any, key string) *float64 {
+	var n float64
+	switch v := m[key].(type) {
+	case int:
+		n = float64(v)
+	case float64:
+		n = v
+	default:
+		return nil
+	}
+	return &n
+}
+
+// validate checks a decoded JSON value, with numbers as json.Number, and
+// appends what's wrong with it to errs. field is the value's path, such as
+// "location.city" or "tags[2]".
+func (s *schema) validate(v any, field string, errs *[]model.FieldError) {
+	if v == nil {
+		if !s.allowsNull() {
+			*errs = append(*errs, fieldError(field, "must be "+describeTypes(s.types)))
+		}
+		return
+	}
+	if len(s.types) > 0 && !matchesType(v, s.types) {
+		*errs = append(*errs, fieldError(field, "must be "+describeTypes(s.types)))
+		return
+	}
+	for _, sub := range s.allOf {
+		sub.validate(v, field, errs)
+	}
+	if len(s.enum) > 0 && !inEnum(v, s.enum) {
+		*errs = append(*errs, fieldError(field, "must be "+describeEnum(s.enum)))
+		return
+	}
+
+	switch v := v.(type) {
+	case string:
+		s.validateString(v, field, errs)
+	case json.Number:
+		s.validateNumber(v, field, errs)
+	case []any:
+		s.validateArray(v, field, errs)
+	case map[string]any:
+		s.validateObject(v, field, errs)
+	}
+}
+
+func (s *schema) validateString(v, field string, errs *[]model.FieldError) {
+	length := utf8.RuneCountInString(v)
+	switch {
+	case s.minLength != nil && length < *s.minLength:
+		if length == 0 {
+			*errs = append(*errs, fieldError(field, "cannot be empty"))
+		} else {
+			*errs = append(*errs, fieldError(field, fmt.Sprintf("must be at least %d characters", *s.minLength)))
+		}
+		return
+	case s.maxLength != nil && length > *s.maxLength:
+		*errs = append(*errs, fieldError(field, fmt.Sprintf("must be %d characters or less", *s.maxLength)))
+		return
+	case s.pattern != nil && !s.pattern.MatchString(v):
+		*errs = append(*errs, fieldError(field, "must match "+s.pattern.String()))
+		return
+	}
+	if message := checkFormat(s.format, v); message != "" {
+		*errs = append(*errs, fieldError(field, message))
+	}
+}
+
+// checkFormat returns what's wrong with a string of the given format, or
+// empty. Formats that are only hints, such as password, aren't checked.
+func checkFormat(format, v string) string {
+	switch format {
+	case "email":
+		if addr, err := mail.ParseAddress(v); err != nil || addr.Address != v {
+			return "must be a valid email address"
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			return "must be an RFC 3339 timestamp"
+		}
+	case "date":
+		if _, err := time.Parse(time.DateOnly, v); err != nil {
+			return "must be a date (YYYY-MM-DD)"
+		}
+	case "uri":
+		if u, err := url.Parse(v); err != nil || u.Scheme == "" {
+			return "must be an absolute URI"
+		}
+	case "byte":
+		if _, err := base64.StdEncoding.DecodeString(v); err != nil {
+			return "must be base64 encoded"
+		}
+	}
+	return ""
+}
+
+func (s *schema) validateNumber(v json.Number, field string, errs *[]model.FieldError) {
+	n, err := v.Float64()
+	if err != nil {
+		*errs = append(*errs, fieldError(field, "must be a number"))
+		return
+	}
+	outOfRange := s.minimum != nil && n < *s.minimum || s.maximum != nil && n > *s.maximum
+	switch {
+	case outOfRange && s.minimum != nil && s.maximum != nil:
+		*errs = append(*errs, fieldError(field, "must be between "+formatNumber(*s.minimum)+" and "+formatNumber(*s.maximum)))
+	case outOfRange && s.minimum != nil:
+		*errs = append(*errs, fieldError(field, "must be at least "+formatNumber(*s.minimum)))
+	case outOfRange:
+		*errs = append(*errs, fieldError(field, "must be at most "+formatNumber(*s.maximum)))
+	case s.exclusiveMin != nil && n <= *s.exclusiveMin:
+		*errs = append(*errs, fieldError(field, "must be greater than "+formatNumber(*s.exclusiveMin)))
+	case s.exclusiveMax != nil && n >= *s.exclusiveMax:
+		*errs = append(*errs, fieldError(field, "must be less than "+formatNumber(*s.exclusiveMax)))
+	}
+}
+
+func (s *schema) validateArray(v []any, field string, errs *[]model.FieldError) {
+	switch {
+	case s.minItems != nil && len(v) < *s.minItems:
+		*errs = append(*errs, fieldError(field, fmt.Sprintf("must have at least %d items", *s.minItems)))
+		return
+	case s.maxItems != nil && len(v) > *s.maxItems:
+		*errs = append(*errs, fieldError(field, fmt.Sprintf("must have at most %d items", *s.maxItems)))
+		return
+	}
+	if s.items == nil {
+		return
+	}
+	for i, item := range v {
+		s.items.validate(item, fmt.Sprintf("%s[%d]", field, i), errs)
+	}
+}
+
+// validateObject checks an object's properties. A property sent as null is
+// treated as left out, as handlers decode it, unless its schema allows null;
+// merge patches send null to clear a field.
+func (s *schema) validateObject(v map[string]any, field string, errs *[]model.FieldError) {
+	for _, name := range s.required {
+		if value, ok := v[name]; !ok || value == nil && !s.properties[name].allowsNull() {
+			*errs = append(*errs, fieldError(join(field, name), "is required"))
+		}
+	}
+
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sub, ok := s.properties[name]
+		if !ok {
+			sub = s.additional
+		}
+		if sub == nil || v[name] == nil {
+			continue
+		}
+		sub.validate(v[name], join(field, name), errs)
+	}
+}
+
+// allowsNull reports whether null is a valid value. A nil schema is a
+// property the spec doesn't describe.
+func (s *schema) allowsNull() bool {
+	return s == nil || s.nullable || len(s.types) == 0 || contains(s.types, "null")
+}
+
+// matchesType reports whether a decoded value is one of types. Integers
+// are numbers with no fractional part.
+func matchesType(v any, types []string) bool {
+	var kind string
+	switch v := v.(type) {
+	case string:
+		kind = "string"
+	case bool:
+		kind = "boolean"
+	case []any:
+		kind = "array"
+	case map[string]any:
+		kind = "object"
+	case json.Number:
+		kind = "number"
+		if n, err := v.Float64(); err == nil && n == math.Trunc(n) && contains(types, "integer") {
+			return true
+		}
+	}
+	return contains(types, kind)
+}
+
+// inEnum compares numbers by value, since enums are read from YAML
+func inEnum(v any, enum []any) bool {
+	for _, allowed := range enum {
+		switch allowed := allowed.(type) {
+		case int:
+			if n, ok := v.(json.Number); ok && n.String() == strconv.Itoa(allowed) {
+				return true
+			}
+		case float64:
+			if n, ok := v.(json.Number); ok {
+				if f, err := n.Float64(); err == nil && f == allowed {
+					return true
+				}
+			}
+		default:
+			if v == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func describeTypes(types []string) string {
+	described := make([]string, len(types))
+	for i, t := range types {
+		switch t {
+		case "integer", "object", "array":
+			described[i] = "an " + t
+		default:
+			described[i] = "a " + t
+		}
+	}
+	return strings.Join(described, " or ")
+}
+
+// describeEnum lists values as "a", "a or b" or "a, b, or c", as
+// model.Validator does
+func describeEnum(enum []any) string {
+	values := make([]string, len(enum))
+	for i, v := range enum {
+		values[i] = fmt.Sprint(v)
+	}
+	switch len(values) {
+	case 1:
+		return values[0]
+	case 2:
+		return values[0] + " or " + values[1]
+	}
+	return strings.Join(values[:len(values)-1], ", ") + ", or " + values[len(values)-1]
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// join returns the path of a property of the value at field
+func join(field, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
+
+// fieldError describes a problem with the value at field. The request body
+// itself is reported as "body".
+func fieldError(field, problem string) model.FieldError {
+	if field == "" {
+		return model.FieldError{Field: "body", Message: "request body " + problem}
+	}
+	return model.FieldError{Field: field, Message: field + " " + problem}
+}
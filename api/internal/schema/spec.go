@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// errDangling is a $ref to something the spec doesn't define
+var errDangling = errors.New("dangling $ref")
+
+// spec reads the OpenAPI document and the files its $refs point into
+type spec struct {
+	fsys fs.FS
+	docs map[string]any // Root value of each loaded file, by path
+}
+
+// ref is a location in the spec, which relative $refs inside it are
+// resolved against
+type ref struct {
+	file    string
+	pointer string
+}
+
+func (r ref) String() string {
+	return r.file + "#" + r.pointer
+}
+
+// load reads a file once and returns its root value
+func (s *spec) load(file string) (any, error) {
+	if doc, ok := s.docs[file]; ok {
+		return doc, nil
+	}
+	data, err := fs.ReadFile(s.fsys, file)
+	if err != nil {
+		return nil, err
+	}
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	s.docs[file] = doc
+	return doc, nil
+}
+
+// lookup returns the value a $ref written in file points to, and where it is
+func (s *spec) lookup(file, target string) (any, ref, error) {
+	name, pointer, _ := strings.Cut(target, "#")
+	if name != "" {
+		file = path.Join(path.Dir(file), name)
+	}
+	at := ref{file: file, pointer: pointer}
+
+	value, err := s.load(file)
+	if err != nil {
+		return nil, at, err
+	}
+	for _, part := range strings.Split(strings.Trim(pointer, "/"), "/") {
+		if part == "" {
+			continue
+		}
+		part = strings.NewReplacer("~1", "/", "~0", "~").Replace(part)
+		m, _ := value.(map[string]any)
+		if value = m[part]; value == nil {
+			return nil, at, fmt.Errorf("%s: %w %q", file, errDangling, target)
+		}
+	}
+	return value, at, nil
+}
+
+// resolve follows $refs from a value in file until it reaches one that
+// isn't a $ref. It returns the value and the file it was found in.
+func (s *spec) resolve(file string, value any) (map[string]any, string, error) {
+	for range 16 {
+		m, _ := value.(map[string]any)
+		target, _ := m["$ref"].(string)
+		if target == "" {
+			return m, file, nil
+		}
+		var at ref
+		var err error
+		if value, at, err = s.lookup(file, target); err != nil {
+			return nil, file, err
+		}
+		file = at.file
+	}
+	return nil, file, fmt.Errorf("%s: $ref chain too long", file)
+}
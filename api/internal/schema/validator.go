@@ -0,0 +1,283 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"mime"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// methods are the HTTP methods operations are read from
+var methods = []string{"get", "post", "put", "patch", "delete"}
+
+// Mode is how requests that don't match the spec are treated
+type Mode string
+
+const (
+	ModeOff     Mode = "off"     // Requests aren't checked
+	ModeReport  Mode = "report"  // Requests are checked and failures logged, not rejected
+	ModeEnforce Mode = "enforce" // Requests that fail are rejected with 422
+)
+
+// IsValid returns true if the mode is known
+func (m Mode) IsValid() bool {
+	switch m {
+	case ModeOff, ModeReport, ModeEnforce:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validator holds the request schemas of every operation in the spec
+type Validator struct {
+	operations map[string]*Operation // By method and path, with parameters as {}
+}
+
+// Operation is what one route accepts
+type Operation struct {
+	ID     string
+	bodies map[string]*schema // Request body schema by media type
+	query  []queryParam
+}
+
+// clampedParams are query parameters whose handlers reduce values above the
+// documented maximum to the maximum, so those values aren't errors. Their
+// other rules, including the minimum, still apply.
+var clampedParams = map[string]bool{"limit": true}
+
+// queryParam is a query parameter the spec defines
+type queryParam struct {
+	name     string
+	required bool
+	schema   *schema
+}
+
+// Load reads the spec whose entry document is root in fsys and compiles
+// the request schemas of its operations. $refs to schemas the spec doesn't
+// define accept any value and are logged.
+func Load(fsys fs.FS, root string) (*Validator, error) {
+	s := &spec{fsys: fsys, docs: make(map[string]any)}
+	c := &compiler{spec: s, refs: make(map[string]*schema)}
+
+	doc, err := s.load(root)
+	if err != nil {
+		return nil, err
+	}
+	paths, _ := doc.(map[string]any)["paths"].(map[string]any)
+
+	v := &Validator{operations: make(map[string]*Operation)}
+	for path, item := range paths {
+		pathItem, file, err := s.resolve(root, item)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		for _, method := range methods {
+			op, ok := pathItem[method].(map[string]any)
+			if !ok {
+				continue
+			}
+			operation, err := c.operation(file, pathItem, op)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+			}
+			v.operations[strings.ToUpper(method)+" "+normalizePath(path)] = operation
+		}
+	}
+
+	if len(c.dangling) > 0 {
+		log.Printf("[Schema] %d dangling $refs accept any value: %s", len(c.dangling), strings.Join(c.dangling, ", "))
+	}
+	return v, nil
+}
+
+// operation compiles the request body and query parameters of an operation
+// defined in file. Parameters of the path item apply to all its operations.
+func (c *compiler) operation(file string, pathItem, op map[string]any) (*Operation, error) {
+	operation := &Operation{bodies: make(map[string]*schema)}
+	operation.ID, _ = op["operationId"].(string)
+
+	params := append(asSlice(pathItem["parameters"]), asSlice(op["parameters"])...)
+	for _, p := range params {
+		param, paramFile, err := c.spec.resolve(file, p)
+		if err != nil {
+			return nil, err
+		}
+		if param["in"] != "query" {
+			continue
+		}
+		compiled, err := c.compile(paramFile, param["schema"])
+		if err != nil {
+			return nil, err
+		}
+		name, _ := param["name"].(string)
+		required, _ := param["required"].(bool)
+		operation.query = append(operation.query, queryParam{name: name, required: required, schema: compiled})
+	}
+
+	if op["requestBody"] == nil {
+		return operation, nil
+	}
+	body, bodyFile, err := c.spec.resolve(file, op["requestBody"])
+	if err != nil {
+		return nil, err
+	}
+	content, _ := body["content"].(map[string]any)
+	for mediaType, media := range content {
+		if !isJSON(mediaType) {
+			continue
+		}
+		m, _ := media.(map[string]any)
+		if m["schema"] == nil {
+			continue
+		}
+		if operation.bodies[mediaType], err = c.compile(bodyFile, m["schema"]); err != nil {
+			return nil, err
+		}
+	}
+	return operation, nil
+}
+
+// Operation returns the operation a route serves, or nil if the spec
+// doesn't define it. pattern is the route's http.ServeMux pattern, such as
+// "GET /v1/guilds/{id}"; parameter names needn't match the spec's.
+func (v *Validator) Operation(pattern string) *Operation {
+	return v.operations[normalizePath(pattern)]
+}
+
+// ValidatesBody reports whether bodies of the given Content-Type are
+// checked. Requests without one are taken to be JSON.
+func (o *Operation) ValidatesBody(contentType string) bool {
+	return o.bodySchema(contentType) != nil
+}
+
+// ValidateBody checks a request body against the operation's schema for
+// its Content-Type. Empty and malformed bodies are left for the handler to
+// reject as it decodes them.
+func (o *Operation) ValidateBody(contentType string, body []byte) []model.FieldError {
+	s := o.bodySchema(contentType)
+	if s == nil || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+
+	var errs []model.FieldError
+	s.validate(value, "", &errs)
+	return errs
+}
+
+// ValidateQuery checks the query parameters the operation defines.
+// Parameters it doesn't define, and empty ones, are ignored.
+func (o *Operation) ValidateQuery(query url.Values) []model.FieldError {
+	var errs []model.FieldError
+	for _, p := range o.query {
+		values := nonEmpty(query[p.name])
+		if len(values) == 0 {
+			if p.required {
+				errs = append(errs, fieldError(p.name, "is required"))
+			}
+			continue
+		}
+
+		if len(p.schema.types) == 1 && p.schema.types[0] == "array" {
+			items := make([]any, len(values))
+			for i, value := range values {
+				items[i] = queryValue(value, p.schema.items)
+			}
+			p.schema.validate(items, p.name, &errs)
+			continue
+		}
+		value := values[0]
+		if clampedParams[p.name] {
+			value = clamp(value, p.schema)
+		}
+		p.schema.validate(queryValue(value, p.schema), p.name, &errs)
+	}
+	return errs
+}
+
+// clamp returns the schema's maximum in place of a number above it, and
+// other values unchanged
+func clamp(value string, s *schema) string {
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || s.maximum == nil || n <= *s.maximum {
+		return value
+	}
+	return formatNumber(*s.maximum)
+}
+
+func (o *Operation) bodySchema(contentType string) *schema {
+	return o.bodies[mediaType(contentType)]
+}
+
+// queryValue converts a query string value to the JSON value s expects,
+// leaving it a string when it doesn't parse so the type check reports it
+func queryValue(value string, s *schema) any {
+	if s == nil || len(s.types) != 1 {
+		return value
+	}
+	switch s.types[0] {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+func nonEmpty(values []string) []string {
+	var kept []string
+	for _, value := range values {
+		if value != "" {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+// mediaType returns the media type of a Content-Type header, without
+// parameters. Requests without one are taken to be JSON.
+func mediaType(contentType string) string {
+	if contentType == "" {
+		return "application/json"
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// isJSON reports whether a media type is JSON, such as application/json or
+// application/merge-patch+json
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// pathParam matches a path parameter in the spec or a route pattern
+var pathParam = regexp.MustCompile(`\{[^}]*\}`)
+
+// normalizePath replaces path parameters with {} so spec paths and route
+// patterns compare equal whatever their parameters are named
+func normalizePath(pattern string) string {
+	pattern = strings.TrimSuffix(pattern, "{$}")
+	return pathParam.ReplaceAllString(pattern, "{}")
+}
@@ -0,0 +1,284 @@
+package schema
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/openapi"
+)
+
+// testSpec is a small spec split across files the way openapi/ is
+var testSpec = fstest.MapFS{
+	"openapi.yaml": {Data: []byte(`
+openapi: 3.0.3
+paths:
+  /v1/things:
+    $ref: ./paths/things.yaml#/things
+  /v1/things/{thingId}:
+    $ref: ./paths/things.yaml#/thing
+`)},
+	"paths/things.yaml": {Data: []byte(`
+things:
+  get:
+    operationId: listThings
+    parameters:
+      - name: limit
+        in: query
+        schema:
+          type: integer
+          minimum: 1
+          maximum: 100
+      - name: status
+        in: query
+        schema:
+          type: array
+          items:
+            type: string
+            enum: [open, closed]
+      - name: owner
+        in: query
+        required: true
+        schema:
+          type: string
+  post:
+    operationId: createThing
+    requestBody:
+      content:
+        application/json:
+          schema:
+            $ref: ../components/schemas/_index.yaml#/CreateThingRequest
+thing:
+  parameters:
+    - name: thingId
+      in: path
+      required: true
+      schema:
+        type: string
+  patch:
+    operationId: updateThing
+    requestBody:
+      content:
+        application/merge-patch+json:
+          schema:
+            $ref: ../components/schemas/_index.yaml#/UpdateThingRequest
+`)},
+	"components/schemas/_index.yaml": {Data: []byte(`
+CreateThingRequest:
+  type: object
+  required: [name, kind]
+  properties:
+    name:
+      type: string
+      minLength: 1
+      maxLength: 10
+    kind:
+      type: string
+      enum: [small, large]
+    email:
+      type: string
+      format: email
+    weight:
+      type: number
+      exclusiveMinimum: 0
+    tags:
+      type: array
+      maxItems: 2
+      items:
+        type: string
+    location:
+      $ref: '#/Location'
+    parent:
+      $ref: '#/Missing'
+Location:
+  type: object
+  required: [lat, lng]
+  properties:
+    lat:
+      type: number
+      minimum: -90
+      maximum: 90
+    lng:
+      type: number
+UpdateThingRequest:
+  type: object
+  properties:
+    name:
+      type: string
+      nullable: true
+    count:
+      type: integer
+`)},
+}
+
+func loadTestSpec(t *testing.T) *Validator {
+	t.Helper()
+	v, err := Load(testSpec, "openapi.yaml")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return v
+}
+
+// messages returns each field error as "field: message"
+func messages(errs []model.FieldError) string {
+	lines := make([]string, len(errs))
+	for i, e := range errs {
+		lines[i] = e.Field + ": " + e.Message
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ============================================================================
+// Operation Lookup Tests
+// ============================================================================
+
+func TestValidator_Operation(t *testing.T) {
+	t.Parallel()
+	v := loadTestSpec(t)
+
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"GET /v1/things", "listThings"},
+		{"POST /v1/things", "createThing"},
+		{"PATCH /v1/things/{id}", "updateThing"},
+	}
+	for _, tt := range tests {
+		op := v.Operation(tt.pattern)
+		if op == nil || op.ID != tt.want {
+			t.Errorf("Operation(%q) = %v, want %s", tt.pattern, op, tt.want)
+		}
+	}
+
+	if op := v.Operation("DELETE /v1/things/{id}"); op != nil {
+		t.Errorf("expected no operation for an unspecced route, got %s", op.ID)
+	}
+}
+
+// ============================================================================
+// Body Validation Tests
+// ============================================================================
+
+func TestOperation_ValidateBody(t *testing.T) {
+	t.Parallel()
+	op := loadTestSpec(t).Operation("POST /v1/things")
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"valid", `{"name":"box","kind":"small","weight":1.5,"tags":["a"],"location":{"lat":1,"lng":2}}`, ""},
+		{"missing required", `{}`, "name: name is required\nkind: kind is required"},
+		{"null required", `{"name":null,"kind":"small"}`, "name: name is required"},
+		{"empty string", `{"name":"","kind":"small"}`, "name: name cannot be empty"},
+		{"too long", `{"name":"abcdefghijk","kind":"small"}`, "name: name must be 10 characters or less"},
+		{"enum", `{"name":"box","kind":"medium"}`, "kind: kind must be small or large"},
+		{"format", `{"name":"box","kind":"small","email":"nope"}`, "email: email must be a valid email address"},
+		{"exclusive minimum", `{"name":"box","kind":"small","weight":0}`, "weight: weight must be greater than 0"},
+		{"wrong type", `{"name":7,"kind":"small"}`, "name: name must be a string"},
+		{"item count", `{"name":"box","kind":"small","tags":["a","b","c"]}`, "tags: tags must have at most 2 items"},
+		{"item type", `{"name":"box","kind":"small","tags":["a",1]}`, "tags[1]: tags[1] must be a string"},
+		{"nested", `{"name":"box","kind":"small","location":{"lat":91}}`, "location.lng: location.lng is required\nlocation.lat: location.lat must be between -90 and 90"},
+		{"dangling ref", `{"name":"box","kind":"small","parent":{"any":"thing"}}`, ""},
+		{"not an object", `[]`, "body: request body must be an object"},
+		{"empty body", ``, ""},
+		{"malformed", `{"name":`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := messages(op.ValidateBody("application/json; charset=utf-8", []byte(tt.body)))
+			if got != tt.want {
+				t.Errorf("ValidateBody(%s):\ngot:\n%s\nwant:\n%s", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperation_ValidateBody_MergePatch(t *testing.T) {
+	t.Parallel()
+	op := loadTestSpec(t).Operation("PATCH /v1/things/{thingId}")
+
+	if op.ValidatesBody("application/json") {
+		t.Error("expected application/json bodies not to be checked against a merge patch schema")
+	}
+	if !op.ValidatesBody("application/merge-patch+json") {
+		t.Fatal("expected merge patch bodies to be checked")
+	}
+	if errs := op.ValidateBody("application/merge-patch+json", []byte(`{"name":null}`)); len(errs) != 0 {
+		t.Errorf("expected null to clear a nullable field, got %s", messages(errs))
+	}
+	if got := messages(op.ValidateBody("application/merge-patch+json", []byte(`{"count":1.5}`))); got != "count: count must be an integer" {
+		t.Errorf("expected an integer error, got %q", got)
+	}
+}
+
+// ============================================================================
+// Query Validation Tests
+// ============================================================================
+
+func TestOperation_ValidateQuery(t *testing.T) {
+	t.Parallel()
+	op := loadTestSpec(t).Operation("GET /v1/things")
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"valid", "owner=me&limit=20&status=open&status=closed", ""},
+		{"missing required", "limit=20", "owner: owner is required"},
+		{"empty ignored", "owner=me&limit=", ""},
+		{"not a number", "owner=me&limit=lots", "limit: limit must be an integer"},
+		{"limit above maximum clamped", "owner=me&limit=500", ""},
+		{"limit below minimum", "owner=me&limit=0", "limit: limit must be between 1 and 100"},
+		{"array item", "owner=me&status=open&status=lost", "status[1]: status[1] must be open or closed"},
+		{"unknown ignored", "owner=me&sort=name", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			query, _ := url.ParseQuery(tt.query)
+			if got := messages(op.ValidateQuery(query)); got != tt.want {
+				t.Errorf("ValidateQuery(%s):\ngot:\n%s\nwant:\n%s", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// API Spec Tests
+// ============================================================================
+
+func TestLoad_APISpec(t *testing.T) {
+	t.Parallel()
+	v, err := Load(openapi.FS, openapi.Root)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	tests := []struct {
+		pattern string
+		body    string
+	}{
+		{"POST /v1/auth/login", `{"email":"ada@example.com","password":"correct horse battery"}`},
+		{"POST /v1/availability", `{"hangout_type":"talk_it_out","start_time":"2026-10-16T18:00:00Z","end_time":"2026-10-16T20:00:00Z","location":{"lat":45.5,"lng":-122.6}}`},
+		{"POST /v1/reviews", `{"reviewee_id":"user:abc","context":"hangout","would_meet_again":true}`},
+		{"POST /v1/questions/{questionId}/answer", `{"selected_option":"a","importance":"very"}`},
+	}
+	for _, tt := range tests {
+		op := v.Operation(tt.pattern)
+		if op == nil {
+			t.Errorf("expected the spec to describe %s", tt.pattern)
+			continue
+		}
+		if errs := op.ValidateBody("application/json", []byte(tt.body)); len(errs) != 0 {
+			t.Errorf("%s rejected a valid body:\n%s", tt.pattern, messages(errs))
+		}
+	}
+}
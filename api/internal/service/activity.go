@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ActivityRecorder is called by services after an action completes so it can
+// be added to the timelines of the users involved. Recording is best-effort:
+// implementations log failures rather than failing the action.
+type ActivityRecorder interface {
+	Record(ctx context.Context, entries ...*model.ActivityEntry)
+}
+
+// recordActivity forwards entries to the recorder when one is configured
+func recordActivity(ctx context.Context, recorder ActivityRecorder, entries ...*model.ActivityEntry) {
+	if recorder == nil || len(entries) == 0 {
+		return
+	}
+	recorder.Record(ctx, entries...)
+}
+
+// ActivityRepository defines the interface for activity ledger storage
+type ActivityRepository interface {
+	Create(ctx context.Context, entry *model.ActivityEntry) error
+	GetByUser(ctx context.Context, userID string, before *time.Time, limit int) ([]*model.ActivityEntry, error)
+}
+
+// ActivityService writes the activity ledger and serves users' activity timelines
+type ActivityService struct {
+	repo ActivityRepository
+}
+
+// ActivityServiceConfig holds configuration for the activity service
+type ActivityServiceConfig struct {
+	Repo ActivityRepository
+}
+
+// NewActivityService creates a new activity service
+func NewActivityService(cfg ActivityServiceConfig) *ActivityService {
+	return &ActivityService{
+		repo: cfg.Repo,
+	}
+}
+
+// Record writes entries to the activity ledger, logging any failures
+func (s *ActivityService) Record(ctx context.Context, entries ...*model.ActivityEntry) {
+	for _, entry := range entries {
+		if err := s.repo.Create(ctx, entry); err != nil {
+			log.Printf("[ActivityService] Failed to record %s activity for user %s: %v", entry.Type, entry.UserID, err)
+		}
+	}
+}
+
+// GetTimeline returns a page of the user's activity, newest first.
+// cursor is the NextCursor of the previous page, or empty for the first page.
+func (s *ActivityService) GetTimeline(ctx context.Context, userID, cursor string, limit int) (*model.ActivityPage, error) {
+	if limit <= 0 {
+		limit = model.DefaultActivityPageSize
+	}
+	if limit > model.MaxActivityPageSize {
+		limit = model.MaxActivityPageSize
+	}
+
+	var before *time.Time
+	if cursor != "" {
+		t, err := time.Parse(time.RFC3339Nano, cursor)
+		if err != nil {
+			return nil, ErrInvalidActivityCursor
+		}
+		before = &t
+	}
+
+	// Fetch one extra entry to learn whether an older page exists
+	entries, err := s.repo.GetByUser(ctx, userID, before, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.ActivityPage{Entries: entries}
+	if len(entries) > limit {
+		page.Entries = entries[:limit]
+		page.NextCursor = page.Entries[limit-1].CreatedOn.UTC().Format(time.RFC3339Nano)
+	}
+	return page, nil
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// mockActivityRepo is an in-memory activity ledger that doubles as a recorder
+type mockActivityRepo struct {
+	mu      sync.Mutex
+	entries []*model.ActivityEntry
+	now     time.Time
+}
+
+func (m *mockActivityRepo) Create(ctx context.Context, entry *model.ActivityEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(time.Second)
+	entry.CreatedOn = m.now
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *mockActivityRepo) GetByUser(ctx context.Context, userID string, before *time.Time, limit int) ([]*model.ActivityEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*model.ActivityEntry
+	for _, e := range m.entries {
+		if e.UserID == userID && (before == nil || e.CreatedOn.Before(*before)) {
+			result = append(result, e)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedOn.After(result[j].CreatedOn) })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *mockActivityRepo) byType(userID string, activityType model.ActivityType) []*model.ActivityEntry {
+	var result []*model.ActivityEntry
+	for _, e := range m.entries {
+		if e.UserID == userID && e.Type == activityType {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+type mockReviewRepo struct {
+	created []*model.Review
+}
+
+func (m *mockReviewRepo) Create(ctx context.Context, review *model.Review) error {
+	review.ID = "review:1"
+	m.created = append(m.created, review)
+	return nil
+}
+
+func (m *mockReviewRepo) GetByID(ctx context.Context, id string) (*model.Review, error) {
+	return nil, nil
+}
+
+func (m *mockReviewRepo) GetReviewsGiven(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error) {
+	return nil, nil
+}
+
+func (m *mockReviewRepo) GetReviewsReceived(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error) {
+	return nil, nil
+}
+
+func (m *mockReviewRepo) HasReviewed(ctx context.Context, reviewerID, revieweeID, referenceID string) (bool, error) {
+	return false, nil
+}
+
+func (m *mockReviewRepo) GetReputation(ctx context.Context, userID string) (*model.Reputation, error) {
+	return nil, nil
+}
+
+func (m *mockReviewRepo) GetReputationDisplay(ctx context.Context, userID string) (*model.ReputationDisplay, error) {
+	return nil, nil
+}
+
+// ============================================================================
+// GetTimeline Tests
+// ============================================================================
+
+func TestGetTimeline_PaginatesNewestFirst(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := &mockActivityRepo{now: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)}
+	svc := NewActivityService(ActivityServiceConfig{Repo: repo})
+	for i := 0; i < 5; i++ {
+		svc.Record(ctx, &model.ActivityEntry{
+			UserID:      "user-1",
+			Type:        model.ActivityRSVPed,
+			SubjectType: model.ActivitySubjectEvent,
+			SubjectID:   "event:" + string(rune('a'+i)),
+		})
+	}
+	svc.Record(ctx, &model.ActivityEntry{UserID: "user-2", Type: model.ActivityRSVPed})
+
+	first, err := svc.GetTimeline(ctx, "user-1", "", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.Entries) != 3 || first.NextCursor == "" {
+		t.Fatalf("expected 3 entries and a cursor, got %d entries cursor %q", len(first.Entries), first.NextCursor)
+	}
+	if first.Entries[0].SubjectID != "event:e" {
+		t.Errorf("expected newest entry first, got %s", first.Entries[0].SubjectID)
+	}
+
+	second, err := svc.GetTimeline(ctx, "user-1", first.NextCursor, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(second.Entries) != 2 || second.NextCursor != "" {
+		t.Fatalf("expected last 2 entries without a cursor, got %d entries cursor %q", len(second.Entries), second.NextCursor)
+	}
+	if second.Entries[1].SubjectID != "event:a" {
+		t.Errorf("expected oldest entry last, got %s", second.Entries[1].SubjectID)
+	}
+}
+
+func TestGetTimeline_InvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	svc := NewActivityService(ActivityServiceConfig{Repo: &mockActivityRepo{}})
+
+	_, err := svc.GetTimeline(context.Background(), "user-1", "yesterday", 10)
+	if !errors.Is(err, ErrInvalidActivityCursor) {
+		t.Errorf("expected ErrInvalidActivityCursor, got %v", err)
+	}
+}
+
+// ============================================================================
+// Recording Tests
+// ============================================================================
+
+func TestCreateReview_RecordsActivityForBothUsers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	activity := &mockActivityRepo{}
+	svc := NewReviewService(ReviewServiceConfig{
+		Repo:     &mockReviewRepo{},
+		Activity: NewActivityService(ActivityServiceConfig{Repo: activity}),
+	})
+
+	_, err := svc.CreateReview(ctx, "user-1", &model.CreateReviewRequest{
+		RevieweeID: "user-2",
+		Context:    model.ReviewContextHosted,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if given := activity.byType("user-1", model.ActivityReviewGiven); len(given) != 1 {
+		t.Errorf("expected 1 review_given entry for reviewer, got %d", len(given))
+	}
+	received := activity.byType("user-2", model.ActivityReviewReceived)
+	if len(received) != 1 {
+		t.Fatalf("expected 1 review_received entry for reviewee, got %d", len(received))
+	}
+	if received[0].ActorID == nil || *received[0].ActorID != "user-1" {
+		t.Errorf("expected reviewer as actor, got %v", received[0].ActorID)
+	}
+}
+
+func TestCreateReview_NoActivityOnFailure(t *testing.T) {
+	t.Parallel()
+
+	activity := &mockActivityRepo{}
+	svc := NewReviewService(ReviewServiceConfig{
+		Repo:     &mockReviewRepo{},
+		Activity: NewActivityService(ActivityServiceConfig{Repo: activity}),
+	})
+
+	if _, err := svc.CreateReview(context.Background(), "user-1", &model.CreateReviewRequest{RevieweeID: "user-1"}); err == nil {
+		t.Fatal("expected error reviewing yourself")
+	}
+	if len(activity.entries) != 0 {
+		t.Errorf("expected no activity for a failed review, got %d", len(activity.entries))
+	}
+}
+
+func TestRunMatching_RecordsActivityForMatchedMembers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	poolRepo := &mockPoolRepo{
+		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
+			return &model.MatchingPool{ID: model.PoolID(poolID), Name: "Coffee chats", MatchSize: 2, Frequency: model.PoolFrequencyWeekly}, nil
+		},
+		getPoolMembersFunc: func(ctx context.Context, poolID string) ([]*model.PoolMember, error) {
+			return []*model.PoolMember{
+				{MemberID: "member:1", UserID: "user-1"},
+				{MemberID: "member:2", UserID: "user-2"},
+			}, nil
+		},
+		createMatchResultFunc: func(ctx context.Context, match *model.MatchResult) error {
+			match.ID = "match:1"
+			return nil
+		},
+	}
+	activity := &mockActivityRepo{}
+	svc := NewPoolService(PoolServiceConfig{
+		PoolRepo:   poolRepo,
+		GuildRepo:  &mockGuildRepo{},
+		MemberRepo: &mockMemberRepo{},
+		Activity:   NewActivityService(ActivityServiceConfig{Repo: activity}),
+	})
+
+	if _, err := svc.RunMatching(ctx, "pool:1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, userID := range []string{"user-1", "user-2"} {
+		entries := activity.byType(userID, model.ActivityMatched)
+		if len(entries) != 1 {
+			t.Fatalf("expected 1 matched entry for %s, got %d", userID, len(entries))
+		}
+		if entries[0].SubjectID != "match:1" || entries[0].Data["pool_name"] != "Coffee chats" {
+			t.Errorf("unexpected matched entry for %s: %+v", userID, entries[0])
+		}
+	}
+}
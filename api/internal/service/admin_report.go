@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/requestid"
+)
+
+// AdminReportRepository defines the interface for admin report storage
+type AdminReportRepository interface {
+	Create(ctx context.Context, report *model.AdminReport) error
+	Get(ctx context.Context, id string) (*model.AdminReport, error)
+	List(ctx context.Context, limit int) ([]*model.AdminReport, error)
+	ListByStatus(ctx context.Context, status model.AdminReportStatus, limit int) ([]*model.AdminReport, error)
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.AdminReport, error)
+	Claim(ctx context.Context, id string) (bool, error)
+	Complete(ctx context.Context, id, objectKey string, rowCount int, expiresOn time.Time) error
+	Fail(ctx context.Context, id, reason string) error
+	MarkExpired(ctx context.Context, id string) error
+}
+
+// ObjectStore is where generated report files are kept
+type ObjectStore interface {
+	Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+	Delete(ctx context.Context, key string) error
+	PresignGet(key string, expires time.Duration) (string, error)
+}
+
+// AdminReportUserSource pages through every user for the users report
+type AdminReportUserSource interface {
+	ListUsers(ctx context.Context, req ListUsersRequest) (*ListUsersResponse, error)
+}
+
+// AdminReportModerationSource pages through every moderation action for the
+// moderation history report
+type AdminReportModerationSource interface {
+	ListActions(ctx context.Context, offset, limit int) ([]*model.ModerationAction, error)
+}
+
+// adminReportBatchSize is how many queued reports one ProcessPending run takes
+const adminReportBatchSize = 10
+
+// AdminReportService generates long admin reports in the background. Reports
+// are queued by an admin, generated by the admin report job into a CSV file
+// in object storage, and downloaded through a signed URL until they expire.
+type AdminReportService struct {
+	repo       AdminReportRepository
+	store      ObjectStore
+	users      AdminReportUserSource
+	moderation AdminReportModerationSource
+	retention  time.Duration
+	urlTTL     time.Duration
+	clock      clock.Clock
+}
+
+// AdminReportServiceConfig holds configuration for the admin report service
+type AdminReportServiceConfig struct {
+	Repo       AdminReportRepository
+	Store      ObjectStore // Reports are disabled when nil
+	Users      AdminReportUserSource
+	Moderation AdminReportModerationSource
+	Retention  time.Duration // How long generated files are kept (default 7 days)
+	URLTTL     time.Duration // How long a download URL is valid (default 15 minutes)
+	Clock      clock.Clock   // Default: the system clock
+}
+
+// NewAdminReportService creates a new admin report service
+func NewAdminReportService(cfg AdminReportServiceConfig) *AdminReportService {
+	if cfg.Retention == 0 {
+		cfg.Retention = 7 * 24 * time.Hour
+	}
+	if cfg.URLTTL == 0 {
+		cfg.URLTTL = 15 * time.Minute
+	}
+	return &AdminReportService{
+		repo:       cfg.Repo,
+		store:      cfg.Store,
+		users:      cfg.Users,
+		moderation: cfg.Moderation,
+		retention:  cfg.Retention,
+		urlTTL:     cfg.URLTTL,
+		clock:      clock.OrReal(cfg.Clock),
+	}
+}
+
+// Enabled reports whether object storage is configured
+func (s *AdminReportService) Enabled() bool {
+	return s.store != nil
+}
+
+// Request queues a report for the admin report job
+func (s *AdminReportService) Request(ctx context.Context, actorID string, req *model.CreateAdminReportRequest) (*model.AdminReport, error) {
+	if !s.Enabled() {
+		return nil, ErrAdminReportsDisabled
+	}
+
+	report := &model.AdminReport{
+		Kind:        req.Kind,
+		RequestedBy: actorID,
+		RequestID:   requestid.FromContext(ctx),
+	}
+	if err := s.repo.Create(ctx, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Get returns a report. Completed reports carry a fresh download URL.
+func (s *AdminReportService) Get(ctx context.Context, id string) (*model.AdminReport, error) {
+	report, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, ErrAdminReportNotFound
+	}
+
+	if err := s.attachDownloadURL(report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// List returns the most recent reports, newest first
+func (s *AdminReportService) List(ctx context.Context, limit int) ([]*model.AdminReport, error) {
+	if limit <= 0 {
+		limit = model.DefaultAdminReportsLimit
+	}
+	if limit > model.MaxAdminReportsLimit {
+		limit = model.MaxAdminReportsLimit
+	}
+	return s.repo.List(ctx, limit)
+}
+
+// ProcessPending generates queued reports. Reports left running by a worker
+// that died are failed first. Returns how many reports were generated.
+func (s *AdminReportService) ProcessPending(ctx context.Context) (int, error) {
+	if !s.Enabled() {
+		return 0, nil
+	}
+
+	if err := s.failStale(ctx); err != nil {
+		return 0, err
+	}
+
+	pending, err := s.repo.ListByStatus(ctx, model.AdminReportPending, adminReportBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for _, report := range pending {
+		claimed, err := s.repo.Claim(ctx, report.ID)
+		if err != nil {
+			return generated, err
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := s.generate(requestid.NewContext(ctx, report.RequestID), report); err != nil {
+			log.Printf("[AdminReportService] Failed to generate %s report %s (request %s): %v", report.Kind, report.ID, report.RequestID, err)
+			if err := s.repo.Fail(ctx, report.ID, err.Error()); err != nil {
+				log.Printf("[AdminReportService] Failed to mark report %s failed: %v", report.ID, err)
+			}
+			continue
+		}
+		generated++
+	}
+	return generated, nil
+}
+
+// ExpireOld deletes generated files past retention. Returns how many reports
+// expired.
+func (s *AdminReportService) ExpireOld(ctx context.Context) (int, error) {
+	if !s.Enabled() {
+		return 0, nil
+	}
+
+	reports, err := s.repo.ListExpired(ctx, s.clock.Now(), model.MaxAdminReportsLimit)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, report := range reports {
+		if report.ObjectKey != "" {
+			if err := s.store.Delete(ctx, report.ObjectKey); err != nil {
+				log.Printf("[AdminReportService] Failed to delete %s: %v", report.ObjectKey, err)
+				continue
+			}
+		}
+		if err := s.repo.MarkExpired(ctx, report.ID); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// failStale fails reports that have been running longer than the timeout
+func (s *AdminReportService) failStale(ctx context.Context) error {
+	running, err := s.repo.ListByStatus(ctx, model.AdminReportRunning, model.MaxAdminReportsLimit)
+	if err != nil {
+		return err
+	}
+
+	cutoff := s.clock.Now().Add(-model.AdminReportTimeout)
+	for _, report := range running {
+		if report.StartedOn != nil && report.StartedOn.Before(cutoff) {
+			if err := s.repo.Fail(ctx, report.ID, "report generation timed out"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// generate writes a report to a temporary file and uploads it
+func (s *AdminReportService) generate(ctx context.Context, report *model.AdminReport) error {
+	file, err := os.CreateTemp("", "saga-report-*.csv")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	var rows int
+	switch report.Kind {
+	case model.AdminReportUsers:
+		rows, err = s.writeUsers(ctx, w)
+	case model.AdminReportModerationHistory:
+		rows, err = s.writeModerationHistory(ctx, w)
+	default:
+		err = fmt.Errorf("unknown report kind %q", report.Kind)
+	}
+	if err != nil {
+		return err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	key := adminReportObjectKey(report)
+	if err := s.store.Put(ctx, key, "text/csv", file, size); err != nil {
+		return err
+	}
+
+	return s.repo.Complete(ctx, report.ID, key, rows, s.clock.Now().Add(s.retention))
+}
+
+func (s *AdminReportService) writeUsers(ctx context.Context, w *csv.Writer) (int, error) {
+	if err := w.Write([]string{"id", "email", "username", "firstname", "lastname", "role", "status", "email_verified", "created_on", "login_on"}); err != nil {
+		return 0, err
+	}
+
+	rows := 0
+	for page := 1; ; page++ {
+		resp, err := s.users.ListUsers(ctx, ListUsersRequest{
+			Page:     page,
+			PageSize: model.AdminReportPageSize,
+			SortBy:   "created_on",
+			SortDir:  "asc",
+		})
+		if err != nil {
+			return rows, err
+		}
+
+		for _, u := range resp.Users {
+			record := []string{
+				u.ID, u.Email, derefString(u.Username), derefString(u.Firstname), derefString(u.Lastname),
+				u.Role, u.Status, strconv.FormatBool(u.EmailVerified), u.CreatedOn, derefString(u.LoginOn),
+			}
+			if err := w.Write(csvSafe(record)); err != nil {
+				return rows, err
+			}
+			rows++
+		}
+
+		if len(resp.Users) < model.AdminReportPageSize {
+			return rows, nil
+		}
+	}
+}
+
+func (s *AdminReportService) writeModerationHistory(ctx context.Context, w *csv.Writer) (int, error) {
+	if err := w.Write([]string{"id", "user_id", "level", "reason", "report_id", "admin_user_id", "duration_days", "is_active", "created_on", "expires_on", "lifted_on", "lifted_by_id", "lift_reason"}); err != nil {
+		return 0, err
+	}
+
+	rows := 0
+	for offset := 0; ; offset += model.AdminReportPageSize {
+		actions, err := s.moderation.ListActions(ctx, offset, model.AdminReportPageSize)
+		if err != nil {
+			return rows, err
+		}
+
+		for _, a := range actions {
+			duration := ""
+			if a.Duration != nil {
+				duration = strconv.Itoa(*a.Duration)
+			}
+			record := []string{
+				a.ID, a.UserID, string(a.Level), a.Reason, derefString(a.ReportID), derefString(a.AdminUserID),
+				duration, strconv.FormatBool(a.IsActive), a.CreatedOn.UTC().Format(time.RFC3339),
+				formatOptionalTime(a.ExpiresOn), formatOptionalTime(a.LiftedOn), derefString(a.LiftedByID), derefString(a.LiftReason),
+			}
+			if err := w.Write(csvSafe(record)); err != nil {
+				return rows, err
+			}
+			rows++
+		}
+
+		if len(actions) < model.AdminReportPageSize {
+			return rows, nil
+		}
+	}
+}
+
+// attachDownloadURL signs a download link for a completed report
+func (s *AdminReportService) attachDownloadURL(report *model.AdminReport) error {
+	if report.Status != model.AdminReportCompleted || report.ObjectKey == "" || !s.Enabled() {
+		return nil
+	}
+
+	url, err := s.store.PresignGet(report.ObjectKey, s.urlTTL)
+	if err != nil {
+		return err
+	}
+	report.DownloadURL = url
+	return nil
+}
+
+// adminReportObjectKey is where a report's file is stored
+func adminReportObjectKey(report *model.AdminReport) string {
+	id := strings.TrimPrefix(report.ID, "admin_report:")
+	return "admin-reports/" + string(report.Kind) + "/" + id + ".csv"
+}
+
+// csvSafe stops user-entered text from being read as a formula when a report
+// is opened in a spreadsheet
+func csvSafe(record []string) []string {
+	for i, field := range record {
+		if field != "" && strings.ContainsRune("=+-@\t\r", rune(field[0])) {
+			record[i] = "'" + field
+		}
+	}
+	return record
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mocks
+// ============================================================================
+
+type mockAdminReportRepo struct {
+	reports map[string]*model.AdminReport
+	nextID  int
+}
+
+func newMockAdminReportRepo() *mockAdminReportRepo {
+	return &mockAdminReportRepo{reports: map[string]*model.AdminReport{}}
+}
+
+func (m *mockAdminReportRepo) add(report *model.AdminReport) {
+	m.nextID++
+	report.ID = fmt.Sprintf("admin_report:%d", m.nextID)
+	m.reports[report.ID] = report
+}
+
+func (m *mockAdminReportRepo) Create(ctx context.Context, report *model.AdminReport) error {
+	report.Status = model.AdminReportPending
+	m.add(report)
+	return nil
+}
+
+func (m *mockAdminReportRepo) Get(ctx context.Context, id string) (*model.AdminReport, error) {
+	return m.reports[id], nil
+}
+
+func (m *mockAdminReportRepo) List(ctx context.Context, limit int) ([]*model.AdminReport, error) {
+	return nil, nil
+}
+
+func (m *mockAdminReportRepo) ListByStatus(ctx context.Context, status model.AdminReportStatus, limit int) ([]*model.AdminReport, error) {
+	var reports []*model.AdminReport
+	for _, r := range m.reports {
+		if r.Status == status {
+			reports = append(reports, r)
+		}
+	}
+	return reports, nil
+}
+
+func (m *mockAdminReportRepo) ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.AdminReport, error) {
+	var reports []*model.AdminReport
+	for _, r := range m.reports {
+		if r.Status == model.AdminReportCompleted && !r.ExpiresOn.After(now) {
+			reports = append(reports, r)
+		}
+	}
+	return reports, nil
+}
+
+func (m *mockAdminReportRepo) Claim(ctx context.Context, id string) (bool, error) {
+	r := m.reports[id]
+	if r.Status != model.AdminReportPending {
+		return false, nil
+	}
+	r.Status = model.AdminReportRunning
+	return true, nil
+}
+
+func (m *mockAdminReportRepo) Complete(ctx context.Context, id, objectKey string, rowCount int, expiresOn time.Time) error {
+	r := m.reports[id]
+	r.Status, r.ObjectKey, r.RowCount, r.ExpiresOn = model.AdminReportCompleted, objectKey, rowCount, &expiresOn
+	return nil
+}
+
+func (m *mockAdminReportRepo) Fail(ctx context.Context, id, reason string) error {
+	r := m.reports[id]
+	r.Status, r.Error = model.AdminReportFailed, reason
+	return nil
+}
+
+func (m *mockAdminReportRepo) MarkExpired(ctx context.Context, id string) error {
+	r := m.reports[id]
+	r.Status, r.ObjectKey = model.AdminReportExpired, ""
+	return nil
+}
+
+type mockObjectStore struct {
+	objects map[string]string
+}
+
+func (m *mockObjectStore) Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != size {
+		return fmt.Errorf("size %d does not match body length %d", size, len(data))
+	}
+	m.objects[key] = string(data)
+	return nil
+}
+
+func (m *mockObjectStore) Delete(ctx context.Context, key string) error {
+	delete(m.objects, key)
+	return nil
+}
+
+func (m *mockObjectStore) PresignGet(key string, expires time.Duration) (string, error) {
+	return "https://storage.example/" + key + "?signed", nil
+}
+
+type mockReportUserSource struct {
+	users []AdminUserItem
+	err   error
+}
+
+func (m *mockReportUserSource) ListUsers(ctx context.Context, req ListUsersRequest) (*ListUsersResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	start := (req.Page - 1) * req.PageSize
+	if start > len(m.users) {
+		start = len(m.users)
+	}
+	end := start + req.PageSize
+	if end > len(m.users) {
+		end = len(m.users)
+	}
+	return &ListUsersResponse{Users: m.users[start:end], Page: req.Page, PageSize: req.PageSize}, nil
+}
+
+type mockReportModerationSource struct {
+	actions []*model.ModerationAction
+}
+
+func (m *mockReportModerationSource) ListActions(ctx context.Context, offset, limit int) ([]*model.ModerationAction, error) {
+	if offset > len(m.actions) {
+		return nil, nil
+	}
+	end := offset + limit
+	if end > len(m.actions) {
+		end = len(m.actions)
+	}
+	return m.actions[offset:end], nil
+}
+
+func newTestAdminReportService(repo *mockAdminReportRepo, store ObjectStore, users *mockReportUserSource) *AdminReportService {
+	return NewAdminReportService(AdminReportServiceConfig{
+		Repo:       repo,
+		Store:      store,
+		Users:      users,
+		Moderation: &mockReportModerationSource{},
+		Clock:      fakeclock.New(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)),
+	})
+}
+
+// ============================================================================
+// Request / Get Tests
+// ============================================================================
+
+func TestAdminReportRequest_DisabledWithoutStore(t *testing.T) {
+	t.Parallel()
+	svc := NewAdminReportService(AdminReportServiceConfig{Repo: newMockAdminReportRepo()})
+
+	_, err := svc.Request(context.Background(), "user:admin", &model.CreateAdminReportRequest{Kind: model.AdminReportUsers})
+	if !errors.Is(err, ErrAdminReportsDisabled) {
+		t.Errorf("expected ErrAdminReportsDisabled, got %v", err)
+	}
+}
+
+func TestAdminReportGet(t *testing.T) {
+	t.Parallel()
+	repo := newMockAdminReportRepo()
+	svc := newTestAdminReportService(repo, &mockObjectStore{objects: map[string]string{}}, &mockReportUserSource{})
+	ctx := context.Background()
+
+	if _, err := svc.Get(ctx, "admin_report:missing"); !errors.Is(err, ErrAdminReportNotFound) {
+		t.Errorf("expected ErrAdminReportNotFound, got %v", err)
+	}
+
+	pending := &model.AdminReport{Status: model.AdminReportPending}
+	completed := &model.AdminReport{Status: model.AdminReportCompleted, ObjectKey: "admin-reports/users/1.csv"}
+	repo.add(pending)
+	repo.add(completed)
+
+	got, _ := svc.Get(ctx, pending.ID)
+	if got.DownloadURL != "" {
+		t.Errorf("pending report should have no download URL, got %q", got.DownloadURL)
+	}
+	got, _ = svc.Get(ctx, completed.ID)
+	if got.DownloadURL != "https://storage.example/admin-reports/users/1.csv?signed" {
+		t.Errorf("expected signed download URL, got %q", got.DownloadURL)
+	}
+}
+
+// ============================================================================
+// Generation Tests
+// ============================================================================
+
+func TestAdminReportProcessPending_UsersReport(t *testing.T) {
+	t.Parallel()
+	repo := newMockAdminReportRepo()
+	store := &mockObjectStore{objects: map[string]string{}}
+
+	users := make([]AdminUserItem, model.AdminReportPageSize+1)
+	for i := range users {
+		users[i] = AdminUserItem{ID: fmt.Sprintf("user:%d", i), Email: fmt.Sprintf("u%d@example.com", i), Role: "user", Status: "active"}
+	}
+	firstname := "=HYPERLINK(\"x\")"
+	users[0].Firstname = &firstname
+
+	svc := newTestAdminReportService(repo, store, &mockReportUserSource{users: users})
+	report, err := svc.Request(context.Background(), "user:admin", &model.CreateAdminReportRequest{Kind: model.AdminReportUsers})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	generated, err := svc.ProcessPending(context.Background())
+	if err != nil || generated != 1 {
+		t.Fatalf("expected 1 report generated, got %d (%v)", generated, err)
+	}
+
+	got := repo.reports[report.ID]
+	if got.Status != model.AdminReportCompleted || got.RowCount != len(users) {
+		t.Fatalf("expected completed report with %d rows, got %+v", len(users), got)
+	}
+	if want := svc.clock.Now().Add(7 * 24 * time.Hour); !got.ExpiresOn.Equal(want) {
+		t.Errorf("expected expiry %v, got %v", want, got.ExpiresOn)
+	}
+
+	csv := store.objects[got.ObjectKey]
+	lines := strings.Split(strings.TrimSpace(csv), "\n")
+	if len(lines) != len(users)+1 {
+		t.Fatalf("expected header and %d rows, got %d lines", len(users), len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "id,email,") {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if !strings.Contains(lines[1], `"'=HYPERLINK(""x"")"`) {
+		t.Errorf("expected formula to be neutralized, got %q", lines[1])
+	}
+}
+
+func TestAdminReportProcessPending_RecordsFailure(t *testing.T) {
+	t.Parallel()
+	repo := newMockAdminReportRepo()
+	store := &mockObjectStore{objects: map[string]string{}}
+	svc := newTestAdminReportService(repo, store, &mockReportUserSource{err: errors.New("database unavailable")})
+
+	report, _ := svc.Request(context.Background(), "user:admin", &model.CreateAdminReportRequest{Kind: model.AdminReportUsers})
+	if _, err := svc.ProcessPending(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := repo.reports[report.ID]
+	if got.Status != model.AdminReportFailed || !strings.Contains(got.Error, "database unavailable") {
+		t.Errorf("expected failed report, got %+v", got)
+	}
+	if len(store.objects) != 0 {
+		t.Errorf("expected nothing uploaded, got %v", store.objects)
+	}
+}
+
+func TestAdminReportProcessPending_FailsStaleRuns(t *testing.T) {
+	t.Parallel()
+	repo := newMockAdminReportRepo()
+	svc := newTestAdminReportService(repo, &mockObjectStore{objects: map[string]string{}}, &mockReportUserSource{})
+
+	staleStart := svc.clock.Now().Add(-model.AdminReportTimeout - time.Minute)
+	freshStart := svc.clock.Now().Add(-time.Minute)
+	stale := &model.AdminReport{Status: model.AdminReportRunning, StartedOn: &staleStart}
+	fresh := &model.AdminReport{Status: model.AdminReportRunning, StartedOn: &freshStart}
+	repo.add(stale)
+	repo.add(fresh)
+
+	if _, err := svc.ProcessPending(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stale.Status != model.AdminReportFailed {
+		t.Errorf("expected stale report failed, got %s", stale.Status)
+	}
+	if fresh.Status != model.AdminReportRunning {
+		t.Errorf("expected fresh report still running, got %s", fresh.Status)
+	}
+}
+
+// ============================================================================
+// Expiry Tests
+// ============================================================================
+
+func TestAdminReportExpireOld(t *testing.T) {
+	t.Parallel()
+	repo := newMockAdminReportRepo()
+	store := &mockObjectStore{objects: map[string]string{"old.csv": "x", "new.csv": "y"}}
+	svc := newTestAdminReportService(repo, store, &mockReportUserSource{})
+
+	past, future := svc.clock.Now().Add(-time.Hour), svc.clock.Now().Add(time.Hour)
+	old := &model.AdminReport{Status: model.AdminReportCompleted, ObjectKey: "old.csv", ExpiresOn: &past}
+	current := &model.AdminReport{Status: model.AdminReportCompleted, ObjectKey: "new.csv", ExpiresOn: &future}
+	repo.add(old)
+	repo.add(current)
+
+	expired, err := svc.ExpireOld(context.Background())
+	if err != nil || expired != 1 {
+		t.Fatalf("expected 1 report expired, got %d (%v)", expired, err)
+	}
+	if old.Status != model.AdminReportExpired {
+		t.Errorf("expected old report expired, got %s", old.Status)
+	}
+	if _, ok := store.objects["old.csv"]; ok {
+		t.Error("expected old file deleted")
+	}
+	if _, ok := store.objects["new.csv"]; !ok {
+		t.Error("expected current file kept")
+	}
+}
@@ -25,12 +25,19 @@ type AdminProfileRepository interface {
 	Delete(ctx context.Context, userID string) error
 }
 
+// AdminSignupRiskSource looks up the risk score an account got at signup
+type AdminSignupRiskSource interface {
+	GetForUser(ctx context.Context, userID string) (*model.SignupRisk, error)
+}
+
 // AdminUsersService handles admin user management operations
 type AdminUsersService struct {
 	db            database.Database
 	userRepo      AdminUserRepository
 	profileRepo   AdminProfileRepository
 	moderationSvc *ModerationService
+	legalHolds    LegalHoldChecker
+	signupRisks   AdminSignupRiskSource
 }
 
 // NewAdminUsersService creates a new admin users service
@@ -39,23 +46,51 @@ func NewAdminUsersService(
 	userRepo AdminUserRepository,
 	profileRepo AdminProfileRepository,
 	moderationSvc *ModerationService,
+	legalHolds LegalHoldChecker,
+	signupRisks AdminSignupRiskSource,
 ) *AdminUsersService {
 	return &AdminUsersService{
 		db:            db,
 		userRepo:      userRepo,
 		profileRepo:   profileRepo,
 		moderationSvc: moderationSvc,
+		legalHolds:    legalHolds,
+		signupRisks:   signupRisks,
 	}
 }
 
-// ListUsersRequest defines the request for listing users
+// AdminUserListFields is what the admin user list can be sorted and
+// filtered by
+var AdminUserListFields = model.ListFields{
+	Sort: []string{"email", "username", "role", "created_on", "updated_on", "login_on"},
+	Filter: map[string][]string{
+		"role": {
+			string(model.UserRoleUser), string(model.UserRoleModerator),
+			string(model.UserRoleAdmin), string(model.UserRoleSuperAdmin),
+		},
+	},
+}
+
+// adminUserListColumns maps AdminUserListFields to user columns
+var adminUserListColumns = database.ListColumns{
+	"email":      "email",
+	"username":   "username",
+	"role":       "role",
+	"created_on": "created_on",
+	"updated_on": "updated_on",
+	"login_on":   "login_on",
+}
+
+// ListUsersRequest defines the request for listing users. Role, SortBy and
+// SortDir predate List, and apply when it doesn't filter or sort.
 type ListUsersRequest struct {
-	Page     int    `json:"page"`
-	PageSize int    `json:"page_size"`
-	Search   string `json:"search,omitempty"`
-	Role     string `json:"role,omitempty"`
-	SortBy   string `json:"sort_by,omitempty"`
-	SortDir  string `json:"sort_dir,omitempty"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"page_size"`
+	Search   string          `json:"search,omitempty"`
+	Role     string          `json:"role,omitempty"`
+	SortBy   string          `json:"sort_by,omitempty"`
+	SortDir  string          `json:"sort_dir,omitempty"`
+	List     model.ListQuery `json:"-"`
 }
 
 // AdminUserItem represents a user in the admin list
@@ -103,6 +138,9 @@ type AdminUserDetail struct {
 
 	// Stats
 	Stats *AdminUserStats `json:"stats,omitempty"`
+
+	// Spam and bot risk worked out at signup
+	SignupRisk *model.SignupRisk `json:"signup_risk,omitempty"`
 }
 
 // AdminUserProfile is a subset of profile data for the admin panel
@@ -151,10 +189,11 @@ func (s *AdminUsersService) ListUsers(ctx context.Context, req ListUsersRequest)
 		vars["search"] = req.Search
 	}
 
+	list := req.List
 	if req.Role != "" {
-		conditions = append(conditions, "role = $role")
-		vars["role"] = req.Role
+		list = list.WithFilter("role", req.Role)
 	}
+	conditions = append(conditions, database.ListConditions(list, adminUserListColumns, vars)...)
 
 	whereClause := ""
 	if len(conditions) > 0 {
@@ -162,15 +201,10 @@ func (s *AdminUsersService) ListUsers(ctx context.Context, req ListUsersRequest)
 	}
 
 	// Build ORDER BY
-	sortBy := "created_on"
-	sortDir := "DESC"
-	validSorts := map[string]bool{"email": true, "username": true, "role": true, "created_on": true, "updated_on": true}
-	if req.SortBy != "" && validSorts[req.SortBy] {
-		sortBy = req.SortBy
-	}
-	if req.SortDir == "asc" || req.SortDir == "ASC" {
-		sortDir = "ASC"
+	if len(list.Sort) == 0 && req.SortBy != "" {
+		list.Sort = []model.SortKey{{Field: req.SortBy, Desc: req.SortDir != "asc" && req.SortDir != "ASC"}}
 	}
+	orderBy := database.ListOrder(list, adminUserListColumns, "created_on DESC")
 
 	// Count query
 	countQuery := fmt.Sprintf("SELECT count() AS total FROM user %s GROUP ALL", whereClause)
@@ -196,10 +230,10 @@ func (s *AdminUsersService) ListUsers(ctx context.Context, req ListUsersRequest)
 			login_on
 		FROM user
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT $limit
 		START $offset
-	`, whereClause, sortBy, sortDir)
+	`, whereClause, orderBy)
 
 	results, err := s.db.Query(ctx, dataQuery, vars)
 	if err != nil {
@@ -301,6 +335,14 @@ func (s *AdminUsersService) GetUserDetail(ctx context.Context, userID string) (*
 	// Get stats (guild and event counts)
 	detail.Stats = s.getUserStats(ctx, userID)
 
+	// Get signup risk
+	if s.signupRisks != nil {
+		risk, err := s.signupRisks.GetForUser(ctx, userID)
+		if err == nil {
+			detail.SignupRisk = risk
+		}
+	}
+
 	return detail, nil
 }
 
@@ -325,21 +367,17 @@ func (s *AdminUsersService) getUserStats(ctx context.Context, userID string) *Ad
 	return stats
 }
 
-// UpdateUserRole updates a user's role with self-demotion protection
+// UpdateUserRole updates a user's role with self-demotion protection. Only
+// superadmins can grant superadmin or change a superadmin's role.
 func (s *AdminUsersService) UpdateUserRole(ctx context.Context, adminUserID, targetUserID string, role model.UserRole) error {
 	// Validate role
 	switch role {
-	case model.UserRoleUser, model.UserRoleModerator, model.UserRoleAdmin:
+	case model.UserRoleUser, model.UserRoleModerator, model.UserRoleAdmin, model.UserRoleSuperAdmin:
 		// valid
 	default:
 		return fmt.Errorf("invalid role: %s", role)
 	}
 
-	// Self-demotion protection
-	if adminUserID == targetUserID && role != model.UserRoleAdmin {
-		return fmt.Errorf("cannot demote yourself")
-	}
-
 	// Verify target user exists
 	user, err := s.userRepo.GetByID(ctx, targetUserID)
 	if err != nil {
@@ -349,6 +387,21 @@ func (s *AdminUsersService) UpdateUserRole(ctx context.Context, adminUserID, tar
 		return ErrUserNotFound
 	}
 
+	// Self-demotion protection
+	if adminUserID == targetUserID && role != user.Role {
+		return fmt.Errorf("cannot demote yourself")
+	}
+
+	if role == model.UserRoleSuperAdmin || user.IsSuperAdmin() {
+		admin, err := s.userRepo.GetByID(ctx, adminUserID)
+		if err != nil {
+			return fmt.Errorf("failed to get admin: %w", err)
+		}
+		if admin == nil || !admin.IsSuperAdmin() {
+			return ErrSuperAdminRequired
+		}
+	}
+
 	return s.userRepo.SetRole(ctx, targetUserID, role)
 }
 
@@ -368,6 +421,17 @@ func (s *AdminUsersService) DeleteUser(ctx context.Context, adminUserID, targetU
 		return fmt.Errorf("cannot delete yourself")
 	}
 
+	// Held users' data is preserved; a ban is still allowed
+	if hard && s.legalHolds != nil {
+		held, err := s.legalHolds.IsHeld(ctx, targetUserID)
+		if err != nil {
+			return fmt.Errorf("failed to check legal hold: %w", err)
+		}
+		if held {
+			return ErrUserOnLegalHold
+		}
+	}
+
 	if !hard {
 		// Soft delete = ban via moderation
 		_, err := s.moderationSvc.TakeAction(ctx, adminUserID, &model.CreateModerationActionRequest{
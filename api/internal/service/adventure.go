@@ -31,6 +31,7 @@ type AdventureRepository interface {
 	GetByGuild(ctx context.Context, guildID string, limit, offset int) ([]*model.Adventure, error)
 	GetByUser(ctx context.Context, userID string, limit, offset int) ([]*model.Adventure, error)
 	Create(ctx context.Context, adventure *model.Adventure) error
+	Delete(ctx context.Context, id string) error
 	Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Adventure, error)
 	UpdateOrganizerUser(ctx context.Context, id string, newOrganizerUserID string) (*model.Adventure, error)
 	Freeze(ctx context.Context, id string, reason string) (*model.Adventure, error)
@@ -49,6 +50,7 @@ type AdventureService struct {
 	adventureRepo AdventureRepository
 	admissionRepo AdventureAdmissionRepository
 	guildRepo     GuildRepository // Uses GuildRepository which has IsMember
+	tx            Transactor
 }
 
 // AdventureServiceConfig holds configuration for the adventure service
@@ -57,6 +59,7 @@ type AdventureServiceConfig struct {
 	AdmissionRepo AdventureAdmissionRepository
 	MemberRepo    interface{} // Not used, kept for backwards compatibility
 	GuildRepo     GuildRepository
+	Tx            Transactor // Optional; without it a failed creation is compensated
 }
 
 // NewAdventureService creates a new adventure service
@@ -65,6 +68,7 @@ func NewAdventureService(cfg AdventureServiceConfig) *AdventureService {
 		adventureRepo: cfg.AdventureRepo,
 		admissionRepo: cfg.AdmissionRepo,
 		guildRepo:     cfg.GuildRepo,
+		tx:            cfg.Tx,
 	}
 }
 
@@ -118,19 +122,45 @@ func (s *AdventureService) Create(ctx context.Context, userID string, req *model
 		adventure.OrganizerID = fmt.Sprintf("user:%s", userID)
 	}
 
-	if err := s.adventureRepo.Create(ctx, adventure); err != nil {
-		return nil, fmt.Errorf("failed to create adventure: %w", err)
-	}
+	// The creator is admitted to their own adventure in the same transaction;
+	// without one, an adventure they aren't admitted to is removed again rather
+	// than left without its organizer
+	err = runOperation(ctx, s.tx, "create adventure", func(ctx context.Context, op *operation) error {
+		err := op.step(ctx, "create adventure",
+			func(ctx context.Context) error {
+				if err := s.adventureRepo.Create(ctx, adventure); err != nil {
+					return fmt.Errorf("failed to create adventure: %w", err)
+				}
+				return nil
+			},
+			func(ctx context.Context) error {
+				return s.adventureRepo.Delete(ctx, adventure.ID)
+			},
+		)
+		if err != nil {
+			return err
+		}
 
-	// Auto-admit the creator
-	admission := &model.AdventureAdmission{
-		AdventureID: adventure.ID,
-		UserID:      userID,
-		Status:      model.AdmissionStatusAdmitted,
-		RequestedBy: model.AdmissionRequestedBySelf,
+		// Auto-admit the creator
+		return op.step(ctx, "admit creator",
+			func(ctx context.Context) error {
+				admission := &model.AdventureAdmission{
+					AdventureID: adventure.ID,
+					UserID:      userID,
+					Status:      model.AdmissionStatusAdmitted,
+					RequestedBy: model.AdmissionRequestedBySelf,
+				}
+				if err := s.admissionRepo.Create(ctx, admission); err != nil {
+					return fmt.Errorf("failed to admit creator: %w", err)
+				}
+				return nil
+			},
+			nil,
+		)
+	})
+	if err != nil {
+		return nil, err
 	}
-	// Create admission (non-fatal error)
-	_ = s.admissionRepo.Create(ctx, admission)
 
 	return adventure, nil
 }
@@ -364,6 +394,52 @@ func (s *AdventureService) InviteToAdventure(ctx context.Context, adventureID st
 	return admission, nil
 }
 
+// AdmitByInvite admits a user who redeemed an invite link created by
+// inviterID. A pending request is admitted; a rejected one stays rejected.
+func (s *AdventureService) AdmitByInvite(ctx context.Context, adventureID, userID, inviterID string) (*model.AdventureAdmission, error) {
+	existing, err := s.admissionRepo.GetByAdventureAndUser(ctx, adventureID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing admission: %w", err)
+	}
+	if existing != nil {
+		switch existing.Status {
+		case model.AdmissionStatusAdmitted:
+			return nil, model.NewConflictError("already admitted to this adventure")
+		case model.AdmissionStatusRequested:
+			return s.admissionRepo.Admit(ctx, existing.ID)
+		default:
+			return nil, model.NewForbiddenError("admission to this adventure was declined")
+		}
+	}
+
+	admission := &model.AdventureAdmission{
+		AdventureID: adventureID,
+		UserID:      userID,
+		Status:      model.AdmissionStatusAdmitted,
+		RequestedBy: model.AdmissionRequestedByInvited,
+		InvitedByID: &inviterID,
+	}
+
+	if err := s.admissionRepo.Create(ctx, admission); err != nil {
+		return nil, fmt.Errorf("failed to create admission: %w", err)
+	}
+
+	return admission, nil
+}
+
+// CanOrganize reports whether the user may manage the adventure: its organizer
+// or, for guild adventures, a guild admin
+func (s *AdventureService) CanOrganize(ctx context.Context, adventure *model.Adventure, userID string) (bool, error) {
+	err := s.checkOrganizerPermission(ctx, adventure, userID)
+	if err == nil {
+		return true, nil
+	}
+	if _, ok := err.(*model.ProblemDetails); ok {
+		return false, nil
+	}
+	return false, err
+}
+
 // IsAdmitted checks if a user is admitted to an adventure
 func (s *AdventureService) IsAdmitted(ctx context.Context, adventureID, userID string) (bool, error) {
 	return s.admissionRepo.IsAdmitted(ctx, adventureID, userID)
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// AnalyticsRepository defines the repository interface for analytics queries
+type AnalyticsRepository interface {
+	GetAttendanceHistory(ctx context.Context, filter model.AttendanceHistoryFilter) ([]*model.AttendanceSample, error)
+}
+
+// AnalyticsService computes aggregate insights from historical activity
+type AnalyticsService struct {
+	repo  AnalyticsRepository
+	clock clock.Clock
+}
+
+// AnalyticsServiceConfig holds configuration for the analytics service
+type AnalyticsServiceConfig struct {
+	Repo  AnalyticsRepository
+	Clock clock.Clock // Default: the system clock
+}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService(cfg AnalyticsServiceConfig) *AnalyticsService {
+	return &AnalyticsService{
+		repo:  cfg.Repo,
+		clock: clock.OrReal(cfg.Clock),
+	}
+}
+
+// forecastBasisQuery is one level of the forecast fallback chain
+type forecastBasisQuery struct {
+	basis    model.ForecastBasis
+	guildID  *string
+	template *string
+}
+
+// ForecastAttendance estimates how many of an event's approved RSVPs will attend.
+// It uses the RSVP-to-attendance conversion of past events, preferring the same
+// guild and template and falling back to broader history when samples are thin.
+// Only attendees who opted in to analytics are counted in the history.
+func (s *AnalyticsService) ForecastAttendance(ctx context.Context, event *model.Event, approvedRSVPs int) (*model.AttendanceForecast, error) {
+	var template *string
+	if event.Template != "" {
+		template = &event.Template
+	}
+
+	var chain []forecastBasisQuery
+	if event.GuildID != nil && template != nil {
+		chain = append(chain, forecastBasisQuery{basis: model.ForecastBasisGuildTemplate, guildID: event.GuildID, template: template})
+	}
+	if event.GuildID != nil {
+		chain = append(chain, forecastBasisQuery{basis: model.ForecastBasisGuild, guildID: event.GuildID})
+	}
+	if template != nil {
+		chain = append(chain, forecastBasisQuery{basis: model.ForecastBasisTemplate, template: template})
+	}
+
+	since := s.clock.Now().AddDate(0, 0, -model.ForecastLookbackDays)
+	for _, q := range chain {
+		samples, err := s.repo.GetAttendanceHistory(ctx, model.AttendanceHistoryFilter{
+			GuildID:  q.guildID,
+			Template: q.template,
+			Since:    since,
+			Limit:    model.ForecastMaxSampleEvents,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(samples) < model.ForecastMinSampleEvents {
+			continue
+		}
+		return buildAttendanceForecast(q.basis, event.Template, samples, approvedRSVPs, s.clock.Now()), nil
+	}
+
+	return buildAttendanceForecast(model.ForecastBasisDefault, event.Template, nil, approvedRSVPs, s.clock.Now()), nil
+}
+
+// buildAttendanceForecast blends historical conversion with the default prior
+// and derives the expected range and confidence label
+func buildAttendanceForecast(basis model.ForecastBasis, template string, samples []*model.AttendanceSample, approvedRSVPs int, now time.Time) *model.AttendanceForecast {
+	sampleRSVPs, attended := 0, 0
+	for _, sample := range samples {
+		sampleRSVPs += sample.Approved
+		if shows := sample.Approved - sample.NoShows; shows > 0 {
+			attended += shows
+		}
+	}
+
+	// Smooth towards the prior so a handful of events can't swing the rate to 0 or 1
+	rate := (float64(attended) + model.DefaultAttendanceRate*model.AttendancePriorWeight) /
+		float64(sampleRSVPs+model.AttendancePriorWeight)
+
+	confidence := forecastConfidence(basis, len(samples), sampleRSVPs)
+
+	expected := float64(approvedRSVPs) * rate
+	// ~80% binomial interval, widened when the history behind the rate is thin
+	spread := 1.28 * math.Sqrt(float64(approvedRSVPs)*rate*(1-rate))
+	if confidence == model.ForecastConfidenceLow {
+		spread *= 2
+	}
+
+	low := int(math.Floor(expected - spread))
+	if low < 0 {
+		low = 0
+	}
+	high := int(math.Ceil(expected + spread))
+	if high > approvedRSVPs {
+		high = approvedRSVPs
+	}
+
+	return &model.AttendanceForecast{
+		ApprovedRSVPs:      approvedRSVPs,
+		ExpectedAttendance: int(math.Round(expected)),
+		ExpectedLow:        low,
+		ExpectedHigh:       high,
+		ConversionRate:     math.Round(rate*1000) / 1000,
+		Confidence:         confidence,
+		Basis:              basis,
+		SampleEvents:       len(samples),
+		SampleRSVPs:        sampleRSVPs,
+		Explanation:        forecastExplanation(basis, template, len(samples), confidence),
+		ComputedOn:         now,
+	}
+}
+
+// forecastConfidence labels a forecast by the amount and specificity of its history
+func forecastConfidence(basis model.ForecastBasis, sampleEvents, sampleRSVPs int) model.ForecastConfidence {
+	switch {
+	case basis == model.ForecastBasisDefault:
+		return model.ForecastConfidenceLow
+	case basis == model.ForecastBasisGuildTemplate && sampleEvents >= 10 && sampleRSVPs >= 100:
+		return model.ForecastConfidenceHigh
+	case sampleEvents >= 5 && sampleRSVPs >= 30:
+		return model.ForecastConfidenceMedium
+	default:
+		return model.ForecastConfidenceLow
+	}
+}
+
+// forecastExplanation describes in plain words what a forecast is based on
+func forecastExplanation(basis model.ForecastBasis, template string, sampleEvents int, confidence model.ForecastConfidence) string {
+	kind := strings.ReplaceAll(template, "_", " ")
+
+	var source string
+	switch basis {
+	case model.ForecastBasisGuildTemplate:
+		source = fmt.Sprintf("Based on %d past %s events in this guild", sampleEvents, kind)
+	case model.ForecastBasisGuild:
+		source = fmt.Sprintf("Based on %d past events in this guild", sampleEvents)
+	case model.ForecastBasisTemplate:
+		source = fmt.Sprintf("Based on %d past %s events across all guilds", sampleEvents, kind)
+	default:
+		return fmt.Sprintf("Not enough attendance history; assuming %d%% of approved RSVPs attend (low confidence)",
+			int(model.DefaultAttendanceRate*100))
+	}
+
+	return fmt.Sprintf("%s (%s confidence)", source, confidence)
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// AnalyticsEmitter is called by services when a domain event happens.
+// Emitting is best-effort: implementations log failures rather than failing
+// the action, and do nothing while analytics are disabled.
+type AnalyticsEmitter interface {
+	Emit(ctx context.Context, name model.AnalyticsEventName, userID string, properties map[string]interface{})
+}
+
+// emitAnalytics forwards an event to the emitter when one is configured
+func emitAnalytics(ctx context.Context, emitter AnalyticsEmitter, name model.AnalyticsEventName, userID string, properties map[string]interface{}) {
+	if emitter == nil {
+		return
+	}
+	emitter.Emit(ctx, name, userID, properties)
+}
+
+// AnalyticsEventRepository defines the interface for the analytics event store
+type AnalyticsEventRepository interface {
+	Create(ctx context.Context, event *model.AnalyticsEvent) error
+	GetRange(ctx context.Context, since, until time.Time, offset, limit int) ([]*model.AnalyticsEvent, error)
+}
+
+// AnalyticsEventService records anonymized domain events and exports them as
+// NDJSON for downstream warehouses. Nothing is recorded or exported unless
+// the pipeline is enabled, and when consent is checked, events are only
+// recorded for users who have opted in to analytics.
+type AnalyticsEventService struct {
+	repo    AnalyticsEventRepository
+	consent ConsentChecker
+	enabled bool
+	hashKey []byte
+	clock   clock.Clock
+}
+
+// AnalyticsEventServiceConfig holds configuration for the analytics event service
+type AnalyticsEventServiceConfig struct {
+	Repo    AnalyticsEventRepository
+	Consent ConsentChecker // Optional; every user counts as opted in when nil
+	Enabled bool
+	HashKey []byte      // HMAC key user IDs are hashed with; required when enabled
+	Clock   clock.Clock // Default: the system clock
+}
+
+// NewAnalyticsEventService creates a new analytics event service
+func NewAnalyticsEventService(cfg AnalyticsEventServiceConfig) *AnalyticsEventService {
+	return &AnalyticsEventService{
+		repo:    cfg.Repo,
+		consent: cfg.Consent,
+		enabled: cfg.Enabled,
+		hashKey: cfg.HashKey,
+		clock:   clock.OrReal(cfg.Clock),
+	}
+}
+
+// Enabled reports whether analytics events are recorded
+func (s *AnalyticsEventService) Enabled() bool {
+	return s.enabled
+}
+
+// Emit records an event for the user, storing only a hash of their ID
+func (s *AnalyticsEventService) Emit(ctx context.Context, name model.AnalyticsEventName, userID string, properties map[string]interface{}) {
+	if !s.enabled {
+		return
+	}
+	if s.consent != nil {
+		allowed, err := s.consent.HasConsent(ctx, userID, model.ConsentAnalytics)
+		if err != nil {
+			log.Printf("[AnalyticsEventService] Failed to check consent, dropping %s event: %v", name, err)
+			return
+		}
+		if !allowed {
+			return
+		}
+	}
+
+	event := &model.AnalyticsEvent{
+		Name:       name,
+		Subject:    s.HashUserID(userID),
+		Properties: properties,
+		OccurredOn: s.clock.Now().UTC(),
+	}
+	if err := s.repo.Create(ctx, event); err != nil {
+		log.Printf("[AnalyticsEventService] Failed to record %s event: %v", name, err)
+	}
+}
+
+// HandleMemberJoined records a guild join. Guild IDs are not recorded, so
+// joins can't be tied to a guild downstream.
+func (s *AnalyticsEventService) HandleMemberJoined(ctx context.Context, event model.MemberJoined) error {
+	s.Emit(ctx, model.AnalyticsGuildJoined, event.UserID, nil)
+	return nil
+}
+
+// HandleEventCompleted records a confirmed event completion
+func (s *AnalyticsEventService) HandleEventCompleted(ctx context.Context, event model.EventCompleted) error {
+	s.Emit(ctx, model.AnalyticsEventCompleted, event.UserID, nil)
+	return nil
+}
+
+// HashUserID returns the stable pseudonymous subject for a user: a hex HMAC
+// of their ID, so the same user can be followed across events without the
+// warehouse learning who they are
+func (s *AnalyticsEventService) HashUserID(userID string) string {
+	mac := hmac.New(sha256.New, s.hashKey)
+	mac.Write([]byte(userID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Export writes the events that occurred in [since, until) to w as NDJSON,
+// one event per line, oldest first. until is capped at the current time.
+// Returns how many events were written.
+func (s *AnalyticsEventService) Export(ctx context.Context, since, until time.Time, w io.Writer) (int, error) {
+	if !s.enabled {
+		return 0, ErrAnalyticsDisabled
+	}
+	if now := s.clock.Now(); until.After(now) {
+		until = now
+	}
+	if !since.Before(until) {
+		return 0, ErrInvalidAnalyticsRange
+	}
+
+	enc := json.NewEncoder(w)
+	written := 0
+	for {
+		events, err := s.repo.GetRange(ctx, since, until, written, model.AnalyticsExportPageSize)
+		if err != nil {
+			return written, fmt.Errorf("reading analytics events: %w", err)
+		}
+		for _, event := range events {
+			if err := enc.Encode(event); err != nil {
+				return written, fmt.Errorf("writing analytics event: %w", err)
+			}
+			written++
+		}
+		if len(events) < model.AnalyticsExportPageSize {
+			return written, nil
+		}
+	}
+}
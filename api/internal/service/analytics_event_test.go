@@ -0,0 +1,233 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repository
+// ============================================================================
+
+type mockAnalyticsEventRepo struct {
+	events []*model.AnalyticsEvent
+	reads  int
+}
+
+func (m *mockAnalyticsEventRepo) Create(ctx context.Context, event *model.AnalyticsEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockAnalyticsEventRepo) GetRange(ctx context.Context, since, until time.Time, offset, limit int) ([]*model.AnalyticsEvent, error) {
+	m.reads++
+	var inRange []*model.AnalyticsEvent
+	for _, event := range m.events {
+		if !event.OccurredOn.Before(since) && event.OccurredOn.Before(until) {
+			inRange = append(inRange, event)
+		}
+	}
+	if offset >= len(inRange) {
+		return nil, nil
+	}
+	end := offset + limit
+	if end > len(inRange) {
+		end = len(inRange)
+	}
+	return inRange[offset:end], nil
+}
+
+var analyticsTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestAnalyticsEventService(repo *mockAnalyticsEventRepo, enabled bool) *AnalyticsEventService {
+	return NewAnalyticsEventService(AnalyticsEventServiceConfig{
+		Repo:    repo,
+		Enabled: enabled,
+		HashKey: []byte("0123456789abcdef0123456789abcdef"),
+		Clock:   fakeclock.New(analyticsTestNow),
+	})
+}
+
+// ============================================================================
+// Emit Tests
+// ============================================================================
+
+func TestAnalyticsEmit_HashesUserID(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockAnalyticsEventRepo{}
+	svc := newTestAnalyticsEventService(repo, true)
+
+	svc.Emit(context.Background(), model.AnalyticsHangoutCompleted, "user:alice", nil)
+	svc.Emit(context.Background(), model.AnalyticsMatchAccepted, "user:alice", nil)
+	svc.Emit(context.Background(), model.AnalyticsMatchAccepted, "user:bob", nil)
+
+	if len(repo.events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(repo.events))
+	}
+	for _, event := range repo.events {
+		if strings.Contains(event.Subject, "alice") || strings.Contains(event.Subject, "bob") {
+			t.Errorf("subject %q leaks the user ID", event.Subject)
+		}
+	}
+	if repo.events[0].Subject != repo.events[1].Subject {
+		t.Error("expected the same user to hash to the same subject")
+	}
+	if repo.events[0].Subject == repo.events[2].Subject {
+		t.Error("expected different users to hash to different subjects")
+	}
+}
+
+func TestAnalyticsEmit_HashDependsOnKey(t *testing.T) {
+	t.Parallel()
+
+	svc := newTestAnalyticsEventService(&mockAnalyticsEventRepo{}, true)
+	other := NewAnalyticsEventService(AnalyticsEventServiceConfig{
+		Enabled: true,
+		HashKey: []byte("fedcba9876543210fedcba9876543210"),
+	})
+
+	if svc.HashUserID("user:alice") == other.HashUserID("user:alice") {
+		t.Error("expected subjects to differ between hash keys")
+	}
+}
+
+func TestAnalyticsEmit_DisabledIsNoOp(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockAnalyticsEventRepo{}
+	svc := newTestAnalyticsEventService(repo, false)
+
+	svc.Emit(context.Background(), model.AnalyticsHangoutCompleted, "user:alice", nil)
+
+	if len(repo.events) != 0 {
+		t.Errorf("expected nothing recorded while disabled, got %d events", len(repo.events))
+	}
+}
+
+type mockConsentChecker struct {
+	granted map[string]bool
+	err     error
+}
+
+func (m *mockConsentChecker) HasConsent(ctx context.Context, userID string, purpose model.ConsentPurpose) (bool, error) {
+	return m.granted[userID], m.err
+}
+
+func TestAnalyticsEmit_RequiresConsent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		checker *mockConsentChecker
+		want    int
+	}{
+		{name: "opted in", checker: &mockConsentChecker{granted: map[string]bool{"user:alice": true}}, want: 1},
+		{name: "not opted in", checker: &mockConsentChecker{}, want: 0},
+		{name: "check fails", checker: &mockConsentChecker{granted: map[string]bool{"user:alice": true}, err: errors.New("db down")}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := &mockAnalyticsEventRepo{}
+			svc := newTestAnalyticsEventService(repo, true)
+			svc.consent = tt.checker
+
+			svc.Emit(context.Background(), model.AnalyticsHangoutCompleted, "user:alice", nil)
+
+			if len(repo.events) != tt.want {
+				t.Errorf("expected %d events, got %d", tt.want, len(repo.events))
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Export Tests
+// ============================================================================
+
+func TestAnalyticsExport_WritesNDJSONAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockAnalyticsEventRepo{}
+	total := model.AnalyticsExportPageSize + 3
+	for i := 0; i < total; i++ {
+		repo.events = append(repo.events, &model.AnalyticsEvent{
+			ID:         fmt.Sprintf("analytics_event:%d", i),
+			Name:       model.AnalyticsMatchCompleted,
+			Subject:    "abc",
+			OccurredOn: analyticsTestNow.Add(-time.Hour),
+		})
+	}
+	svc := newTestAnalyticsEventService(repo, true)
+
+	var buf bytes.Buffer
+	written, err := svc.Export(context.Background(), analyticsTestNow.Add(-24*time.Hour), analyticsTestNow, &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if written != total {
+		t.Errorf("expected %d events written, got %d", total, written)
+	}
+	if repo.reads != 2 {
+		t.Errorf("expected 2 page reads, got %d", repo.reads)
+	}
+
+	lines := 0
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("line %d is not JSON: %v", lines, err)
+		}
+		if line["event"] != string(model.AnalyticsMatchCompleted) {
+			t.Errorf("line %d: unexpected event %v", lines, line["event"])
+		}
+		if _, ok := line["id"]; ok {
+			t.Errorf("line %d: record ID should not be exported", lines)
+		}
+		lines++
+	}
+	if lines != total {
+		t.Errorf("expected %d lines, got %d", total, lines)
+	}
+}
+
+func TestAnalyticsExport_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		enabled bool
+		since   time.Time
+		until   time.Time
+		wantErr error
+	}{
+		{name: "disabled", since: analyticsTestNow.Add(-time.Hour), until: analyticsTestNow, wantErr: ErrAnalyticsDisabled},
+		{name: "empty range", enabled: true, since: analyticsTestNow.Add(-time.Hour), until: analyticsTestNow.Add(-time.Hour), wantErr: ErrInvalidAnalyticsRange},
+		{name: "future range", enabled: true, since: analyticsTestNow.Add(time.Hour), until: analyticsTestNow.Add(2 * time.Hour), wantErr: ErrInvalidAnalyticsRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			svc := newTestAnalyticsEventService(&mockAnalyticsEventRepo{}, tt.enabled)
+
+			var buf bytes.Buffer
+			if _, err := svc.Export(context.Background(), tt.since, tt.until, &buf); !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
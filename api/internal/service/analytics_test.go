@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockAnalyticsRepo struct {
+	// samples keyed by basis: guild+template, guild, template
+	byBasis map[model.ForecastBasis][]*model.AttendanceSample
+}
+
+func (m *mockAnalyticsRepo) GetAttendanceHistory(ctx context.Context, filter model.AttendanceHistoryFilter) ([]*model.AttendanceSample, error) {
+	switch {
+	case filter.GuildID != nil && filter.Template != nil:
+		return m.byBasis[model.ForecastBasisGuildTemplate], nil
+	case filter.GuildID != nil:
+		return m.byBasis[model.ForecastBasisGuild], nil
+	default:
+		return m.byBasis[model.ForecastBasisTemplate], nil
+	}
+}
+
+func attendanceSamples(n, approved, noShows int) []*model.AttendanceSample {
+	samples := make([]*model.AttendanceSample, n)
+	for i := range samples {
+		samples[i] = &model.AttendanceSample{Approved: approved, NoShows: noShows}
+	}
+	return samples
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestForecastAttendance_PrefersGuildTemplate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild:1"
+	svc := NewAnalyticsService(AnalyticsServiceConfig{Repo: &mockAnalyticsRepo{
+		byBasis: map[model.ForecastBasis][]*model.AttendanceSample{
+			model.ForecastBasisGuildTemplate: attendanceSamples(12, 10, 5),
+			model.ForecastBasisGuild:         attendanceSamples(20, 10, 0),
+		},
+	}})
+
+	forecast, err := svc.ForecastAttendance(ctx, &model.Event{GuildID: &guildID, Template: model.EventTemplateDinnerParty}, 20)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if forecast.Basis != model.ForecastBasisGuildTemplate {
+		t.Errorf("expected guild_template basis, got %s", forecast.Basis)
+	}
+	if forecast.Confidence != model.ForecastConfidenceHigh {
+		t.Errorf("expected high confidence, got %s", forecast.Confidence)
+	}
+	// (60 attended + 8 prior) / (120 + 10) ≈ 0.523
+	if forecast.ExpectedAttendance != 10 {
+		t.Errorf("expected 10 attendees, got %d", forecast.ExpectedAttendance)
+	}
+	if forecast.ExpectedLow > forecast.ExpectedAttendance || forecast.ExpectedHigh < forecast.ExpectedAttendance {
+		t.Errorf("expected range to contain estimate, got %d-%d", forecast.ExpectedLow, forecast.ExpectedHigh)
+	}
+}
+
+func TestForecastAttendance_FallsBackWhenHistoryIsThin(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild:1"
+	svc := NewAnalyticsService(AnalyticsServiceConfig{Repo: &mockAnalyticsRepo{
+		byBasis: map[model.ForecastBasis][]*model.AttendanceSample{
+			model.ForecastBasisGuildTemplate: attendanceSamples(2, 10, 0),
+			model.ForecastBasisGuild:         attendanceSamples(1, 10, 0),
+			model.ForecastBasisTemplate:      attendanceSamples(6, 8, 2),
+		},
+	}})
+
+	forecast, err := svc.ForecastAttendance(ctx, &model.Event{GuildID: &guildID, Template: model.EventTemplateActivity}, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if forecast.Basis != model.ForecastBasisTemplate {
+		t.Errorf("expected template basis, got %s", forecast.Basis)
+	}
+	if forecast.Confidence != model.ForecastConfidenceMedium {
+		t.Errorf("expected medium confidence, got %s", forecast.Confidence)
+	}
+
+	empty := NewAnalyticsService(AnalyticsServiceConfig{Repo: &mockAnalyticsRepo{}})
+	forecast, err = empty.ForecastAttendance(ctx, &model.Event{Template: model.EventTemplateCasual}, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if forecast.Basis != model.ForecastBasisDefault || forecast.Confidence != model.ForecastConfidenceLow {
+		t.Errorf("expected default basis with low confidence, got %s/%s", forecast.Basis, forecast.Confidence)
+	}
+	if forecast.ExpectedAttendance != 8 {
+		t.Errorf("expected default rate to give 8 attendees, got %d", forecast.ExpectedAttendance)
+	}
+}
@@ -0,0 +1,592 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+const (
+	// DefaultAnnouncementBatchSize is how many recipients are resolved per page
+	DefaultAnnouncementBatchSize = 500
+	// DefaultAnnouncementStaleAfter is how long an announcement may stay in
+	// sending before another run picks it up again
+	DefaultAnnouncementStaleAfter = 15 * time.Minute
+	// DefaultAnnouncementListLimit caps announcement listings and inboxes
+	DefaultAnnouncementListLimit = 50
+)
+
+// AnnouncementRepository defines the interface for announcement storage
+type AnnouncementRepository interface {
+	Create(ctx context.Context, announcement *model.Announcement) error
+	GetByID(ctx context.Context, id string) (*model.Announcement, error)
+	List(ctx context.Context, limit int) ([]*model.Announcement, error)
+	ListByGuild(ctx context.Context, guildID string, sentOnly bool, limit int) ([]*model.Announcement, error)
+	Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Announcement, error)
+	Delete(ctx context.Context, id string) error
+	ClaimDue(ctx context.Context, now, staleBefore time.Time) ([]*model.Announcement, error)
+	MarkSent(ctx context.Context, id string, recipientCount int, sentOn time.Time) error
+	ListUserIDs(ctx context.Context, after string, limit int) ([]string, error)
+	ListLocationsInBox(ctx context.Context, minLat, maxLat, minLng, maxLng float64, after string, limit int) ([]*model.AnnouncementRecipientLocation, error)
+	CreateReceipt(ctx context.Context, announcementID, userID string) error
+	GetInbox(ctx context.Context, userID string, limit int) ([]*model.AnnouncementReceipt, error)
+	CountUnread(ctx context.Context, userID string) (int, error)
+	MarkRead(ctx context.Context, announcementID, userID string) (bool, error)
+}
+
+// AnnouncementGuildRepository provides guild lookups for guild announcements
+type AnnouncementGuildRepository interface {
+	GetByID(ctx context.Context, id string) (*model.Guild, error)
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error)
+	GetMembers(ctx context.Context, guildID string) ([]*model.Member, error)
+}
+
+// AnnouncementService composes, schedules and delivers announcements.
+// Platform admins can target everyone, a guild or an area; guild admins can
+// announce to their own guild. Delivery happens in ProcessDueAnnouncements,
+// so sending now just schedules the announcement for the next run.
+type AnnouncementService struct {
+	repo        AnnouncementRepository
+	guilds      AnnouncementGuildRepository
+	geo         *GeoService
+	eventHub    *EventHub
+	pushService *PushService
+	outbox      NotificationQueue
+	mutes       NotificationMutes
+	reactions   ReactionSummaries
+	permissions GuildPermissionChecker
+	batchSize   int
+	staleAfter  time.Duration
+	clock       clock.Clock
+}
+
+// AnnouncementServiceConfig holds configuration for the announcement service
+type AnnouncementServiceConfig struct {
+	Repo        AnnouncementRepository
+	GuildRepo   AnnouncementGuildRepository
+	EventHub    *EventHub
+	PushService *PushService
+	Outbox      NotificationQueue      // Optional; nil sends notifications inline
+	Mutes       NotificationMutes      // Optional; nil notifies every recipient of guild announcements
+	Reactions   ReactionSummaries      // Optional; nil leaves reactions out of the inbox
+	Permissions GuildPermissionChecker // Optional; nil lets only guild admins manage guild announcements
+	BatchSize   int                    // Recipients resolved per page (default 500)
+	StaleAfter  time.Duration          // When an interrupted delivery is retried (default 15m)
+	Clock       clock.Clock            // Default: the system clock
+}
+
+// NewAnnouncementService creates a new announcement service
+func NewAnnouncementService(cfg AnnouncementServiceConfig) *AnnouncementService {
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultAnnouncementBatchSize
+	}
+	staleAfter := cfg.StaleAfter
+	if staleAfter <= 0 {
+		staleAfter = DefaultAnnouncementStaleAfter
+	}
+	return &AnnouncementService{
+		repo:        cfg.Repo,
+		guilds:      cfg.GuildRepo,
+		geo:         NewGeoService(),
+		eventHub:    cfg.EventHub,
+		pushService: cfg.PushService,
+		outbox:      cfg.Outbox,
+		mutes:       cfg.Mutes,
+		reactions:   cfg.Reactions,
+		permissions: cfg.Permissions,
+		batchSize:   batchSize,
+		staleAfter:  staleAfter,
+		clock:       clock.OrReal(cfg.Clock),
+	}
+}
+
+// ============================================================================
+// Platform announcements (admins)
+// ============================================================================
+
+// Create composes a platform announcement
+func (s *AnnouncementService) Create(ctx context.Context, adminID string, req *model.CreateAnnouncementRequest) (*model.Announcement, error) {
+	if req.Audience == model.AnnouncementAudienceGuild {
+		guild, err := s.guilds.GetByID(ctx, *req.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("getting guild: %w", err)
+		}
+		if guild == nil {
+			return nil, ErrGuildNotFound
+		}
+	}
+	return s.create(ctx, adminID, req)
+}
+
+// Get returns any announcement
+func (s *AnnouncementService) Get(ctx context.Context, id string) (*model.Announcement, error) {
+	return s.load(ctx, id)
+}
+
+// List returns all announcements, newest first
+func (s *AnnouncementService) List(ctx context.Context) ([]*model.Announcement, error) {
+	return s.repo.List(ctx, DefaultAnnouncementListLimit)
+}
+
+// Update edits a draft or scheduled announcement
+func (s *AnnouncementService) Update(ctx context.Context, id string, req *model.UpdateAnnouncementRequest) (*model.Announcement, error) {
+	announcement, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, announcement, req)
+}
+
+// Send schedules an announcement for immediate delivery
+func (s *AnnouncementService) Send(ctx context.Context, id string) (*model.Announcement, error) {
+	announcement, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.send(ctx, announcement)
+}
+
+// Delete removes an announcement. Deleting a sent announcement also removes it
+// from recipients' inboxes.
+func (s *AnnouncementService) Delete(ctx context.Context, id string) error {
+	if _, err := s.load(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// ============================================================================
+// Guild announcements (guild admins or members allowed to send them)
+// ============================================================================
+
+// CreateForGuild composes an announcement to a guild's members
+func (s *AnnouncementService) CreateForGuild(ctx context.Context, userID, guildID string, req *model.CreateAnnouncementRequest) (*model.Announcement, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	return s.create(ctx, userID, req)
+}
+
+// GetForGuild returns a guild announcement. Members only see sent ones.
+func (s *AnnouncementService) GetForGuild(ctx context.Context, userID, guildID, id string) (*model.Announcement, error) {
+	isAdmin, err := s.checkGuildAccess(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	announcement, err := s.loadForGuild(ctx, guildID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && announcement.Status != model.AnnouncementStatusSent {
+		return nil, ErrAnnouncementNotFound
+	}
+	return announcement, nil
+}
+
+// ListForGuild returns a guild's announcements, newest first. Members only
+// see sent ones.
+func (s *AnnouncementService) ListForGuild(ctx context.Context, userID, guildID string) ([]*model.Announcement, error) {
+	isAdmin, err := s.checkGuildAccess(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListByGuild(ctx, guildID, !isAdmin, DefaultAnnouncementListLimit)
+}
+
+// UpdateForGuild edits a draft or scheduled guild announcement
+func (s *AnnouncementService) UpdateForGuild(ctx context.Context, userID, guildID, id string, req *model.UpdateAnnouncementRequest) (*model.Announcement, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	announcement, err := s.loadForGuild(ctx, guildID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, announcement, req)
+}
+
+// SendForGuild schedules a guild announcement for immediate delivery
+func (s *AnnouncementService) SendForGuild(ctx context.Context, userID, guildID, id string) (*model.Announcement, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	announcement, err := s.loadForGuild(ctx, guildID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.send(ctx, announcement)
+}
+
+// DeleteForGuild removes a guild announcement
+func (s *AnnouncementService) DeleteForGuild(ctx context.Context, userID, guildID, id string) error {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return err
+	}
+	if _, err := s.loadForGuild(ctx, guildID, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// ============================================================================
+// Inbox (recipients)
+// ============================================================================
+
+// GetInbox returns the announcements delivered to a user with their unread count
+func (s *AnnouncementService) GetInbox(ctx context.Context, userID string) (*model.AnnouncementInbox, error) {
+	receipts, err := s.repo.GetInbox(ctx, userID, DefaultAnnouncementListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("getting inbox: %w", err)
+	}
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("counting unread announcements: %w", err)
+	}
+	if s.reactions != nil && len(receipts) > 0 {
+		ids := make([]string, len(receipts))
+		for i, receipt := range receipts {
+			ids[i] = receipt.AnnouncementID
+		}
+		summaries, err := s.reactions.Summaries(ctx, ids, userID)
+		if err != nil {
+			return nil, fmt.Errorf("getting announcement reactions: %w", err)
+		}
+		for _, receipt := range receipts {
+			receipt.Reactions = summaries[receipt.AnnouncementID]
+		}
+	}
+	return &model.AnnouncementInbox{Announcements: receipts, UnreadCount: unread}, nil
+}
+
+// MarkRead marks an announcement delivered to the user as read
+func (s *AnnouncementService) MarkRead(ctx context.Context, userID, id string) error {
+	found, err := s.repo.MarkRead(ctx, id, userID)
+	if err != nil {
+		return fmt.Errorf("marking announcement read: %w", err)
+	}
+	if !found {
+		return ErrAnnouncementNotFound
+	}
+	return nil
+}
+
+// ============================================================================
+// Delivery
+// ============================================================================
+
+// ProcessDueAnnouncements delivers scheduled announcements whose send time has
+// passed. This should be called periodically by a background job.
+//
+// Each recipient gets one receipt, written before they are notified, so an
+// announcement retried after an interrupted run is not delivered twice.
+func (s *AnnouncementService) ProcessDueAnnouncements(ctx context.Context) error {
+	now := s.clock.Now()
+	announcements, err := s.repo.ClaimDue(ctx, now, now.Add(-s.staleAfter))
+	if err != nil {
+		return fmt.Errorf("failed to claim due announcements: %w", err)
+	}
+
+	for _, announcement := range announcements {
+		count, err := s.deliver(ctx, announcement)
+		if err != nil {
+			// Left in sending; picked up again once stale
+			log.Printf("[AnnouncementService] Failed to deliver announcement %s: %v", announcement.ID, err)
+			continue
+		}
+		if err := s.repo.MarkSent(ctx, announcement.ID, count, s.clock.Now()); err != nil {
+			log.Printf("[AnnouncementService] Failed to mark announcement %s sent: %v", announcement.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// deliver writes a receipt for every recipient and notifies the new ones.
+// Returns the number of recipients.
+func (s *AnnouncementService) deliver(ctx context.Context, announcement *model.Announcement) (int, error) {
+	count := 0
+	err := s.forEachRecipientBatch(ctx, announcement, func(userIDs []string) {
+		delivered := make([]string, 0, len(userIDs))
+		for _, userID := range userIDs {
+			if err := s.repo.CreateReceipt(ctx, announcement.ID, userID); err != nil {
+				if errors.Is(err, database.ErrDuplicate) {
+					count++
+				} else {
+					log.Printf("[AnnouncementService] Failed to deliver announcement %s to user %s: %v", announcement.ID, userID, err)
+				}
+				continue
+			}
+			count++
+			delivered = append(delivered, userID)
+		}
+		s.notify(ctx, announcement, delivered)
+	})
+	return count, err
+}
+
+// forEachRecipientBatch resolves the audience page by page
+func (s *AnnouncementService) forEachRecipientBatch(ctx context.Context, announcement *model.Announcement, fn func(userIDs []string)) error {
+	switch announcement.Audience {
+	case model.AnnouncementAudienceAll:
+		after := ""
+		for {
+			userIDs, err := s.repo.ListUserIDs(ctx, after, s.batchSize)
+			if err != nil {
+				return fmt.Errorf("listing users: %w", err)
+			}
+			if len(userIDs) == 0 {
+				return nil
+			}
+			fn(userIDs)
+			if len(userIDs) < s.batchSize {
+				return nil
+			}
+			after = userIDs[len(userIDs)-1]
+		}
+
+	case model.AnnouncementAudienceGuild:
+		if announcement.GuildID == nil {
+			return nil
+		}
+		members, err := s.guilds.GetMembers(ctx, *announcement.GuildID)
+		if err != nil {
+			return fmt.Errorf("listing guild members: %w", err)
+		}
+		userIDs := make([]string, 0, len(members))
+		seen := make(map[string]bool)
+		for _, member := range members {
+			if member.UserID == "" || seen[member.UserID] {
+				continue
+			}
+			seen[member.UserID] = true
+			userIDs = append(userIDs, member.UserID)
+		}
+		fn(userIDs)
+		return nil
+
+	case model.AnnouncementAudienceArea:
+		area := announcement.Area
+		if area == nil {
+			return nil
+		}
+		box := s.geo.GetBoundingBox(area.Lat, area.Lng, area.RadiusKm)
+		after := ""
+		for {
+			locations, err := s.repo.ListLocationsInBox(ctx, box.MinLat, box.MaxLat, box.MinLng, box.MaxLng, after, s.batchSize)
+			if err != nil {
+				return fmt.Errorf("listing users in area: %w", err)
+			}
+			if len(locations) == 0 {
+				return nil
+			}
+			userIDs := make([]string, 0, len(locations))
+			for _, location := range locations {
+				if s.geo.IsWithinRadius(area.Lat, area.Lng, location.Lat, location.Lng, area.RadiusKm) {
+					userIDs = append(userIDs, location.UserID)
+				}
+			}
+			fn(userIDs)
+			if len(locations) < s.batchSize {
+				return nil
+			}
+			after = locations[len(locations)-1].UserID
+		}
+	}
+	return nil
+}
+
+// notify sends the in-app event and, when requested, a push notification.
+// Members who muted the guild still find guild announcements in their inbox.
+func (s *AnnouncementService) notify(ctx context.Context, announcement *model.Announcement, userIDs []string) {
+	if announcement.GuildID != nil && s.mutes != nil {
+		kept, err := s.mutes.FilterMuted(ctx, userIDs, model.NotificationScope{GuildID: *announcement.GuildID})
+		if err != nil {
+			log.Printf("[AnnouncementService] Failed to check mutes for announcement %s: %v", announcement.ID, err)
+		} else {
+			userIDs = kept
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	data := map[string]interface{}{
+		"announcement_id": announcement.ID,
+		"title":           announcement.Title,
+		"body":            announcement.Body,
+	}
+	if announcement.GuildID != nil {
+		data["guild_id"] = *announcement.GuildID
+	}
+	var push *PushNotification
+	if announcement.Push {
+		push = &PushNotification{
+			Title: announcement.Title,
+			Body:  announcement.Body,
+			Data: map[string]string{
+				"type":            string(EventAnnouncement),
+				"announcement_id": announcement.ID,
+			},
+		}
+	}
+
+	event := &Event{Type: EventAnnouncement, Data: data}
+	sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, notificationsForUsers(userIDs, event, push)...)
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+func (s *AnnouncementService) create(ctx context.Context, userID string, req *model.CreateAnnouncementRequest) (*model.Announcement, error) {
+	announcement := &model.Announcement{
+		Title:     req.Title,
+		Body:      req.Body,
+		Audience:  req.Audience,
+		Push:      req.Push,
+		Status:    model.AnnouncementStatusDraft,
+		CreatedBy: userID,
+	}
+	switch req.Audience {
+	case model.AnnouncementAudienceGuild:
+		announcement.GuildID = req.GuildID
+	case model.AnnouncementAudienceArea:
+		announcement.Area = req.Area
+	}
+	if req.SendAt != nil {
+		if err := s.checkSendAt(*req.SendAt); err != nil {
+			return nil, err
+		}
+		announcement.SendAt = req.SendAt
+		announcement.Status = model.AnnouncementStatusScheduled
+	}
+
+	if err := s.repo.Create(ctx, announcement); err != nil {
+		return nil, fmt.Errorf("creating announcement: %w", err)
+	}
+	return announcement, nil
+}
+
+func (s *AnnouncementService) update(ctx context.Context, announcement *model.Announcement, req *model.UpdateAnnouncementRequest) (*model.Announcement, error) {
+	if !announcement.Status.IsEditable() {
+		return nil, ErrAnnouncementNotEditable
+	}
+
+	updates := make(map[string]interface{})
+	if req.Title != nil {
+		updates["title"] = *req.Title
+	}
+	if req.Body != nil {
+		updates["body"] = *req.Body
+	}
+	if req.Push != nil {
+		updates["push"] = *req.Push
+	}
+	if req.SendAt != nil {
+		if err := s.checkSendAt(*req.SendAt); err != nil {
+			return nil, err
+		}
+		updates["send_at"] = *req.SendAt
+		updates["status"] = string(model.AnnouncementStatusScheduled)
+	}
+	if len(updates) == 0 {
+		return announcement, nil
+	}
+
+	return s.applyUpdate(ctx, announcement.ID, updates)
+}
+
+func (s *AnnouncementService) send(ctx context.Context, announcement *model.Announcement) (*model.Announcement, error) {
+	if !announcement.Status.IsEditable() {
+		return nil, ErrAnnouncementNotEditable
+	}
+	return s.applyUpdate(ctx, announcement.ID, map[string]interface{}{
+		"send_at": s.clock.Now(),
+		"status":  string(model.AnnouncementStatusScheduled),
+	})
+}
+
+// applyUpdate updates an editable announcement; delivery may have started
+// since it was loaded
+func (s *AnnouncementService) applyUpdate(ctx context.Context, id string, updates map[string]interface{}) (*model.Announcement, error) {
+	updated, err := s.repo.Update(ctx, id, updates)
+	if err != nil {
+		return nil, fmt.Errorf("updating announcement: %w", err)
+	}
+	if updated == nil {
+		return nil, ErrAnnouncementNotEditable
+	}
+	return updated, nil
+}
+
+func (s *AnnouncementService) checkSendAt(sendAt time.Time) error {
+	now := s.clock.Now()
+	if !sendAt.After(now) || sendAt.After(now.AddDate(0, 0, model.MaxAnnouncementScheduleDays)) {
+		return ErrAnnouncementSendAtInvalid
+	}
+	return nil
+}
+
+func (s *AnnouncementService) load(ctx context.Context, id string) (*model.Announcement, error) {
+	announcement, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting announcement: %w", err)
+	}
+	if announcement == nil {
+		return nil, ErrAnnouncementNotFound
+	}
+	return announcement, nil
+}
+
+// loadForGuild loads an announcement, hiding announcements of other guilds
+func (s *AnnouncementService) loadForGuild(ctx context.Context, guildID, id string) (*model.Announcement, error) {
+	announcement, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if announcement.GuildID == nil || *announcement.GuildID != guildID {
+		return nil, ErrAnnouncementNotFound
+	}
+	return announcement, nil
+}
+
+// checkGuildAccess requires guild membership and reports whether the user
+// may manage the guild's announcements: guild admins, or with a permission
+// checker, members allowed to send announcements
+func (s *AnnouncementService) checkGuildAccess(ctx context.Context, userID, guildID string) (bool, error) {
+	isMember, err := s.guilds.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return false, fmt.Errorf("checking guild membership: %w", err)
+	}
+	if !isMember {
+		return false, ErrNotGuildMember
+	}
+	if s.permissions != nil {
+		err := s.permissions.RequirePermission(ctx, userID, guildID, model.PermissionSendAnnouncements)
+		if errors.Is(err, ErrMissingPermission) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	return s.guilds.IsGuildAdmin(ctx, userID, guildID)
+}
+
+func (s *AnnouncementService) requireGuildAdmin(ctx context.Context, userID, guildID string) error {
+	isAdmin, err := s.checkGuildAccess(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrNotGuildAdmin
+	}
+	return nil
+}
@@ -0,0 +1,525 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repository
+// ============================================================================
+
+// mockAnnouncementRepo keeps announcements and receipts in memory
+type mockAnnouncementRepo struct {
+	announcements map[string]*model.Announcement
+	receipts      map[string]*model.AnnouncementReceipt // announcementID|userID
+	userIDs       []string
+	locations     []*model.AnnouncementRecipientLocation
+	nextID        int
+}
+
+func newMockAnnouncementRepo() *mockAnnouncementRepo {
+	return &mockAnnouncementRepo{
+		announcements: make(map[string]*model.Announcement),
+		receipts:      make(map[string]*model.AnnouncementReceipt),
+	}
+}
+
+func (m *mockAnnouncementRepo) Create(ctx context.Context, announcement *model.Announcement) error {
+	m.nextID++
+	announcement.ID = fmt.Sprintf("announcement:%d", m.nextID)
+	stored := *announcement
+	m.announcements[announcement.ID] = &stored
+	return nil
+}
+
+func (m *mockAnnouncementRepo) GetByID(ctx context.Context, id string) (*model.Announcement, error) {
+	announcement, ok := m.announcements[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *announcement
+	return &copied, nil
+}
+
+func (m *mockAnnouncementRepo) List(ctx context.Context, limit int) ([]*model.Announcement, error) {
+	var result []*model.Announcement
+	for _, announcement := range m.announcements {
+		result = append(result, announcement)
+	}
+	return result, nil
+}
+
+func (m *mockAnnouncementRepo) ListByGuild(ctx context.Context, guildID string, sentOnly bool, limit int) ([]*model.Announcement, error) {
+	var result []*model.Announcement
+	for _, announcement := range m.announcements {
+		if announcement.GuildID == nil || *announcement.GuildID != guildID {
+			continue
+		}
+		if sentOnly && announcement.Status != model.AnnouncementStatusSent {
+			continue
+		}
+		result = append(result, announcement)
+	}
+	return result, nil
+}
+
+func (m *mockAnnouncementRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Announcement, error) {
+	announcement, ok := m.announcements[id]
+	if !ok || !announcement.Status.IsEditable() {
+		return nil, nil
+	}
+	if v, ok := updates["title"]; ok {
+		announcement.Title = v.(string)
+	}
+	if v, ok := updates["push"]; ok {
+		announcement.Push = v.(bool)
+	}
+	if v, ok := updates["status"]; ok {
+		announcement.Status = model.AnnouncementStatus(v.(string))
+	}
+	if v, ok := updates["send_at"]; ok {
+		sendAt := v.(time.Time)
+		announcement.SendAt = &sendAt
+	}
+	return m.GetByID(ctx, id)
+}
+
+func (m *mockAnnouncementRepo) Delete(ctx context.Context, id string) error {
+	delete(m.announcements, id)
+	return nil
+}
+
+func (m *mockAnnouncementRepo) ClaimDue(ctx context.Context, now, staleBefore time.Time) ([]*model.Announcement, error) {
+	var claimed []*model.Announcement
+	for _, announcement := range m.announcements {
+		if announcement.Status == model.AnnouncementStatusScheduled && !announcement.SendAt.After(now) {
+			announcement.Status = model.AnnouncementStatusSending
+			copied := *announcement
+			claimed = append(claimed, &copied)
+		}
+	}
+	return claimed, nil
+}
+
+func (m *mockAnnouncementRepo) MarkSent(ctx context.Context, id string, recipientCount int, sentOn time.Time) error {
+	announcement := m.announcements[id]
+	announcement.Status = model.AnnouncementStatusSent
+	announcement.RecipientCount = recipientCount
+	announcement.SentOn = &sentOn
+	return nil
+}
+
+func (m *mockAnnouncementRepo) ListUserIDs(ctx context.Context, after string, limit int) ([]string, error) {
+	var page []string
+	for _, userID := range m.userIDs {
+		if userID > after && len(page) < limit {
+			page = append(page, userID)
+		}
+	}
+	return page, nil
+}
+
+func (m *mockAnnouncementRepo) ListLocationsInBox(ctx context.Context, minLat, maxLat, minLng, maxLng float64, after string, limit int) ([]*model.AnnouncementRecipientLocation, error) {
+	var page []*model.AnnouncementRecipientLocation
+	for _, location := range m.locations {
+		inBox := location.Lat >= minLat && location.Lat <= maxLat && location.Lng >= minLng && location.Lng <= maxLng
+		if inBox && location.UserID > after && len(page) < limit {
+			page = append(page, location)
+		}
+	}
+	return page, nil
+}
+
+func (m *mockAnnouncementRepo) CreateReceipt(ctx context.Context, announcementID, userID string) error {
+	key := announcementID + "|" + userID
+	if _, ok := m.receipts[key]; ok {
+		return database.ErrDuplicate
+	}
+	announcement := m.announcements[announcementID]
+	m.receipts[key] = &model.AnnouncementReceipt{
+		AnnouncementID: announcementID,
+		Title:          announcement.Title,
+		Body:           announcement.Body,
+		DeliveredOn:    time.Now(),
+	}
+	return nil
+}
+
+func (m *mockAnnouncementRepo) GetInbox(ctx context.Context, userID string, limit int) ([]*model.AnnouncementReceipt, error) {
+	var receipts []*model.AnnouncementReceipt
+	for key, receipt := range m.receipts {
+		if key[len(key)-len(userID)-1:] == "|"+userID {
+			receipts = append(receipts, receipt)
+		}
+	}
+	return receipts, nil
+}
+
+func (m *mockAnnouncementRepo) CountUnread(ctx context.Context, userID string) (int, error) {
+	receipts, _ := m.GetInbox(ctx, userID, 0)
+	unread := 0
+	for _, receipt := range receipts {
+		if receipt.ReadOn == nil {
+			unread++
+		}
+	}
+	return unread, nil
+}
+
+func (m *mockAnnouncementRepo) MarkRead(ctx context.Context, announcementID, userID string) (bool, error) {
+	receipt, ok := m.receipts[announcementID+"|"+userID]
+	if !ok {
+		return false, nil
+	}
+	if receipt.ReadOn == nil {
+		now := time.Now()
+		receipt.ReadOn = &now
+	}
+	return true, nil
+}
+
+// recipients returns who an announcement was delivered to, sorted
+func (m *mockAnnouncementRepo) recipients(announcementID string) []string {
+	var userIDs []string
+	for key := range m.receipts {
+		prefix := announcementID + "|"
+		if len(key) > len(prefix) && key[:len(prefix)] == prefix {
+			userIDs = append(userIDs, key[len(prefix):])
+		}
+	}
+	sort.Strings(userIDs)
+	return userIDs
+}
+
+var announcementTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newAnnouncementTestService(repo *mockAnnouncementRepo, guildRepo AnnouncementGuildRepository) *AnnouncementService {
+	return NewAnnouncementService(AnnouncementServiceConfig{
+		Repo:      repo,
+		GuildRepo: guildRepo,
+		BatchSize: 2,
+		Clock:     fakeclock.New(announcementTestNow),
+	})
+}
+
+func announcementGuildRepo(members ...string) *mockGuildRepo {
+	return &mockGuildRepo{
+		getByIDFunc: func(ctx context.Context, id string) (*model.Guild, error) {
+			return &model.Guild{ID: id, Name: "Tuesday Games"}, nil
+		},
+		isMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			for _, member := range members {
+				if member == userID {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+		getMembersFunc: func(ctx context.Context, guildID string) ([]*model.Member, error) {
+			result := make([]*model.Member, 0, len(members))
+			for _, member := range members {
+				result = append(result, &model.Member{UserID: member})
+			}
+			return result, nil
+		},
+	}
+}
+
+func guildAnnouncementRequest(guildID string) *model.CreateAnnouncementRequest {
+	return &model.CreateAnnouncementRequest{
+		Title:    "Venue change",
+		Body:     "We're meeting at the library this week.",
+		Audience: model.AnnouncementAudienceGuild,
+		GuildID:  &guildID,
+	}
+}
+
+// ============================================================================
+// Compose and schedule Tests
+// ============================================================================
+
+func TestAnnouncement_CreateDraftOrScheduled(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	svc := newAnnouncementTestService(repo, announcementGuildRepo())
+
+	req := &model.CreateAnnouncementRequest{Title: "Maintenance", Body: "Down for an hour", Audience: model.AnnouncementAudienceAll}
+	draft, err := svc.Create(ctx, "user:admin", req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if draft.Status != model.AnnouncementStatusDraft {
+		t.Errorf("expected draft, got %s", draft.Status)
+	}
+
+	sendAt := announcementTestNow.Add(time.Hour)
+	req.SendAt = &sendAt
+	scheduled, err := svc.Create(ctx, "user:admin", req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scheduled.Status != model.AnnouncementStatusScheduled {
+		t.Errorf("expected scheduled, got %s", scheduled.Status)
+	}
+
+	for _, sendAt := range []time.Time{announcementTestNow.Add(-time.Minute), announcementTestNow.AddDate(0, 0, model.MaxAnnouncementScheduleDays+1)} {
+		req.SendAt = &sendAt
+		if _, err := svc.Create(ctx, "user:admin", req); !errors.Is(err, ErrAnnouncementSendAtInvalid) {
+			t.Errorf("send_at %v: expected ErrAnnouncementSendAtInvalid, got %v", sendAt, err)
+		}
+	}
+}
+
+func TestAnnouncement_GuildRequiresAdmin(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	members := announcementGuildRepo("user:member")
+	svc := newAnnouncementTestService(repo, &nonAdminGuildRepo{members})
+
+	if _, err := svc.CreateForGuild(ctx, "user:member", "guild:1", guildAnnouncementRequest("guild:1")); !errors.Is(err, ErrNotGuildAdmin) {
+		t.Errorf("expected ErrNotGuildAdmin, got %v", err)
+	}
+	if _, err := svc.CreateForGuild(ctx, "user:outsider", "guild:1", guildAnnouncementRequest("guild:1")); !errors.Is(err, ErrNotGuildMember) {
+		t.Errorf("expected ErrNotGuildMember, got %v", err)
+	}
+}
+
+func TestAnnouncement_MembersOnlySeeSentGuildAnnouncements(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	adminSvc := newAnnouncementTestService(repo, announcementGuildRepo("user:admin", "user:member"))
+	memberSvc := newAnnouncementTestService(repo, &nonAdminGuildRepo{announcementGuildRepo("user:admin", "user:member")})
+
+	draft, err := adminSvc.CreateForGuild(ctx, "user:admin", "guild:1", guildAnnouncementRequest("guild:1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := memberSvc.GetForGuild(ctx, "user:member", "guild:1", draft.ID); !errors.Is(err, ErrAnnouncementNotFound) {
+		t.Errorf("expected draft to be hidden from members, got %v", err)
+	}
+	if _, err := adminSvc.GetForGuild(ctx, "user:admin", "guild:2", draft.ID); !errors.Is(err, ErrAnnouncementNotFound) {
+		t.Errorf("expected announcement of another guild to be hidden, got %v", err)
+	}
+	listed, err := memberSvc.ListForGuild(ctx, "user:member", "guild:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(listed) != 0 {
+		t.Errorf("expected no sent announcements, got %d", len(listed))
+	}
+}
+
+func TestAnnouncement_SentIsNotEditable(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	svc := newAnnouncementTestService(repo, announcementGuildRepo("user:admin"))
+
+	announcement, err := svc.CreateForGuild(ctx, "user:admin", "guild:1", guildAnnouncementRequest("guild:1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.SendForGuild(ctx, "user:admin", "guild:1", announcement.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessDueAnnouncements(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	title := "Edited"
+	_, err = svc.UpdateForGuild(ctx, "user:admin", "guild:1", announcement.ID, &model.UpdateAnnouncementRequest{Title: &title})
+	if !errors.Is(err, ErrAnnouncementNotEditable) {
+		t.Errorf("expected ErrAnnouncementNotEditable, got %v", err)
+	}
+	if _, err := svc.SendForGuild(ctx, "user:admin", "guild:1", announcement.ID); !errors.Is(err, ErrAnnouncementNotEditable) {
+		t.Errorf("expected resend to fail with ErrAnnouncementNotEditable, got %v", err)
+	}
+}
+
+// ============================================================================
+// Delivery Tests
+// ============================================================================
+
+func TestAnnouncement_DeliversOnlyWhenDue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	svc := newAnnouncementTestService(repo, announcementGuildRepo("user:admin", "user:a", "user:b"))
+
+	req := guildAnnouncementRequest("guild:1")
+	sendAt := announcementTestNow.Add(time.Hour)
+	req.SendAt = &sendAt
+	announcement, err := svc.CreateForGuild(ctx, "user:admin", "guild:1", req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.ProcessDueAnnouncements(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := repo.recipients(announcement.ID); len(got) != 0 {
+		t.Fatalf("expected no delivery before send_at, got %v", got)
+	}
+
+	svc.clock = fakeclock.New(sendAt)
+	if err := svc.ProcessDueAnnouncements(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := repo.recipients(announcement.ID); len(got) != 3 {
+		t.Errorf("expected delivery to 3 members, got %v", got)
+	}
+	stored := repo.announcements[announcement.ID]
+	if stored.Status != model.AnnouncementStatusSent || stored.RecipientCount != 3 {
+		t.Errorf("expected sent to 3 recipients, got %s/%d", stored.Status, stored.RecipientCount)
+	}
+}
+
+func TestAnnouncement_AllUsersPagesThroughEveryone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	repo.userIDs = []string{"user:1", "user:2", "user:3", "user:4", "user:5"}
+	svc := newAnnouncementTestService(repo, announcementGuildRepo())
+
+	announcement, err := svc.Create(ctx, "user:admin", &model.CreateAnnouncementRequest{Title: "Hello", Body: "Welcome", Audience: model.AnnouncementAudienceAll})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.Send(ctx, announcement.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessDueAnnouncements(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := repo.recipients(announcement.ID); len(got) != 5 {
+		t.Errorf("expected all 5 users across pages of 2, got %v", got)
+	}
+}
+
+func TestAnnouncement_AreaFiltersByRadius(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	repo.locations = []*model.AnnouncementRecipientLocation{
+		{UserID: "user:center", Lat: 38.7223, Lng: -9.1393},
+		{UserID: "user:near", Lat: 38.7500, Lng: -9.1500},   // ~3 km
+		{UserID: "user:corner", Lat: 38.8000, Lng: -9.0400}, // in the bounding box, ~12 km away
+		{UserID: "user:far", Lat: 41.1579, Lng: -8.6291},    // Porto
+	}
+	svc := newAnnouncementTestService(repo, announcementGuildRepo())
+
+	announcement, err := svc.Create(ctx, "user:admin", &model.CreateAnnouncementRequest{
+		Title:    "Street festival",
+		Body:     "Road closures downtown",
+		Audience: model.AnnouncementAudienceArea,
+		Area:     &model.AnnouncementArea{Lat: 38.7223, Lng: -9.1393, RadiusKm: 10},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.Send(ctx, announcement.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessDueAnnouncements(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := repo.recipients(announcement.ID)
+	if len(got) != 2 || got[0] != "user:center" || got[1] != "user:near" {
+		t.Errorf("expected only users within 10 km, got %v", got)
+	}
+}
+
+func TestAnnouncement_RetryDoesNotDuplicateReceipts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	svc := newAnnouncementTestService(repo, announcementGuildRepo("user:admin", "user:a"))
+
+	announcement, err := svc.CreateForGuild(ctx, "user:admin", "guild:1", guildAnnouncementRequest("guild:1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A previous run delivered to one member before being interrupted
+	if err := repo.CreateReceipt(ctx, announcement.ID, "user:a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.SendForGuild(ctx, "user:admin", "guild:1", announcement.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessDueAnnouncements(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := repo.announcements[announcement.ID].RecipientCount; got != 2 {
+		t.Errorf("expected 2 recipients, got %d", got)
+	}
+	if got := repo.recipients(announcement.ID); len(got) != 2 {
+		t.Errorf("expected one receipt per member, got %v", got)
+	}
+}
+
+// ============================================================================
+// Inbox Tests
+// ============================================================================
+
+func TestAnnouncement_InboxReadTracking(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockAnnouncementRepo()
+	svc := newAnnouncementTestService(repo, announcementGuildRepo("user:admin", "user:a"))
+
+	announcement, err := svc.CreateForGuild(ctx, "user:admin", "guild:1", guildAnnouncementRequest("guild:1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.SendForGuild(ctx, "user:admin", "guild:1", announcement.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessDueAnnouncements(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	inbox, err := svc.GetInbox(ctx, "user:a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inbox.Announcements) != 1 || inbox.UnreadCount != 1 {
+		t.Fatalf("expected 1 unread announcement, got %d/%d", len(inbox.Announcements), inbox.UnreadCount)
+	}
+
+	if err := svc.MarkRead(ctx, "user:a", announcement.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inbox, _ = svc.GetInbox(ctx, "user:a"); inbox.UnreadCount != 0 {
+		t.Errorf("expected no unread announcements, got %d", inbox.UnreadCount)
+	}
+
+	if err := svc.MarkRead(ctx, "user:outsider", announcement.ID); !errors.Is(err, ErrAnnouncementNotFound) {
+		t.Errorf("expected ErrAnnouncementNotFound for undelivered announcement, got %v", err)
+	}
+}
@@ -2,8 +2,15 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/url"
 	"strings"
+	"time"
 
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/model"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -51,12 +58,43 @@ type PasskeyRepository interface {
 	CountByUserID(ctx context.Context, userID string) (int, error)
 }
 
+// PasswordBreachChecker reports how often a password appears in known
+// breaches
+type PasswordBreachChecker interface {
+	Count(ctx context.Context, password string) (int, error)
+}
+
+// EmailChangeRepository defines the interface for pending email change
+// storage
+type EmailChangeRepository interface {
+	Create(ctx context.Context, change *model.EmailChange) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChange, error)
+	ClaimByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChange, error)
+	ClaimByCancelTokenHash(ctx context.Context, cancelTokenHash string) (*model.EmailChange, error)
+	DeleteByUser(ctx context.Context, userID string) error
+}
+
+// EmailSender delivers plain-text email
+type EmailSender interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
 // AuthService handles authentication operations
 type AuthService struct {
-	userRepo     UserRepository
-	identityRepo IdentityRepository
-	passkeyRepo  PasskeyRepository
-	tokenService *TokenService
+	userRepo         UserRepository
+	identityRepo     IdentityRepository
+	passkeyRepo      PasskeyRepository
+	tokenService     *TokenService
+	minPasswordScore int
+	breachChecker    PasswordBreachChecker
+	emailChangeRepo  EmailChangeRepository
+	invitationRepo   UserInvitationRepository
+	mailer           EmailSender
+	emailVerifyURL   string
+	recoveryURL      string
+	securityEvents   SecurityEventRecorder
+	signupRisk       SignupRiskAssessor
+	clock            clock.Clock
 }
 
 // AuthServiceConfig holds configuration for the auth service
@@ -65,33 +103,75 @@ type AuthServiceConfig struct {
 	IdentityRepo IdentityRepository
 	PasskeyRepo  PasskeyRepository
 	TokenService *TokenService
+
+	// MinPasswordScore is the strength score (0-4) new passwords need; 0
+	// accepts any password of valid length
+	MinPasswordScore int
+	// BreachChecker rejects passwords seen in breaches; nil skips the check
+	BreachChecker PasswordBreachChecker
+
+	// Email changes are unavailable unless both are set
+	EmailChangeRepo EmailChangeRepository
+	Mailer          EmailSender
+	// PublicBaseURL is the site verification links open on
+	PublicBaseURL string
+
+	// InvitationRepo holds invitations to accounts admins created; nil
+	// turns invitations away
+	InvitationRepo UserInvitationRepository
+
+	// SecurityEvents logs credential changes and recoveries; nil skips it
+	SecurityEvents SecurityEventRecorder
+
+	// SignupRisk scores registrations for spam and bots; nil skips it
+	SignupRisk SignupRiskAssessor
+
+	// Clock decides re-authentication windows and email change expiry;
+	// nil is the system clock
+	Clock clock.Clock
 }
 
 // NewAuthService creates a new auth service
 func NewAuthService(cfg AuthServiceConfig) *AuthService {
 	return &AuthService{
-		userRepo:     cfg.UserRepo,
-		identityRepo: cfg.IdentityRepo,
-		passkeyRepo:  cfg.PasskeyRepo,
-		tokenService: cfg.TokenService,
+		userRepo:         cfg.UserRepo,
+		identityRepo:     cfg.IdentityRepo,
+		passkeyRepo:      cfg.PasskeyRepo,
+		tokenService:     cfg.TokenService,
+		minPasswordScore: cfg.MinPasswordScore,
+		breachChecker:    cfg.BreachChecker,
+		emailChangeRepo:  cfg.EmailChangeRepo,
+		invitationRepo:   cfg.InvitationRepo,
+		mailer:           cfg.Mailer,
+		emailVerifyURL:   strings.TrimRight(cfg.PublicBaseURL, "/") + model.EmailVerifyPath,
+		recoveryURL:      strings.TrimRight(cfg.PublicBaseURL, "/") + model.RecoveryCancelPath,
+		securityEvents:   cfg.SecurityEvents,
+		signupRisk:       cfg.SignupRisk,
+		clock:            clock.OrReal(cfg.Clock),
 	}
 }
 
-// RegisterRequest represents a registration request
+// RegisterRequest represents a registration request. IP is the client's
+// address, for signup risk scoring.
 type RegisterRequest struct {
 	Email     string
 	Password  string
 	Firstname string
 	Lastname  string
+	IP        string
 }
 
-// RegisterResult represents a successful registration
+// RegisterResult represents a successful registration.
+// VerificationRequired is set when the signup looked risky enough that the
+// account can't make changes until its email is verified.
 type RegisterResult struct {
-	User      *model.User
-	TokenPair *TokenPair
+	User                 *model.User
+	TokenPair            *TokenPair
+	VerificationRequired bool
 }
 
-// Register creates a new user account with email/password
+// Register creates a new user account with email/password. Risky signups
+// are sent a verification link straight away.
 func (s *AuthService) Register(ctx context.Context, req RegisterRequest) (*RegisterResult, error) {
 	// Validate email
 	email := strings.TrimSpace(strings.ToLower(req.Email))
@@ -103,6 +183,9 @@ func (s *AuthService) Register(ctx context.Context, req RegisterRequest) (*Regis
 	if err := validatePassword(req.Password); err != nil {
 		return nil, err
 	}
+	if err := s.checkPasswordStrength(ctx, req.Password, email, req.Firstname, req.Lastname); err != nil {
+		return nil, err
+	}
 
 	// Check if email already exists
 	existingUser, err := s.userRepo.GetByEmail(ctx, email)
@@ -128,20 +211,34 @@ func (s *AuthService) Register(ctx context.Context, req RegisterRequest) (*Regis
 		EmailVerified: false,
 	}
 
+	var risk *model.SignupRisk
+	if s.signupRisk != nil {
+		risk = s.signupRisk.Assess(ctx, email, req.IP, req.Firstname, req.Lastname)
+	}
+
 	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, err
 	}
 
+	result := &RegisterResult{User: user}
+	if risk != nil {
+		s.signupRisk.Record(ctx, user.ID, risk)
+		result.VerificationRequired = risk.RequiresVerification
+	}
+	if result.VerificationRequired && s.emailChangeRepo != nil && s.mailer != nil {
+		if _, err := s.sendEmailVerification(ctx, user); err != nil {
+			log.Printf("[AuthService] Failed to send signup verification to %s: %v", user.ID, err)
+		}
+	}
+
 	// Generate tokens
 	tokenPair, err := s.tokenService.GenerateTokenPair(ctx, user)
 	if err != nil {
 		return nil, err
 	}
+	result.TokenPair = tokenPair
 
-	return &RegisterResult{
-		User:      user,
-		TokenPair: tokenPair,
-	}, nil
+	return result, nil
 }
 
 // LoginRequest represents a login request
@@ -274,40 +371,443 @@ func (s *AuthService) ValidateAccessToken(token string) (*model.TokenClaims, err
 	}, nil
 }
 
-// ChangePassword changes a user's password
-func (s *AuthService) ChangePassword(ctx context.Context, userID, oldPassword, newPassword string) error {
-	user, err := s.userRepo.GetByID(ctx, userID)
+// ChangePasswordRequest represents a password change. CurrentPassword may
+// be omitted when AuthTime, the caller's sign-in time, is within
+// model.ReauthWindow.
+type ChangePasswordRequest struct {
+	UserID          string
+	CurrentPassword string
+	NewPassword     string
+	AuthTime        time.Time
+}
+
+// ChangePassword changes a user's password, signs out every session and
+// returns fresh tokens for the caller
+func (s *AuthService) ChangePassword(ctx context.Context, req ChangePasswordRequest) (*TokenPair, error) {
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if user == nil {
-		return ErrUserNotFound
+		return nil, ErrUserNotFound
 	}
 
-	// Verify old password if user has one
-	if user.Hash != nil && *user.Hash != "" {
-		if !checkPassword(oldPassword, *user.Hash) {
-			return ErrInvalidCredentials
-		}
+	if err := s.reauthenticate(user, req.CurrentPassword, req.AuthTime); err != nil {
+		return nil, err
 	}
 
 	// Validate new password
-	if err := validatePassword(newPassword); err != nil {
-		return err
+	if err := validatePassword(req.NewPassword); err != nil {
+		return nil, err
+	}
+	if err := s.checkPasswordStrength(ctx, req.NewPassword, user.Email, derefString(user.Firstname), derefString(user.Lastname), derefString(user.Username)); err != nil {
+		return nil, err
 	}
 
 	// Hash new password
-	hash, err := hashPassword(newPassword)
+	hash, err := hashPassword(req.NewPassword)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Update password and revoke all tokens (force re-login)
-	if err := s.userRepo.UpdatePassword(ctx, userID, hash); err != nil {
+	if err := s.userRepo.UpdatePassword(ctx, req.UserID, hash); err != nil {
+		return nil, err
+	}
+	if err := s.tokenService.RevokeAllUserTokens(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	s.recordSecurityEvent(ctx, user.ID, model.SecurityEventPasswordChanged, "")
+
+	return s.tokenService.GenerateTokenPair(ctx, user)
+}
+
+// EmailChangeRequest represents a request to move an account to a new
+// email address. Re-authentication works as for ChangePasswordRequest.
+type EmailChangeRequest struct {
+	UserID          string
+	NewEmail        string
+	CurrentPassword string
+	AuthTime        time.Time
+}
+
+// RequestEmailChange emails a verification link to the new address. The
+// account keeps its current address until ConfirmEmailChange is called with
+// the link's token.
+func (s *AuthService) RequestEmailChange(ctx context.Context, req EmailChangeRequest) (*model.EmailChange, error) {
+	if s.emailChangeRepo == nil || s.mailer == nil {
+		return nil, ErrEmailDeliveryUnavailable
+	}
+
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if err := s.reauthenticate(user, req.CurrentPassword, req.AuthTime); err != nil {
+		return nil, err
+	}
+
+	email, err := s.checkNewEmail(ctx, user, req.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	change := &model.EmailChange{
+		UserID:    user.ID,
+		NewEmail:  email,
+		TokenHash: hashToken(token),
+		ExpiresOn: s.clock.Now().Add(model.EmailChangeTTL),
+	}
+	if err := s.emailChangeRepo.Create(ctx, change); err != nil {
+		return nil, err
+	}
+
+	link := s.emailVerifyURL + "?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf("Someone asked to change the email on your Saga account to this address.\n\n"+
+		"To confirm, open this link within %d hours:\n%s\n\n"+
+		"If this wasn't you, ignore this email and nothing will change.\n", int(model.EmailChangeTTL.Hours()), link)
+	if err := s.mailer.Send(ctx, email, "Confirm your new email address", body); err != nil {
+		_ = s.emailChangeRepo.DeleteByUser(ctx, user.ID)
+		return nil, err
+	}
+	s.recordSecurityEvent(ctx, user.ID, model.SecurityEventEmailChangeRequested, "to "+email)
+
+	return change, nil
+}
+
+// SendEmailVerification emails a link that verifies the address the
+// account already has. Confirming it with ConfirmEmailChange lifts any
+// signup restriction on making changes.
+func (s *AuthService) SendEmailVerification(ctx context.Context, userID string) (*model.EmailChange, error) {
+	if s.emailChangeRepo == nil || s.mailer == nil {
+		return nil, ErrEmailDeliveryUnavailable
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if user.EmailVerified {
+		return nil, ErrEmailAlreadyVerified
+	}
+
+	return s.sendEmailVerification(ctx, user)
+}
+
+// sendEmailVerification emails a user a link verifying their current
+// address
+func (s *AuthService) sendEmailVerification(ctx context.Context, user *model.User) (*model.EmailChange, error) {
+	token, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	change := &model.EmailChange{
+		UserID:    user.ID,
+		NewEmail:  user.Email,
+		TokenHash: hashToken(token),
+		ExpiresOn: s.clock.Now().Add(model.EmailChangeTTL),
+	}
+	if err := s.emailChangeRepo.Create(ctx, change); err != nil {
+		return nil, err
+	}
+
+	link := s.emailVerifyURL + "?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf("Welcome to Saga! Please confirm this is your email address.\n\n"+
+		"Open this link within %d hours:\n%s\n\n"+
+		"If you didn't sign up, ignore this email.\n", int(model.EmailChangeTTL.Hours()), link)
+	if err := s.mailer.Send(ctx, user.Email, "Confirm your email address", body); err != nil {
+		_ = s.emailChangeRepo.DeleteByUser(ctx, user.ID)
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// EmailRecoveryRequest represents a request to move an account whose inbox
+// is lost to a new address. PasskeyID is the passkey the user proved
+// control of.
+type EmailRecoveryRequest struct {
+	UserID    string
+	NewEmail  string
+	PasskeyID string
+}
+
+// StartEmailRecovery starts a recovery change for a user who has signed in
+// with a passkey but can't reach their email. The new address gets a
+// verification link that works only after model.RecoveryDelay; the old
+// address is told straight away and sent a link to cancel.
+func (s *AuthService) StartEmailRecovery(ctx context.Context, req EmailRecoveryRequest) (*model.EmailChange, error) {
+	if s.emailChangeRepo == nil || s.mailer == nil {
+		return nil, ErrEmailDeliveryUnavailable
+	}
+
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	email, err := s.checkNewEmail(ctx, user, req.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	cancelToken, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	effectiveOn := s.clock.Now().Add(model.RecoveryDelay)
+	change := &model.EmailChange{
+		UserID:          user.ID,
+		NewEmail:        email,
+		TokenHash:       hashToken(token),
+		Recovery:        true,
+		EffectiveOn:     &effectiveOn,
+		CancelTokenHash: hashToken(cancelToken),
+		ExpiresOn:       effectiveOn.Add(model.EmailChangeTTL),
+	}
+	if err := s.emailChangeRepo.Create(ctx, change); err != nil {
+		return nil, err
+	}
+
+	// The notice to the old address is what makes recovery safe, so the
+	// change doesn't go ahead unless it is sent
+	when := effectiveOn.UTC().Format("Jan 2, 2006 at 15:04 UTC")
+	cancelLink := s.recoveryURL + "?token=" + url.QueryEscape(cancelToken)
+	notice := fmt.Sprintf("Someone used a passkey on your Saga account to move it to %s, saying they can no longer reach this address.\n\n"+
+		"The change takes effect after %s. If this wasn't you, cancel it with this link, which also signs out every device:\n%s\n", email, when, cancelLink)
+	if err := s.mailer.Send(ctx, user.Email, "Your Saga account is being recovered", notice); err != nil {
+		_ = s.emailChangeRepo.DeleteByUser(ctx, user.ID)
+		return nil, err
+	}
+
+	link := s.emailVerifyURL + "?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf("You asked to recover your Saga account with this address.\n\n"+
+		"For your security the previous address has %d hours to object. After %s, open this link within %d hours to finish:\n%s\n",
+		int(model.RecoveryDelay.Hours()), when, int(model.EmailChangeTTL.Hours()), link)
+	if err := s.mailer.Send(ctx, email, "Finish recovering your Saga account", body); err != nil {
+		_ = s.emailChangeRepo.DeleteByUser(ctx, user.ID)
+		return nil, err
+	}
+	s.recordSecurityEvent(ctx, user.ID, model.SecurityEventEmailRecoveryRequested, fmt.Sprintf("to %s with passkey %s", email, req.PasskeyID))
+
+	return change, nil
+}
+
+// CancelEmailRecovery stops a recovery change with the token sent to the
+// old address. Every session is signed out, since whoever started the
+// recovery may be signed in.
+func (s *AuthService) CancelEmailRecovery(ctx context.Context, cancelToken string) error {
+	if s.emailChangeRepo == nil {
+		return ErrEmailDeliveryUnavailable
+	}
+
+	change, err := s.emailChangeRepo.ClaimByCancelTokenHash(ctx, hashToken(cancelToken))
+	if err != nil {
 		return err
 	}
+	if change == nil {
+		return ErrEmailChangeNotFound
+	}
 
-	return s.tokenService.RevokeAllUserTokens(ctx, userID)
+	if err := s.tokenService.RevokeAllUserTokens(ctx, change.UserID); err != nil {
+		return err
+	}
+	s.recordSecurityEvent(ctx, change.UserID, model.SecurityEventEmailRecoveryCancelled, "to "+change.NewEmail)
+	return nil
+}
+
+// ConfirmEmailChange switches the account to the verified new address and
+// signs out every session. The old address is told about the change.
+// Recovery changes can't be confirmed until their waiting period is over.
+// A link for the address the account already has just verifies it.
+func (s *AuthService) ConfirmEmailChange(ctx context.Context, token string) (*model.User, error) {
+	if s.emailChangeRepo == nil {
+		return nil, ErrEmailDeliveryUnavailable
+	}
+
+	tokenHash := hashToken(token)
+	pending, err := s.emailChangeRepo.GetByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil && pending.IsPending(s.clock.Now()) {
+		return nil, &RecoveryPendingError{EffectiveOn: *pending.EffectiveOn}
+	}
+
+	change, err := s.emailChangeRepo.ClaimByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return nil, ErrEmailChangeNotFound
+	}
+	if change.IsExpired(s.clock.Now()) {
+		return nil, ErrEmailChangeExpired
+	}
+
+	user, err := s.userRepo.GetByID(ctx, change.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	// The address may have been taken since the link was sent
+	existing, err := s.userRepo.GetByEmail(ctx, change.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.ID != user.ID {
+		return nil, ErrEmailAlreadyExists
+	}
+
+	if change.NewEmail == user.Email {
+		if err := s.userRepo.SetEmailVerified(ctx, user.ID, true); err != nil {
+			return nil, err
+		}
+		user.EmailVerified = true
+		return user, nil
+	}
+
+	oldEmail := user.Email
+	user.Email = change.NewEmail
+	user.EmailVerified = true
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.tokenService.RevokeAllUserTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	if s.mailer != nil {
+		body := fmt.Sprintf("The email on your Saga account was changed to %s and every device was signed out.\n\n"+
+			"If this wasn't you, contact support right away.\n", change.NewEmail)
+		if err := s.mailer.Send(ctx, oldEmail, "Your Saga email was changed", body); err != nil {
+			log.Printf("[AuthService] Failed to notify previous address of email change for %s: %v", user.ID, err)
+		}
+	}
+
+	eventType := model.SecurityEventEmailChanged
+	if change.Recovery {
+		eventType = model.SecurityEventEmailRecoveryCompleted
+	}
+	s.recordSecurityEvent(ctx, user.ID, eventType, fmt.Sprintf("from %s to %s", oldEmail, change.NewEmail))
+
+	return user, nil
+}
+
+// AcceptInvitation sets the password on an account an admin created and
+// signs the user in. The emailed token proves the user owns the address, so
+// the email is marked verified.
+func (s *AuthService) AcceptInvitation(ctx context.Context, token, password string) (*LoginResult, error) {
+	if s.invitationRepo == nil {
+		return nil, ErrInvitationNotFound
+	}
+
+	if err := validatePassword(password); err != nil {
+		return nil, err
+	}
+
+	tokenHash := hashToken(token)
+	invitation, err := s.invitationRepo.GetByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, ErrInvitationNotFound
+	}
+	if invitation.IsExpired(s.clock.Now()) {
+		return nil, ErrInvitationExpired
+	}
+
+	user, err := s.userRepo.GetByID(ctx, invitation.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := s.checkPasswordStrength(ctx, password, user.Email, derefString(user.Firstname), derefString(user.Lastname)); err != nil {
+		return nil, err
+	}
+
+	// Only the first request with the link gets to set the password
+	claimed, err := s.invitationRepo.ClaimByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if claimed == nil {
+		return nil, ErrInvitationNotFound
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, hash); err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.SetEmailVerified(ctx, user.ID, true); err != nil {
+		return nil, err
+	}
+	user.Hash = &hash
+	user.EmailVerified = true
+	s.recordSecurityEvent(ctx, user.ID, model.SecurityEventInvitationAccepted, "")
+
+	tokenPair, err := s.tokenService.GenerateTokenPair(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResult{
+		User:      user,
+		TokenPair: tokenPair,
+	}, nil
+}
+
+// checkNewEmail normalizes an address a user wants to move to and checks
+// it is valid, different and free
+func (s *AuthService) checkNewEmail(ctx context.Context, user *model.User, newEmail string) (string, error) {
+	email := strings.TrimSpace(strings.ToLower(newEmail))
+	if !isValidEmail(email) {
+		return "", ErrInvalidEmail
+	}
+	if email == user.Email {
+		return "", ErrEmailUnchanged
+	}
+	existing, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return "", ErrEmailAlreadyExists
+	}
+	return email, nil
+}
+
+func (s *AuthService) recordSecurityEvent(ctx context.Context, userID, eventType, detail string) {
+	if s.securityEvents != nil {
+		s.securityEvents.Record(ctx, userID, eventType, detail)
+	}
 }
 
 // Helper functions
@@ -338,6 +838,60 @@ func validatePassword(password string) error {
 	return nil
 }
 
+// checkPasswordStrength rejects passwords below the configured score or
+// found in a breach. userInputs are the user's own details, which make a
+// password easier to guess. The breach check fails open: if the service is
+// unreachable the password is judged on strength alone.
+func (s *AuthService) checkPasswordStrength(ctx context.Context, password string, userInputs ...string) error {
+	if s.minPasswordScore <= 0 && s.breachChecker == nil {
+		return nil
+	}
+
+	strength := estimatePasswordStrength(password, userInputs...)
+	if s.breachChecker != nil {
+		count, err := s.breachChecker.Count(ctx, password)
+		if err != nil {
+			log.Printf("[AuthService] Password breach check failed: %v", err)
+		} else if count > 0 {
+			strength.Breached = true
+			strength.BreachCount = count
+			strength.Warning = "This password has appeared in a data breach."
+			strength.Suggestions = append(strength.Suggestions, "Choose a password you haven't used anywhere else.")
+			return &WeakPasswordError{Strength: strength}
+		}
+	}
+
+	if strength.Score < s.minPasswordScore {
+		return &WeakPasswordError{Strength: strength}
+	}
+	return nil
+}
+
+// reauthenticate accepts the user's current password, or a sign-in within
+// model.ReauthWindow when no password is given
+func (s *AuthService) reauthenticate(user *model.User, password string, authTime time.Time) error {
+	if password != "" {
+		if user.Hash == nil || *user.Hash == "" || !checkPassword(password, *user.Hash) {
+			return ErrInvalidCredentials
+		}
+		return nil
+	}
+	if !authTime.IsZero() && s.clock.Now().Sub(authTime) <= model.ReauthWindow {
+		return nil
+	}
+	return ErrReauthRequired
+}
+
+// newSecretToken creates a random URL-safe token, for emailed links and
+// other one-time secrets
+func newSecretToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 func isValidEmail(email string) bool {
 	// Basic email validation
 	if email == "" {
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -593,10 +594,22 @@ func TestAuthService_ChangePassword_Success(t *testing.T) {
 	})
 
 	// Change password
-	err := authService.ChangePassword(ctx, regResult.User.ID, "oldpassword123", "newpassword456")
+	tokens, err := authService.ChangePassword(ctx, ChangePasswordRequest{
+		UserID:          regResult.User.ID,
+		CurrentPassword: "oldpassword123",
+		NewPassword:     "newpassword456",
+	})
 	if err != nil {
 		t.Fatalf("ChangePassword failed: %v", err)
 	}
+	if tokens == nil || tokens.AccessToken == "" {
+		t.Error("expected fresh tokens for the caller")
+	}
+
+	// Sessions from before the change are signed out
+	if _, err := authService.RefreshTokens(ctx, regResult.TokenPair.RefreshToken); err == nil {
+		t.Error("expected the old refresh token to be revoked")
+	}
 
 	// Old password should no longer work
 	_, err = authService.Login(ctx, LoginRequest{
@@ -628,7 +641,11 @@ func TestAuthService_ChangePassword_WrongOldPassword(t *testing.T) {
 	})
 
 	// Try to change with wrong old password
-	err := authService.ChangePassword(ctx, regResult.User.ID, "wrongoldpassword", "newpassword456")
+	_, err := authService.ChangePassword(ctx, ChangePasswordRequest{
+		UserID:          regResult.User.ID,
+		CurrentPassword: "wrongoldpassword",
+		NewPassword:     "newpassword456",
+	})
 	if !errors.Is(err, ErrInvalidCredentials) {
 		t.Errorf("expected ErrInvalidCredentials, got %v", err)
 	}
@@ -645,12 +662,528 @@ func TestAuthService_ChangePassword_InvalidNewPassword(t *testing.T) {
 	})
 
 	// Try to change to invalid password
-	err := authService.ChangePassword(ctx, regResult.User.ID, "oldpassword123", "short")
+	_, err := authService.ChangePassword(ctx, ChangePasswordRequest{
+		UserID:          regResult.User.ID,
+		CurrentPassword: "oldpassword123",
+		NewPassword:     "short",
+	})
 	if !errors.Is(err, ErrPasswordTooShort) {
 		t.Errorf("expected ErrPasswordTooShort, got %v", err)
 	}
 }
 
+type mockBreachChecker struct {
+	counts map[string]int
+	err    error
+}
+
+func (m *mockBreachChecker) Count(ctx context.Context, password string) (int, error) {
+	return m.counts[password], m.err
+}
+
+func TestAuthService_Register_PasswordStrength(t *testing.T) {
+	tests := []struct {
+		name         string
+		password     string
+		checker      *mockBreachChecker
+		wantErr      error
+		wantBreached bool
+	}{
+		{"guessable", "password123", nil, ErrPasswordTooWeak, false},
+		{"strong", "violet-harbor-lantern", nil, nil, false},
+		{"breached", "violet-harbor-lantern", &mockBreachChecker{counts: map[string]int{"violet-harbor-lantern": 3}}, ErrPasswordBreached, true},
+		{"breach check down", "violet-harbor-lantern", &mockBreachChecker{err: errors.New("timeout")}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authService, _, _, _, _ := setupAuthService(t)
+			authService.minPasswordScore = model.DefaultMinPasswordScore
+			if tt.checker != nil {
+				authService.breachChecker = tt.checker
+			}
+
+			_, err := authService.Register(context.Background(), RegisterRequest{
+				Email:    "test@example.com",
+				Password: tt.password,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil {
+				return
+			}
+
+			var weak *WeakPasswordError
+			if !errors.As(err, &weak) {
+				t.Fatalf("expected WeakPasswordError, got %T", err)
+			}
+			if weak.Strength.Breached != tt.wantBreached {
+				t.Errorf("expected breached %v, got %v", tt.wantBreached, weak.Strength.Breached)
+			}
+			if weak.Strength.Warning == "" || len(weak.Strength.Suggestions) == 0 {
+				t.Errorf("expected guidance, got %+v", weak.Strength)
+			}
+		})
+	}
+}
+
+func TestAuthService_ChangePassword_RejectsPersonalPassword(t *testing.T) {
+	authService, _, _, _, _ := setupAuthService(t)
+	ctx := context.Background()
+
+	regResult, _ := authService.Register(ctx, RegisterRequest{
+		Email:     "test@example.com",
+		Password:  "oldpassword123",
+		Firstname: "Marguerite",
+	})
+	authService.minPasswordScore = model.DefaultMinPasswordScore
+
+	_, err := authService.ChangePassword(ctx, ChangePasswordRequest{
+		UserID:          regResult.User.ID,
+		CurrentPassword: "oldpassword123",
+		NewPassword:     "Marguerite1987",
+	})
+	if !errors.Is(err, ErrPasswordTooWeak) {
+		t.Errorf("expected ErrPasswordTooWeak, got %v", err)
+	}
+}
+
+func TestAuthService_ChangePassword_RecentSignIn(t *testing.T) {
+	tests := []struct {
+		name     string
+		authTime time.Time
+		wantErr  error
+	}{
+		{"just signed in", time.Now().Add(-time.Minute), nil},
+		{"signed in long ago", time.Now().Add(-time.Hour), ErrReauthRequired},
+		{"sign-in time unknown", time.Time{}, ErrReauthRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authService, _, _, _, _ := setupAuthService(t)
+			regResult, _ := authService.Register(context.Background(), RegisterRequest{
+				Email:    "test@example.com",
+				Password: "oldpassword123",
+			})
+
+			_, err := authService.ChangePassword(context.Background(), ChangePasswordRequest{
+				UserID:      regResult.User.ID,
+				NewPassword: "newpassword456",
+				AuthTime:    tt.authTime,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_RefreshTokens_KeepsSignInTime(t *testing.T) {
+	authService, _, _, _, tokenRepo := setupAuthService(t)
+	ctx := context.Background()
+
+	regResult, _ := authService.Register(ctx, RegisterRequest{
+		Email:    "test@example.com",
+		Password: "password123",
+	})
+	signedIn := time.Now().Add(-time.Hour).Truncate(time.Second)
+	tokenRepo.tokens[hashToken(regResult.TokenPair.RefreshToken)].AuthTime = signedIn
+
+	refreshed, err := authService.RefreshTokens(ctx, regResult.TokenPair.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshTokens failed: %v", err)
+	}
+	claims, err := authService.tokenService.ValidateAccessToken(refreshed.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateAccessToken failed: %v", err)
+	}
+	if claims.AuthTime != signedIn.Unix() {
+		t.Errorf("expected auth_time %d, got %d", signedIn.Unix(), claims.AuthTime)
+	}
+}
+
+// ============================================================================
+// Email Change Tests
+// ============================================================================
+
+type mockEmailChangeRepo struct {
+	changes map[string]*model.EmailChange // By token hash
+}
+
+func (m *mockEmailChangeRepo) Create(ctx context.Context, change *model.EmailChange) error {
+	_ = m.DeleteByUser(ctx, change.UserID)
+	change.ID = "email_change:" + change.TokenHash[:8]
+	m.changes[change.TokenHash] = change
+	return nil
+}
+
+func (m *mockEmailChangeRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChange, error) {
+	return m.changes[tokenHash], nil
+}
+
+func (m *mockEmailChangeRepo) ClaimByCancelTokenHash(ctx context.Context, cancelTokenHash string) (*model.EmailChange, error) {
+	for hash, c := range m.changes {
+		if c.CancelTokenHash != "" && c.CancelTokenHash == cancelTokenHash {
+			delete(m.changes, hash)
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockEmailChangeRepo) ClaimByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChange, error) {
+	change := m.changes[tokenHash]
+	delete(m.changes, tokenHash)
+	return change, nil
+}
+
+func (m *mockEmailChangeRepo) DeleteByUser(ctx context.Context, userID string) error {
+	for hash, c := range m.changes {
+		if c.UserID == userID {
+			delete(m.changes, hash)
+		}
+	}
+	return nil
+}
+
+type sentEmail struct {
+	to, subject, body string
+}
+
+type mockEmailSender struct {
+	sent []sentEmail
+}
+
+func (m *mockEmailSender) Send(ctx context.Context, to, subject, body string) error {
+	m.sent = append(m.sent, sentEmail{to, subject, body})
+	return nil
+}
+
+// setupEmailChange registers a user on an auth service that can send email
+func setupEmailChange(t *testing.T) (*AuthService, *RegisterResult, *mockEmailChangeRepo, *mockEmailSender) {
+	t.Helper()
+	authService, _, _, _, _ := setupAuthService(t)
+	repo := &mockEmailChangeRepo{changes: make(map[string]*model.EmailChange)}
+	sender := &mockEmailSender{}
+	authService.emailChangeRepo = repo
+	authService.mailer = sender
+	authService.emailVerifyURL = "https://saga.test" + model.EmailVerifyPath
+	authService.recoveryURL = "https://saga.test" + model.RecoveryCancelPath
+
+	regResult, err := authService.Register(context.Background(), RegisterRequest{
+		Email:    "old@example.com",
+		Password: "password123",
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	return authService, regResult, repo, sender
+}
+
+// emailedToken pulls the verification token out of the emailed link
+func emailedToken(t *testing.T, body string) string {
+	t.Helper()
+	_, after, ok := strings.Cut(body, "?token=")
+	if !ok {
+		t.Fatalf("expected a verification link in %q", body)
+	}
+	token, _, _ := strings.Cut(after, "\n")
+	return token
+}
+
+func TestAuthService_EmailChange(t *testing.T) {
+	authService, regResult, _, sender := setupEmailChange(t)
+	ctx := context.Background()
+
+	change, err := authService.RequestEmailChange(ctx, EmailChangeRequest{
+		UserID:          regResult.User.ID,
+		NewEmail:        " New@Example.com ",
+		CurrentPassword: "password123",
+	})
+	if err != nil {
+		t.Fatalf("RequestEmailChange failed: %v", err)
+	}
+	if change.NewEmail != "new@example.com" {
+		t.Errorf("expected normalized email, got %s", change.NewEmail)
+	}
+	if len(sender.sent) != 1 || sender.sent[0].to != "new@example.com" {
+		t.Fatalf("expected a verification email to the new address, got %+v", sender.sent)
+	}
+	if !strings.Contains(sender.sent[0].body, "https://saga.test/verify-email?token=") {
+		t.Errorf("expected a verification link, got %q", sender.sent[0].body)
+	}
+
+	// Nothing changes until the link is opened
+	user, _ := authService.GetUserByID(ctx, regResult.User.ID)
+	if user.Email != "old@example.com" {
+		t.Fatalf("expected email to stay old@example.com, got %s", user.Email)
+	}
+
+	token := emailedToken(t, sender.sent[0].body)
+	user, err = authService.ConfirmEmailChange(ctx, token)
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange failed: %v", err)
+	}
+	if user.Email != "new@example.com" || !user.EmailVerified {
+		t.Errorf("expected verified new@example.com, got %s (verified %v)", user.Email, user.EmailVerified)
+	}
+	if _, err := authService.RefreshTokens(ctx, regResult.TokenPair.RefreshToken); err == nil {
+		t.Error("expected existing sessions to be signed out")
+	}
+	if len(sender.sent) != 2 || sender.sent[1].to != "old@example.com" {
+		t.Errorf("expected a notice to the old address, got %+v", sender.sent)
+	}
+
+	// Links work once
+	if _, err := authService.ConfirmEmailChange(ctx, token); !errors.Is(err, ErrEmailChangeNotFound) {
+		t.Errorf("expected ErrEmailChangeNotFound on reuse, got %v", err)
+	}
+}
+
+type fixedSignupRisk struct {
+	risk     *model.SignupRisk
+	recorded map[string]*model.SignupRisk
+}
+
+func (f *fixedSignupRisk) Assess(ctx context.Context, email, ip, firstname, lastname string) *model.SignupRisk {
+	risk := *f.risk
+	risk.IP = ip
+	return &risk
+}
+
+func (f *fixedSignupRisk) Record(ctx context.Context, userID string, risk *model.SignupRisk) {
+	f.recorded[userID] = risk
+}
+
+func TestAuthService_Register_RiskySignupVerifiesEmail(t *testing.T) {
+	authService, _, _, _, _ := setupAuthService(t)
+	sender := &mockEmailSender{}
+	authService.emailChangeRepo = &mockEmailChangeRepo{changes: make(map[string]*model.EmailChange)}
+	authService.mailer = sender
+	authService.emailVerifyURL = "https://saga.test" + model.EmailVerifyPath
+	risk := &model.SignupRisk{}
+	risk.AddReason(model.SignupRiskDisposableEmail)
+	scorer := &fixedSignupRisk{risk: risk, recorded: map[string]*model.SignupRisk{}}
+	authService.signupRisk = scorer
+	ctx := context.Background()
+
+	result, err := authService.Register(ctx, RegisterRequest{
+		Email:    "ada@mailinator.com",
+		Password: "password123",
+		IP:       "203.0.113.7",
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if !result.VerificationRequired {
+		t.Error("expected verification to be required")
+	}
+	if recorded := scorer.recorded[result.User.ID]; recorded == nil || recorded.IP != "203.0.113.7" {
+		t.Errorf("expected the risk recorded with the IP, got %+v", recorded)
+	}
+	if len(sender.sent) != 1 || sender.sent[0].to != "ada@mailinator.com" {
+		t.Fatalf("expected a verification email to the new account, got %+v", sender.sent)
+	}
+
+	// The link verifies the current address without signing anyone out
+	user, err := authService.ConfirmEmailChange(ctx, emailedToken(t, sender.sent[0].body))
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange failed: %v", err)
+	}
+	if user.Email != "ada@mailinator.com" || !user.EmailVerified {
+		t.Errorf("expected verified ada@mailinator.com, got %s (verified %v)", user.Email, user.EmailVerified)
+	}
+	if _, err := authService.RefreshTokens(ctx, result.TokenPair.RefreshToken); err != nil {
+		t.Errorf("expected the session to stay signed in, got %v", err)
+	}
+	if len(sender.sent) != 1 {
+		t.Errorf("expected no change notice, got %+v", sender.sent)
+	}
+
+	if _, err := authService.SendEmailVerification(ctx, user.ID); !errors.Is(err, ErrEmailAlreadyVerified) {
+		t.Errorf("expected ErrEmailAlreadyVerified, got %v", err)
+	}
+}
+
+func TestAuthService_RequestEmailChange_Rejections(t *testing.T) {
+	tests := []struct {
+		name     string
+		newEmail string
+		password string
+		wantErr  error
+	}{
+		{"wrong password", "new@example.com", "wrongpassword", ErrInvalidCredentials},
+		{"no re-authentication", "new@example.com", "", ErrReauthRequired},
+		{"invalid email", "not-an-email", "password123", ErrInvalidEmail},
+		{"same email", "OLD@example.com", "password123", ErrEmailUnchanged},
+		{"taken email", "taken@example.com", "password123", ErrEmailAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authService, regResult, _, sender := setupEmailChange(t)
+			_, _ = authService.Register(context.Background(), RegisterRequest{
+				Email:    "taken@example.com",
+				Password: "password123",
+			})
+
+			_, err := authService.RequestEmailChange(context.Background(), EmailChangeRequest{
+				UserID:          regResult.User.ID,
+				NewEmail:        tt.newEmail,
+				CurrentPassword: tt.password,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if len(sender.sent) != 0 {
+				t.Errorf("expected no email, got %+v", sender.sent)
+			}
+		})
+	}
+}
+
+func TestAuthService_RequestEmailChange_WithoutMail(t *testing.T) {
+	authService, _, _, _, _ := setupAuthService(t)
+
+	_, err := authService.RequestEmailChange(context.Background(), EmailChangeRequest{
+		UserID:   "user:1",
+		NewEmail: "new@example.com",
+	})
+	if !errors.Is(err, ErrEmailDeliveryUnavailable) {
+		t.Errorf("expected ErrEmailDeliveryUnavailable, got %v", err)
+	}
+}
+
+func TestAuthService_ConfirmEmailChange_Expired(t *testing.T) {
+	authService, regResult, repo, sender := setupEmailChange(t)
+	ctx := context.Background()
+
+	_, err := authService.RequestEmailChange(ctx, EmailChangeRequest{
+		UserID:          regResult.User.ID,
+		NewEmail:        "new@example.com",
+		CurrentPassword: "password123",
+	})
+	if err != nil {
+		t.Fatalf("RequestEmailChange failed: %v", err)
+	}
+	token := emailedToken(t, sender.sent[0].body)
+	repo.changes[hashToken(token)].ExpiresOn = time.Now().Add(-time.Minute)
+
+	if _, err := authService.ConfirmEmailChange(ctx, token); !errors.Is(err, ErrEmailChangeExpired) {
+		t.Errorf("expected ErrEmailChangeExpired, got %v", err)
+	}
+}
+
+func TestAuthService_EmailRecovery(t *testing.T) {
+	authService, regResult, repo, sender := setupEmailChange(t)
+	events := &mockSecurityEventRepo{}
+	authService.securityEvents = NewSecurityEventService(SecurityEventServiceConfig{Repo: events})
+	ctx := context.Background()
+
+	change, err := authService.StartEmailRecovery(ctx, EmailRecoveryRequest{
+		UserID:    regResult.User.ID,
+		NewEmail:  "new@example.com",
+		PasskeyID: "passkey:1",
+	})
+	if err != nil {
+		t.Fatalf("StartEmailRecovery failed: %v", err)
+	}
+	if !change.Recovery || change.EffectiveOn == nil {
+		t.Fatalf("expected a delayed recovery change, got %+v", change)
+	}
+	if wait := time.Until(*change.EffectiveOn); wait < model.RecoveryDelay-time.Minute {
+		t.Errorf("expected the change to wait %v, got %v", model.RecoveryDelay, wait)
+	}
+
+	// The old address hears first, with a way to cancel
+	if len(sender.sent) != 2 || sender.sent[0].to != "old@example.com" || sender.sent[1].to != "new@example.com" {
+		t.Fatalf("expected a notice to the old address and a link to the new one, got %+v", sender.sent)
+	}
+	if !strings.Contains(sender.sent[0].body, "https://saga.test/cancel-recovery?token=") {
+		t.Errorf("expected a cancel link, got %q", sender.sent[0].body)
+	}
+
+	// The link doesn't work during the waiting period, and isn't used up
+	token := emailedToken(t, sender.sent[1].body)
+	_, err = authService.ConfirmEmailChange(ctx, token)
+	var pending *RecoveryPendingError
+	if !errors.As(err, &pending) || !errors.Is(err, ErrEmailRecoveryPending) {
+		t.Fatalf("expected RecoveryPendingError, got %v", err)
+	}
+	if !pending.EffectiveOn.Equal(*change.EffectiveOn) {
+		t.Errorf("expected effective time %v, got %v", *change.EffectiveOn, pending.EffectiveOn)
+	}
+
+	past := time.Now().Add(-time.Minute)
+	repo.changes[hashToken(token)].EffectiveOn = &past
+	user, err := authService.ConfirmEmailChange(ctx, token)
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange failed: %v", err)
+	}
+	if user.Email != "new@example.com" {
+		t.Errorf("expected new@example.com, got %s", user.Email)
+	}
+
+	if len(events.events) != 2 ||
+		events.events[0].Type != model.SecurityEventEmailRecoveryRequested ||
+		events.events[1].Type != model.SecurityEventEmailRecoveryCompleted {
+		t.Errorf("expected requested and completed events, got %+v", events.events)
+	}
+}
+
+func TestAuthService_CancelEmailRecovery(t *testing.T) {
+	authService, regResult, _, sender := setupEmailChange(t)
+	events := &mockSecurityEventRepo{}
+	authService.securityEvents = NewSecurityEventService(SecurityEventServiceConfig{Repo: events})
+	ctx := context.Background()
+
+	_, err := authService.StartEmailRecovery(ctx, EmailRecoveryRequest{
+		UserID:   regResult.User.ID,
+		NewEmail: "new@example.com",
+	})
+	if err != nil {
+		t.Fatalf("StartEmailRecovery failed: %v", err)
+	}
+	cancelToken := emailedToken(t, sender.sent[0].body)
+	token := emailedToken(t, sender.sent[1].body)
+
+	// Only the token sent to the old address cancels
+	if err := authService.CancelEmailRecovery(ctx, token); !errors.Is(err, ErrEmailChangeNotFound) {
+		t.Errorf("expected ErrEmailChangeNotFound for the verification token, got %v", err)
+	}
+	if err := authService.CancelEmailRecovery(ctx, cancelToken); err != nil {
+		t.Fatalf("CancelEmailRecovery failed: %v", err)
+	}
+
+	if _, err := authService.ConfirmEmailChange(ctx, token); !errors.Is(err, ErrEmailChangeNotFound) {
+		t.Errorf("expected ErrEmailChangeNotFound after cancelling, got %v", err)
+	}
+	if _, err := authService.RefreshTokens(ctx, regResult.TokenPair.RefreshToken); err == nil {
+		t.Error("expected existing sessions to be signed out")
+	}
+	last := events.events[len(events.events)-1]
+	if last.Type != model.SecurityEventEmailRecoveryCancelled {
+		t.Errorf("expected a cancelled event, got %+v", last)
+	}
+}
+
+func TestAuthService_StartEmailRecovery_Rejections(t *testing.T) {
+	authService, regResult, repo, sender := setupEmailChange(t)
+
+	_, err := authService.StartEmailRecovery(context.Background(), EmailRecoveryRequest{
+		UserID:   regResult.User.ID,
+		NewEmail: "OLD@example.com",
+	})
+	if !errors.Is(err, ErrEmailUnchanged) {
+		t.Errorf("expected ErrEmailUnchanged, got %v", err)
+	}
+	if len(sender.sent) != 0 || len(repo.changes) != 0 {
+		t.Errorf("expected nothing stored or sent, got %+v", sender.sent)
+	}
+}
+
 func TestAuthService_Logout(t *testing.T) {
 	authService, _, _, _, tokenRepo := setupAuthService(t)
 	ctx := context.Background()
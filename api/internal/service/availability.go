@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"sort"
 	"time"
 
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/model"
 )
 
@@ -18,6 +20,7 @@ type AvailabilityRepository interface {
 	GetByHangoutType(ctx context.Context, hangoutType string, excludeUserID string, limit int) ([]*model.Availability, error)
 	Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Availability, error)
 	Delete(ctx context.Context, id string) error
+	MarkPendingDelete(ctx context.Context, id string, until time.Time) error
 	CreateHangoutRequest(ctx context.Context, req *model.HangoutRequest) error
 	GetHangoutRequest(ctx context.Context, id string) (*model.HangoutRequest, error)
 	GetPendingRequests(ctx context.Context, availabilityID string) ([]*model.HangoutRequest, error)
@@ -32,32 +35,78 @@ type AvailabilityRepository interface {
 	GetAllPendingRequests(ctx context.Context) ([]*model.HangoutRequest, error)
 	GetPendingRequestsForUser(ctx context.Context, userID string) ([]*model.HangoutRequest, error)
 	GetUserUpcomingHangouts(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]*model.Hangout, error)
+	// Guild-scoped availability
+	GetByGuild(ctx context.Context, guildID string, limit int) ([]*model.Availability, error)
+	// Guild heatmap
+	GetByGuildInRange(ctx context.Context, guildID string, since, until time.Time) ([]*model.Availability, error)
+}
+
+// AvailabilityGuildRepository provides the guild membership check for the
+// availability heatmap and guild-scoped availability
+type AvailabilityGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+}
+
+// AvailabilityEventRepository provides a guild's scheduled events, which count
+// as conflicts when suggesting event times
+type AvailabilityEventRepository interface {
+	GetByGuild(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error)
 }
 
 // AvailabilityService handles availability business logic
 type AvailabilityService struct {
 	repo       AvailabilityRepository
+	undo       UndoRecorder
+	analytics  AnalyticsEmitter
+	guilds     AvailabilityGuildRepository
+	events     AvailabilityEventRepository
+	conflicts  ConflictChecker
+	types      HangoutTypeCatalog
 	geoService *GeoService
+	clock      clock.Clock
 }
 
 // AvailabilityServiceConfig holds configuration for the availability service
 type AvailabilityServiceConfig struct {
-	Repo AvailabilityRepository
+	Repo      AvailabilityRepository
+	Undo      UndoRecorder     // Optional; deletions are final when nil
+	Analytics AnalyticsEmitter // Optional
+	Guilds    AvailabilityGuildRepository
+	Events    AvailabilityEventRepository
+	Conflicts ConflictChecker // Optional; accepting a hangout skips the conflict check when nil
+	// Optional; only the built-in hangout types are accepted when nil
+	HangoutTypes HangoutTypeCatalog
+	Clock        clock.Clock // Default: the system clock
 }
 
 // NewAvailabilityService creates a new availability service
 func NewAvailabilityService(cfg AvailabilityServiceConfig) *AvailabilityService {
 	return &AvailabilityService{
 		repo:       cfg.Repo,
+		undo:       cfg.Undo,
+		analytics:  cfg.Analytics,
+		guilds:     cfg.Guilds,
+		events:     cfg.Events,
+		conflicts:  cfg.Conflicts,
+		types:      cfg.HangoutTypes,
 		geoService: NewGeoService(),
+		clock:      clock.OrReal(cfg.Clock),
 	}
 }
 
-// CreateAvailability creates a new availability window
+// CreateAvailability creates a new availability window. Availability scoped
+// to a guild is only shown to its members and may use the guild's custom
+// hangout types.
 func (s *AvailabilityService) CreateAvailability(ctx context.Context, userID string, req *model.CreateAvailabilityRequest) (*model.Availability, error) {
+	if req.GuildID != nil {
+		if err := s.requireGuildMember(ctx, userID, *req.GuildID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate hangout type
-	if !isValidHangoutType(req.HangoutType) {
-		return nil, ErrInvalidHangoutType
+	if err := resolveHangoutType(ctx, s.types, req.HangoutType, req.GuildID); err != nil {
+		return nil, err
 	}
 
 	// Parse times
@@ -92,6 +141,7 @@ func (s *AvailabilityService) CreateAvailability(ctx context.Context, userID str
 
 	av := &model.Availability{
 		UserID:              userID,
+		GuildID:             req.GuildID,
 		Status:              model.AvailabilityStatusAvailable,
 		StartTime:           startTime,
 		EndTime:             endTime,
@@ -150,8 +200,8 @@ func (s *AvailabilityService) FindNearbyAvailabilities(ctx context.Context, user
 
 // FindByHangoutType finds availabilities by type
 func (s *AvailabilityService) FindByHangoutType(ctx context.Context, userID string, hangoutType string, limit int) ([]*model.Availability, error) {
-	if !isValidHangoutType(hangoutType) {
-		return nil, ErrInvalidHangoutType
+	if err := resolveHangoutType(ctx, s.types, hangoutType, nil); err != nil {
+		return nil, err
 	}
 
 	if limit <= 0 || limit > 50 {
@@ -161,6 +211,25 @@ func (s *AvailabilityService) FindByHangoutType(ctx context.Context, userID stri
 	return s.repo.GetByHangoutType(ctx, hangoutType, userID, limit)
 }
 
+// GetGuildAvailability lists the availability scoped to a guild (members
+// only)
+func (s *AvailabilityService) GetGuildAvailability(ctx context.Context, userID, guildID string, limit int) ([]*model.Availability, error) {
+	if err := s.requireGuildMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > 50 {
+		limit = 20
+	}
+
+	return s.repo.GetByGuild(ctx, guildID, limit)
+}
+
+// ListHangoutTypes returns the hangout types everyone can use
+func (s *AvailabilityService) ListHangoutTypes(ctx context.Context) ([]*model.HangoutTypeInfo, error) {
+	return listHangoutTypes(ctx, s.types)
+}
+
 // UpdateAvailability updates an availability
 func (s *AvailabilityService) UpdateAvailability(ctx context.Context, userID, id string, req *model.UpdateAvailabilityRequest) (*model.Availability, error) {
 	// Verify ownership
@@ -207,21 +276,35 @@ func (s *AvailabilityService) UpdateAvailability(ctx context.Context, userID, id
 	return s.repo.Update(ctx, id, updates)
 }
 
-// DeleteAvailability deletes an availability
-func (s *AvailabilityService) DeleteAvailability(ctx context.Context, userID, id string) error {
+// DeleteAvailability deletes an availability. When the deletion can be
+// undone the availability is only marked pending-delete, and the returned
+// operation undoes it.
+func (s *AvailabilityService) DeleteAvailability(ctx context.Context, userID, id string) (*model.UndoOperation, error) {
 	// Verify ownership
 	av, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if av == nil {
-		return ErrAvailabilityNotFound
+		return nil, ErrAvailabilityNotFound
 	}
 	if av.UserID != userID {
-		return ErrAvailabilityNotFound
+		return nil, ErrAvailabilityNotFound
+	}
+
+	op := recordUndo(ctx, s.undo, &model.UndoOperation{
+		UserID:     userID,
+		Type:       model.UndoAvailabilityDelete,
+		ResourceID: id,
+	})
+	if op == nil {
+		return nil, s.repo.Delete(ctx, id)
 	}
 
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.MarkPendingDelete(ctx, id, op.ExpiresOn); err != nil {
+		return nil, err
+	}
+	return op, nil
 }
 
 // RequestHangout creates a request to join someone's availability
@@ -240,6 +323,13 @@ func (s *AvailabilityService) RequestHangout(ctx context.Context, requesterID, a
 		return nil, ErrCannotRequestOwn
 	}
 
+	// Guild-scoped availability is for that guild's members
+	if av.GuildID != nil {
+		if err := s.requireGuildMember(ctx, requesterID, *av.GuildID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate note length (minimum 20 chars to prevent shallow interactions)
 	if len(note) < model.MinHangoutNoteLength {
 		return nil, ErrNoteTooShort
@@ -276,8 +366,10 @@ func (s *AvailabilityService) GetPendingRequests(ctx context.Context, userID, av
 	return s.repo.GetPendingRequests(ctx, availabilityID)
 }
 
-// RespondToRequest accepts or declines a hangout request
-func (s *AvailabilityService) RespondToRequest(ctx context.Context, userID, requestID string, accept bool) (*model.Hangout, error) {
+// RespondToRequest accepts or declines a hangout request. Accepting fails with
+// a ScheduleConflictError when the hangout clashes with the user's other
+// commitments, unless force is set.
+func (s *AvailabilityService) RespondToRequest(ctx context.Context, userID, requestID string, accept, force bool) (*model.Hangout, error) {
 	// Get the request
 	req, err := s.repo.GetHangoutRequest(ctx, requestID)
 	if err != nil {
@@ -297,6 +389,18 @@ func (s *AvailabilityService) RespondToRequest(ctx context.Context, userID, requ
 	}
 
 	if accept {
+		end := av.StartTime.Add(model.DefaultHangoutLength)
+		if av.EndTime.Before(end) {
+			end = av.EndTime
+		}
+		if err := checkConflicts(ctx, s.conflicts, model.CommitmentCheck{
+			UserID: userID,
+			Start:  av.StartTime,
+			End:    end,
+		}, force); err != nil {
+			return nil, err
+		}
+
 		// Update request status
 		if err := s.repo.UpdateHangoutRequestStatus(ctx, requestID, model.HangoutRequestStatusAccepted); err != nil {
 			return nil, err
@@ -327,6 +431,7 @@ func (s *AvailabilityService) RespondToRequest(ctx context.Context, userID, requ
 			return nil, err
 		}
 
+		s.emitHangoutEvent(ctx, model.AnalyticsHangoutScheduled, hangout)
 		return hangout, nil
 	}
 
@@ -369,20 +474,236 @@ func (s *AvailabilityService) UpdateHangoutStatus(ctx context.Context, userID, h
 		return ErrHangoutNotFound
 	}
 
-	return s.repo.UpdateHangoutStatus(ctx, hangoutID, status)
+	if err := s.repo.UpdateHangoutStatus(ctx, hangoutID, status); err != nil {
+		return err
+	}
+
+	if status == model.HangoutStatusCompleted && hangout.Status != model.HangoutStatusCompleted {
+		s.emitHangoutEvent(ctx, model.AnalyticsHangoutCompleted, hangout)
+	}
+	return nil
+}
+
+// emitHangoutEvent records an analytics event for each participant
+func (s *AvailabilityService) emitHangoutEvent(ctx context.Context, name model.AnalyticsEventName, hangout *model.Hangout) {
+	for _, participant := range hangout.Participants {
+		emitAnalytics(ctx, s.analytics, name, participant, map[string]interface{}{
+			"hangout_type":    string(hangout.HangoutType),
+			"support_session": hangout.IsSupportSession,
+		})
+	}
+}
+
+// GuildHeatmap aggregates the availability guild members posted over the last
+// weeks into hour-of-week buckets in loc. Only members can view it, and only
+// counts of distinct members are returned.
+func (s *AvailabilityService) GuildHeatmap(ctx context.Context, userID, guildID string, weeks int, loc *time.Location) (*model.AvailabilityHeatmap, error) {
+	if err := s.requireGuildMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	if weeks <= 0 {
+		weeks = model.DefaultAvailabilityHeatmapWeeks
+	}
+	if weeks > model.MaxAvailabilityHeatmapWeeks {
+		weeks = model.MaxAvailabilityHeatmapWeeks
+	}
+	return s.guildHeatmap(ctx, guildID, weeks, loc)
 }
 
-// Helper functions
+// SuggestEventTimes ranks hour-aligned slots within the requested ranges by
+// how many members are usually free then, discounted for guild events already
+// scheduled over them. Slots that have already started are skipped.
+func (s *AvailabilityService) SuggestEventTimes(ctx context.Context, userID, guildID string, req *model.SuggestEventTimesRequest) ([]*model.SuggestedEventTime, error) {
+	if err := s.requireGuildMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+	if req.Timezone != "" {
+		l, err := time.LoadLocation(req.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		loc = l
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = model.DefaultSuggestedTimes
+	}
+
+	heatmap, err := s.guildHeatmap(ctx, guildID, model.DefaultAvailabilityHeatmapWeeks, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	earliest, latest := req.Ranges[0].Start, req.Ranges[0].End
+	for _, rg := range req.Ranges[1:] {
+		if rg.Start.Before(earliest) {
+			earliest = rg.Start
+		}
+		if rg.End.After(latest) {
+			latest = rg.End
+		}
+	}
+	// Events from the day before may still be running into the first range
+	startAfter := earliest.AddDate(0, 0, -1)
+	events, err := s.events.GetByGuild(ctx, guildID, &model.EventSearchFilters{
+		StartAfter:  &startAfter,
+		StartBefore: &latest,
+	}, model.ListQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	now := s.clock.Now()
+	seen := map[int64]bool{}
+	var suggestions []*model.SuggestedEventTime
+	for _, rg := range req.Ranges {
+		for start := nextLocalHour(rg.Start, loc); !start.Add(duration).After(rg.End); start = start.Add(time.Hour) {
+			if start.Before(now) || seen[start.Unix()] {
+				continue
+			}
+			seen[start.Unix()] = true
+			suggestions = append(suggestions, scoreEventTime(heatmap, events, start, start.Add(duration), loc))
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.ExpectedAttendance != b.ExpectedAttendance {
+			return a.ExpectedAttendance > b.ExpectedAttendance
+		}
+		if len(a.ConflictingEventIDs) != len(b.ConflictingEventIDs) {
+			return len(a.ConflictingEventIDs) < len(b.ConflictingEventIDs)
+		}
+		return a.Start.Before(b.Start)
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+func (s *AvailabilityService) requireGuildMember(ctx context.Context, userID, guildID string) error {
+	isMember, err := s.guilds.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotGuildMember
+	}
+	return nil
+}
+
+// guildHeatmap builds the heatmap over the last weeks in loc
+func (s *AvailabilityService) guildHeatmap(ctx context.Context, guildID string, weeks int, loc *time.Location) (*model.AvailabilityHeatmap, error) {
+
+	until := s.clock.Now().UTC()
+	since := until.AddDate(0, 0, -7*weeks)
+	windows, err := s.repo.GetByGuildInRange(ctx, guildID, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	heatmap := buildAvailabilityHeatmap(windows, since, until, loc)
+	heatmap.GuildID = guildID
+	return heatmap, nil
+}
+
+// buildAvailabilityHeatmap counts, for each hour of the week in loc, the
+// distinct users whose windows covered it, then zeroes sparse buckets
+func buildAvailabilityHeatmap(windows []*model.Availability, since, until time.Time, loc *time.Location) *model.AvailabilityHeatmap {
+	covered := map[string]*[7][24]bool{}
+	for _, av := range windows {
+		start, end := av.StartTime, av.EndTime
+		if start.Before(since) {
+			start = since
+		}
+		if end.After(until) {
+			end = until
+		}
+
+		slots := covered[av.UserID]
+		if slots == nil {
+			slots = &[7][24]bool{}
+			covered[av.UserID] = slots
+		}
+
+		// Walk the window an hour at a time from the top of its first local
+		// hour; a week of steps covers every bucket
+		local := start.In(loc)
+		slot := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, loc)
+		for i := 0; i < 7*24 && slot.Before(end); i++ {
+			slots[slot.Weekday()][slot.Hour()] = true
+			slot = slot.Add(time.Hour)
+		}
+	}
+
+	heatmap := &model.AvailabilityHeatmap{
+		Timezone:     loc.String(),
+		Since:        since,
+		Until:        until,
+		Contributors: len(covered),
+		MinMembers:   model.AvailabilityHeatmapMinMembers,
+	}
+	for _, slots := range covered {
+		for day := range slots {
+			for hour, free := range slots[day] {
+				if free {
+					heatmap.Buckets[day][hour]++
+				}
+			}
+		}
+	}
+	for day := range heatmap.Buckets {
+		for hour, count := range heatmap.Buckets[day] {
+			if count < model.AvailabilityHeatmapMinMembers {
+				heatmap.Buckets[day][hour] = 0
+			}
+		}
+	}
+	return heatmap
+}
+
+// scoreEventTime rates a candidate slot. Members available is the lowest
+// heatmap bucket the slot touches, since attendees need to be free for all of
+// it; each overlapping guild event halves the expected attendance.
+func scoreEventTime(heatmap *model.AvailabilityHeatmap, events []*model.Event, start, end time.Time, loc *time.Location) *model.SuggestedEventTime {
+	available := -1
+	for hour := start; hour.Before(end); hour = hour.Add(time.Hour) {
+		local := hour.In(loc)
+		if count := heatmap.Buckets[local.Weekday()][local.Hour()]; available < 0 || count < available {
+			available = count
+		}
+	}
+
+	suggestion := &model.SuggestedEventTime{
+		Start:            start,
+		End:              end,
+		AvailableMembers: available,
+	}
+	for _, event := range events {
+		if event.StartTime.Before(end) && event.EndOrDefault().After(start) {
+			suggestion.ConflictingEventIDs = append(suggestion.ConflictingEventIDs, event.ID)
+		}
+	}
+
+	suggestion.ExpectedAttendance = float64(available)
+	for range suggestion.ConflictingEventIDs {
+		suggestion.ExpectedAttendance /= 2
+	}
+	return suggestion
+}
 
-func isValidHangoutType(t string) bool {
-	switch model.HangoutType(t) {
-	case model.HangoutTypeTalkItOut,
-		model.HangoutTypeHereToListen,
-		model.HangoutTypeConcreteActivity,
-		model.HangoutTypeMutualInterest,
-		model.HangoutTypeMeetAnyone:
-		return true
-	default:
-		return false
+// nextLocalHour returns t if it falls on the hour in loc, otherwise the next
+// hour boundary, in UTC
+func nextLocalHour(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	hour := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, loc)
+	if hour.Before(t) {
+		hour = hour.Add(time.Hour)
 	}
+	return hour.UTC()
 }
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+type mockAvailabilityGuildRepo struct {
+	members map[string]bool
+}
+
+func (m *mockAvailabilityGuildRepo) IsMember(ctx context.Context, userID, guildID string) (bool, error) {
+	return m.members[userID], nil
+}
+
+// window builds an availability for userID from start lasting hours
+func window(userID string, start time.Time, hours int) *model.Availability {
+	return &model.Availability{UserID: userID, StartTime: start, EndTime: start.Add(time.Duration(hours) * time.Hour)}
+}
+
+// ============================================================================
+// Guild Heatmap Tests
+// ============================================================================
+
+func TestGuildHeatmap_RequiresMembership(t *testing.T) {
+	t.Parallel()
+	svc := NewAvailabilityService(AvailabilityServiceConfig{
+		Guilds: &mockAvailabilityGuildRepo{members: map[string]bool{}},
+	})
+
+	_, err := svc.GuildHeatmap(context.Background(), "user:outsider", "guild:1", 0, time.UTC)
+	if !errors.Is(err, ErrNotGuildMember) {
+		t.Errorf("expected ErrNotGuildMember, got %v", err)
+	}
+}
+
+func TestBuildAvailabilityHeatmap_CountsDistinctMembers(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC) // A Sunday
+	until := since.AddDate(0, 0, 28)
+	monday18 := time.Date(2026, 2, 2, 18, 0, 0, 0, time.UTC)
+
+	var windows []*model.Availability
+	for i := 0; i < 3; i++ {
+		windows = append(windows, window(fmt.Sprintf("user:%d", i), monday18, 2))
+	}
+	// The same member free on the same evening a week later still counts once
+	windows = append(windows, window("user:0", monday18.AddDate(0, 0, 7), 1))
+
+	heatmap := buildAvailabilityHeatmap(windows, since, until, time.UTC)
+
+	if heatmap.Contributors != 3 {
+		t.Errorf("expected 3 contributors, got %d", heatmap.Contributors)
+	}
+	if got := heatmap.Buckets[time.Monday][18]; got != 3 {
+		t.Errorf("expected 3 members free Monday 18:00, got %d", got)
+	}
+	if got := heatmap.Buckets[time.Monday][19]; got != 3 {
+		t.Errorf("expected 3 members free Monday 19:00, got %d", got)
+	}
+	if got := heatmap.Buckets[time.Monday][20]; got != 0 {
+		t.Errorf("expected no one free Monday 20:00, got %d", got)
+	}
+}
+
+func TestBuildAvailabilityHeatmap_HidesSparseBuckets(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 0, 28)
+	tuesday9 := time.Date(2026, 2, 3, 9, 0, 0, 0, time.UTC)
+
+	windows := []*model.Availability{
+		window("user:1", tuesday9, 1),
+		window("user:2", tuesday9, 1),
+	}
+
+	heatmap := buildAvailabilityHeatmap(windows, since, until, time.UTC)
+	if got := heatmap.Buckets[time.Tuesday][9]; got != 0 {
+		t.Errorf("expected bucket below %d members to be hidden, got %d", model.AvailabilityHeatmapMinMembers, got)
+	}
+}
+
+func TestBuildAvailabilityHeatmap_UsesTimezone(t *testing.T) {
+	t.Parallel()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	since := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 0, 28)
+	// 02:00 UTC Tuesday is 21:00 Monday in New York (EST, UTC-5)
+	tuesday2UTC := time.Date(2026, 2, 3, 2, 0, 0, 0, time.UTC)
+
+	var windows []*model.Availability
+	for i := 0; i < 3; i++ {
+		windows = append(windows, window(fmt.Sprintf("user:%d", i), tuesday2UTC, 1))
+	}
+
+	heatmap := buildAvailabilityHeatmap(windows, since, until, loc)
+	if got := heatmap.Buckets[time.Monday][21]; got != 3 {
+		t.Errorf("expected 3 members free Monday 21:00 New York time, got %d", got)
+	}
+	if heatmap.Timezone != "America/New_York" {
+		t.Errorf("expected timezone America/New_York, got %q", heatmap.Timezone)
+	}
+}
+
+func TestBuildAvailabilityHeatmap_ClipsToPeriod(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 0, 7)
+
+	// Windows running for weeks mark every hour once, never more
+	var windows []*model.Availability
+	for i := 0; i < 3; i++ {
+		windows = append(windows, window(fmt.Sprintf("user:%d", i), since.AddDate(0, 0, -14), 24*60))
+	}
+
+	heatmap := buildAvailabilityHeatmap(windows, since, until, time.UTC)
+	for day := range heatmap.Buckets {
+		for hour, count := range heatmap.Buckets[day] {
+			if count != 3 {
+				t.Fatalf("expected 3 members in every bucket, got %d at [%d][%d]", count, day, hour)
+			}
+		}
+	}
+}
+
+// ============================================================================
+// Event Time Suggestion Tests
+// ============================================================================
+
+func TestScoreEventTime_UsesQuietestHour(t *testing.T) {
+	t.Parallel()
+	heatmap := &model.AvailabilityHeatmap{}
+	heatmap.Buckets[time.Friday][18] = 6
+	heatmap.Buckets[time.Friday][19] = 4
+	heatmap.Buckets[time.Friday][20] = 9
+
+	start := time.Date(2026, 3, 6, 18, 0, 0, 0, time.UTC) // A Friday
+	got := scoreEventTime(heatmap, nil, start, start.Add(150*time.Minute), time.UTC)
+
+	if got.AvailableMembers != 4 || got.ExpectedAttendance != 4 {
+		t.Errorf("expected 4 available and expected, got %d and %v", got.AvailableMembers, got.ExpectedAttendance)
+	}
+}
+
+func TestScoreEventTime_DiscountsConflicts(t *testing.T) {
+	t.Parallel()
+	heatmap := &model.AvailabilityHeatmap{}
+	heatmap.Buckets[time.Friday][18] = 8
+
+	start := time.Date(2026, 3, 6, 18, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	overlapEnd := start.Add(30 * time.Minute)
+	events := []*model.Event{
+		{ID: "event:open-ended", StartTime: start.Add(-time.Hour)}, // Assumed to run two hours
+		{ID: "event:overlap", StartTime: start.Add(-time.Hour), EndTime: &overlapEnd},
+		{ID: "event:before", StartTime: start.Add(-2 * time.Hour), EndTime: &start},
+		{ID: "event:after", StartTime: end},
+	}
+
+	got := scoreEventTime(heatmap, events, start, end, time.UTC)
+	if len(got.ConflictingEventIDs) != 2 {
+		t.Fatalf("expected 2 conflicts, got %v", got.ConflictingEventIDs)
+	}
+	if got.ExpectedAttendance != 2 {
+		t.Errorf("expected attendance 8 halved twice, got %v", got.ExpectedAttendance)
+	}
+}
+
+func TestNextLocalHour(t *testing.T) {
+	t.Parallel()
+	loc, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		in   time.Time
+		loc  *time.Location
+		want time.Time
+	}{
+		{"on the hour", time.Date(2026, 3, 6, 18, 0, 0, 0, time.UTC), time.UTC, time.Date(2026, 3, 6, 18, 0, 0, 0, time.UTC)},
+		{"rounds up", time.Date(2026, 3, 6, 18, 0, 1, 0, time.UTC), time.UTC, time.Date(2026, 3, 6, 19, 0, 0, 0, time.UTC)},
+		// Kolkata is UTC+5:30, so its hours fall on the half hour in UTC
+		{"half hour zone", time.Date(2026, 3, 6, 18, 0, 0, 0, time.UTC), loc, time.Date(2026, 3, 6, 18, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextLocalHour(tt.in, tt.loc); !got.Equal(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
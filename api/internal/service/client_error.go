@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/requestid"
+)
+
+// ClientErrorRepository defines the interface for client error storage
+type ClientErrorRepository interface {
+	Create(ctx context.Context, report *model.ClientError) error
+	List(ctx context.Context, filter model.ClientErrorFilter) ([]*model.ClientError, error)
+	Summarize(ctx context.Context, filter model.ClientErrorFilter) ([]*model.ClientErrorGroup, error)
+}
+
+// ClientErrorService collects crashes and API-contract mismatches reported
+// by clients. Reports are sampled by kind so a bad release can't flood the
+// table, and grouped by fingerprint for admins.
+type ClientErrorService struct {
+	repo               ClientErrorRepository
+	crashSampleRate    float64
+	mismatchSampleRate float64
+	rng                *rand.Rand
+	clock              clock.Clock
+}
+
+// ClientErrorServiceConfig holds configuration for the client error service
+type ClientErrorServiceConfig struct {
+	Repo               ClientErrorRepository
+	CrashSampleRate    float64     // Share of crash reports kept, from 0 to 1 (default 1)
+	MismatchSampleRate float64     // Share of API mismatch reports kept, from 0 to 1 (default 1)
+	Rand               rand.Source // Optional; draws which reports are kept, randomly seeded if nil
+	Clock              clock.Clock // Default: the system clock
+}
+
+// NewClientErrorService creates a new client error service
+func NewClientErrorService(cfg ClientErrorServiceConfig) *ClientErrorService {
+	if cfg.CrashSampleRate <= 0 {
+		cfg.CrashSampleRate = 1
+	}
+	if cfg.MismatchSampleRate <= 0 {
+		cfg.MismatchSampleRate = 1
+	}
+	return &ClientErrorService{
+		repo:               cfg.Repo,
+		crashSampleRate:    cfg.CrashSampleRate,
+		mismatchSampleRate: cfg.MismatchSampleRate,
+		rng:                newRand(cfg.Rand),
+		clock:              clock.OrReal(cfg.Clock),
+	}
+}
+
+// Report stores a validated report from userID, who is empty when the
+// client wasn't signed in. Reports that lose the sampling draw are dropped
+// and the receipt says so. Request IDs that aren't valid are dropped too,
+// since they can't match a request in the logs.
+func (s *ClientErrorService) Report(ctx context.Context, userID string, req *model.ReportClientErrorRequest) (*model.ClientErrorReceipt, error) {
+	if s.rng.Float64() >= s.sampleRate(req.Kind) {
+		return &model.ClientErrorReceipt{Stored: false}, nil
+	}
+
+	now := s.clock.Now().UTC()
+	occurredOn := now
+	if req.OccurredOn != nil && !req.OccurredOn.After(now) {
+		occurredOn = req.OccurredOn.UTC()
+	}
+
+	var requestIDs []string
+	for _, id := range req.RequestIDs {
+		if requestid.Valid(id) {
+			requestIDs = append(requestIDs, id)
+		}
+	}
+
+	title := clientErrorTitle(req.Message)
+	report := &model.ClientError{
+		Kind:        req.Kind,
+		Platform:    req.Platform,
+		AppVersion:  strings.TrimSpace(req.AppVersion),
+		Title:       title,
+		Message:     req.Message,
+		StackTrace:  req.StackTrace,
+		Endpoint:    strings.TrimSpace(req.Endpoint),
+		StatusCode:  req.StatusCode,
+		RequestIDs:  requestIDs,
+		RequestID:   requestid.FromContext(ctx),
+		Fingerprint: clientErrorFingerprint(req.Kind, req.Platform, strings.TrimSpace(req.Endpoint), title),
+		UserID:      userID,
+		OccurredOn:  occurredOn,
+	}
+	if err := s.repo.Create(ctx, report); err != nil {
+		return nil, err
+	}
+	return &model.ClientErrorReceipt{Stored: true, ID: report.ID}, nil
+}
+
+// List returns the reports matching filter in the last days days, newest
+// first
+func (s *ClientErrorService) List(ctx context.Context, filter model.ClientErrorFilter, days int) ([]*model.ClientError, error) {
+	filter.Since = s.since(days)
+	filter.Limit = clampClientErrorLimit(filter.Limit)
+	return s.repo.List(ctx, filter)
+}
+
+// Summary groups the reports matching filter in the last days days by
+// fingerprint, most reported first
+func (s *ClientErrorService) Summary(ctx context.Context, filter model.ClientErrorFilter, days int) ([]*model.ClientErrorGroup, error) {
+	filter.Since = s.since(days)
+	filter.Limit = clampClientErrorLimit(filter.Limit)
+	return s.repo.Summarize(ctx, filter)
+}
+
+func (s *ClientErrorService) sampleRate(kind model.ClientErrorKind) float64 {
+	if kind == model.ClientErrorAPIMismatch {
+		return s.mismatchSampleRate
+	}
+	return s.crashSampleRate
+}
+
+// since is the start of a window of days, clamped to the summary limits
+func (s *ClientErrorService) since(days int) time.Time {
+	if days <= 0 {
+		days = model.DefaultClientErrorSummaryDays
+	}
+	if days > model.MaxClientErrorSummaryDays {
+		days = model.MaxClientErrorSummaryDays
+	}
+	return s.clock.Now().UTC().AddDate(0, 0, -days)
+}
+
+func clampClientErrorLimit(limit int) int {
+	if limit <= 0 {
+		return model.DefaultClientErrorsLimit
+	}
+	if limit > model.MaxClientErrorsLimit {
+		return model.MaxClientErrorsLimit
+	}
+	return limit
+}
+
+// clientErrorTitle is the first line of message, shortened to
+// model.ClientErrorTitleLength bytes without splitting a character
+func clientErrorTitle(message string) string {
+	title, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	title = strings.TrimSpace(title)
+	if len(title) <= model.ClientErrorTitleLength {
+		return title
+	}
+	cut := model.ClientErrorTitleLength
+	for cut > 0 && !utf8.RuneStart(title[cut]) {
+		cut--
+	}
+	return title[:cut]
+}
+
+// clientErrorFingerprint identifies reports of the same error: same kind,
+// platform, endpoint and title
+func clientErrorFingerprint(kind model.ClientErrorKind, platform model.DevicePlatform, endpoint, title string) string {
+	h := sha256.New()
+	for _, part := range []string{string(kind), string(platform), endpoint, title} {
+		h.Write([]byte(strconv.Itoa(len(part))))
+		h.Write([]byte{':'})
+		h.Write([]byte(part))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
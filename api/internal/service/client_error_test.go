@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/requestid"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+func newRecordingClientErrorRepo() (*mocks.ClientErrorRepository, *[]*model.ClientError) {
+	var stored []*model.ClientError
+	repo := &mocks.ClientErrorRepository{
+		CreateFunc: func(ctx context.Context, report *model.ClientError) error {
+			report.ID = "client_error:1"
+			stored = append(stored, report)
+			return nil
+		},
+	}
+	return repo, &stored
+}
+
+func TestClientErrorService_Report(t *testing.T) {
+	t.Parallel()
+	repo, stored := newRecordingClientErrorRepo()
+	svc := NewClientErrorService(ClientErrorServiceConfig{Repo: repo})
+	ctx := requestid.NewContext(context.Background(), "report-request")
+
+	receipt, err := svc.Report(ctx, "user:1", &model.ReportClientErrorRequest{
+		Kind:       model.ClientErrorAPIMismatch,
+		Platform:   model.PlatformIOS,
+		AppVersion: " 3.2.0 ",
+		Message:    "missing field guild.name\nat GuildDecoder.decode",
+		Endpoint:   "GET /v1/guilds/{guildId}",
+		StatusCode: 200,
+		RequestIDs: []string{"abc-123", "not valid!"},
+	})
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if !receipt.Stored || receipt.ID != "client_error:1" {
+		t.Errorf("receipt = %+v, want the report stored", receipt)
+	}
+
+	if len(*stored) != 1 {
+		t.Fatalf("stored %d reports, want 1", len(*stored))
+	}
+	report := (*stored)[0]
+	if report.Title != "missing field guild.name" {
+		t.Errorf("Title = %q, want the message's first line", report.Title)
+	}
+	if report.AppVersion != "3.2.0" {
+		t.Errorf("AppVersion = %q, want it trimmed", report.AppVersion)
+	}
+	if len(report.RequestIDs) != 1 || report.RequestIDs[0] != "abc-123" {
+		t.Errorf("RequestIDs = %v, want only the valid one", report.RequestIDs)
+	}
+	if report.RequestID != "report-request" || report.UserID != "user:1" {
+		t.Errorf("report = %+v, want the reporting request and user recorded", report)
+	}
+	if report.OccurredOn.IsZero() {
+		t.Error("expected OccurredOn to default to now")
+	}
+}
+
+func TestClientErrorService_Report_Sampled(t *testing.T) {
+	t.Parallel()
+	repo, stored := newRecordingClientErrorRepo()
+	svc := NewClientErrorService(ClientErrorServiceConfig{
+		Repo:               repo,
+		MismatchSampleRate: 0.5,
+		Rand:               rand.NewPCG(1, 2),
+	})
+
+	kept := 0
+	for i := 0; i < 200; i++ {
+		receipt, err := svc.Report(context.Background(), "", &model.ReportClientErrorRequest{
+			Kind:       model.ClientErrorAPIMismatch,
+			Platform:   model.PlatformWeb,
+			AppVersion: "1.0.0",
+			Message:    "unexpected status",
+			Endpoint:   "GET /v1/profile",
+		})
+		if err != nil {
+			t.Fatalf("Report() error = %v", err)
+		}
+		if receipt.Stored {
+			kept++
+		}
+	}
+	if kept != len(*stored) {
+		t.Errorf("%d receipts said stored, but %d reports were", kept, len(*stored))
+	}
+	if kept < 60 || kept > 140 {
+		t.Errorf("kept %d of 200 reports, want about half", kept)
+	}
+
+	// Crashes keep the default rate of 1
+	receipt, err := svc.Report(context.Background(), "", &model.ReportClientErrorRequest{
+		Kind:       model.ClientErrorCrash,
+		Platform:   model.PlatformWeb,
+		AppVersion: "1.0.0",
+		Message:    "TypeError",
+	})
+	if err != nil || !receipt.Stored {
+		t.Errorf("Report(crash) = %+v, %v; want it stored", receipt, err)
+	}
+}
+
+func TestClientErrorFingerprint(t *testing.T) {
+	t.Parallel()
+	a := clientErrorFingerprint(model.ClientErrorCrash, model.PlatformIOS, "", clientErrorTitle("NullPointer\nframe 1"))
+	b := clientErrorFingerprint(model.ClientErrorCrash, model.PlatformIOS, "", clientErrorTitle("NullPointer\nframe 2"))
+	if a != b {
+		t.Error("expected reports differing after the first line to share a fingerprint")
+	}
+	if c := clientErrorFingerprint(model.ClientErrorCrash, model.PlatformAndroid, "", "NullPointer"); c == a {
+		t.Error("expected a different platform to change the fingerprint")
+	}
+
+	long := strings.Repeat("é", model.ClientErrorTitleLength)
+	if title := clientErrorTitle(long); len(title) > model.ClientErrorTitleLength || !utf8.ValidString(title) {
+		t.Errorf("clientErrorTitle() = %q, want valid UTF-8 of at most %d bytes", title, model.ClientErrorTitleLength)
+	}
+}
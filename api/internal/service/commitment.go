@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// CommitmentEventSource provides the events a user has RSVPed to
+type CommitmentEventSource interface {
+	GetUserEventsInRange(ctx context.Context, userID string, since, until time.Time) ([]*model.Event, error)
+}
+
+// CommitmentHangoutSource provides a user's scheduled hangouts
+type CommitmentHangoutSource interface {
+	GetUserUpcomingHangouts(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]*model.Hangout, error)
+}
+
+// CommitmentRideshareSource provides the rideshares a user drives or rides in
+type CommitmentRideshareSource interface {
+	GetByUserInRange(ctx context.Context, userID string, since, until time.Time) ([]*model.Rideshare, error)
+}
+
+// ConflictChecker finds existing commitments that clash with a new one
+type ConflictChecker interface {
+	Conflicts(ctx context.Context, check model.CommitmentCheck) ([]model.CommitmentConflict, error)
+}
+
+// CommitmentService looks across a user's events, hangouts and rides for
+// anything that clashes with a commitment they are about to make
+type CommitmentService struct {
+	events     CommitmentEventSource
+	hangouts   CommitmentHangoutSource
+	rideshares CommitmentRideshareSource
+}
+
+// CommitmentServiceConfig holds configuration for the commitment service
+type CommitmentServiceConfig struct {
+	Events     CommitmentEventSource
+	Hangouts   CommitmentHangoutSource
+	Rideshares CommitmentRideshareSource
+}
+
+// NewCommitmentService creates a new commitment service
+func NewCommitmentService(cfg CommitmentServiceConfig) *CommitmentService {
+	return &CommitmentService{
+		events:     cfg.Events,
+		hangouts:   cfg.Hangouts,
+		rideshares: cfg.Rideshares,
+	}
+}
+
+// Conflicts returns the user's events and hangouts overlapping the check's
+// window, and rides departing or arriving within RideshareAdjacency of it,
+// ordered by start time
+func (s *CommitmentService) Conflicts(ctx context.Context, check model.CommitmentCheck) ([]model.CommitmentConflict, error) {
+	var conflicts []model.CommitmentConflict
+
+	// Events may start up to a day before the window and still run into it
+	events, err := s.events.GetUserEventsInRange(ctx, check.UserID, check.Start.AddDate(0, 0, -1), check.End)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		end := event.EndOrDefault()
+		if event.ID == check.EventID || !overlaps(event.StartTime, end, check.Start, check.End) {
+			continue
+		}
+		conflicts = append(conflicts, model.CommitmentConflict{
+			Kind:  model.ConflictOverlappingEvent,
+			ID:    event.ID,
+			Title: event.Title,
+			Start: event.StartTime,
+			End:   end,
+		})
+	}
+
+	hangouts, err := s.hangouts.GetUserUpcomingHangouts(ctx, check.UserID, check.Start.Add(-model.DefaultHangoutLength), check.End)
+	if err != nil {
+		return nil, err
+	}
+	for _, hangout := range hangouts {
+		end := hangout.ScheduledTime.Add(model.DefaultHangoutLength)
+		if !overlaps(hangout.ScheduledTime, end, check.Start, check.End) {
+			continue
+		}
+		title := ""
+		if hangout.ActivityDescription != nil {
+			title = *hangout.ActivityDescription
+		}
+		conflicts = append(conflicts, model.CommitmentConflict{
+			Kind:  model.ConflictOverlappingHangout,
+			ID:    hangout.ID,
+			Title: title,
+			Start: hangout.ScheduledTime,
+			End:   end,
+		})
+	}
+
+	windowStart, windowEnd := check.Start.Add(-model.RideshareAdjacency), check.End.Add(model.RideshareAdjacency)
+	rideshares, err := s.rideshares.GetByUserInRange(ctx, check.UserID, windowStart.AddDate(0, 0, -1), windowEnd)
+	if err != nil {
+		return nil, err
+	}
+	for _, ride := range rideshares {
+		if check.EventID != "" && ride.EventID != nil && *ride.EventID == check.EventID {
+			continue
+		}
+		end := ride.DepartureTime
+		if ride.ArrivalTime != nil {
+			end = *ride.ArrivalTime
+		}
+		if !ride.DepartureTime.Before(windowEnd) || end.Before(windowStart) {
+			continue
+		}
+		conflicts = append(conflicts, model.CommitmentConflict{
+			Kind:  model.ConflictAdjacentRideshare,
+			ID:    ride.ID,
+			Title: ride.Title,
+			Start: ride.DepartureTime,
+			End:   end,
+		})
+	}
+
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return conflicts[i].Start.Before(conflicts[j].Start)
+	})
+	return conflicts, nil
+}
+
+// overlaps reports whether [aStart, aEnd) and [bStart, bEnd) intersect
+func overlaps(aStart, aEnd, bStart, bEnd time.Time) bool {
+	return aStart.Before(bEnd) && aEnd.After(bStart)
+}
+
+// checkConflicts returns a ScheduleConflictError when checker finds clashes.
+// A nil checker, or force, skips the check.
+func checkConflicts(ctx context.Context, checker ConflictChecker, check model.CommitmentCheck, force bool) error {
+	if checker == nil || force {
+		return nil
+	}
+	conflicts, err := checker.Conflicts(ctx, check)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return &ScheduleConflictError{Conflicts: conflicts}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mocks
+// ============================================================================
+
+type mockCommitmentSources struct {
+	events     []*model.Event
+	hangouts   []*model.Hangout
+	rideshares []*model.Rideshare
+}
+
+func (m *mockCommitmentSources) GetUserEventsInRange(ctx context.Context, userID string, since, until time.Time) ([]*model.Event, error) {
+	return m.events, nil
+}
+
+func (m *mockCommitmentSources) GetUserUpcomingHangouts(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]*model.Hangout, error) {
+	return m.hangouts, nil
+}
+
+func (m *mockCommitmentSources) GetByUserInRange(ctx context.Context, userID string, since, until time.Time) ([]*model.Rideshare, error) {
+	return m.rideshares, nil
+}
+
+func newTestCommitmentService(sources *mockCommitmentSources) *CommitmentService {
+	return NewCommitmentService(CommitmentServiceConfig{
+		Events:     sources,
+		Hangouts:   sources,
+		Rideshares: sources,
+	})
+}
+
+// ============================================================================
+// Conflict Tests
+// ============================================================================
+
+func TestCommitmentConflicts_OverlappingEventsAndHangouts(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 5, 2, 18, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	earlyEnd := start
+
+	sources := &mockCommitmentSources{
+		events: []*model.Event{
+			{ID: "event:self", Title: "The event being RSVPed to", StartTime: start},
+			{ID: "event:dinner", Title: "Dinner", StartTime: start.Add(time.Hour)},
+			{ID: "event:lunch", Title: "Lunch", StartTime: start.Add(-3 * time.Hour), EndTime: &earlyEnd},
+		},
+		hangouts: []*model.Hangout{
+			{ID: "hangout:1", ScheduledTime: start.Add(-time.Hour)}, // Runs into the window
+			{ID: "hangout:2", ScheduledTime: end},
+		},
+	}
+
+	conflicts, err := newTestCommitmentService(sources).Conflicts(context.Background(), model.CommitmentCheck{
+		UserID: "user:1", Start: start, End: end, EventID: "event:self",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conflicts) != 2 {
+		t.Fatalf("expected 2 conflicts, got %+v", conflicts)
+	}
+	// Ordered by start time
+	if conflicts[0].ID != "hangout:1" || conflicts[0].Kind != model.ConflictOverlappingHangout {
+		t.Errorf("expected hangout:1 first, got %+v", conflicts[0])
+	}
+	if conflicts[1].ID != "event:dinner" || conflicts[1].Kind != model.ConflictOverlappingEvent {
+		t.Errorf("expected event:dinner second, got %+v", conflicts[1])
+	}
+}
+
+func TestCommitmentConflicts_AdjacentRideshares(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 5, 2, 18, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	arrival := start.Add(-30 * time.Minute)
+	selfEvent := "event:self"
+
+	sources := &mockCommitmentSources{
+		rideshares: []*model.Rideshare{
+			{ID: "rideshare:after", DepartureTime: end.Add(30 * time.Minute)},
+			{ID: "rideshare:arrives-before", DepartureTime: start.Add(-3 * time.Hour), ArrivalTime: &arrival},
+			{ID: "rideshare:to-event", EventID: &selfEvent, DepartureTime: start.Add(-30 * time.Minute)},
+			{ID: "rideshare:later", DepartureTime: end.Add(2 * time.Hour)},
+		},
+	}
+
+	conflicts, err := newTestCommitmentService(sources).Conflicts(context.Background(), model.CommitmentCheck{
+		UserID: "user:1", Start: start, End: end, EventID: selfEvent,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conflicts) != 2 {
+		t.Fatalf("expected 2 conflicts, got %+v", conflicts)
+	}
+	if conflicts[0].ID != "rideshare:arrives-before" || conflicts[1].ID != "rideshare:after" {
+		t.Errorf("unexpected conflicts %+v", conflicts)
+	}
+	for _, c := range conflicts {
+		if c.Kind != model.ConflictAdjacentRideshare {
+			t.Errorf("expected adjacent_rideshare, got %s", c.Kind)
+		}
+	}
+}
+
+func TestCheckConflicts(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 5, 2, 18, 0, 0, 0, time.UTC)
+	check := model.CommitmentCheck{UserID: "user:1", Start: start, End: start.Add(time.Hour)}
+	busy := newTestCommitmentService(&mockCommitmentSources{
+		events: []*model.Event{{ID: "event:other", StartTime: start}},
+	})
+
+	tests := []struct {
+		name    string
+		checker ConflictChecker
+		force   bool
+		wantErr bool
+	}{
+		{"conflict", busy, false, true},
+		{"forced", busy, true, false},
+		{"no checker", nil, false, false},
+		{"free", newTestCommitmentService(&mockCommitmentSources{}), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkConflicts(context.Background(), tt.checker, check, tt.force)
+			var conflict *ScheduleConflictError
+			if got := errors.As(err, &conflict); got != tt.wantErr {
+				t.Fatalf("expected schedule conflict %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr && !errors.Is(err, ErrScheduleConflict) {
+				t.Error("expected error to unwrap to ErrScheduleConflict")
+			}
+		})
+	}
+}
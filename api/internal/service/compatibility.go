@@ -33,6 +33,51 @@ func (s *CompatibilityService) CalculateCompatibility(ctx context.Context, userA
 		return nil, err
 	}
 
+	return s.scoreSharedAnswers(userAID, userBID, sharedAnswers), nil
+}
+
+// CalculateCompatibilityBatch scores a user against several others at once.
+// All answers are fetched in a single query; the user's own ID is skipped and
+// scores are returned in request order without duplicates.
+func (s *CompatibilityService) CalculateCompatibilityBatch(ctx context.Context, userID string, targetIDs []string) ([]*model.CompatibilityScore, error) {
+	targets := make([]string, 0, len(targetIDs))
+	seen := map[string]bool{userID: true}
+	for _, id := range targetIDs {
+		if !seen[id] {
+			seen[id] = true
+			targets = append(targets, id)
+		}
+	}
+	if len(targets) == 0 {
+		return []*model.CompatibilityScore{}, nil
+	}
+
+	answersByUser, err := s.questionnaireRepo.GetAnswersForUsers(ctx, append([]string{userID}, targets...))
+	if err != nil {
+		return nil, err
+	}
+
+	userAnswers := make(map[string]*model.Answer, len(answersByUser[userID]))
+	for _, a := range answersByUser[userID] {
+		userAnswers[a.QuestionID] = a
+	}
+
+	scores := make([]*model.CompatibilityScore, 0, len(targets))
+	for _, targetID := range targets {
+		sharedAnswers := make(map[string][2]*model.Answer)
+		for _, b := range answersByUser[targetID] {
+			if a, ok := userAnswers[b.QuestionID]; ok {
+				sharedAnswers[b.QuestionID] = [2]*model.Answer{a, b}
+			}
+		}
+		scores = append(scores, s.scoreSharedAnswers(userID, targetID, sharedAnswers))
+	}
+
+	return scores, nil
+}
+
+// scoreSharedAnswers computes the compatibility score from answers both users gave
+func (s *CompatibilityService) scoreSharedAnswers(userAID, userBID string, sharedAnswers map[string][2]*model.Answer) *model.CompatibilityScore {
 	if len(sharedAnswers) == 0 {
 		return &model.CompatibilityScore{
 			UserAID:     userAID,
@@ -42,7 +87,7 @@ func (s *CompatibilityService) CalculateCompatibility(ctx context.Context, userA
 			BToA:        0,
 			SharedCount: 0,
 			DealBreaker: false,
-		}, nil
+		}
 	}
 
 	// Calculate A→B (how well B matches A's preferences)
@@ -68,7 +113,7 @@ func (s *CompatibilityService) CalculateCompatibility(ctx context.Context, userA
 		BToA:        bToAScore,
 		SharedCount: len(sharedAnswers),
 		DealBreaker: hasDealBreaker,
-	}, nil
+	}
 }
 
 // CalculateCompatibilityBreakdown provides detailed scoring info
@@ -14,8 +14,13 @@ import (
 // ============================================================================
 
 type mockQuestionnaireRepo struct {
-	getSharedAnswersFunc func(ctx context.Context, userAID, userBID string) (map[string][2]*model.Answer, error)
-	getAllQuestionsFunc  func(ctx context.Context) ([]*model.Question, error)
+	getSharedAnswersFunc   func(ctx context.Context, userAID, userBID string) (map[string][2]*model.Answer, error)
+	getAllQuestionsFunc    func(ctx context.Context) ([]*model.Question, error)
+	getAnswersForUsersFunc func(ctx context.Context, userIDs []string) (map[string][]*model.Answer, error)
+	getQuestionByIDFunc    func(ctx context.Context, id string) (*model.Question, error)
+	getUserAnswersFunc     func(ctx context.Context, userID string) ([]*model.Answer, error)
+	createAnswerFunc       func(ctx context.Context, answer *model.Answer) error
+	updateAnswerFunc       func(ctx context.Context, userID, questionID string, updates map[string]interface{}) (*model.Answer, error)
 }
 
 func (m *mockQuestionnaireRepo) GetAllQuestions(ctx context.Context) ([]*model.Question, error) {
@@ -29,11 +34,10 @@ func (m *mockQuestionnaireRepo) GetQuestionsByCategory(ctx context.Context, cate
 	return nil, nil
 }
 
-func (m *mockQuestionnaireRepo) GetCircleQuestions(ctx context.Context, circleID string) ([]*model.Question, error) {
-	return nil, nil
-}
-
 func (m *mockQuestionnaireRepo) GetQuestionByID(ctx context.Context, id string) (*model.Question, error) {
+	if m.getQuestionByIDFunc != nil {
+		return m.getQuestionByIDFunc(ctx, id)
+	}
 	return nil, nil
 }
 
@@ -46,6 +50,9 @@ func (m *mockQuestionnaireRepo) GetUserAnswer(ctx context.Context, userID, quest
 }
 
 func (m *mockQuestionnaireRepo) GetUserAnswers(ctx context.Context, userID string) ([]*model.Answer, error) {
+	if m.getUserAnswersFunc != nil {
+		return m.getUserAnswersFunc(ctx, userID)
+	}
 	return nil, nil
 }
 
@@ -54,10 +61,16 @@ func (m *mockQuestionnaireRepo) GetUserAnswersWithQuestions(ctx context.Context,
 }
 
 func (m *mockQuestionnaireRepo) CreateAnswer(ctx context.Context, answer *model.Answer) error {
+	if m.createAnswerFunc != nil {
+		return m.createAnswerFunc(ctx, answer)
+	}
 	return nil
 }
 
 func (m *mockQuestionnaireRepo) UpdateAnswer(ctx context.Context, userID, questionID string, updates map[string]interface{}) (*model.Answer, error) {
+	if m.updateAnswerFunc != nil {
+		return m.updateAnswerFunc(ctx, userID, questionID, updates)
+	}
 	return nil, nil
 }
 
@@ -72,6 +85,13 @@ func (m *mockQuestionnaireRepo) GetSharedAnswers(ctx context.Context, userAID, u
 	return nil, nil
 }
 
+func (m *mockQuestionnaireRepo) GetAnswersForUsers(ctx context.Context, userIDs []string) (map[string][]*model.Answer, error) {
+	if m.getAnswersForUsersFunc != nil {
+		return m.getAnswersForUsersFunc(ctx, userIDs)
+	}
+	return nil, nil
+}
+
 func (m *mockQuestionnaireRepo) GetUserBiasProfile(ctx context.Context, userID string) (*model.UserBiasProfile, error) {
 	return nil, nil
 }
@@ -807,3 +827,44 @@ func TestCalculateCompatibility_OnlyIrrelevantQuestions(t *testing.T) {
 		t.Error("score should not be NaN")
 	}
 }
+
+func TestCalculateCompatibilityBatch_DedupesSkipsSelfAndKeepsOrder(t *testing.T) {
+	t.Parallel()
+	withQuestion := func(questionID string, a *model.Answer) *model.Answer {
+		a.QuestionID = questionID
+		return a
+	}
+	var requested []string
+	repo := &mockQuestionnaireRepo{
+		getAnswersForUsersFunc: func(ctx context.Context, userIDs []string) (map[string][]*model.Answer, error) {
+			requested = userIDs
+			return map[string][]*model.Answer{
+				"user:A": {withQuestion("q1", makeAnswer("yes", []string{"yes"}, model.ImportanceVery, false, 0.5, nil))},
+				"user:B": {withQuestion("q1", makeAnswer("yes", []string{"yes"}, model.ImportanceVery, false, 0.5, nil))},
+				"user:C": {withQuestion("q2", makeAnswer("no", []string{"no"}, model.ImportanceVery, false, 0.5, nil))},
+			}, nil
+		},
+	}
+	svc := newTestCompatibilityService(repo)
+
+	scores, err := svc.CalculateCompatibilityBatch(context.Background(), "user:A", []string{"user:C", "user:A", "user:B", "user:C"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requested) != 3 {
+		t.Errorf("expected answers fetched for 3 users in one call, got %v", requested)
+	}
+	if len(scores) != 2 {
+		t.Fatalf("expected 2 scores, got %d", len(scores))
+	}
+	if scores[0].UserBID != "user:C" || scores[1].UserBID != "user:B" {
+		t.Errorf("expected request order C, B; got %s, %s", scores[0].UserBID, scores[1].UserBID)
+	}
+	if scores[0].SharedCount != 0 || scores[0].Score != 0 {
+		t.Errorf("expected no shared answers with C, got %+v", scores[0])
+	}
+	if scores[1].Score != 100 {
+		t.Errorf("expected score 100 with B, got %f", scores[1].Score)
+	}
+}
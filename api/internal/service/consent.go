@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ConsentChecker is used by services that process data only with the user's
+// consent. Callers treat an error as no consent.
+type ConsentChecker interface {
+	HasConsent(ctx context.Context, userID string, purpose model.ConsentPurpose) (bool, error)
+}
+
+// hasLocationConsent reports whether the user's location may be used to find
+// people, availabilities and alerts near them. Every user counts as opted in
+// when checker is nil; a failed check counts as no consent.
+func hasLocationConsent(ctx context.Context, checker ConsentChecker, userID string) bool {
+	if checker == nil {
+		return true
+	}
+	allowed, err := checker.HasConsent(ctx, userID, model.ConsentLocationProcessing)
+	if err != nil {
+		log.Printf("[ConsentService] Failed to check location consent for user %s: %v", userID, err)
+		return false
+	}
+	return allowed
+}
+
+// ConsentRepository defines the interface for consent decision storage
+type ConsentRepository interface {
+	Create(ctx context.Context, consent *model.Consent) error
+	GetLatest(ctx context.Context, userID string, purpose model.ConsentPurpose) (*model.Consent, error)
+	ListByUser(ctx context.Context, userID string) ([]*model.Consent, error)
+}
+
+// ConsentService manages users' consent to optional data processing.
+// Consent is opt-in: a purpose is allowed only once the user has granted
+// the current version of its text.
+type ConsentService struct {
+	repo ConsentRepository
+}
+
+// ConsentServiceConfig holds configuration for the consent service
+type ConsentServiceConfig struct {
+	Repo ConsentRepository
+}
+
+// NewConsentService creates a new consent service
+func NewConsentService(cfg ConsentServiceConfig) *ConsentService {
+	return &ConsentService{repo: cfg.Repo}
+}
+
+// List returns the user's current decision for every purpose
+func (s *ConsentService) List(ctx context.Context, userID string) ([]*model.ConsentState, error) {
+	history, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[model.ConsentPurpose]*model.Consent)
+	for _, consent := range history {
+		if _, ok := latest[consent.Purpose]; !ok {
+			latest[consent.Purpose] = consent
+		}
+	}
+
+	states := make([]*model.ConsentState, 0, len(model.ConsentPurposes))
+	for _, purpose := range model.ConsentPurposes {
+		states = append(states, model.NewConsentState(purpose, latest[purpose]))
+	}
+	return states, nil
+}
+
+// History returns every grant and withdrawal the user has made, newest first
+func (s *ConsentService) History(ctx context.Context, userID string) ([]*model.Consent, error) {
+	return s.repo.ListByUser(ctx, userID)
+}
+
+// Grant records the user's consent to the given version of a purpose's text.
+// The version must be the current one, so a client showing outdated wording
+// can't record agreement to text the user never saw.
+func (s *ConsentService) Grant(ctx context.Context, userID string, purpose model.ConsentPurpose, version int) (*model.ConsentState, error) {
+	text, ok := model.CurrentConsentText(purpose)
+	if !ok {
+		return nil, ErrInvalidConsentPurpose
+	}
+	if version != text.Version {
+		return nil, ErrConsentVersionOutdated
+	}
+
+	latest, err := s.repo.GetLatest(ctx, userID, purpose)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil && latest.Granted && latest.Version == version {
+		return model.NewConsentState(purpose, latest), nil
+	}
+
+	consent := &model.Consent{
+		UserID:  userID,
+		Purpose: purpose,
+		Granted: true,
+		Version: version,
+	}
+	if err := s.repo.Create(ctx, consent); err != nil {
+		return nil, err
+	}
+	return model.NewConsentState(purpose, consent), nil
+}
+
+// Withdraw records that the user no longer consents to a purpose. Takes
+// effect immediately; withdrawing consent that isn't granted is a no-op.
+func (s *ConsentService) Withdraw(ctx context.Context, userID string, purpose model.ConsentPurpose) (*model.ConsentState, error) {
+	if !purpose.IsValid() {
+		return nil, ErrInvalidConsentPurpose
+	}
+
+	latest, err := s.repo.GetLatest(ctx, userID, purpose)
+	if err != nil {
+		return nil, err
+	}
+	if latest == nil || !latest.Granted {
+		return model.NewConsentState(purpose, latest), nil
+	}
+
+	consent := &model.Consent{
+		UserID:  userID,
+		Purpose: purpose,
+		Granted: false,
+		Version: latest.Version,
+	}
+	if err := s.repo.Create(ctx, consent); err != nil {
+		return nil, err
+	}
+	return model.NewConsentState(purpose, consent), nil
+}
+
+// HasConsent reports whether the user has granted the current text for a
+// purpose
+func (s *ConsentService) HasConsent(ctx context.Context, userID string, purpose model.ConsentPurpose) (bool, error) {
+	if !purpose.IsValid() {
+		return false, ErrInvalidConsentPurpose
+	}
+
+	latest, err := s.repo.GetLatest(ctx, userID, purpose)
+	if err != nil {
+		return false, err
+	}
+	return model.NewConsentState(purpose, latest).Granted, nil
+}
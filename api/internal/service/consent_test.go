@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mock Repository
+// ============================================================================
+
+type mockConsentRepo struct {
+	history []*model.Consent // Newest first
+}
+
+func (m *mockConsentRepo) Create(ctx context.Context, consent *model.Consent) error {
+	consent.ID = "consent:new"
+	consent.DecidedOn = time.Now()
+	m.history = append([]*model.Consent{consent}, m.history...)
+	return nil
+}
+
+func (m *mockConsentRepo) GetLatest(ctx context.Context, userID string, purpose model.ConsentPurpose) (*model.Consent, error) {
+	for _, consent := range m.history {
+		if consent.UserID == userID && consent.Purpose == purpose {
+			return consent, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockConsentRepo) ListByUser(ctx context.Context, userID string) ([]*model.Consent, error) {
+	var consents []*model.Consent
+	for _, consent := range m.history {
+		if consent.UserID == userID {
+			consents = append(consents, consent)
+		}
+	}
+	return consents, nil
+}
+
+func currentConsentVersion(t *testing.T, purpose model.ConsentPurpose) int {
+	t.Helper()
+	text, ok := model.CurrentConsentText(purpose)
+	if !ok {
+		t.Fatalf("no consent text for %s", purpose)
+	}
+	return text.Version
+}
+
+// locationConsents returns a consent service in which every given user has
+// granted location processing
+func locationConsents(t *testing.T, userIDs ...string) *ConsentService {
+	t.Helper()
+	svc := NewConsentService(ConsentServiceConfig{Repo: &mockConsentRepo{}})
+	version := currentConsentVersion(t, model.ConsentLocationProcessing)
+	for _, userID := range userIDs {
+		if _, err := svc.Grant(context.Background(), userID, model.ConsentLocationProcessing, version); err != nil {
+			t.Fatalf("granting location consent for %s: %v", userID, err)
+		}
+	}
+	return svc
+}
+
+// ============================================================================
+// Grant / Withdraw Tests
+// ============================================================================
+
+func TestConsentGrant(t *testing.T) {
+	t.Parallel()
+
+	version := currentConsentVersion(t, model.ConsentAnalytics)
+	tests := []struct {
+		name    string
+		purpose model.ConsentPurpose
+		version int
+		wantErr error
+	}{
+		{name: "current version", purpose: model.ConsentAnalytics, version: version},
+		{name: "outdated version", purpose: model.ConsentAnalytics, version: version - 1, wantErr: ErrConsentVersionOutdated},
+		{name: "future version", purpose: model.ConsentAnalytics, version: version + 1, wantErr: ErrConsentVersionOutdated},
+		{name: "unknown purpose", purpose: "telemetry", version: 1, wantErr: ErrInvalidConsentPurpose},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := &mockConsentRepo{}
+			svc := NewConsentService(ConsentServiceConfig{Repo: repo})
+
+			state, err := svc.Grant(context.Background(), "user:1", tt.purpose, tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				if len(repo.history) != 0 {
+					t.Error("expected nothing recorded")
+				}
+				return
+			}
+			if !state.Granted {
+				t.Error("expected consent to be granted")
+			}
+		})
+	}
+}
+
+func TestConsentGrant_IsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockConsentRepo{}
+	svc := NewConsentService(ConsentServiceConfig{Repo: repo})
+	version := currentConsentVersion(t, model.ConsentAnalytics)
+
+	for i := 0; i < 2; i++ {
+		if _, err := svc.Grant(context.Background(), "user:1", model.ConsentAnalytics, version); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(repo.history) != 1 {
+		t.Errorf("expected 1 decision recorded, got %d", len(repo.history))
+	}
+}
+
+func TestConsentWithdraw(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockConsentRepo{}
+	svc := NewConsentService(ConsentServiceConfig{Repo: repo})
+	ctx := context.Background()
+	version := currentConsentVersion(t, model.ConsentLocationProcessing)
+
+	// Withdrawing consent that was never given records nothing
+	if _, err := svc.Withdraw(ctx, "user:1", model.ConsentLocationProcessing); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.history) != 0 {
+		t.Fatalf("expected no decisions, got %d", len(repo.history))
+	}
+
+	if _, err := svc.Grant(ctx, "user:1", model.ConsentLocationProcessing, version); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	state, err := svc.Withdraw(ctx, "user:1", model.ConsentLocationProcessing)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state.Granted {
+		t.Error("expected consent to be withdrawn")
+	}
+	if len(repo.history) != 2 {
+		t.Errorf("expected grant and withdrawal recorded, got %d decisions", len(repo.history))
+	}
+
+	allowed, err := svc.HasConsent(ctx, "user:1", model.ConsentLocationProcessing)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if allowed {
+		t.Error("expected no consent after withdrawal")
+	}
+}
+
+// ============================================================================
+// State Tests
+// ============================================================================
+
+func TestConsentList_OutdatedGrantNeedsReconsent(t *testing.T) {
+	t.Parallel()
+
+	version := currentConsentVersion(t, model.ConsentAnalytics)
+	repo := &mockConsentRepo{history: []*model.Consent{
+		{UserID: "user:1", Purpose: model.ConsentAnalytics, Granted: true, Version: version - 1},
+	}}
+	svc := NewConsentService(ConsentServiceConfig{Repo: repo})
+
+	states, err := svc.List(context.Background(), "user:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(states) != len(model.ConsentPurposes) {
+		t.Fatalf("expected a state per purpose, got %d", len(states))
+	}
+
+	for _, state := range states {
+		switch state.Purpose {
+		case model.ConsentAnalytics:
+			if state.Granted || !state.NeedsReconsent {
+				t.Errorf("expected outdated analytics grant to need reconsent, got %+v", state)
+			}
+		default:
+			if state.Granted || state.NeedsReconsent || state.DecidedOn != nil {
+				t.Errorf("expected %s to be undecided, got %+v", state.Purpose, state)
+			}
+		}
+	}
+
+	allowed, err := svc.HasConsent(context.Background(), "user:1", model.ConsentAnalytics)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if allowed {
+		t.Error("expected an outdated grant not to count as consent")
+	}
+}
+
+func TestHasLocationConsent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		checker ConsentChecker
+		want    bool
+	}{
+		{name: "not checked", checker: nil, want: true},
+		{name: "granted", checker: &mockConsentChecker{granted: map[string]bool{"user:1": true}}, want: true},
+		{name: "not granted", checker: &mockConsentChecker{}, want: false},
+		{name: "check fails", checker: &mockConsentChecker{granted: map[string]bool{"user:1": true}, err: errors.New("db down")}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := hasLocationConsent(context.Background(), tt.checker, "user:1"); got != tt.want {
+				t.Errorf("hasLocationConsent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,614 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DefaultContentCacheTTL bounds how long another instance may serve a stale
+// catalog after an admin change; the instance making the change invalidates
+// its own cache immediately.
+const DefaultContentCacheTTL = 5 * time.Minute
+
+// ContentRepository defines the storage interface for catalog content
+type ContentRepository interface {
+	ListQuestionCategories(ctx context.Context) ([]*model.QuestionCategory, error)
+	GetQuestionCategory(ctx context.Context, key string) (*model.QuestionCategory, error)
+	CreateQuestionCategory(ctx context.Context, category *model.QuestionCategory) error
+	UpdateQuestionCategory(ctx context.Context, key string, updates map[string]interface{}) (*model.QuestionCategory, error)
+	DeleteQuestionCategory(ctx context.Context, key string) error
+	CountQuestionsInCategory(ctx context.Context, key string) (int, error)
+	ListReviewTags(ctx context.Context, kind model.ReviewTagKind) ([]*model.ReviewTag, error)
+	GetReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) (*model.ReviewTag, error)
+	CreateReviewTag(ctx context.Context, tag *model.ReviewTag) error
+	UpdateReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string, updates map[string]interface{}) (*model.ReviewTag, error)
+	DeleteReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) error
+	CountReviewsWithTag(ctx context.Context, kind model.ReviewTagKind, tag string) (int, error)
+}
+
+// ContentQuestionRepository defines the question storage needed for content management
+type ContentQuestionRepository interface {
+	GetQuestionByID(ctx context.Context, id string) (*model.Question, error)
+	CreateQuestion(ctx context.Context, question *model.Question) error
+	ListQuestions(ctx context.Context, includeInactive bool) ([]*model.Question, error)
+	UpdateQuestion(ctx context.Context, id string, updates map[string]interface{}) (*model.Question, error)
+	DeleteQuestion(ctx context.Context, id string) error
+	CountAnswersForQuestion(ctx context.Context, id string) (int, error)
+	GetAnswerCountsByQuestion(ctx context.Context) (map[string]int, error)
+}
+
+// ContentService manages the questionnaire and review tag catalogs.
+// Public catalogs are served from an in-memory cache that is invalidated on
+// every admin change and otherwise refreshed after the cache TTL.
+type ContentService struct {
+	repo      ContentRepository
+	questions ContentQuestionRepository
+	cacheTTL  time.Duration
+	clock     clock.Clock
+
+	mu      sync.RWMutex
+	catalog *contentCatalog
+}
+
+// ContentServiceConfig holds configuration for the content service
+type ContentServiceConfig struct {
+	Repo      ContentRepository
+	Questions ContentQuestionRepository
+	CacheTTL  time.Duration
+	Clock     clock.Clock // Default: the system clock
+}
+
+// NewContentService creates a new content service
+func NewContentService(cfg ContentServiceConfig) *ContentService {
+	ttl := cfg.CacheTTL
+	if ttl <= 0 {
+		ttl = DefaultContentCacheTTL
+	}
+	return &ContentService{
+		repo:      cfg.Repo,
+		questions: cfg.Questions,
+		cacheTTL:  ttl,
+		clock:     clock.OrReal(cfg.Clock),
+	}
+}
+
+// contentCatalog is a cached snapshot of categories and review tags,
+// including deprecated entries
+type contentCatalog struct {
+	categories []*model.QuestionCategory
+	tags       map[model.ReviewTagKind][]*model.ReviewTag
+	etag       string
+	loadedOn   time.Time
+}
+
+// ===== Public Catalogs =====
+
+// QuestionCategories returns the active question categories and the catalog ETag
+func (s *ContentService) QuestionCategories(ctx context.Context) ([]model.QuestionCategoryInfo, string, error) {
+	catalog, err := s.loadCatalog(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	infos := make([]model.QuestionCategoryInfo, 0, len(catalog.categories))
+	for _, c := range catalog.categories {
+		if c.Active {
+			infos = append(infos, c.Info())
+		}
+	}
+	return infos, catalog.etag, nil
+}
+
+// ReviewTags returns the active review tags of a kind and the catalog ETag
+func (s *ContentService) ReviewTags(ctx context.Context, kind model.ReviewTagKind) ([]model.TagInfo, string, error) {
+	catalog, err := s.loadCatalog(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	infos := make([]model.TagInfo, 0, len(catalog.tags[kind]))
+	for _, t := range catalog.tags[kind] {
+		if t.Active {
+			infos = append(infos, t.Info())
+		}
+	}
+	return infos, catalog.etag, nil
+}
+
+// IsQuestionCategory reports whether key is an active question category
+func (s *ContentService) IsQuestionCategory(ctx context.Context, key string) (bool, error) {
+	catalog, err := s.loadCatalog(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range catalog.categories {
+		if c.Key == key {
+			return c.Active, nil
+		}
+	}
+	return false, nil
+}
+
+// ReviewTagSet returns the active tags of a kind, for validating new reviews
+func (s *ContentService) ReviewTagSet(ctx context.Context, kind model.ReviewTagKind) (map[string]bool, error) {
+	catalog, err := s.loadCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]bool, len(catalog.tags[kind]))
+	for _, t := range catalog.tags[kind] {
+		if t.Active {
+			set[t.Tag] = true
+		}
+	}
+	return set, nil
+}
+
+// ReviewTagLabels returns labels for all tags of a kind, including deprecated
+// ones so that existing reviews keep readable labels
+func (s *ContentService) ReviewTagLabels(ctx context.Context, kind model.ReviewTagKind) (map[string]string, error) {
+	catalog, err := s.loadCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(map[string]string, len(catalog.tags[kind]))
+	for _, t := range catalog.tags[kind] {
+		labels[t.Tag] = t.Label
+	}
+	return labels, nil
+}
+
+// Invalidate drops the cached catalog so the next read reloads it
+func (s *ContentService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.catalog = nil
+}
+
+// loadCatalog returns the cached catalog, reloading it when missing or expired
+func (s *ContentService) loadCatalog(ctx context.Context) (*contentCatalog, error) {
+	s.mu.RLock()
+	catalog := s.catalog
+	s.mu.RUnlock()
+
+	if catalog != nil && s.clock.Now().Sub(catalog.loadedOn) < s.cacheTTL {
+		return catalog, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Another request may have reloaded while we waited for the lock
+	if s.catalog != nil && s.clock.Now().Sub(s.catalog.loadedOn) < s.cacheTTL {
+		return s.catalog, nil
+	}
+
+	categories, err := s.repo.ListQuestionCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) == 0 {
+		categories = builtinQuestionCategories()
+	}
+
+	tags := make(map[model.ReviewTagKind][]*model.ReviewTag, 2)
+	for _, kind := range []model.ReviewTagKind{model.ReviewTagKindPositive, model.ReviewTagKindImprovement} {
+		list, err := s.repo.ListReviewTags(ctx, kind)
+		if err != nil {
+			return nil, err
+		}
+		if len(list) == 0 {
+			list = builtinReviewTags(kind)
+		}
+		tags[kind] = list
+	}
+
+	s.catalog = &contentCatalog{
+		categories: categories,
+		tags:       tags,
+		etag:       catalogETag(categories, tags),
+		loadedOn:   s.clock.Now(),
+	}
+	return s.catalog, nil
+}
+
+// ===== Admin: Questions =====
+
+// ListQuestions returns global questions with answer counts for administration
+func (s *ContentService) ListQuestions(ctx context.Context, includeInactive bool) ([]*model.AdminQuestion, error) {
+	questions, err := s.questions.ListQuestions(ctx, includeInactive)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.questions.GetAnswerCountsByQuestion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.AdminQuestion, len(questions))
+	for i, q := range questions {
+		result[i] = model.NewAdminQuestion(q, counts[q.ID])
+	}
+	return result, nil
+}
+
+// GetQuestion returns a single question with its answer count
+func (s *ContentService) GetQuestion(ctx context.Context, id string) (*model.AdminQuestion, error) {
+	question, err := s.questions.GetQuestionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if question == nil || question.GuildID != nil {
+		return nil, ErrQuestionNotFound
+	}
+
+	count, err := s.questions.CountAnswersForQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewAdminQuestion(question, count), nil
+}
+
+// CreateQuestion creates a global question in an active category
+func (s *ContentService) CreateQuestion(ctx context.Context, req *model.CreateQuestionRequest) (*model.AdminQuestion, error) {
+	if err := s.requireActiveCategory(ctx, req.Category); err != nil {
+		return nil, err
+	}
+
+	question := &model.Question{
+		Text:                  req.Text,
+		Category:              req.Category,
+		Options:               questionOptions(req.Options),
+		IsDealBreakerEligible: req.IsDealBreakerEligible,
+		SortOrder:             req.SortOrder,
+		Active:                true,
+	}
+	if err := s.questions.CreateQuestion(ctx, question); err != nil {
+		return nil, err
+	}
+
+	return model.NewAdminQuestion(question, 0), nil
+}
+
+// UpdateQuestion updates a question. Options that existing answers selected
+// cannot be removed; labels and bias weights may change freely.
+func (s *ContentService) UpdateQuestion(ctx context.Context, id string, req *model.UpdateQuestionRequest) (*model.AdminQuestion, error) {
+	existing, err := s.questions.GetQuestionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || existing.GuildID != nil {
+		return nil, ErrQuestionNotFound
+	}
+
+	count, err := s.questions.CountAnswersForQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Text != nil {
+		updates["text"] = *req.Text
+	}
+	if req.Category != nil && *req.Category != existing.Category {
+		if err := s.requireActiveCategory(ctx, *req.Category); err != nil {
+			return nil, err
+		}
+		updates["category"] = *req.Category
+	}
+	if req.Options != nil {
+		options := questionOptions(req.Options)
+		if count > 0 && removesOptions(existing.Options, options) {
+			return nil, ErrQuestionOptionInUse
+		}
+		updates["options"] = options
+	}
+	if req.IsDealBreakerEligible != nil {
+		updates["is_dealbreaker_eligible"] = *req.IsDealBreakerEligible
+	}
+	if req.SortOrder != nil {
+		updates["sort_order"] = *req.SortOrder
+	}
+	if req.Active != nil {
+		updates["active"] = *req.Active
+	}
+
+	question, err := s.questions.UpdateQuestion(ctx, id, updates)
+	if err != nil {
+		return nil, err
+	}
+	if question == nil {
+		return nil, ErrQuestionNotFound
+	}
+
+	return model.NewAdminQuestion(question, count), nil
+}
+
+// RemoveQuestion deletes a question, or deprecates it if users answered it
+func (s *ContentService) RemoveQuestion(ctx context.Context, id string) (*model.ContentRemoval, error) {
+	existing, err := s.questions.GetQuestionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || existing.GuildID != nil {
+		return nil, ErrQuestionNotFound
+	}
+
+	count, err := s.questions.CountAnswersForQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if count > 0 {
+		if _, err := s.questions.UpdateQuestion(ctx, id, map[string]interface{}{"active": false}); err != nil {
+			return nil, err
+		}
+		return &model.ContentRemoval{Deprecated: true, References: count}, nil
+	}
+
+	if err := s.questions.DeleteQuestion(ctx, id); err != nil {
+		return nil, err
+	}
+	return &model.ContentRemoval{Deleted: true}, nil
+}
+
+// ===== Admin: Question Categories =====
+
+// ListQuestionCategories returns all categories, including deprecated ones
+func (s *ContentService) ListQuestionCategories(ctx context.Context) ([]*model.QuestionCategory, error) {
+	return s.repo.ListQuestionCategories(ctx)
+}
+
+// CreateQuestionCategory creates a question category
+func (s *ContentService) CreateQuestionCategory(ctx context.Context, req *model.CreateQuestionCategoryRequest) (*model.QuestionCategory, error) {
+	category := &model.QuestionCategory{
+		Key:       req.Key,
+		Label:     req.Label,
+		Icon:      req.Icon,
+		SortOrder: req.SortOrder,
+	}
+	if err := s.repo.CreateQuestionCategory(ctx, category); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrQuestionCategoryExists
+		}
+		return nil, err
+	}
+
+	s.Invalidate()
+	return category, nil
+}
+
+// UpdateQuestionCategory updates a question category
+func (s *ContentService) UpdateQuestionCategory(ctx context.Context, key string, req *model.UpdateQuestionCategoryRequest) (*model.QuestionCategory, error) {
+	category, err := s.repo.UpdateQuestionCategory(ctx, key, catalogUpdates(req.Label, req.Icon, req.SortOrder, req.Active))
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, ErrQuestionCategoryNotFound
+	}
+
+	s.Invalidate()
+	return category, nil
+}
+
+// RemoveQuestionCategory deletes a category, or deprecates it if questions use it
+func (s *ContentService) RemoveQuestionCategory(ctx context.Context, key string) (*model.ContentRemoval, error) {
+	existing, err := s.repo.GetQuestionCategory(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrQuestionCategoryNotFound
+	}
+
+	count, err := s.repo.CountQuestionsInCategory(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	removal := &model.ContentRemoval{References: count}
+	if count > 0 {
+		_, err = s.repo.UpdateQuestionCategory(ctx, key, map[string]interface{}{"active": false})
+		removal.Deprecated = true
+	} else {
+		err = s.repo.DeleteQuestionCategory(ctx, key)
+		removal.Deleted = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.Invalidate()
+	return removal, nil
+}
+
+// ===== Admin: Review Tags =====
+
+// ListReviewTags returns all tags of a kind, including deprecated ones
+func (s *ContentService) ListReviewTags(ctx context.Context, kind model.ReviewTagKind) ([]*model.ReviewTag, error) {
+	if !kind.IsValid() {
+		return nil, ErrInvalidReviewTagKind
+	}
+	return s.repo.ListReviewTags(ctx, kind)
+}
+
+// CreateReviewTag creates a review tag
+func (s *ContentService) CreateReviewTag(ctx context.Context, kind model.ReviewTagKind, req *model.CreateReviewTagRequest) (*model.ReviewTag, error) {
+	if !kind.IsValid() {
+		return nil, ErrInvalidReviewTagKind
+	}
+
+	tag := &model.ReviewTag{
+		Kind:      kind,
+		Tag:       req.Tag,
+		Label:     req.Label,
+		Icon:      req.Icon,
+		SortOrder: req.SortOrder,
+	}
+	if err := s.repo.CreateReviewTag(ctx, tag); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrReviewTagExists
+		}
+		return nil, err
+	}
+
+	s.Invalidate()
+	return tag, nil
+}
+
+// UpdateReviewTag updates a review tag
+func (s *ContentService) UpdateReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string, req *model.UpdateReviewTagRequest) (*model.ReviewTag, error) {
+	if !kind.IsValid() {
+		return nil, ErrInvalidReviewTagKind
+	}
+
+	updated, err := s.repo.UpdateReviewTag(ctx, kind, tag, catalogUpdates(req.Label, req.Icon, req.SortOrder, req.Active))
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrReviewTagNotFound
+	}
+
+	s.Invalidate()
+	return updated, nil
+}
+
+// RemoveReviewTag deletes a tag, or deprecates it if reviews used it
+func (s *ContentService) RemoveReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) (*model.ContentRemoval, error) {
+	if !kind.IsValid() {
+		return nil, ErrInvalidReviewTagKind
+	}
+
+	existing, err := s.repo.GetReviewTag(ctx, kind, tag)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrReviewTagNotFound
+	}
+
+	count, err := s.repo.CountReviewsWithTag(ctx, kind, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	removal := &model.ContentRemoval{References: count}
+	if count > 0 {
+		_, err = s.repo.UpdateReviewTag(ctx, kind, tag, map[string]interface{}{"active": false})
+		removal.Deprecated = true
+	} else {
+		err = s.repo.DeleteReviewTag(ctx, kind, tag)
+		removal.Deleted = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.Invalidate()
+	return removal, nil
+}
+
+// ===== Helpers =====
+
+// requireActiveCategory checks that a category exists and is not deprecated
+func (s *ContentService) requireActiveCategory(ctx context.Context, key string) error {
+	ok, err := s.IsQuestionCategory(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUnknownQuestionCategory
+	}
+	return nil
+}
+
+// catalogUpdates collects the set fields of a category or tag update
+func catalogUpdates(label, icon *string, sortOrder *int, active *bool) map[string]interface{} {
+	updates := make(map[string]interface{})
+	if label != nil {
+		updates["label"] = *label
+	}
+	if icon != nil {
+		updates["icon"] = *icon
+	}
+	if sortOrder != nil {
+		updates["sort_order"] = *sortOrder
+	}
+	if active != nil {
+		updates["active"] = *active
+	}
+	return updates
+}
+
+func questionOptions(options []model.AdminQuestionOption) []model.QuestionOption {
+	result := make([]model.QuestionOption, len(options))
+	for i, opt := range options {
+		result[i] = model.QuestionOption(opt)
+	}
+	return result
+}
+
+// removesOptions reports whether any option value in before is missing from after
+func removesOptions(before, after []model.QuestionOption) bool {
+	kept := make(map[string]bool, len(after))
+	for _, opt := range after {
+		kept[opt.Value] = true
+	}
+	for _, opt := range before {
+		if !kept[opt.Value] {
+			return true
+		}
+	}
+	return false
+}
+
+func builtinQuestionCategories() []*model.QuestionCategory {
+	infos := model.GetQuestionCategories()
+	categories := make([]*model.QuestionCategory, len(infos))
+	for i, info := range infos {
+		categories[i] = &model.QuestionCategory{Key: info.ID, Label: info.Label, Icon: info.Icon, SortOrder: i, Active: true}
+	}
+	return categories
+}
+
+func builtinReviewTags(kind model.ReviewTagKind) []*model.ReviewTag {
+	var infos []model.TagInfo
+	switch kind {
+	case model.ReviewTagKindPositive:
+		infos = model.GetPositiveTags()
+	case model.ReviewTagKindImprovement:
+		infos = model.GetImprovementTags()
+	}
+
+	tags := make([]*model.ReviewTag, len(infos))
+	for i, info := range infos {
+		tags[i] = &model.ReviewTag{Kind: kind, Tag: info.Tag, Label: info.Label, Icon: info.Icon, SortOrder: i, Active: true}
+	}
+	return tags
+}
+
+// catalogETag fingerprints the public catalog contents
+func catalogETag(categories []*model.QuestionCategory, tags map[model.ReviewTagKind][]*model.ReviewTag) string {
+	data, _ := json.Marshal(struct {
+		Categories []*model.QuestionCategory                  `json:"c"`
+		Tags       map[model.ReviewTagKind][]*model.ReviewTag `json:"t"`
+	}{categories, tags})
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:8]))
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// mockContentRepo is an in-memory catalog store
+type mockContentRepo struct {
+	categories   []*model.QuestionCategory
+	tags         map[model.ReviewTagKind][]*model.ReviewTag
+	tagUses      map[string]int
+	listCalls    int
+	deletedTags  []string
+	categoryUses map[string]int
+}
+
+func newMockContentRepo() *mockContentRepo {
+	return &mockContentRepo{
+		tags:         make(map[model.ReviewTagKind][]*model.ReviewTag),
+		tagUses:      make(map[string]int),
+		categoryUses: make(map[string]int),
+	}
+}
+
+func (m *mockContentRepo) ListQuestionCategories(ctx context.Context) ([]*model.QuestionCategory, error) {
+	m.listCalls++
+	return m.categories, nil
+}
+
+func (m *mockContentRepo) GetQuestionCategory(ctx context.Context, key string) (*model.QuestionCategory, error) {
+	for _, c := range m.categories {
+		if c.Key == key {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockContentRepo) CreateQuestionCategory(ctx context.Context, category *model.QuestionCategory) error {
+	category.Active = true
+	m.categories = append(m.categories, category)
+	return nil
+}
+
+func (m *mockContentRepo) UpdateQuestionCategory(ctx context.Context, key string, updates map[string]interface{}) (*model.QuestionCategory, error) {
+	category, _ := m.GetQuestionCategory(ctx, key)
+	if category == nil {
+		return nil, nil
+	}
+	if active, ok := updates["active"].(bool); ok {
+		category.Active = active
+	}
+	return category, nil
+}
+
+func (m *mockContentRepo) DeleteQuestionCategory(ctx context.Context, key string) error {
+	return nil
+}
+
+func (m *mockContentRepo) CountQuestionsInCategory(ctx context.Context, key string) (int, error) {
+	return m.categoryUses[key], nil
+}
+
+func (m *mockContentRepo) ListReviewTags(ctx context.Context, kind model.ReviewTagKind) ([]*model.ReviewTag, error) {
+	return m.tags[kind], nil
+}
+
+func (m *mockContentRepo) GetReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) (*model.ReviewTag, error) {
+	for _, t := range m.tags[kind] {
+		if t.Tag == tag {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockContentRepo) CreateReviewTag(ctx context.Context, tag *model.ReviewTag) error {
+	tag.Active = true
+	m.tags[tag.Kind] = append(m.tags[tag.Kind], tag)
+	return nil
+}
+
+func (m *mockContentRepo) UpdateReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string, updates map[string]interface{}) (*model.ReviewTag, error) {
+	existing, _ := m.GetReviewTag(ctx, kind, tag)
+	if existing == nil {
+		return nil, nil
+	}
+	if active, ok := updates["active"].(bool); ok {
+		existing.Active = active
+	}
+	return existing, nil
+}
+
+func (m *mockContentRepo) DeleteReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) error {
+	m.deletedTags = append(m.deletedTags, tag)
+	return nil
+}
+
+func (m *mockContentRepo) CountReviewsWithTag(ctx context.Context, kind model.ReviewTagKind, tag string) (int, error) {
+	return m.tagUses[tag], nil
+}
+
+// mockContentQuestionRepo serves a single question with a fixed answer count
+type mockContentQuestionRepo struct {
+	question    *model.Question
+	answerCount int
+	updates     map[string]interface{}
+}
+
+func (m *mockContentQuestionRepo) GetQuestionByID(ctx context.Context, id string) (*model.Question, error) {
+	if m.question != nil && m.question.ID == id {
+		return m.question, nil
+	}
+	return nil, nil
+}
+
+func (m *mockContentQuestionRepo) CreateQuestion(ctx context.Context, question *model.Question) error {
+	question.ID = "question:new"
+	return nil
+}
+
+func (m *mockContentQuestionRepo) ListQuestions(ctx context.Context, includeInactive bool) ([]*model.Question, error) {
+	return []*model.Question{m.question}, nil
+}
+
+func (m *mockContentQuestionRepo) UpdateQuestion(ctx context.Context, id string, updates map[string]interface{}) (*model.Question, error) {
+	m.updates = updates
+	return m.question, nil
+}
+
+func (m *mockContentQuestionRepo) DeleteQuestion(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockContentQuestionRepo) CountAnswersForQuestion(ctx context.Context, id string) (int, error) {
+	return m.answerCount, nil
+}
+
+func (m *mockContentQuestionRepo) GetAnswerCountsByQuestion(ctx context.Context) (map[string]int, error) {
+	return map[string]int{m.question.ID: m.answerCount}, nil
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestContentService_FallsBackToBuiltinsWhenEmpty(t *testing.T) {
+	svc := NewContentService(ContentServiceConfig{Repo: newMockContentRepo()})
+
+	categories, etag, err := svc.QuestionCategories(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(categories) != len(model.GetQuestionCategories()) {
+		t.Errorf("got %d categories, want built-in %d", len(categories), len(model.GetQuestionCategories()))
+	}
+	if etag == "" {
+		t.Error("expected an ETag")
+	}
+
+	tags, _, err := svc.ReviewTags(context.Background(), model.ReviewTagKindPositive)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tags) != len(model.GetPositiveTags()) {
+		t.Errorf("got %d positive tags, want built-in %d", len(tags), len(model.GetPositiveTags()))
+	}
+}
+
+func TestContentService_AdminWriteInvalidatesCache(t *testing.T) {
+	repo := newMockContentRepo()
+	repo.tags[model.ReviewTagKindPositive] = []*model.ReviewTag{
+		{Kind: model.ReviewTagKindPositive, Tag: "punctual", Label: "Punctual", Active: true},
+	}
+	svc := NewContentService(ContentServiceConfig{Repo: repo})
+	ctx := context.Background()
+
+	before, etagBefore, _ := svc.ReviewTags(ctx, model.ReviewTagKindPositive)
+	if len(before) != 1 {
+		t.Fatalf("got %d tags, want 1", len(before))
+	}
+
+	// Cached: a second read does not hit the repository
+	svc.ReviewTags(ctx, model.ReviewTagKindPositive)
+	if repo.listCalls != 1 {
+		t.Errorf("expected cached catalog, got %d loads", repo.listCalls)
+	}
+
+	_, err := svc.CreateReviewTag(ctx, model.ReviewTagKindPositive, &model.CreateReviewTagRequest{Tag: "kind", Label: "Kind"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	after, etagAfter, _ := svc.ReviewTags(ctx, model.ReviewTagKindPositive)
+	if len(after) != 2 {
+		t.Errorf("got %d tags after create, want 2", len(after))
+	}
+	if etagAfter == etagBefore {
+		t.Error("expected ETag to change after admin write")
+	}
+
+	set, _ := svc.ReviewTagSet(ctx, model.ReviewTagKindPositive)
+	if !set["kind"] {
+		t.Error("expected new tag to be accepted on reviews")
+	}
+}
+
+func TestContentService_RemoveReviewTag(t *testing.T) {
+	repo := newMockContentRepo()
+	repo.tags[model.ReviewTagKindImprovement] = []*model.ReviewTag{
+		{Kind: model.ReviewTagKindImprovement, Tag: "late", Label: "Late", Active: true},
+		{Kind: model.ReviewTagKindImprovement, Tag: "loud", Label: "Loud", Active: true},
+	}
+	repo.tagUses["late"] = 3
+	svc := NewContentService(ContentServiceConfig{Repo: repo})
+	ctx := context.Background()
+
+	removal, err := svc.RemoveReviewTag(ctx, model.ReviewTagKindImprovement, "late")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !removal.Deprecated || removal.Deleted || removal.References != 3 {
+		t.Errorf("referenced tag: got %+v, want deprecated with 3 references", removal)
+	}
+
+	removal, err = svc.RemoveReviewTag(ctx, model.ReviewTagKindImprovement, "loud")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !removal.Deleted || removal.Deprecated {
+		t.Errorf("unused tag: got %+v, want deleted", removal)
+	}
+
+	// Deprecated tags are hidden from new reviews but keep their labels
+	set, _ := svc.ReviewTagSet(ctx, model.ReviewTagKindImprovement)
+	if set["late"] {
+		t.Error("deprecated tag should not be accepted on new reviews")
+	}
+	labels, _ := svc.ReviewTagLabels(ctx, model.ReviewTagKindImprovement)
+	if labels["late"] != "Late" {
+		t.Errorf("deprecated tag label = %q, want %q", labels["late"], "Late")
+	}
+
+	if _, err := svc.RemoveReviewTag(ctx, model.ReviewTagKind("bogus"), "late"); !errors.Is(err, ErrInvalidReviewTagKind) {
+		t.Errorf("expected ErrInvalidReviewTagKind, got %v", err)
+	}
+}
+
+func TestContentService_UpdateQuestionRejectsRemovingAnsweredOptions(t *testing.T) {
+	questions := &mockContentQuestionRepo{
+		question:    makeQuestion("question:1", true, "yes", "no"),
+		answerCount: 2,
+	}
+	svc := NewContentService(ContentServiceConfig{Repo: newMockContentRepo(), Questions: questions})
+	ctx := context.Background()
+
+	_, err := svc.UpdateQuestion(ctx, "question:1", &model.UpdateQuestionRequest{
+		Options: []model.AdminQuestionOption{{Value: "yes", Label: "Yes"}, {Value: "maybe", Label: "Maybe"}},
+	})
+	if !errors.Is(err, ErrQuestionOptionInUse) {
+		t.Fatalf("expected ErrQuestionOptionInUse, got %v", err)
+	}
+
+	// Relabelling and adding options is allowed
+	_, err = svc.UpdateQuestion(ctx, "question:1", &model.UpdateQuestionRequest{
+		Options: []model.AdminQuestionOption{
+			{Value: "yes", Label: "Absolutely", ImplicitBias: 0.5},
+			{Value: "no", Label: "No"},
+			{Value: "maybe", Label: "Maybe"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := questions.updates["options"]; !ok {
+		t.Error("expected options to be updated")
+	}
+}
+
+func TestContentService_CreateQuestionRequiresActiveCategory(t *testing.T) {
+	repo := newMockContentRepo()
+	repo.categories = []*model.QuestionCategory{
+		{Key: "values", Label: "Values", Active: true},
+		{Key: "retired", Label: "Retired", Active: false},
+	}
+	svc := NewContentService(ContentServiceConfig{Repo: repo, Questions: &mockContentQuestionRepo{}})
+	ctx := context.Background()
+
+	options := []model.AdminQuestionOption{{Value: "yes", Label: "Yes"}, {Value: "no", Label: "No"}}
+
+	for _, category := range []string{"retired", "unknown"} {
+		_, err := svc.CreateQuestion(ctx, &model.CreateQuestionRequest{Text: "Q?", Category: category, Options: options})
+		if !errors.Is(err, ErrUnknownQuestionCategory) {
+			t.Errorf("category %q: expected ErrUnknownQuestionCategory, got %v", category, err)
+		}
+	}
+
+	question, err := svc.CreateQuestion(ctx, &model.CreateQuestionRequest{Text: "Q?", Category: "values", Options: options})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if question.ID != "question:new" || question.AnswerCount != 0 {
+		t.Errorf("unexpected question: %+v", question)
+	}
+}
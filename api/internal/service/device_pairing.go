@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// devicePairingTable prefixes pairing IDs; polls for other records are refused
+const devicePairingTable = "device_pairing:"
+
+// DevicePairingRepository defines the interface for QR login storage
+type DevicePairingRepository interface {
+	Create(ctx context.Context, pairing *model.DevicePairing) error
+	GetByID(ctx context.Context, id string) (*model.DevicePairing, error)
+	Approve(ctx context.Context, id, userID string) (*model.DevicePairing, error)
+	Claim(ctx context.Context, id string) (*model.DevicePairing, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// DevicePairingUserRepository defines the user lookups device pairing needs
+type DevicePairingUserRepository interface {
+	GetByID(ctx context.Context, id string) (*model.User, error)
+}
+
+// DevicePairingService signs shared devices in with a QR code. The device
+// shows a signed, short-lived payload; a phone that is already signed in
+// approves it; the device then collects tokens by polling.
+type DevicePairingService struct {
+	repo           DevicePairingRepository
+	userRepo       DevicePairingUserRepository
+	tokenService   *TokenService
+	signingKey     []byte
+	ttl            time.Duration
+	securityEvents SecurityEventRecorder
+	clock          clock.Clock
+}
+
+// DevicePairingServiceConfig holds configuration for the device pairing service
+type DevicePairingServiceConfig struct {
+	Repo         DevicePairingRepository
+	UserRepo     DevicePairingUserRepository
+	TokenService *TokenService
+	SigningKey   []byte        // Signs QR payloads; rotating it invalidates codes on screen
+	TTL          time.Duration // 0 uses model.DefaultDevicePairingTTL
+	// SecurityEvents logs approvals; nil skips it
+	SecurityEvents SecurityEventRecorder
+	Clock          clock.Clock // Default: the system clock
+}
+
+// NewDevicePairingService creates a new device pairing service
+func NewDevicePairingService(cfg DevicePairingServiceConfig) *DevicePairingService {
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = model.DefaultDevicePairingTTL
+	}
+	return &DevicePairingService{
+		repo:           cfg.Repo,
+		userRepo:       cfg.UserRepo,
+		tokenService:   cfg.TokenService,
+		signingKey:     cfg.SigningKey,
+		ttl:            ttl,
+		securityEvents: cfg.SecurityEvents,
+		clock:          clock.OrReal(cfg.Clock),
+	}
+}
+
+// DevicePairingPollResult reports whether a QR login has been approved.
+// User and TokenPair are set once it has.
+type DevicePairingPollResult struct {
+	Status    string
+	User      *model.User
+	TokenPair *TokenPair
+}
+
+// Start creates a QR login for an unauthenticated device
+func (s *DevicePairingService) Start(ctx context.Context, req *model.StartDevicePairingRequest) (*model.DevicePairingStart, error) {
+	pollToken, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// Whole seconds, so the expiry in the payload matches the stored one
+	expiresOn := s.clock.Now().Add(s.ttl).Truncate(time.Second)
+	pairing := &model.DevicePairing{
+		DeviceName:    req.DeviceName,
+		PollTokenHash: hashToken(pollToken),
+		ExpiresOn:     expiresOn,
+	}
+	if err := s.repo.Create(ctx, pairing); err != nil {
+		return nil, err
+	}
+
+	return &model.DevicePairingStart{
+		ID:        pairing.ID,
+		Payload:   s.signPayload(pairing.ID, expiresOn),
+		PollToken: pollToken,
+		ExpiresOn: expiresOn,
+		Interval:  model.DevicePairingPollInterval,
+	}, nil
+}
+
+// Approve signs the device behind a scanned QR payload in as userID
+func (s *DevicePairingService) Approve(ctx context.Context, userID, payload string) (*model.DevicePairing, error) {
+	id, expiresOn, ok := s.verifyPayload(payload)
+	if !ok {
+		return nil, ErrInvalidPairingPayload
+	}
+	if !s.clock.Now().Before(expiresOn) {
+		return nil, ErrDevicePairingExpired
+	}
+
+	pairing, err := s.repo.Approve(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pairing == nil {
+		// Work out why the conditional update matched nothing
+		existing, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case existing == nil:
+			return nil, ErrDevicePairingNotFound
+		case existing.UserID != "":
+			return nil, ErrDevicePairingApproved
+		default:
+			return nil, ErrDevicePairingExpired
+		}
+	}
+
+	if s.securityEvents != nil {
+		s.securityEvents.Record(ctx, userID, model.SecurityEventDeviceApproved, pairing.DeviceName)
+	}
+	return pairing, nil
+}
+
+// Poll is called by the device until its QR login is approved. Tokens are
+// issued once; the pairing is then removed.
+func (s *DevicePairingService) Poll(ctx context.Context, id, pollToken string) (*DevicePairingPollResult, error) {
+	if !strings.HasPrefix(id, devicePairingTable) {
+		return nil, ErrDevicePairingNotFound
+	}
+
+	pairing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if pairing == nil {
+		return nil, ErrDevicePairingNotFound
+	}
+	if subtle.ConstantTimeCompare([]byte(hashToken(pollToken)), []byte(pairing.PollTokenHash)) != 1 {
+		return nil, ErrInvalidPollToken
+	}
+	if pairing.IsExpired(s.clock.Now()) {
+		return nil, ErrDevicePairingExpired
+	}
+	if pairing.UserID == "" {
+		return &DevicePairingPollResult{Status: model.DevicePairingPending}, nil
+	}
+
+	claimed, err := s.repo.Claim(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if claimed == nil {
+		return nil, ErrDevicePairingNotFound
+	}
+
+	user, err := s.userRepo.GetByID(ctx, claimed.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	tokenPair, err := s.tokenService.GenerateTokenPair(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevicePairingPollResult{
+		Status:    model.DevicePairingApproved,
+		User:      user,
+		TokenPair: tokenPair,
+	}, nil
+}
+
+// PurgeExpired removes pairings past their expiry. Returns how many were
+// removed.
+func (s *DevicePairingService) PurgeExpired(ctx context.Context) (int, error) {
+	return s.repo.DeleteExpired(ctx, s.clock.Now())
+}
+
+// signPayload builds the QR payload "<id>.<expiry unix>.<signature>"
+func (s *DevicePairingService) signPayload(id string, expiresOn time.Time) string {
+	message := id + "." + strconv.FormatInt(expiresOn.Unix(), 10)
+	return message + "." + s.sign(message)
+}
+
+// verifyPayload checks a QR payload's signature and returns its pairing ID
+// and expiry
+func (s *DevicePairingService) verifyPayload(payload string) (string, time.Time, bool) {
+	parts := strings.Split(payload, ".")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], devicePairingTable) {
+		return "", time.Time{}, false
+	}
+	message := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(s.sign(message))) {
+		return "", time.Time{}, false
+	}
+	expiry, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return parts[0], time.Unix(expiry, 0), true
+}
+
+func (s *DevicePairingService) sign(message string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(message))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
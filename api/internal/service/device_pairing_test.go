@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/pkg/jwt"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockDevicePairingRepo struct {
+	pairings map[string]*model.DevicePairing
+	now      func() time.Time
+}
+
+func newMockDevicePairingRepo(now func() time.Time) *mockDevicePairingRepo {
+	return &mockDevicePairingRepo{pairings: make(map[string]*model.DevicePairing), now: now}
+}
+
+func (m *mockDevicePairingRepo) Create(ctx context.Context, pairing *model.DevicePairing) error {
+	pairing.ID = "device_pairing:new"
+	pairing.CreatedOn = m.now()
+	m.pairings[pairing.ID] = pairing
+	return nil
+}
+
+func (m *mockDevicePairingRepo) GetByID(ctx context.Context, id string) (*model.DevicePairing, error) {
+	return m.pairings[id], nil
+}
+
+func (m *mockDevicePairingRepo) Approve(ctx context.Context, id, userID string) (*model.DevicePairing, error) {
+	pairing := m.pairings[id]
+	if pairing == nil || pairing.UserID != "" || pairing.IsExpired(m.now()) {
+		return nil, nil
+	}
+	approvedOn := m.now()
+	pairing.UserID = userID
+	pairing.ApprovedOn = &approvedOn
+	return pairing, nil
+}
+
+func (m *mockDevicePairingRepo) Claim(ctx context.Context, id string) (*model.DevicePairing, error) {
+	pairing := m.pairings[id]
+	if pairing == nil || pairing.UserID == "" {
+		return nil, nil
+	}
+	delete(m.pairings, id)
+	return pairing, nil
+}
+
+func (m *mockDevicePairingRepo) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	count := 0
+	for id, pairing := range m.pairings {
+		if pairing.IsExpired(now) {
+			delete(m.pairings, id)
+			count++
+		}
+	}
+	return count, nil
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+func setupDevicePairingService(t *testing.T) (*DevicePairingService, *mockDevicePairingRepo, *passkeyMockUserRepo, *mockSecurityEventRepo, *fakeclock.Clock) {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate test RSA key: %v", err)
+	}
+	tokenService := NewTokenService(TokenServiceConfig{
+		JWTService:      jwt.NewTestService(privateKey, "test-issuer", 15*time.Minute),
+		TokenRepo:       newPasskeyMockTokenRepo(),
+		RefreshDuration: 24 * time.Hour,
+	})
+
+	clk := fakeclock.New(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	repo := newMockDevicePairingRepo(clk.Now)
+	userRepo := newPasskeyMockUserRepo()
+	eventRepo := &mockSecurityEventRepo{}
+
+	svc := NewDevicePairingService(DevicePairingServiceConfig{
+		Repo:           repo,
+		UserRepo:       userRepo,
+		TokenService:   tokenService,
+		SigningKey:     []byte(strings.Repeat("k", 32)),
+		SecurityEvents: NewSecurityEventService(SecurityEventServiceConfig{Repo: eventRepo}),
+		Clock:          clk,
+	})
+	return svc, repo, userRepo, eventRepo, clk
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestDevicePairingService_Flow(t *testing.T) {
+	svc, repo, userRepo, eventRepo, _ := setupDevicePairingService(t)
+	ctx := context.Background()
+	user := createTestUser(t, userRepo, "phone@example.com")
+
+	// Shared device shows a QR code and polls
+	started, err := svc.Start(ctx, &model.StartDevicePairingRequest{DeviceName: "Lobby kiosk"})
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	if started.Payload == "" || started.PollToken == "" || started.Interval <= 0 {
+		t.Fatalf("expected a payload, poll token and interval, got %+v", started)
+	}
+	if !strings.HasPrefix(started.Payload, started.ID+".") {
+		t.Errorf("expected payload to carry the pairing id, got %q", started.Payload)
+	}
+	if !started.ExpiresOn.Equal(svc.clock.Now().Add(model.DefaultDevicePairingTTL)) {
+		t.Errorf("expected default expiry, got %v", started.ExpiresOn)
+	}
+	poll, err := svc.Poll(ctx, started.ID, started.PollToken)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if poll.Status != model.DevicePairingPending || poll.TokenPair != nil {
+		t.Errorf("expected pending without tokens, got %+v", poll)
+	}
+
+	// Signed-in phone scans and approves
+	pairing, err := svc.Approve(ctx, user.ID, started.Payload)
+	if err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if pairing.DeviceName != "Lobby kiosk" || pairing.ApprovedOn == nil {
+		t.Errorf("expected approved pairing, got %+v", pairing)
+	}
+	if len(eventRepo.events) != 1 || eventRepo.events[0].Type != model.SecurityEventDeviceApproved {
+		t.Errorf("expected a device_approved security event, got %+v", eventRepo.events)
+	}
+	if _, err := svc.Approve(ctx, user.ID, started.Payload); !errors.Is(err, ErrDevicePairingApproved) {
+		t.Errorf("expected ErrDevicePairingApproved, got %v", err)
+	}
+
+	// Only the device's poll token collects the session, once
+	if _, err := svc.Poll(ctx, started.ID, "guess"); !errors.Is(err, ErrInvalidPollToken) {
+		t.Errorf("expected ErrInvalidPollToken, got %v", err)
+	}
+	poll, err = svc.Poll(ctx, started.ID, started.PollToken)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if poll.Status != model.DevicePairingApproved || poll.TokenPair == nil || poll.User.ID != user.ID {
+		t.Errorf("expected tokens for the approving user, got %+v", poll)
+	}
+	if _, err := svc.Poll(ctx, started.ID, started.PollToken); !errors.Is(err, ErrDevicePairingNotFound) {
+		t.Errorf("expected ErrDevicePairingNotFound after collecting, got %v", err)
+	}
+	if len(repo.pairings) != 0 {
+		t.Errorf("expected pairing to be removed, %d left", len(repo.pairings))
+	}
+}
+
+func TestDevicePairingService_Approve_InvalidPayload(t *testing.T) {
+	svc, _, _, _, _ := setupDevicePairingService(t)
+	ctx := context.Background()
+
+	started, err := svc.Start(ctx, &model.StartDevicePairingRequest{})
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	parts := strings.Split(started.Payload, ".")
+
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{"empty", ""},
+		{"not a pairing", "user:1." + parts[1] + "." + parts[2]},
+		{"extended expiry", parts[0] + ".9999999999." + parts[2]},
+		{"forged signature", parts[0] + "." + parts[1] + ".AAAA"},
+		{"signed with another key", func() string {
+			other := NewDevicePairingService(DevicePairingServiceConfig{SigningKey: []byte(strings.Repeat("x", 32))})
+			return other.signPayload(started.ID, started.ExpiresOn)
+		}()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.Approve(ctx, "user:1", tt.payload); !errors.Is(err, ErrInvalidPairingPayload) {
+				t.Errorf("expected ErrInvalidPairingPayload, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDevicePairingService_Expired(t *testing.T) {
+	svc, repo, _, _, clk := setupDevicePairingService(t)
+	ctx := context.Background()
+
+	started, err := svc.Start(ctx, &model.StartDevicePairingRequest{})
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	clk.Advance(model.DefaultDevicePairingTTL + time.Second)
+
+	if _, err := svc.Approve(ctx, "user:1", started.Payload); !errors.Is(err, ErrDevicePairingExpired) {
+		t.Errorf("expected ErrDevicePairingExpired on approve, got %v", err)
+	}
+	if _, err := svc.Poll(ctx, started.ID, started.PollToken); !errors.Is(err, ErrDevicePairingExpired) {
+		t.Errorf("expected ErrDevicePairingExpired on poll, got %v", err)
+	}
+
+	purged, err := svc.PurgeExpired(ctx)
+	if err != nil {
+		t.Fatalf("PurgeExpired failed: %v", err)
+	}
+	if purged != 1 || len(repo.pairings) != 0 {
+		t.Errorf("expected 1 pairing purged, got %d (%d left)", purged, len(repo.pairings))
+	}
+	if _, err := svc.Approve(ctx, "user:1", started.Payload); !errors.Is(err, ErrDevicePairingExpired) {
+		t.Errorf("expected ErrDevicePairingExpired once purged, got %v", err)
+	}
+}
+
+func TestDevicePairingService_Poll_NotFound(t *testing.T) {
+	svc, _, _, _, _ := setupDevicePairingService(t)
+	ctx := context.Background()
+
+	for _, id := range []string{"device_pairing:missing", "user:1"} {
+		if _, err := svc.Poll(ctx, id, "token"); !errors.Is(err, ErrDevicePairingNotFound) {
+			t.Errorf("Poll(%q): expected ErrDevicePairingNotFound, got %v", id, err)
+		}
+	}
+}
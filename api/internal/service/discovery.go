@@ -13,6 +13,12 @@ type BlockChecker interface {
 	IsBlockedEitherWay(ctx context.Context, userID1, userID2 string) (bool, error)
 }
 
+// DiscoveryLanguageRepository finds which candidates speak the languages a
+// search asks for
+type DiscoveryLanguageRepository interface {
+	GetSpeakers(ctx context.Context, userIDs, languages []string) (map[string]bool, error)
+}
+
 // DiscoveryService handles global people matching across the platform
 // This service is NOT circle-bound - it finds compatible people anywhere
 type DiscoveryService struct {
@@ -21,6 +27,10 @@ type DiscoveryService struct {
 	interestRepo      InterestRepository
 	profileRepo       ProfileRepository
 	blockChecker      BlockChecker
+	completeness      CompletenessSource
+	types             HangoutTypeCatalog
+	languages         DiscoveryLanguageRepository
+	consent           ConsentChecker
 	geoService        *GeoService
 }
 
@@ -31,6 +41,10 @@ type DiscoveryServiceConfig struct {
 	InterestRepo      InterestRepository
 	ProfileRepo       ProfileRepository
 	BlockChecker      BlockChecker
+	Completeness      CompletenessSource // Optional; locks discovery until the requester's profile is complete enough
+	HangoutTypes      HangoutTypeCatalog // Optional; only the built-in hangout types can be filtered on when nil
+	LanguageRepo      DiscoveryLanguageRepository
+	Consent           ConsentChecker // Optional; location search skips consent checks when nil
 }
 
 // NewDiscoveryService creates a new discovery service
@@ -41,10 +55,19 @@ func NewDiscoveryService(cfg DiscoveryServiceConfig) *DiscoveryService {
 		interestRepo:      cfg.InterestRepo,
 		profileRepo:       cfg.ProfileRepo,
 		blockChecker:      cfg.BlockChecker,
+		completeness:      cfg.Completeness,
+		types:             cfg.HangoutTypes,
+		languages:         cfg.LanguageRepo,
+		consent:           cfg.Consent,
 		geoService:        NewGeoService(),
 	}
 }
 
+// ListHangoutTypes returns the hangout types discovery can filter on
+func (s *DiscoveryService) ListHangoutTypes(ctx context.Context) ([]*model.HangoutTypeInfo, error) {
+	return listHangoutTypes(ctx, s.types)
+}
+
 // isBlocked checks if two users have blocked each other
 func (s *DiscoveryService) isBlocked(ctx context.Context, userID1, userID2 string) bool {
 	if s.blockChecker == nil {
@@ -57,6 +80,22 @@ func (s *DiscoveryService) isBlocked(ctx context.Context, userID1, userID2 strin
 	return blocked
 }
 
+// requireCompleteProfile returns a ProfileIncompleteError while the
+// requester's profile is below the discovery threshold
+func (s *DiscoveryService) requireCompleteProfile(ctx context.Context, requesterID string) error {
+	if s.completeness == nil {
+		return nil
+	}
+	completeness, err := s.completeness.Completeness(ctx, requesterID)
+	if err != nil {
+		return err
+	}
+	if !completeness.DiscoveryUnlocked {
+		return &ProfileIncompleteError{Completeness: completeness}
+	}
+	return nil
+}
+
 // PeopleDiscoveryFilter defines criteria for finding people
 type PeopleDiscoveryFilter struct {
 	// Location-based filtering
@@ -71,6 +110,7 @@ type PeopleDiscoveryFilter struct {
 	// Activity filtering
 	HangoutTypes []model.HangoutType `json:"hangout_types,omitempty"`
 	InterestID   *string             `json:"interest_id,omitempty"` // Specific interest
+	Languages    []string            `json:"languages,omitempty"`   // ISO 639-1 codes; speaks at least one
 
 	// Matching preferences
 	MinCompatibility    float64 `json:"min_compatibility,omitempty"` // Minimum compatibility % (0-100)
@@ -94,6 +134,7 @@ type DiscoveryResult struct {
 	// Scoring components
 	CompatibilityScore float64               `json:"compatibility_score"` // 0-100
 	SharedInterests    []SharedInterestBrief `json:"shared_interests,omitempty"`
+	SharedLanguages    []string              `json:"shared_languages,omitempty"`
 	Distance           model.DistanceBucket  `json:"distance,omitempty"`
 	ActivityRecency    model.FreshnessBucket `json:"activity_recency,omitempty"`
 
@@ -101,6 +142,12 @@ type DiscoveryResult struct {
 	MatchScore float64 `json:"match_score"` // Combined weighted score
 }
 
+// isSpontaneous reports whether the result's availability is happening
+// right now
+func (r DiscoveryResult) isSpontaneous() bool {
+	return r.Availability != nil && r.Availability.Spontaneous
+}
+
 // SharedInterestBrief is a compact representation of a shared interest
 type SharedInterestBrief struct {
 	InterestID   string `json:"interest_id"`
@@ -122,6 +169,24 @@ type DiscoveryResponse struct {
 // DiscoverPeople finds compatible people based on the filter criteria
 // This is the main entry point for global people matching
 func (s *DiscoveryService) DiscoverPeople(ctx context.Context, requesterID string, filter PeopleDiscoveryFilter) (*DiscoveryResponse, error) {
+	if err := s.requireCompleteProfile(ctx, requesterID); err != nil {
+		return nil, err
+	}
+	if filter.CenterLat != nil && filter.CenterLng != nil && !hasLocationConsent(ctx, s.consent, requesterID) {
+		return nil, ErrLocationConsentRequired
+	}
+
+	for _, ht := range filter.HangoutTypes {
+		if err := resolveHangoutType(ctx, s.types, string(ht), nil); err != nil {
+			return nil, err
+		}
+	}
+	languages, ok := model.NormalizeLanguages(filter.Languages)
+	if !ok {
+		return nil, ErrInvalidLanguage
+	}
+	filter.Languages = languages
+
 	// Apply defaults
 	if filter.Limit <= 0 || filter.Limit > 50 {
 		filter.Limit = 20
@@ -165,9 +230,13 @@ func (s *DiscoveryService) DiscoverPeople(ctx context.Context, requesterID strin
 		results = filtered
 	}
 
-	// Step 4: Calculate combined match scores and sort
+	// Step 4: Calculate combined match scores and sort. People available
+	// right now come first; their windows close soon.
 	s.calculateMatchScores(results)
 	sort.Slice(results, func(i, j int) bool {
+		if si, sj := results[i].isSpontaneous(), results[j].isSpontaneous(); si != sj {
+			return si
+		}
 		return results[i].MatchScore > results[j].MatchScore
 	})
 
@@ -286,6 +355,25 @@ func (s *DiscoveryService) findCandidates(ctx context.Context, requesterID strin
 		candidates = filtered
 	}
 
+	// If languages specified, keep people who speak at least one
+	if len(filter.Languages) > 0 && len(candidates) > 0 {
+		userIDs := make([]string, len(candidates))
+		for i, c := range candidates {
+			userIDs[i] = c.UserID
+		}
+		speakers, err := s.languages.GetSpeakers(ctx, userIDs, filter.Languages)
+		if err != nil {
+			return nil, err
+		}
+		filtered := make([]*model.Availability, 0, len(candidates))
+		for _, c := range candidates {
+			if speakers[c.UserID] {
+				filtered = append(filtered, c)
+			}
+		}
+		candidates = filtered
+	}
+
 	return candidates, nil
 }
 
@@ -300,8 +388,9 @@ func (s *DiscoveryService) enrichWithScores(ctx context.Context, requesterID str
 		requesterLng = *filter.CenterLng
 	}
 
-	// Get requester's interests for matching
+	// Get requester's interests and languages for matching
 	requesterInterests, _ := s.interestRepo.GetUserInterests(ctx, requesterID)
+	requesterLanguages := s.spokenLanguages(ctx, requesterID)
 	requesterInterestSet := make(map[string]*model.UserInterest)
 	for _, ui := range requesterInterests {
 		requesterInterestSet[ui.InterestID] = ui
@@ -359,11 +448,17 @@ func (s *DiscoveryService) enrichWithScores(ctx context.Context, requesterID str
 			result.Distance = model.GetDistanceBucket(distance)
 		}
 
-		// Get public profile
+		// Get public profile. Activity recency comes from their last-active
+		// time; without one, their active availability counts as active now.
+		result.ActivityRecency = model.FreshnessBucketActiveNow
 		if s.profileRepo != nil {
 			profile, err := s.profileRepo.GetByUserID(ctx, candidate.UserID)
 			if err == nil && profile != nil {
 				result.Profile = profile.ToPublic()
+				result.SharedLanguages = model.SharedLanguages(requesterLanguages, profile.Languages)
+				if profile.LastActive != nil {
+					result.ActivityRecency = model.GetFreshnessBucket(profile.LastActive)
+				}
 			}
 		}
 
@@ -381,17 +476,27 @@ func (s *DiscoveryService) enrichWithScores(ctx context.Context, requesterID str
 			InterestID:          candidate.InterestID,
 			MaxPeople:           candidate.MaxPeople,
 			Note:                candidate.Note,
+			Spontaneous:         candidate.Spontaneous,
 		}
 
-		// Determine activity recency
-		result.ActivityRecency = model.FreshnessBucketActiveNow // They have active availability
-
 		results = append(results, result)
 	}
 
 	return results, nil
 }
 
+// spokenLanguages returns the languages on the user's profile, if any
+func (s *DiscoveryService) spokenLanguages(ctx context.Context, userID string) []string {
+	if s.profileRepo == nil {
+		return nil
+	}
+	profile, err := s.profileRepo.GetByUserID(ctx, userID)
+	if err != nil || profile == nil {
+		return nil
+	}
+	return profile.Languages
+}
+
 // calculateCompatibilityFromAnswers computes compatibility score from shared answers
 func (s *DiscoveryService) calculateCompatibilityFromAnswers(sharedAnswers map[string][2]*model.Answer) float64 {
 	if len(sharedAnswers) == 0 {
@@ -486,6 +591,14 @@ func (s *DiscoveryService) calculateMatchScores(results []DiscoveryResult) {
 		}
 		score += teachLearnBonus
 
+		// Bonus for shared languages (+5 each, max +10); people who can't
+		// talk to each other rarely hang out
+		languageBonus := float64(len(r.SharedLanguages)) * 5
+		if languageBonus > 10 {
+			languageBonus = 10
+		}
+		score += languageBonus
+
 		// Distance bonus (closer = better, up to +10)
 		distanceBonus := 0.0
 		switch r.Distance {
@@ -506,6 +619,9 @@ func (s *DiscoveryService) calculateMatchScores(results []DiscoveryResult) {
 
 // DiscoverByInterest finds people with a specific shared interest
 func (s *DiscoveryService) DiscoverByInterest(ctx context.Context, requesterID, interestID string, limit int) ([]DiscoveryResult, error) {
+	if err := s.requireCompleteProfile(ctx, requesterID); err != nil {
+		return nil, err
+	}
 	if limit <= 0 || limit > 50 {
 		limit = 20
 	}
@@ -518,6 +634,7 @@ func (s *DiscoveryService) DiscoverByInterest(ctx context.Context, requesterID,
 
 	// Get requester's interest level for this interest
 	requesterInterests, _ := s.interestRepo.GetUserInterests(ctx, requesterID)
+	requesterLanguages := s.spokenLanguages(ctx, requesterID)
 	var requesterInterest *model.UserInterest
 	for _, ui := range requesterInterests {
 		if ui.InterestID == interestID {
@@ -568,6 +685,7 @@ func (s *DiscoveryService) DiscoverByInterest(ctx context.Context, requesterID,
 			profile, err := s.profileRepo.GetByUserID(ctx, ui.UserID)
 			if err == nil && profile != nil {
 				result.Profile = profile.ToPublic()
+				result.SharedLanguages = model.SharedLanguages(requesterLanguages, profile.Languages)
 			}
 		}
 
@@ -590,6 +708,9 @@ func (s *DiscoveryService) DiscoverByInterest(ctx context.Context, requesterID,
 
 // FindTeachLearnMatches finds people with complementary teach/learn interests
 func (s *DiscoveryService) FindTeachLearnMatches(ctx context.Context, requesterID string, limit int) ([]DiscoveryResult, error) {
+	if err := s.requireCompleteProfile(ctx, requesterID); err != nil {
+		return nil, err
+	}
 	if limit <= 0 || limit > 50 {
 		limit = 20
 	}
@@ -681,6 +802,7 @@ func (s *DiscoveryService) FindTeachLearnMatches(ctx context.Context, requesterI
 	}
 
 	// Convert to slice and enrich
+	requesterLanguages := s.spokenLanguages(ctx, requesterID)
 	resultSlice := make([]DiscoveryResult, 0, len(results))
 	for _, r := range results {
 		// SECURITY: Skip blocked users
@@ -701,6 +823,7 @@ func (s *DiscoveryService) FindTeachLearnMatches(ctx context.Context, requesterI
 			profile, err := s.profileRepo.GetByUserID(ctx, r.UserID)
 			if err == nil && profile != nil {
 				r.Profile = profile.ToPublic()
+				r.SharedLanguages = model.SharedLanguages(requesterLanguages, profile.Languages)
 			}
 		}
 
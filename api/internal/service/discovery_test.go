@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
 )
 
 // ============================================================================
@@ -22,6 +25,14 @@ func (m *mockBlockChecker) IsBlockedEitherWay(ctx context.Context, userID1, user
 	return false, nil
 }
 
+type mockCompletenessSource struct {
+	completeness map[string]*model.ProfileCompleteness
+}
+
+func (m *mockCompletenessSource) Completeness(ctx context.Context, userID string) (*model.ProfileCompleteness, error) {
+	return m.completeness[userID], nil
+}
+
 // ============================================================================
 // isBlocked Tests
 // ============================================================================
@@ -326,6 +337,26 @@ func TestCalculateMatchScores_TeachLearnBonusCapped(t *testing.T) {
 	}
 }
 
+func TestCalculateMatchScores_SharedLanguagesBonusCapped(t *testing.T) {
+	t.Parallel()
+
+	svc := &DiscoveryService{}
+
+	results := []DiscoveryResult{
+		{CompatibilityScore: 50, SharedLanguages: []string{"en"}},
+		{CompatibilityScore: 50, SharedLanguages: []string{"en", "es", "fr"}},
+	}
+
+	svc.calculateMatchScores(results)
+
+	if results[0].MatchScore != 55 {
+		t.Errorf("expected match score 55 (50+5 language), got %f", results[0].MatchScore)
+	}
+	if results[1].MatchScore != 60 {
+		t.Errorf("expected match score 60 (50+10 capped languages), got %f", results[1].MatchScore)
+	}
+}
+
 func TestCalculateMatchScores_DistanceBonus(t *testing.T) {
 	t.Parallel()
 
@@ -440,3 +471,146 @@ func TestDiscoverPeople_CapsRadius(t *testing.T) {
 		t.Errorf("expected capped radius %f, got %f", MaxSearchRadiusKm, filter.RadiusKm)
 	}
 }
+
+// ============================================================================
+// Completeness Gate Tests
+// ============================================================================
+
+func TestDiscovery_LockedUntilProfileComplete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	incomplete := model.NewProfileCompleteness(model.CompletenessInput{HasBio: true})
+	svc := NewDiscoveryService(DiscoveryServiceConfig{
+		Completeness: &mockCompletenessSource{completeness: map[string]*model.ProfileCompleteness{
+			"user-1": incomplete,
+		}},
+	})
+
+	_, err := svc.DiscoverPeople(ctx, "user-1", PeopleDiscoveryFilter{})
+	var locked *ProfileIncompleteError
+	if !errors.As(err, &locked) {
+		t.Fatalf("expected ProfileIncompleteError, got %v", err)
+	}
+	if locked.Completeness.Score != 20 {
+		t.Errorf("expected score 20 reported, got %d", locked.Completeness.Score)
+	}
+	if _, err := svc.DiscoverByInterest(ctx, "user-1", "interest:1", 10); !errors.Is(err, ErrProfileIncomplete) {
+		t.Errorf("expected interest discovery locked, got %v", err)
+	}
+	if _, err := svc.FindTeachLearnMatches(ctx, "user-1", 10); !errors.Is(err, ErrProfileIncomplete) {
+		t.Errorf("expected teach/learn discovery locked, got %v", err)
+	}
+}
+
+// ============================================================================
+// Language Tests
+// ============================================================================
+
+func TestDiscoverPeople_FiltersAndRanksByLanguage(t *testing.T) {
+	t.Parallel()
+
+	languages := map[string][]string{
+		"user:me":       {"en", "es"},
+		"user:spanish":  {"es"},
+		"user:both":     {"en", "es"},
+		"user:japanese": {"ja"},
+	}
+	var asked []string
+	svc := NewDiscoveryService(DiscoveryServiceConfig{
+		AvailabilityRepo: &mocks.AvailabilityRepository{
+			GetNearbyFunc: func(ctx context.Context, minLat, maxLat, minLng, maxLng float64, startTime, endTime time.Time, excludeUserID string, limit int) ([]*model.Availability, error) {
+				return []*model.Availability{
+					{ID: "availability:1", UserID: "user:spanish"},
+					{ID: "availability:2", UserID: "user:both"},
+					{ID: "availability:3", UserID: "user:japanese"},
+				}, nil
+			},
+		},
+		InterestRepo: &mocks.InterestRepository{},
+		ProfileRepo: &mocks.ProfileRepository{
+			GetByUserIDFunc: func(ctx context.Context, userID string) (*model.UserProfile, error) {
+				return &model.UserProfile{UserID: userID, Languages: languages[userID]}, nil
+			},
+		},
+		LanguageRepo: &mocks.DiscoveryLanguageRepository{
+			GetSpeakersFunc: func(ctx context.Context, userIDs, wanted []string) (map[string]bool, error) {
+				asked = wanted
+				speakers := make(map[string]bool)
+				for _, id := range userIDs {
+					if len(model.SharedLanguages(wanted, languages[id])) > 0 {
+						speakers[id] = true
+					}
+				}
+				return speakers, nil
+			},
+		},
+	})
+	lat, lng := 40.0, -105.0
+
+	resp, err := svc.DiscoverPeople(context.Background(), "user:me", PeopleDiscoveryFilter{
+		CenterLat: &lat,
+		CenterLng: &lng,
+		Languages: []string{"ES", "en", "es"},
+	})
+	if err != nil {
+		t.Fatalf("DiscoverPeople() error = %v", err)
+	}
+
+	if len(asked) != 2 || asked[0] != "es" || asked[1] != "en" {
+		t.Errorf("filtered on %v, want normalized [es en]", asked)
+	}
+	if len(resp.Results) != 2 {
+		t.Fatalf("got %d results, want the two Spanish or English speakers", len(resp.Results))
+	}
+	if first := resp.Results[0]; first.UserID != "user:both" || len(first.SharedLanguages) != 2 {
+		t.Errorf("first = %s sharing %v, want user:both sharing two languages", first.UserID, first.SharedLanguages)
+	}
+}
+
+func TestDiscoverPeople_RejectsUnknownLanguage(t *testing.T) {
+	t.Parallel()
+
+	svc := NewDiscoveryService(DiscoveryServiceConfig{})
+
+	_, err := svc.DiscoverPeople(context.Background(), "user:me", PeopleDiscoveryFilter{Languages: []string{"english"}})
+	if !errors.Is(err, ErrInvalidLanguage) {
+		t.Errorf("DiscoverPeople() error = %v, want ErrInvalidLanguage", err)
+	}
+}
+
+func TestDiscoverPeople_LocationSearchNeedsConsent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// Nearby availability of users without consent is left out by the
+	// repository query
+	consents := locationConsents(t, "user:me")
+	svc := NewDiscoveryService(DiscoveryServiceConfig{
+		AvailabilityRepo: &mocks.AvailabilityRepository{
+			GetNearbyFunc: func(ctx context.Context, minLat, maxLat, minLng, maxLng float64, startTime, endTime time.Time, excludeUserID string, limit int) ([]*model.Availability, error) {
+				return []*model.Availability{{ID: "availability:1", UserID: "user:consented"}}, nil
+			},
+		},
+		InterestRepo: &mocks.InterestRepository{},
+		Consent:      consents,
+	})
+	lat, lng := 40.0, -105.0
+	filter := PeopleDiscoveryFilter{CenterLat: &lat, CenterLng: &lng}
+
+	resp, err := svc.DiscoverPeople(ctx, "user:me", filter)
+	if err != nil {
+		t.Fatalf("DiscoverPeople() error = %v", err)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].UserID != "user:consented" {
+		t.Errorf("results = %+v, want only user:consented", resp.Results)
+	}
+
+	// Once the requester withdraws, they can't search near a location
+	if _, err := consents.Withdraw(ctx, "user:me", model.ConsentLocationProcessing); err != nil {
+		t.Fatalf("Withdraw() error = %v", err)
+	}
+	if _, err := svc.DiscoverPeople(ctx, "user:me", filter); !errors.Is(err, ErrLocationConsentRequired) {
+		t.Errorf("DiscoverPeople() after withdrawal error = %v, want ErrLocationConsentRequired", err)
+	}
+}
@@ -30,6 +30,18 @@
 //	    ErrNotGuildMember  = errors.New("not a member of this guild")
 //	)
 //
+// # Domain Events
+//
+// Rather than calling the services that react to an action, a service
+// publishes a domain event (model.MemberJoined, model.EventCompleted,
+// model.TrustGranted) through its optional DomainEventPublisher. The
+// DomainEventBus queues one outbox entry per subscriber and the dispatcher
+// job delivers them, retrying failures with backoff:
+//
+//	SubscribeDomainEvent(bus, "resonance", resonanceService.HandleEventCompleted)
+//
+// Delivery is at-least-once, so handlers must be idempotent.
+//
 // # Example Usage
 //
 //	service := NewGuildService(GuildServiceConfig{
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/requestid"
+)
+
+// DomainEventPublisher is called by services after an action completes so
+// other services can react to it without being called directly. Publishing
+// is best-effort: implementations log failures rather than failing the
+// action.
+type DomainEventPublisher interface {
+	Publish(ctx context.Context, events ...model.DomainEvent)
+}
+
+// publishDomainEvents forwards events to the publisher when one is configured
+func publishDomainEvents(ctx context.Context, publisher DomainEventPublisher, events ...model.DomainEvent) {
+	if publisher == nil || len(events) == 0 {
+		return
+	}
+	publisher.Publish(ctx, events...)
+}
+
+// OutboxRepository defines the interface for domain event outbox storage
+type OutboxRepository interface {
+	Create(ctx context.Context, entry *model.OutboxEntry) error
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time) ([]*model.OutboxEntry, error)
+	Delete(ctx context.Context, id string) error
+	Reschedule(ctx context.Context, id, lastError string, availableOn time.Time) error
+	MarkDead(ctx context.Context, id, lastError string, deadOn time.Time) error
+}
+
+// DomainEventHandler reacts to the JSON payload of one domain event.
+// Deliveries are at-least-once, so handlers must be idempotent.
+type DomainEventHandler func(ctx context.Context, payload json.RawMessage) error
+
+// DomainEventBus decouples the services that cause something from the ones
+// that react to it. Publish writes one outbox entry per subscriber of the
+// event's type; Dispatch, run by a background job, delivers due entries and
+// retries failed ones with backoff, so a slow or failing subscriber neither
+// delays the publishing request nor affects the other subscribers.
+type DomainEventBus struct {
+	repo  OutboxRepository
+	clock clock.Clock
+
+	mu       sync.RWMutex
+	handlers map[model.DomainEventType]map[string]DomainEventHandler
+}
+
+// DomainEventBusConfig holds configuration for the domain event bus
+type DomainEventBusConfig struct {
+	Repo  OutboxRepository
+	Clock clock.Clock // Default: the system clock
+}
+
+// NewDomainEventBus creates a new domain event bus
+func NewDomainEventBus(cfg DomainEventBusConfig) *DomainEventBus {
+	return &DomainEventBus{
+		repo:     cfg.Repo,
+		clock:    clock.OrReal(cfg.Clock),
+		handlers: make(map[model.DomainEventType]map[string]DomainEventHandler),
+	}
+}
+
+// Subscribe registers a handler for an event type. subscriber names the
+// handler in the outbox, so it must stay the same across deploys; entries
+// queued for a name that is no longer subscribed are marked dead.
+func (b *DomainEventBus) Subscribe(eventType model.DomainEventType, subscriber string, handler DomainEventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.handlers[eventType] == nil {
+		b.handlers[eventType] = make(map[string]DomainEventHandler)
+	}
+	b.handlers[eventType][subscriber] = handler
+}
+
+// SubscribeDomainEvent registers a handler taking the decoded payload type,
+// which also determines the event type subscribed to
+func SubscribeDomainEvent[E model.DomainEvent](b *DomainEventBus, subscriber string, handle func(ctx context.Context, event E) error) {
+	var zero E
+	b.Subscribe(zero.DomainEventType(), subscriber, func(ctx context.Context, payload json.RawMessage) error {
+		var event E
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return fmt.Errorf("decoding %s payload: %w", zero.DomainEventType(), err)
+		}
+		return handle(ctx, event)
+	})
+}
+
+// Publish queues each event for every subscriber of its type
+func (b *DomainEventBus) Publish(ctx context.Context, events ...model.DomainEvent) {
+	now := b.clock.Now().UTC()
+	requestID := requestid.FromContext(ctx)
+	for _, event := range events {
+		eventType := event.DomainEventType()
+		subscribers := b.subscribers(eventType)
+		if len(subscribers) == 0 {
+			continue
+		}
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			log.Printf("[DomainEventBus] Failed to encode %s event: %v", eventType, err)
+			continue
+		}
+
+		for _, subscriber := range subscribers {
+			entry := &model.OutboxEntry{
+				EventType:   eventType,
+				Subscriber:  subscriber,
+				Payload:     payload,
+				AvailableOn: now,
+				OccurredOn:  now,
+				RequestID:   requestID,
+			}
+			if err := b.repo.Create(ctx, entry); err != nil {
+				log.Printf("[DomainEventBus] Failed to queue %s event for %s: %v", eventType, subscriber, err)
+			}
+		}
+	}
+}
+
+// subscribers returns the names subscribed to an event type, sorted so
+// entries are queued in a stable order
+func (b *DomainEventBus) subscribers(eventType model.DomainEventType) []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	names := make([]string, 0, len(b.handlers[eventType]))
+	for name := range b.handlers[eventType] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Dispatch delivers the outbox entries that are due. Failed deliveries are
+// rescheduled with exponential backoff until model.OutboxMaxAttempts, then
+// marked dead. Returns how many entries were delivered.
+func (b *DomainEventBus) Dispatch(ctx context.Context) (int, error) {
+	now := b.clock.Now()
+	entries, err := b.repo.ClaimDue(ctx, now, now.Add(model.OutboxClaimLease))
+	if err != nil {
+		return 0, fmt.Errorf("claiming outbox entries: %w", err)
+	}
+
+	delivered := 0
+	for _, entry := range entries {
+		if err := b.deliver(ctx, entry); err != nil {
+			b.fail(ctx, entry, err)
+			continue
+		}
+		// An entry that can't be deleted is delivered again once its lease
+		// ends, which idempotent handlers tolerate
+		if err := b.repo.Delete(ctx, entry.ID); err != nil {
+			log.Printf("[DomainEventBus] Failed to delete delivered outbox entry %s: %v", entry.ID, err)
+			continue
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+// deliver runs the entry's handler, turning a panic into an error so one bad
+// event can't stop the dispatcher
+func (b *DomainEventBus) deliver(ctx context.Context, entry *model.OutboxEntry) (err error) {
+	b.mu.RLock()
+	handler := b.handlers[entry.EventType][entry.Subscriber]
+	b.mu.RUnlock()
+	if handler == nil {
+		return fmt.Errorf("no subscriber %q for %s events", entry.Subscriber, entry.EventType)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+	return handler(requestid.NewContext(ctx, entry.RequestID), entry.Payload)
+}
+
+// fail reschedules a failed entry, or marks it dead once it has used up its
+// attempts. Attempts were already counted when the entry was claimed.
+func (b *DomainEventBus) fail(ctx context.Context, entry *model.OutboxEntry, cause error) {
+	now := b.clock.Now()
+	if entry.Attempts >= model.OutboxMaxAttempts {
+		log.Printf("[DomainEventBus] Giving up on %s event for %s after %d attempts: %v", entry.EventType, entry.Subscriber, entry.Attempts, cause)
+		if err := b.repo.MarkDead(ctx, entry.ID, cause.Error(), now); err != nil {
+			log.Printf("[DomainEventBus] Failed to mark outbox entry %s dead: %v", entry.ID, err)
+		}
+		return
+	}
+
+	if err := b.repo.Reschedule(ctx, entry.ID, cause.Error(), now.Add(outboxRetryDelay(entry.Attempts))); err != nil {
+		log.Printf("[DomainEventBus] Failed to reschedule outbox entry %s: %v", entry.ID, err)
+	}
+}
+
+// outboxRetryDelay returns how long to wait after the given failed attempt:
+// the base delay doubled for each earlier attempt, capped at the max delay
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := model.OutboxRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= model.OutboxRetryMaxDelay {
+			return model.OutboxRetryMaxDelay
+		}
+	}
+	return delay
+}
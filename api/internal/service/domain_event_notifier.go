@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DomainEventNotifier tells users about domain events that concern them,
+// over push with SSE as the fallback, and keeps guild streams up to date
+type DomainEventNotifier struct {
+	eventHub    *EventHub
+	pushService *PushService
+}
+
+// DomainEventNotifierConfig holds configuration for the domain event notifier
+type DomainEventNotifierConfig struct {
+	EventHub    *EventHub    // Optional; no SSE events when nil
+	PushService *PushService // Optional; SSE only when nil
+}
+
+// NewDomainEventNotifier creates a new domain event notifier
+func NewDomainEventNotifier(cfg DomainEventNotifierConfig) *DomainEventNotifier {
+	return &DomainEventNotifier{
+		eventHub:    cfg.EventHub,
+		pushService: cfg.PushService,
+	}
+}
+
+// HandleMemberJoined announces a new member on the guild's stream
+func (n *DomainEventNotifier) HandleMemberJoined(ctx context.Context, event model.MemberJoined) error {
+	if n.eventHub == nil {
+		return nil
+	}
+	n.eventHub.Publish(&Event{
+		Type:     EventMemberJoined,
+		CircleID: event.GuildID,
+		Data: map[string]interface{}{
+			"user_id":  event.UserID,
+			"guild_id": event.GuildID,
+		},
+	})
+	return nil
+}
+
+// HandleTrustGranted tells a user that someone trusts them
+func (n *DomainEventNotifier) HandleTrustGranted(ctx context.Context, event model.TrustGranted) error {
+	title := "Someone trusts you"
+	message := "A person you know added you to their trusted circle"
+
+	if n.pushService != nil && n.pushService.IsEnabled() {
+		notification := &PushNotification{
+			Title: title,
+			Body:  message,
+			Data: map[string]string{
+				"type":         string(EventTrustGranted),
+				"from_user_id": event.FromUserID,
+			},
+		}
+		_, err := n.pushService.SendToUser(ctx, event.ToUserID, notification)
+		if err == nil {
+			return nil
+		}
+		log.Printf("[DomainEventNotifier] Push failed for user %s, falling back to SSE: %v", event.ToUserID, err)
+	}
+
+	if n.eventHub == nil {
+		return nil
+	}
+	n.eventHub.SendToUser(event.ToUserID, Event{
+		Type: EventTrustGranted,
+		Data: map[string]interface{}{
+			"from_user_id": event.FromUserID,
+			"title":        title,
+			"message":      message,
+		},
+	})
+	return nil
+}
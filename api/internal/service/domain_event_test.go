@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/requestid"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// memoryOutbox is an in-memory outbox with the claim semantics of the
+// SurrealDB repository
+type memoryOutbox struct {
+	mu      sync.Mutex
+	entries map[string]*model.OutboxEntry
+	nextID  int
+}
+
+func newMemoryOutbox() *memoryOutbox {
+	return &memoryOutbox{entries: make(map[string]*model.OutboxEntry)}
+}
+
+func (m *memoryOutbox) Create(ctx context.Context, entry *model.OutboxEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	entry.ID = fmt.Sprintf("domain_event_outbox:%d", m.nextID)
+	stored := *entry
+	m.entries[entry.ID] = &stored
+	return nil
+}
+
+func (m *memoryOutbox) ClaimDue(ctx context.Context, now, leaseUntil time.Time) ([]*model.OutboxEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var claimed []*model.OutboxEntry
+	for i := 1; i <= m.nextID; i++ {
+		entry, ok := m.entries[fmt.Sprintf("domain_event_outbox:%d", i)]
+		if !ok || entry.DeadOn != nil || entry.AvailableOn.After(now) {
+			continue
+		}
+		entry.AvailableOn = leaseUntil
+		entry.Attempts++
+		copied := *entry
+		claimed = append(claimed, &copied)
+	}
+	return claimed, nil
+}
+
+func (m *memoryOutbox) Delete(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, id)
+	return nil
+}
+
+func (m *memoryOutbox) Reschedule(ctx context.Context, id, lastError string, availableOn time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[id].LastError = &lastError
+	m.entries[id].AvailableOn = availableOn
+	return nil
+}
+
+func (m *memoryOutbox) MarkDead(ctx context.Context, id, lastError string, deadOn time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[id].LastError = &lastError
+	m.entries[id].DeadOn = &deadOn
+	return nil
+}
+
+func (m *memoryOutbox) all() []*model.OutboxEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*model.OutboxEntry
+	for i := 1; i <= m.nextID; i++ {
+		if entry, ok := m.entries[fmt.Sprintf("domain_event_outbox:%d", i)]; ok {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// recordingPublisher collects published events
+type recordingPublisher struct {
+	events []model.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, events ...model.DomainEvent) {
+	p.events = append(p.events, events...)
+}
+
+func newTestDomainEventBus() (*DomainEventBus, *memoryOutbox, *fakeclock.Clock) {
+	outbox := newMemoryOutbox()
+	clk := fakeclock.New(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	bus := NewDomainEventBus(DomainEventBusConfig{Repo: outbox, Clock: clk})
+	return bus, outbox, clk
+}
+
+// ============================================================================
+// Publish Tests
+// ============================================================================
+
+func TestDomainEventBus_Publish_QueuesPerSubscriber(t *testing.T) {
+	t.Parallel()
+
+	bus, outbox, _ := newTestDomainEventBus()
+	noop := func(ctx context.Context, event model.TrustGranted) error { return nil }
+	SubscribeDomainEvent(bus, "notifications", noop)
+	SubscribeDomainEvent(bus, "analytics", noop)
+
+	bus.Publish(context.Background(),
+		model.TrustGranted{FromUserID: "user:a", ToUserID: "user:b"},
+		model.MemberJoined{UserID: "user:a", GuildID: "guild:1"}, // No subscribers
+	)
+
+	entries := outbox.all()
+	if len(entries) != 2 {
+		t.Fatalf("expected one entry per subscriber, got %d", len(entries))
+	}
+	if entries[0].Subscriber != "analytics" || entries[1].Subscriber != "notifications" {
+		t.Errorf("expected entries for analytics and notifications, got %s and %s", entries[0].Subscriber, entries[1].Subscriber)
+	}
+	for _, entry := range entries {
+		if entry.EventType != model.DomainEventTrustGranted {
+			t.Errorf("expected %s, got %s", model.DomainEventTrustGranted, entry.EventType)
+		}
+		if string(entry.Payload) != `{"from_user_id":"user:a","to_user_id":"user:b"}` {
+			t.Errorf("unexpected payload %s", entry.Payload)
+		}
+	}
+}
+
+func TestPublishDomainEvents_NilPublisher(t *testing.T) {
+	t.Parallel()
+
+	// Must not panic
+	publishDomainEvents(context.Background(), nil, model.TrustGranted{})
+}
+
+// ============================================================================
+// Dispatch Tests
+// ============================================================================
+
+func TestDomainEventBus_Dispatch_DeliversAndDeletes(t *testing.T) {
+	t.Parallel()
+
+	bus, outbox, _ := newTestDomainEventBus()
+	var got []model.MemberJoined
+	SubscribeDomainEvent(bus, "notifications", func(ctx context.Context, event model.MemberJoined) error {
+		got = append(got, event)
+		return nil
+	})
+
+	want := model.MemberJoined{UserID: "user:a", GuildID: "guild:1", GuildName: "Hikers"}
+	bus.Publish(context.Background(), want)
+
+	delivered, err := bus.Dispatch(context.Background())
+	if err != nil {
+		t.Fatalf("Dispatch failed: %v", err)
+	}
+	if delivered != 1 || len(got) != 1 || got[0] != want {
+		t.Errorf("expected %+v delivered once, got %d deliveries: %+v", want, delivered, got)
+	}
+	if remaining := outbox.all(); len(remaining) != 0 {
+		t.Errorf("expected delivered entries to be deleted, %d remain", len(remaining))
+	}
+}
+
+func TestDomainEventBus_Dispatch_CarriesRequestID(t *testing.T) {
+	t.Parallel()
+
+	bus, outbox, _ := newTestDomainEventBus()
+	var got string
+	SubscribeDomainEvent(bus, "notifications", func(ctx context.Context, event model.MemberJoined) error {
+		got = requestid.FromContext(ctx)
+		return nil
+	})
+
+	bus.Publish(requestid.NewContext(context.Background(), "req-42"), model.MemberJoined{UserID: "user:a", GuildID: "guild:1"})
+	if entries := outbox.all(); len(entries) != 1 || entries[0].RequestID != "req-42" {
+		t.Fatalf("expected the entry to record the request ID, got %+v", entries)
+	}
+
+	if _, err := bus.Dispatch(context.Background()); err != nil {
+		t.Fatalf("Dispatch failed: %v", err)
+	}
+	if got != "req-42" {
+		t.Errorf("handler saw request ID %q, want req-42", got)
+	}
+}
+
+func TestDomainEventBus_Dispatch_RetriesOnlyFailedSubscriber(t *testing.T) {
+	t.Parallel()
+
+	bus, outbox, clk := newTestDomainEventBus()
+	healthyCalls, flakyCalls := 0, 0
+	SubscribeDomainEvent(bus, "healthy", func(ctx context.Context, event model.TrustGranted) error {
+		healthyCalls++
+		return nil
+	})
+	SubscribeDomainEvent(bus, "flaky", func(ctx context.Context, event model.TrustGranted) error {
+		flakyCalls++
+		if flakyCalls == 1 {
+			return errors.New("push provider unavailable")
+		}
+		return nil
+	})
+	bus.Publish(context.Background(), model.TrustGranted{FromUserID: "user:a", ToUserID: "user:b"})
+
+	if delivered, _ := bus.Dispatch(context.Background()); delivered != 1 {
+		t.Fatalf("expected only the healthy subscriber delivered, got %d", delivered)
+	}
+	entries := outbox.all()
+	if len(entries) != 1 || entries[0].LastError == nil || *entries[0].LastError != "push provider unavailable" {
+		t.Fatalf("expected the failed entry kept with its error, got %+v", entries)
+	}
+
+	// Not due again before the backoff has passed
+	clk.Advance(model.OutboxRetryBaseDelay - time.Second)
+	if delivered, _ := bus.Dispatch(context.Background()); delivered != 0 {
+		t.Errorf("expected no delivery during backoff, got %d", delivered)
+	}
+
+	clk.Advance(time.Second)
+	if delivered, _ := bus.Dispatch(context.Background()); delivered != 1 {
+		t.Errorf("expected the retry to deliver, got %d", delivered)
+	}
+	if healthyCalls != 1 || flakyCalls != 2 {
+		t.Errorf("expected healthy called once and flaky twice, got %d and %d", healthyCalls, flakyCalls)
+	}
+	if remaining := outbox.all(); len(remaining) != 0 {
+		t.Errorf("expected an empty outbox, %d entries remain", len(remaining))
+	}
+}
+
+func TestDomainEventBus_Dispatch_MarksDeadAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	bus, outbox, clk := newTestDomainEventBus()
+	calls := 0
+	SubscribeDomainEvent(bus, "resonance", func(ctx context.Context, event model.EventCompleted) error {
+		calls++
+		panic("broken handler")
+	})
+	bus.Publish(context.Background(), model.EventCompleted{EventID: "event:1", UserID: "user:a"})
+
+	for i := 0; i < model.OutboxMaxAttempts+2; i++ {
+		if _, err := bus.Dispatch(context.Background()); err != nil {
+			t.Fatalf("Dispatch failed: %v", err)
+		}
+		clk.Advance(model.OutboxRetryMaxDelay)
+	}
+
+	if calls != model.OutboxMaxAttempts {
+		t.Errorf("expected %d attempts, got %d", model.OutboxMaxAttempts, calls)
+	}
+	entries := outbox.all()
+	if len(entries) != 1 || entries[0].DeadOn == nil {
+		t.Fatalf("expected the entry kept as dead, got %+v", entries)
+	}
+	if *entries[0].LastError != "handler panicked: broken handler" {
+		t.Errorf("unexpected last error %q", *entries[0].LastError)
+	}
+}
+
+func TestDomainEventBus_Dispatch_UnknownSubscriber(t *testing.T) {
+	t.Parallel()
+
+	bus, outbox, _ := newTestDomainEventBus()
+	_ = outbox.Create(context.Background(), &model.OutboxEntry{
+		EventType:  model.DomainEventMemberJoined,
+		Subscriber: "retired",
+		Payload:    []byte(`{}`),
+	})
+
+	if delivered, _ := bus.Dispatch(context.Background()); delivered != 0 {
+		t.Errorf("expected nothing delivered, got %d", delivered)
+	}
+	entries := outbox.all()
+	if len(entries) != 1 || entries[0].LastError == nil {
+		t.Fatalf("expected the entry kept with an error, got %+v", entries)
+	}
+}
+
+func TestDomainEventBus_Dispatch_ClaimError(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.OutboxRepository{
+		ClaimDueFunc: func(ctx context.Context, now, leaseUntil time.Time) ([]*model.OutboxEntry, error) {
+			return nil, errors.New("db down")
+		},
+	}
+	bus := NewDomainEventBus(DomainEventBusConfig{Repo: repo})
+
+	if _, err := bus.Dispatch(context.Background()); err == nil {
+		t.Error("expected the claim error to be returned")
+	}
+}
+
+func TestOutboxRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, 1 * time.Minute},
+		{4, 4 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, 1 * time.Hour},
+		{40, 1 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := outboxRetryDelay(tt.attempts); got != tt.want {
+			t.Errorf("outboxRetryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+// ============================================================================
+// Publisher Tests
+// ============================================================================
+
+func TestGrantTrust_PublishesTrustGranted(t *testing.T) {
+	t.Parallel()
+
+	events := &recordingPublisher{}
+	svc := NewTrustService(&mocks.TrustRepository{}, nil, events)
+
+	if _, err := svc.GrantTrust(context.Background(), "user:a", "user:b"); err != nil {
+		t.Fatalf("GrantTrust failed: %v", err)
+	}
+
+	want := model.TrustGranted{FromUserID: "user:a", ToUserID: "user:b"}
+	if len(events.events) != 1 || events.events[0] != want {
+		t.Errorf("expected %+v published, got %+v", want, events.events)
+	}
+}
+
+func TestConfirmCompletion_PublishesOnlyWhenCompleted(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+	checkin := start.Add(5 * time.Minute)
+	var updates []map[string]interface{}
+	repo := &mocks.EventRepository{
+		GetRSVPFunc: func(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+			return &model.EventRSVP{ID: "event_rsvp:1", EventID: eventID, UserID: userID, CheckinTime: &checkin}, nil
+		},
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return &model.Event{ID: eventID, StartTime: start}, nil
+		},
+		UpdateRSVPFunc: func(ctx context.Context, rsvpID string, fields map[string]interface{}) (*model.EventRSVP, error) {
+			updates = append(updates, fields)
+			return nil, nil
+		},
+	}
+	events := &recordingPublisher{}
+	svc := NewEventService(repo, nil, nil, nil, nil, nil, nil, nil, nil, events, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	if err := svc.ConfirmCompletion(context.Background(), "user:a", "event:1", false); err != nil {
+		t.Fatalf("ConfirmCompletion(false) failed: %v", err)
+	}
+	if len(events.events) != 0 || updates[0]["completion_confirmed"] != nil {
+		t.Fatalf("expected the confirmation cleared without an event, got %v and %+v", updates[0], events.events)
+	}
+
+	if err := svc.ConfirmCompletion(context.Background(), "user:a", "event:1", true); err != nil {
+		t.Fatalf("ConfirmCompletion(true) failed: %v", err)
+	}
+	if _, ok := updates[1]["completion_confirmed"].(time.Time); !ok {
+		t.Errorf("expected completion_confirmed set to a time, got %v", updates[1]["completion_confirmed"])
+	}
+	if len(events.events) != 1 {
+		t.Fatalf("expected one event published, got %d", len(events.events))
+	}
+	completed := events.events[0].(model.EventCompleted)
+	if completed.EventID != "event:1" || completed.UserID != "user:a" || !completed.StartTime.Equal(start) || completed.CheckinTime != &checkin {
+		t.Errorf("unexpected payload %+v", completed)
+	}
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DraftRepository defines the interface for draft storage
+type DraftRepository interface {
+	Create(ctx context.Context, draft *model.Draft) error
+	GetByID(ctx context.Context, id string) (*model.Draft, error)
+	ListByUser(ctx context.Context, userID string, resourceType *model.DraftResourceType, now time.Time) ([]*model.Draft, error)
+	CountByUser(ctx context.Context, userID string, now time.Time) (int, error)
+	UpdateData(ctx context.Context, id string, data map[string]interface{}, expiresOn time.Time) (*model.Draft, error)
+	SetErrors(ctx context.Context, id string, fieldErrors []model.FieldError) error
+	Delete(ctx context.Context, id string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// DraftEventCreator creates the event a submitted draft describes
+type DraftEventCreator interface {
+	CreateEvent(ctx context.Context, userID string, req *model.CreateEventRequest) (*model.Event, error)
+}
+
+// DraftAdventureCreator creates the adventure a submitted draft describes
+type DraftAdventureCreator interface {
+	Create(ctx context.Context, userID string, req *model.CreateAdventureRequest) (*model.Adventure, error)
+}
+
+// DraftService keeps users' half-finished create forms so they can resume
+// them later. Submitting a draft runs the normal create path; validation
+// errors are recorded on the draft against the fields that caused them.
+type DraftService struct {
+	repo       DraftRepository
+	events     DraftEventCreator
+	adventures DraftAdventureCreator
+	clock      clock.Clock
+}
+
+// DraftServiceConfig holds configuration for the draft service
+type DraftServiceConfig struct {
+	Repo             DraftRepository
+	EventCreator     DraftEventCreator
+	AdventureCreator DraftAdventureCreator
+	Clock            clock.Clock // Default: the system clock
+}
+
+// NewDraftService creates a new draft service
+func NewDraftService(cfg DraftServiceConfig) *DraftService {
+	return &DraftService{
+		repo:       cfg.Repo,
+		events:     cfg.EventCreator,
+		adventures: cfg.AdventureCreator,
+		clock:      clock.OrReal(cfg.Clock),
+	}
+}
+
+// Save starts a new draft
+func (s *DraftService) Save(ctx context.Context, userID string, req *model.SaveDraftRequest) (*model.Draft, error) {
+	now := s.clock.Now()
+
+	count, err := s.repo.CountByUser(ctx, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("counting drafts: %w", err)
+	}
+	if count >= model.MaxDraftsPerUser {
+		return nil, ErrMaxDraftsReached
+	}
+
+	draft := &model.Draft{
+		UserID:       userID,
+		ResourceType: req.ResourceType,
+		Data:         req.Data,
+		ExpiresOn:    now.AddDate(0, 0, model.DraftTTLDays),
+	}
+	if err := s.repo.Create(ctx, draft); err != nil {
+		return nil, fmt.Errorf("creating draft: %w", err)
+	}
+	return draft, nil
+}
+
+// Get returns one of the user's drafts
+func (s *DraftService) Get(ctx context.Context, userID, id string) (*model.Draft, error) {
+	return s.load(ctx, userID, id)
+}
+
+// List returns the user's drafts, most recently saved first
+func (s *DraftService) List(ctx context.Context, userID string, resourceType *model.DraftResourceType) ([]*model.Draft, error) {
+	return s.repo.ListByUser(ctx, userID, resourceType, s.clock.Now())
+}
+
+// Update replaces a draft's data. Each save pushes the expiry back.
+func (s *DraftService) Update(ctx context.Context, userID, id string, req *model.UpdateDraftRequest) (*model.Draft, error) {
+	if _, err := s.load(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	draft, err := s.repo.UpdateData(ctx, id, req.Data, s.clock.Now().AddDate(0, 0, model.DraftTTLDays))
+	if err != nil {
+		return nil, fmt.Errorf("updating draft: %w", err)
+	}
+	if draft == nil {
+		return nil, ErrDraftNotFound
+	}
+	return draft, nil
+}
+
+// Delete discards a draft
+func (s *DraftService) Delete(ctx context.Context, userID, id string) error {
+	if _, err := s.load(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// Submit creates the resource a draft describes and discards the draft. If
+// the data doesn't pass validation the draft is kept, the field errors are
+// saved on it and a validation problem is returned.
+func (s *DraftService) Submit(ctx context.Context, userID, id string) (*model.DraftSubmission, error) {
+	draft, err := s.load(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var submission *model.DraftSubmission
+	var fieldErrors []model.FieldError
+
+	switch draft.ResourceType {
+	case model.DraftResourceEvent:
+		submission, fieldErrors, err = s.submitEvent(ctx, userID, draft)
+	case model.DraftResourceAdventure:
+		submission, fieldErrors, err = s.submitAdventure(ctx, userID, draft)
+	default:
+		fieldErrors = []model.FieldError{{Field: "resource_type", Message: "resource_type must be event or adventure"}}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldErrors) > 0 {
+		if err := s.repo.SetErrors(ctx, draft.ID, fieldErrors); err != nil {
+			return nil, fmt.Errorf("recording draft errors: %w", err)
+		}
+		return nil, model.NewValidationError(fieldErrors)
+	}
+
+	if err := s.repo.Delete(ctx, draft.ID); err != nil {
+		log.Printf("[DraftService] Failed to delete submitted draft %s: %v", draft.ID, err)
+	}
+	return submission, nil
+}
+
+// CleanupExpired removes drafts past their expiry. This should be called
+// periodically by a background job.
+func (s *DraftService) CleanupExpired(ctx context.Context) (int, error) {
+	return s.repo.DeleteExpired(ctx, s.clock.Now())
+}
+
+func (s *DraftService) submitEvent(ctx context.Context, userID string, draft *model.Draft) (*model.DraftSubmission, []model.FieldError, error) {
+	var req model.CreateEventRequest
+	if fieldErrors := decodeDraftData(draft.Data, &req, func() interface{} { return &model.CreateEventRequest{} }); len(fieldErrors) > 0 {
+		return nil, fieldErrors, nil
+	}
+	if fieldErrors := req.Validate(); len(fieldErrors) > 0 {
+		return nil, fieldErrors, nil
+	}
+
+	event, err := s.events.CreateEvent(ctx, userID, &req)
+	if err != nil {
+		if fieldErrors := draftFieldErrors(err); len(fieldErrors) > 0 {
+			return nil, fieldErrors, nil
+		}
+		return nil, nil, err
+	}
+
+	return &model.DraftSubmission{
+		ResourceType: model.DraftResourceEvent,
+		ResourceID:   event.ID,
+		Resource:     event,
+	}, nil, nil
+}
+
+func (s *DraftService) submitAdventure(ctx context.Context, userID string, draft *model.Draft) (*model.DraftSubmission, []model.FieldError, error) {
+	var req model.CreateAdventureRequest
+	if fieldErrors := decodeDraftData(draft.Data, &req, func() interface{} { return &model.CreateAdventureRequest{} }); len(fieldErrors) > 0 {
+		return nil, fieldErrors, nil
+	}
+
+	adventure, err := s.adventures.Create(ctx, userID, &req)
+	if err != nil {
+		if fieldErrors := draftFieldErrors(err); len(fieldErrors) > 0 {
+			return nil, fieldErrors, nil
+		}
+		return nil, nil, err
+	}
+
+	return &model.DraftSubmission{
+		ResourceType: model.DraftResourceAdventure,
+		ResourceID:   adventure.ID,
+		Resource:     adventure,
+	}, nil, nil
+}
+
+// load fetches a draft owned by the user. Other users' drafts and expired
+// drafts are reported as not found.
+func (s *DraftService) load(ctx context.Context, userID, id string) (*model.Draft, error) {
+	draft, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting draft: %w", err)
+	}
+	if draft == nil || draft.UserID != userID || !draft.ExpiresOn.After(s.clock.Now()) {
+		return nil, ErrDraftNotFound
+	}
+	return draft, nil
+}
+
+// decodeDraftData decodes draft data into a create request the same way the
+// create endpoint decodes its body. When that fails, each field is decoded on
+// its own into a fresh request so the error can be pinned to the field.
+func decodeDraftData(data map[string]interface{}, dst interface{}, fresh func() interface{}) []model.FieldError {
+	if decodeStrict(data, dst) == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var fieldErrors []model.FieldError
+	for _, key := range keys {
+		err := decodeStrict(map[string]interface{}{key: data[key]}, fresh())
+		if err == nil {
+			continue
+		}
+		message := fmt.Sprintf("%s has an invalid value", key)
+		if strings.Contains(err.Error(), "unknown field") {
+			message = fmt.Sprintf("%s is not a known field", key)
+		}
+		fieldErrors = append(fieldErrors, model.FieldError{Field: key, Message: message})
+	}
+	if len(fieldErrors) == 0 {
+		fieldErrors = []model.FieldError{{Field: "data", Message: "data is not a valid request"}}
+	}
+	return fieldErrors
+}
+
+func decodeStrict(data map[string]interface{}, dst interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(dst)
+}
+
+// draftFieldErrors maps a create error back to draft fields. Errors that
+// aren't about the submitted data return nil.
+func draftFieldErrors(err error) []model.FieldError {
+	if errors.Is(err, ErrPublishAtInvalid) {
+		return []model.FieldError{{Field: "publish_at", Message: err.Error()}}
+	}
+	var pd *model.ProblemDetails
+	if errors.As(err, &pd) && len(pd.Errors) > 0 {
+		return pd.Errors
+	}
+	return nil
+}
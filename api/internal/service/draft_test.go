@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockDraftRepo struct {
+	drafts    map[string]*model.Draft
+	count     int
+	setErrors []model.FieldError
+	deleted   []string
+}
+
+func newMockDraftRepo(drafts ...*model.Draft) *mockDraftRepo {
+	repo := &mockDraftRepo{drafts: make(map[string]*model.Draft)}
+	for _, d := range drafts {
+		repo.drafts[d.ID] = d
+	}
+	return repo
+}
+
+func (m *mockDraftRepo) Create(ctx context.Context, draft *model.Draft) error {
+	draft.ID = "draft:new"
+	m.drafts[draft.ID] = draft
+	return nil
+}
+
+func (m *mockDraftRepo) GetByID(ctx context.Context, id string) (*model.Draft, error) {
+	return m.drafts[id], nil
+}
+
+func (m *mockDraftRepo) ListByUser(ctx context.Context, userID string, resourceType *model.DraftResourceType, now time.Time) ([]*model.Draft, error) {
+	return nil, nil
+}
+
+func (m *mockDraftRepo) CountByUser(ctx context.Context, userID string, now time.Time) (int, error) {
+	return m.count, nil
+}
+
+func (m *mockDraftRepo) UpdateData(ctx context.Context, id string, data map[string]interface{}, expiresOn time.Time) (*model.Draft, error) {
+	draft := m.drafts[id]
+	if draft == nil {
+		return nil, nil
+	}
+	draft.Data = data
+	draft.Errors = nil
+	draft.ExpiresOn = expiresOn
+	return draft, nil
+}
+
+func (m *mockDraftRepo) SetErrors(ctx context.Context, id string, fieldErrors []model.FieldError) error {
+	m.setErrors = fieldErrors
+	return nil
+}
+
+func (m *mockDraftRepo) Delete(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	delete(m.drafts, id)
+	return nil
+}
+
+func (m *mockDraftRepo) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
+
+type mockDraftEventCreator struct {
+	err     error
+	created *model.CreateEventRequest
+}
+
+func (m *mockDraftEventCreator) CreateEvent(ctx context.Context, userID string, req *model.CreateEventRequest) (*model.Event, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.created = req
+	return &model.Event{ID: "event:1", Title: req.Title, CreatedBy: userID}, nil
+}
+
+type mockDraftAdventureCreator struct {
+	err error
+}
+
+func (m *mockDraftAdventureCreator) Create(ctx context.Context, userID string, req *model.CreateAdventureRequest) (*model.Adventure, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &model.Adventure{ID: "adventure:1", Title: req.Title}, nil
+}
+
+var draftTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestDraftService(repo *mockDraftRepo, events *mockDraftEventCreator, adventures *mockDraftAdventureCreator) *DraftService {
+	return NewDraftService(DraftServiceConfig{
+		Repo:             repo,
+		EventCreator:     events,
+		AdventureCreator: adventures,
+		Clock:            fakeclock.New(draftTestNow),
+	})
+}
+
+func newTestDraft(resourceType model.DraftResourceType, data map[string]interface{}) *model.Draft {
+	return &model.Draft{
+		ID:           "draft:1",
+		UserID:       "user:1",
+		ResourceType: resourceType,
+		Data:         data,
+		ExpiresOn:    draftTestNow.Add(24 * time.Hour),
+	}
+}
+
+func assertDraftFieldError(t *testing.T, err error, field string) {
+	t.Helper()
+	var pd *model.ProblemDetails
+	if !errors.As(err, &pd) || pd.Status != 422 {
+		t.Fatalf("expected validation problem, got %v", err)
+	}
+	for _, fe := range pd.Errors {
+		if fe.Field == field {
+			return
+		}
+	}
+	t.Errorf("expected an error on %s, got %+v", field, pd.Errors)
+}
+
+// ============================================================================
+// Save / Load Tests
+// ============================================================================
+
+func TestDraftSave_LimitReached(t *testing.T) {
+	t.Parallel()
+
+	repo := newMockDraftRepo()
+	repo.count = model.MaxDraftsPerUser
+	svc := newTestDraftService(repo, &mockDraftEventCreator{}, &mockDraftAdventureCreator{})
+
+	_, err := svc.Save(context.Background(), "user:1", &model.SaveDraftRequest{
+		ResourceType: model.DraftResourceEvent,
+		Data:         map[string]interface{}{"title": "Picnic"},
+	})
+	if !errors.Is(err, ErrMaxDraftsReached) {
+		t.Errorf("expected ErrMaxDraftsReached, got %v", err)
+	}
+}
+
+func TestDraftSave_SetsExpiry(t *testing.T) {
+	t.Parallel()
+
+	svc := newTestDraftService(newMockDraftRepo(), &mockDraftEventCreator{}, &mockDraftAdventureCreator{})
+
+	draft, err := svc.Save(context.Background(), "user:1", &model.SaveDraftRequest{
+		ResourceType: model.DraftResourceEvent,
+		Data:         map[string]interface{}{"title": "Picnic"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := draftTestNow.AddDate(0, 0, model.DraftTTLDays); !draft.ExpiresOn.Equal(want) {
+		t.Errorf("expected expiry %v, got %v", want, draft.ExpiresOn)
+	}
+}
+
+func TestDraftGet_HidesOtherUsersAndExpiredDrafts(t *testing.T) {
+	t.Parallel()
+
+	expired := newTestDraft(model.DraftResourceEvent, map[string]interface{}{})
+	expired.ID = "draft:2"
+	expired.ExpiresOn = draftTestNow.Add(-time.Minute)
+	repo := newMockDraftRepo(newTestDraft(model.DraftResourceEvent, map[string]interface{}{}), expired)
+	svc := newTestDraftService(repo, &mockDraftEventCreator{}, &mockDraftAdventureCreator{})
+
+	if _, err := svc.Get(context.Background(), "user:2", "draft:1"); !errors.Is(err, ErrDraftNotFound) {
+		t.Errorf("expected ErrDraftNotFound for another user's draft, got %v", err)
+	}
+	if _, err := svc.Get(context.Background(), "user:1", "draft:2"); !errors.Is(err, ErrDraftNotFound) {
+		t.Errorf("expected ErrDraftNotFound for an expired draft, got %v", err)
+	}
+}
+
+// ============================================================================
+// Submit Tests
+// ============================================================================
+
+func TestDraftSubmit_CreatesEventAndDeletesDraft(t *testing.T) {
+	t.Parallel()
+
+	repo := newMockDraftRepo(newTestDraft(model.DraftResourceEvent, map[string]interface{}{
+		"title":      "Picnic",
+		"start_time": "2025-06-10T18:00:00Z",
+	}))
+	events := &mockDraftEventCreator{}
+	svc := newTestDraftService(repo, events, &mockDraftAdventureCreator{})
+
+	submission, err := svc.Submit(context.Background(), "user:1", "draft:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if submission.ResourceType != model.DraftResourceEvent || submission.ResourceID != "event:1" {
+		t.Errorf("unexpected submission %+v", submission)
+	}
+	if events.created == nil || events.created.StartTime.IsZero() {
+		t.Error("expected the event to be created from the draft data")
+	}
+	if len(repo.deleted) != 1 || repo.deleted[0] != "draft:1" {
+		t.Errorf("expected the draft to be deleted, got %v", repo.deleted)
+	}
+}
+
+func TestDraftSubmit_RecordsValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	repo := newMockDraftRepo(newTestDraft(model.DraftResourceEvent, map[string]interface{}{
+		"start_time": "2025-06-10T18:00:00Z",
+	}))
+	svc := newTestDraftService(repo, &mockDraftEventCreator{}, &mockDraftAdventureCreator{})
+
+	_, err := svc.Submit(context.Background(), "user:1", "draft:1")
+	assertDraftFieldError(t, err, "title")
+	if len(repo.setErrors) != 1 || repo.setErrors[0].Field != "title" {
+		t.Errorf("expected the title error to be saved on the draft, got %+v", repo.setErrors)
+	}
+	if len(repo.deleted) != 0 {
+		t.Error("expected the draft to be kept")
+	}
+}
+
+func TestDraftSubmit_PinsDecodeErrorsToFields(t *testing.T) {
+	t.Parallel()
+
+	repo := newMockDraftRepo(newTestDraft(model.DraftResourceEvent, map[string]interface{}{
+		"title":      "Picnic",
+		"start_time": "next tuesday",
+		"mood":       "sunny",
+	}))
+	svc := newTestDraftService(repo, &mockDraftEventCreator{}, &mockDraftAdventureCreator{})
+
+	_, err := svc.Submit(context.Background(), "user:1", "draft:1")
+	assertDraftFieldError(t, err, "start_time")
+	assertDraftFieldError(t, err, "mood")
+	for _, fe := range repo.setErrors {
+		if fe.Field == "title" {
+			t.Error("expected no error on a valid field")
+		}
+	}
+}
+
+func TestDraftSubmit_MapsCreateErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		resourceType model.DraftResourceType
+		data         map[string]interface{}
+		eventErr     error
+		adventureErr error
+		field        string
+	}{
+		{
+			name:         "event publish_at",
+			resourceType: model.DraftResourceEvent,
+			data:         map[string]interface{}{"title": "Picnic", "start_time": "2025-06-10T18:00:00Z", "publish_at": "2025-05-01T00:00:00Z"},
+			eventErr:     ErrPublishAtInvalid,
+			field:        "publish_at",
+		},
+		{
+			name:         "adventure validation",
+			resourceType: model.DraftResourceAdventure,
+			data:         map[string]interface{}{"title": "Road trip"},
+			adventureErr: model.NewValidationError([]model.FieldError{{Field: "start_date", Message: "start_date is required"}}),
+			field:        "start_date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := newMockDraftRepo(newTestDraft(tt.resourceType, tt.data))
+			svc := newTestDraftService(repo, &mockDraftEventCreator{err: tt.eventErr}, &mockDraftAdventureCreator{err: tt.adventureErr})
+
+			_, err := svc.Submit(context.Background(), "user:1", "draft:1")
+			assertDraftFieldError(t, err, tt.field)
+			if len(repo.setErrors) == 0 {
+				t.Error("expected errors to be saved on the draft")
+			}
+		})
+	}
+}
+
+func TestDraftSubmit_PassesThroughOtherErrors(t *testing.T) {
+	t.Parallel()
+
+	repo := newMockDraftRepo(newTestDraft(model.DraftResourceAdventure, map[string]interface{}{"title": "Road trip"}))
+	forbidden := model.NewForbiddenError("must be guild member to create guild adventure")
+	svc := newTestDraftService(repo, &mockDraftEventCreator{}, &mockDraftAdventureCreator{err: forbidden})
+
+	_, err := svc.Submit(context.Background(), "user:1", "draft:1")
+	if err != forbidden {
+		t.Errorf("expected the forbidden problem to pass through, got %v", err)
+	}
+	if len(repo.setErrors) != 0 || len(repo.deleted) != 0 {
+		t.Error("expected the draft to be left untouched")
+	}
+}
@@ -1,6 +1,11 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
 
 // Centralized service layer errors.
 // All errors returned by service methods are defined here for consistency
@@ -16,6 +21,58 @@ var (
 	ErrPasswordTooLong        = errors.New("password must be at most 128 characters")
 	ErrInvalidEmail           = errors.New("invalid email format")
 	ErrAccountLinkingRequired = errors.New("account linking required")
+	ErrPasswordTooWeak        = errors.New("password is too easy to guess")
+	ErrPasswordBreached       = errors.New("password has appeared in a data breach")
+	ErrReauthRequired         = errors.New("current password or a recent sign-in is required")
+)
+
+// WeakPasswordError is returned when a password is long enough but too
+// guessable, or is known from a breach. Strength carries the guidance.
+type WeakPasswordError struct {
+	Strength *model.PasswordStrength
+}
+
+func (e *WeakPasswordError) Error() string { return e.Unwrap().Error() }
+
+func (e *WeakPasswordError) Unwrap() error {
+	if e.Strength != nil && e.Strength.Breached {
+		return ErrPasswordBreached
+	}
+	return ErrPasswordTooWeak
+}
+
+// ===== Email Change Errors =====
+var (
+	ErrEmailUnchanged           = errors.New("new email is the same as the current one")
+	ErrEmailChangeNotFound      = errors.New("email change not found or already used")
+	ErrEmailChangeExpired       = errors.New("email change link has expired")
+	ErrEmailDeliveryUnavailable = errors.New("email delivery is not configured")
+	ErrEmailRecoveryPending     = errors.New("email recovery is still in its waiting period")
+	ErrAccountRecoveryDisabled  = errors.New("account recovery is not configured")
+	ErrEmailAlreadyVerified     = errors.New("email is already verified")
+)
+
+// RecoveryPendingError is returned when a recovery change's verification
+// link is opened before EffectiveOn
+type RecoveryPendingError struct {
+	EffectiveOn time.Time
+}
+
+func (e *RecoveryPendingError) Error() string { return ErrEmailRecoveryPending.Error() }
+
+func (e *RecoveryPendingError) Unwrap() error { return ErrEmailRecoveryPending }
+
+// ===== Signup Risk Errors =====
+var (
+	ErrSignupRiskNotFound = errors.New("account has no signup risk score")
+)
+
+// ===== Device Pairing Errors =====
+var (
+	ErrDevicePairingNotFound = errors.New("device pairing not found")
+	ErrDevicePairingExpired  = errors.New("device pairing has expired")
+	ErrDevicePairingApproved = errors.New("device pairing was already approved")
+	ErrInvalidPairingPayload = errors.New("invalid device pairing code")
 )
 
 // ===== Token Errors =====
@@ -23,6 +80,7 @@ var (
 	ErrInvalidRefreshToken = errors.New("invalid refresh token")
 	ErrRefreshTokenExpired = errors.New("refresh token expired")
 	ErrRefreshTokenRevoked = errors.New("refresh token revoked")
+	ErrRefreshTokenUnbound = errors.New("refresh token is bound to a key the request did not prove")
 )
 
 // ===== OAuth Errors =====
@@ -43,6 +101,11 @@ var (
 	ErrCredentialNotAllowed = errors.New("credential not allowed for this user")
 	ErrSignCountMismatch    = errors.New("sign count mismatch - potential cloned authenticator")
 	ErrPasskeyLimitReached  = errors.New("maximum number of passkeys reached")
+
+	ErrCrossDeviceNotFound        = errors.New("cross-device login not found")
+	ErrCrossDeviceExpired         = errors.New("cross-device login has expired")
+	ErrCrossDeviceAlreadyApproved = errors.New("cross-device login was already approved")
+	ErrInvalidPollToken           = errors.New("invalid poll token")
 )
 
 // ===== Guild Errors =====
@@ -59,6 +122,8 @@ var (
 	ErrMaxMembersReached           = errors.New("guild has reached maximum member limit")
 	ErrGuildNameExists             = errors.New("a guild with this name already exists")
 	ErrMergeRequiresDualMembership = errors.New("must be a member of both guilds to merge")
+	ErrInvalidGuildSlug            = errors.New("invalid guild slug")
+	ErrGuildSlugTaken              = errors.New("guild slug is already taken")
 )
 
 // ===== Event Errors =====
@@ -74,6 +139,84 @@ var (
 	ErrAlreadyHost         = errors.New("already a host")
 )
 
+// ===== Ticket Errors =====
+var (
+	ErrEventNotTicketed         = errors.New("event doesn't use tickets")
+	ErrTicketHoldRequired       = errors.New("hold a ticket before RSVPing to a ticketed event")
+	ErrTicketNotFound           = errors.New("ticket not found")
+	ErrNotTicketHolder          = errors.New("not your ticket")
+	ErrTicketNotTransferable    = errors.New("only claimed, unused tickets can be transferred")
+	ErrTicketRecipientHasTicket = errors.New("recipient already has a ticket to this event")
+	ErrTicketRecipientNotMember = errors.New("tickets can only go to members of the event's guild")
+	ErrInvalidTicket            = errors.New("ticket isn't valid for this event")
+	ErrTicketAlreadyUsed        = errors.New("ticket was already used")
+	ErrTicketNotApproved        = errors.New("ticket holder's RSVP isn't approved")
+)
+
+// ===== Payment Errors =====
+var (
+	ErrPaymentsDisabled      = errors.New("paid events aren't available")
+	ErrEventNotPaid          = errors.New("event's tickets are free")
+	ErrPaymentNotDue         = errors.New("no payment is due for this RSVP")
+	ErrPayoutAccountNotFound = errors.New("no payout account linked")
+	ErrPayoutAccountNotReady = errors.New("organizer's payout account can't accept payments yet")
+	ErrInvalidWebhook        = errors.New("invalid payment webhook")
+)
+
+// ===== Promo Code Errors =====
+var (
+	ErrPromoCodeNotFound = errors.New("promo code not found")
+	ErrPromoCodeInvalid  = errors.New("promo code is expired, inactive, or used up")
+	ErrPromoCodeExists   = errors.New("event already has this promo code")
+	ErrPromoCodeRedeemed = errors.New("promo code has been redeemed; deactivate it instead")
+	ErrTooManyPromoCodes = errors.New("event has too many promo codes")
+	ErrNotGuildEvent     = errors.New("member discounts are only for guild events")
+)
+
+// ===== Guild Tier Errors =====
+var (
+	ErrTierNotFound           = errors.New("guild tier not found")
+	ErrTierExists             = errors.New("guild already has a tier with this key")
+	ErrTierInUse              = errors.New("guild tier gates upcoming events or pools")
+	ErrTooManyTiers           = errors.New("guild has too many tiers")
+	ErrTierAssignmentNotFound = errors.New("member holds no tier")
+	ErrTierHolderNotMember    = errors.New("tiers can only be given to guild members")
+	ErrTierRequired           = errors.New("a higher membership tier is required")
+)
+
+// ===== RSVP Window Errors =====
+var (
+	ErrRSVPNotOpen         = errors.New("RSVPs aren't open yet")
+	ErrEarlyAccessNotGuild = errors.New("early access rules are only for guild events")
+)
+
+// RSVPNotOpenError is returned when a user RSVPs before the event's RSVP
+// window opens for them. OpensAt is when it does, with their early access.
+type RSVPNotOpenError struct {
+	OpensAt time.Time
+}
+
+func (e *RSVPNotOpenError) Error() string { return ErrRSVPNotOpen.Error() }
+
+func (e *RSVPNotOpenError) Unwrap() error { return ErrRSVPNotOpen }
+
+// ===== Mute Errors =====
+var (
+	ErrMuteNotFound = errors.New("mute not found")
+	ErrTooManyMutes = errors.New("too many mutes")
+)
+
+// ===== Reaction Errors =====
+var (
+	ErrReactionNotFound = errors.New("reaction not found")
+)
+
+// ===== No-Show Errors =====
+var (
+	ErrNoShowNotFound = errors.New("no-show record not found")
+	ErrEventNotEnded  = errors.New("event has not ended")
+)
+
 // ===== Event Role Errors =====
 var (
 	ErrRoleNotFound           = errors.New("role not found")
@@ -84,16 +227,23 @@ var (
 	ErrMaxRolesReached        = errors.New("maximum roles reached")
 	ErrCannotAssignOthers     = errors.New("cannot assign roles to others")
 	ErrMaxRolesPerUserReached = errors.New("maximum roles per user reached")
+	ErrInvalidAssignmentMode  = errors.New("assignment mode must be direct or approval")
+	ErrRoleRequiresApproval   = errors.New("role requires host approval")
+	ErrRoleNotApprovalMode    = errors.New("role does not take nominations")
+	ErrNominationNotPending   = errors.New("nomination is not pending")
 )
 
 // ===== Profile Errors =====
 var (
-	ErrProfileNotFound   = errors.New("profile not found")
-	ErrProfileExists     = errors.New("profile already exists")
-	ErrInvalidVisibility = errors.New("invalid visibility setting")
-	ErrBioTooLong        = errors.New("bio exceeds maximum length")
-	ErrTaglineTooLong    = errors.New("tagline exceeds maximum length")
-	ErrTooManyLanguages  = errors.New("too many languages")
+	ErrProfileNotFound      = errors.New("profile not found")
+	ErrProfileExists        = errors.New("profile already exists")
+	ErrInvalidVisibility    = errors.New("invalid visibility setting")
+	ErrBioTooLong           = errors.New("bio exceeds maximum length")
+	ErrTaglineTooLong       = errors.New("tagline exceeds maximum length")
+	ErrInvalidPhotoURL      = errors.New("photo URL must be an https URL")
+	ErrTooManyLanguages     = errors.New("too many languages")
+	ErrInvalidLanguage      = errors.New("languages must be ISO 639-1 codes")
+	ErrInvalidAccessibility = errors.New(model.InvalidAccessibilityMessage)
 )
 
 // ===== Availability Errors =====
@@ -110,6 +260,67 @@ var (
 	ErrInvalidEndTimeFormat   = errors.New("invalid end_time format")
 )
 
+// ===== Hangout Type Errors =====
+var (
+	ErrHangoutTypeNotFound = errors.New("hangout type not found")
+	ErrHangoutTypeExists   = errors.New("a hangout type with this key already exists")
+	ErrBuiltInHangoutType  = errors.New("built-in hangout types can't be removed or deactivated")
+	ErrTooManyHangoutTypes = errors.New("guild has too many hangout types")
+)
+
+// ===== Venue Errors =====
+var (
+	ErrVenueNotFound     = errors.New("venue not found")
+	ErrTooManyVenues     = errors.New("guild has too many venues")
+	ErrNotVenueCreator   = errors.New("only the member who saved a venue or a guild admin can change it")
+	ErrAddressNotFound   = errors.New("address could not be found")
+	ErrVenueOverCapacity = errors.New("max_attendees is more than the venue holds")
+)
+
+// ===== Carpool Errors =====
+var (
+	ErrRideshareNotFound    = errors.New("rideshare not found")
+	ErrRideRequestNotFound  = errors.New("ride request not found")
+	ErrSeatNotFound         = errors.New("seat not found")
+	ErrNotEventAttendee     = errors.New("only the event's hosts and approved attendees can carpool to it")
+	ErrCarpoolClosed        = errors.New("carpools are closed for this event")
+	ErrNotRideshareDriver   = errors.New("only the driver can answer seat requests")
+	ErrOwnRideshare         = errors.New("can't request a seat in your own rideshare")
+	ErrRideshareFull        = errors.New("rideshare has no seats left")
+	ErrTooManyRideshares    = errors.New("event has too many rides offered")
+	ErrAlreadyOfferingRide  = errors.New("already offering a ride to this event")
+	ErrRideRequestExists    = errors.New("already looking for a ride to this event")
+	ErrSeatAlreadyRequested = errors.New("already requested a seat on this rideshare")
+	ErrSeatAlreadyAnswered  = errors.New("seat request was already answered")
+)
+
+// ===== Expense Errors =====
+var (
+	ErrExpenseNotFound         = errors.New("expense not found")
+	ErrNotExpenseParticipant   = errors.New("only the event's hosts and approved attendees share its expenses")
+	ErrNotSettlementParty      = errors.New("only either party or a host can mark a debt settled")
+	ErrPayerNotParticipant     = errors.New("paid_by must be one of the event's hosts or approved attendees")
+	ErrExpenseCurrencyMismatch = errors.New("expenses must all be in the same currency")
+	ErrTooManyExpenses         = errors.New("event has too many expenses")
+	ErrNothingToSettle         = errors.New("nothing is owed between these participants")
+)
+
+// ===== Spontaneous Availability Errors =====
+var (
+	ErrNotAvailableNow  = errors.New("not available right now")
+	ErrSpontaneousLimit = errors.New("too many spontaneous sessions")
+)
+
+// SpontaneousLimitError is returned when a user has used up their spontaneous
+// sessions for the rolling window. RetryAt is when they can go again.
+type SpontaneousLimitError struct {
+	RetryAt time.Time
+}
+
+func (e *SpontaneousLimitError) Error() string { return ErrSpontaneousLimit.Error() }
+
+func (e *SpontaneousLimitError) Unwrap() error { return ErrSpontaneousLimit }
+
 // ===== Trust Errors =====
 var (
 	ErrTrustNotFound       = errors.New("trust relation not found")
@@ -139,6 +350,18 @@ var (
 	ErrInvalidImportance      = errors.New("invalid importance level")
 	ErrDealBreakerNotAllowed  = errors.New("this question cannot be a dealbreaker")
 	ErrInvalidAlignmentWeight = errors.New("alignment weight must be between 0 and 1")
+	ErrGuildQuestionLimit     = errors.New("guild has reached the maximum number of questions")
+)
+
+// ===== Content Catalog Errors =====
+var (
+	ErrQuestionCategoryNotFound = errors.New("question category not found")
+	ErrQuestionCategoryExists   = errors.New("question category already exists")
+	ErrUnknownQuestionCategory  = errors.New("category does not exist or is deprecated")
+	ErrQuestionOptionInUse      = errors.New("cannot remove options from a question that has answers")
+	ErrReviewTagNotFound        = errors.New("review tag not found")
+	ErrReviewTagExists          = errors.New("review tag already exists")
+	ErrInvalidReviewTagKind     = errors.New("review tag kind must be positive or improvement")
 )
 
 // ===== Interest Errors =====
@@ -162,6 +385,7 @@ var (
 	ErrNotMatchMember         = errors.New("not a member of this match")
 	ErrExclusionLimitReached  = errors.New("maximum exclusions reached")
 	ErrNotEnoughMembers       = errors.New("not enough active members to create matches")
+	ErrNoMemberRecord         = errors.New("user has no member record")
 )
 
 // ===== Moderation Errors =====
@@ -187,3 +411,215 @@ var (
 	ErrNoDeviceTokens     = errors.New("no device tokens found for user")
 	ErrInvalidDeviceToken = errors.New("invalid device token")
 )
+
+// ===== Activity Errors =====
+var (
+	ErrInvalidActivityCursor = errors.New("invalid activity cursor")
+)
+
+// ===== Handle Errors =====
+var (
+	ErrHandleNotFound      = errors.New("handle not found")
+	ErrInvalidHandle       = errors.New("invalid handle")
+	ErrHandleTaken         = errors.New("handle is already taken")
+	ErrHandleChangeTooSoon = errors.New("handle was changed too recently")
+)
+
+// ===== Share Link Errors =====
+var (
+	ErrShareLinkNotFound   = errors.New("share link not found")
+	ErrShareLinkExpired    = errors.New("share link has expired")
+	ErrShareLinkExhausted  = errors.New("invite link has no uses left")
+	ErrShareLinkNotInvite  = errors.New("share link is not an invite")
+	ErrShareTargetNotFound = errors.New("share target not found")
+	ErrShareNotAllowed     = errors.New("not allowed to share this resource")
+)
+
+// ===== Guild Invite Errors =====
+var (
+	ErrGuildInviteNotFound  = errors.New("guild invite not found")
+	ErrGuildInviteExpired   = errors.New("guild invite has expired")
+	ErrGuildInviteExhausted = errors.New("guild invite has no uses left")
+	ErrNotInvitee           = errors.New("this invite is for someone else")
+	ErrTooManyGuildInvites  = errors.New("maximum active invites reached for this guild")
+)
+
+// ===== Guild Permission Errors =====
+var (
+	ErrMissingPermission        = errors.New("guild role doesn't allow this action")
+	ErrGuildRoleNotFound        = errors.New("guild role not found")
+	ErrGuildRoleExists          = errors.New("guild already has a role with this name")
+	ErrTooManyGuildRoles        = errors.New("guild has too many custom roles")
+	ErrTooManyMemberRoles       = errors.New("member holds too many custom roles")
+	ErrGuildRoleHolderNotMember = errors.New("roles can only be given to guild members")
+	ErrGuildRoleNotHeld         = errors.New("member doesn't hold this role")
+)
+
+// ===== Announcement Errors =====
+var (
+	ErrAnnouncementNotFound      = errors.New("announcement not found")
+	ErrAnnouncementNotEditable   = errors.New("announcement has already been sent")
+	ErrAnnouncementSendAtInvalid = errors.New("send_at must be in the future and within 90 days")
+)
+
+// ===== Publication Errors =====
+var (
+	ErrPublicationNotSchedulable = errors.New("only draft or scheduled items can be scheduled")
+	ErrPublishAtInvalid          = errors.New("publish_at must be in the future, within 90 days and before the item starts")
+)
+
+// ===== Draft Errors =====
+var (
+	ErrDraftNotFound    = errors.New("draft not found")
+	ErrMaxDraftsReached = errors.New("maximum number of drafts reached")
+)
+
+// ===== Concurrency Errors =====
+var (
+	ErrVersionConflict = errors.New("resource was modified since the given version")
+)
+
+// VersionConflictError is returned when a compare-and-set update loses to a
+// concurrent edit. Current is the resource as it is now.
+type VersionConflictError struct {
+	Current interface{}
+}
+
+func (e *VersionConflictError) Error() string { return ErrVersionConflict.Error() }
+
+func (e *VersionConflictError) Unwrap() error { return ErrVersionConflict }
+
+// ===== Schedule Conflict Errors =====
+var (
+	ErrScheduleConflict = errors.New("overlaps other commitments")
+)
+
+// ScheduleConflictError is returned when a new commitment clashes with the
+// user's existing ones. Clients confirm by resubmitting with force.
+type ScheduleConflictError struct {
+	Conflicts []model.CommitmentConflict
+}
+
+func (e *ScheduleConflictError) Error() string { return ErrScheduleConflict.Error() }
+
+func (e *ScheduleConflictError) Unwrap() error { return ErrScheduleConflict }
+
+// ===== Duplicate Errors =====
+var (
+	ErrPossibleDuplicate = errors.New("looks like an existing guild or event")
+)
+
+// DuplicateError is returned when a new guild or event looks like existing
+// ones. Clients confirm by resubmitting with confirm_duplicate.
+type DuplicateError struct {
+	Candidates []model.DuplicateCandidate
+}
+
+func (e *DuplicateError) Error() string { return ErrPossibleDuplicate.Error() }
+
+func (e *DuplicateError) Unwrap() error { return ErrPossibleDuplicate }
+
+// ===== Profile Completeness Errors =====
+var (
+	ErrProfileIncomplete = errors.New("profile is not complete enough for discovery")
+)
+
+// ProfileIncompleteError is returned when a user tries discovery before their
+// profile reaches the completeness threshold
+type ProfileIncompleteError struct {
+	Completeness *model.ProfileCompleteness
+}
+
+func (e *ProfileIncompleteError) Error() string { return ErrProfileIncomplete.Error() }
+
+func (e *ProfileIncompleteError) Unwrap() error { return ErrProfileIncomplete }
+
+// ===== Undo Errors =====
+var (
+	ErrUndoNotFound    = errors.New("undo operation not found or expired")
+	ErrUndoUnavailable = errors.New("action can no longer be undone")
+)
+
+// ===== Trash Errors =====
+var (
+	ErrTrashItemNotFound = errors.New("item not found in trash")
+	ErrNotGuildContent   = errors.New("only guild content can be moved to the trash")
+)
+
+// ===== Analytics Event Errors =====
+var (
+	ErrAnalyticsDisabled     = errors.New("analytics events are disabled")
+	ErrInvalidAnalyticsRange = errors.New("export range must end after it starts")
+)
+
+// ===== Consent Errors =====
+var (
+	ErrInvalidConsentPurpose   = errors.New("unknown consent purpose")
+	ErrConsentVersionOutdated  = errors.New("consent text version is not the current version")
+	ErrLocationConsentRequired = errors.New("location processing consent is required")
+)
+
+// ===== Legal Hold Errors =====
+var (
+	ErrLegalHoldNotFound  = errors.New("user is not on legal hold")
+	ErrLegalHoldExists    = errors.New("user is already on legal hold")
+	ErrUserOnLegalHold    = errors.New("user is on legal hold")
+	ErrSuperAdminRequired = errors.New("only superadmins can grant or change the superadmin role")
+)
+
+// ===== Quota Errors =====
+var (
+	ErrQuotaOverrideNotFound = errors.New("user has no quota override")
+)
+
+// ===== Remote Config Errors =====
+var (
+	ErrRemoteConfigNotFound = errors.New("remote config value not found")
+)
+
+// ===== Experiment Errors =====
+var (
+	ErrExperimentNotFound          = errors.New("experiment not found")
+	ErrExperimentExists            = errors.New("experiment already exists")
+	ErrInvalidExperimentTransition = errors.New("experiments go from draft to running to stopped, and can't be restarted")
+)
+
+// ===== Admin Report Errors =====
+var (
+	ErrAdminReportsDisabled = errors.New("admin reports require object storage to be configured")
+	ErrAdminReportNotFound  = errors.New("admin report not found")
+)
+
+// ===== User Import Errors =====
+var (
+	ErrUserImportNotFound = errors.New("user import not found")
+	ErrInvitationNotFound = errors.New("invitation not found or already used")
+	ErrInvitationExpired  = errors.New("invitation link has expired")
+)
+
+// ===== Guild Import Errors =====
+var (
+	ErrInvalidGuildExport   = errors.New("export can't be read")
+	ErrGuildImportNotFound  = errors.New("guild import not found")
+	ErrGuildImportCommitted = errors.New("guild import is already committed")
+	ErrGuildImportExpired   = errors.New("guild import preview has expired")
+)
+
+// ===== Notification Poll Errors =====
+var (
+	ErrInvalidNotificationCursor = errors.New("since must be a cursor from an earlier poll")
+	ErrTooManyNotificationPolls  = errors.New("too many notification polls open")
+)
+
+// ===== Search Errors =====
+var (
+	ErrSearchQueryLength = errors.New("search query length out of range")
+	ErrInvalidSearchType = errors.New("invalid search type")
+)
+
+// ===== Network Policy Errors =====
+var (
+	ErrIPAlreadyDenied = errors.New("range is already on the deny list")
+	ErrIPDenyNotFound  = errors.New("deny list entry not found")
+	ErrDenyOwnAddress  = errors.New("range includes the address of the request denying it")
+)
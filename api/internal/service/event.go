@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/forgo/saga/api/internal/model"
@@ -13,9 +16,11 @@ import (
 type EventRepositoryInterface interface {
 	Create(ctx context.Context, event *model.Event) error
 	Get(ctx context.Context, eventID string) (*model.Event, error)
-	Update(ctx context.Context, eventID string, updates map[string]interface{}) (*model.Event, error)
+	Update(ctx context.Context, eventID string, updates map[string]interface{}, version *int) (*model.Event, error)
 	Delete(ctx context.Context, eventID string) error
-	GetByGuild(ctx context.Context, guildID string, filters *model.EventSearchFilters) ([]*model.Event, error)
+	MoveToTrash(ctx context.Context, eventID, userID string) (bool, error)
+	GetByGuild(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error)
+	GetByGuildPage(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Event], error)
 	GetPublicEvents(ctx context.Context, filters *model.EventSearchFilters, limit int) ([]*model.Event, error)
 	CreateHost(ctx context.Context, host *model.EventHost) error
 	GetHosts(ctx context.Context, eventID string) ([]*model.EventHost, error)
@@ -26,6 +31,17 @@ type EventRepositoryInterface interface {
 	GetRSVPsByEvent(ctx context.Context, eventID string) ([]*model.EventRSVP, error)
 	GetPendingRSVPs(ctx context.Context, eventID string) ([]*model.EventRSVP, error)
 	CountApprovedRSVPs(ctx context.Context, eventID string) (int, error)
+	SchedulePublication(ctx context.Context, eventID string, publishAt time.Time) (*model.Event, error)
+	SetRSVPWindow(ctx context.Context, eventID string, opensAt *time.Time, earlyAccess []model.RSVPEarlyAccess) (*model.Event, error)
+	CreateTicketHold(ctx context.Context, ticket *model.EventTicket, capacity int) (bool, error)
+	GetTicket(ctx context.Context, ticketID string) (*model.EventTicket, error)
+	GetActiveTicket(ctx context.Context, eventID, userID string) (*model.EventTicket, error)
+	CountActiveTickets(ctx context.Context, eventID string) (int, error)
+	ClaimTicket(ctx context.Context, ticketID string) (*model.EventTicket, error)
+	ExtendTicketHold(ctx context.Context, ticketID string, until time.Time) (*model.EventTicket, error)
+	VoidTicket(ctx context.Context, ticketID string) error
+	TransferTicket(ctx context.Context, ticketID, fromUserID, toUserID, code string) (*model.EventTicket, error)
+	CheckInTicket(ctx context.Context, ticketID, hostUserID string) (*model.EventTicket, error)
 }
 
 // CompatibilityServiceForEvent is the compatibility service interface
@@ -44,12 +60,73 @@ type EventRoleServiceForEvent interface {
 	CreateDefaultRole(ctx context.Context, eventID, hostUserID string, maxSlots int) (*model.EventRole, error)
 }
 
+// NoShowServiceForEvent is the no-show service interface
+type NoShowServiceForEvent interface {
+	ShouldAutoDecline(ctx context.Context, guildID, userID string) (bool, *model.NoShowStats, error)
+	GetStatsForEvent(ctx context.Context, event *model.Event, userID string) (*model.NoShowStats, error)
+}
+
+// AnalyticsServiceForEvent is the analytics service interface
+type AnalyticsServiceForEvent interface {
+	ForecastAttendance(ctx context.Context, event *model.Event, approvedRSVPs int) (*model.AttendanceForecast, error)
+}
+
+// EventProfileSource provides the accessibility needs public event
+// discovery can match venues against
+type EventProfileSource interface {
+	GetByUserID(ctx context.Context, userID string) (*model.UserProfile, error)
+}
+
+// EventVenueSource provides the saved guild venues events can be held at
+type EventVenueSource interface {
+	VenueForEvent(ctx context.Context, guildID, venueID string) (*model.Venue, error)
+}
+
+// EventCarpoolSource summarizes the carpools to an event for its detail view
+type EventCarpoolSource interface {
+	CarpoolSummary(ctx context.Context, eventID, userID string) (*model.CarpoolSummary, error)
+}
+
+// EventGuildRepository provides the guild membership checks for ticket
+// transfers and role based early RSVPs
+type EventGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	GetMemberRole(ctx context.Context, userID, guildID string) (model.GuildRole, error)
+}
+
+// EventPayments takes payment for paid events' tickets and refunds it
+type EventPayments interface {
+	CheckPayoutReady(ctx context.Context, userID string) error
+	RefundRSVP(ctx context.Context, event *model.Event, userID string, full bool) error
+	RefundEvent(ctx context.Context, event *model.Event) error
+}
+
+// EventTierGate enforces guild membership tier perks on events
+type EventTierGate interface {
+	ValidateTier(ctx context.Context, guildID, tierID string) error
+	CheckEventTier(ctx context.Context, event *model.Event, userID string, now time.Time) (*model.GuildTier, error)
+}
+
 // EventService handles event business logic
 type EventService struct {
 	repo                 EventRepositoryInterface
 	compatibilityService CompatibilityServiceForEvent
 	questionnaireService QuestionnaireServiceForEvent
 	eventRoleService     EventRoleServiceForEvent
+	noShowService        NoShowServiceForEvent
+	analyticsService     AnalyticsServiceForEvent
+	activity             ActivityRecorder
+	undo                 UndoRecorder
+	conflicts            ConflictChecker
+	events               DomainEventPublisher
+	guilds               EventGuildRepository
+	payments             EventPayments
+	tiers                EventTierGate
+	reactions            ReactionSummaries
+	profiles             EventProfileSource
+	venues               EventVenueSource
+	carpools             EventCarpoolSource
+	permissions          GuildPermissionChecker
 }
 
 // NewEventService creates a new event service
@@ -58,12 +135,40 @@ func NewEventService(
 	compatibilityService CompatibilityServiceForEvent,
 	questionnaireService QuestionnaireServiceForEvent,
 	eventRoleService EventRoleServiceForEvent,
+	noShowService NoShowServiceForEvent,
+	analyticsService AnalyticsServiceForEvent,
+	activity ActivityRecorder,
+	undo UndoRecorder,
+	conflicts ConflictChecker,
+	events DomainEventPublisher,
+	guilds EventGuildRepository,
+	payments EventPayments,
+	tiers EventTierGate,
+	reactions ReactionSummaries,
+	profiles EventProfileSource,
+	venues EventVenueSource,
+	carpools EventCarpoolSource,
+	permissions GuildPermissionChecker,
 ) *EventService {
 	return &EventService{
 		repo:                 repo,
 		compatibilityService: compatibilityService,
 		questionnaireService: questionnaireService,
 		eventRoleService:     eventRoleService,
+		noShowService:        noShowService,
+		analyticsService:     analyticsService,
+		activity:             activity,
+		undo:                 undo,
+		conflicts:            conflicts,
+		events:               events,
+		guilds:               guilds,
+		payments:             payments,
+		tiers:                tiers,
+		reactions:            reactions,
+		profiles:             profiles,
+		venues:               venues,
+		carpools:             carpools,
+		permissions:          permissions,
 	}
 }
 
@@ -87,15 +192,78 @@ func (s *EventService) CreateEvent(ctx context.Context, userID string, req *mode
 		AutoApproveAligned: req.AutoApproveAligned,
 		YikesThreshold:     req.YikesThreshold,
 		IsSupportEvent:     req.IsSupportEvent,
+		Ticketed:           req.Ticketed,
+		TicketPrice:        req.TicketPrice,
+		Currency:           req.Currency,
+		RefundPolicy:       req.RefundPolicy,
+		MinTier:            req.MinTier,
+		RSVPOpensAt:        req.RSVPOpensAt,
 		Status:             model.EventStatusPublished,
 		CreatedBy:          userID,
 	}
 
+	// Guild events are hosted by members whose role allows it
+	if req.GuildID != nil && s.permissions != nil {
+		if err := s.permissions.RequirePermission(ctx, userID, *req.GuildID, model.PermissionCreateEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	// Ticket sales are paid out to the creator, who needs somewhere to
+	// receive them
+	if event.TicketPrice > 0 {
+		if err := s.checkPayoutReady(ctx, userID); err != nil {
+			return nil, err
+		}
+		if event.RefundPolicy == "" {
+			event.RefundPolicy = model.RefundPolicyFull
+		}
+	}
+
+	// Exclusive events are gated on one of their guild's tiers
+	if req.MinTier != nil {
+		if err := s.validateTier(ctx, *req.GuildID, *req.MinTier); err != nil {
+			return nil, err
+		}
+	}
+
+	// Events at a saved venue take its location and, unless set lower,
+	// its capacity
+	if req.VenueID != nil {
+		venue, err := s.venueForEvent(ctx, *req.GuildID, *req.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		event.Location = venue.EventLocation()
+		event.VenueID = &venue.ID
+		if err := checkVenueCapacity(venue, req.MaxAttendees); err != nil {
+			return nil, err
+		}
+		if event.MaxAttendees == nil {
+			event.MaxAttendees = venue.Capacity
+		}
+	}
+
+	// Scheduled events stay hidden until the publisher job publishes them
+	if req.PublishAt != nil {
+		if err := checkPublishAt(time.Now(), *req.PublishAt, req.StartTime); err != nil {
+			return nil, err
+		}
+		event.Status = model.EventStatusScheduled
+		event.PublishAt = req.PublishAt
+	}
+
 	// Set default yikes threshold
 	if event.YikesThreshold == 0 && event.ValuesRequired {
 		event.YikesThreshold = model.DefaultYikesThreshold
 	}
 
+	if !req.ConfirmDuplicate {
+		if err := s.checkDuplicateEvent(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.repo.Create(ctx, event); err != nil {
 		return nil, err
 	}
@@ -149,6 +317,11 @@ func (s *EventService) GetEventWithDetails(ctx context.Context, eventID, userID
 	approvedCount, _ := s.repo.CountApprovedRSVPs(ctx, eventID)
 	pendingRSVPs, _ := s.repo.GetPendingRSVPs(ctx, eventID)
 
+	// Draft and scheduled events are only visible to their hosts
+	if event.IsUnpublished() && !isEventHost(hosts, userID) {
+		return nil, ErrEventNotFound
+	}
+
 	details := &model.EventWithDetails{
 		Event:          *event,
 		Hosts:          make([]model.EventHost, 0, len(hosts)),
@@ -160,16 +333,47 @@ func (s *EventService) GetEventWithDetails(ctx context.Context, eventID, userID
 		details.Hosts = append(details.Hosts, *host)
 	}
 
+	if event.Ticketed {
+		sold, _ := s.repo.CountActiveTickets(ctx, eventID)
+		left := max(ticketCapacity(event)-sold, 0)
+		details.TicketsLeft = &left
+	}
+
+	if s.reactions != nil {
+		if summaries, err := s.reactions.Summaries(ctx, []string{eventID}, userID); err == nil {
+			details.Reactions = summaries[eventID]
+		}
+	}
+
+	if s.carpools != nil {
+		if summary, err := s.carpools.CarpoolSummary(ctx, eventID, userID); err == nil {
+			details.Carpool = summary
+		}
+	}
+
 	// Get user's RSVP if authenticated
 	if userID != "" {
 		rsvp, _ := s.repo.GetRSVP(ctx, eventID, userID)
 		details.UserRSVP = rsvp
+
+		if event.Ticketed {
+			if ticket, _ := s.repo.GetActiveTicket(ctx, eventID, userID); ticket != nil {
+				details.UserTicket = withTicketPayload(ticket)
+			}
+		}
+
+		// Hosts get an attendance forecast for capacity planning
+		if s.analyticsService != nil && isEventHost(hosts, userID) {
+			forecast, _ := s.analyticsService.ForecastAttendance(ctx, event, approvedCount)
+			details.Forecast = forecast
+		}
 	}
 
 	return details, nil
 }
 
-// UpdateEvent updates an event (host only)
+// UpdateEvent updates an event (host only). With a version, the update is
+// rejected with a VersionConflictError if the event has changed.
 func (s *EventService) UpdateEvent(ctx context.Context, userID, eventID string, req *model.UpdateEventRequest) (*model.Event, error) {
 	isHost, err := s.repo.IsHost(ctx, eventID, userID)
 	if err != nil {
@@ -187,16 +391,7 @@ func (s *EventService) UpdateEvent(ctx context.Context, userID, eventID string,
 		updates["description"] = *req.Description
 	}
 	if req.Location != nil {
-		updates["location"] = map[string]interface{}{
-			"name":         req.Location.Name,
-			"address":      req.Location.Address,
-			"neighborhood": req.Location.Neighborhood,
-			"city":         req.Location.City,
-			"lat":          req.Location.Lat,
-			"lng":          req.Location.Lng,
-			"is_virtual":   req.Location.IsVirtual,
-			"meet_link":    req.Location.MeetLink,
-		}
+		updates["location"] = locationUpdate(req.Location)
 	}
 	if req.StartTime != nil {
 		updates["start_time"] = *req.StartTime
@@ -231,12 +426,110 @@ func (s *EventService) UpdateEvent(ctx context.Context, userID, eventID string,
 	if req.Status != nil {
 		updates["status"] = *req.Status
 	}
+	if req.Ticketed != nil {
+		updates["ticketed"] = *req.Ticketed
+	}
+	if req.TicketPrice != nil {
+		updates["ticket_price"] = *req.TicketPrice
+	}
+	if req.Currency != nil {
+		updates["currency"] = *req.Currency
+	}
+	if req.RefundPolicy != nil {
+		updates["refund_policy"] = *req.RefundPolicy
+	}
+	if req.MinTier != nil {
+		updates["min_tier"] = *req.MinTier
+	}
+	if req.RSVPOpensAt != nil {
+		updates["rsvp_opens_at"] = *req.RSVPOpensAt
+	}
+	for _, field := range req.Clear {
+		updates[field] = nil
+	}
+	// A location entered by hand is no longer the saved venue's
+	if req.Location != nil || req.Clear.Has("location") {
+		updates["venue_id"] = nil
+	}
+
+	// Ticketed events can't lose their capacity, paid events their
+	// tickets, or RSVP windows their event
+	if req.Ticketed != nil || req.Clear.Has("max_attendees") || req.TicketPrice != nil || req.Currency != nil || req.RefundPolicy != nil ||
+		req.MinTier != nil || req.RSVPOpensAt != nil {
+		current, err := s.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		if errs := req.ValidateFor(current); len(errs) > 0 {
+			return nil, model.NewValidationError(errs)
+		}
+		if req.TicketPrice != nil && *req.TicketPrice > 0 && !current.IsPaid() {
+			if err := s.checkPayoutReady(ctx, current.CreatedBy); err != nil {
+				return nil, err
+			}
+			if current.RefundPolicy == "" && req.RefundPolicy == nil {
+				updates["refund_policy"] = model.RefundPolicyFull
+			}
+		}
+		if req.MinTier != nil {
+			if err := s.validateTier(ctx, *current.GuildID, *req.MinTier); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Moving to a saved venue copies its location over the event's
+	if req.VenueID != nil {
+		current, err := s.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		if current.GuildID == nil {
+			return nil, model.NewValidationError([]model.FieldError{{Field: "venue_id", Message: "venue_id is only for guild events"}})
+		}
+		venue, err := s.venueForEvent(ctx, *current.GuildID, *req.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		maxAttendees := current.MaxAttendees
+		if req.MaxAttendees != nil {
+			maxAttendees = req.MaxAttendees
+		}
+		if err := checkVenueCapacity(venue, maxAttendees); err != nil {
+			return nil, err
+		}
+		updates["location"] = locationUpdate(venue.EventLocation())
+		updates["venue_id"] = venue.ID
+	}
 
 	if len(updates) == 0 {
-		return s.GetEvent(ctx, eventID)
+		event, err := s.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		if req.Version != nil && *req.Version != event.Version {
+			return nil, &VersionConflictError{Current: event}
+		}
+		return event, nil
 	}
 
-	return s.repo.Update(ctx, eventID, updates)
+	event, err := s.repo.Update(ctx, eventID, updates, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		current, err := s.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, &VersionConflictError{Current: current}
+	}
+	if req.Status != nil && *req.Status == model.EventStatusCancelled {
+		if err := s.refundCancelledEvent(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
 }
 
 // CancelEvent cancels an event (host only)
@@ -249,10 +542,125 @@ func (s *EventService) CancelEvent(ctx context.Context, userID, eventID string)
 		return ErrNotEventHost
 	}
 
-	_, err = s.repo.Update(ctx, eventID, map[string]interface{}{
+	event, err := s.repo.Update(ctx, eventID, map[string]interface{}{
 		"status": model.EventStatusCancelled,
-	})
-	return err
+	}, nil)
+	if err != nil || event == nil {
+		return err
+	}
+	return s.refundCancelledEvent(ctx, event)
+}
+
+// DeleteEvent moves a guild event to the guild's trash (host only), where
+// organizers can restore it for model.TrashRetentionDays. Events outside a
+// guild are cancelled instead.
+func (s *EventService) DeleteEvent(ctx context.Context, userID, eventID string) error {
+	isHost, err := s.repo.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if !isHost {
+		return ErrNotEventHost
+	}
+
+	event, err := s.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if event.GuildID == nil || *event.GuildID == "" {
+		return ErrNotGuildContent
+	}
+
+	moved, err := s.repo.MoveToTrash(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if !moved {
+		return ErrEventNotFound
+	}
+	return nil
+}
+
+// SchedulePublication schedules or reschedules when a draft event is
+// published (host only). Cancelling before publication is CancelEvent.
+func (s *EventService) SchedulePublication(ctx context.Context, userID, eventID string, publishAt time.Time) (*model.Event, error) {
+	isHost, err := s.repo.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isHost {
+		return nil, ErrNotEventHost
+	}
+
+	event, err := s.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsUnpublished() {
+		return nil, ErrPublicationNotSchedulable
+	}
+	if err := checkPublishAt(time.Now(), publishAt, event.StartTime); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.SchedulePublication(ctx, eventID, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		// Published or cancelled since it was loaded
+		return nil, ErrPublicationNotSchedulable
+	}
+	return updated, nil
+}
+
+// SetRSVPWindow replaces when an event's RSVPs open and the early access
+// rules for guild tiers and roles (host only). Guests already in keep their
+// place.
+func (s *EventService) SetRSVPWindow(ctx context.Context, userID, eventID string, req *model.SetRSVPWindowRequest) (*model.Event, error) {
+	isHost, err := s.repo.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isHost {
+		return nil, ErrNotEventHost
+	}
+
+	event, err := s.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if req.OpensAt != nil && !req.OpensAt.Before(event.StartTime) {
+		return nil, model.NewValidationError([]model.FieldError{{Field: "opens_at", Message: "opens_at must be before start_time"}})
+	}
+	if len(req.EarlyAccess) > 0 && event.GuildID == nil {
+		return nil, ErrEarlyAccessNotGuild
+	}
+	for _, rule := range req.EarlyAccess {
+		if rule.TierID == nil {
+			continue
+		}
+		err := s.validateTier(ctx, *event.GuildID, *rule.TierID)
+		if errors.Is(err, ErrTierNotFound) {
+			return nil, model.NewValidationError([]model.FieldError{{Field: "early_access", Message: "early_access tier not found"}})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	earlyAccess := req.EarlyAccess
+	if earlyAccess == nil {
+		earlyAccess = []model.RSVPEarlyAccess{}
+	}
+	updated, err := s.repo.SetRSVPWindow(ctx, eventID, req.OpensAt, earlyAccess)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrEventNotFound
+	}
+	return updated, nil
 }
 
 // AddHost adds a co-host to an event
@@ -310,6 +718,12 @@ func (s *EventService) RSVP(ctx context.Context, userID, eventID string, req *mo
 
 	// Handle not going - simple case
 	if req.RSVPType == model.RSVPTypeNotGoing {
+		if err := s.releaseTicket(ctx, event, userID); err != nil {
+			return nil, err
+		}
+		if err := s.refundTicket(ctx, event, userID, false); err != nil {
+			return nil, err
+		}
 		if existingRSVP != nil {
 			return s.repo.UpdateRSVP(ctx, existingRSVP.ID, map[string]interface{}{
 				"status":    model.RSVPStatusCancelled,
@@ -319,22 +733,58 @@ func (s *EventService) RSVP(ctx context.Context, userID, eventID string, req *mo
 		return nil, nil // No existing RSVP, nothing to cancel
 	}
 
-	// If already has approved RSVP, don't allow re-RSVP
-	if existingRSVP != nil && existingRSVP.Status == model.RSVPStatusApproved {
-		return nil, ErrAlreadyRSVPd
+	if err := rsvpError(event, existingRSVP); err != nil {
+		return nil, err
 	}
 
-	// Check capacity
-	if event.MaxAttendees != nil {
-		currentCount, _ := s.repo.CountApprovedRSVPs(ctx, eventID)
-		totalRequested := 1 + req.PlusOnes
-		if currentCount+totalRequested > *event.MaxAttendees {
-			if !event.WaitlistEnabled {
-				return nil, ErrEventFull
+	// Tier gates and RSVP windows only hold back new guests. Guests already
+	// in keep their place if their tier lapses.
+	if existingRSVP == nil || existingRSVP.Status == model.RSVPStatusCancelled {
+		if err := s.checkRSVPRules(ctx, event, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	// A ticket is one seat, so guests of ticketed events come alone
+	if event.Ticketed && req.PlusOnes > 0 {
+		return nil, model.NewValidationError([]model.FieldError{{Field: "plus_ones", Message: "ticketed events don't allow plus-ones"}})
+	}
+
+	// Apply the guild's no-show policy
+	if s.noShowService != nil && event.GuildID != nil {
+		decline, stats, err := s.noShowService.ShouldAutoDecline(ctx, *event.GuildID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if decline {
+			if err := s.releaseTicket(ctx, event, userID); err != nil {
+				return nil, err
+			}
+			if err := s.refundTicket(ctx, event, userID, true); err != nil {
+				return nil, err
 			}
+			return s.autoDeclineRSVP(ctx, existingRSVP, userID, eventID, req, stats)
+		}
+	}
+
+	// Check capacity; a ticketed event's seats are its tickets
+	if event.MaxAttendees != nil && !event.Ticketed {
+		currentCount, _ := s.repo.CountApprovedRSVPs(ctx, eventID)
+		if err := capacityError(event, currentCount, 1+req.PlusOnes); err != nil {
+			return nil, err
 		}
 	}
 
+	// Warn about overlapping commitments unless the user already confirmed
+	if err := checkConflicts(ctx, s.conflicts, model.CommitmentCheck{
+		UserID:  userID,
+		Start:   event.StartTime,
+		End:     event.EndOrDefault(),
+		EventID: eventID,
+	}, req.Force); err != nil {
+		return nil, err
+	}
+
 	// Check values alignment
 	var valuesCheck *model.EventValuesCheck
 	if event.ValuesRequired {
@@ -365,7 +815,7 @@ func (s *EventService) RSVP(ctx context.Context, userID, eventID string, req *mo
 		// No values check required - check if host approval needed
 		// For public events, auto-approve unless at capacity
 		if event.Visibility == model.EventVisibilityPublic {
-			if event.MaxAttendees == nil {
+			if event.MaxAttendees == nil || event.Ticketed {
 				status = model.RSVPStatusApproved
 			} else {
 				currentCount, _ := s.repo.CountApprovedRSVPs(ctx, eventID)
@@ -380,6 +830,30 @@ func (s *EventService) RSVP(ctx context.Context, userID, eventID string, req *mo
 		}
 	}
 
+	// Claim the held ticket before taking its seat on the guest list. A
+	// paid event's ticket is claimed when the guest pays for it, so until
+	// then the RSVP waits on payment.
+	var paymentStatus *string
+	if event.IsPaid() {
+		due, err := s.ticketPaymentDue(ctx, eventID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if due {
+			pending := model.PaymentStatusPending
+			paymentStatus = &pending
+			if status == model.RSVPStatusApproved {
+				status = model.RSVPStatusPending
+				reason := model.WaitingReasonPayment
+				waitingReason = &reason
+			}
+		}
+	} else if event.Ticketed {
+		if err := s.claimTicket(ctx, eventID, userID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create or update RSVP
 	if existingRSVP != nil {
 		updates := map[string]interface{}{
@@ -391,12 +865,20 @@ func (s *EventService) RSVP(ctx context.Context, userID, eventID string, req *mo
 		if waitingReason != nil {
 			updates["waiting_reason"] = *waitingReason
 		}
+		if paymentStatus != nil {
+			updates["payment_status"] = *paymentStatus
+		}
 		if valuesCheck != nil {
 			updates["values_aligned"] = valuesCheck.IsAligned
 			updates["alignment_score"] = valuesCheck.AlignmentScore
 			updates["yikes_count"] = valuesCheck.YikesCount
 		}
-		return s.repo.UpdateRSVP(ctx, existingRSVP.ID, updates)
+		rsvp, err := s.repo.UpdateRSVP(ctx, existingRSVP.ID, updates)
+		if err != nil {
+			return nil, err
+		}
+		s.recordRSVPActivity(ctx, event, rsvp)
+		return rsvp, nil
 	}
 
 	rsvp := &model.EventRSVP{
@@ -405,6 +887,7 @@ func (s *EventService) RSVP(ctx context.Context, userID, eventID string, req *mo
 		Status:        status,
 		RSVPType:      req.RSVPType,
 		WaitingReason: waitingReason,
+		PaymentStatus: paymentStatus,
 		PlusOnes:      req.PlusOnes,
 		PlusOneNames:  req.PlusOneNames,
 	}
@@ -419,6 +902,51 @@ func (s *EventService) RSVP(ctx context.Context, userID, eventID string, req *mo
 		return nil, err
 	}
 
+	s.recordRSVPActivity(ctx, event, rsvp)
+	return rsvp, nil
+}
+
+// recordRSVPActivity adds an RSVP to the user's activity timeline
+func (s *EventService) recordRSVPActivity(ctx context.Context, event *model.Event, rsvp *model.EventRSVP) {
+	if rsvp == nil {
+		return
+	}
+	recordActivity(ctx, s.activity, &model.ActivityEntry{
+		UserID:      rsvp.UserID,
+		Type:        model.ActivityRSVPed,
+		SubjectType: model.ActivitySubjectEvent,
+		SubjectID:   event.ID,
+		Data: map[string]string{
+			"event_title": event.Title,
+			"status":      rsvp.Status,
+		},
+	})
+}
+
+// autoDeclineRSVP records a declined RSVP for a user over the guild's no-show threshold
+func (s *EventService) autoDeclineRSVP(ctx context.Context, existingRSVP *model.EventRSVP, userID, eventID string, req *model.RSVPRequest, stats *model.NoShowStats) (*model.EventRSVP, error) {
+	note := fmt.Sprintf("Automatically declined: %d no-shows in the last %d days", stats.NoShows, stats.LookbackDays)
+
+	if existingRSVP != nil {
+		return s.repo.UpdateRSVP(ctx, existingRSVP.ID, map[string]interface{}{
+			"status":       model.RSVPStatusDeclined,
+			"rsvp_type":    req.RSVPType,
+			"host_note":    note,
+			"responded_on": time.Now(),
+		})
+	}
+
+	rsvp := &model.EventRSVP{
+		EventID:  eventID,
+		UserID:   userID,
+		Status:   model.RSVPStatusDeclined,
+		RSVPType: req.RSVPType,
+		HostNote: &note,
+	}
+	if err := s.repo.CreateRSVP(ctx, rsvp); err != nil {
+		return nil, err
+	}
+
 	return rsvp, nil
 }
 
@@ -514,8 +1042,24 @@ func (s *EventService) RespondToRSVP(ctx context.Context, hostUserID, eventID, r
 	if req.Approved {
 		updates["status"] = model.RSVPStatusApproved
 		updates["waiting_reason"] = nil
+		// Approval doesn't waive payment; the guest is let in once they pay
+		if paymentDue(rsvp) {
+			updates["status"] = model.RSVPStatusPending
+			updates["waiting_reason"] = model.WaitingReasonPayment
+		}
 	} else {
 		updates["status"] = model.RSVPStatusDeclined
+
+		event, err := s.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.releaseTicket(ctx, event, rsvpUserID); err != nil {
+			return nil, err
+		}
+		if err := s.refundTicket(ctx, event, rsvpUserID, true); err != nil {
+			return nil, err
+		}
 	}
 
 	if req.Note != nil {
@@ -525,23 +1069,52 @@ func (s *EventService) RespondToRSVP(ctx context.Context, hostUserID, eventID, r
 	return s.repo.UpdateRSVP(ctx, rsvp.ID, updates)
 }
 
-// CancelRSVP allows a user to cancel their own RSVP
-func (s *EventService) CancelRSVP(ctx context.Context, userID, eventID string) error {
+// CancelRSVP allows a user to cancel their own RSVP. The returned
+// operation, if any, restores the RSVP's previous status. Cancelling a
+// ticketed RSVP gives the ticket back, and refunds a paid one under the
+// event's refund policy, so it can't be undone.
+func (s *EventService) CancelRSVP(ctx context.Context, userID, eventID string) (*model.UndoOperation, error) {
 	rsvp, err := s.repo.GetRSVP(ctx, eventID, userID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if rsvp == nil {
-		return ErrRSVPNotFound
+		return nil, ErrRSVPNotFound
+	}
+	if rsvp.Status == model.RSVPStatusCancelled {
+		return nil, nil
 	}
 
-	_, err = s.repo.UpdateRSVP(ctx, rsvp.ID, map[string]interface{}{
+	if _, err := s.repo.UpdateRSVP(ctx, rsvp.ID, map[string]interface{}{
 		"status": model.RSVPStatusCancelled,
-	})
-	return err
+	}); err != nil {
+		return nil, err
+	}
+
+	event, err := s.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Ticketed {
+		if err := s.releaseTicket(ctx, event, userID); err != nil {
+			return nil, err
+		}
+		return nil, s.refundTicket(ctx, event, userID, false)
+	}
+
+	return recordUndo(ctx, s.undo, &model.UndoOperation{
+		UserID:     userID,
+		Type:       model.UndoRSVPCancel,
+		ResourceID: rsvp.ID,
+		Data: map[string]interface{}{
+			"event_id": eventID,
+			"status":   rsvp.Status,
+		},
+	}), nil
 }
 
-// GetPendingRSVPs retrieves pending RSVPs for host review
+// GetPendingRSVPs retrieves pending RSVPs for host review, including each
+// RSVP'er's no-show stats when tracking is enabled
 func (s *EventService) GetPendingRSVPs(ctx context.Context, userID, eventID string) ([]*model.EventRSVP, error) {
 	isHost, err := s.repo.IsHost(ctx, eventID, userID)
 	if err != nil {
@@ -551,23 +1124,69 @@ func (s *EventService) GetPendingRSVPs(ctx context.Context, userID, eventID stri
 		return nil, ErrNotEventHost
 	}
 
-	return s.repo.GetPendingRSVPs(ctx, eventID)
+	rsvps, err := s.repo.GetPendingRSVPs(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.noShowService != nil && len(rsvps) > 0 {
+		event, err := s.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		for _, rsvp := range rsvps {
+			if stats, err := s.noShowService.GetStatsForEvent(ctx, event, rsvp.UserID); err == nil {
+				rsvp.NoShowStats = stats
+			}
+		}
+	}
+
+	return rsvps, nil
 }
 
-// GetGuildEvents retrieves events for a guild
-func (s *EventService) GetGuildEvents(ctx context.Context, guildID string, filters *model.EventSearchFilters) ([]*model.Event, error) {
-	return s.repo.GetByGuild(ctx, guildID, filters)
+// GetGuildEvents retrieves events for a guild, sorted and filtered as list
+// asks within model.EventListFields
+func (s *EventService) GetGuildEvents(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error) {
+	return s.repo.GetByGuild(ctx, guildID, filters, list)
 }
 
-// GetPublicEvents retrieves public events
-func (s *EventService) GetPublicEvents(ctx context.Context, filters *model.EventSearchFilters, limit int) ([]*model.Event, error) {
+// GetGuildEventsPage retrieves a page of a guild's events, sorted and
+// filtered as list asks within model.EventListFields
+func (s *EventService) GetGuildEventsPage(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Event], error) {
+	if page.Limit <= 0 || page.Limit > model.MaxPageSize {
+		page.Limit = model.DefaultPageSize
+	}
+	return s.repo.GetByGuildPage(ctx, guildID, list, page)
+}
+
+// GetPublicEvents retrieves public events. With filters.MatchNeeds the venue
+// must also offer every accessibility feature userID's profile lists.
+func (s *EventService) GetPublicEvents(ctx context.Context, userID string, filters *model.EventSearchFilters, limit int) ([]*model.Event, error) {
 	if limit <= 0 {
 		limit = 20
 	}
+	if filters != nil && filters.MatchNeeds && s.profiles != nil {
+		profile, err := s.profiles.GetByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if profile != nil && len(profile.AccessibilityNeeds) > 0 {
+			matched := *filters
+			matched.Accessibility = append([]string(nil), filters.Accessibility...)
+			for _, need := range profile.AccessibilityNeeds {
+				if !containsString(matched.Accessibility, need) {
+					matched.Accessibility = append(matched.Accessibility, need)
+				}
+			}
+			filters = &matched
+		}
+	}
 	return s.repo.GetPublicEvents(ctx, filters, limit)
 }
 
-// ConfirmCompletion marks event attendance as confirmed (for Resonance)
+// ConfirmCompletion marks event attendance as confirmed (for Resonance), or
+// clears the confirmation when completed is false. Confirming publishes
+// EventCompleted.
 func (s *EventService) ConfirmCompletion(ctx context.Context, userID, eventID string, completed bool) error {
 	rsvp, err := s.repo.GetRSVP(ctx, eventID, userID)
 	if err != nil {
@@ -577,10 +1196,37 @@ func (s *EventService) ConfirmCompletion(ctx context.Context, userID, eventID st
 		return ErrRSVPNotFound
 	}
 
-	_, err = s.repo.UpdateRSVP(ctx, rsvp.ID, map[string]interface{}{
-		"completion_confirmed": completed,
+	if !completed {
+		_, err = s.repo.UpdateRSVP(ctx, rsvp.ID, map[string]interface{}{
+			"completion_confirmed": nil,
+		})
+		return err
+	}
+
+	event, err := s.repo.Get(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return ErrEventNotFound
+	}
+
+	now := time.Now()
+	if _, err := s.repo.UpdateRSVP(ctx, rsvp.ID, map[string]interface{}{
+		"completion_confirmed": now,
+	}); err != nil {
+		return err
+	}
+
+	publishDomainEvents(ctx, s.events, model.EventCompleted{
+		EventID:     eventID,
+		UserID:      userID,
+		StartTime:   event.StartTime,
+		EndTime:     event.EndTime,
+		CheckinTime: rsvp.CheckinTime,
+		ConfirmedOn: now,
 	})
-	return err
+	return nil
 }
 
 // Checkin records event check-in time (for Resonance)
@@ -616,3 +1262,208 @@ func (s *EventService) SubmitFeedback(ctx context.Context, userID, eventID strin
 	})
 	return err
 }
+
+// Affordances returns what userID may do to an event, from its details:
+// hosts may edit and cancel it, as UpdateEvent and CancelEvent require, and
+// others may RSVP when RSVP would accept them
+func (s *EventService) Affordances(details *model.EventWithDetails, userID string) model.Affordances {
+	hosts := make([]*model.EventHost, len(details.Hosts))
+	for i := range details.Hosts {
+		hosts[i] = &details.Hosts[i]
+	}
+	isHost := userID != "" && isEventHost(hosts, userID)
+
+	seat := capacityError(&details.Event, details.AttendeesCount, 1) == nil
+	if details.Event.Ticketed {
+		seat = details.UserTicket != nil || (details.TicketsLeft != nil && *details.TicketsLeft > 0)
+	}
+
+	return model.Affordances{
+		model.ActionEdit:   isHost,
+		model.ActionCancel: isHost,
+		model.ActionRSVP:   userID != "" && rsvpError(&details.Event, details.UserRSVP) == nil && seat,
+	}
+}
+
+// checkRSVPRules enforces the event's minimum tier and RSVP window on a
+// user joining it. Before rsvp_opens_at, the user needs early access from
+// their tier or guild role.
+func (s *EventService) checkRSVPRules(ctx context.Context, event *model.Event, userID string) error {
+	now := time.Now()
+	gated := event.MinTier != nil || event.RSVPOpensAt != nil
+
+	var held *model.GuildTier
+	if s.tiers != nil && gated {
+		var err error
+		if held, err = s.tiers.CheckEventTier(ctx, event, userID, now); err != nil {
+			return err
+		}
+	}
+
+	if event.RSVPOpensAt == nil || !now.Before(*event.RSVPOpensAt) {
+		return nil
+	}
+	role, err := s.memberRole(ctx, event, userID)
+	if err != nil {
+		return err
+	}
+	if opens := event.RSVPOpensFor(held, role); now.Before(*opens) {
+		return &RSVPNotOpenError{OpensAt: *opens}
+	}
+	return nil
+}
+
+// memberRole returns the user's role in the event's guild when the event
+// has early access rules for roles, "" if it doesn't or they aren't a member
+func (s *EventService) memberRole(ctx context.Context, event *model.Event, userID string) (model.GuildRole, error) {
+	if event.GuildID == nil || s.guilds == nil || !event.HasRoleRules() {
+		return "", nil
+	}
+
+	isMember, err := s.guilds.IsMember(ctx, userID, *event.GuildID)
+	if err != nil || !isMember {
+		return "", err
+	}
+	return s.guilds.GetMemberRole(ctx, userID, *event.GuildID)
+}
+
+// validateTier checks that an event can be gated on tierID
+func (s *EventService) validateTier(ctx context.Context, guildID, tierID string) error {
+	if s.tiers == nil {
+		return ErrTierNotFound
+	}
+	return s.tiers.ValidateTier(ctx, guildID, tierID)
+}
+
+// venueForEvent returns the guild's saved venue an event is being held at
+func (s *EventService) venueForEvent(ctx context.Context, guildID, venueID string) (*model.Venue, error) {
+	if s.venues == nil {
+		return nil, ErrVenueNotFound
+	}
+	return s.venues.VenueForEvent(ctx, guildID, venueID)
+}
+
+// checkDuplicateEvent returns a DuplicateError when the event's guild
+// already has an event overlapping it with a similar title: very similar on
+// its own, or loosely similar at the same place. Events outside a guild
+// aren't checked.
+func (s *EventService) checkDuplicateEvent(ctx context.Context, event *model.Event) error {
+	if event.GuildID == nil {
+		return nil
+	}
+
+	// Overlapping events start before this one ends; ones that started up
+	// to a day earlier may still be running
+	end := event.EndOrDefault()
+	startAfter := event.StartTime.Add(-24 * time.Hour)
+	existing, err := s.repo.GetByGuild(ctx, *event.GuildID, &model.EventSearchFilters{
+		StartAfter:  &startAfter,
+		StartBefore: &end,
+	}, model.ListQuery{})
+	if err != nil {
+		return err
+	}
+
+	var candidates []model.DuplicateCandidate
+	for _, other := range existing {
+		if !overlaps(event.StartTime, end, other.StartTime, other.EndOrDefault()) {
+			continue
+		}
+		similarity := model.NameSimilarity(event.Title, other.Title)
+		if similarity < model.DuplicateNameThreshold &&
+			(similarity < model.DuplicateNamePlaceThreshold || !samePlace(event, other)) {
+			continue
+		}
+
+		candidate := model.DuplicateCandidate{
+			Kind:       model.DuplicateEvent,
+			ID:         other.ID,
+			Name:       other.Title,
+			Similarity: similarity,
+			StartTime:  &other.StartTime,
+		}
+		if other.Location != nil {
+			candidate.City = other.Location.City
+		}
+		candidates = append(candidates, candidate)
+	}
+	if len(candidates) > 0 {
+		return &DuplicateError{Candidates: model.SortDuplicateCandidates(candidates)}
+	}
+	return nil
+}
+
+// samePlace reports whether two events are held at the same place: the same
+// saved venue, the same named place in the same city, or within
+// DuplicateNearbyKm of each other. Virtual events are never at the same
+// place.
+func samePlace(a, b *model.Event) bool {
+	if a.VenueID != nil && b.VenueID != nil && *a.VenueID == *b.VenueID {
+		return true
+	}
+	la, lb := a.Location, b.Location
+	if la == nil || lb == nil || la.IsVirtual || lb.IsVirtual {
+		return false
+	}
+	if la.Name != "" && strings.EqualFold(la.Name, lb.Name) && strings.EqualFold(la.City, lb.City) {
+		return true
+	}
+	if (la.Lat == 0 && la.Lng == 0) || (lb.Lat == 0 && lb.Lng == 0) {
+		return false
+	}
+	return NewGeoService().HaversineDistance(la.Lat, la.Lng, lb.Lat, lb.Lng) <= model.DuplicateNearbyKm
+}
+
+// checkVenueCapacity rejects a cap on attendees the venue can't hold
+func checkVenueCapacity(venue *model.Venue, maxAttendees *int) error {
+	if venue.Capacity != nil && maxAttendees != nil && *maxAttendees > *venue.Capacity {
+		return ErrVenueOverCapacity
+	}
+	return nil
+}
+
+// locationUpdate is the stored form of an event location for updates
+func locationUpdate(loc *model.EventLocation) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          loc.Name,
+		"address":       loc.Address,
+		"neighborhood":  loc.Neighborhood,
+		"city":          loc.City,
+		"lat":           loc.Lat,
+		"lng":           loc.Lng,
+		"is_virtual":    loc.IsVirtual,
+		"meet_link":     loc.MeetLink,
+		"accessibility": loc.Accessibility,
+	}
+}
+
+// rsvpError is why a user with the existing RSVP can't RSVP to an event,
+// nil if they can. Only published events take RSVPs.
+func rsvpError(event *model.Event, existing *model.EventRSVP) error {
+	if event.Status != model.EventStatusPublished {
+		return ErrRSVPNotAllowed
+	}
+	if existing != nil && existing.Status == model.RSVPStatusApproved {
+		return ErrAlreadyRSVPd
+	}
+	return nil
+}
+
+// capacityError returns ErrEventFull if seats more attendees wouldn't fit
+// and there's no waitlist to join
+func capacityError(event *model.Event, approved, seats int) error {
+	if event.MaxAttendees != nil && approved+seats > *event.MaxAttendees && !event.WaitlistEnabled {
+		return ErrEventFull
+	}
+	return nil
+}
+
+// isEventHost reports whether userID is among the event's hosts
+func isEventHost(hosts []*model.EventHost, userID string) bool {
+	for _, host := range hosts {
+		if host.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DefaultEventReminderOffsets are used when no offsets are configured
+var DefaultEventReminderOffsets = []time.Duration{24 * time.Hour, 1 * time.Hour}
+
+// EventReminderRepository defines the interface for event reminder storage
+type EventReminderRepository interface {
+	GetUpcomingApprovedRSVPs(ctx context.Context, from, to time.Time) ([]*model.EventReminderCandidate, error)
+	RecordSent(ctx context.Context, sent *model.EventReminderSent) error
+	GetPreference(ctx context.Context, userID string) (*model.EventReminderPreference, error)
+	UpsertPreference(ctx context.Context, pref *model.EventReminderPreference) error
+}
+
+// EventReminderProfileRepository provides profile lookups for timezone-aware formatting
+type EventReminderProfileRepository interface {
+	GetByUserID(ctx context.Context, userID string) (*model.UserProfile, error)
+}
+
+// EventReminderService sends reminders ahead of events to users with approved RSVPs
+type EventReminderService struct {
+	reminderRepo EventReminderRepository
+	profileRepo  EventReminderProfileRepository
+	eventHub     *EventHub
+	pushService  *PushService
+	outbox       NotificationQueue
+	offsets      []time.Duration
+	clock        clock.Clock
+}
+
+// EventReminderServiceConfig holds configuration for the event reminder service
+type EventReminderServiceConfig struct {
+	ReminderRepo EventReminderRepository
+	ProfileRepo  EventReminderProfileRepository
+	EventHub     *EventHub
+	PushService  *PushService
+	Outbox       NotificationQueue // Optional; nil sends reminders inline
+	Offsets      []time.Duration   // Default reminder offsets before event start
+	Clock        clock.Clock       // Default: the system clock
+}
+
+// NewEventReminderService creates a new event reminder service
+func NewEventReminderService(cfg EventReminderServiceConfig) *EventReminderService {
+	offsets := cfg.Offsets
+	if len(offsets) == 0 {
+		offsets = DefaultEventReminderOffsets
+	}
+	return &EventReminderService{
+		reminderRepo: cfg.ReminderRepo,
+		profileRepo:  cfg.ProfileRepo,
+		eventHub:     cfg.EventHub,
+		pushService:  cfg.PushService,
+		outbox:       cfg.Outbox,
+		offsets:      offsets,
+		clock:        clock.OrReal(cfg.Clock),
+	}
+}
+
+// ProcessDueReminders sends all reminders that are due.
+// This should be called periodically by a background job.
+//
+// A reminder for offset O is due once the event starts within O. When several
+// offsets are due at once (e.g. an RSVP approved an hour before the event),
+// only the closest one is delivered and the rest are recorded as sent.
+func (s *EventReminderService) ProcessDueReminders(ctx context.Context) error {
+	now := s.clock.Now()
+
+	candidates, err := s.reminderRepo.GetUpcomingApprovedRSVPs(ctx, now, now.Add(maxEventReminderWindow(s.offsets)))
+	if err != nil {
+		return err
+	}
+
+	prefs := make(map[string]*model.EventReminderPreference)
+	for _, c := range candidates {
+		pref, ok := prefs[c.UserID]
+		if !ok {
+			pref, err = s.reminderRepo.GetPreference(ctx, c.UserID)
+			if err != nil {
+				log.Printf("[EventReminderService] Failed to load preference for user %s: %v", c.UserID, err)
+				continue
+			}
+			prefs[c.UserID] = pref
+		}
+
+		if pref != nil && !pref.Enabled {
+			continue
+		}
+
+		offsets := s.offsets
+		if pref != nil && len(pref.OffsetsMinutes) > 0 {
+			offsets = pref.Offsets()
+		}
+
+		due := dueEventReminderOffsets(offsets, c.StartTime.Sub(now))
+		if len(due) == 0 {
+			continue
+		}
+
+		// Record every due offset first so that concurrent runs cannot double-send;
+		// deliver only if this run claimed the closest offset.
+		deliver := false
+		for i, offset := range due {
+			err := s.reminderRepo.RecordSent(ctx, &model.EventReminderSent{
+				UserID:        c.UserID,
+				EventID:       c.EventID,
+				OffsetMinutes: int(offset / time.Minute),
+			})
+			if err != nil {
+				if !errors.Is(err, database.ErrDuplicate) {
+					log.Printf("[EventReminderService] Failed to record reminder for user %s event %s: %v", c.UserID, c.EventID, err)
+				}
+				continue
+			}
+			if i == 0 {
+				deliver = true
+			}
+		}
+
+		if deliver {
+			s.sendReminder(ctx, c)
+		}
+	}
+
+	return nil
+}
+
+// GetPreference returns the user's reminder preference, or the defaults if none is stored
+func (s *EventReminderService) GetPreference(ctx context.Context, userID string) (*model.EventReminderPreference, error) {
+	pref, err := s.reminderRepo.GetPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pref == nil {
+		pref = &model.EventReminderPreference{
+			UserID:  userID,
+			Enabled: true,
+		}
+	}
+	if len(pref.OffsetsMinutes) == 0 {
+		pref.OffsetsMinutes = make([]int, 0, len(s.offsets))
+		for _, offset := range s.offsets {
+			pref.OffsetsMinutes = append(pref.OffsetsMinutes, int(offset/time.Minute))
+		}
+	}
+	return pref, nil
+}
+
+// UpdatePreference applies the user's reminder preference overrides
+func (s *EventReminderService) UpdatePreference(ctx context.Context, userID string, req *model.UpdateEventReminderPreferenceRequest) (*model.EventReminderPreference, error) {
+	pref, err := s.reminderRepo.GetPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pref == nil {
+		pref = &model.EventReminderPreference{
+			UserID:  userID,
+			Enabled: true,
+		}
+	}
+
+	if req.Enabled != nil {
+		pref.Enabled = *req.Enabled
+	}
+	if req.OffsetsMinutes != nil {
+		pref.OffsetsMinutes = req.OffsetsMinutes
+	}
+
+	if err := s.reminderRepo.UpsertPreference(ctx, pref); err != nil {
+		return nil, err
+	}
+
+	return s.GetPreference(ctx, userID)
+}
+
+// sendReminder delivers the reminder via push, falling back to SSE
+func (s *EventReminderService) sendReminder(ctx context.Context, c *model.EventReminderCandidate) {
+	title := "Upcoming event"
+	message := fmt.Sprintf("%s starts %s", c.Title, s.formatStartTime(ctx, c.UserID, c.StartTime))
+
+	sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, &OutboxNotification{
+		UserID: c.UserID,
+		Push: &PushNotification{
+			Title: title,
+			Body:  message,
+			Data: map[string]string{
+				"event_id":   c.EventID,
+				"start_time": c.StartTime.UTC().Format(time.RFC3339),
+			},
+		},
+		Event: &Event{
+			Type: EventEventReminder,
+			Data: map[string]interface{}{
+				"event_id":   c.EventID,
+				"title":      title,
+				"message":    message,
+				"start_time": c.StartTime.UTC(),
+			},
+		},
+	})
+}
+
+// formatStartTime renders the start time in the user's profile timezone, falling back to UTC
+func (s *EventReminderService) formatStartTime(ctx context.Context, userID string, start time.Time) string {
+	loc := time.UTC
+	if s.profileRepo != nil {
+		if profile, err := s.profileRepo.GetByUserID(ctx, userID); err == nil && profile != nil && profile.Timezone != nil {
+			if l, err := time.LoadLocation(*profile.Timezone); err == nil {
+				loc = l
+			}
+		}
+	}
+	return start.In(loc).Format("Mon Jan 2 at 3:04 PM MST")
+}
+
+// dueEventReminderOffsets returns the offsets that are due for an event starting in
+// timeUntil, closest first
+func dueEventReminderOffsets(offsets []time.Duration, timeUntil time.Duration) []time.Duration {
+	if timeUntil <= 0 {
+		return nil
+	}
+	due := make([]time.Duration, 0, len(offsets))
+	for _, offset := range offsets {
+		if offset > 0 && timeUntil <= offset {
+			due = append(due, offset)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i] < due[j] })
+	return due
+}
+
+// maxEventReminderWindow returns how far ahead to look for events needing reminders.
+// It covers both the configured offsets and the largest offset a user may choose.
+func maxEventReminderWindow(offsets []time.Duration) time.Duration {
+	window := time.Duration(model.MaxEventReminderOffsetMinutes) * time.Minute
+	for _, offset := range offsets {
+		if offset > window {
+			window = offset
+		}
+	}
+	return window
+}
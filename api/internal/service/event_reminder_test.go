@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockEventReminderRepo struct {
+	mu             sync.Mutex
+	candidates     []*model.EventReminderCandidate
+	prefs          map[string]*model.EventReminderPreference
+	sent           map[string]bool
+	upsertPrefFunc func(ctx context.Context, pref *model.EventReminderPreference) error
+}
+
+func newMockEventReminderRepo(candidates ...*model.EventReminderCandidate) *mockEventReminderRepo {
+	return &mockEventReminderRepo{
+		candidates: candidates,
+		prefs:      make(map[string]*model.EventReminderPreference),
+		sent:       make(map[string]bool),
+	}
+}
+
+func (m *mockEventReminderRepo) GetUpcomingApprovedRSVPs(ctx context.Context, from, to time.Time) ([]*model.EventReminderCandidate, error) {
+	var result []*model.EventReminderCandidate
+	for _, c := range m.candidates {
+		if c.StartTime.After(from) && !c.StartTime.After(to) {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockEventReminderRepo) RecordSent(ctx context.Context, sent *model.EventReminderSent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := fmt.Sprintf("%s|%s|%d", sent.UserID, sent.EventID, sent.OffsetMinutes)
+	if m.sent[key] {
+		return database.ErrDuplicate
+	}
+	m.sent[key] = true
+	return nil
+}
+
+func (m *mockEventReminderRepo) GetPreference(ctx context.Context, userID string) (*model.EventReminderPreference, error) {
+	return m.prefs[userID], nil
+}
+
+func (m *mockEventReminderRepo) UpsertPreference(ctx context.Context, pref *model.EventReminderPreference) error {
+	if m.upsertPrefFunc != nil {
+		return m.upsertPrefFunc(ctx, pref)
+	}
+	m.prefs[pref.UserID] = pref
+	return nil
+}
+
+type mockEventReminderProfileRepo struct {
+	timezones map[string]string
+}
+
+func (m *mockEventReminderProfileRepo) GetByUserID(ctx context.Context, userID string) (*model.UserProfile, error) {
+	tz, ok := m.timezones[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &model.UserProfile{UserID: userID, Timezone: &tz}, nil
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+func newTestEventReminderService(repo *mockEventReminderRepo, hub *EventHub, now time.Time) *EventReminderService {
+	return NewEventReminderService(EventReminderServiceConfig{
+		ReminderRepo: repo,
+		ProfileRepo: &mockEventReminderProfileRepo{timezones: map[string]string{
+			"user-ny": "America/New_York",
+		}},
+		EventHub: hub,
+		Clock:    fakeclock.New(now),
+	})
+}
+
+func drainReminderEvents(sub *Subscriber) []*Event {
+	var events []*Event
+	for {
+		select {
+		case e := <-sub.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+// ============================================================================
+// dueEventReminderOffsets Tests
+// ============================================================================
+
+func TestDueEventReminderOffsets(t *testing.T) {
+	t.Parallel()
+
+	offsets := []time.Duration{24 * time.Hour, 1 * time.Hour}
+
+	tests := []struct {
+		name      string
+		timeUntil time.Duration
+		want      []time.Duration
+	}{
+		{"not yet due", 30 * time.Hour, nil},
+		{"day reminder due", 23 * time.Hour, []time.Duration{24 * time.Hour}},
+		{"both due closest first", 30 * time.Minute, []time.Duration{1 * time.Hour, 24 * time.Hour}},
+		{"event already started", -time.Minute, nil},
+	}
+
+	for _, tt := range tests {
+		got := dueEventReminderOffsets(offsets, tt.timeUntil)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			}
+		}
+	}
+}
+
+// ============================================================================
+// ProcessDueReminders Tests
+// ============================================================================
+
+func TestProcessDueReminders_SendsOncePerOffset(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockEventReminderRepo(&model.EventReminderCandidate{
+		EventID:   "event:1",
+		UserID:    "user-1",
+		Title:     "Board games",
+		StartTime: now.Add(23 * time.Hour),
+	})
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("user-1", "sub-1")
+
+	svc := newTestEventReminderService(repo, hub, now)
+
+	if err := svc.ProcessDueReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessDueReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events := drainReminderEvents(sub)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 reminder, got %d", len(events))
+	}
+	if events[0].Type != EventEventReminder {
+		t.Errorf("expected event type %s, got %s", EventEventReminder, events[0].Type)
+	}
+}
+
+func TestProcessDueReminders_LateApprovalSendsClosestOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockEventReminderRepo(&model.EventReminderCandidate{
+		EventID:   "event:1",
+		UserID:    "user-1",
+		Title:     "Board games",
+		StartTime: now.Add(30 * time.Minute),
+	})
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("user-1", "sub-1")
+
+	svc := newTestEventReminderService(repo, hub, now)
+
+	if err := svc.ProcessDueReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(drainReminderEvents(sub)) != 1 {
+		t.Error("expected a single reminder when multiple offsets are due")
+	}
+	if !repo.sent["user-1|event:1|1440"] || !repo.sent["user-1|event:1|60"] {
+		t.Error("expected all due offsets to be recorded as sent")
+	}
+}
+
+func TestProcessDueReminders_RespectsPreferences(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockEventReminderRepo(
+		&model.EventReminderCandidate{EventID: "event:1", UserID: "user-off", StartTime: now.Add(30 * time.Minute)},
+		&model.EventReminderCandidate{EventID: "event:1", UserID: "user-custom", StartTime: now.Add(90 * time.Minute)},
+	)
+	repo.prefs["user-off"] = &model.EventReminderPreference{UserID: "user-off", Enabled: false}
+	repo.prefs["user-custom"] = &model.EventReminderPreference{UserID: "user-custom", Enabled: true, OffsetsMinutes: []int{120}}
+
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	offSub := hub.SubscribeUser("user-off", "sub-off")
+	customSub := hub.SubscribeUser("user-custom", "sub-custom")
+
+	svc := newTestEventReminderService(repo, hub, now)
+
+	if err := svc.ProcessDueReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n := len(drainReminderEvents(offSub)); n != 0 {
+		t.Errorf("expected no reminders for disabled user, got %d", n)
+	}
+	if n := len(drainReminderEvents(customSub)); n != 1 {
+		t.Errorf("expected 1 reminder at custom offset, got %d", n)
+	}
+}
+
+func TestProcessDueReminders_FormatsInUserTimezone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockEventReminderRepo(&model.EventReminderCandidate{
+		EventID:   "event:1",
+		UserID:    "user-ny",
+		Title:     "Picnic",
+		StartTime: now.Add(45 * time.Minute), // 12:45 UTC = 8:45 AM EDT
+	})
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("user-ny", "sub-1")
+
+	svc := newTestEventReminderService(repo, hub, now)
+
+	if err := svc.ProcessDueReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events := drainReminderEvents(sub)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 reminder, got %d", len(events))
+	}
+	data := events[0].Data.(map[string]interface{})
+	message, _ := data["message"].(string)
+	if !strings.Contains(message, "8:45 AM EDT") {
+		t.Errorf("expected message in user's timezone, got %q", message)
+	}
+}
+
+// ============================================================================
+// Preference Tests
+// ============================================================================
+
+func TestGetPreference_DefaultsWhenUnset(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	svc := newTestEventReminderService(newMockEventReminderRepo(), nil, time.Now())
+
+	pref, err := svc.GetPreference(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !pref.Enabled {
+		t.Error("expected reminders enabled by default")
+	}
+	if len(pref.OffsetsMinutes) != 2 || pref.OffsetsMinutes[0] != 1440 || pref.OffsetsMinutes[1] != 60 {
+		t.Errorf("expected default offsets [1440 60], got %v", pref.OffsetsMinutes)
+	}
+}
+
+func TestUpdatePreference_AppliesOverrides(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockEventReminderRepo()
+	svc := newTestEventReminderService(repo, nil, time.Now())
+
+	disabled := false
+	pref, err := svc.UpdatePreference(ctx, "user-1", &model.UpdateEventReminderPreferenceRequest{
+		Enabled:        &disabled,
+		OffsetsMinutes: []int{30},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pref.Enabled {
+		t.Error("expected reminders disabled")
+	}
+	if len(pref.OffsetsMinutes) != 1 || pref.OffsetsMinutes[0] != 30 {
+		t.Errorf("expected offsets [30], got %v", pref.OffsetsMinutes)
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/forgo/saga/api/internal/model"
 )
@@ -35,17 +36,40 @@ type InterestServiceForRoles interface {
 	GetUserInterests(ctx context.Context, userID string) ([]*model.UserInterest, error)
 }
 
+// EventRoleEventRepository provides host checks for reviewing role nominations
+type EventRoleEventRepository interface {
+	IsHost(ctx context.Context, eventID, userID string) (bool, error)
+}
+
 // EventRoleService handles event role business logic
 type EventRoleService struct {
 	repo            EventRoleRepositoryInterface
 	interestService InterestServiceForRoles
+	eventRepo       EventRoleEventRepository
+	eventHub        *EventHub
+	pushService     *PushService
+	outbox          NotificationQueue
+}
+
+// EventRoleServiceConfig holds configuration for the event role service
+type EventRoleServiceConfig struct {
+	Repo            EventRoleRepositoryInterface
+	InterestService InterestServiceForRoles
+	EventRepo       EventRoleEventRepository
+	EventHub        *EventHub
+	PushService     *PushService
+	Outbox          NotificationQueue // Optional; nil sends notifications inline
 }
 
 // NewEventRoleService creates a new event role service
-func NewEventRoleService(repo EventRoleRepositoryInterface, interestService InterestServiceForRoles) *EventRoleService {
+func NewEventRoleService(cfg EventRoleServiceConfig) *EventRoleService {
 	return &EventRoleService{
-		repo:            repo,
-		interestService: interestService,
+		repo:            cfg.Repo,
+		interestService: cfg.InterestService,
+		eventRepo:       cfg.EventRepo,
+		eventHub:        cfg.EventHub,
+		pushService:     cfg.PushService,
+		outbox:          cfg.Outbox,
 	}
 }
 
@@ -67,6 +91,14 @@ func (s *EventRoleService) CreateRole(ctx context.Context, eventID, hostUserID s
 		maxSlots = model.DefaultMaxSlotsPerRole
 	}
 
+	assignmentMode := req.AssignmentMode
+	if assignmentMode == "" {
+		assignmentMode = model.RoleAssignmentModeDirect
+	}
+	if !model.IsValidRoleAssignmentMode(assignmentMode) {
+		return nil, ErrInvalidAssignmentMode
+	}
+
 	// Create the role
 	role := &model.EventRole{
 		EventID:            eventID,
@@ -75,6 +107,8 @@ func (s *EventRoleService) CreateRole(ctx context.Context, eventID, hostUserID s
 		MaxSlots:           maxSlots,
 		IsDefault:          false,
 		SortOrder:          len(existing) + 1,
+		AssignmentMode:     assignmentMode,
+		IsCritical:         req.IsCritical,
 		CreatedBy:          hostUserID,
 		SuggestedInterests: req.SuggestedInterests,
 	}
@@ -90,13 +124,14 @@ func (s *EventRoleService) CreateRole(ctx context.Context, eventID, hostUserID s
 func (s *EventRoleService) CreateDefaultRole(ctx context.Context, eventID, hostUserID string, maxSlots int) (*model.EventRole, error) {
 	description := model.DefaultRoleDescription
 	role := &model.EventRole{
-		EventID:     eventID,
-		Name:        model.DefaultRoleName,
-		Description: &description,
-		MaxSlots:    maxSlots,
-		IsDefault:   true,
-		SortOrder:   0,
-		CreatedBy:   hostUserID,
+		EventID:        eventID,
+		Name:           model.DefaultRoleName,
+		Description:    &description,
+		MaxSlots:       maxSlots,
+		IsDefault:      true,
+		SortOrder:      0,
+		AssignmentMode: model.RoleAssignmentModeDirect,
+		CreatedBy:      hostUserID,
 	}
 
 	if err := s.repo.CreateRole(ctx, role); err != nil {
@@ -133,7 +168,11 @@ func (s *EventRoleService) GetEventRolesOverview(ctx context.Context, eventID st
 	totalAttendees := 0
 	totalSlots := 0
 	for _, rwa := range rolesWithAssignments {
-		totalAttendees += len(rwa.Assignments)
+		for _, a := range rwa.Assignments {
+			if a.Status == model.RoleAssignmentStatusConfirmed {
+				totalAttendees++
+			}
+		}
 		// Count total slots (0 = unlimited, so don't count those)
 		if rwa.Role.MaxSlots > 0 {
 			totalSlots += rwa.Role.MaxSlots
@@ -215,6 +254,15 @@ func (s *EventRoleService) UpdateRole(ctx context.Context, roleID string, req *m
 	if req.SuggestedInterests != nil {
 		updates["suggested_interests"] = req.SuggestedInterests
 	}
+	if req.AssignmentMode != nil {
+		if !model.IsValidRoleAssignmentMode(*req.AssignmentMode) {
+			return nil, ErrInvalidAssignmentMode
+		}
+		updates["assignment_mode"] = *req.AssignmentMode
+	}
+	if req.IsCritical != nil {
+		updates["is_critical"] = *req.IsCritical
+	}
 
 	if len(updates) == 0 {
 		return role, nil
@@ -250,30 +298,14 @@ func (s *EventRoleService) AssignRole(ctx context.Context, userID string, req *m
 	if role == nil {
 		return nil, ErrRoleNotFound
 	}
-
-	// Check if user is already assigned to THIS specific role
-	existingForRole, err := s.repo.GetUserAssignmentForRole(ctx, req.RoleID, userID)
-	if err != nil {
-		return nil, err
-	}
-	if existingForRole != nil && existingForRole.Status != model.RoleAssignmentStatusCancelled {
-		return nil, ErrAlreadyAssignedToRole
+	if role.AssignmentMode == model.RoleAssignmentModeApproval {
+		return nil, ErrRoleRequiresApproval
 	}
 
-	// Check if user has reached max roles per user limit
-	existingAssignments, err := s.repo.GetUserAssignmentsForEvent(ctx, role.EventID, userID)
+	existingForRole, err := s.checkCanTakeRole(ctx, role, userID)
 	if err != nil {
 		return nil, err
 	}
-	activeCount := 0
-	for _, a := range existingAssignments {
-		if a.Status != model.RoleAssignmentStatusCancelled {
-			activeCount++
-		}
-	}
-	if activeCount >= MaxRolesPerUser {
-		return nil, ErrMaxRolesPerUserReached
-	}
 
 	// Check if role is full
 	if role.MaxSlots > 0 {
@@ -295,7 +327,45 @@ func (s *EventRoleService) AssignRole(ctx context.Context, userID string, req *m
 		Status:  model.RoleAssignmentStatusConfirmed,
 	}
 
-	if err := s.repo.CreateAssignment(ctx, assignment); err != nil {
+	assignment, err = s.saveAssignment(ctx, existingForRole, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment.RoleName = &role.Name
+	return assignment, nil
+}
+
+// NominateForRole nominates the current user for an approval-mode role.
+// The nomination stays pending until a host approves or declines it; capacity
+// is only checked at approval time.
+func (s *EventRoleService) NominateForRole(ctx context.Context, userID, eventID, roleID string, req *model.NominateRoleRequest) (*model.EventRoleAssignment, error) {
+	role, err := s.repo.GetRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil || role.EventID != eventID {
+		return nil, ErrRoleNotFound
+	}
+	if role.AssignmentMode != model.RoleAssignmentModeApproval {
+		return nil, ErrRoleNotApprovalMode
+	}
+
+	existingForRole, err := s.checkCanTakeRole(ctx, role, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment := &model.EventRoleAssignment{
+		EventID: role.EventID,
+		RoleID:  role.ID,
+		UserID:  userID,
+		Note:    req.Note,
+		Status:  model.RoleAssignmentStatusPending,
+	}
+
+	assignment, err = s.saveAssignment(ctx, existingForRole, assignment)
+	if err != nil {
 		return nil, err
 	}
 
@@ -303,6 +373,69 @@ func (s *EventRoleService) AssignRole(ctx context.Context, userID string, req *m
 	return assignment, nil
 }
 
+// GetPendingNominations lists nominations awaiting a decision for an event (hosts only)
+func (s *EventRoleService) GetPendingNominations(ctx context.Context, hostUserID, eventID string) ([]*model.EventRoleAssignment, error) {
+	if err := s.requireHost(ctx, eventID, hostUserID); err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.repo.GetAssignmentsByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.repo.GetRolesByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	roleNames := make(map[string]string, len(roles))
+	for _, role := range roles {
+		roleNames[role.ID] = role.Name
+	}
+
+	pending := make([]*model.EventRoleAssignment, 0)
+	for _, a := range assignments {
+		if a.Status != model.RoleAssignmentStatusPending {
+			continue
+		}
+		if name, ok := roleNames[a.RoleID]; ok {
+			a.RoleName = &name
+		}
+		pending = append(pending, a)
+	}
+	return pending, nil
+}
+
+// ApproveNomination confirms a pending nomination (hosts only).
+// Fails with ErrRoleFull if the role has no slots left.
+func (s *EventRoleService) ApproveNomination(ctx context.Context, hostUserID, eventID, assignmentID string, req *model.ReviewNominationRequest) (*model.EventRoleAssignment, error) {
+	assignment, role, err := s.getPendingNomination(ctx, hostUserID, eventID, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.MaxSlots > 0 {
+		count, err := s.repo.CountAssignmentsByRole(ctx, role.ID)
+		if err != nil {
+			return nil, err
+		}
+		if count >= role.MaxSlots {
+			return nil, ErrRoleFull
+		}
+	}
+
+	return s.reviewNomination(ctx, assignment, role, hostUserID, model.RoleAssignmentStatusConfirmed, req)
+}
+
+// DeclineNomination declines a pending nomination (hosts only)
+func (s *EventRoleService) DeclineNomination(ctx context.Context, hostUserID, eventID, assignmentID string, req *model.ReviewNominationRequest) (*model.EventRoleAssignment, error) {
+	assignment, role, err := s.getPendingNomination(ctx, hostUserID, eventID, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.reviewNomination(ctx, assignment, role, hostUserID, model.RoleAssignmentStatusDeclined, req)
+}
+
 // UpdateAssignment updates an assignment (user can update their own note)
 func (s *EventRoleService) UpdateAssignment(ctx context.Context, userID, assignmentID string, req *model.UpdateAssignmentRequest) (*model.EventRoleAssignment, error) {
 	assignment, err := s.repo.GetAssignment(ctx, assignmentID)
@@ -411,6 +544,161 @@ func (s *EventRoleService) GetRoleSuggestions(ctx context.Context, eventID, user
 	return suggestions, nil
 }
 
+// ===== Helpers =====
+
+// checkCanTakeRole enforces the per-role and per-user limits for a new assignment
+// or nomination. It returns the user's inactive (cancelled or declined) assignment
+// for the role, if any, so it can be reused.
+func (s *EventRoleService) checkCanTakeRole(ctx context.Context, role *model.EventRole, userID string) (*model.EventRoleAssignment, error) {
+	// Check if user is already assigned to (or nominated for) THIS specific role
+	existingForRole, err := s.repo.GetUserAssignmentForRole(ctx, role.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existingForRole != nil && model.IsActiveRoleAssignmentStatus(existingForRole.Status) {
+		return nil, ErrAlreadyAssignedToRole
+	}
+
+	// Check if user has reached max roles per user limit
+	existingAssignments, err := s.repo.GetUserAssignmentsForEvent(ctx, role.EventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	activeCount := 0
+	for _, a := range existingAssignments {
+		if model.IsActiveRoleAssignmentStatus(a.Status) {
+			activeCount++
+		}
+	}
+	if activeCount >= MaxRolesPerUser {
+		return nil, ErrMaxRolesPerUserReached
+	}
+
+	return existingForRole, nil
+}
+
+// saveAssignment creates the assignment, or reactivates the user's previous
+// assignment for the role since there is one record per user and role
+func (s *EventRoleService) saveAssignment(ctx context.Context, previous, assignment *model.EventRoleAssignment) (*model.EventRoleAssignment, error) {
+	if previous == nil {
+		if err := s.repo.CreateAssignment(ctx, assignment); err != nil {
+			return nil, err
+		}
+		return assignment, nil
+	}
+
+	updates := map[string]interface{}{
+		"status": assignment.Status,
+	}
+	if assignment.Note != nil {
+		updates["note"] = *assignment.Note
+	}
+	return s.repo.UpdateAssignment(ctx, previous.ID, updates)
+}
+
+func (s *EventRoleService) requireHost(ctx context.Context, eventID, userID string) error {
+	if s.eventRepo == nil {
+		return ErrNotEventHost
+	}
+	isHost, err := s.eventRepo.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if !isHost {
+		return ErrNotEventHost
+	}
+	return nil
+}
+
+// getPendingNomination loads a nomination for review and checks the caller hosts its event
+func (s *EventRoleService) getPendingNomination(ctx context.Context, hostUserID, eventID, assignmentID string) (*model.EventRoleAssignment, *model.EventRole, error) {
+	if err := s.requireHost(ctx, eventID, hostUserID); err != nil {
+		return nil, nil, err
+	}
+
+	assignment, err := s.repo.GetAssignment(ctx, assignmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if assignment == nil || assignment.EventID != eventID {
+		return nil, nil, ErrAssignmentNotFound
+	}
+	if assignment.Status != model.RoleAssignmentStatusPending {
+		return nil, nil, ErrNominationNotPending
+	}
+
+	role, err := s.repo.GetRole(ctx, assignment.RoleID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if role == nil {
+		return nil, nil, ErrRoleNotFound
+	}
+
+	return assignment, role, nil
+}
+
+// reviewNomination records the host's decision and notifies the nominee
+func (s *EventRoleService) reviewNomination(ctx context.Context, assignment *model.EventRoleAssignment, role *model.EventRole, hostUserID, status string, req *model.ReviewNominationRequest) (*model.EventRoleAssignment, error) {
+	updates := map[string]interface{}{
+		"status":      status,
+		"reviewed_by": hostUserID,
+	}
+	if req != nil && req.Response != nil {
+		updates["response"] = *req.Response
+	}
+
+	updated, err := s.repo.UpdateAssignment(ctx, assignment.ID, updates)
+	if err != nil {
+		return nil, err
+	}
+	updated.RoleName = &role.Name
+
+	s.notifyNominee(ctx, updated, role)
+	return updated, nil
+}
+
+// notifyNominee tells the nominee about the host's decision via push, falling back to SSE
+func (s *EventRoleService) notifyNominee(ctx context.Context, assignment *model.EventRoleAssignment, role *model.EventRole) {
+	title := "Role nomination approved"
+	message := fmt.Sprintf("You're confirmed as %s", role.Name)
+	if assignment.Status == model.RoleAssignmentStatusDeclined {
+		title = "Role nomination declined"
+		message = fmt.Sprintf("Your nomination for %s was declined", role.Name)
+	}
+
+	data := map[string]interface{}{
+		"event_id":      assignment.EventID,
+		"role_id":       assignment.RoleID,
+		"role_name":     role.Name,
+		"assignment_id": assignment.ID,
+		"status":        assignment.Status,
+		"title":         title,
+		"message":       message,
+	}
+	if assignment.Response != nil {
+		data["response"] = *assignment.Response
+	}
+
+	sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, &OutboxNotification{
+		UserID: assignment.UserID,
+		Push: &PushNotification{
+			Title: title,
+			Body:  message,
+			Data: map[string]string{
+				"event_id":      assignment.EventID,
+				"role_id":       assignment.RoleID,
+				"assignment_id": assignment.ID,
+				"status":        assignment.Status,
+			},
+		},
+		Event: &Event{
+			Type: EventRoleNomination,
+			Data: data,
+		},
+	})
+}
+
 // AddRole is an alias for AssignRole - users can take on multiple roles at an event
 func (s *EventRoleService) AddRole(ctx context.Context, userID string, req *model.AssignRoleRequest) (*model.EventRoleAssignment, error) {
 	return s.AssignRole(ctx, userID, req)
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+const (
+	// DefaultRoleGapWindow is how long before an event hosts are alerted about unfilled critical roles
+	DefaultRoleGapWindow = 3 * 24 * time.Hour
+	// DefaultRoleAssigneeWindow is how long before an event assignees are reminded of their role
+	DefaultRoleAssigneeWindow = 24 * time.Hour
+)
+
+// EventRoleAlertRepository defines the interface for role alert storage
+type EventRoleAlertRepository interface {
+	GetCriticalRoles(ctx context.Context, from, to time.Time) ([]*model.RoleGapCandidate, error)
+	GetConfirmedAssignments(ctx context.Context, from, to time.Time) ([]*model.RoleAssigneeCandidate, error)
+	RecordAlertSent(ctx context.Context, kind, roleID, userID string) error
+}
+
+// EventRoleAlertHostRepository provides event host lookups
+type EventRoleAlertHostRepository interface {
+	GetHosts(ctx context.Context, eventID string) ([]*model.EventHost, error)
+}
+
+// EventRoleAlertService alerts hosts about unfilled critical roles and reminds
+// assignees of their role the day before an event
+type EventRoleAlertService struct {
+	alertRepo      EventRoleAlertRepository
+	hostRepo       EventRoleAlertHostRepository
+	eventHub       *EventHub
+	pushService    *PushService
+	outbox         NotificationQueue
+	gapWindow      time.Duration
+	assigneeWindow time.Duration
+	clock          clock.Clock
+}
+
+// EventRoleAlertServiceConfig holds configuration for the event role alert service
+type EventRoleAlertServiceConfig struct {
+	AlertRepo      EventRoleAlertRepository
+	HostRepo       EventRoleAlertHostRepository
+	EventHub       *EventHub
+	PushService    *PushService
+	Outbox         NotificationQueue // Optional; nil sends alerts inline
+	GapWindow      time.Duration     // How long before the event to alert hosts (default 3 days)
+	AssigneeWindow time.Duration     // How long before the event to remind assignees (default 24h)
+	Clock          clock.Clock       // Default: the system clock
+}
+
+// NewEventRoleAlertService creates a new event role alert service
+func NewEventRoleAlertService(cfg EventRoleAlertServiceConfig) *EventRoleAlertService {
+	gapWindow := cfg.GapWindow
+	if gapWindow <= 0 {
+		gapWindow = DefaultRoleGapWindow
+	}
+	assigneeWindow := cfg.AssigneeWindow
+	if assigneeWindow <= 0 {
+		assigneeWindow = DefaultRoleAssigneeWindow
+	}
+	return &EventRoleAlertService{
+		alertRepo:      cfg.AlertRepo,
+		hostRepo:       cfg.HostRepo,
+		eventHub:       cfg.EventHub,
+		pushService:    cfg.PushService,
+		outbox:         cfg.Outbox,
+		gapWindow:      gapWindow,
+		assigneeWindow: assigneeWindow,
+		clock:          clock.OrReal(cfg.Clock),
+	}
+}
+
+// ProcessRoleAlerts sends due gap alerts and assignee reminders.
+// This should be called periodically by a background job.
+//
+// Each host is alerted at most once per critical role, and each assignee is
+// reminded at most once per role.
+func (s *EventRoleAlertService) ProcessRoleAlerts(ctx context.Context) error {
+	now := s.clock.Now()
+
+	if err := s.processGapAlerts(ctx, now); err != nil {
+		return fmt.Errorf("failed to process role gap alerts: %w", err)
+	}
+	if err := s.processAssigneeReminders(ctx, now); err != nil {
+		return fmt.Errorf("failed to process role assignee reminders: %w", err)
+	}
+	return nil
+}
+
+// processGapAlerts alerts hosts of events starting within the gap window
+// whose critical roles still have open slots
+func (s *EventRoleAlertService) processGapAlerts(ctx context.Context, now time.Time) error {
+	roles, err := s.alertRepo.GetCriticalRoles(ctx, now, now.Add(s.gapWindow))
+	if err != nil {
+		return err
+	}
+
+	hostsByEvent := make(map[string][]*model.EventHost)
+	for _, role := range roles {
+		open := role.OpenSlots()
+		if open == 0 {
+			continue
+		}
+
+		hosts, ok := hostsByEvent[role.EventID]
+		if !ok {
+			hosts, err = s.hostRepo.GetHosts(ctx, role.EventID)
+			if err != nil {
+				log.Printf("[EventRoleAlertService] Failed to get hosts for event %s: %v", role.EventID, err)
+				continue
+			}
+			hostsByEvent[role.EventID] = hosts
+		}
+
+		message := fmt.Sprintf("%s for %s still has %d open %s and the event starts in %s",
+			role.RoleName, role.EventTitle, open, pluralizeSpots(open), formatTimeRemaining(role.StartTime.Sub(now)))
+		for _, host := range hosts {
+			if !s.claimAlert(ctx, model.RoleAlertKindGap, role.RoleID, host.UserID) {
+				continue
+			}
+			s.sendAlert(ctx, host.UserID, "Role still unfilled", message, map[string]interface{}{
+				"kind":       model.RoleAlertKindGap,
+				"event_id":   role.EventID,
+				"role_id":    role.RoleID,
+				"role_name":  role.RoleName,
+				"open_slots": open,
+				"start_time": role.StartTime.UTC(),
+			})
+		}
+	}
+
+	return nil
+}
+
+// processAssigneeReminders reminds confirmed assignees of events starting within the assignee window
+func (s *EventRoleAlertService) processAssigneeReminders(ctx context.Context, now time.Time) error {
+	assignments, err := s.alertRepo.GetConfirmedAssignments(ctx, now, now.Add(s.assigneeWindow))
+	if err != nil {
+		return err
+	}
+
+	for _, a := range assignments {
+		if !s.claimAlert(ctx, model.RoleAlertKindAssignee, a.RoleID, a.UserID) {
+			continue
+		}
+		message := fmt.Sprintf("You're %s for %s, which starts in %s",
+			a.RoleName, a.EventTitle, formatTimeRemaining(a.StartTime.Sub(now)))
+		s.sendAlert(ctx, a.UserID, "Your role is coming up", message, map[string]interface{}{
+			"kind":       model.RoleAlertKindAssignee,
+			"event_id":   a.EventID,
+			"role_id":    a.RoleID,
+			"role_name":  a.RoleName,
+			"start_time": a.StartTime.UTC(),
+		})
+	}
+
+	return nil
+}
+
+// claimAlert records the alert before sending so that concurrent runs cannot double-send
+func (s *EventRoleAlertService) claimAlert(ctx context.Context, kind, roleID, userID string) bool {
+	if err := s.alertRepo.RecordAlertSent(ctx, kind, roleID, userID); err != nil {
+		if !errors.Is(err, database.ErrDuplicate) {
+			log.Printf("[EventRoleAlertService] Failed to record %s alert for user %s role %s: %v", kind, userID, roleID, err)
+		}
+		return false
+	}
+	return true
+}
+
+// sendAlert delivers the alert via push, falling back to SSE
+func (s *EventRoleAlertService) sendAlert(ctx context.Context, userID, title, message string, data map[string]interface{}) {
+	push := &PushNotification{
+		Title: title,
+		Body:  message,
+		Data: map[string]string{
+			"kind":     fmt.Sprint(data["kind"]),
+			"event_id": fmt.Sprint(data["event_id"]),
+			"role_id":  fmt.Sprint(data["role_id"]),
+		},
+	}
+
+	data["title"] = title
+	data["message"] = message
+	sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, &OutboxNotification{
+		UserID: userID,
+		Push:   push,
+		Event: &Event{
+			Type: EventRoleAlert,
+			Data: data,
+		},
+	})
+}
+
+func pluralizeSpots(n int) string {
+	if n == 1 {
+		return "spot"
+	}
+	return "spots"
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockEventRoleAlertRepo struct {
+	mu          sync.Mutex
+	roles       []*model.RoleGapCandidate
+	assignments []*model.RoleAssigneeCandidate
+	sent        map[string]bool
+}
+
+func newMockEventRoleAlertRepo() *mockEventRoleAlertRepo {
+	return &mockEventRoleAlertRepo{sent: make(map[string]bool)}
+}
+
+func (m *mockEventRoleAlertRepo) GetCriticalRoles(ctx context.Context, from, to time.Time) ([]*model.RoleGapCandidate, error) {
+	var result []*model.RoleGapCandidate
+	for _, r := range m.roles {
+		if r.StartTime.After(from) && !r.StartTime.After(to) {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockEventRoleAlertRepo) GetConfirmedAssignments(ctx context.Context, from, to time.Time) ([]*model.RoleAssigneeCandidate, error) {
+	var result []*model.RoleAssigneeCandidate
+	for _, a := range m.assignments {
+		if a.StartTime.After(from) && !a.StartTime.After(to) {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockEventRoleAlertRepo) RecordAlertSent(ctx context.Context, kind, roleID, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := kind + "|" + roleID + "|" + userID
+	if m.sent[key] {
+		return database.ErrDuplicate
+	}
+	m.sent[key] = true
+	return nil
+}
+
+type mockEventRoleAlertHostRepo struct {
+	hosts map[string][]string
+}
+
+func (m *mockEventRoleAlertHostRepo) GetHosts(ctx context.Context, eventID string) ([]*model.EventHost, error) {
+	var result []*model.EventHost
+	for _, userID := range m.hosts[eventID] {
+		result = append(result, &model.EventHost{EventID: eventID, UserID: userID})
+	}
+	return result, nil
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+func newTestEventRoleAlertService(repo *mockEventRoleAlertRepo, hub *EventHub, now time.Time) *EventRoleAlertService {
+	return NewEventRoleAlertService(EventRoleAlertServiceConfig{
+		AlertRepo: repo,
+		HostRepo:  &mockEventRoleAlertHostRepo{hosts: map[string][]string{"event:1": {"host-1", "host-2"}}},
+		EventHub:  hub,
+		Clock:     fakeclock.New(now),
+	})
+}
+
+// ============================================================================
+// ProcessRoleAlerts Tests
+// ============================================================================
+
+func TestProcessRoleAlerts_AlertsHostsOnceForUnfilledCriticalRole(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockEventRoleAlertRepo()
+	repo.roles = []*model.RoleGapCandidate{
+		{EventID: "event:1", EventTitle: "Potluck", StartTime: now.Add(48 * time.Hour), RoleID: "role:1", RoleName: "Grill master", MaxSlots: 2, FilledSlots: 1},
+		{EventID: "event:1", EventTitle: "Potluck", StartTime: now.Add(48 * time.Hour), RoleID: "role:2", RoleName: "DJ", MaxSlots: 1, FilledSlots: 1},
+	}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	host1 := hub.SubscribeUser("host-1", "sub-1")
+	host2 := hub.SubscribeUser("host-2", "sub-2")
+
+	svc := newTestEventRoleAlertService(repo, hub, now)
+
+	if err := svc.ProcessRoleAlerts(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessRoleAlerts(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, sub := range []*Subscriber{host1, host2} {
+		events := drainReminderEvents(sub)
+		if len(events) != 1 {
+			t.Fatalf("expected 1 gap alert per host, got %d", len(events))
+		}
+		data := events[0].Data.(map[string]interface{})
+		if data["kind"] != model.RoleAlertKindGap || data["role_id"] != "role:1" {
+			t.Errorf("expected gap alert for role:1, got %v", data)
+		}
+		if msg, _ := data["message"].(string); !strings.Contains(msg, "1 open spot") {
+			t.Errorf("expected message to mention open spots, got %q", msg)
+		}
+	}
+}
+
+func TestProcessRoleAlerts_GapOutsideWindow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockEventRoleAlertRepo()
+	repo.roles = []*model.RoleGapCandidate{
+		{EventID: "event:1", EventTitle: "Potluck", StartTime: now.Add(5 * 24 * time.Hour), RoleID: "role:1", RoleName: "DJ", MaxSlots: 1},
+	}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("host-1", "sub-1")
+
+	svc := newTestEventRoleAlertService(repo, hub, now)
+
+	if err := svc.ProcessRoleAlerts(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if events := drainReminderEvents(sub); len(events) != 0 {
+		t.Errorf("expected no alert more than 3 days out, got %d", len(events))
+	}
+}
+
+func TestProcessRoleAlerts_RemindsAssigneesDayBefore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockEventRoleAlertRepo()
+	repo.assignments = []*model.RoleAssigneeCandidate{
+		{EventID: "event:1", EventTitle: "Potluck", StartTime: now.Add(20 * time.Hour), RoleID: "role:1", RoleName: "DJ", UserID: "user-1"},
+		{EventID: "event:2", EventTitle: "Hike", StartTime: now.Add(48 * time.Hour), RoleID: "role:9", RoleName: "Navigator", UserID: "user-2"},
+	}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	user1 := hub.SubscribeUser("user-1", "sub-1")
+	user2 := hub.SubscribeUser("user-2", "sub-2")
+
+	svc := newTestEventRoleAlertService(repo, hub, now)
+
+	if err := svc.ProcessRoleAlerts(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessRoleAlerts(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events := drainReminderEvents(user1)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 assignee reminder, got %d", len(events))
+	}
+	if events[0].Type != EventRoleAlert {
+		t.Errorf("expected event type %s, got %s", EventRoleAlert, events[0].Type)
+	}
+	if msg, _ := events[0].Data.(map[string]interface{})["message"].(string); !strings.Contains(msg, "DJ") {
+		t.Errorf("expected message to name the role, got %q", msg)
+	}
+
+	if events := drainReminderEvents(user2); len(events) != 0 {
+		t.Errorf("expected no reminder two days out, got %d", len(events))
+	}
+}
+
+func TestRoleGapCandidate_OpenSlots(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		maxSlots, filled, want int
+	}{
+		{2, 0, 2},
+		{2, 1, 1},
+		{1, 1, 0},
+		{0, 0, 1}, // unlimited roles need at least one person
+		{0, 3, 0},
+	}
+
+	for _, tt := range tests {
+		c := &model.RoleGapCandidate{MaxSlots: tt.maxSlots, FilledSlots: tt.filled}
+		if got := c.OpenSlots(); got != tt.want {
+			t.Errorf("OpenSlots(max=%d, filled=%d) = %d, want %d", tt.maxSlots, tt.filled, got, tt.want)
+		}
+	}
+}
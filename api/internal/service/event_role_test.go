@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// mockEventRoleRepo is an in-memory role and assignment store
+type mockEventRoleRepo struct {
+	roles       map[string]*model.EventRole
+	assignments map[string]*model.EventRoleAssignment
+	nextID      int
+}
+
+func newMockEventRoleRepo(roles ...*model.EventRole) *mockEventRoleRepo {
+	m := &mockEventRoleRepo{
+		roles:       make(map[string]*model.EventRole),
+		assignments: make(map[string]*model.EventRoleAssignment),
+	}
+	for _, r := range roles {
+		m.roles[r.ID] = r
+	}
+	return m
+}
+
+func (m *mockEventRoleRepo) CreateRole(ctx context.Context, role *model.EventRole) error {
+	m.nextID++
+	role.ID = fmt.Sprintf("event_role:%d", m.nextID)
+	m.roles[role.ID] = role
+	return nil
+}
+
+func (m *mockEventRoleRepo) GetRole(ctx context.Context, roleID string) (*model.EventRole, error) {
+	return m.roles[roleID], nil
+}
+
+func (m *mockEventRoleRepo) GetRolesByEvent(ctx context.Context, eventID string) ([]*model.EventRole, error) {
+	var result []*model.EventRole
+	for _, r := range m.roles {
+		if r.EventID == eventID {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockEventRoleRepo) UpdateRole(ctx context.Context, roleID string, updates map[string]interface{}) (*model.EventRole, error) {
+	return m.roles[roleID], nil
+}
+
+func (m *mockEventRoleRepo) DeleteRole(ctx context.Context, roleID string) error {
+	delete(m.roles, roleID)
+	return nil
+}
+
+func (m *mockEventRoleRepo) CreateAssignment(ctx context.Context, assignment *model.EventRoleAssignment) error {
+	m.nextID++
+	assignment.ID = fmt.Sprintf("event_role_assignment:%d", m.nextID)
+	m.assignments[assignment.ID] = assignment
+	return nil
+}
+
+func (m *mockEventRoleRepo) GetAssignment(ctx context.Context, assignmentID string) (*model.EventRoleAssignment, error) {
+	return m.assignments[assignmentID], nil
+}
+
+func (m *mockEventRoleRepo) GetUserAssignmentForRole(ctx context.Context, roleID, userID string) (*model.EventRoleAssignment, error) {
+	for _, a := range m.assignments {
+		if a.RoleID == roleID && a.UserID == userID {
+			return a, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockEventRoleRepo) GetUserAssignmentsForEvent(ctx context.Context, eventID, userID string) ([]*model.EventRoleAssignment, error) {
+	var result []*model.EventRoleAssignment
+	for _, a := range m.assignments {
+		if a.EventID == eventID && a.UserID == userID {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockEventRoleRepo) GetAssignmentsByRole(ctx context.Context, roleID string) ([]*model.EventRoleAssignment, error) {
+	var result []*model.EventRoleAssignment
+	for _, a := range m.assignments {
+		if a.RoleID == roleID && model.IsActiveRoleAssignmentStatus(a.Status) {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockEventRoleRepo) GetAssignmentsByEvent(ctx context.Context, eventID string) ([]*model.EventRoleAssignment, error) {
+	var result []*model.EventRoleAssignment
+	for _, a := range m.assignments {
+		if a.EventID == eventID && model.IsActiveRoleAssignmentStatus(a.Status) {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockEventRoleRepo) UpdateAssignment(ctx context.Context, assignmentID string, updates map[string]interface{}) (*model.EventRoleAssignment, error) {
+	a := m.assignments[assignmentID]
+	if status, ok := updates["status"].(string); ok {
+		a.Status = status
+	}
+	if note, ok := updates["note"].(string); ok {
+		a.Note = &note
+	}
+	if reviewer, ok := updates["reviewed_by"].(string); ok {
+		a.ReviewedBy = &reviewer
+	}
+	if response, ok := updates["response"].(string); ok {
+		a.Response = &response
+	}
+	return a, nil
+}
+
+func (m *mockEventRoleRepo) DeleteAssignment(ctx context.Context, assignmentID string) error {
+	delete(m.assignments, assignmentID)
+	return nil
+}
+
+func (m *mockEventRoleRepo) CountAssignmentsByRole(ctx context.Context, roleID string) (int, error) {
+	count := 0
+	for _, a := range m.assignments {
+		if a.RoleID == roleID && a.Status == model.RoleAssignmentStatusConfirmed {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockEventRoleRepo) GetRolesWithAssignments(ctx context.Context, eventID string) ([]model.EventRoleWithAssignments, error) {
+	return nil, nil
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+func makeApprovalRole(id string, maxSlots int) *model.EventRole {
+	return &model.EventRole{
+		ID:             id,
+		EventID:        "event:1",
+		Name:           "Driver",
+		MaxSlots:       maxSlots,
+		AssignmentMode: model.RoleAssignmentModeApproval,
+	}
+}
+
+func newTestEventRoleService(repo *mockEventRoleRepo, hub *EventHub) *EventRoleService {
+	return NewEventRoleService(EventRoleServiceConfig{
+		Repo:      repo,
+		EventRepo: &mockNoShowEventRepo{hostID: "host-1"},
+		EventHub:  hub,
+	})
+}
+
+// ============================================================================
+// Nomination Tests
+// ============================================================================
+
+func TestAssignRole_ApprovalModeRequiresNomination(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockEventRoleRepo(makeApprovalRole("role:1", 1))
+	svc := newTestEventRoleService(repo, nil)
+
+	_, err := svc.AssignRole(ctx, "user-1", &model.AssignRoleRequest{RoleID: "role:1"})
+	if !errors.Is(err, ErrRoleRequiresApproval) {
+		t.Errorf("expected ErrRoleRequiresApproval, got %v", err)
+	}
+}
+
+func TestNominateForRole_CreatesPendingAssignment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockEventRoleRepo(makeApprovalRole("role:1", 1))
+	svc := newTestEventRoleService(repo, nil)
+
+	note := "I have a van"
+	nomination, err := svc.NominateForRole(ctx, "user-1", "event:1", "role:1", &model.NominateRoleRequest{Note: &note})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nomination.Status != model.RoleAssignmentStatusPending {
+		t.Errorf("expected pending status, got %s", nomination.Status)
+	}
+	if nomination.Note == nil || *nomination.Note != note {
+		t.Errorf("expected note to be kept, got %v", nomination.Note)
+	}
+
+	if _, err := svc.NominateForRole(ctx, "user-1", "event:1", "role:1", &model.NominateRoleRequest{}); !errors.Is(err, ErrAlreadyAssignedToRole) {
+		t.Errorf("expected ErrAlreadyAssignedToRole for duplicate nomination, got %v", err)
+	}
+}
+
+func TestNominateForRole_DirectRole(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	role := makeApprovalRole("role:1", 1)
+	role.AssignmentMode = model.RoleAssignmentModeDirect
+	svc := newTestEventRoleService(newMockEventRoleRepo(role), nil)
+
+	_, err := svc.NominateForRole(ctx, "user-1", "event:1", "role:1", &model.NominateRoleRequest{})
+	if !errors.Is(err, ErrRoleNotApprovalMode) {
+		t.Errorf("expected ErrRoleNotApprovalMode, got %v", err)
+	}
+}
+
+func TestApproveNomination_EnforcesCapacity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockEventRoleRepo(makeApprovalRole("role:1", 1))
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("user-1", "sub-1")
+	svc := newTestEventRoleService(repo, hub)
+
+	// Both nominations are accepted; capacity only applies at approval
+	first, err := svc.NominateForRole(ctx, "user-1", "event:1", "role:1", &model.NominateRoleRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := svc.NominateForRole(ctx, "user-2", "event:1", "role:1", &model.NominateRoleRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	approved, err := svc.ApproveNomination(ctx, "host-1", "event:1", first.ID, &model.ReviewNominationRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if approved.Status != model.RoleAssignmentStatusConfirmed {
+		t.Errorf("expected confirmed status, got %s", approved.Status)
+	}
+	if approved.ReviewedBy == nil || *approved.ReviewedBy != "host-1" {
+		t.Errorf("expected reviewer to be recorded, got %v", approved.ReviewedBy)
+	}
+
+	events := drainReminderEvents(sub)
+	if len(events) != 1 || events[0].Type != EventRoleNomination {
+		t.Fatalf("expected 1 %s event for nominee, got %v", EventRoleNomination, events)
+	}
+	if data := events[0].Data.(map[string]interface{}); data["status"] != model.RoleAssignmentStatusConfirmed {
+		t.Errorf("expected confirmed status in notification, got %v", data["status"])
+	}
+
+	if _, err := svc.ApproveNomination(ctx, "host-1", "event:1", second.ID, &model.ReviewNominationRequest{}); !errors.Is(err, ErrRoleFull) {
+		t.Errorf("expected ErrRoleFull once the role is filled, got %v", err)
+	}
+}
+
+func TestDeclineNomination_NotifiesNominee(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockEventRoleRepo(makeApprovalRole("role:1", 1))
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("user-1", "sub-1")
+	svc := newTestEventRoleService(repo, hub)
+
+	nomination, err := svc.NominateForRole(ctx, "user-1", "event:1", "role:1", &model.NominateRoleRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	response := "We already have enough drivers"
+	declined, err := svc.DeclineNomination(ctx, "host-1", "event:1", nomination.ID, &model.ReviewNominationRequest{Response: &response})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if declined.Status != model.RoleAssignmentStatusDeclined {
+		t.Errorf("expected declined status, got %s", declined.Status)
+	}
+
+	events := drainReminderEvents(sub)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 notification, got %d", len(events))
+	}
+	if data := events[0].Data.(map[string]interface{}); data["response"] != response {
+		t.Errorf("expected host response in notification, got %v", data["response"])
+	}
+
+	if _, err := svc.ApproveNomination(ctx, "host-1", "event:1", nomination.ID, &model.ReviewNominationRequest{}); !errors.Is(err, ErrNominationNotPending) {
+		t.Errorf("expected ErrNominationNotPending for reviewed nomination, got %v", err)
+	}
+
+	// A declined member may nominate again
+	if _, err := svc.NominateForRole(ctx, "user-1", "event:1", "role:1", &model.NominateRoleRequest{}); err != nil {
+		t.Errorf("expected renomination after decline to succeed, got %v", err)
+	}
+}
+
+func TestReviewNomination_NonHostForbidden(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockEventRoleRepo(makeApprovalRole("role:1", 1))
+	svc := newTestEventRoleService(repo, nil)
+
+	nomination, err := svc.NominateForRole(ctx, "user-1", "event:1", "role:1", &model.NominateRoleRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.ApproveNomination(ctx, "user-2", "event:1", nomination.ID, &model.ReviewNominationRequest{}); !errors.Is(err, ErrNotEventHost) {
+		t.Errorf("expected ErrNotEventHost, got %v", err)
+	}
+	if _, err := svc.GetPendingNominations(ctx, "user-2", "event:1"); !errors.Is(err, ErrNotEventHost) {
+		t.Errorf("expected ErrNotEventHost listing nominations, got %v", err)
+	}
+
+	pending, err := svc.GetPendingNominations(ctx, "host-1", "event:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pending) != 1 || pending[0].RoleName == nil || *pending[0].RoleName != "Driver" {
+		t.Errorf("expected 1 pending nomination with role name, got %v", pending)
+	}
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Affordance Tests
+// ============================================================================
+
+func newAffordanceEvent(status string, maxAttendees int, approved int) *model.EventWithDetails {
+	return &model.EventWithDetails{
+		Event:          model.Event{Status: status, MaxAttendees: &maxAttendees},
+		Hosts:          []model.EventHost{{UserID: "host-1"}},
+		AttendeesCount: approved,
+	}
+}
+
+func TestEventAffordances_Host(t *testing.T) {
+	t.Parallel()
+
+	a := (&EventService{}).Affordances(newAffordanceEvent(model.EventStatusPublished, 10, 1), "host-1")
+	if !a.Can(model.ActionEdit) || !a.Can(model.ActionCancel) {
+		t.Errorf("expected the host to edit and cancel, got %v", a)
+	}
+}
+
+func TestEventAffordances_RSVP(t *testing.T) {
+	t.Parallel()
+
+	svc := &EventService{}
+	approved := &model.EventRSVP{Status: model.RSVPStatusApproved}
+
+	tests := []struct {
+		name    string
+		details *model.EventWithDetails
+		userID  string
+		want    bool
+	}{
+		{"open seat", newAffordanceEvent(model.EventStatusPublished, 10, 9), "user-1", true},
+		{"full", newAffordanceEvent(model.EventStatusPublished, 10, 10), "user-1", false},
+		{"draft", newAffordanceEvent(model.EventStatusDraft, 10, 0), "user-1", false},
+		{"anonymous", newAffordanceEvent(model.EventStatusPublished, 10, 0), "", false},
+	}
+	for _, tt := range tests {
+		if got := svc.Affordances(tt.details, tt.userID).Can(model.ActionRSVP); got != tt.want {
+			t.Errorf("%s: expected can_rsvp=%v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	full := newAffordanceEvent(model.EventStatusPublished, 10, 10)
+	full.Event.WaitlistEnabled = true
+	if !svc.Affordances(full, "user-1").Can(model.ActionRSVP) {
+		t.Error("expected a full event with a waitlist to take RSVPs")
+	}
+
+	going := newAffordanceEvent(model.EventStatusPublished, 10, 1)
+	going.UserRSVP = approved
+	a := svc.Affordances(going, "user-1")
+	if a.Can(model.ActionRSVP) || a.Can(model.ActionEdit) {
+		t.Errorf("expected an approved guest to do neither, got %v", a)
+	}
+}
+
+// ============================================================================
+// RSVP Window Tests
+// ============================================================================
+
+func newWindowService(event *model.Event, tier *model.GuildTier, role model.GuildRole) *EventService {
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return event, nil
+		},
+	}
+	guilds := &mocks.EventGuildRepository{
+		IsMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return role != "", nil
+		},
+		GetMemberRoleFunc: func(ctx context.Context, userID, guildID string) (model.GuildRole, error) {
+			return role, nil
+		},
+	}
+	tiers := &fakeTierGate{held: tier}
+	return NewEventService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, guilds, nil, tiers, nil, nil, nil, nil, nil)
+}
+
+type fakeTierGate struct {
+	held *model.GuildTier
+}
+
+func (f *fakeTierGate) ValidateTier(ctx context.Context, guildID, tierID string) error {
+	return nil
+}
+
+func (f *fakeTierGate) CheckEventTier(ctx context.Context, event *model.Event, userID string, now time.Time) (*model.GuildTier, error) {
+	return f.held, nil
+}
+
+func TestCheckRSVPRules_Window(t *testing.T) {
+	t.Parallel()
+
+	guildID := "guild:1"
+	opens := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	moderator := model.GuildRoleModerator
+	supporter := "guild_tier:supporter"
+	dayEarly := opens.Add(-24 * time.Hour)
+	event := &model.Event{
+		ID:          "event:1",
+		GuildID:     &guildID,
+		RSVPOpensAt: &opens,
+		RSVPEarlyAccess: []model.RSVPEarlyAccess{
+			{Role: &moderator, Hours: 72},
+			{TierID: &supporter, Hours: 24},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		tier  *model.GuildTier
+		role  model.GuildRole
+		opens *time.Time // nil = open now
+	}{
+		{"member waits for rsvp_opens_at", nil, model.GuildRoleMember, &opens},
+		{"non-member waits for rsvp_opens_at", nil, "", &opens},
+		{"tier rule opens a day early", &model.GuildTier{ID: supporter}, model.GuildRoleMember, &dayEarly},
+		{"role rule has already opened", nil, model.GuildRoleModerator, nil},
+	}
+	for _, tt := range tests {
+		err := newWindowService(event, tt.tier, tt.role).checkRSVPRules(context.Background(), event, "user:a")
+		if tt.opens == nil {
+			if err != nil {
+				t.Errorf("%s: expected RSVPs open, got %v", tt.name, err)
+			}
+			continue
+		}
+		var notOpen *RSVPNotOpenError
+		if !errors.As(err, &notOpen) {
+			t.Errorf("%s: expected RSVPNotOpenError, got %v", tt.name, err)
+			continue
+		}
+		if !notOpen.OpensAt.Equal(*tt.opens) {
+			t.Errorf("%s: expected RSVPs to open at %v, got %v", tt.name, *tt.opens, notOpen.OpensAt)
+		}
+	}
+}
+
+func TestCheckRSVPRules_WithoutGuild(t *testing.T) {
+	t.Parallel()
+
+	opens := time.Now().Add(time.Hour)
+	event := &model.Event{ID: "event:1", RSVPOpensAt: &opens}
+	svc := NewEventService(&mocks.EventRepository{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	if err := svc.checkRSVPRules(context.Background(), event, "user:a"); !errors.Is(err, ErrRSVPNotOpen) {
+		t.Errorf("expected ErrRSVPNotOpen, got %v", err)
+	}
+}
+
+func TestSetRSVPWindow(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now().Add(7 * 24 * time.Hour)
+	late := start.Add(time.Hour)
+	opens := start.Add(-24 * time.Hour)
+	moderator := model.GuildRoleModerator
+	guildID := "guild:1"
+
+	var saved []model.RSVPEarlyAccess
+	repo := &mocks.EventRepository{
+		IsHostFunc: func(ctx context.Context, eventID, userID string) (bool, error) {
+			return userID == "user:host", nil
+		},
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			if eventID == "event:solo" {
+				return &model.Event{ID: eventID, StartTime: start}, nil
+			}
+			return &model.Event{ID: eventID, GuildID: &guildID, StartTime: start}, nil
+		},
+		SetRSVPWindowFunc: func(ctx context.Context, eventID string, opensAt *time.Time, earlyAccess []model.RSVPEarlyAccess) (*model.Event, error) {
+			saved = earlyAccess
+			return &model.Event{ID: eventID, RSVPOpensAt: opensAt, RSVPEarlyAccess: earlyAccess}, nil
+		},
+	}
+	svc := NewEventService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	rules := []model.RSVPEarlyAccess{{Role: &moderator, Hours: 12}}
+
+	if _, err := svc.SetRSVPWindow(context.Background(), "user:guest", "event:1", &model.SetRSVPWindowRequest{OpensAt: &opens}); !errors.Is(err, ErrNotEventHost) {
+		t.Errorf("expected ErrNotEventHost, got %v", err)
+	}
+	var pd *model.ProblemDetails
+	if _, err := svc.SetRSVPWindow(context.Background(), "user:host", "event:1", &model.SetRSVPWindowRequest{OpensAt: &late}); !errors.As(err, &pd) {
+		t.Errorf("expected opens_at after start_time to fail validation, got %v", err)
+	}
+	if _, err := svc.SetRSVPWindow(context.Background(), "user:host", "event:solo", &model.SetRSVPWindowRequest{OpensAt: &opens, EarlyAccess: rules}); !errors.Is(err, ErrEarlyAccessNotGuild) {
+		t.Errorf("expected ErrEarlyAccessNotGuild, got %v", err)
+	}
+
+	event, err := svc.SetRSVPWindow(context.Background(), "user:host", "event:1", &model.SetRSVPWindowRequest{OpensAt: &opens, EarlyAccess: rules})
+	if err != nil {
+		t.Fatalf("SetRSVPWindow: %v", err)
+	}
+	if len(saved) != 1 || event.RSVPOpensAt == nil || !event.RSVPOpensAt.Equal(opens) {
+		t.Errorf("expected the window saved, got %+v", event)
+	}
+
+	if _, err := svc.SetRSVPWindow(context.Background(), "user:host", "event:1", &model.SetRSVPWindowRequest{}); err != nil {
+		t.Fatalf("SetRSVPWindow: %v", err)
+	}
+	if saved == nil || len(saved) != 0 {
+		t.Errorf("expected clearing the window to save no rules, got %v", saved)
+	}
+}
+
+// ============================================================================
+// Accessibility Tests
+// ============================================================================
+
+func TestGetPublicEvents_MatchNeeds(t *testing.T) {
+	t.Parallel()
+
+	var got *model.EventSearchFilters
+	repo := &mocks.EventRepository{
+		GetPublicEventsFunc: func(ctx context.Context, filters *model.EventSearchFilters, limit int) ([]*model.Event, error) {
+			got = filters
+			return nil, nil
+		},
+	}
+	profiles := &mocks.ProfileRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*model.UserProfile, error) {
+			return &model.UserProfile{UserID: userID, AccessibilityNeeds: []string{model.AccessibilityStepFree, model.AccessibilityQuietSpace}}, nil
+		},
+	}
+	svc := NewEventService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, profiles, nil, nil, nil)
+	ctx := context.Background()
+
+	filters := &model.EventSearchFilters{Accessibility: []string{model.AccessibilityStepFree}}
+	if _, err := svc.GetPublicEvents(ctx, "user:1", filters, 10); err != nil {
+		t.Fatalf("GetPublicEvents() error = %v", err)
+	}
+	if len(got.Accessibility) != 1 {
+		t.Errorf("without match_needs filtered on %v, want only the requested feature", got.Accessibility)
+	}
+
+	filters.MatchNeeds = true
+	if _, err := svc.GetPublicEvents(ctx, "user:1", filters, 10); err != nil {
+		t.Fatalf("GetPublicEvents() error = %v", err)
+	}
+	want := []string{model.AccessibilityStepFree, model.AccessibilityQuietSpace}
+	if len(got.Accessibility) != len(want) || got.Accessibility[0] != want[0] || got.Accessibility[1] != want[1] {
+		t.Errorf("with match_needs filtered on %v, want %v", got.Accessibility, want)
+	}
+	if len(filters.Accessibility) != 1 {
+		t.Errorf("caller's filters changed to %v", filters.Accessibility)
+	}
+}
+
+// ============================================================================
+// CreateEvent Duplicate Tests
+// ============================================================================
+
+func TestCreateEvent_WarnsAboutLookalikes(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 11, 7, 18, 0, 0, 0, time.UTC)
+	guildID := "guild:1"
+	park := func() *model.EventLocation {
+		return &model.EventLocation{Name: "Riverside Park", City: "Springfield"}
+	}
+	existing := []*model.Event{
+		{ID: "event:same", Title: "Board Game Night", StartTime: start.Add(-time.Hour), Location: &model.EventLocation{Name: "Hall", City: "Springfield"}},
+		{ID: "event:place", Title: "Picnic in the park", StartTime: start, Location: park()},
+		{ID: "event:later", Title: "Board Game Night", StartTime: start.Add(7 * 24 * time.Hour)},
+		{ID: "event:other", Title: "Trivia", StartTime: start, Location: park()},
+	}
+	var gotFilters *model.EventSearchFilters
+	created := 0
+	repo := &mocks.EventRepository{
+		GetByGuildFunc: func(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error) {
+			gotFilters = filters
+			return existing, nil
+		},
+		CreateFunc: func(ctx context.Context, event *model.Event) error {
+			created++
+			event.ID = "event:new"
+			return nil
+		},
+	}
+	svc := NewEventService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	candidateIDs := func(req *model.CreateEventRequest) []string {
+		t.Helper()
+		_, err := svc.CreateEvent(ctx, "user:1", req)
+		var duplicate *DuplicateError
+		if !errors.As(err, &duplicate) {
+			t.Fatalf("expected a DuplicateError, got %v", err)
+		}
+		var ids []string
+		for _, c := range duplicate.Candidates {
+			ids = append(ids, c.ID)
+		}
+		return ids
+	}
+
+	// Same title while the other is still running, wherever it is
+	if ids := candidateIDs(&model.CreateEventRequest{GuildID: &guildID, Title: "board game night!", StartTime: start}); len(ids) != 1 || ids[0] != "event:same" {
+		t.Errorf("similar title: candidates = %v, want event:same", ids)
+	}
+	if gotFilters == nil || gotFilters.StartBefore == nil || !gotFilters.StartBefore.Equal(start.Add(model.DefaultEventLength)) {
+		t.Errorf("filters = %+v, want events starting before this one ends", gotFilters)
+	}
+
+	// A looser match only counts at the same place
+	if ids := candidateIDs(&model.CreateEventRequest{GuildID: &guildID, Title: "Park picnic", StartTime: start, Location: park()}); len(ids) != 1 || ids[0] != "event:place" {
+		t.Errorf("same place: candidates = %v, want event:place", ids)
+	}
+	elsewhere := &model.CreateEventRequest{GuildID: &guildID, Title: "Park picnic", StartTime: start, Location: &model.EventLocation{Name: "Lakeside", City: "Springfield"}}
+	if _, err := svc.CreateEvent(ctx, "user:1", elsewhere); err != nil {
+		t.Errorf("elsewhere: CreateEvent() error = %v, want it created", err)
+	}
+
+	confirmed := &model.CreateEventRequest{GuildID: &guildID, Title: "Board Game Night", StartTime: start, ConfirmDuplicate: true}
+	if _, err := svc.CreateEvent(ctx, "user:1", confirmed); err != nil {
+		t.Errorf("confirmed: CreateEvent() error = %v", err)
+	}
+	if created != 2 {
+		t.Errorf("created %d events, want only the distinct and the confirmed one", created)
+	}
+}
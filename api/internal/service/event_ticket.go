@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// HoldTicket reserves a seat at a ticketed event for the user while they
+// RSVP. A user who already holds or has a ticket gets it back rather than a
+// second seat.
+func (s *EventService) HoldTicket(ctx context.Context, userID, eventID string) (*model.EventTicket, error) {
+	event, err := s.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.Ticketed {
+		return nil, ErrEventNotTicketed
+	}
+	if event.Status != model.EventStatusPublished {
+		return nil, ErrRSVPNotAllowed
+	}
+
+	ticket, err := s.repo.GetActiveTicket(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket != nil {
+		return withTicketPayload(ticket), nil
+	}
+	if err := s.checkRSVPRules(ctx, event, userID); err != nil {
+		return nil, err
+	}
+
+	code, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	expires := time.Now().Add(model.TicketHoldDuration)
+	ticket = &model.EventTicket{
+		EventID:       eventID,
+		HolderID:      userID,
+		Status:        model.TicketStatusHeld,
+		HoldExpiresOn: &expires,
+		Code:          code,
+	}
+
+	held, err := s.repo.CreateTicketHold(ctx, ticket, ticketCapacity(event))
+	if err != nil {
+		return nil, err
+	}
+	if !held {
+		return nil, ErrEventFull
+	}
+	return ticket, nil
+}
+
+// ReleaseTicketHold gives back the user's unclaimed hold. A claimed
+// ticket is released by cancelling the RSVP instead.
+func (s *EventService) ReleaseTicketHold(ctx context.Context, userID, eventID string) error {
+	ticket, err := s.repo.GetActiveTicket(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if ticket == nil || ticket.Status != model.TicketStatusHeld {
+		return nil
+	}
+	return s.repo.VoidTicket(ctx, ticket.ID)
+}
+
+// GetMyTicket returns the user's active ticket to an event, with its QR
+// payload once claimed
+func (s *EventService) GetMyTicket(ctx context.Context, userID, eventID string) (*model.EventTicket, error) {
+	ticket, err := s.repo.GetActiveTicket(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil {
+		return nil, ErrTicketNotFound
+	}
+	return withTicketPayload(ticket), nil
+}
+
+// TransferTicket passes the user's claimed ticket to another member of the
+// event's guild. The recipient takes over the sender's RSVP status, the
+// sender's RSVP is cancelled, and the ticket gets a new code so the
+// sender's QR code stops working.
+func (s *EventService) TransferTicket(ctx context.Context, userID, eventID, ticketID string, req *model.TransferTicketRequest) (*model.EventTicket, error) {
+	event, err := s.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := s.repo.GetTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil || ticket.EventID != eventID {
+		return nil, ErrTicketNotFound
+	}
+	if ticket.HolderID != userID {
+		return nil, ErrNotTicketHolder
+	}
+	if ticket.Status != model.TicketStatusClaimed || req.ToUserID == userID {
+		return nil, ErrTicketNotTransferable
+	}
+
+	if event.GuildID != nil && s.guilds != nil {
+		isMember, err := s.guilds.IsMember(ctx, req.ToUserID, *event.GuildID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, ErrTicketRecipientNotMember
+		}
+	}
+	// Tickets can't carry a tier's perks or early access to someone without them
+	if err := s.checkRSVPRules(ctx, event, req.ToUserID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetActiveTicket(ctx, eventID, req.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrTicketRecipientHasTicket
+	}
+
+	code, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	transferred, err := s.repo.TransferTicket(ctx, ticketID, userID, req.ToUserID, code)
+	if err != nil {
+		return nil, err
+	}
+	if transferred == nil {
+		return nil, ErrTicketNotTransferable
+	}
+
+	// The seat moves with the ticket, so the RSVP does too
+	status := model.RSVPStatusApproved
+	senderRSVP, err := s.repo.GetRSVP(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if senderRSVP != nil {
+		status = senderRSVP.Status
+		if _, err := s.repo.UpdateRSVP(ctx, senderRSVP.ID, map[string]interface{}{
+			"status": model.RSVPStatusCancelled,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.giveTicketRSVP(ctx, eventID, req.ToUserID, status); err != nil {
+		return nil, err
+	}
+
+	return transferred, nil
+}
+
+// giveTicketRSVP puts a ticket recipient on the guest list with status
+func (s *EventService) giveTicketRSVP(ctx context.Context, eventID, userID, status string) error {
+	rsvp, err := s.repo.GetRSVP(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if rsvp != nil {
+		_, err := s.repo.UpdateRSVP(ctx, rsvp.ID, map[string]interface{}{
+			"status":         status,
+			"rsvp_type":      model.RSVPTypeGoing,
+			"plus_ones":      0,
+			"plus_one_names": []string{},
+		})
+		return err
+	}
+
+	return s.repo.CreateRSVP(ctx, &model.EventRSVP{
+		EventID:  eventID,
+		UserID:   userID,
+		Status:   status,
+		RSVPType: model.RSVPTypeGoing,
+	})
+}
+
+// CheckInTicket validates a scanned QR payload at the door (host only) and
+// checks its holder in. Each ticket checks in once.
+func (s *EventService) CheckInTicket(ctx context.Context, hostUserID, eventID string, req *model.CheckInTicketRequest) (*model.EventTicket, error) {
+	isHost, err := s.repo.IsHost(ctx, eventID, hostUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isHost {
+		return nil, ErrNotEventHost
+	}
+
+	ticketID, code, ok := model.ParseTicketPayload(req.Payload)
+	if !ok {
+		return nil, ErrInvalidTicket
+	}
+	ticket, err := s.repo.GetTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil || ticket.EventID != eventID || subtle.ConstantTimeCompare([]byte(code), []byte(ticket.Code)) != 1 {
+		return nil, ErrInvalidTicket
+	}
+	switch ticket.Status {
+	case model.TicketStatusUsed:
+		return nil, ErrTicketAlreadyUsed
+	case model.TicketStatusClaimed:
+	default:
+		return nil, ErrInvalidTicket
+	}
+
+	rsvp, err := s.repo.GetRSVP(ctx, eventID, ticket.HolderID)
+	if err != nil {
+		return nil, err
+	}
+	if rsvp == nil || rsvp.Status != model.RSVPStatusApproved {
+		return nil, ErrTicketNotApproved
+	}
+
+	checkedIn, err := s.repo.CheckInTicket(ctx, ticketID, hostUserID)
+	if err != nil {
+		return nil, err
+	}
+	if checkedIn == nil {
+		return nil, ErrTicketAlreadyUsed
+	}
+
+	if _, err := s.repo.UpdateRSVP(ctx, rsvp.ID, map[string]interface{}{
+		"checkin_time": time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+	return checkedIn, nil
+}
+
+// claimTicket claims the user's hold for an RSVP to a ticketed event. The
+// hold must still be live; a ticket already claimed is kept.
+func (s *EventService) claimTicket(ctx context.Context, eventID, userID string) error {
+	ticket, err := s.repo.GetActiveTicket(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if ticket == nil {
+		return ErrTicketHoldRequired
+	}
+	if ticket.Status != model.TicketStatusHeld {
+		return nil
+	}
+
+	claimed, err := s.repo.ClaimTicket(ctx, ticket.ID)
+	if err != nil {
+		return err
+	}
+	if claimed == nil {
+		return ErrTicketHoldRequired
+	}
+	return nil
+}
+
+// releaseTicket voids the user's ticket to a ticketed event, giving the seat
+// back when they stop attending
+func (s *EventService) releaseTicket(ctx context.Context, event *model.Event, userID string) error {
+	if !event.Ticketed {
+		return nil
+	}
+	ticket, err := s.repo.GetActiveTicket(ctx, event.ID, userID)
+	if err != nil || ticket == nil {
+		return err
+	}
+	return s.repo.VoidTicket(ctx, ticket.ID)
+}
+
+// ticketPaymentDue checks that a guest of a paid event holds a ticket, and
+// reports whether it still has to be paid for. A claimed ticket was paid.
+func (s *EventService) ticketPaymentDue(ctx context.Context, eventID, userID string) (bool, error) {
+	ticket, err := s.repo.GetActiveTicket(ctx, eventID, userID)
+	if err != nil {
+		return false, err
+	}
+	if ticket == nil {
+		return false, ErrTicketHoldRequired
+	}
+	return ticket.Status == model.TicketStatusHeld, nil
+}
+
+// refundTicket returns what a guest paid for their ticket: under the
+// event's refund policy when they cancel, or in full when the host turns
+// them away
+func (s *EventService) refundTicket(ctx context.Context, event *model.Event, userID string, full bool) error {
+	if !event.Ticketed || s.payments == nil {
+		return nil
+	}
+	return s.payments.RefundRSVP(ctx, event, userID, full)
+}
+
+// refundCancelledEvent returns every guest's payment for a cancelled event
+func (s *EventService) refundCancelledEvent(ctx context.Context, event *model.Event) error {
+	if !event.Ticketed || s.payments == nil {
+		return nil
+	}
+	return s.payments.RefundEvent(ctx, event)
+}
+
+// checkPayoutReady returns an error unless the user can sell tickets
+func (s *EventService) checkPayoutReady(ctx context.Context, userID string) error {
+	if s.payments == nil {
+		return ErrPaymentsDisabled
+	}
+	return s.payments.CheckPayoutReady(ctx, userID)
+}
+
+// withTicketPayload sets the QR payload on a claimed ticket for its holder
+func withTicketPayload(ticket *model.EventTicket) *model.EventTicket {
+	if ticket.Status == model.TicketStatusClaimed {
+		ticket.QRPayload = ticket.Payload()
+	}
+	return ticket
+}
+
+// ticketCapacity is how many tickets a ticketed event has
+func ticketCapacity(event *model.Event) int {
+	if event.MaxAttendees == nil {
+		return 0
+	}
+	return *event.MaxAttendees
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+type fakeEventGuilds struct {
+	members map[string]bool
+}
+
+func (f *fakeEventGuilds) IsMember(ctx context.Context, userID, guildID string) (bool, error) {
+	return f.members[userID], nil
+}
+
+func (f *fakeEventGuilds) GetMemberRole(ctx context.Context, userID, guildID string) (model.GuildRole, error) {
+	return model.GuildRoleMember, nil
+}
+
+func newTicketedEvent(capacity int) *model.Event {
+	guildID := "guild:1"
+	return &model.Event{
+		ID:           "event:1",
+		GuildID:      &guildID,
+		Status:       model.EventStatusPublished,
+		MaxAttendees: &capacity,
+		Ticketed:     true,
+	}
+}
+
+func newTicketService(repo *mocks.EventRepository, guilds EventGuildRepository) *EventService {
+	return NewEventService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, guilds, nil, nil, nil, nil, nil, nil, nil)
+}
+
+// ============================================================================
+// Hold Tests
+// ============================================================================
+
+func TestHoldTicket_HoldsSeat(t *testing.T) {
+	t.Parallel()
+
+	var capacity int
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return newTicketedEvent(50), nil
+		},
+		CreateTicketHoldFunc: func(ctx context.Context, ticket *model.EventTicket, c int) (bool, error) {
+			capacity = c
+			ticket.ID = "event_ticket:1"
+			return true, nil
+		},
+	}
+
+	ticket, err := newTicketService(repo, nil).HoldTicket(context.Background(), "user:a", "event:1")
+	if err != nil {
+		t.Fatalf("HoldTicket failed: %v", err)
+	}
+	if capacity != 50 {
+		t.Errorf("expected the hold limited to 50 seats, got %d", capacity)
+	}
+	if ticket.Status != model.TicketStatusHeld || ticket.HoldExpiresOn == nil || ticket.Code == "" {
+		t.Errorf("expected a held ticket with an expiry and code, got %+v", ticket)
+	}
+	if ticket.QRPayload != "" {
+		t.Error("expected no QR payload before the ticket is claimed")
+	}
+}
+
+func TestHoldTicket_SoldOut(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return newTicketedEvent(1), nil
+		},
+		CreateTicketHoldFunc: func(ctx context.Context, ticket *model.EventTicket, c int) (bool, error) {
+			return false, nil
+		},
+	}
+
+	if _, err := newTicketService(repo, nil).HoldTicket(context.Background(), "user:a", "event:1"); !errors.Is(err, ErrEventFull) {
+		t.Errorf("expected ErrEventFull, got %v", err)
+	}
+}
+
+func TestHoldTicket_ReturnsExistingTicket(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return newTicketedEvent(1), nil
+		},
+		GetActiveTicketFunc: func(ctx context.Context, eventID, userID string) (*model.EventTicket, error) {
+			return &model.EventTicket{ID: "event_ticket:1", Status: model.TicketStatusClaimed, Code: "c"}, nil
+		},
+		CreateTicketHoldFunc: func(ctx context.Context, ticket *model.EventTicket, c int) (bool, error) {
+			t.Error("expected no second hold")
+			return false, nil
+		},
+	}
+
+	ticket, err := newTicketService(repo, nil).HoldTicket(context.Background(), "user:a", "event:1")
+	if err != nil {
+		t.Fatalf("HoldTicket failed: %v", err)
+	}
+	if ticket.ID != "event_ticket:1" || ticket.QRPayload == "" {
+		t.Errorf("expected the claimed ticket with its payload, got %+v", ticket)
+	}
+}
+
+func TestHoldTicket_NotTicketed(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return &model.Event{ID: eventID, Status: model.EventStatusPublished}, nil
+		},
+	}
+
+	if _, err := newTicketService(repo, nil).HoldTicket(context.Background(), "user:a", "event:1"); !errors.Is(err, ErrEventNotTicketed) {
+		t.Errorf("expected ErrEventNotTicketed, got %v", err)
+	}
+}
+
+// ============================================================================
+// RSVP Tests
+// ============================================================================
+
+func TestRSVP_TicketedRequiresHold(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return newTicketedEvent(10), nil
+		},
+	}
+
+	_, err := newTicketService(repo, nil).RSVP(context.Background(), "user:a", "event:1", &model.RSVPRequest{RSVPType: model.RSVPTypeGoing})
+	if !errors.Is(err, ErrTicketHoldRequired) {
+		t.Errorf("expected ErrTicketHoldRequired, got %v", err)
+	}
+}
+
+func TestRSVP_TicketedClaimsHold(t *testing.T) {
+	t.Parallel()
+
+	var claimed string
+	var created *model.EventRSVP
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			event := newTicketedEvent(10)
+			event.Visibility = model.EventVisibilityPublic
+			return event, nil
+		},
+		GetActiveTicketFunc: func(ctx context.Context, eventID, userID string) (*model.EventTicket, error) {
+			return &model.EventTicket{ID: "event_ticket:1", Status: model.TicketStatusHeld}, nil
+		},
+		ClaimTicketFunc: func(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+			claimed = ticketID
+			return &model.EventTicket{ID: ticketID, Status: model.TicketStatusClaimed}, nil
+		},
+		CreateRSVPFunc: func(ctx context.Context, rsvp *model.EventRSVP) error {
+			created = rsvp
+			return nil
+		},
+	}
+
+	if _, err := newTicketService(repo, nil).RSVP(context.Background(), "user:a", "event:1", &model.RSVPRequest{RSVPType: model.RSVPTypeGoing}); err != nil {
+		t.Fatalf("RSVP failed: %v", err)
+	}
+	if claimed != "event_ticket:1" {
+		t.Errorf("expected the hold claimed, got %q", claimed)
+	}
+	if created == nil || created.Status != model.RSVPStatusApproved {
+		t.Errorf("expected an approved RSVP, got %+v", created)
+	}
+}
+
+// ============================================================================
+// Transfer Tests
+// ============================================================================
+
+func TestTransferTicket_MovesTicketAndRSVP(t *testing.T) {
+	t.Parallel()
+
+	var newCode string
+	var cancelled string
+	var created *model.EventRSVP
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return newTicketedEvent(10), nil
+		},
+		GetTicketFunc: func(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+			return &model.EventTicket{ID: ticketID, EventID: "event:1", HolderID: "user:a", Status: model.TicketStatusClaimed, Code: "old"}, nil
+		},
+		TransferTicketFunc: func(ctx context.Context, ticketID, fromUserID, toUserID, code string) (*model.EventTicket, error) {
+			newCode = code
+			return &model.EventTicket{ID: ticketID, HolderID: toUserID, Status: model.TicketStatusClaimed, Code: code}, nil
+		},
+		GetRSVPFunc: func(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+			if userID == "user:a" {
+				return &model.EventRSVP{ID: "event_rsvp:a", Status: model.RSVPStatusApproved}, nil
+			}
+			return nil, nil
+		},
+		UpdateRSVPFunc: func(ctx context.Context, rsvpID string, updates map[string]interface{}) (*model.EventRSVP, error) {
+			if updates["status"] == model.RSVPStatusCancelled {
+				cancelled = rsvpID
+			}
+			return nil, nil
+		},
+		CreateRSVPFunc: func(ctx context.Context, rsvp *model.EventRSVP) error {
+			created = rsvp
+			return nil
+		},
+	}
+	guilds := &fakeEventGuilds{members: map[string]bool{"user:b": true}}
+
+	ticket, err := newTicketService(repo, guilds).TransferTicket(context.Background(), "user:a", "event:1", "event_ticket:1", &model.TransferTicketRequest{ToUserID: "user:b"})
+	if err != nil {
+		t.Fatalf("TransferTicket failed: %v", err)
+	}
+	if ticket.HolderID != "user:b" || newCode == "" || newCode == "old" {
+		t.Errorf("expected the ticket with user:b under a new code, got %+v", ticket)
+	}
+	if cancelled != "event_rsvp:a" {
+		t.Errorf("expected the sender's RSVP cancelled, got %q", cancelled)
+	}
+	if created == nil || created.UserID != "user:b" || created.Status != model.RSVPStatusApproved {
+		t.Errorf("expected user:b approved, got %+v", created)
+	}
+}
+
+func TestTransferTicket_Rejects(t *testing.T) {
+	t.Parallel()
+
+	claimed := &model.EventTicket{ID: "event_ticket:1", EventID: "event:1", HolderID: "user:a", Status: model.TicketStatusClaimed}
+	tests := []struct {
+		name     string
+		userID   string
+		ticket   *model.EventTicket
+		to       string
+		existing *model.EventTicket
+		want     error
+	}{
+		{"missing", "user:a", nil, "user:b", nil, ErrTicketNotFound},
+		{"not holder", "user:c", claimed, "user:b", nil, ErrNotTicketHolder},
+		{"held", "user:a", &model.EventTicket{EventID: "event:1", HolderID: "user:a", Status: model.TicketStatusHeld}, "user:b", nil, ErrTicketNotTransferable},
+		{"to self", "user:a", claimed, "user:a", nil, ErrTicketNotTransferable},
+		{"not member", "user:a", claimed, "user:x", nil, ErrTicketRecipientNotMember},
+		{"recipient has one", "user:a", claimed, "user:b", &model.EventTicket{Status: model.TicketStatusClaimed}, ErrTicketRecipientHasTicket},
+	}
+	for _, tt := range tests {
+		repo := &mocks.EventRepository{
+			GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+				return newTicketedEvent(10), nil
+			},
+			GetTicketFunc: func(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+				return tt.ticket, nil
+			},
+			GetActiveTicketFunc: func(ctx context.Context, eventID, userID string) (*model.EventTicket, error) {
+				return tt.existing, nil
+			},
+		}
+		guilds := &fakeEventGuilds{members: map[string]bool{"user:a": true, "user:b": true}}
+
+		_, err := newTicketService(repo, guilds).TransferTicket(context.Background(), tt.userID, "event:1", "event_ticket:1", &model.TransferTicketRequest{ToUserID: tt.to})
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+// ============================================================================
+// Check-In Tests
+// ============================================================================
+
+func newCheckInRepo(ticket *model.EventTicket, rsvpStatus string) *mocks.EventRepository {
+	return &mocks.EventRepository{
+		IsHostFunc: func(ctx context.Context, eventID, userID string) (bool, error) {
+			return userID == "user:host", nil
+		},
+		GetTicketFunc: func(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+			if ticketID != ticket.ID {
+				return nil, nil
+			}
+			return ticket, nil
+		},
+		GetRSVPFunc: func(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+			return &model.EventRSVP{ID: "event_rsvp:a", Status: rsvpStatus}, nil
+		},
+		CheckInTicketFunc: func(ctx context.Context, ticketID, hostUserID string) (*model.EventTicket, error) {
+			return &model.EventTicket{ID: ticketID, Status: model.TicketStatusUsed, CheckedInBy: &hostUserID}, nil
+		},
+	}
+}
+
+func TestCheckInTicket_ChecksIn(t *testing.T) {
+	t.Parallel()
+
+	ticket := &model.EventTicket{ID: "event_ticket:1", EventID: "event:1", HolderID: "user:a", Status: model.TicketStatusClaimed, Code: "c0de"}
+	repo := newCheckInRepo(ticket, model.RSVPStatusApproved)
+	var checkin interface{}
+	repo.UpdateRSVPFunc = func(ctx context.Context, rsvpID string, updates map[string]interface{}) (*model.EventRSVP, error) {
+		checkin = updates["checkin_time"]
+		return nil, nil
+	}
+
+	used, err := newTicketService(repo, nil).CheckInTicket(context.Background(), "user:host", "event:1", &model.CheckInTicketRequest{Payload: ticket.Payload()})
+	if err != nil {
+		t.Fatalf("CheckInTicket failed: %v", err)
+	}
+	if used.Status != model.TicketStatusUsed {
+		t.Errorf("expected the ticket used, got %q", used.Status)
+	}
+	if checkin == nil {
+		t.Error("expected the RSVP's check-in time recorded")
+	}
+}
+
+func TestCheckInTicket_Rejects(t *testing.T) {
+	t.Parallel()
+
+	claimed := model.EventTicket{ID: "event_ticket:1", EventID: "event:1", HolderID: "user:a", Status: model.TicketStatusClaimed, Code: "c0de"}
+	forged := claimed
+	forged.Code = "guess"
+	used := claimed
+	used.Status = model.TicketStatusUsed
+	other := claimed
+	other.EventID = "event:2"
+
+	tests := []struct {
+		name    string
+		host    string
+		ticket  model.EventTicket
+		payload string
+		rsvp    string
+		want    error
+	}{
+		{"not host", "user:a", claimed, claimed.Payload(), model.RSVPStatusApproved, ErrNotEventHost},
+		{"garbage", "user:host", claimed, "hello", model.RSVPStatusApproved, ErrInvalidTicket},
+		{"wrong code", "user:host", claimed, forged.Payload(), model.RSVPStatusApproved, ErrInvalidTicket},
+		{"other event", "user:host", other, other.Payload(), model.RSVPStatusApproved, ErrInvalidTicket},
+		{"used", "user:host", used, used.Payload(), model.RSVPStatusApproved, ErrTicketAlreadyUsed},
+		{"pending", "user:host", claimed, claimed.Payload(), model.RSVPStatusPending, ErrTicketNotApproved},
+	}
+	for _, tt := range tests {
+		ticket := tt.ticket
+		repo := newCheckInRepo(&ticket, tt.rsvp)
+
+		_, err := newTicketService(repo, nil).CheckInTicket(context.Background(), tt.host, "event:1", &model.CheckInTicketRequest{Payload: tt.payload})
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
@@ -1,9 +1,15 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/forgo/saga/api/internal/chaos"
 )
 
 // EventType represents the type of event
@@ -19,6 +25,32 @@ const (
 
 	// Nudge events
 	EventNudge EventType = "nudge"
+
+	// Guild health events
+	EventGuildHealthAlert EventType = "guild.health_alert"
+
+	// Event reminder events
+	EventEventReminder EventType = "event.reminder"
+
+	// Event role events
+	EventRoleNomination EventType = "event.role_nomination"
+	EventRoleAlert      EventType = "event.role_alert"
+
+	// Announcement events
+	EventAnnouncement EventType = "announcement"
+
+	// Scheduled publishing events
+	EventEventPublished EventType = "event.published"
+	EventVotePublished  EventType = "vote.published"
+
+	// Trust events
+	EventTrustGranted EventType = "trust.granted"
+
+	// Reaction events
+	EventReactionsUpdated EventType = "reactions.updated"
+
+	// Spontaneous availability events
+	EventSpontaneousAvailable EventType = "availability.spontaneous"
 )
 
 // Event represents a server-sent event
@@ -26,6 +58,7 @@ type Event struct {
 	Type     EventType   `json:"type"`
 	Data     interface{} `json:"data"`
 	CircleID string      `json:"-"` // Used for routing, not sent to client
+	ID       string      `json:"-"` // Poll cursor of a user-directed event, set by SendToUser
 }
 
 // Format returns the SSE formatted string
@@ -34,47 +67,142 @@ func (e *Event) Format() string {
 	return "event: " + string(e.Type) + "\ndata: " + string(data) + "\n\n"
 }
 
+// BufferPolicy decides what happens when a subscriber's buffer is full
+type BufferPolicy string
+
+const (
+	// BufferPolicyDropOldest discards the oldest buffered event to make room
+	BufferPolicyDropOldest BufferPolicy = "drop_oldest"
+	// BufferPolicyDropNewest discards the event being published
+	BufferPolicyDropNewest BufferPolicy = "drop_newest"
+	// BufferPolicyDisconnect disconnects the slow subscriber so it can reconnect fresh
+	BufferPolicyDisconnect BufferPolicy = "disconnect"
+)
+
+// DefaultSubscriberBufferSize is the per-subscriber event buffer when unconfigured
+const DefaultSubscriberBufferSize = 100
+
+// EventHubConfig holds event hub buffering and fan-out settings
+type EventHubConfig struct {
+	BufferSize    int               // Events buffered per subscriber before the policy applies
+	Policy        BufferPolicy      // What to do when a subscriber's buffer is full
+	Broker        EventBroker       // Optional cross-instance fan-out; nil keeps events in-process
+	Faults        *chaos.Injector   // Optional fault injection for resilience tests
+	Notifications *NotificationFeed // Optional store of user-directed events for long polling
+}
+
 // Subscriber represents a connected SSE client
 type Subscriber struct {
 	ID       string
 	CircleID string
 	Events   chan *Event
 	Done     chan struct{}
+
+	doneOnce     sync.Once
+	dropped      atomic.Uint64
+	disconnected atomic.Bool
+}
+
+// Dropped returns how many events were dropped for this subscriber
+func (s *Subscriber) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Disconnected reports whether the hub disconnected this subscriber for falling behind
+func (s *Subscriber) Disconnected() bool {
+	return s.disconnected.Load()
+}
+
+// closeDone closes Done exactly once
+func (s *Subscriber) closeDone() {
+	s.doneOnce.Do(func() { close(s.Done) })
+}
+
+// EventHubStats summarizes event hub delivery and buffer occupancy
+type EventHubStats struct {
+	Subscribers      int     `json:"subscribers"`
+	BufferSize       int     `json:"buffer_size"`
+	Policy           string  `json:"policy"`
+	BufferedEvents   int     `json:"buffered_events"`   // Events waiting across all subscriber buffers
+	MaxBufferFill    float64 `json:"max_buffer_fill"`   // Fullest subscriber buffer, 0-1
+	EventsDelivered  uint64  `json:"events_delivered"`  // Events placed in a subscriber buffer
+	EventsDropped    uint64  `json:"events_dropped"`    // Events discarded because a buffer was full
+	SlowDisconnected uint64  `json:"slow_disconnected"` // Subscribers disconnected for falling behind
 }
 
-// EventHub manages SSE subscriptions and event broadcasting
+// EventHub manages SSE subscriptions and event broadcasting.
+// Publishing never blocks: when a subscriber's bounded buffer is full the
+// configured BufferPolicy decides which event is lost.
 type EventHub struct {
 	mu              sync.RWMutex
 	subscribers     map[string]map[string]*Subscriber // circleID -> subscriberID -> subscriber
 	userSubscribers map[string]map[string]*Subscriber // userID -> subscriberID -> subscriber (for user-directed events)
 	heartbeat       *time.Ticker
 	done            chan struct{}
+
+	bufferSize       int
+	policy           BufferPolicy
+	delivered        atomic.Uint64
+	dropped          atomic.Uint64
+	slowDisconnected atomic.Uint64
+	faults           *chaos.Injector
+	notifications    *NotificationFeed
+
+	// Cross-instance fan-out (see events_broker.go)
+	broker         EventBroker
+	instanceID     string
+	outbound       chan []byte
+	brokerCancel   context.CancelFunc
+	brokerWG       sync.WaitGroup
+	brokerReceived atomic.Uint64
+	brokerDropped  atomic.Uint64
+	brokerErrors   atomic.Uint64
 }
 
 // NewEventHub creates a new event hub
-func NewEventHub() *EventHub {
+func NewEventHub(cfg EventHubConfig) *EventHub {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = DefaultSubscriberBufferSize
+	}
+	if cfg.Policy == "" {
+		cfg.Policy = BufferPolicyDropOldest
+	}
+
 	hub := &EventHub{
 		subscribers:     make(map[string]map[string]*Subscriber),
 		userSubscribers: make(map[string]map[string]*Subscriber),
 		done:            make(chan struct{}),
+		bufferSize:      cfg.BufferSize,
+		policy:          cfg.Policy,
+		faults:          cfg.Faults,
+		notifications:   cfg.Notifications,
 	}
 	// Start heartbeat
 	hub.heartbeat = time.NewTicker(30 * time.Second)
 	go hub.sendHeartbeats()
+
+	if cfg.Broker != nil {
+		hub.startBroker(cfg.Broker)
+	}
 	return hub
 }
 
+// newSubscriber creates a subscriber with a bounded buffer
+func (h *EventHub) newSubscriber(subscriberID, circleID string) *Subscriber {
+	return &Subscriber{
+		ID:       subscriberID,
+		CircleID: circleID,
+		Events:   make(chan *Event, h.bufferSize),
+		Done:     make(chan struct{}),
+	}
+}
+
 // Subscribe adds a new subscriber for a circle
 func (h *EventHub) Subscribe(circleID, subscriberID string) *Subscriber {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	sub := &Subscriber{
-		ID:       subscriberID,
-		CircleID: circleID,
-		Events:   make(chan *Event, 100), // Buffer to prevent blocking
-		Done:     make(chan struct{}),
-	}
+	sub := h.newSubscriber(subscriberID, circleID)
 
 	if h.subscribers[circleID] == nil {
 		h.subscribers[circleID] = make(map[string]*Subscriber)
@@ -91,7 +219,7 @@ func (h *EventHub) Unsubscribe(circleID, subscriberID string) {
 
 	if circleSubs, ok := h.subscribers[circleID]; ok {
 		if sub, ok := circleSubs[subscriberID]; ok {
-			close(sub.Done)
+			sub.closeDone()
 			close(sub.Events)
 			delete(circleSubs, subscriberID)
 		}
@@ -101,8 +229,15 @@ func (h *EventHub) Unsubscribe(circleID, subscriberID string) {
 	}
 }
 
-// Publish sends an event to all subscribers of a circle
+// Publish sends an event to all subscribers of a circle, on every instance
 func (h *EventHub) Publish(event *Event) {
+	h.injectDelay()
+	h.publishLocal(event)
+	h.forward(event, "")
+}
+
+// publishLocal sends an event to this instance's subscribers of a circle
+func (h *EventHub) publishLocal(event *Event) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
@@ -112,12 +247,7 @@ func (h *EventHub) Publish(event *Event) {
 	}
 
 	for _, sub := range circleSubs {
-		select {
-		case sub.Events <- event:
-			// Event sent successfully
-		default:
-			// Buffer full, skip this subscriber
-		}
+		h.deliver(sub, event)
 	}
 }
 
@@ -126,12 +256,7 @@ func (h *EventHub) SubscribeUser(userID, subscriberID string) *Subscriber {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	sub := &Subscriber{
-		ID:       subscriberID,
-		CircleID: "", // Not circle-bound
-		Events:   make(chan *Event, 100),
-		Done:     make(chan struct{}),
-	}
+	sub := h.newSubscriber(subscriberID, "") // Not circle-bound
 
 	if h.userSubscribers[userID] == nil {
 		h.userSubscribers[userID] = make(map[string]*Subscriber)
@@ -148,7 +273,7 @@ func (h *EventHub) UnsubscribeUser(userID, subscriberID string) {
 
 	if userSubs, ok := h.userSubscribers[userID]; ok {
 		if sub, ok := userSubs[subscriberID]; ok {
-			close(sub.Done)
+			sub.closeDone()
 			close(sub.Events)
 			delete(userSubs, subscriberID)
 		}
@@ -158,8 +283,23 @@ func (h *EventHub) UnsubscribeUser(userID, subscriberID string) {
 	}
 }
 
-// SendToUser sends an event to all subscribers of a specific user
+// SendToUser sends an event to all subscribers of a specific user, on every instance
 func (h *EventHub) SendToUser(userID string, event Event) {
+	if h.notifications != nil && event.ID == "" {
+		event.ID = h.notifications.nextID()
+	}
+	h.injectDelay()
+	h.sendLocalToUser(userID, event)
+	h.forward(&event, userID)
+}
+
+// sendLocalToUser sends an event to this instance's subscribers of a user
+// and keeps it for the user's polls
+func (h *EventHub) sendLocalToUser(userID string, event Event) {
+	if h.notifications != nil {
+		h.notifications.record(userID, event)
+	}
+
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
@@ -169,37 +309,97 @@ func (h *EventHub) SendToUser(userID string, event Event) {
 	}
 
 	for _, sub := range userSubs {
+		h.deliver(sub, &event)
+	}
+}
+
+// deliver places an event in a subscriber's buffer without blocking,
+// applying the buffer policy when the buffer is full.
+// Callers must hold at least the read lock so Events is not closed concurrently.
+func (h *EventHub) deliver(sub *Subscriber, event *Event) {
+	if sub.Disconnected() {
+		return
+	}
+
+	// Injected faults lose the event or drop the client's connection
+	switch h.faults.Fail(chaos.EventHub) {
+	case chaos.ErrInjected:
+		h.recordDrop(sub)
+		return
+	case chaos.ErrDisconnected:
+		h.recordDrop(sub)
+		if sub.disconnected.CompareAndSwap(false, true) {
+			sub.closeDone()
+		}
+		return
+	}
+
+	select {
+	case sub.Events <- event:
+		h.delivered.Add(1)
+		return
+	default:
+	}
+
+	switch h.policy {
+	case BufferPolicyDropOldest:
+		// Make room by discarding the oldest buffered event, then retry once
+		select {
+		case <-sub.Events:
+			h.recordDrop(sub)
+		default:
+		}
 		select {
-		case sub.Events <- &event:
-			// Event sent successfully
+		case sub.Events <- event:
+			h.delivered.Add(1)
 		default:
-			// Buffer full, skip this subscriber
+			h.recordDrop(sub)
+		}
+	case BufferPolicyDisconnect:
+		h.recordDrop(sub)
+		if sub.disconnected.CompareAndSwap(false, true) {
+			h.slowDisconnected.Add(1)
+			sub.closeDone()
 		}
+	default:
+		h.recordDrop(sub)
+	}
+}
+
+// injectDelay holds up a publish when fault injection adds latency
+func (h *EventHub) injectDelay() {
+	if delay := h.faults.Delay(chaos.EventHub); delay > 0 {
+		time.Sleep(delay)
 	}
 }
 
+func (h *EventHub) recordDrop(sub *Subscriber) {
+	sub.dropped.Add(1)
+	h.dropped.Add(1)
+}
+
 // sendHeartbeats sends periodic heartbeats to all subscribers
 func (h *EventHub) sendHeartbeats() {
 	for {
 		select {
 		case <-h.heartbeat.C:
 			h.mu.RLock()
-			event := &Event{
-				Type: EventHeartbeat,
-				Data: map[string]string{
-					"timestamp": time.Now().UTC().Format(time.RFC3339),
-				},
-			}
 			for circleID, circleSubs := range h.subscribers {
-				event.CircleID = circleID
+				event := &Event{
+					Type: EventHeartbeat,
+					Data: map[string]string{
+						"timestamp": time.Now().UTC().Format(time.RFC3339),
+					},
+					CircleID: circleID,
+				}
 				for _, sub := range circleSubs {
-					select {
-					case sub.Events <- event:
-					default:
-					}
+					h.deliver(sub, event)
 				}
 			}
 			h.mu.RUnlock()
+			if h.notifications != nil {
+				h.notifications.Sweep()
+			}
 		case <-h.done:
 			return
 		}
@@ -210,19 +410,61 @@ func (h *EventHub) sendHeartbeats() {
 func (h *EventHub) Close() {
 	close(h.done)
 	h.heartbeat.Stop()
+	h.stopBroker()
 
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
 	for circleID, circleSubs := range h.subscribers {
 		for _, sub := range circleSubs {
-			close(sub.Done)
+			sub.closeDone()
 			close(sub.Events)
 		}
 		delete(h.subscribers, circleID)
 	}
 }
 
+// Stats returns delivery counters and current buffer occupancy
+func (h *EventHub) Stats() EventHubStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	stats := EventHubStats{
+		BufferSize:       h.bufferSize,
+		Policy:           string(h.policy),
+		EventsDelivered:  h.delivered.Load(),
+		EventsDropped:    h.dropped.Load(),
+		SlowDisconnected: h.slowDisconnected.Load(),
+	}
+
+	for _, group := range []map[string]map[string]*Subscriber{h.subscribers, h.userSubscribers} {
+		for _, subs := range group {
+			for _, sub := range subs {
+				stats.Subscribers++
+				buffered := len(sub.Events)
+				stats.BufferedEvents += buffered
+				if fill := float64(buffered) / float64(h.bufferSize); fill > stats.MaxBufferFill {
+					stats.MaxBufferFill = fill
+				}
+			}
+		}
+	}
+
+	return stats
+}
+
+// WriteMetrics writes event hub metrics in Prometheus text exposition format
+func (h *EventHub) WriteMetrics(w io.Writer) {
+	stats := h.Stats()
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_subscribers Connected SSE subscribers\n# TYPE saga_eventhub_subscribers gauge\nsaga_eventhub_subscribers %d\n", stats.Subscribers)
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_buffered_events Events waiting in subscriber buffers\n# TYPE saga_eventhub_buffered_events gauge\nsaga_eventhub_buffered_events %d\n", stats.BufferedEvents)
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_max_buffer_fill Fullest subscriber buffer as a fraction of capacity\n# TYPE saga_eventhub_max_buffer_fill gauge\nsaga_eventhub_max_buffer_fill %g\n", stats.MaxBufferFill)
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_events_delivered_total Events placed in subscriber buffers\n# TYPE saga_eventhub_events_delivered_total counter\nsaga_eventhub_events_delivered_total %d\n", stats.EventsDelivered)
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_events_dropped_total Events dropped because a subscriber buffer was full\n# TYPE saga_eventhub_events_dropped_total counter\nsaga_eventhub_events_dropped_total %d\n", stats.EventsDropped)
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_slow_disconnects_total Subscribers disconnected for falling behind\n# TYPE saga_eventhub_slow_disconnects_total counter\nsaga_eventhub_slow_disconnects_total %d\n", stats.SlowDisconnected)
+	h.writeBrokerMetrics(w)
+}
+
 // SubscriberCount returns the number of subscribers for a circle
 func (h *EventHub) SubscriberCount(circleID string) int {
 	h.mu.RLock()
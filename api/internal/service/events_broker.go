@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventBroker carries encoded events between API instances so SSE clients
+// see events regardless of which replica published them
+type EventBroker interface {
+	Publish(ctx context.Context, payload []byte) error
+	Subscribe(ctx context.Context, deliver func(payload []byte)) error
+	Close() error
+}
+
+// Broker fan-out settings
+const (
+	// brokerOutboundBuffer bounds events waiting to be sent to the broker
+	brokerOutboundBuffer = 1024
+	// brokerPublishTimeout bounds a single broker publish
+	brokerPublishTimeout = 2 * time.Second
+	// brokerResubscribeDelay is the pause before resubscribing after a broker error
+	brokerResubscribeDelay = 2 * time.Second
+)
+
+// brokerEnvelope is the wire format for events sent through the broker
+type brokerEnvelope struct {
+	Origin   string          `json:"origin"`
+	CircleID string          `json:"circle_id,omitempty"`
+	UserID   string          `json:"user_id,omitempty"`
+	ID       string          `json:"id,omitempty"`
+	Type     EventType       `json:"type"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// startBroker begins forwarding events to and consuming events from the broker
+func (h *EventHub) startBroker(broker EventBroker) {
+	ctx, cancel := context.WithCancel(context.Background())
+	h.broker = broker
+	h.instanceID = uuid.New().String()
+	h.outbound = make(chan []byte, brokerOutboundBuffer)
+	h.brokerCancel = cancel
+
+	h.brokerWG.Add(2)
+	go h.sendToBroker(ctx)
+	go h.consumeBroker(ctx)
+}
+
+// stopBroker stops broker goroutines and closes the broker
+func (h *EventHub) stopBroker() {
+	if h.broker == nil {
+		return
+	}
+	h.brokerCancel()
+	h.brokerWG.Wait()
+	_ = h.broker.Close()
+}
+
+// forward queues an event for other instances without blocking the publisher.
+// Events are dropped and counted if the outbound queue is full.
+func (h *EventHub) forward(event *Event, userID string) {
+	if h.broker == nil {
+		return
+	}
+
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		h.brokerErrors.Add(1)
+		return
+	}
+	payload, err := json.Marshal(brokerEnvelope{
+		Origin:   h.instanceID,
+		CircleID: event.CircleID,
+		UserID:   userID,
+		ID:       event.ID,
+		Type:     event.Type,
+		Data:     data,
+	})
+	if err != nil {
+		h.brokerErrors.Add(1)
+		return
+	}
+
+	select {
+	case h.outbound <- payload:
+	default:
+		h.brokerDropped.Add(1)
+	}
+}
+
+// sendToBroker drains the outbound queue
+func (h *EventHub) sendToBroker(ctx context.Context) {
+	defer h.brokerWG.Done()
+
+	for {
+		select {
+		case payload := <-h.outbound:
+			pubCtx, cancel := context.WithTimeout(ctx, brokerPublishTimeout)
+			if err := h.broker.Publish(pubCtx, payload); err != nil {
+				h.brokerErrors.Add(1)
+				slog.Warn("event broker publish failed", slog.String("error", err.Error()))
+			}
+			cancel()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// consumeBroker delivers events from other instances to local subscribers,
+// resubscribing after broker errors until the hub is closed
+func (h *EventHub) consumeBroker(ctx context.Context) {
+	defer h.brokerWG.Done()
+
+	for {
+		if err := h.broker.Subscribe(ctx, h.receiveFromBroker); err != nil {
+			h.brokerErrors.Add(1)
+			slog.Warn("event broker subscription failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(brokerResubscribeDelay):
+		}
+	}
+}
+
+// receiveFromBroker decodes a broker payload and delivers it locally.
+// Events this instance published were already delivered locally and are skipped.
+func (h *EventHub) receiveFromBroker(payload []byte) {
+	var envelope brokerEnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		h.brokerErrors.Add(1)
+		return
+	}
+	if envelope.Origin == h.instanceID {
+		return
+	}
+	h.brokerReceived.Add(1)
+
+	event := Event{
+		Type:     envelope.Type,
+		Data:     envelope.Data,
+		CircleID: envelope.CircleID,
+		ID:       envelope.ID,
+	}
+	if envelope.UserID != "" {
+		h.sendLocalToUser(envelope.UserID, event)
+		return
+	}
+	h.publishLocal(&event)
+}
+
+// writeBrokerMetrics writes broker fan-out metrics in Prometheus text format
+func (h *EventHub) writeBrokerMetrics(w io.Writer) {
+	if h.broker == nil {
+		return
+	}
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_broker_received_total Events received from other instances\n# TYPE saga_eventhub_broker_received_total counter\nsaga_eventhub_broker_received_total %d\n", h.brokerReceived.Load())
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_broker_dropped_total Events not forwarded because the outbound queue was full\n# TYPE saga_eventhub_broker_dropped_total counter\nsaga_eventhub_broker_dropped_total %d\n", h.brokerDropped.Load())
+	_, _ = fmt.Fprintf(w, "# HELP saga_eventhub_broker_errors_total Broker encode, publish and subscribe failures\n# TYPE saga_eventhub_broker_errors_total counter\nsaga_eventhub_broker_errors_total %d\n", h.brokerErrors.Load())
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/chaos"
+)
+
+// ============================================================================
+// Buffer Policy Tests
+// ============================================================================
+
+func TestEventHub_DropOldestKeepsNewestEvents(t *testing.T) {
+	t.Parallel()
+
+	hub := NewEventHub(EventHubConfig{BufferSize: 2, Policy: BufferPolicyDropOldest})
+	defer hub.Close()
+
+	sub := hub.Subscribe("guild:1", "sub-1")
+	for _, data := range []string{"a", "b", "c"} {
+		hub.Publish(&Event{Type: EventNudge, CircleID: "guild:1", Data: data})
+	}
+
+	first, second := <-sub.Events, <-sub.Events
+	if first.Data != "b" || second.Data != "c" {
+		t.Errorf("expected [b c], got [%v %v]", first.Data, second.Data)
+	}
+	if sub.Dropped() != 1 {
+		t.Errorf("expected 1 dropped event, got %d", sub.Dropped())
+	}
+}
+
+func TestEventHub_DropNewestKeepsBufferedEvents(t *testing.T) {
+	t.Parallel()
+
+	hub := NewEventHub(EventHubConfig{BufferSize: 2, Policy: BufferPolicyDropNewest})
+	defer hub.Close()
+
+	sub := hub.SubscribeUser("user-1", "sub-1")
+	for _, data := range []string{"a", "b", "c"} {
+		hub.SendToUser("user-1", Event{Type: EventNudge, Data: data})
+	}
+
+	first, second := <-sub.Events, <-sub.Events
+	if first.Data != "a" || second.Data != "b" {
+		t.Errorf("expected [a b], got [%v %v]", first.Data, second.Data)
+	}
+	if hub.Stats().EventsDropped != 1 {
+		t.Errorf("expected 1 dropped event, got %d", hub.Stats().EventsDropped)
+	}
+}
+
+func TestEventHub_DisconnectPolicyClosesSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	hub := NewEventHub(EventHubConfig{BufferSize: 1, Policy: BufferPolicyDisconnect})
+	defer hub.Close()
+
+	sub := hub.Subscribe("guild:1", "sub-1")
+	hub.Publish(&Event{Type: EventNudge, CircleID: "guild:1"})
+	hub.Publish(&Event{Type: EventNudge, CircleID: "guild:1"})
+
+	select {
+	case <-sub.Done:
+	default:
+		t.Fatal("expected slow subscriber to be disconnected")
+	}
+	if !sub.Disconnected() {
+		t.Error("expected subscriber to report disconnected")
+	}
+
+	// Unsubscribing after a disconnect must not panic
+	hub.Unsubscribe("guild:1", "sub-1")
+
+	stats := hub.Stats()
+	if stats.SlowDisconnected != 1 || stats.Subscribers != 0 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestEventHub_InjectedFaults(t *testing.T) {
+	t.Parallel()
+
+	lossy := NewEventHub(EventHubConfig{
+		Faults: chaos.NewInjector(map[chaos.Target]chaos.Rule{chaos.EventHub: {ErrorRate: 1}}, nil),
+	})
+	defer lossy.Close()
+	sub := lossy.Subscribe("guild:1", "sub-1")
+	lossy.Publish(&Event{Type: EventNudge, CircleID: "guild:1"})
+	if len(sub.Events) != 0 || lossy.Stats().EventsDropped != 1 {
+		t.Errorf("expected the event to be dropped, stats %+v", lossy.Stats())
+	}
+
+	flaky := NewEventHub(EventHubConfig{
+		Faults: chaos.NewInjector(map[chaos.Target]chaos.Rule{chaos.EventHub: {DisconnectRate: 1}}, nil),
+	})
+	defer flaky.Close()
+	sub = flaky.SubscribeUser("user-1", "sub-1")
+	flaky.SendToUser("user-1", Event{Type: EventNudge})
+	select {
+	case <-sub.Done:
+	default:
+		t.Fatal("expected the subscriber to be disconnected")
+	}
+	if flaky.Stats().SlowDisconnected != 0 {
+		t.Error("expected injected disconnects not to count as slow subscribers")
+	}
+	flaky.UnsubscribeUser("user-1", "sub-1")
+}
+
+func TestEventHub_WriteMetrics(t *testing.T) {
+	t.Parallel()
+
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+
+	hub.Subscribe("guild:1", "sub-1")
+	hub.Publish(&Event{Type: EventNudge, CircleID: "guild:1"})
+
+	var buf bytes.Buffer
+	hub.WriteMetrics(&buf)
+	for _, want := range []string{"saga_eventhub_subscribers 1", "saga_eventhub_buffered_events 1", "saga_eventhub_events_delivered_total 1"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected %q in metrics output", want)
+		}
+	}
+}
+
+// ============================================================================
+// Broker Fan-out Tests
+// ============================================================================
+
+// fakeBrokerBus connects brokers in-process, like a shared Redis channel
+type fakeBrokerBus struct {
+	mu   sync.Mutex
+	subs []func([]byte)
+}
+
+type fakeBroker struct {
+	bus *fakeBrokerBus
+}
+
+func (b *fakeBroker) Publish(ctx context.Context, payload []byte) error {
+	b.bus.mu.Lock()
+	defer b.bus.mu.Unlock()
+	for _, deliver := range b.bus.subs {
+		deliver(payload)
+	}
+	return nil
+}
+
+func (b *fakeBroker) Subscribe(ctx context.Context, deliver func([]byte)) error {
+	b.bus.mu.Lock()
+	b.bus.subs = append(b.bus.subs, deliver)
+	b.bus.mu.Unlock()
+	<-ctx.Done()
+	return nil
+}
+
+func (b *fakeBroker) Close() error { return nil }
+
+func waitForEvent(t *testing.T, sub *Subscriber) *Event {
+	t.Helper()
+	select {
+	case e := <-sub.Events:
+		return e
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return nil
+	}
+}
+
+func TestEventHub_BrokerFansOutAcrossInstances(t *testing.T) {
+	t.Parallel()
+
+	bus := &fakeBrokerBus{}
+	hubA := NewEventHub(EventHubConfig{Broker: &fakeBroker{bus: bus}})
+	defer hubA.Close()
+	hubB := NewEventHub(EventHubConfig{Broker: &fakeBroker{bus: bus}})
+	defer hubB.Close()
+
+	// Wait for both hubs to subscribe to the bus
+	for deadline := time.Now().Add(2 * time.Second); ; {
+		bus.mu.Lock()
+		n := len(bus.subs)
+		bus.mu.Unlock()
+		if n == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("hubs did not subscribe to broker")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	subA := hubA.SubscribeUser("user-1", "sub-a")
+	subB := hubB.SubscribeUser("user-1", "sub-b")
+	guildB := hubB.Subscribe("guild:1", "sub-guild")
+
+	hubA.SendToUser("user-1", Event{Type: EventNudge, Data: map[string]string{"msg": "hi"}})
+	hubA.Publish(&Event{Type: EventMemberJoined, CircleID: "guild:1"})
+
+	if e := waitForEvent(t, subA); e.Type != EventNudge {
+		t.Errorf("expected local nudge on A, got %s", e.Type)
+	}
+	if e := waitForEvent(t, subB); e.Type != EventNudge || !strings.Contains(e.Format(), `"msg":"hi"`) {
+		t.Errorf("expected forwarded nudge on B, got %s", e.Format())
+	}
+	if e := waitForEvent(t, guildB); e.Type != EventMemberJoined {
+		t.Errorf("expected forwarded guild event on B, got %s", e.Type)
+	}
+
+	// A must not receive its own event back from the broker
+	select {
+	case e := <-subA.Events:
+		t.Errorf("unexpected duplicate event on A: %s", e.Type)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ExpenseRepository defines the interface for shared expense storage.
+// Expenses and settlements belong to a scope: an event or an adventure.
+type ExpenseRepository interface {
+	Create(ctx context.Context, expense *model.Expense) error
+	Get(ctx context.Context, expenseID string) (*model.Expense, error)
+	GetByScope(ctx context.Context, scopeID string) ([]*model.Expense, error)
+	Delete(ctx context.Context, expenseID string) error
+	CreateSettlement(ctx context.Context, settlement *model.ExpenseSettlement) error
+	GetSettlements(ctx context.Context, scopeID string) ([]*model.ExpenseSettlement, error)
+}
+
+// ExpenseEventRepository provides the events and attendees expenses are
+// split among
+type ExpenseEventRepository interface {
+	Get(ctx context.Context, eventID string) (*model.Event, error)
+	IsHost(ctx context.Context, eventID, userID string) (bool, error)
+	GetHosts(ctx context.Context, eventID string) ([]*model.EventHost, error)
+	GetRSVPsByEvent(ctx context.Context, eventID string) ([]*model.EventRSVP, error)
+}
+
+// EventExpenseService splits an event's costs among its hosts and approved
+// attendees. Hosts record what they paid; everyone taking part sees their
+// share and what they owe, and either side of a debt marks it settled.
+// Plus-ones count toward their attendee's share. The ledger itself is
+// model.BuildExpenseLedger, shared with adventure expenses.
+type EventExpenseService struct {
+	expenses ExpenseRepository
+	events   ExpenseEventRepository
+}
+
+// EventExpenseServiceConfig holds configuration for the event expense
+// service
+type EventExpenseServiceConfig struct {
+	Expenses ExpenseRepository
+	Events   ExpenseEventRepository
+}
+
+// NewEventExpenseService creates a new event expense service
+func NewEventExpenseService(cfg EventExpenseServiceConfig) *EventExpenseService {
+	return &EventExpenseService{
+		expenses: cfg.Expenses,
+		events:   cfg.Events,
+	}
+}
+
+// ListExpenses returns an event's expenses, oldest first (participants only)
+func (s *EventExpenseService) ListExpenses(ctx context.Context, userID, eventID string) ([]*model.Expense, error) {
+	if _, err := s.participantsFor(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+	return s.expenses.GetByScope(ctx, eventID)
+}
+
+// AddExpense records a cost a participant paid for the event (hosts only).
+// Every expense on an event is in the currency of the first.
+func (s *EventExpenseService) AddExpense(ctx context.Context, userID, eventID string, req *model.CreateExpenseRequest) (*model.Expense, error) {
+	participants, err := s.participantsFor(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	isHost, err := s.events.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isHost {
+		return nil, ErrNotEventHost
+	}
+
+	paidBy := userID
+	if req.PaidBy != nil {
+		paidBy = *req.PaidBy
+	}
+	if !isParticipant(participants, paidBy) {
+		return nil, ErrPayerNotParticipant
+	}
+
+	existing, err := s.expenses.GetByScope(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= model.MaxExpensesPerScope {
+		return nil, ErrTooManyExpenses
+	}
+	if len(existing) > 0 && existing[0].Currency != req.Currency {
+		return nil, ErrExpenseCurrencyMismatch
+	}
+
+	expense := &model.Expense{
+		ScopeID:     eventID,
+		PaidBy:      paidBy,
+		Description: req.Description,
+		Amount:      req.Amount,
+		Currency:    req.Currency,
+		CreatedBy:   userID,
+	}
+	if err := s.expenses.Create(ctx, expense); err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
+
+// DeleteExpense removes an expense recorded by mistake (hosts only).
+// Settlements already made stay; the ledger shows any overpayment as owed
+// back.
+func (s *EventExpenseService) DeleteExpense(ctx context.Context, userID, eventID, expenseID string) error {
+	if _, err := s.participantsFor(ctx, eventID, userID); err != nil {
+		return err
+	}
+	isHost, err := s.events.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if !isHost {
+		return ErrNotEventHost
+	}
+
+	expense, err := s.expenses.Get(ctx, expenseID)
+	if err != nil {
+		return err
+	}
+	if expense == nil || expense.ScopeID != eventID {
+		return ErrExpenseNotFound
+	}
+	return s.expenses.Delete(ctx, expenseID)
+}
+
+// Ledger returns each participant's share and balance, and the transfers
+// that would settle everyone up (participants only)
+func (s *EventExpenseService) Ledger(ctx context.Context, userID, eventID string) (*model.ExpenseLedger, error) {
+	participants, err := s.participantsFor(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.ledger(ctx, eventID, participants)
+}
+
+// Settle marks what one participant owes another as paid, in full. Either
+// party or a host can mark it.
+func (s *EventExpenseService) Settle(ctx context.Context, userID, eventID string, req *model.SettleExpensesRequest) (*model.ExpenseSettlement, error) {
+	participants, err := s.participantsFor(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != req.FromUser && userID != req.ToUser {
+		isHost, err := s.events.IsHost(ctx, eventID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !isHost {
+			return nil, ErrNotSettlementParty
+		}
+	}
+
+	ledger, err := s.ledger(ctx, eventID, participants)
+	if err != nil {
+		return nil, err
+	}
+	transfer := ledger.Transfer(req.FromUser, req.ToUser)
+	if transfer == nil {
+		return nil, ErrNothingToSettle
+	}
+
+	settlement := &model.ExpenseSettlement{
+		ScopeID:  eventID,
+		FromUser: transfer.FromUser,
+		ToUser:   transfer.ToUser,
+		Amount:   transfer.Amount,
+		Currency: ledger.Currency,
+		MarkedBy: userID,
+	}
+	if err := s.expenses.CreateSettlement(ctx, settlement); err != nil {
+		return nil, err
+	}
+	return settlement, nil
+}
+
+// ledger builds an event's ledger over its participants
+func (s *EventExpenseService) ledger(ctx context.Context, eventID string, participants []model.ExpenseParticipant) (*model.ExpenseLedger, error) {
+	expenses, err := s.expenses.GetByScope(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	settlements, err := s.expenses.GetSettlements(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return model.BuildExpenseLedger(participants, expenses, settlements), nil
+}
+
+// participantsFor returns the people an event's expenses are split among:
+// its hosts, and its approved attendees with their plus-ones. Fails unless
+// userID is one of them.
+func (s *EventExpenseService) participantsFor(ctx context.Context, eventID, userID string) ([]model.ExpenseParticipant, error) {
+	event, err := s.events.Get(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil || event.IsUnpublished() {
+		return nil, ErrEventNotFound
+	}
+
+	hosts, err := s.events.GetHosts(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	rsvps, err := s.events.GetRSVPsByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	headcounts := map[string]int{}
+	for _, host := range hosts {
+		headcounts[host.UserID] = 1
+	}
+	for _, rsvp := range rsvps {
+		if rsvp.Status == model.RSVPStatusApproved {
+			headcounts[rsvp.UserID] = 1 + rsvp.PlusOnes
+		}
+	}
+	if _, ok := headcounts[userID]; !ok {
+		return nil, ErrNotExpenseParticipant
+	}
+
+	participants := make([]model.ExpenseParticipant, 0, len(headcounts))
+	for id, headcount := range headcounts {
+		participants = append(participants, model.ExpenseParticipant{UserID: id, Headcount: headcount})
+	}
+	return participants, nil
+}
+
+// isParticipant reports whether userID is among participants
+func isParticipant(participants []model.ExpenseParticipant, userID string) bool {
+	for _, p := range participants {
+		if p.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// expenseEventRepo serves event:1, hosted by user:host. user:guest has an
+// approved RSVP with one plus-one, user:pending is still waiting, and
+// user:outsider never RSVPed.
+func expenseEventRepo() *mocks.ExpenseEventRepository {
+	return &mocks.ExpenseEventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			if eventID != "event:1" {
+				return nil, nil
+			}
+			return &model.Event{ID: eventID, Status: model.EventStatusPublished, StartTime: time.Now()}, nil
+		},
+		IsHostFunc: func(ctx context.Context, eventID, userID string) (bool, error) {
+			return userID == "user:host", nil
+		},
+		GetHostsFunc: func(ctx context.Context, eventID string) ([]*model.EventHost, error) {
+			return []*model.EventHost{{UserID: "user:host", Role: "primary"}}, nil
+		},
+		GetRSVPsByEventFunc: func(ctx context.Context, eventID string) ([]*model.EventRSVP, error) {
+			return []*model.EventRSVP{
+				{UserID: "user:guest", Status: model.RSVPStatusApproved, PlusOnes: 1},
+				{UserID: "user:pending", Status: model.RSVPStatusPending},
+			}, nil
+		},
+	}
+}
+
+// campsiteExpense is a 30 USD cost the host paid for event:1
+func campsiteExpense() *model.Expense {
+	return &model.Expense{ID: "expense:1", ScopeID: "event:1", PaidBy: "user:host", Amount: 3000, Currency: "usd"}
+}
+
+// ============================================================================
+// Event Expense Tests
+// ============================================================================
+
+func TestEventExpenseService_AddExpense(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		userID   string
+		paidBy   string
+		existing []*model.Expense
+		wantErr  error
+		wantPaid string
+	}{
+		{name: "paid by the host", userID: "user:host", wantPaid: "user:host"},
+		{name: "paid by an attendee", userID: "user:host", paidBy: "user:guest", wantPaid: "user:guest"},
+		{name: "paid by a pending attendee", userID: "user:host", paidBy: "user:pending", wantErr: ErrPayerNotParticipant},
+		{name: "attendees can't record costs", userID: "user:guest", wantErr: ErrNotEventHost},
+		{name: "second currency", userID: "user:host", existing: []*model.Expense{{ID: "expense:1", Amount: 100, Currency: "eur"}}, wantErr: ErrExpenseCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var created *model.Expense
+			svc := NewEventExpenseService(EventExpenseServiceConfig{
+				Expenses: &mocks.ExpenseRepository{
+					GetByScopeFunc: func(ctx context.Context, scopeID string) ([]*model.Expense, error) {
+						return tt.existing, nil
+					},
+					CreateFunc: func(ctx context.Context, expense *model.Expense) error {
+						expense.ID = "expense:new"
+						created = expense
+						return nil
+					},
+				},
+				Events: expenseEventRepo(),
+			})
+
+			req := &model.CreateExpenseRequest{Description: "Campsite", Amount: 3000, Currency: "usd"}
+			if tt.paidBy != "" {
+				req.PaidBy = &tt.paidBy
+			}
+			expense, err := svc.AddExpense(context.Background(), tt.userID, "event:1", req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddExpense() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if created != nil {
+					t.Errorf("created %+v, want no expense", created)
+				}
+				return
+			}
+			if expense.PaidBy != tt.wantPaid || expense.ScopeID != "event:1" || expense.CreatedBy != tt.userID {
+				t.Errorf("expense = %+v, want it paid by %s and recorded by %s", expense, tt.wantPaid, tt.userID)
+			}
+		})
+	}
+}
+
+func TestEventExpenseService_Ledger(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := NewEventExpenseService(EventExpenseServiceConfig{
+		Expenses: &mocks.ExpenseRepository{
+			GetByScopeFunc: func(ctx context.Context, scopeID string) ([]*model.Expense, error) {
+				return []*model.Expense{campsiteExpense()}, nil
+			},
+		},
+		Events: expenseEventRepo(),
+	})
+
+	ledger, err := svc.Ledger(ctx, "user:guest", "event:1")
+	if err != nil {
+		t.Fatalf("Ledger() error = %v", err)
+	}
+	// The host and the guest with their plus-one; the pending RSVP isn't split in
+	if ledger.Headcount != 3 || ledger.PerPersonShare != 1000 {
+		t.Errorf("ledger = %+v, want 3 people at 1000 each", ledger)
+	}
+	if tr := ledger.Transfer("user:guest", "user:host"); tr == nil || tr.Amount != 2000 {
+		t.Errorf("transfer = %+v, want the guest to owe 2000", tr)
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		eventID string
+		wantErr error
+	}{
+		{"pending attendee", "user:pending", "event:1", ErrNotExpenseParticipant},
+		{"outsider", "user:outsider", "event:1", ErrNotExpenseParticipant},
+		{"missing event", "user:host", "event:2", ErrEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := svc.Ledger(ctx, tt.userID, tt.eventID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Ledger() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEventExpenseService_Settle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		userID     string
+		req        model.SettleExpensesRequest
+		settled    []*model.ExpenseSettlement
+		wantErr    error
+		wantAmount int
+	}{
+		{
+			name:       "debtor marks it paid",
+			userID:     "user:guest",
+			req:        model.SettleExpensesRequest{FromUser: "user:guest", ToUser: "user:host"},
+			wantAmount: 2000,
+		},
+		{
+			name:    "already settled",
+			userID:  "user:host",
+			req:     model.SettleExpensesRequest{FromUser: "user:guest", ToUser: "user:host"},
+			settled: []*model.ExpenseSettlement{{ScopeID: "event:1", FromUser: "user:guest", ToUser: "user:host", Amount: 2000, Currency: "usd"}},
+			wantErr: ErrNothingToSettle,
+		},
+		{
+			name:    "nothing owed that way",
+			userID:  "user:host",
+			req:     model.SettleExpensesRequest{FromUser: "user:host", ToUser: "user:guest"},
+			wantErr: ErrNothingToSettle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewEventExpenseService(EventExpenseServiceConfig{
+				Expenses: &mocks.ExpenseRepository{
+					GetByScopeFunc: func(ctx context.Context, scopeID string) ([]*model.Expense, error) {
+						return []*model.Expense{campsiteExpense()}, nil
+					},
+					GetSettlementsFunc: func(ctx context.Context, scopeID string) ([]*model.ExpenseSettlement, error) {
+						return tt.settled, nil
+					},
+				},
+				Events: expenseEventRepo(),
+			})
+
+			settlement, err := svc.Settle(context.Background(), tt.userID, "event:1", &tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Settle() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if settlement.Amount != tt.wantAmount || settlement.Currency != "usd" || settlement.MarkedBy != tt.userID {
+				t.Errorf("settlement = %+v, want %d usd marked by %s", settlement, tt.wantAmount, tt.userID)
+			}
+		})
+	}
+}
+
+func TestEventExpenseService_DeleteExpense(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		userID    string
+		expenseID string
+		wantErr   error
+	}{
+		{"another event's expense", "user:host", "expense:other", ErrExpenseNotFound},
+		{"attendee deleting", "user:guest", "expense:1", ErrNotEventHost},
+		{"host deleting", "user:host", "expense:1", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var deleted []string
+			svc := NewEventExpenseService(EventExpenseServiceConfig{
+				Expenses: &mocks.ExpenseRepository{
+					GetFunc: func(ctx context.Context, expenseID string) (*model.Expense, error) {
+						if expenseID == "expense:other" {
+							return &model.Expense{ID: expenseID, ScopeID: "event:2", PaidBy: "user:host", Amount: 100, Currency: "usd"}, nil
+						}
+						return campsiteExpense(), nil
+					},
+					DeleteFunc: func(ctx context.Context, expenseID string) error {
+						deleted = append(deleted, expenseID)
+						return nil
+					},
+				},
+				Events: expenseEventRepo(),
+			})
+
+			err := svc.DeleteExpense(context.Background(), tt.userID, "event:1", tt.expenseID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteExpense() error = %v, want %v", err, tt.wantErr)
+			}
+			if wantDeleted := tt.wantErr == nil; (len(deleted) == 1) != wantDeleted {
+				t.Errorf("deleted = %v, want deleted %v", deleted, wantDeleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DefaultExperimentCacheTTL bounds how long another instance may keep
+// assigning users to an experiment after an admin stops it
+const DefaultExperimentCacheTTL = time.Minute
+
+// ExperimentRepository defines the interface for experiment storage
+type ExperimentRepository interface {
+	Create(ctx context.Context, experiment *model.Experiment) error
+	GetByKey(ctx context.Context, key string) (*model.Experiment, error)
+	List(ctx context.Context, status model.ExperimentStatus) ([]*model.Experiment, error)
+	Update(ctx context.Context, key string, updates map[string]interface{}) (*model.Experiment, error)
+}
+
+// ExperimentService runs A/B experiments. Users are bucketed into a variant
+// by hashing the experiment's salt with their ID, so assignments need no
+// storage and stay the same across devices. Each assignment handed to a
+// client is logged as an exposure through the analytics pipeline.
+type ExperimentService struct {
+	repo      ExperimentRepository
+	analytics AnalyticsEmitter
+	cacheTTL  time.Duration
+	clock     clock.Clock
+
+	mu       sync.RWMutex
+	running  []*model.Experiment
+	loadedOn time.Time
+}
+
+// ExperimentServiceConfig holds configuration for the experiment service
+type ExperimentServiceConfig struct {
+	Repo      ExperimentRepository
+	Analytics AnalyticsEmitter // Optional; exposures aren't logged when nil
+	CacheTTL  time.Duration    // Default: DefaultExperimentCacheTTL
+	Clock     clock.Clock      // Default: the system clock
+}
+
+// NewExperimentService creates a new experiment service
+func NewExperimentService(cfg ExperimentServiceConfig) *ExperimentService {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultExperimentCacheTTL
+	}
+	return &ExperimentService{
+		repo:      cfg.Repo,
+		analytics: cfg.Analytics,
+		cacheTTL:  cfg.CacheTTL,
+		clock:     clock.OrReal(cfg.Clock),
+	}
+}
+
+// Assignments returns the user's variant in each running experiment on the
+// platform, logging an exposure for each
+func (s *ExperimentService) Assignments(ctx context.Context, userID string, platform model.DevicePlatform) ([]*model.ExperimentAssignment, error) {
+	running, err := s.loadRunning(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]*model.ExperimentAssignment, 0, len(running))
+	for _, experiment := range running {
+		if !experiment.Targets(platform) {
+			continue
+		}
+		variant := bucketVariant(experiment, userID)
+		if variant == nil {
+			continue
+		}
+
+		assignments = append(assignments, &model.ExperimentAssignment{
+			Experiment: experiment.Key,
+			Variant:    variant.Key,
+			Params:     unprotectedParams(variant.Params),
+		})
+		emitAnalytics(ctx, s.analytics, model.AnalyticsExperimentExposed, userID, map[string]interface{}{
+			"experiment": experiment.Key,
+			"variant":    variant.Key,
+		})
+	}
+	return assignments, nil
+}
+
+// List returns experiments, newest first, optionally only those with status
+func (s *ExperimentService) List(ctx context.Context, status model.ExperimentStatus) ([]*model.Experiment, error) {
+	return s.repo.List(ctx, status)
+}
+
+// Get returns an experiment by key
+func (s *ExperimentService) Get(ctx context.Context, key string) (*model.Experiment, error) {
+	experiment, err := s.repo.GetByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if experiment == nil {
+		return nil, ErrExperimentNotFound
+	}
+	return experiment, nil
+}
+
+// Create creates a draft experiment with a fresh salt, so its buckets are
+// independent of every other experiment's
+func (s *ExperimentService) Create(ctx context.Context, adminID string, req *model.CreateExperimentRequest) (*model.Experiment, error) {
+	salt := make([]byte, 8)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	experiment := &model.Experiment{
+		Key:         req.Key,
+		Description: req.Description,
+		Status:      model.ExperimentDraft,
+		Salt:        hex.EncodeToString(salt),
+		Variants:    req.Variants,
+		Platforms:   req.Platforms,
+		CreatedBy:   adminID,
+	}
+	if err := s.repo.Create(ctx, experiment); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrExperimentExists
+		}
+		return nil, err
+	}
+	return experiment, nil
+}
+
+// Update changes an experiment's description or moves it from draft to
+// running, or to stopped. Stopped experiments can't be restarted.
+func (s *ExperimentService) Update(ctx context.Context, key string, req *model.UpdateExperimentRequest) (*model.Experiment, error) {
+	current, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.Status != nil && *req.Status != current.Status {
+		if !experimentTransitionAllowed(current.Status, *req.Status) {
+			return nil, ErrInvalidExperimentTransition
+		}
+		updates["status"] = string(*req.Status)
+	}
+	if len(updates) == 0 {
+		return current, nil
+	}
+
+	experiment, err := s.repo.Update(ctx, key, updates)
+	if err != nil {
+		return nil, err
+	}
+	if experiment == nil {
+		return nil, ErrExperimentNotFound
+	}
+
+	s.Invalidate()
+	return experiment, nil
+}
+
+// Invalidate drops the cached running experiments so the next read reloads
+// them
+func (s *ExperimentService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running = nil
+}
+
+// loadRunning returns the cached running experiments, reloading them when
+// missing or expired
+func (s *ExperimentService) loadRunning(ctx context.Context) ([]*model.Experiment, error) {
+	s.mu.RLock()
+	running, loadedOn := s.running, s.loadedOn
+	s.mu.RUnlock()
+
+	if running != nil && s.clock.Now().Sub(loadedOn) < s.cacheTTL {
+		return running, nil
+	}
+
+	running, err := s.repo.List(ctx, model.ExperimentRunning)
+	if err != nil {
+		return nil, err
+	}
+	if running == nil {
+		running = []*model.Experiment{}
+	}
+
+	s.mu.Lock()
+	s.running = running
+	s.loadedOn = s.clock.Now()
+	s.mu.Unlock()
+	return running, nil
+}
+
+// bucketVariant picks the user's variant: a hash of the salt and user ID,
+// taken modulo the total weight, lands in one variant's share
+func bucketVariant(experiment *model.Experiment, userID string) *model.ExperimentVariant {
+	total := 0
+	for _, variant := range experiment.Variants {
+		total += variant.Weight
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	sum := sha256.Sum256([]byte(experiment.Salt + "." + userID))
+	point := int(binary.BigEndian.Uint64(sum[:8]) % uint64(total))
+	for i := range experiment.Variants {
+		point -= experiment.Variants[i].Weight
+		if point < 0 {
+			return &experiment.Variants[i]
+		}
+	}
+	return nil
+}
+
+// unprotectedParams drops params in protected namespaces. Requests to
+// create experiments are validated against them already; this keeps a
+// stored experiment from varying them regardless.
+func unprotectedParams(params map[string]json.RawMessage) map[string]json.RawMessage {
+	if len(params) == 0 {
+		return nil
+	}
+	kept := make(map[string]json.RawMessage, len(params))
+	for key, value := range params {
+		if !model.IsProtectedConfigKey(key) {
+			kept[key] = value
+		}
+	}
+	return kept
+}
+
+// experimentTransitionAllowed reports whether an experiment may move from
+// one status to another
+func experimentTransitionAllowed(from, to model.ExperimentStatus) bool {
+	switch from {
+	case model.ExperimentDraft:
+		return to == model.ExperimentRunning || to == model.ExperimentStopped
+	case model.ExperimentRunning:
+		return to == model.ExperimentStopped
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+type recordingEmitter struct {
+	events []map[string]interface{}
+}
+
+func (e *recordingEmitter) Emit(ctx context.Context, name model.AnalyticsEventName, userID string, properties map[string]interface{}) {
+	if name == model.AnalyticsExperimentExposed {
+		e.events = append(e.events, properties)
+	}
+}
+
+func newOnboardingExperiment() *model.Experiment {
+	return &model.Experiment{
+		Key:    "onboarding_copy",
+		Status: model.ExperimentRunning,
+		Salt:   "5eed",
+		Variants: []model.ExperimentVariant{
+			{Key: "control", Weight: 50},
+			{Key: "friendly", Weight: 50, Params: map[string]json.RawMessage{
+				"onboarding.headline": json.RawMessage(`"Find your people"`),
+				"auth.mfa_required":   json.RawMessage(`false`), // Never served
+			}},
+		},
+	}
+}
+
+func TestExperimentService_Assignments(t *testing.T) {
+	t.Parallel()
+	iosOnly := &model.Experiment{
+		Key: "ios_only", Status: model.ExperimentRunning, Salt: "1",
+		Variants:  []model.ExperimentVariant{{Key: "a", Weight: 1}, {Key: "b", Weight: 1}},
+		Platforms: []model.DevicePlatform{model.PlatformIOS},
+	}
+	repo := &mocks.ExperimentRepository{
+		ListFunc: func(ctx context.Context, status model.ExperimentStatus) ([]*model.Experiment, error) {
+			if status != model.ExperimentRunning {
+				t.Errorf("List(%q), want running experiments", status)
+			}
+			return []*model.Experiment{newOnboardingExperiment(), iosOnly}, nil
+		},
+	}
+	emitter := &recordingEmitter{}
+	svc := NewExperimentService(ExperimentServiceConfig{Repo: repo, Analytics: emitter})
+	ctx := context.Background()
+
+	first, err := svc.Assignments(ctx, "user:1", model.PlatformWeb)
+	if err != nil {
+		t.Fatalf("Assignments() error = %v", err)
+	}
+	if len(first) != 1 || first[0].Experiment != "onboarding_copy" {
+		t.Fatalf("Assignments() = %+v, want only the experiment for every platform", first)
+	}
+	if _, ok := first[0].Params["auth.mfa_required"]; ok {
+		t.Error("protected params must not be served")
+	}
+
+	again, _ := svc.Assignments(ctx, "user:1", model.PlatformWeb)
+	if again[0].Variant != first[0].Variant {
+		t.Errorf("variant changed from %s to %s, want stable assignments", first[0].Variant, again[0].Variant)
+	}
+
+	if len(emitter.events) != 2 || emitter.events[0]["variant"] != first[0].Variant {
+		t.Errorf("exposures = %v, want one per assignment handed out", emitter.events)
+	}
+}
+
+func TestBucketVariant_FollowsWeights(t *testing.T) {
+	t.Parallel()
+	experiment := &model.Experiment{
+		Salt:     "weights",
+		Variants: []model.ExperimentVariant{{Key: "small", Weight: 1}, {Key: "large", Weight: 3}},
+	}
+
+	counts := map[string]int{}
+	const users = 20000
+	for i := 0; i < users; i++ {
+		counts[bucketVariant(experiment, fmt.Sprintf("user:%d", i)).Key]++
+	}
+
+	share := float64(counts["small"]) / users
+	if math.Abs(share-0.25) > 0.02 {
+		t.Errorf("small variant share = %.3f, want about 0.25", share)
+	}
+}
+
+func TestBucketVariant_SaltSeparatesExperiments(t *testing.T) {
+	t.Parallel()
+	a := &model.Experiment{Salt: "a", Variants: []model.ExperimentVariant{{Key: "x", Weight: 1}, {Key: "y", Weight: 1}}}
+	b := &model.Experiment{Salt: "b", Variants: a.Variants}
+
+	same := 0
+	const users = 2000
+	for i := 0; i < users; i++ {
+		userID := fmt.Sprintf("user:%d", i)
+		if bucketVariant(a, userID).Key == bucketVariant(b, userID).Key {
+			same++
+		}
+	}
+	if share := float64(same) / users; math.Abs(share-0.5) > 0.05 {
+		t.Errorf("%.3f of users share a variant across experiments, want about half", share)
+	}
+}
+
+func TestExperimentService_Update_Transitions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		from, to model.ExperimentStatus
+		wantErr  error
+	}{
+		{model.ExperimentDraft, model.ExperimentRunning, nil},
+		{model.ExperimentRunning, model.ExperimentStopped, nil},
+		{model.ExperimentStopped, model.ExperimentRunning, ErrInvalidExperimentTransition},
+		{model.ExperimentRunning, model.ExperimentDraft, ErrInvalidExperimentTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"_to_"+string(tt.to), func(t *testing.T) {
+			t.Parallel()
+			repo := &mocks.ExperimentRepository{
+				GetByKeyFunc: func(ctx context.Context, key string) (*model.Experiment, error) {
+					return &model.Experiment{Key: key, Status: tt.from}, nil
+				},
+				UpdateFunc: func(ctx context.Context, key string, updates map[string]interface{}) (*model.Experiment, error) {
+					return &model.Experiment{Key: key, Status: model.ExperimentStatus(updates["status"].(string))}, nil
+				},
+			}
+			svc := NewExperimentService(ExperimentServiceConfig{Repo: repo})
+
+			to := tt.to
+			_, err := svc.Update(context.Background(), "onboarding_copy", &model.UpdateExperimentRequest{Status: &to})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
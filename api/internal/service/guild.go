@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/database"
 	"github.com/forgo/saga/api/internal/model"
 )
@@ -14,16 +15,17 @@ import (
 type GuildRepository interface {
 	Create(ctx context.Context, guild *model.Guild) error
 	GetByID(ctx context.Context, id string) (*model.Guild, error)
-	Update(ctx context.Context, guild *model.Guild) error
+	Update(ctx context.Context, guild *model.Guild, version *int) (*model.Guild, error)
 	Delete(ctx context.Context, id string) error
 	GetGuildsForUser(ctx context.Context, userID string) ([]*model.Guild, error)
 	CountGuildsForUser(ctx context.Context, userID string) (int, error)
 	AddMember(ctx context.Context, memberID, guildID string, pendingApproval bool) error
 	AddMemberWithRole(ctx context.Context, memberID, guildID string, role model.GuildRole, pendingApproval bool) error
-	RemoveMember(ctx context.Context, memberID, guildID string) error
+	RemoveMember(ctx context.Context, memberID, guildID string) (*model.GuildMembership, error)
 	IsMember(ctx context.Context, userID, guildID string) (bool, error)
 	CountMembers(ctx context.Context, guildID string) (int, error)
 	GetMembers(ctx context.Context, guildID string) ([]*model.Member, error)
+	GetMembersPage(ctx context.Context, guildID string, page model.PageRequest) (*model.Page[*model.Member], error)
 	GetMemberRole(ctx context.Context, userID, guildID string) (model.GuildRole, error)
 	IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error)
 	IsGuildModerator(ctx context.Context, userID, guildID string) (bool, error)
@@ -40,6 +42,13 @@ type MemberRepository interface {
 	Delete(ctx context.Context, id string) error
 }
 
+// GuildSlugRepository defines the storage for guild slugs and their history
+type GuildSlugRepository interface {
+	GetBySlug(ctx context.Context, slug string) (*model.Guild, error)
+	GetSlugRedirect(ctx context.Context, slug string) (*model.GuildSlugRedirect, error)
+	ChangeSlug(ctx context.Context, guildID, previous, slug string) error
+}
+
 // Error definitions moved to errors.go
 
 // GuildService handles guild business logic
@@ -47,6 +56,12 @@ type GuildService struct {
 	guildRepo  GuildRepository
 	memberRepo MemberRepository
 	userRepo   UserRepository
+	slugRepo   GuildSlugRepository
+	activity   ActivityRecorder
+	undo       UndoRecorder
+	events     DomainEventPublisher
+	tx         Transactor
+	clock      clock.Clock
 }
 
 // GuildServiceConfig holds dependencies for GuildService
@@ -54,6 +69,12 @@ type GuildServiceConfig struct {
 	GuildRepo  GuildRepository
 	MemberRepo MemberRepository
 	UserRepo   UserRepository
+	SlugRepo   GuildSlugRepository  // Optional; guilds get no slugs when nil
+	Activity   ActivityRecorder     // Optional; records joins on the activity timeline
+	Undo       UndoRecorder         // Optional; leaving is final when nil
+	Events     DomainEventPublisher // Optional; publishes joins to subscribers
+	Tx         Transactor           // Optional; without it a failed guild creation is compensated
+	Clock      clock.Clock          // Default: the system clock
 }
 
 // NewGuildService creates a new guild service
@@ -62,16 +83,28 @@ func NewGuildService(cfg GuildServiceConfig) *GuildService {
 		guildRepo:  cfg.GuildRepo,
 		memberRepo: cfg.MemberRepo,
 		userRepo:   cfg.UserRepo,
+		slugRepo:   cfg.SlugRepo,
+		activity:   cfg.Activity,
+		undo:       cfg.Undo,
+		events:     cfg.Events,
+		tx:         cfg.Tx,
+		clock:      clock.OrReal(cfg.Clock),
 	}
 }
 
+// maxGuildSlugSuffix bounds the "-2", "-3", ... attempts when deriving a slug
+const maxGuildSlugSuffix = 20
+
 // CreateGuildRequest represents a request to create a guild
 type CreateGuildRequest struct {
 	Name        string
+	Slug        string // Optional; derived from the name when empty
 	Description string
 	Icon        string
 	Color       string
 	Visibility  string
+	// Create it even if it looks like one of the user's guilds
+	ConfirmDuplicate bool `json:"confirm_duplicate"`
 }
 
 // CreateGuild creates a new guild with the given user as the initial admin member
@@ -90,6 +123,10 @@ func (s *GuildService) CreateGuild(ctx context.Context, userID string, req Creat
 		return nil, ErrGuildDescTooLong
 	}
 
+	if req.Slug != "" && len(model.ValidateGuildSlug(req.Slug)) > 0 {
+		return nil, ErrInvalidGuildSlug
+	}
+
 	// Check user hasn't exceeded max guilds
 	count, err := s.guildRepo.CountGuildsForUser(ctx, userID)
 	if err != nil {
@@ -99,6 +136,12 @@ func (s *GuildService) CreateGuild(ctx context.Context, userID string, req Creat
 		return nil, ErrMaxGuildsReached
 	}
 
+	if !req.ConfirmDuplicate {
+		if err := s.checkDuplicateGuild(ctx, userID, name); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get user for member creation
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
@@ -114,31 +157,72 @@ func (s *GuildService) CreateGuild(ctx context.Context, userID string, req Creat
 		visibility = model.GuildVisibilityPrivate
 	}
 
+	slug, err := s.pickSlug(ctx, name, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create guild
 	guild := &model.Guild{
 		Name:        name,
+		Slug:        slug,
 		Description: req.Description,
 		Icon:        req.Icon,
 		Color:       req.Color,
 		Visibility:  visibility,
 	}
 
-	if err := s.guildRepo.Create(ctx, guild); err != nil {
-		if errors.Is(err, database.ErrDuplicate) {
-			return nil, ErrGuildNameExists
+	// A guild without its admin can't be managed or deleted by anyone, so both
+	// are created in one transaction, or the guild is removed again if the
+	// creator can't be added
+	err = runOperation(ctx, s.tx, "create guild", func(ctx context.Context, op *operation) error {
+		err := op.step(ctx, "create guild",
+			func(ctx context.Context) error {
+				if err := s.guildRepo.Create(ctx, guild); err != nil {
+					if errors.Is(err, database.ErrDuplicate) {
+						return ErrGuildSlugTaken
+					}
+					return fmt.Errorf("creating guild: %w", err)
+				}
+				return nil
+			},
+			func(ctx context.Context) error {
+				return s.guildRepo.Delete(ctx, guild.ID)
+			},
+		)
+		if err != nil {
+			return err
 		}
-		return nil, fmt.Errorf("creating guild: %w", err)
-	}
 
-	// Get or create member for user
-	member, err := s.memberRepo.GetOrCreate(ctx, userID, user.Email, user.Email)
-	if err != nil {
-		return nil, fmt.Errorf("getting/creating member: %w", err)
-	}
+		// Get or create member for user
+		var member *model.Member
+		err = op.step(ctx, "get member",
+			func(ctx context.Context) (err error) {
+				member, err = s.memberRepo.GetOrCreate(ctx, userID, user.Email, user.Email)
+				if err != nil {
+					return fmt.Errorf("getting/creating member: %w", err)
+				}
+				return nil
+			},
+			nil, // The member record is shared with the user's other guilds
+		)
+		if err != nil {
+			return err
+		}
 
-	// Add member to guild as admin (not pending approval since they're the creator)
-	if err := s.guildRepo.AddMemberWithRole(ctx, member.ID, guild.ID, model.GuildRoleAdmin, false); err != nil {
-		return nil, fmt.Errorf("adding member to guild: %w", err)
+		// Add member to guild as admin (not pending approval since they're the creator)
+		return op.step(ctx, "add admin",
+			func(ctx context.Context) error {
+				if err := s.guildRepo.AddMemberWithRole(ctx, member.ID, guild.ID, model.GuildRoleAdmin, false); err != nil {
+					return fmt.Errorf("adding member to guild: %w", err)
+				}
+				return nil
+			},
+			nil,
+		)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return guild, nil
@@ -191,6 +275,23 @@ func (s *GuildService) GetGuildWithMembers(ctx context.Context, userID, guildID
 	}, nil
 }
 
+// ListMembers retrieves a page of a guild's members, in the order they
+// joined. Private guilds list their members only to members.
+func (s *GuildService) ListMembers(ctx context.Context, userID, guildID string, page model.PageRequest) (*model.Page[*model.Member], error) {
+	if _, err := s.GetGuild(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	if page.Limit <= 0 || page.Limit > model.MaxPageSize {
+		page.Limit = model.DefaultPageSize
+	}
+	members, err := s.guildRepo.GetMembersPage(ctx, guildID, page)
+	if err != nil {
+		return nil, fmt.Errorf("getting members: %w", err)
+	}
+	return members, nil
+}
+
 // ListUserGuilds lists all guilds a user is a member of
 func (s *GuildService) ListUserGuilds(ctx context.Context, userID string) ([]*model.Guild, error) {
 	guilds, err := s.guildRepo.GetGuildsForUser(ctx, userID)
@@ -202,14 +303,20 @@ func (s *GuildService) ListUserGuilds(ctx context.Context, userID string) ([]*mo
 
 // UpdateGuildRequest represents a request to update a guild
 type UpdateGuildRequest struct {
-	Name        *string
-	Description *string
-	Icon        *string
-	Color       *string
-	Visibility  *string
+	Name         *string
+	Slug         *string // Changing the slug requires guild admin
+	Description  *string
+	Icon         *string
+	Color        *string
+	Visibility   *string
+	EmbedEnabled *bool `json:"embed_enabled"` // Turning event embeds on or off requires guild admin
+	Version      *int  // Version the edit is based on; stale versions conflict
+
+	Clear model.ClearedFields `json:"-"` // Fields the PATCH set to null
 }
 
-// UpdateGuild updates a guild (requires membership)
+// UpdateGuild updates a guild (requires membership). With a version, the
+// update is rejected with a VersionConflictError if the guild has changed.
 func (s *GuildService) UpdateGuild(ctx context.Context, userID, guildID string, req UpdateGuildRequest) (*model.Guild, error) {
 	// Verify membership
 	isMember, err := s.guildRepo.IsMember(ctx, userID, guildID)
@@ -228,6 +335,9 @@ func (s *GuildService) UpdateGuild(ctx context.Context, userID, guildID string,
 	if guild == nil {
 		return nil, ErrGuildNotFound
 	}
+	if req.Version != nil && *req.Version != guild.Version {
+		return nil, &VersionConflictError{Current: guild}
+	}
 
 	// Apply updates
 	if req.Name != nil {
@@ -260,15 +370,220 @@ func (s *GuildService) UpdateGuild(ctx context.Context, userID, guildID string,
 		guild.Visibility = *req.Visibility
 	}
 
-	if err := s.guildRepo.Update(ctx, guild); err != nil {
+	if req.EmbedEnabled != nil && *req.EmbedEnabled != guild.EmbedEnabled {
+		if err := s.RequireGuildAdmin(ctx, userID, guild.ID); err != nil {
+			return nil, err
+		}
+		guild.EmbedEnabled = *req.EmbedEnabled
+	}
+
+	// Cleared fields are stored as NONE
+	if req.Clear.Has("description") {
+		guild.Description = ""
+	}
+	if req.Clear.Has("icon") {
+		guild.Icon = ""
+	}
+	if req.Clear.Has("color") {
+		guild.Color = ""
+	}
+
+	// Change the slug first so a conflict leaves the guild untouched
+	if req.Slug != nil && *req.Slug != guild.Slug && s.slugRepo != nil {
+		if err := s.changeSlug(ctx, userID, guild, *req.Slug); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.guildRepo.Update(ctx, guild, req.Version)
+	if err != nil {
 		return nil, fmt.Errorf("updating guild: %w", err)
 	}
+	if updated == nil {
+		// Lost a race with another edit since the version check above
+		current, err := s.guildRepo.GetByID(ctx, guildID)
+		if err != nil {
+			return nil, fmt.Errorf("getting guild: %w", err)
+		}
+		if current == nil {
+			return nil, ErrGuildNotFound
+		}
+		return nil, &VersionConflictError{Current: current}
+	}
 
-	return guild, nil
+	return updated, nil
+}
+
+// changeSlug validates and applies a new slug for the guild. The old slug keeps
+// redirecting to the guild.
+func (s *GuildService) changeSlug(ctx context.Context, userID string, guild *model.Guild, slug string) error {
+	if len(model.ValidateGuildSlug(slug)) > 0 {
+		return ErrInvalidGuildSlug
+	}
+	if err := s.RequireGuildAdmin(ctx, userID, guild.ID); err != nil {
+		return err
+	}
+
+	available, err := s.slugAvailable(ctx, guild.ID, slug)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return ErrGuildSlugTaken
+	}
+
+	if err := s.slugRepo.ChangeSlug(ctx, guild.ID, guild.Slug, slug); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return ErrGuildSlugTaken
+		}
+		return fmt.Errorf("changing slug: %w", err)
+	}
+	guild.Slug = slug
+	return nil
+}
+
+// checkDuplicateGuild returns a DuplicateError when the user already belongs
+// to a guild with a similar name. Guilds have no place or schedule, so the
+// name is all there is to compare.
+func (s *GuildService) checkDuplicateGuild(ctx context.Context, userID, name string) error {
+	guilds, err := s.guildRepo.GetGuildsForUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("getting guilds: %w", err)
+	}
+
+	var candidates []model.DuplicateCandidate
+	for _, guild := range guilds {
+		similarity := model.NameSimilarity(name, guild.Name)
+		if similarity < model.DuplicateNameThreshold {
+			continue
+		}
+		candidates = append(candidates, model.DuplicateCandidate{
+			Kind:       model.DuplicateGuild,
+			ID:         guild.ID,
+			Name:       guild.Name,
+			Similarity: similarity,
+		})
+	}
+	if len(candidates) > 0 {
+		return &DuplicateError{Candidates: model.SortDuplicateCandidates(candidates)}
+	}
+	return nil
+}
+
+// pickSlug returns the requested slug when it is free, or derives one from the
+// name, adding a numeric suffix until it is free. Returns an empty slug when
+// slugs are not configured or no derived slug is free.
+func (s *GuildService) pickSlug(ctx context.Context, name, requested string) (string, error) {
+	if s.slugRepo == nil {
+		return "", nil
+	}
+
+	if requested != "" {
+		available, err := s.slugAvailable(ctx, "", requested)
+		if err != nil {
+			return "", err
+		}
+		if !available {
+			return "", ErrGuildSlugTaken
+		}
+		return requested, nil
+	}
+
+	base := model.SlugifyGuildName(name)
+	if len(model.ValidateGuildSlug(base)) > 0 {
+		base = strings.TrimPrefix(base+"-guild", "-")
+	}
+	// Leave room for the numeric suffix
+	if len(base) > model.MaxGuildSlugLength-3 {
+		base = strings.TrimSuffix(base[:model.MaxGuildSlugLength-3], "-")
+	}
+
+	for i := 1; i <= maxGuildSlugSuffix; i++ {
+		candidate := base
+		if i > 1 {
+			candidate = fmt.Sprintf("%s-%d", base, i)
+		}
+		available, err := s.slugAvailable(ctx, "", candidate)
+		if err != nil {
+			return "", err
+		}
+		if available {
+			return candidate, nil
+		}
+	}
+	return "", nil
+}
+
+// slugAvailable reports whether a slug is free for the guild: not the current
+// slug of another guild, and not another guild's released slug still inside
+// its reservation period
+func (s *GuildService) slugAvailable(ctx context.Context, guildID, slug string) (bool, error) {
+	holder, err := s.slugRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return false, fmt.Errorf("checking slug: %w", err)
+	}
+	if holder != nil && holder.ID != guildID {
+		return false, nil
+	}
+
+	redirect, err := s.slugRepo.GetSlugRedirect(ctx, slug)
+	if err != nil {
+		return false, fmt.Errorf("checking slug history: %w", err)
+	}
+	if redirect != nil && redirect.GuildID != guildID &&
+		s.clock.Now().Before(redirect.ReleasedOn.Add(model.GuildSlugReservationPeriod)) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// ResolveSlug returns the ID of the guild a slug refers to. Current slugs win;
+// otherwise a released slug resolves to the guild that last used it.
+func (s *GuildService) ResolveSlug(ctx context.Context, slug string) (string, error) {
+	if s.slugRepo == nil {
+		return "", ErrGuildNotFound
+	}
+
+	guild, err := s.slugRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return "", fmt.Errorf("getting guild by slug: %w", err)
+	}
+	if guild != nil {
+		return guild.ID, nil
+	}
+
+	redirect, err := s.slugRepo.GetSlugRedirect(ctx, slug)
+	if err != nil {
+		return "", fmt.Errorf("getting slug redirect: %w", err)
+	}
+	if redirect == nil {
+		return "", ErrGuildNotFound
+	}
+	return redirect.GuildID, nil
+}
+
+// GetGuildBySlug retrieves a guild and its members by current or previous slug,
+// checking membership for private guilds
+func (s *GuildService) GetGuildBySlug(ctx context.Context, userID, slug string) (*model.GuildData, error) {
+	guildID, err := s.ResolveSlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGuildWithMembers(ctx, userID, guildID)
 }
 
 // JoinGuild allows a user to join a public guild
 func (s *GuildService) JoinGuild(ctx context.Context, userID, guildID string) error {
+	return s.joinGuild(ctx, userID, guildID, false)
+}
+
+// JoinGuildByInvite adds a user who redeemed an invite link. Unlike JoinGuild,
+// members of private guilds join without waiting for approval.
+func (s *GuildService) JoinGuildByInvite(ctx context.Context, userID, guildID string) error {
+	return s.joinGuild(ctx, userID, guildID, true)
+}
+
+func (s *GuildService) joinGuild(ctx context.Context, userID, guildID string, invited bool) error {
 	// Get guild
 	guild, err := s.guildRepo.GetByID(ctx, guildID)
 	if err != nil {
@@ -320,52 +635,81 @@ func (s *GuildService) JoinGuild(ctx context.Context, userID, guildID string) er
 		return fmt.Errorf("getting/creating member: %w", err)
 	}
 
-	// For private guilds, require pending approval
-	pendingApproval := guild.Visibility == model.GuildVisibilityPrivate
+	// For private guilds, require pending approval unless invited
+	pendingApproval := guild.Visibility == model.GuildVisibilityPrivate && !invited
 
 	// Add member to guild
 	if err := s.guildRepo.AddMember(ctx, member.ID, guildID, pendingApproval); err != nil {
 		return fmt.Errorf("adding member: %w", err)
 	}
 
+	if !pendingApproval {
+		recordActivity(ctx, s.activity, &model.ActivityEntry{
+			UserID:      userID,
+			Type:        model.ActivityGuildJoined,
+			SubjectType: model.ActivitySubjectGuild,
+			SubjectID:   guildID,
+			Data:        map[string]string{"guild_name": guild.Name},
+		})
+		publishDomainEvents(ctx, s.events, model.MemberJoined{
+			UserID:    userID,
+			GuildID:   guildID,
+			GuildName: guild.Name,
+		})
+	}
+
 	return nil
 }
 
-// LeaveGuild removes a user from a guild
-func (s *GuildService) LeaveGuild(ctx context.Context, userID, guildID string) error {
+// LeaveGuild removes a user from a guild. The returned operation, if any,
+// rejoins the guild with the same role.
+func (s *GuildService) LeaveGuild(ctx context.Context, userID, guildID string) (*model.UndoOperation, error) {
 	// Check membership
 	isMember, err := s.guildRepo.IsMember(ctx, userID, guildID)
 	if err != nil {
-		return fmt.Errorf("checking membership: %w", err)
+		return nil, fmt.Errorf("checking membership: %w", err)
 	}
 	if !isMember {
-		return ErrNotGuildMember
+		return nil, ErrNotGuildMember
 	}
 
 	// Check if sole member
 	memberCount, err := s.guildRepo.CountMembers(ctx, guildID)
 	if err != nil {
-		return fmt.Errorf("counting members: %w", err)
+		return nil, fmt.Errorf("counting members: %w", err)
 	}
 	if memberCount <= 1 {
-		return ErrCannotLeaveSoleMember
+		return nil, ErrCannotLeaveSoleMember
 	}
 
 	// Get member record
 	member, err := s.memberRepo.GetByUserID(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("getting member: %w", err)
+		return nil, fmt.Errorf("getting member: %w", err)
 	}
 	if member == nil {
-		return ErrNotGuildMember
+		return nil, ErrNotGuildMember
 	}
 
 	// Remove from guild
-	if err := s.guildRepo.RemoveMember(ctx, member.ID, guildID); err != nil {
-		return fmt.Errorf("removing member: %w", err)
-	}
-
-	return nil
+	membership, err := s.guildRepo.RemoveMember(ctx, member.ID, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("removing member: %w", err)
+	}
+	if membership == nil {
+		return nil, nil
+	}
+
+	return recordUndo(ctx, s.undo, &model.UndoOperation{
+		UserID:     userID,
+		Type:       model.UndoGuildLeave,
+		ResourceID: guildID,
+		Data: map[string]interface{}{
+			"member_id":        membership.MemberID,
+			"role":             string(membership.Role),
+			"pending_approval": membership.PendingApproval,
+		},
+	}), nil
 }
 
 // DeleteGuild deletes a guild (only allowed if sole member)
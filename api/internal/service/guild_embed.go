@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildEmbedGuilds finds the guild an embed shows
+type GuildEmbedGuilds interface {
+	ResolveSlug(ctx context.Context, slug string) (string, error)
+}
+
+// GuildEmbedEvents lists the guild's events
+type GuildEmbedEvents interface {
+	GetGuildEvents(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error)
+}
+
+// GuildEmbedService serves a guild's upcoming events to other sites, for
+// organizers who embed them on their own website. Guilds opt in with
+// embed_enabled; only published events with public visibility are shown,
+// and only what a visitor to the event page could already see.
+type GuildEmbedService struct {
+	guilds    GuildEmbedGuilds
+	guildRepo GuildRepository
+	events    GuildEmbedEvents
+	clock     clock.Clock
+}
+
+// GuildEmbedServiceConfig holds configuration for the guild embed service
+type GuildEmbedServiceConfig struct {
+	Guilds    GuildEmbedGuilds
+	GuildRepo GuildRepository
+	Events    GuildEmbedEvents
+
+	// Clock decides which events are upcoming; nil is the system clock
+	Clock clock.Clock
+}
+
+// NewGuildEmbedService creates a new guild embed service
+func NewGuildEmbedService(cfg GuildEmbedServiceConfig) *GuildEmbedService {
+	return &GuildEmbedService{
+		guilds:    cfg.Guilds,
+		guildRepo: cfg.GuildRepo,
+		events:    cfg.Events,
+		clock:     clock.OrReal(cfg.Clock),
+	}
+}
+
+// GetUpcomingEvents returns the next public events of the guild a slug
+// refers to, soonest first. Guilds that haven't enabled embeds are
+// ErrGuildNotFound, so they can't be told apart from guilds that don't
+// exist.
+func (s *GuildEmbedService) GetUpcomingEvents(ctx context.Context, slug string, limit int) (*model.GuildEventsEmbed, error) {
+	if limit <= 0 {
+		limit = model.DefaultGuildEmbedEventsLimit
+	}
+	if limit > model.MaxGuildEmbedEventsLimit {
+		limit = model.MaxGuildEmbedEventsLimit
+	}
+
+	guildID, err := s.guilds.ResolveSlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	guild, err := s.guildRepo.GetByID(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("getting guild: %w", err)
+	}
+	if guild == nil || !guild.EmbedEnabled {
+		return nil, ErrGuildNotFound
+	}
+
+	now := s.clock.Now()
+	events, err := s.events.GetGuildEvents(ctx, guild.ID, &model.EventSearchFilters{StartAfter: &now}, model.ListQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("getting guild events: %w", err)
+	}
+
+	embed := &model.GuildEventsEmbed{
+		Guild:  model.EmbedGuild{Name: guild.Name, Slug: guild.Slug},
+		Events: make([]model.EmbedEvent, 0, limit),
+	}
+	for _, event := range events {
+		if len(embed.Events) == limit {
+			break
+		}
+		if event.Visibility != model.EventVisibilityPublic || event.Status != model.EventStatusPublished {
+			continue
+		}
+		embed.Events = append(embed.Events, embedEvent(event))
+	}
+	return embed, nil
+}
+
+func embedEvent(event *model.Event) model.EmbedEvent {
+	embedded := model.EmbedEvent{
+		ID:        event.ID,
+		Title:     event.Title,
+		StartTime: event.StartTime,
+		EndTime:   event.EndTime,
+	}
+	if loc := event.Location; loc != nil {
+		embedded.Location = &model.EmbedLocation{
+			Name:      loc.Name,
+			City:      loc.City,
+			IsVirtual: loc.IsVirtual,
+		}
+	}
+	return embedded
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// newGuildEmbedTestService serves the hikers guild with the given events
+func newGuildEmbedTestService(embedEnabled bool, events []*model.Event) (*GuildEmbedService, *fakeclock.Clock) {
+	clk := fakeclock.New(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	guildRepo := &mocks.GuildRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Guild, error) {
+			return &model.Guild{ID: id, Name: "Hikers", Slug: "hikers", EmbedEnabled: embedEnabled}, nil
+		},
+	}
+	svc := NewGuildEmbedService(GuildEmbedServiceConfig{
+		Guilds:    newMemoryGuilds(map[string]string{"hikers": "guild:1"}),
+		GuildRepo: guildRepo,
+		Events:    &memoryGuildEvents{events: events},
+		Clock:     clk,
+	})
+	return svc, clk
+}
+
+func embedTestEvent(id, visibility, status string, start time.Time) *model.Event {
+	guildID := "guild:1"
+	address := "1 Park Rd"
+	return &model.Event{
+		ID:         id,
+		GuildID:    &guildID,
+		Title:      "Hike " + id,
+		StartTime:  start,
+		Visibility: visibility,
+		Status:     status,
+		Location:   &model.EventLocation{Name: "Trailhead", Address: &address, City: "Boulder"},
+	}
+}
+
+// ============================================================================
+// GetUpcomingEvents Tests
+// ============================================================================
+
+func TestGuildEmbed_ShowsUpcomingPublicEvents(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	svc, _ := newGuildEmbedTestService(true, []*model.Event{
+		embedTestEvent("past", model.EventVisibilityPublic, model.EventStatusPublished, now.Add(-time.Hour)),
+		embedTestEvent("next", model.EventVisibilityPublic, model.EventStatusPublished, now.Add(time.Hour)),
+		embedTestEvent("members", model.EventVisibilityGuilds, model.EventStatusPublished, now.Add(2*time.Hour)),
+		embedTestEvent("cancelled", model.EventVisibilityPublic, model.EventStatusCancelled, now.Add(3*time.Hour)),
+		embedTestEvent("later", model.EventVisibilityPublic, model.EventStatusPublished, now.Add(4*time.Hour)),
+	})
+
+	embed, err := svc.GetUpcomingEvents(context.Background(), "hikers", 0)
+	if err != nil {
+		t.Fatalf("GetUpcomingEvents failed: %v", err)
+	}
+	if embed.Guild != (model.EmbedGuild{Name: "Hikers", Slug: "hikers"}) {
+		t.Errorf("unexpected guild %+v", embed.Guild)
+	}
+	if len(embed.Events) != 2 || embed.Events[0].ID != "next" || embed.Events[1].ID != "later" {
+		t.Fatalf("expected the upcoming public events, got %+v", embed.Events)
+	}
+	if loc := embed.Events[0].Location; loc == nil || loc.Name != "Trailhead" || loc.City != "Boulder" {
+		t.Errorf("expected the venue name and city, got %+v", loc)
+	}
+
+	limited, err := svc.GetUpcomingEvents(context.Background(), "hikers", 1)
+	if err != nil {
+		t.Fatalf("GetUpcomingEvents failed: %v", err)
+	}
+	if len(limited.Events) != 1 || limited.Events[0].ID != "next" {
+		t.Errorf("expected only the next event, got %+v", limited.Events)
+	}
+}
+
+func TestGuildEmbed_RequiresOptIn(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newGuildEmbedTestService(false, nil)
+	if _, err := svc.GetUpcomingEvents(context.Background(), "hikers", 0); !errors.Is(err, ErrGuildNotFound) {
+		t.Errorf("expected ErrGuildNotFound for a guild without embeds, got %v", err)
+	}
+	if _, err := svc.GetUpcomingEvents(context.Background(), "chess", 0); !errors.Is(err, ErrGuildNotFound) {
+		t.Errorf("expected ErrGuildNotFound for an unknown slug, got %v", err)
+	}
+}
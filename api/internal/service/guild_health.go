@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// guildHealthDayLayout formats the UTC day a snapshot is for
+const guildHealthDayLayout = "2006-01-02"
+
+// GuildHealthRepository defines the interface for guild health storage
+type GuildHealthRepository interface {
+	ListGuildIDs(ctx context.Context) ([]string, error)
+	GetMetrics(ctx context.Context, guildID string, since, until, joinedSince time.Time) (*model.GuildHealthMetrics, error)
+	GetOrganizers(ctx context.Context, guildID string) ([]string, error)
+	Save(ctx context.Context, health *model.GuildHealth) error
+	GetOnOrBefore(ctx context.Context, guildID, day string) (*model.GuildHealth, error)
+	ListRecent(ctx context.Context, guildID string, limit int) ([]*model.GuildHealth, error)
+	LastAlertedOn(ctx context.Context, guildID string) (*time.Time, error)
+	MarkAlerted(ctx context.Context, guildID, day string, alertedOn time.Time) error
+}
+
+// GuildHealthService scores each guild's health daily from its event
+// frequency, RSVP rate, member churn and pool participation, and alerts
+// organizers with suggested actions when the score drops sharply
+type GuildHealthService struct {
+	repo        GuildHealthRepository
+	eventHub    *EventHub
+	pushService *PushService
+	outbox      NotificationQueue
+	clock       clock.Clock
+}
+
+// GuildHealthServiceConfig holds configuration for the guild health service
+type GuildHealthServiceConfig struct {
+	Repo        GuildHealthRepository
+	EventHub    *EventHub
+	PushService *PushService
+	Outbox      NotificationQueue // Optional; nil sends alerts inline
+	Clock       clock.Clock       // Default: the system clock
+}
+
+// NewGuildHealthService creates a new guild health service
+func NewGuildHealthService(cfg GuildHealthServiceConfig) *GuildHealthService {
+	return &GuildHealthService{
+		repo:        cfg.Repo,
+		eventHub:    cfg.EventHub,
+		pushService: cfg.PushService,
+		outbox:      cfg.Outbox,
+		clock:       clock.OrReal(cfg.Clock),
+	}
+}
+
+// ProcessGuildHealth scores every guild for today and alerts organizers of
+// guilds whose score dropped sharply. This should be called periodically by
+// a background job; rescoring a guild on the same day replaces its snapshot.
+func (s *GuildHealthService) ProcessGuildHealth(ctx context.Context) error {
+	guildIDs, err := s.repo.ListGuildIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list guilds: %w", err)
+	}
+
+	for _, guildID := range guildIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		health, err := s.Score(ctx, guildID)
+		if err != nil {
+			log.Printf("[GuildHealthService] Failed to score guild %s: %v", guildID, err)
+			continue
+		}
+		s.alertIfDropped(ctx, health)
+	}
+	return nil
+}
+
+// Report returns a guild's latest health with its suggested actions, and its
+// recent daily scores. A guild that hasn't been scored yet is scored now.
+func (s *GuildHealthService) Report(ctx context.Context, guildID string) (*model.GuildHealthReport, error) {
+	recent, err := s.repo.ListRecent(ctx, guildID, model.GuildHealthHistoryDays)
+	if err != nil {
+		return nil, err
+	}
+
+	var current *model.GuildHealth
+	if len(recent) > 0 {
+		current = recent[0]
+		current.SuggestedActions = suggestGuildHealthActions(current)
+	} else {
+		current, err = s.Score(ctx, guildID)
+		if err != nil {
+			return nil, err
+		}
+		recent = []*model.GuildHealth{current}
+	}
+
+	history := make([]model.GuildHealthPoint, 0, len(recent))
+	for _, snapshot := range recent {
+		history = append(history, model.GuildHealthPoint{Day: snapshot.Day, Score: snapshot.Score})
+	}
+	return &model.GuildHealthReport{Current: current, History: history}, nil
+}
+
+// Score computes and saves a guild's health for today. Member churn is
+// measured against the latest snapshot a full window old, and the score is
+// compared with the latest one a drop window old.
+func (s *GuildHealthService) Score(ctx context.Context, guildID string) (*model.GuildHealth, error) {
+	now := s.clock.Now().UTC()
+	since := now.Add(-model.GuildHealthWindow)
+
+	baseline, err := s.repo.GetOnOrBefore(ctx, guildID, since.Format(guildHealthDayLayout))
+	if err != nil {
+		return nil, err
+	}
+	joinedSince := since
+	if baseline != nil {
+		joinedSince = baseline.ComputedOn
+	}
+
+	metrics, err := s.repo.GetMetrics(ctx, guildID, since, now, joinedSince)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := s.repo.GetOnOrBefore(ctx, guildID, now.Add(-model.GuildHealthDropWindow).Format(guildHealthDayLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	health := buildGuildHealth(metrics, baseline, now)
+	health.GuildID = guildID
+	health.Day = now.Format(guildHealthDayLayout)
+	if previous != nil {
+		score := previous.Score
+		health.PreviousScore = &score
+	}
+	health.AtRisk = health.Score < model.GuildHealthAtRiskScore || health.DroppedSharply()
+	health.SuggestedActions = suggestGuildHealthActions(health)
+
+	if err := s.repo.Save(ctx, health); err != nil {
+		return nil, err
+	}
+	return health, nil
+}
+
+// alertIfDropped alerts the guild's organizers when its score dropped
+// sharply, at most once per drop window
+func (s *GuildHealthService) alertIfDropped(ctx context.Context, health *model.GuildHealth) {
+	if !health.DroppedSharply() {
+		return
+	}
+
+	now := s.clock.Now()
+	lastAlerted, err := s.repo.LastAlertedOn(ctx, health.GuildID)
+	if err != nil {
+		log.Printf("[GuildHealthService] Failed to check last alert for guild %s: %v", health.GuildID, err)
+		return
+	}
+	if lastAlerted != nil && now.Sub(*lastAlerted) < model.GuildHealthDropWindow {
+		return
+	}
+
+	organizers, err := s.repo.GetOrganizers(ctx, health.GuildID)
+	if err != nil {
+		log.Printf("[GuildHealthService] Failed to get organizers for guild %s: %v", health.GuildID, err)
+		return
+	}
+	// Record the alert before sending so that concurrent runs cannot double-send
+	if err := s.repo.MarkAlerted(ctx, health.GuildID, health.Day, now); err != nil {
+		log.Printf("[GuildHealthService] Failed to record alert for guild %s: %v", health.GuildID, err)
+		return
+	}
+
+	title := "Your guild could use some attention"
+	message := fmt.Sprintf("Its health score fell from %d to %d this week", *health.PreviousScore, health.Score)
+	if len(health.SuggestedActions) > 0 {
+		message += ". " + health.SuggestedActions[0].Message
+	}
+
+	for _, userID := range organizers {
+		sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, &OutboxNotification{
+			UserID: userID,
+			Push: &PushNotification{
+				Title: title,
+				Body:  message,
+				Data: map[string]string{
+					"kind":     "guild_health",
+					"guild_id": health.GuildID,
+				},
+			},
+			Event: &Event{
+				Type: EventGuildHealthAlert,
+				Data: map[string]interface{}{
+					"kind":              "guild_health",
+					"guild_id":          health.GuildID,
+					"score":             health.Score,
+					"previous_score":    *health.PreviousScore,
+					"suggested_actions": health.SuggestedActions,
+					"title":             title,
+					"message":           message,
+				},
+			},
+		})
+	}
+}
+
+// buildGuildHealth scores the metrics. Each component is the share of its
+// target reached, capped at 1; churn counts the members of the baseline
+// snapshot who have since left, and is left out when there is no baseline.
+func buildGuildHealth(metrics *model.GuildHealthMetrics, baseline *model.GuildHealth, now time.Time) *model.GuildHealth {
+	components := model.GuildHealthComponents{
+		EventFrequency: capRatio(float64(metrics.Events) / model.GuildHealthTargetEvents),
+	}
+	if metrics.Members > 0 {
+		if metrics.Events > 0 {
+			rsvpRate := float64(metrics.ApprovedRSVPs) / float64(metrics.Events*metrics.Members)
+			components.RSVPRate = capRatio(rsvpRate / model.GuildHealthTargetRSVPRate)
+		}
+		participation := float64(metrics.PoolParticipants) / float64(metrics.Members)
+		components.PoolParticipation = capRatio(participation / model.GuildHealthTargetPoolParticipation)
+	}
+
+	var churnRate *float64
+	if baseline != nil && baseline.Metrics.Members > 0 {
+		left := baseline.Metrics.Members + metrics.Joined - metrics.Members
+		if left < 0 {
+			left = 0
+		}
+		rate := roundRatio(float64(left) / float64(baseline.Metrics.Members))
+		churn := roundRatio(1 - capRatio(rate/model.GuildHealthMaxChurnRate))
+		churnRate = &rate
+		components.MemberChurn = &churn
+	}
+
+	weighted := components.EventFrequency*model.GuildHealthWeightEvents +
+		components.RSVPRate*model.GuildHealthWeightRSVPs +
+		components.PoolParticipation*model.GuildHealthWeightPools
+	total := float64(model.GuildHealthWeightEvents + model.GuildHealthWeightRSVPs + model.GuildHealthWeightPools)
+	if components.MemberChurn != nil {
+		weighted += *components.MemberChurn * model.GuildHealthWeightChurn
+		total += model.GuildHealthWeightChurn
+	}
+
+	components.EventFrequency = roundRatio(components.EventFrequency)
+	components.RSVPRate = roundRatio(components.RSVPRate)
+	components.PoolParticipation = roundRatio(components.PoolParticipation)
+
+	return &model.GuildHealth{
+		Score:      int(math.Round(weighted / total * 100)),
+		Metrics:    *metrics,
+		ChurnRate:  churnRate,
+		Components: components,
+		ComputedOn: now,
+	}
+}
+
+// suggestGuildHealthActions suggests what organizers can do about each weak
+// component, in the order the components are weighted
+func suggestGuildHealthActions(health *model.GuildHealth) []model.GuildHealthSuggestion {
+	const weak = 0.5
+	c, m := health.Components, health.Metrics
+	suggestions := make([]model.GuildHealthSuggestion, 0)
+
+	if c.EventFrequency < weak {
+		message := "No events in the last 4 weeks. Schedule one so members have something to show up to."
+		if m.Events > 0 {
+			message = fmt.Sprintf("Only %d %s in the last 4 weeks. Schedule another so members have something to show up to.",
+				m.Events, pluralizeEvents(m.Events))
+		}
+		suggestions = append(suggestions, model.GuildHealthSuggestion{Action: model.GuildHealthActionScheduleEvent, Message: message})
+	}
+	if m.Events > 0 && c.RSVPRate < weak {
+		suggestions = append(suggestions, model.GuildHealthSuggestion{
+			Action: model.GuildHealthActionPromoteEvents,
+			Message: fmt.Sprintf("About %d%% of members RSVP to each event. Announce upcoming events or try a different format.",
+				int(math.Round(c.RSVPRate*model.GuildHealthTargetRSVPRate*100))),
+		})
+	}
+	if c.MemberChurn != nil && *c.MemberChurn < weak && health.ChurnRate != nil {
+		suggestions = append(suggestions, model.GuildHealthSuggestion{
+			Action: model.GuildHealthActionReengageMembers,
+			Message: fmt.Sprintf("%d%% of members left in the last 4 weeks. Reach out to quiet members and welcome new ones.",
+				int(math.Round(*health.ChurnRate*100))),
+		})
+	}
+	if c.PoolParticipation < weak {
+		message := "Start a matching pool so members get introduced to each other."
+		if m.ActivePools > 0 {
+			message = "Few members are in a matching pool. Run a matching round and invite members to join."
+		}
+		suggestions = append(suggestions, model.GuildHealthSuggestion{Action: model.GuildHealthActionRunMatching, Message: message})
+	}
+
+	return suggestions
+}
+
+// capRatio limits a ratio to [0, 1]
+func capRatio(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+// roundRatio rounds a ratio to three decimal places
+func roundRatio(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
+
+func pluralizeEvents(n int) string {
+	if n == 1 {
+		return "event"
+	}
+	return "events"
+}
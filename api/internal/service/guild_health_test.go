@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+func TestBuildGuildHealth(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	thriving := &model.GuildHealthMetrics{Members: 40, Events: 4, ApprovedRSVPs: 40, ActivePools: 1, PoolParticipants: 12}
+
+	tests := []struct {
+		name      string
+		metrics   *model.GuildHealthMetrics
+		baseline  *model.GuildHealth
+		wantScore int
+		wantChurn *float64
+	}{
+		{
+			name:      "thriving without a baseline leaves churn out",
+			metrics:   thriving,
+			wantScore: 100,
+		},
+		{
+			name:      "no activity",
+			metrics:   &model.GuildHealthMetrics{Members: 40},
+			wantScore: 0,
+		},
+		{
+			name:      "half the target events and RSVPs",
+			metrics:   &model.GuildHealthMetrics{Members: 40, Events: 2, ApprovedRSVPs: 10, PoolParticipants: 6},
+			wantScore: 50,
+		},
+		{
+			// 50 members a window ago, 5 joined since and 40 remain: 15 left
+			name:      "churn counts departures since the baseline",
+			metrics:   &model.GuildHealthMetrics{Members: 40, Joined: 5, Events: 4, ApprovedRSVPs: 40, PoolParticipants: 12},
+			baseline:  &model.GuildHealth{Metrics: model.GuildHealthMetrics{Members: 50}},
+			wantScore: 80,
+			wantChurn: ptrFloat(0.3),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			health := buildGuildHealth(tt.metrics, tt.baseline, now)
+			if health.Score != tt.wantScore {
+				t.Errorf("Score = %d, want %d (components %+v)", health.Score, tt.wantScore, health.Components)
+			}
+			if (health.ChurnRate == nil) != (tt.wantChurn == nil) ||
+				(tt.wantChurn != nil && *health.ChurnRate != *tt.wantChurn) {
+				t.Errorf("ChurnRate = %v, want %v", health.ChurnRate, tt.wantChurn)
+			}
+		})
+	}
+}
+
+func TestSuggestGuildHealthActions(t *testing.T) {
+	t.Parallel()
+	health := buildGuildHealth(&model.GuildHealthMetrics{Members: 20, Events: 1, ApprovedRSVPs: 10}, nil, time.Now())
+
+	var got []model.GuildHealthAction
+	for _, suggestion := range suggestGuildHealthActions(health) {
+		got = append(got, suggestion.Action)
+	}
+
+	want := []model.GuildHealthAction{model.GuildHealthActionScheduleEvent, model.GuildHealthActionRunMatching}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("suggested actions = %v, want %v", got, want)
+	}
+}
+
+func TestGuildHealthService_ProcessGuildHealth_AlertsOrganizersOnSharpDrop(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	clk := fakeclock.New(time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC))
+
+	var mu sync.Mutex
+	var alertedOn *time.Time
+	repo := &mocks.GuildHealthRepository{
+		ListGuildIDsFunc: func(ctx context.Context) ([]string, error) {
+			return []string{"guild:1"}, nil
+		},
+		GetMetricsFunc: func(ctx context.Context, guildID string, since, until, joinedSince time.Time) (*model.GuildHealthMetrics, error) {
+			// One event in four weeks, well attended, no pools
+			return &model.GuildHealthMetrics{Members: 20, Events: 1, ApprovedRSVPs: 10}, nil
+		},
+		GetOnOrBeforeFunc: func(ctx context.Context, guildID, day string) (*model.GuildHealth, error) {
+			return &model.GuildHealth{GuildID: guildID, Day: day, Score: 80}, nil
+		},
+		SaveFunc: func(ctx context.Context, health *model.GuildHealth) error {
+			return nil
+		},
+		GetOrganizersFunc: func(ctx context.Context, guildID string) ([]string, error) {
+			return []string{"user:organizer"}, nil
+		},
+		LastAlertedOnFunc: func(ctx context.Context, guildID string) (*time.Time, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return alertedOn, nil
+		},
+		MarkAlertedFunc: func(ctx context.Context, guildID, day string, at time.Time) error {
+			mu.Lock()
+			defer mu.Unlock()
+			alertedOn = &at
+			return nil
+		},
+	}
+
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	organizer := hub.SubscribeUser("user:organizer", "sub-1")
+
+	svc := NewGuildHealthService(GuildHealthServiceConfig{Repo: repo, EventHub: hub, Clock: clk})
+
+	if err := svc.ProcessGuildHealth(ctx); err != nil {
+		t.Fatalf("ProcessGuildHealth() error = %v", err)
+	}
+	clk.Advance(6 * time.Hour)
+	if err := svc.ProcessGuildHealth(ctx); err != nil {
+		t.Fatalf("ProcessGuildHealth() error = %v", err)
+	}
+
+	events := drainReminderEvents(organizer)
+	if len(events) != 1 {
+		t.Fatalf("got %d alerts, want 1 per drop window", len(events))
+	}
+	if events[0].Type != EventGuildHealthAlert {
+		t.Errorf("event type = %s, want %s", events[0].Type, EventGuildHealthAlert)
+	}
+	data := events[0].Data.(map[string]interface{})
+	if data["previous_score"] != 80 || data["guild_id"] != "guild:1" {
+		t.Errorf("alert data = %v, want the guild's drop from 80", data)
+	}
+	suggestions, _ := data["suggested_actions"].([]model.GuildHealthSuggestion)
+	if len(suggestions) == 0 || suggestions[0].Action != model.GuildHealthActionScheduleEvent {
+		t.Errorf("suggested actions = %v, want scheduling an event first", suggestions)
+	}
+}
+
+func TestGuildHealthService_Report_ScoresUnscoredGuild(t *testing.T) {
+	t.Parallel()
+	saved := 0
+	repo := &mocks.GuildHealthRepository{
+		GetMetricsFunc: func(ctx context.Context, guildID string, since, until, joinedSince time.Time) (*model.GuildHealthMetrics, error) {
+			return &model.GuildHealthMetrics{Members: 10, Events: 4, ApprovedRSVPs: 10, PoolParticipants: 3}, nil
+		},
+		SaveFunc: func(ctx context.Context, health *model.GuildHealth) error {
+			saved++
+			return nil
+		},
+	}
+	clk := fakeclock.New(time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC))
+	svc := NewGuildHealthService(GuildHealthServiceConfig{Repo: repo, Clock: clk})
+
+	report, err := svc.Report(context.Background(), "guild:1")
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if report.Current == nil || report.Current.Day != "2026-03-01" || saved != 1 {
+		t.Fatalf("Report() = %+v with %d saves, want today's score saved", report.Current, saved)
+	}
+	if len(report.History) != 1 || report.History[0].Score != report.Current.Score {
+		t.Errorf("History = %v, want just today's score", report.History)
+	}
+}
+
+func ptrFloat(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildImportRepository defines the interface for guild import storage
+type GuildImportRepository interface {
+	Create(ctx context.Context, imp *model.GuildImport) error
+	Get(ctx context.Context, id string) (*model.GuildImport, error)
+	List(ctx context.Context, limit int) ([]*model.GuildImport, error)
+	Claim(ctx context.Context, id string) (bool, error)
+	SaveCommit(ctx context.Context, imp *model.GuildImport) error
+}
+
+// GuildImportGuilds resolves the guild an export is imported into
+type GuildImportGuilds interface {
+	ResolveSlug(ctx context.Context, slug string) (string, error)
+}
+
+// GuildImportEvents finds and creates the guild's events
+type GuildImportEvents interface {
+	GetGuildEvents(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error)
+	CreateEvent(ctx context.Context, userID string, req *model.CreateEventRequest) (*model.Event, error)
+}
+
+// GuildImportMembers queues imported members as a user import
+type GuildImportMembers interface {
+	Queue(ctx context.Context, actorID string, records []model.UserImportRecord) (*model.UserImport, error)
+}
+
+// guildImportMatchWindow is how far apart an imported event and a guild
+// event can start and still be the same event
+const guildImportMatchWindow = time.Minute
+
+// GuildImportService moves a community onto Saga from a Meetup events
+// export or a Discord member list. Uploading an export maps it onto a
+// guild as a preview: members are matched to accounts by email and events
+// to the guild's events by title and start time. The admin reviews the
+// preview, supplies emails the export lacked and skips what they don't
+// want, then commits it. Committing creates the events and queues the
+// members as a user import, which creates their accounts, adds them to the
+// guild and invites them.
+type GuildImportService struct {
+	repo     GuildImportRepository
+	userRepo UserRepository
+	guilds   GuildImportGuilds
+	events   GuildImportEvents
+	members  GuildImportMembers
+	clock    clock.Clock
+}
+
+// GuildImportServiceConfig holds configuration for the guild import service
+type GuildImportServiceConfig struct {
+	Repo     GuildImportRepository
+	UserRepo UserRepository
+	Guilds   GuildImportGuilds
+	Events   GuildImportEvents
+	Members  GuildImportMembers
+
+	// Clock decides preview expiry and which events are in the past; nil
+	// is the system clock
+	Clock clock.Clock
+}
+
+// NewGuildImportService creates a new guild import service
+func NewGuildImportService(cfg GuildImportServiceConfig) *GuildImportService {
+	return &GuildImportService{
+		repo:     cfg.Repo,
+		userRepo: cfg.UserRepo,
+		guilds:   cfg.Guilds,
+		events:   cfg.Events,
+		members:  cfg.Members,
+		clock:    clock.OrReal(cfg.Clock),
+	}
+}
+
+// Preview reads an export and stores how it maps onto the guild. Nothing
+// is created until the preview is committed.
+func (s *GuildImportService) Preview(ctx context.Context, actorID string, req *model.CreateGuildImportRequest) (*model.GuildImport, error) {
+	if errs := req.Validate(); len(errs) > 0 {
+		return nil, model.NewValidationError(errs)
+	}
+
+	guildID, err := s.guilds.ResolveSlug(ctx, req.Guild)
+	if err != nil {
+		return nil, err
+	}
+
+	members, events, err := guildImportAdapters[req.Source](req.Export)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) > model.MaxGuildImportMembers {
+		return nil, fmt.Errorf("%w: export has more than %d members", ErrInvalidGuildExport, model.MaxGuildImportMembers)
+	}
+	if len(events) > model.MaxGuildImportEvents {
+		return nil, fmt.Errorf("%w: export has more than %d events", ErrInvalidGuildExport, model.MaxGuildImportEvents)
+	}
+
+	if err := s.mapMembers(ctx, members); err != nil {
+		return nil, err
+	}
+	if err := s.mapEvents(ctx, guildID, events); err != nil {
+		return nil, err
+	}
+
+	imp := &model.GuildImport{
+		Source:      req.Source,
+		GuildID:     guildID,
+		GuildSlug:   req.Guild,
+		RequestedBy: actorID,
+		Members:     members,
+		Events:      events,
+		ExpiresOn:   s.clock.Now().Add(model.GuildImportPreviewTTL),
+	}
+	if err := s.repo.Create(ctx, imp); err != nil {
+		return nil, err
+	}
+	imp.Summary = imp.Summarize()
+	return imp, nil
+}
+
+// Get returns an import with its members and events
+func (s *GuildImportService) Get(ctx context.Context, id string) (*model.GuildImport, error) {
+	imp, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if imp == nil {
+		return nil, ErrGuildImportNotFound
+	}
+	return imp, nil
+}
+
+// List returns the most recent imports, newest first
+func (s *GuildImportService) List(ctx context.Context, limit int) ([]*model.GuildImport, error) {
+	if limit <= 0 {
+		limit = model.DefaultGuildImportsLimit
+	}
+	if limit > model.MaxGuildImportsLimit {
+		limit = model.MaxGuildImportsLimit
+	}
+	return s.repo.List(ctx, limit)
+}
+
+// Commit applies the admin's corrections to a preview, maps it again
+// against the current accounts and events, and carries it out. Events are
+// created straight away; members are queued as a user import, which is
+// linked from the result.
+func (s *GuildImportService) Commit(ctx context.Context, actorID, id string, req *model.CommitGuildImportRequest) (*model.GuildImport, error) {
+	imp, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if imp.Status == model.GuildImportCommitted {
+		return nil, ErrGuildImportCommitted
+	}
+	if imp.IsExpired(s.clock.Now()) {
+		return nil, ErrGuildImportExpired
+	}
+	if errs := validateGuildImportCommit(imp, req); len(errs) > 0 {
+		return nil, model.NewValidationError(errs)
+	}
+
+	for i := range imp.Members {
+		if email, ok := req.MemberEmails[imp.Members[i].ExternalID]; ok {
+			imp.Members[i].Email = strings.ToLower(strings.TrimSpace(email))
+		}
+	}
+	if err := s.mapMembers(ctx, imp.Members); err != nil {
+		return nil, err
+	}
+	if err := s.mapEvents(ctx, imp.GuildID, imp.Events); err != nil {
+		return nil, err
+	}
+	applyGuildImportSkips(imp, req.Skip)
+
+	claimed, err := s.repo.Claim(ctx, imp.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, ErrGuildImportCommitted
+	}
+	now := s.clock.Now()
+	imp.Status = model.GuildImportCommitted
+	imp.CommittedOn = &now
+
+	s.createEvents(ctx, actorID, imp)
+
+	var records []model.UserImportRecord
+	for _, m := range imp.Members {
+		if m.Action == model.GuildImportSkip {
+			continue
+		}
+		records = append(records, model.UserImportRecord{
+			Line:   len(records) + 1,
+			Email:  m.Email,
+			Name:   m.Name,
+			Guilds: []string{imp.GuildSlug},
+		})
+	}
+	if len(records) > 0 {
+		userImport, err := s.members.Queue(ctx, actorID, records)
+		if err != nil {
+			log.Printf("[GuildImport] Failed to queue members of %s: %v", imp.ID, err)
+			return nil, err
+		}
+		imp.UserImportID = userImport.ID
+	}
+
+	if err := s.repo.SaveCommit(ctx, imp); err != nil {
+		return nil, err
+	}
+	imp.Summary = imp.Summarize()
+	return imp, nil
+}
+
+// mapMembers decides what committing does with each member: members need
+// a valid email that no other member uses, and are matched to accounts by
+// it
+func (s *GuildImportService) mapMembers(ctx context.Context, members []model.GuildImportMember) error {
+	seen := make(map[string]string)
+	for i := range members {
+		m := &members[i]
+		m.Action, m.UserID, m.Note = model.GuildImportSkip, "", ""
+
+		switch {
+		case m.Name == "":
+			m.Note = "the member has no name"
+		case m.Email == "":
+			m.Note = "the export has no email for this member; set one in member_emails to import them"
+		case !isValidEmail(m.Email):
+			m.Note = "email is not a valid address"
+		case seen[m.Email] != "":
+			m.Note = fmt.Sprintf("email is already used by member %s", seen[m.Email])
+		default:
+			seen[m.Email] = m.ExternalID
+			user, err := s.userRepo.GetByEmail(ctx, m.Email)
+			if err != nil {
+				return err
+			}
+			if user != nil {
+				m.Action, m.UserID = model.GuildImportExisting, user.ID
+			} else {
+				m.Action = model.GuildImportCreate
+			}
+		}
+	}
+	return nil
+}
+
+// mapEvents decides what committing does with each event the export could
+// describe: events the guild already has are matched, past events are
+// skipped and the rest are created
+func (s *GuildImportService) mapEvents(ctx context.Context, guildID string, events []model.GuildImportEvent) error {
+	now := s.clock.Now()
+	for i := range events {
+		e := &events[i]
+		if e.Action == model.GuildImportSkip && e.EventID == "" {
+			continue // The export couldn't describe it, or the admin skipped it
+		}
+		e.Action, e.EventID, e.Note = "", "", ""
+
+		existing, err := s.findEvent(ctx, guildID, e)
+		if err != nil {
+			return err
+		}
+		switch {
+		case existing != nil:
+			e.Action, e.EventID = model.GuildImportExisting, existing.ID
+		case e.StartTime.Before(now):
+			e.Action, e.Note = model.GuildImportSkip, "the event is in the past"
+		default:
+			e.Action = model.GuildImportCreate
+		}
+	}
+	return nil
+}
+
+// findEvent returns the guild's event with the same title that starts at
+// the same time, or nil
+func (s *GuildImportService) findEvent(ctx context.Context, guildID string, e *model.GuildImportEvent) (*model.Event, error) {
+	after := e.StartTime.Add(-guildImportMatchWindow)
+	before := e.StartTime.Add(guildImportMatchWindow)
+	candidates, err := s.events.GetGuildEvents(ctx, guildID, &model.EventSearchFilters{
+		StartAfter:  &after,
+		StartBefore: &before,
+	}, model.ListQuery{})
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		if strings.EqualFold(candidate.Title, e.Title) {
+			return candidate, nil
+		}
+	}
+	return nil, nil
+}
+
+// createEvents creates the events marked create. An event that fails is
+// skipped with the reason so the rest of the import still goes ahead.
+func (s *GuildImportService) createEvents(ctx context.Context, actorID string, imp *model.GuildImport) {
+	guildID := imp.GuildID
+	for i := range imp.Events {
+		e := &imp.Events[i]
+		if e.Action != model.GuildImportCreate {
+			continue
+		}
+
+		req := &model.CreateEventRequest{
+			GuildID:    &guildID,
+			Title:      e.Title,
+			Location:   e.Location,
+			StartTime:  e.StartTime,
+			EndTime:    e.EndTime,
+			Template:   model.EventTemplateCasual,
+			Visibility: model.EventVisibilityGuilds,
+			// Matching existing events is the import's own job
+			ConfirmDuplicate: true,
+		}
+		if e.Description != "" {
+			description := e.Description
+			req.Description = &description
+		}
+		event, err := s.events.CreateEvent(ctx, actorID, req)
+		if err != nil {
+			log.Printf("[GuildImport] Failed to create event %s of %s: %v", e.ExternalID, imp.ID, err)
+			e.Action, e.Note = model.GuildImportSkip, "the event couldn't be created"
+			var problem *model.ProblemDetails
+			if errors.As(err, &problem) {
+				e.Note += ": " + problem.Detail
+			}
+			continue
+		}
+		e.EventID = event.ID
+	}
+}
+
+// validateGuildImportCommit checks that corrections name members and
+// events of the import
+func validateGuildImportCommit(imp *model.GuildImport, req *model.CommitGuildImportRequest) []model.FieldError {
+	var v model.Validator
+
+	members := make(map[string]bool, len(imp.Members))
+	for _, m := range imp.Members {
+		members[m.ExternalID] = true
+	}
+	known := make(map[string]bool, len(imp.Members)+len(imp.Events))
+	for id := range members {
+		known[id] = true
+	}
+	for _, e := range imp.Events {
+		known[e.ExternalID] = true
+	}
+
+	for id := range req.MemberEmails {
+		v.Check("member_emails", members[id], fmt.Sprintf("member %q is not in the import", id))
+	}
+	for _, id := range req.Skip {
+		v.Check("skip", known[id], fmt.Sprintf("%q is not a member or event of the import", id))
+	}
+	return v.Errors()
+}
+
+// applyGuildImportSkips skips the members and events the admin left out
+func applyGuildImportSkips(imp *model.GuildImport, skip []string) {
+	if len(skip) == 0 {
+		return
+	}
+	skipped := make(map[string]bool, len(skip))
+	for _, id := range skip {
+		skipped[id] = true
+	}
+	for i := range imp.Members {
+		if m := &imp.Members[i]; skipped[m.ExternalID] {
+			m.Action, m.UserID, m.Note = model.GuildImportSkip, "", "skipped by an admin"
+		}
+	}
+	for i := range imp.Events {
+		if e := &imp.Events[i]; skipped[e.ExternalID] {
+			e.Action, e.EventID, e.Note = model.GuildImportSkip, "", "skipped by an admin"
+		}
+	}
+}
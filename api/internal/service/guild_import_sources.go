@@ -0,0 +1,232 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// guildImportAdapter reads a platform's export into the members and events
+// it describes. Actions are left for the service to map.
+type guildImportAdapter func(export json.RawMessage) ([]model.GuildImportMember, []model.GuildImportEvent, error)
+
+// guildImportAdapters reads exports by source
+var guildImportAdapters = map[model.GuildImportSource]guildImportAdapter{
+	model.GuildImportMeetup:  readMeetupExport,
+	model.GuildImportDiscord: readDiscordExport,
+}
+
+// exportID is an ID that platforms send either as a string or a number
+type exportID string
+
+func (id *exportID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = exportID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("id must be a string or a number")
+	}
+	*id = exportID(n.String())
+	return nil
+}
+
+// ============================================================================
+// Meetup
+// ============================================================================
+
+// meetupEvent is one event as the Meetup events API returns it. Times are
+// milliseconds since the epoch.
+type meetupEvent struct {
+	ID            exportID     `json:"id"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+	Time          int64        `json:"time"`
+	Duration      int64        `json:"duration"`
+	IsOnlineEvent bool         `json:"is_online_event"`
+	Link          string       `json:"link"`
+	Venue         *meetupVenue `json:"venue"`
+}
+
+type meetupVenue struct {
+	Name     string  `json:"name"`
+	Address1 string  `json:"address_1"`
+	City     string  `json:"city"`
+	Lat      float64 `json:"lat"`
+	Lon      float64 `json:"lon"`
+}
+
+// readMeetupExport reads an array of Meetup events. Meetup exports don't
+// list members.
+func readMeetupExport(export json.RawMessage) ([]model.GuildImportMember, []model.GuildImportEvent, error) {
+	var items []meetupEvent
+	if err := decodeExport(export, &items); err != nil {
+		return nil, nil, err
+	}
+
+	events := make([]model.GuildImportEvent, 0, len(items))
+	for i, item := range items {
+		event := model.GuildImportEvent{
+			ExternalID:  string(item.ID),
+			Title:       truncateText(strings.TrimSpace(item.Name), model.MaxEventTitleLength),
+			Description: truncateText(htmlToText(item.Description), model.MaxEventDescriptionLength),
+			Location:    meetupLocation(item),
+		}
+		if event.ExternalID == "" {
+			event.ExternalID = fmt.Sprintf("event-%d", i+1)
+		}
+		if item.Time > 0 {
+			event.StartTime = time.UnixMilli(item.Time).UTC()
+			if item.Duration > 0 {
+				end := event.StartTime.Add(time.Duration(item.Duration) * time.Millisecond)
+				event.EndTime = &end
+			}
+		}
+
+		switch {
+		case event.Title == "":
+			event.Action = model.GuildImportSkip
+			event.Note = "the event has no name"
+		case event.StartTime.IsZero():
+			event.Action = model.GuildImportSkip
+			event.Note = "the event has no start time"
+		}
+		events = append(events, event)
+	}
+	return nil, events, nil
+}
+
+// meetupLocation maps an event's venue, or marks online events virtual
+// with their Meetup page as the link
+func meetupLocation(item meetupEvent) *model.EventLocation {
+	if item.IsOnlineEvent {
+		loc := &model.EventLocation{Name: "Online", IsVirtual: true}
+		if item.Link != "" {
+			link := item.Link
+			loc.MeetLink = &link
+		}
+		return loc
+	}
+	if item.Venue == nil || item.Venue.Name == "" {
+		return nil
+	}
+	loc := &model.EventLocation{
+		Name: item.Venue.Name,
+		City: item.Venue.City,
+		Lat:  item.Venue.Lat,
+		Lng:  item.Venue.Lon,
+	}
+	if item.Venue.Address1 != "" {
+		address := item.Venue.Address1
+		loc.Address = &address
+	}
+	return loc
+}
+
+// ============================================================================
+// Discord
+// ============================================================================
+
+// discordMember is one member as the Discord guild members API returns it.
+// Discord doesn't share member emails; exports built by a bot with the
+// email scope may add them.
+type discordMember struct {
+	User struct {
+		ID         exportID `json:"id"`
+		Username   string   `json:"username"`
+		GlobalName string   `json:"global_name"`
+		Bot        bool     `json:"bot"`
+	} `json:"user"`
+	Nick  string `json:"nick"`
+	Email string `json:"email"`
+}
+
+// readDiscordExport reads an array of Discord guild members, leaving out
+// bots. Discord exports don't list events.
+func readDiscordExport(export json.RawMessage) ([]model.GuildImportMember, []model.GuildImportEvent, error) {
+	var items []discordMember
+	if err := decodeExport(export, &items); err != nil {
+		return nil, nil, err
+	}
+
+	members := make([]model.GuildImportMember, 0, len(items))
+	for i, item := range items {
+		if item.User.Bot {
+			continue
+		}
+		member := model.GuildImportMember{
+			ExternalID: string(item.User.ID),
+			Name:       truncateText(firstNonEmpty(item.Nick, item.User.GlobalName, item.User.Username), model.MaxUserImportNameLength),
+			Email:      strings.ToLower(strings.TrimSpace(item.Email)),
+		}
+		if member.ExternalID == "" {
+			member.ExternalID = fmt.Sprintf("member-%d", i+1)
+		}
+		members = append(members, member)
+	}
+	return members, nil, nil
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+// decodeExport decodes an export that must be a JSON array
+func decodeExport(export json.RawMessage, v interface{}) error {
+	if !bytes.HasPrefix(bytes.TrimSpace(export), []byte("[")) {
+		return fmt.Errorf("%w: export must be a JSON array", ErrInvalidGuildExport)
+	}
+	if err := json.Unmarshal(export, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGuildExport, err)
+	}
+	return nil
+}
+
+var (
+	htmlBreakPattern = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>`)
+	htmlTagPattern   = regexp.MustCompile(`<[^>]*>`)
+	blankLinePattern = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToText turns Meetup's HTML descriptions into plain text, keeping
+// line breaks between paragraphs
+func htmlToText(s string) string {
+	s = htmlBreakPattern.ReplaceAllString(s, "\n")
+	s = htmlTagPattern.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.TrimSpace(blankLinePattern.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// truncateText cuts s to at most n bytes without splitting a character
+func truncateText(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	s = s[:n]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
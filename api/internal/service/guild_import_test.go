@@ -0,0 +1,482 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Mocks
+// ============================================================================
+
+// memoryGuildEvents stores the events of one guild
+type memoryGuildEvents struct {
+	events []*model.Event
+	failOn string // Title of an event CreateEvent rejects
+}
+
+func (m *memoryGuildEvents) GetGuildEvents(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error) {
+	var found []*model.Event
+	for _, e := range m.events {
+		if *e.GuildID != guildID {
+			continue
+		}
+		if filters.StartAfter != nil && e.StartTime.Before(*filters.StartAfter) {
+			continue
+		}
+		if filters.StartBefore != nil && !e.StartTime.Before(*filters.StartBefore) {
+			continue
+		}
+		found = append(found, e)
+	}
+	return found, nil
+}
+
+func (m *memoryGuildEvents) CreateEvent(ctx context.Context, userID string, req *model.CreateEventRequest) (*model.Event, error) {
+	if req.Title == m.failOn {
+		return nil, errors.New("database unavailable")
+	}
+	event := &model.Event{
+		ID:         fmt.Sprintf("event:%d", len(m.events)+1),
+		GuildID:    req.GuildID,
+		Title:      req.Title,
+		StartTime:  req.StartTime,
+		Visibility: req.Visibility,
+		CreatedBy:  userID,
+	}
+	m.events = append(m.events, event)
+	return event, nil
+}
+
+// recordingMembers records the members queued for import
+type recordingMembers struct {
+	records []model.UserImportRecord
+}
+
+func (m *recordingMembers) Queue(ctx context.Context, actorID string, records []model.UserImportRecord) (*model.UserImport, error) {
+	m.records = records
+	return &model.UserImport{ID: "user_import:1", RequestedBy: actorID, Records: records}, nil
+}
+
+// memoryGuildImportRepo keeps one guild import in memory
+func memoryGuildImportRepo() *mocks.GuildImportRepository {
+	var stored *model.GuildImport
+	return &mocks.GuildImportRepository{
+		CreateFunc: func(ctx context.Context, imp *model.GuildImport) error {
+			imp.ID = "guild_import:1"
+			imp.Status = model.GuildImportPreviewed
+			stored = imp
+			return nil
+		},
+		GetFunc: func(ctx context.Context, id string) (*model.GuildImport, error) {
+			if stored == nil || stored.ID != id {
+				return nil, nil
+			}
+			imp := *stored
+			imp.Members = append([]model.GuildImportMember(nil), stored.Members...)
+			imp.Events = append([]model.GuildImportEvent(nil), stored.Events...)
+			return &imp, nil
+		},
+		ClaimFunc: func(ctx context.Context, id string) (bool, error) {
+			if stored.Status == model.GuildImportCommitted {
+				return false, nil
+			}
+			stored.Status = model.GuildImportCommitted
+			return true, nil
+		},
+		SaveCommitFunc: func(ctx context.Context, imp *model.GuildImport) error {
+			stored = imp
+			return nil
+		},
+	}
+}
+
+var guildImportTestNow = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func previewGuildImport(t *testing.T, svc *GuildImportService, source model.GuildImportSource, export string) *model.GuildImport {
+	t.Helper()
+	imp, err := svc.Preview(context.Background(), "user:admin", &model.CreateGuildImportRequest{
+		Source: source,
+		Guild:  "hikers",
+		Export: json.RawMessage(export),
+	})
+	if err != nil {
+		t.Fatalf("Preview failed: %v", err)
+	}
+	return imp
+}
+
+// meetupExport is two upcoming events, one of them online, a past event
+// and one without a start time
+const meetupExport = `[
+	{"id": "301", "name": "Summit Hike", "description": "<p>Bring water &amp; snacks.</p><p>Meet at the gate.</p>",
+	 "time": 1778846400000, "duration": 14400000,
+	 "venue": {"name": "Trailhead", "address_1": "1 Park Rd", "city": "Boulder", "lat": 40.0, "lon": -105.3}},
+	{"id": 302, "name": "Trip Planning", "time": 1779451200000, "is_online_event": true, "link": "https://meetup.test/e/302"},
+	{"id": "290", "name": "Winter Walk", "time": 1767261600000},
+	{"id": "303", "name": "Untimed Social"}
+]`
+
+// discordExport is three members, one without an email, and a bot
+const discordExport = `[
+	{"user": {"id": "81", "username": "ada", "global_name": "Ada Lovelace"}, "email": "ADA@example.com"},
+	{"user": {"id": "82", "username": "grace"}, "nick": "Grace H", "email": "grace@example.com"},
+	{"user": {"id": "83", "username": "linus"}},
+	{"user": {"id": "84", "username": "modbot", "bot": true}}
+]`
+
+// ============================================================================
+// Adapter Tests
+// ============================================================================
+
+func TestReadMeetupExport(t *testing.T) {
+	t.Parallel()
+
+	members, events, err := readMeetupExport(json.RawMessage(meetupExport))
+	if err != nil {
+		t.Fatalf("readMeetupExport failed: %v", err)
+	}
+	if members != nil || len(events) != 4 {
+		t.Fatalf("expected 4 events and no members, got %d and %d", len(events), len(members))
+	}
+
+	hike := events[0]
+	end := time.Date(2026, 5, 15, 16, 0, 0, 0, time.UTC)
+	if hike.StartTime != time.Date(2026, 5, 15, 12, 0, 0, 0, time.UTC) || hike.EndTime == nil || !hike.EndTime.Equal(end) {
+		t.Errorf("expected the hike from 12:00 to 16:00 UTC, got %v to %v", hike.StartTime, hike.EndTime)
+	}
+	if hike.Description != "Bring water & snacks.\nMeet at the gate." {
+		t.Errorf("expected the description as plain text, got %q", hike.Description)
+	}
+	if loc := hike.Location; loc == nil || loc.Name != "Trailhead" || derefString(loc.Address) != "1 Park Rd" || loc.Lng != -105.3 {
+		t.Errorf("expected the venue as the location, got %+v", loc)
+	}
+
+	planning := events[1]
+	if planning.ExternalID != "302" || planning.Location == nil || !planning.Location.IsVirtual {
+		t.Errorf("expected a virtual event with a numeric id read as a string, got %+v", planning)
+	}
+	if untimed := events[3]; untimed.Action != model.GuildImportSkip || untimed.Note == "" {
+		t.Errorf("expected an event without a time to be skipped, got %+v", untimed)
+	}
+}
+
+func TestReadDiscordExport(t *testing.T) {
+	t.Parallel()
+
+	members, events, err := readDiscordExport(json.RawMessage(discordExport))
+	if err != nil {
+		t.Fatalf("readDiscordExport failed: %v", err)
+	}
+	want := []model.GuildImportMember{
+		{ExternalID: "81", Name: "Ada Lovelace", Email: "ada@example.com"},
+		{ExternalID: "82", Name: "Grace H", Email: "grace@example.com"},
+		{ExternalID: "83", Name: "linus"},
+	}
+	if events != nil || !reflect.DeepEqual(members, want) {
+		t.Errorf("expected %+v without events, got %+v and %d events", want, members, len(events))
+	}
+}
+
+func TestGuildImportAdapters_RejectUnreadableExports(t *testing.T) {
+	t.Parallel()
+
+	for _, export := range []string{`{"events": []}`, `[{"id": true, "user": {"id": true}}]`, `not json`} {
+		for source, adapter := range guildImportAdapters {
+			if _, _, err := adapter(json.RawMessage(export)); !errors.Is(err, ErrInvalidGuildExport) {
+				t.Errorf("%s: expected ErrInvalidGuildExport for %s, got %v", source, export, err)
+			}
+		}
+	}
+}
+
+// ============================================================================
+// Preview Tests
+// ============================================================================
+
+func TestGuildImport_PreviewMapsMembers(t *testing.T) {
+	t.Parallel()
+
+	members := &recordingMembers{}
+	svc := NewGuildImportService(GuildImportServiceConfig{
+		Repo: memoryGuildImportRepo(),
+		UserRepo: &mocks.UserRepository{
+			GetByEmailFunc: func(ctx context.Context, email string) (*model.User, error) {
+				if email != "grace@example.com" {
+					return nil, nil
+				}
+				return &model.User{ID: "user:grace", Email: email}, nil
+			},
+		},
+		Guilds:  newMemoryGuilds(map[string]string{"hikers": "guild:1"}),
+		Events:  &memoryGuildEvents{},
+		Members: members,
+		Clock:   fakeclock.New(guildImportTestNow),
+	})
+
+	imp := previewGuildImport(t, svc, model.GuildImportDiscord, discordExport)
+
+	actions := []model.GuildImportAction{imp.Members[0].Action, imp.Members[1].Action, imp.Members[2].Action}
+	want := []model.GuildImportAction{model.GuildImportCreate, model.GuildImportExisting, model.GuildImportSkip}
+	if !reflect.DeepEqual(actions, want) {
+		t.Fatalf("expected actions %v, got %v", want, actions)
+	}
+	if imp.Members[1].UserID != "user:grace" {
+		t.Errorf("expected Grace matched to user:grace, got %q", imp.Members[1].UserID)
+	}
+	if !strings.Contains(imp.Members[2].Note, "member_emails") {
+		t.Errorf("expected a note on how to import a member without an email, got %q", imp.Members[2].Note)
+	}
+	if imp.GuildID != "guild:1" || !imp.ExpiresOn.Equal(guildImportTestNow.Add(model.GuildImportPreviewTTL)) {
+		t.Errorf("expected a preview of guild:1 expiring in a day, got %+v", imp)
+	}
+	if imp.Summary != (model.GuildImportSummary{MembersCreated: 1, MembersExisting: 1, MembersSkipped: 1}) {
+		t.Errorf("unexpected summary %+v", imp.Summary)
+	}
+	if members.records != nil {
+		t.Error("expected previewing not to queue members")
+	}
+}
+
+func TestGuildImport_PreviewMapsEvents(t *testing.T) {
+	t.Parallel()
+
+	guildID := "guild:1"
+	events := &memoryGuildEvents{events: []*model.Event{{
+		ID:        "event:existing",
+		GuildID:   &guildID,
+		Title:     "summit hike",
+		StartTime: time.Date(2026, 5, 15, 12, 0, 0, 0, time.UTC),
+	}}}
+	svc := NewGuildImportService(GuildImportServiceConfig{
+		Repo:     memoryGuildImportRepo(),
+		UserRepo: &mocks.UserRepository{},
+		Guilds:   newMemoryGuilds(map[string]string{"hikers": "guild:1"}),
+		Events:   events,
+		Members:  &recordingMembers{},
+		Clock:    fakeclock.New(guildImportTestNow),
+	})
+
+	imp := previewGuildImport(t, svc, model.GuildImportMeetup, meetupExport)
+
+	actions := make([]model.GuildImportAction, len(imp.Events))
+	for i, e := range imp.Events {
+		actions[i] = e.Action
+	}
+	want := []model.GuildImportAction{model.GuildImportExisting, model.GuildImportCreate, model.GuildImportSkip, model.GuildImportSkip}
+	if !reflect.DeepEqual(actions, want) {
+		t.Fatalf("expected actions %v, got %v", want, actions)
+	}
+	if imp.Events[0].EventID != "event:existing" {
+		t.Errorf("expected the hike matched to the guild's event, got %q", imp.Events[0].EventID)
+	}
+	if imp.Events[2].Note != "the event is in the past" {
+		t.Errorf("expected the past event skipped, got %q", imp.Events[2].Note)
+	}
+	if len(events.events) != 1 {
+		t.Error("expected previewing not to create events")
+	}
+}
+
+func TestGuildImport_PreviewErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		req            model.CreateGuildImportRequest
+		wantErr        error
+		wantValidation bool
+	}{
+		{
+			name:           "unknown source",
+			req:            model.CreateGuildImportRequest{Source: "slack", Guild: "hikers", Export: json.RawMessage(`[]`)},
+			wantValidation: true,
+		},
+		{
+			name:    "unknown guild",
+			req:     model.CreateGuildImportRequest{Source: model.GuildImportMeetup, Guild: "chess", Export: json.RawMessage(`[]`)},
+			wantErr: ErrGuildNotFound,
+		},
+		{
+			name:    "unreadable export",
+			req:     model.CreateGuildImportRequest{Source: model.GuildImportDiscord, Guild: "hikers", Export: json.RawMessage(`{"members": []}`)},
+			wantErr: ErrInvalidGuildExport,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewGuildImportService(GuildImportServiceConfig{
+				Repo: &mocks.GuildImportRepository{
+					CreateFunc: func(ctx context.Context, imp *model.GuildImport) error {
+						t.Error("expected no preview stored")
+						return nil
+					},
+				},
+				UserRepo: &mocks.UserRepository{},
+				Guilds:   newMemoryGuilds(map[string]string{"hikers": "guild:1"}),
+				Events:   &memoryGuildEvents{},
+				Members:  &recordingMembers{},
+			})
+
+			_, err := svc.Preview(context.Background(), "user:admin", &tt.req)
+			if tt.wantValidation {
+				var problem *model.ProblemDetails
+				if !errors.As(err, &problem) {
+					t.Errorf("expected a validation error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Commit Tests
+// ============================================================================
+
+func TestGuildImport_Commit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	members := &recordingMembers{}
+	svc := NewGuildImportService(GuildImportServiceConfig{
+		Repo:     memoryGuildImportRepo(),
+		UserRepo: &mocks.UserRepository{},
+		Guilds:   newMemoryGuilds(map[string]string{"hikers": "guild:1"}),
+		Events:   &memoryGuildEvents{},
+		Members:  members,
+		Clock:    fakeclock.New(guildImportTestNow),
+	})
+	previewGuildImport(t, svc, model.GuildImportDiscord, discordExport)
+
+	imp, err := svc.Commit(ctx, "user:admin", "guild_import:1", &model.CommitGuildImportRequest{
+		MemberEmails: map[string]string{"83": " Linus@Example.com "},
+		Skip:         []string{"82"},
+	})
+	if err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	want := []model.UserImportRecord{
+		{Line: 1, Email: "ada@example.com", Name: "Ada Lovelace", Guilds: []string{"hikers"}},
+		{Line: 2, Email: "linus@example.com", Name: "linus", Guilds: []string{"hikers"}},
+	}
+	if !reflect.DeepEqual(members.records, want) {
+		t.Errorf("expected members queued as %+v, got %+v", want, members.records)
+	}
+	if imp.Status != model.GuildImportCommitted || imp.CommittedOn == nil || imp.UserImportID != "user_import:1" {
+		t.Errorf("expected a committed import linked to its user import, got %+v", imp)
+	}
+	if imp.Members[1].Note != "skipped by an admin" {
+		t.Errorf("expected Grace skipped by the admin, got %+v", imp.Members[1])
+	}
+}
+
+func TestGuildImport_CommitCreatesEvents(t *testing.T) {
+	t.Parallel()
+
+	events := &memoryGuildEvents{failOn: "Trip Planning"}
+	members := &recordingMembers{}
+	svc := NewGuildImportService(GuildImportServiceConfig{
+		Repo:     memoryGuildImportRepo(),
+		UserRepo: &mocks.UserRepository{},
+		Guilds:   newMemoryGuilds(map[string]string{"hikers": "guild:1"}),
+		Events:   events,
+		Members:  members,
+		Clock:    fakeclock.New(guildImportTestNow),
+	})
+	previewGuildImport(t, svc, model.GuildImportMeetup, meetupExport)
+
+	imp, err := svc.Commit(context.Background(), "user:admin", "guild_import:1", &model.CommitGuildImportRequest{})
+	if err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	if len(events.events) != 1 {
+		t.Fatalf("expected one event created, got %d", len(events.events))
+	}
+	created := events.events[0]
+	if created.Title != "Summit Hike" || *created.GuildID != "guild:1" || created.Visibility != model.EventVisibilityGuilds {
+		t.Errorf("expected the hike created for guild members, got %+v", created)
+	}
+	if imp.Events[0].EventID != created.ID {
+		t.Errorf("expected the import to link the created event, got %q", imp.Events[0].EventID)
+	}
+	if failed := imp.Events[1]; failed.Action != model.GuildImportSkip || !strings.HasPrefix(failed.Note, "the event couldn't be created") {
+		t.Errorf("expected the failed event skipped with a note, got %+v", failed)
+	}
+	if members.records != nil || imp.UserImportID != "" {
+		t.Error("expected no user import for an export without members")
+	}
+}
+
+func TestGuildImport_CommitErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		id             string
+		req            model.CommitGuildImportRequest
+		elapsed        time.Duration // Time between previewing and committing
+		committed      bool          // Commit once before the commit under test
+		wantErr        error
+		wantValidation bool
+	}{
+		{name: "unknown member", id: "guild_import:1", req: model.CommitGuildImportRequest{Skip: []string{"999"}}, wantValidation: true},
+		{name: "unknown import", id: "guild_import:404", wantErr: ErrGuildImportNotFound},
+		{name: "expired preview", id: "guild_import:1", elapsed: model.GuildImportPreviewTTL, wantErr: ErrGuildImportExpired},
+		{name: "already committed", id: "guild_import:1", committed: true, wantErr: ErrGuildImportCommitted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			clk := fakeclock.New(guildImportTestNow)
+			svc := NewGuildImportService(GuildImportServiceConfig{
+				Repo:     memoryGuildImportRepo(),
+				UserRepo: &mocks.UserRepository{},
+				Guilds:   newMemoryGuilds(map[string]string{"hikers": "guild:1"}),
+				Events:   &memoryGuildEvents{},
+				Members:  &recordingMembers{},
+				Clock:    clk,
+			})
+			previewGuildImport(t, svc, model.GuildImportDiscord, discordExport)
+			if tt.committed {
+				if _, err := svc.Commit(ctx, "user:admin", tt.id, &model.CommitGuildImportRequest{}); err != nil {
+					t.Fatalf("first Commit failed: %v", err)
+				}
+			}
+			clk.Advance(tt.elapsed)
+
+			_, err := svc.Commit(ctx, "user:admin", tt.id, &tt.req)
+			if tt.wantValidation {
+				var problem *model.ProblemDetails
+				if !errors.As(err, &problem) {
+					t.Errorf("expected a validation error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildInviteRepository defines the storage for guild invites
+type GuildInviteRepository interface {
+	Create(ctx context.Context, invite *model.GuildInvite) error
+	GetByID(ctx context.Context, id string) (*model.GuildInvite, error)
+	GetByCode(ctx context.Context, code string) (*model.GuildInvite, error)
+	GetByGuild(ctx context.Context, guildID string) ([]*model.GuildInvite, error)
+	CountActive(ctx context.Context, guildID string, now time.Time) (int, error)
+	ClaimUse(ctx context.Context, id string) (bool, error)
+	ReleaseUse(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+}
+
+// GuildInviteGuildSource provides the guilds invites are for and who may
+// manage them
+type GuildInviteGuildSource interface {
+	GetByID(ctx context.Context, id string) (*model.Guild, error)
+	IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error)
+}
+
+// GuildInviteUserSource provides the accounts invites for a specific person
+// are matched against
+type GuildInviteUserSource interface {
+	GetByID(ctx context.Context, id string) (*model.User, error)
+}
+
+// GuildInviteJoiner adds users who accept guild invites
+type GuildInviteJoiner interface {
+	JoinGuildByInvite(ctx context.Context, userID, guildID string) error
+}
+
+// maxInviteCodeAttempts bounds retries when a generated code is taken
+const maxInviteCodeAttempts = 3
+
+// GuildInviteService lets guild admins invite people with expiring codes.
+// Anyone holding a code joins the guild without waiting for approval;
+// invites for a specific person only work for the account with their email.
+type GuildInviteService struct {
+	repo        GuildInviteRepository
+	guilds      GuildInviteGuildSource
+	users       GuildInviteUserSource
+	joiner      GuildInviteJoiner
+	permissions GuildPermissionChecker
+	baseURL     string
+	clock       clock.Clock
+}
+
+// GuildInviteServiceConfig holds configuration for the guild invite service
+type GuildInviteServiceConfig struct {
+	Repo        GuildInviteRepository
+	Guilds      GuildInviteGuildSource
+	Users       GuildInviteUserSource
+	Joiner      GuildInviteJoiner
+	Permissions GuildPermissionChecker // Optional; nil lets only guild admins manage invites
+	BaseURL     string                 // Public site that serves /invite/{code}
+	Clock       clock.Clock            // Default: the system clock
+}
+
+// NewGuildInviteService creates a new guild invite service
+func NewGuildInviteService(cfg GuildInviteServiceConfig) *GuildInviteService {
+	return &GuildInviteService{
+		repo:        cfg.Repo,
+		guilds:      cfg.Guilds,
+		users:       cfg.Users,
+		joiner:      cfg.Joiner,
+		permissions: cfg.Permissions,
+		baseURL:     strings.TrimSuffix(cfg.BaseURL, "/"),
+		clock:       clock.OrReal(cfg.Clock),
+	}
+}
+
+// CreateInvite creates an invite to a guild (guild admins only)
+func (s *GuildInviteService) CreateInvite(ctx context.Context, userID, guildID string, req *model.CreateGuildInviteRequest) (*model.GuildInvite, error) {
+	if errs := req.Validate(); len(errs) > 0 {
+		return nil, model.NewValidationError(errs)
+	}
+	if err := s.requireAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	active, err := s.repo.CountActive(ctx, guildID, now)
+	if err != nil {
+		return nil, fmt.Errorf("counting invites: %w", err)
+	}
+	if active >= model.MaxActiveGuildInvites {
+		return nil, ErrTooManyGuildInvites
+	}
+
+	ttl := model.DefaultGuildInviteTTLHours
+	if req.ExpiresInHours != nil {
+		ttl = *req.ExpiresInHours
+	}
+	invite := &model.GuildInvite{
+		GuildID:   guildID,
+		CreatedBy: userID,
+		MaxUses:   req.MaxUses,
+		ExpiresOn: now.Add(time.Duration(ttl) * time.Hour),
+	}
+	if req.Email != nil {
+		email := strings.ToLower(strings.TrimSpace(*req.Email))
+		if !isValidEmail(email) {
+			return nil, model.NewValidationError([]model.FieldError{{Field: "email", Message: "invalid email format"}})
+		}
+		single := 1
+		invite.Email, invite.MaxUses = &email, &single
+	}
+
+	// Codes are short enough to type, so a collision is unlikely but possible
+	for attempt := 1; ; attempt++ {
+		if invite.Code, err = newGuildInviteCode(); err != nil {
+			return nil, fmt.Errorf("generating invite code: %w", err)
+		}
+		err = s.repo.Create(ctx, invite)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, database.ErrDuplicate) || attempt == maxInviteCodeAttempts {
+			return nil, fmt.Errorf("creating guild invite: %w", err)
+		}
+	}
+
+	s.decorate(invite)
+	return invite, nil
+}
+
+// ListInvites returns a guild's invites, newest first, including expired and
+// used up ones (guild admins only)
+func (s *GuildInviteService) ListInvites(ctx context.Context, userID, guildID string) ([]*model.GuildInvite, error) {
+	if err := s.requireAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.repo.GetByGuild(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("getting invites: %w", err)
+	}
+	for _, invite := range invites {
+		s.decorate(invite)
+	}
+	return invites, nil
+}
+
+// RevokeInvite deletes an invite so its code stops working (guild admins
+// only)
+func (s *GuildInviteService) RevokeInvite(ctx context.Context, userID, guildID, inviteID string) error {
+	if err := s.requireAdmin(ctx, userID, guildID); err != nil {
+		return err
+	}
+
+	invite, err := s.repo.GetByID(ctx, inviteID)
+	if err != nil {
+		return fmt.Errorf("getting invite: %w", err)
+	}
+	if invite == nil || invite.GuildID != guildID {
+		return ErrGuildInviteNotFound
+	}
+	return s.repo.Delete(ctx, inviteID)
+}
+
+// AcceptInvite joins the user to the invite's guild. A use is only counted
+// when joining succeeds.
+func (s *GuildInviteService) AcceptInvite(ctx context.Context, userID, code string) (*model.Guild, error) {
+	invite, err := s.repo.GetByCode(ctx, normalizeInviteCode(code))
+	if err != nil {
+		return nil, fmt.Errorf("getting invite: %w", err)
+	}
+	if invite == nil {
+		return nil, ErrGuildInviteNotFound
+	}
+	if invite.IsExpired(s.clock.Now()) {
+		return nil, ErrGuildInviteExpired
+	}
+
+	if invite.Email != nil {
+		user, err := s.users.GetByID(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("getting user: %w", err)
+		}
+		if user == nil {
+			return nil, ErrUserNotFound
+		}
+		if !user.EmailVerified || !strings.EqualFold(user.Email, *invite.Email) {
+			return nil, ErrNotInvitee
+		}
+	}
+
+	claimed, err := s.repo.ClaimUse(ctx, invite.ID)
+	if err != nil {
+		return nil, fmt.Errorf("claiming invite use: %w", err)
+	}
+	if !claimed {
+		return nil, ErrGuildInviteExhausted
+	}
+
+	if err := s.joiner.JoinGuildByInvite(ctx, userID, invite.GuildID); err != nil {
+		if releaseErr := s.repo.ReleaseUse(ctx, invite.ID); releaseErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("releasing invite use: %w", releaseErr))
+		}
+		return nil, err
+	}
+
+	guild, err := s.guilds.GetByID(ctx, invite.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("getting guild: %w", err)
+	}
+	if guild == nil {
+		return nil, ErrGuildNotFound
+	}
+	return guild, nil
+}
+
+// requireAdmin returns ErrGuildNotFound for missing guilds and
+// ErrNotGuildAdmin unless the user administers the guild. With a permission
+// checker, members allowed to manage invites pass too.
+func (s *GuildInviteService) requireAdmin(ctx context.Context, userID, guildID string) error {
+	guild, err := s.guilds.GetByID(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("getting guild: %w", err)
+	}
+	if guild == nil {
+		return ErrGuildNotFound
+	}
+
+	if s.permissions != nil {
+		err := s.permissions.RequirePermission(ctx, userID, guildID, model.PermissionManageInvites)
+		if errors.Is(err, ErrNotGuildMember) || errors.Is(err, ErrMissingPermission) {
+			return ErrNotGuildAdmin
+		}
+		return err
+	}
+
+	isAdmin, err := s.guilds.IsGuildAdmin(ctx, userID, guildID)
+	if err != nil {
+		return fmt.Errorf("checking admin status: %w", err)
+	}
+	if !isAdmin {
+		return ErrNotGuildAdmin
+	}
+	return nil
+}
+
+func (s *GuildInviteService) decorate(invite *model.GuildInvite) {
+	invite.URL = s.baseURL + model.GuildInvitePathPrefix + invite.Code
+}
+
+// normalizeInviteCode accepts codes typed in lowercase or with spaces
+func normalizeInviteCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), " ", ""))
+}
+
+func newGuildInviteCode() (string, error) {
+	b := make([]byte, model.GuildInviteCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	alphabet := model.GuildInviteCodeAlphabet
+	for i := range b {
+		// 256 isn't a multiple of the alphabet's length; the slight bias
+		// toward its first letters doesn't matter for invite codes
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return string(b), nil
+}
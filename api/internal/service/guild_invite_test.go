@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+var guildInviteTestNow = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// fakeGuildJoiner records invite joins and fails for users already joined
+type fakeGuildJoiner struct {
+	joined map[string]bool
+}
+
+func (j *fakeGuildJoiner) JoinGuildByInvite(ctx context.Context, userID, guildID string) error {
+	if j.joined[userID] {
+		return ErrAlreadyGuildMember
+	}
+	j.joined[userID] = true
+	return nil
+}
+
+// memoryGuildInviteRepo keeps invites in memory, keyed by their code
+func memoryGuildInviteRepo() *mocks.GuildInviteRepository {
+	invites := map[string]*model.GuildInvite{}
+	return &mocks.GuildInviteRepository{
+		CreateFunc: func(ctx context.Context, invite *model.GuildInvite) error {
+			invite.ID = "guild_invite:" + invite.Code
+			invites[invite.ID] = invite
+			return nil
+		},
+		GetByIDFunc: func(ctx context.Context, id string) (*model.GuildInvite, error) {
+			return invites[id], nil
+		},
+		GetByCodeFunc: func(ctx context.Context, code string) (*model.GuildInvite, error) {
+			return invites["guild_invite:"+code], nil
+		},
+		ClaimUseFunc: func(ctx context.Context, id string) (bool, error) {
+			invite := invites[id]
+			if invite.IsExhausted() {
+				return false, nil
+			}
+			invite.UseCount++
+			return true, nil
+		},
+		ReleaseUseFunc: func(ctx context.Context, id string) error {
+			invites[id].UseCount--
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, id string) error {
+			delete(invites, id)
+			return nil
+		},
+	}
+}
+
+// inviteGuildRepo serves the private guild:1, administered by user:admin
+func inviteGuildRepo() *mocks.GuildRepository {
+	return &mocks.GuildRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Guild, error) {
+			if id != "guild:1" {
+				return nil, nil
+			}
+			return &model.Guild{ID: id, Name: "Hikers", Visibility: model.GuildVisibilityPrivate}, nil
+		},
+		IsGuildAdminFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return userID == "user:admin", nil
+		},
+	}
+}
+
+// inviteUserRepo gives user:ada the verified email ada@example.com
+func inviteUserRepo() *mocks.UserRepository {
+	return &mocks.UserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+			if id == "user:ada" {
+				return &model.User{ID: id, Email: "ada@example.com", EmailVerified: true}, nil
+			}
+			return &model.User{ID: id, Email: "someone@example.com", EmailVerified: true}, nil
+		},
+	}
+}
+
+// ============================================================================
+// Guild Invite Tests
+// ============================================================================
+
+func TestGuildInviteService_CreateInvite(t *testing.T) {
+	t.Parallel()
+
+	ada := " Ada@Example.com"
+	tests := []struct {
+		name      string
+		userID    string
+		guildID   string
+		email     *string
+		wantErr   error
+		wantEmail string // Empty for an invite anyone can use
+	}{
+		{name: "anyone", userID: "user:admin", guildID: "guild:1"},
+		{name: "one person", userID: "user:admin", guildID: "guild:1", email: &ada, wantEmail: "ada@example.com"},
+		{name: "non-admin", userID: "user:member", guildID: "guild:1", wantErr: ErrNotGuildAdmin},
+		{name: "missing guild", userID: "user:admin", guildID: "guild:2", wantErr: ErrGuildNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewGuildInviteService(GuildInviteServiceConfig{
+				Repo:    memoryGuildInviteRepo(),
+				Guilds:  inviteGuildRepo(),
+				Users:   inviteUserRepo(),
+				Joiner:  &fakeGuildJoiner{joined: map[string]bool{}},
+				BaseURL: "https://saga.example/",
+				Clock:   fakeclock.New(guildInviteTestNow),
+			})
+
+			invite, err := svc.CreateInvite(context.Background(), tt.userID, tt.guildID, &model.CreateGuildInviteRequest{Email: tt.email})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateInvite() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(invite.Code) != model.GuildInviteCodeLength || invite.URL != "https://saga.example/invite/"+invite.Code {
+				t.Errorf("invite = %+v, want an %d character code and its URL", invite, model.GuildInviteCodeLength)
+			}
+			if want := guildInviteTestNow.Add(model.DefaultGuildInviteTTLHours * time.Hour); !invite.ExpiresOn.Equal(want) {
+				t.Errorf("expires_on = %v, want %v", invite.ExpiresOn, want)
+			}
+			if tt.wantEmail == "" {
+				if invite.Email != nil || invite.MaxUses != nil {
+					t.Errorf("invite = %+v, want it open to anyone", invite)
+				}
+				return
+			}
+			if invite.Email == nil || *invite.Email != tt.wantEmail || invite.MaxUses == nil || *invite.MaxUses != 1 {
+				t.Errorf("invite = %+v, want a single use invite for %s", invite, tt.wantEmail)
+			}
+		})
+	}
+}
+
+func TestGuildInviteService_AcceptInvite(t *testing.T) {
+	t.Parallel()
+
+	ada := "ada@example.com"
+	hour := 1
+	tests := []struct {
+		name     string
+		req      model.CreateGuildInviteRequest
+		accepted []string      // Users who accept the invite first
+		elapsed  time.Duration // Time between creating and accepting
+		revoked  bool
+		userID   string
+		typed    bool // Accept the code as someone might type it
+		wantErr  error
+		wantUses int
+	}{
+		{name: "typed code", userID: "user:bob", typed: true, wantUses: 1},
+		{name: "already joined", accepted: []string{"user:bob"}, userID: "user:bob", wantErr: ErrAlreadyGuildMember, wantUses: 1},
+		{name: "invitee", req: model.CreateGuildInviteRequest{Email: &ada}, userID: "user:ada", wantUses: 1},
+		{name: "someone else", req: model.CreateGuildInviteRequest{Email: &ada}, userID: "user:bob", wantErr: ErrNotInvitee},
+		{name: "used up", req: model.CreateGuildInviteRequest{Email: &ada}, accepted: []string{"user:ada"}, userID: "user:ada", wantErr: ErrGuildInviteExhausted, wantUses: 1},
+		{name: "expired", req: model.CreateGuildInviteRequest{ExpiresInHours: &hour}, elapsed: time.Hour, userID: "user:bob", wantErr: ErrGuildInviteExpired},
+		{name: "revoked", revoked: true, userID: "user:bob", wantErr: ErrGuildInviteNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			clk := fakeclock.New(guildInviteTestNow)
+			joiner := &fakeGuildJoiner{joined: map[string]bool{}}
+			svc := NewGuildInviteService(GuildInviteServiceConfig{
+				Repo:    memoryGuildInviteRepo(),
+				Guilds:  inviteGuildRepo(),
+				Users:   inviteUserRepo(),
+				Joiner:  joiner,
+				BaseURL: "https://saga.example/",
+				Clock:   clk,
+			})
+
+			invite, err := svc.CreateInvite(ctx, "user:admin", "guild:1", &tt.req)
+			if err != nil {
+				t.Fatalf("CreateInvite() error = %v", err)
+			}
+			for _, userID := range tt.accepted {
+				if _, err := svc.AcceptInvite(ctx, userID, invite.Code); err != nil {
+					t.Fatalf("AcceptInvite() for %s error = %v", userID, err)
+				}
+			}
+			if tt.revoked {
+				if err := svc.RevokeInvite(ctx, "user:admin", "guild:1", invite.ID); err != nil {
+					t.Fatalf("RevokeInvite() error = %v", err)
+				}
+			}
+			clk.Advance(tt.elapsed)
+
+			code := invite.Code
+			if tt.typed {
+				code = code[:4] + " " + strings.ToLower(code[4:])
+			}
+			guild, err := svc.AcceptInvite(ctx, tt.userID, code)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AcceptInvite() error = %v, want %v", err, tt.wantErr)
+			}
+			if invite.UseCount != tt.wantUses {
+				t.Errorf("uses = %d, want %d", invite.UseCount, tt.wantUses)
+			}
+			if tt.wantErr == nil && (guild.ID != "guild:1" || !joiner.joined[tt.userID]) {
+				t.Errorf("guild = %+v, joined = %v; want %s in guild:1", guild, joiner.joined, tt.userID)
+			}
+		})
+	}
+}
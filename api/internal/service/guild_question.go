@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildQuestionRepository defines the question storage used for guild questions
+type GuildQuestionRepository interface {
+	GetGuildQuestions(ctx context.Context, guildID string, includeInactive bool) ([]*model.Question, error)
+	GetQuestionByID(ctx context.Context, id string) (*model.Question, error)
+	CreateQuestion(ctx context.Context, question *model.Question) error
+	UpdateQuestion(ctx context.Context, id string, updates map[string]interface{}) (*model.Question, error)
+	DeleteQuestion(ctx context.Context, id string) error
+}
+
+// GuildAnswerRepository defines the storage interface for guild answers
+type GuildAnswerRepository interface {
+	Get(ctx context.Context, userID, questionID string) (*model.GuildAnswer, error)
+	GetByGuild(ctx context.Context, guildID, userID string) ([]*model.GuildAnswer, error)
+	Create(ctx context.Context, answer *model.GuildAnswer) error
+	UpdateSelection(ctx context.Context, userID, questionID, selectedOption string) (*model.GuildAnswer, error)
+	Delete(ctx context.Context, userID, questionID string) error
+	CountForQuestion(ctx context.Context, questionID string) (int, error)
+}
+
+// GuildQuestionGuildRepository provides guild membership checks for guild questions
+type GuildQuestionGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	IsGuildModerator(ctx context.Context, userID, guildID string) (bool, error)
+	GetMemberForUserInGuild(ctx context.Context, userID, guildID string) (*model.Member, error)
+}
+
+// GuildQuestionService handles guild-scoped onboarding questions.
+// Organizers (guild moderators and admins) manage the questions and can read
+// every member's answers; members see only their own answers.
+type GuildQuestionService struct {
+	questions GuildQuestionRepository
+	answers   GuildAnswerRepository
+	guildRepo GuildQuestionGuildRepository
+}
+
+// GuildQuestionServiceConfig holds configuration for the guild question service
+type GuildQuestionServiceConfig struct {
+	Questions GuildQuestionRepository
+	Answers   GuildAnswerRepository
+	GuildRepo GuildQuestionGuildRepository
+}
+
+// NewGuildQuestionService creates a new guild question service
+func NewGuildQuestionService(cfg GuildQuestionServiceConfig) *GuildQuestionService {
+	return &GuildQuestionService{
+		questions: cfg.Questions,
+		answers:   cfg.Answers,
+		guildRepo: cfg.GuildRepo,
+	}
+}
+
+// ListQuestions returns a guild's questions to a member.
+// Retired questions are only included for organizers.
+func (s *GuildQuestionService) ListQuestions(ctx context.Context, userID, guildID string, includeInactive bool) ([]*model.Question, error) {
+	if err := s.requireMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	if includeInactive {
+		organizer, err := s.guildRepo.IsGuildModerator(ctx, userID, guildID)
+		if err != nil {
+			return nil, err
+		}
+		includeInactive = organizer
+	}
+
+	return s.questions.GetGuildQuestions(ctx, guildID, includeInactive)
+}
+
+// CreateQuestion adds a question to a guild (organizers only)
+func (s *GuildQuestionService) CreateQuestion(ctx context.Context, userID, guildID string, req *model.CreateGuildQuestionRequest) (*model.Question, error) {
+	if err := s.requireOrganizer(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	if err := s.checkQuestionLimit(ctx, guildID); err != nil {
+		return nil, err
+	}
+
+	member, err := s.guildRepo.GetMemberForUserInGuild(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	question := &model.Question{
+		Text:      req.Text,
+		Category:  model.GuildQuestionCategory,
+		Options:   req.Options,
+		SortOrder: req.SortOrder,
+		Active:    true,
+		GuildID:   &guildID,
+	}
+	if member != nil {
+		question.CreatedBy = &member.ID
+	}
+
+	if err := s.questions.CreateQuestion(ctx, question); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+// UpdateQuestion updates a guild question (organizers only). Options that
+// members already selected cannot be removed.
+func (s *GuildQuestionService) UpdateQuestion(ctx context.Context, userID, guildID, questionID string, req *model.UpdateGuildQuestionRequest) (*model.Question, error) {
+	if err := s.requireOrganizer(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getGuildQuestion(ctx, guildID, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Text != nil {
+		updates["text"] = *req.Text
+	}
+	if req.Options != nil {
+		if removesOptions(existing.Options, req.Options) {
+			count, err := s.answers.CountForQuestion(ctx, questionID)
+			if err != nil {
+				return nil, err
+			}
+			if count > 0 {
+				return nil, ErrQuestionOptionInUse
+			}
+		}
+		updates["options"] = req.Options
+	}
+	if req.SortOrder != nil {
+		updates["sort_order"] = *req.SortOrder
+	}
+	if req.Active != nil {
+		if *req.Active && !existing.Active {
+			if err := s.checkQuestionLimit(ctx, guildID); err != nil {
+				return nil, err
+			}
+		}
+		updates["active"] = *req.Active
+	}
+
+	question, err := s.questions.UpdateQuestion(ctx, questionID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if question == nil {
+		return nil, ErrQuestionNotFound
+	}
+	return question, nil
+}
+
+// RemoveQuestion deletes a guild question, or retires it if members answered it
+func (s *GuildQuestionService) RemoveQuestion(ctx context.Context, userID, guildID, questionID string) (*model.ContentRemoval, error) {
+	if err := s.requireOrganizer(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	if _, err := s.getGuildQuestion(ctx, guildID, questionID); err != nil {
+		return nil, err
+	}
+
+	count, err := s.answers.CountForQuestion(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if count > 0 {
+		if _, err := s.questions.UpdateQuestion(ctx, questionID, map[string]interface{}{"active": false}); err != nil {
+			return nil, err
+		}
+		return &model.ContentRemoval{Deprecated: true, References: count}, nil
+	}
+
+	if err := s.questions.DeleteQuestion(ctx, questionID); err != nil {
+		return nil, err
+	}
+	return &model.ContentRemoval{Deleted: true}, nil
+}
+
+// AnswerQuestion creates or updates a member's answer to a guild question
+func (s *GuildQuestionService) AnswerQuestion(ctx context.Context, userID, guildID, questionID string, req *model.AnswerGuildQuestionRequest) (*model.GuildAnswer, error) {
+	if err := s.requireMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	question, err := s.getGuildQuestion(ctx, guildID, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if !question.Active {
+		return nil, ErrQuestionNotFound
+	}
+	if !isValidOption(question, req.SelectedOption) {
+		return nil, ErrInvalidOption
+	}
+
+	existing, err := s.answers.Get(ctx, userID, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return s.answers.UpdateSelection(ctx, userID, questionID, req.SelectedOption)
+	}
+
+	answer := &model.GuildAnswer{
+		GuildID:        guildID,
+		QuestionID:     questionID,
+		UserID:         userID,
+		SelectedOption: req.SelectedOption,
+	}
+	if err := s.answers.Create(ctx, answer); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			// Lost a race with a concurrent answer; apply ours on top
+			return s.answers.UpdateSelection(ctx, userID, questionID, req.SelectedOption)
+		}
+		return nil, err
+	}
+	return answer, nil
+}
+
+// DeleteAnswer removes a member's own answer to a guild question
+func (s *GuildQuestionService) DeleteAnswer(ctx context.Context, userID, guildID, questionID string) error {
+	if err := s.requireMember(ctx, userID, guildID); err != nil {
+		return err
+	}
+	if _, err := s.getGuildQuestion(ctx, guildID, questionID); err != nil {
+		return err
+	}
+
+	existing, err := s.answers.Get(ctx, userID, questionID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrAnswerNotFound
+	}
+	return s.answers.Delete(ctx, userID, questionID)
+}
+
+// GetMyAnswers returns the caller's own answers to a guild's questions
+func (s *GuildQuestionService) GetMyAnswers(ctx context.Context, userID, guildID string) ([]*model.GuildAnswer, error) {
+	if err := s.requireMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	return s.answers.GetByGuild(ctx, guildID, userID)
+}
+
+// GetAnswers returns members' answers to a guild's questions (organizers only),
+// optionally limited to one member
+func (s *GuildQuestionService) GetAnswers(ctx context.Context, userID, guildID, memberUserID string) ([]*model.GuildAnswer, error) {
+	if err := s.requireOrganizer(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	return s.answers.GetByGuild(ctx, guildID, memberUserID)
+}
+
+// ===== Helpers =====
+
+func (s *GuildQuestionService) requireMember(ctx context.Context, userID, guildID string) error {
+	isMember, err := s.guildRepo.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotGuildMember
+	}
+	return nil
+}
+
+func (s *GuildQuestionService) requireOrganizer(ctx context.Context, userID, guildID string) error {
+	organizer, err := s.guildRepo.IsGuildModerator(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !organizer {
+		return ErrNotGuildAdmin
+	}
+	return nil
+}
+
+// getGuildQuestion loads a question and checks it belongs to the guild
+func (s *GuildQuestionService) getGuildQuestion(ctx context.Context, guildID, questionID string) (*model.Question, error) {
+	question, err := s.questions.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if question == nil || question.GuildID == nil || *question.GuildID != guildID {
+		return nil, ErrQuestionNotFound
+	}
+	return question, nil
+}
+
+func (s *GuildQuestionService) checkQuestionLimit(ctx context.Context, guildID string) error {
+	active, err := s.questions.GetGuildQuestions(ctx, guildID, false)
+	if err != nil {
+		return err
+	}
+	if len(active) >= model.MaxGuildQuestions {
+		return ErrGuildQuestionLimit
+	}
+	return nil
+}
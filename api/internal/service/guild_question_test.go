@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// mockGuildQuestionRepo is an in-memory question store
+type mockGuildQuestionRepo struct {
+	questions map[string]*model.Question
+	deleted   []string
+}
+
+func (m *mockGuildQuestionRepo) GetGuildQuestions(ctx context.Context, guildID string, includeInactive bool) ([]*model.Question, error) {
+	var result []*model.Question
+	for _, q := range m.questions {
+		if q.GuildID != nil && *q.GuildID == guildID && (includeInactive || q.Active) {
+			result = append(result, q)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockGuildQuestionRepo) GetQuestionByID(ctx context.Context, id string) (*model.Question, error) {
+	return m.questions[id], nil
+}
+
+func (m *mockGuildQuestionRepo) CreateQuestion(ctx context.Context, question *model.Question) error {
+	question.ID = "question:new"
+	m.questions[question.ID] = question
+	return nil
+}
+
+func (m *mockGuildQuestionRepo) UpdateQuestion(ctx context.Context, id string, updates map[string]interface{}) (*model.Question, error) {
+	q := m.questions[id]
+	if active, ok := updates["active"].(bool); ok {
+		q.Active = active
+	}
+	if options, ok := updates["options"].([]model.QuestionOption); ok {
+		q.Options = options
+	}
+	return q, nil
+}
+
+func (m *mockGuildQuestionRepo) DeleteQuestion(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+// mockGuildAnswerRepo is an in-memory guild answer store keyed by user and question
+type mockGuildAnswerRepo struct {
+	answers map[string]*model.GuildAnswer
+}
+
+func (m *mockGuildAnswerRepo) Get(ctx context.Context, userID, questionID string) (*model.GuildAnswer, error) {
+	return m.answers[userID+"|"+questionID], nil
+}
+
+func (m *mockGuildAnswerRepo) GetByGuild(ctx context.Context, guildID, userID string) ([]*model.GuildAnswer, error) {
+	var result []*model.GuildAnswer
+	for _, a := range m.answers {
+		if a.GuildID == guildID && (userID == "" || a.UserID == userID) {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockGuildAnswerRepo) Create(ctx context.Context, answer *model.GuildAnswer) error {
+	m.answers[answer.UserID+"|"+answer.QuestionID] = answer
+	return nil
+}
+
+func (m *mockGuildAnswerRepo) UpdateSelection(ctx context.Context, userID, questionID, selectedOption string) (*model.GuildAnswer, error) {
+	a := m.answers[userID+"|"+questionID]
+	a.SelectedOption = selectedOption
+	return a, nil
+}
+
+func (m *mockGuildAnswerRepo) Delete(ctx context.Context, userID, questionID string) error {
+	delete(m.answers, userID+"|"+questionID)
+	return nil
+}
+
+func (m *mockGuildAnswerRepo) CountForQuestion(ctx context.Context, questionID string) (int, error) {
+	count := 0
+	for _, a := range m.answers {
+		if a.QuestionID == questionID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// mockGuildRoles assigns guild roles by user ID; users without a role are not members
+type mockGuildRoles map[string]model.GuildRole
+
+func (m mockGuildRoles) IsMember(ctx context.Context, userID, guildID string) (bool, error) {
+	_, ok := m[userID]
+	return ok, nil
+}
+
+func (m mockGuildRoles) IsGuildModerator(ctx context.Context, userID, guildID string) (bool, error) {
+	return m[userID].IsModerator(), nil
+}
+
+func (m mockGuildRoles) GetMemberForUserInGuild(ctx context.Context, userID, guildID string) (*model.Member, error) {
+	if _, ok := m[userID]; !ok {
+		return nil, nil
+	}
+	return &model.Member{ID: "member:" + userID}, nil
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+const testGuildID = "guild:1"
+
+func makeGuildQuestion(id, guildID string, options ...string) *model.Question {
+	q := makeQuestion(id, true, options...)
+	q.Category = model.GuildQuestionCategory
+	q.GuildID = &guildID
+	return q
+}
+
+func newTestGuildQuestionService(questions ...*model.Question) (*GuildQuestionService, *mockGuildQuestionRepo, *mockGuildAnswerRepo) {
+	qRepo := &mockGuildQuestionRepo{questions: make(map[string]*model.Question)}
+	for _, q := range questions {
+		qRepo.questions[q.ID] = q
+	}
+	aRepo := &mockGuildAnswerRepo{answers: make(map[string]*model.GuildAnswer)}
+
+	svc := NewGuildQuestionService(GuildQuestionServiceConfig{
+		Questions: qRepo,
+		Answers:   aRepo,
+		GuildRepo: mockGuildRoles{
+			"user:organizer": model.GuildRoleModerator,
+			"user:member":    model.GuildRoleMember,
+			"user:other":     model.GuildRoleMember,
+		},
+	})
+	return svc, qRepo, aRepo
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestGuildQuestionService_CreateQuestion(t *testing.T) {
+	svc, qRepo, _ := newTestGuildQuestionService()
+	ctx := context.Background()
+	req := &model.CreateGuildQuestionRequest{
+		Text:    "Which night works for you?",
+		Options: []model.QuestionOption{{Value: "tue", Label: "Tuesday"}, {Value: "thu", Label: "Thursday"}},
+	}
+
+	if _, err := svc.CreateQuestion(ctx, "user:member", testGuildID, req); !errors.Is(err, ErrNotGuildAdmin) {
+		t.Errorf("member: expected ErrNotGuildAdmin, got %v", err)
+	}
+
+	question, err := svc.CreateQuestion(ctx, "user:organizer", testGuildID, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if question.GuildID == nil || *question.GuildID != testGuildID {
+		t.Errorf("expected guild %s, got %v", testGuildID, question.GuildID)
+	}
+	if question.Category != model.GuildQuestionCategory {
+		t.Errorf("category = %q, want %q", question.Category, model.GuildQuestionCategory)
+	}
+	if question.CreatedBy == nil || *question.CreatedBy != "member:user:organizer" {
+		t.Errorf("unexpected created_by: %v", question.CreatedBy)
+	}
+
+	// Fill the guild up to the limit
+	for i := len(qRepo.questions); i < model.MaxGuildQuestions; i++ {
+		id := "question:" + string(rune('a'+i))
+		qRepo.questions[id] = makeGuildQuestion(id, testGuildID, "yes", "no")
+	}
+	if _, err := svc.CreateQuestion(ctx, "user:organizer", testGuildID, req); !errors.Is(err, ErrGuildQuestionLimit) {
+		t.Errorf("expected ErrGuildQuestionLimit, got %v", err)
+	}
+}
+
+func TestGuildQuestionService_AnswerQuestion(t *testing.T) {
+	svc, _, aRepo := newTestGuildQuestionService(
+		makeGuildQuestion("question:g1", testGuildID, "yes", "no"),
+		makeGuildQuestion("question:other", "guild:2", "yes", "no"),
+	)
+	ctx := context.Background()
+
+	if _, err := svc.AnswerQuestion(ctx, "user:stranger", testGuildID, "question:g1", &model.AnswerGuildQuestionRequest{SelectedOption: "yes"}); !errors.Is(err, ErrNotGuildMember) {
+		t.Errorf("non-member: expected ErrNotGuildMember, got %v", err)
+	}
+	if _, err := svc.AnswerQuestion(ctx, "user:member", testGuildID, "question:other", &model.AnswerGuildQuestionRequest{SelectedOption: "yes"}); !errors.Is(err, ErrQuestionNotFound) {
+		t.Errorf("other guild's question: expected ErrQuestionNotFound, got %v", err)
+	}
+	if _, err := svc.AnswerQuestion(ctx, "user:member", testGuildID, "question:g1", &model.AnswerGuildQuestionRequest{SelectedOption: "maybe"}); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("unknown option: expected ErrInvalidOption, got %v", err)
+	}
+
+	if _, err := svc.AnswerQuestion(ctx, "user:member", testGuildID, "question:g1", &model.AnswerGuildQuestionRequest{SelectedOption: "yes"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	answer, err := svc.AnswerQuestion(ctx, "user:member", testGuildID, "question:g1", &model.AnswerGuildQuestionRequest{SelectedOption: "no"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if answer.SelectedOption != "no" || len(aRepo.answers) != 1 {
+		t.Errorf("expected the answer to be updated in place, got %+v (%d stored)", answer, len(aRepo.answers))
+	}
+}
+
+func TestGuildQuestionService_AnswersVisibleToOrganizersOnly(t *testing.T) {
+	svc, _, _ := newTestGuildQuestionService(makeGuildQuestion("question:g1", testGuildID, "yes", "no"))
+	ctx := context.Background()
+
+	for _, userID := range []string{"user:member", "user:other"} {
+		if _, err := svc.AnswerQuestion(ctx, userID, testGuildID, "question:g1", &model.AnswerGuildQuestionRequest{SelectedOption: "yes"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if _, err := svc.GetAnswers(ctx, "user:member", testGuildID, ""); !errors.Is(err, ErrNotGuildAdmin) {
+		t.Errorf("member: expected ErrNotGuildAdmin, got %v", err)
+	}
+
+	all, err := svc.GetAnswers(ctx, "user:organizer", testGuildID, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("organizer sees %d answers, want 2", len(all))
+	}
+
+	mine, err := svc.GetMyAnswers(ctx, "user:member", testGuildID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mine) != 1 || mine[0].UserID != "user:member" {
+		t.Errorf("member should only see their own answer, got %+v", mine)
+	}
+}
+
+func TestGuildQuestionService_RemoveQuestion(t *testing.T) {
+	svc, qRepo, _ := newTestGuildQuestionService(
+		makeGuildQuestion("question:answered", testGuildID, "yes", "no"),
+		makeGuildQuestion("question:fresh", testGuildID, "yes", "no"),
+	)
+	ctx := context.Background()
+
+	if _, err := svc.AnswerQuestion(ctx, "user:member", testGuildID, "question:answered", &model.AnswerGuildQuestionRequest{SelectedOption: "yes"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Removing an answered option is rejected
+	_, err := svc.UpdateQuestion(ctx, "user:organizer", testGuildID, "question:answered", &model.UpdateGuildQuestionRequest{
+		Options: []model.QuestionOption{{Value: "no", Label: "No"}, {Value: "maybe", Label: "Maybe"}},
+	})
+	if !errors.Is(err, ErrQuestionOptionInUse) {
+		t.Errorf("expected ErrQuestionOptionInUse, got %v", err)
+	}
+
+	removal, err := svc.RemoveQuestion(ctx, "user:organizer", testGuildID, "question:answered")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !removal.Deprecated || removal.References != 1 || qRepo.questions["question:answered"].Active {
+		t.Errorf("answered question: got %+v, want retired", removal)
+	}
+
+	removal, err = svc.RemoveQuestion(ctx, "user:organizer", testGuildID, "question:fresh")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !removal.Deleted || len(qRepo.deleted) != 1 {
+		t.Errorf("unanswered question: got %+v, want deleted", removal)
+	}
+}
+
+func TestQuestionnaireService_IgnoresGuildQuestions(t *testing.T) {
+	guildQuestion := makeGuildQuestion("question:g1", testGuildID, "yes", "no")
+	repo := &mockQuestionnaireRepo{
+		getQuestionByIDFunc: func(ctx context.Context, id string) (*model.Question, error) {
+			return guildQuestion, nil
+		},
+	}
+	svc := NewQuestionnaireService(QuestionnaireServiceConfig{Repo: repo})
+	ctx := context.Background()
+
+	if _, err := svc.GetQuestion(ctx, "question:g1"); !errors.Is(err, ErrQuestionNotFound) {
+		t.Errorf("GetQuestion: expected ErrQuestionNotFound, got %v", err)
+	}
+	if _, err := svc.AnswerQuestion(ctx, "user:member", "question:g1", &model.AnswerQuestionRequest{SelectedOption: "yes"}); !errors.Is(err, ErrQuestionNotFound) {
+		t.Errorf("AnswerQuestion: expected ErrQuestionNotFound, got %v", err)
+	}
+}
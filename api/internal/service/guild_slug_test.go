@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// mockGuildSlugRepo keeps guilds and released slugs in memory
+type mockGuildSlugRepo struct {
+	guilds    map[string]*model.Guild
+	redirects map[string]*model.GuildSlugRedirect
+	clock     *fakeclock.Clock
+}
+
+func newMockGuildSlugRepo(now time.Time, guilds ...*model.Guild) *mockGuildSlugRepo {
+	repo := &mockGuildSlugRepo{
+		guilds:    make(map[string]*model.Guild),
+		redirects: make(map[string]*model.GuildSlugRedirect),
+		clock:     fakeclock.New(now),
+	}
+	for _, g := range guilds {
+		repo.guilds[g.ID] = g
+	}
+	return repo
+}
+
+func (m *mockGuildSlugRepo) GetBySlug(ctx context.Context, slug string) (*model.Guild, error) {
+	for _, g := range m.guilds {
+		if g.Slug == slug {
+			return g, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockGuildSlugRepo) GetSlugRedirect(ctx context.Context, slug string) (*model.GuildSlugRedirect, error) {
+	return m.redirects[slug], nil
+}
+
+func (m *mockGuildSlugRepo) ChangeSlug(ctx context.Context, guildID, previous, slug string) error {
+	delete(m.redirects, slug)
+	if previous != "" && previous != slug {
+		m.redirects[previous] = &model.GuildSlugRedirect{Slug: previous, GuildID: guildID, ReleasedOn: m.clock.Now()}
+	}
+	return nil
+}
+
+// nonAdminGuildRepo is a guild repo where the caller is a plain member
+type nonAdminGuildRepo struct {
+	*mockGuildRepo
+}
+
+func (m *nonAdminGuildRepo) IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error) {
+	return false, nil
+}
+
+func newSlugTestService(guildRepo GuildRepository, slugs *mockGuildSlugRepo) *GuildService {
+	return NewGuildService(GuildServiceConfig{
+		GuildRepo:  guildRepo,
+		MemberRepo: &mockMemberRepo{},
+		UserRepo:   newMockUserRepo(),
+		SlugRepo:   slugs,
+		Clock:      slugs.clock,
+	})
+}
+
+func slugGuildRepo(slugs *mockGuildSlugRepo) *mockGuildRepo {
+	return &mockGuildRepo{
+		getByIDFunc: func(ctx context.Context, id string) (*model.Guild, error) {
+			return slugs.guilds[id], nil
+		},
+		isMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return true, nil
+		},
+	}
+}
+
+// ============================================================================
+// pickSlug Tests
+// ============================================================================
+
+func TestPickSlug_DerivesFromNameWithSuffix(t *testing.T) {
+	t.Parallel()
+
+	slugs := newMockGuildSlugRepo(time.Now(),
+		&model.Guild{ID: "guild:1", Slug: "board-games"},
+		&model.Guild{ID: "guild:2", Slug: "board-games-2"},
+	)
+	svc := newSlugTestService(slugGuildRepo(slugs), slugs)
+
+	slug, err := svc.pickSlug(context.Background(), "Board Games!", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if slug != "board-games-3" {
+		t.Errorf("expected board-games-3, got %q", slug)
+	}
+}
+
+func TestPickSlug_RequestedSlugTaken(t *testing.T) {
+	t.Parallel()
+
+	slugs := newMockGuildSlugRepo(time.Now(), &model.Guild{ID: "guild:1", Slug: "chess-club"})
+	svc := newSlugTestService(slugGuildRepo(slugs), slugs)
+
+	if _, err := svc.pickSlug(context.Background(), "Chess", "chess-club"); !errors.Is(err, ErrGuildSlugTaken) {
+		t.Errorf("expected ErrGuildSlugTaken, got %v", err)
+	}
+}
+
+// ============================================================================
+// UpdateGuild Slug Tests
+// ============================================================================
+
+func TestUpdateGuild_SlugChangeRequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	slugs := newMockGuildSlugRepo(time.Now(), &model.Guild{ID: "guild:1", Slug: "chess-club"})
+	svc := newSlugTestService(&nonAdminGuildRepo{slugGuildRepo(slugs)}, slugs)
+
+	slug := "chess-society"
+	_, err := svc.UpdateGuild(context.Background(), "user:1", "guild:1", UpdateGuildRequest{Slug: &slug})
+	if !errors.Is(err, ErrNotGuildAdmin) {
+		t.Errorf("expected ErrNotGuildAdmin, got %v", err)
+	}
+}
+
+func TestUpdateGuild_ReleasedSlugReservedAndRedirects(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	slugs := newMockGuildSlugRepo(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		&model.Guild{ID: "guild:1", Slug: "chess-club"},
+		&model.Guild{ID: "guild:2", Slug: "go-club"},
+	)
+	svc := newSlugTestService(slugGuildRepo(slugs), slugs)
+
+	renamed := "chess-society"
+	guild, err := svc.UpdateGuild(ctx, "user:1", "guild:1", UpdateGuildRequest{Slug: &renamed})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if guild.Slug != "chess-society" {
+		t.Errorf("expected new slug, got %q", guild.Slug)
+	}
+
+	for _, slug := range []string{"chess-society", "chess-club"} {
+		guildID, err := svc.ResolveSlug(ctx, slug)
+		if err != nil || guildID != "guild:1" {
+			t.Errorf("%s: expected guild:1, got %q (%v)", slug, guildID, err)
+		}
+	}
+
+	old := "chess-club"
+	if _, err := svc.UpdateGuild(ctx, "user:2", "guild:2", UpdateGuildRequest{Slug: &old}); !errors.Is(err, ErrGuildSlugTaken) {
+		t.Errorf("expected released slug to stay reserved, got %v", err)
+	}
+
+	slugs.clock.Advance(model.GuildSlugReservationPeriod)
+	if _, err := svc.UpdateGuild(ctx, "user:2", "guild:2", UpdateGuildRequest{Slug: &old}); err != nil {
+		t.Errorf("expected released slug to be claimable after reservation, got %v", err)
+	}
+
+	if _, err := svc.ResolveSlug(ctx, "no-such-guild"); !errors.Is(err, ErrGuildNotFound) {
+		t.Errorf("expected ErrGuildNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// UpdateGuild Version Tests
+// ============================================================================
+
+func TestUpdateGuild_StaleVersionConflicts(t *testing.T) {
+	t.Parallel()
+
+	slugs := newMockGuildSlugRepo(time.Now(), &model.Guild{ID: "guild:1", Name: "Chess", Version: 5})
+	guilds := slugGuildRepo(slugs)
+	guilds.updateFunc = func(ctx context.Context, guild *model.Guild, version *int) (*model.Guild, error) {
+		t.Error("expected no update for a stale version")
+		return guild, nil
+	}
+	svc := newSlugTestService(guilds, slugs)
+
+	stale := 4
+	name := "Chess Club"
+	_, err := svc.UpdateGuild(context.Background(), "user:1", "guild:1", UpdateGuildRequest{Name: &name, Version: &stale})
+
+	var conflict *VersionConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected VersionConflictError, got %v", err)
+	}
+	if current, ok := conflict.Current.(*model.Guild); !ok || current.Name != "Chess" {
+		t.Errorf("expected the current guild, got %+v", conflict.Current)
+	}
+}
+
+func TestUpdateGuild_LostRaceConflicts(t *testing.T) {
+	t.Parallel()
+
+	slugs := newMockGuildSlugRepo(time.Now(), &model.Guild{ID: "guild:1", Name: "Chess", Version: 5})
+	guilds := slugGuildRepo(slugs)
+	var gotVersion *int
+	guilds.updateFunc = func(ctx context.Context, guild *model.Guild, version *int) (*model.Guild, error) {
+		gotVersion = version
+		return nil, nil // Another edit landed first
+	}
+	svc := newSlugTestService(guilds, slugs)
+
+	version := 5
+	name := "Chess Club"
+	_, err := svc.UpdateGuild(context.Background(), "user:1", "guild:1", UpdateGuildRequest{Name: &name, Version: &version})
+
+	if !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("expected ErrVersionConflict, got %v", err)
+	}
+	if gotVersion == nil || *gotVersion != 5 {
+		t.Errorf("expected the update to be conditioned on version 5, got %v", gotVersion)
+	}
+}
+
+// ============================================================================
+// UpdateGuild Embed Tests
+// ============================================================================
+
+func TestUpdateGuild_EmbedToggleRequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	slugs := newMockGuildSlugRepo(time.Now(), &model.Guild{ID: "guild:1", Name: "Chess"})
+	guilds := slugGuildRepo(slugs)
+	guilds.updateFunc = func(ctx context.Context, guild *model.Guild, version *int) (*model.Guild, error) {
+		t.Error("expected no update from a non-admin")
+		return guild, nil
+	}
+	svc := newSlugTestService(&nonAdminGuildRepo{guilds}, slugs)
+
+	enabled := true
+	_, err := svc.UpdateGuild(context.Background(), "user:1", "guild:1", UpdateGuildRequest{EmbedEnabled: &enabled})
+	if !errors.Is(err, ErrNotGuildAdmin) {
+		t.Errorf("expected ErrNotGuildAdmin, got %v", err)
+	}
+}
+
+// ============================================================================
+// CreateGuild Duplicate Tests
+// ============================================================================
+
+func TestCreateGuild_WarnsAboutLookalikes(t *testing.T) {
+	t.Parallel()
+
+	created := false
+	guilds := &mocks.GuildRepository{
+		GetGuildsForUserFunc: func(ctx context.Context, userID string) ([]*model.Guild, error) {
+			return []*model.Guild{
+				{ID: "guild:1", Name: "Tuesday Hikers"},
+				{ID: "guild:2", Name: "Book Club"},
+			}, nil
+		},
+		CreateFunc: func(ctx context.Context, guild *model.Guild) error {
+			guild.ID = "guild:new"
+			created = true
+			return nil
+		},
+	}
+	users := &mocks.UserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+			return &model.User{ID: id}, nil
+		},
+	}
+	members := &mocks.MemberRepository{
+		GetOrCreateFunc: func(ctx context.Context, userID, name, email string) (*model.Member, error) {
+			return &model.Member{ID: "member:1"}, nil
+		},
+	}
+	svc := NewGuildService(GuildServiceConfig{GuildRepo: guilds, MemberRepo: members, UserRepo: users})
+	ctx := context.Background()
+
+	_, err := svc.CreateGuild(ctx, "user:1", CreateGuildRequest{Name: "hikers tuesday"})
+	var duplicate *DuplicateError
+	if !errors.As(err, &duplicate) {
+		t.Fatalf("expected a DuplicateError, got %v", err)
+	}
+	if len(duplicate.Candidates) != 1 || duplicate.Candidates[0].ID != "guild:1" {
+		t.Errorf("candidates = %+v, want only guild:1", duplicate.Candidates)
+	}
+	if created {
+		t.Error("expected no guild created before confirming")
+	}
+
+	if _, err := svc.CreateGuild(ctx, "user:1", CreateGuildRequest{Name: "hikers tuesday", ConfirmDuplicate: true}); err != nil {
+		t.Fatalf("confirmed CreateGuild() error = %v", err)
+	}
+	if !created {
+		t.Error("expected the confirmed guild to be created")
+	}
+}
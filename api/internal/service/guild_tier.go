@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildTierRepository defines the interface for guild tier storage
+type GuildTierRepository interface {
+	CreateTier(ctx context.Context, tier *model.GuildTier) error
+	GetTier(ctx context.Context, tierID string) (*model.GuildTier, error)
+	ListTiers(ctx context.Context, guildID string) ([]*model.GuildTier, error)
+	CountTiers(ctx context.Context, guildID string) (int, error)
+	UpdateTier(ctx context.Context, tierID string, updates map[string]interface{}) (*model.GuildTier, error)
+	DeleteTier(ctx context.Context, tierID string) error
+	CountTierUses(ctx context.Context, tierID string) (int, error)
+
+	AssignTier(ctx context.Context, assignment *model.GuildTierAssignment) error
+	GetAssignment(ctx context.Context, guildID, userID string) (*model.GuildTierAssignment, error)
+	ListAssignments(ctx context.Context, tierID string, now time.Time) ([]*model.GuildTierAssignment, error)
+	RemoveAssignment(ctx context.Context, guildID, userID string) (bool, error)
+}
+
+// GuildTierGuildRepository provides the guild membership checks tiers need
+type GuildTierGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error)
+}
+
+// GuildTierService manages guild membership tiers and enforces the perks
+// they gate: exclusive events and pools, and early RSVP windows. Guild
+// admins define tiers and hand them out; members can see them.
+type GuildTierService struct {
+	repo   GuildTierRepository
+	guilds GuildTierGuildRepository
+	clock  clock.Clock
+}
+
+// GuildTierServiceConfig holds configuration for the guild tier service
+type GuildTierServiceConfig struct {
+	Repo   GuildTierRepository
+	Guilds GuildTierGuildRepository
+	Clock  clock.Clock // Default: the system clock
+}
+
+// NewGuildTierService creates a new guild tier service
+func NewGuildTierService(cfg GuildTierServiceConfig) *GuildTierService {
+	return &GuildTierService{
+		repo:   cfg.Repo,
+		guilds: cfg.Guilds,
+		clock:  clock.OrReal(cfg.Clock),
+	}
+}
+
+// ============================================================================
+// Tiers
+// ============================================================================
+
+// ListTiers returns a guild's tiers, highest ranked first (members only)
+func (s *GuildTierService) ListTiers(ctx context.Context, userID, guildID string) ([]*model.GuildTier, error) {
+	if _, err := s.checkGuildAccess(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListTiers(ctx, guildID)
+}
+
+// CreateTier adds a tier to a guild (admin only)
+func (s *GuildTierService) CreateTier(ctx context.Context, userID, guildID string, req *model.CreateGuildTierRequest) (*model.GuildTier, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountTiers(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= model.MaxTiersPerGuild {
+		return nil, ErrTooManyTiers
+	}
+
+	tier := &model.GuildTier{
+		GuildID:        guildID,
+		Key:            req.Key,
+		Name:           req.Name,
+		Description:    req.Description,
+		Rank:           req.Rank,
+		EarlyRSVPHours: req.EarlyRSVPHours,
+	}
+	if err := s.repo.CreateTier(ctx, tier); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrTierExists
+		}
+		return nil, err
+	}
+	return tier, nil
+}
+
+// UpdateTier changes a tier's name, description, rank, or early access
+// (admin only). Rank changes apply to gated events and pools at once.
+func (s *GuildTierService) UpdateTier(ctx context.Context, userID, guildID, tierID string, req *model.UpdateGuildTierRequest) (*model.GuildTier, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	if _, err := s.guildTier(ctx, guildID, tierID); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.Rank != nil {
+		updates["rank"] = *req.Rank
+	}
+	if req.EarlyRSVPHours != nil {
+		updates["early_rsvp_hours"] = *req.EarlyRSVPHours
+	}
+
+	tier, err := s.repo.UpdateTier(ctx, tierID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if tier == nil {
+		return nil, ErrTierNotFound
+	}
+	return tier, nil
+}
+
+// DeleteTier deletes a tier and takes it from its holders (admin only).
+// Tiers still gating upcoming events or pools can't be deleted, as that
+// would lock everyone out of them.
+func (s *GuildTierService) DeleteTier(ctx context.Context, userID, guildID, tierID string) error {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return err
+	}
+	if _, err := s.guildTier(ctx, guildID, tierID); err != nil {
+		return err
+	}
+
+	uses, err := s.repo.CountTierUses(ctx, tierID)
+	if err != nil {
+		return err
+	}
+	if uses > 0 {
+		return ErrTierInUse
+	}
+	return s.repo.DeleteTier(ctx, tierID)
+}
+
+// ============================================================================
+// Assignments
+// ============================================================================
+
+// AssignTier gives a guild member a tier, replacing the one they hold
+// (admin only)
+func (s *GuildTierService) AssignTier(ctx context.Context, userID, guildID, memberUserID string, req *model.AssignGuildTierRequest) (*model.GuildTierAssignment, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	tier, err := s.guildTier(ctx, guildID, req.TierID)
+	if err != nil {
+		return nil, err
+	}
+	if req.ExpiresOn != nil && !req.ExpiresOn.After(s.clock.Now()) {
+		return nil, model.NewValidationError([]model.FieldError{{Field: "expires_on", Message: "expires_on must be in the future"}})
+	}
+
+	isMember, err := s.guilds.IsMember(ctx, memberUserID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, ErrTierHolderNotMember
+	}
+
+	assignment := &model.GuildTierAssignment{
+		GuildID:    guildID,
+		UserID:     memberUserID,
+		TierID:     tier.ID,
+		AssignedBy: userID,
+		ExpiresOn:  req.ExpiresOn,
+	}
+	if err := s.repo.AssignTier(ctx, assignment); err != nil {
+		return nil, err
+	}
+	assignment.Tier = tier
+	return assignment, nil
+}
+
+// GetMemberTier returns the tier a guild member holds (members only)
+func (s *GuildTierService) GetMemberTier(ctx context.Context, userID, guildID, memberUserID string) (*model.GuildTierAssignment, error) {
+	if _, err := s.checkGuildAccess(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.repo.GetAssignment(ctx, guildID, memberUserID)
+	if err != nil {
+		return nil, err
+	}
+	if assignment == nil || !assignment.ActiveAt(s.clock.Now()) {
+		return nil, ErrTierAssignmentNotFound
+	}
+	return assignment, nil
+}
+
+// RemoveMemberTier takes a member's tier away (admin only)
+func (s *GuildTierService) RemoveMemberTier(ctx context.Context, userID, guildID, memberUserID string) error {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return err
+	}
+
+	removed, err := s.repo.RemoveAssignment(ctx, guildID, memberUserID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrTierAssignmentNotFound
+	}
+	return nil
+}
+
+// ListTierMembers returns who holds a tier (admin only)
+func (s *GuildTierService) ListTierMembers(ctx context.Context, userID, guildID, tierID string) ([]*model.GuildTierAssignment, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	if _, err := s.guildTier(ctx, guildID, tierID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListAssignments(ctx, tierID, s.clock.Now())
+}
+
+// ============================================================================
+// Enforcement
+// ============================================================================
+
+// ValidateTier checks that tierID is one of the guild's tiers, for gating
+// an event or pool on it
+func (s *GuildTierService) ValidateTier(ctx context.Context, guildID, tierID string) error {
+	_, err := s.guildTier(ctx, guildID, tierID)
+	return err
+}
+
+// HeldTier returns the tier a user holds in a guild at now, or nil if they
+// hold none
+func (s *GuildTierService) HeldTier(ctx context.Context, guildID, userID string, now time.Time) (*model.GuildTier, error) {
+	assignment, err := s.repo.GetAssignment(ctx, guildID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if assignment == nil || !assignment.ActiveAt(now) {
+		return nil, nil
+	}
+	return assignment.Tier, nil
+}
+
+// CheckEventTier enforces an event's minimum tier on a new RSVP, returning
+// the tier the user holds in the event's guild at now (nil if none) so the
+// caller can work out their early access
+func (s *GuildTierService) CheckEventTier(ctx context.Context, event *model.Event, userID string, now time.Time) (*model.GuildTier, error) {
+	if event.GuildID == nil {
+		return nil, nil
+	}
+
+	held, err := s.HeldTier(ctx, *event.GuildID, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	if event.MinTier != nil {
+		if err := s.requireTier(ctx, *event.MinTier, held); err != nil {
+			return nil, err
+		}
+	}
+	return held, nil
+}
+
+// CheckPoolJoin enforces a pool's minimum tier on a user joining it
+func (s *GuildTierService) CheckPoolJoin(ctx context.Context, pool *model.MatchingPool, userID string, now time.Time) error {
+	if pool.MinTier == nil {
+		return nil
+	}
+
+	held, err := s.HeldTier(ctx, string(pool.GuildID), userID, now)
+	if err != nil {
+		return err
+	}
+	return s.requireTier(ctx, *pool.MinTier, held)
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// requireTier returns ErrTierRequired unless held includes the tier. A
+// gate on a tier that no longer exists stays closed.
+func (s *GuildTierService) requireTier(ctx context.Context, tierID string, held *model.GuildTier) error {
+	required, err := s.repo.GetTier(ctx, tierID)
+	if err != nil {
+		return err
+	}
+	if required == nil || !held.Includes(required) {
+		return ErrTierRequired
+	}
+	return nil
+}
+
+// guildTier returns one of a guild's tiers
+func (s *GuildTierService) guildTier(ctx context.Context, guildID, tierID string) (*model.GuildTier, error) {
+	tier, err := s.repo.GetTier(ctx, tierID)
+	if err != nil {
+		return nil, err
+	}
+	if tier == nil || tier.GuildID != guildID {
+		return nil, ErrTierNotFound
+	}
+	return tier, nil
+}
+
+// checkGuildAccess requires guild membership and reports whether the user
+// is a guild admin
+func (s *GuildTierService) checkGuildAccess(ctx context.Context, userID, guildID string) (bool, error) {
+	isMember, err := s.guilds.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return false, fmt.Errorf("checking guild membership: %w", err)
+	}
+	if !isMember {
+		return false, ErrNotGuildMember
+	}
+	return s.guilds.IsGuildAdmin(ctx, userID, guildID)
+}
+
+func (s *GuildTierService) requireGuildAdmin(ctx context.Context, userID, guildID string) error {
+	isAdmin, err := s.checkGuildAccess(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrNotGuildAdmin
+	}
+	return nil
+}
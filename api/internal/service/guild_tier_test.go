@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Guild Tier Tests
+// ============================================================================
+
+var tierTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// tierFixture has a supporter and a patron tier in guild:1. holders maps
+// user IDs to the tier they hold.
+func tierFixture(holders map[string]*model.GuildTier) (*GuildTierService, *mocks.GuildTierRepository) {
+	tiers := map[string]*model.GuildTier{
+		"guild_tier:supporter": {ID: "guild_tier:supporter", GuildID: "guild:1", Key: "supporter", Rank: 10, EarlyRSVPHours: 24},
+		"guild_tier:patron":    {ID: "guild_tier:patron", GuildID: "guild:1", Key: "patron", Rank: 20, EarlyRSVPHours: 72},
+	}
+	repo := &mocks.GuildTierRepository{
+		GetTierFunc: func(ctx context.Context, tierID string) (*model.GuildTier, error) {
+			return tiers[tierID], nil
+		},
+		GetAssignmentFunc: func(ctx context.Context, guildID, userID string) (*model.GuildTierAssignment, error) {
+			tier := holders[userID]
+			if tier == nil {
+				return nil, nil
+			}
+			return &model.GuildTierAssignment{GuildID: guildID, UserID: userID, TierID: tier.ID, Tier: tier}, nil
+		},
+	}
+	guilds := &mocks.GuildTierGuildRepository{
+		IsMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return userID != "user:outsider", nil
+		},
+		IsGuildAdminFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return userID == "user:admin", nil
+		},
+	}
+	return NewGuildTierService(GuildTierServiceConfig{Repo: repo, Guilds: guilds, Clock: fakeclock.New(tierTestNow)}), repo
+}
+
+func TestCheckEventTier_RequiresTier(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := tierFixture(map[string]*model.GuildTier{
+		"user:supporter": {ID: "guild_tier:supporter", Rank: 10},
+		"user:patron":    {ID: "guild_tier:patron", Rank: 20},
+	})
+	guildID := "guild:1"
+	minTier := "guild_tier:supporter"
+	event := &model.Event{ID: "event:1", GuildID: &guildID, MinTier: &minTier}
+	now := tierTestNow
+
+	if _, err := svc.CheckEventTier(context.Background(), event, "user:member", now); !errors.Is(err, ErrTierRequired) {
+		t.Errorf("expected ErrTierRequired without a tier, got %v", err)
+	}
+	if _, err := svc.CheckEventTier(context.Background(), event, "user:supporter", now); err != nil {
+		t.Errorf("expected the tier to qualify, got %v", err)
+	}
+	held, err := svc.CheckEventTier(context.Background(), event, "user:patron", now)
+	if err != nil {
+		t.Errorf("expected a higher tier to qualify, got %v", err)
+	}
+	if held == nil || held.ID != "guild_tier:patron" {
+		t.Errorf("expected the held tier back, got %+v", held)
+	}
+}
+
+func TestCheckEventTier_LapsedTier(t *testing.T) {
+	t.Parallel()
+
+	svc, repo := tierFixture(nil)
+	now := tierTestNow
+	lapsed := now.Add(-time.Hour)
+	repo.GetAssignmentFunc = func(ctx context.Context, guildID, userID string) (*model.GuildTierAssignment, error) {
+		return &model.GuildTierAssignment{
+			TierID:    "guild_tier:patron",
+			ExpiresOn: &lapsed,
+			Tier:      &model.GuildTier{ID: "guild_tier:patron", Rank: 20},
+		}, nil
+	}
+	guildID := "guild:1"
+	minTier := "guild_tier:supporter"
+	event := &model.Event{ID: "event:1", GuildID: &guildID, MinTier: &minTier}
+
+	if _, err := svc.CheckEventTier(context.Background(), event, "user:member", now); !errors.Is(err, ErrTierRequired) {
+		t.Errorf("expected a lapsed tier not to qualify, got %v", err)
+	}
+}
+
+func TestCheckPoolJoin_RequiresTier(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := tierFixture(map[string]*model.GuildTier{
+		"user:patron": {ID: "guild_tier:patron", Rank: 20},
+	})
+	minTier := "guild_tier:patron"
+	pool := &model.MatchingPool{ID: "matching_pool:1", GuildID: "guild:1", MinTier: &minTier}
+
+	if err := svc.CheckPoolJoin(context.Background(), pool, "user:member", tierTestNow); !errors.Is(err, ErrTierRequired) {
+		t.Errorf("expected ErrTierRequired, got %v", err)
+	}
+	if err := svc.CheckPoolJoin(context.Background(), pool, "user:patron", tierTestNow); err != nil {
+		t.Errorf("expected the tier to qualify, got %v", err)
+	}
+	if err := svc.CheckPoolJoin(context.Background(), &model.MatchingPool{GuildID: "guild:1"}, "user:member", tierTestNow); err != nil {
+		t.Errorf("expected an ungated pool to be open, got %v", err)
+	}
+}
+
+func TestDeleteTier_InUse(t *testing.T) {
+	t.Parallel()
+
+	svc, repo := tierFixture(nil)
+	repo.CountTierUsesFunc = func(ctx context.Context, tierID string) (int, error) {
+		return 1, nil
+	}
+	repo.DeleteTierFunc = func(ctx context.Context, tierID string) error {
+		t.Error("expected a tier in use not to be deleted")
+		return nil
+	}
+
+	err := svc.DeleteTier(context.Background(), "user:admin", "guild:1", "guild_tier:supporter")
+	if !errors.Is(err, ErrTierInUse) {
+		t.Errorf("expected ErrTierInUse, got %v", err)
+	}
+}
+
+func TestAssignTier(t *testing.T) {
+	t.Parallel()
+
+	svc, repo := tierFixture(nil)
+	var assigned *model.GuildTierAssignment
+	repo.AssignTierFunc = func(ctx context.Context, assignment *model.GuildTierAssignment) error {
+		assigned = assignment
+		return nil
+	}
+	req := &model.AssignGuildTierRequest{TierID: "guild_tier:patron"}
+
+	if _, err := svc.AssignTier(context.Background(), "user:member", "guild:1", "user:fan", req); !errors.Is(err, ErrNotGuildAdmin) {
+		t.Errorf("expected ErrNotGuildAdmin, got %v", err)
+	}
+	if _, err := svc.AssignTier(context.Background(), "user:admin", "guild:1", "user:outsider", req); !errors.Is(err, ErrTierHolderNotMember) {
+		t.Errorf("expected ErrTierHolderNotMember, got %v", err)
+	}
+	expired := tierTestNow.Add(-time.Minute)
+	lapsed := &model.AssignGuildTierRequest{TierID: "guild_tier:patron", ExpiresOn: &expired}
+	var pd *model.ProblemDetails
+	if _, err := svc.AssignTier(context.Background(), "user:admin", "guild:1", "user:fan", lapsed); !errors.As(err, &pd) || pd.Status != 422 {
+		t.Errorf("expected a validation error for a past expiry, got %v", err)
+	}
+	other := &model.AssignGuildTierRequest{TierID: "guild_tier:elsewhere"}
+	if _, err := svc.AssignTier(context.Background(), "user:admin", "guild:1", "user:fan", other); !errors.Is(err, ErrTierNotFound) {
+		t.Errorf("expected ErrTierNotFound, got %v", err)
+	}
+
+	assignment, err := svc.AssignTier(context.Background(), "user:admin", "guild:1", "user:fan", req)
+	if err != nil {
+		t.Fatalf("AssignTier: %v", err)
+	}
+	if assigned == nil || assigned.UserID != "user:fan" || assigned.AssignedBy != "user:admin" {
+		t.Errorf("expected the tier assigned to user:fan by user:admin, got %+v", assigned)
+	}
+	if assignment.Tier == nil || assignment.Tier.Key != "patron" {
+		t.Errorf("expected the assignment to carry its tier, got %+v", assignment.Tier)
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// HandleRepository defines the user storage needed for handles
+type HandleRepository interface {
+	GetByID(ctx context.Context, id string) (*model.User, error)
+	GetByUsername(ctx context.Context, handle string) (*model.User, error)
+	GetHandleRedirect(ctx context.Context, handle string) (*model.HandleRedirect, error)
+	ChangeUsername(ctx context.Context, userID string, previous *string, handle string) error
+}
+
+// HandleService manages user handles (usernames): claiming, rate-limited
+// changes, and resolving current and historical handles to users
+type HandleService struct {
+	repo  HandleRepository
+	clock clock.Clock
+}
+
+// HandleServiceConfig holds configuration for the handle service
+type HandleServiceConfig struct {
+	Repo  HandleRepository
+	Clock clock.Clock // Default: the system clock
+}
+
+// NewHandleService creates a new handle service
+func NewHandleService(cfg HandleServiceConfig) *HandleService {
+	return &HandleService{
+		repo:  cfg.Repo,
+		clock: clock.OrReal(cfg.Clock),
+	}
+}
+
+// SetHandle claims a handle for the user. The first handle is free; after that
+// changes are limited to one per HandleChangeCooldown. The released handle
+// keeps redirecting to the user and is held for them for
+// HandleReservationPeriod.
+func (s *HandleService) SetHandle(ctx context.Context, userID, handle string) (*model.User, error) {
+	handle = strings.TrimPrefix(strings.TrimSpace(handle), model.HandlePrefix)
+	if len(model.ValidateHandle(handle)) > 0 {
+		return nil, ErrInvalidHandle
+	}
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if user.Username != nil && *user.Username == handle {
+		return user, nil
+	}
+
+	if next := NextHandleChange(user); next != nil && s.clock.Now().Before(*next) {
+		return nil, ErrHandleChangeTooSoon
+	}
+
+	if err := s.checkAvailable(ctx, userID, handle); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.ChangeUsername(ctx, userID, user.Username, handle); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrHandleTaken
+		}
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	user.Username = &handle
+	user.UsernameChangedOn = &now
+	return user, nil
+}
+
+// checkAvailable rejects handles held by another user, either currently or as
+// a redirect still inside its reservation period
+func (s *HandleService) checkAvailable(ctx context.Context, userID, handle string) error {
+	holder, err := s.repo.GetByUsername(ctx, handle)
+	if err != nil {
+		return err
+	}
+	if holder != nil && holder.ID != userID {
+		return ErrHandleTaken
+	}
+
+	redirect, err := s.repo.GetHandleRedirect(ctx, handle)
+	if err != nil {
+		return err
+	}
+	if redirect != nil && redirect.UserID != userID &&
+		s.clock.Now().Before(redirect.ReleasedOn.Add(model.HandleReservationPeriod)) {
+		return ErrHandleTaken
+	}
+	return nil
+}
+
+// ResolveHandle returns the ID of the user a handle refers to. Current handles
+// win; otherwise a released handle resolves to the user who last held it.
+func (s *HandleService) ResolveHandle(ctx context.Context, handle string) (string, error) {
+	user, err := s.repo.GetByUsername(ctx, handle)
+	if err != nil {
+		return "", err
+	}
+	if user != nil {
+		return user.ID, nil
+	}
+
+	redirect, err := s.repo.GetHandleRedirect(ctx, handle)
+	if err != nil {
+		return "", err
+	}
+	if redirect == nil {
+		return "", ErrHandleNotFound
+	}
+	return redirect.UserID, nil
+}
+
+// ResolveUserRef resolves a path reference that is either a user ID or an
+// @handle to a user ID
+func (s *HandleService) ResolveUserRef(ctx context.Context, ref string) (string, error) {
+	if !strings.HasPrefix(ref, model.HandlePrefix) {
+		return ref, nil
+	}
+	return s.ResolveHandle(ctx, ref)
+}
+
+// NextHandleChange returns when the user may next change their handle, or nil
+// if they may change it now because they have never set one
+func NextHandleChange(user *model.User) *time.Time {
+	if user.Username == nil || user.UsernameChangedOn == nil {
+		return nil
+	}
+	next := user.UsernameChangedOn.Add(model.HandleChangeCooldown)
+	return &next
+}
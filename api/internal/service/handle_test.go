@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// mockHandleRepo keeps users and released handles in memory, keyed by the
+// lowercase handle like the real repository
+type mockHandleRepo struct {
+	users     map[string]*model.User
+	redirects map[string]*model.HandleRedirect
+	clock     *fakeclock.Clock
+}
+
+func newMockHandleRepo(now time.Time, users ...*model.User) *mockHandleRepo {
+	repo := &mockHandleRepo{
+		users:     make(map[string]*model.User),
+		redirects: make(map[string]*model.HandleRedirect),
+		clock:     fakeclock.New(now),
+	}
+	for _, u := range users {
+		repo.users[u.ID] = u
+	}
+	return repo
+}
+
+func (m *mockHandleRepo) GetByID(ctx context.Context, id string) (*model.User, error) {
+	return m.users[id], nil
+}
+
+func (m *mockHandleRepo) GetByUsername(ctx context.Context, handle string) (*model.User, error) {
+	for _, u := range m.users {
+		if u.Username != nil && strings.EqualFold(*u.Username, model.NormalizeHandle(handle)) {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockHandleRepo) GetHandleRedirect(ctx context.Context, handle string) (*model.HandleRedirect, error) {
+	return m.redirects[model.NormalizeHandle(handle)], nil
+}
+
+func (m *mockHandleRepo) ChangeUsername(ctx context.Context, userID string, previous *string, handle string) error {
+	delete(m.redirects, model.NormalizeHandle(handle))
+	if previous != nil && model.NormalizeHandle(*previous) != model.NormalizeHandle(handle) {
+		m.redirects[model.NormalizeHandle(*previous)] = &model.HandleRedirect{
+			Handle:     model.NormalizeHandle(*previous),
+			UserID:     userID,
+			ReleasedOn: m.clock.Now(),
+		}
+	}
+	changed := m.clock.Now()
+	m.users[userID].Username = &handle
+	m.users[userID].UsernameChangedOn = &changed
+	return nil
+}
+
+func newHandleTestService(repo *mockHandleRepo) *HandleService {
+	return NewHandleService(HandleServiceConfig{Repo: repo, Clock: repo.clock})
+}
+
+// ============================================================================
+// SetHandle Tests
+// ============================================================================
+
+func TestSetHandle_CaseInsensitiveUniqueness(t *testing.T) {
+	t.Parallel()
+
+	taken := "Ada"
+	repo := newMockHandleRepo(time.Now(),
+		&model.User{ID: "user:1", Username: &taken},
+		&model.User{ID: "user:2"},
+	)
+	svc := newHandleTestService(repo)
+
+	if _, err := svc.SetHandle(context.Background(), "user:2", "ada"); !errors.Is(err, ErrHandleTaken) {
+		t.Errorf("expected ErrHandleTaken, got %v", err)
+	}
+}
+
+func TestSetHandle_RejectsInvalidHandle(t *testing.T) {
+	t.Parallel()
+
+	repo := newMockHandleRepo(time.Now(), &model.User{ID: "user:1"})
+	svc := newHandleTestService(repo)
+
+	if _, err := svc.SetHandle(context.Background(), "user:1", "admin"); !errors.Is(err, ErrInvalidHandle) {
+		t.Errorf("expected ErrInvalidHandle for reserved handle, got %v", err)
+	}
+}
+
+func TestSetHandle_ChangeCooldown(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockHandleRepo(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), &model.User{ID: "user:1"})
+	svc := newHandleTestService(repo)
+
+	if _, err := svc.SetHandle(ctx, "user:1", "first_handle"); err != nil {
+		t.Fatalf("expected first handle to be free, got %v", err)
+	}
+
+	repo.clock.Advance(model.HandleChangeCooldown - time.Hour)
+	if _, err := svc.SetHandle(ctx, "user:1", "second_handle"); !errors.Is(err, ErrHandleChangeTooSoon) {
+		t.Fatalf("expected ErrHandleChangeTooSoon, got %v", err)
+	}
+
+	repo.clock.Advance(time.Hour)
+	user, err := svc.SetHandle(ctx, "user:1", "second_handle")
+	if err != nil {
+		t.Fatalf("expected change after cooldown, got %v", err)
+	}
+	if next := NextHandleChange(user); next == nil || !next.Equal(repo.clock.Now().Add(model.HandleChangeCooldown)) {
+		t.Errorf("expected next change a full cooldown from now, got %v", next)
+	}
+}
+
+func TestSetHandle_ReleasedHandleReservedForPreviousOwner(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockHandleRepo(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		&model.User{ID: "user:1"},
+		&model.User{ID: "user:2"},
+	)
+	svc := newHandleTestService(repo)
+
+	if _, err := svc.SetHandle(ctx, "user:1", "old_name"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	repo.clock.Advance(model.HandleChangeCooldown)
+	if _, err := svc.SetHandle(ctx, "user:1", "new_name"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.SetHandle(ctx, "user:2", "old_name"); !errors.Is(err, ErrHandleTaken) {
+		t.Errorf("expected released handle to stay reserved, got %v", err)
+	}
+
+	repo.clock.Advance(model.HandleReservationPeriod)
+	if _, err := svc.SetHandle(ctx, "user:2", "old_name"); err != nil {
+		t.Errorf("expected released handle to be claimable after reservation, got %v", err)
+	}
+}
+
+// ============================================================================
+// ResolveHandle Tests
+// ============================================================================
+
+func TestResolveHandle_FollowsHistory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := newMockHandleRepo(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), &model.User{ID: "user:1"})
+	svc := newHandleTestService(repo)
+
+	if _, err := svc.SetHandle(ctx, "user:1", "Old_Name"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	repo.clock.Advance(model.HandleChangeCooldown)
+	if _, err := svc.SetHandle(ctx, "user:1", "new_name"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, ref := range []string{"@new_name", "@OLD_NAME", "user:1"} {
+		userID, err := svc.ResolveUserRef(ctx, ref)
+		if err != nil || userID != "user:1" {
+			t.Errorf("%s: expected user:1, got %q (%v)", ref, userID, err)
+		}
+	}
+
+	if _, err := svc.ResolveUserRef(ctx, "@nobody"); !errors.Is(err, ErrHandleNotFound) {
+		t.Errorf("expected ErrHandleNotFound, got %v", err)
+	}
+}
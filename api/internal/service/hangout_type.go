@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// HangoutTypeRepository defines the interface for hangout type catalog
+// storage. An empty guildID means the platform catalog.
+type HangoutTypeRepository interface {
+	Create(ctx context.Context, info *model.HangoutTypeInfo) error
+	GetByKey(ctx context.Context, key, guildID string) (*model.HangoutTypeInfo, error)
+	List(ctx context.Context, guildID string, includeInactive bool) ([]*model.HangoutTypeInfo, error)
+	CountGuildTypes(ctx context.Context, guildID string) (int, error)
+	Update(ctx context.Context, id string, updates map[string]interface{}) (*model.HangoutTypeInfo, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// HangoutTypeGuildRepository provides the guild checks custom types need
+type HangoutTypeGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error)
+}
+
+// HangoutTypeCatalog lists and resolves hangout types for the services that
+// take them
+type HangoutTypeCatalog interface {
+	ListActive(ctx context.Context) ([]*model.HangoutTypeInfo, error)
+	Resolve(ctx context.Context, key string, guildID *string) (*model.HangoutTypeInfo, error)
+}
+
+// HangoutTypeService manages the hangout type catalog. Admins curate the
+// platform types everyone can use; guild admins add custom types for
+// availability scoped to their guild. Built-in types carry behavior elsewhere
+// in the server, so they can be relabeled but not removed or deactivated.
+type HangoutTypeService struct {
+	repo   HangoutTypeRepository
+	guilds HangoutTypeGuildRepository
+}
+
+// NewHangoutTypeService creates a new hangout type service
+func NewHangoutTypeService(repo HangoutTypeRepository, guilds HangoutTypeGuildRepository) *HangoutTypeService {
+	return &HangoutTypeService{
+		repo:   repo,
+		guilds: guilds,
+	}
+}
+
+// ============================================================================
+// Platform Types
+// ============================================================================
+
+// ListActive returns the active platform types, in display order
+func (s *HangoutTypeService) ListActive(ctx context.Context) ([]*model.HangoutTypeInfo, error) {
+	return s.repo.List(ctx, "", false)
+}
+
+// ListPlatformTypes returns every platform type, including inactive ones
+// (admin only)
+func (s *HangoutTypeService) ListPlatformTypes(ctx context.Context) ([]*model.HangoutTypeInfo, error) {
+	return s.repo.List(ctx, "", true)
+}
+
+// CreatePlatformType adds a type everyone can use (admin only)
+func (s *HangoutTypeService) CreatePlatformType(ctx context.Context, req *model.CreateHangoutTypeRequest) (*model.HangoutTypeInfo, error) {
+	return s.create(ctx, nil, req)
+}
+
+// UpdatePlatformType changes a platform type (admin only)
+func (s *HangoutTypeService) UpdatePlatformType(ctx context.Context, key string, req *model.UpdateHangoutTypeRequest) (*model.HangoutTypeInfo, error) {
+	info, err := s.scopedType(ctx, "", key)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, info, req)
+}
+
+// DeletePlatformType removes a platform type (admin only). Availability
+// already using it keeps its type.
+func (s *HangoutTypeService) DeletePlatformType(ctx context.Context, key string) error {
+	info, err := s.scopedType(ctx, "", key)
+	if err != nil {
+		return err
+	}
+	if info.BuiltIn {
+		return ErrBuiltInHangoutType
+	}
+	return s.repo.Delete(ctx, info.ID)
+}
+
+// ============================================================================
+// Guild Types
+// ============================================================================
+
+// ListGuildTypes returns a guild's custom types (members only). Admins also
+// see inactive ones.
+func (s *HangoutTypeService) ListGuildTypes(ctx context.Context, userID, guildID string) ([]*model.HangoutTypeInfo, error) {
+	isAdmin, err := s.checkGuildAccess(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, guildID, isAdmin)
+}
+
+// CreateGuildType adds a custom type to a guild (admin only). It can't reuse
+// a platform type's key.
+func (s *HangoutTypeService) CreateGuildType(ctx context.Context, userID, guildID string, req *model.CreateHangoutTypeRequest) (*model.HangoutTypeInfo, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountGuildTypes(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= model.MaxGuildHangoutTypes {
+		return nil, ErrTooManyHangoutTypes
+	}
+
+	return s.create(ctx, &guildID, req)
+}
+
+// UpdateGuildType changes one of a guild's custom types (admin only)
+func (s *HangoutTypeService) UpdateGuildType(ctx context.Context, userID, guildID, key string, req *model.UpdateHangoutTypeRequest) (*model.HangoutTypeInfo, error) {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	info, err := s.scopedType(ctx, guildID, key)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, info, req)
+}
+
+// DeleteGuildType removes one of a guild's custom types (admin only)
+func (s *HangoutTypeService) DeleteGuildType(ctx context.Context, userID, guildID, key string) error {
+	if err := s.requireGuildAdmin(ctx, userID, guildID); err != nil {
+		return err
+	}
+	info, err := s.scopedType(ctx, guildID, key)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, info.ID)
+}
+
+// ============================================================================
+// Resolution
+// ============================================================================
+
+// Resolve returns the active type with key, looking in the platform catalog
+// and then, for guild-scoped availability, the guild's. Unknown and inactive
+// types fail with ErrInvalidHangoutType.
+func (s *HangoutTypeService) Resolve(ctx context.Context, key string, guildID *string) (*model.HangoutTypeInfo, error) {
+	info, err := s.repo.GetByKey(ctx, key, "")
+	if err != nil {
+		return nil, err
+	}
+	if (info == nil || !info.IsActive) && guildID != nil {
+		info, err = s.repo.GetByKey(ctx, key, *guildID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if info == nil || !info.IsActive {
+		return nil, ErrInvalidHangoutType
+	}
+	return info, nil
+}
+
+// resolveHangoutType checks key against the catalog, or against the
+// built-in types when there is no catalog
+func resolveHangoutType(ctx context.Context, catalog HangoutTypeCatalog, key string, guildID *string) error {
+	if catalog == nil {
+		if !model.IsBuiltInHangoutType(model.HangoutType(key)) {
+			return ErrInvalidHangoutType
+		}
+		return nil
+	}
+	_, err := catalog.Resolve(ctx, key, guildID)
+	return err
+}
+
+// listHangoutTypes returns the active platform types from the catalog, or
+// the built-in types when there is no catalog
+func listHangoutTypes(ctx context.Context, catalog HangoutTypeCatalog) ([]*model.HangoutTypeInfo, error) {
+	if catalog != nil {
+		return catalog.ListActive(ctx)
+	}
+	builtIn := model.GetHangoutTypeInfo()
+	types := make([]*model.HangoutTypeInfo, len(builtIn))
+	for i := range builtIn {
+		types[i] = &builtIn[i]
+	}
+	return types, nil
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+func (s *HangoutTypeService) create(ctx context.Context, guildID *string, req *model.CreateHangoutTypeRequest) (*model.HangoutTypeInfo, error) {
+	if guildID != nil {
+		platform, err := s.repo.GetByKey(ctx, req.Type, "")
+		if err != nil {
+			return nil, err
+		}
+		if platform != nil {
+			return nil, ErrHangoutTypeExists
+		}
+	}
+
+	info := &model.HangoutTypeInfo{
+		Type:        model.HangoutType(req.Type),
+		Label:       req.Label,
+		Description: req.Description,
+		Icon:        req.Icon,
+		GuildID:     guildID,
+		SortOrder:   req.SortOrder,
+	}
+	if err := s.repo.Create(ctx, info); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrHangoutTypeExists
+		}
+		return nil, err
+	}
+	return info, nil
+}
+
+func (s *HangoutTypeService) update(ctx context.Context, info *model.HangoutTypeInfo, req *model.UpdateHangoutTypeRequest) (*model.HangoutTypeInfo, error) {
+	if info.BuiltIn && req.IsActive != nil && !*req.IsActive {
+		return nil, ErrBuiltInHangoutType
+	}
+
+	updates := make(map[string]interface{})
+	if req.Label != nil {
+		updates["label"] = *req.Label
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.Icon != nil {
+		updates["icon"] = *req.Icon
+	}
+	if req.SortOrder != nil {
+		updates["sort_order"] = *req.SortOrder
+	}
+	if req.IsActive != nil {
+		updates["is_active"] = *req.IsActive
+	}
+	if len(updates) == 0 {
+		return info, nil
+	}
+
+	updated, err := s.repo.Update(ctx, info.ID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrHangoutTypeNotFound
+	}
+	return updated, nil
+}
+
+// scopedType returns a type from a guild's catalog, or the platform's when
+// guildID is empty
+func (s *HangoutTypeService) scopedType(ctx context.Context, guildID, key string) (*model.HangoutTypeInfo, error) {
+	info, err := s.repo.GetByKey(ctx, key, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, ErrHangoutTypeNotFound
+	}
+	return info, nil
+}
+
+// checkGuildAccess requires guild membership and reports whether the user
+// is a guild admin
+func (s *HangoutTypeService) checkGuildAccess(ctx context.Context, userID, guildID string) (bool, error) {
+	isMember, err := s.guilds.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return false, fmt.Errorf("checking guild membership: %w", err)
+	}
+	if !isMember {
+		return false, ErrNotGuildMember
+	}
+	return s.guilds.IsGuildAdmin(ctx, userID, guildID)
+}
+
+func (s *HangoutTypeService) requireGuildAdmin(ctx context.Context, userID, guildID string) error {
+	isAdmin, err := s.checkGuildAccess(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrNotGuildAdmin
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// hangoutTypeCatalog returns a catalog keyed by guild ("" for the platform)
+// and type key. It holds the built-in types, an inactive platform "retired"
+// type and a "board_games" type in guild:1.
+func hangoutTypeCatalog() map[string]map[string]*model.HangoutTypeInfo {
+	types := map[string]map[string]*model.HangoutTypeInfo{"": {}, "guild:1": {}}
+	for _, info := range model.GetHangoutTypeInfo() {
+		info.ID = "hangout_type:" + string(info.Type)
+		types[""][string(info.Type)] = &info
+	}
+	types[""]["retired"] = &model.HangoutTypeInfo{ID: "hangout_type:retired", Type: "retired"}
+	guildID := "guild:1"
+	types[guildID]["board_games"] = &model.HangoutTypeInfo{ID: "hangout_type:board_games", Type: "board_games", GuildID: &guildID, IsActive: true}
+	return types
+}
+
+// memoryHangoutTypeRepo stores hangout types in the given catalog
+func memoryHangoutTypeRepo(types map[string]map[string]*model.HangoutTypeInfo) *mocks.HangoutTypeRepository {
+	return &mocks.HangoutTypeRepository{
+		CreateFunc: func(ctx context.Context, info *model.HangoutTypeInfo) error {
+			scope := ""
+			if info.GuildID != nil {
+				scope = *info.GuildID
+			}
+			if types[scope] == nil {
+				types[scope] = map[string]*model.HangoutTypeInfo{}
+			}
+			info.ID = "hangout_type:new"
+			info.IsActive = true
+			types[scope][string(info.Type)] = info
+			return nil
+		},
+		GetByKeyFunc: func(ctx context.Context, key, guildID string) (*model.HangoutTypeInfo, error) {
+			return types[guildID][key], nil
+		},
+		CountGuildTypesFunc: func(ctx context.Context, guildID string) (int, error) {
+			return len(types[guildID]), nil
+		},
+		UpdateFunc: func(ctx context.Context, id string, updates map[string]interface{}) (*model.HangoutTypeInfo, error) {
+			for _, scope := range types {
+				for _, info := range scope {
+					if info.ID != id {
+						continue
+					}
+					if label, ok := updates["label"].(string); ok {
+						info.Label = label
+					}
+					if active, ok := updates["is_active"].(bool); ok {
+						info.IsActive = active
+					}
+					return info, nil
+				}
+			}
+			return nil, nil
+		},
+		DeleteFunc: func(ctx context.Context, id string) error {
+			for _, scope := range types {
+				for key, info := range scope {
+					if info.ID == id {
+						delete(scope, key)
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// hangoutTypeGuildRepo makes user:admin a guild admin and everyone but
+// user:outsider a member
+func hangoutTypeGuildRepo() *mocks.HangoutTypeGuildRepository {
+	return &mocks.HangoutTypeGuildRepository{
+		IsMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return userID != "user:outsider", nil
+		},
+		IsGuildAdminFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return userID == "user:admin", nil
+		},
+	}
+}
+
+// ============================================================================
+// Hangout Type Catalog Tests
+// ============================================================================
+
+func TestResolveHangoutType(t *testing.T) {
+	t.Parallel()
+
+	svc := NewHangoutTypeService(memoryHangoutTypeRepo(hangoutTypeCatalog()), hangoutTypeGuildRepo())
+	guild1, guild2 := "guild:1", "guild:2"
+
+	tests := []struct {
+		name    string
+		key     string
+		guildID *string
+		wantErr error
+	}{
+		{"built-in", string(model.HangoutTypeMeetAnyone), nil, nil},
+		{"built-in in a guild", string(model.HangoutTypeMeetAnyone), &guild1, nil},
+		{"guild type in its guild", "board_games", &guild1, nil},
+		{"guild type outside a guild", "board_games", nil, ErrInvalidHangoutType},
+		{"guild type in another guild", "board_games", &guild2, ErrInvalidHangoutType},
+		{"inactive", "retired", nil, ErrInvalidHangoutType},
+		{"unknown", "skydiving", nil, ErrInvalidHangoutType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := resolveHangoutType(context.Background(), svc, tt.key, tt.guildID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("resolveHangoutType(%q) error = %v, want %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveHangoutType_WithoutCatalog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr error
+	}{
+		{"built-in", string(model.HangoutTypeTalkItOut), nil},
+		{"custom", "board_games", ErrInvalidHangoutType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := resolveHangoutType(context.Background(), nil, tt.key, nil); !errors.Is(err, tt.wantErr) {
+				t.Errorf("resolveHangoutType(%q) error = %v, want %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdatePlatformType_BuiltInCanOnlyBeRelabeled(t *testing.T) {
+	t.Parallel()
+
+	label := "Vent"
+	inactive := false
+	tests := []struct {
+		name    string
+		req     model.UpdateHangoutTypeRequest
+		wantErr error
+	}{
+		{"relabel", model.UpdateHangoutTypeRequest{Label: &label}, nil},
+		{"deactivate", model.UpdateHangoutTypeRequest{IsActive: &inactive}, ErrBuiltInHangoutType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewHangoutTypeService(memoryHangoutTypeRepo(hangoutTypeCatalog()), hangoutTypeGuildRepo())
+			info, err := svc.UpdatePlatformType(context.Background(), string(model.HangoutTypeTalkItOut), &tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdatePlatformType() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (info.Label != label || !info.IsActive) {
+				t.Errorf("type = %+v, want active and relabeled %q", info, label)
+			}
+		})
+	}
+}
+
+func TestDeletePlatformType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr error
+	}{
+		{"custom", "retired", nil},
+		{"built-in", string(model.HangoutTypeTalkItOut), ErrBuiltInHangoutType},
+		{"guild type", "board_games", ErrHangoutTypeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			types := hangoutTypeCatalog()
+			svc := NewHangoutTypeService(memoryHangoutTypeRepo(types), hangoutTypeGuildRepo())
+			if err := svc.DeletePlatformType(context.Background(), tt.key); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeletePlatformType() error = %v, want %v", err, tt.wantErr)
+			}
+			removed := types[""][tt.key] == nil && types["guild:1"][tt.key] == nil
+			if removed != (tt.wantErr == nil) {
+				t.Errorf("removed = %v, want %v", removed, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestCreateGuildType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		userID  string
+		key     string
+		full    bool // The guild already has as many types as it may
+		wantErr error
+	}{
+		{name: "admin", userID: "user:admin", key: "puzzles"},
+		{name: "member", userID: "user:member", key: "puzzles", wantErr: ErrNotGuildAdmin},
+		{name: "outsider", userID: "user:outsider", key: "puzzles", wantErr: ErrNotGuildMember},
+		{name: "platform key", userID: "user:admin", key: string(model.HangoutTypeMeetAnyone), wantErr: ErrHangoutTypeExists},
+		{name: "limit reached", userID: "user:admin", key: "puzzles", full: true, wantErr: ErrTooManyHangoutTypes},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			types := hangoutTypeCatalog()
+			if tt.full {
+				for i := len(types["guild:1"]); i < model.MaxGuildHangoutTypes; i++ {
+					types["guild:1"][string(rune('a'+i))+"_type"] = &model.HangoutTypeInfo{}
+				}
+			}
+			svc := NewHangoutTypeService(memoryHangoutTypeRepo(types), hangoutTypeGuildRepo())
+
+			info, err := svc.CreateGuildType(context.Background(), tt.userID, "guild:1", &model.CreateHangoutTypeRequest{Type: tt.key, Label: "Label"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateGuildType() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (info.GuildID == nil || *info.GuildID != "guild:1") {
+				t.Errorf("guild = %v, want guild:1", info.GuildID)
+			}
+		})
+	}
+}
+
+func TestUpdateGuildType(t *testing.T) {
+	t.Parallel()
+
+	label := "Tabletop"
+	tests := []struct {
+		name    string
+		guildID string
+		wantErr error
+	}{
+		{"own guild", "guild:1", nil},
+		{"other guild", "guild:2", ErrHangoutTypeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewHangoutTypeService(memoryHangoutTypeRepo(hangoutTypeCatalog()), hangoutTypeGuildRepo())
+			info, err := svc.UpdateGuildType(context.Background(), "user:admin", tt.guildID, "board_games", &model.UpdateHangoutTypeRequest{Label: &label})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateGuildType() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && info.Label != label {
+				t.Errorf("label = %q, want %q", info.Label, label)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Guild-Scoped Availability Tests
+// ============================================================================
+
+func TestCreateAvailability_GuildHangoutType(t *testing.T) {
+	t.Parallel()
+
+	guildID := "guild:1"
+	tests := []struct {
+		name    string
+		userID  string
+		guildID *string
+		wantErr error
+	}{
+		{"member in the guild", "user:member", &guildID, nil},
+		{"without a guild", "user:member", nil, ErrInvalidHangoutType},
+		{"outsider", "user:outsider", &guildID, ErrNotGuildMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var created *model.Availability
+			svc := NewAvailabilityService(AvailabilityServiceConfig{
+				Repo: &mocks.AvailabilityRepository{
+					CreateFunc: func(ctx context.Context, av *model.Availability) error {
+						created = av
+						return nil
+					},
+				},
+				Guilds:       &mockAvailabilityGuildRepo{members: map[string]bool{"user:member": true}},
+				HangoutTypes: NewHangoutTypeService(memoryHangoutTypeRepo(hangoutTypeCatalog()), hangoutTypeGuildRepo()),
+			})
+
+			start := time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC)
+			av, err := svc.CreateAvailability(context.Background(), tt.userID, &model.CreateAvailabilityRequest{
+				HangoutType: "board_games",
+				StartTime:   start.Format(time.RFC3339),
+				EndTime:     start.Add(2 * time.Hour).Format(time.RFC3339),
+				GuildID:     tt.guildID,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateAvailability() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if created != nil {
+					t.Errorf("created = %+v, want nothing", created)
+				}
+				return
+			}
+			if av != created || av.GuildID == nil || *av.GuildID != guildID {
+				t.Errorf("created = %+v, want it scoped to %s", created, guildID)
+			}
+		})
+	}
+}
+
+func TestFindByHangoutType_RejectsInactiveType(t *testing.T) {
+	t.Parallel()
+
+	svc := NewAvailabilityService(AvailabilityServiceConfig{
+		Repo:         &mocks.AvailabilityRepository{},
+		HangoutTypes: NewHangoutTypeService(memoryHangoutTypeRepo(hangoutTypeCatalog()), hangoutTypeGuildRepo()),
+	})
+
+	if _, err := svc.FindByHangoutType(context.Background(), "user:1", "retired", 10); !errors.Is(err, ErrInvalidHangoutType) {
+		t.Errorf("FindByHangoutType() error = %v, want ErrInvalidHangoutType", err)
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// IdempotencyRepository defines the interface for stored idempotent responses
+type IdempotencyRepository interface {
+	Claim(ctx context.Context, key string, now, lockedUntil, expiresOn time.Time) (*model.IdempotentResponse, bool, error)
+	Complete(ctx context.Context, key string, response *model.IdempotentResponse, expiresOn time.Time) error
+	Delete(ctx context.Context, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// IdempotencyService keeps the responses to requests sent with an
+// Idempotency-Key in the database, so retries get the original response
+// even after a restart or from another instance. It satisfies
+// middleware.IdempotencyStore.
+type IdempotencyService struct {
+	repo         IdempotencyRepository
+	ttl          time.Duration
+	lease        time.Duration
+	wait         time.Duration
+	pollInterval time.Duration
+	clock        clock.Clock
+}
+
+// IdempotencyServiceConfig holds configuration for the idempotency service
+type IdempotencyServiceConfig struct {
+	Repo         IdempotencyRepository
+	TTL          time.Duration // How long responses are replayed (default model.IdempotencyTTL)
+	Lease        time.Duration // How long a request holds its key (default model.IdempotencyLease)
+	Wait         time.Duration // How long a retry waits for the request holding its key (default model.IdempotencyWait)
+	PollInterval time.Duration // How often a waiting retry checks the key again (default 100ms)
+	Clock        clock.Clock   // Default: the system clock
+}
+
+// NewIdempotencyService creates a new idempotency service
+func NewIdempotencyService(cfg IdempotencyServiceConfig) *IdempotencyService {
+	if cfg.TTL <= 0 {
+		cfg.TTL = model.IdempotencyTTL
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = model.IdempotencyLease
+	}
+	if cfg.Wait <= 0 {
+		cfg.Wait = model.IdempotencyWait
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 100 * time.Millisecond
+	}
+	return &IdempotencyService{
+		repo:         cfg.Repo,
+		ttl:          cfg.TTL,
+		lease:        cfg.Lease,
+		wait:         cfg.Wait,
+		pollInterval: cfg.PollInterval,
+		clock:        clock.OrReal(cfg.Clock),
+	}
+}
+
+// Claim takes key for a new request and returns nil, or returns the stored
+// response when the request was already handled. While another request holds
+// the key it waits for that request's response, giving up with
+// model.ErrIdempotencyKeyInUse.
+func (s *IdempotencyService) Claim(ctx context.Context, key string) (*model.IdempotentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.wait)
+	defer cancel()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		now := s.clock.Now().UTC()
+		response, claimed, err := s.repo.Claim(ctx, key, now, now.Add(s.lease), now.Add(s.ttl))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, model.ErrIdempotencyKeyInUse
+			}
+			return nil, err
+		}
+		if claimed || response != nil {
+			return response, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, model.ErrIdempotencyKeyInUse
+		}
+	}
+}
+
+// Complete stores the response to a claimed key for retries to replay
+func (s *IdempotencyService) Complete(ctx context.Context, key string, response *model.IdempotentResponse) error {
+	return s.repo.Complete(ctx, key, response, s.clock.Now().UTC().Add(s.ttl))
+}
+
+// Release gives up a claimed key without a response, so a retry can run the
+// request again
+func (s *IdempotencyService) Release(ctx context.Context, key string) error {
+	return s.repo.Delete(ctx, key)
+}
+
+// PurgeExpired removes responses that are no longer replayed. Returns how
+// many were removed.
+func (s *IdempotencyService) PurgeExpired(ctx context.Context) (int, error) {
+	return s.repo.DeleteExpired(ctx, s.clock.Now().UTC())
+}
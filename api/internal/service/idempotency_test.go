@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+func TestIdempotencyService_Claim_WaitsForResponse(t *testing.T) {
+	t.Parallel()
+	stored := &model.IdempotentResponse{Status: http.StatusCreated, Headers: http.Header{}, Body: []byte(`{"data":{}}`)}
+	calls := 0
+	repo := &mocks.IdempotencyRepository{
+		ClaimFunc: func(ctx context.Context, key string, now, lockedUntil, expiresOn time.Time) (*model.IdempotentResponse, bool, error) {
+			calls++
+			if calls < 3 {
+				return nil, false, nil // Still being handled
+			}
+			return stored, false, nil
+		},
+	}
+	svc := NewIdempotencyService(IdempotencyServiceConfig{Repo: repo, PollInterval: time.Millisecond})
+
+	response, err := svc.Claim(context.Background(), "key")
+	if err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+	if response != stored {
+		t.Errorf("Claim() = %+v, want the stored response", response)
+	}
+	if calls != 3 {
+		t.Errorf("claimed %d times, want 3", calls)
+	}
+}
+
+func TestIdempotencyService_Claim_GivesUp(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.IdempotencyRepository{
+		ClaimFunc: func(ctx context.Context, key string, now, lockedUntil, expiresOn time.Time) (*model.IdempotentResponse, bool, error) {
+			return nil, false, nil
+		},
+	}
+	svc := NewIdempotencyService(IdempotencyServiceConfig{
+		Repo:         repo,
+		Wait:         20 * time.Millisecond,
+		PollInterval: time.Millisecond,
+	})
+
+	if _, err := svc.Claim(context.Background(), "key"); !errors.Is(err, model.ErrIdempotencyKeyInUse) {
+		t.Errorf("Claim() error = %v, want ErrIdempotencyKeyInUse", err)
+	}
+}
+
+func TestIdempotencyService_Claim_Lease(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	repo := &mocks.IdempotencyRepository{
+		ClaimFunc: func(ctx context.Context, key string, claimedOn, lockedUntil, expiresOn time.Time) (*model.IdempotentResponse, bool, error) {
+			if !claimedOn.Equal(now) || !lockedUntil.Equal(now.Add(time.Minute)) || !expiresOn.Equal(now.Add(time.Hour)) {
+				t.Errorf("Claim(%v, %v, %v), want the lease and TTL from now", claimedOn, lockedUntil, expiresOn)
+			}
+			return nil, true, nil
+		},
+	}
+	svc := NewIdempotencyService(IdempotencyServiceConfig{
+		Repo:  repo,
+		TTL:   time.Hour,
+		Lease: time.Minute,
+		Clock: fakeclock.New(now),
+	})
+
+	response, err := svc.Claim(context.Background(), "key")
+	if err != nil || response != nil {
+		t.Errorf("Claim() = %+v, %v, want the key claimed", response, err)
+	}
+}
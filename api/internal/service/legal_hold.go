@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// LegalHoldChecker is used to refuse destructive actions on held users
+type LegalHoldChecker interface {
+	IsHeld(ctx context.Context, userID string) (bool, error)
+}
+
+// LegalHoldRepository defines the interface for legal hold storage
+type LegalHoldRepository interface {
+	Create(ctx context.Context, hold *model.LegalHold) error
+	Get(ctx context.Context, userID string) (*model.LegalHold, error)
+	Delete(ctx context.Context, userID string) (*model.LegalHold, error)
+	CreateAccess(ctx context.Context, access *model.LegalHoldAccess) error
+	ListAccess(ctx context.Context, userID string, limit int) ([]*model.LegalHoldAccess, error)
+}
+
+// LegalHoldUserRepository defines the user lookups the legal hold service needs
+type LegalHoldUserRepository interface {
+	GetByID(ctx context.Context, id string) (*model.User, error)
+}
+
+// LegalHoldService manages legal holds. Holds are placed and released by
+// superadmins; purge queries skip held users' data on their own, and the
+// service records every access to a held user's data.
+type LegalHoldService struct {
+	repo     LegalHoldRepository
+	userRepo LegalHoldUserRepository
+}
+
+// LegalHoldServiceConfig holds configuration for the legal hold service
+type LegalHoldServiceConfig struct {
+	Repo     LegalHoldRepository
+	UserRepo LegalHoldUserRepository
+}
+
+// NewLegalHoldService creates a new legal hold service
+func NewLegalHoldService(cfg LegalHoldServiceConfig) *LegalHoldService {
+	return &LegalHoldService{
+		repo:     cfg.Repo,
+		userRepo: cfg.UserRepo,
+	}
+}
+
+// Get returns the hold on a user
+func (s *LegalHoldService) Get(ctx context.Context, userID string) (*model.LegalHold, error) {
+	hold, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if hold == nil {
+		return nil, ErrLegalHoldNotFound
+	}
+	return hold, nil
+}
+
+// Place puts a user on legal hold
+func (s *LegalHoldService) Place(ctx context.Context, actorID, userID string, req *model.PlaceLegalHoldRequest) (*model.LegalHold, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	existing, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrLegalHoldExists
+	}
+
+	hold := &model.LegalHold{
+		UserID:   userID,
+		Reason:   req.Reason,
+		PlacedBy: actorID,
+	}
+	if err := s.repo.Create(ctx, hold); err != nil {
+		return nil, err
+	}
+
+	s.appendAccess(ctx, actorID, userID, model.LegalHoldActionPlaced)
+	return hold, nil
+}
+
+// Release takes a user off legal hold. Their data becomes eligible for the
+// next purge run.
+func (s *LegalHoldService) Release(ctx context.Context, actorID, userID string) (*model.LegalHold, error) {
+	hold, err := s.repo.Delete(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if hold == nil {
+		return nil, ErrLegalHoldNotFound
+	}
+
+	s.appendAccess(ctx, actorID, userID, model.LegalHoldActionReleased)
+	return hold, nil
+}
+
+// IsHeld reports whether a user is on legal hold
+func (s *LegalHoldService) IsHeld(ctx context.Context, userID string) (bool, error) {
+	hold, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return hold != nil, nil
+}
+
+// RecordAccess logs an access to a user's data if they are on legal hold.
+// actorID is empty for unauthenticated access. Failures are logged; the
+// access itself is not refused.
+func (s *LegalHoldService) RecordAccess(ctx context.Context, actorID, userID, action string) {
+	held, err := s.IsHeld(ctx, userID)
+	if err != nil {
+		log.Printf("[LegalHoldService] Failed to check hold on %s for %q: %v", userID, action, err)
+		return
+	}
+	if held {
+		s.appendAccess(ctx, actorID, userID, action)
+	}
+}
+
+// AccessLog returns a user's access log, newest first. The log is kept after
+// a hold is released.
+func (s *LegalHoldService) AccessLog(ctx context.Context, userID string, limit int) ([]*model.LegalHoldAccess, error) {
+	if limit <= 0 {
+		limit = model.DefaultLegalHoldAccessLimit
+	}
+	if limit > model.MaxLegalHoldAccessLimit {
+		limit = model.MaxLegalHoldAccessLimit
+	}
+	return s.repo.ListAccess(ctx, userID, limit)
+}
+
+func (s *LegalHoldService) appendAccess(ctx context.Context, actorID, userID, action string) {
+	access := &model.LegalHoldAccess{
+		UserID:  userID,
+		ActorID: actorID,
+		Action:  action,
+	}
+	if err := s.repo.CreateAccess(ctx, access); err != nil {
+		log.Printf("[LegalHoldService] Failed to log %q on %s: %v", action, userID, err)
+	}
+}
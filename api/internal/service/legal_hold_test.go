@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockLegalHoldRepo struct {
+	holds    map[string]*model.LegalHold
+	accesses []*model.LegalHoldAccess
+}
+
+func newMockLegalHoldRepo() *mockLegalHoldRepo {
+	return &mockLegalHoldRepo{holds: map[string]*model.LegalHold{}}
+}
+
+func (m *mockLegalHoldRepo) Create(ctx context.Context, hold *model.LegalHold) error {
+	m.holds[hold.UserID] = hold
+	return nil
+}
+
+func (m *mockLegalHoldRepo) Get(ctx context.Context, userID string) (*model.LegalHold, error) {
+	return m.holds[userID], nil
+}
+
+func (m *mockLegalHoldRepo) Delete(ctx context.Context, userID string) (*model.LegalHold, error) {
+	hold := m.holds[userID]
+	delete(m.holds, userID)
+	return hold, nil
+}
+
+func (m *mockLegalHoldRepo) CreateAccess(ctx context.Context, access *model.LegalHoldAccess) error {
+	m.accesses = append(m.accesses, access)
+	return nil
+}
+
+func (m *mockLegalHoldRepo) ListAccess(ctx context.Context, userID string, limit int) ([]*model.LegalHoldAccess, error) {
+	return m.accesses, nil
+}
+
+type mockLegalHoldUserRepo struct{}
+
+func (m *mockLegalHoldUserRepo) GetByID(ctx context.Context, id string) (*model.User, error) {
+	if id == "user:missing" {
+		return nil, nil
+	}
+	return &model.User{ID: id}, nil
+}
+
+func newTestLegalHoldService(repo *mockLegalHoldRepo) *LegalHoldService {
+	return NewLegalHoldService(LegalHoldServiceConfig{
+		Repo:     repo,
+		UserRepo: &mockLegalHoldUserRepo{},
+	})
+}
+
+// ============================================================================
+// Place / Release Tests
+// ============================================================================
+
+func TestLegalHoldPlace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		userID  string
+		held    bool
+		wantErr error
+	}{
+		{name: "places hold", userID: "user:1"},
+		{name: "already held", userID: "user:1", held: true, wantErr: ErrLegalHoldExists},
+		{name: "unknown user", userID: "user:missing", wantErr: ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := newMockLegalHoldRepo()
+			if tt.held {
+				repo.holds[tt.userID] = &model.LegalHold{UserID: tt.userID}
+			}
+			svc := newTestLegalHoldService(repo)
+
+			hold, err := svc.Place(context.Background(), "user:super", tt.userID, &model.PlaceLegalHoldRequest{Reason: "case 42"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if hold.PlacedBy != "user:super" || hold.Reason != "case 42" {
+				t.Errorf("unexpected hold %+v", hold)
+			}
+			if len(repo.accesses) != 1 || repo.accesses[0].Action != model.LegalHoldActionPlaced {
+				t.Errorf("expected placement to be logged, got %+v", repo.accesses)
+			}
+		})
+	}
+}
+
+func TestLegalHoldRelease(t *testing.T) {
+	t.Parallel()
+
+	repo := newMockLegalHoldRepo()
+	repo.holds["user:1"] = &model.LegalHold{UserID: "user:1"}
+	svc := newTestLegalHoldService(repo)
+	ctx := context.Background()
+
+	if _, err := svc.Release(ctx, "user:super", "user:1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held, _ := svc.IsHeld(ctx, "user:1"); held {
+		t.Error("expected hold to be released")
+	}
+	if len(repo.accesses) != 1 || repo.accesses[0].Action != model.LegalHoldActionReleased {
+		t.Errorf("expected release to be logged, got %+v", repo.accesses)
+	}
+
+	if _, err := svc.Release(ctx, "user:super", "user:1"); !errors.Is(err, ErrLegalHoldNotFound) {
+		t.Errorf("expected ErrLegalHoldNotFound, got %v", err)
+	}
+}
+
+// ============================================================================
+// Access Log Tests
+// ============================================================================
+
+func TestLegalHoldRecordAccess_OnlyHeldUsers(t *testing.T) {
+	t.Parallel()
+
+	repo := newMockLegalHoldRepo()
+	repo.holds["user:held"] = &model.LegalHold{UserID: "user:held"}
+	svc := newTestLegalHoldService(repo)
+	ctx := context.Background()
+
+	svc.RecordAccess(ctx, "user:viewer", "user:held", "GET /v1/users/user:held/profile")
+	svc.RecordAccess(ctx, "user:viewer", "user:free", "GET /v1/users/user:free/profile")
+
+	if len(repo.accesses) != 1 {
+		t.Fatalf("expected 1 access logged, got %d", len(repo.accesses))
+	}
+	got := repo.accesses[0]
+	if got.UserID != "user:held" || got.ActorID != "user:viewer" || got.Action != "GET /v1/users/user:held/profile" {
+		t.Errorf("unexpected access %+v", got)
+	}
+}
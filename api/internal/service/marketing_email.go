@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// marketingEmailFooter ends every marketing email
+const marketingEmailFooter = "\n\n--\nYou're receiving this because you opted in to marketing email from Saga. " +
+	"You can withdraw at any time under Settings > Privacy."
+
+// MarketingEmailUserRepository defines the user lookups marketing email needs
+type MarketingEmailUserRepository interface {
+	GetByID(ctx context.Context, id string) (*model.User, error)
+}
+
+// MarketingEmailService sends promotional email such as win-back nudges.
+// Marketing email is opt-in: only users who granted the current
+// marketing_email consent text are mailed, and only at a verified address.
+type MarketingEmailService struct {
+	mailer   EmailSender
+	userRepo MarketingEmailUserRepository
+	consent  ConsentChecker
+}
+
+// MarketingEmailServiceConfig holds configuration for the marketing email service
+type MarketingEmailServiceConfig struct {
+	Mailer   EmailSender
+	UserRepo MarketingEmailUserRepository
+	Consent  ConsentChecker // Required; nobody is mailed without it
+}
+
+// NewMarketingEmailService creates a new marketing email service
+func NewMarketingEmailService(cfg MarketingEmailServiceConfig) *MarketingEmailService {
+	return &MarketingEmailService{
+		mailer:   cfg.Mailer,
+		userRepo: cfg.UserRepo,
+		consent:  cfg.Consent,
+	}
+}
+
+// Send emails the user unless they haven't consented to marketing email or
+// have no verified address. It reports whether the email was sent.
+func (s *MarketingEmailService) Send(ctx context.Context, userID, subject, body string) (bool, error) {
+	if s.mailer == nil || s.consent == nil {
+		return false, nil
+	}
+
+	allowed, err := s.consent.HasConsent(ctx, userID, model.ConsentMarketingEmail)
+	if err != nil {
+		return false, err
+	}
+	if !allowed {
+		return false, nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if user == nil || user.Email == "" || !user.EmailVerified {
+		return false, nil
+	}
+
+	if err := s.mailer.Send(ctx, user.Email, subject, body+marketingEmailFooter); err != nil {
+		return false, err
+	}
+	return true, nil
+}
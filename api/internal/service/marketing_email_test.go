@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// marketingConsents returns a consent service in which every given user has
+// granted marketing email
+func marketingConsents(t *testing.T, userIDs ...string) *ConsentService {
+	t.Helper()
+	svc := NewConsentService(ConsentServiceConfig{Repo: &mockConsentRepo{}})
+	version := currentConsentVersion(t, model.ConsentMarketingEmail)
+	for _, userID := range userIDs {
+		if _, err := svc.Grant(context.Background(), userID, model.ConsentMarketingEmail, version); err != nil {
+			t.Fatalf("granting marketing consent for %s: %v", userID, err)
+		}
+	}
+	return svc
+}
+
+func TestMarketingEmailService_Send(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	users := &mocks.MarketingEmailUserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+			return map[string]*model.User{
+				"user:opted-in":   {ID: "user:opted-in", Email: "in@example.com", EmailVerified: true},
+				"user:never":      {ID: "user:never", Email: "never@example.com", EmailVerified: true},
+				"user:withdrew":   {ID: "user:withdrew", Email: "withdrew@example.com", EmailVerified: true},
+				"user:unverified": {ID: "user:unverified", Email: "unverified@example.com"},
+			}[id], nil
+		},
+	}
+	consents := marketingConsents(t, "user:opted-in", "user:withdrew", "user:unverified")
+	if _, err := consents.Withdraw(ctx, "user:withdrew", model.ConsentMarketingEmail); err != nil {
+		t.Fatalf("Withdraw() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		userID   string
+		consent  ConsentChecker
+		wantSent bool
+	}{
+		{"opted in", "user:opted-in", consents, true},
+		{"never decided", "user:never", consents, false},
+		{"withdrew", "user:withdrew", consents, false},
+		{"unverified address", "user:unverified", consents, false},
+		{"no consent checker", "user:opted-in", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mailer := &mockEmailSender{}
+			svc := NewMarketingEmailService(MarketingEmailServiceConfig{
+				Mailer:   mailer,
+				UserRepo: users,
+				Consent:  tt.consent,
+			})
+
+			sent, err := svc.Send(ctx, tt.userID, "New in Saga", "Guild events now support tickets.")
+			if err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			if sent != tt.wantSent || sent != (len(mailer.sent) == 1) {
+				t.Fatalf("Send() = %v with %d emails, want %v", sent, len(mailer.sent), tt.wantSent)
+			}
+			if tt.wantSent && !strings.Contains(mailer.sent[0].body, "withdraw") {
+				t.Errorf("body = %q, want the withdrawal footer", mailer.sent[0].body)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Code generated by go generate ./internal/testing/mocks; DO NOT EDIT.
+
+package service
+
+import "github.com/forgo/saga/api/internal/testing/mocks"
+
+var (
+	_ ActivityRepository                = (*mocks.ActivityRepository)(nil)
+	_ AdminProfileRepository            = (*mocks.AdminProfileRepository)(nil)
+	_ AdminReportRepository             = (*mocks.AdminReportRepository)(nil)
+	_ AdminUserRepository               = (*mocks.AdminUserRepository)(nil)
+	_ AdventureAdmissionRepository      = (*mocks.AdventureAdmissionRepository)(nil)
+	_ AdventureRepository               = (*mocks.AdventureRepository)(nil)
+	_ AnalyticsEventRepository          = (*mocks.AnalyticsEventRepository)(nil)
+	_ AnalyticsRepository               = (*mocks.AnalyticsRepository)(nil)
+	_ AnnouncementGuildRepository       = (*mocks.AnnouncementGuildRepository)(nil)
+	_ AnnouncementRepository            = (*mocks.AnnouncementRepository)(nil)
+	_ AvailabilityEventRepository       = (*mocks.AvailabilityEventRepository)(nil)
+	_ AvailabilityGuildRepository       = (*mocks.AvailabilityGuildRepository)(nil)
+	_ AvailabilityRepository            = (*mocks.AvailabilityRepository)(nil)
+	_ ClientErrorRepository             = (*mocks.ClientErrorRepository)(nil)
+	_ ConsentRepository                 = (*mocks.ConsentRepository)(nil)
+	_ ContentQuestionRepository         = (*mocks.ContentQuestionRepository)(nil)
+	_ ContentRepository                 = (*mocks.ContentRepository)(nil)
+	_ DevicePairingRepository           = (*mocks.DevicePairingRepository)(nil)
+	_ DevicePairingUserRepository       = (*mocks.DevicePairingUserRepository)(nil)
+	_ DeviceTokenRepository             = (*mocks.DeviceTokenRepository)(nil)
+	_ DiscoveryLanguageRepository       = (*mocks.DiscoveryLanguageRepository)(nil)
+	_ DraftRepository                   = (*mocks.DraftRepository)(nil)
+	_ EmailChangeRepository             = (*mocks.EmailChangeRepository)(nil)
+	_ EventGuildRepository              = (*mocks.EventGuildRepository)(nil)
+	_ EventReminderProfileRepository    = (*mocks.EventReminderProfileRepository)(nil)
+	_ EventReminderRepository           = (*mocks.EventReminderRepository)(nil)
+	_ EventRepositoryInterface          = (*mocks.EventRepository)(nil)
+	_ EventRoleAlertHostRepository      = (*mocks.EventRoleAlertHostRepository)(nil)
+	_ EventRoleAlertRepository          = (*mocks.EventRoleAlertRepository)(nil)
+	_ EventRoleEventRepository          = (*mocks.EventRoleEventRepository)(nil)
+	_ EventRoleRepositoryInterface      = (*mocks.EventRoleRepository)(nil)
+	_ ExpenseEventRepository            = (*mocks.ExpenseEventRepository)(nil)
+	_ ExpenseRepository                 = (*mocks.ExpenseRepository)(nil)
+	_ ExperimentRepository              = (*mocks.ExperimentRepository)(nil)
+	_ GuildAnswerRepository             = (*mocks.GuildAnswerRepository)(nil)
+	_ GuildHealthRepository             = (*mocks.GuildHealthRepository)(nil)
+	_ GuildImportRepository             = (*mocks.GuildImportRepository)(nil)
+	_ GuildInviteRepository             = (*mocks.GuildInviteRepository)(nil)
+	_ GuildPermissionRepository         = (*mocks.GuildPermissionRepository)(nil)
+	_ GuildQuestionGuildRepository      = (*mocks.GuildQuestionGuildRepository)(nil)
+	_ GuildQuestionRepository           = (*mocks.GuildQuestionRepository)(nil)
+	_ GuildRepository                   = (*mocks.GuildRepository)(nil)
+	_ GuildSlugRepository               = (*mocks.GuildSlugRepository)(nil)
+	_ GuildTierGuildRepository          = (*mocks.GuildTierGuildRepository)(nil)
+	_ GuildTierRepository               = (*mocks.GuildTierRepository)(nil)
+	_ HandleRepository                  = (*mocks.HandleRepository)(nil)
+	_ HangoutTypeGuildRepository        = (*mocks.HangoutTypeGuildRepository)(nil)
+	_ HangoutTypeRepository             = (*mocks.HangoutTypeRepository)(nil)
+	_ IPDenyRepository                  = (*mocks.IPDenyRepository)(nil)
+	_ IdempotencyRepository             = (*mocks.IdempotencyRepository)(nil)
+	_ IdentityRepository                = (*mocks.IdentityRepository)(nil)
+	_ InterestRepository                = (*mocks.InterestRepository)(nil)
+	_ LegalHoldRepository               = (*mocks.LegalHoldRepository)(nil)
+	_ LegalHoldUserRepository           = (*mocks.LegalHoldUserRepository)(nil)
+	_ MarketingEmailUserRepository      = (*mocks.MarketingEmailUserRepository)(nil)
+	_ MemberRepository                  = (*mocks.MemberRepository)(nil)
+	_ ModerationRepository              = (*mocks.ModerationRepository)(nil)
+	_ MuteGuildRepository               = (*mocks.MuteGuildRepository)(nil)
+	_ MuteRepository                    = (*mocks.MuteRepository)(nil)
+	_ NoShowEventRepository             = (*mocks.NoShowEventRepository)(nil)
+	_ NoShowGuildRepository             = (*mocks.NoShowGuildRepository)(nil)
+	_ NoShowRepository                  = (*mocks.NoShowRepository)(nil)
+	_ NotificationOutboxRepository      = (*mocks.NotificationOutboxRepository)(nil)
+	_ NudgePreferenceRepository         = (*mocks.NudgePreferenceRepository)(nil)
+	_ OutboxRepository                  = (*mocks.OutboxRepository)(nil)
+	_ PasskeyRepository                 = (*mocks.PasskeyRepository)(nil)
+	_ PaymentEventRepository            = (*mocks.PaymentEventRepository)(nil)
+	_ PaymentGuildRepository            = (*mocks.PaymentGuildRepository)(nil)
+	_ PaymentRepository                 = (*mocks.PaymentRepository)(nil)
+	_ PermissionGuildRepository         = (*mocks.PermissionGuildRepository)(nil)
+	_ PoolRepository                    = (*mocks.PoolRepository)(nil)
+	_ PresenceRepository                = (*mocks.PresenceRepository)(nil)
+	_ ProfileGuildRepository            = (*mocks.ProfileGuildRepository)(nil)
+	_ ProfileModerationRepository       = (*mocks.ProfileModerationRepository)(nil)
+	_ ProfileNudgeRepository            = (*mocks.ProfileNudgeRepository)(nil)
+	_ ProfileRepository                 = (*mocks.ProfileRepository)(nil)
+	_ PublicationEventRepository        = (*mocks.PublicationEventRepository)(nil)
+	_ PublicationGuildRepository        = (*mocks.PublicationGuildRepository)(nil)
+	_ PublicationVoteRepository         = (*mocks.PublicationVoteRepository)(nil)
+	_ QuestionnaireRepository           = (*mocks.QuestionnaireRepository)(nil)
+	_ QuotaRepository                   = (*mocks.QuotaRepository)(nil)
+	_ QuotaUserRepository               = (*mocks.QuotaUserRepository)(nil)
+	_ ReactionAnnouncementRepository    = (*mocks.ReactionAnnouncementRepository)(nil)
+	_ ReactionEventRepository           = (*mocks.ReactionEventRepository)(nil)
+	_ ReactionRepository                = (*mocks.ReactionRepository)(nil)
+	_ RemoteConfigRepository            = (*mocks.RemoteConfigRepository)(nil)
+	_ ResonanceRepository               = (*mocks.ResonanceRepository)(nil)
+	_ ReviewRepository                  = (*mocks.ReviewRepository)(nil)
+	_ RideRequestRepository             = (*mocks.RideRequestRepository)(nil)
+	_ RideshareEventRepository          = (*mocks.RideshareEventRepository)(nil)
+	_ RideshareRepository               = (*mocks.RideshareRepository)(nil)
+	_ RideshareRoleRepository           = (*mocks.RideshareRoleRepository)(nil)
+	_ RoleCatalogRepository             = (*mocks.RoleCatalogRepository)(nil)
+	_ SecurityEventRepository           = (*mocks.SecurityEventRepository)(nil)
+	_ ShareLinkRepository               = (*mocks.ShareLinkRepository)(nil)
+	_ SignupRiskRepository              = (*mocks.SignupRiskRepository)(nil)
+	_ SignupRiskUserRepository          = (*mocks.SignupRiskUserRepository)(nil)
+	_ SpontaneousAvailabilityRepository = (*mocks.SpontaneousAvailabilityRepository)(nil)
+	_ SpontaneousInterestRepository     = (*mocks.SpontaneousInterestRepository)(nil)
+	_ SpontaneousProfileRepository      = (*mocks.SpontaneousProfileRepository)(nil)
+	_ SpontaneousRepository             = (*mocks.SpontaneousRepository)(nil)
+	_ TrashGuildRepository              = (*mocks.TrashGuildRepository)(nil)
+	_ TrashPoolRepository               = (*mocks.TrashPoolRepository)(nil)
+	_ TrashRepository                   = (*mocks.TrashRepository)(nil)
+	_ TrustRatingRepository             = (*mocks.TrustRatingRepository)(nil)
+	_ TrustRepositoryInterface          = (*mocks.TrustRepository)(nil)
+	_ UndoAvailabilityRepository        = (*mocks.UndoAvailabilityRepository)(nil)
+	_ UndoEventRepository               = (*mocks.UndoEventRepository)(nil)
+	_ UndoGuildRepository               = (*mocks.UndoGuildRepository)(nil)
+	_ UndoRepository                    = (*mocks.UndoRepository)(nil)
+	_ UserImportRepository              = (*mocks.UserImportRepository)(nil)
+	_ UserInvitationRepository          = (*mocks.UserInvitationRepository)(nil)
+	_ UserRepository                    = (*mocks.UserRepository)(nil)
+	_ VenueGuildRepository              = (*mocks.VenueGuildRepository)(nil)
+	_ VenueRepository                   = (*mocks.VenueRepository)(nil)
+	_ VoteReminderRepository            = (*mocks.VoteReminderRepository)(nil)
+	_ VoteRepository                    = (*mocks.VoteRepository)(nil)
+	_ VoteUserRepository                = (*mocks.VoteUserRepository)(nil)
+	_ WinBackProfileRepository          = (*mocks.WinBackProfileRepository)(nil)
+	_ WinBackRepository                 = (*mocks.WinBackRepository)(nil)
+)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/model"
 )
 
@@ -20,10 +21,10 @@ type ModerationRepository interface {
 	// Actions
 	CreateAction(ctx context.Context, action *model.ModerationAction) error
 	GetAction(ctx context.Context, id string) (*model.ModerationAction, error)
-	GetActiveActionsForUser(ctx context.Context, userID string) ([]*model.ModerationAction, error)
+	GetActiveActionsForUser(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error)
 	GetAllActionsForUser(ctx context.Context, userID string) ([]*model.ModerationAction, error)
 	UpdateAction(ctx context.Context, id string, updates map[string]interface{}) error
-	ExpireOldActions(ctx context.Context) error
+	ExpireOldActions(ctx context.Context, now time.Time) error
 
 	// Blocks
 	CreateBlock(ctx context.Context, block *model.Block) error
@@ -43,6 +44,7 @@ type ModerationRepository interface {
 type ModerationService struct {
 	moderationRepo ModerationRepository
 	eventHub       *EventHub
+	clock          clock.Clock
 }
 
 // NewModerationService creates a new moderation service
@@ -50,6 +52,7 @@ func NewModerationService(moderationRepo ModerationRepository, eventHub *EventHu
 	return &ModerationService{
 		moderationRepo: moderationRepo,
 		eventHub:       eventHub,
+		clock:          clock.Real,
 	}
 }
 
@@ -135,7 +138,7 @@ func (s *ModerationService) ReviewReport(ctx context.Context, reportID, reviewer
 	updates := map[string]interface{}{
 		"status":         req.Status,
 		"reviewed_by_id": reviewerID,
-		"reviewed_on":    time.Now(),
+		"reviewed_on":    s.clock.Now(),
 	}
 	if req.Notes != nil {
 		updates["review_notes"] = *req.Notes
@@ -144,7 +147,7 @@ func (s *ModerationService) ReviewReport(ctx context.Context, reportID, reviewer
 		updates["action_taken"] = *req.ActionTaken
 	}
 	if req.Status == string(model.ReportStatusResolved) {
-		updates["resolved_on"] = time.Now()
+		updates["resolved_on"] = s.clock.Now()
 	}
 
 	return s.moderationRepo.UpdateReport(ctx, reportID, updates)
@@ -178,7 +181,7 @@ func (s *ModerationService) TakeAction(ctx context.Context, adminUserID string,
 	// Set expiration based on level
 	switch action.Level {
 	case model.ModerationLevelWarning:
-		expires := time.Now().AddDate(0, 0, model.WarningDurationDays)
+		expires := s.clock.Now().AddDate(0, 0, model.WarningDurationDays)
 		action.ExpiresOn = &expires
 		dur := model.WarningDurationDays
 		action.Duration = &dur
@@ -187,7 +190,7 @@ func (s *ModerationService) TakeAction(ctx context.Context, adminUserID string,
 		if req.DurationDays != nil && *req.DurationDays > 0 {
 			days = *req.DurationDays
 		}
-		expires := time.Now().AddDate(0, 0, days)
+		expires := s.clock.Now().AddDate(0, 0, days)
 		action.ExpiresOn = &expires
 		action.Duration = &days
 	case model.ModerationLevelBan:
@@ -231,7 +234,7 @@ func (s *ModerationService) LiftAction(ctx context.Context, actionID, adminUserI
 
 	updates := map[string]interface{}{
 		"is_active":    false,
-		"lifted_on":    time.Now(),
+		"lifted_on":    s.clock.Now(),
 		"lifted_by_id": adminUserID,
 		"lift_reason":  req.Reason,
 	}
@@ -242,7 +245,7 @@ func (s *ModerationService) LiftAction(ctx context.Context, actionID, adminUserI
 // GetUserModerationStatus retrieves a user's current moderation standing
 func (s *ModerationService) GetUserModerationStatus(ctx context.Context, userID string) (*model.UserModerationStatus, error) {
 	// Get active actions
-	actionPtrs, err := s.moderationRepo.GetActiveActionsForUser(ctx, userID)
+	actionPtrs, err := s.moderationRepo.GetActiveActionsForUser(ctx, userID, s.clock.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -332,7 +335,7 @@ func (s *ModerationService) CanUserAccess(ctx context.Context, userID string) er
 
 // ExpireOldActions expires old moderation actions (should be run periodically)
 func (s *ModerationService) ExpireOldActions(ctx context.Context) error {
-	return s.moderationRepo.ExpireOldActions(ctx)
+	return s.moderationRepo.ExpireOldActions(ctx, s.clock.Now())
 }
 
 // Block operations
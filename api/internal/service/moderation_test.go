@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
 )
 
 // ============================================================================
@@ -22,10 +23,10 @@ type mockModerationRepo struct {
 	updateReportFunc          func(ctx context.Context, id string, updates map[string]interface{}) (*model.Report, error)
 	createActionFunc          func(ctx context.Context, action *model.ModerationAction) error
 	getActionFunc             func(ctx context.Context, id string) (*model.ModerationAction, error)
-	getActiveActionsFunc      func(ctx context.Context, userID string) ([]*model.ModerationAction, error)
+	getActiveActionsFunc      func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error)
 	getAllActionsFunc         func(ctx context.Context, userID string) ([]*model.ModerationAction, error)
 	updateActionFunc          func(ctx context.Context, id string, updates map[string]interface{}) error
-	expireOldActionsFunc      func(ctx context.Context) error
+	expireOldActionsFunc      func(ctx context.Context, now time.Time) error
 	createBlockFunc           func(ctx context.Context, block *model.Block) error
 	getBlockFunc              func(ctx context.Context, blockerID, blockedID string) (*model.Block, error)
 	getBlocksByBlockerFunc    func(ctx context.Context, blockerID string) ([]*model.Block, error)
@@ -93,9 +94,9 @@ func (m *mockModerationRepo) GetAction(ctx context.Context, id string) (*model.M
 	return nil, nil
 }
 
-func (m *mockModerationRepo) GetActiveActionsForUser(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+func (m *mockModerationRepo) GetActiveActionsForUser(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 	if m.getActiveActionsFunc != nil {
-		return m.getActiveActionsFunc(ctx, userID)
+		return m.getActiveActionsFunc(ctx, userID, now)
 	}
 	return nil, nil
 }
@@ -114,9 +115,9 @@ func (m *mockModerationRepo) UpdateAction(ctx context.Context, id string, update
 	return nil
 }
 
-func (m *mockModerationRepo) ExpireOldActions(ctx context.Context) error {
+func (m *mockModerationRepo) ExpireOldActions(ctx context.Context, now time.Time) error {
 	if m.expireOldActionsFunc != nil {
-		return m.expireOldActionsFunc(ctx)
+		return m.expireOldActionsFunc(ctx, now)
 	}
 	return nil
 }
@@ -672,7 +673,7 @@ func TestGetUserModerationStatus_Clean(t *testing.T) {
 	ctx := context.Background()
 
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{}, nil
 		},
 		getReportsAgainstUserFunc: func(ctx context.Context, userID string) ([]*model.Report, error) {
@@ -704,7 +705,7 @@ func TestGetUserModerationStatus_Banned(t *testing.T) {
 	ctx := context.Background()
 
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{
 				{ID: "action:1", Level: model.ModerationLevelBan, IsActive: true},
 			}, nil
@@ -733,7 +734,7 @@ func TestGetUserModerationStatus_Suspended(t *testing.T) {
 
 	expires := time.Now().Add(24 * time.Hour)
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{
 				{ID: "action:1", Level: model.ModerationLevelSuspension, IsActive: true, ExpiresOn: &expires},
 			}, nil
@@ -759,13 +760,78 @@ func TestGetUserModerationStatus_Suspended(t *testing.T) {
 	}
 }
 
+func TestGetUserModerationStatus_SuspensionEndsWithClock(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	clk := fakeclock.New(start)
+
+	// The repository filters by the time it is given, as the query does
+	var actions []*model.ModerationAction
+	repo := &mockModerationRepo{
+		createActionFunc: func(ctx context.Context, action *model.ModerationAction) error {
+			actions = append(actions, action)
+			return nil
+		},
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
+			var active []*model.ModerationAction
+			for _, a := range actions {
+				if a.ExpiresOn == nil || a.ExpiresOn.After(now) {
+					active = append(active, a)
+				}
+			}
+			return active, nil
+		},
+		getReportsAgainstUserFunc: func(ctx context.Context, userID string) ([]*model.Report, error) {
+			return []*model.Report{}, nil
+		},
+		getRecentReportsFunc: func(ctx context.Context, userID string, days int) ([]*model.Report, error) {
+			return []*model.Report{}, nil
+		},
+	}
+	svc := NewModerationService(repo, nil)
+	svc.clock = clk
+
+	action, err := svc.TakeAction(ctx, "admin:1", &model.CreateModerationActionRequest{
+		UserID: "user:1",
+		Level:  "suspension",
+		Reason: "Repeated violations",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := start.AddDate(0, 0, model.DefaultSuspensionDays)
+	if action.ExpiresOn == nil || !action.ExpiresOn.Equal(want) {
+		t.Fatalf("expected suspension to end at %v, got %v", want, action.ExpiresOn)
+	}
+
+	clk.Set(want.Add(-time.Second))
+	status, err := svc.GetUserModerationStatus(ctx, "user:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !status.IsSuspended {
+		t.Error("expected suspended until the end time")
+	}
+
+	clk.Advance(time.Second)
+	status, err = svc.GetUserModerationStatus(ctx, "user:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.IsSuspended {
+		t.Error("expected suspension to have ended")
+	}
+}
+
 func TestGetUserModerationStatus_WithWarning(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
 	expires := time.Now().Add(7 * 24 * time.Hour)
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{
 				{ID: "action:1", Level: model.ModerationLevelWarning, IsActive: true, ExpiresOn: &expires},
 			}, nil
@@ -796,7 +862,7 @@ func TestGetUserModerationStatus_WithRestrictions(t *testing.T) {
 	ctx := context.Background()
 
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{
 				{
 					ID:           "action:1",
@@ -833,7 +899,7 @@ func TestIsUserRestricted_NotRestricted(t *testing.T) {
 	ctx := context.Background()
 
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{}, nil
 		},
 		getReportsAgainstUserFunc: func(ctx context.Context, userID string) ([]*model.Report, error) {
@@ -859,7 +925,7 @@ func TestIsUserRestricted_Banned(t *testing.T) {
 	ctx := context.Background()
 
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{
 				{ID: "action:1", Level: model.ModerationLevelBan, IsActive: true},
 			}, nil
@@ -887,7 +953,7 @@ func TestIsUserRestricted_SpecificRestriction(t *testing.T) {
 	ctx := context.Background()
 
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{
 				{
 					ID:           "action:1",
@@ -934,7 +1000,7 @@ func TestCanUserAccess_Allowed(t *testing.T) {
 	ctx := context.Background()
 
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{}, nil
 		},
 		getReportsAgainstUserFunc: func(ctx context.Context, userID string) ([]*model.Report, error) {
@@ -957,7 +1023,7 @@ func TestCanUserAccess_Banned(t *testing.T) {
 	ctx := context.Background()
 
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{
 				{ID: "action:1", Level: model.ModerationLevelBan, IsActive: true},
 			}, nil
@@ -983,7 +1049,7 @@ func TestCanUserAccess_Suspended(t *testing.T) {
 
 	expires := time.Now().Add(24 * time.Hour)
 	repo := &mockModerationRepo{
-		getActiveActionsFunc: func(ctx context.Context, userID string) ([]*model.ModerationAction, error) {
+		getActiveActionsFunc: func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error) {
 			return []*model.ModerationAction{
 				{ID: "action:1", Level: model.ModerationLevelSuspension, IsActive: true, ExpiresOn: &expires},
 			}, nil
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// MuteRepository defines the interface for mute storage
+type MuteRepository interface {
+	CreateMute(ctx context.Context, mute *model.Mute) error
+	ListMutes(ctx context.Context, userID string, now time.Time) ([]*model.Mute, error)
+	CountMutes(ctx context.Context, userID string, now time.Time) (int, error)
+	DeleteMute(ctx context.Context, userID, muteID string) (bool, error)
+	ListMutesFor(ctx context.Context, scope model.NotificationScope, now time.Time) ([]*model.Mute, error)
+}
+
+// MuteGuildRepository provides the guild membership check for guild mutes
+type MuteGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+}
+
+// NotificationMutes drops the users who muted a guild broadcast from its
+// recipients
+type NotificationMutes interface {
+	FilterMuted(ctx context.Context, userIDs []string, scope model.NotificationScope) ([]string, error)
+}
+
+// MuteService manages the guilds and event categories users have muted,
+// and filters guild broadcasts by them
+type MuteService struct {
+	repo   MuteRepository
+	guilds MuteGuildRepository
+	clock  clock.Clock
+}
+
+// MuteServiceConfig holds configuration for the mute service
+type MuteServiceConfig struct {
+	Repo   MuteRepository
+	Guilds MuteGuildRepository
+	Clock  clock.Clock // Default: the system clock
+}
+
+// NewMuteService creates a new mute service
+func NewMuteService(cfg MuteServiceConfig) *MuteService {
+	return &MuteService{
+		repo:   cfg.Repo,
+		guilds: cfg.Guilds,
+		clock:  clock.OrReal(cfg.Clock),
+	}
+}
+
+// ListMutes returns the user's unexpired mutes, newest first
+func (s *MuteService) ListMutes(ctx context.Context, userID string) ([]*model.Mute, error) {
+	return s.repo.ListMutes(ctx, userID, s.clock.Now())
+}
+
+// CreateMute mutes a guild the user belongs to, or an event category.
+// Muting something already muted replaces that mute.
+func (s *MuteService) CreateMute(ctx context.Context, userID string, req *model.CreateMuteRequest) (*model.Mute, error) {
+	now := s.clock.Now()
+	if req.Until != nil && !req.Until.After(now) {
+		return nil, model.NewValidationError([]model.FieldError{{Field: "until", Message: "until must be in the future"}})
+	}
+
+	if req.Kind == model.MuteKindGuild {
+		isMember, err := s.guilds.IsMember(ctx, userID, *req.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("checking guild membership: %w", err)
+		}
+		if !isMember {
+			return nil, ErrNotGuildMember
+		}
+	}
+
+	count, err := s.repo.CountMutes(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	if count >= model.MaxMutesPerUser {
+		return nil, ErrTooManyMutes
+	}
+
+	mute := &model.Mute{
+		UserID:   userID,
+		Kind:     req.Kind,
+		GuildID:  req.GuildID,
+		Category: req.Category,
+		Until:    req.Until,
+	}
+	if err := s.repo.CreateMute(ctx, mute); err != nil {
+		return nil, err
+	}
+	return mute, nil
+}
+
+// DeleteMute unmutes one of the user's mutes
+func (s *MuteService) DeleteMute(ctx context.Context, userID, muteID string) error {
+	deleted, err := s.repo.DeleteMute(ctx, userID, muteID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrMuteNotFound
+	}
+	return nil
+}
+
+// FilterMuted returns userIDs without the users who muted the guild or
+// event category a broadcast is about
+func (s *MuteService) FilterMuted(ctx context.Context, userIDs []string, scope model.NotificationScope) ([]string, error) {
+	if len(userIDs) == 0 {
+		return userIDs, nil
+	}
+
+	now := s.clock.Now()
+	mutes, err := s.repo.ListMutesFor(ctx, scope, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(mutes) == 0 {
+		return userIDs, nil
+	}
+
+	muted := make(map[string]bool, len(mutes))
+	for _, mute := range mutes {
+		if mute.Silences(scope, now) {
+			muted[mute.UserID] = true
+		}
+	}
+
+	kept := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if !muted[userID] {
+			kept = append(kept, userID)
+		}
+	}
+	return kept, nil
+}
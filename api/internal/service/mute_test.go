@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Mute Tests
+// ============================================================================
+
+var muteTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newMuteService(repo *mocks.MuteRepository) *MuteService {
+	guilds := &mocks.MuteGuildRepository{
+		IsMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return userID != "user:outsider", nil
+		},
+	}
+	return NewMuteService(MuteServiceConfig{Repo: repo, Guilds: guilds, Clock: fakeclock.New(muteTestNow)})
+}
+
+func TestCreateMute_NotMember(t *testing.T) {
+	t.Parallel()
+
+	created := false
+	svc := newMuteService(&mocks.MuteRepository{
+		CreateMuteFunc: func(ctx context.Context, mute *model.Mute) error {
+			created = true
+			return nil
+		},
+	})
+	guildID := "guild:1"
+	req := &model.CreateMuteRequest{Kind: model.MuteKindGuild, GuildID: &guildID}
+
+	if _, err := svc.CreateMute(context.Background(), "user:outsider", req); !errors.Is(err, ErrNotGuildMember) {
+		t.Errorf("expected ErrNotGuildMember, got %v", err)
+	}
+	if created {
+		t.Error("expected no mute for a guild the user isn't in")
+	}
+	if _, err := svc.CreateMute(context.Background(), "user:member", req); err != nil {
+		t.Errorf("expected members to mute their guild, got %v", err)
+	}
+}
+
+func TestCreateMute_Until(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		until     time.Time
+		wantValid bool
+	}{
+		{"in the past", muteTestNow.Add(-time.Hour), false},
+		{"now", muteTestNow, false},
+		{"in the future", muteTestNow.Add(time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := newMuteService(&mocks.MuteRepository{})
+			category := model.EventTemplateTrip
+			req := &model.CreateMuteRequest{Kind: model.MuteKindEventCategory, Category: &category, Until: &tt.until}
+
+			_, err := svc.CreateMute(context.Background(), "user:member", req)
+			var pd *model.ProblemDetails
+			if invalid := errors.As(err, &pd); invalid == tt.wantValid {
+				t.Errorf("expected valid %v, got %v", tt.wantValid, err)
+			}
+		})
+	}
+}
+
+func TestCreateMute_TooMany(t *testing.T) {
+	t.Parallel()
+
+	svc := newMuteService(&mocks.MuteRepository{
+		CountMutesFunc: func(ctx context.Context, userID string, now time.Time) (int, error) {
+			return model.MaxMutesPerUser, nil
+		},
+	})
+	category := model.EventTemplateTrip
+	req := &model.CreateMuteRequest{Kind: model.MuteKindEventCategory, Category: &category}
+
+	if _, err := svc.CreateMute(context.Background(), "user:member", req); !errors.Is(err, ErrTooManyMutes) {
+		t.Errorf("expected ErrTooManyMutes, got %v", err)
+	}
+}
+
+func TestDeleteMute_NotFound(t *testing.T) {
+	t.Parallel()
+
+	svc := newMuteService(&mocks.MuteRepository{
+		DeleteMuteFunc: func(ctx context.Context, userID, muteID string) (bool, error) {
+			return false, nil
+		},
+	})
+
+	if err := svc.DeleteMute(context.Background(), "user:member", "mute:other"); !errors.Is(err, ErrMuteNotFound) {
+		t.Errorf("expected ErrMuteNotFound, got %v", err)
+	}
+}
+
+func TestFilterMuted(t *testing.T) {
+	t.Parallel()
+
+	guildID := "guild:1"
+	category := model.EventTemplateTrip
+	lapsed := muteTestNow.Add(-time.Minute)
+	svc := newMuteService(&mocks.MuteRepository{
+		ListMutesForFunc: func(ctx context.Context, scope model.NotificationScope, now time.Time) ([]*model.Mute, error) {
+			return []*model.Mute{
+				{UserID: "user:guild", Kind: model.MuteKindGuild, GuildID: &guildID},
+				{UserID: "user:trips", Kind: model.MuteKindEventCategory, Category: &category},
+				{UserID: "user:lapsed", Kind: model.MuteKindGuild, GuildID: &guildID, Until: &lapsed},
+			}, nil
+		},
+	})
+
+	kept, err := svc.FilterMuted(context.Background(), []string{"user:guild", "user:trips", "user:lapsed", "user:other"},
+		model.NotificationScope{GuildID: guildID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 3 || kept[0] != "user:trips" || kept[1] != "user:lapsed" || kept[2] != "user:other" {
+		t.Errorf("expected only the unexpired guild mute to apply to a vote, got %v", kept)
+	}
+
+	kept, err = svc.FilterMuted(context.Background(), []string{"user:guild", "user:trips", "user:other"},
+		model.NotificationScope{GuildID: guildID, EventCategory: category})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 1 || kept[0] != "user:other" {
+		t.Errorf("expected both mutes to apply to a new trip, got %v", kept)
+	}
+}
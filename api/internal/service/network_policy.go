@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// NetworkPolicyEnforcer turns client addresses away. The network policy
+// middleware implements it.
+type NetworkPolicyEnforcer interface {
+	AdminAllowlist() []string
+	DenyList() []*model.IPDenyEntry
+	Deny(entry *model.IPDenyEntry)
+	RemoveDeny(id string)
+}
+
+// IPDenyRepository defines the interface for deny list storage
+type IPDenyRepository interface {
+	Create(ctx context.Context, entry *model.IPDenyEntry) error
+	List(ctx context.Context) ([]*model.IPDenyEntry, error)
+	Delete(ctx context.Context, id string) (*model.IPDenyEntry, error)
+}
+
+// NetworkPolicyService lets admins manage the IP deny list. The enforcer
+// checks requests against it; entries are stored so they survive restarts.
+// The admin allowlist comes from configuration and is only shown here.
+type NetworkPolicyService struct {
+	repo     IPDenyRepository
+	enforcer NetworkPolicyEnforcer
+}
+
+// NetworkPolicyServiceConfig holds configuration for the network policy service
+type NetworkPolicyServiceConfig struct {
+	Repo     IPDenyRepository
+	Enforcer NetworkPolicyEnforcer
+}
+
+// NewNetworkPolicyService creates a new network policy service
+func NewNetworkPolicyService(cfg NetworkPolicyServiceConfig) *NetworkPolicyService {
+	return &NetworkPolicyService{
+		repo:     cfg.Repo,
+		enforcer: cfg.Enforcer,
+	}
+}
+
+// LoadDenyList applies the stored deny list to the enforcer. Call it on
+// startup.
+func (s *NetworkPolicyService) LoadDenyList(ctx context.Context) error {
+	entries, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		s.enforcer.Deny(entry)
+	}
+	return nil
+}
+
+// Get returns the admin allowlist and the deny list in force
+func (s *NetworkPolicyService) Get() *model.NetworkPolicy {
+	return &model.NetworkPolicy{
+		AdminAllowlist: s.enforcer.AdminAllowlist(),
+		DenyList:       s.enforcer.DenyList(),
+	}
+}
+
+// Deny turns a range away from the whole API. actorIP is the address of the
+// admin's request: a range holding it is refused, so admins can't lock
+// themselves out.
+func (s *NetworkPolicyService) Deny(ctx context.Context, actorID, actorIP string, req *model.CreateIPDenyRequest) (*model.IPDenyEntry, error) {
+	prefix, err := model.ParseNetwork(req.CIDR)
+	if err != nil {
+		return nil, err
+	}
+	if addr, err := netip.ParseAddr(actorIP); err == nil && prefix.Contains(addr.Unmap()) {
+		return nil, ErrDenyOwnAddress
+	}
+
+	entry := &model.IPDenyEntry{
+		CIDR:      prefix.String(),
+		Reason:    req.Reason,
+		CreatedBy: actorID,
+	}
+	if req.DurationMinutes > 0 {
+		expiresAt := time.Now().Add(time.Duration(req.DurationMinutes) * time.Minute)
+		entry.ExpiresAt = &expiresAt
+	}
+	if err := s.repo.Create(ctx, entry); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrIPAlreadyDenied
+		}
+		return nil, err
+	}
+
+	s.enforcer.Deny(entry)
+	return entry, nil
+}
+
+// RemoveDeny takes an entry off the deny list
+func (s *NetworkPolicyService) RemoveDeny(ctx context.Context, id string) error {
+	entry, err := s.repo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return ErrIPDenyNotFound
+	}
+
+	s.enforcer.RemoveDeny(entry.ID)
+	return nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+type stubNetworkPolicyEnforcer struct {
+	denied map[string]*model.IPDenyEntry
+}
+
+func (e *stubNetworkPolicyEnforcer) AdminAllowlist() []string { return nil }
+
+func (e *stubNetworkPolicyEnforcer) DenyList() []*model.IPDenyEntry {
+	entries := make([]*model.IPDenyEntry, 0, len(e.denied))
+	for _, entry := range e.denied {
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func (e *stubNetworkPolicyEnforcer) Deny(entry *model.IPDenyEntry) {
+	e.denied[entry.ID] = entry
+}
+
+func (e *stubNetworkPolicyEnforcer) RemoveDeny(id string) {
+	delete(e.denied, id)
+}
+
+func newTestNetworkPolicyService(repo *mocks.IPDenyRepository) (*NetworkPolicyService, *stubNetworkPolicyEnforcer) {
+	enforcer := &stubNetworkPolicyEnforcer{denied: make(map[string]*model.IPDenyEntry)}
+	return NewNetworkPolicyService(NetworkPolicyServiceConfig{Repo: repo, Enforcer: enforcer}), enforcer
+}
+
+func TestNetworkPolicyService_Deny(t *testing.T) {
+	var stored *model.IPDenyEntry
+	repo := &mocks.IPDenyRepository{
+		CreateFunc: func(ctx context.Context, entry *model.IPDenyEntry) error {
+			entry.ID = "ip_deny:1"
+			stored = entry
+			return nil
+		},
+	}
+	svc, enforcer := newTestNetworkPolicyService(repo)
+
+	entry, err := svc.Deny(context.Background(), "user:admin", "10.0.0.5", &model.CreateIPDenyRequest{
+		CIDR:            "198.51.100.77/24",
+		Reason:          "credential stuffing",
+		DurationMinutes: 60,
+	})
+	if err != nil {
+		t.Fatalf("Deny() error = %v", err)
+	}
+	if stored == nil || stored.CIDR != "198.51.100.0/24" {
+		t.Errorf("stored %+v, want the masked range 198.51.100.0/24", stored)
+	}
+	if entry.ExpiresAt == nil {
+		t.Error("expected an expiry for a timed entry")
+	}
+	if enforcer.denied["ip_deny:1"] == nil {
+		t.Error("expected the entry to be enforced")
+	}
+}
+
+func TestNetworkPolicyService_Deny_Refused(t *testing.T) {
+	repo := &mocks.IPDenyRepository{
+		CreateFunc: func(ctx context.Context, entry *model.IPDenyEntry) error {
+			return database.ErrDuplicate
+		},
+	}
+	svc, enforcer := newTestNetworkPolicyService(repo)
+
+	_, err := svc.Deny(context.Background(), "user:admin", "198.51.100.4", &model.CreateIPDenyRequest{CIDR: "198.51.100.0/24", Reason: "abuse"})
+	if !errors.Is(err, ErrDenyOwnAddress) {
+		t.Errorf("own address: err = %v, want ErrDenyOwnAddress", err)
+	}
+
+	_, err = svc.Deny(context.Background(), "user:admin", "10.0.0.5", &model.CreateIPDenyRequest{CIDR: "198.51.100.0/24", Reason: "abuse"})
+	if !errors.Is(err, ErrIPAlreadyDenied) {
+		t.Errorf("duplicate: err = %v, want ErrIPAlreadyDenied", err)
+	}
+	if len(enforcer.denied) != 0 {
+		t.Errorf("enforced %d entries, want 0", len(enforcer.denied))
+	}
+}
+
+func TestNetworkPolicyService_RemoveDeny(t *testing.T) {
+	repo := &mocks.IPDenyRepository{
+		ListFunc: func(ctx context.Context) ([]*model.IPDenyEntry, error) {
+			return []*model.IPDenyEntry{{ID: "ip_deny:1", CIDR: "198.51.100.0/24"}}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id string) (*model.IPDenyEntry, error) {
+			if id != "ip_deny:1" {
+				return nil, nil
+			}
+			return &model.IPDenyEntry{ID: id}, nil
+		},
+	}
+	svc, enforcer := newTestNetworkPolicyService(repo)
+	if err := svc.LoadDenyList(context.Background()); err != nil {
+		t.Fatalf("LoadDenyList() error = %v", err)
+	}
+
+	if err := svc.RemoveDeny(context.Background(), "ip_deny:2"); !errors.Is(err, ErrIPDenyNotFound) {
+		t.Errorf("missing entry: err = %v, want ErrIPDenyNotFound", err)
+	}
+	if err := svc.RemoveDeny(context.Background(), "ip_deny:1"); err != nil {
+		t.Fatalf("RemoveDeny() error = %v", err)
+	}
+	if len(enforcer.denied) != 0 {
+		t.Errorf("enforced %d entries after removal, want 0", len(enforcer.denied))
+	}
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// NoShowRepository defines the interface for no-show storage
+type NoShowRepository interface {
+	GetEventsAwaitingCheck(ctx context.Context, endedBefore time.Time, limit int) ([]*model.NoShowEventRef, error)
+	GetUnattendedUserIDs(ctx context.Context, eventID string) ([]string, error)
+	MarkEventChecked(ctx context.Context, eventID string) error
+	Create(ctx context.Context, noShow *model.NoShow) error
+	Get(ctx context.Context, eventID, userID string) (*model.NoShow, error)
+	GetByUser(ctx context.Context, userID string, since time.Time) ([]*model.NoShow, error)
+	Delete(ctx context.Context, id string) error
+	CountCheckedRSVPs(ctx context.Context, userID string, since time.Time) (int, error)
+	GetPolicy(ctx context.Context, guildID string) (*model.GuildNoShowPolicy, error)
+	UpsertPolicy(ctx context.Context, policy *model.GuildNoShowPolicy) error
+}
+
+// NoShowEventRepository provides event lookups for no-show handling
+type NoShowEventRepository interface {
+	Get(ctx context.Context, eventID string) (*model.Event, error)
+	IsHost(ctx context.Context, eventID, userID string) (bool, error)
+	GetRSVP(ctx context.Context, eventID, userID string) (*model.EventRSVP, error)
+}
+
+// NoShowGuildRepository provides guild permission checks for no-show handling
+type NoShowGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error)
+}
+
+// NoShowService handles no-show detection, reliability stats and guild policies
+type NoShowService struct {
+	repo      NoShowRepository
+	eventRepo NoShowEventRepository
+	guildRepo NoShowGuildRepository
+	clock     clock.Clock
+}
+
+// NoShowServiceConfig holds configuration for the no-show service
+type NoShowServiceConfig struct {
+	Repo      NoShowRepository
+	EventRepo NoShowEventRepository
+	GuildRepo NoShowGuildRepository
+	Clock     clock.Clock // Default: the system clock
+}
+
+// NewNoShowService creates a new no-show service
+func NewNoShowService(cfg NoShowServiceConfig) *NoShowService {
+	return &NoShowService{
+		repo:      cfg.Repo,
+		eventRepo: cfg.EventRepo,
+		guildRepo: cfg.GuildRepo,
+		clock:     clock.OrReal(cfg.Clock),
+	}
+}
+
+// noShowDetectionBatchSize limits how many events are checked per run
+const noShowDetectionBatchSize = 100
+
+// DetectNoShows marks approved attendees of ended events who never checked in
+// and did not confirm completion. Events are only checked once the grace
+// period after their end has passed.
+// This should be called periodically by a background job.
+func (s *NoShowService) DetectNoShows(ctx context.Context) error {
+	cutoff := s.clock.Now().Add(-model.NoShowGracePeriod)
+
+	events, err := s.repo.GetEventsAwaitingCheck(ctx, cutoff, noShowDetectionBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		userIDs, err := s.repo.GetUnattendedUserIDs(ctx, event.EventID)
+		if err != nil {
+			log.Printf("[NoShowService] Failed to get unattended RSVPs for event %s: %v", event.EventID, err)
+			continue
+		}
+
+		for _, userID := range userIDs {
+			noShow := &model.NoShow{
+				UserID:  userID,
+				EventID: event.EventID,
+				GuildID: event.GuildID,
+				Source:  model.NoShowSourceDetected,
+			}
+			if err := s.repo.Create(ctx, noShow); err != nil && !errors.Is(err, database.ErrDuplicate) {
+				log.Printf("[NoShowService] Failed to record no-show for user %s event %s: %v", userID, event.EventID, err)
+			}
+		}
+
+		if err := s.repo.MarkEventChecked(ctx, event.EventID); err != nil {
+			log.Printf("[NoShowService] Failed to mark event %s as checked: %v", event.EventID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetUserStats returns a user's no-show stats over the given lookback window
+func (s *NoShowService) GetUserStats(ctx context.Context, userID string, lookbackDays int) (*model.NoShowStats, error) {
+	if lookbackDays <= 0 {
+		lookbackDays = model.DefaultNoShowLookbackDays
+	}
+	since := s.clock.Now().AddDate(0, 0, -lookbackDays)
+
+	noShows, err := s.repo.GetByUser(ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	checked, err := s.repo.CountCheckedRSVPs(ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeNoShowStats(userID, lookbackDays, checked, len(noShows)), nil
+}
+
+// GetStatsForHost returns an RSVP'er's no-show stats to a host of the event.
+// The guild's lookback window is used for guild events.
+func (s *NoShowService) GetStatsForHost(ctx context.Context, hostUserID, eventID, userID string) (*model.NoShowStats, error) {
+	event, err := s.requireHost(ctx, hostUserID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetStatsForEvent(ctx, event, userID)
+}
+
+// GetStatsForEvent returns a user's no-show stats using the lookback window of the event's guild.
+// Callers are responsible for authorization.
+func (s *NoShowService) GetStatsForEvent(ctx context.Context, event *model.Event, userID string) (*model.NoShowStats, error) {
+	lookbackDays := model.DefaultNoShowLookbackDays
+	if event.GuildID != nil {
+		policy, err := s.GetPolicy(ctx, *event.GuildID)
+		if err != nil {
+			return nil, err
+		}
+		lookbackDays = policy.LookbackDays
+	}
+	return s.GetUserStats(ctx, userID, lookbackDays)
+}
+
+// ShouldAutoDecline reports whether the guild policy requires declining the user's RSVP
+func (s *NoShowService) ShouldAutoDecline(ctx context.Context, guildID, userID string) (bool, *model.NoShowStats, error) {
+	policy, err := s.GetPolicy(ctx, guildID)
+	if err != nil {
+		return false, nil, err
+	}
+	if policy.AutoDeclineThreshold <= 0 {
+		return false, nil, nil
+	}
+
+	stats, err := s.GetUserStats(ctx, userID, policy.LookbackDays)
+	if err != nil {
+		return false, nil, err
+	}
+
+	return stats.NoShows >= policy.AutoDeclineThreshold, stats, nil
+}
+
+// MarkNoShow lets a host mark an approved attendee as a no-show after the event has ended
+func (s *NoShowService) MarkNoShow(ctx context.Context, hostUserID, eventID, userID string) (*model.NoShow, error) {
+	event, err := s.requireHost(ctx, hostUserID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	end := event.StartTime
+	if event.EndTime != nil {
+		end = *event.EndTime
+	}
+	if s.clock.Now().Before(end) {
+		return nil, ErrEventNotEnded
+	}
+
+	rsvp, err := s.eventRepo.GetRSVP(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if rsvp == nil || rsvp.Status != model.RSVPStatusApproved {
+		return nil, ErrRSVPNotFound
+	}
+
+	noShow := &model.NoShow{
+		UserID:  userID,
+		EventID: eventID,
+		GuildID: event.GuildID,
+		Source:  model.NoShowSourceHost,
+	}
+	if err := s.repo.Create(ctx, noShow); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return s.repo.Get(ctx, eventID, userID)
+		}
+		return nil, err
+	}
+
+	return noShow, nil
+}
+
+// RemoveNoShow removes an erroneous no-show mark. Event hosts and admins of
+// the event's guild may remove marks, which is how users appeal them.
+func (s *NoShowService) RemoveNoShow(ctx context.Context, actorUserID, eventID, userID string) error {
+	event, err := s.eventRepo.Get(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return ErrEventNotFound
+	}
+
+	allowed, err := s.eventRepo.IsHost(ctx, eventID, actorUserID)
+	if err != nil {
+		return err
+	}
+	if !allowed && event.GuildID != nil && s.guildRepo != nil {
+		allowed, err = s.guildRepo.IsGuildAdmin(ctx, actorUserID, *event.GuildID)
+		if err != nil {
+			return err
+		}
+	}
+	if !allowed {
+		return ErrNotEventHost
+	}
+
+	noShow, err := s.repo.Get(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if noShow == nil {
+		return ErrNoShowNotFound
+	}
+
+	return s.repo.Delete(ctx, noShow.ID)
+}
+
+// GetUserNoShows returns a user's own no-show records within the default window
+func (s *NoShowService) GetUserNoShows(ctx context.Context, userID string) ([]*model.NoShow, error) {
+	since := s.clock.Now().AddDate(0, 0, -model.DefaultNoShowLookbackDays)
+	return s.repo.GetByUser(ctx, userID, since)
+}
+
+// GetPolicy returns a guild's no-show policy, or the default (disabled) policy
+func (s *NoShowService) GetPolicy(ctx context.Context, guildID string) (*model.GuildNoShowPolicy, error) {
+	policy, err := s.repo.GetPolicy(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		policy = &model.GuildNoShowPolicy{GuildID: guildID}
+	}
+	if policy.LookbackDays <= 0 {
+		policy.LookbackDays = model.DefaultNoShowLookbackDays
+	}
+	return policy, nil
+}
+
+// GetPolicyForMember returns a guild's no-show policy to one of its members
+func (s *NoShowService) GetPolicyForMember(ctx context.Context, userID, guildID string) (*model.GuildNoShowPolicy, error) {
+	isMember, err := s.guildRepo.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, ErrNotGuildMember
+	}
+	return s.GetPolicy(ctx, guildID)
+}
+
+// UpdatePolicy updates a guild's no-show policy (guild admin only)
+func (s *NoShowService) UpdatePolicy(ctx context.Context, userID, guildID string, req *model.UpdateGuildNoShowPolicyRequest) (*model.GuildNoShowPolicy, error) {
+	isAdmin, err := s.guildRepo.IsGuildAdmin(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, ErrNotGuildAdmin
+	}
+
+	policy, err := s.GetPolicy(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AutoDeclineThreshold != nil {
+		policy.AutoDeclineThreshold = *req.AutoDeclineThreshold
+	}
+	if req.LookbackDays != nil {
+		policy.LookbackDays = *req.LookbackDays
+	}
+	policy.UpdatedBy = userID
+
+	if err := s.repo.UpsertPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// requireHost loads the event and verifies the user hosts it
+func (s *NoShowService) requireHost(ctx context.Context, userID, eventID string) (*model.Event, error) {
+	event, err := s.eventRepo.Get(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+
+	isHost, err := s.eventRepo.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isHost {
+		return nil, ErrNotEventHost
+	}
+
+	return event, nil
+}
+
+// computeNoShowStats derives stats from the number of checked RSVPs and no-shows
+func computeNoShowStats(userID string, lookbackDays, checkedRSVPs, noShows int) *model.NoShowStats {
+	attended := checkedRSVPs - noShows
+	if attended < 0 {
+		attended = 0
+	}
+
+	stats := &model.NoShowStats{
+		UserID:       userID,
+		LookbackDays: lookbackDays,
+		Attended:     attended,
+		NoShows:      noShows,
+	}
+	if total := attended + noShows; total > 0 {
+		stats.NoShowRate = float64(noShows) / float64(total)
+	}
+	return stats
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockNoShowRepo struct {
+	noShows       []*model.NoShow
+	checkedRSVPs  int
+	policy        *model.GuildNoShowPolicy
+	deletedID     string
+	unattended    map[string][]string
+	checkedEvents []string
+	awaiting      []*model.NoShowEventRef
+}
+
+func (m *mockNoShowRepo) GetEventsAwaitingCheck(ctx context.Context, endedBefore time.Time, limit int) ([]*model.NoShowEventRef, error) {
+	return m.awaiting, nil
+}
+
+func (m *mockNoShowRepo) GetUnattendedUserIDs(ctx context.Context, eventID string) ([]string, error) {
+	return m.unattended[eventID], nil
+}
+
+func (m *mockNoShowRepo) MarkEventChecked(ctx context.Context, eventID string) error {
+	m.checkedEvents = append(m.checkedEvents, eventID)
+	return nil
+}
+
+func (m *mockNoShowRepo) Create(ctx context.Context, noShow *model.NoShow) error {
+	noShow.ID = "no_show:" + noShow.UserID
+	m.noShows = append(m.noShows, noShow)
+	return nil
+}
+
+func (m *mockNoShowRepo) Get(ctx context.Context, eventID, userID string) (*model.NoShow, error) {
+	for _, n := range m.noShows {
+		if n.EventID == eventID && n.UserID == userID {
+			return n, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockNoShowRepo) GetByUser(ctx context.Context, userID string, since time.Time) ([]*model.NoShow, error) {
+	var result []*model.NoShow
+	for _, n := range m.noShows {
+		if n.UserID == userID {
+			result = append(result, n)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockNoShowRepo) Delete(ctx context.Context, id string) error {
+	m.deletedID = id
+	return nil
+}
+
+func (m *mockNoShowRepo) CountCheckedRSVPs(ctx context.Context, userID string, since time.Time) (int, error) {
+	return m.checkedRSVPs, nil
+}
+
+func (m *mockNoShowRepo) GetPolicy(ctx context.Context, guildID string) (*model.GuildNoShowPolicy, error) {
+	return m.policy, nil
+}
+
+func (m *mockNoShowRepo) UpsertPolicy(ctx context.Context, policy *model.GuildNoShowPolicy) error {
+	m.policy = policy
+	return nil
+}
+
+type mockNoShowEventRepo struct {
+	event  *model.Event
+	hostID string
+}
+
+func (m *mockNoShowEventRepo) Get(ctx context.Context, eventID string) (*model.Event, error) {
+	return m.event, nil
+}
+
+func (m *mockNoShowEventRepo) IsHost(ctx context.Context, eventID, userID string) (bool, error) {
+	return userID == m.hostID, nil
+}
+
+func (m *mockNoShowEventRepo) GetRSVP(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+	return &model.EventRSVP{EventID: eventID, UserID: userID, Status: model.RSVPStatusApproved}, nil
+}
+
+type mockNoShowGuildRepo struct {
+	adminID   string
+	memberIDs []string
+}
+
+func (m *mockNoShowGuildRepo) IsMember(ctx context.Context, userID, guildID string) (bool, error) {
+	for _, id := range m.memberIDs {
+		if id == userID {
+			return true, nil
+		}
+	}
+	return userID == m.adminID, nil
+}
+
+func (m *mockNoShowGuildRepo) IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error) {
+	return userID == m.adminID, nil
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestComputeNoShowStats(t *testing.T) {
+	t.Parallel()
+
+	stats := computeNoShowStats("user-1", 90, 10, 3)
+	if stats.Attended != 7 || stats.NoShows != 3 {
+		t.Errorf("expected 7 attended and 3 no-shows, got %d and %d", stats.Attended, stats.NoShows)
+	}
+	if stats.NoShowRate != 0.3 {
+		t.Errorf("expected rate 0.3, got %v", stats.NoShowRate)
+	}
+
+	empty := computeNoShowStats("user-1", 90, 0, 0)
+	if empty.NoShowRate != 0 {
+		t.Errorf("expected rate 0 with no history, got %v", empty.NoShowRate)
+	}
+}
+
+func TestDetectNoShows_MarksUnattendedAndChecksEvent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild:1"
+	repo := &mockNoShowRepo{
+		awaiting:   []*model.NoShowEventRef{{EventID: "event:1", GuildID: &guildID}},
+		unattended: map[string][]string{"event:1": {"user:a", "user:b"}},
+	}
+	svc := NewNoShowService(NoShowServiceConfig{Repo: repo})
+
+	if err := svc.DetectNoShows(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.noShows) != 2 {
+		t.Fatalf("expected 2 no-shows, got %d", len(repo.noShows))
+	}
+	if repo.noShows[0].Source != model.NoShowSourceDetected || repo.noShows[0].GuildID == nil {
+		t.Error("expected detected no-show with guild ID")
+	}
+	if len(repo.checkedEvents) != 1 || repo.checkedEvents[0] != "event:1" {
+		t.Errorf("expected event:1 to be marked checked, got %v", repo.checkedEvents)
+	}
+}
+
+func TestShouldAutoDecline(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := &mockNoShowRepo{
+		noShows: []*model.NoShow{
+			{UserID: "user-1", EventID: "event:1"},
+			{UserID: "user-1", EventID: "event:2"},
+			{UserID: "user-1", EventID: "event:3"},
+		},
+		checkedRSVPs: 5,
+	}
+	svc := NewNoShowService(NoShowServiceConfig{Repo: repo})
+
+	decline, _, err := svc.ShouldAutoDecline(ctx, "guild:1", "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decline {
+		t.Error("expected no auto-decline without a policy")
+	}
+
+	repo.policy = &model.GuildNoShowPolicy{GuildID: "guild:1", AutoDeclineThreshold: 3, LookbackDays: 90}
+	decline, stats, err := svc.ShouldAutoDecline(ctx, "guild:1", "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decline {
+		t.Error("expected auto-decline at threshold")
+	}
+	if stats == nil || stats.LookbackDays != 90 {
+		t.Errorf("expected stats using policy lookback, got %+v", stats)
+	}
+
+	repo.policy.AutoDeclineThreshold = 4
+	if decline, _, _ := svc.ShouldAutoDecline(ctx, "guild:1", "user-1"); decline {
+		t.Error("expected no auto-decline below threshold")
+	}
+}
+
+func TestRemoveNoShow_Authorization(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild:1"
+	newService := func() (*NoShowService, *mockNoShowRepo) {
+		repo := &mockNoShowRepo{noShows: []*model.NoShow{{ID: "no_show:1", UserID: "user-1", EventID: "event:1"}}}
+		svc := NewNoShowService(NoShowServiceConfig{
+			Repo:      repo,
+			EventRepo: &mockNoShowEventRepo{event: &model.Event{ID: "event:1", GuildID: &guildID}, hostID: "host"},
+			GuildRepo: &mockNoShowGuildRepo{adminID: "admin"},
+		})
+		return svc, repo
+	}
+
+	svc, _ := newService()
+	if err := svc.RemoveNoShow(ctx, "stranger", "event:1", "user-1"); !errors.Is(err, ErrNotEventHost) {
+		t.Errorf("expected ErrNotEventHost, got %v", err)
+	}
+
+	for _, actor := range []string{"host", "admin"} {
+		svc, repo := newService()
+		if err := svc.RemoveNoShow(ctx, actor, "event:1", "user-1"); err != nil {
+			t.Errorf("%s: unexpected error: %v", actor, err)
+		}
+		if repo.deletedID != "no_show:1" {
+			t.Errorf("%s: expected no_show:1 deleted, got %q", actor, repo.deletedID)
+		}
+	}
+
+	svc, _ = newService()
+	if err := svc.RemoveNoShow(ctx, "host", "event:1", "user-2"); !errors.Is(err, ErrNoShowNotFound) {
+		t.Errorf("expected ErrNoShowNotFound, got %v", err)
+	}
+}
+
+func TestMarkNoShow_RequiresEventEnded(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	start := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	clk := fakeclock.New(start.Add(-time.Hour))
+	repo := &mockNoShowRepo{}
+	svc := NewNoShowService(NoShowServiceConfig{
+		Repo:      repo,
+		EventRepo: &mockNoShowEventRepo{event: &model.Event{ID: "event:1", StartTime: start}, hostID: "host"},
+		Clock:     clk,
+	})
+
+	if _, err := svc.MarkNoShow(ctx, "host", "event:1", "user-1"); !errors.Is(err, ErrEventNotEnded) {
+		t.Errorf("expected ErrEventNotEnded, got %v", err)
+	}
+
+	clk.Advance(2 * time.Hour)
+	noShow, err := svc.MarkNoShow(ctx, "host", "event:1", "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if noShow.Source != model.NoShowSourceHost {
+		t.Errorf("expected host source, got %s", noShow.Source)
+	}
+}
+
+func TestGetPolicyForMember(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := &mockNoShowRepo{policy: &model.GuildNoShowPolicy{GuildID: "guild:1", AutoDeclineThreshold: 3, LookbackDays: 90}}
+	svc := NewNoShowService(NoShowServiceConfig{
+		Repo:      repo,
+		GuildRepo: &mockNoShowGuildRepo{adminID: "admin", memberIDs: []string{"member"}},
+	})
+
+	if _, err := svc.GetPolicyForMember(ctx, "stranger", "guild:1"); !errors.Is(err, ErrNotGuildMember) {
+		t.Errorf("expected ErrNotGuildMember, got %v", err)
+	}
+
+	policy, err := svc.GetPolicyForMember(ctx, "member", "guild:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.AutoDeclineThreshold != 3 {
+		t.Errorf("expected threshold 3, got %d", policy.AutoDeclineThreshold)
+	}
+}
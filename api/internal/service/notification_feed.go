@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+)
+
+// Long-poll defaults
+const (
+	// DefaultNotificationPollTimeout is how long a poll waits for a notification
+	DefaultNotificationPollTimeout = 25 * time.Second
+	// DefaultNotificationPollsPerUser is how many polls a user may hold open at once
+	DefaultNotificationPollsPerUser = 2
+
+	// notificationFeedSize is how many recent notifications are kept per user
+	notificationFeedSize = 100
+	// notificationRetention is how long a notification can be polled for
+	notificationRetention = 10 * time.Minute
+)
+
+// Notification is a user-directed event kept for clients that poll
+type Notification struct {
+	ID        string      `json:"id"`
+	Type      EventType   `json:"type"`
+	Data      interface{} `json:"data"`
+	CreatedOn time.Time   `json:"created_on"`
+
+	seq int64
+}
+
+// NotificationPoll is what a poll returns: the notifications after its
+// cursor, or none if nothing arrived before the poll timed out
+type NotificationPoll struct {
+	Notifications []Notification `json:"notifications"`
+	Cursor        string         `json:"cursor"`    // Pass as since on the next poll
+	Truncated     bool           `json:"truncated"` // Some notifications after since were discarded; refetch state
+}
+
+// NotificationFeedConfig holds long-poll settings
+type NotificationFeedConfig struct {
+	PollTimeout  time.Duration // How long a poll waits; default DefaultNotificationPollTimeout
+	PollsPerUser int           // Polls a user may hold open at once; default DefaultNotificationPollsPerUser
+	Clock        clock.Clock   // Stamps and expires notifications; default the system clock
+}
+
+// NotificationFeed keeps each user's recent notifications for clients that
+// can't hold an SSE stream open, such as embedded webviews. The EventHub
+// records every user-directed event here, including those other instances
+// send through the broker, so a poll sees the same notifications wherever
+// it lands. Cursors come from the sending instance's clock, so polls that
+// hop between instances rely on their clocks roughly agreeing.
+type NotificationFeed struct {
+	mu           sync.Mutex
+	users        map[string]*notificationLog
+	lastSeq      int64
+	pollTimeout  time.Duration
+	pollsPerUser int
+	clock        clock.Clock
+}
+
+// notificationLog is one user's recent notifications, oldest first
+type notificationLog struct {
+	items   []Notification
+	evicted int64         // Newest seq discarded to keep the log bounded
+	wake    chan struct{} // Closed and replaced when a notification arrives
+	polls   int
+}
+
+// NewNotificationFeed creates a notification feed
+func NewNotificationFeed(cfg NotificationFeedConfig) *NotificationFeed {
+	if cfg.PollTimeout <= 0 {
+		cfg.PollTimeout = DefaultNotificationPollTimeout
+	}
+	if cfg.PollsPerUser <= 0 {
+		cfg.PollsPerUser = DefaultNotificationPollsPerUser
+	}
+	return &NotificationFeed{
+		users:        make(map[string]*notificationLog),
+		pollTimeout:  cfg.PollTimeout,
+		pollsPerUser: cfg.PollsPerUser,
+		clock:        clock.OrReal(cfg.Clock),
+	}
+}
+
+// PollTimeout returns how long a poll waits before returning empty
+func (f *NotificationFeed) PollTimeout() time.Duration {
+	return f.pollTimeout
+}
+
+// nextID returns a cursor for a new notification, later than any before it
+func (f *NotificationFeed) nextID() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	seq := f.clock.Now().UnixNano()
+	if seq <= f.lastSeq {
+		seq = f.lastSeq + 1
+	}
+	f.lastSeq = seq
+	return strconv.FormatInt(seq, 10)
+}
+
+// record keeps a user-directed event and wakes the user's polls. Events
+// without an ID, or seen already, are ignored.
+func (f *NotificationFeed) record(userID string, event Event) {
+	seq, err := strconv.ParseInt(event.ID, 10, 64)
+	if err != nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if seq > f.lastSeq {
+		f.lastSeq = seq // Keep local cursors after those from other instances
+	}
+	log := f.logFor(userID)
+
+	// Broker delivery can reorder events, so insert by seq
+	i := len(log.items)
+	for i > 0 && log.items[i-1].seq >= seq {
+		if log.items[i-1].seq == seq {
+			return
+		}
+		i--
+	}
+	if seq <= log.evicted {
+		return
+	}
+	log.items = append(log.items, Notification{})
+	copy(log.items[i+1:], log.items[i:])
+	log.items[i] = Notification{
+		ID:        event.ID,
+		Type:      event.Type,
+		Data:      event.Data,
+		CreatedOn: f.clock.Now(),
+		seq:       seq,
+	}
+	if over := len(log.items) - notificationFeedSize; over > 0 {
+		log.evicted = log.items[over-1].seq
+		log.items = append(log.items[:0], log.items[over:]...)
+	}
+
+	close(log.wake)
+	log.wake = make(chan struct{})
+}
+
+// Poll returns the user's notifications after since, waiting up to the poll
+// timeout for one to arrive. An empty since starts from the oldest kept.
+func (f *NotificationFeed) Poll(ctx context.Context, userID, since string) (*NotificationPoll, error) {
+	var after int64
+	if since != "" {
+		var err error
+		if after, err = strconv.ParseInt(since, 10, 64); err != nil || after < 0 {
+			return nil, ErrInvalidNotificationCursor
+		}
+	}
+
+	if err := f.acquirePoll(userID); err != nil {
+		return nil, err
+	}
+	defer f.releasePoll(userID)
+
+	timeout := time.NewTimer(f.pollTimeout)
+	defer timeout.Stop()
+
+	for {
+		poll, wake := f.since(userID, after)
+		if len(poll.Notifications) > 0 || poll.Truncated {
+			return poll, nil
+		}
+		select {
+		case <-wake:
+		case <-timeout.C:
+			return poll, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// since returns the notifications after a seq, and a channel closed when
+// another arrives
+func (f *NotificationFeed) since(userID string, after int64) (*NotificationPoll, <-chan struct{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	log := f.logFor(userID)
+	f.expire(log)
+
+	// Cursors are send times, so one older than the retention window may
+	// have missed notifications even after the user's log was forgotten
+	expired := f.clock.Now().Add(-notificationRetention).UnixNano()
+	poll := &NotificationPoll{
+		Notifications: []Notification{},
+		Truncated:     after > 0 && (after < log.evicted || after < expired),
+	}
+	if after > 0 {
+		poll.Cursor = strconv.FormatInt(after, 10)
+	}
+	for _, n := range log.items {
+		if n.seq > after {
+			poll.Notifications = append(poll.Notifications, n)
+			poll.Cursor = n.ID
+		}
+	}
+	return poll, log.wake
+}
+
+func (f *NotificationFeed) acquirePoll(userID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	log := f.logFor(userID)
+	if log.polls >= f.pollsPerUser {
+		return ErrTooManyNotificationPolls
+	}
+	log.polls++
+	return nil
+}
+
+func (f *NotificationFeed) releasePoll(userID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if log, ok := f.users[userID]; ok {
+		log.polls--
+		f.forgetIfIdle(userID, log)
+	}
+}
+
+// Sweep discards expired notifications and forgets users with nothing left
+// to poll. The EventHub calls it with each heartbeat.
+func (f *NotificationFeed) Sweep() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for userID, log := range f.users {
+		f.expire(log)
+		f.forgetIfIdle(userID, log)
+	}
+}
+
+// expire discards notifications older than the retention window. Callers
+// must hold mu.
+func (f *NotificationFeed) expire(log *notificationLog) {
+	cutoff := f.clock.Now().Add(-notificationRetention)
+	n := 0
+	for n < len(log.items) && log.items[n].CreatedOn.Before(cutoff) {
+		n++
+	}
+	if n > 0 {
+		log.evicted = log.items[n-1].seq
+		log.items = append(log.items[:0], log.items[n:]...)
+	}
+}
+
+// logFor returns a user's log, creating it if needed. Callers must hold mu.
+func (f *NotificationFeed) logFor(userID string) *notificationLog {
+	log, ok := f.users[userID]
+	if !ok {
+		log = &notificationLog{wake: make(chan struct{})}
+		f.users[userID] = log
+	}
+	return log
+}
+
+// forgetIfIdle drops a user's log once it has nothing kept and nobody
+// polling. Callers must hold mu.
+func (f *NotificationFeed) forgetIfIdle(userID string, log *notificationLog) {
+	if len(log.items) == 0 && log.polls == 0 {
+		delete(f.users, userID)
+	}
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+func newTestNotificationHub(t *testing.T, cfg NotificationFeedConfig) (*EventHub, *NotificationFeed) {
+	t.Helper()
+	feed := NewNotificationFeed(cfg)
+	hub := NewEventHub(EventHubConfig{Notifications: feed})
+	t.Cleanup(hub.Close)
+	return hub, feed
+}
+
+// waitForPolls waits until a user has n polls open
+func waitForPolls(t *testing.T, feed *NotificationFeed, userID string, n int) {
+	t.Helper()
+	for deadline := time.Now().Add(2 * time.Second); ; {
+		feed.mu.Lock()
+		log, ok := feed.users[userID]
+		open := ok && log.polls == n
+		feed.mu.Unlock()
+		if open {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d polls", n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// ============================================================================
+// Poll Tests
+// ============================================================================
+
+func TestNotificationFeed_ReturnsNotificationsAfterCursor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	hub, feed := newTestNotificationHub(t, NotificationFeedConfig{})
+	hub.SendToUser("user-1", Event{Type: EventNudge, Data: "a"})
+	hub.SendToUser("user-1", Event{Type: EventAnnouncement, Data: "b"})
+	hub.SendToUser("user-2", Event{Type: EventNudge, Data: "other"})
+
+	poll, err := feed.Poll(ctx, "user-1", "")
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(poll.Notifications) != 2 || poll.Notifications[0].Data != "a" || poll.Notifications[1].Data != "b" {
+		t.Fatalf("expected [a b], got %+v", poll.Notifications)
+	}
+	if poll.Cursor != poll.Notifications[1].ID {
+		t.Errorf("expected the cursor at the last notification, got %q", poll.Cursor)
+	}
+
+	poll, err = feed.Poll(ctx, "user-1", poll.Notifications[0].ID)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(poll.Notifications) != 1 || poll.Notifications[0].Type != EventAnnouncement {
+		t.Errorf("expected only the announcement, got %+v", poll.Notifications)
+	}
+}
+
+func TestNotificationFeed_WaitsForNotification(t *testing.T) {
+	t.Parallel()
+
+	hub, feed := newTestNotificationHub(t, NotificationFeedConfig{PollTimeout: 5 * time.Second})
+
+	done := make(chan *NotificationPoll, 1)
+	go func() {
+		poll, _ := feed.Poll(context.Background(), "user-1", "")
+		done <- poll
+	}()
+	waitForPolls(t, feed, "user-1", 1)
+	hub.SendToUser("user-1", Event{Type: EventNudge, Data: "hi"})
+
+	select {
+	case poll := <-done:
+		if poll == nil || len(poll.Notifications) != 1 {
+			t.Errorf("expected the nudge, got %+v", poll)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("poll did not return when a notification arrived")
+	}
+}
+
+func TestNotificationFeed_TimesOutEmpty(t *testing.T) {
+	t.Parallel()
+
+	_, feed := newTestNotificationHub(t, NotificationFeedConfig{PollTimeout: 20 * time.Millisecond})
+	since := feed.nextID()
+
+	poll, err := feed.Poll(context.Background(), "user-1", since)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(poll.Notifications) != 0 || poll.Cursor != since || poll.Truncated {
+		t.Errorf("expected an empty poll at the same cursor, got %+v", poll)
+	}
+}
+
+func TestNotificationFeed_RejectsInvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	_, feed := newTestNotificationHub(t, NotificationFeedConfig{})
+	if _, err := feed.Poll(context.Background(), "user-1", "yesterday"); !errors.Is(err, ErrInvalidNotificationCursor) {
+		t.Errorf("expected ErrInvalidNotificationCursor, got %v", err)
+	}
+}
+
+func TestNotificationFeed_LimitsPollsPerUser(t *testing.T) {
+	t.Parallel()
+
+	_, feed := newTestNotificationHub(t, NotificationFeedConfig{PollTimeout: 5 * time.Second, PollsPerUser: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := feed.Poll(ctx, "user-1", "")
+		done <- err
+	}()
+	waitForPolls(t, feed, "user-1", 1)
+
+	if _, err := feed.Poll(context.Background(), "user-1", ""); !errors.Is(err, ErrTooManyNotificationPolls) {
+		t.Errorf("expected ErrTooManyNotificationPolls, got %v", err)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the first poll to end with its context, got %v", err)
+	}
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+	if _, ok := feed.users["user-1"]; ok {
+		t.Error("expected an idle user to be forgotten")
+	}
+}
+
+// ============================================================================
+// Retention Tests
+// ============================================================================
+
+func TestNotificationFeed_TruncatesWhenFull(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	hub, feed := newTestNotificationHub(t, NotificationFeedConfig{})
+	for i := 0; i < notificationFeedSize+2; i++ {
+		hub.SendToUser("user-1", Event{Type: EventNudge, Data: i})
+	}
+
+	// The first two were discarded, so an earlier cursor missed them
+	poll, err := feed.Poll(ctx, "user-1", "1")
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if !poll.Truncated || len(poll.Notifications) != notificationFeedSize {
+		t.Errorf("expected %d notifications, truncated; got %d, %v", notificationFeedSize, len(poll.Notifications), poll.Truncated)
+	}
+	if poll.Notifications[0].Data != 2 {
+		t.Errorf("expected the oldest kept to be 2, got %v", poll.Notifications[0].Data)
+	}
+}
+
+func TestNotificationFeed_ExpiresNotifications(t *testing.T) {
+	t.Parallel()
+
+	clk := fakeclock.New(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	hub, feed := newTestNotificationHub(t, NotificationFeedConfig{PollTimeout: 20 * time.Millisecond, Clock: clk})
+	since := feed.nextID()
+	hub.SendToUser("user-1", Event{Type: EventNudge, Data: "old"})
+
+	clk.Advance(notificationRetention + time.Minute)
+	feed.Sweep()
+
+	poll, err := feed.Poll(context.Background(), "user-1", since)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(poll.Notifications) != 0 || !poll.Truncated {
+		t.Errorf("expected an expired, truncated poll, got %+v", poll)
+	}
+}
+
+// ============================================================================
+// Broker Tests
+// ============================================================================
+
+func TestNotificationFeed_RecordsBrokerEvents(t *testing.T) {
+	t.Parallel()
+
+	bus := &fakeBrokerBus{}
+	feedA, feedB := NewNotificationFeed(NotificationFeedConfig{}), NewNotificationFeed(NotificationFeedConfig{PollTimeout: 2 * time.Second})
+	hubA := NewEventHub(EventHubConfig{Broker: &fakeBroker{bus: bus}, Notifications: feedA})
+	defer hubA.Close()
+	hubB := NewEventHub(EventHubConfig{Broker: &fakeBroker{bus: bus}, Notifications: feedB})
+	defer hubB.Close()
+
+	for deadline := time.Now().Add(2 * time.Second); ; {
+		bus.mu.Lock()
+		n := len(bus.subs)
+		bus.mu.Unlock()
+		if n == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("hubs did not subscribe to broker")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	hubA.SendToUser("user-1", Event{Type: EventNudge, Data: map[string]string{"msg": "hi"}})
+
+	pollA, err := feedA.Poll(context.Background(), "user-1", "")
+	if err != nil || len(pollA.Notifications) != 1 {
+		t.Fatalf("expected the nudge on A, got %+v, %v", pollA, err)
+	}
+	pollB, err := feedB.Poll(context.Background(), "user-1", "")
+	if err != nil || len(pollB.Notifications) != 1 {
+		t.Fatalf("expected the nudge on B, got %+v, %v", pollB, err)
+	}
+	if pollA.Cursor != pollB.Cursor {
+		t.Errorf("expected the same cursor on both instances, got %q and %q", pollA.Cursor, pollB.Cursor)
+	}
+}
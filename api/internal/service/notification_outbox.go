@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/requestid"
+)
+
+// OutboxNotification is a message for one user: a push, with Event sent
+// over SSE when the push can't be delivered. Either may be nil; a
+// notification without Push is sent over SSE only.
+type OutboxNotification struct {
+	UserID string            `json:"user_id"`
+	Push   *PushNotification `json:"push,omitempty"`
+	Event  *Event            `json:"event,omitempty"`
+}
+
+// NotificationQueue queues notifications for delivery. Queued inside a
+// transaction, notifications are only sent once it commits, so a crash
+// between the write and the send no longer loses them.
+// NotificationOutbox implements it.
+type NotificationQueue interface {
+	Enqueue(ctx context.Context, notifications ...*OutboxNotification) error
+}
+
+// NotificationOutboxRepository defines the interface for notification outbox storage
+type NotificationOutboxRepository interface {
+	Create(ctx context.Context, entry *model.NotificationOutboxEntry) error
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time) ([]*model.NotificationOutboxEntry, error)
+	Delete(ctx context.Context, id string) error
+	Reschedule(ctx context.Context, id, lastError string, availableOn time.Time) error
+	MarkDead(ctx context.Context, id, lastError string, deadOn time.Time) error
+}
+
+// NotificationOutbox stores notifications and delivers them to PushService
+// and EventHub. Enqueue writes one entry per notification; Drain, run by
+// jobs.OutboxProcessor, sends due entries and retries failed pushes with
+// the same backoff as domain events before giving up on them.
+type NotificationOutbox struct {
+	repo        NotificationOutboxRepository
+	pushService *PushService
+	eventHub    *EventHub
+	clock       clock.Clock
+	wake        chan struct{}
+}
+
+// NotificationOutboxConfig holds configuration for the notification outbox
+type NotificationOutboxConfig struct {
+	Repo        NotificationOutboxRepository
+	PushService *PushService // Optional; SSE only when nil
+	EventHub    *EventHub    // Optional; nil skips SSE events
+	Clock       clock.Clock  // Default: the system clock
+}
+
+// NewNotificationOutbox creates a new notification outbox
+func NewNotificationOutbox(cfg NotificationOutboxConfig) *NotificationOutbox {
+	return &NotificationOutbox{
+		repo:        cfg.Repo,
+		pushService: cfg.PushService,
+		eventHub:    cfg.EventHub,
+		clock:       clock.OrReal(cfg.Clock),
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Enqueue stores the notifications for delivery and wakes the processor.
+// It stops at the first notification that can't be stored.
+func (o *NotificationOutbox) Enqueue(ctx context.Context, notifications ...*OutboxNotification) error {
+	now := o.clock.Now().UTC()
+	requestID := requestid.FromContext(ctx)
+	for _, n := range notifications {
+		payload, err := json.Marshal(n)
+		if err != nil {
+			return fmt.Errorf("encoding notification for %s: %w", n.UserID, err)
+		}
+
+		entry := &model.NotificationOutboxEntry{
+			UserID:      n.UserID,
+			Payload:     payload,
+			AvailableOn: now,
+			RequestID:   requestID,
+		}
+		if err := o.repo.Create(ctx, entry); err != nil {
+			return fmt.Errorf("queueing notification for %s: %w", n.UserID, err)
+		}
+	}
+
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Wake signals after notifications are queued, so the processor can send
+// them without waiting for its next run. Entries queued in a transaction
+// that hasn't committed yet are picked up on a later run.
+func (o *NotificationOutbox) Wake() <-chan struct{} {
+	return o.wake
+}
+
+// Drain delivers the notifications that are due. Failed deliveries are
+// rescheduled with exponential backoff until model.OutboxMaxAttempts, then
+// marked dead. Returns how many notifications were delivered.
+func (o *NotificationOutbox) Drain(ctx context.Context) (int, error) {
+	now := o.clock.Now()
+	entries, err := o.repo.ClaimDue(ctx, now, now.Add(model.OutboxClaimLease))
+	if err != nil {
+		return 0, fmt.Errorf("claiming notification outbox entries: %w", err)
+	}
+
+	delivered := 0
+	for _, entry := range entries {
+		if err := o.deliver(ctx, entry); err != nil {
+			o.fail(ctx, entry, err)
+			continue
+		}
+		// An entry that can't be deleted is sent again once its lease ends
+		if err := o.repo.Delete(ctx, entry.ID); err != nil {
+			log.Printf("[NotificationOutbox] Failed to delete delivered outbox entry %s: %v", entry.ID, err)
+			continue
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+// deliver sends the entry's notification. On its last attempt a push that
+// keeps failing falls back to SSE, and the error still marks the entry dead.
+func (o *NotificationOutbox) deliver(ctx context.Context, entry *model.NotificationOutboxEntry) error {
+	var n OutboxNotification
+	if err := json.Unmarshal(entry.Payload, &n); err != nil {
+		return fmt.Errorf("decoding notification: %w", err)
+	}
+	lastAttempt := entry.Attempts >= model.OutboxMaxAttempts
+	return deliverNotification(requestid.NewContext(ctx, entry.RequestID), o.pushService, o.eventHub, &n, lastAttempt)
+}
+
+// fail reschedules a failed entry, or marks it dead once it has used up its
+// attempts. Attempts were already counted when the entry was claimed.
+func (o *NotificationOutbox) fail(ctx context.Context, entry *model.NotificationOutboxEntry, cause error) {
+	now := o.clock.Now()
+	if entry.Attempts >= model.OutboxMaxAttempts {
+		log.Printf("[NotificationOutbox] Giving up on notification for %s after %d attempts: %v", entry.UserID, entry.Attempts, cause)
+		if err := o.repo.MarkDead(ctx, entry.ID, cause.Error(), now); err != nil {
+			log.Printf("[NotificationOutbox] Failed to mark outbox entry %s dead: %v", entry.ID, err)
+		}
+		return
+	}
+
+	if err := o.repo.Reschedule(ctx, entry.ID, cause.Error(), now.Add(outboxRetryDelay(entry.Attempts))); err != nil {
+		log.Printf("[NotificationOutbox] Failed to reschedule outbox entry %s: %v", entry.ID, err)
+	}
+}
+
+// deliverNotification sends n's push, then its event when the push can't be
+// delivered. A push that fails in a way worth retrying returns an error
+// instead of falling back; on the last attempt it falls back and still
+// returns the error.
+func deliverNotification(ctx context.Context, push *PushService, hub *EventHub, n *OutboxNotification, lastAttempt bool) error {
+	if n.Push != nil && push != nil && push.IsEnabled() {
+		err := sendPush(ctx, push, n.UserID, n.Push)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, errPushUndeliverable) {
+			if lastAttempt {
+				sendEvent(hub, n)
+			}
+			return err
+		}
+	}
+
+	sendEvent(hub, n)
+	return nil
+}
+
+// errPushUndeliverable marks push failures that retrying won't fix: push is
+// off, the user has no devices, or every device rejected the notification
+var errPushUndeliverable = errors.New("push undeliverable")
+
+// sendPush sends a push to the user's devices, succeeding when any device
+// accepted it
+func sendPush(ctx context.Context, push *PushService, userID string, notification *PushNotification) error {
+	results, err := push.SendToUser(ctx, userID, notification)
+	if errors.Is(err, ErrPushDisabled) || errors.Is(err, ErrNoDeviceTokens) {
+		return fmt.Errorf("%w: %w", errPushUndeliverable, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	retry := false
+	lastError := ""
+	for _, result := range results {
+		if result.Success {
+			return nil
+		}
+		retry = retry || result.ShouldRetry
+		lastError = result.Error
+	}
+	if retry {
+		return fmt.Errorf("push failed on every device: %s", lastError)
+	}
+	return fmt.Errorf("%w: push rejected by every device: %s", errPushUndeliverable, lastError)
+}
+
+// sendEvent sends n's event over SSE, if it has one and there is a hub
+func sendEvent(hub *EventHub, n *OutboxNotification) {
+	if hub == nil || n.Event == nil {
+		return
+	}
+	hub.SendToUser(n.UserID, *n.Event)
+}
+
+// notificationsForUsers builds notifications that send event and push to
+// every user, independently of each other: the event goes out whether or
+// not the push arrives. Either may be nil.
+func notificationsForUsers(userIDs []string, event *Event, push *PushNotification) []*OutboxNotification {
+	notifications := make([]*OutboxNotification, 0, 2*len(userIDs))
+	for _, userID := range userIDs {
+		if event != nil {
+			notifications = append(notifications, &OutboxNotification{UserID: userID, Event: event})
+		}
+		if push != nil {
+			notifications = append(notifications, &OutboxNotification{UserID: userID, Push: push})
+		}
+	}
+	return notifications
+}
+
+// sendNotifications queues the notifications when there is a queue, and
+// sends the ones it can't queue right away, without retries
+func sendNotifications(ctx context.Context, queue NotificationQueue, push *PushService, hub *EventHub, notifications ...*OutboxNotification) {
+	for _, n := range notifications {
+		if queue != nil {
+			err := queue.Enqueue(ctx, n)
+			if err == nil {
+				continue
+			}
+			log.Printf("[NotificationOutbox] Failed to queue notification for user %s, sending it now: %v", n.UserID, err)
+		}
+
+		if err := deliverNotification(ctx, push, hub, n, true); err != nil {
+			log.Printf("[NotificationOutbox] Push failed for user %s, fell back to SSE: %v", n.UserID, err)
+		}
+	}
+}
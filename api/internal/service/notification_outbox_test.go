@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/chaos"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// newMemoryNotificationOutbox returns a mock repository keeping entries in
+// a map, with the claim semantics of the SurrealDB repository
+func newMemoryNotificationOutbox() (*mocks.NotificationOutboxRepository, map[string]*model.NotificationOutboxEntry) {
+	entries := make(map[string]*model.NotificationOutboxEntry)
+	repo := &mocks.NotificationOutboxRepository{
+		CreateFunc: func(ctx context.Context, entry *model.NotificationOutboxEntry) error {
+			entry.ID = fmt.Sprintf("notification_outbox:%d", len(entries)+1)
+			stored := *entry
+			entries[entry.ID] = &stored
+			return nil
+		},
+		ClaimDueFunc: func(ctx context.Context, now, leaseUntil time.Time) ([]*model.NotificationOutboxEntry, error) {
+			var claimed []*model.NotificationOutboxEntry
+			for _, entry := range entries {
+				if entry.DeadOn != nil || entry.AvailableOn.After(now) {
+					continue
+				}
+				entry.AvailableOn = leaseUntil
+				entry.Attempts++
+				copied := *entry
+				claimed = append(claimed, &copied)
+			}
+			return claimed, nil
+		},
+		DeleteFunc: func(ctx context.Context, id string) error {
+			delete(entries, id)
+			return nil
+		},
+		RescheduleFunc: func(ctx context.Context, id, lastError string, availableOn time.Time) error {
+			entries[id].LastError = &lastError
+			entries[id].AvailableOn = availableOn
+			return nil
+		},
+		MarkDeadFunc: func(ctx context.Context, id, lastError string, deadOn time.Time) error {
+			entries[id].LastError = &lastError
+			entries[id].DeadOn = &deadOn
+			return nil
+		},
+	}
+	return repo, entries
+}
+
+func newOutboxPushService(t *testing.T, devices int, faults *chaos.Injector) *PushService {
+	t.Helper()
+	deviceRepo := &mockDeviceTokenRepo{
+		getByUserIDFunc: func(ctx context.Context, userID string) ([]*model.DeviceToken, error) {
+			tokens := make([]*model.DeviceToken, 0, devices)
+			for i := 0; i < devices; i++ {
+				tokens = append(tokens, &model.DeviceToken{ID: fmt.Sprintf("device-%d", i), UserID: userID, Platform: model.PlatformIOS, Token: "apns-token-123"})
+			}
+			return tokens, nil
+		},
+		updateLastUsedFunc: func(ctx context.Context, id string) error { return nil },
+	}
+	push, err := NewPushService(PushServiceConfig{DeviceRepo: deviceRepo, Enabled: true, Faults: faults})
+	if err != nil {
+		t.Fatalf("NewPushService() error = %v", err)
+	}
+	return push
+}
+
+func testOutboxNotification() *OutboxNotification {
+	return &OutboxNotification{
+		UserID: "user-1",
+		Push:   &PushNotification{Title: "Upcoming event", Body: "Picnic starts soon"},
+		Event:  &Event{Type: EventEventReminder, Data: map[string]interface{}{"event_id": "event:1"}},
+	}
+}
+
+func TestNotificationOutbox_DeliversPush(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo, entries := newMemoryNotificationOutbox()
+	hub := NewEventHub(EventHubConfig{})
+	sub := hub.SubscribeUser("user-1", "sub-1")
+	outbox := NewNotificationOutbox(NotificationOutboxConfig{
+		Repo:        repo,
+		PushService: newOutboxPushService(t, 1, nil),
+		EventHub:    hub,
+	})
+
+	if err := outbox.Enqueue(ctx, testOutboxNotification()); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	select {
+	case <-outbox.Wake():
+	default:
+		t.Error("expected Enqueue to wake the processor")
+	}
+
+	delivered, err := outbox.Drain(ctx)
+	if err != nil || delivered != 1 {
+		t.Fatalf("Drain() = %d, %v; want 1 delivered", delivered, err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("%d entries left, want the delivered one deleted", len(entries))
+	}
+	if len(sub.Events) != 0 {
+		t.Error("expected no SSE event once the push was delivered")
+	}
+}
+
+func TestNotificationOutbox_FallsBackToSSE(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo, entries := newMemoryNotificationOutbox()
+	hub := NewEventHub(EventHubConfig{})
+	sub := hub.SubscribeUser("user-1", "sub-1")
+	outbox := NewNotificationOutbox(NotificationOutboxConfig{
+		Repo:        repo,
+		PushService: newOutboxPushService(t, 0, nil), // No devices
+		EventHub:    hub,
+	})
+
+	if err := outbox.Enqueue(ctx, testOutboxNotification()); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	if delivered, err := outbox.Drain(ctx); err != nil || delivered != 1 {
+		t.Fatalf("Drain() = %d, %v; want 1 delivered", delivered, err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("%d entries left, want the delivered one deleted", len(entries))
+	}
+	select {
+	case e := <-sub.Events:
+		if e.Type != EventEventReminder {
+			t.Errorf("event type = %s, want %s", e.Type, EventEventReminder)
+		}
+	default:
+		t.Error("expected the SSE fallback")
+	}
+}
+
+func TestNotificationOutbox_RetriesThenDeadLetters(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	clk := fakeclock.New(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	repo, entries := newMemoryNotificationOutbox()
+	hub := NewEventHub(EventHubConfig{})
+	sub := hub.SubscribeUser("user-1", "sub-1")
+	outbox := NewNotificationOutbox(NotificationOutboxConfig{
+		Repo:        repo,
+		PushService: newOutboxPushService(t, 1, chaos.NewInjector(map[chaos.Target]chaos.Rule{chaos.Push: {ErrorRate: 1}}, nil)),
+		EventHub:    hub,
+		Clock:       clk,
+	})
+
+	if err := outbox.Enqueue(ctx, testOutboxNotification()); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	for attempt := 1; attempt < model.OutboxMaxAttempts; attempt++ {
+		if delivered, err := outbox.Drain(ctx); err != nil || delivered != 0 {
+			t.Fatalf("attempt %d: Drain() = %d, %v; want 0 delivered", attempt, delivered, err)
+		}
+		if len(sub.Events) != 0 {
+			t.Fatalf("attempt %d: expected no SSE fallback before the last attempt", attempt)
+		}
+		clk.Advance(model.OutboxRetryMaxDelay)
+	}
+
+	if delivered, err := outbox.Drain(ctx); err != nil || delivered != 0 {
+		t.Fatalf("last attempt: Drain() = %d, %v; want 0 delivered", delivered, err)
+	}
+	if len(sub.Events) != 1 {
+		t.Errorf("expected the SSE fallback on the last attempt, got %d events", len(sub.Events))
+	}
+	if len(entries) != 1 {
+		t.Fatalf("%d entries left, want the dead one kept", len(entries))
+	}
+	for _, entry := range entries {
+		if entry.DeadOn == nil || entry.LastError == nil {
+			t.Errorf("entry %+v, want it marked dead with the last error", entry)
+		}
+	}
+}
+
+func TestSendNotifications_WithoutQueue(t *testing.T) {
+	t.Parallel()
+	hub := NewEventHub(EventHubConfig{})
+	sub := hub.SubscribeUser("user-1", "sub-1")
+
+	event := &Event{Type: EventAnnouncement, Data: map[string]interface{}{"announcement_id": "announcement:1"}}
+	push := &PushNotification{Title: "News"}
+	sendNotifications(context.Background(), nil, nil, hub, notificationsForUsers([]string{"user-1"}, event, push)...)
+
+	if len(sub.Events) != 1 {
+		t.Errorf("expected the event sent inline, got %d events", len(sub.Events))
+	}
+}
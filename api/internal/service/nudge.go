@@ -4,18 +4,43 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/model"
 )
 
+// ProfileNudgeRepository finds incomplete profiles and records the
+// completeness nudges sent to them
+type ProfileNudgeRepository interface {
+	GetCompletenessNudgeCandidates(ctx context.Context, createdBefore, nudgedBefore time.Time, maxNudges, limit int) ([]*model.UserProfile, error)
+	SetCompletionScore(ctx context.Context, userID string, score float64) error
+	ClaimCompletenessNudge(ctx context.Context, userID string, nudgedBefore time.Time) (bool, error)
+}
+
+// profileNudgeBatchSize caps the profiles checked per run
+const profileNudgeBatchSize = 200
+
 // NudgeService handles nudge generation and delivery
 type NudgeService struct {
 	availabilityRepo AvailabilityRepository
 	poolRepo         PoolRepository
+	profileNudgeRepo ProfileNudgeRepository
+	completeness     CompletenessSource
+	preferenceRepo   NudgePreferenceRepository
+	winBackRepo      WinBackRepository
+	winBackProfiles  WinBackProfileRepository
+	compatibility    CompatibilityScorer
+	consent          ConsentChecker
+	marketingEmail   *MarketingEmailService
+	geoService       *GeoService
+	holdoutPercent   int
 	eventHub         *EventHub
 	pushService      *PushService
+	outbox           NotificationQueue
 	configs          map[model.NudgeType]model.NudgeConfig
+	clock            clock.Clock
 }
 
 // NudgeServiceConfig holds configuration for the nudge service
@@ -24,6 +49,22 @@ type NudgeServiceConfig struct {
 	PoolRepo         PoolRepository
 	EventHub         *EventHub
 	PushService      *PushService
+	Outbox           NotificationQueue // Optional; nil sends nudges inline
+
+	// Optional; profile completeness nudges need both
+	ProfileNudgeRepo ProfileNudgeRepository
+	Completeness     CompletenessSource
+	PreferenceRepo   NudgePreferenceRepository // Optional; honors users' profile_incomplete and win_back preferences
+
+	// Optional; win-back nudges need WinBackRepo, and name compatible new
+	// members nearby only when WinBackProfiles and Compatibility are set too
+	WinBackRepo     WinBackRepository
+	WinBackProfiles WinBackProfileRepository
+	Compatibility   CompatibilityScorer
+	Consent         ConsentChecker         // Optional; new members are looked up near everyone when nil
+	MarketingEmail  *MarketingEmailService // Optional; win-back nudges are also emailed to users who opted in
+	HoldoutPercent  int                    // Share of at-risk users held out of win-back nudges to measure them, 0-50
+	Clock           clock.Clock            // Default: the system clock
 }
 
 // NewNudgeService creates a new nudge service
@@ -31,9 +72,21 @@ func NewNudgeService(cfg NudgeServiceConfig) *NudgeService {
 	return &NudgeService{
 		availabilityRepo: cfg.AvailabilityRepo,
 		poolRepo:         cfg.PoolRepo,
+		profileNudgeRepo: cfg.ProfileNudgeRepo,
+		completeness:     cfg.Completeness,
+		preferenceRepo:   cfg.PreferenceRepo,
+		winBackRepo:      cfg.WinBackRepo,
+		winBackProfiles:  cfg.WinBackProfiles,
+		compatibility:    cfg.Compatibility,
+		consent:          cfg.Consent,
+		marketingEmail:   cfg.MarketingEmail,
+		geoService:       NewGeoService(),
+		holdoutPercent:   cfg.HoldoutPercent,
 		eventHub:         cfg.EventHub,
 		pushService:      cfg.PushService,
+		outbox:           cfg.Outbox,
 		configs:          model.DefaultNudgeConfigs,
+		clock:            clock.OrReal(cfg.Clock),
 	}
 }
 
@@ -61,6 +114,10 @@ func (s *NudgeService) ProcessPendingNudges(ctx context.Context) error {
 		log.Printf("Error processing pool match nudges: %v", err)
 	}
 
+	if err := s.processProfileNudges(ctx); err != nil {
+		log.Printf("Error processing profile nudges: %v", err)
+	}
+
 	return nil
 }
 
@@ -210,8 +267,76 @@ func (s *NudgeService) processPoolMatchNudges(ctx context.Context) error {
 	return nil
 }
 
+// processProfileNudges prompts users whose profiles are still incomplete with
+// the single missing item worth the most points. Each user is nudged at most
+// once per repeat interval and MaxRepeat times overall.
+func (s *NudgeService) processProfileNudges(ctx context.Context) error {
+	config := s.configs[model.NudgeTypeProfileIncomplete]
+	if !config.Enabled {
+		return nil
+	}
+
+	if s.profileNudgeRepo == nil || s.completeness == nil {
+		return nil
+	}
+
+	now := time.Now()
+	nudgedBefore := now.Add(-config.RepeatInterval)
+	profiles, err := s.profileNudgeRepo.GetCompletenessNudgeCandidates(ctx, now.Add(-config.DelayAfter), nudgedBefore, config.MaxRepeat, profileNudgeBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, profile := range profiles {
+		completeness, err := s.completeness.Completeness(ctx, profile.UserID)
+		if err != nil {
+			log.Printf("[NudgeService] Failed to score profile for user %s: %v", profile.UserID, err)
+			continue
+		}
+
+		// Keep the stored score current so complete profiles drop out of the candidates
+		if err := s.profileNudgeRepo.SetCompletionScore(ctx, profile.UserID, float64(completeness.Score)/100); err != nil {
+			log.Printf("[NudgeService] Failed to store completion score for user %s: %v", profile.UserID, err)
+		}
+		if completeness.NextStep == nil {
+			continue
+		}
+
+		channel := config.Channel
+		if s.preferenceRepo != nil {
+			pref, err := s.preferenceRepo.GetPreference(ctx, profile.UserID, model.NudgeTypeProfileIncomplete)
+			if err != nil {
+				log.Printf("[NudgeService] Failed to load preference for user %s: %v", profile.UserID, err)
+				continue
+			}
+			if pref != nil && !pref.Enabled {
+				continue
+			}
+			if pref != nil && pref.Channel != nil {
+				channel = *pref.Channel
+			}
+		}
+
+		// Record first so that concurrent runs cannot double-send
+		claimed, err := s.profileNudgeRepo.ClaimCompletenessNudge(ctx, profile.UserID, nudgedBefore)
+		if err != nil {
+			log.Printf("[NudgeService] Failed to record profile nudge for user %s: %v", profile.UserID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		nudge := s.buildProfileNudge(profile.UserID, completeness)
+		nudge.Channel = channel
+		s.sendNudge(ctx, nudge)
+	}
+
+	return nil
+}
+
 // buildNudge creates a nudge for a pool match
-func (s *NudgeService) buildNudge(nudgeType model.NudgeType, userID string, match *model.MatchResult) *model.Nudge {
+func (s *NudgeService) buildNudge(nudgeType model.NudgeType, userID model.UserID, match *model.MatchResult) *model.Nudge {
 	template := model.NudgeTemplates[nudgeType]
 
 	// Build partner names (excluding current user)
@@ -228,7 +353,7 @@ func (s *NudgeService) buildNudge(nudgeType model.NudgeType, userID string, matc
 	}
 
 	return &model.Nudge{
-		UserID:  userID,
+		UserID:  string(userID),
 		Type:    nudgeType,
 		Channel: s.configs[nudgeType].Channel,
 		Title:   template.Title,
@@ -292,29 +417,43 @@ func (s *NudgeService) buildRequestNudge(nudgeType model.NudgeType, userID strin
 	}
 }
 
-// sendNudge delivers the nudge via the appropriate channel
+// buildProfileNudge creates a nudge prompting the user to fill in the next
+// completeness item
+func (s *NudgeService) buildProfileNudge(userID string, completeness *model.ProfileCompleteness) *model.Nudge {
+	template := model.NudgeTemplates[model.NudgeTypeProfileIncomplete]
+	item := completeness.NextStep.Item
+	actionURL := "/profile"
+
+	return &model.Nudge{
+		UserID:  userID,
+		Type:    model.NudgeTypeProfileIncomplete,
+		Channel: s.configs[model.NudgeTypeProfileIncomplete].Channel,
+		Title:   template.Title,
+		Message: fmt.Sprintf(template.Message, completeness.Score, completeness.NextStep.Prompt),
+		Data: model.NudgeData{
+			ProfileItem: &item,
+			ActionURL:   &actionURL,
+		},
+		SentAt: time.Now(),
+	}
+}
+
+// sendNudge delivers the nudge via the appropriate channel, falling back
+// from push to SSE
 func (s *NudgeService) sendNudge(ctx context.Context, nudge *model.Nudge) {
-	switch nudge.Channel {
-	case model.NudgeChannelSSE:
-		s.sendSSENudge(nudge)
-	case model.NudgeChannelPush:
-		// Try push notification first
-		if s.pushService != nil && s.pushService.IsEnabled() {
-			notification := &PushNotification{
-				Title: nudge.Title,
-				Body:  nudge.Message,
-				Data:  s.convertNudgeDataToStrings(nudge),
-			}
-			if _, err := s.pushService.SendToUser(ctx, nudge.UserID, notification); err != nil {
-				// Fall back to SSE on push failure
-				log.Printf("[NudgeService] Push failed for user %s, falling back to SSE: %v", nudge.UserID, err)
-				s.sendSSENudge(nudge)
-			}
-		} else {
-			// Push not available, fall back to SSE
-			s.sendSSENudge(nudge)
+	notification := &OutboxNotification{
+		UserID: nudge.UserID,
+		Event:  nudgeEvent(nudge),
+	}
+	if nudge.Channel == model.NudgeChannelPush {
+		notification.Push = &PushNotification{
+			Title: nudge.Title,
+			Body:  nudge.Message,
+			Data:  s.convertNudgeDataToStrings(nudge),
 		}
 	}
+
+	sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, notification)
 }
 
 // convertNudgeDataToStrings converts nudge data to string map for push payload
@@ -325,7 +464,7 @@ func (s *NudgeService) convertNudgeDataToStrings(nudge *model.Nudge) map[string]
 
 	// Data is a value type, check individual fields
 	if nudge.Data.MatchID != nil {
-		result["match_id"] = *nudge.Data.MatchID
+		result["match_id"] = string(*nudge.Data.MatchID)
 	}
 	if nudge.Data.AvailabilityID != nil {
 		result["availability_id"] = *nudge.Data.AvailabilityID
@@ -333,18 +472,25 @@ func (s *NudgeService) convertNudgeDataToStrings(nudge *model.Nudge) map[string]
 	if nudge.Data.PartnerUserID != nil {
 		result["partner_user_id"] = *nudge.Data.PartnerUserID
 	}
+	if nudge.Data.ProfileItem != nil {
+		result["profile_item"] = string(*nudge.Data.ProfileItem)
+	}
+	if nudge.Data.NewMemberCount != nil {
+		result["new_member_count"] = strconv.Itoa(*nudge.Data.NewMemberCount)
+	}
+	if len(nudge.Data.Events) > 0 {
+		result["event_id"] = nudge.Data.Events[0].ID
+	}
+	if nudge.Data.ActionURL != nil {
+		result["action_url"] = *nudge.Data.ActionURL
+	}
 
 	return result
 }
 
-// sendSSENudge sends nudge via server-sent events
-func (s *NudgeService) sendSSENudge(nudge *model.Nudge) {
-	if s.eventHub == nil {
-		return
-	}
-
-	// Create SSE event
-	event := Event{
+// nudgeEvent builds the server-sent event for a nudge
+func nudgeEvent(nudge *model.Nudge) *Event {
+	return &Event{
 		Type: EventNudge,
 		Data: map[string]interface{}{
 			"nudge_type": nudge.Type,
@@ -354,8 +500,6 @@ func (s *NudgeService) sendSSENudge(nudge *model.Nudge) {
 			"sent_at":    nudge.SentAt,
 		},
 	}
-
-	s.eventHub.SendToUser(nudge.UserID, event)
 }
 
 // GetNudgeSummary returns a summary of actionable items for a user
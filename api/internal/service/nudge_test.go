@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mocks
+// ============================================================================
+
+type mockProfileNudgeRepo struct {
+	candidates []*model.UserProfile
+	scores     map[string]float64
+	claimed    map[string]bool
+}
+
+func (m *mockProfileNudgeRepo) GetCompletenessNudgeCandidates(ctx context.Context, createdBefore, nudgedBefore time.Time, maxNudges, limit int) ([]*model.UserProfile, error) {
+	return m.candidates, nil
+}
+
+func (m *mockProfileNudgeRepo) SetCompletionScore(ctx context.Context, userID string, score float64) error {
+	m.scores[userID] = score
+	return nil
+}
+
+func (m *mockProfileNudgeRepo) ClaimCompletenessNudge(ctx context.Context, userID string, nudgedBefore time.Time) (bool, error) {
+	if m.claimed[userID] {
+		return false, nil
+	}
+	m.claimed[userID] = true
+	return true, nil
+}
+
+// ============================================================================
+// Profile Nudge Tests
+// ============================================================================
+
+func TestProcessProfileNudges(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := &mockProfileNudgeRepo{
+		candidates: []*model.UserProfile{
+			{UserID: "user:incomplete"},
+			{UserID: "user:complete"},
+			{UserID: "user:opted-out"},
+			{UserID: "user:already-nudged"},
+		},
+		scores:  map[string]float64{},
+		claimed: map[string]bool{"user:already-nudged": true},
+	}
+	full := model.CompletenessInput{HasPhoto: true, HasBio: true, InterestCount: 3, QuestionsAnswered: 3, HasOpenAvailability: true}
+	noPhoto := full
+	noPhoto.HasPhoto = false
+	completeness := &mockCompletenessSource{completeness: map[string]*model.ProfileCompleteness{
+		"user:incomplete":     model.NewProfileCompleteness(noPhoto),
+		"user:complete":       model.NewProfileCompleteness(full),
+		"user:opted-out":      model.NewProfileCompleteness(noPhoto),
+		"user:already-nudged": model.NewProfileCompleteness(noPhoto),
+	}}
+
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("user:incomplete", "sub-1")
+
+	svc := NewNudgeService(NudgeServiceConfig{
+		EventHub:         hub,
+		ProfileNudgeRepo: repo,
+		Completeness:     completeness,
+		PreferenceRepo: &mockNudgePreferenceRepo{prefs: map[string]*model.NudgePreference{
+			"user:opted-out": {UserID: "user:opted-out", Type: model.NudgeTypeProfileIncomplete, Enabled: false},
+		}},
+	})
+
+	if err := svc.processProfileNudges(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if repo.scores["user:complete"] != 1 || repo.scores["user:incomplete"] != 0.8 {
+		t.Errorf("expected stored scores to be refreshed, got %v", repo.scores)
+	}
+	if !repo.claimed["user:incomplete"] {
+		t.Error("expected incomplete profile to be nudged")
+	}
+	if repo.claimed["user:complete"] || repo.claimed["user:opted-out"] {
+		t.Errorf("expected complete and opted-out profiles to be skipped, got %v", repo.claimed)
+	}
+
+	select {
+	case event := <-sub.Events:
+		data := event.Data.(map[string]interface{})["data"].(model.NudgeData)
+		if data.ProfileItem == nil || *data.ProfileItem != model.CompletenessPhoto {
+			t.Errorf("expected photo to be the prompted item, got %+v", data.ProfileItem)
+		}
+	default:
+		t.Error("expected a nudge to be delivered")
+	}
+}
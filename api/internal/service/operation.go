@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"log"
+)
+
+// Transactor runs fn in a database transaction: repository calls made with
+// the context passed to fn commit or roll back together.
+// database.Transactor implements it.
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// operation runs the steps of a multi-step write. Each completed step can
+// leave a compensation behind; when a later step fails, the compensations
+// run newest first so the operation either completes or leaves nothing
+// behind. Inside a transaction (see runOperation) rolling back does that
+// instead and compensations are not recorded.
+//
+//	op := newOperation("create guild")
+//	if err := op.step(ctx, "insert guild", insert, remove); err != nil {
+//		return nil, err
+//	}
+type operation struct {
+	name          string
+	transactional bool
+	completed     []string
+	compensations []compensation
+}
+
+// compensation undoes one completed step
+type compensation struct {
+	step string
+	undo func(ctx context.Context) error
+}
+
+// newOperation starts an operation; name identifies it in logs
+func newOperation(name string) *operation {
+	return &operation{name: name}
+}
+
+// runOperation runs fn as the named operation. With a transactor all of
+// fn's steps share one transaction, which fn's error rolls back; without
+// one, completed steps are compensated.
+func runOperation(ctx context.Context, tx Transactor, name string, fn func(ctx context.Context, op *operation) error) error {
+	if tx == nil {
+		return fn(ctx, newOperation(name))
+	}
+	return tx.WithTransaction(ctx, func(ctx context.Context) error {
+		return fn(ctx, &operation{name: name, transactional: true})
+	})
+}
+
+// step runs do. On success the step is recorded, along with undo when it is
+// non-nil. On failure every earlier step is compensated and do's error is
+// returned unchanged, so callers can still match it with errors.Is.
+func (o *operation) step(ctx context.Context, name string, do, undo func(ctx context.Context) error) error {
+	if err := do(ctx); err != nil {
+		o.compensate(ctx, name, err)
+		return err
+	}
+
+	o.completed = append(o.completed, name)
+	if undo != nil && !o.transactional {
+		o.compensations = append(o.compensations, compensation{step: name, undo: undo})
+	}
+	return nil
+}
+
+// compensate undoes the completed steps, newest first. It runs even if the
+// request was cancelled, since that is a common reason for the failure.
+// A compensation that fails is logged and the rest still run.
+func (o *operation) compensate(ctx context.Context, failedStep string, cause error) {
+	if len(o.compensations) == 0 {
+		return
+	}
+	log.Printf("[operation] %s failed at %s after %v, compensating: %v", o.name, failedStep, o.completed, cause)
+
+	ctx = context.WithoutCancel(ctx)
+	for i := len(o.compensations) - 1; i >= 0; i-- {
+		c := o.compensations[i]
+		if err := c.undo(ctx); err != nil {
+			log.Printf("[operation] %s: failed to compensate %s, manual cleanup needed: %v", o.name, c.step, err)
+		}
+	}
+	o.compensations = nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// operation Tests
+// ============================================================================
+
+func TestOperation_CompensatesNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	var undone []string
+	succeed := func(ctx context.Context) error { return nil }
+	undo := func(step string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			undone = append(undone, step)
+			return nil
+		}
+	}
+	errBoom := errors.New("boom")
+
+	op := newOperation("test")
+	_ = op.step(context.Background(), "first", succeed, undo("first"))
+	_ = op.step(context.Background(), "shared", succeed, nil)
+	_ = op.step(context.Background(), "second", succeed, undo("second"))
+	err := op.step(context.Background(), "third", func(ctx context.Context) error { return errBoom }, undo("third"))
+
+	if !errors.Is(err, errBoom) {
+		t.Errorf("expected the step's error, got %v", err)
+	}
+	if want := []string{"second", "first"}; !reflect.DeepEqual(undone, want) {
+		t.Errorf("expected %v undone, got %v", want, undone)
+	}
+}
+
+func TestOperation_ContinuesPastFailedCompensation(t *testing.T) {
+	t.Parallel()
+
+	firstUndone := false
+	op := newOperation("test")
+	_ = op.step(context.Background(), "first",
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context) error { firstUndone = true; return nil },
+	)
+	_ = op.step(context.Background(), "second",
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context) error { return errors.New("undo failed") },
+	)
+	_ = op.step(context.Background(), "third", func(ctx context.Context) error { return errors.New("boom") }, nil)
+
+	if !firstUndone {
+		t.Error("expected the first step compensated despite the second failing to")
+	}
+}
+
+func TestOperation_CompensatesAfterCancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var undoErr error
+	op := newOperation("test")
+	_ = op.step(ctx, "first",
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context) error { undoErr = ctx.Err(); return nil },
+	)
+	cancel()
+	_ = op.step(ctx, "second", func(ctx context.Context) error { return ctx.Err() }, nil)
+
+	if undoErr != nil {
+		t.Errorf("expected the compensation to run with a live context, got %v", undoErr)
+	}
+}
+
+// ============================================================================
+// CreateGuild Tests
+// ============================================================================
+
+func TestCreateGuild_DeletesGuildWhenAdminCantBeAdded(t *testing.T) {
+	t.Parallel()
+
+	var deleted []string
+	guilds := &mocks.GuildRepository{
+		CreateFunc: func(ctx context.Context, guild *model.Guild) error {
+			guild.ID = "guild:1"
+			return nil
+		},
+		AddMemberWithRoleFunc: func(ctx context.Context, memberID, guildID string, role model.GuildRole, pendingApproval bool) error {
+			return errors.New("db down")
+		},
+		DeleteFunc: func(ctx context.Context, id string) error {
+			deleted = append(deleted, id)
+			return nil
+		},
+	}
+	users := &mocks.UserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+			return &model.User{ID: id, Email: "ada@example.com"}, nil
+		},
+	}
+	members := &mocks.MemberRepository{
+		GetOrCreateFunc: func(ctx context.Context, userID, name, email string) (*model.Member, error) {
+			return &model.Member{ID: "member:1"}, nil
+		},
+	}
+	svc := NewGuildService(GuildServiceConfig{GuildRepo: guilds, MemberRepo: members, UserRepo: users})
+
+	if _, err := svc.CreateGuild(context.Background(), "user:1", CreateGuildRequest{Name: "Hikers"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if !reflect.DeepEqual(deleted, []string{"guild:1"}) {
+		t.Errorf("expected the new guild deleted, got %v", deleted)
+	}
+}
+
+func TestCreateGuild_SlugTakenIsNotWrapped(t *testing.T) {
+	t.Parallel()
+
+	deleted := false
+	guilds := &mocks.GuildRepository{
+		CreateFunc: func(ctx context.Context, guild *model.Guild) error {
+			return fmt.Errorf("index violation: %w", database.ErrDuplicate)
+		},
+		DeleteFunc: func(ctx context.Context, id string) error {
+			deleted = true
+			return nil
+		},
+	}
+	users := &mocks.UserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+			return &model.User{ID: id}, nil
+		},
+	}
+	svc := NewGuildService(GuildServiceConfig{GuildRepo: guilds, MemberRepo: &mocks.MemberRepository{}, UserRepo: users})
+
+	_, err := svc.CreateGuild(context.Background(), "user:1", CreateGuildRequest{Name: "Hikers"})
+	if !errors.Is(err, ErrGuildSlugTaken) {
+		t.Errorf("expected ErrGuildSlugTaken, got %v", err)
+	}
+	if deleted {
+		t.Error("expected nothing to compensate when the first step fails")
+	}
+}
+
+// fakeTransactor marks the contexts it runs fn with and reports how the
+// transaction ended
+type fakeTransactor struct {
+	committed  bool
+	rolledBack bool
+}
+
+type fakeTxKey struct{}
+
+func (f *fakeTransactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, fakeTxKey{}, f)); err != nil {
+		f.rolledBack = true
+		return err
+	}
+	f.committed = true
+	return nil
+}
+
+func TestCreateGuild_RollsBackInsteadOfCompensating(t *testing.T) {
+	t.Parallel()
+
+	deleted := false
+	inTx := 0
+	guilds := &mocks.GuildRepository{
+		CreateFunc: func(ctx context.Context, guild *model.Guild) error {
+			if ctx.Value(fakeTxKey{}) != nil {
+				inTx++
+			}
+			guild.ID = "guild:1"
+			return nil
+		},
+		AddMemberWithRoleFunc: func(ctx context.Context, memberID, guildID string, role model.GuildRole, pendingApproval bool) error {
+			if ctx.Value(fakeTxKey{}) != nil {
+				inTx++
+			}
+			return errors.New("db down")
+		},
+		DeleteFunc: func(ctx context.Context, id string) error {
+			deleted = true
+			return nil
+		},
+	}
+	users := &mocks.UserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+			return &model.User{ID: id, Email: "ada@example.com"}, nil
+		},
+	}
+	members := &mocks.MemberRepository{
+		GetOrCreateFunc: func(ctx context.Context, userID, name, email string) (*model.Member, error) {
+			return &model.Member{ID: "member:1"}, nil
+		},
+	}
+	tx := &fakeTransactor{}
+	svc := NewGuildService(GuildServiceConfig{GuildRepo: guilds, MemberRepo: members, UserRepo: users, Tx: tx})
+
+	if _, err := svc.CreateGuild(context.Background(), "user:1", CreateGuildRequest{Name: "Hikers"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if inTx != 2 {
+		t.Errorf("expected both writes inside the transaction, got %d", inTx)
+	}
+	if !tx.rolledBack || tx.committed {
+		t.Errorf("expected the transaction rolled back, got %+v", tx)
+	}
+	if deleted {
+		t.Error("expected no compensation inside a transaction")
+	}
+}
+
+// ============================================================================
+// AdventureService.Create Tests
+// ============================================================================
+
+func TestAdventureCreate_DeletesAdventureWhenCreatorCantBeAdmitted(t *testing.T) {
+	t.Parallel()
+
+	var deleted []string
+	adventures := &mocks.AdventureRepository{
+		CreateFunc: func(ctx context.Context, adventure *model.Adventure) error {
+			adventure.ID = "adventure:1"
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, id string) error {
+			deleted = append(deleted, id)
+			return nil
+		},
+	}
+	admissions := &mocks.AdventureAdmissionRepository{
+		CreateFunc: func(ctx context.Context, admission *model.AdventureAdmission) error {
+			return errors.New("db down")
+		},
+	}
+	svc := NewAdventureService(AdventureServiceConfig{AdventureRepo: adventures, AdmissionRepo: admissions})
+
+	req := &model.CreateAdventureRequest{
+		Title:     "Coastal hike",
+		StartDate: "2026-05-01T09:00:00Z",
+		EndDate:   "2026-05-03T17:00:00Z",
+	}
+	if _, err := svc.Create(context.Background(), "user:1", req); err == nil {
+		t.Fatal("expected an error")
+	}
+	if !reflect.DeepEqual(deleted, []string{"adventure:1"}) {
+		t.Errorf("expected the new adventure deleted, got %v", deleted)
+	}
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/webauthn"
 )
 
 // Error definitions moved to errors.go
@@ -20,6 +25,16 @@ const (
 
 	// Challenge length in bytes
 	challengeLength = 32
+
+	// How long a cross-device login waits for approval on the phone
+	crossDeviceExpiration = 2 * time.Minute
+
+	// Seconds the initiating device should wait between polls
+	crossDevicePollInterval = 2
+
+	// discoverableChallengeUser stands in for the user on login challenges
+	// issued without an email; any passkey's owner may answer them
+	discoverableChallengeUser = "discoverable"
 )
 
 // PasskeyConfig holds passkey/WebAuthn configuration
@@ -32,13 +47,21 @@ type PasskeyConfig struct {
 	AttestationType string
 }
 
+// AccountRecoverer starts an email recovery once a passkey has been verified
+type AccountRecoverer interface {
+	StartEmailRecovery(ctx context.Context, req EmailRecoveryRequest) (*model.EmailChange, error)
+}
+
 // PasskeyService handles WebAuthn/Passkey operations
 type PasskeyService struct {
 	config       PasskeyConfig
 	passkeyRepo  PasskeyRepository
 	userRepo     UserRepository
 	tokenService *TokenService
+	recoverer    AccountRecoverer
+	rp           webauthn.RelyingParty
 	challenges   *challengeStore
+	crossDevice  *crossDeviceStore
 }
 
 // PasskeyServiceConfig holds configuration for the passkey service
@@ -47,6 +70,8 @@ type PasskeyServiceConfig struct {
 	PasskeyRepo  PasskeyRepository
 	UserRepo     UserRepository
 	TokenService *TokenService
+	// Recoverer enables email recovery with a passkey; nil disables it
+	Recoverer AccountRecoverer
 }
 
 // NewPasskeyService creates a new passkey service
@@ -56,12 +81,31 @@ func NewPasskeyService(cfg PasskeyServiceConfig) *PasskeyService {
 		passkeyRepo:  cfg.PasskeyRepo,
 		userRepo:     cfg.UserRepo,
 		tokenService: cfg.TokenService,
+		recoverer:    cfg.Recoverer,
+		rp:           relyingParty(cfg.Config),
 		challenges:   newChallengeStore(),
+		crossDevice:  newCrossDeviceStore(),
+	}
+}
+
+// relyingParty builds the WebAuthn relying party from configuration. Native
+// apps sign in from the RP ID's own origin, so it is always allowed.
+func relyingParty(cfg PasskeyConfig) webauthn.RelyingParty {
+	origins := slices.Clone(cfg.RPOrigins)
+	if appOrigin := "https://" + cfg.RPID; !slices.Contains(origins, appOrigin) {
+		origins = append(origins, appOrigin)
+	}
+	return webauthn.RelyingParty{
+		ID:                      cfg.RPID,
+		Origins:                 origins,
+		RequireUserVerification: cfg.RequireUV,
 	}
 }
 
-// challengeStore stores pending challenges with expiration
+// challengeStore stores pending challenges with expiration. Each challenge
+// can be answered once.
 type challengeStore struct {
+	mu         sync.Mutex
 	challenges map[string]*challenge
 }
 
@@ -85,6 +129,8 @@ func (s *challengeStore) Create(userID string, isLogin bool) ([]byte, error) {
 	}
 
 	key := base64.RawURLEncoding.EncodeToString(value)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.challenges[key] = &challenge{
 		Value:     value,
 		UserID:    userID,
@@ -98,23 +144,48 @@ func (s *challengeStore) Create(userID string, isLogin bool) ([]byte, error) {
 	return value, nil
 }
 
+// Verify consumes a challenge and reports whether it was issued to userID
+// for the same kind of ceremony and is unexpired. Discoverable login
+// challenges may be answered by any user.
 func (s *challengeStore) Verify(challengeB64 string, userID string, isLogin bool) bool {
+	return s.consume(challengeB64, userID, isLogin) != nil
+}
+
+// VerifyLogin is Verify for login challenges, and also reports whether the
+// challenge was issued without knowing the user
+func (s *challengeStore) VerifyLogin(challengeB64 string, userID string) (ok, discoverable bool) {
+	c := s.consume(challengeB64, userID, true)
+	if c == nil {
+		return false, false
+	}
+	return true, c.UserID == discoverableChallengeUser
+}
+
+// consume removes a challenge and returns it if it may be answered by
+// userID for this kind of ceremony
+func (s *challengeStore) consume(challengeB64 string, userID string, isLogin bool) *challenge {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	c, exists := s.challenges[challengeB64]
 	if !exists {
-		return false
+		return nil
 	}
 
 	defer delete(s.challenges, challengeB64)
 
 	if time.Now().After(c.ExpiresAt) {
-		return false
+		return nil
 	}
 
-	if c.UserID != userID || c.IsLogin != isLogin {
-		return false
+	if c.IsLogin != isLogin {
+		return nil
+	}
+	if c.UserID != userID && !(isLogin && c.UserID == discoverableChallengeUser) {
+		return nil
 	}
 
-	return true
+	return c
 }
 
 func (s *challengeStore) cleanup() {
@@ -237,9 +308,12 @@ func (s *PasskeyService) StartRegistration(ctx context.Context, req Registration
 		},
 		Timeout:     int(s.config.Timeout.Milliseconds()),
 		Attestation: s.config.AttestationType,
+		// Discoverable credentials let users sign in without typing an
+		// email, from autofill or another device
 		AuthenticatorSelection: &AuthenticatorSelection{
-			ResidentKey:      "preferred",
-			UserVerification: "preferred",
+			ResidentKey:        "required",
+			RequireResidentKey: true,
+			UserVerification:   "preferred",
 		},
 		ExcludeCredentials: excludeCredentials,
 	}, nil
@@ -269,20 +343,10 @@ type RegistrationFinishResult struct {
 	Passkey *model.Passkey
 }
 
-// FinishRegistration completes passkey registration
+// FinishRegistration completes passkey registration. The attestation must
+// answer a challenge from StartRegistration for the same user; its
+// statement isn't verified, as the API requests none.
 func (s *PasskeyService) FinishRegistration(ctx context.Context, req RegistrationFinishRequest) (*RegistrationFinishResult, error) {
-	// In a full implementation, you would:
-	// 1. Decode and parse clientDataJSON
-	// 2. Verify challenge matches
-	// 3. Verify origin matches configured origins
-	// 4. Parse attestationObject (CBOR encoded)
-	// 5. Extract authenticator data and public key
-	// 6. Verify attestation signature
-	// 7. Store credential
-
-	// For now, we'll implement a simplified version
-	// In production, use go-webauthn/webauthn library
-
 	// Verify user exists
 	user, err := s.userRepo.GetByID(ctx, req.UserID)
 	if err != nil {
@@ -292,12 +356,37 @@ func (s *PasskeyService) FinishRegistration(ctx context.Context, req Registratio
 		return nil, ErrUserNotFound
 	}
 
+	if req.Credential == nil {
+		return nil, ErrInvalidCredential
+	}
+	clientDataJSON, errClient := decodeBase64URL(req.Credential.Response.ClientDataJSON)
+	attestation, errAttestation := decodeBase64URL(req.Credential.Response.AttestationObject)
+	if errClient != nil || errAttestation != nil {
+		return nil, ErrInvalidCredential
+	}
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil {
+		return nil, ErrInvalidCredential
+	}
+	if !s.challenges.Verify(clientData.Challenge, req.UserID, false) {
+		return nil, ErrInvalidChallenge
+	}
+
+	authData, err := s.rp.VerifyRegistration(clientDataJSON, attestation)
+	if err != nil {
+		return nil, ErrInvalidCredential
+	}
+	credentialID := base64.RawURLEncoding.EncodeToString(authData.CredentialID)
+	if strings.TrimRight(req.Credential.ID, "=") != credentialID {
+		return nil, ErrInvalidCredential
+	}
+
 	// Create passkey record
 	passkey := &model.Passkey{
 		UserID:       req.UserID,
-		CredentialID: req.Credential.ID,
-		PublicKey:    []byte{}, // Would be extracted from attestationObject
-		SignCount:    0,
+		CredentialID: credentialID,
+		PublicKey:    authData.PublicKey,
+		SignCount:    authData.SignCount,
 		Name:         req.Name,
 	}
 
@@ -313,6 +402,9 @@ func (s *PasskeyService) FinishRegistration(ctx context.Context, req Registratio
 // LoginStartRequest represents input for starting passkey login
 type LoginStartRequest struct {
 	Email string // Optional email hint
+	// Conditional starts a login for passkey autofill: the browser offers
+	// discoverable passkeys in the username field, so no email is used
+	Conditional bool
 }
 
 // LoginStartResponse is returned to start passkey login
@@ -322,6 +414,7 @@ type LoginStartResponse struct {
 	RPID             string                 `json:"rpId"`
 	AllowCredentials []CredentialDescriptor `json:"allowCredentials,omitempty"`
 	UserVerification string                 `json:"userVerification,omitempty"`
+	Mediation        string                 `json:"mediation,omitempty"` // "conditional" for autofill
 }
 
 // StartLogin initiates passkey login
@@ -329,7 +422,7 @@ func (s *PasskeyService) StartLogin(ctx context.Context, req LoginStartRequest)
 	var allowCredentials []CredentialDescriptor
 	var userID string
 
-	if req.Email != "" {
+	if req.Email != "" && !req.Conditional {
 		// If email provided, get user's passkeys
 		user, err := s.userRepo.GetByEmail(ctx, req.Email)
 		if err != nil {
@@ -356,7 +449,7 @@ func (s *PasskeyService) StartLogin(ctx context.Context, req LoginStartRequest)
 		}
 	} else {
 		// Discoverable credential flow - no allowCredentials
-		userID = "discoverable"
+		userID = discoverableChallengeUser
 	}
 
 	// Generate challenge
@@ -365,13 +458,17 @@ func (s *PasskeyService) StartLogin(ctx context.Context, req LoginStartRequest)
 		return nil, err
 	}
 
-	return &LoginStartResponse{
+	response := &LoginStartResponse{
 		Challenge:        base64.RawURLEncoding.EncodeToString(challengeBytes),
 		Timeout:          int(s.config.Timeout.Milliseconds()),
 		RPID:             s.config.RPID,
 		AllowCredentials: allowCredentials,
 		UserVerification: "preferred",
-	}, nil
+	}
+	if req.Conditional {
+		response.Mediation = "conditional"
+	}
+	return response, nil
 }
 
 // LoginFinishRequest represents input for completing passkey login
@@ -399,50 +496,146 @@ type LoginFinishResult struct {
 	TokenPair *TokenPair
 }
 
-// FinishLogin completes passkey login
+// FinishLogin completes passkey login. The assertion must answer a
+// challenge from StartLogin.
 func (s *PasskeyService) FinishLogin(ctx context.Context, req LoginFinishRequest) (*LoginFinishResult, error) {
-	// Find passkey by credential ID
-	passkey, err := s.passkeyRepo.GetByCredentialID(ctx, req.Credential.ID)
+	_, user, err := s.verifyAssertion(ctx, req.Credential, s.loginChallenge)
 	if err != nil {
 		return nil, err
 	}
+
+	// Generate tokens
+	tokenPair, err := s.tokenService.GenerateTokenPair(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginFinishResult{
+		User:      user,
+		TokenPair: tokenPair,
+	}, nil
+}
+
+// RecoveryFinishRequest represents input for recovering an account with a
+// passkey. The assertion answers a challenge from StartLogin.
+type RecoveryFinishRequest struct {
+	Credential *AssertionResponse
+	NewEmail   string
+}
+
+// FinishRecovery verifies a passkey and starts moving its account to a new
+// email address, for users who can no longer reach their inbox. No session
+// is issued; the change completes once the new address is verified after
+// the recovery delay.
+func (s *PasskeyService) FinishRecovery(ctx context.Context, req RecoveryFinishRequest) (*model.EmailChange, error) {
+	if s.recoverer == nil {
+		return nil, ErrAccountRecoveryDisabled
+	}
+
+	passkey, user, err := s.verifyAssertion(ctx, req.Credential, s.loginChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.recoverer.StartEmailRecovery(ctx, EmailRecoveryRequest{
+		UserID:    user.ID,
+		NewEmail:  req.NewEmail,
+		PasskeyID: passkey.ID,
+	})
+}
+
+// challengeCheck consumes the challenge an assertion answers and reports
+// whether it was issued for a login by userID, and whether it was issued
+// without knowing the user (discoverable, conditional or cross-device)
+type challengeCheck func(challenge, userID string) (ok, discoverable bool)
+
+// loginChallenge checks challenges issued by StartLogin
+func (s *PasskeyService) loginChallenge(challenge, userID string) (ok, discoverable bool) {
+	return s.challenges.VerifyLogin(challenge, userID)
+}
+
+// verifyAssertion verifies a passkey assertion: it must answer a challenge
+// accepted by checkChallenge, come from an allowed origin for this RP, be
+// signed by the passkey's stored public key and advance its sign count. It
+// returns the passkey and the user it belongs to.
+func (s *PasskeyService) verifyAssertion(ctx context.Context, credential *AssertionResponse, checkChallenge challengeCheck) (*model.Passkey, *model.User, error) {
+	if credential == nil {
+		return nil, nil, ErrInvalidCredential
+	}
+
+	// Find passkey by credential ID
+	passkey, err := s.passkeyRepo.GetByCredentialID(ctx, strings.TrimRight(credential.ID, "="))
+	if err != nil {
+		return nil, nil, err
+	}
 	if passkey == nil {
-		return nil, ErrPasskeyNotFound
+		return nil, nil, ErrPasskeyNotFound
+	}
+
+	// Discoverable credentials name their user; it must be the passkey's owner
+	if credential.Response.UserHandle != "" {
+		userHandle, err := decodeBase64URL(credential.Response.UserHandle)
+		if err != nil {
+			return nil, nil, ErrInvalidCredential
+		}
+		if string(userHandle) != passkey.UserID {
+			return nil, nil, ErrCredentialNotAllowed
+		}
+	}
+
+	clientDataJSON, errClient := decodeBase64URL(credential.Response.ClientDataJSON)
+	authenticatorData, errAuth := decodeBase64URL(credential.Response.AuthenticatorData)
+	signature, errSig := decodeBase64URL(credential.Response.Signature)
+	if errClient != nil || errAuth != nil || errSig != nil {
+		return nil, nil, ErrInvalidCredential
+	}
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil {
+		return nil, nil, ErrInvalidCredential
+	}
+
+	// The challenge is spent whether or not the rest of the assertion holds
+	ok, discoverable := checkChallenge(clientData.Challenge, passkey.UserID)
+	if !ok {
+		return nil, nil, ErrInvalidChallenge
+	}
+
+	// A login that didn't name its user learns it from the authenticator
+	if discoverable && credential.Response.UserHandle == "" {
+		return nil, nil, ErrInvalidCredential
 	}
 
-	// In a full implementation, you would:
-	// 1. Decode and parse clientDataJSON
-	// 2. Verify challenge matches
-	// 3. Verify origin matches configured origins
-	// 4. Parse authenticatorData
-	// 5. Verify signature using stored public key
-	// 6. Verify sign count (detect cloned authenticators)
+	authData, err := s.rp.VerifyAssertion(clientDataJSON, authenticatorData, signature, passkey.PublicKey)
+	if err != nil {
+		return nil, nil, ErrInvalidCredential
+	}
+	if webauthn.SignCountRegressed(passkey.SignCount, authData.SignCount) {
+		return nil, nil, ErrSignCountMismatch
+	}
 
 	// Get user
 	user, err := s.userRepo.GetByID(ctx, passkey.UserID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if user == nil {
-		return nil, ErrUserNotFound
+		return nil, nil, ErrUserNotFound
 	}
 
-	// Update sign count
-	newSignCount := passkey.SignCount + 1
-	if err := s.passkeyRepo.UpdateSignCount(ctx, passkey.CredentialID, newSignCount); err != nil {
-		return nil, err
+	if err := s.passkeyRepo.UpdateSignCount(ctx, passkey.CredentialID, authData.SignCount); err != nil {
+		return nil, nil, err
 	}
+	passkey.SignCount = authData.SignCount
 
-	// Generate tokens
-	tokenPair, err := s.tokenService.GenerateTokenPair(ctx, user)
-	if err != nil {
-		return nil, err
-	}
+	return passkey, user, nil
+}
 
-	return &LoginFinishResult{
-		User:      user,
-		TokenPair: tokenPair,
-	}, nil
+// decodeBase64URL decodes base64url with or without padding, as clients send
+func decodeBase64URL(value string) ([]byte, error) {
+	if value == "" {
+		return nil, errors.New("empty value")
+	}
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
 }
 
 // DeletePasskey removes a passkey
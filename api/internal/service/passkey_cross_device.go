@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// Cross-device login statuses
+const (
+	CrossDeviceStatusPending  = "pending"
+	CrossDeviceStatusApproved = "approved"
+)
+
+// crossDeviceStore holds cross-device logins in memory, like challenges.
+// A login must be started, approved and polled on the same instance.
+type crossDeviceStore struct {
+	mu       sync.Mutex
+	sessions map[string]*crossDeviceSession
+}
+
+type crossDeviceSession struct {
+	PollTokenHash string
+	Challenge     []byte
+	ExpiresAt     time.Time
+	UserID        string // Set once approved
+}
+
+func newCrossDeviceStore() *crossDeviceStore {
+	return &crossDeviceStore{
+		sessions: make(map[string]*crossDeviceSession),
+	}
+}
+
+// get returns a live session. Expired sessions are removed.
+func (s *crossDeviceStore) get(id string) (*crossDeviceSession, error) {
+	session, exists := s.sessions[id]
+	if !exists {
+		return nil, ErrCrossDeviceNotFound
+	}
+	if time.Now().After(session.ExpiresAt) {
+		delete(s.sessions, id)
+		return nil, ErrCrossDeviceExpired
+	}
+	return session, nil
+}
+
+// consumeChallenge spends a session's challenge and reports whether
+// challenge matched it
+func (s *crossDeviceStore) consumeChallenge(id, challenge string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.get(id)
+	if err != nil || session.Challenge == nil {
+		return false
+	}
+	expected := base64.RawURLEncoding.EncodeToString(session.Challenge)
+	session.Challenge = nil
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(expected)) == 1
+}
+
+func (s *crossDeviceStore) cleanup() {
+	now := time.Now()
+	for id, session := range s.sessions {
+		if now.After(session.ExpiresAt) {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// CrossDeviceStartResponse is returned to the device that wants to sign in.
+// It shows ID to the phone, typically as a QR code, and keeps PollToken to
+// itself.
+type CrossDeviceStartResponse struct {
+	ID        string    `json:"id"`
+	PollToken string    `json:"poll_token"`
+	ExpiresOn time.Time `json:"expires_on"`
+	Interval  int       `json:"interval"` // Seconds between polls
+}
+
+// CrossDevicePollResult reports whether a cross-device login has been
+// approved. User and TokenPair are set once it has.
+type CrossDevicePollResult struct {
+	Status    string
+	User      *model.User
+	TokenPair *TokenPair
+}
+
+// StartCrossDevice begins a login on a device without a passkey, to be
+// approved with a passkey on another device such as the user's phone
+func (s *PasskeyService) StartCrossDevice(ctx context.Context) (*CrossDeviceStartResponse, error) {
+	id, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	pollToken, err := newSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	challengeBytes := make([]byte, challengeLength)
+	if _, err := rand.Read(challengeBytes); err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(crossDeviceExpiration)
+	s.crossDevice.mu.Lock()
+	defer s.crossDevice.mu.Unlock()
+	s.crossDevice.cleanup()
+	s.crossDevice.sessions[id] = &crossDeviceSession{
+		PollTokenHash: hashToken(pollToken),
+		Challenge:     challengeBytes,
+		ExpiresAt:     expiresAt,
+	}
+
+	return &CrossDeviceStartResponse{
+		ID:        id,
+		PollToken: pollToken,
+		ExpiresOn: expiresAt,
+		Interval:  crossDevicePollInterval,
+	}, nil
+}
+
+// CrossDeviceOptions returns the assertion options the approving device
+// passes to its authenticator. No credentials are listed, so any
+// discoverable passkey can approve.
+func (s *PasskeyService) CrossDeviceOptions(ctx context.Context, id string) (*LoginStartResponse, error) {
+	s.crossDevice.mu.Lock()
+	defer s.crossDevice.mu.Unlock()
+
+	session, err := s.crossDevice.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != "" {
+		return nil, ErrCrossDeviceAlreadyApproved
+	}
+	if session.Challenge == nil {
+		return nil, ErrInvalidChallenge
+	}
+
+	return &LoginStartResponse{
+		Challenge:        base64.RawURLEncoding.EncodeToString(session.Challenge),
+		Timeout:          int(time.Until(session.ExpiresAt).Milliseconds()),
+		RPID:             s.config.RPID,
+		UserVerification: "preferred",
+	}, nil
+}
+
+// ApproveCrossDevice approves a cross-device login with a passkey
+// assertion from the approving device. The initiating device is signed in
+// as the passkey's user on its next poll.
+func (s *PasskeyService) ApproveCrossDevice(ctx context.Context, id string, credential *AssertionResponse) error {
+	// Check the session before spending the passkey's sign count on it
+	s.crossDevice.mu.Lock()
+	session, err := s.crossDevice.get(id)
+	if err == nil && session.UserID != "" {
+		err = ErrCrossDeviceAlreadyApproved
+	}
+	s.crossDevice.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// The assertion must answer this login's own challenge, which is spent
+	// by the first attempt like a StartLogin challenge
+	_, user, err := s.verifyAssertion(ctx, credential, func(challenge, _ string) (bool, bool) {
+		return s.crossDevice.consumeChallenge(id, challenge), true
+	})
+	if err != nil {
+		return err
+	}
+
+	s.crossDevice.mu.Lock()
+	defer s.crossDevice.mu.Unlock()
+	session, err = s.crossDevice.get(id)
+	if err != nil {
+		return err
+	}
+	if session.UserID != "" {
+		return ErrCrossDeviceAlreadyApproved
+	}
+	session.UserID = user.ID
+	return nil
+}
+
+// PollCrossDevice is called by the initiating device until its login is
+// approved. Tokens are issued once; the login is then finished.
+func (s *PasskeyService) PollCrossDevice(ctx context.Context, id, pollToken string) (*CrossDevicePollResult, error) {
+	s.crossDevice.mu.Lock()
+	session, err := s.crossDevice.get(id)
+	if err != nil {
+		s.crossDevice.mu.Unlock()
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashToken(pollToken)), []byte(session.PollTokenHash)) != 1 {
+		s.crossDevice.mu.Unlock()
+		return nil, ErrInvalidPollToken
+	}
+	userID := session.UserID
+	if userID != "" {
+		delete(s.crossDevice.sessions, id)
+	}
+	s.crossDevice.mu.Unlock()
+
+	if userID == "" {
+		return &CrossDevicePollResult{Status: CrossDeviceStatusPending}, nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	tokenPair, err := s.tokenService.GenerateTokenPair(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CrossDevicePollResult{
+		Status:    CrossDeviceStatusApproved,
+		User:      user,
+		TokenPair: tokenPair,
+	}, nil
+}
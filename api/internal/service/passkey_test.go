@@ -1,14 +1,17 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/authenticator"
 	"github.com/forgo/saga/api/pkg/jwt"
 )
 
@@ -263,6 +266,44 @@ func createTestUser(t *testing.T, userRepo *passkeyMockUserRepo, email string) *
 	return user
 }
 
+// addTestPasskey stores a passkey for user held by a software
+// authenticator, which can then answer login challenges
+func addTestPasskey(t *testing.T, passkeyRepo *passkeyMockPasskeyRepo, user *model.User) *authenticator.Authenticator {
+	t.Helper()
+	auth := authenticator.New(t, "localhost", "http://localhost:3000")
+	_ = passkeyRepo.Create(context.Background(), &model.Passkey{
+		UserID:       user.ID,
+		CredentialID: auth.CredentialID(),
+		PublicKey:    auth.PublicKey(),
+	})
+	return auth
+}
+
+// assertionResponse converts an authenticator's assertion to the request form
+func assertionResponse(a authenticator.Assertion) *AssertionResponse {
+	return &AssertionResponse{
+		ID:    a.CredentialID,
+		RawID: a.CredentialID,
+		Type:  "public-key",
+		Response: AuthenticatorAssertionResponse{
+			ClientDataJSON:    a.ClientDataJSON,
+			AuthenticatorData: a.AuthenticatorData,
+			Signature:         a.Signature,
+			UserHandle:        a.UserHandle,
+		},
+	}
+}
+
+// startTestLogin starts a login and returns its challenge
+func startTestLogin(t *testing.T, passkeyService *PasskeyService, req LoginStartRequest) string {
+	t.Helper()
+	started, err := passkeyService.StartLogin(context.Background(), req)
+	if err != nil {
+		t.Fatalf("StartLogin failed: %v", err)
+	}
+	return started.Challenge
+}
+
 // Tests for challengeStore
 
 func TestChallengeStore_Create(t *testing.T) {
@@ -508,17 +549,23 @@ func TestPasskeyService_FinishRegistration_Success(t *testing.T) {
 	ctx := context.Background()
 
 	user := createTestUser(t, userRepo, "test@example.com")
+	started, err := passkeyService.StartRegistration(ctx, RegistrationStartRequest{UserID: user.ID})
+	if err != nil {
+		t.Fatalf("StartRegistration failed: %v", err)
+	}
+	auth := authenticator.New(t, "localhost", "http://localhost:3000")
+	reg := auth.Register(started.Challenge)
 
 	result, err := passkeyService.FinishRegistration(ctx, RegistrationFinishRequest{
 		UserID: user.ID,
 		Name:   "My Passkey",
 		Credential: &CredentialResponse{
-			ID:    "new-cred-456",
-			RawID: "new-cred-456",
+			ID:    reg.CredentialID,
+			RawID: reg.CredentialID,
 			Type:  "public-key",
 			Response: AttestationResponse{
-				ClientDataJSON:    "mock-client-data",
-				AttestationObject: "mock-attestation",
+				ClientDataJSON:    reg.ClientDataJSON,
+				AttestationObject: reg.AttestationObject,
 			},
 		},
 	})
@@ -532,8 +579,8 @@ func TestPasskeyService_FinishRegistration_Success(t *testing.T) {
 	if result.Passkey == nil {
 		t.Fatal("expected Passkey to be set")
 	}
-	if result.Passkey.CredentialID != "new-cred-456" {
-		t.Errorf("expected CredentialID new-cred-456, got %s", result.Passkey.CredentialID)
+	if result.Passkey.CredentialID != reg.CredentialID {
+		t.Errorf("expected CredentialID %s, got %s", reg.CredentialID, result.Passkey.CredentialID)
 	}
 	if result.Passkey.Name != "My Passkey" {
 		t.Errorf("expected Name 'My Passkey', got %s", result.Passkey.Name)
@@ -542,10 +589,67 @@ func TestPasskeyService_FinishRegistration_Success(t *testing.T) {
 		t.Error("initial SignCount should be 0")
 	}
 
-	// Verify passkey was stored
-	stored, _ := passkeyRepo.GetByCredentialID(ctx, "new-cred-456")
+	// Verify passkey was stored with its public key
+	stored, _ := passkeyRepo.GetByCredentialID(ctx, reg.CredentialID)
 	if stored == nil {
-		t.Error("passkey should be stored in repository")
+		t.Fatal("passkey should be stored in repository")
+	}
+	if !bytes.Equal(stored.PublicKey, auth.PublicKey()) {
+		t.Error("expected the credential's public key to be stored")
+	}
+}
+
+func TestPasskeyService_FinishRegistration_Rejections(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+	ctx := context.Background()
+	user := createTestUser(t, userRepo, "test@example.com")
+	other := createTestUser(t, userRepo, "other@example.com")
+
+	finish := func(userID string, reg authenticator.Registration, id string) error {
+		_, err := passkeyService.FinishRegistration(ctx, RegistrationFinishRequest{
+			UserID: userID,
+			Name:   "My Passkey",
+			Credential: &CredentialResponse{
+				ID:   id,
+				Type: "public-key",
+				Response: AttestationResponse{
+					ClientDataJSON:    reg.ClientDataJSON,
+					AttestationObject: reg.AttestationObject,
+				},
+			},
+		})
+		return err
+	}
+	challenge := func(userID string) string {
+		started, err := passkeyService.StartRegistration(ctx, RegistrationStartRequest{UserID: userID})
+		if err != nil {
+			t.Fatalf("StartRegistration failed: %v", err)
+		}
+		return started.Challenge
+	}
+
+	auth := authenticator.New(t, "localhost", "http://localhost:3000")
+	reg := auth.Register(challenge(user.ID))
+	if err := finish(user.ID, reg, "another-credential"); !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("expected ErrInvalidCredential for a mismatched credential ID, got %v", err)
+	}
+	if err := finish(user.ID, reg, reg.CredentialID); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("expected ErrInvalidChallenge for a spent challenge, got %v", err)
+	}
+
+	reg = auth.Register(challenge(other.ID))
+	if err := finish(user.ID, reg, reg.CredentialID); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("expected ErrInvalidChallenge for another user's challenge, got %v", err)
+	}
+
+	evil := authenticator.New(t, "localhost", "https://evil.example.com")
+	reg = evil.Register(challenge(user.ID))
+	if err := finish(user.ID, reg, reg.CredentialID); !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("expected ErrInvalidCredential for a foreign origin, got %v", err)
+	}
+
+	if len(passkeyRepo.passkeys) != 0 {
+		t.Errorf("expected nothing stored, got %d passkeys", len(passkeyRepo.passkeys))
 	}
 }
 
@@ -655,26 +759,12 @@ func TestPasskeyService_FinishLogin_Success(t *testing.T) {
 	ctx := context.Background()
 
 	user := createTestUser(t, userRepo, "test@example.com")
+	auth := addTestPasskey(t, passkeyRepo, user)
+	auth.SetSignCount(5)
 
-	// Add passkey for user
-	_ = passkeyRepo.Create(ctx, &model.Passkey{
-		UserID:       user.ID,
-		CredentialID: "cred-login",
-		PublicKey:    []byte("pubkey"),
-		SignCount:    5,
-	})
-
+	challenge := startTestLogin(t, passkeyService, LoginStartRequest{Email: "test@example.com"})
 	result, err := passkeyService.FinishLogin(ctx, LoginFinishRequest{
-		Credential: &AssertionResponse{
-			ID:    "cred-login",
-			RawID: "cred-login",
-			Type:  "public-key",
-			Response: AuthenticatorAssertionResponse{
-				ClientDataJSON:    "mock-client-data",
-				AuthenticatorData: "mock-auth-data",
-				Signature:         "mock-signature",
-			},
-		},
+		Credential: assertionResponse(auth.Assert(challenge, "")),
 	})
 	if err != nil {
 		t.Fatalf("FinishLogin failed: %v", err)
@@ -693,10 +783,72 @@ func TestPasskeyService_FinishLogin_Success(t *testing.T) {
 		t.Error("expected TokenPair to be set")
 	}
 
-	// Verify sign count was updated
-	passkey, _ := passkeyRepo.GetByCredentialID(ctx, "cred-login")
+	// Verify the authenticator's sign count was stored
+	passkey, _ := passkeyRepo.GetByCredentialID(ctx, auth.CredentialID())
 	if passkey.SignCount != 6 {
-		t.Errorf("expected SignCount to be incremented to 6, got %d", passkey.SignCount)
+		t.Errorf("expected SignCount 6, got %d", passkey.SignCount)
+	}
+}
+
+func TestPasskeyService_FinishLogin_RejectsUnverifiedAssertions(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, userRepo, "test@example.com")
+	other := createTestUser(t, userRepo, "other@example.com")
+	auth := addTestPasskey(t, passkeyRepo, user)
+	login := func(credential *AssertionResponse) error {
+		_, err := passkeyService.FinishLogin(ctx, LoginFinishRequest{Credential: credential})
+		return err
+	}
+
+	// Only the credential ID, as read from allowCredentials
+	startTestLogin(t, passkeyService, LoginStartRequest{Email: "test@example.com"})
+	if err := login(&AssertionResponse{ID: auth.CredentialID(), Type: "public-key"}); !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("expected ErrInvalidCredential for a bare credential ID, got %v", err)
+	}
+
+	// A challenge the server never issued
+	if err := login(assertionResponse(auth.Assert("bm90LWlzc3VlZA", ""))); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("expected ErrInvalidChallenge for an unissued challenge, got %v", err)
+	}
+
+	// A challenge issued to someone else
+	challenge := startTestLogin(t, passkeyService, LoginStartRequest{Email: "other@example.com"})
+	if err := login(assertionResponse(auth.Assert(challenge, ""))); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("expected ErrInvalidChallenge for %s's challenge, got %v", other.ID, err)
+	}
+
+	// Signed by a different key
+	impostor := authenticator.New(t, "localhost", "http://localhost:3000")
+	challenge = startTestLogin(t, passkeyService, LoginStartRequest{Email: "test@example.com"})
+	forged := impostor.Assert(challenge, "")
+	forged.CredentialID = auth.CredentialID()
+	if err := login(assertionResponse(forged)); !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("expected ErrInvalidCredential for a forged signature, got %v", err)
+	}
+
+	// From a foreign origin, or for another RP
+	for _, rogue := range []*authenticator.Authenticator{
+		authenticator.New(t, "localhost", "https://evil.example.com"),
+		authenticator.New(t, "evil.example.com", "http://localhost:3000"),
+	} {
+		_ = passkeyRepo.Create(ctx, &model.Passkey{UserID: user.ID, CredentialID: rogue.CredentialID(), PublicKey: rogue.PublicKey()})
+		challenge = startTestLogin(t, passkeyService, LoginStartRequest{Email: "test@example.com"})
+		if err := login(assertionResponse(rogue.Assert(challenge, ""))); !errors.Is(err, ErrInvalidCredential) {
+			t.Errorf("expected ErrInvalidCredential for a foreign origin or RP, got %v", err)
+		}
+	}
+
+	// A sign count that goes backwards
+	challenge = startTestLogin(t, passkeyService, LoginStartRequest{Email: "test@example.com"})
+	if err := login(assertionResponse(auth.Assert(challenge, ""))); err != nil {
+		t.Fatalf("expected a valid assertion to sign in, got %v", err)
+	}
+	auth.SetSignCount(0)
+	challenge = startTestLogin(t, passkeyService, LoginStartRequest{Email: "test@example.com"})
+	if err := login(assertionResponse(auth.Assert(challenge, ""))); !errors.Is(err, ErrSignCountMismatch) {
+		t.Errorf("expected ErrSignCountMismatch for a cloned authenticator, got %v", err)
 	}
 }
 
@@ -935,3 +1087,364 @@ func TestWebAuthnStructures(t *testing.T) {
 		t.Error("CredentialDescriptor.Transports mismatch")
 	}
 }
+
+type mockAccountRecoverer struct {
+	requests []EmailRecoveryRequest
+}
+
+func (m *mockAccountRecoverer) StartEmailRecovery(ctx context.Context, req EmailRecoveryRequest) (*model.EmailChange, error) {
+	m.requests = append(m.requests, req)
+	return &model.EmailChange{UserID: req.UserID, NewEmail: req.NewEmail, Recovery: true}, nil
+}
+
+func TestPasskeyService_FinishRecovery(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, tokenRepo := setupPasskeyService(t)
+	recoverer := &mockAccountRecoverer{}
+	passkeyService.recoverer = recoverer
+	ctx := context.Background()
+
+	user := createTestUser(t, userRepo, "lost@example.com")
+	auth := addTestPasskey(t, passkeyRepo, user)
+
+	challenge := startTestLogin(t, passkeyService, LoginStartRequest{})
+	change, err := passkeyService.FinishRecovery(ctx, RecoveryFinishRequest{
+		Credential: assertionResponse(auth.Assert(challenge, user.ID)),
+		NewEmail:   "found@example.com",
+	})
+	if err != nil {
+		t.Fatalf("FinishRecovery failed: %v", err)
+	}
+	if !change.Recovery || change.NewEmail != "found@example.com" {
+		t.Errorf("expected a recovery change to found@example.com, got %+v", change)
+	}
+	if len(recoverer.requests) != 1 || recoverer.requests[0].UserID != user.ID || recoverer.requests[0].PasskeyID == "" {
+		t.Errorf("expected recovery for %s with the passkey, got %+v", user.ID, recoverer.requests)
+	}
+
+	// Recovery doesn't sign the caller in
+	if len(tokenRepo.tokens) != 0 {
+		t.Errorf("expected no session, got %d refresh tokens", len(tokenRepo.tokens))
+	}
+}
+
+func TestPasskeyService_FinishRecovery_Rejections(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+	ctx := context.Background()
+	req := RecoveryFinishRequest{
+		Credential: &AssertionResponse{ID: "nonexistent-cred", Type: "public-key"},
+		NewEmail:   "found@example.com",
+	}
+
+	if _, err := passkeyService.FinishRecovery(ctx, req); !errors.Is(err, ErrAccountRecoveryDisabled) {
+		t.Errorf("expected ErrAccountRecoveryDisabled, got %v", err)
+	}
+
+	recoverer := &mockAccountRecoverer{}
+	passkeyService.recoverer = recoverer
+	if _, err := passkeyService.FinishRecovery(ctx, req); !errors.Is(err, ErrPasskeyNotFound) {
+		t.Errorf("expected ErrPasskeyNotFound, got %v", err)
+	}
+
+	// Knowing a credential ID and its owner isn't proof of holding the key
+	user := createTestUser(t, userRepo, "lost@example.com")
+	auth := addTestPasskey(t, passkeyRepo, user)
+	startTestLogin(t, passkeyService, LoginStartRequest{})
+	req.Credential = &AssertionResponse{
+		ID:       auth.CredentialID(),
+		Type:     "public-key",
+		Response: AuthenticatorAssertionResponse{UserHandle: base64.RawURLEncoding.EncodeToString([]byte(user.ID))},
+	}
+	if _, err := passkeyService.FinishRecovery(ctx, req); !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("expected ErrInvalidCredential without a signed assertion, got %v", err)
+	}
+	if len(recoverer.requests) != 0 {
+		t.Errorf("expected no recovery, got %+v", recoverer.requests)
+	}
+}
+
+func TestPasskeyService_StartLogin_Conditional(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, userRepo, "test@example.com")
+	_ = passkeyRepo.Create(ctx, &model.Passkey{UserID: user.ID, CredentialID: "cred-1"})
+
+	// Autofill never lists credentials, even with an email hint
+	result, err := passkeyService.StartLogin(ctx, LoginStartRequest{
+		Email:       "test@example.com",
+		Conditional: true,
+	})
+	if err != nil {
+		t.Fatalf("StartLogin failed: %v", err)
+	}
+	if result.Mediation != "conditional" {
+		t.Errorf("expected conditional mediation, got %q", result.Mediation)
+	}
+	if len(result.AllowCredentials) != 0 {
+		t.Errorf("expected no allowCredentials, got %+v", result.AllowCredentials)
+	}
+}
+
+func TestPasskeyService_FinishLogin_UserHandle(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, userRepo, "test@example.com")
+	other := createTestUser(t, userRepo, "other@example.com")
+	auth := addTestPasskey(t, passkeyRepo, user)
+
+	login := func(userHandle string) error {
+		assertion := auth.Assert(startTestLogin(t, passkeyService, LoginStartRequest{}), "")
+		assertion.UserHandle = userHandle
+		_, err := passkeyService.FinishLogin(ctx, LoginFinishRequest{Credential: assertionResponse(assertion)})
+		return err
+	}
+
+	if err := login(base64.RawURLEncoding.EncodeToString([]byte(user.ID))); err != nil {
+		t.Errorf("expected the owner's user handle to sign in, got %v", err)
+	}
+	if err := login(base64.URLEncoding.EncodeToString([]byte(user.ID))); err != nil {
+		t.Errorf("expected a padded user handle to sign in, got %v", err)
+	}
+	if err := login(base64.RawURLEncoding.EncodeToString([]byte(other.ID))); !errors.Is(err, ErrCredentialNotAllowed) {
+		t.Errorf("expected ErrCredentialNotAllowed for another user's handle, got %v", err)
+	}
+	if err := login("not base64!"); !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("expected ErrInvalidCredential for a malformed handle, got %v", err)
+	}
+}
+
+func TestPasskeyService_CrossDevice(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, userRepo, "test@example.com")
+	phone := addTestPasskey(t, passkeyRepo, user)
+
+	// Desktop starts and polls
+	started, err := passkeyService.StartCrossDevice(ctx)
+	if err != nil {
+		t.Fatalf("StartCrossDevice failed: %v", err)
+	}
+	if started.ID == "" || started.PollToken == "" || started.Interval <= 0 {
+		t.Fatalf("expected an id, poll token and interval, got %+v", started)
+	}
+	poll, err := passkeyService.PollCrossDevice(ctx, started.ID, started.PollToken)
+	if err != nil {
+		t.Fatalf("PollCrossDevice failed: %v", err)
+	}
+	if poll.Status != CrossDeviceStatusPending || poll.TokenPair != nil {
+		t.Errorf("expected pending without tokens, got %+v", poll)
+	}
+
+	// Phone fetches options and approves with its passkey
+	options, err := passkeyService.CrossDeviceOptions(ctx, started.ID)
+	if err != nil {
+		t.Fatalf("CrossDeviceOptions failed: %v", err)
+	}
+	if options.Challenge == "" || len(options.AllowCredentials) != 0 {
+		t.Errorf("expected a challenge for any discoverable passkey, got %+v", options)
+	}
+	credential := assertionResponse(phone.Assert(options.Challenge, user.ID))
+	if err := passkeyService.ApproveCrossDevice(ctx, started.ID, credential); err != nil {
+		t.Fatalf("ApproveCrossDevice failed: %v", err)
+	}
+	if err := passkeyService.ApproveCrossDevice(ctx, started.ID, credential); !errors.Is(err, ErrCrossDeviceAlreadyApproved) {
+		t.Errorf("expected ErrCrossDeviceAlreadyApproved, got %v", err)
+	}
+
+	// Only the desktop's poll token collects the session, once
+	if _, err := passkeyService.PollCrossDevice(ctx, started.ID, "guess"); !errors.Is(err, ErrInvalidPollToken) {
+		t.Errorf("expected ErrInvalidPollToken, got %v", err)
+	}
+	poll, err = passkeyService.PollCrossDevice(ctx, started.ID, started.PollToken)
+	if err != nil {
+		t.Fatalf("PollCrossDevice failed: %v", err)
+	}
+	if poll.Status != CrossDeviceStatusApproved || poll.User.ID != user.ID || poll.TokenPair == nil {
+		t.Errorf("expected an approved session for %s, got %+v", user.ID, poll)
+	}
+	if _, err := passkeyService.PollCrossDevice(ctx, started.ID, started.PollToken); !errors.Is(err, ErrCrossDeviceNotFound) {
+		t.Errorf("expected ErrCrossDeviceNotFound after collecting, got %v", err)
+	}
+}
+
+func TestPasskeyService_CrossDevice_Expired(t *testing.T) {
+	passkeyService, _, _, _ := setupPasskeyService(t)
+	ctx := context.Background()
+
+	started, err := passkeyService.StartCrossDevice(ctx)
+	if err != nil {
+		t.Fatalf("StartCrossDevice failed: %v", err)
+	}
+	passkeyService.crossDevice.sessions[started.ID].ExpiresAt = time.Now().Add(-time.Second)
+
+	if _, err := passkeyService.PollCrossDevice(ctx, started.ID, started.PollToken); !errors.Is(err, ErrCrossDeviceExpired) {
+		t.Errorf("expected ErrCrossDeviceExpired, got %v", err)
+	}
+	if _, err := passkeyService.CrossDeviceOptions(ctx, "missing"); !errors.Is(err, ErrCrossDeviceNotFound) {
+		t.Errorf("expected ErrCrossDeviceNotFound, got %v", err)
+	}
+}
+
+// tamper flips a bit in an assertion's signature
+func tamper(t *testing.T, a authenticator.Assertion) authenticator.Assertion {
+	t.Helper()
+	signature, err := base64.RawURLEncoding.DecodeString(a.Signature)
+	if err != nil {
+		t.Fatalf("failed to decode signature: %v", err)
+	}
+	signature[len(signature)-1] ^= 0x01
+	a.Signature = base64.RawURLEncoding.EncodeToString(signature)
+	return a
+}
+
+func TestPasskeyService_RejectsTamperedAndReplayedAssertions(t *testing.T) {
+	tests := []struct {
+		name string
+		// challenge starts a ceremony and returns its challenge
+		challenge func(t *testing.T, passkeyService *PasskeyService) string
+		// finish answers the ceremony started by challenge
+		finish func(passkeyService *PasskeyService, credential *AssertionResponse) error
+		// userHandle is whether the authenticator names its user
+		userHandle bool
+	}{
+		{
+			name: "login",
+			challenge: func(t *testing.T, passkeyService *PasskeyService) string {
+				return startTestLogin(t, passkeyService, LoginStartRequest{Email: "test@example.com"})
+			},
+			finish: func(passkeyService *PasskeyService, credential *AssertionResponse) error {
+				_, err := passkeyService.FinishLogin(context.Background(), LoginFinishRequest{Credential: credential})
+				return err
+			},
+		},
+		{
+			name: "conditional login",
+			challenge: func(t *testing.T, passkeyService *PasskeyService) string {
+				return startTestLogin(t, passkeyService, LoginStartRequest{Conditional: true})
+			},
+			finish: func(passkeyService *PasskeyService, credential *AssertionResponse) error {
+				_, err := passkeyService.FinishLogin(context.Background(), LoginFinishRequest{Credential: credential})
+				return err
+			},
+			userHandle: true,
+		},
+		{
+			name: "recovery",
+			challenge: func(t *testing.T, passkeyService *PasskeyService) string {
+				return startTestLogin(t, passkeyService, LoginStartRequest{})
+			},
+			finish: func(passkeyService *PasskeyService, credential *AssertionResponse) error {
+				_, err := passkeyService.FinishRecovery(context.Background(), RecoveryFinishRequest{
+					Credential: credential,
+					NewEmail:   "found@example.com",
+				})
+				return err
+			},
+			userHandle: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+			passkeyService.recoverer = &mockAccountRecoverer{}
+			user := createTestUser(t, userRepo, "test@example.com")
+			auth := addTestPasskey(t, passkeyRepo, user)
+			userID := ""
+			if tt.userHandle {
+				userID = user.ID
+			}
+
+			// A tampered signature is rejected and spends the challenge
+			challenge := tt.challenge(t, passkeyService)
+			if err := tt.finish(passkeyService, assertionResponse(tamper(t, auth.Assert(challenge, userID)))); !errors.Is(err, ErrInvalidCredential) {
+				t.Errorf("expected ErrInvalidCredential for a tampered signature, got %v", err)
+			}
+			if err := tt.finish(passkeyService, assertionResponse(auth.Assert(challenge, userID))); !errors.Is(err, ErrInvalidChallenge) {
+				t.Errorf("expected ErrInvalidChallenge after a tampered attempt, got %v", err)
+			}
+
+			// A valid assertion can't be replayed
+			challenge = tt.challenge(t, passkeyService)
+			valid := assertionResponse(auth.Assert(challenge, userID))
+			if err := tt.finish(passkeyService, valid); err != nil {
+				t.Fatalf("expected a valid assertion to succeed, got %v", err)
+			}
+			if err := tt.finish(passkeyService, valid); !errors.Is(err, ErrInvalidChallenge) {
+				t.Errorf("expected ErrInvalidChallenge for a replayed assertion, got %v", err)
+			}
+
+			// Nor can the challenge be answered again with a fresh signature
+			if err := tt.finish(passkeyService, assertionResponse(auth.Assert(challenge, userID))); !errors.Is(err, ErrInvalidChallenge) {
+				t.Errorf("expected ErrInvalidChallenge for a reused challenge, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPasskeyService_Conditional_RequiresUserHandle(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+	ctx := context.Background()
+	user := createTestUser(t, userRepo, "test@example.com")
+	auth := addTestPasskey(t, passkeyRepo, user)
+
+	challenge := startTestLogin(t, passkeyService, LoginStartRequest{Conditional: true})
+	_, err := passkeyService.FinishLogin(ctx, LoginFinishRequest{
+		Credential: assertionResponse(auth.Assert(challenge, "")),
+	})
+	if !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("expected ErrInvalidCredential without a user handle, got %v", err)
+	}
+}
+
+func TestPasskeyService_CrossDevice_RejectsTamperedAndReplayedAssertions(t *testing.T) {
+	passkeyService, userRepo, passkeyRepo, _ := setupPasskeyService(t)
+	ctx := context.Background()
+	user := createTestUser(t, userRepo, "test@example.com")
+	phone := addTestPasskey(t, passkeyRepo, user)
+
+	start := func() (string, string) {
+		started, err := passkeyService.StartCrossDevice(ctx)
+		if err != nil {
+			t.Fatalf("StartCrossDevice failed: %v", err)
+		}
+		options, err := passkeyService.CrossDeviceOptions(ctx, started.ID)
+		if err != nil {
+			t.Fatalf("CrossDeviceOptions failed: %v", err)
+		}
+		return started.ID, options.Challenge
+	}
+
+	// A tampered signature is rejected and spends the login's challenge
+	id, challenge := start()
+	err := passkeyService.ApproveCrossDevice(ctx, id, assertionResponse(tamper(t, phone.Assert(challenge, user.ID))))
+	if !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("expected ErrInvalidCredential for a tampered signature, got %v", err)
+	}
+	if err := passkeyService.ApproveCrossDevice(ctx, id, assertionResponse(phone.Assert(challenge, user.ID))); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("expected ErrInvalidChallenge after a tampered attempt, got %v", err)
+	}
+	if _, err := passkeyService.CrossDeviceOptions(ctx, id); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("expected ErrInvalidChallenge for a spent login's options, got %v", err)
+	}
+
+	// An assertion approving one login can't approve another
+	id, challenge = start()
+	valid := assertionResponse(phone.Assert(challenge, user.ID))
+	if err := passkeyService.ApproveCrossDevice(ctx, id, valid); err != nil {
+		t.Fatalf("ApproveCrossDevice failed: %v", err)
+	}
+	other, _ := start()
+	if err := passkeyService.ApproveCrossDevice(ctx, other, valid); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("expected ErrInvalidChallenge for another login's assertion, got %v", err)
+	}
+
+	// A cross-device challenge isn't a StartLogin challenge
+	_, challenge = start()
+	_, err = passkeyService.FinishLogin(ctx, LoginFinishRequest{Credential: assertionResponse(phone.Assert(challenge, user.ID))})
+	if !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("expected ErrInvalidChallenge for a cross-device challenge used to log in, got %v", err)
+	}
+}
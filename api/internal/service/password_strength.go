@@ -0,0 +1,414 @@
+package service
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// Password strength estimation in the style of zxcvbn: the password is split
+// into the cheapest sequence of guessable patterns (common passwords and
+// words, the user's own details, repeats, sequences, keyboard rows and
+// years), anything left over is brute forced, and the resulting guess count
+// sets the score. The dictionary is deliberately small; the breach check
+// catches the long tail.
+
+// commonPasswords are frequent passwords and words, most common first. The
+// rank is the number of guesses an attacker needs to reach an entry.
+var commonPasswords = []string{
+	"password", "123456", "qwerty", "letmein", "welcome", "monkey", "dragon",
+	"football", "baseball", "iloveyou", "admin", "login", "sunshine", "master",
+	"shadow", "princess", "abc123", "trustno1", "superman", "batman", "hello",
+	"freedom", "whatever", "starwars", "secret", "summer", "winter", "spring",
+	"autumn", "love", "pass", "test", "guest", "root", "changeme", "default",
+	"michael", "jennifer", "jordan", "hunter", "ranger", "buster", "soccer",
+	"hockey", "killer", "george", "charlie", "andrew", "thomas", "robert",
+	"daniel", "jessica", "ashley", "pepper", "ginger", "cookie", "cheese",
+	"flower", "orange", "banana", "purple", "silver", "golden", "diamond",
+	"computer", "internet", "samsung", "google", "apple", "mustang", "corvette",
+	"chelsea", "liverpool", "arsenal", "yankees", "cowboys", "lakers", "angel",
+	"blessed", "family", "forever", "friend", "happy", "lucky", "music",
+	"money", "nothing", "pokemon", "naruto", "matrix", "ninja", "tigger",
+	"saga", "guild", "qwertyuiop", "asdfgh", "zxcvbn", "passw0rd", "welcome1",
+}
+
+// keyboardRows are runs of adjacent keys on a US layout
+var keyboardRows = []string{
+	"`1234567890-=",
+	"qwertyuiop[]\\",
+	"asdfghjkl;'",
+	"zxcvbnm,./",
+	"~!@#$%^&*()_+",
+}
+
+// leetSubstitutions map common character swaps back to letters
+var leetSubstitutions = map[rune]rune{
+	'4': 'a', '@': 'a', '8': 'b', '3': 'e', '6': 'g', '1': 'i', '!': 'i',
+	'0': 'o', '5': 's', '$': 's', '7': 't', '+': 't', '2': 'z',
+}
+
+type passwordPattern string
+
+const (
+	patternDictionary passwordPattern = "dictionary"
+	patternUserInput  passwordPattern = "user_input"
+	patternRepeat     passwordPattern = "repeat"
+	patternSequence   passwordPattern = "sequence"
+	patternKeyboard   passwordPattern = "keyboard"
+	patternYear       passwordPattern = "year"
+)
+
+// passwordMatch is a guessable pattern covering password[start:end]
+type passwordMatch struct {
+	pattern passwordPattern
+	start   int
+	end     int
+	guesses float64
+	rank    int  // Dictionary rank
+	leet    bool // Matched after undoing substitutions
+	capital bool // Capitalized in a predictable way
+}
+
+const (
+	bruteforceCardinality = 10 // Guesses per unmatched character, as zxcvbn counts them
+	minMatchGuesses       = 10 // No pattern is cheaper than this
+	minYear               = 1900
+	maxYear               = 2049
+)
+
+// estimatePasswordStrength scores a password 0-4 with feedback. userInputs
+// are the user's own details (email, names) that attackers try first.
+func estimatePasswordStrength(password string, userInputs ...string) *model.PasswordStrength {
+	runes := []rune(password)
+	matches := findPasswordMatches(runes, userInputs)
+
+	// best[k] is the fewest guesses for the first k runes; via[k] is the
+	// match ending at k on that path, or nil for a brute-forced rune
+	n := len(runes)
+	best := make([]float64, n+1)
+	via := make([]*passwordMatch, n+1)
+	best[0] = 1
+	for k := 1; k <= n; k++ {
+		best[k] = best[k-1] * bruteforceCardinality
+		for i := range matches {
+			m := &matches[i]
+			if m.end != k {
+				continue
+			}
+			if g := best[m.start] * math.Max(m.guesses, minMatchGuesses); g < best[k] {
+				best[k] = g
+				via[k] = m
+			}
+		}
+	}
+
+	var used []*passwordMatch
+	for k := n; k > 0; {
+		if m := via[k]; m != nil {
+			used = append(used, m)
+			k = m.start
+		} else {
+			k--
+		}
+	}
+
+	strength := &model.PasswordStrength{Score: passwordScore(best[n])}
+	if strength.Score <= model.PasswordScoreSomewhatGuessable {
+		strength.Warning, strength.Suggestions = passwordFeedback(used, len(runes))
+	}
+	return strength
+}
+
+// passwordScore buckets a guess count into a 0-4 score
+func passwordScore(guesses float64) int {
+	switch {
+	case guesses < 1e3:
+		return model.PasswordScoreTooGuessable
+	case guesses < 1e6:
+		return model.PasswordScoreVeryGuessable
+	case guesses < 1e8:
+		return model.PasswordScoreSomewhatGuessable
+	case guesses < 1e10:
+		return model.PasswordScoreSafelyUnguessable
+	default:
+		return model.PasswordScoreVeryUnguessable
+	}
+}
+
+// findPasswordMatches lists every guessable pattern in the password
+func findPasswordMatches(runes []rune, userInputs []string) []passwordMatch {
+	lower := []rune(strings.ToLower(string(runes)))
+	unleet := make([]rune, len(lower))
+	for i, r := range lower {
+		if sub, ok := leetSubstitutions[r]; ok {
+			unleet[i] = sub
+		} else {
+			unleet[i] = r
+		}
+	}
+
+	var matches []passwordMatch
+	for rank, word := range commonPasswords {
+		matches = append(matches, dictionaryMatches(runes, lower, unleet, word, rank+1, patternDictionary)...)
+	}
+	for _, word := range userInputTokens(userInputs) {
+		matches = append(matches, dictionaryMatches(runes, lower, unleet, word, 1, patternUserInput)...)
+	}
+	matches = append(matches, repeatMatches(lower)...)
+	matches = append(matches, sequenceMatches(lower)...)
+	matches = append(matches, keyboardMatches(lower)...)
+	matches = append(matches, yearMatches(runes)...)
+	return matches
+}
+
+// dictionaryMatches finds word in the password as typed or with leet
+// substitutions undone
+func dictionaryMatches(runes, lower, unleet []rune, word string, rank int, pattern passwordPattern) []passwordMatch {
+	w := []rune(word)
+	var matches []passwordMatch
+	for i := 0; i+len(w) <= len(lower); i++ {
+		plain := string(lower[i:i+len(w)]) == word
+		if !plain && string(unleet[i:i+len(w)]) != word {
+			continue
+		}
+		m := passwordMatch{
+			pattern: pattern,
+			start:   i,
+			end:     i + len(w),
+			rank:    rank,
+			leet:    !plain,
+		}
+		m.guesses = float64(rank) * uppercaseVariations(runes[m.start:m.end])
+		if m.leet {
+			m.guesses *= 2
+		}
+		m.capital = m.guesses > float64(rank) && !m.leet
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// uppercaseVariations is how many capitalizations an attacker tries to reach
+// this one: all lowercase is free, a capital first letter or all caps is the
+// next guess, anything else depends on how many letters are capitalized
+func uppercaseVariations(word []rune) float64 {
+	upper, lower := 0, 0
+	for _, r := range word {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+	switch {
+	case upper == 0:
+		return 1
+	case lower == 0 || (upper == 1 && unicode.IsUpper(word[0])):
+		return 2
+	default:
+		return math.Pow(2, float64(min(upper, lower)+1))
+	}
+}
+
+// userInputTokens splits the user's details into the words attackers try,
+// such as the parts of an email address
+func userInputTokens(inputs []string) []string {
+	var tokens []string
+	for _, input := range inputs {
+		for _, token := range strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if len([]rune(token)) >= 3 {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	return tokens
+}
+
+// repeatMatches finds runs of the same character, like "aaa"
+func repeatMatches(lower []rune) []passwordMatch {
+	var matches []passwordMatch
+	for i := 0; i < len(lower); {
+		j := i + 1
+		for j < len(lower) && lower[j] == lower[i] {
+			j++
+		}
+		if j-i >= 3 {
+			matches = append(matches, passwordMatch{
+				pattern: patternRepeat,
+				start:   i,
+				end:     j,
+				guesses: charsetSize(lower[i]) * float64(j-i),
+			})
+		}
+		i = j
+	}
+	return matches
+}
+
+// sequenceMatches finds runs of consecutive characters, like "abc" or "987"
+func sequenceMatches(lower []rune) []passwordMatch {
+	var matches []passwordMatch
+	for i := 0; i < len(lower)-2; {
+		delta := lower[i+1] - lower[i]
+		if delta != 1 && delta != -1 {
+			i++
+			continue
+		}
+		j := i + 2
+		for j < len(lower) && lower[j]-lower[j-1] == delta {
+			j++
+		}
+		if j-i >= 3 {
+			// Obvious starting points are tried first
+			start := 26.0
+			switch lower[i] {
+			case 'a', 'z', '0', '1', '9':
+				start = 4
+			}
+			if unicode.IsDigit(lower[i]) {
+				start = math.Min(start, 10)
+			}
+			guesses := start * float64(j-i)
+			if delta < 0 {
+				guesses *= 2
+			}
+			matches = append(matches, passwordMatch{pattern: patternSequence, start: i, end: j, guesses: guesses})
+		}
+		i = j - 1
+	}
+	return matches
+}
+
+// keyboardMatches finds runs of four or more adjacent keys on one row, typed
+// in either direction
+func keyboardMatches(lower []rune) []passwordMatch {
+	var matches []passwordMatch
+	for _, row := range keyboardRows {
+		for _, keys := range []string{row, reverseString(row)} {
+			for i := 0; i < len(lower); i++ {
+				pos := strings.IndexRune(keys, lower[i])
+				if pos < 0 {
+					continue
+				}
+				j := i + 1
+				for j < len(lower) && pos+(j-i) < len(keys) && rune(keys[pos+(j-i)]) == lower[j] {
+					j++
+				}
+				if j-i >= 4 {
+					matches = append(matches, passwordMatch{
+						pattern: patternKeyboard,
+						start:   i,
+						end:     j,
+						guesses: float64(len(row)) * float64(j-i) * 2,
+					})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// yearMatches finds four-digit years people commonly pick
+func yearMatches(runes []rune) []passwordMatch {
+	var matches []passwordMatch
+	for i := 0; i+4 <= len(runes); i++ {
+		year := 0
+		digits := true
+		for _, r := range runes[i : i+4] {
+			if r < '0' || r > '9' {
+				digits = false
+				break
+			}
+			year = year*10 + int(r-'0')
+		}
+		if digits && year >= minYear && year <= maxYear {
+			matches = append(matches, passwordMatch{
+				pattern: patternYear,
+				start:   i,
+				end:     i + 4,
+				guesses: maxYear - minYear + 1,
+			})
+		}
+	}
+	return matches
+}
+
+// passwordFeedback explains the weakest part of a guessable password and
+// how to do better
+func passwordFeedback(used []*passwordMatch, length int) (string, []string) {
+	if len(used) == 0 {
+		if length < 12 {
+			return "", []string{"Use a few words, avoid common phrases.", "Longer passwords are harder to guess."}
+		}
+		return "", []string{"Use a few words, avoid common phrases."}
+	}
+
+	// The longest pattern is the one most worth changing
+	longest := used[0]
+	for _, m := range used[1:] {
+		if m.end-m.start > longest.end-longest.start {
+			longest = m
+		}
+	}
+
+	suggestions := []string{"Add another word or two. Uncommon words are better."}
+	var warning string
+	switch longest.pattern {
+	case patternDictionary:
+		switch {
+		case longest.rank <= 10 && longest.start == 0 && longest.end == length:
+			warning = "This is a top-10 common password."
+		case longest.start == 0 && longest.end == length:
+			warning = "This is a very common password."
+		default:
+			warning = "Common words and passwords are easy to guess."
+		}
+		if longest.capital {
+			suggestions = append(suggestions, "Capitalization doesn't help very much.")
+		}
+		if longest.leet {
+			suggestions = append(suggestions, "Predictable substitutions like '@' instead of 'a' don't help very much.")
+		}
+	case patternUserInput:
+		warning = "Passwords based on your name or email are easy to guess."
+		suggestions = append(suggestions, "Avoid using your name or email in your password.")
+	case patternRepeat:
+		warning = `Repeats like "aaa" are easy to guess.`
+		suggestions = append(suggestions, "Avoid repeated words and characters.")
+	case patternSequence:
+		warning = "Sequences like abc or 6543 are easy to guess."
+		suggestions = append(suggestions, "Avoid sequences.")
+	case patternKeyboard:
+		warning = "Straight rows of keys are easy to guess."
+		suggestions = append(suggestions, "Use a longer keyboard pattern with more turns.")
+	case patternYear:
+		warning = "Recent years are easy to guess."
+		suggestions = append(suggestions, "Avoid years that are associated with you.")
+	}
+	return warning, suggestions
+}
+
+// charsetSize is the alphabet an attacker assumes for a character
+func charsetSize(r rune) float64 {
+	switch {
+	case unicode.IsDigit(r):
+		return 10
+	case unicode.IsLetter(r):
+		return 26
+	default:
+		return 33
+	}
+}
+
+func reverseString(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+func TestEstimatePasswordStrength(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		password    string
+		userInputs  []string
+		maxScore    int
+		minScore    int
+		wantWarning bool
+	}{
+		{"top common password", "password", nil, 0, 0, true},
+		{"common password with digits", "password123", nil, 1, 0, true},
+		{"leet substitutions", "P@ssw0rd", nil, 0, 0, true},
+		{"digit sequence", "12345678", nil, 0, 0, true},
+		{"repeated characters", "aaaaaaaaaa", nil, 0, 0, true},
+		{"keyboard row", "zxcvbnm1", nil, 1, 0, true},
+		{"name and year", "alice1990", []string{"alice@example.com"}, 1, 0, true},
+		{"random characters", "kX9#mQ2!vL7p", nil, 4, model.PasswordScoreSafelyUnguessable, false},
+		{"passphrase", "correct horse battery staple", nil, 4, model.PasswordScoreVeryUnguessable, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := estimatePasswordStrength(tt.password, tt.userInputs...)
+			if got.Score < tt.minScore || got.Score > tt.maxScore {
+				t.Errorf("expected score %d-%d, got %d", tt.minScore, tt.maxScore, got.Score)
+			}
+			if (got.Warning != "") != tt.wantWarning {
+				t.Errorf("expected warning %v, got %q", tt.wantWarning, got.Warning)
+			}
+			if tt.wantWarning && len(got.Suggestions) == 0 {
+				t.Error("expected suggestions for a guessable password")
+			}
+		})
+	}
+}
+
+func TestEstimatePasswordStrength_UserInputs(t *testing.T) {
+	t.Parallel()
+
+	without := estimatePasswordStrength("marguerite42")
+	with := estimatePasswordStrength("marguerite42", "marguerite@example.com")
+	if with.Score >= without.Score {
+		t.Errorf("expected the user's own name to lower the score, got %d and %d", with.Score, without.Score)
+	}
+	if with.Warning != "Passwords based on your name or email are easy to guess." {
+		t.Errorf("unexpected warning %q", with.Warning)
+	}
+}
@@ -0,0 +1,557 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/payments"
+)
+
+// PaymentProvider takes payments for paid events' tickets and pays them
+// out to organizers. pkg/payments implements it against Stripe.
+type PaymentProvider interface {
+	CreateCheckoutSession(ctx context.Context, p payments.CheckoutParams) (*payments.CheckoutSession, error)
+	Refund(ctx context.Context, p payments.RefundParams) (*payments.Refund, error)
+	CreateAccount(ctx context.Context, metadata map[string]string, idempotencyKey string) (*payments.Account, error)
+	GetAccount(ctx context.Context, accountID string) (*payments.Account, error)
+	CreateAccountLink(ctx context.Context, accountID, refreshURL, returnURL string) (*payments.AccountLink, error)
+	ParseWebhook(payload []byte, signature string, now time.Time) (*payments.WebhookEvent, error)
+}
+
+// PaymentRepository defines the interface for payment and payout account
+// storage
+type PaymentRepository interface {
+	CreatePayment(ctx context.Context, payment *model.EventPayment) error
+	GetPayment(ctx context.Context, paymentID string) (*model.EventPayment, error)
+	SetCheckoutSession(ctx context.Context, paymentID, sessionID string) error
+	MarkPaymentPaid(ctx context.Context, paymentID, paymentIntentID string) (*model.EventPayment, error)
+	MarkPaymentExpired(ctx context.Context, paymentID string) (*model.EventPayment, error)
+	MarkPaymentRefunded(ctx context.Context, paymentID, refundID string) (*model.EventPayment, error)
+	GetPaidPayment(ctx context.Context, eventID, userID string) (*model.EventPayment, error)
+	GetPaidPaymentsByEvent(ctx context.Context, eventID string) ([]*model.EventPayment, error)
+	GetSettledPaymentsByEvent(ctx context.Context, eventID string) ([]*model.EventPayment, error)
+	GetPayoutAccount(ctx context.Context, userID string) (*model.PayoutAccount, error)
+	CreatePayoutAccount(ctx context.Context, account *model.PayoutAccount) error
+	UpdatePayoutAccount(ctx context.Context, accountID string, chargesEnabled, payoutsEnabled, detailsSubmitted bool) (*model.PayoutAccount, error)
+	CreatePromoCode(ctx context.Context, promo *model.PromoCode) error
+	GetPromoCode(ctx context.Context, promoID string) (*model.PromoCode, error)
+	GetPromoCodeByCode(ctx context.Context, eventID, code string) (*model.PromoCode, error)
+	ListPromoCodes(ctx context.Context, eventID string) ([]*model.PromoCode, error)
+	CountPromoCodes(ctx context.Context, eventID string) (int, error)
+	UpdatePromoCode(ctx context.Context, promoID string, updates map[string]interface{}) (*model.PromoCode, error)
+	DeletePromoCode(ctx context.Context, promoID string) (bool, error)
+	ReservePromoCode(ctx context.Context, promoID string, now time.Time) (*model.PromoCode, error)
+	ReleasePromoCode(ctx context.Context, promoID string) error
+	SetMemberDiscounts(ctx context.Context, eventID string, discounts []model.MemberDiscount) error
+}
+
+// PaymentEventRepository is the part of the event repository payments
+// use: the guest's RSVP and the ticket they pay for
+type PaymentEventRepository interface {
+	Get(ctx context.Context, eventID string) (*model.Event, error)
+	IsHost(ctx context.Context, eventID, userID string) (bool, error)
+	GetRSVP(ctx context.Context, eventID, userID string) (*model.EventRSVP, error)
+	UpdateRSVP(ctx context.Context, rsvpID string, updates map[string]interface{}) (*model.EventRSVP, error)
+	GetActiveTicket(ctx context.Context, eventID, userID string) (*model.EventTicket, error)
+	ExtendTicketHold(ctx context.Context, ticketID string, until time.Time) (*model.EventTicket, error)
+	ClaimTicket(ctx context.Context, ticketID string) (*model.EventTicket, error)
+	VoidTicket(ctx context.Context, ticketID string) error
+}
+
+// PaymentGuildRepository looks up the guild role member discounts are
+// given by
+type PaymentGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	GetMemberRole(ctx context.Context, userID, guildID string) (model.GuildRole, error)
+}
+
+const (
+	// checkoutDuration is how long a guest has to pay; Stripe's minimum
+	checkoutDuration = 30 * time.Minute
+	// checkoutHoldGrace keeps the ticket held past the checkout's expiry,
+	// so a payment made at the last moment still finds its seat when the
+	// webhook arrives
+	checkoutHoldGrace = 15 * time.Minute
+)
+
+// PaymentService takes payment for paid events' tickets through Stripe
+// Checkout. A guest of a paid event holds a ticket, RSVPs, and pays; the
+// checkout's webhook claims the ticket and approves the RSVP. Money goes to
+// the event creator's payout account, and comes back to guests under the
+// event's refund policy. Promo codes and member discounts come off the
+// price at checkout.
+type PaymentService struct {
+	provider  PaymentProvider
+	payments  PaymentRepository
+	events    PaymentEventRepository
+	guilds    PaymentGuildRepository
+	returnURL string
+	clock     clock.Clock
+}
+
+// PaymentServiceConfig holds configuration for the payment service
+type PaymentServiceConfig struct {
+	Provider  PaymentProvider // Paid events are disabled when nil
+	Payments  PaymentRepository
+	Events    PaymentEventRepository
+	Guilds    PaymentGuildRepository
+	ReturnURL string      // Site guests and organizers come back to from Stripe's pages
+	Clock     clock.Clock // Default: the system clock
+}
+
+// NewPaymentService creates a new payment service
+func NewPaymentService(cfg PaymentServiceConfig) *PaymentService {
+	return &PaymentService{
+		provider:  cfg.Provider,
+		payments:  cfg.Payments,
+		events:    cfg.Events,
+		guilds:    cfg.Guilds,
+		returnURL: cfg.ReturnURL,
+		clock:     clock.OrReal(cfg.Clock),
+	}
+}
+
+// Enabled reports whether a payment provider is configured
+func (s *PaymentService) Enabled() bool {
+	return s.provider != nil
+}
+
+// ============================================================================
+// Checkout
+// ============================================================================
+
+// Checkout starts payment for the user's held ticket to a paid event. The
+// user must have RSVPed; the hold is extended while they pay. The better of
+// the promo code, if given, and the user's member discount comes off the
+// price; a ticket discounted to nothing is settled without a checkout.
+func (s *PaymentService) Checkout(ctx context.Context, userID, eventID string, req *model.CheckoutRequest) (*model.CheckoutSession, error) {
+	if !s.Enabled() {
+		return nil, ErrPaymentsDisabled
+	}
+
+	event, err := s.events.Get(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+	if !event.IsPaid() {
+		return nil, ErrEventNotPaid
+	}
+
+	rsvp, err := s.events.GetRSVP(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !paymentDue(rsvp) {
+		return nil, ErrPaymentNotDue
+	}
+
+	ticket, err := s.events.GetActiveTicket(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil {
+		return nil, ErrTicketHoldRequired
+	}
+	if ticket.Status != model.TicketStatusHeld {
+		return nil, ErrPaymentNotDue
+	}
+
+	account, err := s.readyPayoutAccount(ctx, event.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, promo, err := s.discountedPayment(ctx, event, userID, req.PromoCode)
+	if err != nil {
+		return nil, err
+	}
+	payment.TicketID = ticket.ID
+
+	expires := s.clock.Now().Add(checkoutDuration)
+	extended, err := s.events.ExtendTicketHold(ctx, ticket.ID, expires.Add(checkoutHoldGrace))
+	if err != nil {
+		return nil, err
+	}
+	if extended == nil {
+		return nil, ErrTicketHoldRequired
+	}
+
+	if promo != nil {
+		reserved, err := s.payments.ReservePromoCode(ctx, promo.ID, s.clock.Now())
+		if err != nil {
+			return nil, err
+		}
+		if reserved == nil {
+			return nil, ErrPromoCodeInvalid
+		}
+	}
+	if err := s.payments.CreatePayment(ctx, payment); err != nil {
+		if promo != nil {
+			_ = s.payments.ReleasePromoCode(ctx, promo.ID)
+		}
+		return nil, err
+	}
+
+	checkout := &model.CheckoutSession{
+		PaymentID:      payment.ID,
+		Amount:         payment.Amount,
+		Discount:       payment.Discount,
+		DiscountSource: payment.DiscountSource,
+		ExpiresOn:      expires,
+	}
+	if payment.Amount == 0 {
+		if err := s.settlePayment(ctx, payment.ID, ""); err != nil {
+			return nil, err
+		}
+		checkout.URL = s.siteURL("/events/"+eventID, "checkout", "paid")
+		checkout.ExpiresOn = s.clock.Now()
+		return checkout, nil
+	}
+
+	session, err := s.provider.CreateCheckoutSession(ctx, payments.CheckoutParams{
+		Amount:            int64(payment.Amount),
+		Currency:          event.Currency,
+		Name:              event.Title,
+		SuccessURL:        s.siteURL("/events/"+eventID, "checkout", "paid"),
+		CancelURL:         s.siteURL("/events/"+eventID, "checkout", "cancelled"),
+		ClientReferenceID: payment.ID,
+		Destination:       account.AccountID,
+		ExpiresAt:         expires,
+		Metadata: map[string]string{
+			"payment_id": payment.ID,
+			"event_id":   eventID,
+			"user_id":    userID,
+		},
+		IdempotencyKey: payment.ID,
+	})
+	if err != nil {
+		_ = s.expirePayment(ctx, payment.ID)
+		return nil, err
+	}
+	if err := s.payments.SetCheckoutSession(ctx, payment.ID, session.ID); err != nil {
+		return nil, err
+	}
+
+	checkout.URL = session.URL
+	return checkout, nil
+}
+
+// paymentDue reports whether an RSVP is waiting on payment
+func paymentDue(rsvp *model.EventRSVP) bool {
+	if rsvp == nil || rsvp.PaymentStatus == nil || *rsvp.PaymentStatus != model.PaymentStatusPending {
+		return false
+	}
+	return rsvp.Status != model.RSVPStatusCancelled && rsvp.Status != model.RSVPStatusDeclined
+}
+
+// ============================================================================
+// Webhooks
+// ============================================================================
+
+// HandleWebhook verifies and applies a Stripe webhook. Each event is safe
+// to apply more than once, since Stripe retries deliveries.
+func (s *PaymentService) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	if !s.Enabled() {
+		return ErrPaymentsDisabled
+	}
+
+	event, err := s.provider.ParseWebhook(payload, signature, s.clock.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+	}
+
+	switch event.Type {
+	case payments.EventCheckoutCompleted, payments.EventCheckoutAsyncPaymentSucceeded:
+		session, err := event.CheckoutSession()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+		}
+		// Delayed payment methods complete checkout unpaid and succeed later
+		if session.PaymentStatus != "paid" || session.ClientReferenceID == "" {
+			return nil
+		}
+		return s.settlePayment(ctx, session.ClientReferenceID, session.PaymentIntent)
+
+	case payments.EventCheckoutExpired, payments.EventCheckoutAsyncPaymentFailed:
+		session, err := event.CheckoutSession()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+		}
+		if session.ClientReferenceID == "" {
+			return nil
+		}
+		// The ticket hold lapses on its own; the guest can check out again
+		return s.expirePayment(ctx, session.ClientReferenceID)
+
+	case payments.EventAccountUpdated:
+		account, err := event.Account()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+		}
+		_, err = s.payments.UpdatePayoutAccount(ctx, account.ID, account.ChargesEnabled, account.PayoutsEnabled, account.DetailsSubmitted)
+		return err
+	}
+
+	return nil
+}
+
+// settlePayment marks a payment paid, claims its ticket, and approves the
+// RSVP if payment was all it waited on. If the seat was lost while the
+// guest paid, the payment is refunded.
+func (s *PaymentService) settlePayment(ctx context.Context, paymentID, paymentIntentID string) error {
+	payment, err := s.payments.MarkPaymentPaid(ctx, paymentID, paymentIntentID)
+	if err != nil {
+		return err
+	}
+	if payment == nil {
+		return nil // Already applied
+	}
+
+	claimed, err := s.events.ClaimTicket(ctx, payment.TicketID)
+	if err != nil {
+		return err
+	}
+	rsvp, err := s.events.GetRSVP(ctx, payment.EventID, payment.UserID)
+	if err != nil {
+		return err
+	}
+
+	// The hold lapsed or the guest cancelled while paying
+	if claimed == nil || rsvp == nil {
+		if claimed != nil {
+			if err := s.events.VoidTicket(ctx, claimed.ID); err != nil {
+				return err
+			}
+		}
+		return s.refund(ctx, payment, rsvp)
+	}
+
+	updates := map[string]interface{}{
+		"payment_status": model.PaymentStatusPaid,
+	}
+	if rsvp.WaitingReason != nil && *rsvp.WaitingReason == model.WaitingReasonPayment {
+		updates["status"] = model.RSVPStatusApproved
+		updates["waiting_reason"] = nil
+	}
+	_, err = s.events.UpdateRSVP(ctx, rsvp.ID, updates)
+	return err
+}
+
+// expirePayment marks a pending payment expired and gives back the promo
+// code redemption it reserved
+func (s *PaymentService) expirePayment(ctx context.Context, paymentID string) error {
+	payment, err := s.payments.MarkPaymentExpired(ctx, paymentID)
+	if err != nil || payment == nil || payment.PromoCodeID == nil {
+		return err
+	}
+	return s.payments.ReleasePromoCode(ctx, *payment.PromoCodeID)
+}
+
+// ============================================================================
+// Refunds
+// ============================================================================
+
+// RefundRSVP refunds a guest's payment for an event: under the event's
+// refund policy when the guest cancels, or always when full is set, as
+// when a host declines them. Guests who haven't paid are left alone.
+func (s *PaymentService) RefundRSVP(ctx context.Context, event *model.Event, userID string, full bool) error {
+	if !s.Enabled() {
+		return nil
+	}
+	if !full && !event.RefundableAt(s.clock.Now()) {
+		return nil
+	}
+
+	payment, err := s.payments.GetPaidPayment(ctx, event.ID, userID)
+	if err != nil || payment == nil {
+		return err
+	}
+	rsvp, err := s.events.GetRSVP(ctx, event.ID, userID)
+	if err != nil {
+		return err
+	}
+	return s.refund(ctx, payment, rsvp)
+}
+
+// RefundEvent refunds every guest of a cancelled event. A failed refund
+// doesn't stop the others.
+func (s *PaymentService) RefundEvent(ctx context.Context, event *model.Event) error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	paid, err := s.payments.GetPaidPaymentsByEvent(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, payment := range paid {
+		rsvp, err := s.events.GetRSVP(ctx, event.ID, payment.UserID)
+		if err == nil {
+			err = s.refund(ctx, payment, rsvp)
+		}
+		if err != nil {
+			log.Printf("refunding payment %s for cancelled event %s: %v", payment.ID, event.ID, err)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// refund returns a paid payment in full and marks the guest's RSVP, if
+// any, refunded. The idempotency key keeps a retried refund from paying
+// out twice. Free tickets only need marking.
+func (s *PaymentService) refund(ctx context.Context, payment *model.EventPayment, rsvp *model.EventRSVP) error {
+	var refundID string
+	if payment.Amount > 0 {
+		if payment.PaymentIntentID == nil {
+			return nil
+		}
+		refund, err := s.provider.Refund(ctx, payments.RefundParams{
+			PaymentIntent:  *payment.PaymentIntentID,
+			IdempotencyKey: "refund:" + payment.ID,
+		})
+		if err != nil {
+			return err
+		}
+		refundID = refund.ID
+	}
+	if _, err := s.payments.MarkPaymentRefunded(ctx, payment.ID, refundID); err != nil {
+		return err
+	}
+
+	if rsvp == nil {
+		return nil
+	}
+	_, err := s.events.UpdateRSVP(ctx, rsvp.ID, map[string]interface{}{
+		"payment_status": model.PaymentStatusRefunded,
+	})
+	return err
+}
+
+// ============================================================================
+// Payout Accounts
+// ============================================================================
+
+// GetPayoutAccount returns the user's payout account
+func (s *PaymentService) GetPayoutAccount(ctx context.Context, userID string) (*model.PayoutAccount, error) {
+	if !s.Enabled() {
+		return nil, ErrPaymentsDisabled
+	}
+
+	account, err := s.payments.GetPayoutAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, ErrPayoutAccountNotFound
+	}
+	return s.syncPayoutAccount(ctx, account)
+}
+
+// LinkPayoutAccount returns a Stripe page where the user sets up, or
+// finishes setting up, the account their ticket sales are paid out to
+func (s *PaymentService) LinkPayoutAccount(ctx context.Context, userID string) (*model.PayoutAccountLink, error) {
+	if !s.Enabled() {
+		return nil, ErrPaymentsDisabled
+	}
+
+	account, err := s.payments.GetPayoutAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		created, err := s.provider.CreateAccount(ctx, map[string]string{"user_id": userID}, "payout-account:"+userID)
+		if err != nil {
+			return nil, err
+		}
+		account = &model.PayoutAccount{
+			UserID:           userID,
+			AccountID:        created.ID,
+			ChargesEnabled:   created.ChargesEnabled,
+			PayoutsEnabled:   created.PayoutsEnabled,
+			DetailsSubmitted: created.DetailsSubmitted,
+		}
+		if err := s.payments.CreatePayoutAccount(ctx, account); err != nil {
+			return nil, err
+		}
+	}
+
+	link, err := s.provider.CreateAccountLink(ctx, account.AccountID,
+		s.siteURL("/profile/payouts", "setup", "refresh"),
+		s.siteURL("/profile/payouts", "setup", "done"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model.PayoutAccountLink{
+		URL:       link.URL,
+		ExpiresOn: time.Unix(link.ExpiresAt, 0),
+	}, nil
+}
+
+// CheckPayoutReady returns an error unless the user can sell tickets
+func (s *PaymentService) CheckPayoutReady(ctx context.Context, userID string) error {
+	_, err := s.readyPayoutAccount(ctx, userID)
+	return err
+}
+
+// readyPayoutAccount returns the user's payout account if it can accept
+// payments
+func (s *PaymentService) readyPayoutAccount(ctx context.Context, userID string) (*model.PayoutAccount, error) {
+	if !s.Enabled() {
+		return nil, ErrPaymentsDisabled
+	}
+
+	account, err := s.payments.GetPayoutAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, ErrPayoutAccountNotReady
+	}
+	account, err = s.syncPayoutAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if !account.ChargesEnabled {
+		return nil, ErrPayoutAccountNotReady
+	}
+	return account, nil
+}
+
+// syncPayoutAccount refreshes an account that can't take payments yet from
+// Stripe, in case its account.updated webhook hasn't arrived
+func (s *PaymentService) syncPayoutAccount(ctx context.Context, account *model.PayoutAccount) (*model.PayoutAccount, error) {
+	if account.ChargesEnabled {
+		return account, nil
+	}
+
+	current, err := s.provider.GetAccount(ctx, account.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.payments.UpdatePayoutAccount(ctx, current.ID, current.ChargesEnabled, current.PayoutsEnabled, current.DetailsSubmitted)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return account, nil
+	}
+	return updated, nil
+}
+
+// siteURL builds a link back to the site with one query parameter
+func (s *PaymentService) siteURL(path, key, value string) string {
+	return s.returnURL + path + "?" + url.Values{key: {value}}.Encode()
+}
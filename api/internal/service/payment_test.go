@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+	"github.com/forgo/saga/api/pkg/payments"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// fakePaymentProvider returns a canned webhook event and records refunds
+type fakePaymentProvider struct {
+	webhook *payments.WebhookEvent
+	refunds []payments.RefundParams
+}
+
+func (f *fakePaymentProvider) CreateCheckoutSession(ctx context.Context, p payments.CheckoutParams) (*payments.CheckoutSession, error) {
+	return &payments.CheckoutSession{ID: "cs_1", URL: "https://checkout.stripe.com/c/pay/cs_1"}, nil
+}
+
+func (f *fakePaymentProvider) Refund(ctx context.Context, p payments.RefundParams) (*payments.Refund, error) {
+	f.refunds = append(f.refunds, p)
+	return &payments.Refund{ID: "re_1", Status: "succeeded"}, nil
+}
+
+func (f *fakePaymentProvider) CreateAccount(ctx context.Context, metadata map[string]string, idempotencyKey string) (*payments.Account, error) {
+	return &payments.Account{ID: "acct_1"}, nil
+}
+
+func (f *fakePaymentProvider) GetAccount(ctx context.Context, accountID string) (*payments.Account, error) {
+	return &payments.Account{ID: accountID}, nil
+}
+
+func (f *fakePaymentProvider) CreateAccountLink(ctx context.Context, accountID, refreshURL, returnURL string) (*payments.AccountLink, error) {
+	return &payments.AccountLink{URL: "https://connect.stripe.com/setup/1"}, nil
+}
+
+func (f *fakePaymentProvider) ParseWebhook(payload []byte, signature string, now time.Time) (*payments.WebhookEvent, error) {
+	if f.webhook == nil {
+		return nil, payments.ErrInvalidSignature
+	}
+	return f.webhook, nil
+}
+
+// checkoutCompleted is a paid checkout.session.completed event for paymentID
+func checkoutCompleted(paymentID string) *payments.WebhookEvent {
+	event := &payments.WebhookEvent{ID: "evt_1", Type: payments.EventCheckoutCompleted}
+	event.Data.Object = json.RawMessage(`{"id":"cs_1","payment_status":"paid","payment_intent":"pi_1","client_reference_id":"` + paymentID + `"}`)
+	return event
+}
+
+func newPaidEvent() *model.Event {
+	event := newTicketedEvent(20)
+	event.CreatedBy = "user:host"
+	event.TicketPrice = 1500
+	event.Currency = "usd"
+	event.RefundPolicy = model.RefundPolicyFull
+	event.StartTime = time.Now().Add(72 * time.Hour)
+	return event
+}
+
+var paymentTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newPaymentService(provider PaymentProvider, repo *mocks.PaymentRepository, events *mocks.PaymentEventRepository) *PaymentService {
+	return NewPaymentService(PaymentServiceConfig{
+		Provider:  provider,
+		Payments:  repo,
+		Events:    events,
+		ReturnURL: "https://saga.example",
+		Clock:     fakeclock.New(paymentTestNow),
+	})
+}
+
+// ============================================================================
+// Webhook Tests
+// ============================================================================
+
+func TestHandleWebhook_CompletesPayment(t *testing.T) {
+	t.Parallel()
+
+	waiting := model.WaitingReasonPayment
+	pending := model.PaymentStatusPending
+	paid := false
+	var claimed []string
+	var updates map[string]interface{}
+
+	repo := &mocks.PaymentRepository{
+		MarkPaymentPaidFunc: func(ctx context.Context, paymentID, paymentIntentID string) (*model.EventPayment, error) {
+			if paid {
+				return nil, nil // A retried delivery
+			}
+			paid = true
+			return &model.EventPayment{ID: paymentID, EventID: "event:1", UserID: "user:a", TicketID: "event_ticket:1", Status: model.PaymentStatusPaid}, nil
+		},
+	}
+	events := &mocks.PaymentEventRepository{
+		ClaimTicketFunc: func(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+			claimed = append(claimed, ticketID)
+			return &model.EventTicket{ID: ticketID, Status: model.TicketStatusClaimed}, nil
+		},
+		GetRSVPFunc: func(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+			return &model.EventRSVP{ID: "event_rsvp:1", Status: model.RSVPStatusPending, WaitingReason: &waiting, PaymentStatus: &pending}, nil
+		},
+		UpdateRSVPFunc: func(ctx context.Context, rsvpID string, u map[string]interface{}) (*model.EventRSVP, error) {
+			updates = u
+			return &model.EventRSVP{ID: rsvpID}, nil
+		},
+	}
+	svc := newPaymentService(&fakePaymentProvider{webhook: checkoutCompleted("event_payment:1")}, repo, events)
+
+	for i := 0; i < 2; i++ {
+		if err := svc.HandleWebhook(context.Background(), []byte(`{}`), "t=1,v1=00"); err != nil {
+			t.Fatalf("HandleWebhook failed: %v", err)
+		}
+	}
+
+	if len(claimed) != 1 {
+		t.Errorf("expected the ticket claimed once, got %v", claimed)
+	}
+	if updates["status"] != model.RSVPStatusApproved || updates["payment_status"] != model.PaymentStatusPaid {
+		t.Errorf("expected the RSVP approved and paid, got %v", updates)
+	}
+}
+
+func TestHandleWebhook_RefundsLostSeat(t *testing.T) {
+	t.Parallel()
+
+	intent := "pi_1"
+	var refunded string
+	repo := &mocks.PaymentRepository{
+		MarkPaymentPaidFunc: func(ctx context.Context, paymentID, paymentIntentID string) (*model.EventPayment, error) {
+			return &model.EventPayment{ID: paymentID, EventID: "event:1", UserID: "user:a", TicketID: "event_ticket:1", Amount: 1500, PaymentIntentID: &intent}, nil
+		},
+		MarkPaymentRefundedFunc: func(ctx context.Context, paymentID, refundID string) (*model.EventPayment, error) {
+			refunded = paymentID
+			return &model.EventPayment{ID: paymentID}, nil
+		},
+	}
+	// The hold lapsed and the guest cancelled while paying
+	events := &mocks.PaymentEventRepository{}
+	provider := &fakePaymentProvider{webhook: checkoutCompleted("event_payment:1")}
+
+	if err := newPaymentService(provider, repo, events).HandleWebhook(context.Background(), []byte(`{}`), "t=1,v1=00"); err != nil {
+		t.Fatalf("HandleWebhook failed: %v", err)
+	}
+
+	if len(provider.refunds) != 1 || provider.refunds[0].PaymentIntent != "pi_1" || provider.refunds[0].Amount != 0 {
+		t.Errorf("expected one full refund of pi_1, got %+v", provider.refunds)
+	}
+	if refunded != "event_payment:1" {
+		t.Errorf("expected the payment marked refunded, got %q", refunded)
+	}
+}
+
+func TestHandleWebhook_InvalidSignature(t *testing.T) {
+	t.Parallel()
+
+	svc := newPaymentService(&fakePaymentProvider{}, &mocks.PaymentRepository{}, &mocks.PaymentEventRepository{})
+
+	if err := svc.HandleWebhook(context.Background(), []byte(`{}`), ""); !errors.Is(err, ErrInvalidWebhook) {
+		t.Errorf("expected ErrInvalidWebhook, got %v", err)
+	}
+}
+
+// ============================================================================
+// Refund Tests
+// ============================================================================
+
+func TestRefundRSVP_FollowsPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		policy     string
+		startsIn   time.Duration
+		full       bool
+		wantRefund bool
+	}{
+		{"no refunds", model.RefundPolicyNone, 72 * time.Hour, false, false},
+		{"host turns guest away", model.RefundPolicyNone, 72 * time.Hour, true, true},
+		{"full before start", model.RefundPolicyFull, time.Hour, false, true},
+		{"full after start", model.RefundPolicyFull, -time.Hour, false, false},
+		{"day before in time", model.RefundPolicyDayBefore, 48 * time.Hour, false, true},
+		{"day before too late", model.RefundPolicyDayBefore, 12 * time.Hour, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			intent := "pi_1"
+			repo := &mocks.PaymentRepository{
+				GetPaidPaymentFunc: func(ctx context.Context, eventID, userID string) (*model.EventPayment, error) {
+					return &model.EventPayment{ID: "event_payment:1", Amount: 1500, PaymentIntentID: &intent, Status: model.PaymentStatusPaid}, nil
+				},
+			}
+			provider := &fakePaymentProvider{}
+			svc := newPaymentService(provider, repo, &mocks.PaymentEventRepository{})
+
+			event := newPaidEvent()
+			event.RefundPolicy = tt.policy
+			event.StartTime = paymentTestNow.Add(tt.startsIn)
+
+			if err := svc.RefundRSVP(context.Background(), event, "user:a", tt.full); err != nil {
+				t.Fatalf("RefundRSVP failed: %v", err)
+			}
+			if refunded := len(provider.refunds) == 1; refunded != tt.wantRefund {
+				t.Errorf("expected refund %v, got %+v", tt.wantRefund, provider.refunds)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Checkout Tests
+// ============================================================================
+
+func TestCheckout_RequiresHold(t *testing.T) {
+	t.Parallel()
+
+	pending := model.PaymentStatusPending
+	events := &mocks.PaymentEventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return newPaidEvent(), nil
+		},
+		GetRSVPFunc: func(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+			return &model.EventRSVP{ID: "event_rsvp:1", Status: model.RSVPStatusPending, PaymentStatus: &pending}, nil
+		},
+	}
+	svc := newPaymentService(&fakePaymentProvider{}, &mocks.PaymentRepository{}, events)
+
+	if _, err := svc.Checkout(context.Background(), "user:a", "event:1", &model.CheckoutRequest{}); !errors.Is(err, ErrTicketHoldRequired) {
+		t.Errorf("expected ErrTicketHoldRequired, got %v", err)
+	}
+}
+
+func TestCheckout_ExtendsHold(t *testing.T) {
+	t.Parallel()
+
+	pending := model.PaymentStatusPending
+	holdUntil := paymentTestNow.Add(model.TicketHoldDuration)
+	var extendedTo time.Time
+	var params payments.CheckoutParams
+
+	events := &mocks.PaymentEventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return newPaidEvent(), nil
+		},
+		GetRSVPFunc: func(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+			return &model.EventRSVP{ID: "event_rsvp:1", Status: model.RSVPStatusPending, PaymentStatus: &pending}, nil
+		},
+		GetActiveTicketFunc: func(ctx context.Context, eventID, userID string) (*model.EventTicket, error) {
+			return &model.EventTicket{ID: "event_ticket:1", Status: model.TicketStatusHeld, HoldExpiresOn: &holdUntil}, nil
+		},
+		ExtendTicketHoldFunc: func(ctx context.Context, ticketID string, until time.Time) (*model.EventTicket, error) {
+			extendedTo = until
+			return &model.EventTicket{ID: ticketID}, nil
+		},
+	}
+	repo := &mocks.PaymentRepository{
+		GetPayoutAccountFunc: func(ctx context.Context, userID string) (*model.PayoutAccount, error) {
+			return &model.PayoutAccount{UserID: userID, AccountID: "acct_1", ChargesEnabled: true}, nil
+		},
+		CreatePaymentFunc: func(ctx context.Context, payment *model.EventPayment) error {
+			payment.ID = "event_payment:1"
+			return nil
+		},
+	}
+	provider := &recordingCheckoutProvider{fakePaymentProvider: &fakePaymentProvider{}, params: &params}
+
+	session, err := newPaymentService(provider, repo, events).Checkout(context.Background(), "user:a", "event:1", &model.CheckoutRequest{})
+	if err != nil {
+		t.Fatalf("Checkout failed: %v", err)
+	}
+
+	if want := paymentTestNow.Add(checkoutDuration); !session.ExpiresOn.Equal(want) {
+		t.Errorf("expected the checkout to expire at %v, got %v", want, session.ExpiresOn)
+	}
+	if want := paymentTestNow.Add(checkoutDuration + checkoutHoldGrace); !extendedTo.Equal(want) {
+		t.Errorf("expected the hold kept until %v, got %v", want, extendedTo)
+	}
+	if params.Amount != 1500 || params.Destination != "acct_1" || params.ClientReferenceID != "event_payment:1" {
+		t.Errorf("unexpected checkout params %+v", params)
+	}
+}
+
+// recordingCheckoutProvider records the params of the checkout it creates
+type recordingCheckoutProvider struct {
+	*fakePaymentProvider
+	params *payments.CheckoutParams
+}
+
+func (r *recordingCheckoutProvider) CreateCheckoutSession(ctx context.Context, p payments.CheckoutParams) (*payments.CheckoutSession, error) {
+	*r.params = p
+	return r.fakePaymentProvider.CreateCheckoutSession(ctx, p)
+}
+
+// ============================================================================
+// Paid RSVP Tests
+// ============================================================================
+
+func TestRSVP_PaidEventWaitsOnPayment(t *testing.T) {
+	t.Parallel()
+
+	claimCalled := false
+	var created *model.EventRSVP
+	repo := &mocks.EventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			event := newPaidEvent()
+			event.Visibility = model.EventVisibilityPublic
+			return event, nil
+		},
+		GetActiveTicketFunc: func(ctx context.Context, eventID, userID string) (*model.EventTicket, error) {
+			return &model.EventTicket{ID: "event_ticket:1", Status: model.TicketStatusHeld}, nil
+		},
+		ClaimTicketFunc: func(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+			claimCalled = true
+			return &model.EventTicket{ID: ticketID}, nil
+		},
+		CreateRSVPFunc: func(ctx context.Context, rsvp *model.EventRSVP) error {
+			created = rsvp
+			return nil
+		},
+	}
+
+	_, err := newTicketService(repo, nil).RSVP(context.Background(), "user:a", "event:1", &model.RSVPRequest{RSVPType: model.RSVPTypeGoing})
+	if err != nil {
+		t.Fatalf("RSVP failed: %v", err)
+	}
+
+	if claimCalled {
+		t.Error("expected the ticket left held until payment")
+	}
+	if created.Status != model.RSVPStatusPending || created.WaitingReason == nil || *created.WaitingReason != model.WaitingReasonPayment {
+		t.Errorf("expected the RSVP waiting on payment, got %+v", created)
+	}
+	if created.PaymentStatus == nil || *created.PaymentStatus != model.PaymentStatusPending {
+		t.Errorf("expected payment_status pending, got %v", created.PaymentStatus)
+	}
+}
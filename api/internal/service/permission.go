@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// GuildPermissionRepository defines the storage for guild custom roles and
+// who holds them
+type GuildPermissionRepository interface {
+	CreateRole(ctx context.Context, role *model.GuildCustomRole) error
+	GetRole(ctx context.Context, roleID string) (*model.GuildCustomRole, error)
+	ListRoles(ctx context.Context, guildID string) ([]*model.GuildCustomRole, error)
+	CountRoles(ctx context.Context, guildID string) (int, error)
+	UpdateRole(ctx context.Context, roleID string, updates map[string]interface{}) (*model.GuildCustomRole, error)
+	DeleteRole(ctx context.Context, roleID string) error
+
+	AssignRole(ctx context.Context, guildID, roleID, userID, assignedBy string) error
+	UnassignRole(ctx context.Context, roleID, userID string) (bool, error)
+	GetMemberRoles(ctx context.Context, guildID, userID string) ([]*model.GuildCustomRole, error)
+}
+
+// PermissionGuildRepository provides the built-in roles permissions start
+// from
+type PermissionGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	GetMemberRole(ctx context.Context, userID, guildID string) (model.GuildRole, error)
+}
+
+// GuildPermissionChecker decides whether a guild member may take an action.
+// Services that gate actions on permissions take it as an optional
+// dependency and fall back to their built-in role checks without it.
+type GuildPermissionChecker interface {
+	RequirePermission(ctx context.Context, userID, guildID string, perm model.Permission) error
+}
+
+// PermissionService works out what guild members may do. Built-in roles
+// (member, moderator, admin) grant default permissions; guilds can define
+// custom roles, such as "organizer", that grant more on top.
+type PermissionService struct {
+	repo   GuildPermissionRepository
+	guilds PermissionGuildRepository
+}
+
+// NewPermissionService creates a new permission service
+func NewPermissionService(repo GuildPermissionRepository, guilds PermissionGuildRepository) *PermissionService {
+	return &PermissionService{
+		repo:   repo,
+		guilds: guilds,
+	}
+}
+
+// ============================================================================
+// Enforcement
+// ============================================================================
+
+// MemberPermissions returns what a user may do in a guild. Returns
+// ErrNotGuildMember if they aren't a member.
+func (s *PermissionService) MemberPermissions(ctx context.Context, userID, guildID string) (*model.GuildPermissions, error) {
+	isMember, err := s.guilds.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("checking guild membership: %w", err)
+	}
+	if !isMember {
+		return nil, ErrNotGuildMember
+	}
+
+	role, err := s.guilds.GetMemberRole(ctx, userID, guildID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, ErrNotGuildMember
+		}
+		return nil, fmt.Errorf("getting member role: %w", err)
+	}
+
+	customRoles, err := s.repo.GetMemberRoles(ctx, guildID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting custom roles: %w", err)
+	}
+
+	perms := &model.GuildPermissions{
+		GuildID:     guildID,
+		Role:        role,
+		CustomRoles: make([]model.GuildCustomRole, 0, len(customRoles)),
+	}
+	lists := [][]model.Permission{role.DefaultPermissions()}
+	for _, custom := range customRoles {
+		perms.CustomRoles = append(perms.CustomRoles, *custom)
+		lists = append(lists, custom.Permissions)
+	}
+	perms.Permissions = model.MergePermissions(lists...)
+	return perms, nil
+}
+
+// RequirePermission returns ErrNotGuildMember unless the user is a member
+// of the guild, and ErrMissingPermission unless they hold the permission
+func (s *PermissionService) RequirePermission(ctx context.Context, userID, guildID string, perm model.Permission) error {
+	perms, err := s.MemberPermissions(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !perms.Has(perm) {
+		return ErrMissingPermission
+	}
+	return nil
+}
+
+// GetMemberPermissions returns what a guild member may do (members only).
+// Returns ErrGuildRoleHolderNotMember if memberUserID isn't in the guild.
+func (s *PermissionService) GetMemberPermissions(ctx context.Context, userID, guildID, memberUserID string) (*model.GuildPermissions, error) {
+	if memberUserID != userID {
+		if _, err := s.MemberPermissions(ctx, userID, guildID); err != nil {
+			return nil, err
+		}
+	}
+	perms, err := s.MemberPermissions(ctx, memberUserID, guildID)
+	if errors.Is(err, ErrNotGuildMember) && memberUserID != userID {
+		return nil, ErrGuildRoleHolderNotMember
+	}
+	return perms, err
+}
+
+// ============================================================================
+// Custom Roles
+// ============================================================================
+
+// ListRoles returns a guild's custom roles (members only)
+func (s *PermissionService) ListRoles(ctx context.Context, userID, guildID string) ([]*model.GuildCustomRole, error) {
+	if _, err := s.MemberPermissions(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRoles(ctx, guildID)
+}
+
+// CreateRole defines a custom role (manage_roles). Members can't grant
+// permissions they don't hold themselves.
+func (s *PermissionService) CreateRole(ctx context.Context, userID, guildID string, req *model.CreateGuildCustomRoleRequest) (*model.GuildCustomRole, error) {
+	if errs := req.Validate(); len(errs) > 0 {
+		return nil, model.NewValidationError(errs)
+	}
+	if err := s.requireGrant(ctx, userID, guildID, req.Permissions); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountRoles(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= model.MaxCustomRolesPerGuild {
+		return nil, ErrTooManyGuildRoles
+	}
+
+	role := &model.GuildCustomRole{
+		GuildID:     guildID,
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: model.MergePermissions(req.Permissions),
+		CreatedBy:   userID,
+	}
+	if err := s.repo.CreateRole(ctx, role); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrGuildRoleExists
+		}
+		return nil, err
+	}
+	return role, nil
+}
+
+// UpdateRole changes a custom role (manage_roles). Permission changes apply
+// to its holders at once.
+func (s *PermissionService) UpdateRole(ctx context.Context, userID, guildID, roleID string, req *model.UpdateGuildCustomRoleRequest) (*model.GuildCustomRole, error) {
+	if errs := req.Validate(); len(errs) > 0 {
+		return nil, model.NewValidationError(errs)
+	}
+	role, err := s.guildRole(ctx, guildID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	// Editing a role takes the same reach as granting what it holds
+	if err := s.requireGrant(ctx, userID, guildID, model.MergePermissions(role.Permissions, req.Permissions)); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.Permissions != nil {
+		updates["permissions"] = model.MergePermissions(req.Permissions)
+	}
+
+	updated, err := s.repo.UpdateRole(ctx, roleID, updates)
+	if err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrGuildRoleExists
+		}
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrGuildRoleNotFound
+	}
+	return updated, nil
+}
+
+// DeleteRole deletes a custom role and takes it from its holders
+// (manage_roles)
+func (s *PermissionService) DeleteRole(ctx context.Context, userID, guildID, roleID string) error {
+	role, err := s.guildRole(ctx, guildID, roleID)
+	if err != nil {
+		return err
+	}
+	if err := s.requireGrant(ctx, userID, guildID, role.Permissions); err != nil {
+		return err
+	}
+	return s.repo.DeleteRole(ctx, roleID)
+}
+
+// ============================================================================
+// Assignments
+// ============================================================================
+
+// AssignRole gives a guild member a custom role (manage_roles)
+func (s *PermissionService) AssignRole(ctx context.Context, userID, guildID, roleID, memberUserID string) (*model.GuildPermissions, error) {
+	role, err := s.guildRole(ctx, guildID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireGrant(ctx, userID, guildID, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	holder, err := s.MemberPermissions(ctx, memberUserID, guildID)
+	if err != nil {
+		if errors.Is(err, ErrNotGuildMember) {
+			return nil, ErrGuildRoleHolderNotMember
+		}
+		return nil, err
+	}
+	held := slices.ContainsFunc(holder.CustomRoles, func(r model.GuildCustomRole) bool { return r.ID == roleID })
+	if !held && len(holder.CustomRoles) >= model.MaxCustomRolesPerMember {
+		return nil, ErrTooManyMemberRoles
+	}
+
+	if err := s.repo.AssignRole(ctx, guildID, roleID, memberUserID, userID); err != nil {
+		return nil, err
+	}
+	return s.MemberPermissions(ctx, memberUserID, guildID)
+}
+
+// UnassignRole takes a custom role from a guild member (manage_roles)
+func (s *PermissionService) UnassignRole(ctx context.Context, userID, guildID, roleID, memberUserID string) error {
+	role, err := s.guildRole(ctx, guildID, roleID)
+	if err != nil {
+		return err
+	}
+	if err := s.requireGrant(ctx, userID, guildID, role.Permissions); err != nil {
+		return err
+	}
+
+	removed, err := s.repo.UnassignRole(ctx, roleID, memberUserID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrGuildRoleNotHeld
+	}
+	return nil
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// requireGrant requires manage_roles and every one of perms, so members
+// can't hand out more than they hold
+func (s *PermissionService) requireGrant(ctx context.Context, userID, guildID string, perms []model.Permission) error {
+	actor, err := s.MemberPermissions(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !actor.Has(model.PermissionManageRoles) || !actor.HasAll(perms) {
+		return ErrMissingPermission
+	}
+	return nil
+}
+
+// guildRole returns one of a guild's custom roles
+func (s *PermissionService) guildRole(ctx context.Context, guildID, roleID string) (*model.GuildCustomRole, error) {
+	role, err := s.repo.GetRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil || role.GuildID != guildID {
+		return nil, ErrGuildRoleNotFound
+	}
+	return role, nil
+}
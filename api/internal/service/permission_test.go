@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// memoryGuildPermissionRepo keeps guild:1's custom roles in memory, starting
+// with an organizer role (start_votes, manage_pools) and a role manager role
+// (manage_roles). assignments maps user IDs to the role IDs they hold.
+func memoryGuildPermissionRepo(assignments map[string][]string) *mocks.GuildPermissionRepository {
+	roles := map[string]*model.GuildCustomRole{
+		"guild_custom_role:organizer": {
+			ID:          "guild_custom_role:organizer",
+			GuildID:     "guild:1",
+			Name:        "Organizer",
+			Permissions: []model.Permission{model.PermissionStartVotes, model.PermissionManagePools},
+		},
+		"guild_custom_role:role_manager": {
+			ID:          "guild_custom_role:role_manager",
+			GuildID:     "guild:1",
+			Name:        "Role Manager",
+			Permissions: []model.Permission{model.PermissionManageRoles},
+		},
+	}
+	return &mocks.GuildPermissionRepository{
+		CreateRoleFunc: func(ctx context.Context, role *model.GuildCustomRole) error {
+			role.ID = "guild_custom_role:" + role.Name
+			roles[role.ID] = role
+			return nil
+		},
+		GetRoleFunc: func(ctx context.Context, roleID string) (*model.GuildCustomRole, error) {
+			return roles[roleID], nil
+		},
+		CountRolesFunc: func(ctx context.Context, guildID string) (int, error) {
+			return len(roles), nil
+		},
+		AssignRoleFunc: func(ctx context.Context, guildID, roleID, userID, assignedBy string) error {
+			if !slices.Contains(assignments[userID], roleID) {
+				assignments[userID] = append(assignments[userID], roleID)
+			}
+			return nil
+		},
+		UnassignRoleFunc: func(ctx context.Context, roleID, userID string) (bool, error) {
+			held := assignments[userID]
+			i := slices.Index(held, roleID)
+			if i < 0 {
+				return false, nil
+			}
+			assignments[userID] = slices.Delete(held, i, i+1)
+			return true, nil
+		},
+		GetMemberRolesFunc: func(ctx context.Context, guildID, userID string) ([]*model.GuildCustomRole, error) {
+			var held []*model.GuildCustomRole
+			for _, id := range assignments[userID] {
+				held = append(held, roles[id])
+			}
+			return held, nil
+		},
+	}
+}
+
+// permissionGuildRepo makes user:admin an admin of guild:1, user:mod a
+// moderator and user:ada and user:bob members
+func permissionGuildRepo() *mocks.PermissionGuildRepository {
+	builtIn := map[string]model.GuildRole{
+		"user:admin": model.GuildRoleAdmin,
+		"user:mod":   model.GuildRoleModerator,
+		"user:ada":   model.GuildRoleMember,
+		"user:bob":   model.GuildRoleMember,
+	}
+	return &mocks.PermissionGuildRepository{
+		IsMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			_, ok := builtIn[userID]
+			return ok && guildID == "guild:1", nil
+		},
+		GetMemberRoleFunc: func(ctx context.Context, userID, guildID string) (model.GuildRole, error) {
+			return builtIn[userID], nil
+		},
+	}
+}
+
+// ============================================================================
+// Permission Tests
+// ============================================================================
+
+func TestPermissions_BuiltInRoleDefaults(t *testing.T) {
+	t.Parallel()
+
+	svc := NewPermissionService(memoryGuildPermissionRepo(map[string][]string{}), permissionGuildRepo())
+
+	tests := []struct {
+		userID  string
+		perm    model.Permission
+		wantErr error
+	}{
+		{"user:ada", model.PermissionCreateEvents, nil},
+		{"user:ada", model.PermissionManagePools, ErrMissingPermission},
+		{"user:mod", model.PermissionManagePools, nil},
+		{"user:mod", model.PermissionStartVotes, nil},
+		{"user:mod", model.PermissionManageRoles, ErrMissingPermission},
+		{"user:admin", model.PermissionManageRoles, nil},
+		{"user:stranger", model.PermissionCreateEvents, ErrNotGuildMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.userID+" "+string(tt.perm), func(t *testing.T) {
+			t.Parallel()
+			if err := svc.RequirePermission(context.Background(), tt.userID, "guild:1", tt.perm); !errors.Is(err, tt.wantErr) {
+				t.Errorf("RequirePermission() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPermissions_AssignRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		roleID    string
+		member    string
+		wantErr   error
+		wantPerms []model.Permission
+	}{
+		{
+			name:      "organizer",
+			roleID:    "guild_custom_role:organizer",
+			member:    "user:ada",
+			wantPerms: []model.Permission{model.PermissionCreateEvents, model.PermissionManagePools, model.PermissionStartVotes},
+		},
+		{name: "non-member", roleID: "guild_custom_role:organizer", member: "user:stranger", wantErr: ErrGuildRoleHolderNotMember},
+		{name: "missing role", roleID: "guild_custom_role:missing", member: "user:ada", wantErr: ErrGuildRoleNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewPermissionService(memoryGuildPermissionRepo(map[string][]string{}), permissionGuildRepo())
+			perms, err := svc.AssignRole(context.Background(), "user:admin", "guild:1", tt.roleID, tt.member)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AssignRole() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !slices.Equal(perms.Permissions, tt.wantPerms) {
+				t.Errorf("permissions = %v, want %v", perms.Permissions, tt.wantPerms)
+			}
+			if len(perms.CustomRoles) != 1 || perms.CustomRoles[0].ID != tt.roleID {
+				t.Errorf("custom roles = %+v, want %s", perms.CustomRoles, tt.roleID)
+			}
+		})
+	}
+}
+
+func TestPermissions_UnassignRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		held    []string
+		wantErr error
+	}{
+		{"held", []string{"guild_custom_role:organizer"}, nil},
+		{"not held", nil, ErrGuildRoleNotHeld},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			repo := memoryGuildPermissionRepo(map[string][]string{"user:ada": tt.held})
+			svc := NewPermissionService(repo, permissionGuildRepo())
+
+			if err := svc.UnassignRole(ctx, "user:admin", "guild:1", "guild_custom_role:organizer", "user:ada"); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UnassignRole() error = %v, want %v", err, tt.wantErr)
+			}
+			if err := svc.RequirePermission(ctx, "user:ada", "guild:1", model.PermissionManagePools); !errors.Is(err, ErrMissingPermission) {
+				t.Errorf("RequirePermission() error = %v, want ErrMissingPermission once unassigned", err)
+			}
+		})
+	}
+}
+
+func TestPermissions_CreateRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		userID         string
+		held           []string // Custom roles userID holds
+		req            model.CreateGuildCustomRoleRequest
+		wantErr        error
+		wantValidation bool
+	}{
+		{
+			name:   "admin",
+			userID: "user:admin",
+			req:    model.CreateGuildCustomRoleRequest{Name: "Host", Permissions: []model.Permission{model.PermissionCreateEvents}},
+		},
+		{
+			name:    "moderators can't manage roles",
+			userID:  "user:mod",
+			req:     model.CreateGuildCustomRoleRequest{Name: "Helper", Permissions: []model.Permission{model.PermissionCreateEvents}},
+			wantErr: ErrMissingPermission,
+		},
+		{
+			name:   "role manager within what they hold",
+			userID: "user:ada",
+			held:   []string{"guild_custom_role:role_manager"},
+			req:    model.CreateGuildCustomRoleRequest{Name: "Host", Permissions: []model.Permission{model.PermissionCreateEvents}},
+		},
+		{
+			name:    "role manager granting what they don't hold",
+			userID:  "user:ada",
+			held:    []string{"guild_custom_role:role_manager"},
+			req:     model.CreateGuildCustomRoleRequest{Name: "Doorkeeper", Permissions: []model.Permission{model.PermissionManageInvites}},
+			wantErr: ErrMissingPermission,
+		},
+		{
+			name:           "built-in name",
+			userID:         "user:admin",
+			req:            model.CreateGuildCustomRoleRequest{Name: "Moderator", Permissions: []model.Permission{model.PermissionCreateEvents}},
+			wantValidation: true,
+		},
+		{
+			name:           "no permissions",
+			userID:         "user:admin",
+			req:            model.CreateGuildCustomRoleRequest{Name: "Empty"},
+			wantValidation: true,
+		},
+		{
+			name:           "unknown permission",
+			userID:         "user:admin",
+			req:            model.CreateGuildCustomRoleRequest{Name: "Odd", Permissions: []model.Permission{"ban_members"}},
+			wantValidation: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := memoryGuildPermissionRepo(map[string][]string{tt.userID: tt.held})
+			svc := NewPermissionService(repo, permissionGuildRepo())
+
+			role, err := svc.CreateRole(context.Background(), tt.userID, "guild:1", &tt.req)
+			if tt.wantValidation {
+				var pd *model.ProblemDetails
+				if !errors.As(err, &pd) || pd.Status != 422 {
+					t.Errorf("CreateRole() error = %v, want a validation problem", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateRole() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (role.GuildID != "guild:1" || role.Name != tt.req.Name) {
+				t.Errorf("role = %+v, want %s in guild:1", role, tt.req.Name)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"log"
 	"math"
+	"math/rand/v2"
 	"sort"
 	"time"
 
@@ -17,7 +19,7 @@ type PoolRepository interface {
 	GetPool(ctx context.Context, poolID string) (*model.MatchingPool, error)
 	GetPoolsByGuild(ctx context.Context, guildID string) ([]*model.MatchingPool, error)
 	UpdatePool(ctx context.Context, poolID string, updates map[string]interface{}) (*model.MatchingPool, error)
-	DeletePool(ctx context.Context, poolID string) error
+	MoveToTrash(ctx context.Context, poolID, userID string) (bool, error)
 	CountPoolsByGuild(ctx context.Context, guildID string) (int, error)
 
 	AddMember(ctx context.Context, member *model.PoolMember) error
@@ -41,6 +43,12 @@ type PoolRepository interface {
 	GetStaleMatches(ctx context.Context, cutoff time.Time, status string) ([]*model.MatchResult, error)
 }
 
+// PoolTierGate enforces guild membership tier perks on pools
+type PoolTierGate interface {
+	ValidateTier(ctx context.Context, guildID, tierID string) error
+	CheckPoolJoin(ctx context.Context, pool *model.MatchingPool, userID string, now time.Time) error
+}
+
 // CompatibilityCalculator interface for optional compatibility scoring
 type CompatibilityCalculator interface {
 	CalculateCompatibility(ctx context.Context, userAID, userBID string) (*model.CompatibilityScore, error)
@@ -52,7 +60,11 @@ type PoolService struct {
 	guildRepo     GuildRepository
 	memberRepo    MemberRepository
 	compatibility CompatibilityCalculator
+	activity      ActivityRecorder
+	analytics     AnalyticsEmitter
+	tiers         PoolTierGate
 	config        model.MatchingConfig
+	seeds         *rand.Rand
 }
 
 // PoolServiceConfig holds configuration for the pool service
@@ -61,7 +73,11 @@ type PoolServiceConfig struct {
 	GuildRepo     GuildRepository
 	MemberRepo    MemberRepository
 	Compatibility CompatibilityCalculator // Optional
+	Activity      ActivityRecorder        // Optional; records matches on members' timelines
+	Analytics     AnalyticsEmitter        // Optional
+	Tiers         PoolTierGate            // Optional; without it pools can't be gated on a tier
 	Config        *model.MatchingConfig   // Optional, uses defaults if nil
+	Rand          rand.Source             // Optional; draws each matching round's seed, randomly seeded if nil
 }
 
 // NewPoolService creates a new pool service
@@ -75,12 +91,16 @@ func NewPoolService(cfg PoolServiceConfig) *PoolService {
 		guildRepo:     cfg.GuildRepo,
 		memberRepo:    cfg.MemberRepo,
 		compatibility: cfg.Compatibility,
+		activity:      cfg.Activity,
+		analytics:     cfg.Analytics,
+		tiers:         cfg.Tiers,
 		config:        config,
+		seeds:         newRand(cfg.Rand),
 	}
 }
 
 // CreatePool creates a new matching pool in a guild
-func (s *PoolService) CreatePool(ctx context.Context, guildID string, req *model.CreatePoolRequest, creatorMemberID string) (*model.MatchingPool, error) {
+func (s *PoolService) CreatePool(ctx context.Context, guildID model.GuildID, req *model.CreatePoolRequest, createdBy model.UserID) (*model.MatchingPool, error) {
 	// Validate frequency
 	if !isValidFrequency(req.Frequency) {
 		return nil, ErrInvalidFrequency
@@ -96,7 +116,7 @@ func (s *PoolService) CreatePool(ctx context.Context, guildID string, req *model
 	}
 
 	// Check pool limit for guild
-	count, err := s.poolRepo.CountPoolsByGuild(ctx, guildID)
+	count, err := s.poolRepo.CountPoolsByGuild(ctx, string(guildID))
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +137,13 @@ func (s *PoolService) CreatePool(ctx context.Context, guildID string, req *model
 		req.ActivitySuggestion = &sugg
 	}
 
+	// Exclusive pools are gated on one of the guild's tiers
+	if req.MinTier != nil {
+		if err := s.validateTier(ctx, guildID, *req.MinTier); err != nil {
+			return nil, err
+		}
+	}
+
 	// Calculate first match date
 	nextMatch := model.GetNextMatchDate(req.Frequency, time.Now())
 
@@ -127,9 +154,10 @@ func (s *PoolService) CreatePool(ctx context.Context, guildID string, req *model
 		Frequency:          req.Frequency,
 		MatchSize:          matchSize,
 		ActivitySuggestion: req.ActivitySuggestion,
+		MinTier:            req.MinTier,
 		NextMatchOn:        nextMatch,
 		Active:             true,
-		CreatedBy:          creatorMemberID,
+		CreatedBy:          createdBy,
 	}
 
 	if err := s.poolRepo.CreatePool(ctx, pool); err != nil {
@@ -140,8 +168,8 @@ func (s *PoolService) CreatePool(ctx context.Context, guildID string, req *model
 }
 
 // GetPool retrieves a pool by ID
-func (s *PoolService) GetPool(ctx context.Context, poolID string) (*model.MatchingPool, error) {
-	pool, err := s.poolRepo.GetPool(ctx, poolID)
+func (s *PoolService) GetPool(ctx context.Context, poolID model.PoolID) (*model.MatchingPool, error) {
+	pool, err := s.poolRepo.GetPool(ctx, string(poolID))
 	if err != nil {
 		return nil, err
 	}
@@ -152,13 +180,13 @@ func (s *PoolService) GetPool(ctx context.Context, poolID string) (*model.Matchi
 }
 
 // GetPoolsByGuild retrieves all pools for a guild
-func (s *PoolService) GetPoolsByGuild(ctx context.Context, guildID string) ([]*model.MatchingPool, error) {
-	return s.poolRepo.GetPoolsByGuild(ctx, guildID)
+func (s *PoolService) GetPoolsByGuild(ctx context.Context, guildID model.GuildID) ([]*model.MatchingPool, error) {
+	return s.poolRepo.GetPoolsByGuild(ctx, string(guildID))
 }
 
 // UpdatePool updates a pool
-func (s *PoolService) UpdatePool(ctx context.Context, poolID string, req *model.UpdatePoolRequest) (*model.MatchingPool, error) {
-	pool, err := s.poolRepo.GetPool(ctx, poolID)
+func (s *PoolService) UpdatePool(ctx context.Context, poolID model.PoolID, req *model.UpdatePoolRequest) (*model.MatchingPool, error) {
+	pool, err := s.poolRepo.GetPool(ctx, string(poolID))
 	if err != nil {
 		return nil, err
 	}
@@ -206,29 +234,40 @@ func (s *PoolService) UpdatePool(ctx context.Context, poolID string, req *model.
 	if req.Active != nil {
 		updates["active"] = *req.Active
 	}
+	if req.MinTier != nil {
+		if *req.MinTier == "" {
+			updates["min_tier"] = nil
+		} else {
+			if err := s.validateTier(ctx, pool.GuildID, *req.MinTier); err != nil {
+				return nil, err
+			}
+			updates["min_tier"] = *req.MinTier
+		}
+	}
 
 	if len(updates) == 0 {
 		return pool, nil
 	}
 
-	return s.poolRepo.UpdatePool(ctx, poolID, updates)
+	return s.poolRepo.UpdatePool(ctx, string(poolID), updates)
 }
 
-// DeletePool deletes a pool and all associated data
-func (s *PoolService) DeletePool(ctx context.Context, poolID string) error {
-	pool, err := s.poolRepo.GetPool(ctx, poolID)
+// DeletePool moves a pool to its guild's trash. Its members and match
+// history are kept until the trash is purged.
+func (s *PoolService) DeletePool(ctx context.Context, poolID model.PoolID, userID model.UserID) error {
+	moved, err := s.poolRepo.MoveToTrash(ctx, string(poolID), string(userID))
 	if err != nil {
 		return err
 	}
-	if pool == nil {
+	if !moved {
 		return ErrPoolNotFound
 	}
-	return s.poolRepo.DeletePool(ctx, poolID)
+	return nil
 }
 
 // JoinPool adds a user to a pool
-func (s *PoolService) JoinPool(ctx context.Context, poolID, memberID, userID string, req *model.JoinPoolRequest) (*model.PoolMember, error) {
-	pool, err := s.poolRepo.GetPool(ctx, poolID)
+func (s *PoolService) JoinPool(ctx context.Context, poolID model.PoolID, memberID model.MemberID, userID model.UserID, req *model.JoinPoolRequest) (*model.PoolMember, error) {
+	pool, err := s.poolRepo.GetPool(ctx, string(poolID))
 	if err != nil {
 		return nil, err
 	}
@@ -237,24 +276,32 @@ func (s *PoolService) JoinPool(ctx context.Context, poolID, memberID, userID str
 	}
 
 	// Check if already a member
-	existing, err := s.poolRepo.GetMember(ctx, poolID, memberID)
+	existing, err := s.poolRepo.GetMember(ctx, string(poolID), string(memberID))
 	if err != nil {
 		return nil, err
 	}
-	if existing != nil {
-		if existing.Active {
-			return nil, ErrAlreadyPoolMember
+	if existing != nil && existing.Active {
+		return nil, ErrAlreadyPoolMember
+	}
+
+	// Exclusive pools are a perk of guild tiers
+	if s.tiers != nil {
+		if err := s.tiers.CheckPoolJoin(ctx, pool, string(userID), time.Now()); err != nil {
+			return nil, err
 		}
+	}
+
+	if existing != nil {
 		// Reactivate membership
 		updates := map[string]interface{}{
 			"active":           true,
-			"excluded_members": req.ExcludedMembers,
+			"excluded_members": model.IDStrings(req.ExcludedMembers),
 		}
-		return s.poolRepo.UpdateMember(ctx, existing.ID, updates)
+		return s.poolRepo.UpdateMember(ctx, string(existing.ID), updates)
 	}
 
 	// Check member limit
-	members, err := s.poolRepo.GetPoolMembers(ctx, poolID)
+	members, err := s.poolRepo.GetPoolMembers(ctx, string(poolID))
 	if err != nil {
 		return nil, err
 	}
@@ -282,21 +329,34 @@ func (s *PoolService) JoinPool(ctx context.Context, poolID, memberID, userID str
 	return member, nil
 }
 
+// MemberIDForUser returns the member record pool memberships refer to for
+// a user
+func (s *PoolService) MemberIDForUser(ctx context.Context, userID model.UserID) (model.MemberID, error) {
+	member, err := s.memberRepo.GetByUserID(ctx, string(userID))
+	if err != nil {
+		return "", err
+	}
+	if member == nil {
+		return "", ErrNoMemberRecord
+	}
+	return model.MemberID(member.ID), nil
+}
+
 // LeavePool removes a user from a pool
-func (s *PoolService) LeavePool(ctx context.Context, poolID, memberID string) error {
-	member, err := s.poolRepo.GetMember(ctx, poolID, memberID)
+func (s *PoolService) LeavePool(ctx context.Context, poolID model.PoolID, memberID model.MemberID) error {
+	member, err := s.poolRepo.GetMember(ctx, string(poolID), string(memberID))
 	if err != nil {
 		return err
 	}
 	if member == nil || !member.Active {
 		return ErrNotPoolMember
 	}
-	return s.poolRepo.RemoveMember(ctx, member.ID)
+	return s.poolRepo.RemoveMember(ctx, string(member.ID))
 }
 
 // UpdateMembership updates a user's pool membership settings
-func (s *PoolService) UpdateMembership(ctx context.Context, poolID, memberID string, req *model.UpdateMembershipRequest) (*model.PoolMember, error) {
-	member, err := s.poolRepo.GetMember(ctx, poolID, memberID)
+func (s *PoolService) UpdateMembership(ctx context.Context, poolID model.PoolID, memberID model.MemberID, req *model.UpdateMembershipRequest) (*model.PoolMember, error) {
+	member, err := s.poolRepo.GetMember(ctx, string(poolID), string(memberID))
 	if err != nil {
 		return nil, err
 	}
@@ -312,34 +372,34 @@ func (s *PoolService) UpdateMembership(ctx context.Context, poolID, memberID str
 		if len(req.ExcludedMembers) > model.MaxExclusionsPerMember {
 			return nil, ErrExclusionLimitReached
 		}
-		updates["excluded_members"] = req.ExcludedMembers
+		updates["excluded_members"] = model.IDStrings(req.ExcludedMembers)
 	}
 
 	if len(updates) == 0 {
 		return member, nil
 	}
 
-	return s.poolRepo.UpdateMember(ctx, member.ID, updates)
+	return s.poolRepo.UpdateMember(ctx, string(member.ID), updates)
 }
 
 // GetPoolMembers retrieves all members of a pool
-func (s *PoolService) GetPoolMembers(ctx context.Context, poolID string) ([]*model.PoolMember, error) {
-	return s.poolRepo.GetPoolMembers(ctx, poolID)
+func (s *PoolService) GetPoolMembers(ctx context.Context, poolID model.PoolID) ([]*model.PoolMember, error) {
+	return s.poolRepo.GetPoolMembers(ctx, string(poolID))
 }
 
 // GetUserMemberships retrieves all pools a user belongs to
-func (s *PoolService) GetUserMemberships(ctx context.Context, userID string) ([]*model.PoolMember, error) {
-	return s.poolRepo.GetUserPoolMemberships(ctx, userID)
+func (s *PoolService) GetUserMemberships(ctx context.Context, userID model.UserID) ([]*model.PoolMember, error) {
+	return s.poolRepo.GetUserPoolMemberships(ctx, string(userID))
 }
 
 // GetPoolWithMembers retrieves a pool with its member list
-func (s *PoolService) GetPoolWithMembers(ctx context.Context, poolID string) (*model.PoolWithMembers, error) {
+func (s *PoolService) GetPoolWithMembers(ctx context.Context, poolID model.PoolID) (*model.PoolWithMembers, error) {
 	pool, err := s.GetPool(ctx, poolID)
 	if err != nil {
 		return nil, err
 	}
 
-	members, err := s.poolRepo.GetPoolMembers(ctx, poolID)
+	members, err := s.poolRepo.GetPoolMembers(ctx, string(poolID))
 	if err != nil {
 		return nil, err
 	}
@@ -356,30 +416,30 @@ func (s *PoolService) GetPoolWithMembers(ctx context.Context, poolID string) (*m
 }
 
 // GetPendingMatches retrieves pending matches for a user
-func (s *PoolService) GetPendingMatches(ctx context.Context, userID string) ([]*model.PendingMatch, error) {
-	matches, err := s.poolRepo.GetUserPendingMatches(ctx, userID)
+func (s *PoolService) GetPendingMatches(ctx context.Context, userID model.UserID) ([]*model.PendingMatch, error) {
+	matches, err := s.poolRepo.GetUserPendingMatches(ctx, string(userID))
 	if err != nil {
 		return nil, err
 	}
 
 	var pending []*model.PendingMatch
 	for _, match := range matches {
-		pool, err := s.poolRepo.GetPool(ctx, match.PoolID)
+		pool, err := s.poolRepo.GetPool(ctx, string(match.PoolID))
 		if err != nil || pool == nil {
 			continue
 		}
 
 		// Get guild info (would need guild repo)
-		guild, err := s.guildRepo.GetByID(ctx, pool.GuildID)
+		guild, err := s.guildRepo.GetByID(ctx, string(pool.GuildID))
 		if err != nil || guild == nil {
 			continue
 		}
 
 		// Get partner info
-		partnerIDs := make([]string, 0)
+		partnerIDs := make([]model.MemberID, 0)
 		partnerNames := make([]string, 0)
 		for _, mid := range match.Members {
-			member, err := s.poolRepo.GetMember(ctx, pool.ID, mid)
+			member, err := s.poolRepo.GetMember(ctx, string(pool.ID), string(mid))
 			if err == nil && member != nil && member.UserID != userID {
 				partnerIDs = append(partnerIDs, mid)
 				if member.MemberName != nil {
@@ -405,8 +465,8 @@ func (s *PoolService) GetPendingMatches(ctx context.Context, userID string) ([]*
 }
 
 // UpdateMatch updates a match result (status, scheduled time, etc.)
-func (s *PoolService) UpdateMatch(ctx context.Context, matchID, userID string, req *model.UpdateMatchRequest) (*model.MatchResult, error) {
-	match, err := s.poolRepo.GetMatchResult(ctx, matchID)
+func (s *PoolService) UpdateMatch(ctx context.Context, matchID model.MatchID, userID model.UserID, req *model.UpdateMatchRequest) (*model.MatchResult, error) {
+	match, err := s.poolRepo.GetMatchResult(ctx, string(matchID))
 	if err != nil {
 		return nil, err
 	}
@@ -439,24 +499,46 @@ func (s *PoolService) UpdateMatch(ctx context.Context, matchID, userID string, r
 		return match, nil
 	}
 
-	return s.poolRepo.UpdateMatchResult(ctx, matchID, updates)
+	updated, err := s.poolRepo.UpdateMatchResult(ctx, string(matchID), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	if status, ok := updates["status"].(string); ok && status != match.Status {
+		switch status {
+		case model.MatchStatusScheduled:
+			s.emitMatchEvent(ctx, model.AnalyticsMatchAccepted, match)
+		case model.MatchStatusCompleted:
+			s.emitMatchEvent(ctx, model.AnalyticsMatchCompleted, match)
+		}
+	}
+	return updated, nil
+}
+
+// emitMatchEvent records an analytics event for each member of a match
+func (s *PoolService) emitMatchEvent(ctx context.Context, name model.AnalyticsEventName, match *model.MatchResult) {
+	for _, userID := range match.MemberUserIDs {
+		emitAnalytics(ctx, s.analytics, name, string(userID), map[string]interface{}{
+			"group_size": len(match.MemberUserIDs),
+		})
+	}
 }
 
 // GetPoolStats retrieves statistics for a pool
-func (s *PoolService) GetPoolStats(ctx context.Context, poolID string) (*model.PoolStats, error) {
-	return s.poolRepo.GetPoolStats(ctx, poolID)
+func (s *PoolService) GetPoolStats(ctx context.Context, poolID model.PoolID) (*model.PoolStats, error) {
+	return s.poolRepo.GetPoolStats(ctx, string(poolID))
 }
 
 // GetMatchHistory retrieves match history for a pool
-func (s *PoolService) GetMatchHistory(ctx context.Context, poolID string, limit int) ([]*model.MatchResult, error) {
+func (s *PoolService) GetMatchHistory(ctx context.Context, poolID model.PoolID, limit int) ([]*model.MatchResult, error) {
 	if limit <= 0 {
 		limit = 20
 	}
-	return s.poolRepo.GetMatchesByPool(ctx, poolID, limit)
+	return s.poolRepo.GetMatchesByPool(ctx, string(poolID), limit)
 }
 
 // ValidatePoolInGuild checks if a pool belongs to a guild
-func (s *PoolService) ValidatePoolInGuild(ctx context.Context, poolID, guildID string) (*model.MatchingPool, error) {
+func (s *PoolService) ValidatePoolInGuild(ctx context.Context, poolID model.PoolID, guildID model.GuildID) (*model.MatchingPool, error) {
 	pool, err := s.GetPool(ctx, poolID)
 	if err != nil {
 		return nil, err
@@ -468,35 +550,28 @@ func (s *PoolService) ValidatePoolInGuild(ctx context.Context, poolID, guildID s
 }
 
 // RunMatching executes the matching algorithm for a pool
-func (s *PoolService) RunMatching(ctx context.Context, poolID string) (*model.MatchRoundInfo, error) {
+func (s *PoolService) RunMatching(ctx context.Context, poolID model.PoolID) (*model.MatchRoundInfo, error) {
 	pool, err := s.GetPool(ctx, poolID)
 	if err != nil {
 		return nil, err
 	}
 
-	members, err := s.poolRepo.GetPoolMembers(ctx, poolID)
+	// Log the seed so a bad round can be replayed with ReplayMatching
+	seed := s.seeds.Uint64()
+	round := model.GetMatchRound(time.Now())
+	log.Printf("[PoolService] Matching pool %s round %s with seed %d", poolID, round, seed)
+
+	groups, err := s.matchGroups(ctx, pool, seed)
 	if err != nil {
 		return nil, err
 	}
 
-	// Need at least match_size members
-	if len(members) < pool.MatchSize {
-		return nil, ErrNotEnoughMembers
-	}
-
-	// Build scoring matrix
-	scores := s.buildScoringMatrix(ctx, members, pool)
-
-	// Run matching algorithm
-	groups := s.formGroups(members, scores, pool.MatchSize)
-
 	// Create match results
-	round := model.GetMatchRound(time.Now())
 	var matches []model.MatchResult
 
 	for _, group := range groups {
-		memberIDs := make([]string, len(group))
-		userIDs := make([]string, len(group))
+		memberIDs := make([]model.MemberID, len(group))
+		userIDs := make([]model.UserID, len(group))
 		for i, m := range group {
 			memberIDs[i] = m.MemberID
 			userIDs[i] = m.UserID
@@ -514,12 +589,27 @@ func (s *PoolService) RunMatching(ctx context.Context, poolID string) (*model.Ma
 			return nil, err
 		}
 		matches = append(matches, *match)
+
+		entries := make([]*model.ActivityEntry, 0, len(userIDs))
+		for _, userID := range userIDs {
+			entries = append(entries, &model.ActivityEntry{
+				UserID:      string(userID),
+				Type:        model.ActivityMatched,
+				SubjectType: model.ActivitySubjectMatch,
+				SubjectID:   string(match.ID),
+				Data: map[string]string{
+					"pool_id":   string(poolID),
+					"pool_name": pool.Name,
+				},
+			})
+		}
+		recordActivity(ctx, s.activity, entries...)
 	}
 
 	// Update pool's next match date and last match date
 	now := time.Now()
 	nextMatch := model.GetNextMatchDate(pool.Frequency, now)
-	_, err = s.poolRepo.UpdatePool(ctx, poolID, map[string]interface{}{
+	_, err = s.poolRepo.UpdatePool(ctx, string(poolID), map[string]interface{}{
 		"next_match_on": nextMatch,
 		"last_match_on": now,
 	})
@@ -532,11 +622,72 @@ func (s *PoolService) RunMatching(ctx context.Context, poolID string) (*model.Ma
 		PoolName:   pool.Name,
 		Round:      round,
 		RanOn:      now,
+		Seed:       seed,
 		MatchCount: len(matches),
 		Matches:    matches,
 	}, nil
 }
 
+// ReplayMatching re-forms a pool's groups with the seed logged for a past
+// round, without saving matches or moving the pool's schedule. Scores use
+// current data, so members who joined or left since, and the round's own
+// matches now counting against variety, can change the outcome.
+func (s *PoolService) ReplayMatching(ctx context.Context, poolID model.PoolID, seed uint64) (*model.MatchRoundInfo, error) {
+	pool, err := s.GetPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.matchGroups(ctx, pool, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]model.MatchResult, 0, len(groups))
+	for _, group := range groups {
+		match := model.MatchResult{
+			PoolID:        poolID,
+			Members:       make([]model.MemberID, len(group)),
+			MemberUserIDs: make([]model.UserID, len(group)),
+			Status:        model.MatchStatusPending,
+		}
+		for i, m := range group {
+			match.Members[i] = m.MemberID
+			match.MemberUserIDs[i] = m.UserID
+		}
+		matches = append(matches, match)
+	}
+
+	return &model.MatchRoundInfo{
+		PoolID:     poolID,
+		PoolName:   pool.Name,
+		RanOn:      time.Now(),
+		Seed:       seed,
+		MatchCount: len(matches),
+		Matches:    matches,
+	}, nil
+}
+
+// matchGroups scores a pool's active members and forms groups, shuffling
+// with the given seed
+func (s *PoolService) matchGroups(ctx context.Context, pool *model.MatchingPool, seed uint64) ([][]*model.PoolMember, error) {
+	members, err := s.poolRepo.GetPoolMembers(ctx, string(pool.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Need at least match_size members
+	if len(members) < pool.MatchSize {
+		return nil, ErrNotEnoughMembers
+	}
+
+	// Build scoring matrix
+	scores := s.buildScoringMatrix(ctx, members, pool)
+
+	// Run matching algorithm
+	return s.formGroups(members, scores, pool.MatchSize, seededRand(seed)), nil
+}
+
 // GetPoolsDueForMatching retrieves pools that need matching run
 func (s *PoolService) GetPoolsDueForMatching(ctx context.Context) ([]*model.MatchingPool, error) {
 	return s.poolRepo.GetPoolsDueForMatching(ctx)
@@ -544,17 +695,17 @@ func (s *PoolService) GetPoolsDueForMatching(ctx context.Context) ([]*model.Matc
 
 // buildScoringMatrix creates a scoring matrix between all members
 // Higher scores = better matches
-func (s *PoolService) buildScoringMatrix(ctx context.Context, members []*model.PoolMember, pool *model.MatchingPool) map[string]map[string]float64 {
-	scores := make(map[string]map[string]float64)
+func (s *PoolService) buildScoringMatrix(ctx context.Context, members []*model.PoolMember, pool *model.MatchingPool) map[model.MemberID]map[model.MemberID]float64 {
+	scores := make(map[model.MemberID]map[model.MemberID]float64)
 
 	for _, m := range members {
-		scores[m.MemberID] = make(map[string]float64)
+		scores[m.MemberID] = make(map[model.MemberID]float64)
 	}
 
 	// Build exclusion sets for quick lookup
-	exclusions := make(map[string]map[string]bool)
+	exclusions := make(map[model.MemberID]map[model.MemberID]bool)
 	for _, m := range members {
-		exclusions[m.MemberID] = make(map[string]bool)
+		exclusions[m.MemberID] = make(map[model.MemberID]bool)
 		for _, ex := range m.ExcludedMembers {
 			exclusions[m.MemberID][ex] = true
 		}
@@ -580,7 +731,7 @@ func (s *PoolService) buildScoringMatrix(ctx context.Context, members []*model.P
 
 			// Apply compatibility score if available
 			if s.compatibility != nil {
-				compat, err := s.compatibility.CalculateCompatibility(ctx, a.UserID, b.UserID)
+				compat, err := s.compatibility.CalculateCompatibility(ctx, string(a.UserID), string(b.UserID))
 				if err == nil && compat != nil {
 					// Blend compatibility: weight * compat + (1-weight) * base
 					score = s.config.CompatibilityWeight*compat.Score +
@@ -589,7 +740,7 @@ func (s *PoolService) buildScoringMatrix(ctx context.Context, members []*model.P
 			}
 
 			// Apply variety penalty for recent matches
-			recentMatches, err := s.poolRepo.GetRecentMatchesBetween(ctx, []string{a.MemberID, b.MemberID}, s.config.RecencyDays)
+			recentMatches, err := s.poolRepo.GetRecentMatchesBetween(ctx, []string{string(a.MemberID), string(b.MemberID)}, s.config.RecencyDays)
 			if err == nil && len(recentMatches) > 0 {
 				// Penalize based on number of recent matches
 				// Each recent match reduces score by variety_weight * 20
@@ -606,13 +757,18 @@ func (s *PoolService) buildScoringMatrix(ctx context.Context, members []*model.P
 }
 
 // formGroups uses a greedy algorithm to form groups
-func (s *PoolService) formGroups(members []*model.PoolMember, scores map[string]map[string]float64, groupSize int) [][]*model.PoolMember {
+func (s *PoolService) formGroups(members []*model.PoolMember, scores map[model.MemberID]map[model.MemberID]float64, groupSize int, rng *rand.Rand) [][]*model.PoolMember {
 	var groups [][]*model.PoolMember
 	remaining := make([]*model.PoolMember, len(members))
 	copy(remaining, members)
 
+	// Start from a fixed order so the shuffle depends only on the seed
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return remaining[i].MemberID < remaining[j].MemberID
+	})
+
 	// Shuffle to avoid bias
-	shuffleMembers(remaining)
+	shuffleMembers(remaining, rng)
 
 	for len(remaining) >= groupSize {
 		// Pick first remaining member
@@ -671,18 +827,18 @@ func (s *PoolService) formGroups(members []*model.PoolMember, scores map[string]
 }
 
 // shuffleMembers randomly shuffles the members slice
-func shuffleMembers(members []*model.PoolMember) {
-	// Use a simple Fisher-Yates shuffle with timestamp seed
-	n := len(members)
-	seed := time.Now().UnixNano()
-	for i := n - 1; i > 0; i-- {
-		seed = (seed*1103515245 + 12345) % (1 << 31)
-		j := int(seed) % (i + 1)
-		if j < 0 {
-			j = -j
-		}
+func shuffleMembers(members []*model.PoolMember, rng *rand.Rand) {
+	rng.Shuffle(len(members), func(i, j int) {
 		members[i], members[j] = members[j], members[i]
+	})
+}
+
+// validateTier checks that a pool in the guild can be gated on tierID
+func (s *PoolService) validateTier(ctx context.Context, guildID model.GuildID, tierID string) error {
+	if s.tiers == nil {
+		return ErrTierNotFound
 	}
+	return s.tiers.ValidateTier(ctx, string(guildID), tierID)
 }
 
 // isValidFrequency checks if a frequency string is valid
@@ -696,13 +852,13 @@ func isValidFrequency(freq string) bool {
 }
 
 // GetRoundMatches retrieves matches for a specific round
-func (s *PoolService) GetRoundMatches(ctx context.Context, poolID, round string) ([]*model.MatchResult, error) {
-	return s.poolRepo.GetMatchesByRound(ctx, poolID, round)
+func (s *PoolService) GetRoundMatches(ctx context.Context, poolID model.PoolID, round string) ([]*model.MatchResult, error) {
+	return s.poolRepo.GetMatchesByRound(ctx, string(poolID), round)
 }
 
 // GetMatchWithDetails retrieves a match with member names populated
-func (s *PoolService) GetMatchWithDetails(ctx context.Context, matchID string) (*model.MatchResult, error) {
-	match, err := s.poolRepo.GetMatchResult(ctx, matchID)
+func (s *PoolService) GetMatchWithDetails(ctx context.Context, matchID model.MatchID) (*model.MatchResult, error) {
+	match, err := s.poolRepo.GetMatchResult(ctx, string(matchID))
 	if err != nil {
 		return nil, err
 	}
@@ -727,7 +883,7 @@ func (s *PoolService) GetMatchWithDetails(ctx context.Context, matchID string) (
 	match.MemberNames = names
 
 	// Get pool name
-	pool, err := s.poolRepo.GetPool(ctx, match.PoolID)
+	pool, err := s.poolRepo.GetPool(ctx, string(match.PoolID))
 	if err == nil && pool != nil {
 		match.PoolName = &pool.Name
 	}
@@ -736,9 +892,9 @@ func (s *PoolService) GetMatchWithDetails(ctx context.Context, matchID string) (
 }
 
 // GetUserMatchHistory retrieves match history for a user in a pool
-func (s *PoolService) GetUserMatchHistory(ctx context.Context, poolID, userID string, days int) (*model.PoolMatchHistory, error) {
+func (s *PoolService) GetUserMatchHistory(ctx context.Context, poolID model.PoolID, userID model.UserID, days int) (*model.PoolMatchHistory, error) {
 	// Get member
-	member, err := s.poolRepo.GetMemberByUser(ctx, poolID, userID)
+	member, err := s.poolRepo.GetMemberByUser(ctx, string(poolID), string(userID))
 	if err != nil {
 		return nil, err
 	}
@@ -747,7 +903,7 @@ func (s *PoolService) GetUserMatchHistory(ctx context.Context, poolID, userID st
 	}
 
 	// Get recent matches
-	matches, err := s.poolRepo.GetMatchesByPool(ctx, poolID, 50)
+	matches, err := s.poolRepo.GetMatchesByPool(ctx, string(poolID), 50)
 	if err != nil {
 		return nil, err
 	}
@@ -755,7 +911,7 @@ func (s *PoolService) GetUserMatchHistory(ctx context.Context, poolID, userID st
 	// Filter to matches including this user
 	cutoff := time.Now().AddDate(0, 0, -days)
 	var userMatches []model.MatchResult
-	matchCounts := make(map[string]int)
+	matchCounts := make(map[model.MemberID]int)
 
 	for _, match := range matches {
 		if match.CreatedOn.Before(cutoff) {
@@ -3,10 +3,13 @@ package service
 import (
 	"context"
 	"errors"
+	"math/rand/v2"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
 )
 
 // ============================================================================
@@ -18,7 +21,7 @@ type mockPoolRepo struct {
 	getPoolFunc                 func(ctx context.Context, poolID string) (*model.MatchingPool, error)
 	getPoolsByGuildFunc         func(ctx context.Context, guildID string) ([]*model.MatchingPool, error)
 	updatePoolFunc              func(ctx context.Context, poolID string, updates map[string]interface{}) (*model.MatchingPool, error)
-	deletePoolFunc              func(ctx context.Context, poolID string) error
+	moveToTrashFunc             func(ctx context.Context, poolID, userID string) (bool, error)
 	countPoolsByGuildFunc       func(ctx context.Context, guildID string) (int, error)
 	addMemberFunc               func(ctx context.Context, member *model.PoolMember) error
 	getMemberFunc               func(ctx context.Context, poolID, memberID string) (*model.PoolMember, error)
@@ -67,11 +70,11 @@ func (m *mockPoolRepo) UpdatePool(ctx context.Context, poolID string, updates ma
 	return nil, nil
 }
 
-func (m *mockPoolRepo) DeletePool(ctx context.Context, poolID string) error {
-	if m.deletePoolFunc != nil {
-		return m.deletePoolFunc(ctx, poolID)
+func (m *mockPoolRepo) MoveToTrash(ctx context.Context, poolID, userID string) (bool, error) {
+	if m.moveToTrashFunc != nil {
+		return m.moveToTrashFunc(ctx, poolID, userID)
 	}
-	return nil
+	return true, nil
 }
 
 func (m *mockPoolRepo) CountPoolsByGuild(ctx context.Context, guildID string) (int, error) {
@@ -201,7 +204,10 @@ func (m *mockPoolRepo) GetStaleMatches(ctx context.Context, cutoff time.Time, st
 }
 
 type mockGuildRepo struct {
-	getByIDFunc func(ctx context.Context, id string) (*model.Guild, error)
+	getByIDFunc    func(ctx context.Context, id string) (*model.Guild, error)
+	isMemberFunc   func(ctx context.Context, userID, guildID string) (bool, error)
+	getMembersFunc func(ctx context.Context, guildID string) ([]*model.Member, error)
+	updateFunc     func(ctx context.Context, guild *model.Guild, version *int) (*model.Guild, error)
 }
 
 func (m *mockGuildRepo) Create(ctx context.Context, guild *model.Guild) error { return nil }
@@ -211,8 +217,13 @@ func (m *mockGuildRepo) GetByID(ctx context.Context, id string) (*model.Guild, e
 	}
 	return nil, nil
 }
-func (m *mockGuildRepo) Update(ctx context.Context, guild *model.Guild) error { return nil }
-func (m *mockGuildRepo) Delete(ctx context.Context, id string) error          { return nil }
+func (m *mockGuildRepo) Update(ctx context.Context, guild *model.Guild, version *int) (*model.Guild, error) {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, guild, version)
+	}
+	return guild, nil
+}
+func (m *mockGuildRepo) Delete(ctx context.Context, id string) error { return nil }
 func (m *mockGuildRepo) GetGuildsForUser(ctx context.Context, userID string) ([]*model.Guild, error) {
 	return nil, nil
 }
@@ -222,18 +233,27 @@ func (m *mockGuildRepo) CountGuildsForUser(ctx context.Context, userID string) (
 func (m *mockGuildRepo) AddMember(ctx context.Context, memberID, guildID string, pendingApproval bool) error {
 	return nil
 }
-func (m *mockGuildRepo) RemoveMember(ctx context.Context, memberID, guildID string) error {
-	return nil
+func (m *mockGuildRepo) RemoveMember(ctx context.Context, memberID, guildID string) (*model.GuildMembership, error) {
+	return &model.GuildMembership{MemberID: memberID, GuildID: guildID, Role: model.GuildRoleMember}, nil
 }
 func (m *mockGuildRepo) IsMember(ctx context.Context, userID, guildID string) (bool, error) {
+	if m.isMemberFunc != nil {
+		return m.isMemberFunc(ctx, userID, guildID)
+	}
 	return false, nil
 }
 func (m *mockGuildRepo) CountMembers(ctx context.Context, guildID string) (int, error) {
 	return 0, nil
 }
 func (m *mockGuildRepo) GetMembers(ctx context.Context, guildID string) ([]*model.Member, error) {
+	if m.getMembersFunc != nil {
+		return m.getMembersFunc(ctx, guildID)
+	}
 	return nil, nil
 }
+func (m *mockGuildRepo) GetMembersPage(ctx context.Context, guildID string, page model.PageRequest) (*model.Page[*model.Member], error) {
+	return &model.Page[*model.Member]{}, nil
+}
 func (m *mockGuildRepo) AddMemberWithRole(ctx context.Context, memberID, guildID string, role model.GuildRole, pendingApproval bool) error {
 	return nil
 }
@@ -530,7 +550,7 @@ func TestGetPool_Success(t *testing.T) {
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
 			return &model.MatchingPool{
-				ID:   poolID,
+				ID:   model.PoolID(poolID),
 				Name: "Test Pool",
 			}, nil
 		},
@@ -593,7 +613,7 @@ func TestJoinPool_Success(t *testing.T) {
 
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
-			return &model.MatchingPool{ID: poolID}, nil
+			return &model.MatchingPool{ID: model.PoolID(poolID)}, nil
 		},
 		getMemberFunc: func(ctx context.Context, poolID, memberID string) (*model.PoolMember, error) {
 			return nil, nil // Not a member
@@ -645,7 +665,7 @@ func TestJoinPool_AlreadyMember(t *testing.T) {
 
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
-			return &model.MatchingPool{ID: poolID}, nil
+			return &model.MatchingPool{ID: model.PoolID(poolID)}, nil
 		},
 		getMemberFunc: func(ctx context.Context, poolID, memberID string) (*model.PoolMember, error) {
 			return &model.PoolMember{ID: "existing", Active: true}, nil
@@ -667,7 +687,7 @@ func TestJoinPool_ReactivatesInactiveMember(t *testing.T) {
 	updateCalled := false
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
-			return &model.MatchingPool{ID: poolID}, nil
+			return &model.MatchingPool{ID: model.PoolID(poolID)}, nil
 		},
 		getMemberFunc: func(ctx context.Context, poolID, memberID string) (*model.PoolMember, error) {
 			return &model.PoolMember{ID: "existing", Active: false}, nil
@@ -677,7 +697,7 @@ func TestJoinPool_ReactivatesInactiveMember(t *testing.T) {
 			if updates["active"] != true {
 				t.Error("expected active to be set to true")
 			}
-			return &model.PoolMember{ID: membershipID, Active: true}, nil
+			return &model.PoolMember{ID: model.PoolMemberID(membershipID), Active: true}, nil
 		},
 	}
 
@@ -703,7 +723,7 @@ func TestJoinPool_MemberLimitReached(t *testing.T) {
 
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
-			return &model.MatchingPool{ID: poolID}, nil
+			return &model.MatchingPool{ID: model.PoolID(poolID)}, nil
 		},
 		getMemberFunc: func(ctx context.Context, poolID, memberID string) (*model.PoolMember, error) {
 			return nil, nil
@@ -725,7 +745,7 @@ func TestJoinPool_TruncatesExcessiveExclusions(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	exclusions := make([]string, model.MaxExclusionsPerMember+10)
+	exclusions := make([]model.MemberID, model.MaxExclusionsPerMember+10)
 	for i := range exclusions {
 		exclusions[i] = "excluded"
 	}
@@ -733,7 +753,7 @@ func TestJoinPool_TruncatesExcessiveExclusions(t *testing.T) {
 	var capturedMember *model.PoolMember
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
-			return &model.MatchingPool{ID: poolID}, nil
+			return &model.MatchingPool{ID: model.PoolID(poolID)}, nil
 		},
 		getMemberFunc: func(ctx context.Context, poolID, memberID string) (*model.PoolMember, error) {
 			return nil, nil
@@ -760,6 +780,35 @@ func TestJoinPool_TruncatesExcessiveExclusions(t *testing.T) {
 	}
 }
 
+func TestMemberIDForUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	svc := NewPoolService(PoolServiceConfig{
+		PoolRepo: &mockPoolRepo{},
+		MemberRepo: &mocks.MemberRepository{
+			GetByUserIDFunc: func(ctx context.Context, userID string) (*model.Member, error) {
+				if userID == "user:1" {
+					return &model.Member{ID: "member:9", UserID: userID}, nil
+				}
+				return nil, nil
+			},
+		},
+	})
+
+	memberID, err := svc.MemberIDForUser(ctx, "user:1")
+	if err != nil {
+		t.Fatalf("MemberIDForUser failed: %v", err)
+	}
+	if memberID != "member:9" {
+		t.Errorf("expected the user's member record, got %q", memberID)
+	}
+
+	if _, err := svc.MemberIDForUser(ctx, "user:2"); !errors.Is(err, ErrNoMemberRecord) {
+		t.Errorf("expected ErrNoMemberRecord, got %v", err)
+	}
+}
+
 // ============================================================================
 // LeavePool Tests
 // ============================================================================
@@ -838,12 +887,12 @@ func TestUpdateMatch_Success(t *testing.T) {
 	poolRepo := &mockPoolRepo{
 		getMatchResultFunc: func(ctx context.Context, matchID string) (*model.MatchResult, error) {
 			return &model.MatchResult{
-				ID:            matchID,
-				MemberUserIDs: []string{"user-1", "user-2"},
+				ID:            model.MatchID(matchID),
+				MemberUserIDs: []model.UserID{"user-1", "user-2"},
 			}, nil
 		},
 		updateMatchResultFunc: func(ctx context.Context, matchID string, updates map[string]interface{}) (*model.MatchResult, error) {
-			return &model.MatchResult{ID: matchID, Status: scheduled}, nil
+			return &model.MatchResult{ID: model.MatchID(matchID), Status: scheduled}, nil
 		},
 	}
 
@@ -860,6 +909,73 @@ func TestUpdateMatch_Success(t *testing.T) {
 	}
 }
 
+type recordingAnalyticsEmitter struct {
+	names    []model.AnalyticsEventName
+	subjects []string
+}
+
+func (e *recordingAnalyticsEmitter) Emit(ctx context.Context, name model.AnalyticsEventName, userID string, properties map[string]interface{}) {
+	e.names = append(e.names, name)
+	e.subjects = append(e.subjects, userID)
+}
+
+func TestUpdateMatch_EmitsAnalyticsOnStatusChange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		from      string
+		to        string
+		wantEvent model.AnalyticsEventName
+	}{
+		{name: "accepted", from: model.MatchStatusPending, to: model.MatchStatusScheduled, wantEvent: model.AnalyticsMatchAccepted},
+		{name: "completed", from: model.MatchStatusScheduled, to: model.MatchStatusCompleted, wantEvent: model.AnalyticsMatchCompleted},
+		{name: "unchanged", from: model.MatchStatusScheduled, to: model.MatchStatusScheduled},
+		{name: "skipped", from: model.MatchStatusPending, to: model.MatchStatusSkipped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			poolRepo := &mockPoolRepo{
+				getMatchResultFunc: func(ctx context.Context, matchID string) (*model.MatchResult, error) {
+					return &model.MatchResult{ID: model.MatchID(matchID), Status: tt.from, MemberUserIDs: []model.UserID{"user-1", "user-2"}}, nil
+				},
+				updateMatchResultFunc: func(ctx context.Context, matchID string, updates map[string]interface{}) (*model.MatchResult, error) {
+					return &model.MatchResult{ID: model.MatchID(matchID), Status: tt.to}, nil
+				},
+			}
+			emitter := &recordingAnalyticsEmitter{}
+			svc := NewPoolService(PoolServiceConfig{
+				PoolRepo:   poolRepo,
+				GuildRepo:  &mockGuildRepo{},
+				MemberRepo: &mockMemberRepo{},
+				Analytics:  emitter,
+			})
+
+			status := tt.to
+			if _, err := svc.UpdateMatch(context.Background(), "match-1", "user-1", &model.UpdateMatchRequest{Status: &status}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantEvent == "" {
+				if len(emitter.names) != 0 {
+					t.Errorf("expected no analytics events, got %v", emitter.names)
+				}
+				return
+			}
+			if len(emitter.names) != 2 {
+				t.Fatalf("expected one event per member, got %v", emitter.names)
+			}
+			for _, name := range emitter.names {
+				if name != tt.wantEvent {
+					t.Errorf("expected %s, got %s", tt.wantEvent, name)
+				}
+			}
+		})
+	}
+}
+
 func TestUpdateMatch_MatchNotFound(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -885,8 +1001,8 @@ func TestUpdateMatch_NotMatchMember(t *testing.T) {
 	poolRepo := &mockPoolRepo{
 		getMatchResultFunc: func(ctx context.Context, matchID string) (*model.MatchResult, error) {
 			return &model.MatchResult{
-				ID:            matchID,
-				MemberUserIDs: []string{"user-2", "user-3"},
+				ID:            model.MatchID(matchID),
+				MemberUserIDs: []model.UserID{"user-2", "user-3"},
 			}, nil
 		},
 	}
@@ -912,13 +1028,13 @@ func TestUpdateMatch_ScheduledTimeSetsStatusScheduled(t *testing.T) {
 	poolRepo := &mockPoolRepo{
 		getMatchResultFunc: func(ctx context.Context, matchID string) (*model.MatchResult, error) {
 			return &model.MatchResult{
-				ID:            matchID,
-				MemberUserIDs: []string{"user-1"},
+				ID:            model.MatchID(matchID),
+				MemberUserIDs: []model.UserID{"user-1"},
 			}, nil
 		},
 		updateMatchResultFunc: func(ctx context.Context, matchID string, updates map[string]interface{}) (*model.MatchResult, error) {
 			capturedUpdates = updates
-			return &model.MatchResult{ID: matchID}, nil
+			return &model.MatchResult{ID: model.MatchID(matchID)}, nil
 		},
 	}
 
@@ -945,7 +1061,7 @@ func TestValidatePoolInGuild_Success(t *testing.T) {
 
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
-			return &model.MatchingPool{ID: poolID, GuildID: "guild-1"}, nil
+			return &model.MatchingPool{ID: model.PoolID(poolID), GuildID: "guild-1"}, nil
 		},
 	}
 
@@ -966,7 +1082,7 @@ func TestValidatePoolInGuild_WrongGuild(t *testing.T) {
 
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
-			return &model.MatchingPool{ID: poolID, GuildID: "guild-1"}, nil
+			return &model.MatchingPool{ID: model.PoolID(poolID), GuildID: "guild-1"}, nil
 		},
 	}
 
@@ -1025,7 +1141,7 @@ func TestBuildScoringMatrix_WithExclusions(t *testing.T) {
 	svc := newTestPoolService(poolRepo, nil, nil, nil)
 
 	members := []*model.PoolMember{
-		{MemberID: "m1", UserID: "u1", ExcludedMembers: []string{"m2"}},
+		{MemberID: "m1", UserID: "u1", ExcludedMembers: []model.MemberID{"m2"}},
 		{MemberID: "m2", UserID: "u2"},
 		{MemberID: "m3", UserID: "u3"},
 	}
@@ -1123,14 +1239,14 @@ func TestFormGroups_PairsExactFit(t *testing.T) {
 	}
 
 	// All scores are equal and positive
-	scores := map[string]map[string]float64{
+	scores := map[model.MemberID]map[model.MemberID]float64{
 		"m1": {"m2": 100, "m3": 100, "m4": 100},
 		"m2": {"m1": 100, "m3": 100, "m4": 100},
 		"m3": {"m1": 100, "m2": 100, "m4": 100},
 		"m4": {"m1": 100, "m2": 100, "m3": 100},
 	}
 
-	groups := svc.formGroups(members, scores, 2)
+	groups := svc.formGroups(members, scores, 2, seededRand(1))
 
 	if len(groups) != 2 {
 		t.Errorf("expected 2 groups, got %d", len(groups))
@@ -1156,9 +1272,9 @@ func TestFormGroups_TriosWithLeftover(t *testing.T) {
 	}
 
 	// All positive scores
-	scores := make(map[string]map[string]float64)
+	scores := make(map[model.MemberID]map[model.MemberID]float64)
 	for _, m := range members {
-		scores[m.MemberID] = make(map[string]float64)
+		scores[m.MemberID] = make(map[model.MemberID]float64)
 		for _, n := range members {
 			if m.MemberID != n.MemberID {
 				scores[m.MemberID][n.MemberID] = 100
@@ -1166,7 +1282,7 @@ func TestFormGroups_TriosWithLeftover(t *testing.T) {
 		}
 	}
 
-	groups := svc.formGroups(members, scores, 3)
+	groups := svc.formGroups(members, scores, 3, seededRand(1))
 
 	// 5 members, group size 3: should form 1 group of 3, leaving 2
 	if len(groups) != 1 {
@@ -1194,19 +1310,19 @@ func TestFormGroups_RespectsExclusions(t *testing.T) {
 	}
 
 	// m1 and m2 exclude each other, but m3 and m4 are fine
-	scores := map[string]map[string]float64{
+	scores := map[model.MemberID]map[model.MemberID]float64{
 		"m1": {"m2": -1, "m3": 100, "m4": 100},
 		"m2": {"m1": -1, "m3": 100, "m4": 100},
 		"m3": {"m1": 100, "m2": 100, "m4": 100},
 		"m4": {"m1": 100, "m2": 100, "m3": 100},
 	}
 
-	groups := svc.formGroups(members, scores, 2)
+	groups := svc.formGroups(members, scores, 2, seededRand(1))
 
 	// Should form 2 groups, but m1 and m2 should never be paired together
 	for i, g := range groups {
 		hasBothExcluded := false
-		var memberIDs []string
+		var memberIDs []model.MemberID
 		for _, m := range g {
 			memberIDs = append(memberIDs, m.MemberID)
 			for _, other := range g {
@@ -1233,7 +1349,7 @@ func TestFormGroups_PicksBestScoring(t *testing.T) {
 	}
 
 	// m1-m2 has highest score
-	scores := map[string]map[string]float64{
+	scores := map[model.MemberID]map[model.MemberID]float64{
 		"m1": {"m2": 100, "m3": 50},
 		"m2": {"m1": 100, "m3": 50},
 		"m3": {"m1": 50, "m2": 50},
@@ -1242,7 +1358,7 @@ func TestFormGroups_PicksBestScoring(t *testing.T) {
 	// Run multiple times - due to shuffle, result may vary
 	// but the algorithm should always pick valid pairs
 	for i := 0; i < 10; i++ {
-		groups := svc.formGroups(members, scores, 2)
+		groups := svc.formGroups(members, scores, 2, seededRand(uint64(i)))
 		if len(groups) != 1 {
 			t.Errorf("iteration %d: expected 1 group, got %d", i, len(groups))
 		}
@@ -1252,6 +1368,62 @@ func TestFormGroups_PicksBestScoring(t *testing.T) {
 	}
 }
 
+func TestFormGroups_SameSeedSameGroups(t *testing.T) {
+	t.Parallel()
+
+	svc := newTestPoolService(nil, nil, nil, nil)
+
+	members := make([]*model.PoolMember, 8)
+	scores := make(map[model.MemberID]map[model.MemberID]float64)
+	for i := range members {
+		members[i] = &model.PoolMember{MemberID: model.MemberID(string(rune('a' + i)))}
+		scores[members[i].MemberID] = make(map[model.MemberID]float64)
+	}
+	for i, a := range members {
+		for j, b := range members {
+			if i != j {
+				scores[a.MemberID][b.MemberID] = float64((i + j) % 5 * 10)
+			}
+		}
+	}
+
+	// The order members arrive in doesn't change the outcome
+	reversed := make([]*model.PoolMember, len(members))
+	for i, m := range members {
+		reversed[len(members)-1-i] = m
+	}
+
+	want := groupIDs(svc.formGroups(members, scores, 2, seededRand(42)))
+	got := groupIDs(svc.formGroups(reversed, scores, 2, seededRand(42)))
+	if got != want {
+		t.Errorf("expected the same groups for the same seed, got %s and %s", want, got)
+	}
+
+	varied := false
+	for seed := uint64(0); seed < 10; seed++ {
+		if groupIDs(svc.formGroups(members, scores, 2, seededRand(seed))) != want {
+			varied = true
+			break
+		}
+	}
+	if !varied {
+		t.Error("expected different seeds to give different groups")
+	}
+}
+
+// groupIDs renders groups of members as "a+b,c+d" for comparison
+func groupIDs(groups [][]*model.PoolMember) string {
+	var rendered []string
+	for _, g := range groups {
+		ids := make([]model.MemberID, len(g))
+		for i, m := range g {
+			ids[i] = m.MemberID
+		}
+		rendered = append(rendered, strings.Join(model.IDStrings(ids), "+"))
+	}
+	return strings.Join(rendered, ",")
+}
+
 // ============================================================================
 // RunMatching Tests
 // ============================================================================
@@ -1264,7 +1436,7 @@ func TestRunMatching_Success(t *testing.T) {
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
 			return &model.MatchingPool{
-				ID:        poolID,
+				ID:        model.PoolID(poolID),
 				Name:      "Test Pool",
 				MatchSize: 2,
 				Frequency: model.PoolFrequencyWeekly,
@@ -1281,7 +1453,7 @@ func TestRunMatching_Success(t *testing.T) {
 		},
 		createMatchResultFunc: func(ctx context.Context, match *model.MatchResult) error {
 			matchesCreated++
-			match.ID = "match-" + string(rune('0'+matchesCreated))
+			match.ID = model.MatchID("match-" + string(rune('0'+matchesCreated)))
 			return nil
 		},
 		updatePoolFunc: func(ctx context.Context, poolID string, updates map[string]interface{}) (*model.MatchingPool, error) {
@@ -1313,7 +1485,7 @@ func TestRunMatching_NotEnoughMembers(t *testing.T) {
 	poolRepo := &mockPoolRepo{
 		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
 			return &model.MatchingPool{
-				ID:        poolID,
+				ID:        model.PoolID(poolID),
 				MatchSize: 2,
 			}, nil
 		},
@@ -1350,6 +1522,87 @@ func TestRunMatching_PoolNotFound(t *testing.T) {
 	}
 }
 
+func TestReplayMatching_ReproducesRound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var created []string
+	poolRepo := &mockPoolRepo{
+		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
+			return &model.MatchingPool{ID: model.PoolID(poolID), Name: "Test Pool", MatchSize: 2, Frequency: model.PoolFrequencyWeekly}, nil
+		},
+		getPoolMembersFunc: func(ctx context.Context, poolID string) ([]*model.PoolMember, error) {
+			members := make([]*model.PoolMember, 10)
+			for i := range members {
+				members[i] = &model.PoolMember{MemberID: model.MemberID(string(rune('a' + i))), UserID: model.UserID(string(rune('A' + i)))}
+			}
+			return members, nil
+		},
+		createMatchResultFunc: func(ctx context.Context, match *model.MatchResult) error {
+			created = append(created, strings.Join(model.IDStrings(match.Members), "+"))
+			return nil
+		},
+		updatePoolFunc: func(ctx context.Context, poolID string, updates map[string]interface{}) (*model.MatchingPool, error) {
+			return nil, nil
+		},
+	}
+	svc := NewPoolService(PoolServiceConfig{
+		PoolRepo:   poolRepo,
+		GuildRepo:  &mockGuildRepo{},
+		MemberRepo: &mockMemberRepo{},
+		Rand:       rand.NewPCG(7, 7),
+	})
+
+	round, err := svc.RunMatching(ctx, "pool-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	saved := strings.Join(created, ",")
+
+	created = nil
+	replay, err := svc.ReplayMatching(ctx, "pool-1", round.Seed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(created) != 0 {
+		t.Errorf("expected replay to save nothing, saved %v", created)
+	}
+
+	var replayed []string
+	for _, m := range replay.Matches {
+		replayed = append(replayed, strings.Join(model.IDStrings(m.Members), "+"))
+	}
+	if got := strings.Join(replayed, ","); got != saved {
+		t.Errorf("expected replay to reproduce %s, got %s", saved, got)
+	}
+	if replay.Seed != round.Seed {
+		t.Errorf("expected seed %d, got %d", round.Seed, replay.Seed)
+	}
+}
+
+func TestRunMatching_SeedsFromSource(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	poolRepo := &mockPoolRepo{
+		getPoolFunc: func(ctx context.Context, poolID string) (*model.MatchingPool, error) {
+			return &model.MatchingPool{ID: model.PoolID(poolID), MatchSize: 2, Frequency: model.PoolFrequencyWeekly}, nil
+		},
+		getPoolMembersFunc: func(ctx context.Context, poolID string) ([]*model.PoolMember, error) {
+			return []*model.PoolMember{{MemberID: "m1"}, {MemberID: "m2"}}, nil
+		},
+	}
+	newSvc := func() *PoolService {
+		return NewPoolService(PoolServiceConfig{PoolRepo: poolRepo, Rand: rand.NewPCG(1, 2)})
+	}
+
+	first, _ := newSvc().RunMatching(ctx, "pool-1")
+	again, _ := newSvc().RunMatching(ctx, "pool-1")
+	if first == nil || again == nil || first.Seed != again.Seed {
+		t.Fatalf("expected the same source to give the same round seed, got %+v and %+v", first, again)
+	}
+}
+
 // ============================================================================
 // UpdateMembership Tests
 // ============================================================================
@@ -1364,7 +1617,7 @@ func TestUpdateMembership_Success(t *testing.T) {
 			return &model.PoolMember{ID: "membership-1", Active: true}, nil
 		},
 		updateMemberFunc: func(ctx context.Context, membershipID string, updates map[string]interface{}) (*model.PoolMember, error) {
-			return &model.PoolMember{ID: membershipID, Active: false}, nil
+			return &model.PoolMember{ID: model.PoolMemberID(membershipID), Active: false}, nil
 		},
 	}
 
@@ -1385,7 +1638,7 @@ func TestUpdateMembership_ExclusionLimitReached(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	exclusions := make([]string, model.MaxExclusionsPerMember+1)
+	exclusions := make([]model.MemberID, model.MaxExclusionsPerMember+1)
 	for i := range exclusions {
 		exclusions[i] = "member"
 	}
@@ -1480,18 +1733,18 @@ func TestShuffleMembers_ChangesOrder(t *testing.T) {
 
 	members := make([]*model.PoolMember, 20)
 	for i := range members {
-		members[i] = &model.PoolMember{MemberID: string(rune('a' + i))}
+		members[i] = &model.PoolMember{MemberID: model.MemberID(string(rune('a' + i)))}
 	}
 
 	// Copy original order
-	original := make([]string, len(members))
+	original := make([]model.MemberID, len(members))
 	for i, m := range members {
 		original[i] = m.MemberID
 	}
 
-	shuffleMembers(members)
+	shuffleMembers(members, seededRand(1))
 
-	// Check that order changed (with high probability for 20 elements)
+	// Check that order changed
 	same := 0
 	for i, m := range members {
 		if m.MemberID == original[i] {
@@ -1515,12 +1768,12 @@ func TestShuffleMembers_PreservesElements(t *testing.T) {
 		{MemberID: "d"},
 	}
 
-	original := make(map[string]bool)
+	original := make(map[model.MemberID]bool)
 	for _, m := range members {
 		original[m.MemberID] = true
 	}
 
-	shuffleMembers(members)
+	shuffleMembers(members, seededRand(1))
 
 	// All elements should still be present
 	for _, m := range members {
@@ -1539,7 +1792,7 @@ func TestShuffleMembers_EmptySlice(t *testing.T) {
 	t.Parallel()
 
 	members := []*model.PoolMember{}
-	shuffleMembers(members) // Should not panic
+	shuffleMembers(members, seededRand(1)) // Should not panic
 
 	if len(members) != 0 {
 		t.Error("empty slice should remain empty")
@@ -1550,7 +1803,7 @@ func TestShuffleMembers_SingleElement(t *testing.T) {
 	t.Parallel()
 
 	members := []*model.PoolMember{{MemberID: "only"}}
-	shuffleMembers(members) // Should not panic
+	shuffleMembers(members, seededRand(1)) // Should not panic
 
 	if members[0].MemberID != "only" {
 		t.Error("single element should remain unchanged")
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+)
+
+// DefaultPresenceInterval is how often at most a user's last-active time is
+// written
+const DefaultPresenceInterval = 5 * time.Minute
+
+// PresenceRepository stores users' last-active times
+type PresenceRepository interface {
+	UpdateLastActive(ctx context.Context, userID string) error
+}
+
+// PresenceService keeps users' last-active times current, which drive the
+// activity status on profiles and in discovery. Every authenticated request
+// touches the user, and clients can send an explicit heartbeat while idle;
+// writes are throttled to one per user per interval on each instance.
+type PresenceService struct {
+	repo     PresenceRepository
+	interval time.Duration
+	clock    clock.Clock
+
+	mu        sync.Mutex
+	written   map[string]time.Time // userID -> last write
+	lastSweep time.Time
+}
+
+// PresenceServiceConfig holds configuration for the presence service
+type PresenceServiceConfig struct {
+	Repo     PresenceRepository
+	Interval time.Duration // Minimum time between writes per user (default 5m)
+	Clock    clock.Clock   // Default: the system clock
+}
+
+// NewPresenceService creates a new presence service
+func NewPresenceService(cfg PresenceServiceConfig) *PresenceService {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = DefaultPresenceInterval
+	}
+	return &PresenceService{
+		repo:     cfg.Repo,
+		interval: interval,
+		clock:    clock.OrReal(cfg.Clock),
+		written:  make(map[string]time.Time),
+	}
+}
+
+// Touch records that the user is active, unless it was recorded within the
+// interval. Failures are logged; the request carries on.
+func (s *PresenceService) Touch(ctx context.Context, userID string) {
+	if userID == "" || !s.claim(userID) {
+		return
+	}
+	if err := s.repo.UpdateLastActive(ctx, userID); err != nil {
+		log.Printf("[PresenceService] Failed to update last active for %s: %v", userID, err)
+	}
+}
+
+// claim reports whether the user is due a write, and if so marks it written.
+// Entries older than the interval are swept once per interval so the map
+// only holds recently active users.
+func (s *PresenceService) claim(userID string) bool {
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= s.interval {
+		for id, at := range s.written {
+			if now.Sub(at) >= s.interval {
+				delete(s.written, id)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	if at, ok := s.written[userID]; ok && now.Sub(at) < s.interval {
+		return false
+	}
+	s.written[userID] = now
+	return true
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Presence Tests
+// ============================================================================
+
+// newPresenceService returns a presence service on a settable clock and
+// counts its writes per user
+func newPresenceService(fail bool) (*PresenceService, map[string]int, *fakeclock.Clock) {
+	writes := make(map[string]int)
+	repo := &mocks.PresenceRepository{
+		UpdateLastActiveFunc: func(ctx context.Context, userID string) error {
+			writes[userID]++
+			if fail {
+				return errors.New("database unavailable")
+			}
+			return nil
+		},
+	}
+	clk := fakeclock.New(time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC))
+	return NewPresenceService(PresenceServiceConfig{Repo: repo, Clock: clk}), writes, clk
+}
+
+func TestPresenceTouch_Throttles(t *testing.T) {
+	t.Parallel()
+
+	svc, writes, clk := newPresenceService(false)
+	ctx := context.Background()
+
+	svc.Touch(ctx, "user:a")
+	svc.Touch(ctx, "user:a")
+	svc.Touch(ctx, "user:b")
+	if writes["user:a"] != 1 || writes["user:b"] != 1 {
+		t.Errorf("expected one write per user, got %v", writes)
+	}
+
+	clk.Advance(DefaultPresenceInterval - time.Second)
+	svc.Touch(ctx, "user:a")
+	if writes["user:a"] != 1 {
+		t.Errorf("expected no write within the interval, got %d", writes["user:a"])
+	}
+
+	clk.Advance(time.Second)
+	svc.Touch(ctx, "user:a")
+	if writes["user:a"] != 2 {
+		t.Errorf("expected a write once the interval passed, got %d", writes["user:a"])
+	}
+}
+
+func TestPresenceTouch_SweepsStaleUsers(t *testing.T) {
+	t.Parallel()
+
+	svc, _, clk := newPresenceService(false)
+	ctx := context.Background()
+
+	svc.Touch(ctx, "user:a")
+	clk.Advance(DefaultPresenceInterval)
+	svc.Touch(ctx, "user:b")
+
+	if _, ok := svc.written["user:a"]; ok {
+		t.Error("expected user:a to be swept once their write was an interval old")
+	}
+	if _, ok := svc.written["user:b"]; !ok {
+		t.Error("expected user:b to be kept")
+	}
+}
+
+func TestPresenceTouch_FailureStillThrottles(t *testing.T) {
+	t.Parallel()
+
+	svc, writes, _ := newPresenceService(true)
+	ctx := context.Background()
+
+	svc.Touch(ctx, "user:a")
+	svc.Touch(ctx, "user:a")
+	if writes["user:a"] != 1 {
+		t.Errorf("expected a failed write not to be retried on every request, got %d", writes["user:a"])
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/forgo/saga/api/internal/model"
 )
@@ -12,7 +13,7 @@ import (
 type ProfileRepository interface {
 	Create(ctx context.Context, profile *model.UserProfile) error
 	GetByUserID(ctx context.Context, userID string) (*model.UserProfile, error)
-	Update(ctx context.Context, userID string, updates map[string]interface{}) (*model.UserProfile, error)
+	Update(ctx context.Context, userID string, updates map[string]interface{}, version *int) (*model.UserProfile, error)
 	UpdateLastActive(ctx context.Context, userID string) error
 	Delete(ctx context.Context, userID string) error
 	GetNearby(ctx context.Context, minLat, maxLat, minLng, maxLng float64, limit int) ([]*model.UserProfile, error)
@@ -29,12 +30,44 @@ type ProfileGuildRepository interface {
 	GetGuildsForUser(ctx context.Context, userID string) ([]*model.Guild, error)
 }
 
+// ProfileReputationSource provides the reputation summary shown on profiles
+type ProfileReputationSource interface {
+	GetReputationDisplay(ctx context.Context, userID string) (*model.ReputationDisplay, error)
+}
+
+// ProfileInterestSource provides a user's interests for completeness scoring
+type ProfileInterestSource interface {
+	GetUserInterests(ctx context.Context, userID string) ([]*model.UserInterest, error)
+}
+
+// ProfileQuestionnaireSource provides a user's questionnaire progress for
+// completeness scoring
+type ProfileQuestionnaireSource interface {
+	GetQuestionProgress(ctx context.Context, userID string) (*model.QuestionProgress, error)
+}
+
+// ProfileAvailabilitySource provides a user's open availability windows for
+// completeness scoring
+type ProfileAvailabilitySource interface {
+	GetByUser(ctx context.Context, userID string) ([]*model.Availability, error)
+}
+
+// CompletenessSource scores how complete a user's profile is
+type CompletenessSource interface {
+	Completeness(ctx context.Context, userID string) (*model.ProfileCompleteness, error)
+}
+
 // ProfileService handles profile business logic
 type ProfileService struct {
 	profileRepo    ProfileRepository
 	userRepo       UserRepository
 	moderationRepo ProfileModerationRepository
 	guildRepo      ProfileGuildRepository
+	reputation     ProfileReputationSource
+	interests      ProfileInterestSource
+	questionnaire  ProfileQuestionnaireSource
+	availability   ProfileAvailabilitySource
+	consent        ConsentChecker
 	geoService     *GeoService
 }
 
@@ -44,6 +77,16 @@ type ProfileServiceConfig struct {
 	UserRepo       UserRepository
 	ModerationRepo ProfileModerationRepository
 	GuildRepo      ProfileGuildRepository
+	Reputation     ProfileReputationSource // Optional; adds reputation and badges to profile views
+
+	// Optional completeness inputs; a missing source scores its item as empty
+	Interests     ProfileInterestSource
+	Questionnaire ProfileQuestionnaireSource
+	Availability  ProfileAvailabilitySource
+
+	// Optional; locations are stored and used for nearby search without
+	// checking location processing consent when nil
+	Consent ConsentChecker
 }
 
 // NewProfileService creates a new profile service
@@ -53,6 +96,11 @@ func NewProfileService(cfg ProfileServiceConfig) *ProfileService {
 		userRepo:       cfg.UserRepo,
 		moderationRepo: cfg.ModerationRepo,
 		guildRepo:      cfg.GuildRepo,
+		reputation:     cfg.Reputation,
+		interests:      cfg.Interests,
+		questionnaire:  cfg.Questionnaire,
+		availability:   cfg.Availability,
+		consent:        cfg.Consent,
 		geoService:     NewGeoService(),
 	}
 }
@@ -135,7 +183,55 @@ func (s *ProfileService) GetOrCreateProfile(ctx context.Context, userID string)
 	return profile, nil
 }
 
-// UpdateProfile updates a user's profile
+// Completeness scores how much of the user's profile is filled in, and which
+// missing item would add the most
+func (s *ProfileService) Completeness(ctx context.Context, userID string) (*model.ProfileCompleteness, error) {
+	profile, err := s.GetOrCreateProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.CompletenessFor(ctx, profile)
+}
+
+// CompletenessFor scores an already loaded profile
+func (s *ProfileService) CompletenessFor(ctx context.Context, profile *model.UserProfile) (*model.ProfileCompleteness, error) {
+	in := model.CompletenessInput{
+		HasPhoto: profile.PhotoURL != nil && *profile.PhotoURL != "",
+		HasBio:   profile.Bio != nil && strings.TrimSpace(*profile.Bio) != "",
+	}
+
+	if s.interests != nil {
+		interests, err := s.interests.GetUserInterests(ctx, profile.UserID)
+		if err != nil {
+			return nil, err
+		}
+		in.InterestCount = len(interests)
+	}
+
+	if s.questionnaire != nil {
+		progress, err := s.questionnaire.GetQuestionProgress(ctx, profile.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if progress != nil {
+			in.QuestionsAnswered = progress.AnsweredCount
+			in.MissingCategories = len(progress.RequiredCategories)
+		}
+	}
+
+	if s.availability != nil {
+		windows, err := s.availability.GetByUser(ctx, profile.UserID)
+		if err != nil {
+			return nil, err
+		}
+		in.HasOpenAvailability = len(windows) > 0
+	}
+
+	return model.NewProfileCompleteness(in), nil
+}
+
+// UpdateProfile updates a user's profile. With a version, the update is
+// rejected with a VersionConflictError if the profile has changed.
 func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, req *model.UpdateProfileRequest) (*model.UserProfile, error) {
 	// Validate fields
 	if req.Bio != nil && len(*req.Bio) > model.MaxBioLength {
@@ -144,18 +240,34 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, req *
 	if req.Tagline != nil && len(*req.Tagline) > model.MaxTaglineLength {
 		return nil, ErrTaglineTooLong
 	}
-	if len(req.Languages) > model.MaxLanguages {
+	if req.PhotoURL != nil && !model.IsValidPhotoURL(*req.PhotoURL) {
+		return nil, ErrInvalidPhotoURL
+	}
+	languages, ok := model.NormalizeLanguages(req.Languages)
+	if !ok {
+		return nil, ErrInvalidLanguage
+	}
+	if len(languages) > model.MaxLanguages {
 		return nil, ErrTooManyLanguages
 	}
+	if !model.ValidAccessibility(req.AccessibilityNeeds) {
+		return nil, ErrInvalidAccessibility
+	}
 	if req.Visibility != nil && !isValidVisibility(*req.Visibility) {
 		return nil, ErrInvalidVisibility
 	}
+	if req.Location != nil && !hasLocationConsent(ctx, s.consent, userID) {
+		return nil, ErrLocationConsentRequired
+	}
 
 	// Ensure profile exists
-	_, err := s.GetOrCreateProfile(ctx, userID)
+	profile, err := s.GetOrCreateProfile(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	if req.Version != nil && *req.Version != profile.Version {
+		return nil, &VersionConflictError{Current: profile}
+	}
 
 	// Build updates map
 	updates := make(map[string]interface{})
@@ -165,8 +277,11 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, req *
 	if req.Tagline != nil {
 		updates["tagline"] = *req.Tagline
 	}
-	if len(req.Languages) > 0 {
-		updates["languages"] = req.Languages
+	if req.PhotoURL != nil {
+		updates["photo_url"] = *req.PhotoURL
+	}
+	if len(languages) > 0 {
+		updates["languages"] = languages
 	}
 	if req.Timezone != nil {
 		updates["timezone"] = *req.Timezone
@@ -184,61 +299,96 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, req *
 	if req.Visibility != nil {
 		updates["visibility"] = *req.Visibility
 	}
+	if req.ShareEnabled != nil {
+		updates["share_enabled"] = *req.ShareEnabled
+	}
+	if len(req.AccessibilityNeeds) > 0 {
+		updates["accessibility_needs"] = req.AccessibilityNeeds
+	}
+	if req.ShareAccessibility != nil {
+		updates["share_accessibility"] = *req.ShareAccessibility
+	}
+	for _, field := range req.Clear {
+		updates[field] = nil
+	}
 
-	return s.profileRepo.Update(ctx, userID, updates)
+	updated, err := s.profileRepo.Update(ctx, userID, updates, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		// Lost a race with another edit since the version check above
+		current, err := s.GetOrCreateProfile(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, &VersionConflictError{Current: current}
+	}
+	return updated, nil
 }
 
-// GetPublicProfile retrieves another user's public profile with privacy controls
+// GetPublicProfile retrieves another user's public profile with privacy
+// controls, including their reputation summary and badges
 func (s *ProfileService) GetPublicProfile(ctx context.Context, viewerID, targetUserID string, viewerLocation *model.LocationInternal) (*model.PublicProfile, error) {
-	// SECURITY: Check if users have blocked each other
-	if s.isBlocked(ctx, viewerID, targetUserID) {
-		return nil, ErrProfileNotFound
+	public, err := s.getPublicProfile(ctx, viewerID, targetUserID, viewerLocation)
+	if err != nil {
+		return nil, err
 	}
+	s.attachReputation(ctx, public)
+	return public, nil
+}
 
-	// Get target profile
+// GetSharedProfile retrieves a profile for an unauthenticated share link.
+// Only profiles whose owner enabled sharing are returned, with the reduced
+// anonymous field set.
+func (s *ProfileService) GetSharedProfile(ctx context.Context, targetUserID string) (*model.PublicProfile, error) {
 	profile, err := s.profileRepo.GetByUserID(ctx, targetUserID)
 	if err != nil {
 		return nil, err
 	}
-	if profile == nil {
+	if profile == nil || !profile.VisibleTo(model.ProfileAudienceAnonymous) {
 		return nil, ErrProfileNotFound
 	}
 
-	// Check visibility
-	if profile.Visibility == model.VisibilityPrivate {
-		return nil, ErrProfileNotFound
+	public, err := s.buildPublicProfile(ctx, profile, model.ProfileAudienceAnonymous)
+	if err != nil {
+		return nil, err
 	}
+	s.attachReputation(ctx, public)
+	return public, nil
+}
 
-	// SECURITY: Check guild membership if visibility is "guilds"
-	if profile.Visibility == model.VisibilityGuilds {
-		if !s.sharesGuild(ctx, viewerID, targetUserID) {
-			return nil, ErrProfileNotFound
-		}
+// getPublicProfile applies blocks and visibility tiers and serializes the
+// profile for the viewer, without the reputation lookup
+func (s *ProfileService) getPublicProfile(ctx context.Context, viewerID, targetUserID string, viewerLocation *model.LocationInternal) (*model.PublicProfile, error) {
+	// SECURITY: Check if users have blocked each other
+	if viewerID != targetUserID && s.isBlocked(ctx, viewerID, targetUserID) {
+		return nil, ErrProfileNotFound
 	}
 
-	// Get user details
-	user, err := s.userRepo.GetByID(ctx, targetUserID)
+	// Get target profile
+	profile, err := s.profileRepo.GetByUserID(ctx, targetUserID)
 	if err != nil {
 		return nil, err
 	}
+	if profile == nil {
+		return nil, ErrProfileNotFound
+	}
 
-	// Build public profile
-	public := &model.PublicProfile{
-		UserID:    targetUserID,
-		Firstname: user.Firstname,
-		Bio:       profile.Bio,
-		Tagline:   profile.Tagline,
-		Languages: profile.Languages,
+	// SECURITY: Check visibility for the viewer's tier
+	audience := s.audienceFor(ctx, viewerID, profile)
+	if !profile.VisibleTo(audience) {
+		return nil, ErrProfileNotFound
 	}
 
-	// Add city/country (never exact location)
-	if profile.Location != nil {
-		public.City = profile.Location.City
-		public.Country = profile.Location.Country
+	public, err := s.buildPublicProfile(ctx, profile, audience)
+	if err != nil {
+		return nil, err
 	}
 
-	// Calculate distance bucket if both have locations
-	if viewerLocation != nil && profile.Location != nil {
+	// Calculate distance bucket if both have locations and the target
+	// allows theirs to be used
+	if viewerLocation != nil && profile.Location != nil && hasLocationConsent(ctx, s.consent, targetUserID) {
 		targetLocation := &model.LocationInternal{
 			Lat: profile.Location.Lat,
 			Lng: profile.Location.Lng,
@@ -249,12 +399,51 @@ func (s *ProfileService) GetPublicProfile(ctx context.Context, viewerID, targetU
 		}
 	}
 
-	// Add activity status
-	public.ActivityStatus = model.GetActivityStatus(profile.LastActive)
+	return public, nil
+}
+
+// audienceFor returns the closest visibility tier the viewer belongs to.
+// Guild membership is only looked up when it decides access.
+func (s *ProfileService) audienceFor(ctx context.Context, viewerID string, profile *model.UserProfile) model.ProfileAudience {
+	if viewerID == profile.UserID {
+		return model.ProfileAudienceSelf
+	}
+	if profile.Visibility == model.VisibilityGuilds && s.sharesGuild(ctx, viewerID, profile.UserID) {
+		return model.ProfileAudienceGuild
+	}
+	return model.ProfileAudienceMember
+}
+
+// buildPublicProfile serializes the profile for the audience with the owner's names
+func (s *ProfileService) buildPublicProfile(ctx context.Context, profile *model.UserProfile, audience model.ProfileAudience) (*model.PublicProfile, error) {
+	user, err := s.userRepo.GetByID(ctx, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrProfileNotFound
+	}
 
+	public := profile.ToPublicFor(audience)
+	public.Username = user.Username
+	public.Firstname = user.Firstname
 	return public, nil
 }
 
+// attachReputation adds the reputation summary and badges when available.
+// Reputation is supplementary, so lookup failures leave the profile without it.
+func (s *ProfileService) attachReputation(ctx context.Context, public *model.PublicProfile) {
+	if s.reputation == nil {
+		return
+	}
+	display, err := s.reputation.GetReputationDisplay(ctx, public.UserID)
+	if err != nil || display == nil {
+		return
+	}
+	public.Reputation = display
+	public.Badges = model.BadgesFromReputation(display)
+}
+
 // UpdateLastActive updates the user's last active timestamp
 func (s *ProfileService) UpdateLastActive(ctx context.Context, userID string) error {
 	return s.profileRepo.UpdateLastActive(ctx, userID)
@@ -286,7 +475,7 @@ func (s *ProfileService) GetNearbyProfiles(ctx context.Context, viewerID string,
 		}
 
 		// Get public profile with distance
-		public, err := s.GetPublicProfile(ctx, viewerID, profile.UserID, viewerLocation)
+		public, err := s.getPublicProfile(ctx, viewerID, profile.UserID, viewerLocation)
 		if err != nil {
 			continue
 		}
@@ -301,8 +490,13 @@ func (s *ProfileService) GetNearbyProfiles(ctx context.Context, viewerID string,
 	return result, nil
 }
 
-// GetLocationInternal gets a user's internal location (for distance calculations)
+// GetLocationInternal gets a user's internal location (for distance
+// calculations). Returns ErrLocationConsentRequired when the user hasn't
+// allowed their location to be used.
 func (s *ProfileService) GetLocationInternal(ctx context.Context, userID string) (*model.LocationInternal, error) {
+	if !hasLocationConsent(ctx, s.consent, userID) {
+		return nil, ErrLocationConsentRequired
+	}
 	return s.profileRepo.GetLocationInternal(ctx, userID)
 }
 
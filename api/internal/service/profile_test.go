@@ -0,0 +1,447 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockProfileRepo struct {
+	profiles map[string]*model.UserProfile
+	updates  map[string]interface{}
+}
+
+func (m *mockProfileRepo) Create(ctx context.Context, profile *model.UserProfile) error {
+	m.profiles[profile.UserID] = profile
+	return nil
+}
+
+func (m *mockProfileRepo) GetByUserID(ctx context.Context, userID string) (*model.UserProfile, error) {
+	return m.profiles[userID], nil
+}
+
+func (m *mockProfileRepo) Update(ctx context.Context, userID string, updates map[string]interface{}, version *int) (*model.UserProfile, error) {
+	profile := m.profiles[userID]
+	if profile == nil || (version != nil && *version != profile.Version) {
+		return nil, nil
+	}
+	m.updates = updates
+	profile.Version++
+	return profile, nil
+}
+
+func (m *mockProfileRepo) UpdateLastActive(ctx context.Context, userID string) error { return nil }
+func (m *mockProfileRepo) Delete(ctx context.Context, userID string) error           { return nil }
+
+func (m *mockProfileRepo) GetNearby(ctx context.Context, minLat, maxLat, minLng, maxLng float64, limit int) ([]*model.UserProfile, error) {
+	return nil, nil
+}
+
+func (m *mockProfileRepo) GetLocationInternal(ctx context.Context, userID string) (*model.LocationInternal, error) {
+	return nil, nil
+}
+
+// mockProfileGuildRepo maps users to the guilds they belong to
+type mockProfileGuildRepo struct {
+	guilds map[string][]string
+}
+
+func (m *mockProfileGuildRepo) GetGuildsForUser(ctx context.Context, userID string) ([]*model.Guild, error) {
+	var result []*model.Guild
+	for _, id := range m.guilds[userID] {
+		result = append(result, &model.Guild{ID: id})
+	}
+	return result, nil
+}
+
+type mockReputationSource struct {
+	display *model.ReputationDisplay
+}
+
+func (m *mockReputationSource) GetReputationDisplay(ctx context.Context, userID string) (*model.ReputationDisplay, error) {
+	return m.display, nil
+}
+
+func newProfileTestService(profile *model.UserProfile, guilds map[string][]string) *ProfileService {
+	firstname := "Ada"
+	users := newMockUserRepo()
+	users.users[profile.UserID] = &model.User{ID: profile.UserID, Firstname: &firstname}
+
+	return NewProfileService(ProfileServiceConfig{
+		ProfileRepo: &mockProfileRepo{profiles: map[string]*model.UserProfile{profile.UserID: profile}},
+		UserRepo:    users,
+		GuildRepo:   &mockProfileGuildRepo{guilds: guilds},
+		Reputation: &mockReputationSource{display: &model.ReputationDisplay{
+			TopTags: []model.TagCount{
+				{Tag: model.TagGreatHost, Label: "Great host", Count: 5},
+				{Tag: model.TagOnTime, Label: "Started/ended on time", Count: 1},
+			},
+		}},
+	})
+}
+
+func testProfile(visibility string) *model.UserProfile {
+	lastActive := time.Now()
+	return &model.UserProfile{
+		UserID:     "user:owner",
+		Visibility: visibility,
+		LastActive: &lastActive,
+		Location:   &model.Location{City: "Lisbon", Country: "Portugal"},
+	}
+}
+
+// ============================================================================
+// GetPublicProfile Tests
+// ============================================================================
+
+func TestGetPublicProfile_PrivateVisibleOnlyToSelf(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	svc := newProfileTestService(testProfile(model.VisibilityPrivate), nil)
+
+	if _, err := svc.GetPublicProfile(ctx, "user:other", "user:owner", nil); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound for another user, got %v", err)
+	}
+	if _, err := svc.GetPublicProfile(ctx, "user:owner", "user:owner", nil); err != nil {
+		t.Errorf("expected owner to see their own profile, got %v", err)
+	}
+}
+
+func TestGetPublicProfile_GuildsVisibilityRequiresSharedGuild(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	svc := newProfileTestService(testProfile(model.VisibilityGuilds), map[string][]string{
+		"user:owner":     {"guild:1"},
+		"user:guildmate": {"guild:1"},
+		"user:stranger":  {"guild:2"},
+	})
+
+	if _, err := svc.GetPublicProfile(ctx, "user:stranger", "user:owner", nil); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound outside the guild, got %v", err)
+	}
+	public, err := svc.GetPublicProfile(ctx, "user:guildmate", "user:owner", nil)
+	if err != nil {
+		t.Fatalf("unexpected error for guild member: %v", err)
+	}
+	if public.City != "Lisbon" || public.ActivityStatus == "" {
+		t.Errorf("expected city and activity status for guild member, got %+v", public)
+	}
+}
+
+func TestGetPublicProfile_BlockedViewer(t *testing.T) {
+	t.Parallel()
+
+	svc := newProfileTestService(testProfile(model.VisibilityPublic), nil)
+	svc.moderationRepo = &mockBlockChecker{
+		isBlockedFunc: func(ctx context.Context, userID1, userID2 string) (bool, error) { return true, nil },
+	}
+
+	if _, err := svc.GetPublicProfile(context.Background(), "user:other", "user:owner", nil); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound for blocked viewer, got %v", err)
+	}
+}
+
+func TestGetPublicProfile_IncludesReputationAndBadges(t *testing.T) {
+	t.Parallel()
+
+	svc := newProfileTestService(testProfile(model.VisibilityPublic), nil)
+
+	public, err := svc.GetPublicProfile(context.Background(), "user:other", "user:owner", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if public.Reputation == nil {
+		t.Fatal("expected reputation summary")
+	}
+	if len(public.Badges) != 1 || public.Badges[0].Tag != model.TagGreatHost || public.Badges[0].Icon == "" {
+		t.Errorf("expected only the great host badge with an icon, got %+v", public.Badges)
+	}
+	if public.Firstname == nil || *public.Firstname != "Ada" {
+		t.Errorf("expected firstname from user record, got %v", public.Firstname)
+	}
+}
+
+// ============================================================================
+// GetSharedProfile Tests
+// ============================================================================
+
+func TestGetSharedProfile_RequiresOptIn(t *testing.T) {
+	t.Parallel()
+
+	svc := newProfileTestService(testProfile(model.VisibilityPublic), nil)
+
+	if _, err := svc.GetSharedProfile(context.Background(), "user:owner"); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound without opt-in, got %v", err)
+	}
+}
+
+func TestGetSharedProfile_PrivateOverridesOptIn(t *testing.T) {
+	t.Parallel()
+
+	profile := testProfile(model.VisibilityPrivate)
+	profile.ShareEnabled = true
+	svc := newProfileTestService(profile, nil)
+
+	if _, err := svc.GetSharedProfile(context.Background(), "user:owner"); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound for private profile, got %v", err)
+	}
+}
+
+func TestGetSharedProfile_OmitsSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	profile := testProfile(model.VisibilityGuilds)
+	profile.ShareEnabled = true
+	svc := newProfileTestService(profile, nil)
+
+	public, err := svc.GetSharedProfile(context.Background(), "user:owner")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if public.City != "" || public.ActivityStatus != "" {
+		t.Errorf("expected no city or activity status on share link, got %+v", public)
+	}
+	if public.Country != "Portugal" || len(public.Badges) != 1 {
+		t.Errorf("expected country and badges on share link, got %+v", public)
+	}
+}
+
+// ============================================================================
+// UpdateProfile Version Tests
+// ============================================================================
+
+func TestUpdateProfile_StaleVersionConflicts(t *testing.T) {
+	t.Parallel()
+
+	profile := testProfile(model.VisibilityGuilds)
+	profile.Version = 3
+	svc := newProfileTestService(profile, nil)
+
+	stale := 2
+	bio := "Hello"
+	_, err := svc.UpdateProfile(context.Background(), "user:owner", &model.UpdateProfileRequest{Bio: &bio, Version: &stale})
+
+	var conflict *VersionConflictError
+	if !errors.As(err, &conflict) || !errors.Is(err, ErrVersionConflict) {
+		t.Fatalf("expected VersionConflictError, got %v", err)
+	}
+	if current, ok := conflict.Current.(*model.UserProfile); !ok || current.Version != 3 {
+		t.Errorf("expected the current profile at version 3, got %+v", conflict.Current)
+	}
+}
+
+func TestUpdateProfile_MatchingVersionApplies(t *testing.T) {
+	t.Parallel()
+
+	profile := testProfile(model.VisibilityGuilds)
+	profile.Version = 3
+	svc := newProfileTestService(profile, nil)
+
+	version := 3
+	bio := "Hello"
+	updated, err := svc.UpdateProfile(context.Background(), "user:owner", &model.UpdateProfileRequest{Bio: &bio, Version: &version})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Version != 4 {
+		t.Errorf("expected version 4, got %d", updated.Version)
+	}
+}
+
+func TestUpdateProfile_ClearedFieldsAreRemoved(t *testing.T) {
+	t.Parallel()
+
+	svc := newProfileTestService(testProfile(model.VisibilityGuilds), nil)
+
+	tagline := "Hiker"
+	_, err := svc.UpdateProfile(context.Background(), "user:owner", &model.UpdateProfileRequest{
+		Tagline: &tagline,
+		Clear:   model.ClearedFields{"bio", "location"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updates := svc.profileRepo.(*mockProfileRepo).updates
+	for _, field := range []string{"bio", "location"} {
+		if value, ok := updates[field]; !ok || value != nil {
+			t.Errorf("expected %s to be cleared, got %v", field, value)
+		}
+	}
+	if updates["tagline"] != "Hiker" {
+		t.Errorf("expected tagline to be set, got %v", updates["tagline"])
+	}
+}
+
+// ============================================================================
+// Completeness Tests
+// ============================================================================
+
+type mockCompletenessSources struct {
+	interests []*model.UserInterest
+	progress  *model.QuestionProgress
+	windows   []*model.Availability
+}
+
+func (m *mockCompletenessSources) GetUserInterests(ctx context.Context, userID string) ([]*model.UserInterest, error) {
+	return m.interests, nil
+}
+
+func (m *mockCompletenessSources) GetQuestionProgress(ctx context.Context, userID string) (*model.QuestionProgress, error) {
+	return m.progress, nil
+}
+
+func (m *mockCompletenessSources) GetByUser(ctx context.Context, userID string) ([]*model.Availability, error) {
+	return m.windows, nil
+}
+
+func TestProfileCompleteness(t *testing.T) {
+	t.Parallel()
+	photo, bio, blank := "https://cdn.example/ada.jpg", "Climber and cook", "   "
+	allMissing := &model.QuestionProgress{RequiredCategories: []string{"values", "social"}}
+
+	tests := []struct {
+		name         string
+		profile      model.UserProfile
+		sources      mockCompletenessSources
+		wantScore    int
+		wantNext     model.CompletenessItem // Empty when complete
+		wantUnlocked bool
+	}{
+		{
+			name:     "empty profile starts with the questionnaire",
+			sources:  mockCompletenessSources{progress: allMissing},
+			wantNext: model.CompletenessQuestionnaire,
+		},
+		{
+			name:    "blank bio does not count",
+			profile: model.UserProfile{Bio: &blank, PhotoURL: &photo},
+			sources: mockCompletenessSources{
+				interests: make([]*model.UserInterest, 1),
+				progress:  &model.QuestionProgress{AnsweredCount: 3},
+			},
+			// photo 20 + interests 1/3 of 20 + questionnaire 25
+			wantScore: 52,
+			wantNext:  model.CompletenessBio,
+		},
+		{
+			name:    "missing categories hold back questionnaire credit",
+			profile: model.UserProfile{Bio: &bio, PhotoURL: &photo},
+			sources: mockCompletenessSources{
+				interests: make([]*model.UserInterest, 4),
+				progress:  &model.QuestionProgress{AnsweredCount: 5, RequiredCategories: []string{"social"}},
+				windows:   []*model.Availability{{}},
+			},
+			// 20 + 20 + 20 + 2/3 of 25 + 15
+			wantScore:    92,
+			wantNext:     model.CompletenessQuestionnaire,
+			wantUnlocked: true,
+		},
+		{
+			name:    "complete profile",
+			profile: model.UserProfile{Bio: &bio, PhotoURL: &photo},
+			sources: mockCompletenessSources{
+				interests: make([]*model.UserInterest, 3),
+				progress:  &model.QuestionProgress{AnsweredCount: 3},
+				windows:   []*model.Availability{{}},
+			},
+			wantScore:    100,
+			wantUnlocked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			profile := tt.profile
+			profile.UserID = "user:1"
+			sources := tt.sources
+			svc := NewProfileService(ProfileServiceConfig{
+				ProfileRepo:   &mockProfileRepo{profiles: map[string]*model.UserProfile{"user:1": &profile}},
+				Interests:     &sources,
+				Questionnaire: &sources,
+				Availability:  &sources,
+			})
+
+			got, err := svc.Completeness(context.Background(), "user:1")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Score != tt.wantScore {
+				t.Errorf("expected score %d, got %d", tt.wantScore, got.Score)
+			}
+			if got.DiscoveryUnlocked != tt.wantUnlocked {
+				t.Errorf("expected discovery unlocked %v, got %v", tt.wantUnlocked, got.DiscoveryUnlocked)
+			}
+			switch {
+			case tt.wantNext == "" && got.NextStep != nil:
+				t.Errorf("expected no next step, got %+v", got.NextStep)
+			case tt.wantNext != "" && (got.NextStep == nil || got.NextStep.Item != tt.wantNext):
+				t.Errorf("expected next step %s, got %+v", tt.wantNext, got.NextStep)
+			}
+		})
+	}
+}
+
+func TestUpdateProfile_RejectsInsecurePhotoURL(t *testing.T) {
+	t.Parallel()
+	svc := newProfileTestService(testProfile(model.VisibilityPublic), nil)
+
+	photo := "http://cdn.example/ada.jpg"
+	_, err := svc.UpdateProfile(context.Background(), "user:owner", &model.UpdateProfileRequest{PhotoURL: &photo})
+	if !errors.Is(err, ErrInvalidPhotoURL) {
+		t.Errorf("expected ErrInvalidPhotoURL, got %v", err)
+	}
+}
+
+func TestUpdateProfile_RejectsUnknownLanguage(t *testing.T) {
+	t.Parallel()
+	svc := newProfileTestService(testProfile(model.VisibilityPublic), nil)
+
+	_, err := svc.UpdateProfile(context.Background(), "user:owner", &model.UpdateProfileRequest{Languages: []string{"en", "klingon"}})
+	if !errors.Is(err, ErrInvalidLanguage) {
+		t.Errorf("expected ErrInvalidLanguage, got %v", err)
+	}
+}
+
+func TestUpdateProfile_RejectsUnknownAccessibilityNeed(t *testing.T) {
+	t.Parallel()
+	svc := newProfileTestService(testProfile(model.VisibilityPublic), nil)
+
+	_, err := svc.UpdateProfile(context.Background(), "user:owner", &model.UpdateProfileRequest{AccessibilityNeeds: []string{"elevator"}})
+	if !errors.Is(err, ErrInvalidAccessibility) {
+		t.Errorf("expected ErrInvalidAccessibility, got %v", err)
+	}
+}
+
+func TestProfileLocation_StopsWhenConsentWithdrawn(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	svc := newProfileTestService(testProfile(model.VisibilityPublic), nil)
+	consents := locationConsents(t, "user:owner")
+	svc.consent = consents
+
+	req := &model.UpdateProfileRequest{Location: &model.LocationRequest{Lat: 38.7, Lng: -9.1, City: "Lisbon", Country: "Portugal"}}
+	if _, err := svc.UpdateProfile(ctx, "user:owner", req); err != nil {
+		t.Fatalf("UpdateProfile() with consent error = %v", err)
+	}
+
+	if _, err := consents.Withdraw(ctx, "user:owner", model.ConsentLocationProcessing); err != nil {
+		t.Fatalf("Withdraw() error = %v", err)
+	}
+	if _, err := svc.UpdateProfile(ctx, "user:owner", req); !errors.Is(err, ErrLocationConsentRequired) {
+		t.Errorf("UpdateProfile() after withdrawal error = %v, want ErrLocationConsentRequired", err)
+	}
+	if _, err := svc.GetLocationInternal(ctx, "user:owner"); !errors.Is(err, ErrLocationConsentRequired) {
+		t.Errorf("GetLocationInternal() after withdrawal error = %v, want ErrLocationConsentRequired", err)
+	}
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Discounts
+// ============================================================================
+
+// discountedPayment prices the user's ticket to event. A promo code only
+// applies when it beats the user's member discount, so a member isn't
+// charged a redemption for a code that saved them nothing. Returns the
+// promo code applied, if any, for the caller to reserve.
+func (s *PaymentService) discountedPayment(ctx context.Context, event *model.Event, userID, code string) (*model.EventPayment, *model.PromoCode, error) {
+	payment := &model.EventPayment{
+		EventID:  event.ID,
+		UserID:   userID,
+		Amount:   event.TicketPrice,
+		Currency: event.Currency,
+	}
+
+	discount, err := s.memberDiscount(ctx, event, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if discount > 0 {
+		payment.DiscountSource = model.DiscountSourceMember
+	}
+
+	var promo *model.PromoCode
+	if code != "" {
+		promo, err = s.payments.GetPromoCodeByCode(ctx, event.ID, model.NormalizePromoCode(code))
+		if err != nil {
+			return nil, nil, err
+		}
+		if promo == nil {
+			return nil, nil, ErrPromoCodeNotFound
+		}
+		if !promo.UsableAt(s.clock.Now()) {
+			return nil, nil, ErrPromoCodeInvalid
+		}
+		if off := promo.DiscountOn(event.TicketPrice); off > discount {
+			discount = off
+			payment.DiscountSource = model.DiscountSourcePromoCode
+			payment.PromoCodeID = &promo.ID
+		} else {
+			promo = nil
+		}
+	}
+
+	payment.Amount = model.DiscountedPrice(event.TicketPrice, discount)
+	payment.Discount = event.TicketPrice - payment.Amount
+	return payment, promo, nil
+}
+
+// memberDiscount returns how much the user gets off a guild event's ticket
+// price for their role in the guild
+func (s *PaymentService) memberDiscount(ctx context.Context, event *model.Event, userID string) (int, error) {
+	if event.GuildID == nil || len(event.MemberDiscounts) == 0 || s.guilds == nil {
+		return 0, nil
+	}
+
+	isMember, err := s.guilds.IsMember(ctx, userID, *event.GuildID)
+	if err != nil || !isMember {
+		return 0, err
+	}
+	role, err := s.guilds.GetMemberRole(ctx, userID, *event.GuildID)
+	if err != nil {
+		return 0, err
+	}
+	return event.MemberDiscountFor(role), nil
+}
+
+// ============================================================================
+// Promo Codes
+// ============================================================================
+
+// CreatePromoCode adds a promo code to a paid event (host only)
+func (s *PaymentService) CreatePromoCode(ctx context.Context, userID, eventID string, req *model.CreatePromoCodeRequest) (*model.PromoCode, error) {
+	event, err := s.hostedEvent(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsPaid() {
+		return nil, ErrEventNotPaid
+	}
+
+	count, err := s.payments.CountPromoCodes(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= model.MaxPromoCodesPerEvent {
+		return nil, ErrTooManyPromoCodes
+	}
+
+	promo := &model.PromoCode{
+		EventID:        eventID,
+		Code:           model.NormalizePromoCode(req.Code),
+		DiscountType:   req.DiscountType,
+		Amount:         req.Amount,
+		MaxRedemptions: req.MaxRedemptions,
+		ExpiresOn:      req.ExpiresOn,
+		CreatedBy:      userID,
+	}
+	if err := s.payments.CreatePromoCode(ctx, promo); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, ErrPromoCodeExists
+		}
+		return nil, err
+	}
+	return promo, nil
+}
+
+// ListPromoCodes returns an event's promo codes (host only)
+func (s *PaymentService) ListPromoCodes(ctx context.Context, userID, eventID string) ([]*model.PromoCode, error) {
+	if _, err := s.hostedEvent(ctx, userID, eventID); err != nil {
+		return nil, err
+	}
+	return s.payments.ListPromoCodes(ctx, eventID)
+}
+
+// UpdatePromoCode changes a promo code's cap, expiry, or whether it is
+// active (host only)
+func (s *PaymentService) UpdatePromoCode(ctx context.Context, userID, eventID, promoID string, req *model.UpdatePromoCodeRequest) (*model.PromoCode, error) {
+	if _, err := s.hostedPromoCode(ctx, userID, eventID, promoID); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.MaxRedemptions != nil {
+		updates["max_redemptions"] = *req.MaxRedemptions
+	}
+	if req.ExpiresOn != nil {
+		updates["expires_on"] = *req.ExpiresOn
+	}
+	if req.Active != nil {
+		updates["active"] = *req.Active
+	}
+
+	promo, err := s.payments.UpdatePromoCode(ctx, promoID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if promo == nil {
+		return nil, ErrPromoCodeNotFound
+	}
+	return promo, nil
+}
+
+// DeletePromoCode deletes a promo code no one has redeemed (host only).
+// Redeemed codes stay for reporting and can be deactivated instead.
+func (s *PaymentService) DeletePromoCode(ctx context.Context, userID, eventID, promoID string) error {
+	if _, err := s.hostedPromoCode(ctx, userID, eventID, promoID); err != nil {
+		return err
+	}
+
+	deleted, err := s.payments.DeletePromoCode(ctx, promoID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrPromoCodeRedeemed
+	}
+	return nil
+}
+
+// SetMemberDiscounts replaces a guild event's member discounts (host only).
+// An empty list removes them.
+func (s *PaymentService) SetMemberDiscounts(ctx context.Context, userID, eventID string, req *model.SetMemberDiscountsRequest) ([]model.MemberDiscount, error) {
+	event, err := s.hostedEvent(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.GuildID == nil && len(req.Discounts) > 0 {
+		return nil, ErrNotGuildEvent
+	}
+
+	discounts := req.Discounts
+	if discounts == nil {
+		discounts = []model.MemberDiscount{}
+	}
+	if err := s.payments.SetMemberDiscounts(ctx, eventID, discounts); err != nil {
+		return nil, err
+	}
+	return discounts, nil
+}
+
+// ============================================================================
+// Reporting
+// ============================================================================
+
+// GetDiscountReport sums an event's ticket sales by the discount they got
+// (host only)
+func (s *PaymentService) GetDiscountReport(ctx context.Context, userID, eventID string) (*model.DiscountReport, error) {
+	event, err := s.hostedEvent(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	promos, err := s.payments.ListPromoCodes(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	settled, err := s.payments.GetSettledPaymentsByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildDiscountReport(event, promos, settled), nil
+}
+
+// buildDiscountReport totals settled payments overall, per promo code, and
+// for member discounts. Every code is listed, redeemed or not.
+func buildDiscountReport(event *model.Event, promos []*model.PromoCode, settled []*model.EventPayment) *model.DiscountReport {
+	report := &model.DiscountReport{
+		EventID:     event.ID,
+		Currency:    event.Currency,
+		PromoCodes:  make([]*model.PromoCodeReport, 0, len(promos)),
+		Redemptions: []*model.PromoRedemption{},
+	}
+
+	byID := make(map[string]*model.PromoCodeReport, len(promos))
+	for _, promo := range promos {
+		entry := &model.PromoCodeReport{PromoCodeID: promo.ID, Code: promo.Code}
+		byID[promo.ID] = entry
+		report.PromoCodes = append(report.PromoCodes, entry)
+	}
+
+	for _, payment := range settled {
+		refunded := payment.Status == model.PaymentStatusRefunded
+		report.Sales++
+		if !refunded {
+			report.Revenue += payment.Amount
+		}
+
+		if payment.DiscountSource == "" {
+			continue
+		}
+		report.Redemptions = append(report.Redemptions, &model.PromoRedemption{
+			PaymentID:      payment.ID,
+			UserID:         payment.UserID,
+			PromoCodeID:    payment.PromoCodeID,
+			DiscountSource: payment.DiscountSource,
+			Amount:         payment.Amount,
+			Discount:       payment.Discount,
+			Status:         payment.Status,
+			PaidOn:         payment.PaidOn,
+		})
+
+		totals := &report.Member
+		if payment.DiscountSource == model.DiscountSourcePromoCode {
+			entry := byID[derefString(payment.PromoCodeID)]
+			if entry == nil {
+				continue // The code was deleted with its event
+			}
+			totals = &entry.DiscountTotals
+		}
+		totals.Redemptions++
+		if refunded {
+			totals.Refunded++
+			continue
+		}
+		totals.DiscountTotal += payment.Discount
+		totals.Revenue += payment.Amount
+	}
+
+	return report
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// hostedEvent returns an event the user hosts
+func (s *PaymentService) hostedEvent(ctx context.Context, userID, eventID string) (*model.Event, error) {
+	event, err := s.events.Get(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+
+	isHost, err := s.events.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isHost {
+		return nil, ErrNotEventHost
+	}
+	return event, nil
+}
+
+// hostedPromoCode returns a promo code on an event the user hosts
+func (s *PaymentService) hostedPromoCode(ctx context.Context, userID, eventID, promoID string) (*model.PromoCode, error) {
+	if _, err := s.hostedEvent(ctx, userID, eventID); err != nil {
+		return nil, err
+	}
+
+	promo, err := s.payments.GetPromoCode(ctx, promoID)
+	if err != nil {
+		return nil, err
+	}
+	if promo == nil || promo.EventID != eventID {
+		return nil, ErrPromoCodeNotFound
+	}
+	return promo, nil
+}
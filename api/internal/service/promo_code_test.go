@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+	"github.com/forgo/saga/api/pkg/payments"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// heldTicketEvents serves event for a guest holding a ticket, waiting on
+// payment. claimed is set when the ticket is claimed without a checkout.
+func heldTicketEvents(event *model.Event, claimed *bool) *mocks.PaymentEventRepository {
+	pending := model.PaymentStatusPending
+	waiting := model.WaitingReasonPayment
+	return &mocks.PaymentEventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return event, nil
+		},
+		GetRSVPFunc: func(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+			return &model.EventRSVP{ID: "event_rsvp:1", Status: model.RSVPStatusPending, WaitingReason: &waiting, PaymentStatus: &pending}, nil
+		},
+		GetActiveTicketFunc: func(ctx context.Context, eventID, userID string) (*model.EventTicket, error) {
+			return &model.EventTicket{ID: "event_ticket:1", Status: model.TicketStatusHeld}, nil
+		},
+		ExtendTicketHoldFunc: func(ctx context.Context, ticketID string, until time.Time) (*model.EventTicket, error) {
+			return &model.EventTicket{ID: ticketID}, nil
+		},
+		ClaimTicketFunc: func(ctx context.Context, ticketID string) (*model.EventTicket, error) {
+			*claimed = true
+			return &model.EventTicket{ID: ticketID}, nil
+		},
+	}
+}
+
+// checkoutGuildRepo puts the guest in the event's guild with role, or
+// outside it when role is empty
+func checkoutGuildRepo(role model.GuildRole) *mocks.PaymentGuildRepository {
+	return &mocks.PaymentGuildRepository{
+		IsMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return role != "", nil
+		},
+		GetMemberRoleFunc: func(ctx context.Context, userID, guildID string) (model.GuildRole, error) {
+			return role, nil
+		},
+	}
+}
+
+func percentOff(code string, percent int) *model.PromoCode {
+	return &model.PromoCode{
+		ID:           "promo_code:1",
+		EventID:      "event:1",
+		Code:         code,
+		DiscountType: model.DiscountTypePercent,
+		Amount:       percent,
+		Active:       true,
+	}
+}
+
+// ============================================================================
+// Checkout Discount Tests
+// ============================================================================
+
+func TestCheckout_Discounts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		promo        *model.PromoCode
+		code         string
+		discounts    []model.MemberDiscount
+		role         model.GuildRole
+		wantAmount   int
+		wantDiscount int
+		wantSource   string
+		wantReserved bool // The promo code is redeemed
+		wantClaimed  bool // The ticket is claimed without a provider checkout
+	}{
+		{
+			name:         "promo code beats member discount",
+			promo:        percentOff("SPRING25", 25),
+			code:         " spring25 ",
+			discounts:    []model.MemberDiscount{{Role: model.GuildRoleMember, PercentOff: 10}},
+			role:         model.GuildRoleMember,
+			wantAmount:   1125,
+			wantDiscount: 375,
+			wantSource:   model.DiscountSourcePromoCode,
+			wantReserved: true,
+		},
+		{
+			name:  "member discount keeps promo code",
+			promo: percentOff("FRIENDS", 10),
+			code:  "FRIENDS",
+			discounts: []model.MemberDiscount{
+				{Role: model.GuildRoleMember, PercentOff: 5},
+				{Role: model.GuildRoleModerator, PercentOff: 20},
+			},
+			role:         model.GuildRoleAdmin,
+			wantAmount:   1200,
+			wantDiscount: 300,
+			wantSource:   model.DiscountSourceMember,
+		},
+		{
+			name:         "free ticket skips provider",
+			promo:        percentOff("COMP", 100),
+			code:         "COMP",
+			wantAmount:   0,
+			wantDiscount: 1500,
+			wantSource:   model.DiscountSourcePromoCode,
+			wantReserved: true,
+			wantClaimed:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := newPaidEvent()
+			event.MemberDiscounts = tt.discounts
+			var (
+				claimed  bool
+				reserved []string
+				created  *model.EventPayment
+				params   payments.CheckoutParams
+			)
+			svc := NewPaymentService(PaymentServiceConfig{
+				Provider: &recordingCheckoutProvider{fakePaymentProvider: &fakePaymentProvider{}, params: &params},
+				Payments: &mocks.PaymentRepository{
+					GetPayoutAccountFunc: func(ctx context.Context, userID string) (*model.PayoutAccount, error) {
+						return &model.PayoutAccount{UserID: userID, AccountID: "acct_1", ChargesEnabled: true}, nil
+					},
+					GetPromoCodeByCodeFunc: func(ctx context.Context, eventID, code string) (*model.PromoCode, error) {
+						if code != tt.promo.Code {
+							return nil, nil
+						}
+						return tt.promo, nil
+					},
+					ReservePromoCodeFunc: func(ctx context.Context, promoID string, now time.Time) (*model.PromoCode, error) {
+						reserved = append(reserved, promoID)
+						return tt.promo, nil
+					},
+					CreatePaymentFunc: func(ctx context.Context, payment *model.EventPayment) error {
+						payment.ID = "event_payment:1"
+						created = payment
+						return nil
+					},
+					MarkPaymentPaidFunc: func(ctx context.Context, paymentID, paymentIntentID string) (*model.EventPayment, error) {
+						paid := *created
+						paid.Status = model.PaymentStatusPaid
+						return &paid, nil
+					},
+				},
+				Events:    heldTicketEvents(event, &claimed),
+				Guilds:    checkoutGuildRepo(tt.role),
+				ReturnURL: "https://saga.example",
+				Clock:     fakeclock.New(paymentTestNow),
+			})
+
+			session, err := svc.Checkout(context.Background(), "user:a", "event:1", &model.CheckoutRequest{PromoCode: tt.code})
+			if err != nil {
+				t.Fatalf("Checkout failed: %v", err)
+			}
+
+			if session.Amount != tt.wantAmount || session.Discount != tt.wantDiscount || session.DiscountSource != tt.wantSource {
+				t.Errorf("session = %+v, want %d after a %d %s discount", session, tt.wantAmount, tt.wantDiscount, tt.wantSource)
+			}
+			if checkedOut := params.ClientReferenceID != ""; checkedOut == tt.wantClaimed || params.Amount != int64(tt.wantAmount) {
+				t.Errorf("provider checkout = %+v, want %d charged unless claimed for free", params, tt.wantAmount)
+			}
+			if claimed != tt.wantClaimed {
+				t.Errorf("claimed = %v, want %v", claimed, tt.wantClaimed)
+			}
+			if (len(reserved) == 1) != tt.wantReserved || (created.PromoCodeID != nil) != tt.wantReserved {
+				t.Errorf("reserved = %v, payment = %+v; want the code redeemed %v", reserved, created, tt.wantReserved)
+			}
+		})
+	}
+}
+
+func TestCheckout_RejectsUnusablePromoCode(t *testing.T) {
+	t.Parallel()
+
+	expired := paymentTestNow.Add(-time.Hour)
+	used := 5
+	tests := []struct {
+		name  string
+		code  string
+		promo *model.PromoCode
+		want  error
+	}{
+		{"unknown", "NOPE", percentOff("SPRING", 10), ErrPromoCodeNotFound},
+		{"expired", "SPRING", &model.PromoCode{ID: "promo_code:1", Code: "SPRING", Active: true, ExpiresOn: &expired}, ErrPromoCodeInvalid},
+		{"used up", "SPRING", &model.PromoCode{ID: "promo_code:1", Code: "SPRING", Active: true, MaxRedemptions: &used, Redemptions: 5}, ErrPromoCodeInvalid},
+		{"inactive", "SPRING", &model.PromoCode{ID: "promo_code:1", Code: "SPRING"}, ErrPromoCodeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var claimed bool
+			svc := NewPaymentService(PaymentServiceConfig{
+				Provider: &fakePaymentProvider{},
+				Payments: &mocks.PaymentRepository{
+					GetPayoutAccountFunc: func(ctx context.Context, userID string) (*model.PayoutAccount, error) {
+						return &model.PayoutAccount{UserID: userID, AccountID: "acct_1", ChargesEnabled: true}, nil
+					},
+					GetPromoCodeByCodeFunc: func(ctx context.Context, eventID, code string) (*model.PromoCode, error) {
+						if code != tt.promo.Code {
+							return nil, nil
+						}
+						return tt.promo, nil
+					},
+					ReservePromoCodeFunc: func(ctx context.Context, promoID string, now time.Time) (*model.PromoCode, error) {
+						t.Error("expected the code not to be redeemed")
+						return tt.promo, nil
+					},
+				},
+				Events:    heldTicketEvents(newPaidEvent(), &claimed),
+				Guilds:    checkoutGuildRepo(""),
+				ReturnURL: "https://saga.example",
+				Clock:     fakeclock.New(paymentTestNow),
+			})
+
+			_, err := svc.Checkout(context.Background(), "user:a", "event:1", &model.CheckoutRequest{PromoCode: tt.code})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestHandleWebhook_ExpiredCheckoutReleasesPromoCode(t *testing.T) {
+	t.Parallel()
+
+	promoID := "promo_code:1"
+	var released []string
+	repo := &mocks.PaymentRepository{
+		MarkPaymentExpiredFunc: func(ctx context.Context, paymentID string) (*model.EventPayment, error) {
+			return &model.EventPayment{ID: paymentID, PromoCodeID: &promoID, Status: model.PaymentStatusExpired}, nil
+		},
+		ReleasePromoCodeFunc: func(ctx context.Context, promoID string) error {
+			released = append(released, promoID)
+			return nil
+		},
+	}
+	event := &payments.WebhookEvent{ID: "evt_2", Type: payments.EventCheckoutExpired}
+	event.Data.Object = []byte(`{"id":"cs_1","client_reference_id":"event_payment:1"}`)
+
+	svc := newPaymentService(&fakePaymentProvider{webhook: event}, repo, &mocks.PaymentEventRepository{})
+	if err := svc.HandleWebhook(context.Background(), []byte(`{}`), "t=1,v1=00"); err != nil {
+		t.Fatalf("HandleWebhook failed: %v", err)
+	}
+
+	if len(released) != 1 || released[0] != promoID {
+		t.Errorf("expected the redemption given back, got %v", released)
+	}
+}
+
+// ============================================================================
+// Management Tests
+// ============================================================================
+
+func TestDeletePromoCode_Redeemed(t *testing.T) {
+	t.Parallel()
+
+	events := &mocks.PaymentEventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			return newPaidEvent(), nil
+		},
+		IsHostFunc: func(ctx context.Context, eventID, userID string) (bool, error) {
+			return userID == "user:host", nil
+		},
+	}
+	repo := &mocks.PaymentRepository{
+		GetPromoCodeFunc: func(ctx context.Context, promoID string) (*model.PromoCode, error) {
+			return &model.PromoCode{ID: promoID, EventID: "event:1", Redemptions: 3}, nil
+		},
+		DeletePromoCodeFunc: func(ctx context.Context, promoID string) (bool, error) {
+			return false, nil
+		},
+	}
+	svc := newPaymentService(&fakePaymentProvider{}, repo, events)
+
+	tests := []struct {
+		name    string
+		userID  string
+		wantErr error
+	}{
+		{"guest", "user:a", ErrNotEventHost},
+		{"host", "user:host", ErrPromoCodeRedeemed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := svc.DeletePromoCode(context.Background(), tt.userID, "event:1", "promo_code:1"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeletePromoCode() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetMemberDiscounts_RequiresGuildEvent(t *testing.T) {
+	t.Parallel()
+
+	events := &mocks.PaymentEventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			event := newPaidEvent()
+			event.GuildID = nil
+			return event, nil
+		},
+		IsHostFunc: func(ctx context.Context, eventID, userID string) (bool, error) {
+			return true, nil
+		},
+	}
+	svc := newPaymentService(&fakePaymentProvider{}, &mocks.PaymentRepository{}, events)
+
+	req := &model.SetMemberDiscountsRequest{Discounts: []model.MemberDiscount{{Role: model.GuildRoleMember, PercentOff: 10}}}
+	if _, err := svc.SetMemberDiscounts(context.Background(), "user:host", "event:1", req); !errors.Is(err, ErrNotGuildEvent) {
+		t.Errorf("expected ErrNotGuildEvent, got %v", err)
+	}
+}
+
+// ============================================================================
+// Reporting Tests
+// ============================================================================
+
+func TestBuildDiscountReport(t *testing.T) {
+	t.Parallel()
+
+	spring := "promo_code:spring"
+	promos := []*model.PromoCode{
+		{ID: spring, Code: "SPRING"},
+		{ID: "promo_code:unused", Code: "UNUSED"},
+	}
+	settled := []*model.EventPayment{
+		{ID: "p1", Amount: 1500, Status: model.PaymentStatusPaid},
+		{ID: "p2", Amount: 1125, Discount: 375, DiscountSource: model.DiscountSourcePromoCode, PromoCodeID: &spring, Status: model.PaymentStatusPaid},
+		{ID: "p3", Amount: 1125, Discount: 375, DiscountSource: model.DiscountSourcePromoCode, PromoCodeID: &spring, Status: model.PaymentStatusRefunded},
+		{ID: "p4", Amount: 1350, Discount: 150, DiscountSource: model.DiscountSourceMember, Status: model.PaymentStatusPaid},
+	}
+
+	report := buildDiscountReport(newPaidEvent(), promos, settled)
+
+	if report.Sales != 4 || report.Revenue != 1500+1125+1350 {
+		t.Errorf("expected 4 sales and refunds excluded from revenue, got %d, %d", report.Sales, report.Revenue)
+	}
+	code := report.PromoCodes[0].DiscountTotals
+	if code.Redemptions != 2 || code.Refunded != 1 || code.DiscountTotal != 375 || code.Revenue != 1125 {
+		t.Errorf("unexpected SPRING totals %+v", code)
+	}
+	if report.PromoCodes[1].Redemptions != 0 {
+		t.Errorf("expected the unused code listed with no redemptions, got %+v", report.PromoCodes[1])
+	}
+	if report.Member.Redemptions != 1 || report.Member.DiscountTotal != 150 {
+		t.Errorf("unexpected member totals %+v", report.Member)
+	}
+	if len(report.Redemptions) != 3 {
+		t.Errorf("expected 3 discounted sales listed, got %d", len(report.Redemptions))
+	}
+}
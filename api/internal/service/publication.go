@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// PublicationEventRepository publishes scheduled events
+type PublicationEventRepository interface {
+	PublishDue(ctx context.Context, now time.Time) ([]*model.Event, error)
+}
+
+// PublicationVoteRepository publishes scheduled votes
+type PublicationVoteRepository interface {
+	PublishDue(ctx context.Context, now time.Time) ([]*model.Vote, error)
+}
+
+// PublicationGuildRepository resolves who hears about a publication
+type PublicationGuildRepository interface {
+	GetMembers(ctx context.Context, guildID string) ([]*model.Member, error)
+}
+
+// PublicationService publishes events and votes that were scheduled to go
+// live at a future time, and tells the guild about them
+type PublicationService struct {
+	events      PublicationEventRepository
+	votes       PublicationVoteRepository
+	guilds      PublicationGuildRepository
+	eventHub    *EventHub
+	pushService *PushService
+	outbox      NotificationQueue
+	mutes       NotificationMutes
+	clock       clock.Clock
+}
+
+// PublicationServiceConfig holds configuration for the publication service
+type PublicationServiceConfig struct {
+	EventRepo   PublicationEventRepository
+	VoteRepo    PublicationVoteRepository
+	GuildRepo   PublicationGuildRepository
+	EventHub    *EventHub
+	PushService *PushService
+	Outbox      NotificationQueue // Optional; nil sends notifications inline
+	Mutes       NotificationMutes // Optional; nil delivers to every member
+	Clock       clock.Clock       // Default: the system clock
+}
+
+// NewPublicationService creates a new publication service
+func NewPublicationService(cfg PublicationServiceConfig) *PublicationService {
+	return &PublicationService{
+		events:      cfg.EventRepo,
+		votes:       cfg.VoteRepo,
+		guilds:      cfg.GuildRepo,
+		eventHub:    cfg.EventHub,
+		pushService: cfg.PushService,
+		outbox:      cfg.Outbox,
+		mutes:       cfg.Mutes,
+		clock:       clock.OrReal(cfg.Clock),
+	}
+}
+
+// ProcessDuePublications publishes scheduled events and votes whose publish
+// time has passed and notifies their guild's members. This should be called
+// periodically by a background job.
+func (s *PublicationService) ProcessDuePublications(ctx context.Context) error {
+	now := s.clock.Now()
+
+	events, err := s.events.PublishDue(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to publish due events: %w", err)
+	}
+	for _, event := range events {
+		s.notifyEventPublished(ctx, event)
+	}
+
+	votes, err := s.votes.PublishDue(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to publish due votes: %w", err)
+	}
+	for _, vote := range votes {
+		s.notifyVotePublished(ctx, vote)
+	}
+
+	return nil
+}
+
+// notifyEventPublished tells the guild about a newly published event.
+// Invite-only and private events are left to their hosts to share.
+func (s *PublicationService) notifyEventPublished(ctx context.Context, event *model.Event) {
+	if event.GuildID == nil {
+		return
+	}
+	if event.Visibility == model.EventVisibilityInviteOnly || event.Visibility == model.EventVisibilityPrivate {
+		return
+	}
+
+	scope := model.NotificationScope{GuildID: *event.GuildID, EventCategory: event.Template}
+	s.notifyGuild(ctx, scope, event.CreatedBy, Event{
+		Type: EventEventPublished,
+		Data: map[string]interface{}{
+			"event_id":   event.ID,
+			"guild_id":   *event.GuildID,
+			"title":      event.Title,
+			"start_time": event.StartTime,
+		},
+	}, &PushNotification{
+		Title: "New event",
+		Body:  event.Title,
+		Data: map[string]string{
+			"type":     string(EventEventPublished),
+			"event_id": event.ID,
+		},
+	})
+}
+
+// notifyVotePublished tells the guild about a newly published vote. Global
+// votes are not pushed to everyone; announcements cover that.
+func (s *PublicationService) notifyVotePublished(ctx context.Context, vote *model.Vote) {
+	if vote.ScopeType != model.VoteScopeGuild || vote.ScopeID == nil {
+		return
+	}
+
+	s.notifyGuild(ctx, model.NotificationScope{GuildID: *vote.ScopeID}, vote.CreatedBy, Event{
+		Type: EventVotePublished,
+		Data: map[string]interface{}{
+			"vote_id":   vote.ID,
+			"guild_id":  *vote.ScopeID,
+			"title":     vote.Title,
+			"status":    vote.Status,
+			"opens_at":  vote.OpensAt,
+			"closes_at": vote.ClosesAt,
+		},
+	}, &PushNotification{
+		Title: "New vote",
+		Body:  vote.Title,
+		Data: map[string]string{
+			"type":    string(EventVotePublished),
+			"vote_id": vote.ID,
+		},
+	})
+}
+
+// notifyGuild sends the in-app event and a push notification to every guild
+// member except the author and those who muted it
+func (s *PublicationService) notifyGuild(ctx context.Context, scope model.NotificationScope, authorID string, event Event, notification *PushNotification) {
+	guildID := scope.GuildID
+	members, err := s.guilds.GetMembers(ctx, guildID)
+	if err != nil {
+		log.Printf("[PublicationService] Failed to list members of guild %s: %v", guildID, err)
+		return
+	}
+
+	userIDs := make([]string, 0, len(members))
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if member.UserID == "" || member.UserID == authorID || seen[member.UserID] {
+			continue
+		}
+		seen[member.UserID] = true
+		userIDs = append(userIDs, member.UserID)
+	}
+	if s.mutes != nil {
+		kept, err := s.mutes.FilterMuted(ctx, userIDs, scope)
+		if err != nil {
+			log.Printf("[PublicationService] Failed to check mutes for guild %s: %v", guildID, err)
+		} else {
+			userIDs = kept
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, notificationsForUsers(userIDs, &event, notification)...)
+}
+
+// checkPublishAt validates a requested publish time: in the future, within
+// the scheduling window and before the item starts
+func checkPublishAt(now, publishAt, startsAt time.Time) error {
+	if !publishAt.After(now) || publishAt.After(now.AddDate(0, 0, model.MaxPublicationScheduleDays)) {
+		return ErrPublishAtInvalid
+	}
+	if publishAt.After(startsAt) {
+		return ErrPublishAtInvalid
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// mockPublicationEventRepo returns its due events once, like the status flip
+type mockPublicationEventRepo struct {
+	due []*model.Event
+}
+
+func (m *mockPublicationEventRepo) PublishDue(ctx context.Context, now time.Time) ([]*model.Event, error) {
+	due := m.due
+	m.due = nil
+	return due, nil
+}
+
+type mockPublicationVoteRepo struct {
+	due []*model.Vote
+	err error
+}
+
+func (m *mockPublicationVoteRepo) PublishDue(ctx context.Context, now time.Time) ([]*model.Vote, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	due := m.due
+	m.due = nil
+	return due, nil
+}
+
+func newPublicationGuildRepo(userIDs ...string) *mockGuildRepo {
+	return &mockGuildRepo{
+		getMembersFunc: func(ctx context.Context, guildID string) ([]*model.Member, error) {
+			members := make([]*model.Member, 0, len(userIDs))
+			for _, userID := range userIDs {
+				members = append(members, &model.Member{UserID: userID})
+			}
+			return members, nil
+		},
+	}
+}
+
+func newTestPublicationService(events *mockPublicationEventRepo, votes *mockPublicationVoteRepo, guilds *mockGuildRepo, hub *EventHub) *PublicationService {
+	return NewPublicationService(PublicationServiceConfig{
+		EventRepo: events,
+		VoteRepo:  votes,
+		GuildRepo: guilds,
+		EventHub:  hub,
+	})
+}
+
+func drainPublicationEvents(sub *Subscriber) []*Event {
+	var events []*Event
+	for {
+		select {
+		case e := <-sub.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+// ============================================================================
+// ProcessDuePublications Tests
+// ============================================================================
+
+func TestProcessDuePublications_NotifiesGuildOnce(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild:1"
+	events := &mockPublicationEventRepo{due: []*model.Event{{
+		ID:         "event:1",
+		GuildID:    &guildID,
+		Title:      "Board games",
+		Visibility: model.EventVisibilityGuilds,
+		Status:     model.EventStatusPublished,
+		CreatedBy:  "host",
+	}}}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	memberSub := hub.SubscribeUser("member", "sub-1")
+	hostSub := hub.SubscribeUser("host", "sub-2")
+
+	svc := newTestPublicationService(events, &mockPublicationVoteRepo{}, newPublicationGuildRepo("host", "member"), hub)
+
+	for i := 0; i < 2; i++ {
+		if err := svc.ProcessDuePublications(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	received := drainPublicationEvents(memberSub)
+	if len(received) != 1 {
+		t.Fatalf("expected 1 notification, got %d", len(received))
+	}
+	if received[0].Type != EventEventPublished {
+		t.Errorf("expected event type %s, got %s", EventEventPublished, received[0].Type)
+	}
+	if len(drainPublicationEvents(hostSub)) != 0 {
+		t.Error("expected the host not to be notified of their own event")
+	}
+}
+
+func TestProcessDuePublications_SkipsMutedMembers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild:1"
+	events := &mockPublicationEventRepo{due: []*model.Event{{
+		ID:         "event:1",
+		GuildID:    &guildID,
+		Template:   model.EventTemplateTrip,
+		Visibility: model.EventVisibilityGuilds,
+		CreatedBy:  "host",
+	}}}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	mutedSub := hub.SubscribeUser("muted", "sub-1")
+	memberSub := hub.SubscribeUser("member", "sub-2")
+
+	category := model.EventTemplateTrip
+	mutes := NewMuteService(MuteServiceConfig{Repo: &mocks.MuteRepository{
+		ListMutesForFunc: func(ctx context.Context, scope model.NotificationScope, now time.Time) ([]*model.Mute, error) {
+			return []*model.Mute{{UserID: "muted", Kind: model.MuteKindEventCategory, Category: &category}}, nil
+		},
+	}})
+	svc := NewPublicationService(PublicationServiceConfig{
+		EventRepo: events,
+		VoteRepo:  &mockPublicationVoteRepo{},
+		GuildRepo: newPublicationGuildRepo("muted", "member"),
+		EventHub:  hub,
+		Mutes:     mutes,
+	})
+
+	if err := svc.ProcessDuePublications(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(drainPublicationEvents(mutedSub)) != 0 {
+		t.Error("expected no notification for a member who muted the category")
+	}
+	if len(drainPublicationEvents(memberSub)) != 1 {
+		t.Error("expected other members to be notified")
+	}
+}
+
+func TestProcessDuePublications_SkipsInviteOnlyEvents(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild:1"
+	events := &mockPublicationEventRepo{due: []*model.Event{{
+		ID:         "event:1",
+		GuildID:    &guildID,
+		Visibility: model.EventVisibilityInviteOnly,
+		CreatedBy:  "host",
+	}}}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("member", "sub-1")
+
+	svc := newTestPublicationService(events, &mockPublicationVoteRepo{}, newPublicationGuildRepo("member"), hub)
+
+	if err := svc.ProcessDuePublications(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(drainPublicationEvents(sub)) != 0 {
+		t.Error("expected no notification for an invite-only event")
+	}
+}
+
+func TestProcessDuePublications_NotifiesGuildVotesOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild:1"
+	votes := &mockPublicationVoteRepo{due: []*model.Vote{
+		{ID: "vote:1", ScopeType: model.VoteScopeGuild, ScopeID: &guildID, Status: model.VoteStatusDraft, CreatedBy: "admin"},
+		{ID: "vote:2", ScopeType: model.VoteScopeGlobal, Status: model.VoteStatusOpen, CreatedBy: "admin"},
+	}}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("member", "sub-1")
+
+	svc := newTestPublicationService(&mockPublicationEventRepo{}, votes, newPublicationGuildRepo("member"), hub)
+
+	if err := svc.ProcessDuePublications(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	received := drainPublicationEvents(sub)
+	if len(received) != 1 {
+		t.Fatalf("expected 1 notification, got %d", len(received))
+	}
+	data, _ := received[0].Data.(map[string]interface{})
+	if received[0].Type != EventVotePublished || data["vote_id"] != "vote:1" {
+		t.Errorf("expected a vote.published event for vote:1, got %s %v", received[0].Type, data["vote_id"])
+	}
+}
+
+func TestProcessDuePublications_ReturnsRepositoryError(t *testing.T) {
+	t.Parallel()
+
+	votes := &mockPublicationVoteRepo{err: errors.New("boom")}
+	svc := newTestPublicationService(&mockPublicationEventRepo{}, votes, newPublicationGuildRepo(), nil)
+
+	if err := svc.ProcessDuePublications(context.Background()); err == nil {
+		t.Error("expected error when votes cannot be published")
+	}
+}
+
+// ============================================================================
+// checkPublishAt Tests
+// ============================================================================
+
+func TestCheckPublishAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	startsAt := now.Add(7 * 24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		publishAt time.Time
+		startsAt  time.Time
+		wantErr   bool
+	}{
+		{"future before start", now.Add(time.Hour), startsAt, false},
+		{"at start", startsAt, startsAt, false},
+		{"now", now, startsAt, true},
+		{"past", now.Add(-time.Hour), startsAt, true},
+		{"after start", startsAt.Add(time.Minute), startsAt, true},
+		{"beyond window", now.AddDate(0, 0, model.MaxPublicationScheduleDays+1), now.AddDate(1, 0, 0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := checkPublishAt(now, tt.publishAt, tt.startsAt)
+			if tt.wantErr && !errors.Is(err, ErrPublishAtInvalid) {
+				t.Errorf("expected ErrPublishAtInvalid, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
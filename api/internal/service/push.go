@@ -7,7 +7,9 @@ import (
 	"log"
 	"time"
 
+	"github.com/forgo/saga/api/internal/chaos"
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/requestid"
 )
 
 // Error definitions moved to errors.go
@@ -44,6 +46,7 @@ type DeviceTokenRepository interface {
 type PushService struct {
 	deviceRepo DeviceTokenRepository
 	enabled    bool
+	faults     *chaos.Injector
 }
 
 // PushServiceConfig holds configuration for the push service
@@ -51,6 +54,7 @@ type PushServiceConfig struct {
 	DeviceRepo         DeviceTokenRepository
 	Enabled            bool
 	FCMCredentialsPath string
+	Faults             *chaos.Injector // Optional fault injection for resilience tests
 }
 
 // NewPushService creates a new push service
@@ -58,6 +62,7 @@ func NewPushService(cfg PushServiceConfig) (*PushService, error) {
 	svc := &PushService{
 		deviceRepo: cfg.DeviceRepo,
 		enabled:    cfg.Enabled,
+		faults:     cfg.Faults,
 	}
 
 	if cfg.Enabled && cfg.FCMCredentialsPath != "" {
@@ -134,6 +139,13 @@ func (s *PushService) sendToDevice(ctx context.Context, device *model.DeviceToke
 		DeviceToken: device.Token,
 	}
 
+	// Injected faults look like the provider being unavailable
+	if err := s.faults.Inject(ctx, chaos.Push); err != nil {
+		result.Error = err.Error()
+		result.ShouldRetry = true
+		return result
+	}
+
 	// TODO: Replace with actual FCM implementation
 	// When Firebase is integrated, this would look like:
 	//
@@ -176,13 +188,15 @@ func (s *PushService) sendToDevice(ctx context.Context, device *model.DeviceToke
 	//     return result
 	// }
 	//
+	// // Pairs the provider's message ID with the request that caused the push
+	// log.Printf("[PushService] Sent FCM message %s (request %s)", messageID, requestid.FromContext(ctx))
 	// result.Success = true
 	// result.MessageID = messageID
 	// return result
 
 	// Stub implementation - log and succeed
-	log.Printf("[PushService] Would send push to %s (%s): %s - %s",
-		device.Platform, maskToken(device.Token), notification.Title, notification.Body)
+	log.Printf("[PushService] Would send push to %s (%s): %s - %s (request %s)",
+		device.Platform, maskToken(device.Token), notification.Title, notification.Body, requestid.FromContext(ctx))
 
 	result.Success = true
 	result.MessageID = fmt.Sprintf("stub_%d", time.Now().UnixNano())
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/forgo/saga/api/internal/chaos"
 	"github.com/forgo/saga/api/internal/model"
 )
 
@@ -169,6 +170,34 @@ func TestPushService_SendToUser_Success(t *testing.T) {
 	}
 }
 
+func TestPushService_SendToUser_InjectedFailure(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deviceRepo := &mockDeviceTokenRepo{
+		getByUserIDFunc: func(ctx context.Context, userID string) ([]*model.DeviceToken, error) {
+			return []*model.DeviceToken{{ID: "device-1", UserID: userID, Platform: model.PlatformIOS, Token: "apns-token-123", Active: true}}, nil
+		},
+		markInactiveFunc: func(ctx context.Context, token string) error {
+			t.Error("expected an unavailable provider not to deactivate the token")
+			return nil
+		},
+	}
+	svc, _ := NewPushService(PushServiceConfig{
+		DeviceRepo: deviceRepo,
+		Enabled:    true,
+		Faults:     chaos.NewInjector(map[chaos.Target]chaos.Rule{chaos.Push: {ErrorRate: 1}}, nil),
+	})
+
+	results, err := svc.SendToUser(ctx, "user-1", &PushNotification{Title: "Test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || results[0].Success || !results[0].ShouldRetry || results[0].Error == "" {
+		t.Errorf("expected a retryable failure, got %+v", results)
+	}
+}
+
 func TestPushService_SendToUser_MultipleDevices(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/forgo/saga/api/internal/model"
 )
@@ -13,7 +14,6 @@ import (
 type QuestionnaireRepository interface {
 	GetAllQuestions(ctx context.Context) ([]*model.Question, error)
 	GetQuestionsByCategory(ctx context.Context, category string) ([]*model.Question, error)
-	GetCircleQuestions(ctx context.Context, circleID string) ([]*model.Question, error)
 	GetQuestionByID(ctx context.Context, id string) (*model.Question, error)
 	CreateQuestion(ctx context.Context, question *model.Question) error
 	GetUserAnswer(ctx context.Context, userID, questionID string) (*model.Answer, error)
@@ -23,6 +23,7 @@ type QuestionnaireRepository interface {
 	UpdateAnswer(ctx context.Context, userID, questionID string, updates map[string]interface{}) (*model.Answer, error)
 	DeleteAnswer(ctx context.Context, userID, questionID string) error
 	GetSharedAnswers(ctx context.Context, userAID, userBID string) (map[string][2]*model.Answer, error)
+	GetAnswersForUsers(ctx context.Context, userIDs []string) (map[string][]*model.Answer, error)
 	GetUserBiasProfile(ctx context.Context, userID string) (*model.UserBiasProfile, error)
 	UpdateUserBiasProfile(ctx context.Context, userID string, accumulatedBias float64, answerCount int) error
 	GetQuestionProgress(ctx context.Context, userID string) (*model.QuestionProgress, error)
@@ -31,20 +32,28 @@ type QuestionnaireRepository interface {
 	CreateCircleValues(ctx context.Context, cv *model.CircleValues) error
 }
 
+// QuestionCategoryCatalog defines the category lookup used to validate categories
+type QuestionCategoryCatalog interface {
+	IsQuestionCategory(ctx context.Context, key string) (bool, error)
+}
+
 // QuestionnaireService handles questionnaire business logic
 type QuestionnaireService struct {
-	repo QuestionnaireRepository
+	repo    QuestionnaireRepository
+	catalog QuestionCategoryCatalog
 }
 
 // QuestionnaireServiceConfig holds configuration for the questionnaire service
 type QuestionnaireServiceConfig struct {
-	Repo QuestionnaireRepository
+	Repo    QuestionnaireRepository
+	Catalog QuestionCategoryCatalog // Optional; built-in categories are used when nil
 }
 
 // NewQuestionnaireService creates a new questionnaire service
 func NewQuestionnaireService(cfg QuestionnaireServiceConfig) *QuestionnaireService {
 	return &QuestionnaireService{
-		repo: cfg.Repo,
+		repo:    cfg.Repo,
+		catalog: cfg.Catalog,
 	}
 }
 
@@ -55,7 +64,11 @@ func (s *QuestionnaireService) GetAllQuestions(ctx context.Context) ([]*model.Qu
 
 // GetQuestionsByCategory retrieves questions by category
 func (s *QuestionnaireService) GetQuestionsByCategory(ctx context.Context, category string) ([]*model.Question, error) {
-	if !isValidQuestionCategory(category) {
+	valid, err := s.isQuestionCategory(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
 		return []*model.Question{}, nil
 	}
 	return s.repo.GetQuestionsByCategory(ctx, category)
@@ -67,7 +80,8 @@ func (s *QuestionnaireService) GetQuestion(ctx context.Context, id string) (*mod
 	if err != nil {
 		return nil, err
 	}
-	if question == nil {
+	// Guild questions are only reachable through their guild
+	if question == nil || question.GuildID != nil {
 		return nil, ErrQuestionNotFound
 	}
 	return question, nil
@@ -95,58 +109,13 @@ func (s *QuestionnaireService) AnswerQuestion(ctx context.Context, userID, quest
 	if err != nil {
 		return nil, err
 	}
-	if question == nil {
+	if question == nil || question.GuildID != nil {
 		return nil, ErrQuestionNotFound
 	}
 
-	// Validate selected option
-	if !isValidOption(question, req.SelectedOption) {
-		return nil, ErrInvalidOption
-	}
-
-	// Validate acceptable options
-	for _, opt := range req.AcceptableOptions {
-		if !isValidOption(question, opt) {
-			return nil, ErrInvalidOption
-		}
-	}
-
-	// Validate yikes options
-	for _, opt := range req.YikesOptions {
-		if !isValidOption(question, opt) {
-			return nil, ErrInvalidOption
-		}
-	}
-
-	// Validate importance
-	importance := req.Importance
-	if importance == "" {
-		importance = model.ImportanceSomewhat
-	}
-	if !isValidImportance(importance) {
-		return nil, ErrInvalidImportance
-	}
-
-	// Validate dealbreaker
-	if req.IsDealBreaker && !question.IsDealBreakerEligible {
-		return nil, ErrDealBreakerNotAllowed
-	}
-
-	// Validate alignment weight
-	alignmentWeight := model.DefaultAlignmentWeight
-	if req.AlignmentWeight != nil {
-		if *req.AlignmentWeight < model.MinAlignmentWeight || *req.AlignmentWeight > model.MaxAlignmentWeight {
-			return nil, ErrInvalidAlignmentWeight
-		}
-		alignmentWeight = *req.AlignmentWeight
-	}
-
-	// Default acceptable options to all if not specified
-	acceptableOptions := req.AcceptableOptions
-	if len(acceptableOptions) == 0 {
-		for _, opt := range question.Options {
-			acceptableOptions = append(acceptableOptions, opt.Value)
-		}
+	validated, err := buildAnswer(question, req)
+	if err != nil {
+		return nil, err
 	}
 
 	// Check if answer exists
@@ -158,27 +127,12 @@ func (s *QuestionnaireService) AnswerQuestion(ctx context.Context, userID, quest
 	var answer *model.Answer
 	if existingAnswer != nil {
 		// Update existing answer
-		updates := map[string]interface{}{
-			"selected_option":    req.SelectedOption,
-			"acceptable_options": acceptableOptions,
-			"importance":         importance,
-			"is_dealbreaker":     req.IsDealBreaker,
-			"alignment_weight":   alignmentWeight,
-			"yikes_options":      req.YikesOptions,
-		}
-		answer, err = s.repo.UpdateAnswer(ctx, userID, questionID, updates)
+		answer, err = s.repo.UpdateAnswer(ctx, userID, questionID, answerUpdates(validated))
 	} else {
 		// Create new answer
-		answer = &model.Answer{
-			UserID:            userID,
-			QuestionID:        questionID,
-			SelectedOption:    req.SelectedOption,
-			AcceptableOptions: acceptableOptions,
-			Importance:        importance,
-			IsDealBreaker:     req.IsDealBreaker,
-			AlignmentWeight:   alignmentWeight,
-			YikesOptions:      req.YikesOptions,
-		}
+		answer = validated
+		answer.UserID = userID
+		answer.QuestionID = questionID
 		err = s.repo.CreateAnswer(ctx, answer)
 	}
 
@@ -199,7 +153,7 @@ func (s *QuestionnaireService) UpdateAnswer(ctx context.Context, userID, questio
 	if err != nil {
 		return nil, err
 	}
-	if question == nil {
+	if question == nil || question.GuildID != nil {
 		return nil, ErrQuestionNotFound
 	}
 
@@ -321,40 +275,6 @@ func (s *QuestionnaireService) updateBiasProfile(ctx context.Context, userID str
 	_ = s.repo.UpdateUserBiasProfile(ctx, userID, accumulatedBias, answerCount)
 }
 
-// CreateCircleQuestion creates a question specific to a circle
-func (s *QuestionnaireService) CreateCircleQuestion(ctx context.Context, circleID, createdBy string, req *model.CreateCircleQuestionRequest) (*model.Question, error) {
-	// Validate category
-	if !isValidQuestionCategory(req.Category) {
-		return nil, errors.New("invalid category")
-	}
-
-	// Validate options
-	if len(req.Options) < 2 {
-		return nil, errors.New("question must have at least 2 options")
-	}
-
-	question := &model.Question{
-		Text:                  req.Text,
-		Category:              req.Category,
-		Options:               req.Options,
-		IsDealBreakerEligible: req.IsDealBreakerEligible,
-		Active:                true,
-		CircleID:              &circleID,
-		CreatedBy:             &createdBy,
-	}
-
-	if err := s.repo.CreateQuestion(ctx, question); err != nil {
-		return nil, err
-	}
-
-	return question, nil
-}
-
-// GetCircleQuestions retrieves questions for a specific circle
-func (s *QuestionnaireService) GetCircleQuestions(ctx context.Context, circleID string) ([]*model.Question, error) {
-	return s.repo.GetCircleQuestions(ctx, circleID)
-}
-
 // CreateCircleValues creates a circle values questionnaire
 func (s *QuestionnaireService) CreateCircleValues(ctx context.Context, circleID, createdBy string, req *model.CreateCircleValuesRequest) (*model.CircleValues, error) {
 	// Validate questions exist
@@ -394,8 +314,282 @@ func (s *QuestionnaireService) GetCircleValuesByCircle(ctx context.Context, circ
 	return s.repo.GetCircleValuesByCircle(ctx, circleID)
 }
 
+// ExportAnswers builds a portable export of a user's answers. Each answer is
+// stamped with the version of its question so a later import can detect
+// questions that changed in the meantime.
+func (s *QuestionnaireService) ExportAnswers(ctx context.Context, userID string) (*model.QuestionnaireExport, error) {
+	answers, err := s.repo.GetUserAnswers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.questionLookup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &model.QuestionnaireExport{
+		FormatVersion: model.QuestionnaireExportFormatVersion,
+		ExportedOn:    time.Now(),
+		Answers:       make([]model.ExportedAnswer, 0, len(answers)),
+	}
+
+	for _, answer := range answers {
+		alignmentWeight := answer.AlignmentWeight
+		exported := model.ExportedAnswer{
+			QuestionID:        answer.QuestionID,
+			SelectedOption:    answer.SelectedOption,
+			AcceptableOptions: answer.AcceptableOptions,
+			Importance:        answer.Importance,
+			IsDealBreaker:     answer.IsDealBreaker,
+			AlignmentWeight:   &alignmentWeight,
+			YikesOptions:      answer.YikesOptions,
+		}
+
+		question, err := questions.get(ctx, answer.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+		if question != nil {
+			exported.QuestionVersion = question.Version()
+		}
+
+		export.Answers = append(export.Answers, exported)
+	}
+
+	return export, nil
+}
+
+// ImportAnswers validates an exported answer set against the current questions
+// and saves the valid answers, overwriting existing answers to the same
+// questions. Answers that can't be applied are reported rather than failing the
+// import. With dryRun set nothing is written.
+func (s *QuestionnaireService) ImportAnswers(ctx context.Context, userID string, data *model.QuestionnaireExport, dryRun bool) (*model.QuestionnaireImportResult, error) {
+	questions, err := s.questionLookup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetUserAnswers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	answered := make(map[string]bool, len(existing))
+	for _, answer := range existing {
+		answered[answer.QuestionID] = true
+	}
+
+	result := &model.QuestionnaireImportResult{
+		DryRun:  dryRun,
+		Skipped: []model.SkippedAnswer{},
+	}
+
+	seen := make(map[string]bool, len(data.Answers))
+	for _, item := range data.Answers {
+		skip := func(reason string) {
+			result.Skipped = append(result.Skipped, model.SkippedAnswer{QuestionID: item.QuestionID, Reason: reason})
+		}
+
+		if seen[item.QuestionID] {
+			skip(model.ImportSkipDuplicate)
+			continue
+		}
+		seen[item.QuestionID] = true
+
+		question, err := questions.get(ctx, item.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case question == nil:
+			skip(model.ImportSkipQuestionNotFound)
+			continue
+		case !question.Active:
+			skip(model.ImportSkipQuestionRetired)
+			continue
+		case item.QuestionVersion != "" && item.QuestionVersion != question.Version():
+			skip(model.ImportSkipQuestionChanged)
+			continue
+		}
+
+		answer, err := buildAnswer(question, &model.AnswerQuestionRequest{
+			SelectedOption:    item.SelectedOption,
+			AcceptableOptions: item.AcceptableOptions,
+			Importance:        item.Importance,
+			IsDealBreaker:     item.IsDealBreaker,
+			AlignmentWeight:   item.AlignmentWeight,
+			YikesOptions:      item.YikesOptions,
+		})
+		if err != nil {
+			skip(importSkipReason(err))
+			continue
+		}
+
+		if answered[item.QuestionID] {
+			result.Updated++
+		} else {
+			result.Created++
+		}
+		if dryRun {
+			continue
+		}
+
+		if answered[item.QuestionID] {
+			_, err = s.repo.UpdateAnswer(ctx, userID, item.QuestionID, answerUpdates(answer))
+		} else {
+			answer.UserID = userID
+			answer.QuestionID = item.QuestionID
+			err = s.repo.CreateAnswer(ctx, answer)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !dryRun && result.Created+result.Updated > 0 {
+		go s.updateBiasProfile(context.Background(), userID)
+	}
+
+	return result, nil
+}
+
+// questionCache resolves questions by ID, preloaded with the active global
+// questions and falling back to a lookup for retired questions.
+// Guild questions resolve to nil.
+type questionCache struct {
+	repo      QuestionnaireRepository
+	questions map[string]*model.Question
+}
+
+func (s *QuestionnaireService) questionLookup(ctx context.Context) (*questionCache, error) {
+	all, err := s.repo.GetAllQuestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &questionCache{repo: s.repo, questions: make(map[string]*model.Question, len(all))}
+	for _, q := range all {
+		cache.questions[q.ID] = q
+	}
+	return cache, nil
+}
+
+func (c *questionCache) get(ctx context.Context, id string) (*model.Question, error) {
+	if q, ok := c.questions[id]; ok {
+		return q, nil
+	}
+
+	q, err := c.repo.GetQuestionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if q != nil && q.GuildID != nil {
+		q = nil
+	}
+	c.questions[id] = q
+	return q, nil
+}
+
+// isQuestionCategory checks a category against the catalog, falling back to
+// the built-in categories when no catalog is configured
+func (s *QuestionnaireService) isQuestionCategory(ctx context.Context, category string) (bool, error) {
+	if s.catalog == nil {
+		return isValidQuestionCategory(category), nil
+	}
+	return s.catalog.IsQuestionCategory(ctx, category)
+}
+
 // Helper functions
 
+// buildAnswer validates an answer request against its question and applies
+// defaults. The returned answer has no user or question ID set.
+func buildAnswer(question *model.Question, req *model.AnswerQuestionRequest) (*model.Answer, error) {
+	// Validate selected option
+	if !isValidOption(question, req.SelectedOption) {
+		return nil, ErrInvalidOption
+	}
+
+	// Validate acceptable options
+	for _, opt := range req.AcceptableOptions {
+		if !isValidOption(question, opt) {
+			return nil, ErrInvalidOption
+		}
+	}
+
+	// Validate yikes options
+	for _, opt := range req.YikesOptions {
+		if !isValidOption(question, opt) {
+			return nil, ErrInvalidOption
+		}
+	}
+
+	// Validate importance
+	importance := req.Importance
+	if importance == "" {
+		importance = model.ImportanceSomewhat
+	}
+	if !isValidImportance(importance) {
+		return nil, ErrInvalidImportance
+	}
+
+	// Validate dealbreaker
+	if req.IsDealBreaker && !question.IsDealBreakerEligible {
+		return nil, ErrDealBreakerNotAllowed
+	}
+
+	// Validate alignment weight
+	alignmentWeight := model.DefaultAlignmentWeight
+	if req.AlignmentWeight != nil {
+		if *req.AlignmentWeight < model.MinAlignmentWeight || *req.AlignmentWeight > model.MaxAlignmentWeight {
+			return nil, ErrInvalidAlignmentWeight
+		}
+		alignmentWeight = *req.AlignmentWeight
+	}
+
+	// Default acceptable options to all if not specified
+	acceptableOptions := req.AcceptableOptions
+	if len(acceptableOptions) == 0 {
+		for _, opt := range question.Options {
+			acceptableOptions = append(acceptableOptions, opt.Value)
+		}
+	}
+
+	return &model.Answer{
+		SelectedOption:    req.SelectedOption,
+		AcceptableOptions: acceptableOptions,
+		Importance:        importance,
+		IsDealBreaker:     req.IsDealBreaker,
+		AlignmentWeight:   alignmentWeight,
+		YikesOptions:      req.YikesOptions,
+	}, nil
+}
+
+// answerUpdates converts a validated answer into a full update map
+func answerUpdates(answer *model.Answer) map[string]interface{} {
+	return map[string]interface{}{
+		"selected_option":    answer.SelectedOption,
+		"acceptable_options": answer.AcceptableOptions,
+		"importance":         answer.Importance,
+		"is_dealbreaker":     answer.IsDealBreaker,
+		"alignment_weight":   answer.AlignmentWeight,
+		"yikes_options":      answer.YikesOptions,
+	}
+}
+
+// importSkipReason maps an answer validation error to an import skip reason
+func importSkipReason(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidImportance):
+		return model.ImportSkipInvalidImportance
+	case errors.Is(err, ErrDealBreakerNotAllowed):
+		return model.ImportSkipDealBreaker
+	case errors.Is(err, ErrInvalidAlignmentWeight):
+		return model.ImportSkipInvalidAlignment
+	default:
+		return model.ImportSkipInvalidOption
+	}
+}
+
 func isValidQuestionCategory(category string) bool {
 	switch category {
 	case model.QuestionCategoryValues,
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+func makeQuestion(id string, active bool, options ...string) *model.Question {
+	q := &model.Question{ID: id, Text: "Question " + id, Active: active}
+	for _, opt := range options {
+		q.Options = append(q.Options, model.QuestionOption{Value: opt, Label: opt})
+	}
+	return q
+}
+
+// importTestRepo returns a repo with two active questions, one retired
+// question and an existing answer to question:1
+func importTestRepo(created *[]*model.Answer, updated *[]string) *mockQuestionnaireRepo {
+	q1 := makeQuestion("question:1", true, "yes", "no")
+	q2 := makeQuestion("question:2", true, "often", "rarely")
+	retired := makeQuestion("question:old", false, "yes", "no")
+
+	return &mockQuestionnaireRepo{
+		getAllQuestionsFunc: func(ctx context.Context) ([]*model.Question, error) {
+			return []*model.Question{q1, q2}, nil
+		},
+		getQuestionByIDFunc: func(ctx context.Context, id string) (*model.Question, error) {
+			if id == retired.ID {
+				return retired, nil
+			}
+			return nil, nil
+		},
+		getUserAnswersFunc: func(ctx context.Context, userID string) ([]*model.Answer, error) {
+			return []*model.Answer{{UserID: userID, QuestionID: "question:1", SelectedOption: "no"}}, nil
+		},
+		createAnswerFunc: func(ctx context.Context, answer *model.Answer) error {
+			*created = append(*created, answer)
+			return nil
+		},
+		updateAnswerFunc: func(ctx context.Context, userID, questionID string, updates map[string]interface{}) (*model.Answer, error) {
+			*updated = append(*updated, questionID)
+			return &model.Answer{UserID: userID, QuestionID: questionID}, nil
+		},
+	}
+}
+
+func importPayload() *model.QuestionnaireExport {
+	return &model.QuestionnaireExport{
+		FormatVersion: model.QuestionnaireExportFormatVersion,
+		Answers: []model.ExportedAnswer{
+			{QuestionID: "question:1", QuestionVersion: makeQuestion("question:1", true, "yes", "no").Version(), SelectedOption: "yes"},
+			{QuestionID: "question:2", SelectedOption: "often", Importance: model.ImportanceVery},
+			{QuestionID: "question:2", SelectedOption: "rarely"},
+			{QuestionID: "question:old", SelectedOption: "yes"},
+			{QuestionID: "question:gone", SelectedOption: "yes"},
+		},
+	}
+}
+
+func skipReasons(result *model.QuestionnaireImportResult) map[string]string {
+	reasons := make(map[string]string, len(result.Skipped))
+	for _, s := range result.Skipped {
+		reasons[s.QuestionID] = s.Reason
+	}
+	return reasons
+}
+
+// ============================================================================
+// Import/Export Tests
+// ============================================================================
+
+func TestQuestionVersion_ChangesWithOptions(t *testing.T) {
+	t.Parallel()
+	a := makeQuestion("question:1", true, "yes", "no")
+	b := makeQuestion("question:1", true, "yes", "no", "maybe")
+
+	if a.Version() == b.Version() {
+		t.Error("expected version to change when options change")
+	}
+	if a.Version() != makeQuestion("question:1", false, "yes", "no").Version() {
+		t.Error("expected version to ignore non-content fields")
+	}
+}
+
+func TestImportAnswers_DryRunReportsWithoutWriting(t *testing.T) {
+	t.Parallel()
+	var created []*model.Answer
+	var updated []string
+	svc := NewQuestionnaireService(QuestionnaireServiceConfig{Repo: importTestRepo(&created, &updated)})
+
+	result, err := svc.ImportAnswers(context.Background(), "user:A", importPayload(), true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.DryRun || result.Created != 1 || result.Updated != 1 {
+		t.Errorf("expected dry run with 1 created and 1 updated, got %+v", result)
+	}
+	if len(created) != 0 || len(updated) != 0 {
+		t.Errorf("expected no writes in dry run, got %d creates and %d updates", len(created), len(updated))
+	}
+
+	reasons := skipReasons(result)
+	expected := map[string]string{
+		"question:2":    model.ImportSkipDuplicate,
+		"question:old":  model.ImportSkipQuestionRetired,
+		"question:gone": model.ImportSkipQuestionNotFound,
+	}
+	for questionID, reason := range expected {
+		if reasons[questionID] != reason {
+			t.Errorf("expected %s skipped as %s, got %q", questionID, reason, reasons[questionID])
+		}
+	}
+}
+
+func TestImportAnswers_SkipsChangedAndInvalidAnswers(t *testing.T) {
+	t.Parallel()
+	var created []*model.Answer
+	var updated []string
+	svc := NewQuestionnaireService(QuestionnaireServiceConfig{Repo: importTestRepo(&created, &updated)})
+
+	payload := &model.QuestionnaireExport{
+		FormatVersion: model.QuestionnaireExportFormatVersion,
+		Answers: []model.ExportedAnswer{
+			{QuestionID: "question:1", QuestionVersion: "stale", SelectedOption: "yes"},
+			{QuestionID: "question:2", SelectedOption: "never"},
+		},
+	}
+
+	result, err := svc.ImportAnswers(context.Background(), "user:A", payload, false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reasons := skipReasons(result)
+	if reasons["question:1"] != model.ImportSkipQuestionChanged {
+		t.Errorf("expected question:1 skipped as changed, got %q", reasons["question:1"])
+	}
+	if reasons["question:2"] != model.ImportSkipInvalidOption {
+		t.Errorf("expected question:2 skipped as invalid option, got %q", reasons["question:2"])
+	}
+	if len(created) != 0 || len(updated) != 0 {
+		t.Errorf("expected no writes, got %d creates and %d updates", len(created), len(updated))
+	}
+}
+
+func TestImportAnswers_WritesValidAnswers(t *testing.T) {
+	t.Parallel()
+	var created []*model.Answer
+	var updated []string
+	svc := NewQuestionnaireService(QuestionnaireServiceConfig{Repo: importTestRepo(&created, &updated)})
+
+	result, err := svc.ImportAnswers(context.Background(), "user:A", importPayload(), false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.DryRun {
+		t.Error("expected a real import")
+	}
+	if len(updated) != 1 || updated[0] != "question:1" {
+		t.Errorf("expected question:1 to be updated, got %v", updated)
+	}
+	if len(created) != 1 {
+		t.Fatalf("expected 1 created answer, got %d", len(created))
+	}
+	answer := created[0]
+	if answer.UserID != "user:A" || answer.QuestionID != "question:2" || answer.Importance != model.ImportanceVery {
+		t.Errorf("unexpected created answer: %+v", answer)
+	}
+	if len(answer.AcceptableOptions) != 2 {
+		t.Errorf("expected acceptable options to default to all options, got %v", answer.AcceptableOptions)
+	}
+}
+
+func TestExportAnswers_StampsQuestionVersion(t *testing.T) {
+	t.Parallel()
+	var created []*model.Answer
+	var updated []string
+	svc := NewQuestionnaireService(QuestionnaireServiceConfig{Repo: importTestRepo(&created, &updated)})
+
+	export, err := svc.ExportAnswers(context.Background(), "user:A")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if export.FormatVersion != model.QuestionnaireExportFormatVersion {
+		t.Errorf("expected format version %d, got %d", model.QuestionnaireExportFormatVersion, export.FormatVersion)
+	}
+	if len(export.Answers) != 1 {
+		t.Fatalf("expected 1 exported answer, got %d", len(export.Answers))
+	}
+	if export.Answers[0].QuestionVersion != makeQuestion("question:1", true, "yes", "no").Version() {
+		t.Errorf("expected question version to be stamped, got %q", export.Answers[0].QuestionVersion)
+	}
+}
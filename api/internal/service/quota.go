@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// QuotaTracker counts request cost against users' daily quotas. The quota
+// middleware's limiter implements it.
+type QuotaTracker interface {
+	Usage(userID string) model.QuotaUsage
+	TopUsage(n int) []model.QuotaUsage
+	SetLimit(userID string, limit int)
+	ClearLimit(userID string)
+	ResetUsage(userID string)
+}
+
+// QuotaRepository defines the interface for quota override storage
+type QuotaRepository interface {
+	Upsert(ctx context.Context, override *model.QuotaOverride) error
+	Get(ctx context.Context, userID string) (*model.QuotaOverride, error)
+	List(ctx context.Context) ([]*model.QuotaOverride, error)
+	Delete(ctx context.Context, userID string) (*model.QuotaOverride, error)
+}
+
+// QuotaUserRepository defines the user lookups the quota service needs
+type QuotaUserRepository interface {
+	GetByID(ctx context.Context, id string) (*model.User, error)
+}
+
+// QuotaService lets admins inspect and adjust users' daily request quotas.
+// Usage lives in the tracker; overrides are stored so they survive restarts.
+type QuotaService struct {
+	repo     QuotaRepository
+	tracker  QuotaTracker
+	userRepo QuotaUserRepository
+}
+
+// QuotaServiceConfig holds configuration for the quota service
+type QuotaServiceConfig struct {
+	Repo     QuotaRepository
+	Tracker  QuotaTracker
+	UserRepo QuotaUserRepository
+}
+
+// NewQuotaService creates a new quota service
+func NewQuotaService(cfg QuotaServiceConfig) *QuotaService {
+	return &QuotaService{
+		repo:     cfg.Repo,
+		tracker:  cfg.Tracker,
+		userRepo: cfg.UserRepo,
+	}
+}
+
+// LoadOverrides applies stored overrides to the tracker. Call it on startup.
+func (s *QuotaService) LoadOverrides(ctx context.Context) error {
+	overrides, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, override := range overrides {
+		s.tracker.SetLimit(override.UserID, override.DailyLimit)
+	}
+	return nil
+}
+
+// Get returns a user's usage for today
+func (s *QuotaService) Get(ctx context.Context, userID string) (*model.QuotaUsage, error) {
+	if err := s.requireUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	usage := s.tracker.Usage(userID)
+	return &usage, nil
+}
+
+// Top returns the heaviest users today, highest first
+func (s *QuotaService) Top(limit int) []model.QuotaUsage {
+	if limit <= 0 {
+		limit = model.DefaultQuotaTopLimit
+	}
+	if limit > model.MaxQuotaTopLimit {
+		limit = model.MaxQuotaTopLimit
+	}
+	return s.tracker.TopUsage(limit)
+}
+
+// SetLimit overrides a user's daily limit
+func (s *QuotaService) SetLimit(ctx context.Context, actorID, userID string, req *model.SetQuotaRequest) (*model.QuotaUsage, error) {
+	if err := s.requireUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	override := &model.QuotaOverride{
+		UserID:     userID,
+		DailyLimit: req.DailyLimit,
+		Reason:     req.Reason,
+		SetBy:      actorID,
+	}
+	if err := s.repo.Upsert(ctx, override); err != nil {
+		return nil, err
+	}
+
+	s.tracker.SetLimit(userID, req.DailyLimit)
+	usage := s.tracker.Usage(userID)
+	return &usage, nil
+}
+
+// ClearLimit puts a user back on the default daily limit
+func (s *QuotaService) ClearLimit(ctx context.Context, userID string) error {
+	override, err := s.repo.Delete(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if override == nil {
+		return ErrQuotaOverrideNotFound
+	}
+
+	s.tracker.ClearLimit(userID)
+	return nil
+}
+
+// ResetUsage forgives what a user has spent today
+func (s *QuotaService) ResetUsage(ctx context.Context, userID string) (*model.QuotaUsage, error) {
+	if err := s.requireUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	s.tracker.ResetUsage(userID)
+	usage := s.tracker.Usage(userID)
+	return &usage, nil
+}
+
+func (s *QuotaService) requireUser(ctx context.Context, userID string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+	return nil
+}
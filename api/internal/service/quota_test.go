@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ============================================================================
+// Mocks
+// ============================================================================
+
+type mockQuotaRepo struct {
+	overrides map[string]*model.QuotaOverride
+}
+
+func newMockQuotaRepo() *mockQuotaRepo {
+	return &mockQuotaRepo{overrides: map[string]*model.QuotaOverride{}}
+}
+
+func (m *mockQuotaRepo) Upsert(ctx context.Context, override *model.QuotaOverride) error {
+	m.overrides[override.UserID] = override
+	return nil
+}
+
+func (m *mockQuotaRepo) Get(ctx context.Context, userID string) (*model.QuotaOverride, error) {
+	return m.overrides[userID], nil
+}
+
+func (m *mockQuotaRepo) List(ctx context.Context) ([]*model.QuotaOverride, error) {
+	overrides := make([]*model.QuotaOverride, 0, len(m.overrides))
+	for _, o := range m.overrides {
+		overrides = append(overrides, o)
+	}
+	return overrides, nil
+}
+
+func (m *mockQuotaRepo) Delete(ctx context.Context, userID string) (*model.QuotaOverride, error) {
+	override := m.overrides[userID]
+	delete(m.overrides, userID)
+	return override, nil
+}
+
+type mockQuotaTracker struct {
+	limits map[string]int
+	used   map[string]int
+}
+
+func newMockQuotaTracker() *mockQuotaTracker {
+	return &mockQuotaTracker{limits: map[string]int{}, used: map[string]int{}}
+}
+
+func (m *mockQuotaTracker) Usage(userID string) model.QuotaUsage {
+	limit, override := m.limits[userID]
+	if !override {
+		limit = 100
+	}
+	return model.QuotaUsage{UserID: userID, DailyLimit: limit, Used: m.used[userID], Override: override}
+}
+
+func (m *mockQuotaTracker) TopUsage(n int) []model.QuotaUsage { return nil }
+
+func (m *mockQuotaTracker) SetLimit(userID string, limit int) { m.limits[userID] = limit }
+
+func (m *mockQuotaTracker) ClearLimit(userID string) { delete(m.limits, userID) }
+
+func (m *mockQuotaTracker) ResetUsage(userID string) { delete(m.used, userID) }
+
+func newTestQuotaService(repo *mockQuotaRepo, tracker *mockQuotaTracker) *QuotaService {
+	return NewQuotaService(QuotaServiceConfig{
+		Repo:     repo,
+		Tracker:  tracker,
+		UserRepo: &mockLegalHoldUserRepo{},
+	})
+}
+
+// ============================================================================
+// Quota Service Tests
+// ============================================================================
+
+func TestQuotaSetLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		userID  string
+		wantErr error
+	}{
+		{name: "sets override", userID: "user:1"},
+		{name: "unknown user", userID: "user:missing", wantErr: ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo, tracker := newMockQuotaRepo(), newMockQuotaTracker()
+			svc := newTestQuotaService(repo, tracker)
+
+			usage, err := svc.SetLimit(context.Background(), "user:admin", tt.userID, &model.SetQuotaRequest{DailyLimit: 500, Reason: "load test"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if usage.DailyLimit != 500 || !usage.Override {
+				t.Errorf("expected override applied, got %+v", usage)
+			}
+			if stored := repo.overrides[tt.userID]; stored == nil || stored.SetBy != "user:admin" {
+				t.Errorf("expected override stored, got %+v", stored)
+			}
+		})
+	}
+}
+
+func TestQuotaClearLimit(t *testing.T) {
+	t.Parallel()
+
+	repo, tracker := newMockQuotaRepo(), newMockQuotaTracker()
+	svc := newTestQuotaService(repo, tracker)
+	ctx := context.Background()
+
+	if _, err := svc.SetLimit(ctx, "user:admin", "user:1", &model.SetQuotaRequest{DailyLimit: 500}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ClearLimit(ctx, "user:1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usage := tracker.Usage("user:1"); usage.Override {
+		t.Errorf("expected default limit, got %+v", usage)
+	}
+	if err := svc.ClearLimit(ctx, "user:1"); !errors.Is(err, ErrQuotaOverrideNotFound) {
+		t.Errorf("expected ErrQuotaOverrideNotFound, got %v", err)
+	}
+}
+
+func TestQuotaLoadOverrides(t *testing.T) {
+	t.Parallel()
+
+	repo, tracker := newMockQuotaRepo(), newMockQuotaTracker()
+	repo.overrides["user:1"] = &model.QuotaOverride{UserID: "user:1", DailyLimit: 42}
+	svc := newTestQuotaService(repo, tracker)
+
+	if err := svc.LoadOverrides(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tracker.limits["user:1"] != 42 {
+		t.Errorf("expected stored override loaded, got %v", tracker.limits)
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"math/rand/v2"
+	"sync"
+)
+
+// lockedSource makes a rand.Source safe for concurrent use, so one injected
+// source can be shared by all of a service's requests
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+}
+
+func (l *lockedSource) Uint64() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.src.Uint64()
+}
+
+// newRand returns a generator over src that is safe for concurrent use. A
+// nil src means a randomly seeded one.
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return rand.New(&lockedSource{src: src})
+}
+
+// seededRand returns the generator for a single run, which the same seed
+// reproduces exactly
+func seededRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ReactionRepository defines the interface for reaction storage
+type ReactionRepository interface {
+	SetReaction(ctx context.Context, reaction *model.Reaction) error
+	DeleteReaction(ctx context.Context, targetID, userID string) (bool, error)
+	Summaries(ctx context.Context, targetIDs []string, userID string) (map[string]*model.ReactionSummary, error)
+}
+
+// ReactionEventRepository provides event lookups for event reactions
+type ReactionEventRepository interface {
+	Get(ctx context.Context, eventID string) (*model.Event, error)
+}
+
+// ReactionAnnouncementRepository provides delivered announcements for
+// announcement reactions
+type ReactionAnnouncementRepository interface {
+	GetReceipt(ctx context.Context, announcementID, userID string) (*model.AnnouncementReceipt, error)
+}
+
+// ReactionSummaries attaches reaction counts to the payloads of reactable
+// items
+type ReactionSummaries interface {
+	Summaries(ctx context.Context, targetIDs []string, userID string) (map[string]*model.ReactionSummary, error)
+}
+
+// ReactionService manages emoji reactions on events and announcements. Users
+// can react to published events and to announcements delivered to them; new
+// counts go live to the item's guild.
+type ReactionService struct {
+	repo          ReactionRepository
+	events        ReactionEventRepository
+	announcements ReactionAnnouncementRepository
+	eventHub      *EventHub
+}
+
+// ReactionServiceConfig holds configuration for the reaction service
+type ReactionServiceConfig struct {
+	Repo             ReactionRepository
+	EventRepo        ReactionEventRepository
+	AnnouncementRepo ReactionAnnouncementRepository
+	EventHub         *EventHub // Optional; nil skips live count updates
+}
+
+// NewReactionService creates a new reaction service
+func NewReactionService(cfg ReactionServiceConfig) *ReactionService {
+	return &ReactionService{
+		repo:          cfg.Repo,
+		events:        cfg.EventRepo,
+		announcements: cfg.AnnouncementRepo,
+		eventHub:      cfg.EventHub,
+	}
+}
+
+// React sets the user's reaction on an item, replacing their previous one,
+// and returns the item's new summary
+func (s *ReactionService) React(ctx context.Context, userID string, targetType model.ReactionTargetType, targetID string, req *model.SetReactionRequest) (*model.ReactionSummary, error) {
+	guildID, err := s.resolveTarget(ctx, userID, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := &model.Reaction{
+		TargetType: targetType,
+		TargetID:   targetID,
+		UserID:     userID,
+		Emoji:      req.Emoji,
+	}
+	if err := s.repo.SetReaction(ctx, reaction); err != nil {
+		return nil, fmt.Errorf("setting reaction: %w", err)
+	}
+
+	return s.updated(ctx, userID, targetType, targetID, guildID)
+}
+
+// Unreact removes the user's reaction from an item and returns the item's
+// new summary
+func (s *ReactionService) Unreact(ctx context.Context, userID string, targetType model.ReactionTargetType, targetID string) (*model.ReactionSummary, error) {
+	guildID, err := s.resolveTarget(ctx, userID, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.repo.DeleteReaction(ctx, targetID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("deleting reaction: %w", err)
+	}
+	if !deleted {
+		return nil, ErrReactionNotFound
+	}
+
+	return s.updated(ctx, userID, targetType, targetID, guildID)
+}
+
+// Summaries returns the reaction summary of each of targetIDs, with the
+// user's own reactions when userID is set
+func (s *ReactionService) Summaries(ctx context.Context, targetIDs []string, userID string) (map[string]*model.ReactionSummary, error) {
+	return s.repo.Summaries(ctx, targetIDs, userID)
+}
+
+// resolveTarget checks the user can react to the item and returns the guild
+// its counts are broadcast to, or "" to broadcast them nowhere
+func (s *ReactionService) resolveTarget(ctx context.Context, userID string, targetType model.ReactionTargetType, targetID string) (string, error) {
+	switch targetType {
+	case model.ReactionTargetEvent:
+		event, err := s.events.Get(ctx, targetID)
+		if err != nil {
+			return "", fmt.Errorf("getting event: %w", err)
+		}
+		if event == nil || event.IsUnpublished() {
+			return "", ErrEventNotFound
+		}
+		// Counts of events not every member can see stay off the guild stream
+		if event.GuildID == nil || event.Visibility == model.EventVisibilityInviteOnly || event.Visibility == model.EventVisibilityPrivate {
+			return "", nil
+		}
+		return *event.GuildID, nil
+
+	case model.ReactionTargetAnnouncement:
+		receipt, err := s.announcements.GetReceipt(ctx, targetID, userID)
+		if err != nil {
+			return "", fmt.Errorf("getting announcement receipt: %w", err)
+		}
+		if receipt == nil {
+			return "", ErrAnnouncementNotFound
+		}
+		if receipt.GuildID == nil {
+			return "", nil
+		}
+		return *receipt.GuildID, nil
+	}
+	return "", fmt.Errorf("unknown reaction target type %q", targetType)
+}
+
+// updated loads the item's new summary and broadcasts its counts
+func (s *ReactionService) updated(ctx context.Context, userID string, targetType model.ReactionTargetType, targetID, guildID string) (*model.ReactionSummary, error) {
+	summaries, err := s.repo.Summaries(ctx, []string{targetID}, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting reaction summary: %w", err)
+	}
+	summary := summaries[targetID]
+	if summary == nil {
+		summary = &model.ReactionSummary{Counts: map[string]int{}}
+	}
+
+	if s.eventHub != nil && guildID != "" {
+		s.eventHub.Publish(&Event{
+			Type:     EventReactionsUpdated,
+			CircleID: guildID,
+			Data: map[string]interface{}{
+				"target_type": targetType,
+				"target_id":   targetID,
+				"counts":      summary.Counts,
+				"total":       summary.Total,
+			},
+		})
+	}
+
+	return summary, nil
+}
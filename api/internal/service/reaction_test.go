@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Reaction Tests
+// ============================================================================
+
+// reactionFixture reacts in memory. events and receipts are looked up by ID;
+// receipts are only delivered to user:member.
+func reactionFixture(events map[string]*model.Event, receipts map[string]*model.AnnouncementReceipt, hub *EventHub) *ReactionService {
+	reactions := make(map[string]map[string]string) // target -> user -> emoji
+	repo := &mocks.ReactionRepository{
+		SetReactionFunc: func(ctx context.Context, reaction *model.Reaction) error {
+			if reactions[reaction.TargetID] == nil {
+				reactions[reaction.TargetID] = make(map[string]string)
+			}
+			reactions[reaction.TargetID][reaction.UserID] = reaction.Emoji
+			return nil
+		},
+		DeleteReactionFunc: func(ctx context.Context, targetID, userID string) (bool, error) {
+			if _, ok := reactions[targetID][userID]; !ok {
+				return false, nil
+			}
+			delete(reactions[targetID], userID)
+			return true, nil
+		},
+		SummariesFunc: func(ctx context.Context, targetIDs []string, userID string) (map[string]*model.ReactionSummary, error) {
+			summaries := make(map[string]*model.ReactionSummary)
+			for _, id := range targetIDs {
+				summary := &model.ReactionSummary{Counts: map[string]int{}}
+				for user, emoji := range reactions[id] {
+					summary.Add(emoji, 1)
+					if user == userID {
+						mine := emoji
+						summary.Mine = &mine
+					}
+				}
+				summaries[id] = summary
+			}
+			return summaries, nil
+		},
+	}
+	return NewReactionService(ReactionServiceConfig{
+		Repo: repo,
+		EventRepo: &mocks.ReactionEventRepository{
+			GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+				return events[eventID], nil
+			},
+		},
+		AnnouncementRepo: &mocks.ReactionAnnouncementRepository{
+			GetReceiptFunc: func(ctx context.Context, announcementID, userID string) (*model.AnnouncementReceipt, error) {
+				if userID != "user:member" {
+					return nil, nil
+				}
+				return receipts[announcementID], nil
+			},
+		},
+		EventHub: hub,
+	})
+}
+
+func TestReact_ReplacesReactionAndBroadcasts(t *testing.T) {
+	t.Parallel()
+
+	guildID := "guild:1"
+	events := map[string]*model.Event{
+		"event:1": {ID: "event:1", GuildID: &guildID, Visibility: model.EventVisibilityGuilds, Status: model.EventStatusPublished},
+	}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.Subscribe(guildID, "sub-1")
+	svc := reactionFixture(events, nil, hub)
+	ctx := context.Background()
+
+	if _, err := svc.React(ctx, "user:a", model.ReactionTargetEvent, "event:1", &model.SetReactionRequest{Emoji: model.ReactionHeart}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	summary, err := svc.React(ctx, "user:a", model.ReactionTargetEvent, "event:1", &model.SetReactionRequest{Emoji: model.ReactionParty})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Total != 1 || summary.Counts[model.ReactionParty] != 1 {
+		t.Errorf("expected the second reaction to replace the first, got %+v", summary)
+	}
+	if summary.Mine == nil || *summary.Mine != model.ReactionParty {
+		t.Errorf("expected mine to be party, got %v", summary.Mine)
+	}
+
+	received := 0
+	for len(sub.Events) > 0 {
+		if e := <-sub.Events; e.Type == EventReactionsUpdated {
+			received++
+		}
+	}
+	if received != 2 {
+		t.Errorf("expected 2 reactions.updated events on the guild stream, got %d", received)
+	}
+}
+
+func TestReact_PrivateEventNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	guildID := "guild:1"
+	events := map[string]*model.Event{
+		"event:1": {ID: "event:1", GuildID: &guildID, Visibility: model.EventVisibilityInviteOnly, Status: model.EventStatusPublished},
+	}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.Subscribe(guildID, "sub-1")
+	svc := reactionFixture(events, nil, hub)
+
+	if _, err := svc.React(context.Background(), "user:a", model.ReactionTargetEvent, "event:1", &model.SetReactionRequest{Emoji: model.ReactionHeart}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for len(sub.Events) > 0 {
+		if e := <-sub.Events; e.Type == EventReactionsUpdated {
+			t.Error("expected no guild broadcast for an invite-only event")
+		}
+	}
+}
+
+func TestReact_UnpublishedEvent(t *testing.T) {
+	t.Parallel()
+
+	events := map[string]*model.Event{
+		"event:draft": {ID: "event:draft", Status: model.EventStatusDraft},
+	}
+	svc := reactionFixture(events, nil, nil)
+	req := &model.SetReactionRequest{Emoji: model.ReactionHeart}
+
+	if _, err := svc.React(context.Background(), "user:a", model.ReactionTargetEvent, "event:draft", req); !errors.Is(err, ErrEventNotFound) {
+		t.Errorf("expected ErrEventNotFound for a draft, got %v", err)
+	}
+	if _, err := svc.React(context.Background(), "user:a", model.ReactionTargetEvent, "event:missing", req); !errors.Is(err, ErrEventNotFound) {
+		t.Errorf("expected ErrEventNotFound, got %v", err)
+	}
+}
+
+func TestReact_AnnouncementNotDelivered(t *testing.T) {
+	t.Parallel()
+
+	receipts := map[string]*model.AnnouncementReceipt{
+		"announcement:1": {AnnouncementID: "announcement:1"},
+	}
+	svc := reactionFixture(nil, receipts, nil)
+	req := &model.SetReactionRequest{Emoji: model.ReactionThumbsUp}
+
+	if _, err := svc.React(context.Background(), "user:other", model.ReactionTargetAnnouncement, "announcement:1", req); !errors.Is(err, ErrAnnouncementNotFound) {
+		t.Errorf("expected ErrAnnouncementNotFound, got %v", err)
+	}
+	if _, err := svc.React(context.Background(), "user:member", model.ReactionTargetAnnouncement, "announcement:1", req); err != nil {
+		t.Errorf("expected recipients to react, got %v", err)
+	}
+}
+
+func TestUnreact(t *testing.T) {
+	t.Parallel()
+
+	events := map[string]*model.Event{
+		"event:1": {ID: "event:1", Status: model.EventStatusPublished},
+	}
+	svc := reactionFixture(events, nil, nil)
+	ctx := context.Background()
+
+	if _, err := svc.Unreact(ctx, "user:a", model.ReactionTargetEvent, "event:1"); !errors.Is(err, ErrReactionNotFound) {
+		t.Errorf("expected ErrReactionNotFound, got %v", err)
+	}
+	if _, err := svc.React(ctx, "user:a", model.ReactionTargetEvent, "event:1", &model.SetReactionRequest{Emoji: model.ReactionWow}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	summary, err := svc.Unreact(ctx, "user:a", model.ReactionTargetEvent, "event:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Total != 0 || summary.Mine != nil {
+		t.Errorf("expected no reactions left, got %+v", summary)
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DefaultRemoteConfigCacheTTL bounds how long another instance may serve
+// stale tunables after an admin change; the instance making the change
+// invalidates its own cache immediately.
+const DefaultRemoteConfigCacheTTL = 5 * time.Minute
+
+// RemoteConfigRepository defines the interface for client tunable storage
+type RemoteConfigRepository interface {
+	Upsert(ctx context.Context, value *model.RemoteConfigValue) error
+	List(ctx context.Context) ([]*model.RemoteConfigValue, error)
+	Delete(ctx context.Context, key string, platform model.DevicePlatform) (*model.RemoteConfigValue, error)
+}
+
+// RemoteConfigService serves client tunables - copy, defaults and toggles
+// that admins change without shipping an app release. Values are resolved
+// per platform from built-in defaults, admin values for every platform and
+// the platform's overrides, and served from a cache that is invalidated on
+// every admin change and otherwise refreshed after the cache TTL.
+type RemoteConfigService struct {
+	repo     RemoteConfigRepository
+	cacheTTL time.Duration
+	clock    clock.Clock
+
+	mu       sync.RWMutex
+	values   []*model.RemoteConfigValue
+	loadedOn time.Time
+}
+
+// RemoteConfigServiceConfig holds configuration for the remote config service
+type RemoteConfigServiceConfig struct {
+	Repo     RemoteConfigRepository
+	CacheTTL time.Duration // Default: DefaultRemoteConfigCacheTTL
+	Clock    clock.Clock   // Default: the system clock
+}
+
+// NewRemoteConfigService creates a new remote config service
+func NewRemoteConfigService(cfg RemoteConfigServiceConfig) *RemoteConfigService {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultRemoteConfigCacheTTL
+	}
+	return &RemoteConfigService{
+		repo:     cfg.Repo,
+		cacheTTL: cfg.CacheTTL,
+		clock:    clock.OrReal(cfg.Clock),
+	}
+}
+
+// Config returns the tunables for a platform, or the values for every
+// platform when platform is empty, and their ETag
+func (s *RemoteConfigService) Config(ctx context.Context, platform model.DevicePlatform) (*model.RemoteConfig, string, error) {
+	values, err := s.load(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resolved := builtinRemoteConfig()
+	for _, v := range values {
+		if v.Platform == "" {
+			resolved[v.Key] = v.Value
+		}
+	}
+	if platform != "" {
+		for _, v := range values {
+			if v.Platform == platform {
+				resolved[v.Key] = v.Value
+			}
+		}
+	}
+
+	config := &model.RemoteConfig{Platform: platform, Values: resolved}
+	return config, remoteConfigETag(config), nil
+}
+
+// List returns every admin-set value, by key and then platform
+func (s *RemoteConfigService) List(ctx context.Context) ([]*model.RemoteConfigValue, error) {
+	return s.repo.List(ctx)
+}
+
+// Set sets the value of key, for every platform or for the request's
+// platform only
+func (s *RemoteConfigService) Set(ctx context.Context, adminID, key string, req *model.SetRemoteConfigRequest) (*model.RemoteConfigValue, error) {
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, req.Value); err != nil {
+		return nil, fmt.Errorf("compacting value: %w", err)
+	}
+
+	value := &model.RemoteConfigValue{
+		Key:         key,
+		Platform:    req.Platform,
+		Value:       compact.Bytes(),
+		Description: req.Description,
+		UpdatedBy:   adminID,
+	}
+	if err := s.repo.Upsert(ctx, value); err != nil {
+		return nil, err
+	}
+
+	s.Invalidate()
+	return value, nil
+}
+
+// Delete removes the value of key for platform, or the value for every
+// platform when platform is empty. Built-in defaults apply again afterwards.
+func (s *RemoteConfigService) Delete(ctx context.Context, key string, platform model.DevicePlatform) error {
+	removed, err := s.repo.Delete(ctx, key, platform)
+	if err != nil {
+		return err
+	}
+	if removed == nil {
+		return ErrRemoteConfigNotFound
+	}
+
+	s.Invalidate()
+	return nil
+}
+
+// Invalidate drops the cached values so the next read reloads them
+func (s *RemoteConfigService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values = nil
+}
+
+// load returns the cached values, reloading them when missing or expired
+func (s *RemoteConfigService) load(ctx context.Context) ([]*model.RemoteConfigValue, error) {
+	s.mu.RLock()
+	values, loadedOn := s.values, s.loadedOn
+	s.mu.RUnlock()
+
+	if values != nil && s.clock.Now().Sub(loadedOn) < s.cacheTTL {
+		return values, nil
+	}
+
+	values, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = []*model.RemoteConfigValue{}
+	}
+
+	s.mu.Lock()
+	s.values = values
+	s.loadedOn = s.clock.Now()
+	s.mu.Unlock()
+	return values, nil
+}
+
+// builtinRemoteConfig returns the tunables clients get before an admin sets
+// them
+func builtinRemoteConfig() map[string]json.RawMessage {
+	radius, _ := json.Marshal(DefaultSearchRadiusKm)
+	return map[string]json.RawMessage{
+		"discovery.default_radius_km": radius,
+		"nudges.copy_variants":        json.RawMessage(`{}`), // Nudge type to copy variants; apps use their own copy when empty
+	}
+}
+
+// remoteConfigETag fingerprints a platform's resolved tunables
+func remoteConfigETag(config *model.RemoteConfig) string {
+	data, _ := json.Marshal(config)
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:8]))
+}
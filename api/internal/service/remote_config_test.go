@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+func newTestRemoteConfigService(values ...*model.RemoteConfigValue) (*RemoteConfigService, *int) {
+	loads := 0
+	repo := &mocks.RemoteConfigRepository{
+		ListFunc: func(ctx context.Context) ([]*model.RemoteConfigValue, error) {
+			loads++
+			return values, nil
+		},
+		UpsertFunc: func(ctx context.Context, value *model.RemoteConfigValue) error {
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, key string, platform model.DevicePlatform) (*model.RemoteConfigValue, error) {
+			return nil, nil
+		},
+	}
+	return NewRemoteConfigService(RemoteConfigServiceConfig{Repo: repo}), &loads
+}
+
+func TestRemoteConfigService_Config_ResolvesPlatformOverrides(t *testing.T) {
+	t.Parallel()
+	svc, _ := newTestRemoteConfigService(
+		&model.RemoteConfigValue{Key: "discovery.default_radius_km", Value: json.RawMessage(`15`)},
+		&model.RemoteConfigValue{Key: "discovery.default_radius_km", Platform: model.PlatformIOS, Value: json.RawMessage(`10`)},
+		&model.RemoteConfigValue{Key: "features.new_onboarding", Platform: model.PlatformAndroid, Value: json.RawMessage(`true`)},
+	)
+	ctx := context.Background()
+
+	ios, iosETag, err := svc.Config(ctx, model.PlatformIOS)
+	if err != nil {
+		t.Fatalf("Config() error = %v", err)
+	}
+	if got := string(ios.Values["discovery.default_radius_km"]); got != "10" {
+		t.Errorf("iOS radius = %s, want the iOS override", got)
+	}
+	if _, ok := ios.Values["features.new_onboarding"]; ok {
+		t.Error("iOS should not get the Android-only toggle")
+	}
+	if _, ok := ios.Values["nudges.copy_variants"]; !ok {
+		t.Error("expected built-in defaults to be included")
+	}
+
+	web, webETag, _ := svc.Config(ctx, model.PlatformWeb)
+	if got := string(web.Values["discovery.default_radius_km"]); got != "15" {
+		t.Errorf("web radius = %s, want the value for every platform", got)
+	}
+	if iosETag == webETag {
+		t.Error("expected platforms with different values to have different ETags")
+	}
+
+	again, againETag, _ := svc.Config(ctx, model.PlatformIOS)
+	if againETag != iosETag || string(again.Values["discovery.default_radius_km"]) != "10" {
+		t.Error("expected the same ETag for unchanged values")
+	}
+}
+
+func TestRemoteConfigService_Config_BuiltinDefaults(t *testing.T) {
+	t.Parallel()
+	svc, _ := newTestRemoteConfigService()
+
+	config, _, err := svc.Config(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Config() error = %v", err)
+	}
+	var radius float64
+	if err := json.Unmarshal(config.Values["discovery.default_radius_km"], &radius); err != nil || radius != DefaultSearchRadiusKm {
+		t.Errorf("radius = %s, want the default search radius", config.Values["discovery.default_radius_km"])
+	}
+}
+
+func TestRemoteConfigService_Set_InvalidatesCache(t *testing.T) {
+	t.Parallel()
+	svc, loads := newTestRemoteConfigService()
+	ctx := context.Background()
+
+	_, _, _ = svc.Config(ctx, "")
+	_, _, _ = svc.Config(ctx, "")
+	if *loads != 1 {
+		t.Fatalf("loaded %d times, want the cache used", *loads)
+	}
+
+	value, err := svc.Set(ctx, "user:admin", "nudges.copy_variants", &model.SetRemoteConfigRequest{
+		Value: json.RawMessage(`{ "pending_match": [ "Say hi!" ] }`),
+	})
+	if err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if string(value.Value) != `{"pending_match":["Say hi!"]}` {
+		t.Errorf("Value = %s, want it compacted", value.Value)
+	}
+
+	_, _, _ = svc.Config(ctx, "")
+	if *loads != 2 {
+		t.Errorf("loaded %d times, want a reload after Set", *loads)
+	}
+}
+
+func TestRemoteConfigService_Delete_NotFound(t *testing.T) {
+	t.Parallel()
+	svc, _ := newTestRemoteConfigService()
+
+	err := svc.Delete(context.Background(), "features.unknown", model.PlatformWeb)
+	if !errors.Is(err, ErrRemoteConfigNotFound) {
+		t.Errorf("Delete() error = %v, want ErrRemoteConfigNotFound", err)
+	}
+}
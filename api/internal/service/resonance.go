@@ -126,6 +126,13 @@ func (s *ResonanceService) AwardQuesting(ctx context.Context, userID, eventID st
 	return nil
 }
 
+// HandleEventCompleted awards Questing points when an attendee confirms
+// completing an event. AwardQuesting skips events already awarded, so
+// redelivered events award nothing.
+func (s *ResonanceService) HandleEventCompleted(ctx context.Context, event model.EventCompleted) error {
+	return s.AwardQuesting(ctx, event.UserID, event.EventID, event.EarlyConfirm(), event.OnTimeCheckin())
+}
+
 // AwardMana awards Mana points for helpful support sessions
 func (s *ResonanceService) AwardMana(ctx context.Context, helperID, receiverID, hangoutID string, helpfulRating string, earlyConfirm, hasHelpfulTag bool) error {
 	// Only award if receiver rated as helpful
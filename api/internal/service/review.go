@@ -12,27 +12,39 @@ import (
 type ReviewRepository interface {
 	Create(ctx context.Context, review *model.Review) error
 	GetByID(ctx context.Context, id string) (*model.Review, error)
-	GetReviewsGiven(ctx context.Context, userID string, limit, offset int) ([]*model.Review, error)
-	GetReviewsReceived(ctx context.Context, userID string, limit, offset int) ([]*model.Review, error)
+	GetReviewsGiven(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error)
+	GetReviewsReceived(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error)
 	HasReviewed(ctx context.Context, reviewerID, revieweeID, referenceID string) (bool, error)
 	GetReputation(ctx context.Context, userID string) (*model.Reputation, error)
 	GetReputationDisplay(ctx context.Context, userID string) (*model.ReputationDisplay, error)
 }
 
+// ReviewTagCatalog defines the tag lookups used to validate and label review tags
+type ReviewTagCatalog interface {
+	ReviewTagSet(ctx context.Context, kind model.ReviewTagKind) (map[string]bool, error)
+	ReviewTagLabels(ctx context.Context, kind model.ReviewTagKind) (map[string]string, error)
+}
+
 // ReviewService handles review business logic
 type ReviewService struct {
-	repo ReviewRepository
+	repo     ReviewRepository
+	catalog  ReviewTagCatalog
+	activity ActivityRecorder
 }
 
 // ReviewServiceConfig holds configuration for the review service
 type ReviewServiceConfig struct {
-	Repo ReviewRepository
+	Repo     ReviewRepository
+	Catalog  ReviewTagCatalog // Optional; built-in tags are used when nil
+	Activity ActivityRecorder // Optional; records reviews on both users' timelines
 }
 
 // NewReviewService creates a new review service
 func NewReviewService(cfg ReviewServiceConfig) *ReviewService {
 	return &ReviewService{
-		repo: cfg.Repo,
+		repo:     cfg.Repo,
+		catalog:  cfg.Catalog,
+		activity: cfg.Activity,
 	}
 }
 
@@ -73,8 +85,16 @@ func (s *ReviewService) CreateReview(ctx context.Context, reviewerID string, req
 	}
 
 	// Validate tags are from known sets
-	validPositiveTags := filterValidTags(req.PositiveTags, getPositiveTagSet())
-	validImprovementTags := filterValidTags(req.ImprovementTags, getImprovementTagSet())
+	positiveSet, err := s.tagSet(ctx, model.ReviewTagKindPositive)
+	if err != nil {
+		return nil, err
+	}
+	improvementSet, err := s.tagSet(ctx, model.ReviewTagKindImprovement)
+	if err != nil {
+		return nil, err
+	}
+	validPositiveTags := filterValidTags(req.PositiveTags, positiveSet)
+	validImprovementTags := filterValidTags(req.ImprovementTags, improvementSet)
 
 	review := &model.Review{
 		ReviewerID:      reviewerID,
@@ -91,6 +111,25 @@ func (s *ReviewService) CreateReview(ctx context.Context, reviewerID string, req
 		return nil, err
 	}
 
+	data := map[string]string{"context": review.Context}
+	recordActivity(ctx, s.activity,
+		&model.ActivityEntry{
+			UserID:      reviewerID,
+			Type:        model.ActivityReviewGiven,
+			SubjectType: model.ActivitySubjectReview,
+			SubjectID:   review.ID,
+			Data:        data,
+		},
+		&model.ActivityEntry{
+			UserID:      review.RevieweeID,
+			Type:        model.ActivityReviewReceived,
+			ActorID:     &reviewerID,
+			SubjectType: model.ActivitySubjectReview,
+			SubjectID:   review.ID,
+			Data:        data,
+		},
+	)
+
 	return review, nil
 }
 
@@ -106,30 +145,44 @@ func (s *ReviewService) GetReview(ctx context.Context, id string) (*model.Review
 	return review, nil
 }
 
-// GetReviewsGiven retrieves reviews given by a user
-func (s *ReviewService) GetReviewsGiven(ctx context.Context, userID string, limit, offset int) ([]*model.Review, error) {
-	if limit <= 0 || limit > 50 {
-		limit = 20
+// GetReviewsGiven retrieves a page of the reviews given by a user
+func (s *ReviewService) GetReviewsGiven(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error) {
+	if page.Limit <= 0 || page.Limit > 50 {
+		page.Limit = 20
 	}
-	return s.repo.GetReviewsGiven(ctx, userID, limit, offset)
+	return s.repo.GetReviewsGiven(ctx, userID, page)
 }
 
-// GetReviewsReceived retrieves reviews received by a user
-func (s *ReviewService) GetReviewsReceived(ctx context.Context, userID string, limit, offset int) ([]*model.Review, error) {
-	if limit <= 0 || limit > 50 {
-		limit = 20
+// GetReviewsReceived retrieves a page of the reviews received by a user
+func (s *ReviewService) GetReviewsReceived(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error) {
+	if page.Limit <= 0 || page.Limit > 50 {
+		page.Limit = 20
 	}
-	return s.repo.GetReviewsReceived(ctx, userID, limit, offset)
+	return s.repo.GetReviewsReceived(ctx, userID, page)
 }
 
 // GetReputation retrieves full reputation data for a user
 func (s *ReviewService) GetReputation(ctx context.Context, userID string) (*model.Reputation, error) {
-	return s.repo.GetReputation(ctx, userID)
+	reputation, err := s.repo.GetReputation(ctx, userID)
+	if err != nil || reputation == nil {
+		return reputation, err
+	}
+	if err := s.labelTagCounts(ctx, reputation.TopPositiveTags); err != nil {
+		return nil, err
+	}
+	return reputation, nil
 }
 
 // GetReputationDisplay retrieves profile-ready reputation display
 func (s *ReviewService) GetReputationDisplay(ctx context.Context, userID string) (*model.ReputationDisplay, error) {
-	return s.repo.GetReputationDisplay(ctx, userID)
+	display, err := s.repo.GetReputationDisplay(ctx, userID)
+	if err != nil || display == nil {
+		return display, err
+	}
+	if err := s.labelTagCounts(ctx, display.TopTags); err != nil {
+		return nil, err
+	}
+	return display, nil
 }
 
 // SubmitEventFeedback processes post-event feedback
@@ -161,6 +214,35 @@ func isValidReviewContext(ctx string) bool {
 	}
 }
 
+// tagSet returns the tags accepted for new reviews
+func (s *ReviewService) tagSet(ctx context.Context, kind model.ReviewTagKind) (map[string]bool, error) {
+	if s.catalog != nil {
+		return s.catalog.ReviewTagSet(ctx, kind)
+	}
+	if kind == model.ReviewTagKindImprovement {
+		return getImprovementTagSet(), nil
+	}
+	return getPositiveTagSet(), nil
+}
+
+// labelTagCounts applies catalog labels to positive tag counts so that
+// admin-created and renamed tags display correctly
+func (s *ReviewService) labelTagCounts(ctx context.Context, counts []model.TagCount) error {
+	if s.catalog == nil || len(counts) == 0 {
+		return nil
+	}
+	labels, err := s.catalog.ReviewTagLabels(ctx, model.ReviewTagKindPositive)
+	if err != nil {
+		return err
+	}
+	for i := range counts {
+		if label, ok := labels[counts[i].Tag]; ok {
+			counts[i].Label = label
+		}
+	}
+	return nil
+}
+
 func getPositiveTagSet() map[string]bool {
 	tags := model.GetPositiveTags()
 	set := make(map[string]bool, len(tags))
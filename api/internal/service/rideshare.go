@@ -0,0 +1,513 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// RideshareRepository defines the interface for rideshare storage
+type RideshareRepository interface {
+	Create(ctx context.Context, rideshare *model.Rideshare) error
+	Get(ctx context.Context, rideshareID string) (*model.Rideshare, error)
+	GetByEvent(ctx context.Context, eventID string) ([]*model.Rideshare, error)
+	CreateSegments(ctx context.Context, rideshareID string, segments []model.RideshareSegment) error
+	GetSegments(ctx context.Context, rideshareID string) ([]model.RideshareSegment, error)
+	ReserveSeat(ctx context.Context, rideshareID string) (*model.Rideshare, error)
+	ReleaseSeat(ctx context.Context, rideshareID string) error
+	SetStatus(ctx context.Context, rideshareID, status string) error
+	CreateSeat(ctx context.Context, seat *model.RideshareSeat) error
+	GetSeat(ctx context.Context, seatID string) (*model.RideshareSeat, error)
+	GetPassengerSeat(ctx context.Context, rideshareID, userID string) (*model.RideshareSeat, error)
+	SetSeatStatus(ctx context.Context, seatID, status string) (*model.RideshareSeat, error)
+}
+
+// RideRequestRepository defines the interface for ride request storage
+type RideRequestRepository interface {
+	Create(ctx context.Context, req *model.RideRequest) error
+	Get(ctx context.Context, requestID string) (*model.RideRequest, error)
+	GetOpen(ctx context.Context, eventID, userID string) (*model.RideRequest, error)
+	GetOpenByEvent(ctx context.Context, eventID string) ([]*model.RideRequest, error)
+	Close(ctx context.Context, requestID, status string, rideshareID *string) (*model.RideRequest, error)
+}
+
+// RideshareEventRepository provides the event checks carpools need
+type RideshareEventRepository interface {
+	Get(ctx context.Context, eventID string) (*model.Event, error)
+	IsHost(ctx context.Context, eventID, userID string) (bool, error)
+	GetRSVP(ctx context.Context, eventID, userID string) (*model.EventRSVP, error)
+}
+
+// RideshareService coordinates carpools to events. An event's hosts and
+// approved attendees can offer rides along a route to the event or ask for
+// one from a pickup point; riders are matched to drivers whose route passes
+// within MaxPickupDetourKm of their pickup.
+type RideshareService struct {
+	rideshares   RideshareRepository
+	rideRequests RideRequestRepository
+	events       RideshareEventRepository
+}
+
+// RideshareServiceConfig holds configuration for the rideshare service
+type RideshareServiceConfig struct {
+	Rideshares   RideshareRepository
+	RideRequests RideRequestRepository
+	Events       RideshareEventRepository
+}
+
+// NewRideshareService creates a new rideshare service
+func NewRideshareService(cfg RideshareServiceConfig) *RideshareService {
+	return &RideshareService{
+		rideshares:   cfg.Rideshares,
+		rideRequests: cfg.RideRequests,
+		events:       cfg.Events,
+	}
+}
+
+// OfferRide offers a ride to an event. The rideshare ends at the event's
+// location, shares the event's visibility, and keeps its stops as route
+// segments for matching.
+func (s *RideshareService) OfferRide(ctx context.Context, userID, eventID string, req *model.OfferRideRequest) (*model.Rideshare, error) {
+	event, err := s.carpoolEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !req.DepartureTime.Before(event.StartTime) {
+		return nil, model.NewValidationError([]model.FieldError{{Field: "departure_time", Message: "departure_time must be before the event starts"}})
+	}
+
+	existing, err := s.rideshares.GetByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= model.MaxRidesharesPerEvent {
+		return nil, ErrTooManyRideshares
+	}
+	for _, ride := range existing {
+		if ride.DriverID == userID {
+			return nil, ErrAlreadyOfferingRide
+		}
+	}
+
+	rideshare := &model.Rideshare{
+		EventID:       &eventID,
+		DriverID:      userID,
+		Title:         req.Title,
+		Description:   req.Description,
+		Origin:        req.Origin.Location(),
+		Destination:   eventDestination(event),
+		DepartureTime: req.DepartureTime,
+		SeatsTotal:    req.SeatsTotal,
+		TrustRequired: req.TrustRequired,
+		Visibility:    event.Visibility,
+	}
+	if err := s.rideshares.Create(ctx, rideshare); err != nil {
+		return nil, err
+	}
+
+	if len(req.Stops) > 0 {
+		if err := s.rideshares.CreateSegments(ctx, rideshare.ID, routeSegments(rideshare, req.Stops)); err != nil {
+			return nil, err
+		}
+	}
+
+	return rideshare, nil
+}
+
+// ListEventRides returns the rides offered to an event (attendees only)
+func (s *RideshareService) ListEventRides(ctx context.Context, userID, eventID string) ([]*model.Rideshare, error) {
+	if _, err := s.attendedEvent(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+	return s.rideshares.GetByEvent(ctx, eventID)
+}
+
+// RequestRide posts that the user is looking for a ride to an event. A user
+// has at most one open request per event.
+func (s *RideshareService) RequestRide(ctx context.Context, userID, eventID string, req *model.RequestRideRequest) (*model.RideRequest, error) {
+	if _, err := s.carpoolEvent(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.rideRequests.GetOpen(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrRideRequestExists
+	}
+
+	rideRequest := &model.RideRequest{
+		EventID: eventID,
+		UserID:  userID,
+		Pickup:  req.Pickup.Location(),
+		Notes:   req.Notes,
+	}
+	if err := s.rideRequests.Create(ctx, rideRequest); err != nil {
+		return nil, err
+	}
+	return rideRequest, nil
+}
+
+// CancelRideRequest withdraws the user's open ride request
+func (s *RideshareService) CancelRideRequest(ctx context.Context, userID, eventID, requestID string) error {
+	existing, err := s.rideRequests.Get(ctx, requestID)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.UserID != userID || existing.EventID != eventID {
+		return ErrRideRequestNotFound
+	}
+
+	closed, err := s.rideRequests.Close(ctx, requestID, model.RideRequestStatusCancelled, nil)
+	if err != nil {
+		return err
+	}
+	if closed == nil {
+		return ErrRideRequestNotFound
+	}
+	return nil
+}
+
+// FindRideMatches ranks the event's open rides by how far the user's pickup
+// is from each driver's route, leaving out rides that would need a detour
+// of more than MaxPickupDetourKm
+func (s *RideshareService) FindRideMatches(ctx context.Context, userID, eventID string) ([]model.RideshareMatch, error) {
+	if _, err := s.attendedEvent(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+
+	rideRequest, err := s.rideRequests.GetOpen(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if rideRequest == nil {
+		return nil, ErrRideRequestNotFound
+	}
+
+	rides, err := s.rideshares.GetByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]model.RideshareMatch, 0)
+	for _, ride := range rides {
+		if ride.DriverID == userID || ride.Status != model.RideshareStatusOpen || ride.SeatsAvailable == 0 {
+			continue
+		}
+
+		segments, err := s.rideshares.GetSegments(ctx, ride.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		detour, ok := routeDetourKm(rideRequest.Pickup, ridePath(ride, segments))
+		if !ok || detour > model.MaxPickupDetourKm {
+			continue
+		}
+
+		matches = append(matches, model.RideshareMatch{
+			Rideshare:      *ride,
+			DriverID:       ride.DriverID,
+			MatchScore:     math.Round((1-detour/model.MaxPickupDetourKm)*100) / 100,
+			DistanceKm:     math.Round(detour*10) / 10,
+			TimeOverlap:    true,
+			AvailableSeats: ride.SeatsAvailable,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].DistanceKm < matches[j].DistanceKm
+	})
+	return matches, nil
+}
+
+// RequestSeat asks the driver of an event's rideshare for a seat
+func (s *RideshareService) RequestSeat(ctx context.Context, userID, rideshareID string, req *model.RequestSeatRequest) (*model.RideshareSeat, error) {
+	ride, err := s.eventRideshare(ctx, rideshareID)
+	if err != nil {
+		return nil, err
+	}
+	if ride.DriverID == userID {
+		return nil, ErrOwnRideshare
+	}
+	if _, err := s.carpoolEvent(ctx, *ride.EventID, userID); err != nil {
+		return nil, err
+	}
+	if ride.Status != model.RideshareStatusOpen || ride.SeatsAvailable == 0 {
+		return nil, ErrRideshareFull
+	}
+
+	existing, err := s.rideshares.GetPassengerSeat(ctx, rideshareID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrSeatAlreadyRequested
+	}
+
+	seat := &model.RideshareSeat{
+		RideshareID: rideshareID,
+		PassengerID: userID,
+		Status:      model.SeatStatusRequested,
+		Notes:       req.Notes,
+	}
+	if err := s.rideshares.CreateSeat(ctx, seat); err != nil {
+		return nil, err
+	}
+	return seat, nil
+}
+
+// RespondToSeat lets the driver confirm or decline a seat request.
+// Confirming takes a seat, marks the rideshare full when it was the last
+// one, and closes the passenger's ride request as matched.
+func (s *RideshareService) RespondToSeat(ctx context.Context, userID, rideshareID, seatID string, req *model.RespondToSeatRequest) (*model.RideshareSeat, error) {
+	ride, err := s.eventRideshare(ctx, rideshareID)
+	if err != nil {
+		return nil, err
+	}
+	if ride.DriverID != userID {
+		return nil, ErrNotRideshareDriver
+	}
+
+	seat, err := s.rideshares.GetSeat(ctx, seatID)
+	if err != nil {
+		return nil, err
+	}
+	if seat == nil || seat.RideshareID != rideshareID {
+		return nil, ErrSeatNotFound
+	}
+	if seat.Status != model.SeatStatusRequested {
+		return nil, ErrSeatAlreadyAnswered
+	}
+
+	if !req.Confirmed {
+		declined, err := s.rideshares.SetSeatStatus(ctx, seatID, model.SeatStatusCancelled)
+		if err != nil {
+			return nil, err
+		}
+		if declined == nil {
+			return nil, ErrSeatAlreadyAnswered
+		}
+		return declined, nil
+	}
+
+	reserved, err := s.rideshares.ReserveSeat(ctx, rideshareID)
+	if err != nil {
+		return nil, err
+	}
+	if reserved == nil {
+		return nil, ErrRideshareFull
+	}
+
+	confirmed, err := s.rideshares.SetSeatStatus(ctx, seatID, model.SeatStatusConfirmed)
+	if err != nil || confirmed == nil {
+		// Answered concurrently; give the seat back
+		_ = s.rideshares.ReleaseSeat(ctx, rideshareID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrSeatAlreadyAnswered
+	}
+
+	if reserved.SeatsAvailable == 0 {
+		if err := s.rideshares.SetStatus(ctx, rideshareID, model.RideshareStatusFull); err != nil {
+			return nil, err
+		}
+	}
+
+	// The seat is booked either way; a stale ride request only shows up as
+	// a waiting rider until the passenger cancels it
+	if rideRequest, _ := s.rideRequests.GetOpen(ctx, *ride.EventID, seat.PassengerID); rideRequest != nil {
+		_, _ = s.rideRequests.Close(ctx, rideRequest.ID, model.RideRequestStatusMatched, &rideshareID)
+	}
+
+	return confirmed, nil
+}
+
+// CarpoolSummary counts an event's open rides, seats and waiting riders.
+// With a userID it also points to that user's own ride offer and request.
+func (s *RideshareService) CarpoolSummary(ctx context.Context, eventID, userID string) (*model.CarpoolSummary, error) {
+	rides, err := s.rideshares.GetByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := s.rideRequests.GetOpenByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &model.CarpoolSummary{RidersWaiting: len(requests)}
+	for _, ride := range rides {
+		if ride.Status == model.RideshareStatusOpen {
+			summary.Rides++
+			summary.SeatsAvailable += ride.SeatsAvailable
+		}
+		if userID != "" && ride.DriverID == userID {
+			summary.MyRideID = &ride.ID
+		}
+	}
+	if userID != "" {
+		for _, rideRequest := range requests {
+			if rideRequest.UserID == userID {
+				summary.MyRequest = rideRequest
+				break
+			}
+		}
+	}
+	return summary, nil
+}
+
+// attendedEvent returns the event if the user hosts it or has an approved
+// RSVP
+func (s *RideshareService) attendedEvent(ctx context.Context, eventID, userID string) (*model.Event, error) {
+	event, err := s.events.Get(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil || event.IsUnpublished() {
+		return nil, ErrEventNotFound
+	}
+
+	isHost, err := s.events.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if isHost {
+		return event, nil
+	}
+
+	rsvp, err := s.events.GetRSVP(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if rsvp == nil || rsvp.Status != model.RSVPStatusApproved {
+		return nil, ErrNotEventAttendee
+	}
+	return event, nil
+}
+
+// carpoolEvent is attendedEvent for changes, which stop once the event is
+// no longer upcoming
+func (s *RideshareService) carpoolEvent(ctx context.Context, eventID, userID string) (*model.Event, error) {
+	event, err := s.attendedEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status != model.EventStatusPublished || !event.StartTime.After(time.Now()) {
+		return nil, ErrCarpoolClosed
+	}
+	return event, nil
+}
+
+// eventRideshare returns a rideshare attached to an event
+func (s *RideshareService) eventRideshare(ctx context.Context, rideshareID string) (*model.Rideshare, error) {
+	ride, err := s.rideshares.Get(ctx, rideshareID)
+	if err != nil {
+		return nil, err
+	}
+	if ride == nil || ride.EventID == nil {
+		return nil, ErrRideshareNotFound
+	}
+	return ride, nil
+}
+
+// eventDestination is where a ride to the event ends
+func eventDestination(event *model.Event) model.RideshareLocation {
+	if event.Location == nil {
+		return model.RideshareLocation{Name: event.Title}
+	}
+	return model.RideshareLocation{
+		Name:         event.Location.Name,
+		Address:      event.Location.Address,
+		Neighborhood: event.Location.Neighborhood,
+		City:         event.Location.City,
+		Lat:          event.Location.Lat,
+		Lng:          event.Location.Lng,
+	}
+}
+
+// routeSegments splits a ride's route into legs: origin to the first stop,
+// stop to stop, and the last stop to the event
+func routeSegments(ride *model.Rideshare, stops []model.CarpoolStop) []model.RideshareSegment {
+	points := make([]model.RideshareLocation, 0, len(stops)+2)
+	points = append(points, ride.Origin)
+	for _, stop := range stops {
+		points = append(points, stop.Location())
+	}
+	points = append(points, ride.Destination)
+
+	segments := make([]model.RideshareSegment, 0, len(points)-1)
+	for i := 0; i < len(points)-1; i++ {
+		segments = append(segments, model.RideshareSegment{
+			RideshareID:   ride.ID,
+			SequenceOrder: i,
+			PickupPoint:   points[i],
+			DropoffPoint:  points[i+1],
+		})
+	}
+	return segments
+}
+
+// ridePath returns the points a ride passes through in order
+func ridePath(ride *model.Rideshare, segments []model.RideshareSegment) []model.RideshareLocation {
+	if len(segments) == 0 {
+		return []model.RideshareLocation{ride.Origin, ride.Destination}
+	}
+	path := []model.RideshareLocation{segments[0].PickupPoint}
+	for _, segment := range segments {
+		path = append(path, segment.DropoffPoint)
+	}
+	return path
+}
+
+// routeDetourKm returns how far pickup is from the nearest point of the
+// path, treating each leg as a straight line. Points without coordinates
+// (such as an event with no map location) are skipped; ok is false when
+// no point has them.
+func routeDetourKm(pickup model.RideshareLocation, path []model.RideshareLocation) (float64, bool) {
+	// Project onto a flat plane centered on the pickup; at carpool
+	// distances the error is negligible
+	kmPerDegree := EarthRadiusKm * math.Pi / 180
+	lngScale := math.Cos(pickup.Lat * math.Pi / 180)
+	project := func(loc model.RideshareLocation) (float64, float64) {
+		return (loc.Lng - pickup.Lng) * lngScale * kmPerDegree, (loc.Lat - pickup.Lat) * kmPerDegree
+	}
+
+	var points [][2]float64
+	for _, loc := range path {
+		if loc.Lat == 0 && loc.Lng == 0 {
+			continue
+		}
+		x, y := project(loc)
+		points = append(points, [2]float64{x, y})
+	}
+	if len(points) == 0 {
+		return 0, false
+	}
+	if len(points) == 1 {
+		return math.Hypot(points[0][0], points[0][1]), true
+	}
+
+	best := math.Inf(1)
+	for i := 0; i < len(points)-1; i++ {
+		best = math.Min(best, distanceToLeg(points[i], points[i+1]))
+	}
+	return best, true
+}
+
+// distanceToLeg returns the distance from the origin to the line segment
+// from a to b
+func distanceToLeg(a, b [2]float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	lengthSq := dx*dx + dy*dy
+	if lengthSq == 0 {
+		return math.Hypot(a[0], a[1])
+	}
+	// Fraction along the leg of the point closest to the origin
+	t := math.Max(0, math.Min(1, -(a[0]*dx+a[1]*dy)/lengthSq))
+	return math.Hypot(a[0]+t*dx, a[1]+t*dy)
+}
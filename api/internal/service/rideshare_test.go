@@ -0,0 +1,415 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// kmNorth returns the latitude d km north of lat
+func kmNorth(lat, d float64) float64 {
+	return lat + d/(EarthRadiusKm*math.Pi/180)
+}
+
+// newCarpoolEvent returns event:1, a published event tomorrow at (40, -105)
+func newCarpoolEvent() *model.Event {
+	return &model.Event{
+		ID:         "event:1",
+		Title:      "Trail cleanup",
+		StartTime:  time.Now().Add(24 * time.Hour),
+		Status:     model.EventStatusPublished,
+		Visibility: "guilds",
+		Location:   &model.EventLocation{Name: "Trailhead", City: "Boulder", Lat: 40, Lng: -105},
+	}
+}
+
+// carpoolEventRepo serves event, hosted by user:host. Everyone except
+// user:outsider has an approved RSVP.
+func carpoolEventRepo(event *model.Event) *mocks.RideshareEventRepository {
+	return &mocks.RideshareEventRepository{
+		GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+			if eventID != event.ID {
+				return nil, nil
+			}
+			return event, nil
+		},
+		IsHostFunc: func(ctx context.Context, eventID, userID string) (bool, error) {
+			return userID == "user:host", nil
+		},
+		GetRSVPFunc: func(ctx context.Context, eventID, userID string) (*model.EventRSVP, error) {
+			if userID == "user:outsider" {
+				return nil, nil
+			}
+			return &model.EventRSVP{UserID: userID, Status: model.RSVPStatusApproved}, nil
+		},
+	}
+}
+
+// openRide returns an open ride from origin straight to event
+func openRide(event *model.Event, id, driverID string, origin model.RideshareLocation, seats int) *model.Rideshare {
+	return &model.Rideshare{
+		ID:             id,
+		EventID:        &event.ID,
+		DriverID:       driverID,
+		Origin:         origin,
+		Destination:    eventDestination(event),
+		SeatsTotal:     seats,
+		SeatsAvailable: seats,
+		Status:         model.RideshareStatusOpen,
+	}
+}
+
+func newCarpoolOffer(event *model.Event) *model.OfferRideRequest {
+	return &model.OfferRideRequest{
+		Title:         "Ride from downtown",
+		Origin:        model.CarpoolStop{Name: "Downtown", City: "Denver", Lat: 39.74, Lng: -104.99},
+		Stops:         []model.CarpoolStop{{Name: "Park and ride", City: "Broomfield", Lat: 39.92, Lng: -105.07}},
+		DepartureTime: event.StartTime.Add(-time.Hour),
+		SeatsTotal:    3,
+	}
+}
+
+// ============================================================================
+// Carpool Tests
+// ============================================================================
+
+func TestRouteDetourKm(t *testing.T) {
+	t.Parallel()
+
+	origin := model.RideshareLocation{Lat: 40, Lng: -105.2}
+	event := model.RideshareLocation{Lat: 40, Lng: -105}
+
+	tests := []struct {
+		name   string
+		pickup model.RideshareLocation
+		path   []model.RideshareLocation
+		wantKm float64
+		wantOK bool
+	}{
+		{"on the route", model.RideshareLocation{Lat: 40, Lng: -105.1}, []model.RideshareLocation{origin, event}, 0, true},
+		{"off the route", model.RideshareLocation{Lat: kmNorth(40, 2), Lng: -105.1}, []model.RideshareLocation{origin, event}, 2, true},
+		// Past the end of the leg the nearest point is the endpoint
+		{"beyond the event", model.RideshareLocation{Lat: kmNorth(40, 3), Lng: -105}, []model.RideshareLocation{origin, event}, 3, true},
+		// An event without coordinates leaves only the origin
+		{"only the origin mapped", origin, []model.RideshareLocation{origin, {Name: "Somewhere"}}, 0, true},
+		{"nothing mapped", origin, []model.RideshareLocation{{}, {}}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d, ok := routeDetourKm(tt.pickup, tt.path)
+			if ok != tt.wantOK {
+				t.Fatalf("routeDetourKm() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && math.Abs(d-tt.wantKm) > 0.05 {
+				t.Errorf("routeDetourKm() = %.3f km, want %.0f", d, tt.wantKm)
+			}
+		})
+	}
+}
+
+func TestRideshareService_OfferRide(t *testing.T) {
+	t.Parallel()
+
+	event := newCarpoolEvent()
+	var segments []model.RideshareSegment
+	svc := NewRideshareService(RideshareServiceConfig{
+		Rideshares: &mocks.RideshareRepository{
+			CreateFunc: func(ctx context.Context, ride *model.Rideshare) error {
+				ride.ID = "rideshare:new"
+				return nil
+			},
+			CreateSegmentsFunc: func(ctx context.Context, rideshareID string, created []model.RideshareSegment) error {
+				segments = created
+				return nil
+			},
+		},
+		Events: carpoolEventRepo(event),
+	})
+
+	ride, err := svc.OfferRide(context.Background(), "user:driver", "event:1", newCarpoolOffer(event))
+	if err != nil {
+		t.Fatalf("OfferRide() error = %v", err)
+	}
+	if ride.Destination.Name != "Trailhead" || ride.Destination.Lat != 40 || ride.Visibility != "guilds" {
+		t.Errorf("ride = %+v, want it to end at the event with the event's visibility", ride)
+	}
+	if len(segments) != 2 || segments[0].DropoffPoint.Name != "Park and ride" || segments[1].DropoffPoint.Name != "Trailhead" {
+		t.Errorf("segments = %+v, want origin → park and ride → trailhead", segments)
+	}
+}
+
+func TestRideshareService_OfferRide_Rejected(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		userID         string
+		startsIn       time.Duration
+		departs        time.Duration // Relative to the start
+		existing       []string      // Drivers already offering rides
+		wantErr        error
+		wantValidation bool
+	}{
+		{name: "departing after the start", userID: "user:driver", startsIn: 24 * time.Hour, departs: time.Minute, wantValidation: true},
+		{name: "not attending", userID: "user:outsider", startsIn: 24 * time.Hour, departs: -time.Hour, wantErr: ErrNotEventAttendee},
+		{name: "second offer", userID: "user:driver", startsIn: 24 * time.Hour, departs: -time.Hour, existing: []string{"user:driver"}, wantErr: ErrAlreadyOfferingRide},
+		{name: "event started", userID: "user:host", startsIn: -time.Hour, departs: -time.Hour, wantErr: ErrCarpoolClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := newCarpoolEvent()
+			event.StartTime = time.Now().Add(tt.startsIn)
+			var rides []*model.Rideshare
+			for _, driverID := range tt.existing {
+				rides = append(rides, openRide(event, "rideshare:1", driverID, model.RideshareLocation{}, 2))
+			}
+			svc := NewRideshareService(RideshareServiceConfig{
+				Rideshares: &mocks.RideshareRepository{
+					GetByEventFunc: func(ctx context.Context, eventID string) ([]*model.Rideshare, error) {
+						return rides, nil
+					},
+					CreateFunc: func(ctx context.Context, ride *model.Rideshare) error {
+						t.Error("expected no rideshare created")
+						return nil
+					},
+				},
+				Events: carpoolEventRepo(event),
+			})
+
+			req := newCarpoolOffer(event)
+			req.DepartureTime = event.StartTime.Add(tt.departs)
+			_, err := svc.OfferRide(context.Background(), tt.userID, "event:1", req)
+
+			if tt.wantValidation {
+				var pd *model.ProblemDetails
+				if !errors.As(err, &pd) {
+					t.Errorf("OfferRide() error = %v, want a validation error", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("OfferRide() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRideshareService_FindRideMatches(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	event := newCarpoolEvent()
+
+	// Drives east 3 km north of the pickup, then south to the event
+	north := openRide(event, "rideshare:north", "user:host", model.RideshareLocation{Lat: kmNorth(40, 3), Lng: -105.2}, 1)
+	turn := model.RideshareLocation{Name: "Turn", Lat: kmNorth(40, 3), Lng: -105}
+	rides := []*model.Rideshare{
+		// Drives straight past the pickup
+		openRide(event, "rideshare:direct", "user:driver", model.RideshareLocation{Lat: 40, Lng: -105.2}, 2),
+		north,
+		// Too far away
+		openRide(event, "rideshare:far", "user:other", model.RideshareLocation{Lat: 41, Lng: -105.1}, 2),
+		// Passes the pickup but has no seats
+		openRide(event, "rideshare:full", "user:other", model.RideshareLocation{Lat: 40, Lng: -105.2}, 0),
+		// The rider's own car
+		openRide(event, "rideshare:own", "user:rider", model.RideshareLocation{Lat: 40, Lng: -105.2}, 2),
+	}
+
+	svc := NewRideshareService(RideshareServiceConfig{
+		Rideshares: &mocks.RideshareRepository{
+			GetByEventFunc: func(ctx context.Context, eventID string) ([]*model.Rideshare, error) {
+				return rides, nil
+			},
+			GetSegmentsFunc: func(ctx context.Context, rideshareID string) ([]model.RideshareSegment, error) {
+				if rideshareID != north.ID {
+					return nil, nil
+				}
+				return []model.RideshareSegment{
+					{PickupPoint: north.Origin, DropoffPoint: turn},
+					{SequenceOrder: 1, PickupPoint: turn, DropoffPoint: north.Destination},
+				}, nil
+			},
+		},
+		RideRequests: &mocks.RideRequestRepository{
+			GetOpenFunc: func(ctx context.Context, eventID, userID string) (*model.RideRequest, error) {
+				if userID != "user:rider" {
+					return nil, nil
+				}
+				return &model.RideRequest{
+					ID: "ride_request:1", UserID: userID, Status: model.RideRequestStatusOpen,
+					Pickup: model.RideshareLocation{Name: "Corner", Lat: 40, Lng: -105.1},
+				}, nil
+			},
+		},
+		Events: carpoolEventRepo(event),
+	})
+
+	matches, err := svc.FindRideMatches(ctx, "user:rider", "event:1")
+	if err != nil {
+		t.Fatalf("FindRideMatches() error = %v", err)
+	}
+	if len(matches) != 2 || matches[0].Rideshare.ID != "rideshare:direct" || matches[1].Rideshare.ID != "rideshare:north" {
+		t.Fatalf("matches = %+v, want direct then north", matches)
+	}
+	if matches[0].MatchScore != 1 || matches[1].DistanceKm != 3 || matches[1].MatchScore != 0.4 {
+		t.Errorf("scores = %.2f, %.2f (%.1f km); want 1 and 0.4 at 3 km", matches[0].MatchScore, matches[1].MatchScore, matches[1].DistanceKm)
+	}
+
+	if _, err := svc.FindRideMatches(ctx, "user:other", "event:1"); !errors.Is(err, ErrRideRequestNotFound) {
+		t.Errorf("without a ride request: error = %v, want ErrRideRequestNotFound", err)
+	}
+}
+
+func TestRideshareService_RespondToSeat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		userID      string
+		seatsLeft   int
+		confirmed   bool
+		wantErr     error
+		wantStatus  string // Seat status
+		wantFull    bool   // Rideshare marked full
+		wantMatched bool   // Ride request closed as matched
+	}{
+		{name: "confirming the last seat", userID: "user:driver", seatsLeft: 1, confirmed: true, wantStatus: model.SeatStatusConfirmed, wantFull: true, wantMatched: true},
+		{name: "seats left", userID: "user:driver", seatsLeft: 3, confirmed: true, wantStatus: model.SeatStatusConfirmed, wantMatched: true},
+		{name: "no seats left", userID: "user:driver", seatsLeft: 0, confirmed: true, wantErr: ErrRideshareFull},
+		{name: "declining", userID: "user:driver", seatsLeft: 1, wantStatus: model.SeatStatusCancelled},
+		{name: "not the driver", userID: "user:rider", seatsLeft: 1, confirmed: true, wantErr: ErrNotRideshareDriver},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ride := openRide(newCarpoolEvent(), "rideshare:1", "user:driver", model.RideshareLocation{}, tt.seatsLeft)
+			seat := &model.RideshareSeat{ID: "rideshare_seat:1", RideshareID: ride.ID, PassengerID: "user:rider", Status: model.SeatStatusRequested}
+			var markedFull, matched bool
+			svc := NewRideshareService(RideshareServiceConfig{
+				Rideshares: &mocks.RideshareRepository{
+					GetFunc: func(ctx context.Context, rideshareID string) (*model.Rideshare, error) {
+						return ride, nil
+					},
+					GetSeatFunc: func(ctx context.Context, seatID string) (*model.RideshareSeat, error) {
+						return seat, nil
+					},
+					ReserveSeatFunc: func(ctx context.Context, rideshareID string) (*model.Rideshare, error) {
+						if ride.SeatsAvailable == 0 {
+							return nil, nil
+						}
+						ride.SeatsAvailable--
+						return ride, nil
+					},
+					SetSeatStatusFunc: func(ctx context.Context, seatID, status string) (*model.RideshareSeat, error) {
+						seat.Status = status
+						return seat, nil
+					},
+					SetStatusFunc: func(ctx context.Context, rideshareID, status string) error {
+						markedFull = status == model.RideshareStatusFull
+						return nil
+					},
+				},
+				RideRequests: &mocks.RideRequestRepository{
+					GetOpenFunc: func(ctx context.Context, eventID, userID string) (*model.RideRequest, error) {
+						return &model.RideRequest{ID: "ride_request:1", UserID: userID, Status: model.RideRequestStatusOpen}, nil
+					},
+					CloseFunc: func(ctx context.Context, requestID, status string, rideshareID *string) (*model.RideRequest, error) {
+						matched = status == model.RideRequestStatusMatched
+						return &model.RideRequest{ID: requestID, Status: status, RideshareID: rideshareID}, nil
+					},
+				},
+				Events: carpoolEventRepo(newCarpoolEvent()),
+			})
+
+			got, err := svc.RespondToSeat(context.Background(), tt.userID, ride.ID, seat.ID, &model.RespondToSeatRequest{Confirmed: tt.confirmed})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RespondToSeat() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("seat status = %s, want %s", got.Status, tt.wantStatus)
+			}
+			if markedFull != tt.wantFull {
+				t.Errorf("rideshare marked full = %v, want %v", markedFull, tt.wantFull)
+			}
+			if matched != tt.wantMatched {
+				t.Errorf("ride request matched = %v, want %v", matched, tt.wantMatched)
+			}
+		})
+	}
+}
+
+func TestRideshareService_CarpoolSummary(t *testing.T) {
+	t.Parallel()
+
+	event := newCarpoolEvent()
+	full := openRide(event, "rideshare:2", "user:host", model.RideshareLocation{}, 0)
+	full.Status = model.RideshareStatusFull
+	svc := NewRideshareService(RideshareServiceConfig{
+		Rideshares: &mocks.RideshareRepository{
+			GetByEventFunc: func(ctx context.Context, eventID string) ([]*model.Rideshare, error) {
+				return []*model.Rideshare{openRide(event, "rideshare:1", "user:driver", model.RideshareLocation{}, 3), full}, nil
+			},
+		},
+		RideRequests: &mocks.RideRequestRepository{
+			GetOpenByEventFunc: func(ctx context.Context, eventID string) ([]*model.RideRequest, error) {
+				return []*model.RideRequest{
+					{ID: "ride_request:1", UserID: "user:rider", Status: model.RideRequestStatusOpen},
+					{ID: "ride_request:2", UserID: "user:other", Status: model.RideRequestStatusOpen},
+				}, nil
+			},
+		},
+		Events: carpoolEventRepo(event),
+	})
+
+	tests := []struct {
+		userID      string
+		wantRideID  string
+		wantRequest string
+	}{
+		{userID: "user:rider", wantRequest: "ride_request:1"},
+		{userID: "user:host", wantRideID: "rideshare:2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.userID, func(t *testing.T) {
+			t.Parallel()
+
+			summary, err := svc.CarpoolSummary(context.Background(), "event:1", tt.userID)
+			if err != nil {
+				t.Fatalf("CarpoolSummary() error = %v", err)
+			}
+			if summary.Rides != 1 || summary.SeatsAvailable != 3 || summary.RidersWaiting != 2 {
+				t.Errorf("summary = %+v, want 1 ride, 3 seats, 2 riders", summary)
+			}
+			if rideID := derefString(summary.MyRideID); rideID != tt.wantRideID {
+				t.Errorf("my ride = %q, want %q", rideID, tt.wantRideID)
+			}
+			requestID := ""
+			if summary.MyRequest != nil {
+				requestID = summary.MyRequest.ID
+			}
+			if requestID != tt.wantRequest {
+				t.Errorf("my request = %q, want %q", requestID, tt.wantRequest)
+			}
+		})
+	}
+}
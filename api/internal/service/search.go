@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// SearchEngine runs full-text searches over public guilds, events,
+// interests and users. repository.SearchRepository implements it with
+// SurrealDB's full-text indexes; a dedicated engine such as Meilisearch or
+// Typesense can stand in for it once the catalog outgrows them.
+type SearchEngine interface {
+	Search(ctx context.Context, q model.SearchQuery) ([]*model.SearchResult, error)
+}
+
+// SearchService finds guilds, events, interests and people by name, for
+// members who know what they're after rather than browsing discovery
+type SearchService struct {
+	engine       SearchEngine
+	blockChecker BlockChecker
+}
+
+// SearchServiceConfig holds configuration for the search service
+type SearchServiceConfig struct {
+	Engine       SearchEngine
+	BlockChecker BlockChecker // Optional; nil skips hiding people the searcher has blocked or been blocked by
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(cfg SearchServiceConfig) *SearchService {
+	return &SearchService{
+		engine:       cfg.Engine,
+		blockChecker: cfg.BlockChecker,
+	}
+}
+
+// Search matches text against the given types, or every type when none are
+// given, and returns the matches best first. limit caps the matches of each
+// type.
+func (s *SearchService) Search(ctx context.Context, userID, text string, types []string, limit int) ([]*model.SearchResult, error) {
+	text = strings.TrimSpace(text)
+	if n := utf8.RuneCountInString(text); n < model.MinSearchQueryLength || n > model.MaxSearchQueryLength {
+		return nil, ErrSearchQueryLength
+	}
+
+	searchTypes, err := searchTypesFor(types)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = model.DefaultSearchLimit
+	}
+	if limit > model.MaxSearchLimit {
+		limit = model.MaxSearchLimit
+	}
+
+	results, err := s.engine.Search(ctx, model.SearchQuery{
+		Text:  text,
+		Types: searchTypes,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]*model.SearchResult, 0, len(results))
+	for _, result := range results {
+		if result.Type == model.SearchTypeUser && s.isBlocked(ctx, userID, result.ID) {
+			continue
+		}
+		visible = append(visible, result)
+	}
+	sort.SliceStable(visible, func(i, j int) bool {
+		return visible[i].Score > visible[j].Score
+	})
+	return visible, nil
+}
+
+// searchTypesFor checks the requested types and drops repeats. No types
+// means all of them.
+func searchTypesFor(types []string) ([]string, error) {
+	if len(types) == 0 {
+		return model.SearchTypes, nil
+	}
+	seen := make(map[string]bool, len(types))
+	searchTypes := make([]string, 0, len(types))
+	for _, t := range model.SearchTypes {
+		seen[t] = false
+	}
+	for _, t := range types {
+		done, ok := seen[t]
+		if !ok {
+			return nil, ErrInvalidSearchType
+		}
+		if !done {
+			seen[t] = true
+			searchTypes = append(searchTypes, t)
+		}
+	}
+	return searchTypes, nil
+}
+
+// isBlocked checks if two users have blocked each other
+func (s *SearchService) isBlocked(ctx context.Context, userID1, userID2 string) bool {
+	if s.blockChecker == nil {
+		return false
+	}
+	blocked, err := s.blockChecker.IsBlockedEitherWay(ctx, userID1, userID2)
+	if err != nil {
+		return false // Fail open to avoid breaking search on errors
+	}
+	return blocked
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+type stubSearchEngine struct {
+	results []*model.SearchResult
+	query   model.SearchQuery
+}
+
+func (e *stubSearchEngine) Search(ctx context.Context, q model.SearchQuery) ([]*model.SearchResult, error) {
+	e.query = q
+	return e.results, nil
+}
+
+func TestSearchService_Search_ValidatesQuery(t *testing.T) {
+	svc := NewSearchService(SearchServiceConfig{Engine: &stubSearchEngine{}})
+
+	if _, err := svc.Search(context.Background(), "user:1", "  a ", nil, 0); !errors.Is(err, ErrSearchQueryLength) {
+		t.Errorf("one character: err = %v, want ErrSearchQueryLength", err)
+	}
+	if _, err := svc.Search(context.Background(), "user:1", "board games", []string{"guild", "planet"}, 0); !errors.Is(err, ErrInvalidSearchType) {
+		t.Errorf("unknown type: err = %v, want ErrInvalidSearchType", err)
+	}
+}
+
+func TestSearchService_Search_TypesAndLimit(t *testing.T) {
+	engine := &stubSearchEngine{}
+	svc := NewSearchService(SearchServiceConfig{Engine: engine})
+
+	if _, err := svc.Search(context.Background(), "user:1", " board game night ", nil, 500); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	want := model.SearchQuery{Text: "board game night", Types: model.SearchTypes, Limit: model.MaxSearchLimit}
+	if !reflect.DeepEqual(engine.query, want) {
+		t.Errorf("query = %+v, want %+v", engine.query, want)
+	}
+
+	if _, err := svc.Search(context.Background(), "user:1", "chess", []string{"event", "guild", "event"}, 0); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	want = model.SearchQuery{Text: "chess", Types: []string{"event", "guild"}, Limit: model.DefaultSearchLimit}
+	if !reflect.DeepEqual(engine.query, want) {
+		t.Errorf("query = %+v, want %+v", engine.query, want)
+	}
+}
+
+func TestSearchService_Search_RanksAndHidesBlockedUsers(t *testing.T) {
+	engine := &stubSearchEngine{results: []*model.SearchResult{
+		{Type: model.SearchTypeGuild, ID: "guild:1", Score: 1.5},
+		{Type: model.SearchTypeEvent, ID: "event:1", Score: 4.2},
+		{Type: model.SearchTypeUser, ID: "user:blocked", Score: 9},
+		{Type: model.SearchTypeUser, ID: "user:2", Score: 2},
+	}}
+	blocks := &mockBlockChecker{isBlockedFunc: func(ctx context.Context, userID1, userID2 string) (bool, error) {
+		return userID2 == "user:blocked", nil
+	}}
+	svc := NewSearchService(SearchServiceConfig{Engine: engine, BlockChecker: blocks})
+
+	results, err := svc.Search(context.Background(), "user:1", "game", nil, 0)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	var got []string
+	for _, r := range results {
+		got = append(got, r.ID)
+	}
+	want := []string{"event:1", "user:2", "guild:1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("results = %v, want %v", got, want)
+	}
+}
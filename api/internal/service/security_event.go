@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// SecurityEventRepository defines the interface for security log storage
+type SecurityEventRepository interface {
+	Create(ctx context.Context, event *model.SecurityEvent) error
+	ListByUser(ctx context.Context, userID string, limit int) ([]*model.SecurityEvent, error)
+	ListByTypes(ctx context.Context, types []string, limit int) ([]*model.SecurityEvent, error)
+}
+
+// SecurityEventRecorder appends to a user's security log
+type SecurityEventRecorder interface {
+	Record(ctx context.Context, userID, eventType, detail string)
+}
+
+// SecurityEventService keeps each user's security log: password and email
+// changes and account recoveries, for admins to review. It also logs the
+// clients caught scraping.
+type SecurityEventService struct {
+	repo SecurityEventRepository
+}
+
+// SecurityEventServiceConfig holds configuration for the security event service
+type SecurityEventServiceConfig struct {
+	Repo SecurityEventRepository
+}
+
+// NewSecurityEventService creates a new security event service
+func NewSecurityEventService(cfg SecurityEventServiceConfig) *SecurityEventService {
+	return &SecurityEventService{repo: cfg.Repo}
+}
+
+// Record appends an event to a user's security log. A failure is logged
+// rather than returned so it never undoes the change being recorded.
+func (s *SecurityEventService) Record(ctx context.Context, userID, eventType, detail string) {
+	event := &model.SecurityEvent{
+		UserID: userID,
+		Type:   eventType,
+		Detail: detail,
+	}
+	if err := s.repo.Create(ctx, event); err != nil {
+		log.Printf("[SecurityEventService] Failed to record %q for %s: %v", eventType, userID, err)
+	}
+}
+
+// RecordClient logs an event against a client address rather than a user,
+// such as a scraper detection. Failures are logged, not returned.
+func (s *SecurityEventService) RecordClient(ctx context.Context, ip, eventType, detail string) {
+	event := &model.SecurityEvent{
+		IP:     ip,
+		Type:   eventType,
+		Detail: detail,
+	}
+	if err := s.repo.Create(ctx, event); err != nil {
+		log.Printf("[SecurityEventService] Failed to record %q for %s: %v", eventType, ip, err)
+	}
+}
+
+// List returns a user's security log, newest first
+func (s *SecurityEventService) List(ctx context.Context, userID string, limit int) ([]*model.SecurityEvent, error) {
+	return s.repo.ListByUser(ctx, userID, clampSecurityEventLimit(limit))
+}
+
+// ListScraperDetections returns the latest honeypot hits, tarpittings and
+// blocks, newest first
+func (s *SecurityEventService) ListScraperDetections(ctx context.Context, limit int) ([]*model.SecurityEvent, error) {
+	return s.repo.ListByTypes(ctx, model.ScraperSecurityEvents, clampSecurityEventLimit(limit))
+}
+
+// ListBlockedAdminAttempts returns the latest requests to admin routes the
+// network policy turned away, newest first
+func (s *SecurityEventService) ListBlockedAdminAttempts(ctx context.Context, limit int) ([]*model.SecurityEvent, error) {
+	return s.repo.ListByTypes(ctx, []string{model.SecurityEventAdminAccessBlocked}, clampSecurityEventLimit(limit))
+}
+
+func clampSecurityEventLimit(limit int) int {
+	if limit <= 0 {
+		return model.DefaultSecurityEventLimit
+	}
+	if limit > model.MaxSecurityEventLimit {
+		return model.MaxSecurityEventLimit
+	}
+	return limit
+}
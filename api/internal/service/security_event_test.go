@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+type mockSecurityEventRepo struct {
+	events    []*model.SecurityEvent
+	lastLimit int
+	err       error
+}
+
+func (m *mockSecurityEventRepo) Create(ctx context.Context, event *model.SecurityEvent) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockSecurityEventRepo) ListByUser(ctx context.Context, userID string, limit int) ([]*model.SecurityEvent, error) {
+	m.lastLimit = limit
+	var events []*model.SecurityEvent
+	for _, e := range m.events {
+		if e.UserID == userID {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (m *mockSecurityEventRepo) ListByTypes(ctx context.Context, types []string, limit int) ([]*model.SecurityEvent, error) {
+	m.lastLimit = limit
+	var events []*model.SecurityEvent
+	for _, e := range m.events {
+		for _, eventType := range types {
+			if e.Type == eventType {
+				events = append(events, e)
+			}
+		}
+	}
+	return events, nil
+}
+
+func TestSecurityEventService_Record(t *testing.T) {
+	repo := &mockSecurityEventRepo{}
+	svc := NewSecurityEventService(SecurityEventServiceConfig{Repo: repo})
+	ctx := context.Background()
+
+	svc.Record(ctx, "user:1", model.SecurityEventPasswordChanged, "")
+	svc.Record(ctx, "user:2", model.SecurityEventEmailChanged, "from a@example.com to b@example.com")
+
+	events, err := svc.List(ctx, "user:1", 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != model.SecurityEventPasswordChanged {
+		t.Errorf("expected user:1's password change, got %+v", events)
+	}
+
+	// A storage failure is logged, not surfaced
+	repo.err = errors.New("db down")
+	svc.Record(ctx, "user:1", model.SecurityEventPasswordChanged, "")
+}
+
+func TestSecurityEventService_List_ClampsLimit(t *testing.T) {
+	tests := []struct {
+		limit int
+		want  int
+	}{
+		{0, model.DefaultSecurityEventLimit},
+		{-5, model.DefaultSecurityEventLimit},
+		{20, 20},
+		{10000, model.MaxSecurityEventLimit},
+	}
+
+	for _, tt := range tests {
+		repo := &mockSecurityEventRepo{}
+		svc := NewSecurityEventService(SecurityEventServiceConfig{Repo: repo})
+		if _, err := svc.List(context.Background(), "user:1", tt.limit); err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if repo.lastLimit != tt.want {
+			t.Errorf("List(limit=%d) used %d, want %d", tt.limit, repo.lastLimit, tt.want)
+		}
+	}
+}
+
+func TestSecurityEventService_ListScraperDetections(t *testing.T) {
+	repo := &mockSecurityEventRepo{}
+	svc := NewSecurityEventService(SecurityEventServiceConfig{Repo: repo})
+	ctx := context.Background()
+
+	svc.Record(ctx, "user:1", model.SecurityEventPasswordChanged, "")
+	svc.RecordClient(ctx, "203.0.113.7", model.SecurityEventHoneypotHit, "requested decoy /wp-login.php")
+
+	events, err := svc.ListScraperDetections(ctx, 0)
+	if err != nil {
+		t.Fatalf("ListScraperDetections failed: %v", err)
+	}
+	if len(events) != 1 || events[0].IP != "203.0.113.7" || events[0].UserID != "" {
+		t.Errorf("expected only the honeypot hit, by address, got %+v", events)
+	}
+	if repo.lastLimit != model.DefaultSecurityEventLimit {
+		t.Errorf("expected the default limit, got %d", repo.lastLimit)
+	}
+}
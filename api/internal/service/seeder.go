@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	mrand "math/rand/v2"
+	"sort"
 	"time"
 
 	"github.com/forgo/saga/api/internal/database"
@@ -16,12 +17,14 @@ import (
 
 // SeederService generates mock data for testing and development
 type SeederService struct {
-	db database.Database
+	db   database.Database
+	rand *mrand.Rand
 }
 
-// NewSeederService creates a new seeder service
-func NewSeederService(db database.Database) *SeederService {
-	return &SeederService{db: db}
+// NewSeederService creates a new seeder service. A seeded src makes the
+// generated names, locations and times repeatable; nil seeds randomly.
+func NewSeederService(db database.Database, src mrand.Source) *SeederService {
+	return &SeederService{db: db, rand: newRand(src)}
 }
 
 // SeedUsersRequest configures user seeding
@@ -181,8 +184,8 @@ func (s *SeederService) SeedUsers(ctx context.Context, req SeedUsersRequest) (*S
 		randID := randomID()
 		email := fmt.Sprintf("%s%s@test.local", req.Prefix, randID)
 		username := fmt.Sprintf("%s%s", req.Prefix, randID)
-		firstName := firstNames[mrand.IntN(len(firstNames))]
-		lastName := lastNames[mrand.IntN(len(lastNames))]
+		firstName := firstNames[s.rand.IntN(len(firstNames))]
+		lastName := lastNames[s.rand.IntN(len(lastNames))]
 
 		// Create user
 		userQuery := `
@@ -216,15 +219,15 @@ func (s *SeederService) SeedUsers(ctx context.Context, req SeedUsersRequest) (*S
 		ids = append(ids, userID)
 
 		// Generate location within bounds
-		lat := req.Region.MinLat + mrand.Float64()*(req.Region.MaxLat-req.Region.MinLat)
-		lng := req.Region.MinLng + mrand.Float64()*(req.Region.MaxLng-req.Region.MinLng)
+		lat := req.Region.MinLat + s.rand.Float64()*(req.Region.MaxLat-req.Region.MinLat)
+		lng := req.Region.MinLng + s.rand.Float64()*(req.Region.MaxLng-req.Region.MinLng)
 
 		// Determine last active based on distribution
-		lastActive := generateLastActive(req.ActivityDistribution)
+		lastActive := s.generateLastActive(req.ActivityDistribution)
 
 		// Create profile
-		bio := bios[mrand.IntN(len(bios))]
-		tagline := taglines[mrand.IntN(len(taglines))]
+		bio := bios[s.rand.IntN(len(bios))]
+		tagline := taglines[s.rand.IntN(len(taglines))]
 
 		profileQuery := `
 			CREATE profile CONTENT {
@@ -312,7 +315,7 @@ func (s *SeederService) SeedGuilds(ctx context.Context, req SeedGuildsRequest) (
 	ids := make([]string, 0, req.Count)
 
 	for i := 0; i < req.Count; i++ {
-		name := fmt.Sprintf("%s%s", req.Prefix, guildNames[mrand.IntN(len(guildNames))])
+		name := fmt.Sprintf("%s%s", req.Prefix, guildNames[s.rand.IntN(len(guildNames))])
 		description := fmt.Sprintf("A community for %s enthusiasts", name)
 
 		// Create guild
@@ -457,8 +460,8 @@ func (s *SeederService) SeedEvents(ctx context.Context, req SeedEventsRequest) (
 			continue
 		}
 
-		title := fmt.Sprintf("%s%s", req.Prefix, eventTitles[mrand.IntN(len(eventTitles))])
-		startTime := time.Now().Add(time.Duration(mrand.IntN(14)+1) * 24 * time.Hour)
+		title := fmt.Sprintf("%s%s", req.Prefix, eventTitles[s.rand.IntN(len(eventTitles))])
+		startTime := time.Now().Add(time.Duration(s.rand.IntN(14)+1) * 24 * time.Hour)
 		endTime := startTime.Add(2 * time.Hour)
 		confirmDeadline := endTime.Add(48 * time.Hour)
 
@@ -680,7 +683,7 @@ func randomID() string {
 	return hex.EncodeToString(b)
 }
 
-func generateLastActive(distribution map[string]int) time.Time {
+func (s *SeederService) generateLastActive(distribution map[string]int) time.Time {
 	total := 0
 	for _, v := range distribution {
 		total += v
@@ -689,21 +692,28 @@ func generateLastActive(distribution map[string]int) time.Time {
 		return time.Now().Add(-24 * time.Hour)
 	}
 
-	r := mrand.IntN(total)
+	r := s.rand.IntN(total)
 	cumulative := 0
 
-	for status, weight := range distribution {
-		cumulative += weight
+	// Walk statuses in a fixed order so a seeded source picks the same one
+	statuses := make([]string, 0, len(distribution))
+	for status := range distribution {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	for _, status := range statuses {
+		cumulative += distribution[status]
 		if r < cumulative {
 			switch status {
 			case "active_now":
-				return time.Now().Add(-time.Duration(mrand.IntN(10)) * time.Minute)
+				return time.Now().Add(-time.Duration(s.rand.IntN(10)) * time.Minute)
 			case "active_today":
-				return time.Now().Add(-time.Duration(mrand.IntN(24)) * time.Hour)
+				return time.Now().Add(-time.Duration(s.rand.IntN(24)) * time.Hour)
 			case "active_this_week":
-				return time.Now().Add(-time.Duration(mrand.IntN(7)*24) * time.Hour)
+				return time.Now().Add(-time.Duration(s.rand.IntN(7)*24) * time.Hour)
 			case "away":
-				return time.Now().Add(-time.Duration(mrand.IntN(30)+7) * 24 * time.Hour)
+				return time.Now().Add(-time.Duration(s.rand.IntN(30)+7) * 24 * time.Hour)
 			}
 		}
 	}
@@ -0,0 +1,483 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ShareLinkRepository defines the storage for share links
+type ShareLinkRepository interface {
+	Create(ctx context.Context, link *model.ShareLink) error
+	GetByCode(ctx context.Context, code string) (*model.ShareLink, error)
+	ClaimUse(ctx context.Context, id string) (bool, error)
+	ReleaseUse(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+}
+
+// ShareEventSource provides the events share links can point at
+type ShareEventSource interface {
+	Get(ctx context.Context, eventID string) (*model.Event, error)
+	IsHost(ctx context.Context, eventID, userID string) (bool, error)
+}
+
+// ShareGuildSource provides the guilds share links can point at
+type ShareGuildSource interface {
+	GetByID(ctx context.Context, id string) (*model.Guild, error)
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error)
+	CountMembers(ctx context.Context, guildID string) (int, error)
+}
+
+// ShareAdventureSource provides the adventures share links can point at
+type ShareAdventureSource interface {
+	GetByID(ctx context.Context, id string) (*model.Adventure, error)
+}
+
+// ShareGuildInviter adds users who redeem guild invite links
+type ShareGuildInviter interface {
+	JoinGuildByInvite(ctx context.Context, userID, guildID string) error
+}
+
+// ShareAdventureInviter checks adventure access and admits users who redeem
+// adventure invite links
+type ShareAdventureInviter interface {
+	IsAdmitted(ctx context.Context, adventureID, userID string) (bool, error)
+	CanOrganize(ctx context.Context, adventure *model.Adventure, userID string) (bool, error)
+	AdmitByInvite(ctx context.Context, adventureID, userID, inviterID string) (*model.AdventureAdmission, error)
+}
+
+// Share tokens are a random code followed by a truncated HMAC of the code, both
+// base64url encoded: 12 + 12 characters
+const (
+	shareCodeBytes      = 9
+	shareSignatureBytes = 9
+)
+
+var shareCodeLength = base64.RawURLEncoding.EncodedLen(shareCodeBytes)
+
+// ShareLinkService creates, resolves and redeems share links
+type ShareLinkService struct {
+	repo             ShareLinkRepository
+	events           ShareEventSource
+	guilds           ShareGuildSource
+	adventures       ShareAdventureSource
+	guildInviter     ShareGuildInviter
+	adventureInviter ShareAdventureInviter
+	signingKey       []byte
+	baseURL          string
+	clock            clock.Clock
+}
+
+// ShareLinkServiceConfig holds configuration for the share link service
+type ShareLinkServiceConfig struct {
+	Repo             ShareLinkRepository
+	Events           ShareEventSource
+	Guilds           ShareGuildSource
+	Adventures       ShareAdventureSource
+	GuildInviter     ShareGuildInviter
+	AdventureInviter ShareAdventureInviter
+	SigningKey       []byte      // Signs tokens; rotating it invalidates every link
+	BaseURL          string      // Public site that serves /s/{token}
+	Clock            clock.Clock // Default: the system clock
+}
+
+// NewShareLinkService creates a new share link service
+func NewShareLinkService(cfg ShareLinkServiceConfig) *ShareLinkService {
+	return &ShareLinkService{
+		repo:             cfg.Repo,
+		events:           cfg.Events,
+		guilds:           cfg.Guilds,
+		adventures:       cfg.Adventures,
+		guildInviter:     cfg.GuildInviter,
+		adventureInviter: cfg.AdventureInviter,
+		signingKey:       cfg.SigningKey,
+		baseURL:          strings.TrimSuffix(cfg.BaseURL, "/"),
+		clock:            clock.OrReal(cfg.Clock),
+	}
+}
+
+// CreateLink creates a share link for a target the user can see. Invite links
+// additionally require the user to manage the guild or adventure.
+func (s *ShareLinkService) CreateLink(ctx context.Context, userID string, req *model.CreateShareLinkRequest) (*model.ShareLink, error) {
+	if errs := req.Validate(); len(errs) > 0 {
+		return nil, model.NewValidationError(errs)
+	}
+
+	if err := s.checkCanShare(ctx, userID, req.TargetType, req.TargetID, req.Invite); err != nil {
+		return nil, err
+	}
+
+	code, err := newShareCode()
+	if err != nil {
+		return nil, fmt.Errorf("generating share code: %w", err)
+	}
+
+	link := &model.ShareLink{
+		Code:       code,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		CreatedBy:  userID,
+		Invite:     req.Invite,
+		MaxUses:    req.MaxUses,
+	}
+	if req.ExpiresInHours != nil {
+		expires := s.clock.Now().Add(time.Duration(*req.ExpiresInHours) * time.Hour)
+		link.ExpiresOn = &expires
+	}
+
+	if err := s.repo.Create(ctx, link); err != nil {
+		return nil, fmt.Errorf("creating share link: %w", err)
+	}
+
+	s.decorate(link)
+	return link, nil
+}
+
+// Resolve returns the target of a share token with a preview that is safe to
+// show without authentication
+func (s *ShareLinkService) Resolve(ctx context.Context, token string) (*model.ShareLinkResolution, error) {
+	link, err := s.lookup(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := s.buildPreview(ctx, link.TargetType, link.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ShareLinkResolution{
+		TargetType: link.TargetType,
+		TargetID:   link.TargetID,
+		Invite:     link.Invite && !link.IsExhausted(),
+		ExpiresOn:  link.ExpiresOn,
+		Preview:    preview,
+	}, nil
+}
+
+// Accept redeems an invite link, joining the user to the guild or admitting
+// them to the adventure. A use is only counted when the redemption succeeds.
+func (s *ShareLinkService) Accept(ctx context.Context, userID, token string) (*model.ShareLinkResolution, error) {
+	link, err := s.lookup(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !link.Invite {
+		return nil, ErrShareLinkNotInvite
+	}
+
+	claimed, err := s.repo.ClaimUse(ctx, link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("claiming invite use: %w", err)
+	}
+	if !claimed {
+		return nil, ErrShareLinkExhausted
+	}
+
+	if err := s.redeem(ctx, userID, link); err != nil {
+		if releaseErr := s.repo.ReleaseUse(ctx, link.ID); releaseErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("releasing invite use: %w", releaseErr))
+		}
+		return nil, err
+	}
+
+	return s.Resolve(ctx, token)
+}
+
+// Revoke deletes a share link. Only its creator may revoke it.
+func (s *ShareLinkService) Revoke(ctx context.Context, userID, token string) error {
+	code, ok := s.verifyToken(token)
+	if !ok {
+		return ErrShareLinkNotFound
+	}
+	link, err := s.repo.GetByCode(ctx, code)
+	if err != nil {
+		return fmt.Errorf("getting share link: %w", err)
+	}
+	if link == nil {
+		return ErrShareLinkNotFound
+	}
+	if link.CreatedBy != userID {
+		return ErrShareNotAllowed
+	}
+	return s.repo.Delete(ctx, link.ID)
+}
+
+// lookup verifies a token's signature and returns its live link
+func (s *ShareLinkService) lookup(ctx context.Context, token string) (*model.ShareLink, error) {
+	code, ok := s.verifyToken(token)
+	if !ok {
+		return nil, ErrShareLinkNotFound
+	}
+
+	link, err := s.repo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("getting share link: %w", err)
+	}
+	if link == nil {
+		return nil, ErrShareLinkNotFound
+	}
+	if link.IsExpired(s.clock.Now()) {
+		return nil, ErrShareLinkExpired
+	}
+
+	s.decorate(link)
+	return link, nil
+}
+
+func (s *ShareLinkService) redeem(ctx context.Context, userID string, link *model.ShareLink) error {
+	switch link.TargetType {
+	case model.ShareTargetGuild:
+		return s.guildInviter.JoinGuildByInvite(ctx, userID, link.TargetID)
+	case model.ShareTargetAdventure:
+		_, err := s.adventureInviter.AdmitByInvite(ctx, link.TargetID, userID, link.CreatedBy)
+		return err
+	}
+	return ErrShareLinkNotInvite
+}
+
+// checkCanShare returns ErrShareTargetNotFound when the user cannot see the
+// target, so existence is not revealed, and ErrShareNotAllowed when they can
+// see it but may not create an invite for it
+func (s *ShareLinkService) checkCanShare(ctx context.Context, userID string, targetType model.ShareTargetType, targetID string, invite bool) error {
+	switch targetType {
+	case model.ShareTargetEvent:
+		return s.checkCanShareEvent(ctx, userID, targetID)
+	case model.ShareTargetGuild:
+		return s.checkCanShareGuild(ctx, userID, targetID, invite)
+	case model.ShareTargetAdventure:
+		return s.checkCanShareAdventure(ctx, userID, targetID, invite)
+	}
+	return ErrShareTargetNotFound
+}
+
+func (s *ShareLinkService) checkCanShareEvent(ctx context.Context, userID, eventID string) error {
+	event, err := s.events.Get(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("getting event: %w", err)
+	}
+	if event == nil {
+		return ErrShareTargetNotFound
+	}
+	if isPublicEvent(event) {
+		return nil
+	}
+
+	isHost, err := s.events.IsHost(ctx, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("checking host: %w", err)
+	}
+	if isHost {
+		return nil
+	}
+
+	// Guild events can be shared by guild members once published
+	if event.Visibility == model.EventVisibilityGuilds && !event.IsUnpublished() && event.GuildID != nil {
+		isMember, err := s.guilds.IsMember(ctx, userID, *event.GuildID)
+		if err != nil {
+			return fmt.Errorf("checking membership: %w", err)
+		}
+		if isMember {
+			return nil
+		}
+	}
+	return ErrShareTargetNotFound
+}
+
+func (s *ShareLinkService) checkCanShareGuild(ctx context.Context, userID, guildID string, invite bool) error {
+	guild, err := s.guilds.GetByID(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("getting guild: %w", err)
+	}
+	if guild == nil {
+		return ErrShareTargetNotFound
+	}
+
+	isMember, err := s.guilds.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return fmt.Errorf("checking membership: %w", err)
+	}
+	if !isMember && guild.Visibility != model.GuildVisibilityPublic {
+		return ErrShareTargetNotFound
+	}
+
+	if invite {
+		isAdmin, err := s.guilds.IsGuildAdmin(ctx, userID, guildID)
+		if err != nil {
+			return fmt.Errorf("checking admin status: %w", err)
+		}
+		if !isAdmin {
+			return ErrShareNotAllowed
+		}
+	}
+	return nil
+}
+
+func (s *ShareLinkService) checkCanShareAdventure(ctx context.Context, userID, adventureID string, invite bool) error {
+	adventure, err := s.adventures.GetByID(ctx, adventureID)
+	if err != nil {
+		return fmt.Errorf("getting adventure: %w", err)
+	}
+	if adventure == nil {
+		return ErrShareTargetNotFound
+	}
+
+	canOrganize, err := s.adventureInviter.CanOrganize(ctx, adventure, userID)
+	if err != nil {
+		return fmt.Errorf("checking organizer: %w", err)
+	}
+	if canOrganize {
+		return nil
+	}
+	if !s.canSeeAdventure(ctx, userID, adventure) {
+		return ErrShareTargetNotFound
+	}
+	if invite {
+		return ErrShareNotAllowed
+	}
+	return nil
+}
+
+// canSeeAdventure reports whether a non-organizer can see the adventure:
+// public ones, guild ones for guild members, and any but drafts once admitted
+func (s *ShareLinkService) canSeeAdventure(ctx context.Context, userID string, adventure *model.Adventure) bool {
+	switch adventure.Visibility {
+	case model.AdventureVisibilityPublic:
+		return true
+	case model.AdventureVisibilityPrivate:
+		return false
+	case model.AdventureVisibilityGuilds:
+		if adventure.GuildID != nil {
+			if isMember, err := s.guilds.IsMember(ctx, userID, *adventure.GuildID); err == nil && isMember {
+				return true
+			}
+		}
+	}
+	admitted, err := s.adventureInviter.IsAdmitted(ctx, adventure.ID, userID)
+	return err == nil && admitted
+}
+
+// buildPreview summarizes the target for unfurling. Non-public targets only
+// reveal their type and title.
+func (s *ShareLinkService) buildPreview(ctx context.Context, targetType model.ShareTargetType, targetID string) (*model.SharePreview, error) {
+	switch targetType {
+	case model.ShareTargetEvent:
+		event, err := s.events.Get(ctx, targetID)
+		if err != nil {
+			return nil, fmt.Errorf("getting event: %w", err)
+		}
+		if event == nil || event.IsUnpublished() {
+			return nil, ErrShareLinkNotFound
+		}
+		return eventPreview(event), nil
+
+	case model.ShareTargetGuild:
+		guild, err := s.guilds.GetByID(ctx, targetID)
+		if err != nil {
+			return nil, fmt.Errorf("getting guild: %w", err)
+		}
+		if guild == nil {
+			return nil, ErrShareLinkNotFound
+		}
+		preview := &model.SharePreview{TargetType: targetType, Title: guild.Name}
+		if guild.Visibility != model.GuildVisibilityPublic {
+			preview.Restricted = true
+			return preview, nil
+		}
+		preview.Description = stringPtr(guild.Description)
+		if count, err := s.guilds.CountMembers(ctx, guild.ID); err == nil {
+			preview.MemberCount = &count
+		}
+		return preview, nil
+
+	case model.ShareTargetAdventure:
+		adventure, err := s.adventures.GetByID(ctx, targetID)
+		if err != nil {
+			return nil, fmt.Errorf("getting adventure: %w", err)
+		}
+		if adventure == nil {
+			return nil, ErrShareLinkNotFound
+		}
+		return adventurePreview(adventure), nil
+	}
+	return nil, ErrShareLinkNotFound
+}
+
+func eventPreview(event *model.Event) *model.SharePreview {
+	preview := &model.SharePreview{TargetType: model.ShareTargetEvent, Title: event.Title}
+	if !isPublicEvent(event) {
+		preview.Restricted = true
+		return preview
+	}
+	preview.Description = event.Description
+	preview.ImageURL = event.CoverImage
+	preview.StartsOn = &event.StartTime
+	preview.EndsOn = event.EndTime
+	preview.Status = event.Status
+	// City only; the address and coordinates stay with attendees
+	if event.Location != nil && !event.Location.IsVirtual {
+		preview.City = stringPtr(event.Location.City)
+	}
+	return preview
+}
+
+func adventurePreview(adventure *model.Adventure) *model.SharePreview {
+	preview := &model.SharePreview{TargetType: model.ShareTargetAdventure, Title: adventure.Title}
+	if adventure.Visibility != model.AdventureVisibilityPublic {
+		preview.Restricted = true
+		return preview
+	}
+	preview.Description = adventure.Description
+	preview.ImageURL = adventure.CoverImage
+	preview.StartsOn = &adventure.StartDate
+	preview.EndsOn = &adventure.EndDate
+	preview.Status = string(adventure.Status)
+	return preview
+}
+
+// isPublicEvent reports whether anyone may see the event
+func isPublicEvent(event *model.Event) bool {
+	return event.Visibility == model.EventVisibilityPublic && !event.IsUnpublished()
+}
+
+// decorate fills in the token and URL, which are derived rather than stored
+func (s *ShareLinkService) decorate(link *model.ShareLink) {
+	link.Token = link.Code + s.sign(link.Code)
+	link.URL = s.baseURL + model.ShareLinkPathPrefix + link.Token
+}
+
+func (s *ShareLinkService) sign(code string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(code))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:shareSignatureBytes])
+}
+
+// verifyToken checks the token's signature and returns its code
+func (s *ShareLinkService) verifyToken(token string) (string, bool) {
+	if len(token) <= shareCodeLength {
+		return "", false
+	}
+	code, signature := token[:shareCodeLength], token[shareCodeLength:]
+	if !hmac.Equal([]byte(signature), []byte(s.sign(code))) {
+		return "", false
+	}
+	return code, true
+}
+
+func newShareCode() (string, error) {
+	b := make([]byte, shareCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
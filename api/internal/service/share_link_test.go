@@ -0,0 +1,448 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+// mockShareLinkRepo keeps share links in memory, keyed by code
+type mockShareLinkRepo struct {
+	links map[string]*model.ShareLink
+}
+
+func (m *mockShareLinkRepo) Create(ctx context.Context, link *model.ShareLink) error {
+	link.ID = "share_link:" + link.Code
+	stored := *link
+	m.links[link.Code] = &stored
+	return nil
+}
+
+func (m *mockShareLinkRepo) GetByCode(ctx context.Context, code string) (*model.ShareLink, error) {
+	link, ok := m.links[code]
+	if !ok {
+		return nil, nil
+	}
+	copied := *link
+	return &copied, nil
+}
+
+func (m *mockShareLinkRepo) byID(id string) *model.ShareLink {
+	for _, link := range m.links {
+		if link.ID == id {
+			return link
+		}
+	}
+	return nil
+}
+
+func (m *mockShareLinkRepo) ClaimUse(ctx context.Context, id string) (bool, error) {
+	link := m.byID(id)
+	if link == nil || link.IsExhausted() {
+		return false, nil
+	}
+	link.UseCount++
+	return true, nil
+}
+
+func (m *mockShareLinkRepo) ReleaseUse(ctx context.Context, id string) error {
+	if link := m.byID(id); link != nil && link.UseCount > 0 {
+		link.UseCount--
+	}
+	return nil
+}
+
+func (m *mockShareLinkRepo) Delete(ctx context.Context, id string) error {
+	if link := m.byID(id); link != nil {
+		delete(m.links, link.Code)
+	}
+	return nil
+}
+
+type mockShareEvents struct {
+	events map[string]*model.Event
+	hosts  map[string]string
+}
+
+func (m *mockShareEvents) Get(ctx context.Context, eventID string) (*model.Event, error) {
+	return m.events[eventID], nil
+}
+
+func (m *mockShareEvents) IsHost(ctx context.Context, eventID, userID string) (bool, error) {
+	return m.hosts[eventID] == userID, nil
+}
+
+type mockShareAdventures struct {
+	adventures map[string]*model.Adventure
+}
+
+func (m *mockShareAdventures) GetByID(ctx context.Context, id string) (*model.Adventure, error) {
+	return m.adventures[id], nil
+}
+
+// mockGuildInviter records invite joins and fails for users already joined
+type mockGuildInviter struct {
+	joined map[string]bool
+}
+
+func (m *mockGuildInviter) JoinGuildByInvite(ctx context.Context, userID, guildID string) error {
+	if m.joined[userID] {
+		return ErrAlreadyGuildMember
+	}
+	m.joined[userID] = true
+	return nil
+}
+
+type shareTestDeps struct {
+	links   *mockShareLinkRepo
+	guilds  *mockGuildRepo
+	inviter *mockGuildInviter
+}
+
+func newShareTestService(guildRepo ShareGuildSource, deps *shareTestDeps) *ShareLinkService {
+	description := "Board games every Tuesday"
+	address := "12 Rua Augusta"
+	return NewShareLinkService(ShareLinkServiceConfig{
+		Repo: deps.links,
+		Events: &mockShareEvents{
+			events: map[string]*model.Event{
+				"event:public": {
+					ID:          "event:public",
+					Title:       "Game night",
+					Description: &description,
+					Visibility:  model.EventVisibilityPublic,
+					Status:      model.EventStatusPublished,
+					Location:    &model.EventLocation{Name: "Cafe", Address: &address, City: "Lisbon"},
+				},
+				"event:invite": {ID: "event:invite", Title: "Surprise party", Visibility: model.EventVisibilityInviteOnly, Status: model.EventStatusPublished},
+			},
+			hosts: map[string]string{"event:invite": "user:host"},
+		},
+		Guilds:       guildRepo,
+		Adventures:   &mockShareAdventures{adventures: map[string]*model.Adventure{}},
+		GuildInviter: deps.inviter,
+		SigningKey:   []byte("test-signing-key"),
+		BaseURL:      "https://saga.example/",
+	})
+}
+
+func newShareTestDeps(guildVisibility string, members ...string) *shareTestDeps {
+	isMember := make(map[string]bool)
+	for _, m := range members {
+		isMember[m] = true
+	}
+	return &shareTestDeps{
+		links: &mockShareLinkRepo{links: make(map[string]*model.ShareLink)},
+		guilds: &mockGuildRepo{
+			getByIDFunc: func(ctx context.Context, id string) (*model.Guild, error) {
+				if id != "guild:1" {
+					return nil, nil
+				}
+				return &model.Guild{ID: id, Name: "Tuesday Games", Description: "Weekly", Visibility: guildVisibility}, nil
+			},
+			isMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+				return isMember[userID], nil
+			},
+		},
+		inviter: &mockGuildInviter{joined: make(map[string]bool)},
+	}
+}
+
+// ============================================================================
+// CreateLink / Resolve Tests
+// ============================================================================
+
+func TestShareLink_ResolvePublicEventPreview(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPrivate)
+	svc := newShareTestService(deps.guilds, deps)
+
+	link, err := svc.CreateLink(ctx, "user:anyone", &model.CreateShareLinkRequest{
+		TargetType: model.ShareTargetEvent,
+		TargetID:   "event:public",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(link.Token) != 24 || link.URL != "https://saga.example/s/"+link.Token {
+		t.Errorf("expected 24 character token under /s/, got %q (%s)", link.Token, link.URL)
+	}
+
+	resolved, err := svc.Resolve(ctx, link.Token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolved.TargetID != "event:public" || resolved.Preview.Restricted {
+		t.Fatalf("expected unrestricted event preview, got %+v", resolved)
+	}
+	if resolved.Preview.City == nil || *resolved.Preview.City != "Lisbon" || resolved.Preview.Description == nil {
+		t.Errorf("expected city and description in preview, got %+v", resolved.Preview)
+	}
+}
+
+func TestShareLink_RejectsTamperedToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPrivate)
+	svc := newShareTestService(deps.guilds, deps)
+
+	link, err := svc.CreateLink(ctx, "user:anyone", &model.CreateShareLinkRequest{
+		TargetType: model.ShareTargetEvent,
+		TargetID:   "event:public",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tampered := link.Token[:len(link.Token)-1] + "A"
+	if strings.HasSuffix(link.Token, "A") {
+		tampered = link.Token[:len(link.Token)-1] + "B"
+	}
+	for _, token := range []string{tampered, link.Token[:12], "not-a-token"} {
+		if _, err := svc.Resolve(ctx, token); !errors.Is(err, ErrShareLinkNotFound) {
+			t.Errorf("%q: expected ErrShareLinkNotFound, got %v", token, err)
+		}
+	}
+}
+
+func TestShareLink_Expiry(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPrivate)
+	svc := newShareTestService(deps.guilds, deps)
+	clk := fakeclock.New(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	svc.clock = clk
+
+	hours := 24
+	link, err := svc.CreateLink(ctx, "user:anyone", &model.CreateShareLinkRequest{
+		TargetType:     model.ShareTargetEvent,
+		TargetID:       "event:public",
+		ExpiresInHours: &hours,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	clk.Advance(23 * time.Hour)
+	if _, err := svc.Resolve(ctx, link.Token); err != nil {
+		t.Fatalf("expected link to resolve before expiry, got %v", err)
+	}
+	clk.Advance(time.Hour)
+	if _, err := svc.Resolve(ctx, link.Token); !errors.Is(err, ErrShareLinkExpired) {
+		t.Errorf("expected ErrShareLinkExpired, got %v", err)
+	}
+}
+
+func TestShareLink_VisibilityRules(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPrivate, "user:member")
+	svc := newShareTestService(&nonAdminGuildRepo{deps.guilds}, deps)
+
+	_, err := svc.CreateLink(ctx, "user:guest", &model.CreateShareLinkRequest{TargetType: model.ShareTargetEvent, TargetID: "event:invite"})
+	if !errors.Is(err, ErrShareTargetNotFound) {
+		t.Errorf("expected ErrShareTargetNotFound for non-host on invite-only event, got %v", err)
+	}
+	if _, err := svc.CreateLink(ctx, "user:host", &model.CreateShareLinkRequest{TargetType: model.ShareTargetEvent, TargetID: "event:invite"}); err != nil {
+		t.Errorf("expected host to share invite-only event, got %v", err)
+	}
+
+	_, err = svc.CreateLink(ctx, "user:guest", &model.CreateShareLinkRequest{TargetType: model.ShareTargetGuild, TargetID: "guild:1"})
+	if !errors.Is(err, ErrShareTargetNotFound) {
+		t.Errorf("expected ErrShareTargetNotFound for non-member on private guild, got %v", err)
+	}
+	_, err = svc.CreateLink(ctx, "user:member", &model.CreateShareLinkRequest{TargetType: model.ShareTargetGuild, TargetID: "guild:1", Invite: true})
+	if !errors.Is(err, ErrShareNotAllowed) {
+		t.Errorf("expected ErrShareNotAllowed for invite by non-admin, got %v", err)
+	}
+
+	link, err := svc.CreateLink(ctx, "user:member", &model.CreateShareLinkRequest{TargetType: model.ShareTargetGuild, TargetID: "guild:1"})
+	if err != nil {
+		t.Fatalf("expected member to share private guild, got %v", err)
+	}
+	resolved, err := svc.Resolve(ctx, link.Token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resolved.Preview.Restricted || resolved.Preview.Title != "Tuesday Games" || resolved.Preview.Description != nil {
+		t.Errorf("expected restricted preview with title only, got %+v", resolved.Preview)
+	}
+}
+
+// ============================================================================
+// Accept / Revoke Tests
+// ============================================================================
+
+func TestShareLink_AcceptInviteCountsSuccessfulUses(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPrivate, "user:admin")
+	svc := newShareTestService(deps.guilds, deps)
+
+	maxUses := 2
+	link, err := svc.CreateLink(ctx, "user:admin", &model.CreateShareLinkRequest{
+		TargetType: model.ShareTargetGuild,
+		TargetID:   "guild:1",
+		Invite:     true,
+		MaxUses:    &maxUses,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.Accept(ctx, "user:a", link.Token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.Accept(ctx, "user:a", link.Token); !errors.Is(err, ErrAlreadyGuildMember) {
+		t.Fatalf("expected ErrAlreadyGuildMember, got %v", err)
+	}
+	if _, err := svc.Accept(ctx, "user:b", link.Token); err != nil {
+		t.Fatalf("expected failed redemption not to use up the invite, got %v", err)
+	}
+	if _, err := svc.Accept(ctx, "user:c", link.Token); !errors.Is(err, ErrShareLinkExhausted) {
+		t.Errorf("expected ErrShareLinkExhausted, got %v", err)
+	}
+
+	resolved, err := svc.Resolve(ctx, link.Token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolved.Invite {
+		t.Error("expected exhausted link to resolve without invite")
+	}
+}
+
+func TestShareLink_AcceptRequiresInvite(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPublic)
+	svc := newShareTestService(deps.guilds, deps)
+
+	link, err := svc.CreateLink(ctx, "user:anyone", &model.CreateShareLinkRequest{TargetType: model.ShareTargetGuild, TargetID: "guild:1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.Accept(ctx, "user:a", link.Token); !errors.Is(err, ErrShareLinkNotInvite) {
+		t.Errorf("expected ErrShareLinkNotInvite, got %v", err)
+	}
+}
+
+func TestShareLink_RevokeByCreatorOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPublic)
+	svc := newShareTestService(deps.guilds, deps)
+
+	link, err := svc.CreateLink(ctx, "user:creator", &model.CreateShareLinkRequest{TargetType: model.ShareTargetGuild, TargetID: "guild:1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.Revoke(ctx, "user:other", link.Token); !errors.Is(err, ErrShareNotAllowed) {
+		t.Errorf("expected ErrShareNotAllowed, got %v", err)
+	}
+	if err := svc.Revoke(ctx, "user:creator", link.Token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.Resolve(ctx, link.Token); !errors.Is(err, ErrShareLinkNotFound) {
+		t.Errorf("expected revoked link to be gone, got %v", err)
+	}
+}
+
+// ============================================================================
+// Unfurl Tests
+// ============================================================================
+
+func TestShareLink_UnfurlPublicEvent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPrivate)
+	svc := newShareTestService(deps.guilds, deps)
+
+	link, err := svc.CreateLink(ctx, "user:anyone", &model.CreateShareLinkRequest{TargetType: model.ShareTargetEvent, TargetID: "event:public"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	og, err := svc.Unfurl(ctx, link.Token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if og.Title != "Game night" || og.Description != "Board games every Tuesday" || og.URL != link.URL || og.Restricted {
+		t.Errorf("unexpected preview: %+v", og)
+	}
+	if og.StructuredData["@type"] != "Event" || og.StructuredData["eventStatus"] != "https://schema.org/EventScheduled" {
+		t.Errorf("expected schema.org Event, got %v", og.StructuredData)
+	}
+	location, _ := og.StructuredData["location"].(map[string]any)
+	address, _ := location["address"].(map[string]any)
+	if address["addressLocality"] != "Lisbon" || len(address) != 2 {
+		t.Errorf("expected location limited to the city, got %v", location)
+	}
+}
+
+func TestShareLink_UnfurlRedactsPrivateGuild(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPrivate, "user:member")
+	svc := newShareTestService(deps.guilds, deps)
+
+	link, err := svc.CreateLink(ctx, "user:member", &model.CreateShareLinkRequest{TargetType: model.ShareTargetGuild, TargetID: "guild:1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	og, err := svc.Unfurl(ctx, link.Token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !og.Restricted || og.Title != "Tuesday Games" || og.ImageURL != nil || og.StructuredData != nil {
+		t.Errorf("expected redacted preview, got %+v", og)
+	}
+	if strings.Contains(og.Description, "Weekly") {
+		t.Errorf("expected guild description to be withheld, got %q", og.Description)
+	}
+}
+
+func TestShareLink_UnfurlPublicGuildSummary(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deps := newShareTestDeps(model.GuildVisibilityPublic)
+	deps.guilds.getByIDFunc = func(ctx context.Context, id string) (*model.Guild, error) {
+		return &model.Guild{ID: id, Name: "Tuesday Games", Visibility: model.GuildVisibilityPublic}, nil
+	}
+	svc := newShareTestService(deps.guilds, deps)
+
+	link, err := svc.CreateLink(ctx, "user:anyone", &model.CreateShareLinkRequest{TargetType: model.ShareTargetGuild, TargetID: "guild:1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	og, err := svc.Unfurl(ctx, link.Token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if og.StructuredData["@type"] != "Organization" || !strings.HasPrefix(og.Description, "A guild on Saga") {
+		t.Errorf("expected organization with fallback description, got %+v", og)
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// Unfurl returns OpenGraph metadata and schema.org structured data for a share
+// token, so links unfurl in messaging apps. It is built from the same preview
+// as Resolve, so non-public targets are redacted the same way.
+func (s *ShareLinkService) Unfurl(ctx context.Context, token string) (*model.OpenGraphPreview, error) {
+	resolution, err := s.Resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := resolution.Preview
+	og := &model.OpenGraphPreview{
+		Title:      preview.Title,
+		URL:        s.baseURL + model.ShareLinkPathPrefix + token,
+		Type:       "website",
+		SiteName:   model.ShareSiteName,
+		Restricted: preview.Restricted,
+	}
+
+	if preview.Restricted {
+		og.Description = fmt.Sprintf("A private %s on %s. Sign in to see the details.", preview.TargetType, model.ShareSiteName)
+		return og, nil
+	}
+
+	og.Description = unfurlDescription(preview)
+	og.ImageURL = preview.ImageURL
+	og.StructuredData = structuredData(preview, og.URL)
+	return og, nil
+}
+
+// unfurlDescription falls back to a one-line summary when the target has no
+// description of its own
+func unfurlDescription(preview *model.SharePreview) string {
+	if preview.Description != nil && *preview.Description != "" {
+		return *preview.Description
+	}
+
+	summary := fmt.Sprintf("A %s on %s", preview.TargetType, model.ShareSiteName)
+	if preview.StartsOn != nil {
+		summary += " on " + preview.StartsOn.Format("Mon, Jan 2 2006")
+	}
+	if preview.City != nil {
+		summary += " in " + *preview.City
+	}
+	if preview.MemberCount != nil {
+		summary += fmt.Sprintf(" with %d members", *preview.MemberCount)
+	}
+	return summary + "."
+}
+
+// structuredData returns schema.org JSON-LD for a public preview. Guilds are
+// organizations; events and adventures are events.
+func structuredData(preview *model.SharePreview, url string) map[string]any {
+	data := map[string]any{
+		"@context": "https://schema.org",
+		"name":     preview.Title,
+		"url":      url,
+	}
+	if preview.Description != nil {
+		data["description"] = *preview.Description
+	}
+	if preview.ImageURL != nil {
+		data["image"] = *preview.ImageURL
+	}
+
+	if preview.TargetType == model.ShareTargetGuild {
+		data["@type"] = "Organization"
+		return data
+	}
+
+	data["@type"] = "Event"
+	if preview.StartsOn != nil {
+		data["startDate"] = preview.StartsOn.Format(time.RFC3339)
+	}
+	if preview.EndsOn != nil {
+		data["endDate"] = preview.EndsOn.Format(time.RFC3339)
+	}
+	if preview.Status == model.EventStatusCancelled {
+		data["eventStatus"] = "https://schema.org/EventCancelled"
+	} else {
+		data["eventStatus"] = "https://schema.org/EventScheduled"
+	}
+	if preview.City != nil {
+		data["location"] = map[string]any{
+			"@type": "Place",
+			"address": map[string]any{
+				"@type":           "PostalAddress",
+				"addressLocality": *preview.City,
+			},
+		}
+	}
+	return data
+}
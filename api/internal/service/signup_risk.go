@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// disposableEmailDomains are throwaway mail providers. Subdomains match too.
+var disposableEmailDomains = map[string]bool{
+	"10minutemail.com": true, "33mail.com": true, "dispostable.com": true,
+	"emailondeck.com": true, "fakeinbox.com": true, "getairmail.com": true,
+	"getnada.com": true, "guerrillamail.com": true, "guerrillamail.net": true,
+	"maildrop.cc": true, "mailinator.com": true, "mailnesia.com": true,
+	"mintemail.com": true, "mohmal.com": true, "mytemp.email": true,
+	"sharklasers.com": true, "spamgourmet.com": true, "temp-mail.org": true,
+	"tempmail.com": true, "tempmailo.com": true, "tempr.email": true,
+	"throwawaymail.com": true, "trashmail.com": true, "yopmail.com": true,
+}
+
+// SignupRiskRepository defines the interface for signup risk storage
+type SignupRiskRepository interface {
+	Create(ctx context.Context, risk *model.SignupRisk) error
+	GetByUser(ctx context.Context, userID string) (*model.SignupRisk, error)
+	CountRecentByIP(ctx context.Context, ip string, since time.Time) (int, error)
+	ListFlagged(ctx context.Context, limit int) ([]*model.SignupRisk, error)
+	MarkReviewed(ctx context.Context, userID, reviewedBy string) (*model.SignupRisk, error)
+}
+
+// SignupRiskUserRepository defines the user lookups SignupRiskService needs
+type SignupRiskUserRepository interface {
+	GetByID(ctx context.Context, id string) (*model.User, error)
+}
+
+// SignupRiskAssessor scores registrations for AuthService
+type SignupRiskAssessor interface {
+	Assess(ctx context.Context, email, ip, firstname, lastname string) *model.SignupRisk
+	Record(ctx context.Context, userID string, risk *model.SignupRisk)
+}
+
+// SignupRiskService scores new accounts for signs of spam and bots. Risky
+// accounts can't make changes until they verify their email, and the
+// riskiest wait in a queue for admins.
+type SignupRiskService struct {
+	repo  SignupRiskRepository
+	users SignupRiskUserRepository
+	clock clock.Clock
+}
+
+// SignupRiskServiceConfig holds configuration for the signup risk service
+type SignupRiskServiceConfig struct {
+	Repo     SignupRiskRepository
+	UserRepo SignupRiskUserRepository
+
+	// Clock sets the signup velocity window; nil is the system clock
+	Clock clock.Clock
+}
+
+// NewSignupRiskService creates a new signup risk service
+func NewSignupRiskService(cfg SignupRiskServiceConfig) *SignupRiskService {
+	return &SignupRiskService{
+		repo:  cfg.Repo,
+		users: cfg.UserRepo,
+		clock: clock.OrReal(cfg.Clock),
+	}
+}
+
+// Assess scores a registration before the account is created. A failed
+// velocity lookup skips that heuristic rather than turning the user away.
+func (s *SignupRiskService) Assess(ctx context.Context, email, ip, firstname, lastname string) *model.SignupRisk {
+	risk := &model.SignupRisk{IP: ip, Reasons: []string{}}
+
+	local, domain, _ := strings.Cut(email, "@")
+	if isDisposableDomain(domain) {
+		risk.AddReason(model.SignupRiskDisposableEmail)
+	}
+	if looksGenerated(local) {
+		risk.AddReason(model.SignupRiskRandomEmail)
+	}
+	if isSuspiciousName(firstname, lastname) {
+		risk.AddReason(model.SignupRiskSuspiciousName)
+	}
+
+	if ip != "" {
+		count, err := s.repo.CountRecentByIP(ctx, ip, s.clock.Now().Add(-model.SignupVelocityWindow))
+		if err != nil {
+			log.Printf("[SignupRiskService] Failed to count recent signups from %s: %v", ip, err)
+		} else if count >= model.SignupVelocityLimit {
+			risk.AddReason(model.SignupRiskIPVelocity)
+		}
+	}
+
+	return risk
+}
+
+// Record stores the score for a new account. A failure is logged rather
+// than returned so it never undoes the registration.
+func (s *SignupRiskService) Record(ctx context.Context, userID string, risk *model.SignupRisk) {
+	risk.UserID = userID
+	if err := s.repo.Create(ctx, risk); err != nil {
+		log.Printf("[SignupRiskService] Failed to record signup risk for %s: %v", userID, err)
+	}
+}
+
+// WritesRestricted reports whether a user must verify their email before
+// making changes
+func (s *SignupRiskService) WritesRestricted(ctx context.Context, userID string) (bool, error) {
+	risk, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if risk == nil || !risk.RequiresVerification {
+		return false, nil
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return user != nil && !user.EmailVerified, nil
+}
+
+// GetForUser returns the score an account got at signup, or nil if it was
+// never scored
+func (s *SignupRiskService) GetForUser(ctx context.Context, userID string) (*model.SignupRisk, error) {
+	return s.repo.GetByUser(ctx, userID)
+}
+
+// ListFlagged returns flagged accounts no admin has reviewed, riskiest
+// first
+func (s *SignupRiskService) ListFlagged(ctx context.Context, limit int) ([]*model.SignupRisk, error) {
+	if limit <= 0 {
+		limit = model.DefaultSignupReviewLimit
+	}
+	if limit > model.MaxSignupReviewLimit {
+		limit = model.MaxSignupReviewLimit
+	}
+	return s.repo.ListFlagged(ctx, limit)
+}
+
+// Review records that an admin looked at an account and found it genuine,
+// taking it off the queue and lifting its restriction on changes
+func (s *SignupRiskService) Review(ctx context.Context, userID, adminID string) (*model.SignupRisk, error) {
+	risk, err := s.repo.MarkReviewed(ctx, userID, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if risk == nil {
+		return nil, ErrSignupRiskNotFound
+	}
+	return risk, nil
+}
+
+// isDisposableDomain reports whether an email domain, or one it is a
+// subdomain of, is a throwaway mail provider
+func isDisposableDomain(domain string) bool {
+	for domain != "" {
+		if disposableEmailDomains[domain] {
+			return true
+		}
+		_, rest, found := strings.Cut(domain, ".")
+		if !found {
+			break
+		}
+		domain = rest
+	}
+	return false
+}
+
+// looksGenerated reports whether an email's local part reads like a script
+// made it up: a long run of digits or of consonants
+func looksGenerated(local string) bool {
+	local, _, _ = strings.Cut(local, "+")
+
+	digits, consonants := 0, 0
+	for _, r := range local {
+		switch {
+		case unicode.IsDigit(r):
+			digits++
+			consonants = 0
+		case unicode.IsLetter(r) && !strings.ContainsRune("aeiouy", unicode.ToLower(r)):
+			consonants++
+			digits = 0
+		default:
+			digits, consonants = 0, 0
+		}
+		if digits >= 6 || consonants >= 6 {
+			return true
+		}
+	}
+	return false
+}
+
+// isSuspiciousName reports whether a name holds something no real name
+// does, such as a link, an address or digits, or repeats the first name as
+// the last
+func isSuspiciousName(firstname, lastname string) bool {
+	first := strings.ToLower(strings.TrimSpace(firstname))
+	last := strings.ToLower(strings.TrimSpace(lastname))
+	if first != "" && first == last {
+		return true
+	}
+
+	for _, name := range []string{first, last} {
+		if strings.Contains(name, "://") || strings.Contains(name, "www.") || strings.ContainsRune(name, '@') {
+			return true
+		}
+		if strings.ContainsFunc(name, unicode.IsDigit) {
+			return true
+		}
+	}
+	return false
+}
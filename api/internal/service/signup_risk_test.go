@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// memorySignupRiskRepo keeps risk scores in memory; recentByIP is what
+// CountRecentByIP reports for each IP
+func memorySignupRiskRepo(recentByIP map[string]int) *mocks.SignupRiskRepository {
+	stored := map[string]*model.SignupRisk{}
+	return &mocks.SignupRiskRepository{
+		CreateFunc: func(ctx context.Context, risk *model.SignupRisk) error {
+			stored[risk.UserID] = risk
+			return nil
+		},
+		GetByUserFunc: func(ctx context.Context, userID string) (*model.SignupRisk, error) {
+			return stored[userID], nil
+		},
+		CountRecentByIPFunc: func(ctx context.Context, ip string, since time.Time) (int, error) {
+			return recentByIP[ip], nil
+		},
+		MarkReviewedFunc: func(ctx context.Context, userID, reviewedBy string) (*model.SignupRisk, error) {
+			risk := stored[userID]
+			if risk == nil {
+				return nil, nil
+			}
+			reviewedOn := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+			risk.RequiresVerification = false
+			risk.ReviewedBy = &reviewedBy
+			risk.ReviewedOn = &reviewedOn
+			return risk, nil
+		},
+	}
+}
+
+// signupRiskUserRepo serves a single user
+func signupRiskUserRepo(user *model.User) *mocks.SignupRiskUserRepository {
+	return &mocks.SignupRiskUserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+			if id != user.ID {
+				return nil, nil
+			}
+			return user, nil
+		},
+	}
+}
+
+// ============================================================================
+// Signup Risk Tests
+// ============================================================================
+
+func TestSignupRisk_Assess(t *testing.T) {
+	t.Parallel()
+
+	svc := NewSignupRiskService(SignupRiskServiceConfig{
+		Repo:     memorySignupRiskRepo(map[string]int{"203.0.113.7": model.SignupVelocityLimit}),
+		UserRepo: &mocks.SignupRiskUserRepository{},
+	})
+
+	tests := []struct {
+		name                 string
+		email, ip            string
+		firstname, lastname  string
+		reasons              []string
+		requiresVerification bool
+		flagged              bool
+	}{
+		{"ordinary", "ada.lovelace@example.com", "198.51.100.1", "Ada", "Lovelace", nil, false, false},
+		{"disposable", "ada@mailinator.com", "198.51.100.1", "Ada", "Lovelace", []string{model.SignupRiskDisposableEmail}, true, false},
+		{"disposable subdomain", "ada@inbox.yopmail.com", "", "Ada", "", []string{model.SignupRiskDisposableEmail}, true, false},
+		{"generated address", "xkqzrtvw8812@example.com", "", "Ada", "", []string{model.SignupRiskRandomEmail}, false, false},
+		{"link in name", "ada@example.com", "", "www.cheap-pills", "Shop", []string{model.SignupRiskSuspiciousName}, false, false},
+		{"repeated name", "ada@example.com", "", "Bob", "bob", []string{model.SignupRiskSuspiciousName}, false, false},
+		{"busy IP", "ada@example.com", "203.0.113.7", "Ada", "", []string{model.SignupRiskIPVelocity}, true, false},
+		{"busy IP and disposable", "bot123456@tempmail.com", "203.0.113.7", "Ada", "", []string{model.SignupRiskDisposableEmail, model.SignupRiskRandomEmail, model.SignupRiskIPVelocity}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			risk := svc.Assess(context.Background(), tt.email, tt.ip, tt.firstname, tt.lastname)
+			if !slices.Equal(risk.Reasons, tt.reasons) && !(len(risk.Reasons) == 0 && len(tt.reasons) == 0) {
+				t.Errorf("expected reasons %v, got %v", tt.reasons, risk.Reasons)
+			}
+			if risk.RequiresVerification != tt.requiresVerification {
+				t.Errorf("expected requires_verification %v, got %v (score %d)", tt.requiresVerification, risk.RequiresVerification, risk.Score)
+			}
+			if risk.FlaggedForReview != tt.flagged {
+				t.Errorf("expected flagged %v, got %v (score %d)", tt.flagged, risk.FlaggedForReview, risk.Score)
+			}
+			if risk.Score > model.MaxSignupRiskScore {
+				t.Errorf("score %d above the maximum", risk.Score)
+			}
+		})
+	}
+}
+
+func TestSignupRisk_WritesRestricted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		email    string // Empty for an account from before scoring
+		verified bool
+		want     bool
+	}{
+		{name: "risky", email: "ada@mailinator.com", want: true},
+		{name: "risky but verified", email: "ada@mailinator.com", verified: true},
+		{name: "ordinary", email: "ada@example.com"},
+		{name: "unscored"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			svc := NewSignupRiskService(SignupRiskServiceConfig{
+				Repo:     memorySignupRiskRepo(nil),
+				UserRepo: signupRiskUserRepo(&model.User{ID: "user:1", Email: tt.email, EmailVerified: tt.verified}),
+			})
+			if tt.email != "" {
+				svc.Record(ctx, "user:1", svc.Assess(ctx, tt.email, "", "", ""))
+			}
+
+			if restricted, _ := svc.WritesRestricted(ctx, "user:1"); restricted != tt.want {
+				t.Errorf("WritesRestricted() = %v, want %v", restricted, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignupRisk_Review(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		userID  string
+		wantErr error
+	}{
+		{"scored", "user:risky", nil},
+		{"unscored", "user:unscored", ErrSignupRiskNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			svc := NewSignupRiskService(SignupRiskServiceConfig{
+				Repo:     memorySignupRiskRepo(nil),
+				UserRepo: signupRiskUserRepo(&model.User{ID: "user:risky"}),
+			})
+			svc.Record(ctx, "user:risky", svc.Assess(ctx, "bot123456@mailinator.com", "", "Bob", "Bob"))
+
+			risk, err := svc.Review(ctx, tt.userID, "user:admin")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Review() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if risk.NeedsReview() {
+				t.Error("expected the account off the review queue")
+			}
+			if restricted, _ := svc.WritesRestricted(ctx, tt.userID); restricted {
+				t.Error("expected review to lift the restriction")
+			}
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// SpontaneousAvailabilityRepository stores spontaneous availability windows
+type SpontaneousAvailabilityRepository interface {
+	Create(ctx context.Context, av *model.Availability) error
+	GetActiveSpontaneous(ctx context.Context, userID string) (*model.Availability, error)
+	Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Availability, error)
+}
+
+// SpontaneousRepository defines the interface for spontaneous alert
+// preferences and the session log
+type SpontaneousRepository interface {
+	GetAlertPreference(ctx context.Context, userID string) (*model.SpontaneousAlertPreference, error)
+	UpsertAlertPreference(ctx context.Context, pref *model.SpontaneousAlertPreference) error
+	GetEnabledAlertPreferences(ctx context.Context, userIDs []string) (map[string]*model.SpontaneousAlertPreference, error)
+	ClaimAlert(ctx context.Context, userID string, cutoff time.Time) (bool, error)
+	RecordSession(ctx context.Context, userID, availabilityID string) error
+	GetSessionStartsSince(ctx context.Context, userID string, since time.Time) ([]time.Time, error)
+}
+
+// SpontaneousProfileRepository finds the people near a spontaneous window
+type SpontaneousProfileRepository interface {
+	GetNearby(ctx context.Context, minLat, maxLat, minLng, maxLng float64, limit int) ([]*model.UserProfile, error)
+}
+
+// SpontaneousInterestRepository provides interests for compatibility checks
+type SpontaneousInterestRepository interface {
+	GetUserInterests(ctx context.Context, userID string) ([]*model.UserInterest, error)
+}
+
+// spontaneousAlertCandidates caps the nearby profiles considered per alert
+const spontaneousAlertCandidates = 200
+
+// SpontaneousService manages "available right now" windows. Unlike planned
+// availability they start immediately, expire on their own, rank first in
+// nearby discovery and alert compatible people nearby who opted in. Sessions
+// per day, alerts per session and alerts per recipient are all capped.
+type SpontaneousService struct {
+	availability SpontaneousAvailabilityRepository
+	repo         SpontaneousRepository
+	profiles     SpontaneousProfileRepository
+	interests    SpontaneousInterestRepository
+	blocks       BlockChecker
+	eventHub     *EventHub
+	pushService  *PushService
+	outbox       NotificationQueue
+	types        HangoutTypeCatalog
+	consent      ConsentChecker
+	geoService   *GeoService
+	clock        clock.Clock
+}
+
+// SpontaneousServiceConfig holds configuration for the spontaneous service
+type SpontaneousServiceConfig struct {
+	AvailabilityRepo SpontaneousAvailabilityRepository
+	Repo             SpontaneousRepository
+	ProfileRepo      SpontaneousProfileRepository
+	InterestRepo     SpontaneousInterestRepository
+	BlockChecker     BlockChecker
+	EventHub         *EventHub          // Optional; nil skips in-app alerts
+	PushService      *PushService       // Optional; nil skips push alerts
+	Outbox           NotificationQueue  // Optional; nil sends alerts inline
+	HangoutTypes     HangoutTypeCatalog // Optional; only the built-in hangout types are accepted when nil
+	Consent          ConsentChecker     // Optional; locations are used without consent checks when nil
+	Clock            clock.Clock        // Default: the system clock
+}
+
+// NewSpontaneousService creates a new spontaneous service
+func NewSpontaneousService(cfg SpontaneousServiceConfig) *SpontaneousService {
+	return &SpontaneousService{
+		availability: cfg.AvailabilityRepo,
+		repo:         cfg.Repo,
+		profiles:     cfg.ProfileRepo,
+		interests:    cfg.InterestRepo,
+		blocks:       cfg.BlockChecker,
+		eventHub:     cfg.EventHub,
+		pushService:  cfg.PushService,
+		outbox:       cfg.Outbox,
+		types:        cfg.HangoutTypes,
+		consent:      cfg.Consent,
+		geoService:   NewGeoService(),
+		clock:        clock.OrReal(cfg.Clock),
+	}
+}
+
+// GoAvailableNow starts a spontaneous window and alerts compatible people
+// nearby. If the user is already available right now, the window is extended
+// to the new duration instead and nobody is alerted again.
+func (s *SpontaneousService) GoAvailableNow(ctx context.Context, userID string, req *model.GoAvailableNowRequest) (*model.Availability, error) {
+	if !hasLocationConsent(ctx, s.consent, userID) {
+		return nil, ErrLocationConsentRequired
+	}
+	if req.HangoutType != "" {
+		if err := resolveHangoutType(ctx, s.types, req.HangoutType, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.clock.Now()
+	minutes := req.DurationMinutes
+	if minutes == 0 {
+		minutes = model.DefaultSpontaneousMinutes
+	}
+	endTime := now.Add(time.Duration(minutes) * time.Minute)
+
+	active, err := s.availability.GetActiveSpontaneous(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting active spontaneous availability: %w", err)
+	}
+	if active != nil {
+		if !endTime.After(active.EndTime) {
+			return active, nil
+		}
+		return s.availability.Update(ctx, active.ID, map[string]interface{}{
+			"end_time":   endTime,
+			"expires_at": endTime,
+		})
+	}
+
+	starts, err := s.repo.GetSessionStartsSince(ctx, userID, now.Add(-model.SpontaneousSessionWindow))
+	if err != nil {
+		return nil, fmt.Errorf("counting spontaneous sessions: %w", err)
+	}
+	if len(starts) >= model.MaxSpontaneousSessions {
+		// A slot frees up when enough of the oldest sessions leave the window
+		oldest := starts[len(starts)-model.MaxSpontaneousSessions]
+		return nil, &SpontaneousLimitError{RetryAt: oldest.Add(model.SpontaneousSessionWindow)}
+	}
+
+	hangoutType := model.HangoutTypeMeetAnyone
+	if req.HangoutType != "" {
+		hangoutType = model.HangoutType(req.HangoutType)
+	}
+	radius := req.Location.Radius
+	if radius <= 0 {
+		radius = model.DefaultSpontaneousAlertRadiusKm
+	}
+
+	av := &model.Availability{
+		UserID:    userID,
+		Status:    model.AvailabilityStatusAvailable,
+		StartTime: now,
+		EndTime:   endTime,
+		Location: &model.AvailabilityLocation{
+			Lat:    req.Location.Lat,
+			Lng:    req.Location.Lng,
+			Radius: radius,
+		},
+		HangoutType:         hangoutType,
+		ActivityDescription: req.ActivityDescription,
+		ActivityVenue:       req.ActivityVenue,
+		InterestID:          req.InterestID,
+		MaxPeople:           1,
+		Note:                req.Note,
+		Visibility:          "circles",
+		Spontaneous:         true,
+		ExpiresAt:           endTime,
+	}
+	if err := s.availability.Create(ctx, av); err != nil {
+		return nil, fmt.Errorf("creating spontaneous availability: %w", err)
+	}
+	if err := s.repo.RecordSession(ctx, userID, av.ID); err != nil {
+		return nil, fmt.Errorf("recording spontaneous session: %w", err)
+	}
+
+	s.alertNearby(ctx, av)
+	return av, nil
+}
+
+// GetAvailableNow returns the user's spontaneous window
+func (s *SpontaneousService) GetAvailableNow(ctx context.Context, userID string) (*model.Availability, error) {
+	active, err := s.availability.GetActiveSpontaneous(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if active == nil {
+		return nil, ErrNotAvailableNow
+	}
+	return active, nil
+}
+
+// StopAvailableNow ends the user's spontaneous window early. The session
+// still counts toward the daily limit.
+func (s *SpontaneousService) StopAvailableNow(ctx context.Context, userID string) error {
+	active, err := s.availability.GetActiveSpontaneous(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("getting active spontaneous availability: %w", err)
+	}
+	if active == nil {
+		return ErrNotAvailableNow
+	}
+
+	now := s.clock.Now()
+	_, err = s.availability.Update(ctx, active.ID, map[string]interface{}{
+		"end_time":   now,
+		"expires_at": now,
+	})
+	return err
+}
+
+// GetAlertPreference returns the user's spontaneous alert preference, which
+// is off until they opt in
+func (s *SpontaneousService) GetAlertPreference(ctx context.Context, userID string) (*model.SpontaneousAlertPreference, error) {
+	pref, err := s.repo.GetAlertPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pref == nil {
+		pref = &model.SpontaneousAlertPreference{
+			UserID:   userID,
+			RadiusKm: model.DefaultSpontaneousAlertRadiusKm,
+		}
+	}
+	return pref, nil
+}
+
+// UpdateAlertPreference opts the user in or out of spontaneous alerts and
+// sets how far away they want to hear about
+func (s *SpontaneousService) UpdateAlertPreference(ctx context.Context, userID string, req *model.UpdateSpontaneousAlertPreferenceRequest) (*model.SpontaneousAlertPreference, error) {
+	pref, err := s.GetAlertPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Enabled != nil {
+		pref.Enabled = *req.Enabled
+	}
+	if req.RadiusKm != nil {
+		pref.RadiusKm = *req.RadiusKm
+	}
+
+	if err := s.repo.UpsertAlertPreference(ctx, pref); err != nil {
+		return nil, err
+	}
+	return pref, nil
+}
+
+// alertNearby notifies the closest compatible people who opted in and have
+// the window inside their alert radius. Failures are logged; the window is
+// live either way.
+func (s *SpontaneousService) alertNearby(ctx context.Context, av *model.Availability) {
+	recipients, err := s.findRecipients(ctx, av)
+	if err != nil {
+		log.Printf("[SpontaneousService] Failed to find alert recipients for %s: %v", av.ID, err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	event := &Event{
+		Type: EventSpontaneousAvailable,
+		Data: map[string]interface{}{
+			"availability_id": av.ID,
+			"hangout_type":    av.HangoutType,
+			"end_time":        av.EndTime,
+		},
+	}
+	push := &PushNotification{
+		Title: "Someone nearby is free right now",
+		Body:  "A compatible person near you is up for hanging out. Take a look before it expires.",
+		Data: map[string]string{
+			"type":            string(EventSpontaneousAvailable),
+			"availability_id": av.ID,
+		},
+	}
+	sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, notificationsForUsers(recipients, event, push)...)
+}
+
+// findRecipients returns who to alert about av, closest first. Each
+// recipient's alert is claimed as it's chosen so nobody hears more than once
+// per cooldown.
+func (s *SpontaneousService) findRecipients(ctx context.Context, av *model.Availability) ([]string, error) {
+	bbox := s.geoService.GetBoundingBox(av.Location.Lat, av.Location.Lng, model.MaxSpontaneousAlertRadiusKm)
+	profiles, err := s.profiles.GetNearby(ctx, bbox.MinLat, bbox.MaxLat, bbox.MinLng, bbox.MaxLng, spontaneousAlertCandidates)
+	if err != nil {
+		return nil, fmt.Errorf("getting nearby profiles: %w", err)
+	}
+
+	type candidate struct {
+		userID   string
+		distance float64
+	}
+	candidates := make([]candidate, 0, len(profiles))
+	userIDs := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		if p.UserID == av.UserID || p.Location == nil {
+			continue
+		}
+		distance := s.geoService.HaversineDistance(av.Location.Lat, av.Location.Lng, p.Location.Lat, p.Location.Lng)
+		candidates = append(candidates, candidate{userID: p.UserID, distance: distance})
+		userIDs = append(userIDs, p.UserID)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	prefs, err := s.repo.GetEnabledAlertPreferences(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getting alert preferences: %w", err)
+	}
+	if len(prefs) == 0 {
+		return nil, nil
+	}
+
+	ownerInterests, err := s.interestSet(ctx, av.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := s.clock.Now().Add(-model.SpontaneousAlertCooldown)
+	var recipients []string
+	for _, c := range candidates {
+		if len(recipients) >= model.MaxSpontaneousAlertRecipients {
+			break
+		}
+		pref := prefs[c.userID]
+		if pref == nil {
+			continue
+		}
+		radius := pref.RadiusKm
+		if radius <= 0 {
+			radius = model.DefaultSpontaneousAlertRadiusKm
+		}
+		if c.distance > radius {
+			continue
+		}
+		if s.blocks != nil {
+			blocked, err := s.blocks.IsBlockedEitherWay(ctx, av.UserID, c.userID)
+			if err != nil || blocked {
+				continue // Fail closed; a missed alert beats an unwanted one
+			}
+		}
+		if !s.compatible(ctx, av, ownerInterests, c.userID) {
+			continue
+		}
+		claimed, err := s.repo.ClaimAlert(ctx, c.userID, cutoff)
+		if err != nil {
+			log.Printf("[SpontaneousService] Failed to claim alert for %s: %v", c.userID, err)
+			continue
+		}
+		if claimed {
+			recipients = append(recipients, c.userID)
+		}
+	}
+	return recipients, nil
+}
+
+// compatible reports whether userID suits the window: they must share the
+// window's interest for mutual_interest windows, and any interest with its
+// owner otherwise
+func (s *SpontaneousService) compatible(ctx context.Context, av *model.Availability, ownerInterests map[string]bool, userID string) bool {
+	interests, err := s.interestSet(ctx, userID)
+	if err != nil {
+		return false
+	}
+	if av.HangoutType == model.HangoutTypeMutualInterest && av.InterestID != nil {
+		return interests[*av.InterestID]
+	}
+	for id := range interests {
+		if ownerInterests[id] {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SpontaneousService) interestSet(ctx context.Context, userID string) (map[string]bool, error) {
+	interests, err := s.interests.GetUserInterests(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting interests: %w", err)
+	}
+	set := make(map[string]bool, len(interests))
+	for _, ui := range interests {
+		set[ui.InterestID] = true
+	}
+	return set, nil
+}
@@ -0,0 +1,484 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+var spontaneousTestNow = time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC)
+
+// spontaneousNeighbor is someone latKm kilometres north of user:owner, who
+// shares interest:hiking with them. Unless opted out they want alerts within
+// 5km.
+type spontaneousNeighbor struct {
+	userID     string
+	latKm      float64
+	optedOut   bool
+	interests  []string // Default: interest:hiking
+	blocked    bool
+	alertedAgo time.Duration // Since their last alert; zero if never alerted
+}
+
+// spontaneousAvailabilityRepo keeps the owner's spontaneous window in active
+// and counts the windows created
+func spontaneousAvailabilityRepo(active **model.Availability, created *int) *mocks.SpontaneousAvailabilityRepository {
+	return &mocks.SpontaneousAvailabilityRepository{
+		CreateFunc: func(ctx context.Context, av *model.Availability) error {
+			*created++
+			av.ID = fmt.Sprintf("availability:%d", *created)
+			*active = av
+			return nil
+		},
+		GetActiveSpontaneousFunc: func(ctx context.Context, userID string) (*model.Availability, error) {
+			if *active == nil || !(*active).ExpiresAt.After(spontaneousTestNow) {
+				return nil, nil
+			}
+			return *active, nil
+		},
+		UpdateFunc: func(ctx context.Context, id string, updates map[string]interface{}) (*model.Availability, error) {
+			updated := **active
+			updated.EndTime = updates["end_time"].(time.Time)
+			updated.ExpiresAt = updates["expires_at"].(time.Time)
+			*active = &updated
+			return *active, nil
+		},
+	}
+}
+
+// spontaneousRepo serves the neighbors' alert preferences, appending alert
+// claims to claims and session starts to starts
+func spontaneousRepo(neighbors []spontaneousNeighbor, claims *[]string, starts *[]time.Time) *mocks.SpontaneousRepository {
+	alerted := map[string]time.Time{}
+	for _, n := range neighbors {
+		if n.alertedAgo != 0 {
+			alerted[n.userID] = spontaneousTestNow.Add(-n.alertedAgo)
+		}
+	}
+	return &mocks.SpontaneousRepository{
+		GetEnabledAlertPreferencesFunc: func(ctx context.Context, userIDs []string) (map[string]*model.SpontaneousAlertPreference, error) {
+			prefs := make(map[string]*model.SpontaneousAlertPreference)
+			for _, n := range neighbors {
+				if !n.optedOut && slices.Contains(userIDs, n.userID) {
+					prefs[n.userID] = &model.SpontaneousAlertPreference{UserID: n.userID, Enabled: true, RadiusKm: 5}
+				}
+			}
+			return prefs, nil
+		},
+		ClaimAlertFunc: func(ctx context.Context, userID string, cutoff time.Time) (bool, error) {
+			if last, ok := alerted[userID]; ok && last.After(cutoff) {
+				return false, nil
+			}
+			alerted[userID] = spontaneousTestNow
+			*claims = append(*claims, userID)
+			return true, nil
+		},
+		RecordSessionFunc: func(ctx context.Context, userID, availabilityID string) error {
+			*starts = append(*starts, spontaneousTestNow)
+			return nil
+		},
+		GetSessionStartsSinceFunc: func(ctx context.Context, userID string, since time.Time) ([]time.Time, error) {
+			var recent []time.Time
+			for _, t := range *starts {
+				if t.After(since) {
+					recent = append(recent, t)
+				}
+			}
+			return recent, nil
+		},
+	}
+}
+
+// spontaneousProfileRepo finds the neighbors nearby
+func spontaneousProfileRepo(neighbors []spontaneousNeighbor) *mocks.SpontaneousProfileRepository {
+	return &mocks.SpontaneousProfileRepository{
+		GetNearbyFunc: func(ctx context.Context, minLat, maxLat, minLng, maxLng float64, limit int) ([]*model.UserProfile, error) {
+			profiles := make([]*model.UserProfile, len(neighbors))
+			for i, n := range neighbors {
+				profiles[i] = &model.UserProfile{
+					UserID:   n.userID,
+					Location: &model.Location{Lat: 40.0 + n.latKm/111.2, Lng: -105.0},
+				}
+			}
+			return profiles, nil
+		},
+	}
+}
+
+// spontaneousInterestRepo gives the owner interest:hiking and each neighbor
+// their interests
+func spontaneousInterestRepo(neighbors []spontaneousNeighbor) *mocks.SpontaneousInterestRepository {
+	interests := map[string][]string{"user:owner": {"interest:hiking"}}
+	for _, n := range neighbors {
+		interests[n.userID] = n.interests
+		if n.interests == nil {
+			interests[n.userID] = []string{"interest:hiking"}
+		}
+	}
+	return &mocks.SpontaneousInterestRepository{
+		GetUserInterestsFunc: func(ctx context.Context, userID string) ([]*model.UserInterest, error) {
+			var held []*model.UserInterest
+			for _, id := range interests[userID] {
+				held = append(held, &model.UserInterest{InterestID: id})
+			}
+			return held, nil
+		},
+	}
+}
+
+// spontaneousBlockChecker reports the blocked neighbors
+func spontaneousBlockChecker(neighbors []spontaneousNeighbor) *mockBlockChecker {
+	return &mockBlockChecker{
+		isBlockedFunc: func(ctx context.Context, userID1, userID2 string) (bool, error) {
+			for _, n := range neighbors {
+				if n.userID == userID2 {
+					return n.blocked, nil
+				}
+			}
+			return false, nil
+		},
+	}
+}
+
+func goNowRequest() *model.GoAvailableNowRequest {
+	return &model.GoAvailableNowRequest{Location: &model.AvailabilityLocationRequest{Lat: 40.0, Lng: -105.0}}
+}
+
+// ============================================================================
+// Spontaneous Availability Tests
+// ============================================================================
+
+func TestGoAvailableNow_OpensWindowAndAlerts(t *testing.T) {
+	t.Parallel()
+
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser("user:match", "sub-1")
+
+	neighbors := []spontaneousNeighbor{{userID: "user:match", latKm: 1}}
+	var (
+		active  *model.Availability
+		created int
+		claims  []string
+		starts  []time.Time
+	)
+	svc := NewSpontaneousService(SpontaneousServiceConfig{
+		AvailabilityRepo: spontaneousAvailabilityRepo(&active, &created),
+		Repo:             spontaneousRepo(neighbors, &claims, &starts),
+		ProfileRepo:      spontaneousProfileRepo(neighbors),
+		InterestRepo:     spontaneousInterestRepo(neighbors),
+		BlockChecker:     spontaneousBlockChecker(neighbors),
+		EventHub:         hub,
+		Clock:            fakeclock.New(spontaneousTestNow),
+	})
+
+	av, err := svc.GoAvailableNow(context.Background(), "user:owner", goNowRequest())
+	if err != nil {
+		t.Fatalf("GoAvailableNow() error = %v", err)
+	}
+
+	if !av.Spontaneous || !av.StartTime.Equal(spontaneousTestNow) {
+		t.Errorf("window = %+v, want spontaneous starting now", av)
+	}
+	if want := spontaneousTestNow.Add(model.DefaultSpontaneousMinutes * time.Minute); !av.EndTime.Equal(want) || !av.ExpiresAt.Equal(want) {
+		t.Errorf("end = %v, expires = %v, want both %v", av.EndTime, av.ExpiresAt, want)
+	}
+	if av.HangoutType != model.HangoutTypeMeetAnyone {
+		t.Errorf("hangout type = %q, want meet_anyone by default", av.HangoutType)
+	}
+	if created != 1 || len(starts) != 1 {
+		t.Errorf("created %d windows and %d sessions, want one of each", created, len(starts))
+	}
+	select {
+	case e := <-sub.Events:
+		if e.Type != EventSpontaneousAvailable {
+			t.Errorf("event type = %q, want %q", e.Type, EventSpontaneousAvailable)
+		}
+	default:
+		t.Error("expected user:match to get a spontaneous alert")
+	}
+}
+
+func TestGoAvailableNow_ChoosesWhoToAlert(t *testing.T) {
+	t.Parallel()
+
+	var crowd []spontaneousNeighbor
+	var closest []string
+	for i := model.MaxSpontaneousAlertRecipients + 5; i > 0; i-- {
+		crowd = append(crowd, spontaneousNeighbor{userID: fmt.Sprintf("user:%d", i), latKm: float64(i) * 0.1})
+	}
+	for i := 1; i <= model.MaxSpontaneousAlertRecipients; i++ {
+		closest = append(closest, fmt.Sprintf("user:%d", i))
+	}
+	climbing := "interest:climbing"
+
+	tests := []struct {
+		name        string
+		neighbors   []spontaneousNeighbor
+		hangoutType model.HangoutType
+		interestID  *string
+		wantAlerted []string
+	}{
+		{
+			name: "compatible opted-in people nearby",
+			neighbors: []spontaneousNeighbor{
+				{userID: "user:match", latKm: 1},
+				{userID: "user:far", latKm: 8}, // Outside their 5km radius
+				{userID: "user:optedout", latKm: 1, optedOut: true},
+				{userID: "user:stranger", latKm: 1, interests: []string{"interest:chess"}},
+				{userID: "user:blocked", latKm: 1, blocked: true},
+				{userID: "user:recent", latKm: 1, alertedAgo: 30 * time.Minute},
+			},
+			wantAlerted: []string{"user:match"},
+		},
+		{
+			name: "mutual interest needs that interest",
+			neighbors: []spontaneousNeighbor{
+				{userID: "user:hiker", latKm: 1},
+				{userID: "user:climber", latKm: 2, interests: []string{"interest:hiking", climbing}},
+			},
+			hangoutType: model.HangoutTypeMutualInterest,
+			interestID:  &climbing,
+			wantAlerted: []string{"user:climber"},
+		},
+		{
+			name:        "capped closest first",
+			neighbors:   crowd,
+			wantAlerted: closest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				active  *model.Availability
+				created int
+				claims  []string
+				starts  []time.Time
+			)
+			svc := NewSpontaneousService(SpontaneousServiceConfig{
+				AvailabilityRepo: spontaneousAvailabilityRepo(&active, &created),
+				Repo:             spontaneousRepo(tt.neighbors, &claims, &starts),
+				ProfileRepo:      spontaneousProfileRepo(tt.neighbors),
+				InterestRepo:     spontaneousInterestRepo(tt.neighbors),
+				BlockChecker:     spontaneousBlockChecker(tt.neighbors),
+				Clock:            fakeclock.New(spontaneousTestNow),
+			})
+
+			req := goNowRequest()
+			req.HangoutType = string(tt.hangoutType)
+			req.InterestID = tt.interestID
+			if _, err := svc.GoAvailableNow(context.Background(), "user:owner", req); err != nil {
+				t.Fatalf("GoAvailableNow() error = %v", err)
+			}
+			if !slices.Equal(claims, tt.wantAlerted) {
+				t.Errorf("alerted %v, want %v", claims, tt.wantAlerted)
+			}
+		})
+	}
+}
+
+func TestGoAvailableNow_RespectsLocationConsent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		withdrawn   bool
+		wantErr     error
+		wantAlerted []string
+	}{
+		// Nearby users without consent are left out by the repository query
+		{name: "consented", wantAlerted: []string{"user:consented"}},
+		// Once the owner withdraws, their location isn't stored or used at all
+		{name: "withdrawn", withdrawn: true, wantErr: ErrLocationConsentRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			consents := locationConsents(t, "user:owner")
+			if tt.withdrawn {
+				if _, err := consents.Withdraw(ctx, "user:owner", model.ConsentLocationProcessing); err != nil {
+					t.Fatalf("Withdraw() error = %v", err)
+				}
+			}
+			neighbors := []spontaneousNeighbor{{userID: "user:consented", latKm: 1}}
+			var (
+				active  *model.Availability
+				created int
+				claims  []string
+				starts  []time.Time
+			)
+			svc := NewSpontaneousService(SpontaneousServiceConfig{
+				AvailabilityRepo: spontaneousAvailabilityRepo(&active, &created),
+				Repo:             spontaneousRepo(neighbors, &claims, &starts),
+				ProfileRepo:      spontaneousProfileRepo(neighbors),
+				InterestRepo:     spontaneousInterestRepo(neighbors),
+				BlockChecker:     spontaneousBlockChecker(neighbors),
+				Consent:          consents,
+				Clock:            fakeclock.New(spontaneousTestNow),
+			})
+
+			_, err := svc.GoAvailableNow(ctx, "user:owner", goNowRequest())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GoAvailableNow() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(claims, tt.wantAlerted) {
+				t.Errorf("alerted %v, want %v", claims, tt.wantAlerted)
+			}
+			if wantCreated := len(tt.wantAlerted); created != wantCreated {
+				t.Errorf("created %d windows, want %d", created, wantCreated)
+			}
+		})
+	}
+}
+
+func TestGoAvailableNow_ExtendsActiveWindowWithoutAlerting(t *testing.T) {
+	t.Parallel()
+
+	neighbors := []spontaneousNeighbor{{userID: "user:match", latKm: 1}}
+	active := &model.Availability{
+		ID:          "availability:1",
+		UserID:      "user:owner",
+		Spontaneous: true,
+		StartTime:   spontaneousTestNow.Add(-time.Hour),
+		EndTime:     spontaneousTestNow.Add(30 * time.Minute),
+		ExpiresAt:   spontaneousTestNow.Add(30 * time.Minute),
+	}
+	var (
+		created int
+		claims  []string
+		starts  []time.Time
+	)
+	svc := NewSpontaneousService(SpontaneousServiceConfig{
+		AvailabilityRepo: spontaneousAvailabilityRepo(&active, &created),
+		Repo:             spontaneousRepo(neighbors, &claims, &starts),
+		ProfileRepo:      spontaneousProfileRepo(neighbors),
+		InterestRepo:     spontaneousInterestRepo(neighbors),
+		BlockChecker:     spontaneousBlockChecker(neighbors),
+		Clock:            fakeclock.New(spontaneousTestNow),
+	})
+
+	av, err := svc.GoAvailableNow(context.Background(), "user:owner", goNowRequest())
+	if err != nil {
+		t.Fatalf("GoAvailableNow() error = %v", err)
+	}
+
+	if want := spontaneousTestNow.Add(2 * time.Hour); av.ID != "availability:1" || !av.EndTime.Equal(want) {
+		t.Errorf("window = %s ending %v, want availability:1 extended to %v", av.ID, av.EndTime, want)
+	}
+	if created != 0 || len(starts) != 0 {
+		t.Errorf("created %d windows and %d sessions, want none", created, len(starts))
+	}
+	if len(claims) != 0 {
+		t.Errorf("alerted %v on extend, want nobody", claims)
+	}
+}
+
+func TestGoAvailableNow_LimitsSessionsPerDay(t *testing.T) {
+	t.Parallel()
+
+	var (
+		active  *model.Availability
+		created int
+		claims  []string
+	)
+	starts := []time.Time{
+		spontaneousTestNow.Add(-30 * time.Hour), // Outside the window
+		spontaneousTestNow.Add(-20 * time.Hour),
+		spontaneousTestNow.Add(-10 * time.Hour),
+		spontaneousTestNow.Add(-2 * time.Hour),
+	}
+	svc := NewSpontaneousService(SpontaneousServiceConfig{
+		AvailabilityRepo: spontaneousAvailabilityRepo(&active, &created),
+		Repo:             spontaneousRepo(nil, &claims, &starts),
+		ProfileRepo:      spontaneousProfileRepo(nil),
+		InterestRepo:     spontaneousInterestRepo(nil),
+		BlockChecker:     spontaneousBlockChecker(nil),
+		Clock:            fakeclock.New(spontaneousTestNow),
+	})
+
+	_, err := svc.GoAvailableNow(context.Background(), "user:owner", goNowRequest())
+
+	var limited *SpontaneousLimitError
+	if !errors.As(err, &limited) || !errors.Is(err, ErrSpontaneousLimit) {
+		t.Fatalf("GoAvailableNow() error = %v, want SpontaneousLimitError", err)
+	}
+	if want := spontaneousTestNow.Add(4 * time.Hour); !limited.RetryAt.Equal(want) {
+		t.Errorf("RetryAt = %v, want %v", limited.RetryAt, want)
+	}
+	if created != 0 {
+		t.Error("expected no window to be created over the limit")
+	}
+}
+
+func TestStopAvailableNow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		started bool // The owner went available first
+		wantErr error
+	}{
+		{name: "available", started: true},
+		{name: "not available", wantErr: ErrNotAvailableNow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var (
+				active  *model.Availability
+				created int
+				claims  []string
+				starts  []time.Time
+			)
+			svc := NewSpontaneousService(SpontaneousServiceConfig{
+				AvailabilityRepo: spontaneousAvailabilityRepo(&active, &created),
+				Repo:             spontaneousRepo(nil, &claims, &starts),
+				ProfileRepo:      spontaneousProfileRepo(nil),
+				InterestRepo:     spontaneousInterestRepo(nil),
+				BlockChecker:     spontaneousBlockChecker(nil),
+				Clock:            fakeclock.New(spontaneousTestNow),
+			})
+			if tt.started {
+				if _, err := svc.GoAvailableNow(ctx, "user:owner", goNowRequest()); err != nil {
+					t.Fatalf("GoAvailableNow() error = %v", err)
+				}
+			}
+
+			if err := svc.StopAvailableNow(ctx, "user:owner"); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("StopAvailableNow() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !active.ExpiresAt.Equal(spontaneousTestNow) {
+				t.Errorf("expires = %v, want %v", active.ExpiresAt, spontaneousTestNow)
+			}
+			if _, err := svc.GetAvailableNow(ctx, "user:owner"); !errors.Is(err, ErrNotAvailableNow) {
+				t.Errorf("GetAvailableNow() after stop error = %v, want ErrNotAvailableNow", err)
+			}
+			if len(starts) != 1 {
+				t.Errorf("sessions = %d, want the stopped session to still count", len(starts))
+			}
+		})
+	}
+}
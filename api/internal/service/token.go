@@ -5,9 +5,12 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"log"
 	"time"
 
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/dpop"
 	"github.com/forgo/saga/api/pkg/jwt"
 )
 
@@ -20,7 +23,12 @@ type RefreshToken struct {
 	TokenHash string    `json:"token_hash"`
 	ExpiresAt time.Time `json:"expires_at"`
 	CreatedAt time.Time `json:"created_at"`
+	AuthTime  time.Time `json:"auth_time"` // When the user signed in; carried across rotation
 	Revoked   bool      `json:"revoked"`
+
+	// Carried across rotation, so a refreshed session stays bound
+	KeyThumbprint string `json:"dpop_jkt,omitempty"`        // Key the session is bound to
+	Platform      string `json:"client_platform,omitempty"` // Client the session was issued to
 }
 
 // TokenRepository defines the interface for refresh token storage
@@ -37,6 +45,8 @@ type TokenService struct {
 	jwtService      *jwt.Service
 	tokenRepo       TokenRepository
 	refreshDuration time.Duration
+	dpopPolicy      dpop.Policy
+	clock           clock.Clock
 }
 
 // TokenServiceConfig holds configuration for the token service
@@ -44,6 +54,8 @@ type TokenServiceConfig struct {
 	JWTService      *jwt.Service
 	TokenRepo       TokenRepository
 	RefreshDuration time.Duration // Default: 30 days
+	DPoPPolicy      dpop.Policy   // Proof-of-possession mode per client platform; nil is off for all
+	Clock           clock.Clock   // Default: the system clock
 }
 
 // NewTokenService creates a new token service
@@ -56,6 +68,8 @@ func NewTokenService(cfg TokenServiceConfig) *TokenService {
 		jwtService:      cfg.JWTService,
 		tokenRepo:       cfg.TokenRepo,
 		refreshDuration: cfg.RefreshDuration,
+		dpopPolicy:      cfg.DPoPPolicy,
+		clock:           clock.OrReal(cfg.Clock),
 	}
 }
 
@@ -63,12 +77,20 @@ func NewTokenService(cfg TokenServiceConfig) *TokenService {
 type TokenPair struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
-	TokenType    string `json:"token_type"`
+	TokenType    string `json:"token_type"` // "DPoP" when bound to a client key, else "Bearer"
 	ExpiresIn    int    `json:"expires_in"` // seconds
 }
 
 // GenerateTokenPair creates a new access token and refresh token for a user
+// who has just authenticated. Tokens are bound to the client key in the
+// context's DPoP binding, if any.
 func (s *TokenService) GenerateTokenPair(ctx context.Context, user *model.User) (*TokenPair, error) {
+	return s.generateTokenPair(ctx, user, s.clock.Now(), dpop.FromContext(ctx))
+}
+
+// generateTokenPair issues tokens recording authTime as when the user last
+// authenticated
+func (s *TokenService) generateTokenPair(ctx context.Context, user *model.User, authTime time.Time, binding dpop.Binding) (*TokenPair, error) {
 	// Generate access token (JWT)
 	claims := jwt.Claims{
 		Subject:  user.ID,
@@ -76,6 +98,15 @@ func (s *TokenService) GenerateTokenPair(ctx context.Context, user *model.User)
 		Email:    user.Email,
 		Username: stringValue(user.Username),
 		Role:     string(user.Role),
+		Platform: binding.Platform,
+	}
+	if !authTime.IsZero() {
+		claims.AuthTime = authTime.Unix()
+	}
+	tokenType := "Bearer"
+	if binding.KeyThumbprint != "" {
+		claims.Confirmation = &jwt.Confirmation{JKT: binding.KeyThumbprint}
+		tokenType = dpop.Scheme
 	}
 
 	accessToken, err := s.jwtService.Sign(claims)
@@ -93,12 +124,17 @@ func (s *TokenService) GenerateTokenPair(ctx context.Context, user *model.User)
 	tokenHash := hashToken(refreshToken)
 
 	// Store refresh token
+	now := s.clock.Now()
 	storedToken := &RefreshToken{
 		UserID:    user.ID,
 		TokenHash: tokenHash,
-		ExpiresAt: time.Now().Add(s.refreshDuration),
-		CreatedAt: time.Now(),
+		ExpiresAt: now.Add(s.refreshDuration),
+		CreatedAt: now,
+		AuthTime:  authTime,
 		Revoked:   false,
+
+		KeyThumbprint: binding.KeyThumbprint,
+		Platform:      binding.Platform,
 	}
 
 	if err := s.tokenRepo.CreateRefreshToken(ctx, storedToken); err != nil {
@@ -108,7 +144,7 @@ func (s *TokenService) GenerateTokenPair(ctx context.Context, user *model.User)
 	return &TokenPair{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
-		TokenType:    "Bearer",
+		TokenType:    tokenType,
 		ExpiresIn:    int(s.jwtService.GetExpiration().Seconds()),
 	}, nil
 }
@@ -136,17 +172,39 @@ func (s *TokenService) RefreshTokens(ctx context.Context, refreshToken string, u
 	}
 
 	// Check if expired
-	if time.Now().After(storedToken.ExpiresAt) {
+	if s.clock.Now().After(storedToken.ExpiresAt) {
 		return nil, ErrRefreshTokenExpired
 	}
 
+	// A bound session can only be refreshed with its key, unless its
+	// platform is report-only or off. An unbound one is bound to the key
+	// presented now, which is how sessions from before enforcement was
+	// switched on pick one up.
+	presented := dpop.FromContext(ctx)
+	binding := dpop.Binding{KeyThumbprint: storedToken.KeyThumbprint, Platform: storedToken.Platform}
+	if binding.Platform == "" {
+		binding.Platform = presented.Platform
+	}
+	switch {
+	case binding.KeyThumbprint == "":
+		binding.KeyThumbprint = presented.KeyThumbprint
+	case presented.KeyThumbprint != binding.KeyThumbprint:
+		switch s.dpopPolicy.Mode(binding.Platform) {
+		case dpop.ModeEnforce:
+			return nil, ErrRefreshTokenUnbound
+		case dpop.ModeReport:
+			log.Printf("[TokenService] DPoP report-only: refresh token for %s used without its key", storedToken.UserID)
+		}
+	}
+
 	// Revoke old token (single-use)
 	if err := s.tokenRepo.RevokeRefreshToken(ctx, tokenHash); err != nil {
 		return nil, err
 	}
 
-	// Generate new token pair
-	return s.GenerateTokenPair(ctx, user)
+	// Generate new token pair, keeping the original sign-in time so a
+	// refreshed session doesn't count as a fresh authentication
+	return s.generateTokenPair(ctx, user, storedToken.AuthTime, binding)
 }
 
 // ValidateAccessToken validates an access token and returns the claims
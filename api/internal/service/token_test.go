@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/pkg/dpop"
 	"github.com/forgo/saga/api/pkg/jwt"
 )
 
@@ -450,6 +452,40 @@ func TestRefreshTokens_ExpiredToken_ReturnsError(t *testing.T) {
 	}
 }
 
+func TestRefreshTokens_ExpiresWithClock(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	clk := fakeclock.New(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	var stored *RefreshToken
+	tokenRepo := &mockTokenRepo{
+		createRefreshTokenFunc: func(ctx context.Context, token *RefreshToken) error {
+			stored = token
+			return nil
+		},
+		getRefreshTokenByHashFunc: func(ctx context.Context, hash string) (*RefreshToken, error) {
+			return stored, nil
+		},
+	}
+	svc := NewTokenService(TokenServiceConfig{
+		JWTService:      createTestJWTService(t),
+		TokenRepo:       tokenRepo,
+		RefreshDuration: 7 * 24 * time.Hour,
+		Clock:           clk,
+	})
+
+	user := &model.User{ID: "user-123", Email: "test@example.com"}
+	pair, err := svc.GenerateTokenPair(ctx, user)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	clk.Advance(7*24*time.Hour + time.Second)
+	if _, err := svc.RefreshTokens(ctx, pair.RefreshToken, user); !errors.Is(err, ErrRefreshTokenExpired) {
+		t.Errorf("expected ErrRefreshTokenExpired, got %v", err)
+	}
+}
+
 func TestRefreshTokens_RevokesOldToken(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -490,6 +526,121 @@ func TestRefreshTokens_RevokesOldToken(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// DPoP Binding Tests
+// ============================================================================
+
+func TestGenerateTokenPair_BindsToContextKey(t *testing.T) {
+	t.Parallel()
+
+	jwtSvc := createTestJWTService(t)
+	var stored *RefreshToken
+	svc := NewTokenService(TokenServiceConfig{
+		JWTService: jwtSvc,
+		TokenRepo: &mockTokenRepo{createRefreshTokenFunc: func(ctx context.Context, token *RefreshToken) error {
+			stored = token
+			return nil
+		}},
+	})
+
+	ctx := dpop.NewContext(context.Background(), dpop.Binding{KeyThumbprint: "jkt", Platform: "ios"})
+	pair, err := svc.GenerateTokenPair(ctx, &model.User{ID: "user-123", Email: "test@example.com"})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair failed: %v", err)
+	}
+	if pair.TokenType != "DPoP" {
+		t.Errorf("expected token type DPoP, got %s", pair.TokenType)
+	}
+	claims, err := jwtSvc.Validate(pair.AccessToken)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if !claims.IsBound() || claims.Confirmation.JKT != "jkt" || claims.Platform != "ios" {
+		t.Errorf("expected access token bound to jkt on ios, got %+v", claims)
+	}
+	if stored.KeyThumbprint != "jkt" || stored.Platform != "ios" {
+		t.Errorf("expected refresh token to keep the binding, got %+v", stored)
+	}
+
+	pair, err = svc.GenerateTokenPair(context.Background(), &model.User{ID: "user-123"})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair failed: %v", err)
+	}
+	claims, _ = jwtSvc.Validate(pair.AccessToken)
+	if pair.TokenType != "Bearer" || claims.IsBound() {
+		t.Errorf("expected an unbound bearer token without a binding, got %s %+v", pair.TokenType, claims)
+	}
+}
+
+func TestRefreshTokens_Binding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		storedJKT string
+		presented string
+		mode      dpop.Mode
+		wantErr   error
+		wantJKT   string
+	}{
+		{"bound with its key", "jkt", "jkt", dpop.ModeEnforce, nil, "jkt"},
+		{"bound without its key, enforced", "jkt", "", dpop.ModeEnforce, ErrRefreshTokenUnbound, ""},
+		{"bound with another key, enforced", "jkt", "other", dpop.ModeEnforce, ErrRefreshTokenUnbound, ""},
+		{"bound without its key, report-only", "jkt", "", dpop.ModeReport, nil, "jkt"},
+		{"unbound picks up the presented key", "", "jkt", dpop.ModeEnforce, nil, "jkt"},
+		{"unbound stays unbound", "", "", dpop.ModeOff, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			jwtSvc := createTestJWTService(t)
+			var created *RefreshToken
+			tokenRepo := &mockTokenRepo{
+				getRefreshTokenByHashFunc: func(ctx context.Context, hash string) (*RefreshToken, error) {
+					return &RefreshToken{
+						UserID:        "user-123",
+						TokenHash:     hash,
+						ExpiresAt:     time.Now().Add(time.Hour),
+						KeyThumbprint: tt.storedJKT,
+						Platform:      "ios",
+					}, nil
+				},
+				createRefreshTokenFunc: func(ctx context.Context, token *RefreshToken) error {
+					created = token
+					return nil
+				},
+			}
+			svc := NewTokenService(TokenServiceConfig{
+				JWTService: jwtSvc,
+				TokenRepo:  tokenRepo,
+				DPoPPolicy: dpop.Policy{"ios": tt.mode},
+			})
+
+			// The request's platform header can't change the stored one
+			ctx := dpop.NewContext(context.Background(), dpop.Binding{KeyThumbprint: tt.presented, Platform: "web"})
+			pair, err := svc.RefreshTokens(ctx, "refresh-token", &model.User{ID: "user-123"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			claims, _ := jwtSvc.Validate(pair.AccessToken)
+			gotJKT := ""
+			if claims.IsBound() {
+				gotJKT = claims.Confirmation.JKT
+			}
+			if gotJKT != tt.wantJKT || created.KeyThumbprint != tt.wantJKT {
+				t.Errorf("expected binding %q, got access %q refresh %q", tt.wantJKT, gotJKT, created.KeyThumbprint)
+			}
+			if claims.Platform != "ios" {
+				t.Errorf("expected platform to carry over, got %q", claims.Platform)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // ValidateAccessToken Tests
 // ============================================================================
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// TrashRepository is implemented by the repositories that keep deleted guild
+// content in the trash
+type TrashRepository interface {
+	GetTrashByGuild(ctx context.Context, guildID string) ([]*model.TrashItem, error)
+	RestoreFromTrash(ctx context.Context, id, guildID string) (*model.TrashItem, error)
+	PurgeTrashed(ctx context.Context, before time.Time) (int, error)
+}
+
+// TrashPoolRepository restores trashed pools within the guild's pool limit
+type TrashPoolRepository interface {
+	TrashRepository
+	CountPoolsByGuild(ctx context.Context, guildID string) (int, error)
+}
+
+// TrashGuildRepository checks who may manage a guild's trash
+type TrashGuildRepository interface {
+	IsGuildModerator(ctx context.Context, userID, guildID string) (bool, error)
+}
+
+// TrashService manages each guild's trash of deleted events, pools and votes.
+// Organizers (guild moderators and admins) can list and restore items for
+// model.TrashRetentionDays, after which they're purged.
+type TrashService struct {
+	events    TrashRepository
+	pools     TrashPoolRepository
+	votes     TrashRepository
+	guildRepo TrashGuildRepository
+	clock     clock.Clock
+}
+
+// TrashServiceConfig holds configuration for the trash service
+type TrashServiceConfig struct {
+	Events    TrashRepository
+	Pools     TrashPoolRepository
+	Votes     TrashRepository
+	GuildRepo TrashGuildRepository
+	Clock     clock.Clock // Default: the system clock
+}
+
+// NewTrashService creates a new trash service
+func NewTrashService(cfg TrashServiceConfig) *TrashService {
+	return &TrashService{
+		events:    cfg.Events,
+		pools:     cfg.Pools,
+		votes:     cfg.Votes,
+		guildRepo: cfg.GuildRepo,
+		clock:     clock.OrReal(cfg.Clock),
+	}
+}
+
+// List returns everything in a guild's trash, most recently deleted first
+// (organizers only)
+func (s *TrashService) List(ctx context.Context, userID, guildID string) ([]*model.TrashItem, error) {
+	if err := s.requireOrganizer(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.TrashItem, 0)
+	for _, repo := range []TrashRepository{s.events, s.pools, s.votes} {
+		trashed, err := repo.GetTrashByGuild(ctx, guildID)
+		if err != nil {
+			return nil, fmt.Errorf("listing trash: %w", err)
+		}
+		items = append(items, trashed...)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+	return items, nil
+}
+
+// Restore takes an item out of a guild's trash (organizers only). A pool is
+// only restored while the guild is under its pool limit.
+func (s *TrashService) Restore(ctx context.Context, userID, guildID, itemID string) (*model.TrashItem, error) {
+	if err := s.requireOrganizer(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	itemType, ok := model.TrashItemTypeForID(itemID)
+	if !ok {
+		return nil, ErrTrashItemNotFound
+	}
+
+	var repo TrashRepository
+	switch itemType {
+	case model.TrashItemEvent:
+		repo = s.events
+	case model.TrashItemPool:
+		count, err := s.pools.CountPoolsByGuild(ctx, guildID)
+		if err != nil {
+			return nil, fmt.Errorf("counting pools: %w", err)
+		}
+		if count >= model.MaxPoolsPerGuild {
+			return nil, ErrPoolLimitReached
+		}
+		repo = s.pools
+	case model.TrashItemVote:
+		repo = s.votes
+	}
+
+	item, err := repo.RestoreFromTrash(ctx, itemID, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("restoring %s: %w", itemType, err)
+	}
+	if item == nil {
+		return nil, ErrTrashItemNotFound
+	}
+	return item, nil
+}
+
+// PurgeExpired permanently deletes content that has been in the trash longer
+// than model.TrashRetentionDays. This should be called periodically by a
+// background job.
+func (s *TrashService) PurgeExpired(ctx context.Context) (int, error) {
+	cutoff := model.TrashPurgeCutoff(s.clock.Now())
+
+	total := 0
+	for itemType, repo := range map[model.TrashItemType]TrashRepository{
+		model.TrashItemEvent: s.events,
+		model.TrashItemPool:  s.pools,
+		model.TrashItemVote:  s.votes,
+	} {
+		purged, err := repo.PurgeTrashed(ctx, cutoff)
+		total += purged
+		if err != nil {
+			return total, fmt.Errorf("purging trashed %ss: %w", itemType, err)
+		}
+		if purged > 0 {
+			log.Printf("[TrashService] Purged %d trashed %ss", purged, itemType)
+		}
+	}
+	return total, nil
+}
+
+func (s *TrashService) requireOrganizer(ctx context.Context, userID, guildID string) error {
+	organizer, err := s.guildRepo.IsGuildModerator(ctx, userID, guildID)
+	if err != nil {
+		return err
+	}
+	if !organizer {
+		return ErrNotGuildAdmin
+	}
+	return nil
+}
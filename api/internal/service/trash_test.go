@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockTrashRepo struct {
+	items      []*model.TrashItem
+	pools      int
+	restored   string
+	purgedTill time.Time
+}
+
+func (m *mockTrashRepo) GetTrashByGuild(ctx context.Context, guildID string) ([]*model.TrashItem, error) {
+	return m.items, nil
+}
+
+func (m *mockTrashRepo) RestoreFromTrash(ctx context.Context, id, guildID string) (*model.TrashItem, error) {
+	for _, item := range m.items {
+		if item.ID == id && item.GuildID == guildID {
+			m.restored = id
+			return item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockTrashRepo) PurgeTrashed(ctx context.Context, before time.Time) (int, error) {
+	m.purgedTill = before
+	return len(m.items), nil
+}
+
+func (m *mockTrashRepo) CountPoolsByGuild(ctx context.Context, guildID string) (int, error) {
+	return m.pools, nil
+}
+
+type mockTrashGuildRepo struct {
+	organizers map[string]bool
+}
+
+func (m *mockTrashGuildRepo) IsGuildModerator(ctx context.Context, userID, guildID string) (bool, error) {
+	return m.organizers[userID], nil
+}
+
+var trashTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestTrashService(events, pools, votes *mockTrashRepo) *TrashService {
+	return NewTrashService(TrashServiceConfig{
+		Events:    events,
+		Pools:     pools,
+		Votes:     votes,
+		GuildRepo: &mockTrashGuildRepo{organizers: map[string]bool{"user:organizer": true}},
+		Clock:     fakeclock.New(trashTestNow),
+	})
+}
+
+func newTestTrashItem(id string, itemType model.TrashItemType, deletedAgo time.Duration) *model.TrashItem {
+	return &model.TrashItem{
+		ID:        id,
+		Type:      itemType,
+		GuildID:   "guild:1",
+		DeletedAt: trashTestNow.Add(-deletedAgo),
+	}
+}
+
+// ============================================================================
+// List Tests
+// ============================================================================
+
+func TestTrashList_MergesNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	events := &mockTrashRepo{items: []*model.TrashItem{newTestTrashItem("event:1", model.TrashItemEvent, 3*time.Hour)}}
+	pools := &mockTrashRepo{items: []*model.TrashItem{newTestTrashItem("matching_pool:1", model.TrashItemPool, time.Hour)}}
+	votes := &mockTrashRepo{items: []*model.TrashItem{newTestTrashItem("vote:1", model.TrashItemVote, 2*time.Hour)}}
+	svc := newTestTrashService(events, pools, votes)
+
+	items, err := svc.List(context.Background(), "user:organizer", "guild:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"matching_pool:1", "vote:1", "event:1"}
+	if len(items) != len(want) {
+		t.Fatalf("expected %d items, got %d", len(want), len(items))
+	}
+	for i, id := range want {
+		if items[i].ID != id {
+			t.Errorf("item %d: expected %s, got %s", i, id, items[i].ID)
+		}
+	}
+}
+
+func TestTrashList_OrganizersOnly(t *testing.T) {
+	t.Parallel()
+
+	svc := newTestTrashService(&mockTrashRepo{}, &mockTrashRepo{}, &mockTrashRepo{})
+
+	if _, err := svc.List(context.Background(), "user:member", "guild:1"); !errors.Is(err, ErrNotGuildAdmin) {
+		t.Errorf("expected ErrNotGuildAdmin, got %v", err)
+	}
+}
+
+// ============================================================================
+// Restore Tests
+// ============================================================================
+
+func TestTrashRestore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		itemID  string
+		guildID string
+		pools   int
+		wantErr error
+	}{
+		{name: "event", itemID: "event:1", guildID: "guild:1"},
+		{name: "pool under limit", itemID: "matching_pool:1", guildID: "guild:1", pools: model.MaxPoolsPerGuild - 1},
+		{name: "pool at limit", itemID: "matching_pool:1", guildID: "guild:1", pools: model.MaxPoolsPerGuild, wantErr: ErrPoolLimitReached},
+		{name: "other guild", itemID: "vote:1", guildID: "guild:2", wantErr: ErrTrashItemNotFound},
+		{name: "not trashable", itemID: "guild:1", guildID: "guild:1", wantErr: ErrTrashItemNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			events := &mockTrashRepo{items: []*model.TrashItem{newTestTrashItem("event:1", model.TrashItemEvent, time.Hour)}}
+			pools := &mockTrashRepo{items: []*model.TrashItem{newTestTrashItem("matching_pool:1", model.TrashItemPool, time.Hour)}, pools: tt.pools}
+			votes := &mockTrashRepo{items: []*model.TrashItem{newTestTrashItem("vote:1", model.TrashItemVote, time.Hour)}}
+			svc := newTestTrashService(events, pools, votes)
+
+			item, err := svc.Restore(context.Background(), "user:organizer", tt.guildID, tt.itemID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && (item == nil || item.ID != tt.itemID) {
+				t.Errorf("expected %s to be restored, got %+v", tt.itemID, item)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Purge Tests
+// ============================================================================
+
+func TestTrashPurgeExpired_UsesRetentionCutoff(t *testing.T) {
+	t.Parallel()
+
+	events := &mockTrashRepo{items: []*model.TrashItem{newTestTrashItem("event:1", model.TrashItemEvent, 0)}}
+	pools := &mockTrashRepo{}
+	votes := &mockTrashRepo{items: []*model.TrashItem{newTestTrashItem("vote:1", model.TrashItemVote, 0)}}
+	svc := newTestTrashService(events, pools, votes)
+
+	purged, err := svc.PurgeExpired(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if purged != 2 {
+		t.Errorf("expected 2 purged, got %d", purged)
+	}
+
+	want := trashTestNow.AddDate(0, 0, -model.TrashRetentionDays)
+	for name, repo := range map[string]*mockTrashRepo{"events": events, "pools": pools, "votes": votes} {
+		if !repo.purgedTill.Equal(want) {
+			t.Errorf("%s: expected cutoff %v, got %v", name, want, repo.purgedTill)
+		}
+	}
+}
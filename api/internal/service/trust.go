@@ -27,12 +27,16 @@ type TrustRepositoryInterface interface {
 
 // TrustService handles trust and IRL verification business logic
 type TrustService struct {
-	repo TrustRepositoryInterface
+	repo     TrustRepositoryInterface
+	activity ActivityRecorder
+	events   DomainEventPublisher
 }
 
-// NewTrustService creates a new trust service
-func NewTrustService(repo TrustRepositoryInterface) *TrustService {
-	return &TrustService{repo: repo}
+// NewTrustService creates a new trust service. activity is optional and
+// records granted trust on both users' timelines; events is optional and
+// publishes granted trust to subscribers.
+func NewTrustService(repo TrustRepositoryInterface, activity ActivityRecorder, events DomainEventPublisher) *TrustService {
+	return &TrustService{repo: repo, activity: activity, events: events}
 }
 
 // GrantTrust grants trust from user A to user B
@@ -57,6 +61,7 @@ func (s *TrustService) GrantTrust(ctx context.Context, fromUserID, toUserID stri
 		}
 		existing.Status = model.TrustStatusActive
 		existing.UpdatedOn = time.Now()
+		s.recordTrustGranted(ctx, existing)
 		return existing, nil
 	}
 
@@ -71,9 +76,32 @@ func (s *TrustService) GrantTrust(ctx context.Context, fromUserID, toUserID stri
 		return nil, err
 	}
 
+	s.recordTrustGranted(ctx, trust)
 	return trust, nil
 }
 
+// recordTrustGranted adds granted trust to the timelines of both users and
+// publishes it
+func (s *TrustService) recordTrustGranted(ctx context.Context, trust *model.TrustRelation) {
+	fromUserID, toUserID := trust.UserAID, trust.UserBID
+	recordActivity(ctx, s.activity,
+		&model.ActivityEntry{
+			UserID:      fromUserID,
+			Type:        model.ActivityTrustGiven,
+			SubjectType: model.ActivitySubjectTrust,
+			SubjectID:   toUserID,
+		},
+		&model.ActivityEntry{
+			UserID:      toUserID,
+			Type:        model.ActivityTrustReceived,
+			ActorID:     &fromUserID,
+			SubjectType: model.ActivitySubjectTrust,
+			SubjectID:   fromUserID,
+		},
+	)
+	publishDomainEvents(ctx, s.events, model.TrustGranted{FromUserID: fromUserID, ToUserID: toUserID})
+}
+
 // RevokeTrust revokes trust from user A to user B
 func (s *TrustService) RevokeTrust(ctx context.Context, fromUserID, toUserID string) error {
 	if fromUserID == toUserID {
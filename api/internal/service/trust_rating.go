@@ -15,8 +15,8 @@ type TrustRatingRepository interface {
 	GetByRaterRateeAnchor(ctx context.Context, raterID, rateeID, anchorType, anchorID string) (*model.TrustRating, error)
 	Update(ctx context.Context, id string, trustLevel model.TrustLevel, trustReview string) (*model.TrustRating, error)
 	Delete(ctx context.Context, id string) error
-	GetReceivedRatings(ctx context.Context, userID string, limit, offset int) ([]*model.TrustRating, error)
-	GetGivenRatings(ctx context.Context, userID string, limit, offset int) ([]*model.TrustRating, error)
+	GetReceivedRatings(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error)
+	GetGivenRatings(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error)
 	GetAggregate(ctx context.Context, userID string) (*model.TrustAggregate, error)
 	GetDailyCount(ctx context.Context, userID string) (int, error)
 	CanRate(ctx context.Context, raterID, rateeID, anchorType, anchorID string) (bool, error)
@@ -198,20 +198,22 @@ func (s *TrustRatingService) Delete(ctx context.Context, id string, userID strin
 	return nil
 }
 
-// GetReceivedRatings retrieves public ratings received by a user
-func (s *TrustRatingService) GetReceivedRatings(ctx context.Context, userID string, limit, offset int) ([]*model.TrustRating, error) {
-	if limit <= 0 || limit > 100 {
-		limit = 50
+// GetReceivedRatings retrieves a page of the public ratings received by a
+// user, sorted and filtered as list asks within model.TrustRatingListFields
+func (s *TrustRatingService) GetReceivedRatings(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error) {
+	if page.Limit <= 0 || page.Limit > model.MaxPageSize {
+		page.Limit = model.DefaultPageSize
 	}
-	return s.repo.GetReceivedRatings(ctx, userID, limit, offset)
+	return s.repo.GetReceivedRatings(ctx, userID, list, page)
 }
 
-// GetGivenRatings retrieves ratings given by a user
-func (s *TrustRatingService) GetGivenRatings(ctx context.Context, userID string, limit, offset int) ([]*model.TrustRating, error) {
-	if limit <= 0 || limit > 100 {
-		limit = 50
+// GetGivenRatings retrieves a page of the ratings given by a user, sorted
+// and filtered as list asks within model.TrustRatingListFields
+func (s *TrustRatingService) GetGivenRatings(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error) {
+	if page.Limit <= 0 || page.Limit > model.MaxPageSize {
+		page.Limit = model.DefaultPageSize
 	}
-	return s.repo.GetGivenRatings(ctx, userID, limit, offset)
+	return s.repo.GetGivenRatings(ctx, userID, list, page)
 }
 
 // GetAggregate retrieves aggregated trust stats for a user
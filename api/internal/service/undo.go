@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// UndoRepository defines the interface for undo operation storage
+type UndoRepository interface {
+	Create(ctx context.Context, op *model.UndoOperation) error
+	GetByID(ctx context.Context, id string) (*model.UndoOperation, error)
+	Claim(ctx context.Context, id string) (*model.UndoOperation, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// UndoAvailabilityRepository restores and finalizes deleted availability
+type UndoAvailabilityRepository interface {
+	RestorePendingDelete(ctx context.Context, id string, now time.Time) (bool, error)
+	DeleteExpiredPending(ctx context.Context, now time.Time) (int, error)
+}
+
+// UndoGuildRepository restores guild memberships
+type UndoGuildRepository interface {
+	GetByID(ctx context.Context, id string) (*model.Guild, error)
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	CountMembers(ctx context.Context, guildID string) (int, error)
+	CountGuildsForUser(ctx context.Context, userID string) (int, error)
+	AddMemberWithRole(ctx context.Context, memberID, guildID string, role model.GuildRole, pendingApproval bool) error
+}
+
+// UndoEventRepository restores cancelled RSVPs
+type UndoEventRepository interface {
+	Get(ctx context.Context, eventID string) (*model.Event, error)
+	CountApprovedRSVPs(ctx context.Context, eventID string) (int, error)
+	RestoreRSVP(ctx context.Context, rsvpID, status string) (*model.EventRSVP, error)
+}
+
+// UndoRecorder is called by services after a destructive action so the user
+// can undo it for a short window. Record sets the operation's ID and expiry.
+type UndoRecorder interface {
+	Record(ctx context.Context, op *model.UndoOperation) error
+}
+
+// UndoService lets users reverse a destructive action within
+// model.UndoWindowMinutes of taking it
+type UndoService struct {
+	repo             UndoRepository
+	availabilityRepo UndoAvailabilityRepository
+	guildRepo        UndoGuildRepository
+	eventRepo        UndoEventRepository
+	clock            clock.Clock
+}
+
+// UndoServiceConfig holds configuration for the undo service
+type UndoServiceConfig struct {
+	Repo             UndoRepository
+	AvailabilityRepo UndoAvailabilityRepository
+	GuildRepo        UndoGuildRepository
+	EventRepo        UndoEventRepository
+	Clock            clock.Clock // Default: the system clock
+}
+
+// NewUndoService creates a new undo service
+func NewUndoService(cfg UndoServiceConfig) *UndoService {
+	return &UndoService{
+		repo:             cfg.Repo,
+		availabilityRepo: cfg.AvailabilityRepo,
+		guildRepo:        cfg.GuildRepo,
+		eventRepo:        cfg.EventRepo,
+		clock:            clock.OrReal(cfg.Clock),
+	}
+}
+
+// Record stores an undo operation that expires at the end of the undo window
+func (s *UndoService) Record(ctx context.Context, op *model.UndoOperation) error {
+	op.ExpiresOn = s.clock.Now().Add(model.UndoWindowMinutes * time.Minute)
+	if err := s.repo.Create(ctx, op); err != nil {
+		return fmt.Errorf("recording undo operation: %w", err)
+	}
+	return nil
+}
+
+// Undo reverses one of the user's actions while its window is open. An
+// operation can only be used once; if the action can't be reversed any more
+// (the guild filled up, the RSVP was changed) ErrUndoUnavailable is returned.
+func (s *UndoService) Undo(ctx context.Context, userID, id string) (*model.UndoOperation, error) {
+	op, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting undo operation: %w", err)
+	}
+	now := s.clock.Now()
+	if op == nil || op.UserID != userID || !op.ExpiresOn.After(now) {
+		return nil, ErrUndoNotFound
+	}
+
+	op, err = s.repo.Claim(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("claiming undo operation: %w", err)
+	}
+	if op == nil {
+		return nil, ErrUndoNotFound
+	}
+
+	switch op.Type {
+	case model.UndoAvailabilityDelete:
+		err = s.restoreAvailability(ctx, op, now)
+	case model.UndoGuildLeave:
+		err = s.restoreGuildMembership(ctx, op)
+	case model.UndoRSVPCancel:
+		err = s.restoreRSVP(ctx, op)
+	default:
+		err = ErrUndoUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// FinalizeExpired deletes availability whose undo window has closed and
+// drops expired operations. This should be called periodically by a
+// background job.
+func (s *UndoService) FinalizeExpired(ctx context.Context) (int, error) {
+	now := s.clock.Now()
+
+	deleted, err := s.availabilityRepo.DeleteExpiredPending(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("deleting pending availability: %w", err)
+	}
+
+	expired, err := s.repo.DeleteExpired(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired undo operations: %w", err)
+	}
+	if deleted > 0 {
+		log.Printf("[UndoService] Finalized %d availability deletions", deleted)
+	}
+	return expired, nil
+}
+
+func (s *UndoService) restoreAvailability(ctx context.Context, op *model.UndoOperation, now time.Time) error {
+	restored, err := s.availabilityRepo.RestorePendingDelete(ctx, op.ResourceID, now)
+	if err != nil {
+		return fmt.Errorf("restoring availability: %w", err)
+	}
+	if !restored {
+		return ErrUndoUnavailable
+	}
+	return nil
+}
+
+// restoreGuildMembership rejoins the guild with the role the user left with.
+// Rejoining is subject to the same limits as joining.
+func (s *UndoService) restoreGuildMembership(ctx context.Context, op *model.UndoOperation) error {
+	guildID := op.ResourceID
+	memberID, _ := op.Data["member_id"].(string)
+	role, _ := op.Data["role"].(string)
+	pendingApproval, _ := op.Data["pending_approval"].(bool)
+	if memberID == "" {
+		return ErrUndoUnavailable
+	}
+
+	guild, err := s.guildRepo.GetByID(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("getting guild: %w", err)
+	}
+	if guild == nil {
+		return ErrUndoUnavailable
+	}
+
+	isMember, err := s.guildRepo.IsMember(ctx, op.UserID, guildID)
+	if err != nil {
+		return fmt.Errorf("checking membership: %w", err)
+	}
+	if isMember {
+		return ErrUndoUnavailable
+	}
+
+	memberCount, err := s.guildRepo.CountMembers(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("counting members: %w", err)
+	}
+	guildCount, err := s.guildRepo.CountGuildsForUser(ctx, op.UserID)
+	if err != nil {
+		return fmt.Errorf("counting user guilds: %w", err)
+	}
+	if memberCount >= model.MaxMembersPerGuild || guildCount >= model.MaxGuildsPerUser {
+		return ErrUndoUnavailable
+	}
+
+	guildRole := model.GuildRole(role)
+	if !guildRole.IsValid() {
+		guildRole = model.GuildRoleMember
+	}
+	if err := s.guildRepo.AddMemberWithRole(ctx, memberID, guildID, guildRole, pendingApproval); err != nil {
+		return fmt.Errorf("restoring membership: %w", err)
+	}
+	return nil
+}
+
+// restoreRSVP puts a cancelled RSVP back in its previous status. An approved
+// RSVP is only restored while the event has room for it.
+func (s *UndoService) restoreRSVP(ctx context.Context, op *model.UndoOperation) error {
+	eventID, _ := op.Data["event_id"].(string)
+	status, _ := op.Data["status"].(string)
+	if eventID == "" || status == "" || status == model.RSVPStatusCancelled {
+		return ErrUndoUnavailable
+	}
+
+	event, err := s.eventRepo.Get(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("getting event: %w", err)
+	}
+	if event == nil || event.Status == model.EventStatusCancelled {
+		return ErrUndoUnavailable
+	}
+
+	if status == model.RSVPStatusApproved && event.MaxAttendees != nil {
+		approved, err := s.eventRepo.CountApprovedRSVPs(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("counting RSVPs: %w", err)
+		}
+		if approved >= *event.MaxAttendees {
+			return ErrUndoUnavailable
+		}
+	}
+
+	rsvp, err := s.eventRepo.RestoreRSVP(ctx, op.ResourceID, status)
+	if err != nil {
+		return fmt.Errorf("restoring RSVP: %w", err)
+	}
+	if rsvp == nil {
+		return ErrUndoUnavailable
+	}
+	return nil
+}
+
+// recordUndo records a destructive action with the recorder when one is
+// configured. Failing to record doesn't fail the action; it just can't be
+// undone.
+func recordUndo(ctx context.Context, recorder UndoRecorder, op *model.UndoOperation) *model.UndoOperation {
+	if recorder == nil {
+		return nil
+	}
+	if err := recorder.Record(ctx, op); err != nil {
+		log.Printf("[Undo] Failed to record %s of %s: %v", op.Type, op.ResourceID, err)
+		return nil
+	}
+	return op
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockUndoRepo struct {
+	ops map[string]*model.UndoOperation
+}
+
+func newMockUndoRepo(ops ...*model.UndoOperation) *mockUndoRepo {
+	repo := &mockUndoRepo{ops: make(map[string]*model.UndoOperation)}
+	for _, op := range ops {
+		repo.ops[op.ID] = op
+	}
+	return repo
+}
+
+func (m *mockUndoRepo) Create(ctx context.Context, op *model.UndoOperation) error {
+	op.ID = "undo_operation:new"
+	m.ops[op.ID] = op
+	return nil
+}
+
+func (m *mockUndoRepo) GetByID(ctx context.Context, id string) (*model.UndoOperation, error) {
+	return m.ops[id], nil
+}
+
+func (m *mockUndoRepo) Claim(ctx context.Context, id string) (*model.UndoOperation, error) {
+	op := m.ops[id]
+	delete(m.ops, id)
+	return op, nil
+}
+
+func (m *mockUndoRepo) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
+
+type mockUndoAvailabilityRepo struct {
+	pending map[string]bool
+}
+
+func (m *mockUndoAvailabilityRepo) RestorePendingDelete(ctx context.Context, id string, now time.Time) (bool, error) {
+	if !m.pending[id] {
+		return false, nil
+	}
+	delete(m.pending, id)
+	return true, nil
+}
+
+func (m *mockUndoAvailabilityRepo) DeleteExpiredPending(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
+
+type mockUndoGuildRepo struct {
+	members  int
+	restored *model.GuildMembership
+}
+
+func (m *mockUndoGuildRepo) GetByID(ctx context.Context, id string) (*model.Guild, error) {
+	return &model.Guild{ID: id}, nil
+}
+
+func (m *mockUndoGuildRepo) IsMember(ctx context.Context, userID, guildID string) (bool, error) {
+	return m.restored != nil, nil
+}
+
+func (m *mockUndoGuildRepo) CountMembers(ctx context.Context, guildID string) (int, error) {
+	return m.members, nil
+}
+
+func (m *mockUndoGuildRepo) CountGuildsForUser(ctx context.Context, userID string) (int, error) {
+	return 0, nil
+}
+
+func (m *mockUndoGuildRepo) AddMemberWithRole(ctx context.Context, memberID, guildID string, role model.GuildRole, pendingApproval bool) error {
+	m.restored = &model.GuildMembership{MemberID: memberID, GuildID: guildID, Role: role, PendingApproval: pendingApproval}
+	return nil
+}
+
+type mockUndoEventRepo struct {
+	event    *model.Event
+	approved int
+	restored string
+}
+
+func (m *mockUndoEventRepo) Get(ctx context.Context, eventID string) (*model.Event, error) {
+	return m.event, nil
+}
+
+func (m *mockUndoEventRepo) CountApprovedRSVPs(ctx context.Context, eventID string) (int, error) {
+	return m.approved, nil
+}
+
+func (m *mockUndoEventRepo) RestoreRSVP(ctx context.Context, rsvpID, status string) (*model.EventRSVP, error) {
+	m.restored = status
+	return &model.EventRSVP{ID: rsvpID, Status: status}, nil
+}
+
+var undoTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestUndoService(repo *mockUndoRepo, availability *mockUndoAvailabilityRepo, guilds *mockUndoGuildRepo, events *mockUndoEventRepo) *UndoService {
+	return NewUndoService(UndoServiceConfig{
+		Repo:             repo,
+		AvailabilityRepo: availability,
+		GuildRepo:        guilds,
+		EventRepo:        events,
+		Clock:            fakeclock.New(undoTestNow),
+	})
+}
+
+func newTestUndoOperation(opType model.UndoOperationType, resourceID string, data map[string]interface{}) *model.UndoOperation {
+	return &model.UndoOperation{
+		ID:         "undo_operation:1",
+		UserID:     "user:1",
+		Type:       opType,
+		ResourceID: resourceID,
+		Data:       data,
+		ExpiresOn:  undoTestNow.Add(time.Minute),
+	}
+}
+
+// ============================================================================
+// Record / Lookup Tests
+// ============================================================================
+
+func TestUndoRecord_SetsWindow(t *testing.T) {
+	t.Parallel()
+
+	svc := newTestUndoService(newMockUndoRepo(), &mockUndoAvailabilityRepo{}, &mockUndoGuildRepo{}, &mockUndoEventRepo{})
+
+	op := &model.UndoOperation{UserID: "user:1", Type: model.UndoAvailabilityDelete, ResourceID: "availability:1"}
+	if err := svc.Record(context.Background(), op); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := undoTestNow.Add(model.UndoWindowMinutes * time.Minute); !op.ExpiresOn.Equal(want) {
+		t.Errorf("expected expiry %v, got %v", want, op.ExpiresOn)
+	}
+}
+
+func TestUndo_HidesOtherUsersAndExpiredOperations(t *testing.T) {
+	t.Parallel()
+
+	expired := newTestUndoOperation(model.UndoAvailabilityDelete, "availability:1", nil)
+	expired.ID = "undo_operation:2"
+	expired.ExpiresOn = undoTestNow
+	repo := newMockUndoRepo(newTestUndoOperation(model.UndoAvailabilityDelete, "availability:1", nil), expired)
+	svc := newTestUndoService(repo, &mockUndoAvailabilityRepo{pending: map[string]bool{"availability:1": true}}, &mockUndoGuildRepo{}, &mockUndoEventRepo{})
+
+	if _, err := svc.Undo(context.Background(), "user:2", "undo_operation:1"); !errors.Is(err, ErrUndoNotFound) {
+		t.Errorf("expected ErrUndoNotFound for another user's operation, got %v", err)
+	}
+	if _, err := svc.Undo(context.Background(), "user:1", "undo_operation:2"); !errors.Is(err, ErrUndoNotFound) {
+		t.Errorf("expected ErrUndoNotFound for an expired operation, got %v", err)
+	}
+}
+
+// ============================================================================
+// Restore Tests
+// ============================================================================
+
+func TestUndo_RestoresAvailabilityOnce(t *testing.T) {
+	t.Parallel()
+
+	availability := &mockUndoAvailabilityRepo{pending: map[string]bool{"availability:1": true}}
+	repo := newMockUndoRepo(newTestUndoOperation(model.UndoAvailabilityDelete, "availability:1", nil))
+	svc := newTestUndoService(repo, availability, &mockUndoGuildRepo{}, &mockUndoEventRepo{})
+
+	if _, err := svc.Undo(context.Background(), "user:1", "undo_operation:1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if availability.pending["availability:1"] {
+		t.Error("expected the availability to be restored")
+	}
+	if _, err := svc.Undo(context.Background(), "user:1", "undo_operation:1"); !errors.Is(err, ErrUndoNotFound) {
+		t.Errorf("expected a second undo to find nothing, got %v", err)
+	}
+}
+
+func TestUndo_RejoinsGuildWithRole(t *testing.T) {
+	t.Parallel()
+
+	guilds := &mockUndoGuildRepo{members: 3}
+	repo := newMockUndoRepo(newTestUndoOperation(model.UndoGuildLeave, "guild:1", map[string]interface{}{
+		"member_id":        "member:1",
+		"role":             "moderator",
+		"pending_approval": false,
+	}))
+	svc := newTestUndoService(repo, &mockUndoAvailabilityRepo{}, guilds, &mockUndoEventRepo{})
+
+	if _, err := svc.Undo(context.Background(), "user:1", "undo_operation:1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if guilds.restored == nil || guilds.restored.MemberID != "member:1" || guilds.restored.Role != model.GuildRoleModerator {
+		t.Errorf("expected the moderator membership to be restored, got %+v", guilds.restored)
+	}
+}
+
+func TestUndo_UnavailableWhenGuildFull(t *testing.T) {
+	t.Parallel()
+
+	guilds := &mockUndoGuildRepo{members: model.MaxMembersPerGuild}
+	repo := newMockUndoRepo(newTestUndoOperation(model.UndoGuildLeave, "guild:1", map[string]interface{}{
+		"member_id": "member:1",
+		"role":      "member",
+	}))
+	svc := newTestUndoService(repo, &mockUndoAvailabilityRepo{}, guilds, &mockUndoEventRepo{})
+
+	if _, err := svc.Undo(context.Background(), "user:1", "undo_operation:1"); !errors.Is(err, ErrUndoUnavailable) {
+		t.Errorf("expected ErrUndoUnavailable, got %v", err)
+	}
+	if guilds.restored != nil {
+		t.Error("expected no membership to be restored")
+	}
+}
+
+func TestUndo_RestoresRSVPStatus(t *testing.T) {
+	t.Parallel()
+
+	maxAttendees := 10
+	tests := []struct {
+		name     string
+		status   string
+		approved int
+		wantErr  error
+	}{
+		{name: "approved with room", status: model.RSVPStatusApproved, approved: 9},
+		{name: "approved when full", status: model.RSVPStatusApproved, approved: 10, wantErr: ErrUndoUnavailable},
+		{name: "pending when full", status: model.RSVPStatusPending, approved: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			events := &mockUndoEventRepo{
+				event:    &model.Event{ID: "event:1", Status: model.EventStatusPublished, MaxAttendees: &maxAttendees},
+				approved: tt.approved,
+			}
+			repo := newMockUndoRepo(newTestUndoOperation(model.UndoRSVPCancel, "event_rsvp:1", map[string]interface{}{
+				"event_id": "event:1",
+				"status":   tt.status,
+			}))
+			svc := newTestUndoService(repo, &mockUndoAvailabilityRepo{}, &mockUndoGuildRepo{}, events)
+
+			_, err := svc.Undo(context.Background(), "user:1", "undo_operation:1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && events.restored != tt.status {
+				t.Errorf("expected the RSVP restored to %s, got %q", tt.status, events.restored)
+			}
+		})
+	}
+}
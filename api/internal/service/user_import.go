@@ -0,0 +1,517 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/requestid"
+)
+
+// UserImportRepository defines the interface for user import storage
+type UserImportRepository interface {
+	Create(ctx context.Context, imp *model.UserImport) error
+	Get(ctx context.Context, id string) (*model.UserImport, error)
+	List(ctx context.Context, limit int) ([]*model.UserImport, error)
+	ListByStatus(ctx context.Context, status model.UserImportStatus, limit int) ([]*model.UserImport, error)
+	Claim(ctx context.Context, id string) (bool, error)
+	Requeue(ctx context.Context, id string) error
+	Complete(ctx context.Context, id string, rows []model.UserImportRow) error
+	Fail(ctx context.Context, id, reason string) error
+}
+
+// UserInvitationRepository defines the interface for invitation storage
+type UserInvitationRepository interface {
+	Create(ctx context.Context, invitation *model.UserInvitation) error
+	GetOpenByUser(ctx context.Context, userID string, now time.Time) (*model.UserInvitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*model.UserInvitation, error)
+	ClaimByTokenHash(ctx context.Context, tokenHash string) (*model.UserInvitation, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// UserImportGuilds adds imported users to the guilds their row names
+type UserImportGuilds interface {
+	ResolveSlug(ctx context.Context, slug string) (string, error)
+	JoinGuildByInvite(ctx context.Context, userID, guildID string) error
+}
+
+// userImportBatchSize is how many queued imports one ProcessPending run takes
+const userImportBatchSize = 5
+
+// UserImportFileError is returned when an uploaded CSV file can't be
+// imported at all. Line is 0 when the problem isn't on a particular line.
+type UserImportFileError struct {
+	Line   int
+	Reason string
+}
+
+func (e *UserImportFileError) Error() string {
+	if e.Line == 0 {
+		return e.Reason
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Reason)
+}
+
+// UserImportService seeds a community from a CSV file of members. An admin
+// uploads the file, the user import job creates an account for each new
+// email, adds the user to the guilds on their row and emails an invitation
+// to choose a password, and the admin polls the import for each row's
+// result.
+//
+// Every row is idempotent: existing accounts are reused, guilds the user is
+// already in are skipped and invitations are only sent when the user has
+// no open one, so a file can safely be imported again after fixing the
+// rows that failed.
+type UserImportService struct {
+	repo        UserImportRepository
+	userRepo    UserRepository
+	invitations UserInvitationRepository
+	guilds      UserImportGuilds
+	mailer      EmailSender
+	acceptURL   string
+	clock       clock.Clock
+}
+
+// UserImportServiceConfig holds configuration for the user import service
+type UserImportServiceConfig struct {
+	Repo     UserImportRepository
+	UserRepo UserRepository
+	Guilds   UserImportGuilds
+
+	// Invitations are not sent unless both are set
+	InvitationRepo UserInvitationRepository
+	Mailer         EmailSender
+	// PublicBaseURL is the site invitation links open on
+	PublicBaseURL string
+
+	// Clock decides invitation expiry and stale imports; nil is the system
+	// clock
+	Clock clock.Clock
+}
+
+// NewUserImportService creates a new user import service
+func NewUserImportService(cfg UserImportServiceConfig) *UserImportService {
+	return &UserImportService{
+		repo:        cfg.Repo,
+		userRepo:    cfg.UserRepo,
+		invitations: cfg.InvitationRepo,
+		guilds:      cfg.Guilds,
+		mailer:      cfg.Mailer,
+		acceptURL:   strings.TrimRight(cfg.PublicBaseURL, "/") + model.InvitationAcceptPath,
+		clock:       clock.OrReal(cfg.Clock),
+	}
+}
+
+// Request parses a CSV file and queues its rows for the user import job.
+// The file needs a header naming its columns: email and name are required,
+// and guilds lists guild slugs separated by semicolons. Problems with
+// individual rows are reported in the import's results; a file that can't
+// be read at all is a *UserImportFileError.
+func (s *UserImportService) Request(ctx context.Context, actorID string, data io.Reader) (*model.UserImport, error) {
+	records, err := parseUserImportCSV(data)
+	if err != nil {
+		return nil, err
+	}
+	return s.Queue(ctx, actorID, records)
+}
+
+// Queue queues rows that were read from another kind of upload, such as
+// the members of a guild import
+func (s *UserImportService) Queue(ctx context.Context, actorID string, records []model.UserImportRecord) (*model.UserImport, error) {
+	imp := &model.UserImport{
+		RequestedBy: actorID,
+		Records:     records,
+		RequestID:   requestid.FromContext(ctx),
+	}
+	if err := s.repo.Create(ctx, imp); err != nil {
+		return nil, err
+	}
+	return imp, nil
+}
+
+// Get returns an import with its row results
+func (s *UserImportService) Get(ctx context.Context, id string) (*model.UserImport, error) {
+	imp, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if imp == nil {
+		return nil, ErrUserImportNotFound
+	}
+	return imp, nil
+}
+
+// List returns the most recent imports, newest first
+func (s *UserImportService) List(ctx context.Context, limit int) ([]*model.UserImport, error) {
+	if limit <= 0 {
+		limit = model.DefaultUserImportsLimit
+	}
+	if limit > model.MaxUserImportsLimit {
+		limit = model.MaxUserImportsLimit
+	}
+	return s.repo.List(ctx, limit)
+}
+
+// ProcessPending imports queued files. Imports left running by a worker
+// that died are queued again first, which is safe because rows are
+// idempotent. Returns how many imports were processed.
+func (s *UserImportService) ProcessPending(ctx context.Context) (int, error) {
+	if err := s.requeueStale(ctx); err != nil {
+		return 0, err
+	}
+
+	pending, err := s.repo.ListByStatus(ctx, model.UserImportPending, userImportBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, imp := range pending {
+		claimed, err := s.repo.Claim(ctx, imp.ID)
+		if err != nil {
+			return processed, err
+		}
+		if !claimed {
+			continue
+		}
+
+		rows := s.importRows(requestid.NewContext(ctx, imp.RequestID), imp.Records)
+		if err := s.repo.Complete(ctx, imp.ID, rows); err != nil {
+			log.Printf("[UserImportService] Failed to save results of import %s (request %s): %v", imp.ID, imp.RequestID, err)
+			if err := s.repo.Fail(ctx, imp.ID, err.Error()); err != nil {
+				log.Printf("[UserImportService] Failed to mark import %s failed: %v", imp.ID, err)
+			}
+			continue
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// requeueStale queues imports that have been running longer than the
+// timeout again
+func (s *UserImportService) requeueStale(ctx context.Context) error {
+	running, err := s.repo.ListByStatus(ctx, model.UserImportRunning, model.MaxUserImportsLimit)
+	if err != nil {
+		return err
+	}
+
+	cutoff := s.clock.Now().Add(-model.UserImportTimeout)
+	for _, imp := range running {
+		if imp.StartedOn != nil && imp.StartedOn.Before(cutoff) {
+			if err := s.repo.Requeue(ctx, imp.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// userImportRun is the state shared by the rows of one import
+type userImportRun struct {
+	seen     map[string]int    // Email to the line it first appeared on
+	guildIDs map[string]string // Slug to guild ID; "" when the slug is unknown
+}
+
+func (s *UserImportService) importRows(ctx context.Context, records []model.UserImportRecord) []model.UserImportRow {
+	run := &userImportRun{
+		seen:     make(map[string]int),
+		guildIDs: make(map[string]string),
+	}
+
+	rows := make([]model.UserImportRow, 0, len(records))
+	for _, rec := range records {
+		rows = append(rows, s.importRow(ctx, run, rec))
+	}
+	return rows
+}
+
+// importRow imports one row. A row that fails validation changes nothing;
+// one that fails part way keeps what it did, and importing it again
+// finishes the rest.
+func (s *UserImportService) importRow(ctx context.Context, run *userImportRun, rec model.UserImportRecord) model.UserImportRow {
+	row := model.UserImportRow{Line: rec.Line, Email: rec.Email}
+
+	guildIDs, fieldErrors, err := s.validateRecord(ctx, run, rec)
+	if err != nil {
+		log.Printf("[UserImportService] Failed to validate line %d: %v", rec.Line, err)
+		return failedImportRow(row, "", "row could not be checked")
+	}
+	if len(fieldErrors) > 0 {
+		row.Status = model.UserImportRowInvalid
+		row.Errors = fieldErrors
+		return row
+	}
+
+	user, created, err := s.findOrCreateUser(ctx, rec)
+	if err != nil {
+		log.Printf("[UserImportService] Failed to create user on line %d: %v", rec.Line, err)
+		return failedImportRow(row, "email", "account could not be created")
+	}
+	row.UserID = user.ID
+	row.Status = model.UserImportRowExisting
+	if created {
+		row.Status = model.UserImportRowCreated
+	}
+
+	for i, guildID := range guildIDs {
+		err := s.guilds.JoinGuildByInvite(ctx, user.ID, guildID)
+		switch {
+		case err == nil:
+			row.Guilds = append(row.Guilds, guildID)
+		case errors.Is(err, ErrAlreadyGuildMember):
+		default:
+			row = failedImportRow(row, "guilds", rec.Guilds[i]+": "+joinFailureReason(err))
+			if !isExpectedJoinFailure(err) {
+				log.Printf("[UserImportService] Failed to add line %d to guild %s: %v", rec.Line, guildID, err)
+			}
+		}
+	}
+
+	invited, err := s.invite(ctx, user)
+	if err != nil {
+		log.Printf("[UserImportService] Failed to invite %s: %v", user.ID, err)
+		return failedImportRow(row, "email", "invitation could not be sent")
+	}
+	row.Invited = invited
+
+	return row
+}
+
+// validateRecord checks a row and resolves its guild slugs, returned in the
+// order of rec.Guilds
+func (s *UserImportService) validateRecord(ctx context.Context, run *userImportRun, rec model.UserImportRecord) ([]string, []model.FieldError, error) {
+	var v model.Validator
+
+	if !isValidEmail(rec.Email) {
+		v.Fail("email", "email is not a valid address")
+	} else if line, ok := run.seen[rec.Email]; ok {
+		v.Fail("email", fmt.Sprintf("email is already on line %d", line))
+	} else {
+		run.seen[rec.Email] = rec.Line
+	}
+	v.String("name", rec.Name).Required().MaxLength(model.MaxUserImportNameLength)
+	v.Check("guilds", len(rec.Guilds) <= model.MaxUserImportGuilds,
+		fmt.Sprintf("guilds must list at most %d guilds", model.MaxUserImportGuilds))
+	if v.Failed("guilds") {
+		return nil, v.Errors(), nil
+	}
+
+	guildIDs := make([]string, 0, len(rec.Guilds))
+	for _, slug := range rec.Guilds {
+		guildID, ok := run.guildIDs[slug]
+		if !ok {
+			var err error
+			guildID, err = s.guilds.ResolveSlug(ctx, slug)
+			if err != nil && !errors.Is(err, ErrGuildNotFound) {
+				return nil, nil, err
+			}
+			run.guildIDs[slug] = guildID
+		}
+		if guildID == "" {
+			v.Fail("guilds", fmt.Sprintf("guild %q not found", slug))
+			continue
+		}
+		guildIDs = append(guildIDs, guildID)
+	}
+	return guildIDs, v.Errors(), nil
+}
+
+// findOrCreateUser returns the account for a row's email, creating one
+// without a password if there is none. created reports whether it was new.
+func (s *UserImportService) findOrCreateUser(ctx context.Context, rec model.UserImportRecord) (user *model.User, created bool, err error) {
+	user, err = s.userRepo.GetByEmail(ctx, rec.Email)
+	if err != nil || user != nil {
+		return user, false, err
+	}
+
+	firstname, lastname, _ := strings.Cut(rec.Name, " ")
+	user = &model.User{
+		Email:     rec.Email,
+		Firstname: stringPtr(firstname),
+		Lastname:  stringPtr(strings.TrimSpace(lastname)),
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		// The address may have registered since it was looked up
+		if errors.Is(err, database.ErrDuplicate) {
+			user, err = s.userRepo.GetByEmail(ctx, rec.Email)
+			if err == nil && user == nil {
+				err = ErrUserNotFound
+			}
+			return user, false, err
+		}
+		return nil, false, err
+	}
+	return user, true, nil
+}
+
+// invite emails a link to choose a password to a user who has no way to
+// sign in yet. Users with a password or a verified email (from a provider
+// sign-in) aren't invited, nor are users with an open invitation. Returns
+// whether an invitation was sent.
+func (s *UserImportService) invite(ctx context.Context, user *model.User) (bool, error) {
+	if s.invitations == nil || s.mailer == nil {
+		return false, nil
+	}
+	if user.Hash != nil || user.EmailVerified {
+		return false, nil
+	}
+
+	now := s.clock.Now()
+	open, err := s.invitations.GetOpenByUser(ctx, user.ID, now)
+	if err != nil {
+		return false, err
+	}
+	if open != nil {
+		return false, nil
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return false, err
+	}
+	invitation := &model.UserInvitation{
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresOn: now.Add(model.UserInvitationTTL),
+	}
+	if err := s.invitations.Create(ctx, invitation); err != nil {
+		return false, err
+	}
+
+	link := s.acceptURL + "?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf("An account has been created for you on Saga.\n\n"+
+		"To choose a password and sign in, open this link within %d days:\n%s\n\n"+
+		"If you weren't expecting this, you can ignore this email.\n", int(model.UserInvitationTTL.Hours()/24), link)
+	if err := s.mailer.Send(ctx, user.Email, "You're invited to Saga", body); err != nil {
+		// Without the email the invitation can't be used, so let the next
+		// import send a new one
+		_ = s.invitations.Delete(ctx, invitation.ID)
+		return false, err
+	}
+	return true, nil
+}
+
+func failedImportRow(row model.UserImportRow, field, message string) model.UserImportRow {
+	row.Status = model.UserImportRowFailed
+	row.Errors = append(row.Errors, model.FieldError{Field: field, Message: message})
+	return row
+}
+
+// joinFailureReason describes why an imported user couldn't join a guild
+func joinFailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrGuildNotFound):
+		return "guild not found"
+	case errors.Is(err, ErrMaxMembersReached):
+		return "guild is full"
+	case errors.Is(err, ErrMaxGuildsReached):
+		return "user is in too many guilds"
+	}
+	return "could not join guild"
+}
+
+func isExpectedJoinFailure(err error) bool {
+	return errors.Is(err, ErrGuildNotFound) || errors.Is(err, ErrMaxMembersReached) || errors.Is(err, ErrMaxGuildsReached)
+}
+
+// parseUserImportCSV reads the rows of an uploaded file
+func parseUserImportCSV(data io.Reader) ([]model.UserImportRecord, error) {
+	r := csv.NewReader(data)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, &UserImportFileError{Reason: "file is empty"}
+	}
+	if err != nil {
+		return nil, userImportReadError(err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff") // Spreadsheet apps often save a byte order mark
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, dup := columns[name]; dup {
+			return nil, &UserImportFileError{Line: 1, Reason: fmt.Sprintf("column %q appears more than once", name)}
+		}
+		columns[name] = i
+	}
+	_, hasEmail := columns[model.UserImportColumnEmail]
+	_, hasName := columns[model.UserImportColumnName]
+	if !hasEmail || !hasName {
+		return nil, &UserImportFileError{Line: 1, Reason: `header must name the "email" and "name" columns`}
+	}
+
+	var records []model.UserImportRecord
+	for {
+		fields, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, userImportReadError(err)
+		}
+		if len(records) == model.MaxUserImportRows {
+			return nil, &UserImportFileError{Reason: fmt.Sprintf("file has more than %d rows", model.MaxUserImportRows)}
+		}
+
+		field := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(fields) {
+				return ""
+			}
+			return strings.TrimSpace(fields[i])
+		}
+		line, _ := r.FieldPos(0)
+		records = append(records, model.UserImportRecord{
+			Line:   line,
+			Email:  strings.ToLower(field(model.UserImportColumnEmail)),
+			Name:   field(model.UserImportColumnName),
+			Guilds: splitGuildSlugs(field(model.UserImportColumnGuilds)),
+		})
+	}
+
+	if len(records) == 0 {
+		return nil, &UserImportFileError{Reason: "file has no rows"}
+	}
+	return records, nil
+}
+
+// userImportReadError reports a malformed line as a file error; anything
+// else is a failure to read the upload
+func userImportReadError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &UserImportFileError{Line: parseErr.Line, Reason: parseErr.Err.Error()}
+	}
+	return err
+}
+
+// splitGuildSlugs splits the semicolon-separated guilds column, dropping
+// blanks and repeats
+func splitGuildSlugs(value string) []string {
+	var slugs []string
+	seen := make(map[string]bool)
+	for _, slug := range strings.Split(value, ";") {
+		slug = strings.ToLower(strings.TrimSpace(slug))
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		slugs = append(slugs, slug)
+	}
+	return slugs
+}
@@ -0,0 +1,429 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+var userImportTestNow = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// memoryUserInvitationRepo stores invitations in the given map
+func memoryUserInvitationRepo(invitations map[string]*model.UserInvitation) *mocks.UserInvitationRepository {
+	getByTokenHash := func(ctx context.Context, tokenHash string) (*model.UserInvitation, error) {
+		for _, inv := range invitations {
+			if inv.TokenHash == tokenHash && inv.AcceptedOn == nil {
+				return inv, nil
+			}
+		}
+		return nil, nil
+	}
+	return &mocks.UserInvitationRepository{
+		CreateFunc: func(ctx context.Context, invitation *model.UserInvitation) error {
+			invitation.ID = fmt.Sprintf("user_invitation:%d", len(invitations)+1)
+			invitations[invitation.ID] = invitation
+			return nil
+		},
+		GetOpenByUserFunc: func(ctx context.Context, userID string, now time.Time) (*model.UserInvitation, error) {
+			for _, inv := range invitations {
+				if inv.UserID == userID && inv.AcceptedOn == nil && !inv.IsExpired(now) {
+					return inv, nil
+				}
+			}
+			return nil, nil
+		},
+		GetByTokenHashFunc: getByTokenHash,
+		ClaimByTokenHashFunc: func(ctx context.Context, tokenHash string) (*model.UserInvitation, error) {
+			inv, _ := getByTokenHash(ctx, tokenHash)
+			if inv != nil {
+				acceptedOn := userImportTestNow
+				inv.AcceptedOn = &acceptedOn
+			}
+			return inv, nil
+		},
+		DeleteFunc: func(ctx context.Context, id string) error {
+			delete(invitations, id)
+			return nil
+		},
+	}
+}
+
+// memoryGuilds resolves slugs and records memberships
+type memoryGuilds struct {
+	slugs   map[string]string
+	members map[string]bool
+}
+
+func newMemoryGuilds(slugs map[string]string) *memoryGuilds {
+	return &memoryGuilds{slugs: slugs, members: map[string]bool{}}
+}
+
+func (m *memoryGuilds) ResolveSlug(ctx context.Context, slug string) (string, error) {
+	if id, ok := m.slugs[slug]; ok {
+		return id, nil
+	}
+	return "", ErrGuildNotFound
+}
+
+func (m *memoryGuilds) JoinGuildByInvite(ctx context.Context, userID, guildID string) error {
+	key := userID + "|" + guildID
+	if m.members[key] {
+		return ErrAlreadyGuildMember
+	}
+	m.members[key] = true
+	return nil
+}
+
+// memoryUserImportRepo queues one import at a time, storing the rows of the
+// last one completed in rows
+func memoryUserImportRepo(rows *[]model.UserImportRow) *mocks.UserImportRepository {
+	var queued *model.UserImport
+	return &mocks.UserImportRepository{
+		CreateFunc: func(ctx context.Context, imp *model.UserImport) error {
+			imp.ID = "user_import:1"
+			imp.Status = model.UserImportPending
+			queued = imp
+			return nil
+		},
+		ListByStatusFunc: func(ctx context.Context, status model.UserImportStatus, limit int) ([]*model.UserImport, error) {
+			if queued != nil && queued.Status == status {
+				return []*model.UserImport{queued}, nil
+			}
+			return nil, nil
+		},
+		ClaimFunc: func(ctx context.Context, id string) (bool, error) {
+			queued.Status = model.UserImportRunning
+			return true, nil
+		},
+		CompleteFunc: func(ctx context.Context, id string, completed []model.UserImportRow) error {
+			queued.Status = model.UserImportCompleted
+			*rows = completed
+			return nil
+		},
+	}
+}
+
+// runUserImport uploads a file and processes it
+func runUserImport(t *testing.T, svc *UserImportService, file string) {
+	t.Helper()
+	if _, err := svc.Request(context.Background(), "user:admin", strings.NewReader(file)); err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	processed, err := svc.ProcessPending(context.Background())
+	if err != nil {
+		t.Fatalf("ProcessPending failed: %v", err)
+	}
+	if processed != 1 {
+		t.Fatalf("expected 1 import processed, got %d", processed)
+	}
+}
+
+// ============================================================================
+// parseUserImportCSV Tests
+// ============================================================================
+
+func TestParseUserImportCSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		file string
+		want []model.UserImportRecord
+	}{
+		{
+			name: "normalizes headers, emails and guilds",
+			file: "\ufeffName, Email ,Guilds\n" +
+				"Ada Lovelace,ADA@example.com,hikers; Book-Club;hikers\n" +
+				"\"Hopper, Grace\",grace@example.com\n",
+			want: []model.UserImportRecord{
+				{Line: 2, Email: "ada@example.com", Name: "Ada Lovelace", Guilds: []string{"hikers", "book-club"}},
+				{Line: 3, Email: "grace@example.com", Name: "Hopper, Grace"},
+			},
+		},
+		{
+			name: "without a guilds column",
+			file: "email,name\nbob@example.com,Bob\n",
+			want: []model.UserImportRecord{{Line: 2, Email: "bob@example.com", Name: "Bob"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			records, err := parseUserImportCSV(strings.NewReader(tt.file))
+			if err != nil {
+				t.Fatalf("parseUserImportCSV failed: %v", err)
+			}
+			if !reflect.DeepEqual(records, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, records)
+			}
+		})
+	}
+}
+
+func TestParseUserImportCSV_FileErrors(t *testing.T) {
+	t.Parallel()
+
+	tooMany := "email,name\n" + strings.Repeat("a@example.com,A\n", model.MaxUserImportRows+1)
+	tests := []struct {
+		name     string
+		file     string
+		wantLine int
+	}{
+		{"empty", "", 0},
+		{"header only", "email,name\n", 0},
+		{"missing name column", "email,guilds\na@example.com,hikers\n", 1},
+		{"repeated column", "email,name,Email\na@example.com,A,b@example.com\n", 1},
+		{"malformed quoting", "email,name\na@example.com,\"A\n", 2},
+		{"too many rows", tooMany, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseUserImportCSV(strings.NewReader(tt.file))
+			var fileErr *UserImportFileError
+			if !errors.As(err, &fileErr) {
+				t.Fatalf("expected a UserImportFileError, got %v", err)
+			}
+			if fileErr.Line != tt.wantLine {
+				t.Errorf("expected line %d, got %d (%v)", tt.wantLine, fileErr.Line, err)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// ProcessPending Tests
+// ============================================================================
+
+func TestUserImport_ProcessPending(t *testing.T) {
+	t.Parallel()
+
+	users := newMockUserRepo()
+	hash := "bcrypt-hash"
+	_ = users.Create(context.Background(), &model.User{Email: "member@example.com", Hash: &hash})
+	mailer := &mockEmailSender{}
+	var rows []model.UserImportRow
+	svc := NewUserImportService(UserImportServiceConfig{
+		Repo:           memoryUserImportRepo(&rows),
+		UserRepo:       users,
+		Guilds:         newMemoryGuilds(map[string]string{"hikers": "guild:1", "book-club": "guild:2"}),
+		InvitationRepo: memoryUserInvitationRepo(map[string]*model.UserInvitation{}),
+		Mailer:         mailer,
+		PublicBaseURL:  "https://saga.test/",
+		Clock:          fakeclock.New(userImportTestNow),
+	})
+
+	runUserImport(t, svc, "email,name,guilds\n"+
+		"ada@example.com,Ada Lovelace,hikers;book-club\n"+
+		"member@example.com,Existing Member,hikers\n"+
+		"not-an-email,Nobody,\n"+
+		"ada@example.com,Ada Again,\n"+
+		"bob@example.com,Bob,chess\n"+
+		"carol@example.com,,\n")
+
+	tests := []struct {
+		name        string
+		wantStatus  model.UserImportRowStatus
+		wantGuilds  []string
+		wantInvited bool
+		wantError   string // The field or message of the row's only error
+	}{
+		{name: "new account", wantStatus: model.UserImportRowCreated, wantGuilds: []string{"guild:1", "guild:2"}, wantInvited: true},
+		{name: "existing account", wantStatus: model.UserImportRowExisting, wantGuilds: []string{"guild:1"}},
+		{name: "invalid email", wantStatus: model.UserImportRowInvalid},
+		{name: "duplicate email", wantStatus: model.UserImportRowInvalid, wantError: "email is already on line 2"},
+		{name: "unknown guild", wantStatus: model.UserImportRowInvalid, wantError: "guilds"},
+		{name: "missing name", wantStatus: model.UserImportRowInvalid},
+	}
+	if len(rows) != len(tests) {
+		t.Fatalf("expected %d rows, got %+v", len(tests), rows)
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := rows[i]
+			if row.Status != tt.wantStatus || row.Invited != tt.wantInvited {
+				t.Errorf("row = %+v, want %s and invited %v", row, tt.wantStatus, tt.wantInvited)
+			}
+			if !reflect.DeepEqual(row.Guilds, tt.wantGuilds) && len(row.Guilds)+len(tt.wantGuilds) > 0 {
+				t.Errorf("guilds = %v, want %v", row.Guilds, tt.wantGuilds)
+			}
+			if tt.wantError != "" && (len(row.Errors) != 1 || (row.Errors[0].Field != tt.wantError && row.Errors[0].Message != tt.wantError)) {
+				t.Errorf("errors = %+v, want one about %q", row.Errors, tt.wantError)
+			}
+		})
+	}
+
+	ada := users.emailIndex["ada@example.com"]
+	if ada == nil || derefString(ada.Firstname) != "Ada" || derefString(ada.Lastname) != "Lovelace" || ada.Hash != nil {
+		t.Fatalf("expected a passwordless account for Ada Lovelace, got %+v", ada)
+	}
+	if users.emailIndex["bob@example.com"] != nil || users.emailIndex["carol@example.com"] != nil {
+		t.Error("expected invalid rows not to create accounts")
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].to != "ada@example.com" {
+		t.Fatalf("expected one invitation to Ada, got %+v", mailer.sent)
+	}
+	if !strings.Contains(mailer.sent[0].body, "https://saga.test/accept-invite?token=") {
+		t.Errorf("expected an invitation link, got %q", mailer.sent[0].body)
+	}
+	want := model.UserImportSummary{Created: 1, Existing: 1, Invalid: 4, Invited: 1}
+	if got := model.SummarizeUserImportRows(rows); got != want {
+		t.Errorf("expected summary %+v, got %+v", want, got)
+	}
+}
+
+func TestUserImport_RerunIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	clk := fakeclock.New(userImportTestNow)
+	users := newMockUserRepo()
+	invitations := map[string]*model.UserInvitation{}
+	mailer := &mockEmailSender{}
+	var rows []model.UserImportRow
+	svc := NewUserImportService(UserImportServiceConfig{
+		Repo:           memoryUserImportRepo(&rows),
+		UserRepo:       users,
+		Guilds:         newMemoryGuilds(map[string]string{"hikers": "guild:1"}),
+		InvitationRepo: memoryUserInvitationRepo(invitations),
+		Mailer:         mailer,
+		PublicBaseURL:  "https://saga.test/",
+		Clock:          clk,
+	})
+
+	runs := []struct {
+		name        string
+		elapsed     time.Duration // Since the previous run
+		wantStatus  model.UserImportRowStatus
+		wantJoined  bool
+		wantInvited bool
+		wantSent    int // Invitations sent over all runs
+	}{
+		{name: "first run", wantStatus: model.UserImportRowCreated, wantJoined: true, wantInvited: true, wantSent: 1},
+		{name: "rerun changes nothing", wantStatus: model.UserImportRowExisting, wantSent: 1},
+		// Once the invitation lapses, importing again sends a new one
+		{name: "after the invitation expires", elapsed: model.UserInvitationTTL, wantStatus: model.UserImportRowExisting, wantInvited: true, wantSent: 2},
+	}
+	for _, run := range runs {
+		clk.Advance(run.elapsed)
+		runUserImport(t, svc, "email,name,guilds\nada@example.com,Ada Lovelace,hikers\n")
+
+		row := rows[0]
+		if row.Status != run.wantStatus || (len(row.Guilds) == 1) != run.wantJoined || row.Invited != run.wantInvited {
+			t.Errorf("%s: row = %+v, want %s, joined %v and invited %v", run.name, row, run.wantStatus, run.wantJoined, run.wantInvited)
+		}
+		if len(users.users) != 1 || len(invitations) != run.wantSent || len(mailer.sent) != run.wantSent {
+			t.Errorf("%s: got %d users, %d invitations and %d emails, want 1 and %d of each",
+				run.name, len(users.users), len(invitations), len(mailer.sent), run.wantSent)
+		}
+	}
+}
+
+func TestUserImport_RequeuesStaleImports(t *testing.T) {
+	t.Parallel()
+
+	clk := fakeclock.New(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	started := clk.Now().Add(-model.UserImportTimeout - time.Minute)
+	recent := clk.Now().Add(-time.Minute)
+	var requeued []string
+	repo := &mocks.UserImportRepository{
+		ListByStatusFunc: func(ctx context.Context, status model.UserImportStatus, limit int) ([]*model.UserImport, error) {
+			if status != model.UserImportRunning {
+				return nil, nil
+			}
+			return []*model.UserImport{
+				{ID: "user_import:stale", StartedOn: &started},
+				{ID: "user_import:recent", StartedOn: &recent},
+			}, nil
+		},
+		RequeueFunc: func(ctx context.Context, id string) error {
+			requeued = append(requeued, id)
+			return nil
+		},
+	}
+	svc := NewUserImportService(UserImportServiceConfig{Repo: repo, Clock: clk})
+
+	if _, err := svc.ProcessPending(context.Background()); err != nil {
+		t.Fatalf("ProcessPending failed: %v", err)
+	}
+	if !reflect.DeepEqual(requeued, []string{"user_import:stale"}) {
+		t.Errorf("expected only the stale import requeued, got %v", requeued)
+	}
+}
+
+// ============================================================================
+// AcceptInvitation Tests
+// ============================================================================
+
+func TestAuthService_AcceptInvitation(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration // Between the invitation and accepting it
+		used    bool          // The link was already used
+		wantErr error
+	}{
+		{name: "sets the password and signs in"},
+		{name: "links work once", used: true, wantErr: ErrInvitationNotFound},
+		{name: "expired", elapsed: model.UserInvitationTTL, wantErr: ErrInvitationExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			clk := fakeclock.New(userImportTestNow)
+			users := newMockUserRepo()
+			invitations := memoryUserInvitationRepo(map[string]*model.UserInvitation{})
+			mailer := &mockEmailSender{}
+			var rows []model.UserImportRow
+			imports := NewUserImportService(UserImportServiceConfig{
+				Repo:           memoryUserImportRepo(&rows),
+				UserRepo:       users,
+				Guilds:         newMemoryGuilds(nil),
+				InvitationRepo: invitations,
+				Mailer:         mailer,
+				PublicBaseURL:  "https://saga.test/",
+				Clock:          clk,
+			})
+			runUserImport(t, imports, "email,name\nada@example.com,Ada Lovelace\n")
+			token := emailedToken(t, mailer.sent[0].body)
+
+			authService, _, _, _, _ := setupAuthService(t)
+			authService.userRepo = users
+			authService.invitationRepo = invitations
+			authService.clock = clk
+			if tt.used {
+				if _, err := authService.AcceptInvitation(ctx, token, "correct horse battery"); err != nil {
+					t.Fatalf("first AcceptInvitation failed: %v", err)
+				}
+			}
+			clk.Advance(tt.elapsed)
+
+			result, err := authService.AcceptInvitation(ctx, token, "another password")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AcceptInvitation() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !result.User.EmailVerified || result.TokenPair == nil {
+				t.Errorf("expected a verified, signed in user, got %+v", result)
+			}
+			if _, err := authService.Login(ctx, LoginRequest{Email: "ada@example.com", Password: "another password"}); err != nil {
+				t.Errorf("expected to sign in with the new password, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/pkg/geocode"
+)
+
+// Geocoder turns an address into coordinates. pkg/geocode implements it
+// against Nominatim; it returns geocode.ErrNotFound for addresses that
+// match nothing.
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (*geocode.Result, error)
+}
+
+// VenueRepository defines the interface for saved venue storage
+type VenueRepository interface {
+	Create(ctx context.Context, venue *model.Venue) error
+	Get(ctx context.Context, venueID string) (*model.Venue, error)
+	ListByGuild(ctx context.Context, guildID string) ([]*model.Venue, error)
+	CountByGuild(ctx context.Context, guildID string) (int, error)
+	Update(ctx context.Context, venueID string, updates map[string]interface{}) (*model.Venue, error)
+	Delete(ctx context.Context, venueID string) error
+}
+
+// VenueGuildRepository provides the guild checks venues need
+type VenueGuildRepository interface {
+	IsMember(ctx context.Context, userID, guildID string) (bool, error)
+	IsGuildAdmin(ctx context.Context, userID, guildID string) (bool, error)
+}
+
+// VenueService manages guilds' venue directories. Any member can save a
+// venue for the guild's events; the member who saved it and guild admins
+// can change or remove it.
+type VenueService struct {
+	repo     VenueRepository
+	guilds   VenueGuildRepository
+	geocoder Geocoder
+}
+
+// VenueServiceConfig holds configuration for the venue service
+type VenueServiceConfig struct {
+	Repo     VenueRepository
+	Guilds   VenueGuildRepository
+	Geocoder Geocoder // Optional; fills in coordinates for addresses entered without them
+}
+
+// NewVenueService creates a new venue service
+func NewVenueService(cfg VenueServiceConfig) *VenueService {
+	return &VenueService{
+		repo:     cfg.Repo,
+		guilds:   cfg.Guilds,
+		geocoder: cfg.Geocoder,
+	}
+}
+
+// ListGuildVenues returns a guild's venues by name (members only)
+func (s *VenueService) ListGuildVenues(ctx context.Context, userID, guildID string) ([]*model.Venue, error) {
+	if err := s.requireMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByGuild(ctx, guildID)
+}
+
+// GetVenue returns one of a guild's venues (members only)
+func (s *VenueService) GetVenue(ctx context.Context, userID, guildID, venueID string) (*model.Venue, error) {
+	if err := s.requireMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	return s.guildVenue(ctx, guildID, venueID)
+}
+
+// CreateVenue saves a venue to a guild (members only), geocoding its address
+// when no coordinates are given
+func (s *VenueService) CreateVenue(ctx context.Context, userID, guildID string, req *model.CreateVenueRequest) (*model.Venue, error) {
+	if err := s.requireMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountByGuild(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= model.MaxGuildVenues {
+		return nil, ErrTooManyVenues
+	}
+
+	venue := &model.Venue{
+		GuildID:       guildID,
+		Name:          req.Name,
+		Address:       req.Address,
+		Neighborhood:  req.Neighborhood,
+		City:          req.City,
+		Lat:           req.Lat,
+		Lng:           req.Lng,
+		Capacity:      req.Capacity,
+		Accessibility: req.Accessibility,
+		CreatedBy:     userID,
+	}
+	if venue.Lat == nil {
+		if venue.Lat, venue.Lng, err = s.locate(ctx, venue.Address, venue.City); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Create(ctx, venue); err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// UpdateVenue changes a venue (its creator or a guild admin). A new address
+// without coordinates is geocoded again.
+func (s *VenueService) UpdateVenue(ctx context.Context, userID, guildID, venueID string, req *model.UpdateVenueRequest) (*model.Venue, error) {
+	venue, err := s.editableVenue(ctx, userID, guildID, venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Address != nil {
+		updates["address"] = *req.Address
+	}
+	if req.Neighborhood != nil {
+		updates["neighborhood"] = *req.Neighborhood
+	}
+	if req.City != nil {
+		updates["city"] = *req.City
+	}
+	if req.Capacity != nil {
+		updates["capacity"] = *req.Capacity
+	}
+	if req.Accessibility != nil {
+		updates["accessibility"] = req.Accessibility
+	}
+
+	switch {
+	case req.Lat != nil:
+		updates["lat"], updates["lng"] = *req.Lat, *req.Lng
+	case req.Address != nil || req.City != nil:
+		address, city := venue.Address, venue.City
+		if req.Address != nil {
+			address = *req.Address
+		}
+		if req.City != nil {
+			city = *req.City
+		}
+		lat, lng, err := s.locate(ctx, address, city)
+		if err != nil {
+			return nil, err
+		}
+		// Coordinates for the old address would be wrong for the new one
+		updates["lat"], updates["lng"] = nil, nil
+		if lat != nil {
+			updates["lat"], updates["lng"] = *lat, *lng
+		}
+	}
+	if len(updates) == 0 {
+		return venue, nil
+	}
+
+	updated, err := s.repo.Update(ctx, venueID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrVenueNotFound
+	}
+	return updated, nil
+}
+
+// DeleteVenue removes a venue (its creator or a guild admin). Events already
+// held there keep their location.
+func (s *VenueService) DeleteVenue(ctx context.Context, userID, guildID, venueID string) error {
+	if _, err := s.editableVenue(ctx, userID, guildID, venueID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, venueID)
+}
+
+// VenueForEvent returns the venue an event in guildID is being held at, for
+// the event service to copy into the event's location
+func (s *VenueService) VenueForEvent(ctx context.Context, guildID, venueID string) (*model.Venue, error) {
+	return s.guildVenue(ctx, guildID, venueID)
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+// locate geocodes an address. Without a geocoder, or when the provider is
+// unreachable, the venue is saved without coordinates rather than refused;
+// only an address that matches nothing is an error.
+func (s *VenueService) locate(ctx context.Context, address, city string) (*float64, *float64, error) {
+	if s.geocoder == nil {
+		return nil, nil, nil
+	}
+
+	query := address
+	if city != "" && !strings.Contains(strings.ToLower(address), strings.ToLower(city)) {
+		query += ", " + city
+	}
+	result, err := s.geocoder.Geocode(ctx, query)
+	if errors.Is(err, geocode.ErrNotFound) {
+		return nil, nil, ErrAddressNotFound
+	}
+	if err != nil {
+		slog.Warn("geocoding venue address failed", slog.String("error", err.Error()))
+		return nil, nil, nil
+	}
+	return &result.Lat, &result.Lng, nil
+}
+
+// guildVenue returns a venue if it belongs to guildID
+func (s *VenueService) guildVenue(ctx context.Context, guildID, venueID string) (*model.Venue, error) {
+	venue, err := s.repo.Get(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	if venue == nil || venue.GuildID != guildID {
+		return nil, ErrVenueNotFound
+	}
+	return venue, nil
+}
+
+// editableVenue returns a venue userID may change: one they saved, or any in
+// a guild they administer
+func (s *VenueService) editableVenue(ctx context.Context, userID, guildID, venueID string) (*model.Venue, error) {
+	if err := s.requireMember(ctx, userID, guildID); err != nil {
+		return nil, err
+	}
+	venue, err := s.guildVenue(ctx, guildID, venueID)
+	if err != nil {
+		return nil, err
+	}
+	if venue.CreatedBy == userID {
+		return venue, nil
+	}
+	isAdmin, err := s.guilds.IsGuildAdmin(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, ErrNotVenueCreator
+	}
+	return venue, nil
+}
+
+func (s *VenueService) requireMember(ctx context.Context, userID, guildID string) error {
+	isMember, err := s.guilds.IsMember(ctx, userID, guildID)
+	if err != nil {
+		return fmt.Errorf("checking guild membership: %w", err)
+	}
+	if !isMember {
+		return ErrNotGuildMember
+	}
+	return nil
+}
@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+	"github.com/forgo/saga/api/pkg/geocode"
+)
+
+// ============================================================================
+// Venue Directory Tests
+// ============================================================================
+
+// fakeGeocoder answers every address with the same result or error and
+// records what it was asked
+type fakeGeocoder struct {
+	result  *geocode.Result
+	err     error
+	queries []string
+}
+
+func (f *fakeGeocoder) Geocode(ctx context.Context, address string) (*geocode.Result, error) {
+	f.queries = append(f.queries, address)
+	return f.result, f.err
+}
+
+// hallVenue is guild:1's venue:hall, saved by user:creator
+func hallVenue() *model.Venue {
+	capacity := 40
+	return &model.Venue{ID: "venue:hall", GuildID: "guild:1", Name: "Hall", Address: "1 Main St", City: "Springfield", Capacity: &capacity, CreatedBy: "user:creator"}
+}
+
+// memoryVenueRepo stores venues in the given map; count is what
+// CountByGuild reports
+func memoryVenueRepo(venues map[string]*model.Venue, count int) *mocks.VenueRepository {
+	return &mocks.VenueRepository{
+		CreateFunc: func(ctx context.Context, venue *model.Venue) error {
+			venue.ID = "venue:new"
+			venues[venue.ID] = venue
+			return nil
+		},
+		GetFunc: func(ctx context.Context, venueID string) (*model.Venue, error) {
+			return venues[venueID], nil
+		},
+		CountByGuildFunc: func(ctx context.Context, guildID string) (int, error) {
+			return count, nil
+		},
+		UpdateFunc: func(ctx context.Context, venueID string, updates map[string]interface{}) (*model.Venue, error) {
+			venue := venues[venueID]
+			if venue == nil {
+				return nil, nil
+			}
+			if address, ok := updates["address"].(string); ok {
+				venue.Address = address
+			}
+			venue.Lat, venue.Lng = nil, nil
+			if lat, ok := updates["lat"].(float64); ok {
+				lng := updates["lng"].(float64)
+				venue.Lat, venue.Lng = &lat, &lng
+			}
+			return venue, nil
+		},
+		DeleteFunc: func(ctx context.Context, venueID string) error {
+			delete(venues, venueID)
+			return nil
+		},
+	}
+}
+
+// venueGuildRepo makes user:admin a guild admin and everyone but
+// user:outsider a member
+func venueGuildRepo() *mocks.VenueGuildRepository {
+	return &mocks.VenueGuildRepository{
+		IsMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return userID != "user:outsider", nil
+		},
+		IsGuildAdminFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			return userID == "user:admin", nil
+		},
+	}
+}
+
+func newVenueRequest() *model.CreateVenueRequest {
+	return &model.CreateVenueRequest{Name: "Library", Address: "5 Elm St", City: "Springfield"}
+}
+
+func TestCreateVenue(t *testing.T) {
+	t.Parallel()
+
+	enteredLat, enteredLng := 39.8, -89.6
+	tests := []struct {
+		name        string
+		userID      string
+		count       int
+		geocoder    *fakeGeocoder // nil for no provider
+		lat, lng    *float64
+		wantErr     error
+		wantLat     *float64
+		wantQueries []string
+	}{
+		{
+			name:        "geocodes the address",
+			userID:      "user:member",
+			geocoder:    &fakeGeocoder{result: &geocode.Result{Lat: 39.8, Lng: -89.6}},
+			wantLat:     &enteredLat,
+			wantQueries: []string{"5 Elm St, Springfield"},
+		},
+		{
+			name:     "keeps entered coordinates",
+			userID:   "user:member",
+			geocoder: &fakeGeocoder{result: &geocode.Result{Lat: 1, Lng: 1}},
+			lat:      &enteredLat,
+			lng:      &enteredLng,
+			wantLat:  &enteredLat,
+		},
+		{
+			name:        "address not found",
+			userID:      "user:member",
+			geocoder:    &fakeGeocoder{err: geocode.ErrNotFound},
+			wantErr:     ErrAddressNotFound,
+			wantQueries: []string{"5 Elm St, Springfield"},
+		},
+		{
+			name:        "provider unavailable",
+			userID:      "user:member",
+			geocoder:    &fakeGeocoder{err: errors.New("connection refused")},
+			wantQueries: []string{"5 Elm St, Springfield"},
+		},
+		{name: "no provider", userID: "user:member"},
+		{name: "outsider", userID: "user:outsider", wantErr: ErrNotGuildMember},
+		{name: "full directory", userID: "user:member", count: model.MaxGuildVenues, wantErr: ErrTooManyVenues},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := VenueServiceConfig{Repo: memoryVenueRepo(map[string]*model.Venue{}, tt.count), Guilds: venueGuildRepo()}
+			if tt.geocoder != nil {
+				cfg.Geocoder = tt.geocoder
+			}
+			req := newVenueRequest()
+			req.Lat, req.Lng = tt.lat, tt.lng
+
+			venue, err := NewVenueService(cfg).CreateVenue(context.Background(), tt.userID, "guild:1", req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateVenue() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.geocoder != nil && !slices.Equal(tt.geocoder.queries, tt.wantQueries) {
+				t.Errorf("geocoded %v, want %v", tt.geocoder.queries, tt.wantQueries)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if tt.wantLat == nil {
+				if venue.Lat != nil {
+					t.Errorf("lat = %v, want none", *venue.Lat)
+				}
+				return
+			}
+			if venue.Lat == nil || *venue.Lat != *tt.wantLat || *venue.Lng != enteredLng {
+				t.Errorf("coordinates = %v, %v, want %v, %v", venue.Lat, venue.Lng, *tt.wantLat, enteredLng)
+			}
+		})
+	}
+}
+
+func TestUpdateVenue_Permissions(t *testing.T) {
+	t.Parallel()
+
+	name := "Great Hall"
+	tests := []struct {
+		name    string
+		userID  string
+		guildID string
+		wantErr error
+	}{
+		{"creator", "user:creator", "guild:1", nil},
+		{"guild admin", "user:admin", "guild:1", nil},
+		{"other member", "user:member", "guild:1", ErrNotVenueCreator},
+		{"outsider", "user:outsider", "guild:1", ErrNotGuildMember},
+		{"other guild", "user:admin", "guild:2", ErrVenueNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			svc := NewVenueService(VenueServiceConfig{
+				Repo:   memoryVenueRepo(map[string]*model.Venue{"venue:hall": hallVenue()}, 1),
+				Guilds: venueGuildRepo(),
+			})
+			_, err := svc.UpdateVenue(context.Background(), tt.userID, tt.guildID, "venue:hall", &model.UpdateVenueRequest{Name: &name})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateVenue() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateVenue_NewAddressIsGeocoded(t *testing.T) {
+	t.Parallel()
+
+	geocoder := &fakeGeocoder{result: &geocode.Result{Lat: 39.7, Lng: -89.5}}
+	svc := NewVenueService(VenueServiceConfig{
+		Repo:     memoryVenueRepo(map[string]*model.Venue{"venue:hall": hallVenue()}, 1),
+		Guilds:   venueGuildRepo(),
+		Geocoder: geocoder,
+	})
+	address := "9 Oak Ave"
+
+	venue, err := svc.UpdateVenue(context.Background(), "user:creator", "guild:1", "venue:hall", &model.UpdateVenueRequest{Address: &address})
+	if err != nil {
+		t.Fatalf("UpdateVenue() error = %v", err)
+	}
+	if len(geocoder.queries) != 1 || geocoder.queries[0] != "9 Oak Ave, Springfield" {
+		t.Errorf("geocoded %v, want the new address in the venue's city", geocoder.queries)
+	}
+	if venue.Lat == nil || *venue.Lat != 39.7 {
+		t.Errorf("lat = %v, want the new address's", venue.Lat)
+	}
+}
+
+func TestDeleteVenue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		userID  string
+		wantErr error
+	}{
+		{"guild admin", "user:admin", nil},
+		{"creator", "user:creator", nil},
+		{"other member", "user:member", ErrNotVenueCreator},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			venues := map[string]*model.Venue{"venue:hall": hallVenue()}
+			svc := NewVenueService(VenueServiceConfig{Repo: memoryVenueRepo(venues, 1), Guilds: venueGuildRepo()})
+
+			if err := svc.DeleteVenue(context.Background(), tt.userID, "guild:1", "venue:hall"); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteVenue() error = %v, want %v", err, tt.wantErr)
+			}
+			if removed := venues["venue:hall"] == nil; removed != (tt.wantErr == nil) {
+				t.Errorf("removed = %v, want %v", removed, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Event Venue Tests
+// ============================================================================
+
+func TestCreateEvent_AtVenue(t *testing.T) {
+	t.Parallel()
+
+	lat, lng := 39.8, -89.6
+	tooMany := 41
+	tests := []struct {
+		name         string
+		guildID      string
+		maxAttendees *int
+		wantErr      error
+	}{
+		{name: "takes the venue's location and capacity", guildID: "guild:1"},
+		{name: "over capacity", guildID: "guild:1", maxAttendees: &tooMany, wantErr: ErrVenueOverCapacity},
+		{name: "another guild's venue", guildID: "guild:2", wantErr: ErrVenueNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hall := hallVenue()
+			hall.Lat, hall.Lng = &lat, &lng
+			hall.Accessibility = []string{model.AccessibilityStepFree}
+			venues := NewVenueService(VenueServiceConfig{
+				Repo:   memoryVenueRepo(map[string]*model.Venue{"venue:hall": hall}, 1),
+				Guilds: venueGuildRepo(),
+			})
+			var created *model.Event
+			repo := &mocks.EventRepository{
+				CreateFunc: func(ctx context.Context, event *model.Event) error {
+					event.ID = "event:1"
+					created = event
+					return nil
+				},
+			}
+			svc := NewEventService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, venues, nil, nil)
+
+			venueID := "venue:hall"
+			_, err := svc.CreateEvent(context.Background(), "user:member", &model.CreateEventRequest{
+				GuildID:      &tt.guildID,
+				Title:        "Game night",
+				VenueID:      &venueID,
+				StartTime:    time.Date(2026, 11, 1, 18, 0, 0, 0, time.UTC),
+				MaxAttendees: tt.maxAttendees,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateEvent() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if created != nil {
+					t.Errorf("created = %+v, want no event", created)
+				}
+				return
+			}
+			loc := created.Location
+			if loc == nil || loc.Name != "Hall" || loc.Lat != lat || len(loc.Accessibility) != 1 {
+				t.Errorf("location = %+v, want the venue's", loc)
+			}
+			if created.VenueID == nil || *created.VenueID != venueID {
+				t.Errorf("venue_id = %v, want %s", created.VenueID, venueID)
+			}
+			if created.MaxAttendees == nil || *created.MaxAttendees != 40 {
+				t.Errorf("max_attendees = %v, want the venue's capacity", created.MaxAttendees)
+			}
+		})
+	}
+}
+
+func TestUpdateEvent_VenueLink(t *testing.T) {
+	t.Parallel()
+
+	venueID := "venue:hall"
+	tests := []struct {
+		name         string
+		req          model.UpdateEventRequest
+		wantVenueID  interface{}
+		wantLocation string
+	}{
+		{name: "linking a venue", req: model.UpdateEventRequest{VenueID: &venueID}, wantVenueID: venueID, wantLocation: "Hall"},
+		{name: "hand-entered location", req: model.UpdateEventRequest{Location: &model.EventLocation{Name: "Park", City: "Springfield"}}, wantVenueID: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			guildID := "guild:1"
+			var updates map[string]interface{}
+			repo := &mocks.EventRepository{
+				IsHostFunc: func(ctx context.Context, eventID, userID string) (bool, error) {
+					return true, nil
+				},
+				GetFunc: func(ctx context.Context, eventID string) (*model.Event, error) {
+					return &model.Event{ID: eventID, GuildID: &guildID}, nil
+				},
+				UpdateFunc: func(ctx context.Context, eventID string, u map[string]interface{}, version *int) (*model.Event, error) {
+					updates = u
+					return &model.Event{ID: eventID}, nil
+				},
+			}
+			venues := NewVenueService(VenueServiceConfig{
+				Repo:   memoryVenueRepo(map[string]*model.Venue{"venue:hall": hallVenue()}, 1),
+				Guilds: venueGuildRepo(),
+			})
+			svc := NewEventService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, venues, nil, nil)
+
+			if _, err := svc.UpdateEvent(context.Background(), "user:host", "event:1", &tt.req); err != nil {
+				t.Fatalf("UpdateEvent() error = %v", err)
+			}
+			if v, ok := updates["venue_id"]; !ok || v != tt.wantVenueID {
+				t.Errorf("venue_id = %v, want %v", v, tt.wantVenueID)
+			}
+			if tt.wantLocation == "" {
+				return
+			}
+			if loc, ok := updates["location"].(map[string]interface{}); !ok || loc["name"] != tt.wantLocation {
+				t.Errorf("location = %v, want the venue's", updates["location"])
+			}
+		})
+	}
+}
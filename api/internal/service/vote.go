@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
 
+	"github.com/forgo/saga/api/internal/clock"
 	"github.com/forgo/saga/api/internal/model"
 )
 
@@ -13,13 +15,15 @@ import (
 type VoteRepository interface {
 	Create(ctx context.Context, vote *model.Vote) error
 	GetByID(ctx context.Context, id string) (*model.Vote, error)
-	GetByGuild(ctx context.Context, guildID string, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error)
-	GetGlobalVotes(ctx context.Context, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error)
-	GetVotesToOpen(ctx context.Context) ([]*model.Vote, error)
-	GetVotesToClose(ctx context.Context) ([]*model.Vote, error)
+	GetByGuild(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error)
+	GetGlobalVotes(ctx context.Context, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error)
+	GetVotesToOpen(ctx context.Context, now time.Time) ([]*model.Vote, error)
+	GetVotesToClose(ctx context.Context, now time.Time) ([]*model.Vote, error)
 	Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Vote, error)
 	UpdateStatus(ctx context.Context, id string, status model.VoteStatus) error
+	SchedulePublication(ctx context.Context, id string, publishAt time.Time) (*model.Vote, error)
 	Delete(ctx context.Context, id string) error
+	MoveToTrash(ctx context.Context, id, userID string) (bool, error)
 	// Options
 	CreateOption(ctx context.Context, option *model.VoteOption) error
 	GetOptionByID(ctx context.Context, id string) (*model.VoteOption, error)
@@ -42,25 +46,31 @@ type VoteUserRepository interface {
 
 // VoteService handles vote business logic
 type VoteService struct {
-	repo      VoteRepository
-	userRepo  VoteUserRepository
-	guildRepo GuildRepository // Uses GuildRepository which has IsMember
+	repo        VoteRepository
+	userRepo    VoteUserRepository
+	guildRepo   GuildRepository // Uses GuildRepository which has IsMember
+	permissions GuildPermissionChecker
+	clock       clock.Clock
 }
 
 // VoteServiceConfig holds configuration for the vote service
 type VoteServiceConfig struct {
-	VoteRepo   VoteRepository
-	UserRepo   VoteUserRepository
-	GuildRepo  GuildRepository
-	MemberRepo interface{} // Deprecated, kept for backwards compatibility
+	VoteRepo    VoteRepository
+	UserRepo    VoteUserRepository
+	GuildRepo   GuildRepository
+	MemberRepo  interface{}            // Deprecated, kept for backwards compatibility
+	Permissions GuildPermissionChecker // Decides who may start guild votes; default guild admins
+	Clock       clock.Clock            // Decides when votes open and close; default the system clock
 }
 
 // NewVoteService creates a new vote service
 func NewVoteService(cfg VoteServiceConfig) *VoteService {
 	return &VoteService{
-		repo:      cfg.VoteRepo,
-		userRepo:  cfg.UserRepo,
-		guildRepo: cfg.GuildRepo,
+		repo:        cfg.VoteRepo,
+		userRepo:    cfg.UserRepo,
+		guildRepo:   cfg.GuildRepo,
+		permissions: cfg.Permissions,
+		clock:       clock.OrReal(cfg.Clock),
 	}
 }
 
@@ -71,7 +81,8 @@ func (s *VoteService) Create(ctx context.Context, userID string, req *model.Crea
 		return nil, model.NewValidationError(errors)
 	}
 
-	// Parse times
+	// Parse times. Validate has checked the format and that closes_at is
+	// after opens_at.
 	opensAt, err := time.Parse(time.RFC3339, req.OpensAt)
 	if err != nil {
 		return nil, model.NewBadRequestError("invalid opens_at format")
@@ -81,14 +92,31 @@ func (s *VoteService) Create(ctx context.Context, userID string, req *model.Crea
 		return nil, model.NewBadRequestError("invalid closes_at format")
 	}
 
-	// Validate dates
-	if closesAt.Before(opensAt) {
-		return nil, model.NewBadRequestError("closes_at must be after opens_at")
+	// Scheduled votes stay hidden until the publisher job publishes them
+	var publishAt *time.Time
+	if req.PublishAt != nil {
+		t, err := time.Parse(time.RFC3339, *req.PublishAt)
+		if err != nil {
+			return nil, model.NewBadRequestError("invalid publish_at format")
+		}
+		if err := checkPublishAt(s.clock.Now(), t, opensAt); err != nil {
+			return nil, publishAtValidationError()
+		}
+		publishAt = &t
 	}
 
-	// Check permissions for guild votes - must be guild admin
+	// Check permissions for guild votes - must be allowed to start votes,
+	// or be guild admin without a permission checker
 	if req.ScopeType == string(model.VoteScopeGuild) && req.ScopeID != nil {
-		if s.guildRepo != nil {
+		if s.permissions != nil {
+			err := s.permissions.RequirePermission(ctx, userID, *req.ScopeID, model.PermissionStartVotes)
+			if errors.Is(err, ErrNotGuildMember) || errors.Is(err, ErrMissingPermission) {
+				return nil, model.NewForbiddenError("guild role doesn't allow starting votes")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to check permissions: %w", err)
+			}
+		} else if s.guildRepo != nil {
 			isAdmin, err := s.guildRepo.IsGuildAdmin(ctx, userID, *req.ScopeID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to check admin status: %w", err)
@@ -119,6 +147,11 @@ func (s *VoteService) Create(ctx context.Context, userID string, req *model.Crea
 		ResultsVisibility:    resultsVisibility,
 		MaxOptionsSelectable: req.MaxOptionsSelectable,
 		AllowAbstain:         req.AllowAbstain,
+		RemindersDisabled:    req.RemindersDisabled,
+	}
+	if publishAt != nil {
+		vote.Status = model.VoteStatusScheduled
+		vote.PublishAt = publishAt
 	}
 
 	if err := s.repo.Create(ctx, vote); err != nil {
@@ -137,6 +170,10 @@ func (s *VoteService) GetByID(ctx context.Context, id string, userID string) (*m
 	if vote == nil {
 		return nil, model.NewNotFoundError("vote not found")
 	}
+	// Scheduled votes are only visible to their creator
+	if vote.Status == model.VoteStatusScheduled && vote.CreatedBy != userID {
+		return nil, model.NewNotFoundError("vote not found")
+	}
 
 	optionPtrs, err := s.repo.GetOptionsByVote(ctx, id)
 	if err != nil {
@@ -168,34 +205,24 @@ func (s *VoteService) GetByID(ctx context.Context, id string, userID string) (*m
 	return details, nil
 }
 
-// GetGuildVotes retrieves votes for a guild
-func (s *VoteService) GetGuildVotes(ctx context.Context, guildID string, status *string, limit, offset int) ([]*model.Vote, error) {
-	if limit <= 0 || limit > 100 {
-		limit = 50
-	}
-
-	var statusFilter *model.VoteStatus
-	if status != nil {
-		st := model.VoteStatus(*status)
-		statusFilter = &st
+// GetGuildVotes retrieves a page of a guild's votes, sorted and filtered
+// as list asks within model.VoteListFields
+func (s *VoteService) GetGuildVotes(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error) {
+	if page.Limit <= 0 || page.Limit > model.MaxPageSize {
+		page.Limit = model.DefaultPageSize
 	}
 
-	return s.repo.GetByGuild(ctx, guildID, statusFilter, limit, offset)
+	return s.repo.GetByGuild(ctx, guildID, list, page)
 }
 
-// GetGlobalVotes retrieves global votes
-func (s *VoteService) GetGlobalVotes(ctx context.Context, status *string, limit, offset int) ([]*model.Vote, error) {
-	if limit <= 0 || limit > 100 {
-		limit = 50
+// GetGlobalVotes retrieves a page of global votes, sorted and filtered as
+// list asks within model.VoteListFields
+func (s *VoteService) GetGlobalVotes(ctx context.Context, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error) {
+	if page.Limit <= 0 || page.Limit > model.MaxPageSize {
+		page.Limit = model.DefaultPageSize
 	}
 
-	var statusFilter *model.VoteStatus
-	if status != nil {
-		st := model.VoteStatus(*status)
-		statusFilter = &st
-	}
-
-	return s.repo.GetGlobalVotes(ctx, statusFilter, limit, offset)
+	return s.repo.GetGlobalVotes(ctx, list, page)
 }
 
 // Update updates a vote (only when draft)
@@ -212,14 +239,8 @@ func (s *VoteService) Update(ctx context.Context, id string, userID string, req
 		return nil, model.NewNotFoundError("vote not found")
 	}
 
-	// Only draft votes can be updated
-	if vote.Status != model.VoteStatusDraft {
-		return nil, model.NewBadRequestError("can only update draft votes")
-	}
-
-	// Check permission
-	if vote.CreatedBy != userID {
-		return nil, model.NewForbiddenError("not your vote")
+	if err := voteEditError(vote, userID, req.RemindersOnly()); err != nil {
+		return nil, err
 	}
 
 	updates := make(map[string]interface{})
@@ -252,6 +273,9 @@ func (s *VoteService) Update(ctx context.Context, id string, userID string, req
 	if req.AllowAbstain != nil {
 		updates["allow_abstain"] = *req.AllowAbstain
 	}
+	if req.RemindersDisabled != nil {
+		updates["reminders_disabled"] = *req.RemindersDisabled
+	}
 
 	return s.repo.Update(ctx, id, updates)
 }
@@ -308,15 +332,74 @@ func (s *VoteService) Cancel(ctx context.Context, id string, userID string) erro
 		return model.NewNotFoundError("vote not found")
 	}
 
-	if vote.Status == model.VoteStatusClosed || vote.Status == model.VoteStatusCancelled {
-		return model.NewBadRequestError("vote already ended")
+	if err := voteCancelError(vote, userID); err != nil {
+		return err
+	}
+
+	return s.repo.UpdateStatus(ctx, id, model.VoteStatusCancelled)
+}
+
+// Delete moves a guild vote to the guild's trash (creator only). Global votes
+// are cancelled rather than deleted to maintain their audit trail.
+func (s *VoteService) Delete(ctx context.Context, id string, userID string) error {
+	vote, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get vote: %w", err)
+	}
+	if vote == nil {
+		return model.NewNotFoundError("vote not found")
+	}
+
+	if vote.ScopeType != model.VoteScopeGuild || vote.ScopeID == nil {
+		return s.Cancel(ctx, id, userID)
 	}
 
 	if vote.CreatedBy != userID {
 		return model.NewForbiddenError("not your vote")
 	}
 
-	return s.repo.UpdateStatus(ctx, id, model.VoteStatusCancelled)
+	moved, err := s.repo.MoveToTrash(ctx, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete vote: %w", err)
+	}
+	if !moved {
+		return model.NewNotFoundError("vote not found")
+	}
+	return nil
+}
+
+// SchedulePublication schedules or reschedules when a draft vote is
+// published (creator only). Cancelling before publication is Cancel.
+func (s *VoteService) SchedulePublication(ctx context.Context, id string, userID string, publishAt time.Time) (*model.Vote, error) {
+	vote, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote: %w", err)
+	}
+	if vote == nil {
+		return nil, model.NewNotFoundError("vote not found")
+	}
+
+	if vote.CreatedBy != userID {
+		return nil, model.NewForbiddenError("not your vote")
+	}
+
+	if !vote.Status.IsEditable() {
+		return nil, model.NewBadRequestError("can only schedule draft votes")
+	}
+
+	if err := checkPublishAt(s.clock.Now(), publishAt, vote.OpensAt); err != nil {
+		return nil, publishAtValidationError()
+	}
+
+	updated, err := s.repo.SchedulePublication(ctx, id, publishAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule vote: %w", err)
+	}
+	if updated == nil {
+		// Opened or cancelled since it was loaded
+		return nil, model.NewBadRequestError("can only schedule draft votes")
+	}
+	return updated, nil
 }
 
 // Option operations
@@ -335,7 +418,7 @@ func (s *VoteService) AddOption(ctx context.Context, voteID string, userID strin
 		return nil, model.NewNotFoundError("vote not found")
 	}
 
-	if vote.Status != model.VoteStatusDraft {
+	if !vote.Status.IsEditable() {
 		return nil, model.NewBadRequestError("can only add options to draft votes")
 	}
 
@@ -375,7 +458,7 @@ func (s *VoteService) UpdateOption(ctx context.Context, optionID string, userID
 
 	// Check vote status
 	vote, _ := s.repo.GetByID(ctx, option.VoteID)
-	if vote != nil && vote.Status != model.VoteStatusDraft {
+	if vote != nil && !vote.Status.IsEditable() {
 		return nil, model.NewBadRequestError("can only update options for draft votes")
 	}
 
@@ -394,7 +477,7 @@ func (s *VoteService) DeleteOption(ctx context.Context, optionID string, userID
 
 	// Check vote status
 	vote, _ := s.repo.GetByID(ctx, option.VoteID)
-	if vote != nil && vote.Status != model.VoteStatusDraft {
+	if vote != nil && !vote.Status.IsEditable() {
 		return model.NewBadRequestError("can only delete options for draft votes")
 	}
 
@@ -517,8 +600,10 @@ func (s *VoteService) GetResults(ctx context.Context, voteID string, userID stri
 
 // ProcessScheduledTransitions processes votes that should open/close based on time
 func (s *VoteService) ProcessScheduledTransitions(ctx context.Context) error {
+	now := s.clock.Now()
+
 	// Open votes that should be open
-	toOpen, err := s.repo.GetVotesToOpen(ctx)
+	toOpen, err := s.repo.GetVotesToOpen(ctx, now)
 	if err != nil {
 		return fmt.Errorf("failed to get votes to open: %w", err)
 	}
@@ -527,7 +612,7 @@ func (s *VoteService) ProcessScheduledTransitions(ctx context.Context) error {
 	}
 
 	// Close votes that should be closed
-	toClose, err := s.repo.GetVotesToClose(ctx)
+	toClose, err := s.repo.GetVotesToClose(ctx, now)
 	if err != nil {
 		return fmt.Errorf("failed to get votes to close: %w", err)
 	}
@@ -538,8 +623,43 @@ func (s *VoteService) ProcessScheduledTransitions(ctx context.Context) error {
 	return nil
 }
 
+// Affordances returns what userID may do to a vote, from its details: the
+// creator may edit a draft and cancel it until it ends, as Update and Cancel
+// require, and eligible voters may vote while it's open
+func (s *VoteService) Affordances(details *model.VoteWithDetails, userID string) model.Affordances {
+	return model.Affordances{
+		model.ActionEdit:   voteEditError(&details.Vote, userID, false) == nil,
+		model.ActionCancel: voteCancelError(&details.Vote, userID) == nil,
+		model.ActionVote:   details.CanVote,
+	}
+}
+
 // Helper methods
 
+// voteEditError is why userID can't update a vote, nil if they can. Only
+// draft votes can be updated, except for the reminder opt-out which the
+// creator may still toggle while the vote is open.
+func voteEditError(vote *model.Vote, userID string, remindersOnly bool) error {
+	if !vote.Status.IsEditable() && !(vote.Status == model.VoteStatusOpen && remindersOnly) {
+		return model.NewBadRequestError("can only update draft votes")
+	}
+	if vote.CreatedBy != userID {
+		return model.NewForbiddenError("not your vote")
+	}
+	return nil
+}
+
+// voteCancelError is why userID can't cancel a vote, nil if they can
+func voteCancelError(vote *model.Vote, userID string) error {
+	if vote.Status == model.VoteStatusClosed || vote.Status == model.VoteStatusCancelled {
+		return model.NewBadRequestError("vote already ended")
+	}
+	if vote.CreatedBy != userID {
+		return model.NewForbiddenError("not your vote")
+	}
+	return nil
+}
+
 func (s *VoteService) canVote(ctx context.Context, vote *model.Vote, userID string) bool {
 	if vote.Status != model.VoteStatusOpen {
 		return false
@@ -559,6 +679,10 @@ func (s *VoteService) canVote(ctx context.Context, vote *model.Vote, userID stri
 	return false
 }
 
+func publishAtValidationError() error {
+	return model.NewValidationError([]model.FieldError{{Field: "publish_at", Message: ErrPublishAtInvalid.Error()}})
+}
+
 func (s *VoteService) canViewResults(vote *model.Vote, userID string) bool {
 	switch vote.ResultsVisibility {
 	case model.ResultsVisibilityLive:
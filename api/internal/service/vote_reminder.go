@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// DefaultVoteReminderWindow is how long before a vote closes that non-voters are reminded
+const DefaultVoteReminderWindow = 24 * time.Hour
+
+// VoteReminderRepository defines the interface for vote reminder storage
+type VoteReminderRepository interface {
+	GetVotesClosingBetween(ctx context.Context, from, to time.Time) ([]*model.Vote, error)
+	RecordReminderSent(ctx context.Context, voteID, userID string) error
+}
+
+// NudgePreferenceRepository provides users' per-type nudge preferences
+type NudgePreferenceRepository interface {
+	GetPreference(ctx context.Context, userID string, nudgeType model.NudgeType) (*model.NudgePreference, error)
+}
+
+// VoteReminderService reminds eligible members who haven't voted yet that a
+// vote is about to close. Only guild votes are reminded; global votes are open
+// to every user and are left to the regular vote listings.
+type VoteReminderService struct {
+	votes          *VoteService
+	reminderRepo   VoteReminderRepository
+	preferenceRepo NudgePreferenceRepository
+	eventHub       *EventHub
+	pushService    *PushService
+	outbox         NotificationQueue
+	config         model.NudgeConfig
+	window         time.Duration
+	clock          clock.Clock
+}
+
+// VoteReminderServiceConfig holds configuration for the vote reminder service
+type VoteReminderServiceConfig struct {
+	VoteService    *VoteService // Provides eligibility rules and ballot lookups
+	ReminderRepo   VoteReminderRepository
+	PreferenceRepo NudgePreferenceRepository
+	EventHub       *EventHub
+	PushService    *PushService
+	Outbox         NotificationQueue // Optional; nil sends reminders inline
+	Window         time.Duration     // How long before close to remind (default 24h)
+	Clock          clock.Clock       // Default: the system clock
+}
+
+// NewVoteReminderService creates a new vote reminder service
+func NewVoteReminderService(cfg VoteReminderServiceConfig) *VoteReminderService {
+	window := cfg.Window
+	if window <= 0 {
+		window = DefaultVoteReminderWindow
+	}
+	return &VoteReminderService{
+		votes:          cfg.VoteService,
+		reminderRepo:   cfg.ReminderRepo,
+		preferenceRepo: cfg.PreferenceRepo,
+		eventHub:       cfg.EventHub,
+		pushService:    cfg.PushService,
+		outbox:         cfg.Outbox,
+		config:         model.DefaultNudgeConfigs[model.NudgeTypeVoteClosing],
+		window:         window,
+		clock:          clock.OrReal(cfg.Clock),
+	}
+}
+
+// ProcessClosingReminders reminds non-voters of votes closing within the window.
+// This should be called periodically by a background job.
+//
+// Each member is reminded at most once per vote. Votes whose creator turned
+// reminders off are skipped, as are members who disabled vote_closing nudges.
+func (s *VoteReminderService) ProcessClosingReminders(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	now := s.clock.Now()
+	votes, err := s.reminderRepo.GetVotesClosingBetween(ctx, now, now.Add(s.window))
+	if err != nil {
+		return fmt.Errorf("failed to get closing votes: %w", err)
+	}
+
+	prefs := make(map[string]*model.NudgePreference)
+	for _, vote := range votes {
+		if vote.RemindersDisabled {
+			continue
+		}
+
+		nonVoters, err := s.nonVoters(ctx, vote)
+		if err != nil {
+			log.Printf("[VoteReminderService] Failed to list non-voters for vote %s: %v", vote.ID, err)
+			continue
+		}
+
+		for _, userID := range nonVoters {
+			pref, ok := prefs[userID]
+			if !ok {
+				pref, err = s.preferenceRepo.GetPreference(ctx, userID, model.NudgeTypeVoteClosing)
+				if err != nil {
+					log.Printf("[VoteReminderService] Failed to load preference for user %s: %v", userID, err)
+					continue
+				}
+				prefs[userID] = pref
+			}
+			if pref != nil && !pref.Enabled {
+				continue
+			}
+
+			// Record first so that concurrent runs cannot double-send
+			if err := s.reminderRepo.RecordReminderSent(ctx, vote.ID, userID); err != nil {
+				if !errors.Is(err, database.ErrDuplicate) {
+					log.Printf("[VoteReminderService] Failed to record reminder for user %s vote %s: %v", userID, vote.ID, err)
+				}
+				continue
+			}
+
+			channel := s.config.Channel
+			if pref != nil && pref.Channel != nil {
+				channel = *pref.Channel
+			}
+			s.sendReminder(ctx, s.buildNudge(vote, userID, now, channel))
+		}
+	}
+
+	return nil
+}
+
+// nonVoters returns the members eligible to vote who haven't cast a ballot
+func (s *VoteReminderService) nonVoters(ctx context.Context, vote *model.Vote) ([]string, error) {
+	if vote.ScopeType != model.VoteScopeGuild || vote.ScopeID == nil || s.votes.guildRepo == nil {
+		return nil, nil
+	}
+
+	members, err := s.votes.guildRepo.GetMembers(ctx, *vote.ScopeID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(members))
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if member.UserID == "" || seen[member.UserID] {
+			continue
+		}
+		seen[member.UserID] = true
+
+		if !s.votes.canVote(ctx, vote, member.UserID) {
+			continue
+		}
+		voted, err := s.votes.repo.HasVoted(ctx, vote.ID, member.UserID)
+		if err != nil {
+			log.Printf("[VoteReminderService] Failed to check ballot for user %s vote %s: %v", member.UserID, vote.ID, err)
+			continue
+		}
+		if !voted {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	return userIDs, nil
+}
+
+// buildNudge creates the vote closing nudge for a user
+func (s *VoteReminderService) buildNudge(vote *model.Vote, userID string, now time.Time, channel model.NudgeChannel) *model.Nudge {
+	template := model.NudgeTemplates[model.NudgeTypeVoteClosing]
+	closesAt := vote.ClosesAt
+	actionURL := "/votes/" + vote.ID
+
+	return &model.Nudge{
+		UserID:  userID,
+		Type:    model.NudgeTypeVoteClosing,
+		Channel: channel,
+		Title:   template.Title,
+		Message: fmt.Sprintf(template.Message, vote.Title, formatTimeRemaining(closesAt.Sub(now))),
+		Data: model.NudgeData{
+			VoteID:    &vote.ID,
+			ClosesAt:  &closesAt,
+			ActionURL: &actionURL,
+		},
+		SentAt: now,
+	}
+}
+
+// sendReminder delivers the nudge on its channel, falling back from push to SSE
+func (s *VoteReminderService) sendReminder(ctx context.Context, nudge *model.Nudge) {
+	notification := &OutboxNotification{
+		UserID: nudge.UserID,
+		Event: &Event{
+			Type: EventNudge,
+			Data: map[string]interface{}{
+				"nudge_type": nudge.Type,
+				"title":      nudge.Title,
+				"message":    nudge.Message,
+				"data":       nudge.Data,
+				"sent_at":    nudge.SentAt,
+			},
+		},
+	}
+	if nudge.Channel == model.NudgeChannelPush {
+		notification.Push = &PushNotification{
+			Title: nudge.Title,
+			Body:  nudge.Message,
+			Data: map[string]string{
+				"nudge_type": string(nudge.Type),
+				"vote_id":    *nudge.Data.VoteID,
+				"closes_at":  nudge.Data.ClosesAt.UTC().Format(time.RFC3339),
+			},
+		}
+	}
+
+	sendNotifications(ctx, s.outbox, s.pushService, s.eventHub, notification)
+}
+
+// formatTimeRemaining renders a duration as a short human phrase, e.g. "5 hours"
+func formatTimeRemaining(d time.Duration) string {
+	if d >= 2*time.Hour {
+		return fmt.Sprintf("%d hours", int(d.Hours()))
+	}
+	if d >= time.Hour {
+		return "1 hour"
+	}
+	minutes := int(d.Minutes())
+	if minutes <= 1 {
+		return "1 minute"
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
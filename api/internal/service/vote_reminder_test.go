@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/database"
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+// ============================================================================
+// Mock Repositories
+// ============================================================================
+
+type mockVoteReminderRepo struct {
+	mu    sync.Mutex
+	votes []*model.Vote
+	sent  map[string]bool
+}
+
+func newMockVoteReminderRepo(votes ...*model.Vote) *mockVoteReminderRepo {
+	return &mockVoteReminderRepo{
+		votes: votes,
+		sent:  make(map[string]bool),
+	}
+}
+
+func (m *mockVoteReminderRepo) GetVotesClosingBetween(ctx context.Context, from, to time.Time) ([]*model.Vote, error) {
+	var result []*model.Vote
+	for _, v := range m.votes {
+		if v.Status == model.VoteStatusOpen && v.ClosesAt.After(from) && !v.ClosesAt.After(to) {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockVoteReminderRepo) RecordReminderSent(ctx context.Context, voteID, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := voteID + "|" + userID
+	if m.sent[key] {
+		return database.ErrDuplicate
+	}
+	m.sent[key] = true
+	return nil
+}
+
+type mockNudgePreferenceRepo struct {
+	prefs map[string]*model.NudgePreference
+}
+
+func (m *mockNudgePreferenceRepo) GetPreference(ctx context.Context, userID string, nudgeType model.NudgeType) (*model.NudgePreference, error) {
+	if pref, ok := m.prefs[userID]; ok && pref.Type == nudgeType {
+		return pref, nil
+	}
+	return nil, nil
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// newTestVoteReminderService builds a reminder service for a guild whose
+// members are user-1..user-3, where voted lists the users with a ballot
+func newTestVoteReminderService(repo *mockVoteReminderRepo, prefs *mockNudgePreferenceRepo, hub *EventHub, now time.Time, voted ...string) *VoteReminderService {
+	members := []*model.Member{
+		{ID: "member:1", UserID: "user-1"},
+		{ID: "member:2", UserID: "user-2"},
+		{ID: "member:3", UserID: "user-3"},
+	}
+	guildRepo := &mockGuildRepo{
+		isMemberFunc: func(ctx context.Context, userID, guildID string) (bool, error) {
+			for _, m := range members {
+				if m.UserID == userID {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+		getMembersFunc: func(ctx context.Context, guildID string) ([]*model.Member, error) {
+			return members, nil
+		},
+	}
+	voteRepo := &mocks.VoteRepository{
+		HasVotedFunc: func(ctx context.Context, voteID, userID string) (bool, error) {
+			for _, v := range voted {
+				if v == userID {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+	}
+	if prefs == nil {
+		prefs = &mockNudgePreferenceRepo{}
+	}
+
+	return NewVoteReminderService(VoteReminderServiceConfig{
+		VoteService:    newTestVoteService(voteRepo, nil, guildRepo),
+		ReminderRepo:   repo,
+		PreferenceRepo: prefs,
+		EventHub:       hub,
+		Clock:          fakeclock.New(now),
+	})
+}
+
+func makeClosingGuildVote(id string, closesAt time.Time) *model.Vote {
+	guildID := "guild:1"
+	return &model.Vote{
+		ID:        id,
+		ScopeType: model.VoteScopeGuild,
+		ScopeID:   &guildID,
+		CreatedBy: "user-1",
+		Title:     "Next campout",
+		Status:    model.VoteStatusOpen,
+		ClosesAt:  closesAt,
+	}
+}
+
+func subscribeVoteReminderUsers(hub *EventHub, userIDs ...string) map[string]*Subscriber {
+	subs := make(map[string]*Subscriber)
+	for _, userID := range userIDs {
+		subs[userID] = hub.SubscribeUser(userID, "sub-"+userID)
+	}
+	return subs
+}
+
+// ============================================================================
+// ProcessClosingReminders Tests
+// ============================================================================
+
+func TestProcessClosingReminders_RemindsNonVotersOnce(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockVoteReminderRepo(makeClosingGuildVote("vote:1", now.Add(5*time.Hour)))
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	subs := subscribeVoteReminderUsers(hub, "user-1", "user-2", "user-3")
+
+	svc := newTestVoteReminderService(repo, nil, hub, now, "user-2")
+
+	if err := svc.ProcessClosingReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ProcessClosingReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, userID := range []string{"user-1", "user-3"} {
+		events := drainReminderEvents(subs[userID])
+		if len(events) != 1 {
+			t.Fatalf("expected 1 reminder for %s, got %d", userID, len(events))
+		}
+		if events[0].Type != EventNudge {
+			t.Errorf("expected event type %s, got %s", EventNudge, events[0].Type)
+		}
+		data := events[0].Data.(map[string]interface{})
+		if data["nudge_type"] != model.NudgeTypeVoteClosing {
+			t.Errorf("expected nudge type %s, got %v", model.NudgeTypeVoteClosing, data["nudge_type"])
+		}
+		if msg, _ := data["message"].(string); !strings.Contains(msg, "5 hours") {
+			t.Errorf("expected message to mention time remaining, got %q", msg)
+		}
+	}
+
+	if events := drainReminderEvents(subs["user-2"]); len(events) != 0 {
+		t.Errorf("expected no reminder for user who voted, got %d", len(events))
+	}
+}
+
+func TestProcessClosingReminders_OutsideWindow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockVoteReminderRepo(makeClosingGuildVote("vote:1", now.Add(30*time.Hour)))
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	subs := subscribeVoteReminderUsers(hub, "user-1")
+
+	svc := newTestVoteReminderService(repo, nil, hub, now)
+
+	if err := svc.ProcessClosingReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if events := drainReminderEvents(subs["user-1"]); len(events) != 0 {
+		t.Errorf("expected no reminder more than 24h before close, got %d", len(events))
+	}
+}
+
+func TestProcessClosingReminders_CreatorOptOut(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	vote := makeClosingGuildVote("vote:1", now.Add(2*time.Hour))
+	vote.RemindersDisabled = true
+	repo := newMockVoteReminderRepo(vote)
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	subs := subscribeVoteReminderUsers(hub, "user-1", "user-2")
+
+	svc := newTestVoteReminderService(repo, nil, hub, now)
+
+	if err := svc.ProcessClosingReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for userID, sub := range subs {
+		if events := drainReminderEvents(sub); len(events) != 0 {
+			t.Errorf("expected no reminder for %s when creator opted out, got %d", userID, len(events))
+		}
+	}
+}
+
+func TestProcessClosingReminders_RespectsNudgePreference(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockVoteReminderRepo(makeClosingGuildVote("vote:1", now.Add(2*time.Hour)))
+	prefs := &mockNudgePreferenceRepo{prefs: map[string]*model.NudgePreference{
+		"user-1": {UserID: "user-1", Type: model.NudgeTypeVoteClosing, Enabled: false},
+	}}
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	subs := subscribeVoteReminderUsers(hub, "user-1", "user-2")
+
+	svc := newTestVoteReminderService(repo, prefs, hub, now)
+
+	if err := svc.ProcessClosingReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if events := drainReminderEvents(subs["user-1"]); len(events) != 0 {
+		t.Errorf("expected no reminder for user who disabled vote nudges, got %d", len(events))
+	}
+	if events := drainReminderEvents(subs["user-2"]); len(events) != 1 {
+		t.Errorf("expected 1 reminder for user-2, got %d", len(events))
+	}
+	if repo.sent["vote:1|user-1"] {
+		t.Error("expected opted-out user not to be recorded as reminded")
+	}
+}
+
+func TestProcessClosingReminders_SkipsGlobalVotes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	vote := makeClosingGuildVote("vote:1", now.Add(2*time.Hour))
+	vote.ScopeType = model.VoteScopeGlobal
+	vote.ScopeID = nil
+	repo := newMockVoteReminderRepo(vote)
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+
+	svc := newTestVoteReminderService(repo, nil, hub, now)
+
+	if err := svc.ProcessClosingReminders(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.sent) != 0 {
+		t.Errorf("expected no reminders for global votes, got %d", len(repo.sent))
+	}
+}
+
+// ============================================================================
+// Reminder opt-out Tests
+// ============================================================================
+
+func TestUpdate_OpenVote_AllowsReminderOptOut(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var captured map[string]interface{}
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+			return &model.Vote{ID: id, Status: model.VoteStatusOpen, CreatedBy: "user-1"}, nil
+		},
+		UpdateFunc: func(ctx context.Context, id string, updates map[string]interface{}) (*model.Vote, error) {
+			captured = updates
+			return &model.Vote{ID: id}, nil
+		},
+	}
+	svc := newTestVoteService(voteRepo, nil, nil)
+
+	disabled := true
+	if _, err := svc.Update(ctx, "vote-1", "user-1", &model.UpdateVoteRequest{RemindersDisabled: &disabled}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if captured["reminders_disabled"] != true {
+		t.Errorf("expected reminders_disabled update, got %v", captured)
+	}
+
+	title := "Renamed"
+	if _, err := svc.Update(ctx, "vote-1", "user-1", &model.UpdateVoteRequest{Title: &title, RemindersDisabled: &disabled}); err == nil {
+		t.Error("expected error updating other fields of an open vote")
+	}
+
+	if _, err := svc.Update(ctx, "vote-1", "user-2", &model.UpdateVoteRequest{RemindersDisabled: &disabled}); err == nil {
+		t.Error("expected error when non-creator changes the reminder opt-out")
+	}
+}
+
+func TestFormatTimeRemaining(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{23*time.Hour + 59*time.Minute, "23 hours"},
+		{90 * time.Minute, "1 hour"},
+		{45 * time.Minute, "45 minutes"},
+		{30 * time.Second, "1 minute"},
+	}
+
+	for _, tt := range tests {
+		if got := formatTimeRemaining(tt.d); got != tt.want {
+			t.Errorf("formatTimeRemaining(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
@@ -6,193 +6,17 @@ import (
 	"time"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
 )
 
-// ============================================================================
-// Mock Repositories
-// ============================================================================
-
-type mockVoteRepo struct {
-	createFunc           func(ctx context.Context, vote *model.Vote) error
-	getByIDFunc          func(ctx context.Context, id string) (*model.Vote, error)
-	getByGuildFunc       func(ctx context.Context, guildID string, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error)
-	getGlobalVotesFunc   func(ctx context.Context, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error)
-	getVotesToOpenFunc   func(ctx context.Context) ([]*model.Vote, error)
-	getVotesToCloseFunc  func(ctx context.Context) ([]*model.Vote, error)
-	updateFunc           func(ctx context.Context, id string, updates map[string]interface{}) (*model.Vote, error)
-	updateStatusFunc     func(ctx context.Context, id string, status model.VoteStatus) error
-	deleteFunc           func(ctx context.Context, id string) error
-	createOptionFunc     func(ctx context.Context, option *model.VoteOption) error
-	getOptionByIDFunc    func(ctx context.Context, id string) (*model.VoteOption, error)
-	getOptionsByVoteFunc func(ctx context.Context, voteID string) ([]*model.VoteOption, error)
-	updateOptionFunc     func(ctx context.Context, id string, updates *model.UpdateVoteOptionRequest) (*model.VoteOption, error)
-	deleteOptionFunc     func(ctx context.Context, id string) error
-	createBallotFunc     func(ctx context.Context, ballot *model.VoteBallot) error
-	getBallotByVoterFunc func(ctx context.Context, voteID, userID string) (*model.VoteBallot, error)
-	getBallotsByVoteFunc func(ctx context.Context, voteID string) ([]*model.VoteBallot, error)
-	deleteBallotFunc     func(ctx context.Context, id string) error
-	hasVotedFunc         func(ctx context.Context, voteID, userID string) (bool, error)
-	countBallotsFunc     func(ctx context.Context, voteID string) (int, error)
-}
-
-func (m *mockVoteRepo) Create(ctx context.Context, vote *model.Vote) error {
-	if m.createFunc != nil {
-		return m.createFunc(ctx, vote)
-	}
-	return nil
-}
-
-func (m *mockVoteRepo) GetByID(ctx context.Context, id string) (*model.Vote, error) {
-	if m.getByIDFunc != nil {
-		return m.getByIDFunc(ctx, id)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) GetByGuild(ctx context.Context, guildID string, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error) {
-	if m.getByGuildFunc != nil {
-		return m.getByGuildFunc(ctx, guildID, status, limit, offset)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) GetGlobalVotes(ctx context.Context, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error) {
-	if m.getGlobalVotesFunc != nil {
-		return m.getGlobalVotesFunc(ctx, status, limit, offset)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) GetVotesToOpen(ctx context.Context) ([]*model.Vote, error) {
-	if m.getVotesToOpenFunc != nil {
-		return m.getVotesToOpenFunc(ctx)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) GetVotesToClose(ctx context.Context) ([]*model.Vote, error) {
-	if m.getVotesToCloseFunc != nil {
-		return m.getVotesToCloseFunc(ctx)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*model.Vote, error) {
-	if m.updateFunc != nil {
-		return m.updateFunc(ctx, id, updates)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) UpdateStatus(ctx context.Context, id string, status model.VoteStatus) error {
-	if m.updateStatusFunc != nil {
-		return m.updateStatusFunc(ctx, id, status)
-	}
-	return nil
-}
-
-func (m *mockVoteRepo) Delete(ctx context.Context, id string) error {
-	if m.deleteFunc != nil {
-		return m.deleteFunc(ctx, id)
-	}
-	return nil
-}
-
-func (m *mockVoteRepo) CreateOption(ctx context.Context, option *model.VoteOption) error {
-	if m.createOptionFunc != nil {
-		return m.createOptionFunc(ctx, option)
-	}
-	return nil
-}
-
-func (m *mockVoteRepo) GetOptionByID(ctx context.Context, id string) (*model.VoteOption, error) {
-	if m.getOptionByIDFunc != nil {
-		return m.getOptionByIDFunc(ctx, id)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) GetOptionsByVote(ctx context.Context, voteID string) ([]*model.VoteOption, error) {
-	if m.getOptionsByVoteFunc != nil {
-		return m.getOptionsByVoteFunc(ctx, voteID)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) UpdateOption(ctx context.Context, id string, updates *model.UpdateVoteOptionRequest) (*model.VoteOption, error) {
-	if m.updateOptionFunc != nil {
-		return m.updateOptionFunc(ctx, id, updates)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) DeleteOption(ctx context.Context, id string) error {
-	if m.deleteOptionFunc != nil {
-		return m.deleteOptionFunc(ctx, id)
-	}
-	return nil
-}
-
-func (m *mockVoteRepo) CreateBallot(ctx context.Context, ballot *model.VoteBallot) error {
-	if m.createBallotFunc != nil {
-		return m.createBallotFunc(ctx, ballot)
-	}
-	return nil
-}
-
-func (m *mockVoteRepo) GetBallotByVoter(ctx context.Context, voteID, userID string) (*model.VoteBallot, error) {
-	if m.getBallotByVoterFunc != nil {
-		return m.getBallotByVoterFunc(ctx, voteID, userID)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) GetBallotsByVote(ctx context.Context, voteID string) ([]*model.VoteBallot, error) {
-	if m.getBallotsByVoteFunc != nil {
-		return m.getBallotsByVoteFunc(ctx, voteID)
-	}
-	return nil, nil
-}
-
-func (m *mockVoteRepo) DeleteBallot(ctx context.Context, id string) error {
-	if m.deleteBallotFunc != nil {
-		return m.deleteBallotFunc(ctx, id)
-	}
-	return nil
-}
-
-func (m *mockVoteRepo) HasVoted(ctx context.Context, voteID, userID string) (bool, error) {
-	if m.hasVotedFunc != nil {
-		return m.hasVotedFunc(ctx, voteID, userID)
-	}
-	return false, nil
-}
-
-func (m *mockVoteRepo) CountBallots(ctx context.Context, voteID string) (int, error) {
-	if m.countBallotsFunc != nil {
-		return m.countBallotsFunc(ctx, voteID)
-	}
-	return 0, nil
-}
-
-type mockVoteUserRepo struct {
-	getByIDFunc func(ctx context.Context, id string) (*model.User, error)
-}
-
-func (m *mockVoteUserRepo) GetByID(ctx context.Context, id string) (*model.User, error) {
-	if m.getByIDFunc != nil {
-		return m.getByIDFunc(ctx, id)
-	}
-	return nil, nil
-}
-
 // ============================================================================
 // Helper Functions
 // ============================================================================
 
-func newTestVoteService(voteRepo *mockVoteRepo, userRepo *mockVoteUserRepo, guildRepo *mockGuildRepo) *VoteService {
+func newTestVoteService(voteRepo *mocks.VoteRepository, userRepo *mocks.VoteUserRepository, guildRepo *mockGuildRepo) *VoteService {
 	if voteRepo == nil {
-		voteRepo = &mockVoteRepo{}
+		voteRepo = &mocks.VoteRepository{}
 	}
 	return NewVoteService(VoteServiceConfig{
 		VoteRepo:  voteRepo,
@@ -278,6 +102,46 @@ func TestCanVote_ClosedVote_ReturnsFalse(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// Affordance Tests
+// ============================================================================
+
+func TestVoteAffordances(t *testing.T) {
+	t.Parallel()
+
+	svc := newTestVoteService(nil, nil, nil)
+
+	tests := []struct {
+		name   string
+		status model.VoteStatus
+		userID string
+		can    bool // can_vote from GetByID
+		want   []model.Action
+	}{
+		{"creator, draft", model.VoteStatusDraft, "creator-1", false, []model.Action{model.ActionCancel, model.ActionEdit}},
+		{"creator, open", model.VoteStatusOpen, "creator-1", true, []model.Action{model.ActionCancel, model.ActionVote}},
+		{"voter, open", model.VoteStatusOpen, "user-1", true, []model.Action{model.ActionVote}},
+		{"creator, closed", model.VoteStatusClosed, "creator-1", false, nil},
+	}
+	for _, tt := range tests {
+		details := &model.VoteWithDetails{
+			Vote:    model.Vote{Status: tt.status, CreatedBy: "creator-1"},
+			CanVote: tt.can,
+		}
+		got := svc.Affordances(details, tt.userID).Allowed()
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+				break
+			}
+		}
+	}
+}
+
 // ============================================================================
 // canViewResults Tests
 // ============================================================================
@@ -714,15 +578,15 @@ func TestOpen_DraftVote_TransitionsToOpen(t *testing.T) {
 	ctx := context.Background()
 
 	var capturedStatus model.VoteStatus
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:        id,
 				Status:    model.VoteStatusDraft,
 				CreatedBy: "user-1",
 			}, nil
 		},
-		updateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
+		UpdateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
 			capturedStatus = status
 			return nil
 		},
@@ -743,8 +607,8 @@ func TestOpen_NotDraft_ReturnsError(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:        id,
 				Status:    model.VoteStatusOpen,
@@ -765,8 +629,8 @@ func TestOpen_WrongUser_ReturnsError(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:        id,
 				Status:    model.VoteStatusDraft,
@@ -788,15 +652,15 @@ func TestClose_OpenVote_TransitionsToClosed(t *testing.T) {
 	ctx := context.Background()
 
 	var capturedStatus model.VoteStatus
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:        id,
 				Status:    model.VoteStatusOpen,
 				CreatedBy: "user-1",
 			}, nil
 		},
-		updateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
+		UpdateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
 			capturedStatus = status
 			return nil
 		},
@@ -818,15 +682,15 @@ func TestCancel_DraftVote_TransitionsToCancelled(t *testing.T) {
 	ctx := context.Background()
 
 	var capturedStatus model.VoteStatus
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:        id,
 				Status:    model.VoteStatusDraft,
 				CreatedBy: "user-1",
 			}, nil
 		},
-		updateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
+		UpdateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
 			capturedStatus = status
 			return nil
 		},
@@ -847,8 +711,8 @@ func TestCancel_AlreadyClosed_ReturnsError(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:        id,
 				Status:    model.VoteStatusClosed,
@@ -865,6 +729,76 @@ func TestCancel_AlreadyClosed_ReturnsError(t *testing.T) {
 	}
 }
 
+func TestDelete_GuildVote_MovesToTrash(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	guildID := "guild-1"
+	var trashed string
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+			return &model.Vote{
+				ID:        id,
+				ScopeType: model.VoteScopeGuild,
+				ScopeID:   &guildID,
+				Status:    model.VoteStatusOpen,
+				CreatedBy: "user-1",
+			}, nil
+		},
+		MoveToTrashFunc: func(ctx context.Context, id, userID string) (bool, error) {
+			trashed = id
+			return true, nil
+		},
+		UpdateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
+			t.Errorf("expected no status change, got %s", status)
+			return nil
+		},
+	}
+
+	svc := newTestVoteService(voteRepo, nil, nil)
+
+	if err := svc.Delete(ctx, "vote-1", "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trashed != "vote-1" {
+		t.Errorf("expected vote-1 to be moved to the trash, got %q", trashed)
+	}
+}
+
+func TestDelete_GlobalVote_Cancels(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var capturedStatus model.VoteStatus
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+			return &model.Vote{
+				ID:        id,
+				ScopeType: model.VoteScopeGlobal,
+				Status:    model.VoteStatusDraft,
+				CreatedBy: "user-1",
+			}, nil
+		},
+		MoveToTrashFunc: func(ctx context.Context, id, userID string) (bool, error) {
+			t.Error("expected a global vote not to be moved to the trash")
+			return true, nil
+		},
+		UpdateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
+			capturedStatus = status
+			return nil
+		},
+	}
+
+	svc := newTestVoteService(voteRepo, nil, nil)
+
+	if err := svc.Delete(ctx, "vote-1", "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if capturedStatus != model.VoteStatusCancelled {
+		t.Errorf("expected status to be cancelled, got %s", capturedStatus)
+	}
+}
+
 // ============================================================================
 // CastBallot Tests
 // ============================================================================
@@ -874,8 +808,8 @@ func TestCastBallot_Success(t *testing.T) {
 	ctx := context.Background()
 
 	optionID := "opt-1"
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:        id,
 				Status:    model.VoteStatusOpen,
@@ -883,17 +817,17 @@ func TestCastBallot_Success(t *testing.T) {
 				VoteType:  model.VoteTypeFPTP,
 			}, nil
 		},
-		getBallotByVoterFunc: func(ctx context.Context, voteID, userID string) (*model.VoteBallot, error) {
+		GetBallotByVoterFunc: func(ctx context.Context, voteID, userID string) (*model.VoteBallot, error) {
 			return nil, nil // No existing ballot
 		},
-		createBallotFunc: func(ctx context.Context, ballot *model.VoteBallot) error {
+		CreateBallotFunc: func(ctx context.Context, ballot *model.VoteBallot) error {
 			ballot.ID = "ballot-1"
 			return nil
 		},
 	}
 
-	userRepo := &mockVoteUserRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+	userRepo := &mocks.VoteUserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
 			return nil, nil
 		},
 	}
@@ -917,8 +851,8 @@ func TestCastBallot_Revote_DeletesExisting(t *testing.T) {
 
 	deleteCalled := false
 	optionID := "opt-1"
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:        id,
 				Status:    model.VoteStatusOpen,
@@ -926,20 +860,20 @@ func TestCastBallot_Revote_DeletesExisting(t *testing.T) {
 				VoteType:  model.VoteTypeFPTP,
 			}, nil
 		},
-		getBallotByVoterFunc: func(ctx context.Context, voteID, userID string) (*model.VoteBallot, error) {
+		GetBallotByVoterFunc: func(ctx context.Context, voteID, userID string) (*model.VoteBallot, error) {
 			return &model.VoteBallot{ID: "existing-ballot"}, nil
 		},
-		deleteBallotFunc: func(ctx context.Context, id string) error {
+		DeleteBallotFunc: func(ctx context.Context, id string) error {
 			deleteCalled = true
 			return nil
 		},
-		createBallotFunc: func(ctx context.Context, ballot *model.VoteBallot) error {
+		CreateBallotFunc: func(ctx context.Context, ballot *model.VoteBallot) error {
 			return nil
 		},
 	}
 
-	userRepo := &mockVoteUserRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+	userRepo := &mocks.VoteUserRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
 			return nil, nil
 		},
 	}
@@ -962,8 +896,8 @@ func TestCastBallot_VoteNotOpen_ReturnsError(t *testing.T) {
 	ctx := context.Background()
 
 	optionID := "opt-1"
-	voteRepo := &mockVoteRepo{
-		getByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
 			return &model.Vote{
 				ID:       id,
 				Status:   model.VoteStatusDraft,
@@ -993,14 +927,14 @@ func TestProcessScheduledTransitions_OpensAndCloses(t *testing.T) {
 	openedIDs := make(map[string]bool)
 	closedIDs := make(map[string]bool)
 
-	voteRepo := &mockVoteRepo{
-		getVotesToOpenFunc: func(ctx context.Context) ([]*model.Vote, error) {
+	voteRepo := &mocks.VoteRepository{
+		GetVotesToOpenFunc: func(ctx context.Context, now time.Time) ([]*model.Vote, error) {
 			return []*model.Vote{{ID: "vote-to-open"}}, nil
 		},
-		getVotesToCloseFunc: func(ctx context.Context) ([]*model.Vote, error) {
+		GetVotesToCloseFunc: func(ctx context.Context, now time.Time) ([]*model.Vote, error) {
 			return []*model.Vote{{ID: "vote-to-close"}}, nil
 		},
-		updateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
+		UpdateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
 			switch status {
 			case model.VoteStatusOpen:
 				openedIDs[id] = true
@@ -1026,6 +960,55 @@ func TestProcessScheduledTransitions_OpensAndCloses(t *testing.T) {
 	}
 }
 
+func TestProcessScheduledTransitions_FollowsClock(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	clk := fakeclock.New(start)
+	vote := &model.Vote{ID: "vote-1", Status: model.VoteStatusDraft, OpensAt: start.Add(time.Hour), ClosesAt: start.Add(25 * time.Hour)}
+
+	// The repository selects by the time it is given, as the query does
+	voteRepo := &mocks.VoteRepository{
+		GetVotesToOpenFunc: func(ctx context.Context, now time.Time) ([]*model.Vote, error) {
+			if vote.Status == model.VoteStatusDraft && !vote.OpensAt.After(now) {
+				return []*model.Vote{vote}, nil
+			}
+			return nil, nil
+		},
+		GetVotesToCloseFunc: func(ctx context.Context, now time.Time) ([]*model.Vote, error) {
+			if vote.Status == model.VoteStatusOpen && !vote.ClosesAt.After(now) {
+				return []*model.Vote{vote}, nil
+			}
+			return nil, nil
+		},
+		UpdateStatusFunc: func(ctx context.Context, id string, status model.VoteStatus) error {
+			vote.Status = status
+			return nil
+		},
+	}
+	svc := NewVoteService(VoteServiceConfig{VoteRepo: voteRepo, Clock: clk})
+
+	steps := []struct {
+		advance time.Duration
+		want    model.VoteStatus
+	}{
+		{0, model.VoteStatusDraft},
+		{time.Hour, model.VoteStatusOpen},
+		{23 * time.Hour, model.VoteStatusOpen},
+		{time.Hour, model.VoteStatusClosed},
+	}
+	for _, step := range steps {
+		clk.Advance(step.advance)
+		if err := svc.ProcessScheduledTransitions(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if vote.Status != step.want {
+			t.Fatalf("at %v expected %s, got %s", clk.Now().Sub(start), step.want, vote.Status)
+		}
+	}
+}
+
 // ============================================================================
 // GetGuildVotes Tests
 // ============================================================================
@@ -1035,16 +1018,16 @@ func TestGetGuildVotes_DefaultsLimit(t *testing.T) {
 	ctx := context.Background()
 
 	var capturedLimit int
-	voteRepo := &mockVoteRepo{
-		getByGuildFunc: func(ctx context.Context, guildID string, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error) {
-			capturedLimit = limit
-			return nil, nil
+	voteRepo := &mocks.VoteRepository{
+		GetByGuildFunc: func(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error) {
+			capturedLimit = page.Limit
+			return &model.Page[*model.Vote]{}, nil
 		},
 	}
 
 	svc := newTestVoteService(voteRepo, nil, nil)
 
-	_, _ = svc.GetGuildVotes(ctx, "guild-1", nil, 0, 0)
+	_, _ = svc.GetGuildVotes(ctx, "guild-1", model.ListQuery{}, model.PageRequest{})
 	if capturedLimit != 50 {
 		t.Errorf("expected default limit 50, got %d", capturedLimit)
 	}
@@ -1055,16 +1038,16 @@ func TestGetGuildVotes_CapsLimit(t *testing.T) {
 	ctx := context.Background()
 
 	var capturedLimit int
-	voteRepo := &mockVoteRepo{
-		getByGuildFunc: func(ctx context.Context, guildID string, status *model.VoteStatus, limit, offset int) ([]*model.Vote, error) {
-			capturedLimit = limit
-			return nil, nil
+	voteRepo := &mocks.VoteRepository{
+		GetByGuildFunc: func(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error) {
+			capturedLimit = page.Limit
+			return &model.Page[*model.Vote]{}, nil
 		},
 	}
 
 	svc := newTestVoteService(voteRepo, nil, nil)
 
-	_, _ = svc.GetGuildVotes(ctx, "guild-1", nil, 200, 0)
+	_, _ = svc.GetGuildVotes(ctx, "guild-1", model.ListQuery{}, model.PageRequest{Limit: 200})
 	if capturedLimit != 50 {
 		t.Errorf("expected capped limit 50, got %d", capturedLimit)
 	}
@@ -1078,8 +1061,8 @@ func TestCreate_ValidRequest_CreatesVote(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	voteRepo := &mockVoteRepo{
-		createFunc: func(ctx context.Context, vote *model.Vote) error {
+	voteRepo := &mocks.VoteRepository{
+		CreateFunc: func(ctx context.Context, vote *model.Vote) error {
 			vote.ID = "vote-1"
 			return nil
 		},
@@ -1113,7 +1096,7 @@ func TestCreate_ClosesBeforeOpens_ReturnsError(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	svc := newTestVoteService(&mockVoteRepo{}, nil, nil)
+	svc := newTestVoteService(&mocks.VoteRepository{}, nil, nil)
 
 	opensAt := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
 	closesAt := time.Now().Add(1 * time.Hour).Format(time.RFC3339)
@@ -1130,3 +1113,147 @@ func TestCreate_ClosesBeforeOpens_ReturnsError(t *testing.T) {
 		t.Error("expected error for closes before opens")
 	}
 }
+
+// ============================================================================
+// Scheduled Publishing Tests
+// ============================================================================
+
+func TestCreate_WithPublishAt_CreatesScheduledVote(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	svc := newTestVoteService(&mocks.VoteRepository{}, nil, nil)
+
+	publishAt := time.Now().Add(1 * time.Hour).Format(time.RFC3339)
+	vote, err := svc.Create(ctx, "user-1", &model.CreateVoteRequest{
+		ScopeType: string(model.VoteScopeGlobal),
+		Title:     "Test Vote",
+		VoteType:  string(model.VoteTypeFPTP),
+		OpensAt:   time.Now().Add(2 * time.Hour).Format(time.RFC3339),
+		ClosesAt:  time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		PublishAt: &publishAt,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vote.Status != model.VoteStatusScheduled {
+		t.Errorf("expected scheduled status, got %s", vote.Status)
+	}
+	if vote.PublishAt == nil {
+		t.Error("expected publish_at to be set")
+	}
+}
+
+func TestCreate_PublishAfterOpening_ReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	svc := newTestVoteService(&mocks.VoteRepository{}, nil, nil)
+
+	publishAt := time.Now().Add(3 * time.Hour).Format(time.RFC3339)
+	_, err := svc.Create(ctx, "user-1", &model.CreateVoteRequest{
+		ScopeType: string(model.VoteScopeGlobal),
+		Title:     "Test Vote",
+		VoteType:  string(model.VoteTypeFPTP),
+		OpensAt:   time.Now().Add(2 * time.Hour).Format(time.RFC3339),
+		ClosesAt:  time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		PublishAt: &publishAt,
+	})
+
+	if err == nil {
+		t.Error("expected error for publish_at after opens_at")
+	}
+}
+
+func TestCreate_PublishAtInPast_ReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc := NewVoteService(VoteServiceConfig{VoteRepo: &mocks.VoteRepository{}, Clock: fakeclock.New(now)})
+
+	publishAt := now.Add(-time.Minute).Format(time.RFC3339)
+	_, err := svc.Create(ctx, "user-1", &model.CreateVoteRequest{
+		ScopeType: string(model.VoteScopeGlobal),
+		Title:     "Test Vote",
+		VoteType:  string(model.VoteTypeFPTP),
+		OpensAt:   now.Add(2 * time.Hour).Format(time.RFC3339),
+		ClosesAt:  now.Add(24 * time.Hour).Format(time.RFC3339),
+		PublishAt: &publishAt,
+	})
+
+	if err == nil {
+		t.Error("expected error for publish_at before the service's current time")
+	}
+}
+
+func TestSchedulePublication_OpenVote_ReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	scheduled := false
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+			return &model.Vote{ID: id, CreatedBy: "user-1", Status: model.VoteStatusOpen, OpensAt: time.Now().Add(time.Hour)}, nil
+		},
+		SchedulePublicationFunc: func(ctx context.Context, id string, publishAt time.Time) (*model.Vote, error) {
+			scheduled = true
+			return nil, nil
+		},
+	}
+
+	svc := newTestVoteService(voteRepo, nil, nil)
+
+	if _, err := svc.SchedulePublication(ctx, "vote-1", "user-1", time.Now().Add(30*time.Minute)); err == nil {
+		t.Error("expected error when scheduling an open vote")
+	}
+	if scheduled {
+		t.Error("expected the repository not to be called")
+	}
+}
+
+func TestSchedulePublication_ReschedulesScheduledVote(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	publishAt := time.Now().Add(30 * time.Minute)
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+			return &model.Vote{ID: id, CreatedBy: "user-1", Status: model.VoteStatusScheduled, OpensAt: time.Now().Add(time.Hour)}, nil
+		},
+		SchedulePublicationFunc: func(ctx context.Context, id string, at time.Time) (*model.Vote, error) {
+			return &model.Vote{ID: id, Status: model.VoteStatusScheduled, PublishAt: &at}, nil
+		},
+	}
+
+	svc := newTestVoteService(voteRepo, nil, nil)
+
+	vote, err := svc.SchedulePublication(ctx, "vote-1", "user-1", publishAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vote.PublishAt == nil || !vote.PublishAt.Equal(publishAt) {
+		t.Errorf("expected publish_at %v, got %v", publishAt, vote.PublishAt)
+	}
+}
+
+func TestGetByID_ScheduledVote_HiddenFromOthers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	voteRepo := &mocks.VoteRepository{
+		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+			return &model.Vote{ID: id, CreatedBy: "user-1", Status: model.VoteStatusScheduled}, nil
+		},
+	}
+
+	svc := newTestVoteService(voteRepo, nil, nil)
+
+	if _, err := svc.GetByID(ctx, "vote-1", "user-2"); err == nil {
+		t.Error("expected scheduled vote to be hidden from other users")
+	}
+	if _, err := svc.GetByID(ctx, "vote-1", "user-1"); err != nil {
+		t.Errorf("expected creator to see scheduled vote, got %v", err)
+	}
+}
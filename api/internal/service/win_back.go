@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// WinBackRepository finds users drifting toward inactivity and records the
+// win-back attempts made for them
+type WinBackRepository interface {
+	GetChurnRiskCandidates(ctx context.Context, activeSince, activeBefore, targetedBefore time.Time, maxAttempts, limit int) ([]*model.ChurnRiskCandidate, error)
+	GetUpcomingEvents(ctx context.Context, userID string, from, until time.Time, limit int) ([]model.WinBackEvent, error)
+	ClaimWinBack(ctx context.Context, userID string, targetedOn, targetedBefore time.Time) (bool, error)
+	RecordAttempt(ctx context.Context, attempt *model.WinBackAttempt) error
+	MarkReturned(ctx context.Context, targetedSince time.Time) (int, error)
+	GetGroupStats(ctx context.Context, since, until time.Time) ([]model.WinBackGroupStats, error)
+}
+
+// WinBackProfileRepository finds the new members near an at-risk user
+type WinBackProfileRepository interface {
+	GetLocationInternal(ctx context.Context, userID string) (*model.LocationInternal, error)
+	GetNearby(ctx context.Context, minLat, maxLat, minLng, maxLng float64, limit int) ([]*model.UserProfile, error)
+}
+
+// CompatibilityScorer scores how well other users suit a user
+type CompatibilityScorer interface {
+	CalculateCompatibilityBatch(ctx context.Context, userID string, targetIDs []string) ([]*model.CompatibilityScore, error)
+}
+
+const (
+	// winBackBatchSize caps the at-risk users targeted per run
+	winBackBatchSize = 200
+	// winBackNearbyCandidates caps the nearby profiles checked for new members
+	winBackNearbyCandidates = 200
+	// winBackHoldoutSalt keeps holdout bucketing independent of experiments
+	winBackHoldoutSalt = "win_back_holdout"
+)
+
+// ProcessWinBackNudges targets users who have been away for a while with
+// nothing coming up. Each is bucketed into the treatment group, who are
+// nudged with compatible new members nearby and low-commitment events in
+// their guilds, or the holdout group, who are only recorded. Nudges are
+// also emailed to users who opted in to marketing email. Users who opted
+// out of win-back nudges or have nothing to come back for are skipped before
+// bucketing so that both groups are drawn from the same users. Attempts whose
+// users became active again within the return window are marked returned.
+// This should be called periodically by a background job.
+func (s *NudgeService) ProcessWinBackNudges(ctx context.Context) error {
+	config := s.configs[model.NudgeTypeWinBack]
+	if !config.Enabled {
+		return nil
+	}
+
+	if s.winBackRepo == nil {
+		return nil
+	}
+
+	now := s.clock.Now()
+	if _, err := s.winBackRepo.MarkReturned(ctx, now.Add(-model.WinBackReturnWindow)); err != nil {
+		log.Printf("[NudgeService] Failed to mark returned win-back users: %v", err)
+	}
+
+	targetedBefore := now.Add(-config.RepeatInterval)
+	candidates, err := s.winBackRepo.GetChurnRiskCandidates(ctx, now.Add(-model.WinBackLapsedAfter), now.Add(-config.DelayAfter), targetedBefore, config.MaxRepeat, winBackBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		channel := config.Channel
+		if s.preferenceRepo != nil {
+			pref, err := s.preferenceRepo.GetPreference(ctx, candidate.UserID, model.NudgeTypeWinBack)
+			if err != nil {
+				log.Printf("[NudgeService] Failed to load preference for user %s: %v", candidate.UserID, err)
+				continue
+			}
+			if pref != nil && !pref.Enabled {
+				continue
+			}
+			if pref != nil && pref.Channel != nil {
+				channel = *pref.Channel
+			}
+		}
+
+		newMembers, err := s.countCompatibleNewMembers(ctx, candidate.UserID, now)
+		if err != nil {
+			log.Printf("[NudgeService] Failed to find new members near user %s: %v", candidate.UserID, err)
+			continue
+		}
+		events, err := s.winBackRepo.GetUpcomingEvents(ctx, candidate.UserID, now, now.Add(model.WinBackEventWindow), model.WinBackMaxEvents)
+		if err != nil {
+			log.Printf("[NudgeService] Failed to find events for user %s: %v", candidate.UserID, err)
+			continue
+		}
+		if newMembers == 0 && len(events) == 0 {
+			continue
+		}
+
+		// Record first so that concurrent runs cannot double-send
+		claimed, err := s.winBackRepo.ClaimWinBack(ctx, candidate.UserID, now, targetedBefore)
+		if err != nil {
+			log.Printf("[NudgeService] Failed to record win-back for user %s: %v", candidate.UserID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		attempt := &model.WinBackAttempt{
+			UserID:     candidate.UserID,
+			Group:      winBackGroup(candidate.UserID, s.holdoutPercent),
+			LastActive: candidate.LastActive,
+			TargetedOn: now,
+		}
+		if attempt.Group == model.WinBackGroupTreatment {
+			attempt.NewMembers = newMembers
+			for _, event := range events {
+				attempt.SuggestedEvents = append(attempt.SuggestedEvents, event.ID)
+			}
+		}
+		if err := s.winBackRepo.RecordAttempt(ctx, attempt); err != nil {
+			log.Printf("[NudgeService] Failed to record win-back attempt for user %s: %v", candidate.UserID, err)
+		}
+		if attempt.Group == model.WinBackGroupHoldout {
+			continue
+		}
+
+		nudge := s.buildWinBackNudge(candidate.UserID, newMembers, events)
+		nudge.Channel = channel
+		s.sendNudge(ctx, nudge)
+		if s.marketingEmail != nil {
+			if _, err := s.marketingEmail.Send(ctx, candidate.UserID, nudge.Title, nudge.Message); err != nil {
+				log.Printf("[NudgeService] Failed to email win-back nudge to user %s: %v", candidate.UserID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// WinBackStats compares the return rates of nudged and held-out users
+// targeted since the given time, counting only attempts whose return window
+// has closed
+func (s *NudgeService) WinBackStats(ctx context.Context, since time.Time) (*model.WinBackStats, error) {
+	if s.winBackRepo == nil {
+		return nil, fmt.Errorf("win-back is not configured")
+	}
+
+	groups, err := s.winBackRepo.GetGroupStats(ctx, since, s.clock.Now().Add(-model.WinBackReturnWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &model.WinBackStats{
+		Since:     since,
+		Treatment: model.WinBackGroupStats{Group: model.WinBackGroupTreatment},
+		Holdout:   model.WinBackGroupStats{Group: model.WinBackGroupHoldout},
+	}
+	for _, group := range groups {
+		switch group.Group {
+		case model.WinBackGroupTreatment:
+			stats.Treatment = group
+		case model.WinBackGroupHoldout:
+			stats.Holdout = group
+		}
+	}
+	for _, group := range []*model.WinBackGroupStats{&stats.Treatment, &stats.Holdout} {
+		if group.Targeted > 0 {
+			group.ReturnRate = roundRatio(float64(group.Returned) / float64(group.Targeted))
+		}
+	}
+	if stats.Treatment.Targeted > 0 && stats.Holdout.Targeted > 0 {
+		lift := roundRatio(stats.Treatment.ReturnRate - stats.Holdout.ReturnRate)
+		stats.Lift = &lift
+	}
+	return stats, nil
+}
+
+// countCompatibleNewMembers counts members who joined recently near the
+// user and score as compatible with them, without deal-breakers. Only
+// locations whose owners allow them to be used are considered. It is 0 when
+// the user has no usable location or compatibility can't be scored.
+func (s *NudgeService) countCompatibleNewMembers(ctx context.Context, userID string, now time.Time) (int, error) {
+	if s.winBackProfiles == nil || s.compatibility == nil {
+		return 0, nil
+	}
+	if !hasLocationConsent(ctx, s.consent, userID) {
+		return 0, nil
+	}
+
+	location, err := s.winBackProfiles.GetLocationInternal(ctx, userID)
+	if err != nil || location == nil {
+		return 0, err
+	}
+
+	bbox := s.geoService.GetBoundingBox(location.Lat, location.Lng, model.WinBackNearbyRadiusKm)
+	profiles, err := s.winBackProfiles.GetNearby(ctx, bbox.MinLat, bbox.MaxLat, bbox.MinLng, bbox.MaxLng, winBackNearbyCandidates)
+	if err != nil {
+		return 0, err
+	}
+
+	joinedSince := now.Add(-model.WinBackNewMemberWindow)
+	var newMemberIDs []string
+	for _, profile := range profiles {
+		if profile.UserID == userID || profile.CreatedOn.Before(joinedSince) {
+			continue
+		}
+		newMemberIDs = append(newMemberIDs, profile.UserID)
+		if len(newMemberIDs) == model.MaxBatchCompatibilityUsers {
+			break
+		}
+	}
+	if len(newMemberIDs) == 0 {
+		return 0, nil
+	}
+
+	scores, err := s.compatibility.CalculateCompatibilityBatch(ctx, userID, newMemberIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	compatible := 0
+	for _, score := range scores {
+		if score != nil && !score.DealBreaker && score.Score >= model.WinBackMinCompatibility {
+			compatible++
+		}
+	}
+	return compatible, nil
+}
+
+// buildWinBackNudge creates a nudge naming what the user could come back for
+func (s *NudgeService) buildWinBackNudge(userID string, newMembers int, events []model.WinBackEvent) *model.Nudge {
+	template := model.NudgeTemplates[model.NudgeTypeWinBack]
+
+	var reasons []string
+	data := model.NudgeData{}
+	if newMembers > 0 {
+		if newMembers == 1 {
+			reasons = append(reasons, "A new member near you looks like a good match.")
+		} else {
+			reasons = append(reasons, fmt.Sprintf("%d new members near you look like a good match.", newMembers))
+		}
+		data.NewMemberCount = &newMembers
+	}
+	if len(events) > 0 {
+		first := events[0]
+		switch len(events) {
+		case 1:
+			reasons = append(reasons, fmt.Sprintf("%s is coming up on %s.", first.Title, first.StartTime.Format("Jan 2")))
+		default:
+			reasons = append(reasons, fmt.Sprintf("%s and %d more %s are coming up in your guilds.",
+				first.Title, len(events)-1, pluralizeEvents(len(events)-1)))
+		}
+		data.Events = events
+	}
+
+	actionURL := "/discover"
+	if newMembers == 0 && len(events) > 0 {
+		actionURL = "/events/" + events[0].ID
+	}
+	data.ActionURL = &actionURL
+
+	return &model.Nudge{
+		UserID:  userID,
+		Type:    model.NudgeTypeWinBack,
+		Channel: s.configs[model.NudgeTypeWinBack].Channel,
+		Title:   template.Title,
+		Message: fmt.Sprintf(template.Message, strings.Join(reasons, " ")),
+		Data:    data,
+		SentAt:  s.clock.Now(),
+	}
+}
+
+// winBackGroup buckets a user into the holdout the way experiments bucket
+// variants, so the same user lands in the same group on every run
+func winBackGroup(userID string, holdoutPercent int) model.WinBackGroup {
+	if holdoutPercent <= 0 {
+		return model.WinBackGroupTreatment
+	}
+
+	variant := bucketVariant(&model.Experiment{
+		Salt: winBackHoldoutSalt,
+		Variants: []model.ExperimentVariant{
+			{Key: string(model.WinBackGroupHoldout), Weight: holdoutPercent},
+			{Key: string(model.WinBackGroupTreatment), Weight: 100 - holdoutPercent},
+		},
+	}, userID)
+	if variant != nil && variant.Key == string(model.WinBackGroupHoldout) {
+		return model.WinBackGroupHoldout
+	}
+	return model.WinBackGroupTreatment
+}
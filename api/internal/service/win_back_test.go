@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/testing/fakeclock"
+	"github.com/forgo/saga/api/internal/testing/mocks"
+)
+
+type mockCompatibilityScorer struct {
+	scores map[string]float64
+	asked  []string
+}
+
+func (m *mockCompatibilityScorer) CalculateCompatibilityBatch(ctx context.Context, userID string, targetIDs []string) ([]*model.CompatibilityScore, error) {
+	m.asked = append(m.asked, targetIDs...)
+	scores := make([]*model.CompatibilityScore, 0, len(targetIDs))
+	for _, targetID := range targetIDs {
+		scores = append(scores, &model.CompatibilityScore{UserAID: userID, UserBID: targetID, Score: m.scores[targetID]})
+	}
+	return scores, nil
+}
+
+// winBackRepoFor returns a repository offering the given candidates and
+// events, recording attempts into the returned slice
+func winBackRepoFor(candidates []*model.ChurnRiskCandidate, events map[string][]model.WinBackEvent) (*mocks.WinBackRepository, *[]*model.WinBackAttempt) {
+	var mu sync.Mutex
+	var attempts []*model.WinBackAttempt
+	claimed := map[string]bool{}
+	repo := &mocks.WinBackRepository{
+		GetChurnRiskCandidatesFunc: func(ctx context.Context, activeSince, activeBefore, targetedBefore time.Time, maxAttempts, limit int) ([]*model.ChurnRiskCandidate, error) {
+			return candidates, nil
+		},
+		GetUpcomingEventsFunc: func(ctx context.Context, userID string, from, until time.Time, limit int) ([]model.WinBackEvent, error) {
+			return events[userID], nil
+		},
+		ClaimWinBackFunc: func(ctx context.Context, userID string, targetedOn, targetedBefore time.Time) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if claimed[userID] {
+				return false, nil
+			}
+			claimed[userID] = true
+			return true, nil
+		},
+		RecordAttemptFunc: func(ctx context.Context, attempt *model.WinBackAttempt) error {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts = append(attempts, attempt)
+			return nil
+		},
+	}
+	return repo, &attempts
+}
+
+func TestProcessWinBackNudges(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	clk := fakeclock.New(now)
+	away := now.Add(-10 * 24 * time.Hour)
+
+	repo, attempts := winBackRepoFor(
+		[]*model.ChurnRiskCandidate{
+			{UserID: "user:event-goer", LastActive: away},
+			{UserID: "user:nothing-nearby", LastActive: away},
+			{UserID: "user:opted-out", LastActive: away},
+			{UserID: "user:social", LastActive: away},
+		},
+		map[string][]model.WinBackEvent{
+			"user:event-goer": {{ID: "event:1", Title: "Board games", StartTime: time.Date(2026, 3, 7, 18, 0, 0, 0, time.UTC)}},
+			"user:opted-out":  {{ID: "event:1", Title: "Board games", StartTime: time.Date(2026, 3, 7, 18, 0, 0, 0, time.UTC)}},
+		},
+	)
+	profiles := &mocks.WinBackProfileRepository{
+		GetLocationInternalFunc: func(ctx context.Context, userID string) (*model.LocationInternal, error) {
+			if userID != "user:social" {
+				return nil, nil
+			}
+			return &model.LocationInternal{Lat: 40.7, Lng: -74.0}, nil
+		},
+		GetNearbyFunc: func(ctx context.Context, minLat, maxLat, minLng, maxLng float64, limit int) ([]*model.UserProfile, error) {
+			return []*model.UserProfile{
+				{UserID: "user:social", CreatedOn: now.AddDate(-1, 0, 0)},
+				{UserID: "user:new-match", CreatedOn: now.Add(-5 * 24 * time.Hour)},
+				{UserID: "user:new-mismatch", CreatedOn: now.Add(-5 * 24 * time.Hour)},
+				{UserID: "user:old-timer", CreatedOn: now.AddDate(0, -3, 0)},
+			}, nil
+		},
+	}
+	compatibility := &mockCompatibilityScorer{scores: map[string]float64{"user:new-match": 85, "user:new-mismatch": 40}}
+
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	eventGoer := hub.SubscribeUser("user:event-goer", "sub-1")
+	social := hub.SubscribeUser("user:social", "sub-2")
+	optedOut := hub.SubscribeUser("user:opted-out", "sub-3")
+
+	svc := NewNudgeService(NudgeServiceConfig{
+		EventHub:        hub,
+		WinBackRepo:     repo,
+		WinBackProfiles: profiles,
+		Compatibility:   compatibility,
+		PreferenceRepo: &mockNudgePreferenceRepo{prefs: map[string]*model.NudgePreference{
+			"user:opted-out": {UserID: "user:opted-out", Type: model.NudgeTypeWinBack, Enabled: false},
+		}},
+		Clock: clk,
+	})
+
+	if err := svc.ProcessWinBackNudges(ctx); err != nil {
+		t.Fatalf("ProcessWinBackNudges() error = %v", err)
+	}
+
+	if len(compatibility.asked) != 2 {
+		t.Errorf("scored %v, want only the new members other than the user", compatibility.asked)
+	}
+
+	tests := []struct {
+		sub         *Subscriber
+		wantMessage string
+	}{
+		{eventGoer, "It's been a while. Board games is coming up on Mar 7."},
+		{social, "It's been a while. A new member near you looks like a good match."},
+	}
+	for _, tt := range tests {
+		events := drainReminderEvents(tt.sub)
+		if len(events) != 1 {
+			t.Fatalf("got %d nudges, want 1", len(events))
+		}
+		data := events[0].Data.(map[string]interface{})
+		if data["nudge_type"] != model.NudgeTypeWinBack || data["message"] != tt.wantMessage {
+			t.Errorf("nudge = %v %q, want win_back %q", data["nudge_type"], data["message"], tt.wantMessage)
+		}
+	}
+	if events := drainReminderEvents(optedOut); len(events) != 0 {
+		t.Errorf("opted-out user got %d nudges, want 0", len(events))
+	}
+
+	// Users with nothing to offer or who opted out aren't targeted at all
+	if len(*attempts) != 2 {
+		t.Fatalf("recorded %d attempts, want 2", len(*attempts))
+	}
+	for _, attempt := range *attempts {
+		if attempt.Group != model.WinBackGroupTreatment || !attempt.TargetedOn.Equal(now) {
+			t.Errorf("attempt = %+v, want treatment targeted now", attempt)
+		}
+	}
+}
+
+func TestProcessWinBackNudges_HoldoutIsRecordedButNotNudged(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var heldOut string
+	for i := 0; heldOut == ""; i++ {
+		if userID := fmt.Sprintf("user:%d", i); winBackGroup(userID, 50) == model.WinBackGroupHoldout {
+			heldOut = userID
+		}
+	}
+
+	repo, attempts := winBackRepoFor(
+		[]*model.ChurnRiskCandidate{{UserID: heldOut, LastActive: time.Now().Add(-10 * 24 * time.Hour)}},
+		map[string][]model.WinBackEvent{heldOut: {{ID: "event:1", Title: "Board games", StartTime: time.Now().Add(48 * time.Hour)}}},
+	)
+
+	hub := NewEventHub(EventHubConfig{})
+	defer hub.Close()
+	sub := hub.SubscribeUser(heldOut, "sub-1")
+
+	svc := NewNudgeService(NudgeServiceConfig{EventHub: hub, WinBackRepo: repo, HoldoutPercent: 50})
+	if err := svc.ProcessWinBackNudges(ctx); err != nil {
+		t.Fatalf("ProcessWinBackNudges() error = %v", err)
+	}
+
+	if events := drainReminderEvents(sub); len(events) != 0 {
+		t.Errorf("held-out user got %d nudges, want 0", len(events))
+	}
+	if len(*attempts) != 1 || (*attempts)[0].Group != model.WinBackGroupHoldout || len((*attempts)[0].SuggestedEvents) != 0 {
+		t.Errorf("attempts = %+v, want one holdout attempt without suggestions", *attempts)
+	}
+}
+
+func TestWinBackGroup(t *testing.T) {
+	t.Parallel()
+
+	held := 0
+	for i := 0; i < 10000; i++ {
+		userID := fmt.Sprintf("user:%d", i)
+		group := winBackGroup(userID, 10)
+		if group != winBackGroup(userID, 10) {
+			t.Fatalf("group for %s changed between calls", userID)
+		}
+		if group == model.WinBackGroupHoldout {
+			held++
+		}
+		if winBackGroup(userID, 0) != model.WinBackGroupTreatment {
+			t.Fatalf("%s held out with no holdout", userID)
+		}
+	}
+	if held < 900 || held > 1100 {
+		t.Errorf("held out %d of 10000, want about 10%%", held)
+	}
+}
+
+func TestNudgeService_WinBackStats(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	var until time.Time
+	repo := &mocks.WinBackRepository{
+		GetGroupStatsFunc: func(ctx context.Context, since, u time.Time) ([]model.WinBackGroupStats, error) {
+			until = u
+			return []model.WinBackGroupStats{
+				{Group: model.WinBackGroupTreatment, Targeted: 90, Returned: 27},
+				{Group: model.WinBackGroupHoldout, Targeted: 10, Returned: 2},
+			}, nil
+		},
+	}
+	svc := NewNudgeService(NudgeServiceConfig{WinBackRepo: repo, Clock: fakeclock.New(now)})
+
+	stats, err := svc.WinBackStats(context.Background(), now.AddDate(0, 0, -90))
+	if err != nil {
+		t.Fatalf("WinBackStats() error = %v", err)
+	}
+	if !until.Equal(now.Add(-model.WinBackReturnWindow)) {
+		t.Errorf("counted attempts until %v, want those whose return window has closed", until)
+	}
+	if stats.Treatment.ReturnRate != 0.3 || stats.Holdout.ReturnRate != 0.2 {
+		t.Errorf("return rates = %v / %v, want 0.3 / 0.2", stats.Treatment.ReturnRate, stats.Holdout.ReturnRate)
+	}
+	if stats.Lift == nil || *stats.Lift != 0.1 {
+		t.Errorf("Lift = %v, want 0.1", stats.Lift)
+	}
+}
+
+func TestProcessWinBackNudges_NewMembersNeedLocationConsent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	away := now.Add(-10 * 24 * time.Hour)
+	joined := now.Add(-5 * 24 * time.Hour)
+
+	repo, attempts := winBackRepoFor(
+		[]*model.ChurnRiskCandidate{
+			{UserID: "user:social", LastActive: away},
+			{UserID: "user:withdrew", LastActive: away},
+		},
+		nil,
+	)
+	profiles := &mocks.WinBackProfileRepository{
+		GetLocationInternalFunc: func(ctx context.Context, userID string) (*model.LocationInternal, error) {
+			return &model.LocationInternal{Lat: 40.7, Lng: -74.0}, nil
+		},
+		GetNearbyFunc: func(ctx context.Context, minLat, maxLat, minLng, maxLng float64, limit int) ([]*model.UserProfile, error) {
+			// New members without consent are left out by the repository query
+			return []*model.UserProfile{{UserID: "user:new-consented", CreatedOn: joined}}, nil
+		},
+	}
+	compatibility := &mockCompatibilityScorer{scores: map[string]float64{"user:new-consented": 85}}
+	consents := locationConsents(t, "user:social", "user:withdrew")
+	if _, err := consents.Withdraw(ctx, "user:withdrew", model.ConsentLocationProcessing); err != nil {
+		t.Fatalf("Withdraw() error = %v", err)
+	}
+
+	svc := NewNudgeService(NudgeServiceConfig{
+		WinBackRepo:     repo,
+		WinBackProfiles: profiles,
+		Compatibility:   compatibility,
+		Consent:         consents,
+		Clock:           fakeclock.New(now),
+	})
+	if err := svc.ProcessWinBackNudges(ctx); err != nil {
+		t.Fatalf("ProcessWinBackNudges() error = %v", err)
+	}
+
+	if len(compatibility.asked) != 1 || compatibility.asked[0] != "user:new-consented" {
+		t.Errorf("scored %v, want new members near the consenting user only", compatibility.asked)
+	}
+	if len(*attempts) != 1 || (*attempts)[0].UserID != "user:social" || (*attempts)[0].NewMembers != 1 {
+		t.Errorf("attempts = %+v, want one for user:social with 1 new member", *attempts)
+	}
+}
+
+func TestProcessWinBackNudges_EmailsOnlyMarketingOptIns(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	away := now.Add(-10 * 24 * time.Hour)
+	event := model.WinBackEvent{ID: "event:1", Title: "Board games", StartTime: time.Date(2026, 3, 7, 18, 0, 0, 0, time.UTC)}
+
+	repo, _ := winBackRepoFor(
+		[]*model.ChurnRiskCandidate{
+			{UserID: "user:opted-in", LastActive: away},
+			{UserID: "user:never", LastActive: away},
+		},
+		map[string][]model.WinBackEvent{"user:opted-in": {event}, "user:never": {event}},
+	)
+	mailer := &mockEmailSender{}
+	marketing := NewMarketingEmailService(MarketingEmailServiceConfig{
+		Mailer: mailer,
+		UserRepo: &mocks.MarketingEmailUserRepository{
+			GetByIDFunc: func(ctx context.Context, id string) (*model.User, error) {
+				return &model.User{ID: id, Email: id + "@example.com", EmailVerified: true}, nil
+			},
+		},
+		Consent: marketingConsents(t, "user:opted-in"),
+	})
+
+	svc := NewNudgeService(NudgeServiceConfig{
+		WinBackRepo:    repo,
+		MarketingEmail: marketing,
+		Clock:          fakeclock.New(now),
+	})
+	if err := svc.ProcessWinBackNudges(ctx); err != nil {
+		t.Fatalf("ProcessWinBackNudges() error = %v", err)
+	}
+
+	if len(mailer.sent) != 1 || mailer.sent[0].to != "user:opted-in@example.com" {
+		t.Errorf("sent %+v, want one email to the opted-in user", mailer.sent)
+	}
+}
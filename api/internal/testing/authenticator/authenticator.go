@@ -0,0 +1,167 @@
+package authenticator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Authenticator data flags set on every response
+const flags = 0x01 | 0x04 // User present and verified
+
+// Authenticator is a single passkey on a software authenticator
+type Authenticator struct {
+	t            *testing.T
+	rpID         string
+	origin       string
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+// Registration is a create() response, base64url-encoded as clients send it
+type Registration struct {
+	CredentialID      string
+	ClientDataJSON    string
+	AttestationObject string
+}
+
+// Assertion is a get() response, base64url-encoded as clients send it
+type Assertion struct {
+	CredentialID      string
+	ClientDataJSON    string
+	AuthenticatorData string
+	Signature         string
+	UserHandle        string
+}
+
+// New creates an authenticator holding a fresh ES256 passkey for rpID,
+// answering as origin
+func New(t *testing.T, rpID, origin string) *Authenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	credentialID := make([]byte, 16)
+	if _, err := rand.Read(credentialID); err != nil {
+		t.Fatalf("failed to generate credential ID: %v", err)
+	}
+	return &Authenticator{t: t, rpID: rpID, origin: origin, key: key, credentialID: credentialID}
+}
+
+// coseEncoding encodes keys the same way every time, so PublicKey can be
+// compared with what the authenticator registered
+var coseEncoding, _ = cbor.CoreDetEncOptions().EncMode()
+
+// CredentialID returns the passkey's credential ID, base64url-encoded
+func (a *Authenticator) CredentialID() string {
+	return base64.RawURLEncoding.EncodeToString(a.credentialID)
+}
+
+// PublicKey returns the passkey's public key as a COSE key
+func (a *Authenticator) PublicKey() []byte {
+	a.t.Helper()
+	raw, err := coseEncoding.Marshal(map[int]any{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: a.key.X.FillBytes(make([]byte, 32)),
+		-3: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		a.t.Fatalf("failed to encode public key: %v", err)
+	}
+	return raw
+}
+
+// Register answers a registration challenge
+func (a *Authenticator) Register(challenge string) Registration {
+	a.t.Helper()
+
+	authData := a.authData()
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, a.PublicKey()...)
+	authData[32] |= 0x40 // Attested credential data
+
+	attestation, err := cbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		a.t.Fatalf("failed to encode attestation: %v", err)
+	}
+
+	return Registration{
+		CredentialID:      a.CredentialID(),
+		ClientDataJSON:    encode(a.clientData("webauthn.create", challenge)),
+		AttestationObject: encode(attestation),
+	}
+}
+
+// Assert answers a login challenge, naming userID as the user handle when
+// it isn't empty. Each assertion advances the sign count.
+func (a *Authenticator) Assert(challenge, userID string) Assertion {
+	a.t.Helper()
+
+	a.signCount++
+	authData := a.authData()
+	clientData := a.clientData("webauthn.get", challenge)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		a.t.Fatalf("failed to sign assertion: %v", err)
+	}
+
+	assertion := Assertion{
+		CredentialID:      a.CredentialID(),
+		ClientDataJSON:    encode(clientData),
+		AuthenticatorData: encode(authData),
+		Signature:         encode(signature),
+	}
+	if userID != "" {
+		assertion.UserHandle = encode([]byte(userID))
+	}
+	return assertion
+}
+
+// SetSignCount sets the count the next assertion increments, e.g. to
+// simulate a cloned authenticator
+func (a *Authenticator) SetSignCount(count uint32) {
+	a.signCount = count
+}
+
+func (a *Authenticator) authData() []byte {
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	return binary.BigEndian.AppendUint32(data, a.signCount)
+}
+
+func (a *Authenticator) clientData(ceremony, challenge string) []byte {
+	a.t.Helper()
+	raw, err := json.Marshal(map[string]any{
+		"type":      ceremony,
+		"challenge": challenge,
+		"origin":    a.origin,
+	})
+	if err != nil {
+		a.t.Fatalf("failed to encode client data: %v", err)
+	}
+	return raw
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
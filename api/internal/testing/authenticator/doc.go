@@ -0,0 +1,18 @@
+// Package authenticator is a software WebAuthn authenticator for tests of
+// the Saga API's passkey flows.
+//
+// It holds an ES256 key, answers create() and get() challenges with real
+// attestation objects and signed assertions, and counts signatures like a
+// hardware authenticator, so tests exercise the same verification as
+// production.
+//
+// # Registering and Signing In
+//
+//	auth := authenticator.New(t, "localhost", "http://localhost:3000")
+//
+//	reg := auth.Register(start.Challenge)
+//	// Send reg.ClientDataJSON and reg.AttestationObject with ID reg.CredentialID
+//
+//	assertion := auth.Assert(login.Challenge, userID)
+//	assertion.Signature = tampered // To test rejection
+package authenticator
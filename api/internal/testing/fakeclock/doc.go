@@ -0,0 +1,13 @@
+// Package fakeclock provides a controllable clock for tests of the Saga API.
+//
+// The fake implements clock.Clock and only moves when told to, so tests of
+// expiry and scheduling are fast and deterministic.
+//
+// # Controlling Time
+//
+//	clk := fakeclock.New(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+//	svc := service.NewTokenService(service.TokenServiceConfig{Clock: clk, ...})
+//
+//	clk.Advance(31 * 24 * time.Hour) // Refresh tokens issued above have expired
+//	clk.Set(opensAt)                 // Jump to an exact instant
+package fakeclock
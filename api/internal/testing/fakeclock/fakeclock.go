@@ -0,0 +1,43 @@
+package fakeclock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/forgo/saga/api/internal/clock"
+)
+
+var _ clock.Clock = (*Clock)(nil)
+
+// Clock is a clock.Clock that only moves when told to. It is safe for
+// concurrent use.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// New creates a clock stopped at now
+func New(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// Now returns the clock's current time
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set moves the clock to t, forwards or backwards
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// Advance moves the clock forward by d
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,23 @@
+// Package golden compares JSON responses in tests against stored golden
+// files, so unintended serialization changes (renamed fields, dropped
+// omitempty, new nesting) fail a test instead of reaching clients.
+//
+// Golden files live in the package's testdata/golden directory, named after
+// the test. Before comparing, values that change between runs are replaced
+// with placeholders: RFC 3339 timestamps become "<time>" and UUIDs become
+// "<uuid>". Keys are sorted and the JSON is indented, so files diff well.
+//
+// # Comparing a Response
+//
+//	rr := httptest.NewRecorder()
+//	h.ServeHTTP(rr, req)
+//	golden.Response(t, rr)                        // testdata/golden/TestMe_ReturnsUser.json
+//	golden.Response(t, rr, golden.Ignore("id"))   // Record IDs generated by the code under test
+//
+// # Updating Golden Files
+//
+// When a change to the JSON is intended, rewrite the files and review the
+// diff before committing:
+//
+//	go test ./internal/handler -run TestMe -update
+package golden
@@ -0,0 +1,171 @@
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the actual output")
+
+// Placeholders for normalized values
+const (
+	Time    = "<time>"
+	UUID    = "<uuid>"
+	Ignored = "<ignored>"
+)
+
+var (
+	timePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`)
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// Option adjusts how JSON is normalized before comparing
+type Option func(*options)
+
+type options struct {
+	ignore map[string]bool
+}
+
+// Ignore replaces the values of fields with these names, at any depth, with
+// "<ignored>". Use it for values the code under test generates.
+func Ignore(keys ...string) Option {
+	return func(o *options) {
+		for _, key := range keys {
+			o.ignore[key] = true
+		}
+	}
+}
+
+// Response compares a recorded response's JSON body with the test's golden
+// file
+func Response(t *testing.T, rr *httptest.ResponseRecorder, opts ...Option) {
+	t.Helper()
+	JSON(t, rr.Body.Bytes(), opts...)
+}
+
+// JSON compares got with the test's golden file after normalizing both, or
+// rewrites the file when tests run with -update
+func JSON(t *testing.T, got []byte, opts ...Option) {
+	t.Helper()
+
+	o := &options{ignore: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	actual, err := normalize(got, o)
+	if err != nil {
+		t.Fatalf("golden: response is not JSON: %v\n%s", err, got)
+	}
+
+	path := Path(t)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("golden: %v", err)
+		}
+		if err := os.WriteFile(path, actual, 0o644); err != nil {
+			t.Fatalf("golden: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("golden: %s does not exist; run the test with -update to create it", path)
+	}
+	if err != nil {
+		t.Fatalf("golden: %v", err)
+	}
+	if !bytes.Equal(actual, want) {
+		t.Errorf("golden: response differs from %s (run with -update if the change is intended)\n%s", path, diff(want, actual))
+	}
+}
+
+// Path returns the golden file for the running test. Subtests get a
+// directory per parent test.
+func Path(t *testing.T) string {
+	return filepath.Join("testdata", "golden", filepath.FromSlash(t.Name())+".json")
+}
+
+// normalize parses JSON, masks volatile values and re-encodes it with
+// sorted keys and indentation
+func normalize(data []byte, o *options) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(mask(v, o)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func mask(v any, o *options) any {
+	switch x := v.(type) {
+	case map[string]any:
+		for key, value := range x {
+			if o.ignore[key] {
+				x[key] = Ignored
+				continue
+			}
+			x[key] = mask(value, o)
+		}
+		return x
+	case []any:
+		for i := range x {
+			x[i] = mask(x[i], o)
+		}
+		return x
+	case string:
+		switch {
+		case timePattern.MatchString(x):
+			return Time
+		case uuidPattern.MatchString(x):
+			return UUID
+		}
+		return x
+	default:
+		return v
+	}
+}
+
+// diff shows the first differing line with a little context
+func diff(want, got []byte) string {
+	wantLines := strings.Split(string(want), "\n")
+	gotLines := strings.Split(string(got), "\n")
+
+	line := 0
+	for line < len(wantLines) && line < len(gotLines) && wantLines[line] == gotLines[line] {
+		line++
+	}
+	start := max(0, line-3)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "first difference at line %d:\n", line+1)
+	for i := start; i < line; i++ {
+		fmt.Fprintf(&b, "  %s\n", wantLines[i])
+	}
+	if line < len(wantLines) {
+		fmt.Fprintf(&b, "- %s\n", wantLines[line])
+	}
+	if line < len(gotLines) {
+		fmt.Fprintf(&b, "+ %s\n", gotLines[line])
+	}
+	return b.String()
+}
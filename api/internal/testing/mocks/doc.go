@@ -0,0 +1,27 @@
+// Package mocks provides generated test doubles for the repository
+// interfaces declared by the service package.
+//
+// Each mock has a Func field per method. A method calls its field when set
+// and returns zero values otherwise, so a test only stubs what it exercises:
+//
+//	repo := &mocks.VoteRepository{
+//		GetByIDFunc: func(ctx context.Context, id string) (*model.Vote, error) {
+//			return vote, nil
+//		},
+//	}
+//	svc := service.NewVoteService(service.VoteServiceConfig{VoteRepo: repo})
+//
+// # Regenerating
+//
+// The mocks are generated from the interfaces, so adding a repository
+// method only needs:
+//
+//	go generate ./internal/testing/mocks
+//
+// This also rewrites internal/service/mocks_test.go, which fails to compile
+// if a mock no longer satisfies its interface. Interfaces whose methods use
+// types declared in the service package are skipped, since the service
+// tests that import this package can't be imported back.
+package mocks
+
+//go:generate go run ./gen
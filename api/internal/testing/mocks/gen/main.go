@@ -0,0 +1,383 @@
+// Command gen writes the mocks package from the repository interfaces the
+// service package declares. Run it through go generate:
+//
+//	go generate ./internal/testing/mocks
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by go generate ./internal/testing/mocks; DO NOT EDIT.\n\n"
+
+func main() {
+	src := flag.String("src", "../../service", "directory of the package declaring the interfaces")
+	out := flag.String("out", "repositories.go", "file to write the mocks to")
+	check := flag.String("check", "../../service/mocks_test.go", "file to write the interface assertions to")
+	flag.Parse()
+
+	pkg, err := load(*src)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := write(*out, pkg.mocks()); err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+	if err := write(*check, pkg.assertions()); err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+	for _, name := range pkg.skipped {
+		fmt.Fprintf(os.Stderr, "gen: skipped %s: its methods use types declared in package %s\n", name, pkg.name)
+	}
+}
+
+// iface is a repository interface and the mock generated for it
+type iface struct {
+	name    string // Interface name in the source package
+	mock    string // Mock type name
+	methods []method
+}
+
+type method struct {
+	name    string
+	params  []field
+	results []field
+}
+
+type field struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+type source struct {
+	name    string
+	ifaces  []iface
+	imports map[string]string // Package name -> import path, for types the mocks use
+	skipped []string
+}
+
+// load parses the non-test files in dir and collects every interface named
+// *Repository or *RepositoryInterface. Interfaces using types local to the
+// package are skipped: the mocks can't import it, as its tests import them.
+func load(dir string) (*source, error) {
+	fset := token.NewFileSet()
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &source{imports: make(map[string]string)}
+	decls := make(map[string]*ast.InterfaceType)
+	fileImports := make(map[string]map[string]string)
+	for _, path := range matches {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		s.name = f.Name.Name
+
+		imports := make(map[string]string)
+		for _, spec := range f.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := importPath[strings.LastIndex(importPath, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = importPath
+		}
+
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if it, ok := ts.Type.(*ast.InterfaceType); ok {
+					decls[ts.Name.Name] = it
+					fileImports[ts.Name.Name] = imports
+				}
+			}
+		}
+	}
+	if s.name == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+
+	names := make([]string, 0, len(decls))
+	for name := range decls {
+		if strings.HasSuffix(name, "Repository") || strings.HasSuffix(name, "RepositoryInterface") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		r := &resolver{fset: fset, decls: decls, fileImports: fileImports, used: make(map[string]string)}
+		methods, ok := r.methods(name, make(map[string]bool))
+		if !ok {
+			s.skipped = append(s.skipped, name)
+			continue
+		}
+		for pkgName, path := range r.used {
+			s.imports[pkgName] = path
+		}
+		s.ifaces = append(s.ifaces, iface{
+			name:    name,
+			mock:    strings.TrimSuffix(name, "Interface"),
+			methods: methods,
+		})
+	}
+	return s, nil
+}
+
+type resolver struct {
+	fset        *token.FileSet
+	decls       map[string]*ast.InterfaceType
+	fileImports map[string]map[string]string
+	used        map[string]string
+}
+
+// methods flattens the interface's method set, following embedded
+// interfaces declared in the same package. It reports false if a method
+// refers to a type the mocks package can't name.
+func (r *resolver) methods(name string, seen map[string]bool) ([]method, bool) {
+	it, ok := r.decls[name]
+	if !ok {
+		return nil, false
+	}
+	var methods []method
+	for _, m := range it.Methods.List {
+		switch t := m.Type.(type) {
+		case *ast.FuncType:
+			params, ok := r.fields(name, t.Params, "p")
+			if !ok {
+				return nil, false
+			}
+			results, ok := r.fields(name, t.Results, "r")
+			if !ok {
+				return nil, false
+			}
+			for _, n := range m.Names {
+				if seen[n.Name] {
+					continue
+				}
+				seen[n.Name] = true
+				methods = append(methods, method{name: n.Name, params: params, results: results})
+			}
+		case *ast.Ident:
+			embedded, ok := r.methods(t.Name, seen)
+			if !ok {
+				return nil, false
+			}
+			methods = append(methods, embedded...)
+		default:
+			return nil, false
+		}
+	}
+	return methods, true
+}
+
+func (r *resolver) fields(owner string, list *ast.FieldList, prefix string) ([]field, bool) {
+	if list == nil {
+		return nil, true
+	}
+	var fields []field
+	for _, f := range list.List {
+		typ := f.Type
+		variadic := false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ = ellipsis.Elt
+			variadic = true
+		}
+		if !r.resolvable(owner, typ) {
+			return nil, false
+		}
+		var buf bytes.Buffer
+		if err := format.Node(&buf, r.fset, typ); err != nil {
+			return nil, false
+		}
+
+		names := f.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+		for _, n := range names {
+			name := n.Name
+			if name == "_" {
+				name = prefix + strconv.Itoa(len(fields))
+			}
+			fields = append(fields, field{name: name, typ: buf.String(), variadic: variadic})
+		}
+	}
+	return fields, true
+}
+
+// resolvable records the imports typ needs and reports false if it names a
+// type declared in the source package
+func (r *resolver) resolvable(owner string, typ ast.Expr) bool {
+	ok := true
+	ast.Inspect(typ, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.SelectorExpr:
+			if pkg, isIdent := x.X.(*ast.Ident); isIdent {
+				path, found := r.fileImports[owner][pkg.Name]
+				if !found {
+					ok = false
+				}
+				r.used[pkg.Name] = path
+			}
+			return false
+		case *ast.Ident:
+			if !predeclared[x.Name] {
+				ok = false
+			}
+		}
+		return ok
+	})
+	return ok
+}
+
+var predeclared = map[string]bool{
+	"any": true, "bool": true, "byte": true, "comparable": true, "complex64": true,
+	"complex128": true, "error": true, "float32": true, "float64": true, "int": true,
+	"int8": true, "int16": true, "int32": true, "int64": true, "rune": true,
+	"string": true, "uint": true, "uint8": true, "uint16": true, "uint32": true,
+	"uint64": true, "uintptr": true,
+}
+
+// mocks renders the mocks package
+func (s *source) mocks() []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package mocks\n\nimport (\n")
+	paths := make([]string, 0, len(s.imports))
+	for name, path := range s.imports {
+		if base := path[strings.LastIndex(path, "/")+1:]; base != name {
+			path = name + " " + strconv.Quote(path)
+		} else {
+			path = strconv.Quote(path)
+		}
+		paths = append(paths, path)
+	}
+	// Standard library first, as goimports groups them
+	sort.Slice(paths, func(i, j int) bool {
+		if std(paths[i]) != std(paths[j]) {
+			return std(paths[i])
+		}
+		return paths[i] < paths[j]
+	})
+	for i, path := range paths {
+		if i > 0 && std(paths[i-1]) && !std(path) {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "\t%s\n", path)
+	}
+	b.WriteString(")\n")
+
+	for _, it := range s.ifaces {
+		fmt.Fprintf(&b, "\n// %s mocks %s.%s\n", it.mock, s.name, it.name)
+		fmt.Fprintf(&b, "type %s struct {\n", it.mock)
+		for _, m := range it.methods {
+			fmt.Fprintf(&b, "\t%sFunc func(%s) %s\n", m.name, signature(m.params), results(m.results, false))
+		}
+		b.WriteString("}\n")
+
+		for _, m := range it.methods {
+			fmt.Fprintf(&b, "\nfunc (m *%s) %s(%s) %s {\n", it.mock, m.name, signature(m.params), results(m.results, true))
+			fmt.Fprintf(&b, "\tif m.%sFunc != nil {\n", m.name)
+			call := fmt.Sprintf("m.%sFunc(%s)", m.name, arguments(m.params))
+			if len(m.results) > 0 {
+				fmt.Fprintf(&b, "\t\treturn %s\n\t}\n\treturn\n}\n", call)
+			} else {
+				fmt.Fprintf(&b, "\t\t%s\n\t}\n}\n", call)
+			}
+		}
+	}
+	return b.Bytes()
+}
+
+// assertions renders a test file in the source package that fails to
+// compile when a mock no longer satisfies its interface
+func (s *source) assertions() []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\nimport \"github.com/forgo/saga/api/internal/testing/mocks\"\n\nvar (\n", s.name)
+	for _, it := range s.ifaces {
+		fmt.Fprintf(&b, "\t_ %s = (*mocks.%s)(nil)\n", it.name, it.mock)
+	}
+	b.WriteString(")\n")
+	return b.Bytes()
+}
+
+// std reports whether an import line names a standard library package
+func std(importLine string) bool {
+	path := importLine[strings.Index(importLine, "\"")+1:]
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
+
+func signature(params []field) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		typ := p.typ
+		if p.variadic {
+			typ = "..." + typ
+		}
+		parts[i] = p.name + " " + typ
+	}
+	return strings.Join(parts, ", ")
+}
+
+// results renders a result list, naming the results so the mock can return
+// zero values with a bare return
+func results(fields []field, named bool) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		parts[i] = f.typ
+		if named {
+			parts[i] = "r" + strconv.Itoa(i) + " " + f.typ
+		}
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func arguments(params []field) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		parts[i] = p.name
+		if p.variadic {
+			parts[i] += "..."
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func write(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w\n%s", path, err, src)
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
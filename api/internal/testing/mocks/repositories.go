@@ -0,0 +1,5978 @@
+// Code generated by go generate ./internal/testing/mocks; DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/forgo/saga/api/internal/model"
+)
+
+// ActivityRepository mocks service.ActivityRepository
+type ActivityRepository struct {
+	CreateFunc    func(ctx context.Context, entry *model.ActivityEntry) error
+	GetByUserFunc func(ctx context.Context, userID string, before *time.Time, limit int) ([]*model.ActivityEntry, error)
+}
+
+func (m *ActivityRepository) Create(ctx context.Context, entry *model.ActivityEntry) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, entry)
+	}
+	return
+}
+
+func (m *ActivityRepository) GetByUser(ctx context.Context, userID string, before *time.Time, limit int) (r0 []*model.ActivityEntry, r1 error) {
+	if m.GetByUserFunc != nil {
+		return m.GetByUserFunc(ctx, userID, before, limit)
+	}
+	return
+}
+
+// AdminProfileRepository mocks service.AdminProfileRepository
+type AdminProfileRepository struct {
+	GetByUserIDFunc func(ctx context.Context, userID string) (*model.UserProfile, error)
+	DeleteFunc      func(ctx context.Context, userID string) error
+}
+
+func (m *AdminProfileRepository) GetByUserID(ctx context.Context, userID string) (r0 *model.UserProfile, r1 error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *AdminProfileRepository) Delete(ctx context.Context, userID string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID)
+	}
+	return
+}
+
+// AdminReportRepository mocks service.AdminReportRepository
+type AdminReportRepository struct {
+	CreateFunc       func(ctx context.Context, report *model.AdminReport) error
+	GetFunc          func(ctx context.Context, id string) (*model.AdminReport, error)
+	ListFunc         func(ctx context.Context, limit int) ([]*model.AdminReport, error)
+	ListByStatusFunc func(ctx context.Context, status model.AdminReportStatus, limit int) ([]*model.AdminReport, error)
+	ListExpiredFunc  func(ctx context.Context, now time.Time, limit int) ([]*model.AdminReport, error)
+	ClaimFunc        func(ctx context.Context, id string) (bool, error)
+	CompleteFunc     func(ctx context.Context, id string, objectKey string, rowCount int, expiresOn time.Time) error
+	FailFunc         func(ctx context.Context, id string, reason string) error
+	MarkExpiredFunc  func(ctx context.Context, id string) error
+}
+
+func (m *AdminReportRepository) Create(ctx context.Context, report *model.AdminReport) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, report)
+	}
+	return
+}
+
+func (m *AdminReportRepository) Get(ctx context.Context, id string) (r0 *model.AdminReport, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AdminReportRepository) List(ctx context.Context, limit int) (r0 []*model.AdminReport, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, limit)
+	}
+	return
+}
+
+func (m *AdminReportRepository) ListByStatus(ctx context.Context, status model.AdminReportStatus, limit int) (r0 []*model.AdminReport, r1 error) {
+	if m.ListByStatusFunc != nil {
+		return m.ListByStatusFunc(ctx, status, limit)
+	}
+	return
+}
+
+func (m *AdminReportRepository) ListExpired(ctx context.Context, now time.Time, limit int) (r0 []*model.AdminReport, r1 error) {
+	if m.ListExpiredFunc != nil {
+		return m.ListExpiredFunc(ctx, now, limit)
+	}
+	return
+}
+
+func (m *AdminReportRepository) Claim(ctx context.Context, id string) (r0 bool, r1 error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AdminReportRepository) Complete(ctx context.Context, id string, objectKey string, rowCount int, expiresOn time.Time) (r0 error) {
+	if m.CompleteFunc != nil {
+		return m.CompleteFunc(ctx, id, objectKey, rowCount, expiresOn)
+	}
+	return
+}
+
+func (m *AdminReportRepository) Fail(ctx context.Context, id string, reason string) (r0 error) {
+	if m.FailFunc != nil {
+		return m.FailFunc(ctx, id, reason)
+	}
+	return
+}
+
+func (m *AdminReportRepository) MarkExpired(ctx context.Context, id string) (r0 error) {
+	if m.MarkExpiredFunc != nil {
+		return m.MarkExpiredFunc(ctx, id)
+	}
+	return
+}
+
+// AdminUserRepository mocks service.AdminUserRepository
+type AdminUserRepository struct {
+	GetByIDFunc func(ctx context.Context, id string) (*model.User, error)
+	SetRoleFunc func(ctx context.Context, userID string, role model.UserRole) error
+	DeleteFunc  func(ctx context.Context, id string) error
+}
+
+func (m *AdminUserRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AdminUserRepository) SetRole(ctx context.Context, userID string, role model.UserRole) (r0 error) {
+	if m.SetRoleFunc != nil {
+		return m.SetRoleFunc(ctx, userID, role)
+	}
+	return
+}
+
+func (m *AdminUserRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// AdventureAdmissionRepository mocks service.AdventureAdmissionRepository
+type AdventureAdmissionRepository struct {
+	CreateFunc                func(ctx context.Context, admission *model.AdventureAdmission) error
+	GetByIDFunc               func(ctx context.Context, id string) (*model.AdventureAdmission, error)
+	GetByAdventureAndUserFunc func(ctx context.Context, adventureID string, userID string) (*model.AdventureAdmission, error)
+	GetByAdventureFunc        func(ctx context.Context, adventureID string, status *model.AdventureAdmissionStatus, limit int, offset int) ([]*model.AdventureAdmission, error)
+	GetByUserFunc             func(ctx context.Context, userID string, status *model.AdventureAdmissionStatus) ([]*model.AdventureAdmission, error)
+	GetAdmittedUsersFunc      func(ctx context.Context, adventureID string) ([]*model.AdventureAdmission, error)
+	GetPendingRequestsFunc    func(ctx context.Context, adventureID string) ([]*model.AdventureAdmission, error)
+	UpdateFunc                func(ctx context.Context, id string, status model.AdventureAdmissionStatus, rejectionReason *string) (*model.AdventureAdmission, error)
+	AdmitFunc                 func(ctx context.Context, id string) (*model.AdventureAdmission, error)
+	RejectFunc                func(ctx context.Context, id string, reason string) (*model.AdventureAdmission, error)
+	DeleteFunc                func(ctx context.Context, id string) error
+	IsAdmittedFunc            func(ctx context.Context, adventureID string, userID string) (bool, error)
+	CountAdmittedFunc         func(ctx context.Context, adventureID string) (int, error)
+}
+
+func (m *AdventureAdmissionRepository) Create(ctx context.Context, admission *model.AdventureAdmission) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, admission)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) GetByID(ctx context.Context, id string) (r0 *model.AdventureAdmission, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) GetByAdventureAndUser(ctx context.Context, adventureID string, userID string) (r0 *model.AdventureAdmission, r1 error) {
+	if m.GetByAdventureAndUserFunc != nil {
+		return m.GetByAdventureAndUserFunc(ctx, adventureID, userID)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) GetByAdventure(ctx context.Context, adventureID string, status *model.AdventureAdmissionStatus, limit int, offset int) (r0 []*model.AdventureAdmission, r1 error) {
+	if m.GetByAdventureFunc != nil {
+		return m.GetByAdventureFunc(ctx, adventureID, status, limit, offset)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) GetByUser(ctx context.Context, userID string, status *model.AdventureAdmissionStatus) (r0 []*model.AdventureAdmission, r1 error) {
+	if m.GetByUserFunc != nil {
+		return m.GetByUserFunc(ctx, userID, status)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) GetAdmittedUsers(ctx context.Context, adventureID string) (r0 []*model.AdventureAdmission, r1 error) {
+	if m.GetAdmittedUsersFunc != nil {
+		return m.GetAdmittedUsersFunc(ctx, adventureID)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) GetPendingRequests(ctx context.Context, adventureID string) (r0 []*model.AdventureAdmission, r1 error) {
+	if m.GetPendingRequestsFunc != nil {
+		return m.GetPendingRequestsFunc(ctx, adventureID)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) Update(ctx context.Context, id string, status model.AdventureAdmissionStatus, rejectionReason *string) (r0 *model.AdventureAdmission, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, status, rejectionReason)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) Admit(ctx context.Context, id string) (r0 *model.AdventureAdmission, r1 error) {
+	if m.AdmitFunc != nil {
+		return m.AdmitFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) Reject(ctx context.Context, id string, reason string) (r0 *model.AdventureAdmission, r1 error) {
+	if m.RejectFunc != nil {
+		return m.RejectFunc(ctx, id, reason)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) IsAdmitted(ctx context.Context, adventureID string, userID string) (r0 bool, r1 error) {
+	if m.IsAdmittedFunc != nil {
+		return m.IsAdmittedFunc(ctx, adventureID, userID)
+	}
+	return
+}
+
+func (m *AdventureAdmissionRepository) CountAdmitted(ctx context.Context, adventureID string) (r0 int, r1 error) {
+	if m.CountAdmittedFunc != nil {
+		return m.CountAdmittedFunc(ctx, adventureID)
+	}
+	return
+}
+
+// AdventureRepository mocks service.AdventureRepository
+type AdventureRepository struct {
+	GetByIDFunc             func(ctx context.Context, id string) (*model.Adventure, error)
+	GetByGuildFunc          func(ctx context.Context, guildID string, limit int, offset int) ([]*model.Adventure, error)
+	GetByUserFunc           func(ctx context.Context, userID string, limit int, offset int) ([]*model.Adventure, error)
+	CreateFunc              func(ctx context.Context, adventure *model.Adventure) error
+	DeleteFunc              func(ctx context.Context, id string) error
+	UpdateFunc              func(ctx context.Context, id string, updates map[string]interface{}) (*model.Adventure, error)
+	UpdateOrganizerUserFunc func(ctx context.Context, id string, newOrganizerUserID string) (*model.Adventure, error)
+	FreezeFunc              func(ctx context.Context, id string, reason string) (*model.Adventure, error)
+	UnfreezeFunc            func(ctx context.Context, id string) (*model.Adventure, error)
+}
+
+func (m *AdventureRepository) GetByID(ctx context.Context, id string) (r0 *model.Adventure, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AdventureRepository) GetByGuild(ctx context.Context, guildID string, limit int, offset int) (r0 []*model.Adventure, r1 error) {
+	if m.GetByGuildFunc != nil {
+		return m.GetByGuildFunc(ctx, guildID, limit, offset)
+	}
+	return
+}
+
+func (m *AdventureRepository) GetByUser(ctx context.Context, userID string, limit int, offset int) (r0 []*model.Adventure, r1 error) {
+	if m.GetByUserFunc != nil {
+		return m.GetByUserFunc(ctx, userID, limit, offset)
+	}
+	return
+}
+
+func (m *AdventureRepository) Create(ctx context.Context, adventure *model.Adventure) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, adventure)
+	}
+	return
+}
+
+func (m *AdventureRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AdventureRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.Adventure, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *AdventureRepository) UpdateOrganizerUser(ctx context.Context, id string, newOrganizerUserID string) (r0 *model.Adventure, r1 error) {
+	if m.UpdateOrganizerUserFunc != nil {
+		return m.UpdateOrganizerUserFunc(ctx, id, newOrganizerUserID)
+	}
+	return
+}
+
+func (m *AdventureRepository) Freeze(ctx context.Context, id string, reason string) (r0 *model.Adventure, r1 error) {
+	if m.FreezeFunc != nil {
+		return m.FreezeFunc(ctx, id, reason)
+	}
+	return
+}
+
+func (m *AdventureRepository) Unfreeze(ctx context.Context, id string) (r0 *model.Adventure, r1 error) {
+	if m.UnfreezeFunc != nil {
+		return m.UnfreezeFunc(ctx, id)
+	}
+	return
+}
+
+// AnalyticsEventRepository mocks service.AnalyticsEventRepository
+type AnalyticsEventRepository struct {
+	CreateFunc   func(ctx context.Context, event *model.AnalyticsEvent) error
+	GetRangeFunc func(ctx context.Context, since time.Time, until time.Time, offset int, limit int) ([]*model.AnalyticsEvent, error)
+}
+
+func (m *AnalyticsEventRepository) Create(ctx context.Context, event *model.AnalyticsEvent) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, event)
+	}
+	return
+}
+
+func (m *AnalyticsEventRepository) GetRange(ctx context.Context, since time.Time, until time.Time, offset int, limit int) (r0 []*model.AnalyticsEvent, r1 error) {
+	if m.GetRangeFunc != nil {
+		return m.GetRangeFunc(ctx, since, until, offset, limit)
+	}
+	return
+}
+
+// AnalyticsRepository mocks service.AnalyticsRepository
+type AnalyticsRepository struct {
+	GetAttendanceHistoryFunc func(ctx context.Context, filter model.AttendanceHistoryFilter) ([]*model.AttendanceSample, error)
+}
+
+func (m *AnalyticsRepository) GetAttendanceHistory(ctx context.Context, filter model.AttendanceHistoryFilter) (r0 []*model.AttendanceSample, r1 error) {
+	if m.GetAttendanceHistoryFunc != nil {
+		return m.GetAttendanceHistoryFunc(ctx, filter)
+	}
+	return
+}
+
+// AnnouncementGuildRepository mocks service.AnnouncementGuildRepository
+type AnnouncementGuildRepository struct {
+	GetByIDFunc      func(ctx context.Context, id string) (*model.Guild, error)
+	IsMemberFunc     func(ctx context.Context, userID string, guildID string) (bool, error)
+	IsGuildAdminFunc func(ctx context.Context, userID string, guildID string) (bool, error)
+	GetMembersFunc   func(ctx context.Context, guildID string) ([]*model.Member, error)
+}
+
+func (m *AnnouncementGuildRepository) GetByID(ctx context.Context, id string) (r0 *model.Guild, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AnnouncementGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *AnnouncementGuildRepository) IsGuildAdmin(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildAdminFunc != nil {
+		return m.IsGuildAdminFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *AnnouncementGuildRepository) GetMembers(ctx context.Context, guildID string) (r0 []*model.Member, r1 error) {
+	if m.GetMembersFunc != nil {
+		return m.GetMembersFunc(ctx, guildID)
+	}
+	return
+}
+
+// AnnouncementRepository mocks service.AnnouncementRepository
+type AnnouncementRepository struct {
+	CreateFunc             func(ctx context.Context, announcement *model.Announcement) error
+	GetByIDFunc            func(ctx context.Context, id string) (*model.Announcement, error)
+	ListFunc               func(ctx context.Context, limit int) ([]*model.Announcement, error)
+	ListByGuildFunc        func(ctx context.Context, guildID string, sentOnly bool, limit int) ([]*model.Announcement, error)
+	UpdateFunc             func(ctx context.Context, id string, updates map[string]interface{}) (*model.Announcement, error)
+	DeleteFunc             func(ctx context.Context, id string) error
+	ClaimDueFunc           func(ctx context.Context, now time.Time, staleBefore time.Time) ([]*model.Announcement, error)
+	MarkSentFunc           func(ctx context.Context, id string, recipientCount int, sentOn time.Time) error
+	ListUserIDsFunc        func(ctx context.Context, after string, limit int) ([]string, error)
+	ListLocationsInBoxFunc func(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, after string, limit int) ([]*model.AnnouncementRecipientLocation, error)
+	CreateReceiptFunc      func(ctx context.Context, announcementID string, userID string) error
+	GetInboxFunc           func(ctx context.Context, userID string, limit int) ([]*model.AnnouncementReceipt, error)
+	CountUnreadFunc        func(ctx context.Context, userID string) (int, error)
+	MarkReadFunc           func(ctx context.Context, announcementID string, userID string) (bool, error)
+}
+
+func (m *AnnouncementRepository) Create(ctx context.Context, announcement *model.Announcement) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, announcement)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) GetByID(ctx context.Context, id string) (r0 *model.Announcement, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) List(ctx context.Context, limit int) (r0 []*model.Announcement, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, limit)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) ListByGuild(ctx context.Context, guildID string, sentOnly bool, limit int) (r0 []*model.Announcement, r1 error) {
+	if m.ListByGuildFunc != nil {
+		return m.ListByGuildFunc(ctx, guildID, sentOnly, limit)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.Announcement, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) ClaimDue(ctx context.Context, now time.Time, staleBefore time.Time) (r0 []*model.Announcement, r1 error) {
+	if m.ClaimDueFunc != nil {
+		return m.ClaimDueFunc(ctx, now, staleBefore)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) MarkSent(ctx context.Context, id string, recipientCount int, sentOn time.Time) (r0 error) {
+	if m.MarkSentFunc != nil {
+		return m.MarkSentFunc(ctx, id, recipientCount, sentOn)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) ListUserIDs(ctx context.Context, after string, limit int) (r0 []string, r1 error) {
+	if m.ListUserIDsFunc != nil {
+		return m.ListUserIDsFunc(ctx, after, limit)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) ListLocationsInBox(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, after string, limit int) (r0 []*model.AnnouncementRecipientLocation, r1 error) {
+	if m.ListLocationsInBoxFunc != nil {
+		return m.ListLocationsInBoxFunc(ctx, minLat, maxLat, minLng, maxLng, after, limit)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) CreateReceipt(ctx context.Context, announcementID string, userID string) (r0 error) {
+	if m.CreateReceiptFunc != nil {
+		return m.CreateReceiptFunc(ctx, announcementID, userID)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) GetInbox(ctx context.Context, userID string, limit int) (r0 []*model.AnnouncementReceipt, r1 error) {
+	if m.GetInboxFunc != nil {
+		return m.GetInboxFunc(ctx, userID, limit)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) CountUnread(ctx context.Context, userID string) (r0 int, r1 error) {
+	if m.CountUnreadFunc != nil {
+		return m.CountUnreadFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *AnnouncementRepository) MarkRead(ctx context.Context, announcementID string, userID string) (r0 bool, r1 error) {
+	if m.MarkReadFunc != nil {
+		return m.MarkReadFunc(ctx, announcementID, userID)
+	}
+	return
+}
+
+// AvailabilityEventRepository mocks service.AvailabilityEventRepository
+type AvailabilityEventRepository struct {
+	GetByGuildFunc func(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error)
+}
+
+func (m *AvailabilityEventRepository) GetByGuild(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) (r0 []*model.Event, r1 error) {
+	if m.GetByGuildFunc != nil {
+		return m.GetByGuildFunc(ctx, guildID, filters, list)
+	}
+	return
+}
+
+// AvailabilityGuildRepository mocks service.AvailabilityGuildRepository
+type AvailabilityGuildRepository struct {
+	IsMemberFunc func(ctx context.Context, userID string, guildID string) (bool, error)
+}
+
+func (m *AvailabilityGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// AvailabilityRepository mocks service.AvailabilityRepository
+type AvailabilityRepository struct {
+	CreateFunc                     func(ctx context.Context, av *model.Availability) error
+	GetByIDFunc                    func(ctx context.Context, id string) (*model.Availability, error)
+	GetByUserFunc                  func(ctx context.Context, userID string) ([]*model.Availability, error)
+	GetNearbyFunc                  func(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, startTime time.Time, endTime time.Time, excludeUserID string, limit int) ([]*model.Availability, error)
+	GetByHangoutTypeFunc           func(ctx context.Context, hangoutType string, excludeUserID string, limit int) ([]*model.Availability, error)
+	UpdateFunc                     func(ctx context.Context, id string, updates map[string]interface{}) (*model.Availability, error)
+	DeleteFunc                     func(ctx context.Context, id string) error
+	MarkPendingDeleteFunc          func(ctx context.Context, id string, until time.Time) error
+	CreateHangoutRequestFunc       func(ctx context.Context, req *model.HangoutRequest) error
+	GetHangoutRequestFunc          func(ctx context.Context, id string) (*model.HangoutRequest, error)
+	GetPendingRequestsFunc         func(ctx context.Context, availabilityID string) ([]*model.HangoutRequest, error)
+	UpdateHangoutRequestStatusFunc func(ctx context.Context, id string, status string) error
+	CreateHangoutFunc              func(ctx context.Context, hangout *model.Hangout) error
+	GetHangoutFunc                 func(ctx context.Context, id string) (*model.Hangout, error)
+	GetUserHangoutsFunc            func(ctx context.Context, userID string, limit int) ([]*model.Hangout, error)
+	UpdateHangoutStatusFunc        func(ctx context.Context, id string, status string) error
+	GetStaleHangoutsFunc           func(ctx context.Context, cutoff time.Time, status string) ([]*model.Hangout, error)
+	GetUpcomingHangoutsFunc        func(ctx context.Context, windowStart time.Time, windowEnd time.Time) ([]*model.Hangout, error)
+	GetAllPendingRequestsFunc      func(ctx context.Context) ([]*model.HangoutRequest, error)
+	GetPendingRequestsForUserFunc  func(ctx context.Context, userID string) ([]*model.HangoutRequest, error)
+	GetUserUpcomingHangoutsFunc    func(ctx context.Context, userID string, windowStart time.Time, windowEnd time.Time) ([]*model.Hangout, error)
+	GetByGuildFunc                 func(ctx context.Context, guildID string, limit int) ([]*model.Availability, error)
+	GetByGuildInRangeFunc          func(ctx context.Context, guildID string, since time.Time, until time.Time) ([]*model.Availability, error)
+}
+
+func (m *AvailabilityRepository) Create(ctx context.Context, av *model.Availability) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, av)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetByID(ctx context.Context, id string) (r0 *model.Availability, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetByUser(ctx context.Context, userID string) (r0 []*model.Availability, r1 error) {
+	if m.GetByUserFunc != nil {
+		return m.GetByUserFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetNearby(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, startTime time.Time, endTime time.Time, excludeUserID string, limit int) (r0 []*model.Availability, r1 error) {
+	if m.GetNearbyFunc != nil {
+		return m.GetNearbyFunc(ctx, minLat, maxLat, minLng, maxLng, startTime, endTime, excludeUserID, limit)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetByHangoutType(ctx context.Context, hangoutType string, excludeUserID string, limit int) (r0 []*model.Availability, r1 error) {
+	if m.GetByHangoutTypeFunc != nil {
+		return m.GetByHangoutTypeFunc(ctx, hangoutType, excludeUserID, limit)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.Availability, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) MarkPendingDelete(ctx context.Context, id string, until time.Time) (r0 error) {
+	if m.MarkPendingDeleteFunc != nil {
+		return m.MarkPendingDeleteFunc(ctx, id, until)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) CreateHangoutRequest(ctx context.Context, req *model.HangoutRequest) (r0 error) {
+	if m.CreateHangoutRequestFunc != nil {
+		return m.CreateHangoutRequestFunc(ctx, req)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetHangoutRequest(ctx context.Context, id string) (r0 *model.HangoutRequest, r1 error) {
+	if m.GetHangoutRequestFunc != nil {
+		return m.GetHangoutRequestFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetPendingRequests(ctx context.Context, availabilityID string) (r0 []*model.HangoutRequest, r1 error) {
+	if m.GetPendingRequestsFunc != nil {
+		return m.GetPendingRequestsFunc(ctx, availabilityID)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) UpdateHangoutRequestStatus(ctx context.Context, id string, status string) (r0 error) {
+	if m.UpdateHangoutRequestStatusFunc != nil {
+		return m.UpdateHangoutRequestStatusFunc(ctx, id, status)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) CreateHangout(ctx context.Context, hangout *model.Hangout) (r0 error) {
+	if m.CreateHangoutFunc != nil {
+		return m.CreateHangoutFunc(ctx, hangout)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetHangout(ctx context.Context, id string) (r0 *model.Hangout, r1 error) {
+	if m.GetHangoutFunc != nil {
+		return m.GetHangoutFunc(ctx, id)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetUserHangouts(ctx context.Context, userID string, limit int) (r0 []*model.Hangout, r1 error) {
+	if m.GetUserHangoutsFunc != nil {
+		return m.GetUserHangoutsFunc(ctx, userID, limit)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) UpdateHangoutStatus(ctx context.Context, id string, status string) (r0 error) {
+	if m.UpdateHangoutStatusFunc != nil {
+		return m.UpdateHangoutStatusFunc(ctx, id, status)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetStaleHangouts(ctx context.Context, cutoff time.Time, status string) (r0 []*model.Hangout, r1 error) {
+	if m.GetStaleHangoutsFunc != nil {
+		return m.GetStaleHangoutsFunc(ctx, cutoff, status)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetUpcomingHangouts(ctx context.Context, windowStart time.Time, windowEnd time.Time) (r0 []*model.Hangout, r1 error) {
+	if m.GetUpcomingHangoutsFunc != nil {
+		return m.GetUpcomingHangoutsFunc(ctx, windowStart, windowEnd)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetAllPendingRequests(ctx context.Context) (r0 []*model.HangoutRequest, r1 error) {
+	if m.GetAllPendingRequestsFunc != nil {
+		return m.GetAllPendingRequestsFunc(ctx)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetPendingRequestsForUser(ctx context.Context, userID string) (r0 []*model.HangoutRequest, r1 error) {
+	if m.GetPendingRequestsForUserFunc != nil {
+		return m.GetPendingRequestsForUserFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetUserUpcomingHangouts(ctx context.Context, userID string, windowStart time.Time, windowEnd time.Time) (r0 []*model.Hangout, r1 error) {
+	if m.GetUserUpcomingHangoutsFunc != nil {
+		return m.GetUserUpcomingHangoutsFunc(ctx, userID, windowStart, windowEnd)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetByGuild(ctx context.Context, guildID string, limit int) (r0 []*model.Availability, r1 error) {
+	if m.GetByGuildFunc != nil {
+		return m.GetByGuildFunc(ctx, guildID, limit)
+	}
+	return
+}
+
+func (m *AvailabilityRepository) GetByGuildInRange(ctx context.Context, guildID string, since time.Time, until time.Time) (r0 []*model.Availability, r1 error) {
+	if m.GetByGuildInRangeFunc != nil {
+		return m.GetByGuildInRangeFunc(ctx, guildID, since, until)
+	}
+	return
+}
+
+// ClientErrorRepository mocks service.ClientErrorRepository
+type ClientErrorRepository struct {
+	CreateFunc    func(ctx context.Context, report *model.ClientError) error
+	ListFunc      func(ctx context.Context, filter model.ClientErrorFilter) ([]*model.ClientError, error)
+	SummarizeFunc func(ctx context.Context, filter model.ClientErrorFilter) ([]*model.ClientErrorGroup, error)
+}
+
+func (m *ClientErrorRepository) Create(ctx context.Context, report *model.ClientError) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, report)
+	}
+	return
+}
+
+func (m *ClientErrorRepository) List(ctx context.Context, filter model.ClientErrorFilter) (r0 []*model.ClientError, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filter)
+	}
+	return
+}
+
+func (m *ClientErrorRepository) Summarize(ctx context.Context, filter model.ClientErrorFilter) (r0 []*model.ClientErrorGroup, r1 error) {
+	if m.SummarizeFunc != nil {
+		return m.SummarizeFunc(ctx, filter)
+	}
+	return
+}
+
+// ConsentRepository mocks service.ConsentRepository
+type ConsentRepository struct {
+	CreateFunc     func(ctx context.Context, consent *model.Consent) error
+	GetLatestFunc  func(ctx context.Context, userID string, purpose model.ConsentPurpose) (*model.Consent, error)
+	ListByUserFunc func(ctx context.Context, userID string) ([]*model.Consent, error)
+}
+
+func (m *ConsentRepository) Create(ctx context.Context, consent *model.Consent) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, consent)
+	}
+	return
+}
+
+func (m *ConsentRepository) GetLatest(ctx context.Context, userID string, purpose model.ConsentPurpose) (r0 *model.Consent, r1 error) {
+	if m.GetLatestFunc != nil {
+		return m.GetLatestFunc(ctx, userID, purpose)
+	}
+	return
+}
+
+func (m *ConsentRepository) ListByUser(ctx context.Context, userID string) (r0 []*model.Consent, r1 error) {
+	if m.ListByUserFunc != nil {
+		return m.ListByUserFunc(ctx, userID)
+	}
+	return
+}
+
+// ContentQuestionRepository mocks service.ContentQuestionRepository
+type ContentQuestionRepository struct {
+	GetQuestionByIDFunc           func(ctx context.Context, id string) (*model.Question, error)
+	CreateQuestionFunc            func(ctx context.Context, question *model.Question) error
+	ListQuestionsFunc             func(ctx context.Context, includeInactive bool) ([]*model.Question, error)
+	UpdateQuestionFunc            func(ctx context.Context, id string, updates map[string]interface{}) (*model.Question, error)
+	DeleteQuestionFunc            func(ctx context.Context, id string) error
+	CountAnswersForQuestionFunc   func(ctx context.Context, id string) (int, error)
+	GetAnswerCountsByQuestionFunc func(ctx context.Context) (map[string]int, error)
+}
+
+func (m *ContentQuestionRepository) GetQuestionByID(ctx context.Context, id string) (r0 *model.Question, r1 error) {
+	if m.GetQuestionByIDFunc != nil {
+		return m.GetQuestionByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *ContentQuestionRepository) CreateQuestion(ctx context.Context, question *model.Question) (r0 error) {
+	if m.CreateQuestionFunc != nil {
+		return m.CreateQuestionFunc(ctx, question)
+	}
+	return
+}
+
+func (m *ContentQuestionRepository) ListQuestions(ctx context.Context, includeInactive bool) (r0 []*model.Question, r1 error) {
+	if m.ListQuestionsFunc != nil {
+		return m.ListQuestionsFunc(ctx, includeInactive)
+	}
+	return
+}
+
+func (m *ContentQuestionRepository) UpdateQuestion(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.Question, r1 error) {
+	if m.UpdateQuestionFunc != nil {
+		return m.UpdateQuestionFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *ContentQuestionRepository) DeleteQuestion(ctx context.Context, id string) (r0 error) {
+	if m.DeleteQuestionFunc != nil {
+		return m.DeleteQuestionFunc(ctx, id)
+	}
+	return
+}
+
+func (m *ContentQuestionRepository) CountAnswersForQuestion(ctx context.Context, id string) (r0 int, r1 error) {
+	if m.CountAnswersForQuestionFunc != nil {
+		return m.CountAnswersForQuestionFunc(ctx, id)
+	}
+	return
+}
+
+func (m *ContentQuestionRepository) GetAnswerCountsByQuestion(ctx context.Context) (r0 map[string]int, r1 error) {
+	if m.GetAnswerCountsByQuestionFunc != nil {
+		return m.GetAnswerCountsByQuestionFunc(ctx)
+	}
+	return
+}
+
+// ContentRepository mocks service.ContentRepository
+type ContentRepository struct {
+	ListQuestionCategoriesFunc   func(ctx context.Context) ([]*model.QuestionCategory, error)
+	GetQuestionCategoryFunc      func(ctx context.Context, key string) (*model.QuestionCategory, error)
+	CreateQuestionCategoryFunc   func(ctx context.Context, category *model.QuestionCategory) error
+	UpdateQuestionCategoryFunc   func(ctx context.Context, key string, updates map[string]interface{}) (*model.QuestionCategory, error)
+	DeleteQuestionCategoryFunc   func(ctx context.Context, key string) error
+	CountQuestionsInCategoryFunc func(ctx context.Context, key string) (int, error)
+	ListReviewTagsFunc           func(ctx context.Context, kind model.ReviewTagKind) ([]*model.ReviewTag, error)
+	GetReviewTagFunc             func(ctx context.Context, kind model.ReviewTagKind, tag string) (*model.ReviewTag, error)
+	CreateReviewTagFunc          func(ctx context.Context, tag *model.ReviewTag) error
+	UpdateReviewTagFunc          func(ctx context.Context, kind model.ReviewTagKind, tag string, updates map[string]interface{}) (*model.ReviewTag, error)
+	DeleteReviewTagFunc          func(ctx context.Context, kind model.ReviewTagKind, tag string) error
+	CountReviewsWithTagFunc      func(ctx context.Context, kind model.ReviewTagKind, tag string) (int, error)
+}
+
+func (m *ContentRepository) ListQuestionCategories(ctx context.Context) (r0 []*model.QuestionCategory, r1 error) {
+	if m.ListQuestionCategoriesFunc != nil {
+		return m.ListQuestionCategoriesFunc(ctx)
+	}
+	return
+}
+
+func (m *ContentRepository) GetQuestionCategory(ctx context.Context, key string) (r0 *model.QuestionCategory, r1 error) {
+	if m.GetQuestionCategoryFunc != nil {
+		return m.GetQuestionCategoryFunc(ctx, key)
+	}
+	return
+}
+
+func (m *ContentRepository) CreateQuestionCategory(ctx context.Context, category *model.QuestionCategory) (r0 error) {
+	if m.CreateQuestionCategoryFunc != nil {
+		return m.CreateQuestionCategoryFunc(ctx, category)
+	}
+	return
+}
+
+func (m *ContentRepository) UpdateQuestionCategory(ctx context.Context, key string, updates map[string]interface{}) (r0 *model.QuestionCategory, r1 error) {
+	if m.UpdateQuestionCategoryFunc != nil {
+		return m.UpdateQuestionCategoryFunc(ctx, key, updates)
+	}
+	return
+}
+
+func (m *ContentRepository) DeleteQuestionCategory(ctx context.Context, key string) (r0 error) {
+	if m.DeleteQuestionCategoryFunc != nil {
+		return m.DeleteQuestionCategoryFunc(ctx, key)
+	}
+	return
+}
+
+func (m *ContentRepository) CountQuestionsInCategory(ctx context.Context, key string) (r0 int, r1 error) {
+	if m.CountQuestionsInCategoryFunc != nil {
+		return m.CountQuestionsInCategoryFunc(ctx, key)
+	}
+	return
+}
+
+func (m *ContentRepository) ListReviewTags(ctx context.Context, kind model.ReviewTagKind) (r0 []*model.ReviewTag, r1 error) {
+	if m.ListReviewTagsFunc != nil {
+		return m.ListReviewTagsFunc(ctx, kind)
+	}
+	return
+}
+
+func (m *ContentRepository) GetReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) (r0 *model.ReviewTag, r1 error) {
+	if m.GetReviewTagFunc != nil {
+		return m.GetReviewTagFunc(ctx, kind, tag)
+	}
+	return
+}
+
+func (m *ContentRepository) CreateReviewTag(ctx context.Context, tag *model.ReviewTag) (r0 error) {
+	if m.CreateReviewTagFunc != nil {
+		return m.CreateReviewTagFunc(ctx, tag)
+	}
+	return
+}
+
+func (m *ContentRepository) UpdateReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string, updates map[string]interface{}) (r0 *model.ReviewTag, r1 error) {
+	if m.UpdateReviewTagFunc != nil {
+		return m.UpdateReviewTagFunc(ctx, kind, tag, updates)
+	}
+	return
+}
+
+func (m *ContentRepository) DeleteReviewTag(ctx context.Context, kind model.ReviewTagKind, tag string) (r0 error) {
+	if m.DeleteReviewTagFunc != nil {
+		return m.DeleteReviewTagFunc(ctx, kind, tag)
+	}
+	return
+}
+
+func (m *ContentRepository) CountReviewsWithTag(ctx context.Context, kind model.ReviewTagKind, tag string) (r0 int, r1 error) {
+	if m.CountReviewsWithTagFunc != nil {
+		return m.CountReviewsWithTagFunc(ctx, kind, tag)
+	}
+	return
+}
+
+// DevicePairingRepository mocks service.DevicePairingRepository
+type DevicePairingRepository struct {
+	CreateFunc        func(ctx context.Context, pairing *model.DevicePairing) error
+	GetByIDFunc       func(ctx context.Context, id string) (*model.DevicePairing, error)
+	ApproveFunc       func(ctx context.Context, id string, userID string) (*model.DevicePairing, error)
+	ClaimFunc         func(ctx context.Context, id string) (*model.DevicePairing, error)
+	DeleteExpiredFunc func(ctx context.Context, now time.Time) (int, error)
+}
+
+func (m *DevicePairingRepository) Create(ctx context.Context, pairing *model.DevicePairing) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, pairing)
+	}
+	return
+}
+
+func (m *DevicePairingRepository) GetByID(ctx context.Context, id string) (r0 *model.DevicePairing, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *DevicePairingRepository) Approve(ctx context.Context, id string, userID string) (r0 *model.DevicePairing, r1 error) {
+	if m.ApproveFunc != nil {
+		return m.ApproveFunc(ctx, id, userID)
+	}
+	return
+}
+
+func (m *DevicePairingRepository) Claim(ctx context.Context, id string) (r0 *model.DevicePairing, r1 error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, id)
+	}
+	return
+}
+
+func (m *DevicePairingRepository) DeleteExpired(ctx context.Context, now time.Time) (r0 int, r1 error) {
+	if m.DeleteExpiredFunc != nil {
+		return m.DeleteExpiredFunc(ctx, now)
+	}
+	return
+}
+
+// DevicePairingUserRepository mocks service.DevicePairingUserRepository
+type DevicePairingUserRepository struct {
+	GetByIDFunc func(ctx context.Context, id string) (*model.User, error)
+}
+
+func (m *DevicePairingUserRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+// DeviceTokenRepository mocks service.DeviceTokenRepository
+type DeviceTokenRepository struct {
+	GetByUserIDFunc    func(ctx context.Context, userID string) ([]*model.DeviceToken, error)
+	GetByTokenFunc     func(ctx context.Context, token string) (*model.DeviceToken, error)
+	MarkInactiveFunc   func(ctx context.Context, token string) error
+	UpdateLastUsedFunc func(ctx context.Context, id string) error
+}
+
+func (m *DeviceTokenRepository) GetByUserID(ctx context.Context, userID string) (r0 []*model.DeviceToken, r1 error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *DeviceTokenRepository) GetByToken(ctx context.Context, token string) (r0 *model.DeviceToken, r1 error) {
+	if m.GetByTokenFunc != nil {
+		return m.GetByTokenFunc(ctx, token)
+	}
+	return
+}
+
+func (m *DeviceTokenRepository) MarkInactive(ctx context.Context, token string) (r0 error) {
+	if m.MarkInactiveFunc != nil {
+		return m.MarkInactiveFunc(ctx, token)
+	}
+	return
+}
+
+func (m *DeviceTokenRepository) UpdateLastUsed(ctx context.Context, id string) (r0 error) {
+	if m.UpdateLastUsedFunc != nil {
+		return m.UpdateLastUsedFunc(ctx, id)
+	}
+	return
+}
+
+// DiscoveryLanguageRepository mocks service.DiscoveryLanguageRepository
+type DiscoveryLanguageRepository struct {
+	GetSpeakersFunc func(ctx context.Context, userIDs []string, languages []string) (map[string]bool, error)
+}
+
+func (m *DiscoveryLanguageRepository) GetSpeakers(ctx context.Context, userIDs []string, languages []string) (r0 map[string]bool, r1 error) {
+	if m.GetSpeakersFunc != nil {
+		return m.GetSpeakersFunc(ctx, userIDs, languages)
+	}
+	return
+}
+
+// DraftRepository mocks service.DraftRepository
+type DraftRepository struct {
+	CreateFunc        func(ctx context.Context, draft *model.Draft) error
+	GetByIDFunc       func(ctx context.Context, id string) (*model.Draft, error)
+	ListByUserFunc    func(ctx context.Context, userID string, resourceType *model.DraftResourceType, now time.Time) ([]*model.Draft, error)
+	CountByUserFunc   func(ctx context.Context, userID string, now time.Time) (int, error)
+	UpdateDataFunc    func(ctx context.Context, id string, data map[string]interface{}, expiresOn time.Time) (*model.Draft, error)
+	SetErrorsFunc     func(ctx context.Context, id string, fieldErrors []model.FieldError) error
+	DeleteFunc        func(ctx context.Context, id string) error
+	DeleteExpiredFunc func(ctx context.Context, now time.Time) (int, error)
+}
+
+func (m *DraftRepository) Create(ctx context.Context, draft *model.Draft) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, draft)
+	}
+	return
+}
+
+func (m *DraftRepository) GetByID(ctx context.Context, id string) (r0 *model.Draft, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *DraftRepository) ListByUser(ctx context.Context, userID string, resourceType *model.DraftResourceType, now time.Time) (r0 []*model.Draft, r1 error) {
+	if m.ListByUserFunc != nil {
+		return m.ListByUserFunc(ctx, userID, resourceType, now)
+	}
+	return
+}
+
+func (m *DraftRepository) CountByUser(ctx context.Context, userID string, now time.Time) (r0 int, r1 error) {
+	if m.CountByUserFunc != nil {
+		return m.CountByUserFunc(ctx, userID, now)
+	}
+	return
+}
+
+func (m *DraftRepository) UpdateData(ctx context.Context, id string, data map[string]interface{}, expiresOn time.Time) (r0 *model.Draft, r1 error) {
+	if m.UpdateDataFunc != nil {
+		return m.UpdateDataFunc(ctx, id, data, expiresOn)
+	}
+	return
+}
+
+func (m *DraftRepository) SetErrors(ctx context.Context, id string, fieldErrors []model.FieldError) (r0 error) {
+	if m.SetErrorsFunc != nil {
+		return m.SetErrorsFunc(ctx, id, fieldErrors)
+	}
+	return
+}
+
+func (m *DraftRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *DraftRepository) DeleteExpired(ctx context.Context, now time.Time) (r0 int, r1 error) {
+	if m.DeleteExpiredFunc != nil {
+		return m.DeleteExpiredFunc(ctx, now)
+	}
+	return
+}
+
+// EmailChangeRepository mocks service.EmailChangeRepository
+type EmailChangeRepository struct {
+	CreateFunc                 func(ctx context.Context, change *model.EmailChange) error
+	GetByTokenHashFunc         func(ctx context.Context, tokenHash string) (*model.EmailChange, error)
+	ClaimByTokenHashFunc       func(ctx context.Context, tokenHash string) (*model.EmailChange, error)
+	ClaimByCancelTokenHashFunc func(ctx context.Context, cancelTokenHash string) (*model.EmailChange, error)
+	DeleteByUserFunc           func(ctx context.Context, userID string) error
+}
+
+func (m *EmailChangeRepository) Create(ctx context.Context, change *model.EmailChange) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, change)
+	}
+	return
+}
+
+func (m *EmailChangeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (r0 *model.EmailChange, r1 error) {
+	if m.GetByTokenHashFunc != nil {
+		return m.GetByTokenHashFunc(ctx, tokenHash)
+	}
+	return
+}
+
+func (m *EmailChangeRepository) ClaimByTokenHash(ctx context.Context, tokenHash string) (r0 *model.EmailChange, r1 error) {
+	if m.ClaimByTokenHashFunc != nil {
+		return m.ClaimByTokenHashFunc(ctx, tokenHash)
+	}
+	return
+}
+
+func (m *EmailChangeRepository) ClaimByCancelTokenHash(ctx context.Context, cancelTokenHash string) (r0 *model.EmailChange, r1 error) {
+	if m.ClaimByCancelTokenHashFunc != nil {
+		return m.ClaimByCancelTokenHashFunc(ctx, cancelTokenHash)
+	}
+	return
+}
+
+func (m *EmailChangeRepository) DeleteByUser(ctx context.Context, userID string) (r0 error) {
+	if m.DeleteByUserFunc != nil {
+		return m.DeleteByUserFunc(ctx, userID)
+	}
+	return
+}
+
+// EventGuildRepository mocks service.EventGuildRepository
+type EventGuildRepository struct {
+	IsMemberFunc      func(ctx context.Context, userID string, guildID string) (bool, error)
+	GetMemberRoleFunc func(ctx context.Context, userID string, guildID string) (model.GuildRole, error)
+}
+
+func (m *EventGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *EventGuildRepository) GetMemberRole(ctx context.Context, userID string, guildID string) (r0 model.GuildRole, r1 error) {
+	if m.GetMemberRoleFunc != nil {
+		return m.GetMemberRoleFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// EventReminderProfileRepository mocks service.EventReminderProfileRepository
+type EventReminderProfileRepository struct {
+	GetByUserIDFunc func(ctx context.Context, userID string) (*model.UserProfile, error)
+}
+
+func (m *EventReminderProfileRepository) GetByUserID(ctx context.Context, userID string) (r0 *model.UserProfile, r1 error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+// EventReminderRepository mocks service.EventReminderRepository
+type EventReminderRepository struct {
+	GetUpcomingApprovedRSVPsFunc func(ctx context.Context, from time.Time, to time.Time) ([]*model.EventReminderCandidate, error)
+	RecordSentFunc               func(ctx context.Context, sent *model.EventReminderSent) error
+	GetPreferenceFunc            func(ctx context.Context, userID string) (*model.EventReminderPreference, error)
+	UpsertPreferenceFunc         func(ctx context.Context, pref *model.EventReminderPreference) error
+}
+
+func (m *EventReminderRepository) GetUpcomingApprovedRSVPs(ctx context.Context, from time.Time, to time.Time) (r0 []*model.EventReminderCandidate, r1 error) {
+	if m.GetUpcomingApprovedRSVPsFunc != nil {
+		return m.GetUpcomingApprovedRSVPsFunc(ctx, from, to)
+	}
+	return
+}
+
+func (m *EventReminderRepository) RecordSent(ctx context.Context, sent *model.EventReminderSent) (r0 error) {
+	if m.RecordSentFunc != nil {
+		return m.RecordSentFunc(ctx, sent)
+	}
+	return
+}
+
+func (m *EventReminderRepository) GetPreference(ctx context.Context, userID string) (r0 *model.EventReminderPreference, r1 error) {
+	if m.GetPreferenceFunc != nil {
+		return m.GetPreferenceFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *EventReminderRepository) UpsertPreference(ctx context.Context, pref *model.EventReminderPreference) (r0 error) {
+	if m.UpsertPreferenceFunc != nil {
+		return m.UpsertPreferenceFunc(ctx, pref)
+	}
+	return
+}
+
+// EventRepository mocks service.EventRepositoryInterface
+type EventRepository struct {
+	CreateFunc              func(ctx context.Context, event *model.Event) error
+	GetFunc                 func(ctx context.Context, eventID string) (*model.Event, error)
+	UpdateFunc              func(ctx context.Context, eventID string, updates map[string]interface{}, version *int) (*model.Event, error)
+	DeleteFunc              func(ctx context.Context, eventID string) error
+	MoveToTrashFunc         func(ctx context.Context, eventID string, userID string) (bool, error)
+	GetByGuildFunc          func(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) ([]*model.Event, error)
+	GetByGuildPageFunc      func(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Event], error)
+	GetPublicEventsFunc     func(ctx context.Context, filters *model.EventSearchFilters, limit int) ([]*model.Event, error)
+	CreateHostFunc          func(ctx context.Context, host *model.EventHost) error
+	GetHostsFunc            func(ctx context.Context, eventID string) ([]*model.EventHost, error)
+	IsHostFunc              func(ctx context.Context, eventID string, userID string) (bool, error)
+	CreateRSVPFunc          func(ctx context.Context, rsvp *model.EventRSVP) error
+	GetRSVPFunc             func(ctx context.Context, eventID string, userID string) (*model.EventRSVP, error)
+	UpdateRSVPFunc          func(ctx context.Context, rsvpID string, updates map[string]interface{}) (*model.EventRSVP, error)
+	GetRSVPsByEventFunc     func(ctx context.Context, eventID string) ([]*model.EventRSVP, error)
+	GetPendingRSVPsFunc     func(ctx context.Context, eventID string) ([]*model.EventRSVP, error)
+	CountApprovedRSVPsFunc  func(ctx context.Context, eventID string) (int, error)
+	SchedulePublicationFunc func(ctx context.Context, eventID string, publishAt time.Time) (*model.Event, error)
+	SetRSVPWindowFunc       func(ctx context.Context, eventID string, opensAt *time.Time, earlyAccess []model.RSVPEarlyAccess) (*model.Event, error)
+	CreateTicketHoldFunc    func(ctx context.Context, ticket *model.EventTicket, capacity int) (bool, error)
+	GetTicketFunc           func(ctx context.Context, ticketID string) (*model.EventTicket, error)
+	GetActiveTicketFunc     func(ctx context.Context, eventID string, userID string) (*model.EventTicket, error)
+	CountActiveTicketsFunc  func(ctx context.Context, eventID string) (int, error)
+	ClaimTicketFunc         func(ctx context.Context, ticketID string) (*model.EventTicket, error)
+	ExtendTicketHoldFunc    func(ctx context.Context, ticketID string, until time.Time) (*model.EventTicket, error)
+	VoidTicketFunc          func(ctx context.Context, ticketID string) error
+	TransferTicketFunc      func(ctx context.Context, ticketID string, fromUserID string, toUserID string, code string) (*model.EventTicket, error)
+	CheckInTicketFunc       func(ctx context.Context, ticketID string, hostUserID string) (*model.EventTicket, error)
+}
+
+func (m *EventRepository) Create(ctx context.Context, event *model.Event) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, event)
+	}
+	return
+}
+
+func (m *EventRepository) Get(ctx context.Context, eventID string) (r0 *model.Event, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRepository) Update(ctx context.Context, eventID string, updates map[string]interface{}, version *int) (r0 *model.Event, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, eventID, updates, version)
+	}
+	return
+}
+
+func (m *EventRepository) Delete(ctx context.Context, eventID string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRepository) MoveToTrash(ctx context.Context, eventID string, userID string) (r0 bool, r1 error) {
+	if m.MoveToTrashFunc != nil {
+		return m.MoveToTrashFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *EventRepository) GetByGuild(ctx context.Context, guildID string, filters *model.EventSearchFilters, list model.ListQuery) (r0 []*model.Event, r1 error) {
+	if m.GetByGuildFunc != nil {
+		return m.GetByGuildFunc(ctx, guildID, filters, list)
+	}
+	return
+}
+
+func (m *EventRepository) GetByGuildPage(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (r0 *model.Page[*model.Event], r1 error) {
+	if m.GetByGuildPageFunc != nil {
+		return m.GetByGuildPageFunc(ctx, guildID, list, page)
+	}
+	return
+}
+
+func (m *EventRepository) GetPublicEvents(ctx context.Context, filters *model.EventSearchFilters, limit int) (r0 []*model.Event, r1 error) {
+	if m.GetPublicEventsFunc != nil {
+		return m.GetPublicEventsFunc(ctx, filters, limit)
+	}
+	return
+}
+
+func (m *EventRepository) CreateHost(ctx context.Context, host *model.EventHost) (r0 error) {
+	if m.CreateHostFunc != nil {
+		return m.CreateHostFunc(ctx, host)
+	}
+	return
+}
+
+func (m *EventRepository) GetHosts(ctx context.Context, eventID string) (r0 []*model.EventHost, r1 error) {
+	if m.GetHostsFunc != nil {
+		return m.GetHostsFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRepository) IsHost(ctx context.Context, eventID string, userID string) (r0 bool, r1 error) {
+	if m.IsHostFunc != nil {
+		return m.IsHostFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *EventRepository) CreateRSVP(ctx context.Context, rsvp *model.EventRSVP) (r0 error) {
+	if m.CreateRSVPFunc != nil {
+		return m.CreateRSVPFunc(ctx, rsvp)
+	}
+	return
+}
+
+func (m *EventRepository) GetRSVP(ctx context.Context, eventID string, userID string) (r0 *model.EventRSVP, r1 error) {
+	if m.GetRSVPFunc != nil {
+		return m.GetRSVPFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *EventRepository) UpdateRSVP(ctx context.Context, rsvpID string, updates map[string]interface{}) (r0 *model.EventRSVP, r1 error) {
+	if m.UpdateRSVPFunc != nil {
+		return m.UpdateRSVPFunc(ctx, rsvpID, updates)
+	}
+	return
+}
+
+func (m *EventRepository) GetRSVPsByEvent(ctx context.Context, eventID string) (r0 []*model.EventRSVP, r1 error) {
+	if m.GetRSVPsByEventFunc != nil {
+		return m.GetRSVPsByEventFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRepository) GetPendingRSVPs(ctx context.Context, eventID string) (r0 []*model.EventRSVP, r1 error) {
+	if m.GetPendingRSVPsFunc != nil {
+		return m.GetPendingRSVPsFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRepository) CountApprovedRSVPs(ctx context.Context, eventID string) (r0 int, r1 error) {
+	if m.CountApprovedRSVPsFunc != nil {
+		return m.CountApprovedRSVPsFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRepository) SchedulePublication(ctx context.Context, eventID string, publishAt time.Time) (r0 *model.Event, r1 error) {
+	if m.SchedulePublicationFunc != nil {
+		return m.SchedulePublicationFunc(ctx, eventID, publishAt)
+	}
+	return
+}
+
+func (m *EventRepository) SetRSVPWindow(ctx context.Context, eventID string, opensAt *time.Time, earlyAccess []model.RSVPEarlyAccess) (r0 *model.Event, r1 error) {
+	if m.SetRSVPWindowFunc != nil {
+		return m.SetRSVPWindowFunc(ctx, eventID, opensAt, earlyAccess)
+	}
+	return
+}
+
+func (m *EventRepository) CreateTicketHold(ctx context.Context, ticket *model.EventTicket, capacity int) (r0 bool, r1 error) {
+	if m.CreateTicketHoldFunc != nil {
+		return m.CreateTicketHoldFunc(ctx, ticket, capacity)
+	}
+	return
+}
+
+func (m *EventRepository) GetTicket(ctx context.Context, ticketID string) (r0 *model.EventTicket, r1 error) {
+	if m.GetTicketFunc != nil {
+		return m.GetTicketFunc(ctx, ticketID)
+	}
+	return
+}
+
+func (m *EventRepository) GetActiveTicket(ctx context.Context, eventID string, userID string) (r0 *model.EventTicket, r1 error) {
+	if m.GetActiveTicketFunc != nil {
+		return m.GetActiveTicketFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *EventRepository) CountActiveTickets(ctx context.Context, eventID string) (r0 int, r1 error) {
+	if m.CountActiveTicketsFunc != nil {
+		return m.CountActiveTicketsFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRepository) ClaimTicket(ctx context.Context, ticketID string) (r0 *model.EventTicket, r1 error) {
+	if m.ClaimTicketFunc != nil {
+		return m.ClaimTicketFunc(ctx, ticketID)
+	}
+	return
+}
+
+func (m *EventRepository) ExtendTicketHold(ctx context.Context, ticketID string, until time.Time) (r0 *model.EventTicket, r1 error) {
+	if m.ExtendTicketHoldFunc != nil {
+		return m.ExtendTicketHoldFunc(ctx, ticketID, until)
+	}
+	return
+}
+
+func (m *EventRepository) VoidTicket(ctx context.Context, ticketID string) (r0 error) {
+	if m.VoidTicketFunc != nil {
+		return m.VoidTicketFunc(ctx, ticketID)
+	}
+	return
+}
+
+func (m *EventRepository) TransferTicket(ctx context.Context, ticketID string, fromUserID string, toUserID string, code string) (r0 *model.EventTicket, r1 error) {
+	if m.TransferTicketFunc != nil {
+		return m.TransferTicketFunc(ctx, ticketID, fromUserID, toUserID, code)
+	}
+	return
+}
+
+func (m *EventRepository) CheckInTicket(ctx context.Context, ticketID string, hostUserID string) (r0 *model.EventTicket, r1 error) {
+	if m.CheckInTicketFunc != nil {
+		return m.CheckInTicketFunc(ctx, ticketID, hostUserID)
+	}
+	return
+}
+
+// EventRoleAlertHostRepository mocks service.EventRoleAlertHostRepository
+type EventRoleAlertHostRepository struct {
+	GetHostsFunc func(ctx context.Context, eventID string) ([]*model.EventHost, error)
+}
+
+func (m *EventRoleAlertHostRepository) GetHosts(ctx context.Context, eventID string) (r0 []*model.EventHost, r1 error) {
+	if m.GetHostsFunc != nil {
+		return m.GetHostsFunc(ctx, eventID)
+	}
+	return
+}
+
+// EventRoleAlertRepository mocks service.EventRoleAlertRepository
+type EventRoleAlertRepository struct {
+	GetCriticalRolesFunc        func(ctx context.Context, from time.Time, to time.Time) ([]*model.RoleGapCandidate, error)
+	GetConfirmedAssignmentsFunc func(ctx context.Context, from time.Time, to time.Time) ([]*model.RoleAssigneeCandidate, error)
+	RecordAlertSentFunc         func(ctx context.Context, kind string, roleID string, userID string) error
+}
+
+func (m *EventRoleAlertRepository) GetCriticalRoles(ctx context.Context, from time.Time, to time.Time) (r0 []*model.RoleGapCandidate, r1 error) {
+	if m.GetCriticalRolesFunc != nil {
+		return m.GetCriticalRolesFunc(ctx, from, to)
+	}
+	return
+}
+
+func (m *EventRoleAlertRepository) GetConfirmedAssignments(ctx context.Context, from time.Time, to time.Time) (r0 []*model.RoleAssigneeCandidate, r1 error) {
+	if m.GetConfirmedAssignmentsFunc != nil {
+		return m.GetConfirmedAssignmentsFunc(ctx, from, to)
+	}
+	return
+}
+
+func (m *EventRoleAlertRepository) RecordAlertSent(ctx context.Context, kind string, roleID string, userID string) (r0 error) {
+	if m.RecordAlertSentFunc != nil {
+		return m.RecordAlertSentFunc(ctx, kind, roleID, userID)
+	}
+	return
+}
+
+// EventRoleEventRepository mocks service.EventRoleEventRepository
+type EventRoleEventRepository struct {
+	IsHostFunc func(ctx context.Context, eventID string, userID string) (bool, error)
+}
+
+func (m *EventRoleEventRepository) IsHost(ctx context.Context, eventID string, userID string) (r0 bool, r1 error) {
+	if m.IsHostFunc != nil {
+		return m.IsHostFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+// EventRoleRepository mocks service.EventRoleRepositoryInterface
+type EventRoleRepository struct {
+	CreateRoleFunc                 func(ctx context.Context, role *model.EventRole) error
+	GetRoleFunc                    func(ctx context.Context, roleID string) (*model.EventRole, error)
+	GetRolesByEventFunc            func(ctx context.Context, eventID string) ([]*model.EventRole, error)
+	UpdateRoleFunc                 func(ctx context.Context, roleID string, updates map[string]interface{}) (*model.EventRole, error)
+	DeleteRoleFunc                 func(ctx context.Context, roleID string) error
+	CreateAssignmentFunc           func(ctx context.Context, assignment *model.EventRoleAssignment) error
+	GetAssignmentFunc              func(ctx context.Context, assignmentID string) (*model.EventRoleAssignment, error)
+	GetUserAssignmentForRoleFunc   func(ctx context.Context, roleID string, userID string) (*model.EventRoleAssignment, error)
+	GetUserAssignmentsForEventFunc func(ctx context.Context, eventID string, userID string) ([]*model.EventRoleAssignment, error)
+	GetAssignmentsByRoleFunc       func(ctx context.Context, roleID string) ([]*model.EventRoleAssignment, error)
+	GetAssignmentsByEventFunc      func(ctx context.Context, eventID string) ([]*model.EventRoleAssignment, error)
+	UpdateAssignmentFunc           func(ctx context.Context, assignmentID string, updates map[string]interface{}) (*model.EventRoleAssignment, error)
+	DeleteAssignmentFunc           func(ctx context.Context, assignmentID string) error
+	CountAssignmentsByRoleFunc     func(ctx context.Context, roleID string) (int, error)
+	GetRolesWithAssignmentsFunc    func(ctx context.Context, eventID string) ([]model.EventRoleWithAssignments, error)
+}
+
+func (m *EventRoleRepository) CreateRole(ctx context.Context, role *model.EventRole) (r0 error) {
+	if m.CreateRoleFunc != nil {
+		return m.CreateRoleFunc(ctx, role)
+	}
+	return
+}
+
+func (m *EventRoleRepository) GetRole(ctx context.Context, roleID string) (r0 *model.EventRole, r1 error) {
+	if m.GetRoleFunc != nil {
+		return m.GetRoleFunc(ctx, roleID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) GetRolesByEvent(ctx context.Context, eventID string) (r0 []*model.EventRole, r1 error) {
+	if m.GetRolesByEventFunc != nil {
+		return m.GetRolesByEventFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) UpdateRole(ctx context.Context, roleID string, updates map[string]interface{}) (r0 *model.EventRole, r1 error) {
+	if m.UpdateRoleFunc != nil {
+		return m.UpdateRoleFunc(ctx, roleID, updates)
+	}
+	return
+}
+
+func (m *EventRoleRepository) DeleteRole(ctx context.Context, roleID string) (r0 error) {
+	if m.DeleteRoleFunc != nil {
+		return m.DeleteRoleFunc(ctx, roleID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) CreateAssignment(ctx context.Context, assignment *model.EventRoleAssignment) (r0 error) {
+	if m.CreateAssignmentFunc != nil {
+		return m.CreateAssignmentFunc(ctx, assignment)
+	}
+	return
+}
+
+func (m *EventRoleRepository) GetAssignment(ctx context.Context, assignmentID string) (r0 *model.EventRoleAssignment, r1 error) {
+	if m.GetAssignmentFunc != nil {
+		return m.GetAssignmentFunc(ctx, assignmentID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) GetUserAssignmentForRole(ctx context.Context, roleID string, userID string) (r0 *model.EventRoleAssignment, r1 error) {
+	if m.GetUserAssignmentForRoleFunc != nil {
+		return m.GetUserAssignmentForRoleFunc(ctx, roleID, userID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) GetUserAssignmentsForEvent(ctx context.Context, eventID string, userID string) (r0 []*model.EventRoleAssignment, r1 error) {
+	if m.GetUserAssignmentsForEventFunc != nil {
+		return m.GetUserAssignmentsForEventFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) GetAssignmentsByRole(ctx context.Context, roleID string) (r0 []*model.EventRoleAssignment, r1 error) {
+	if m.GetAssignmentsByRoleFunc != nil {
+		return m.GetAssignmentsByRoleFunc(ctx, roleID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) GetAssignmentsByEvent(ctx context.Context, eventID string) (r0 []*model.EventRoleAssignment, r1 error) {
+	if m.GetAssignmentsByEventFunc != nil {
+		return m.GetAssignmentsByEventFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) UpdateAssignment(ctx context.Context, assignmentID string, updates map[string]interface{}) (r0 *model.EventRoleAssignment, r1 error) {
+	if m.UpdateAssignmentFunc != nil {
+		return m.UpdateAssignmentFunc(ctx, assignmentID, updates)
+	}
+	return
+}
+
+func (m *EventRoleRepository) DeleteAssignment(ctx context.Context, assignmentID string) (r0 error) {
+	if m.DeleteAssignmentFunc != nil {
+		return m.DeleteAssignmentFunc(ctx, assignmentID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) CountAssignmentsByRole(ctx context.Context, roleID string) (r0 int, r1 error) {
+	if m.CountAssignmentsByRoleFunc != nil {
+		return m.CountAssignmentsByRoleFunc(ctx, roleID)
+	}
+	return
+}
+
+func (m *EventRoleRepository) GetRolesWithAssignments(ctx context.Context, eventID string) (r0 []model.EventRoleWithAssignments, r1 error) {
+	if m.GetRolesWithAssignmentsFunc != nil {
+		return m.GetRolesWithAssignmentsFunc(ctx, eventID)
+	}
+	return
+}
+
+// ExpenseEventRepository mocks service.ExpenseEventRepository
+type ExpenseEventRepository struct {
+	GetFunc             func(ctx context.Context, eventID string) (*model.Event, error)
+	IsHostFunc          func(ctx context.Context, eventID string, userID string) (bool, error)
+	GetHostsFunc        func(ctx context.Context, eventID string) ([]*model.EventHost, error)
+	GetRSVPsByEventFunc func(ctx context.Context, eventID string) ([]*model.EventRSVP, error)
+}
+
+func (m *ExpenseEventRepository) Get(ctx context.Context, eventID string) (r0 *model.Event, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *ExpenseEventRepository) IsHost(ctx context.Context, eventID string, userID string) (r0 bool, r1 error) {
+	if m.IsHostFunc != nil {
+		return m.IsHostFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *ExpenseEventRepository) GetHosts(ctx context.Context, eventID string) (r0 []*model.EventHost, r1 error) {
+	if m.GetHostsFunc != nil {
+		return m.GetHostsFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *ExpenseEventRepository) GetRSVPsByEvent(ctx context.Context, eventID string) (r0 []*model.EventRSVP, r1 error) {
+	if m.GetRSVPsByEventFunc != nil {
+		return m.GetRSVPsByEventFunc(ctx, eventID)
+	}
+	return
+}
+
+// ExpenseRepository mocks service.ExpenseRepository
+type ExpenseRepository struct {
+	CreateFunc           func(ctx context.Context, expense *model.Expense) error
+	GetFunc              func(ctx context.Context, expenseID string) (*model.Expense, error)
+	GetByScopeFunc       func(ctx context.Context, scopeID string) ([]*model.Expense, error)
+	DeleteFunc           func(ctx context.Context, expenseID string) error
+	CreateSettlementFunc func(ctx context.Context, settlement *model.ExpenseSettlement) error
+	GetSettlementsFunc   func(ctx context.Context, scopeID string) ([]*model.ExpenseSettlement, error)
+}
+
+func (m *ExpenseRepository) Create(ctx context.Context, expense *model.Expense) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, expense)
+	}
+	return
+}
+
+func (m *ExpenseRepository) Get(ctx context.Context, expenseID string) (r0 *model.Expense, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, expenseID)
+	}
+	return
+}
+
+func (m *ExpenseRepository) GetByScope(ctx context.Context, scopeID string) (r0 []*model.Expense, r1 error) {
+	if m.GetByScopeFunc != nil {
+		return m.GetByScopeFunc(ctx, scopeID)
+	}
+	return
+}
+
+func (m *ExpenseRepository) Delete(ctx context.Context, expenseID string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, expenseID)
+	}
+	return
+}
+
+func (m *ExpenseRepository) CreateSettlement(ctx context.Context, settlement *model.ExpenseSettlement) (r0 error) {
+	if m.CreateSettlementFunc != nil {
+		return m.CreateSettlementFunc(ctx, settlement)
+	}
+	return
+}
+
+func (m *ExpenseRepository) GetSettlements(ctx context.Context, scopeID string) (r0 []*model.ExpenseSettlement, r1 error) {
+	if m.GetSettlementsFunc != nil {
+		return m.GetSettlementsFunc(ctx, scopeID)
+	}
+	return
+}
+
+// ExperimentRepository mocks service.ExperimentRepository
+type ExperimentRepository struct {
+	CreateFunc   func(ctx context.Context, experiment *model.Experiment) error
+	GetByKeyFunc func(ctx context.Context, key string) (*model.Experiment, error)
+	ListFunc     func(ctx context.Context, status model.ExperimentStatus) ([]*model.Experiment, error)
+	UpdateFunc   func(ctx context.Context, key string, updates map[string]interface{}) (*model.Experiment, error)
+}
+
+func (m *ExperimentRepository) Create(ctx context.Context, experiment *model.Experiment) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, experiment)
+	}
+	return
+}
+
+func (m *ExperimentRepository) GetByKey(ctx context.Context, key string) (r0 *model.Experiment, r1 error) {
+	if m.GetByKeyFunc != nil {
+		return m.GetByKeyFunc(ctx, key)
+	}
+	return
+}
+
+func (m *ExperimentRepository) List(ctx context.Context, status model.ExperimentStatus) (r0 []*model.Experiment, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, status)
+	}
+	return
+}
+
+func (m *ExperimentRepository) Update(ctx context.Context, key string, updates map[string]interface{}) (r0 *model.Experiment, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, key, updates)
+	}
+	return
+}
+
+// GuildAnswerRepository mocks service.GuildAnswerRepository
+type GuildAnswerRepository struct {
+	GetFunc              func(ctx context.Context, userID string, questionID string) (*model.GuildAnswer, error)
+	GetByGuildFunc       func(ctx context.Context, guildID string, userID string) ([]*model.GuildAnswer, error)
+	CreateFunc           func(ctx context.Context, answer *model.GuildAnswer) error
+	UpdateSelectionFunc  func(ctx context.Context, userID string, questionID string, selectedOption string) (*model.GuildAnswer, error)
+	DeleteFunc           func(ctx context.Context, userID string, questionID string) error
+	CountForQuestionFunc func(ctx context.Context, questionID string) (int, error)
+}
+
+func (m *GuildAnswerRepository) Get(ctx context.Context, userID string, questionID string) (r0 *model.GuildAnswer, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, userID, questionID)
+	}
+	return
+}
+
+func (m *GuildAnswerRepository) GetByGuild(ctx context.Context, guildID string, userID string) (r0 []*model.GuildAnswer, r1 error) {
+	if m.GetByGuildFunc != nil {
+		return m.GetByGuildFunc(ctx, guildID, userID)
+	}
+	return
+}
+
+func (m *GuildAnswerRepository) Create(ctx context.Context, answer *model.GuildAnswer) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, answer)
+	}
+	return
+}
+
+func (m *GuildAnswerRepository) UpdateSelection(ctx context.Context, userID string, questionID string, selectedOption string) (r0 *model.GuildAnswer, r1 error) {
+	if m.UpdateSelectionFunc != nil {
+		return m.UpdateSelectionFunc(ctx, userID, questionID, selectedOption)
+	}
+	return
+}
+
+func (m *GuildAnswerRepository) Delete(ctx context.Context, userID string, questionID string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, questionID)
+	}
+	return
+}
+
+func (m *GuildAnswerRepository) CountForQuestion(ctx context.Context, questionID string) (r0 int, r1 error) {
+	if m.CountForQuestionFunc != nil {
+		return m.CountForQuestionFunc(ctx, questionID)
+	}
+	return
+}
+
+// GuildHealthRepository mocks service.GuildHealthRepository
+type GuildHealthRepository struct {
+	ListGuildIDsFunc  func(ctx context.Context) ([]string, error)
+	GetMetricsFunc    func(ctx context.Context, guildID string, since time.Time, until time.Time, joinedSince time.Time) (*model.GuildHealthMetrics, error)
+	GetOrganizersFunc func(ctx context.Context, guildID string) ([]string, error)
+	SaveFunc          func(ctx context.Context, health *model.GuildHealth) error
+	GetOnOrBeforeFunc func(ctx context.Context, guildID string, day string) (*model.GuildHealth, error)
+	ListRecentFunc    func(ctx context.Context, guildID string, limit int) ([]*model.GuildHealth, error)
+	LastAlertedOnFunc func(ctx context.Context, guildID string) (*time.Time, error)
+	MarkAlertedFunc   func(ctx context.Context, guildID string, day string, alertedOn time.Time) error
+}
+
+func (m *GuildHealthRepository) ListGuildIDs(ctx context.Context) (r0 []string, r1 error) {
+	if m.ListGuildIDsFunc != nil {
+		return m.ListGuildIDsFunc(ctx)
+	}
+	return
+}
+
+func (m *GuildHealthRepository) GetMetrics(ctx context.Context, guildID string, since time.Time, until time.Time, joinedSince time.Time) (r0 *model.GuildHealthMetrics, r1 error) {
+	if m.GetMetricsFunc != nil {
+		return m.GetMetricsFunc(ctx, guildID, since, until, joinedSince)
+	}
+	return
+}
+
+func (m *GuildHealthRepository) GetOrganizers(ctx context.Context, guildID string) (r0 []string, r1 error) {
+	if m.GetOrganizersFunc != nil {
+		return m.GetOrganizersFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildHealthRepository) Save(ctx context.Context, health *model.GuildHealth) (r0 error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, health)
+	}
+	return
+}
+
+func (m *GuildHealthRepository) GetOnOrBefore(ctx context.Context, guildID string, day string) (r0 *model.GuildHealth, r1 error) {
+	if m.GetOnOrBeforeFunc != nil {
+		return m.GetOnOrBeforeFunc(ctx, guildID, day)
+	}
+	return
+}
+
+func (m *GuildHealthRepository) ListRecent(ctx context.Context, guildID string, limit int) (r0 []*model.GuildHealth, r1 error) {
+	if m.ListRecentFunc != nil {
+		return m.ListRecentFunc(ctx, guildID, limit)
+	}
+	return
+}
+
+func (m *GuildHealthRepository) LastAlertedOn(ctx context.Context, guildID string) (r0 *time.Time, r1 error) {
+	if m.LastAlertedOnFunc != nil {
+		return m.LastAlertedOnFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildHealthRepository) MarkAlerted(ctx context.Context, guildID string, day string, alertedOn time.Time) (r0 error) {
+	if m.MarkAlertedFunc != nil {
+		return m.MarkAlertedFunc(ctx, guildID, day, alertedOn)
+	}
+	return
+}
+
+// GuildImportRepository mocks service.GuildImportRepository
+type GuildImportRepository struct {
+	CreateFunc     func(ctx context.Context, imp *model.GuildImport) error
+	GetFunc        func(ctx context.Context, id string) (*model.GuildImport, error)
+	ListFunc       func(ctx context.Context, limit int) ([]*model.GuildImport, error)
+	ClaimFunc      func(ctx context.Context, id string) (bool, error)
+	SaveCommitFunc func(ctx context.Context, imp *model.GuildImport) error
+}
+
+func (m *GuildImportRepository) Create(ctx context.Context, imp *model.GuildImport) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, imp)
+	}
+	return
+}
+
+func (m *GuildImportRepository) Get(ctx context.Context, id string) (r0 *model.GuildImport, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return
+}
+
+func (m *GuildImportRepository) List(ctx context.Context, limit int) (r0 []*model.GuildImport, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, limit)
+	}
+	return
+}
+
+func (m *GuildImportRepository) Claim(ctx context.Context, id string) (r0 bool, r1 error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, id)
+	}
+	return
+}
+
+func (m *GuildImportRepository) SaveCommit(ctx context.Context, imp *model.GuildImport) (r0 error) {
+	if m.SaveCommitFunc != nil {
+		return m.SaveCommitFunc(ctx, imp)
+	}
+	return
+}
+
+// GuildInviteRepository mocks service.GuildInviteRepository
+type GuildInviteRepository struct {
+	CreateFunc      func(ctx context.Context, invite *model.GuildInvite) error
+	GetByIDFunc     func(ctx context.Context, id string) (*model.GuildInvite, error)
+	GetByCodeFunc   func(ctx context.Context, code string) (*model.GuildInvite, error)
+	GetByGuildFunc  func(ctx context.Context, guildID string) ([]*model.GuildInvite, error)
+	CountActiveFunc func(ctx context.Context, guildID string, now time.Time) (int, error)
+	ClaimUseFunc    func(ctx context.Context, id string) (bool, error)
+	ReleaseUseFunc  func(ctx context.Context, id string) error
+	DeleteFunc      func(ctx context.Context, id string) error
+}
+
+func (m *GuildInviteRepository) Create(ctx context.Context, invite *model.GuildInvite) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, invite)
+	}
+	return
+}
+
+func (m *GuildInviteRepository) GetByID(ctx context.Context, id string) (r0 *model.GuildInvite, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *GuildInviteRepository) GetByCode(ctx context.Context, code string) (r0 *model.GuildInvite, r1 error) {
+	if m.GetByCodeFunc != nil {
+		return m.GetByCodeFunc(ctx, code)
+	}
+	return
+}
+
+func (m *GuildInviteRepository) GetByGuild(ctx context.Context, guildID string) (r0 []*model.GuildInvite, r1 error) {
+	if m.GetByGuildFunc != nil {
+		return m.GetByGuildFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildInviteRepository) CountActive(ctx context.Context, guildID string, now time.Time) (r0 int, r1 error) {
+	if m.CountActiveFunc != nil {
+		return m.CountActiveFunc(ctx, guildID, now)
+	}
+	return
+}
+
+func (m *GuildInviteRepository) ClaimUse(ctx context.Context, id string) (r0 bool, r1 error) {
+	if m.ClaimUseFunc != nil {
+		return m.ClaimUseFunc(ctx, id)
+	}
+	return
+}
+
+func (m *GuildInviteRepository) ReleaseUse(ctx context.Context, id string) (r0 error) {
+	if m.ReleaseUseFunc != nil {
+		return m.ReleaseUseFunc(ctx, id)
+	}
+	return
+}
+
+func (m *GuildInviteRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// GuildPermissionRepository mocks service.GuildPermissionRepository
+type GuildPermissionRepository struct {
+	CreateRoleFunc     func(ctx context.Context, role *model.GuildCustomRole) error
+	GetRoleFunc        func(ctx context.Context, roleID string) (*model.GuildCustomRole, error)
+	ListRolesFunc      func(ctx context.Context, guildID string) ([]*model.GuildCustomRole, error)
+	CountRolesFunc     func(ctx context.Context, guildID string) (int, error)
+	UpdateRoleFunc     func(ctx context.Context, roleID string, updates map[string]interface{}) (*model.GuildCustomRole, error)
+	DeleteRoleFunc     func(ctx context.Context, roleID string) error
+	AssignRoleFunc     func(ctx context.Context, guildID string, roleID string, userID string, assignedBy string) error
+	UnassignRoleFunc   func(ctx context.Context, roleID string, userID string) (bool, error)
+	GetMemberRolesFunc func(ctx context.Context, guildID string, userID string) ([]*model.GuildCustomRole, error)
+}
+
+func (m *GuildPermissionRepository) CreateRole(ctx context.Context, role *model.GuildCustomRole) (r0 error) {
+	if m.CreateRoleFunc != nil {
+		return m.CreateRoleFunc(ctx, role)
+	}
+	return
+}
+
+func (m *GuildPermissionRepository) GetRole(ctx context.Context, roleID string) (r0 *model.GuildCustomRole, r1 error) {
+	if m.GetRoleFunc != nil {
+		return m.GetRoleFunc(ctx, roleID)
+	}
+	return
+}
+
+func (m *GuildPermissionRepository) ListRoles(ctx context.Context, guildID string) (r0 []*model.GuildCustomRole, r1 error) {
+	if m.ListRolesFunc != nil {
+		return m.ListRolesFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildPermissionRepository) CountRoles(ctx context.Context, guildID string) (r0 int, r1 error) {
+	if m.CountRolesFunc != nil {
+		return m.CountRolesFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildPermissionRepository) UpdateRole(ctx context.Context, roleID string, updates map[string]interface{}) (r0 *model.GuildCustomRole, r1 error) {
+	if m.UpdateRoleFunc != nil {
+		return m.UpdateRoleFunc(ctx, roleID, updates)
+	}
+	return
+}
+
+func (m *GuildPermissionRepository) DeleteRole(ctx context.Context, roleID string) (r0 error) {
+	if m.DeleteRoleFunc != nil {
+		return m.DeleteRoleFunc(ctx, roleID)
+	}
+	return
+}
+
+func (m *GuildPermissionRepository) AssignRole(ctx context.Context, guildID string, roleID string, userID string, assignedBy string) (r0 error) {
+	if m.AssignRoleFunc != nil {
+		return m.AssignRoleFunc(ctx, guildID, roleID, userID, assignedBy)
+	}
+	return
+}
+
+func (m *GuildPermissionRepository) UnassignRole(ctx context.Context, roleID string, userID string) (r0 bool, r1 error) {
+	if m.UnassignRoleFunc != nil {
+		return m.UnassignRoleFunc(ctx, roleID, userID)
+	}
+	return
+}
+
+func (m *GuildPermissionRepository) GetMemberRoles(ctx context.Context, guildID string, userID string) (r0 []*model.GuildCustomRole, r1 error) {
+	if m.GetMemberRolesFunc != nil {
+		return m.GetMemberRolesFunc(ctx, guildID, userID)
+	}
+	return
+}
+
+// GuildQuestionGuildRepository mocks service.GuildQuestionGuildRepository
+type GuildQuestionGuildRepository struct {
+	IsMemberFunc                func(ctx context.Context, userID string, guildID string) (bool, error)
+	IsGuildModeratorFunc        func(ctx context.Context, userID string, guildID string) (bool, error)
+	GetMemberForUserInGuildFunc func(ctx context.Context, userID string, guildID string) (*model.Member, error)
+}
+
+func (m *GuildQuestionGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *GuildQuestionGuildRepository) IsGuildModerator(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildModeratorFunc != nil {
+		return m.IsGuildModeratorFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *GuildQuestionGuildRepository) GetMemberForUserInGuild(ctx context.Context, userID string, guildID string) (r0 *model.Member, r1 error) {
+	if m.GetMemberForUserInGuildFunc != nil {
+		return m.GetMemberForUserInGuildFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// GuildQuestionRepository mocks service.GuildQuestionRepository
+type GuildQuestionRepository struct {
+	GetGuildQuestionsFunc func(ctx context.Context, guildID string, includeInactive bool) ([]*model.Question, error)
+	GetQuestionByIDFunc   func(ctx context.Context, id string) (*model.Question, error)
+	CreateQuestionFunc    func(ctx context.Context, question *model.Question) error
+	UpdateQuestionFunc    func(ctx context.Context, id string, updates map[string]interface{}) (*model.Question, error)
+	DeleteQuestionFunc    func(ctx context.Context, id string) error
+}
+
+func (m *GuildQuestionRepository) GetGuildQuestions(ctx context.Context, guildID string, includeInactive bool) (r0 []*model.Question, r1 error) {
+	if m.GetGuildQuestionsFunc != nil {
+		return m.GetGuildQuestionsFunc(ctx, guildID, includeInactive)
+	}
+	return
+}
+
+func (m *GuildQuestionRepository) GetQuestionByID(ctx context.Context, id string) (r0 *model.Question, r1 error) {
+	if m.GetQuestionByIDFunc != nil {
+		return m.GetQuestionByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *GuildQuestionRepository) CreateQuestion(ctx context.Context, question *model.Question) (r0 error) {
+	if m.CreateQuestionFunc != nil {
+		return m.CreateQuestionFunc(ctx, question)
+	}
+	return
+}
+
+func (m *GuildQuestionRepository) UpdateQuestion(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.Question, r1 error) {
+	if m.UpdateQuestionFunc != nil {
+		return m.UpdateQuestionFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *GuildQuestionRepository) DeleteQuestion(ctx context.Context, id string) (r0 error) {
+	if m.DeleteQuestionFunc != nil {
+		return m.DeleteQuestionFunc(ctx, id)
+	}
+	return
+}
+
+// GuildRepository mocks service.GuildRepository
+type GuildRepository struct {
+	CreateFunc             func(ctx context.Context, guild *model.Guild) error
+	GetByIDFunc            func(ctx context.Context, id string) (*model.Guild, error)
+	UpdateFunc             func(ctx context.Context, guild *model.Guild, version *int) (*model.Guild, error)
+	DeleteFunc             func(ctx context.Context, id string) error
+	GetGuildsForUserFunc   func(ctx context.Context, userID string) ([]*model.Guild, error)
+	CountGuildsForUserFunc func(ctx context.Context, userID string) (int, error)
+	AddMemberFunc          func(ctx context.Context, memberID string, guildID string, pendingApproval bool) error
+	AddMemberWithRoleFunc  func(ctx context.Context, memberID string, guildID string, role model.GuildRole, pendingApproval bool) error
+	RemoveMemberFunc       func(ctx context.Context, memberID string, guildID string) (*model.GuildMembership, error)
+	IsMemberFunc           func(ctx context.Context, userID string, guildID string) (bool, error)
+	CountMembersFunc       func(ctx context.Context, guildID string) (int, error)
+	GetMembersFunc         func(ctx context.Context, guildID string) ([]*model.Member, error)
+	GetMembersPageFunc     func(ctx context.Context, guildID string, page model.PageRequest) (*model.Page[*model.Member], error)
+	GetMemberRoleFunc      func(ctx context.Context, userID string, guildID string) (model.GuildRole, error)
+	IsGuildAdminFunc       func(ctx context.Context, userID string, guildID string) (bool, error)
+	IsGuildModeratorFunc   func(ctx context.Context, userID string, guildID string) (bool, error)
+	UpdateMemberRoleFunc   func(ctx context.Context, userID string, guildID string, role model.GuildRole) error
+}
+
+func (m *GuildRepository) Create(ctx context.Context, guild *model.Guild) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, guild)
+	}
+	return
+}
+
+func (m *GuildRepository) GetByID(ctx context.Context, id string) (r0 *model.Guild, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *GuildRepository) Update(ctx context.Context, guild *model.Guild, version *int) (r0 *model.Guild, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, guild, version)
+	}
+	return
+}
+
+func (m *GuildRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *GuildRepository) GetGuildsForUser(ctx context.Context, userID string) (r0 []*model.Guild, r1 error) {
+	if m.GetGuildsForUserFunc != nil {
+		return m.GetGuildsForUserFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *GuildRepository) CountGuildsForUser(ctx context.Context, userID string) (r0 int, r1 error) {
+	if m.CountGuildsForUserFunc != nil {
+		return m.CountGuildsForUserFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *GuildRepository) AddMember(ctx context.Context, memberID string, guildID string, pendingApproval bool) (r0 error) {
+	if m.AddMemberFunc != nil {
+		return m.AddMemberFunc(ctx, memberID, guildID, pendingApproval)
+	}
+	return
+}
+
+func (m *GuildRepository) AddMemberWithRole(ctx context.Context, memberID string, guildID string, role model.GuildRole, pendingApproval bool) (r0 error) {
+	if m.AddMemberWithRoleFunc != nil {
+		return m.AddMemberWithRoleFunc(ctx, memberID, guildID, role, pendingApproval)
+	}
+	return
+}
+
+func (m *GuildRepository) RemoveMember(ctx context.Context, memberID string, guildID string) (r0 *model.GuildMembership, r1 error) {
+	if m.RemoveMemberFunc != nil {
+		return m.RemoveMemberFunc(ctx, memberID, guildID)
+	}
+	return
+}
+
+func (m *GuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *GuildRepository) CountMembers(ctx context.Context, guildID string) (r0 int, r1 error) {
+	if m.CountMembersFunc != nil {
+		return m.CountMembersFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildRepository) GetMembers(ctx context.Context, guildID string) (r0 []*model.Member, r1 error) {
+	if m.GetMembersFunc != nil {
+		return m.GetMembersFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildRepository) GetMembersPage(ctx context.Context, guildID string, page model.PageRequest) (r0 *model.Page[*model.Member], r1 error) {
+	if m.GetMembersPageFunc != nil {
+		return m.GetMembersPageFunc(ctx, guildID, page)
+	}
+	return
+}
+
+func (m *GuildRepository) GetMemberRole(ctx context.Context, userID string, guildID string) (r0 model.GuildRole, r1 error) {
+	if m.GetMemberRoleFunc != nil {
+		return m.GetMemberRoleFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *GuildRepository) IsGuildAdmin(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildAdminFunc != nil {
+		return m.IsGuildAdminFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *GuildRepository) IsGuildModerator(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildModeratorFunc != nil {
+		return m.IsGuildModeratorFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *GuildRepository) UpdateMemberRole(ctx context.Context, userID string, guildID string, role model.GuildRole) (r0 error) {
+	if m.UpdateMemberRoleFunc != nil {
+		return m.UpdateMemberRoleFunc(ctx, userID, guildID, role)
+	}
+	return
+}
+
+// GuildSlugRepository mocks service.GuildSlugRepository
+type GuildSlugRepository struct {
+	GetBySlugFunc       func(ctx context.Context, slug string) (*model.Guild, error)
+	GetSlugRedirectFunc func(ctx context.Context, slug string) (*model.GuildSlugRedirect, error)
+	ChangeSlugFunc      func(ctx context.Context, guildID string, previous string, slug string) error
+}
+
+func (m *GuildSlugRepository) GetBySlug(ctx context.Context, slug string) (r0 *model.Guild, r1 error) {
+	if m.GetBySlugFunc != nil {
+		return m.GetBySlugFunc(ctx, slug)
+	}
+	return
+}
+
+func (m *GuildSlugRepository) GetSlugRedirect(ctx context.Context, slug string) (r0 *model.GuildSlugRedirect, r1 error) {
+	if m.GetSlugRedirectFunc != nil {
+		return m.GetSlugRedirectFunc(ctx, slug)
+	}
+	return
+}
+
+func (m *GuildSlugRepository) ChangeSlug(ctx context.Context, guildID string, previous string, slug string) (r0 error) {
+	if m.ChangeSlugFunc != nil {
+		return m.ChangeSlugFunc(ctx, guildID, previous, slug)
+	}
+	return
+}
+
+// GuildTierGuildRepository mocks service.GuildTierGuildRepository
+type GuildTierGuildRepository struct {
+	IsMemberFunc     func(ctx context.Context, userID string, guildID string) (bool, error)
+	IsGuildAdminFunc func(ctx context.Context, userID string, guildID string) (bool, error)
+}
+
+func (m *GuildTierGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *GuildTierGuildRepository) IsGuildAdmin(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildAdminFunc != nil {
+		return m.IsGuildAdminFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// GuildTierRepository mocks service.GuildTierRepository
+type GuildTierRepository struct {
+	CreateTierFunc       func(ctx context.Context, tier *model.GuildTier) error
+	GetTierFunc          func(ctx context.Context, tierID string) (*model.GuildTier, error)
+	ListTiersFunc        func(ctx context.Context, guildID string) ([]*model.GuildTier, error)
+	CountTiersFunc       func(ctx context.Context, guildID string) (int, error)
+	UpdateTierFunc       func(ctx context.Context, tierID string, updates map[string]interface{}) (*model.GuildTier, error)
+	DeleteTierFunc       func(ctx context.Context, tierID string) error
+	CountTierUsesFunc    func(ctx context.Context, tierID string) (int, error)
+	AssignTierFunc       func(ctx context.Context, assignment *model.GuildTierAssignment) error
+	GetAssignmentFunc    func(ctx context.Context, guildID string, userID string) (*model.GuildTierAssignment, error)
+	ListAssignmentsFunc  func(ctx context.Context, tierID string, now time.Time) ([]*model.GuildTierAssignment, error)
+	RemoveAssignmentFunc func(ctx context.Context, guildID string, userID string) (bool, error)
+}
+
+func (m *GuildTierRepository) CreateTier(ctx context.Context, tier *model.GuildTier) (r0 error) {
+	if m.CreateTierFunc != nil {
+		return m.CreateTierFunc(ctx, tier)
+	}
+	return
+}
+
+func (m *GuildTierRepository) GetTier(ctx context.Context, tierID string) (r0 *model.GuildTier, r1 error) {
+	if m.GetTierFunc != nil {
+		return m.GetTierFunc(ctx, tierID)
+	}
+	return
+}
+
+func (m *GuildTierRepository) ListTiers(ctx context.Context, guildID string) (r0 []*model.GuildTier, r1 error) {
+	if m.ListTiersFunc != nil {
+		return m.ListTiersFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildTierRepository) CountTiers(ctx context.Context, guildID string) (r0 int, r1 error) {
+	if m.CountTiersFunc != nil {
+		return m.CountTiersFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *GuildTierRepository) UpdateTier(ctx context.Context, tierID string, updates map[string]interface{}) (r0 *model.GuildTier, r1 error) {
+	if m.UpdateTierFunc != nil {
+		return m.UpdateTierFunc(ctx, tierID, updates)
+	}
+	return
+}
+
+func (m *GuildTierRepository) DeleteTier(ctx context.Context, tierID string) (r0 error) {
+	if m.DeleteTierFunc != nil {
+		return m.DeleteTierFunc(ctx, tierID)
+	}
+	return
+}
+
+func (m *GuildTierRepository) CountTierUses(ctx context.Context, tierID string) (r0 int, r1 error) {
+	if m.CountTierUsesFunc != nil {
+		return m.CountTierUsesFunc(ctx, tierID)
+	}
+	return
+}
+
+func (m *GuildTierRepository) AssignTier(ctx context.Context, assignment *model.GuildTierAssignment) (r0 error) {
+	if m.AssignTierFunc != nil {
+		return m.AssignTierFunc(ctx, assignment)
+	}
+	return
+}
+
+func (m *GuildTierRepository) GetAssignment(ctx context.Context, guildID string, userID string) (r0 *model.GuildTierAssignment, r1 error) {
+	if m.GetAssignmentFunc != nil {
+		return m.GetAssignmentFunc(ctx, guildID, userID)
+	}
+	return
+}
+
+func (m *GuildTierRepository) ListAssignments(ctx context.Context, tierID string, now time.Time) (r0 []*model.GuildTierAssignment, r1 error) {
+	if m.ListAssignmentsFunc != nil {
+		return m.ListAssignmentsFunc(ctx, tierID, now)
+	}
+	return
+}
+
+func (m *GuildTierRepository) RemoveAssignment(ctx context.Context, guildID string, userID string) (r0 bool, r1 error) {
+	if m.RemoveAssignmentFunc != nil {
+		return m.RemoveAssignmentFunc(ctx, guildID, userID)
+	}
+	return
+}
+
+// HandleRepository mocks service.HandleRepository
+type HandleRepository struct {
+	GetByIDFunc           func(ctx context.Context, id string) (*model.User, error)
+	GetByUsernameFunc     func(ctx context.Context, handle string) (*model.User, error)
+	GetHandleRedirectFunc func(ctx context.Context, handle string) (*model.HandleRedirect, error)
+	ChangeUsernameFunc    func(ctx context.Context, userID string, previous *string, handle string) error
+}
+
+func (m *HandleRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *HandleRepository) GetByUsername(ctx context.Context, handle string) (r0 *model.User, r1 error) {
+	if m.GetByUsernameFunc != nil {
+		return m.GetByUsernameFunc(ctx, handle)
+	}
+	return
+}
+
+func (m *HandleRepository) GetHandleRedirect(ctx context.Context, handle string) (r0 *model.HandleRedirect, r1 error) {
+	if m.GetHandleRedirectFunc != nil {
+		return m.GetHandleRedirectFunc(ctx, handle)
+	}
+	return
+}
+
+func (m *HandleRepository) ChangeUsername(ctx context.Context, userID string, previous *string, handle string) (r0 error) {
+	if m.ChangeUsernameFunc != nil {
+		return m.ChangeUsernameFunc(ctx, userID, previous, handle)
+	}
+	return
+}
+
+// HangoutTypeGuildRepository mocks service.HangoutTypeGuildRepository
+type HangoutTypeGuildRepository struct {
+	IsMemberFunc     func(ctx context.Context, userID string, guildID string) (bool, error)
+	IsGuildAdminFunc func(ctx context.Context, userID string, guildID string) (bool, error)
+}
+
+func (m *HangoutTypeGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *HangoutTypeGuildRepository) IsGuildAdmin(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildAdminFunc != nil {
+		return m.IsGuildAdminFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// HangoutTypeRepository mocks service.HangoutTypeRepository
+type HangoutTypeRepository struct {
+	CreateFunc          func(ctx context.Context, info *model.HangoutTypeInfo) error
+	GetByKeyFunc        func(ctx context.Context, key string, guildID string) (*model.HangoutTypeInfo, error)
+	ListFunc            func(ctx context.Context, guildID string, includeInactive bool) ([]*model.HangoutTypeInfo, error)
+	CountGuildTypesFunc func(ctx context.Context, guildID string) (int, error)
+	UpdateFunc          func(ctx context.Context, id string, updates map[string]interface{}) (*model.HangoutTypeInfo, error)
+	DeleteFunc          func(ctx context.Context, id string) error
+}
+
+func (m *HangoutTypeRepository) Create(ctx context.Context, info *model.HangoutTypeInfo) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, info)
+	}
+	return
+}
+
+func (m *HangoutTypeRepository) GetByKey(ctx context.Context, key string, guildID string) (r0 *model.HangoutTypeInfo, r1 error) {
+	if m.GetByKeyFunc != nil {
+		return m.GetByKeyFunc(ctx, key, guildID)
+	}
+	return
+}
+
+func (m *HangoutTypeRepository) List(ctx context.Context, guildID string, includeInactive bool) (r0 []*model.HangoutTypeInfo, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, guildID, includeInactive)
+	}
+	return
+}
+
+func (m *HangoutTypeRepository) CountGuildTypes(ctx context.Context, guildID string) (r0 int, r1 error) {
+	if m.CountGuildTypesFunc != nil {
+		return m.CountGuildTypesFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *HangoutTypeRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.HangoutTypeInfo, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *HangoutTypeRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// IPDenyRepository mocks service.IPDenyRepository
+type IPDenyRepository struct {
+	CreateFunc func(ctx context.Context, entry *model.IPDenyEntry) error
+	ListFunc   func(ctx context.Context) ([]*model.IPDenyEntry, error)
+	DeleteFunc func(ctx context.Context, id string) (*model.IPDenyEntry, error)
+}
+
+func (m *IPDenyRepository) Create(ctx context.Context, entry *model.IPDenyEntry) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, entry)
+	}
+	return
+}
+
+func (m *IPDenyRepository) List(ctx context.Context) (r0 []*model.IPDenyEntry, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+func (m *IPDenyRepository) Delete(ctx context.Context, id string) (r0 *model.IPDenyEntry, r1 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// IdempotencyRepository mocks service.IdempotencyRepository
+type IdempotencyRepository struct {
+	ClaimFunc         func(ctx context.Context, key string, now time.Time, lockedUntil time.Time, expiresOn time.Time) (*model.IdempotentResponse, bool, error)
+	CompleteFunc      func(ctx context.Context, key string, response *model.IdempotentResponse, expiresOn time.Time) error
+	DeleteFunc        func(ctx context.Context, key string) error
+	DeleteExpiredFunc func(ctx context.Context, now time.Time) (int, error)
+}
+
+func (m *IdempotencyRepository) Claim(ctx context.Context, key string, now time.Time, lockedUntil time.Time, expiresOn time.Time) (r0 *model.IdempotentResponse, r1 bool, r2 error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, key, now, lockedUntil, expiresOn)
+	}
+	return
+}
+
+func (m *IdempotencyRepository) Complete(ctx context.Context, key string, response *model.IdempotentResponse, expiresOn time.Time) (r0 error) {
+	if m.CompleteFunc != nil {
+		return m.CompleteFunc(ctx, key, response, expiresOn)
+	}
+	return
+}
+
+func (m *IdempotencyRepository) Delete(ctx context.Context, key string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, key)
+	}
+	return
+}
+
+func (m *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (r0 int, r1 error) {
+	if m.DeleteExpiredFunc != nil {
+		return m.DeleteExpiredFunc(ctx, now)
+	}
+	return
+}
+
+// IdentityRepository mocks service.IdentityRepository
+type IdentityRepository struct {
+	CreateFunc             func(ctx context.Context, identity *model.Identity) error
+	GetByProviderIDFunc    func(ctx context.Context, provider string, providerUserID string) (*model.Identity, error)
+	GetByUserIDFunc        func(ctx context.Context, userID string) ([]*model.Identity, error)
+	GetByProviderEmailFunc func(ctx context.Context, provider string, email string) (*model.Identity, error)
+	DeleteFunc             func(ctx context.Context, id string) error
+	CountByUserIDFunc      func(ctx context.Context, userID string) (int, error)
+}
+
+func (m *IdentityRepository) Create(ctx context.Context, identity *model.Identity) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, identity)
+	}
+	return
+}
+
+func (m *IdentityRepository) GetByProviderID(ctx context.Context, provider string, providerUserID string) (r0 *model.Identity, r1 error) {
+	if m.GetByProviderIDFunc != nil {
+		return m.GetByProviderIDFunc(ctx, provider, providerUserID)
+	}
+	return
+}
+
+func (m *IdentityRepository) GetByUserID(ctx context.Context, userID string) (r0 []*model.Identity, r1 error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *IdentityRepository) GetByProviderEmail(ctx context.Context, provider string, email string) (r0 *model.Identity, r1 error) {
+	if m.GetByProviderEmailFunc != nil {
+		return m.GetByProviderEmailFunc(ctx, provider, email)
+	}
+	return
+}
+
+func (m *IdentityRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *IdentityRepository) CountByUserID(ctx context.Context, userID string) (r0 int, r1 error) {
+	if m.CountByUserIDFunc != nil {
+		return m.CountByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+// InterestRepository mocks service.InterestRepository
+type InterestRepository struct {
+	GetAllFunc                 func(ctx context.Context) ([]*model.Interest, error)
+	GetByCategoryFunc          func(ctx context.Context, category string) ([]*model.Interest, error)
+	GetByIDFunc                func(ctx context.Context, id string) (*model.Interest, error)
+	CreateFunc                 func(ctx context.Context, interest *model.Interest) error
+	AddUserInterestFunc        func(ctx context.Context, userID string, interestID string, req *model.AddInterestRequest) error
+	UpdateUserInterestFunc     func(ctx context.Context, userID string, interestID string, updates map[string]interface{}) error
+	RemoveUserInterestFunc     func(ctx context.Context, userID string, interestID string) error
+	GetUserInterestsFunc       func(ctx context.Context, userID string) ([]*model.UserInterest, error)
+	FindTeachingMatchesFunc    func(ctx context.Context, userID string, limit int) ([]*model.InterestMatch, error)
+	FindLearningMatchesFunc    func(ctx context.Context, userID string, limit int) ([]*model.InterestMatch, error)
+	FindSharedInterestsFunc    func(ctx context.Context, userID string, limit int) ([]*model.SharedInterestUser, error)
+	GetUsersWithInterestFunc   func(ctx context.Context, interestID string) ([]*model.UserInterest, error)
+	GetTeachersForInterestFunc func(ctx context.Context, interestID string) ([]*model.UserInterest, error)
+	GetLearnersForInterestFunc func(ctx context.Context, interestID string) ([]*model.UserInterest, error)
+}
+
+func (m *InterestRepository) GetAll(ctx context.Context) (r0 []*model.Interest, r1 error) {
+	if m.GetAllFunc != nil {
+		return m.GetAllFunc(ctx)
+	}
+	return
+}
+
+func (m *InterestRepository) GetByCategory(ctx context.Context, category string) (r0 []*model.Interest, r1 error) {
+	if m.GetByCategoryFunc != nil {
+		return m.GetByCategoryFunc(ctx, category)
+	}
+	return
+}
+
+func (m *InterestRepository) GetByID(ctx context.Context, id string) (r0 *model.Interest, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *InterestRepository) Create(ctx context.Context, interest *model.Interest) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, interest)
+	}
+	return
+}
+
+func (m *InterestRepository) AddUserInterest(ctx context.Context, userID string, interestID string, req *model.AddInterestRequest) (r0 error) {
+	if m.AddUserInterestFunc != nil {
+		return m.AddUserInterestFunc(ctx, userID, interestID, req)
+	}
+	return
+}
+
+func (m *InterestRepository) UpdateUserInterest(ctx context.Context, userID string, interestID string, updates map[string]interface{}) (r0 error) {
+	if m.UpdateUserInterestFunc != nil {
+		return m.UpdateUserInterestFunc(ctx, userID, interestID, updates)
+	}
+	return
+}
+
+func (m *InterestRepository) RemoveUserInterest(ctx context.Context, userID string, interestID string) (r0 error) {
+	if m.RemoveUserInterestFunc != nil {
+		return m.RemoveUserInterestFunc(ctx, userID, interestID)
+	}
+	return
+}
+
+func (m *InterestRepository) GetUserInterests(ctx context.Context, userID string) (r0 []*model.UserInterest, r1 error) {
+	if m.GetUserInterestsFunc != nil {
+		return m.GetUserInterestsFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *InterestRepository) FindTeachingMatches(ctx context.Context, userID string, limit int) (r0 []*model.InterestMatch, r1 error) {
+	if m.FindTeachingMatchesFunc != nil {
+		return m.FindTeachingMatchesFunc(ctx, userID, limit)
+	}
+	return
+}
+
+func (m *InterestRepository) FindLearningMatches(ctx context.Context, userID string, limit int) (r0 []*model.InterestMatch, r1 error) {
+	if m.FindLearningMatchesFunc != nil {
+		return m.FindLearningMatchesFunc(ctx, userID, limit)
+	}
+	return
+}
+
+func (m *InterestRepository) FindSharedInterests(ctx context.Context, userID string, limit int) (r0 []*model.SharedInterestUser, r1 error) {
+	if m.FindSharedInterestsFunc != nil {
+		return m.FindSharedInterestsFunc(ctx, userID, limit)
+	}
+	return
+}
+
+func (m *InterestRepository) GetUsersWithInterest(ctx context.Context, interestID string) (r0 []*model.UserInterest, r1 error) {
+	if m.GetUsersWithInterestFunc != nil {
+		return m.GetUsersWithInterestFunc(ctx, interestID)
+	}
+	return
+}
+
+func (m *InterestRepository) GetTeachersForInterest(ctx context.Context, interestID string) (r0 []*model.UserInterest, r1 error) {
+	if m.GetTeachersForInterestFunc != nil {
+		return m.GetTeachersForInterestFunc(ctx, interestID)
+	}
+	return
+}
+
+func (m *InterestRepository) GetLearnersForInterest(ctx context.Context, interestID string) (r0 []*model.UserInterest, r1 error) {
+	if m.GetLearnersForInterestFunc != nil {
+		return m.GetLearnersForInterestFunc(ctx, interestID)
+	}
+	return
+}
+
+// LegalHoldRepository mocks service.LegalHoldRepository
+type LegalHoldRepository struct {
+	CreateFunc       func(ctx context.Context, hold *model.LegalHold) error
+	GetFunc          func(ctx context.Context, userID string) (*model.LegalHold, error)
+	DeleteFunc       func(ctx context.Context, userID string) (*model.LegalHold, error)
+	CreateAccessFunc func(ctx context.Context, access *model.LegalHoldAccess) error
+	ListAccessFunc   func(ctx context.Context, userID string, limit int) ([]*model.LegalHoldAccess, error)
+}
+
+func (m *LegalHoldRepository) Create(ctx context.Context, hold *model.LegalHold) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, hold)
+	}
+	return
+}
+
+func (m *LegalHoldRepository) Get(ctx context.Context, userID string) (r0 *model.LegalHold, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *LegalHoldRepository) Delete(ctx context.Context, userID string) (r0 *model.LegalHold, r1 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *LegalHoldRepository) CreateAccess(ctx context.Context, access *model.LegalHoldAccess) (r0 error) {
+	if m.CreateAccessFunc != nil {
+		return m.CreateAccessFunc(ctx, access)
+	}
+	return
+}
+
+func (m *LegalHoldRepository) ListAccess(ctx context.Context, userID string, limit int) (r0 []*model.LegalHoldAccess, r1 error) {
+	if m.ListAccessFunc != nil {
+		return m.ListAccessFunc(ctx, userID, limit)
+	}
+	return
+}
+
+// LegalHoldUserRepository mocks service.LegalHoldUserRepository
+type LegalHoldUserRepository struct {
+	GetByIDFunc func(ctx context.Context, id string) (*model.User, error)
+}
+
+func (m *LegalHoldUserRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+// MarketingEmailUserRepository mocks service.MarketingEmailUserRepository
+type MarketingEmailUserRepository struct {
+	GetByIDFunc func(ctx context.Context, id string) (*model.User, error)
+}
+
+func (m *MarketingEmailUserRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+// MemberRepository mocks service.MemberRepository
+type MemberRepository struct {
+	CreateFunc      func(ctx context.Context, member *model.Member) error
+	GetByIDFunc     func(ctx context.Context, id string) (*model.Member, error)
+	GetByUserIDFunc func(ctx context.Context, userID string) (*model.Member, error)
+	GetOrCreateFunc func(ctx context.Context, userID string, name string, email string) (*model.Member, error)
+	UpdateFunc      func(ctx context.Context, member *model.Member) error
+	DeleteFunc      func(ctx context.Context, id string) error
+}
+
+func (m *MemberRepository) Create(ctx context.Context, member *model.Member) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, member)
+	}
+	return
+}
+
+func (m *MemberRepository) GetByID(ctx context.Context, id string) (r0 *model.Member, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *MemberRepository) GetByUserID(ctx context.Context, userID string) (r0 *model.Member, r1 error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *MemberRepository) GetOrCreate(ctx context.Context, userID string, name string, email string) (r0 *model.Member, r1 error) {
+	if m.GetOrCreateFunc != nil {
+		return m.GetOrCreateFunc(ctx, userID, name, email)
+	}
+	return
+}
+
+func (m *MemberRepository) Update(ctx context.Context, member *model.Member) (r0 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, member)
+	}
+	return
+}
+
+func (m *MemberRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// ModerationRepository mocks service.ModerationRepository
+type ModerationRepository struct {
+	CreateReportFunc                func(ctx context.Context, report *model.Report) error
+	GetReportFunc                   func(ctx context.Context, id string) (*model.Report, error)
+	GetReportsByStatusFunc          func(ctx context.Context, status model.ReportStatus, limit int) ([]*model.Report, error)
+	GetReportsAgainstUserFunc       func(ctx context.Context, userID string) ([]*model.Report, error)
+	GetRecentReportsAgainstUserFunc func(ctx context.Context, userID string, days int) ([]*model.Report, error)
+	UpdateReportFunc                func(ctx context.Context, id string, updates map[string]interface{}) (*model.Report, error)
+	CreateActionFunc                func(ctx context.Context, action *model.ModerationAction) error
+	GetActionFunc                   func(ctx context.Context, id string) (*model.ModerationAction, error)
+	GetActiveActionsForUserFunc     func(ctx context.Context, userID string, now time.Time) ([]*model.ModerationAction, error)
+	GetAllActionsForUserFunc        func(ctx context.Context, userID string) ([]*model.ModerationAction, error)
+	UpdateActionFunc                func(ctx context.Context, id string, updates map[string]interface{}) error
+	ExpireOldActionsFunc            func(ctx context.Context, now time.Time) error
+	CreateBlockFunc                 func(ctx context.Context, block *model.Block) error
+	GetBlockFunc                    func(ctx context.Context, blockerID string, blockedID string) (*model.Block, error)
+	GetBlocksByBlockerFunc          func(ctx context.Context, blockerID string) ([]*model.Block, error)
+	IsBlockedFunc                   func(ctx context.Context, blockerID string, blockedID string) (bool, error)
+	IsBlockedEitherWayFunc          func(ctx context.Context, userID1 string, userID2 string) (bool, error)
+	DeleteBlockFunc                 func(ctx context.Context, blockerID string, blockedID string) error
+	GetModerationStatsFunc          func(ctx context.Context) (*model.ModerationStats, error)
+}
+
+func (m *ModerationRepository) CreateReport(ctx context.Context, report *model.Report) (r0 error) {
+	if m.CreateReportFunc != nil {
+		return m.CreateReportFunc(ctx, report)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetReport(ctx context.Context, id string) (r0 *model.Report, r1 error) {
+	if m.GetReportFunc != nil {
+		return m.GetReportFunc(ctx, id)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetReportsByStatus(ctx context.Context, status model.ReportStatus, limit int) (r0 []*model.Report, r1 error) {
+	if m.GetReportsByStatusFunc != nil {
+		return m.GetReportsByStatusFunc(ctx, status, limit)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetReportsAgainstUser(ctx context.Context, userID string) (r0 []*model.Report, r1 error) {
+	if m.GetReportsAgainstUserFunc != nil {
+		return m.GetReportsAgainstUserFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetRecentReportsAgainstUser(ctx context.Context, userID string, days int) (r0 []*model.Report, r1 error) {
+	if m.GetRecentReportsAgainstUserFunc != nil {
+		return m.GetRecentReportsAgainstUserFunc(ctx, userID, days)
+	}
+	return
+}
+
+func (m *ModerationRepository) UpdateReport(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.Report, r1 error) {
+	if m.UpdateReportFunc != nil {
+		return m.UpdateReportFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *ModerationRepository) CreateAction(ctx context.Context, action *model.ModerationAction) (r0 error) {
+	if m.CreateActionFunc != nil {
+		return m.CreateActionFunc(ctx, action)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetAction(ctx context.Context, id string) (r0 *model.ModerationAction, r1 error) {
+	if m.GetActionFunc != nil {
+		return m.GetActionFunc(ctx, id)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetActiveActionsForUser(ctx context.Context, userID string, now time.Time) (r0 []*model.ModerationAction, r1 error) {
+	if m.GetActiveActionsForUserFunc != nil {
+		return m.GetActiveActionsForUserFunc(ctx, userID, now)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetAllActionsForUser(ctx context.Context, userID string) (r0 []*model.ModerationAction, r1 error) {
+	if m.GetAllActionsForUserFunc != nil {
+		return m.GetAllActionsForUserFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ModerationRepository) UpdateAction(ctx context.Context, id string, updates map[string]interface{}) (r0 error) {
+	if m.UpdateActionFunc != nil {
+		return m.UpdateActionFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *ModerationRepository) ExpireOldActions(ctx context.Context, now time.Time) (r0 error) {
+	if m.ExpireOldActionsFunc != nil {
+		return m.ExpireOldActionsFunc(ctx, now)
+	}
+	return
+}
+
+func (m *ModerationRepository) CreateBlock(ctx context.Context, block *model.Block) (r0 error) {
+	if m.CreateBlockFunc != nil {
+		return m.CreateBlockFunc(ctx, block)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetBlock(ctx context.Context, blockerID string, blockedID string) (r0 *model.Block, r1 error) {
+	if m.GetBlockFunc != nil {
+		return m.GetBlockFunc(ctx, blockerID, blockedID)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetBlocksByBlocker(ctx context.Context, blockerID string) (r0 []*model.Block, r1 error) {
+	if m.GetBlocksByBlockerFunc != nil {
+		return m.GetBlocksByBlockerFunc(ctx, blockerID)
+	}
+	return
+}
+
+func (m *ModerationRepository) IsBlocked(ctx context.Context, blockerID string, blockedID string) (r0 bool, r1 error) {
+	if m.IsBlockedFunc != nil {
+		return m.IsBlockedFunc(ctx, blockerID, blockedID)
+	}
+	return
+}
+
+func (m *ModerationRepository) IsBlockedEitherWay(ctx context.Context, userID1 string, userID2 string) (r0 bool, r1 error) {
+	if m.IsBlockedEitherWayFunc != nil {
+		return m.IsBlockedEitherWayFunc(ctx, userID1, userID2)
+	}
+	return
+}
+
+func (m *ModerationRepository) DeleteBlock(ctx context.Context, blockerID string, blockedID string) (r0 error) {
+	if m.DeleteBlockFunc != nil {
+		return m.DeleteBlockFunc(ctx, blockerID, blockedID)
+	}
+	return
+}
+
+func (m *ModerationRepository) GetModerationStats(ctx context.Context) (r0 *model.ModerationStats, r1 error) {
+	if m.GetModerationStatsFunc != nil {
+		return m.GetModerationStatsFunc(ctx)
+	}
+	return
+}
+
+// MuteGuildRepository mocks service.MuteGuildRepository
+type MuteGuildRepository struct {
+	IsMemberFunc func(ctx context.Context, userID string, guildID string) (bool, error)
+}
+
+func (m *MuteGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// MuteRepository mocks service.MuteRepository
+type MuteRepository struct {
+	CreateMuteFunc   func(ctx context.Context, mute *model.Mute) error
+	ListMutesFunc    func(ctx context.Context, userID string, now time.Time) ([]*model.Mute, error)
+	CountMutesFunc   func(ctx context.Context, userID string, now time.Time) (int, error)
+	DeleteMuteFunc   func(ctx context.Context, userID string, muteID string) (bool, error)
+	ListMutesForFunc func(ctx context.Context, scope model.NotificationScope, now time.Time) ([]*model.Mute, error)
+}
+
+func (m *MuteRepository) CreateMute(ctx context.Context, mute *model.Mute) (r0 error) {
+	if m.CreateMuteFunc != nil {
+		return m.CreateMuteFunc(ctx, mute)
+	}
+	return
+}
+
+func (m *MuteRepository) ListMutes(ctx context.Context, userID string, now time.Time) (r0 []*model.Mute, r1 error) {
+	if m.ListMutesFunc != nil {
+		return m.ListMutesFunc(ctx, userID, now)
+	}
+	return
+}
+
+func (m *MuteRepository) CountMutes(ctx context.Context, userID string, now time.Time) (r0 int, r1 error) {
+	if m.CountMutesFunc != nil {
+		return m.CountMutesFunc(ctx, userID, now)
+	}
+	return
+}
+
+func (m *MuteRepository) DeleteMute(ctx context.Context, userID string, muteID string) (r0 bool, r1 error) {
+	if m.DeleteMuteFunc != nil {
+		return m.DeleteMuteFunc(ctx, userID, muteID)
+	}
+	return
+}
+
+func (m *MuteRepository) ListMutesFor(ctx context.Context, scope model.NotificationScope, now time.Time) (r0 []*model.Mute, r1 error) {
+	if m.ListMutesForFunc != nil {
+		return m.ListMutesForFunc(ctx, scope, now)
+	}
+	return
+}
+
+// NoShowEventRepository mocks service.NoShowEventRepository
+type NoShowEventRepository struct {
+	GetFunc     func(ctx context.Context, eventID string) (*model.Event, error)
+	IsHostFunc  func(ctx context.Context, eventID string, userID string) (bool, error)
+	GetRSVPFunc func(ctx context.Context, eventID string, userID string) (*model.EventRSVP, error)
+}
+
+func (m *NoShowEventRepository) Get(ctx context.Context, eventID string) (r0 *model.Event, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *NoShowEventRepository) IsHost(ctx context.Context, eventID string, userID string) (r0 bool, r1 error) {
+	if m.IsHostFunc != nil {
+		return m.IsHostFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *NoShowEventRepository) GetRSVP(ctx context.Context, eventID string, userID string) (r0 *model.EventRSVP, r1 error) {
+	if m.GetRSVPFunc != nil {
+		return m.GetRSVPFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+// NoShowGuildRepository mocks service.NoShowGuildRepository
+type NoShowGuildRepository struct {
+	IsMemberFunc     func(ctx context.Context, userID string, guildID string) (bool, error)
+	IsGuildAdminFunc func(ctx context.Context, userID string, guildID string) (bool, error)
+}
+
+func (m *NoShowGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *NoShowGuildRepository) IsGuildAdmin(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildAdminFunc != nil {
+		return m.IsGuildAdminFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// NoShowRepository mocks service.NoShowRepository
+type NoShowRepository struct {
+	GetEventsAwaitingCheckFunc func(ctx context.Context, endedBefore time.Time, limit int) ([]*model.NoShowEventRef, error)
+	GetUnattendedUserIDsFunc   func(ctx context.Context, eventID string) ([]string, error)
+	MarkEventCheckedFunc       func(ctx context.Context, eventID string) error
+	CreateFunc                 func(ctx context.Context, noShow *model.NoShow) error
+	GetFunc                    func(ctx context.Context, eventID string, userID string) (*model.NoShow, error)
+	GetByUserFunc              func(ctx context.Context, userID string, since time.Time) ([]*model.NoShow, error)
+	DeleteFunc                 func(ctx context.Context, id string) error
+	CountCheckedRSVPsFunc      func(ctx context.Context, userID string, since time.Time) (int, error)
+	GetPolicyFunc              func(ctx context.Context, guildID string) (*model.GuildNoShowPolicy, error)
+	UpsertPolicyFunc           func(ctx context.Context, policy *model.GuildNoShowPolicy) error
+}
+
+func (m *NoShowRepository) GetEventsAwaitingCheck(ctx context.Context, endedBefore time.Time, limit int) (r0 []*model.NoShowEventRef, r1 error) {
+	if m.GetEventsAwaitingCheckFunc != nil {
+		return m.GetEventsAwaitingCheckFunc(ctx, endedBefore, limit)
+	}
+	return
+}
+
+func (m *NoShowRepository) GetUnattendedUserIDs(ctx context.Context, eventID string) (r0 []string, r1 error) {
+	if m.GetUnattendedUserIDsFunc != nil {
+		return m.GetUnattendedUserIDsFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *NoShowRepository) MarkEventChecked(ctx context.Context, eventID string) (r0 error) {
+	if m.MarkEventCheckedFunc != nil {
+		return m.MarkEventCheckedFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *NoShowRepository) Create(ctx context.Context, noShow *model.NoShow) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, noShow)
+	}
+	return
+}
+
+func (m *NoShowRepository) Get(ctx context.Context, eventID string, userID string) (r0 *model.NoShow, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *NoShowRepository) GetByUser(ctx context.Context, userID string, since time.Time) (r0 []*model.NoShow, r1 error) {
+	if m.GetByUserFunc != nil {
+		return m.GetByUserFunc(ctx, userID, since)
+	}
+	return
+}
+
+func (m *NoShowRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *NoShowRepository) CountCheckedRSVPs(ctx context.Context, userID string, since time.Time) (r0 int, r1 error) {
+	if m.CountCheckedRSVPsFunc != nil {
+		return m.CountCheckedRSVPsFunc(ctx, userID, since)
+	}
+	return
+}
+
+func (m *NoShowRepository) GetPolicy(ctx context.Context, guildID string) (r0 *model.GuildNoShowPolicy, r1 error) {
+	if m.GetPolicyFunc != nil {
+		return m.GetPolicyFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *NoShowRepository) UpsertPolicy(ctx context.Context, policy *model.GuildNoShowPolicy) (r0 error) {
+	if m.UpsertPolicyFunc != nil {
+		return m.UpsertPolicyFunc(ctx, policy)
+	}
+	return
+}
+
+// NotificationOutboxRepository mocks service.NotificationOutboxRepository
+type NotificationOutboxRepository struct {
+	CreateFunc     func(ctx context.Context, entry *model.NotificationOutboxEntry) error
+	ClaimDueFunc   func(ctx context.Context, now time.Time, leaseUntil time.Time) ([]*model.NotificationOutboxEntry, error)
+	DeleteFunc     func(ctx context.Context, id string) error
+	RescheduleFunc func(ctx context.Context, id string, lastError string, availableOn time.Time) error
+	MarkDeadFunc   func(ctx context.Context, id string, lastError string, deadOn time.Time) error
+}
+
+func (m *NotificationOutboxRepository) Create(ctx context.Context, entry *model.NotificationOutboxEntry) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, entry)
+	}
+	return
+}
+
+func (m *NotificationOutboxRepository) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time) (r0 []*model.NotificationOutboxEntry, r1 error) {
+	if m.ClaimDueFunc != nil {
+		return m.ClaimDueFunc(ctx, now, leaseUntil)
+	}
+	return
+}
+
+func (m *NotificationOutboxRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *NotificationOutboxRepository) Reschedule(ctx context.Context, id string, lastError string, availableOn time.Time) (r0 error) {
+	if m.RescheduleFunc != nil {
+		return m.RescheduleFunc(ctx, id, lastError, availableOn)
+	}
+	return
+}
+
+func (m *NotificationOutboxRepository) MarkDead(ctx context.Context, id string, lastError string, deadOn time.Time) (r0 error) {
+	if m.MarkDeadFunc != nil {
+		return m.MarkDeadFunc(ctx, id, lastError, deadOn)
+	}
+	return
+}
+
+// NudgePreferenceRepository mocks service.NudgePreferenceRepository
+type NudgePreferenceRepository struct {
+	GetPreferenceFunc func(ctx context.Context, userID string, nudgeType model.NudgeType) (*model.NudgePreference, error)
+}
+
+func (m *NudgePreferenceRepository) GetPreference(ctx context.Context, userID string, nudgeType model.NudgeType) (r0 *model.NudgePreference, r1 error) {
+	if m.GetPreferenceFunc != nil {
+		return m.GetPreferenceFunc(ctx, userID, nudgeType)
+	}
+	return
+}
+
+// OutboxRepository mocks service.OutboxRepository
+type OutboxRepository struct {
+	CreateFunc     func(ctx context.Context, entry *model.OutboxEntry) error
+	ClaimDueFunc   func(ctx context.Context, now time.Time, leaseUntil time.Time) ([]*model.OutboxEntry, error)
+	DeleteFunc     func(ctx context.Context, id string) error
+	RescheduleFunc func(ctx context.Context, id string, lastError string, availableOn time.Time) error
+	MarkDeadFunc   func(ctx context.Context, id string, lastError string, deadOn time.Time) error
+}
+
+func (m *OutboxRepository) Create(ctx context.Context, entry *model.OutboxEntry) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, entry)
+	}
+	return
+}
+
+func (m *OutboxRepository) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time) (r0 []*model.OutboxEntry, r1 error) {
+	if m.ClaimDueFunc != nil {
+		return m.ClaimDueFunc(ctx, now, leaseUntil)
+	}
+	return
+}
+
+func (m *OutboxRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *OutboxRepository) Reschedule(ctx context.Context, id string, lastError string, availableOn time.Time) (r0 error) {
+	if m.RescheduleFunc != nil {
+		return m.RescheduleFunc(ctx, id, lastError, availableOn)
+	}
+	return
+}
+
+func (m *OutboxRepository) MarkDead(ctx context.Context, id string, lastError string, deadOn time.Time) (r0 error) {
+	if m.MarkDeadFunc != nil {
+		return m.MarkDeadFunc(ctx, id, lastError, deadOn)
+	}
+	return
+}
+
+// PasskeyRepository mocks service.PasskeyRepository
+type PasskeyRepository struct {
+	CreateFunc            func(ctx context.Context, passkey *model.Passkey) error
+	GetByCredentialIDFunc func(ctx context.Context, credentialID string) (*model.Passkey, error)
+	GetByUserIDFunc       func(ctx context.Context, userID string) ([]*model.Passkey, error)
+	GetByIDFunc           func(ctx context.Context, id string) (*model.Passkey, error)
+	UpdateSignCountFunc   func(ctx context.Context, credentialID string, signCount uint32) error
+	DeleteFunc            func(ctx context.Context, id string) error
+	CountByUserIDFunc     func(ctx context.Context, userID string) (int, error)
+}
+
+func (m *PasskeyRepository) Create(ctx context.Context, passkey *model.Passkey) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, passkey)
+	}
+	return
+}
+
+func (m *PasskeyRepository) GetByCredentialID(ctx context.Context, credentialID string) (r0 *model.Passkey, r1 error) {
+	if m.GetByCredentialIDFunc != nil {
+		return m.GetByCredentialIDFunc(ctx, credentialID)
+	}
+	return
+}
+
+func (m *PasskeyRepository) GetByUserID(ctx context.Context, userID string) (r0 []*model.Passkey, r1 error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *PasskeyRepository) GetByID(ctx context.Context, id string) (r0 *model.Passkey, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *PasskeyRepository) UpdateSignCount(ctx context.Context, credentialID string, signCount uint32) (r0 error) {
+	if m.UpdateSignCountFunc != nil {
+		return m.UpdateSignCountFunc(ctx, credentialID, signCount)
+	}
+	return
+}
+
+func (m *PasskeyRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *PasskeyRepository) CountByUserID(ctx context.Context, userID string) (r0 int, r1 error) {
+	if m.CountByUserIDFunc != nil {
+		return m.CountByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+// PaymentEventRepository mocks service.PaymentEventRepository
+type PaymentEventRepository struct {
+	GetFunc              func(ctx context.Context, eventID string) (*model.Event, error)
+	IsHostFunc           func(ctx context.Context, eventID string, userID string) (bool, error)
+	GetRSVPFunc          func(ctx context.Context, eventID string, userID string) (*model.EventRSVP, error)
+	UpdateRSVPFunc       func(ctx context.Context, rsvpID string, updates map[string]interface{}) (*model.EventRSVP, error)
+	GetActiveTicketFunc  func(ctx context.Context, eventID string, userID string) (*model.EventTicket, error)
+	ExtendTicketHoldFunc func(ctx context.Context, ticketID string, until time.Time) (*model.EventTicket, error)
+	ClaimTicketFunc      func(ctx context.Context, ticketID string) (*model.EventTicket, error)
+	VoidTicketFunc       func(ctx context.Context, ticketID string) error
+}
+
+func (m *PaymentEventRepository) Get(ctx context.Context, eventID string) (r0 *model.Event, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *PaymentEventRepository) IsHost(ctx context.Context, eventID string, userID string) (r0 bool, r1 error) {
+	if m.IsHostFunc != nil {
+		return m.IsHostFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *PaymentEventRepository) GetRSVP(ctx context.Context, eventID string, userID string) (r0 *model.EventRSVP, r1 error) {
+	if m.GetRSVPFunc != nil {
+		return m.GetRSVPFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *PaymentEventRepository) UpdateRSVP(ctx context.Context, rsvpID string, updates map[string]interface{}) (r0 *model.EventRSVP, r1 error) {
+	if m.UpdateRSVPFunc != nil {
+		return m.UpdateRSVPFunc(ctx, rsvpID, updates)
+	}
+	return
+}
+
+func (m *PaymentEventRepository) GetActiveTicket(ctx context.Context, eventID string, userID string) (r0 *model.EventTicket, r1 error) {
+	if m.GetActiveTicketFunc != nil {
+		return m.GetActiveTicketFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *PaymentEventRepository) ExtendTicketHold(ctx context.Context, ticketID string, until time.Time) (r0 *model.EventTicket, r1 error) {
+	if m.ExtendTicketHoldFunc != nil {
+		return m.ExtendTicketHoldFunc(ctx, ticketID, until)
+	}
+	return
+}
+
+func (m *PaymentEventRepository) ClaimTicket(ctx context.Context, ticketID string) (r0 *model.EventTicket, r1 error) {
+	if m.ClaimTicketFunc != nil {
+		return m.ClaimTicketFunc(ctx, ticketID)
+	}
+	return
+}
+
+func (m *PaymentEventRepository) VoidTicket(ctx context.Context, ticketID string) (r0 error) {
+	if m.VoidTicketFunc != nil {
+		return m.VoidTicketFunc(ctx, ticketID)
+	}
+	return
+}
+
+// PaymentGuildRepository mocks service.PaymentGuildRepository
+type PaymentGuildRepository struct {
+	IsMemberFunc      func(ctx context.Context, userID string, guildID string) (bool, error)
+	GetMemberRoleFunc func(ctx context.Context, userID string, guildID string) (model.GuildRole, error)
+}
+
+func (m *PaymentGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *PaymentGuildRepository) GetMemberRole(ctx context.Context, userID string, guildID string) (r0 model.GuildRole, r1 error) {
+	if m.GetMemberRoleFunc != nil {
+		return m.GetMemberRoleFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// PaymentRepository mocks service.PaymentRepository
+type PaymentRepository struct {
+	CreatePaymentFunc             func(ctx context.Context, payment *model.EventPayment) error
+	GetPaymentFunc                func(ctx context.Context, paymentID string) (*model.EventPayment, error)
+	SetCheckoutSessionFunc        func(ctx context.Context, paymentID string, sessionID string) error
+	MarkPaymentPaidFunc           func(ctx context.Context, paymentID string, paymentIntentID string) (*model.EventPayment, error)
+	MarkPaymentExpiredFunc        func(ctx context.Context, paymentID string) (*model.EventPayment, error)
+	MarkPaymentRefundedFunc       func(ctx context.Context, paymentID string, refundID string) (*model.EventPayment, error)
+	GetPaidPaymentFunc            func(ctx context.Context, eventID string, userID string) (*model.EventPayment, error)
+	GetPaidPaymentsByEventFunc    func(ctx context.Context, eventID string) ([]*model.EventPayment, error)
+	GetSettledPaymentsByEventFunc func(ctx context.Context, eventID string) ([]*model.EventPayment, error)
+	GetPayoutAccountFunc          func(ctx context.Context, userID string) (*model.PayoutAccount, error)
+	CreatePayoutAccountFunc       func(ctx context.Context, account *model.PayoutAccount) error
+	UpdatePayoutAccountFunc       func(ctx context.Context, accountID string, chargesEnabled bool, payoutsEnabled bool, detailsSubmitted bool) (*model.PayoutAccount, error)
+	CreatePromoCodeFunc           func(ctx context.Context, promo *model.PromoCode) error
+	GetPromoCodeFunc              func(ctx context.Context, promoID string) (*model.PromoCode, error)
+	GetPromoCodeByCodeFunc        func(ctx context.Context, eventID string, code string) (*model.PromoCode, error)
+	ListPromoCodesFunc            func(ctx context.Context, eventID string) ([]*model.PromoCode, error)
+	CountPromoCodesFunc           func(ctx context.Context, eventID string) (int, error)
+	UpdatePromoCodeFunc           func(ctx context.Context, promoID string, updates map[string]interface{}) (*model.PromoCode, error)
+	DeletePromoCodeFunc           func(ctx context.Context, promoID string) (bool, error)
+	ReservePromoCodeFunc          func(ctx context.Context, promoID string, now time.Time) (*model.PromoCode, error)
+	ReleasePromoCodeFunc          func(ctx context.Context, promoID string) error
+	SetMemberDiscountsFunc        func(ctx context.Context, eventID string, discounts []model.MemberDiscount) error
+}
+
+func (m *PaymentRepository) CreatePayment(ctx context.Context, payment *model.EventPayment) (r0 error) {
+	if m.CreatePaymentFunc != nil {
+		return m.CreatePaymentFunc(ctx, payment)
+	}
+	return
+}
+
+func (m *PaymentRepository) GetPayment(ctx context.Context, paymentID string) (r0 *model.EventPayment, r1 error) {
+	if m.GetPaymentFunc != nil {
+		return m.GetPaymentFunc(ctx, paymentID)
+	}
+	return
+}
+
+func (m *PaymentRepository) SetCheckoutSession(ctx context.Context, paymentID string, sessionID string) (r0 error) {
+	if m.SetCheckoutSessionFunc != nil {
+		return m.SetCheckoutSessionFunc(ctx, paymentID, sessionID)
+	}
+	return
+}
+
+func (m *PaymentRepository) MarkPaymentPaid(ctx context.Context, paymentID string, paymentIntentID string) (r0 *model.EventPayment, r1 error) {
+	if m.MarkPaymentPaidFunc != nil {
+		return m.MarkPaymentPaidFunc(ctx, paymentID, paymentIntentID)
+	}
+	return
+}
+
+func (m *PaymentRepository) MarkPaymentExpired(ctx context.Context, paymentID string) (r0 *model.EventPayment, r1 error) {
+	if m.MarkPaymentExpiredFunc != nil {
+		return m.MarkPaymentExpiredFunc(ctx, paymentID)
+	}
+	return
+}
+
+func (m *PaymentRepository) MarkPaymentRefunded(ctx context.Context, paymentID string, refundID string) (r0 *model.EventPayment, r1 error) {
+	if m.MarkPaymentRefundedFunc != nil {
+		return m.MarkPaymentRefundedFunc(ctx, paymentID, refundID)
+	}
+	return
+}
+
+func (m *PaymentRepository) GetPaidPayment(ctx context.Context, eventID string, userID string) (r0 *model.EventPayment, r1 error) {
+	if m.GetPaidPaymentFunc != nil {
+		return m.GetPaidPaymentFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *PaymentRepository) GetPaidPaymentsByEvent(ctx context.Context, eventID string) (r0 []*model.EventPayment, r1 error) {
+	if m.GetPaidPaymentsByEventFunc != nil {
+		return m.GetPaidPaymentsByEventFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *PaymentRepository) GetSettledPaymentsByEvent(ctx context.Context, eventID string) (r0 []*model.EventPayment, r1 error) {
+	if m.GetSettledPaymentsByEventFunc != nil {
+		return m.GetSettledPaymentsByEventFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *PaymentRepository) GetPayoutAccount(ctx context.Context, userID string) (r0 *model.PayoutAccount, r1 error) {
+	if m.GetPayoutAccountFunc != nil {
+		return m.GetPayoutAccountFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *PaymentRepository) CreatePayoutAccount(ctx context.Context, account *model.PayoutAccount) (r0 error) {
+	if m.CreatePayoutAccountFunc != nil {
+		return m.CreatePayoutAccountFunc(ctx, account)
+	}
+	return
+}
+
+func (m *PaymentRepository) UpdatePayoutAccount(ctx context.Context, accountID string, chargesEnabled bool, payoutsEnabled bool, detailsSubmitted bool) (r0 *model.PayoutAccount, r1 error) {
+	if m.UpdatePayoutAccountFunc != nil {
+		return m.UpdatePayoutAccountFunc(ctx, accountID, chargesEnabled, payoutsEnabled, detailsSubmitted)
+	}
+	return
+}
+
+func (m *PaymentRepository) CreatePromoCode(ctx context.Context, promo *model.PromoCode) (r0 error) {
+	if m.CreatePromoCodeFunc != nil {
+		return m.CreatePromoCodeFunc(ctx, promo)
+	}
+	return
+}
+
+func (m *PaymentRepository) GetPromoCode(ctx context.Context, promoID string) (r0 *model.PromoCode, r1 error) {
+	if m.GetPromoCodeFunc != nil {
+		return m.GetPromoCodeFunc(ctx, promoID)
+	}
+	return
+}
+
+func (m *PaymentRepository) GetPromoCodeByCode(ctx context.Context, eventID string, code string) (r0 *model.PromoCode, r1 error) {
+	if m.GetPromoCodeByCodeFunc != nil {
+		return m.GetPromoCodeByCodeFunc(ctx, eventID, code)
+	}
+	return
+}
+
+func (m *PaymentRepository) ListPromoCodes(ctx context.Context, eventID string) (r0 []*model.PromoCode, r1 error) {
+	if m.ListPromoCodesFunc != nil {
+		return m.ListPromoCodesFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *PaymentRepository) CountPromoCodes(ctx context.Context, eventID string) (r0 int, r1 error) {
+	if m.CountPromoCodesFunc != nil {
+		return m.CountPromoCodesFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *PaymentRepository) UpdatePromoCode(ctx context.Context, promoID string, updates map[string]interface{}) (r0 *model.PromoCode, r1 error) {
+	if m.UpdatePromoCodeFunc != nil {
+		return m.UpdatePromoCodeFunc(ctx, promoID, updates)
+	}
+	return
+}
+
+func (m *PaymentRepository) DeletePromoCode(ctx context.Context, promoID string) (r0 bool, r1 error) {
+	if m.DeletePromoCodeFunc != nil {
+		return m.DeletePromoCodeFunc(ctx, promoID)
+	}
+	return
+}
+
+func (m *PaymentRepository) ReservePromoCode(ctx context.Context, promoID string, now time.Time) (r0 *model.PromoCode, r1 error) {
+	if m.ReservePromoCodeFunc != nil {
+		return m.ReservePromoCodeFunc(ctx, promoID, now)
+	}
+	return
+}
+
+func (m *PaymentRepository) ReleasePromoCode(ctx context.Context, promoID string) (r0 error) {
+	if m.ReleasePromoCodeFunc != nil {
+		return m.ReleasePromoCodeFunc(ctx, promoID)
+	}
+	return
+}
+
+func (m *PaymentRepository) SetMemberDiscounts(ctx context.Context, eventID string, discounts []model.MemberDiscount) (r0 error) {
+	if m.SetMemberDiscountsFunc != nil {
+		return m.SetMemberDiscountsFunc(ctx, eventID, discounts)
+	}
+	return
+}
+
+// PermissionGuildRepository mocks service.PermissionGuildRepository
+type PermissionGuildRepository struct {
+	IsMemberFunc      func(ctx context.Context, userID string, guildID string) (bool, error)
+	GetMemberRoleFunc func(ctx context.Context, userID string, guildID string) (model.GuildRole, error)
+}
+
+func (m *PermissionGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *PermissionGuildRepository) GetMemberRole(ctx context.Context, userID string, guildID string) (r0 model.GuildRole, r1 error) {
+	if m.GetMemberRoleFunc != nil {
+		return m.GetMemberRoleFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// PoolRepository mocks service.PoolRepository
+type PoolRepository struct {
+	CreatePoolFunc              func(ctx context.Context, pool *model.MatchingPool) error
+	GetPoolFunc                 func(ctx context.Context, poolID string) (*model.MatchingPool, error)
+	GetPoolsByGuildFunc         func(ctx context.Context, guildID string) ([]*model.MatchingPool, error)
+	UpdatePoolFunc              func(ctx context.Context, poolID string, updates map[string]interface{}) (*model.MatchingPool, error)
+	MoveToTrashFunc             func(ctx context.Context, poolID string, userID string) (bool, error)
+	CountPoolsByGuildFunc       func(ctx context.Context, guildID string) (int, error)
+	AddMemberFunc               func(ctx context.Context, member *model.PoolMember) error
+	GetMemberFunc               func(ctx context.Context, poolID string, memberID string) (*model.PoolMember, error)
+	GetMemberByUserFunc         func(ctx context.Context, poolID string, userID string) (*model.PoolMember, error)
+	GetPoolMembersFunc          func(ctx context.Context, poolID string) ([]*model.PoolMember, error)
+	UpdateMemberFunc            func(ctx context.Context, membershipID string, updates map[string]interface{}) (*model.PoolMember, error)
+	RemoveMemberFunc            func(ctx context.Context, membershipID string) error
+	GetUserPoolMembershipsFunc  func(ctx context.Context, userID string) ([]*model.PoolMember, error)
+	CreateMatchResultFunc       func(ctx context.Context, match *model.MatchResult) error
+	GetMatchResultFunc          func(ctx context.Context, matchID string) (*model.MatchResult, error)
+	GetMatchesByPoolFunc        func(ctx context.Context, poolID string, limit int) ([]*model.MatchResult, error)
+	GetMatchesByRoundFunc       func(ctx context.Context, poolID string, round string) ([]*model.MatchResult, error)
+	GetUserPendingMatchesFunc   func(ctx context.Context, userID string) ([]*model.MatchResult, error)
+	GetRecentMatchesBetweenFunc func(ctx context.Context, memberIDs []string, days int) ([]*model.MatchResult, error)
+	UpdateMatchResultFunc       func(ctx context.Context, matchID string, updates map[string]interface{}) (*model.MatchResult, error)
+	GetPoolsDueForMatchingFunc  func(ctx context.Context) ([]*model.MatchingPool, error)
+	GetPoolStatsFunc            func(ctx context.Context, poolID string) (*model.PoolStats, error)
+	GetStaleMatchesFunc         func(ctx context.Context, cutoff time.Time, status string) ([]*model.MatchResult, error)
+}
+
+func (m *PoolRepository) CreatePool(ctx context.Context, pool *model.MatchingPool) (r0 error) {
+	if m.CreatePoolFunc != nil {
+		return m.CreatePoolFunc(ctx, pool)
+	}
+	return
+}
+
+func (m *PoolRepository) GetPool(ctx context.Context, poolID string) (r0 *model.MatchingPool, r1 error) {
+	if m.GetPoolFunc != nil {
+		return m.GetPoolFunc(ctx, poolID)
+	}
+	return
+}
+
+func (m *PoolRepository) GetPoolsByGuild(ctx context.Context, guildID string) (r0 []*model.MatchingPool, r1 error) {
+	if m.GetPoolsByGuildFunc != nil {
+		return m.GetPoolsByGuildFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *PoolRepository) UpdatePool(ctx context.Context, poolID string, updates map[string]interface{}) (r0 *model.MatchingPool, r1 error) {
+	if m.UpdatePoolFunc != nil {
+		return m.UpdatePoolFunc(ctx, poolID, updates)
+	}
+	return
+}
+
+func (m *PoolRepository) MoveToTrash(ctx context.Context, poolID string, userID string) (r0 bool, r1 error) {
+	if m.MoveToTrashFunc != nil {
+		return m.MoveToTrashFunc(ctx, poolID, userID)
+	}
+	return
+}
+
+func (m *PoolRepository) CountPoolsByGuild(ctx context.Context, guildID string) (r0 int, r1 error) {
+	if m.CountPoolsByGuildFunc != nil {
+		return m.CountPoolsByGuildFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *PoolRepository) AddMember(ctx context.Context, member *model.PoolMember) (r0 error) {
+	if m.AddMemberFunc != nil {
+		return m.AddMemberFunc(ctx, member)
+	}
+	return
+}
+
+func (m *PoolRepository) GetMember(ctx context.Context, poolID string, memberID string) (r0 *model.PoolMember, r1 error) {
+	if m.GetMemberFunc != nil {
+		return m.GetMemberFunc(ctx, poolID, memberID)
+	}
+	return
+}
+
+func (m *PoolRepository) GetMemberByUser(ctx context.Context, poolID string, userID string) (r0 *model.PoolMember, r1 error) {
+	if m.GetMemberByUserFunc != nil {
+		return m.GetMemberByUserFunc(ctx, poolID, userID)
+	}
+	return
+}
+
+func (m *PoolRepository) GetPoolMembers(ctx context.Context, poolID string) (r0 []*model.PoolMember, r1 error) {
+	if m.GetPoolMembersFunc != nil {
+		return m.GetPoolMembersFunc(ctx, poolID)
+	}
+	return
+}
+
+func (m *PoolRepository) UpdateMember(ctx context.Context, membershipID string, updates map[string]interface{}) (r0 *model.PoolMember, r1 error) {
+	if m.UpdateMemberFunc != nil {
+		return m.UpdateMemberFunc(ctx, membershipID, updates)
+	}
+	return
+}
+
+func (m *PoolRepository) RemoveMember(ctx context.Context, membershipID string) (r0 error) {
+	if m.RemoveMemberFunc != nil {
+		return m.RemoveMemberFunc(ctx, membershipID)
+	}
+	return
+}
+
+func (m *PoolRepository) GetUserPoolMemberships(ctx context.Context, userID string) (r0 []*model.PoolMember, r1 error) {
+	if m.GetUserPoolMembershipsFunc != nil {
+		return m.GetUserPoolMembershipsFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *PoolRepository) CreateMatchResult(ctx context.Context, match *model.MatchResult) (r0 error) {
+	if m.CreateMatchResultFunc != nil {
+		return m.CreateMatchResultFunc(ctx, match)
+	}
+	return
+}
+
+func (m *PoolRepository) GetMatchResult(ctx context.Context, matchID string) (r0 *model.MatchResult, r1 error) {
+	if m.GetMatchResultFunc != nil {
+		return m.GetMatchResultFunc(ctx, matchID)
+	}
+	return
+}
+
+func (m *PoolRepository) GetMatchesByPool(ctx context.Context, poolID string, limit int) (r0 []*model.MatchResult, r1 error) {
+	if m.GetMatchesByPoolFunc != nil {
+		return m.GetMatchesByPoolFunc(ctx, poolID, limit)
+	}
+	return
+}
+
+func (m *PoolRepository) GetMatchesByRound(ctx context.Context, poolID string, round string) (r0 []*model.MatchResult, r1 error) {
+	if m.GetMatchesByRoundFunc != nil {
+		return m.GetMatchesByRoundFunc(ctx, poolID, round)
+	}
+	return
+}
+
+func (m *PoolRepository) GetUserPendingMatches(ctx context.Context, userID string) (r0 []*model.MatchResult, r1 error) {
+	if m.GetUserPendingMatchesFunc != nil {
+		return m.GetUserPendingMatchesFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *PoolRepository) GetRecentMatchesBetween(ctx context.Context, memberIDs []string, days int) (r0 []*model.MatchResult, r1 error) {
+	if m.GetRecentMatchesBetweenFunc != nil {
+		return m.GetRecentMatchesBetweenFunc(ctx, memberIDs, days)
+	}
+	return
+}
+
+func (m *PoolRepository) UpdateMatchResult(ctx context.Context, matchID string, updates map[string]interface{}) (r0 *model.MatchResult, r1 error) {
+	if m.UpdateMatchResultFunc != nil {
+		return m.UpdateMatchResultFunc(ctx, matchID, updates)
+	}
+	return
+}
+
+func (m *PoolRepository) GetPoolsDueForMatching(ctx context.Context) (r0 []*model.MatchingPool, r1 error) {
+	if m.GetPoolsDueForMatchingFunc != nil {
+		return m.GetPoolsDueForMatchingFunc(ctx)
+	}
+	return
+}
+
+func (m *PoolRepository) GetPoolStats(ctx context.Context, poolID string) (r0 *model.PoolStats, r1 error) {
+	if m.GetPoolStatsFunc != nil {
+		return m.GetPoolStatsFunc(ctx, poolID)
+	}
+	return
+}
+
+func (m *PoolRepository) GetStaleMatches(ctx context.Context, cutoff time.Time, status string) (r0 []*model.MatchResult, r1 error) {
+	if m.GetStaleMatchesFunc != nil {
+		return m.GetStaleMatchesFunc(ctx, cutoff, status)
+	}
+	return
+}
+
+// PresenceRepository mocks service.PresenceRepository
+type PresenceRepository struct {
+	UpdateLastActiveFunc func(ctx context.Context, userID string) error
+}
+
+func (m *PresenceRepository) UpdateLastActive(ctx context.Context, userID string) (r0 error) {
+	if m.UpdateLastActiveFunc != nil {
+		return m.UpdateLastActiveFunc(ctx, userID)
+	}
+	return
+}
+
+// ProfileGuildRepository mocks service.ProfileGuildRepository
+type ProfileGuildRepository struct {
+	GetGuildsForUserFunc func(ctx context.Context, userID string) ([]*model.Guild, error)
+}
+
+func (m *ProfileGuildRepository) GetGuildsForUser(ctx context.Context, userID string) (r0 []*model.Guild, r1 error) {
+	if m.GetGuildsForUserFunc != nil {
+		return m.GetGuildsForUserFunc(ctx, userID)
+	}
+	return
+}
+
+// ProfileModerationRepository mocks service.ProfileModerationRepository
+type ProfileModerationRepository struct {
+	IsBlockedEitherWayFunc func(ctx context.Context, userID1 string, userID2 string) (bool, error)
+}
+
+func (m *ProfileModerationRepository) IsBlockedEitherWay(ctx context.Context, userID1 string, userID2 string) (r0 bool, r1 error) {
+	if m.IsBlockedEitherWayFunc != nil {
+		return m.IsBlockedEitherWayFunc(ctx, userID1, userID2)
+	}
+	return
+}
+
+// ProfileNudgeRepository mocks service.ProfileNudgeRepository
+type ProfileNudgeRepository struct {
+	GetCompletenessNudgeCandidatesFunc func(ctx context.Context, createdBefore time.Time, nudgedBefore time.Time, maxNudges int, limit int) ([]*model.UserProfile, error)
+	SetCompletionScoreFunc             func(ctx context.Context, userID string, score float64) error
+	ClaimCompletenessNudgeFunc         func(ctx context.Context, userID string, nudgedBefore time.Time) (bool, error)
+}
+
+func (m *ProfileNudgeRepository) GetCompletenessNudgeCandidates(ctx context.Context, createdBefore time.Time, nudgedBefore time.Time, maxNudges int, limit int) (r0 []*model.UserProfile, r1 error) {
+	if m.GetCompletenessNudgeCandidatesFunc != nil {
+		return m.GetCompletenessNudgeCandidatesFunc(ctx, createdBefore, nudgedBefore, maxNudges, limit)
+	}
+	return
+}
+
+func (m *ProfileNudgeRepository) SetCompletionScore(ctx context.Context, userID string, score float64) (r0 error) {
+	if m.SetCompletionScoreFunc != nil {
+		return m.SetCompletionScoreFunc(ctx, userID, score)
+	}
+	return
+}
+
+func (m *ProfileNudgeRepository) ClaimCompletenessNudge(ctx context.Context, userID string, nudgedBefore time.Time) (r0 bool, r1 error) {
+	if m.ClaimCompletenessNudgeFunc != nil {
+		return m.ClaimCompletenessNudgeFunc(ctx, userID, nudgedBefore)
+	}
+	return
+}
+
+// ProfileRepository mocks service.ProfileRepository
+type ProfileRepository struct {
+	CreateFunc              func(ctx context.Context, profile *model.UserProfile) error
+	GetByUserIDFunc         func(ctx context.Context, userID string) (*model.UserProfile, error)
+	UpdateFunc              func(ctx context.Context, userID string, updates map[string]interface{}, version *int) (*model.UserProfile, error)
+	UpdateLastActiveFunc    func(ctx context.Context, userID string) error
+	DeleteFunc              func(ctx context.Context, userID string) error
+	GetNearbyFunc           func(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, limit int) ([]*model.UserProfile, error)
+	GetLocationInternalFunc func(ctx context.Context, userID string) (*model.LocationInternal, error)
+}
+
+func (m *ProfileRepository) Create(ctx context.Context, profile *model.UserProfile) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, profile)
+	}
+	return
+}
+
+func (m *ProfileRepository) GetByUserID(ctx context.Context, userID string) (r0 *model.UserProfile, r1 error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ProfileRepository) Update(ctx context.Context, userID string, updates map[string]interface{}, version *int) (r0 *model.UserProfile, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, userID, updates, version)
+	}
+	return
+}
+
+func (m *ProfileRepository) UpdateLastActive(ctx context.Context, userID string) (r0 error) {
+	if m.UpdateLastActiveFunc != nil {
+		return m.UpdateLastActiveFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ProfileRepository) Delete(ctx context.Context, userID string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ProfileRepository) GetNearby(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, limit int) (r0 []*model.UserProfile, r1 error) {
+	if m.GetNearbyFunc != nil {
+		return m.GetNearbyFunc(ctx, minLat, maxLat, minLng, maxLng, limit)
+	}
+	return
+}
+
+func (m *ProfileRepository) GetLocationInternal(ctx context.Context, userID string) (r0 *model.LocationInternal, r1 error) {
+	if m.GetLocationInternalFunc != nil {
+		return m.GetLocationInternalFunc(ctx, userID)
+	}
+	return
+}
+
+// PublicationEventRepository mocks service.PublicationEventRepository
+type PublicationEventRepository struct {
+	PublishDueFunc func(ctx context.Context, now time.Time) ([]*model.Event, error)
+}
+
+func (m *PublicationEventRepository) PublishDue(ctx context.Context, now time.Time) (r0 []*model.Event, r1 error) {
+	if m.PublishDueFunc != nil {
+		return m.PublishDueFunc(ctx, now)
+	}
+	return
+}
+
+// PublicationGuildRepository mocks service.PublicationGuildRepository
+type PublicationGuildRepository struct {
+	GetMembersFunc func(ctx context.Context, guildID string) ([]*model.Member, error)
+}
+
+func (m *PublicationGuildRepository) GetMembers(ctx context.Context, guildID string) (r0 []*model.Member, r1 error) {
+	if m.GetMembersFunc != nil {
+		return m.GetMembersFunc(ctx, guildID)
+	}
+	return
+}
+
+// PublicationVoteRepository mocks service.PublicationVoteRepository
+type PublicationVoteRepository struct {
+	PublishDueFunc func(ctx context.Context, now time.Time) ([]*model.Vote, error)
+}
+
+func (m *PublicationVoteRepository) PublishDue(ctx context.Context, now time.Time) (r0 []*model.Vote, r1 error) {
+	if m.PublishDueFunc != nil {
+		return m.PublishDueFunc(ctx, now)
+	}
+	return
+}
+
+// QuestionnaireRepository mocks service.QuestionnaireRepository
+type QuestionnaireRepository struct {
+	GetAllQuestionsFunc             func(ctx context.Context) ([]*model.Question, error)
+	GetQuestionsByCategoryFunc      func(ctx context.Context, category string) ([]*model.Question, error)
+	GetQuestionByIDFunc             func(ctx context.Context, id string) (*model.Question, error)
+	CreateQuestionFunc              func(ctx context.Context, question *model.Question) error
+	GetUserAnswerFunc               func(ctx context.Context, userID string, questionID string) (*model.Answer, error)
+	GetUserAnswersFunc              func(ctx context.Context, userID string) ([]*model.Answer, error)
+	GetUserAnswersWithQuestionsFunc func(ctx context.Context, userID string) ([]*model.AnswerWithQuestion, error)
+	CreateAnswerFunc                func(ctx context.Context, answer *model.Answer) error
+	UpdateAnswerFunc                func(ctx context.Context, userID string, questionID string, updates map[string]interface{}) (*model.Answer, error)
+	DeleteAnswerFunc                func(ctx context.Context, userID string, questionID string) error
+	GetSharedAnswersFunc            func(ctx context.Context, userAID string, userBID string) (map[string][2]*model.Answer, error)
+	GetAnswersForUsersFunc          func(ctx context.Context, userIDs []string) (map[string][]*model.Answer, error)
+	GetUserBiasProfileFunc          func(ctx context.Context, userID string) (*model.UserBiasProfile, error)
+	UpdateUserBiasProfileFunc       func(ctx context.Context, userID string, accumulatedBias float64, answerCount int) error
+	GetQuestionProgressFunc         func(ctx context.Context, userID string) (*model.QuestionProgress, error)
+	GetCircleValuesFunc             func(ctx context.Context, id string) (*model.CircleValues, error)
+	GetCircleValuesByCircleFunc     func(ctx context.Context, circleID string) ([]*model.CircleValues, error)
+	CreateCircleValuesFunc          func(ctx context.Context, cv *model.CircleValues) error
+}
+
+func (m *QuestionnaireRepository) GetAllQuestions(ctx context.Context) (r0 []*model.Question, r1 error) {
+	if m.GetAllQuestionsFunc != nil {
+		return m.GetAllQuestionsFunc(ctx)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetQuestionsByCategory(ctx context.Context, category string) (r0 []*model.Question, r1 error) {
+	if m.GetQuestionsByCategoryFunc != nil {
+		return m.GetQuestionsByCategoryFunc(ctx, category)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetQuestionByID(ctx context.Context, id string) (r0 *model.Question, r1 error) {
+	if m.GetQuestionByIDFunc != nil {
+		return m.GetQuestionByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) CreateQuestion(ctx context.Context, question *model.Question) (r0 error) {
+	if m.CreateQuestionFunc != nil {
+		return m.CreateQuestionFunc(ctx, question)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetUserAnswer(ctx context.Context, userID string, questionID string) (r0 *model.Answer, r1 error) {
+	if m.GetUserAnswerFunc != nil {
+		return m.GetUserAnswerFunc(ctx, userID, questionID)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetUserAnswers(ctx context.Context, userID string) (r0 []*model.Answer, r1 error) {
+	if m.GetUserAnswersFunc != nil {
+		return m.GetUserAnswersFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetUserAnswersWithQuestions(ctx context.Context, userID string) (r0 []*model.AnswerWithQuestion, r1 error) {
+	if m.GetUserAnswersWithQuestionsFunc != nil {
+		return m.GetUserAnswersWithQuestionsFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) CreateAnswer(ctx context.Context, answer *model.Answer) (r0 error) {
+	if m.CreateAnswerFunc != nil {
+		return m.CreateAnswerFunc(ctx, answer)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) UpdateAnswer(ctx context.Context, userID string, questionID string, updates map[string]interface{}) (r0 *model.Answer, r1 error) {
+	if m.UpdateAnswerFunc != nil {
+		return m.UpdateAnswerFunc(ctx, userID, questionID, updates)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) DeleteAnswer(ctx context.Context, userID string, questionID string) (r0 error) {
+	if m.DeleteAnswerFunc != nil {
+		return m.DeleteAnswerFunc(ctx, userID, questionID)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetSharedAnswers(ctx context.Context, userAID string, userBID string) (r0 map[string][2]*model.Answer, r1 error) {
+	if m.GetSharedAnswersFunc != nil {
+		return m.GetSharedAnswersFunc(ctx, userAID, userBID)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetAnswersForUsers(ctx context.Context, userIDs []string) (r0 map[string][]*model.Answer, r1 error) {
+	if m.GetAnswersForUsersFunc != nil {
+		return m.GetAnswersForUsersFunc(ctx, userIDs)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetUserBiasProfile(ctx context.Context, userID string) (r0 *model.UserBiasProfile, r1 error) {
+	if m.GetUserBiasProfileFunc != nil {
+		return m.GetUserBiasProfileFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) UpdateUserBiasProfile(ctx context.Context, userID string, accumulatedBias float64, answerCount int) (r0 error) {
+	if m.UpdateUserBiasProfileFunc != nil {
+		return m.UpdateUserBiasProfileFunc(ctx, userID, accumulatedBias, answerCount)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetQuestionProgress(ctx context.Context, userID string) (r0 *model.QuestionProgress, r1 error) {
+	if m.GetQuestionProgressFunc != nil {
+		return m.GetQuestionProgressFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetCircleValues(ctx context.Context, id string) (r0 *model.CircleValues, r1 error) {
+	if m.GetCircleValuesFunc != nil {
+		return m.GetCircleValuesFunc(ctx, id)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) GetCircleValuesByCircle(ctx context.Context, circleID string) (r0 []*model.CircleValues, r1 error) {
+	if m.GetCircleValuesByCircleFunc != nil {
+		return m.GetCircleValuesByCircleFunc(ctx, circleID)
+	}
+	return
+}
+
+func (m *QuestionnaireRepository) CreateCircleValues(ctx context.Context, cv *model.CircleValues) (r0 error) {
+	if m.CreateCircleValuesFunc != nil {
+		return m.CreateCircleValuesFunc(ctx, cv)
+	}
+	return
+}
+
+// QuotaRepository mocks service.QuotaRepository
+type QuotaRepository struct {
+	UpsertFunc func(ctx context.Context, override *model.QuotaOverride) error
+	GetFunc    func(ctx context.Context, userID string) (*model.QuotaOverride, error)
+	ListFunc   func(ctx context.Context) ([]*model.QuotaOverride, error)
+	DeleteFunc func(ctx context.Context, userID string) (*model.QuotaOverride, error)
+}
+
+func (m *QuotaRepository) Upsert(ctx context.Context, override *model.QuotaOverride) (r0 error) {
+	if m.UpsertFunc != nil {
+		return m.UpsertFunc(ctx, override)
+	}
+	return
+}
+
+func (m *QuotaRepository) Get(ctx context.Context, userID string) (r0 *model.QuotaOverride, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *QuotaRepository) List(ctx context.Context) (r0 []*model.QuotaOverride, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+func (m *QuotaRepository) Delete(ctx context.Context, userID string) (r0 *model.QuotaOverride, r1 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID)
+	}
+	return
+}
+
+// QuotaUserRepository mocks service.QuotaUserRepository
+type QuotaUserRepository struct {
+	GetByIDFunc func(ctx context.Context, id string) (*model.User, error)
+}
+
+func (m *QuotaUserRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+// ReactionAnnouncementRepository mocks service.ReactionAnnouncementRepository
+type ReactionAnnouncementRepository struct {
+	GetReceiptFunc func(ctx context.Context, announcementID string, userID string) (*model.AnnouncementReceipt, error)
+}
+
+func (m *ReactionAnnouncementRepository) GetReceipt(ctx context.Context, announcementID string, userID string) (r0 *model.AnnouncementReceipt, r1 error) {
+	if m.GetReceiptFunc != nil {
+		return m.GetReceiptFunc(ctx, announcementID, userID)
+	}
+	return
+}
+
+// ReactionEventRepository mocks service.ReactionEventRepository
+type ReactionEventRepository struct {
+	GetFunc func(ctx context.Context, eventID string) (*model.Event, error)
+}
+
+func (m *ReactionEventRepository) Get(ctx context.Context, eventID string) (r0 *model.Event, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, eventID)
+	}
+	return
+}
+
+// ReactionRepository mocks service.ReactionRepository
+type ReactionRepository struct {
+	SetReactionFunc    func(ctx context.Context, reaction *model.Reaction) error
+	DeleteReactionFunc func(ctx context.Context, targetID string, userID string) (bool, error)
+	SummariesFunc      func(ctx context.Context, targetIDs []string, userID string) (map[string]*model.ReactionSummary, error)
+}
+
+func (m *ReactionRepository) SetReaction(ctx context.Context, reaction *model.Reaction) (r0 error) {
+	if m.SetReactionFunc != nil {
+		return m.SetReactionFunc(ctx, reaction)
+	}
+	return
+}
+
+func (m *ReactionRepository) DeleteReaction(ctx context.Context, targetID string, userID string) (r0 bool, r1 error) {
+	if m.DeleteReactionFunc != nil {
+		return m.DeleteReactionFunc(ctx, targetID, userID)
+	}
+	return
+}
+
+func (m *ReactionRepository) Summaries(ctx context.Context, targetIDs []string, userID string) (r0 map[string]*model.ReactionSummary, r1 error) {
+	if m.SummariesFunc != nil {
+		return m.SummariesFunc(ctx, targetIDs, userID)
+	}
+	return
+}
+
+// RemoteConfigRepository mocks service.RemoteConfigRepository
+type RemoteConfigRepository struct {
+	UpsertFunc func(ctx context.Context, value *model.RemoteConfigValue) error
+	ListFunc   func(ctx context.Context) ([]*model.RemoteConfigValue, error)
+	DeleteFunc func(ctx context.Context, key string, platform model.DevicePlatform) (*model.RemoteConfigValue, error)
+}
+
+func (m *RemoteConfigRepository) Upsert(ctx context.Context, value *model.RemoteConfigValue) (r0 error) {
+	if m.UpsertFunc != nil {
+		return m.UpsertFunc(ctx, value)
+	}
+	return
+}
+
+func (m *RemoteConfigRepository) List(ctx context.Context) (r0 []*model.RemoteConfigValue, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+func (m *RemoteConfigRepository) Delete(ctx context.Context, key string, platform model.DevicePlatform) (r0 *model.RemoteConfigValue, r1 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, key, platform)
+	}
+	return
+}
+
+// ResonanceRepository mocks service.ResonanceRepository
+type ResonanceRepository struct {
+	AwardPointsFunc               func(ctx context.Context, entry *model.ResonanceLedgerEntry) error
+	HasAwardedPointsFunc          func(ctx context.Context, userID string, stat string, sourceObjectID string) (bool, error)
+	GetUserLedgerFunc             func(ctx context.Context, userID string, limit int, offset int) ([]*model.ResonanceLedgerEntry, error)
+	GetUserScoreFunc              func(ctx context.Context, userID string) (*model.ResonanceScore, error)
+	RecalculateUserScoreFunc      func(ctx context.Context, userID string) (*model.ResonanceScore, error)
+	GetDailyCapFunc               func(ctx context.Context, userID string, date string) (*model.ResonanceDailyCap, error)
+	IncrementDailyCapFunc         func(ctx context.Context, userID string, date string, stat model.ResonanceStat, amount int) error
+	GetSupportPairCountFunc       func(ctx context.Context, helperID string, receiverID string) (int, error)
+	IncrementSupportPairCountFunc func(ctx context.Context, helperID string, receiverID string) error
+	GetAllActiveUserIDsFunc       func(ctx context.Context) ([]string, error)
+	GetUserCirclesForNexusFunc    func(ctx context.Context, userID string) ([]*model.NexusCircleData, error)
+	GetCirclePairOverlapFunc      func(ctx context.Context, circleID1 string, circleID2 string) (int, error)
+}
+
+func (m *ResonanceRepository) AwardPoints(ctx context.Context, entry *model.ResonanceLedgerEntry) (r0 error) {
+	if m.AwardPointsFunc != nil {
+		return m.AwardPointsFunc(ctx, entry)
+	}
+	return
+}
+
+func (m *ResonanceRepository) HasAwardedPoints(ctx context.Context, userID string, stat string, sourceObjectID string) (r0 bool, r1 error) {
+	if m.HasAwardedPointsFunc != nil {
+		return m.HasAwardedPointsFunc(ctx, userID, stat, sourceObjectID)
+	}
+	return
+}
+
+func (m *ResonanceRepository) GetUserLedger(ctx context.Context, userID string, limit int, offset int) (r0 []*model.ResonanceLedgerEntry, r1 error) {
+	if m.GetUserLedgerFunc != nil {
+		return m.GetUserLedgerFunc(ctx, userID, limit, offset)
+	}
+	return
+}
+
+func (m *ResonanceRepository) GetUserScore(ctx context.Context, userID string) (r0 *model.ResonanceScore, r1 error) {
+	if m.GetUserScoreFunc != nil {
+		return m.GetUserScoreFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ResonanceRepository) RecalculateUserScore(ctx context.Context, userID string) (r0 *model.ResonanceScore, r1 error) {
+	if m.RecalculateUserScoreFunc != nil {
+		return m.RecalculateUserScoreFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ResonanceRepository) GetDailyCap(ctx context.Context, userID string, date string) (r0 *model.ResonanceDailyCap, r1 error) {
+	if m.GetDailyCapFunc != nil {
+		return m.GetDailyCapFunc(ctx, userID, date)
+	}
+	return
+}
+
+func (m *ResonanceRepository) IncrementDailyCap(ctx context.Context, userID string, date string, stat model.ResonanceStat, amount int) (r0 error) {
+	if m.IncrementDailyCapFunc != nil {
+		return m.IncrementDailyCapFunc(ctx, userID, date, stat, amount)
+	}
+	return
+}
+
+func (m *ResonanceRepository) GetSupportPairCount(ctx context.Context, helperID string, receiverID string) (r0 int, r1 error) {
+	if m.GetSupportPairCountFunc != nil {
+		return m.GetSupportPairCountFunc(ctx, helperID, receiverID)
+	}
+	return
+}
+
+func (m *ResonanceRepository) IncrementSupportPairCount(ctx context.Context, helperID string, receiverID string) (r0 error) {
+	if m.IncrementSupportPairCountFunc != nil {
+		return m.IncrementSupportPairCountFunc(ctx, helperID, receiverID)
+	}
+	return
+}
+
+func (m *ResonanceRepository) GetAllActiveUserIDs(ctx context.Context) (r0 []string, r1 error) {
+	if m.GetAllActiveUserIDsFunc != nil {
+		return m.GetAllActiveUserIDsFunc(ctx)
+	}
+	return
+}
+
+func (m *ResonanceRepository) GetUserCirclesForNexus(ctx context.Context, userID string) (r0 []*model.NexusCircleData, r1 error) {
+	if m.GetUserCirclesForNexusFunc != nil {
+		return m.GetUserCirclesForNexusFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ResonanceRepository) GetCirclePairOverlap(ctx context.Context, circleID1 string, circleID2 string) (r0 int, r1 error) {
+	if m.GetCirclePairOverlapFunc != nil {
+		return m.GetCirclePairOverlapFunc(ctx, circleID1, circleID2)
+	}
+	return
+}
+
+// ReviewRepository mocks service.ReviewRepository
+type ReviewRepository struct {
+	CreateFunc               func(ctx context.Context, review *model.Review) error
+	GetByIDFunc              func(ctx context.Context, id string) (*model.Review, error)
+	GetReviewsGivenFunc      func(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error)
+	GetReviewsReceivedFunc   func(ctx context.Context, userID string, page model.PageRequest) (*model.Page[*model.Review], error)
+	HasReviewedFunc          func(ctx context.Context, reviewerID string, revieweeID string, referenceID string) (bool, error)
+	GetReputationFunc        func(ctx context.Context, userID string) (*model.Reputation, error)
+	GetReputationDisplayFunc func(ctx context.Context, userID string) (*model.ReputationDisplay, error)
+}
+
+func (m *ReviewRepository) Create(ctx context.Context, review *model.Review) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, review)
+	}
+	return
+}
+
+func (m *ReviewRepository) GetByID(ctx context.Context, id string) (r0 *model.Review, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *ReviewRepository) GetReviewsGiven(ctx context.Context, userID string, page model.PageRequest) (r0 *model.Page[*model.Review], r1 error) {
+	if m.GetReviewsGivenFunc != nil {
+		return m.GetReviewsGivenFunc(ctx, userID, page)
+	}
+	return
+}
+
+func (m *ReviewRepository) GetReviewsReceived(ctx context.Context, userID string, page model.PageRequest) (r0 *model.Page[*model.Review], r1 error) {
+	if m.GetReviewsReceivedFunc != nil {
+		return m.GetReviewsReceivedFunc(ctx, userID, page)
+	}
+	return
+}
+
+func (m *ReviewRepository) HasReviewed(ctx context.Context, reviewerID string, revieweeID string, referenceID string) (r0 bool, r1 error) {
+	if m.HasReviewedFunc != nil {
+		return m.HasReviewedFunc(ctx, reviewerID, revieweeID, referenceID)
+	}
+	return
+}
+
+func (m *ReviewRepository) GetReputation(ctx context.Context, userID string) (r0 *model.Reputation, r1 error) {
+	if m.GetReputationFunc != nil {
+		return m.GetReputationFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *ReviewRepository) GetReputationDisplay(ctx context.Context, userID string) (r0 *model.ReputationDisplay, r1 error) {
+	if m.GetReputationDisplayFunc != nil {
+		return m.GetReputationDisplayFunc(ctx, userID)
+	}
+	return
+}
+
+// RideRequestRepository mocks service.RideRequestRepository
+type RideRequestRepository struct {
+	CreateFunc         func(ctx context.Context, req *model.RideRequest) error
+	GetFunc            func(ctx context.Context, requestID string) (*model.RideRequest, error)
+	GetOpenFunc        func(ctx context.Context, eventID string, userID string) (*model.RideRequest, error)
+	GetOpenByEventFunc func(ctx context.Context, eventID string) ([]*model.RideRequest, error)
+	CloseFunc          func(ctx context.Context, requestID string, status string, rideshareID *string) (*model.RideRequest, error)
+}
+
+func (m *RideRequestRepository) Create(ctx context.Context, req *model.RideRequest) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, req)
+	}
+	return
+}
+
+func (m *RideRequestRepository) Get(ctx context.Context, requestID string) (r0 *model.RideRequest, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, requestID)
+	}
+	return
+}
+
+func (m *RideRequestRepository) GetOpen(ctx context.Context, eventID string, userID string) (r0 *model.RideRequest, r1 error) {
+	if m.GetOpenFunc != nil {
+		return m.GetOpenFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *RideRequestRepository) GetOpenByEvent(ctx context.Context, eventID string) (r0 []*model.RideRequest, r1 error) {
+	if m.GetOpenByEventFunc != nil {
+		return m.GetOpenByEventFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *RideRequestRepository) Close(ctx context.Context, requestID string, status string, rideshareID *string) (r0 *model.RideRequest, r1 error) {
+	if m.CloseFunc != nil {
+		return m.CloseFunc(ctx, requestID, status, rideshareID)
+	}
+	return
+}
+
+// RideshareEventRepository mocks service.RideshareEventRepository
+type RideshareEventRepository struct {
+	GetFunc     func(ctx context.Context, eventID string) (*model.Event, error)
+	IsHostFunc  func(ctx context.Context, eventID string, userID string) (bool, error)
+	GetRSVPFunc func(ctx context.Context, eventID string, userID string) (*model.EventRSVP, error)
+}
+
+func (m *RideshareEventRepository) Get(ctx context.Context, eventID string) (r0 *model.Event, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *RideshareEventRepository) IsHost(ctx context.Context, eventID string, userID string) (r0 bool, r1 error) {
+	if m.IsHostFunc != nil {
+		return m.IsHostFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+func (m *RideshareEventRepository) GetRSVP(ctx context.Context, eventID string, userID string) (r0 *model.EventRSVP, r1 error) {
+	if m.GetRSVPFunc != nil {
+		return m.GetRSVPFunc(ctx, eventID, userID)
+	}
+	return
+}
+
+// RideshareRepository mocks service.RideshareRepository
+type RideshareRepository struct {
+	CreateFunc           func(ctx context.Context, rideshare *model.Rideshare) error
+	GetFunc              func(ctx context.Context, rideshareID string) (*model.Rideshare, error)
+	GetByEventFunc       func(ctx context.Context, eventID string) ([]*model.Rideshare, error)
+	CreateSegmentsFunc   func(ctx context.Context, rideshareID string, segments []model.RideshareSegment) error
+	GetSegmentsFunc      func(ctx context.Context, rideshareID string) ([]model.RideshareSegment, error)
+	ReserveSeatFunc      func(ctx context.Context, rideshareID string) (*model.Rideshare, error)
+	ReleaseSeatFunc      func(ctx context.Context, rideshareID string) error
+	SetStatusFunc        func(ctx context.Context, rideshareID string, status string) error
+	CreateSeatFunc       func(ctx context.Context, seat *model.RideshareSeat) error
+	GetSeatFunc          func(ctx context.Context, seatID string) (*model.RideshareSeat, error)
+	GetPassengerSeatFunc func(ctx context.Context, rideshareID string, userID string) (*model.RideshareSeat, error)
+	SetSeatStatusFunc    func(ctx context.Context, seatID string, status string) (*model.RideshareSeat, error)
+}
+
+func (m *RideshareRepository) Create(ctx context.Context, rideshare *model.Rideshare) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, rideshare)
+	}
+	return
+}
+
+func (m *RideshareRepository) Get(ctx context.Context, rideshareID string) (r0 *model.Rideshare, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, rideshareID)
+	}
+	return
+}
+
+func (m *RideshareRepository) GetByEvent(ctx context.Context, eventID string) (r0 []*model.Rideshare, r1 error) {
+	if m.GetByEventFunc != nil {
+		return m.GetByEventFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *RideshareRepository) CreateSegments(ctx context.Context, rideshareID string, segments []model.RideshareSegment) (r0 error) {
+	if m.CreateSegmentsFunc != nil {
+		return m.CreateSegmentsFunc(ctx, rideshareID, segments)
+	}
+	return
+}
+
+func (m *RideshareRepository) GetSegments(ctx context.Context, rideshareID string) (r0 []model.RideshareSegment, r1 error) {
+	if m.GetSegmentsFunc != nil {
+		return m.GetSegmentsFunc(ctx, rideshareID)
+	}
+	return
+}
+
+func (m *RideshareRepository) ReserveSeat(ctx context.Context, rideshareID string) (r0 *model.Rideshare, r1 error) {
+	if m.ReserveSeatFunc != nil {
+		return m.ReserveSeatFunc(ctx, rideshareID)
+	}
+	return
+}
+
+func (m *RideshareRepository) ReleaseSeat(ctx context.Context, rideshareID string) (r0 error) {
+	if m.ReleaseSeatFunc != nil {
+		return m.ReleaseSeatFunc(ctx, rideshareID)
+	}
+	return
+}
+
+func (m *RideshareRepository) SetStatus(ctx context.Context, rideshareID string, status string) (r0 error) {
+	if m.SetStatusFunc != nil {
+		return m.SetStatusFunc(ctx, rideshareID, status)
+	}
+	return
+}
+
+func (m *RideshareRepository) CreateSeat(ctx context.Context, seat *model.RideshareSeat) (r0 error) {
+	if m.CreateSeatFunc != nil {
+		return m.CreateSeatFunc(ctx, seat)
+	}
+	return
+}
+
+func (m *RideshareRepository) GetSeat(ctx context.Context, seatID string) (r0 *model.RideshareSeat, r1 error) {
+	if m.GetSeatFunc != nil {
+		return m.GetSeatFunc(ctx, seatID)
+	}
+	return
+}
+
+func (m *RideshareRepository) GetPassengerSeat(ctx context.Context, rideshareID string, userID string) (r0 *model.RideshareSeat, r1 error) {
+	if m.GetPassengerSeatFunc != nil {
+		return m.GetPassengerSeatFunc(ctx, rideshareID, userID)
+	}
+	return
+}
+
+func (m *RideshareRepository) SetSeatStatus(ctx context.Context, seatID string, status string) (r0 *model.RideshareSeat, r1 error) {
+	if m.SetSeatStatusFunc != nil {
+		return m.SetSeatStatusFunc(ctx, seatID, status)
+	}
+	return
+}
+
+// RideshareRoleRepository mocks service.RideshareRoleRepository
+type RideshareRoleRepository struct {
+	CreateFunc                    func(ctx context.Context, role *model.RideshareRole) error
+	GetByIDFunc                   func(ctx context.Context, id string) (*model.RideshareRole, error)
+	GetByRideshareFunc            func(ctx context.Context, rideshareID string) ([]*model.RideshareRole, error)
+	UpdateFunc                    func(ctx context.Context, id string, updates *model.UpdateRideshareRoleRequest) (*model.RideshareRole, error)
+	DeleteFunc                    func(ctx context.Context, id string) error
+	CreateAssignmentFunc          func(ctx context.Context, assignment *model.RideshareRoleAssignment) error
+	GetAssignmentByIDFunc         func(ctx context.Context, id string) (*model.RideshareRoleAssignment, error)
+	GetAssignmentsByRoleFunc      func(ctx context.Context, roleID string) ([]*model.RideshareRoleAssignment, error)
+	GetAssignmentsByRideshareFunc func(ctx context.Context, rideshareID string) ([]*model.RideshareRoleAssignment, error)
+	GetAssignmentsByUserFunc      func(ctx context.Context, rideshareID string, userID string) ([]*model.RideshareRoleAssignment, error)
+	DeleteAssignmentFunc          func(ctx context.Context, id string) error
+	GetRolesWithAssignmentsFunc   func(ctx context.Context, rideshareID string) ([]model.RideshareRoleWithAssignments, error)
+}
+
+func (m *RideshareRoleRepository) Create(ctx context.Context, role *model.RideshareRole) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, role)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) GetByID(ctx context.Context, id string) (r0 *model.RideshareRole, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) GetByRideshare(ctx context.Context, rideshareID string) (r0 []*model.RideshareRole, r1 error) {
+	if m.GetByRideshareFunc != nil {
+		return m.GetByRideshareFunc(ctx, rideshareID)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) Update(ctx context.Context, id string, updates *model.UpdateRideshareRoleRequest) (r0 *model.RideshareRole, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) CreateAssignment(ctx context.Context, assignment *model.RideshareRoleAssignment) (r0 error) {
+	if m.CreateAssignmentFunc != nil {
+		return m.CreateAssignmentFunc(ctx, assignment)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) GetAssignmentByID(ctx context.Context, id string) (r0 *model.RideshareRoleAssignment, r1 error) {
+	if m.GetAssignmentByIDFunc != nil {
+		return m.GetAssignmentByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) GetAssignmentsByRole(ctx context.Context, roleID string) (r0 []*model.RideshareRoleAssignment, r1 error) {
+	if m.GetAssignmentsByRoleFunc != nil {
+		return m.GetAssignmentsByRoleFunc(ctx, roleID)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) GetAssignmentsByRideshare(ctx context.Context, rideshareID string) (r0 []*model.RideshareRoleAssignment, r1 error) {
+	if m.GetAssignmentsByRideshareFunc != nil {
+		return m.GetAssignmentsByRideshareFunc(ctx, rideshareID)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) GetAssignmentsByUser(ctx context.Context, rideshareID string, userID string) (r0 []*model.RideshareRoleAssignment, r1 error) {
+	if m.GetAssignmentsByUserFunc != nil {
+		return m.GetAssignmentsByUserFunc(ctx, rideshareID, userID)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) DeleteAssignment(ctx context.Context, id string) (r0 error) {
+	if m.DeleteAssignmentFunc != nil {
+		return m.DeleteAssignmentFunc(ctx, id)
+	}
+	return
+}
+
+func (m *RideshareRoleRepository) GetRolesWithAssignments(ctx context.Context, rideshareID string) (r0 []model.RideshareRoleWithAssignments, r1 error) {
+	if m.GetRolesWithAssignmentsFunc != nil {
+		return m.GetRolesWithAssignmentsFunc(ctx, rideshareID)
+	}
+	return
+}
+
+// RoleCatalogRepository mocks service.RoleCatalogRepository
+type RoleCatalogRepository struct {
+	CreateFunc           func(ctx context.Context, catalog *model.RoleCatalog) error
+	GetByIDFunc          func(ctx context.Context, id string) (*model.RoleCatalog, error)
+	GetByScopeFunc       func(ctx context.Context, scopeType model.RoleCatalogScopeType, scopeID string, roleType *model.RoleCatalogRoleType) ([]*model.RoleCatalog, error)
+	GetGuildCatalogsFunc func(ctx context.Context, guildID string, roleType *model.RoleCatalogRoleType) ([]*model.RoleCatalog, error)
+	GetUserCatalogsFunc  func(ctx context.Context, userID string, roleType *model.RoleCatalogRoleType) ([]*model.RoleCatalog, error)
+	UpdateFunc           func(ctx context.Context, id string, updates *model.UpdateRoleCatalogRequest) (*model.RoleCatalog, error)
+	DeleteFunc           func(ctx context.Context, id string) error
+}
+
+func (m *RoleCatalogRepository) Create(ctx context.Context, catalog *model.RoleCatalog) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, catalog)
+	}
+	return
+}
+
+func (m *RoleCatalogRepository) GetByID(ctx context.Context, id string) (r0 *model.RoleCatalog, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *RoleCatalogRepository) GetByScope(ctx context.Context, scopeType model.RoleCatalogScopeType, scopeID string, roleType *model.RoleCatalogRoleType) (r0 []*model.RoleCatalog, r1 error) {
+	if m.GetByScopeFunc != nil {
+		return m.GetByScopeFunc(ctx, scopeType, scopeID, roleType)
+	}
+	return
+}
+
+func (m *RoleCatalogRepository) GetGuildCatalogs(ctx context.Context, guildID string, roleType *model.RoleCatalogRoleType) (r0 []*model.RoleCatalog, r1 error) {
+	if m.GetGuildCatalogsFunc != nil {
+		return m.GetGuildCatalogsFunc(ctx, guildID, roleType)
+	}
+	return
+}
+
+func (m *RoleCatalogRepository) GetUserCatalogs(ctx context.Context, userID string, roleType *model.RoleCatalogRoleType) (r0 []*model.RoleCatalog, r1 error) {
+	if m.GetUserCatalogsFunc != nil {
+		return m.GetUserCatalogsFunc(ctx, userID, roleType)
+	}
+	return
+}
+
+func (m *RoleCatalogRepository) Update(ctx context.Context, id string, updates *model.UpdateRoleCatalogRequest) (r0 *model.RoleCatalog, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *RoleCatalogRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// SecurityEventRepository mocks service.SecurityEventRepository
+type SecurityEventRepository struct {
+	CreateFunc      func(ctx context.Context, event *model.SecurityEvent) error
+	ListByUserFunc  func(ctx context.Context, userID string, limit int) ([]*model.SecurityEvent, error)
+	ListByTypesFunc func(ctx context.Context, types []string, limit int) ([]*model.SecurityEvent, error)
+}
+
+func (m *SecurityEventRepository) Create(ctx context.Context, event *model.SecurityEvent) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, event)
+	}
+	return
+}
+
+func (m *SecurityEventRepository) ListByUser(ctx context.Context, userID string, limit int) (r0 []*model.SecurityEvent, r1 error) {
+	if m.ListByUserFunc != nil {
+		return m.ListByUserFunc(ctx, userID, limit)
+	}
+	return
+}
+
+func (m *SecurityEventRepository) ListByTypes(ctx context.Context, types []string, limit int) (r0 []*model.SecurityEvent, r1 error) {
+	if m.ListByTypesFunc != nil {
+		return m.ListByTypesFunc(ctx, types, limit)
+	}
+	return
+}
+
+// ShareLinkRepository mocks service.ShareLinkRepository
+type ShareLinkRepository struct {
+	CreateFunc     func(ctx context.Context, link *model.ShareLink) error
+	GetByCodeFunc  func(ctx context.Context, code string) (*model.ShareLink, error)
+	ClaimUseFunc   func(ctx context.Context, id string) (bool, error)
+	ReleaseUseFunc func(ctx context.Context, id string) error
+	DeleteFunc     func(ctx context.Context, id string) error
+}
+
+func (m *ShareLinkRepository) Create(ctx context.Context, link *model.ShareLink) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, link)
+	}
+	return
+}
+
+func (m *ShareLinkRepository) GetByCode(ctx context.Context, code string) (r0 *model.ShareLink, r1 error) {
+	if m.GetByCodeFunc != nil {
+		return m.GetByCodeFunc(ctx, code)
+	}
+	return
+}
+
+func (m *ShareLinkRepository) ClaimUse(ctx context.Context, id string) (r0 bool, r1 error) {
+	if m.ClaimUseFunc != nil {
+		return m.ClaimUseFunc(ctx, id)
+	}
+	return
+}
+
+func (m *ShareLinkRepository) ReleaseUse(ctx context.Context, id string) (r0 error) {
+	if m.ReleaseUseFunc != nil {
+		return m.ReleaseUseFunc(ctx, id)
+	}
+	return
+}
+
+func (m *ShareLinkRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// SignupRiskRepository mocks service.SignupRiskRepository
+type SignupRiskRepository struct {
+	CreateFunc          func(ctx context.Context, risk *model.SignupRisk) error
+	GetByUserFunc       func(ctx context.Context, userID string) (*model.SignupRisk, error)
+	CountRecentByIPFunc func(ctx context.Context, ip string, since time.Time) (int, error)
+	ListFlaggedFunc     func(ctx context.Context, limit int) ([]*model.SignupRisk, error)
+	MarkReviewedFunc    func(ctx context.Context, userID string, reviewedBy string) (*model.SignupRisk, error)
+}
+
+func (m *SignupRiskRepository) Create(ctx context.Context, risk *model.SignupRisk) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, risk)
+	}
+	return
+}
+
+func (m *SignupRiskRepository) GetByUser(ctx context.Context, userID string) (r0 *model.SignupRisk, r1 error) {
+	if m.GetByUserFunc != nil {
+		return m.GetByUserFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *SignupRiskRepository) CountRecentByIP(ctx context.Context, ip string, since time.Time) (r0 int, r1 error) {
+	if m.CountRecentByIPFunc != nil {
+		return m.CountRecentByIPFunc(ctx, ip, since)
+	}
+	return
+}
+
+func (m *SignupRiskRepository) ListFlagged(ctx context.Context, limit int) (r0 []*model.SignupRisk, r1 error) {
+	if m.ListFlaggedFunc != nil {
+		return m.ListFlaggedFunc(ctx, limit)
+	}
+	return
+}
+
+func (m *SignupRiskRepository) MarkReviewed(ctx context.Context, userID string, reviewedBy string) (r0 *model.SignupRisk, r1 error) {
+	if m.MarkReviewedFunc != nil {
+		return m.MarkReviewedFunc(ctx, userID, reviewedBy)
+	}
+	return
+}
+
+// SignupRiskUserRepository mocks service.SignupRiskUserRepository
+type SignupRiskUserRepository struct {
+	GetByIDFunc func(ctx context.Context, id string) (*model.User, error)
+}
+
+func (m *SignupRiskUserRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+// SpontaneousAvailabilityRepository mocks service.SpontaneousAvailabilityRepository
+type SpontaneousAvailabilityRepository struct {
+	CreateFunc               func(ctx context.Context, av *model.Availability) error
+	GetActiveSpontaneousFunc func(ctx context.Context, userID string) (*model.Availability, error)
+	UpdateFunc               func(ctx context.Context, id string, updates map[string]interface{}) (*model.Availability, error)
+}
+
+func (m *SpontaneousAvailabilityRepository) Create(ctx context.Context, av *model.Availability) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, av)
+	}
+	return
+}
+
+func (m *SpontaneousAvailabilityRepository) GetActiveSpontaneous(ctx context.Context, userID string) (r0 *model.Availability, r1 error) {
+	if m.GetActiveSpontaneousFunc != nil {
+		return m.GetActiveSpontaneousFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *SpontaneousAvailabilityRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.Availability, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, updates)
+	}
+	return
+}
+
+// SpontaneousInterestRepository mocks service.SpontaneousInterestRepository
+type SpontaneousInterestRepository struct {
+	GetUserInterestsFunc func(ctx context.Context, userID string) ([]*model.UserInterest, error)
+}
+
+func (m *SpontaneousInterestRepository) GetUserInterests(ctx context.Context, userID string) (r0 []*model.UserInterest, r1 error) {
+	if m.GetUserInterestsFunc != nil {
+		return m.GetUserInterestsFunc(ctx, userID)
+	}
+	return
+}
+
+// SpontaneousProfileRepository mocks service.SpontaneousProfileRepository
+type SpontaneousProfileRepository struct {
+	GetNearbyFunc func(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, limit int) ([]*model.UserProfile, error)
+}
+
+func (m *SpontaneousProfileRepository) GetNearby(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, limit int) (r0 []*model.UserProfile, r1 error) {
+	if m.GetNearbyFunc != nil {
+		return m.GetNearbyFunc(ctx, minLat, maxLat, minLng, maxLng, limit)
+	}
+	return
+}
+
+// SpontaneousRepository mocks service.SpontaneousRepository
+type SpontaneousRepository struct {
+	GetAlertPreferenceFunc         func(ctx context.Context, userID string) (*model.SpontaneousAlertPreference, error)
+	UpsertAlertPreferenceFunc      func(ctx context.Context, pref *model.SpontaneousAlertPreference) error
+	GetEnabledAlertPreferencesFunc func(ctx context.Context, userIDs []string) (map[string]*model.SpontaneousAlertPreference, error)
+	ClaimAlertFunc                 func(ctx context.Context, userID string, cutoff time.Time) (bool, error)
+	RecordSessionFunc              func(ctx context.Context, userID string, availabilityID string) error
+	GetSessionStartsSinceFunc      func(ctx context.Context, userID string, since time.Time) ([]time.Time, error)
+}
+
+func (m *SpontaneousRepository) GetAlertPreference(ctx context.Context, userID string) (r0 *model.SpontaneousAlertPreference, r1 error) {
+	if m.GetAlertPreferenceFunc != nil {
+		return m.GetAlertPreferenceFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *SpontaneousRepository) UpsertAlertPreference(ctx context.Context, pref *model.SpontaneousAlertPreference) (r0 error) {
+	if m.UpsertAlertPreferenceFunc != nil {
+		return m.UpsertAlertPreferenceFunc(ctx, pref)
+	}
+	return
+}
+
+func (m *SpontaneousRepository) GetEnabledAlertPreferences(ctx context.Context, userIDs []string) (r0 map[string]*model.SpontaneousAlertPreference, r1 error) {
+	if m.GetEnabledAlertPreferencesFunc != nil {
+		return m.GetEnabledAlertPreferencesFunc(ctx, userIDs)
+	}
+	return
+}
+
+func (m *SpontaneousRepository) ClaimAlert(ctx context.Context, userID string, cutoff time.Time) (r0 bool, r1 error) {
+	if m.ClaimAlertFunc != nil {
+		return m.ClaimAlertFunc(ctx, userID, cutoff)
+	}
+	return
+}
+
+func (m *SpontaneousRepository) RecordSession(ctx context.Context, userID string, availabilityID string) (r0 error) {
+	if m.RecordSessionFunc != nil {
+		return m.RecordSessionFunc(ctx, userID, availabilityID)
+	}
+	return
+}
+
+func (m *SpontaneousRepository) GetSessionStartsSince(ctx context.Context, userID string, since time.Time) (r0 []time.Time, r1 error) {
+	if m.GetSessionStartsSinceFunc != nil {
+		return m.GetSessionStartsSinceFunc(ctx, userID, since)
+	}
+	return
+}
+
+// TrashGuildRepository mocks service.TrashGuildRepository
+type TrashGuildRepository struct {
+	IsGuildModeratorFunc func(ctx context.Context, userID string, guildID string) (bool, error)
+}
+
+func (m *TrashGuildRepository) IsGuildModerator(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildModeratorFunc != nil {
+		return m.IsGuildModeratorFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// TrashPoolRepository mocks service.TrashPoolRepository
+type TrashPoolRepository struct {
+	GetTrashByGuildFunc   func(ctx context.Context, guildID string) ([]*model.TrashItem, error)
+	RestoreFromTrashFunc  func(ctx context.Context, id string, guildID string) (*model.TrashItem, error)
+	PurgeTrashedFunc      func(ctx context.Context, before time.Time) (int, error)
+	CountPoolsByGuildFunc func(ctx context.Context, guildID string) (int, error)
+}
+
+func (m *TrashPoolRepository) GetTrashByGuild(ctx context.Context, guildID string) (r0 []*model.TrashItem, r1 error) {
+	if m.GetTrashByGuildFunc != nil {
+		return m.GetTrashByGuildFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *TrashPoolRepository) RestoreFromTrash(ctx context.Context, id string, guildID string) (r0 *model.TrashItem, r1 error) {
+	if m.RestoreFromTrashFunc != nil {
+		return m.RestoreFromTrashFunc(ctx, id, guildID)
+	}
+	return
+}
+
+func (m *TrashPoolRepository) PurgeTrashed(ctx context.Context, before time.Time) (r0 int, r1 error) {
+	if m.PurgeTrashedFunc != nil {
+		return m.PurgeTrashedFunc(ctx, before)
+	}
+	return
+}
+
+func (m *TrashPoolRepository) CountPoolsByGuild(ctx context.Context, guildID string) (r0 int, r1 error) {
+	if m.CountPoolsByGuildFunc != nil {
+		return m.CountPoolsByGuildFunc(ctx, guildID)
+	}
+	return
+}
+
+// TrashRepository mocks service.TrashRepository
+type TrashRepository struct {
+	GetTrashByGuildFunc  func(ctx context.Context, guildID string) ([]*model.TrashItem, error)
+	RestoreFromTrashFunc func(ctx context.Context, id string, guildID string) (*model.TrashItem, error)
+	PurgeTrashedFunc     func(ctx context.Context, before time.Time) (int, error)
+}
+
+func (m *TrashRepository) GetTrashByGuild(ctx context.Context, guildID string) (r0 []*model.TrashItem, r1 error) {
+	if m.GetTrashByGuildFunc != nil {
+		return m.GetTrashByGuildFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *TrashRepository) RestoreFromTrash(ctx context.Context, id string, guildID string) (r0 *model.TrashItem, r1 error) {
+	if m.RestoreFromTrashFunc != nil {
+		return m.RestoreFromTrashFunc(ctx, id, guildID)
+	}
+	return
+}
+
+func (m *TrashRepository) PurgeTrashed(ctx context.Context, before time.Time) (r0 int, r1 error) {
+	if m.PurgeTrashedFunc != nil {
+		return m.PurgeTrashedFunc(ctx, before)
+	}
+	return
+}
+
+// TrustRatingRepository mocks service.TrustRatingRepository
+type TrustRatingRepository struct {
+	CreateFunc                  func(ctx context.Context, rating *model.TrustRating) error
+	GetByIDFunc                 func(ctx context.Context, id string) (*model.TrustRating, error)
+	GetByRaterRateeAnchorFunc   func(ctx context.Context, raterID string, rateeID string, anchorType string, anchorID string) (*model.TrustRating, error)
+	UpdateFunc                  func(ctx context.Context, id string, trustLevel model.TrustLevel, trustReview string) (*model.TrustRating, error)
+	DeleteFunc                  func(ctx context.Context, id string) error
+	GetReceivedRatingsFunc      func(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error)
+	GetGivenRatingsFunc         func(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.TrustRating], error)
+	GetAggregateFunc            func(ctx context.Context, userID string) (*model.TrustAggregate, error)
+	GetDailyCountFunc           func(ctx context.Context, userID string) (int, error)
+	CanRateFunc                 func(ctx context.Context, raterID string, rateeID string, anchorType string, anchorID string) (bool, error)
+	CreateEndorsementFunc       func(ctx context.Context, endorsement *model.TrustEndorsement) error
+	GetEndorsementsByRatingFunc func(ctx context.Context, ratingID string) ([]*model.TrustEndorsement, error)
+	GetEndorsementCountsFunc    func(ctx context.Context, ratingID string) (int, int, error)
+	HasEndorsedFunc             func(ctx context.Context, endorserID string, ratingID string) (bool, error)
+	GetDistrustSignalsFunc      func(ctx context.Context, minDistrust int, limit int) ([]*model.DistrustSignal, error)
+}
+
+func (m *TrustRatingRepository) Create(ctx context.Context, rating *model.TrustRating) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, rating)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetByID(ctx context.Context, id string) (r0 *model.TrustRating, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetByRaterRateeAnchor(ctx context.Context, raterID string, rateeID string, anchorType string, anchorID string) (r0 *model.TrustRating, r1 error) {
+	if m.GetByRaterRateeAnchorFunc != nil {
+		return m.GetByRaterRateeAnchorFunc(ctx, raterID, rateeID, anchorType, anchorID)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) Update(ctx context.Context, id string, trustLevel model.TrustLevel, trustReview string) (r0 *model.TrustRating, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, trustLevel, trustReview)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetReceivedRatings(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (r0 *model.Page[*model.TrustRating], r1 error) {
+	if m.GetReceivedRatingsFunc != nil {
+		return m.GetReceivedRatingsFunc(ctx, userID, list, page)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetGivenRatings(ctx context.Context, userID string, list model.ListQuery, page model.PageRequest) (r0 *model.Page[*model.TrustRating], r1 error) {
+	if m.GetGivenRatingsFunc != nil {
+		return m.GetGivenRatingsFunc(ctx, userID, list, page)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetAggregate(ctx context.Context, userID string) (r0 *model.TrustAggregate, r1 error) {
+	if m.GetAggregateFunc != nil {
+		return m.GetAggregateFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetDailyCount(ctx context.Context, userID string) (r0 int, r1 error) {
+	if m.GetDailyCountFunc != nil {
+		return m.GetDailyCountFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) CanRate(ctx context.Context, raterID string, rateeID string, anchorType string, anchorID string) (r0 bool, r1 error) {
+	if m.CanRateFunc != nil {
+		return m.CanRateFunc(ctx, raterID, rateeID, anchorType, anchorID)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) CreateEndorsement(ctx context.Context, endorsement *model.TrustEndorsement) (r0 error) {
+	if m.CreateEndorsementFunc != nil {
+		return m.CreateEndorsementFunc(ctx, endorsement)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetEndorsementsByRating(ctx context.Context, ratingID string) (r0 []*model.TrustEndorsement, r1 error) {
+	if m.GetEndorsementsByRatingFunc != nil {
+		return m.GetEndorsementsByRatingFunc(ctx, ratingID)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetEndorsementCounts(ctx context.Context, ratingID string) (r0 int, r1 int, r2 error) {
+	if m.GetEndorsementCountsFunc != nil {
+		return m.GetEndorsementCountsFunc(ctx, ratingID)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) HasEndorsed(ctx context.Context, endorserID string, ratingID string) (r0 bool, r1 error) {
+	if m.HasEndorsedFunc != nil {
+		return m.HasEndorsedFunc(ctx, endorserID, ratingID)
+	}
+	return
+}
+
+func (m *TrustRatingRepository) GetDistrustSignals(ctx context.Context, minDistrust int, limit int) (r0 []*model.DistrustSignal, r1 error) {
+	if m.GetDistrustSignalsFunc != nil {
+		return m.GetDistrustSignalsFunc(ctx, minDistrust, limit)
+	}
+	return
+}
+
+// TrustRepository mocks service.TrustRepositoryInterface
+type TrustRepository struct {
+	CreateTrustRelationFunc   func(ctx context.Context, trust *model.TrustRelation) error
+	GetTrustRelationFunc      func(ctx context.Context, userAID string, userBID string) (*model.TrustRelation, error)
+	UpdateTrustRelationFunc   func(ctx context.Context, id string, status string) error
+	DeleteTrustRelationFunc   func(ctx context.Context, id string) error
+	GetUserTrustRelationsFunc func(ctx context.Context, userID string) ([]*model.TrustRelation, error)
+	CheckMutualTrustFunc      func(ctx context.Context, userAID string, userBID string) (bool, error)
+	CreateIRLVerificationFunc func(ctx context.Context, irl *model.IRLVerification) error
+	GetIRLVerificationFunc    func(ctx context.Context, userAID string, userBID string) (*model.IRLVerification, error)
+	UpdateIRLVerificationFunc func(ctx context.Context, id string, updates map[string]interface{}) error
+	CheckIRLConfirmedFunc     func(ctx context.Context, userAID string, userBID string) (bool, error)
+	GetUserIRLConnectionsFunc func(ctx context.Context, userID string) ([]*model.IRLVerification, error)
+	GetTrustProfileFunc       func(ctx context.Context, userID string) (*model.UserTrustProfile, error)
+}
+
+func (m *TrustRepository) CreateTrustRelation(ctx context.Context, trust *model.TrustRelation) (r0 error) {
+	if m.CreateTrustRelationFunc != nil {
+		return m.CreateTrustRelationFunc(ctx, trust)
+	}
+	return
+}
+
+func (m *TrustRepository) GetTrustRelation(ctx context.Context, userAID string, userBID string) (r0 *model.TrustRelation, r1 error) {
+	if m.GetTrustRelationFunc != nil {
+		return m.GetTrustRelationFunc(ctx, userAID, userBID)
+	}
+	return
+}
+
+func (m *TrustRepository) UpdateTrustRelation(ctx context.Context, id string, status string) (r0 error) {
+	if m.UpdateTrustRelationFunc != nil {
+		return m.UpdateTrustRelationFunc(ctx, id, status)
+	}
+	return
+}
+
+func (m *TrustRepository) DeleteTrustRelation(ctx context.Context, id string) (r0 error) {
+	if m.DeleteTrustRelationFunc != nil {
+		return m.DeleteTrustRelationFunc(ctx, id)
+	}
+	return
+}
+
+func (m *TrustRepository) GetUserTrustRelations(ctx context.Context, userID string) (r0 []*model.TrustRelation, r1 error) {
+	if m.GetUserTrustRelationsFunc != nil {
+		return m.GetUserTrustRelationsFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *TrustRepository) CheckMutualTrust(ctx context.Context, userAID string, userBID string) (r0 bool, r1 error) {
+	if m.CheckMutualTrustFunc != nil {
+		return m.CheckMutualTrustFunc(ctx, userAID, userBID)
+	}
+	return
+}
+
+func (m *TrustRepository) CreateIRLVerification(ctx context.Context, irl *model.IRLVerification) (r0 error) {
+	if m.CreateIRLVerificationFunc != nil {
+		return m.CreateIRLVerificationFunc(ctx, irl)
+	}
+	return
+}
+
+func (m *TrustRepository) GetIRLVerification(ctx context.Context, userAID string, userBID string) (r0 *model.IRLVerification, r1 error) {
+	if m.GetIRLVerificationFunc != nil {
+		return m.GetIRLVerificationFunc(ctx, userAID, userBID)
+	}
+	return
+}
+
+func (m *TrustRepository) UpdateIRLVerification(ctx context.Context, id string, updates map[string]interface{}) (r0 error) {
+	if m.UpdateIRLVerificationFunc != nil {
+		return m.UpdateIRLVerificationFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *TrustRepository) CheckIRLConfirmed(ctx context.Context, userAID string, userBID string) (r0 bool, r1 error) {
+	if m.CheckIRLConfirmedFunc != nil {
+		return m.CheckIRLConfirmedFunc(ctx, userAID, userBID)
+	}
+	return
+}
+
+func (m *TrustRepository) GetUserIRLConnections(ctx context.Context, userID string) (r0 []*model.IRLVerification, r1 error) {
+	if m.GetUserIRLConnectionsFunc != nil {
+		return m.GetUserIRLConnectionsFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *TrustRepository) GetTrustProfile(ctx context.Context, userID string) (r0 *model.UserTrustProfile, r1 error) {
+	if m.GetTrustProfileFunc != nil {
+		return m.GetTrustProfileFunc(ctx, userID)
+	}
+	return
+}
+
+// UndoAvailabilityRepository mocks service.UndoAvailabilityRepository
+type UndoAvailabilityRepository struct {
+	RestorePendingDeleteFunc func(ctx context.Context, id string, now time.Time) (bool, error)
+	DeleteExpiredPendingFunc func(ctx context.Context, now time.Time) (int, error)
+}
+
+func (m *UndoAvailabilityRepository) RestorePendingDelete(ctx context.Context, id string, now time.Time) (r0 bool, r1 error) {
+	if m.RestorePendingDeleteFunc != nil {
+		return m.RestorePendingDeleteFunc(ctx, id, now)
+	}
+	return
+}
+
+func (m *UndoAvailabilityRepository) DeleteExpiredPending(ctx context.Context, now time.Time) (r0 int, r1 error) {
+	if m.DeleteExpiredPendingFunc != nil {
+		return m.DeleteExpiredPendingFunc(ctx, now)
+	}
+	return
+}
+
+// UndoEventRepository mocks service.UndoEventRepository
+type UndoEventRepository struct {
+	GetFunc                func(ctx context.Context, eventID string) (*model.Event, error)
+	CountApprovedRSVPsFunc func(ctx context.Context, eventID string) (int, error)
+	RestoreRSVPFunc        func(ctx context.Context, rsvpID string, status string) (*model.EventRSVP, error)
+}
+
+func (m *UndoEventRepository) Get(ctx context.Context, eventID string) (r0 *model.Event, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *UndoEventRepository) CountApprovedRSVPs(ctx context.Context, eventID string) (r0 int, r1 error) {
+	if m.CountApprovedRSVPsFunc != nil {
+		return m.CountApprovedRSVPsFunc(ctx, eventID)
+	}
+	return
+}
+
+func (m *UndoEventRepository) RestoreRSVP(ctx context.Context, rsvpID string, status string) (r0 *model.EventRSVP, r1 error) {
+	if m.RestoreRSVPFunc != nil {
+		return m.RestoreRSVPFunc(ctx, rsvpID, status)
+	}
+	return
+}
+
+// UndoGuildRepository mocks service.UndoGuildRepository
+type UndoGuildRepository struct {
+	GetByIDFunc            func(ctx context.Context, id string) (*model.Guild, error)
+	IsMemberFunc           func(ctx context.Context, userID string, guildID string) (bool, error)
+	CountMembersFunc       func(ctx context.Context, guildID string) (int, error)
+	CountGuildsForUserFunc func(ctx context.Context, userID string) (int, error)
+	AddMemberWithRoleFunc  func(ctx context.Context, memberID string, guildID string, role model.GuildRole, pendingApproval bool) error
+}
+
+func (m *UndoGuildRepository) GetByID(ctx context.Context, id string) (r0 *model.Guild, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *UndoGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *UndoGuildRepository) CountMembers(ctx context.Context, guildID string) (r0 int, r1 error) {
+	if m.CountMembersFunc != nil {
+		return m.CountMembersFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *UndoGuildRepository) CountGuildsForUser(ctx context.Context, userID string) (r0 int, r1 error) {
+	if m.CountGuildsForUserFunc != nil {
+		return m.CountGuildsForUserFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *UndoGuildRepository) AddMemberWithRole(ctx context.Context, memberID string, guildID string, role model.GuildRole, pendingApproval bool) (r0 error) {
+	if m.AddMemberWithRoleFunc != nil {
+		return m.AddMemberWithRoleFunc(ctx, memberID, guildID, role, pendingApproval)
+	}
+	return
+}
+
+// UndoRepository mocks service.UndoRepository
+type UndoRepository struct {
+	CreateFunc        func(ctx context.Context, op *model.UndoOperation) error
+	GetByIDFunc       func(ctx context.Context, id string) (*model.UndoOperation, error)
+	ClaimFunc         func(ctx context.Context, id string) (*model.UndoOperation, error)
+	DeleteExpiredFunc func(ctx context.Context, now time.Time) (int, error)
+}
+
+func (m *UndoRepository) Create(ctx context.Context, op *model.UndoOperation) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, op)
+	}
+	return
+}
+
+func (m *UndoRepository) GetByID(ctx context.Context, id string) (r0 *model.UndoOperation, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *UndoRepository) Claim(ctx context.Context, id string) (r0 *model.UndoOperation, r1 error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, id)
+	}
+	return
+}
+
+func (m *UndoRepository) DeleteExpired(ctx context.Context, now time.Time) (r0 int, r1 error) {
+	if m.DeleteExpiredFunc != nil {
+		return m.DeleteExpiredFunc(ctx, now)
+	}
+	return
+}
+
+// UserImportRepository mocks service.UserImportRepository
+type UserImportRepository struct {
+	CreateFunc       func(ctx context.Context, imp *model.UserImport) error
+	GetFunc          func(ctx context.Context, id string) (*model.UserImport, error)
+	ListFunc         func(ctx context.Context, limit int) ([]*model.UserImport, error)
+	ListByStatusFunc func(ctx context.Context, status model.UserImportStatus, limit int) ([]*model.UserImport, error)
+	ClaimFunc        func(ctx context.Context, id string) (bool, error)
+	RequeueFunc      func(ctx context.Context, id string) error
+	CompleteFunc     func(ctx context.Context, id string, rows []model.UserImportRow) error
+	FailFunc         func(ctx context.Context, id string, reason string) error
+}
+
+func (m *UserImportRepository) Create(ctx context.Context, imp *model.UserImport) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, imp)
+	}
+	return
+}
+
+func (m *UserImportRepository) Get(ctx context.Context, id string) (r0 *model.UserImport, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return
+}
+
+func (m *UserImportRepository) List(ctx context.Context, limit int) (r0 []*model.UserImport, r1 error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, limit)
+	}
+	return
+}
+
+func (m *UserImportRepository) ListByStatus(ctx context.Context, status model.UserImportStatus, limit int) (r0 []*model.UserImport, r1 error) {
+	if m.ListByStatusFunc != nil {
+		return m.ListByStatusFunc(ctx, status, limit)
+	}
+	return
+}
+
+func (m *UserImportRepository) Claim(ctx context.Context, id string) (r0 bool, r1 error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, id)
+	}
+	return
+}
+
+func (m *UserImportRepository) Requeue(ctx context.Context, id string) (r0 error) {
+	if m.RequeueFunc != nil {
+		return m.RequeueFunc(ctx, id)
+	}
+	return
+}
+
+func (m *UserImportRepository) Complete(ctx context.Context, id string, rows []model.UserImportRow) (r0 error) {
+	if m.CompleteFunc != nil {
+		return m.CompleteFunc(ctx, id, rows)
+	}
+	return
+}
+
+func (m *UserImportRepository) Fail(ctx context.Context, id string, reason string) (r0 error) {
+	if m.FailFunc != nil {
+		return m.FailFunc(ctx, id, reason)
+	}
+	return
+}
+
+// UserInvitationRepository mocks service.UserInvitationRepository
+type UserInvitationRepository struct {
+	CreateFunc           func(ctx context.Context, invitation *model.UserInvitation) error
+	GetOpenByUserFunc    func(ctx context.Context, userID string, now time.Time) (*model.UserInvitation, error)
+	GetByTokenHashFunc   func(ctx context.Context, tokenHash string) (*model.UserInvitation, error)
+	ClaimByTokenHashFunc func(ctx context.Context, tokenHash string) (*model.UserInvitation, error)
+	DeleteFunc           func(ctx context.Context, id string) error
+}
+
+func (m *UserInvitationRepository) Create(ctx context.Context, invitation *model.UserInvitation) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, invitation)
+	}
+	return
+}
+
+func (m *UserInvitationRepository) GetOpenByUser(ctx context.Context, userID string, now time.Time) (r0 *model.UserInvitation, r1 error) {
+	if m.GetOpenByUserFunc != nil {
+		return m.GetOpenByUserFunc(ctx, userID, now)
+	}
+	return
+}
+
+func (m *UserInvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (r0 *model.UserInvitation, r1 error) {
+	if m.GetByTokenHashFunc != nil {
+		return m.GetByTokenHashFunc(ctx, tokenHash)
+	}
+	return
+}
+
+func (m *UserInvitationRepository) ClaimByTokenHash(ctx context.Context, tokenHash string) (r0 *model.UserInvitation, r1 error) {
+	if m.ClaimByTokenHashFunc != nil {
+		return m.ClaimByTokenHashFunc(ctx, tokenHash)
+	}
+	return
+}
+
+func (m *UserInvitationRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// UserRepository mocks service.UserRepository
+type UserRepository struct {
+	CreateFunc           func(ctx context.Context, user *model.User) error
+	GetByIDFunc          func(ctx context.Context, id string) (*model.User, error)
+	GetByEmailFunc       func(ctx context.Context, email string) (*model.User, error)
+	UpdateFunc           func(ctx context.Context, user *model.User) error
+	UpdatePasswordFunc   func(ctx context.Context, userID string, hash string) error
+	DeleteFunc           func(ctx context.Context, id string) error
+	SetEmailVerifiedFunc func(ctx context.Context, userID string, verified bool) error
+}
+
+func (m *UserRepository) Create(ctx context.Context, user *model.User) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, user)
+	}
+	return
+}
+
+func (m *UserRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *UserRepository) GetByEmail(ctx context.Context, email string) (r0 *model.User, r1 error) {
+	if m.GetByEmailFunc != nil {
+		return m.GetByEmailFunc(ctx, email)
+	}
+	return
+}
+
+func (m *UserRepository) Update(ctx context.Context, user *model.User) (r0 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, user)
+	}
+	return
+}
+
+func (m *UserRepository) UpdatePassword(ctx context.Context, userID string, hash string) (r0 error) {
+	if m.UpdatePasswordFunc != nil {
+		return m.UpdatePasswordFunc(ctx, userID, hash)
+	}
+	return
+}
+
+func (m *UserRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *UserRepository) SetEmailVerified(ctx context.Context, userID string, verified bool) (r0 error) {
+	if m.SetEmailVerifiedFunc != nil {
+		return m.SetEmailVerifiedFunc(ctx, userID, verified)
+	}
+	return
+}
+
+// VenueGuildRepository mocks service.VenueGuildRepository
+type VenueGuildRepository struct {
+	IsMemberFunc     func(ctx context.Context, userID string, guildID string) (bool, error)
+	IsGuildAdminFunc func(ctx context.Context, userID string, guildID string) (bool, error)
+}
+
+func (m *VenueGuildRepository) IsMember(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsMemberFunc != nil {
+		return m.IsMemberFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+func (m *VenueGuildRepository) IsGuildAdmin(ctx context.Context, userID string, guildID string) (r0 bool, r1 error) {
+	if m.IsGuildAdminFunc != nil {
+		return m.IsGuildAdminFunc(ctx, userID, guildID)
+	}
+	return
+}
+
+// VenueRepository mocks service.VenueRepository
+type VenueRepository struct {
+	CreateFunc       func(ctx context.Context, venue *model.Venue) error
+	GetFunc          func(ctx context.Context, venueID string) (*model.Venue, error)
+	ListByGuildFunc  func(ctx context.Context, guildID string) ([]*model.Venue, error)
+	CountByGuildFunc func(ctx context.Context, guildID string) (int, error)
+	UpdateFunc       func(ctx context.Context, venueID string, updates map[string]interface{}) (*model.Venue, error)
+	DeleteFunc       func(ctx context.Context, venueID string) error
+}
+
+func (m *VenueRepository) Create(ctx context.Context, venue *model.Venue) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, venue)
+	}
+	return
+}
+
+func (m *VenueRepository) Get(ctx context.Context, venueID string) (r0 *model.Venue, r1 error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, venueID)
+	}
+	return
+}
+
+func (m *VenueRepository) ListByGuild(ctx context.Context, guildID string) (r0 []*model.Venue, r1 error) {
+	if m.ListByGuildFunc != nil {
+		return m.ListByGuildFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *VenueRepository) CountByGuild(ctx context.Context, guildID string) (r0 int, r1 error) {
+	if m.CountByGuildFunc != nil {
+		return m.CountByGuildFunc(ctx, guildID)
+	}
+	return
+}
+
+func (m *VenueRepository) Update(ctx context.Context, venueID string, updates map[string]interface{}) (r0 *model.Venue, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, venueID, updates)
+	}
+	return
+}
+
+func (m *VenueRepository) Delete(ctx context.Context, venueID string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, venueID)
+	}
+	return
+}
+
+// VoteReminderRepository mocks service.VoteReminderRepository
+type VoteReminderRepository struct {
+	GetVotesClosingBetweenFunc func(ctx context.Context, from time.Time, to time.Time) ([]*model.Vote, error)
+	RecordReminderSentFunc     func(ctx context.Context, voteID string, userID string) error
+}
+
+func (m *VoteReminderRepository) GetVotesClosingBetween(ctx context.Context, from time.Time, to time.Time) (r0 []*model.Vote, r1 error) {
+	if m.GetVotesClosingBetweenFunc != nil {
+		return m.GetVotesClosingBetweenFunc(ctx, from, to)
+	}
+	return
+}
+
+func (m *VoteReminderRepository) RecordReminderSent(ctx context.Context, voteID string, userID string) (r0 error) {
+	if m.RecordReminderSentFunc != nil {
+		return m.RecordReminderSentFunc(ctx, voteID, userID)
+	}
+	return
+}
+
+// VoteRepository mocks service.VoteRepository
+type VoteRepository struct {
+	CreateFunc              func(ctx context.Context, vote *model.Vote) error
+	GetByIDFunc             func(ctx context.Context, id string) (*model.Vote, error)
+	GetByGuildFunc          func(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error)
+	GetGlobalVotesFunc      func(ctx context.Context, list model.ListQuery, page model.PageRequest) (*model.Page[*model.Vote], error)
+	GetVotesToOpenFunc      func(ctx context.Context, now time.Time) ([]*model.Vote, error)
+	GetVotesToCloseFunc     func(ctx context.Context, now time.Time) ([]*model.Vote, error)
+	UpdateFunc              func(ctx context.Context, id string, updates map[string]interface{}) (*model.Vote, error)
+	UpdateStatusFunc        func(ctx context.Context, id string, status model.VoteStatus) error
+	SchedulePublicationFunc func(ctx context.Context, id string, publishAt time.Time) (*model.Vote, error)
+	DeleteFunc              func(ctx context.Context, id string) error
+	MoveToTrashFunc         func(ctx context.Context, id string, userID string) (bool, error)
+	CreateOptionFunc        func(ctx context.Context, option *model.VoteOption) error
+	GetOptionByIDFunc       func(ctx context.Context, id string) (*model.VoteOption, error)
+	GetOptionsByVoteFunc    func(ctx context.Context, voteID string) ([]*model.VoteOption, error)
+	UpdateOptionFunc        func(ctx context.Context, id string, updates *model.UpdateVoteOptionRequest) (*model.VoteOption, error)
+	DeleteOptionFunc        func(ctx context.Context, id string) error
+	CreateBallotFunc        func(ctx context.Context, ballot *model.VoteBallot) error
+	GetBallotByVoterFunc    func(ctx context.Context, voteID string, userID string) (*model.VoteBallot, error)
+	GetBallotsByVoteFunc    func(ctx context.Context, voteID string) ([]*model.VoteBallot, error)
+	DeleteBallotFunc        func(ctx context.Context, id string) error
+	HasVotedFunc            func(ctx context.Context, voteID string, userID string) (bool, error)
+	CountBallotsFunc        func(ctx context.Context, voteID string) (int, error)
+}
+
+func (m *VoteRepository) Create(ctx context.Context, vote *model.Vote) (r0 error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, vote)
+	}
+	return
+}
+
+func (m *VoteRepository) GetByID(ctx context.Context, id string) (r0 *model.Vote, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *VoteRepository) GetByGuild(ctx context.Context, guildID string, list model.ListQuery, page model.PageRequest) (r0 *model.Page[*model.Vote], r1 error) {
+	if m.GetByGuildFunc != nil {
+		return m.GetByGuildFunc(ctx, guildID, list, page)
+	}
+	return
+}
+
+func (m *VoteRepository) GetGlobalVotes(ctx context.Context, list model.ListQuery, page model.PageRequest) (r0 *model.Page[*model.Vote], r1 error) {
+	if m.GetGlobalVotesFunc != nil {
+		return m.GetGlobalVotesFunc(ctx, list, page)
+	}
+	return
+}
+
+func (m *VoteRepository) GetVotesToOpen(ctx context.Context, now time.Time) (r0 []*model.Vote, r1 error) {
+	if m.GetVotesToOpenFunc != nil {
+		return m.GetVotesToOpenFunc(ctx, now)
+	}
+	return
+}
+
+func (m *VoteRepository) GetVotesToClose(ctx context.Context, now time.Time) (r0 []*model.Vote, r1 error) {
+	if m.GetVotesToCloseFunc != nil {
+		return m.GetVotesToCloseFunc(ctx, now)
+	}
+	return
+}
+
+func (m *VoteRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (r0 *model.Vote, r1 error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *VoteRepository) UpdateStatus(ctx context.Context, id string, status model.VoteStatus) (r0 error) {
+	if m.UpdateStatusFunc != nil {
+		return m.UpdateStatusFunc(ctx, id, status)
+	}
+	return
+}
+
+func (m *VoteRepository) SchedulePublication(ctx context.Context, id string, publishAt time.Time) (r0 *model.Vote, r1 error) {
+	if m.SchedulePublicationFunc != nil {
+		return m.SchedulePublicationFunc(ctx, id, publishAt)
+	}
+	return
+}
+
+func (m *VoteRepository) Delete(ctx context.Context, id string) (r0 error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+func (m *VoteRepository) MoveToTrash(ctx context.Context, id string, userID string) (r0 bool, r1 error) {
+	if m.MoveToTrashFunc != nil {
+		return m.MoveToTrashFunc(ctx, id, userID)
+	}
+	return
+}
+
+func (m *VoteRepository) CreateOption(ctx context.Context, option *model.VoteOption) (r0 error) {
+	if m.CreateOptionFunc != nil {
+		return m.CreateOptionFunc(ctx, option)
+	}
+	return
+}
+
+func (m *VoteRepository) GetOptionByID(ctx context.Context, id string) (r0 *model.VoteOption, r1 error) {
+	if m.GetOptionByIDFunc != nil {
+		return m.GetOptionByIDFunc(ctx, id)
+	}
+	return
+}
+
+func (m *VoteRepository) GetOptionsByVote(ctx context.Context, voteID string) (r0 []*model.VoteOption, r1 error) {
+	if m.GetOptionsByVoteFunc != nil {
+		return m.GetOptionsByVoteFunc(ctx, voteID)
+	}
+	return
+}
+
+func (m *VoteRepository) UpdateOption(ctx context.Context, id string, updates *model.UpdateVoteOptionRequest) (r0 *model.VoteOption, r1 error) {
+	if m.UpdateOptionFunc != nil {
+		return m.UpdateOptionFunc(ctx, id, updates)
+	}
+	return
+}
+
+func (m *VoteRepository) DeleteOption(ctx context.Context, id string) (r0 error) {
+	if m.DeleteOptionFunc != nil {
+		return m.DeleteOptionFunc(ctx, id)
+	}
+	return
+}
+
+func (m *VoteRepository) CreateBallot(ctx context.Context, ballot *model.VoteBallot) (r0 error) {
+	if m.CreateBallotFunc != nil {
+		return m.CreateBallotFunc(ctx, ballot)
+	}
+	return
+}
+
+func (m *VoteRepository) GetBallotByVoter(ctx context.Context, voteID string, userID string) (r0 *model.VoteBallot, r1 error) {
+	if m.GetBallotByVoterFunc != nil {
+		return m.GetBallotByVoterFunc(ctx, voteID, userID)
+	}
+	return
+}
+
+func (m *VoteRepository) GetBallotsByVote(ctx context.Context, voteID string) (r0 []*model.VoteBallot, r1 error) {
+	if m.GetBallotsByVoteFunc != nil {
+		return m.GetBallotsByVoteFunc(ctx, voteID)
+	}
+	return
+}
+
+func (m *VoteRepository) DeleteBallot(ctx context.Context, id string) (r0 error) {
+	if m.DeleteBallotFunc != nil {
+		return m.DeleteBallotFunc(ctx, id)
+	}
+	return
+}
+
+func (m *VoteRepository) HasVoted(ctx context.Context, voteID string, userID string) (r0 bool, r1 error) {
+	if m.HasVotedFunc != nil {
+		return m.HasVotedFunc(ctx, voteID, userID)
+	}
+	return
+}
+
+func (m *VoteRepository) CountBallots(ctx context.Context, voteID string) (r0 int, r1 error) {
+	if m.CountBallotsFunc != nil {
+		return m.CountBallotsFunc(ctx, voteID)
+	}
+	return
+}
+
+// VoteUserRepository mocks service.VoteUserRepository
+type VoteUserRepository struct {
+	GetByIDFunc func(ctx context.Context, id string) (*model.User, error)
+}
+
+func (m *VoteUserRepository) GetByID(ctx context.Context, id string) (r0 *model.User, r1 error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return
+}
+
+// WinBackProfileRepository mocks service.WinBackProfileRepository
+type WinBackProfileRepository struct {
+	GetLocationInternalFunc func(ctx context.Context, userID string) (*model.LocationInternal, error)
+	GetNearbyFunc           func(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, limit int) ([]*model.UserProfile, error)
+}
+
+func (m *WinBackProfileRepository) GetLocationInternal(ctx context.Context, userID string) (r0 *model.LocationInternal, r1 error) {
+	if m.GetLocationInternalFunc != nil {
+		return m.GetLocationInternalFunc(ctx, userID)
+	}
+	return
+}
+
+func (m *WinBackProfileRepository) GetNearby(ctx context.Context, minLat float64, maxLat float64, minLng float64, maxLng float64, limit int) (r0 []*model.UserProfile, r1 error) {
+	if m.GetNearbyFunc != nil {
+		return m.GetNearbyFunc(ctx, minLat, maxLat, minLng, maxLng, limit)
+	}
+	return
+}
+
+// WinBackRepository mocks service.WinBackRepository
+type WinBackRepository struct {
+	GetChurnRiskCandidatesFunc func(ctx context.Context, activeSince time.Time, activeBefore time.Time, targetedBefore time.Time, maxAttempts int, limit int) ([]*model.ChurnRiskCandidate, error)
+	GetUpcomingEventsFunc      func(ctx context.Context, userID string, from time.Time, until time.Time, limit int) ([]model.WinBackEvent, error)
+	ClaimWinBackFunc           func(ctx context.Context, userID string, targetedOn time.Time, targetedBefore time.Time) (bool, error)
+	RecordAttemptFunc          func(ctx context.Context, attempt *model.WinBackAttempt) error
+	MarkReturnedFunc           func(ctx context.Context, targetedSince time.Time) (int, error)
+	GetGroupStatsFunc          func(ctx context.Context, since time.Time, until time.Time) ([]model.WinBackGroupStats, error)
+}
+
+func (m *WinBackRepository) GetChurnRiskCandidates(ctx context.Context, activeSince time.Time, activeBefore time.Time, targetedBefore time.Time, maxAttempts int, limit int) (r0 []*model.ChurnRiskCandidate, r1 error) {
+	if m.GetChurnRiskCandidatesFunc != nil {
+		return m.GetChurnRiskCandidatesFunc(ctx, activeSince, activeBefore, targetedBefore, maxAttempts, limit)
+	}
+	return
+}
+
+func (m *WinBackRepository) GetUpcomingEvents(ctx context.Context, userID string, from time.Time, until time.Time, limit int) (r0 []model.WinBackEvent, r1 error) {
+	if m.GetUpcomingEventsFunc != nil {
+		return m.GetUpcomingEventsFunc(ctx, userID, from, until, limit)
+	}
+	return
+}
+
+func (m *WinBackRepository) ClaimWinBack(ctx context.Context, userID string, targetedOn time.Time, targetedBefore time.Time) (r0 bool, r1 error) {
+	if m.ClaimWinBackFunc != nil {
+		return m.ClaimWinBackFunc(ctx, userID, targetedOn, targetedBefore)
+	}
+	return
+}
+
+func (m *WinBackRepository) RecordAttempt(ctx context.Context, attempt *model.WinBackAttempt) (r0 error) {
+	if m.RecordAttemptFunc != nil {
+		return m.RecordAttemptFunc(ctx, attempt)
+	}
+	return
+}
+
+func (m *WinBackRepository) MarkReturned(ctx context.Context, targetedSince time.Time) (r0 int, r1 error) {
+	if m.MarkReturnedFunc != nil {
+		return m.MarkReturnedFunc(ctx, targetedSince)
+	}
+	return
+}
+
+func (m *WinBackRepository) GetGroupStats(ctx context.Context, since time.Time, until time.Time) (r0 []model.WinBackGroupStats, r1 error) {
+	if m.GetGroupStatsFunc != nil {
+		return m.GetGroupStatsFunc(ctx, since, until)
+	}
+	return
+}
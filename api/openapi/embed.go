@@ -0,0 +1,13 @@
+// Package openapi embeds the API's OpenAPI spec, so the server can check
+// requests against the same document clients are generated from.
+package openapi
+
+import "embed"
+
+// FS holds openapi.yaml and the path and component files it references
+//
+//go:embed openapi.yaml paths components/schemas/_index.yaml
+var FS embed.FS
+
+// Root is the spec's entry document within FS
+const Root = "openapi.yaml"
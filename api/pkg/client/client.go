@@ -0,0 +1,192 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the API when run locally
+const DefaultBaseURL = "http://localhost:8080"
+
+// maxErrorBody caps how much of an error response is read
+const maxErrorBody = 64 << 10
+
+// Config holds API client configuration
+type Config struct {
+	BaseURL    string // Default DefaultBaseURL
+	Token      string // Access token sent as a bearer token; empty for anonymous requests
+	UserAgent  string // Default "saga-client"
+	HTTPClient *http.Client
+}
+
+// Client calls the Saga API. Its methods are generated from the OpenAPI
+// spec, one per operation.
+type Client struct {
+	baseURL    string
+	token      string
+	userAgent  string
+	httpClient *http.Client
+}
+
+// NewClient creates a new API client
+func NewClient(cfg Config) *Client {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = "saga-client"
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Client{
+		baseURL:    strings.TrimRight(cfg.BaseURL, "/"),
+		token:      cfg.Token,
+		userAgent:  cfg.UserAgent,
+		httpClient: cfg.HTTPClient,
+	}
+}
+
+// WithToken returns a copy of the client that authenticates with token,
+// for acting as several users over one connection pool
+func (c *Client) WithToken(token string) *Client {
+	clone := *c
+	clone.token = token
+	return &clone
+}
+
+// Error is a response with a status other than 2xx. Problem holds the RFC
+// 9457 problem details when the API sent them.
+type Error struct {
+	StatusCode int
+	Header     http.Header
+	Problem    *ProblemDetails
+}
+
+func (e *Error) Error() string {
+	if e.Problem == nil {
+		return fmt.Sprintf("saga api: unexpected status %d", e.StatusCode)
+	}
+	if e.Problem.Detail != nil && *e.Problem.Detail != "" {
+		return fmt.Sprintf("saga api: %d %s: %s", e.StatusCode, e.Problem.Title, *e.Problem.Detail)
+	}
+	return fmt.Sprintf("saga api: %d %s", e.StatusCode, e.Problem.Title)
+}
+
+// StatusCode returns the status of the *Error in err's chain, or 0 if err
+// isn't an API error
+func StatusCode(err error) int {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// request is an API call built by a generated method
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	header      http.Header
+	body        any // Encoded as JSON unless it's an io.Reader
+	contentType string
+}
+
+// do sends the request and decodes a JSON response into out. It returns
+// the status so callers can tell an empty 204 from a decoded body.
+func (c *Client) do(ctx context.Context, r request, out any) (int, error) {
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && !errors.Is(err, io.EOF) {
+		return resp.StatusCode, fmt.Errorf("saga api: decoding %s %s: %w", r.method, r.path, err)
+	}
+	return resp.StatusCode, nil
+}
+
+// doRaw sends the request and returns the response body as is
+func (c *Client) doRaw(ctx context.Context, r request) ([]byte, error) {
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// send makes the request, turning statuses other than 2xx into *Error
+func (c *Client) send(ctx context.Context, r request) (*http.Response, error) {
+	var body io.Reader
+	switch b := r.body.(type) {
+	case nil:
+	case io.Reader:
+		body = b
+	default:
+		encoded, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("saga api: encoding %s %s: %w", r.method, r.path, err)
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	target := c.baseURL + r.path
+	if len(r.query) > 0 {
+		target += "?" + r.query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range r.header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", r.contentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	apiErr := &Error{StatusCode: resp.StatusCode, Header: resp.Header}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	var problem ProblemDetails
+	if json.Unmarshal(data, &problem) == nil && problem.Title != "" {
+		apiErr.Problem = &problem
+	}
+	return nil, apiErr
+}
+
+// paramValue formats a query parameter
+func paramValue(v any) string {
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(v)
+}
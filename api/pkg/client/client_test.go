@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestClient serves requests with handler and returns a client for it
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewClient(Config{BaseURL: server.URL + "/", HTTPClient: server.Client()})
+}
+
+// ============================================================================
+// Request Tests
+// ============================================================================
+
+func TestClient_BuildsRequest(t *testing.T) {
+	t.Parallel()
+
+	api := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.EscapedPath() != "/v1/public/guilds/chess%20club/events" {
+			t.Errorf("expected an escaped slug, got %s", r.URL.EscapedPath())
+		}
+		if got := r.URL.Query().Get("limit"); got != "5" {
+			t.Errorf("expected limit=5, got %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token-1" {
+			t.Errorf("expected a bearer token, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data":{"guild":{"name":"Chess Club","slug":"chess club"},"events":[{"id":"event:1","title":"Blitz night","start_time":"2026-05-02T18:00:00Z"}]},"_links":{"self":"/v1/public/guilds/chess%20club/events"}}`)
+	}).WithToken("token-1")
+
+	limit := 5
+	resp, err := api.GetGuildEventsEmbed(context.Background(), "chess club", &GetGuildEventsEmbedParams{Limit: &limit})
+	if err != nil {
+		t.Fatalf("GetGuildEventsEmbed failed: %v", err)
+	}
+	if resp.Data == nil || resp.Data.Guild.Name != "Chess Club" {
+		t.Fatalf("expected the guild in data, got %+v", resp.Data)
+	}
+	if len(resp.Data.Events) != 1 || resp.Data.Events[0].Title != "Blitz night" {
+		t.Errorf("expected the event, got %+v", resp.Data.Events)
+	}
+}
+
+func TestClient_RepeatsArrayParams(t *testing.T) {
+	t.Parallel()
+
+	api := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query()["hangout_type"]; len(got) != 2 || got[0] != "talk_it_out" || got[1] != "meet_anyone" {
+			t.Errorf("expected hangout_type twice, got %v", got)
+		}
+		if r.URL.Query().Has("limit") {
+			t.Error("expected unset params to be left out")
+		}
+		_, _ = io.WriteString(w, `{"data":[]}`)
+	})
+
+	_, err := api.DiscoverPeople(context.Background(), &DiscoverPeopleParams{
+		HangoutType: []string{"talk_it_out", "meet_anyone"},
+	})
+	if err != nil {
+		t.Fatalf("DiscoverPeople failed: %v", err)
+	}
+}
+
+func TestClient_SendsDeepObjectParams(t *testing.T) {
+	t.Parallel()
+
+	api := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("filter[status]"); got != "open,closed" {
+			t.Errorf("expected filter[status]=open,closed, got %q", got)
+		}
+		if got := r.URL.Query().Get("sort"); got != "-closes_at" {
+			t.Errorf("expected sort=-closes_at, got %q", got)
+		}
+		_, _ = io.WriteString(w, `{"data":[]}`)
+	})
+
+	sort := "-closes_at"
+	_, err := api.ListGlobalVotes(context.Background(), &ListGlobalVotesParams{
+		Sort:   &sort,
+		Filter: map[string]string{"status": "open,closed"},
+	})
+	if err != nil {
+		t.Fatalf("ListGlobalVotes failed: %v", err)
+	}
+}
+
+func TestClient_SendsJSONBody(t *testing.T) {
+	t.Parallel()
+
+	api := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected a JSON body, got %q", got)
+		}
+		if got := r.Header.Get("X-Client-Platform"); got != "ios" {
+			t.Errorf("expected the platform header, got %q", got)
+		}
+		var body LoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Email != "ada@example.com" {
+			t.Errorf("expected the login body, got %+v (%v)", body, err)
+		}
+		_, _ = io.WriteString(w, `{"data":{"token":{"access_token":"token-2","refresh_token":"r","token_type":"Bearer","expires_in":900}}}`)
+	})
+
+	resp, err := api.Login(context.Background(), &LoginParams{XClientPlatform: "ios"}, &LoginRequest{
+		Email:    "ada@example.com",
+		Password: "correct horse",
+	})
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if resp.Data.Token.AccessToken != "token-2" {
+		t.Errorf("expected the access token, got %+v", resp.Data.Token)
+	}
+}
+
+// ============================================================================
+// Response Tests
+// ============================================================================
+
+func TestClient_ProblemDetailsError(t *testing.T) {
+	t.Parallel()
+
+	api := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"type":"https://saga-api.forgo.software/errors/not-found","title":"Not Found","status":404,"detail":"guild not found"}`)
+	})
+
+	_, err := api.GetGuild(context.Background(), "guild:1")
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *Error, got %v", err)
+	}
+	if apiErr.Problem == nil || apiErr.Problem.Title != "Not Found" {
+		t.Errorf("expected the problem details, got %+v", apiErr.Problem)
+	}
+	if StatusCode(err) != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", StatusCode(err))
+	}
+	if !strings.Contains(err.Error(), "guild not found") {
+		t.Errorf("expected the detail in the message, got %q", err.Error())
+	}
+}
+
+func TestClient_NoContent(t *testing.T) {
+	t.Parallel()
+
+	api := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := api.LeaveGuild(context.Background(), "guild:1")
+	if err != nil || resp != nil {
+		t.Errorf("expected no result and no error, got %+v, %v", resp, err)
+	}
+}
+
+func TestClient_RawResponse(t *testing.T) {
+	t.Parallel()
+
+	api := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = io.WriteString(w, "<!DOCTYPE html>")
+	})
+
+	html, err := api.GetGuildEventsWidget(context.Background(), "chess", nil)
+	if err != nil {
+		t.Fatalf("GetGuildEventsWidget failed: %v", err)
+	}
+	if string(html) != "<!DOCTYPE html>" {
+		t.Errorf("expected the page, got %q", html)
+	}
+}
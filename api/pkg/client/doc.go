@@ -0,0 +1,37 @@
+// Package client is a typed Go client for the Saga API, generated from the
+// OpenAPI spec so tools and tests don't hand-write HTTP calls.
+//
+// Each operation is a method named after its operationId. Path parameters
+// are arguments, query and header parameters go in a Params struct, and
+// request and response bodies are the spec's schemas. Responses are
+// returned as the API sends them, so most carry the resource in Data and
+// related links in Links:
+//
+//	api := client.NewClient(client.Config{BaseURL: "http://localhost:8080"})
+//
+//	login, err := api.Login(ctx, nil, &client.LoginRequest{Email: email, Password: password})
+//	if err != nil {
+//	    return err
+//	}
+//	api = api.WithToken(login.Data.Token.AccessToken)
+//
+//	guild, err := api.GetGuild(ctx, guildID)
+//	if client.StatusCode(err) == http.StatusNotFound {
+//	    // No such guild
+//	}
+//
+// Statuses other than 2xx are returned as *Error, with the RFC 9457 problem
+// details when the API sent them. Tokens are sent as bearer tokens; DPoP
+// bound tokens aren't supported.
+//
+// # Regenerating
+//
+// After changing the spec, regenerate the client with:
+//
+//	go generate ./pkg/client
+//
+// Operations that stream server-sent events are skipped, and merge and
+// JSON patches are sent in their plain JSON form.
+package client
+
+//go:generate go run ./gen
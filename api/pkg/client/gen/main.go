@@ -0,0 +1,248 @@
+// Command gen writes the client package from the OpenAPI spec. Run it
+// through go generate:
+//
+//	go generate ./pkg/client
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const header = "// Code generated by go generate ./pkg/client; DO NOT EDIT.\n\n"
+
+func main() {
+	specFile := flag.String("spec", "../../openapi/openapi.yaml", "OpenAPI document to generate from")
+	typesOut := flag.String("types", "types.gen.go", "file to write the schema types to")
+	opsOut := flag.String("operations", "operations.gen.go", "file to write the client methods to")
+	flag.Parse()
+
+	g, err := load(*specFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := write(*typesOut, g.types.source()); err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+	if err := write(*opsOut, g.source()); err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+	for _, name := range sortedKeys(g.types.missing) {
+		fmt.Fprintf(os.Stderr, "gen: %s is referenced but not defined; typed as json.RawMessage\n", name)
+	}
+	for _, s := range g.skipped {
+		fmt.Fprintf(os.Stderr, "gen: skipped %s: %s\n", s.id, s.reason)
+	}
+}
+
+// generator holds everything read from the spec
+type generator struct {
+	spec    *spec
+	types   *types
+	ops     []*operation
+	skipped []skipped
+}
+
+// load reads the document, declaring the component schemas first so they
+// keep their names, then the operations and their inline types
+func load(file string) (*generator, error) {
+	s := newSpec()
+	g := &generator{spec: s, types: newTypes(s)}
+
+	root, err := s.load(file)
+	if err != nil {
+		return nil, err
+	}
+	components := filepath.Join(filepath.Dir(file), "components", "schemas", "_index.yaml")
+	if err := g.types.components(components); err != nil {
+		return nil, err
+	}
+	if err := g.operations(root); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// source renders the client methods and their parameter types
+func (g *generator) source() string {
+	var b strings.Builder
+	for _, o := range g.ops {
+		if len(o.params) > 0 {
+			g.writeParams(&b, o)
+		}
+		g.writeMethod(&b, o)
+	}
+
+	var imports []string
+	for _, pkg := range []string{"context", "encoding/json", "io", "net/http", "net/url", "time"} {
+		if uses(b.String(), pkg) {
+			imports = append(imports, fmt.Sprintf("%q", pkg))
+		}
+	}
+	return "package client\n\nimport (\n\t" + strings.Join(imports, "\n\t") + "\n)\n" + b.String()
+}
+
+// uses reports whether src refers to an exported name of pkg
+func uses(src, pkg string) bool {
+	name := pkg[strings.LastIndex(pkg, "/")+1:]
+	return regexp.MustCompile(`\b` + name + `\.[A-Z]`).MatchString(src)
+}
+
+// writeParams writes the struct holding an operation's query and header
+// parameters and the method that encodes them
+func (g *generator) writeParams(b *strings.Builder, o *operation) {
+	fmt.Fprintf(b, "\n// %sParams holds the query and header parameters of %s.\n", o.name, o.name)
+	fmt.Fprintf(b, "type %sParams struct {\n", o.name)
+	for _, p := range o.params {
+		typ := p.typ
+		if !p.required && p.in == "query" && g.types.pointable(typ) {
+			typ = "*" + typ
+		}
+		fmt.Fprintf(b, "\t%s %s // %s %s\n", p.goName, typ, p.name, p.in)
+	}
+	b.WriteString("}\n")
+
+	fmt.Fprintf(b, "\nfunc (p *%sParams) values() (url.Values, http.Header) {\n", o.name)
+	b.WriteString("\tquery, header := url.Values{}, http.Header{}\n")
+	for _, p := range o.params {
+		field := "p." + p.goName
+		switch {
+		case p.in == "header":
+			fmt.Fprintf(b, "\tif %s != \"\" {\n\t\theader.Set(%q, %s)\n\t}\n", field, p.name, field)
+		case p.deep:
+			fmt.Fprintf(b, "\tfor k, v := range %s {\n\t\tquery.Set(%q+k+\"]\", paramValue(v))\n\t}\n", field, p.name+"[")
+		case strings.HasPrefix(p.typ, "[]"):
+			fmt.Fprintf(b, "\tfor _, v := range %s {\n\t\tquery.Add(%q, paramValue(v))\n\t}\n", field, p.name)
+		case !p.required && g.types.pointable(p.typ):
+			fmt.Fprintf(b, "\tif %s != nil {\n\t\tquery.Set(%q, paramValue(*%s))\n\t}\n", field, p.name, field)
+		default:
+			fmt.Fprintf(b, "\tquery.Set(%q, paramValue(%s))\n", p.name, field)
+		}
+	}
+	b.WriteString("\treturn query, header\n}\n")
+}
+
+// writeMethod writes the client method calling an operation
+func (g *generator) writeMethod(b *strings.Builder, o *operation) {
+	args := []string{"ctx context.Context"}
+	for _, p := range o.pathParams {
+		args = append(args, p.goName+" string")
+	}
+	if len(o.params) > 0 {
+		args = append(args, "params *"+o.name+"Params")
+	}
+	bodyType := ""
+	if o.body != nil {
+		bodyType = o.body.typ
+		if g.types.isStruct(bodyType) {
+			bodyType = "*" + bodyType
+		}
+		args = append(args, "body "+bodyType)
+	}
+
+	resultType, zero := "", ""
+	if r := o.result; r != nil {
+		resultType, zero = r.typ, "out"
+		if g.types.isStruct(r.typ) {
+			resultType = "*" + r.typ
+		}
+		if strings.HasPrefix(resultType, "*") || strings.HasPrefix(resultType, "[]") ||
+			strings.HasPrefix(resultType, "map[") || resultType == "json.RawMessage" {
+			zero = "nil"
+		}
+	}
+
+	doc := o.name + " sends " + strings.ToUpper(o.method) + " " + o.path + "."
+	if o.summary != "" {
+		doc += " " + strings.TrimSuffix(o.summary, ".") + "."
+	}
+	if o.description != "" {
+		doc += "\n\n" + o.description
+	}
+	b.WriteString("\n" + comment(doc, ""))
+
+	returns := "error"
+	if resultType != "" {
+		returns = "(" + resultType + ", error)"
+	}
+	fmt.Fprintf(b, "func (c *Client) %s(%s) %s {\n", o.name, strings.Join(args, ", "), returns)
+
+	fmt.Fprintf(b, "\treq := request{\n\t\tmethod: http.Method%s,\n\t\tpath:   %s,\n\t}\n", methodConst(o.method), pathExpr(o))
+	if len(o.params) > 0 {
+		b.WriteString("\tif params != nil {\n\t\treq.query, req.header = params.values()\n\t}\n")
+	}
+	if o.body != nil {
+		nilable := strings.HasPrefix(bodyType, "*") || strings.HasPrefix(bodyType, "[]") ||
+			strings.HasPrefix(bodyType, "map[") || bodyType == "io.Reader" || bodyType == "json.RawMessage"
+		if nilable {
+			b.WriteString("\tif body != nil {\n")
+		}
+		fmt.Fprintf(b, "\treq.body, req.contentType = body, %q\n", o.body.contentType)
+		if nilable {
+			b.WriteString("\t}\n")
+		}
+	}
+
+	r := o.result
+	switch {
+	case r == nil:
+		b.WriteString("\t_, err := c.do(ctx, req, nil)\n\treturn err\n")
+	case r.raw:
+		b.WriteString("\treturn c.doRaw(ctx, req)\n")
+	default:
+		fmt.Fprintf(b, "\tvar out %s\n", r.typ)
+		if r.noContent {
+			b.WriteString("\tstatus, err := c.do(ctx, req, &out)\n")
+			fmt.Fprintf(b, "\tif err != nil || status == http.StatusNoContent {\n\t\treturn %s, err\n\t}\n", zero)
+		} else {
+			fmt.Fprintf(b, "\tif _, err := c.do(ctx, req, &out); err != nil {\n\t\treturn %s, err\n\t}\n", zero)
+		}
+		if strings.HasPrefix(resultType, "*") {
+			b.WriteString("\treturn &out, nil\n")
+		} else {
+			b.WriteString("\treturn out, nil\n")
+		}
+	}
+	b.WriteString("}\n")
+}
+
+func methodConst(method string) string {
+	return strings.ToUpper(method[:1]) + strings.ToLower(method[1:])
+}
+
+// pathExpr builds the request path, escaping each path parameter
+func pathExpr(o *operation) string {
+	parts := pathParamPattern.Split(o.path, -1)
+	var expr []string
+	for i, part := range parts {
+		if part != "" {
+			expr = append(expr, fmt.Sprintf("%q", part))
+		}
+		if i < len(o.pathParams) {
+			expr = append(expr, "url.PathEscape("+o.pathParams[i].goName+")")
+		}
+	}
+	return strings.Join(expr, " + ")
+}
+
+// write formats source and writes it, marked as generated
+func write(file, src string) error {
+	formatted, err := format.Source([]byte(header + src))
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", file, err)
+	}
+	if existing, err := os.ReadFile(file); err == nil && bytes.Equal(existing, formatted) {
+		return nil
+	}
+	return os.WriteFile(file, formatted, 0o644)
+}
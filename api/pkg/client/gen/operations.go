@@ -0,0 +1,267 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// methods are the HTTP methods operations are read from, in the order
+// they are generated for a path
+var methods = []string{"get", "post", "put", "patch", "delete"}
+
+// operation is one method of the client
+type operation struct {
+	id          string
+	name        string // Go method name
+	method      string
+	path        string
+	summary     string
+	description string
+	pathParams  []param
+	params      []param // Query and header parameters
+	body        *body
+	result      *result
+}
+
+type param struct {
+	name     string // Name on the wire
+	in       string // path, query or header
+	goName   string
+	typ      string
+	required bool
+	deep     bool // A deepObject, sent as name[key]=value
+}
+
+type body struct {
+	typ         string
+	contentType string
+	raw         bool // Sent as an io.Reader rather than encoded as JSON
+}
+
+type result struct {
+	typ       string
+	raw       bool // Returned as bytes rather than decoded as JSON
+	noContent bool // Some successes have no body
+}
+
+// skipped records an operation the client can't call
+type skipped struct {
+	id     string
+	reason string
+}
+
+// operations reads every operation of the document's paths
+func (g *generator) operations(root node) error {
+	seen := make(map[string]string)
+	for _, p := range root.get("paths").pairs() {
+		item, err := g.spec.resolve(p.value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p.key, err)
+		}
+		for _, method := range methods {
+			op := item.get(method)
+			if op.Node == nil {
+				continue
+			}
+			id := op.get("operationId").str()
+			if id == "" {
+				return fmt.Errorf("%s %s has no operationId", strings.ToUpper(method), p.key)
+			}
+			if other, dup := seen[id]; dup {
+				return fmt.Errorf("operationId %s is used by %s and %s", id, other, p.key)
+			}
+			seen[id] = p.key
+
+			o, reason, err := g.operation(id, method, p.key, item, op)
+			if err != nil {
+				return fmt.Errorf("%s: %w", id, err)
+			}
+			if reason != "" {
+				g.skipped = append(g.skipped, skipped{id, reason})
+				continue
+			}
+			g.ops = append(g.ops, o)
+		}
+	}
+	return nil
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+func (g *generator) operation(id, method, path string, item, op node) (*operation, string, error) {
+	o := &operation{
+		id:          id,
+		name:        goName(id),
+		method:      method,
+		path:        path,
+		summary:     op.get("summary").str(),
+		description: op.get("description").str(),
+	}
+
+	result, reason, err := g.result(o.name, op)
+	if err != nil || reason != "" {
+		return nil, reason, err
+	}
+	o.result = result
+
+	params, err := g.parameters(o.name, item, op)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, match := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+		p, ok := params[match[1]+"/path"]
+		if !ok {
+			p = param{name: match[1], in: "path", typ: "string", required: true}
+		}
+		p.goName = argName(match[1])
+		o.pathParams = append(o.pathParams, p)
+	}
+	for _, key := range sortedKeys(params) {
+		if p := params[key]; p.in != "path" {
+			o.params = append(o.params, p)
+		}
+	}
+
+	if o.body, err = g.body(o.name, op); err != nil {
+		return nil, "", err
+	}
+	return o, "", nil
+}
+
+// parameters merges the path's parameters with the operation's, which
+// override them, keyed by name and location
+func (g *generator) parameters(opName string, item, op node) (map[string]param, error) {
+	params := make(map[string]param)
+	for _, list := range []node{item.get("parameters"), op.get("parameters")} {
+		for _, raw := range list.items() {
+			n, err := g.spec.resolve(raw)
+			if err != nil {
+				return nil, err
+			}
+			p := param{
+				name:     n.get("name").str(),
+				in:       n.get("in").str(),
+				required: n.get("required").str() == "true",
+				goName:   goName(n.get("name").str()),
+				deep:     n.get("style").str() == "deepObject",
+			}
+			if p.in == "cookie" {
+				continue
+			}
+			p.typ = "string"
+			if schema := n.get("schema"); schema.Node != nil && p.in != "header" {
+				if p.typ, err = g.types.typeOf(opName+p.goName, "the "+p.name+" parameter of "+opName, schema); err != nil {
+					return nil, fmt.Errorf("parameter %s: %w", p.name, err)
+				}
+			}
+			params[p.name+"/"+p.in] = p
+		}
+	}
+	return params, nil
+}
+
+// body picks the request body, preferring JSON. Merge and JSON patches are
+// left to the plain JSON form, which the API accepts for every patch.
+func (g *generator) body(opName string, op node) (*body, error) {
+	n, err := g.spec.resolve(op.get("requestBody"))
+	if err != nil || n.Node == nil {
+		return nil, err
+	}
+	content := n.get("content")
+	if schema := content.get("application/json").get("schema"); schema.Node != nil {
+		typ, err := g.types.typeOf(opName+"Body", "the request body of "+opName, schema)
+		if err != nil {
+			return nil, fmt.Errorf("request body: %w", err)
+		}
+		return &body{typ: typ, contentType: "application/json"}, nil
+	}
+	pairs := content.pairs()
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	return &body{typ: "io.Reader", contentType: pairs[0].key, raw: true}, nil
+}
+
+// result picks what a successful response returns. Streams can't be
+// returned as a value, so operations that only stream or upgrade the
+// connection are skipped.
+func (g *generator) result(opName string, op node) (*result, string, error) {
+	var codes []string
+	responses := op.get("responses")
+	if responses.get("101").Node != nil {
+		return nil, "it upgrades to a WebSocket", nil
+	}
+	for _, p := range responses.pairs() {
+		if strings.HasPrefix(p.key, "2") {
+			codes = append(codes, p.key)
+		}
+	}
+	sort.Strings(codes)
+
+	var r *result
+	empty := false
+	for _, code := range codes {
+		resp, err := g.spec.resolve(responses.get(code))
+		if err != nil {
+			return nil, "", err
+		}
+		content := resp.get("content")
+		if len(content.pairs()) == 0 {
+			empty = true
+			continue
+		}
+		if r != nil {
+			continue
+		}
+		if schema := content.get("application/json").get("schema"); schema.Node != nil {
+			typ, err := g.types.typeOf(opName+"Response", "the response to "+opName, schema)
+			if err != nil {
+				return nil, "", fmt.Errorf("response: %w", err)
+			}
+			r = &result{typ: typ}
+			continue
+		}
+		if content.get("text/event-stream").Node != nil {
+			return nil, "it streams server-sent events", nil
+		}
+		r = &result{typ: "[]byte", raw: true}
+	}
+	if r != nil {
+		r.noContent = empty
+	}
+	return r, "", nil
+}
+
+// argName turns a path parameter into a Go argument name
+func argName(name string) string {
+	arg := goName(name)
+	for i, r := range arg {
+		if r < 'A' || r > 'Z' {
+			if i > 1 {
+				i--
+			}
+			arg = strings.ToLower(arg[:i]) + arg[i:]
+			break
+		}
+		if i == len(arg)-1 {
+			arg = strings.ToLower(arg)
+		}
+	}
+	switch arg {
+	case "ctx", "params", "body", "type", "func", "range", "default":
+		arg += "Arg"
+	}
+	return arg
+}
+
+// sortedKeys returns a map's keys in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// spec reads the OpenAPI document and the files its $refs point into
+type spec struct {
+	docs map[string]*yaml.Node // Mapping node of each loaded file, by path
+}
+
+// errDangling is a $ref to something the spec doesn't define
+var errDangling = errors.New("dangling $ref")
+
+// node is a YAML node and the file it was read from, which relative $refs
+// inside it are resolved against
+type node struct {
+	file string
+	*yaml.Node
+}
+
+func newSpec() *spec {
+	return &spec{docs: make(map[string]*yaml.Node)}
+}
+
+// load reads a file once and returns its root mapping
+func (s *spec) load(file string) (node, error) {
+	file = filepath.Clean(file)
+	if root, ok := s.docs[file]; ok {
+		return node{file, root}, nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return node{}, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return node{}, fmt.Errorf("%s: %w", file, err)
+	}
+	root := &doc
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	s.docs[file] = root
+	return node{file, root}, nil
+}
+
+// resolve follows $refs until it reaches a node that isn't one
+func (s *spec) resolve(n node) (node, error) {
+	for range 16 {
+		ref := n.get("$ref").str()
+		if ref == "" {
+			return n, nil
+		}
+		target, err := s.ref(n.file, ref)
+		if err != nil {
+			return node{}, err
+		}
+		n = target
+	}
+	return node{}, fmt.Errorf("%s: $ref chain too long", n.file)
+}
+
+// ref looks up a $ref written in file
+func (s *spec) ref(file, ref string) (node, error) {
+	path, pointer, _ := strings.Cut(ref, "#")
+	if path != "" {
+		file = filepath.Join(filepath.Dir(file), path)
+	}
+	n, err := s.load(file)
+	if err != nil {
+		return node{}, err
+	}
+	for _, part := range strings.Split(strings.Trim(pointer, "/"), "/") {
+		if part == "" {
+			continue
+		}
+		part = strings.NewReplacer("~1", "/", "~0", "~").Replace(part)
+		if n = n.get(part); n.Node == nil {
+			return node{}, fmt.Errorf("%s: %w %q", file, errDangling, ref)
+		}
+	}
+	return n, nil
+}
+
+// refName returns the last segment of a $ref, which names the schema
+func refName(ref string) string {
+	_, pointer, _ := strings.Cut(ref, "#")
+	return pointer[strings.LastIndex(pointer, "/")+1:]
+}
+
+// get returns a mapping's value for key, or a nil node
+func (n node) get(key string) node {
+	if n.Node == nil || n.Kind != yaml.MappingNode {
+		return node{file: n.file}
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return node{n.file, n.Content[i+1]}
+		}
+	}
+	return node{file: n.file}
+}
+
+// pair is a mapping entry
+type pair struct {
+	key   string
+	value node
+}
+
+// pairs returns a mapping's entries in document order
+func (n node) pairs() []pair {
+	if n.Node == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	pairs := make([]pair, 0, len(n.Content)/2)
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		pairs = append(pairs, pair{n.Content[i].Value, node{n.file, n.Content[i+1]}})
+	}
+	return pairs
+}
+
+// items returns a sequence's elements
+func (n node) items() []node {
+	if n.Node == nil || n.Kind != yaml.SequenceNode {
+		return nil
+	}
+	items := make([]node, len(n.Content))
+	for i, item := range n.Content {
+		items[i] = node{n.file, item}
+	}
+	return items
+}
+
+// str returns a scalar's value, or "" for anything else
+func (n node) str() string {
+	if n.Node == nil || n.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return n.Value
+}
+
+// strs returns the values of a sequence of scalars
+func (n node) strs() []string {
+	var values []string
+	for _, item := range n.items() {
+		values = append(values, item.str())
+	}
+	return values
+}
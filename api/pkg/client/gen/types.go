@@ -0,0 +1,379 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// decl is a Go type declaration written to the types file
+type decl struct {
+	name     string
+	doc      string
+	def      string // Type definition, e.g. "struct { ... }" or "string"
+	isStruct bool
+}
+
+// types turns schemas into Go types, declaring a named type for every
+// component schema and every inline object
+type types struct {
+	spec  *spec
+	decls map[string]*decl
+	order []string
+	refs  map[string]string // Declared names by the schema they came from
+
+	// missing are schemas referenced but not defined, which are typed as
+	// json.RawMessage until the spec defines them
+	missing map[string]bool
+}
+
+func newTypes(s *spec) *types {
+	return &types{
+		spec:    s,
+		decls:   make(map[string]*decl),
+		refs:    make(map[string]string),
+		missing: make(map[string]bool),
+	}
+}
+
+// components declares every schema in a components file, in file order.
+// Entries that are shared responses rather than schemas are skipped.
+func (t *types) components(file string) error {
+	root, err := t.spec.load(file)
+	if err != nil {
+		return err
+	}
+	for _, p := range root.pairs() {
+		if !isSchema(p.value) {
+			continue
+		}
+		if _, err := t.named(p.key, "the "+p.key+" schema", p.value); err != nil {
+			return fmt.Errorf("schema %s: %w", p.key, err)
+		}
+	}
+	return nil
+}
+
+// isSchema reports whether a components entry describes a value, as
+// opposed to a response with a description and content
+func isSchema(n node) bool {
+	for _, key := range []string{"type", "properties", "allOf", "items", "enum", "$ref"} {
+		if n.get(key).Node != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// named declares a type called name for a schema and returns its name. A
+// schema already declared under another name is reused. what says where
+// the schema appears, for the doc comment.
+func (t *types) named(name, what string, n node) (string, error) {
+	key := schemaKey(n)
+	if existing, ok := t.refs[key]; ok {
+		return existing, nil
+	}
+	if _, taken := t.decls[name]; taken {
+		return "", fmt.Errorf("type name %s is used by two schemas", name)
+	}
+
+	d := &decl{name: name, doc: describe(name, what, n)}
+	t.decls[name] = d
+	t.order = append(t.order, name)
+	t.refs[key] = name
+
+	def, isStruct, err := t.define(name, what, n)
+	if err != nil {
+		return "", err
+	}
+	d.def, d.isStruct = def, isStruct
+	return name, nil
+}
+
+// schemaKey identifies a schema node across lookups
+func schemaKey(n node) string {
+	return fmt.Sprintf("%s:%d:%d", n.file, n.Line, n.Column)
+}
+
+// define returns the definition of a named type for a schema
+func (t *types) define(name, what string, n node) (string, bool, error) {
+	if allOf := n.get("allOf"); allOf.Node != nil {
+		def, err := t.allOf(name, allOf)
+		return def, true, err
+	}
+	if props := n.get("properties"); len(props.pairs()) > 0 {
+		def, err := t.structOf(name, n)
+		return def, true, err
+	}
+	typ, err := t.typeOf(name, what, n)
+	return typ, false, err
+}
+
+// typeOf returns the Go type for a schema, declaring inline objects as
+// named types called hint
+func (t *types) typeOf(hint, what string, n node) (string, error) {
+	if ref := n.get("$ref").str(); ref != "" {
+		target, err := t.spec.ref(n.file, ref)
+		if errors.Is(err, errDangling) {
+			t.missing[refName(ref)] = true
+			return "json.RawMessage", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		return t.named(refName(ref), "the "+refName(ref)+" schema", target)
+	}
+	if n.get("allOf").Node != nil || len(n.get("properties").pairs()) > 0 {
+		return t.named(hint, what, n)
+	}
+
+	switch n.get("type").str() {
+	case "string":
+		switch n.get("format").str() {
+		case "date-time":
+			return "time.Time", nil
+		case "byte":
+			return "[]byte", nil
+		}
+		return "string", nil
+	case "integer":
+		if n.get("format").str() == "int64" {
+			return "int64", nil
+		}
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		items := n.get("items")
+		if items.Node == nil {
+			return "[]any", nil
+		}
+		elem, err := t.typeOf(singular(hint), "an element of "+what, items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object", "":
+		if n.get("type").str() == "" && n.get("enum").Node != nil {
+			return "string", nil
+		}
+		extra := n.get("additionalProperties")
+		if extra.Node != nil && extra.Kind == yaml.MappingNode {
+			value, err := t.typeOf(hint+"Value", "a value of "+what, extra)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + value, nil
+		}
+		return "map[string]any", nil
+	}
+	return "", fmt.Errorf("unsupported type %q", n.get("type").str())
+}
+
+// structOf returns a struct with a field per property. Optional and
+// nullable scalars and structs are pointers, so leaving them out of a
+// request doesn't send zero values.
+func (t *types) structOf(name string, n node) (string, error) {
+	fields, err := t.fields(name, n)
+	if err != nil {
+		return "", err
+	}
+	return "struct {\n" + fields + "}", nil
+}
+
+func (t *types) fields(name string, n node) (string, error) {
+	required := make(map[string]bool)
+	for _, field := range n.get("required").strs() {
+		required[field] = true
+	}
+
+	var b strings.Builder
+	seen := make(map[string]bool)
+	for _, p := range n.get("properties").pairs() {
+		prop, err := t.spec.resolve(p.value)
+		if errors.Is(err, errDangling) {
+			prop = p.value
+		} else if err != nil {
+			return "", err
+		}
+		field := goName(p.key)
+		if seen[field] {
+			return "", fmt.Errorf("properties of %s map to the same field %s", name, field)
+		}
+		seen[field] = true
+
+		typ, err := t.typeOf(name+field, fmt.Sprintf("the %s property of %s", p.key, name), p.value)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", p.key, err)
+		}
+		optional := !required[p.key] || prop.get("nullable").str() == "true"
+		if optional && t.pointable(typ) {
+			typ = "*" + typ
+		}
+		tag := p.key
+		if !required[p.key] {
+			tag += ",omitempty"
+		}
+
+		if doc := p.value.get("description").str(); doc != "" {
+			b.WriteString(comment(doc, "\t"))
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:%q`\n", field, typ, tag)
+	}
+	return b.String(), nil
+}
+
+// allOf returns a struct embedding each referenced schema, with the
+// properties of inline ones as fields
+func (t *types) allOf(name string, n node) (string, error) {
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	for _, part := range n.get("allOf").items() {
+		if ref := part.get("$ref").str(); ref != "" {
+			embedded, err := t.typeOf("", "", part)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&b, "\t%s\n", embedded)
+			continue
+		}
+		fields, err := t.fields(name, part)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(fields)
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// pointable reports whether an optional field of the type should be a
+// pointer; slices and maps already have a nil value
+func (t *types) pointable(typ string) bool {
+	if strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") || typ == "any" || typ == "json.RawMessage" {
+		return false
+	}
+	if d, ok := t.decls[typ]; ok && !d.isStruct {
+		return !strings.HasPrefix(d.def, "[]") && !strings.HasPrefix(d.def, "map[")
+	}
+	return true
+}
+
+// isStruct reports whether a Go type is a declared struct
+func (t *types) isStruct(typ string) bool {
+	d, ok := t.decls[typ]
+	return ok && d.isStruct
+}
+
+// source renders every declaration
+func (t *types) source() string {
+	var b strings.Builder
+	for _, name := range t.order {
+		d := t.decls[name]
+		fmt.Fprintf(&b, "\n%stype %s %s\n", comment(d.doc, ""), d.name, d.def)
+	}
+	var imports []string
+	for _, pkg := range []string{"encoding/json", "time"} {
+		if uses(b.String(), pkg) {
+			imports = append(imports, fmt.Sprintf("%q", pkg))
+		}
+	}
+	return "package client\n\nimport (\n\t" + strings.Join(imports, "\n\t") + "\n)\n" + b.String()
+}
+
+// describe returns a type's doc comment
+func describe(name, what string, n node) string {
+	doc := name + " is " + what + "."
+	if description := n.get("description").str(); description != "" {
+		doc += "\n\n" + description
+	}
+	return doc
+}
+
+// goName turns a JSON or parameter name into an exported Go name
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.' || r == ' ' || r == '$'
+	})
+	var b strings.Builder
+	for _, word := range words {
+		b.WriteString(goWord(word))
+	}
+	out := b.String()
+	if out == "" || (out[0] >= '0' && out[0] <= '9') {
+		out = "N" + out
+	}
+	return out
+}
+
+// goWord capitalizes one word of a name, keeping Go's initialisms and
+// splitting camelCase so guildId becomes GuildID
+func goWord(word string) string {
+	var parts []string
+	start := 0
+	for i := 1; i < len(word); i++ {
+		if word[i] >= 'A' && word[i] <= 'Z' && word[i-1] >= 'a' && word[i-1] <= 'z' {
+			parts = append(parts, word[start:i])
+			start = i
+		}
+	}
+	parts = append(parts, word[start:])
+
+	var b strings.Builder
+	for _, part := range parts {
+		if initialism, ok := initialisms[strings.ToLower(part)]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+var initialisms = map[string]string{
+	"api": "API", "csv": "CSV", "dpop": "DPoP", "html": "HTML", "http": "HTTP",
+	"id": "ID", "ids": "IDs", "ip": "IP", "json": "JSON", "sms": "SMS",
+	"uri": "URI", "url": "URL", "urls": "URLs", "utc": "UTC", "uuid": "UUID",
+}
+
+// singular names an array's elements after the array
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "ss"), len(name) < 4:
+		return name + "Item"
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name + "Item"
+}
+
+// comment renders text as a Go comment wrapped at 78 columns
+func comment(text, indent string) string {
+	var b strings.Builder
+	for i, para := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		if i > 0 {
+			b.WriteString(indent + "//\n")
+		}
+		line := ""
+		for _, word := range strings.Fields(para) {
+			if line != "" && len(indent)*4+len(line)+len(word) > 75 {
+				b.WriteString(indent + "// " + line + "\n")
+				line = ""
+			}
+			if line != "" {
+				line += " "
+			}
+			line += word
+		}
+		if line != "" {
+			b.WriteString(indent + "// " + line + "\n")
+		}
+	}
+	return b.String()
+}
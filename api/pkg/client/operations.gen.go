@@ -0,0 +1,8546 @@
+// Code generated by go generate ./pkg/client; DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// GetHealth sends GET /health. Health check.
+func (c *Client) GetHealth(ctx context.Context) (*HealthResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/health",
+	}
+	var out HealthResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RegisterParams holds the query and header parameters of Register.
+type RegisterParams struct {
+	DPoP            string // DPoP header
+	XClientPlatform string // X-Client-Platform header
+}
+
+func (p *RegisterParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DPoP != "" {
+		header.Set("DPoP", p.DPoP)
+	}
+	if p.XClientPlatform != "" {
+		header.Set("X-Client-Platform", p.XClientPlatform)
+	}
+	return query, header
+}
+
+// Register sends POST /v1/auth/register. Register new user.
+//
+// Create a new user account with email and password. Signups that look like
+// spam or bots get verification_required and are emailed a verification link;
+// until it is opened the account can read but not make changes.
+func (c *Client) Register(ctx context.Context, params *RegisterParams, body *RegisterRequest) (*RegisterResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/register",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RegisterResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LoginParams holds the query and header parameters of Login.
+type LoginParams struct {
+	DPoP            string // DPoP header
+	XClientPlatform string // X-Client-Platform header
+}
+
+func (p *LoginParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DPoP != "" {
+		header.Set("DPoP", p.DPoP)
+	}
+	if p.XClientPlatform != "" {
+		header.Set("X-Client-Platform", p.XClientPlatform)
+	}
+	return query, header
+}
+
+// Login sends POST /v1/auth/login. Login with email/password.
+func (c *Client) Login(ctx context.Context, params *LoginParams, body *LoginRequest) (*LoginResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/login",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out LoginResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// OauthGoogleParams holds the query and header parameters of OauthGoogle.
+type OauthGoogleParams struct {
+	DPoP            string // DPoP header
+	XClientPlatform string // X-Client-Platform header
+}
+
+func (p *OauthGoogleParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DPoP != "" {
+		header.Set("DPoP", p.DPoP)
+	}
+	if p.XClientPlatform != "" {
+		header.Set("X-Client-Platform", p.XClientPlatform)
+	}
+	return query, header
+}
+
+// OauthGoogle sends POST /v1/auth/oauth/google. Authenticate with Google.
+//
+// Exchange Google OAuth authorization code for tokens
+func (c *Client) OauthGoogle(ctx context.Context, params *OauthGoogleParams, body *OAuthRequest) (*OauthGoogleResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/oauth/google",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out OauthGoogleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// OauthAppleParams holds the query and header parameters of OauthApple.
+type OauthAppleParams struct {
+	DPoP            string // DPoP header
+	XClientPlatform string // X-Client-Platform header
+}
+
+func (p *OauthAppleParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DPoP != "" {
+		header.Set("DPoP", p.DPoP)
+	}
+	if p.XClientPlatform != "" {
+		header.Set("X-Client-Platform", p.XClientPlatform)
+	}
+	return query, header
+}
+
+// OauthApple sends POST /v1/auth/oauth/apple. Authenticate with Apple.
+//
+// Exchange Apple OAuth authorization code for tokens
+func (c *Client) OauthApple(ctx context.Context, params *OauthAppleParams, body *OAuthRequest) (*OauthAppleResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/oauth/apple",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out OauthAppleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PasskeyRegisterStart sends POST /v1/auth/passkey/register/start. Start
+// passkey registration.
+//
+// Get WebAuthn challenge and options for registering a new passkey
+func (c *Client) PasskeyRegisterStart(ctx context.Context) (*PasskeyRegistrationStartResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/passkey/register/start",
+	}
+	var out PasskeyRegistrationStartResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PasskeyRegisterFinish sends POST /v1/auth/passkey/register/finish. Complete
+// passkey registration.
+//
+// Submit WebAuthn attestation to finish registering a passkey
+func (c *Client) PasskeyRegisterFinish(ctx context.Context, body *PasskeyRegistrationFinishRequest) (*PasskeyRegisterFinishResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/passkey/register/finish",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PasskeyRegisterFinishResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PasskeyLoginStart sends POST /v1/auth/passkey/login/start. Start passkey
+// login.
+//
+// Get WebAuthn challenge for authenticating with a passkey
+func (c *Client) PasskeyLoginStart(ctx context.Context, body *PasskeyLoginStartRequest) (*PasskeyLoginStartResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/passkey/login/start",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PasskeyLoginStartResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PasskeyLoginFinishParams holds the query and header parameters of PasskeyLoginFinish.
+type PasskeyLoginFinishParams struct {
+	DPoP            string // DPoP header
+	XClientPlatform string // X-Client-Platform header
+}
+
+func (p *PasskeyLoginFinishParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DPoP != "" {
+		header.Set("DPoP", p.DPoP)
+	}
+	if p.XClientPlatform != "" {
+		header.Set("X-Client-Platform", p.XClientPlatform)
+	}
+	return query, header
+}
+
+// PasskeyLoginFinish sends POST /v1/auth/passkey/login/finish. Complete
+// passkey login.
+//
+// Submit WebAuthn assertion to authenticate with a passkey
+func (c *Client) PasskeyLoginFinish(ctx context.Context, params *PasskeyLoginFinishParams, body *PasskeyLoginFinishRequest) (*PasskeyLoginFinishResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/passkey/login/finish",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PasskeyLoginFinishResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PasskeyCrossDeviceStart sends POST /v1/auth/passkey/cross-device. Start
+// cross-device login.
+//
+// Start a login on a device without a passkey, to be approved with a passkey
+// on another device such as a phone. Show the id to the other device and poll
+// with the poll token until it approves. Expires after 2 minutes.
+func (c *Client) PasskeyCrossDeviceStart(ctx context.Context) (*PasskeyCrossDeviceStartResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/passkey/cross-device",
+	}
+	var out PasskeyCrossDeviceStartResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PasskeyCrossDeviceOptions sends GET /v1/auth/passkey/cross-device/{id}.
+// Cross-device login options.
+//
+// WebAuthn assertion options for the approving device. Any discoverable
+// passkey can approve.
+func (c *Client) PasskeyCrossDeviceOptions(ctx context.Context, id string) (*PasskeyCrossDeviceOptionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/auth/passkey/cross-device/" + url.PathEscape(id),
+	}
+	var out PasskeyCrossDeviceOptionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PasskeyCrossDeviceApprove sends POST
+// /v1/auth/passkey/cross-device/{id}/approve. Approve cross-device login.
+//
+// Approve the login with a passkey assertion from this device
+func (c *Client) PasskeyCrossDeviceApprove(ctx context.Context, id string, body *PasskeyLoginFinishRequest) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/passkey/cross-device/" + url.PathEscape(id) + "/approve",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// PasskeyCrossDevicePollParams holds the query and header parameters of PasskeyCrossDevicePoll.
+type PasskeyCrossDevicePollParams struct {
+	DPoP            string // DPoP header
+	XClientPlatform string // X-Client-Platform header
+}
+
+func (p *PasskeyCrossDevicePollParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DPoP != "" {
+		header.Set("DPoP", p.DPoP)
+	}
+	if p.XClientPlatform != "" {
+		header.Set("X-Client-Platform", p.XClientPlatform)
+	}
+	return query, header
+}
+
+// PasskeyCrossDevicePoll sends POST /v1/auth/passkey/cross-device/{id}/poll.
+// Poll cross-device login.
+//
+// Called by the initiating device until the login is approved. The approved
+// response carries the session and ends the login.
+func (c *Client) PasskeyCrossDevicePoll(ctx context.Context, id string, params *PasskeyCrossDevicePollParams, body *CrossDevicePollRequest) (*PasskeyCrossDevicePollResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/passkey/cross-device/" + url.PathEscape(id) + "/poll",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PasskeyCrossDevicePollResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DevicePairingStart sends POST /v1/auth/device-pairing. Start QR login.
+//
+// Start a login on a shared device that isn't signed in. Show the payload as a
+// QR code for a signed-in phone to approve, and poll with the poll token until
+// it does. Expires after 2 minutes by default.
+func (c *Client) DevicePairingStart(ctx context.Context, body *StartDevicePairingRequest) (*DevicePairingStartResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/device-pairing",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out DevicePairingStartResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DevicePairingPollParams holds the query and header parameters of DevicePairingPoll.
+type DevicePairingPollParams struct {
+	DPoP            string // DPoP header
+	XClientPlatform string // X-Client-Platform header
+}
+
+func (p *DevicePairingPollParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DPoP != "" {
+		header.Set("DPoP", p.DPoP)
+	}
+	if p.XClientPlatform != "" {
+		header.Set("X-Client-Platform", p.XClientPlatform)
+	}
+	return query, header
+}
+
+// DevicePairingPoll sends POST /v1/auth/device-pairing/{id}/poll. Poll QR
+// login.
+//
+// Called by the shared device until its QR login is approved. The approved
+// response carries the session and ends the login.
+func (c *Client) DevicePairingPoll(ctx context.Context, id string, params *DevicePairingPollParams, body *PollDevicePairingRequest) (*DevicePairingPollResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/device-pairing/" + url.PathEscape(id) + "/poll",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out DevicePairingPollResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeviceApprove sends POST /v1/auth/device-approve. Approve QR login.
+//
+// Sign the shared device behind a scanned QR code in as the current user. The
+// device receives tokens on its next poll.
+func (c *Client) DeviceApprove(ctx context.Context, body *ApproveDeviceRequest) (*DeviceApproveResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/device-approve",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out DeviceApproveResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RefreshTokensParams holds the query and header parameters of RefreshTokens.
+type RefreshTokensParams struct {
+	DPoP            string // DPoP header
+	XClientPlatform string // X-Client-Platform header
+}
+
+func (p *RefreshTokensParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DPoP != "" {
+		header.Set("DPoP", p.DPoP)
+	}
+	if p.XClientPlatform != "" {
+		header.Set("X-Client-Platform", p.XClientPlatform)
+	}
+	return query, header
+}
+
+// RefreshTokens sends POST /v1/auth/refresh. Refresh access token.
+//
+// Exchange a refresh token for new access and refresh tokens
+func (c *Client) RefreshTokens(ctx context.Context, params *RefreshTokensParams, body *RefreshRequest) (*RefreshTokensResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/refresh",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RefreshTokensResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Logout sends POST /v1/auth/logout. Logout.
+//
+// Revoke all refresh tokens for the current user
+func (c *Client) Logout(ctx context.Context) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/logout",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetCurrentUser sends GET /v1/auth/me. Get current user.
+//
+// Get the authenticated user's profile, identities, and passkeys
+func (c *Client) GetCurrentUser(ctx context.Context) (*GetCurrentUserResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/auth/me",
+	}
+	var out GetCurrentUserResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ChangePassword sends POST /v1/auth/password. Change password.
+//
+// Change the current user's password. Needs the current password, or a sign-in
+// within the last 5 minutes (the access token's auth_time). Every session is
+// signed out; the response carries fresh tokens for the caller.
+func (c *Client) ChangePassword(ctx context.Context, body *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/password",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out ChangePasswordResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ChangeEmail sends POST /v1/auth/email/change. Change email.
+//
+// Start moving the account to a new email address. Needs the current password,
+// or a sign-in within the last 5 minutes. A verification link is emailed to
+// the new address; the account keeps its current address until the link is
+// opened.
+func (c *Client) ChangeEmail(ctx context.Context, body *EmailChangeRequest) (*ChangeEmailResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/email/change",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out ChangeEmailResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SendEmailVerification sends POST /v1/auth/email/verification. Send email
+// verification.
+//
+// Email a link verifying the address the account already has. Opening it with
+// the verify endpoint marks the email verified and lifts any restriction on
+// making changes placed at signup.
+func (c *Client) SendEmailVerification(ctx context.Context) (*SendEmailVerificationResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/email/verification",
+	}
+	var out SendEmailVerificationResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// VerifyEmail sends POST /v1/auth/email/verify. Verify new email.
+//
+// Finish an email change with the token from the verification link. The
+// account switches to the new address, every session is signed out and the
+// previous address is notified. Recovery links work only after their
+// effective_on time. A link for the account's current address just marks it
+// verified.
+func (c *Client) VerifyEmail(ctx context.Context, body *EmailVerifyRequest) (*VerifyEmailResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/email/verify",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out VerifyEmailResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PasskeyRecover sends POST /v1/auth/passkey/recover. Recover account with a
+// passkey.
+//
+// Move an account to a new email address when the old inbox is lost, proven
+// with a passkey assertion to a challenge from passkey login/start. No session
+// is issued. The previous address is told at once and sent a link to cancel;
+// the new address gets a verification link that works after 72 hours.
+func (c *Client) PasskeyRecover(ctx context.Context, body *PasskeyRecoverRequest) (*PasskeyRecoverResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/passkey/recover",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PasskeyRecoverResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelRecovery sends POST /v1/auth/recovery/cancel. Cancel account recovery.
+//
+// Stop a passkey recovery with the token from the link sent to the previous
+// address. Every session is signed out.
+func (c *Client) CancelRecovery(ctx context.Context, body *EmailVerifyRequest) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/recovery/cancel",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// AcceptInvitation sends POST /v1/auth/invitations/accept. Accept invitation.
+//
+// Choose a password for an account an admin created, with the token from the
+// invitation email, and sign in. Accepting verifies the email. Each link works
+// once.
+func (c *Client) AcceptInvitation(ctx context.Context, body *AcceptInvitationBody) (*AcceptInvitationResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/invitations/accept",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AcceptInvitationResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LinkProvider sends POST /v1/auth/link. Link OAuth provider.
+//
+// Link a new OAuth identity to the current account
+func (c *Client) LinkProvider(ctx context.Context, body *OAuthRequest) (*LinkProviderResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/auth/link",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out LinkProviderResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeletePasskey sends DELETE /v1/auth/passkey/{id}. Delete passkey.
+//
+// Remove a registered passkey
+func (c *Client) DeletePasskey(ctx context.Context, id string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/auth/passkey/" + url.PathEscape(id),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListGuilds sends GET /v1/guilds. List user's guilds.
+func (c *Client) ListGuilds(ctx context.Context) (*ListGuildsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds",
+	}
+	var out ListGuildsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuild sends POST /v1/guilds. Create a new guild.
+func (c *Client) CreateGuild(ctx context.Context, body *CreateGuildBody) (*CreateGuildResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuild sends GET /v1/guilds/{id}. Get guild with all data.
+func (c *Client) GetGuild(ctx context.Context, id string) (*GetGuildResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(id),
+	}
+	var out GetGuildResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuild sends PATCH /v1/guilds/{id}. Update guild.
+func (c *Client) UpdateGuild(ctx context.Context, id string, body *UpdateGuildRequest) (*UpdateGuildResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(id),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateGuildResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuild sends DELETE /v1/guilds/{id}. Delete guild.
+func (c *Client) DeleteGuild(ctx context.Context, id string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(id),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetGuildMembersParams holds the query and header parameters of GetGuildMembers.
+type GetGuildMembersParams struct {
+	Cursor *string // cursor query
+	Limit  *int    // limit query
+	Offset *int    // offset query
+}
+
+func (p *GetGuildMembersParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	return query, header
+}
+
+// GetGuildMembers sends GET /v1/guilds/{id}/members. List guild members.
+func (c *Client) GetGuildMembers(ctx context.Context, id string, params *GetGuildMembersParams) (*GetGuildMembersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(id) + "/members",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetGuildMembersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// JoinGuild sends POST /v1/guilds/{id}/join. Join an existing guild.
+//
+// Adds the authenticated user as a member of the specified guild
+func (c *Client) JoinGuild(ctx context.Context, id string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(id) + "/join",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// LeaveGuild sends POST /v1/guilds/{id}/leave. Leave guild.
+func (c *Client) LeaveGuild(ctx context.Context, id string) (*UndoableResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(id) + "/leave",
+	}
+	var out UndoableResponse
+	status, err := c.do(ctx, req, &out)
+	if err != nil || status == http.StatusNoContent {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MergeGuilds sends POST /v1/guilds/{id}/merge. Merge another guild into this
+// one.
+//
+// Merges the source guild into the target guild. All people, activities, and
+// members from the source guild are moved to the target. The source guild is
+// deleted after merge. User must be a member of both guilds.
+func (c *Client) MergeGuilds(ctx context.Context, id string, body *MergeGuildsBody) (*MergeGuildsResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(id) + "/merge",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out MergeGuildsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildBySlug sends GET /v1/guild-slugs/{slug}. Get guild by slug.
+//
+// Resolves a guild's current slug, or a slug it previously used, and returns
+// the same payload as `GET /v1/guilds/{id}`.
+func (c *Client) GetGuildBySlug(ctx context.Context, slug string) (*GetGuildBySlugResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guild-slugs/" + url.PathEscape(slug),
+	}
+	var out GetGuildBySlugResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildTiers sends GET /v1/guilds/{guildId}/tiers. List a guild's tiers.
+//
+// The guild's tiers, highest ranked first (members only).
+func (c *Client) ListGuildTiers(ctx context.Context, guildID string) (*ListGuildTiersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/tiers",
+	}
+	var out ListGuildTiersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildTier sends POST /v1/guilds/{guildId}/tiers. Create a guild tier.
+//
+// Adds a tier to the guild (admins only). A guild can have up to 10 tiers,
+// each with a unique key.
+func (c *Client) CreateGuildTier(ctx context.Context, guildID string, body *CreateGuildTierRequest) (*CreateGuildTierResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/tiers",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildTierResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuildTier sends PATCH /v1/guilds/{guildId}/tiers/{tierId}. Update a
+// guild tier.
+//
+// Changes a tier's name, description, rank, or early RSVP hours (admins only).
+// Rank changes apply to gated events and pools at once.
+func (c *Client) UpdateGuildTier(ctx context.Context, guildID string, tierID string, body *UpdateGuildTierRequest) (*UpdateGuildTierResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/tiers/" + url.PathEscape(tierID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateGuildTierResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuildTier sends DELETE /v1/guilds/{guildId}/tiers/{tierId}. Delete a
+// guild tier.
+//
+// Deletes a tier and takes it from its holders (admins only). Tiers gating
+// upcoming events or pools can't be deleted; clear their min_tier first.
+func (c *Client) DeleteGuildTier(ctx context.Context, guildID string, tierID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/tiers/" + url.PathEscape(tierID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListGuildTierMembers sends GET /v1/guilds/{guildId}/tiers/{tierId}/members.
+// List a tier's holders.
+//
+// Members holding the tier, excluding lapsed ones (admins only).
+func (c *Client) ListGuildTierMembers(ctx context.Context, guildID string, tierID string) (*ListGuildTierMembersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/tiers/" + url.PathEscape(tierID) + "/members",
+	}
+	var out ListGuildTierMembersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildMemberTier sends GET /v1/guilds/{guildId}/members/{userId}/tier. Get
+// a member's tier.
+//
+// The tier a guild member holds (members only).
+func (c *Client) GetGuildMemberTier(ctx context.Context, guildID string, userID string) (*GetGuildMemberTierResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/members/" + url.PathEscape(userID) + "/tier",
+	}
+	var out GetGuildMemberTierResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AssignGuildMemberTier sends PUT /v1/guilds/{guildId}/members/{userId}/tier.
+// Assign a member's tier.
+//
+// Gives a guild member a tier, replacing the one they hold (admins only). With
+// expires_on the tier lapses at that time.
+func (c *Client) AssignGuildMemberTier(ctx context.Context, guildID string, userID string, body *AssignGuildTierRequest) (*AssignGuildMemberTierResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/members/" + url.PathEscape(userID) + "/tier",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AssignGuildMemberTierResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveGuildMemberTier sends DELETE
+// /v1/guilds/{guildId}/members/{userId}/tier. Remove a member's tier.
+//
+// Takes a member's tier away (admins only).
+func (c *Client) RemoveGuildMemberTier(ctx context.Context, guildID string, userID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/members/" + url.PathEscape(userID) + "/tier",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListGuildHangoutTypes sends GET /v1/guilds/{guildId}/hangout-types. List a
+// guild's hangout types.
+//
+// The guild's custom types in display order (members only). Guild admins also
+// see inactive ones.
+func (c *Client) ListGuildHangoutTypes(ctx context.Context, guildID string) (*ListGuildHangoutTypesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/hangout-types",
+	}
+	var out ListGuildHangoutTypesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildHangoutType sends POST /v1/guilds/{guildId}/hangout-types. Create
+// a guild hangout type.
+//
+// Adds a custom type members can use for availability scoped to the guild
+// (admins only). A guild can have up to 20 types, and their keys can't reuse a
+// platform type's.
+func (c *Client) CreateGuildHangoutType(ctx context.Context, guildID string, body *CreateHangoutTypeRequest) (*CreateGuildHangoutTypeResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/hangout-types",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildHangoutTypeResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuildHangoutType sends PATCH
+// /v1/guilds/{guildId}/hangout-types/{type}. Update a guild hangout type.
+//
+// Changes one of the guild's custom types (admins only).
+func (c *Client) UpdateGuildHangoutType(ctx context.Context, guildID string, typeArg string, body *UpdateHangoutTypeRequest) (*UpdateGuildHangoutTypeResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/hangout-types/" + url.PathEscape(typeArg),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateGuildHangoutTypeResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuildHangoutType sends DELETE
+// /v1/guilds/{guildId}/hangout-types/{type}. Delete a guild hangout type.
+//
+// Removes one of the guild's custom types (admins only). Availability already
+// posted with it keeps its type.
+func (c *Client) DeleteGuildHangoutType(ctx context.Context, guildID string, typeArg string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/hangout-types/" + url.PathEscape(typeArg),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListGuildVenues sends GET /v1/guilds/{guildId}/venues. List a guild's
+// venues.
+//
+// The guild's saved venues by name (members only).
+func (c *Client) ListGuildVenues(ctx context.Context, guildID string) (*ListGuildVenuesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/venues",
+	}
+	var out ListGuildVenuesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildVenue sends POST /v1/guilds/{guildId}/venues. Save a venue.
+//
+// Adds a venue to the guild's directory (members only). Without lat and lng
+// the address is geocoded; if the provider is unavailable the venue is saved
+// without coordinates. A guild can have up to 200 venues.
+func (c *Client) CreateGuildVenue(ctx context.Context, guildID string, body *CreateVenueRequest) (*CreateGuildVenueResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/venues",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildVenueResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildVenue sends GET /v1/guilds/{guildId}/venues/{venueId}. Get a venue.
+func (c *Client) GetGuildVenue(ctx context.Context, guildID string, venueID string) (*GetGuildVenueResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/venues/" + url.PathEscape(venueID),
+	}
+	var out GetGuildVenueResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuildVenue sends PATCH /v1/guilds/{guildId}/venues/{venueId}. Update a
+// venue.
+//
+// Changes a venue (the member who saved it or a guild admin). Events already
+// at the venue keep the location they were created with.
+func (c *Client) UpdateGuildVenue(ctx context.Context, guildID string, venueID string, body *UpdateVenueRequest) (*UpdateGuildVenueResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/venues/" + url.PathEscape(venueID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateGuildVenueResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuildVenue sends DELETE /v1/guilds/{guildId}/venues/{venueId}. Delete
+// a venue.
+//
+// Removes a venue from the directory (the member who saved it or a guild
+// admin). Events already at the venue keep their location.
+func (c *Client) DeleteGuildVenue(ctx context.Context, guildID string, venueID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/venues/" + url.PathEscape(venueID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListGuildInvites sends GET /v1/guilds/{guildId}/invites. List a guild's
+// invites.
+//
+// The guild's invites, newest first, including expired and used up ones (guild
+// admins only). Revoked invites are gone.
+func (c *Client) ListGuildInvites(ctx context.Context, guildID string) (*ListGuildInvitesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/invites",
+	}
+	var out ListGuildInvitesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildInvite sends POST /v1/guilds/{guildId}/invites. Create an invite.
+//
+// Creates an invite code and link to the guild (guild admins only). Invites
+// expire after 7 days unless expires_in_hours says otherwise, up to 30 days.
+// An invite with an email is for that person only: it can be used once, from
+// the account with that verified email. A guild can have up to 50 active
+// invites.
+func (c *Client) CreateGuildInvite(ctx context.Context, guildID string, body *CreateGuildInviteRequest) (*CreateGuildInviteResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/invites",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildInviteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeGuildInvite sends DELETE /v1/guilds/{guildId}/invites/{inviteId}.
+// Revoke an invite.
+//
+// Deletes the invite so its code stops working (guild admins only).
+func (c *Client) RevokeGuildInvite(ctx context.Context, guildID string, inviteID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/invites/" + url.PathEscape(inviteID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// AcceptGuildInvite sends POST /v1/invites/{code}/accept. Accept an invite.
+//
+// Joins the invite's guild without waiting for approval.
+func (c *Client) AcceptGuildInvite(ctx context.Context, code string) (*AcceptGuildInviteResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/invites/" + url.PathEscape(code) + "/accept",
+	}
+	var out AcceptGuildInviteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildMemberPermissions sends GET
+// /v1/guilds/{guildId}/members/{userId}/permissions. Get a member's guild
+// permissions.
+//
+// What a guild member may do, with their built-in role and the custom roles
+// granting the rest (members only).
+func (c *Client) GetGuildMemberPermissions(ctx context.Context, guildID string, userID string) (*GetGuildMemberPermissionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/members/" + url.PathEscape(userID) + "/permissions",
+	}
+	var out GetGuildMemberPermissionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildRoles sends GET /v1/guilds/{guildId}/roles. List a guild's custom
+// roles.
+//
+// The guild's custom roles by name (members only).
+func (c *Client) ListGuildRoles(ctx context.Context, guildID string) (*ListGuildRolesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/roles",
+	}
+	var out ListGuildRolesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildRole sends POST /v1/guilds/{guildId}/roles. Create a guild custom
+// role.
+//
+// Defines a custom role (manage_roles). Members can only grant permissions
+// they hold themselves. A guild can have up to 20 custom roles, each with a
+// unique name.
+func (c *Client) CreateGuildRole(ctx context.Context, guildID string, body *CreateGuildCustomRoleRequest) (*CreateGuildRoleResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/roles",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildRoleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuildRole sends PATCH /v1/guilds/{guildId}/roles/{roleId}. Update a
+// guild custom role.
+//
+// Changes a custom role (manage_roles). Permission changes apply to its
+// holders at once.
+func (c *Client) UpdateGuildRole(ctx context.Context, guildID string, roleID string, body *UpdateGuildCustomRoleRequest) (*UpdateGuildRoleResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/roles/" + url.PathEscape(roleID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateGuildRoleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuildRole sends DELETE /v1/guilds/{guildId}/roles/{roleId}. Delete a
+// guild custom role.
+//
+// Deletes a custom role and takes it from its holders (manage_roles).
+func (c *Client) DeleteGuildRole(ctx context.Context, guildID string, roleID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/roles/" + url.PathEscape(roleID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// AssignGuildRole sends PUT
+// /v1/guilds/{guildId}/roles/{roleId}/members/{userId}. Give a member a custom
+// role.
+//
+// Gives a guild member a custom role (manage_roles). Members can hold up to 5
+// custom roles; assigning one they hold does nothing.
+func (c *Client) AssignGuildRole(ctx context.Context, guildID string, roleID string, userID string) (*AssignGuildRoleResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/roles/" + url.PathEscape(roleID) + "/members/" + url.PathEscape(userID),
+	}
+	var out AssignGuildRoleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnassignGuildRole sends DELETE
+// /v1/guilds/{guildId}/roles/{roleId}/members/{userId}. Take a custom role
+// from a member.
+//
+// Takes a custom role from a guild member (manage_roles).
+func (c *Client) UnassignGuildRole(ctx context.Context, guildID string, roleID string, userID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/roles/" + url.PathEscape(roleID) + "/members/" + url.PathEscape(userID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetGuildHealth sends GET /v1/guilds/{guildId}/health. Get a guild's health.
+//
+// The guild's latest health score, the components it is made of, actions
+// organizers can take to improve it, and its daily scores for the last 30
+// days, newest first. Scores are recomputed every few hours; a guild that
+// hasn't been scored yet is scored on request.
+//
+// Requires the manage_pools permission (moderators and admins by default).
+// Moderators and admins are also alerted when the score falls by 15 or more
+// points within a week.
+func (c *Client) GetGuildHealth(ctx context.Context, guildID string) (*GetGuildHealthResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/health",
+	}
+	var out GetGuildHealthResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPeople sends GET /v1/guilds/{guildId}/people. List people in guild.
+func (c *Client) ListPeople(ctx context.Context, guildID string) (*ListPeopleResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people",
+	}
+	var out ListPeopleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreatePerson sends POST /v1/guilds/{guildId}/people. Add person to guild.
+func (c *Client) CreatePerson(ctx context.Context, guildID string, body *CreatePersonRequest) (*CreatePersonResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreatePersonResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPerson sends GET /v1/guilds/{guildId}/people/{personId}. Get person
+// details with timers.
+func (c *Client) GetPerson(ctx context.Context, guildID string, personID string) (*GetPersonResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID),
+	}
+	var out GetPersonResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdatePerson sends PATCH /v1/guilds/{guildId}/people/{personId}. Update
+// person.
+func (c *Client) UpdatePerson(ctx context.Context, guildID string, personID string, body *UpdatePersonRequest) (*UpdatePersonResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdatePersonResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeletePerson sends DELETE /v1/guilds/{guildId}/people/{personId}. Remove
+// person.
+func (c *Client) DeletePerson(ctx context.Context, guildID string, personID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListActivities sends GET /v1/guilds/{guildId}/activities. List activities in
+// guild.
+func (c *Client) ListActivities(ctx context.Context, guildID string) (*ListActivitiesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/activities",
+	}
+	var out ListActivitiesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateActivity sends POST /v1/guilds/{guildId}/activities. Create activity.
+func (c *Client) CreateActivity(ctx context.Context, guildID string, body *CreateActivityRequest) (*CreateActivityResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/activities",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateActivityResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetActivity sends GET /v1/guilds/{guildId}/activities/{activityId}. Get
+// activity details.
+func (c *Client) GetActivity(ctx context.Context, guildID string, activityID string) (*GetActivityResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/activities/" + url.PathEscape(activityID),
+	}
+	var out GetActivityResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateActivity sends PATCH /v1/guilds/{guildId}/activities/{activityId}.
+// Update activity.
+func (c *Client) UpdateActivity(ctx context.Context, guildID string, activityID string, body *UpdateActivityBody) (*UpdateActivityResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/activities/" + url.PathEscape(activityID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateActivityResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteActivity sends DELETE /v1/guilds/{guildId}/activities/{activityId}.
+// Delete activity.
+func (c *Client) DeleteActivity(ctx context.Context, guildID string, activityID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/activities/" + url.PathEscape(activityID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListTimers sends GET /v1/guilds/{guildId}/people/{personId}/timers. List
+// timers for person.
+func (c *Client) ListTimers(ctx context.Context, guildID string, personID string) (*ListTimersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID) + "/timers",
+	}
+	var out ListTimersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTimer sends POST /v1/guilds/{guildId}/people/{personId}/timers. Create
+// timer.
+func (c *Client) CreateTimer(ctx context.Context, guildID string, personID string, body *CreateTimerRequest) (*CreateTimerResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID) + "/timers",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateTimerResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTimer sends GET /v1/guilds/{guildId}/people/{personId}/timers/{timerId}.
+// Get timer details.
+func (c *Client) GetTimer(ctx context.Context, guildID string, personID string, timerID string) (*GetTimerResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID) + "/timers/" + url.PathEscape(timerID),
+	}
+	var out GetTimerResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTimer sends PATCH
+// /v1/guilds/{guildId}/people/{personId}/timers/{timerId}. Update timer.
+func (c *Client) UpdateTimer(ctx context.Context, guildID string, personID string, timerID string, body *UpdateTimerRequest) (*UpdateTimerResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID) + "/timers/" + url.PathEscape(timerID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateTimerResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTimer sends DELETE
+// /v1/guilds/{guildId}/people/{personId}/timers/{timerId}. Delete timer.
+func (c *Client) DeleteTimer(ctx context.Context, guildID string, personID string, timerID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID) + "/timers/" + url.PathEscape(timerID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ResetTimer sends POST
+// /v1/guilds/{guildId}/people/{personId}/timers/{timerId}/reset. Reset timer.
+//
+// Resets the timer's reset_date to the current time
+func (c *Client) ResetTimer(ctx context.Context, guildID string, personID string, timerID string) (*ResetTimerResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/people/" + url.PathEscape(personID) + "/timers/" + url.PathEscape(timerID) + "/reset",
+	}
+	var out ResetTimerResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTrustRating sends POST /v1/trust-ratings. Create trust rating.
+//
+// Create a trust rating for another user. Requires an anchor (event or
+// rideshare) where both users had confirmed attendance. Limited to 10 ratings
+// per day.
+func (c *Client) CreateTrustRating(ctx context.Context, body *CreateTrustRatingRequest) (*CreateTrustRatingResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/trust-ratings",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateTrustRatingResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTrustRating sends GET /v1/trust-ratings/{ratingId}. Get trust rating
+// details.
+func (c *Client) GetTrustRating(ctx context.Context, ratingID string) (*GetTrustRatingResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/trust-ratings/" + url.PathEscape(ratingID),
+	}
+	var out GetTrustRatingResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTrustRating sends PATCH /v1/trust-ratings/{ratingId}. Update trust
+// rating.
+//
+// Update an existing trust rating. Subject to 30-day cooldown period after
+// last change.
+func (c *Client) UpdateTrustRating(ctx context.Context, ratingID string, body *UpdateTrustRatingRequest) (*UpdateTrustRatingResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/trust-ratings/" + url.PathEscape(ratingID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateTrustRatingResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTrustRating sends DELETE /v1/trust-ratings/{ratingId}. Unset trust
+// rating.
+//
+// Remove trust rating (return to neutral/undecided state). The rating is
+// deleted but history is preserved.
+func (c *Client) DeleteTrustRating(ctx context.Context, ratingID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/trust-ratings/" + url.PathEscape(ratingID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetEndorsements sends GET /v1/trust-ratings/{ratingId}/endorsements. Get
+// endorsements for rating.
+func (c *Client) GetEndorsements(ctx context.Context, ratingID string) (*GetEndorsementsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/trust-ratings/" + url.PathEscape(ratingID) + "/endorsements",
+	}
+	var out GetEndorsementsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEndorsement sends POST /v1/trust-ratings/{ratingId}/endorsements.
+// Create endorsement.
+//
+// Endorse a trust rating. Only users who attended the same anchor
+// event/rideshare can endorse.
+func (c *Client) CreateEndorsement(ctx context.Context, ratingID string, body *CreateEndorsementRequest) (*CreateEndorsementResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/trust-ratings/" + url.PathEscape(ratingID) + "/endorsements",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateEndorsementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserTrustRatingsReceivedParams holds the query and header parameters of GetUserTrustRatingsReceived.
+type GetUserTrustRatingsReceivedParams struct {
+	Cursor *string           // cursor query
+	Filter map[string]string // filter query
+	Limit  *int              // limit query
+	Offset *int              // offset query
+	Sort   *string           // sort query
+}
+
+func (p *GetUserTrustRatingsReceivedParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	for k, v := range p.Filter {
+		query.Set("filter["+k+"]", paramValue(v))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	if p.Sort != nil {
+		query.Set("sort", paramValue(*p.Sort))
+	}
+	return query, header
+}
+
+// GetUserTrustRatingsReceived sends GET
+// /v1/users/{userId}/trust-ratings/received. Get trust ratings received by
+// user.
+//
+// Returns public trust ratings about a user (distrust ratings hidden)
+func (c *Client) GetUserTrustRatingsReceived(ctx context.Context, userID string, params *GetUserTrustRatingsReceivedParams) (*GetUserTrustRatingsReceivedResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/" + url.PathEscape(userID) + "/trust-ratings/received",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetUserTrustRatingsReceivedResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserTrustRatingsGivenParams holds the query and header parameters of GetUserTrustRatingsGiven.
+type GetUserTrustRatingsGivenParams struct {
+	Cursor *string           // cursor query
+	Filter map[string]string // filter query
+	Limit  *int              // limit query
+	Offset *int              // offset query
+	Sort   *string           // sort query
+}
+
+func (p *GetUserTrustRatingsGivenParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	for k, v := range p.Filter {
+		query.Set("filter["+k+"]", paramValue(v))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	if p.Sort != nil {
+		query.Set("sort", paramValue(*p.Sort))
+	}
+	return query, header
+}
+
+// GetUserTrustRatingsGiven sends GET /v1/users/{userId}/trust-ratings/given.
+// Get trust ratings given by user.
+func (c *Client) GetUserTrustRatingsGiven(ctx context.Context, userID string, params *GetUserTrustRatingsGivenParams) (*GetUserTrustRatingsGivenResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/" + url.PathEscape(userID) + "/trust-ratings/given",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetUserTrustRatingsGivenResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserTrustAggregate sends GET /v1/users/{userId}/trust-aggregate. Get user
+// trust aggregate.
+//
+// Returns aggregate trust statistics for a user
+func (c *Client) GetUserTrustAggregate(ctx context.Context, userID string) (*GetUserTrustAggregateResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/" + url.PathEscape(userID) + "/trust-aggregate",
+	}
+	var out GetUserTrustAggregateResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDistrustSignalsParams holds the query and header parameters of GetDistrustSignals.
+type GetDistrustSignalsParams struct {
+	Limit       *int // limit query
+	MinDistrust *int // min_distrust query
+}
+
+func (p *GetDistrustSignalsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.MinDistrust != nil {
+		query.Set("min_distrust", paramValue(*p.MinDistrust))
+	}
+	return query, header
+}
+
+// GetDistrustSignals sends GET /v1/admin/distrust-signals. Get distrust
+// signals (admin only).
+//
+// Returns users with high distrust signal counts for moderation review.
+// Requires admin privileges.
+func (c *Client) GetDistrustSignals(ctx context.Context, params *GetDistrustSignalsParams) (*GetDistrustSignalsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/distrust-signals",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetDistrustSignalsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildRoleCatalogsParams holds the query and header parameters of ListGuildRoleCatalogs.
+type ListGuildRoleCatalogsParams struct {
+	RoleType *string // role_type query
+}
+
+func (p *ListGuildRoleCatalogsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.RoleType != nil {
+		query.Set("role_type", paramValue(*p.RoleType))
+	}
+	return query, header
+}
+
+// ListGuildRoleCatalogs sends GET /v1/guilds/{guildId}/role-catalogs. List
+// guild role catalogs.
+func (c *Client) ListGuildRoleCatalogs(ctx context.Context, guildID string, params *ListGuildRoleCatalogsParams) (*ListGuildRoleCatalogsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/role-catalogs",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListGuildRoleCatalogsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildRoleCatalog sends POST /v1/guilds/{guildId}/role-catalogs. Create
+// guild role catalog.
+//
+// Create a reusable role template for the guild. Requires guild admin.
+func (c *Client) CreateGuildRoleCatalog(ctx context.Context, guildID string, body *CreateRoleCatalogRequest) (*CreateGuildRoleCatalogResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/role-catalogs",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildRoleCatalogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildRoleCatalog sends GET
+// /v1/guilds/{guildId}/role-catalogs/{catalogId}. Get guild role catalog.
+func (c *Client) GetGuildRoleCatalog(ctx context.Context, guildID string, catalogID string) (*GetGuildRoleCatalogResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/role-catalogs/" + url.PathEscape(catalogID),
+	}
+	var out GetGuildRoleCatalogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuildRoleCatalog sends PATCH
+// /v1/guilds/{guildId}/role-catalogs/{catalogId}. Update guild role catalog.
+func (c *Client) UpdateGuildRoleCatalog(ctx context.Context, guildID string, catalogID string, body *UpdateRoleCatalogRequest) (*UpdateGuildRoleCatalogResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/role-catalogs/" + url.PathEscape(catalogID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateGuildRoleCatalogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuildRoleCatalog sends DELETE
+// /v1/guilds/{guildId}/role-catalogs/{catalogId}. Delete guild role catalog.
+func (c *Client) DeleteGuildRoleCatalog(ctx context.Context, guildID string, catalogID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/role-catalogs/" + url.PathEscape(catalogID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListMyRoleCatalogsParams holds the query and header parameters of ListMyRoleCatalogs.
+type ListMyRoleCatalogsParams struct {
+	RoleType *string // role_type query
+}
+
+func (p *ListMyRoleCatalogsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.RoleType != nil {
+		query.Set("role_type", paramValue(*p.RoleType))
+	}
+	return query, header
+}
+
+// ListMyRoleCatalogs sends GET /v1/users/me/role-catalogs. List my role
+// catalogs.
+func (c *Client) ListMyRoleCatalogs(ctx context.Context, params *ListMyRoleCatalogsParams) (*ListMyRoleCatalogsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/me/role-catalogs",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListMyRoleCatalogsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateMyRoleCatalog sends POST /v1/users/me/role-catalogs. Create personal
+// role catalog.
+//
+// Create a reusable role template for personal use
+func (c *Client) CreateMyRoleCatalog(ctx context.Context, body *CreateRoleCatalogRequest) (*CreateMyRoleCatalogResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/users/me/role-catalogs",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateMyRoleCatalogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyRoleCatalog sends GET /v1/users/me/role-catalogs/{catalogId}. Get my
+// role catalog.
+func (c *Client) GetMyRoleCatalog(ctx context.Context, catalogID string) (*GetMyRoleCatalogResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/me/role-catalogs/" + url.PathEscape(catalogID),
+	}
+	var out GetMyRoleCatalogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateMyRoleCatalog sends PATCH /v1/users/me/role-catalogs/{catalogId}.
+// Update my role catalog.
+func (c *Client) UpdateMyRoleCatalog(ctx context.Context, catalogID string, body *UpdateRoleCatalogRequest) (*UpdateMyRoleCatalogResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/users/me/role-catalogs/" + url.PathEscape(catalogID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateMyRoleCatalogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteMyRoleCatalog sends DELETE /v1/users/me/role-catalogs/{catalogId}.
+// Delete my role catalog.
+func (c *Client) DeleteMyRoleCatalog(ctx context.Context, catalogID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/users/me/role-catalogs/" + url.PathEscape(catalogID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// CreateEventRoleFromCatalog sends POST
+// /v1/events/{eventId}/roles/from-catalog. Create event role from catalog.
+//
+// Create an event role using a catalog template
+func (c *Client) CreateEventRoleFromCatalog(ctx context.Context, eventID string, body *CreateEventRoleFromCatalogBody) (*CreateEventRoleFromCatalogResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/from-catalog",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateEventRoleFromCatalogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRideshareRoles sends GET /v1/rideshares/{rideshareId}/roles. List
+// rideshare roles.
+func (c *Client) ListRideshareRoles(ctx context.Context, rideshareID string) (*ListRideshareRolesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/roles",
+	}
+	var out ListRideshareRolesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateRideshareRole sends POST /v1/rideshares/{rideshareId}/roles. Create
+// rideshare role.
+func (c *Client) CreateRideshareRole(ctx context.Context, rideshareID string, body *CreateRideshareRoleRequest) (*CreateRideshareRoleResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/roles",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateRideshareRoleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateRideshareRole sends PATCH /v1/rideshares/{rideshareId}/roles/{roleId}.
+// Update rideshare role.
+func (c *Client) UpdateRideshareRole(ctx context.Context, rideshareID string, roleID string, body *UpdateRideshareRoleRequest) (*UpdateRideshareRoleResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/roles/" + url.PathEscape(roleID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateRideshareRoleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRideshareRole sends DELETE
+// /v1/rideshares/{rideshareId}/roles/{roleId}. Delete rideshare role.
+func (c *Client) DeleteRideshareRole(ctx context.Context, rideshareID string, roleID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/roles/" + url.PathEscape(roleID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// AssignRideshareRole sends POST
+// /v1/rideshares/{rideshareId}/roles/{roleId}/assignments. Assign user to
+// rideshare role.
+func (c *Client) AssignRideshareRole(ctx context.Context, rideshareID string, roleID string, body *AssignRideshareRoleBody) (*AssignRideshareRoleResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/roles/" + url.PathEscape(roleID) + "/assignments",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AssignRideshareRoleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnassignRideshareRole sends DELETE
+// /v1/rideshares/{rideshareId}/roles/{roleId}/assignments/{assignmentId}.
+// Remove user from rideshare role.
+func (c *Client) UnassignRideshareRole(ctx context.Context, rideshareID string, roleID string, assignmentID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/roles/" + url.PathEscape(roleID) + "/assignments/" + url.PathEscape(assignmentID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// CreateRideshareRoleFromCatalog sends POST
+// /v1/rideshares/{rideshareId}/roles/from-catalog. Create rideshare role from
+// catalog.
+func (c *Client) CreateRideshareRoleFromCatalog(ctx context.Context, rideshareID string, body *CreateRideshareRoleFromCatalogBody) (*CreateRideshareRoleFromCatalogResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/roles/from-catalog",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateRideshareRoleFromCatalogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateVote sends POST /v1/votes. Create vote.
+//
+// Create a new vote. Guild votes require guild admin permission. Global votes
+// require sysadmin permission.
+func (c *Client) CreateVote(ctx context.Context, body *CreateVoteRequest) (*CreateVoteResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/votes",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateVoteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVote sends GET /v1/votes/{voteId}. Get vote details.
+func (c *Client) GetVote(ctx context.Context, voteID string) (*GetVoteResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/votes/" + url.PathEscape(voteID),
+	}
+	var out GetVoteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateVote sends PATCH /v1/votes/{voteId}. Update vote.
+//
+// Update vote details. Only allowed while vote is in draft status, except
+// reminders_disabled which the creator can also change while the vote is open.
+func (c *Client) UpdateVote(ctx context.Context, voteID string, body *UpdateVoteRequest) (*UpdateVoteResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/votes/" + url.PathEscape(voteID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateVoteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteVote sends DELETE /v1/votes/{voteId}. Delete vote.
+//
+// Guild votes are moved to the guild's trash, where organizers can restore
+// them for 30 days before they are permanently deleted. Global votes are
+// cancelled and archived instead, for their audit trail.
+func (c *Client) DeleteVote(ctx context.Context, voteID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/votes/" + url.PathEscape(voteID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// OpenVote sends POST /v1/votes/{voteId}/open. Open vote.
+//
+// Manually open voting (before scheduled opens_at time)
+func (c *Client) OpenVote(ctx context.Context, voteID string) (*OpenVoteResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/open",
+	}
+	var out OpenVoteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CloseVote sends POST /v1/votes/{voteId}/close. Close vote.
+//
+// Manually close voting (before scheduled closes_at time)
+func (c *Client) CloseVote(ctx context.Context, voteID string) (*CloseVoteResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/close",
+	}
+	var out CloseVoteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ScheduleVotePublication sends PUT /v1/votes/{voteId}/schedule. Schedule vote
+// publication.
+//
+// Schedule or reschedule when a draft vote is published. The vote stays hidden
+// until then; at publish time guild members are notified and the vote opens at
+// opens_at. Cancel the vote to call off a scheduled publication.
+func (c *Client) ScheduleVotePublication(ctx context.Context, voteID string, body *SchedulePublicationRequest) (*Vote, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/schedule",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Vote
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelVote sends POST /v1/votes/{voteId}/cancel. Cancel vote.
+//
+// Cancel the vote at any stage
+func (c *Client) CancelVote(ctx context.Context, voteID string) (*CancelVoteResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/cancel",
+	}
+	var out CancelVoteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListVoteOptions sends GET /v1/votes/{voteId}/options. List vote options.
+func (c *Client) ListVoteOptions(ctx context.Context, voteID string) (*ListVoteOptionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/options",
+	}
+	var out ListVoteOptionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateVoteOption sends POST /v1/votes/{voteId}/options. Add vote option.
+//
+// Add an option to the vote. Only allowed while vote is in draft status.
+func (c *Client) CreateVoteOption(ctx context.Context, voteID string, body *CreateVoteOptionRequest) (*CreateVoteOptionResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/options",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateVoteOptionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchCreateVoteOptions sends POST /v1/votes/{voteId}/options/batch. Batch
+// add vote options.
+//
+// Add multiple options at once. Maximum 50 options per batch.
+func (c *Client) BatchCreateVoteOptions(ctx context.Context, voteID string, body *BatchCreateVoteOptionsBody) (*BatchCreateVoteOptionsResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/options/batch",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out BatchCreateVoteOptionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateVoteOption sends PATCH /v1/votes/{voteId}/options/{optionId}. Update
+// vote option.
+func (c *Client) UpdateVoteOption(ctx context.Context, voteID string, optionID string, body *UpdateVoteOptionRequest) (*UpdateVoteOptionResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/options/" + url.PathEscape(optionID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateVoteOptionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteVoteOption sends DELETE /v1/votes/{voteId}/options/{optionId}. Delete
+// vote option.
+func (c *Client) DeleteVoteOption(ctx context.Context, voteID string, optionID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/options/" + url.PathEscape(optionID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetMyBallot sends GET /v1/votes/{voteId}/ballot. Get my ballot.
+func (c *Client) GetMyBallot(ctx context.Context, voteID string) (*GetMyBallotResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/ballot",
+	}
+	var out GetMyBallotResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CastBallot sends POST /v1/votes/{voteId}/ballot. Cast ballot.
+//
+// Cast your vote. Ballots are immutable - once cast, they cannot be changed.
+// Format varies by vote type (FPTP, ranked choice, approval, multi-select).
+func (c *Client) CastBallot(ctx context.Context, voteID string, body *CastBallotRequest) (*CastBallotResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/ballot",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CastBallotResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVoteBallots sends GET /v1/votes/{voteId}/ballots. Get all ballots.
+//
+// Get all ballots for a vote (transparent ledger). Shows who voted for what
+// based on results_visibility setting.
+func (c *Client) GetVoteBallots(ctx context.Context, voteID string) (*GetVoteBallotsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/ballots",
+	}
+	var out GetVoteBallotsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVoteResults sends GET /v1/votes/{voteId}/results. Get vote results.
+//
+// Get computed results for the vote. Result format varies by vote type: -
+// FPTP: Winner and vote counts - Ranked Choice: Elimination rounds and final
+// winner - Approval: All options ranked by approval count - Multi-select: All
+// options ranked by selection count
+func (c *Client) GetVoteResults(ctx context.Context, voteID string) (*GetVoteResultsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/results",
+	}
+	var out GetVoteResultsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVoteStats sends GET /v1/votes/{voteId}/stats. Get vote statistics.
+func (c *Client) GetVoteStats(ctx context.Context, voteID string) (*GetVoteStatsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/votes/" + url.PathEscape(voteID) + "/stats",
+	}
+	var out GetVoteStatsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildVotesParams holds the query and header parameters of ListGuildVotes.
+type ListGuildVotesParams struct {
+	Cursor *string           // cursor query
+	Filter map[string]string // filter query
+	Limit  *int              // limit query
+	Offset *int              // offset query
+	Sort   *string           // sort query
+	Status *string           // status query
+}
+
+func (p *ListGuildVotesParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	for k, v := range p.Filter {
+		query.Set("filter["+k+"]", paramValue(v))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	if p.Sort != nil {
+		query.Set("sort", paramValue(*p.Sort))
+	}
+	if p.Status != nil {
+		query.Set("status", paramValue(*p.Status))
+	}
+	return query, header
+}
+
+// ListGuildVotes sends GET /v1/guilds/{guildId}/votes. List guild votes.
+func (c *Client) ListGuildVotes(ctx context.Context, guildID string, params *ListGuildVotesParams) (*ListGuildVotesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/votes",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListGuildVotesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGlobalVotesParams holds the query and header parameters of ListGlobalVotes.
+type ListGlobalVotesParams struct {
+	Cursor *string           // cursor query
+	Filter map[string]string // filter query
+	Limit  *int              // limit query
+	Offset *int              // offset query
+	Sort   *string           // sort query
+	Status *string           // status query
+}
+
+func (p *ListGlobalVotesParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	for k, v := range p.Filter {
+		query.Set("filter["+k+"]", paramValue(v))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	if p.Sort != nil {
+		query.Set("sort", paramValue(*p.Sort))
+	}
+	if p.Status != nil {
+		query.Set("status", paramValue(*p.Status))
+	}
+	return query, header
+}
+
+// ListGlobalVotes sends GET /v1/votes/global. List global votes.
+func (c *Client) ListGlobalVotes(ctx context.Context, params *ListGlobalVotesParams) (*ListGlobalVotesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/votes/global",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListGlobalVotesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAdventure sends POST /v1/adventures. Create adventure.
+//
+// Create a new adventure. Specify organizer_type to determine ownership: -
+// "guild": Adventure organized by a guild (requires guild membership) -
+// "user": Adventure organized by you personally
+func (c *Client) CreateAdventure(ctx context.Context, body *CreateAdventureRequest) (*CreateAdventureResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/adventures",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateAdventureResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAdventure sends GET /v1/adventures/{adventureId}. Get adventure details.
+func (c *Client) GetAdventure(ctx context.Context, adventureID string) (*GetAdventureResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID),
+	}
+	var out GetAdventureResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAdventure sends PATCH /v1/adventures/{adventureId}. Update adventure.
+func (c *Client) UpdateAdventure(ctx context.Context, adventureID string, body *UpdateAdventureRequest) (*UpdateAdventureResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateAdventureResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteAdventure sends DELETE /v1/adventures/{adventureId}. Cancel adventure.
+func (c *Client) DeleteAdventure(ctx context.Context, adventureID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// TransferAdventure sends POST /v1/adventures/{adventureId}/transfer. Transfer
+// adventure ownership.
+//
+// Transfer organizer role to another user. - For guild adventures: new
+// organizer must be a guild member - For user adventures: requires explicit
+// acceptance Note: organizer_type can NEVER change (no guild-to-user or
+// user-to-guild transfers)
+func (c *Client) TransferAdventure(ctx context.Context, adventureID string, body *TransferAdventureBody) (*TransferAdventureResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID) + "/transfer",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out TransferAdventureResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnfreezeAdventure sends POST /v1/adventures/{adventureId}/unfreeze. Unfreeze
+// adventure.
+//
+// Unfreeze a frozen adventure by assigning a new organizer. Only guild admins
+// can unfreeze guild adventures.
+func (c *Client) UnfreezeAdventure(ctx context.Context, adventureID string, body *UnfreezeAdventureBody) (*UnfreezeAdventureResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID) + "/unfreeze",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UnfreezeAdventureResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestAdventureAdmission sends POST
+// /v1/adventures/{adventureId}/admission/request. Request admission to
+// adventure.
+func (c *Client) RequestAdventureAdmission(ctx context.Context, adventureID string, body *RequestAdventureAdmissionBody) (*RequestAdventureAdmissionResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID) + "/admission/request",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RequestAdventureAdmissionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyAdventureAdmission sends GET /v1/adventures/{adventureId}/admission.
+// Get my admission status.
+func (c *Client) GetMyAdventureAdmission(ctx context.Context, adventureID string) (*GetMyAdventureAdmissionResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID) + "/admission",
+	}
+	var out GetMyAdventureAdmissionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// WithdrawAdventureAdmission sends DELETE
+// /v1/adventures/{adventureId}/admission. Withdraw admission request.
+func (c *Client) WithdrawAdventureAdmission(ctx context.Context, adventureID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID) + "/admission",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListAdventureAdmissionsParams holds the query and header parameters of ListAdventureAdmissions.
+type ListAdventureAdmissionsParams struct {
+	Status *string // status query
+}
+
+func (p *ListAdventureAdmissionsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Status != nil {
+		query.Set("status", paramValue(*p.Status))
+	}
+	return query, header
+}
+
+// ListAdventureAdmissions sends GET /v1/adventures/{adventureId}/admissions.
+// List admission requests.
+//
+// List all admission requests for the adventure (organizer only)
+func (c *Client) ListAdventureAdmissions(ctx context.Context, adventureID string, params *ListAdventureAdmissionsParams) (*ListAdventureAdmissionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID) + "/admissions",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListAdventureAdmissionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RespondToAdventureAdmission sends POST
+// /v1/adventures/{adventureId}/admissions/{userId}/respond. Respond to
+// admission request.
+//
+// Approve or reject an admission request (organizer only)
+func (c *Client) RespondToAdventureAdmission(ctx context.Context, adventureID string, userID string, body *RespondToAdventureAdmissionBody) (*RespondToAdventureAdmissionResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID) + "/admissions/" + url.PathEscape(userID) + "/respond",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RespondToAdventureAdmissionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// InviteToAdventure sends POST /v1/adventures/{adventureId}/admissions/invite.
+// Invite user to adventure.
+//
+// Invite a user to the adventure (auto-admits them)
+func (c *Client) InviteToAdventure(ctx context.Context, adventureID string, body *InviteToAdventureBody) (*InviteToAdventureResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/adventures/" + url.PathEscape(adventureID) + "/admissions/invite",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out InviteToAdventureResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildAdventuresParams holds the query and header parameters of ListGuildAdventures.
+type ListGuildAdventuresParams struct {
+	Limit  *int    // limit query
+	Offset *int    // offset query
+	Status *string // status query
+}
+
+func (p *ListGuildAdventuresParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	if p.Status != nil {
+		query.Set("status", paramValue(*p.Status))
+	}
+	return query, header
+}
+
+// ListGuildAdventures sends GET /v1/guilds/{guildId}/adventures. List guild
+// adventures.
+func (c *Client) ListGuildAdventures(ctx context.Context, guildID string, params *ListGuildAdventuresParams) (*ListGuildAdventuresResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/adventures",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListGuildAdventuresResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildAdventure sends POST /v1/guilds/{guildId}/adventures. Create
+// guild adventure.
+//
+// Create an adventure for the guild
+func (c *Client) CreateGuildAdventure(ctx context.Context, guildID string, body *CreateAdventureRequest) (*CreateGuildAdventureResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/adventures",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildAdventureResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMyAdventuresParams holds the query and header parameters of ListMyAdventures.
+type ListMyAdventuresParams struct {
+	Limit  *int    // limit query
+	Offset *int    // offset query
+	Role   *string // role query
+	Status *string // status query
+}
+
+func (p *ListMyAdventuresParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	if p.Role != nil {
+		query.Set("role", paramValue(*p.Role))
+	}
+	if p.Status != nil {
+		query.Set("status", paramValue(*p.Status))
+	}
+	return query, header
+}
+
+// ListMyAdventures sends GET /v1/users/me/adventures. List my adventures.
+//
+// List adventures I organize or am admitted to
+func (c *Client) ListMyAdventures(ctx context.Context, params *ListMyAdventuresParams) (*ListMyAdventuresResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/me/adventures",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListMyAdventuresResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateMyAdventure sends POST /v1/users/me/adventures. Create personal
+// adventure.
+//
+// Create an adventure organized by you personally
+func (c *Client) CreateMyAdventure(ctx context.Context, body *CreateAdventureRequest) (*CreateMyAdventureResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/users/me/adventures",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateMyAdventureResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateShareLink sends POST /v1/share-links. Create share link.
+//
+// Create a short, signed link to an event, guild or adventure. You must be
+// able to see the target. Invite links let whoever accepts them join the guild
+// or adventure and can only be created by guild admins and adventure
+// organizers.
+func (c *Client) CreateShareLink(ctx context.Context, body *CreateShareLinkRequest) (*CreateShareLinkResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/share-links",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateShareLinkResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResolveShareLink sends GET /v1/share-links/{token}. Resolve share link.
+//
+// Resolve a share token to its target and an unfurl preview. Does not require
+// authentication; previews of non-public targets are restricted to the title.
+func (c *Client) ResolveShareLink(ctx context.Context, token string) (*ResolveShareLinkResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/share-links/" + url.PathEscape(token),
+	}
+	var out ResolveShareLinkResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeShareLink sends DELETE /v1/share-links/{token}. Revoke share link.
+//
+// Revoke a share link you created
+func (c *Client) RevokeShareLink(ctx context.Context, token string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/share-links/" + url.PathEscape(token),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// AcceptShareLink sends POST /v1/share-links/{token}/accept. Accept invite
+// link.
+//
+// Join the guild or adventure behind an invite link. A use is only counted
+// when the join succeeds.
+func (c *Client) AcceptShareLink(ctx context.Context, token string) (*AcceptShareLinkResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/share-links/" + url.PathEscape(token) + "/accept",
+	}
+	var out AcceptShareLinkResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetShareLinkPreview sends GET /v1/preview/{token}. Get share link unfurl
+// metadata.
+//
+// OpenGraph fields and schema.org structured data for a share link, for
+// clients that build their own link previews. Previews of non-public targets
+// are redacted.
+func (c *Client) GetShareLinkPreview(ctx context.Context, token string) (*GetShareLinkPreviewResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/preview/" + url.PathEscape(token),
+	}
+	var out GetShareLinkPreviewResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSharePage sends GET /s/{token}. Share link page.
+//
+// Minimal HTML page with OpenGraph and Twitter meta tags and JSON-LD for the
+// share link, served to crawlers that unfurl links. Dead links still render a
+// generic page with a 404 or 410 status.
+func (c *Client) GetSharePage(ctx context.Context, token string) ([]byte, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/s/" + url.PathEscape(token),
+	}
+	return c.doRaw(ctx, req)
+}
+
+// PollNotificationsParams holds the query and header parameters of PollNotifications.
+type PollNotificationsParams struct {
+	Since *string // since query
+}
+
+func (p *PollNotificationsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Since != nil {
+		query.Set("since", paramValue(*p.Since))
+	}
+	return query, header
+}
+
+// PollNotifications sends GET /v1/notifications/poll. Poll for notifications.
+//
+// Long-poll fallback for clients, such as embedded webviews, that can't keep
+// the SSE stream open. Returns as soon as there are notifications after since,
+// or with none once the poll has waited 25 seconds. Pass the returned cursor
+// as since on the next poll. Notifications are kept for 10 minutes, up to 100
+// per user; truncated is set when some after since were discarded, and the
+// client should refetch what it shows.
+func (c *Client) PollNotifications(ctx context.Context, params *PollNotificationsParams) (*PollNotificationsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/notifications/poll",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out PollNotificationsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMutes sends GET /v1/profile/mutes. List notification mutes.
+//
+// The user's unexpired mutes, newest first.
+func (c *Client) ListMutes(ctx context.Context) (*ListMutesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/mutes",
+	}
+	var out ListMutesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateMute sends POST /v1/profile/mutes. Mute a guild or event category.
+//
+// Stops a guild's new event, vote and announcement notifications, or new event
+// notifications of one category in every guild, in-app and push, without
+// leaving anything. Muting something already muted replaces that mute. A user
+// can have up to 100 mutes.
+func (c *Client) CreateMute(ctx context.Context, body *CreateMuteRequest) (*CreateMuteResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/profile/mutes",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateMuteResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteMute sends DELETE /v1/profile/mutes/{muteId}. Unmute.
+func (c *Client) DeleteMute(ctx context.Context, muteID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/profile/mutes/" + url.PathEscape(muteID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetEventReminderPreference sends GET /v1/profile/event-reminders. Get own
+// event reminder preferences.
+//
+// Whether reminders are on and how many minutes before an event they are sent.
+// Users who never changed them get reminders at the server defaults, 24 hours
+// and 1 hour before by default.
+func (c *Client) GetEventReminderPreference(ctx context.Context) (*GetEventReminderPreferenceResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/event-reminders",
+	}
+	var out GetEventReminderPreferenceResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateEventReminderPreference sends PATCH /v1/profile/event-reminders.
+// Update own event reminder preferences.
+//
+// Omitted fields are unchanged. An empty offsets_minutes goes back to the
+// server defaults.
+func (c *Client) UpdateEventReminderPreference(ctx context.Context, body *UpdateEventReminderPreferenceRequest) (*UpdateEventReminderPreferenceResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/profile/event-reminders",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateEventReminderPreferenceResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAnnouncementInbox sends GET /v1/announcements. Get announcement inbox.
+//
+// Announcements delivered to the authenticated user, newest first, with the
+// unread count.
+func (c *Client) GetAnnouncementInbox(ctx context.Context) (*GetAnnouncementInboxResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/announcements",
+	}
+	var out GetAnnouncementInboxResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MarkAnnouncementRead sends POST /v1/announcements/{announcementId}/read.
+// Mark announcement read.
+func (c *Client) MarkAnnouncementRead(ctx context.Context, announcementID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/announcements/" + url.PathEscape(announcementID) + "/read",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ReactToAnnouncement sends PUT /v1/announcements/{announcementId}/reactions.
+// React to an announcement.
+//
+// Sets the user's reaction, replacing the one they had. Reactions are limited
+// to 30 a minute per user.
+func (c *Client) ReactToAnnouncement(ctx context.Context, announcementID string, body *SetReactionRequest) (*ReactToAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/announcements/" + url.PathEscape(announcementID) + "/reactions",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out ReactToAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnreactToAnnouncement sends DELETE
+// /v1/announcements/{announcementId}/reactions. Remove reaction from an
+// announcement.
+func (c *Client) UnreactToAnnouncement(ctx context.Context, announcementID string) (*UnreactToAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/announcements/" + url.PathEscape(announcementID) + "/reactions",
+	}
+	var out UnreactToAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildAnnouncements sends GET /v1/guilds/{guildId}/announcements. List
+// guild announcements.
+//
+// Guild admins see drafts and scheduled announcements; members only see sent
+// ones.
+func (c *Client) ListGuildAnnouncements(ctx context.Context, guildID string) (*ListGuildAnnouncementsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/announcements",
+	}
+	var out ListGuildAnnouncementsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildAnnouncement sends POST /v1/guilds/{guildId}/announcements.
+// Create guild announcement.
+//
+// Compose an announcement to the guild's members (guild admins only).
+func (c *Client) CreateGuildAnnouncement(ctx context.Context, guildID string, body *CreateAnnouncementRequest) (*CreateGuildAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/announcements",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildAnnouncement sends GET
+// /v1/guilds/{guildId}/announcements/{announcementId}. Get guild announcement.
+func (c *Client) GetGuildAnnouncement(ctx context.Context, guildID string, announcementID string) (*GetGuildAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/announcements/" + url.PathEscape(announcementID),
+	}
+	var out GetGuildAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuildAnnouncement sends PATCH
+// /v1/guilds/{guildId}/announcements/{announcementId}. Update guild
+// announcement.
+func (c *Client) UpdateGuildAnnouncement(ctx context.Context, guildID string, announcementID string, body *UpdateAnnouncementRequest) (*UpdateGuildAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/announcements/" + url.PathEscape(announcementID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateGuildAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuildAnnouncement sends DELETE
+// /v1/guilds/{guildId}/announcements/{announcementId}. Delete guild
+// announcement.
+func (c *Client) DeleteGuildAnnouncement(ctx context.Context, guildID string, announcementID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/announcements/" + url.PathEscape(announcementID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// SendGuildAnnouncement sends POST
+// /v1/guilds/{guildId}/announcements/{announcementId}/send. Send guild
+// announcement now.
+func (c *Client) SendGuildAnnouncement(ctx context.Context, guildID string, announcementID string) (*SendGuildAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/announcements/" + url.PathEscape(announcementID) + "/send",
+	}
+	var out SendGuildAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAdminAnnouncements sends GET /v1/admin/announcements. List
+// announcements.
+func (c *Client) ListAdminAnnouncements(ctx context.Context) (*ListAdminAnnouncementsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/announcements",
+	}
+	var out ListAdminAnnouncementsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAdminAnnouncement sends POST /v1/admin/announcements. Create platform
+// announcement.
+//
+// Compose an announcement to all users, a guild or a geographic area. Saved as
+// a draft unless send_at is given.
+func (c *Client) CreateAdminAnnouncement(ctx context.Context, body *CreateAnnouncementRequest) (*CreateAdminAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/announcements",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateAdminAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAdminAnnouncement sends GET /v1/admin/announcements/{announcementId}. Get
+// announcement.
+//
+// Includes recipient and read counts.
+func (c *Client) GetAdminAnnouncement(ctx context.Context, announcementID string) (*GetAdminAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/announcements/" + url.PathEscape(announcementID),
+	}
+	var out GetAdminAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAdminAnnouncement sends PATCH
+// /v1/admin/announcements/{announcementId}. Update announcement.
+func (c *Client) UpdateAdminAnnouncement(ctx context.Context, announcementID string, body *UpdateAnnouncementRequest) (*UpdateAdminAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/admin/announcements/" + url.PathEscape(announcementID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateAdminAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteAdminAnnouncement sends DELETE
+// /v1/admin/announcements/{announcementId}. Delete announcement.
+//
+// Deleting a sent announcement also removes it from inboxes.
+func (c *Client) DeleteAdminAnnouncement(ctx context.Context, announcementID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/announcements/" + url.PathEscape(announcementID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// SendAdminAnnouncement sends POST
+// /v1/admin/announcements/{announcementId}/send. Send announcement now.
+//
+// Schedules the announcement for immediate delivery by the background sender.
+func (c *Client) SendAdminAnnouncement(ctx context.Context, announcementID string) (*SendAdminAnnouncementResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/announcements/" + url.PathEscape(announcementID) + "/send",
+	}
+	var out SendAdminAnnouncementResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDraftsParams holds the query and header parameters of ListDrafts.
+type ListDraftsParams struct {
+	ResourceType *string // resource_type query
+}
+
+func (p *ListDraftsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.ResourceType != nil {
+		query.Set("resource_type", paramValue(*p.ResourceType))
+	}
+	return query, header
+}
+
+// ListDrafts sends GET /v1/drafts. List drafts.
+//
+// The user's unexpired drafts, most recently saved first.
+func (c *Client) ListDrafts(ctx context.Context, params *ListDraftsParams) (*ListDraftsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/drafts",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListDraftsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateDraft sends POST /v1/drafts. Start a draft.
+//
+// Save an unfinished event or adventure create form. Users can keep up to 25
+// drafts.
+func (c *Client) CreateDraft(ctx context.Context, body *SaveDraftRequest) (*CreateDraftResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/drafts",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateDraftResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDraft sends GET /v1/drafts/{draftId}. Resume a draft.
+func (c *Client) GetDraft(ctx context.Context, draftID string) (*GetDraftResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/drafts/" + url.PathEscape(draftID),
+	}
+	var out GetDraftResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateDraft sends PUT /v1/drafts/{draftId}. Autosave a draft.
+//
+// Replaces the draft's data, clears errors from the last submit and pushes the
+// expiry back 30 days.
+func (c *Client) UpdateDraft(ctx context.Context, draftID string, body *UpdateDraftRequest) (*UpdateDraftResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/drafts/" + url.PathEscape(draftID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateDraftResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteDraft sends DELETE /v1/drafts/{draftId}. Discard a draft.
+func (c *Client) DeleteDraft(ctx context.Context, draftID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/drafts/" + url.PathEscape(draftID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// SubmitDraft sends POST /v1/drafts/{draftId}/submit. Submit a draft.
+//
+// Creates the event or adventure the draft describes, exactly as if its data
+// were posted to the create endpoint, and discards the draft. If the data
+// fails validation the draft is kept and the field errors, keyed by data
+// field, are returned and saved on the draft.
+func (c *Client) SubmitDraft(ctx context.Context, draftID string) (*SubmitDraftResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/drafts/" + url.PathEscape(draftID) + "/submit",
+	}
+	var out SubmitDraftResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UndoOperation sends POST /v1/undo/{operationId}. Undo a destructive action.
+//
+// Reverses a recent availability deletion, guild leave or RSVP cancellation
+// while its undo window is open. Deleting an availability, leaving a guild and
+// cancelling an RSVP return the operation to post here. Each operation can be
+// used once.
+func (c *Client) UndoOperation(ctx context.Context, operationID string) (*UndoOperationResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/undo/" + url.PathEscape(operationID),
+	}
+	var out UndoOperationResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildTrash sends GET /v1/guilds/{guildId}/trash. List a guild's trash.
+//
+// Deleted events, pools and votes of the guild, most recently deleted first
+// (organizers only). Items are permanently deleted at purge_at, 30 days after
+// they were deleted.
+func (c *Client) ListGuildTrash(ctx context.Context, guildID string) (*ListGuildTrashResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/trash",
+	}
+	var out ListGuildTrashResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreGuildTrashItem sends POST
+// /v1/guilds/{guildId}/trash/{itemId}/restore. Restore an item from a guild's
+// trash.
+//
+// Restores a deleted event, pool or vote (organizers only). A pool is only
+// restored while the guild is under its pool limit.
+func (c *Client) RestoreGuildTrashItem(ctx context.Context, guildID string, itemID string) (*RestoreGuildTrashItemResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/trash/" + url.PathEscape(itemID) + "/restore",
+	}
+	var out RestoreGuildTrashItemResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListConsents sends GET /v1/consents. List consents.
+//
+// The current consent text and the user's decision for every purpose. Purposes
+// the user has never decided on are not granted.
+func (c *Client) ListConsents(ctx context.Context) (*ListConsentsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/consents",
+	}
+	var out ListConsentsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListConsentHistory sends GET /v1/consents/history. Consent history.
+//
+// Every grant and withdrawal the user has made, newest first, with the version
+// of the text each applied to.
+func (c *Client) ListConsentHistory(ctx context.Context) (*ListConsentHistoryResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/consents/history",
+	}
+	var out ListConsentHistoryResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GrantConsent sends PUT /v1/consents/{purpose}. Grant consent.
+//
+// Consent to the version of the purpose's text the user was shown. Granting
+// the same version again is a no-op.
+func (c *Client) GrantConsent(ctx context.Context, purpose string, body *GrantConsentBody) (*GrantConsentResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/consents/" + url.PathEscape(purpose),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out GrantConsentResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// WithdrawConsent sends DELETE /v1/consents/{purpose}. Withdraw consent.
+//
+// Withdraw consent for a purpose, taking effect immediately. Withdrawing
+// consent that isn't granted is a no-op.
+func (c *Client) WithdrawConsent(ctx context.Context, purpose string) (*WithdrawConsentResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/consents/" + url.PathEscape(purpose),
+	}
+	var out WithdrawConsentResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLegalHold sends GET /v1/admin/users/{userId}/legal-hold. Get legal hold.
+func (c *Client) GetLegalHold(ctx context.Context, userID string) (*GetLegalHoldResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/legal-hold",
+	}
+	var out GetLegalHoldResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PlaceLegalHold sends PUT /v1/admin/users/{userId}/legal-hold. Place legal
+// hold.
+//
+// Preserve the user's data for an investigation. While held, the account can't
+// be hard deleted, purge jobs (undo cleanup, draft cleanup, trash purge) skip
+// the user's data, and every request naming the user in its path is written to
+// the access log.
+func (c *Client) PlaceLegalHold(ctx context.Context, userID string, body *PlaceLegalHoldBody) (*PlaceLegalHoldResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/legal-hold",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PlaceLegalHoldResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReleaseLegalHold sends DELETE /v1/admin/users/{userId}/legal-hold. Release
+// legal hold.
+//
+// Held data becomes eligible for the next purge run. The access log is kept.
+func (c *Client) ReleaseLegalHold(ctx context.Context, userID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/legal-hold",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetLegalHoldAccessLogParams holds the query and header parameters of GetLegalHoldAccessLog.
+type GetLegalHoldAccessLogParams struct {
+	Limit *int // limit query
+}
+
+func (p *GetLegalHoldAccessLogParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// GetLegalHoldAccessLog sends GET
+// /v1/admin/users/{userId}/legal-hold/access-log. Legal hold access log.
+//
+// Every access to the user's data while they were held, plus hold placements
+// and releases, newest first.
+func (c *Client) GetLegalHoldAccessLog(ctx context.Context, userID string, params *GetLegalHoldAccessLogParams) (*GetLegalHoldAccessLogResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/legal-hold/access-log",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetLegalHoldAccessLogResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSecurityEventsParams holds the query and header parameters of ListSecurityEvents.
+type ListSecurityEventsParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListSecurityEventsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListSecurityEvents sends GET /v1/admin/users/{userId}/security-events. User
+// security log.
+//
+// Password and email changes and passkey account recoveries for a user, newest
+// first.
+func (c *Client) ListSecurityEvents(ctx context.Context, userID string, params *ListSecurityEventsParams) (*ListSecurityEventsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/security-events",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListSecurityEventsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSignupReviewsParams holds the query and header parameters of ListSignupReviews.
+type ListSignupReviewsParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListSignupReviewsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListSignupReviews sends GET /v1/admin/signup-reviews. Flagged signups.
+//
+// Accounts flagged at signup that no admin has reviewed, riskiest first.
+func (c *Client) ListSignupReviews(ctx context.Context, params *ListSignupReviewsParams) (*ListSignupReviewsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/signup-reviews",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListSignupReviewsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReviewSignup sends POST /v1/admin/users/{userId}/signup-review. Clear a
+// flagged signup.
+//
+// Record that an admin found the account genuine. It leaves the review queue
+// and no longer needs to verify its email before making changes.
+func (c *Client) ReviewSignup(ctx context.Context, userID string) (*ReviewSignupResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/signup-review",
+	}
+	var out ReviewSignupResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListScraperDetectionsParams holds the query and header parameters of ListScraperDetections.
+type ListScraperDetectionsParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListScraperDetectionsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListScraperDetections sends GET /v1/admin/scraper-detections. Scraper
+// detections.
+//
+// Honeypot hits, tarpittings and blocks, newest first.
+func (c *Client) ListScraperDetections(ctx context.Context, params *ListScraperDetectionsParams) (*ListScraperDetectionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/scraper-detections",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListScraperDetectionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTarpitClients sends GET /v1/admin/tarpit. Tarpitted clients.
+//
+// Clients being slowed down or blocked right now, blocked ones first.
+func (c *Client) ListTarpitClients(ctx context.Context) (*ListTarpitClientsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/tarpit",
+	}
+	var out ListTarpitClientsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReleaseTarpitClient sends DELETE /v1/admin/tarpit/{ip}. Release a tarpitted
+// client.
+//
+// Clear a client's strikes and lift any block, for clients caught by mistake.
+func (c *Client) ReleaseTarpitClient(ctx context.Context, ip string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/tarpit/" + url.PathEscape(ip),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetNetworkPolicy sends GET /v1/admin/network. Network policy.
+//
+// The admin allowlist and the deny list entries in force.
+func (c *Client) GetNetworkPolicy(ctx context.Context) (*GetNetworkPolicyResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/network",
+	}
+	var out GetNetworkPolicyResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateIPDeny sends POST /v1/admin/network/deny. Deny an address or range.
+//
+// Turn an address or CIDR range away from the whole API, until removed or for
+// duration_minutes. A range holding the address of the request is refused, so
+// admins can't lock themselves out.
+func (c *Client) CreateIPDeny(ctx context.Context, body *CreateIPDenyRequest) (*CreateIPDenyResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/network/deny",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateIPDenyResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteIPDeny sends DELETE /v1/admin/network/deny/{entryId}. Remove a deny
+// list entry.
+func (c *Client) DeleteIPDeny(ctx context.Context, entryID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/network/deny/" + url.PathEscape(entryID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListBlockedAdminAttemptsParams holds the query and header parameters of ListBlockedAdminAttempts.
+type ListBlockedAdminAttemptsParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListBlockedAdminAttemptsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListBlockedAdminAttempts sends GET /v1/admin/network/blocked-attempts.
+// Blocked admin requests.
+//
+// Requests to admin routes the network policy turned away, newest first.
+func (c *Client) ListBlockedAdminAttempts(ctx context.Context, params *ListBlockedAdminAttemptsParams) (*ListBlockedAdminAttemptsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/network/blocked-attempts",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListBlockedAdminAttemptsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListClientErrorsParams holds the query and header parameters of ListClientErrors.
+type ListClientErrorsParams struct {
+	AppVersion  *string // app_version query
+	Days        *int    // days query
+	Fingerprint *string // fingerprint query
+	Kind        *string // kind query
+	Limit       *int    // limit query
+	Platform    *string // platform query
+}
+
+func (p *ListClientErrorsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.AppVersion != nil {
+		query.Set("app_version", paramValue(*p.AppVersion))
+	}
+	if p.Days != nil {
+		query.Set("days", paramValue(*p.Days))
+	}
+	if p.Fingerprint != nil {
+		query.Set("fingerprint", paramValue(*p.Fingerprint))
+	}
+	if p.Kind != nil {
+		query.Set("kind", paramValue(*p.Kind))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Platform != nil {
+		query.Set("platform", paramValue(*p.Platform))
+	}
+	return query, header
+}
+
+// ListClientErrors sends GET /v1/admin/client-errors. List client errors.
+//
+// Stored client error reports from the last `days` days, newest first.
+func (c *Client) ListClientErrors(ctx context.Context, params *ListClientErrorsParams) (*ListClientErrorsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/client-errors",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListClientErrorsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SummarizeClientErrorsParams holds the query and header parameters of SummarizeClientErrors.
+type SummarizeClientErrorsParams struct {
+	AppVersion  *string // app_version query
+	Days        *int    // days query
+	Fingerprint *string // fingerprint query
+	Kind        *string // kind query
+	Limit       *int    // limit query
+	Platform    *string // platform query
+}
+
+func (p *SummarizeClientErrorsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.AppVersion != nil {
+		query.Set("app_version", paramValue(*p.AppVersion))
+	}
+	if p.Days != nil {
+		query.Set("days", paramValue(*p.Days))
+	}
+	if p.Fingerprint != nil {
+		query.Set("fingerprint", paramValue(*p.Fingerprint))
+	}
+	if p.Kind != nil {
+		query.Set("kind", paramValue(*p.Kind))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Platform != nil {
+		query.Set("platform", paramValue(*p.Platform))
+	}
+	return query, header
+}
+
+// SummarizeClientErrors sends GET /v1/admin/client-errors/summary. Summarize
+// client errors.
+//
+// Stored client error reports from the last `days` days, grouped by
+// fingerprint and most reported first. Counts are of stored reports, so with
+// sampling they compare errors rather than measure them.
+func (c *Client) SummarizeClientErrors(ctx context.Context, params *SummarizeClientErrorsParams) (*SummarizeClientErrorsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/client-errors/summary",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out SummarizeClientErrorsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminListHangoutTypes sends GET /v1/admin/hangout-types. List platform
+// hangout types.
+//
+// Every platform type in display order, including inactive ones.
+func (c *Client) AdminListHangoutTypes(ctx context.Context) (*AdminListHangoutTypesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/hangout-types",
+	}
+	var out AdminListHangoutTypesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminCreateHangoutType sends POST /v1/admin/hangout-types. Create a platform
+// hangout type.
+//
+// Adds a type everyone can use for availability and discovery.
+func (c *Client) AdminCreateHangoutType(ctx context.Context, body *CreateHangoutTypeRequest) (*AdminCreateHangoutTypeResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/hangout-types",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminCreateHangoutTypeResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminUpdateHangoutType sends PATCH /v1/admin/hangout-types/{type}. Update a
+// platform hangout type.
+//
+// Changes a type's label, description, icon, order or whether it's offered.
+// Built-in types can be relabeled but not deactivated.
+func (c *Client) AdminUpdateHangoutType(ctx context.Context, typeArg string, body *UpdateHangoutTypeRequest) (*AdminUpdateHangoutTypeResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/admin/hangout-types/" + url.PathEscape(typeArg),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminUpdateHangoutTypeResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminDeleteHangoutType sends DELETE /v1/admin/hangout-types/{type}. Delete a
+// platform hangout type.
+//
+// Removes a type from the catalog. Availability already posted with it keeps
+// its type. Built-in types can't be deleted.
+func (c *Client) AdminDeleteHangoutType(ctx context.Context, typeArg string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/hangout-types/" + url.PathEscape(typeArg),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListQuotaUsageParams holds the query and header parameters of ListQuotaUsage.
+type ListQuotaUsageParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListQuotaUsageParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListQuotaUsage sends GET /v1/admin/quotas. Top quota consumers.
+//
+// Users who have spent the most request cost today, highest first.
+func (c *Client) ListQuotaUsage(ctx context.Context, params *ListQuotaUsageParams) (*ListQuotaUsageResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/quotas",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListQuotaUsageResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserQuota sends GET /v1/admin/users/{userId}/quota. Get user quota.
+func (c *Client) GetUserQuota(ctx context.Context, userID string) (*GetUserQuotaResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/quota",
+	}
+	var out GetUserQuotaResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetUserQuota sends PUT /v1/admin/users/{userId}/quota. Set user quota.
+//
+// Override the user's daily limit. Overrides are stored and survive restarts.
+func (c *Client) SetUserQuota(ctx context.Context, userID string, body *SetUserQuotaBody) (*SetUserQuotaResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/quota",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out SetUserQuotaResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ClearUserQuota sends DELETE /v1/admin/users/{userId}/quota. Clear user
+// quota.
+//
+// Put the user back on the default daily limit.
+func (c *Client) ClearUserQuota(ctx context.Context, userID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/quota",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ResetUserQuotaUsage sends POST /v1/admin/users/{userId}/quota/reset. Reset
+// user quota usage.
+//
+// Forgive the request cost the user has spent today.
+func (c *Client) ResetUserQuotaUsage(ctx context.Context, userID string) (*ResetUserQuotaUsageResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/users/" + url.PathEscape(userID) + "/quota/reset",
+	}
+	var out ResetUserQuotaUsageResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminListQuestionsParams holds the query and header parameters of AdminListQuestions.
+type AdminListQuestionsParams struct {
+	IncludeInactive *bool // include_inactive query
+}
+
+func (p *AdminListQuestionsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.IncludeInactive != nil {
+		query.Set("include_inactive", paramValue(*p.IncludeInactive))
+	}
+	return query, header
+}
+
+// AdminListQuestions sends GET /v1/admin/questions. List global questions.
+//
+// Every global question in display order with option bias weights and answer
+// counts.
+func (c *Client) AdminListQuestions(ctx context.Context, params *AdminListQuestionsParams) (*AdminListQuestionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/questions",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out AdminListQuestionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminCreateQuestion sends POST /v1/admin/questions. Create a global
+// question.
+func (c *Client) AdminCreateQuestion(ctx context.Context, body *CreateQuestionRequest) (*AdminCreateQuestionResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/questions",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminCreateQuestionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminGetQuestion sends GET /v1/admin/questions/{questionId}. Get a global
+// question.
+func (c *Client) AdminGetQuestion(ctx context.Context, questionID string) (*AdminGetQuestionResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/questions/" + url.PathEscape(questionID),
+	}
+	var out AdminGetQuestionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminUpdateQuestion sends PATCH /v1/admin/questions/{questionId}. Update a
+// global question.
+//
+// Omitted fields are unchanged. Options can be reworded, reweighted or added,
+// but not removed once the question has answers. Set active to restore a
+// deprecated question.
+func (c *Client) AdminUpdateQuestion(ctx context.Context, questionID string, body *UpdateQuestionRequest) (*AdminUpdateQuestionResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/admin/questions/" + url.PathEscape(questionID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminUpdateQuestionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminDeleteQuestion sends DELETE /v1/admin/questions/{questionId}. Remove a
+// global question.
+//
+// Deletes the question, or deprecates it when it has answers.
+func (c *Client) AdminDeleteQuestion(ctx context.Context, questionID string) (*AdminDeleteQuestionResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/questions/" + url.PathEscape(questionID),
+	}
+	var out AdminDeleteQuestionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminListQuestionCategories sends GET /v1/admin/question-categories. List
+// question categories.
+//
+// Every category in display order, including deprecated ones.
+func (c *Client) AdminListQuestionCategories(ctx context.Context) (*AdminListQuestionCategoriesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/question-categories",
+	}
+	var out AdminListQuestionCategoriesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminCreateQuestionCategory sends POST /v1/admin/question-categories. Create
+// a question category.
+func (c *Client) AdminCreateQuestionCategory(ctx context.Context, body *CreateQuestionCategoryRequest) (*AdminCreateQuestionCategoryResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/question-categories",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminCreateQuestionCategoryResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminUpdateQuestionCategory sends PATCH /v1/admin/question-categories/{key}.
+// Update a question category.
+//
+// Omitted fields are unchanged. Set active to restore a deprecated category.
+func (c *Client) AdminUpdateQuestionCategory(ctx context.Context, key string, body *UpdateQuestionCategoryRequest) (*AdminUpdateQuestionCategoryResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/admin/question-categories/" + url.PathEscape(key),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminUpdateQuestionCategoryResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminDeleteQuestionCategory sends DELETE
+// /v1/admin/question-categories/{key}. Remove a question category.
+//
+// Deletes the category, or deprecates it while it still contains questions.
+func (c *Client) AdminDeleteQuestionCategory(ctx context.Context, key string) (*AdminDeleteQuestionCategoryResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/question-categories/" + url.PathEscape(key),
+	}
+	var out AdminDeleteQuestionCategoryResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminListReviewTags sends GET /v1/admin/review-tags/{kind}. List review
+// tags.
+//
+// Every tag in the catalog in display order, including deprecated ones.
+func (c *Client) AdminListReviewTags(ctx context.Context, kind string) (*AdminListReviewTagsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/review-tags/" + url.PathEscape(kind),
+	}
+	var out AdminListReviewTagsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminCreateReviewTag sends POST /v1/admin/review-tags/{kind}. Create a
+// review tag.
+func (c *Client) AdminCreateReviewTag(ctx context.Context, kind string, body *CreateReviewTagRequest) (*AdminCreateReviewTagResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/review-tags/" + url.PathEscape(kind),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminCreateReviewTagResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminUpdateReviewTag sends PATCH /v1/admin/review-tags/{kind}/{tag}. Update
+// a review tag.
+//
+// Omitted fields are unchanged. Set active to restore a deprecated tag.
+func (c *Client) AdminUpdateReviewTag(ctx context.Context, kind string, tag string, body *UpdateReviewTagRequest) (*AdminUpdateReviewTagResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/admin/review-tags/" + url.PathEscape(kind) + "/" + url.PathEscape(tag),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminUpdateReviewTagResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminDeleteReviewTag sends DELETE /v1/admin/review-tags/{kind}/{tag}. Remove
+// a review tag.
+//
+// Deletes the tag, or deprecates it when existing reviews use it.
+func (c *Client) AdminDeleteReviewTag(ctx context.Context, kind string, tag string) (*AdminDeleteReviewTagResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/review-tags/" + url.PathEscape(kind) + "/" + url.PathEscape(tag),
+	}
+	var out AdminDeleteReviewTagResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRemoteConfig sends GET /v1/admin/remote-config. List remote config
+// values.
+//
+// Every admin-set value, by key and then platform. Built-in defaults are not
+// listed.
+func (c *Client) ListRemoteConfig(ctx context.Context) (*ListRemoteConfigResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/remote-config",
+	}
+	var out ListRemoteConfigResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetRemoteConfig sends PUT /v1/admin/remote-config/{key}. Set remote config
+// value.
+//
+// Set a tunable for every platform, or override it for one platform. Clients
+// see the change on their next revalidation; other instances within five
+// minutes.
+func (c *Client) SetRemoteConfig(ctx context.Context, key string, body *SetRemoteConfigBody) (*SetRemoteConfigResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/admin/remote-config/" + url.PathEscape(key),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out SetRemoteConfigResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRemoteConfigParams holds the query and header parameters of DeleteRemoteConfig.
+type DeleteRemoteConfigParams struct {
+	Platform *string // platform query
+}
+
+func (p *DeleteRemoteConfigParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Platform != nil {
+		query.Set("platform", paramValue(*p.Platform))
+	}
+	return query, header
+}
+
+// DeleteRemoteConfig sends DELETE /v1/admin/remote-config/{key}. Delete remote
+// config value.
+//
+// Remove a platform's override, or the value for every platform when no
+// platform is given. The built-in default, if any, applies again.
+func (c *Client) DeleteRemoteConfig(ctx context.Context, key string, params *DeleteRemoteConfigParams) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/remote-config/" + url.PathEscape(key),
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListExperimentsParams holds the query and header parameters of ListExperiments.
+type ListExperimentsParams struct {
+	Status *string // status query
+}
+
+func (p *ListExperimentsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Status != nil {
+		query.Set("status", paramValue(*p.Status))
+	}
+	return query, header
+}
+
+// ListExperiments sends GET /v1/admin/experiments. List experiments.
+//
+// Experiments, newest first.
+func (c *Client) ListExperiments(ctx context.Context, params *ListExperimentsParams) (*ListExperimentsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/experiments",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListExperimentsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateExperiment sends POST /v1/admin/experiments. Create experiment.
+//
+// Create a draft experiment with a fresh salt. Variant params may not touch
+// the `auth.`, `security.`, `dpop.`, `privacy.`, `moderation.`, `safety.` or
+// `payments.` namespaces, so experiments can never vary security-relevant
+// behavior. Variants can't be changed afterwards.
+func (c *Client) CreateExperiment(ctx context.Context, body *CreateExperimentBody) (*CreateExperimentResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/experiments",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateExperimentResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetExperiment sends GET /v1/admin/experiments/{key}. Get experiment.
+func (c *Client) GetExperiment(ctx context.Context, key string) (*GetExperimentResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/experiments/" + url.PathEscape(key),
+	}
+	var out GetExperimentResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateExperiment sends PATCH /v1/admin/experiments/{key}. Update experiment.
+//
+// Edit the description, or start or stop the experiment. Experiments go from
+// draft to running to stopped and can't be restarted; other instances pick up
+// the change within a minute.
+func (c *Client) UpdateExperiment(ctx context.Context, key string, body *UpdateExperimentBody) (*UpdateExperimentResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/admin/experiments/" + url.PathEscape(key),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateExperimentResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReceivePaymentWebhookParams holds the query and header parameters of ReceivePaymentWebhook.
+type ReceivePaymentWebhookParams struct {
+	StripeSignature string // Stripe-Signature header
+}
+
+func (p *ReceivePaymentWebhookParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.StripeSignature != "" {
+		header.Set("Stripe-Signature", p.StripeSignature)
+	}
+	return query, header
+}
+
+// ReceivePaymentWebhook sends POST /v1/payments/webhook. Receive a Stripe
+// webhook.
+//
+// Stripe's event deliveries for checkout sessions and payout accounts.
+// Requests are authenticated by their `Stripe-Signature` header rather than a
+// token, and events from the other mode (live in the sandbox, or test outside
+// it) are refused. Each event is safe to deliver twice. Returns 404 when
+// payments aren't configured.
+func (c *Client) ReceivePaymentWebhook(ctx context.Context, params *ReceivePaymentWebhookParams, body map[string]any) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/payments/webhook",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListAdminReportsParams holds the query and header parameters of ListAdminReports.
+type ListAdminReportsParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListAdminReportsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListAdminReports sends GET /v1/admin/reports. List admin reports.
+//
+// The most recent reports, newest first.
+func (c *Client) ListAdminReports(ctx context.Context, params *ListAdminReportsParams) (*ListAdminReportsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/reports",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListAdminReportsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAdminReport sends POST /v1/admin/reports. Request admin report.
+//
+// Queue a long report for background generation. Poll the report until its
+// status is `completed`, then download it from `download_url`. Reports are
+// written as CSV to object storage and deleted when they expire. Returns 404
+// when object storage isn't configured.
+func (c *Client) CreateAdminReport(ctx context.Context, body *CreateAdminReportBody) (*CreateAdminReportResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/reports",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateAdminReportResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAdminReport sends GET /v1/admin/reports/{reportId}. Get admin report.
+//
+// Completed reports include a signed `download_url` that is valid for a short
+// time; fetch the report again for a fresh one.
+func (c *Client) GetAdminReport(ctx context.Context, reportID string) (*GetAdminReportResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/reports/" + url.PathEscape(reportID),
+	}
+	var out GetAdminReportResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUserImportsParams holds the query and header parameters of ListUserImports.
+type ListUserImportsParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListUserImportsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListUserImports sends GET /v1/admin/import/users. List user imports.
+//
+// The most recent imports, newest first, without their rows.
+func (c *Client) ListUserImports(ctx context.Context, params *ListUserImportsParams) (*ListUserImportsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/import/users",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListUserImportsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateUserImport sends POST /v1/admin/import/users. Import users from CSV.
+//
+// Upload a CSV file of members to seed a community. The header names the
+// columns: `email` and `name` are required, and `guilds` optionally lists
+// guild slugs separated by semicolons. Rows are imported in the background;
+// poll the import until its status is `completed` to see each row's result.
+//
+// New emails get an account without a password, and users who can't sign in
+// yet are emailed an invitation to choose one. Each row is idempotent:
+// existing accounts are reused, guild memberships are not duplicated, and
+// users with an open invitation aren't sent another, so the same file can be
+// imported again after fixing rows that failed.
+func (c *Client) CreateUserImport(ctx context.Context, body io.Reader) (*CreateUserImportResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/import/users",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "text/csv"
+	}
+	var out CreateUserImportResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserImport sends GET /v1/admin/import/users/{importId}. Get user import.
+//
+// Completed imports include each row's result.
+func (c *Client) GetUserImport(ctx context.Context, importID string) (*GetUserImportResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/import/users/" + url.PathEscape(importID),
+	}
+	var out GetUserImportResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildImportsParams holds the query and header parameters of ListGuildImports.
+type ListGuildImportsParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListGuildImportsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListGuildImports sends GET /v1/admin/import/guilds. List guild imports.
+//
+// The most recent imports, newest first, without their members and events.
+func (c *Client) ListGuildImports(ctx context.Context, params *ListGuildImportsParams) (*ListGuildImportsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/import/guilds",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListGuildImportsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildImport sends POST /v1/admin/import/guilds. Preview a guild
+// import.
+//
+// Upload a Meetup events export or a Discord member list to move a community
+// onto a guild. `export` is the JSON array the platform's API returned: Meetup
+// events, or Discord guild members (bots are left out).
+//
+// Nothing is created yet. The response previews what committing will do with
+// each member and event: members are matched to accounts by email and events
+// to the guild's events by title and start time. Discord doesn't share emails,
+// so members without one are skipped unless an email is supplied when
+// committing. Previews can be committed for a day.
+func (c *Client) CreateGuildImport(ctx context.Context, body *CreateGuildImportBody) (*CreateGuildImportResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/import/guilds",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateGuildImportResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildImport sends GET /v1/admin/import/guilds/{importId}. Get guild
+// import.
+func (c *Client) GetGuildImport(ctx context.Context, importID string) (*GetGuildImportResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/import/guilds/" + url.PathEscape(importID),
+	}
+	var out GetGuildImportResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CommitGuildImport sends POST /v1/admin/import/guilds/{importId}/commit.
+// Commit a guild import.
+//
+// Apply corrections to a preview and carry it out. Members and events are
+// mapped again first, so accounts and events created since the preview are
+// matched rather than duplicated.
+//
+// Events are created straight away for guild members; an event that can't be
+// created is skipped with a note. Members are queued as a user import, linked
+// as `user_import`, which creates their accounts, adds them to the guild and
+// emails invitations in the background.
+func (c *Client) CommitGuildImport(ctx context.Context, importID string, body *CommitGuildImportBody) (*CommitGuildImportResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/import/guilds/" + url.PathEscape(importID) + "/commit",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CommitGuildImportResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportAdminAnalyticsEventsParams holds the query and header parameters of ExportAdminAnalyticsEvents.
+type ExportAdminAnalyticsEventsParams struct {
+	Since *time.Time // since query
+	Until *time.Time // until query
+}
+
+func (p *ExportAdminAnalyticsEventsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Since != nil {
+		query.Set("since", paramValue(*p.Since))
+	}
+	if p.Until != nil {
+		query.Set("until", paramValue(*p.Until))
+	}
+	return query, header
+}
+
+// ExportAdminAnalyticsEvents sends GET /v1/admin/analytics/events/export.
+// Export analytics events.
+//
+// Stream anonymized domain events (hangout_completed, match_accepted, ...) as
+// newline-delimited JSON, oldest first, for loading into a warehouse. Subjects
+// are keyed hashes of user IDs, and events are only recorded for users who
+// consented to analytics. Only available when ANALYTICS_EVENTS_ENABLED is set.
+func (c *Client) ExportAdminAnalyticsEvents(ctx context.Context, params *ExportAdminAnalyticsEventsParams) ([]byte, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/analytics/events/export",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	return c.doRaw(ctx, req)
+}
+
+// GetAdminWinBackStatsParams holds the query and header parameters of GetAdminWinBackStats.
+type GetAdminWinBackStatsParams struct {
+	Since *time.Time // since query
+}
+
+func (p *GetAdminWinBackStatsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Since != nil {
+		query.Set("since", paramValue(*p.Since))
+	}
+	return query, header
+}
+
+// GetAdminWinBackStats sends GET /v1/admin/win-back/stats. Get win-back stats.
+//
+// Compare how many at-risk users came back within 14 days of being targeted,
+// between users who got a win-back nudge (treatment) and users deliberately
+// held out (holdout). Users are at risk when they have been away 7 to 30 days
+// with no upcoming events, hangouts or pending matches. The holdout share is
+// set by WIN_BACK_HOLDOUT_PERCENT. Only users whose 14-day return window has
+// closed are counted.
+func (c *Client) GetAdminWinBackStats(ctx context.Context, params *GetAdminWinBackStatsParams) (*GetAdminWinBackStatsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/win-back/stats",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetAdminWinBackStatsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminGetRegion sends GET /v1/admin/region. Get the region state.
+//
+// The serving region's role, whether it is rejecting writes, and, on a
+// standby, how far it lags behind the active region's heartbeat.
+func (c *Client) AdminGetRegion(ctx context.Context) (*AdminGetRegionResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/region",
+	}
+	var out AdminGetRegionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminUpdateRegion sends PATCH /v1/admin/region. Update the region state.
+//
+// Promote or demote the region, or toggle read-only mode. Omitted fields are
+// unchanged. A standby is always read-only, whatever read_only is set to.
+// Changes last until the next restart, which reads REGION_ROLE and
+// READ_ONLY_MODE again.
+func (c *Client) AdminUpdateRegion(ctx context.Context, body *UpdateRegionRequest) (*AdminUpdateRegionResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/admin/region",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminUpdateRegionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminGetQueryStatsParams holds the query and header parameters of AdminGetQueryStats.
+type AdminGetQueryStatsParams struct {
+	Limit *int // limit query
+}
+
+func (p *AdminGetQueryStatsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// AdminGetQueryStats sends GET /v1/admin/query-stats. Get query stats.
+//
+// Repository methods ordered by total query time, worst first, with the 50
+// most recent slow queries, newest first. Slow query text is truncated to 500
+// characters and errors are redacted.
+func (c *Client) AdminGetQueryStats(ctx context.Context, params *AdminGetQueryStatsParams) (*AdminGetQueryStatsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/query-stats",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out AdminGetQueryStatsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminResetQueryStats sends DELETE /v1/admin/query-stats. Reset query stats.
+//
+// Clears the collected stats and starts counting again from now.
+func (c *Client) AdminResetQueryStats(ctx context.Context) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/admin/query-stats",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// AdminCreateRuntimeSnapshot sends POST /v1/admin/diagnostics/snapshot. Take a
+// runtime snapshot.
+//
+// Writes a goroutine dump and a heap profile, taken after a garbage
+// collection, to DIAGNOSTICS_DIR (the OS temp dir by default) on the instance
+// that handles the request, and returns where they were written with a summary
+// of memory use.
+func (c *Client) AdminCreateRuntimeSnapshot(ctx context.Context) (*AdminCreateRuntimeSnapshotResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/admin/diagnostics/snapshot",
+	}
+	var out AdminCreateRuntimeSnapshotResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminGetLogLevel sends GET /v1/admin/diagnostics/log-level. Get the log
+// level.
+//
+// The instance's current log level, and when a temporary level reverts.
+func (c *Client) AdminGetLogLevel(ctx context.Context) (*AdminGetLogLevelResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/admin/diagnostics/log-level",
+	}
+	var out AdminGetLogLevelResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminUpdateLogLevel sends PATCH /v1/admin/diagnostics/log-level. Change the
+// log level.
+//
+// Changes the log level of the instance that handles the request. With
+// duration_minutes the level reverts to LOG_LEVEL once it has passed; without
+// it the level lasts until the next change or restart. Each change replaces
+// any pending revert.
+func (c *Client) AdminUpdateLogLevel(ctx context.Context, body *UpdateLogLevelRequest) (*AdminUpdateLogLevelResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/admin/diagnostics/log-level",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AdminUpdateLogLevelResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DiscoverPeopleParams holds the query and header parameters of DiscoverPeople.
+type DiscoverPeopleParams struct {
+	HangoutType         []string // hangout_type query
+	InterestID          *string  // interest_id query
+	Language            []string // language query
+	Lat                 *float64 // lat query
+	Limit               *int     // limit query
+	Lng                 *float64 // lng query
+	MinCompatibility    *float64 // min_compatibility query
+	Offset              *int     // offset query
+	Radius              *float64 // radius query
+	RequireSharedAnswer *bool    // require_shared_answer query
+}
+
+func (p *DiscoverPeopleParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	for _, v := range p.HangoutType {
+		query.Add("hangout_type", paramValue(v))
+	}
+	if p.InterestID != nil {
+		query.Set("interest_id", paramValue(*p.InterestID))
+	}
+	for _, v := range p.Language {
+		query.Add("language", paramValue(v))
+	}
+	if p.Lat != nil {
+		query.Set("lat", paramValue(*p.Lat))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Lng != nil {
+		query.Set("lng", paramValue(*p.Lng))
+	}
+	if p.MinCompatibility != nil {
+		query.Set("min_compatibility", paramValue(*p.MinCompatibility))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	if p.Radius != nil {
+		query.Set("radius", paramValue(*p.Radius))
+	}
+	if p.RequireSharedAnswer != nil {
+		query.Set("require_shared_answer", paramValue(*p.RequireSharedAnswer))
+	}
+	return query, header
+}
+
+// DiscoverPeople sends GET /v1/discover/people. Discover compatible people.
+func (c *Client) DiscoverPeople(ctx context.Context, params *DiscoverPeopleParams) (*DiscoveryResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/discover/people",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out DiscoveryResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DiscoverByInterestParams holds the query and header parameters of DiscoverByInterest.
+type DiscoverByInterestParams struct {
+	Limit *int // limit query
+}
+
+func (p *DiscoverByInterestParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// DiscoverByInterest sends GET /v1/discover/interest/{interestId}. Discover
+// people by specific interest.
+func (c *Client) DiscoverByInterest(ctx context.Context, interestID string, params *DiscoverByInterestParams) (*DiscoverByInterestResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/discover/interest/" + url.PathEscape(interestID),
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out DiscoverByInterestResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DiscoverTeachLearnParams holds the query and header parameters of DiscoverTeachLearn.
+type DiscoverTeachLearnParams struct {
+	Limit *int // limit query
+}
+
+func (p *DiscoverTeachLearnParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// DiscoverTeachLearn sends GET /v1/discover/teach-learn. Find teach/learn
+// matches.
+//
+// Find people who want to teach what you want to learn, and vice versa
+func (c *Client) DiscoverTeachLearn(ctx context.Context, params *DiscoverTeachLearnParams) (*DiscoverTeachLearnResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/discover/teach-learn",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out DiscoverTeachLearnResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetHangoutTypes sends GET /v1/discover/hangout-types. Get available hangout
+// types.
+func (c *Client) GetHangoutTypes(ctx context.Context) (*GetHangoutTypesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/discover/hangout-types",
+	}
+	var out GetHangoutTypesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SearchParams holds the query and header parameters of Search.
+type SearchParams struct {
+	Limit *int     // limit query
+	Q     string   // q query
+	Type  []string // type query
+}
+
+func (p *SearchParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	query.Set("q", paramValue(p.Q))
+	for _, v := range p.Type {
+		query.Add("type", paramValue(v))
+	}
+	return query, header
+}
+
+// Search sends GET /v1/search. Search.
+//
+// Matches the query against the names and descriptions of public guilds,
+// published public events, interests, and people whose profile is public.
+// Words are stemmed, so "games" finds "game night". Results from every
+// requested type come back together, best match first; scores are only
+// comparable within one search. People you've blocked, or who blocked you, are
+// left out.
+func (c *Client) Search(ctx context.Context, params *SearchParams) (*SearchResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/search",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out SearchResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEvent sends POST /v1/events. Create a new event.
+func (c *Client) CreateEvent(ctx context.Context, body *CreateEventRequest) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetEvent sends GET /v1/events/{eventId}. Get event details.
+func (c *Client) GetEvent(ctx context.Context, eventID string) (*GetEventResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID),
+	}
+	var out GetEventResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateEvent sends PATCH /v1/events/{eventId}. Update an event.
+func (c *Client) UpdateEvent(ctx context.Context, eventID string, body *UpdateEventRequest) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/events/" + url.PathEscape(eventID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteEvent sends DELETE /v1/events/{eventId}. Delete a guild event.
+//
+// Moves the event to its guild's trash (hosts only). Guild organizers can
+// restore it for 30 days before it is permanently deleted. Events outside a
+// guild can't be deleted; cancel them instead.
+func (c *Client) DeleteEvent(ctx context.Context, eventID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// CancelEvent sends POST /v1/events/{eventId}/cancel. Cancel an event.
+func (c *Client) CancelEvent(ctx context.Context, eventID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/cancel",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ScheduleEventPublication sends PUT /v1/events/{eventId}/schedule. Schedule
+// event publication.
+//
+// Schedule or reschedule when a draft event is published. The event stays
+// hidden until then; at publish time it goes live and guild members are
+// notified. Cancel the event to call off a scheduled publication.
+func (c *Client) ScheduleEventPublication(ctx context.Context, eventID string, body *SchedulePublicationRequest) (*Event, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/schedule",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Event
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetEventRSVPWindow sends PUT /v1/events/{eventId}/rsvp-window. Set an
+// event's RSVP window.
+//
+// Replaces when RSVPs open and who can RSVP early (host only). Early access
+// rules name a guild tier (that exact tier) or a guild role (that role or
+// higher) and how many hours before opens_at its members can RSVP; a tier's
+// own early_rsvp_hours apply too, and the most generous applies. A null
+// opens_at opens RSVPs now. Guests already in keep their place.
+func (c *Client) SetEventRSVPWindow(ctx context.Context, eventID string, body *SetRSVPWindowRequest) (*Event, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/rsvp-window",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Event
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateRSVP sends POST /v1/events/{eventId}/rsvp. RSVP to an event.
+func (c *Client) CreateRSVP(ctx context.Context, eventID string, body *RSVPRequest) (*RSVP, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/rsvp",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RSVP
+	status, err := c.do(ctx, req, &out)
+	if err != nil || status == http.StatusNoContent {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelRSVP sends DELETE /v1/events/{eventId}/rsvp. Cancel own RSVP.
+func (c *Client) CancelRSVP(ctx context.Context, eventID string) (*UndoableResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/rsvp",
+	}
+	var out UndoableResponse
+	status, err := c.do(ctx, req, &out)
+	if err != nil || status == http.StatusNoContent {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPendingRSVPs sends GET /v1/events/{eventId}/rsvps/pending. Get pending
+// RSVPs (host only).
+func (c *Client) GetPendingRSVPs(ctx context.Context, eventID string) (*GetPendingRSVPsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/rsvps/pending",
+	}
+	var out GetPendingRSVPsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RespondToRSVP sends POST /v1/events/{eventId}/rsvps/{userId}/respond.
+// Respond to an RSVP (host only).
+func (c *Client) RespondToRSVP(ctx context.Context, eventID string, userID string, body json.RawMessage) (*RSVP, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/rsvps/" + url.PathEscape(userID) + "/respond",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RSVP
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddHost sends POST /v1/events/{eventId}/hosts. Add a co-host.
+func (c *Client) AddHost(ctx context.Context, eventID string, body *AddHostBody) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/hosts",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfirmEventCompletion sends POST /v1/events/{eventId}/confirm. Confirm
+// event completion.
+func (c *Client) ConfirmEventCompletion(ctx context.Context, eventID string, body *ConfirmEventCompletionBody) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/confirm",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// CheckinEvent sends POST /v1/events/{eventId}/checkin. Check in to event.
+func (c *Client) CheckinEvent(ctx context.Context, eventID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/checkin",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// SubmitEventFeedback sends POST /v1/events/{eventId}/feedback. Submit event
+// feedback.
+func (c *Client) SubmitEventFeedback(ctx context.Context, eventID string, body *EventFeedbackRequest) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/feedback",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ReactToEvent sends PUT /v1/events/{eventId}/reactions. React to an event.
+//
+// Sets the user's reaction, replacing the one they had. Reactions are limited
+// to 30 a minute per user.
+func (c *Client) ReactToEvent(ctx context.Context, eventID string, body *SetReactionRequest) (*ReactToEventResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/reactions",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out ReactToEventResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnreactToEvent sends DELETE /v1/events/{eventId}/reactions. Remove reaction
+// from an event.
+func (c *Client) UnreactToEvent(ctx context.Context, eventID string) (*UnreactToEventResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/reactions",
+	}
+	var out UnreactToEventResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckoutEventRSVP sends POST /v1/events/{eventId}/rsvp/checkout. Pay for a
+// ticket.
+//
+// Starts a Stripe Checkout session for the caller's held ticket to a paid
+// event. RSVP first; the RSVP waits with `payment_status: pending` and is
+// approved once the payment goes through. The hold is kept until shortly after
+// the session expires. The better of the promo code and the caller's member
+// discount comes off the price. Returns 404 when payments aren't configured.
+func (c *Client) CheckoutEventRSVP(ctx context.Context, eventID string, body *CheckoutRequest) (*CheckoutEventRSVPResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/rsvp/checkout",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CheckoutEventRSVPResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEventPromoCodes sends GET /v1/events/{eventId}/promo-codes. List an
+// event's promo codes.
+//
+// Every promo code on the event, newest first (host only).
+func (c *Client) ListEventPromoCodes(ctx context.Context, eventID string) (*ListEventPromoCodesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/promo-codes",
+	}
+	var out ListEventPromoCodesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEventPromoCode sends POST /v1/events/{eventId}/promo-codes. Create a
+// promo code.
+//
+// Adds a percentage or fixed discount code to a paid event (host only). Codes
+// are matched case-insensitively, may cap their redemptions and expire, and an
+// event can have up to 100.
+func (c *Client) CreateEventPromoCode(ctx context.Context, eventID string, body *CreatePromoCodeRequest) (*CreateEventPromoCodeResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/promo-codes",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateEventPromoCodeResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateEventPromoCode sends PATCH /v1/events/{eventId}/promo-codes/{promoId}.
+// Update a promo code.
+//
+// Changes a promo code's redemption cap or expiry, or deactivates it (host
+// only). The discount itself can't change.
+func (c *Client) UpdateEventPromoCode(ctx context.Context, eventID string, promoID string, body *UpdatePromoCodeRequest) (*UpdateEventPromoCodeResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/promo-codes/" + url.PathEscape(promoID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateEventPromoCodeResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteEventPromoCode sends DELETE
+// /v1/events/{eventId}/promo-codes/{promoId}. Delete a promo code.
+//
+// Deletes a promo code no one has redeemed (host only). Redeemed codes are
+// kept for reporting; deactivate them instead.
+func (c *Client) DeleteEventPromoCode(ctx context.Context, eventID string, promoID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/promo-codes/" + url.PathEscape(promoID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// SetEventMemberDiscounts sends PUT /v1/events/{eventId}/member-discounts. Set
+// member discounts.
+//
+// Replaces the percentage off a guild event's tickets that guild members get
+// by role (host only). A discount for a role applies to higher roles too, and
+// members get the best one they qualify for. Checkout applies it automatically
+// unless a promo code saves more.
+func (c *Client) SetEventMemberDiscounts(ctx context.Context, eventID string, body *SetMemberDiscountsRequest) (*SetEventMemberDiscountsResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/member-discounts",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out SetEventMemberDiscountsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEventDiscountReport sends GET /v1/events/{eventId}/discount-report. Get
+// an event's discount report.
+//
+// Ticket sales overall, per promo code, and for member discounts, with each
+// discounted sale listed (host only). Refunded sales are counted but left out
+// of revenue and discount totals.
+func (c *Client) GetEventDiscountReport(ctx context.Context, eventID string) (*GetEventDiscountReportResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/discount-report",
+	}
+	var out GetEventDiscountReportResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HoldEventTicket sends POST /v1/events/{eventId}/tickets/hold. Hold a ticket.
+//
+// Reserves a seat at a ticketed event for 10 minutes while the caller RSVPs;
+// the RSVP claims the ticket. Returns the caller's existing ticket if they
+// have one.
+func (c *Client) HoldEventTicket(ctx context.Context, eventID string) (*HoldEventTicketResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/tickets/hold",
+	}
+	var out HoldEventTicketResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReleaseEventTicketHold sends DELETE /v1/events/{eventId}/tickets/hold.
+// Release a held ticket.
+//
+// Gives back an unclaimed hold. Cancel the RSVP to give back a claimed ticket.
+func (c *Client) ReleaseEventTicketHold(ctx context.Context, eventID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/tickets/hold",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetMyEventTicket sends GET /v1/events/{eventId}/tickets/mine. Get own
+// ticket.
+func (c *Client) GetMyEventTicket(ctx context.Context, eventID string) (*GetMyEventTicketResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/tickets/mine",
+	}
+	var out GetMyEventTicketResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TransferEventTicket sends POST
+// /v1/events/{eventId}/tickets/{ticketId}/transfer. Transfer a ticket.
+//
+// Gives the caller's claimed ticket to another member of the event's guild.
+// The recipient takes over the caller's RSVP and the ticket gets a new QR
+// payload.
+func (c *Client) TransferEventTicket(ctx context.Context, eventID string, ticketID string, body *TransferTicketRequest) (*TransferEventTicketResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/tickets/" + url.PathEscape(ticketID) + "/transfer",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out TransferEventTicketResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckInEventTicket sends POST /v1/events/{eventId}/tickets/check-in. Check
+// in a ticket (host only).
+//
+// Validates a scanned QR payload and checks its holder in. Each ticket checks
+// in once, and only if the holder's RSVP is approved.
+func (c *Client) CheckInEventTicket(ctx context.Context, eventID string, body *CheckInTicketRequest) (*CheckInEventTicketResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/tickets/check-in",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CheckInEventTicketResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DiscoverEventsParams holds the query and header parameters of DiscoverEvents.
+type DiscoverEventsParams struct {
+	Accessibility []AccessibilityFeature // accessibility query
+	City          *string                // city query
+	Limit         *int                   // limit query
+	MatchNeeds    *bool                  // match_needs query
+	Template      *string                // template query
+}
+
+func (p *DiscoverEventsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	for _, v := range p.Accessibility {
+		query.Add("accessibility", paramValue(v))
+	}
+	if p.City != nil {
+		query.Set("city", paramValue(*p.City))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.MatchNeeds != nil {
+		query.Set("match_needs", paramValue(*p.MatchNeeds))
+	}
+	if p.Template != nil {
+		query.Set("template", paramValue(*p.Template))
+	}
+	return query, header
+}
+
+// DiscoverEvents sends GET /v1/discover/events. Discover public events.
+func (c *Client) DiscoverEvents(ctx context.Context, params *DiscoverEventsParams) (*DiscoverEventsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/discover/events",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out DiscoverEventsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildEventsParams holds the query and header parameters of GetGuildEvents.
+type GetGuildEventsParams struct {
+	Cursor *string           // cursor query
+	Filter map[string]string // filter query
+	Limit  *int              // limit query
+	Offset *int              // offset query
+	Sort   *string           // sort query
+}
+
+func (p *GetGuildEventsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	for k, v := range p.Filter {
+		query.Set("filter["+k+"]", paramValue(v))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	if p.Sort != nil {
+		query.Set("sort", paramValue(*p.Sort))
+	}
+	return query, header
+}
+
+// GetGuildEvents sends GET /v1/guilds/{guildId}/events. Get guild events.
+func (c *Client) GetGuildEvents(ctx context.Context, guildID string, params *GetGuildEventsParams) (*GetGuildEventsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/events",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetGuildEventsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEventCarpoolRides sends GET /v1/events/{eventId}/carpool/rides. List
+// rides to an event.
+//
+// Open and full rides offered to the event, by departure time (hosts and
+// approved attendees only).
+func (c *Client) ListEventCarpoolRides(ctx context.Context, eventID string) (*ListEventCarpoolRidesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/carpool/rides",
+	}
+	var out ListEventCarpoolRidesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// OfferEventCarpoolRide sends POST /v1/events/{eventId}/carpool/rides. Offer a
+// ride to an event.
+//
+// Offers a ride from an origin, through optional stops, to the event's
+// location. The ride shares the event's visibility. Each attendee can offer
+// one ride and an event can have up to 10. Closed once the event starts.
+func (c *Client) OfferEventCarpoolRide(ctx context.Context, eventID string, body *OfferRideRequest) (*OfferEventCarpoolRideResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/carpool/rides",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out OfferEventCarpoolRideResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestEventCarpoolRide sends POST /v1/events/{eventId}/carpool/requests.
+// Look for a ride to an event.
+//
+// Posts a pickup point for matching. A user has one open request per event; it
+// closes as matched when a driver confirms their seat.
+func (c *Client) RequestEventCarpoolRide(ctx context.Context, eventID string, body *RequestRideRequest) (*RequestEventCarpoolRideResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/carpool/requests",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RequestEventCarpoolRideResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelEventCarpoolRequest sends DELETE
+// /v1/events/{eventId}/carpool/requests/{requestId}. Withdraw a ride request.
+func (c *Client) CancelEventCarpoolRequest(ctx context.Context, eventID string, requestID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/carpool/requests/" + url.PathEscape(requestID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListEventCarpoolMatches sends GET /v1/events/{eventId}/carpool/matches. Find
+// rides for my pickup.
+//
+// Open rides whose route passes within 5 km of the caller's open ride request,
+// closest first. The caller's own ride is left out.
+func (c *Client) ListEventCarpoolMatches(ctx context.Context, eventID string) (*ListEventCarpoolMatchesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/carpool/matches",
+	}
+	var out ListEventCarpoolMatchesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestRideshareSeat sends POST /v1/rideshares/{rideshareId}/seats. Request
+// a seat.
+//
+// Asks the driver of an event's rideshare for a seat. One request per
+// passenger per rideshare.
+func (c *Client) RequestRideshareSeat(ctx context.Context, rideshareID string, body *RequestSeatRequest) (*RequestRideshareSeatResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/seats",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RequestRideshareSeatResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RespondToRideshareSeat sends POST
+// /v1/rideshares/{rideshareId}/seats/{seatId}/respond. Confirm or decline a
+// seat request.
+//
+// Driver only. Confirming takes a seat, marks the rideshare full when it was
+// the last one, and closes the passenger's ride request as matched.
+func (c *Client) RespondToRideshareSeat(ctx context.Context, rideshareID string, seatID string, body *RespondToSeatRequest) (*RespondToRideshareSeatResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/rideshares/" + url.PathEscape(rideshareID) + "/seats/" + url.PathEscape(seatID) + "/respond",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out RespondToRideshareSeatResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEventExpenses sends GET /v1/events/{eventId}/expenses. List an event's
+// expenses.
+//
+// Expenses recorded for the event, oldest first (hosts and approved attendees
+// only).
+func (c *Client) ListEventExpenses(ctx context.Context, eventID string) (*ListEventExpensesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/expenses",
+	}
+	var out ListEventExpensesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEventExpense sends POST /v1/events/{eventId}/expenses. Record an event
+// expense.
+//
+// Records a cost a host or approved attendee paid for the event (hosts only).
+// It is split evenly among the hosts and approved attendees, with plus-ones
+// counting toward their attendee's share. All of an event's expenses are in
+// one currency, and an event can have up to 100.
+func (c *Client) CreateEventExpense(ctx context.Context, eventID string, body *CreateExpenseRequest) (*CreateEventExpenseResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/expenses",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out CreateEventExpenseResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteEventExpense sends DELETE /v1/events/{eventId}/expenses/{expenseId}.
+// Remove an event expense.
+//
+// Removes an expense recorded by mistake (hosts only). Settlements already
+// made are kept.
+func (c *Client) DeleteEventExpense(ctx context.Context, eventID string, expenseID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/expenses/" + url.PathEscape(expenseID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetEventExpenseLedger sends GET /v1/events/{eventId}/expenses/ledger. Get an
+// event's expense ledger.
+//
+// Each participant's share and balance, and the transfers that would settle
+// everyone up (hosts and approved attendees only).
+func (c *Client) GetEventExpenseLedger(ctx context.Context, eventID string) (*GetEventExpenseLedgerResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/expenses/ledger",
+	}
+	var out GetEventExpenseLedgerResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SettleEventExpenses sends POST /v1/events/{eventId}/expenses/settlements.
+// Mark an expense debt settled.
+//
+// Records that one participant paid back what the ledger says they owe
+// another, in full. Either party or a host can mark it.
+func (c *Client) SettleEventExpenses(ctx context.Context, eventID string, body *SettleExpensesRequest) (*SettleEventExpensesResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/expenses/settlements",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out SettleEventExpensesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAttendeeNoShowStats sends GET
+// /v1/events/{eventId}/rsvps/{userId}/no-shows. Get an RSVP'er's no-show
+// stats.
+//
+// Attendance reliability of a user who RSVP'd to the event, over the lookback
+// window of the event's guild (180 days for events outside a guild). Event
+// hosts only.
+func (c *Client) GetAttendeeNoShowStats(ctx context.Context, eventID string, userID string) (*GetAttendeeNoShowStatsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/rsvps/" + url.PathEscape(userID) + "/no-shows",
+	}
+	var out GetAttendeeNoShowStatsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MarkNoShow sends POST /v1/events/{eventId}/no-shows/{userId}. Mark an
+// attendee as a no-show.
+//
+// Records that an attendee with an approved RSVP didn't attend. Only possible
+// once the event has ended. Event hosts only.
+func (c *Client) MarkNoShow(ctx context.Context, eventID string, userID string) (*MarkNoShowResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/no-shows/" + url.PathEscape(userID),
+	}
+	var out MarkNoShowResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveNoShow sends DELETE /v1/events/{eventId}/no-shows/{userId}. Remove a
+// no-show mark.
+//
+// Removes an erroneous no-show mark. Event hosts and guild admins only.
+func (c *Client) RemoveNoShow(ctx context.Context, eventID string, userID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/no-shows/" + url.PathEscape(userID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetMyNoShows sends GET /v1/profile/no-shows. Get own no-show stats and
+// records.
+//
+// The user's attendance reliability and no-shows over the last 180 days.
+func (c *Client) GetMyNoShows(ctx context.Context) (*GetMyNoShowsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/no-shows",
+	}
+	var out GetMyNoShowsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildNoShowPolicy sends GET /v1/guilds/{guildId}/no-show-policy. Get a
+// guild's no-show policy.
+//
+// How the guild reacts to repeated no-shows. Guilds without a policy return
+// the default, which never auto-declines. Guild members only.
+func (c *Client) GetGuildNoShowPolicy(ctx context.Context, guildID string) (*GetGuildNoShowPolicyResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/no-show-policy",
+	}
+	var out GetGuildNoShowPolicyResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuildNoShowPolicy sends PATCH /v1/guilds/{guildId}/no-show-policy.
+// Update a guild's no-show policy.
+func (c *Client) UpdateGuildNoShowPolicy(ctx context.Context, guildID string, body *UpdateGuildNoShowPolicyRequest) (*UpdateGuildNoShowPolicyResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/no-show-policy",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateGuildNoShowPolicyResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEventRoles sends GET /v1/events/{eventId}/roles. List roles for an event.
+func (c *Client) GetEventRoles(ctx context.Context, eventID string) (*GetEventRolesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles",
+	}
+	var out GetEventRolesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEventRole sends POST /v1/events/{eventId}/roles. Create a new role
+// (host only).
+func (c *Client) CreateEventRole(ctx context.Context, eventID string, body *CreateEventRoleRequest) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetEventRolesOverview sends GET /v1/events/{eventId}/roles/overview. Get
+// roles with assignments overview.
+func (c *Client) GetEventRolesOverview(ctx context.Context, eventID string) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/overview",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateEventRole sends PATCH /v1/events/{eventId}/roles/{roleId}. Update a
+// role (host only).
+func (c *Client) UpdateEventRole(ctx context.Context, eventID string, roleID string, body *UpdateEventRoleRequest) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/" + url.PathEscape(roleID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteEventRole sends DELETE /v1/events/{eventId}/roles/{roleId}. Delete a
+// role (host only).
+func (c *Client) DeleteEventRole(ctx context.Context, eventID string, roleID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/" + url.PathEscape(roleID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// AssignEventRole sends POST /v1/events/{eventId}/roles/assign. Assign self to
+// a role.
+func (c *Client) AssignEventRole(ctx context.Context, eventID string, body json.RawMessage) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/assign",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetMyEventRoles sends GET /v1/events/{eventId}/roles/mine. Get my roles for
+// an event.
+func (c *Client) GetMyEventRoles(ctx context.Context, eventID string) (*GetMyEventRolesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/mine",
+	}
+	var out GetMyEventRolesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEventRoleSuggestions sends GET /v1/events/{eventId}/roles/suggestions.
+// Get role suggestions based on interests.
+func (c *Client) GetEventRoleSuggestions(ctx context.Context, eventID string) (*GetEventRoleSuggestionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/suggestions",
+	}
+	var out GetEventRoleSuggestionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelEventRoleAssignment sends DELETE
+// /v1/events/{eventId}/roles/assignments/{assignmentId}. Cancel own
+// assignment.
+func (c *Client) CancelEventRoleAssignment(ctx context.Context, eventID string, assignmentID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/assignments/" + url.PathEscape(assignmentID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// NominateForEventRole sends POST
+// /v1/events/{eventId}/roles/{roleId}/nominations. Nominate self for an
+// approval-mode role.
+//
+// Creates a pending assignment that a host approves or declines. Role capacity
+// is checked at approval.
+func (c *Client) NominateForEventRole(ctx context.Context, eventID string, roleID string, body *NominateRoleRequest) (*EventRoleAssignment, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/" + url.PathEscape(roleID) + "/nominations",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out EventRoleAssignment
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEventRoleNominations sends GET /v1/events/{eventId}/roles/nominations.
+// List pending nominations (host only).
+func (c *Client) GetEventRoleNominations(ctx context.Context, eventID string) (*GetEventRoleNominationsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/nominations",
+	}
+	var out GetEventRoleNominationsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ApproveEventRoleNomination sends POST
+// /v1/events/{eventId}/roles/nominations/{assignmentId}/approve. Approve a
+// nomination (host only).
+//
+// Confirms the nominee in the role and notifies them. Fails if the role is
+// already full.
+func (c *Client) ApproveEventRoleNomination(ctx context.Context, eventID string, assignmentID string, body *ReviewNominationRequest) (*EventRoleAssignment, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/nominations/" + url.PathEscape(assignmentID) + "/approve",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out EventRoleAssignment
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeclineEventRoleNomination sends POST
+// /v1/events/{eventId}/roles/nominations/{assignmentId}/decline. Decline a
+// nomination (host only).
+//
+// Declines the nomination and notifies the nominee.
+func (c *Client) DeclineEventRoleNomination(ctx context.Context, eventID string, assignmentID string, body *ReviewNominationRequest) (*EventRoleAssignment, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/events/" + url.PathEscape(eventID) + "/roles/nominations/" + url.PathEscape(assignmentID) + "/decline",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out EventRoleAssignment
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAvailability sends POST /v1/availability. Create availability window.
+func (c *Client) CreateAvailability(ctx context.Context, body *CreateAvailabilityRequest) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/availability",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetAvailability sends GET /v1/availability/{availabilityId}. Get an
+// availability.
+func (c *Client) GetAvailability(ctx context.Context, availabilityID string) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/availability/" + url.PathEscape(availabilityID),
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateAvailability sends PATCH /v1/availability/{availabilityId}. Update
+// availability.
+func (c *Client) UpdateAvailability(ctx context.Context, availabilityID string, body *UpdateAvailabilityRequest) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/availability/" + url.PathEscape(availabilityID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteAvailability sends DELETE /v1/availability/{availabilityId}. Delete
+// availability.
+func (c *Client) DeleteAvailability(ctx context.Context, availabilityID string) (*UndoableResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/availability/" + url.PathEscape(availabilityID),
+	}
+	var out UndoableResponse
+	status, err := c.do(ctx, req, &out)
+	if err != nil || status == http.StatusNoContent {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAvailableNow sends GET /v1/availability/now. Get own spontaneous
+// availability.
+func (c *Client) GetAvailableNow(ctx context.Context) (*GetAvailableNowResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/availability/now",
+	}
+	var out GetAvailableNowResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GoAvailableNow sends POST /v1/availability/now. Go available right now.
+//
+// Starts a spontaneous window that begins immediately and expires on its own.
+// It ranks first in nearby discovery and alerts compatible people nearby who
+// opted into spontaneous alerts, each at most once an hour and at most 20 per
+// session. Users may start 3 sessions in any 24 hours. Going available again
+// while available extends the window without alerting anyone.
+func (c *Client) GoAvailableNow(ctx context.Context, body *GoAvailableNowRequest) (*GoAvailableNowResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/availability/now",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out GoAvailableNowResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StopAvailableNow sends DELETE /v1/availability/now. Stop being available
+// right now.
+//
+// Ends the window early. The session still counts toward the daily limit.
+func (c *Client) StopAvailableNow(ctx context.Context) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/availability/now",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetMyAvailabilities sends GET /v1/profile/availability. Get own
+// availabilities.
+func (c *Client) GetMyAvailabilities(ctx context.Context) (*GetMyAvailabilitiesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/availability",
+	}
+	var out GetMyAvailabilitiesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSpontaneousAlertPreference sends GET /v1/profile/spontaneous-alerts. Get
+// spontaneous alert preferences.
+func (c *Client) GetSpontaneousAlertPreference(ctx context.Context) (*GetSpontaneousAlertPreferenceResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/spontaneous-alerts",
+	}
+	var out GetSpontaneousAlertPreferenceResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSpontaneousAlertPreference sends PATCH /v1/profile/spontaneous-alerts.
+// Update spontaneous alert preferences.
+func (c *Client) UpdateSpontaneousAlertPreference(ctx context.Context, body *UpdateSpontaneousAlertPreferenceRequest) (*UpdateSpontaneousAlertPreferenceResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/profile/spontaneous-alerts",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out UpdateSpontaneousAlertPreferenceResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindNearbyAvailabilityParams holds the query and header parameters of FindNearbyAvailability.
+type FindNearbyAvailabilityParams struct {
+	EndTime   *time.Time // end_time query
+	Limit     *int       // limit query
+	RadiusKm  *float64   // radius_km query
+	StartTime *time.Time // start_time query
+}
+
+func (p *FindNearbyAvailabilityParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.EndTime != nil {
+		query.Set("end_time", paramValue(*p.EndTime))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.RadiusKm != nil {
+		query.Set("radius_km", paramValue(*p.RadiusKm))
+	}
+	if p.StartTime != nil {
+		query.Set("start_time", paramValue(*p.StartTime))
+	}
+	return query, header
+}
+
+// FindNearbyAvailability sends GET /v1/discover/availability. Find nearby
+// available people.
+//
+// Requires user location to be set and location_processing consent. Only
+// availability of users who also consented is returned.
+func (c *Client) FindNearbyAvailability(ctx context.Context, params *FindNearbyAvailabilityParams) (*FindNearbyAvailabilityResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/discover/availability",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out FindNearbyAvailabilityResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildAvailabilityParams holds the query and header parameters of ListGuildAvailability.
+type ListGuildAvailabilityParams struct {
+	Limit *int // limit query
+}
+
+func (p *ListGuildAvailabilityParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// ListGuildAvailability sends GET /v1/guilds/{guildId}/availability. List
+// guild availability.
+//
+// Unexpired availability scoped to the guild, spontaneous windows first and
+// then soonest. Guild-scoped availability is left out of global discovery.
+// Requires guild membership.
+func (c *Client) ListGuildAvailability(ctx context.Context, guildID string, params *ListGuildAvailabilityParams) (*ListGuildAvailabilityResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/availability",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListGuildAvailabilityResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildAvailabilityHeatmapParams holds the query and header parameters of GetGuildAvailabilityHeatmap.
+type GetGuildAvailabilityHeatmapParams struct {
+	Tz    *string // tz query
+	Weeks *int    // weeks query
+}
+
+func (p *GetGuildAvailabilityHeatmapParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Tz != nil {
+		query.Set("tz", paramValue(*p.Tz))
+	}
+	if p.Weeks != nil {
+		query.Set("weeks", paramValue(*p.Weeks))
+	}
+	return query, header
+}
+
+// GetGuildAvailabilityHeatmap sends GET
+// /v1/guilds/{guildId}/availability/heatmap. Guild availability heatmap.
+//
+// Counts how many members of the guild posted availability in each day-of-week
+// × hour slot over the last few weeks. Slots with fewer than `min_members`
+// members read as zero so no individual's schedule can be picked out. Requires
+// guild membership.
+func (c *Client) GetGuildAvailabilityHeatmap(ctx context.Context, guildID string, params *GetGuildAvailabilityHeatmapParams) (*GetGuildAvailabilityHeatmapResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/availability/heatmap",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetGuildAvailabilityHeatmapResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SuggestGuildEventTimes sends POST /v1/guilds/{guildId}/events/suggest-times.
+// Suggest times for a guild event.
+//
+// Ranks slots starting on the hour within the candidate ranges by how many
+// members are usually free for the whole slot, based on the guild's
+// availability heatmap over the last 8 weeks. Each published guild event
+// overlapping a slot halves its expected attendance. Slots in the past are
+// skipped. Requires guild membership.
+func (c *Client) SuggestGuildEventTimes(ctx context.Context, guildID string, body *SuggestGuildEventTimesBody) (*SuggestGuildEventTimesResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/events/suggest-times",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out SuggestGuildEventTimesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindAvailabilityByTypeParams holds the query and header parameters of FindAvailabilityByType.
+type FindAvailabilityByTypeParams struct {
+	Limit *int // limit query
+}
+
+func (p *FindAvailabilityByTypeParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// FindAvailabilityByType sends GET /v1/discover/availability/type/{type}. Find
+// availability by hangout type.
+func (c *Client) FindAvailabilityByType(ctx context.Context, typeArg string, params *FindAvailabilityByTypeParams) (*FindAvailabilityByTypeResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/discover/availability/type/" + url.PathEscape(typeArg),
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out FindAvailabilityByTypeResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestHangout sends POST /v1/availability/{availabilityId}/request. Request
+// to join an availability.
+func (c *Client) RequestHangout(ctx context.Context, availabilityID string, body *RequestHangoutBody) (*HangoutRequest, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/availability/" + url.PathEscape(availabilityID) + "/request",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out HangoutRequest
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAvailabilityRequests sends GET
+// /v1/availability/{availabilityId}/requests. Get pending requests for an
+// availability.
+func (c *Client) GetAvailabilityRequests(ctx context.Context, availabilityID string) (*GetAvailabilityRequestsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/availability/" + url.PathEscape(availabilityID) + "/requests",
+	}
+	var out GetAvailabilityRequestsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RespondToHangoutRequest sends POST /v1/requests/{requestId}/respond. Respond
+// to a hangout request.
+func (c *Client) RespondToHangoutRequest(ctx context.Context, requestID string, body *RespondToHangoutRequestBody) (*Hangout, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/requests/" + url.PathEscape(requestID) + "/respond",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Hangout
+	status, err := c.do(ctx, req, &out)
+	if err != nil || status == http.StatusNoContent {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListHangoutTypes sends GET /v1/hangout-types. List hangout types.
+//
+// The active platform hangout types from the catalog, in display order.
+func (c *Client) ListHangoutTypes(ctx context.Context) (*ListHangoutTypesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/hangout-types",
+	}
+	var out ListHangoutTypesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyHangoutsParams holds the query and header parameters of GetMyHangouts.
+type GetMyHangoutsParams struct {
+	Limit *int // limit query
+}
+
+func (p *GetMyHangoutsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// GetMyHangouts sends GET /v1/profile/hangouts. Get user's hangouts.
+func (c *Client) GetMyHangouts(ctx context.Context, params *GetMyHangoutsParams) (*GetMyHangoutsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/hangouts",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetMyHangoutsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateHangoutStatus sends PATCH /v1/hangouts/{hangoutId}/status. Update
+// hangout status.
+func (c *Client) UpdateHangoutStatus(ctx context.Context, hangoutID string, body *UpdateHangoutStatusBody) error {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/hangouts/" + url.PathEscape(hangoutID) + "/status",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetProfile sends GET /v1/profile. Get own profile.
+//
+// Includes the profile's completeness score and the missing item worth the
+// most points.
+func (c *Client) GetProfile(ctx context.Context) (*ProfileResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile",
+	}
+	var out ProfileResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProfile sends PATCH /v1/profile. Update own profile.
+func (c *Client) UpdateProfile(ctx context.Context, body *UpdateProfileRequest) (*ProfileResponse, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/profile",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out ProfileResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetProfileHandle sends PUT /v1/profile/handle. Set or change own handle.
+//
+// Handles are 3-30 letters, digits and underscores, start with a letter, and
+// are unique case-insensitively. Setting the first handle is free; changes are
+// limited to one every 30 days. A released handle keeps resolving to its
+// previous owner and cannot be claimed by anyone else for 90 days.
+func (c *Client) SetProfileHandle(ctx context.Context, body *SetHandleRequest) (*HandleResponse, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/v1/profile/handle",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out HandleResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProfileActivityParams holds the query and header parameters of GetProfileActivity.
+type GetProfileActivityParams struct {
+	Cursor *string // cursor query
+	Limit  *int    // limit query
+}
+
+func (p *GetProfileActivityParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// GetProfileActivity sends GET /v1/profile/activity. Get own activity
+// timeline.
+//
+// Unified timeline of the user's actions (joined a guild, RSVP'd, matched,
+// gave a review or trust) and actions others took toward them (received a
+// review or trust), newest first. Pass the returned cursor to get older
+// entries.
+func (c *Client) GetProfileActivity(ctx context.Context, params *GetProfileActivityParams) (*GetProfileActivityResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/activity",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetProfileActivityResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SendPresenceHeartbeat sends POST /v1/presence. Send an activity heartbeat.
+//
+// Marks the user active, for clients that stay open without making other
+// requests. Every authenticated request already does this, so a heartbeat
+// every few minutes while idle is enough. The last-active time is written at
+// most once every 5 minutes and drives activity_status on profiles and
+// activity_recency in discovery.
+func (c *Client) SendPresenceHeartbeat(ctx context.Context) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/presence",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetPayoutAccount sends GET /v1/profile/payout-account. Get my payout
+// account.
+//
+// The Stripe account the caller's ticket sales are paid out to. Paid events
+// need an account with `charges_enabled`.
+func (c *Client) GetPayoutAccount(ctx context.Context) (*GetPayoutAccountResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/payout-account",
+	}
+	var out GetPayoutAccountResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LinkPayoutAccount sends POST /v1/profile/payout-account/link. Link a payout
+// account.
+//
+// Returns a single-use Stripe page where the caller sets up, or finishes
+// setting up, their payout account, creating the account first if they have
+// none.
+func (c *Client) LinkPayoutAccount(ctx context.Context) (*LinkPayoutAccountResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/profile/payout-account/link",
+	}
+	var out LinkPayoutAccountResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserProfile sends GET /v1/users/{userId}/profile. Get another user's
+// public profile.
+func (c *Client) GetUserProfile(ctx context.Context, userID string) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/" + url.PathEscape(userID) + "/profile",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetSharedUserProfile sends GET /v1/public/users/{userId}/profile. Get a
+// user's shared profile.
+//
+// Unauthenticated profile for share links. Only available when the owner has
+// enabled share_enabled and the profile is not private. City and activity
+// status are omitted.
+func (c *Client) GetSharedUserProfile(ctx context.Context, userID string) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/public/users/" + url.PathEscape(userID) + "/profile",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetGuildEventsEmbedParams holds the query and header parameters of GetGuildEventsEmbed.
+type GetGuildEventsEmbedParams struct {
+	Limit *int // limit query
+}
+
+func (p *GetGuildEventsEmbedParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// GetGuildEventsEmbed sends GET /v1/public/guilds/{slug}/events. Get a guild's
+// upcoming events for embedding.
+//
+// The guild's next published events with public visibility, soonest first, for
+// organizers who show them on their own website. Guilds that haven't enabled
+// embeds are not found.
+func (c *Client) GetGuildEventsEmbed(ctx context.Context, slug string, params *GetGuildEventsEmbedParams) (*GetGuildEventsEmbedResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/public/guilds/" + url.PathEscape(slug) + "/events",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetGuildEventsEmbedResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGuildEventsWidgetParams holds the query and header parameters of GetGuildEventsWidget.
+type GetGuildEventsWidgetParams struct {
+	Limit *int // limit query
+}
+
+func (p *GetGuildEventsWidgetParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// GetGuildEventsWidget sends GET /v1/public/guilds/{slug}/events/widget. Get a
+// guild's upcoming events as an HTML widget.
+//
+// A self-contained HTML page listing the same events, made to be framed by
+// other sites.
+func (c *Client) GetGuildEventsWidget(ctx context.Context, slug string, params *GetGuildEventsWidgetParams) ([]byte, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/public/guilds/" + url.PathEscape(slug) + "/events/widget",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	return c.doRaw(ctx, req)
+}
+
+// GetGuildEventsOEmbedParams holds the query and header parameters of GetGuildEventsOEmbed.
+type GetGuildEventsOEmbedParams struct {
+	Format    *string // format query
+	Maxheight *int    // maxheight query
+	Maxwidth  *int    // maxwidth query
+}
+
+func (p *GetGuildEventsOEmbedParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Format != nil {
+		query.Set("format", paramValue(*p.Format))
+	}
+	if p.Maxheight != nil {
+		query.Set("maxheight", paramValue(*p.Maxheight))
+	}
+	if p.Maxwidth != nil {
+		query.Set("maxwidth", paramValue(*p.Maxwidth))
+	}
+	return query, header
+}
+
+// GetGuildEventsOEmbed sends GET /v1/public/guilds/{slug}/events/oembed. Get
+// oEmbed for a guild's events widget.
+//
+// An oEmbed rich response whose HTML frames the widget, so sites and CMSs that
+// support oEmbed can embed it from a URL.
+func (c *Client) GetGuildEventsOEmbed(ctx context.Context, slug string, params *GetGuildEventsOEmbedParams) (*OEmbed, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/public/guilds/" + url.PathEscape(slug) + "/events/oembed",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out OEmbed
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListQuestionsParams holds the query and header parameters of ListQuestions.
+type ListQuestionsParams struct {
+	Category *string // category query
+}
+
+func (p *ListQuestionsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Category != nil {
+		query.Set("category", paramValue(*p.Category))
+	}
+	return query, header
+}
+
+// ListQuestions sends GET /v1/questions. List questions.
+func (c *Client) ListQuestions(ctx context.Context, params *ListQuestionsParams) (*ListQuestionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/questions",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListQuestionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetQuestionCategoriesParams holds the query and header parameters of GetQuestionCategories.
+type GetQuestionCategoriesParams struct {
+	IfNoneMatch string // If-None-Match header
+}
+
+func (p *GetQuestionCategoriesParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.IfNoneMatch != "" {
+		header.Set("If-None-Match", p.IfNoneMatch)
+	}
+	return query, header
+}
+
+// GetQuestionCategories sends GET /v1/questions/categories. List question
+// categories.
+func (c *Client) GetQuestionCategories(ctx context.Context, params *GetQuestionCategoriesParams) (*GetQuestionCategoriesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/questions/categories",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetQuestionCategoriesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetQuestion sends GET /v1/questions/{questionId}. Get a specific question.
+func (c *Client) GetQuestion(ctx context.Context, questionID string) (*Question, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/questions/" + url.PathEscape(questionID),
+	}
+	var out Question
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyAnswers sends GET /v1/profile/answers. Get own answers.
+func (c *Client) GetMyAnswers(ctx context.Context) (*GetMyAnswersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/answers",
+	}
+	var out GetMyAnswersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyAnswersDetailed sends GET /v1/profile/answers/detailed. Get answers
+// with question details.
+func (c *Client) GetMyAnswersDetailed(ctx context.Context) (*GetMyAnswersDetailedResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/answers/detailed",
+	}
+	var out GetMyAnswersDetailedResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportMyAnswers sends GET /v1/profile/answers/export. Export my answers.
+//
+// Returns a portable JSON export of all answers. Each answer carries the
+// version of its question so a later import can detect changed questions.
+func (c *Client) ExportMyAnswers(ctx context.Context) (*ExportMyAnswersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/answers/export",
+	}
+	var out ExportMyAnswersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportMyAnswersParams holds the query and header parameters of ImportMyAnswers.
+type ImportMyAnswersParams struct {
+	DryRun *bool // dry_run query
+}
+
+func (p *ImportMyAnswersParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.DryRun != nil {
+		query.Set("dry_run", paramValue(*p.DryRun))
+	}
+	return query, header
+}
+
+// ImportMyAnswers sends POST /v1/profile/answers/import. Import answers from
+// an export.
+//
+// Saves answers from a previous export, overwriting existing answers to the
+// same questions. Answers whose question no longer exists, is retired, has
+// changed since export, or fails validation are skipped and reported.
+func (c *Client) ImportMyAnswers(ctx context.Context, params *ImportMyAnswersParams, body *QuestionnaireExport) (*ImportMyAnswersResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/profile/answers/import",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out ImportMyAnswersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetQuestionProgress sends GET /v1/profile/questions/progress. Get question
+// progress.
+func (c *Client) GetQuestionProgress(ctx context.Context) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/questions/progress",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnswerQuestion sends POST /v1/questions/{questionId}/answer. Answer a
+// question.
+func (c *Client) AnswerQuestion(ctx context.Context, questionID string, body *AnswerQuestionRequest) (*Answer, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/questions/" + url.PathEscape(questionID) + "/answer",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Answer
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAnswer sends PATCH /v1/questions/{questionId}/answer. Update an
+// answer.
+func (c *Client) UpdateAnswer(ctx context.Context, questionID string, body json.RawMessage) (*Answer, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/questions/" + url.PathEscape(questionID) + "/answer",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Answer
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteAnswer sends DELETE /v1/questions/{questionId}/answer. Delete an
+// answer.
+func (c *Client) DeleteAnswer(ctx context.Context, questionID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/questions/" + url.PathEscape(questionID) + "/answer",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetCompatibilityBatch sends POST /v1/compatibility/batch. Get compatibility
+// with several users at once.
+//
+// Scores are returned in request order. Duplicate IDs and your own ID are
+// skipped.
+func (c *Client) GetCompatibilityBatch(ctx context.Context, body *GetCompatibilityBatchBody) (*GetCompatibilityBatchResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/compatibility/batch",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out GetCompatibilityBatchResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCompatibility sends GET /v1/compatibility/{userId}. Get compatibility
+// with another user.
+func (c *Client) GetCompatibility(ctx context.Context, userID string) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/compatibility/" + url.PathEscape(userID),
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetYikesSummary sends GET /v1/compatibility/{userId}/yikes. Get yikes flags
+// (major incompatibilities).
+func (c *Client) GetYikesSummary(ctx context.Context, userID string) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/compatibility/" + url.PathEscape(userID) + "/yikes",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListGuildQuestionsParams holds the query and header parameters of ListGuildQuestions.
+type ListGuildQuestionsParams struct {
+	IncludeInactive *bool // include_inactive query
+}
+
+func (p *ListGuildQuestionsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.IncludeInactive != nil {
+		query.Set("include_inactive", paramValue(*p.IncludeInactive))
+	}
+	return query, header
+}
+
+// ListGuildQuestions sends GET /v1/guilds/{guildId}/questions. List a guild's
+// custom questions.
+//
+// Guild questions are answered by members only and never affect global
+// compatibility. Organizers can include retired questions.
+func (c *Client) ListGuildQuestions(ctx context.Context, guildID string, params *ListGuildQuestionsParams) (*ListGuildQuestionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/questions",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListGuildQuestionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGuildQuestion sends POST /v1/guilds/{guildId}/questions. Add a guild
+// question.
+func (c *Client) CreateGuildQuestion(ctx context.Context, guildID string, body *GuildQuestionRequest) (*Question, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/questions",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Question
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGuildQuestion sends PATCH /v1/guilds/{guildId}/questions/{questionId}.
+// Update a guild question.
+//
+// Options that members already selected cannot be removed.
+func (c *Client) UpdateGuildQuestion(ctx context.Context, guildID string, questionID string, body *UpdateGuildQuestionRequest) (*Question, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/questions/" + url.PathEscape(questionID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Question
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuildQuestion sends DELETE
+// /v1/guilds/{guildId}/questions/{questionId}. Remove a guild question.
+//
+// Questions that members answered are retired instead of deleted.
+func (c *Client) DeleteGuildQuestion(ctx context.Context, guildID string, questionID string) (*DeleteGuildQuestionResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/questions/" + url.PathEscape(questionID),
+	}
+	var out DeleteGuildQuestionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AnswerGuildQuestion sends POST
+// /v1/guilds/{guildId}/questions/{questionId}/answer. Answer a guild question.
+//
+// Creates or replaces your answer. Visible to you and guild organizers only.
+func (c *Client) AnswerGuildQuestion(ctx context.Context, guildID string, questionID string, body *AnswerGuildQuestionBody) (*GuildAnswer, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/questions/" + url.PathEscape(questionID) + "/answer",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out GuildAnswer
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGuildAnswer sends DELETE
+// /v1/guilds/{guildId}/questions/{questionId}/answer. Withdraw your answer to
+// a guild question.
+func (c *Client) DeleteGuildAnswer(ctx context.Context, guildID string, questionID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/questions/" + url.PathEscape(questionID) + "/answer",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// ListGuildAnswersParams holds the query and header parameters of ListGuildAnswers.
+type ListGuildAnswersParams struct {
+	UserID *string // user_id query
+}
+
+func (p *ListGuildAnswersParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.UserID != nil {
+		query.Set("user_id", paramValue(*p.UserID))
+	}
+	return query, header
+}
+
+// ListGuildAnswers sends GET /v1/guilds/{guildId}/questions/answers. List
+// members' answers to guild questions (organizers only).
+func (c *Client) ListGuildAnswers(ctx context.Context, guildID string, params *ListGuildAnswersParams) (*ListGuildAnswersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/questions/answers",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListGuildAnswersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMyGuildAnswers sends GET /v1/guilds/{guildId}/questions/answers/me. List
+// your answers to a guild's questions.
+func (c *Client) ListMyGuildAnswers(ctx context.Context, guildID string) (*ListMyGuildAnswersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/questions/answers/me",
+	}
+	var out ListMyGuildAnswersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListInterestsParams holds the query and header parameters of ListInterests.
+type ListInterestsParams struct {
+	Category *string // category query
+}
+
+func (p *ListInterestsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Category != nil {
+		query.Set("category", paramValue(*p.Category))
+	}
+	return query, header
+}
+
+// ListInterests sends GET /v1/interests. List all interests.
+func (c *Client) ListInterests(ctx context.Context, params *ListInterestsParams) (*ListInterestsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/interests",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out ListInterestsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInterestCategories sends GET /v1/interests/categories. List interest
+// categories.
+func (c *Client) GetInterestCategories(ctx context.Context) (*GetInterestCategoriesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/interests/categories",
+	}
+	var out GetInterestCategoriesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyInterests sends GET /v1/profile/interests. Get own interests.
+func (c *Client) GetMyInterests(ctx context.Context) (*GetMyInterestsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/interests",
+	}
+	var out GetMyInterestsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddInterest sends POST /v1/profile/interests. Add interest to profile.
+func (c *Client) AddInterest(ctx context.Context, body *AddInterestRequest) (*AddInterestResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/profile/interests",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out AddInterestResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateMyInterest sends PATCH /v1/profile/interests/{interestId}. Update
+// interest settings.
+func (c *Client) UpdateMyInterest(ctx context.Context, interestID string, body *UpdateInterestRequest) error {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/profile/interests/" + url.PathEscape(interestID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// RemoveInterest sends DELETE /v1/profile/interests/{interestId}. Remove
+// interest from profile.
+func (c *Client) RemoveInterest(ctx context.Context, interestID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/profile/interests/" + url.PathEscape(interestID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// FindTeachingMatchesParams holds the query and header parameters of FindTeachingMatches.
+type FindTeachingMatchesParams struct {
+	Limit *int // limit query
+}
+
+func (p *FindTeachingMatchesParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// FindTeachingMatches sends GET /v1/interests/matches/teaching. Find people to
+// teach.
+//
+// Find people who want to learn what you want to teach
+func (c *Client) FindTeachingMatches(ctx context.Context, params *FindTeachingMatchesParams) (*FindTeachingMatchesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/interests/matches/teaching",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out FindTeachingMatchesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindLearningMatchesParams holds the query and header parameters of FindLearningMatches.
+type FindLearningMatchesParams struct {
+	Limit *int // limit query
+}
+
+func (p *FindLearningMatchesParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// FindLearningMatches sends GET /v1/interests/matches/learning. Find teachers.
+//
+// Find people who want to teach what you want to learn
+func (c *Client) FindLearningMatches(ctx context.Context, params *FindLearningMatchesParams) (*FindLearningMatchesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/interests/matches/learning",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out FindLearningMatchesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindSharedInterestsParams holds the query and header parameters of FindSharedInterests.
+type FindSharedInterestsParams struct {
+	Limit *int // limit query
+}
+
+func (p *FindSharedInterestsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// FindSharedInterests sends GET /v1/interests/shared. Find users with shared
+// interests.
+func (c *Client) FindSharedInterests(ctx context.Context, params *FindSharedInterestsParams) (*FindSharedInterestsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/interests/shared",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out FindSharedInterestsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInterestStats sends GET /v1/profile/interests/stats. Get user's interest
+// statistics.
+func (c *Client) GetInterestStats(ctx context.Context) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/interests/stats",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateReview sends POST /v1/reviews. Leave feedback.
+func (c *Client) CreateReview(ctx context.Context, body *CreateReviewRequest) (*Review, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/reviews",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Review
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetReview sends GET /v1/reviews/{reviewId}. Get a specific review.
+func (c *Client) GetReview(ctx context.Context, reviewID string) (*Review, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/reviews/" + url.PathEscape(reviewID),
+	}
+	var out Review
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetReviewsGivenParams holds the query and header parameters of GetReviewsGiven.
+type GetReviewsGivenParams struct {
+	Cursor *string // cursor query
+	Limit  *int    // limit query
+	Offset *int    // offset query
+}
+
+func (p *GetReviewsGivenParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	return query, header
+}
+
+// GetReviewsGiven sends GET /v1/profile/reviews/given. Get reviews you've
+// left.
+func (c *Client) GetReviewsGiven(ctx context.Context, params *GetReviewsGivenParams) (*GetReviewsGivenResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/reviews/given",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetReviewsGivenResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetReviewsReceivedParams holds the query and header parameters of GetReviewsReceived.
+type GetReviewsReceivedParams struct {
+	Cursor *string // cursor query
+	Limit  *int    // limit query
+	Offset *int    // offset query
+}
+
+func (p *GetReviewsReceivedParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Cursor != nil {
+		query.Set("cursor", paramValue(*p.Cursor))
+	}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	return query, header
+}
+
+// GetReviewsReceived sends GET /v1/profile/reviews/received. Get reviews
+// you've received.
+func (c *Client) GetReviewsReceived(ctx context.Context, params *GetReviewsReceivedParams) (*GetReviewsReceivedResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/reviews/received",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetReviewsReceivedResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyReputation sends GET /v1/profile/reputation. Get own reputation.
+func (c *Client) GetMyReputation(ctx context.Context) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/reputation",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetUserReputation sends GET /v1/users/{userId}/reputation. Get another
+// user's reputation.
+func (c *Client) GetUserReputation(ctx context.Context, userID string) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/" + url.PathEscape(userID) + "/reputation",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetPositiveTagsParams holds the query and header parameters of GetPositiveTags.
+type GetPositiveTagsParams struct {
+	IfNoneMatch string // If-None-Match header
+}
+
+func (p *GetPositiveTagsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.IfNoneMatch != "" {
+		header.Set("If-None-Match", p.IfNoneMatch)
+	}
+	return query, header
+}
+
+// GetPositiveTags sends GET /v1/reviews/tags/positive. List positive review
+// tags.
+func (c *Client) GetPositiveTags(ctx context.Context, params *GetPositiveTagsParams) (*GetPositiveTagsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/reviews/tags/positive",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetPositiveTagsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImprovementTagsParams holds the query and header parameters of GetImprovementTags.
+type GetImprovementTagsParams struct {
+	IfNoneMatch string // If-None-Match header
+}
+
+func (p *GetImprovementTagsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.IfNoneMatch != "" {
+		header.Set("If-None-Match", p.IfNoneMatch)
+	}
+	return query, header
+}
+
+// GetImprovementTags sends GET /v1/reviews/tags/improvement. List improvement
+// review tags.
+func (c *Client) GetImprovementTags(ctx context.Context, params *GetImprovementTagsParams) (*GetImprovementTagsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/reviews/tags/improvement",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetImprovementTagsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTrustedUsers sends GET /v1/trust. Get list of trusted users.
+func (c *Client) GetTrustedUsers(ctx context.Context) (*GetTrustedUsersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/trust",
+	}
+	var out GetTrustedUsersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTrustSummary sends GET /v1/trust/{userId}. Get trust summary with a user.
+func (c *Client) GetTrustSummary(ctx context.Context, userID string) (*TrustSummary, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/trust/" + url.PathEscape(userID),
+	}
+	var out TrustSummary
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GrantTrust sends POST /v1/trust/{userId}. Grant trust to another user.
+func (c *Client) GrantTrust(ctx context.Context, userID string) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/trust/" + url.PathEscape(userID),
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RevokeTrust sends DELETE /v1/trust/{userId}. Revoke trust from a user.
+func (c *Client) RevokeTrust(ctx context.Context, userID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/trust/" + url.PathEscape(userID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetTrustProfile sends GET /v1/profile/trust. Get own trust profile.
+func (c *Client) GetTrustProfile(ctx context.Context) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/trust",
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfirmIRL sends POST /v1/irl/{userId}. Confirm IRL meeting with a user.
+func (c *Client) ConfirmIRL(ctx context.Context, userID string, body json.RawMessage) (json.RawMessage, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/irl/" + url.PathEscape(userID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out json.RawMessage
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetIRLConnections sends GET /v1/irl. Get list of IRL connections.
+func (c *Client) GetIRLConnections(ctx context.Context) (*GetIRLConnectionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/irl",
+	}
+	var out GetIRLConnectionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGuildPools sends GET /v1/guilds/{guildId}/pools. List pools in a guild.
+func (c *Client) ListGuildPools(ctx context.Context, guildID string) (*ListGuildPoolsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools",
+	}
+	var out ListGuildPoolsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreatePool sends POST /v1/guilds/{guildId}/pools. Create a new pool.
+func (c *Client) CreatePool(ctx context.Context, guildID string, body *CreatePoolRequest) (*Pool, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Pool
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPool sends GET /v1/guilds/{guildId}/pools/{poolId}. Get pool details.
+func (c *Client) GetPool(ctx context.Context, guildID string, poolID string) (*PoolWithMembers, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID),
+	}
+	var out PoolWithMembers
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdatePool sends PATCH /v1/guilds/{guildId}/pools/{poolId}. Update a pool.
+func (c *Client) UpdatePool(ctx context.Context, guildID string, poolID string, body *UpdatePoolRequest) (*Pool, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Pool
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeletePool sends DELETE /v1/guilds/{guildId}/pools/{poolId}. Delete a pool.
+//
+// Moves the pool to the guild's trash. Organizers can restore it, with its
+// members and match history, for 30 days before it is permanently deleted.
+func (c *Client) DeletePool(ctx context.Context, guildID string, poolID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// JoinPool sends POST /v1/guilds/{guildId}/pools/{poolId}/join. Join a pool.
+func (c *Client) JoinPool(ctx context.Context, guildID string, poolID string, body *JoinPoolRequest) (*PoolMember, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID) + "/join",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PoolMember
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LeavePool sends POST /v1/guilds/{guildId}/pools/{poolId}/leave. Leave a
+// pool.
+func (c *Client) LeavePool(ctx context.Context, guildID string, poolID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID) + "/leave",
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetPoolMembers sends GET /v1/guilds/{guildId}/pools/{poolId}/members. List
+// pool members.
+func (c *Client) GetPoolMembers(ctx context.Context, guildID string, poolID string) (*GetPoolMembersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID) + "/members",
+	}
+	var out GetPoolMembersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdatePoolMembership sends PATCH
+// /v1/guilds/{guildId}/pools/{poolId}/membership. Update membership settings.
+func (c *Client) UpdatePoolMembership(ctx context.Context, guildID string, poolID string, body *UpdateMembershipRequest) (*PoolMember, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID) + "/membership",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PoolMember
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPoolStats sends GET /v1/guilds/{guildId}/pools/{poolId}/stats. Get pool
+// statistics.
+func (c *Client) GetPoolStats(ctx context.Context, guildID string, poolID string) (*PoolStats, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID) + "/stats",
+	}
+	var out PoolStats
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPoolMatchHistoryParams holds the query and header parameters of GetPoolMatchHistory.
+type GetPoolMatchHistoryParams struct {
+	Limit *int // limit query
+}
+
+func (p *GetPoolMatchHistoryParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	return query, header
+}
+
+// GetPoolMatchHistory sends GET /v1/guilds/{guildId}/pools/{poolId}/matches.
+// Get match history.
+func (c *Client) GetPoolMatchHistory(ctx context.Context, guildID string, poolID string, params *GetPoolMatchHistoryParams) (*GetPoolMatchHistoryResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/guilds/" + url.PathEscape(guildID) + "/pools/" + url.PathEscape(poolID) + "/matches",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetPoolMatchHistoryResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPendingMatches sends GET /v1/profile/matches/pending. Get user's pending
+// matches.
+func (c *Client) GetPendingMatches(ctx context.Context) (*GetPendingMatchesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/profile/matches/pending",
+	}
+	var out GetPendingMatchesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateMatch sends PATCH /v1/matches/{matchId}. Update a match (schedule,
+// status).
+func (c *Client) UpdateMatch(ctx context.Context, matchID string, body *UpdateMatchRequest) (*PoolMatch, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/matches/" + url.PathEscape(matchID),
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out PoolMatch
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateReport sends POST /v1/reports. Create a report.
+func (c *Client) CreateReport(ctx context.Context, body *CreateReportRequest) (*Report, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/reports",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Report
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetReport sends GET /v1/reports/{reportId}. Get a report.
+func (c *Client) GetReport(ctx context.Context, reportID string) (*Report, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/reports/" + url.PathEscape(reportID),
+	}
+	var out Report
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPendingReports sends GET /v1/reports/pending. Get pending reports
+// (moderator/admin only).
+func (c *Client) GetPendingReports(ctx context.Context) (*GetPendingReportsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/reports/pending",
+	}
+	var out GetPendingReportsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReviewReport sends PATCH /v1/reports/{reportId}/review. Review a report
+// (moderator/admin only).
+func (c *Client) ReviewReport(ctx context.Context, reportID string, body *ReviewReportRequest) (*Report, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/v1/reports/" + url.PathEscape(reportID) + "/review",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Report
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TakeAction sends POST /v1/moderation/actions. Take a moderation action.
+//
+// Moderators can issue nudges and warnings. Admins can issue suspensions and
+// bans.
+func (c *Client) TakeAction(ctx context.Context, body *CreateModerationActionRequest) (*ModerationAction, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/moderation/actions",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out ModerationAction
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAction sends GET /v1/moderation/actions/{actionId}. Get a moderation
+// action (moderator/admin only).
+func (c *Client) GetAction(ctx context.Context, actionID string) (*ModerationAction, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/moderation/actions/" + url.PathEscape(actionID),
+	}
+	var out ModerationAction
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LiftAction sends POST /v1/moderation/actions/{actionId}/lift. Lift a
+// moderation action (admin only).
+func (c *Client) LiftAction(ctx context.Context, actionID string, body *LiftActionRequest) (*LiftActionResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/moderation/actions/" + url.PathEscape(actionID) + "/lift",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out LiftActionResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserModerationStatus sends GET /v1/moderation/users/{userId}/status. Get
+// user's moderation status.
+//
+// Users can view their own status, moderators/admins can view anyone's
+func (c *Client) GetUserModerationStatus(ctx context.Context, userID string) (*UserModerationStatus, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/moderation/users/" + url.PathEscape(userID) + "/status",
+	}
+	var out UserModerationStatus
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserModerationActions sends GET /v1/moderation/users/{userId}/actions.
+// Get user's moderation actions (moderator/admin only).
+func (c *Client) GetUserModerationActions(ctx context.Context, userID string) (*GetUserModerationActionsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/moderation/users/" + url.PathEscape(userID) + "/actions",
+	}
+	var out GetUserModerationActionsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetModerationStats sends GET /v1/moderation/stats. Get moderation statistics
+// (admin only).
+func (c *Client) GetModerationStats(ctx context.Context) (*ModerationStats, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/moderation/stats",
+	}
+	var out ModerationStats
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBlockedUsers sends GET /v1/blocks. Get blocked users.
+func (c *Client) GetBlockedUsers(ctx context.Context) (*GetBlockedUsersResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/blocks",
+	}
+	var out GetBlockedUsersResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BlockUser sends POST /v1/blocks. Block a user.
+func (c *Client) BlockUser(ctx context.Context, body *CreateBlockRequest) (*Block, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/blocks",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out Block
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnblockUser sends DELETE /v1/blocks/{blockedUserId}. Unblock a user.
+func (c *Client) UnblockUser(ctx context.Context, blockedUserID string) (*UnblockUserResponse, error) {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/blocks/" + url.PathEscape(blockedUserID),
+	}
+	var out UnblockUserResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckBlock sends GET /v1/blocks/{userId}/check. Check if a block exists
+// between users.
+func (c *Client) CheckBlock(ctx context.Context, userID string) (*CheckBlockResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/blocks/" + url.PathEscape(userID) + "/check",
+	}
+	var out CheckBlockResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyResonance sends GET /v1/resonance. Get own resonance score.
+func (c *Client) GetMyResonance(ctx context.Context) (*ResonanceDisplay, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/resonance",
+	}
+	var out ResonanceDisplay
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserResonance sends GET /v1/users/{userId}/resonance. Get another user's
+// resonance score.
+//
+// Only returns total score for privacy
+func (c *Client) GetUserResonance(ctx context.Context, userID string) (*ResonanceDisplay, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/users/" + url.PathEscape(userID) + "/resonance",
+	}
+	var out ResonanceDisplay
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetResonanceLedgerParams holds the query and header parameters of GetResonanceLedger.
+type GetResonanceLedgerParams struct {
+	Limit  *int // limit query
+	Offset *int // offset query
+}
+
+func (p *GetResonanceLedgerParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Limit != nil {
+		query.Set("limit", paramValue(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Set("offset", paramValue(*p.Offset))
+	}
+	return query, header
+}
+
+// GetResonanceLedger sends GET /v1/resonance/ledger. Get point history.
+func (c *Client) GetResonanceLedger(ctx context.Context, params *GetResonanceLedgerParams) (*GetResonanceLedgerResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/resonance/ledger",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetResonanceLedgerResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RecalculateResonance sends POST /v1/resonance/recalculate. Force recalculate
+// resonance score.
+func (c *Client) RecalculateResonance(ctx context.Context) (*ResonanceDisplay, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/resonance/recalculate",
+	}
+	var out ResonanceDisplay
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExplainResonance sends GET /v1/resonance/explain. Explain the scoring
+// system.
+func (c *Client) ExplainResonance(ctx context.Context) (*ResonanceExplainer, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/resonance/explain",
+	}
+	var out ResonanceExplainer
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDevices sends GET /v1/devices. List registered devices.
+func (c *Client) ListDevices(ctx context.Context) (*ListDevicesResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/devices",
+	}
+	var out ListDevicesResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RegisterDevice sends POST /v1/devices. Register a device token.
+func (c *Client) RegisterDevice(ctx context.Context, body *RegisterDeviceRequest) (*DeviceToken, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/devices",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out DeviceToken
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteDevice sends DELETE /v1/devices/{deviceId}. Unregister a device.
+func (c *Client) DeleteDevice(ctx context.Context, deviceID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/v1/devices/" + url.PathEscape(deviceID),
+	}
+	_, err := c.do(ctx, req, nil)
+	return err
+}
+
+// GetClientConfig sends GET /v1/meta/client-config. Get client config.
+//
+// The minimum and current app version for each platform, and the feature flags
+// that are on. Apps below the minimum get 426 Upgrade Required from other
+// routes but can still read this one. Cacheable for five minutes.
+func (c *Client) GetClientConfig(ctx context.Context) (*GetClientConfigResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/meta/client-config",
+	}
+	var out GetClientConfigResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRemoteConfigParams holds the query and header parameters of GetRemoteConfig.
+type GetRemoteConfigParams struct {
+	IfNoneMatch string  // If-None-Match header
+	Platform    *string // platform query
+}
+
+func (p *GetRemoteConfigParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.IfNoneMatch != "" {
+		header.Set("If-None-Match", p.IfNoneMatch)
+	}
+	if p.Platform != nil {
+		query.Set("platform", paramValue(*p.Platform))
+	}
+	return query, header
+}
+
+// GetRemoteConfig sends GET /v1/meta/config. Get remote config.
+//
+// Client tunables such as the default discovery radius, nudge copy variants
+// and feature toggles, so they can change without an app release. Values are
+// built-in defaults overlaid with what admins set for every platform, then
+// with the platform's overrides. The platform comes from the `platform` query
+// parameter or `X-Client-Platform`; without either only the values for every
+// platform are applied. Revalidate with `If-None-Match` on every launch.
+func (c *Client) GetRemoteConfig(ctx context.Context, params *GetRemoteConfigParams) (*GetRemoteConfigResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/meta/config",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetRemoteConfigResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetExperimentAssignmentsParams holds the query and header parameters of GetExperimentAssignments.
+type GetExperimentAssignmentsParams struct {
+	Platform *string // platform query
+}
+
+func (p *GetExperimentAssignmentsParams) values() (url.Values, http.Header) {
+	query, header := url.Values{}, http.Header{}
+	if p.Platform != nil {
+		query.Set("platform", paramValue(*p.Platform))
+	}
+	return query, header
+}
+
+// GetExperimentAssignments sends GET /v1/meta/experiments. Get experiment
+// assignments.
+//
+// The signed-in user's variant of each running A/B experiment on their
+// platform. Variants are chosen by hashing the experiment's salt with the
+// user's ID, so they are the same on every device for as long as the
+// experiment runs. A variant's params override the matching values from
+// `/v1/meta/config`. Each assignment returned is recorded as an exposure in
+// the analytics pipeline, so fetch them when the experiment surfaces are about
+// to be shown.
+func (c *Client) GetExperimentAssignments(ctx context.Context, params *GetExperimentAssignmentsParams) (*GetExperimentAssignmentsResponse, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/v1/meta/experiments",
+	}
+	if params != nil {
+		req.query, req.header = params.values()
+	}
+	var out GetExperimentAssignmentsResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReportClientError sends POST /v1/client-errors. Report a client error.
+//
+// Report a crash or an API-contract mismatch. Signing in is optional; reports
+// sent with a valid access token are attributed to the user. Include the
+// `X-Request-ID` of the requests leading up to the error in `request_ids` so
+// they can be found in the server logs; IDs that aren't valid request IDs are
+// dropped.
+//
+// Reports are sampled, so a report can be accepted without being stored;
+// `stored` in the response says which. Each client address may send a limited
+// number of reports a minute.
+func (c *Client) ReportClientError(ctx context.Context, body *ReportClientErrorRequest) (*ReportClientErrorResponse, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/v1/client-errors",
+	}
+	if body != nil {
+		req.body, req.contentType = body, "application/json"
+	}
+	var out ReportClientErrorResponse
+	if _, err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
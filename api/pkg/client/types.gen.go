@@ -0,0 +1,6042 @@
+// Code generated by go generate ./pkg/client; DO NOT EDIT.
+
+package client
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// HealthResponse is the HealthResponse schema.
+type HealthResponse struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+	Version   string    `json:"version"`
+}
+
+// User is the User schema.
+type User struct {
+	ID            string    `json:"id"`
+	Email         string    `json:"email"`
+	Username      *string   `json:"username,omitempty"`
+	Firstname     *string   `json:"firstname,omitempty"`
+	Lastname      *string   `json:"lastname,omitempty"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedOn     time.Time `json:"created_on"`
+	UpdatedOn     time.Time `json:"updated_on"`
+}
+
+// Identity is the Identity schema.
+type Identity struct {
+	ID                      string    `json:"id"`
+	Provider                string    `json:"provider"`
+	ProviderUserID          string    `json:"provider_user_id"`
+	ProviderEmail           *string   `json:"provider_email,omitempty"`
+	EmailVerifiedByProvider *bool     `json:"email_verified_by_provider,omitempty"`
+	CreatedOn               time.Time `json:"created_on"`
+}
+
+// Passkey is the Passkey schema.
+type Passkey struct {
+	ID string `json:"id"`
+	// User-friendly name like "iPhone 15"
+	Name       string     `json:"name"`
+	CreatedOn  time.Time  `json:"created_on"`
+	LastUsedOn *time.Time `json:"last_used_on,omitempty"`
+}
+
+// UserWithIdentities is the UserWithIdentities schema.
+type UserWithIdentities struct {
+	User       User       `json:"user"`
+	Identities []Identity `json:"identities"`
+	Passkeys   []Passkey  `json:"passkeys"`
+}
+
+// Guild is the Guild schema.
+type Guild struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	// Other sites may embed the guild's upcoming public events
+	EmbedEnabled *bool `json:"embed_enabled,omitempty"`
+	// Incremented on every update; send it back on PATCH
+	Version   *int      `json:"version,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+// Member is the Member schema.
+type Member struct {
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	Email string  `json:"email"`
+	User  *string `json:"user,omitempty"`
+}
+
+// GuildData is the GuildData schema.
+type GuildData struct {
+	Guild      Guild      `json:"guild"`
+	Members    []Member   `json:"members"`
+	People     []Person   `json:"people"`
+	Activities []Activity `json:"activities"`
+}
+
+// Person is the Person schema.
+type Person struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Nickname  *string   `json:"nickname,omitempty"`
+	Birthday  *string   `json:"birthday,omitempty"`
+	Notes     *string   `json:"notes,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+// Activity is the Activity schema.
+type Activity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Icon string `json:"icon"`
+	// Seconds until warning threshold
+	Warn float64 `json:"warn"`
+	// Seconds until critical threshold
+	Critical  float64   `json:"critical"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+// CreatePersonRequest is the CreatePersonRequest schema.
+type CreatePersonRequest struct {
+	Name     string  `json:"name"`
+	Nickname *string `json:"nickname,omitempty"`
+	Birthday *string `json:"birthday,omitempty"`
+	Notes    *string `json:"notes,omitempty"`
+}
+
+// UpdatePersonRequest is the UpdatePersonRequest schema.
+type UpdatePersonRequest struct {
+	Name     *string `json:"name,omitempty"`
+	Nickname *string `json:"nickname,omitempty"`
+	Birthday *string `json:"birthday,omitempty"`
+	Notes    *string `json:"notes,omitempty"`
+}
+
+// CreateActivityRequest is the CreateActivityRequest schema.
+type CreateActivityRequest struct {
+	Name     string  `json:"name"`
+	Icon     string  `json:"icon"`
+	Warn     float64 `json:"warn"`
+	Critical float64 `json:"critical"`
+}
+
+// Timer is the Timer schema.
+type Timer struct {
+	ID        string    `json:"id"`
+	ResetDate time.Time `json:"reset_date"`
+	Enabled   bool      `json:"enabled"`
+	// Push notifications enabled
+	Push      bool      `json:"push"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+// CreateTimerRequest is the CreateTimerRequest schema.
+type CreateTimerRequest struct {
+	ActivityID string `json:"activity_id"`
+	Enabled    *bool  `json:"enabled,omitempty"`
+	Push       *bool  `json:"push,omitempty"`
+}
+
+// UpdateTimerRequest is the UpdateTimerRequest schema.
+type UpdateTimerRequest struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	Push    *bool `json:"push,omitempty"`
+}
+
+// RegisterRequest is the RegisterRequest schema.
+type RegisterRequest struct {
+	Email string `json:"email"`
+	// Must also meet the server's strength score and not appear in a known
+	// data breach
+	Password  string  `json:"password"`
+	Firstname *string `json:"firstname,omitempty"`
+	Lastname  *string `json:"lastname,omitempty"`
+}
+
+// PasswordStrength is the PasswordStrength schema.
+//
+// Estimated strength of a rejected password, with guidance on making it
+// stronger
+type PasswordStrength struct {
+	// 0 is too guessable, 4 is very unguessable
+	Score       int      `json:"score"`
+	Warning     *string  `json:"warning,omitempty"`
+	Suggestions []string `json:"suggestions,omitempty"`
+	// The password appears in a known data breach
+	Breached *bool `json:"breached,omitempty"`
+	// Times the password has been seen in breaches
+	BreachCount *int `json:"breach_count,omitempty"`
+}
+
+// LoginRequest is the LoginRequest schema.
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// OAuthRequest is the OAuthRequest schema.
+type OAuthRequest struct {
+	// Authorization code from OAuth provider
+	Code string `json:"code"`
+	// PKCE code verifier
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// TokenResponse is the TokenResponse schema.
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	// DPoP when the tokens are bound to the key that signed the request's
+	// proof
+	TokenType string `json:"token_type"`
+	// Seconds until access token expires
+	ExpiresIn int `json:"expires_in"`
+}
+
+// RefreshRequest is the RefreshRequest schema.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ChangePasswordRequest is the ChangePasswordRequest schema.
+type ChangePasswordRequest struct {
+	// Required unless the caller signed in within the last 5 minutes
+	CurrentPassword *string `json:"current_password,omitempty"`
+	NewPassword     string  `json:"new_password"`
+}
+
+// EmailChangeRequest is the EmailChangeRequest schema.
+type EmailChangeRequest struct {
+	NewEmail string `json:"new_email"`
+	// Required unless the caller signed in within the last 5 minutes
+	CurrentPassword *string `json:"current_password,omitempty"`
+}
+
+// EmailChange is the EmailChange schema.
+//
+// A pending email change, waiting for the new address to be verified
+type EmailChange struct {
+	NewEmail *string `json:"new_email,omitempty"`
+	// Started with a passkey by a user who lost their inbox
+	Recovery *bool `json:"recovery,omitempty"`
+	// On recovery changes, when the verification link starts working. Until
+	// then the previous address can cancel.
+	EffectiveOn *time.Time `json:"effective_on,omitempty"`
+	// When the verification link stops working
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+	CreatedOn *time.Time `json:"created_on,omitempty"`
+}
+
+// EmailVerifyRequest is the EmailVerifyRequest schema.
+type EmailVerifyRequest struct {
+	// Token from the emailed verification link
+	Token string `json:"token"`
+}
+
+// PasskeyRegistrationStartResponse is the PasskeyRegistrationStartResponse
+// schema.
+type PasskeyRegistrationStartResponse struct {
+	Challenge        []byte                                            `json:"challenge"`
+	Rp               PasskeyRegistrationStartResponseRp                `json:"rp"`
+	User             PasskeyRegistrationStartResponseUser              `json:"user"`
+	PubKeyCredParams []PasskeyRegistrationStartResponsePubKeyCredParam `json:"pubKeyCredParams"`
+}
+
+// PasskeyRegistrationStartResponseRp is the rp property of
+// PasskeyRegistrationStartResponse.
+type PasskeyRegistrationStartResponseRp struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// PasskeyRegistrationStartResponseUser is the user property of
+// PasskeyRegistrationStartResponse.
+type PasskeyRegistrationStartResponseUser struct {
+	ID          []byte  `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// PasskeyRegistrationStartResponsePubKeyCredParam is an element of the
+// pubKeyCredParams property of PasskeyRegistrationStartResponse.
+type PasskeyRegistrationStartResponsePubKeyCredParam struct {
+	Type *string `json:"type,omitempty"`
+	Alg  *int    `json:"alg,omitempty"`
+}
+
+// PasskeyRegistrationFinishRequest is the PasskeyRegistrationFinishRequest
+// schema.
+type PasskeyRegistrationFinishRequest struct {
+	// WebAuthn credential response
+	Credential map[string]any `json:"credential"`
+	// User-friendly name for this passkey
+	Name string `json:"name"`
+}
+
+// PasskeyLoginStartRequest is the PasskeyLoginStartRequest schema.
+type PasskeyLoginStartRequest struct {
+	// Optional email hint; ignored for conditional mediation
+	Email *string `json:"email,omitempty"`
+	// Start a passkey autofill login, offering discoverable passkeys without
+	// asking for an email
+	Mediation *string `json:"mediation,omitempty"`
+}
+
+// PasskeyLoginStartResponse is the PasskeyLoginStartResponse schema.
+type PasskeyLoginStartResponse struct {
+	Challenge        []byte                                     `json:"challenge"`
+	AllowCredentials []PasskeyLoginStartResponseAllowCredential `json:"allowCredentials"`
+	// Set when the login was started for autofill
+	Mediation *string `json:"mediation,omitempty"`
+}
+
+// PasskeyLoginStartResponseAllowCredential is an element of the
+// allowCredentials property of PasskeyLoginStartResponse.
+type PasskeyLoginStartResponseAllowCredential struct {
+	ID   []byte  `json:"id,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// PasskeyLoginFinishRequest is the PasskeyLoginFinishRequest schema.
+type PasskeyLoginFinishRequest struct {
+	// WebAuthn assertion response
+	Credential map[string]any `json:"credential"`
+}
+
+// CrossDeviceStartResponse is the CrossDeviceStartResponse schema.
+type CrossDeviceStartResponse struct {
+	// Shown to the approving device, typically as a QR code
+	ID string `json:"id"`
+	// Kept by the initiating device to collect the session
+	PollToken string    `json:"poll_token"`
+	ExpiresOn time.Time `json:"expires_on"`
+	// Seconds to wait between polls
+	Interval int `json:"interval"`
+}
+
+// CrossDevicePollRequest is the CrossDevicePollRequest schema.
+type CrossDevicePollRequest struct {
+	PollToken string `json:"poll_token"`
+}
+
+// CrossDevicePollResponse is the CrossDevicePollResponse schema.
+type CrossDevicePollResponse struct {
+	Status string         `json:"status"`
+	User   *User          `json:"user,omitempty"`
+	Token  *TokenResponse `json:"token,omitempty"`
+}
+
+// StartDevicePairingRequest is the StartDevicePairingRequest schema.
+type StartDevicePairingRequest struct {
+	// Shown on the approving phone
+	DeviceName *string `json:"device_name,omitempty"`
+}
+
+// DevicePairingStart is the DevicePairingStart schema.
+type DevicePairingStart struct {
+	ID string `json:"id"`
+	// Signed, short-lived code to show as a QR code
+	Payload string `json:"payload"`
+	// Kept by the shared device to collect the session
+	PollToken string    `json:"poll_token"`
+	ExpiresOn time.Time `json:"expires_on"`
+	// Seconds to wait between polls
+	Interval int `json:"interval"`
+}
+
+// PollDevicePairingRequest is the PollDevicePairingRequest schema.
+type PollDevicePairingRequest struct {
+	PollToken string `json:"poll_token"`
+}
+
+// ApproveDeviceRequest is the ApproveDeviceRequest schema.
+type ApproveDeviceRequest struct {
+	// Payload read from the QR code
+	Payload string `json:"payload"`
+}
+
+// DevicePairing is the DevicePairing schema.
+type DevicePairing struct {
+	ID         string     `json:"id"`
+	DeviceName *string    `json:"device_name,omitempty"`
+	ApprovedOn *time.Time `json:"approved_on,omitempty"`
+	ExpiresOn  time.Time  `json:"expires_on"`
+	CreatedOn  time.Time  `json:"created_on"`
+}
+
+// PasskeyRecoverRequest is the PasskeyRecoverRequest schema.
+type PasskeyRecoverRequest struct {
+	// WebAuthn assertion response to a challenge from passkey login/start
+	Credential map[string]any `json:"credential"`
+	NewEmail   string         `json:"new_email"`
+}
+
+// ProblemDetails is the ProblemDetails schema.
+type ProblemDetails struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   *string      `json:"detail,omitempty"`
+	Instance *string      `json:"instance,omitempty"`
+	Errors   []FieldError `json:"errors,omitempty"`
+	Code     *int         `json:"code,omitempty"`
+	Limit    *int         `json:"limit,omitempty"`
+	Current  *int         `json:"current,omitempty"`
+	// The resource's current state, on version conflicts (code 3004)
+	Resource map[string]any `json:"resource,omitempty"`
+	// The user's clashing commitments, on schedule conflicts (code 3005)
+	Conflicts []CommitmentConflict `json:"conflicts,omitempty"`
+	// What to fill in next, on incomplete profiles (code 2003)
+	NextStep *CompletenessNextStep `json:"next_step,omitempty"`
+	// Strength guidance, on weak or breached passwords
+	Password *PasswordStrength `json:"password,omitempty"`
+	// When RSVPs open for the caller, early access included, on early RSVPs
+	// (code 3006)
+	OpensAt *time.Time `json:"opens_at,omitempty"`
+	// Existing guilds or events the new one looks like, on possible duplicates
+	// (code 3007)
+	Duplicates []DuplicateCandidate `json:"duplicates,omitempty"`
+	// The supported versions and where to update, on obsolete apps (code 4004)
+	Upgrade *UpgradeRequired `json:"upgrade,omitempty"`
+}
+
+// FieldError is the FieldError schema.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// CommitmentConflict is the CommitmentConflict schema.
+type CommitmentConflict struct {
+	// adjacent_rideshare flags rides within an hour of the new commitment
+	Kind  string    `json:"kind"`
+	ID    string    `json:"id"`
+	Title *string   `json:"title,omitempty"`
+	Start time.Time `json:"start"`
+	// Assumed two hours after start for events and hangouts without an end
+	End time.Time `json:"end"`
+}
+
+// CompletenessNextStep is the CompletenessNextStep schema.
+//
+// The missing item worth the most points; absent when the profile is complete
+type CompletenessNextStep struct {
+	Item   *string `json:"item,omitempty"`
+	Prompt *string `json:"prompt,omitempty"`
+	// Points gained by completing the item
+	Gain *int `json:"gain,omitempty"`
+}
+
+// DuplicateCandidate is the DuplicateCandidate schema.
+type DuplicateCandidate struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+	// The guild's name or the event's title
+	Name string `json:"name"`
+	// How alike the names are, ignoring case, punctuation and word order
+	Similarity float64 `json:"similarity"`
+	// Events only
+	StartTime *time.Time `json:"start_time,omitempty"`
+	// Events only
+	City *string `json:"city,omitempty"`
+}
+
+// UpgradeRequired is the UpgradeRequired schema.
+//
+// Sent in the `upgrade` member of 426 Upgrade Required problems
+type UpgradeRequired struct {
+	Platform string `json:"platform"`
+	// The X-App-Version the app sent
+	Version        string  `json:"version"`
+	MinimumVersion string  `json:"minimum_version"`
+	CurrentVersion *string `json:"current_version,omitempty"`
+	UpdateURL      *string `json:"update_url,omitempty"`
+}
+
+// PaginationInfo is the PaginationInfo schema.
+type PaginationInfo struct {
+	// Cursor for the next page, when there is one
+	Cursor *string `json:"cursor,omitempty"`
+	// Cursor for the page before, when there is one
+	PrevCursor *string `json:"prev_cursor,omitempty"`
+	HasMore    *bool   `json:"has_more,omitempty"`
+}
+
+// DataResponse is the DataResponse schema.
+type DataResponse struct {
+	Data    map[string]any    `json:"data"`
+	Links   map[string]string `json:"_links,omitempty"`
+	Actions *Affordances      `json:"_actions,omitempty"`
+}
+
+// Affordances is the Affordances schema.
+//
+// What the caller may do to the resource, worked out with the same checks the
+// server applies when they try. An allowed action also gets a link in _links
+// to the endpoint that performs it.
+type Affordances struct {
+	CanEdit   *bool `json:"can_edit,omitempty"`
+	CanCancel *bool `json:"can_cancel,omitempty"`
+	CanRsvp   *bool `json:"can_rsvp,omitempty"`
+	CanVote   *bool `json:"can_vote,omitempty"`
+}
+
+// CollectionResponse is the CollectionResponse schema.
+type CollectionResponse struct {
+	Data       []map[string]any  `json:"data"`
+	Pagination *PaginationInfo   `json:"pagination,omitempty"`
+	Links      map[string]string `json:"_links,omitempty"`
+}
+
+// Notification is the Notification schema.
+type Notification struct {
+	ID string `json:"id"`
+	// The SSE event type, such as nudge or event.reminder
+	Type string `json:"type"`
+	// The SSE event payload
+	Data      map[string]any `json:"data"`
+	CreatedOn time.Time      `json:"created_on"`
+}
+
+// NotificationPoll is the NotificationPoll schema.
+type NotificationPoll struct {
+	Notifications []Notification `json:"notifications"`
+	// Pass as since on the next poll
+	Cursor string `json:"cursor"`
+	// Some notifications after since were discarded; refetch state
+	Truncated bool `json:"truncated"`
+}
+
+// TrustRating is the TrustRating schema.
+type TrustRating struct {
+	ID         string `json:"id"`
+	RaterID    string `json:"rater_id"`
+	RateeID    string `json:"ratee_id"`
+	AnchorType string `json:"anchor_type"`
+	AnchorID   string `json:"anchor_id"`
+	TrustLevel string `json:"trust_level"`
+	// Required review (public for trust, admin-only for distrust)
+	TrustReview      string     `json:"trust_review"`
+	ReviewVisibility *string    `json:"review_visibility,omitempty"`
+	CreatedOn        time.Time  `json:"created_on"`
+	UpdatedOn        *time.Time `json:"updated_on,omitempty"`
+}
+
+// TrustRatingWithEndorsements is the TrustRatingWithEndorsements schema.
+type TrustRatingWithEndorsements struct {
+}
+
+// CreateTrustRatingRequest is the CreateTrustRatingRequest schema.
+type CreateTrustRatingRequest struct {
+	RateeID     string `json:"ratee_id"`
+	AnchorType  string `json:"anchor_type"`
+	AnchorID    string `json:"anchor_id"`
+	TrustLevel  string `json:"trust_level"`
+	TrustReview string `json:"trust_review"`
+}
+
+// UpdateTrustRatingRequest is the UpdateTrustRatingRequest schema.
+type UpdateTrustRatingRequest struct {
+	TrustLevel  *string `json:"trust_level,omitempty"`
+	TrustReview *string `json:"trust_review,omitempty"`
+}
+
+// TrustEndorsement is the TrustEndorsement schema.
+type TrustEndorsement struct {
+	ID              string    `json:"id"`
+	TrustRatingID   string    `json:"trust_rating_id"`
+	EndorserID      string    `json:"endorser_id"`
+	EndorsementType string    `json:"endorsement_type"`
+	Note            *string   `json:"note,omitempty"`
+	CreatedOn       time.Time `json:"created_on"`
+}
+
+// CreateEndorsementRequest is the CreateEndorsementRequest schema.
+type CreateEndorsementRequest struct {
+	EndorsementType string  `json:"endorsement_type"`
+	Note            *string `json:"note,omitempty"`
+}
+
+// TrustAggregate is the TrustAggregate schema.
+type TrustAggregate struct {
+	UserID            *string `json:"user_id,omitempty"`
+	TrustCount        *int    `json:"trust_count,omitempty"`
+	DistrustCount     *int    `json:"distrust_count,omitempty"`
+	TotalEndorsements *int    `json:"total_endorsements,omitempty"`
+}
+
+// DistrustSignal is the DistrustSignal schema.
+type DistrustSignal struct {
+	UserID          *string       `json:"user_id,omitempty"`
+	DistrustCount   *int          `json:"distrust_count,omitempty"`
+	RecentDistrusts []TrustRating `json:"recent_distrusts,omitempty"`
+}
+
+// RoleCatalog is the RoleCatalog schema.
+type RoleCatalog struct {
+	ID          string    `json:"id"`
+	ScopeType   string    `json:"scope_type"`
+	ScopeID     string    `json:"scope_id"`
+	RoleType    string    `json:"role_type"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	Icon        *string   `json:"icon,omitempty"`
+	IsActive    bool      `json:"is_active"`
+	CreatedBy   *string   `json:"created_by,omitempty"`
+	CreatedOn   time.Time `json:"created_on"`
+}
+
+// CreateRoleCatalogRequest is the CreateRoleCatalogRequest schema.
+type CreateRoleCatalogRequest struct {
+	RoleType    string  `json:"role_type"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+}
+
+// UpdateRoleCatalogRequest is the UpdateRoleCatalogRequest schema.
+type UpdateRoleCatalogRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	IsActive    *bool   `json:"is_active,omitempty"`
+}
+
+// RideshareRole is the RideshareRole schema.
+type RideshareRole struct {
+	ID            string    `json:"id"`
+	RideshareID   string    `json:"rideshare_id"`
+	CatalogRoleID *string   `json:"catalog_role_id,omitempty"`
+	Name          string    `json:"name"`
+	Description   *string   `json:"description,omitempty"`
+	MaxSlots      int       `json:"max_slots"`
+	FilledSlots   int       `json:"filled_slots"`
+	SortOrder     *int      `json:"sort_order,omitempty"`
+	CreatedBy     *string   `json:"created_by,omitempty"`
+	CreatedOn     time.Time `json:"created_on"`
+}
+
+// RideshareRoleAssignment is the RideshareRoleAssignment schema.
+type RideshareRoleAssignment struct {
+	ID          string    `json:"id"`
+	RideshareID string    `json:"rideshare_id"`
+	RoleID      string    `json:"role_id"`
+	UserID      string    `json:"user_id"`
+	Note        *string   `json:"note,omitempty"`
+	Status      string    `json:"status"`
+	AssignedOn  time.Time `json:"assigned_on"`
+}
+
+// RideshareRoleWithAssignments is the RideshareRoleWithAssignments schema.
+type RideshareRoleWithAssignments struct {
+	Role        *RideshareRole            `json:"role,omitempty"`
+	Assignments []RideshareRoleAssignment `json:"assignments,omitempty"`
+	IsFull      *bool                     `json:"is_full,omitempty"`
+	// -1 means unlimited
+	SpotsLeft *int `json:"spots_left,omitempty"`
+}
+
+// CreateRideshareRoleRequest is the CreateRideshareRoleRequest schema.
+type CreateRideshareRoleRequest struct {
+	CatalogRoleID *string `json:"catalog_role_id,omitempty"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description,omitempty"`
+	MaxSlots      *int    `json:"max_slots,omitempty"`
+}
+
+// UpdateRideshareRoleRequest is the UpdateRideshareRoleRequest schema.
+type UpdateRideshareRoleRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	MaxSlots    *int    `json:"max_slots,omitempty"`
+}
+
+// EventRole is the EventRole schema.
+type EventRole struct {
+	ID            string  `json:"id"`
+	EventID       string  `json:"event_id"`
+	CatalogRoleID *string `json:"catalog_role_id,omitempty"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description,omitempty"`
+	MaxSlots      int     `json:"max_slots"`
+	FilledSlots   int     `json:"filled_slots"`
+	// direct roles are taken on assign; approval roles take nominations that a
+	// host reviews
+	AssignmentMode *string `json:"assignment_mode,omitempty"`
+	// Hosts are alerted while a critical role is unfilled within 3 days of the
+	// event
+	IsCritical *bool `json:"is_critical,omitempty"`
+}
+
+// Vote is the Vote schema.
+type Vote struct {
+	ID        string `json:"id"`
+	ScopeType string `json:"scope_type"`
+	// Guild ID if scope_type is guild
+	ScopeID     *string   `json:"scope_id,omitempty"`
+	CreatedBy   string    `json:"created_by"`
+	Title       string    `json:"title"`
+	Description *string   `json:"description,omitempty"`
+	VoteType    string    `json:"vote_type"`
+	OpensAt     time.Time `json:"opens_at"`
+	ClosesAt    time.Time `json:"closes_at"`
+	// Scheduled votes are only visible to their creator until publish_at
+	Status string `json:"status"`
+	// When a scheduled vote is published
+	PublishAt         *time.Time `json:"publish_at,omitempty"`
+	ResultsVisibility *string    `json:"results_visibility,omitempty"`
+	// For multi_select votes
+	MaxOptionsSelectable *int `json:"max_options_selectable,omitempty"`
+	// Creator opt-out of closing reminders to members who haven't voted
+	RemindersDisabled *bool      `json:"reminders_disabled,omitempty"`
+	BallotCount       *int       `json:"ballot_count,omitempty"`
+	CreatedOn         time.Time  `json:"created_on"`
+	UpdatedOn         *time.Time `json:"updated_on,omitempty"`
+}
+
+// VoteOption is the VoteOption schema.
+type VoteOption struct {
+	ID                string     `json:"id"`
+	VoteID            string     `json:"vote_id"`
+	OptionText        string     `json:"option_text"`
+	OptionDescription *string    `json:"option_description,omitempty"`
+	SortOrder         int        `json:"sort_order"`
+	CreatedBy         *string    `json:"created_by,omitempty"`
+	CreatedOn         *time.Time `json:"created_on,omitempty"`
+}
+
+// VoteWithDetails is the VoteWithDetails schema.
+type VoteWithDetails struct {
+	Vote      *Vote        `json:"vote,omitempty"`
+	Options   []VoteOption `json:"options,omitempty"`
+	HasVoted  *bool        `json:"has_voted,omitempty"`
+	CanVote   *bool        `json:"can_vote,omitempty"`
+	CanManage *bool        `json:"can_manage,omitempty"`
+}
+
+// CreateVoteRequest is the CreateVoteRequest schema.
+type CreateVoteRequest struct {
+	ScopeType string `json:"scope_type"`
+	// Required if scope_type is guild
+	ScopeID           *string   `json:"scope_id,omitempty"`
+	Title             string    `json:"title"`
+	Description       *string   `json:"description,omitempty"`
+	VoteType          string    `json:"vote_type"`
+	OpensAt           time.Time `json:"opens_at"`
+	ClosesAt          time.Time `json:"closes_at"`
+	ResultsVisibility *string   `json:"results_visibility,omitempty"`
+	// Required for multi_select
+	MaxOptionsSelectable *int `json:"max_options_selectable,omitempty"`
+	// Skip the reminder sent to non-voters 24 hours before close
+	RemindersDisabled *bool `json:"reminders_disabled,omitempty"`
+	// Keep the vote hidden and publish it at this time (no later than
+	// opens_at)
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+}
+
+// UpdateVoteRequest is the UpdateVoteRequest schema.
+type UpdateVoteRequest struct {
+	Title             *string    `json:"title,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+	OpensAt           *time.Time `json:"opens_at,omitempty"`
+	ClosesAt          *time.Time `json:"closes_at,omitempty"`
+	ResultsVisibility *string    `json:"results_visibility,omitempty"`
+	// Can also be changed while the vote is open
+	RemindersDisabled *bool `json:"reminders_disabled,omitempty"`
+}
+
+// CreateVoteOptionRequest is the CreateVoteOptionRequest schema.
+type CreateVoteOptionRequest struct {
+	OptionText        string  `json:"option_text"`
+	OptionDescription *string `json:"option_description,omitempty"`
+	SortOrder         *int    `json:"sort_order,omitempty"`
+}
+
+// UpdateVoteOptionRequest is the UpdateVoteOptionRequest schema.
+type UpdateVoteOptionRequest struct {
+	OptionText        *string `json:"option_text,omitempty"`
+	OptionDescription *string `json:"option_description,omitempty"`
+	SortOrder         *int    `json:"sort_order,omitempty"`
+}
+
+// VoteBallot is the VoteBallot schema.
+type VoteBallot struct {
+	ID            string                   `json:"id"`
+	VoteID        string                   `json:"vote_id"`
+	VoterUserID   string                   `json:"voter_user_id"`
+	VoterSnapshot *VoteBallotVoterSnapshot `json:"voter_snapshot,omitempty"`
+	// Format varies by vote_type: - fptp: { "option_id": "..." } -
+	// ranked_choice: { "rankings": ["opt1", "opt2", ...] } - approval: {
+	// "approved_options": ["opt1", "opt2"] } - multi_select: {
+	// "selected_options": ["opt1", "opt2"] }
+	BallotData map[string]any `json:"ballot_data"`
+	CreatedOn  time.Time      `json:"created_on"`
+}
+
+// VoteBallotVoterSnapshot is the voter_snapshot property of VoteBallot.
+type VoteBallotVoterSnapshot struct {
+	Username    *string `json:"username,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+}
+
+// CastBallotRequest is the CastBallotRequest schema.
+//
+// Send the field that matches the vote's type: option_id for fptp, rankings
+// for ranked_choice, selected_options for approval and multi_select.
+// is_abstain casts an abstention where the vote allows it.
+type CastBallotRequest struct {
+	OptionID *string `json:"option_id,omitempty"`
+	// Option IDs, most preferred first
+	Rankings        []string `json:"rankings,omitempty"`
+	SelectedOptions []string `json:"selected_options,omitempty"`
+	IsAbstain       *bool    `json:"is_abstain,omitempty"`
+}
+
+// VoteResults is the VoteResults schema.
+type VoteResults struct {
+	VoteID        *string                   `json:"vote_id,omitempty"`
+	VoteType      *string                   `json:"vote_type,omitempty"`
+	TotalBallots  *int                      `json:"total_ballots,omitempty"`
+	Winner        *VoteOption               `json:"winner,omitempty"`
+	OptionResults []VoteResultsOptionResult `json:"option_results,omitempty"`
+	// For ranked_choice only - elimination rounds
+	Rounds []VoteResultsRound `json:"rounds,omitempty"`
+}
+
+// VoteResultsOptionResult is an element of the option_results property of
+// VoteResults.
+type VoteResultsOptionResult struct {
+	Option     *VoteOption `json:"option,omitempty"`
+	Count      *int        `json:"count,omitempty"`
+	Percentage *float64    `json:"percentage,omitempty"`
+}
+
+// VoteResultsRound is an element of the rounds property of VoteResults.
+type VoteResultsRound struct {
+	RoundNumber *int           `json:"round_number,omitempty"`
+	Eliminated  *string        `json:"eliminated,omitempty"`
+	Standings   map[string]any `json:"standings,omitempty"`
+}
+
+// Adventure is the Adventure schema.
+type Adventure struct {
+	ID            string `json:"id"`
+	OrganizerType string `json:"organizer_type"`
+	// Guild or user ID
+	OrganizerID string `json:"organizer_id"`
+	// The specific user organizing (within guild or personally)
+	OrganizerUserID *string `json:"organizer_user_id,omitempty"`
+	// Deprecated - use organizer_type/organizer_id
+	GuildID      *string    `json:"guild_id,omitempty"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description,omitempty"`
+	Status       string     `json:"status"`
+	Visibility   string     `json:"visibility"`
+	FreezeReason *string    `json:"freeze_reason,omitempty"`
+	FrozenOn     *time.Time `json:"frozen_on,omitempty"`
+	CreatedByID  *string    `json:"created_by_id,omitempty"`
+	CreatedOn    time.Time  `json:"created_on"`
+	UpdatedOn    *time.Time `json:"updated_on,omitempty"`
+}
+
+// CreateAdventureRequest is the CreateAdventureRequest schema.
+type CreateAdventureRequest struct {
+	OrganizerType *string `json:"organizer_type,omitempty"`
+	// Required if organizer_type is guild
+	GuildID     *string `json:"guild_id,omitempty"`
+	Title       string  `json:"title"`
+	Description *string `json:"description,omitempty"`
+	Visibility  *string `json:"visibility,omitempty"`
+}
+
+// UpdateAdventureRequest is the UpdateAdventureRequest schema.
+type UpdateAdventureRequest struct {
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Status      *string `json:"status,omitempty"`
+	Visibility  *string `json:"visibility,omitempty"`
+}
+
+// AdventureAdmission is the AdventureAdmission schema.
+type AdventureAdmission struct {
+	ID              string     `json:"id"`
+	AdventureID     string     `json:"adventure_id"`
+	UserID          string     `json:"user_id"`
+	Status          string     `json:"status"`
+	RequestedBy     string     `json:"requested_by"`
+	InvitedByID     *string    `json:"invited_by_id,omitempty"`
+	RejectionReason *string    `json:"rejection_reason,omitempty"`
+	RequestedOn     time.Time  `json:"requested_on"`
+	DecidedOn       *time.Time `json:"decided_on,omitempty"`
+}
+
+// UnauthorizedError is the UnauthorizedError schema.
+type UnauthorizedError ProblemDetails
+
+// NotFoundError is the NotFoundError schema.
+type NotFoundError ProblemDetails
+
+// ValidationError is the ValidationError schema.
+type ValidationError ProblemDetails
+
+// VersionConflictError is the VersionConflictError schema.
+//
+// The update was based on a stale version; resource holds the current state
+type VersionConflictError ProblemDetails
+
+// JSONPatch is the JSONPatch schema.
+//
+// RFC 6902 JSON Patch. add, replace and remove operate on top-level fields and
+// behave like the equivalent merge patch; test is only supported on /version
+// and acts as the version precondition.
+type JSONPatch []JSONPatchOperation
+
+// JSONPatchOperation is the JSONPatchOperation schema.
+type JSONPatchOperation struct {
+	Op string `json:"op"`
+	// JSON pointer to a top-level field, like /title
+	Path string `json:"path"`
+	// New value for add and replace, expected version for test
+	Value map[string]any `json:"value,omitempty"`
+}
+
+// DiscoveryResponse is the DiscoveryResponse schema.
+type DiscoveryResponse struct {
+	Data       []DiscoveryResult `json:"data,omitempty"`
+	Pagination *PaginationInfo   `json:"pagination,omitempty"`
+}
+
+// DiscoveryResult is the DiscoveryResult schema.
+type DiscoveryResult struct {
+	UserID      *string  `json:"user_id,omitempty"`
+	Username    *string  `json:"username,omitempty"`
+	DisplayName *string  `json:"display_name,omitempty"`
+	Bio         *string  `json:"bio,omitempty"`
+	DistanceKm  *float64 `json:"distance_km,omitempty"`
+	// 0-100 score based on questionnaire answers
+	CompatibilityScore *float64 `json:"compatibility_score,omitempty"`
+	SharedInterests    []string `json:"shared_interests,omitempty"`
+	// Languages both people speak; each adds to the match score
+	SharedLanguages []string `json:"shared_languages,omitempty"`
+	MutualGuilds    []string `json:"mutual_guilds,omitempty"`
+}
+
+// TeachLearnMatch is the TeachLearnMatch schema.
+type TeachLearnMatch struct {
+	UserID      *string   `json:"user_id,omitempty"`
+	Username    *string   `json:"username,omitempty"`
+	DisplayName *string   `json:"display_name,omitempty"`
+	Interest    *Interest `json:"interest,omitempty"`
+	TheirLevel  *string   `json:"their_level,omitempty"`
+	YourLevel   *string   `json:"your_level,omitempty"`
+	DistanceKm  *float64  `json:"distance_km,omitempty"`
+}
+
+// Interest is the Interest schema.
+type Interest struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Category    string  `json:"category"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	IsActive    bool    `json:"is_active"`
+}
+
+// Event is the Event schema.
+type Event struct {
+	ID          string   `json:"id"`
+	GuildID     string   `json:"guild_id"`
+	HostID      string   `json:"host_id"`
+	Title       string   `json:"title"`
+	Description *string  `json:"description,omitempty"`
+	Location    *string  `json:"location,omitempty"`
+	LocationLat *float64 `json:"location_lat,omitempty"`
+	LocationLng *float64 `json:"location_lng,omitempty"`
+	// The saved guild venue the location was copied from
+	VenueID   *string    `json:"venue_id,omitempty"`
+	StartTime time.Time  `json:"start_time"`
+	EndTime   *time.Time `json:"end_time,omitempty"`
+	// null means unlimited
+	Capacity  *int `json:"capacity,omitempty"`
+	RsvpCount *int `json:"rsvp_count,omitempty"`
+	// Seats are tickets; guests hold one before they RSVP
+	Ticketed *bool `json:"ticketed,omitempty"`
+	// Price of a ticket in the currency's minor unit, such as cents; 0 is free
+	TicketPrice *int `json:"ticket_price,omitempty"`
+	// Required when ticket_price is set
+	Currency *string `json:"currency,omitempty"`
+	// When guests who cancel are refunded - until the event starts, until 24
+	// hours before, or never. Hosts cancelling or declining always refund.
+	RefundPolicy *string `json:"refund_policy,omitempty"`
+	// Percentages off a guild event's tickets for guild members, applied at
+	// checkout
+	MemberDiscounts []MemberDiscount `json:"member_discounts,omitempty"`
+	// Guild tier needed to RSVP or hold a ticket; higher ranked tiers qualify
+	// too
+	MinTier *string `json:"min_tier,omitempty"`
+	// When RSVPs open. Holders of tiers with early access can RSVP that many
+	// hours sooner.
+	RsvpOpensAt *time.Time `json:"rsvp_opens_at,omitempty"`
+	// Who else can RSVP before rsvp_opens_at; set with PUT
+	// /v1/events/{eventId}/rsvp-window
+	RsvpEarlyAccess []RSVPEarlyAccess `json:"rsvp_early_access,omitempty"`
+	// Draft and scheduled events are only visible to their hosts
+	Status string `json:"status"`
+	// When a scheduled event is published
+	PublishAt  *time.Time `json:"publish_at,omitempty"`
+	Visibility *string    `json:"visibility,omitempty"`
+	// Incremented on every update; send it back on PATCH
+	Version   *int       `json:"version,omitempty"`
+	CreatedOn time.Time  `json:"created_on"`
+	UpdatedOn *time.Time `json:"updated_on,omitempty"`
+}
+
+// MemberDiscount is the MemberDiscount schema.
+type MemberDiscount struct {
+	// Applies to guild members with this role or higher
+	Role       string `json:"role"`
+	PercentOff int    `json:"percent_off"`
+}
+
+// RSVPEarlyAccess is the RSVPEarlyAccess schema.
+//
+// Early RSVPs for a guild tier or a guild role; a rule names one or the other
+type RSVPEarlyAccess struct {
+	// Holders of exactly this guild tier
+	TierID *string `json:"tier_id,omitempty"`
+	// Guild members with this role or higher
+	Role *string `json:"role,omitempty"`
+	// How many hours before rsvp_opens_at they can RSVP
+	Hours int `json:"hours"`
+}
+
+// CreateEventRequest is the CreateEventRequest schema.
+type CreateEventRequest struct {
+	GuildID     string                      `json:"guild_id"`
+	Title       string                      `json:"title"`
+	Description *string                     `json:"description,omitempty"`
+	Location    *CreateEventRequestLocation `json:"location,omitempty"`
+	// A saved venue of the event's guild to hold it at, instead of location.
+	// The venue's capacity is the default max_attendees.
+	VenueID    *string    `json:"venue_id,omitempty"`
+	StartTime  time.Time  `json:"start_time"`
+	EndTime    *time.Time `json:"end_time,omitempty"`
+	Capacity   *int       `json:"capacity,omitempty"`
+	Visibility *string    `json:"visibility,omitempty"`
+	// Give each seat a ticket; requires max_attendees
+	Ticketed *bool `json:"ticketed,omitempty"`
+	// Price of a ticket in the currency's minor unit, such as cents; 0 is
+	// free. Paid events must be ticketed, and their creator needs a payout
+	// account that can accept payments.
+	TicketPrice *int `json:"ticket_price,omitempty"`
+	// Required when ticket_price is set
+	Currency *string `json:"currency,omitempty"`
+	// When guests who cancel are refunded - until the event starts, until 24
+	// hours before, or never. Hosts cancelling or declining always refund.
+	RefundPolicy *string `json:"refund_policy,omitempty"`
+	// Keep the event hidden and publish it at this time (no later than
+	// start_time)
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// Only members holding this guild tier or a higher one can RSVP (guild
+	// events only)
+	MinTier *string `json:"min_tier,omitempty"`
+	// Open RSVPs at this time (before start_time); tiers with early access can
+	// RSVP sooner
+	RsvpOpensAt *time.Time `json:"rsvp_opens_at,omitempty"`
+	// Create the event even though it looks like another of the guild's events
+	ConfirmDuplicate *bool `json:"confirm_duplicate,omitempty"`
+}
+
+// CreateEventRequestLocation is the location property of CreateEventRequest.
+type CreateEventRequestLocation struct {
+	Name         string  `json:"name"`
+	Address      *string `json:"address,omitempty"`
+	Neighborhood *string `json:"neighborhood,omitempty"`
+	City         string  `json:"city"`
+	IsVirtual    *bool   `json:"is_virtual,omitempty"`
+	MeetLink     *string `json:"meet_link,omitempty"`
+	// Accessibility features the venue offers
+	Accessibility []AccessibilityFeature `json:"accessibility,omitempty"`
+}
+
+// AccessibilityFeature is the AccessibilityFeature schema.
+//
+// An accessibility feature an event venue offers or a person needs.
+// wheelchair_access covers the entrance, seating and restroom; step_free means
+// no steps between the street and the space; quiet_space is somewhere quiet to
+// step away to.
+type AccessibilityFeature string
+
+// SchedulePublicationRequest is the SchedulePublicationRequest schema.
+type SchedulePublicationRequest struct {
+	// In the future, within 90 days and no later than the event start or vote
+	// opening
+	PublishAt time.Time `json:"publish_at"`
+}
+
+// SetRSVPWindowRequest is the SetRSVPWindowRequest schema.
+type SetRSVPWindowRequest struct {
+	// When RSVPs open (before start_time); null opens them now
+	OpensAt *time.Time `json:"opens_at,omitempty"`
+	// Needs opens_at; at most one rule per tier and per role (guild events
+	// only)
+	EarlyAccess []RSVPEarlyAccess `json:"early_access,omitempty"`
+}
+
+// UpdateGuildRequest is the UpdateGuildRequest schema.
+//
+// Merge patch of the guild. Omitted fields are unchanged; null clears
+// description, icon or color. Immutable fields such as id are rejected.
+type UpdateGuildRequest struct {
+	Name *string `json:"name,omitempty"`
+	// New slug (guild admins only); the old slug keeps redirecting
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	// Turn event embeds on or off (guild admins only)
+	EmbedEnabled *bool `json:"embed_enabled,omitempty"`
+	// Version the edit is based on; rejected with 409 if the guild has changed
+	// since
+	Version *int `json:"version,omitempty"`
+}
+
+// UpdateEventRequest is the UpdateEventRequest schema.
+//
+// Merge patch of the event. Omitted fields are unchanged; null clears
+// description, location, end_time, max_attendees, cover_image, theme_color or
+// values_questions. Immutable fields such as guild_id are rejected.
+type UpdateEventRequest struct {
+	Title       *string                     `json:"title,omitempty"`
+	Description *string                     `json:"description,omitempty"`
+	Location    *UpdateEventRequestLocation `json:"location,omitempty"`
+	// Moves the event to a saved venue of its guild, replacing location.
+	// Setting location by hand unlinks the venue.
+	VenueID    *string    `json:"venue_id,omitempty"`
+	StartTime  *time.Time `json:"start_time,omitempty"`
+	EndTime    *time.Time `json:"end_time,omitempty"`
+	Capacity   *int       `json:"capacity,omitempty"`
+	Status     *string    `json:"status,omitempty"`
+	Visibility *string    `json:"visibility,omitempty"`
+	// Ticketed events can't clear max_attendees
+	Ticketed *bool `json:"ticketed,omitempty"`
+	// Price of a ticket in the currency's minor unit, such as cents; 0 is
+	// free. Paid events must be ticketed
+	TicketPrice *int `json:"ticket_price,omitempty"`
+	// Required when ticket_price is set
+	Currency *string `json:"currency,omitempty"`
+	// When guests who cancel are refunded - until the event starts, until 24
+	// hours before, or never. Hosts cancelling or declining always refund.
+	RefundPolicy *string `json:"refund_policy,omitempty"`
+	// Guild tier needed to RSVP (guild events only); null opens the event to
+	// every member. Guests already in keep their place.
+	MinTier *string `json:"min_tier,omitempty"`
+	// When RSVPs open (before start_time); null opens them now
+	RsvpOpensAt *time.Time `json:"rsvp_opens_at,omitempty"`
+	// Version the edit is based on; rejected with 409 if the event has changed
+	// since
+	Version *int `json:"version,omitempty"`
+}
+
+// UpdateEventRequestLocation is the location property of UpdateEventRequest.
+type UpdateEventRequestLocation struct {
+	Name         string  `json:"name"`
+	Address      *string `json:"address,omitempty"`
+	Neighborhood *string `json:"neighborhood,omitempty"`
+	City         string  `json:"city"`
+	IsVirtual    *bool   `json:"is_virtual,omitempty"`
+	MeetLink     *string `json:"meet_link,omitempty"`
+	// Accessibility features the venue offers
+	Accessibility []AccessibilityFeature `json:"accessibility,omitempty"`
+}
+
+// RSVP is the RSVP schema.
+type RSVP struct {
+	ID      string  `json:"id"`
+	EventID string  `json:"event_id"`
+	UserID  string  `json:"user_id"`
+	Status  string  `json:"status"`
+	Note    *string `json:"note,omitempty"`
+	// Why a pending RSVP is waiting
+	WaitingReason *string `json:"waiting_reason,omitempty"`
+	// Paid events only
+	PaymentStatus *string `json:"payment_status,omitempty"`
+	// Attendance reliability, included for hosts reviewing pending RSVPs
+	NoShowStats *NoShowStats `json:"no_show_stats,omitempty"`
+	CreatedOn   time.Time    `json:"created_on"`
+	UpdatedOn   *time.Time   `json:"updated_on,omitempty"`
+}
+
+// NoShowStats is the NoShowStats schema.
+type NoShowStats struct {
+	UserID       string `json:"user_id"`
+	LookbackDays int    `json:"lookback_days"`
+	// Approved RSVPs to checked events, minus no-shows
+	Attended int `json:"attended"`
+	NoShows  int `json:"no_shows"`
+	// no_shows / (attended + no_shows)
+	NoShowRate float64 `json:"no_show_rate"`
+}
+
+// NoShow is the NoShow schema.
+type NoShow struct {
+	ID      string  `json:"id"`
+	UserID  string  `json:"user_id"`
+	EventID string  `json:"event_id"`
+	GuildID *string `json:"guild_id,omitempty"`
+	// Found by the no-show detection job, or marked by an event host
+	Source   string    `json:"source"`
+	MarkedOn time.Time `json:"marked_on"`
+}
+
+// GuildNoShowPolicy is the GuildNoShowPolicy schema.
+type GuildNoShowPolicy struct {
+	GuildID string `json:"guild_id"`
+	// No-shows within the lookback window after which new RSVPs to guild
+	// events are declined; 0 disables it
+	AutoDeclineThreshold int        `json:"auto_decline_threshold"`
+	LookbackDays         int        `json:"lookback_days"`
+	UpdatedBy            *string    `json:"updated_by,omitempty"`
+	UpdatedOn            *time.Time `json:"updated_on,omitempty"`
+}
+
+// UpdateGuildNoShowPolicyRequest is the UpdateGuildNoShowPolicyRequest schema.
+type UpdateGuildNoShowPolicyRequest struct {
+	AutoDeclineThreshold *int `json:"auto_decline_threshold,omitempty"`
+	LookbackDays         *int `json:"lookback_days,omitempty"`
+}
+
+// RSVPRequest is the RSVPRequest schema.
+type RSVPRequest struct {
+	RsvpType     string   `json:"rsvp_type"`
+	PlusOnes     *int     `json:"plus_ones,omitempty"`
+	PlusOneNames []string `json:"plus_one_names,omitempty"`
+	// Message to the host
+	Note *string `json:"note,omitempty"`
+	// RSVP even though it clashes with other commitments
+	Force *bool `json:"force,omitempty"`
+}
+
+// CreateRSVPRequest is the CreateRSVPRequest schema.
+type CreateRSVPRequest struct {
+	Status string  `json:"status"`
+	Note   *string `json:"note,omitempty"`
+}
+
+// UpdateRSVPRequest is the UpdateRSVPRequest schema.
+type UpdateRSVPRequest struct {
+	Status *string `json:"status,omitempty"`
+	Note   *string `json:"note,omitempty"`
+}
+
+// EventWithAttendees is the EventWithAttendees schema.
+type EventWithAttendees struct {
+	Event     *Event      `json:"event,omitempty"`
+	Rsvps     []RSVP      `json:"rsvps,omitempty"`
+	Roles     []EventRole `json:"roles,omitempty"`
+	MyRsvp    *RSVP       `json:"my_rsvp,omitempty"`
+	CanManage *bool       `json:"can_manage,omitempty"`
+}
+
+// EventWithDetails is the EventWithDetails schema.
+type EventWithDetails struct {
+	Event          Event                `json:"event"`
+	Hosts          []map[string]any     `json:"hosts"`
+	Roles          []EventRole          `json:"roles,omitempty"`
+	AttendeesCount int                  `json:"attendees_count"`
+	WaitlistCount  int                  `json:"waitlist_count"`
+	UserRsvp       *RSVP                `json:"user_rsvp,omitempty"`
+	UserRole       *EventRoleAssignment `json:"user_role,omitempty"`
+	UserTicket     *EventTicket         `json:"user_ticket,omitempty"`
+	// Ticketed events only
+	TicketsLeft *int `json:"tickets_left,omitempty"`
+	// Attendance forecast (hosts only)
+	Forecast  map[string]any   `json:"forecast,omitempty"`
+	Reactions *ReactionSummary `json:"reactions,omitempty"`
+	Carpool   *CarpoolSummary  `json:"carpool,omitempty"`
+}
+
+// EventRoleAssignment is the EventRoleAssignment schema.
+type EventRoleAssignment struct {
+	ID      string  `json:"id"`
+	EventID string  `json:"event_id"`
+	RoleID  string  `json:"role_id"`
+	UserID  string  `json:"user_id"`
+	Note    *string `json:"note,omitempty"`
+	// pending while a nomination awaits host review
+	Status   string  `json:"status"`
+	RoleName *string `json:"role_name,omitempty"`
+	// Host who approved or declined the nomination
+	ReviewedBy *string    `json:"reviewed_by,omitempty"`
+	ReviewedOn *time.Time `json:"reviewed_on,omitempty"`
+	// Host's message to the nominee
+	Response   *string   `json:"response,omitempty"`
+	AssignedOn time.Time `json:"assigned_on"`
+}
+
+// EventTicket is the EventTicket schema.
+//
+// One seat at a ticketed event. A hold reserves the seat for 10 minutes while
+// the guest RSVPs; the RSVP claims it.
+type EventTicket struct {
+	ID       string `json:"id"`
+	EventID  string `json:"event_id"`
+	HolderID string `json:"holder_id"`
+	Status   string `json:"status"`
+	// Held tickets only
+	HoldExpiresOn *time.Time `json:"hold_expires_on,omitempty"`
+	// Previous holder, if the ticket was transferred
+	TransferredFrom *string    `json:"transferred_from,omitempty"`
+	ClaimedOn       *time.Time `json:"claimed_on,omitempty"`
+	CheckedInOn     *time.Time `json:"checked_in_on,omitempty"`
+	// Host who scanned the ticket
+	CheckedInBy *string `json:"checked_in_by,omitempty"`
+	// What the ticket's QR code encodes; only on claimed tickets, for their
+	// holder
+	QrPayload *string    `json:"qr_payload,omitempty"`
+	CreatedOn time.Time  `json:"created_on"`
+	UpdatedOn *time.Time `json:"updated_on,omitempty"`
+}
+
+// ReactionSummary is the ReactionSummary schema.
+//
+// The reactions on an event or announcement. Guild members get new counts live
+// as reactions.updated events on the guild stream.
+type ReactionSummary struct {
+	// Reactions per emoji, for emoji with at least one
+	Counts map[string]int `json:"counts"`
+	Total  int            `json:"total"`
+	// The current user's reaction, if any
+	Mine *string `json:"mine,omitempty"`
+}
+
+// CarpoolSummary is the CarpoolSummary schema.
+type CarpoolSummary struct {
+	// Open ride offers
+	Rides          int `json:"rides"`
+	SeatsAvailable int `json:"seats_available"`
+	// Open ride requests
+	RidersWaiting int          `json:"riders_waiting"`
+	MyRideID      *string      `json:"my_ride_id,omitempty"`
+	MyRequest     *RideRequest `json:"my_request,omitempty"`
+}
+
+// RideRequest is the RideRequest schema.
+type RideRequest struct {
+	ID      string            `json:"id"`
+	EventID string            `json:"event_id"`
+	UserID  string            `json:"user_id"`
+	Pickup  RideshareLocation `json:"pickup"`
+	Notes   *string           `json:"notes,omitempty"`
+	Status  string            `json:"status"`
+	// The rideshare that confirmed a seat, once matched
+	RideshareID *string    `json:"rideshare_id,omitempty"`
+	CreatedOn   time.Time  `json:"created_on"`
+	UpdatedOn   *time.Time `json:"updated_on,omitempty"`
+}
+
+// RideshareLocation is the RideshareLocation schema.
+type RideshareLocation struct {
+	Name         string  `json:"name"`
+	Description  *string `json:"description,omitempty"`
+	Address      *string `json:"address,omitempty"`
+	Neighborhood *string `json:"neighborhood,omitempty"`
+	City         string  `json:"city"`
+	Country      *string `json:"country,omitempty"`
+}
+
+// EventFeedbackRequest is the EventFeedbackRequest schema.
+type EventFeedbackRequest struct {
+	// yes, somewhat or not_really
+	HelpfulnessRating *string  `json:"helpfulness_rating,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+}
+
+// TransferTicketRequest is the TransferTicketRequest schema.
+type TransferTicketRequest struct {
+	// Member of the event's guild without a ticket to the event
+	ToUserID string `json:"to_user_id"`
+}
+
+// CheckInTicketRequest is the CheckInTicketRequest schema.
+type CheckInTicketRequest struct {
+	// The scanned ticket's qr_payload
+	Payload string `json:"payload"`
+}
+
+// CheckoutRequest is the CheckoutRequest schema.
+type CheckoutRequest struct {
+	// Case-insensitive. Applied only if it beats the caller's member discount.
+	PromoCode *string `json:"promo_code,omitempty"`
+}
+
+// CheckoutSession is the CheckoutSession schema.
+//
+// A Stripe Checkout page where the guest pays for their held ticket. The
+// ticket stays held until shortly after the session expires. When a discount
+// leaves nothing to pay, the ticket is claimed at once and url points back to
+// the event.
+type CheckoutSession struct {
+	PaymentID string `json:"payment_id"`
+	// Send the guest here to pay
+	URL string `json:"url"`
+	// Charged, in the currency's minor unit; 0 when the ticket was discounted
+	// to free
+	Amount int `json:"amount"`
+	// Taken off the ticket price
+	Discount       int       `json:"discount"`
+	DiscountSource *string   `json:"discount_source,omitempty"`
+	ExpiresOn      time.Time `json:"expires_on"`
+}
+
+// PromoCode is the PromoCode schema.
+//
+// A code for a discount on a paid event's tickets
+type PromoCode struct {
+	ID           string `json:"id"`
+	EventID      string `json:"event_id"`
+	Code         string `json:"code"`
+	DiscountType string `json:"discount_type"`
+	// Percent off, or minor units off the ticket price
+	Amount int `json:"amount"`
+	// Omitted when unlimited
+	MaxRedemptions *int `json:"max_redemptions,omitempty"`
+	// Includes checkouts still in progress
+	Redemptions int        `json:"redemptions"`
+	ExpiresOn   *time.Time `json:"expires_on,omitempty"`
+	Active      bool       `json:"active"`
+	CreatedBy   *string    `json:"created_by,omitempty"`
+	CreatedOn   *time.Time `json:"created_on,omitempty"`
+	UpdatedOn   *time.Time `json:"updated_on,omitempty"`
+}
+
+// CreatePromoCodeRequest is the CreatePromoCodeRequest schema.
+type CreatePromoCodeRequest struct {
+	// Stored upper case
+	Code         string `json:"code"`
+	DiscountType string `json:"discount_type"`
+	// Percent off (up to 100), or minor units off the ticket price
+	Amount         int        `json:"amount"`
+	MaxRedemptions *int       `json:"max_redemptions,omitempty"`
+	ExpiresOn      *time.Time `json:"expires_on,omitempty"`
+}
+
+// UpdatePromoCodeRequest is the UpdatePromoCodeRequest schema.
+type UpdatePromoCodeRequest struct {
+	MaxRedemptions *int       `json:"max_redemptions,omitempty"`
+	ExpiresOn      *time.Time `json:"expires_on,omitempty"`
+	// Inactive codes can't be redeemed
+	Active *bool `json:"active,omitempty"`
+}
+
+// SetMemberDiscountsRequest is the SetMemberDiscountsRequest schema.
+type SetMemberDiscountsRequest struct {
+	// One per role at most; empty removes them
+	Discounts []MemberDiscount `json:"discounts"`
+}
+
+// DiscountTotals is the DiscountTotals schema.
+type DiscountTotals struct {
+	Redemptions *int `json:"redemptions,omitempty"`
+	Refunded    *int `json:"refunded,omitempty"`
+	// Given up, excluding refunded sales
+	DiscountTotal *int `json:"discount_total,omitempty"`
+	// Taken, excluding refunded sales
+	Revenue *int `json:"revenue,omitempty"`
+}
+
+// DiscountReport is the DiscountReport schema.
+//
+// An event's ticket sales by discount, in the event's currency's minor unit
+type DiscountReport struct {
+	EventID  *string `json:"event_id,omitempty"`
+	Currency *string `json:"currency,omitempty"`
+	// Tickets paid for, refunded or not
+	Sales *int `json:"sales,omitempty"`
+	// Taken, excluding refunded sales
+	Revenue     *int                       `json:"revenue,omitempty"`
+	PromoCodes  []DiscountReportPromoCode  `json:"promo_codes,omitempty"`
+	Member      *DiscountTotals            `json:"member,omitempty"`
+	Redemptions []DiscountReportRedemption `json:"redemptions,omitempty"`
+}
+
+// DiscountReportPromoCode is an element of the promo_codes property of
+// DiscountReport.
+type DiscountReportPromoCode struct {
+}
+
+// DiscountReportRedemption is an element of the redemptions property of
+// DiscountReport.
+type DiscountReportRedemption struct {
+	PaymentID      *string    `json:"payment_id,omitempty"`
+	UserID         *string    `json:"user_id,omitempty"`
+	PromoCodeID    *string    `json:"promo_code_id,omitempty"`
+	DiscountSource *string    `json:"discount_source,omitempty"`
+	Amount         *int       `json:"amount,omitempty"`
+	Discount       *int       `json:"discount,omitempty"`
+	Status         *string    `json:"status,omitempty"`
+	PaidOn         *time.Time `json:"paid_on,omitempty"`
+}
+
+// PayoutAccount is the PayoutAccount schema.
+//
+// The Stripe account an organizer's ticket sales are paid out to
+type PayoutAccount struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	AccountID string `json:"account_id"`
+	// Can sell tickets
+	ChargesEnabled bool `json:"charges_enabled"`
+	// Can be paid out to a bank account
+	PayoutsEnabled   bool       `json:"payouts_enabled"`
+	DetailsSubmitted bool       `json:"details_submitted"`
+	CreatedOn        *time.Time `json:"created_on,omitempty"`
+	UpdatedOn        *time.Time `json:"updated_on,omitempty"`
+}
+
+// PayoutAccountLink is the PayoutAccountLink schema.
+//
+// A single-use Stripe page for setting up a payout account
+type PayoutAccountLink struct {
+	URL       string    `json:"url"`
+	ExpiresOn time.Time `json:"expires_on"`
+}
+
+// AttendanceStats is the AttendanceStats schema.
+type AttendanceStats struct {
+	EventID       *string `json:"event_id,omitempty"`
+	TotalRsvps    *int    `json:"total_rsvps,omitempty"`
+	GoingCount    *int    `json:"going_count,omitempty"`
+	MaybeCount    *int    `json:"maybe_count,omitempty"`
+	AttendedCount *int    `json:"attended_count,omitempty"`
+	NoShowCount   *int    `json:"no_show_count,omitempty"`
+}
+
+// EventSummary is the EventSummary schema.
+type EventSummary struct {
+	UpcomingCount *int     `json:"upcoming_count,omitempty"`
+	HostedCount   *int     `json:"hosted_count,omitempty"`
+	AttendedCount *int     `json:"attended_count,omitempty"`
+	AverageRating *float64 `json:"average_rating,omitempty"`
+}
+
+// CreateEventRoleRequest is the CreateEventRoleRequest schema.
+type CreateEventRoleRequest struct {
+	CatalogRoleID  *string `json:"catalog_role_id,omitempty"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	MaxSlots       *int    `json:"max_slots,omitempty"`
+	AssignmentMode *string `json:"assignment_mode,omitempty"`
+	IsCritical     *bool   `json:"is_critical,omitempty"`
+}
+
+// UpdateEventRoleRequest is the UpdateEventRoleRequest schema.
+type UpdateEventRoleRequest struct {
+	Name           *string `json:"name,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	MaxSlots       *int    `json:"max_slots,omitempty"`
+	AssignmentMode *string `json:"assignment_mode,omitempty"`
+	IsCritical     *bool   `json:"is_critical,omitempty"`
+}
+
+// NominateRoleRequest is the NominateRoleRequest schema.
+type NominateRoleRequest struct {
+	// Why the member wants the role
+	Note *string `json:"note,omitempty"`
+}
+
+// ReviewNominationRequest is the ReviewNominationRequest schema.
+type ReviewNominationRequest struct {
+	// Optional message sent to the nominee
+	Response *string `json:"response,omitempty"`
+}
+
+// AssignEventRoleRequest is the AssignEventRoleRequest schema.
+type AssignEventRoleRequest struct {
+	// If not provided, assigns to current user
+	UserID *string `json:"user_id,omitempty"`
+	Note   *string `json:"note,omitempty"`
+}
+
+// EventRoleWithAssignments is the EventRoleWithAssignments schema.
+type EventRoleWithAssignments struct {
+	Role        *EventRole            `json:"role,omitempty"`
+	Assignments []EventRoleAssignment `json:"assignments,omitempty"`
+	IsFull      *bool                 `json:"is_full,omitempty"`
+	SpotsLeft   *int                  `json:"spots_left,omitempty"`
+}
+
+// RoleSuggestion is the RoleSuggestion schema.
+type RoleSuggestion struct {
+	Role       *RoleCatalog `json:"role,omitempty"`
+	Reason     *string      `json:"reason,omitempty"`
+	UsageCount *int         `json:"usage_count,omitempty"`
+}
+
+// Availability is the Availability schema.
+type Availability struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	// Key of a hangout type in the catalog
+	HangoutType string `json:"hangout_type"`
+	// Set when the availability is scoped to a guild and only shown to its
+	// members
+	GuildID     *string   `json:"guild_id,omitempty"`
+	Title       *string   `json:"title,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	Location    *string   `json:"location,omitempty"`
+	LocationLat *float64  `json:"location_lat,omitempty"`
+	LocationLng *float64  `json:"location_lng,omitempty"`
+	RadiusKm    *float64  `json:"radius_km,omitempty"`
+	Status      string    `json:"status"`
+	// Available right now rather than planned; ranks first in nearby discovery
+	Spontaneous *bool      `json:"spontaneous,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CreatedOn   time.Time  `json:"created_on"`
+}
+
+// CreateAvailabilityRequest is the CreateAvailabilityRequest schema.
+type CreateAvailabilityRequest struct {
+	// Key of an active platform hangout type, or of one of the guild's custom
+	// types when guild_id is set
+	HangoutType string `json:"hangout_type"`
+	// Scope to a guild the user belongs to; hidden from global discovery
+	GuildID     *string                            `json:"guild_id,omitempty"`
+	Title       *string                            `json:"title,omitempty"`
+	Description *string                            `json:"description,omitempty"`
+	StartTime   time.Time                          `json:"start_time"`
+	EndTime     time.Time                          `json:"end_time"`
+	Location    *CreateAvailabilityRequestLocation `json:"location,omitempty"`
+	RadiusKm    *float64                           `json:"radius_km,omitempty"`
+}
+
+// CreateAvailabilityRequestLocation is the location property of
+// CreateAvailabilityRequest.
+type CreateAvailabilityRequestLocation struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+	// Search radius in km (default 5)
+	Radius *float64 `json:"radius,omitempty"`
+}
+
+// UpdateAvailabilityRequest is the UpdateAvailabilityRequest schema.
+type UpdateAvailabilityRequest struct {
+	Title       *string    `json:"title,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+	Location    *string    `json:"location,omitempty"`
+	LocationLat *float64   `json:"location_lat,omitempty"`
+	LocationLng *float64   `json:"location_lng,omitempty"`
+	RadiusKm    *float64   `json:"radius_km,omitempty"`
+	Status      *string    `json:"status,omitempty"`
+}
+
+// GoAvailableNowRequest is the GoAvailableNowRequest schema.
+type GoAvailableNowRequest struct {
+	DurationMinutes *int                          `json:"duration_minutes,omitempty"`
+	Location        GoAvailableNowRequestLocation `json:"location"`
+	// Key of an active platform hangout type
+	HangoutType         *string `json:"hangout_type,omitempty"`
+	ActivityDescription *string `json:"activity_description,omitempty"`
+	ActivityVenue       *string `json:"activity_venue,omitempty"`
+	// Required for mutual_interest
+	InterestID *string `json:"interest_id,omitempty"`
+	Note       *string `json:"note,omitempty"`
+}
+
+// GoAvailableNowRequestLocation is the location property of
+// GoAvailableNowRequest.
+type GoAvailableNowRequestLocation struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+	// Search radius in km (default 5)
+	Radius *float64 `json:"radius,omitempty"`
+}
+
+// SpontaneousAlertPreference is the SpontaneousAlertPreference schema.
+type SpontaneousAlertPreference struct {
+	UserID string `json:"user_id"`
+	// Off until the user opts in
+	Enabled bool `json:"enabled"`
+	// How far away a spontaneous window may be to alert the user
+	RadiusKm      float64    `json:"radius_km"`
+	LastAlertedOn *time.Time `json:"last_alerted_on,omitempty"`
+	UpdatedOn     *time.Time `json:"updated_on,omitempty"`
+}
+
+// UpdateSpontaneousAlertPreferenceRequest is the
+// UpdateSpontaneousAlertPreferenceRequest schema.
+type UpdateSpontaneousAlertPreferenceRequest struct {
+	Enabled  *bool    `json:"enabled,omitempty"`
+	RadiusKm *float64 `json:"radius_km,omitempty"`
+}
+
+// HangoutTypeInfo is the HangoutTypeInfo schema.
+type HangoutTypeInfo struct {
+	ID *string `json:"id,omitempty"`
+	// The key availability refers to
+	Type        string  `json:"type"`
+	Label       string  `json:"label"`
+	Description *string `json:"description,omitempty"`
+	// SF Symbol name
+	Icon *string `json:"icon,omitempty"`
+	// Set on a guild's custom types
+	GuildID *string `json:"guild_id,omitempty"`
+	// Built-in types can be relabeled but not removed or deactivated
+	BuiltIn bool `json:"built_in"`
+	// Inactive types can't be used for new availability
+	IsActive  bool       `json:"is_active"`
+	SortOrder int        `json:"sort_order"`
+	CreatedOn *time.Time `json:"created_on,omitempty"`
+	UpdatedOn *time.Time `json:"updated_on,omitempty"`
+}
+
+// CreateHangoutTypeRequest is the CreateHangoutTypeRequest schema.
+type CreateHangoutTypeRequest struct {
+	Type        string  `json:"type"`
+	Label       string  `json:"label"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	SortOrder   *int    `json:"sort_order,omitempty"`
+}
+
+// UpdateHangoutTypeRequest is the UpdateHangoutTypeRequest schema.
+type UpdateHangoutTypeRequest struct {
+	Label       *string `json:"label,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	SortOrder   *int    `json:"sort_order,omitempty"`
+	IsActive    *bool   `json:"is_active,omitempty"`
+}
+
+// NearbyAvailability is the NearbyAvailability schema.
+type NearbyAvailability struct {
+	Availability       *Availability  `json:"availability,omitempty"`
+	User               *PublicProfile `json:"user,omitempty"`
+	DistanceKm         *float64       `json:"distance_km,omitempty"`
+	CompatibilityScore *float64       `json:"compatibility_score,omitempty"`
+}
+
+// PublicProfile is the PublicProfile schema.
+//
+// Privacy-filtered profile for other users to see
+type PublicProfile struct {
+	UserID *string `json:"user_id,omitempty"`
+	// The user's handle, without the leading @
+	Username    *string `json:"username,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	Bio         *string `json:"bio,omitempty"`
+	AvatarURL   *string `json:"avatar_url,omitempty"`
+	PhotoURL    *string `json:"photo_url,omitempty"`
+	// Only shown if show_distance is true
+	DistanceKm *float64 `json:"distance_km,omitempty"`
+	// Only shown if show_online is true
+	IsOnline     *bool    `json:"is_online,omitempty"`
+	MutualGuilds []string `json:"mutual_guilds,omitempty"`
+	// Only when the owner shares them, and never on share links
+	AccessibilityNeeds []AccessibilityFeature `json:"accessibility_needs,omitempty"`
+	Reputation         json.RawMessage        `json:"reputation,omitempty"`
+	// Positive review tags given at least 3 times
+	Badges []PublicProfileBadge `json:"badges,omitempty"`
+}
+
+// PublicProfileBadge is an element of the badges property of PublicProfile.
+type PublicProfileBadge struct {
+	Tag   *string `json:"tag,omitempty"`
+	Label *string `json:"label,omitempty"`
+	Icon  *string `json:"icon,omitempty"`
+	Count *int    `json:"count,omitempty"`
+}
+
+// HangoutRequest is the HangoutRequest schema.
+type HangoutRequest struct {
+	ID             string     `json:"id"`
+	AvailabilityID string     `json:"availability_id"`
+	RequesterID    string     `json:"requester_id"`
+	Message        *string    `json:"message,omitempty"`
+	Status         string     `json:"status"`
+	CreatedOn      time.Time  `json:"created_on"`
+	RespondedOn    *time.Time `json:"responded_on,omitempty"`
+}
+
+// CreateHangoutRequestRequest is the CreateHangoutRequestRequest schema.
+type CreateHangoutRequestRequest struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// RespondHangoutRequest is the RespondHangoutRequest schema.
+type RespondHangoutRequest struct {
+	Accept  bool    `json:"accept"`
+	Message *string `json:"message,omitempty"`
+}
+
+// Hangout is the Hangout schema.
+type Hangout struct {
+	ID             string     `json:"id"`
+	AvailabilityID string     `json:"availability_id"`
+	Participants   []string   `json:"participants"`
+	ScheduledTime  *time.Time `json:"scheduled_time,omitempty"`
+	Location       *string    `json:"location,omitempty"`
+	Status         string     `json:"status"`
+	CreatedOn      time.Time  `json:"created_on"`
+}
+
+// HangoutRequestDisplay is the HangoutRequestDisplay schema.
+type HangoutRequestDisplay struct {
+	Request      *HangoutRequest `json:"request,omitempty"`
+	Requester    *PublicProfile  `json:"requester,omitempty"`
+	Availability *Availability   `json:"availability,omitempty"`
+}
+
+// Venue is the Venue schema.
+type Venue struct {
+	ID           string  `json:"id"`
+	GuildID      string  `json:"guild_id"`
+	Name         string  `json:"name"`
+	Address      string  `json:"address"`
+	Neighborhood *string `json:"neighborhood,omitempty"`
+	City         string  `json:"city"`
+	// Entered or geocoded; absent when unknown
+	Lat *float64 `json:"lat,omitempty"`
+	Lng *float64 `json:"lng,omitempty"`
+	// Most people the space holds
+	Capacity      *int                   `json:"capacity,omitempty"`
+	Accessibility []AccessibilityFeature `json:"accessibility,omitempty"`
+	CreatedBy     string                 `json:"created_by"`
+	CreatedOn     *time.Time             `json:"created_on,omitempty"`
+	UpdatedOn     *time.Time             `json:"updated_on,omitempty"`
+}
+
+// CreateVenueRequest is the CreateVenueRequest schema.
+//
+// lat and lng go together. Without them the address is geocoded when the
+// server has a geocoding provider.
+type CreateVenueRequest struct {
+	Name          string                 `json:"name"`
+	Address       string                 `json:"address"`
+	Neighborhood  *string                `json:"neighborhood,omitempty"`
+	City          string                 `json:"city"`
+	Lat           *float64               `json:"lat,omitempty"`
+	Lng           *float64               `json:"lng,omitempty"`
+	Capacity      *int                   `json:"capacity,omitempty"`
+	Accessibility []AccessibilityFeature `json:"accessibility,omitempty"`
+}
+
+// GuildInvite is the GuildInvite schema.
+type GuildInvite struct {
+	ID      string `json:"id"`
+	GuildID string `json:"guild_id"`
+	// Eight characters, without 0, O, 1, I or L
+	Code string `json:"code"`
+	// Link on the public site that opens the invite
+	URL       string `json:"url"`
+	CreatedBy string `json:"created_by"`
+	// The one person the invite is for
+	Email *string `json:"email,omitempty"`
+	// Absent when unlimited
+	MaxUses   *int       `json:"max_uses,omitempty"`
+	UseCount  int        `json:"use_count"`
+	ExpiresOn time.Time  `json:"expires_on"`
+	CreatedOn *time.Time `json:"created_on,omitempty"`
+}
+
+// CreateGuildInviteRequest is the CreateGuildInviteRequest schema.
+type CreateGuildInviteRequest struct {
+	// Invite one person; the invite can only be used once, from the account
+	// with this verified email
+	Email *string `json:"email,omitempty"`
+	// Unlimited when omitted; must be 1 with email
+	MaxUses        *int `json:"max_uses,omitempty"`
+	ExpiresInHours *int `json:"expires_in_hours,omitempty"`
+}
+
+// GuildPermission is the GuildPermission schema.
+//
+// Something a guild member may be allowed to do
+type GuildPermission string
+
+// GuildCustomRole is the GuildCustomRole schema.
+//
+// A role a guild defines on top of member, moderator and admin, such as
+// "organizer". Holders gain its permissions in addition to their built-in
+// role's.
+type GuildCustomRole struct {
+	ID          string            `json:"id"`
+	GuildID     string            `json:"guild_id"`
+	Name        string            `json:"name"`
+	Description *string           `json:"description,omitempty"`
+	Permissions []GuildPermission `json:"permissions"`
+	CreatedBy   string            `json:"created_by"`
+	CreatedOn   *time.Time        `json:"created_on,omitempty"`
+	UpdatedOn   *time.Time        `json:"updated_on,omitempty"`
+}
+
+// CreateGuildCustomRoleRequest is the CreateGuildCustomRoleRequest schema.
+type CreateGuildCustomRoleRequest struct {
+	// Unique in the guild; can't be member, moderator or admin
+	Name        string            `json:"name"`
+	Description *string           `json:"description,omitempty"`
+	Permissions []GuildPermission `json:"permissions"`
+}
+
+// UpdateGuildCustomRoleRequest is the UpdateGuildCustomRoleRequest schema.
+type UpdateGuildCustomRoleRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// Replaces the role's permissions
+	Permissions []GuildPermission `json:"permissions,omitempty"`
+}
+
+// GuildPermissions is the GuildPermissions schema.
+//
+// What a member may do in a guild and which roles grant it
+type GuildPermissions struct {
+	GuildID string `json:"guild_id"`
+	// Built-in role. Members can create events; moderators can also manage
+	// pools and start votes; admins can do everything.
+	Role        string            `json:"role"`
+	CustomRoles []GuildCustomRole `json:"custom_roles"`
+	Permissions []GuildPermission `json:"permissions"`
+}
+
+// UpdateVenueRequest is the UpdateVenueRequest schema.
+//
+// Omitted fields are unchanged. A new address or city without lat and lng is
+// geocoded again.
+type UpdateVenueRequest struct {
+	Name         *string  `json:"name,omitempty"`
+	Address      *string  `json:"address,omitempty"`
+	Neighborhood *string  `json:"neighborhood,omitempty"`
+	City         *string  `json:"city,omitempty"`
+	Lat          *float64 `json:"lat,omitempty"`
+	Lng          *float64 `json:"lng,omitempty"`
+	Capacity     *int     `json:"capacity,omitempty"`
+	// Replaces the list
+	Accessibility []AccessibilityFeature `json:"accessibility,omitempty"`
+}
+
+// CarpoolStop is the CarpoolStop schema.
+//
+// Coordinates are used for matching and never returned
+type CarpoolStop struct {
+	Name         string  `json:"name"`
+	Neighborhood *string `json:"neighborhood,omitempty"`
+	City         string  `json:"city"`
+	Lat          float64 `json:"lat"`
+	Lng          float64 `json:"lng"`
+}
+
+// Rideshare is the Rideshare schema.
+type Rideshare struct {
+	ID             string            `json:"id"`
+	EventID        *string           `json:"event_id,omitempty"`
+	AdventureID    *string           `json:"adventure_id,omitempty"`
+	DriverID       string            `json:"driver_id"`
+	Title          string            `json:"title"`
+	Description    *string           `json:"description,omitempty"`
+	Origin         RideshareLocation `json:"origin"`
+	Destination    RideshareLocation `json:"destination"`
+	DepartureTime  time.Time         `json:"departure_time"`
+	ArrivalTime    *time.Time        `json:"arrival_time,omitempty"`
+	SeatsTotal     int               `json:"seats_total"`
+	SeatsAvailable int               `json:"seats_available"`
+	Status         string            `json:"status"`
+	TrustRequired  *bool             `json:"trust_required,omitempty"`
+	// Same as the event's for carpools
+	Visibility *string    `json:"visibility,omitempty"`
+	CreatedOn  *time.Time `json:"created_on,omitempty"`
+	UpdatedOn  *time.Time `json:"updated_on,omitempty"`
+}
+
+// RideshareSeat is the RideshareSeat schema.
+type RideshareSeat struct {
+	ID               string     `json:"id"`
+	RideshareID      string     `json:"rideshare_id"`
+	PassengerID      string     `json:"passenger_id"`
+	Status           string     `json:"status"`
+	PickupSegmentID  *string    `json:"pickup_segment_id,omitempty"`
+	DropoffSegmentID *string    `json:"dropoff_segment_id,omitempty"`
+	RequestedOn      time.Time  `json:"requested_on"`
+	ConfirmedOn      *time.Time `json:"confirmed_on,omitempty"`
+	Notes            *string    `json:"notes,omitempty"`
+}
+
+// RideshareMatch is the RideshareMatch schema.
+type RideshareMatch struct {
+	Rideshare Rideshare `json:"rideshare"`
+	DriverID  string    `json:"driver_id"`
+	// 1 when the route passes the pickup, falling to 0 at the 5 km limit
+	MatchScore float64 `json:"match_score"`
+	// Detour from the driver's route to the pickup
+	DistanceKm     *float64 `json:"distance_km,omitempty"`
+	TimeOverlap    bool     `json:"time_overlap"`
+	AvailableSeats int      `json:"available_seats"`
+}
+
+// OfferRideRequest is the OfferRideRequest schema.
+type OfferRideRequest struct {
+	Title       string      `json:"title"`
+	Description *string     `json:"description,omitempty"`
+	Origin      CarpoolStop `json:"origin"`
+	// Stops between the origin and the event, in driving order
+	Stops []CarpoolStop `json:"stops,omitempty"`
+	// Must be before the event starts
+	DepartureTime time.Time `json:"departure_time"`
+	SeatsTotal    int       `json:"seats_total"`
+	TrustRequired *bool     `json:"trust_required,omitempty"`
+}
+
+// RequestRideRequest is the RequestRideRequest schema.
+type RequestRideRequest struct {
+	Pickup CarpoolStop `json:"pickup"`
+	Notes  *string     `json:"notes,omitempty"`
+}
+
+// RequestSeatRequest is the RequestSeatRequest schema.
+type RequestSeatRequest struct {
+	Notes *string `json:"notes,omitempty"`
+}
+
+// RespondToSeatRequest is the RespondToSeatRequest schema.
+type RespondToSeatRequest struct {
+	Confirmed bool    `json:"confirmed"`
+	Notes     *string `json:"notes,omitempty"`
+}
+
+// Expense is the Expense schema.
+type Expense struct {
+	ID string `json:"id"`
+	// The event or adventure the expense belongs to
+	ScopeID     string `json:"scope_id"`
+	PaidBy      string `json:"paid_by"`
+	Description string `json:"description"`
+	// In the currency's minor unit
+	Amount    int       `json:"amount"`
+	Currency  string    `json:"currency"`
+	CreatedBy string    `json:"created_by"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// CreateExpenseRequest is the CreateExpenseRequest schema.
+type CreateExpenseRequest struct {
+	Description string `json:"description"`
+	// In the currency's minor unit
+	Amount   int    `json:"amount"`
+	Currency string `json:"currency"`
+	// A host or approved attendee; defaults to whoever records it
+	PaidBy *string `json:"paid_by,omitempty"`
+}
+
+// ExpenseSettlement is the ExpenseSettlement schema.
+type ExpenseSettlement struct {
+	ID         string `json:"id"`
+	ScopeID    string `json:"scope_id"`
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+	Amount     int    `json:"amount"`
+	Currency   string `json:"currency"`
+	// Either party, or a host
+	MarkedBy  string    `json:"marked_by"`
+	SettledOn time.Time `json:"settled_on"`
+}
+
+// SettleExpensesRequest is the SettleExpensesRequest schema.
+type SettleExpensesRequest struct {
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+}
+
+// ExpenseLedger is the ExpenseLedger schema.
+type ExpenseLedger struct {
+	Currency *string `json:"currency,omitempty"`
+	Total    int     `json:"total"`
+	// Participants and their plus-ones
+	Headcount int `json:"headcount"`
+	// Total over headcount, rounded down
+	PerPersonShare int              `json:"per_person_share"`
+	Balances       []ExpenseBalance `json:"balances"`
+	// What is still owed
+	Transfers []ExpenseTransfer `json:"transfers"`
+}
+
+// ExpenseBalance is the ExpenseBalance schema.
+type ExpenseBalance struct {
+	UserID    string `json:"user_id"`
+	Headcount int    `json:"headcount"`
+	Paid      int    `json:"paid"`
+	Share     int    `json:"share"`
+	// Paid back to others, less received
+	Settled int `json:"settled"`
+	// What they are owed; negative when they owe
+	Balance int `json:"balance"`
+}
+
+// ExpenseTransfer is the ExpenseTransfer schema.
+type ExpenseTransfer struct {
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+	Amount     int    `json:"amount"`
+}
+
+// Profile is the Profile schema.
+type Profile struct {
+	ID           string   `json:"id"`
+	UserID       string   `json:"user_id"`
+	DisplayName  *string  `json:"display_name,omitempty"`
+	Bio          *string  `json:"bio,omitempty"`
+	AvatarURL    *string  `json:"avatar_url,omitempty"`
+	Location     *string  `json:"location,omitempty"`
+	LocationLat  *float64 `json:"location_lat,omitempty"`
+	LocationLng  *float64 `json:"location_lng,omitempty"`
+	Visibility   *string  `json:"visibility,omitempty"`
+	ShowDistance *bool    `json:"show_distance,omitempty"`
+	ShowOnline   *bool    `json:"show_online,omitempty"`
+	// Allows the unauthenticated share link profile
+	ShareEnabled *bool `json:"share_enabled,omitempty"`
+	// Incremented on every profile edit; send it back on PATCH
+	Version   *int       `json:"version,omitempty"`
+	CreatedOn time.Time  `json:"created_on"`
+	UpdatedOn *time.Time `json:"updated_on,omitempty"`
+}
+
+// ProfileResponse is the ProfileResponse schema.
+type ProfileResponse struct {
+	UserID       string   `json:"user_id"`
+	Bio          *string  `json:"bio,omitempty"`
+	Tagline      *string  `json:"tagline,omitempty"`
+	PhotoURL     *string  `json:"photo_url,omitempty"`
+	Languages    []string `json:"languages,omitempty"`
+	Timezone     *string  `json:"timezone,omitempty"`
+	City         *string  `json:"city,omitempty"`
+	Country      *string  `json:"country,omitempty"`
+	Visibility   string   `json:"visibility"`
+	ShareEnabled bool     `json:"share_enabled"`
+	// Private unless share_accessibility is on
+	AccessibilityNeeds []AccessibilityFeature `json:"accessibility_needs,omitempty"`
+	// Shows accessibility_needs on the public profile
+	ShareAccessibility *bool `json:"share_accessibility,omitempty"`
+	// Incremented on every profile edit; send it back on PATCH
+	Version      int                  `json:"version"`
+	CreatedOn    time.Time            `json:"created_on"`
+	UpdatedOn    time.Time            `json:"updated_on"`
+	Completeness *ProfileCompleteness `json:"completeness,omitempty"`
+}
+
+// ProfileCompleteness is the ProfileCompleteness schema.
+//
+// How filled-in the profile is, out of 100. Photo and bio are worth 20 points
+// each, interests 20 (full credit at 3), the questionnaire 25 (full credit
+// once the discovery minimum is answered across required categories) and
+// posted availability 15. Discovery unlocks at discovery_threshold.
+type ProfileCompleteness struct {
+	Score              *int                      `json:"score,omitempty"`
+	DiscoveryThreshold *int                      `json:"discovery_threshold,omitempty"`
+	DiscoveryUnlocked  *bool                     `json:"discovery_unlocked,omitempty"`
+	Items              []ProfileCompletenessItem `json:"items,omitempty"`
+	NextStep           *CompletenessNextStep     `json:"next_step,omitempty"`
+}
+
+// ProfileCompletenessItem is an element of the items property of
+// ProfileCompleteness.
+type ProfileCompletenessItem struct {
+	Item     *string  `json:"item,omitempty"`
+	Weight   *int     `json:"weight,omitempty"`
+	Progress *float64 `json:"progress,omitempty"`
+	Complete *bool    `json:"complete,omitempty"`
+}
+
+// UpdateProfileRequest is the UpdateProfileRequest schema.
+//
+// Merge patch of the profile. Omitted fields are unchanged; null clears bio,
+// tagline, photo_url, timezone, location or accessibility_needs. Immutable
+// fields such as user_id are rejected.
+type UpdateProfileRequest struct {
+	DisplayName *string `json:"display_name,omitempty"`
+	Bio         *string `json:"bio,omitempty"`
+	// https URL of the profile photo
+	PhotoURL  *string `json:"photo_url,omitempty"`
+	AvatarURL *string `json:"avatar_url,omitempty"`
+	// Languages the user speaks as ISO 639-1 codes (e.g. en, es); duplicates
+	// are dropped
+	Languages    []string                      `json:"languages,omitempty"`
+	Location     *UpdateProfileRequestLocation `json:"location,omitempty"`
+	Visibility   *string                       `json:"visibility,omitempty"`
+	ShowDistance *bool                         `json:"show_distance,omitempty"`
+	ShowOnline   *bool                         `json:"show_online,omitempty"`
+	ShareEnabled *bool                         `json:"share_enabled,omitempty"`
+	// Replaces the list; null clears it. Event discovery matches on these
+	// without other users seeing them unless share_accessibility is on.
+	AccessibilityNeeds []AccessibilityFeature `json:"accessibility_needs,omitempty"`
+	ShareAccessibility *bool                  `json:"share_accessibility,omitempty"`
+	// Version the edit is based on; rejected with 409 if the profile has
+	// changed since
+	Version *int `json:"version,omitempty"`
+}
+
+// UpdateProfileRequestLocation is the location property of
+// UpdateProfileRequest.
+type UpdateProfileRequestLocation struct {
+	Lat          float64 `json:"lat"`
+	Lng          float64 `json:"lng"`
+	City         *string `json:"city,omitempty"`
+	Neighborhood *string `json:"neighborhood,omitempty"`
+	Country      *string `json:"country,omitempty"`
+	CountryCode  *string `json:"country_code,omitempty"`
+}
+
+// NearbyProfile is the NearbyProfile schema.
+type NearbyProfile struct {
+	Profile            *PublicProfile `json:"profile,omitempty"`
+	DistanceKm         *float64       `json:"distance_km,omitempty"`
+	CompatibilityScore *float64       `json:"compatibility_score,omitempty"`
+}
+
+// AdminQuestionOption is the AdminQuestionOption schema.
+type AdminQuestionOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	// Internal weight used in compatibility scoring; never shown to members
+	ImplicitBias float64 `json:"implicit_bias"`
+}
+
+// AdminQuestion is the AdminQuestion schema.
+type AdminQuestion struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	// Question category key
+	Category              string                `json:"category"`
+	Options               []AdminQuestionOption `json:"options"`
+	IsDealbreakerEligible bool                  `json:"is_dealbreaker_eligible"`
+	SortOrder             int                   `json:"sort_order"`
+	Active                bool                  `json:"active"`
+	// Set when removed while still answered
+	DeprecatedOn *time.Time `json:"deprecated_on,omitempty"`
+	AnswerCount  int        `json:"answer_count"`
+	CreatedOn    time.Time  `json:"created_on"`
+}
+
+// CreateQuestionRequest is the CreateQuestionRequest schema.
+type CreateQuestionRequest struct {
+	Text string `json:"text"`
+	// Key of an active question category
+	Category              string                `json:"category"`
+	Options               []AdminQuestionOption `json:"options"`
+	IsDealbreakerEligible *bool                 `json:"is_dealbreaker_eligible,omitempty"`
+	SortOrder             *int                  `json:"sort_order,omitempty"`
+}
+
+// UpdateQuestionRequest is the UpdateQuestionRequest schema.
+type UpdateQuestionRequest struct {
+	Text                  *string               `json:"text,omitempty"`
+	Category              *string               `json:"category,omitempty"`
+	Options               []AdminQuestionOption `json:"options,omitempty"`
+	IsDealbreakerEligible *bool                 `json:"is_dealbreaker_eligible,omitempty"`
+	SortOrder             *int                  `json:"sort_order,omitempty"`
+	// Set true to restore a deprecated question
+	Active *bool `json:"active,omitempty"`
+}
+
+// AdminQuestionCategory is the AdminQuestionCategory schema.
+type AdminQuestionCategory struct {
+	Key       string  `json:"key"`
+	Label     string  `json:"label"`
+	Icon      *string `json:"icon,omitempty"`
+	SortOrder int     `json:"sort_order"`
+	Active    bool    `json:"active"`
+	// Set when removed while it still contained questions
+	DeprecatedOn *time.Time `json:"deprecated_on,omitempty"`
+	CreatedOn    time.Time  `json:"created_on"`
+	UpdatedOn    time.Time  `json:"updated_on"`
+}
+
+// CreateQuestionCategoryRequest is the CreateQuestionCategoryRequest schema.
+type CreateQuestionCategoryRequest struct {
+	Key       string  `json:"key"`
+	Label     string  `json:"label"`
+	Icon      *string `json:"icon,omitempty"`
+	SortOrder *int    `json:"sort_order,omitempty"`
+}
+
+// UpdateQuestionCategoryRequest is the UpdateQuestionCategoryRequest schema.
+type UpdateQuestionCategoryRequest struct {
+	Label     *string `json:"label,omitempty"`
+	Icon      *string `json:"icon,omitempty"`
+	SortOrder *int    `json:"sort_order,omitempty"`
+	// Set true to restore a deprecated category
+	Active *bool `json:"active,omitempty"`
+}
+
+// AdminReviewTag is the AdminReviewTag schema.
+type AdminReviewTag struct {
+	Kind      string  `json:"kind"`
+	Tag       string  `json:"tag"`
+	Label     string  `json:"label"`
+	Icon      *string `json:"icon,omitempty"`
+	SortOrder int     `json:"sort_order"`
+	Active    bool    `json:"active"`
+	// Set when removed while reviews still used it
+	DeprecatedOn *time.Time `json:"deprecated_on,omitempty"`
+	CreatedOn    time.Time  `json:"created_on"`
+	UpdatedOn    time.Time  `json:"updated_on"`
+}
+
+// CreateReviewTagRequest is the CreateReviewTagRequest schema.
+type CreateReviewTagRequest struct {
+	Tag       string  `json:"tag"`
+	Label     string  `json:"label"`
+	Icon      *string `json:"icon,omitempty"`
+	SortOrder *int    `json:"sort_order,omitempty"`
+}
+
+// UpdateReviewTagRequest is the UpdateReviewTagRequest schema.
+type UpdateReviewTagRequest struct {
+	Label     *string `json:"label,omitempty"`
+	Icon      *string `json:"icon,omitempty"`
+	SortOrder *int    `json:"sort_order,omitempty"`
+	// Set true to restore a deprecated tag
+	Active *bool `json:"active,omitempty"`
+}
+
+// ContentRemoval is the ContentRemoval schema.
+//
+// Items still referenced are deprecated, and hidden from public catalogs,
+// instead of deleted
+type ContentRemoval struct {
+	Deleted    bool `json:"deleted"`
+	Deprecated bool `json:"deprecated"`
+	// Answers, questions or reviews still referencing the item
+	References int `json:"references"`
+}
+
+// QuestionCategory is the QuestionCategory schema.
+type QuestionCategory struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	SortOrder   int     `json:"sort_order"`
+}
+
+// Question is the Question schema.
+type Question struct {
+	ID           string `json:"id"`
+	CategoryID   string `json:"category_id"`
+	QuestionText string `json:"question_text"`
+	QuestionType string `json:"question_type"`
+	// For multiple_choice questions
+	Options  []string `json:"options,omitempty"`
+	ScaleMin *int     `json:"scale_min,omitempty"`
+	ScaleMax *int     `json:"scale_max,omitempty"`
+	// Impact on compatibility score
+	Weight    *float64 `json:"weight,omitempty"`
+	IsActive  bool     `json:"is_active"`
+	SortOrder *int     `json:"sort_order,omitempty"`
+}
+
+// Answer is the Answer schema.
+type Answer struct {
+	ID         string `json:"id"`
+	UserID     string `json:"user_id"`
+	QuestionID string `json:"question_id"`
+	// The user's answer
+	AnswerValue *string `json:"answer_value,omitempty"`
+	Importance  *string `json:"importance,omitempty"`
+	// Answers user finds acceptable in others
+	AcceptableAnswers []string   `json:"acceptable_answers,omitempty"`
+	CreatedOn         time.Time  `json:"created_on"`
+	UpdatedOn         *time.Time `json:"updated_on,omitempty"`
+}
+
+// AnswerQuestionRequest is the AnswerQuestionRequest schema.
+type AnswerQuestionRequest struct {
+	SelectedOption string `json:"selected_option"`
+	// Answers you'd accept from others; all options when omitted
+	AcceptableOptions []string `json:"acceptable_options,omitempty"`
+	Importance        *string  `json:"importance,omitempty"`
+	IsDealbreaker     *bool    `json:"is_dealbreaker,omitempty"`
+	AlignmentWeight   *float64 `json:"alignment_weight,omitempty"`
+	// Answers that are red flags
+	YikesOptions []string `json:"yikes_options,omitempty"`
+}
+
+// QuestionWithAnswer is the QuestionWithAnswer schema.
+type QuestionWithAnswer struct {
+	Question   *Question `json:"question,omitempty"`
+	Answer     *Answer   `json:"answer,omitempty"`
+	IsAnswered *bool     `json:"is_answered,omitempty"`
+}
+
+// CompatibilityMatch is the CompatibilityMatch schema.
+type CompatibilityMatch struct {
+	UserID      *string `json:"user_id,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	// 0-100 compatibility percentage
+	OverallScore       *float64           `json:"overall_score,omitempty"`
+	CategoryScores     map[string]float64 `json:"category_scores,omitempty"`
+	SharedAnswers      *int               `json:"shared_answers,omitempty"`
+	ConflictingAnswers *int               `json:"conflicting_answers,omitempty"`
+}
+
+// BiasCategory is the BiasCategory schema.
+type BiasCategory struct {
+	Category      *string `json:"category,omitempty"`
+	BiasDirection *string `json:"bias_direction,omitempty"`
+	// 0-1 indicating how strong the bias is
+	Strength *float64 `json:"strength,omitempty"`
+}
+
+// BiasProfile is the BiasProfile schema.
+type BiasProfile struct {
+	UserID      *string        `json:"user_id,omitempty"`
+	Categories  []BiasCategory `json:"categories,omitempty"`
+	AnswerCount *int           `json:"answer_count,omitempty"`
+}
+
+// UserInterest is the UserInterest schema.
+type UserInterest struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	InterestID  string     `json:"interest_id"`
+	Interest    *Interest  `json:"interest,omitempty"`
+	Level       string     `json:"level"`
+	WantToTeach *bool      `json:"want_to_teach,omitempty"`
+	WantToLearn *bool      `json:"want_to_learn,omitempty"`
+	Notes       *string    `json:"notes,omitempty"`
+	CreatedOn   time.Time  `json:"created_on"`
+	UpdatedOn   *time.Time `json:"updated_on,omitempty"`
+}
+
+// AddInterestRequest is the AddInterestRequest schema.
+type AddInterestRequest struct {
+	InterestID  string  `json:"interest_id"`
+	Level       string  `json:"level"`
+	WantToTeach *bool   `json:"want_to_teach,omitempty"`
+	WantToLearn *bool   `json:"want_to_learn,omitempty"`
+	Notes       *string `json:"notes,omitempty"`
+}
+
+// UpdateInterestRequest is the UpdateInterestRequest schema.
+type UpdateInterestRequest struct {
+	Level       *string `json:"level,omitempty"`
+	WantToTeach *bool   `json:"want_to_teach,omitempty"`
+	WantToLearn *bool   `json:"want_to_learn,omitempty"`
+	Notes       *string `json:"notes,omitempty"`
+}
+
+// InterestCategory is the InterestCategory schema.
+type InterestCategory struct {
+	Category  *string    `json:"category,omitempty"`
+	Interests []Interest `json:"interests,omitempty"`
+}
+
+// TeachMatch is the TeachMatch schema.
+type TeachMatch struct {
+	User       *PublicProfile `json:"user,omitempty"`
+	Interest   *Interest      `json:"interest,omitempty"`
+	TheirLevel *string        `json:"their_level,omitempty"`
+	DistanceKm *float64       `json:"distance_km,omitempty"`
+}
+
+// LearnMatch is the LearnMatch schema.
+type LearnMatch struct {
+	User       *PublicProfile `json:"user,omitempty"`
+	Interest   *Interest      `json:"interest,omitempty"`
+	TheirLevel *string        `json:"their_level,omitempty"`
+	DistanceKm *float64       `json:"distance_km,omitempty"`
+}
+
+// Review is the Review schema.
+type Review struct {
+	ID          string  `json:"id"`
+	ReviewerID  string  `json:"reviewer_id"`
+	RevieweeID  string  `json:"reviewee_id"`
+	ContextType string  `json:"context_type"`
+	ContextID   string  `json:"context_id"`
+	Rating      int     `json:"rating"`
+	Comment     *string `json:"comment,omitempty"`
+	// Predefined positive/negative tags
+	Tags      []string   `json:"tags,omitempty"`
+	IsPublic  *bool      `json:"is_public,omitempty"`
+	CreatedOn time.Time  `json:"created_on"`
+	UpdatedOn *time.Time `json:"updated_on,omitempty"`
+}
+
+// CreateReviewRequest is the CreateReviewRequest schema.
+type CreateReviewRequest struct {
+	RevieweeID string `json:"reviewee_id"`
+	Context    string `json:"context"`
+	// The event, match or hangout reviewed
+	ReferenceID     *string  `json:"reference_id,omitempty"`
+	WouldMeetAgain  *bool    `json:"would_meet_again,omitempty"`
+	PositiveTags    []string `json:"positive_tags,omitempty"`
+	ImprovementTags []string `json:"improvement_tags,omitempty"`
+	// Only visible to the reviewee
+	PrivateNote *string `json:"private_note,omitempty"`
+}
+
+// ReputationSummary is the ReputationSummary schema.
+type ReputationSummary struct {
+	UserID             *string                                           `json:"user_id,omitempty"`
+	AverageRating      *float64                                          `json:"average_rating,omitempty"`
+	TotalReviews       *int                                              `json:"total_reviews,omitempty"`
+	RatingDistribution *ReputationSummaryRatingDistribution              `json:"rating_distribution,omitempty"`
+	TopTags            []ReputationSummaryTopTag                         `json:"top_tags,omitempty"`
+	ContextBreakdown   map[string]ReputationSummaryContextBreakdownValue `json:"context_breakdown,omitempty"`
+}
+
+// ReputationSummaryRatingDistribution is the rating_distribution property of
+// ReputationSummary.
+type ReputationSummaryRatingDistribution struct {
+	OneStar   *int `json:"one_star,omitempty"`
+	TwoStar   *int `json:"two_star,omitempty"`
+	ThreeStar *int `json:"three_star,omitempty"`
+	FourStar  *int `json:"four_star,omitempty"`
+	FiveStar  *int `json:"five_star,omitempty"`
+}
+
+// ReputationSummaryTopTag is an element of the top_tags property of
+// ReputationSummary.
+type ReputationSummaryTopTag struct {
+	Tag   *string `json:"tag,omitempty"`
+	Count *int    `json:"count,omitempty"`
+}
+
+// ReputationSummaryContextBreakdownValue is a value of the context_breakdown
+// property of ReputationSummary.
+type ReputationSummaryContextBreakdownValue struct {
+	Average *float64 `json:"average,omitempty"`
+	Count   *int     `json:"count,omitempty"`
+}
+
+// PendingReview is the PendingReview schema.
+type PendingReview struct {
+	ContextType  *string    `json:"context_type,omitempty"`
+	ContextID    *string    `json:"context_id,omitempty"`
+	RevieweeID   *string    `json:"reviewee_id,omitempty"`
+	RevieweeName *string    `json:"reviewee_name,omitempty"`
+	EventTitle   *string    `json:"event_title,omitempty"`
+	CompletedOn  *time.Time `json:"completed_on,omitempty"`
+}
+
+// TrustGrant is the TrustGrant schema.
+type TrustGrant struct {
+	ID          string     `json:"id"`
+	GrantorID   string     `json:"grantor_id"`
+	GranteeID   string     `json:"grantee_id"`
+	TrustLevel  string     `json:"trust_level"`
+	Permissions []string   `json:"permissions,omitempty"`
+	Notes       *string    `json:"notes,omitempty"`
+	CreatedOn   time.Time  `json:"created_on"`
+	UpdatedOn   *time.Time `json:"updated_on,omitempty"`
+}
+
+// CreateTrustRequest is the CreateTrustRequest schema.
+type CreateTrustRequest struct {
+	GranteeID   string   `json:"grantee_id"`
+	TrustLevel  string   `json:"trust_level"`
+	Permissions []string `json:"permissions,omitempty"`
+	Notes       *string  `json:"notes,omitempty"`
+}
+
+// UpdateTrustRequest is the UpdateTrustRequest schema.
+type UpdateTrustRequest struct {
+	TrustLevel  *string  `json:"trust_level,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Notes       *string  `json:"notes,omitempty"`
+}
+
+// TrustSummary is the TrustSummary schema.
+type TrustSummary struct {
+	UserID            *string `json:"user_id,omitempty"`
+	TrustedByCount    *int    `json:"trusted_by_count,omitempty"`
+	TrustsCount       *int    `json:"trusts_count,omitempty"`
+	MutualTrustCount  *int    `json:"mutual_trust_count,omitempty"`
+	IrlConfirmedCount *int    `json:"irl_confirmed_count,omitempty"`
+}
+
+// IRLConfirmation is the IRLConfirmation schema.
+type IRLConfirmation struct {
+	ID          string     `json:"id"`
+	RequesterID string     `json:"requester_id"`
+	TargetID    string     `json:"target_id"`
+	Context     string     `json:"context"`
+	ContextID   *string    `json:"context_id,omitempty"`
+	Location    *string    `json:"location,omitempty"`
+	Status      string     `json:"status"`
+	CreatedOn   time.Time  `json:"created_on"`
+	ConfirmedOn *time.Time `json:"confirmed_on,omitempty"`
+}
+
+// RequestIRLRequest is the RequestIRLRequest schema.
+type RequestIRLRequest struct {
+	TargetID  string  `json:"target_id"`
+	Context   string  `json:"context"`
+	ContextID *string `json:"context_id,omitempty"`
+	Location  *string `json:"location,omitempty"`
+}
+
+// AcceptIRLRequest is the AcceptIRLRequest schema.
+type AcceptIRLRequest struct {
+	Confirm *bool `json:"confirm,omitempty"`
+}
+
+// Pool is the Pool schema.
+type Pool struct {
+	ID             string     `json:"id"`
+	GuildID        string     `json:"guild_id"`
+	Name           string     `json:"name"`
+	Description    *string    `json:"description,omitempty"`
+	MatchSize      int        `json:"match_size"`
+	MatchFrequency string     `json:"match_frequency"`
+	NextMatchAt    *time.Time `json:"next_match_at,omitempty"`
+	Status         string     `json:"status"`
+	MemberCount    *int       `json:"member_count,omitempty"`
+	// Guild tier needed to join; higher ranked tiers qualify too
+	MinTier   *string   `json:"min_tier,omitempty"`
+	CreatedBy *string   `json:"created_by,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// CreatePoolRequest is the CreatePoolRequest schema.
+type CreatePoolRequest struct {
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	MatchSize      *int    `json:"match_size,omitempty"`
+	MatchFrequency *string `json:"match_frequency,omitempty"`
+	// Only members holding this guild tier or a higher one can join
+	MinTier *string `json:"min_tier,omitempty"`
+}
+
+// UpdatePoolRequest is the UpdatePoolRequest schema.
+type UpdatePoolRequest struct {
+	Name           *string `json:"name,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	MatchSize      *int    `json:"match_size,omitempty"`
+	MatchFrequency *string `json:"match_frequency,omitempty"`
+	Status         *string `json:"status,omitempty"`
+	// Guild tier needed to join; an empty string opens the pool to every
+	// member. Current members stay.
+	MinTier *string `json:"min_tier,omitempty"`
+}
+
+// GuildTier is the GuildTier schema.
+//
+// A membership level a guild hands out, separate from member roles. Events and
+// pools gated on a tier are open to every tier ranked at or above it.
+type GuildTier struct {
+	ID      string `json:"id"`
+	GuildID string `json:"guild_id"`
+	// Stable identifier, unique in the guild
+	Key         string  `json:"key"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	// Higher ranks include the perks of lower ones
+	Rank int `json:"rank"`
+	// How many hours before an event's rsvp_opens_at holders can RSVP
+	EarlyRsvpHours int        `json:"early_rsvp_hours"`
+	CreatedOn      *time.Time `json:"created_on,omitempty"`
+	UpdatedOn      *time.Time `json:"updated_on,omitempty"`
+}
+
+// GuildHealthReport is the GuildHealthReport schema.
+type GuildHealthReport struct {
+	Current GuildHealth `json:"current"`
+	// Daily scores, newest first
+	History []GuildHealthReportHistoryItem `json:"history"`
+}
+
+// GuildHealth is the GuildHealth schema.
+//
+// A guild's health on one day. The score blends event frequency (30%), RSVP
+// rate (30%), member churn (20%) and pool participation (20%) over the last 28
+// days. Churn is left out, and the other parts weighted up, until there is a
+// snapshot 28 days old to compare membership against.
+type GuildHealth struct {
+	ID      string `json:"id"`
+	GuildID string `json:"guild_id"`
+	// UTC date the snapshot is for
+	Day   string `json:"day"`
+	Score int    `json:"score"`
+	// The score a week earlier, when there is one
+	PreviousScore *int `json:"previous_score,omitempty"`
+	// The score is below 40, or fell 15 or more points in a week
+	AtRisk  bool               `json:"at_risk"`
+	Metrics GuildHealthMetrics `json:"metrics"`
+	// Share of members who left over the last 28 days
+	ChurnRate *float64 `json:"churn_rate,omitempty"`
+	// Each part of the score, from 0 to 1
+	Components       GuildHealthComponents   `json:"components"`
+	SuggestedActions []GuildHealthSuggestion `json:"suggested_actions"`
+	// When organizers were alerted about this snapshot's drop
+	AlertedOn  *time.Time `json:"alerted_on,omitempty"`
+	ComputedOn time.Time  `json:"computed_on"`
+}
+
+// GuildHealthMetrics is the metrics property of GuildHealth.
+type GuildHealthMetrics struct {
+	Members *int `json:"members,omitempty"`
+	// Members who joined since the churn baseline
+	Joined           *int `json:"joined,omitempty"`
+	Events           *int `json:"events,omitempty"`
+	ApprovedRsvps    *int `json:"approved_rsvps,omitempty"`
+	ActivePools      *int `json:"active_pools,omitempty"`
+	PoolParticipants *int `json:"pool_participants,omitempty"`
+}
+
+// GuildHealthComponents is the components property of GuildHealth.
+//
+// Each part of the score, from 0 to 1
+type GuildHealthComponents struct {
+	EventFrequency    *float64 `json:"event_frequency,omitempty"`
+	RsvpRate          *float64 `json:"rsvp_rate,omitempty"`
+	MemberChurn       *float64 `json:"member_churn,omitempty"`
+	PoolParticipation *float64 `json:"pool_participation,omitempty"`
+}
+
+// GuildHealthSuggestion is the GuildHealthSuggestion schema.
+type GuildHealthSuggestion struct {
+	Action  string `json:"action"`
+	Message string `json:"message"`
+}
+
+// GuildHealthReportHistoryItem is an element of the history property of
+// GuildHealthReport.
+type GuildHealthReportHistoryItem struct {
+	Day   string `json:"day"`
+	Score int    `json:"score"`
+}
+
+// WinBackStats is the WinBackStats schema.
+type WinBackStats struct {
+	Since     time.Time         `json:"since"`
+	Treatment WinBackGroupStats `json:"treatment"`
+	Holdout   WinBackGroupStats `json:"holdout"`
+	// Treatment return rate minus holdout return rate. Omitted until both
+	// groups have users.
+	Lift *float64 `json:"lift,omitempty"`
+}
+
+// WinBackGroupStats is the WinBackGroupStats schema.
+type WinBackGroupStats struct {
+	Group    string `json:"group"`
+	Targeted int    `json:"targeted"`
+	// Users active again within 14 days of being targeted
+	Returned   int     `json:"returned"`
+	ReturnRate float64 `json:"return_rate"`
+}
+
+// CreateGuildTierRequest is the CreateGuildTierRequest schema.
+type CreateGuildTierRequest struct {
+	Key            string  `json:"key"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	Rank           int     `json:"rank"`
+	EarlyRsvpHours *int    `json:"early_rsvp_hours,omitempty"`
+}
+
+// UpdateGuildTierRequest is the UpdateGuildTierRequest schema.
+//
+// The key can't change
+type UpdateGuildTierRequest struct {
+	Name           *string `json:"name,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Rank           *int    `json:"rank,omitempty"`
+	EarlyRsvpHours *int    `json:"early_rsvp_hours,omitempty"`
+}
+
+// GuildTierAssignment is the GuildTierAssignment schema.
+//
+// A guild member's tier
+type GuildTierAssignment struct {
+	ID         string    `json:"id"`
+	GuildID    string    `json:"guild_id"`
+	UserID     string    `json:"user_id"`
+	TierID     string    `json:"tier_id"`
+	AssignedBy string    `json:"assigned_by"`
+	AssignedOn time.Time `json:"assigned_on"`
+	// Omitted when the tier is held until removed
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+	Tier      *GuildTier `json:"tier,omitempty"`
+}
+
+// AssignGuildTierRequest is the AssignGuildTierRequest schema.
+type AssignGuildTierRequest struct {
+	TierID string `json:"tier_id"`
+	// When the tier lapses; in the future
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+}
+
+// PoolMember is the PoolMember schema.
+type PoolMember struct {
+	ID          string                 `json:"id"`
+	PoolID      string                 `json:"pool_id"`
+	UserID      string                 `json:"user_id"`
+	User        *PublicProfile         `json:"user,omitempty"`
+	Status      string                 `json:"status"`
+	Preferences *PoolMemberPreferences `json:"preferences,omitempty"`
+	JoinedOn    time.Time              `json:"joined_on"`
+}
+
+// PoolMemberPreferences is the preferences property of PoolMember.
+type PoolMemberPreferences struct {
+	SkipNext       *bool    `json:"skip_next,omitempty"`
+	PreferredTimes []string `json:"preferred_times,omitempty"`
+}
+
+// JoinPoolRequest is the JoinPoolRequest schema.
+type JoinPoolRequest struct {
+	Preferences *JoinPoolRequestPreferences `json:"preferences,omitempty"`
+}
+
+// JoinPoolRequestPreferences is the preferences property of JoinPoolRequest.
+type JoinPoolRequestPreferences struct {
+	PreferredTimes []string `json:"preferred_times,omitempty"`
+}
+
+// PoolWithMembers is the PoolWithMembers schema.
+type PoolWithMembers struct {
+	Pool         *Pool        `json:"pool,omitempty"`
+	Members      []PoolMember `json:"members,omitempty"`
+	MyMembership *PoolMember  `json:"my_membership,omitempty"`
+}
+
+// UpdateMembershipRequest is the UpdateMembershipRequest schema.
+type UpdateMembershipRequest struct {
+	Status      *string                             `json:"status,omitempty"`
+	Preferences *UpdateMembershipRequestPreferences `json:"preferences,omitempty"`
+}
+
+// UpdateMembershipRequestPreferences is the preferences property of
+// UpdateMembershipRequest.
+type UpdateMembershipRequestPreferences struct {
+	SkipNext       *bool    `json:"skip_next,omitempty"`
+	PreferredTimes []string `json:"preferred_times,omitempty"`
+}
+
+// PoolMatch is the PoolMatch schema.
+type PoolMatch struct {
+	ID            string          `json:"id"`
+	PoolID        string          `json:"pool_id"`
+	Participants  []PublicProfile `json:"participants"`
+	ScheduledTime *time.Time      `json:"scheduled_time,omitempty"`
+	Location      *string         `json:"location,omitempty"`
+	Status        string          `json:"status"`
+	MatchedOn     time.Time       `json:"matched_on"`
+}
+
+// UpdateMatchRequest is the UpdateMatchRequest schema.
+type UpdateMatchRequest struct {
+	ScheduledTime *time.Time `json:"scheduled_time,omitempty"`
+	Location      *string    `json:"location,omitempty"`
+	Status        *string    `json:"status,omitempty"`
+}
+
+// PoolStats is the PoolStats schema.
+type PoolStats struct {
+	PoolID                     *string  `json:"pool_id,omitempty"`
+	TotalMembers               *int     `json:"total_members,omitempty"`
+	ActiveMembers              *int     `json:"active_members,omitempty"`
+	TotalMatches               *int     `json:"total_matches,omitempty"`
+	CompletedMatches           *int     `json:"completed_matches,omitempty"`
+	CompletionRate             *float64 `json:"completion_rate,omitempty"`
+	AverageSchedulingTimeHours *float64 `json:"average_scheduling_time_hours,omitempty"`
+}
+
+// Report is the Report schema.
+type Report struct {
+	ID             string     `json:"id"`
+	ReporterID     string     `json:"reporter_id"`
+	ReportedUserID string     `json:"reported_user_id"`
+	Category       string     `json:"category"`
+	Description    *string    `json:"description,omitempty"`
+	EvidenceURLs   []string   `json:"evidence_urls,omitempty"`
+	Status         string     `json:"status"`
+	Resolution     *string    `json:"resolution,omitempty"`
+	ResolvedBy     *string    `json:"resolved_by,omitempty"`
+	ResolvedOn     *time.Time `json:"resolved_on,omitempty"`
+	CreatedOn      time.Time  `json:"created_on"`
+}
+
+// CreateReportRequest is the CreateReportRequest schema.
+type CreateReportRequest struct {
+	ReportedUserID string   `json:"reported_user_id"`
+	Category       string   `json:"category"`
+	Description    *string  `json:"description,omitempty"`
+	EvidenceURLs   []string `json:"evidence_urls,omitempty"`
+}
+
+// ReviewReportRequest is the ReviewReportRequest schema.
+type ReviewReportRequest struct {
+	Status      string  `json:"status"`
+	Notes       *string `json:"notes,omitempty"`
+	ActionTaken *string `json:"action_taken,omitempty"`
+}
+
+// ModerationAction is the ModerationAction schema.
+type ModerationAction struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	ActionType string     `json:"action_type"`
+	Reason     string     `json:"reason"`
+	ReportID   *string    `json:"report_id,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	IsActive   *bool      `json:"is_active,omitempty"`
+	CreatedBy  string     `json:"created_by"`
+	CreatedOn  time.Time  `json:"created_on"`
+	LiftedOn   *time.Time `json:"lifted_on,omitempty"`
+	LiftedBy   *string    `json:"lifted_by,omitempty"`
+}
+
+// CreateModerationActionRequest is the CreateModerationActionRequest schema.
+type CreateModerationActionRequest struct {
+	UserID string `json:"user_id"`
+	// Suspensions and bans need an admin
+	Level    string  `json:"level"`
+	Reason   string  `json:"reason"`
+	ReportID *string `json:"report_id,omitempty"`
+	// For suspensions
+	DurationDays *int     `json:"duration_days,omitempty"`
+	Restrictions []string `json:"restrictions,omitempty"`
+}
+
+// LiftActionRequest is the LiftActionRequest schema.
+type LiftActionRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// Block is the Block schema.
+type Block struct {
+	ID        string    `json:"id"`
+	BlockerID string    `json:"blocker_id"`
+	BlockedID string    `json:"blocked_id"`
+	Reason    *string   `json:"reason,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// CreateBlockRequest is the CreateBlockRequest schema.
+type CreateBlockRequest struct {
+	BlockedUserID string  `json:"blocked_user_id"`
+	Reason        *string `json:"reason,omitempty"`
+}
+
+// UserModerationStatus is the UserModerationStatus schema.
+type UserModerationStatus struct {
+	UserID         *string    `json:"user_id,omitempty"`
+	IsBanned       *bool      `json:"is_banned,omitempty"`
+	BanExpiresAt   *time.Time `json:"ban_expires_at,omitempty"`
+	ActiveWarnings *int       `json:"active_warnings,omitempty"`
+	Restrictions   []string   `json:"restrictions,omitempty"`
+}
+
+// ModerationStats is the ModerationStats schema.
+type ModerationStats struct {
+	TotalReports           *int           `json:"total_reports,omitempty"`
+	PendingReports         *int           `json:"pending_reports,omitempty"`
+	ResolvedThisWeek       *int           `json:"resolved_this_week,omitempty"`
+	ActiveBans             *int           `json:"active_bans,omitempty"`
+	WarningsIssuedThisWeek *int           `json:"warnings_issued_this_week,omitempty"`
+	CategoryBreakdown      map[string]int `json:"category_breakdown,omitempty"`
+}
+
+// ResonanceDisplay is the ResonanceDisplay schema.
+type ResonanceDisplay struct {
+	UserID     *string `json:"user_id,omitempty"`
+	TotalScore *int    `json:"total_score,omitempty"`
+	Level      *int    `json:"level,omitempty"`
+	LevelName  *string `json:"level_name,omitempty"`
+	// 0-1 percentage to next level
+	ProgressToNext *float64 `json:"progress_to_next,omitempty"`
+	// Only shown for own profile
+	Breakdown    *ResonanceDisplayBreakdown `json:"breakdown,omitempty"`
+	StreakDays   *int                       `json:"streak_days,omitempty"`
+	LastActivity *time.Time                 `json:"last_activity,omitempty"`
+}
+
+// ResonanceDisplayBreakdown is the breakdown property of ResonanceDisplay.
+//
+// Only shown for own profile
+type ResonanceDisplayBreakdown struct {
+	EventsHosted      *int `json:"events_hosted,omitempty"`
+	EventsAttended    *int `json:"events_attended,omitempty"`
+	ReviewsGiven      *int `json:"reviews_given,omitempty"`
+	TrustReceived     *int `json:"trust_received,omitempty"`
+	HangoutsCompleted *int `json:"hangouts_completed,omitempty"`
+	TeachingSessions  *int `json:"teaching_sessions,omitempty"`
+}
+
+// ResonanceLedgerEntry is the ResonanceLedgerEntry schema.
+type ResonanceLedgerEntry struct {
+	ID          string  `json:"id"`
+	UserID      string  `json:"user_id"`
+	ActionType  string  `json:"action_type"`
+	Points      int     `json:"points"`
+	Description *string `json:"description,omitempty"`
+	// ID of related event/review/etc
+	ReferenceID *string   `json:"reference_id,omitempty"`
+	CreatedOn   time.Time `json:"created_on"`
+}
+
+// ResonanceExplainer is the ResonanceExplainer schema.
+type ResonanceExplainer struct {
+	Levels []ResonanceExplainerLevel `json:"levels,omitempty"`
+	// Points earned for each action type
+	PointValues map[string]int `json:"point_values,omitempty"`
+	// Maximum points earnable per action type per day
+	DailyCaps map[string]int `json:"daily_caps,omitempty"`
+}
+
+// ResonanceExplainerLevel is an element of the levels property of
+// ResonanceExplainer.
+type ResonanceExplainerLevel struct {
+	Level     *int     `json:"level,omitempty"`
+	Name      *string  `json:"name,omitempty"`
+	MinPoints *int     `json:"min_points,omitempty"`
+	Perks     []string `json:"perks,omitempty"`
+}
+
+// Device is the Device schema.
+type Device struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	DeviceType string     `json:"device_type"`
+	DeviceName *string    `json:"device_name,omitempty"`
+	LastActive *time.Time `json:"last_active,omitempty"`
+	CreatedOn  time.Time  `json:"created_on"`
+}
+
+// DeviceToken is the DeviceToken schema.
+type DeviceToken struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	Token      string     `json:"token"`
+	Platform   string     `json:"platform"`
+	DeviceName *string    `json:"device_name,omitempty"`
+	IsActive   *bool      `json:"is_active,omitempty"`
+	CreatedOn  time.Time  `json:"created_on"`
+	UpdatedOn  *time.Time `json:"updated_on,omitempty"`
+}
+
+// RegisterDeviceRequest is the RegisterDeviceRequest schema.
+type RegisterDeviceRequest struct {
+	Token      string  `json:"token"`
+	Platform   string  `json:"platform"`
+	DeviceName *string `json:"device_name,omitempty"`
+}
+
+// QuestionnaireExport is the QuestionnaireExport schema.
+type QuestionnaireExport struct {
+	FormatVersion int                         `json:"format_version"`
+	ExportedOn    *time.Time                  `json:"exported_on,omitempty"`
+	Answers       []QuestionnaireExportAnswer `json:"answers"`
+}
+
+// QuestionnaireExportAnswer is an element of the answers property of
+// QuestionnaireExport.
+type QuestionnaireExportAnswer struct {
+	QuestionID        string   `json:"question_id"`
+	QuestionVersion   *string  `json:"question_version,omitempty"`
+	SelectedOption    string   `json:"selected_option"`
+	AcceptableOptions []string `json:"acceptable_options,omitempty"`
+	Importance        *string  `json:"importance,omitempty"`
+	IsDealbreaker     *bool    `json:"is_dealbreaker,omitempty"`
+	AlignmentWeight   *float64 `json:"alignment_weight,omitempty"`
+	YikesOptions      []string `json:"yikes_options,omitempty"`
+}
+
+// GuildQuestionRequest is the GuildQuestionRequest schema.
+type GuildQuestionRequest struct {
+	Text      string                       `json:"text"`
+	Options   []GuildQuestionRequestOption `json:"options"`
+	SortOrder *int                         `json:"sort_order,omitempty"`
+}
+
+// GuildQuestionRequestOption is an element of the options property of
+// GuildQuestionRequest.
+type GuildQuestionRequestOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// UpdateGuildQuestionRequest is the UpdateGuildQuestionRequest schema.
+type UpdateGuildQuestionRequest struct {
+	Text      *string                            `json:"text,omitempty"`
+	Options   []UpdateGuildQuestionRequestOption `json:"options,omitempty"`
+	SortOrder *int                               `json:"sort_order,omitempty"`
+	// Set true to restore a retired question
+	Active *bool `json:"active,omitempty"`
+}
+
+// UpdateGuildQuestionRequestOption is an element of the options property of
+// UpdateGuildQuestionRequest.
+type UpdateGuildQuestionRequestOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// GuildAnswer is the GuildAnswer schema.
+type GuildAnswer struct {
+	ID             string     `json:"id"`
+	GuildID        string     `json:"guild_id"`
+	QuestionID     string     `json:"question_id"`
+	UserID         string     `json:"user_id"`
+	SelectedOption string     `json:"selected_option"`
+	CreatedOn      *time.Time `json:"created_on,omitempty"`
+	UpdatedOn      *time.Time `json:"updated_on,omitempty"`
+}
+
+// ActivityEntry is the ActivityEntry schema.
+type ActivityEntry struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	Type   string `json:"type"`
+	// User who took the action, when it was taken toward this user
+	ActorID     *string `json:"actor_id,omitempty"`
+	SubjectType string  `json:"subject_type"`
+	// ID of the guild, event, match or review; for trust, the other user's ID
+	SubjectID string `json:"subject_id"`
+	// Display hints such as guild_name, event_title, pool_name or rsvp status
+	Data      map[string]string `json:"data,omitempty"`
+	CreatedOn time.Time         `json:"created_on"`
+}
+
+// SetHandleRequest is the SetHandleRequest schema.
+type SetHandleRequest struct {
+	Handle string `json:"handle"`
+}
+
+// HandleResponse is the HandleResponse schema.
+type HandleResponse struct {
+	Handle    *string    `json:"handle,omitempty"`
+	ChangedOn *time.Time `json:"changed_on,omitempty"`
+	// When the handle may next be changed
+	NextChangeOn *time.Time `json:"next_change_on,omitempty"`
+}
+
+// CreateShareLinkRequest is the CreateShareLinkRequest schema.
+type CreateShareLinkRequest struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	// Let whoever accepts the link join the guild or adventure (guild admins
+	// and adventure organizers only)
+	Invite         *bool `json:"invite,omitempty"`
+	ExpiresInHours *int  `json:"expires_in_hours,omitempty"`
+	// Invite links only; omit for unlimited redemptions
+	MaxUses *int `json:"max_uses,omitempty"`
+}
+
+// ShareLink is the ShareLink schema.
+type ShareLink struct {
+	ID string `json:"id"`
+	// Signed token; the only way to address the link
+	Token      string     `json:"token"`
+	URL        string     `json:"url"`
+	TargetType string     `json:"target_type"`
+	TargetID   string     `json:"target_id"`
+	CreatedBy  string     `json:"created_by"`
+	Invite     bool       `json:"invite"`
+	MaxUses    *int       `json:"max_uses,omitempty"`
+	UseCount   int        `json:"use_count"`
+	ExpiresOn  *time.Time `json:"expires_on,omitempty"`
+	CreatedOn  time.Time  `json:"created_on"`
+}
+
+// SharePreview is the SharePreview schema.
+//
+// Unfurl data for a shared target. Restricted previews carry only the title.
+type SharePreview struct {
+	TargetType  string     `json:"target_type"`
+	Title       string     `json:"title"`
+	Description *string    `json:"description,omitempty"`
+	ImageURL    *string    `json:"image_url,omitempty"`
+	StartsOn    *time.Time `json:"starts_on,omitempty"`
+	EndsOn      *time.Time `json:"ends_on,omitempty"`
+	City        *string    `json:"city,omitempty"`
+	Status      *string    `json:"status,omitempty"`
+	MemberCount *int       `json:"member_count,omitempty"`
+	// True when the target is not public and details were withheld
+	Restricted bool `json:"restricted"`
+}
+
+// ShareLinkResolution is the ShareLinkResolution schema.
+type ShareLinkResolution struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	// True while the link can still be accepted as an invite
+	Invite    bool         `json:"invite"`
+	ExpiresOn *time.Time   `json:"expires_on,omitempty"`
+	Preview   SharePreview `json:"preview"`
+}
+
+// OpenGraphPreview is the OpenGraphPreview schema.
+//
+// Unfurl metadata for a share link. Restricted previews of non-public targets
+// keep the title, replace the description and omit the image and structured
+// data.
+type OpenGraphPreview struct {
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	ImageURL    *string `json:"image_url,omitempty"`
+	// Canonical share URL
+	URL string `json:"url"`
+	// og:type
+	Type       string `json:"type"`
+	SiteName   string `json:"site_name"`
+	Restricted bool   `json:"restricted"`
+	// schema.org JSON-LD (Event for events and adventures, Organization for
+	// guilds)
+	StructuredData map[string]any `json:"structured_data,omitempty"`
+}
+
+// AnnouncementArea is the AnnouncementArea schema.
+type AnnouncementArea struct {
+	Lat      float64 `json:"lat"`
+	Lng      float64 `json:"lng"`
+	RadiusKm float64 `json:"radius_km"`
+}
+
+// CreateAnnouncementRequest is the CreateAnnouncementRequest schema.
+//
+// Without send_at the announcement is saved as a draft. On the guild endpoint
+// audience, guild_id and area are ignored; the announcement goes to the guild
+// in the path.
+type CreateAnnouncementRequest struct {
+	Title    string  `json:"title"`
+	Body     string  `json:"body"`
+	Audience *string `json:"audience,omitempty"`
+	// Required when audience is guild
+	GuildID *string           `json:"guild_id,omitempty"`
+	Area    *AnnouncementArea `json:"area,omitempty"`
+	// Also send a push notification
+	Push *bool `json:"push,omitempty"`
+	// Schedule delivery; must be in the future and within 90 days
+	SendAt *time.Time `json:"send_at,omitempty"`
+}
+
+// UpdateAnnouncementRequest is the UpdateAnnouncementRequest schema.
+//
+// Edit a draft or scheduled announcement. The audience cannot be changed.
+type UpdateAnnouncementRequest struct {
+	Title *string `json:"title,omitempty"`
+	Body  *string `json:"body,omitempty"`
+	Push  *bool   `json:"push,omitempty"`
+	// Schedules a draft or reschedules
+	SendAt *time.Time `json:"send_at,omitempty"`
+}
+
+// Announcement is the Announcement schema.
+type Announcement struct {
+	ID             string            `json:"id"`
+	Title          string            `json:"title"`
+	Body           string            `json:"body"`
+	Audience       string            `json:"audience"`
+	GuildID        *string           `json:"guild_id,omitempty"`
+	Area           *AnnouncementArea `json:"area,omitempty"`
+	Push           bool              `json:"push"`
+	Status         string            `json:"status"`
+	SendAt         *time.Time        `json:"send_at,omitempty"`
+	SentOn         *time.Time        `json:"sent_on,omitempty"`
+	RecipientCount int               `json:"recipient_count"`
+	ReadCount      int               `json:"read_count"`
+	CreatedBy      string            `json:"created_by"`
+	CreatedOn      time.Time         `json:"created_on"`
+	UpdatedOn      time.Time         `json:"updated_on"`
+}
+
+// AnnouncementReceipt is the AnnouncementReceipt schema.
+type AnnouncementReceipt struct {
+	AnnouncementID string           `json:"announcement_id"`
+	Title          string           `json:"title"`
+	Body           string           `json:"body"`
+	GuildID        *string          `json:"guild_id,omitempty"`
+	DeliveredOn    time.Time        `json:"delivered_on"`
+	ReadOn         *time.Time       `json:"read_on,omitempty"`
+	Reactions      *ReactionSummary `json:"reactions,omitempty"`
+}
+
+// AnnouncementInbox is the AnnouncementInbox schema.
+type AnnouncementInbox struct {
+	Announcements []AnnouncementReceipt `json:"announcements"`
+	UnreadCount   int                   `json:"unread_count"`
+}
+
+// Draft is the Draft schema.
+type Draft struct {
+	ID           string `json:"id"`
+	UserID       string `json:"user_id"`
+	ResourceType string `json:"resource_type"`
+	// Create request fields as entered, keyed like CreateEventRequest or
+	// CreateAdventureRequest
+	Data map[string]any `json:"data"`
+	// Field errors from the last failed submit; cleared by the next save
+	Errors []FieldError `json:"errors,omitempty"`
+	// 30 days after the last save
+	ExpiresOn time.Time `json:"expires_on"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+// SaveDraftRequest is the SaveDraftRequest schema.
+type SaveDraftRequest struct {
+	ResourceType string `json:"resource_type"`
+	// At most 32 KB when encoded
+	Data map[string]any `json:"data"`
+}
+
+// UpdateDraftRequest is the UpdateDraftRequest schema.
+type UpdateDraftRequest struct {
+	// Replaces the draft's data; at most 32 KB when encoded
+	Data map[string]any `json:"data"`
+}
+
+// DraftSubmission is the DraftSubmission schema.
+type DraftSubmission struct {
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	// The created event or adventure
+	Resource map[string]any `json:"resource"`
+}
+
+// UndoOperation is the UndoOperation schema.
+type UndoOperation struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// The availability, guild or RSVP the action applied to
+	ResourceID string `json:"resource_id"`
+	// End of the undo window, 10 minutes after the action
+	ExpiresOn time.Time `json:"expires_on"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// UndoableResponse is the UndoableResponse schema.
+type UndoableResponse struct {
+	Data  *UndoOperation         `json:"data,omitempty"`
+	Links *UndoableResponseLinks `json:"_links,omitempty"`
+}
+
+// UndoableResponseLinks is the _links property of UndoableResponse.
+type UndoableResponseLinks struct {
+	// POST here to undo the action
+	Undo *string `json:"undo,omitempty"`
+}
+
+// TrashItem is the TrashItem schema.
+type TrashItem struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	GuildID string `json:"guild_id"`
+	// The event or vote title, or the pool name
+	Title     string    `json:"title"`
+	DeletedBy *string   `json:"deleted_by,omitempty"`
+	DeletedAt time.Time `json:"deleted_at"`
+	// When the item is permanently deleted, 30 days after deletion
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+// AnalyticsEvent is the AnalyticsEvent schema.
+type AnalyticsEvent struct {
+	Event string `json:"event"`
+	// Hex HMAC-SHA256 of the user ID; stable per user, never the ID itself
+	Subject string `json:"subject"`
+	// Coarse attributes such as hangout_type or group_size
+	Properties map[string]any `json:"properties,omitempty"`
+	OccurredOn time.Time      `json:"occurred_on"`
+}
+
+// ConsentText is the ConsentText schema.
+type ConsentText struct {
+	Purpose string `json:"purpose"`
+	// Bumped whenever the wording changes; earlier grants then need reconsent
+	Version int    `json:"version"`
+	Text    string `json:"text"`
+}
+
+// ConsentState is the ConsentState schema.
+type ConsentState struct {
+	Purpose string      `json:"purpose"`
+	Text    ConsentText `json:"text"`
+	// True only when the current text version is granted
+	Granted bool `json:"granted"`
+	// Version the user last granted, if any
+	GrantedVersion *int `json:"granted_version,omitempty"`
+	// The user granted an earlier version of the text
+	NeedsReconsent bool       `json:"needs_reconsent"`
+	DecidedOn      *time.Time `json:"decided_on,omitempty"`
+}
+
+// Consent is the Consent schema.
+type Consent struct {
+	ID      string `json:"id"`
+	UserID  string `json:"user_id"`
+	Purpose string `json:"purpose"`
+	// False for a withdrawal
+	Granted bool `json:"granted"`
+	Version int  `json:"version"`
+	// Granted by a backfill for users who already shared a location, not by
+	// the user
+	Grandfathered *bool     `json:"grandfathered,omitempty"`
+	DecidedOn     time.Time `json:"decided_on"`
+}
+
+// LegalHold is the LegalHold schema.
+type LegalHold struct {
+	UserID string `json:"user_id"`
+	Reason string `json:"reason"`
+	// Superadmin who placed the hold
+	PlacedBy string    `json:"placed_by"`
+	PlacedOn time.Time `json:"placed_on"`
+}
+
+// LegalHoldAccess is the LegalHoldAccess schema.
+type LegalHoldAccess struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	// Who accessed the data; absent for unauthenticated access
+	ActorID *string `json:"actor_id,omitempty"`
+	// Method and path of the request, or legal_hold.placed /
+	// legal_hold.released
+	Action     string    `json:"action"`
+	AccessedOn time.Time `json:"accessed_on"`
+}
+
+// SecurityEvent is the SecurityEvent schema.
+type SecurityEvent struct {
+	ID string `json:"id"`
+	// The user the event concerns; absent for scraper detections by address
+	// alone
+	UserID *string `json:"user_id,omitempty"`
+	// The client address, for scraper detections and blocked admin requests
+	IP        *string   `json:"ip,omitempty"`
+	Type      string    `json:"type"`
+	Detail    *string   `json:"detail,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// NetworkPolicy is the NetworkPolicy schema.
+type NetworkPolicy struct {
+	// Ranges admin routes accept; empty accepts any
+	AdminAllowlist []string      `json:"admin_allowlist"`
+	DenyList       []IPDenyEntry `json:"deny_list"`
+}
+
+// IPDenyEntry is the IPDenyEntry schema.
+type IPDenyEntry struct {
+	ID     string `json:"id"`
+	Cidr   string `json:"cidr"`
+	Reason string `json:"reason"`
+	// Absent when the entry stays until removed
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedBy string     `json:"created_by"`
+	CreatedOn time.Time  `json:"created_on"`
+}
+
+// CreateIPDenyRequest is the CreateIPDenyRequest schema.
+type CreateIPDenyRequest struct {
+	// An IP address or CIDR range
+	Cidr   string `json:"cidr"`
+	Reason string `json:"reason"`
+	// How long the entry lasts; 0 or absent lasts until removed
+	DurationMinutes *int `json:"duration_minutes,omitempty"`
+}
+
+// TarpitClient is the TarpitClient schema.
+type TarpitClient struct {
+	IP string `json:"ip"`
+	// Windows the client went over a limit in
+	Strikes int    `json:"strikes"`
+	Reason  string `json:"reason"`
+	// Added to each response while the client isn't blocked
+	Delay        *string    `json:"delay,omitempty"`
+	BlockedUntil *time.Time `json:"blocked_until,omitempty"`
+	LastSeen     time.Time  `json:"last_seen"`
+}
+
+// SearchResult is the SearchResult schema.
+type SearchResult struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	// Guild or interest name, event title, or username
+	Title string `json:"title"`
+	// Start of the description, the interest category, or the person's full
+	// name
+	Subtitle *string `json:"subtitle,omitempty"`
+	// Relevance; only comparable within one search
+	Score float64 `json:"score"`
+}
+
+// SignupRisk is the SignupRisk schema.
+type SignupRisk struct {
+	UserID string  `json:"user_id"`
+	IP     *string `json:"ip,omitempty"`
+	// 40 or more must verify their email before making changes; 70 or more are
+	// flagged for review
+	Score                int        `json:"score"`
+	Reasons              []string   `json:"reasons"`
+	RequiresVerification bool       `json:"requires_verification"`
+	FlaggedForReview     bool       `json:"flagged_for_review"`
+	ReviewedBy           *string    `json:"reviewed_by,omitempty"`
+	ReviewedOn           *time.Time `json:"reviewed_on,omitempty"`
+	CreatedOn            time.Time  `json:"created_on"`
+}
+
+// QuotaUsage is the QuotaUsage schema.
+type QuotaUsage struct {
+	UserID     string `json:"user_id"`
+	DailyLimit int    `json:"daily_limit"`
+	// Request cost spent today
+	Used      int `json:"used"`
+	Remaining int `json:"remaining"`
+	// Next midnight UTC
+	ResetsAt time.Time `json:"resets_at"`
+	// Whether daily_limit was set by an admin rather than the default
+	Override bool `json:"override"`
+}
+
+// AdminReport is the AdminReport schema.
+type AdminReport struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	// expired reports have had their file deleted
+	Status      string `json:"status"`
+	RequestedBy string `json:"requested_by"`
+	RowCount    int    `json:"row_count"`
+	// Why generation failed
+	Error *string `json:"error,omitempty"`
+	// Signed, short-lived link to the CSV; only on completed reports
+	DownloadURL *string    `json:"download_url,omitempty"`
+	CreatedOn   time.Time  `json:"created_on"`
+	StartedOn   *time.Time `json:"started_on,omitempty"`
+	CompletedOn *time.Time `json:"completed_on,omitempty"`
+	// When the file will be deleted
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+	// X-Request-ID of the request that queued the report
+	RequestID *string `json:"request_id,omitempty"`
+}
+
+// UserImport is the UserImport schema.
+type UserImport struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	RequestedBy string `json:"requested_by"`
+	TotalRows   int    `json:"total_rows"`
+	// Counts of row outcomes; all zero until the import completes
+	Summary UserImportSummary `json:"summary"`
+	// Each row's result, once the import completes; left out of lists
+	Rows []UserImportRow `json:"rows,omitempty"`
+	// Why the import couldn't be processed
+	Error *string `json:"error,omitempty"`
+	// X-Request-ID of the request that queued the import
+	RequestID   *string    `json:"request_id,omitempty"`
+	CreatedOn   time.Time  `json:"created_on"`
+	StartedOn   *time.Time `json:"started_on,omitempty"`
+	CompletedOn *time.Time `json:"completed_on,omitempty"`
+}
+
+// UserImportSummary is the summary property of UserImport.
+//
+// Counts of row outcomes; all zero until the import completes
+type UserImportSummary struct {
+	Created  *int `json:"created,omitempty"`
+	Existing *int `json:"existing,omitempty"`
+	Invalid  *int `json:"invalid,omitempty"`
+	Failed   *int `json:"failed,omitempty"`
+	Invited  *int `json:"invited,omitempty"`
+}
+
+// UserImportRow is the UserImportRow schema.
+type UserImportRow struct {
+	// Line in the file, counting the header as line 1
+	Line  int    `json:"line"`
+	Email string `json:"email"`
+	// invalid rows changed nothing; failed rows stopped part way and are
+	// retried by importing the file again
+	Status string  `json:"status"`
+	UserID *string `json:"user_id,omitempty"`
+	// Guilds the user was added to by this import
+	GuildsJoined []string `json:"guilds_joined,omitempty"`
+	// Whether this import emailed the user an invitation
+	Invited bool         `json:"invited"`
+	Errors  []FieldError `json:"errors,omitempty"`
+}
+
+// GuildImport is the GuildImport schema.
+type GuildImport struct {
+	ID          string `json:"id"`
+	Source      string `json:"source"`
+	GuildID     string `json:"guild_id"`
+	GuildSlug   string `json:"guild_slug"`
+	Status      string `json:"status"`
+	RequestedBy string `json:"requested_by"`
+	// Members found in the export; left out of lists
+	Members []GuildImportMember `json:"members,omitempty"`
+	// Events found in the export; left out of lists
+	Events []GuildImportEvent `json:"events,omitempty"`
+	// Counts of what committing will do, or did
+	Summary GuildImportSummary `json:"summary"`
+	// The user import that adds the members, once committed
+	UserImportID *string   `json:"user_import_id,omitempty"`
+	CreatedOn    time.Time `json:"created_on"`
+	// The preview can't be committed after this
+	ExpiresOn   time.Time  `json:"expires_on"`
+	CommittedOn *time.Time `json:"committed_on,omitempty"`
+}
+
+// GuildImportMember is the GuildImportMember schema.
+type GuildImportMember struct {
+	// The member's ID on the platform
+	ExternalID string  `json:"external_id"`
+	Name       string  `json:"name"`
+	Email      *string `json:"email,omitempty"`
+	// create makes a new account, existing matches the account with the same
+	// email, skip leaves the member out
+	Action string `json:"action"`
+	// The matching account, for existing members
+	UserID *string `json:"user_id,omitempty"`
+	// Why the member is skipped
+	Note *string `json:"note,omitempty"`
+}
+
+// GuildImportEvent is the GuildImportEvent schema.
+type GuildImportEvent struct {
+	// The event's ID on the platform
+	ExternalID  string                    `json:"external_id"`
+	Title       string                    `json:"title"`
+	Description *string                   `json:"description,omitempty"`
+	StartTime   time.Time                 `json:"start_time"`
+	EndTime     *time.Time                `json:"end_time,omitempty"`
+	Location    *GuildImportEventLocation `json:"location,omitempty"`
+	// existing matches a guild event with the same title and start time; past
+	// events and events without a name or time are skipped
+	Action string `json:"action"`
+	// The matching event, or the one committing created
+	EventID *string `json:"event_id,omitempty"`
+	// Why the event is skipped
+	Note *string `json:"note,omitempty"`
+}
+
+// GuildImportEventLocation is the location property of GuildImportEvent.
+type GuildImportEventLocation struct {
+	Name      *string `json:"name,omitempty"`
+	Address   *string `json:"address,omitempty"`
+	City      *string `json:"city,omitempty"`
+	IsVirtual *bool   `json:"is_virtual,omitempty"`
+	MeetLink  *string `json:"meet_link,omitempty"`
+}
+
+// GuildImportSummary is the summary property of GuildImport.
+//
+// Counts of what committing will do, or did
+type GuildImportSummary struct {
+	MembersCreated  *int `json:"members_created,omitempty"`
+	MembersExisting *int `json:"members_existing,omitempty"`
+	MembersSkipped  *int `json:"members_skipped,omitempty"`
+	EventsCreated   *int `json:"events_created,omitempty"`
+	EventsExisting  *int `json:"events_existing,omitempty"`
+	EventsSkipped   *int `json:"events_skipped,omitempty"`
+}
+
+// AvailabilityHeatmap is the AvailabilityHeatmap schema.
+type AvailabilityHeatmap struct {
+	GuildID  string    `json:"guild_id"`
+	Timezone string    `json:"timezone"`
+	Since    time.Time `json:"since"`
+	Until    time.Time `json:"until"`
+	// Members with any availability in the period
+	Contributors int `json:"contributors"`
+	// Slots with fewer members than this are reported as zero
+	MinMembers int `json:"min_members"`
+	// Member counts indexed by weekday (Sunday first), then hour of day
+	Buckets [][]int `json:"buckets"`
+}
+
+// SuggestedEventTime is the SuggestedEventTime schema.
+type SuggestedEventTime struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Fewest members usually free in any hour of the slot
+	AvailableMembers int `json:"available_members"`
+	// available_members halved for each conflicting guild event
+	ExpectedAttendance  float64  `json:"expected_attendance"`
+	ConflictingEventIDs []string `json:"conflicting_event_ids,omitempty"`
+}
+
+// GuildEventsEmbed is the GuildEventsEmbed schema.
+//
+// A guild's upcoming public events as other sites see them. Nothing about
+// organizers or attendees is included, and locations leave out the street
+// address and meeting link.
+type GuildEventsEmbed struct {
+	Guild  GuildEventsEmbedGuild   `json:"guild"`
+	Events []GuildEventsEmbedEvent `json:"events"`
+}
+
+// GuildEventsEmbedGuild is the guild property of GuildEventsEmbed.
+type GuildEventsEmbedGuild struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// GuildEventsEmbedEvent is an element of the events property of
+// GuildEventsEmbed.
+type GuildEventsEmbedEvent struct {
+	ID        string                         `json:"id"`
+	Title     string                         `json:"title"`
+	StartTime time.Time                      `json:"start_time"`
+	EndTime   *time.Time                     `json:"end_time,omitempty"`
+	Location  *GuildEventsEmbedEventLocation `json:"location,omitempty"`
+}
+
+// GuildEventsEmbedEventLocation is the location property of
+// GuildEventsEmbedEvent.
+type GuildEventsEmbedEventLocation struct {
+	Name      string  `json:"name"`
+	City      *string `json:"city,omitempty"`
+	IsVirtual bool    `json:"is_virtual"`
+}
+
+// OEmbed is the OEmbed schema.
+//
+// oEmbed rich response (https://oembed.com)
+type OEmbed struct {
+	Type         string  `json:"type"`
+	Version      string  `json:"version"`
+	Title        *string `json:"title,omitempty"`
+	ProviderName *string `json:"provider_name,omitempty"`
+	// An iframe showing the events widget
+	HTML   string `json:"html"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	// Seconds consumers may cache the response
+	CacheAge *int `json:"cache_age,omitempty"`
+}
+
+// Mute is the Mute schema.
+//
+// Silences a guild's new events, votes and announcements, or new events of one
+// category in every guild, for one user. Reminders and other notifications
+// addressed to the user are never muted.
+type Mute struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	Kind   string `json:"kind"`
+	// For guild mutes
+	GuildID *string `json:"guild_id,omitempty"`
+	// Event template, for event_category mutes
+	Category *string `json:"category,omitempty"`
+	// When the mute ends; absent until removed
+	Until     *time.Time `json:"until,omitempty"`
+	CreatedOn time.Time  `json:"created_on"`
+}
+
+// CreateMuteRequest is the CreateMuteRequest schema.
+//
+// Guild mutes need guild_id, event_category mutes need category
+type CreateMuteRequest struct {
+	Kind     string  `json:"kind"`
+	GuildID  *string `json:"guild_id,omitempty"`
+	Category *string `json:"category,omitempty"`
+	// Must be in the future; omit to mute until removed
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// EventReminderPreference is the EventReminderPreference schema.
+type EventReminderPreference struct {
+	// Absent until the user first changes their preferences
+	ID      *string `json:"id,omitempty"`
+	UserID  string  `json:"user_id"`
+	Enabled bool    `json:"enabled"`
+	// Minutes before an event each reminder is sent; the server defaults when
+	// the user hasn't picked any
+	OffsetsMinutes []int      `json:"offsets_minutes"`
+	CreatedOn      *time.Time `json:"created_on,omitempty"`
+	UpdatedOn      *time.Time `json:"updated_on,omitempty"`
+}
+
+// UpdateEventReminderPreferenceRequest is the
+// UpdateEventReminderPreferenceRequest schema.
+type UpdateEventReminderPreferenceRequest struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// Empty goes back to the server defaults
+	OffsetsMinutes []int `json:"offsets_minutes,omitempty"`
+}
+
+// SetReactionRequest is the SetReactionRequest schema.
+type SetReactionRequest struct {
+	Emoji string `json:"emoji"`
+}
+
+// ReportClientErrorRequest is the ReportClientErrorRequest schema.
+type ReportClientErrorRequest struct {
+	// api_mismatch is a response that didn't match the API contract the app
+	// was built against
+	Kind       string `json:"kind"`
+	Platform   string `json:"platform"`
+	AppVersion string `json:"app_version"`
+	// Reports are grouped by the first line
+	Message    string  `json:"message"`
+	StackTrace *string `json:"stack_trace,omitempty"`
+	// The route that returned the unexpected response; required for
+	// api_mismatch
+	Endpoint   *string `json:"endpoint,omitempty"`
+	StatusCode *int    `json:"status_code,omitempty"`
+	// X-Request-ID of the requests leading up to the error
+	RequestIDs []string `json:"request_ids,omitempty"`
+	// Defaults to when the report arrives
+	OccurredOn *time.Time `json:"occurred_on,omitempty"`
+}
+
+// ClientErrorReceipt is the ClientErrorReceipt schema.
+type ClientErrorReceipt struct {
+	// False when the report was sampled out
+	Stored bool    `json:"stored"`
+	ID     *string `json:"id,omitempty"`
+}
+
+// ClientError is the ClientError schema.
+type ClientError struct {
+	ID         string `json:"id"`
+	Kind       string `json:"kind"`
+	Platform   string `json:"platform"`
+	AppVersion string `json:"app_version"`
+	// First line of the message
+	Title      string   `json:"title"`
+	Message    string   `json:"message"`
+	StackTrace *string  `json:"stack_trace,omitempty"`
+	Endpoint   *string  `json:"endpoint,omitempty"`
+	StatusCode *int     `json:"status_code,omitempty"`
+	RequestIDs []string `json:"request_ids,omitempty"`
+	// The request that reported the error
+	RequestID *string `json:"request_id,omitempty"`
+	// Shared by reports with the same kind, platform, endpoint and title
+	Fingerprint string `json:"fingerprint"`
+	// Set when the client was signed in
+	UserID     *string   `json:"user_id,omitempty"`
+	OccurredOn time.Time `json:"occurred_on"`
+	CreatedOn  time.Time `json:"created_on"`
+}
+
+// ClientErrorGroup is the ClientErrorGroup schema.
+type ClientErrorGroup struct {
+	Fingerprint string  `json:"fingerprint"`
+	Kind        string  `json:"kind"`
+	Platform    string  `json:"platform"`
+	Title       string  `json:"title"`
+	Endpoint    *string `json:"endpoint,omitempty"`
+	// Stored reports, after sampling
+	Count       int       `json:"count"`
+	AppVersions []string  `json:"app_versions"`
+	FirstSeen   time.Time `json:"first_seen"`
+	LastSeen    time.Time `json:"last_seen"`
+}
+
+// ClientRelease is the ClientRelease schema.
+type ClientRelease struct {
+	// Older apps get 426 Upgrade Required; absent when every version is
+	// supported
+	MinimumVersion *string `json:"minimum_version,omitempty"`
+	// Latest release, for suggesting optional upgrades
+	CurrentVersion *string `json:"current_version,omitempty"`
+	// Store listing or download page
+	UpdateURL *string `json:"update_url,omitempty"`
+}
+
+// ClientConfig is the ClientConfig schema.
+type ClientConfig struct {
+	// Releases keyed by platform (ios, android, web)
+	Platforms map[string]ClientRelease `json:"platforms"`
+	// Feature flags that are on
+	Features map[string]bool `json:"features"`
+}
+
+// RemoteConfig is the RemoteConfig schema.
+type RemoteConfig struct {
+	// Platform whose overrides were applied; absent when none was given
+	Platform *string `json:"platform,omitempty"`
+	// Tunable values keyed by dotted key
+	Values map[string]any `json:"values"`
+}
+
+// RemoteConfigValue is the RemoteConfigValue schema.
+type RemoteConfigValue struct {
+	Key string `json:"key"`
+	// Platform the value overrides; absent for the value for every platform
+	Platform *string `json:"platform,omitempty"`
+	// Any JSON value
+	Value       map[string]any `json:"value"`
+	Description *string        `json:"description,omitempty"`
+	UpdatedBy   string         `json:"updated_by"`
+	UpdatedOn   time.Time      `json:"updated_on"`
+}
+
+// Experiment is the Experiment schema.
+type Experiment struct {
+	ID          string  `json:"id"`
+	Key         string  `json:"key"`
+	Description *string `json:"description,omitempty"`
+	Status      string  `json:"status"`
+	// Hashed with user IDs to bucket them; unique per experiment
+	Salt     string              `json:"salt"`
+	Variants []ExperimentVariant `json:"variants"`
+	// Platforms the experiment runs on; absent for every platform
+	Platforms []string   `json:"platforms,omitempty"`
+	CreatedBy string     `json:"created_by"`
+	CreatedOn time.Time  `json:"created_on"`
+	UpdatedOn time.Time  `json:"updated_on"`
+	StartedOn *time.Time `json:"started_on,omitempty"`
+	StoppedOn *time.Time `json:"stopped_on,omitempty"`
+}
+
+// ExperimentVariant is the ExperimentVariant schema.
+type ExperimentVariant struct {
+	Key string `json:"key"`
+	// Users are split between variants in proportion to their weights
+	Weight int `json:"weight"`
+	// Remote config values the variant overrides, keyed by remote config key
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// ExperimentAssignment is the ExperimentAssignment schema.
+type ExperimentAssignment struct {
+	Experiment string `json:"experiment"`
+	Variant    string `json:"variant"`
+	// Remote config values to use instead of those from /v1/meta/config
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// RegionStatus is the RegionStatus schema.
+type RegionStatus struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+	// Whether writes are rejected with 503; always true on a standby
+	ReadOnly bool `json:"read_only"`
+	// How far the standby trails the active region's heartbeat. Omitted on the
+	// active region and until the first measurement.
+	ReplicaLagSeconds *float64   `json:"replica_lag_seconds,omitempty"`
+	LagCheckedOn      *time.Time `json:"lag_checked_on,omitempty"`
+	// Whether the replica lag is above DB_MAX_REPLICA_LAG
+	LagExceeded bool `json:"lag_exceeded"`
+}
+
+// UpdateRegionRequest is the UpdateRegionRequest schema.
+type UpdateRegionRequest struct {
+	Role     *string `json:"role,omitempty"`
+	ReadOnly *bool   `json:"read_only,omitempty"`
+}
+
+// QueryStats is the QueryStats schema.
+type QueryStats struct {
+	// When collection started or was last reset
+	Since           time.Time `json:"since"`
+	SlowThresholdMs int       `json:"slow_threshold_ms"`
+	// Repository methods by total query time, worst first
+	Methods []QueryMethodStats `json:"methods"`
+	// The most recent slow queries, newest first
+	RecentSlow []SlowQuery `json:"recent_slow"`
+}
+
+// QueryMethodStats is the QueryMethodStats schema.
+type QueryMethodStats struct {
+	// Repository function that issued the queries, else the service or job
+	// function, else unknown
+	Method          string `json:"method"`
+	Count           int    `json:"count"`
+	Errors          int    `json:"errors"`
+	SlowCount       int    `json:"slow_count"`
+	Rows            int    `json:"rows"`
+	TotalDurationNs int    `json:"total_duration_ns"`
+	MaxDurationNs   int    `json:"max_duration_ns"`
+}
+
+// SlowQuery is the SlowQuery schema.
+type SlowQuery struct {
+	Method string `json:"method"`
+	// Query text, truncated to 500 characters
+	Query      string `json:"query"`
+	DurationNs int    `json:"duration_ns"`
+	Rows       int    `json:"rows"`
+	// Redacted error, when the query failed
+	Error      *string   `json:"error,omitempty"`
+	ExecutedOn time.Time `json:"executed_on"`
+}
+
+// RuntimeSnapshot is the RuntimeSnapshot schema.
+type RuntimeSnapshot struct {
+	// Path of the goroutine dump on the instance
+	GoroutineFile string `json:"goroutine_file"`
+	// Path of the pprof heap profile on the instance
+	HeapFile    string    `json:"heap_file"`
+	Goroutines  int       `json:"goroutines"`
+	HeapAllocMb float64   `json:"heap_alloc_mb"`
+	HeapObjects int       `json:"heap_objects"`
+	NumGc       int       `json:"num_gc"`
+	TakenOn     time.Time `json:"taken_on"`
+}
+
+// LogLevel is the LogLevel schema.
+type LogLevel struct {
+	Level string `json:"level"`
+	// The LOG_LEVEL the instance started with, which temporary levels revert
+	// to
+	DefaultLevel string `json:"default_level"`
+	// When level reverts to default_level. Omitted when the level isn't
+	// temporary.
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+}
+
+// UpdateLogLevelRequest is the UpdateLogLevelRequest schema.
+type UpdateLogLevelRequest struct {
+	Level string `json:"level"`
+	// Revert to LOG_LEVEL after this many minutes. Omit to keep the level
+	// until the next change or restart.
+	DurationMinutes *int `json:"duration_minutes,omitempty"`
+}
+
+// RegisterResponse is the response to Register.
+type RegisterResponse struct {
+	Data *RegisterResponseData `json:"data,omitempty"`
+}
+
+// RegisterResponseData is the data property of RegisterResponse.
+type RegisterResponseData struct {
+	User  *User          `json:"user,omitempty"`
+	Token *TokenResponse `json:"token,omitempty"`
+	// The email must be verified before the account can make changes
+	VerificationRequired *bool `json:"verification_required,omitempty"`
+}
+
+// LoginResponse is the response to Login.
+type LoginResponse struct {
+	Data *LoginResponseData `json:"data,omitempty"`
+}
+
+// LoginResponseData is the data property of LoginResponse.
+type LoginResponseData struct {
+	User  *User          `json:"user,omitempty"`
+	Token *TokenResponse `json:"token,omitempty"`
+}
+
+// OauthGoogleResponse is the response to OauthGoogle.
+type OauthGoogleResponse struct {
+	Data *OauthGoogleResponseData `json:"data,omitempty"`
+}
+
+// OauthGoogleResponseData is the data property of OauthGoogleResponse.
+type OauthGoogleResponseData struct {
+	User      *User          `json:"user,omitempty"`
+	Token     *TokenResponse `json:"token,omitempty"`
+	IsNewUser *bool          `json:"is_new_user,omitempty"`
+}
+
+// OauthAppleResponse is the response to OauthApple.
+type OauthAppleResponse struct {
+	Data *OauthAppleResponseData `json:"data,omitempty"`
+}
+
+// OauthAppleResponseData is the data property of OauthAppleResponse.
+type OauthAppleResponseData struct {
+	User      *User          `json:"user,omitempty"`
+	Token     *TokenResponse `json:"token,omitempty"`
+	IsNewUser *bool          `json:"is_new_user,omitempty"`
+}
+
+// PasskeyRegisterFinishResponse is the response to PasskeyRegisterFinish.
+type PasskeyRegisterFinishResponse struct {
+	Data *Passkey `json:"data,omitempty"`
+}
+
+// PasskeyLoginFinishResponse is the response to PasskeyLoginFinish.
+type PasskeyLoginFinishResponse struct {
+	Data *PasskeyLoginFinishResponseData `json:"data,omitempty"`
+}
+
+// PasskeyLoginFinishResponseData is the data property of
+// PasskeyLoginFinishResponse.
+type PasskeyLoginFinishResponseData struct {
+	User  *User          `json:"user,omitempty"`
+	Token *TokenResponse `json:"token,omitempty"`
+}
+
+// PasskeyCrossDeviceStartResponse is the response to PasskeyCrossDeviceStart.
+type PasskeyCrossDeviceStartResponse struct {
+	Data *CrossDeviceStartResponse `json:"data,omitempty"`
+}
+
+// PasskeyCrossDeviceOptionsResponse is the response to
+// PasskeyCrossDeviceOptions.
+type PasskeyCrossDeviceOptionsResponse struct {
+	Data *PasskeyLoginStartResponse `json:"data,omitempty"`
+}
+
+// PasskeyCrossDevicePollResponse is the response to PasskeyCrossDevicePoll.
+type PasskeyCrossDevicePollResponse struct {
+	Data *CrossDevicePollResponse `json:"data,omitempty"`
+}
+
+// DevicePairingStartResponse is the response to DevicePairingStart.
+type DevicePairingStartResponse struct {
+	Data *DevicePairingStart `json:"data,omitempty"`
+}
+
+// DevicePairingPollResponse is the response to DevicePairingPoll.
+type DevicePairingPollResponse struct {
+	Data *CrossDevicePollResponse `json:"data,omitempty"`
+}
+
+// DeviceApproveResponse is the response to DeviceApprove.
+type DeviceApproveResponse struct {
+	Data *DevicePairing `json:"data,omitempty"`
+}
+
+// RefreshTokensResponse is the response to RefreshTokens.
+type RefreshTokensResponse struct {
+	Data *TokenResponse `json:"data,omitempty"`
+}
+
+// GetCurrentUserResponse is the response to GetCurrentUser.
+type GetCurrentUserResponse struct {
+	Data *UserWithIdentities `json:"data,omitempty"`
+}
+
+// ChangePasswordResponse is the response to ChangePassword.
+type ChangePasswordResponse struct {
+	Data *TokenResponse `json:"data,omitempty"`
+}
+
+// ChangeEmailResponse is the response to ChangeEmail.
+type ChangeEmailResponse struct {
+	Data *EmailChange `json:"data,omitempty"`
+}
+
+// SendEmailVerificationResponse is the response to SendEmailVerification.
+type SendEmailVerificationResponse struct {
+	Data *EmailChange `json:"data,omitempty"`
+}
+
+// VerifyEmailResponse is the response to VerifyEmail.
+type VerifyEmailResponse struct {
+	Data *User `json:"data,omitempty"`
+}
+
+// PasskeyRecoverResponse is the response to PasskeyRecover.
+type PasskeyRecoverResponse struct {
+	Data *EmailChange `json:"data,omitempty"`
+}
+
+// AcceptInvitationResponse is the response to AcceptInvitation.
+type AcceptInvitationResponse struct {
+	Data *AcceptInvitationResponseData `json:"data,omitempty"`
+}
+
+// AcceptInvitationResponseData is the data property of
+// AcceptInvitationResponse.
+type AcceptInvitationResponseData struct {
+	User  *User          `json:"user,omitempty"`
+	Token *TokenResponse `json:"token,omitempty"`
+}
+
+// AcceptInvitationBody is the request body of AcceptInvitation.
+type AcceptInvitationBody struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// LinkProviderResponse is the response to LinkProvider.
+type LinkProviderResponse struct {
+	Data *Identity `json:"data,omitempty"`
+}
+
+// ListGuildsResponse is the response to ListGuilds.
+type ListGuildsResponse struct {
+	Data  []Guild        `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateGuildResponse is the response to CreateGuild.
+type CreateGuildResponse struct {
+	Data  *Guild         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateGuildBody is the request body of CreateGuild.
+type CreateGuildBody struct {
+	// Guild name
+	Name *string `json:"name,omitempty"`
+	// Vanity URL slug; derived from the name when omitted
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	// Create the guild even though it looks like one the user already belongs
+	// to
+	ConfirmDuplicate *bool `json:"confirm_duplicate,omitempty"`
+}
+
+// GetGuildResponse is the response to GetGuild.
+type GetGuildResponse struct {
+	Data  *GuildData     `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateGuildResponse is the response to UpdateGuild.
+type UpdateGuildResponse struct {
+	Data  *Guild         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetGuildMembersResponse is the response to GetGuildMembers.
+type GetGuildMembersResponse struct {
+	Data       []Member        `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Links      map[string]any  `json:"_links,omitempty"`
+}
+
+// MergeGuildsResponse is the response to MergeGuilds.
+type MergeGuildsResponse struct {
+	Data  *GuildData     `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// MergeGuildsBody is the request body of MergeGuilds.
+type MergeGuildsBody struct {
+	// ID of guild to merge into this one
+	SourceGuildID string `json:"source_guild_id"`
+}
+
+// GetGuildBySlugResponse is the response to GetGuildBySlug.
+type GetGuildBySlugResponse struct {
+	Data  *GuildData     `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListGuildTiersResponse is the response to ListGuildTiers.
+type ListGuildTiersResponse struct {
+	Data  []GuildTier       `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CreateGuildTierResponse is the response to CreateGuildTier.
+type CreateGuildTierResponse struct {
+	Data  *GuildTier        `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// UpdateGuildTierResponse is the response to UpdateGuildTier.
+type UpdateGuildTierResponse struct {
+	Data  *GuildTier        `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListGuildTierMembersResponse is the response to ListGuildTierMembers.
+type ListGuildTierMembersResponse struct {
+	Data  []GuildTierAssignment `json:"data,omitempty"`
+	Links map[string]string     `json:"_links,omitempty"`
+}
+
+// GetGuildMemberTierResponse is the response to GetGuildMemberTier.
+type GetGuildMemberTierResponse struct {
+	Data  *GuildTierAssignment `json:"data,omitempty"`
+	Links map[string]string    `json:"_links,omitempty"`
+}
+
+// AssignGuildMemberTierResponse is the response to AssignGuildMemberTier.
+type AssignGuildMemberTierResponse struct {
+	Data  *GuildTierAssignment `json:"data,omitempty"`
+	Links map[string]string    `json:"_links,omitempty"`
+}
+
+// ListGuildHangoutTypesResponse is the response to ListGuildHangoutTypes.
+type ListGuildHangoutTypesResponse struct {
+	Data  []HangoutTypeInfo `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CreateGuildHangoutTypeResponse is the response to CreateGuildHangoutType.
+type CreateGuildHangoutTypeResponse struct {
+	Data  *HangoutTypeInfo  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// UpdateGuildHangoutTypeResponse is the response to UpdateGuildHangoutType.
+type UpdateGuildHangoutTypeResponse struct {
+	Data  *HangoutTypeInfo  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListGuildVenuesResponse is the response to ListGuildVenues.
+type ListGuildVenuesResponse struct {
+	Data  []Venue           `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CreateGuildVenueResponse is the response to CreateGuildVenue.
+type CreateGuildVenueResponse struct {
+	Data  *Venue            `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GetGuildVenueResponse is the response to GetGuildVenue.
+type GetGuildVenueResponse struct {
+	Data  *Venue            `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// UpdateGuildVenueResponse is the response to UpdateGuildVenue.
+type UpdateGuildVenueResponse struct {
+	Data  *Venue            `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListGuildInvitesResponse is the response to ListGuildInvites.
+type ListGuildInvitesResponse struct {
+	Data  []GuildInvite     `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CreateGuildInviteResponse is the response to CreateGuildInvite.
+type CreateGuildInviteResponse struct {
+	Data  *GuildInvite      `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AcceptGuildInviteResponse is the response to AcceptGuildInvite.
+type AcceptGuildInviteResponse struct {
+	Data  *Guild            `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GetGuildMemberPermissionsResponse is the response to
+// GetGuildMemberPermissions.
+type GetGuildMemberPermissionsResponse struct {
+	Data  *GuildPermissions `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListGuildRolesResponse is the response to ListGuildRoles.
+type ListGuildRolesResponse struct {
+	Data  []GuildCustomRole `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CreateGuildRoleResponse is the response to CreateGuildRole.
+type CreateGuildRoleResponse struct {
+	Data  *GuildCustomRole  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// UpdateGuildRoleResponse is the response to UpdateGuildRole.
+type UpdateGuildRoleResponse struct {
+	Data  *GuildCustomRole  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AssignGuildRoleResponse is the response to AssignGuildRole.
+type AssignGuildRoleResponse struct {
+	Data  *GuildPermissions `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GetGuildHealthResponse is the response to GetGuildHealth.
+type GetGuildHealthResponse struct {
+	Data  *GuildHealthReport `json:"data,omitempty"`
+	Links map[string]string  `json:"_links,omitempty"`
+}
+
+// ListPeopleResponse is the response to ListPeople.
+type ListPeopleResponse struct {
+	Data  []Person       `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreatePersonResponse is the response to CreatePerson.
+type CreatePersonResponse struct {
+	Data  *Person        `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetPersonResponse is the response to GetPerson.
+type GetPersonResponse struct {
+	Data  *GetPersonResponseData `json:"data,omitempty"`
+	Links map[string]any         `json:"_links,omitempty"`
+}
+
+// GetPersonResponseData is the data property of GetPersonResponse.
+type GetPersonResponseData struct {
+	Person *Person                      `json:"person,omitempty"`
+	Timers []GetPersonResponseDataTimer `json:"timers,omitempty"`
+}
+
+// GetPersonResponseDataTimer is an element of the timers property of
+// GetPersonResponseData.
+type GetPersonResponseDataTimer struct {
+	Timer    *Timer    `json:"timer,omitempty"`
+	Activity *Activity `json:"activity,omitempty"`
+}
+
+// UpdatePersonResponse is the response to UpdatePerson.
+type UpdatePersonResponse struct {
+	Data  *Person        `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListActivitiesResponse is the response to ListActivities.
+type ListActivitiesResponse struct {
+	Data  []Activity     `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateActivityResponse is the response to CreateActivity.
+type CreateActivityResponse struct {
+	Data  *Activity      `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetActivityResponse is the response to GetActivity.
+type GetActivityResponse struct {
+	Data  *Activity      `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateActivityResponse is the response to UpdateActivity.
+type UpdateActivityResponse struct {
+	Data  *Activity      `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateActivityBody is the request body of UpdateActivity.
+type UpdateActivityBody struct {
+	Name     *string  `json:"name,omitempty"`
+	Icon     *string  `json:"icon,omitempty"`
+	Warn     *float64 `json:"warn,omitempty"`
+	Critical *float64 `json:"critical,omitempty"`
+}
+
+// ListTimersResponse is the response to ListTimers.
+type ListTimersResponse struct {
+	Data  []ListTimersResponseDataItem `json:"data,omitempty"`
+	Links map[string]any               `json:"_links,omitempty"`
+}
+
+// ListTimersResponseDataItem is an element of the data property of
+// ListTimersResponse.
+type ListTimersResponseDataItem struct {
+	Timer    *Timer    `json:"timer,omitempty"`
+	Activity *Activity `json:"activity,omitempty"`
+}
+
+// CreateTimerResponse is the response to CreateTimer.
+type CreateTimerResponse struct {
+	Data  *Timer         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetTimerResponse is the response to GetTimer.
+type GetTimerResponse struct {
+	Data  *Timer         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateTimerResponse is the response to UpdateTimer.
+type UpdateTimerResponse struct {
+	Data  *Timer         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ResetTimerResponse is the response to ResetTimer.
+type ResetTimerResponse struct {
+	Data  *Timer         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateTrustRatingResponse is the response to CreateTrustRating.
+type CreateTrustRatingResponse struct {
+	Data *TrustRating `json:"data,omitempty"`
+}
+
+// GetTrustRatingResponse is the response to GetTrustRating.
+type GetTrustRatingResponse struct {
+	Data *TrustRatingWithEndorsements `json:"data,omitempty"`
+}
+
+// UpdateTrustRatingResponse is the response to UpdateTrustRating.
+type UpdateTrustRatingResponse struct {
+	Data *TrustRating `json:"data,omitempty"`
+}
+
+// GetEndorsementsResponse is the response to GetEndorsements.
+type GetEndorsementsResponse struct {
+	Data []TrustEndorsement `json:"data,omitempty"`
+}
+
+// CreateEndorsementResponse is the response to CreateEndorsement.
+type CreateEndorsementResponse struct {
+	Data *TrustEndorsement `json:"data,omitempty"`
+}
+
+// GetUserTrustRatingsReceivedResponse is the response to
+// GetUserTrustRatingsReceived.
+type GetUserTrustRatingsReceivedResponse struct {
+	Data       []TrustRating   `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Links      map[string]any  `json:"_links,omitempty"`
+}
+
+// GetUserTrustRatingsGivenResponse is the response to
+// GetUserTrustRatingsGiven.
+type GetUserTrustRatingsGivenResponse struct {
+	Data       []TrustRating   `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Links      map[string]any  `json:"_links,omitempty"`
+}
+
+// GetUserTrustAggregateResponse is the response to GetUserTrustAggregate.
+type GetUserTrustAggregateResponse struct {
+	Data *TrustAggregate `json:"data,omitempty"`
+}
+
+// GetDistrustSignalsResponse is the response to GetDistrustSignals.
+type GetDistrustSignalsResponse struct {
+	Data []DistrustSignal `json:"data,omitempty"`
+}
+
+// ListGuildRoleCatalogsResponse is the response to ListGuildRoleCatalogs.
+type ListGuildRoleCatalogsResponse struct {
+	Data []RoleCatalog `json:"data,omitempty"`
+}
+
+// CreateGuildRoleCatalogResponse is the response to CreateGuildRoleCatalog.
+type CreateGuildRoleCatalogResponse struct {
+	Data *RoleCatalog `json:"data,omitempty"`
+}
+
+// GetGuildRoleCatalogResponse is the response to GetGuildRoleCatalog.
+type GetGuildRoleCatalogResponse struct {
+	Data *RoleCatalog `json:"data,omitempty"`
+}
+
+// UpdateGuildRoleCatalogResponse is the response to UpdateGuildRoleCatalog.
+type UpdateGuildRoleCatalogResponse struct {
+	Data *RoleCatalog `json:"data,omitempty"`
+}
+
+// ListMyRoleCatalogsResponse is the response to ListMyRoleCatalogs.
+type ListMyRoleCatalogsResponse struct {
+	Data []RoleCatalog `json:"data,omitempty"`
+}
+
+// CreateMyRoleCatalogResponse is the response to CreateMyRoleCatalog.
+type CreateMyRoleCatalogResponse struct {
+	Data *RoleCatalog `json:"data,omitempty"`
+}
+
+// GetMyRoleCatalogResponse is the response to GetMyRoleCatalog.
+type GetMyRoleCatalogResponse struct {
+	Data *RoleCatalog `json:"data,omitempty"`
+}
+
+// UpdateMyRoleCatalogResponse is the response to UpdateMyRoleCatalog.
+type UpdateMyRoleCatalogResponse struct {
+	Data *RoleCatalog `json:"data,omitempty"`
+}
+
+// CreateEventRoleFromCatalogResponse is the response to
+// CreateEventRoleFromCatalog.
+type CreateEventRoleFromCatalogResponse struct {
+	Data *EventRole `json:"data,omitempty"`
+}
+
+// CreateEventRoleFromCatalogBody is the request body of
+// CreateEventRoleFromCatalog.
+type CreateEventRoleFromCatalogBody struct {
+	// Role catalog ID to use as template
+	CatalogID string `json:"catalog_id"`
+	// Override max slots from catalog
+	MaxSlots *int `json:"max_slots,omitempty"`
+}
+
+// ListRideshareRolesResponse is the response to ListRideshareRoles.
+type ListRideshareRolesResponse struct {
+	Data []RideshareRoleWithAssignments `json:"data,omitempty"`
+}
+
+// CreateRideshareRoleResponse is the response to CreateRideshareRole.
+type CreateRideshareRoleResponse struct {
+	Data *RideshareRole `json:"data,omitempty"`
+}
+
+// UpdateRideshareRoleResponse is the response to UpdateRideshareRole.
+type UpdateRideshareRoleResponse struct {
+	Data *RideshareRole `json:"data,omitempty"`
+}
+
+// AssignRideshareRoleResponse is the response to AssignRideshareRole.
+type AssignRideshareRoleResponse struct {
+	Data *RideshareRoleAssignment `json:"data,omitempty"`
+}
+
+// AssignRideshareRoleBody is the request body of AssignRideshareRole.
+type AssignRideshareRoleBody struct {
+	UserID string  `json:"user_id"`
+	Note   *string `json:"note,omitempty"`
+}
+
+// CreateRideshareRoleFromCatalogResponse is the response to
+// CreateRideshareRoleFromCatalog.
+type CreateRideshareRoleFromCatalogResponse struct {
+	Data *RideshareRole `json:"data,omitempty"`
+}
+
+// CreateRideshareRoleFromCatalogBody is the request body of
+// CreateRideshareRoleFromCatalog.
+type CreateRideshareRoleFromCatalogBody struct {
+	CatalogID string `json:"catalog_id"`
+	MaxSlots  *int   `json:"max_slots,omitempty"`
+}
+
+// CreateVoteResponse is the response to CreateVote.
+type CreateVoteResponse struct {
+	Data *VoteWithDetails `json:"data,omitempty"`
+}
+
+// GetVoteResponse is the response to GetVote.
+type GetVoteResponse struct {
+	Data    *VoteWithDetails  `json:"data,omitempty"`
+	Links   map[string]string `json:"_links,omitempty"`
+	Actions *Affordances      `json:"_actions,omitempty"`
+}
+
+// UpdateVoteResponse is the response to UpdateVote.
+type UpdateVoteResponse struct {
+	Data *VoteWithDetails `json:"data,omitempty"`
+}
+
+// OpenVoteResponse is the response to OpenVote.
+type OpenVoteResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+// CloseVoteResponse is the response to CloseVote.
+type CloseVoteResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+// CancelVoteResponse is the response to CancelVote.
+type CancelVoteResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+// ListVoteOptionsResponse is the response to ListVoteOptions.
+type ListVoteOptionsResponse struct {
+	Data []VoteOption `json:"data,omitempty"`
+}
+
+// CreateVoteOptionResponse is the response to CreateVoteOption.
+type CreateVoteOptionResponse struct {
+	Data *VoteOption `json:"data,omitempty"`
+}
+
+// BatchCreateVoteOptionsResponse is the response to BatchCreateVoteOptions.
+type BatchCreateVoteOptionsResponse struct {
+	Data []VoteOption `json:"data,omitempty"`
+}
+
+// BatchCreateVoteOptionsBody is the request body of BatchCreateVoteOptions.
+type BatchCreateVoteOptionsBody struct {
+	Options []CreateVoteOptionRequest `json:"options"`
+}
+
+// UpdateVoteOptionResponse is the response to UpdateVoteOption.
+type UpdateVoteOptionResponse struct {
+	Data *VoteOption `json:"data,omitempty"`
+}
+
+// GetMyBallotResponse is the response to GetMyBallot.
+type GetMyBallotResponse struct {
+	Data *VoteBallot `json:"data,omitempty"`
+}
+
+// CastBallotResponse is the response to CastBallot.
+type CastBallotResponse struct {
+	Data *VoteBallot `json:"data,omitempty"`
+}
+
+// GetVoteBallotsResponse is the response to GetVoteBallots.
+type GetVoteBallotsResponse struct {
+	Data []VoteBallot `json:"data,omitempty"`
+}
+
+// GetVoteResultsResponse is the response to GetVoteResults.
+type GetVoteResultsResponse struct {
+	Data *VoteResults `json:"data,omitempty"`
+}
+
+// GetVoteStatsResponse is the response to GetVoteStats.
+type GetVoteStatsResponse struct {
+	Data *GetVoteStatsResponseData `json:"data,omitempty"`
+}
+
+// GetVoteStatsResponseData is the data property of GetVoteStatsResponse.
+type GetVoteStatsResponseData struct {
+	VoteID      *string `json:"vote_id,omitempty"`
+	BallotCount *int    `json:"ballot_count,omitempty"`
+	Status      *string `json:"status,omitempty"`
+}
+
+// ListGuildVotesResponse is the response to ListGuildVotes.
+type ListGuildVotesResponse struct {
+	Data       []Vote          `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Links      map[string]any  `json:"_links,omitempty"`
+}
+
+// ListGlobalVotesResponse is the response to ListGlobalVotes.
+type ListGlobalVotesResponse struct {
+	Data       []Vote          `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Links      map[string]any  `json:"_links,omitempty"`
+}
+
+// CreateAdventureResponse is the response to CreateAdventure.
+type CreateAdventureResponse struct {
+	Data *Adventure `json:"data,omitempty"`
+}
+
+// GetAdventureResponse is the response to GetAdventure.
+type GetAdventureResponse struct {
+	Data *Adventure `json:"data,omitempty"`
+}
+
+// UpdateAdventureResponse is the response to UpdateAdventure.
+type UpdateAdventureResponse struct {
+	Data *Adventure `json:"data,omitempty"`
+}
+
+// TransferAdventureResponse is the response to TransferAdventure.
+type TransferAdventureResponse struct {
+	Data *Adventure `json:"data,omitempty"`
+}
+
+// TransferAdventureBody is the request body of TransferAdventure.
+type TransferAdventureBody struct {
+	// User ID of new organizer
+	NewOrganizerUserID string `json:"new_organizer_user_id"`
+}
+
+// UnfreezeAdventureResponse is the response to UnfreezeAdventure.
+type UnfreezeAdventureResponse struct {
+	Data *Adventure `json:"data,omitempty"`
+}
+
+// UnfreezeAdventureBody is the request body of UnfreezeAdventure.
+type UnfreezeAdventureBody struct {
+	NewOrganizerUserID string `json:"new_organizer_user_id"`
+}
+
+// RequestAdventureAdmissionResponse is the response to
+// RequestAdventureAdmission.
+type RequestAdventureAdmissionResponse struct {
+	Data *AdventureAdmission `json:"data,omitempty"`
+}
+
+// RequestAdventureAdmissionBody is the request body of
+// RequestAdventureAdmission.
+type RequestAdventureAdmissionBody struct {
+	// Optional message to organizer
+	Note *string `json:"note,omitempty"`
+}
+
+// GetMyAdventureAdmissionResponse is the response to GetMyAdventureAdmission.
+type GetMyAdventureAdmissionResponse struct {
+	Data *AdventureAdmission `json:"data,omitempty"`
+}
+
+// ListAdventureAdmissionsResponse is the response to ListAdventureAdmissions.
+type ListAdventureAdmissionsResponse struct {
+	Data []AdventureAdmission `json:"data,omitempty"`
+}
+
+// RespondToAdventureAdmissionResponse is the response to
+// RespondToAdventureAdmission.
+type RespondToAdventureAdmissionResponse struct {
+	Data *AdventureAdmission `json:"data,omitempty"`
+}
+
+// RespondToAdventureAdmissionBody is the request body of
+// RespondToAdventureAdmission.
+type RespondToAdventureAdmissionBody struct {
+	// true admits the user, false rejects the request
+	Admit bool `json:"admit"`
+	// Required when rejecting
+	RejectionReason *string `json:"rejection_reason,omitempty"`
+}
+
+// InviteToAdventureResponse is the response to InviteToAdventure.
+type InviteToAdventureResponse struct {
+	Data *AdventureAdmission `json:"data,omitempty"`
+}
+
+// InviteToAdventureBody is the request body of InviteToAdventure.
+type InviteToAdventureBody struct {
+	UserID string `json:"user_id"`
+}
+
+// ListGuildAdventuresResponse is the response to ListGuildAdventures.
+type ListGuildAdventuresResponse struct {
+	Data []Adventure `json:"data,omitempty"`
+}
+
+// CreateGuildAdventureResponse is the response to CreateGuildAdventure.
+type CreateGuildAdventureResponse struct {
+	Data *Adventure `json:"data,omitempty"`
+}
+
+// ListMyAdventuresResponse is the response to ListMyAdventures.
+type ListMyAdventuresResponse struct {
+	Data []Adventure `json:"data,omitempty"`
+}
+
+// CreateMyAdventureResponse is the response to CreateMyAdventure.
+type CreateMyAdventureResponse struct {
+	Data *Adventure `json:"data,omitempty"`
+}
+
+// CreateShareLinkResponse is the response to CreateShareLink.
+type CreateShareLinkResponse struct {
+	Data  *ShareLink     `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ResolveShareLinkResponse is the response to ResolveShareLink.
+type ResolveShareLinkResponse struct {
+	Data  *ShareLinkResolution `json:"data,omitempty"`
+	Links map[string]any       `json:"_links,omitempty"`
+}
+
+// AcceptShareLinkResponse is the response to AcceptShareLink.
+type AcceptShareLinkResponse struct {
+	Data  *ShareLinkResolution `json:"data,omitempty"`
+	Links map[string]any       `json:"_links,omitempty"`
+}
+
+// GetShareLinkPreviewResponse is the response to GetShareLinkPreview.
+type GetShareLinkPreviewResponse struct {
+	Data  *OpenGraphPreview `json:"data,omitempty"`
+	Links map[string]any    `json:"_links,omitempty"`
+}
+
+// PollNotificationsResponse is the response to PollNotifications.
+type PollNotificationsResponse struct {
+	Data  *NotificationPoll `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListMutesResponse is the response to ListMutes.
+type ListMutesResponse struct {
+	Data  []Mute            `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CreateMuteResponse is the response to CreateMute.
+type CreateMuteResponse struct {
+	Data  *Mute             `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GetEventReminderPreferenceResponse is the response to
+// GetEventReminderPreference.
+type GetEventReminderPreferenceResponse struct {
+	Data  *EventReminderPreference `json:"data,omitempty"`
+	Links map[string]string        `json:"_links,omitempty"`
+}
+
+// UpdateEventReminderPreferenceResponse is the response to
+// UpdateEventReminderPreference.
+type UpdateEventReminderPreferenceResponse struct {
+	Data  *EventReminderPreference `json:"data,omitempty"`
+	Links map[string]string        `json:"_links,omitempty"`
+}
+
+// GetAnnouncementInboxResponse is the response to GetAnnouncementInbox.
+type GetAnnouncementInboxResponse struct {
+	Data  *AnnouncementInbox `json:"data,omitempty"`
+	Links map[string]any     `json:"_links,omitempty"`
+}
+
+// ReactToAnnouncementResponse is the response to ReactToAnnouncement.
+type ReactToAnnouncementResponse struct {
+	Data  *ReactionSummary  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// UnreactToAnnouncementResponse is the response to UnreactToAnnouncement.
+type UnreactToAnnouncementResponse struct {
+	Data  *ReactionSummary  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListGuildAnnouncementsResponse is the response to ListGuildAnnouncements.
+type ListGuildAnnouncementsResponse struct {
+	Data  []Announcement `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateGuildAnnouncementResponse is the response to CreateGuildAnnouncement.
+type CreateGuildAnnouncementResponse struct {
+	Data  *Announcement  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetGuildAnnouncementResponse is the response to GetGuildAnnouncement.
+type GetGuildAnnouncementResponse struct {
+	Data  *Announcement  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateGuildAnnouncementResponse is the response to UpdateGuildAnnouncement.
+type UpdateGuildAnnouncementResponse struct {
+	Data  *Announcement  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// SendGuildAnnouncementResponse is the response to SendGuildAnnouncement.
+type SendGuildAnnouncementResponse struct {
+	Data  *Announcement  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListAdminAnnouncementsResponse is the response to ListAdminAnnouncements.
+type ListAdminAnnouncementsResponse struct {
+	Data  []Announcement `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateAdminAnnouncementResponse is the response to CreateAdminAnnouncement.
+type CreateAdminAnnouncementResponse struct {
+	Data  *Announcement  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetAdminAnnouncementResponse is the response to GetAdminAnnouncement.
+type GetAdminAnnouncementResponse struct {
+	Data  *Announcement  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateAdminAnnouncementResponse is the response to UpdateAdminAnnouncement.
+type UpdateAdminAnnouncementResponse struct {
+	Data  *Announcement  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// SendAdminAnnouncementResponse is the response to SendAdminAnnouncement.
+type SendAdminAnnouncementResponse struct {
+	Data  *Announcement  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListDraftsResponse is the response to ListDrafts.
+type ListDraftsResponse struct {
+	Data  []Draft        `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateDraftResponse is the response to CreateDraft.
+type CreateDraftResponse struct {
+	Data  *Draft         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetDraftResponse is the response to GetDraft.
+type GetDraftResponse struct {
+	Data  *Draft         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateDraftResponse is the response to UpdateDraft.
+type UpdateDraftResponse struct {
+	Data  *Draft         `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// SubmitDraftResponse is the response to SubmitDraft.
+type SubmitDraftResponse struct {
+	Data  *DraftSubmission `json:"data,omitempty"`
+	Links map[string]any   `json:"_links,omitempty"`
+}
+
+// UndoOperationResponse is the response to UndoOperation.
+type UndoOperationResponse struct {
+	Data  *UndoOperation              `json:"data,omitempty"`
+	Links *UndoOperationResponseLinks `json:"_links,omitempty"`
+}
+
+// UndoOperationResponseLinks is the _links property of UndoOperationResponse.
+type UndoOperationResponseLinks struct {
+	Resource *string `json:"resource,omitempty"`
+}
+
+// ListGuildTrashResponse is the response to ListGuildTrash.
+type ListGuildTrashResponse struct {
+	Data  []TrashItem    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// RestoreGuildTrashItemResponse is the response to RestoreGuildTrashItem.
+type RestoreGuildTrashItemResponse struct {
+	Data  *TrashItem                          `json:"data,omitempty"`
+	Links *RestoreGuildTrashItemResponseLinks `json:"_links,omitempty"`
+}
+
+// RestoreGuildTrashItemResponseLinks is the _links property of
+// RestoreGuildTrashItemResponse.
+type RestoreGuildTrashItemResponseLinks struct {
+	Resource *string `json:"resource,omitempty"`
+}
+
+// ListConsentsResponse is the response to ListConsents.
+type ListConsentsResponse struct {
+	Data  []ConsentState `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListConsentHistoryResponse is the response to ListConsentHistory.
+type ListConsentHistoryResponse struct {
+	Data  []Consent      `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GrantConsentResponse is the response to GrantConsent.
+type GrantConsentResponse struct {
+	Data  *ConsentState  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GrantConsentBody is the request body of GrantConsent.
+type GrantConsentBody struct {
+	Version int `json:"version"`
+}
+
+// WithdrawConsentResponse is the response to WithdrawConsent.
+type WithdrawConsentResponse struct {
+	Data  *ConsentState  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetLegalHoldResponse is the response to GetLegalHold.
+type GetLegalHoldResponse struct {
+	Data  *LegalHold     `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// PlaceLegalHoldResponse is the response to PlaceLegalHold.
+type PlaceLegalHoldResponse struct {
+	Data  *LegalHold     `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// PlaceLegalHoldBody is the request body of PlaceLegalHold.
+type PlaceLegalHoldBody struct {
+	Reason string `json:"reason"`
+}
+
+// GetLegalHoldAccessLogResponse is the response to GetLegalHoldAccessLog.
+type GetLegalHoldAccessLogResponse struct {
+	Data  []LegalHoldAccess `json:"data,omitempty"`
+	Links map[string]any    `json:"_links,omitempty"`
+}
+
+// ListSecurityEventsResponse is the response to ListSecurityEvents.
+type ListSecurityEventsResponse struct {
+	Data  []SecurityEvent `json:"data,omitempty"`
+	Links map[string]any  `json:"_links,omitempty"`
+}
+
+// ListSignupReviewsResponse is the response to ListSignupReviews.
+type ListSignupReviewsResponse struct {
+	Data  []SignupRisk   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ReviewSignupResponse is the response to ReviewSignup.
+type ReviewSignupResponse struct {
+	Data  *SignupRisk    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListScraperDetectionsResponse is the response to ListScraperDetections.
+type ListScraperDetectionsResponse struct {
+	Data  []SecurityEvent `json:"data,omitempty"`
+	Links map[string]any  `json:"_links,omitempty"`
+}
+
+// ListTarpitClientsResponse is the response to ListTarpitClients.
+type ListTarpitClientsResponse struct {
+	Data  []TarpitClient `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetNetworkPolicyResponse is the response to GetNetworkPolicy.
+type GetNetworkPolicyResponse struct {
+	Data  *NetworkPolicy `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateIPDenyResponse is the response to CreateIPDeny.
+type CreateIPDenyResponse struct {
+	Data  *IPDenyEntry   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListBlockedAdminAttemptsResponse is the response to
+// ListBlockedAdminAttempts.
+type ListBlockedAdminAttemptsResponse struct {
+	Data  []SecurityEvent `json:"data,omitempty"`
+	Links map[string]any  `json:"_links,omitempty"`
+}
+
+// ListClientErrorsResponse is the response to ListClientErrors.
+type ListClientErrorsResponse struct {
+	Data  []ClientError  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// SummarizeClientErrorsResponse is the response to SummarizeClientErrors.
+type SummarizeClientErrorsResponse struct {
+	Data  []ClientErrorGroup `json:"data,omitempty"`
+	Links map[string]any     `json:"_links,omitempty"`
+}
+
+// AdminListHangoutTypesResponse is the response to AdminListHangoutTypes.
+type AdminListHangoutTypesResponse struct {
+	Data  []HangoutTypeInfo `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminCreateHangoutTypeResponse is the response to AdminCreateHangoutType.
+type AdminCreateHangoutTypeResponse struct {
+	Data  *HangoutTypeInfo  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminUpdateHangoutTypeResponse is the response to AdminUpdateHangoutType.
+type AdminUpdateHangoutTypeResponse struct {
+	Data  *HangoutTypeInfo  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListQuotaUsageResponse is the response to ListQuotaUsage.
+type ListQuotaUsageResponse struct {
+	Data  []QuotaUsage   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetUserQuotaResponse is the response to GetUserQuota.
+type GetUserQuotaResponse struct {
+	Data  *QuotaUsage    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// SetUserQuotaResponse is the response to SetUserQuota.
+type SetUserQuotaResponse struct {
+	Data  *QuotaUsage    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// SetUserQuotaBody is the request body of SetUserQuota.
+type SetUserQuotaBody struct {
+	DailyLimit int     `json:"daily_limit"`
+	Reason     *string `json:"reason,omitempty"`
+}
+
+// ResetUserQuotaUsageResponse is the response to ResetUserQuotaUsage.
+type ResetUserQuotaUsageResponse struct {
+	Data  *QuotaUsage    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// AdminListQuestionsResponse is the response to AdminListQuestions.
+type AdminListQuestionsResponse struct {
+	Data  []AdminQuestion   `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminCreateQuestionResponse is the response to AdminCreateQuestion.
+type AdminCreateQuestionResponse struct {
+	Data  *AdminQuestion    `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminGetQuestionResponse is the response to AdminGetQuestion.
+type AdminGetQuestionResponse struct {
+	Data  *AdminQuestion    `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminUpdateQuestionResponse is the response to AdminUpdateQuestion.
+type AdminUpdateQuestionResponse struct {
+	Data  *AdminQuestion    `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminDeleteQuestionResponse is the response to AdminDeleteQuestion.
+type AdminDeleteQuestionResponse struct {
+	Data *ContentRemoval `json:"data,omitempty"`
+}
+
+// AdminListQuestionCategoriesResponse is the response to
+// AdminListQuestionCategories.
+type AdminListQuestionCategoriesResponse struct {
+	Data  []AdminQuestionCategory `json:"data,omitempty"`
+	Links map[string]string       `json:"_links,omitempty"`
+}
+
+// AdminCreateQuestionCategoryResponse is the response to
+// AdminCreateQuestionCategory.
+type AdminCreateQuestionCategoryResponse struct {
+	Data  *AdminQuestionCategory `json:"data,omitempty"`
+	Links map[string]string      `json:"_links,omitempty"`
+}
+
+// AdminUpdateQuestionCategoryResponse is the response to
+// AdminUpdateQuestionCategory.
+type AdminUpdateQuestionCategoryResponse struct {
+	Data  *AdminQuestionCategory `json:"data,omitempty"`
+	Links map[string]string      `json:"_links,omitempty"`
+}
+
+// AdminDeleteQuestionCategoryResponse is the response to
+// AdminDeleteQuestionCategory.
+type AdminDeleteQuestionCategoryResponse struct {
+	Data *ContentRemoval `json:"data,omitempty"`
+}
+
+// AdminListReviewTagsResponse is the response to AdminListReviewTags.
+type AdminListReviewTagsResponse struct {
+	Data  []AdminReviewTag  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminCreateReviewTagResponse is the response to AdminCreateReviewTag.
+type AdminCreateReviewTagResponse struct {
+	Data  *AdminReviewTag   `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminUpdateReviewTagResponse is the response to AdminUpdateReviewTag.
+type AdminUpdateReviewTagResponse struct {
+	Data  *AdminReviewTag   `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminDeleteReviewTagResponse is the response to AdminDeleteReviewTag.
+type AdminDeleteReviewTagResponse struct {
+	Data *ContentRemoval `json:"data,omitempty"`
+}
+
+// ListRemoteConfigResponse is the response to ListRemoteConfig.
+type ListRemoteConfigResponse struct {
+	Data  []RemoteConfigValue `json:"data,omitempty"`
+	Links map[string]any      `json:"_links,omitempty"`
+}
+
+// SetRemoteConfigResponse is the response to SetRemoteConfig.
+type SetRemoteConfigResponse struct {
+	Data  *RemoteConfigValue `json:"data,omitempty"`
+	Links map[string]any     `json:"_links,omitempty"`
+}
+
+// SetRemoteConfigBody is the request body of SetRemoteConfig.
+type SetRemoteConfigBody struct {
+	// Omit to set the value for every platform
+	Platform *string `json:"platform,omitempty"`
+	// Any JSON value except null, up to 16 KB
+	Value       map[string]any `json:"value"`
+	Description *string        `json:"description,omitempty"`
+}
+
+// ListExperimentsResponse is the response to ListExperiments.
+type ListExperimentsResponse struct {
+	Data  []Experiment   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateExperimentResponse is the response to CreateExperiment.
+type CreateExperimentResponse struct {
+	Data  *Experiment    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateExperimentBody is the request body of CreateExperiment.
+type CreateExperimentBody struct {
+	Key         string              `json:"key"`
+	Description *string             `json:"description,omitempty"`
+	Variants    []ExperimentVariant `json:"variants"`
+	// Omit to run on every platform
+	Platforms []string `json:"platforms,omitempty"`
+}
+
+// GetExperimentResponse is the response to GetExperiment.
+type GetExperimentResponse struct {
+	Data  *Experiment    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateExperimentResponse is the response to UpdateExperiment.
+type UpdateExperimentResponse struct {
+	Data  *Experiment    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// UpdateExperimentBody is the request body of UpdateExperiment.
+type UpdateExperimentBody struct {
+	Description *string `json:"description,omitempty"`
+	Status      *string `json:"status,omitempty"`
+}
+
+// ListAdminReportsResponse is the response to ListAdminReports.
+type ListAdminReportsResponse struct {
+	Data  []AdminReport  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateAdminReportResponse is the response to CreateAdminReport.
+type CreateAdminReportResponse struct {
+	Data  *AdminReport   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateAdminReportBody is the request body of CreateAdminReport.
+type CreateAdminReportBody struct {
+	Kind string `json:"kind"`
+}
+
+// GetAdminReportResponse is the response to GetAdminReport.
+type GetAdminReportResponse struct {
+	Data  *AdminReport   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListUserImportsResponse is the response to ListUserImports.
+type ListUserImportsResponse struct {
+	Data  []UserImport   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateUserImportResponse is the response to CreateUserImport.
+type CreateUserImportResponse struct {
+	Data  *UserImport    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetUserImportResponse is the response to GetUserImport.
+type GetUserImportResponse struct {
+	Data  *UserImport    `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// ListGuildImportsResponse is the response to ListGuildImports.
+type ListGuildImportsResponse struct {
+	Data  []GuildImport  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateGuildImportResponse is the response to CreateGuildImport.
+type CreateGuildImportResponse struct {
+	Data  *GuildImport   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CreateGuildImportBody is the request body of CreateGuildImport.
+type CreateGuildImportBody struct {
+	Source string `json:"source"`
+	// Slug of the guild to import into
+	Guild  string           `json:"guild"`
+	Export []map[string]any `json:"export"`
+}
+
+// GetGuildImportResponse is the response to GetGuildImport.
+type GetGuildImportResponse struct {
+	Data  *GuildImport   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CommitGuildImportResponse is the response to CommitGuildImport.
+type CommitGuildImportResponse struct {
+	Data  *GuildImport   `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// CommitGuildImportBody is the request body of CommitGuildImport.
+type CommitGuildImportBody struct {
+	// Emails for members by external ID
+	MemberEmails map[string]string `json:"member_emails,omitempty"`
+	// External IDs of members and events to leave out
+	Skip []string `json:"skip,omitempty"`
+}
+
+// GetAdminWinBackStatsResponse is the response to GetAdminWinBackStats.
+type GetAdminWinBackStatsResponse struct {
+	Data  *WinBackStats     `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminGetRegionResponse is the response to AdminGetRegion.
+type AdminGetRegionResponse struct {
+	Data  *RegionStatus     `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminUpdateRegionResponse is the response to AdminUpdateRegion.
+type AdminUpdateRegionResponse struct {
+	Data  *RegionStatus     `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminGetQueryStatsResponse is the response to AdminGetQueryStats.
+type AdminGetQueryStatsResponse struct {
+	Data  *QueryStats       `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminCreateRuntimeSnapshotResponse is the response to
+// AdminCreateRuntimeSnapshot.
+type AdminCreateRuntimeSnapshotResponse struct {
+	Data *RuntimeSnapshot `json:"data,omitempty"`
+}
+
+// AdminGetLogLevelResponse is the response to AdminGetLogLevel.
+type AdminGetLogLevelResponse struct {
+	Data  *LogLevel         `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// AdminUpdateLogLevelResponse is the response to AdminUpdateLogLevel.
+type AdminUpdateLogLevelResponse struct {
+	Data  *LogLevel         `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// DiscoverByInterestResponse is the response to DiscoverByInterest.
+type DiscoverByInterestResponse struct {
+	Results    []DiscoveryResult `json:"results,omitempty"`
+	TotalCount *int              `json:"total_count,omitempty"`
+}
+
+// DiscoverTeachLearnResponse is the response to DiscoverTeachLearn.
+type DiscoverTeachLearnResponse struct {
+	Results    []TeachLearnMatch `json:"results,omitempty"`
+	TotalCount *int              `json:"total_count,omitempty"`
+}
+
+// GetHangoutTypesResponse is the response to GetHangoutTypes.
+type GetHangoutTypesResponse struct {
+	HangoutTypes []HangoutTypeInfo `json:"hangout_types,omitempty"`
+}
+
+// SearchResponse is the response to Search.
+type SearchResponse struct {
+	Data  []SearchResult `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetEventResponse is the response to GetEvent.
+type GetEventResponse struct {
+	Data    *EventWithDetails `json:"data,omitempty"`
+	Links   map[string]string `json:"_links,omitempty"`
+	Actions *Affordances      `json:"_actions,omitempty"`
+}
+
+// GetPendingRSVPsResponse is the response to GetPendingRSVPs.
+type GetPendingRSVPsResponse struct {
+	Data []RSVP `json:"data,omitempty"`
+}
+
+// AddHostBody is the request body of AddHost.
+type AddHostBody struct {
+	UserID string `json:"user_id"`
+}
+
+// ConfirmEventCompletionBody is the request body of ConfirmEventCompletion.
+type ConfirmEventCompletionBody struct {
+	// True if you attended the event
+	Completed bool `json:"completed"`
+}
+
+// ReactToEventResponse is the response to ReactToEvent.
+type ReactToEventResponse struct {
+	Data  *ReactionSummary  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// UnreactToEventResponse is the response to UnreactToEvent.
+type UnreactToEventResponse struct {
+	Data  *ReactionSummary  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CheckoutEventRSVPResponse is the response to CheckoutEventRSVP.
+type CheckoutEventRSVPResponse struct {
+	Data  *CheckoutSession  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListEventPromoCodesResponse is the response to ListEventPromoCodes.
+type ListEventPromoCodesResponse struct {
+	Data  []PromoCode       `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CreateEventPromoCodeResponse is the response to CreateEventPromoCode.
+type CreateEventPromoCodeResponse struct {
+	Data  *PromoCode        `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// UpdateEventPromoCodeResponse is the response to UpdateEventPromoCode.
+type UpdateEventPromoCodeResponse struct {
+	Data  *PromoCode        `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// SetEventMemberDiscountsResponse is the response to SetEventMemberDiscounts.
+type SetEventMemberDiscountsResponse struct {
+	Data  []MemberDiscount  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GetEventDiscountReportResponse is the response to GetEventDiscountReport.
+type GetEventDiscountReportResponse struct {
+	Data  *DiscountReport   `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// HoldEventTicketResponse is the response to HoldEventTicket.
+type HoldEventTicketResponse struct {
+	Data  *EventTicket      `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GetMyEventTicketResponse is the response to GetMyEventTicket.
+type GetMyEventTicketResponse struct {
+	Data  *EventTicket      `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// TransferEventTicketResponse is the response to TransferEventTicket.
+type TransferEventTicketResponse struct {
+	Data  *EventTicket      `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CheckInEventTicketResponse is the response to CheckInEventTicket.
+type CheckInEventTicketResponse struct {
+	Data  *EventTicket      `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// DiscoverEventsResponse is the response to DiscoverEvents.
+type DiscoverEventsResponse struct {
+	Data []Event `json:"data,omitempty"`
+}
+
+// GetGuildEventsResponse is the response to GetGuildEvents.
+type GetGuildEventsResponse struct {
+	Data       []Event         `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Links      map[string]any  `json:"_links,omitempty"`
+}
+
+// ListEventCarpoolRidesResponse is the response to ListEventCarpoolRides.
+type ListEventCarpoolRidesResponse struct {
+	Data  []Rideshare       `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// OfferEventCarpoolRideResponse is the response to OfferEventCarpoolRide.
+type OfferEventCarpoolRideResponse struct {
+	Data  *Rideshare        `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// RequestEventCarpoolRideResponse is the response to RequestEventCarpoolRide.
+type RequestEventCarpoolRideResponse struct {
+	Data  *RideRequest      `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListEventCarpoolMatchesResponse is the response to ListEventCarpoolMatches.
+type ListEventCarpoolMatchesResponse struct {
+	Data  []RideshareMatch  `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// RequestRideshareSeatResponse is the response to RequestRideshareSeat.
+type RequestRideshareSeatResponse struct {
+	Data  *RideshareSeat    `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// RespondToRideshareSeatResponse is the response to RespondToRideshareSeat.
+type RespondToRideshareSeatResponse struct {
+	Data  *RideshareSeat    `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// ListEventExpensesResponse is the response to ListEventExpenses.
+type ListEventExpensesResponse struct {
+	Data  []Expense         `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// CreateEventExpenseResponse is the response to CreateEventExpense.
+type CreateEventExpenseResponse struct {
+	Data  *Expense          `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GetEventExpenseLedgerResponse is the response to GetEventExpenseLedger.
+type GetEventExpenseLedgerResponse struct {
+	Data  *ExpenseLedger    `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// SettleEventExpensesResponse is the response to SettleEventExpenses.
+type SettleEventExpensesResponse struct {
+	Data  *ExpenseSettlement `json:"data,omitempty"`
+	Links map[string]string  `json:"_links,omitempty"`
+}
+
+// GetAttendeeNoShowStatsResponse is the response to GetAttendeeNoShowStats.
+type GetAttendeeNoShowStatsResponse struct {
+	Data  *NoShowStats      `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// MarkNoShowResponse is the response to MarkNoShow.
+type MarkNoShowResponse struct {
+	Data *NoShow `json:"data,omitempty"`
+}
+
+// GetMyNoShowsResponse is the response to GetMyNoShows.
+type GetMyNoShowsResponse struct {
+	Data  *GetMyNoShowsResponseData `json:"data,omitempty"`
+	Links map[string]string         `json:"_links,omitempty"`
+}
+
+// GetMyNoShowsResponseData is the data property of GetMyNoShowsResponse.
+type GetMyNoShowsResponseData struct {
+	Stats   *NoShowStats `json:"stats,omitempty"`
+	NoShows []NoShow     `json:"no_shows,omitempty"`
+}
+
+// GetGuildNoShowPolicyResponse is the response to GetGuildNoShowPolicy.
+type GetGuildNoShowPolicyResponse struct {
+	Data  *GuildNoShowPolicy `json:"data,omitempty"`
+	Links map[string]string  `json:"_links,omitempty"`
+}
+
+// UpdateGuildNoShowPolicyResponse is the response to UpdateGuildNoShowPolicy.
+type UpdateGuildNoShowPolicyResponse struct {
+	Data  *GuildNoShowPolicy `json:"data,omitempty"`
+	Links map[string]string  `json:"_links,omitempty"`
+}
+
+// GetEventRolesResponse is the response to GetEventRoles.
+type GetEventRolesResponse struct {
+	Data []EventRole `json:"data,omitempty"`
+}
+
+// GetMyEventRolesResponse is the response to GetMyEventRoles.
+type GetMyEventRolesResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// GetEventRoleSuggestionsResponse is the response to GetEventRoleSuggestions.
+type GetEventRoleSuggestionsResponse struct {
+	Data []RoleSuggestion `json:"data,omitempty"`
+}
+
+// GetEventRoleNominationsResponse is the response to GetEventRoleNominations.
+type GetEventRoleNominationsResponse struct {
+	Data []EventRoleAssignment `json:"data,omitempty"`
+}
+
+// GetAvailableNowResponse is the response to GetAvailableNow.
+type GetAvailableNowResponse struct {
+	Data  *Availability     `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GoAvailableNowResponse is the response to GoAvailableNow.
+type GoAvailableNowResponse struct {
+	Data  *Availability     `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// GetMyAvailabilitiesResponse is the response to GetMyAvailabilities.
+type GetMyAvailabilitiesResponse struct {
+	Data []Availability `json:"data,omitempty"`
+}
+
+// GetSpontaneousAlertPreferenceResponse is the response to
+// GetSpontaneousAlertPreference.
+type GetSpontaneousAlertPreferenceResponse struct {
+	Data  *SpontaneousAlertPreference `json:"data,omitempty"`
+	Links map[string]string           `json:"_links,omitempty"`
+}
+
+// UpdateSpontaneousAlertPreferenceResponse is the response to
+// UpdateSpontaneousAlertPreference.
+type UpdateSpontaneousAlertPreferenceResponse struct {
+	Data  *SpontaneousAlertPreference `json:"data,omitempty"`
+	Links map[string]string           `json:"_links,omitempty"`
+}
+
+// FindNearbyAvailabilityResponse is the response to FindNearbyAvailability.
+type FindNearbyAvailabilityResponse struct {
+	Data []Availability `json:"data,omitempty"`
+}
+
+// ListGuildAvailabilityResponse is the response to ListGuildAvailability.
+type ListGuildAvailabilityResponse struct {
+	Data []Availability `json:"data,omitempty"`
+}
+
+// GetGuildAvailabilityHeatmapResponse is the response to
+// GetGuildAvailabilityHeatmap.
+type GetGuildAvailabilityHeatmapResponse struct {
+	Data *AvailabilityHeatmap `json:"data,omitempty"`
+}
+
+// SuggestGuildEventTimesResponse is the response to SuggestGuildEventTimes.
+type SuggestGuildEventTimesResponse struct {
+	Data []SuggestedEventTime `json:"data,omitempty"`
+}
+
+// SuggestGuildEventTimesBody is the request body of SuggestGuildEventTimes.
+type SuggestGuildEventTimesBody struct {
+	DurationMinutes int `json:"duration_minutes"`
+	// Candidate periods; at most 31 days in total
+	Ranges []SuggestGuildEventTimesBodyRange `json:"ranges"`
+	// IANA time zone whose hours the slots start on
+	Timezone *string `json:"timezone,omitempty"`
+	Limit    *int    `json:"limit,omitempty"`
+}
+
+// SuggestGuildEventTimesBodyRange is an element of the ranges property of
+// SuggestGuildEventTimesBody.
+type SuggestGuildEventTimesBodyRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// FindAvailabilityByTypeResponse is the response to FindAvailabilityByType.
+type FindAvailabilityByTypeResponse struct {
+	Data []Availability `json:"data,omitempty"`
+}
+
+// RequestHangoutBody is the request body of RequestHangout.
+type RequestHangoutBody struct {
+	// Message to the person (min 20 chars)
+	Note string `json:"note"`
+}
+
+// GetAvailabilityRequestsResponse is the response to GetAvailabilityRequests.
+type GetAvailabilityRequestsResponse struct {
+	Data []HangoutRequest `json:"data,omitempty"`
+}
+
+// RespondToHangoutRequestBody is the request body of RespondToHangoutRequest.
+type RespondToHangoutRequestBody struct {
+	Accept bool `json:"accept"`
+	// Accept even though the hangout clashes with other commitments
+	Force *bool `json:"force,omitempty"`
+}
+
+// ListHangoutTypesResponse is the response to ListHangoutTypes.
+type ListHangoutTypesResponse struct {
+	Data []HangoutTypeInfo `json:"data,omitempty"`
+}
+
+// GetMyHangoutsResponse is the response to GetMyHangouts.
+type GetMyHangoutsResponse struct {
+	Data []Hangout `json:"data,omitempty"`
+}
+
+// UpdateHangoutStatusBody is the request body of UpdateHangoutStatus.
+type UpdateHangoutStatusBody struct {
+	Status string `json:"status"`
+}
+
+// GetProfileActivityResponse is the response to GetProfileActivity.
+type GetProfileActivityResponse struct {
+	Data       []ActivityEntry `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+}
+
+// GetPayoutAccountResponse is the response to GetPayoutAccount.
+type GetPayoutAccountResponse struct {
+	Data  *PayoutAccount    `json:"data,omitempty"`
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// LinkPayoutAccountResponse is the response to LinkPayoutAccount.
+type LinkPayoutAccountResponse struct {
+	Data  *PayoutAccountLink `json:"data,omitempty"`
+	Links map[string]string  `json:"_links,omitempty"`
+}
+
+// GetGuildEventsEmbedResponse is the response to GetGuildEventsEmbed.
+type GetGuildEventsEmbedResponse struct {
+	Data  *GuildEventsEmbed `json:"data,omitempty"`
+	Links map[string]any    `json:"_links,omitempty"`
+}
+
+// ListQuestionsResponse is the response to ListQuestions.
+type ListQuestionsResponse struct {
+	Data []Question `json:"data,omitempty"`
+}
+
+// GetQuestionCategoriesResponse is the response to GetQuestionCategories.
+type GetQuestionCategoriesResponse struct {
+	Data []QuestionCategory `json:"data,omitempty"`
+}
+
+// GetMyAnswersResponse is the response to GetMyAnswers.
+type GetMyAnswersResponse struct {
+	Data []Answer `json:"data,omitempty"`
+}
+
+// GetMyAnswersDetailedResponse is the response to GetMyAnswersDetailed.
+type GetMyAnswersDetailedResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// ExportMyAnswersResponse is the response to ExportMyAnswers.
+type ExportMyAnswersResponse struct {
+	Data *QuestionnaireExport `json:"data,omitempty"`
+}
+
+// ImportMyAnswersResponse is the response to ImportMyAnswers.
+type ImportMyAnswersResponse struct {
+	Data *ImportMyAnswersResponseData `json:"data,omitempty"`
+}
+
+// ImportMyAnswersResponseData is the data property of ImportMyAnswersResponse.
+type ImportMyAnswersResponseData struct {
+	DryRun  *bool                                    `json:"dry_run,omitempty"`
+	Created *int                                     `json:"created,omitempty"`
+	Updated *int                                     `json:"updated,omitempty"`
+	Skipped []ImportMyAnswersResponseDataSkippedItem `json:"skipped,omitempty"`
+}
+
+// ImportMyAnswersResponseDataSkippedItem is an element of the skipped property
+// of ImportMyAnswersResponseData.
+type ImportMyAnswersResponseDataSkippedItem struct {
+	QuestionID *string `json:"question_id,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
+}
+
+// GetCompatibilityBatchResponse is the response to GetCompatibilityBatch.
+type GetCompatibilityBatchResponse struct {
+	Scores []json.RawMessage `json:"scores,omitempty"`
+}
+
+// GetCompatibilityBatchBody is the request body of GetCompatibilityBatch.
+type GetCompatibilityBatchBody struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// ListGuildQuestionsResponse is the response to ListGuildQuestions.
+type ListGuildQuestionsResponse struct {
+	Data []Question `json:"data,omitempty"`
+}
+
+// DeleteGuildQuestionResponse is the response to DeleteGuildQuestion.
+type DeleteGuildQuestionResponse struct {
+	Deleted    *bool `json:"deleted,omitempty"`
+	Deprecated *bool `json:"deprecated,omitempty"`
+	References *int  `json:"references,omitempty"`
+}
+
+// AnswerGuildQuestionBody is the request body of AnswerGuildQuestion.
+type AnswerGuildQuestionBody struct {
+	SelectedOption string `json:"selected_option"`
+}
+
+// ListGuildAnswersResponse is the response to ListGuildAnswers.
+type ListGuildAnswersResponse struct {
+	Data []GuildAnswer `json:"data,omitempty"`
+}
+
+// ListMyGuildAnswersResponse is the response to ListMyGuildAnswers.
+type ListMyGuildAnswersResponse struct {
+	Data []GuildAnswer `json:"data,omitempty"`
+}
+
+// ListInterestsResponse is the response to ListInterests.
+type ListInterestsResponse struct {
+	Data []Interest `json:"data,omitempty"`
+}
+
+// GetInterestCategoriesResponse is the response to GetInterestCategories.
+type GetInterestCategoriesResponse struct {
+	Data []InterestCategory `json:"data,omitempty"`
+}
+
+// GetMyInterestsResponse is the response to GetMyInterests.
+type GetMyInterestsResponse struct {
+	Data []UserInterest `json:"data,omitempty"`
+}
+
+// AddInterestResponse is the response to AddInterest.
+type AddInterestResponse struct {
+	Data []UserInterest `json:"data,omitempty"`
+}
+
+// FindTeachingMatchesResponse is the response to FindTeachingMatches.
+type FindTeachingMatchesResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// FindLearningMatchesResponse is the response to FindLearningMatches.
+type FindLearningMatchesResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// FindSharedInterestsResponse is the response to FindSharedInterests.
+type FindSharedInterestsResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// GetReviewsGivenResponse is the response to GetReviewsGiven.
+type GetReviewsGivenResponse struct {
+	Data       []Review        `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Links      map[string]any  `json:"_links,omitempty"`
+}
+
+// GetReviewsReceivedResponse is the response to GetReviewsReceived.
+type GetReviewsReceivedResponse struct {
+	Data       []Review        `json:"data,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Links      map[string]any  `json:"_links,omitempty"`
+}
+
+// GetPositiveTagsResponse is the response to GetPositiveTags.
+type GetPositiveTagsResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// GetImprovementTagsResponse is the response to GetImprovementTags.
+type GetImprovementTagsResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// GetTrustedUsersResponse is the response to GetTrustedUsers.
+type GetTrustedUsersResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// GetIRLConnectionsResponse is the response to GetIRLConnections.
+type GetIRLConnectionsResponse struct {
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+// ListGuildPoolsResponse is the response to ListGuildPools.
+type ListGuildPoolsResponse struct {
+	Data []Pool `json:"data,omitempty"`
+}
+
+// GetPoolMembersResponse is the response to GetPoolMembers.
+type GetPoolMembersResponse struct {
+	Data []PoolMember `json:"data,omitempty"`
+}
+
+// GetPoolMatchHistoryResponse is the response to GetPoolMatchHistory.
+type GetPoolMatchHistoryResponse struct {
+	Data []PoolMatch `json:"data,omitempty"`
+}
+
+// GetPendingMatchesResponse is the response to GetPendingMatches.
+type GetPendingMatchesResponse struct {
+	Data []PoolMatch `json:"data,omitempty"`
+}
+
+// GetPendingReportsResponse is the response to GetPendingReports.
+type GetPendingReportsResponse struct {
+	Reports []Report `json:"reports,omitempty"`
+}
+
+// LiftActionResponse is the response to LiftAction.
+type LiftActionResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// GetUserModerationActionsResponse is the response to
+// GetUserModerationActions.
+type GetUserModerationActionsResponse struct {
+	Actions []ModerationAction `json:"actions,omitempty"`
+}
+
+// GetBlockedUsersResponse is the response to GetBlockedUsers.
+type GetBlockedUsersResponse struct {
+	Blocks []Block `json:"blocks,omitempty"`
+}
+
+// UnblockUserResponse is the response to UnblockUser.
+type UnblockUserResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// CheckBlockResponse is the response to CheckBlock.
+type CheckBlockResponse struct {
+	Blocked *bool `json:"blocked,omitempty"`
+}
+
+// GetResonanceLedgerResponse is the response to GetResonanceLedger.
+type GetResonanceLedgerResponse struct {
+	Data []ResonanceLedgerEntry `json:"data,omitempty"`
+}
+
+// ListDevicesResponse is the response to ListDevices.
+type ListDevicesResponse struct {
+	Data []Device `json:"data,omitempty"`
+}
+
+// GetClientConfigResponse is the response to GetClientConfig.
+type GetClientConfigResponse struct {
+	Data  *ClientConfig  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetRemoteConfigResponse is the response to GetRemoteConfig.
+type GetRemoteConfigResponse struct {
+	Data  *RemoteConfig  `json:"data,omitempty"`
+	Links map[string]any `json:"_links,omitempty"`
+}
+
+// GetExperimentAssignmentsResponse is the response to
+// GetExperimentAssignments.
+type GetExperimentAssignmentsResponse struct {
+	Data  []ExperimentAssignment `json:"data,omitempty"`
+	Links map[string]any         `json:"_links,omitempty"`
+}
+
+// ReportClientErrorResponse is the response to ReportClientError.
+type ReportClientErrorResponse struct {
+	Data *ClientErrorReceipt `json:"data,omitempty"`
+}
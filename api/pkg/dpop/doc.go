@@ -0,0 +1,32 @@
+// Package dpop verifies DPoP proofs (RFC 9449) for the Saga API.
+//
+// A client that wants its tokens bound to it keeps a P-256 key pair on the
+// device and signs a short JWT, the proof, for every request. The proof names
+// the request's method and URL, carries the public key, and on API calls
+// includes a hash of the access token. Tokens issued alongside a valid proof
+// carry the key's thumbprint, so a stolen token is useless without the key.
+//
+// Only ES256 is accepted: it is what the iOS Secure Enclave and Android
+// Keystore provide.
+//
+// # Verifying a Proof
+//
+//	verifier := dpop.NewVerifier(dpop.Config{MaxAge: time.Minute})
+//
+//	proof, err := verifier.Verify(r.Header.Get(dpop.HeaderName), r.Method, r.URL.Path, accessToken)
+//	if err != nil {
+//	    // Missing, malformed, stale, replayed or for another request
+//	}
+//	if proof.KeyThumbprint != claims.Confirmation.JKT {
+//	    // Signed by a different key than the token is bound to
+//	}
+//
+// # Binding Tokens
+//
+// Middleware on token-issuing routes verifies the proof and passes the key
+// on through the request context:
+//
+//	ctx = dpop.NewContext(ctx, dpop.Binding{KeyThumbprint: proof.KeyThumbprint})
+//
+//	binding := dpop.FromContext(ctx) // Zero when the client sent no proof
+package dpop
@@ -0,0 +1,375 @@
+package dpop
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// HeaderName is the request header carrying the proof
+	HeaderName = "DPoP"
+	// Scheme is the Authorization scheme for key-bound access tokens
+	Scheme = "DPoP"
+	// proofType is the JOSE "typ" every proof must declare
+	proofType = "dpop+jwt"
+	// DefaultMaxAge is how old a proof may be, allowing for clock skew
+	DefaultMaxAge = time.Minute
+)
+
+var (
+	ErrMissingProof      = errors.New("dpop proof is missing")
+	ErrInvalidProof      = errors.New("dpop proof is invalid")
+	ErrUnsupportedKey    = errors.New("dpop proof key is not an ES256 key")
+	ErrInvalidSignature  = errors.New("dpop proof signature is invalid")
+	ErrRequestMismatch   = errors.New("dpop proof is for a different request")
+	ErrStaleProof        = errors.New("dpop proof is too old or from the future")
+	ErrReplayedProof     = errors.New("dpop proof has already been used")
+	ErrTokenHashMismatch = errors.New("dpop proof is for a different access token")
+	ErrKeyMismatch       = errors.New("dpop proof is signed by a different key than the token is bound to")
+	ErrWrongScheme       = errors.New("key-bound token must be sent with the DPoP scheme")
+	ErrUnboundToken      = errors.New("token is not bound to a key")
+)
+
+// Mode is how strictly proofs are required for a kind of client
+type Mode string
+
+const (
+	ModeOff     Mode = "off"     // Proofs are ignored and tokens are never bound
+	ModeReport  Mode = "report"  // Tokens are bound when a proof is sent; failures are logged, not rejected
+	ModeEnforce Mode = "enforce" // Tokens must be bound and every request must carry a valid proof
+)
+
+// IsValid returns true if the mode is known
+func (m Mode) IsValid() bool {
+	switch m {
+	case ModeOff, ModeReport, ModeEnforce:
+		return true
+	default:
+		return false
+	}
+}
+
+// Policy maps client platforms (ios, android, web) to how strictly they
+// need proofs. Platforms not listed are off.
+type Policy map[string]Mode
+
+// Mode returns the mode for a platform
+func (p Policy) Mode(platform string) Mode {
+	if mode, ok := p[platform]; ok {
+		return mode
+	}
+	return ModeOff
+}
+
+// Proof is a verified DPoP proof
+type Proof struct {
+	ID              string    // jti, unique per proof
+	Method          string    // htm
+	URL             string    // htu
+	IssuedAt        time.Time // iat
+	AccessTokenHash string    // ath, set on API calls
+	KeyThumbprint   string    // RFC 7638 thumbprint of the signing key
+}
+
+// jwk is the public key embedded in a proof's header
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type proofHeader struct {
+	Typ string `json:"typ"`
+	Alg string `json:"alg"`
+	JWK *jwk   `json:"jwk"`
+}
+
+type proofClaims struct {
+	JTI string `json:"jti"`
+	HTM string `json:"htm"`
+	HTU string `json:"htu"`
+	IAT int64  `json:"iat"`
+	ATH string `json:"ath,omitempty"`
+}
+
+// Parse checks a proof's structure and signature against the key it carries.
+// It does not check which request the proof is for; use a Verifier for that.
+func Parse(proof string) (*Proof, error) {
+	if proof == "" {
+		return nil, ErrMissingProof
+	}
+	parts := strings.Split(proof, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidProof
+	}
+
+	var header proofHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrInvalidProof
+	}
+	if header.Typ != proofType || header.JWK == nil {
+		return nil, ErrInvalidProof
+	}
+	if header.Alg != "ES256" {
+		return nil, ErrUnsupportedKey
+	}
+	key, err := header.JWK.publicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(signature) != 64 {
+		return nil, ErrInvalidSignature
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	if !ecdsa.Verify(key, hash[:], r, s) {
+		return nil, ErrInvalidSignature
+	}
+
+	var claims proofClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidProof
+	}
+	if claims.JTI == "" || claims.HTM == "" || claims.HTU == "" || claims.IAT == 0 {
+		return nil, ErrInvalidProof
+	}
+
+	return &Proof{
+		ID:              claims.JTI,
+		Method:          claims.HTM,
+		URL:             claims.HTU,
+		IssuedAt:        time.Unix(claims.IAT, 0),
+		AccessTokenHash: claims.ATH,
+		KeyThumbprint:   header.JWK.thumbprint(),
+	}, nil
+}
+
+// publicKey validates the JWK as a P-256 point
+func (k *jwk) publicKey() (*ecdsa.PublicKey, error) {
+	if k.Kty != "EC" || k.Crv != "P-256" {
+		return nil, ErrUnsupportedKey
+	}
+	x, errX := base64.RawURLEncoding.DecodeString(k.X)
+	y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+	if errX != nil || errY != nil || len(x) != 32 || len(y) != 32 {
+		return nil, ErrInvalidProof
+	}
+	// Rejects points that aren't on the curve
+	point := append(append([]byte{4}, x...), y...)
+	if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+		return nil, ErrInvalidProof
+	}
+	return &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}, nil
+}
+
+// thumbprint is the RFC 7638 SHA-256 thumbprint: the required members in
+// lexicographic order with no whitespace
+func (k *jwk) thumbprint() string {
+	canonical := `{"crv":"` + k.Crv + `","kty":"` + k.Kty + `","x":"` + k.X + `","y":"` + k.Y + `"}`
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AccessTokenHash is the ath value a proof carries for an access token
+func AccessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Config holds verifier configuration
+type Config struct {
+	MaxAge time.Duration // Default DefaultMaxAge
+}
+
+// Verifier checks that proofs are fresh, unused and for the request they
+// arrive on. Used proof IDs are remembered in memory until they are too old
+// to pass, so a proof replayed to another instance is only caught by its age.
+type Verifier struct {
+	maxAge    time.Duration
+	now       func() time.Time
+	mu        sync.Mutex
+	seen      map[string]time.Time // Proof ID to when it can be forgotten
+	lastPrune time.Time
+}
+
+// NewVerifier creates a new proof verifier
+func NewVerifier(cfg Config) *Verifier {
+	if cfg.MaxAge <= 0 {
+		cfg.MaxAge = DefaultMaxAge
+	}
+	return &Verifier{
+		maxAge: cfg.MaxAge,
+		now:    time.Now,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// Verify checks a proof for a request. path is compared with the proof's URL
+// path; scheme and host are not, as they differ behind proxies. accessToken
+// is the token presented with the request, or empty on token requests.
+func (v *Verifier) Verify(proof, method, path, accessToken string) (*Proof, error) {
+	p, err := Parse(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	htu, err := url.Parse(p.URL)
+	if err != nil || !strings.EqualFold(p.Method, method) || htu.Path != path {
+		return nil, ErrRequestMismatch
+	}
+
+	now := v.now()
+	if p.IssuedAt.Before(now.Add(-v.maxAge)) || p.IssuedAt.After(now.Add(v.maxAge)) {
+		return nil, ErrStaleProof
+	}
+
+	if accessToken != "" && p.AccessTokenHash != AccessTokenHash(accessToken) {
+		return nil, ErrTokenHashMismatch
+	}
+
+	if !v.remember(p.ID, now) {
+		return nil, ErrReplayedProof
+	}
+	return p, nil
+}
+
+// remember records a proof ID, returning false if it was already used
+func (v *Verifier) remember(id string, now time.Time) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if now.Sub(v.lastPrune) > v.maxAge {
+		for seenID, forgetAt := range v.seen {
+			if now.After(forgetAt) {
+				delete(v.seen, seenID)
+			}
+		}
+		v.lastPrune = now
+	}
+
+	if forgetAt, ok := v.seen[id]; ok && !now.After(forgetAt) {
+		return false
+	}
+	// Proofs are accepted up to maxAge either side of now
+	v.seen[id] = now.Add(2 * v.maxAge)
+	return true
+}
+
+// Sign creates a proof for a request, as a client would. accessToken is
+// empty on token requests.
+func Sign(key *ecdsa.PrivateKey, method, htu, accessToken string) (string, error) {
+	if key.Curve != elliptic.P256() {
+		return "", ErrUnsupportedKey
+	}
+	k, err := newJWK(&key.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	header, err := json.Marshal(proofHeader{Typ: proofType, Alg: "ES256", JWK: k})
+	if err != nil {
+		return "", err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	claims := proofClaims{
+		JTI: base64.RawURLEncoding.EncodeToString(id),
+		HTM: method,
+		HTU: htu,
+		IAT: time.Now().Unix(),
+	}
+	if accessToken != "" {
+		claims.ATH = AccessTokenHash(accessToken)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	message := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(message))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	if err != nil {
+		return "", err
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return message + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Thumbprint is the RFC 7638 thumbprint of a P-256 public key, as carried
+// in a bound token's cnf.jkt
+func Thumbprint(key *ecdsa.PublicKey) (string, error) {
+	k, err := newJWK(key)
+	if err != nil {
+		return "", err
+	}
+	return k.thumbprint(), nil
+}
+
+// newJWK encodes a P-256 public key
+func newJWK(key *ecdsa.PublicKey) (*jwk, error) {
+	pub, err := key.ECDH()
+	if err != nil {
+		return nil, err
+	}
+	point := pub.Bytes() // 0x04 || x || y
+	return &jwk{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(point[1:33]),
+		Y:   base64.RawURLEncoding.EncodeToString(point[33:]),
+	}, nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Binding is the key a request's tokens are to be bound to, and the kind of
+// client asking for them
+type Binding struct {
+	KeyThumbprint string // Empty when the client sent no valid proof
+	Platform      string // ios, android or web; empty when unknown
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the binding for tokens issued while
+// handling the request
+func NewContext(ctx context.Context, binding Binding) context.Context {
+	return context.WithValue(ctx, contextKey{}, binding)
+}
+
+// FromContext returns the binding set by NewContext, or the zero Binding
+func FromContext(ctx context.Context) Binding {
+	binding, _ := ctx.Value(contextKey{}).(Binding)
+	return binding
+}
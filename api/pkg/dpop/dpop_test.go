@@ -0,0 +1,212 @@
+package dpop
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+func signProof(t *testing.T, key *ecdsa.PrivateKey, method, htu, accessToken string) string {
+	t.Helper()
+	proof, err := Sign(key, method, htu, accessToken)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	return proof
+}
+
+// ============================================================================
+// Parse() Tests
+// ============================================================================
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	proof, err := Parse(signProof(t, key, "POST", "https://api.example.com/v1/auth/login", ""))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	want, _ := Thumbprint(&key.PublicKey)
+	if proof.KeyThumbprint != want {
+		t.Errorf("expected thumbprint %s, got %s", want, proof.KeyThumbprint)
+	}
+	if proof.Method != "POST" || proof.URL != "https://api.example.com/v1/auth/login" || proof.ID == "" {
+		t.Errorf("unexpected proof %+v", proof)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	valid := signProof(t, newTestKey(t), "GET", "https://api.example.com/v1/profile", "")
+	parts := strings.Split(valid, ".")
+	other := strings.Split(signProof(t, newTestKey(t), "GET", "https://api.example.com/v1/profile", ""), ".")
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name  string
+		proof string
+		want  error
+	}{
+		{"empty", "", ErrMissingProof},
+		{"not a jwt", "abc", ErrInvalidProof},
+		{"wrong typ", encode(`{"typ":"JWT","alg":"ES256","jwk":{}}`) + "." + parts[1] + "." + parts[2], ErrInvalidProof},
+		{"rsa", encode(`{"typ":"dpop+jwt","alg":"RS256","jwk":{"kty":"RSA"}}`) + "." + parts[1] + "." + parts[2], ErrUnsupportedKey},
+		{"none alg", encode(`{"typ":"dpop+jwt","alg":"none","jwk":{"kty":"EC"}}`) + "." + parts[1] + ".", ErrUnsupportedKey},
+		{"signed by another key", parts[0] + "." + parts[1] + "." + other[2], ErrInvalidSignature},
+		{"claims swapped", parts[0] + "." + other[1] + "." + parts[2], ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.proof); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestThumbprint_RFC9449Example(t *testing.T) {
+	t.Parallel()
+
+	// The public key from the examples in RFC 9449
+	k := jwk{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   "l8tFrhx-34tV3hRICRDY9zCkDlpBhF42UQUfWVAWBFs",
+		Y:   "9VE4jf_Ok_o64zbTTlcuNJajHmt6v9TDVrU0CdvGRDA",
+	}
+	if got := k.thumbprint(); got != "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I" {
+		t.Errorf("unexpected thumbprint %s", got)
+	}
+}
+
+// ============================================================================
+// Verifier Tests
+// ============================================================================
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	v := NewVerifier(Config{})
+
+	proof := signProof(t, key, "GET", "https://api.example.com/v1/profile", "access-token")
+	p, err := v.Verify(proof, "GET", "/v1/profile", "access-token")
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	if p.AccessTokenHash != AccessTokenHash("access-token") {
+		t.Errorf("expected ath to be carried, got %q", p.AccessTokenHash)
+	}
+
+	if _, err := v.Verify(proof, "GET", "/v1/profile", "access-token"); !errors.Is(err, ErrReplayedProof) {
+		t.Errorf("expected ErrReplayedProof, got %v", err)
+	}
+}
+
+func TestVerifier_Verify_Mismatch(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	v := NewVerifier(Config{})
+
+	tests := []struct {
+		name   string
+		proof  string
+		method string
+		path   string
+		token  string
+		want   error
+	}{
+		{"method", signProof(t, key, "GET", "https://api.example.com/v1/profile", ""), "DELETE", "/v1/profile", "", ErrRequestMismatch},
+		{"path", signProof(t, key, "GET", "https://api.example.com/v1/profile", ""), "GET", "/v1/admin/users", "", ErrRequestMismatch},
+		{"access token", signProof(t, key, "GET", "https://api.example.com/v1/profile", "stolen"), "GET", "/v1/profile", "mine", ErrTokenHashMismatch},
+		{"no ath", signProof(t, key, "GET", "https://api.example.com/v1/profile", ""), "GET", "/v1/profile", "mine", ErrTokenHashMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := v.Verify(tt.proof, tt.method, tt.path, tt.token); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestVerifier_Verify_Stale(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	v := NewVerifier(Config{MaxAge: time.Minute})
+	proof := signProof(t, key, "GET", "https://api.example.com/v1/profile", "")
+
+	v.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if _, err := v.Verify(proof, "GET", "/v1/profile", ""); !errors.Is(err, ErrStaleProof) {
+		t.Errorf("expected ErrStaleProof for an old proof, got %v", err)
+	}
+	v.now = func() time.Time { return time.Now().Add(-2 * time.Minute) }
+	if _, err := v.Verify(proof, "GET", "/v1/profile", ""); !errors.Is(err, ErrStaleProof) {
+		t.Errorf("expected ErrStaleProof for a proof from the future, got %v", err)
+	}
+}
+
+func TestVerifier_ForgetsOldProofs(t *testing.T) {
+	t.Parallel()
+
+	v := NewVerifier(Config{MaxAge: time.Minute})
+	now := time.Now()
+	if !v.remember("a", now) || v.remember("a", now) {
+		t.Fatal("expected a proof ID to be accepted once")
+	}
+
+	later := now.Add(3 * time.Minute)
+	if !v.remember("b", later) {
+		t.Fatal("expected a new proof ID to be accepted")
+	}
+	if _, ok := v.seen["a"]; ok {
+		t.Error("expected proof IDs too old to pass to be pruned")
+	}
+}
+
+// ============================================================================
+// Policy and Context Tests
+// ============================================================================
+
+func TestPolicy_Mode(t *testing.T) {
+	t.Parallel()
+
+	p := Policy{"ios": ModeEnforce, "android": ModeReport}
+	if p.Mode("ios") != ModeEnforce || p.Mode("android") != ModeReport {
+		t.Error("expected configured modes")
+	}
+	if p.Mode("web") != ModeOff || p.Mode("") != ModeOff || Policy(nil).Mode("ios") != ModeOff {
+		t.Error("expected unlisted platforms to be off")
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	if got := FromContext(context.Background()); got != (Binding{}) {
+		t.Errorf("expected zero binding, got %+v", got)
+	}
+	ctx := NewContext(context.Background(), Binding{KeyThumbprint: "jkt", Platform: "ios"})
+	if got := FromContext(ctx); got.KeyThumbprint != "jkt" || got.Platform != "ios" {
+		t.Errorf("unexpected binding %+v", got)
+	}
+}
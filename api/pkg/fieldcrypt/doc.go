@@ -0,0 +1,27 @@
+// Package fieldcrypt encrypts individual database fields at the application
+// layer for the Saga API.
+//
+// Values are sealed with AES-256-GCM and stored as text that names the key
+// used: "enc:v1:<key id>:<nonce and ciphertext, base64url>". A keyring holds
+// a primary key for sealing and older keys for opening, so keys can be
+// rotated without downtime: add the new key first, re-seal stored values,
+// then drop the old one.
+//
+// # Sealing a Field
+//
+//	keys, err := fieldcrypt.ParseKeys(os.Getenv("FIELD_ENCRYPTION_KEYS")) // "2026-10:base64,2025-01:base64"
+//	keyring, err := fieldcrypt.NewKeyring(keys)
+//
+//	sealed, err := keyring.Seal([]byte(phone), "user.phone")
+//	phone, err := keyring.Open(sealed, "user.phone")
+//
+// The context names the field. It is authenticated but not stored, so a
+// sealed value copied into a different field won't open.
+//
+// # Rotating Keys
+//
+//	if keyring.NeedsReseal(stored) {
+//	    plaintext, err := keyring.Open(stored, "user.phone")
+//	    stored, err = keyring.Seal(plaintext, "user.phone")
+//	}
+package fieldcrypt
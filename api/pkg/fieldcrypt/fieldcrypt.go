@@ -0,0 +1,200 @@
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// prefix marks a sealed value and its format version
+	prefix = "enc:v1:"
+	// KeySize is the AES-256 key length in bytes
+	KeySize = 32
+)
+
+var (
+	ErrNoKeys       = errors.New("fieldcrypt: no keys configured")
+	ErrInvalidKeyID = errors.New("fieldcrypt: key IDs must be letters, digits, '-' or '_'")
+	ErrInvalidKey   = errors.New("fieldcrypt: keys must be 32 bytes, base64 encoded")
+	ErrDuplicateKey = errors.New("fieldcrypt: key ID is listed twice")
+	ErrNotSealed    = errors.New("fieldcrypt: value is not sealed")
+	ErrUnknownKey   = errors.New("fieldcrypt: value is sealed with a key that is not configured")
+	ErrMalformed    = errors.New("fieldcrypt: sealed value is malformed")
+	ErrDecrypt      = errors.New("fieldcrypt: sealed value could not be decrypted")
+)
+
+// Key is a named AES-256 key
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// Keyring seals values with its primary key and opens values sealed with
+// any of its keys. Rotating means adding a new primary key, re-sealing
+// stored values, then dropping the old key.
+type Keyring struct {
+	primary string
+	aeads   map[string]cipher.AEAD
+}
+
+// NewKeyring creates a keyring. The first key is the primary key; the rest
+// are only used to open values sealed before a rotation.
+func NewKeyring(keys []Key) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+	k := &Keyring{primary: keys[0].ID, aeads: make(map[string]cipher.AEAD, len(keys))}
+	for _, key := range keys {
+		if !validKeyID(key.ID) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidKeyID, key.ID)
+		}
+		if _, ok := k.aeads[key.ID]; ok {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateKey, key.ID)
+		}
+		if len(key.Secret) != KeySize {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidKey, key.ID)
+		}
+		block, err := aes.NewCipher(key.Secret)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		k.aeads[key.ID] = aead
+	}
+	return k, nil
+}
+
+// ParseKeys reads keys written as "id:base64key,id:base64key", primary
+// first. Standard and URL-safe base64 are accepted, with or without padding.
+func ParseKeys(spec string) ([]Key, error) {
+	var keys []Key
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q has no key ID", ErrInvalidKey, entry)
+		}
+		secret, err := decodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidKey, id)
+		}
+		keys = append(keys, Key{ID: id, Secret: secret})
+	}
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+	return keys, nil
+}
+
+// PrimaryKeyID returns the ID of the key new values are sealed with
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primary
+}
+
+// Seal encrypts a value with the primary key. context is authenticated but
+// not stored: pass the same context to Open, so a value copied into another
+// field won't open there.
+func (k *Keyring) Seal(plaintext []byte, context string) (string, error) {
+	aead := k.aeads[k.primary]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, []byte(context))
+	return prefix + k.primary + ":" + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value sealed with any key in the keyring
+func (k *Keyring) Open(value, context string) ([]byte, error) {
+	keyID, ok := KeyID(value)
+	if !ok {
+		return nil, ErrNotSealed
+	}
+	aead, ok := k.aeads[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, keyID)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value[len(prefix)+len(keyID)+1:])
+	if err != nil || len(data) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrMalformed
+	}
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(context))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// NeedsReseal returns true if a value isn't sealed with the primary key,
+// either because it predates encryption or a rotation
+func (k *Keyring) NeedsReseal(value string) bool {
+	keyID, ok := KeyID(value)
+	return !ok || keyID != k.primary
+}
+
+// SealedPrefix is how every value sealed with the primary key starts, for
+// finding values that need resealing in a query
+func (k *Keyring) SealedPrefix() string {
+	return prefix + k.primary + ":"
+}
+
+// KeyID returns the ID of the key a value was sealed with, and false if the
+// value isn't sealed
+func KeyID(value string) (string, bool) {
+	if !strings.HasPrefix(value, prefix) {
+		return "", false
+	}
+	keyID, _, ok := strings.Cut(value[len(prefix):], ":")
+	if !ok || !validKeyID(keyID) {
+		return "", false
+	}
+	return keyID, true
+}
+
+// IsSealed returns true if a value was produced by Seal
+func IsSealed(value string) bool {
+	_, ok := KeyID(value)
+	return ok
+}
+
+// GenerateKey returns a new random key, base64 encoded as ParseKeys expects
+func GenerateKey() (string, error) {
+	secret := make([]byte, KeySize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(secret), nil
+}
+
+func decodeKey(encoded string) ([]byte, error) {
+	encoded = strings.TrimRight(strings.TrimSpace(encoded), "=")
+	if secret, err := base64.RawStdEncoding.DecodeString(encoded); err == nil {
+		return secret, nil
+	}
+	return base64.RawURLEncoding.DecodeString(encoded)
+}
+
+func validKeyID(id string) bool {
+	if id == "" || len(id) > 32 {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,192 @@
+package fieldcrypt
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newTestKey(t *testing.T, id string) Key {
+	t.Helper()
+	encoded, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	secret, err := decodeKey(encoded)
+	if err != nil {
+		t.Fatalf("decodeKey failed: %v", err)
+	}
+	return Key{ID: id, Secret: secret}
+}
+
+func newTestKeyring(t *testing.T, keys ...Key) *Keyring {
+	t.Helper()
+	k, err := NewKeyring(keys)
+	if err != nil {
+		t.Fatalf("NewKeyring failed: %v", err)
+	}
+	return k
+}
+
+// ============================================================================
+// Seal() / Open() Tests
+// ============================================================================
+
+func TestSealOpen(t *testing.T) {
+	t.Parallel()
+
+	k := newTestKeyring(t, newTestKey(t, "k1"))
+	sealed, err := k.Seal([]byte("+1 555 0100"), "user.phone")
+	if err != nil {
+		t.Fatalf("Seal failed: %v", err)
+	}
+	if !strings.HasPrefix(sealed, "enc:v1:k1:") || strings.Contains(sealed, "555") {
+		t.Errorf("unexpected sealed value %q", sealed)
+	}
+
+	again, _ := k.Seal([]byte("+1 555 0100"), "user.phone")
+	if again == sealed {
+		t.Error("expected a fresh nonce for every seal")
+	}
+
+	plaintext, err := k.Open(sealed, "user.phone")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if string(plaintext) != "+1 555 0100" {
+		t.Errorf("expected original value, got %q", plaintext)
+	}
+}
+
+func TestOpen_Errors(t *testing.T) {
+	t.Parallel()
+
+	k := newTestKeyring(t, newTestKey(t, "k1"))
+	other := newTestKeyring(t, newTestKey(t, "k2"))
+	sealed, _ := k.Seal([]byte("secret"), "user.phone")
+	foreign, _ := other.Seal([]byte("secret"), "user.phone")
+
+	data, _ := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(sealed, "enc:v1:k1:"))
+	data[len(data)-1] ^= 1
+	tampered := "enc:v1:k1:" + base64.RawURLEncoding.EncodeToString(data)
+
+	tests := []struct {
+		name    string
+		value   string
+		context string
+		want    error
+	}{
+		{"plaintext", "+1 555 0100", "user.phone", ErrNotSealed},
+		{"unknown key", foreign, "user.phone", ErrUnknownKey},
+		{"other field", sealed, "user.email", ErrDecrypt},
+		{"tampered", tampered, "user.phone", ErrDecrypt},
+		{"truncated", "enc:v1:k1:AAAA", "user.phone", ErrMalformed},
+		{"not base64", "enc:v1:k1:!!!", "user.phone", ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := k.Open(tt.value, tt.context); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Rotation Tests
+// ============================================================================
+
+func TestRotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey, newKey := newTestKey(t, "2025-01"), newTestKey(t, "2026-10")
+	before := newTestKeyring(t, oldKey)
+	sealed, _ := before.Seal([]byte("51.5072,-0.1276"), "user_profile.location")
+
+	after := newTestKeyring(t, newKey, oldKey)
+	if !after.NeedsReseal(sealed) || !after.NeedsReseal("plaintext") {
+		t.Error("expected values not under the primary key to need resealing")
+	}
+	plaintext, err := after.Open(sealed, "user_profile.location")
+	if err != nil {
+		t.Fatalf("expected old values to open after rotation: %v", err)
+	}
+
+	resealed, _ := after.Seal(plaintext, "user_profile.location")
+	if after.NeedsReseal(resealed) || !strings.HasPrefix(resealed, after.SealedPrefix()) {
+		t.Errorf("expected resealed value under the primary key, got %q", resealed)
+	}
+	if _, err := newTestKeyring(t, newKey).Open(resealed, "user_profile.location"); err != nil {
+		t.Errorf("expected resealed value to open without the old key: %v", err)
+	}
+}
+
+// ============================================================================
+// Key Parsing Tests
+// ============================================================================
+
+func TestParseKeys(t *testing.T) {
+	t.Parallel()
+
+	k1, _ := GenerateKey()
+	k2, _ := GenerateKey()
+	keys, err := ParseKeys(" new:" + k1 + ", old:" + strings.TrimRight(k2, "=") + ",")
+	if err != nil {
+		t.Fatalf("ParseKeys failed: %v", err)
+	}
+	if len(keys) != 2 || keys[0].ID != "new" || keys[1].ID != "old" {
+		t.Fatalf("unexpected keys %+v", keys)
+	}
+	k, err := NewKeyring(keys)
+	if err != nil {
+		t.Fatalf("NewKeyring failed: %v", err)
+	}
+	if k.PrimaryKeyID() != "new" {
+		t.Errorf("expected the first key to be primary, got %s", k.PrimaryKeyID())
+	}
+}
+
+func TestParseKeys_Invalid(t *testing.T) {
+	t.Parallel()
+
+	valid, _ := GenerateKey()
+	tests := []struct {
+		name string
+		spec string
+		want error
+	}{
+		{"empty", "", ErrNoKeys},
+		{"no key ID", valid, ErrInvalidKey},
+		{"not base64", "k1:not base64!", ErrInvalidKey},
+		{"short key", "k1:c2hvcnQ=", ErrInvalidKey},
+		{"bad key ID", "k.1:" + valid, ErrInvalidKeyID},
+		{"duplicate", "k1:" + valid + ",k1:" + valid, ErrDuplicateKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := ParseKeys(tt.spec)
+			if err == nil {
+				_, err = NewKeyring(keys)
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestKeyID(t *testing.T) {
+	t.Parallel()
+
+	k := newTestKeyring(t, newTestKey(t, "k1"))
+	sealed, _ := k.Seal([]byte("x"), "f")
+	if id, ok := KeyID(sealed); !ok || id != "k1" {
+		t.Errorf("expected k1, got %q %v", id, ok)
+	}
+	for _, v := range []string{"", "enc:v1:", "enc:v1:k1", "enc:v2:k1:abc", "k1:abc"} {
+		if IsSealed(v) {
+			t.Errorf("expected %q not to be sealed", v)
+		}
+	}
+}
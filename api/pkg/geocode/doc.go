@@ -0,0 +1,20 @@
+// Package geocode turns street addresses into coordinates.
+//
+// Client looks addresses up with a Nominatim server, the OpenStreetMap
+// geocoder. The public instance asks for an identifying User-Agent and no
+// more than one request a second, which suits addresses typed in by
+// organizers; heavier use should point BaseURL at a self-hosted server.
+//
+// # Looking Up an Address
+//
+//	client := geocode.NewClient(geocode.Config{UserAgent: "saga-api (ops@example.com)"})
+//
+//	result, err := client.Geocode(ctx, "1777 Broadway, Boulder")
+//	if errors.Is(err, geocode.ErrNotFound) {
+//	    // No match; ask for a fuller address
+//	}
+//	if err != nil {
+//	    // The service is unreachable
+//	}
+//	// result.Lat, result.Lng, result.City, ...
+package geocode
@@ -0,0 +1,136 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the public Nominatim instance
+const DefaultBaseURL = "https://nominatim.openstreetmap.org"
+
+// ErrNotFound is returned when the address matches nothing
+var ErrNotFound = errors.New("geocode: address not found")
+
+// Result is the best match for an address
+type Result struct {
+	Lat         float64
+	Lng         float64
+	Address     string // The provider's formatted address
+	City        string // City, town or village; empty when the match has none
+	Country     string
+	CountryCode string // ISO 3166-1 alpha-2, lower case
+}
+
+// Config holds geocoding client configuration
+type Config struct {
+	BaseURL    string // Default DefaultBaseURL
+	UserAgent  string // Default "saga-api"
+	HTTPClient *http.Client
+}
+
+// Client looks addresses up with a Nominatim server
+type Client struct {
+	baseURL    string
+	userAgent  string
+	httpClient *http.Client
+}
+
+// NewClient creates a new geocoding client
+func NewClient(cfg Config) *Client {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = "saga-api"
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 5 * time.Second}
+	}
+	return &Client{
+		baseURL:    strings.TrimRight(cfg.BaseURL, "/"),
+		userAgent:  cfg.UserAgent,
+		httpClient: cfg.HTTPClient,
+	}
+}
+
+// place is one entry of a Nominatim search response. Coordinates come back
+// as strings.
+type place struct {
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+	DisplayName string `json:"display_name"`
+	Address     struct {
+		City        string `json:"city"`
+		Town        string `json:"town"`
+		Village     string `json:"village"`
+		Country     string `json:"country"`
+		CountryCode string `json:"country_code"`
+	} `json:"address"`
+}
+
+// Geocode returns the best match for address, or ErrNotFound
+func (c *Client) Geocode(ctx context.Context, address string) (*Result, error) {
+	query := url.Values{
+		"q":              {address},
+		"format":         {"jsonv2"},
+		"addressdetails": {"1"},
+		"limit":          {"1"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("geocode: unexpected status %d", resp.StatusCode)
+	}
+
+	var places []place
+	if err := json.NewDecoder(resp.Body).Decode(&places); err != nil {
+		return nil, fmt.Errorf("geocode: decoding response: %w", err)
+	}
+	if len(places) == 0 {
+		return nil, ErrNotFound
+	}
+
+	p := places[0]
+	lat, err := strconv.ParseFloat(p.Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("geocode: invalid latitude %q", p.Lat)
+	}
+	lng, err := strconv.ParseFloat(p.Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("geocode: invalid longitude %q", p.Lon)
+	}
+
+	result := &Result{
+		Lat:         lat,
+		Lng:         lng,
+		Address:     p.DisplayName,
+		City:        p.Address.City,
+		Country:     p.Address.Country,
+		CountryCode: p.Address.CountryCode,
+	}
+	if result.City == "" {
+		result.City = p.Address.Town
+	}
+	if result.City == "" {
+		result.City = p.Address.Village
+	}
+	return result, nil
+}
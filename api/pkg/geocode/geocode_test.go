@@ -0,0 +1,72 @@
+package geocode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ============================================================================
+// Geocode() Tests
+// ============================================================================
+
+func TestGeocode(t *testing.T) {
+	t.Parallel()
+
+	var gotQuery, gotAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery, gotAgent = r.URL.Query().Get("q"), r.Header.Get("User-Agent")
+		fmt.Fprint(w, `[{"lat":"40.0190","lon":"-105.2747","display_name":"1777, Broadway, Boulder, Colorado, United States",
+			"address":{"town":"Boulder","country":"United States","country_code":"us"}}]`)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL, UserAgent: "saga-test"})
+
+	result, err := client.Geocode(context.Background(), "1777 Broadway, Boulder")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Lat != 40.0190 || result.Lng != -105.2747 {
+		t.Errorf("expected 40.0190,-105.2747, got %v,%v", result.Lat, result.Lng)
+	}
+	if result.City != "Boulder" || result.CountryCode != "us" {
+		t.Errorf("expected Boulder, us, got %q, %q", result.City, result.CountryCode)
+	}
+	if gotQuery != "1777 Broadway, Boulder" {
+		t.Errorf("expected the address as the query, got %q", gotQuery)
+	}
+	if gotAgent != "saga-test" {
+		t.Errorf("expected the configured user agent, got %q", gotAgent)
+	}
+}
+
+func TestGeocode_NotFound(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(Config{BaseURL: server.URL}).Geocode(context.Background(), "nowhere at all"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestGeocode_ServiceError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(Config{BaseURL: server.URL}).Geocode(context.Background(), "1777 Broadway")
+	if err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a service error, got %v", err)
+	}
+}
@@ -35,22 +35,48 @@ type Claims struct {
 	NotBefore int64  `json:"nbf,omitempty"`
 	IssuedAt  int64  `json:"iat,omitempty"`
 	JWTID     string `json:"jti,omitempty"`
+	AuthTime  int64  `json:"auth_time,omitempty"` // When the user last proved who they are
 
 	// Custom claims
 	Email    string `json:"email,omitempty"`
 	UserID   string `json:"user_id,omitempty"`
 	Username string `json:"username,omitempty"`
-	Role     string `json:"role,omitempty"` // user, moderator, admin
+	Role     string `json:"role,omitempty"` // user, moderator, admin, superadmin
+	Platform string `json:"plat,omitempty"` // Client the token was issued to: ios, android or web
+
+	// Confirmation binds the token to a client key (RFC 9449); requests
+	// must then prove possession of it
+	Confirmation *Confirmation `json:"cnf,omitempty"`
+}
+
+// Confirmation identifies the key a token is bound to
+type Confirmation struct {
+	JKT string `json:"jkt"` // RFC 7638 thumbprint of the client's public key
 }
 
-// IsAdmin returns true if the claims indicate admin role
+// IsBound returns true if the token is bound to a client key
+func (c *Claims) IsBound() bool {
+	return c.Confirmation != nil && c.Confirmation.JKT != ""
+}
+
+// IsAdmin returns true if the claims indicate admin or superadmin role
 func (c *Claims) IsAdmin() bool {
-	return c.Role == "admin"
+	return c.Role == "admin" || c.IsSuperAdmin()
+}
+
+// IsSuperAdmin returns true if the claims indicate superadmin role
+func (c *Claims) IsSuperAdmin() bool {
+	return c.Role == "superadmin"
 }
 
-// Valid checks if the claims are valid
+// Valid checks if the claims are valid now
 func (c *Claims) Valid() error {
-	now := time.Now().Unix()
+	return c.ValidAt(time.Now())
+}
+
+// ValidAt checks if the claims are valid at the given time
+func (c *Claims) ValidAt(t time.Time) error {
+	now := t.Unix()
 
 	if c.ExpiresAt != 0 && now > c.ExpiresAt {
 		return ErrTokenExpired
@@ -69,6 +95,7 @@ type Service struct {
 	publicKey  *rsa.PublicKey
 	issuer     string
 	expiration time.Duration
+	now        func() time.Time
 }
 
 // Config holds JWT service configuration
@@ -77,6 +104,7 @@ type Config struct {
 	PublicKeyPath  string
 	Issuer         string
 	ExpirationMins int
+	Now            func() time.Time // Default time.Now; set in tests to control issue and expiry times
 }
 
 // NewService creates a new JWT service
@@ -102,11 +130,16 @@ func NewService(cfg Config) (*Service, error) {
 		}
 	}
 
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
 	return &Service{
 		privateKey: privateKey,
 		publicKey:  publicKey,
 		issuer:     cfg.Issuer,
 		expiration: time.Duration(cfg.ExpirationMins) * time.Minute,
+		now:        cfg.Now,
 	}, nil
 }
 
@@ -148,7 +181,7 @@ func (s *Service) Sign(claims Claims) (string, error) {
 		return "", ErrInvalidKey
 	}
 
-	now := time.Now()
+	now := s.currentTime()
 
 	// Set standard claims
 	claims.Issuer = s.issuer
@@ -230,7 +263,7 @@ func (s *Service) Validate(tokenString string) (*Claims, error) {
 	}
 
 	// Validate claims
-	if err := claims.Valid(); err != nil {
+	if err := claims.ValidAt(s.currentTime()); err != nil {
 		return nil, err
 	}
 
@@ -255,11 +288,21 @@ func NewTestService(privateKey *rsa.PrivateKey, issuer string, expiration time.D
 		publicKey:  &privateKey.PublicKey,
 		issuer:     issuer,
 		expiration: expiration,
+		now:        time.Now,
 	}
 }
 
 // Helper functions
 
+// currentTime is the configured time, or the system's for services built
+// without NewService
+func (s *Service) currentTime() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
 func loadPrivateKey(path string) (*rsa.PrivateKey, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
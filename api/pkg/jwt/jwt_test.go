@@ -50,6 +50,17 @@ func TestClaims_Valid_NoExpiration_ReturnsNil(t *testing.T) {
 	}
 }
 
+func TestClaims_IsBound(t *testing.T) {
+	t.Parallel()
+
+	if (&Claims{}).IsBound() || (&Claims{Confirmation: &Confirmation{}}).IsBound() {
+		t.Error("expected claims without a key thumbprint to be unbound")
+	}
+	if !(&Claims{Confirmation: &Confirmation{JKT: "jkt"}}).IsBound() {
+		t.Error("expected claims with a key thumbprint to be bound")
+	}
+}
+
 func TestClaims_Valid_NotExpired_ReturnsNil(t *testing.T) {
 	t.Parallel()
 	claims := Claims{
@@ -501,6 +512,26 @@ func TestValidate_TokenNotYetValid_ReturnsErrTokenNotYetValid(t *testing.T) {
 	}
 }
 
+func TestValidate_UsesServiceClock(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := newTestService(t)
+	svc.now = func() time.Time { return now }
+
+	token, err := svc.Sign(Claims{UserID: "user:123"})
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if _, err := svc.Validate(token); err != nil {
+		t.Fatalf("expected token to be valid when issued, got %v", err)
+	}
+
+	now = now.Add(svc.GetExpiration() + time.Second)
+	if _, err := svc.Validate(token); err != ErrTokenExpired {
+		t.Errorf("expected ErrTokenExpired once the clock passes expiry, got %v", err)
+	}
+}
+
 func TestValidate_WrongIssuer_ReturnsErrInvalidToken(t *testing.T) {
 	t.Parallel()
 	// Create two services with different issuers
@@ -0,0 +1,20 @@
+// Package mail sends plain-text email for the Saga API over SMTP.
+//
+// The sender covers what the API needs - short transactional messages such
+// as address verification links - and upgrades to TLS with STARTTLS when the
+// server offers it.
+//
+// # Creating a Sender
+//
+//	sender, err := mail.NewSender(mail.Config{
+//	    Host:     "smtp.example.com",
+//	    Port:     587,
+//	    Username: os.Getenv("SMTP_USERNAME"),
+//	    Password: os.Getenv("SMTP_PASSWORD"),
+//	    From:     "Saga <no-reply@saga.forgo.software>",
+//	})
+//
+// # Sending
+//
+//	err := sender.Send(ctx, "ada@example.com", "Confirm your new email", body)
+package mail
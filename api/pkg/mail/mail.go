@@ -0,0 +1,147 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors
+var (
+	ErrMissingHost    = errors.New("SMTP host is required")
+	ErrInvalidFrom    = errors.New("from address is invalid")
+	ErrInvalidAddress = errors.New("recipient address is invalid")
+)
+
+// Config holds SMTP sender configuration
+type Config struct {
+	Host     string
+	Port     int    // Default 587
+	Username string // Authentication is skipped when empty
+	Password string
+	From     string        // Address messages are sent from, optionally with a display name
+	Timeout  time.Duration // Default 10s
+}
+
+// Sender delivers plain-text email through an SMTP server
+type Sender struct {
+	addr    string
+	host    string
+	auth    smtp.Auth
+	from    *mail.Address
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// NewSender creates a new SMTP sender
+func NewSender(cfg Config) (*Sender, error) {
+	if cfg.Host == "" {
+		return nil, ErrMissingHost
+	}
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFrom, err)
+	}
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	s := &Sender{
+		addr:    net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		host:    cfg.Host,
+		from:    from,
+		timeout: cfg.Timeout,
+		now:     time.Now,
+	}
+	if cfg.Username != "" {
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return s, nil
+}
+
+// Send delivers a plain-text message to a single recipient
+func (s *Sender) Send(ctx context.Context, to, subject, body string) error {
+	rcpt, err := mail.ParseAddress(to)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+
+	deadline := s.now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("connect to SMTP server: %w", err)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP handshake: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("SMTP STARTTLS: %w", err)
+		}
+	}
+	if s.auth != nil {
+		if err := client.Auth(s.auth); err != nil {
+			return fmt.Errorf("SMTP auth: %w", err)
+		}
+	}
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM: %w", err)
+	}
+	if err := client.Rcpt(rcpt.Address); err != nil {
+		return fmt.Errorf("SMTP RCPT TO: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA: %w", err)
+	}
+	if _, err := w.Write(s.message(rcpt, subject, body)); err != nil {
+		w.Close()
+		return fmt.Errorf("write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("SMTP DATA: %w", err)
+	}
+	return client.Quit()
+}
+
+// message builds the RFC 5322 message with CRLF line endings
+func (s *Sender) message(to *mail.Address, subject, body string) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + s.from.String() + "\r\n")
+	b.WriteString("To: " + to.String() + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	b.WriteString("Date: " + s.now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,170 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTPServer accepts one connection, answers every command with
+// success and records the commands and message data it received
+type fakeSMTPServer struct {
+	listener net.Listener
+	commands []string
+	data     string
+	done     chan struct{}
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeSMTPServer{listener: l, done: make(chan struct{})}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimRight(line, "\r\n")
+		s.commands = append(s.commands, cmd)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			reply("250 localhost")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil || l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.data = data.String()
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+// ============================================================================
+// Send() Tests
+// ============================================================================
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeSMTPServer(t)
+	sender, err := NewSender(Config{Host: "127.0.0.1", Port: server.port(), From: "Saga <no-reply@saga.test>"})
+	if err != nil {
+		t.Fatalf("NewSender failed: %v", err)
+	}
+
+	err = sender.Send(context.Background(), "ada@example.com", "Confirm your new email", "Open this link:\nhttps://saga.test/verify")
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	<-server.done
+
+	want := []string{"MAIL FROM:<no-reply@saga.test>", "RCPT TO:<ada@example.com>"}
+	for _, w := range want {
+		found := false
+		for _, c := range server.commands {
+			if strings.HasPrefix(c, w) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected command %q, got %v", w, server.commands)
+		}
+	}
+	if !strings.Contains(server.data, "Subject: Confirm your new email\r\n") {
+		t.Errorf("expected subject header, got %q", server.data)
+	}
+	if !strings.Contains(server.data, "\r\n\r\nOpen this link:\r\nhttps://saga.test/verify\r\n") {
+		t.Errorf("expected CRLF body, got %q", server.data)
+	}
+}
+
+func TestSend_InvalidRecipient(t *testing.T) {
+	t.Parallel()
+
+	sender, _ := NewSender(Config{Host: "127.0.0.1", From: "no-reply@saga.test"})
+	err := sender.Send(context.Background(), "not an address", "Hi", "Body")
+	if !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("expected ErrInvalidAddress, got %v", err)
+	}
+}
+
+// ============================================================================
+// NewSender() Tests
+// ============================================================================
+
+func TestNewSender_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr error
+	}{
+		{"missing host", Config{From: "no-reply@saga.test"}, ErrMissingHost},
+		{"invalid from", Config{Host: "smtp.saga.test", From: "saga"}, ErrInvalidFrom},
+		{"valid", Config{Host: "smtp.saga.test", From: "no-reply@saga.test"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sender, err := NewSender(tt.cfg)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && sender.addr != "smtp.saga.test:"+strconv.Itoa(587) {
+				t.Errorf("expected default port 587, got %s", sender.addr)
+			}
+		})
+	}
+}
+
+func TestSend_HonorsContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	sender, _ := NewSender(Config{Host: "127.0.0.1", Port: 1, From: "no-reply@saga.test"})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := sender.Send(ctx, "ada@example.com", "Hi", "Body"); err == nil {
+		t.Error("expected an error when the server is unreachable")
+	}
+}
@@ -0,0 +1,39 @@
+// Package payments charges for event tickets through Stripe.
+//
+// The client covers what paid events need - hosted Checkout sessions,
+// refunds, and Connect Express accounts that organizers are paid out to -
+// over Stripe's form-encoded REST API. Charges are destination charges: the
+// guest pays the platform and the amount is transferred to the organizer's
+// connected account, so refunds reverse that transfer.
+//
+// # Sandbox
+//
+// With Sandbox set the client only accepts test-mode keys and test-mode
+// webhooks, so a staging deployment can't take real money by accident.
+// Without it, only live keys and live webhooks are accepted.
+//
+//	client, err := payments.NewClient(payments.Config{
+//	    SecretKey:     os.Getenv("STRIPE_SECRET_KEY"), // sk_test_...
+//	    WebhookSecret: os.Getenv("STRIPE_WEBHOOK_SECRET"),
+//	    Sandbox:       true,
+//	})
+//
+// # Taking a Payment
+//
+//	session, err := client.CreateCheckoutSession(ctx, payments.CheckoutParams{
+//	    Amount:      2500,
+//	    Currency:    "usd",
+//	    Name:        "Board game night",
+//	    SuccessURL:  "https://saga.example/events/1?checkout=success",
+//	    CancelURL:   "https://saga.example/events/1?checkout=cancelled",
+//	    Destination: "acct_123",
+//	})
+//	// Send the guest to session.URL
+//
+// # Webhooks
+//
+//	event, err := client.ParseWebhook(body, r.Header.Get("Stripe-Signature"), time.Now())
+//	if errors.Is(err, payments.ErrInvalidSignature) {
+//	    // Not from Stripe
+//	}
+package payments
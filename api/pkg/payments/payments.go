@@ -0,0 +1,288 @@
+package payments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the Stripe API
+const DefaultBaseURL = "https://api.stripe.com"
+
+// Errors
+var (
+	ErrMissingSecretKey = errors.New("secret key is required")
+	ErrKeyMode          = errors.New("secret key doesn't match the sandbox setting: sandbox needs a test key, live a live key")
+)
+
+// Error is an error response from the Stripe API
+type Error struct {
+	Status  int    // HTTP status
+	Type    string // e.g. card_error, invalid_request_error
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("stripe: %s (%s, status %d)", e.Message, e.Code, e.Status)
+	}
+	return fmt.Sprintf("stripe: %s (status %d)", e.Message, e.Status)
+}
+
+// Config holds Stripe client configuration
+type Config struct {
+	SecretKey     string // sk_test_/rk_test_ in sandbox, sk_live_/rk_live_ otherwise
+	WebhookSecret string // Signing secret of the webhook endpoint (whsec_...)
+	Sandbox       bool   // Test mode; live keys and live webhooks are refused
+	BaseURL       string // Default DefaultBaseURL
+	HTTPClient    *http.Client
+}
+
+// Client is a minimal Stripe client for paid event tickets
+type Client struct {
+	secretKey     string
+	webhookSecret string
+	sandbox       bool
+	baseURL       string
+	httpClient    *http.Client
+}
+
+// NewClient creates a new Stripe client
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.SecretKey == "" {
+		return nil, ErrMissingSecretKey
+	}
+	if IsTestKey(cfg.SecretKey) != cfg.Sandbox {
+		return nil, ErrKeyMode
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Client{
+		secretKey:     cfg.SecretKey,
+		webhookSecret: cfg.WebhookSecret,
+		sandbox:       cfg.Sandbox,
+		baseURL:       strings.TrimRight(cfg.BaseURL, "/"),
+		httpClient:    cfg.HTTPClient,
+	}, nil
+}
+
+// IsTestKey reports whether a Stripe API key is a test-mode key
+func IsTestKey(key string) bool {
+	return strings.HasPrefix(key, "sk_test_") || strings.HasPrefix(key, "rk_test_")
+}
+
+// Sandbox reports whether the client is in test mode
+func (c *Client) Sandbox() bool {
+	return c.sandbox
+}
+
+// ============================================================================
+// Checkout
+// ============================================================================
+
+// CheckoutParams describes a one-item Checkout session
+type CheckoutParams struct {
+	Amount            int64  // In the currency's minor unit
+	Currency          string // ISO 4217, lowercase
+	Name              string // Shown to the guest as the line item
+	SuccessURL        string // May contain {CHECKOUT_SESSION_ID}
+	CancelURL         string
+	ClientReferenceID string
+	Destination       string // Connected account the payment is transferred to
+	ExpiresAt         time.Time
+	Metadata          map[string]string // Copied to the session and its payment
+	IdempotencyKey    string
+}
+
+// CheckoutSession is a hosted payment page
+type CheckoutSession struct {
+	ID                string            `json:"id"`
+	URL               string            `json:"url"`
+	Status            string            `json:"status"`         // open, complete, expired
+	PaymentStatus     string            `json:"payment_status"` // paid, unpaid, no_payment_required
+	PaymentIntent     string            `json:"payment_intent"`
+	ClientReferenceID string            `json:"client_reference_id"`
+	AmountTotal       int64             `json:"amount_total"`
+	Currency          string            `json:"currency"`
+	ExpiresAt         int64             `json:"expires_at"` // Unix seconds
+	Metadata          map[string]string `json:"metadata"`
+}
+
+// CreateCheckoutSession starts a Checkout session for a single item
+func (c *Client) CreateCheckoutSession(ctx context.Context, p CheckoutParams) (*CheckoutSession, error) {
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", p.Currency)
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(p.Amount, 10))
+	form.Set("line_items[0][price_data][product_data][name]", p.Name)
+	form.Set("success_url", p.SuccessURL)
+	form.Set("cancel_url", p.CancelURL)
+	if p.ClientReferenceID != "" {
+		form.Set("client_reference_id", p.ClientReferenceID)
+	}
+	if p.Destination != "" {
+		form.Set("payment_intent_data[transfer_data][destination]", p.Destination)
+	}
+	if !p.ExpiresAt.IsZero() {
+		form.Set("expires_at", strconv.FormatInt(p.ExpiresAt.Unix(), 10))
+	}
+	for k, v := range p.Metadata {
+		form.Set("metadata["+k+"]", v)
+		form.Set("payment_intent_data[metadata]["+k+"]", v)
+	}
+
+	var session CheckoutSession
+	if err := c.do(ctx, http.MethodPost, "/v1/checkout/sessions", form, p.IdempotencyKey, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ============================================================================
+// Refunds
+// ============================================================================
+
+// RefundParams describes a refund of a payment
+type RefundParams struct {
+	PaymentIntent  string
+	Amount         int64 // Zero refunds the whole payment
+	IdempotencyKey string
+}
+
+// Refund is money returned to a guest
+type Refund struct {
+	ID     string `json:"id"`
+	Amount int64  `json:"amount"`
+	Status string `json:"status"` // pending, succeeded, failed, canceled
+}
+
+// Refund returns a payment to the guest and reverses its transfer to the
+// organizer
+func (c *Client) Refund(ctx context.Context, p RefundParams) (*Refund, error) {
+	form := url.Values{}
+	form.Set("payment_intent", p.PaymentIntent)
+	form.Set("reverse_transfer", "true")
+	if p.Amount > 0 {
+		form.Set("amount", strconv.FormatInt(p.Amount, 10))
+	}
+
+	var refund Refund
+	if err := c.do(ctx, http.MethodPost, "/v1/refunds", form, p.IdempotencyKey, &refund); err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+// ============================================================================
+// Connected Accounts
+// ============================================================================
+
+// Account is an organizer's Connect Express account
+type Account struct {
+	ID               string            `json:"id"`
+	ChargesEnabled   bool              `json:"charges_enabled"`
+	PayoutsEnabled   bool              `json:"payouts_enabled"`
+	DetailsSubmitted bool              `json:"details_submitted"`
+	Metadata         map[string]string `json:"metadata"`
+}
+
+// AccountLink is a single-use onboarding page for a connected account
+type AccountLink struct {
+	URL       string `json:"url"`
+	ExpiresAt int64  `json:"expires_at"` // Unix seconds
+}
+
+// CreateAccount creates a Connect Express account that can receive
+// transfers
+func (c *Client) CreateAccount(ctx context.Context, metadata map[string]string, idempotencyKey string) (*Account, error) {
+	form := url.Values{}
+	form.Set("type", "express")
+	form.Set("capabilities[card_payments][requested]", "true")
+	form.Set("capabilities[transfers][requested]", "true")
+	for k, v := range metadata {
+		form.Set("metadata["+k+"]", v)
+	}
+
+	var account Account
+	if err := c.do(ctx, http.MethodPost, "/v1/accounts", form, idempotencyKey, &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// GetAccount fetches a connected account
+func (c *Client) GetAccount(ctx context.Context, accountID string) (*Account, error) {
+	var account Account
+	if err := c.do(ctx, http.MethodGet, "/v1/accounts/"+url.PathEscape(accountID), nil, "", &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// CreateAccountLink returns an onboarding page for a connected account.
+// refreshURL is visited if the link expires; returnURL when the organizer
+// finishes or leaves.
+func (c *Client) CreateAccountLink(ctx context.Context, accountID, refreshURL, returnURL string) (*AccountLink, error) {
+	form := url.Values{}
+	form.Set("account", accountID)
+	form.Set("refresh_url", refreshURL)
+	form.Set("return_url", returnURL)
+	form.Set("type", "account_onboarding")
+
+	var link AccountLink
+	if err := c.do(ctx, http.MethodPost, "/v1/account_links", form, "", &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// do sends a request and decodes the JSON response into out
+func (c *Client) do(ctx context.Context, method, path string, form url.Values, idempotencyKey string, out interface{}) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.secretKey)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var e struct {
+			Error struct {
+				Type    string `json:"type"`
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&e)
+		return &Error{Status: resp.StatusCode, Type: e.Error.Type, Code: e.Error.Code, Message: e.Error.Message}
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,151 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// NewClient() Tests
+// ============================================================================
+
+func TestNewClient_KeyMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		key     string
+		sandbox bool
+		want    error
+	}{
+		{"sk_test_abc", true, nil},
+		{"rk_test_abc", true, nil},
+		{"sk_live_abc", false, nil},
+		{"sk_live_abc", true, ErrKeyMode},
+		{"sk_test_abc", false, ErrKeyMode},
+		{"", true, ErrMissingSecretKey},
+	}
+	for _, tt := range tests {
+		if _, err := NewClient(Config{SecretKey: tt.key, Sandbox: tt.sandbox}); !errors.Is(err, tt.want) {
+			t.Errorf("key %q, sandbox %v: expected %v, got %v", tt.key, tt.sandbox, tt.want, err)
+		}
+	}
+}
+
+// ============================================================================
+// CreateCheckoutSession() Tests
+// ============================================================================
+
+func TestCreateCheckoutSession(t *testing.T) {
+	t.Parallel()
+
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		got = r
+		fmt.Fprint(w, `{"id":"cs_test_1","url":"https://checkout.stripe.com/c/pay/cs_test_1","status":"open","expires_at":1780000000}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{SecretKey: "sk_test_abc", Sandbox: true, BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	session, err := client.CreateCheckoutSession(context.Background(), CheckoutParams{
+		Amount:            2500,
+		Currency:          "usd",
+		Name:              "Game night",
+		SuccessURL:        "https://saga.example/ok",
+		CancelURL:         "https://saga.example/cancel",
+		ClientReferenceID: "event_payment:1",
+		Destination:       "acct_1",
+		ExpiresAt:         time.Unix(1780000000, 0),
+		Metadata:          map[string]string{"event_id": "event:1"},
+		IdempotencyKey:    "event_payment:1",
+	})
+	if err != nil {
+		t.Fatalf("CreateCheckoutSession failed: %v", err)
+	}
+	if session.ID != "cs_test_1" || session.URL == "" {
+		t.Errorf("unexpected session %+v", session)
+	}
+
+	if got.URL.Path != "/v1/checkout/sessions" || got.Header.Get("Authorization") != "Bearer sk_test_abc" {
+		t.Errorf("unexpected request %s %v", got.URL.Path, got.Header)
+	}
+	if got.Header.Get("Idempotency-Key") != "event_payment:1" {
+		t.Errorf("expected the idempotency key sent, got %q", got.Header.Get("Idempotency-Key"))
+	}
+	for field, want := range map[string]string{
+		"mode":                                            "payment",
+		"line_items[0][price_data][unit_amount]":          "2500",
+		"line_items[0][price_data][currency]":             "usd",
+		"payment_intent_data[transfer_data][destination]": "acct_1",
+		"client_reference_id":                             "event_payment:1",
+		"expires_at":                                      "1780000000",
+		"metadata[event_id]":                              "event:1",
+		"payment_intent_data[metadata][event_id]":         "event:1",
+	} {
+		if v := got.PostForm.Get(field); v != want {
+			t.Errorf("%s: expected %q, got %q", field, want, v)
+		}
+	}
+}
+
+func TestCreateCheckoutSession_Error(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":{"type":"invalid_request_error","code":"parameter_invalid_integer","message":"Invalid integer"}}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{SecretKey: "sk_test_abc", Sandbox: true, BaseURL: server.URL})
+
+	_, err := client.CreateCheckoutSession(context.Background(), CheckoutParams{Amount: -1, Currency: "usd"})
+	var stripeErr *Error
+	if !errors.As(err, &stripeErr) {
+		t.Fatalf("expected a Stripe error, got %v", err)
+	}
+	if stripeErr.Status != http.StatusBadRequest || stripeErr.Code != "parameter_invalid_integer" {
+		t.Errorf("unexpected error %+v", stripeErr)
+	}
+}
+
+// ============================================================================
+// Refund() Tests
+// ============================================================================
+
+func TestRefund_ReversesTransfer(t *testing.T) {
+	t.Parallel()
+
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		got = r
+		fmt.Fprint(w, `{"id":"re_1","amount":2500,"status":"succeeded"}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{SecretKey: "sk_test_abc", Sandbox: true, BaseURL: server.URL})
+
+	refund, err := client.Refund(context.Background(), RefundParams{PaymentIntent: "pi_1"})
+	if err != nil {
+		t.Fatalf("Refund failed: %v", err)
+	}
+	if refund.ID != "re_1" || refund.Status != "succeeded" {
+		t.Errorf("unexpected refund %+v", refund)
+	}
+	if got.PostForm.Get("payment_intent") != "pi_1" || got.PostForm.Get("reverse_transfer") != "true" {
+		t.Errorf("unexpected form %v", got.PostForm)
+	}
+	if got.PostForm.Has("amount") {
+		t.Error("expected a full refund to send no amount")
+	}
+}
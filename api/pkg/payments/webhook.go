@@ -0,0 +1,126 @@
+package payments
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookTolerance is how old a webhook's signature timestamp may be
+const WebhookTolerance = 5 * time.Minute
+
+// Webhook errors
+var (
+	ErrInvalidSignature = errors.New("webhook signature is invalid")
+	ErrStaleWebhook     = errors.New("webhook timestamp is outside the tolerance")
+	ErrWebhookMode      = errors.New("webhook is from the other mode: live in sandbox, or test outside it")
+)
+
+// Webhook event types paid events handle
+const (
+	EventCheckoutCompleted             = "checkout.session.completed"
+	EventCheckoutAsyncPaymentSucceeded = "checkout.session.async_payment_succeeded"
+	EventCheckoutAsyncPaymentFailed    = "checkout.session.async_payment_failed"
+	EventCheckoutExpired               = "checkout.session.expired"
+	EventAccountUpdated                = "account.updated"
+)
+
+// WebhookEvent is a verified Stripe event
+type WebhookEvent struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Livemode bool   `json:"livemode"`
+	Data     struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// CheckoutSession decodes the event's object as a Checkout session
+func (e *WebhookEvent) CheckoutSession() (*CheckoutSession, error) {
+	var session CheckoutSession
+	if err := json.Unmarshal(e.Data.Object, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Account decodes the event's object as a connected account
+func (e *WebhookEvent) Account() (*Account, error) {
+	var account Account
+	if err := json.Unmarshal(e.Data.Object, &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// ParseWebhook verifies a webhook's Stripe-Signature header against the
+// webhook secret and decodes it. Events from the other mode are refused.
+func (c *Client) ParseWebhook(payload []byte, signature string, now time.Time) (*WebhookEvent, error) {
+	if err := verifySignature(payload, signature, c.webhookSecret, now); err != nil {
+		return nil, err
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	if event.Livemode == c.sandbox {
+		return nil, ErrWebhookMode
+	}
+	return &event, nil
+}
+
+// verifySignature checks a "t=<unix>,v1=<hex>" header: v1 is the
+// HMAC-SHA256 of "<t>.<payload>" keyed with the secret. Any v1 may match,
+// since Stripe signs with each active secret while one is rolled.
+func verifySignature(payload []byte, header, secret string, now time.Time) error {
+	if secret == "" {
+		return ErrInvalidSignature
+	}
+
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			timestamp = v
+		case "v1":
+			signatures = append(signatures, v)
+		}
+	}
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	valid := false
+	for _, sig := range signatures {
+		got, err := hex.DecodeString(sig)
+		if err == nil && hmac.Equal(got, expected) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	if age := now.Sub(time.Unix(unix, 0)); age > WebhookTolerance || age < -WebhookTolerance {
+		return ErrStaleWebhook
+	}
+	return nil
+}
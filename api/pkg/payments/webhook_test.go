@@ -0,0 +1,87 @@
+package payments
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const testWebhookSecret = "whsec_test"
+
+// sign builds a Stripe-Signature header for payload at t
+func sign(payload []byte, secret string, t time.Time) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(payload)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ============================================================================
+// ParseWebhook() Tests
+// ============================================================================
+
+func TestParseWebhook(t *testing.T) {
+	t.Parallel()
+
+	client, _ := NewClient(Config{SecretKey: "sk_test_abc", WebhookSecret: testWebhookSecret, Sandbox: true})
+	now := time.Unix(1780000000, 0)
+	payload := []byte(`{"id":"evt_1","type":"checkout.session.completed","livemode":false,"data":{"object":{"id":"cs_1","payment_status":"paid","client_reference_id":"event_payment:1"}}}`)
+
+	event, err := client.ParseWebhook(payload, sign(payload, testWebhookSecret, now), now)
+	if err != nil {
+		t.Fatalf("ParseWebhook failed: %v", err)
+	}
+	if event.Type != EventCheckoutCompleted {
+		t.Errorf("expected %s, got %s", EventCheckoutCompleted, event.Type)
+	}
+	session, err := event.CheckoutSession()
+	if err != nil || session.ClientReferenceID != "event_payment:1" || session.PaymentStatus != "paid" {
+		t.Errorf("unexpected session %+v, %v", session, err)
+	}
+}
+
+func TestParseWebhook_Rejects(t *testing.T) {
+	t.Parallel()
+
+	client, _ := NewClient(Config{SecretKey: "sk_test_abc", WebhookSecret: testWebhookSecret, Sandbox: true})
+	now := time.Unix(1780000000, 0)
+	payload := []byte(`{"id":"evt_1","type":"checkout.session.completed","livemode":false}`)
+	live := []byte(`{"id":"evt_2","type":"checkout.session.completed","livemode":true}`)
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		want      error
+	}{
+		{"missing", payload, "", ErrInvalidSignature},
+		{"wrong secret", payload, sign(payload, "whsec_other", now), ErrInvalidSignature},
+		{"tampered", []byte(`{"id":"evt_1","type":"account.updated","livemode":false}`), sign(payload, testWebhookSecret, now), ErrInvalidSignature},
+		{"stale", payload, sign(payload, testWebhookSecret, now.Add(-WebhookTolerance-time.Second)), ErrStaleWebhook},
+		{"live in sandbox", live, sign(live, testWebhookSecret, now), ErrWebhookMode},
+	}
+	for _, tt := range tests {
+		if _, err := client.ParseWebhook(tt.payload, tt.signature, now); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestParseWebhook_AnySignatureMatches(t *testing.T) {
+	t.Parallel()
+
+	client, _ := NewClient(Config{SecretKey: "sk_test_abc", WebhookSecret: testWebhookSecret, Sandbox: true})
+	now := time.Unix(1780000000, 0)
+	payload := []byte(`{"id":"evt_1","type":"account.updated","livemode":false}`)
+
+	// While a secret is rolled, Stripe signs with the old and new secrets
+	header := sign(payload, "whsec_old", now) + ",v1=" + sign(payload, testWebhookSecret, now)[len("t=1780000000,v1="):]
+	if _, err := client.ParseWebhook(payload, header, now); err != nil {
+		t.Errorf("expected the second signature to verify, got %v", err)
+	}
+}
@@ -0,0 +1,19 @@
+// Package pwned checks passwords against the Have I Been Pwned breach corpus.
+//
+// Lookups use the k-anonymity range API: only the first five characters of
+// the password's SHA-1 hash leave the process, and the matching suffix is
+// found locally among the hundreds returned. Responses are padded so their
+// size doesn't reveal the prefix either.
+//
+// # Checking a Password
+//
+//	client := pwned.NewClient(pwned.Config{})
+//
+//	count, err := client.Count(ctx, password)
+//	if err != nil {
+//	    // The service is unreachable; decide whether to fail open
+//	}
+//	if count > 0 {
+//	    // Seen count times in known breaches
+//	}
+package pwned
@@ -0,0 +1,92 @@
+package pwned
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the Pwned Passwords range API
+const DefaultBaseURL = "https://api.pwnedpasswords.com"
+
+// prefixLength is how many hex characters of the hash are sent
+const prefixLength = 5
+
+// Config holds Pwned Passwords client configuration
+type Config struct {
+	BaseURL    string // Default DefaultBaseURL
+	UserAgent  string // Default "saga-api"
+	HTTPClient *http.Client
+}
+
+// Client looks up passwords in the Pwned Passwords corpus
+type Client struct {
+	baseURL    string
+	userAgent  string
+	httpClient *http.Client
+}
+
+// NewClient creates a new Pwned Passwords client
+func NewClient(cfg Config) *Client {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = "saga-api"
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 3 * time.Second}
+	}
+	return &Client{
+		baseURL:    strings.TrimRight(cfg.BaseURL, "/"),
+		userAgent:  cfg.UserAgent,
+		httpClient: cfg.HTTPClient,
+	}
+}
+
+// Count returns how many times the password appears in known breaches, or
+// zero if it doesn't
+func (c *Client) Count(ctx context.Context, password string) (int, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:prefixLength], hash[prefixLength:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/range/"+prefix, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("pwned passwords: unexpected status %d", resp.StatusCode)
+	}
+
+	// Each line is SUFFIX:COUNT; padding entries have a count of zero
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		hashSuffix, count, ok := strings.Cut(line, ":")
+		if !ok || !strings.EqualFold(hashSuffix, suffix) {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, fmt.Errorf("pwned passwords: invalid count %q", count)
+		}
+		return n, nil
+	}
+	return 0, scanner.Err()
+}
@@ -0,0 +1,62 @@
+package pwned
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// "password" hashes to 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+
+// ============================================================================
+// Count() Tests
+// ============================================================================
+
+func TestCount(t *testing.T) {
+	t.Parallel()
+
+	var gotPath, gotPadding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotPadding = r.URL.Path, r.Header.Get("Add-Padding")
+		fmt.Fprint(w, "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n")
+		fmt.Fprint(w, "1E4C9B93F3F0682250B6CF8331B7EE68FD8:9659365\r\n")
+		fmt.Fprint(w, "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF:0\r\n")
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+
+	count, err := client.Count(context.Background(), "password")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 9659365 {
+		t.Errorf("expected 9659365, got %d", count)
+	}
+	if gotPath != "/range/5BAA6" {
+		t.Errorf("expected only the hash prefix to be sent, got %s", gotPath)
+	}
+	if gotPadding != "true" {
+		t.Error("expected padded responses to be requested")
+	}
+
+	count, err = client.Count(context.Background(), "a much less common passphrase")
+	if err != nil || count != 0 {
+		t.Errorf("expected unseen password to count 0, got %d (%v)", count, err)
+	}
+}
+
+func TestCount_ServiceError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(Config{BaseURL: server.URL}).Count(context.Background(), "password"); err == nil {
+		t.Error("expected an error for a failed lookup")
+	}
+}
@@ -0,0 +1,24 @@
+// Package redact masks personal data before it reaches logs and error
+// reports for the Saga API.
+//
+// Emails, credentials (auth headers, JWTs and long opaque tokens),
+// latitude/longitude pairs and phone numbers are found in free text and
+// replaced with markers such as [email] and [token]. Fields and query
+// parameters with sensitive names (password, token, lat, phone, ...) are
+// masked whatever their value looks like. Request IDs (UUIDs) are kept.
+//
+// # Logging
+//
+// Wrap the root handler once; every slog call, and the log package once the
+// logger is the default, is masked:
+//
+//	logger := slog.New(redact.NewHandler(slog.NewJSONHandler(os.Stdout, nil)))
+//	slog.SetDefault(logger)
+//
+//	slog.Info("invite sent", "to", "ana@example.com") // "to":"[email]"
+//
+// # Query Strings and Free Text
+//
+//	redact.Query("email=ana%40example.com&page=2") // "email=[redacted]&page=2"
+//	redact.String("login failed for ana@example.com") // "login failed for [email]"
+package redact
@@ -0,0 +1,193 @@
+package redact
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Markers replace what was masked, so logs still show what kind of value
+// was there
+const (
+	Email    = "[email]"
+	Token    = "[token]"
+	Phone    = "[phone]"
+	Coords   = "[coords]"
+	Redacted = "[redacted]"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	// Credentials after an auth scheme, e.g. in a logged header
+	schemePattern = regexp.MustCompile(`(?i)\b(Bearer|DPoP|Basic)\s+[A-Za-z0-9._~+/=-]+`)
+	jwtPattern    = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	// Long opaque strings such as refresh, reset and share tokens
+	opaquePattern = regexp.MustCompile(`\b[A-Za-z0-9_-]{32,}\b`)
+	uuidPattern   = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	// A latitude, longitude pair with street-level precision or better
+	coordsPattern = regexp.MustCompile(`-?\b\d{1,2}\.\d{3,},\s*-?\d{1,3}\.\d{3,}\b`)
+	// International numbers, and North American numbers with separators
+	phonePattern = regexp.MustCompile(`\+\d[\d\s().-]{6,}\d|\(?\b\d{3}\)?[\s.-]\d{3}[\s.-]\d{4}\b`)
+)
+
+// sensitiveKeys are field and query parameter names whose values are masked
+// whatever they look like
+var sensitiveKeys = map[string]bool{
+	"email":         true,
+	"password":      true,
+	"new_password":  true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"authorization": true,
+	"cookie":        true,
+	"secret":        true,
+	"client_secret": true,
+	"api_key":       true,
+	"dpop":          true,
+	"phone":         true,
+	"phone_number":  true,
+	"lat":           true,
+	"lng":           true,
+	"latitude":      true,
+	"longitude":     true,
+}
+
+// IsSensitiveKey returns true if values under a field or parameter name are
+// always masked. Names are matched case-insensitively, with '-' as '_'.
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeys[strings.ReplaceAll(strings.ToLower(key), "-", "_")]
+}
+
+// String masks emails, tokens, coordinates and phone numbers in free text
+func String(s string) string {
+	if s == "" {
+		return s
+	}
+	s = schemePattern.ReplaceAllString(s, "$1 "+Token)
+	s = jwtPattern.ReplaceAllString(s, Token)
+	s = emailPattern.ReplaceAllString(s, Email)
+	s = opaquePattern.ReplaceAllStringFunc(s, func(m string) string {
+		if uuidPattern.MatchString(m) || !hasDigit(m) {
+			return m
+		}
+		return Token
+	})
+	s = coordsPattern.ReplaceAllString(s, Coords)
+	s = phonePattern.ReplaceAllString(s, Phone)
+	return s
+}
+
+// Query masks a raw URL query: values of sensitive parameters entirely,
+// others as free text. Parameter order is kept.
+func Query(rawQuery string) string {
+	if rawQuery == "" {
+		return rawQuery
+	}
+	params := strings.Split(rawQuery, "&")
+	for i, param := range params {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			continue
+		}
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			name = key
+		}
+		if IsSensitiveKey(name) {
+			params[i] = key + "=" + Redacted
+			continue
+		}
+		decoded, err := url.QueryUnescape(value)
+		if err != nil {
+			decoded = value
+		}
+		if masked := String(decoded); masked != decoded {
+			params[i] = key + "=" + masked
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// Attr masks an attribute's value by its key and content. Groups are masked
+// recursively; values a handler can't inspect are rendered as text first.
+func Attr(a slog.Attr) slog.Attr {
+	v := a.Value.Resolve()
+	if IsSensitiveKey(a.Key) && v.Kind() != slog.KindGroup {
+		return slog.String(a.Key, Redacted)
+	}
+
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, String(v.String()))
+	case slog.KindGroup:
+		attrs := v.Group()
+		masked := make([]slog.Attr, len(attrs))
+		for i, attr := range attrs {
+			masked[i] = Attr(attr)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(masked...)}
+	case slog.KindAny:
+		switch x := v.Any().(type) {
+		case error:
+			return slog.String(a.Key, String(x.Error()))
+		case fmt.Stringer:
+			return slog.String(a.Key, String(x.String()))
+		case []byte:
+			return slog.String(a.Key, String(string(x)))
+		default:
+			return slog.String(a.Key, String(fmt.Sprintf("%+v", x)))
+		}
+	default:
+		// Numbers, bools, times and durations carry no free text
+		return slog.Attr{Key: a.Key, Value: v}
+	}
+}
+
+// Handler masks personal data in log records before passing them on. Set it
+// as the default handler and the log package's output is masked too.
+type Handler struct {
+	next slog.Handler
+}
+
+// NewHandler wraps a handler so its records are masked
+func NewHandler(next slog.Handler) *Handler {
+	return &Handler{next: next}
+}
+
+// Enabled reports whether the wrapped handler handles records at the level
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle masks the record's message and attributes
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	masked := slog.NewRecord(r.Time, r.Level, String(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		masked.AddAttrs(Attr(a))
+		return true
+	})
+	return h.next.Handle(ctx, masked)
+}
+
+// WithAttrs masks attributes once, when they are attached
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	masked := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		masked[i] = Attr(a)
+	}
+	return &Handler{next: h.next.WithAttrs(masked)}
+}
+
+// WithGroup returns a handler whose attributes are nested in a group
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{next: h.next.WithGroup(name)}
+}
+
+func hasDigit(s string) bool {
+	return strings.ContainsAny(s, "0123456789")
+}
@@ -0,0 +1,31 @@
+// Package s3 provides a minimal Amazon S3 client for the Saga API.
+//
+// The client covers what the API needs from object storage - uploading,
+// deleting and handing out time-limited download links - and signs requests
+// with AWS Signature Version 4. It works with any S3-compatible store.
+//
+// # Creating a Client
+//
+//	client, err := s3.NewClient(s3.Config{
+//	    Bucket:          "saga-reports",
+//	    Region:          "us-east-1",
+//	    AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+//	    SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+//	})
+//
+// For MinIO or another S3-compatible store, set Endpoint and usually
+// PathStyle:
+//
+//	client, err := s3.NewClient(s3.Config{
+//	    Bucket:    "saga-reports",
+//	    Endpoint:  "http://localhost:9000",
+//	    PathStyle: true,
+//	    ...
+//	})
+//
+// # Objects
+//
+//	err := client.Put(ctx, "reports/users.csv", "text/csv", file, size)
+//	url, err := client.PresignGet("reports/users.csv", 15*time.Minute)
+//	err = client.Delete(ctx, "reports/users.csv")
+package s3
@@ -0,0 +1,287 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors
+var (
+	ErrMissingBucket      = errors.New("bucket is required")
+	ErrMissingCredentials = errors.New("access key ID and secret access key are required")
+	ErrInvalidExpiry      = errors.New("presigned URL expiry must be between 1 second and 7 days")
+)
+
+// MaxPresignExpiry is the longest a presigned URL may be valid for
+const MaxPresignExpiry = 7 * 24 * time.Hour
+
+const (
+	algorithm       = "AWS4-HMAC-SHA256"
+	service         = "s3"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	amzDateFormat   = "20060102T150405Z"
+	shortDateFormat = "20060102"
+)
+
+// Config holds S3 client configuration
+type Config struct {
+	Bucket          string
+	Region          string // Default us-east-1
+	Endpoint        string // Default https://s3.<region>.amazonaws.com; set for S3-compatible stores
+	AccessKeyID     string
+	SecretAccessKey string
+	PathStyle       bool // Address the bucket in the path rather than the host name
+	HTTPClient      *http.Client
+}
+
+// Client is a minimal S3 client: put, delete and presigned GET URLs, signed
+// with AWS Signature Version 4
+type Client struct {
+	bucket     string
+	region     string
+	endpoint   *url.URL
+	accessKey  string
+	secretKey  string
+	pathStyle  bool
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewClient creates a new S3 client
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.Bucket == "" {
+		return nil, ErrMissingBucket
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, ErrMissingCredentials
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = "https://s3." + cfg.Region + ".amazonaws.com"
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 5 * time.Minute}
+	}
+
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q", cfg.Endpoint)
+	}
+
+	return &Client{
+		bucket:     cfg.Bucket,
+		region:     cfg.Region,
+		endpoint:   endpoint,
+		accessKey:  cfg.AccessKeyID,
+		secretKey:  cfg.SecretAccessKey,
+		pathStyle:  cfg.PathStyle,
+		httpClient: cfg.HTTPClient,
+		now:        time.Now,
+	}, nil
+}
+
+// Put uploads an object. The payload is sent unsigned, so use an https
+// endpoint outside development.
+func (c *Client) Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.objectURL(key).String(), body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return c.do(req)
+}
+
+// Delete removes an object. Deleting a missing object succeeds.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req)
+}
+
+// PresignGet returns a URL anyone can download the object from until it
+// expires
+func (c *Client) PresignGet(key string, expires time.Duration) (string, error) {
+	if expires < time.Second || expires > MaxPresignExpiry {
+		return "", ErrInvalidExpiry
+	}
+
+	now := c.now().UTC()
+	u := c.objectURL(key)
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", algorithm)
+	query.Set("X-Amz-Credential", c.accessKey+"/"+c.scope(now))
+	query.Set("X-Amz-Date", now.Format(amzDateFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	query.Set("X-Amz-Signature", c.signature(now, canonicalRequest))
+	u.RawQuery = canonicalQuery(query)
+	return u.String(), nil
+}
+
+// do signs and sends a request, treating any non-2xx response as an error
+func (c *Client) do(req *http.Request) error {
+	c.sign(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 %s %s: %s: %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// sign adds an Authorization header to the request
+func (c *Client) sign(req *http.Request) {
+	now := c.now().UTC()
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": unsignedPayload,
+		"x-amz-date":           now.Format(amzDateFormat),
+	}
+	if ct := req.Header.Get("Content-Type"); ct != "" {
+		headers["content-type"] = ct
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, c.accessKey, c.scope(now), signedHeaders, c.signature(now, canonicalRequest)))
+}
+
+// signature signs a canonical request
+func (c *Client) signature(now time.Time, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		algorithm,
+		now.Format(amzDateFormat),
+		c.scope(now),
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.secretKey), now.Format(shortDateFormat))
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func (c *Client) scope(now time.Time) string {
+	return now.Format(shortDateFormat) + "/" + c.region + "/" + service + "/aws4_request"
+}
+
+// objectURL addresses a key in the bucket
+func (c *Client) objectURL(key string) *url.URL {
+	u := *c.endpoint
+	path := "/" + strings.TrimPrefix(key, "/")
+	if c.pathStyle {
+		path = "/" + c.bucket + path
+	} else {
+		u.Host = c.bucket + "." + u.Host
+	}
+	u.Path = path
+	u.RawPath = escapePath(path)
+	return &u
+}
+
+// escapePath URI-encodes each path segment as SigV4 requires
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// canonicalQuery encodes query parameters sorted by name
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range values[key] {
+			pairs = append(pairs, escape(key)+"="+escape(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escape percent-encodes everything but RFC 3986 unreserved characters
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ('A' <= ch && ch <= 'Z') || ('a' <= ch && ch <= 'z') || ('0' <= ch && ch <= '9') ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' {
+			b.WriteByte(ch)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
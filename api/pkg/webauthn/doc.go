@@ -0,0 +1,33 @@
+// Package webauthn verifies WebAuthn registrations and assertions (passkeys)
+// for the Saga API.
+//
+// Registration checks the authenticator's response to a create() ceremony
+// and extracts the credential's public key, stored as a COSE key. Assertion
+// checks a get() ceremony against that key: the client data's type and
+// origin, the RP ID hash, the user presence and verification flags, and the
+// signature over the authenticator data and client data hash.
+//
+// Challenges and sign counts are the caller's to check: the client data's
+// challenge is returned for the caller to match against the one it issued,
+// and the authenticator's sign count is returned for the caller to compare
+// with the stored one.
+//
+// Only ES256 and RS256 keys are accepted. Attestation statements are not
+// verified, so registrations are trusted as much as "none" attestation.
+//
+// # Verifying an Assertion
+//
+//	rp := webauthn.RelyingParty{ID: "saga.forgo.software", Origins: []string{"https://saga.forgo.software"}}
+//
+//	clientData, err := webauthn.ParseClientData(clientDataJSON)
+//	if err != nil || !challenges.Consume(clientData.Challenge) {
+//	    // Malformed, or answers a challenge that wasn't issued
+//	}
+//	authData, err := rp.VerifyAssertion(clientDataJSON, authenticatorData, signature, storedPublicKey)
+//	if err != nil {
+//	    // Wrong origin or RP, user not present, or a bad signature
+//	}
+//	if webauthn.SignCountRegressed(storedSignCount, authData.SignCount) {
+//	    // Possibly a cloned authenticator
+//	}
+package webauthn
@@ -0,0 +1,308 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"slices"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Ceremony types named in client data
+const (
+	TypeCreate = "webauthn.create"
+	TypeGet    = "webauthn.get"
+)
+
+// Authenticator data flags
+const (
+	flagUserPresent      = 0x01
+	flagUserVerified     = 0x04
+	flagAttestedCredData = 0x40
+)
+
+// COSE key parameters (RFC 9053)
+const (
+	coseKtyEC2   = 2
+	coseKtyRSA   = 3
+	coseAlgES256 = -7
+	coseAlgRS256 = -257
+	coseCrvP256  = 1
+)
+
+// minAuthDataLength is the RP ID hash, flags and sign count
+const minAuthDataLength = 37
+
+var (
+	ErrInvalidClientData  = errors.New("webauthn client data is invalid")
+	ErrWrongType          = errors.New("webauthn client data is for a different ceremony")
+	ErrOriginNotAllowed   = errors.New("webauthn origin is not allowed")
+	ErrInvalidAuthData    = errors.New("webauthn authenticator data is invalid")
+	ErrRPIDMismatch       = errors.New("webauthn authenticator data is for a different relying party")
+	ErrUserNotPresent     = errors.New("webauthn user presence was not confirmed")
+	ErrUserNotVerified    = errors.New("webauthn user verification is required")
+	ErrInvalidAttestation = errors.New("webauthn attestation object is invalid")
+	ErrUnsupportedKey     = errors.New("webauthn credential key is not an ES256 or RS256 key")
+	ErrInvalidSignature   = errors.New("webauthn signature is invalid")
+)
+
+// RelyingParty is the site credentials are scoped to
+type RelyingParty struct {
+	ID                      string   // RP ID, e.g. "saga.forgo.software"
+	Origins                 []string // Origins ceremonies may come from
+	RequireUserVerification bool
+}
+
+// ClientData is the client data collected by the browser or platform
+type ClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"` // base64url, as issued
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin,omitempty"`
+}
+
+// AuthenticatorData is the authenticator's signed view of a ceremony
+type AuthenticatorData struct {
+	RPIDHash  []byte
+	Flags     byte
+	SignCount uint32
+	// Set on registration only
+	CredentialID []byte
+	PublicKey    []byte // COSE key
+}
+
+// UserPresent reports whether the user touched the authenticator
+func (a *AuthenticatorData) UserPresent() bool { return a.Flags&flagUserPresent != 0 }
+
+// UserVerified reports whether the authenticator verified the user, e.g. by biometrics
+func (a *AuthenticatorData) UserVerified() bool { return a.Flags&flagUserVerified != 0 }
+
+// ParseClientData decodes clientDataJSON
+func ParseClientData(raw []byte) (*ClientData, error) {
+	var data ClientData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, ErrInvalidClientData
+	}
+	if data.Type == "" || data.Challenge == "" || data.Origin == "" {
+		return nil, ErrInvalidClientData
+	}
+	return &data, nil
+}
+
+// ParseAuthenticatorData decodes authenticator data, including the attested
+// credential when present
+func ParseAuthenticatorData(raw []byte) (*AuthenticatorData, error) {
+	if len(raw) < minAuthDataLength {
+		return nil, ErrInvalidAuthData
+	}
+	data := &AuthenticatorData{
+		RPIDHash:  raw[:32],
+		Flags:     raw[32],
+		SignCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+	if data.Flags&flagAttestedCredData == 0 {
+		return data, nil
+	}
+
+	// AAGUID (16 bytes), credential ID length (2 bytes), credential ID, COSE key
+	rest := raw[minAuthDataLength:]
+	if len(rest) < 18 {
+		return nil, ErrInvalidAuthData
+	}
+	idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if len(rest) < idLength {
+		return nil, ErrInvalidAuthData
+	}
+	data.CredentialID = rest[:idLength]
+
+	var key cbor.RawMessage
+	if _, err := cbor.UnmarshalFirst(rest[idLength:], &key); err != nil {
+		return nil, ErrInvalidAuthData
+	}
+	data.PublicKey = key
+	return data, nil
+}
+
+// checkClientData checks the ceremony type and origin
+func (rp RelyingParty) checkClientData(raw []byte, ceremony string) (*ClientData, error) {
+	data, err := ParseClientData(raw)
+	if err != nil {
+		return nil, err
+	}
+	if data.Type != ceremony {
+		return nil, ErrWrongType
+	}
+	if !slices.Contains(rp.Origins, data.Origin) {
+		return nil, ErrOriginNotAllowed
+	}
+	return data, nil
+}
+
+// checkAuthData checks the RP ID hash and user flags
+func (rp RelyingParty) checkAuthData(data *AuthenticatorData) error {
+	expected := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(data.RPIDHash, expected[:]) != 1 {
+		return ErrRPIDMismatch
+	}
+	if !data.UserPresent() {
+		return ErrUserNotPresent
+	}
+	if rp.RequireUserVerification && !data.UserVerified() {
+		return ErrUserNotVerified
+	}
+	return nil
+}
+
+// attestationObject is the CBOR-encoded result of a create() ceremony
+type attestationObject struct {
+	Fmt      string          `cbor:"fmt"`
+	AttStmt  cbor.RawMessage `cbor:"attStmt"`
+	AuthData []byte          `cbor:"authData"`
+}
+
+// VerifyRegistration checks a create() response and returns the new
+// credential's ID, public key and initial sign count. The client data's
+// challenge must be checked by the caller.
+func (rp RelyingParty) VerifyRegistration(clientDataJSON, attestation []byte) (*AuthenticatorData, error) {
+	if _, err := rp.checkClientData(clientDataJSON, TypeCreate); err != nil {
+		return nil, err
+	}
+
+	var obj attestationObject
+	if err := cbor.Unmarshal(attestation, &obj); err != nil || obj.Fmt == "" {
+		return nil, ErrInvalidAttestation
+	}
+	data, err := ParseAuthenticatorData(obj.AuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.checkAuthData(data); err != nil {
+		return nil, err
+	}
+	if len(data.CredentialID) == 0 || len(data.PublicKey) == 0 {
+		return nil, ErrInvalidAttestation
+	}
+	if _, err := ParsePublicKey(data.PublicKey); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// VerifyAssertion checks a get() response against the credential's stored
+// COSE public key and returns the authenticator data. The client data's
+// challenge and the sign count must be checked by the caller.
+func (rp RelyingParty) VerifyAssertion(clientDataJSON, authenticatorData, signature, publicKey []byte) (*AuthenticatorData, error) {
+	if _, err := rp.checkClientData(clientDataJSON, TypeGet); err != nil {
+		return nil, err
+	}
+	data, err := ParseAuthenticatorData(authenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.checkAuthData(data); err != nil {
+		return nil, err
+	}
+
+	key, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte{}, authenticatorData...), clientDataHash[:]...)
+	if err := verifySignature(key, signed, signature); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SignCountRegressed reports whether an assertion's sign count fails to
+// advance past the stored one. Authenticators that don't count always
+// report zero.
+func SignCountRegressed(stored, asserted uint32) bool {
+	if stored == 0 && asserted == 0 {
+		return false
+	}
+	return asserted <= stored
+}
+
+// coseKey holds the COSE key members used by ES256 and RS256. Members -1
+// to -3 mean different things per key type, so they are decoded later.
+type coseKey struct {
+	Kty int             `cbor:"1,keyasint"`
+	Alg int             `cbor:"3,keyasint"`
+	P1  cbor.RawMessage `cbor:"-1,keyasint,omitempty"` // EC2 crv, RSA n
+	P2  cbor.RawMessage `cbor:"-2,keyasint,omitempty"` // EC2 x, RSA e
+	P3  cbor.RawMessage `cbor:"-3,keyasint,omitempty"` // EC2 y
+}
+
+// ParsePublicKey decodes a COSE key into an *ecdsa.PublicKey (ES256) or
+// *rsa.PublicKey (RS256)
+func ParsePublicKey(raw []byte) (crypto.PublicKey, error) {
+	var key coseKey
+	if len(raw) == 0 || cbor.Unmarshal(raw, &key) != nil {
+		return nil, ErrUnsupportedKey
+	}
+
+	switch {
+	case key.Kty == coseKtyEC2 && key.Alg == coseAlgES256:
+		var crv int
+		var x, y []byte
+		if cbor.Unmarshal(key.P1, &crv) != nil || cbor.Unmarshal(key.P2, &x) != nil || cbor.Unmarshal(key.P3, &y) != nil {
+			return nil, ErrUnsupportedKey
+		}
+		if crv != coseCrvP256 || len(x) != 32 || len(y) != 32 {
+			return nil, ErrUnsupportedKey
+		}
+		// Rejects points that aren't on the curve
+		point := append(append([]byte{4}, x...), y...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, ErrUnsupportedKey
+		}
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+
+	case key.Kty == coseKtyRSA && key.Alg == coseAlgRS256:
+		var n, e []byte
+		if cbor.Unmarshal(key.P1, &n) != nil || cbor.Unmarshal(key.P2, &e) != nil {
+			return nil, ErrUnsupportedKey
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) < 256 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return nil, ErrUnsupportedKey
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+func verifySignature(key crypto.PublicKey, signed, signature []byte) error {
+	hash := sha256.Sum256(signed)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, hash[:], signature) {
+			return ErrInvalidSignature
+		}
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) != nil {
+			return ErrInvalidSignature
+		}
+	default:
+		return ErrUnsupportedKey
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/forgo/saga/api/internal/testing/authenticator"
+	"github.com/forgo/saga/api/pkg/webauthn"
+)
+
+const (
+	testRPID   = "saga.test"
+	testOrigin = "https://saga.test"
+)
+
+var testRP = webauthn.RelyingParty{ID: testRPID, Origins: []string{testOrigin}}
+
+func decode(t *testing.T, value string) []byte {
+	t.Helper()
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		t.Fatalf("failed to decode %q: %v", value, err)
+	}
+	return raw
+}
+
+func verify(t *testing.T, rp webauthn.RelyingParty, a authenticator.Assertion, publicKey []byte) (*webauthn.AuthenticatorData, error) {
+	t.Helper()
+	return rp.VerifyAssertion(decode(t, a.ClientDataJSON), decode(t, a.AuthenticatorData), decode(t, a.Signature), publicKey)
+}
+
+func TestVerifyRegistration(t *testing.T) {
+	auth := authenticator.New(t, testRPID, testOrigin)
+	reg := auth.Register("Y2hhbGxlbmdl")
+
+	data, err := testRP.VerifyRegistration(decode(t, reg.ClientDataJSON), decode(t, reg.AttestationObject))
+	if err != nil {
+		t.Fatalf("VerifyRegistration failed: %v", err)
+	}
+	if got := base64.RawURLEncoding.EncodeToString(data.CredentialID); got != auth.CredentialID() {
+		t.Errorf("expected credential ID %s, got %s", auth.CredentialID(), got)
+	}
+	key, err := webauthn.ParsePublicKey(data.PublicKey)
+	if err != nil {
+		t.Fatalf("ParsePublicKey failed: %v", err)
+	}
+	if _, ok := key.(*ecdsa.PublicKey); !ok {
+		t.Errorf("expected an ECDSA key, got %T", key)
+	}
+
+	clientData, err := webauthn.ParseClientData(decode(t, reg.ClientDataJSON))
+	if err != nil {
+		t.Fatalf("ParseClientData failed: %v", err)
+	}
+	if clientData.Challenge != "Y2hhbGxlbmdl" {
+		t.Errorf("expected the issued challenge, got %q", clientData.Challenge)
+	}
+}
+
+func TestVerifyRegistration_Invalid(t *testing.T) {
+	reg := authenticator.New(t, testRPID, testOrigin).Register("Y2hhbGxlbmdl")
+	assertion := authenticator.New(t, testRPID, testOrigin).Assert("Y2hhbGxlbmdl", "")
+
+	tests := []struct {
+		name        string
+		rp          webauthn.RelyingParty
+		clientData  string
+		attestation []byte
+		want        error
+	}{
+		{"assertion client data", testRP, assertion.ClientDataJSON, decode(t, reg.AttestationObject), webauthn.ErrWrongType},
+		{"other origin", webauthn.RelyingParty{ID: testRPID, Origins: []string{"https://other.test"}}, reg.ClientDataJSON, decode(t, reg.AttestationObject), webauthn.ErrOriginNotAllowed},
+		{"other RP", webauthn.RelyingParty{ID: "other.test", Origins: []string{testOrigin}}, reg.ClientDataJSON, decode(t, reg.AttestationObject), webauthn.ErrRPIDMismatch},
+		{"garbage attestation", testRP, reg.ClientDataJSON, []byte("not cbor"), webauthn.ErrInvalidAttestation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.rp.VerifyRegistration(decode(t, tt.clientData), tt.attestation)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	auth := authenticator.New(t, testRPID, testOrigin)
+	auth.SetSignCount(41)
+
+	data, err := verify(t, testRP, auth.Assert("Y2hhbGxlbmdl", ""), auth.PublicKey())
+	if err != nil {
+		t.Fatalf("VerifyAssertion failed: %v", err)
+	}
+	if data.SignCount != 42 {
+		t.Errorf("expected sign count 42, got %d", data.SignCount)
+	}
+	if !data.UserPresent() || !data.UserVerified() {
+		t.Errorf("expected UP and UV flags, got %08b", data.Flags)
+	}
+}
+
+func TestVerifyAssertion_Invalid(t *testing.T) {
+	auth := authenticator.New(t, testRPID, testOrigin)
+	impostor := authenticator.New(t, testRPID, testOrigin)
+	valid := auth.Assert("Y2hhbGxlbmdl", "")
+
+	tampered := auth.Assert("Y2hhbGxlbmdl", "")
+	signature := decode(t, tampered.Signature)
+	signature[len(signature)-1] ^= 0xff
+	tampered.Signature = base64.RawURLEncoding.EncodeToString(signature)
+
+	// Client data from a different challenge than the one signed
+	swapped := auth.Assert("Y2hhbGxlbmdl", "")
+	swapped.ClientDataJSON = auth.Assert("b3RoZXI", "").ClientDataJSON
+
+	tests := []struct {
+		name      string
+		rp        webauthn.RelyingParty
+		assertion authenticator.Assertion
+		publicKey []byte
+		want      error
+	}{
+		{"tampered signature", testRP, tampered, auth.PublicKey(), webauthn.ErrInvalidSignature},
+		{"swapped client data", testRP, swapped, auth.PublicKey(), webauthn.ErrInvalidSignature},
+		{"other key", testRP, valid, impostor.PublicKey(), webauthn.ErrInvalidSignature},
+		{"no stored key", testRP, valid, nil, webauthn.ErrUnsupportedKey},
+		{"other origin", webauthn.RelyingParty{ID: testRPID, Origins: []string{"https://other.test"}}, valid, auth.PublicKey(), webauthn.ErrOriginNotAllowed},
+		{"other RP", webauthn.RelyingParty{ID: "other.test", Origins: []string{testOrigin}}, valid, auth.PublicKey(), webauthn.ErrRPIDMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verify(t, tt.rp, tt.assertion, tt.publicKey); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestSignCountRegressed(t *testing.T) {
+	tests := []struct {
+		stored, asserted uint32
+		want             bool
+	}{
+		{0, 0, false},
+		{0, 1, false},
+		{5, 6, false},
+		{5, 5, true},
+		{5, 4, true},
+		{5, 0, true},
+	}
+
+	for _, tt := range tests {
+		if got := webauthn.SignCountRegressed(tt.stored, tt.asserted); got != tt.want {
+			t.Errorf("SignCountRegressed(%d, %d) = %t, want %t", tt.stored, tt.asserted, got, tt.want)
+		}
+	}
+}
@@ -829,8 +829,17 @@ func TestAuth_ChangePassword(t *testing.T) {
 	require.NoError(t, err)
 
 	// Change password
-	err = authService.ChangePassword(ctx, regResult.User.ID, "oldpassword123", "newpassword456")
+	tokens, err := authService.ChangePassword(ctx, service.ChangePasswordRequest{
+		UserID:          regResult.User.ID,
+		CurrentPassword: "oldpassword123",
+		NewPassword:     "newpassword456",
+	})
 	require.NoError(t, err)
+	assert.NotEmpty(t, tokens.AccessToken)
+
+	// Sessions from before the change are signed out
+	_, err = authService.RefreshTokens(ctx, regResult.TokenPair.RefreshToken)
+	require.Error(t, err)
 
 	// Old password should no longer work
 	_, err = authService.Login(ctx, service.LoginRequest{
@@ -864,6 +873,10 @@ func TestAuth_ChangePasswordWrongOldPassword(t *testing.T) {
 	require.NoError(t, err)
 
 	// Try to change password with wrong old password
-	err = authService.ChangePassword(ctx, regResult.User.ID, "wrongoldpass", "newpassword456")
+	_, err = authService.ChangePassword(ctx, service.ChangePasswordRequest{
+		UserID:          regResult.User.ID,
+		CurrentPassword: "wrongoldpass",
+		NewPassword:     "newpassword456",
+	})
 	require.ErrorIs(t, err, service.ErrInvalidCredentials)
 }
@@ -388,7 +388,7 @@ func TestDiscovery_ProfileEligibility_Update(t *testing.T) {
 	defer tdb.Close()
 
 	f := fixtures.New(tdb.DB)
-	profileRepo := repository.NewProfileRepository(tdb.DB)
+	profileRepo := repository.NewProfileRepository(tdb.DB, nil)
 	ctx := context.Background()
 
 	user := f.CreateUser(t)
@@ -410,7 +410,7 @@ func TestDiscovery_ProfileEligibility_Update(t *testing.T) {
 	// Update to eligible
 	updated, err := profileRepo.Update(ctx, user.ID, map[string]interface{}{
 		"discovery_eligible": true,
-	})
+	}, nil)
 	require.NoError(t, err)
 	assert.True(t, updated.DiscoveryEligible, "Should be discovery eligible after update")
 }
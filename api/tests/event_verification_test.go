@@ -434,7 +434,7 @@ func TestEventVerification_ConfirmationExpired(t *testing.T) {
 	pastDeadline := time.Now().Add(-1 * time.Hour)
 	_, err := eventRepo.Update(ctx, event.ID, map[string]interface{}{
 		"confirmation_deadline": pastDeadline,
-	})
+	}, nil)
 	require.NoError(t, err)
 
 	// Create RSVPs
@@ -593,7 +593,7 @@ func TestGuild_LeaveGuild(t *testing.T) {
 	assert.Equal(t, 2, count)
 
 	// Second user leaves
-	err = guildService.LeaveGuild(ctx, member.ID, guild.ID)
+	_, err = guildService.LeaveGuild(ctx, member.ID, guild.ID)
 
 	require.NoError(t, err)
 
@@ -631,7 +631,7 @@ func TestGuild_CannotLeaveSoleMember(t *testing.T) {
 	assert.Equal(t, 1, count)
 
 	// Try to leave
-	err = guildService.LeaveGuild(ctx, user.ID, guild.ID)
+	_, err = guildService.LeaveGuild(ctx, user.ID, guild.ID)
 
 	require.ErrorIs(t, err, service.ErrCannotLeaveSoleMember)
 
@@ -660,7 +660,7 @@ func TestGuild_LeaveNonMember(t *testing.T) {
 	require.NoError(t, err)
 
 	// Non-member tries to leave
-	err = guildService.LeaveGuild(ctx, nonMember.ID, guild.ID)
+	_, err = guildService.LeaveGuild(ctx, nonMember.ID, guild.ID)
 
 	require.ErrorIs(t, err, service.ErrNotGuildMember)
 }
@@ -21,13 +21,26 @@ AC-LOC-003: Coarse Location Only
   GIVEN user profile
   WHEN fetched publicly
   THEN only city, country, timezone visible
+
+AC-LOC-004: Precise Coordinates Encrypted at Rest
+  GIVEN field encryption keys are configured
+  WHEN a profile location is stored
+  THEN the database holds only coarse coordinates in plaintext
+  AND precise coordinates are readable through the repository
+  AND rows are resealed under a new primary key after rotation
 */
 
 import (
+	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/forgo/saga/api/internal/model"
+	"github.com/forgo/saga/api/internal/repository"
+	"github.com/forgo/saga/api/internal/testing/fixtures"
+	"github.com/forgo/saga/api/internal/testing/testdb"
+	"github.com/forgo/saga/api/pkg/fieldcrypt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -219,3 +232,66 @@ func TestLocationPrivacy_DistanceBucketValues(t *testing.T) {
 	assert.Equal(t, model.DistanceBucket("~10km"), model.Distance10km)
 	assert.Equal(t, model.DistanceBucket(">20km"), model.Distance20kmPlus)
 }
+
+func newTestKeyring(t *testing.T, ids ...string) *fieldcrypt.Keyring {
+	t.Helper()
+	var keys []fieldcrypt.Key
+	for _, id := range ids {
+		// Each ID always gets the same key, padded to size
+		secret := []byte(id + strings.Repeat("-", fieldcrypt.KeySize-len(id)))
+		keys = append(keys, fieldcrypt.Key{ID: id, Secret: secret})
+	}
+	keyring, err := fieldcrypt.NewKeyring(keys)
+	require.NoError(t, err)
+	return keyring
+}
+
+func TestLocationPrivacy_PreciseCoordinatesEncryptedAtRest(t *testing.T) {
+	// AC-LOC-004: Precise coordinates are sealed; only coarse ones are stored in plaintext
+	tdb := testdb.New(t)
+	defer tdb.Close()
+
+	f := fixtures.New(tdb.DB)
+	ctx := context.Background()
+	user := f.CreateUser(t)
+
+	oldRepo := repository.NewProfileRepository(tdb.DB, newTestKeyring(t, "old"))
+	require.NoError(t, oldRepo.Create(ctx, &model.UserProfile{
+		UserID:     user.ID,
+		Visibility: model.VisibilityPublic,
+		Location: &model.Location{
+			Lat:     37.774929,
+			Lng:     -122.419416,
+			City:    "San Francisco",
+			Country: "United States",
+		},
+	}))
+
+	raw, err := tdb.DB.QueryOne(ctx, `SELECT location, location_sealed FROM user_profile WHERE user = type::record($user_id)`, map[string]interface{}{"user_id": user.ID})
+	require.NoError(t, err)
+	row := raw.(map[string]interface{})
+	stored := row["location"].(map[string]interface{})
+	assert.InDelta(t, 37.77, stored["lat"], 1e-9, "only coarse latitude should be stored in plaintext")
+	assert.InDelta(t, -122.42, stored["lng"], 1e-9, "only coarse longitude should be stored in plaintext")
+	sealed, _ := row["location_sealed"].(string)
+	assert.True(t, strings.HasPrefix(sealed, "enc:v1:old:"), "precise coordinates should be sealed")
+
+	location, err := oldRepo.GetLocationInternal(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 37.774929, location.Lat)
+	assert.Equal(t, -122.419416, location.Lng)
+
+	// Rotate: new primary key first, old key kept for opening
+	rotatedRepo := repository.NewProfileRepository(tdb.DB, newTestKeyring(t, "new", "old"))
+	resealed, err := rotatedRepo.ResealLocations(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resealed)
+	resealed, err = rotatedRepo.ResealLocations(ctx, 100)
+	require.NoError(t, err)
+	assert.Zero(t, resealed, "resealed rows should not be picked up again")
+
+	newRepo := repository.NewProfileRepository(tdb.DB, newTestKeyring(t, "new"))
+	profile, err := newRepo.GetByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 37.774929, profile.Location.Lat, "precise location should open without the old key")
+}